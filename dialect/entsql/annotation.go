@@ -0,0 +1,204 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package entsql provides the schema annotations for the SQL dialects.
+package entsql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Annotation is a builtin schema annotation for attaching
+// SQL metadata to schema objects in codegen. For example:
+//
+//	edge.To("spouse", User.Type).
+//		Unique().
+//		Annotations(entsql.Deferrable(entsql.InitiallyDeferred))
+//
+type Annotation struct {
+	// Deferrable defines the deferrable mode of the foreign-key constraint that
+	// is created for the edge. Note that deferrable constraints are supported only
+	// by PostgreSQL, and migrating them on other dialects fails.
+	Deferrable DeferrableMode `json:"deferrable,omitempty"`
+
+	// IncludeColumns defines the non-key columns that are included in the index
+	// (covering index). Note that covering indexes are supported only by PostgreSQL,
+	// and migrating them on other dialects fails.
+	IncludeColumns []string `json:"include_columns,omitempty"`
+
+	// IndexType defines the method (access method) of the index. For example,
+	// GIST for spatial indexes. Note that index types are supported only by
+	// PostgreSQL, and migrating them on other dialects fails.
+	IndexType string `json:"index_type,omitempty"`
+
+	// IndexOpClass defines the operator class of the key columns of the index. For
+	// example, vector_cosine_ops for pgvector indexes. Note that operator classes are
+	// supported only by PostgreSQL, and migrating them on other dialects fails.
+	IndexOpClass string `json:"index_op_class,omitempty"`
+
+	// IndexWith defines the storage parameters of the index (its WITH clause). For
+	// example, the "lists" parameter of IVFFlat indexes. Note that storage parameters
+	// are supported only by PostgreSQL, and migrating them on other dialects fails.
+	IndexWith map[string]int `json:"index_with,omitempty"`
+
+	// Sequence defines the sequence that is used for generating the values of
+	// the field (e.g. string ids) in the database. Note that sequences are supported
+	// only by PostgreSQL, and the field has no default value on other dialects.
+	Sequence *Sequence `json:"sequence,omitempty"`
+
+	// SharedColumns defines the columns that are shared by the two types of the edge,
+	// and are added to its foreign-key constraint. For example, a tenant column that
+	// makes the foreign-key of the edge a composite key: (tenant_id, order_id).
+	SharedColumns []string `json:"shared_columns,omitempty"`
+}
+
+// Name describes the annotation name.
+func (Annotation) Name() string {
+	return "EntSQL"
+}
+
+// Sequence describes a database sequence and the format of the values that
+// are generated from it. For example, the sequence below generates values like
+// 'card_000001', 'card_000002', etc.
+//
+//	&entsql.Sequence{Name: "card_seq", Prefix: "card_", Width: 6}
+//
+type Sequence struct {
+	Name   string `json:"name"`
+	Prefix string `json:"prefix,omitempty"`
+	Width  int    `json:"width,omitempty"`
+}
+
+// Expr returns the PostgreSQL expression that generates the next value
+// of the sequence. It is used as the default expression of the column.
+func (s Sequence) Expr() string {
+	expr := fmt.Sprintf("nextval('%s')::text", quote(s.Name))
+	if s.Width > 0 {
+		expr = fmt.Sprintf("lpad(%s, %d, '0')", expr, s.Width)
+	}
+	if s.Prefix != "" {
+		expr = fmt.Sprintf("'%s' || %s", quote(s.Prefix), expr)
+	}
+	return expr
+}
+
+// quote escapes the single quotes of a string literal.
+func quote(s string) string {
+	return strings.Replace(s, "'", "''", -1)
+}
+
+// DeferrableMode defines when the constraint of a deferrable foreign-key is checked.
+type DeferrableMode string
+
+// Deferrable modes.
+const (
+	// InitiallyImmediate checks the constraint after each statement,
+	// unless it's deferred explicitly using SET CONSTRAINTS.
+	InitiallyImmediate DeferrableMode = "INITIALLY IMMEDIATE"
+	// InitiallyDeferred checks the constraint only at the end of the transaction.
+	InitiallyDeferred DeferrableMode = "INITIALLY DEFERRED"
+)
+
+// Valid reports if the mode is a valid deferrable mode.
+func (m DeferrableMode) Valid() bool {
+	return m == InitiallyImmediate || m == InitiallyDeferred
+}
+
+// Deferrable returns an annotation that makes the foreign-key
+// constraint of the edge deferrable with the given mode.
+func Deferrable(mode DeferrableMode) *Annotation {
+	return &Annotation{Deferrable: mode}
+}
+
+// IncludeColumns returns an annotation that adds the given columns to the
+// INCLUDE clause of the index, for supporting index-only scans. For example:
+//
+//	index.Fields("owner_id").
+//		Annotations(entsql.IncludeColumns("type", "expires_at"))
+//
+func IncludeColumns(columns ...string) *Annotation {
+	return &Annotation{IncludeColumns: columns}
+}
+
+// IndexType returns an annotation that sets the method of the index. For
+// example, a GiST index for geometry columns:
+//
+//	index.Fields("location").
+//		Annotations(entsql.IndexType("GIST"))
+//
+func IndexType(t string) *Annotation {
+	return &Annotation{IndexType: t}
+}
+
+// Operator classes of pgvector indexes. Each class matches a
+// distance operator (see sql.VectorOp).
+const (
+	VectorL2Ops     = "vector_l2_ops"
+	VectorCosineOps = "vector_cosine_ops"
+	VectorIPOps     = "vector_ip_ops"
+)
+
+// HNSW returns an annotation that makes the index a pgvector HNSW index with
+// the given operator class and build parameters. Zero parameters are omitted,
+// and fall back to their pgvector defaults. For example:
+//
+//	index.Fields("embedding").
+//		Annotations(entsql.HNSW(entsql.VectorCosineOps, 16, 64))
+//
+func HNSW(opclass string, m, efConstruction int) *Annotation {
+	ant := &Annotation{IndexType: "hnsw", IndexOpClass: opclass}
+	for k, v := range map[string]int{"m": m, "ef_construction": efConstruction} {
+		if v > 0 {
+			if ant.IndexWith == nil {
+				ant.IndexWith = make(map[string]int)
+			}
+			ant.IndexWith[k] = v
+		}
+	}
+	return ant
+}
+
+// IVFFlat returns an annotation that makes the index a pgvector IVFFlat index
+// with the given operator class and number of lists. For example:
+//
+//	index.Fields("embedding").
+//		Annotations(entsql.IVFFlat(entsql.VectorL2Ops, 100))
+//
+func IVFFlat(opclass string, lists int) *Annotation {
+	ant := &Annotation{IndexType: "ivfflat", IndexOpClass: opclass}
+	if lists > 0 {
+		ant.IndexWith = map[string]int{"lists": lists}
+	}
+	return ant
+}
+
+// IDSequence returns an annotation that generates the values of a string id
+// field from a database sequence, using the given prefix and zero-padded width.
+// For example:
+//
+//	field.String("id").
+//		Annotations(entsql.IDSequence("card_seq", "card_", 6))
+//
+// On PostgreSQL, the migration creates the sequence and sets the column default to:
+//
+//	'card_' || lpad(nextval('card_seq')::text, 6, '0')
+//
+func IDSequence(name, prefix string, width int) *Annotation {
+	return &Annotation{Sequence: &Sequence{Name: name, Prefix: prefix, Width: width}}
+}
+
+// CompositeForeignKey returns an annotation that turns the foreign-key of
+// the edge into a composite key, by prefixing it with the given fields.
+// The fields must be defined with the same name and type in both types of
+// the edge. For example, scoping the line items of an order to its tenant:
+//
+//	edge.To("line_items", LineItem.Type).
+//		Annotations(entsql.CompositeForeignKey("tenant_id"))
+//
+// The migration creates the foreign-key (tenant_id, order_line_items) that references
+// the columns (tenant_id, id) of the orders table, and a unique index on the latter.
+func CompositeForeignKey(fields ...string) *Annotation {
+	return &Annotation{SharedColumns: fields}
+}
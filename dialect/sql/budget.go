@@ -0,0 +1,153 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// QueryBudgetExceededError is returned by the BudgetDriver for statements that are
+// executed after the query budget of their context (see WithQueryBudget) was used.
+type QueryBudgetExceededError struct {
+	// Max is the number of statements that the budget allows.
+	Max int
+}
+
+// Error implements the error interface.
+func (e *QueryBudgetExceededError) Error() string {
+	return fmt.Sprintf("dialect/sql: query budget of %d statements exceeded", e.Max)
+}
+
+// budget counts the statements that were executed with a context.
+type budget struct {
+	max  int
+	used int64
+}
+
+type budgetKey struct{}
+
+// WithQueryBudget returns a new context that allows executing at most max statements
+// by the BudgetDriver (and its transactions), including statements that are executed
+// by eager-loading and bulk operations. The budget is shared by all the contexts that
+// are derived from the returned context.
+//
+//	ctx = sql.WithQueryBudget(r.Context(), 50)
+//	users, err := client.User.Query().WithPets().All(ctx)
+//
+func WithQueryBudget(ctx context.Context, max int) context.Context {
+	if max < 0 {
+		max = 0
+	}
+	return context.WithValue(ctx, budgetKey{}, &budget{max: max})
+}
+
+// QueryBudgetUsed returns the number of statements that were executed with the
+// query budget of the context, and false if the context does not have a budget.
+func QueryBudgetUsed(ctx context.Context) (int, bool) {
+	b, ok := ctx.Value(budgetKey{}).(*budget)
+	if !ok {
+		return 0, false
+	}
+	used := atomic.LoadInt64(&b.used)
+	if used > int64(b.max) {
+		used = int64(b.max)
+	}
+	return int(used), true
+}
+
+// spend counts a statement on the budget of the context (if it has one), and
+// returns a *QueryBudgetExceededError if the budget was already used.
+func spend(ctx context.Context) error {
+	b, ok := ctx.Value(budgetKey{}).(*budget)
+	if !ok {
+		return nil
+	}
+	if atomic.AddInt64(&b.used, 1) > int64(b.max) {
+		return &QueryBudgetExceededError{Max: b.max}
+	}
+	return nil
+}
+
+// BudgetDriver is a driver that enforces the query budget of the context (see WithQueryBudget)
+// on the statements that are executed by the underlying driver (or by its transactions).
+type BudgetDriver struct {
+	dialect.Driver // underlying driver.
+}
+
+// Budget gets a driver and returns a new driver that enforces the query budget of
+// the context on its statements. Contexts without a budget are not limited.
+func Budget(d dialect.Driver) dialect.Driver {
+	return &BudgetDriver{d}
+}
+
+// Exec spends the budget of the context and calls the underlying driver Exec method.
+func (d *BudgetDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := spend(ctx); err != nil {
+		return err
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query spends the budget of the context and calls the underlying driver Query method.
+func (d *BudgetDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := spend(ctx); err != nil {
+		return err
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction that enforces the query budget on its statements.
+func (d *BudgetDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &BudgetTx{tx}, nil
+}
+
+// BeginTx starts a transaction with options that enforces the query budget on its
+// statements. It fails if the underlying driver does not support transaction options.
+func (d *BudgetDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: Driver.BeginTx is not supported by %T", d.Driver)
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &BudgetTx{tx}, nil
+}
+
+// BudgetTx is a transaction implementation that enforces the query budget of the
+// context on its statements. Committing or rolling back the transaction is not
+// counted, and it is not limited by the budget.
+type BudgetTx struct {
+	dialect.Tx // underlying transaction.
+}
+
+// Exec spends the budget of the context and calls the underlying transaction Exec method.
+func (t *BudgetTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := spend(ctx); err != nil {
+		return err
+	}
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// Query spends the budget of the context and calls the underlying transaction Query method.
+func (t *BudgetTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := spend(ctx); err != nil {
+		return err
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+var _ dialect.Driver = (*BudgetDriver)(nil)
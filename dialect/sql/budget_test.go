@@ -0,0 +1,63 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := Budget(OpenDB("mysql", db))
+
+	// Contexts without a budget are not limited.
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `users`")).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		require.NoError(t, drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, &Rows{}))
+	}
+	_, ok := QueryBudgetUsed(ctx)
+	require.False(t, ok)
+
+	ctx = WithQueryBudget(ctx, 3)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `users`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	require.NoError(t, drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, &Rows{}))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `age` = ?")).
+		WithArgs(30).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `pets` SET `age` = ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "UPDATE `users` SET `age` = ?", []interface{}{30}, nil))
+	require.NoError(t, tx.Exec(ctx, "UPDATE `pets` SET `age` = ?", []interface{}{1}, nil))
+	used, ok := QueryBudgetUsed(ctx)
+	require.True(t, ok)
+	require.Equal(t, 3, used)
+
+	// Statements in (and out of) the transaction are not executed once the budget was used.
+	err = tx.Query(ctx, "SELECT `id` FROM `pets`", []interface{}{}, &Rows{})
+	var berr *QueryBudgetExceededError
+	require.True(t, errors.As(err, &berr))
+	require.Equal(t, 3, berr.Max)
+	require.NoError(t, tx.Commit())
+	err = drv.Exec(WithOperation(ctx, "User", "Delete"), "DELETE FROM `users`", []interface{}{}, nil)
+	require.EqualError(t, err, "dialect/sql: query budget of 3 statements exceeded", "derived contexts share the budget")
+	used, _ = QueryBudgetUsed(ctx)
+	require.Equal(t, 3, used)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,7 +7,10 @@ package sql
 import (
 	"bytes"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -425,6 +428,13 @@ func (fk *ForeignKeyBuilder) OnUpdate(action string) *ForeignKeyBuilder {
 	return fk
 }
 
+// Deferrable sets the constraint as deferrable with the given mode (e.g. "INITIALLY DEFERRED").
+// Note that, it should be called after setting the referential actions of the constraint.
+func (fk *ForeignKeyBuilder) Deferrable(mode string) *ForeignKeyBuilder {
+	fk.actions = append(fk.actions, "DEFERRABLE "+mode)
+	return fk
+}
+
 // Query returns query representation of a foreign key constraint.
 func (fk *ForeignKeyBuilder) Query() (string, []interface{}) {
 	if fk.symbol != "" {
@@ -483,6 +493,10 @@ type IndexBuilder struct {
 	unique  bool
 	table   string
 	columns []string
+	include []string
+	method  string
+	opclass string
+	with    map[string]int
 }
 
 // CreateIndex creates a builder for the `CREATE INDEX` statement.
@@ -527,6 +541,71 @@ func (i *IndexBuilder) Columns(columns ...string) *IndexBuilder {
 	return i
 }
 
+// Include appends the given columns to the INCLUDE clause of the index (covering index).
+// Note that the INCLUDE clause is supported only by PostgreSQL.
+//
+//	Dialect(dialect.Postgres).
+//		CreateIndex("owner_id").
+//		Table("cards").
+//		Column("owner_id").
+//		Include("type", "expires_at")
+//
+func (i *IndexBuilder) Include(columns ...string) *IndexBuilder {
+	i.include = append(i.include, columns...)
+	return i
+}
+
+// Using sets the index method (access method) of the index. For example, GIST
+// for spatial indexes. Note that the USING clause is supported only by PostgreSQL.
+//
+//	Dialect(dialect.Postgres).
+//		CreateIndex("location").
+//		Table("places").
+//		Column("location").
+//		Using("GIST")
+//
+func (i *IndexBuilder) Using(method string) *IndexBuilder {
+	i.method = method
+	return i
+}
+
+// OpClass sets the operator class of the key columns of the index. For example,
+// vector_cosine_ops for pgvector indexes. Note that operator classes are supported
+// only by PostgreSQL.
+//
+//	Dialect(dialect.Postgres).
+//		CreateIndex("embedding").
+//		Table("documents").
+//		Column("embedding").
+//		Using("hnsw").
+//		OpClass("vector_cosine_ops")
+//
+func (i *IndexBuilder) OpClass(opclass string) *IndexBuilder {
+	i.opclass = opclass
+	return i
+}
+
+// With sets the storage parameters of the index (the WITH clause). Parameters
+// are written in a sorted order. Note that the WITH clause is supported only by
+// PostgreSQL.
+//
+//	Dialect(dialect.Postgres).
+//		CreateIndex("embedding").
+//		Table("documents").
+//		Column("embedding").
+//		Using("ivfflat").
+//		With(map[string]int{"lists": 100})
+//
+func (i *IndexBuilder) With(params map[string]int) *IndexBuilder {
+	if i.with == nil {
+		i.with = make(map[string]int, len(params))
+	}
+	for k, v := range params {
+		i.with[k] = v
+	}
+	return i
+}
+
 // Query returns query representation of a reference clause.
 func (i *IndexBuilder) Query() (string, []interface{}) {
 	i.WriteString("CREATE ")
@@ -536,9 +615,43 @@ func (i *IndexBuilder) Query() (string, []interface{}) {
 	i.WriteString("INDEX ")
 	i.Ident(i.name)
 	i.WriteString(" ON ")
-	i.Ident(i.table).Nested(func(b *Builder) {
-		b.IdentComma(i.columns...)
+	i.Ident(i.table)
+	if i.method != "" {
+		i.WriteString(" USING " + i.method + " ")
+	}
+	i.Nested(func(b *Builder) {
+		for j, c := range i.columns {
+			if j > 0 {
+				b.Comma()
+			}
+			b.Ident(c)
+			if i.opclass != "" {
+				b.WriteString(" " + i.opclass)
+			}
+		}
 	})
+	if len(i.include) > 0 {
+		i.WriteString(" INCLUDE ")
+		i.Nested(func(b *Builder) {
+			b.IdentComma(i.include...)
+		})
+	}
+	if len(i.with) > 0 {
+		keys := make([]string, 0, len(i.with))
+		for k := range i.with {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		i.WriteString(" WITH ")
+		i.Nested(func(b *Builder) {
+			for j, k := range keys {
+				if j > 0 {
+					b.Comma()
+				}
+				b.WriteString(k + " = " + strconv.Itoa(i.with[k]))
+			}
+		})
+	}
 	return i.String(), nil
 }
 
@@ -592,6 +705,8 @@ type InsertBuilder struct {
 	defaults  string
 	returning []string
 	values    [][]interface{}
+	selector  *Selector
+	conflict  *conflict
 }
 
 // Insert creates a builder for the `INSERT INTO` statement.
@@ -627,6 +742,19 @@ func (i *InsertBuilder) Values(values ...interface{}) *InsertBuilder {
 	return i
 }
 
+// Select sets the SELECT statement that provides the inserted rows, instead
+// of the VALUES clause. The selected columns are matched to the columns of the
+// insert statement by their position.
+//
+//	Insert("archived_users").
+//		Columns("name", "age").
+//		Select(Select("name", "age").From(Table("users")).Where(GT("age", 30)))
+//
+func (i *InsertBuilder) Select(s *Selector) *InsertBuilder {
+	i.selector = s
+	return i
+}
+
 // Default sets the default values clause based on the dialect type.
 func (i *InsertBuilder) Default() *InsertBuilder {
 	switch i.Dialect() {
@@ -644,9 +772,126 @@ func (i *InsertBuilder) Returning(columns ...string) *InsertBuilder {
 	return i
 }
 
+// conflict holds the configuration of the ON CONFLICT clause.
+type conflict struct {
+	columns    []string
+	constraint string
+	where      *Predicate
+	nothing    bool
+	update     bool
+	ignore     []string
+	lastID     string
+}
+
+// ConflictOption allows configuring the conflict
+// clause of the `INSERT` statement.
+type ConflictOption func(*conflict)
+
+// ConflictColumns sets the unique constraints that trigger conflicts on insert.
+// In PostgreSQL and SQLite, it is used as the conflict target. MySQL ignores it
+// and applies the conflict action to all unique keys.
+func ConflictColumns(columns ...string) ConflictOption {
+	return func(c *conflict) {
+		c.columns = columns
+		c.constraint, c.where = "", nil
+	}
+}
+
+// ConflictColumnsWhere sets the unique columns and the index predicate of a partial
+// unique index as the conflict target. In PostgreSQL and SQLite, it is translated to
+// `ON CONFLICT (columns) WHERE predicate`, and it allows the database to infer partial
+// indexes as arbiters. MySQL ignores it, like ConflictColumns.
+//
+//	ConflictColumnsWhere([]string{"number_hash"}, IsNull("deleted_at"))
+//
+func ConflictColumnsWhere(columns []string, p *Predicate) ConflictOption {
+	return func(c *conflict) {
+		c.columns, c.where = columns, p
+		c.constraint = ""
+	}
+}
+
+// ConflictConstraint sets the named constraint that is used as the conflict target.
+// It is translated to `ON CONFLICT ON CONSTRAINT name`, and therefore, it is supported
+// only by PostgreSQL. MySQL ignores it, like ConflictColumns.
+//
+//	ConflictConstraint("uq_number_hash")
+//
+func ConflictConstraint(name string) ConflictOption {
+	return func(c *conflict) {
+		c.constraint = name
+		c.columns, c.where = nil, nil
+	}
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict
+// with existing rows. In PostgreSQL and SQLite, it is translated to
+// `ON CONFLICT DO NOTHING`, and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL downgrades other errors (e.g. data truncation) to warnings
+// when `INSERT IGNORE` is used, and does not report which rows were ignored.
+func DoNothing() ConflictOption {
+	return func(c *conflict) {
+		c.nothing = true
+	}
+}
+
+// ResolveWithNewValues configures the conflict action to update the rows that conflict
+// with the values that were proposed for insertion. In PostgreSQL and SQLite, it is
+// translated to `ON CONFLICT (...) DO UPDATE SET c = excluded.c`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE c = VALUES(c)`.
+//
+// All columns of the insert are updated, except the conflict columns and the columns
+// that were set by UpdateIgnore. Note that PostgreSQL and SQLite require the conflict
+// columns to be set (see ConflictColumns).
+func ResolveWithNewValues() ConflictOption {
+	return func(c *conflict) {
+		c.update = true
+	}
+}
+
+// UpdateIgnore sets the columns that are not updated by ResolveWithNewValues
+// (e.g. the primary key, if it was provided for insertion).
+func UpdateIgnore(columns ...string) ConflictOption {
+	return func(c *conflict) {
+		c.ignore = append(c.ignore, columns...)
+	}
+}
+
+// UpdateLastInsertID sets the value of the given (integer) column of the row that was inserted,
+// or updated by ResolveWithNewValues, as the LAST_INSERT_ID of the statement in MySQL, using
+// `column = LAST_INSERT_ID(column)`. It allows reading the id of the row that was updated, since
+// MySQL applies the update on a conflict with any unique key. Other dialects ignore it.
+func UpdateLastInsertID(column string) ConflictOption {
+	return func(c *conflict) {
+		c.lastID = column
+	}
+}
+
+// OnConflict sets the conflict options of the insert statement.
+//
+//	Insert("users").
+//		Columns("name", "age").
+//		Values("a8m", 10).
+//		OnConflict(ConflictColumns("name"), DoNothing())
+//
+func (i *InsertBuilder) OnConflict(opts ...ConflictOption) *InsertBuilder {
+	if i.conflict == nil {
+		i.conflict = &conflict{}
+	}
+	for _, opt := range opts {
+		opt(i.conflict)
+	}
+	return i
+}
+
 // Query returns query representation of an `INSERT INTO` statement.
 func (i *InsertBuilder) Query() (string, []interface{}) {
-	i.WriteString("INSERT INTO ")
+	i.WriteString("INSERT ")
+	if i.conflict != nil && i.conflict.nothing && !i.upsert() {
+		i.WriteString("IGNORE ")
+	}
+	i.WriteString("INTO ")
 	i.Ident(i.table).Pad()
 	if i.defaults != "" && len(i.columns) == 0 {
 		i.WriteString(i.defaults)
@@ -654,16 +899,28 @@ func (i *InsertBuilder) Query() (string, []interface{}) {
 		i.Nested(func(b *Builder) {
 			b.IdentComma(i.columns...)
 		})
-		i.WriteString(" VALUES ")
-		for j, v := range i.values {
-			if j > 0 {
-				i.Comma()
+		if i.selector != nil {
+			i.Pad().Join(i.selector)
+		} else {
+			i.WriteString(" VALUES ")
+			for j, v := range i.values {
+				if j > 0 {
+					i.Comma()
+				}
+				i.Nested(func(b *Builder) {
+					b.Args(v...)
+				})
 			}
-			i.Nested(func(b *Builder) {
-				b.Args(v...)
-			})
 		}
 	}
+	if i.conflict != nil && i.conflict.nothing && i.upsert() {
+		i.WriteString(" ON CONFLICT")
+		i.conflictTarget()
+		i.WriteString(" DO NOTHING")
+	}
+	if i.conflict != nil && i.conflict.update {
+		i.updateSet()
+	}
 	if len(i.returning) > 0 && i.postgres() {
 		i.WriteString(" RETURNING ")
 		i.IdentComma(i.returning...)
@@ -671,14 +928,90 @@ func (i *InsertBuilder) Query() (string, []interface{}) {
 	return i.String(), i.args
 }
 
+// updateSet writes the conflict clause that updates the conflicting rows with the new values.
+func (i *InsertBuilder) updateSet() {
+	skip := make(map[string]bool)
+	for _, c := range append(i.conflict.columns, i.conflict.ignore...) {
+		skip[c] = true
+	}
+	var columns []string
+	for _, c := range i.columns {
+		if !skip[c] {
+			columns = append(columns, c)
+		}
+	}
+	switch {
+	case len(columns) == 0:
+		i.AddError(fmt.Errorf("missing columns for updating the conflicting rows of table %q", i.table))
+		return
+	case !i.upsert():
+		i.WriteString(" ON DUPLICATE KEY UPDATE ")
+		for j, c := range columns {
+			if j > 0 {
+				i.Comma()
+			}
+			i.Ident(c).WriteString(" = VALUES(")
+			i.Ident(c).WriteByte(')')
+		}
+		if c := i.conflict.lastID; c != "" {
+			i.Comma().Ident(c).WriteString(" = LAST_INSERT_ID(")
+			i.Ident(c).WriteByte(')')
+		}
+		return
+	case len(i.conflict.columns) == 0 && i.conflict.constraint == "":
+		i.AddError(fmt.Errorf("missing conflict columns for updating the conflicting rows of table %q", i.table))
+		return
+	}
+	i.WriteString(" ON CONFLICT")
+	i.conflictTarget()
+	i.WriteString(" DO UPDATE SET ")
+	for j, c := range columns {
+		if j > 0 {
+			i.Comma()
+		}
+		i.Ident(c).WriteString(" = excluded.")
+		i.Ident(c)
+	}
+}
+
+// conflictTarget writes the conflict target of the `ON CONFLICT` clause, if it was set.
+func (i *InsertBuilder) conflictTarget() {
+	switch c := i.conflict; {
+	case c.constraint != "":
+		if !i.postgres() {
+			i.AddError(fmt.Errorf("conflict constraint %q is not supported by dialect %q", c.constraint, i.Dialect()))
+			return
+		}
+		i.WriteString(" ON CONSTRAINT ")
+		i.Ident(c.constraint)
+	case len(c.columns) > 0:
+		i.Pad().Nested(func(b *Builder) {
+			b.IdentComma(c.columns...)
+		})
+		if c.where != nil {
+			i.WriteString(" WHERE ")
+			// The predicate is cloned, because conflict
+			// options may be shared by multiple inserts.
+			i.Join(c.where.clone())
+		}
+	}
+}
+
+// upsert reports if the builder dialect supports the `ON CONFLICT` clause.
+func (i *InsertBuilder) upsert() bool {
+	return i.postgres() || i.Dialect() == dialect.SQLite
+}
+
 // UpdateBuilder is a builder for `UPDATE` statement.
 type UpdateBuilder struct {
 	Builder
-	table   string
-	where   *Predicate
-	nulls   []string
-	columns []string
-	values  []interface{}
+	table     string
+	where     *Predicate
+	nulls     []string
+	columns   []string
+	values    []interface{}
+	limit     *int
+	returning []string
 }
 
 // Update creates a builder for the `UPDATE` statement.
@@ -708,6 +1041,168 @@ func (u *UpdateBuilder) Add(column string, v interface{}) *UpdateBuilder {
 	return u
 }
 
+// SetCase sets a column to a different value for each row, selected by the value of the given key
+// column. The i-th value is set on the row whose key equals the i-th key, and other rows keep their
+// current value. For example:
+//
+//	Update("users").SetCase("name", "id", []interface{}{1, 2}, []interface{}{"a8m", "nati"})
+//	// UPDATE `users` SET `name` = CASE WHEN `id` = ? THEN ? WHEN `id` = ? THEN ? ELSE `name` END
+//
+func (u *UpdateBuilder) SetCase(column, key string, keys, values []interface{}) *UpdateBuilder {
+	if len(keys) != len(values) {
+		u.AddError(fmt.Errorf("dialect/sql: mismatched number of keys (%d) and values (%d) for column %q", len(keys), len(values), column))
+		return u
+	}
+	u.columns = append(u.columns, column)
+	u.values = append(u.values, P().append(func(b *Builder) {
+		b.WriteString("CASE")
+		for i := range keys {
+			b.WriteString(" WHEN ")
+			b.Ident(key)
+			b.WriteString(" = ")
+			b.Arg(keys[i])
+			b.WriteString(" THEN ")
+			b.Arg(values[i])
+		}
+		b.WriteString(" ELSE ")
+		b.Ident(column)
+		b.WriteString(" END")
+	}))
+	return u
+}
+
+// MergeJSON merges the given JSON document (encoded as string) into the JSON
+// object stored in the given column. Only the top-level keys of the document are
+// set in PostgreSQL (using the "||" operator), and MySQL applies the document as
+// a JSON merge-patch (RFC 7396). Other dialects are not supported.
+func (u *UpdateBuilder) MergeJSON(column string, v interface{}) *UpdateBuilder {
+	var p *Predicate
+	switch u.dialect {
+	case dialect.Postgres:
+		p = P().append(func(b *Builder) {
+			b.WriteString("COALESCE")
+			b.Nested(func(b *Builder) {
+				b.Ident(column).Comma().WriteString("'{}'::jsonb")
+			})
+			b.WriteString(" || ")
+			b.Arg(v)
+			b.WriteString("::jsonb")
+		})
+	case dialect.MySQL:
+		p = P().append(func(b *Builder) {
+			b.WriteString("JSON_MERGE_PATCH")
+			b.Nested(func(b *Builder) {
+				b.WriteString("COALESCE")
+				b.Nested(func(b *Builder) {
+					b.Ident(column).Comma().WriteString("'{}'")
+				})
+				b.Comma().Arg(v)
+			})
+		})
+	default:
+		u.AddError(fmt.Errorf("dialect/sql: JSON merge is not supported by %q dialect", u.dialect))
+		return u
+	}
+	u.columns = append(u.columns, column)
+	u.values = append(u.values, p)
+	return u
+}
+
+// SetJSONPath sets the value at the given path of the JSON object stored in the
+// given column, leaving the rest of the document untouched. The value is expected
+// to be JSON encoded, and calling it multiple times for the same column nests the
+// updates in the order they were added. Only PostgreSQL and MySQL are supported.
+//
+//	Dialect(dialect.Postgres).
+//		Update("users").
+//		SetJSONPath("meta", []string{"a", "b"}, "1")
+//
+func (u *UpdateBuilder) SetJSONPath(column string, path []string, v interface{}) *UpdateBuilder {
+	if len(path) == 0 {
+		u.AddError(fmt.Errorf("dialect/sql: missing JSON path for column %q", column))
+		return u
+	}
+	idx, prev := -1, interface{}(nil)
+	for i := range u.columns {
+		if u.columns[i] == column {
+			idx, prev = i, u.values[i]
+		}
+	}
+	// doc writes the current value of the document.
+	doc := func(b *Builder) {
+		if idx == -1 {
+			b.Ident(column)
+			return
+		}
+		switch x := prev.(type) {
+		case Querier:
+			b.Join(x)
+		default:
+			b.Arg(x)
+		}
+	}
+	var p *Predicate
+	switch u.dialect {
+	case dialect.Postgres:
+		p = P().append(func(b *Builder) {
+			b.WriteString("jsonb_set")
+			b.Nested(func(b *Builder) {
+				b.WriteString("COALESCE")
+				b.Nested(func(b *Builder) {
+					doc(b)
+					b.Comma().WriteString("'{}'::jsonb")
+				})
+				b.Comma().WriteString("ARRAY[")
+				for i := range path {
+					if i > 0 {
+						b.Comma()
+					}
+					b.Arg(path[i])
+				}
+				b.WriteString("]::text[]")
+				b.Comma().Arg(v)
+				b.WriteString("::jsonb")
+			})
+		})
+	case dialect.MySQL:
+		p = P().append(func(b *Builder) {
+			b.WriteString("JSON_SET")
+			b.Nested(func(b *Builder) {
+				b.WriteString("COALESCE")
+				b.Nested(func(b *Builder) {
+					doc(b)
+					b.Comma().WriteString("'{}'")
+				})
+				b.Comma().Arg(mysqlJSONPath(path))
+				b.Comma().WriteString("CAST(")
+				b.Arg(v)
+				b.WriteString(" AS JSON)")
+			})
+		})
+	default:
+		u.AddError(fmt.Errorf("dialect/sql: JSON path update is not supported by %q dialect", u.dialect))
+		return u
+	}
+	if idx != -1 {
+		u.values[idx] = p
+		return u
+	}
+	u.columns = append(u.columns, column)
+	u.values = append(u.values, p)
+	return u
+}
+
+// mysqlJSONPath returns the MySQL representation of the given JSON path.
+func mysqlJSONPath(path []string) string {
+	var b strings.Builder
+	b.WriteString("$")
+	for _, p := range path {
+		b.WriteString(".")
+		b.WriteString(strconv.Quote(p))
+	}
+	return b.String()
+}
+
 // SetNull sets a column as null value.
 func (u *UpdateBuilder) SetNull(column string) *UpdateBuilder {
 	u.nulls = append(u.nulls, column)
@@ -724,6 +1219,38 @@ func (u *UpdateBuilder) Where(p *Predicate) *UpdateBuilder {
 	return u
 }
 
+// FromSelect makes it possible to update the rows that match the predicate of a selector.
+func (u *UpdateBuilder) FromSelect(s *Selector) *UpdateBuilder {
+	u.Where(s.where)
+	if table, _ := s.from.(*SelectTable); table != nil {
+		u.table = table.name
+	}
+	return u
+}
+
+// Returning adds the `RETURNING` clause to the update statement. It is supported
+// by PostgreSQL and SQLite (3.35 or above), and fails in MySQL.
+func (u *UpdateBuilder) Returning(columns ...string) *UpdateBuilder {
+	if u.Dialect() == dialect.MySQL {
+		u.AddError(fmt.Errorf("dialect/sql: UPDATE with RETURNING is not supported by %q dialect", u.dialect))
+		return u
+	}
+	u.returning = columns
+	return u
+}
+
+// Limit limits the number of rows that are updated by the `UPDATE` statement.
+// It is supported only by MySQL. In other dialects, the rows can be limited
+// using a subquery in the WHERE clause.
+func (u *UpdateBuilder) Limit(n int) *UpdateBuilder {
+	if u.postgres() || u.Dialect() == dialect.SQLite {
+		u.AddError(fmt.Errorf("dialect/sql: UPDATE with LIMIT is not supported by %q dialect", u.dialect))
+		return u
+	}
+	u.limit = &n
+	return u
+}
+
 // Empty reports whether this builder does not contain update changes.
 func (u *UpdateBuilder) Empty() bool {
 	return len(u.columns) == 0 && len(u.nulls) == 0
@@ -758,6 +1285,14 @@ func (u *UpdateBuilder) Query() (string, []interface{}) {
 		u.WriteString(" WHERE ")
 		u.Join(u.where)
 	}
+	if u.limit != nil {
+		u.WriteString(" LIMIT ")
+		u.Arg(*u.limit)
+	}
+	if len(u.returning) > 0 {
+		u.WriteString(" RETURNING ")
+		u.IdentComma(u.returning...)
+	}
 	return u.String(), u.args
 }
 
@@ -766,6 +1301,7 @@ type DeleteBuilder struct {
 	Builder
 	table string
 	where *Predicate
+	limit *int
 }
 
 // Delete creates a builder for the `DELETE` statement.
@@ -803,6 +1339,18 @@ func (d *DeleteBuilder) FromSelect(s *Selector) *DeleteBuilder {
 	return d
 }
 
+// Limit limits the number of rows that are deleted by the `DELETE` statement.
+// It is supported only by MySQL. In other dialects, the rows can be limited
+// using a subquery in the WHERE clause.
+func (d *DeleteBuilder) Limit(n int) *DeleteBuilder {
+	if d.postgres() || d.Dialect() == dialect.SQLite {
+		d.AddError(fmt.Errorf("dialect/sql: DELETE with LIMIT is not supported by %q dialect", d.dialect))
+		return d
+	}
+	d.limit = &n
+	return d
+}
+
 // Query returns query representation of a `DELETE` statement.
 func (d *DeleteBuilder) Query() (string, []interface{}) {
 	d.WriteString("DELETE FROM ")
@@ -811,6 +1359,10 @@ func (d *DeleteBuilder) Query() (string, []interface{}) {
 		d.WriteString(" WHERE ")
 		d.Join(d.where)
 	}
+	if d.limit != nil {
+		d.WriteString(" LIMIT ")
+		d.Arg(*d.limit)
+	}
 	return d.String(), d.args
 }
 
@@ -928,6 +1480,33 @@ func (p *Predicate) EQ(col string, arg interface{}) *Predicate {
 	})
 }
 
+// NullSafeEQ returns a NULL-safe equality predicate. See Predicate.NullSafeEQ for details.
+func NullSafeEQ(col string, value interface{}) *Predicate {
+	return (&Predicate{}).NullSafeEQ(col, value)
+}
+
+// NullSafeEQ appends a NULL-safe equality predicate, that treats NULL values as equal to each other.
+// A nil argument generates the `IS NULL` predicate, and other arguments are compared using the
+// NULL-safe operator of the dialect: `IS NOT DISTINCT FROM` in PostgreSQL, `<=>` in MySQL and
+// `IS` in SQLite.
+func (p *Predicate) NullSafeEQ(col string, arg interface{}) *Predicate {
+	if arg == nil {
+		return p.IsNull(col)
+	}
+	return p.append(func(b *Builder) {
+		b.Ident(col)
+		switch b.dialect {
+		case dialect.Postgres:
+			b.WriteString(" IS NOT DISTINCT FROM ")
+		case dialect.MySQL:
+			b.WriteString(" <=> ")
+		default:
+			b.WriteString(" IS ")
+		}
+		b.Arg(arg)
+	})
+}
+
 // NEQ returns a "<>" predicate.
 func NEQ(col string, value interface{}) *Predicate {
 	return (&Predicate{}).NEQ(col, value)
@@ -993,6 +1572,80 @@ func (p *Predicate) GTE(col string, arg interface{}) *Predicate {
 	})
 }
 
+// ColumnsEQ returns a "=" predicate between two columns.
+//
+//	ColumnsEQ(t1.C("id"), t2.C("owner_id"))
+//
+func ColumnsEQ(col1, col2 string) *Predicate {
+	return (&Predicate{}).ColumnsEQ(col1, col2)
+}
+
+// ColumnsEQ appends a "=" predicate between two columns.
+func (p *Predicate) ColumnsEQ(col1, col2 string) *Predicate {
+	return p.columnsOp(col1, col2, "=")
+}
+
+// ColumnsNEQ returns a "<>" predicate between two columns.
+func ColumnsNEQ(col1, col2 string) *Predicate {
+	return (&Predicate{}).ColumnsNEQ(col1, col2)
+}
+
+// ColumnsNEQ appends a "<>" predicate between two columns.
+func (p *Predicate) ColumnsNEQ(col1, col2 string) *Predicate {
+	return p.columnsOp(col1, col2, "<>")
+}
+
+// ColumnsLT returns a "<" predicate between two columns.
+//
+//	ColumnsLT(t1.C("expires_at"), t1.C("created_at"))
+//
+func ColumnsLT(col1, col2 string) *Predicate {
+	return (&Predicate{}).ColumnsLT(col1, col2)
+}
+
+// ColumnsLT appends a "<" predicate between two columns.
+func (p *Predicate) ColumnsLT(col1, col2 string) *Predicate {
+	return p.columnsOp(col1, col2, "<")
+}
+
+// ColumnsLTE returns a "<=" predicate between two columns.
+func ColumnsLTE(col1, col2 string) *Predicate {
+	return (&Predicate{}).ColumnsLTE(col1, col2)
+}
+
+// ColumnsLTE appends a "<=" predicate between two columns.
+func (p *Predicate) ColumnsLTE(col1, col2 string) *Predicate {
+	return p.columnsOp(col1, col2, "<=")
+}
+
+// ColumnsGT returns a ">" predicate between two columns.
+func ColumnsGT(col1, col2 string) *Predicate {
+	return (&Predicate{}).ColumnsGT(col1, col2)
+}
+
+// ColumnsGT appends a ">" predicate between two columns.
+func (p *Predicate) ColumnsGT(col1, col2 string) *Predicate {
+	return p.columnsOp(col1, col2, ">")
+}
+
+// ColumnsGTE returns a ">=" predicate between two columns.
+func ColumnsGTE(col1, col2 string) *Predicate {
+	return (&Predicate{}).ColumnsGTE(col1, col2)
+}
+
+// ColumnsGTE appends a ">=" predicate between two columns.
+func (p *Predicate) ColumnsGTE(col1, col2 string) *Predicate {
+	return p.columnsOp(col1, col2, ">=")
+}
+
+// columnsOp appends a binary predicate between two columns.
+func (p *Predicate) columnsOp(col1, col2, op string) *Predicate {
+	return p.append(func(b *Builder) {
+		b.Ident(col1).WriteString(" " + op + " ")
+		b.Ident(col2)
+	})
+}
+
 // NotNull returns the `IS NOT NULL` predicate.
 func NotNull(col string) *Predicate {
 	return (&Predicate{}).NotNull(col)
@@ -1017,6 +1670,39 @@ func (p *Predicate) IsNull(col string) *Predicate {
 	})
 }
 
+// Exists returns the `EXISTS` predicate.
+//
+//	Exists(Select(t2.C("id")).From(t2).Where(ColumnsEQ(t1.C("owner_id"), t2.C("id"))))
+//
+func Exists(query Querier) *Predicate {
+	return (&Predicate{}).Exists(query)
+}
+
+// Exists appends the `EXISTS` predicate.
+func (p *Predicate) Exists(query Querier) *Predicate {
+	return p.append(func(b *Builder) {
+		b.WriteString("EXISTS ")
+		b.Nested(func(b *Builder) {
+			b.Join(query)
+		})
+	})
+}
+
+// NotExists returns the `NOT EXISTS` predicate.
+func NotExists(query Querier) *Predicate {
+	return (&Predicate{}).NotExists(query)
+}
+
+// NotExists appends the `NOT EXISTS` predicate.
+func (p *Predicate) NotExists(query Querier) *Predicate {
+	return p.append(func(b *Builder) {
+		b.WriteString("NOT EXISTS ")
+		b.Nested(func(b *Builder) {
+			b.Join(query)
+		})
+	})
+}
+
 // In returns the `IN` predicate.
 func In(col string, args ...interface{}) *Predicate {
 	return (&Predicate{}).In(col, args...)
@@ -1095,6 +1781,20 @@ func (p *Predicate) Like(col, pattern string) *Predicate {
 	})
 }
 
+// NotLike returns the `NOT LIKE` predicate.
+func NotLike(col, pattern string) *Predicate {
+	return (&Predicate{}).NotLike(col, pattern)
+}
+
+// NotLike appends the `NOT LIKE` predicate. Note that, like the `LIKE`
+// predicate, it does not match rows where the column is NULL.
+func (p *Predicate) NotLike(col, pattern string) *Predicate {
+	return p.append(func(b *Builder) {
+		b.Ident(col).WriteString(" NOT LIKE ")
+		b.Arg(pattern)
+	})
+}
+
 // HasPrefix is a helper predicate that checks prefix using the LIKE predicate.
 func HasPrefix(col, prefix string) *Predicate {
 	return (&Predicate{}).HasPrefix(col, prefix)
@@ -1105,6 +1805,14 @@ func (p *Predicate) HasPrefix(col, prefix string) *Predicate {
 	return p.Like(col, prefix+"%")
 }
 
+// NotHasPrefix is a helper predicate that checks prefix using the NOT LIKE predicate.
+func NotHasPrefix(col, prefix string) *Predicate { return (&Predicate{}).NotHasPrefix(col, prefix) }
+
+// NotHasPrefix is a helper predicate that checks prefix using the NOT LIKE predicate.
+func (p *Predicate) NotHasPrefix(col, prefix string) *Predicate {
+	return p.NotLike(col, prefix+"%")
+}
+
 // HasSuffix is a helper predicate that checks suffix using the LIKE predicate.
 func HasSuffix(col, suffix string) *Predicate { return (&Predicate{}).HasSuffix(col, suffix) }
 
@@ -1113,10 +1821,20 @@ func (p *Predicate) HasSuffix(col, suffix string) *Predicate {
 	return p.Like(col, "%"+suffix)
 }
 
+// NotHasSuffix is a helper predicate that checks suffix using the NOT LIKE predicate.
+func NotHasSuffix(col, suffix string) *Predicate { return (&Predicate{}).NotHasSuffix(col, suffix) }
+
+// NotHasSuffix is a helper predicate that checks suffix using the NOT LIKE predicate.
+func (p *Predicate) NotHasSuffix(col, suffix string) *Predicate {
+	return p.NotLike(col, "%"+suffix)
+}
+
 // EqualFold is a helper predicate that applies the "=" predicate with case-folding.
 func EqualFold(col, sub string) *Predicate { return (&Predicate{}).EqualFold(col, sub) }
 
 // EqualFold is a helper predicate that applies the "=" predicate with case-folding.
+// In all dialects, it is translated to `LOWER(col) = lower(v)`, and therefore, it can
+// use an expression index on `LOWER(col)` (if it was defined by the user).
 func (p *Predicate) EqualFold(col, sub string) *Predicate {
 	return p.append(func(b *Builder) {
 		f := &Func{}
@@ -1134,18 +1852,55 @@ func (p *Predicate) Contains(col, sub string) *Predicate {
 	return p.Like(col, "%"+sub+"%")
 }
 
-// ContainsFold is a helper predicate that checks substring using the LIKE predicate.
+// NotContains is a helper predicate that checks substring using the NOT LIKE predicate.
+func NotContains(col, sub string) *Predicate { return (&Predicate{}).NotContains(col, sub) }
+
+// NotContains is a helper predicate that checks substring using the NOT LIKE predicate.
+func (p *Predicate) NotContains(col, sub string) *Predicate {
+	return p.NotLike(col, "%"+sub+"%")
+}
+
+// ContainsFold is a helper predicate that checks substring using the LIKE predicate with case-folding.
 func ContainsFold(col, sub string) *Predicate { return (&Predicate{}).ContainsFold(col, sub) }
 
-// ContainsFold is a helper predicate that applies the LIKE predicate with case-folding.
-// The recommendation is to avoid using it, and to use a dialect specific feature, like
-// `ILIKE` in PostgreSQL, and `COLLATE` clause in MySQL.
+// ContainsFold is a helper predicate that checks substring using the LIKE predicate with case-folding.
+// See likeFold for the statement that is generated per dialect.
 func (p *Predicate) ContainsFold(col, sub string) *Predicate {
+	return p.likeFold(col, "%"+sub+"%")
+}
+
+// HasPrefixFold is a helper predicate that checks prefix using the LIKE predicate with case-folding.
+func HasPrefixFold(col, prefix string) *Predicate { return (&Predicate{}).HasPrefixFold(col, prefix) }
+
+// HasPrefixFold is a helper predicate that checks prefix using the LIKE predicate with case-folding.
+// See likeFold for the statement that is generated per dialect.
+func (p *Predicate) HasPrefixFold(col, prefix string) *Predicate {
+	return p.likeFold(col, prefix+"%")
+}
+
+// HasSuffixFold is a helper predicate that checks suffix using the LIKE predicate with case-folding.
+func HasSuffixFold(col, suffix string) *Predicate { return (&Predicate{}).HasSuffixFold(col, suffix) }
+
+// HasSuffixFold is a helper predicate that checks suffix using the LIKE predicate with case-folding.
+// See likeFold for the statement that is generated per dialect.
+func (p *Predicate) HasSuffixFold(col, suffix string) *Predicate {
+	return p.likeFold(col, "%"+suffix)
+}
+
+// likeFold appends a case-insensitive LIKE predicate: `ILIKE` in PostgreSQL (that can use
+// trigram indexes), and `LOWER(col) LIKE` in MySQL and SQLite. Note that a collation is not
+// used in MySQL, because it depends on the character set of the column.
+func (p *Predicate) likeFold(col, pattern string) *Predicate {
 	return p.append(func(b *Builder) {
-		f := &Func{}
-		f.SetDialect(b.dialect)
-		b.Ident(f.Lower(col)).WriteString(" LIKE ")
-		b.Arg("%" + strings.ToLower(sub) + "%")
+		switch b.dialect {
+		case dialect.Postgres:
+			b.Ident(col).WriteString(" ILIKE ")
+		default:
+			f := &Func{}
+			f.SetDialect(b.dialect)
+			b.Ident(f.Lower(col)).WriteString(" LIKE ")
+		}
+		b.Arg(strings.ToLower(pattern))
 	})
 }
 
@@ -1286,6 +2041,128 @@ func As(ident string, as string) string {
 	return b.String()
 }
 
+// TimeBucket returns a column that truncates the given time column to the start of the
+// given bucket: minute, hour, day, week (starting on Monday), month or year. The column can
+// be used in the SELECT, GROUP BY and ORDER BY clauses of a Selector, and it is translated
+// to the expression of the selector dialect (date_trunc in PostgreSQL, DATE_FORMAT in MySQL
+// and strftime in SQLite). In the SELECT clause, the column is named "<column>_<bucket>".
+//
+//	Select(TimeBucket("day", "created_at"), Count("*")).
+//		From(Table("cards")).
+//		GroupBy(TimeBucket("day", "created_at"))
+//
+// An unsupported bucket fails the query with an error.
+func TimeBucket(bucket, column string) string {
+	return fmt.Sprintf("time_bucket(%s, %s)", bucket, column)
+}
+
+// timeBuckets holds the supported buckets of TimeBucket and their MySQL and SQLite
+// formats. PostgreSQL uses the bucket name as the date_trunc field.
+var timeBuckets = map[string]struct{ mysql, sqlite string }{
+	"minute": {"%Y-%m-%d %H:%i:00", "%Y-%m-%d %H:%M:00"},
+	"hour":   {"%Y-%m-%d %H:00:00", "%Y-%m-%d %H:00:00"},
+	"day":    {"%Y-%m-%d 00:00:00", "%Y-%m-%d 00:00:00"},
+	"week":   {"%Y-%m-%d 00:00:00", "%Y-%m-%d 00:00:00"},
+	"month":  {"%Y-%m-01 00:00:00", "%Y-%m-01 00:00:00"},
+	"year":   {"%Y-01-01 00:00:00", "%Y-01-01 00:00:00"},
+}
+
+var timeBucketRe = regexp.MustCompile(`^time_bucket\(([^,()']*), (\w+)\)$`)
+
+// timeBucket writes the dialect expression of the given column if it was created
+// by TimeBucket, and reports whether it was.
+func (b *Builder) timeBucket(c string, alias bool) bool {
+	m := timeBucketRe.FindStringSubmatch(c)
+	if m == nil {
+		return false
+	}
+	bucket, column := m[1], m[2]
+	f, ok := timeBuckets[bucket]
+	if !ok {
+		// Reported by Selector.checkTimeBuckets.
+		b.WriteString(c)
+		return true
+	}
+	ident := b.Quote(column)
+	switch b.Dialect() {
+	case dialect.Postgres:
+		b.WriteString("date_trunc('" + bucket + "', " + ident + ")")
+	case dialect.SQLite:
+		b.WriteString("strftime('" + f.sqlite + "', " + ident)
+		if bucket == "week" {
+			b.WriteString(", 'weekday 0', '-6 days'")
+		}
+		b.WriteString(")")
+	default:
+		if bucket == "week" {
+			ident = "DATE_SUB(" + ident + ", INTERVAL WEEKDAY(" + ident + ") DAY)"
+		}
+		b.WriteString("CAST(DATE_FORMAT(" + ident + ", '" + f.mysql + "') AS DATETIME)")
+	}
+	if alias {
+		b.WriteString(" AS ")
+		b.Ident(column + "_" + bucket)
+	}
+	return true
+}
+
+// bucketComma calls IdentComma on the given columns, and expands the
+// columns that were created by TimeBucket (see Builder.timeBucket).
+func (b *Builder) bucketComma(alias bool, columns ...string) *Builder {
+	for i, c := range columns {
+		if i > 0 {
+			b.Comma()
+		}
+		if !b.timeBucket(c, alias) {
+			b.Ident(c)
+		}
+	}
+	return b
+}
+
+// SelectExpr is an expression column of the `SELECT` statement
+// that is selected with an alias (see Selector.AppendSelectExpr).
+type SelectExpr struct {
+	Builder
+	expr string
+	args []interface{}
+	as   string
+}
+
+// ExprAs returns an expression column that is selected with the given alias (expr AS `as`).
+// The expression is placed as-is in the query, and its `?` placeholders are bound to the
+// given arguments (and are converted to $n in PostgreSQL).
+//
+//	ExprAs("julianday(`expires_at`) - julianday(?)", "days_left", time.Now())
+//
+func ExprAs(expr, as string, args ...interface{}) *SelectExpr {
+	e := &SelectExpr{expr: expr, as: as, args: args}
+	if n := strings.Count(expr, "?"); n != len(args) {
+		e.AddError(fmt.Errorf("sql: expression %q has %d placeholders and %d arguments", expr, n, len(args)))
+	}
+	return e
+}
+
+// Alias returns the alias of the expression column.
+func (e *SelectExpr) Alias() string {
+	return e.as
+}
+
+// Query returns query representation of the expression column.
+func (e *SelectExpr) Query() (string, []interface{}) {
+	b := e.Builder.clone()
+	for i, part := range strings.Split(e.expr, "?") {
+		if i > 0 && i <= len(e.args) {
+			b.Arg(e.args[i-1])
+		}
+		b.WriteString(part)
+	}
+	b.WriteString(" AS ")
+	b.Ident(e.as)
+	e.total = b.total
+	return b.String(), b.args
+}
+
 // Distinct prefixed the given columns with the `DISTINCT` keyword (DISTINCT `id`).
 func Distinct(idents ...string) string {
 	b := &Builder{}
@@ -1390,19 +2267,21 @@ func (j join) clone() join {
 // Selector is a builder for the `SELECT` statement.
 type Selector struct {
 	Builder
-	as       string
-	columns  []string
-	from     TableView
-	joins    []join
-	where    *Predicate
-	or       bool
-	not      bool
-	order    []string
-	group    []string
-	having   *Predicate
-	limit    *int
-	offset   *int
-	distinct bool
+	as        string
+	columns   []string
+	exprs     []*SelectExpr
+	from      TableView
+	joins     []join
+	where     *Predicate
+	or        bool
+	not       bool
+	order     []string
+	group     []string
+	having    *Predicate
+	limit     *int
+	offset    *int
+	distinct  bool
+	forUpdate bool
 }
 
 // Select returns a new selector for the `SELECT` statement.
@@ -1419,12 +2298,30 @@ func Select(columns ...string) *Selector {
 }
 
 // Select changes the columns selection of the SELECT statement.
-// Empty selection means all columns *.
+// Empty selection means all columns *. The expression columns
+// that were added by AppendSelectExpr are kept.
 func (s *Selector) Select(columns ...string) *Selector {
 	s.columns = columns
 	return s
 }
 
+// AppendSelectExpr appends the given expression columns to the selection of the SELECT
+// statement. They are selected after the columns that were set by Select.
+//
+//	Select(t.C("id")).
+//		AppendSelectExpr(ExprAs("`age` + ?", "next_age", 1)).
+//		From(t)
+//
+func (s *Selector) AppendSelectExpr(exprs ...*SelectExpr) *Selector {
+	s.exprs = append(s.exprs, exprs...)
+	return s
+}
+
+// SelectExprs returns the expression columns of the selection.
+func (s *Selector) SelectExprs() []*SelectExpr {
+	return s.exprs
+}
+
 // From sets the source of `FROM` clause.
 func (s *Selector) From(t TableView) *Selector {
 	s.from = t
@@ -1440,6 +2337,13 @@ func (s *Selector) Distinct() *Selector {
 	return s
 }
 
+// ForUpdate appends the `FOR UPDATE` clause to the `SELECT` statement, for locking the selected
+// rows until the end of the transaction. SQLite does not support row locks, and the clause is omitted.
+func (s *Selector) ForUpdate() *Selector {
+	s.forUpdate = true
+	return s
+}
+
 // SetDistinct sets explicitly if the returned rows are distinct or indistinct.
 func (s *Selector) SetDistinct(v bool) *Selector {
 	s.distinct = v
@@ -1563,6 +2467,22 @@ func (s *Selector) On(c1, c2 string) *Selector {
 	return s
 }
 
+// AndOn appends a column equality to the `ON` clause of the last `JOIN` operation.
+// It is used for joining tables on composite keys. For example:
+//
+//	Join(t2).On(t1.C("id"), t2.C("order_id")).AndOn(t1.C("tenant_id"), t2.C("tenant_id"))
+//
+func (s *Selector) AndOn(c1, c2 string) *Selector {
+	if len(s.joins) == 0 {
+		return s
+	}
+	if j := &s.joins[len(s.joins)-1]; j.on != "" {
+		j.on = fmt.Sprintf("%s AND %s = %s", j.on, c1, c2)
+		return s
+	}
+	return s.On(c1, c2)
+}
+
 // As give this selection an alias.
 func (s *Selector) As(alias string) *Selector {
 	s.as = alias
@@ -1578,6 +2498,7 @@ func (s *Selector) Count(columns ...string) *Selector {
 		column = b.String()
 	}
 	s.columns = []string{Count(column)}
+	s.exprs = nil
 	return s
 }
 
@@ -1592,21 +2513,73 @@ func (s *Selector) Clone() *Selector {
 		joins[i] = s.joins[i].clone()
 	}
 	return &Selector{
-		Builder:  s.Builder.clone(),
-		as:       s.as,
-		or:       s.or,
-		not:      s.not,
-		from:     s.from,
-		limit:    s.limit,
-		offset:   s.offset,
-		distinct: s.distinct,
-		where:    s.where.clone(),
-		having:   s.having.clone(),
-		joins:    append([]join{}, joins...),
-		group:    append([]string{}, s.group...),
-		order:    append([]string{}, s.order...),
-		columns:  append([]string{}, s.columns...),
+		Builder:   s.Builder.clone(),
+		as:        s.as,
+		or:        s.or,
+		not:       s.not,
+		from:      s.from,
+		limit:     s.limit,
+		offset:    s.offset,
+		distinct:  s.distinct,
+		forUpdate: s.forUpdate,
+		where:     s.where.clone(),
+		having:    s.having.clone(),
+		joins:     append([]join{}, joins...),
+		group:     append([]string{}, s.group...),
+		order:     append([]string{}, s.order...),
+		columns:   append([]string{}, s.columns...),
+		exprs:     append([]*SelectExpr{}, s.exprs...),
+	}
+}
+
+// WindowBuilder is a builder for window functions.
+type WindowBuilder struct {
+	fn        string
+	partition []string
+	order     []string
+}
+
+// RowNumber returns a builder for the `ROW_NUMBER` window function,
+// that numbers the rows of each partition in the window order.
+//
+//	t := Table("cards")
+//	w := RowNumber().PartitionBy(t.C("owner_id")).OrderBy(Desc(t.C("expired_at")))
+//	Select(t.C("id"), As(w.String(), "rn")).From(t)
+//
+func RowNumber() *WindowBuilder {
+	return &WindowBuilder{fn: "ROW_NUMBER"}
+}
+
+// PartitionBy appends the columns of the `PARTITION BY` clause of the window.
+func (w *WindowBuilder) PartitionBy(columns ...string) *WindowBuilder {
+	w.partition = append(w.partition, columns...)
+	return w
+}
+
+// OrderBy appends the columns of the `ORDER BY` clause of the window.
+func (w *WindowBuilder) OrderBy(columns ...string) *WindowBuilder {
+	w.order = append(w.order, columns...)
+	return w
+}
+
+// String returns the window function expression.
+func (w *WindowBuilder) String() string {
+	b := &Builder{}
+	b.WriteString(w.fn)
+	b.WriteString("() OVER (")
+	if len(w.partition) > 0 {
+		b.WriteString("PARTITION BY ")
+		b.IdentComma(w.partition...)
+	}
+	if len(w.order) > 0 {
+		if len(w.partition) > 0 {
+			b.Pad()
+		}
+		b.WriteString("ORDER BY ")
+		b.IdentComma(w.order...)
 	}
+	b.WriteByte(')')
+	return b.String()
 }
 
 // Asc adds the ASC suffix for the given column.
@@ -1629,30 +2602,95 @@ func (s *Selector) OrderBy(columns ...string) *Selector {
 	return s
 }
 
+// OrderColumns returns the columns (and their directions) of the `ORDER BY` clause.
+func (s *Selector) OrderColumns() []string {
+	return append([]string{}, s.order...)
+}
+
 // GroupBy appends the `GROUP BY` clause to the `SELECT` statement.
 func (s *Selector) GroupBy(columns ...string) *Selector {
 	s.group = append(s.group, columns...)
 	return s
 }
 
-// Having appends a predicate for the `HAVING` clause.
+// Having appends a predicate for the `HAVING` clause. The predicate can reference
+// aliased columns (e.g. aggregations) by their names, and these references are
+// replaced with the column expressions, because PostgreSQL does not support
+// output-column names in the `HAVING` clause. References to columns that are not
+// grouped or aggregated are reported by Err after calling Query.
+//
+//	Select("name", As(Count("*"), "count")).
+//		From(Table("users")).
+//		GroupBy("name").
+//		Having(GT("count", 10))
+//
 func (s *Selector) Having(p *Predicate) *Selector {
 	s.having = p
 	return s
 }
 
+// aggregateFunc matches calls to aggregate functions.
+var aggregateFunc = regexp.MustCompile(`(?i)\b(COUNT|SUM|AVG|MIN|MAX)\s*\([^()]*\)`)
+
+// checkHaving checks that the given `HAVING` clause references only grouped
+// columns, aliased columns or aggregate functions.
+func (s *Selector) checkHaving(having string) error {
+	allowed := make(map[string]bool, len(s.group)+len(s.columns))
+	for _, c := range s.group {
+		allowed[unqualify(c)] = true
+	}
+	for _, c := range s.columns {
+		if _, alias := splitAlias(c); alias != "" {
+			allowed[alias] = true
+		}
+	}
+	having = aggregateFunc.ReplaceAllString(having, "")
+	for _, m := range quotedIdent.FindAllString(having, -1) {
+		if ident := unqualify(m); !allowed[ident] {
+			return fmt.Errorf("sql: HAVING references %q that is neither a grouped column nor an aggregation", ident)
+		}
+	}
+	return nil
+}
+
+// checkTimeBuckets checks that the TimeBucket columns of the
+// selector use only supported buckets.
+func (s *Selector) checkTimeBuckets() error {
+	for _, cs := range [][]string{s.columns, s.group, s.order} {
+		for _, c := range cs {
+			m := timeBucketRe.FindStringSubmatch(c)
+			if m == nil {
+				continue
+			}
+			if _, ok := timeBuckets[m[1]]; !ok {
+				return fmt.Errorf("sql: unsupported time bucket %q for column %q", m[1], m[2])
+			}
+		}
+	}
+	return nil
+}
+
 // Query returns query representation of a `SELECT` statement.
 func (s *Selector) Query() (string, []interface{}) {
+	if err := s.checkTimeBuckets(); err != nil {
+		s.AddError(err)
+	}
 	b := s.Builder.clone()
 	b.WriteString("SELECT ")
 	if s.distinct {
 		b.WriteString("DISTINCT ")
 	}
 	if len(s.columns) > 0 {
-		b.IdentComma(s.columns...)
+		b.bucketComma(true, s.columns...)
 	} else {
 		b.WriteString("*")
 	}
+	for _, expr := range s.exprs {
+		if err := expr.Err(); err != nil {
+			s.AddError(err)
+		}
+		b.Comma().Join(expr)
+	}
 	b.WriteString(" FROM ")
 	switch t := s.from.(type) {
 	case *SelectTable:
@@ -1691,15 +2729,27 @@ func (s *Selector) Query() (string, []interface{}) {
 	}
 	if len(s.group) > 0 {
 		b.WriteString(" GROUP BY ")
-		b.IdentComma(s.group...)
+		b.bucketComma(false, s.group...)
 	}
 	if s.having != nil {
 		b.WriteString(" HAVING ")
+		start := b.Len()
 		b.Join(s.having)
+		having := b.String()[start:]
+		if err := s.checkHaving(having); err != nil {
+			s.AddError(err)
+		}
+		for _, c := range s.columns {
+			if expr, alias := splitAlias(c); alias != "" {
+				having = strings.Replace(having, b.Quote(alias), b.expr(expr), -1)
+			}
+		}
+		b.Truncate(start)
+		b.WriteString(having)
 	}
 	if len(s.order) > 0 {
 		b.WriteString(" ORDER BY ")
-		b.IdentComma(s.order...)
+		b.bucketComma(false, s.order...)
 	}
 	if s.limit != nil {
 		b.WriteString(" LIMIT ")
@@ -1709,6 +2759,9 @@ func (s *Selector) Query() (string, []interface{}) {
 		b.WriteString(" OFFSET ")
 		b.Arg(*s.offset)
 	}
+	if s.forUpdate && s.Dialect() != dialect.SQLite {
+		b.WriteString(" FOR UPDATE")
+	}
 	s.total = b.total
 	return b.String(), b.args
 }
@@ -1827,6 +2880,29 @@ type Builder struct {
 	dialect      string        // configured dialect.
 	args         []interface{} // query parameters.
 	total        int           // total number of parameters in query tree.
+	errs         []error       // errors that occurred during query construction.
+}
+
+// AddError appends an error to the builder errors.
+func (b *Builder) AddError(err error) *Builder {
+	b.errs = append(b.errs, err)
+	return b
+}
+
+// Err returns a concatenated error of all errors encountered during
+// the query-building, or were added manually by calling AddError.
+func (b Builder) Err() error {
+	if len(b.errs) == 0 {
+		return nil
+	}
+	br := strings.Builder{}
+	for i := range b.errs {
+		if i > 0 {
+			br.WriteString("; ")
+		}
+		br.WriteString(b.errs[i].Error())
+	}
+	return errors.New(br.String())
 }
 
 // Quote quotes the given identifier with the characters based
@@ -2197,6 +3273,35 @@ func (d *DialectBuilder) DropIndex(name string) *DropIndexBuilder {
 	return b
 }
 
+// quotedIdent matches quoted identifiers, including qualified ones (e.g. `t`.`c`).
+var quotedIdent = regexp.MustCompile("(?:(?:`[^`]+`|\"[^\"]+\")\\.)*(?:`[^`]+`|\"[^\"]+\")")
+
+// unqualify returns the unquoted column name of the given identifier.
+func unqualify(ident string) string {
+	if i := strings.LastIndexByte(ident, '.'); i != -1 {
+		ident = ident[i+1:]
+	}
+	return strings.Trim(ident, "`\"")
+}
+
+// splitAlias splits a column with an alias (e.g. "COUNT(*) AS `count`")
+// to its expression and unquoted alias.
+func splitAlias(column string) (string, string) {
+	i := strings.LastIndex(column, " AS ")
+	if i == -1 {
+		return column, ""
+	}
+	return column[:i], strings.Trim(column[i+4:], "`\"")
+}
+
+// expr returns the given column expression formatted for the builder dialect.
+func (b *Builder) expr(s string) string {
+	if b.postgres() {
+		return strings.Replace(s, "`", `"`, -1)
+	}
+	return s
+}
+
 func isFunc(s string) bool {
 	return strings.Contains(s, "(") && strings.Contains(s, ")")
 }
@@ -111,6 +111,15 @@ func TestBuilder(t *testing.T) {
 				),
 			wantQuery: `ALTER TABLE "users" ADD COLUMN "group_id" int UNIQUE, ADD CONSTRAINT "constraint" FOREIGN KEY("group_id") REFERENCES "groups"("id") ON DELETE CASCADE`,
 		},
+		{
+			input: Dialect(dialect.Postgres).AlterTable("cards").
+				AddForeignKey(ForeignKey("cards_users_owner").Columns("owner_id").
+					Reference(Reference().Table("users").Columns("id")).
+					OnDelete("SET NULL").
+					Deferrable("INITIALLY DEFERRED"),
+				),
+			wantQuery: `ALTER TABLE "cards" ADD CONSTRAINT "cards_users_owner" FOREIGN KEY("owner_id") REFERENCES "users"("id") ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED`,
+		},
 		{
 			input: AlterTable("users").
 				AddColumn(Column("group_id").Type("int").Attr("UNIQUE")).
@@ -261,6 +270,105 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `INSERT INTO "users" ("name", "age") VALUES ($1, $2), ($3, $4), ($5, $6)`,
 			wantArgs:  []interface{}{"a8m", 10, "foo", 20, "bar", 30},
 		},
+		{
+			input:     Insert("users").Columns("name", "age").Values("a8m", 10).OnConflict(DoNothing()),
+			wantQuery: "INSERT IGNORE INTO `users` (`name`, `age`) VALUES (?, ?)",
+			wantArgs:  []interface{}{"a8m", 10},
+		},
+		{
+			input:     Dialect(dialect.SQLite).Insert("users").Columns("name", "age").Values("a8m", 10).OnConflict(DoNothing()),
+			wantQuery: "INSERT INTO `users` (`name`, `age`) VALUES (?, ?) ON CONFLICT DO NOTHING",
+			wantArgs:  []interface{}{"a8m", 10},
+		},
+		{
+			input: Dialect(dialect.Postgres).Insert("users").
+				Columns("name", "age").
+				Values("a8m", 10).
+				OnConflict(ConflictColumns("name"), DoNothing()).
+				Returning("id"),
+			wantQuery: `INSERT INTO "users" ("name", "age") VALUES ($1, $2) ON CONFLICT ("name") DO NOTHING RETURNING "id"`,
+			wantArgs:  []interface{}{"a8m", 10},
+		},
+		{
+			input: Insert("users").
+				Columns("name", "age").
+				Values("a8m", 10).
+				OnConflict(ResolveWithNewValues()),
+			wantQuery: "INSERT INTO `users` (`name`, `age`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`), `age` = VALUES(`age`)",
+			wantArgs:  []interface{}{"a8m", 10},
+		},
+		{
+			input: Insert("users").
+				Columns("name", "age").
+				Values("a8m", 10).
+				OnConflict(ConflictColumns("name"), ResolveWithNewValues(), UpdateLastInsertID("id")),
+			wantQuery: "INSERT INTO `users` (`name`, `age`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `age` = VALUES(`age`), `id` = LAST_INSERT_ID(`id`)",
+			wantArgs:  []interface{}{"a8m", 10},
+		},
+		{
+			input: Dialect(dialect.SQLite).Insert("users").
+				Columns("id", "name", "age").
+				Values(1, "a8m", 10).
+				OnConflict(ConflictColumns("name"), ResolveWithNewValues(), UpdateIgnore("id")),
+			wantQuery: "INSERT INTO `users` (`id`, `name`, `age`) VALUES (?, ?, ?) ON CONFLICT (`name`) DO UPDATE SET `age` = excluded.`age`",
+			wantArgs:  []interface{}{1, "a8m", 10},
+		},
+		{
+			input: Dialect(dialect.Postgres).Insert("users").
+				Columns("name", "age").
+				Values("a8m", 10).
+				OnConflict(ConflictColumns("name"), ResolveWithNewValues()).
+				Returning("id", "name", "age"),
+			wantQuery: `INSERT INTO "users" ("name", "age") VALUES ($1, $2) ON CONFLICT ("name") DO UPDATE SET "age" = excluded."age" RETURNING "id", "name", "age"`,
+			wantArgs:  []interface{}{"a8m", 10},
+		},
+		{
+			input: Dialect(dialect.Postgres).Insert("cards").
+				Columns("number_hash", "name").
+				Values("h", "a8m").
+				OnConflict(ConflictConstraint("uq_number_hash"), DoNothing()),
+			wantQuery: `INSERT INTO "cards" ("number_hash", "name") VALUES ($1, $2) ON CONFLICT ON CONSTRAINT "uq_number_hash" DO NOTHING`,
+			wantArgs:  []interface{}{"h", "a8m"},
+		},
+		{
+			input: Dialect(dialect.Postgres).Insert("cards").
+				Columns("number_hash", "name").
+				Values("h", "a8m").
+				OnConflict(ConflictColumnsWhere([]string{"number_hash"}, And(IsNull("deleted_at"), EQ("active", true))), ResolveWithNewValues()).
+				Returning("id"),
+			wantQuery: `INSERT INTO "cards" ("number_hash", "name") VALUES ($1, $2) ON CONFLICT ("number_hash") WHERE ("deleted_at" IS NULL) AND ("active" = $3) DO UPDATE SET "name" = excluded."name" RETURNING "id"`,
+			wantArgs:  []interface{}{"h", "a8m", true},
+		},
+		{
+			input: Dialect(dialect.SQLite).Insert("cards").
+				Columns("number_hash", "name").
+				Values("h", "a8m").
+				OnConflict(ConflictColumnsWhere([]string{"number_hash"}, IsNull("deleted_at")), DoNothing()),
+			wantQuery: "INSERT INTO `cards` (`number_hash`, `name`) VALUES (?, ?) ON CONFLICT (`number_hash`) WHERE `deleted_at` IS NULL DO NOTHING",
+			wantArgs:  []interface{}{"h", "a8m"},
+		},
+		{
+			input: Insert("cards").
+				Columns("number_hash", "name").
+				Values("h", "a8m").
+				OnConflict(ConflictConstraint("uq_number_hash"), DoNothing()),
+			wantQuery: "INSERT IGNORE INTO `cards` (`number_hash`, `name`) VALUES (?, ?)",
+			wantArgs:  []interface{}{"h", "a8m"},
+		},
+		{
+			input: Insert("archived_users").
+				Columns("name", "age").
+				Select(Select("name", "age").From(Table("users")).Where(GT("age", 30))),
+			wantQuery: "INSERT INTO `archived_users` (`name`, `age`) SELECT `name`, `age` FROM `users` WHERE `age` > ?",
+			wantArgs:  []interface{}{30},
+		},
+		{
+			input: Dialect(dialect.Postgres).Insert("archived_users").
+				Columns("name", "age").
+				Select(Select("name", "age").From(Table("users")).Where(And(GT("age", 30), EQ("name", "a8m")))),
+			wantQuery: `INSERT INTO "archived_users" ("name", "age") SELECT "name", "age" FROM "users" WHERE ("age" > $1) AND ("name" = $2)`,
+			wantArgs:  []interface{}{30, "a8m"},
+		},
 		{
 			input:     Update("users").Set("name", "foo"),
 			wantQuery: "UPDATE `users` SET `name` = ?",
@@ -381,6 +489,14 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `UPDATE "users" SET "name" = $1 WHERE "nickname" LIKE $2 AND "lastname" LIKE $3`,
 			wantArgs:  []interface{}{"foo", "a8m%", "%mash%"},
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				Update("users").
+				Set("name", "foo").
+				Where(NotHasPrefix("nickname", "a8m").And().NotHasSuffix("nickname", "x").And().NotContains("lastname", "mash")),
+			wantQuery: `UPDATE "users" SET "name" = $1 WHERE "nickname" NOT LIKE $2 AND "nickname" NOT LIKE $3 AND "lastname" NOT LIKE $4`,
+			wantArgs:  []interface{}{"foo", "a8m%", "%x", "%mash%"},
+		},
 		{
 			input: Update("users").
 				Add("age", 1).
@@ -396,6 +512,57 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `UPDATE "users" SET "age" = COALESCE("age", $1) + $2 WHERE "nickname" LIKE $3`,
 			wantArgs:  []interface{}{0, 1, "a8m%"},
 		},
+		{
+			input: Dialect(dialect.MySQL).
+				Update("users").
+				MergeJSON("meta", `{"a":1}`).
+				Where(EQ("id", 1)),
+			wantQuery: "UPDATE `users` SET `meta` = JSON_MERGE_PATCH(COALESCE(`meta`, '{}'), ?) WHERE `id` = ?",
+			wantArgs:  []interface{}{`{"a":1}`, 1},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Update("users").
+				Set("name", "a8m").
+				MergeJSON("meta", `{"a":1}`).
+				Where(EQ("id", 1)),
+			wantQuery: `UPDATE "users" SET "name" = $1, "meta" = COALESCE("meta", '{}'::jsonb) || $2::jsonb WHERE "id" = $3`,
+			wantArgs:  []interface{}{"a8m", `{"a":1}`, 1},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Update("users").
+				SetJSONPath("meta", []string{"a", "b"}, "1").
+				Where(EQ("id", 1)),
+			wantQuery: `UPDATE "users" SET "meta" = jsonb_set(COALESCE("meta", '{}'::jsonb), ARRAY[$1, $2]::text[], $3::jsonb) WHERE "id" = $4`,
+			wantArgs:  []interface{}{"a", "b", "1", 1},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Update("users").
+				Set("name", "a8m").
+				SetJSONPath("meta", []string{"a"}, "1").
+				SetJSONPath("meta", []string{"b"}, `"x"`),
+			wantQuery: `UPDATE "users" SET "name" = $1, "meta" = jsonb_set(COALESCE(jsonb_set(COALESCE("meta", '{}'::jsonb), ARRAY[$2]::text[], $3::jsonb), '{}'::jsonb), ARRAY[$4]::text[], $5::jsonb)`,
+			wantArgs:  []interface{}{"a8m", "a", "1", "b", `"x"`},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Update("users").
+				SetJSONPath("meta", []string{"a", "b c"}, "true").
+				Where(EQ("id", 1)),
+			wantQuery: "UPDATE `users` SET `meta` = JSON_SET(COALESCE(`meta`, '{}'), ?, CAST(? AS JSON)) WHERE `id` = ?",
+			wantArgs:  []interface{}{`$."a"."b c"`, "true", 1},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Update("users").
+				SetCase("name", "id", []interface{}{1, 2}, []interface{}{"a8m", "nati"}).
+				SetCase("age", "id", []interface{}{1, 2}, []interface{}{30, nil}).
+				Where(InInts("id", 1, 2)),
+			wantQuery: `UPDATE "users" SET "name" = CASE WHEN "id" = $1 THEN $2 WHEN "id" = $3 THEN $4 ELSE "name" END, "age" = CASE WHEN "id" = $5 THEN $6 WHEN "id" = $7 THEN $8 ELSE "age" END WHERE "id" IN ($9, $10)`,
+			wantArgs:  []interface{}{1, "a8m", 2, "nati", 1, 30, 2, nil, 1, 2},
+		},
 		{
 			input: Update("users").
 				Add("age", 1).
@@ -707,6 +874,18 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `SELECT "u"."id", "g"."name" FROM "users" AS "u" JOIN (SELECT * FROM "groups" WHERE "user_id" = $1) AS "g" ON "u"."id" = "g"."user_id"`,
 			wantArgs:  []interface{}{10},
 		},
+		{
+			input: func() Querier {
+				t1 := Table("orders")
+				t2 := Table("line_items")
+				return Select().
+					From(t1).
+					Join(t2).
+					On(t1.C("id"), t2.C("order_id")).
+					AndOn(t1.C("tenant_id"), t2.C("tenant_id"))
+			}(),
+			wantQuery: "SELECT * FROM `orders` JOIN `line_items` AS `t0` ON `orders`.`id` = `t0`.`order_id` AND `orders`.`tenant_id` = `t0`.`tenant_id`",
+		},
 		{
 			input: func() Querier {
 				selector := Select().Where(EQ("name", "foo").Or().EQ("name", "bar"))
@@ -813,9 +992,65 @@ func TestBuilder(t *testing.T) {
 				Select().
 				From(Table("users")).
 				Where(ContainsFold("name", "Ariel").And().ContainsFold("nick", "Bar")),
-			wantQuery: `SELECT * FROM "users" WHERE LOWER("name") LIKE $1 AND LOWER("nick") LIKE $2`,
+			wantQuery: `SELECT * FROM "users" WHERE "name" ILIKE $1 AND "nick" ILIKE $2`,
 			wantArgs:  []interface{}{"%ariel%", "%bar%"},
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				From(Table("users")).
+				Where(NullSafeEQ("name", "a8m").Or().NullSafeEQ("nick", nil)),
+			wantQuery: `SELECT * FROM "users" WHERE "name" IS NOT DISTINCT FROM $1 OR "nick" IS NULL`,
+			wantArgs:  []interface{}{"a8m"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select().
+				From(Table("users")).
+				Where(NullSafeEQ("name", "a8m")),
+			wantQuery: "SELECT * FROM `users` WHERE `name` <=> ?",
+			wantArgs:  []interface{}{"a8m"},
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Select().
+				From(Table("users")).
+				Where(NullSafeEQ("name", "a8m")),
+			wantQuery: "SELECT * FROM `users` WHERE `name` IS ?",
+			wantArgs:  []interface{}{"a8m"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select().
+				From(Table("users")).
+				Where(HasPrefixFold("name", "Ariel").And().HasSuffixFold("nick", "Bar")),
+			wantQuery: "SELECT * FROM `users` WHERE LOWER(`name`) LIKE ? AND LOWER(`nick`) LIKE ?",
+			wantArgs:  []interface{}{"ariel%", "%bar"},
+		},
+		{
+			input: Dialect(dialect.MySQL).
+				Select().
+				From(Table("users")).
+				Where(ContainsFold("name", "Ariel")),
+			wantQuery: "SELECT * FROM `users` WHERE LOWER(`name`) LIKE ?",
+			wantArgs:  []interface{}{"%ariel%"},
+		},
+		{
+			input: Dialect(dialect.SQLite).
+				Select().
+				From(Table("users")).
+				Where(HasPrefixFold("name", "Ariel").Or().HasSuffixFold("nick", "Bar")),
+			wantQuery: "SELECT * FROM `users` WHERE LOWER(`name`) LIKE ? OR LOWER(`nick`) LIKE ?",
+			wantArgs:  []interface{}{"ariel%", "%bar"},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select().
+				From(Table("users")).
+				Where(HasPrefixFold("name", "Ariel")),
+			wantQuery: `SELECT * FROM "users" WHERE "name" ILIKE $1`,
+			wantArgs:  []interface{}{"ariel%"},
+		},
 		{
 			input: func() Querier {
 				s1 := Select().
@@ -875,6 +1110,40 @@ func TestBuilder(t *testing.T) {
 			wantQuery: `SELECT * FROM "users" WHERE "users"."id" IN (SELECT "owner_id" FROM "pets" WHERE "name" = $1)`,
 			wantArgs:  []interface{}{"pedro"},
 		},
+		{
+			input: func() Querier {
+				t1, t2 := Table("cards"), Table("users")
+				return Select().
+					From(t1).
+					Where(And(NotNull(t1.C("owner_id")), NotExists(Select(t2.C("id")).From(t2).Where(ColumnsEQ(t2.C("id"), t1.C("owner_id"))))))
+			}(),
+			wantQuery: "SELECT * FROM `cards` WHERE (`cards`.`owner_id` IS NOT NULL) AND (NOT EXISTS (SELECT `users`.`id` FROM `users` WHERE `users`.`id` = `cards`.`owner_id`))",
+		},
+		{
+			input: func() Querier {
+				t1, t2 := Table("users"), Table("pets")
+				return Dialect(dialect.Postgres).
+					Select().
+					From(t1).
+					Where(Exists(Select(t2.C("id")).From(t2).Where(ColumnsEQ(t2.C("owner_id"), t1.C("id")).And().EQ(t2.C("name"), "pedro"))))
+			}(),
+			wantQuery: `SELECT * FROM "users" WHERE EXISTS (SELECT "pets"."id" FROM "pets" WHERE "pets"."owner_id" = "users"."id" AND "pets"."name" = $1)`,
+			wantArgs:  []interface{}{"pedro"},
+		},
+		{
+			input: func() Querier {
+				t1 := Table("cards")
+				return Dialect(dialect.Postgres).
+					Select().
+					From(t1).
+					Where(Or(ColumnsLT(t1.C("expires_at"), t1.C("created_at")), ColumnsGTE(t1.C("updated_at"), t1.C("expires_at")).And().ColumnsNEQ(t1.C("a"), t1.C("b"))))
+			}(),
+			wantQuery: `SELECT * FROM "cards" WHERE (("cards"."expires_at" < "cards"."created_at") OR ("cards"."updated_at" >= "cards"."expires_at" AND "cards"."a" <> "cards"."b"))`,
+		},
+		{
+			input:     Select().From(Table("t")).Where(And(ColumnsLTE("a", "b"), ColumnsGT("c", "d"))),
+			wantQuery: "SELECT * FROM `t` WHERE (`a` <= `b`) AND (`c` > `d`)",
+		},
 		{
 			input: func() Querier {
 				t1 := Table("users")
@@ -1002,6 +1271,24 @@ func TestBuilder(t *testing.T) {
 				OrderBy(Desc("name"), "age"),
 			wantQuery: `SELECT "name", "age", COUNT(*) FROM "users" GROUP BY "name", "age" ORDER BY "name" DESC, "age"`,
 		},
+		{
+			input: Select("name", As(Count("*"), "count")).
+				From(Table("users")).
+				GroupBy("name").
+				Having(GT("count", 10)),
+			wantQuery: "SELECT `name`, COUNT(*) AS `count` FROM `users` GROUP BY `name` HAVING COUNT(*) > ?",
+			wantArgs:  []interface{}{10},
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				Select("name", As(Count("*"), "count")).
+				From(Table("users")).
+				Where(EQ("active", true)).
+				GroupBy("name").
+				Having(And(GT("count", 10), NEQ("name", "a8m"))),
+			wantQuery: `SELECT "name", COUNT(*) AS "count" FROM "users" WHERE "active" = $1 GROUP BY "name" HAVING (COUNT(*) > $2) AND ("name" <> $3)`,
+			wantArgs:  []interface{}{true, 10, "a8m"},
+		},
 		{
 			input: Select("*").
 				From(Table("users")).
@@ -1028,6 +1315,34 @@ func TestBuilder(t *testing.T) {
 				From(Table("users")),
 			wantQuery: `SELECT DISTINCT "age" FROM "users"`,
 		},
+		{
+			input: func() Querier {
+				t := Table("cards")
+				w := RowNumber().PartitionBy(t.C("owner_id")).OrderBy(Desc(t.C("number")), t.C("id"))
+				return Select(t.C("id"), As(w.String(), "rn")).From(t)
+			}(),
+			wantQuery: "SELECT `cards`.`id`, ROW_NUMBER() OVER (PARTITION BY `cards`.`owner_id` ORDER BY `cards`.`number` DESC, `cards`.`id`) AS `rn` FROM `cards`",
+		},
+		{
+			input: func() Querier {
+				b := Dialect(dialect.Postgres)
+				t := b.Table("cards")
+				w := RowNumber().PartitionBy(t.C("owner_id")).OrderBy(Desc("number"))
+				inner := b.Select(t.C("id"), As(w.String(), "rn")).From(t).As("t")
+				return b.Select(inner.C("id")).From(inner).Where(LTE(inner.C("rn"), 2))
+			}(),
+			wantQuery: `SELECT "t"."id" FROM (SELECT "cards"."id", ROW_NUMBER() OVER (PARTITION BY "cards"."owner_id" ORDER BY "number" DESC) AS "rn" FROM "cards") AS "t" WHERE "t"."rn" <= $1`,
+			wantArgs:  []interface{}{2},
+		},
+		{
+			input:     Select("id").From(Table("users")).Where(EQ("name", "a8m")).Limit(1).ForUpdate(),
+			wantQuery: "SELECT `id` FROM `users` WHERE `name` = ? LIMIT ? FOR UPDATE",
+			wantArgs:  []interface{}{"a8m", 1},
+		},
+		{
+			input:     Dialect(dialect.SQLite).Select("id").From(Table("users")).ForUpdate(),
+			wantQuery: "SELECT `id` FROM `users`",
+		},
 		{
 			input:     Select("age", "name").From(Table("users")).Distinct().OrderBy("name"),
 			wantQuery: "SELECT DISTINCT `age`, `name` FROM `users` ORDER BY `name`",
@@ -1151,6 +1466,32 @@ func TestBuilder(t *testing.T) {
 				Columns("first", "last"),
 			wantQuery: `CREATE UNIQUE INDEX "unique_name" ON "users"("first", "last")`,
 		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateIndex("owner_id").
+				Table("cards").
+				Column("owner_id").
+				Include("type", "expires_at"),
+			wantQuery: `CREATE INDEX "owner_id" ON "cards"("owner_id") INCLUDE ("type", "expires_at")`,
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateIndex("location").
+				Table("places").
+				Column("location").
+				Using("GIST"),
+			wantQuery: `CREATE INDEX "location" ON "places" USING GIST ("location")`,
+		},
+		{
+			input: Dialect(dialect.Postgres).
+				CreateIndex("embedding").
+				Table("documents").
+				Column("embedding").
+				Using("hnsw").
+				OpClass("vector_cosine_ops").
+				With(map[string]int{"m": 16, "ef_construction": 64}),
+			wantQuery: `CREATE INDEX "embedding" ON "documents" USING hnsw ("embedding" vector_cosine_ops) WITH (ef_construction = 64, m = 16)`,
+		},
 		{
 			input:     DropIndex("name_index"),
 			wantQuery: "DROP INDEX `name_index`",
@@ -1187,3 +1528,187 @@ func TestBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestSelector_HavingErr(t *testing.T) {
+	s := Select("name", As(Count("*"), "count")).
+		From(Table("users")).
+		GroupBy("name").
+		Having(And(GT("count", 10), EQ("name", "a8m")))
+	s.Query()
+	require.NoError(t, s.Err())
+	s = Select("name").
+		From(Table("users")).
+		GroupBy("name").
+		Having(GT("SUM(`age`)", 10))
+	s.Query()
+	require.NoError(t, s.Err())
+	s = Dialect(dialect.Postgres).
+		Select("name", As(Count("*"), "count")).
+		From(Table("users")).
+		GroupBy("name").
+		Having(Or(GT("count", 10), EQ("age", 1)))
+	s.Query()
+	require.Error(t, s.Err())
+}
+
+func TestSelector_SelectExpr(t *testing.T) {
+	t1 := Table("users")
+	s := Select(t1.C("id")).
+		AppendSelectExpr(ExprAs("`age` + ?", "next_age", 1)).
+		From(t1).
+		Where(EQ("name", "a8m"))
+	query, args := s.Query()
+	require.Equal(t, "SELECT `users`.`id`, `age` + ? AS `next_age` FROM `users` WHERE `name` = ?", query)
+	require.Equal(t, []interface{}{1, "a8m"}, args)
+	require.NoError(t, s.Err())
+
+	query, _ = s.Clone().Select().Query()
+	require.Equal(t, "SELECT *, `age` + ? AS `next_age` FROM `users` WHERE `name` = ?", query, "expressions are kept")
+	query, _ = s.Clone().Count().Query()
+	require.Equal(t, "SELECT COUNT(*) FROM `users` WHERE `name` = ?", query, "expressions are dropped")
+
+	t2 := Dialect(dialect.Postgres).Table("users")
+	query, args = Dialect(dialect.Postgres).
+		Select(t2.C("id")).
+		AppendSelectExpr(ExprAs(`"age" BETWEEN ? AND ?`, "adult", 18, 120)).
+		From(t2).
+		Where(EQ("name", "a8m")).
+		Query()
+	require.Equal(t, `SELECT "users"."id", "age" BETWEEN $1 AND $2 AS "adult" FROM "users" WHERE "name" = $3`, query)
+	require.Equal(t, []interface{}{18, 120, "a8m"}, args)
+
+	s = Select().AppendSelectExpr(ExprAs("`age` + ?", "next_age")).From(Table("users"))
+	s.Query()
+	require.EqualError(t, s.Err(), "sql: expression \"`age` + ?\" has 1 placeholders and 0 arguments")
+}
+
+func TestSelector_TimeBucket(t *testing.T) {
+	tests := []struct {
+		dialect string
+		bucket  string
+		wantQ   string
+	}{
+		{
+			dialect: dialect.Postgres,
+			bucket:  "day",
+			wantQ:   `SELECT date_trunc('day', "created_at") AS "created_at_day", COUNT(*) FROM "cards" GROUP BY date_trunc('day', "created_at") ORDER BY date_trunc('day', "created_at")`,
+		},
+		{
+			dialect: dialect.MySQL,
+			bucket:  "month",
+			wantQ:   "SELECT CAST(DATE_FORMAT(`created_at`, '%Y-%m-01 00:00:00') AS DATETIME) AS `created_at_month`, COUNT(*) FROM `cards` GROUP BY CAST(DATE_FORMAT(`created_at`, '%Y-%m-01 00:00:00') AS DATETIME) ORDER BY CAST(DATE_FORMAT(`created_at`, '%Y-%m-01 00:00:00') AS DATETIME)",
+		},
+		{
+			dialect: dialect.MySQL,
+			bucket:  "week",
+			wantQ:   "SELECT CAST(DATE_FORMAT(DATE_SUB(`created_at`, INTERVAL WEEKDAY(`created_at`) DAY), '%Y-%m-%d 00:00:00') AS DATETIME) AS `created_at_week`, COUNT(*) FROM `cards` GROUP BY CAST(DATE_FORMAT(DATE_SUB(`created_at`, INTERVAL WEEKDAY(`created_at`) DAY), '%Y-%m-%d 00:00:00') AS DATETIME) ORDER BY CAST(DATE_FORMAT(DATE_SUB(`created_at`, INTERVAL WEEKDAY(`created_at`) DAY), '%Y-%m-%d 00:00:00') AS DATETIME)",
+		},
+		{
+			dialect: dialect.SQLite,
+			bucket:  "hour",
+			wantQ:   "SELECT strftime('%Y-%m-%d %H:00:00', `created_at`) AS `created_at_hour`, COUNT(*) FROM `cards` GROUP BY strftime('%Y-%m-%d %H:00:00', `created_at`) ORDER BY strftime('%Y-%m-%d %H:00:00', `created_at`)",
+		},
+		{
+			dialect: dialect.SQLite,
+			bucket:  "week",
+			wantQ:   "SELECT strftime('%Y-%m-%d 00:00:00', `created_at`, 'weekday 0', '-6 days') AS `created_at_week`, COUNT(*) FROM `cards` GROUP BY strftime('%Y-%m-%d 00:00:00', `created_at`, 'weekday 0', '-6 days') ORDER BY strftime('%Y-%m-%d 00:00:00', `created_at`, 'weekday 0', '-6 days')",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect+"/"+tt.bucket, func(t *testing.T) {
+			bucket := TimeBucket(tt.bucket, "created_at")
+			s := Dialect(tt.dialect).
+				Select(bucket, Count("*")).
+				From(Table("cards")).
+				GroupBy(bucket).
+				OrderBy(bucket)
+			query, args := s.Query()
+			require.NoError(t, s.Err())
+			require.Equal(t, tt.wantQ, query)
+			require.Empty(t, args)
+		})
+	}
+	s := Select(TimeBucket("decade", "created_at")).From(Table("cards"))
+	s.Query()
+	require.EqualError(t, s.Err(), `sql: unsupported time bucket "decade" for column "created_at"`)
+	query, _ := Dialect(dialect.Postgres).Select("time_bucket('1 day', created_at)").From(Table("cards")).Query()
+	require.Equal(t, `SELECT time_bucket('1 day', created_at) FROM "cards"`, query, "non-bucket functions are kept as-is")
+}
+
+func TestUpdateBuilder_MergeJSONErr(t *testing.T) {
+	u := Dialect(dialect.MySQL).Update("users").MergeJSON("meta", `{}`)
+	u.Query()
+	require.NoError(t, u.Err())
+	u = Dialect(dialect.SQLite).Update("users").MergeJSON("meta", `{}`)
+	u.Query()
+	require.Error(t, u.Err())
+}
+
+func TestUpdateBuilder_SetJSONPathErr(t *testing.T) {
+	u := Dialect(dialect.Postgres).Update("users").SetJSONPath("meta", nil, "1")
+	u.Query()
+	require.Error(t, u.Err())
+	u = Dialect(dialect.SQLite).Update("users").SetJSONPath("meta", []string{"a"}, "1")
+	u.Query()
+	require.Error(t, u.Err())
+}
+
+func TestUpdateBuilder_Returning(t *testing.T) {
+	u := Dialect(dialect.Postgres).Update("users").Set("active", false).Where(EQ("name", "a8m")).Returning("id")
+	query, args := u.Query()
+	require.NoError(t, u.Err())
+	require.Equal(t, `UPDATE "users" SET "active" = $1 WHERE "name" = $2 RETURNING "id"`, query)
+	require.Equal(t, []interface{}{false, "a8m"}, args)
+	s := Dialect(dialect.SQLite).Select().From(Table("users"))
+	s.Where(EQ(s.C("name"), "a8m"))
+	u = Dialect(dialect.SQLite).Update("").Set("active", false).FromSelect(s).Returning("id", "name")
+	query, args = u.Query()
+	require.NoError(t, u.Err())
+	require.Equal(t, "UPDATE `users` SET `active` = ? WHERE `users`.`name` = ? RETURNING `id`, `name`", query)
+	require.Equal(t, []interface{}{false, "a8m"}, args)
+	u = Dialect(dialect.MySQL).Update("users").Set("active", false).Returning("id")
+	u.Query()
+	require.Error(t, u.Err())
+}
+
+func TestBuilder_UpdateDeleteLimit(t *testing.T) {
+	u := Dialect(dialect.MySQL).Update("users").Set("active", false).Limit(10)
+	query, args := u.Query()
+	require.NoError(t, u.Err())
+	require.Equal(t, "UPDATE `users` SET `active` = ? LIMIT ?", query)
+	require.Equal(t, []interface{}{false, 10}, args)
+	d := Dialect(dialect.MySQL).Delete("users").Where(EQ("active", false)).Limit(10)
+	query, args = d.Query()
+	require.NoError(t, d.Err())
+	require.Equal(t, "DELETE FROM `users` WHERE `active` = ? LIMIT ?", query)
+	require.Equal(t, []interface{}{false, 10}, args)
+	for _, name := range []string{dialect.Postgres, dialect.SQLite} {
+		u := Dialect(name).Update("users").Set("active", false).Limit(10)
+		u.Query()
+		require.Error(t, u.Err())
+		d := Dialect(name).Delete("users").Limit(10)
+		d.Query()
+		require.Error(t, d.Err())
+	}
+}
+
+func TestUpdateBuilder_SetCaseErr(t *testing.T) {
+	u := Update("users").SetCase("name", "id", []interface{}{1, 2}, []interface{}{"a8m"})
+	u.Query()
+	require.Error(t, u.Err())
+}
+
+func TestInsertBuilder_ResolveWithNewValuesErr(t *testing.T) {
+	i := Dialect(dialect.MySQL).Insert("users").Columns("name").Values("a8m").OnConflict(ResolveWithNewValues())
+	i.Query()
+	require.NoError(t, i.Err())
+	i = Dialect(dialect.SQLite).Insert("users").Columns("name").Values("a8m").OnConflict(ResolveWithNewValues())
+	i.Query()
+	require.Error(t, i.Err(), "conflict columns are required")
+	i = Dialect(dialect.Postgres).Insert("users").Columns("name").Values("a8m").OnConflict(ConflictColumns("name"), ResolveWithNewValues())
+	i.Query()
+	require.Error(t, i.Err(), "no columns to update")
+	i = Dialect(dialect.SQLite).Insert("users").Columns("name").Values("a8m").OnConflict(ConflictConstraint("uq_name"), DoNothing())
+	i.Query()
+	require.Error(t, i.Err(), "named constraints are supported only by PostgreSQL")
+}
@@ -0,0 +1,252 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Cipher is the interface that is implemented by the ciphers of encrypted fields. The
+// generated builders encrypt the values of encrypted fields before they are written to
+// the database, and the queries decrypt them after they are read. For example:
+//
+//	field.String("ssn").
+//		Encrypted(sql.AESGCM(sql.StaticKey(key)))
+//
+type Cipher interface {
+	Encrypt(plaintext []byte) ([]byte, error)
+	Decrypt(ciphertext []byte) ([]byte, error)
+}
+
+// BlindIndex is the interface that is implemented by the blind indexes of encrypted fields.
+// A blind index is a keyed hash of the plaintext, that is stored in a separate column and is
+// used by the equality predicates of fields that are encrypted with a non-deterministic cipher.
+type BlindIndex interface {
+	Index(plaintext []byte) ([]byte, error)
+}
+
+// KeyProvider provides the keys of the AES ciphers. Keys are identified by an id that is stored
+// with the ciphertext, and therefore, keys can be rotated by changing the current key, as long as
+// the previous keys are still provided for decryption. Keys are AES-128, AES-192 or AES-256 keys
+// (16, 24 or 32 bytes). Implementations can be backed by a KMS or a secret manager.
+type KeyProvider interface {
+	// CurrentKey returns the id and the value of the key that is used for encryption.
+	CurrentKey() (id string, key []byte, err error)
+	// Key returns the value of the key with the given id. It is used for decryption.
+	Key(id string) ([]byte, error)
+}
+
+// StaticKeys returns a KeyProvider for a fixed set of keys, that encrypts with the key of the given id.
+func StaticKeys(current string, keys map[string][]byte) KeyProvider {
+	return &staticKeys{current: current, keys: keys}
+}
+
+// StaticKey returns a KeyProvider with a single key (and an empty id).
+func StaticKey(key []byte) KeyProvider {
+	return StaticKeys("", map[string][]byte{"": key})
+}
+
+type staticKeys struct {
+	current string
+	keys    map[string][]byte
+}
+
+func (s *staticKeys) CurrentKey() (string, []byte, error) {
+	key, err := s.Key(s.current)
+	return s.current, key, err
+}
+
+func (s *staticKeys) Key(id string) ([]byte, error) {
+	key, ok := s.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("sql: unknown key %q", id)
+	}
+	return key, nil
+}
+
+// AESCipher is a Cipher that encrypts values using AES-GCM. Ciphertexts are prefixed with
+// the id of the key that encrypted them, and authenticated together with it.
+type AESCipher struct {
+	keys          KeyProvider
+	deterministic bool
+}
+
+// AESGCM returns a Cipher that encrypts values using AES-GCM with random nonces. Hence,
+// encrypting the same value twice results in different ciphertexts.
+func AESGCM(keys KeyProvider) *AESCipher {
+	return &AESCipher{keys: keys}
+}
+
+// DeterministicAESGCM returns a Cipher that encrypts values using AES-GCM with nonces that are
+// derived from the plaintext (using HMAC-SHA256, like AES-GCM-SIV does). Hence, encrypting the
+// same value twice (with the same key) results in the same ciphertext, and encrypted fields can
+// be compared for equality in the database, at the cost of revealing which values are equal.
+//
+// Note that values are compared using their encryption with the current key. Hence, values that
+// were encrypted with a previous key do not match equal values after the key is rotated.
+func DeterministicAESGCM(keys KeyProvider) *AESCipher {
+	return &AESCipher{keys: keys, deterministic: true}
+}
+
+// Deterministic reports if the cipher encrypts equal values to equal ciphertexts.
+func (c *AESCipher) Deterministic() bool { return c.deterministic }
+
+// Encrypt implements the Cipher interface.
+func (c *AESCipher) Encrypt(plaintext []byte) ([]byte, error) {
+	id, key, err := c.keys.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("sql: key id %q is too long", id)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if c.deterministic {
+		mac := hmac.New(sha256.New, deriveKey(key, "nonce"))
+		mac.Write([]byte(id))
+		mac.Write(plaintext)
+		copy(nonce, mac.Sum(nil))
+	} else if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	out := make([]byte, 0, 1+len(id)+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(append(out, byte(len(id))), id...)
+	header := out[:len(out):len(out)]
+	return aead.Seal(append(out, nonce...), nonce, plaintext, header), nil
+}
+
+// Decrypt implements the Cipher interface.
+func (c *AESCipher) Decrypt(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) == 0 || len(ciphertext) < 1+int(ciphertext[0]) {
+		return nil, errors.New("sql: invalid ciphertext")
+	}
+	header := ciphertext[:1+int(ciphertext[0])]
+	key, err := c.keys.Key(string(header[1:]))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	ciphertext = ciphertext[len(header):]
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("sql: invalid ciphertext")
+	}
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], header)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("sql: %v", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// deriveKey derives a sub-key for the given purpose from the given key.
+func deriveKey(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("ent/" + purpose))
+	return mac.Sum(nil)
+}
+
+// HMACIndex returns a BlindIndex that computes the HMAC-SHA256 of values with the given key.
+// Note that unlike the keys of ciphers, the key of a blind index cannot be rotated without
+// recomputing the indexes of the stored values.
+func HMACIndex(key []byte) BlindIndex {
+	return hmacIndex(deriveKey(key, "index"))
+}
+
+type hmacIndex []byte
+
+func (k hmacIndex) Index(plaintext []byte) ([]byte, error) {
+	mac := hmac.New(sha256.New, k)
+	mac.Write(plaintext)
+	return mac.Sum(nil), nil
+}
+
+// EncryptValue returns a driver.Valuer that encrypts the given value using the cipher when
+// it is sent to the database. The ciphertext is stored base64-encoded, and the value is not
+// printed by the debug logs. It is used by the generated code for writing encrypted fields.
+func EncryptValue(c Cipher, v string) driver.Valuer {
+	return &encryptedValue{c: c, v: v}
+}
+
+type encryptedValue struct {
+	c Cipher
+	v string
+}
+
+// Value implements the driver.Valuer interface.
+func (e *encryptedValue) Value() (driver.Value, error) {
+	if e.c == nil {
+		return nil, errors.New("sql: missing cipher for encrypted value")
+	}
+	b, err := e.c.Encrypt([]byte(e.v))
+	if err != nil {
+		return nil, fmt.Errorf("sql: encrypting value: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (*encryptedValue) String() string { return "<encrypted>" }
+
+// DecryptString decrypts a base64-encoded ciphertext that was written by EncryptValue.
+func DecryptString(c Cipher, s string) (string, error) {
+	if c == nil {
+		return "", errors.New("sql: missing cipher for encrypted value")
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", fmt.Errorf("sql: decoding encrypted value: %v", err)
+	}
+	if b, err = c.Decrypt(b); err != nil {
+		return "", fmt.Errorf("sql: decrypting value: %v", err)
+	}
+	return string(b), nil
+}
+
+// BlindIndexValue returns a driver.Valuer of the hex-encoded blind index of the given value.
+// It is used by the generated code for writing and querying the blind indexes of encrypted
+// fields.
+func BlindIndexValue(b BlindIndex, v string) driver.Valuer {
+	return &indexValue{b: b, v: v}
+}
+
+type indexValue struct {
+	b BlindIndex
+	v string
+}
+
+// Value implements the driver.Valuer interface.
+func (i *indexValue) Value() (driver.Value, error) {
+	if i.b == nil {
+		return nil, errors.New("sql: missing blind index for encrypted value")
+	}
+	idx, err := i.b.Index([]byte(i.v))
+	if err != nil {
+		return nil, fmt.Errorf("sql: computing blind index: %v", err)
+	}
+	return hex.EncodeToString(idx), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (*indexValue) String() string { return "<blind-index>" }
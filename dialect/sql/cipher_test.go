@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAESGCM(t *testing.T) {
+	keys := StaticKeys("v1", map[string][]byte{"v1": make([]byte, 32)})
+	c := AESGCM(keys)
+	require.False(t, c.Deterministic())
+	b1, err := c.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	b2, err := c.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	require.NotEqual(t, b1, b2)
+	for _, b := range [][]byte{b1, b2} {
+		p, err := c.Decrypt(b)
+		require.NoError(t, err)
+		require.Equal(t, "secret", string(p))
+	}
+	b1[len(b1)-1] ^= 1
+	_, err = c.Decrypt(b1)
+	require.Error(t, err, "tampered ciphertext")
+	_, err = c.Decrypt(nil)
+	require.Error(t, err)
+
+	// Rotate the key, and decrypt values that were encrypted with the previous one.
+	rotated := AESGCM(StaticKeys("v2", map[string][]byte{"v1": make([]byte, 32), "v2": []byte("0123456789abcdef")}))
+	p, err := rotated.Decrypt(b2)
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(p))
+	_, err = AESGCM(StaticKey([]byte("0123456789abcdef"))).Decrypt(b2)
+	require.Error(t, err, "unknown key")
+	_, err = AESGCM(StaticKey([]byte("short"))).Encrypt([]byte("secret"))
+	require.Error(t, err, "invalid key size")
+}
+
+func TestDeterministicAESGCM(t *testing.T) {
+	c := DeterministicAESGCM(StaticKey(make([]byte, 16)))
+	require.True(t, c.Deterministic())
+	b1, err := c.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	b2, err := c.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	require.Equal(t, b1, b2)
+	b3, err := c.Encrypt([]byte("secreT"))
+	require.NoError(t, err)
+	require.NotEqual(t, b1, b3)
+	p, err := c.Decrypt(b1)
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(p))
+}
+
+func TestHMACIndex(t *testing.T) {
+	idx := HMACIndex([]byte("key"))
+	i1, err := idx.Index([]byte("a8m"))
+	require.NoError(t, err)
+	i2, err := idx.Index([]byte("a8m"))
+	require.NoError(t, err)
+	require.Equal(t, i1, i2)
+	i3, err := HMACIndex([]byte("other")).Index([]byte("a8m"))
+	require.NoError(t, err)
+	require.NotEqual(t, i1, i3)
+}
+
+func TestEncryptValue(t *testing.T) {
+	c := AESGCM(StaticKey(make([]byte, 32)))
+	v := EncryptValue(c, "secret")
+	require.Equal(t, "<encrypted>", fmt.Sprint(v))
+	enc, err := v.Value()
+	require.NoError(t, err)
+	require.IsType(t, "", enc)
+	s, err := DecryptString(c, enc.(string))
+	require.NoError(t, err)
+	require.Equal(t, "secret", s)
+	_, err = DecryptString(c, "not base64!")
+	require.Error(t, err)
+	_, err = DecryptString(nil, enc.(string))
+	require.Error(t, err)
+	_, err = EncryptValue(nil, "secret").Value()
+	require.Error(t, err)
+
+	v = BlindIndexValue(HMACIndex([]byte("key")), "secret")
+	require.Equal(t, "<blind-index>", fmt.Sprint(v))
+	idx, err := v.Value()
+	require.NoError(t, err)
+	require.Len(t, idx, 64)
+	_, err = BlindIndexValue(nil, "secret").Value()
+	require.Error(t, err)
+}
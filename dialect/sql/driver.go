@@ -7,6 +7,7 @@ package sql
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"strings"
 
@@ -149,3 +150,19 @@ type (
 	// TxOptions holds the transaction options to be used in DB.BeginTx.
 	TxOptions = sql.TxOptions
 )
+
+// MaskValue returns a driver.Valuer of the given value, that is printed (e.g. by the debug logs)
+// as the given mask. It is used by the generated code for writing sensitive fields.
+func MaskValue(v, mask string) driver.Valuer {
+	return &maskedValue{v: v, mask: mask}
+}
+
+type maskedValue struct {
+	v, mask string
+}
+
+// Value implements the driver.Valuer interface.
+func (m *maskedValue) Value() (driver.Value, error) { return m.v, nil }
+
+// String implements the fmt.Stringer interface.
+func (m *maskedValue) String() string { return m.mask }
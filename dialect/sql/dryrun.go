@@ -0,0 +1,121 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Statement is a statement (and its arguments) that was recorded in dry-run mode.
+type Statement struct {
+	Query string
+	Args  []interface{}
+}
+
+// dryRunKey is the context key for the statements that are recorded in dry-run mode.
+type dryRunKey struct{}
+
+// dryRunStatements holds the statements that were recorded in a dry-run context.
+type dryRunStatements struct {
+	sync.Mutex
+	stmts []Statement
+}
+
+// WithDryRun returns a new context that is marked for running in dry-run mode. Statements
+// that are executed by the DryRunDriver with this context are recorded in it (see
+// DryRunStatements), instead of being executed on the database.
+func WithDryRun(parent context.Context) context.Context {
+	return context.WithValue(parent, dryRunKey{}, &dryRunStatements{})
+}
+
+// IsDryRun reports if the context was marked for running in dry-run mode.
+func IsDryRun(ctx context.Context) bool {
+	_, ok := ctx.Value(dryRunKey{}).(*dryRunStatements)
+	return ok
+}
+
+// DryRunStatements returns the statements that were recorded in the dry-run context, in
+// their execution order. It returns nil if the context is not in dry-run mode.
+func DryRunStatements(ctx context.Context) []Statement {
+	s, ok := ctx.Value(dryRunKey{}).(*dryRunStatements)
+	if !ok {
+		return nil
+	}
+	s.Lock()
+	defer s.Unlock()
+	return append([]Statement(nil), s.stmts...)
+}
+
+// DryRunDriver is a driver that records the statements in their context
+// (see WithDryRun) instead of passing them to the underlying driver.
+type DryRunDriver struct {
+	dialect.Driver // underlying driver.
+}
+
+// DryRun gets a driver and returns a new driver that records all statements that
+// are executed by the driver or by its transactions, without executing them. Exec
+// calls return a result with zero affected rows and a zero last insert id, and Query
+// calls leave the given rows untouched. Therefore, callers must not read them.
+func DryRun(d dialect.Driver) dialect.Driver {
+	return &DryRunDriver{d}
+}
+
+// Exec records the statement and sets a dry-run result.
+func (d *DryRunDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := recordStatement(ctx, query, args); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+	case *Result:
+		*v = dryRunResult{}
+	default:
+		return fmt.Errorf("dialect/sql: invalid type %T. expect *sql.Result", v)
+	}
+	return nil
+}
+
+// Query records the statement.
+func (d *DryRunDriver) Query(ctx context.Context, query string, args, _ interface{}) error {
+	return recordStatement(ctx, query, args)
+}
+
+// Tx returns a transaction that records its statements, and its Commit
+// and Rollback methods are no-op.
+func (d *DryRunDriver) Tx(context.Context) (dialect.Tx, error) {
+	return dialect.NopTx(d), nil
+}
+
+// Close is a no-op, since the underlying driver is not owned by the DryRunDriver.
+func (d *DryRunDriver) Close() error { return nil }
+
+func recordStatement(ctx context.Context, query string, args interface{}) error {
+	var argv []interface{}
+	if args != nil {
+		v, ok := args.([]interface{})
+		if !ok {
+			return fmt.Errorf("dialect/sql: invalid type %T. expect []interface{} for args", args)
+		}
+		argv = v
+	}
+	if s, ok := ctx.Value(dryRunKey{}).(*dryRunStatements); ok {
+		s.Lock()
+		s.stmts = append(s.stmts, Statement{Query: query, Args: argv})
+		s.Unlock()
+	}
+	return nil
+}
+
+// dryRunResult is the result of statements that were executed in dry-run mode.
+type dryRunResult struct{}
+
+func (dryRunResult) LastInsertId() (int64, error) { return 0, nil }
+func (dryRunResult) RowsAffected() (int64, error) { return 0, nil }
+
+var _ dialect.Driver = (*DryRunDriver)(nil)
@@ -0,0 +1,44 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := DryRun(OpenDB("mysql", db))
+	require.Equal(t, "mysql", drv.Dialect())
+	ctx := WithDryRun(context.Background())
+	require.True(t, IsDryRun(ctx))
+	require.False(t, IsDryRun(context.Background()))
+	require.Nil(t, DryRunStatements(context.Background()))
+
+	var res Result
+	require.NoError(t, drv.Exec(ctx, "UPDATE `users` SET `age` = ?", []interface{}{30}, &res))
+	affected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Zero(t, affected)
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, &Rows{}))
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM `users`", nil, nil))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, []Statement{
+		{Query: "UPDATE `users` SET `age` = ?", Args: []interface{}{30}},
+		{Query: "SELECT `id` FROM `users`", Args: []interface{}{}},
+		{Query: "DELETE FROM `users`"},
+	}, DryRunStatements(ctx))
+
+	err = drv.Exec(ctx, "DELETE FROM `users`", []string{"a8m"}, nil)
+	require.EqualError(t, err, "dialect/sql: invalid type []string. expect []interface{} for args")
+	require.NoError(t, mock.ExpectationsWereMet(), "statements are not executed")
+}
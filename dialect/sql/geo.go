@@ -0,0 +1,540 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// SRID4326 is the spatial reference identifier of the WGS 84 coordinate
+// system (latitude and longitude), that is used by GeoPoint values.
+const SRID4326 = 4326
+
+// GeoPoint represents a PostGIS point. It implements the sql.Scanner and driver.Valuer
+// interfaces, and it is the Go type of the field.Point fields. For example:
+//
+//	field.Point("location")
+//
+// Points are in the WGS 84 coordinate system (SRID 4326), unless their SRID was set. In
+// other coordinate systems, Lng holds the X coordinate of the point, and Lat holds its Y.
+type GeoPoint struct {
+	Lat  float64
+	Lng  float64
+	SRID int // spatial reference identifier. Zero stands for SRID4326.
+}
+
+// EWKB type flags and geometry types.
+const (
+	wkbPoint              uint32 = 1
+	wkbLineString         uint32 = 2
+	wkbPolygon            uint32 = 3
+	wkbMultiPoint         uint32 = 4
+	wkbMultiLineString    uint32 = 5
+	wkbMultiPolygon       uint32 = 6
+	wkbGeometryCollection uint32 = 7
+	ewkbSRID              uint32 = 0x20000000
+	ewkbZ                 uint32 = 0x80000000
+	ewkbM                 uint32 = 0x40000000
+)
+
+// Spatial is the interface that is implemented by the spatial types (GeoPoint, GeoPolygon
+// and Geometry), and it is accepted as an argument by the spatial predicates (e.g. Intersects).
+type Spatial interface {
+	driver.Valuer
+	spatial()
+}
+
+func (GeoPoint) spatial()   {}
+func (GeoPolygon) spatial() {}
+func (Geometry) spatial()   {}
+
+// Value implements the driver.Valuer interface. Points are encoded as
+// hex-encoded EWKB (Extended Well-Known Binary) with their SRID.
+func (p GeoPoint) Value() (driver.Value, error) {
+	w := newEWKB(wkbPoint, p.SRID)
+	w.point(p)
+	return w.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts points that are encoded
+// as WKB or EWKB, in their raw or hex-encoded form (the PostGIS text output).
+func (p *GeoPoint) Scan(src interface{}) error {
+	if src == nil {
+		*p = GeoPoint{}
+		return nil
+	}
+	r, err := newWKBReader(src, "GeoPoint")
+	if err != nil {
+		return err
+	}
+	typ, srid, err := r.header()
+	if err != nil {
+		return err
+	}
+	if typ != wkbPoint {
+		return fmt.Errorf("sql: unexpected geometry type %d for GeoPoint", typ)
+	}
+	v, err := r.point()
+	if err != nil {
+		return err
+	}
+	if len(r.b) != 0 {
+		return fmt.Errorf("sql: invalid WKB length for GeoPoint")
+	}
+	v.SRID = srid
+	*p = v
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (p GeoPoint) String() string {
+	return fmt.Sprintf("POINT(%v %v)", p.Lng, p.Lat)
+}
+
+// GeoPolygon represents a PostGIS polygon. It implements the sql.Scanner and driver.Valuer
+// interfaces, and it is the Go type of the field.Polygon fields. The first ring is the exterior
+// ring of the polygon, and the rest are its holes. Rings are closed (i.e. their first and last
+// points are equal), and the SRID of their points is ignored.
+//
+//	field.Polygon("area")
+//
+type GeoPolygon struct {
+	Rings [][]GeoPoint
+	SRID  int // spatial reference identifier. Zero stands for SRID4326.
+}
+
+// Value implements the driver.Valuer interface. Polygons are encoded as
+// hex-encoded EWKB (Extended Well-Known Binary) with their SRID.
+func (p GeoPolygon) Value() (driver.Value, error) {
+	if p.Rings == nil {
+		return nil, nil
+	}
+	w := newEWKB(wkbPolygon, p.SRID)
+	w.uint32(uint32(len(p.Rings)))
+	for _, ring := range p.Rings {
+		w.uint32(uint32(len(ring)))
+		for _, pt := range ring {
+			w.point(pt)
+		}
+	}
+	return w.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts polygons that are encoded
+// as WKB or EWKB, in their raw or hex-encoded form (the PostGIS text output).
+func (p *GeoPolygon) Scan(src interface{}) error {
+	if src == nil {
+		*p = GeoPolygon{}
+		return nil
+	}
+	r, err := newWKBReader(src, "GeoPolygon")
+	if err != nil {
+		return err
+	}
+	typ, srid, err := r.header()
+	if err != nil {
+		return err
+	}
+	if typ != wkbPolygon {
+		return fmt.Errorf("sql: unexpected geometry type %d for GeoPolygon", typ)
+	}
+	n, err := r.uint32()
+	if err != nil {
+		return err
+	}
+	rings := make([][]GeoPoint, 0, n)
+	for i := uint32(0); i < n; i++ {
+		ring, err := r.points()
+		if err != nil {
+			return err
+		}
+		rings = append(rings, ring)
+	}
+	if len(r.b) != 0 {
+		return fmt.Errorf("sql: invalid WKB length for GeoPolygon")
+	}
+	*p = GeoPolygon{Rings: rings, SRID: srid}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (p GeoPolygon) String() string {
+	var b strings.Builder
+	b.WriteString("POLYGON")
+	writeRings(&b, p.Rings)
+	return b.String()
+}
+
+// Geometry represents a PostGIS geometry of any type (e.g. a line or a multi-polygon) in its
+// WKT (Well-Known Text) representation. It implements the sql.Scanner and driver.Valuer
+// interfaces, and it is the Go type of the field.Geometry fields. For example:
+//
+//	sql.Geometry{WKT: "LINESTRING(0 0,1 1)"}
+//
+type Geometry struct {
+	WKT  string
+	SRID int // spatial reference identifier. Zero stands for SRID4326.
+}
+
+// Value implements the driver.Valuer interface. Geometries
+// are encoded as EWKT (e.g. "SRID=4326;POINT(1 2)").
+func (g Geometry) Value() (driver.Value, error) {
+	if g.WKT == "" {
+		return nil, nil
+	}
+	return fmt.Sprintf("SRID=%d;%s", sridOr(g.SRID), g.WKT), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts geometries that are encoded as
+// WKB or EWKB, in their raw or hex-encoded form (the PostGIS text output), or as (E)WKT.
+func (g *Geometry) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*g = Geometry{}
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("sql: unexpected type %T for Geometry", src)
+	}
+	// Text that does not start with a byte-order mark is (E)WKT.
+	if len(s) > 1 && s[0] > 1 && !strings.HasPrefix(s, "00") && !strings.HasPrefix(s, "01") {
+		v := Geometry{WKT: s}
+		if strings.HasPrefix(s, "SRID=") {
+			i := strings.IndexByte(s, ';')
+			if i == -1 {
+				return fmt.Errorf("sql: invalid EWKT %q for Geometry", s)
+			}
+			srid, err := strconv.Atoi(s[5:i])
+			if err != nil {
+				return fmt.Errorf("sql: invalid SRID in EWKT %q for Geometry", s)
+			}
+			v = Geometry{WKT: s[i+1:], SRID: srid}
+		}
+		if v.SRID == SRID4326 {
+			v.SRID = 0
+		}
+		*g = v
+		return nil
+	}
+	r, err := newWKBReader(src, "Geometry")
+	if err != nil {
+		return err
+	}
+	var b strings.Builder
+	srid, err := r.wkt(&b, true)
+	if err != nil {
+		return err
+	}
+	if len(r.b) != 0 {
+		return fmt.Errorf("sql: invalid WKB length for Geometry")
+	}
+	*g = Geometry{WKT: b.String(), SRID: srid}
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (g Geometry) String() string {
+	return g.WKT
+}
+
+// sridOr returns the given SRID, or SRID4326 if it is zero.
+func sridOr(srid int) int {
+	if srid == 0 {
+		return SRID4326
+	}
+	return srid
+}
+
+// ewkbWriter writes (little-endian) EWKB encoded geometries.
+type ewkbWriter struct {
+	b []byte
+}
+
+// newEWKB returns a writer with the header of a geometry with the given type and SRID.
+func newEWKB(typ uint32, srid int) *ewkbWriter {
+	w := &ewkbWriter{b: []byte{1}} // little endian.
+	w.uint32(typ | ewkbSRID)
+	w.uint32(uint32(sridOr(srid)))
+	return w
+}
+
+func (w *ewkbWriter) uint32(v uint32) {
+	w.b = append(w.b, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(w.b[len(w.b)-4:], v)
+}
+
+func (w *ewkbWriter) point(p GeoPoint) {
+	w.b = append(w.b, make([]byte, 16)...)
+	binary.LittleEndian.PutUint64(w.b[len(w.b)-16:], math.Float64bits(p.Lng))
+	binary.LittleEndian.PutUint64(w.b[len(w.b)-8:], math.Float64bits(p.Lat))
+}
+
+// String returns the hex-encoded EWKB.
+func (w *ewkbWriter) String() string {
+	return hex.EncodeToString(w.b)
+}
+
+// wkbReader reads (E)WKB encoded geometries.
+type wkbReader struct {
+	b     []byte
+	name  string
+	order binary.ByteOrder
+}
+
+// newWKBReader returns a reader for the given raw or hex-encoded (E)WKB.
+func newWKBReader(src interface{}, name string) (*wkbReader, error) {
+	var b []byte
+	switch v := src.(type) {
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return nil, fmt.Errorf("sql: unexpected type %T for %s", src, name)
+	}
+	// Raw (E)WKB starts with the byte-order mark (0 or 1).
+	if len(b) > 0 && b[0] > 1 {
+		dec := make([]byte, hex.DecodedLen(len(b)))
+		if _, err := hex.Decode(dec, b); err != nil {
+			return nil, fmt.Errorf("sql: decoding hex %s: %v", name, err)
+		}
+		b = dec
+	}
+	return &wkbReader{b: b, name: name}, nil
+}
+
+// header reads the byte order, the type and the optional SRID of a geometry.
+// The returned SRID is zero if it is missing or if it is SRID4326.
+func (r *wkbReader) header() (typ uint32, srid int, err error) {
+	if len(r.b) < 5 {
+		return 0, 0, fmt.Errorf("sql: invalid WKB length %d for %s", len(r.b), r.name)
+	}
+	r.order = binary.LittleEndian
+	if r.b[0] == 0 {
+		r.order = binary.BigEndian
+	}
+	typ = r.order.Uint32(r.b[1:])
+	r.b = r.b[5:]
+	if typ&(ewkbZ|ewkbM) != 0 {
+		return 0, 0, fmt.Errorf("sql: unsupported %s dimensions (type %#x)", r.name, typ)
+	}
+	if typ&ewkbSRID != 0 {
+		v, err := r.uint32()
+		if err != nil {
+			return 0, 0, fmt.Errorf("sql: missing SRID for %s", r.name)
+		}
+		if srid = int(v); srid == SRID4326 {
+			srid = 0
+		}
+	}
+	return typ &^ ewkbSRID, srid, nil
+}
+
+func (r *wkbReader) uint32() (uint32, error) {
+	if len(r.b) < 4 {
+		return 0, fmt.Errorf("sql: invalid WKB length for %s", r.name)
+	}
+	v := r.order.Uint32(r.b)
+	r.b = r.b[4:]
+	return v, nil
+}
+
+func (r *wkbReader) point() (GeoPoint, error) {
+	if len(r.b) < 16 {
+		return GeoPoint{}, fmt.Errorf("sql: invalid WKB length for %s", r.name)
+	}
+	p := GeoPoint{
+		Lng: math.Float64frombits(r.order.Uint64(r.b)),
+		Lat: math.Float64frombits(r.order.Uint64(r.b[8:])),
+	}
+	r.b = r.b[16:]
+	return p, nil
+}
+
+// points reads a list of points that is prefixed with its length.
+func (r *wkbReader) points() ([]GeoPoint, error) {
+	n, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if int(n) > len(r.b)/16 {
+		return nil, fmt.Errorf("sql: invalid WKB length for %s", r.name)
+	}
+	ps := make([]GeoPoint, n)
+	for i := range ps {
+		if ps[i], err = r.point(); err != nil {
+			return nil, err
+		}
+	}
+	return ps, nil
+}
+
+// wkt reads a geometry and writes its WKT representation to the given builder. The types
+// of the top-level geometry and its members are written, except for the members of multi
+// geometries (e.g. the polygons of a MULTIPOLYGON).
+func (r *wkbReader) wkt(b *strings.Builder, typed bool) (int, error) {
+	typ, srid, err := r.header()
+	if err != nil {
+		return 0, err
+	}
+	names := map[uint32]string{
+		wkbPoint:              "POINT",
+		wkbLineString:         "LINESTRING",
+		wkbPolygon:            "POLYGON",
+		wkbMultiPoint:         "MULTIPOINT",
+		wkbMultiLineString:    "MULTILINESTRING",
+		wkbMultiPolygon:       "MULTIPOLYGON",
+		wkbGeometryCollection: "GEOMETRYCOLLECTION",
+	}
+	name, ok := names[typ]
+	if !ok {
+		return 0, fmt.Errorf("sql: unsupported geometry type %d for %s", typ, r.name)
+	}
+	if typed {
+		b.WriteString(name)
+	}
+	switch typ {
+	case wkbPoint:
+		p, err := r.point()
+		if err != nil {
+			return 0, err
+		}
+		// Empty points are encoded with NaN coordinates.
+		if math.IsNaN(p.Lng) && math.IsNaN(p.Lat) {
+			b.WriteString(" EMPTY")
+			break
+		}
+		writePoints(b, []GeoPoint{p})
+	case wkbLineString:
+		ps, err := r.points()
+		if err != nil {
+			return 0, err
+		}
+		writePoints(b, ps)
+	case wkbPolygon:
+		n, err := r.uint32()
+		if err != nil {
+			return 0, err
+		}
+		rings := make([][]GeoPoint, 0, n)
+		for i := uint32(0); i < n; i++ {
+			ring, err := r.points()
+			if err != nil {
+				return 0, err
+			}
+			rings = append(rings, ring)
+		}
+		writeRings(b, rings)
+	default:
+		n, err := r.uint32()
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			b.WriteString(" EMPTY")
+			break
+		}
+		b.WriteByte('(')
+		for i := uint32(0); i < n; i++ {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			// Members of collections are written with their types.
+			if _, err := r.wkt(b, typ == wkbGeometryCollection); err != nil {
+				return 0, err
+			}
+		}
+		b.WriteByte(')')
+	}
+	return srid, nil
+}
+
+// writePoints writes a list of points in WKT format. For example, "(1 2,3 4)".
+func writePoints(b *strings.Builder, ps []GeoPoint) {
+	if len(ps) == 0 {
+		b.WriteString(" EMPTY")
+		return
+	}
+	b.WriteByte('(')
+	for i, p := range ps {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(p.Lng, 'f', -1, 64))
+		b.WriteByte(' ')
+		b.WriteString(strconv.FormatFloat(p.Lat, 'f', -1, 64))
+	}
+	b.WriteByte(')')
+}
+
+// writeRings writes the rings of a polygon in WKT format. For example, "((0 0,1 0,1 1,0 0))".
+func writeRings(b *strings.Builder, rings [][]GeoPoint) {
+	if len(rings) == 0 {
+		b.WriteString(" EMPTY")
+		return
+	}
+	b.WriteByte('(')
+	for i, ring := range rings {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		writePoints(b, ring)
+	}
+	b.WriteByte(')')
+}
+
+// WithinDistance returns a predicate that checks if the point stored in the given geometry
+// column is within the given distance (in meters) of the point (lat, lng). The distance is
+// computed on the spheroid using the PostGIS ST_DWithin function. Hence, it is supported
+// only by PostgreSQL.
+//
+//	WithinDistance("location", 40.7128, -74.0060, 1000)
+//
+func WithinDistance(col string, lat, lng, meters float64) *Predicate {
+	return (&Predicate{}).WithinDistance(col, lat, lng, meters)
+}
+
+// WithinDistance returns a predicate that checks if the point stored in the given geometry
+// column is within the given distance (in meters) of the point (lat, lng).
+func (p *Predicate) WithinDistance(col string, lat, lng, meters float64) *Predicate {
+	return p.append(func(b *Builder) {
+		b.WriteString("ST_DWithin(")
+		b.Ident(col).WriteString("::geography, ST_SetSRID(ST_MakePoint(")
+		b.Arg(lng).Comma().Arg(lat)
+		b.WriteString("), 4326)::geography, ")
+		b.Arg(meters)
+		b.WriteString(")")
+	})
+}
+
+// Intersects returns a predicate that checks if the geometry stored in the given column
+// spatially intersects with the given geometry, using the PostGIS ST_Intersects function.
+// Hence, it is supported only by PostgreSQL.
+//
+//	Intersects("area", sql.GeoPoint{Lat: 40.7128, Lng: -74.0060})
+//
+func Intersects(col string, g Spatial) *Predicate {
+	return (&Predicate{}).Intersects(col, g)
+}
+
+// Intersects returns a predicate that checks if the geometry stored in
+// the given column spatially intersects with the given geometry.
+func (p *Predicate) Intersects(col string, g Spatial) *Predicate {
+	return p.append(func(b *Builder) {
+		b.WriteString("ST_Intersects(")
+		b.Ident(col).Comma().Arg(g)
+		b.WriteString("::geometry)")
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeoPoint(t *testing.T) {
+	// SELECT ST_SetSRID(ST_MakePoint(1, 2), 4326).
+	const ewkb = "0101000020E6100000000000000000F03F0000000000000040"
+	v, err := GeoPoint{Lat: 2, Lng: 1}.Value()
+	require.NoError(t, err)
+	require.Equal(t, strings.ToLower(ewkb), v)
+
+	p := &GeoPoint{}
+	require.NoError(t, p.Scan(ewkb))
+	require.Equal(t, GeoPoint{Lat: 2, Lng: 1}, *p)
+
+	// Raw big-endian WKB (without SRID).
+	wkb, err := hex.DecodeString("00000000014008000000000000C010000000000000")
+	require.NoError(t, err)
+	require.NoError(t, p.Scan(wkb))
+	require.Equal(t, GeoPoint{Lat: -4, Lng: 3}, *p)
+
+	require.NoError(t, p.Scan(nil))
+	require.Equal(t, GeoPoint{}, *p)
+
+	// LINESTRING(1 2, 3 4).
+	require.Error(t, p.Scan("0102000020E610000002000000000000000000F03F000000000000004000000000000008400000000000001040"))
+	require.Error(t, p.Scan("zz"))
+	require.Error(t, p.Scan(1))
+
+	ns := &NullScanner{S: &GeoPoint{}}
+	require.NoError(t, ns.Scan(nil))
+	require.False(t, ns.Valid)
+	require.NoError(t, ns.Scan(ewkb))
+	require.True(t, ns.Valid)
+	require.Equal(t, GeoPoint{Lat: 2, Lng: 1}, *ns.S.(*GeoPoint))
+
+	// SRID 3857.
+	const mercator = "0101000020110F0000000000000000F03F0000000000000040"
+	require.NoError(t, p.Scan(mercator))
+	require.Equal(t, GeoPoint{Lat: 2, Lng: 1, SRID: 3857}, *p)
+	v, err = p.Value()
+	require.NoError(t, err)
+	require.Equal(t, strings.ToLower(mercator), v)
+}
+
+func TestGeoPolygon(t *testing.T) {
+	p := GeoPolygon{
+		Rings: [][]GeoPoint{
+			{{Lng: 0, Lat: 0}, {Lng: 4, Lat: 0}, {Lng: 4, Lat: 4}, {Lng: 0, Lat: 0}},
+			{{Lng: 1, Lat: 1}, {Lng: 2, Lat: 1}, {Lng: 2, Lat: 2}, {Lng: 1, Lat: 1}},
+		},
+	}
+	require.Equal(t, "POLYGON((0 0,4 0,4 4,0 0),(1 1,2 1,2 2,1 1))", p.String())
+	v, err := p.Value()
+	require.NoError(t, err)
+
+	scan := &GeoPolygon{}
+	require.NoError(t, scan.Scan(v))
+	require.Equal(t, p, *scan)
+
+	// SELECT 'SRID=3857;POLYGON((0 0,1 0,1 1,0 0))'::geometry.
+	const ewkb = "0103000020110F0000010000000400000000000000000000000000000000000000000000000000F03F0000000000000000000000000000F03F000000000000F03F00000000000000000000000000000000"
+	require.NoError(t, scan.Scan(ewkb))
+	require.Equal(t, 3857, scan.SRID)
+	require.Equal(t, "POLYGON((0 0,1 0,1 1,0 0))", scan.String())
+	v, err = scan.Value()
+	require.NoError(t, err)
+	require.Equal(t, strings.ToLower(ewkb), v)
+
+	v, err = GeoPolygon{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+	require.NoError(t, scan.Scan(nil))
+	require.Equal(t, GeoPolygon{}, *scan)
+
+	// POINT(1 2).
+	require.Error(t, scan.Scan("0101000020E6100000000000000000F03F0000000000000040"))
+	// Truncated polygon.
+	require.Error(t, scan.Scan(ewkb[:len(ewkb)-32]))
+}
+
+func TestGeometry(t *testing.T) {
+	g := &Geometry{}
+	// SELECT 'SRID=4326;LINESTRING(1 2,3 4)'::geometry.
+	require.NoError(t, g.Scan("0102000020E610000002000000000000000000F03F000000000000004000000000000008400000000000001040"))
+	require.Equal(t, Geometry{WKT: "LINESTRING(1 2,3 4)"}, *g)
+	v, err := g.Value()
+	require.NoError(t, err)
+	require.Equal(t, "SRID=4326;LINESTRING(1 2,3 4)", v)
+
+	// SELECT 'SRID=3857;MULTIPOINT(1 2,3 4)'::geometry.
+	require.NoError(t, g.Scan("0104000020110F0000020000000101000000000000000000F03F0000000000000040010100000000000000000008400000000000001040"))
+	require.Equal(t, Geometry{WKT: "MULTIPOINT((1 2),(3 4))", SRID: 3857}, *g)
+
+	// SELECT 'GEOMETRYCOLLECTION(POINT(1 2),LINESTRING EMPTY)'::geometry.
+	require.NoError(t, g.Scan("0107000000020000000101000000000000000000F03F0000000000000040010200000000000000"))
+	require.Equal(t, Geometry{WKT: "GEOMETRYCOLLECTION(POINT(1 2),LINESTRING EMPTY)"}, *g)
+
+	require.NoError(t, g.Scan("SRID=3857;POINT(1 2)"))
+	require.Equal(t, Geometry{WKT: "POINT(1 2)", SRID: 3857}, *g)
+	require.NoError(t, g.Scan([]byte("POINT(1 2)")))
+	require.Equal(t, Geometry{WKT: "POINT(1 2)"}, *g)
+
+	require.NoError(t, g.Scan(nil))
+	require.Equal(t, Geometry{}, *g)
+	v, err = g.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	require.Error(t, g.Scan("SRID=x;POINT(1 2)"))
+	// POINT Z(1 2 3).
+	require.Error(t, g.Scan("01010000A0E6100000000000000000F03F00000000000000400000000000000840"))
+	require.Error(t, g.Scan(1))
+}
+
+func TestIntersects(t *testing.T) {
+	g := Geometry{WKT: "POINT(1 2)"}
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("places")).
+		Where(Intersects("area", g)).
+		Query()
+	require.Equal(t, `SELECT * FROM "places" WHERE ST_Intersects("area", $1::geometry)`, query)
+	require.Equal(t, []interface{}{g}, args)
+}
+
+func TestWithinDistance(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("places")).
+		Where(And(EQ("name", "a8m"), WithinDistance("location", 40.7128, -74.0060, 1000))).
+		Query()
+	require.Equal(t, `SELECT * FROM "places" WHERE ("name" = $1) AND (ST_DWithin("location"::geography, ST_SetSRID(ST_MakePoint($2, $3), 4326)::geography, $4))`, query)
+	require.Equal(t, []interface{}{"a8m", -74.0060, 40.7128, float64(1000)}, args)
+}
@@ -0,0 +1,206 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// IP represents an IP address that is stored in a PostgreSQL inet column, or as
+// a string in other dialects. It implements the sql.Scanner and driver.Valuer
+// interfaces, and it is the Go type of the field.IP fields.
+//
+//	field.IP("address")
+//
+type IP struct {
+	net.IP
+}
+
+// ParseIP parses s as an IPv4 or an IPv6 address.
+func ParseIP(s string) (IP, error) {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return IP{}, fmt.Errorf("sql: invalid IP address %q", s)
+	}
+	return IP{IP: ip}, nil
+}
+
+// Value implements the driver.Valuer interface.
+func (ip IP) Value() (driver.Value, error) {
+	if ip.IP == nil {
+		return nil, nil
+	}
+	return ip.IP.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. Addresses with a netmask (e.g. an
+// inet value like '10.0.0.1/8') are scanned without their netmask.
+func (ip *IP) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*ip = IP{}
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("sql: unexpected type %T for IP", src)
+	}
+	if i := strings.IndexByte(s, '/'); i != -1 {
+		s = s[:i]
+	}
+	v, err := ParseIP(s)
+	if err != nil {
+		return err
+	}
+	*ip = v
+	return nil
+}
+
+// CIDR represents an IP network that is stored in a PostgreSQL cidr column, or
+// as a string in other dialects. It implements the sql.Scanner and driver.Valuer
+// interfaces, and it is the Go type of the field.CIDR fields.
+//
+//	field.CIDR("subnet")
+//
+type CIDR struct {
+	net.IPNet
+}
+
+// ParseCIDR parses s as an IP network in CIDR notation (e.g. "10.0.0.0/8").
+func ParseCIDR(s string) (CIDR, error) {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return CIDR{}, fmt.Errorf("sql: invalid CIDR network %q", s)
+	}
+	return CIDR{IPNet: *n}, nil
+}
+
+// Value implements the driver.Valuer interface.
+func (c CIDR) Value() (driver.Value, error) {
+	if c.IP == nil {
+		return nil, nil
+	}
+	return c.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *CIDR) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*c = CIDR{}
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("sql: unexpected type %T for CIDR", src)
+	}
+	v, err := ParseCIDR(s)
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (c CIDR) String() string {
+	if c.IP == nil {
+		return ""
+	}
+	return c.IPNet.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c CIDR) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *CIDR) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*c = CIDR{}
+		return nil
+	}
+	v, err := ParseCIDR(string(text))
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
+
+// InetWithin returns a predicate that checks if the address (or the network) stored in the
+// given inet or cidr column is within the given network, or equal to it. Network operators
+// are supported only by PostgreSQL.
+//
+//	InetWithin("address", "10.0.0.0/8")
+//
+func InetWithin(col, network string) *Predicate {
+	return (&Predicate{}).InetWithin(col, network)
+}
+
+// InetWithin returns a predicate that checks if the address (or the network) stored
+// in the given inet or cidr column is within the given network, or equal to it.
+func (p *Predicate) InetWithin(col, network string) *Predicate {
+	return p.append(func(b *Builder) {
+		b.Ident(col).WriteString(" <<= ")
+		b.Arg(network).WriteString("::inet")
+	})
+}
+
+// InetContains returns a predicate that checks if the network stored in the given inet or
+// cidr column contains the given address (or network), or is equal to it. Network operators
+// are supported only by PostgreSQL.
+//
+//	InetContains("subnet", "10.1.2.3")
+//
+func InetContains(col, addr string) *Predicate {
+	return (&Predicate{}).InetContains(col, addr)
+}
+
+// InetContains returns a predicate that checks if the network stored in the given
+// inet or cidr column contains the given address (or network), or is equal to it.
+func (p *Predicate) InetContains(col, addr string) *Predicate {
+	return p.append(func(b *Builder) {
+		b.Ident(col).WriteString(" >>= ")
+		b.Arg(addr).WriteString("::inet")
+	})
+}
+
+// InetFamily returns a predicate that checks if the address stored in the given column
+// belongs to the given family (4 for IPv4 and 6 for IPv6). In PostgreSQL, it uses the
+// family function, and in other dialects, it checks if the address contains a colon.
+//
+//	InetFamily("address", 6)
+//
+func InetFamily(col string, family int) *Predicate {
+	return (&Predicate{}).InetFamily(col, family)
+}
+
+// InetFamily returns a predicate that checks if the address stored
+// in the given column belongs to the given family (4 or 6).
+func (p *Predicate) InetFamily(col string, family int) *Predicate {
+	return p.append(func(b *Builder) {
+		switch {
+		case b.postgres():
+			b.WriteString("family(")
+			b.Ident(col).WriteString(") = ")
+			b.Arg(family)
+		case family == 6:
+			b.Ident(col).WriteString(" LIKE '%:%'")
+		default:
+			b.Ident(col).WriteString(" NOT LIKE '%:%'")
+		}
+	})
+}
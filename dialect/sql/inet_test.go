@@ -0,0 +1,85 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"encoding/json"
+	"net"
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIP(t *testing.T) {
+	ip, err := ParseIP("10.0.0.1")
+	require.NoError(t, err)
+	v, err := ip.Value()
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", v)
+	v, err = IP{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	require.NoError(t, ip.Scan([]byte("2001:db8::1")))
+	require.True(t, net.ParseIP("2001:db8::1").Equal(ip.IP))
+	require.NoError(t, ip.Scan("10.0.0.1/8"))
+	require.Equal(t, "10.0.0.1", ip.String())
+	require.NoError(t, ip.Scan(nil))
+	require.Nil(t, ip.IP)
+	require.Error(t, ip.Scan("10.0.0"))
+	require.Error(t, ip.Scan(1))
+	_, err = ParseIP("a8m")
+	require.Error(t, err)
+
+	buf, err := json.Marshal(struct{ IP IP }{IP: IP{IP: net.ParseIP("::1")}})
+	require.NoError(t, err)
+	require.Equal(t, `{"IP":"::1"}`, string(buf))
+}
+
+func TestCIDR(t *testing.T) {
+	c, err := ParseCIDR("10.1.2.3/8")
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.0/8", c.String())
+	v, err := c.Value()
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.0/8", v)
+	v, err = CIDR{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	require.NoError(t, c.Scan([]byte("2001:db8::/32")))
+	require.Equal(t, "2001:db8::/32", c.String())
+	require.NoError(t, c.Scan(nil))
+	require.Equal(t, "", c.String())
+	require.Error(t, c.Scan("10.0.0.1"))
+	require.Error(t, c.Scan(1))
+
+	var s struct{ Net CIDR }
+	require.NoError(t, json.Unmarshal([]byte(`{"Net":"192.168.0.0/16"}`), &s))
+	require.Equal(t, "192.168.0.0/16", s.Net.String())
+	buf, err := json.Marshal(s)
+	require.NoError(t, err)
+	require.Equal(t, `{"Net":"192.168.0.0/16"}`, string(buf))
+}
+
+func TestInetPredicates(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("hosts")).
+		Where(And(InetWithin("address", "10.0.0.0/8"), InetContains("subnet", "10.1.2.3"), InetFamily("address", 4))).
+		Query()
+	require.Equal(t, `SELECT * FROM "hosts" WHERE ("address" <<= $1::inet) AND ("subnet" >>= $2::inet) AND (family("address") = $3)`, query)
+	require.Equal(t, []interface{}{"10.0.0.0/8", "10.1.2.3", 4}, args)
+
+	query, args = Dialect(dialect.SQLite).
+		Select("*").
+		From(Table("hosts")).
+		Where(Or(InetFamily("address", 4), InetFamily("address", 6))).
+		Query()
+	require.Equal(t, "SELECT * FROM `hosts` WHERE ((`address` NOT LIKE '%:%') OR (`address` LIKE '%:%'))", query)
+	require.Empty(t, args)
+}
@@ -0,0 +1,177 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// NullDuration represents a time.Duration that may be null. It scans PostgreSQL interval
+// values (in their default output style), and integers of nanoseconds that are used for
+// storing durations in other dialects. It is used by the generated code for scanning
+// field.Duration fields.
+type NullDuration struct {
+	Duration time.Duration
+	Valid    bool // Valid is true if Duration is not NULL.
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullDuration) Scan(src interface{}) error {
+	var (
+		s   string
+		err error
+	)
+	switch src := src.(type) {
+	case nil:
+		n.Duration, n.Valid = 0, false
+		return nil
+	case int64:
+		n.Duration, n.Valid = time.Duration(src), true
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("sql: unexpected type %T for duration", src)
+	}
+	if i, perr := strconv.ParseInt(s, 10, 64); perr == nil {
+		n.Duration, n.Valid = time.Duration(i), true
+		return nil
+	}
+	if n.Duration, err = ParseInterval(s); err != nil {
+		return err
+	}
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullDuration) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Duration), nil
+}
+
+// DurationValue returns a driver.Valuer that stores the given duration in a duration field of the
+// given dialect: an interval in PostgreSQL, and an integer of nanoseconds in other dialects. It is
+// used by the generated code for writing and querying field.Duration fields.
+func DurationValue(dialect string, d time.Duration) driver.Valuer {
+	return &durationValue{dialect: dialect, d: d}
+}
+
+type durationValue struct {
+	dialect string
+	d       time.Duration
+}
+
+// Value implements the driver.Valuer interface.
+func (v *durationValue) Value() (driver.Value, error) {
+	if v.dialect == dialect.Postgres {
+		return FormatInterval(v.d), nil
+	}
+	return int64(v.d), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (v *durationValue) String() string { return v.d.String() }
+
+// The number of days in month and year units of intervals, as defined by PostgreSQL.
+const (
+	daysPerMonth = 30
+	daysPerYear  = 365.25
+)
+
+// ParseInterval parses a PostgreSQL interval in its default output style (e.g. "1 day 02:03:04.5"
+// or "-00:00:01"). Months are counted as 30 days, and years as 365.25 days, like PostgreSQL does
+// when it extracts the epoch of an interval.
+func ParseInterval(s string) (time.Duration, error) {
+	var (
+		d      time.Duration
+		fields = strings.Fields(s)
+	)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("sql: invalid interval %q", s)
+	}
+	for i := 0; i < len(fields); i++ {
+		if strings.Contains(fields[i], ":") {
+			t, err := parseIntervalTime(fields[i])
+			if err != nil {
+				return 0, fmt.Errorf("sql: invalid interval %q", s)
+			}
+			d += t
+			continue
+		}
+		if i+1 == len(fields) {
+			return 0, fmt.Errorf("sql: invalid interval %q", s)
+		}
+		n, err := strconv.ParseInt(fields[i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("sql: invalid interval %q", s)
+		}
+		i++
+		day := 24 * time.Hour
+		switch strings.TrimSuffix(fields[i], "s") {
+		case "year":
+			d += time.Duration(float64(n) * daysPerYear * float64(day))
+		case "mon":
+			d += time.Duration(n) * daysPerMonth * day
+		case "day":
+			d += time.Duration(n) * day
+		default:
+			return 0, fmt.Errorf("sql: unexpected unit %q in interval %q", fields[i], s)
+		}
+	}
+	return d, nil
+}
+
+// parseIntervalTime parses the time part of an interval (e.g. "-02:03:04.000005").
+func parseIntervalTime(s string) (time.Duration, error) {
+	neg := strings.HasPrefix(s, "-")
+	parts := strings.Split(strings.TrimLeft(s, "+-"), ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("unexpected time format %q", s)
+	}
+	h, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	sec, err := time.ParseDuration(parts[2] + "s")
+	if err != nil {
+		return 0, err
+	}
+	d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + sec
+	if neg {
+		d = -d
+	}
+	return d, nil
+}
+
+// FormatInterval formats the given duration as a PostgreSQL interval input (e.g. "-26:03:04.000005").
+// Intervals have a resolution of microseconds, and therefore, nanoseconds are truncated.
+func FormatInterval(d time.Duration) string {
+	var sign string
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	d = d.Truncate(time.Microsecond)
+	h, m := d/time.Hour, d%time.Hour/time.Minute
+	s, us := d%time.Minute/time.Second, d%time.Second/time.Microsecond
+	if us == 0 {
+		return fmt.Sprintf("%s%02d:%02d:%02d", sign, h, m, s)
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, h, m, s, us)
+}
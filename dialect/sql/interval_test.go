@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullDuration(t *testing.T) {
+	var d NullDuration
+	require.NoError(t, d.Scan(int64(time.Second)))
+	require.True(t, d.Valid)
+	require.Equal(t, time.Second, d.Duration)
+	require.NoError(t, d.Scan([]byte("60000000000")))
+	require.Equal(t, time.Minute, d.Duration)
+	require.NoError(t, d.Scan("1 day 02:03:04.5"))
+	require.Equal(t, 26*time.Hour+3*time.Minute+4500*time.Millisecond, d.Duration)
+	v, err := d.Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(d.Duration), v)
+	require.NoError(t, d.Scan(nil))
+	require.False(t, d.Valid)
+	v, err = d.Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+	require.Error(t, d.Scan("1 week"))
+	require.Error(t, d.Scan(1.5))
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"00:00:00", 0},
+		{"00:05:00", 5 * time.Minute},
+		{"-00:00:01", -time.Second},
+		{"100:00:00.000001", 100*time.Hour + time.Microsecond},
+		{"1 day", 24 * time.Hour},
+		{"-1 days -02:00:00", -26 * time.Hour},
+		{"1 mon 2 days", 32 * 24 * time.Hour},
+		{"1 year", 8766 * time.Hour},
+	}
+	for _, tt := range tests {
+		d, err := ParseInterval(tt.in)
+		require.NoError(t, err, tt.in)
+		require.Equal(t, tt.want, d, tt.in)
+	}
+	for _, s := range []string{"", "1", "1 day 2", "1:2", "a day", "00:00:xx"} {
+		_, err := ParseInterval(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestFormatInterval(t *testing.T) {
+	require.Equal(t, "00:00:00", FormatInterval(0))
+	require.Equal(t, "01:30:00", FormatInterval(90*time.Minute))
+	require.Equal(t, "36:00:00.001500", FormatInterval(36*time.Hour+1500*time.Microsecond+999))
+	require.Equal(t, "-00:00:01", FormatInterval(-time.Second))
+	for _, d := range []time.Duration{time.Microsecond, -90 * time.Hour, 26*time.Hour + 3*time.Second} {
+		v, err := ParseInterval(FormatInterval(d))
+		require.NoError(t, err)
+		require.Equal(t, d, v)
+	}
+}
+
+func TestDurationValue(t *testing.T) {
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("notes")).
+		Where(GT("ttl", DurationValue(dialect.Postgres, 5*time.Minute))).
+		Query()
+	require.Equal(t, `SELECT * FROM "notes" WHERE "ttl" > $1`, query)
+	require.Len(t, args, 1)
+	v, err := args[0].(driver.Valuer).Value()
+	require.NoError(t, err)
+	require.Equal(t, "00:05:00", v)
+	v, err = DurationValue(dialect.MySQL, 5*time.Minute).Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(5*time.Minute), v)
+
+	// Durations that are not values of duration fields are passed as is.
+	_, args = Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("jobs")).
+		Where(GT("timeout_ns", 5*time.Minute)).
+		Query()
+	require.Equal(t, []interface{}{5 * time.Minute}, args)
+}
@@ -0,0 +1,194 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+type (
+	// MetricsCollector collects the metrics of the statements that are executed by the
+	// MetricsDriver. Collect is called synchronously after each statement, and therefore,
+	// it should be fast. It can be implemented by a thin adapter on top of metrics libraries,
+	// like Prometheus. For example:
+	//
+	//	type collector struct {
+	//		duration *prometheus.HistogramVec
+	//		errors   *prometheus.CounterVec
+	//	}
+	//
+	//	func (c collector) Collect(_ context.Context, m sql.Metric) {
+	//		c.duration.WithLabelValues(m.Entity, m.Operation, m.Method).Observe(m.Duration.Seconds())
+	//		if m.Err != nil {
+	//			c.errors.WithLabelValues(m.Entity, m.Operation, string(m.ErrorType)).Inc()
+	//		}
+	//	}
+	//
+	MetricsCollector interface {
+		Collect(context.Context, Metric)
+	}
+
+	// Metric holds the metrics of a single statement.
+	Metric struct {
+		// Entity and Operation hold the entity and the operation that executed
+		// the statement (e.g. "User" and "Create"). See WithOperation for more info.
+		Entity, Operation string
+		// Method is the driver method that executed the statement ("Query" or "Exec").
+		Method string
+		// Duration of the statement execution.
+		Duration time.Duration
+		// RowsAffected holds the number of rows that were affected by
+		// Exec statements, or -1 if it is not known.
+		RowsAffected int64
+		// Err is the error that the statement failed with, and
+		// ErrorType is its classification.
+		Err       error
+		ErrorType ErrorType
+	}
+
+	// ErrorType is the classification of statement errors.
+	ErrorType string
+)
+
+// Error types of failed statements.
+const (
+	UniqueError     ErrorType = "unique"
+	ForeignKeyError ErrorType = "foreign_key"
+	NotNullError    ErrorType = "not_null"
+	CheckError      ErrorType = "check"
+	CanceledError   ErrorType = "canceled"
+	UnknownError    ErrorType = "unknown"
+)
+
+// errorTypes holds the error format per dialect of each error type.
+var errorTypes = [...]struct {
+	typ  ErrorType
+	msgs []string
+}{
+	{UniqueError, []string{"Error 1062", "UNIQUE constraint failed", "violates unique constraint"}},
+	{ForeignKeyError, []string{"Error 1451", "Error 1452", "FOREIGN KEY constraint failed", "violates foreign key constraint"}},
+	{NotNullError, []string{"Error 1048", "NOT NULL constraint failed", "violates not-null constraint"}},
+	{CheckError, []string{"Error 3819", "CHECK constraint failed", "violates check constraint"}},
+}
+
+// ClassifyError returns the type of the given statement error, or an empty string if it is nil.
+func ClassifyError(err error) ErrorType {
+	if err == nil {
+		return ""
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return CanceledError
+	}
+	msg := err.Error()
+	for _, t := range errorTypes {
+		for _, m := range t.msgs {
+			if strings.Contains(msg, m) {
+				return t.typ
+			}
+		}
+	}
+	return UnknownError
+}
+
+// MetricsDriver is a driver that reports the metrics of each statement that is
+// executed by the underlying driver (or by its transactions) to a collector.
+type MetricsDriver struct {
+	dialect.Driver                  // underlying driver.
+	collector      MetricsCollector // metrics collector.
+}
+
+// Metrics gets a driver and a collector, and returns a new driver that reports
+// the metrics of the statements that are executed by it to the collector.
+func Metrics(d dialect.Driver, c MetricsCollector) dialect.Driver {
+	return &MetricsDriver{d, c}
+}
+
+// Exec calls the underlying driver Exec method and collects its metrics.
+func (d *MetricsDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	start := time.Now()
+	err := d.Driver.Exec(ctx, query, args, v)
+	d.collect(ctx, "Exec", start, v, err)
+	return err
+}
+
+// Query calls the underlying driver Query method and collects its metrics.
+func (d *MetricsDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	start := time.Now()
+	err := d.Driver.Query(ctx, query, args, v)
+	d.collect(ctx, "Query", start, nil, err)
+	return err
+}
+
+// Tx starts a transaction that collects the metrics of its statements.
+func (d *MetricsDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &MetricsTx{tx, d}, nil
+}
+
+// BeginTx starts a transaction with options that collects the metrics of its statements.
+// It fails if the underlying driver does not support transaction options.
+func (d *MetricsDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: Driver.BeginTx is not supported by %T", d.Driver)
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &MetricsTx{tx, d}, nil
+}
+
+// collect reports the metrics of a statement to the collector.
+func (d *MetricsDriver) collect(ctx context.Context, method string, start time.Time, v interface{}, err error) {
+	m := Metric{Method: method, Duration: time.Since(start), RowsAffected: -1, Err: err}
+	if op, ok := ctx.Value(operationKey{}).(operation); ok {
+		m.Entity, m.Operation = op.entity, op.op
+	}
+	if err != nil {
+		m.ErrorType = ClassifyError(err)
+	} else if res, ok := v.(*Result); ok && *res != nil {
+		if n, err := (*res).RowsAffected(); err == nil {
+			m.RowsAffected = n
+		}
+	}
+	d.collector.Collect(ctx, m)
+}
+
+// MetricsTx is a transaction implementation that collects the metrics of its statements.
+// Statements that are executed in the transaction are collected only once, by the transaction.
+type MetricsTx struct {
+	dialect.Tx                // underlying transaction.
+	drv        *MetricsDriver // driver that started the transaction.
+}
+
+// Exec calls the underlying transaction Exec method and collects its metrics.
+func (t *MetricsTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	start := time.Now()
+	err := t.Tx.Exec(ctx, query, args, v)
+	t.drv.collect(ctx, "Exec", start, v, err)
+	return err
+}
+
+// Query calls the underlying transaction Query method and collects its metrics.
+func (t *MetricsTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	start := time.Now()
+	err := t.Tx.Query(ctx, query, args, v)
+	t.drv.collect(ctx, "Query", start, nil, err)
+	return err
+}
+
+var _ dialect.Driver = (*MetricsDriver)(nil)
@@ -0,0 +1,106 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockCollector struct{ metrics []Metric }
+
+func (c *mockCollector) Collect(_ context.Context, m Metric) {
+	c.metrics = append(c.metrics, m)
+}
+
+func TestMetrics(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	collector := &mockCollector{}
+	drv := Metrics(OpenDB("mysql", db), collector)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `age` = ?")).
+		WithArgs(30).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	var res Result
+	require.NoError(t, drv.Exec(WithOperation(ctx, "User", "Update"), "UPDATE `users` SET `age` = ?", []interface{}{30}, &res))
+	require.Len(t, collector.metrics, 1)
+	m := collector.metrics[0]
+	require.Equal(t, "User", m.Entity)
+	require.Equal(t, "Update", m.Operation)
+	require.Equal(t, "Exec", m.Method)
+	require.Equal(t, int64(2), m.RowsAffected)
+	require.NoError(t, m.Err)
+	require.Empty(t, m.ErrorType)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `users`")).
+		WillReturnError(errors.New("bad conn"))
+	err = drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, &Rows{})
+	require.Error(t, err)
+	m = collector.metrics[1]
+	require.Empty(t, m.Entity)
+	require.Equal(t, "Query", m.Method)
+	require.Equal(t, int64(-1), m.RowsAffected)
+	require.Equal(t, err, m.Err)
+	require.Equal(t, UnknownError, m.ErrorType)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `users` (`name`) VALUES (?)")).
+		WithArgs("a8m").
+		WillReturnError(errors.New("Error 1062: Duplicate entry 'a8m' for key 'name'"))
+	mock.ExpectRollback()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	err = tx.Exec(WithOperation(ctx, "User", "Create"), "INSERT INTO `users` (`name`) VALUES (?)", []interface{}{"a8m"}, nil)
+	require.Error(t, err)
+	require.NoError(t, tx.Rollback())
+	require.Len(t, collector.metrics, 3, "transaction statements are collected once")
+	m = collector.metrics[2]
+	require.Equal(t, "Create", m.Operation)
+	require.Equal(t, UniqueError, m.ErrorType)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want ErrorType
+	}{
+		{nil, ""},
+		{errors.New("UNIQUE constraint failed: users.name"), UniqueError},
+		{errors.New(`pq: duplicate key value violates unique constraint "users_name_key"`), UniqueError},
+		{errors.New("Error 1452: Cannot add or update a child row: a foreign key constraint fails"), ForeignKeyError},
+		{errors.New("FOREIGN KEY constraint failed"), ForeignKeyError},
+		{errors.New(`pq: null value in column "name" violates not-null constraint`), NotNullError},
+		{errors.New("CHECK constraint failed: age"), CheckError},
+		{fmt.Errorf("querying: %w", context.DeadlineExceeded), CanceledError},
+		{errors.New("bad conn"), UnknownError},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, ClassifyError(tt.err))
+	}
+}
+
+func TestMetrics_Allocs(t *testing.T) {
+	drv := &MetricsDriver{collector: nopCollector{}}
+	ctx := WithOperation(context.Background(), "User", "Query")
+	start := time.Now()
+	allocs := testing.AllocsPerRun(100, func() {
+		drv.collect(ctx, "Query", start, nil, nil)
+	})
+	require.Zero(t, allocs)
+}
+
+type nopCollector struct{}
+
+func (nopCollector) Collect(context.Context, Metric) {}
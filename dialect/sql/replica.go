@@ -0,0 +1,111 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// ReadConsistency defines the consistency level of read queries. It is carried by the
+// context of the queries, and it is used by drivers that route queries to replicas
+// (like the ReplicaDriver) for deciding which connection executes them.
+type ReadConsistency uint
+
+const (
+	// ReadEventual allows the query to be executed by a replica, and
+	// therefore, it may not reflect recent writes. This is the default.
+	ReadEventual ReadConsistency = iota
+	// ReadPrimary forces the query to be executed by the primary
+	// database, for reading the writes that were committed to it.
+	ReadPrimary
+)
+
+// String implements the fmt.Stringer interface.
+func (c ReadConsistency) String() string {
+	switch c {
+	case ReadEventual:
+		return "eventual"
+	case ReadPrimary:
+		return "primary"
+	default:
+		return fmt.Sprintf("ReadConsistency(%d)", c)
+	}
+}
+
+type consistencyKey struct{}
+
+// WithReadConsistency returns a new context that carries the given read consistency level.
+// Use WithReadConsistency(ctx, ReadPrimary) for forcing all queries that are executed with
+// the context to the primary database, without changing the client.
+func WithReadConsistency(ctx context.Context, c ReadConsistency) context.Context {
+	return context.WithValue(ctx, consistencyKey{}, c)
+}
+
+// ReadConsistencyFrom returns the read consistency level that is carried by the context,
+// or ReadEventual if there is none.
+func ReadConsistencyFrom(ctx context.Context) ReadConsistency {
+	c, _ := ctx.Value(consistencyKey{}).(ReadConsistency)
+	return c
+}
+
+// ReplicaDriver is a driver that routes the read queries to the replicas (in round-robin),
+// and the writes and transactions to the primary database. Queries that require a stronger
+// consistency than ReadEventual (see WithReadConsistency) are executed by the primary.
+type ReplicaDriver struct {
+	dialect.Driver                  // primary driver.
+	replicas       []dialect.Driver // read replicas.
+	next           uint32           // next replica index.
+}
+
+// Replica gets a primary driver and its read replicas, and returns a new driver that
+// routes the read queries to the replicas. The dialect of the driver is the dialect
+// of the primary, and therefore, the replicas must share it.
+func Replica(primary dialect.Driver, replicas ...dialect.Driver) dialect.Driver {
+	return &ReplicaDriver{Driver: primary, replicas: replicas}
+}
+
+// Query executes the query on one of the replicas, or on the primary
+// database if there are no replicas or if the context requires it.
+func (d *ReplicaDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.reader(ctx).Query(ctx, query, args, v)
+}
+
+// reader returns the driver that executes read queries with the given context.
+func (d *ReplicaDriver) reader(ctx context.Context) dialect.Driver {
+	if len(d.replicas) == 0 || ReadConsistencyFrom(ctx) != ReadEventual {
+		return d.Driver
+	}
+	i := atomic.AddUint32(&d.next, 1) - 1
+	return d.replicas[i%uint32(len(d.replicas))]
+}
+
+// BeginTx starts a transaction with options on the primary database.
+// It fails if the primary driver does not support transaction options.
+func (d *ReplicaDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: Driver.BeginTx is not supported by %T", d.Driver)
+	}
+	return drv.BeginTx(ctx, opts)
+}
+
+// Close closes the connections of the primary database and the replicas.
+func (d *ReplicaDriver) Close() error {
+	err := d.Driver.Close()
+	for _, r := range d.replicas {
+		if cerr := r.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+var _ dialect.Driver = (*ReplicaDriver)(nil)
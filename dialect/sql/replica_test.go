@@ -0,0 +1,76 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplica(t *testing.T) {
+	var (
+		dbs   = make([]sqlmock.Sqlmock, 3)
+		drvs  = make([]*Driver, 3)
+		query = "SELECT `id` FROM `users`"
+		ctx   = context.Background()
+	)
+	for i := range dbs {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		dbs[i], drvs[i] = mock, OpenDB("mysql", db)
+	}
+	primary, r1, r2 := dbs[0], dbs[1], dbs[2]
+	drv := Replica(drvs[0], drvs[1], drvs[2])
+	require.Equal(t, "mysql", drv.Dialect())
+
+	// Queries are executed by the replicas in round-robin.
+	r1.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	r2.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	r1.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	for i := 0; i < 3; i++ {
+		rows := &Rows{}
+		require.NoError(t, drv.Query(ctx, query, []interface{}{}, rows))
+		require.NoError(t, rows.Close())
+	}
+
+	// Writes, transactions and primary reads are executed by the primary.
+	primary.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `age` = ?")).
+		WithArgs(1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	primary.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	primary.ExpectBegin()
+	primary.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	primary.ExpectCommit()
+	require.NoError(t, drv.Exec(ctx, "UPDATE `users` SET `age` = ?", []interface{}{1}, nil))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(WithReadConsistency(ctx, ReadPrimary), query, []interface{}{}, rows))
+	require.NoError(t, rows.Close())
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	rows = &Rows{}
+	require.NoError(t, tx.Query(ctx, query, []interface{}{}, rows))
+	require.NoError(t, rows.Close())
+	require.NoError(t, tx.Commit())
+
+	for _, mock := range dbs {
+		mock.ExpectClose()
+	}
+	require.NoError(t, drv.Close())
+	for _, mock := range dbs {
+		require.NoError(t, mock.ExpectationsWereMet())
+	}
+}
+
+func TestReadConsistencyFrom(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, ReadEventual, ReadConsistencyFrom(ctx))
+	ctx = WithReadConsistency(ctx, ReadPrimary)
+	require.Equal(t, ReadPrimary, ReadConsistencyFrom(ctx))
+	require.Equal(t, "primary", ReadPrimary.String())
+}
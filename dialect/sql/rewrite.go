@@ -0,0 +1,119 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// RewriteFunc gets a statement and its arguments after they were built by ent,
+// and returns the statement and arguments that are sent to the database instead.
+// Note that the returned arguments must match the placeholders of the returned
+// statement, since the database may execute it as a prepared statement.
+type RewriteFunc func(ctx context.Context, query string, args []interface{}) (string, []interface{})
+
+// RewriteDriver is a driver that rewrites all statements
+// before passing them to the underlying driver.
+type RewriteDriver struct {
+	dialect.Driver             // underlying driver.
+	rewrite        RewriteFunc // rewrite function.
+}
+
+// Rewrite gets a driver and a rewrite function, and returns a new driver
+// that applies the function on all statements (and their arguments) that
+// are executed by the driver or by its transactions.
+//
+//	drv := sql.Rewrite(drv, func(ctx context.Context, query string, args []interface{}) (string, []interface{}) {
+//		return "/* app=api */ " + query, args
+//	})
+//
+func Rewrite(d dialect.Driver, fn RewriteFunc) dialect.Driver {
+	return &RewriteDriver{d, fn}
+}
+
+// Exec rewrites its params and calls the underlying driver Exec method.
+func (d *RewriteDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	query, args, err := rewrite(ctx, d.rewrite, query, args)
+	if err != nil {
+		return err
+	}
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+// Query rewrites its params and calls the underlying driver Query method.
+func (d *RewriteDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	query, args, err := rewrite(ctx, d.rewrite, query, args)
+	if err != nil {
+		return err
+	}
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+// Tx starts a transaction that rewrites all its statements.
+func (d *RewriteDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &RewriteTx{tx, d.rewrite}, nil
+}
+
+// BeginTx starts a transaction with options that rewrites all its statements.
+// It fails if the underlying driver does not support transaction options.
+func (d *RewriteDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: Driver.BeginTx is not supported by %T", d.Driver)
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &RewriteTx{tx, d.rewrite}, nil
+}
+
+// RewriteTx is a transaction implementation that rewrites all its statements.
+type RewriteTx struct {
+	dialect.Tx             // underlying transaction.
+	rewrite    RewriteFunc // rewrite function.
+}
+
+// Exec rewrites its params and calls the underlying transaction Exec method.
+func (t *RewriteTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	query, args, err := rewrite(ctx, t.rewrite, query, args)
+	if err != nil {
+		return err
+	}
+	return t.Tx.Exec(ctx, query, args, v)
+}
+
+// Query rewrites its params and calls the underlying transaction Query method.
+func (t *RewriteTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	query, args, err := rewrite(ctx, t.rewrite, query, args)
+	if err != nil {
+		return err
+	}
+	return t.Tx.Query(ctx, query, args, v)
+}
+
+func rewrite(ctx context.Context, fn RewriteFunc, query string, args interface{}) (string, interface{}, error) {
+	var argv []interface{}
+	if args != nil {
+		v, ok := args.([]interface{})
+		if !ok {
+			return "", nil, fmt.Errorf("dialect/sql: invalid type %T. expect []interface{} for args", args)
+		}
+		argv = v
+	}
+	query, argv = fn(ctx, query, argv)
+	return query, argv, nil
+}
+
+var _ dialect.Driver = (*RewriteDriver)(nil)
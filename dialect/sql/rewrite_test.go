@@ -0,0 +1,50 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewrite(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := Rewrite(OpenDB("mysql", db), func(_ context.Context, query string, args []interface{}) (string, []interface{}) {
+		return "/* app=test */ " + query, append(args, "a8m")
+	})
+	require.Equal(t, "mysql", drv.Dialect())
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("/* app=test */ UPDATE `users` SET `age` = ? WHERE `name` = ?")).
+		WithArgs(30, "a8m").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, drv.Exec(ctx, "UPDATE `users` SET `age` = ? WHERE `name` = ?", []interface{}{30}, nil))
+
+	mock.ExpectQuery(regexp.QuoteMeta("/* app=test */ SELECT `id` FROM `users` WHERE `name` = ?")).
+		WithArgs("a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT `id` FROM `users` WHERE `name` = ?", []interface{}{}, rows))
+	require.NoError(t, rows.Close())
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("/* app=test */ DELETE FROM `users` WHERE `name` = ?")).
+		WithArgs("a8m").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	tx, err := drv.(*RewriteDriver).BeginTx(ctx, &TxOptions{})
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM `users` WHERE `name` = ?", []interface{}{}, nil))
+	require.NoError(t, tx.Commit())
+
+	err = drv.Exec(ctx, "DELETE FROM `users`", []string{"a8m"}, nil)
+	require.EqualError(t, err, "dialect/sql: invalid type []string. expect []interface{} for args")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
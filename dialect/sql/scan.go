@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
 // ColumnScanner is the interface that wraps the
@@ -20,6 +21,23 @@ type ColumnScanner interface {
 	Err() error
 }
 
+// NullScanner wraps a sql.Scanner and records if the scanned value was NULL,
+// similar to sql.NullString. It is used by the generated code for scanning
+// optional fields with custom Go types.
+type NullScanner struct {
+	S     sql.Scanner
+	Valid bool // Valid is true if the scanned value is not NULL.
+}
+
+// Scan implements the Scanner interface.
+func (n *NullScanner) Scan(value interface{}) error {
+	n.Valid = value != nil
+	if n.Valid {
+		return n.S.Scan(value)
+	}
+	return nil
+}
+
 // ScanOne scans one row to the given value. It fails if the rows holds more than 1 row.
 func ScanOne(rows ColumnScanner, v interface{}) error {
 	columns, err := rows.Columns()
@@ -116,11 +134,18 @@ func (r *rowScan) values() []interface{} {
 	return values
 }
 
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
 // scanType returns rowScan for the given reflect.Type.
 func scanType(typ reflect.Type, columns []string) (*rowScan, error) {
 	switch k := typ.Kind(); {
 	case k == reflect.Interface && typ.NumMethod() == 0:
 		fallthrough // interface{}
+	case k == reflect.Struct && (typ == timeType || reflect.PtrTo(typ).Implements(scannerType)):
+		fallthrough // single column values, like time.Time or sql.NullString.
 	case k == reflect.String || k >= reflect.Bool && k <= reflect.Float64:
 		return &rowScan{
 			columns: []reflect.Type{typ},
@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
@@ -126,6 +127,23 @@ func TestScanSlicePtr(t *testing.T) {
 	require.Equal(t, "nati", **v2[1].Name)
 }
 
+func TestScanSliceScanner(t *testing.T) {
+	now := time.Now().UTC()
+	mock := sqlmock.NewRows([]string{"created_at"}).
+		AddRow(now).
+		AddRow(now.Add(time.Hour))
+	var v0 []time.Time
+	require.NoError(t, ScanSlice(toRows(mock), &v0))
+	require.Equal(t, []time.Time{now, now.Add(time.Hour)}, v0)
+
+	mock = sqlmock.NewRows([]string{"name"}).
+		AddRow("a8m").
+		AddRow(nil)
+	var v1 []sql.NullString
+	require.NoError(t, ScanSlice(toRows(mock), &v1))
+	require.Equal(t, []sql.NullString{{String: "a8m", Valid: true}, {}}, v1)
+}
+
 func TestScanInt64(t *testing.T) {
 	mock := sqlmock.NewRows([]string{"age"}).
 		AddRow("10").
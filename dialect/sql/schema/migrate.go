@@ -59,14 +59,46 @@ func WithFixture(b bool) MigrateOption {
 	}
 }
 
+// WithPreHook adds raw SQL statements (e.g. `CREATE EXTENSION`) that are executed
+// in the migration transaction before the tables are created or altered, only if the
+// migration runs on the given dialect. Hooks are executed in the order they were added.
+//
+// Note that the migration runs on every startup, and therefore, the statements must be
+// idempotent. For example, `CREATE EXTENSION IF NOT EXISTS`.
+func WithPreHook(dialect string, stmts ...string) MigrateOption {
+	return func(m *Migrate) {
+		m.preHooks = append(m.preHooks, &rawHook{dialect: dialect, stmts: stmts})
+	}
+}
+
+// WithPostHook adds raw SQL statements (e.g. `CREATE TRIGGER`) that are executed in
+// the migration transaction after the tables and their foreign-keys were created or
+// altered, only if the migration runs on the given dialect. Hooks are executed in the
+// order they were added, and like WithPreHook, their statements must be idempotent.
+// For example, `CREATE OR REPLACE TRIGGER` (PostgreSQL 14) or `DROP TRIGGER IF EXISTS`
+// followed by `CREATE TRIGGER`.
+func WithPostHook(dialect string, stmts ...string) MigrateOption {
+	return func(m *Migrate) {
+		m.postHooks = append(m.postHooks, &rawHook{dialect: dialect, stmts: stmts})
+	}
+}
+
+// rawHook holds the raw SQL statements of a migration hook.
+type rawHook struct {
+	dialect string
+	stmts   []string
+}
+
 // Migrate runs the migrations logic for the SQL dialects.
 type Migrate struct {
 	sqlDialect
-	universalID bool     // global unique ids.
-	dropColumns bool     // drop deleted columns.
-	dropIndexes bool     // drop deleted indexes.
-	withFixture bool     // with fks rename fixture.
-	typeRanges  []string // types order by their range.
+	universalID bool       // global unique ids.
+	dropColumns bool       // drop deleted columns.
+	dropIndexes bool       // drop deleted indexes.
+	withFixture bool       // with fks rename fixture.
+	typeRanges  []string   // types order by their range.
+	preHooks    []*rawHook // raw statements before tables creation.
+	postHooks   []*rawHook // raw statements after tables creation.
 }
 
 // NewMigrate create a migration structure for the given SQL driver.
@@ -98,6 +130,15 @@ func NewMigrate(d dialect.Driver, opts ...MigrateOption) (*Migrate, error) {
 // Note that SQLite dialect does not support (this moment) the "append-only" mode describe above,
 // since it's used only for testing.
 func (m *Migrate) Create(ctx context.Context, tables ...*Table) error {
+	if err := m.verifyFKs(tables); err != nil {
+		return err
+	}
+	if err := m.verifyIndexes(tables); err != nil {
+		return err
+	}
+	if err := m.verifyColumns(tables); err != nil {
+		return err
+	}
 	tx, err := m.Tx(ctx)
 	if err != nil {
 		return err
@@ -110,15 +151,39 @@ func (m *Migrate) Create(ctx context.Context, tables ...*Table) error {
 			return rollback(tx, err)
 		}
 	}
+	if err := m.execHooks(ctx, tx, m.preHooks); err != nil {
+		return rollback(tx, err)
+	}
 	if err := m.create(ctx, tx, tables...); err != nil {
 		return rollback(tx, err)
 	}
+	if err := m.execHooks(ctx, tx, m.postHooks); err != nil {
+		return rollback(tx, err)
+	}
 	return tx.Commit()
 }
 
+// execHooks executes the statements of the hooks that were added for the migration dialect.
+func (m *Migrate) execHooks(ctx context.Context, tx dialect.Tx, hooks []*rawHook) error {
+	for _, h := range hooks {
+		if h.dialect != m.Dialect() {
+			continue
+		}
+		for _, stmt := range h.stmts {
+			if err := tx.Exec(ctx, stmt, []interface{}{}, nil); err != nil {
+				return fmt.Errorf("sql/schema: exec migration hook %q: %v", stmt, err)
+			}
+		}
+	}
+	return nil
+}
+
 func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) error {
 	for _, t := range tables {
 		m.setupTable(t)
+		if err := m.createSequences(ctx, tx, t); err != nil {
+			return err
+		}
 		switch exist, err := m.tableExist(ctx, tx, t.Name); {
 		case err != nil:
 			return err
@@ -192,6 +257,74 @@ func (m *Migrate) create(ctx context.Context, tx dialect.Tx, tables ...*Table) e
 	return nil
 }
 
+// verifyFKs verifies that the foreign-keys of the given tables are supported by the dialect.
+func (m *Migrate) verifyFKs(tables []*Table) error {
+	if m.Dialect() == dialect.Postgres {
+		return nil
+	}
+	for _, t := range tables {
+		for _, fk := range t.ForeignKeys {
+			if fk.Deferrable != "" {
+				return fmt.Errorf("sql/schema: deferrable foreign-key %q of table %q is not supported by %s", fk.Symbol, t.Name, m.Dialect())
+			}
+		}
+	}
+	return nil
+}
+
+// verifyIndexes verifies that the indexes of the given tables are supported by the dialect.
+func (m *Migrate) verifyIndexes(tables []*Table) error {
+	if m.Dialect() == dialect.Postgres {
+		return nil
+	}
+	for _, t := range tables {
+		for _, idx := range t.Indexes {
+			if len(idx.Include) > 0 {
+				return fmt.Errorf("sql/schema: covering index %q of table %q is not supported by %s", idx.Name, t.Name, m.Dialect())
+			}
+			if idx.Type != "" {
+				return fmt.Errorf("sql/schema: index type %s of index %q of table %q is not supported by %s", idx.Type, idx.Name, t.Name, m.Dialect())
+			}
+			if idx.OpClass != "" || len(idx.With) > 0 {
+				return fmt.Errorf("sql/schema: operator class and storage parameters of index %q of table %q are not supported by %s", idx.Name, t.Name, m.Dialect())
+			}
+		}
+	}
+	return nil
+}
+
+// verifyColumns verifies that columns with custom (other) types
+// have a schema type that was defined for the dialect.
+func (m *Migrate) verifyColumns(tables []*Table) error {
+	for _, t := range tables {
+		for _, c := range t.Columns {
+			if c.Type == field.TypeOther && c.SchemaType[m.Dialect()] == "" {
+				return fmt.Errorf("sql/schema: column %q of table %q has no schema type for %s", c.Name, t.Name, m.Dialect())
+			}
+		}
+	}
+	return nil
+}
+
+// createSequences creates the sequences that are used by the default expressions
+// of the table columns, before the table is created or altered. Dialects that do
+// not support sequences ignore them, because these columns have no default value.
+func (m *Migrate) createSequences(ctx context.Context, tx dialect.Tx, t *Table) error {
+	sc, ok := m.sqlDialect.(sequenceCreator)
+	if !ok {
+		return nil
+	}
+	for _, c := range t.Columns {
+		if c.Sequence == "" {
+			continue
+		}
+		if err := sc.createSequence(ctx, tx, c.Sequence); err != nil {
+			return fmt.Errorf("create sequence %q for column %q: %v", c.Sequence, c.Name, err)
+		}
+	}
+	return nil
+}
+
 // apply applies changes on the given table.
 func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change *changes) error {
 	// Constraints should be dropped before dropping columns, because if a column
@@ -296,6 +429,11 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 				return nil, fmt.Errorf("missing index to drop for column %q", c2.Name)
 			}
 			change.index.drop.append(idx)
+		// Custom (other) types are managed by the user, and only their nullability is changed.
+		case c1.Type == field.TypeOther:
+			if c1.Nullable != c2.Nullable {
+				change.column.modify = append(change.column.modify, c1)
+			}
 		// Extending column types.
 		case m.cType(c1) != m.cType(c2):
 			if !c2.ConvertibleTo(c1) {
@@ -324,8 +462,8 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		switch idx2, ok := curr.index(idx1.Name); {
 		case !ok:
 			change.index.add.append(idx1)
-		// Changing index cardinality require drop and create.
-		case idx1.Unique != idx2.Unique:
+		// Changing index cardinality, its included columns or its type require drop and create.
+		case idx1.Unique != idx2.Unique || !idx1.sameInclude(idx2) || !idx1.sameType(idx2):
 			change.index.drop.append(idx2)
 			change.index.add.append(idx1)
 		}
@@ -596,6 +734,12 @@ type preparer interface {
 	prepare(context.Context, dialect.Tx, *changes, string) error
 }
 
+// sequenceCreator is implemented by the dialects that support
+// sequences for generating column values (e.g. string ids).
+type sequenceCreator interface {
+	createSequence(context.Context, dialect.Tx, string) error
+}
+
 // fkRenamer is used by the fixture migration (to solve #285),
 // and it's implemented by the different dialects for renaming FKs.
 type fkRenamer interface {
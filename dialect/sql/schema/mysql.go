@@ -183,7 +183,8 @@ func (d *MySQL) cType(c *Column) (t string) {
 		t = "int"
 	case field.TypeUint32:
 		t = "int unsigned"
-	case field.TypeInt, field.TypeInt64:
+	case field.TypeInt, field.TypeInt64, field.TypeDuration:
+		// Durations are stored as nanoseconds.
 		t = "bigint"
 	case field.TypeUint, field.TypeUint64:
 		t = "bigint unsigned"
@@ -219,6 +220,8 @@ func (d *MySQL) cType(c *Column) (t string) {
 		}
 	case field.TypeFloat32, field.TypeFloat64:
 		t = c.scanTypeOr("double")
+	case field.TypeDecimal:
+		t = c.scanTypeOr(fmt.Sprintf("decimal(%d,%d)", c.Precision, c.Scale))
 	case field.TypeTime:
 		t = c.scanTypeOr("timestamp")
 		// In MySQL, timestamp columns are `NOT NULL by default, and assigning NULL
@@ -243,12 +246,13 @@ func (d *MySQL) cType(c *Column) (t string) {
 // The syntax/order is: datatype [Charset] [Unique|Increment] [Collation] [Nullable].
 func (d *MySQL) addColumn(c *Column) *sql.ColumnBuilder {
 	b := sql.Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	c.generated(b, dialect.MySQL)
 	c.unique(b)
 	if c.Increment {
 		b.Attr("AUTO_INCREMENT")
 	}
 	c.nullable(b)
-	c.defaultValue(b)
+	c.defaultValue(b, dialect.MySQL)
 	return b
 }
 
@@ -354,7 +358,13 @@ func (d *MySQL) scanColumn(c *Column, rows *sql.Rows) error {
 		default:
 			c.Type = field.TypeInt8
 		}
-	case "numeric", "decimal", "double":
+	case "numeric", "decimal":
+		c.Type = field.TypeDecimal
+		c.Precision, c.Scale, err = parseDecimal(parts)
+		if err != nil {
+			return err
+		}
+	case "double":
 		c.Type = field.TypeFloat64
 	case "time", "timestamp", "date", "datetime":
 		c.Type = field.TypeTime
@@ -514,6 +524,22 @@ func parseColumn(typ string) (parts []string, size int64, unsigned bool, err err
 	return parts, size, unsigned, nil
 }
 
+// parseDecimal returns the precision and the scale of a decimal column from its
+// type parts. For example, ["decimal", "12", "2"] or ["numeric", "10"].
+func parseDecimal(parts []string) (precision, scale int, err error) {
+	if len(parts) > 1 {
+		if precision, err = strconv.Atoi(parts[1]); err != nil {
+			return 0, 0, fmt.Errorf("converting %s precision to int: %v", parts[0], err)
+		}
+	}
+	if len(parts) > 2 && parts[2] != "unsigned" {
+		if scale, err = strconv.Atoi(parts[2]); err != nil {
+			return 0, 0, fmt.Errorf("converting %s scale to int: %v", parts[0], err)
+		}
+	}
+	return precision, scale, nil
+}
+
 // fkNames returns the foreign-key names of a column.
 func fkNames(ctx context.Context, tx dialect.Tx, table, column string) ([]string, error) {
 	query, args := sql.Select("CONSTRAINT_NAME").From(sql.Table("INFORMATION_SCHEMA.KEY_COLUMN_USAGE").Unquote()).
@@ -41,6 +41,59 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "deferrable foreign key",
+			tables: func() []*Table {
+				c := []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "parent_id", Type: field.TypeInt, Nullable: true}}
+				t := &Table{Name: "nodes", Columns: c, PrimaryKey: c[0:1]}
+				t.AddForeignKey(&ForeignKey{Symbol: "nodes_parent", Columns: c[1:], RefTable: t, RefColumns: c[0:1], Deferrable: InitiallyDeferred})
+				return []*Table{t}
+			}(),
+			before:  func(mysqlMock) {},
+			wantErr: true,
+		},
+		{
+			name: "covering index",
+			tables: func() []*Table {
+				c := []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "age", Type: field.TypeInt}, {Name: "name", Type: field.TypeString}}
+				t := &Table{Name: "users", Columns: c, PrimaryKey: c[0:1]}
+				t.Indexes = []*Index{{Name: "age", Columns: c[1:2], Include: []string{"name"}}}
+				return []*Table{t}
+			}(),
+			before:  func(mysqlMock) {},
+			wantErr: true,
+		},
+		{
+			name: "index type",
+			tables: func() []*Table {
+				c := []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "age", Type: field.TypeInt}}
+				t := &Table{Name: "users", Columns: c, PrimaryKey: c[0:1]}
+				t.Indexes = []*Index{{Name: "age", Columns: c[1:2], Type: "GIST"}}
+				return []*Table{t}
+			}(),
+			before:  func(mysqlMock) {},
+			wantErr: true,
+		},
+		{
+			name: "index operator class",
+			tables: func() []*Table {
+				c := []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "age", Type: field.TypeInt}}
+				t := &Table{Name: "users", Columns: c, PrimaryKey: c[0:1]}
+				t.Indexes = []*Index{{Name: "age", Columns: c[1:2], OpClass: "int4_ops"}}
+				return []*Table{t}
+			}(),
+			before:  func(mysqlMock) {},
+			wantErr: true,
+		},
+		{
+			name: "other type without schema type",
+			tables: func() []*Table {
+				c := []*Column{{Name: "id", Type: field.TypeInt, Increment: true}, {Name: "location", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "geometry(Point,4326)"}}}
+				return []*Table{{Name: "places", Columns: c, PrimaryKey: c[0:1]}}
+			}(),
+			before:  func(mysqlMock) {},
+			wantErr: true,
+		},
 		{
 			name: "create new table",
 			tables: []*Table{
@@ -69,6 +122,31 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with generated columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "first_name", Type: field.TypeString},
+						{Name: "last_name", Type: field.TypeString},
+						{Name: "full_name", Type: field.TypeString, Generated: "CONCAT(first_name, ' ', last_name)", Stored: true},
+						{Name: "name_len", Type: field.TypeInt, Nullable: true, Generated: "CHAR_LENGTH(first_name)"},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("5.7.8")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE IF NOT EXISTS `users`(`id` bigint AUTO_INCREMENT NOT NULL, `first_name` varchar(255) NOT NULL, `last_name` varchar(255) NOT NULL, `full_name` varchar(255) GENERATED ALWAYS AS (CONCAT(first_name, ' ', last_name)) STORED NOT NULL, `name_len` bigint GENERATED ALWAYS AS (CHAR_LENGTH(first_name)) VIRTUAL NULL, PRIMARY KEY(`id`)) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "create new table 5.6",
 			tables: []*Table{
@@ -196,6 +274,70 @@ func TestMySQL_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "decimal",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "price", Type: field.TypeDecimal, Precision: 12, Scale: 2},
+						{Name: "total", Type: field.TypeDecimal, Precision: 8, Scale: 2, Nullable: true},
+						{Name: "fee", Type: field.TypeDecimal, Precision: 6, Scale: 2, Default: "0.50"},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("8.0.19")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM INFORMATION_SCHEMA.COLUMNS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "").
+						AddRow("price", "decimal(10,2)", "NO", "", "NULL", "", "", "").
+						AddRow("total", "decimal(8,2)", "YES", "", "NULL", "", "", ""))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `non_unique`, `seq_in_index` FROM INFORMATION_SCHEMA.STATISTICS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", "0", "1"))
+				mock.ExpectExec(escape("ALTER TABLE `users` ADD COLUMN `fee` decimal(6,2) NOT NULL DEFAULT '0.50', MODIFY COLUMN `price` decimal(12,2) NOT NULL")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "decimal precision reduced",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "price", Type: field.TypeDecimal, Precision: 12, Scale: 1},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock mysqlMock) {
+				mock.start("8.0.19")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape("SELECT `column_name`, `column_type`, `is_nullable`, `column_key`, `column_default`, `extra`, `character_set_name`, `collation_name` FROM INFORMATION_SCHEMA.COLUMNS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ?")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "column_type", "is_nullable", "column_key", "column_default", "extra", "character_set_name", "collation_name"}).
+						AddRow("id", "bigint(20)", "NO", "PRI", "NULL", "auto_increment", "", "").
+						AddRow("price", "decimal(10,2)", "NO", "", "NULL", "", "", ""))
+				mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `non_unique`, `seq_in_index` FROM INFORMATION_SCHEMA.STATISTICS WHERE `TABLE_SCHEMA` = (SELECT DATABASE()) AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "non_unique", "seq_in_index"}).
+						AddRow("PRIMARY", "id", "0", "1"))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 		{
 			name: "enums",
 			tables: []*Table{
@@ -76,11 +76,18 @@ func (d *Postgres) setRange(ctx context.Context, tx dialect.Tx, t *Table, value
 	return tx.Exec(ctx, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s RESTART WITH %d", t.Name, pk, value), []interface{}{}, nil)
 }
 
+// createSequence creates the given sequence if it does not exist.
+func (d *Postgres) createSequence(ctx context.Context, tx dialect.Tx, name string) error {
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	return tx.Exec(ctx, fmt.Sprintf("CREATE SEQUENCE IF NOT EXISTS %s", b.Quote(name)), []interface{}{}, nil)
+}
+
 // table loads the current table description from the database.
 func (d *Postgres) table(ctx context.Context, tx dialect.Tx, name string) (*Table, error) {
 	rows := &sql.Rows{}
 	query, args := sql.Dialect(dialect.Postgres).
-		Select("column_name", "data_type", "is_nullable", "column_default").
+		Select("column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale").
 		From(sql.Table("INFORMATION_SCHEMA.COLUMNS").Unquote()).
 		Where(sql.EQ("table_schema", sql.Raw("CURRENT_SCHEMA()")).And().EQ("table_name", name)).Query()
 	if err := tx.Query(ctx, query, args, rows); err != nil {
@@ -137,20 +144,26 @@ func (d *Postgres) table(ctx context.Context, tx dialect.Tx, name string) (*Tabl
 }
 
 // indexesQuery holds a query format for retrieving
-// table indexes of the current schema.
+// table indexes of the current schema. Columns that are positioned after the
+// key columns (key_columns) are the included columns of covering indexes, and
+// the method is the index access method (e.g. btree or gist).
 const indexesQuery = `
 SELECT i.relname AS index_name,
        a.attname AS column_name,
        idx.indisprimary AS primary,
        idx.indisunique AS unique,
-       array_position(idx.indkey, a.attnum) as seq_in_index
+       array_position(idx.indkey, a.attnum) as seq_in_index,
+       idx.indnkeyatts AS key_columns,
+       am.amname AS method
 FROM pg_class t,
      pg_class i,
      pg_index idx,
      pg_attribute a,
-     pg_namespace n
+     pg_namespace n,
+     pg_am am
 WHERE t.oid = idx.indrelid
   AND i.oid = idx.indexrelid
+  AND am.oid = i.relam
   AND n.oid = t.relnamespace
   AND a.attrelid = t.oid
   AND a.attnum = ANY(idx.indkey)
@@ -161,8 +174,13 @@ ORDER BY index_name, seq_in_index;
 `
 
 func (d *Postgres) indexes(ctx context.Context, tx dialect.Tx, table string) (Indexes, error) {
+	query := fmt.Sprintf(indexesQuery, table)
+	// Covering indexes (and the indnkeyatts column) were added in v11.
+	if compareVersions(d.version, "11.0.0") == -1 {
+		query = strings.Replace(query, "idx.indnkeyatts", "idx.indnatts", 1)
+	}
 	rows := &sql.Rows{}
-	if err := tx.Query(ctx, fmt.Sprintf(indexesQuery, table), []interface{}{}, rows); err != nil {
+	if err := tx.Query(ctx, query, []interface{}{}, rows); err != nil {
 		return nil, fmt.Errorf("querying indexes for table %s: %v", table, err)
 	}
 	defer rows.Close()
@@ -172,11 +190,11 @@ func (d *Postgres) indexes(ctx context.Context, tx dialect.Tx, table string) (In
 	)
 	for rows.Next() {
 		var (
-			seqindex        int
-			name, column    string
-			unique, primary bool
+			seqindex, keys       int
+			name, column, method string
+			unique, primary      bool
 		)
-		if err := rows.Scan(&name, &column, &primary, &unique, &seqindex); err != nil {
+		if err := rows.Scan(&name, &column, &primary, &unique, &seqindex, &keys, &method); err != nil {
 			return nil, fmt.Errorf("scanning index description: %v", err)
 		}
 		// If the index is prefixed with the table, it's probably was
@@ -184,11 +202,15 @@ func (d *Postgres) indexes(ctx context.Context, tx dialect.Tx, table string) (In
 		short := strings.TrimPrefix(name, table+"_")
 		idx, ok := names[short]
 		if !ok {
-			idx = &Index{Name: short, Unique: unique, primary: primary, realname: name}
+			idx = &Index{Name: short, Unique: unique, Type: method, primary: primary, realname: name}
 			idxs = append(idxs, idx)
 			names[short] = idx
 		}
-		idx.columns = append(idx.columns, column)
+		if seqindex < keys {
+			idx.columns = append(idx.columns, column)
+		} else {
+			idx.Include = append(idx.Include, column)
+		}
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -202,10 +224,12 @@ const maxCharSize = 10 << 20
 // scanColumn scans the information a column from column description.
 func (d *Postgres) scanColumn(c *Column, rows *sql.Rows) error {
 	var (
-		nullable sql.NullString
-		defaults sql.NullString
+		nullable  sql.NullString
+		defaults  sql.NullString
+		precision sql.NullInt64
+		scale     sql.NullInt64
 	)
-	if err := rows.Scan(&c.Name, &c.typ, &nullable, &defaults); err != nil {
+	if err := rows.Scan(&c.Name, &c.typ, &nullable, &defaults, &precision, &scale); err != nil {
 		return fmt.Errorf("scanning column description: %v", err)
 	}
 	if nullable.Valid {
@@ -222,7 +246,10 @@ func (d *Postgres) scanColumn(c *Column, rows *sql.Rows) error {
 		c.Type = field.TypeInt64
 	case "real":
 		c.Type = field.TypeFloat32
-	case "numeric", "decimal", "double precision":
+	case "numeric", "decimal":
+		c.Type = field.TypeDecimal
+		c.Precision, c.Scale = int(precision.Int64), int(scale.Int64)
+	case "double precision":
 		c.Type = field.TypeFloat64
 	case "text":
 		c.Type = field.TypeString
@@ -235,8 +262,13 @@ func (d *Postgres) scanColumn(c *Column, rows *sql.Rows) error {
 		c.Type = field.TypeBytes
 	case "jsonb":
 		c.Type = field.TypeJSON
+	case "interval":
+		c.Type = field.TypeDuration
 	case "uuid":
 		c.Type = field.TypeUUID
+	case "USER-DEFINED":
+		// Types that were defined by extensions or users. For example, PostGIS geometry.
+		c.Type = field.TypeOther
 	}
 	switch {
 	case !defaults.Valid || c.Type == field.TypeTime:
@@ -281,12 +313,20 @@ func (d *Postgres) cType(c *Column) (t string) {
 		t = c.scanTypeOr("real")
 	case field.TypeFloat64:
 		t = c.scanTypeOr("double precision")
+	case field.TypeDecimal:
+		// Unconstrained numeric columns have no precision.
+		t = "numeric"
+		if c.Precision > 0 {
+			t = fmt.Sprintf("numeric(%d,%d)", c.Precision, c.Scale)
+		}
 	case field.TypeBytes:
 		t = "bytea"
 	case field.TypeJSON:
 		t = "jsonb"
 	case field.TypeUUID:
 		t = "uuid"
+	case field.TypeDuration:
+		t = "interval"
 	case field.TypeString:
 		t = "varchar"
 		if c.Size > maxCharSize {
@@ -308,12 +348,13 @@ func (d *Postgres) cType(c *Column) (t string) {
 func (d *Postgres) addColumn(c *Column) *sql.ColumnBuilder {
 	b := sql.Dialect(dialect.Postgres).
 		Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	c.generated(b, dialect.Postgres)
 	c.unique(b)
 	if c.Increment {
 		b.Attr("GENERATED BY DEFAULT AS IDENTITY")
 	}
 	c.nullable(b)
-	c.defaultValue(b)
+	c.defaultValue(b, dialect.Postgres)
 	return b
 }
 
@@ -342,6 +383,18 @@ func (d *Postgres) addIndex(i *Index, table string) *sql.IndexBuilder {
 	for _, c := range i.Columns {
 		idx.Column(c.Name)
 	}
+	if i.Type != "" {
+		idx.Using(i.Type)
+	}
+	if len(i.Include) > 0 {
+		idx.Include(i.Include...)
+	}
+	if i.OpClass != "" {
+		idx.OpClass(i.OpClass)
+	}
+	if len(i.With) > 0 {
+		idx.With(i.With)
+	}
 	return idx
 }
 
@@ -48,6 +48,37 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "migration hooks",
+			options: []MigrateOption{
+				WithPreHook(dialect.Postgres, "CREATE EXTENSION IF NOT EXISTS pg_trgm"),
+				WithPreHook(dialect.MySQL, "SET GLOBAL innodb_file_per_table = 1"),
+				WithPostHook(dialect.Postgres, "DROP TRIGGER IF EXISTS users_audit ON users", "CREATE TRIGGER users_audit AFTER UPDATE ON users FOR EACH ROW EXECUTE FUNCTION audit()"),
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.ExpectExec(escape("CREATE EXTENSION IF NOT EXISTS pg_trgm")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(escape("DROP TRIGGER IF EXISTS users_audit ON users")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(escape("CREATE TRIGGER users_audit AFTER UPDATE ON users FOR EACH ROW EXECUTE FUNCTION audit()")).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "migration hooks failed",
+			options: []MigrateOption{
+				WithPostHook(dialect.Postgres, "CREATE TRIGGER users_audit AFTER UPDATE ON users FOR EACH ROW EXECUTE FUNCTION audit()"),
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.ExpectExec(escape("CREATE TRIGGER users_audit AFTER UPDATE ON users FOR EACH ROW EXECUTE FUNCTION audit()")).
+					WillReturnError(sqlmock.ErrCancelled)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 		{
 			name: "create new table",
 			tables: []*Table{
@@ -75,6 +106,142 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "duration",
+			tables: []*Table{
+				{
+					Name: "notes",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "ttl", Type: field.TypeDuration, Nullable: true},
+						{Name: "delay", Type: field.TypeDuration},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("notes", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("notes").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", 64, 0).
+						AddRow("ttl", "interval", "YES", "'01:00:00'::interval", nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "notes"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("notes_pkey", "id", "t", "t", 0, 1, "btree"))
+				mock.ExpectExec(escape(`ALTER TABLE "notes" ADD COLUMN "delay" interval NOT NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "decimal",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "price", Type: field.TypeDecimal, Precision: 12, Scale: 2},
+						{Name: "total", Type: field.TypeDecimal, Precision: 8, Scale: 2, Nullable: true},
+						{Name: "fee", Type: field.TypeDecimal, Precision: 6, Scale: 2, Default: "0.50"},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", 64, 0).
+						AddRow("price", "numeric", "NO", "NULL", 10, 2).
+						AddRow("total", "numeric", "YES", "NULL", 8, 2))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "fee" numeric(6,2) NOT NULL DEFAULT '0.50', ALTER COLUMN "price" TYPE numeric(12,2), ALTER COLUMN "price" SET NOT NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with default expressions",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "created_at", Type: field.TypeTime, DefaultExpr: "CURRENT_TIMESTAMP"},
+						{Name: "token", Type: field.TypeUUID, DefaultExpr: "uuid()", DefaultExprs: map[string]string{dialect.Postgres: "gen_random_uuid()"}},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "created_at" timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP, "token" uuid NOT NULL DEFAULT gen_random_uuid(), PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with generated columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "first_name", Type: field.TypeString},
+						{Name: "last_name", Type: field.TypeString},
+						{Name: "full_name", Type: field.TypeString, Generated: "first_name || ' ' || last_name", Stored: true},
+						{Name: "name_len", Type: field.TypeInt, Nullable: true, Generated: "length(first_name)"},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "first_name" varchar NOT NULL, "last_name" varchar NOT NULL, "full_name" varchar GENERATED ALWAYS AS (first_name || ' ' || last_name) STORED NOT NULL, "name_len" bigint GENERATED ALWAYS AS (length(first_name)) STORED NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with sequence id",
+			tables: []*Table{
+				{
+					Name: "cards",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeString, Sequence: "card_seq"},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeString, Sequence: "card_seq", DefaultExprs: map[string]string{dialect.Postgres: "'card_' || lpad(nextval('card_seq')::text, 6, '0')"}},
+						{Name: "number", Type: field.TypeString},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.ExpectExec(escape(`CREATE SEQUENCE IF NOT EXISTS "card_seq"`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.tableExists("cards", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "cards"("id" varchar NOT NULL DEFAULT 'card_' || lpad(nextval('card_seq')::text, 6, '0'), "number" varchar NOT NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "create new table with foreign key",
 			tables: func() []*Table {
@@ -125,6 +292,48 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with deferrable foreign key",
+			tables: func() []*Table {
+				var (
+					c1 = []*Column{{Name: "id", Type: field.TypeInt, Increment: true}}
+					c2 = []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "owner_id", Type: field.TypeInt, Nullable: true},
+					}
+					t1 = &Table{Name: "users", Columns: c1, PrimaryKey: c1[0:1]}
+					t2 = &Table{
+						Name:       "cards",
+						Columns:    c2,
+						PrimaryKey: c2[0:1],
+						ForeignKeys: []*ForeignKey{
+							{
+								Symbol:     "cards_owner",
+								Columns:    c2[1:],
+								RefTable:   t1,
+								RefColumns: c1[0:1],
+								OnDelete:   SetNull,
+								Deferrable: InitiallyDeferred,
+							},
+						},
+					}
+				)
+				return []*Table{t1, t2}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.tableExists("cards", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "cards"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "owner_id" bigint NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.fkExists("cards_owner", false)
+				mock.ExpectExec(escape(`ALTER TABLE "cards" ADD CONSTRAINT "cards_owner" FOREIGN KEY("owner_id") REFERENCES "users"("id") ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "add column to table",
 			tables: []*Table{
@@ -148,19 +357,19 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character varying", "YES", "NULL").
-						AddRow("uuid", "uuid", "YES", "NULL").
-						AddRow("created_at", "date", "NO", "CURRENT_DATE").
-						AddRow("updated_at", "timestamp", "YES", "NULL").
-						AddRow("deleted_at", "date", "YES", "NULL").
-						AddRow("text", "text", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character varying", "YES", "NULL", nil, nil).
+						AddRow("uuid", "uuid", "YES", "NULL", nil, nil).
+						AddRow("created_at", "date", "NO", "CURRENT_DATE", nil, nil).
+						AddRow("updated_at", "timestamp", "YES", "NULL", nil, nil).
+						AddRow("deleted_at", "date", "YES", "NULL", nil, nil).
+						AddRow("text", "text", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "age" bigint NOT NULL, ALTER COLUMN "updated_at" TYPE timestamp with time zone, ALTER COLUMN "updated_at" DROP NOT NULL, ALTER COLUMN "deleted_at" TYPE timestamp with time zone, ALTER COLUMN "deleted_at" DROP NOT NULL`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -185,15 +394,15 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character", "YES", "NULL").
-						AddRow("doc", "jsonb", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character", "YES", "NULL", nil, nil).
+						AddRow("doc", "jsonb", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "age" bigint NOT NULL DEFAULT 10`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -219,15 +428,15 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character", "YES", "NULL").
-						AddRow("doc", "jsonb", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character", "YES", "NULL", nil, nil).
+						AddRow("doc", "jsonb", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "blob" bytea NOT NULL, ADD COLUMN "longblob" bytea NOT NULL`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -251,14 +460,14 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "age" double precision NOT NULL DEFAULT 10.1`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -282,14 +491,14 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "age" boolean NOT NULL DEFAULT true`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -313,14 +522,14 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "nick" varchar NOT NULL DEFAULT 'unknown'`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -343,14 +552,14 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" DROP COLUMN "name"`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -373,14 +582,14 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("name", "character", "NO", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character", "NO", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`ALTER TABLE "users" ALTER COLUMN "name" TYPE varchar, ALTER COLUMN "name" DROP NOT NULL`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -403,14 +612,14 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("age", "bigint", "NO", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("age", "bigint", "NO", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.ExpectExec(escape(`CREATE UNIQUE INDEX "users_age" ON "users"("age")`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
@@ -433,15 +642,15 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("age", "bigint", "NO", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("age", "bigint", "NO", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0).
-						AddRow("users_age_key", "age", "f", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree").
+						AddRow("users_age_key", "age", "f", "t", 0, 1, "btree"))
 				mock.ExpectCommit()
 			},
 		},
@@ -463,15 +672,15 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "NO", "NULL").
-						AddRow("age", "bigint", "NO", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("age", "bigint", "NO", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0).
-						AddRow("users_age_key", "age", "f", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree").
+						AddRow("users_age_key", "age", "f", "t", 0, 1, "btree"))
 				mock.ExpectQuery(escape(`SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS WHERE "table_schema" = CURRENT_SCHEMA() AND "constraint_type" = $1 AND "constraint_name" = $2`)).
 					WithArgs("UNIQUE", "users_age_key").
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
@@ -480,6 +689,233 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with covering index",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "age", Type: field.TypeInt},
+						{Name: "name", Type: field.TypeString},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				}
+				t.Indexes = []*Index{{Name: "age", Columns: t.Columns[1:2], Include: []string{"name"}}}
+				return []*Table{t}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "age" bigint NOT NULL, "name" varchar NOT NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "users_age" ON "users"("age") INCLUDE ("name")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with spatial column",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "places",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "location", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "geometry(Point,4326)"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				}
+				t.Indexes = []*Index{{Name: "location", Columns: t.Columns[1:2], Type: "GIST"}}
+				return []*Table{t}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("places", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "places"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "location" geometry(Point,4326) NOT NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "places_location" ON "places" USING GIST ("location")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with vector column",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "documents",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{dialect.Postgres: "vector(3)"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				}
+				t.Indexes = []*Index{{Name: "embedding", Columns: t.Columns[1:2], Type: "hnsw", OpClass: "vector_cosine_ops", With: map[string]int{"m": 16}}}
+				return []*Table{t}
+			}(),
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("documents", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "documents"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "embedding" vector(3) NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "documents_embedding" ON "documents" USING hnsw ("embedding" vector_cosine_ops) WITH (m = 16)`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "spatial column without changes",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "places",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "location", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "geometry(Point,4326)"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				}
+				t.Indexes = []*Index{{Name: "location", Columns: t.Columns[1:2], Type: "GIST"}}
+				return []*Table{t}
+			}(),
+			options: []MigrateOption{WithDropIndex(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("places", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("places").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("location", "USER-DEFINED", "NO", "NULL", nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "places"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("places_pkey", "id", "t", "t", 0, 1, "btree").
+						AddRow("places_location", "location", "f", "f", 0, 1, "gist"))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "change index type",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "places",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "location", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "geometry(Point,4326)"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				}
+				t.Indexes = []*Index{{Name: "location", Columns: t.Columns[1:2], Type: "GIST"}}
+				return []*Table{t}
+			}(),
+			options: []MigrateOption{WithDropIndex(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("places", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("places").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("location", "USER-DEFINED", "NO", "NULL", nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "places"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("places_pkey", "id", "t", "t", 0, 1, "btree").
+						AddRow("places_location", "location", "f", "f", 0, 1, "btree"))
+				mock.ExpectQuery(escape(`SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS WHERE "table_schema" = CURRENT_SCHEMA() AND "constraint_type" = $1 AND "constraint_name" = $2`)).
+					WithArgs("UNIQUE", "places_location").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(escape(`DROP INDEX "places_location"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "places_location" ON "places" USING GIST ("location")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "covering index without changes",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "age", Type: field.TypeInt},
+						{Name: "name", Type: field.TypeString},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				}
+				t.Indexes = []*Index{{Name: "age", Columns: t.Columns[1:2], Include: []string{"name"}}}
+				return []*Table{t}
+			}(),
+			options: []MigrateOption{WithDropIndex(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("age", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character varying", "NO", "NULL", nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree").
+						AddRow("users_age", "age", "f", "f", 0, 1, "btree").
+						AddRow("users_age", "name", "f", "f", 1, 1, "btree"))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "remove included columns from index",
+			tables: func() []*Table {
+				t := &Table{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "age", Type: field.TypeInt},
+						{Name: "name", Type: field.TypeString},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				}
+				t.Indexes = []*Index{{Name: "age", Columns: t.Columns[1:2]}}
+				return []*Table{t}
+			}(),
+			options: []MigrateOption{WithDropIndex(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "NO", "NULL", nil, nil).
+						AddRow("age", "bigint", "NO", "NULL", nil, nil).
+						AddRow("name", "character varying", "NO", "NULL", nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree").
+						AddRow("users_age", "age", "f", "f", 0, 1, "btree").
+						AddRow("users_age", "name", "f", "f", 1, 1, "btree"))
+				mock.ExpectQuery(escape(`SELECT COUNT(*) FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS WHERE "table_schema" = CURRENT_SCHEMA() AND "constraint_type" = $1 AND "constraint_name" = $2`)).
+					WithArgs("UNIQUE", "users_age").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectExec(escape(`DROP INDEX "users_age"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX "users_age" ON "users"("age")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "add edge to table",
 			tables: func() []*Table {
@@ -509,14 +945,14 @@ func TestPostgres_Create(t *testing.T) {
 			before: func(mock pgMock) {
 				mock.start("120000")
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "YES", "NULL").
-						AddRow("name", "character", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "YES", "NULL", nil, nil).
+						AddRow("name", "character", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				mock.fkExists("user_spouse____________________390ed76f91d3c57cd3516e7690f621dc", false)
 				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "spouse_id" bigint NULL`)).
 					WillReturnResult(sqlmock.NewResult(0, 1))
@@ -576,13 +1012,13 @@ func TestPostgres_Create(t *testing.T) {
 				// query users table.
 				mock.tableExists("users", true)
 				// users table has no changes.
-				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale" FROM INFORMATION_SCHEMA.COLUMNS WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
 					WithArgs("users").
-					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default"}).
-						AddRow("id", "bigint", "YES", "NULL"))
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "numeric_precision", "numeric_scale"}).
+						AddRow("id", "bigint", "YES", "NULL", nil, nil))
 				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "users"))).
-					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
-						AddRow("users_pkey", "id", "t", "t", 0))
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index", "key_columns", "method"}).
+						AddRow("users_pkey", "id", "t", "t", 0, 1, "btree"))
 				// query groups table.
 				mock.tableExists("groups", false)
 				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "groups"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, PRIMARY KEY("id"))`)).
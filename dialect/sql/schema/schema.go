@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/schema/field"
 )
@@ -45,6 +46,7 @@ func NewTable(name string) *Table {
 
 // AddPrimary adds a new primary key to the table.
 func (t *Table) AddPrimary(c *Column) *Table {
+	t.columns[c.Name] = c
 	t.Columns = append(t.Columns, c)
 	t.PrimaryKey = append(t.PrimaryKey, c)
 	return t
@@ -137,20 +139,27 @@ func (t *Table) fk(symbol string) (*ForeignKey, bool) {
 
 // Column schema definition for SQL dialects.
 type Column struct {
-	Name       string            // column name.
-	Type       field.Type        // column type.
-	SchemaType map[string]string // optional schema type per dialect.
-	Attr       string            // extra attributes.
-	Size       int64             // max size parameter for string, blob, etc.
-	Key        string            // key definition (PRI, UNI or MUL).
-	Unique     bool              // column with unique constraint.
-	Increment  bool              // auto increment attribute.
-	Nullable   bool              // null or not null attribute.
-	Default    interface{}       // default value.
-	Enums      []string          // enum values.
-	typ        string            // row column type (used for Rows.Scan).
-	indexes    Indexes           // linked indexes.
-	foreign    *ForeignKey       // linked foreign-key.
+	Name         string            // column name.
+	Type         field.Type        // column type.
+	SchemaType   map[string]string // optional schema type per dialect.
+	Attr         string            // extra attributes.
+	Size         int64             // max size parameter for string, blob, etc.
+	Precision    int               // precision of decimal columns.
+	Scale        int               // scale of decimal columns.
+	Key          string            // key definition (PRI, UNI or MUL).
+	Unique       bool              // column with unique constraint.
+	Increment    bool              // auto increment attribute.
+	Nullable     bool              // null or not null attribute.
+	Default      interface{}       // default value.
+	DefaultExpr  string            // default expression that is evaluated by the database.
+	DefaultExprs map[string]string // optional default expression per dialect.
+	Sequence     string            // sequence that is used by the default expression (PostgreSQL only).
+	Generated    string            // expression of generated (computed) columns.
+	Stored       bool              // generated column is stored (and not virtual).
+	Enums        []string          // enum values.
+	typ          string            // row column type (used for Rows.Scan).
+	indexes      Indexes           // linked indexes.
+	foreign      *ForeignKey       // linked foreign-key.
 }
 
 // UniqueKey returns boolean indicates if this column is a unique key.
@@ -164,6 +173,9 @@ func (c *Column) PrimaryKey() bool { return c.Key == PrimaryKey }
 // ConvertibleTo reports whether a column can be converted to the new column without altering its data.
 func (c *Column) ConvertibleTo(d *Column) bool {
 	switch {
+	case c.Type == field.TypeDecimal && d.Type == field.TypeDecimal:
+		// Neither the integer digits nor the fraction digits can be reduced.
+		return c.Precision-c.Scale <= d.Precision-d.Scale && c.Scale <= d.Scale
 	case c.Type == d.Type:
 		return c.Size <= d.Size
 	case c.IntType() && d.IntType() || c.UintType() && d.UintType():
@@ -172,7 +184,8 @@ func (c *Column) ConvertibleTo(d *Column) bool {
 		// uintX can not be converted to intY, when X > Y.
 		return c.Type-field.TypeUint8 <= d.Type-field.TypeInt8
 	}
-	return c.FloatType() && d.FloatType()
+	// Decimal columns were scanned as floats in the past.
+	return (c.FloatType() || c.Type == field.TypeDecimal) && d.FloatType()
 }
 
 // IntType reports whether the column is an int type (int8 ... int64).
@@ -218,6 +231,15 @@ func (c *Column) ScanDefault(value string) error {
 			return fmt.Errorf("scanning string value for column %q: %v", c.Name, err)
 		}
 		c.Default = v.String
+	case c.Type == field.TypeDecimal:
+		// Decimal defaults are kept in their textual representation.
+		c.Default = strings.Trim(value, "'")
+	case c.Type == field.TypeTime:
+		// Time columns accept only default expressions (e.g. CURRENT_TIMESTAMP),
+		// that are defined by the schema and are not scanned from the database.
+	case c.Type == field.TypeDuration:
+		// Duration defaults are applied by the client, and their database
+		// representation (e.g. PostgreSQL intervals) is not scanned.
 	default:
 		return fmt.Errorf("unsupported type: %v", c.Type)
 	}
@@ -227,7 +249,14 @@ func (c *Column) ScanDefault(value string) error {
 // defaultValue adds tge `DEFAULT` attribute the the column.
 // Note that, in SQLite if a NOT NULL constraint is specified,
 // then the column must have a default value which not NULL.
-func (c *Column) defaultValue(b *sql.ColumnBuilder) {
+func (c *Column) defaultValue(b *sql.ColumnBuilder, name string) {
+	if c.Generated != "" {
+		return
+	}
+	if expr := c.defaultExpr(name); expr != "" {
+		b.Attr("DEFAULT " + expr)
+		return
+	}
 	// has default, and it's supported in the database level.
 	if c.Default != nil && c.supportDefault() {
 		attr := "DEFAULT "
@@ -244,12 +273,40 @@ func (c *Column) defaultValue(b *sql.ColumnBuilder) {
 	}
 }
 
+// generated adds the `GENERATED ALWAYS AS` attribute to generated (computed) columns.
+// PostgreSQL supports only stored generated columns, and therefore, they are always stored.
+func (c *Column) generated(b *sql.ColumnBuilder, name string) {
+	if c.Generated == "" {
+		return
+	}
+	attr := "GENERATED ALWAYS AS (" + c.Generated + ")"
+	if c.Stored || name == dialect.Postgres {
+		attr += " STORED"
+	} else {
+		attr += " VIRTUAL"
+	}
+	b.Attr(attr)
+}
+
+// defaultExpr returns the default expression of the column for the given dialect.
+// SQLite requires expressions that are not literals to be wrapped with parentheses.
+func (c Column) defaultExpr(name string) string {
+	expr := c.DefaultExpr
+	if v, ok := c.DefaultExprs[name]; ok {
+		expr = v
+	}
+	if expr != "" && name == dialect.SQLite && !strings.HasPrefix(expr, "(") {
+		expr = "(" + expr + ")"
+	}
+	return expr
+}
+
 // supportDefault reports if the column type supports default value.
 func (c Column) supportDefault() bool {
 	switch {
 	case c.Type == field.TypeString:
 		return c.Size < 1<<16 // not a text.
-	case c.Type.Numeric(), c.Type == field.TypeBool:
+	case c.Type.Numeric(), c.Type == field.TypeBool, c.Type == field.TypeDecimal:
 		return true
 	default:
 		return false
@@ -307,6 +364,7 @@ type ForeignKey struct {
 	RefColumns []*Column       // referenced columns.
 	OnUpdate   ReferenceOption // action on update.
 	OnDelete   ReferenceOption // action on delete.
+	Deferrable DeferrableMode  // deferrable mode. PostgreSQL only.
 }
 
 // DSL returns a default DSL query for a foreign-key.
@@ -328,6 +386,9 @@ func (fk ForeignKey) DSL() *sql.ForeignKeyBuilder {
 	if action := string(fk.OnUpdate); action != "" {
 		dsl.OnUpdate(action)
 	}
+	if mode := string(fk.Deferrable); mode != "" {
+		dsl.Deferrable(mode)
+	}
 	return dsl
 }
 
@@ -351,14 +412,32 @@ func (r ReferenceOption) ConstName() string {
 	return strings.Replace(strings.Title(strings.ToLower(string(r))), " ", "", -1)
 }
 
+// DeferrableMode for deferrable constraints.
+type DeferrableMode string
+
+// Deferrable modes.
+const (
+	InitiallyImmediate DeferrableMode = "INITIALLY IMMEDIATE"
+	InitiallyDeferred  DeferrableMode = "INITIALLY DEFERRED"
+)
+
+// ConstName returns the constant name of a deferrable mode. It's used by entc for printing the constant name in templates.
+func (m DeferrableMode) ConstName() string {
+	return strings.Replace(strings.Title(strings.ToLower(string(m))), " ", "", -1)
+}
+
 // Index definition for table index.
 type Index struct {
-	Name     string    // index name.
-	Unique   bool      // uniqueness.
-	Columns  []*Column // actual table columns.
-	columns  []string  // columns loaded from query scan.
-	primary  bool      // primary key index.
-	realname string    // real name in the database (Postgres only).
+	Name     string         // index name.
+	Unique   bool           // uniqueness.
+	Columns  []*Column      // actual table columns.
+	Include  []string       // included (non-key) columns of covering indexes. PostgreSQL only.
+	Type     string         // index method (e.g. GIST). PostgreSQL only.
+	OpClass  string         // operator class of the key columns (e.g. vector_l2_ops). PostgreSQL only.
+	With     map[string]int // storage parameters (e.g. lists). PostgreSQL only.
+	columns  []string       // columns loaded from query scan.
+	primary  bool           // primary key index.
+	realname string         // real name in the database (Postgres only).
 }
 
 // Builder returns the query builder for index creation. The DSL is identical in all dialects.
@@ -370,6 +449,18 @@ func (i *Index) Builder(table string) *sql.IndexBuilder {
 	for _, c := range i.Columns {
 		idx.Column(c.Name)
 	}
+	if i.Type != "" {
+		idx.Using(i.Type)
+	}
+	if len(i.Include) > 0 {
+		idx.Include(i.Include...)
+	}
+	if i.OpClass != "" {
+		idx.OpClass(i.OpClass)
+	}
+	if len(i.With) > 0 {
+		idx.With(i.With)
+	}
 	return idx
 }
 
@@ -382,7 +473,7 @@ func (i *Index) DropBuilder(table string) *sql.DropIndexBuilder {
 // sameAs reports if the index has the same properties
 // as the given index (except the name).
 func (i *Index) sameAs(idx *Index) bool {
-	if i.Unique != idx.Unique || len(i.Columns) != len(idx.Columns) {
+	if i.Unique != idx.Unique || len(i.Columns) != len(idx.Columns) || !i.sameInclude(idx) || !i.sameType(idx) {
 		return false
 	}
 	for j, c := range i.Columns {
@@ -393,6 +484,37 @@ func (i *Index) sameAs(idx *Index) bool {
 	return true
 }
 
+// sameInclude reports if the index has the same included columns as the given
+// index. The order of the included columns does not affect the index.
+func (i *Index) sameInclude(idx *Index) bool {
+	if len(i.Include) != len(idx.Include) {
+		return false
+	}
+	include := make(map[string]bool, len(i.Include))
+	for _, c := range i.Include {
+		include[c] = true
+	}
+	for _, c := range idx.Include {
+		if !include[c] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameType reports if the index has the same method as the given index.
+// An empty type stands for the default method of the database (BTREE).
+func (i *Index) sameType(idx *Index) bool {
+	t1, t2 := i.Type, idx.Type
+	if t1 == "" {
+		t1 = "btree"
+	}
+	if t2 == "" {
+		t2 = "btree"
+	}
+	return strings.EqualFold(t1, t2)
+}
+
 // Indexes used for scanning all sql.Rows into a list of indexes, because
 // multiple sql rows can represent the same index (multi-columns indexes).
 type Indexes []*Index
@@ -92,4 +92,8 @@ func TestColumn_ScanDefault(t *testing.T) {
 	require.NoError(t, c1.ScanDefault("false"))
 	require.Equal(t, false, c1.Default)
 	require.Error(t, c1.ScanDefault("foo"))
+
+	c1 = &Column{Type: field.TypeTime}
+	require.NoError(t, c1.ScanDefault("CURRENT_TIMESTAMP"))
+	require.Nil(t, c1.Default)
 }
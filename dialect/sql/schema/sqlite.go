@@ -97,7 +97,7 @@ func (*SQLite) cType(c *Column) (t string) {
 	case field.TypeBool:
 		t = "bool"
 	case field.TypeInt8, field.TypeUint8, field.TypeInt16, field.TypeUint16, field.TypeInt32,
-		field.TypeUint32, field.TypeUint, field.TypeInt, field.TypeInt64, field.TypeUint64:
+		field.TypeUint32, field.TypeUint, field.TypeInt, field.TypeInt64, field.TypeUint64, field.TypeDuration:
 		t = "integer"
 	case field.TypeBytes:
 		t = "blob"
@@ -107,6 +107,8 @@ func (*SQLite) cType(c *Column) (t string) {
 		t = fmt.Sprintf("varchar(%d)", DefaultStringLen)
 	case field.TypeFloat32, field.TypeFloat64:
 		t = "real"
+	case field.TypeDecimal:
+		t = fmt.Sprintf("decimal(%d,%d)", c.Precision, c.Scale)
 	case field.TypeTime:
 		t = "datetime"
 	case field.TypeJSON:
@@ -122,12 +124,13 @@ func (*SQLite) cType(c *Column) (t string) {
 // addColumn returns the DSL query for adding the given column to a table.
 func (d *SQLite) addColumn(c *Column) *sql.ColumnBuilder {
 	b := sql.Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	c.generated(b, dialect.SQLite)
 	c.unique(b)
 	if c.Increment {
 		b.Attr("PRIMARY KEY AUTOINCREMENT")
 	}
 	c.nullable(b)
-	c.defaultValue(b)
+	c.defaultValue(b, dialect.SQLite)
 	return b
 }
 
@@ -148,8 +151,9 @@ func (d *SQLite) fkExist(context.Context, dialect.Tx, string) (bool, error) { re
 // table returns always error to indicate that SQLite dialect doesn't support incremental migration.
 func (d *SQLite) table(ctx context.Context, tx dialect.Tx, name string) (*Table, error) {
 	rows := &sql.Rows{}
+	// Unlike table_info, table_xinfo includes generated columns.
 	query, args := sql.Select("name", "type", "notnull", "dflt_value", "pk").
-		From(sql.Table(fmt.Sprintf("pragma_table_info('%s')", name)).Unquote()).
+		From(sql.Table(fmt.Sprintf("pragma_table_xinfo('%s')", name)).Unquote()).
 		OrderBy("pk").
 		Query()
 	if err := tx.Query(ctx, query, args, rows); err != nil {
@@ -285,6 +289,11 @@ func (d *SQLite) scanColumn(c *Column, rows *sql.Rows) error {
 		c.Type = field.TypeInt
 	case "real", "float", "double":
 		c.Type = field.TypeFloat64
+	case "decimal", "numeric":
+		c.Type = field.TypeDecimal
+		if c.Precision, c.Scale, err = parseDecimal(parts); err != nil {
+			return err
+		}
 	case "datetime":
 		c.Type = field.TypeTime
 	case "json":
@@ -76,6 +76,77 @@ func TestSQLite_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create new table with decimal",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "price", Type: field.TypeDecimal, Precision: 12, Scale: 2},
+						{Name: "fee", Type: field.TypeDecimal, Precision: 6, Scale: 2, Default: "0.50"},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `price` decimal(12,2) NOT NULL, `fee` decimal(6,2) NOT NULL DEFAULT '0.50')")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with default expressions",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "created_at", Type: field.TypeTime, DefaultExpr: "CURRENT_TIMESTAMP"},
+						{Name: "token", Type: field.TypeString, DefaultExpr: "hex(randomblob(16))", DefaultExprs: map[string]string{dialect.Postgres: "md5(random()::text)"}},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `created_at` datetime NOT NULL DEFAULT (CURRENT_TIMESTAMP), `token` varchar(255) NOT NULL DEFAULT (hex(randomblob(16))))")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create new table with generated columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "first_name", Type: field.TypeString},
+						{Name: "last_name", Type: field.TypeString},
+						{Name: "full_name", Type: field.TypeString, Generated: "first_name || ' ' || last_name", Stored: true},
+						{Name: "name_len", Type: field.TypeInt, Nullable: true, Generated: "length(first_name)"},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `first_name` varchar(255) NOT NULL, `last_name` varchar(255) NOT NULL, `full_name` varchar(255) GENERATED ALWAYS AS (first_name || ' ' || last_name) STORED NOT NULL, `name_len` integer GENERATED ALWAYS AS (length(first_name)) VIRTUAL NULL)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "create new table with foreign key",
 			tables: func() []*Table {
@@ -143,7 +214,7 @@ func TestSQLite_Create(t *testing.T) {
 			before: func(mock sqliteMock) {
 				mock.start()
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_xinfo('users') ORDER BY `pk`")).
 					WithArgs().
 					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
 						AddRow("name", "varchar(255)", 0, nil, 0).
@@ -176,7 +247,7 @@ func TestSQLite_Create(t *testing.T) {
 			before: func(mock sqliteMock) {
 				mock.start()
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_xinfo('users') ORDER BY `pk`")).
 					WithArgs().
 					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
 						AddRow("created_at", "datetime", 0, nil, 0).
@@ -213,7 +284,7 @@ func TestSQLite_Create(t *testing.T) {
 			before: func(mock sqliteMock) {
 				mock.start()
 				mock.tableExists("blobs", true)
-				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('blobs') ORDER BY `pk`")).
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_xinfo('blobs') ORDER BY `pk`")).
 					WithArgs().
 					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
 						AddRow("old_tiny", "blob", 1, nil, 0).
@@ -249,7 +320,7 @@ func TestSQLite_Create(t *testing.T) {
 			before: func(mock sqliteMock) {
 				mock.start()
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_xinfo('users') ORDER BY `pk`")).
 					WithArgs().
 					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
 						AddRow("id", "integer", 1, "NULL", 1))
@@ -292,7 +363,7 @@ func TestSQLite_Create(t *testing.T) {
 			before: func(mock sqliteMock) {
 				mock.start()
 				mock.tableExists("users", true)
-				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_info('users') ORDER BY `pk`")).
+				mock.ExpectQuery(escape("SELECT `name`, `type`, `notnull`, `dflt_value`, `pk` FROM pragma_table_xinfo('users') ORDER BY `pk`")).
 					WithArgs().
 					WillReturnRows(sqlmock.NewRows([]string{"name", "type", "notnull", "dflt_value", "pk"}).
 						AddRow("name", "varchar(255)", 1, "NULL", 0).
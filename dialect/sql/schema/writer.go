@@ -46,3 +46,25 @@ func (w *WriteDriver) Rollback() error {
 	_, err := io.WriteString(w, "ROLLBACK;\n")
 	return err
 }
+
+// PlanDriver is a driver that records all driver exec operations instead of executing them.
+// Query operations (e.g. schema inspection) are executed by the underlying driver.
+type PlanDriver struct {
+	dialect.Driver          // underlying driver.
+	Stmts          []string // recorded exec statements.
+}
+
+// Exec records its query without calling the underlying driver Exec method.
+func (p *PlanDriver) Exec(_ context.Context, query string, _, _ interface{}) error {
+	p.Stmts = append(p.Stmts, query)
+	return nil
+}
+
+// Tx returns the driver itself, because exec statements are not executed.
+func (p *PlanDriver) Tx(context.Context) (dialect.Tx, error) { return p, nil }
+
+// Commit is a nop, because exec statements are not executed.
+func (*PlanDriver) Commit() error { return nil }
+
+// Rollback is a nop, because exec statements are not executed.
+func (*PlanDriver) Rollback() error { return nil }
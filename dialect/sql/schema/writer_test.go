@@ -40,6 +40,21 @@ func TestWriteDriver(t *testing.T) {
 	require.Empty(t, lines[4], "file ends with blank line")
 }
 
+func TestPlanDriver(t *testing.T) {
+	p := &PlanDriver{Driver: nopDriver{}}
+	ctx := context.Background()
+	tx, err := p.Tx(ctx)
+	require.NoError(t, err)
+	err = tx.Query(ctx, "SELECT `name` FROM `users`", nil, nil)
+	require.NoError(t, err)
+	err = tx.Exec(ctx, "ALTER TABLE `users` ADD COLUMN `age` int", nil, nil)
+	require.NoError(t, err)
+	err = tx.Exec(ctx, "CREATE INDEX `user_age` ON `users`(`age`)", nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, []string{"ALTER TABLE `users` ADD COLUMN `age` int", "CREATE INDEX `user_age` ON `users`(`age`)"}, p.Stmts)
+}
+
 type nopDriver struct {
 	dialect.Driver
 }
@@ -12,7 +12,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"reflect"
 	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -77,6 +81,9 @@ type Step struct {
 		// In O2O and M2O, it holds the foreign-key column. Hence, len == 1.
 		// In M2M, it holds the primary-key columns of the join table. Hence, len == 2.
 		Columns []string
+		// SharedColumns holds the columns that prefix the foreign-key of the edge in case
+		// of a composite foreign-key. They are defined with the same names in both tables.
+		SharedColumns []string
 		// Inverse indicates if the edge is an inverse edge.
 		Inverse bool
 	}
@@ -121,6 +128,14 @@ func Edge(rel Rel, inverse bool, table string, columns ...string) StepOption {
 	}
 }
 
+// SharedColumns sets the columns that prefix the composite foreign-key of
+// the edge. They are added to the join conditions of the step.
+func SharedColumns(columns ...string) StepOption {
+	return func(s *Step) {
+		s.Edge.SharedColumns = columns
+	}
+}
+
 // NewStep gets list of options and returns a configured step.
 //
 //	NewStep(
@@ -158,13 +173,15 @@ func Neighbors(dialect string, s *Step) (q *sql.Selector) {
 			On(to.C(s.To.Column), match.C(pk1))
 	case r == M2O || (r == O2O && s.Edge.Inverse):
 		t1 := builder.Table(s.To.Table)
-		t2 := builder.Select(s.Edge.Columns[0]).
+		columns := append(append([]string{}, s.Edge.SharedColumns...), s.Edge.Columns[0])
+		t2 := builder.Select(columns...).
 			From(builder.Table(s.Edge.Table)).
 			Where(sql.EQ(s.From.Column, s.From.V))
 		q = builder.Select().
 			From(t1).
 			Join(t2).
 			On(t1.C(s.From.Column), t2.C(s.Edge.Columns[0]))
+		joinShared(q, t1, t2, s)
 	case r == O2M || (r == O2O && !s.Edge.Inverse):
 		q = builder.Select().
 			From(builder.Table(s.To.Table)).
@@ -197,22 +214,32 @@ func SetNeighbors(dialect string, s *Step) (q *sql.Selector) {
 			On(to.C(s.To.Column), match.C(pk1))
 	case r == M2O || (r == O2O && s.Edge.Inverse):
 		t1 := builder.Table(s.To.Table)
-		set.Select(set.C(s.Edge.Columns[0]))
+		set.Select(append(set.Columns(s.Edge.SharedColumns...), set.C(s.Edge.Columns[0]))...)
 		q = builder.Select().
 			From(t1).
 			Join(set).
 			On(t1.C(s.To.Column), set.C(s.Edge.Columns[0]))
+		joinShared(q, t1, set, s)
 	case r == O2M || (r == O2O && !s.Edge.Inverse):
 		t1 := builder.Table(s.To.Table)
-		set.Select(set.C(s.From.Column))
+		set.Select(append(set.Columns(s.Edge.SharedColumns...), set.C(s.From.Column))...)
 		q = builder.Select().
 			From(t1).
 			Join(set).
 			On(t1.C(s.Edge.Columns[0]), set.C(s.From.Column))
+		joinShared(q, t1, set, s)
 	}
 	return q
 }
 
+// joinShared adds the shared columns of the step to the join condition of the
+// neighbors, in order to join the tables on their composite foreign-key.
+func joinShared(q *sql.Selector, t1 *sql.SelectTable, t2 *sql.Selector, s *Step) {
+	for _, c := range s.Edge.SharedColumns {
+		q.AndOn(t1.C(c), t2.C(c))
+	}
+}
+
 // HasNeighbors applies on the given Selector a neighbors check.
 func HasNeighbors(q *sql.Selector, s *Step) {
 	builder := sql.Dialect(q.Dialect())
@@ -231,19 +258,18 @@ func HasNeighbors(q *sql.Selector, s *Step) {
 			),
 		)
 	case r == M2O || (r == O2O && s.Edge.Inverse):
+		// The shared columns are not checked, because the
+		// composite foreign-key is not null in this case.
 		from := q.Table()
 		q.Where(sql.NotNull(from.C(s.Edge.Columns[0])))
 	case r == O2M || (r == O2O && !s.Edge.Inverse):
 		from := q.Table()
-		to := builder.Table(s.Edge.Table)
-		q.Where(
-			sql.In(
-				from.C(s.From.Column),
-				builder.Select(to.C(s.Edge.Columns[0])).
-					From(to).
-					Where(sql.NotNull(to.C(s.Edge.Columns[0]))),
-			),
-		)
+		to := neighborsTable(builder, from, s.Edge.Table, s)
+		matches := builder.Select(to.C(s.Edge.Columns[0])).
+			From(to).
+			Where(sql.NotNull(to.C(s.Edge.Columns[0])))
+		matchShared(matches, from, to, s)
+		q.Where(sql.In(from.C(s.From.Column), matches))
 	}
 }
 
@@ -265,26 +291,57 @@ func HasNeighborsWith(q *sql.Selector, s *Step, pred func(*sql.Selector)) {
 			Join(to).
 			On(edge.C(pk1), to.C(s.To.Column))
 		matches := builder.Select().From(to)
-		pred(matches)
+		applyPredicate(q, matches, pred)
 		join.FromSelect(matches)
 		q.Where(sql.In(from.C(s.From.Column), join))
 	case r == M2O || (r == O2O && s.Edge.Inverse):
 		from := q.Table()
-		to := builder.Table(s.To.Table)
+		to := neighborsTable(builder, from, s.To.Table, s)
 		matches := builder.Select(to.C(s.To.Column)).
 			From(to)
-		pred(matches)
+		applyPredicate(q, matches, pred)
+		matchShared(matches, from, to, s)
 		q.Where(sql.In(from.C(s.Edge.Columns[0]), matches))
 	case r == O2M || (r == O2O && !s.Edge.Inverse):
 		from := q.Table()
-		to := builder.Table(s.Edge.Table)
+		to := neighborsTable(builder, from, s.Edge.Table, s)
 		matches := builder.Select(to.C(s.Edge.Columns[0])).
 			From(to)
-		pred(matches)
+		applyPredicate(q, matches, pred)
+		matchShared(matches, from, to, s)
 		q.Where(sql.In(from.C(s.From.Column), matches))
 	}
 }
 
+// neighborsTable returns the table of the neighbors for the sub-query of a neighbors check.
+// In case the step has shared columns, the sub-query references the columns of the outer
+// query, and therefore, the table is aliased if it has the same name (e.g. edges to the same
+// type).
+func neighborsTable(builder *sql.DialectBuilder, from *sql.SelectTable, table string, s *Step) *sql.SelectTable {
+	to := builder.Table(table)
+	if len(s.Edge.SharedColumns) > 0 && to.C(s.To.Column) == from.C(s.To.Column) {
+		to.As("t1")
+	}
+	return to
+}
+
+// matchShared adds the shared columns of the step to the predicates of the neighbors
+// sub-query, in order to match the neighbors by their composite foreign-key.
+func matchShared(matches *sql.Selector, from, to *sql.SelectTable, s *Step) {
+	for _, c := range s.Edge.SharedColumns {
+		matches.Where(sql.ColumnsEQ(to.C(c), from.C(c)))
+	}
+}
+
+// applyPredicate applies the given predicate on the selector of a sub-query, and
+// adds the errors that were added by the predicate to the root query selector.
+func applyPredicate(q, s *sql.Selector, pred func(*sql.Selector)) {
+	pred(s)
+	if err := s.Err(); err != nil {
+		q.AddError(err)
+	}
+}
+
 type (
 	// FieldSpec holds the information for updating a field
 	// column in the database.
@@ -310,6 +367,9 @@ type (
 		Columns []string
 		Bidi    bool        // bidirectional edge.
 		Target  *EdgeTarget // target nodes.
+		// SharedColumns holds the columns that prefix the
+		// foreign-key of the edge in case it is a composite key.
+		SharedColumns []string
 	}
 
 	// EdgeSpecs used for perform common operations on list of edges.
@@ -324,6 +384,16 @@ type (
 	}
 )
 
+// hasColumn reports if the given column is one of the node columns.
+func (n *NodeSpec) hasColumn(column string) bool {
+	for _, c := range n.Columns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
 // CreateSpec holds the information for creating
 // a node in the graph.
 type CreateSpec struct {
@@ -331,6 +401,14 @@ type CreateSpec struct {
 	ID     *FieldSpec
 	Fields []*FieldSpec
 	Edges  []*EdgeSpec
+
+	// Columns, ScanValues and Assign configure CreateNode to read back
+	// the row of the node after it was inserted, in order to get the
+	// values that were set by the database (e.g. default expressions).
+	// Columns holds the columns to read, starting with the id column.
+	Columns    []string
+	ScanValues func() []interface{}
+	Assign     func(...interface{}) error
 }
 
 // CreateNode applies the CreateSpec on the graph.
@@ -339,7 +417,7 @@ func CreateNode(ctx context.Context, drv dialect.Driver, spec *CreateSpec) error
 	if err != nil {
 		return err
 	}
-	gr := graph{tx: tx, builder: sql.Dialect(drv.Dialect())}
+	gr := graph{tx: tx, builder: sql.Dialect(drv.Dialect()), dryRun: isDryRun(drv)}
 	cr := &creator{CreateSpec: spec, graph: gr}
 	if err := cr.node(ctx, tx); err != nil {
 		return rollback(tx, err)
@@ -347,6 +425,54 @@ func CreateNode(ctx context.Context, drv dialect.Driver, spec *CreateSpec) error
 	return tx.Commit()
 }
 
+// BatchCreateSpec holds the information for creating
+// multiple nodes in the graph.
+type BatchCreateSpec struct {
+	Nodes      []*CreateSpec
+	OnConflict []sql.ConflictOption
+
+	// Columns, ScanValues and Assign configure BatchCreate to read back the
+	// final rows of the nodes after they were inserted, or updated by the
+	// conflict clause. Columns holds the columns to read (starting with the
+	// id column), and ConflictColumns holds the unique columns that locate
+	// the rows in dialects that do not support the `RETURNING` clause.
+	Columns         []string
+	ConflictColumns []string
+	ScanValues      func(int) []interface{}
+	Assign          func(int, ...interface{}) error
+
+	// Skipped holds the indexes of the nodes that were not
+	// inserted because of the OnConflict options. It is set
+	// by BatchCreate.
+	Skipped []int
+}
+
+// BatchCreate applies the BatchCreateSpec on the graph.
+//
+// When no conflict options are provided, all nodes are inserted in one statement.
+// Otherwise, nodes are inserted one by one in the same transaction, in order to
+// report which of them were skipped by the conflict clause. If the context is
+// canceled between the statements, the transaction is rolled back, and the
+// context error is returned.
+func BatchCreate(ctx context.Context, drv dialect.Driver, spec *BatchCreateSpec) error {
+	if len(spec.Nodes) == 0 {
+		return nil
+	}
+	if isDryRun(drv) {
+		return errDryRun("BatchCreate")
+	}
+	tx, err := drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	gr := graph{tx: tx, builder: sql.Dialect(drv.Dialect())}
+	cr := &batchCreator{BatchCreateSpec: spec, graph: gr}
+	if err := cr.nodes(ctx, tx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
 type (
 	// EdgeMut defines edge mutations.
 	EdgeMut struct {
@@ -358,7 +484,11 @@ type (
 	FieldMut struct {
 		Set   []*FieldSpec // field = ?
 		Add   []*FieldSpec // field = field + ?
-		Clear []*FieldSpec // field = NULL
+		Merge []*FieldSpec // field = field || ?
+		// SetPath holds JSON fields with a map[string]interface{} value
+		// from dot-separated paths to the values stored under them.
+		SetPath []*FieldSpec // field = json_set(field, path, ?)
+		Clear   []*FieldSpec // field = NULL
 	}
 
 	// UpdateSpec holds the information for updating one
@@ -368,19 +498,26 @@ type (
 		Edges     EdgeMut
 		Fields    FieldMut
 		Predicate func(*sql.Selector)
+		// Limit, if positive, is the maximum number of nodes that are updated by UpdateNodes.
+		Limit int
+		// ScanIDs, if not nil, is a pointer to a slice (e.g. *[]int) that the ids
+		// of the nodes that are updated by UpdateNodes are scanned into.
+		ScanIDs interface{}
 
 		ScanValues []interface{}
 		Assign     func(...interface{}) error
 	}
 )
 
-// UpdateNode applies the UpdateSpec on one node in the graph.
+// UpdateNode applies the UpdateSpec on one node in the graph. If the spec
+// has a predicate, the node is updated only if it matches the predicate,
+// and a NotFoundError is returned otherwise.
 func UpdateNode(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) error {
 	tx, err := drv.Tx(ctx)
 	if err != nil {
 		return err
 	}
-	gr := graph{tx: tx, builder: sql.Dialect(drv.Dialect())}
+	gr := graph{tx: tx, builder: sql.Dialect(drv.Dialect()), dryRun: isDryRun(drv)}
 	cr := &updater{UpdateSpec: spec, graph: gr}
 	if err := cr.node(ctx, tx); err != nil {
 		return rollback(tx, err)
@@ -390,6 +527,9 @@ func UpdateNode(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) error
 
 // UpdateNodes applies the UpdateSpec on a set of nodes in the graph.
 func UpdateNodes(ctx context.Context, drv dialect.Driver, spec *UpdateSpec) (int, error) {
+	if isDryRun(drv) {
+		return 0, errDryRun("UpdateNodes")
+	}
 	tx, err := drv.Tx(ctx)
 	if err != nil {
 		return 0, err
@@ -419,6 +559,10 @@ func (e *NotFoundError) Error() string {
 type DeleteSpec struct {
 	Node      *NodeSpec
 	Predicate func(*sql.Selector)
+	// Limit, if positive, is the maximum number of nodes that are deleted. It is
+	// applied using a `LIMIT` clause in MySQL, and using a subquery that selects
+	// the ids of the deleted nodes in other dialects.
+	Limit int
 }
 
 // DeleteNodes applies the DeleteSpec on the graph.
@@ -436,7 +580,23 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	if pred := spec.Predicate; pred != nil {
 		pred(selector)
 	}
-	query, args := builder.Delete(spec.Node.Table).FromSelect(selector).Query()
+	if err := selector.Err(); err != nil {
+		return 0, rollback(tx, err)
+	}
+	del := builder.Delete(spec.Node.Table)
+	switch {
+	case spec.Limit <= 0:
+		del.FromSelect(selector)
+	case drv.Dialect() == dialect.MySQL:
+		del.FromSelect(selector).Limit(spec.Limit)
+	default:
+		selector.Select(selector.C(spec.Node.ID.Column)).Limit(spec.Limit)
+		del.Where(sql.In(spec.Node.ID.Column, selector))
+	}
+	query, args := del.Query()
+	if err := del.Err(); err != nil {
+		return 0, rollback(tx, err)
+	}
 	if err := tx.Exec(ctx, query, args, &res); err != nil {
 		return 0, rollback(tx, err)
 	}
@@ -447,6 +607,63 @@ func DeleteNodes(ctx context.Context, drv dialect.Driver, spec *DeleteSpec) (int
 	return int(affected), tx.Commit()
 }
 
+// InsertSelectSpec holds the information for inserting the
+// rows that are selected by a query into a table of the graph.
+type InsertSelectSpec struct {
+	Table   string            // Target table.
+	Columns []string          // Valid columns of the target table.
+	Mapping map[string]string // Target columns to source columns.
+	Source  IDsQuerier        // Source query.
+}
+
+// InsertSelect applies the InsertSelectSpec on the graph using one statement
+// (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows.
+// The columns selection of the source query is replaced by the mapping.
+func InsertSelect(ctx context.Context, drv dialect.Driver, spec *InsertSelectSpec) (int, error) {
+	if len(spec.Mapping) == 0 {
+		return 0, fmt.Errorf("sqlgraph: missing column mapping for table %q", spec.Table)
+	}
+	valid := make(map[string]bool, len(spec.Columns))
+	for _, c := range spec.Columns {
+		valid[c] = true
+	}
+	columns := make([]string, 0, len(spec.Mapping))
+	for c, src := range spec.Mapping {
+		switch {
+		case !valid[c]:
+			return 0, fmt.Errorf("sqlgraph: unknown column %q for table %q", c, spec.Table)
+		case src == "":
+			return 0, fmt.Errorf("sqlgraph: missing source column for %q", c)
+		}
+		columns = append(columns, c)
+	}
+	// Keep the statement stable between executions.
+	sort.Strings(columns)
+	selector := spec.Source.IDsQuery()
+	selection := make([]string, len(columns))
+	for i, c := range columns {
+		selection[i] = selector.C(spec.Mapping[c])
+	}
+	selector.Select(selection...)
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	var res sql.Result
+	query, args := sql.Dialect(drv.Dialect()).
+		Insert(spec.Table).
+		Columns(columns...).
+		Select(selector).
+		Query()
+	if err := drv.Exec(ctx, query, args, &res); err != nil {
+		return 0, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
 // QuerySpec holds the information for querying
 // nodes in the graph.
 type QuerySpec struct {
@@ -459,6 +676,21 @@ type QuerySpec struct {
 	Order     func(*sql.Selector)
 	Predicate func(*sql.Selector)
 
+	// ForUpdate locks the rows that are selected by QueryNodes until the end
+	// of the transaction. The rows are not made unique in this case, because
+	// the `FOR UPDATE` clause cannot be used with DISTINCT in PostgreSQL.
+	ForUpdate bool
+
+	// PartitionBy applies the Limit and the Offset to each group of nodes with the same value
+	// in the given column (e.g. the foreign-key of an eager-loaded edge), instead of the whole
+	// query. The nodes of each group are numbered in the query order using the ROW_NUMBER window
+	// function, and therefore, it requires PostgreSQL, MySQL 8 or SQLite 3.25 (or above).
+	PartitionBy string
+
+	// Exprs are expression columns that are selected after the node columns
+	// by QueryNodes, and their values are scanned after the node values.
+	Exprs []*sql.SelectExpr
+
 	ScanValues func() []interface{}
 	Assign     func(...interface{}) error
 }
@@ -477,6 +709,26 @@ func CountNodes(ctx context.Context, drv dialect.Driver, spec *QuerySpec) (int,
 	return qr.count(ctx, drv)
 }
 
+// CountDistinct counts the distinct values of the given columns in the nodes of the given
+// graph query. Multiple columns count the distinct tuples of their values, and are supported
+// only by MySQL and PostgreSQL. Note that NULL values are not counted.
+func CountDistinct(ctx context.Context, drv dialect.Driver, spec *QuerySpec, columns ...string) (int, error) {
+	builder := sql.Dialect(drv.Dialect())
+	qr := &query{graph: graph{builder: builder}, QuerySpec: spec}
+	return qr.countDistinct(ctx, drv, columns)
+}
+
+// IDsQuerier wraps the IDsQuery method that is implemented by the generated
+// query builders. It returns a selector that selects only the identifiers of
+// the matched nodes, and it is used for embedding a query as a sub-query in
+// predicates of other nodes. For example:
+//
+//	WHERE owner_id IN (SELECT id FROM users WHERE ...)
+//
+type IDsQuerier interface {
+	IDsQuery() *sql.Selector
+}
+
 // EdgeQuerySpec holds the information for querying
 // edges in the graph.
 type EdgeQuerySpec struct {
@@ -526,7 +778,14 @@ type query struct {
 
 func (q *query) nodes(ctx context.Context, drv dialect.Driver) error {
 	rows := &sql.Rows{}
-	query, args := q.selector().Query()
+	selector := q.selector()
+	if q.ForUpdate {
+		selector.SetDistinct(false).ForUpdate()
+	}
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	if err := drv.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -552,6 +811,44 @@ func (q *query) count(ctx context.Context, drv dialect.Driver) (int, error) {
 		selector.Count(sql.Distinct(selector.C(q.Node.ID.Column)))
 	}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	return sql.ScanInt(rows)
+}
+
+func (q *query) countDistinct(ctx context.Context, drv dialect.Driver, columns []string) (int, error) {
+	if len(columns) == 0 {
+		return 0, fmt.Errorf("sqlgraph: missing columns for counting distinct values")
+	}
+	if len(columns) > 1 && drv.Dialect() == dialect.SQLite {
+		return 0, fmt.Errorf("sqlgraph: counting distinct values of multiple columns is not supported by SQLite")
+	}
+	for _, c := range columns {
+		if !q.Node.hasColumn(c) {
+			return 0, fmt.Errorf("sqlgraph: unknown column %q for table %q", c, q.Node.Table)
+		}
+	}
+	rows := &sql.Rows{}
+	selector := q.selector()
+	selector.SetDistinct(false)
+	idents := make([]string, len(columns))
+	for i, c := range columns {
+		idents[i] = selector.C(c)
+	}
+	if len(idents) > 1 && drv.Dialect() == dialect.Postgres {
+		// PostgreSQL counts distinct tuples using the row constructor.
+		idents = []string{"(" + strings.Join(idents, ", ") + ")"}
+	}
+	selector.Count(sql.Distinct(idents...))
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	if err := drv.Query(ctx, query, args, rows); err != nil {
 		return 0, err
 	}
@@ -564,10 +861,13 @@ func (q *query) selector() *sql.Selector {
 	if q.From != nil {
 		selector = q.From
 	}
-	selector.Select(selector.Columns(q.Node.Columns...)...)
+	selector.Select(selector.Columns(q.Node.Columns...)...).AppendSelectExpr(q.Exprs...)
 	if pred := q.Predicate; pred != nil {
 		pred(selector)
 	}
+	if q.PartitionBy != "" && (q.Limit != 0 || q.Offset != 0) {
+		return q.partition(selector)
+	}
 	if order := q.Order; order != nil {
 		order(selector)
 	}
@@ -585,6 +885,29 @@ func (q *query) selector() *sql.Selector {
 	return selector
 }
 
+// partition wraps the given selector with a selector that returns the rows of each
+// partition whose row numbers (in the query order) are in the limit-offset range.
+func (q *query) partition(inner *sql.Selector) *sql.Selector {
+	const rowNumber = "row_number"
+	w := sql.RowNumber().PartitionBy(inner.C(q.PartitionBy))
+	if order := q.Order; order != nil {
+		ordered := inner.Clone()
+		order(ordered)
+		w.OrderBy(ordered.OrderColumns()...)
+	}
+	inner.Select(append(inner.Columns(q.Node.Columns...), sql.As(w.String(), rowNumber))...).As("t")
+	columns := inner.Columns(q.Node.Columns...)
+	for _, e := range q.Exprs {
+		columns = append(columns, inner.C(e.Alias()))
+	}
+	selector := q.builder.Select(columns...).From(inner)
+	selector.Where(sql.GT(inner.C(rowNumber), q.Offset))
+	if q.Limit != 0 {
+		selector.Where(sql.LTE(inner.C(rowNumber), q.Offset+q.Limit))
+	}
+	return selector.OrderBy(inner.C(q.PartitionBy), inner.C(rowNumber))
+}
+
 type updater struct {
 	graph
 	*UpdateSpec
@@ -599,19 +922,46 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
 	)
 	update := u.builder.Update(u.Node.Table).Where(sql.EQ(u.Node.ID.Column, id))
+	if u.Predicate != nil {
+		p, err := u.nodePredicate()
+		if err != nil {
+			return err
+		}
+		update.Where(p)
+	}
 	if err := u.setTableColumns(update, addEdges, clearEdges); err != nil {
 		return err
 	}
+	var matched bool
 	if !update.Empty() {
 		var res sql.Result
 		query, args := update.Query()
 		if err := tx.Exec(ctx, query, args, &res); err != nil {
 			return err
 		}
+		if u.Predicate != nil {
+			affected, err := res.RowsAffected()
+			if err != nil {
+				return err
+			}
+			matched = affected > 0
+		}
+	}
+	// Some databases (like MySQL) report only the rows that were actually changed.
+	// Hence, zero affected rows does not mean that the node does not match the predicate.
+	if u.Predicate != nil && !matched && !u.dryRun {
+		if err := u.matchNode(ctx, tx); err != nil {
+			return err
+		}
 	}
 	if err := u.setExternalEdges(ctx, []driver.Value{id}, addEdges, clearEdges); err != nil {
 		return err
 	}
+	// Statements are not executed in dry-run mode,
+	// and therefore, the node cannot be read back.
+	if u.dryRun {
+		return nil
+	}
 	selector := u.builder.Select(u.Node.Columns...).
 		From(u.builder.Table(u.Node.Table)).
 		Where(sql.EQ(u.Node.ID.Column, u.Node.ID.Value))
@@ -623,24 +973,70 @@ func (u *updater) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	return u.scan(rows)
 }
 
+// nodePredicate returns the predicate of the spec as an SQL predicate.
+func (u *updater) nodePredicate() (*sql.Predicate, error) {
+	selector := u.builder.Select().From(u.builder.Table(u.Node.Table))
+	u.Predicate(selector)
+	if err := selector.Err(); err != nil {
+		return nil, err
+	}
+	return selector.P(), nil
+}
+
+// matchNode returns a NotFoundError if the node does not match the spec predicate.
+func (u *updater) matchNode(ctx context.Context, tx dialect.ExecQuerier) error {
+	selector := u.builder.Select(u.Node.ID.Column).
+		From(u.builder.Table(u.Node.Table)).
+		Where(sql.EQ(u.Node.ID.Column, u.Node.ID.Value))
+	u.Predicate(selector)
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return &NotFoundError{table: u.Node.Table, id: u.Node.ID.Value}
+	}
+	return rows.Close()
+}
+
 func (u *updater) nodes(ctx context.Context, tx dialect.ExecQuerier) (int, error) {
 	var (
 		ids        []driver.Value
 		addEdges   = EdgeSpecs(u.Edges.Add).GroupRel()
 		clearEdges = EdgeSpecs(u.Edges.Clear).GroupRel()
 	)
+	if u.ScanIDs != nil {
+		affected, ok, err := u.nodesReturning(ctx, tx, addEdges, clearEdges)
+		if ok || err != nil {
+			return affected, err
+		}
+	}
 	selector := u.builder.Select(u.Node.ID.Column).
 		From(u.builder.Table(u.Node.Table))
 	if pred := u.Predicate; pred != nil {
 		pred(selector)
 	}
+	if u.Limit > 0 {
+		selector.Limit(u.Limit)
+	}
 	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return 0, err
+	}
 	rows := &sql.Rows{}
 	if err := u.tx.Query(ctx, query, args, rows); err != nil {
-		return 0, fmt.Errorf("querying table %s: %v", u.Node.Table, err)
+		return 0, fmt.Errorf("querying table %s: %w", u.Node.Table, err)
 	}
 	defer rows.Close()
-	if err := sql.ScanSlice(rows, &ids); err != nil {
+	if err := u.scanIDs(rows, &ids); err != nil {
 		return 0, fmt.Errorf("scan node ids: %v", err)
 	}
 	if err := rows.Close(); err != nil {
@@ -666,6 +1062,114 @@ func (u *updater) nodes(ctx context.Context, tx dialect.ExecQuerier) (int, error
 	return len(ids), nil
 }
 
+// nodesReturning updates the nodes and scans their ids using the `RETURNING` clause of the
+// UPDATE statement, instead of selecting them before the update. It reports false if the
+// statement was not executed, because there are no columns to update in the nodes table, or
+// because the database does not support the `RETURNING` clause (MySQL, and SQLite before 3.35).
+func (u *updater) nodesReturning(ctx context.Context, tx dialect.ExecQuerier, addEdges, clearEdges map[Rel][]*EdgeSpec) (int, bool, error) {
+	update := u.builder.Update(u.Node.Table)
+	switch update.Dialect() {
+	case dialect.Postgres:
+	case dialect.SQLite:
+		supported, err := sqliteReturning.supported(ctx, tx)
+		if err != nil || !supported {
+			return 0, false, err
+		}
+	default:
+		return 0, false, nil
+	}
+	if err := u.setTableColumns(update, addEdges, clearEdges); err != nil {
+		return 0, false, err
+	}
+	if update.Empty() {
+		return 0, false, nil
+	}
+	selector := u.builder.Select().
+		From(u.builder.Table(u.Node.Table))
+	if pred := u.Predicate; pred != nil {
+		pred(selector)
+	}
+	if u.Limit > 0 {
+		selector.Select(selector.C(u.Node.ID.Column)).Limit(u.Limit)
+		update.Where(sql.In(u.Node.ID.Column, selector))
+	} else {
+		update.FromSelect(selector)
+	}
+	query, args := update.Returning(u.Node.ID.Column).Query()
+	if err := selector.Err(); err != nil {
+		return 0, false, err
+	}
+	if err := update.Err(); err != nil {
+		return 0, false, err
+	}
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return 0, false, fmt.Errorf("updating table %s: %w", u.Node.Table, err)
+	}
+	defer rows.Close()
+	var ids []driver.Value
+	if err := u.scanIDs(rows, &ids); err != nil {
+		return 0, false, fmt.Errorf("scan node ids: %v", err)
+	}
+	if err := rows.Close(); err != nil {
+		return 0, false, err
+	}
+	if err := u.setExternalEdges(ctx, ids, addEdges, clearEdges); err != nil {
+		return 0, false, err
+	}
+	return len(ids), true, nil
+}
+
+// sqliteReturning reports if the SQLite library supports the `RETURNING` clause (added in 3.35).
+// Since SQLite is embedded in the process, its version is detected once, by the first update
+// that needs it.
+var sqliteReturning returningSupport
+
+type returningSupport struct {
+	sync.Mutex
+	checked, ok bool
+}
+
+// supported reports if the `RETURNING` clause is supported by the SQLite version of the connection.
+func (r *returningSupport) supported(ctx context.Context, tx dialect.ExecQuerier) (bool, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.checked {
+		return r.ok, nil
+	}
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, "SELECT sqlite_version()", []interface{}{}, rows); err != nil {
+		return false, fmt.Errorf("querying sqlite version: %w", err)
+	}
+	defer rows.Close()
+	version, err := sql.ScanString(rows)
+	if err != nil {
+		return false, fmt.Errorf("scanning sqlite version: %w", err)
+	}
+	var major, minor int
+	if _, err := fmt.Sscanf(version, "%d.%d", &major, &minor); err != nil {
+		return false, fmt.Errorf("parsing sqlite version %q: %w", version, err)
+	}
+	r.checked, r.ok = true, major > 3 || major == 3 && minor >= 35
+	return r.ok, nil
+}
+
+// scanIDs scans the node ids into the given slice, and into the ScanIDs slice of the spec if it was set.
+func (u *updater) scanIDs(rows *sql.Rows, ids *[]driver.Value) error {
+	if u.ScanIDs == nil {
+		return sql.ScanSlice(rows, ids)
+	}
+	if err := sql.ScanSlice(rows, u.ScanIDs); err != nil {
+		return err
+	}
+	rv := reflect.Indirect(reflect.ValueOf(u.ScanIDs))
+	*ids = make([]driver.Value, rv.Len())
+	for i := range *ids {
+		(*ids)[i] = rv.Index(i).Interface()
+	}
+	return nil
+}
+
 func (u *updater) setExternalEdges(ctx context.Context, ids []driver.Value, addEdges, clearEdges map[Rel][]*EdgeSpec) error {
 	if err := u.graph.clearM2MEdges(ctx, ids, clearEdges[M2M]); err != nil {
 		return err
@@ -676,7 +1180,7 @@ func (u *updater) setExternalEdges(ctx context.Context, ids []driver.Value, addE
 	if err := u.graph.clearFKEdges(ctx, ids, append(clearEdges[O2M], clearEdges[O2O]...)); err != nil {
 		return err
 	}
-	if err := u.graph.addFKEdges(ctx, ids, append(addEdges[O2M], addEdges[O2O]...)); err != nil {
+	if err := u.graph.addFKEdges(ctx, u.Node, ids, append(addEdges[O2M], addEdges[O2O]...)); err != nil {
 		return err
 	}
 	return nil
@@ -708,16 +1212,45 @@ func (u *updater) setTableColumns(update *sql.UpdateBuilder, addEdges, clearEdge
 			update.SetNull(col)
 		}
 	}
-	err := setTableColumns(u.Fields.Set, addEdges, func(column string, value driver.Value) {
+	err := setTableColumns(update.Dialect(), u.Fields.Set, addEdges, func(column string, value driver.Value) {
 		update.Set(column, value)
 	})
 	if err != nil {
 		return err
 	}
 	for _, fi := range u.Fields.Add {
-		update.Add(fi.Column, fi.Value)
+		value := fi.Value
+		if fi.Type == field.TypeDuration {
+			value = durationValue(update.Dialect(), fi)
+		}
+		update.Add(fi.Column, value)
 	}
-	return nil
+	for _, fi := range u.Fields.Merge {
+		buf, err := json.Marshal(fi.Value)
+		if err != nil {
+			return fmt.Errorf("marshal value for column %s: %v", fi.Column, err)
+		}
+		update.MergeJSON(fi.Column, string(buf))
+	}
+	for _, fi := range u.Fields.SetPath {
+		values, ok := fi.Value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected JSON path values %T for column %s", fi.Value, fi.Column)
+		}
+		paths := make([]string, 0, len(values))
+		for path := range values {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			buf, err := json.Marshal(values[path])
+			if err != nil {
+				return fmt.Errorf("marshal value for column %s at path %q: %v", fi.Column, path, err)
+			}
+			update.SetJSONPath(fi.Column, strings.Split(path, "."), string(buf))
+		}
+	}
+	return update.Err()
 }
 
 func (u *updater) scan(rows *sql.Rows) error {
@@ -748,13 +1281,20 @@ func (c *creator) node(ctx context.Context, tx dialect.ExecQuerier) error {
 	if err := c.setTableColumns(insert, edges); err != nil {
 		return err
 	}
-	if err := c.insert(ctx, tx, insert); err != nil {
-		return fmt.Errorf("insert node to table %q: %v", c.Table, err)
+	insertFn := c.insert
+	switch {
+	case c.dryRun:
+		insertFn = c.insertDryRun
+	case len(c.Columns) > 0:
+		insertFn = c.insertReadBack
+	}
+	if err := insertFn(ctx, tx, insert); err != nil {
+		return fmt.Errorf("insert node to table %q: %w", c.Table, err)
 	}
 	if err := c.graph.addM2MEdges(ctx, []driver.Value{c.ID.Value}, edges[M2M]); err != nil {
 		return err
 	}
-	if err := c.graph.addFKEdges(ctx, []driver.Value{c.ID.Value}, append(edges[O2M], edges[O2O]...)); err != nil {
+	if err := c.graph.addFKEdges(ctx, &NodeSpec{Table: c.Table, ID: c.ID}, []driver.Value{c.ID.Value}, append(edges[O2M], edges[O2O]...)); err != nil {
 		return err
 	}
 	return nil
@@ -762,7 +1302,7 @@ func (c *creator) node(ctx context.Context, tx dialect.ExecQuerier) error {
 
 // setTableColumns sets the table columns and foreign_keys used in insert.
 func (c *creator) setTableColumns(insert *sql.InsertBuilder, edges map[Rel][]*EdgeSpec) error {
-	err := setTableColumns(c.Fields, edges, func(column string, value driver.Value) {
+	err := setTableColumns(insert.Dialect(), c.Fields, edges, func(column string, value driver.Value) {
 		insert.Set(column, value)
 	})
 	return err
@@ -777,6 +1317,17 @@ func (c *creator) insert(ctx context.Context, tx dialect.ExecQuerier, insert *sq
 		query, args := insert.Query()
 		return tx.Exec(ctx, query, args, &res)
 	}
+	if rawID(c.ID) {
+		ids, err := c.insertRawIDs(ctx, tx, insert, c.Table, c.ID.Column)
+		if err != nil {
+			return err
+		}
+		if len(ids) != 1 {
+			return fmt.Errorf("unexpected number of returned ids: %d != 1", len(ids))
+		}
+		c.ID.Value = ids[0]
+		return nil
+	}
 	id, err := insertLastID(ctx, tx, insert.Returning(c.ID.Column))
 	if err != nil {
 		return err
@@ -785,6 +1336,392 @@ func (c *creator) insert(ctx context.Context, tx dialect.ExecQuerier, insert *sq
 	return nil
 }
 
+// insertDryRun records the insert statement of the node in dry-run mode. Since the statement
+// is not executed, ids that are generated by the database are set to their zero values.
+func (c *creator) insertDryRun(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+	if c.ID.Value != nil {
+		insert.Set(c.ID.Column, c.ID.Value)
+	}
+	var res sql.Result
+	query, args := insert.Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return err
+	}
+	if c.ID.Value == nil {
+		c.ID.Value = int64(0)
+		if rawID(c.ID) {
+			c.ID.Value = ""
+		}
+	}
+	return nil
+}
+
+// insertReadBack inserts the node and reads back its row. PostgreSQL reads the
+// row using the `RETURNING` clause, and other dialects query it by its id after
+// the insert.
+func (c *creator) insertReadBack(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+	rows := &sql.Rows{}
+	if insert.Dialect() == dialect.Postgres {
+		if c.ID.Value != nil {
+			insert.Set(c.ID.Column, c.ID.Value)
+		}
+		query, args := insert.Returning(c.Columns...).Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+	} else {
+		if err := c.insert(ctx, tx, insert); err != nil {
+			return err
+		}
+		query, args := c.builder.Select(c.Columns...).
+			From(c.builder.Table(c.Table)).
+			Where(sql.EQ(c.ID.Column, c.ID.Value)).
+			Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+	}
+	values := c.ScanValues()
+	if err := scanRow(rows, c.CreateSpec, values); err != nil {
+		return err
+	}
+	return c.Assign(values...)
+}
+
+type batchCreator struct {
+	graph
+	*BatchCreateSpec
+}
+
+func (c *batchCreator) nodes(ctx context.Context, tx dialect.ExecQuerier) error {
+	if len(c.OnConflict) > 0 {
+		return c.nodesOnConflict(ctx, tx)
+	}
+	var (
+		columns = make(map[string]int)
+		values  = make([]map[string]driver.Value, len(c.Nodes))
+		edges   = make([]map[Rel][]*EdgeSpec, len(c.Nodes))
+		insert  = c.builder.Insert(c.Nodes[0].Table).Default()
+	)
+	for i, node := range c.Nodes {
+		if i > 0 && (node.ID.Value != nil) != (c.Nodes[0].ID.Value != nil) {
+			return fmt.Errorf("inconsistent id values for batch insert to table %q", node.Table)
+		}
+		values[i] = make(map[string]driver.Value)
+		edges[i] = EdgeSpecs(node.Edges).GroupRel()
+		err := setTableColumns(insert.Dialect(), node.Fields, edges[i], func(column string, value driver.Value) {
+			if _, ok := columns[column]; !ok {
+				columns[column] = len(columns)
+			}
+			values[i][column] = value
+		})
+		if err != nil {
+			return err
+		}
+		if node.ID.Value != nil {
+			if _, ok := columns[node.ID.Column]; !ok {
+				columns[node.ID.Column] = len(columns)
+			}
+			values[i][node.ID.Column] = node.ID.Value
+		}
+	}
+	keys := make([]string, len(columns))
+	for column, i := range columns {
+		keys[i] = column
+	}
+	insert.Columns(keys...)
+	for i := range values {
+		vs := make([]interface{}, len(keys))
+		for j, column := range keys {
+			vs[j] = values[i][column]
+		}
+		insert.Values(vs...)
+	}
+	if err := c.insert(ctx, tx, insert); err != nil {
+		return fmt.Errorf("insert nodes to table %q: %w", c.Nodes[0].Table, err)
+	}
+	for i, node := range c.Nodes {
+		// Edges are added using separate statements, and
+		// they are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := c.graph.addM2MEdges(ctx, []driver.Value{node.ID.Value}, edges[i][M2M]); err != nil {
+			return err
+		}
+		if err := c.graph.addFKEdges(ctx, &NodeSpec{Table: node.Table, ID: node.ID}, []driver.Value{node.ID.Value}, append(edges[i][O2M], edges[i][O2O]...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert inserts the nodes to their table and sets their IDs if they weren't provided by the user.
+func (c *batchCreator) insert(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) error {
+	var res sql.Result
+	// If the id field was provided by the user.
+	if c.Nodes[0].ID.Value != nil {
+		query, args := insert.Query()
+		return tx.Exec(ctx, query, args, &res)
+	}
+	if node := c.Nodes[0]; rawID(node.ID) {
+		ids, err := c.insertRawIDs(ctx, tx, insert, node.Table, node.ID.Column)
+		if err != nil {
+			return err
+		}
+		if len(ids) != len(c.Nodes) {
+			return fmt.Errorf("unexpected number of returned ids: %d != %d", len(ids), len(c.Nodes))
+		}
+		for i := range c.Nodes {
+			c.Nodes[i].ID.Value = ids[i]
+		}
+		return nil
+	}
+	// PostgreSQL returns the ids using the `RETURNING` clause.
+	if insert.Dialect() == dialect.Postgres {
+		query, args := insert.Returning(c.Nodes[0].ID.Column).Query()
+		rows := &sql.Rows{}
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+		defer rows.Close()
+		var ids []int64
+		if err := sql.ScanSlice(rows, &ids); err != nil {
+			return err
+		}
+		if len(ids) != len(c.Nodes) {
+			return fmt.Errorf("unexpected number of returned ids: %d != %d", len(ids), len(c.Nodes))
+		}
+		for i := range c.Nodes {
+			c.Nodes[i].ID.Value = ids[i]
+		}
+		return nil
+	}
+	query, args := insert.Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return err
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	// MySQL returns the id of the first inserted row, and SQLite
+	// returns the id of the last one. IDs are allocated sequentially.
+	if insert.Dialect() == dialect.SQLite {
+		id -= int64(len(c.Nodes) - 1)
+	}
+	for i := range c.Nodes {
+		c.Nodes[i].ID.Value = id + int64(i)
+	}
+	return nil
+}
+
+// nodesOnConflict inserts the nodes one by one, and records the nodes that were skipped by
+// the conflict clause. Affected rows are used for detecting skipped nodes, because MySQL
+// does not report which rows were ignored by `INSERT IGNORE`.
+func (c *batchCreator) nodesOnConflict(ctx context.Context, tx dialect.ExecQuerier) error {
+	c.Skipped = c.Skipped[:0]
+	for i, node := range c.Nodes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		edges := EdgeSpecs(node.Edges).GroupRel()
+		insert := c.builder.Insert(node.Table).Default().OnConflict(c.OnConflict...)
+		if err := setTableColumns(insert.Dialect(), node.Fields, edges, func(column string, value driver.Value) {
+			insert.Set(column, value)
+		}); err != nil {
+			return err
+		}
+		if c.ScanValues != nil {
+			if err := c.insertReadBack(ctx, tx, i, node, insert); err != nil {
+				return fmt.Errorf("insert node to table %q: %w", node.Table, err)
+			}
+		} else if inserted, err := c.insertOnConflict(ctx, tx, node, insert); err != nil {
+			return fmt.Errorf("insert node to table %q: %w", node.Table, err)
+		} else if !inserted {
+			c.Skipped = append(c.Skipped, i)
+			continue
+		}
+		if err := c.graph.addM2MEdges(ctx, []driver.Value{node.ID.Value}, edges[M2M]); err != nil {
+			return err
+		}
+		if err := c.graph.addFKEdges(ctx, &NodeSpec{Table: node.Table, ID: node.ID}, []driver.Value{node.ID.Value}, append(edges[O2M], edges[O2O]...)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertOnConflict inserts one node and reports if it was inserted or skipped by the conflict clause.
+func (c *batchCreator) insertOnConflict(ctx context.Context, tx dialect.ExecQuerier, node *CreateSpec, insert *sql.InsertBuilder) (bool, error) {
+	if node.ID.Value == nil && rawID(node.ID) {
+		ids, err := c.insertRawIDs(ctx, tx, insert, node.Table, node.ID.Column)
+		if err != nil || len(ids) == 0 {
+			return false, err
+		}
+		node.ID.Value = ids[0]
+		return true, nil
+	}
+	if node.ID.Value == nil && insert.Dialect() == dialect.Postgres {
+		query, args := insert.Returning(node.ID.Column).Query()
+		rows := &sql.Rows{}
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return false, err
+		}
+		defer rows.Close()
+		var ids []int64
+		if err := sql.ScanSlice(rows, &ids); err != nil {
+			return false, err
+		}
+		if len(ids) == 0 {
+			return false, nil
+		}
+		node.ID.Value = ids[0]
+		return true, nil
+	}
+	if node.ID.Value != nil {
+		insert.Set(node.ID.Column, node.ID.Value)
+	}
+	var res sql.Result
+	query, args := insert.Query()
+	if err := tx.Exec(ctx, query, args, &res); err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil || affected == 0 {
+		return false, err
+	}
+	if node.ID.Value == nil {
+		id, err := res.LastInsertId()
+		if err != nil {
+			return false, err
+		}
+		node.ID.Value = id
+	}
+	return true, nil
+}
+
+// insertReadBack inserts one node and reads back its final row (that was either inserted or
+// updated by the conflict clause). PostgreSQL reads the row using the `RETURNING` clause, and
+// other dialects query it by the values of the conflict columns after the insert. Hence, MySQL
+// and SQLite execute two statements for each node.
+//
+// Unlike PostgreSQL and SQLite that update only the rows that conflict on the conflict columns,
+// MySQL updates the row that conflicts on any of the unique keys of the table. Therefore, for
+// integer ids, the id of the affected row is recorded using UpdateLastInsertID, and an error is
+// returned if it is not the row that was read back.
+func (c *batchCreator) insertReadBack(ctx context.Context, tx dialect.ExecQuerier, i int, node *CreateSpec, insert *sql.InsertBuilder) error {
+	if node.ID.Value != nil {
+		insert.Set(node.ID.Column, node.ID.Value)
+	}
+	values := c.ScanValues(i)
+	rows := &sql.Rows{}
+	var res sql.Result
+	verify := insert.Dialect() != dialect.Postgres && insert.Dialect() != dialect.SQLite && !rawID(node.ID)
+	if verify {
+		insert.OnConflict(sql.UpdateLastInsertID(node.ID.Column))
+	}
+	if insert.Dialect() == dialect.Postgres {
+		query, args := insert.Returning(c.Columns...).Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+	} else {
+		if len(c.ConflictColumns) == 0 {
+			return fmt.Errorf("missing conflict columns for reading back the row")
+		}
+		selector := c.builder.Select(c.Columns...).From(c.builder.Table(node.Table))
+		for _, column := range c.ConflictColumns {
+			v, ok := node.columnValue(column)
+			if !ok {
+				return fmt.Errorf("missing value for conflict column %q", column)
+			}
+			selector.Where(sql.EQ(column, v))
+		}
+		query, args := insert.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return err
+		}
+		query, args = selector.Query()
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return err
+		}
+	}
+	if err := scanRow(rows, node, values); err != nil {
+		return err
+	}
+	if verify {
+		if err := verifyLastID(res, node, values[0]); err != nil {
+			return err
+		}
+	}
+	return c.Assign(i, values...)
+}
+
+// verifyLastID returns an error if the row that was read back (by its id value) is not the row
+// that was inserted, or updated by the conflict clause (as reported by the LAST_INSERT_ID).
+func verifyLastID(res sql.Result, node *CreateSpec, v interface{}) error {
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+	valuer, ok := v.(driver.Valuer)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for id column %q", v, node.ID.Column)
+	}
+	id, err := valuer.Value()
+	if err != nil {
+		return err
+	}
+	if id != lastID {
+		return fmt.Errorf("row %v was affected by a conflict on another unique key, instead of row %v that matches the conflict columns", lastID, id)
+	}
+	return nil
+}
+
+// scanRow scans the first row of the given rows into the values, and sets
+// the id of the node from the first value if it was not set before.
+func scanRow(rows *sql.Rows, node *CreateSpec, values []interface{}) error {
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return &NotFoundError{table: node.Table, id: node.ID.Value}
+	}
+	if err := rows.Scan(values...); err != nil {
+		return err
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if node.ID.Value == nil {
+		v, ok := values[0].(driver.Valuer)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for id column %q", values[0], node.ID.Column)
+		}
+		id, err := v.Value()
+		if err != nil {
+			return err
+		}
+		node.ID.Value = id
+	}
+	return nil
+}
+
+// columnValue returns the value of the given column in the spec.
+func (n *CreateSpec) columnValue(column string) (driver.Value, bool) {
+	if column == n.ID.Column && n.ID.Value != nil {
+		return n.ID.Value, true
+	}
+	for _, f := range n.Fields {
+		if f.Column == column {
+			return f.Value, true
+		}
+	}
+	return nil, false
+}
+
 // GroupRel groups edges by their relation type.
 func (es EdgeSpecs) GroupRel() map[Rel][]*EdgeSpec {
 	edges := make(map[Rel][]*EdgeSpec)
@@ -813,6 +1750,20 @@ func (es EdgeSpecs) GroupTable() map[string][]*EdgeSpec {
 type graph struct {
 	tx      dialect.ExecQuerier
 	builder *sql.DialectBuilder
+	// dryRun indicates that the statements are recorded by a
+	// sql.DryRunDriver, and their results must not be read.
+	dryRun bool
+}
+
+// isDryRun reports if the given driver records its statements instead of executing them.
+func isDryRun(drv dialect.Driver) bool {
+	_, ok := drv.(*sql.DryRunDriver)
+	return ok
+}
+
+// errDryRun returns an error for operations that are not supported in dry-run mode.
+func errDryRun(op string) error {
+	return fmt.Errorf("sqlgraph: %s is not supported in dry-run mode", op)
 }
 
 func (g *graph) clearM2MEdges(ctx context.Context, ids []driver.Value, edges EdgeSpecs) error {
@@ -835,9 +1786,12 @@ func (g *graph) clearM2MEdges(ctx context.Context, ids []driver.Value, edges Edg
 				preds = append(preds, matchIDs(edge.Columns[0], pk2, edge.Columns[1], pk1))
 			}
 		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		query, args := g.builder.Delete(table).Where(sql.Or(preds...)).Query()
 		if err := g.tx.Exec(ctx, query, args, &res); err != nil {
-			return fmt.Errorf("remove m2m edge for table %s: %v", table, err)
+			return fmt.Errorf("remove m2m edge for table %s: %w", table, err)
 		}
 	}
 	return nil
@@ -865,9 +1819,12 @@ func (g *graph) addM2MEdges(ctx context.Context, ids []driver.Value, edges EdgeS
 				}
 			}
 		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		query, args := insert.Query()
 		if err := g.tx.Exec(ctx, query, args, &res); err != nil {
-			return fmt.Errorf("add m2m edge for table %s: %v", table, err)
+			return fmt.Errorf("add m2m edge for table %s: %w", table, err)
 		}
 	}
 	return nil
@@ -884,19 +1841,22 @@ func (g *graph) clearFKEdges(ctx context.Context, ids []driver.Value, edges []*E
 		if nodes := edge.Target.Nodes; len(nodes) > 0 {
 			pred = matchIDs(edge.Target.IDSpec.Column, edge.Target.Nodes, edge.Columns[0], ids)
 		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		query, args := g.builder.Update(edge.Table).
 			SetNull(edge.Columns[0]).
 			Where(pred).
 			Query()
 		var res sql.Result
 		if err := g.tx.Exec(ctx, query, args, &res); err != nil {
-			return fmt.Errorf("add %s edge for table %s: %v", edge.Rel, edge.Table, err)
+			return fmt.Errorf("add %s edge for table %s: %w", edge.Rel, edge.Table, err)
 		}
 	}
 	return nil
 }
 
-func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*EdgeSpec) error {
+func (g *graph) addFKEdges(ctx context.Context, node *NodeSpec, ids []driver.Value, edges []*EdgeSpec) error {
 	id := ids[0]
 	if len(ids) > 1 && len(edges) != 0 {
 		// O2M and O2O edges are defined by a FK in the "other" table.
@@ -913,19 +1873,33 @@ func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*Edg
 		if len(edge.Target.Nodes) > 1 {
 			p = sql.InValues(edge.Target.IDSpec.Column, edge.Target.Nodes...)
 		}
+		preds := []*sql.Predicate{p, sql.IsNull(edge.Columns[0])}
+		// Connect only nodes that share the columns of the composite
+		// foreign-key with the node, in order to keep them consistent.
+		for _, c := range edge.SharedColumns {
+			t := g.builder.Table(node.Table)
+			preds = append(preds, sql.In(c, g.builder.Select(t.C(c)).From(t).Where(sql.EQ(t.C(node.ID.Column), id))))
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		query, args := g.builder.Update(edge.Table).
 			Set(edge.Columns[0], id).
-			Where(sql.And(p, sql.IsNull(edge.Columns[0]))).
+			Where(sql.And(preds...)).
 			Query()
 		var res sql.Result
 		if err := g.tx.Exec(ctx, query, args, &res); err != nil {
-			return fmt.Errorf("add %s edge for table %s: %v", edge.Rel, edge.Table, err)
+			return fmt.Errorf("add %s edge for table %s: %w", edge.Rel, edge.Table, err)
 		}
 		affected, err := res.RowsAffected()
 		if err != nil {
 			return err
 		}
-		if ids := edge.Target.Nodes; int(affected) < len(ids) {
+		switch ids := edge.Target.Nodes; {
+		case int(affected) >= len(ids) || g.dryRun:
+		case len(edge.SharedColumns) > 0:
+			return &ConstraintError{msg: fmt.Sprintf("one of %v is already connected to a different %s, or does not match its %s", ids, edge.Columns[0], strings.Join(edge.SharedColumns, ", "))}
+		default:
 			return &ConstraintError{msg: fmt.Sprintf("one of %v is already connected to a different %s", ids, edge.Columns[0])}
 		}
 	}
@@ -933,10 +1907,11 @@ func (g *graph) addFKEdges(ctx context.Context, ids []driver.Value, edges []*Edg
 }
 
 // setTableColumns is shared between updater and creator.
-func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(string, driver.Value)) (err error) {
+func setTableColumns(d string, fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(string, driver.Value)) (err error) {
 	for _, fi := range fields {
 		value := fi.Value
-		if fi.Type == field.TypeJSON {
+		switch fi.Type {
+		case field.TypeJSON:
 			buf, err := json.Marshal(value)
 			if err != nil {
 				return fmt.Errorf("marshal value for column %s: %v", fi.Column, err)
@@ -944,6 +1919,8 @@ func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(st
 			// If the underlying driver does not support JSON types,
 			// driver.DefaultParameterConverter will convert it to uint8.
 			value = json.RawMessage(buf)
+		case field.TypeDuration:
+			value = durationValue(d, fi)
 		}
 		set(fi.Column, value)
 	}
@@ -958,6 +1935,14 @@ func setTableColumns(fields []*FieldSpec, edges map[Rel][]*EdgeSpec, set func(st
 	return nil
 }
 
+// durationValue returns the value of a duration field in the given dialect.
+func durationValue(d string, fi *FieldSpec) driver.Value {
+	if v, ok := fi.Value.(time.Duration); ok {
+		return sql.DurationValue(d, v)
+	}
+	return fi.Value
+}
+
 // insertLastID invokes the insert query on the transaction and returns the LastInsertID.
 func insertLastID(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder) (int64, error) {
 	query, args := insert.Query()
@@ -979,10 +1964,71 @@ func insertLastID(ctx context.Context, tx dialect.ExecQuerier, insert *sql.Inser
 	return res.LastInsertId()
 }
 
+// rawID reports if the given id spec holds non-numeric ids (e.g. UUID or bytes). If these ids
+// are generated by the database, they are read back as they were returned by the driver, and
+// the generated code converts them to their Go type.
+func rawID(id *FieldSpec) bool {
+	switch id.Type {
+	case field.TypeUUID, field.TypeBytes, field.TypeString:
+		return true
+	}
+	return false
+}
+
+// insertRawIDs executes the insert statement and returns the ids that were generated by the
+// database for the inserted rows, in their order. PostgreSQL returns the ids using the `RETURNING`
+// clause, and SQLite reads them by the rowids of the inserted rows. Rows that were skipped by a
+// conflict clause are not returned.
+func (g *graph) insertRawIDs(ctx context.Context, tx dialect.ExecQuerier, insert *sql.InsertBuilder, table, column string) ([]interface{}, error) {
+	var ids []interface{}
+	switch insert.Dialect() {
+	case dialect.Postgres:
+		query, args := insert.Returning(column).Query()
+		rows := &sql.Rows{}
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		if err := sql.ScanSlice(rows, &ids); err != nil {
+			return nil, err
+		}
+	case dialect.SQLite:
+		var res sql.Result
+		query, args := insert.Query()
+		if err := tx.Exec(ctx, query, args, &res); err != nil {
+			return nil, err
+		}
+		affected, err := res.RowsAffected()
+		if err != nil || affected == 0 {
+			return nil, err
+		}
+		last, err := res.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		query, args = g.builder.Select(column).
+			From(g.builder.Table(table)).
+			Where(sql.And(sql.GTE("rowid", last-affected+1), sql.LTE("rowid", last))).
+			OrderBy("rowid").
+			Query()
+		rows := &sql.Rows{}
+		if err := tx.Query(ctx, query, args, rows); err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		if err := sql.ScanSlice(rows, &ids); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("reading back %s ids that were generated by the database is not supported", insert.Dialect())
+	}
+	return ids, nil
+}
+
 // rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
 func rollback(tx dialect.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
-		err = fmt.Errorf("%s: %v", err.Error(), rerr)
+		err = fmt.Errorf("%w: %v", err, rerr)
 	}
 	return err
 }
@@ -7,10 +7,14 @@ package sqlgraph
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/schema/field"
 
@@ -107,6 +111,17 @@ func TestNeighbors(t *testing.T) {
 			wantQuery: "SELECT * FROM `users` JOIN (SELECT `parent_id` FROM `users` WHERE `id` = ?) AS `t1` ON `users`.`id` = `t1`.`parent_id`",
 			wantArgs:  []interface{}{2},
 		},
+		{
+			name: "M2O/2type/shared",
+			input: NewStep(
+				From("line_items", "id", 2),
+				To("orders", "id"),
+				Edge(M2O, true, "line_items", "order_id"),
+				SharedColumns("tenant_id"),
+			),
+			wantQuery: "SELECT * FROM `orders` JOIN (SELECT `tenant_id`, `order_id` FROM `line_items` WHERE `id` = ?) AS `t1` ON `orders`.`id` = `t1`.`order_id` AND `orders`.`tenant_id` = `t1`.`tenant_id`",
+			wantArgs:  []interface{}{2},
+		},
 		{
 			name: "M2M/2type",
 			input: NewStep(
@@ -165,6 +180,28 @@ func TestSetNeighbors(t *testing.T) {
 			wantQuery: `SELECT * FROM "users" JOIN (SELECT "pets"."owner_id" FROM "pets" WHERE "name" = $1) AS "t1" ON "users"."id" = "t1"."owner_id"`,
 			wantArgs:  []interface{}{"pedro"},
 		},
+		{
+			name: "O2M/2types/shared",
+			input: NewStep(
+				From("orders", "id", sql.Select().From(sql.Table("orders")).Where(sql.EQ("status", "open"))),
+				To("line_items", "id"),
+				Edge(O2M, false, "line_items", "order_id"),
+				SharedColumns("tenant_id"),
+			),
+			wantQuery: `SELECT * FROM "line_items" JOIN (SELECT "orders"."tenant_id", "orders"."id" FROM "orders" WHERE "status" = $1) AS "t1" ON "line_items"."order_id" = "t1"."id" AND "line_items"."tenant_id" = "t1"."tenant_id"`,
+			wantArgs:  []interface{}{"open"},
+		},
+		{
+			name: "M2O/2types/shared",
+			input: NewStep(
+				From("line_items", "id", sql.Select().From(sql.Table("line_items")).Where(sql.EQ("quantity", 2))),
+				To("orders", "id"),
+				Edge(M2O, true, "line_items", "order_id"),
+				SharedColumns("tenant_id"),
+			),
+			wantQuery: `SELECT * FROM "orders" JOIN (SELECT "line_items"."tenant_id", "line_items"."order_id" FROM "line_items" WHERE "quantity" = $1) AS "t1" ON "orders"."id" = "t1"."order_id" AND "orders"."tenant_id" = "t1"."tenant_id"`,
+			wantArgs:  []interface{}{2},
+		},
 		{
 			name: "M2M/2types",
 			input: NewStep(
@@ -267,6 +304,39 @@ func TestHasNeighbors(t *testing.T) {
 			selector:  sql.Select("*").From(sql.Table("pets")),
 			wantQuery: "SELECT * FROM `pets` WHERE `pets`.`owner_id` IS NOT NULL",
 		},
+		{
+			name: "O2M/2types/shared",
+			step: NewStep(
+				From("orders", "id"),
+				To("line_items", "id"),
+				Edge(O2M, false, "line_items", "order_id"),
+				SharedColumns("tenant_id"),
+			),
+			selector:  sql.Select("*").From(sql.Table("orders")),
+			wantQuery: "SELECT * FROM `orders` WHERE `orders`.`id` IN (SELECT `line_items`.`order_id` FROM `line_items` WHERE `line_items`.`order_id` IS NOT NULL AND `line_items`.`tenant_id` = `orders`.`tenant_id`)",
+		},
+		{
+			name: "O2M/1type/shared",
+			step: NewStep(
+				From("nodes", "id"),
+				To("nodes", "id"),
+				Edge(O2M, false, "nodes", "parent_id"),
+				SharedColumns("tenant_id"),
+			),
+			selector:  sql.Select("*").From(sql.Table("nodes")),
+			wantQuery: "SELECT * FROM `nodes` WHERE `nodes`.`id` IN (SELECT `t1`.`parent_id` FROM `nodes` AS `t1` WHERE `t1`.`parent_id` IS NOT NULL AND `t1`.`tenant_id` = `nodes`.`tenant_id`)",
+		},
+		{
+			name: "M2O/2types/shared",
+			step: NewStep(
+				From("line_items", "id"),
+				To("orders", "id"),
+				Edge(M2O, true, "line_items", "order_id"),
+				SharedColumns("tenant_id"),
+			),
+			selector:  sql.Select("*").From(sql.Table("line_items")),
+			wantQuery: "SELECT * FROM `line_items` WHERE `line_items`.`order_id` IS NOT NULL",
+		},
 		{
 			name: "M2M/2types",
 			step: NewStep(
@@ -367,6 +437,51 @@ func TestHasNeighborsWith(t *testing.T) {
 			wantQuery: `SELECT * FROM "pets" WHERE "name" = $1 AND "pets"."owner_id" IN (SELECT "users"."id" FROM "users" WHERE "last_name" = $2)`,
 			wantArgs:  []interface{}{"pedro", "mashraki"},
 		},
+		{
+			name: "O2M/shared",
+			step: NewStep(
+				From("orders", "id"),
+				To("line_items", "id"),
+				Edge(O2M, false, "line_items", "order_id"),
+				SharedColumns("tenant_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").From(sql.Table("orders")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.GT(s.C("quantity"), 1))
+			},
+			wantQuery: `SELECT * FROM "orders" WHERE "orders"."id" IN (SELECT "line_items"."order_id" FROM "line_items" WHERE "line_items"."quantity" > $1 AND "line_items"."tenant_id" = "orders"."tenant_id")`,
+			wantArgs:  []interface{}{1},
+		},
+		{
+			name: "M2O/shared",
+			step: NewStep(
+				From("line_items", "id"),
+				To("orders", "id"),
+				Edge(M2O, true, "line_items", "order_id"),
+				SharedColumns("tenant_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").From(sql.Table("line_items")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("status"), "paid"))
+			},
+			wantQuery: `SELECT * FROM "line_items" WHERE "line_items"."order_id" IN (SELECT "orders"."id" FROM "orders" WHERE "orders"."status" = $1 AND "orders"."tenant_id" = "line_items"."tenant_id")`,
+			wantArgs:  []interface{}{"paid"},
+		},
+		{
+			name: "M2O/1type/shared",
+			step: NewStep(
+				From("nodes", "id"),
+				To("nodes", "id"),
+				Edge(M2O, true, "nodes", "parent_id"),
+				SharedColumns("tenant_id"),
+			),
+			selector: sql.Dialect("postgres").Select("*").From(sql.Table("nodes")),
+			predicate: func(s *sql.Selector) {
+				s.Where(sql.EQ(s.C("name"), "root"))
+			},
+			wantQuery: `SELECT * FROM "nodes" WHERE "nodes"."parent_id" IN (SELECT "t1"."id" FROM "nodes" AS "t1" WHERE "t1"."name" = $1 AND "t1"."tenant_id" = "nodes"."tenant_id")`,
+			wantArgs:  []interface{}{"root"},
+		},
 		{
 			name: "M2M",
 			step: NewStep(
@@ -482,6 +597,23 @@ func TestCreateNode(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "fields/duration",
+			spec: &CreateSpec{
+				Table: "notes",
+				ID:    &FieldSpec{Column: "id"},
+				Fields: []*FieldSpec{
+					{Column: "ttl", Type: field.TypeDuration, Value: time.Minute},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `notes` (`ttl`) VALUES (?)")).
+					WithArgs(int64(time.Minute)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectCommit()
+			},
+		},
 		{
 			name: "fields/json",
 			spec: &CreateSpec{
@@ -585,6 +717,29 @@ func TestCreateNode(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "edges/o2m/shared",
+			spec: &CreateSpec{
+				Table: "orders",
+				ID:    &FieldSpec{Column: "id"},
+				Fields: []*FieldSpec{
+					{Column: "tenant_id", Type: field.TypeInt, Value: 10},
+				},
+				Edges: []*EdgeSpec{
+					{Rel: O2M, Table: "line_items", Columns: []string{"order_id"}, SharedColumns: []string{"tenant_id"}, Target: &EdgeTarget{Nodes: []driver.Value{2, 3}, IDSpec: &FieldSpec{Column: "id"}}},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `orders` (`tenant_id`) VALUES (?)")).
+					WithArgs(10).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectExec(escape("UPDATE `line_items` SET `order_id` = ? WHERE (`id` IN (?, ?)) AND (`order_id` IS NULL) AND (`tenant_id` IN (SELECT `orders`.`tenant_id` FROM `orders` WHERE `orders`.`id` = ?))")).
+					WithArgs(1, 2, 3, 1).
+					WillReturnResult(sqlmock.NewResult(1, 2))
+				m.ExpectCommit()
+			},
+		},
 		{
 			name: "edges/o2o",
 			spec: &CreateSpec{
@@ -729,6 +884,19 @@ func TestCreateNode(t *testing.T) {
 				m.ExpectCommit()
 			},
 		},
+		{
+			name: "generated-id/unsupported",
+			spec: &CreateSpec{
+				Table:  "devices",
+				ID:     &FieldSpec{Column: "id", Type: field.TypeUUID},
+				Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectRollback()
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -741,152 +909,723 @@ func TestCreateNode(t *testing.T) {
 	}
 }
 
-type user struct {
-	id    int
-	age   int
-	name  string
-	edges struct {
-		fk1 int
-		fk2 int
+func TestCreateNode_ReadBack(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  string
+		expect   func(sqlmock.Sqlmock)
+		wantUser *user
+	}{
+		{
+			name: "mysql",
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?)")).
+					WithArgs("a8m").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				m.ExpectQuery(escape("SELECT `id`, `age`, `name` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).AddRow(1, 30, "a8m"))
+				m.ExpectCommit()
+			},
+			wantUser: &user{id: 1, age: 30, name: "a8m"},
+		},
+		{
+			name:    "postgres",
+			dialect: dialect.Postgres,
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1) RETURNING "id", "age", "name"`)).
+					WithArgs("a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).AddRow(1, 30, "a8m"))
+				m.ExpectCommit()
+			},
+			wantUser: &user{id: 1, age: 30, name: "a8m"},
+		},
 	}
-}
-
-func (*user) values() []interface{} {
-	return []interface{}{&sql.NullInt64{}, &sql.NullInt64{}, &sql.NullString{}}
-}
-
-func (u *user) assign(values ...interface{}) error {
-	u.id = int(values[0].(*sql.NullInt64).Int64)
-	u.age = int(values[1].(*sql.NullInt64).Int64)
-	u.name = values[2].(*sql.NullString).String
-	// loaded with foreign-keys.
-	if len(values) > 3 {
-		u.edges.fk1 = int(values[3].(*sql.NullInt64).Int64)
-		u.edges.fk2 = int(values[4].(*sql.NullInt64).Int64)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.expect(mock)
+			usr := &user{}
+			spec := &CreateSpec{
+				Table: "users",
+				ID:    &FieldSpec{Column: "id"},
+				Fields: []*FieldSpec{
+					{Column: "name", Type: field.TypeString, Value: "a8m"},
+				},
+				Columns:    []string{"id", "age", "name"},
+				ScanValues: usr.values,
+				Assign:     usr.assign,
+			}
+			err = CreateNode(context.Background(), sql.OpenDB(tt.dialect, db), spec)
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+			require.Equal(t, tt.wantUser, usr)
+			require.Equal(t, int64(1), spec.ID.Value)
+		})
 	}
-	return nil
 }
 
-func TestUpdateNode(t *testing.T) {
+func TestBatchCreate(t *testing.T) {
+	scanUser := func(int) []interface{} {
+		return []interface{}{&sql.NullInt64{}, &sql.NullString{}}
+	}
+	// assignUser verifies that the read back rows hold the given names.
+	assignUser := func(names ...string) func(int, ...interface{}) error {
+		return func(i int, values ...interface{}) error {
+			if name := values[1].(*sql.NullString).String; name != names[i] {
+				return fmt.Errorf("unexpected name %q for node %d", name, i)
+			}
+			return nil
+		}
+	}
 	tests := []struct {
-		name     string
-		spec     *UpdateSpec
-		prepare  func(sqlmock.Sqlmock)
-		wantErr  bool
-		wantUser *user
+		name        string
+		dialect     string
+		spec        *BatchCreateSpec
+		expect      func(sqlmock.Sqlmock)
+		wantIDs     []driver.Value
+		wantSkipped []int
+		wantErr     bool
 	}{
 		{
-			name: "fields/set",
-			spec: &UpdateSpec{
-				Node: &NodeSpec{
-					Table:   "users",
-					Columns: []string{"id", "name", "age"},
-					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
-				},
-				Fields: FieldMut{
-					Set: []*FieldSpec{
-						{Column: "age", Type: field.TypeInt, Value: 30},
-						{Column: "name", Type: field.TypeString, Value: "Ariel"},
+			name: "fields",
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
+					},
+					{
+						Table: "users",
+						ID:    &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{
+							{Column: "name", Type: field.TypeString, Value: "nati"},
+							{Column: "age", Type: field.TypeInt, Value: 30},
+						},
 					},
 				},
 			},
-			prepare: func(mock sqlmock.Sqlmock) {
-				mock.ExpectBegin()
-				mock.ExpectExec(escape("UPDATE `users` SET `age` = ?, `name` = ? WHERE `id` = ?")).
-					WithArgs(30, "Ariel", 1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
-					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
-						AddRow(1, 30, "Ariel"))
-				mock.ExpectCommit()
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`name`, `age`) VALUES (?, ?), (?, ?)")).
+					WithArgs("a8m", nil, "nati", 30).
+					WillReturnResult(sqlmock.NewResult(10, 2))
+				m.ExpectCommit()
 			},
-			wantUser: &user{name: "Ariel", age: 30, id: 1},
+			wantIDs: []driver.Value{int64(10), int64(11)},
 		},
 		{
-			name: "fields/add_clear",
-			spec: &UpdateSpec{
-				Node: &NodeSpec{
-					Table:   "users",
-					Columns: []string{"id", "name", "age"},
-					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
-				},
-				Fields: FieldMut{
-					Add: []*FieldSpec{
-						{Column: "age", Type: field.TypeInt, Value: 1},
+			name:    "fields/sqlite",
+			dialect: dialect.SQLite,
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
 					},
-					Clear: []*FieldSpec{
-						{Column: "name", Type: field.TypeString},
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}},
 					},
 				},
 			},
-			prepare: func(mock sqlmock.Sqlmock) {
-				mock.ExpectBegin()
-				mock.ExpectExec(escape("UPDATE `users` SET `name` = NULL, `age` = COALESCE(`age`, ?) + ? WHERE `id` = ?")).
-					WithArgs(0, 1, 1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
-					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
-						AddRow(1, 31, nil))
-				mock.ExpectCommit()
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?), (?)")).
+					WithArgs("a8m", "nati").
+					WillReturnResult(sqlmock.NewResult(11, 2))
+				m.ExpectCommit()
 			},
-			wantUser: &user{age: 31, id: 1},
+			wantIDs: []driver.Value{int64(10), int64(11)},
 		},
 		{
-			name: "edges/o2o_non_inverse and m2o",
-			spec: &UpdateSpec{
-				Node: &NodeSpec{
-					Table:   "users",
-					Columns: []string{"id", "name", "age"},
-					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
-				},
-				Edges: EdgeMut{
-					Clear: []*EdgeSpec{
-						{Rel: O2O, Columns: []string{"car_id"}, Inverse: true},
-						{Rel: M2O, Columns: []string{"workplace_id"}, Inverse: true},
+			name:    "fields/postgres",
+			dialect: dialect.Postgres,
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
 					},
-					Add: []*EdgeSpec{
-						{Rel: O2O, Columns: []string{"card_id"}, Inverse: true, Target: &EdgeTarget{Nodes: []driver.Value{2}}},
-						{Rel: M2O, Columns: []string{"parent_id"}, Inverse: true, Target: &EdgeTarget{Nodes: []driver.Value{2}}},
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}},
 					},
 				},
 			},
-			prepare: func(mock sqlmock.Sqlmock) {
-				mock.ExpectBegin()
-				mock.ExpectExec(escape("UPDATE `users` SET `workplace_id` = NULL, `car_id` = NULL, `parent_id` = ?, `card_id` = ? WHERE `id` = ?")).
-					WithArgs(2, 2, 1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
-					WithArgs(1).
-					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
-						AddRow(1, 31, nil))
-				mock.ExpectCommit()
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1), ($2) RETURNING "id"`)).
+					WithArgs("a8m", "nati").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(10).AddRow(11))
+				m.ExpectCommit()
 			},
-			wantUser: &user{age: 31, id: 1},
+			wantIDs: []driver.Value{int64(10), int64(11)},
 		},
 		{
-			name: "edges/o2o_bidi",
-			spec: &UpdateSpec{
-				Node: &NodeSpec{
-					Table:   "users",
-					Columns: []string{"id", "name", "age"},
-					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+			name: "on-conflict/do-nothing",
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
+					},
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}},
+					},
 				},
-				Edges: EdgeMut{
-					Clear: []*EdgeSpec{
-						{Rel: O2O, Table: "users", Bidi: true, Columns: []string{"partner_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}}},
-						{Rel: O2O, Table: "users", Bidi: true, Columns: []string{"spouse_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{2}}},
+				OnConflict: []sql.ConflictOption{sql.DoNothing()},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT IGNORE INTO `users` (`name`) VALUES (?)")).
+					WithArgs("a8m").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				m.ExpectExec(escape("INSERT IGNORE INTO `users` (`name`) VALUES (?)")).
+					WithArgs("nati").
+					WillReturnResult(sqlmock.NewResult(5, 1))
+				m.ExpectCommit()
+			},
+			wantIDs:     []driver.Value{nil, int64(5)},
+			wantSkipped: []int{0},
+		},
+		{
+			name:    "on-conflict/do-nothing/postgres",
+			dialect: dialect.Postgres,
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
 					},
-					Add: []*EdgeSpec{
-						{Rel: O2O, Table: "users", Bidi: true, Columns: []string{"spouse_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{3}}},
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}},
 					},
 				},
+				OnConflict: []sql.ConflictOption{sql.ConflictColumns("name"), sql.DoNothing()},
 			},
-			prepare: func(mock sqlmock.Sqlmock) {
-				mock.ExpectBegin()
-				// Clear the "partner" from 1's column, and set "spouse 3".
-				// "spouse 2" is implicitly removed when setting a different foreign-key.
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1) ON CONFLICT ("name") DO NOTHING RETURNING "id"`)).
+					WithArgs("a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				m.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1) ON CONFLICT ("name") DO NOTHING RETURNING "id"`)).
+					WithArgs("nati").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				m.ExpectCommit()
+			},
+			wantIDs:     []driver.Value{int64(1), nil},
+			wantSkipped: []int{1},
+		},
+		{
+			name: "on-conflict/update/mysql",
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}, {Column: "age", Type: field.TypeInt, Value: 30}},
+					},
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "nati"}, {Column: "age", Type: field.TypeInt, Value: 1}},
+					},
+				},
+				OnConflict:      []sql.ConflictOption{sql.ConflictColumns("name"), sql.ResolveWithNewValues()},
+				Columns:         []string{"id", "name"},
+				ConflictColumns: []string{"name"},
+				ScanValues:      scanUser,
+				Assign:          assignUser("a8m", "nati"),
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`name`, `age`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `age` = VALUES(`age`), `id` = LAST_INSERT_ID(`id`)")).
+					WithArgs("a8m", 30).
+					WillReturnResult(sqlmock.NewResult(1, 2))
+				m.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `name` = ?")).
+					WithArgs("a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m"))
+				m.ExpectExec(escape("INSERT INTO `users` (`name`, `age`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `age` = VALUES(`age`), `id` = LAST_INSERT_ID(`id`)")).
+					WithArgs("nati", 1).
+					WillReturnResult(sqlmock.NewResult(2, 1))
+				m.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `name` = ?")).
+					WithArgs("nati").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(2, "nati"))
+				m.ExpectCommit()
+			},
+			wantIDs: []driver.Value{int64(1), int64(2)},
+		},
+		{
+			// The insert conflicted on another unique key, and
+			// updated a row other than the one that was read back.
+			name: "on-conflict/update/mysql/other-key",
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}, {Column: "age", Type: field.TypeInt, Value: 30}},
+					},
+				},
+				OnConflict:      []sql.ConflictOption{sql.ConflictColumns("name"), sql.ResolveWithNewValues()},
+				Columns:         []string{"id", "name"},
+				ConflictColumns: []string{"name"},
+				ScanValues:      scanUser,
+				Assign:          assignUser("a8m"),
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `users` (`name`, `age`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `age` = VALUES(`age`), `id` = LAST_INSERT_ID(`id`)")).
+					WithArgs("a8m", 30).
+					WillReturnResult(sqlmock.NewResult(2, 2))
+				m.ExpectQuery(escape("SELECT `id`, `name` FROM `users` WHERE `name` = ?")).
+					WithArgs("a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m"))
+				m.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name:    "on-conflict/update/postgres",
+			dialect: dialect.Postgres,
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "users",
+						ID:     &FieldSpec{Column: "id"},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}, {Column: "age", Type: field.TypeInt, Value: 30}},
+					},
+				},
+				OnConflict:      []sql.ConflictOption{sql.ConflictColumns("name"), sql.ResolveWithNewValues()},
+				Columns:         []string{"id", "name"},
+				ConflictColumns: []string{"name"},
+				ScanValues:      scanUser,
+				Assign:          assignUser("a8m"),
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(escape(`INSERT INTO "users" ("name", "age") VALUES ($1, $2) ON CONFLICT ("name") DO UPDATE SET "age" = excluded."age" RETURNING "id", "name"`)).
+					WithArgs("a8m", 30).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(1, "a8m"))
+				m.ExpectCommit()
+			},
+			wantIDs: []driver.Value{int64(1)},
+		},
+		{
+			name:    "generated-ids/postgres",
+			dialect: dialect.Postgres,
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "devices",
+						ID:     &FieldSpec{Column: "id", Type: field.TypeUUID},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a"}},
+					},
+					{
+						Table:  "devices",
+						ID:     &FieldSpec{Column: "id", Type: field.TypeUUID},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "b"}},
+					},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(escape(`INSERT INTO "devices" ("name") VALUES ($1), ($2) RETURNING "id"`)).
+					WithArgs("a", "b").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow([]byte("1")).AddRow([]byte("2")))
+				m.ExpectCommit()
+			},
+			wantIDs: []driver.Value{[]byte("1"), []byte("2")},
+		},
+		{
+			name:    "generated-ids/sqlite",
+			dialect: dialect.SQLite,
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "devices",
+						ID:     &FieldSpec{Column: "id", Type: field.TypeUUID},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a"}},
+					},
+					{
+						Table:  "devices",
+						ID:     &FieldSpec{Column: "id", Type: field.TypeUUID},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "b"}},
+					},
+				},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec(escape("INSERT INTO `devices` (`name`) VALUES (?), (?)")).
+					WithArgs("a", "b").
+					WillReturnResult(sqlmock.NewResult(11, 2))
+				m.ExpectQuery(escape("SELECT `id` FROM `devices` WHERE (`rowid` >= ?) AND (`rowid` <= ?) ORDER BY `rowid`")).
+					WithArgs(10, 11).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow([]byte("1")).AddRow([]byte("2")))
+				m.ExpectCommit()
+			},
+			wantIDs: []driver.Value{[]byte("1"), []byte("2")},
+		},
+		{
+			name:    "generated-ids/on-conflict/postgres",
+			dialect: dialect.Postgres,
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{
+					{
+						Table:  "devices",
+						ID:     &FieldSpec{Column: "id", Type: field.TypeUUID},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "a"}},
+					},
+					{
+						Table:  "devices",
+						ID:     &FieldSpec{Column: "id", Type: field.TypeUUID},
+						Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "b"}},
+					},
+				},
+				OnConflict: []sql.ConflictOption{sql.ConflictColumns("name"), sql.DoNothing()},
+			},
+			expect: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectQuery(escape(`INSERT INTO "devices" ("name") VALUES ($1) ON CONFLICT ("name") DO NOTHING RETURNING "id"`)).
+					WithArgs("a").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				m.ExpectQuery(escape(`INSERT INTO "devices" ("name") VALUES ($1) ON CONFLICT ("name") DO NOTHING RETURNING "id"`)).
+					WithArgs("b").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow([]byte("2")))
+				m.ExpectCommit()
+			},
+			wantIDs:     []driver.Value{nil, []byte("2")},
+			wantSkipped: []int{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.expect(mock)
+			err = BatchCreate(context.Background(), sql.OpenDB(tt.dialect, db), tt.spec)
+			require.Equal(t, tt.wantErr, err != nil, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+			if tt.wantErr {
+				return
+			}
+			for i, node := range tt.spec.Nodes {
+				require.Equal(t, tt.wantIDs[i], node.ID.Value)
+			}
+			require.Equal(t, len(tt.wantSkipped), len(tt.spec.Skipped))
+			for i := range tt.wantSkipped {
+				require.Equal(t, tt.wantSkipped[i], tt.spec.Skipped[i])
+			}
+		})
+	}
+}
+
+func TestBatchCreate_Canceled(t *testing.T) {
+	node := func(name string, edges ...*EdgeSpec) *CreateSpec {
+		return &CreateSpec{
+			Table:  "users",
+			ID:     &FieldSpec{Column: "id"},
+			Fields: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: name}},
+			Edges:  edges,
+		}
+	}
+	groups := &EdgeSpec{Rel: M2M, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{Nodes: []driver.Value{2}, IDSpec: &FieldSpec{Column: "id"}}}
+	tests := []struct {
+		name string
+		spec *BatchCreateSpec
+	}{
+		{
+			name: "on-conflict",
+			spec: &BatchCreateSpec{
+				Nodes:      []*CreateSpec{node("a8m"), node("nati"), node("alex")},
+				OnConflict: []sql.ConflictOption{sql.DoNothing()},
+			},
+		},
+		{
+			name: "edges",
+			spec: &BatchCreateSpec{
+				Nodes: []*CreateSpec{node("a8m", groups), node("nati", groups)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			drv := &cancelDriver{cancel: cancel, after: 1}
+			err := BatchCreate(ctx, drv, tt.spec)
+			require.True(t, errors.Is(err, context.Canceled), "unexpected error: %v", err)
+			require.Len(t, drv.stmts, 1, "statements are not issued after the context was canceled")
+			require.True(t, drv.rolledback)
+		})
+	}
+}
+
+// cancelDriver records the executed statements, and cancels the context after the
+// given number of statements. Unlike database/sql, it does not check the context.
+type cancelDriver struct {
+	dialect.Driver
+	stmts      []string
+	after      int
+	cancel     func()
+	rolledback bool
+}
+
+func (d *cancelDriver) Exec(_ context.Context, query string, _, v interface{}) error {
+	d.stmts = append(d.stmts, query)
+	if len(d.stmts) == d.after {
+		d.cancel()
+	}
+	if res, ok := v.(*sql.Result); ok {
+		*res = sqlmock.NewResult(int64(len(d.stmts)), 1)
+	}
+	return nil
+}
+
+func (d *cancelDriver) Tx(context.Context) (dialect.Tx, error) { return d, nil }
+func (d *cancelDriver) Dialect() string                        { return dialect.MySQL }
+func (d *cancelDriver) Commit() error                          { return nil }
+func (d *cancelDriver) Rollback() error                        { d.rolledback = true; return nil }
+
+type user struct {
+	id    int
+	age   int
+	name  string
+	edges struct {
+		fk1 int
+		fk2 int
+	}
+}
+
+func (*user) values() []interface{} {
+	return []interface{}{&sql.NullInt64{}, &sql.NullInt64{}, &sql.NullString{}}
+}
+
+func (u *user) assign(values ...interface{}) error {
+	u.id = int(values[0].(*sql.NullInt64).Int64)
+	u.age = int(values[1].(*sql.NullInt64).Int64)
+	u.name = values[2].(*sql.NullString).String
+	// loaded with foreign-keys.
+	if len(values) > 3 {
+		u.edges.fk1 = int(values[3].(*sql.NullInt64).Int64)
+		u.edges.fk2 = int(values[4].(*sql.NullInt64).Int64)
+	}
+	return nil
+}
+
+func TestUpdateNode(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  string
+		spec     *UpdateSpec
+		prepare  func(sqlmock.Sqlmock)
+		wantErr  bool
+		wantUser *user
+	}{
+		{
+			name: "fields/set",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
+						{Column: "name", Type: field.TypeString, Value: "Ariel"},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = ?, `name` = ? WHERE `id` = ?")).
+					WithArgs(30, "Ariel", 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "Ariel"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "Ariel", age: 30, id: 1},
+		},
+		{
+			name: "fields/add_clear",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Add: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 1},
+					},
+					Clear: []*FieldSpec{
+						{Column: "name", Type: field.TypeString},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `name` = NULL, `age` = COALESCE(`age`, ?) + ? WHERE `id` = ?")).
+					WithArgs(0, 1, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 31, nil))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{age: 31, id: 1},
+		},
+		{
+			name:    "fields/merge",
+			dialect: dialect.MySQL,
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Merge: []*FieldSpec{
+						{Column: "meta", Type: field.TypeJSON, Value: map[string]interface{}{"a": 1}},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `meta` = JSON_MERGE_PATCH(COALESCE(`meta`, '{}'), ?) WHERE `id` = ?")).
+					WithArgs(`{"a":1}`, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "a8m"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "a8m", age: 30, id: 1},
+		},
+		{
+			name:    "fields/set_path",
+			dialect: dialect.MySQL,
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					SetPath: []*FieldSpec{
+						{Column: "meta", Type: field.TypeJSON, Value: map[string]interface{}{"b.c": "x", "a": 1}},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `meta` = JSON_SET(COALESCE(JSON_SET(COALESCE(`meta`, '{}'), ?, CAST(? AS JSON)), '{}'), ?, CAST(? AS JSON)) WHERE `id` = ?")).
+					WithArgs(`$."a"`, "1", `$."b"."c"`, `"x"`, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "a8m"))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{name: "a8m", age: 30, id: 1},
+		},
+		{
+			name:    "fields/merge_unsupported",
+			dialect: dialect.SQLite,
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Merge: []*FieldSpec{
+						{Column: "meta", Type: field.TypeJSON, Value: map[string]interface{}{"a": 1}},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectRollback()
+			},
+			wantErr:  true,
+			wantUser: &user{},
+		},
+		{
+			name: "edges/o2o_non_inverse and m2o",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Edges: EdgeMut{
+					Clear: []*EdgeSpec{
+						{Rel: O2O, Columns: []string{"car_id"}, Inverse: true},
+						{Rel: M2O, Columns: []string{"workplace_id"}, Inverse: true},
+					},
+					Add: []*EdgeSpec{
+						{Rel: O2O, Columns: []string{"card_id"}, Inverse: true, Target: &EdgeTarget{Nodes: []driver.Value{2}}},
+						{Rel: M2O, Columns: []string{"parent_id"}, Inverse: true, Target: &EdgeTarget{Nodes: []driver.Value{2}}},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `workplace_id` = NULL, `car_id` = NULL, `parent_id` = ?, `card_id` = ? WHERE `id` = ?")).
+					WithArgs(2, 2, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 31, nil))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{age: 31, id: 1},
+		},
+		{
+			name: "edges/o2o_bidi",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Edges: EdgeMut{
+					Clear: []*EdgeSpec{
+						{Rel: O2O, Table: "users", Bidi: true, Columns: []string{"partner_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}}},
+						{Rel: O2O, Table: "users", Bidi: true, Columns: []string{"spouse_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{2}}},
+					},
+					Add: []*EdgeSpec{
+						{Rel: O2O, Table: "users", Bidi: true, Columns: []string{"spouse_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{3}}},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				// Clear the "partner" from 1's column, and set "spouse 3".
+				// "spouse 2" is implicitly removed when setting a different foreign-key.
 				mock.ExpectExec(escape("UPDATE `users` SET `partner_id` = NULL, `spouse_id` = ? WHERE `id` = ?")).
 					WithArgs(3, 1).
 					WillReturnResult(sqlmock.NewResult(1, 1))
@@ -905,56 +1644,151 @@ func TestUpdateNode(t *testing.T) {
 				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
 					WithArgs(1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
-						AddRow(1, 31, nil))
+						AddRow(1, 31, nil))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{age: 31, id: 1},
+		},
+		{
+			name: "edges/clear_add_m2m",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Edges: EdgeMut{
+					Clear: []*EdgeSpec{
+						{Rel: M2M, Table: "user_friends", Bidi: true, Columns: []string{"user_id", "friend_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{2}}},
+						{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{3, 7}}},
+					},
+					Add: []*EdgeSpec{
+						{Rel: M2M, Table: "user_friends", Bidi: true, Columns: []string{"user_id", "friend_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{4}}},
+						{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{5}}},
+						{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{6, 8}}},
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				// Clear user groups.
+				mock.ExpectExec(escape("DELETE FROM `group_users` WHERE (`group_id` IN (?, ?) AND `user_id` = ?)")).
+					WithArgs(3, 7, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				// Clear user friends.
+				mock.ExpectExec(escape("DELETE FROM `user_friends` WHERE ((`user_id` = ? AND `friend_id` = ?) OR (`user_id` = ? AND `friend_id` = ?))")).
+					WithArgs(1, 2, 2, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				// Add new groups.
+				mock.ExpectExec(escape("INSERT INTO `group_users` (`group_id`, `user_id`) VALUES (?, ?), (?, ?), (?, ?)")).
+					WithArgs(5, 1, 6, 1, 8, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				// Add new friends.
+				mock.ExpectExec(escape("INSERT INTO `user_friends` (`user_id`, `friend_id`) VALUES (?, ?), (?, ?)")).
+					WithArgs(1, 4, 4, 1).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 31, nil))
+				mock.ExpectCommit()
+			},
+			wantUser: &user{age: 31, id: 1},
+		},
+		{
+			name: "fields/set_predicate",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
+					},
+				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.EQ(s.C("name"), "a8m"))
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE `id` = ? AND `users`.`name` = ?")).
+					WithArgs(30, 1, "a8m").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
+						AddRow(1, 30, "a8m"))
 				mock.ExpectCommit()
 			},
-			wantUser: &user{age: 31, id: 1},
+			wantUser: &user{name: "a8m", age: 30, id: 1},
 		},
 		{
-			name: "edges/clear_add_m2m",
+			name: "fields/set_predicate_unchanged",
 			spec: &UpdateSpec{
 				Node: &NodeSpec{
 					Table:   "users",
 					Columns: []string{"id", "name", "age"},
 					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
 				},
-				Edges: EdgeMut{
-					Clear: []*EdgeSpec{
-						{Rel: M2M, Table: "user_friends", Bidi: true, Columns: []string{"user_id", "friend_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{2}}},
-						{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{3, 7}}},
-					},
-					Add: []*EdgeSpec{
-						{Rel: M2M, Table: "user_friends", Bidi: true, Columns: []string{"user_id", "friend_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{4}}},
-						{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{5}}},
-						{Rel: M2M, Inverse: true, Table: "group_users", Columns: []string{"group_id", "user_id"}, Target: &EdgeTarget{IDSpec: &FieldSpec{Column: "id"}, Nodes: []driver.Value{6, 8}}},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
 					},
 				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.EQ(s.C("name"), "a8m"))
+				},
 			},
 			prepare: func(mock sqlmock.Sqlmock) {
 				mock.ExpectBegin()
-				// Clear user groups.
-				mock.ExpectExec(escape("DELETE FROM `group_users` WHERE (`group_id` IN (?, ?) AND `user_id` = ?)")).
-					WithArgs(3, 7, 1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				// Clear user friends.
-				mock.ExpectExec(escape("DELETE FROM `user_friends` WHERE ((`user_id` = ? AND `friend_id` = ?) OR (`user_id` = ? AND `friend_id` = ?))")).
-					WithArgs(1, 2, 2, 1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				// Add new groups.
-				mock.ExpectExec(escape("INSERT INTO `group_users` (`group_id`, `user_id`) VALUES (?, ?), (?, ?), (?, ?)")).
-					WithArgs(5, 1, 6, 1, 8, 1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
-				// Add new friends.
-				mock.ExpectExec(escape("INSERT INTO `user_friends` (`user_id`, `friend_id`) VALUES (?, ?), (?, ?)")).
-					WithArgs(1, 4, 4, 1).
-					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE `id` = ? AND `users`.`name` = ?")).
+					WithArgs(30, 1, "a8m").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				// Zero affected rows, check that the node matches the predicate.
+				mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `id` = ? AND `users`.`name` = ?")).
+					WithArgs(1, "a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).
+						AddRow(1))
 				mock.ExpectQuery(escape("SELECT `id`, `name`, `age` FROM `users` WHERE `id` = ?")).
 					WithArgs(1).
 					WillReturnRows(sqlmock.NewRows([]string{"id", "age", "name"}).
-						AddRow(1, 31, nil))
+						AddRow(1, 30, "a8m"))
 				mock.ExpectCommit()
 			},
-			wantUser: &user{age: 31, id: 1},
+			wantUser: &user{name: "a8m", age: 30, id: 1},
+		},
+		{
+			name: "fields/set_predicate_not_found",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table:   "users",
+					Columns: []string{"id", "name", "age"},
+					ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "age", Type: field.TypeInt, Value: 30},
+					},
+				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.EQ(s.C("name"), "a8m"))
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE `id` = ? AND `users`.`name` = ?")).
+					WithArgs(30, 1, "a8m").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `id` = ? AND `users`.`name` = ?")).
+					WithArgs(1, "a8m").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			wantErr:  true,
+			wantUser: &user{},
 		},
 	}
 	for _, tt := range tests {
@@ -965,7 +1799,7 @@ func TestUpdateNode(t *testing.T) {
 			usr := &user{}
 			tt.spec.Assign = usr.assign
 			tt.spec.ScanValues = usr.values()
-			err = UpdateNode(context.Background(), sql.OpenDB("", db), tt.spec)
+			err = UpdateNode(context.Background(), sql.OpenDB(tt.dialect, db), tt.spec)
 			require.Equal(t, tt.wantErr, err != nil, err)
 			require.Equal(t, tt.wantUser, usr)
 		})
@@ -1041,6 +1875,37 @@ func TestUpdateNodes(t *testing.T) {
 			},
 			wantAffected: 1,
 		},
+		{
+			name: "with limit",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table: "users",
+					ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+				},
+				Fields: FieldMut{
+					Set: []*FieldSpec{
+						{Column: "active", Type: field.TypeBool, Value: false},
+					},
+				},
+				Predicate: func(s *sql.Selector) {
+					s.Where(sql.EQ("active", true))
+				},
+				Limit: 2,
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `active` = ? LIMIT ?")).
+					WithArgs(true, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).
+						AddRow(1).
+						AddRow(2))
+				mock.ExpectExec(escape("UPDATE `users` SET `active` = ? WHERE `id` IN (?, ?)")).
+					WithArgs(false, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			wantAffected: 2,
+		},
 		{
 			name: "own_fks/m2o_o2o_inverse",
 			spec: &UpdateSpec{
@@ -1135,6 +2000,239 @@ func TestUpdateNodes(t *testing.T) {
 	}
 }
 
+func TestUpdateNodes_Duration(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape(`SELECT "id" FROM "notes" WHERE "id" = $1`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(escape(`UPDATE "notes" SET "ttl" = COALESCE("ttl", $1) + $2 WHERE "id" = $3`)).
+		WithArgs(0, "-00:01:30", 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	affected, err := UpdateNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), &UpdateSpec{
+		Node: &NodeSpec{
+			Table: "notes",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.EQ("id", 1))
+		},
+		Fields: FieldMut{
+			Add: []*FieldSpec{
+				{Column: "ttl", Type: field.TypeDuration, Value: -90 * time.Second},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateNodes_ScanIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	var ids []int
+	spec := &UpdateSpec{
+		Node: &NodeSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Fields: FieldMut{
+			Set: []*FieldSpec{
+				{Column: "age", Type: field.TypeInt, Value: 30},
+			},
+		},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.EQ("name", "a8m"))
+		},
+		ScanIDs: &ids,
+	}
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `name` = ?")).
+		WithArgs("a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).
+			AddRow(1).
+			AddRow(2))
+	mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE `id` IN (?, ?)")).
+		WithArgs(30, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+	affected, err := UpdateNodes(context.Background(), sql.OpenDB("", db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+	require.Equal(t, []int{1, 2}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// PostgreSQL and SQLite return the ids from the UPDATE statement.
+	db, mock, err = sqlmock.New()
+	require.NoError(t, err)
+	ids = nil
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape(`UPDATE "users" SET "age" = $1 WHERE "name" = $2 RETURNING "id"`)).
+		WithArgs(30, "a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).
+			AddRow(1).
+			AddRow(2))
+	mock.ExpectCommit()
+	affected, err = UpdateNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+	require.Equal(t, []int{1, 2}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// Limited updates select the ids of the nodes in a subquery. In SQLite,
+	// the version of the library is detected once, by the first update.
+	db, mock, err = sqlmock.New()
+	require.NoError(t, err)
+	ids = nil
+	spec.Limit = 1
+	sqliteReturning.checked = false
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape("SELECT sqlite_version()")).
+		WillReturnRows(sqlmock.NewRows([]string{"sqlite_version()"}).
+			AddRow("3.35.5"))
+	mock.ExpectQuery(escape("UPDATE `users` SET `age` = ? WHERE `id` IN (SELECT `users`.`id` FROM `users` WHERE `name` = ? LIMIT ?) RETURNING `id`")).
+		WithArgs(30, "a8m", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).
+			AddRow(1))
+	mock.ExpectCommit()
+	affected, err = UpdateNodes(context.Background(), sql.OpenDB(dialect.SQLite, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+	require.Equal(t, []int{1}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// The detected version is reused by the next updates.
+	db, mock, err = sqlmock.New()
+	require.NoError(t, err)
+	ids = nil
+	spec.Limit = 0
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape("UPDATE `users` SET `age` = ? WHERE `name` = ? RETURNING `id`")).
+		WithArgs(30, "a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).
+			AddRow(1))
+	mock.ExpectCommit()
+	affected, err = UpdateNodes(context.Background(), sql.OpenDB(dialect.SQLite, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+	require.Equal(t, []int{1}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// SQLite versions that do not support the RETURNING clause fall back to selecting the ids.
+	db, mock, err = sqlmock.New()
+	require.NoError(t, err)
+	ids = nil
+	sqliteReturning.checked = false
+	mock.ExpectBegin()
+	mock.ExpectQuery(escape("SELECT sqlite_version()")).
+		WillReturnRows(sqlmock.NewRows([]string{"sqlite_version()"}).
+			AddRow("3.30.1"))
+	mock.ExpectQuery(escape("SELECT `id` FROM `users` WHERE `name` = ?")).
+		WithArgs("a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).
+			AddRow(1))
+	mock.ExpectExec(escape("UPDATE `users` SET `age` = ? WHERE `id` = ?")).
+		WithArgs(30, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	affected, err = UpdateNodes(context.Background(), sql.OpenDB(dialect.SQLite, db), spec)
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+	require.Equal(t, []int{1}, ids)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDryRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := sql.DryRun(sql.OpenDB(dialect.Postgres, db))
+	ctx := sql.WithDryRun(context.Background())
+	spec := &CreateSpec{
+		Table:   "users",
+		ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+		Columns: []string{"id", "name"},
+		Fields: []*FieldSpec{
+			{Column: "name", Type: field.TypeString, Value: "a8m"},
+		},
+		Edges: []*EdgeSpec{
+			{Rel: O2M, Table: "pets", Columns: []string{"owner_id"}, Target: &EdgeTarget{Nodes: []driver.Value{2}, IDSpec: &FieldSpec{Column: "id"}}},
+		},
+	}
+	require.NoError(t, CreateNode(ctx, drv, spec))
+	require.Equal(t, int64(0), spec.ID.Value)
+	err = UpdateNode(ctx, drv, &UpdateSpec{
+		Node: &NodeSpec{
+			Table:   "users",
+			Columns: []string{"id", "name"},
+			ID:      &FieldSpec{Column: "id", Type: field.TypeInt, Value: 1},
+		},
+		Fields: FieldMut{
+			Set: []*FieldSpec{{Column: "name", Type: field.TypeString, Value: "Ariel"}},
+		},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.EQ("name", "a8m"))
+		},
+		Assign: func(...interface{}) error {
+			t.Fatal("node is read back in dry-run mode")
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []sql.Statement{
+		{Query: `INSERT INTO "users" ("name") VALUES ($1)`, Args: []interface{}{"a8m"}},
+		{Query: `UPDATE "pets" SET "owner_id" = $1 WHERE ("id" = $2) AND ("owner_id" IS NULL)`, Args: []interface{}{int64(0), 2}},
+		{Query: `UPDATE "users" SET "name" = $1 WHERE "id" = $2 AND "name" = $3`, Args: []interface{}{"Ariel", 1, "a8m"}},
+	}, sql.DryRunStatements(ctx))
+
+	_, err = UpdateNodes(ctx, drv, &UpdateSpec{Node: &NodeSpec{Table: "users"}})
+	require.EqualError(t, err, "sqlgraph: UpdateNodes is not supported in dry-run mode")
+	err = BatchCreate(ctx, drv, &BatchCreateSpec{Nodes: []*CreateSpec{spec}})
+	require.EqualError(t, err, "sqlgraph: BatchCreate is not supported in dry-run mode")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+type idsQuerier func() *sql.Selector
+
+func (f idsQuerier) IDsQuery() *sql.Selector { return f() }
+
+func TestInsertSelect(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	users := idsQuerier(func() *sql.Selector {
+		t := sql.Table("users")
+		return sql.Select(t.C("id")).From(t).Where(sql.GT("age", 30))
+	})
+	mock.ExpectExec(escape("INSERT INTO `archived_users` (`age`, `name`) SELECT `users`.`age`, `users`.`name` FROM `users` WHERE `age` > ?")).
+		WithArgs(30).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	affected, err := InsertSelect(context.Background(), sql.OpenDB("", db), &InsertSelectSpec{
+		Table:   "archived_users",
+		Columns: []string{"id", "name", "age"},
+		Mapping: map[string]string{"name": "name", "age": "age"},
+		Source:  users,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, affected)
+
+	_, err = InsertSelect(context.Background(), sql.OpenDB("", db), &InsertSelectSpec{
+		Table:   "archived_users",
+		Columns: []string{"id", "name", "age"},
+		Mapping: map[string]string{"nickname": "name"},
+		Source:  users,
+	})
+	require.EqualError(t, err, `sqlgraph: unknown column "nickname" for table "archived_users"`)
+	_, err = InsertSelect(context.Background(), sql.OpenDB("", db), &InsertSelectSpec{
+		Table:   "archived_users",
+		Columns: []string{"id", "name", "age"},
+		Source:  users,
+	})
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDeleteNodes(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -1150,6 +2248,76 @@ func TestDeleteNodes(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, 2, affected)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(escape("DELETE FROM `cards` WHERE `owner_id` IN (SELECT `users`.`id` FROM `users` WHERE `age` > ?)")).
+		WithArgs(30).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	affected, err = DeleteNodes(context.Background(), sql.OpenDB("", db), &DeleteSpec{
+		Node: &NodeSpec{
+			Table: "cards",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Predicate: func(s *sql.Selector) {
+			t := sql.Table("users")
+			sub := sql.Select(t.C("id")).From(t).Where(sql.GT("age", 30))
+			s.Where(sql.In("owner_id", sub))
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, affected)
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	_, err = DeleteNodes(context.Background(), sql.OpenDB("", db), &DeleteSpec{
+		Node: &NodeSpec{
+			Table: "cards",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Predicate: func(s *sql.Selector) {
+			s.AddError(errors.New("invalid sub-query"))
+		},
+	})
+	require.EqualError(t, err, "invalid sub-query")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	mock.ExpectBegin()
+	mock.ExpectExec(escape("DELETE FROM `cards` WHERE `expired` = ? LIMIT ?")).
+		WithArgs(true, 1000).
+		WillReturnResult(sqlmock.NewResult(0, 1000))
+	mock.ExpectCommit()
+	affected, err = DeleteNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), &DeleteSpec{
+		Node: &NodeSpec{
+			Table: "cards",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.EQ("expired", true))
+		},
+		Limit: 1000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1000, affected)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(escape(`DELETE FROM "cards" WHERE "id" IN (SELECT "cards"."id" FROM "cards" WHERE "expired" = $1 LIMIT $2)`)).
+		WithArgs(true, 1000).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectCommit()
+	affected, err = DeleteNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), &DeleteSpec{
+		Node: &NodeSpec{
+			Table: "cards",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.EQ("expired", true))
+		},
+		Limit: 1000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 10, affected)
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestQueryNodes(t *testing.T) {
@@ -1207,6 +2375,156 @@ func TestQueryNodes(t *testing.T) {
 	require.Equal(t, 3, n)
 }
 
+func TestQueryNodes_PartitionBy(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT `t`.`id`, `t`.`name`, `t`.`owner_id` FROM (SELECT `cards`.`id`, `cards`.`name`, `cards`.`owner_id`, ROW_NUMBER() OVER (PARTITION BY `cards`.`owner_id` ORDER BY `name` DESC) AS `row_number` FROM `cards` WHERE `owner_id` IN (?, ?)) AS `t` WHERE `t`.`row_number` > ? AND `t`.`row_number` <= ? ORDER BY `t`.`owner_id`, `t`.`row_number`")).
+		WithArgs(1, 2, 1, 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "owner_id"}).
+			AddRow(3, "b", 1).
+			AddRow(2, "a", 1).
+			AddRow(5, "c", 2))
+	var ids []int64
+	err = QueryNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), &QuerySpec{
+		Node: &NodeSpec{
+			Table:   "cards",
+			Columns: []string{"id", "name", "owner_id"},
+			ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Unique:      true,
+		Limit:       2,
+		Offset:      1,
+		PartitionBy: "owner_id",
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.InInts("owner_id", 1, 2))
+		},
+		Order: func(s *sql.Selector) {
+			s.OrderBy(sql.Desc("name"))
+		},
+		ScanValues: func() []interface{} {
+			return []interface{}{&sql.NullInt64{}, &sql.NullString{}, &sql.NullInt64{}}
+		},
+		Assign: func(values ...interface{}) error {
+			ids = append(ids, values[0].(*sql.NullInt64).Int64)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []int64{3, 2, 5}, ids)
+}
+
+func TestQueryNodes_Exprs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT DISTINCT `users`.`id`, `users`.`age`, `age` + ? AS `next_age` FROM `users` WHERE `name` = ?")).
+		WithArgs(1, "a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "age", "next_age"}).
+			AddRow(1, 30, 31))
+	var next []int64
+	err = QueryNodes(context.Background(), sql.OpenDB(dialect.MySQL, db), &QuerySpec{
+		Node: &NodeSpec{
+			Table:   "users",
+			Columns: []string{"id", "age"},
+			ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Unique: true,
+		Exprs:  []*sql.SelectExpr{sql.ExprAs("`age` + ?", "next_age", 1)},
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.EQ("name", "a8m"))
+		},
+		ScanValues: func() []interface{} {
+			return []interface{}{&sql.NullInt64{}, &sql.NullInt64{}, &sql.NullInt64{}}
+		},
+		Assign: func(values ...interface{}) error {
+			next = append(next, values[2].(*sql.NullInt64).Int64)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []int64{31}, next)
+}
+
+func TestQueryNodes_ForUpdate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape(`SELECT "users"."id", "users"."age" FROM "users" WHERE "age" < $1 FOR UPDATE`)).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "age"}).AddRow(1, 10))
+	var ids []int64
+	err = QueryNodes(context.Background(), sql.OpenDB(dialect.Postgres, db), &QuerySpec{
+		Node: &NodeSpec{
+			Table:   "users",
+			Columns: []string{"id", "age"},
+			ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+		},
+		Unique:    true,
+		ForUpdate: true,
+		Predicate: func(s *sql.Selector) {
+			s.Where(sql.LT("age", 40))
+		},
+		ScanValues: func() []interface{} {
+			return []interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+		},
+		Assign: func(values ...interface{}) error {
+			ids = append(ids, values[0].(*sql.NullInt64).Int64)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []int64{1}, ids)
+}
+
+func TestCountDistinct(t *testing.T) {
+	spec := func() *QuerySpec {
+		return &QuerySpec{
+			Node: &NodeSpec{
+				Table:   "users",
+				Columns: []string{"id", "age", "name"},
+				ID:      &FieldSpec{Column: "id", Type: field.TypeInt},
+			},
+			Unique: true,
+			Predicate: func(s *sql.Selector) {
+				s.Where(sql.LT("age", 40))
+			},
+		}
+	}
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape("SELECT COUNT(DISTINCT `users`.`name`) FROM `users` WHERE `age` < ?")).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(2))
+	mock.ExpectQuery(escape("SELECT COUNT(DISTINCT `users`.`name`, `users`.`age`) FROM `users` WHERE `age` < ?")).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(3))
+	n, err := CountDistinct(context.Background(), sql.OpenDB(dialect.MySQL, db), spec(), "name")
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	n, err = CountDistinct(context.Background(), sql.OpenDB(dialect.MySQL, db), spec(), "name", "age")
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	db, mock, err = sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(escape(`SELECT COUNT(DISTINCT ("users"."name", "users"."age")) FROM "users" WHERE "age" < $1`)).
+		WithArgs(40).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(3))
+	n, err = CountDistinct(context.Background(), sql.OpenDB(dialect.Postgres, db), spec(), "name", "age")
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	_, err = CountDistinct(context.Background(), sql.OpenDB(dialect.SQLite, db), spec(), "name", "age")
+	require.Error(t, err, "multiple columns are not supported by SQLite")
+	_, err = CountDistinct(context.Background(), sql.OpenDB(dialect.SQLite, db), spec())
+	require.Error(t, err, "missing columns")
+	_, err = CountDistinct(context.Background(), sql.OpenDB(dialect.SQLite, db), spec(), "unknown")
+	require.Error(t, err, "unknown column")
+}
+
 func TestQueryEdges(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
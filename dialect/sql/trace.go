@@ -0,0 +1,194 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Attributes recorded on the spans by the TraceDriver.
+const (
+	SystemAttribute    = "db.system"
+	StatementAttribute = "db.statement"
+	EntityAttribute    = "ent.entity"
+	OperationAttribute = "ent.operation"
+	RowsAttribute      = "db.rows_affected"
+)
+
+type (
+	// Tracer starts the spans that are recorded by the TraceDriver. It is intentionally
+	// minimal, and it can be implemented by a thin adapter on top of tracing libraries,
+	// like OpenTelemetry or OpenCensus. For example:
+	//
+	//	type tracer struct{ trace.Tracer }
+	//
+	//	func (t tracer) Start(ctx context.Context, name string, attrs ...sql.SpanAttribute) (context.Context, sql.Span) {
+	//		ctx, span := t.Tracer.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+	//		s := otelSpan{span}
+	//		s.SetAttributes(attrs...)
+	//		return ctx, s
+	//	}
+	//
+	Tracer interface {
+		Start(ctx context.Context, name string, attrs ...SpanAttribute) (context.Context, Span)
+	}
+
+	// Span is a single traced operation that was started by a Tracer.
+	Span interface {
+		// SetAttributes sets the given attributes on the span.
+		SetAttributes(...SpanAttribute)
+		// RecordError records the error that the operation failed with.
+		RecordError(error)
+		// End completes the span.
+		End()
+	}
+
+	// SpanAttribute is a key-value pair that is recorded on a span.
+	SpanAttribute struct {
+		Key   string
+		Value interface{}
+	}
+)
+
+// operation holds the entity and the operation that
+// are executed by the statements of the context.
+type operation struct {
+	entity, op string
+}
+
+type operationKey struct{}
+
+// WithOperation returns a new context that carries the name of the entity and the
+// operation (e.g. "Query" or "Create") that are executed by the statements of the
+// context. The TraceDriver records them on the statements spans.
+func WithOperation(ctx context.Context, entity, op string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation{entity: entity, op: op})
+}
+
+// TraceDriver is a driver that records a span for each statement
+// (and transaction) that is executed by the underlying driver.
+type TraceDriver struct {
+	dialect.Driver        // underlying driver.
+	tracer         Tracer // span tracer.
+}
+
+// Trace gets a driver and a tracer, and returns a new driver that records
+// a span for each statement and transaction that are executed by it.
+func Trace(d dialect.Driver, t Tracer) dialect.Driver {
+	return &TraceDriver{d, t}
+}
+
+// Exec records a span and calls the underlying driver Exec method.
+func (d *TraceDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := d.start(ctx, ctx, query)
+	defer span.End()
+	return record(span, v, d.Driver.Exec(ctx, query, args, v))
+}
+
+// Query records a span and calls the underlying driver Query method.
+func (d *TraceDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	ctx, span := d.start(ctx, ctx, query)
+	defer span.End()
+	return record(span, nil, d.Driver.Query(ctx, query, args, v))
+}
+
+// Tx starts a transaction that is recorded as a span, and
+// its statements are recorded as children of this span.
+func (d *TraceDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	ctx, span := d.tracer.Start(ctx, "sql.Tx", SpanAttribute{SystemAttribute, d.Dialect()})
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.End()
+		return nil, err
+	}
+	return &TraceTx{tx, d, ctx, span}, nil
+}
+
+// BeginTx starts a transaction with options that is recorded as a span.
+// It fails if the underlying driver does not support transaction options.
+func (d *TraceDriver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql: Driver.BeginTx is not supported by %T", d.Driver)
+	}
+	ctx, span := d.tracer.Start(ctx, "sql.Tx", SpanAttribute{SystemAttribute, d.Dialect()})
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		span.RecordError(err)
+		span.End()
+		return nil, err
+	}
+	return &TraceTx{tx, d, ctx, span}, nil
+}
+
+// start starts a span for the given statement. The parent context holds the
+// parent span, and the operation is extracted from the statement context.
+func (d *TraceDriver) start(parent, ctx context.Context, query string) (context.Context, Span) {
+	name := "sql.Statement"
+	attrs := []SpanAttribute{{SystemAttribute, d.Dialect()}, {StatementAttribute, query}}
+	if op, ok := ctx.Value(operationKey{}).(operation); ok {
+		name = "ent." + op.entity + "." + op.op
+		attrs = append(attrs, SpanAttribute{EntityAttribute, op.entity}, SpanAttribute{OperationAttribute, op.op})
+	}
+	return d.tracer.Start(parent, name, attrs...)
+}
+
+// TraceTx is a transaction implementation that records a span for each statement.
+type TraceTx struct {
+	dialect.Tx                 // underlying transaction.
+	drv        *TraceDriver    // driver that started the transaction.
+	ctx        context.Context // transaction span context.
+	span       Span            // transaction span.
+}
+
+// Exec records a span (as a child of the transaction span)
+// and calls the underlying transaction Exec method.
+func (t *TraceTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	_, span := t.drv.start(t.ctx, ctx, query)
+	defer span.End()
+	return record(span, v, t.Tx.Exec(ctx, query, args, v))
+}
+
+// Query records a span (as a child of the transaction span)
+// and calls the underlying transaction Query method.
+func (t *TraceTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	_, span := t.drv.start(t.ctx, ctx, query)
+	defer span.End()
+	return record(span, nil, t.Tx.Query(ctx, query, args, v))
+}
+
+// Commit commits the underlying transaction and ends its span.
+func (t *TraceTx) Commit() error {
+	defer t.span.End()
+	return record(t.span, nil, t.Tx.Commit())
+}
+
+// Rollback rollbacks the underlying transaction and ends its span.
+func (t *TraceTx) Rollback() error {
+	defer t.span.End()
+	return record(t.span, nil, t.Tx.Rollback())
+}
+
+// record records the error or the number of affected rows on the span.
+func record(span Span, v interface{}, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	if res, ok := v.(*Result); ok && *res != nil {
+		if n, err := (*res).RowsAffected(); err == nil {
+			span.SetAttributes(SpanAttribute{RowsAttribute, n})
+		}
+	}
+	return nil
+}
+
+var _ dialect.Driver = (*TraceDriver)(nil)
@@ -0,0 +1,91 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	spanKey    struct{}
+	mockTracer struct{ spans []*mockSpan }
+	mockSpan   struct {
+		name   string
+		parent *mockSpan
+		attrs  map[string]interface{}
+		err    error
+		ended  bool
+	}
+)
+
+func (t *mockTracer) Start(ctx context.Context, name string, attrs ...SpanAttribute) (context.Context, Span) {
+	s := &mockSpan{name: name, attrs: make(map[string]interface{})}
+	s.parent, _ = ctx.Value(spanKey{}).(*mockSpan)
+	s.SetAttributes(attrs...)
+	t.spans = append(t.spans, s)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func (s *mockSpan) SetAttributes(attrs ...SpanAttribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *mockSpan) RecordError(err error) { s.err = err }
+func (s *mockSpan) End()                  { s.ended = true }
+
+func TestTrace(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	tracer := &mockTracer{}
+	drv := Trace(OpenDB("mysql", db), tracer)
+	ctx := context.Background()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `age` = ?")).
+		WithArgs(30).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	var res Result
+	require.NoError(t, drv.Exec(WithOperation(ctx, "User", "Update"), "UPDATE `users` SET `age` = ?", []interface{}{30}, &res))
+	require.Len(t, tracer.spans, 1)
+	span := tracer.spans[0]
+	require.Equal(t, "ent.User.Update", span.name)
+	require.True(t, span.ended)
+	require.Equal(t, "mysql", span.attrs[SystemAttribute])
+	require.Equal(t, "UPDATE `users` SET `age` = ?", span.attrs[StatementAttribute])
+	require.Equal(t, "User", span.attrs[EntityAttribute])
+	require.Equal(t, "Update", span.attrs[OperationAttribute])
+	require.Equal(t, int64(2), span.attrs[RowsAttribute])
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id` FROM `users`")).
+		WillReturnError(errors.New("bad conn"))
+	err = drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, &Rows{})
+	require.Error(t, err)
+	span = tracer.spans[1]
+	require.Equal(t, "sql.Statement", span.name)
+	require.Equal(t, err, span.err)
+	require.True(t, span.ended)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `users`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil))
+	require.NoError(t, tx.Commit())
+	require.Len(t, tracer.spans, 4)
+	txSpan, span := tracer.spans[2], tracer.spans[3]
+	require.Equal(t, "sql.Tx", txSpan.name)
+	require.True(t, txSpan.ended)
+	require.Equal(t, txSpan, span.parent, "transaction statements are nested in its span")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,175 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Vector represents a pgvector value (an embedding). It implements the sql.Scanner
+// and driver.Valuer interfaces, and it is the Go type of the field.Vector fields.
+//
+//	field.Vector("embedding", 1536)
+//
+type Vector []float32
+
+// Value implements the driver.Valuer interface. Vectors are encoded
+// in the pgvector text format. For example, '[1,2,3]'.
+func (v Vector) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts vectors
+// in the pgvector text format, in their string or raw form.
+func (v *Vector) Scan(src interface{}) error {
+	var s string
+	switch src := src.(type) {
+	case nil:
+		*v = nil
+		return nil
+	case string:
+		s = src
+	case []byte:
+		s = string(src)
+	default:
+		return fmt.Errorf("sql: unexpected type %T for Vector", src)
+	}
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || s[0] != '[' || s[len(s)-1] != ']' {
+		return fmt.Errorf("sql: invalid Vector value %q", s)
+	}
+	vec := Vector{}
+	if s = strings.TrimSpace(s[1 : len(s)-1]); s != "" {
+		parts := strings.Split(s, ",")
+		vec = make(Vector, len(parts))
+		for i, p := range parts {
+			f, err := strconv.ParseFloat(strings.TrimSpace(p), 32)
+			if err != nil {
+				return fmt.Errorf("sql: invalid Vector element %q: %v", p, err)
+			}
+			vec[i] = float32(f)
+		}
+	}
+	*v = vec
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (v Vector) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(f), 'f', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+// VectorOp is a pgvector distance operator.
+type VectorOp string
+
+// Distance operators of pgvector.
+const (
+	// OpL2Distance computes the Euclidean distance between two vectors.
+	OpL2Distance VectorOp = "<->"
+	// OpCosineDistance computes the cosine distance (1 - cosine similarity) between two vectors.
+	OpCosineDistance VectorOp = "<=>"
+	// OpInnerProduct computes the negative inner product of two vectors.
+	OpInnerProduct VectorOp = "<#>"
+)
+
+// Valid reports if the operator is a valid pgvector distance operator.
+func (op VectorOp) Valid() bool {
+	return op == OpL2Distance || op == OpCosineDistance || op == OpInnerProduct
+}
+
+// L2Distance returns a predicate that checks if the Euclidean distance between the vector
+// stored in the given column and v is at most max. Vectors are supported only by PostgreSQL
+// (pgvector).
+//
+//	L2Distance("embedding", v, 0.5)
+//
+func L2Distance(col string, v Vector, max float64) *Predicate {
+	return (&Predicate{}).L2Distance(col, v, max)
+}
+
+// L2Distance returns a predicate that checks if the Euclidean distance between
+// the vector stored in the given column and v is at most max.
+func (p *Predicate) L2Distance(col string, v Vector, max float64) *Predicate {
+	return p.append(func(b *Builder) {
+		b.Ident(col).WriteString(" " + string(OpL2Distance) + " ")
+		b.Arg(v).WriteString(" <= ")
+		b.Arg(max)
+	})
+}
+
+// CosineSimilarity returns a predicate that checks if the cosine similarity between the
+// vector stored in the given column and v is at least min. Vectors are supported only by
+// PostgreSQL (pgvector).
+//
+//	CosineSimilarity("embedding", v, 0.8)
+//
+func CosineSimilarity(col string, v Vector, min float64) *Predicate {
+	return (&Predicate{}).CosineSimilarity(col, v, min)
+}
+
+// CosineSimilarity returns a predicate that checks if the cosine similarity
+// between the vector stored in the given column and v is at least min.
+func (p *Predicate) CosineSimilarity(col string, v Vector, min float64) *Predicate {
+	return p.append(func(b *Builder) {
+		b.WriteString("1 - (")
+		b.Ident(col).WriteString(" " + string(OpCosineDistance) + " ")
+		b.Arg(v).WriteString(") >= ")
+		b.Arg(min)
+	})
+}
+
+// InnerProduct returns a predicate that checks if the inner product of the vector stored
+// in the given column and v is at least min. Vectors are supported only by PostgreSQL
+// (pgvector).
+//
+//	InnerProduct("embedding", v, 0.8)
+//
+func InnerProduct(col string, v Vector, min float64) *Predicate {
+	return (&Predicate{}).InnerProduct(col, v, min)
+}
+
+// InnerProduct returns a predicate that checks if the inner product
+// of the vector stored in the given column and v is at least min.
+func (p *Predicate) InnerProduct(col string, v Vector, min float64) *Predicate {
+	return p.append(func(b *Builder) {
+		b.WriteString("(")
+		b.Ident(col).WriteString(" " + string(OpInnerProduct) + " ")
+		b.Arg(v).WriteString(") * -1 >= ")
+		b.Arg(min)
+	})
+}
+
+// OrderByDistance returns an ordering term of the `ORDER BY` clause that sorts rows by the distance
+// between the vector stored in the given column and v (the nearest first), using the given operator.
+// Since ordering terms are not bound to arguments, the vector is inlined in the term as a literal.
+//
+//	Select().
+//		From(Table("documents")).
+//		OrderBy(OrderByDistance("embedding", v, OpCosineDistance)).
+//		Limit(10)
+//
+func OrderByDistance(col string, v Vector, op VectorOp) string {
+	b := &Builder{dialect: dialect.Postgres}
+	b.Ident(col).WriteString(" " + string(op) + " ")
+	b.WriteString("'" + v.String() + "'")
+	return b.String()
+}
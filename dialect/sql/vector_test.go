@@ -0,0 +1,69 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVector(t *testing.T) {
+	v, err := Vector{1, 2.5, -0.125}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "[1,2.5,-0.125]", v)
+	v, err = Vector(nil).Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	vec := &Vector{}
+	require.NoError(t, vec.Scan("[1,2.5,-0.125]"))
+	require.Equal(t, Vector{1, 2.5, -0.125}, *vec)
+	require.NoError(t, vec.Scan([]byte(" [3, 4] ")))
+	require.Equal(t, Vector{3, 4}, *vec)
+	require.NoError(t, vec.Scan("[]"))
+	require.Equal(t, Vector{}, *vec)
+	require.NoError(t, vec.Scan(nil))
+	require.Nil(t, *vec)
+
+	require.Error(t, vec.Scan("1,2"))
+	require.Error(t, vec.Scan("[1,a]"))
+	require.Error(t, vec.Scan(1))
+
+	ns := &NullScanner{S: &Vector{}}
+	require.NoError(t, ns.Scan(nil))
+	require.False(t, ns.Valid)
+	require.NoError(t, ns.Scan("[1,2]"))
+	require.True(t, ns.Valid)
+	require.Equal(t, Vector{1, 2}, *ns.S.(*Vector))
+}
+
+func TestVectorPredicates(t *testing.T) {
+	v := Vector{1, 2}
+	query, args := Dialect(dialect.Postgres).
+		Select("*").
+		From(Table("documents")).
+		Where(Or(L2Distance("embedding", v, 0.5), CosineSimilarity("embedding", v, 0.8), InnerProduct("embedding", v, 1))).
+		Query()
+	require.Equal(t, `SELECT * FROM "documents" WHERE (("embedding" <-> $1 <= $2) OR (1 - ("embedding" <=> $3) >= $4) OR (("embedding" <#> $5) * -1 >= $6))`, query)
+	require.Equal(t, []interface{}{v, 0.5, v, 0.8, v, float64(1)}, args)
+}
+
+func TestOrderByDistance(t *testing.T) {
+	t1 := Table("documents")
+	query, args := Dialect(dialect.Postgres).
+		Select("id").
+		From(t1).
+		Where(EQ("lang", "en")).
+		OrderBy(OrderByDistance(t1.C("embedding"), Vector{1, 0.5}, OpCosineDistance)).
+		Limit(10).
+		Query()
+	require.Equal(t, `SELECT "id" FROM "documents" WHERE "lang" = $1 ORDER BY "documents"."embedding" <=> '[1,0.5]' LIMIT $2`, query)
+	require.Equal(t, []interface{}{"en", 10}, args)
+	require.True(t, OpInnerProduct.Valid())
+	require.False(t, VectorOp("<+>").Valid())
+}
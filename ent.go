@@ -108,6 +108,12 @@ type (
 	Config struct {
 		// A Table is an optional table name defined for the schema.
 		Table string
+		// View marks the schema as a read-only entity that is backed by a database
+		// view (or a materialized view) with the name of the Table option. Query
+		// builders are generated for the entity, but no create, update or delete
+		// builders, and the migration does not create its table. Supported only
+		// by the SQL storage, and views cannot have edges.
+		View bool
 	}
 
 	// The Mixin type describes a set of methods that can extend
@@ -311,6 +317,36 @@ func (f MutateFunc) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	return f(ctx, m)
 }
 
+// committer is implemented by the generated mutations for registering
+// functions that are called after their transaction was committed.
+type committer interface {
+	AfterCommit(func()) bool
+}
+
+// AfterCommit returns a hook that calls fn after the mutation was applied and committed
+// successfully. If the mutation is executed in a transaction (Tx), fn is called only after
+// the transaction was committed, and it is skipped if the transaction was rolled back.
+// Otherwise, fn is called after the statement (or statements) of the mutation succeeded.
+//
+//	client.Use(ent.AfterCommit(func(ctx context.Context, m ent.Mutation) {
+//		notify(ctx, m.Type(), m.Op())
+//	}))
+//
+func AfterCommit(fn func(context.Context, Mutation)) Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if c, ok := m.(committer); !ok || !c.AfterCommit(func() { fn(ctx, m) }) {
+				fn(ctx, m)
+			}
+			return v, nil
+		})
+	}
+}
+
 // An Op represents a mutation operation.
 type Op uint
 
@@ -115,6 +115,26 @@ func Storage(typ string) Option {
 	}
 }
 
+// Hooks appends the given hooks to the codegen hooks. Hooks are executed on the loaded
+// graph, and can be used for generating additional artifacts (e.g. API specifications),
+// or for adding checks before or after the code-generation.
+func Hooks(hooks ...gen.Hook) Option {
+	return func(cfg *gen.Config) error {
+		cfg.Hooks = append(cfg.Hooks, hooks...)
+		return nil
+	}
+}
+
+// NamingStrategy sets the strategy for naming the tables and the columns of the schema,
+// including the foreign-key columns and the join tables of the edges. Tables and fields
+// that were named explicitly in the schema (e.g. using StorageKey) keep their names.
+func NamingStrategy(s gen.NamingStrategy) Option {
+	return func(cfg *gen.Config) error {
+		cfg.Naming = s
+		return nil
+	}
+}
+
 // TemplateFiles parses the named files and associates the resulting templates
 // with codegen templates.
 func TemplateFiles(filenames ...string) Option {
@@ -69,12 +69,16 @@ var (
 // ops returns all operations for given field.
 func ops(f *Field) (op []Op) {
 	switch t := f.Type.Type; {
+	case f.Opaque():
+	// Encrypted values can be compared only for equality.
+	case f.Encrypted():
+		op = []Op{EQ, NEQ, In, NotIn}
 	case t == field.TypeJSON:
 	case t == field.TypeBool:
 		op = boolOps
 	case t == field.TypeString && strings.ToLower(f.Name) != "id":
 		op = stringOps
-	case t == field.TypeEnum:
+	case t == field.TypeEnum, t == field.TypeOther:
 		op = enumOps
 	default:
 		op = numericOps
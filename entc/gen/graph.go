@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime/debug"
+	"strings"
 	"text/template"
 	"text/template/parse"
 
@@ -46,7 +47,79 @@ type (
 		// Note that, additional templates are executed on the Graph object and
 		// the execution output is stored in a file derived by the template name.
 		Template *template.Template
+		// Hooks holds an optional list of Hooks to apply on the graph before/after the code-generation.
+		Hooks []Hook
+		// Naming is an optional strategy for naming the tables and columns of the
+		// types and fields. If nil, names are derived from the schema in snake-case.
+		Naming NamingStrategy
 	}
+
+	// NamingStrategy defines the storage names of the types and the fields that were not
+	// named explicitly in the schema (using the Config.Table option or field.StorageKey),
+	// and the names of the foreign-key columns and the join tables of their edges. For
+	// example, a strategy for the "tblCards" and "colOwnerId" naming convention:
+	//
+	//	type Strategy struct{}
+	//
+	//	func (Strategy) TableName(t *gen.Type) string {
+	//		return "tbl" + t.Name + "s"
+	//	}
+	//
+	//	func (Strategy) ColumnName(f *gen.Field) string {
+	//		return "col" + f.StructField()
+	//	}
+	//
+	//	func (Strategy) EdgeColumn(e *gen.Edge) string {
+	//		return "col" + e.Owner.Name + e.StructField()
+	//	}
+	//
+	//	func (Strategy) JoinTable(e *gen.Edge) string {
+	//		return "tbl" + e.Owner.Name + e.StructField()
+	//	}
+	//
+	//	func (Strategy) JoinColumns(e *gen.Edge) (string, string) {
+	//		return "col" + e.Owner.Name + "Id", "col" + e.Type.Name + "Id"
+	//	}
+	//
+	// The edge methods are called with the assoc edge of the relation (and not its inverse),
+	// and their results are shared by the two edges of the relation.
+	NamingStrategy interface {
+		// TableName returns the SQL table name of the type.
+		TableName(*Type) string
+		// ColumnName returns the SQL column (or Gremlin property) name of the field.
+		ColumnName(*Field) string
+		// EdgeColumn returns the foreign-key column of an O2O, O2M or M2O edge.
+		EdgeColumn(*Edge) string
+		// JoinTable returns the name of the join table of an M2M edge.
+		JoinTable(*Edge) string
+		// JoinColumns returns the columns of the join table of an M2M edge that
+		// reference the edge owner and the edge type (in this order).
+		JoinColumns(*Edge) (string, string)
+	}
+
+	// Generator is the interface that wraps the Generate method.
+	Generator interface {
+		// Generate generates the ent artifacts for the given graph.
+		Generate(*Graph) error
+	}
+
+	// The GenerateFunc type is an adapter to allow the use of ordinary
+	// function as Generator. If f is a function with the appropriate signature,
+	// GenerateFunc(f) is a Generator that calls f.
+	GenerateFunc func(*Graph) error
+
+	// Hook defines the "generate middleware". A function that gets a Generator
+	// and returns a Generator. For example:
+	//
+	//	hook := func(next gen.Generator) gen.Generator {
+	//		return gen.GenerateFunc(func(g *Graph) error {
+	//			fmt.Println("Graph:", g)
+	//			return next.Generate(g)
+	//		})
+	//	}
+	//
+	Hook func(Generator) Generator
+
 	// Graph holds the nodes/entities of the loaded graph schema. Note that, it doesn't
 	// hold the edges of the graph. Instead, each Type holds the edges for other Types.
 	Graph struct {
@@ -72,17 +145,154 @@ func NewGraph(c *Config, schemas ...*load.Schema) (g *Graph, err error) {
 	for _, t := range g.Nodes {
 		check(resolve(t), "resolve %q relations", t.Name)
 	}
+	for _, t := range g.Nodes {
+		check(g.checkView(t), "check views of %q", t.Name)
+	}
+	for _, t := range g.Nodes {
+		for _, e := range t.Edges {
+			ant, err := e.EntSQL()
+			check(err, "invalid annotation for edge %s.%s", t.Name, e.Name)
+			expect(ant == nil || ant.Deferrable == "" || !e.IsInverse(), "deferrable foreign-keys are defined on the assoc edge (edge.To) and not on %s.%s", t.Name, e.Name)
+			if ant != nil && len(ant.SharedColumns) > 0 {
+				check(checkSharedColumns(t, e, ant.SharedColumns), "invalid composite foreign-key for edge %s.%s", t.Name, e.Name)
+			}
+		}
+	}
 	for _, t := range g.Nodes {
 		t.resolveFKs()
 	}
+	for _, t := range g.Nodes {
+		check(g.addPolyEdges(t), "resolve polymorphic edges of %q", t.Name)
+	}
 	for _, schema := range schemas {
 		g.addIndexes(schema)
 	}
 	return
 }
 
+// checkView checks that a view type is supported by the storage driver,
+// and that it does not take part in any relation.
+func (g *Graph) checkView(t *Type) error {
+	for _, e := range t.Edges {
+		if t.IsView() || e.Type.IsView() {
+			return fmt.Errorf("views cannot have edges, but found edge %s.%s", t.Name, e.Name)
+		}
+	}
+	if t.IsView() && (g.Storage == nil || g.Storage.Name != "sql") {
+		return fmt.Errorf("views are supported only by the sql storage")
+	}
+	return nil
+}
+
+// checkSharedColumns checks that the composite foreign-key of the edge is defined on
+// the assoc edge of an O2O, O2M or M2O relation, and that its fields are defined with
+// the same column and type in both types of the edge.
+func checkSharedColumns(t *Type, e *Edge, names []string) error {
+	switch {
+	case e.IsInverse():
+		return fmt.Errorf("composite foreign-keys are defined on the assoc edge (edge.To)")
+	case e.M2M():
+		return fmt.Errorf("composite foreign-keys are not supported by M2M relations")
+	}
+	for _, name := range names {
+		f1, ok1 := t.fields[name]
+		f2, ok2 := e.Type.fields[name]
+		switch {
+		case !ok1 || !ok2:
+			return fmt.Errorf("field %q is not defined in both %s and %s", name, t.Name, e.Type.Name)
+		case f1.StorageKey() != f2.StorageKey() || f1.Type.Type != f2.Type.Type:
+			return fmt.Errorf("field %q is defined with a different column or type in %s and %s", name, t.Name, e.Type.Name)
+		case f1.Optional || f2.Optional || f1.Nillable || f2.Nillable:
+			return fmt.Errorf("field %q of a composite foreign-key cannot be optional or nillable", name)
+		}
+	}
+	return nil
+}
+
+// addPolyEdges adds the polymorphic edges that were defined on the fields of the
+// given type (see field.Polymorphic), and links them to the types they point to.
+func (g *Graph) addPolyEdges(t *Type) error {
+	for _, f := range t.Fields {
+		ant, err := f.Polymorphic()
+		if err != nil {
+			return fmt.Errorf("invalid annotation for field %s.%s: %v", t.Name, f.Name, err)
+		}
+		if ant == nil {
+			continue
+		}
+		tf, ok := t.fields[ant.TypeField]
+		switch {
+		case ant.Edge == "":
+			return fmt.Errorf("missing edge name for polymorphic field %s.%s", t.Name, f.Name)
+		case !ok:
+			return fmt.Errorf("type field %q of polymorphic edge %s.%s was not found", ant.TypeField, t.Name, ant.Edge)
+		case tf.Type.Type != field.TypeString:
+			return fmt.Errorf("type field %q of polymorphic edge %s.%s must be a string field", ant.TypeField, t.Name, ant.Edge)
+		case f.Nillable || tf.Nillable:
+			return fmt.Errorf("fields of polymorphic edge %s.%s cannot be nillable", t.Name, ant.Edge)
+		case f.Optional != tf.Optional:
+			return fmt.Errorf("fields of polymorphic edge %s.%s must be both optional or both required", t.Name, ant.Edge)
+		case len(ant.Types) == 0:
+			return fmt.Errorf("polymorphic edge %s.%s must point to at least one type", t.Name, ant.Edge)
+		}
+		if _, ok := t.fields[ant.Edge]; ok {
+			return fmt.Errorf("polymorphic edge %s.%s collides with a field", t.Name, ant.Edge)
+		}
+		for _, e := range t.Edges {
+			if e.Name == ant.Edge {
+				return fmt.Errorf("polymorphic edge %s.%s collides with another edge", t.Name, ant.Edge)
+			}
+		}
+		for _, e := range t.PolyEdges {
+			if e.Name == ant.Edge {
+				return fmt.Errorf("polymorphic edge %s.%s is defined more than once", t.Name, ant.Edge)
+			}
+		}
+		e := &PolyEdge{Name: ant.Edge, Owner: t, TypeField: tf, IDField: f}
+		for _, name := range ant.Types {
+			var ref *Type
+			for _, n := range g.Nodes {
+				if n.Name == name {
+					ref = n
+				}
+			}
+			switch {
+			case ref == nil:
+				return fmt.Errorf("type %q of polymorphic edge %s.%s was not found", name, t.Name, e.Name)
+			case ref.IsView():
+				return fmt.Errorf("polymorphic edge %s.%s cannot point to view %q", t.Name, e.Name, name)
+			case ref.ID.Type.Type != f.Type.Type:
+				return fmt.Errorf("field %s.%s does not match the id type of %q", t.Name, f.Name, name)
+			}
+			for _, r := range e.Types {
+				if r == ref {
+					return fmt.Errorf("type %q is defined more than once in polymorphic edge %s.%s", name, t.Name, e.Name)
+				}
+			}
+			e.Types = append(e.Types, ref)
+			ref.PolyRefs = append(ref.PolyRefs, e)
+		}
+		t.PolyEdges = append(t.PolyEdges, e)
+	}
+	return nil
+}
+
 // Gen generates the artifacts for the graph.
-func (g *Graph) Gen() (err error) {
+func (g *Graph) Gen() error {
+	var gen Generator = GenerateFunc(generate)
+	for i := len(g.Hooks) - 1; i >= 0; i-- {
+		gen = g.Hooks[i](gen)
+	}
+	return gen.Generate(g)
+}
+
+// Generate calls f(g).
+func (f GenerateFunc) Generate(g *Graph) error {
+	return f(g)
+}
+
+// generate is the default Generator for the code-generation.
+func generate(g *Graph) (err error) {
 	defer catch(&err)
 	var (
 		written             []string
@@ -92,6 +302,13 @@ func (g *Graph) Gen() (err error) {
 		path := filepath.Join(g.Config.Target, n.Package())
 		check(os.MkdirAll(path, os.ModePerm), "create dir %q", path)
 		for _, tmpl := range Templates {
+			if tmpl.Skip != nil && tmpl.Skip(n) {
+				// Remove files that were generated before the type was changed.
+				if err := os.Remove(filepath.Join(g.Config.Target, tmpl.Format(n))); err != nil && !os.IsNotExist(err) {
+					check(err, "remove file %s", tmpl.Format(n))
+				}
+				continue
+			}
 			b := bytes.NewBuffer(nil)
 			check(templates.ExecuteTemplate(b, tmpl.Name, n), "execute template %q", tmpl.Name)
 			target := filepath.Join(g.Config.Target, tmpl.Format(n))
@@ -144,24 +361,26 @@ func (g *Graph) addEdges(schema *load.Schema) {
 		// Assoc only.
 		case !e.Inverse:
 			t.Edges = append(t.Edges, &Edge{
-				Type:      typ,
-				Name:      e.Name,
-				Owner:     t,
-				Unique:    e.Unique,
-				Optional:  !e.Required,
-				StructTag: e.Tag,
+				Type:        typ,
+				Name:        e.Name,
+				Owner:       t,
+				Unique:      e.Unique,
+				Optional:    !e.Required,
+				StructTag:   e.Tag,
+				Annotations: e.Annotations,
 			})
 		// Inverse only.
 		case e.Inverse && e.Ref == nil:
 			expect(e.RefName != "", "missing reference name for inverse edge: %s.%s", t.Name, e.Name)
 			t.Edges = append(t.Edges, &Edge{
-				Type:      typ,
-				Name:      e.Name,
-				Owner:     typ,
-				Inverse:   e.RefName,
-				Unique:    e.Unique,
-				Optional:  !e.Required,
-				StructTag: e.Tag,
+				Type:        typ,
+				Name:        e.Name,
+				Owner:       typ,
+				Inverse:     e.RefName,
+				Unique:      e.Unique,
+				Optional:    !e.Required,
+				StructTag:   e.Tag,
+				Annotations: e.Annotations,
 			})
 		// Inverse and assoc.
 		case e.Inverse:
@@ -169,20 +388,22 @@ func (g *Graph) addEdges(schema *load.Schema) {
 			expect(e.RefName == "", "reference name is derived from the assoc name: %s.%s <-> %s.%s", t.Name, ref.Name, t.Name, e.Name)
 			expect(ref.Type == t.Name, "assoc-inverse edge allowed only as o2o relation of the same type")
 			t.Edges = append(t.Edges, &Edge{
-				Type:      typ,
-				Name:      e.Name,
-				Owner:     t,
-				Inverse:   ref.Name,
-				Unique:    e.Unique,
-				Optional:  !e.Required,
-				StructTag: e.Tag,
+				Type:        typ,
+				Name:        e.Name,
+				Owner:       t,
+				Inverse:     ref.Name,
+				Unique:      e.Unique,
+				Optional:    !e.Required,
+				StructTag:   e.Tag,
+				Annotations: e.Annotations,
 			}, &Edge{
-				Type:      typ,
-				Owner:     t,
-				Name:      ref.Name,
-				Unique:    ref.Unique,
-				Optional:  !ref.Required,
-				StructTag: ref.Tag,
+				Type:        typ,
+				Owner:       t,
+				Name:        ref.Name,
+				Unique:      ref.Unique,
+				Optional:    !ref.Required,
+				StructTag:   ref.Tag,
+				Annotations: ref.Annotations,
 			})
 		default:
 			panic(graphError{"edge must be either an assoc or inverse edge"})
@@ -196,22 +417,21 @@ func (g *Graph) addEdges(schema *load.Schema) {
 // relation definitions between A and B, where A is the owner of
 // the edge and B uses this edge as a back-reference:
 //
-// 	O2O
-// 	 - A have a unique edge (E) to B, and B have a back-reference unique edge (E') for E.
-// 	 - A have a unique edge (E) to A.
-//
-// 	O2M (The "Many" side, keeps a reference to the "One" side).
-// 	 - A have an edge (E) to B (not unique), and B doesn't have a back-reference edge for E.
-// 	 - A have an edge (E) to B (not unique), and B have a back-reference unique edge (E') for E.
+//	O2O
+//	 - A have a unique edge (E) to B, and B have a back-reference unique edge (E') for E.
+//	 - A have a unique edge (E) to A.
 //
-// 	M2O (The "Many" side, holds the reference to the "One" side).
-// 	 - A have a unique edge (E) to B, and B doesn't have a back-reference edge for E.
-// 	 - A have a unique edge (E) to B, and B have a back-reference non-unique edge (E') for E.
+//	O2M (The "Many" side, keeps a reference to the "One" side).
+//	 - A have an edge (E) to B (not unique), and B doesn't have a back-reference edge for E.
+//	 - A have an edge (E) to B (not unique), and B have a back-reference unique edge (E') for E.
 //
-// 	M2M
-// 	 - A have an edge (E) to B (not unique), and B have a back-reference non-unique edge (E') for E.
-// 	 - A have an edge (E) to A (not unique).
+//	M2O (The "Many" side, holds the reference to the "One" side).
+//	 - A have a unique edge (E) to B, and B doesn't have a back-reference edge for E.
+//	 - A have a unique edge (E) to B, and B have a back-reference non-unique edge (E') for E.
 //
+//	M2M
+//	 - A have an edge (E) to B (not unique), and B have a back-reference non-unique edge (E') for E.
+//	 - A have an edge (E) to A (not unique).
 func resolve(t *Type) error {
 	for _, e := range t.Edges {
 		switch {
@@ -260,6 +480,9 @@ func resolve(t *Type) error {
 				e.Rel.Columns = []string{column}
 				ref.Rel.Columns = []string{column}
 			}
+			if relNames(ref) {
+				e.Rel.Table, e.Rel.Columns = ref.Rel.Table, append([]string(nil), ref.Rel.Columns...)
+			}
 		// Assoc with uninitialized relation.
 		case !e.IsInverse() && e.Rel.Type == Unk:
 			switch {
@@ -282,18 +505,42 @@ func resolve(t *Type) error {
 			if !e.M2M() {
 				e.Rel.Columns = []string{fmt.Sprintf("%s_%s", t.Label(), snake(e.Name))}
 			}
+			relNames(e)
 		}
 	}
 	return nil
 }
 
+// relNames applies the naming strategy of the config (if it was set) on the join table and
+// the columns of the relation of the given assoc edge. It reports if the names were changed.
+func relNames(e *Edge) bool {
+	c := e.Owner.Config
+	if c == nil || c.Naming == nil {
+		return false
+	}
+	if e.M2M() {
+		c1, c2 := c.Naming.JoinColumns(e)
+		e.Rel.Table, e.Rel.Columns = c.Naming.JoinTable(e), []string{c1, c2}
+	} else {
+		e.Rel.Columns = []string{c.Naming.EdgeColumn(e)}
+	}
+	return true
+}
+
 // Tables returns the schema definitions of SQL tables for the graph.
 func (g *Graph) Tables() (all []*schema.Table) {
 	tables := make(map[string]*schema.Table)
 	for _, n := range g.Nodes {
+		// Views are managed outside of the migration.
+		if n.IsView() {
+			continue
+		}
 		table := schema.NewTable(n.Table()).AddPrimary(n.ID.PK())
 		for _, f := range n.Fields {
 			table.AddColumn(f.Column())
+			if f.HasBlindIndex() {
+				table.AddColumn(f.BlindIndexColumn())
+			}
 		}
 		tables[table.Name] = table
 		all = append(all, table)
@@ -312,25 +559,31 @@ func (g *Graph) Tables() (all []*schema.Table) {
 				pk := ref.PrimaryKey[0]
 				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, Unique: e.Rel.Type == O2O, Nullable: true}
 				owner.AddColumn(column)
-				owner.AddForeignKey(&schema.ForeignKey{
+				fk := &schema.ForeignKey{
 					RefTable:   ref,
 					OnDelete:   schema.SetNull,
 					Columns:    []*schema.Column{column},
 					RefColumns: []*schema.Column{ref.PrimaryKey[0]},
 					Symbol:     fmt.Sprintf("%s_%s_%s", owner.Name, ref.Name, e.Name),
-				})
+					Deferrable: e.deferrable(),
+				}
+				addSharedColumns(owner, fk, e.SharedFields())
+				owner.AddForeignKey(fk)
 			case M2O:
 				ref, owner := tables[e.Type.Table()], tables[e.Rel.Table]
 				pk := ref.PrimaryKey[0]
 				column := &schema.Column{Name: e.Rel.Column(), Size: pk.Size, Type: pk.Type, Nullable: true}
 				owner.AddColumn(column)
-				owner.AddForeignKey(&schema.ForeignKey{
+				fk := &schema.ForeignKey{
 					RefTable:   ref,
 					OnDelete:   schema.SetNull,
 					Columns:    []*schema.Column{column},
 					RefColumns: []*schema.Column{ref.PrimaryKey[0]},
 					Symbol:     fmt.Sprintf("%s_%s_%s", owner.Name, ref.Name, e.Name),
-				})
+					Deferrable: e.deferrable(),
+				}
+				addSharedColumns(owner, fk, e.SharedFields())
+				owner.AddForeignKey(fk)
 			case M2M:
 				t1, t2 := tables[n.Table()], tables[e.Type.Table()]
 				c1 := &schema.Column{Name: e.Rel.Columns[0], Type: field.TypeInt}
@@ -354,6 +607,7 @@ func (g *Graph) Tables() (all []*schema.Table) {
 							Columns:    []*schema.Column{c1},
 							RefColumns: []*schema.Column{t1.PrimaryKey[0]},
 							Symbol:     fmt.Sprintf("%s_%s", e.Rel.Table, c1.Name),
+							Deferrable: e.deferrable(),
 						},
 						{
 							RefTable:   t2,
@@ -361,6 +615,7 @@ func (g *Graph) Tables() (all []*schema.Table) {
 							Columns:    []*schema.Column{c2},
 							RefColumns: []*schema.Column{t2.PrimaryKey[0]},
 							Symbol:     fmt.Sprintf("%s_%s", e.Rel.Table, c2.Name),
+							Deferrable: e.deferrable(),
 						},
 					},
 				})
@@ -372,11 +627,114 @@ func (g *Graph) Tables() (all []*schema.Table) {
 		table := tables[n.Table()]
 		for _, idx := range n.Indexes {
 			table.AddIndex(idx.Name, idx.Unique, idx.Columns)
+			table.Indexes[len(table.Indexes)-1].Include = idx.Include
+			table.Indexes[len(table.Indexes)-1].Type = idx.Type
+			table.Indexes[len(table.Indexes)-1].OpClass = idx.OpClass
+			table.Indexes[len(table.Indexes)-1].With = idx.With
+		}
+		// Blind indexes of non-unique fields are indexed, unless they
+		// are the first column of an index that was defined in the schema.
+	fields:
+		for _, f := range n.Fields {
+			c := f.BlindIndexColumn()
+			if !f.HasBlindIndex() || c.Unique {
+				continue
+			}
+			for _, idx := range n.Indexes {
+				if idx.Columns[0] == c.Name {
+					continue fields
+				}
+			}
+			table.AddIndex(strings.ToLower(n.Name)+"_"+c.Name, false, []string{c.Name})
 		}
 	}
 	return
 }
 
+// addSharedColumns prefixes the columns of the foreign-key with the shared columns of
+// its edge, and adds a unique index on the referenced columns, as the databases require
+// one for composite foreign-keys.
+func addSharedColumns(owner *schema.Table, fk *schema.ForeignKey, fields []*Field) {
+	if len(fields) == 0 {
+		return
+	}
+	var columns, refs []*schema.Column
+	for _, f := range fields {
+		columns = append(columns, tableColumn(owner, f.StorageKey()))
+		refs = append(refs, tableColumn(fk.RefTable, f.StorageKey()))
+	}
+	fk.Columns = append(columns, fk.Columns...)
+	fk.RefColumns = append(refs, fk.RefColumns...)
+	// Setting the foreign-key to NULL on delete clears the
+	// shared columns as well. Hence, the deletion is restricted.
+	fk.OnDelete = schema.NoAction
+	names := make([]string, len(fk.RefColumns))
+	for i, c := range fk.RefColumns {
+		names[i] = c.Name
+	}
+	name := fmt.Sprintf("%s_%s", fk.RefTable.Name, strings.Join(names, "_"))
+	for _, idx := range fk.RefTable.Indexes {
+		if idx.Name == name {
+			return
+		}
+	}
+	fk.RefTable.AddIndex(name, true, names)
+}
+
+// tableColumn returns the column of the table with the given name.
+func tableColumn(t *schema.Table, name string) *schema.Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// DeleteOrder returns the nodes of the graph in the order they should be deleted. That is, types
+// that hold foreign-keys to other types precede them (children before parents). Self-references
+// and M2M edges (join tables) are ignored, and cycles are broken by the order of the nodes. Views
+// are read-only, and therefore, they are not returned.
+func (g *Graph) DeleteOrder() []*Type {
+	// Referenced types, and the types that reference them.
+	refs := make(map[*Type][]*Type)
+	for _, t := range g.Nodes {
+		for _, e := range t.Edges {
+			if e.IsInverse() || e.M2M() {
+				continue
+			}
+			owner, ref := e.Type, t
+			if e.OwnFK() {
+				owner, ref = t, e.Type
+			}
+			if owner != ref {
+				refs[ref] = append(refs[ref], owner)
+			}
+		}
+	}
+	var (
+		visit   func(*Type)
+		order   = make([]*Type, 0, len(g.Nodes))
+		visited = make(map[*Type]bool, len(g.Nodes))
+	)
+	visit = func(t *Type) {
+		if visited[t] {
+			return
+		}
+		visited[t] = true
+		for _, owner := range refs[t] {
+			visit(owner)
+		}
+		if !t.IsView() {
+			order = append(order, t)
+		}
+	}
+	for _, t := range g.Nodes {
+		visit(t)
+	}
+	return order
+}
+
 // SupportMigrate reports if the codegen supports schema migration.
 func (g *Graph) SupportMigrate() bool {
 	return g.Storage.SchemaMode.Support(Migrate)
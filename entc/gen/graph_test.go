@@ -6,11 +6,19 @@ package gen
 
 import (
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"testing"
 	"text/template"
 
+	"github.com/facebookincubator/ent"
+
+	"github.com/facebookincubator/ent/dialect/entsql"
+	"github.com/facebookincubator/ent/dialect/sql/schema"
 	"github.com/facebookincubator/ent/entc/load"
 	"github.com/facebookincubator/ent/schema/field"
 
@@ -238,6 +246,38 @@ func TestFKColumns(t *testing.T) {
 	require.Equal(Relation{Type: O2M, Table: "users", Columns: []string{"user_pet"}}, t2.Edges[1].Rel)
 }
 
+func TestGraph_DeleteOrder(t *testing.T) {
+	require := require.New(t)
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]},
+		&load.Schema{
+			Name: "Group",
+			Edges: []*load.Edge{
+				{Name: "users", Type: "User"},
+			},
+		},
+		&load.Schema{
+			Name: "User",
+			Edges: []*load.Edge{
+				{Name: "pets", Type: "Pet"},
+				{Name: "parent", Type: "User", Unique: true},
+			},
+		},
+		&load.Schema{
+			Name: "Pet",
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "pets", Inverse: true, Unique: true},
+			},
+		},
+	)
+	require.NoError(err)
+	pos := make(map[string]int)
+	for i, n := range graph.DeleteOrder() {
+		pos[n.Name] = i
+	}
+	require.Len(pos, 3)
+	require.Less(pos["Pet"], pos["User"], "pets hold a foreign-key to users")
+}
+
 func TestGraph_Gen(t *testing.T) {
 	require := require.New(t)
 	target := filepath.Join(os.TempDir(), "ent")
@@ -276,4 +316,351 @@ func TestGraph_Gen(t *testing.T) {
 	}
 	_, err = os.Stat(target + "/external.go")
 	require.NoError(err)
+	checkContextFirst(t, target)
+}
+
+func TestGraph_View(t *testing.T) {
+	require := require.New(t)
+	view := &load.Schema{
+		Name:   "Stat",
+		Config: ent.Config{Table: "user_stats", View: true},
+		Fields: []*load.Field{
+			{Name: "count", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	}
+	_, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[1]}, view)
+	require.Error(err, "views are not supported by gremlin")
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, view, &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "stats", Type: "Stat"},
+		},
+	})
+	require.Error(err, "views cannot have edges")
+
+	target := filepath.Join(os.TempDir(), "ent")
+	require.NoError(os.MkdirAll(target, os.ModePerm), "creating tmpdir")
+	defer os.RemoveAll(target)
+	graph, err := NewGraph(&Config{
+		Package: "entc/gen",
+		Target:  target,
+		Storage: drivers[0],
+		IDType:  &field.TypeInfo{Type: field.TypeInt},
+	}, view, &load.Schema{Name: "User"})
+	require.NoError(err)
+	tables := graph.Tables()
+	require.Len(tables, 1)
+	require.Equal("users", tables[0].Name)
+	order := graph.DeleteOrder()
+	require.Len(order, 1)
+	require.Equal("User", order[0].Name)
+
+	// ensure stale files of mutation builders are removed.
+	require.NoError(ioutil.WriteFile(filepath.Join(target, "stat_create.go"), []byte("package ent"), 0644))
+	require.NoError(graph.Gen())
+	for _, format := range []string{"%s_create", "%s_update", "%s_delete"} {
+		_, err := os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "stat"))
+		require.True(os.IsNotExist(err))
+		_, err = os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "user"))
+		require.NoError(err)
+	}
+	for _, format := range []string{"%s", "%s_query"} {
+		_, err := os.Stat(fmt.Sprintf(fmt.Sprintf("%s/%s.go", target, format), "stat"))
+		require.NoError(err)
+	}
+}
+
+// checkContextFirst checks that all generated functions that accept
+// a context.Context, accept it as their first parameter.
+func checkContextFirst(t *testing.T, dir string) {
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".go" {
+			return err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			var i int
+			for _, p := range fn.Type.Params.List {
+				if sel, ok := p.Type.(*ast.SelectorExpr); ok && sel.Sel.Name == "Context" && fmt.Sprint(sel.X) == "context" && i > 0 {
+					t.Errorf("%s: context.Context must be the first parameter of %s", filepath.Base(path), fn.Name.Name)
+				}
+				if n := len(p.Names); n > 0 {
+					i += n
+				} else {
+					i++
+				}
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestGraph_Hooks(t *testing.T) {
+	require := require.New(t)
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, T1, T2)
+	require.NoError(err)
+	var calls []string
+	hook := func(name string) Hook {
+		return func(next Generator) Generator {
+			return GenerateFunc(func(g *Graph) error {
+				calls = append(calls, name)
+				require.Equal(graph, g)
+				return nil
+			})
+		}
+	}
+	graph.Hooks = []Hook{
+		func(next Generator) Generator {
+			return GenerateFunc(func(g *Graph) error {
+				calls = append(calls, "first")
+				return next.Generate(g)
+			})
+		},
+		hook("second"),
+	}
+	require.NoError(graph.Gen())
+	require.Equal([]string{"first", "second"}, calls, "hooks are executed in order, and can skip the default generator")
+
+	graph.Hooks = []Hook{
+		func(Generator) Generator {
+			return GenerateFunc(func(*Graph) error { return fmt.Errorf("invalid graph") })
+		},
+	}
+	require.EqualError(graph.Gen(), "invalid graph")
+}
+
+func TestGraph_DeferrableFKs(t *testing.T) {
+	require := require.New(t)
+	deferred := map[string]interface{}{"EntSQL": entsql.Deferrable(entsql.InitiallyDeferred)}
+	user := &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "card", Type: "Card", Unique: true},
+			{Name: "friends", Type: "User", Annotations: deferred},
+		},
+	}
+	card := &load.Schema{
+		Name: "Card",
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", Unique: true, Annotations: deferred},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, card)
+	require.NoError(err)
+	tables := graph.Tables()
+	require.Len(tables, 3)
+	require.Empty(tables[0].ForeignKeys[0].Deferrable, "users.user_card is not deferrable")
+	require.Equal(schema.InitiallyDeferred, tables[1].ForeignKeys[0].Deferrable, "cards.card_owner is deferrable")
+	require.Equal(schema.InitiallyDeferred, tables[2].ForeignKeys[0].Deferrable, "user_friends join table FKs are deferrable")
+	require.Equal(schema.InitiallyDeferred, tables[2].ForeignKeys[1].Deferrable)
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "parent", Type: "User", Unique: true, Annotations: map[string]interface{}{"EntSQL": map[string]interface{}{"deferrable": "DEFERRED"}}},
+		},
+	})
+	require.Error(err)
+	require.Contains(err.Error(), `invalid deferrable mode "DEFERRED"`)
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name: "User",
+		Edges: []*load.Edge{
+			{Name: "children", Type: "User"},
+			{Name: "parent", Type: "User", Unique: true, Inverse: true, RefName: "children", Annotations: deferred},
+		},
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "deferrable foreign-keys are defined on the assoc edge")
+}
+
+func TestGraph_CompositeFKs(t *testing.T) {
+	require := require.New(t)
+	composite := map[string]interface{}{"EntSQL": entsql.CompositeForeignKey("tenant_id")}
+	tenant := []*load.Field{{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeInt}}}
+	order := &load.Schema{
+		Name:   "Order",
+		Fields: tenant,
+		Edges: []*load.Edge{
+			{Name: "line_items", Type: "LineItem", Annotations: composite},
+		},
+	}
+	item := &load.Schema{
+		Name:   "LineItem",
+		Fields: tenant,
+		Edges: []*load.Edge{
+			{Name: "order", Type: "Order", Unique: true, Inverse: true, RefName: "line_items"},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, order, item)
+	require.NoError(err)
+	for _, n := range graph.Nodes {
+		fields := n.Edges[0].SharedFields()
+		require.Len(fields, 1)
+		require.Equal("tenant_id", fields[0].Name)
+	}
+	tables := graph.Tables()
+	require.Len(tables, 2)
+	fk := tables[1].ForeignKeys[0]
+	require.Equal([]*schema.Column{tables[1].Columns[1], tables[1].Columns[2]}, fk.Columns)
+	require.Equal([]*schema.Column{tables[0].Columns[1], tables[0].Columns[0]}, fk.RefColumns)
+	require.Equal(schema.NoAction, fk.OnDelete)
+	require.Len(tables[0].Indexes, 1)
+	require.True(tables[0].Indexes[0].Unique)
+	require.Equal("orders_tenant_id_id", tables[0].Indexes[0].Name)
+	require.Equal(fk.RefColumns, tables[0].Indexes[0].Columns)
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, order, &load.Schema{
+		Name: "LineItem",
+		Edges: []*load.Edge{
+			{Name: "order", Type: "Order", Unique: true, Inverse: true, RefName: "line_items"},
+		},
+	})
+	require.Error(err)
+	require.Contains(err.Error(), `field "tenant_id" is not defined in both Order and LineItem`)
+
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "User",
+		Fields: tenant,
+		Edges: []*load.Edge{
+			{Name: "friends", Type: "User", Annotations: composite},
+		},
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "composite foreign-keys are not supported by M2M relations")
+}
+
+func TestGraph_PolyEdges(t *testing.T) {
+	require := require.New(t)
+	comment := func(ant *field.PolymorphicAnnotation) *load.Schema {
+		return &load.Schema{
+			Name: "Comment",
+			Fields: []*load.Field{
+				{Name: "owner_type", Info: &field.TypeInfo{Type: field.TypeString}, Optional: true},
+				{Name: "owner_id", Info: &field.TypeInfo{Type: field.TypeInt}, Optional: true, Annotations: map[string]interface{}{ant.Name(): ant}},
+			},
+		}
+	}
+	post, photo := &load.Schema{Name: "Post"}, &load.Schema{Name: "Photo"}
+	cfg := &Config{Package: "entc/gen", Storage: drivers[0], IDType: &field.TypeInfo{Type: field.TypeInt}}
+	graph, err := NewGraph(cfg, comment(field.Polymorphic("owner", "owner_type", "Post", "Photo")), post, photo)
+	require.NoError(err)
+	require.Len(graph.Nodes[0].PolyEdges, 1)
+	e := graph.Nodes[0].PolyEdges[0]
+	require.Equal("Owner", e.StructField())
+	require.Equal("CommentOwner", e.Interface())
+	require.Equal("owner_type", e.TypeField.Name)
+	require.Equal("owner_id", e.IDField.Name)
+	require.Equal([]*Type{graph.Nodes[1], graph.Nodes[2]}, e.Types)
+	require.Equal([]*PolyEdge{e}, graph.Nodes[1].PolyRefs)
+	require.Equal([]*PolyEdge{e}, graph.Nodes[2].PolyRefs)
+
+	for ant, msg := range map[*field.PolymorphicAnnotation]string{
+		field.Polymorphic("owner", "kind", "Post"):               `type field "kind" of polymorphic edge Comment.owner was not found`,
+		field.Polymorphic("owner", "owner_id", "Post"):           `type field "owner_id" of polymorphic edge Comment.owner must be a string field`,
+		field.Polymorphic("owner", "owner_type"):                 "polymorphic edge Comment.owner must point to at least one type",
+		field.Polymorphic("owner", "owner_type", "Video"):        `type "Video" of polymorphic edge Comment.owner was not found`,
+		field.Polymorphic("owner", "owner_type", "Post", "Post"): `type "Post" is defined more than once in polymorphic edge Comment.owner`,
+		field.Polymorphic("owner_type", "owner_type", "Post"):    "polymorphic edge Comment.owner_type collides with a field",
+	} {
+		_, err := NewGraph(cfg, comment(ant), post, photo)
+		require.Error(err)
+		require.Contains(err.Error(), msg)
+	}
+}
+
+type naming struct{}
+
+func (naming) TableName(t *Type) string   { return "tbl" + t.Name + "s" }
+func (naming) ColumnName(f *Field) string { return "col" + f.StructField() }
+func (naming) EdgeColumn(e *Edge) string  { return "col" + e.Owner.Name + e.StructField() }
+func (naming) JoinTable(e *Edge) string   { return "tbl" + e.Owner.Name + e.StructField() }
+func (naming) JoinColumns(e *Edge) (string, string) {
+	return "col" + e.Owner.Name + "ID", "col" + e.StructField() + "ID"
+}
+
+func TestGraph_NamingStrategy(t *testing.T) {
+	require := require.New(t)
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, StorageKey: "nick"},
+		},
+		Edges: []*load.Edge{
+			{Name: "cards", Type: "Card"},
+			{Name: "friends", Type: "User"},
+		},
+	}
+	card := &load.Schema{
+		Name:   "Card",
+		Config: ent.Config{Table: "cards"},
+		Edges: []*load.Edge{
+			{Name: "owner", Type: "User", RefName: "cards", Unique: true, Inverse: true},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Naming: naming{}}, user, card)
+	require.NoError(err)
+	require.Equal("tblUsers", graph.Nodes[0].Table())
+	require.Equal("colID", graph.Nodes[0].ID.StorageKey())
+	require.Equal("colName", graph.Nodes[0].Fields[0].StorageKey())
+	require.Equal("nick", graph.Nodes[0].Fields[1].StorageKey(), "storage-key overrides the strategy")
+	require.Equal("cards", graph.Nodes[1].Table(), "table config overrides the strategy")
+
+	cards, owner := graph.Nodes[0].Edges[0], graph.Nodes[1].Edges[0]
+	require.Equal([]string{"colUserCards"}, cards.Rel.Columns)
+	require.Equal(cards.Rel.Columns, owner.Rel.Columns, "inverse edges share the names of their assoc edge")
+	friends := graph.Nodes[0].Edges[1]
+	require.Equal("tblUserFriends", friends.Rel.Table)
+	require.Equal([]string{"colUserID", "colFriendsID"}, friends.Rel.Columns)
+
+	tables := graph.Tables()
+	require.Len(tables, 3)
+	require.Equal("tblUsers", tables[0].Name)
+	require.Equal("colID", tables[0].PrimaryKey[0].Name)
+	require.Equal("cards", tables[1].Name)
+	require.Equal("colUserCards", tables[1].ForeignKeys[0].Columns[0].Name)
+	require.Equal("tblUsers", tables[1].ForeignKeys[0].RefTable.Name)
+	require.Equal("colID", tables[1].ForeignKeys[0].RefColumns[0].Name)
+	require.Equal("tblUserFriends", tables[2].Name)
+	require.Equal("colUserID", tables[2].PrimaryKey[0].Name)
+	require.Equal("colFriendsID", tables[2].PrimaryKey[1].Name)
+	require.Equal("tblUsers", tables[2].ForeignKeys[0].RefTable.Name)
+
+	// The strategy is called with the types and fields of the graph, and not with their copies.
+	rec := &recordNaming{}
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0], Naming: rec}, user, card)
+	require.NoError(err)
+	rec.types, rec.fields = nil, nil
+	graph.Nodes[0].Table()
+	graph.Nodes[0].Fields[0].StorageKey()
+	require.Len(rec.types, 1)
+	require.Same(graph.Nodes[0], rec.types[0])
+	require.Len(rec.fields, 1)
+	require.Same(graph.Nodes[0].Fields[0], rec.fields[0])
+}
+
+// recordNaming records the types and fields that were passed to the strategy.
+type recordNaming struct {
+	naming
+	types  []*Type
+	fields []*Field
+}
+
+func (r *recordNaming) TableName(t *Type) string {
+	r.types = append(r.types, t)
+	return r.naming.TableName(t)
+}
+
+func (r *recordNaming) ColumnName(f *Field) string {
+	r.fields = append(r.fields, f)
+	return r.naming.ColumnName(f)
 }
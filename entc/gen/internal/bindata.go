@@ -24,6 +24,7 @@
 // template/dialect/gremlin/select.tmpl
 // template/dialect/gremlin/update.tmpl
 // template/dialect/sql/by.tmpl
+// template/dialect/sql/config.tmpl
 // template/dialect/sql/create.tmpl
 // template/dialect/sql/decode.tmpl
 // template/dialect/sql/delete.tmpl
@@ -32,9 +33,11 @@
 // template/dialect/sql/group.tmpl
 // template/dialect/sql/meta.tmpl
 // template/dialect/sql/open.tmpl
+// template/dialect/sql/paginate.tmpl
 // template/dialect/sql/predicate.tmpl
 // template/dialect/sql/query.tmpl
 // template/dialect/sql/select.tmpl
+// template/dialect/sql/sync.tmpl
 // template/dialect/sql/tx.tmpl
 // template/dialect/sql/update.tmpl
 // template/ent.tmpl
@@ -126,7 +129,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateBaseTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5f\x6f\xd4\x38\x10\x7f\xde\x7c\x8a\x51\xb4\xc7\x6d\xca\x36\x81\xbe\x1d\x52\x1f\x4a\x45\xef\x90\xa0\x70\x2a\x77\x3c\x9e\xdc\x64\x92\x58\x75\xec\xd4\x76\xda\xae\xa2\xfd\xee\xa7\xb1\xf3\x77\x59\xba\x85\xc2\x03\xca\xce\xd8\xf3\xef\xf7\x9b\xc9\xa4\x6d\x9b\x1c\x05\xe7\xaa\xde\x68\x5e\x94\x16\x4e\x5e\xbd\xfe\xe3\xb8\xd6\x68\x50\x5a\xb8\x60\x29\x5e\x2b\x75\x03\xef\x65\x1a\xc3\x99\x10\xe0\x0e\x19\x20\xbd\xbe\xc3\x2c\x0e\xbe\x94\xdc\x80\x51\x8d\x4e\x11\x52\x95\x21\x70\x03\x82\xa7\x28\x0d\x66\xd0\xc8\x0c\x35\xd8\x12\xe1\xac\x66\x69\x89\x70\x12\xbf\xea\xb5\x90\xab\x46\x66\x01\x97\x4e\xff\xe1\xfd\xf9\xbb\xcb\xab\x77\x90\x73\x81\xd0\xc9\xb4\x52\x16\x32\xae\x31\xb5\x4a\x6f\x40\xe5\x60\x27\xce\xac\x46\x8c\x83\xa3\x64\xbb\x0d\x82\xb6\x85\x0c\x73\x2e\x11\xc2\x6b\x66\x30\x84\x4e\xb8\xac\x6f\x0a\x78\x73\x0a\x24\x84\x65\x7c\xae\x64\xce\x8b\xf8\x33\x4b\x6f\x58\x81\x74\xa8\x6d\xc1\x62\x55\x0b\x66\x11\xc2\x12\x59\x86\x3a\x84\x65\x7f\x7d\x54\xf1\xaa\x56\xda\xf6\xaa\x24\x01\xaa\x0e\x13\x9c\x19\x34\x60\x15\xb0\x3b\xc5\x33\xf0\xa7\x20\x55\x32\x17\x3c\xb5\x94\x47\x63\x50\xff\x6e\x5c\x65\xe2\xc0\x6e\x6a\x84\x55\xb0\xf8\x54\x43\xff\xef\x94\x2c\xc5\x9f\xea\x60\xf1\x17\xd5\x79\x2a\x24\x41\xb0\xf8\x97\x89\x06\xa7\x62\x27\x08\x16\x7f\x37\xa8\x37\x53\xb9\x13\x04\x8b\xcf\x4a\xf0\x74\x33\x91\x7b\x41\xb0\xf8\xd8\x58\x66\x95\x1e\x15\x9d\xa0\xd3\x70\x25\xe7\x1a\xae\x64\xa7\xc2\x8b\x46\xa6\x53\x95\x13\x04\x91\x2b\xc4\x27\x9d\xa1\x76\x07\x58\x5d\x0b\x8e\x06\x98\x04\x45\x42\x2e\x0b\x50\x12\x90\xdb\x12\x35\x14\x9a\xd5\x25\x58\xcd\xee\x50\x1b\x26\x40\x69\x30\xb7\x02\x0c\x0a\x07\x6f\x57\x9c\xd1\x5a\xde\xc8\x74\x45\x10\xc6\x57\x56\x69\x56\x60\xfc\xb6\xe1\x82\xe8\xb4\xdd\x46\x0e\x1d\xcd\x64\x81\xb0\xcc\xd7\xb0\x74\xfe\x08\x68\xff\xb0\xdd\x06\x0b\xba\x9a\xc3\x29\xd4\xcc\xa4\x4c\xd0\x33\x49\x93\x04\xbc\x62\xbb\x1d\xe2\x25\xaa\x15\xfc\x0e\x25\xe4\x1c\x45\x66\x08\xb6\xb6\x85\xa6\xae\x51\x77\x47\x9d\xd9\x38\x58\x50\x50\x83\x81\x55\x77\x3c\x8e\x63\x63\x29\xdb\x68\x12\x7e\x1b\x2c\x16\x6d\x7b\x0c\xf7\xdc\x96\x80\x0f\x16\x65\x06\x2b\x2e\x33\x7c\x80\x65\x7c\xa9\x32\x34\xf0\x2a\x82\x90\xce\x86\x64\x2e\x74\x57\xc3\x3e\x95\x63\x0a\x76\xe1\x92\xb0\x55\x2d\x28\xb5\x5a\x73\x69\x73\x08\x33\xce\xa8\x64\xc9\x6f\x26\x51\xdd\x9d\xbe\x44\xe0\x6f\x69\xb4\x8d\x76\x39\x3c\x0c\x0c\xf6\x66\x62\x7f\xa2\x6d\x81\xe2\x71\x4e\x5c\x0f\xd0\xaf\xbe\x65\x1e\xf1\x57\x68\xd5\xd4\x89\xe1\x85\x64\xb6\xd1\xb8\xe3\x39\x49\xe0\xac\x28\x34\x16\x3d\x63\x26\x84\x60\x9d\x82\x58\x66\x2c\xd6\x44\x0c\x57\x77\xb2\x78\x7c\xbd\x19\x89\x91\x8c\x8c\xf8\x5e\x02\x8e\x77\x67\x86\x26\x0d\x83\xda\x60\x93\xa9\x99\x03\x42\xc9\x3f\x28\x0d\x1a\x25\xab\x88\x8a\x4c\x2a\x47\x44\xff\x7f\x7f\xc6\x78\x84\xd2\xc6\x58\x55\x81\x64\x15\x9a\x18\x2e\x94\x06\x7c\x60\x55\x2d\xf0\x4d\x90\x24\x41\x92\x2c\xfe\xa4\x40\xdf\x6e\x3c\xe6\xaf\xd7\x9e\x2a\x27\x51\x4c\xba\x21\xeb\x55\x3f\x72\xb6\xdb\xf8\xcc\x4c\x7f\x5d\x35\x55\x77\x35\x5a\x43\x68\x9a\xea\x3f\xff\x2b\x8c\xd6\xf0\x84\x5b\x27\xb3\x5b\x27\x61\xe4\x1d\x5f\xa5\x4c\xae\x52\xfb\xb0\x86\x17\x77\x11\x05\xea\xf8\x79\x66\x56\xb9\x9c\x43\xb1\x76\x08\xf7\x2c\x9d\xa3\xd4\x06\x8e\xa8\xbe\xbe\x8f\xc0\xce\xcc\x2e\xd3\x0e\xf0\x6c\x3b\xed\x52\xaa\xec\x1a\x96\x54\xec\x0b\xca\x81\x18\xd6\x63\x86\x63\xc3\x4a\xc7\xbc\xae\x65\xe9\xce\xa0\x3a\x48\xcb\x54\x49\x63\x77\x43\x6c\x5b\xe0\x39\x94\xcc\x7c\x99\x07\xd8\xb7\xc1\x81\xf6\xbc\x64\x15\xb1\xdc\x05\x32\xf4\xaa\x9c\x74\xe7\xe3\x0d\xd6\x45\xd0\x77\xd7\x30\x7d\xe4\xee\xf8\x69\x5b\xb8\x6d\x94\xc5\x21\xe7\xfd\x7c\x56\xae\xd8\x3c\x9f\xd6\x71\xbb\xdd\x99\x5f\xf4\x9e\x1c\x9c\x22\x4b\x4b\xdf\x64\xb3\xe9\x45\x01\xac\xf6\x98\xf2\x06\x3c\x4f\x06\x1b\x7b\x08\xf3\x23\xa3\x4d\x42\xf8\xb5\x77\x11\x4e\xdd\x3d\x6d\xc6\x79\x70\x73\x6f\xec\x97\x0d\xba\x24\x81\x4b\x65\x2f\x68\x03\x79\xa7\xb5\x1b\x13\x64\xca\xc0\x7d\x89\x12\xac\xde\xd0\xc4\xb0\x0a\x72\xb4\x69\x09\x0c\x4c\x8d\x29\xcf\x79\x4a\xef\x40\x6e\x37\xc0\x64\x06\xdc\xc2\x3d\x33\x20\x95\xf5\xab\x4c\xbf\xb6\x64\xcc\x32\x5a\x38\xba\x57\xda\xdc\x8f\xb1\xba\x49\x2d\xd5\x50\xb0\x6b\x14\x5d\xad\x03\x1f\x92\x3f\xc2\x69\xee\x54\x28\xad\xe7\x06\x7a\xa1\xb4\xa8\x73\x96\x62\xec\x9b\x7c\x85\x70\x34\xb3\x1c\xf9\xdb\xab\xa8\x33\x49\x2e\xba\xfa\x84\xe3\x48\x79\x03\x21\xbc\x04\x8c\xbd\xf3\x97\x10\x8e\xe1\x87\x5d\x10\xef\x4d\x6f\x77\x28\x0a\x83\x6b\xa5\x04\x32\x09\x5c\x66\x3c\x65\x96\xec\xdf\x97\xe8\x26\xe9\x24\x46\x3a\x39\x96\xc3\x09\xbb\x70\x47\xa3\x2b\xd4\xda\xab\x22\x67\x95\xe2\xe4\x39\x49\xe0\xf4\x14\x24\x77\x82\x3e\xf2\x9c\x09\x83\x04\xdd\xe2\x8e\x69\xd8\x4d\x79\x48\xd0\x99\x33\x34\x3c\x51\xeb\x35\xbc\xc0\xa8\xcb\xe5\x23\x33\x37\x43\x36\x15\x33\x37\x04\x97\xde\x13\xdf\xf4\xe0\x34\x42\x9f\x98\x0f\x71\x9e\x43\x34\x8d\x53\x72\xe1\xa2\x1c\xe3\x09\x06\x92\x5d\x71\x59\x34\x82\xe9\xa7\xf1\xac\x3b\x3c\xe5\x59\xa5\x34\x52\x95\xa9\xff\xd1\x51\xee\x00\xdd\xe6\x1e\x7f\x31\xe3\x66\xc6\x9f\x43\xba\x3e\xd5\x19\xef\x7a\xeb\x3f\x4d\xbd\xb1\x80\xbb\xec\xeb\x4d\x3f\x9b\x80\xb3\x0a\x1c\xe6\xe0\xa5\xb2\x1f\x14\xcb\xf0\xf1\x41\x53\xa0\x75\x19\x64\x04\x35\x1b\x27\x8b\x70\x57\x81\x36\xa4\x12\xe1\x96\x76\xfc\x11\xe8\xa9\xdd\x11\x66\xcc\x0a\x7c\x2e\xca\x13\xcb\x3f\x86\xb1\x73\x4e\x10\xbb\x87\x79\x16\x33\xa4\xbd\x87\x9f\xc6\xb9\xab\xcb\x37\x28\x7b\xb3\xcf\xc6\x78\x92\xff\x61\x84\xcf\x69\xf3\xd0\x8c\x4b\xfb\x28\xc4\xa9\x46\x66\x31\x69\xea\x8c\xde\x53\xd4\xcb\x4a\xfb\xe6\x76\xcd\xee\x37\xe5\x8c\x0c\x4e\x75\xee\xab\x17\xb9\x86\x74\xf0\x62\x20\x67\x5c\x60\x36\x5b\x54\xd7\x70\xc7\x95\xf0\x0b\x83\xca\x7d\xf9\x95\x26\x6b\xfe\xa5\xde\x48\x7e\xdb\xa0\x44\x63\x3a\xfe\xec\x46\x3d\x12\xa8\x32\x45\xcf\x9f\xc5\xbd\x66\xb5\xcf\xfc\xa7\xb8\xb4\xe3\xe4\xa9\x5c\x1a\x73\xed\x52\xed\xe9\x55\x99\x9e\xd3\xff\x48\x17\xda\xbe\x40\x4c\xfc\x55\x33\xf7\xf5\xb6\x8f\xdd\xdf\x84\xe4\x2d\xad\x26\xc3\xbe\x07\x3c\x26\xc5\x40\xda\xef\xc1\xfc\x23\xd4\xdd\x49\xac\xd1\x38\x90\x77\xc7\xfc\xf3\x28\xbc\x63\xec\x00\x87\xdb\x36\x39\x02\x7c\xa8\x59\xff\x56\x04\x62\x88\xa3\x23\x14\x42\x5d\x33\x01\x25\x8a\x1a\xb5\x89\xc1\xfd\xc1\x65\xd8\xd9\xf6\xae\x6c\xde\xc9\xce\xba\xf6\xd8\x26\xbe\x67\x81\x5b\x76\x77\xbe\xf9\x32\xdd\xbf\x24\xba\x20\x7f\xbd\xcb\xee\xf1\xff\x00\x00\x00\xff\xff\x48\xe9\xca\x16\x23\x13\x00\x00")
+var _templateBaseTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5b\xeb\x73\xdb\x38\x92\xff\x2c\xfd\x15\xbd\xaa\xcc\x0e\x99\xd0\x54\x92\x49\xa5\x76\x9d\xf3\x55\x79\x3c\xf1\x9c\x6f\x27\x4e\x6e\x1c\xef\x7c\xc8\xa5\x52\x10\xd9\x94\xb0\x26\x01\x06\x00\xe9\xe8\x34\xfa\xdf\xaf\x1a\x0f\x3e\x64\xf9\x91\xc9\xdc\xe5\x43\x2c\xe1\xd1\xcf\x5f\x37\x80\x06\xb4\xd9\xcc\x1f\x4f\x4f\x64\xbd\x56\x7c\xb9\x32\xf0\xfc\xe9\xb3\xbf\x1f\xd4\x0a\x35\x0a\x03\xa7\x2c\xc3\x85\x94\x57\x70\x26\xb2\x14\x8e\xcb\x12\xec\x20\x0d\xd4\xaf\x5a\xcc\xd3\xe9\xfb\x15\xd7\xa0\x65\xa3\x32\x84\x4c\xe6\x08\x5c\x43\xc9\x33\x14\x1a\x73\x68\x44\x8e\x0a\xcc\x0a\xe1\xb8\x66\xd9\x0a\xe1\x79\xfa\x34\xf4\x42\x21\x1b\x91\x4f\xb9\xb0\xfd\xbf\x9c\x9d\xbc\x3e\xbf\x78\x0d\x05\x2f\x11\x7c\x9b\x92\xd2\x40\xce\x15\x66\x46\xaa\x35\xc8\x02\xcc\x80\x99\x51\x88\xe9\xf4\xf1\x7c\xbb\x9d\x4e\x37\x1b\xc8\xb1\xe0\x02\x61\xb6\x60\x1a\x67\xe0\x1b\x1f\xd5\x57\x4b\x38\x3c\x02\x6a\x84\x47\xe9\x89\x14\x05\x5f\xa6\xef\x58\x76\xc5\x96\x48\x83\x36\x1b\x30\x58\xd5\x25\x33\x08\xb3\x15\xb2\x1c\xd5\x0c\x1e\x85\xe9\x7d\x17\xaf\x6a\xa9\x4c\xe8\x9a\xcf\x81\xac\xc3\x4a\xce\x34\x6a\x30\x12\x58\x2b\x79\x0e\x6e\x14\x64\x52\x14\x25\xcf\x0c\xe9\xd1\x68\x54\xdf\x6b\x6b\x99\x74\x6a\xd6\x35\x42\x34\x9d\xbc\xad\x21\xfc\x3b\x22\x4a\xe9\xdb\x7a\x3a\xf9\x0f\xb2\xf3\xb0\x91\x1a\xa6\x93\x7f\xb2\xb2\xc1\x61\xb3\x6d\x98\x4e\xfe\xab\x41\xb5\x1e\xb6\xdb\x86\xe9\xe4\x9d\x2c\x79\xb6\x1e\xb4\xbb\x86\xe9\xe4\x4d\x63\x98\x91\xaa\xef\xf0\x0d\xbe\x87\x4b\x31\xee\xe1\x52\xf8\x2e\x3c\x6d\x44\x36\xec\xb2\x0d\xd3\xd8\x1a\xe2\xad\xca\x51\xd1\x77\x60\x75\x5d\x72\xd4\xc0\x04\x48\x6a\xe4\x62\x09\x52\x00\x72\xb3\x42\x05\x4b\xc5\xea\x15\x18\xc5\x5a\x54\x9a\x95\x20\x15\xe8\xcf\x25\x68\x2c\xad\x7b\xbd\x71\x7a\x6a\x45\x23\xb2\x88\x5c\x98\x5e\x18\xa9\xd8\x12\xd3\x1f\x1b\x5e\x12\x9c\xb6\xdb\xd8\x3a\x57\x31\xb1\x44\x78\x54\x24\xf0\xc8\xf2\x23\x47\xbb\x0f\xdb\xed\x74\x42\x53\x0b\x38\x82\x9a\xe9\x8c\x95\xf4\x99\x5a\xe7\x73\x70\x1d\xdb\x6d\x27\x2f\xc1\x6f\xc9\x5b\x14\x50\x70\x2c\x73\x4d\x6e\xdb\x6c\xa0\xa9\x6b\x54\x7e\xa8\x25\x9b\x4e\x27\x24\x54\x47\x20\xf2\xc3\xd3\x34\xd5\x46\x71\xb1\x8c\x07\xe2\x6f\xa6\x93\xc9\x66\x73\x00\xd7\xdc\xac\x00\xbf\x18\x14\x39\x44\x5c\xe4\xf8\x05\x1e\xa5\xe7\x32\x47\x0d\x4f\x63\x98\x91\xe1\x66\xc4\x64\x66\xa7\xce\x82\x2a\x07\x24\x2c\x51\x80\x47\xa6\xaa\x4b\x52\xad\x56\x5c\x98\x02\x66\x39\x67\x64\xb2\xf9\x77\x7a\x2e\xfd\x9c\x60\x22\xc2\xed\x64\x32\x51\x68\x1a\x65\x75\xf8\xd2\x21\xd8\x91\x49\xdd\x88\xcd\x06\x48\x1e\xcb\xc4\xc6\x00\x7d\x0b\x21\x73\x1f\xbf\x79\xce\xb5\x61\x22\xc3\x1d\xc6\x9b\x0d\xf0\x02\x56\x4c\xbf\x1f\xf3\xf4\xce\xd8\x15\xe5\x11\x6c\xf7\xb3\xde\xcb\x79\xa9\x64\x53\xcf\x35\x5f\x0a\x66\x1a\xb5\xcb\x7a\x3e\x87\xe3\xe5\x52\xe1\x32\x60\x75\x00\x45\xe6\x3b\x08\xdf\xda\x60\x4d\x90\xb4\x1e\x27\x8a\x07\x8b\x75\x0f\xc9\x79\x8f\xc5\xdb\x4c\x67\x11\x7f\xac\x29\xc7\x31\xa8\x35\x36\xb9\x1c\x31\x20\x7c\xb8\x0f\x52\x81\x42\xc1\x2a\x0a\x02\x26\xa4\x0d\x01\xf7\x7f\x18\xa3\x1d\x36\xb2\x46\x1b\x59\x81\x60\x15\xea\x14\x4e\xa5\x02\xfc\xc2\xaa\xba\xc4\xc3\xe9\x7c\x3e\x9d\xcf\x27\x3f\x93\xa0\x3f\xae\x1d\xda\x9e\x25\x0e\xa4\xcf\xe3\x94\xfa\x3a\xad\xa3\x90\xec\xb6\xdb\xf4\x58\x0f\xbf\x5d\x34\x95\x9f\x1a\x27\x30\xd3\x4d\xf5\xc9\x7d\x9b\xc5\x09\x3c\x60\xd6\xf3\xd1\xac\xe7\xb3\xd8\x31\xbe\xc8\x98\x88\x32\xf3\x25\x81\xbf\xb6\x31\x09\x4a\x5a\xc1\xb1\x8e\x0a\x31\x76\x45\x62\x91\x16\xe2\x63\xd4\x05\x1b\x02\xc6\xc1\xfd\x6e\x67\x7a\xc7\xdf\xf7\x21\x7c\x3b\xcc\x0f\x64\xd9\x04\x1e\x91\xb1\x4f\x49\x73\xc2\x76\xf0\x19\xf6\xa9\x42\xc0\x61\x9f\x2c\x68\x4e\xd7\x75\x47\x40\x38\xf9\x32\x29\xb4\xd9\x15\xf1\xae\x70\x20\xb2\xf7\x24\x86\x73\x56\x11\xca\xad\x20\x5d\x96\x10\x83\xbc\x70\x77\x68\x7b\x09\x42\x70\x75\x79\x4f\xec\x26\xbe\xcd\x06\x3e\x37\xd2\x78\x3b\xd9\xde\x7d\x78\x96\xd6\xd8\xbc\x18\xda\x71\xbb\xdd\xc9\x9c\xb4\x42\x77\x4c\x91\x65\x2b\xb0\xf6\x19\xe5\x4d\x12\x20\xda\x43\xca\x11\x70\x38\xe9\x68\xec\x01\xcc\xd7\x24\x55\x01\xb3\xdf\x02\x8b\xd9\x90\xdd\xc3\xb2\xab\x15\x7e\x4e\xc0\xfe\x33\x53\xec\x7c\x0e\x39\x2e\x9a\xa5\x95\x84\x36\x52\x44\xc8\xf9\x22\x5b\xd1\x8a\xa6\xc9\x8c\xf4\xb5\x0a\xcb\x72\x21\xdd\x1e\xea\xa7\xc1\xbc\x0a\xcd\x4a\xe6\x61\xe8\xc2\x2d\x8d\x3a\x85\xf7\x2b\x84\x96\x95\x0d\x6a\x4a\x55\xbe\xdb\x2f\x54\x4c\xe4\xf6\x2b\xcf\x3b\x1e\x2c\xcf\x31\x07\xcc\x89\x2d\x53\x08\x57\xb8\xc6\x1c\x28\x2b\xae\x90\x2b\x97\x95\x92\x6e\xa2\x4b\x60\x41\xcc\x6e\x3c\x71\x72\x53\xec\x84\x40\x5b\xa3\x31\x76\xf7\xc7\x0c\x54\x2c\x47\x1a\x50\xed\x4d\x71\x33\x9a\x36\x3b\x84\x19\xfb\x5b\x35\x4b\x60\xc6\xf2\xfc\x13\x5b\xe2\xec\x10\x9e\x25\x30\xcb\x4a\x64\xea\x93\xe0\xd9\x95\x1f\x66\x54\x83\x09\xcc\x6a\x34\x7a\x76\x08\x1f\x3e\xda\x1d\xd1\xe6\xd9\x36\x81\x99\xc2\x4a\xb6\xf8\xa9\x50\x1c\x45\x3e\xec\x7d\xbe\xed\xb2\xd4\xc0\xfc\x51\x05\x61\xaf\x13\x43\xc5\xea\x0f\x0e\x80\x8e\x22\xe5\x27\x6f\xb9\xc3\x23\xa8\xd8\x15\x46\xbb\x43\xe2\xe9\x84\x9c\xf3\x29\x71\x8a\x1f\x1e\xf9\xa4\x53\xa5\x9e\x7e\x4c\x44\x26\xbc\x80\x36\x01\x79\x45\x59\xc4\x77\x45\x34\x21\x7e\x45\x8d\x34\xc2\x33\xfa\x40\xad\x1f\xe1\x08\x14\xe6\x2c\x33\x51\xe5\x08\x27\xd0\xc6\xd3\xc9\xc4\x42\xe9\x36\x86\xc7\xe4\xc9\x3b\xb8\xf6\xfd\xb7\xb0\xb6\x56\x9f\x3d\x09\x12\xb4\xf7\x31\x3c\x21\xb7\xec\xb0\x0c\xa4\x9c\xcb\x7a\x62\xe4\xb1\x7b\x85\x7f\x4d\x28\x1c\x13\x0a\xf3\xfd\x88\xb3\x9f\xb4\x13\xfe\x2e\x5a\xbf\x5a\x08\xec\xa3\x16\xd0\xd1\xcb\xd5\x8d\x7e\x10\x65\xaf\xf1\x3e\xca\xb7\x2a\xec\x13\x85\x1b\x37\x75\xf1\x1f\x02\xfb\xc2\x82\x6d\x94\x02\x0c\x7e\x31\x0d\x2b\x41\xa1\x3f\x82\xb9\x0c\xb0\x93\x11\x74\x48\x09\x5c\x81\x27\xe2\xf2\x81\x4b\x00\xc4\x63\x18\xa4\x85\x54\x15\x33\x06\xf3\x70\xb0\xb2\xfb\x46\x4f\x94\x2b\x0a\x7a\x0d\x91\x46\x1b\xa1\xa3\xec\xd4\xe5\xff\x90\x82\xae\x70\xed\xc9\xc5\xa9\x8b\xa6\xb1\x32\xa3\x80\x72\x91\x32\x0e\xa3\x51\xf0\xc5\xd3\x89\xe5\x1d\xc2\xeb\xc3\x47\x37\x25\x81\xa7\x09\x94\x28\xfc\x0e\x3b\xf6\x61\x76\xd5\x3b\xc3\x13\x24\x17\x58\x0a\x47\xb4\x9b\x47\x91\x47\xf4\x2d\x81\x2b\xe7\x47\x2d\x95\x49\x9d\x60\xda\xf6\xc4\xd3\x49\xcb\x14\x2c\xfc\x42\xa3\xc3\x99\x62\x3a\x59\xa4\xbf\x29\x6e\x30\x68\x91\xbe\x5f\xd7\x18\xc5\xf0\x04\x66\x41\x9f\x48\xd6\x47\x33\x78\x02\x55\xfa\xb6\x8e\x62\x4f\x36\x0a\xb2\x7d\x4a\x86\xe2\x11\x2f\xd2\x7b\x87\x6c\x51\x99\xf4\xc2\x6d\x23\xa2\x59\x02\xdf\xe9\xa3\xef\xda\x59\x02\x57\x7e\x53\xa7\x3f\x5c\x7d\x24\x7a\xdb\x4e\x9c\x1f\xd7\x06\xa3\xef\xe3\xef\xe3\x0e\x48\x8b\x8e\xb1\x07\x93\xcb\x14\x23\x10\x55\x4c\x5f\x05\xc8\xb8\xe5\xd9\xae\x08\x09\x6d\x48\xb8\x71\x9b\x57\xdb\x42\x83\x18\x68\x14\x9a\x1b\xde\x7a\xab\xee\x82\xcd\xfb\x39\x64\xa4\xce\xbf\x3e\x3e\x82\xc7\x5a\xb0\x49\x33\x86\x2e\x77\xf2\x02\x54\x9f\x7f\x22\x2e\x0c\xaa\x82\x65\xb8\xf1\xd9\xcd\x62\x2c\x0a\xf3\x47\xb3\xb7\x7d\x7a\xf2\x7a\xab\x74\x38\xa9\x4f\x8a\x7d\x88\xb5\x23\x83\xbc\x56\x4a\x2a\x6b\x09\xb7\xb6\xca\x2c\x6b\x94\x42\x91\xa1\xde\x63\x9a\xfd\x86\xf0\xd1\x52\xa1\xd6\xb4\xf2\xfb\x69\x48\x94\x47\x46\xb1\xbc\x22\x54\xca\xf5\x25\x94\xec\x89\xb3\x47\x59\xec\x9a\xbd\x49\x5a\x38\x3a\x82\xd9\x0c\x7e\xff\x1d\xfe\x12\x50\x78\x22\x85\x61\x5c\x68\xa2\x91\x3a\x6a\x31\xa9\x37\x34\x00\x2a\x35\xd4\xd6\x92\xd4\xe9\x39\x5e\x7b\x0b\xea\xf4\x57\xac\x4b\x96\xe1\x71\x59\xee\xd0\x71\xd2\xc4\xb1\x37\xd0\xb9\x34\xa7\x54\x7a\xb1\x8c\x3a\xe0\x5c\xaf\x50\x80\x51\x6b\xca\x48\x46\x42\x81\x26\x5b\x01\x03\x5d\x63\xc6\x0b\x9e\xd1\xe1\x9f\x9b\xb5\xdd\x0a\x70\x03\xd7\x4c\x83\x90\xc6\xd5\x70\x82\xa1\x72\x66\x18\x55\x5a\xfc\x59\x7e\xcc\x47\x1b\xd5\x64\x86\x34\x2a\xd9\x02\x4b\x6f\x1b\x2f\x92\x1b\xc2\xe9\xd8\x53\xa1\x30\xba\x37\x34\x74\xb0\xf1\x26\x8f\x10\x1e\x8f\x28\xc7\xe0\x35\xf5\x24\x61\xd3\x19\x69\xd6\x9f\x68\x0e\x81\x62\x17\x53\xc7\xfc\x09\xcc\x7a\xf1\x67\x5e\x88\x33\x1d\xe8\x76\x46\x61\xb0\x90\xb2\x44\x26\x80\x8b\x9c\x67\xcc\x10\xfd\xeb\x15\xda\x7d\xd0\x40\x46\x8a\xa8\xde\x1c\x43\x84\xf4\x44\x7b\x80\xc4\x96\xaa\xc7\x03\xb5\x1e\x1d\x81\xe0\xe5\xd0\xdb\x05\x2b\x35\x5a\x7f\x53\xbe\xda\x55\x79\x17\x05\xc7\x16\x39\x09\xfc\x15\x83\x8f\xdf\x30\x7d\x15\xa6\xf8\x28\x10\x52\xed\x91\x6f\x38\x70\x28\xe1\x10\xb2\x63\x1d\x46\xa8\x14\xbc\xdc\x41\xa5\x17\xe0\x5c\x9a\x0b\x2e\x96\x4d\xc9\xd4\xc3\x70\xe6\x07\x0f\x71\x56\x49\x65\x97\x24\x3a\x7e\xa0\x85\xdc\x3d\x70\x1b\x73\xfc\x93\x11\x37\x22\xfe\x2d\xa0\x0b\xaa\x8e\x70\x17\xa8\xff\x61\xe8\xf5\x06\xdc\x45\x5f\x20\xfd\xcd\x00\x0c\x84\x1e\x88\xc1\x73\x69\x7e\x91\x2c\xc7\xbb\x13\xcd\x12\x8d\xd5\xc0\x9e\x0f\x58\x9f\x59\x4a\x3b\x35\x9c\x2b\x3e\x53\x71\xb3\x77\xf4\x90\x6e\xef\x66\x3a\xc6\x7c\xab\x97\x07\x94\xbf\xce\xc7\x96\x39\xb9\xd8\x7e\x18\x6b\x31\xf2\xb4\xe3\xf0\x87\xfd\xec\xed\x72\xc3\xcb\x8e\xec\x37\xfb\x78\xa0\xff\xfd\x1e\xfe\x27\x2b\x79\x6e\x37\x03\x7b\x5c\xdc\xfa\x4e\x2a\x80\x85\x9d\x85\xa2\xe2\xb0\x35\x50\xc1\x78\xa9\xbd\x43\x77\xc9\xf4\x1e\x3d\xef\x77\x18\x30\x9f\xc3\x69\xa0\x62\x49\xd0\x26\x20\x9d\x4e\x48\x63\x27\xe2\x1f\x73\xfa\x0e\xf7\x3b\xbc\x8e\xe9\x60\x5d\xf5\xcc\x2e\xc5\xb5\x62\xf5\x5e\x6e\x3a\xfd\x4d\xd1\xae\xf4\x81\x6c\x1d\xa5\x68\x90\x7a\x87\x6c\x3d\xbb\x33\x7d\x9b\xcd\xbf\x06\x47\xc1\x35\xd2\xfb\xd6\x8b\x75\x83\xf8\xb7\xa1\x69\x87\xd8\xfd\x70\x3a\xa1\x3a\x9a\x62\x5c\x98\x3b\x33\x46\xa6\x90\x19\x9c\x37\x75\x4e\x55\x17\x5a\x1a\x68\xa7\x47\x6b\x85\x5d\x3b\xa8\xb2\xc5\x44\x4e\x04\x87\x7d\xdd\x69\x27\xeb\xb8\x68\x8b\x42\xcc\x47\x35\x89\x04\x5a\x2e\xcb\xee\xd8\x65\x91\x26\x15\x51\x73\x18\x6e\x04\xff\xdc\xa0\x40\x1d\xd0\xbb\x2b\x75\x8f\xde\x4a\x2f\x3d\x88\xa6\x13\xf2\xed\x37\xa0\x74\x87\xc9\x43\x53\x53\xaf\xab\x57\x35\x64\xab\x4a\x2f\xbf\x15\xc0\x37\x44\xba\x03\xc0\xd4\xe1\xf9\x9d\xe9\xdb\xdc\xfc\x35\x08\xde\x51\xac\x51\x41\xb2\x1b\xe4\xbf\x0d\xc3\x3b\xc4\xee\xc7\x70\xc1\xc5\x12\x95\x3d\xe3\xc1\x35\x1d\xfd\x74\x5f\x17\x0b\x65\xb4\xff\xbc\x78\x7b\x0e\x28\x32\x99\x13\xa2\xed\xd1\xc3\x61\xcb\x9d\x45\x8c\xb4\x53\x56\x4c\xaf\xec\xb9\x84\xc8\x9e\xf6\x64\x53\x78\xcf\xab\x50\xe4\xb3\xe7\xfb\x4c\x8a\x16\x15\x9d\xef\x8d\x84\xcb\xf7\x27\xae\x60\x47\xaa\x0d\x06\x59\x7e\x98\x03\x2d\x4c\x4d\x59\x7a\x73\x0d\xc4\x8d\xae\x81\x4b\x77\x5c\x55\x37\xce\x76\x1d\x00\x36\xdb\x98\x2c\xa8\xaf\x39\x9d\x0f\x0c\x9d\xed\xda\x34\xa2\x34\x6e\xdb\x33\xba\x68\x35\xbc\xc2\x94\x84\x3c\x9c\x4e\x26\x2d\x1c\x81\x49\x2f\xdf\x9f\x44\xb1\xef\x7e\x3c\xea\xe7\x05\x18\xf8\x4b\xef\x89\xf1\x04\x5f\x82\x5a\x24\x64\x71\x62\xf6\x2f\x2d\x45\xfa\x86\x29\xbd\x62\x65\xd4\xc6\x9d\x2f\x07\x14\x16\x70\x04\x1f\x3e\x2e\xe8\xe8\xdc\x1f\xb8\xa3\xd6\x9f\xac\xa9\xe9\xd4\x9f\xc1\xaf\x13\x98\xd1\x21\x5c\xff\xb7\x98\x85\x4a\xdb\x22\x78\xf2\xaa\x7d\x6d\x4d\xe6\x3c\x85\xba\x5b\xc5\xac\x51\xbb\xa2\x6c\x51\x32\x43\x15\x94\x03\xd7\xbc\xbf\x70\xd3\x25\xa6\x50\x65\x21\x06\xef\xe5\x1b\x56\x87\xc2\x4d\x7c\xd3\xad\x03\x8f\xfd\x7a\x7a\x02\x3f\xfc\xf0\xc3\xdf\x13\x20\xb5\x34\x39\x91\x0e\x5a\x2f\x5f\x24\x61\x82\x59\x31\xd3\x07\x71\x60\x11\x40\x96\xbe\xc7\x2f\xc6\x5b\x6d\x18\xce\xd0\xd0\xb6\xd1\x27\x46\xaa\x3d\x75\x33\x3a\xc2\xb2\xa0\xdb\x73\x9e\xc1\x15\x17\xb9\x86\xc8\x83\x98\xdb\x94\x48\x26\xcb\x81\xbc\xaf\x63\x4f\x4b\x1b\x45\x78\x74\x18\x74\x05\x63\x4f\x29\xc2\x74\x99\x3a\xf0\xfb\xba\x0e\xe9\x41\xdf\x3d\x18\x83\xc5\xa3\x1d\xbc\xf5\x39\xce\xc3\xae\xbd\x1f\x76\x3e\x4e\xdb\xf4\xd4\x56\xab\x22\x8b\xb9\x5f\x4f\x4f\xc8\x8a\xe7\x4c\xc8\x00\x46\x07\x94\xc1\x0c\x67\xd7\xf4\xc2\xe4\xaf\x83\xf1\xec\x07\x7c\x2f\x7d\xe5\xa5\x0d\x73\xf7\x5b\xd7\x83\xba\x87\x6c\x1b\xf0\x4a\x4e\x88\xe2\x57\xbb\xb9\x27\x70\x76\x6a\x46\x8b\x1e\xf6\xca\x2a\xaa\xb0\xa0\x2b\x89\xd4\x56\x48\xde\x16\x16\xf5\xde\x10\xaa\x4d\xff\xc1\x45\x1e\xf5\x46\x08\x83\x9d\xac\x03\xbd\x54\xdb\x57\x8e\xc6\x43\x7f\x94\xb2\x1c\x0c\xf4\x0e\xf4\x86\xa3\xce\x48\xb5\xa9\xfd\x1b\xef\x4e\x3d\x13\x26\x19\x7e\xf9\xdb\xe8\xdb\xb3\x97\xa3\xaf\x3f\x3c\x1f\x7d\x7d\xf9\xe2\x56\xa6\x67\xc2\x10\x4f\xfa\x13\x27\xf0\xec\xe9\x2e\xdb\x4b\x3e\xe4\x7b\xc9\x47\x8c\x2f\xf9\x98\xf3\x25\x1f\xb3\xbe\xe4\x77\xf2\xa6\x6e\x62\x7e\xc9\x6f\xe3\x7e\x5a\x4a\x36\xa2\x68\x1b\xee\x20\x69\xfb\x89\xa6\xfb\x10\x27\xf0\xfd\xf2\xfb\x04\x0e\x9e\x25\xf0\xf2\x45\xfc\xf5\x09\xce\x33\x19\x25\xb7\xe1\x29\xbc\x07\x52\xc8\x64\x3f\x21\x21\x18\x72\xfb\x87\x92\x85\x4f\x34\x21\x3f\xb9\x52\x18\x15\x77\xfd\xd9\xfa\xae\x84\x46\x5b\x78\xca\x67\x34\xd8\x48\x68\x13\xe2\xc1\xa0\x96\x36\x68\xc3\xf2\x65\x43\x3c\xe9\x13\x0c\x28\xa4\x6b\xf6\x3e\x6a\x88\x54\x88\xf8\x14\xde\x70\x6d\x47\xda\xca\x29\x65\x3f\x7d\xc5\xeb\x1a\xf3\x04\x1a\x51\xa2\xb6\xb7\x59\x66\x85\x6b\xbb\x96\x29\xfc\xdc\x70\x85\x79\x97\x37\x9c\x7e\x51\x35\xbc\xc3\x09\x8b\x17\x69\xb5\x77\x21\x23\x48\x38\x3a\x76\xe3\x31\xd8\xb7\xe8\xae\x6c\xf9\xe1\x0a\xd7\x1f\xed\x22\xf3\x17\x5f\x8f\xe4\x45\x3f\x6d\x18\xbd\xe4\x0d\xbb\x47\x28\xa2\xd1\x16\xac\xf2\x8a\x75\x93\xac\x61\xe0\xbb\xcf\x54\xf8\xc5\xb5\x0f\xf6\x40\x25\xd4\x53\x6c\xd5\x27\x6c\x5c\xee\xc9\x7b\xe3\xf5\xf4\x71\xeb\x12\xcf\x91\xcb\x87\xef\x98\xd2\x78\x23\xfb\x25\xa0\x03\xaa\x1f\xf7\x19\xb0\x9f\xba\x27\x09\x3a\x13\xfb\x14\xa2\xf7\xa6\xc0\x4b\x51\x0d\x93\xa0\x23\xd5\xa6\x5d\x33\x8d\x89\xfc\xca\xac\x29\x97\xe4\x58\xb0\xa6\x34\xfd\xe0\xe0\x49\x9b\xea\x22\x9d\xec\xc9\x7d\xe9\xeb\x12\xab\xc8\x2f\xe6\x77\xc6\xc6\x3e\x6f\xd8\x08\x20\x9c\x05\x2f\x1c\x82\x2b\xc1\xe3\xda\xea\x3e\xaa\x28\x93\x33\xc6\x11\x64\xc5\x18\x85\x91\x8b\x1c\xbf\x42\xd9\x78\x18\xd5\xd6\xfb\xa5\x33\xa1\xd3\x6f\xa1\x64\x35\x5e\xf1\x46\xfa\x76\x38\x55\xed\x58\xf3\x21\x36\xbf\x22\xf3\xb7\xe9\x05\x9a\x5d\x97\xed\xa6\xfd\x3e\xf5\x84\xac\x65\x31\x43\xdd\x76\xd2\x1e\x23\x07\x03\x51\xb9\xcf\xc3\xd7\xb2\xb2\x73\x16\x7f\xfe\x22\xc1\xf7\x8b\x48\xeb\x83\xa6\x0c\x9d\x80\x6a\xfd\x8d\x4d\xfa\x23\x37\x3a\x8a\xbf\x4e\x6e\x22\xc4\xff\x8f\x16\x99\x66\xbf\xec\x97\xfc\xcf\x12\xde\x52\x6a\xbe\x66\x91\x2a\xf6\x8b\x64\xa7\x44\xfa\x5b\xe5\x71\x64\x8a\x71\x78\xfb\xb1\x76\x71\xeb\xf2\x41\x9f\x0b\x2c\xcf\xe3\x3c\x57\x51\x4c\x20\x70\x9b\xd3\x10\xe4\x37\xa2\x91\xa8\xbc\x63\x66\xd5\x9d\x1c\xfb\xf5\x4c\x1b\xa9\x46\xef\x4e\x5d\x7c\xe6\xd2\x1c\x68\xac\x99\x62\x74\x4a\xaa\x69\xae\x0d\x4f\x0a\x45\x90\x8b\x7f\x91\xc5\x5c\x44\x06\xda\x51\x2e\xb3\xe1\x6a\x32\x5a\x37\x2c\x81\x70\xb9\x33\xb8\xd8\xda\x26\x7e\x25\xd9\xf4\x17\x9b\xe1\x76\xe6\xa2\x2e\xb9\x89\x68\x66\x02\xb3\x74\x76\xeb\xb5\xe1\x87\x43\xba\xf8\xa4\xd9\xf1\xc1\xb3\x8f\x36\xdc\x04\x7e\x31\x61\x45\xca\x65\xf6\xe1\xea\x63\x1a\xed\x17\xcd\x7b\x2a\xac\x55\x03\xd3\x25\xe1\x6c\x4b\x16\x9d\x90\x72\x47\x40\x74\x6d\xbe\xeb\x9e\x09\x58\xea\x24\xc4\x50\x86\x8f\x9d\x0b\xec\xb8\x81\x13\xce\xc4\xf8\xe2\x91\x24\x59\x86\x0d\xff\xc0\xc2\xa2\xa9\x16\xa8\x52\x38\xb7\x7f\xdd\xe9\xc6\x25\x51\x7b\xba\x29\x08\x31\x2f\x5f\xc0\x62\xed\x57\x79\xa0\x07\x86\x39\x53\x39\x94\x7c\xa1\x98\x5a\x77\x4f\x50\x14\x16\x52\x61\x02\x85\x62\xf6\x61\x12\x2b\x41\x0c\x88\x2a\xa4\x07\xb8\xfe\x94\x2b\xc5\x81\x17\x48\x0f\x9c\x4b\xb1\xbe\x73\xbe\x20\x0f\x0e\x3c\x77\xe7\x62\xcb\xc5\x10\xcf\x6d\xe2\x6f\xfa\x43\xdf\x68\x17\x48\x81\xd9\xc6\xa3\x21\x5e\x55\x7f\x46\xb0\x6f\xce\xdc\xa8\x57\xa1\x2b\x12\x31\x1d\x0e\xda\xb1\x03\x03\x11\xf2\x96\x25\x44\x38\x4d\x9d\x3d\x03\xb1\x2e\xaa\xed\xce\xf9\xe5\x8b\x3b\x8e\x1a\xc4\x53\x74\x92\x85\xd3\x86\xd3\xe9\x69\x80\x4a\xef\x67\x1b\xd2\x23\x4f\x5b\x61\xb9\x58\x1e\xd8\x5d\xdf\x6d\x0e\xef\xad\x6e\x29\xdc\xb0\xbb\x57\xf9\xa1\xb6\xf7\xc3\x6f\xb5\xbf\xed\xff\xe1\xf9\xa0\xdf\xcf\xd8\xf5\xc2\xd8\x89\xb7\x0f\x7a\xf9\xe2\xfe\x61\x37\x1d\xd1\xa7\x57\xbf\xe3\xbf\xd3\x15\xc5\x83\xbc\x90\x23\xd6\xf4\x46\x1f\x32\x59\x87\xa7\x7b\xbe\x5a\x44\x07\x7f\xad\x32\xb7\xff\xa6\xc7\xe8\x09\x89\x2e\x77\x87\xe4\xda\xf4\x43\x52\x78\x47\x1f\x51\xe9\x04\x34\xbd\xc7\xd7\x76\x13\x5f\xb1\x5a\x27\xc0\x94\x62\xf4\x88\xa2\xf3\x55\xff\x72\x0c\xbf\xf8\xf8\xf2\xef\x2f\x64\xe1\xcb\x9d\xa1\x10\x55\x73\x7b\xb5\x91\x35\x4a\xf3\x16\xcb\x1b\x81\x1b\x02\xdc\x5c\xcb\x50\x16\xc8\xa5\xbd\xd7\xd0\x2b\xa2\x50\x61\x25\x29\xdc\x17\xa8\x79\xd8\x62\x35\xe2\x0e\xb6\x56\xb7\x01\x6f\x46\x8f\x73\x3d\xf0\x82\xd1\xa2\x5c\x9b\xc4\xda\x68\x04\x3f\x5b\x61\x1f\x6f\x2f\x73\x6d\xc2\x06\x93\x96\xb2\x28\x93\xf5\xda\xf6\x45\xbb\x23\xb5\xca\xba\xad\x68\x38\x67\x75\xa3\xbb\x50\x61\x56\x06\x72\x9a\xff\xf9\x42\x58\x90\xac\xee\x29\x5c\x20\xf6\xae\x2d\x42\xa9\x9a\x8b\x42\x7a\x15\x7a\x01\x6e\xec\x08\x47\x5f\x87\xb1\x73\xdb\xc6\xf0\x9d\x09\x08\x6d\xd3\x33\x7d\xce\xcb\x28\x1e\x21\xd1\xbf\xf8\x9a\x64\xc3\xa2\x03\x3d\x30\xe8\xb6\x03\xdd\xd6\x7b\x92\xed\xb7\x52\xdb\x9b\xa4\x23\x9b\xed\x88\xd1\xad\xef\x87\x77\xf1\x0a\xcc\xfc\x82\xb6\x23\x71\x76\x17\xdf\xed\xed\xbc\x2f\x08\xeb\x5f\x6f\x84\x37\xec\x0a\xed\xd4\x4e\xbc\x04\xda\xf4\x17\x14\x83\x0f\xc4\x99\x3c\xc8\x69\xe2\xd3\x57\xc0\xe1\xdf\x42\xd7\x2b\xe0\x4f\x9e\x04\xc1\xcf\xe8\xa9\x6f\xc4\x6f\x5a\x2e\x74\xdc\xa7\xc3\x31\x85\xe7\x43\x6d\xf7\xff\x22\xd1\x1b\x56\xff\x31\x9b\xbe\x61\x35\x3d\xd0\xbd\xe0\xff\xb3\xc7\xb2\x9d\xf8\x06\xbb\x32\x5a\xfd\x2b\xed\x93\xac\x45\x0d\xaa\xf4\xdc\x17\xd4\xbc\x22\x17\x68\xde\xb0\xda\x4b\x4d\xfd\xff\xc0\x35\x11\xec\x75\xb2\xad\xee\xe3\xbd\x5a\x5d\xd8\x0c\xf3\x50\x43\x3b\x44\xb6\xfb\x6d\x7e\xde\x54\xf6\xea\x72\x64\x78\x5e\x40\x41\xa3\x32\xff\x20\x94\xc7\xaf\xa0\x48\x4f\x98\x20\x42\xde\x80\x93\xe2\x86\x53\xc2\x60\x2b\xfe\x64\x7b\x43\x87\x9b\x9b\xee\x96\xd6\x15\x97\xa1\xec\x8f\x70\x7e\x6a\xaa\x1a\x56\x92\x12\xa9\xdf\x6b\x19\xbf\x76\x53\xcf\xcf\x34\x04\x32\x46\x57\x04\xb4\xfc\x0e\xe6\xf4\x37\x5b\x74\x2b\xa6\x01\x86\x1b\x64\x5a\xc2\xa7\x93\x96\x6b\x4e\x5b\xec\xdd\x0e\xc7\x5e\xe0\xf5\xcf\x1d\xb5\x3e\x47\x0a\xbc\x1e\x70\xb1\x19\xbd\x90\x65\x29\xaf\x87\x47\x6c\xcb\xd2\x1e\xa2\x59\x59\xfa\x17\xcb\xb4\xeb\xa1\x3b\xbe\x6b\x54\x7e\x98\x4f\x9b\x43\x46\x91\x1b\x1b\xde\x16\xc6\xf0\xb8\xe7\xb5\x99\x4e\x72\xf2\xc1\x5f\xbb\xa6\x8d\xd7\xe0\xf0\xc6\x83\x5f\x52\x30\x76\x35\x07\xda\x1d\x5b\xaa\x31\xfc\x3b\x3c\x25\x83\x4c\xf2\xd4\x36\xc0\xd1\xde\x79\xee\x35\xa3\x1d\x11\x07\x88\xdc\x78\x60\x6a\xbb\x2d\xb1\x40\xad\x7b\xff\xba\x6f\x87\x90\x7b\xa3\x3a\x53\xf9\xad\xaf\x1e\x5f\x98\xd1\xe5\x25\x3d\x7b\x1f\xd8\x91\x48\x82\x5e\xc9\xa6\xcc\x61\x41\x6f\x43\x69\x76\x57\x4a\x8b\xf2\x81\x79\x62\xdf\x1b\x0d\x1e\x56\xf4\x97\x68\x41\x8c\xa0\xb8\x3b\x19\xfe\xfe\x7b\x68\xf9\x40\xed\x1f\xbd\x90\xd6\xaa\x50\x31\x75\x35\xf2\xa9\x7f\xeb\xa3\xc1\x5b\xdd\x6d\x17\x76\x55\x19\xbc\x37\xf3\xe3\x60\x61\xcf\x01\xfb\x85\xb6\x63\xa2\xe1\x9b\x9f\x04\x78\x3e\x5e\xfb\x83\x12\x54\x1c\x3c\x3c\x1a\x94\x52\x8b\x68\xf6\x9d\x3e\xb4\x35\x21\x4b\x81\xa6\xba\x22\x6c\x9e\x7a\xe6\xb6\x26\xb8\xff\xd6\x70\x67\x4c\x70\x9c\x1f\x67\xbf\xd8\xdf\xa6\xcc\x1f\x03\x7e\xa9\x59\x78\x8b\xe5\x6e\x50\xac\xee\xcb\x52\x2e\x58\x09\x2b\x2c\x6b\xfb\x9e\xdf\xfe\xbe\xb1\xfb\xa1\xc2\xde\xdf\x29\x58\x12\xbb\x3f\x91\xb9\xeb\xe7\x27\xdf\xfe\x6b\x2c\x2b\xe4\x9f\xcf\x12\x45\x0e\xdb\xed\xf4\x7f\x07\x00\xef\x92\x2b\x0f\x92\x3a\x00\x00")
 
 func templateBaseTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -141,12 +144,12 @@ func templateBaseTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/base.tmpl", size: 4899, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/base.tmpl", size: 14994, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x56\x6f\x6f\xdb\x36\x13\x7f\x2d\x7d\x8a\xab\xa0\x02\x52\x60\xcb\x69\xdf\x3d\x09\xfc\x00\x5d\x92\x6e\x06\xb6\x6e\x80\x9b\xa2\x40\x5b\x0c\x8c\x74\xb2\x09\x4b\xa4\x4a\x52\x6e\x02\x43\xdf\x7d\x38\x52\xa2\x25\xd7\xed\xd6\xbe\x12\x45\xde\xdf\xdf\xfd\xee\xc8\xc3\x61\x71\x11\xde\xc8\xe6\x49\xf1\xcd\xd6\xc0\xcb\xcb\x17\xff\x9b\x37\x0a\x35\x0a\x03\xaf\x59\x8e\x0f\x52\xee\x60\x25\xf2\x0c\x5e\x55\x15\x58\x21\x0d\x74\xae\xf6\x58\x64\xe1\xdb\x2d\xd7\xa0\x65\xab\x72\x84\x5c\x16\x08\x5c\x43\xc5\x73\x14\x1a\x0b\x68\x45\x81\x0a\xcc\x16\xe1\x55\xc3\xf2\x2d\xc2\xcb\xec\x72\x38\x85\x52\xb6\xa2\x08\xb9\xb0\xe7\xbf\xaf\x6e\xee\xde\xac\xef\xa0\xe4\x15\x42\xbf\xa7\xa4\x34\x50\x70\x85\xb9\x91\xea\x09\x64\x09\x66\xe4\xcc\x28\xc4\x2c\xbc\x58\x74\x5d\x18\x1e\x0e\x50\x60\xc9\x05\x42\x94\x2b\x64\x06\x23\xe8\x3a\xda\x8d\x9b\xdd\x06\xae\x96\xf0\xc0\x34\x42\x9c\xdd\x48\x51\xf2\x4d\xf6\x17\xcb\x77\x6c\x83\xd0\xab\x1a\xac\x9b\x8a\x19\x84\x68\x8b\xac\x40\x15\x41\xfc\xf5\x11\xaf\x1b\xa9\xcc\x70\xe4\xfe\x20\x09\x83\xc3\x61\x0e\x8a\x89\x0d\x42\xdc\x30\xb3\x25\x67\x71\xb6\xe6\x0f\x15\x17\x9b\x95\x95\xd2\xa4\x11\x04\x91\x0d\x87\x44\xba\x2e\x72\x7a\x28\x0a\x3a\x4b\xad\xab\xf8\xa1\xe5\x15\xc1\x75\xb5\x84\x46\x71\x61\x20\x69\x98\xce\x59\x05\x71\xf6\x86\xd5\x98\x42\x74\x33\xcd\x4d\x61\x8e\x7c\xef\x34\xfc\xda\x9b\xa1\x30\x17\x0b\x18\x5b\xee\x3a\xaa\x0e\x41\x3b\xec\x94\x52\x81\x45\x8c\x8b\x0d\x30\x2b\x6c\x9d\x91\x28\x0a\xc3\xcd\x53\x16\x9a\xa7\x06\x4f\xcd\x68\xa3\xda\xdc\xc0\x21\x0c\x72\x0b\x69\x18\xd4\xad\x61\x86\x4b\x01\x17\x87\x03\x40\x9c\xfd\xd1\xff\xf7\xd6\xc2\x60\x2b\xe5\x4e\xc3\x87\x4f\xbf\x49\xb9\x0b\x1d\xba\x5f\xb8\xd9\x02\x3e\x1a\xc2\x21\x86\xe8\x17\x67\x3f\x9a\xe4\x10\x4c\xaa\xa0\xd1\x18\x92\xc8\x7a\x0c\x7a\x04\x29\xd1\x35\xdb\xa3\xcb\x05\x5d\x8e\x93\x64\x7a\x4a\x15\xcc\x30\xe2\x42\x16\x96\xad\xc8\x21\x99\xc0\xd8\x75\x36\xf8\x91\xf7\xd4\x5a\x4d\x72\xf3\x08\xb9\x14\x06\x1f\x0d\x51\x88\xbe\x29\x24\x17\x63\x07\x33\x40\xa5\xa4\x4a\x09\x12\x2a\x6d\xec\xf1\xf0\xe5\x3c\x3a\x8a\xb2\xe1\x34\xea\x53\x9c\x43\x5c\x72\xac\x0a\xed\xf8\xf3\xda\xad\xbb\xee\x70\x00\x5e\x42\x9c\xad\x6e\xb3\x7b\x8d\xea\xd6\x92\xbc\x70\x07\x83\xc6\x12\x58\xd3\x58\x08\xfb\x0d\x12\x77\x22\x3d\x3c\x63\x92\x96\xd6\x43\x39\x38\x08\x03\x7b\xc8\x4b\x90\x0a\xe2\x32\xbb\xc5\x92\xb5\x95\x81\x84\x89\x02\x12\x21\x0d\x6d\xfe\xd9\x50\xac\xac\x4a\x21\x11\x64\xc2\x25\x6d\xa3\xb2\xcc\x4c\x9d\xa1\x80\x97\xf0\xf7\x0c\xe4\x8e\x5c\x50\x80\x1e\x83\xae\xcb\x6c\xc0\x9e\x15\xbf\xa2\x81\xae\x4b\xd2\x6b\x78\x26\x77\x84\x59\xe0\xe3\x18\x05\xe1\xac\x06\xc1\x7e\x30\x38\xea\xdc\xde\x60\x2f\xda\x57\xc1\xc1\xe5\x32\x59\xe9\xb7\xbc\x46\xb7\xba\xbf\xb7\x88\x24\xe9\x08\x13\xe7\x72\x1a\xe4\x1a\x8d\x33\xbb\xb6\xfc\xb6\x65\x20\xbd\x7d\xea\x23\xc4\x4a\xa3\xd7\x57\x68\x5a\x25\x40\xf0\xaa\xaf\xbf\xce\xde\xe0\x97\x24\x1a\x26\x4f\xd7\x5d\x41\xcd\xb5\xa6\xee\x52\xf8\xb9\xe5\x0a\x0b\xb0\xd8\xc3\xc7\xc8\x79\xea\x23\xff\x18\x45\x23\x1f\x3e\xc4\xa1\x3e\x7e\x87\x7e\x6c\xdb\xb8\x24\xdf\xb1\x8a\x17\xcc\x48\xa5\x5d\xa2\x77\xa2\xad\x8f\xc5\xd8\xff\x68\x31\x7c\x2d\x78\x49\xf9\x7c\x1b\x76\xef\xd7\xa1\x73\x6d\xa5\x9f\x2d\x09\x89\xde\xc2\x04\x9b\xb2\x36\xd9\x1d\xe1\x53\x4e\xb1\xd9\x7b\x33\x25\xe3\x15\x61\x43\xcb\xf3\xf8\x5c\xc1\xf3\x7d\x64\x61\x76\x40\x9d\xc5\xe7\x74\xdd\x93\x1e\x5d\x5b\xdd\x15\x1b\x9c\x92\xde\x12\x1c\x3d\xc1\x7b\xe8\x06\x26\x62\x76\x2f\xf8\xe7\xd6\xd7\xfb\xdf\xf8\x8d\x27\xbc\x59\xdd\x4e\x18\x7e\x4a\x1f\x5e\x42\x85\x22\xf9\x6f\x96\x74\x92\xa6\xb0\x5c\xc2\xe5\xc8\xd6\x91\xc9\x3f\x45\x44\x2c\x36\xd8\xe3\x8c\xa7\x3c\xfc\x1e\xb0\x7b\xa6\xe8\xe6\x0b\xa8\xe6\xd6\x59\x18\x04\x82\xae\xfe\xc9\x44\x0c\x83\x34\x1c\xa7\x38\x1a\xb3\x99\xbd\x0b\x46\xe9\x90\xb6\x0d\x1c\x1c\xb0\x63\x59\x6b\x73\x6d\xa4\x72\x04\x1c\x06\x72\x1a\x06\x9d\x43\x93\x0c\x50\x48\x75\x6b\xc0\x52\x5a\x92\x19\xbb\xc2\xd7\xad\xc8\x13\x1a\xf5\xe7\x66\xf8\x0c\x6a\x18\x7a\x20\x85\xe4\x1d\xab\x5a\x1c\xcf\xf1\xc0\x5f\x6a\x43\xd1\xeb\xac\x9f\xfa\x27\xb7\x5b\xda\x37\xdc\x71\x98\x7d\x8b\xfd\xad\xc0\xc7\x06\x73\x83\x05\xf8\x9a\xdb\x0b\xf6\xf9\xdb\x68\x06\xb5\xc7\xfe\x74\x34\xc1\xd2\xcb\xd3\xe9\xcf\x01\x76\x0c\x6b\x50\x0f\x83\xc0\x06\x4f\x7d\xc7\x29\xc3\xef\x54\x6b\x0e\x2f\xae\x81\xc3\xff\x97\x70\x79\x0d\x7c\x3e\xf7\x10\x9d\x89\xc1\xaa\x7c\xe0\x9f\x92\xba\x35\x64\x9f\x52\x72\xdd\xd3\x8f\x95\xba\x35\x0e\x44\x1b\xdb\xec\xb4\x9d\xce\x4c\x94\x13\x8a\x3b\xa3\x5d\xf8\x75\x4a\xc7\xe7\xc0\x7b\xc8\x59\x55\x69\xf7\x34\xa0\x0b\xad\x61\x82\xe7\x9a\x7a\xdb\x6e\x39\x55\x0d\x4c\xb8\xaa\xff\xd0\xab\xe0\xfd\xf9\x67\xc1\xa4\x07\x28\xf2\xfd\x6c\x3c\x4a\xc7\x20\x8d\x2a\xd3\xcf\xdb\x51\xbe\x36\xd4\xc4\x4d\xbb\x63\x96\xfb\x1f\x7c\x39\xc5\xa6\x6e\x2a\xff\x04\x29\x21\x2a\x38\xab\x30\x37\x8b\xe7\x7a\x31\xbc\x94\xc7\x64\xb1\x4a\x8f\xfe\xbd\xe5\xd4\x4f\x1f\x5b\x7e\xf9\x4f\x00\x00\x00\xff\xff\xe1\x6d\x94\x8c\x3b\x0c\x00\x00")
+var _templateBuilderCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x5d\x6f\xdb\x38\xd6\xbe\x96\x7e\xc5\x19\xc1\x7d\x21\x05\x8e\xdc\x99\xbb\x37\x85\x17\x98\x4d\xd2\x36\xc0\x4e\x77\x81\x24\x83\x01\xda\x62\x41\x4b\x47\x36\x61\x89\xd4\x90\x94\x63\x43\xd0\x7f\x5f\x1c\x92\x92\x25\x3b\x99\x49\xbb\xbb\x17\x7b\xd3\xca\xe4\xf9\x3e\xcf\xf9\x60\xda\x76\x71\x11\x5e\xcb\xfa\xa0\xf8\x7a\x63\xe0\xa7\xb7\x3f\xfe\xff\x65\xad\x50\xa3\x30\xf0\x9e\x65\xb8\x92\x72\x0b\x77\x22\x4b\xe1\xe7\xb2\x04\x4b\xa4\x81\xee\xd5\x0e\xf3\x34\x7c\xd8\x70\x0d\x5a\x36\x2a\x43\xc8\x64\x8e\xc0\x35\x94\x3c\x43\xa1\x31\x87\x46\xe4\xa8\xc0\x6c\x10\x7e\xae\x59\xb6\x41\xf8\x29\x7d\xdb\xdf\x42\x21\x1b\x91\x87\x5c\xd8\xfb\xbf\xdd\x5d\xdf\x7e\xba\xbf\x85\x82\x97\x08\xfe\x4c\x49\x69\x20\xe7\x0a\x33\x23\xd5\x01\x64\x01\x66\xa4\xcc\x28\xc4\x34\xbc\x58\x74\x5d\x18\xb6\x2d\xe4\x58\x70\x81\x10\x65\x0a\x99\xc1\x08\xba\x8e\x4e\x67\xf5\x76\x0d\x57\x4b\x58\x31\x8d\x30\x4b\xaf\xa5\x28\xf8\x3a\xfd\x07\xcb\xb6\x6c\x8d\xe0\x59\x0d\x56\x75\xc9\x0c\x42\xb4\x41\x96\xa3\x8a\x60\x76\x7e\xc5\xab\x5a\x2a\xd3\x5f\xb9\x5f\x10\x87\x41\xdb\x5e\x82\x62\x62\x8d\x30\xab\x99\xd9\x90\xb2\x59\x7a\xcf\x57\x25\x17\xeb\x3b\x4b\xa5\x49\x58\x10\x44\xd6\x1c\x22\xe9\xba\xc8\xf1\xa1\xc8\xe9\x2e\xb1\x0e\xcc\x56\x0d\x2f\x29\x5c\x57\x4b\xa8\x15\x17\x06\xe2\x9a\xe9\x8c\x95\x30\x4b\x3f\xb1\x0a\x13\x88\xae\xa7\xbe\x29\xcc\x90\xef\x1c\xc7\xf0\x3d\x88\x21\x33\x17\x0b\x18\x4b\xee\x3a\xca\x0e\x85\xbb\x3f\x29\xa4\x02\x1b\x31\x2e\xd6\xc0\x2c\xb1\x55\x06\x5d\x07\x28\x0c\x37\x87\x34\x34\x87\x1a\x4f\xc5\x68\xa3\x9a\xcc\x40\x1b\x06\x99\x0d\x69\x18\x54\x8d\x61\x86\x4b\x01\x17\x6d\x0b\x30\x4b\x7f\xf1\xbf\xbd\xb4\x30\xd8\x48\xb9\xd5\xf0\xf9\xeb\x47\x29\xb7\xa1\x8b\xee\x13\x37\x1b\xc0\xbd\xa1\x38\xcc\x20\xfa\xab\x93\x1f\x8d\x35\x85\xc1\x24\x0b\x1a\x8d\x21\x8a\xd4\xc7\xc0\x47\x30\x5c\x2c\xe0\x9e\xed\xd0\xf9\x82\xce\xc7\x89\x33\x1e\x52\x39\x33\x8c\xb0\x90\x86\x45\x23\x32\x88\x27\x61\xec\x3a\xb8\x98\xfa\x99\x58\xa9\x71\x66\xf6\x90\x49\x61\x70\x6f\x08\x42\xf4\x7f\x02\xf1\xc5\x58\xc1\x1c\x50\x29\xa9\x12\x0a\x09\xa5\x76\x36\xc4\x63\x48\xe7\x51\x51\x94\xf6\xb7\x16\xa7\x01\x2f\x88\x9b\xd2\x98\x6d\x30\xdb\x3e\xec\x4f\xed\x4a\x73\x45\x16\x26\xef\x2c\xdd\x0f\x4b\x10\xbc\x24\x4d\x81\x42\xd3\x28\x41\x3f\xad\x01\x61\xd0\x85\xc1\x19\x2f\x16\xac\x29\x8d\x8e\x93\xb1\xa6\x53\x2a\xab\x39\x7e\x9d\x86\x1d\x53\x04\xfd\x80\x44\x59\xb7\xc3\x20\x10\x54\xfb\x93\x90\x84\x81\x53\x58\xa2\x38\xf3\xc7\x82\x21\x81\xe5\x12\xde\x5a\x3f\x88\xdb\xca\x87\x73\xcb\xe8\x77\x7a\x6f\xa4\x72\x25\xdb\x67\x24\x09\x83\x0e\xb0\xd4\x68\x05\x90\x49\x55\x63\xc0\xc2\x4e\x2a\x58\xba\x2f\x7c\xdf\x88\x2c\xa6\x5c\x3f\x97\xc4\x39\x54\xd0\xe3\x34\x81\xf8\x57\x56\x36\x38\x4e\x64\x30\xa0\x7a\x0e\x72\x4b\xf9\xa9\x52\x9f\xf6\x13\x78\x27\x44\xcc\x0b\xf8\x41\x6e\x1d\xe3\x24\x6e\x45\x65\xd2\x5b\x8a\x53\x11\x47\x8d\xc0\x7d\x8d\x99\xc1\x1c\x7a\xe1\x60\x2b\xec\xcd\x43\x34\x87\xca\x0a\xa2\x76\x61\xd3\x38\x50\x74\x1d\x2c\x07\xfa\x30\xf8\xde\x80\x1d\xcd\xea\xd9\xc3\x20\xe8\x48\x27\x35\x02\x4e\x1e\xfe\x41\xb6\x2e\xe1\xc7\x77\xc0\xe1\x2f\x4b\x78\xfb\x0e\xf8\xe5\xe5\x10\xa2\x67\x6c\xb0\x2c\x9f\xf9\xd7\xb8\x6a\x0c\xc9\x27\x97\x78\x01\xff\x9c\xf7\xf8\xab\x1a\xe3\x7a\x84\xb5\x6d\x0e\x27\xee\x9e\x03\x71\x12\x51\x6f\xb9\xc5\xfb\x99\x4b\xc7\x7e\xf0\x1b\x64\xac\x2c\xb5\xad\x62\x60\x22\x87\x9a\x09\x9e\x69\xe0\x85\x3b\x72\xac\x1a\x98\x20\x46\xa9\xbe\xa9\x2d\xfc\xf6\x7c\x5f\x98\xd4\x00\x85\x68\x37\xf8\x7c\x1a\xa4\x51\x66\x78\x71\xea\xaf\x35\x35\x46\xa5\x92\xb1\x97\x3b\x6a\x9d\x8b\x05\x3c\x8a\x27\xc5\x6a\x50\xb8\xe2\x22\x9f\xf6\x74\xb3\x61\x06\x9e\x98\xf6\xcd\x30\x87\xd5\x01\x18\x18\xc5\x84\x66\x19\xa1\x89\x95\x90\x95\x9c\xe6\xbb\x91\x96\xd3\x75\x17\xc7\xa8\x0d\x53\x06\x73\x8a\x20\x5d\x8d\xd8\xe6\xc0\x0a\x83\xea\xf4\xd8\xa9\x92\x55\xc5\x0d\x81\x5a\x2a\x50\xb2\x2c\x31\x87\x15\xcb\xb6\x29\xf8\xa6\x4e\x26\x32\x03\x4c\x21\xac\x68\xee\x83\x91\xc0\x48\x49\x56\x4a\xda\x14\xc6\x02\x0b\xc6\x4b\x37\x1b\x6e\x95\x7a\xd8\x5f\x5b\x8a\x57\xa7\xc6\x45\x26\x4e\x4e\x6f\x28\x15\x66\xdf\x17\xf2\x69\x2a\xdc\x18\xf3\x7d\x36\x8d\x2f\xcc\xfe\xc6\x7e\x26\xe1\xb8\xac\x5d\x4e\xa2\x7e\xb1\xe8\xba\xab\x67\xe6\xab\x90\xe6\x2c\xde\x9e\x22\x4a\x9e\xed\xd0\x13\xe5\xb0\x04\xb3\x4f\x73\xb5\x3b\xa7\xeb\xeb\xe3\x9c\xd2\xa3\xe3\x84\xc1\x63\xe5\x06\x57\xcd\xfa\x3d\xc7\x32\xd7\x03\xe2\x29\xb7\xd9\x86\xf6\x16\x9f\x99\x27\x54\x08\xac\xae\x4b\x4e\xd9\x90\x13\x44\x69\x09\x05\x53\x73\xd8\xe2\x81\xf2\x7a\xb0\x97\x05\x09\xb4\x45\x85\xf9\x1a\x29\x95\x82\x55\xa8\x21\xd6\x88\x96\x20\x1f\xa9\xa5\xdc\x91\xe5\x40\x7d\x86\x2e\xb7\x78\xa0\xef\x8a\x99\x24\x85\x07\x4b\x6d\xa7\x14\xec\xa8\x09\x6b\xb7\xec\x79\x25\xda\xc2\x86\x8b\xac\x6c\x72\x87\x4c\x29\xca\xc3\x11\x8d\x07\x67\xbc\x46\x43\xb6\x51\x51\xbd\x1a\x2c\xa3\xd0\xc4\x09\x54\xac\xfe\xac\x8d\xe2\x62\xfd\xd5\xce\x02\x68\x87\xc8\x8e\x9c\x89\x5f\x4a\x4b\xe2\xe3\xed\x5d\xd1\xa0\xd1\x68\x30\x2f\x3a\xd7\x1b\xb2\xc2\x42\x92\xfd\xdf\x62\x78\xaf\x23\xfe\xbe\x8d\xc3\xae\x28\x3e\xb8\x76\x63\xf5\x79\xea\xba\xb6\xa5\xee\x38\x4b\xef\x6e\xd2\x47\x8d\xea\xc6\xae\xd5\xb4\x64\xb5\xed\xc0\xb1\x24\xa0\xd0\xea\xd5\x1f\x10\xb9\x23\xf1\x0b\xd9\x78\x2d\x2e\xc8\xa0\x9e\x92\xee\xec\x25\x29\x29\xd2\x1b\x1f\x18\x7b\xec\x07\xc4\xb1\x40\x07\x8f\xfc\x50\x2b\x86\xad\xf2\x03\x1a\xe8\x3a\x5a\x57\x8e\x13\x77\xd7\xb3\x8d\xf6\x7b\xcf\xe6\xd5\xf8\xa6\xec\x5c\x94\x8a\x0c\xb8\xd3\x0f\xbc\x42\xf7\xf5\xf8\x68\xbd\x88\x93\x91\x1f\xe7\x83\x38\xbd\x47\xe3\xa4\xde\xdb\x25\xd8\x46\x8e\xd8\x76\xc3\xec\x1e\xed\xf6\xe3\x3d\xdf\xa1\xc3\x2e\x5a\xa0\x1a\xa1\x81\x95\xa5\xfb\x49\x13\x28\x87\x46\xa3\xba\xcc\x7d\xc0\x77\xac\xe4\x39\x33\x52\x69\x90\x62\x0c\x97\x57\x43\xc4\x6f\x74\x34\x57\xa4\xfa\xdf\x45\x09\x45\x26\xa6\x9e\x7a\xcc\x63\x32\x1c\x7c\x64\xda\x9f\xdd\xee\x6b\x75\x3c\xff\x80\x02\x15\x0d\xbf\xe3\xd1\xdf\x6b\x82\x12\x2b\xe9\x84\xf4\xb9\xa7\x01\xd9\xe4\x9f\x57\xff\x09\x0c\xfa\x6e\xf1\x7f\xbf\xba\xec\x71\x29\xec\xde\xd7\x92\x86\x2b\xb0\x83\xc3\x2b\xee\xba\xc8\xee\x05\x57\xf4\x8f\x54\x3a\xfd\x84\x4f\xd3\xc9\x52\x71\xad\xe9\x59\xa6\xf0\xf7\x86\x2b\xcc\x5d\x33\x84\x2f\x53\x29\x5f\xa2\x28\xe9\x9e\x03\xde\xa8\xca\x86\x68\xfc\x1b\x3e\xfe\x57\x5c\x5c\x0f\x86\xbd\xe0\x1b\x64\x4c\x50\xaa\x57\x48\xcd\xf4\x8f\x3c\xb5\xfb\x82\xab\x69\x6f\x19\x95\x8e\xad\xeb\x5b\xd1\x54\x47\xcf\x77\xdf\xed\xf9\xf4\xf1\x74\xde\x65\x06\xbd\x14\xb2\xdd\x33\x1b\xec\xb8\xf3\xdd\xa3\xd0\xdc\xf0\x1d\x7a\xcb\x02\xf7\x9c\xa2\x97\x7c\xce\x32\x63\x61\x43\xdb\x9f\xdd\x8c\x1d\xcb\x47\xa6\x3f\xc8\x07\x7a\x2b\x74\x9d\x1b\x53\xf1\xce\xf6\x2a\x7a\x04\x75\xdd\x6e\x28\xac\xf9\xd9\x7a\xa3\x7b\x6d\xbf\x30\xbd\x8d\x49\xc6\x78\x4b\x9d\xbf\xe0\xd0\xb5\x14\xda\x30\x41\x81\x48\x92\xa3\x03\x43\xdc\xbf\x13\x0d\xe3\xe7\xd0\x18\x0d\x43\xcb\xb3\x1b\x20\x81\x42\xaa\x97\x40\x7f\x05\x6f\x76\xae\x80\x1c\x26\x82\x67\x91\x71\xfa\xed\x7b\x0d\x52\x0e\x67\xe9\x6d\x4e\xdb\xcf\xb8\x56\x08\x6a\x33\x1c\x5a\x85\xf7\xb2\x4f\x1a\xa6\x8f\x82\xff\xde\x0c\x19\xfb\xb3\x32\xc2\x93\x09\x71\x77\x33\x69\x16\x24\xd6\xbe\x5f\x8f\xe2\xfa\xc7\xd7\x9f\x4b\xd2\x71\x32\x7a\x3e\x4f\x1c\x7d\x5d\x56\xf0\xb5\x35\x7a\xd6\x86\x68\xdd\x83\x2f\x53\x21\x2f\x76\xa1\xf1\xb7\xb7\x4a\xf0\xf2\x1b\xff\xfe\x33\x33\x55\x5d\x0e\x03\xab\x80\x28\xe7\xac\xc4\xcc\x2c\xde\xe8\x45\xff\xf7\xbe\xf1\x8b\xd7\x32\xed\x87\xbf\x1a\x39\xf6\xd3\x3f\x19\xb5\x2d\xa0\xc8\xa1\xeb\xfe\x35\x00\xbd\x05\x45\x85\x01\x15\x00\x00")
 
 func templateBuilderCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -161,12 +164,12 @@ func templateBuilderCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 3131, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/create.tmpl", size: 5377, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x71\x6f\xdb\xb6\x13\xfd\x5b\xfc\x14\xaf\x82\xfb\x83\x54\x38\x74\xda\xff\x7e\x29\x3c\xa0\xeb\x52\xac\x40\xd7\x0d\x6b\xb1\x15\x28\x8a\x81\xa1\x4e\x36\x61\x89\xd4\x28\x2a\xb1\x61\xe8\xbb\x0f\x24\x6d\x59\x76\x9c\x34\x18\x16\x04\x89\x44\xde\x3d\xde\x7b\x77\xc7\xd3\x76\x3b\x7b\xc1\xde\x9a\x66\x63\xd5\x62\xe9\xf0\xea\xf2\xe5\xff\x2f\x1a\x4b\x2d\x69\x87\x77\x42\xd2\x8d\x31\x2b\xbc\xd7\x92\xe3\x4d\x55\x21\x18\xb5\xf0\xfb\xf6\x96\x0a\xce\x3e\x2f\x55\x8b\xd6\x74\x56\x12\xa4\x29\x08\xaa\x45\xa5\x24\xe9\x96\x0a\x74\xba\x20\x0b\xb7\x24\xbc\x69\x84\x5c\x12\x5e\xf1\xcb\xfd\x2e\x4a\xd3\xe9\x82\x29\x1d\xf6\x3f\xbc\x7f\x7b\xfd\xf1\xd3\x35\x4a\x55\x11\x76\x6b\xd6\x18\x87\x42\x59\x92\xce\xd8\x0d\x4c\x09\x37\x3a\xcc\x59\x22\xce\x5e\xcc\xfa\x9e\xb1\xed\x16\x05\x95\x4a\x13\xd2\x82\x2a\x72\x94\xa2\xef\xfd\xea\xa4\x59\x2d\x70\x35\xc7\x8d\x68\x09\x13\xfe\xd6\xe8\x52\x2d\xf8\x6f\x42\xae\xc4\x82\xb0\x73\x75\x54\x37\x95\x70\x84\x74\x49\xa2\x20\x9b\x62\x72\x7f\x4b\xd5\x8d\xb1\x6e\xbf\x15\xdf\x90\xb1\x24\xf5\xa7\xdc\x07\x9e\x85\xe5\xc3\x7b\xca\x72\x16\x10\x27\x37\x9d\xaa\xbc\x2a\x57\x73\x34\x56\x69\x87\xac\x11\xad\x14\x15\x26\xfc\xa3\xa8\x29\x47\xfa\xd3\x31\x05\x4b\x92\xd4\x6d\xf4\x18\x9e\x07\x98\x9d\x51\xdd\x39\xe1\x94\xd1\x07\xd8\x83\x5f\xca\xf7\xbb\x01\x93\xcd\x66\x18\x07\xd2\xf7\x3e\x67\x5e\xf0\xfd\x4a\x69\x2c\x82\x8e\x4a\x2f\x20\xbc\xf1\x51\x88\xde\x83\xb4\x53\x6e\xc3\x99\xdb\x34\x74\x8a\xd6\x3a\xdb\x49\x87\x2d\x4b\x64\x90\x85\x25\x4b\x63\x56\x2d\xc2\xcf\xd7\x6f\x3f\x1b\xb3\x62\xc9\x10\x30\xf0\x22\x68\xf5\xcb\x6e\x61\x77\x02\x4b\x1a\x4b\x85\x92\xc2\x51\x8b\xaf\xdf\x86\x17\x1e\x8c\xf7\x46\x3d\x0b\x74\xfe\x5c\x92\x25\x88\xa2\x68\x21\xa0\xe9\x0e\x83\x39\x9c\x09\xd4\x62\x59\xec\x19\x72\x56\x76\x5a\x22\x3b\x92\xb7\xef\x63\x24\x07\x26\x79\x04\xce\x9a\x16\x9c\xf3\xf3\x21\xe4\xa7\x4e\x9e\xf7\x18\xb7\xef\xf9\x88\xc9\x1c\xa2\x69\x48\x17\xd9\x83\x26\x53\x34\x2d\xe7\x3c\x67\x89\x25\xd7\x59\x8d\x93\x20\x59\xcc\xe0\xf5\x9a\x24\x68\x4d\xb2\xf3\xb0\x03\x45\xaf\xe8\xdf\x1d\xd9\x0d\x84\x2e\x10\x11\x5a\x2c\xcd\x1d\x6a\xa1\x37\xb8\x25\xeb\x94\xa4\x16\x77\x5e\xb0\x28\x4a\x71\x4e\x8d\x73\x62\xf8\x23\x33\xe9\xd6\x90\x46\x3b\x5a\x3b\x5f\xf5\xfe\x7f\x8e\x4c\x69\x37\x05\x59\x6b\x6c\xee\xf9\xdf\x0a\xeb\x7b\x23\x21\x6b\xe3\x2a\x4b\x12\x51\x96\x24\x1d\x15\x50\xda\xb1\x24\x67\x89\x2a\x51\x91\x3e\xcd\x01\x0f\xb5\x92\x63\x3e\xc7\xa5\x87\x1a\xfc\x02\x3e\xe6\xa7\x72\xc4\x64\x7c\x72\xc6\xc6\x46\xdb\x07\x99\xb3\xa4\x07\x55\x2d\x05\x10\x1f\x50\xdd\x39\x84\x22\x33\x1e\x26\x3c\xd1\xbb\x4e\xcb\xcc\xb3\x3f\xc7\x6b\x8a\x1a\xfb\xaa\xcc\x91\xfd\x21\xaa\x8e\xc6\x2c\x93\xa1\x88\xa7\x30\x2b\xdf\x79\x35\xcf\xce\x16\x73\xee\x8d\x55\x89\x67\x66\x15\x1d\xf7\xb9\xd5\xaa\x9a\xa2\xac\x1d\xbf\xf6\xa8\x65\x96\x76\x9a\xd6\x4d\xd4\x69\xe8\x90\xd0\x63\xcf\x3f\xa7\x53\xd4\x01\xa8\xf7\x7f\x8e\x9a\xbe\xef\x31\x1f\xec\xfd\xee\xbf\x17\x6d\x08\xed\x08\x82\x25\x49\x20\xe1\x6f\x06\xe5\x99\x3e\x92\xb9\x0b\xbc\x7c\x0d\x85\x1f\xe6\xb8\x7c\x0d\x75\x71\x31\x48\x75\x26\x8e\xe0\xf2\x55\x7d\xcb\xea\xce\x79\x7c\x4f\x4d\x95\xf8\x2b\xc6\x7d\x15\x48\x45\x31\xc9\xc7\x37\xc5\x09\xed\xfc\x75\x30\x7c\x36\xf7\x4a\xc6\x83\x76\xe1\x5f\x0e\x71\x33\xff\x7b\x96\xd4\xa1\x93\xbe\xc4\xb1\xb5\xa2\xf0\x36\xc5\x4d\xe7\xd0\x08\xad\x64\x0b\x55\x42\xe8\x98\x75\x18\x29\x3b\xdb\x3e\xf9\xf6\x08\xc8\xe7\x3b\xc6\xdf\xd0\x5b\x96\xe8\x81\xe8\xa9\x32\xa3\x94\xa8\xf2\x94\x64\x08\x2d\x23\x6b\xf3\x31\x39\xcd\xe2\xd0\xba\x53\x6e\x09\x5a\x3b\xd2\x05\x26\x48\x7f\x8c\x11\xa5\x47\x33\x23\xd4\x8f\xab\x9b\x6a\x18\x18\x25\xd2\x42\x89\x8a\xa4\x9b\x3d\x6f\x67\xfb\x31\x3a\xae\x92\xe0\xb4\x1e\x46\x62\x74\xe7\xbb\x09\xe4\x0f\xdb\x0d\xcd\x89\xd1\x74\x6f\xca\x0d\x87\xa7\xbf\xea\xc3\x6c\x33\x9a\x7e\x3f\x3b\xde\x46\x10\xa3\x91\x75\xb4\xfa\x9d\xa9\xd5\x2a\xbd\xa8\xe2\x6c\x7a\x78\x6a\x1d\x03\x1e\x06\xd7\x77\x52\xfb\xc4\x3b\x78\x5c\x28\x63\xa6\x7b\xc0\xa3\xd3\x1f\xbb\x5f\x63\xf5\xdd\xab\x97\x63\x4c\xfe\x48\x09\xb5\x77\xca\xc9\x65\x18\xc9\xfe\x4b\xe8\x50\x4e\x57\x6c\xe8\x98\xd0\x2e\x61\x5b\x87\xdb\x77\xb4\xf5\xbf\x8f\xc6\xbd\xf3\x9f\x6b\xe1\x9a\xda\xe2\xe4\xe3\x86\x7f\x10\x37\x54\xf5\x2c\x29\xa8\x14\x5d\xe5\x46\x9e\x5a\x55\xbe\x44\xff\x83\x4e\x7b\xa2\x80\x0f\xf4\xdb\x2e\xa7\x4f\x50\xec\x4b\x94\x2c\x96\xf2\xae\xaa\xff\x09\x00\x00\xff\xff\xda\xaa\x3b\xf4\x24\x0b\x00\x00")
+var _templateBuilderDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\x6d\x6f\xdb\xc8\x11\xfe\x4c\xfe\x8a\x27\x82\x2f\xa0\x0c\x85\xf6\xdd\xb7\x26\x50\x81\x6b\xe2\xa0\x06\x9c\xb4\xbd\xb8\xed\x01\x41\x50\xac\x96\x43\x6b\xa1\xe5\x2e\xb3\xbb\xb4\x65\xa8\xfc\xef\xc5\x2c\x45\x8a\x7a\x89\x6d\xf4\xf2\x21\x26\x97\xf3\xb2\xf3\xcc\x33\x2f\xda\x6c\x2e\xce\xd3\xf7\xb6\x7e\x74\xea\x6e\x19\xf0\xcb\xe5\xcf\x7f\x7a\x53\x3b\xf2\x64\x02\x3e\x0a\x49\x0b\x6b\x57\xb8\x36\x32\xc7\xaf\x5a\x23\x0a\x79\xf0\x77\x77\x4f\x45\x9e\xde\x2e\x95\x87\xb7\x8d\x93\x04\x69\x0b\x82\xf2\xd0\x4a\x92\xf1\x54\xa0\x31\x05\x39\x84\x25\xe1\xd7\x5a\xc8\x25\xe1\x97\xfc\xb2\xff\x8a\xd2\x36\xa6\x48\x95\x89\xdf\x6f\xae\xdf\x5f\x7d\xfe\x72\x85\x52\x69\xc2\xf6\xcc\x59\x1b\x50\x28\x47\x32\x58\xf7\x08\x5b\x22\x8c\x9c\x05\x47\x94\xa7\xe7\x17\x6d\x9b\xa6\x9b\x0d\x0a\x2a\x95\x21\x4c\x0a\xd2\x14\x68\x82\xb6\xe5\xd3\xb3\x7a\x75\x87\xb7\x73\x2c\x84\x27\x9c\xe5\xef\xad\x29\xd5\x5d\xfe\x77\x21\x57\xe2\x8e\xb0\x55\x0d\x54\xd5\x5a\x04\xc2\x64\x49\xa2\x20\x37\xc1\xd9\xf1\x27\x55\xd5\xd6\x85\xfe\x53\xf7\x86\x2c\x4d\x26\x9b\xcd\x29\xc3\x17\xf1\x78\xf7\x3e\x49\xa7\x69\xbc\xe7\xd9\xa2\x51\x9a\x51\x79\x3b\x47\xed\x94\x09\xc8\x6a\xe1\xa5\xd0\x38\xcb\x3f\x8b\x8a\xa6\x98\x7c\xd8\x0f\xc1\x91\x24\x75\xdf\x69\x0c\xcf\x83\x99\xad\x50\xd5\x04\x11\x94\x35\x3b\xb3\x3b\xbd\x49\xde\x7f\x8d\xb0\xa4\x17\x17\x18\x5f\xa4\x6d\x39\x67\x9c\x84\xfe\xa4\xb4\x0e\x11\x47\x65\xee\x20\x58\x78\xef\x8a\x68\x5b\x90\x09\x2a\x3c\xe6\x69\x78\xac\xe9\xd0\x9a\x0f\xae\x91\x01\x9b\x34\x91\x11\x96\x34\x59\x5a\xbb\xf2\x88\xff\xbe\x7e\xfb\xab\xb5\xab\x34\x19\x2e\x0c\x9c\xb3\x7e\xfe\x69\x7b\xb0\xf5\x90\x26\xb5\xa3\x42\x49\x11\xc8\xe3\xeb\xb7\xe1\x25\xdf\x6c\x76\xd7\x48\x13\xad\x2a\x15\xd8\x2e\x70\xae\x4c\x48\xdb\x34\xc6\xf7\xef\x25\x39\x82\x28\x0a\x0f\x01\x43\x0f\x18\xf4\x11\x6c\x24\x57\x8c\x6f\x08\x39\x4f\xcb\xc6\x48\x64\x7b\x78\xb7\x2d\xce\xf7\x43\x9b\x76\x86\xb3\xda\x23\xcf\xf3\xd3\x77\x9a\x1e\x2a\x31\x10\x63\xbb\x6d\xbb\xd3\xf4\x98\x43\xd4\x35\x99\x22\xfb\xa1\xc8\x0c\xb5\xcf\xf3\x7c\x9a\x26\x8e\x42\xe3\x0c\xc6\x92\x8c\x54\x1b\x43\xbe\x89\x48\x44\x3c\xba\x6c\x9a\xa6\x5a\x90\xe3\xb2\xe1\x04\xea\xc6\xed\x12\xf8\x5f\x68\xfb\xd0\x45\x18\x96\x22\x40\xb8\x1e\x90\x02\x8b\xc7\x31\x17\x72\x7c\xb4\x0e\xb4\x16\x55\xad\x69\xc6\x7e\xf6\xc8\xa1\x85\xbb\x23\x88\xca\x36\x26\xf8\xe7\x5c\x29\x03\x5f\x09\xad\xb1\x10\x41\x2e\xc9\xe3\x41\x85\xa5\x6d\x02\xb4\x95\x2b\xa6\x1a\xfb\x7d\x58\x5a\x4d\x08\x62\xa1\x29\x67\x77\xd7\x06\x9f\x1e\xbf\xfc\xe3\x66\x06\x15\x98\xa7\xa2\xae\xb5\xe2\xd6\xe2\x59\x43\xe0\xe6\xfa\xd3\xf5\x2d\xa4\x16\x8d\xa7\x19\x84\x29\xb8\x7b\xd8\xb0\x24\x87\x42\x09\x4d\x32\xf8\x41\xd6\x37\x8b\xef\x0d\x71\x2b\xe9\x1a\x8c\x2a\xfc\x8b\x13\x1f\xe1\xcd\x22\xbc\x50\x26\x3c\x9b\x65\xb4\x6d\xde\x49\xcf\xf1\x3a\x3e\x3c\x93\xbf\x7f\x9a\x07\x27\x6a\x38\x5a\x28\x53\xec\xd7\x63\xcc\xd1\x83\xf0\x90\x8e\xc4\x36\x47\x02\xc1\x09\xe3\x85\xe4\x22\x12\x1a\x52\x2b\xee\xd8\x3d\xbb\x5d\x0c\x24\x2a\xfa\x20\x5c\xa0\x82\xc1\x64\xa3\x23\xb5\x19\x44\x19\xc8\x1d\x1e\x77\xae\x6c\x55\xa9\xc0\xce\xac\x83\xb3\x5a\xb3\x5b\x21\x57\x39\xfe\xd2\xc5\xec\x77\xd4\x59\x70\x27\xe7\xc2\x12\xec\x44\x6a\xcb\xbd\x7f\x6c\xb0\x14\x4a\xc7\x6c\xe3\xca\xb9\xdb\xf5\xfb\x28\xf1\x62\xe8\x3b\x64\xb2\x93\x90\x87\xf5\x0c\x76\xc5\x5d\xef\xc0\x4c\xde\xf5\x9e\xbc\x43\x22\xcf\xce\xc3\xfa\x43\x7c\x9c\xa6\x89\x2a\xf1\xca\xae\xb8\x2e\x93\x5a\x18\x25\xb3\x49\x3f\x2a\xda\xf6\xed\x89\xde\x68\x6c\x38\xc2\x7b\x2b\x31\x99\xa6\x49\x9b\x26\x4f\x3a\xc7\x1c\x61\x9d\x17\xee\xfe\x58\xae\xef\x82\xc7\x92\x4f\x72\xe5\x6a\x4d\x12\xb4\x26\xd9\x70\x0b\x19\xda\x19\x43\xdd\x11\x9c\xeb\xa0\xb3\xe0\xb1\xb4\x0f\xa8\x84\x79\xc4\x3d\xb9\xa0\x24\xd7\x1d\xed\xea\xfd\x54\x16\x4e\x25\x81\x5d\x66\x32\xac\x21\xad\x09\xb4\x0e\x3c\xf2\xf8\xef\x14\x99\x32\x61\x06\x72\xce\xba\x29\x63\xaa\x4a\x7e\xe1\x94\xc8\x25\xc9\xd5\xed\xfa\x30\xc3\xdb\x60\xa7\xef\xa2\xdc\xab\x39\x8c\xd2\xac\xd8\xc7\x7c\x19\xad\x45\x5c\xef\x85\xe3\x21\x9b\xb0\x60\xf4\x90\x26\x89\x28\x4b\x92\x4c\x4c\xee\xf7\x49\x97\x4e\x4d\xe6\xc8\x4b\x1c\x3a\x53\xcc\xe7\xb8\xc4\x66\xa4\x17\xad\xe3\x98\x30\xfc\x9e\x7f\x09\xd6\x75\x13\xbb\x0f\x98\x13\x0c\xd2\x9e\xa2\x11\xbe\x50\xd5\x04\xc4\x69\x65\x39\x61\xf1\x89\x3e\x36\x46\x66\x8c\xe4\x29\x8c\x66\xa8\xd0\x8f\xb7\x29\xb2\x7f\x09\xdd\xd0\x18\xb1\x64\x98\x86\x3d\x99\xab\x3c\x3b\x39\x15\xa7\x2c\x3c\xa2\xef\x80\x99\x51\x7a\x86\xb2\x0a\xf9\x15\xa3\x54\x66\x93\xc6\xd0\xba\x8e\xf1\xa2\x37\x8e\x38\xac\x7f\xba\x9d\xcc\x50\x45\x43\x2d\xff\xb7\xb7\x3d\xb4\x2d\xe6\x83\x7c\x9a\xfc\x11\xd0\x86\xab\xed\x99\x48\x93\xa4\x65\xdf\x3c\x45\x14\x47\xfa\x44\xe6\xde\xe0\xe7\x77\x50\xf8\xf3\x1c\x97\xef\xa0\xde\xbc\x19\xa0\x3a\x71\x8f\xa8\xf2\x55\x7d\xcb\xaa\x26\xb0\x7d\x0e\x4d\x95\xf8\xcf\xac\xe7\x62\xd5\x84\x6e\xc5\x20\xce\xd0\x0c\x07\x61\x1f\x93\xf1\x90\x8d\x4c\xc7\x36\x3d\x1d\xd4\xae\x2a\x7f\xe7\x19\xa5\xd5\x8a\xe2\xdb\x0c\x8b\x26\x20\xf6\x18\x0f\x55\x42\x18\x16\xb7\x0e\x56\xca\xc6\xbd\x7c\xf8\xb0\xad\xdf\x4f\x57\x1f\xaf\x7a\x9b\x34\x31\x43\xa0\x87\xc8\x8c\x52\xa2\xca\xc3\x20\xe3\xd5\x32\x72\x6e\x3a\x0e\xce\x70\x9b\xd9\x6c\xba\x8e\x4d\xeb\x40\xa6\xc0\x19\x26\xdb\xc6\x3f\x19\xdf\xad\x6b\x69\xa1\xaa\xf5\xb0\x79\x96\x98\x6c\x27\xef\xc5\x4f\xfe\xa2\xdf\xc7\xc7\x2c\x89\x4a\xeb\x61\xb7\xee\xd4\xf3\xed\xbe\xcb\xce\xb6\xdb\xf7\x99\x35\xd4\xbb\xda\xed\xb5\xfd\xc9\xe4\x6f\x66\xb7\x24\x5b\x43\xbf\x9d\xdc\x93\x47\x26\x46\xbb\xef\xde\xe9\x33\xeb\x2f\x2f\x0f\x9a\x30\xde\xf1\x8e\xd7\xdf\x7d\x83\xbb\x0d\xf8\x99\xd4\xbe\xb0\x9f\x8f\x89\x32\x8e\xb4\x37\xb8\xe7\xfd\xa9\x5e\xdd\xb1\xef\x88\x2f\xfb\x36\xf3\x27\x28\xe4\x1f\x54\x90\x4b\x8e\x4c\xf2\x4f\xaa\x1d\x9d\xde\xee\xfa\x77\x2c\xf3\xf8\xd9\xc4\xee\xbb\xd9\x30\xf7\xe9\xfb\x8e\x03\x1d\x8e\x13\xff\x5d\x73\x02\xf1\xfa\x35\x5e\xf9\xef\x3a\xbf\xf6\x1f\xdc\xe3\x6f\x8d\xe1\xbb\x4f\x07\x2a\x8c\x2c\xbf\xfe\x6c\xc3\x47\x5e\x36\x62\x97\xdb\xe0\xe0\x47\x56\x7e\x23\x16\xa4\xdb\x34\x29\xa8\x14\x8d\x0e\x23\x4d\xa3\x74\x9a\x8c\xe1\xfe\xbf\x0b\xf5\x85\xf8\xff\xa0\x5c\xb7\x94\x78\x01\xe0\xd1\xc0\x74\x5b\x89\x64\x0a\xb4\x6d\xfa\xbf\x01\x00\x79\x1c\xf7\xa4\xac\x0f\x00\x00")
 
 func templateBuilderDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -181,12 +184,12 @@ func templateBuilderDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/delete.tmpl", size: 2852, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/delete.tmpl", size: 4012, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderMutationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x5b\xdb\x73\xdb\x36\x97\x7f\x26\xff\x8a\x53\x8d\x9b\x11\xbd\x2a\x95\xf6\x6d\xdd\xd5\x43\x36\x4e\x77\x3d\xb3\x9b\xec\x34\xee\xbe\x78\x32\x2d\x4d\x1c\x59\x18\x53\x24\x0b\x50\xb2\x3d\xfa\xf4\xbf\x7f\x83\x03\x80\x04\x78\xd3\x25\x49\xd3\xaf\x0f\x1d\x8b\xc4\xe5\x5c\x7e\xe7\xce\xec\x76\xf3\xcb\xf0\x6d\x51\xbe\x08\xfe\xb0\xaa\xe0\xa7\xd7\x3f\xfe\xfb\x0f\xa5\x40\x89\x79\x05\xbf\x24\x29\xde\x17\xc5\x23\xdc\xe4\x69\x0c\x6f\xb2\x0c\x68\x91\x04\xf5\x5e\x6c\x91\xc5\xe1\xed\x8a\x4b\x90\xc5\x46\xa4\x08\x69\xc1\x10\xb8\x84\x8c\xa7\x98\x4b\x64\xb0\xc9\x19\x0a\xa8\x56\x08\x6f\xca\x24\x5d\x21\xfc\x14\xbf\xb6\x6f\x61\x59\x6c\x72\x16\xf2\x9c\xde\xff\xcf\xcd\xdb\x77\xef\x3f\xbe\x83\x25\xcf\x10\xcc\x33\x51\x14\x15\x30\x2e\x30\xad\x0a\xf1\x02\xc5\x12\x2a\xe7\xb2\x4a\x20\xc6\xe1\xe5\x7c\xbf\x0f\xc3\xdd\x0e\x18\x2e\x79\x8e\x30\x59\x6f\xaa\xa4\xe2\x45\x3e\x01\xf3\xe2\xa2\x7c\x7c\x80\xab\x05\xdc\x27\x12\xe1\x22\x7e\x5b\xe4\x4b\xfe\x10\xff\x5f\x92\x3e\x26\x0f\xa8\x16\xed\x76\x50\xe1\xba\xcc\x92\x0a\x61\xb2\xc2\x84\xa1\x98\xc0\x05\x6d\xe7\xeb\xb2\x10\x15\x4c\xc3\x60\xb7\xfb\x01\x44\x92\x3f\x20\x5c\xe4\xea\xb4\x8b\xf8\x7d\xc1\x50\xaa\x55\x41\x30\x51\xd7\x74\x4f\x9e\xab\xc7\xb9\xf3\x60\xa2\xcf\xc1\x9c\xd1\xe9\xc1\xe4\x81\x57\xab\xcd\x7d\x9c\x16\xeb\xf9\xd2\x88\x9a\xe7\xe9\xe6\x3e\xa9\x0a\x31\xc7\xbc\x9a\x84\x51\x18\xa6\x45\x2e\x89\x86\xf9\x1c\x3e\x94\x28\x88\x3d\xa8\x5e\x4a\x94\x71\x18\x7c\x28\xdf\x0a\x54\xa4\x03\xc0\x02\x30\xaf\x62\xfb\x44\xbd\xbb\xc6\x0c\xfd\x77\xfa\x49\xf3\xee\x43\x8e\xad\x77\x1f\x72\x7a\xfd\x5b\xc9\x5a\xc7\xea\x27\xcd\x3b\x77\x6b\xfd\x24\x24\x3a\x95\x70\x6a\x12\x47\x65\x77\xfb\x52\xa2\x96\xd3\xfb\x64\xad\x84\x04\x0b\x98\x78\x0f\x7c\xa9\x45\xa4\xd4\x81\xe3\x48\xdf\x16\x01\xf4\x2e\x8f\xff\xd7\xfc\x34\xa7\x85\xf3\x39\x78\xab\xf6\x7b\x10\x68\x00\x2f\x21\xc9\xa1\x68\x64\xbc\x4a\x2a\xa0\x85\x48\x80\xdc\xed\xa0\xcc\x36\x22\xc9\x1c\xea\xd4\x79\x39\xdd\x6f\x50\xfb\x20\x92\x72\x15\x87\x8a\xf9\xce\x45\xb2\x12\x9b\xb4\x82\x5d\x18\xa4\x04\x96\x30\x28\x4a\xf8\x50\x86\x41\xf5\x52\xaa\x97\x3c\x7f\x50\xcc\xaa\xe3\x6f\xae\xe3\xff\xdc\xf0\x8c\xa1\xf8\x85\x63\xa6\x58\x87\xcb\xfa\x8d\x12\x1a\x89\xcf\x11\xed\xd2\xf0\x4b\xcb\x8d\x70\xd5\x86\x65\xff\x39\xcb\xe6\x10\x3a\x85\x2f\xed\xb3\xf8\xfd\x66\x8d\x82\xa7\xfa\x5d\x90\x30\x76\xc2\x31\x46\x4b\xde\xdf\x69\x86\x89\x40\x66\x08\x5b\x27\xe5\x9d\x66\xf5\x93\x16\xc7\xce\xe7\x03\x0d\x1f\xef\xd8\x03\x4a\x9f\x3e\x8c\x7f\xcb\xf9\x9f\x1b\xba\x0e\x9c\xff\x14\x21\xd8\x4f\x1f\x6a\x96\x5c\x99\x05\x96\xa0\xfe\x6d\xf7\x45\x91\x59\x66\x32\x79\xe4\x5d\x8a\xa9\xde\xeb\x1c\x1e\x83\x40\xe0\xba\xd8\x0e\xdd\x7b\xd4\x11\x03\x22\xde\x87\xe1\x36\x11\xf0\x3b\x19\xa3\x05\x3c\x2c\x60\x7a\xd9\x42\x60\x34\xcd\x79\x16\x85\x04\x5a\x7c\x6a\xc3\x33\x25\xbf\x21\xd5\x2b\xa8\x9f\x2f\x0b\x61\xe1\x1e\x87\xcb\x4d\x9e\xf6\xec\x9c\xa6\xa0\x01\x3d\x03\x02\x74\x04\xed\x8b\x15\xe6\x05\x56\x1b\x91\xc3\xab\xd6\xab\x5d\x18\x18\x73\xb8\xb2\x42\x4e\x67\x61\x10\x14\xe5\x95\x2b\xf8\xa2\x54\x0f\xab\x17\xef\x69\xc7\x7b\xa8\x35\x1e\xde\xae\x60\x9d\x3c\xe2\xb4\x07\x75\xd1\x2c\x0c\x48\x74\xf3\x39\xbc\xcd\xb8\x8a\x77\x9a\x42\x09\x09\x89\xe0\x0f\x25\x4d\xfd\xe6\x0f\x58\x8a\x62\x4d\xf6\x6d\x29\x8f\xe1\x66\xe9\x3d\x80\xa7\x44\xaa\xb3\xf0\x19\xd3\x4d\x85\x4c\x39\x84\x04\x2a\x91\xe4\x32\x49\x69\xc1\x54\x1d\x78\xfb\x1c\xcd\xfc\xe7\x49\x06\xa9\xbe\x9f\x4b\x43\x82\x8a\xac\x24\xeb\xe9\xba\xed\x44\x22\x43\xec\x34\x82\x4b\x43\xb6\xf2\x27\xfa\xaf\xab\x05\xbc\xd2\x0f\x77\x56\xa4\xeb\x58\xff\xb5\xb7\x8b\x62\x9e\xf3\x6a\x1a\xd5\xfa\xd0\x4f\x8d\x20\x6e\x9f\x1b\x21\xe4\x5a\x02\xb7\xcf\x7f\x10\x08\x2c\x0d\x52\xfb\xc5\x27\x14\xe8\xf1\xea\x70\x24\x7f\x56\x67\xf1\xca\x3d\x0b\x85\x28\x04\x14\xd5\x0a\xc5\x13\x97\x38\xc2\xdf\xed\xf3\x34\x82\xe9\xe5\xed\xf3\x4c\x6f\x8a\x14\x83\x7c\x09\xc1\xef\x33\x28\x1e\x15\x8f\xeb\x98\x09\xbe\x45\x11\x4f\x2f\xab\xe7\x6b\xfa\x33\xfa\x19\xbe\x2b\x1e\xd5\x4a\xcb\x57\xce\xb3\x19\x2c\xd7\x55\xfc\x4e\x1d\xb2\x9c\x4e\x6c\x32\xb0\xdf\x5f\x35\x4a\xe3\x12\xf2\xa2\x02\xb1\xc9\x73\x9e\x3f\x74\x74\x36\x89\x14\x48\x82\xea\x99\x44\x7b\xfb\xdc\x27\xd6\xea\xb9\x2d\xd2\xea\x79\xa6\xae\x0f\x29\x22\x69\xdf\x45\x7e\xfb\x37\x89\xe2\x9a\x12\x15\x6d\xc2\xf3\x39\x7c\xc4\xea\xe6\x1a\x24\x56\x92\xc0\xb4\x4d\xb2\x0d\xea\x54\x07\x81\x33\x58\x2a\x10\xc7\xf0\xbe\xa0\x10\x94\x54\x33\xca\x81\x68\x67\x13\xa7\xb8\x84\x24\x4d\xb1\x54\x8a\x28\xf2\xec\x05\x8a\x1c\xfc\x98\x4a\x96\xad\x40\x1b\x06\x56\xec\x1d\xd7\xa0\x49\x99\x72\x06\xed\x38\x43\x0a\x08\xd6\xf1\x60\x64\x5a\xc0\x2b\xce\x94\xa0\xdc\xf4\x66\x3e\x87\x9b\xeb\x1a\x01\x86\x1f\xcd\x9f\x09\x95\x8d\x29\x79\xfc\xa9\x85\x84\x1f\x09\xc9\x36\xe1\x59\x72\x9f\xa1\xe6\x8b\x2f\x15\xa8\x9e\x12\x09\xa5\x28\xb6\x9c\x21\x83\xaa\xa0\x1d\xf7\x9a\xa2\x06\x55\x5d\xf6\x6e\xae\x15\xac\x7a\xd8\x9b\x01\x3e\x73\x59\x49\x72\xfd\x16\x6c\x63\xdc\x2e\x94\x72\x1d\xa8\x11\x44\x8c\xea\x2f\x87\x37\xce\xa0\x12\x1b\xd4\xa0\x18\x89\xda\x04\x53\xf5\x58\x60\x8a\x0a\xda\x75\x60\xfe\x48\x0e\x4c\xb9\xcc\x9d\x12\x05\xfe\xa9\x16\x4e\xd6\x13\xf2\xaf\xb4\x6b\x01\x13\x92\xb0\x7d\xd4\xc4\x0a\xb8\x20\xc9\x5c\x2d\xa0\x14\x3c\xaf\x60\xf2\x11\xab\x89\x3a\xf9\x23\xb9\x43\x4b\xa3\x5e\xaa\x53\xce\x7a\xad\x93\xc4\x4e\x62\xda\xf4\x56\x2d\x48\xf2\xca\xa2\xb8\x3e\x5f\x25\x39\x16\xcb\x3a\x3f\xb0\x10\xd4\x48\x1e\xc3\x9f\x73\xc8\x54\xb3\x63\xf8\x5a\xf6\x01\xb1\x9b\x90\x2c\x74\x68\x29\x9b\xa4\x61\x7e\xa9\xa8\xa9\x94\xd0\x72\x93\xd4\x50\x5e\x56\x6c\x51\x08\xce\x10\x4a\x81\x5b\x5e\x6c\x24\xa4\x49\x96\x49\x05\xa6\x37\x8c\xc5\x40\x55\xc5\x81\xbc\x68\x1d\x0f\x66\x46\x84\x8f\x56\xb4\xde\x87\x8d\xa0\xea\x10\xfd\x5f\x58\xe9\xfc\xb3\xb1\x11\x5f\x68\xfd\xe6\x72\x50\x88\xad\x0b\x14\xee\x85\x2f\xc9\x2e\xe6\x83\xad\xf6\xac\xbd\x2c\x85\x81\xb2\x89\xad\x0b\xfd\x1a\xfb\x0a\xfc\x35\xfa\xb7\x06\xe4\xc4\xaf\x86\x69\x92\xb3\x7e\x11\xf6\x80\xf2\x0d\x63\xbd\xa0\x6c\x63\x2c\x61\x4c\x42\x8d\x91\xaa\xf0\xc5\x16\x87\xc1\x17\x80\x99\xf6\x02\x83\x4a\xfe\xce\x11\x45\x70\x39\xb2\xf0\xdf\x16\xe0\xc0\x32\xd8\xeb\xb4\x52\xef\x1b\x05\xd1\x2b\x6f\x1b\x09\x5a\x4b\xe2\x0d\x63\x68\x76\xf9\x82\xf2\x90\xa4\xb1\xa3\x63\x75\x22\x95\xc8\x1a\x77\xd9\x63\x9a\x1a\x65\x5c\xba\x30\x1b\x91\xe2\x20\x0d\xc7\x81\xcd\xa2\x6d\x88\x7d\x23\x7f\x1f\x71\x0d\xe4\x82\x7d\x83\x40\x07\x74\x81\xf6\x5f\x75\x81\xad\x01\x78\xb1\x8c\x3f\x94\x26\xd1\x1a\x02\xde\x5b\x95\x33\x1e\x05\x3d\xca\x2e\x5b\xc1\xfa\x4c\xf4\x19\x51\x0c\xfb\x33\xed\x46\xc6\xfd\xd0\x31\x8e\xc8\xf3\x44\x6a\x87\x97\x22\xdf\x35\x2e\x7f\xbf\xff\x04\x0b\xb0\x19\xf2\xae\x06\x5d\x4d\x75\x1d\x12\x7c\x41\x69\xf9\x21\x9b\xf4\x8a\xcc\xa2\x92\xeb\xb4\x46\xc3\xcd\x87\xa0\x42\xa8\x21\xea\x44\x20\xfa\x02\x55\x08\xd3\x52\x75\x92\xc5\x11\x6e\x1d\x18\x15\x8f\xbd\x00\xb2\x7c\x3b\x5e\xf5\x57\x94\xd8\x1b\xfa\x04\xbd\x48\xb2\x0c\xd2\x95\x8a\xef\xd2\x66\x72\x13\x8f\xdb\xc9\x89\xc1\xf0\x50\xd8\x6b\xa2\xcd\x97\x8a\x56\xce\x61\xbe\xe9\x04\x8c\x7a\x44\xd3\x96\x50\x67\xe0\x4a\x35\xea\xc4\x3e\x47\xa6\x4e\xf2\xd3\x2d\xf5\xd5\x29\x05\x25\x3f\x93\x84\x11\x9a\x8c\x09\x3b\xa5\xbf\x59\xb3\x80\x89\x54\x29\x0c\x3d\x70\xf3\x1c\xce\xe4\x2f\x9e\x71\x4f\xcb\x44\xa6\x49\xa6\x76\x45\x30\x95\x3c\x7f\xd8\x64\x89\x50\x67\x92\x3e\xfe\x01\xfa\x7d\x04\x93\x9b\x6b\x39\x7c\xa7\x3d\xb7\xff\x58\xfb\x43\x1f\x4a\x67\xb5\x68\x33\x58\xb1\xc7\x98\xa0\x53\x28\x07\xdf\x84\x7e\xac\x2d\x02\xd9\x03\xda\xc8\x66\x7a\x02\xf6\xd5\xfd\x0b\x70\xa6\x89\x54\xb5\x8b\x4b\xa8\xac\x2f\x3c\x88\xae\x86\x90\x69\x97\x61\x3a\xdf\xf4\x3f\x38\x93\x10\xc7\x71\x7d\x32\xf4\xb6\x29\x34\x48\xfb\x5a\x35\xb5\x8b\xeb\x36\x3d\x4c\xe1\xe0\x75\x5b\x6c\xe4\x1d\xd8\xe1\xc6\x83\xe1\x63\xeb\xa2\x7f\xbc\xa5\x12\xd5\xb1\x44\x55\xb8\x9c\xb2\x6e\xc2\xa6\xe2\x79\xf4\x8e\x3b\xce\xe4\x1d\xff\xd4\x71\x97\x41\xbb\x4d\xb3\xaf\xc3\x90\x2f\x93\x91\x20\x84\xa7\x04\xa1\x63\x51\x73\x46\x58\x1a\xed\x93\x2d\x9a\x98\xdb\x1b\x20\xf0\xfc\x00\x41\x4c\xf8\x7c\x39\xf1\xe1\xbc\x70\x60\x9c\xfc\x38\x53\x4e\x8e\xa5\xdf\xfb\x7a\x68\x95\xb3\x3e\x85\x9c\xf5\xe4\xe9\x07\x08\xed\x5e\xe0\x94\xa8\x1d\xd4\xf6\xa5\x51\x23\x96\xf2\x5d\x37\x73\xb2\xd5\x69\x67\x71\x9d\x40\xb9\x89\x55\x13\x0e\x6b\xcb\xac\x6b\xd3\xac\x78\x42\x01\x53\xd2\xf5\x12\x26\xdf\xc7\x3f\xca\x89\x87\xb8\xa8\xd9\xd0\x71\xc8\x93\x5f\xa9\x11\x3a\x39\xca\x19\x37\xea\x70\x3c\xa7\xee\xa4\x9e\xe3\x36\xe5\x61\xad\x38\x8e\xb1\x71\x7d\x43\x0e\x4f\x6b\x60\xb4\xb3\xdb\x72\x59\xe3\x6b\x4f\xf7\x5c\x03\x2e\xf7\xc0\x4d\x77\x9c\x75\x7d\x57\xcb\x0d\x0f\x3b\xc5\xc3\x87\xf7\x3b\xc7\x86\xe2\x3a\xd3\xf0\xdd\x47\x1b\x23\xec\x28\x77\xe8\x5a\xa5\xa1\x8b\x88\x35\xa9\xf9\xe9\x3e\xf0\xe6\x5a\x6a\x4b\x94\x70\xf7\x69\x4c\xfb\x24\x21\xd6\x88\xe8\x80\x7a\xb5\xf4\xd4\xb1\x0b\x48\xca\x12\x73\xa6\xee\x98\x01\x67\xd1\xa0\xf1\xd9\x5c\x74\xd0\x29\xc9\x51\xaf\x24\xbb\x6e\x49\xcf\x31\xfa\x50\x43\xa3\x3b\xd3\x8a\xa3\xbd\x49\xf6\x94\xbc\x34\x17\x64\x98\x2b\x82\x23\xf8\x8f\x05\xfc\x48\x5d\xe1\x8d\xde\xad\xcc\x4e\xce\xa8\xd6\x7f\x29\x36\x20\x57\xc5\x26\x63\xb0\x91\x38\xea\x4d\x79\x2e\x2b\x4c\x58\x0c\x37\x95\xf5\x6d\xd4\xe7\x23\xa9\xe6\x15\x0a\x95\x77\x6e\x64\xf2\x80\xca\x78\x9d\x3e\x9f\x1d\x2b\x5a\x14\x9d\xea\x66\x8f\xd1\xae\x92\xd2\x90\x71\xf1\xa5\xd1\xfa\x80\x3f\xfd\x59\xbd\xf6\x1c\x70\x57\xe7\x97\x8e\xd2\x5b\x86\xd7\x45\xd5\xd9\x70\x32\x52\xda\xef\xbd\x6e\x65\xe8\xb7\x04\x2f\xf0\x73\x6b\x1b\x6c\x6a\x1b\x05\x85\xb3\x4a\x9b\x3e\x6f\xe8\x97\x36\x9d\xac\xf2\x40\x86\xb2\x4c\x32\x42\x60\x4b\xbc\x07\x7d\x70\x5f\xfb\xce\x2d\x61\x68\x12\xef\x59\x5d\xd3\x8c\xcf\x9b\x41\x59\x2f\xf7\x1f\xca\xa9\xfa\x9f\x33\x10\x5b\xc7\x45\x69\xc7\x2f\x0a\x7e\xee\xb9\xb9\x1d\xa4\xd7\x9f\x3f\xd4\x87\x51\xf3\xaa\x6e\xf1\x47\x63\x77\xaa\x63\xa7\x91\x99\x30\x7b\x37\x57\x2f\xf6\x6a\xd3\x81\xae\x27\x36\x59\xa6\xab\x54\x77\xdc\xa3\x35\xcf\x80\x6d\x68\x54\x3d\x9f\xb7\x0a\x75\xb7\x8f\xcf\x73\x28\x04\x7d\xfe\x51\xc0\x83\x41\x8e\x69\xc2\xaa\x8d\x9d\xb3\x79\x3e\x67\x98\x0a\x5c\x63\x5e\x21\x9b\x51\x47\x56\x77\x99\x34\x65\xd3\x51\x0e\xed\x1a\xb8\xfb\xd4\x70\x69\xee\xb8\x32\x41\xd5\xbe\x9a\xc1\x6b\xaa\x57\x33\xcc\xbd\xd6\x7b\x74\xc4\x3c\xfd\x87\x53\x9b\xe3\x4d\x86\x36\xde\x42\x34\xb4\xd6\x76\xbc\x1c\xa8\xab\x5b\xb3\x75\xa3\x48\xbd\xda\xd5\x64\x4f\x2b\xb0\x58\x42\x62\x9a\x2f\x4f\xbc\x5a\xe9\xaf\x15\xf8\x16\x2d\x66\x15\xfe\x56\x08\x12\xd3\x22\x67\x94\x64\x62\x92\xd7\x2d\x68\xc6\x53\x1a\x0e\x93\xc6\x48\xed\xe6\x28\x3d\xf5\x54\x85\xa8\xc4\x6a\x06\x85\xa0\x64\x5d\xfd\x36\xdf\xe4\x98\xf8\x23\xd3\x15\xae\x93\x83\x4a\x9c\x2a\x62\x0c\x54\x23\x3d\x32\xfd\x7f\x45\xc2\xac\x49\x7b\xe5\x13\xaf\xd2\x15\x51\xad\x7e\x7e\x05\xa5\xa5\x89\x44\x4f\xf4\x57\x4e\x0d\x51\xeb\xb3\xdd\x65\x0f\xdb\x85\x9f\x37\x89\x24\x5f\xa4\x35\xf4\x11\x4d\x2c\x6a\xcd\xfd\x94\xdf\x6f\x69\x45\x85\xc6\x66\x8a\x4a\xf3\x65\x1a\xa4\x7a\xbd\x34\xee\xca\x9b\xf9\x02\x27\x8d\xe8\xd5\x64\xb0\xca\x97\xac\xb9\x5c\x27\x4a\x84\xcd\x11\xea\xf9\x98\x6e\x2c\xc9\xae\x7a\x66\x86\xec\x5a\x47\x91\x21\xee\x1b\xea\x68\x6b\xdb\x7e\x44\x5a\x3c\xf5\x1b\xfb\x26\x76\xdb\x49\x71\xad\x52\x77\x4c\xbc\xc9\xf1\xb9\xc4\xb4\x42\x2d\x14\xf8\xfe\x96\xf4\xa2\xc5\xf4\xbd\x9c\x18\xae\x67\xc4\x5b\x93\xb6\xad\xe3\x8f\x58\xf5\x36\xc4\xb7\x91\x03\x1e\x0a\x2d\xfd\x30\xf1\x89\x78\xcc\x8b\xa7\xf6\x18\xd7\xa1\x41\x5f\xae\xe1\xe4\x78\x49\xcf\x7f\xdb\x99\x57\x8f\xaf\xad\x1d\xad\xda\x5f\x08\x70\x5c\xaf\xf1\xee\xed\x1e\xec\x81\x51\x40\xaf\x03\x6e\x86\xdf\xff\x9d\x48\xaf\x21\xb9\x4d\x84\x25\xcb\x6e\x30\x30\x18\x41\xc9\x81\x46\xf8\x39\x20\x3a\x69\xca\x73\xb4\x93\xee\xab\x73\xbc\x1f\xbe\xdb\x6e\xe5\x27\x03\x48\x69\xeb\xda\xcf\x13\x8c\x28\x5a\x43\x1f\x3f\xa8\x52\xdc\x15\xc5\x7a\x34\x0c\xb8\x31\xa0\xe5\xfb\x75\xc0\xef\xb8\xff\x2f\xe2\xfb\x1b\xbe\x8e\x08\x00\xc3\xb8\x6a\xb9\x9d\x6f\x82\xa8\x7e\xc7\xe4\xb4\xa0\x46\x66\x67\xe3\xb0\xe9\x0f\xfe\x9d\xf0\xf2\x86\x19\x84\xd0\x98\xf4\x5f\x22\xbc\x58\x92\xbf\x5e\x78\x19\xd4\xf2\x59\x4a\x1e\xd0\xf1\xe1\xe8\xe3\x87\x9f\x2f\x13\x7f\x02\xdb\xf0\x79\xc3\xfa\x61\xa5\x23\x90\xe7\x58\x06\xbf\xd6\x3c\x25\x1e\x79\x01\xa6\x15\x97\xf4\x67\x75\xee\x97\x9f\x7e\x68\xca\xf4\x77\x3d\xdd\x1a\x43\xef\x51\xdb\x4f\x0d\x44\xde\x75\x63\xa1\xc8\x9f\x69\x7d\x6e\x2c\x6a\x4d\xc8\x3e\x27\x0e\xd1\x0d\x86\x8d\xa9\x17\x55\xfe\x46\x21\xc8\x25\xd2\xf9\x64\xd2\x16\x0c\x4d\xa9\xc0\x97\x3d\x85\xc2\xf0\x94\xf7\x40\x61\x60\xc5\xe2\x85\x07\xdb\xe0\x1f\x9c\xf6\xaa\xd5\x9f\x42\x67\xc6\xbb\x6f\x90\xa9\xed\xa3\x33\xd0\xff\x1a\x6e\xf2\x20\x6c\x7b\x42\x5f\xed\xec\x46\xb0\x7b\x7e\xbc\xfb\x32\xa8\x1d\x8a\x75\xeb\x98\xb8\x1a\x0e\x72\x2d\x6f\x74\x72\xd0\x3b\xc6\x39\xb9\x2e\xa6\xc7\x3b\x51\xab\xcb\xa6\x52\x54\x89\xb9\xdd\xad\x56\xd7\x14\x04\x3e\x24\x82\x69\x7f\x44\xa1\x4e\xc3\x43\x1f\xde\x03\x92\x61\x84\x90\x6b\x3b\x15\x24\x0d\xb1\x03\x20\xf9\x56\x05\x57\xbb\x18\xb6\x0d\xc4\xe9\x5f\x52\xf5\xe8\x4f\x07\xdc\xc8\x42\x03\x20\xb5\xce\x0d\x2a\x12\xab\xb9\xfe\x00\xca\xb8\x1d\x75\xc0\xd1\xb5\x0d\x5d\xd2\x8a\x27\xd4\xf0\x3e\xd4\x5a\xb2\x1f\x36\x44\x87\xfe\x85\xc3\x91\x63\xbc\x63\xb4\x86\x6d\x1b\xd5\x94\xd6\x01\xc3\x74\xea\x8f\xeb\x2b\xd1\x62\x57\xde\xee\xb4\x41\x49\x9b\x33\x09\xd3\xaa\xd0\xdf\x75\xeb\x7f\x08\x13\x39\x72\xd7\x32\x5f\x16\x42\x97\x0e\xd6\xa7\xd6\x3a\x3a\x28\xfa\x9b\x6b\xe9\xe3\xfd\xee\x53\x9d\x0e\x8e\xa3\x7e\xe0\xdf\x91\x9c\x2a\xbe\x7e\xd0\x0f\xcd\xdc\x4e\x9f\x0b\x58\x49\x3b\x7c\xed\x2e\x39\x6b\x4f\xcb\x9c\xa1\x1c\xf7\x5a\x9a\x4e\x79\xf4\x7a\x46\xf3\x99\x81\xab\xa3\xa8\x19\xe9\x1d\x3d\x5a\x18\x9e\x2d\x98\x9c\xd3\x50\xcf\x55\x0a\x71\x4c\x4e\x69\xbe\x8a\x27\xef\x4b\x2d\xf8\x23\x0d\xb8\x9e\xea\x9d\x66\xbe\xee\x25\x5f\xd5\x80\x0d\x20\xda\x9f\xe3\x1c\x37\x20\xf6\x00\x71\x96\x8d\x1f\x69\xe4\x9d\x8f\x53\x0e\x98\xbc\x11\xdf\x89\x46\x6f\x75\x75\x9e\xd9\x37\x77\x7e\x59\xc3\x1f\xd0\xce\x59\xe2\x1e\xc8\x7a\x0e\x5b\xe6\x18\x0c\x06\x0d\xf4\x88\x89\xf2\x69\x76\x7a\x8a\x99\x9a\xac\xfb\x48\x33\x6d\x25\xf7\xc7\x9a\xa9\x7b\xc9\x5f\x61\xa6\xbd\x26\x3a\x3a\x4b\xfc\xfb\xd9\xa6\xe2\xea\x94\x22\x8c\xf4\xf5\x19\x35\x98\x73\x5f\x7f\x09\x76\x8e\x45\x7e\x4d\x6b\x3c\xf6\x8b\xaf\x23\x1a\x21\x4e\x6f\x8d\x44\xa0\x18\xf9\x12\x75\x63\x6d\x43\x9f\x57\x3b\x2a\x72\x0e\x96\x8e\x8e\xf0\x47\x8a\xc6\x1e\x55\x0d\x26\x3b\xe7\x59\xc3\x11\x15\x63\xfb\x7b\x8d\xbf\xaa\x62\x74\xbe\x65\xe9\x96\x1b\x54\xd7\x90\xe2\xcf\x2f\x16\x9b\x00\x38\x56\x2b\xd2\xaa\xcf\x2d\x15\x47\x30\xf1\x8d\x72\x66\x9b\x69\x7e\xbd\x42\xb1\xab\x38\xe7\xa3\x8d\xe6\xcf\x7f\x06\x00\x00\xff\xff\xfd\x5d\xba\xf1\xf2\x42\x00\x00")
+var _templateBuilderMutationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5c\x5b\x73\xe3\xc6\x72\x7e\x26\x7e\x45\x2f\x6b\xbd\x05\x28\x34\x68\x9f\xb7\xc8\xd1\xc3\x66\x65\x27\xac\x8a\x77\x9d\xb3\x72\xf2\xa0\xda\x3a\x86\x80\x86\x38\x11\x08\xc0\x98\x21\x25\x86\xe6\x7f\x4f\xf5\x5c\x80\x19\xdc\x08\x72\xd7\xc7\xce\x71\xb9\xca\x22\x30\x97\x9e\xee\xfe\xfa\x36\x0d\x1f\x0e\xcb\x2b\xef\x5d\x51\xee\x2b\xf6\xb8\x16\xf0\x97\x6f\xbe\xfd\xe7\xaf\xcb\x0a\x39\xe6\x02\x7e\x88\x62\x7c\x28\x8a\x27\x58\xe5\x71\x08\x6f\xb3\x0c\xe4\x20\x0e\xf4\xbe\xda\x61\x12\x7a\x77\x6b\xc6\x81\x17\xdb\x2a\x46\x88\x8b\x04\x81\x71\xc8\x58\x8c\x39\xc7\x04\xb6\x79\x82\x15\x88\x35\xc2\xdb\x32\x8a\xd7\x08\x7f\x09\xbf\x31\x6f\x21\x2d\xb6\x79\xe2\xb1\x5c\xbe\xff\x8f\xd5\xbb\xef\xdf\x7f\xfc\x1e\x52\x96\x21\xe8\x67\x55\x51\x08\x48\x58\x85\xb1\x28\xaa\x3d\x14\x29\x08\x6b\x33\x51\x21\x86\xde\xd5\xf2\x78\xf4\xbc\xc3\x01\x12\x4c\x59\x8e\x30\xdf\x6c\x45\x24\x58\x91\xcf\x41\xbf\x78\x5d\x3e\x3d\xc2\xf5\x0d\x3c\x44\x1c\xe1\x75\xf8\xae\xc8\x53\xf6\x18\xfe\x14\xc5\x4f\xd1\x23\xd2\xa0\xc3\x01\x04\x6e\xca\x2c\x12\x08\xf3\x35\x46\x09\x56\x73\x78\x4d\x6f\x3c\xb6\x29\x8b\x4a\x80\xef\xcd\x0e\x87\xaf\xa1\x8a\xf2\x47\x84\xd7\x39\xad\xf6\x3a\x7c\x5f\x24\xc8\x69\xd4\x6c\x36\x3f\x1c\xfa\x56\x5e\xd2\xe3\xdc\x7a\x30\x57\xeb\x60\x9e\xd0\x3c\x6f\x36\x7f\x64\x62\xbd\x7d\x08\xe3\x62\xb3\x4c\x35\xab\x59\x1e\x6f\x1f\x22\x51\x54\x4b\xcc\xc5\xdc\x0b\x3c\x2f\x2e\x72\x2e\x69\x58\x2e\xe1\x43\x89\x95\x3c\x1e\x88\x7d\x89\x3c\xf4\x66\x1f\xca\x77\x15\x12\xe9\x00\x70\x03\x98\x8b\xd0\x3c\xa1\x77\xb7\x98\xa1\xfb\x4e\x3d\x69\xde\x7d\xc8\xb1\xf5\xee\x43\x2e\x5f\xff\x5c\x26\xad\x65\xd5\x93\xe6\x9d\x3d\xb5\x7e\xe2\x79\xb3\xe5\x12\x88\x39\x35\x89\xa3\xbc\xbb\xdb\x97\xa8\xf8\xf4\x3e\xda\x10\xd7\xe0\x06\xe6\xce\x03\x97\x6b\x81\x14\xea\xc0\x72\xf4\xea\xb5\xd1\x00\xf9\x2e\x0f\x7f\xd4\x3f\xf5\x6a\xde\x72\x09\xce\xa8\xe3\x11\x2a\xd4\x0a\xcf\x21\xca\xa1\x68\x78\xbc\x8e\x04\xc8\x81\x28\x15\xf2\x70\x80\x32\xdb\x56\x51\x66\x51\x47\xeb\xe5\x52\x15\xb4\xd6\x3e\x56\x51\xb9\x0e\x3d\x3a\x7c\x67\x23\x2e\xaa\x6d\x2c\xe0\xe0\xcd\x62\xa9\x2c\xde\xac\x28\xe1\x43\xe9\xcd\xc4\xbe\x04\x2e\x2a\x96\x3f\xd2\x61\x69\xf9\xd5\x6d\xf8\xaf\x5b\x96\x25\x58\xfd\xc0\x30\x23\x85\x81\xab\xfa\x0d\x31\x8d\xf6\xb6\xd5\x32\xd5\xe7\x95\xc3\x35\x73\x69\x42\xda\xbf\x4e\xda\x2c\x22\x57\x61\xa9\x79\x16\xbe\xdf\x6e\xb0\x62\xb1\x7a\x37\x8b\x92\xe4\x8c\x65\x30\xe3\xa8\xd7\xfa\x11\xab\x47\x8c\x1e\x32\xfd\x76\xb6\xa1\xdf\xfd\x4b\x6d\xa2\xf2\x5e\x1d\xff\x13\xcb\x05\x56\x04\x86\x83\xdc\xbd\x21\xed\x76\x9f\x47\x9b\x9a\xaa\x19\x47\x51\x46\x62\x7d\xc9\x7a\x5a\x93\xdc\x1f\xf6\xdf\x71\x86\x51\x85\x89\xe6\xa4\xb5\x98\x92\xdf\xc1\x65\x3c\x6a\xc6\x7f\x9f\x3c\x22\x77\x19\x8a\xe1\xcf\x39\xfb\x75\x2b\x39\x00\xd6\x3f\x44\x35\x76\xa8\x96\x0c\x45\xc9\x50\x47\xc8\x33\x43\x50\xff\xb4\x87\xa2\xc8\xe8\x68\xa4\x52\x19\x8b\xc5\xe8\xa8\x5a\x46\x93\x28\xa2\xa3\xf7\x12\x65\x71\x62\x36\xab\x70\x53\xec\x30\xf9\x8c\x25\x06\x04\x71\xf4\xbc\x5d\x54\xc1\xdf\xa4\xfd\x31\x38\x86\x1b\xf0\xaf\x5a\xc0\x0a\xfc\x9c\x65\x81\x27\xb1\x88\xcf\x6d\xd4\xc5\xd2\x40\x72\xc8\xf1\x19\xea\xe7\x69\x51\x19\x14\x87\x5e\xba\xcd\xe3\x9e\x99\x7e\x0c\xc4\x54\xf6\xb8\x00\x89\xd3\x00\xda\x1b\x13\x94\x2b\x14\xdb\x2a\x87\x37\xad\x57\x07\x6f\xa6\x51\x7e\x6d\x98\x1c\x2f\xbc\xd9\xac\x28\xeb\xdf\xf4\x6f\x51\xd2\x43\xb1\x77\x9e\x76\x8c\xe2\xc2\xab\x95\x40\x0a\x87\x5f\xc3\x26\x7a\x42\xbf\x47\x37\x83\x85\x37\x3b\x7a\x47\xc9\x8c\x77\x19\x23\x37\xae\x28\xe4\x10\xd1\x19\xe1\x17\xe2\xa6\x7a\xf3\x0b\xa4\x55\xb1\x91\x66\xcb\x50\x1e\xc2\x2a\x75\x1e\xc0\x73\xc4\x69\x2d\x7c\xc1\x78\x2b\x30\x21\xef\x1c\x81\xa8\xa2\x9c\x47\xb1\x1c\xe0\xd3\x82\x77\x2f\xc1\xc2\x7d\x1e\x65\x10\xcb\x5d\x28\x24\x50\x24\x50\xc0\x20\x79\xed\x6f\xda\xb6\x31\x00\x45\x92\x1f\xc0\x95\x26\x9b\xcc\xa4\xfa\xeb\xfa\x06\xde\xa8\x87\x07\xc3\xd2\x4d\xa8\xfe\x3a\x9a\x41\x21\xcb\x99\xf0\x83\x5a\x1e\xea\xa9\x66\xc4\xdd\x4b\xc3\x84\x5c\x71\xe0\xee\xe5\x17\xa9\x04\x86\x06\xae\xcc\xfd\x33\x56\xe8\x9c\xd5\x3a\x11\xff\x8e\x18\xc1\x2c\x86\xe6\x80\x55\x55\x54\x50\x88\x35\x56\xcf\x8c\xe3\xc8\xf9\xee\x5e\xfc\x00\xfc\xab\xbb\x97\x85\x9a\x14\x90\xf2\xb0\x14\x66\x7f\x5b\x40\xf1\x44\x46\x64\x13\x26\x15\xdb\x61\x15\xfa\x57\xe2\xe5\x56\xfe\x19\x7c\x07\xaf\x8a\x27\x1a\x69\xce\x95\xb3\x6c\x01\xe9\x46\x84\xdf\xd3\x22\xa9\x3f\x37\x31\xce\xf1\x78\xdd\x08\x8d\x71\xc8\x0b\x01\xd5\x36\xcf\x59\xfe\xd8\x91\xd9\x3c\x20\x25\x99\x89\x17\xda\xf6\xcd\xdd\x4b\x1f\x5b\xc5\x4b\x9b\xa5\xe2\x65\x01\x39\xcb\x34\x4f\xdf\xa6\x02\xab\x77\xc5\x66\x23\x19\xf2\xc8\xb8\xc0\x8a\x43\x0a\xa2\x80\x07\x84\x38\xca\x32\x4c\x20\xa2\x41\x52\x9f\xac\xdd\xa1\xe8\x57\xb1\x58\x2e\x46\x7c\xe7\xdb\x38\x46\xce\xd3\x6d\x96\xed\x43\x58\x35\x1c\x4f\x23\xed\x60\x9c\xf9\x3d\xa7\xa5\xf5\xac\x2d\x43\xf8\x88\x28\xed\x88\x4d\xb6\x94\x7f\x51\x51\xc4\x99\x16\x23\xa2\xb3\xe6\xf8\x29\xd0\x30\x3f\x08\xa4\x49\x25\xc9\x88\x97\x31\x01\x4a\x19\xb7\x64\x28\x4f\xa1\x25\x10\x16\xf9\x3b\x73\x6e\x3f\xb5\x98\x5d\x6d\x91\x38\x6d\x7c\x89\x74\xfc\x3f\x73\xac\x6e\x65\xa4\x2b\x0d\x24\x85\x5a\x1f\x51\xac\x6e\x81\xa3\x20\x05\x46\xd8\x45\xd9\x16\x0d\x83\x59\x02\x29\x99\x8b\x10\xde\x17\x32\x86\x89\xc4\x42\x06\xd1\x32\x48\x6b\x02\x1d\xc6\x21\x8a\x63\x2c\x89\xf5\x45\x9e\xed\xa1\xc8\xc1\xb1\x3f\xca\x86\x12\x1f\xbd\x99\xe1\x52\xc7\x08\x2b\x52\x7c\x96\xe8\xb9\x8d\xad\x97\xaa\x3e\xdb\x84\xf5\xf3\xb6\x97\xb8\x81\x37\x2c\x21\x86\x58\xd6\x9f\x24\xb8\xba\xad\x25\xaf\xcf\xa3\xce\xa7\x63\x2d\xb3\x7b\xeb\x7c\x34\x90\x66\xd3\xb1\x76\x11\xcb\x64\x10\x22\xcf\xc5\x52\x60\x82\xd4\x0d\xca\xaa\xd8\xb1\x04\x13\x52\x57\x5a\xfa\x41\x51\x14\x7a\xc3\xc7\x5b\xdd\x12\x80\x7b\x8e\xb7\x00\x7c\x61\x5c\x70\xa9\x11\x06\xd6\x63\xa7\xbd\x21\x18\x59\x0a\x41\x27\x37\x72\xbf\x1a\x9e\xb8\xb0\x94\x62\x24\xec\xa3\xe9\x25\xa9\x63\x85\x31\x92\x3a\xd6\x91\xdd\x47\x19\x63\x91\x73\x3a\x50\x90\x86\xbf\xd2\xc0\xf9\x86\x72\x25\x79\xa8\x92\x82\x6f\xc9\x61\xf3\x48\xcb\x42\x06\xa5\x92\x33\xd7\x37\x50\x56\x2c\x17\x30\xff\x88\x62\x4e\x2b\x7f\x94\x8e\xc7\xd0\x48\x0e\x1c\x5e\xab\x9c\xa5\x1e\x6b\x65\x41\xf3\x50\x4e\x7a\x47\x03\xa2\x5c\x18\x2d\xae\xd7\x3f\x1e\x1b\x5d\x96\x0f\x6b\x15\x54\x9a\x3c\xa6\x7f\xd6\x22\x3e\xfd\x5d\x9a\x73\xa5\x7d\x8a\xd8\x0d\x1b\x6f\x94\x13\x2f\x9b\x20\x6e\x79\x45\xd4\x08\x62\x5a\xae\xa3\x62\x19\xd8\x17\x3b\xac\x2a\x96\x20\x94\x15\xee\x58\xb1\xe5\xd2\xde\x71\x52\xa6\xb7\x49\x12\xc2\xd5\xd2\x89\x03\x7b\x03\xeb\x4d\x38\x18\x5a\x4b\xfd\x98\x10\x51\x87\x23\x31\xb5\x59\x63\x38\x84\xde\x84\xa3\x41\xb4\xb3\x80\xd1\x03\xe7\xc7\xd1\x6b\x64\x57\xe7\x59\xff\x86\x24\x54\x07\xb6\xae\x1c\xfb\x11\x7c\x52\xae\xad\x0d\x08\x8a\x95\x2b\xdc\x2e\x0c\x67\x3b\x82\xc1\x80\xb8\xbd\x19\xc1\x74\x67\xa3\xb1\x86\x23\xe1\xb1\x06\xe4\x4e\xe3\x4e\x9e\x57\x21\x27\xca\x93\x7e\xa9\xf6\xe0\xe4\x6d\x92\xf4\xe2\xa4\xad\xf6\x51\x92\x70\x8d\xc2\xe3\x91\x34\xc9\x61\x5b\xe8\xcd\xbe\x80\xe6\xd3\x89\x47\xf4\xee\x95\xc5\x8a\xd9\xd5\xc8\xc0\x7f\xba\xa9\x29\xa5\x55\x8f\x4a\x4b\x0f\x5a\xad\x06\xa7\xb9\x00\x93\x4c\x26\x9e\x12\x27\xde\x26\x09\xea\x59\x2e\xa3\x1c\x4d\x52\xba\x43\x7e\x4c\x1a\xf1\x28\xb1\x2c\xb8\xab\x65\xd2\x5a\x50\x24\x40\xee\xce\x56\xb3\x11\x2e\x0e\xd2\x30\x4d\xd9\x8c\xb6\x0d\x1d\xdf\x9b\xf5\x68\x5c\xa3\x72\x33\x9d\x4c\xb5\x94\x8e\x1e\x37\x86\xd8\x28\x60\xd7\x1a\xf4\x68\x9e\xb4\x17\x93\x74\x4f\xda\x11\x65\x74\x9f\x70\xcf\x4d\xfc\xf0\xc8\x76\x98\x43\xf1\xf0\x3f\x18\x0b\x60\xf9\x29\x46\x23\x24\x91\x88\xa8\xd0\x46\xf9\x0a\x39\xe0\x9c\x0b\x8c\x12\x5a\xae\xc2\x32\x8b\x62\x32\xa4\x34\xee\x79\x5d\x64\x5a\x9a\x21\xfc\xb8\xcd\x04\x2b\x33\xd4\x36\x34\xaa\x50\xd1\x43\xe1\xb7\x28\xa0\xc8\x51\x93\x30\x1d\x03\xbb\x81\x12\x80\x8d\x82\x31\xdb\x69\x0b\x68\x7c\x64\x27\x0f\xb3\x76\x5b\x40\x86\xb9\xbf\x0b\x82\x5a\xba\x14\x70\xca\x50\x5f\x79\xef\xdd\x84\x2d\xee\x9f\x3e\xc1\x0d\xec\xee\x9f\x3e\xb5\x21\x23\xc5\x7b\x1a\x33\x5a\x7c\x12\x34\x8c\x3b\xac\xfd\x32\xb0\x19\xa6\x43\xe1\x66\x88\x39\x83\x00\x1a\x66\xc6\x39\x10\x3a\x8d\x20\xc7\x1f\xf6\xe0\xa7\x2f\xc2\x99\xff\x14\x89\xf5\xbc\x17\x44\xad\x18\xbc\x29\x7e\x2b\x18\x25\x85\xf8\x9a\x63\x19\x55\x11\x85\xd9\x54\xbc\x02\x1f\xc3\xc7\x10\xe6\x51\xf8\x30\x0f\x0c\xe6\x1c\x79\xa8\x4d\x6a\x00\xba\x18\x9b\x08\xaf\xbb\x35\x42\x19\x55\x94\x47\xab\x85\x6a\x78\x4b\x1a\x36\x5b\x2e\xd4\x36\x52\x1e\x66\x17\x2e\x8a\x8a\xb2\x01\x39\x63\x21\x1d\xde\xc6\xc5\x29\xe9\xb2\x1c\x49\x86\x40\x2e\xf5\x84\x58\xca\x85\xb3\x88\x0b\xbd\xfd\x64\xc8\xca\x15\x94\x9a\x2c\x60\x07\x03\xa0\x1d\x8f\x58\x5c\xd8\x9e\x88\x6e\x46\x80\xdb\x00\x76\x7c\x95\x7b\x22\x5a\xc2\xd3\x82\x65\x1f\x10\x48\x69\x78\xd7\x93\xe9\x9a\x03\x59\x3c\x8e\x02\x1e\xf6\xc4\x3d\x56\x01\x2d\x5b\x17\x94\x3f\x1f\xa0\x83\x14\x5d\x88\xcf\x31\x96\x7c\x59\x84\x7e\x28\x75\x25\x69\x08\xa2\xef\xa8\x32\x3a\xc9\xc5\xc9\xf2\x99\x8d\xcf\x22\x75\xb1\x36\x5d\x55\x35\x37\x36\xe1\x84\xc0\x79\x30\xfc\x1f\x8f\x93\xec\xd0\x7b\x38\x01\x38\xe5\x9b\xf4\x2a\xc3\x29\xc0\x69\x94\xd8\x4b\x68\xf9\x74\x7e\x6d\x42\xa7\x36\x79\xdf\x64\x80\xc7\x23\xa1\xc3\x94\x26\x0f\xb5\xf7\xaa\xb9\x69\x04\xd9\x12\xa0\x92\x2b\x26\xfd\x86\xd6\xe0\x48\x97\x81\x14\x24\x5c\x98\x50\x74\xa8\x89\xba\x04\x2c\xb5\xa0\x4d\x8d\x67\x66\x57\xe9\x46\x4e\x6b\x69\x77\xf1\xd4\xab\xd8\xe6\xdc\x56\x46\xf3\x57\xe4\xd8\x9b\x09\xd3\xad\x94\x80\x28\xcb\x20\x5e\x53\xc0\x50\x5b\xee\xb9\x73\xda\xf9\x99\xb9\xf1\xa9\x2c\xb8\x49\x3e\xff\x81\x93\x57\x6b\x69\xd7\xca\xcc\x12\x79\x31\xea\xb7\xe4\xbc\x00\x5b\xd0\x41\x6b\x35\xaa\x5c\x99\x1f\x76\x79\xa6\x7b\x39\x44\xab\x14\xb2\x3c\x33\x8f\x28\x33\x34\xc5\x18\xfb\xb2\x48\x8f\xb9\x81\x39\xa7\x10\xe4\x78\x6c\x16\x97\x46\x90\x25\xfc\x07\xc7\x0e\xfa\x65\xc4\x63\xba\x97\x2c\xca\x00\x7c\xce\xf2\xc7\x6d\x16\x55\x74\xeb\x22\x01\xf1\x1b\xa8\xf7\x01\xcc\x57\xb7\x7c\x78\x4f\xb3\x6e\xff\xb2\xe6\x87\x5a\x54\xae\xd5\xa2\x4d\xab\xaf\x59\x46\xe7\xa0\x05\x15\x54\x9a\x4a\x80\xa6\xe9\x78\x04\x4c\x1e\xd1\x24\xba\xfa\x7e\xc8\xbc\x7a\xd8\x03\xa3\xd0\x96\xa5\xb2\xb2\x6b\x13\xca\xeb\x0d\x4f\x2a\x7c\x43\x88\xdf\x3d\xb0\x5c\x5f\xdf\x85\xb1\x84\x43\x18\x86\xf5\xca\x36\x49\xed\x32\xa6\xd1\x1b\x6b\xa9\xc6\x1b\xe0\x50\x69\x93\x06\x8c\xdf\xd0\xdd\x98\xb2\x70\x83\x9c\xda\x9d\x0e\x2e\xee\x46\x3c\x43\x0b\xd7\xa1\xce\xf8\x4d\x9c\x9b\xa7\xb0\x26\x4f\x21\xf6\x8c\xee\x71\xcf\x12\x7e\xcf\x3e\x75\x8c\xfd\xac\x8d\xba\xa3\x37\xeb\x4a\x62\xdc\xb5\xe3\x39\xae\x7d\xaa\x82\x85\x20\x97\xa7\x80\x39\xca\xf5\xb8\xba\xaa\x90\x55\x18\x25\x7b\xe5\x77\xc8\x00\xb7\x1d\x08\x5d\x68\xb1\x7c\x17\x65\x2c\x91\x81\x71\x1a\xb1\x8c\x3b\xe5\xac\x05\x3c\x6c\x85\xa2\x4b\x6d\x91\xd0\xeb\xbc\x2e\x26\xca\x2b\x13\x8a\xd8\x91\xab\x6d\x68\x32\x51\x71\x41\x10\xa2\xad\xd4\x90\xec\x75\x84\x35\x41\xfd\x86\xf4\xe7\x95\x31\xa6\x03\xae\x1b\x2f\x77\xdd\x74\xe4\x96\xcc\x2c\xcf\x7d\x99\xa3\xd6\xee\x77\x9c\x31\xe6\x34\x9a\xbc\xb6\x8e\xb5\xee\x1d\x5c\x0a\x59\xd2\x53\xbd\x3c\x41\x68\x77\x03\xeb\x2e\xa1\x83\xc8\xbe\xd8\x7b\xc4\x0a\x38\x65\x3b\xf7\x1a\xa1\x33\xb8\x0e\xb9\xed\x48\x7c\xb2\x6c\x57\xb7\xef\x64\x20\x32\x28\x5d\xea\x97\xaa\xa5\xeb\xb2\x4d\xca\x9a\xfa\xb7\x50\x10\x26\x23\x48\x58\x9a\xa2\xcc\x4b\xbb\xf8\x5c\x40\x51\x19\x35\x58\xc0\x83\x06\xa3\x0b\x31\x42\x55\xad\x4f\x82\x43\x91\x29\x38\xd2\x25\x37\x4b\xb8\xca\x7c\xd5\x0f\x42\x2c\x4d\xfe\x5f\xac\x0a\x1d\xfe\xdb\x1a\xc8\x34\x0a\xf5\x86\x6a\x26\x2d\xa7\x66\x66\x45\x44\x75\xc6\xfa\xae\xbc\xc9\xc0\x35\xb0\x2b\x4c\x8b\x0a\x17\xda\x4a\xa0\x58\x17\x72\x1e\xdf\x96\xc4\x0f\x7d\xa9\xa6\x73\xf9\x1c\xea\x5e\xa9\xa6\xd5\x88\x4f\x57\xf5\x58\xbc\x50\x57\x82\xc0\x17\x41\x2d\x67\xf4\xdf\x00\xfc\x22\x4b\x56\xb7\x0b\xba\xdf\x5f\xdd\x0e\xe9\x94\x8a\x21\x13\xa9\x54\xf2\x0e\xda\xba\x87\x9e\xe2\x65\xde\xbc\x81\x57\x27\xcc\x8d\xa3\x82\x36\x4d\x0b\xe5\xdc\x16\x26\x2c\x33\x8e\xed\xd5\x26\x2c\xca\x70\xc5\x7d\xab\x85\x2c\x98\xb0\xcc\xd0\x05\xb8\xad\x8d\x74\xfd\x97\x65\xc5\xb3\x75\xa9\xd9\xc7\xfa\x79\xe3\xf6\x58\x52\x23\x4f\x26\xba\x84\x52\x43\xa8\x7e\xfe\x65\x48\xab\xf0\xd7\x2d\xab\x50\x76\x10\xac\x6e\xed\xa4\xbe\x51\x70\x9b\xae\x89\xd8\x97\x84\xc0\xcd\x20\xf8\xeb\x05\x35\xe1\xa4\x03\x74\x4e\xd3\x7a\xa1\x6f\x1e\x35\x06\xc3\xff\xdc\x62\xb5\xf7\x83\xf0\xbf\xd7\x58\xa1\xdf\xee\x66\x0c\x57\xb7\x3e\x4b\x82\x40\x0d\xeb\x33\x72\x7e\x10\x7e\xc8\xb3\xfd\xea\xd6\x8f\xc5\x8b\x3c\x0d\x7f\x66\x22\x5e\x2b\x6a\x63\x6a\xc8\x5c\xf1\xf7\x85\xf8\x81\x3a\x41\x7d\xac\xaa\xe0\x7a\x98\xbb\xe3\x0c\xa8\x15\x4b\xae\x4a\xe7\x52\xcf\xaf\xcf\x12\xd7\xaf\x74\x12\x72\xd8\x45\x96\xb4\xac\x17\x4b\xae\xe1\xab\xdd\x5c\xe2\xa6\x11\xcc\x59\x94\x6a\x18\xfd\xf6\x9b\x1a\x0f\xaf\x6e\xcc\x0c\xe3\x5e\x67\x4d\x44\xaa\xad\xb1\x4c\x14\x48\x87\x2b\xf0\xa5\xbb\x4d\x61\xfe\x55\xf8\x2d\x9f\x3b\x06\x33\x68\x26\x74\x52\x83\xf9\x5f\x65\x7b\xd6\x7c\x52\x5a\xd0\x98\xf4\x26\x74\x06\xd5\xdf\x75\x5e\x7c\x45\xb5\x2c\x96\x4c\x30\x6b\xcd\x3e\x7e\x13\x84\x77\xad\x97\x6d\xa4\x46\xfb\xcd\x5a\x11\xf1\xf8\xd8\xf3\x03\xe3\x81\xe0\xff\xc4\x4e\xf7\x2c\xe9\x86\xc6\xad\x28\x7f\x38\xe6\x3e\xbd\x78\x7f\xec\xdd\x50\x6c\xa2\xef\x96\x97\x6f\xeb\x48\x32\x29\xda\xb6\x03\x23\x4d\x97\x24\xb6\x48\x5d\xed\x98\xec\xd2\x56\xb7\x5c\x05\x43\x1c\xee\x3f\x8d\x49\x5f\x72\x28\x69\x58\x34\xce\x17\xcd\x3d\x5a\xf6\x06\xa2\xb2\xc4\x3c\x21\x15\x5b\x00\x4b\xda\x00\xee\x16\x6a\xf4\x99\xdb\xdc\x58\xdd\xba\x55\x5c\x17\x0e\xb4\x55\x27\x32\xa4\x86\xbe\x7e\xad\x59\x2e\x9b\xb6\x15\xc9\xc1\x28\x7b\x8e\xf6\xcd\x06\x74\x79\xc4\x12\x1e\xc0\xbf\xdc\xc0\xb7\xb2\x57\x6d\xab\x72\x60\x82\x1d\x57\xc1\xc7\xbe\xd8\x02\x5f\x17\xdb\x2c\x81\x2d\x47\x6f\x36\x4c\xb8\xb9\x2d\x90\xed\x54\xda\x99\xc9\x9e\x18\x5a\x58\xd6\x7d\xf3\x28\x83\x2d\xa7\xde\xf5\x87\xbd\xdd\x13\x63\x7a\xb8\x8d\x16\x8d\x0b\xb5\x87\x65\x13\xa4\x4b\x5c\x1a\x02\x17\x35\xed\x24\xcd\x45\x7e\x47\xd0\xdf\xd1\x6b\xc7\x0f\x76\x65\x7e\x65\x09\xbd\x05\xbc\xae\x56\x5d\xac\x4e\x9a\x4b\xc7\xa6\x7b\x80\x2a\x43\x76\xdd\x8f\xaa\x41\xf8\xb9\x85\xbf\x5a\xe3\xe6\x26\x67\x3c\x27\x65\x1c\x3a\x9f\xc9\xf2\x06\xa4\x70\x22\xf2\x6b\xaa\x17\x97\x17\x3a\x4e\xe0\xd9\x26\xb0\xb7\xee\xd6\x2d\xbb\xfd\x54\x64\x7b\xb7\xf4\xd6\x32\x7c\xea\x8a\xaf\xad\xb3\xde\xe0\xd5\x5e\x9b\xff\x65\x91\xed\x37\x45\x55\xae\x59\x5c\x7b\xc3\xe6\xca\x0f\x73\xc1\xc4\x9e\xf2\x17\x93\xf9\x7b\x26\x8d\xd1\x0b\x11\x04\xe4\xae\xcd\x92\x04\x6b\xfd\x76\x75\xdb\x7a\x27\x03\x45\xd9\x66\xc4\xe8\xae\x91\x7c\x23\x0d\x67\xd3\x6b\xbf\x3b\x0d\xd2\x95\xb9\xe9\xa9\xcd\xab\xd8\x97\x0b\x6d\x5e\x77\xa4\x22\x71\xb4\xc1\x0c\x7c\x9e\x47\x4f\xe8\xcc\xa0\x68\x43\xc6\xc5\x9b\xf0\x23\x8a\xf6\x39\x5c\x4e\xfa\x62\x5f\xb6\x86\xae\x6e\x7b\x07\x4a\x18\xd9\xe5\x21\x33\x6e\xe0\x0e\xe8\xf3\xea\x44\x9a\xc1\x83\x72\x94\x32\x33\xf5\x9b\x26\xf9\x9c\xb7\x4f\x7b\x86\xd4\x2e\x28\xed\xc8\x1a\xd5\x09\x06\x07\x9d\xa1\xab\xdb\xc1\x81\x8e\x97\xb3\x90\x43\xdd\x92\x1f\x4a\xc7\xb5\xd5\x09\x12\xe4\x4d\x8f\x7c\x2f\xe9\x1f\x4a\x3f\x80\x0f\xa5\xd5\x0b\x4f\x49\x9d\xe9\xbc\x26\x1d\xb5\xd7\xcd\xcd\xa7\x41\xf5\x07\x5d\xf5\x62\x3a\xc5\xd0\x7c\x0b\xc6\xf6\x24\x76\xf8\x81\xfe\x66\xc6\xd9\x59\xec\xcd\xd6\xba\xdb\x91\x6d\xca\x0c\x37\xf2\xeb\x1e\xda\x9f\xe2\x7d\xf5\x06\xb5\xdf\x23\x9d\x56\x29\xbf\xbe\x94\x2d\x52\xe0\x98\x73\x26\xd8\x4e\xdf\x2e\xe9\xae\x94\x88\x3f\x61\x32\x46\x96\x5a\xb8\x97\x30\x3d\x4c\x8f\xd8\x04\xba\x8d\x93\x74\x3d\x0f\xff\x3d\xe2\x1f\xeb\x1d\x8f\x47\xa2\xbe\xc2\x24\x32\xd2\xb3\xf9\xb7\x89\xf8\x93\xf1\x06\xca\xca\x48\xaa\x17\xe4\x39\x99\xa0\xb2\x43\xd4\x5c\x70\x46\xed\x83\x8c\x11\x6f\xed\xe8\x93\xcc\xf5\x21\xe8\x36\x9e\x1a\xa7\xff\x8b\x16\x0d\x9a\x3f\x89\xeb\x3a\x9f\x93\xa3\x0f\x13\x3e\x47\x32\x37\x2e\xce\x61\x4d\xd6\xd6\x49\x30\x0f\x87\x56\xab\xa8\x93\xcd\x6d\xc2\xfa\x6c\x3f\x46\xfc\xc9\xef\x7e\x34\x21\x35\xb7\x7d\x53\xe3\x7a\x0f\xb3\xd8\xce\x73\xf0\xb0\x5c\x82\x26\xdc\xb0\x9e\x7c\xb1\xb1\xbe\xf5\x77\x02\xa6\xa2\x92\x6c\x49\xa8\x24\x35\x47\xa1\x9d\xb6\x64\x96\x43\x51\xc9\x8e\x90\x02\x1e\xb5\x73\xd7\x3d\xa5\x34\xb1\xb3\x36\xcb\x97\x09\xc6\x95\xd4\x5c\xaa\x81\x51\xd3\x85\xea\x50\x53\x94\xf9\xa3\xf8\x30\x63\xe0\xfe\x53\xa3\x8a\x7a\x8f\x6b\x9d\xf7\x98\x57\x0b\xf8\x46\x5e\x6e\x65\x98\x3b\x12\x0b\x26\x09\x54\x5f\x89\xd5\x2e\xf5\x44\xaf\x6f\x53\xcb\x48\x47\x6b\x19\x9a\xd6\x3a\xd4\x4a\x07\x2e\xe1\x5a\x12\xd5\xe2\x54\xa3\xb5\x1d\xe8\x62\xc8\xc2\x87\x1c\x09\xcf\x4c\xac\x2d\x44\x91\xde\x84\x24\x15\x32\x0a\x1c\xe3\x22\x57\x55\x33\x8c\x34\xda\x80\xe5\x09\x8b\xe5\x57\x45\x32\x7a\x97\x62\xd7\x4b\xa9\x6f\x19\xe8\xd6\x8a\xa3\x90\x75\x4c\x2a\x69\xd3\x6f\xfd\x8d\xaa\x4e\x11\x78\xbc\xc6\x4d\x74\x52\x88\x36\x14\x03\xf0\x6b\xf8\x2d\x9a\xe2\xf0\xf9\x28\x3c\x57\x68\x35\x3e\x0d\xeb\xaf\xad\xea\x47\x2d\x4f\x13\xd6\x9a\x0e\xdd\x21\xb0\xa9\x4f\x58\x64\x0c\xa8\x2d\x35\x6a\x43\xd7\x6a\xa1\x32\x2d\x46\x96\x54\xec\x8f\x41\xa2\x9c\x26\xcb\x22\xa6\x29\x39\xeb\x4a\x9a\xcd\xef\xba\xb0\xa6\x18\x2e\x25\xa2\x46\xd3\x6c\xd9\xce\xbd\x61\x7c\x13\x51\x61\xaa\x59\x82\x9e\x8f\xc9\xe6\x23\x8a\x8e\x78\x16\x9a\xec\x5a\x46\x81\x26\xee\x0f\x94\xd1\xce\xb4\x2d\x48\xd2\x42\xdf\x6d\x0a\xd6\xe9\x95\xf9\x3c\xa5\x16\xa9\x5d\x14\xdb\xe6\xf8\x52\x62\x4c\x4d\x6b\xc4\x14\xf8\xea\x4e\xe6\xa2\x8a\x4d\x5f\xf1\xb9\x3e\x75\x63\x6d\x4d\xe7\x94\x8e\xf8\xda\xad\x39\xfe\x2e\xb0\x94\x47\x86\xf4\xfd\x6a\xe2\x12\xf1\x94\x17\xcf\xed\xaf\x52\x2c\x1a\xd4\xe6\xfa\xcb\xa4\xc6\x4a\x36\xba\xd2\x98\xdb\x3e\x5b\x5b\x1b\x5a\x9a\x5f\x54\x60\x99\x5e\x6d\xdd\x5b\xa6\x7d\x44\x35\x1c\x23\xed\x18\x60\xe3\x00\xa5\xc3\x77\x1a\x2a\xe8\x3b\x47\x4d\x96\x99\xe0\xcd\x4e\x69\xc9\x78\x8b\xc6\x45\x4a\xa4\x4b\x6c\x83\xcd\x1d\x4e\x9a\x3d\xd9\x48\x6b\x95\xb0\xc5\xec\x98\x86\x5a\xe2\x72\xbe\xd7\xca\x0b\x07\x34\xa5\x2d\xeb\x5a\xd4\x04\x62\x23\xea\x56\xc3\xb8\xeb\x54\x69\xbe\xbc\xd4\x19\x73\x03\xb6\x0f\x68\xd9\x7e\x9a\xdf\x63\xfe\xbf\x88\xed\x6f\xce\x35\xc1\x01\x0c\xeb\x55\xcb\xec\xfc\x21\x1a\xd5\x6f\x98\x6a\xb9\x6e\xc2\x91\xbe\xfb\x71\xb5\xe9\x77\xfe\x1d\xf7\xf2\x36\xd1\x1a\x22\x3f\xb1\xf8\x7f\xe1\x5e\xde\x26\x5d\xe1\x7f\x59\xf7\x32\x28\xe5\x8b\x84\x3c\x20\xe3\xd3\xde\xc7\x75\x3f\xfd\xa6\xff\x5c\xff\x33\x33\x35\xf9\xb7\x49\xbf\x5a\x29\x0f\x64\xe9\x4b\x4b\xb1\xec\xbf\x8f\x5e\x3f\x51\x7d\xfe\xc8\x71\x30\x2d\xbf\x44\xc6\x42\xb7\x46\x68\x51\xd4\x7a\x26\x5d\x53\x96\xc9\xe6\xb8\x8e\x6f\xd2\x55\x37\x9a\x7e\xae\x23\x72\xb6\x1b\x73\x45\x6e\x89\xe5\x73\x7d\x91\xbb\xda\xa5\x56\x43\xfa\x21\xc9\x29\x7d\x0c\xdf\x56\x2f\x5d\x22\xf9\x53\xb8\x20\x9b\xc8\xc6\x78\xd4\x09\x43\x93\x2a\xb0\xb4\xe5\x29\xbc\xa6\xe7\xa0\xd3\x64\x34\x26\x58\x87\x2d\x8e\x7b\x30\x6d\x30\x83\xdd\xaa\x34\xfa\x53\xad\xd2\xc5\x93\x3e\x83\xe4\xb1\x1c\x62\x57\xc9\x7e\x3f\x33\x79\x52\x6d\x7b\x5c\x5f\x6d\xec\x46\x74\xf7\x72\x7f\xf7\x65\xb4\x76\xc8\xd7\x59\x05\xba\x7e\x27\xe7\xea\xd8\xf9\x4e\x6f\x8a\x71\xb2\x4d\x4c\x8f\x75\x92\x6d\xc8\x26\x94\x92\x99\x98\x7d\x01\xa1\xc5\x57\x4b\xaa\xc2\xc7\xa8\x4a\xf4\x17\x27\xa4\xc8\x4a\x3d\x94\xe8\x7b\x94\x64\x58\x43\x68\xf2\xd9\x4a\xd2\x10\x3b\xa0\x24\xe7\x7b\xc4\x73\xa5\xdd\x2f\xeb\x76\x32\x6c\xee\x78\xfc\xbf\x4b\xd6\xa3\x2e\x3b\x6a\xae\x67\x99\xac\x66\xcb\x71\xb6\x53\xe1\x28\x96\xea\xe3\x49\x6d\x76\x48\x06\x86\xbf\x63\x6c\x6f\x36\x69\xf9\x13\xda\xe6\x64\x69\xc9\x5c\xc5\xb8\x95\xa5\x6e\x8f\xf4\xd4\x86\x97\x29\x52\xc3\x36\x46\x15\xa5\xb5\xc3\xd0\x97\xa9\xd3\xea\x4a\x72\xb0\xcd\x6f\xfb\x42\x98\xd0\x42\xd7\x83\x3e\x7d\xb4\x48\x5d\x61\xb2\xd6\xcd\x03\x8b\xef\x8a\xe7\x69\x51\x79\xcb\xa5\x7d\x4f\x64\x64\x74\x92\xf5\x74\x9b\xea\xe8\xfb\xfd\xa7\x3a\x1c\x1c\xd7\xfa\x1e\x2e\x5f\xc2\xbe\x7e\xa5\x1f\xb8\x33\xbc\xe0\xea\xd6\x70\xda\x3a\xd7\xe1\x8a\x25\xed\x86\x86\xda\x33\xab\x1b\xd9\x46\xef\xea\x59\x52\xf5\xe8\x0a\x7d\x60\x6b\xf5\x55\x66\x4f\x4f\x41\xef\x68\x43\xdd\xe0\xf5\xaf\x8e\x39\x35\xf5\x2c\xe1\x35\xa9\x5a\x83\xfa\xd1\xde\xfc\xef\x54\x74\x0b\xc6\x44\x00\xeb\x8b\xd2\x73\xe1\x6b\x6f\xf2\xbb\x02\x58\x2b\x44\xbb\x77\x5f\xd7\x9b\x4e\x5c\xf4\x3a\x0a\x71\x11\xc6\x27\x82\x7c\x76\x1c\x09\xfc\x7b\x20\xaf\xd9\x77\x26\xe8\x8d\xac\x2e\x83\x7d\xb3\xe7\x97\x05\xfe\x80\x74\x2e\x62\x77\xbf\x51\x98\x80\xcc\x31\x35\x18\x04\xe8\xd8\xa4\x8b\x70\x7a\x0e\x4c\x75\xd4\x3d\x11\xa6\xad\xe0\x7e\x2a\x4c\xed\x4d\xfe\x1e\x30\xed\x85\xa8\xa6\x7d\x8c\xcd\x7f\x26\x6c\xd2\xa9\x34\xdf\x26\x25\x61\x34\xf7\x73\x72\x30\x6b\xbf\xfe\x14\xec\x12\x44\xfe\x9e\x68\xd4\x3c\x1b\x17\xec\x24\x34\xd8\xb5\x35\xc9\x02\x3a\xc8\x97\xc8\x1b\x6b\x0c\x7d\x5e\xee\x48\xe4\x0c\x64\x05\x86\xcf\x0e\xf3\x5b\x92\x3a\x21\xaa\x21\x59\x5d\x88\x86\x09\x19\x23\xfe\x41\x19\xa3\xd5\x6e\xd8\x4d\x37\x64\x5e\x43\x6c\xf9\x8c\x64\xb1\x96\xf7\x68\xae\x68\x3e\x1c\xf9\xac\x54\x71\x44\x27\xce\x06\xea\xb9\x42\xee\x17\xb1\x89\x34\x7f\xbf\x44\xb1\x2b\x38\xab\xc5\xe1\x70\x00\xcc\x13\x38\x1e\xbd\xff\x1b\x00\xa1\x40\xc6\xa7\x02\x5a\x00\x00")
 
 func templateBuilderMutationTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -201,12 +204,12 @@ func templateBuilderMutationTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/mutation.tmpl", size: 17138, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/mutation.tmpl", size: 23042, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x5a\xff\x6f\xdb\xb8\x92\xff\xd9\xfe\x2b\xe6\x19\x79\x81\x1d\xb8\x72\xdb\xdf\x2e\x87\x1c\xd0\xd7\xb4\x77\x06\x16\x7d\x6f\xdb\x1e\x76\x81\xa2\xd8\x65\xa4\x91\xcd\xad\x4c\x6a\x49\xca\x49\x90\xf3\xff\x7e\xe0\x90\x92\xa8\x6f\xb1\x9c\x06\x7b\xc5\xfd\x64\x4b\x22\x87\xe4\xcc\x67\x3e\x33\xe4\xf0\xe1\x61\x75\x31\x7d\x2b\xf3\x7b\xc5\x37\x5b\x03\xaf\x5f\xbe\xfa\xb7\x17\xb9\x42\x8d\xc2\xc0\x7b\x16\xe3\x8d\x94\xdf\x60\x2d\xe2\x08\xde\x64\x19\x50\x23\x0d\xf6\xbb\xda\x63\x12\x4d\x3f\x6f\xb9\x06\x2d\x0b\x15\x23\xc4\x32\x41\xe0\x1a\x32\x1e\xa3\xd0\x98\x40\x21\x12\x54\x60\xb6\x08\x6f\x72\x16\x6f\x11\x5e\x47\x2f\xcb\xaf\x90\xca\x42\x24\x53\x2e\xe8\xfb\x4f\xeb\xb7\xef\x3e\x7c\x7a\x07\x29\xcf\x10\xfc\x3b\x25\xa5\x81\x84\x2b\x8c\x8d\x54\xf7\x20\x53\x30\xc1\x60\x46\x21\x46\xd3\x8b\xd5\xe1\x30\x9d\x3e\x3c\x40\x82\x29\x17\x08\xb3\x3f\x0b\x54\xf7\x33\x38\x1c\xec\xcb\xb3\xfc\xdb\x06\x2e\xaf\xe0\x86\x69\x84\xb3\xe8\xad\x14\x29\xdf\x44\xff\x62\xf1\x37\xb6\x41\xf0\x3d\x0d\xee\xf2\x8c\x19\x84\xd9\x16\x59\x82\x6a\x06\x67\xdd\x4f\x7c\x97\x4b\x65\xca\x4f\xee\x09\xe6\xd3\xc9\xc3\xc3\x0b\x50\x4c\x6c\x10\xce\x72\x66\xb6\x76\xb0\xb3\xe8\x13\xbf\xc9\xb8\xd8\xac\xa9\x95\xb6\x3d\x26\x93\x19\x4d\xc7\x36\x39\x1c\x66\xae\x1f\x8a\xc4\x7e\x5b\x4c\x69\xac\xb3\x9b\x82\x67\x56\x5d\x24\xe2\x67\xbb\x8c\x0f\x6c\x87\xe5\x4a\x14\xc6\xc8\xf7\xee\x73\xf5\xbf\xea\x63\x27\xb5\x5a\x41\x28\xe6\x70\xb0\xa6\xb0\x7a\x2c\xdf\xa4\x52\x01\xa9\x87\x8b\x8d\x6d\x9a\x33\x1d\xb3\x0c\xce\x22\x3f\x0e\xa0\x30\xdc\x70\xd4\xd1\xd4\xdc\xe7\xd8\x96\xa6\x8d\x2a\x62\x03\x0f\xd3\x49\x4c\x7a\x9c\x4e\x32\xbe\xe3\x66\x32\xb9\xe0\xc2\x4c\x27\x32\x4d\x35\xd6\x4f\x2a\x41\x35\x99\x7c\xf9\xfa\x4f\xfb\xe7\x7d\x21\xe2\xe9\xa4\x10\xfc\xcf\x02\xed\x4b\x6d\x14\x17\x9b\xe9\x24\x57\x98\xf0\x98\x19\xd4\x30\xf9\xf2\xb5\x7a\x8a\xec\xc8\xe5\xac\x9c\xae\x6e\xb9\xd9\xc2\x59\xf4\x2e\xd9\xa0\x57\xe8\x6a\x05\xc8\x36\xa8\x5e\x64\x92\x25\x76\x45\x68\xbf\x45\xd3\x49\x68\x13\xb4\xea\x8a\x5c\x87\x89\x95\x11\x2c\x1b\xab\x75\x5f\xd8\xf1\x30\xfa\x7c\x9f\x63\x53\xf1\x93\xd0\x4e\x9d\xff\xab\x0b\x78\x93\x24\xdc\x70\x29\x58\x06\x29\xc7\x2c\xd1\x60\x24\xb0\x24\xb1\x3f\x81\xea\x23\x20\x9c\x52\xaf\x33\xb3\xcb\x33\x3b\xad\x5c\x71\x61\x52\x98\x25\x9c\x65\x18\x9b\xd5\xdf\xf5\x8a\xac\xb3\x72\x92\x66\x16\x48\x46\x2a\x8f\x54\xea\xcb\x53\xd8\x32\xfd\xb9\x44\xa5\x13\x55\xcd\xf3\xce\x34\x3f\x44\x9d\x59\xaf\x56\xc0\x85\x41\xb5\xc3\x84\xdb\x76\x34\x1e\xcc\x79\x84\x11\x18\xc5\xf6\xa8\x34\xcb\xc0\xa2\x74\x11\xd9\x9e\x8d\x29\x40\xf8\x1c\xfd\xa3\x46\xde\x84\x60\x9d\x16\x22\x9e\xc7\x52\x18\xbc\x33\xd6\xd3\xec\xef\x02\xe6\x03\x9d\x96\x80\x4a\x49\xb5\x98\x3a\xe0\xfe\xb2\x45\x85\x56\x71\x1a\x18\x08\xbc\x85\x0a\x0b\x84\xda\x50\x95\x53\x3b\x90\x93\x5b\xf9\x41\x69\xc3\x1a\xad\x0b\x27\x72\x9e\x6b\x88\xa2\xa8\x1f\x59\x8b\x76\x27\x8b\xed\x50\xee\xe1\x10\x05\x08\xbd\x02\x96\xe7\x28\x92\xf6\xd0\x41\x9b\x25\xe4\x3a\x8a\xa2\xc5\x74\xa2\xd0\x14\x4a\x40\xab\xa9\x5f\xed\x4f\xd6\x6f\xca\xd5\x92\x13\x81\x36\x98\x97\xa0\x21\xab\x8c\x5e\x27\x09\x9b\x3b\x29\x5c\x98\xa3\x8b\xb2\x33\x76\xad\xaf\xe0\x9c\xfe\x1c\x99\xed\x3f\xc9\xb1\xfd\x74\x05\x38\x3f\xff\x8e\x09\x3b\x79\x73\x2f\x67\xec\x94\x7d\xf3\x2b\x38\x77\xff\x8e\x4d\xda\xd2\x4e\x3d\x67\x7a\xfa\x8e\x29\xdb\xfe\x73\x69\xa1\x54\xf1\xd9\xb8\x59\xd3\xc0\x83\xc8\xa1\xcf\x4b\x90\xc7\x30\x63\x63\xb4\x0b\x7e\x14\x62\xb7\x4c\x83\xe6\x3b\x9e\x31\xc5\xcd\xbd\xe3\x46\xcb\x7e\xb4\x2a\x8e\xda\x06\xd0\x38\xe3\x28\x4c\x44\x44\x40\xe4\xf3\xf0\x50\x92\xe2\x6f\x4b\x4f\x8c\x21\x9f\x12\x05\x26\x1b\xfc\x2d\x08\x43\xc4\x50\x30\xaf\x09\x93\x18\xd2\x7a\xcf\x02\x66\x3f\x57\x81\xd6\xd2\x0a\x3d\xf5\x92\x6b\xbc\x65\x5c\xb8\x40\x14\x17\x4a\xd9\xb4\xc2\xd1\x8e\x74\x51\xde\x71\x6f\x15\x82\x92\x0d\x46\xd3\xc9\x48\xbb\x0c\x8e\x3a\xf7\xd6\x69\xac\xc8\x99\x68\xe2\x46\xbf\xbc\x82\xf3\x9e\x16\x0f\x2e\xb6\x5d\xb6\xad\x10\xb9\xf7\x87\xb2\x7f\x44\x9c\x77\xe5\x59\xcf\xdc\x41\x97\xf9\x52\x25\x77\xff\x3d\x44\x9a\xc4\x7f\x9e\x03\x69\x56\x13\x9e\xd2\xab\xcb\xab\xce\xd0\xb9\xc2\x9c\x29\xa4\xc5\xda\xb1\x16\xff\x4e\x2d\xff\x76\x05\x82\x67\xae\x73\x89\x1d\xc1\x33\x92\x6c\xdf\x51\xcc\xab\x62\x27\xde\x19\x1b\x05\xce\x60\xf6\xd1\x8b\x9e\x05\xa3\xcc\x2c\x10\x66\x16\x16\xb3\x75\x82\xc2\xcc\x60\x46\xd3\x9f\xc1\x0b\x17\x3b\x09\x1f\x47\x23\x97\x55\x4a\x3b\x6e\x4d\x1e\x0b\x4e\x75\x80\xf5\xe3\xf8\x75\xd0\xe0\x4b\xbb\x9c\xa9\x5b\x88\x7f\x4f\xc3\x4c\x27\x84\x66\x1f\xd4\xac\xb7\xbf\xe7\x4a\x1b\x70\x6d\x1c\xd4\x52\x7a\x13\xb2\xbd\xcb\x6e\xee\xcb\xe4\xd2\x59\x11\x3e\xfa\x3e\x17\x1f\xa4\x79\x6f\x13\xd2\x77\xd6\x24\x70\xbb\x45\x01\x42\x5a\x01\x99\xbc\xb5\x99\x56\x25\xe6\x96\x69\x97\xba\x8e\x66\x0f\x9a\xdd\x00\x48\x2e\xc2\x29\x2e\x03\x40\x58\x54\x67\x85\xa2\xfc\xec\x63\x2d\x7d\x39\x04\x12\x17\x06\x5e\x2d\xa2\x37\x59\x46\x20\x99\x96\x88\x0a\x70\xd2\x41\xc9\x81\x5a\x65\x28\xe6\x03\xe3\x2d\xe0\xea\x0a\x5e\x76\x3a\x9f\x37\xd4\xf5\xe0\x14\x5d\xe7\xd5\xd1\x4f\xec\x06\xb3\x03\xc9\xaf\x59\xad\x4f\xfe\x97\x97\x5f\x9d\x99\x03\x43\xfe\xea\xf6\x10\xdf\xd0\x3d\x2e\xe1\xa6\x30\x90\x33\xc1\x63\x6d\x33\x20\x26\x9c\x9a\x40\xc6\x71\xa1\xf4\x69\x66\xf8\xb5\xdf\x0e\x0d\x33\x94\x44\x3e\x4a\xef\x95\x71\x3b\x0a\x3f\x3f\x87\xbf\xad\x75\xa9\xa8\x39\x2a\xef\xe9\xb4\x12\x7a\x6c\xe9\xa7\x31\x60\xa8\x90\xf5\xf5\x31\x6c\xf3\xe4\x34\x5c\xf3\xe4\xa9\x38\x5e\x5f\x0f\x20\x99\x27\x6e\x4a\xeb\x6b\x0a\x13\x3d\x1c\xb7\x67\x0a\x78\xa2\xe1\xcb\xd7\x56\x43\xd2\x1c\x4f\xb4\xeb\xf0\x08\xb6\xd7\xd7\xba\x9f\x00\x9d\x7a\x42\x3c\xf3\x44\x07\xd8\x75\x72\xc7\xa2\x36\x14\xe7\xcd\xc3\x13\xdd\x0b\xd5\xf5\x75\x13\xac\xeb\xeb\xe7\x85\xeb\x90\xba\x5b\x1a\xb4\x8b\xe4\xc9\xe3\x20\x75\xa2\xbe\x13\xa6\x3c\x29\x13\x2c\x91\xdd\x37\x50\x29\xed\x8b\x63\x84\xbb\xac\xba\x54\x6a\xe1\x29\x08\x69\x00\xef\x58\x6c\x32\x9b\x15\x60\xd9\xd1\x22\xd4\x35\xc7\xf1\x20\xb5\xf3\xfa\x6b\xb8\xf6\xf5\xe9\x5c\xab\x6f\xb9\x89\xb7\x8f\xf3\xad\xdd\x5f\x33\x8d\xf0\xea\xb2\x16\x72\x8c\x3c\x5d\x8f\x97\x97\x4f\x64\xe9\x04\x53\x56\x64\xa6\xaf\xfb\x27\x2e\x36\x45\xc6\xd4\x51\x9e\xaf\x51\x51\xd3\xb7\x7d\x7a\x2e\x77\x20\xc9\xcf\x4d\xde\x25\x58\x7a\x0d\x78\x12\x4f\x5b\x49\x2d\x9a\xee\x3a\x44\x8b\xa5\xc7\x39\x83\xa7\xea\x27\x39\xc2\xff\x1d\x59\xbf\x1e\x47\xd6\x81\x43\x10\x61\x37\xc0\xcf\x13\xb8\xf2\xc4\x1b\x22\xfc\x34\x2e\x0f\xb0\x5d\x77\x1c\x8d\xea\x72\xae\x01\xba\x03\xc6\x77\x2a\x7e\x56\x84\x3f\x0f\xdf\xd7\xb6\x3f\x01\xd9\x15\xb5\xbf\xc9\x32\xc0\x3b\x8c\x0b\x83\xba\x46\x2b\x30\x91\xd4\x80\x85\x8c\x6b\x03\x32\x6d\x50\x93\xc7\xf9\xe8\x15\x7b\xfa\xec\xc1\xe7\x97\xaf\x83\x64\xfd\x3d\xfb\xa4\x3e\x4e\xee\xdf\x75\x47\xad\xc3\xaf\x8a\xe9\x2b\x15\xd5\x34\xf7\x26\xcb\x9e\x0b\x03\x56\x6e\xbf\x4a\x5a\x1a\x79\x4a\xd8\x7a\x2c\x5a\x0d\x92\x5d\xdf\x08\x5e\x09\xeb\x6b\x7d\x12\x4e\x42\x22\x1c\xaf\x12\x4f\x23\xbd\x20\xe9\xe3\xb0\x51\xfc\x35\xa0\xa1\x4f\x68\xf7\xb3\xf3\x36\x1f\xbc\xe7\x98\x25\xeb\xeb\x45\xf4\x29\x66\xc2\x4e\x66\x09\xe7\x96\xae\x4e\xc1\x17\x31\x66\x9d\x3d\xae\xaf\x75\x0d\xa0\xf5\xb5\x7e\x2e\x00\x59\xb9\x43\x00\xea\xe5\x10\x3d\x08\x97\x92\xbf\x4f\x61\x10\xed\x97\xf7\x56\x16\xa2\xb9\x21\x8f\xe9\x0d\xd5\x70\x10\x36\x7c\x8f\xe2\xc4\x33\x38\x12\x39\x14\xce\x84\x79\x66\x8a\x78\x79\x2a\x41\x54\xd3\x5b\x84\x2a\xa8\x6d\x4c\x8f\xcf\x65\x65\x27\xbb\x5f\x19\x5c\xf8\x1a\x4d\xe1\x95\xd2\xa7\x87\x60\xb6\xa3\xad\x4b\x12\xfd\xe2\xde\xdd\xf1\xf0\xc0\x45\x15\x68\x97\x53\x73\xc0\x96\x69\xc0\x0c\x77\x28\x8c\x2e\x73\x9e\x8d\x62\xf9\x76\xf4\x12\x69\x84\x01\x73\xdf\x48\x99\x3d\xb3\xbd\x53\x96\x69\x3c\xd5\xe6\xd5\x1c\x17\xa1\x5a\x6a\x9b\xd3\xe3\x73\xd9\xdc\xc9\xee\xd7\x88\x55\x88\x5d\x0d\xba\x01\x07\x94\x11\x4c\x77\xb4\xd1\x49\x62\x89\xe8\xcc\xe6\xa3\x35\xb5\x27\x45\x9e\xb9\x1a\x8d\x0c\x6d\xef\x27\xbd\x04\x2e\xe2\xac\xa0\xd2\x1c\xcb\x32\x60\x5a\xcb\x98\x33\x83\x09\x1d\xc4\xeb\x08\xd6\x06\x62\x26\xe0\x06\xad\xf0\x42\x23\x55\xcd\xbc\xc5\x20\x96\xbb\x9d\x14\x4d\x91\x9a\x62\x4b\xa1\xd1\x8e\xb6\x83\x84\xa7\x29\x2a\x14\x36\x53\x66\xa9\xf1\x95\xe6\x98\x66\xc9\x35\xec\x58\x82\xe3\x3d\xca\xf6\x9a\xf7\x9e\xe9\x7b\x4d\x9c\x37\xbf\x58\x95\x95\x67\xc5\x9d\x63\x7f\xf7\x61\x39\x9d\xb8\x12\xe9\x25\x4c\xfa\x4b\x30\xb6\x85\x2b\x67\xf4\x08\x71\x1f\xa8\x89\x4a\x50\x59\x21\xbe\x8c\x10\x54\x55\x1f\x0e\xcb\x8e\x9d\xa9\x79\x14\x45\x0b\xdb\xd7\x15\x5d\x2f\xa1\xee\xeb\x8a\xaf\x7d\x1d\x5d\xdb\xb2\x67\x5d\xd6\xba\x84\xaa\x73\x7f\x25\xad\x4f\x58\xdd\xbd\x14\xb8\x5a\x95\xc6\xe9\xd4\x20\x5d\xd9\xb6\xe1\x5c\xdd\x23\xf8\x56\x83\xc8\xdb\x8c\xe6\xca\xcc\xb6\xdb\xc1\xbe\x5d\xfa\xcd\x69\xbb\x28\xdc\xa9\x7d\x84\xe5\xf7\xde\x5a\xf0\x6a\x05\xf0\xcb\x50\x09\xd9\x60\x96\x05\x49\xd0\x8b\x52\x9a\x91\x41\x95\xda\x35\x10\x32\xa1\x7c\x89\x19\x70\x40\x17\x02\x63\x43\xe8\xa7\x41\x6c\x9b\x59\xa3\x2a\x32\x73\x65\x11\xf8\x6c\x77\x95\xb9\xaf\x37\x33\xb5\x29\x1c\xbf\x96\xae\xe3\x50\x57\x28\xec\x3a\x63\xe9\xa1\xa7\x95\x57\x86\x56\x3b\x97\xb9\xa1\xba\x2a\x55\x3f\x2e\x1a\xea\x3b\x1c\x16\xbd\x5e\xd4\x2e\xbb\x9c\x54\x72\x49\xa5\x82\xdf\x96\x76\xed\x74\xfd\x81\xcc\x48\x73\xa0\xe2\x87\xcc\xcd\x9c\xa4\x2f\x7c\xb1\xa0\x2d\x68\xb0\xf0\x7f\x55\x16\x14\x86\x6a\x6f\x54\x69\xa8\xea\xe7\x74\x11\x63\xa3\x64\x91\xff\x23\x28\x92\x35\x6e\x51\xfc\x4f\x55\x1c\xf9\xbb\xfe\x4f\x6a\xe9\x6a\x64\x96\xe2\xfc\x73\x65\x2f\x92\x04\x7b\x54\x86\xc7\xa8\xe1\xc6\xed\xf7\xa5\x82\x9d\x54\xe8\xef\x13\xac\x62\x99\x15\x3b\xa1\x23\x4a\x1a\x8d\xe5\x35\x99\x1a\x14\x4e\x08\x55\x76\xd8\x66\xa3\x70\x43\xa5\xf2\x42\xc4\x16\x1d\x7a\x49\xf1\x87\x34\xfa\x87\xe4\x02\xe6\xdf\xf0\x5e\xd7\x0d\x17\x30\x5b\xc2\x8c\x76\x6a\x55\x7d\x28\x43\x01\x67\x2e\xd3\xd5\xee\xce\xc9\x0b\x38\x4b\xed\x02\xb9\x48\xf0\xae\xfe\xf6\xd2\x7e\x5d\xad\x5c\xb8\x63\xbb\x3c\xc3\x4b\xf7\x48\x29\xf7\x1e\x88\x60\xdc\x45\x91\xd5\xca\xd9\x22\x8d\x3e\xd1\x2b\x92\x50\xde\x24\x48\xab\x3c\xf4\xf7\xb0\xcd\x67\xb6\x81\xc3\xe1\x77\xea\xeb\xb2\x48\x9b\xd0\xfc\xfe\x87\x96\xe2\x72\xe6\x92\x1a\xb9\xe3\x06\x77\xb9\xb9\x9f\x51\x33\x3f\x9b\x89\xaf\x78\xf6\x5c\x6c\x71\x8e\x3c\x5f\x44\x24\xd5\x9b\xa1\x93\xe5\xbb\x59\xbc\x95\x42\x1b\x26\x8c\x05\xb2\x6b\xff\xa6\x54\x1b\xf5\xc8\xbf\x6d\xea\x04\x6a\xe1\x9b\x04\xfb\x82\xfd\xc2\x4e\x27\x00\xcd\x48\x5f\x2b\x67\x45\x66\x07\xc7\xd1\xcb\xf2\x52\x49\x14\x45\xee\x8d\x77\xad\x06\x06\x9d\x7f\x39\x30\x95\xee\xd5\x6a\x70\xdc\xc5\xa8\x43\xe4\x87\xbb\x82\x76\xb0\xa0\x0f\x87\x72\x3e\xae\x5c\xed\xba\x1c\xaf\x83\xe6\x0a\xf7\xa3\xcb\xa0\xdf\x55\x05\xed\x16\x41\x0f\x83\xae\xdd\x8e\x26\x1e\x22\xfe\x3c\xb5\x4e\x80\x68\x95\x53\xef\xfb\x9a\xf6\x87\xa3\x9c\xdf\x6d\x25\x2b\xdf\x77\x8f\x3d\x0e\x4e\xa5\xce\xee\xa6\xe8\x47\xf6\xcb\x53\x1d\x6e\x60\x57\x3d\xe4\x6f\xcf\xe0\x4c\x7e\xc4\x51\xbe\xd4\xb4\xa9\x73\x26\xf7\x4e\xaa\xca\x9f\xda\x8d\x8e\x3b\x54\x29\xe2\x34\x9f\xaa\x7a\xfd\x7f\x77\xab\x72\xa1\xd6\xb3\x46\x1a\xb5\x3d\xd3\xae\x4e\xdc\xbe\xca\x6d\x08\xfb\x72\xc1\xc6\x76\x47\xe1\x7e\x70\xa7\x64\x1b\xfb\x8d\x52\xcf\x4e\xa9\xda\x1b\x55\xba\x38\xa2\x04\xb8\xb2\x93\xdf\xd3\xfa\xfd\x35\xbe\xb3\xe8\xbf\x98\xfe\x97\xcc\x78\x7c\xef\x2e\x42\x34\x2d\x14\xfa\x89\x6b\x15\xbd\xdb\xb3\xac\x5a\x7b\x27\xdd\x1e\x36\x5b\x35\xcb\xf0\x22\x60\x6d\x52\x4f\x6d\xad\x3b\x22\x1e\x4a\xb3\xda\x02\x33\x3f\xa3\x59\x19\x02\xa7\xa3\xae\x84\x74\x6f\x31\xf6\xdf\x04\x09\xee\x73\xd0\x65\x27\xa2\xdd\x9b\x3a\x7f\xad\xee\xf9\xba\xd0\xf6\xb1\xf7\x36\x6c\x2b\xea\x55\x57\x62\xdb\xe1\xb2\xe7\x5e\x2c\x35\x79\x71\x73\x3f\xf6\x5e\x6c\x5b\x64\xf7\x72\xac\xf7\xfb\xfa\xb2\x6b\x2a\x34\x00\xc0\x97\xaf\x55\x42\xe1\xae\xc5\xfe\xb0\x97\x32\xab\x79\xba\x7b\x74\x75\x8c\x2a\x13\x49\x2e\x45\x9d\x73\x96\x37\xeb\x2a\x4d\x76\x8e\xf7\x9a\x96\x2b\x5d\xbc\xa5\xc9\x45\x3d\xec\xdc\x6a\x2c\x8a\xa2\x86\xbe\x86\x33\xa0\xbe\x21\x22\x2b\xa2\x71\xfd\xae\xaf\xc5\x12\x52\xd1\xbd\xb7\xd9\x6e\xe9\xb5\x62\xc3\x93\x15\x98\x71\x7f\xea\xdd\x5c\x30\x1d\x51\x68\xdb\x86\xae\xb0\xa3\x2e\x32\x4a\x61\x65\xa0\xbf\x3d\xcb\x0a\x7c\x82\x66\xca\xc8\xd8\x66\xbe\x25\xec\x1d\x84\x52\x16\xe3\xc3\x21\x20\x42\x5f\x67\x0c\x98\xa5\xb3\xfe\x80\xeb\x06\x8b\xd8\xe5\xb1\x58\xaf\x80\x2e\xd9\xf9\x4d\xd5\x23\xba\x6c\x77\xaa\x63\xfe\x7e\x11\xe8\xb9\x3e\x4a\xb3\x4f\x27\x9c\xa4\x9d\xa0\xd0\xde\x23\xb5\x8e\x46\x3b\xa7\x8c\x9d\x15\x85\x4b\xe8\x90\x71\xf3\x70\xcd\x31\x59\x70\x1b\xd3\x78\x0a\xdd\x71\xc3\xf7\xc1\xa1\x44\x1a\xe6\x98\xc6\xe6\x97\xae\x1a\xe3\x8f\x23\x5c\x93\xc3\xa1\x3a\x93\xeb\x29\xca\xd9\xc4\xca\xe5\x98\x25\x4e\xa3\x72\x1f\x29\xb2\x7b\x60\x59\x26\x6f\xed\x4e\x72\x5b\xe6\x9e\x5c\x6c\x6a\x48\x53\x58\xb0\x49\x2b\xb1\x59\xe3\xe4\x60\xa4\x8a\xcb\x39\x3e\x5a\xc3\x31\xad\xe2\x4d\x70\x29\xad\xc7\x61\x89\x58\x17\xf0\x1f\xf0\xaa\x37\x3f\x91\x4a\x47\x1f\xf0\x76\x3e\xab\xb7\x6b\x97\x7d\x9c\x1d\x55\xea\xe3\x9a\xaa\xee\x2c\xde\x72\xdc\xb3\x9b\x0c\x9d\x3a\xa8\xbd\x55\x07\xa5\xeb\x66\xcb\x04\xbc\x72\x8a\x98\x95\x27\x0d\x65\x6a\x5d\x2e\xa2\x13\xc8\x1f\x81\xc9\x79\x0f\x4e\x1e\xcf\xb5\xf6\x55\x1a\xd5\x30\x7f\xed\x25\xe5\x9b\xa3\x9e\xf2\x74\x3b\x3e\x5a\x5d\x32\xe5\x61\xcf\xfe\x71\xce\x09\x41\x31\x90\x63\x85\x1e\xd3\xd0\x41\xeb\xf6\xe7\x63\xb9\x4b\x3b\x1f\x38\x96\xb1\x50\xfb\xa7\x66\x2c\x2e\xa3\xed\x49\x58\xdc\x87\xfe\x8c\xa5\xbd\xaf\xa8\x52\x96\xce\xae\xa4\x27\x67\xf1\x23\xfa\x44\xc3\xbb\xfd\x88\xdc\xa5\x23\x7b\x44\xf2\xf2\x63\x26\x29\xbd\xf1\xb8\xda\xbc\x3d\x3d\x1e\xb7\x4c\x56\x3a\x45\x5b\x71\xcf\x13\x91\x3b\x83\x9d\x1c\x92\xbb\x12\xc6\xc4\xe4\xa3\xbd\x9e\x3b\x28\x9f\xa4\xd5\x27\x86\xe5\xee\xa2\x7e\xf8\xb8\x5c\xed\xf9\x07\xe3\xb2\x6b\x61\x23\x51\x7f\x28\x1e\xad\xd8\xef\x0e\xc6\x5d\xf5\x3e\x39\x1a\xb7\x67\x77\x34\x1c\xd7\x5a\xf8\x8e\x78\xfc\x18\x3e\x7e\x90\x80\x7c\xb2\x35\x9f\x12\x92\xfb\x9d\xff\x2f\x88\xc9\x9d\x88\x77\x2c\x28\x6b\x7f\x90\xfa\x84\xa8\x5c\xfe\xfd\xdf\x00\x00\x00\xff\xff\xe4\x99\x16\xc3\xe3\x3c\x00\x00")
+var _templateBuilderQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x5b\x5f\x8f\xdb\x38\x92\x7f\x96\x3e\x45\xad\xd1\xdb\xb0\x03\x47\x4e\xe6\xed\x7a\xd0\x07\xe4\xd2\xc9\x9d\x81\xc1\xcc\xec\x24\x8b\x1d\x20\x08\x66\xd4\x12\x65\x73\x23\x93\x0a\x49\xbb\xbb\xe1\xf3\x77\x3f\x54\x91\x94\x68\xfd\x69\xcb\x9d\x9e\xdb\x01\xf6\xa9\x2d\x89\x2c\xd6\x9f\x5f\x15\x8b\xac\xea\xfd\x7e\xf1\x22\x7e\x2b\xab\x07\xc5\x57\x6b\x03\xdf\xbd\x7a\xfd\x1f\x2f\x2b\xc5\x34\x13\x06\xde\xa7\x19\xbb\x95\xf2\x0b\x2c\x45\x96\xc0\x9b\xb2\x04\x1a\xa4\x01\xbf\xab\x1d\xcb\x93\xf8\xe3\x9a\x6b\xd0\x72\xab\x32\x06\x99\xcc\x19\x70\x0d\x25\xcf\x98\xd0\x2c\x87\xad\xc8\x99\x02\xb3\x66\xf0\xa6\x4a\xb3\x35\x83\xef\x92\x57\xfe\x2b\x14\x72\x2b\xf2\x98\x0b\xfa\xfe\xc3\xf2\xed\xbb\x1f\x3f\xbc\x83\x82\x97\x0c\xdc\x3b\x25\xa5\x81\x9c\x2b\x96\x19\xa9\x1e\x40\x16\x60\x82\xc5\x8c\x62\x2c\x89\x5f\x2c\x0e\x87\x38\xde\xef\x21\x67\x05\x17\x0c\x26\x5f\xb7\x4c\x3d\x4c\xe0\x70\xc0\x97\x17\xd5\x97\x15\x5c\x5d\xc3\x6d\xaa\x19\x5c\x24\x6f\xa5\x28\xf8\x2a\xf9\x39\xcd\xbe\xa4\x2b\x06\x6e\xa6\x61\x9b\xaa\x4c\x0d\x83\xc9\x9a\xa5\x39\x53\x13\xb8\xe8\x7e\xe2\x9b\x4a\x2a\xe3\x3f\xd9\x27\x98\xc6\xd1\x7e\xff\x12\x54\x2a\x56\x0c\x2e\xaa\xd4\xac\x71\xb1\x8b\xe4\x03\xbf\x2d\xb9\x58\x2d\x69\x94\x46\x62\x51\x34\x21\x76\x70\xc8\xe1\x30\xb1\xf3\x98\xc8\xf1\xdb\x2c\x26\x01\x2e\x6e\xb7\xbc\x44\x75\x11\x89\xbf\xa1\x18\x3f\xa6\x1b\xe6\x25\x51\x2c\x63\x7c\x67\x3f\xd7\xbf\xeb\x39\xc8\xd4\x62\x01\x21\x99\xc3\x01\x4d\x81\xba\xf5\x6f\x0a\xa9\x80\xd4\xc3\xc5\x0a\x87\x56\xa9\xce\xd2\x12\x2e\x12\xb7\x0e\x30\x61\xb8\xe1\x4c\x27\xb1\x79\xa8\x58\x9b\x9a\x36\x6a\x9b\x19\xd8\xc7\x51\x46\x7a\x8c\xa3\x92\x6f\xb8\x89\xa2\x17\x5c\x98\x38\x92\x45\xa1\x59\xf3\xa4\x72\xa6\xa2\xe8\xd3\xe7\x9f\xf0\xc7\xfb\xad\xc8\xe2\x68\x2b\xf8\xd7\x2d\xc3\x97\xda\x28\x2e\x56\x71\x54\x29\x96\xf3\x2c\x35\x4c\x43\xf4\xe9\x73\xfd\x94\xec\xf7\x0d\x57\x56\x57\x77\xdc\xac\xe1\x22\x79\x97\xaf\x98\x53\xe8\x62\x01\x2c\x5d\x31\xf5\xb2\x94\x69\x8e\x12\x31\xfc\x96\xc4\x51\x68\x13\x86\xea\x4a\xec\x84\x08\x69\x04\x62\xb3\x5a\xee\x17\xb8\x1e\x4b\x3e\x3e\x54\xec\x58\xf1\x51\x68\xa7\xce\xef\xc5\x0b\x78\x93\xe7\xdc\x70\x29\xd2\x12\x0a\xce\xca\x5c\x83\x91\x90\xe6\x39\xfe\x09\x54\x9f\x00\xe1\x94\x66\x5d\x98\x4d\x55\x22\x5b\x95\xe2\xc2\x14\x30\xc9\x79\x5a\xb2\xcc\x2c\xfe\xaa\x17\x64\x9d\x85\xa5\x34\x81\x8b\xe4\x83\x91\xca\x21\x95\xe6\xf2\x02\xd6\xa9\xfe\xe8\x51\x69\x49\xd5\x7c\xde\xd7\x70\xb5\x1f\x92\x0e\xd7\x8b\x05\x70\x61\x98\xda\xb0\x9c\x23\x01\x5a\x0f\xa6\x3c\x61\x09\x18\x95\xee\x98\xd2\x69\x09\x08\xe4\x59\x82\x33\x8f\x58\x80\xf0\x39\xf9\xaf\x1a\x18\x71\x84\x13\xa0\xd8\x8a\x6c\x9a\x49\x61\xd8\xbd\x41\x4f\xc3\xbf\x33\x98\x0e\x4c\x9a\x03\x53\x4a\xaa\x59\x6c\x81\xfb\x8f\x35\x53\x0c\x15\xa7\x21\x05\xc1\xee\xa0\xc6\x02\xa1\x36\x54\x65\x8c\x0b\xc1\xf4\xc8\x27\xbc\x0d\xdd\x18\x38\x1c\x66\x96\xe4\xb4\xd2\x90\x24\x49\x3f\xb2\x66\xed\x49\x88\xed\x90\xee\xe1\xd0\xcc\xd4\x70\x0d\x69\x55\x31\x91\xb7\x97\x0e\xc6\xcc\xa1\xd2\x49\x92\xcc\xe2\x48\x31\xb3\x55\x02\x5a\x43\x9d\xb4\x3f\x37\x44\xed\x38\xeb\xa7\xc1\x5a\x66\x9d\x1a\xb8\x73\x3a\x61\x5d\x38\x7d\xc4\xf0\x48\x53\x59\x0e\x1a\x23\x2a\x6a\x91\xa3\xf6\x32\x59\x3d\xcc\x21\x15\x39\x64\xeb\x54\xac\xd0\x33\xb8\x81\x5c\x32\x0d\x42\x1a\x48\x8b\x82\x65\xe6\x98\xda\xdf\x35\x83\x0f\xcc\x04\x6c\x59\xa5\xa7\x66\xb4\xb6\x9b\xb9\xd3\x19\x0c\xb9\x32\xec\x6b\xc5\x38\x55\x0e\x8d\xdc\x1f\xe6\x30\xac\x66\x52\xb1\x55\xe5\x31\xdb\x8a\x55\x65\x9a\xb1\x8e\x3a\x65\x11\x0a\x0c\x18\x08\xe8\xc5\x8a\xef\x98\x08\x06\x26\xf0\x51\xae\x98\x59\x33\x85\xb4\x69\x58\x43\x7d\x0e\xdc\x40\x5a\x96\xf2\x4e\xc3\x5a\xca\x2f\x9a\x94\x5c\x29\xbe\x4b\xb3\x07\x50\xdb\x12\xd7\x95\xc0\x85\xae\x50\xc3\xf8\x51\xb1\x3b\xc5\x0d\xa3\xb5\xac\xaf\x15\xbc\x34\x4c\xe9\xd1\x7a\x3d\x92\xef\xdb\xd0\x0c\x03\x70\x7e\xcc\x06\xa3\xe0\xfc\x03\x6e\x03\xde\x79\x69\x4f\x00\x6d\x58\xe5\x41\x4b\x82\x8f\x16\x98\x88\x4d\x2d\x15\x2e\xcc\x28\xa9\xec\xe8\x6b\xb8\xa4\x1f\x27\xb8\xfd\x89\xf6\x29\xc7\xae\x00\xbb\x6d\x7d\x03\xc3\x96\xde\xd4\xd1\x19\xcb\xb2\x1b\x7e\x0d\x97\xf6\xd7\x29\xa6\x71\x17\x6d\x78\xa6\xa7\x6f\x60\x19\xe7\x4f\x25\x46\xc6\x7a\x7b\x1e\xc7\x35\x8e\x1e\x0e\x84\xf4\x79\x0e\xf2\x14\x66\x30\xe5\xb4\xb9\x1c\x65\x8c\xeb\x54\x83\xe6\x1b\x5e\xa6\x8a\x9b\x07\xeb\x9d\x2c\x5f\x59\xa9\x38\xd3\x98\x0f\x66\x25\x67\xc2\x24\xb4\xaf\xd1\x5e\xba\xdf\xfb\x3d\xfe\xb7\xb9\xdb\xe7\xc3\xf4\x00\x59\x43\x1a\xbf\x79\x81\xfc\x86\x0b\xd3\x66\xff\xa7\x0d\x1f\xdd\x67\x06\x93\xbf\xd5\x79\x63\xb4\x58\x00\x3d\xf5\xe6\x0a\xd9\x3a\xe5\x2e\x5e\x67\x5b\xa5\x30\x4b\x46\x36\x1f\x40\xda\xa4\x75\xbf\x0f\x47\x23\x0b\x49\x1c\x8d\xb4\xcb\xe0\xaa\x53\x67\x9d\x23\x89\x2c\xb0\x22\xbb\xfa\xd5\x35\x5c\xf6\x8c\xd8\xdb\x54\xed\xaa\x6d\x85\xc4\xbe\x3f\xf8\xf9\x09\x6d\xe1\xd7\x6e\x13\x37\xf7\xd0\xdd\xc8\x0b\x25\x37\x7f\x1f\xca\x01\x68\x3b\x77\x5b\x3a\x71\x15\xf1\x02\x1f\x31\xcf\x69\x2f\x5d\x29\x56\xa5\x8a\x91\xb0\xd3\xcc\xdc\xcf\xbe\xa7\x91\x7f\xb9\x06\xc1\x4b\x3b\xd9\x63\x47\xf0\x92\x28\xe3\x3b\xe4\xb5\x49\x05\xd9\xbd\xc1\xa4\xe6\x02\x26\xbf\x38\xd2\x93\x60\x95\x09\x02\x61\x82\xb0\x98\x2c\x73\x26\xcc\x04\x26\xc4\xfe\x04\x5e\x22\x38\x88\xd0\x88\x44\x0c\x95\xd2\x4e\xc3\xa2\xc7\x72\xad\x26\x5f\x74\xeb\x38\x39\x68\xf1\x39\xca\x17\x5b\x41\xdc\x7b\xd2\x7d\x1c\xd1\x59\xc5\xe5\x68\xb8\xf1\xbc\xe7\x4a\x9b\xa3\xd4\xa0\xa0\x37\x61\x74\xb6\xc9\xfa\x83\x3f\x2b\x11\xa5\x04\x7e\x71\x73\x5e\xfc\x28\xcd\x7b\x3c\x5f\xbd\x43\x93\xc0\xdd\x9a\x09\x10\x12\xad\x57\xca\x3b\xa6\x02\x32\x77\xa9\xb6\x27\xb1\xd1\xd1\x83\xb8\x1b\x00\xc9\x8b\x90\x45\x9f\xe3\xb9\x48\x52\x95\x5b\x85\xa8\x4e\xbc\xc5\x6a\xdc\xf4\x80\xc4\x6e\x03\xaf\x67\xc9\x9b\xb2\xc4\xb5\x66\xb1\x47\x54\x80\x93\x0e\x4a\x0e\x34\xaa\x64\x62\x3a\xb0\xde\x0c\xae\xaf\xe1\x55\x67\xf2\xe5\x91\xba\xf6\xc4\x4d\x70\x4c\x4c\x7e\x48\x6f\x59\x79\x40\x43\xf9\x69\x03\xf4\x3f\xbd\xfa\x6c\xcd\x1c\x18\xf2\x57\x3c\x87\x95\xfc\x0b\xb3\x8f\x73\xb8\xdd\x1a\xa8\x52\xc1\x33\x0d\xbc\x80\x54\xa0\x0e\xa4\x02\x99\x65\xdb\x33\x32\x03\x22\xf6\x6b\xbf\x1d\x8e\xcc\xe0\x03\xf9\x28\xbd\xd7\xc6\xed\x28\xfc\xf2\x12\xfe\xb2\xd4\x5e\x51\x53\xa6\x9c\xa7\x93\x24\xf4\xd8\xd2\xcf\xd1\x82\xa1\x42\x96\x37\xa7\xb0\xcd\xf3\xf3\x70\xcd\xf3\xa7\xe2\x78\x79\x33\x80\x64\x9e\x5b\x96\x96\x37\x74\x2e\xec\x89\x71\xbb\x54\x01\xcf\x35\x7c\xfa\xdc\x1a\x48\x9a\xe3\xb9\xb6\x4a\x7e\x04\xdb\xcb\x1b\xdd\x1f\x00\xad\x7a\x42\x3c\xf3\x5c\x07\xd8\xb5\x74\xc7\xa2\x36\x24\xe7\xcc\xc3\x73\xdd\x0b\xd5\xe5\xcd\x31\x58\x97\x37\xcf\x0b\xd7\x21\x75\xb7\x34\x88\x42\xf2\xfc\x71\x90\x2e\x6f\x9e\x01\xa6\x3c\x77\xe2\xff\x24\xca\x87\x23\x54\x4a\x7c\x71\x2a\xe0\xce\xeb\x29\xb5\x5a\x78\x41\xc7\x2c\x76\x9f\x66\xa6\xc4\xac\x80\xf9\x89\x88\x50\x7f\x66\x1b\xad\x36\xe4\xeb\xff\x27\xd6\x7e\x77\x7e\xac\xd5\x77\xdc\x64\xeb\xc7\xe3\x2d\x5e\x17\xe1\xed\xdb\xeb\xab\x86\xc8\xa9\xe0\x69\x67\xbc\xba\x7a\x62\x94\xce\x59\x91\x6e\x4b\xd3\x37\xfd\x03\x17\xab\x6d\x99\xaa\x13\x14\xea\xb4\x5b\x94\x0f\x4d\xf8\x46\x5b\x3c\x97\x3b\x20\xad\x67\x0f\xde\x1e\x2c\xbd\x06\x3c\x2b\x4e\x23\xa5\xe5\xcd\x09\x87\xe0\xf9\x13\x9c\x81\xe7\x4f\x77\x84\x7f\x5d\xb0\xfe\x6e\x5c\xb0\x0e\x1c\x82\x02\xf6\x11\xf8\x79\x0e\xd7\xb8\xd2\xa7\x57\x9f\x43\x84\x9f\x17\xcb\x03\x6c\x37\x13\x47\xa3\xda\xf3\x1a\xa0\x3b\x88\xf8\xf8\xfc\x7c\x01\xdf\x51\xef\xb7\xd8\x79\xf1\xbe\xb1\xfd\x19\xc8\xae\x43\x3b\x96\x2d\xd8\x3d\xcb\xb6\xc6\x5d\x0c\x11\x5a\xdd\xfd\x8c\x03\x2c\x94\x5c\x1b\xac\x30\x84\xa1\xc9\xe1\x7c\xb4\xc4\x2e\x7c\xf6\xe0\xf3\xd3\xe7\xc1\x60\xcd\x8b\x21\xa9\x4f\x9f\x93\xfa\x62\xb2\x7b\xd7\x26\x16\x9e\xdb\xe0\x70\xa8\x23\x7d\xad\xa2\x26\xcc\xbd\x29\xcb\xe7\xc2\x00\xd2\xed\x57\xc9\xa7\xcf\x7d\x61\xae\x6f\x57\x18\x44\x45\x2d\xc3\x39\xc1\xae\x6f\x05\x87\x93\xe5\x8d\x3e\x0b\x27\x0d\xf3\x3c\x1f\xaf\x12\x17\x46\x7a\x41\xd2\xf2\x8a\xf9\xe8\xf8\x35\xa0\xa1\x0f\x0c\x0b\x0b\xd3\x76\x3c\x78\x8f\xf5\x85\xe5\xcd\x2c\xf9\x90\xa5\x02\xcd\x33\x87\x4b\x0c\x57\xe7\xe0\x8b\xd2\xdb\x26\x7b\x5c\xde\xe8\x06\x40\xcb\x1b\xfd\x5c\x00\x42\xba\x43\x00\x6a\x29\x02\x39\xae\xe3\x78\x8f\x32\x7c\xfc\x1e\x0f\x17\x9e\x6b\x27\xde\x5b\xb9\x15\xc7\x07\xf2\x8c\xde\xb8\x7b\x65\x7b\x8d\x7c\xde\x1d\x1c\x91\x1c\x40\x02\x17\xe6\x99\x43\xc4\xab\x73\x03\x44\xcd\x9e\x0f\x11\xf4\xa2\xb1\x31\x3d\x3e\x97\x95\x89\xd8\x80\x9d\xb9\x70\x25\xc7\xad\x53\x4a\x9f\x1e\x02\x6e\x47\x5b\x97\x2c\xe8\x84\x7b\x77\xcf\xc3\x0b\x17\xb5\x65\x28\x4e\x13\x03\xf0\x86\x92\x95\x6c\xc3\x84\xd1\x3e\xe7\x59\xa9\xb4\x5a\x8f\x16\x91\x56\x18\x30\xf7\xad\x94\xe5\x33\xdb\xbb\x48\x4b\xcd\xce\xb5\x79\xcd\xa3\xb7\x39\xbd\x68\x6c\x4e\x8f\xcf\x65\x73\x22\x36\x60\x73\x54\x08\x4a\xc3\x70\xcc\xa0\xd1\x03\x76\x47\x1b\x9d\x28\x3a\xe9\xde\x96\x78\x38\xf3\x46\x4f\x21\xdf\x56\x25\x55\x41\x7c\xb9\xc8\xda\xde\x31\x3d\x07\x2e\xb2\x72\x4b\x95\xe6\xb4\x2c\x21\xd5\x5a\x66\x58\x44\xcd\xa9\x76\xa0\x13\x58\x1a\xc8\x52\x01\xb7\x0c\x55\xb7\xc5\xf6\x07\x23\xc1\x59\x0c\x32\xb9\xd9\x48\x71\x4c\x12\xef\xf2\x73\xd8\x6a\xaa\x0f\x6d\x20\xe7\x45\xc1\xf0\x42\xb9\x7c\x80\xb4\x30\xae\x71\x22\x23\x2e\xb9\x86\x4d\x9a\xb3\xd1\xda\x25\xd9\xa6\xb3\xf6\x87\xa0\x00\x77\x79\xfc\x05\x63\x85\xbf\x2b\xee\x5c\xfb\xdb\x0f\xf3\x38\x8a\xa8\xc0\x72\x05\x51\x67\x08\x7d\xc0\x11\xb6\x9c\xd1\x43\xc4\x7e\xa0\x21\x58\x26\x40\x22\x75\x01\xaa\xae\x42\xf4\xd5\xfd\xa8\xaa\x80\x25\x05\x9c\x6b\x7b\x08\xae\xa0\x99\x6b\x7b\x09\xfa\x26\xda\xb1\x7e\x66\x53\xfa\xba\x1a\x53\xf9\x6a\x13\x6b\xa6\x7b\x82\x8b\x85\x37\x4e\xa7\xa4\x6e\xbb\x10\x8e\x9c\xeb\xea\x94\xf7\x25\xce\x66\x48\x1a\x2f\x9e\xbb\x13\xf0\xed\xdc\x1d\x4e\xdb\x3d\x0e\x9d\xda\x87\x37\xed\xd5\x75\x5d\xe9\x38\x6e\x6d\x58\x2c\x00\xfe\x31\xd4\x11\x61\x58\x59\x06\x49\xd0\x4b\x4f\xcd\xc8\xa0\xe9\xc2\x0e\x10\x32\xf7\x05\x6b\x0b\x74\x21\x58\x86\x6e\x61\x24\x2d\x82\x63\x26\x47\x55\x91\x09\x60\x95\xc2\x16\xb1\x65\xe5\xda\x27\x52\xb5\xda\xda\xf8\xea\x5d\xc7\xa2\x6e\xab\xc2\xf2\xa9\xe7\xc3\x79\xe8\x79\xe5\x95\x21\x69\xa7\xb2\x32\x54\x58\x45\xe7\xb2\xd7\x2a\x2c\x98\xd7\xeb\x45\xed\xb2\xcb\x59\x25\x17\xac\xae\xff\x36\x07\x59\x19\x24\x60\xcd\x48\x3c\x20\xe1\x48\x56\x66\x4a\xd4\x67\xae\x58\xd0\x26\x34\xd8\xc7\x72\xed\x0b\x0a\xde\xc9\x5b\x33\x11\x3b\x41\x3b\x08\x56\x1d\x2e\x56\x4a\x6e\x2b\x5f\xc8\xb9\xba\xae\xa9\x5a\xa2\xff\x5b\x17\x47\xfe\xaa\xff\x9b\x46\xda\x1a\x19\x86\x38\xf7\x5c\xdb\x8b\x28\xc1\x8e\x29\xc3\xb1\x04\x7f\x6b\x2f\xbf\xa4\x82\x8d\x54\xcc\xb5\xc7\x2c\x32\x59\x6e\x37\x42\x27\x48\x60\x69\x70\x6b\x91\x85\x61\xc2\x12\x41\xc1\x20\x5d\xad\x14\x5b\xa1\x2b\xa1\x39\x10\x1d\x7a\x4e\xfb\x0f\x39\xc4\x3f\x25\x17\x30\xfd\xc2\x1e\x74\x33\x70\x06\x93\x39\x20\x5b\x49\x5c\xd7\x87\x4a\x26\xe0\xc2\x66\xba\xe4\x14\xf8\xe1\xa2\x40\x75\x73\x91\xb3\xfb\xe6\xdb\x2b\xfc\xba\x58\x20\x3f\xef\xee\xd3\x4d\x55\xb2\x2b\xfb\x48\x57\x06\x3b\xa0\x00\x63\xfb\x9e\x16\x0b\xeb\xd5\x45\xf2\x81\x5a\xa1\x88\xba\x6f\x8c\x29\xea\x3c\xf4\xf7\x70\xcc\xc7\x74\x05\x87\xc3\xef\x48\x2f\xa2\x2c\x85\x12\x9a\xdf\xff\xa9\xa5\xb8\x9a\x50\x0a\x32\x97\x1b\x8e\xc5\x24\xf3\x30\xa1\x61\x8e\x9b\xc8\x55\x3c\x03\x43\x7b\x3b\xdb\x1e\xa5\xe9\x0c\x95\x18\x45\xce\x0c\x9d\x2c\x1f\x9f\x0b\xcc\x32\xb4\x49\x85\xc1\x5d\xc1\x8e\x7f\xe3\xd5\x36\xf5\x0d\x72\x75\x02\x35\x73\x43\x82\x73\xc1\x6e\x86\xec\x04\xa0\x19\xe9\x6b\x9e\x2b\x32\x3b\xd8\x18\x3d\xf7\x3d\x52\x49\x92\xd8\x37\xce\xb5\x8e\x30\x88\xfa\x8c\x23\x7a\x55\xbb\x57\x6b\xc0\x69\x17\xa3\x09\x89\x5b\xee\x1a\xda\x9b\x05\x7d\x38\x78\x7e\x30\xa0\xfb\x29\xa7\xeb\xa0\x95\x62\xbb\xd1\x65\xd0\x6f\x49\xe5\xba\xc7\xaf\xb0\x74\x78\x62\x37\x71\x10\x71\xf7\xa9\x4d\x02\x44\x52\xc6\xce\xf7\x35\x9d\x0f\x47\x39\xbf\x3d\x4a\xd6\xbe\x6f\x1f\x7b\x1c\x9c\x4a\x9d\xdd\x43\xd1\x9f\xd9\x2f\xcf\x75\xb8\x81\x53\xf5\x90\xbf\x3d\x83\x33\xb9\x15\x47\xf9\xd2\xb1\x4d\xad\x33\xd9\x77\x52\xd5\xfe\xd4\x1e\x74\xda\xa1\x3c\x89\xf3\x7c\xca\xf5\x2c\xb2\xaf\x81\xb7\x10\xb4\x26\xfa\x6b\x49\x60\x8a\x1a\xca\xb4\x77\x75\x6f\x60\x8f\x3b\x18\xeb\xd1\x7f\x7e\x47\x1d\x23\xfc\x23\xeb\x66\x6b\x96\x7d\x79\x77\x5f\x29\x3d\xed\x59\xb2\x67\xcd\x28\xec\x38\x68\x53\xd3\x5f\x4b\x0f\xe2\x37\x94\xfc\x5a\x4c\x21\xfd\x36\xfe\x12\x86\x8b\xa2\x01\x5d\xf4\xb0\x06\x28\xb5\x6f\x80\x78\x4a\x04\x3a\x32\x62\x13\x8e\xbc\x39\x31\x22\x8d\x74\x86\xb6\x3d\xba\x96\x27\xb3\xa2\x65\x78\xd1\x61\x92\x60\x13\x28\x12\x41\x32\x78\xc2\xc4\xc1\xee\x80\xe9\x0d\x15\xda\xc0\xc9\x10\x5a\xfc\x51\x8d\x00\xb6\x9a\xb0\x1d\x66\x61\x1e\x1c\x17\xc9\xff\xa4\xfa\x67\x59\xf2\xec\x81\x34\xd3\xc2\x43\x18\x5f\xec\xa8\xe4\xdd\x2e\x75\x96\xa4\x8b\xbb\xd6\x92\xb3\xef\x4f\x72\x19\x1a\xc2\x7d\x73\xf7\x78\xfb\x7d\xbb\xb7\xc6\x39\xcc\xa4\xb1\xc0\xc4\x71\x34\xf1\xa9\x43\x3c\xaa\x95\xa6\xdb\xcc\xdc\xdf\x41\x13\xf4\xc1\x50\x93\x18\x6d\x57\xb7\x4d\xde\x5f\xb7\xfb\xdb\xbc\xf5\x97\xde\xa6\xf8\x56\xb6\x50\x77\xc6\xb7\xde\xf7\xb5\xc7\xd3\x90\x97\xb7\x0f\x63\xdb\xe3\xdb\x24\xbb\x3d\xf2\x2e\x5e\xfa\x30\x19\x47\x85\xd0\x00\x00\x9f\x3e\xd7\x89\x98\xed\x8e\xff\x63\x3a\xca\x89\xc1\x7f\xc7\x8e\xf2\x5a\xbb\xb6\x6b\xb2\xc9\x48\xfc\xb1\x81\x4b\xd1\x9c\x30\xbc\x76\x6b\xfb\xbb\xbc\xa5\x89\x49\xc7\x78\xf3\x81\xa9\x65\xff\x59\xb3\xec\x14\xed\x9c\x24\x49\xfd\x22\x68\xb2\x6c\xa3\xc6\xd5\x78\xdb\x4b\x24\x85\x08\x36\xda\xa1\x11\x73\x28\x84\xdb\x6e\x9d\x3b\xf7\x8d\x74\x5a\xc1\x64\x04\xb3\xe1\x92\x33\xdd\x23\x30\x5d\x48\x69\x1c\x83\xdf\x14\xd3\xdb\x92\xba\x70\x9d\x72\x28\xa3\xdb\xa5\xe5\xf6\xe8\x22\x6a\xa4\x66\x7c\x1e\xd4\x8e\xd7\x73\xd8\xe1\x12\x4c\x15\x69\xc6\xf6\x87\x20\x7c\xbb\xaa\x72\x10\x0f\xdb\x4b\x85\x11\xba\x1b\xa0\x9d\x3a\xfc\x25\x68\x2f\x81\x10\x4c\x47\x47\xe8\x47\x74\xd9\x8e\xeb\x4d\x86\xb7\xf3\xe8\xc3\x57\xcd\xc5\x29\x3e\x9d\x71\x6f\x7a\x86\x42\x7f\x1d\xa5\xd1\xce\x9d\x72\x47\xa2\x50\x84\xef\x1f\xbf\x4a\xa5\xd0\xec\xef\x9e\xb0\xf7\xd6\xb8\xd0\xb3\xe1\x86\xef\x82\x2b\xa8\x22\x3c\x51\x18\x3c\x4d\xd8\xda\x9b\x0b\x1b\x28\x53\x81\x2a\xf7\x37\xb0\x3d\x25\x58\x3c\xb6\xda\x13\x85\xc7\x69\xe2\x6f\x0d\xb0\x1b\x81\x9a\xf1\x59\x6e\xdb\xc0\xea\xff\x6c\xaa\x21\x4d\x9b\x19\x1e\x51\x28\xfc\x1d\xdd\x13\x8d\x54\xb1\xe7\xf1\xd1\x8a\x9d\x09\x82\x8f\xcf\x25\x5d\x4b\x4c\x17\x3a\xc4\x8a\x9e\xc1\x7f\xc2\xeb\xde\xdc\x51\x2a\x9d\xfc\xc8\xee\xa6\x93\xe6\x70\x7e\x05\x3d\xbc\x25\xb5\xfa\xb8\xfb\xbf\x8e\x6c\xcd\xd9\x2e\xbd\x2d\x99\x55\x07\x8d\xc7\xcb\x6a\x3a\x9c\x99\x75\x2a\xe0\xb5\x3d\xa3\x4d\xfc\xbd\x92\x3f\x48\x79\x21\x3a\xe9\xc7\x23\x30\xb9\xec\xc1\x49\x5b\x16\xb7\x8c\x7b\xbb\x73\x99\xe0\x21\x3e\x32\x7f\xe3\x25\xfe\xcd\x49\x4f\x79\xba\x1d\x07\xea\x0d\x8d\x0a\x48\x8e\xdd\xfc\x51\x25\x78\x62\x8f\x64\x86\xa1\xc7\x1c\xe9\xa0\xd5\xeb\xfb\x58\xc6\xd5\x12\xe2\x64\x9e\x45\xe3\x9f\x9a\x67\xd9\x3c\xbc\x27\xcd\xb2\x1f\xfa\xf3\xac\xf6\x29\xb2\x4e\xb4\xda\x1f\xfa\x32\x2d\xb7\xa2\xcb\x71\x64\x31\x36\xe3\xea\xd0\x1e\x91\x72\xfd\x31\xa9\x95\xe5\xe4\xdf\x2e\xb7\xea\x4d\x23\xfc\x39\xee\x1b\xd2\x88\x16\xd2\xbc\x2f\xb7\xed\xfd\x3c\x89\x44\x67\xb1\xb3\x33\x89\x2e\x85\x31\xa9\xc4\xc9\x59\xcf\x9d\x4b\x9c\xa5\xd5\x27\x66\x13\x5d\xa1\xfe\xf4\xe9\x84\xc7\xeb\x70\x3a\x61\x47\xe0\x06\xda\x9f\x41\x8c\x56\x6c\xb8\x5d\x3c\x29\x87\xe8\xaa\xf7\xc9\x49\x44\x9b\xbb\x93\x59\x44\xa3\x85\x6f\x48\x23\x1e\xc3\xc7\x9f\x24\x8f\x38\xdb\x9a\x4f\xc9\x24\xba\x7a\x78\xc6\x54\x62\xb1\x80\x8f\x7c\xc3\x74\x07\xff\x86\xde\x7e\x0b\xea\x9f\xa0\x26\x62\x65\x10\xf1\xc8\x52\x82\x43\x42\xc8\x9f\x85\xf8\x16\x42\xc6\x03\x1e\x57\xd5\x4f\x44\xfb\x21\xae\xb1\x5e\x4b\x10\x7f\x23\xd6\x5b\x82\x84\x77\xa3\x0e\xe8\x81\x6d\x1b\x8c\xd3\xe3\x1f\xb1\x0d\x10\xe1\x41\x70\xd7\x62\xa3\x11\x4e\x81\xbb\xc6\x40\xef\x96\x7a\xbc\x0b\xd4\x32\x9f\xba\x87\x6c\x73\x7c\x32\x2d\xd6\xae\x70\xf5\x84\xbc\x18\x98\xc8\xe1\x70\x88\xff\x6f\x00\x64\xe4\xac\x9c\x22\x45\x00\x00")
 
 func templateBuilderQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -221,12 +224,12 @@ func templateBuilderQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 15587, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/query.tmpl", size: 17698, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\xdd\x6f\xdb\x36\x10\x7f\xb6\xfe\x8a\x9b\xe0\x01\xb2\xd1\xd0\x6d\xdf\x36\xc0\x0f\x59\x9d\x00\x06\xb6\x14\x98\x9b\xa7\xa2\x18\x14\xf1\xe8\x70\x55\x48\x95\xa4\xbc\x05\x1a\xff\xf7\x81\x1f\xfa\xb0\xec\x38\x71\xba\xbd\xc9\xbc\x4f\xfe\xee\x77\xbc\x73\xd3\x2c\xe6\xc9\x07\x59\x3d\x2a\xbe\xbd\x37\xf0\xfe\xed\xbb\x9f\x2e\x2a\x85\x1a\x85\x81\xeb\xbc\xc0\x3b\x29\xbf\xc2\x5a\x14\x04\x2e\xcb\x12\xbc\x92\x06\x27\x57\x3b\xa4\x24\xf9\x74\xcf\x35\x68\x59\xab\x02\xa1\x90\x14\x81\x6b\x28\x79\x81\x42\x23\x85\x5a\x50\x54\x60\xee\x11\x2e\xab\xbc\xb8\x47\x78\x4f\xde\xb6\x52\x60\xb2\x16\x34\xe1\xc2\xcb\x7f\x5d\x7f\xb8\xba\xd9\x5c\x01\xe3\x25\x42\x3c\x53\x52\x1a\xa0\x5c\x61\x61\xa4\x7a\x04\xc9\xc0\x0c\x82\x19\x85\x48\x92\xf9\xc2\xda\x24\x69\x1a\xa0\xc8\xb8\x40\x48\x35\x1a\x83\x2a\x05\x6b\xdd\xe9\xf4\xae\xe6\xa5\xcb\xe1\xe7\x25\x54\xb9\x2e\xf2\x12\xa6\x64\x53\xc8\x0a\xc9\x2f\x51\x12\x15\x15\x16\xc8\x77\x41\xb3\xfb\xee\xcc\xa3\x12\xe3\x58\x52\xed\x54\xa6\xe4\x3a\x7c\x47\x49\x5d\xd1\xdc\x04\x6b\x96\x97\x1a\xc3\xf9\x05\x70\x06\x52\x41\x76\x9f\xeb\x4d\xcd\x18\xff\xbb\x77\x99\xde\x7a\x93\x74\x76\x4a\xfa\x51\x38\x05\x6b\x93\xc9\x30\xc8\x12\x8c\xaa\xb1\x3b\x8e\x59\xb9\xa4\x7e\xab\x4d\x7e\x57\xe2\x30\xb7\x0b\x40\x97\x0f\x67\x30\x25\xeb\x15\xb9\xd5\xa8\x56\x1e\x2b\x7a\xe8\x20\xaf\x2a\x14\xb4\x3b\x70\x06\x9d\x13\xe1\xf5\xdd\x65\x55\x2e\xb6\x08\xd3\x3f\xde\xc0\x94\x79\x2c\x58\x17\xce\xbb\xab\xf6\x31\x64\xe4\xd3\x63\x85\x64\x63\x14\x17\xdb\x3e\x66\x2d\x0a\x5f\x15\xc5\x85\x81\x74\x83\x26\x75\xaa\x1b\xa3\xea\xc2\xf8\xfc\xbd\xea\x62\x01\x9d\xb6\xb5\xa0\xd1\x68\xcf\x0d\x7f\x48\x6e\xf2\x07\x07\x03\xf8\x04\x48\x32\xf1\x6a\xd9\x5e\x39\xad\x85\xf9\x90\x08\xd6\xce\x86\x1e\xb3\x90\xb0\xb5\xd1\xa3\x4b\xd5\xeb\x8c\x8c\xa0\x49\x26\x13\x87\xc3\x62\xee\x92\x30\xee\x2a\xa2\x7e\x40\xc5\x0b\x30\xce\x46\xee\x50\x29\x4e\x11\x2a\x85\x3b\x2e\x6b\x0d\x45\x5e\x96\x1a\x8c\x84\x4b\x4a\x09\x78\xa2\x06\x17\x9c\x41\xee\x51\x0e\xc0\xdc\x44\x37\x5d\x79\xbd\xe2\x64\x74\x0b\xf2\x50\x9b\xdc\x70\x29\x48\xd3\xb4\xa0\xfd\x8e\xfa\x28\x6c\xd9\x2c\x46\x8a\x45\x3b\xed\xec\x00\x0a\x67\xad\xd0\xd4\x4a\xc0\xc8\x2e\x99\xd8\xc4\x95\x6f\x31\x87\x7c\x27\x39\x85\x2d\x0a\x54\x01\x0c\x5e\x96\x8e\x7a\x10\x1a\x50\x03\x93\xaa\x3f\x74\x10\xe9\x16\x84\xa6\x69\x21\xc8\x84\x34\x3d\x0e\x51\x79\x06\x99\xf4\xb4\xf9\x58\xb9\x14\x5d\xcb\x32\xb2\x42\x96\xd7\xa5\x99\x05\x93\xcc\xe3\xd7\xe2\x35\x65\x24\x74\x4b\xab\x34\xeb\x2f\xdd\x66\x70\x7d\x40\xb7\x36\xdc\x51\xda\xb5\xbc\xdb\x33\x7f\x86\x7f\xee\x52\x4e\xb4\xe5\x3b\x14\xb0\xcb\xcb\xda\x3f\x86\x2e\x5f\xc1\x4b\x92\x4c\xce\xa1\xe7\x28\x70\x4f\xd3\xf9\x0b\x78\x3a\xe1\x0c\x3a\x83\x1f\x96\x2e\x7c\x38\x3f\xe0\xc1\xb0\xfc\xf3\x61\xfd\x27\x9e\x83\x4f\xb1\x60\x12\xaa\xd8\xbe\x09\x83\x8a\x9e\x26\xf5\x91\xc6\xbf\xa4\xf4\x64\x05\xda\xce\xcf\x29\xd5\xfd\xa5\x8c\xdc\xaf\xc0\x99\xe8\xbe\xa6\xf9\xcf\xef\xa1\xd7\xc1\xd7\xb3\xfe\x39\xe8\x3e\x94\x98\xab\x17\x81\x57\x38\xcd\x40\xdc\xc0\x4b\xc9\xfe\x13\xfc\xbe\x07\xa9\x33\x10\x1a\x60\xb5\x3f\x7e\x30\x8c\xe2\x2b\xba\xc5\x7e\xfc\x48\x3f\x7f\xd2\xdc\x11\xcb\xda\x80\xed\x14\xc9\xad\xe0\xdf\xfc\xd0\x8c\x3a\x4b\xbf\x2b\x44\x95\xf6\xa1\x74\x32\x4e\xf5\xfe\x6b\x91\xb5\x9b\x83\xac\x66\x90\x69\x2e\xb6\x75\x99\x2b\xe7\xd3\xa3\xf7\x4f\xdc\x2c\x66\x90\xae\x57\xfa\xe9\x98\xad\xdf\xe3\x6e\xdb\x1f\xc1\xa9\xf7\x35\xca\x2d\xd6\xb4\x75\x13\x59\x2b\x1d\xdb\xfa\x37\x09\xbb\x37\x09\xe9\x16\xdb\x3e\xc1\xd8\x94\x51\x74\xf7\x08\x9c\x86\x24\xfd\x03\x3c\x48\x54\x77\x01\xcf\x1b\xa7\x7d\x56\xd9\xe1\xed\x7d\x30\x0c\x5b\x11\xa7\x1a\x08\x21\x5d\x98\x61\x7e\xeb\xd5\x73\xf3\xf7\x04\xaf\x5e\x9d\xc1\xe9\x71\x37\x6c\xce\xce\xe1\x14\xfb\x36\x3d\x18\x35\xeb\x95\x3e\x39\x6d\x70\xaf\x5d\x63\x9d\x0f\x47\x4e\xeb\x66\x3c\x75\x5e\x5e\xe1\xff\x65\x20\xf5\x69\x65\x9c\x06\xd5\x17\x56\xcf\x4d\x25\x4e\x9f\x9e\x47\xd6\xc2\x72\x5c\x81\x71\x65\xe7\x9c\x9e\x3b\x9d\xfa\x95\xb4\x94\x7f\xa1\x82\xcc\x17\x85\x41\xfa\x23\x79\xa7\xd3\x3d\xe4\xba\x4d\x9b\x33\xc0\x6f\xce\x6a\x0f\x97\xe0\x68\x09\xe9\x2e\x8d\x3f\x87\x21\xd8\xd3\x6f\xc6\xa8\xe4\x47\xf6\xda\x67\x3b\xb9\x69\xc6\xcd\x3a\xec\xd5\xe3\x2c\xf8\xfe\x85\xf8\xc8\x03\x31\xec\x9c\xf9\x28\xe6\x89\xbe\xdd\xeb\xc7\x0b\x7b\xa2\x7e\x47\x9a\xd9\xe7\x43\xd6\xab\x6e\xad\x75\x8d\x1c\x9d\xf0\xf0\x7f\xec\x21\xff\x8a\xd9\xe7\x2f\x47\xe9\xf8\x06\x4a\x14\xfd\x64\x9e\xb5\x23\x8a\xfb\x39\xc1\xfb\x17\xdb\xd5\x9c\x07\xad\x20\x5f\x42\xfa\xe7\xe0\x15\x8e\x21\xdd\x66\x1b\xe4\xd6\xfa\xbf\x3a\x7e\x20\xf5\xb8\x79\x66\x73\xaa\x3f\xb7\x4a\x5f\x22\xb1\x9d\xb8\x3f\x24\xeb\xd5\x33\x54\x1e\x43\xc1\xa9\x26\x84\x8c\x97\xfb\xe1\x7c\xec\xbf\xfe\x0d\x00\x00\xff\xff\x2b\x26\xdd\x47\xd7\x0f\x00\x00")
+var _templateBuilderSetterTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\xdf\x6f\x1b\xb9\x11\x7e\x96\xfe\x8a\xa9\xa0\x02\x92\x61\xd3\xb9\x7b\xeb\x01\x7e\x48\xa3\xa4\x10\x90\xe4\x0e\xf5\xf9\x29\x08\x0a\x6a\x39\x2b\xf1\xb2\x22\xf7\x48\xae\xee\x04\x75\xff\xf7\x62\x48\xee\x2e\x57\x3f\x2d\x3b\x2d\xfa\x26\x93\xc3\xe1\xec\xf7\x7d\x33\x24\xc7\xbb\xdd\xfd\xcd\xf0\x9d\x2e\xb7\x46\x2e\x57\x0e\x7e\x7c\xf3\xc3\xdf\xee\x4a\x83\x16\x95\x83\x0f\x3c\xc3\x85\xd6\xdf\x60\xae\x32\x06\x6f\x8b\x02\xbc\x91\x05\x9a\x37\x1b\x14\x6c\xf8\xeb\x4a\x5a\xb0\xba\x32\x19\x42\xa6\x05\x82\xb4\x50\xc8\x0c\x95\x45\x01\x95\x12\x68\xc0\xad\x10\xde\x96\x3c\x5b\x21\xfc\xc8\xde\x34\xb3\x90\xeb\x4a\x89\xa1\x54\x7e\xfe\xe3\xfc\xdd\xfb\xcf\x8f\xef\x21\x97\x05\x42\x1c\x33\x5a\x3b\x10\xd2\x60\xe6\xb4\xd9\x82\xce\xc1\x25\x9b\x39\x83\xc8\x86\x37\xf7\x75\x3d\x1c\xee\x76\x20\x30\x97\x0a\x61\x64\xd1\x39\x34\x23\xa8\x6b\x1a\x1d\x2f\x2a\x59\x50\x0c\x3f\x3d\x40\xc9\x6d\xc6\x0b\x18\xb3\xc7\x4c\x97\xc8\xfe\x1e\x67\xa2\xa1\xc1\x0c\xe5\x26\x58\xb6\xbf\xc7\x8b\xbe\x51\x2e\xb1\x10\x96\x4c\xc6\xec\x43\xf8\x1d\x67\xaa\x52\x70\x17\x56\xe7\xbc\xb0\x18\x56\xdc\x81\xcc\x41\x1b\x98\xac\xb8\x7d\xac\xf2\x5c\xfe\xd9\x45\x34\x7a\xf2\x4b\x46\xd3\x73\xb3\x3f\x2b\x1c\x4d\xc9\xd7\x20\xdd\xe4\x01\x9c\xa9\xb0\x1d\x8e\x51\x51\x50\x9f\x2a\xc7\x17\x05\xa6\xb1\xdd\x01\x52\x3c\x32\x87\x31\x9b\xcf\xd8\x93\x45\x33\xf3\x58\x89\x43\x07\xbc\x2c\x51\x89\x76\x80\x16\xb4\x4e\x94\x68\x7e\xde\xdf\xc0\x12\x15\x1a\xee\x50\x40\x34\xe5\x86\xe8\x5f\x97\x15\x8d\x2d\xb6\x9e\x3f\xc1\x1d\x5f\x70\x8b\x0c\x3c\x4b\x14\x2a\xb1\x43\x01\x12\x4e\x85\xb4\x0e\xea\x7a\xb7\x03\xc3\xd5\x12\x61\x9c\xd3\x68\xb3\xb7\x9f\x90\x39\x28\xed\x60\x9c\xb3\x7f\xb4\x1b\xd6\x75\xcf\x51\x17\x74\x3b\x34\xce\x83\x5b\xfa\x94\xe4\x47\xca\xe0\x43\x62\x1e\x05\x14\x83\xf8\xd7\xed\x41\x20\x01\xe5\xb2\xaf\x8c\x9c\xfd\xba\x2d\x91\x3d\x3a\x23\xd5\xb2\x43\xb2\x52\x19\xd9\x95\x46\x2a\x07\xa3\x47\x74\x23\x32\x7d\x74\xa6\xca\x9c\x67\xc5\x9b\xde\xdf\x43\x6b\x5d\xd7\x60\xd1\x59\x8f\x98\x1f\x64\x9f\xf9\x9a\xc8\x0d\xd0\xb2\xe1\xc0\x9b\x4d\x7a\x22\xad\x6b\xb8\x49\xe5\x5d\xd7\xd3\xd4\xa3\x37\x2e\xc9\x07\xfd\x08\xa1\x7a\x9b\xbd\x45\xb0\x1b\x0e\x06\x91\x52\xc2\x83\x3e\x45\x55\x6b\x34\x32\x03\xb7\x2d\x11\xf4\x06\x8d\x91\x02\xa1\x34\xb8\x91\xba\xb2\x90\xf1\xa2\xb0\xe0\x34\xbc\x15\x22\x12\x1b\x5c\xc8\x1c\xb8\x12\x2d\x30\x9f\xa3\x9b\x56\xb4\xde\x70\xb0\xf7\x15\x6c\x5d\x39\xee\xa4\x56\x6c\xb7\x6b\x40\xfb\x27\xda\xa3\xb0\x4d\xa6\x31\xd8\x48\xe7\x79\x67\x07\x50\xd0\x6a\x83\xae\x32\x0a\xf6\xd6\x0d\x07\xf5\x90\x38\xbe\xbf\x01\xbe\xd1\x52\x04\x75\x07\x30\x64\x51\x78\x91\x10\x3a\x68\x2c\xe4\xda\x74\x83\x04\x91\x6d\x40\x08\x72\x25\x08\x26\x51\xb3\x01\x87\x68\x3c\x85\x89\x36\x84\xce\xcf\x25\x85\x48\x85\x28\x67\x33\xcc\x79\x55\xb8\x69\x58\x32\xa1\xc5\x2d\x5e\xe3\x9c\x85\x1a\xd0\x18\x4d\xbb\x8f\x6e\x22\xf8\x70\x20\xb7\x66\xbb\xa3\xb2\x6b\x74\xd7\x5b\x7e\x41\x7f\xf4\x51\x34\xb5\x94\x1b\x54\xb0\xe1\x45\xe5\x4b\x3c\xc5\xab\x64\xc1\x86\x83\x6b\xe4\xb9\xb7\x71\x27\xd3\x9b\x67\xe8\x74\x20\x73\x68\x17\xfc\xe5\x81\x68\xf0\xfa\x3d\xd4\x41\x4a\xff\x4d\xb3\x84\xf8\x1f\x10\x08\x27\x55\x40\xb3\x5d\xb5\x48\x19\x3d\x2f\xea\x23\x89\xff\x56\x88\xb3\x0c\xc4\xe8\x80\x0b\x61\xbb\x8f\x72\xba\xcf\xc0\x95\xe8\x36\x9f\x7c\x4d\xf2\x5f\x9f\x43\xe7\xe0\x8b\x09\x4a\x67\x4d\xce\xe6\x76\x56\x19\xef\x2b\xc9\x7d\x5b\x2d\x52\xc1\x56\x8b\xe3\x30\x35\x38\x91\x39\x09\xb4\x5a\x38\xc3\x33\x97\x60\x95\x1b\xbd\x3e\x44\xeb\x1a\xb8\x82\xef\xeb\xd0\x3a\xf1\xf1\x3d\xb0\xee\x0e\x14\x97\x16\xad\x93\x02\xfb\x84\x66\x89\x94\x1c\x97\xd5\xe5\x4d\x9f\xa5\xaf\x35\x59\x86\xdc\xfe\x86\x5b\x0b\x3a\x4d\x66\xbd\xf8\x0d\x33\x07\x52\x39\x7d\x2a\xfb\x6f\x41\x2a\xeb\x90\x0b\xd0\x79\xf0\x6e\xb0\x2c\x78\x46\xb5\x91\x96\xfc\xb1\xd2\x05\x86\xaa\xc0\xe0\x11\xb1\xf5\xc3\x3e\x45\x1d\x35\xe4\xf4\xa3\x72\x2b\x2d\x7c\x2d\x5d\x6b\x43\xf7\xbd\x5c\xbf\x50\xeb\x1b\x58\xf3\xf2\x8b\xf5\xa7\xf0\x57\xa9\x1c\x9a\x9c\x67\xb8\x7b\x95\xda\x37\xd3\x17\x57\x89\xd9\x56\xf1\xf5\x73\x0a\xc4\xb1\x9b\xc1\xe8\x17\xee\x56\xa3\xa3\x3c\xb6\x15\xda\x43\x9d\xdc\xab\x03\x93\x42\xbb\x3b\x8b\x25\x0f\x57\xb2\x92\xbb\x55\xc3\xf4\x39\x4e\xc3\x36\x3a\xff\x7f\xe3\xd4\xc7\x1f\x28\xbd\x85\x0d\x7c\x27\x56\xc9\xeb\x2d\xbc\x82\xdb\xee\xe0\xbe\x44\xee\xbb\x02\xb9\x79\x56\x7e\x66\x64\x99\x32\xab\xf3\x3e\x65\x2f\x84\xf0\x35\x40\xbd\x00\xa1\x8b\x88\x3c\xa9\xe3\x77\xba\x43\x44\x0c\xae\xf5\x26\x96\xac\x6c\x45\x77\x72\x7b\x46\xcb\xe1\x18\x20\xf8\x9a\x4f\x9d\x20\x5b\x32\xe0\xfd\x0b\x6b\xd8\xc6\x69\x78\x44\xb7\xdb\x1d\x86\x31\xbd\xf5\x09\xec\x56\x68\x30\xd7\x06\x6f\xfd\x7e\xf1\xfe\x63\xa1\xc0\xdc\x41\xa5\x42\x38\xa2\x79\x9e\xb6\xcf\x9b\xde\xa9\xd7\xaa\xa4\xae\xe1\x49\x15\xf2\x1b\x82\x97\xc3\xb1\x6d\x6f\x43\x5c\xd2\x81\xd0\x68\xfd\x53\xc7\xa2\x4b\xf6\x76\x1a\x3e\x3f\x7d\xfc\x18\xa2\xe3\x85\xd5\x2d\x3c\x7b\x1f\x48\x17\xf2\x93\xdb\xb0\xbd\x23\xe8\x7f\x26\xa8\xb6\x60\xf8\x2b\xfd\x95\xda\x4a\x54\xd6\x7f\x9d\x21\xe9\x6a\xcc\xde\xaf\x17\x98\x3c\xcf\x72\x1a\x95\x4a\xe0\x9f\x30\xc6\xe6\x71\xfe\xa6\x99\x8e\x0f\xc9\x83\x1b\xf6\x7c\x4d\x27\x80\xbf\x9e\x9f\x92\x6e\xa8\xd4\x78\x51\xb8\x6d\x89\x8e\xef\xc6\x44\xb4\xd8\x8a\x16\x29\x68\xd1\x3c\x9b\x5f\x98\xdc\x9b\xe8\xf4\xd2\xc5\xee\xae\xff\xb0\x6e\x51\xf1\xd1\x1f\x72\x77\x22\x37\x26\x9b\x48\xa6\x07\xbc\x19\x9d\x36\x5b\x44\x8e\xce\xd3\x3a\x1c\x3c\xab\x94\x9e\xab\xa5\x47\x08\x38\x53\x4c\xaf\xe3\xe0\x3b\x26\xc4\x65\xd8\x0f\x70\x3f\x99\xb7\xfe\xc1\xbb\x8f\xf2\x69\x98\xe3\x55\xb3\xb5\xed\x7e\x76\xbf\x4e\xa4\x92\xa0\x2a\x1b\xd7\x8c\xb5\xef\x74\x8c\x38\x3d\x61\x9a\x2e\xcc\x18\xd9\x93\x92\xbf\xfb\xa6\x53\xb4\x79\xf0\xbd\xb6\x68\x12\xdd\xd3\xf6\x63\x29\x6c\xff\x5d\x3a\x69\x3a\x6f\xba\x9c\xc2\xc4\x4a\xb5\xac\x0a\x6e\x5a\x4a\xfe\x1d\x3b\x73\x53\x18\xcd\x67\xf6\xf4\x9e\x8d\xdf\xe3\x6e\x9b\x3f\x82\x53\xef\x6b\x2f\xb6\xa8\x96\xc6\x4d\xbc\xf1\x6b\xba\xa9\xdb\x63\x32\x11\x4b\x6c\x5e\x64\x18\x9f\x7f\x71\x6a\xb1\x05\x29\x92\xf6\x54\x12\xa8\x6d\x37\xbc\xae\x71\xd3\x45\x35\x39\xfc\x7a\xbf\x99\xef\xe2\xd5\xb5\x14\x16\x18\x63\xed\x36\x69\x7c\xf3\xd9\xf9\xe7\xcb\xd9\x6a\xfd\xe2\x08\xce\x37\x56\xd2\xc4\x6f\x1d\x8e\xb1\x2b\x01\x6d\xdd\x6d\x9a\x03\xf3\x99\x3d\xdb\xd7\xe8\x57\x82\xc8\x73\x57\x8f\xf7\xdd\xa4\xa5\xf9\x3a\x86\xff\x2b\xad\x8f\x2e\xac\x89\x14\x70\x93\xec\x7d\x89\x3d\xea\x7f\x48\x71\xba\xf3\x51\xd7\xf0\xb0\xcf\xc0\x3e\xb3\x37\x52\x5c\xdb\x07\xe9\x9a\x9f\x85\xfe\x03\x0d\x4c\x7c\xf6\xe5\x30\xfa\x2b\xfb\xc1\x8e\x7a\xc8\xb5\x9d\x6a\x99\x03\xfe\x4e\x8f\xe0\xd4\x71\x7c\xbb\x3f\xc0\x68\x33\x8a\x7f\xa6\x5b\xf4\xeb\x7e\x2f\xb9\x8f\x14\xff\xfb\xfb\xb4\x1a\x5f\xce\xe4\xdd\x6e\x3f\x59\xd3\x5c\x3d\xae\x82\xd7\xb7\x5e\x8f\x14\x88\x34\x73\x52\xf6\x29\xd8\x33\x79\xdb\xcb\xc7\xbb\x73\x07\xee\x91\x64\xf6\xfd\x0d\x36\x9f\xb5\x0d\xd4\xc2\xb6\x4e\xa8\x9e\xfc\xf4\x00\x6b\xfe\x0d\x27\x5f\xbe\x1e\x95\xe3\x2d\x14\xa8\x5a\x3f\xd3\x78\xf4\xc3\x58\x12\x5d\x23\xd9\x55\x6c\xe2\x5c\x86\xaf\x27\x6b\x09\x0f\x30\xfa\x2d\xa9\xc2\x71\x4b\x7a\xf7\x87\xf9\xba\x26\x17\xe1\x40\x6a\xfc\x47\x65\x4b\x61\xbf\x34\x46\x5f\xa3\xb0\x69\xba\x1b\x64\xf3\xd9\x05\x29\xef\x43\x21\x85\x65\x8c\xed\xb7\x91\x4f\x9c\x8f\xf1\x6c\xfc\x45\x17\xdb\xf4\x7c\x6c\xff\xa5\xe3\x2b\x7f\xbc\xbe\x34\x0d\x5c\xea\xf0\x06\xf8\x7c\x71\xea\xae\x97\x34\x3c\x9f\xed\x0d\xa6\x1d\xdd\x70\xe6\x6e\xfa\x58\xf6\xf3\xa7\x4b\x1f\xea\x01\x24\xb0\xb6\x4e\x5e\x7b\x73\xed\xa7\x4d\xa9\x8b\xed\x5a\x9b\x72\x25\xb3\xb6\x54\x76\xe5\x10\x95\x93\x6e\xfb\xc2\x1b\x6c\x43\x66\xdc\x71\xde\xbc\xf0\x4f\xd7\xbe\xb3\x47\xd7\xbe\xdb\x67\xbc\x32\x5a\xfa\x3b\x0c\x77\x3b\x40\x25\xa0\xae\x87\xff\x19\x00\x59\x4e\x52\x6b\x13\x1d\x00\x00")
 
 func templateBuilderSetterTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -241,12 +244,12 @@ func templateBuilderSetterTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 4055, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/setter.tmpl", size: 7443, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x6d\x6f\xe3\xb8\x11\xfe\x6c\xfd\x8a\x59\xc1\x7b\x90\x02\x5b\xce\xee\xb7\x66\xe1\x02\x77\xbb\x49\x1b\xa0\xdd\x2b\x36\x7b\xd7\x43\x73\xc1\x82\x96\x46\x36\x1b\x99\xd4\x92\x94\x93\xd4\xd5\x7f\x2f\x86\x94\x68\xc9\x56\x52\x67\x91\xb6\x38\xa0\x40\x80\x48\x22\x39\x9c\x79\xe6\xed\x21\xbd\xdd\xce\x4e\x82\xf7\xb2\x7c\x50\x7c\xb9\x32\xf0\xf6\xf4\xcd\xef\xa6\xa5\x42\x8d\xc2\xc0\x05\x4b\x71\x21\xe5\x2d\x5c\x8a\x34\x81\xef\x8b\x02\xec\x24\x0d\x34\xae\x36\x98\x25\xc1\xe7\x15\xd7\xa0\x65\xa5\x52\x84\x54\x66\x08\x5c\x43\xc1\x53\x14\x1a\x33\xa8\x44\x86\x0a\xcc\x0a\xe1\xfb\x92\xa5\x2b\x84\xb7\xc9\x69\x3b\x0a\xb9\xac\x44\x16\x70\x61\xc7\xff\x74\xf9\xfe\xfc\xe3\xd5\x39\xe4\xbc\x40\x68\xbe\x29\x29\x0d\x64\x5c\x61\x6a\xa4\x7a\x00\x99\x83\xe9\x6c\x66\x14\x62\x12\x9c\xcc\xea\x3a\x08\xb6\x5b\xc8\x30\xe7\x02\x21\xac\xca\x8c\x19\x0c\xa1\xae\xe9\xeb\xb8\xbc\x5d\xc2\xd9\x1c\x16\x4c\x23\x8c\x93\xf7\x52\xe4\x7c\x99\xfc\x85\xa5\xb7\x6c\x89\xd0\x2c\x35\xb8\x2e\x0b\x66\x10\xc2\x15\xb2\x0c\x55\x08\xe3\xc3\x21\xbe\x2e\xa5\x32\xed\x90\x7b\x83\x28\x18\x6d\xb7\x53\x50\x4c\x2c\x11\xc6\x25\x33\x2b\xda\x6c\x9c\x5c\xf1\x45\xc1\xc5\xf2\xd2\xce\xd2\xb4\x62\x34\x0a\xad\x3a\x34\xa5\xae\x43\xb7\x0e\x45\x46\x63\x71\x60\xf7\x1a\x2f\x2a\x5e\x10\x5e\x67\x73\x28\x15\x17\x06\xa2\x92\xe9\x94\x15\x30\x4e\x3e\xb2\x35\xc6\x10\xfe\xd4\x37\x4e\x61\x8a\x7c\xe3\x56\xf8\x67\x2f\xa6\x99\xb4\xae\x0c\x33\x5c\x8a\x9d\xd8\xdd\xba\x30\x69\x47\xad\xcc\x60\x36\x83\xae\x22\x75\x4d\xde\x24\x57\xb4\x5f\x72\xa9\xc0\x22\xcc\xc5\xd2\x4e\xb5\x9a\xd1\x44\x14\x86\x1b\x8e\x3a\x09\xcc\x43\x89\xfb\x62\xb4\x51\x55\x6a\x60\x1b\x8c\x52\xeb\x02\x67\xff\x0e\x5d\xe7\xb5\x59\xce\xb1\xc8\x34\x81\x3c\x25\xcc\x4a\x85\x19\x4f\x99\x41\x0d\xd7\x37\xfe\x25\xe9\xee\x1b\x38\xad\xff\xba\x42\x85\xc0\xb2\x4c\x03\x03\x81\x77\xe0\x67\x5b\x95\x3b\x26\x24\x41\x5e\x89\x14\xa2\x2e\x7e\x75\x0d\x27\x7d\x85\x63\x27\x31\x2a\x35\x24\x49\x32\xbc\x75\xbc\xbf\x88\xcc\xeb\x8b\x4d\x3a\x16\xcc\x81\x95\x25\x8a\x2c\x7a\x74\xca\x04\x4a\x9d\x24\x49\x1c\x8c\x14\x9a\x4a\x09\xe8\xf9\xd8\xd9\xba\xdd\xc2\x1d\x37\x2b\xc0\x7b\x43\xd1\x33\x86\xf0\x07\xb7\x7f\xd8\x73\xfc\xa8\x17\xbb\x1a\x8d\xa1\x19\x49\x13\x13\x4d\xdc\x7d\x9b\xb0\xc6\x55\x98\x2d\x51\x1f\x8a\x9c\xcd\xe0\x8a\x6d\x10\xf0\x1e\xd3\x8a\xcc\x26\xe8\xbf\x56\xa8\x1e\x80\x89\x0c\x9c\x61\xee\xab\xa8\xd6\x0b\x54\x94\xd6\x4a\xde\xe9\xd9\x06\x95\xe1\x29\x6a\x58\x33\x93\xae\x30\x83\xc5\x83\xcb\x77\x59\xa2\xb2\x31\x3a\xe4\x3a\x18\xf2\x1d\x69\x10\xa5\xe6\x1e\x52\x29\x0c\xde\x1b\xca\x7b\xfa\x1f\x43\xc4\x85\x99\x00\x2a\x25\x55\xdc\xb8\x6b\x0f\x81\x4f\x8d\xe0\xb0\x9b\x26\x4d\xc1\x08\x5d\x3d\x09\xff\x86\x4a\xfe\xcc\x8a\x0a\x43\x38\x75\x91\x3a\x08\x91\x66\x1b\x6c\x10\xf2\xe9\x6e\x67\x6f\x98\xa2\xd2\x31\x42\xa5\x9c\x2e\xc1\x68\xc4\xf2\x1c\x53\x83\x19\x70\x61\x82\x51\x1c\x8c\x78\x0e\x05\x8a\x7d\x63\x93\x95\x94\xb7\x3a\x86\xf9\x1c\x4e\xc9\x00\xbf\xce\x5a\x05\xf3\xfd\x98\x71\x11\x7b\x65\xa4\x72\x05\xaf\x85\x26\x0e\x46\x35\x60\xa1\xd1\x0a\x21\x85\xd6\x95\x81\x3f\x53\x35\x90\x24\xc6\x3e\xe1\x45\x25\xd2\x88\x40\x1f\x42\x73\x02\x6b\x37\x8d\x4b\x11\x43\x64\x01\xe9\x62\x3b\x1a\xb5\xc5\x65\x02\xf2\x96\xca\xcf\x3a\x89\xac\xaf\x92\x76\x59\x9b\x49\x34\x99\xe7\xf0\x4a\xde\xba\x85\x6d\x02\x08\x5e\x4c\x20\x5f\x9b\xe4\x9c\xa4\xe6\x51\x58\x09\xbc\x2f\x1d\x4e\xbe\xae\xd9\x7a\xf3\xfa\x73\x38\x81\xb5\x15\x44\xee\x18\xf5\x2a\x5f\x5d\xc3\xdc\xcf\xa7\xd1\x6f\x07\xcd\xab\xd6\x13\x11\x8c\x46\xd6\x08\xaa\x35\x9c\x2c\x7d\xc2\x73\x53\x78\xf3\x0e\x38\xfc\x7e\x0e\xa7\xef\x80\x4f\xa7\x1e\xaa\x01\x3d\xec\x92\x6b\x7e\x13\xad\x2b\x43\xf2\xc9\x34\x9e\xc3\x17\xa7\xf7\x99\x35\xca\x81\x69\xf5\x9b\xc0\x9e\xd9\xf1\x3b\x3b\xf1\xd5\x9c\x90\x74\x1b\x35\xea\x9f\x7a\xbd\x03\xfa\x1b\x34\x6a\x97\xce\xbf\xb8\xae\x7e\x8b\xf6\x6d\x02\x8b\xca\x40\xc9\x04\x4f\x35\xf0\x1c\x98\x70\x5e\x07\x99\xa6\x95\xd2\xcf\x4a\xd3\x5f\x86\xf3\x94\xda\xd4\x36\xd8\xf3\xd3\xd9\x21\x40\x1d\xcf\xf0\x7c\xdf\x56\xab\x61\x84\x4a\xc5\x43\x36\x36\xe6\x9d\xdf\x63\x3a\x50\xad\x8e\x36\x82\xd6\x0f\xdb\xe0\x30\xd9\x06\xa3\x2f\xc7\xa8\xdf\x68\xb7\xc3\x9d\x04\xef\x70\xa7\xb7\x97\xc2\xdd\x4a\x1e\xd6\x79\xeb\x71\x1c\xd0\xb6\x35\xf5\x30\xaa\xfa\x48\x1f\xd9\x59\xf6\xaa\x6a\xd3\x68\xc6\x66\x5d\x16\x9e\xab\xe4\x10\x66\x9c\x15\x98\x9a\xd9\x6b\x3d\x6b\xb9\x5d\x37\x37\xed\xa2\x7b\x5f\x7b\xdd\xf2\x81\x46\x37\x96\x02\xf7\x09\x56\x0e\xe1\x6b\xfd\xa3\xc0\xf0\x80\x34\x79\xb3\xbb\xc4\xaa\x23\x61\x9f\x5b\x1d\x4d\xad\x7a\x32\x9e\x64\x57\x0c\x34\x17\xcb\x02\x07\x68\xd6\x43\x87\x64\xf5\x05\x3e\x9b\x67\xfd\x7b\x56\xd1\xb7\xfa\x38\x62\xf1\xcd\x02\x5f\x8c\x5c\x38\x41\x99\xc7\xeb\x89\xd4\xe8\x23\xf8\x24\x7b\x38\xe9\xfa\xe2\x45\x79\x44\x28\x78\x11\xbe\x14\x97\x10\x74\x0e\xeb\xe9\xfa\x1c\x46\x41\xab\xff\xcf\x26\x9e\xc1\x26\xbe\x0d\xb0\x9d\x5a\xed\xf2\xdf\x1e\x8b\xb0\x88\x0e\xf0\x88\x9d\x49\xff\x09\x0e\xd1\x4b\xd8\x27\x69\x44\x2f\x07\xda\xe3\x59\xf2\x69\x27\xf0\x25\x89\xc5\xbe\xec\xa7\x09\x06\x48\x77\xc9\xf1\xdc\x02\xf5\x9b\x61\x1c\x03\x5a\xff\x0f\x49\x47\x47\x9b\xff\x2e\xef\xd8\x3d\xce\x4e\x40\xaf\x98\xc2\xac\xed\xd2\xae\x0b\xc3\x02\xcd\x1d\xa2\x8b\x06\x73\x27\x9b\xd6\xa5\x34\xd8\xbb\xad\x83\xab\xad\xb6\x79\x93\x0a\x36\xb3\xe1\xfa\xe6\x8f\x52\xde\x06\xbe\x40\xc2\x60\x59\x7c\x4c\x19\xdb\x6b\x41\xe1\x5a\x6e\x58\xf1\x6c\x65\x9a\x4e\xdd\xf0\xa1\x2e\xc1\x72\x57\x57\xc9\x55\x2a\x4b\x4c\x7e\x78\x84\x5e\xbd\xd4\xc5\xd5\x76\xdb\x5e\xc2\x7d\x99\xc0\x18\xdd\x2d\xdc\xb9\xb5\xac\x71\x15\xcf\x61\x8c\xc9\x4f\x82\x7f\xad\x9c\xfb\xac\xd3\x6d\xfc\x7a\xf9\xe1\xfb\x02\x19\x45\x0b\x26\x57\xd6\x45\x17\x04\xb5\x9b\xdd\xf0\x37\xbb\xa0\xae\x21\xa5\x99\x2e\x9d\xe9\x33\xee\x08\x5a\xb6\x44\x30\xb2\xf9\xfa\xf9\xa1\xf4\x43\x09\x95\xf6\xe3\x18\x7a\x67\xa7\x68\xf0\x9a\xe9\xa0\x25\x25\xbd\x25\x9d\x12\xbd\x7f\x87\x64\x2b\x35\x85\x02\x75\x6b\x8f\x43\x69\xdb\x8d\xbc\x43\x05\x91\xa7\xc6\xc9\x1b\x1d\xf6\x8c\x88\xdb\x05\xb3\x13\xc2\xd3\x5e\xe2\x90\x6d\xd2\x3d\x97\x4c\xb1\x35\x1a\x54\x94\xe2\x79\xc1\x53\xa3\x5d\x42\xda\xcb\xdc\x56\x07\xbb\xc2\x46\xd3\xa8\xf1\x0b\x7e\x25\x05\x7a\x88\x38\x9d\xe6\x10\x6e\xc2\xe6\xb5\x09\x5d\xa7\x2e\xcf\xf4\x45\xdf\x73\x9f\x28\x7e\x31\x84\x88\x48\x73\x55\x30\xe5\x7d\xf2\xcf\x26\x14\x63\x08\x2f\x3f\xb8\x50\xf5\xde\x6c\xe5\xd4\xb5\x4b\x00\x7c\x9e\x47\x61\xf1\x00\x3c\xd3\xcf\x74\xec\x6e\xd3\x88\x67\xf6\x7e\xb1\x23\xf9\xf2\x83\xfd\xff\xd8\xf5\xe2\xb0\xdf\xfb\x12\xdd\x15\xe2\xd3\x01\x30\x14\xfc\x2d\x84\x47\x44\x7f\x0b\xd6\x21\x50\xfa\x45\x63\xdf\x85\x41\x5d\x13\x48\x27\x87\x52\x1f\x81\x88\x50\x25\x56\xc3\x6e\x31\xba\xbe\x19\x04\x77\xe2\xb9\x15\x89\x8f\xe3\x16\x59\x4b\xbb\x42\x4e\x51\xb2\x8b\x4d\xee\x66\xb9\xf1\x39\x84\x7f\x6f\x86\x3d\x07\x77\x94\xcd\x8d\xd7\xb5\x2d\x6a\xb6\x18\x79\xf5\x1d\x0d\xe5\x99\xbe\x6e\x27\xdd\x34\x3c\x8d\x86\x77\x1f\x93\xcb\x0f\x9e\x73\x0e\xbb\xef\x71\x7f\x37\x69\xed\xd2\x64\xe8\xa9\x57\xf5\x7d\xe3\x6a\xaf\xc7\xe9\x80\x01\x6b\x34\x2b\x99\xb5\xf9\xfc\xb6\x3d\x98\x3e\x5a\xfd\xdd\xa9\xc4\x0e\x4d\xfd\x6f\x2d\x4d\xc9\x6f\x7f\x64\x99\xb6\xc3\xff\x40\x25\x3b\xe3\xfe\xf0\xe3\xd7\x77\xbb\x42\x33\xc9\xd3\x29\x2f\xe5\xd8\xae\x30\x75\x16\x4f\xbb\x7d\x21\x77\x7d\xe1\xc2\xf5\xdd\x69\x7b\x96\xa2\xd6\x90\x27\xee\xb7\x95\x0f\x98\xb3\xaa\x30\x8d\x5f\x1d\x4b\x76\xc7\x8d\xc1\x82\xeb\x9b\xec\x1f\xd0\xd8\xca\xfb\xce\x1d\x3b\xb6\x8d\xd0\x1f\x4b\x1a\x64\x05\x05\xc1\x77\xdf\xc1\xab\x61\x21\xfd\x74\xb3\x4d\x08\xb3\x28\xde\x95\x3d\x17\x40\x9b\x56\x8d\xce\x0f\x58\x8d\x84\x9e\xf2\x4d\x76\x78\x25\x2e\xf5\x67\x6e\xbf\x44\x71\xb7\x90\x1e\x94\x92\x2b\x34\x43\xfa\x44\x9b\x7e\x78\x35\xb8\xb9\xd2\x4e\xe7\xee\x48\x2a\x5a\xf5\x33\x2b\x78\x46\x07\x3e\xed\x36\x3d\x17\xd5\x3a\x86\x48\x48\x63\xdf\xd7\xb4\xd5\xa2\xc0\x78\x87\xed\xe6\xb9\xd8\xb6\x27\xba\x3e\x5d\x3c\x84\xc3\xab\xe2\xd4\x3f\x3c\xbf\x74\xb3\xcb\xc6\x25\x95\x84\xee\xd1\x70\xbb\x6d\xd9\xe1\x19\x6c\xbc\xb4\x9c\xf1\x02\x33\x9b\x33\x96\x85\xc1\xaf\xa1\xdb\xb0\x81\xfc\xd7\xf0\x0c\x5e\x6f\x42\xcb\xbe\xfd\xe9\xb1\x8f\x5c\xef\x71\x7a\x04\x6b\x21\x84\x77\xcc\xc5\xc1\x89\x3e\xb0\xe2\x23\x23\x75\xbf\xa6\x5f\x7e\x20\x3c\x8f\x99\xb9\x0b\x47\x0a\xe0\xd6\x03\x43\xf8\xd9\xa3\x81\x4e\x3e\xe2\x5d\x1f\x3f\xcb\x95\xdc\x95\x56\xe5\xac\xb0\x2d\xd5\x61\x87\x3b\xec\xc2\xc3\x38\x3b\x7c\xac\xeb\xe0\x5f\x01\x00\x00\xff\xff\x87\x35\x86\xb3\xb2\x1e\x00\x00")
+var _templateBuilderUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x6d\x6f\xdb\x46\x12\xfe\x2c\xfd\x8a\x29\xa1\x04\x94\x21\xd3\x69\xbf\x5d\x02\x1f\xd0\x8b\x93\xd6\x40\x9b\x16\xb5\xdb\x2b\x2e\x09\x82\x15\x39\xb4\xf6\x4c\x72\x99\xdd\xa5\x6c\x9f\xc2\xff\x7e\x98\x7d\x23\x29\xd1\xb2\x9d\xba\x2d\x0a\x34\x5f\x22\x93\xbb\xb3\xb3\xcf\xbc\x3e\xbb\xdc\x6c\x8e\x0e\xa6\x2f\x45\x7d\x23\xf9\xc5\x4a\xc3\x57\xcf\xbe\xfc\xc7\x61\x2d\x51\x61\xa5\xe1\x35\x4b\x71\x29\xc4\x25\x9c\x56\x69\x02\x5f\x17\x05\x98\x41\x0a\xe8\xbd\x5c\x63\x96\x4c\xcf\x57\x5c\x81\x12\x8d\x4c\x11\x52\x91\x21\x70\x05\x05\x4f\xb1\x52\x98\x41\x53\x65\x28\x41\xaf\x10\xbe\xae\x59\xba\x42\xf8\x2a\x79\xe6\xdf\x42\x2e\x9a\x2a\x9b\xf2\xca\xbc\xff\xee\xf4\xe5\xab\x37\x67\xaf\x20\xe7\x05\x82\x7b\x26\x85\xd0\x90\x71\x89\xa9\x16\xf2\x06\x44\x0e\xba\xb7\x98\x96\x88\xc9\xf4\xe0\xa8\x6d\xa7\xd3\xcd\x06\x32\xcc\x79\x85\x10\x35\x75\xc6\x34\x46\xd0\xb6\xf4\x74\x56\x5f\x5e\xc0\xf3\x63\x58\x32\x85\x30\x4b\x5e\x8a\x2a\xe7\x17\xc9\x8f\x2c\xbd\x64\x17\x08\x6e\xaa\xc6\xb2\x2e\x98\x46\x88\x56\xc8\x32\x94\x11\xcc\x76\x5f\xf1\xb2\x16\x52\xfb\x57\xf6\x2f\x88\xa7\x93\xcd\xe6\x10\x24\xab\x2e\x10\x66\x35\xd3\x2b\x5a\x6c\x96\x9c\xf1\x65\xc1\xab\x8b\x53\x33\x4a\x91\xb0\xc9\x24\x32\xea\xd0\x90\xb6\x8d\xec\x3c\xac\x32\x7a\x37\x9f\x9a\x1d\xcc\x96\x0d\x2f\x08\xaf\xe7\xc7\x50\x4b\x5e\x69\x88\x6b\xa6\x52\x56\xc0\x2c\x79\xc3\x4a\x9c\x43\xf4\xf3\x70\x73\x12\x53\xe4\x6b\x3b\x23\xfc\x0e\x62\xdc\xa0\xb2\xd1\x4c\x73\x51\x75\x62\xbb\x79\x51\xe2\xdf\x1a\xc0\xa6\x47\x47\xd0\x57\xa4\x6d\xc9\x9a\x64\x1e\xff\x24\x17\x12\x0c\xc2\xbc\xba\x30\x43\x8d\x66\xd0\xb6\x80\x95\xe6\x9a\xa3\x4a\xa6\xfa\xa6\xc6\x6d\x31\x4a\xcb\x26\xd5\xb0\x99\x4e\x52\x63\x02\xbb\xff\x0e\x5d\x23\x13\x8f\x72\x8e\x45\xa6\x08\xe4\x43\xc2\xac\x96\x98\xf1\x94\x69\x54\xf0\xf6\x7d\xf8\x23\xe9\xaf\x3b\x9d\x14\xbc\xe4\x1a\xcc\xbf\x03\x5e\x69\x2b\x79\xa6\xcb\xba\x08\x3b\xce\x21\xca\x38\x2b\x30\xd5\x47\x4f\xd4\xd1\xf6\x5a\xc9\x99\x16\xd2\xb9\x83\x99\xcc\x73\x58\x31\x75\xee\x95\xb3\xb2\xe8\xa5\x79\x7b\x1d\xb4\xb6\x2f\x66\x61\x9e\x33\xa7\xc5\xf1\xdf\x2b\x94\x08\x2c\xcb\x14\x30\xa8\xf0\x0a\x82\xfe\x06\xc4\x1e\xa8\xc9\x34\x6f\xaa\x14\xe2\xbe\x45\xdb\x16\x0e\x86\x10\xce\xad\xc4\xb8\x56\x90\x24\xc9\x38\x18\xf3\xed\x49\x04\xf8\x50\x6c\x37\x53\xc1\x31\xb0\xba\xc6\x2a\x8b\x6f\x1d\xb2\x80\x5a\x25\x49\x32\x9f\x4e\x24\xea\x46\x56\xd0\x1f\xd9\xed\xf5\x3b\x63\x02\x63\x08\xeb\x2e\x55\x53\x2e\x51\x52\xc4\x6e\x36\x50\x17\x8d\x0c\x4e\x0c\x9f\xa0\x10\x57\xd6\x29\xf4\x8a\x69\x60\x12\xad\x47\x61\x06\xcb\x9b\x01\x2e\xf0\x5a\x48\xc0\x6b\x56\xd6\x05\x2e\x68\x9d\x81\xf7\x15\x4c\x5e\x20\xb0\x52\x34\x95\x56\x77\x2d\xc5\x2b\x50\x25\x2b\x0a\x58\x32\x9d\xae\x50\xc1\x15\xd7\x2b\xd1\x68\x28\x44\x7a\x49\xbe\x4c\xeb\x5e\xad\x44\x81\xa0\xd9\xb2\xc0\x31\x9b\xc0\x98\x51\xcc\xd6\x63\xb3\x75\xe0\x95\xbe\xd3\x02\xd0\xb6\x89\x1d\x7d\x0c\x4f\xcd\x8f\x7d\xd8\x6e\x36\x46\x51\xc0\x6b\x4d\xce\x35\x83\xe8\x5f\x56\x72\xd4\x5f\xc3\xd8\xb8\x8b\x25\x85\x5a\xd3\x88\xc4\xa5\x09\xe7\x96\x9f\x27\xcc\x05\x0b\x66\x17\xa8\x76\x45\x1e\x1d\xc1\xcf\xd5\x95\x64\x35\x48\x5c\xf2\x2a\x1b\x26\x0b\x63\xdf\x2b\xa6\x20\x95\xe8\xed\xcb\x40\x4b\x56\x29\x96\x52\xd2\x61\x05\xa4\x05\xa7\x42\xa3\x85\x99\x99\x49\x03\xb4\x99\xa8\x34\x93\x1a\x33\xb2\x3b\xbd\xea\x4d\x5b\x00\xcb\x35\xca\xed\xc7\x76\x29\x51\x96\x5c\xd3\x62\x42\x82\x14\x45\x41\xcb\xb2\xf4\x32\x01\xb7\x59\xd5\xb9\xdd\x92\x0a\x10\x68\x01\x8c\x16\x49\x0b\x41\x25\xab\x2f\x30\x67\xbc\xb0\x06\x78\x25\xe5\xf9\xf5\x4b\x33\xe2\xde\xae\x61\x91\x89\x47\x5d\x42\x5f\x2f\x40\x5c\x52\x82\xda\x12\x93\xd8\xfc\x98\x58\x24\x92\xf8\x40\x5f\x9f\x98\x9f\xf3\xe9\x84\xe7\xf0\x85\xb8\xa4\x98\x9e\xd4\xac\xe2\x69\x1c\xf9\x0a\xd7\xb6\xcf\x47\x12\x77\x25\xf4\x0e\xde\x6e\x44\x34\x9f\x4e\xda\xe9\x64\xef\xe2\x70\x0c\xfa\x3a\xc9\xe4\x7a\x77\x9c\xaf\x1a\xbb\x23\xf7\xe6\x89\x13\x5c\x36\x17\xaf\x4d\xde\x05\x3b\x90\xac\x81\x90\xae\xa8\x80\x3a\xcb\x5c\x99\xbc\x59\xd7\x05\x27\x6b\x88\x81\x47\x29\x01\x39\x93\x0b\xb8\xc4\x9b\x90\x2e\xc8\x76\xa6\x6e\x00\xab\x32\x20\x47\x85\x8a\x95\xa8\x20\x56\x88\x66\x76\xd6\x5b\x96\x6c\x47\x9a\x87\x24\x7c\x89\x37\xf4\xbb\x64\x7a\x7e\x6f\xcb\xf6\xf6\x11\xcf\xa1\x64\xf5\x5b\xa5\x25\xaf\x2e\xde\xff\xc2\x8a\x06\x61\x13\x60\xe8\xad\x1c\xdf\x86\xe1\xdc\x81\x73\xc6\xd6\x08\x78\x8d\x69\x43\xb9\x99\xf4\xfe\xd8\xa0\xbc\x31\xbb\xea\x83\xd5\xe5\x56\x29\xae\xd4\xd1\x1a\xa5\xe6\x29\x2a\x28\x4d\x66\x73\xa8\x70\x05\xa2\x46\x69\x16\xb8\xf7\xb6\x48\x83\x38\xd5\xd7\x90\x8a\x4a\xe3\xb5\xa6\x76\x89\xfe\x9f\x43\xcc\x2b\xbd\x00\x94\x52\xc8\xb9\xcb\x68\x5b\xa9\xe4\x27\x27\x38\xea\xad\x11\xfd\x07\xa5\x30\x90\x44\xf0\x0c\x0e\x5d\x05\xdd\x4d\x2e\x8a\xad\xd1\xe5\x96\x50\x47\xcd\xe8\x35\x93\xd4\x62\x4d\x50\x4a\xbb\xf8\x74\x32\x61\x79\x8e\x29\xc5\xb7\xa9\xf5\x36\x2a\x0a\xac\x76\xe0\x5d\x09\x71\xa9\xe6\x70\x7c\x0c\xcf\x60\xd3\x9b\x67\xb6\x01\xbb\x71\xb7\xd9\x0c\x3a\x01\x8f\x05\xc5\x09\x60\xa1\x8c\x55\x8d\x42\x65\xa3\xe1\x7b\xb2\x9d\x20\xbf\x37\xbf\xf0\x75\x53\xa5\x31\xa1\x3c\x06\xdf\x02\x4a\x3b\x81\x8c\x0d\xb1\x01\xa4\x0f\xe6\x64\xe2\x5d\xc1\xe7\x84\x32\x89\x8d\x71\x12\x3f\xcd\xd7\x77\x1a\xdc\xcb\x02\x13\xef\x67\x15\x2f\x16\x90\x97\x3a\x79\x45\x28\xe5\x71\xd4\x54\x78\x5d\x9b\xfd\x82\x17\x0e\xa6\x2f\x7b\x72\x1e\x2d\xa0\x9c\xd3\x64\x32\xc7\x64\xd0\x21\xb6\x2d\x1c\x87\xf1\xf6\xed\x21\xf0\x1c\x66\xc9\x69\x49\x8f\x97\x05\xba\x30\x22\xeb\x58\x5d\x08\xcd\xb1\x34\xb6\xc2\xf4\x32\xcc\x8a\xe7\x2f\x68\xc3\xf0\xc5\x31\x54\xbc\x70\xba\x0f\x94\x47\x29\xa7\x93\x4e\xa9\xd0\x4c\x4d\x7e\x8b\xe5\x02\x3e\x03\x11\xd3\xc9\xc4\x20\x49\x19\x80\x13\xdc\x7b\xdc\xe7\x10\xbe\x7c\x01\x1c\xfe\x79\x0c\xcf\x5e\x00\x3f\x3c\x0c\xf6\x1a\xd1\xc3\x4c\x79\xcb\xdf\xc7\x65\xa3\x49\x3e\x41\xc4\x73\xf8\xb0\xf0\x18\x95\x8d\xb6\x16\x35\xfa\x2d\x60\x0b\xfb\x11\x8c\x9c\xfa\xcf\x82\xde\x26\x6b\x8f\x6e\xaa\x4b\x22\xbf\x52\xd3\x5e\xf0\x4b\x34\x7f\x2d\x60\xd9\x68\x30\xf5\x42\x91\x2d\x59\x45\xc3\x85\x04\x91\xa6\x8d\x54\x0f\x4a\x0e\xbf\x8e\x67\x07\xe2\x14\x9b\xe9\x96\x9d\x46\x7c\xa2\x67\x19\x9e\x6f\xef\xd5\x68\x18\xa3\x94\xf3\xb1\x3d\xba\x1c\xf9\xea\x1a\xd3\x91\x1c\x79\xef\x4d\xd0\xfc\xf1\x3d\x58\x4c\x36\xd3\xc9\x87\xfb\xa8\xef\xb4\xeb\x70\x27\xc1\x1d\xee\xf4\xd7\x63\xe1\x4e\xb2\x6e\xc1\x7d\x13\x70\x1c\xd1\xd6\x6f\x75\xfe\x62\x3f\xd2\xf7\x6c\x0c\xc7\x13\xbc\x23\xd2\x91\xef\x42\xc6\x9b\x47\x93\x0b\x76\x9b\xc7\x7b\x2d\x3b\xba\xc2\xdd\xec\x6e\x97\xd6\xed\xf0\xb6\x11\x75\x66\xa2\x42\xbf\x72\x4f\xfa\x13\xf5\x43\x85\xd1\x0e\xb1\x0e\x30\xf4\xc9\x77\x4f\xc2\x36\xff\x76\x02\xfb\xf8\x8d\xd3\xef\x81\x8c\xbd\x0c\x9c\x81\xe2\xd5\x45\x81\x23\x54\xfc\xa6\x47\xc4\x87\x02\x7f\x3f\x2e\xfe\x60\xe6\x0b\xe7\x2b\x74\xea\xd2\x3e\x3d\x0f\x14\x55\x71\x43\x31\xc3\x35\xc9\x2b\x1d\x65\x23\xfa\x16\x44\xa9\x85\x69\x8c\x18\x1c\xbc\x11\xfa\x35\x35\xf2\xa6\xf4\x91\x14\x1b\x9c\xc4\x00\xf4\x0a\xe5\x15\x57\xa3\x6c\xce\xc7\xda\x00\x9b\x07\x90\xec\x21\xa6\x7f\x00\xcf\xde\x17\x2e\x03\x65\xee\x49\x07\x3f\x5b\xe0\xdf\x94\xf0\x41\x94\x70\x00\xe5\x36\x2b\x1c\xbc\xfc\x1d\x89\xe1\x70\x9d\xbf\xb9\xe1\xe3\x72\xc3\x01\xba\x7f\x32\x3d\xf4\x39\xd4\x17\x81\xfb\xaa\x0d\x7b\xf9\xdf\x41\x3f\x03\xfe\x36\x26\x18\x55\xbc\x88\x1e\x8b\x0d\x56\x74\xe3\x30\x50\xee\x21\x9c\x90\x66\xff\xcd\x07\xff\x6a\x7c\xf0\xf3\xac\xd6\x89\xf7\xd3\xff\x7a\x3c\xb0\x87\x4c\xdb\x67\x49\xdd\x96\x7e\x0f\x16\xb8\x95\xdd\xf6\x10\xc1\x41\x20\xfa\x9e\x28\xf1\x09\xc1\x67\x8e\x31\xf7\xe8\x19\x8a\xe7\xdb\xdb\x1f\xa7\x86\xdb\xb2\xf7\x53\x44\xa0\x03\x90\x15\x3e\x38\x2d\xfe\x65\x38\xe3\x88\xd6\x7f\x22\x6d\xec\x69\xf3\x07\x33\xc7\xfe\xca\x7f\x28\x79\xec\x7e\x1e\x1d\x80\x5a\x31\x89\x99\xa7\x5a\xe6\x78\x5a\xc1\x12\xf5\x15\xa2\xf5\x43\x7d\x25\x1c\xdd\x91\x0a\xcc\x25\xf6\xce\x1d\xb6\x67\x60\xa4\xb7\xc9\x29\xf0\xf6\xfd\xb7\x42\x5c\x4e\x43\x7d\x80\xd1\xaa\x70\x9b\x32\x74\x34\x4e\xbd\x55\x29\xd6\xac\x78\xb0\x32\xae\xdd\x77\xa4\xd6\x43\x4c\x2c\xd9\xde\x51\x27\x67\xa9\xa8\x31\x71\x86\x70\x6a\x3c\xfe\x0d\xf5\x66\xe3\x6f\xdb\x3f\x2c\x60\x86\x34\x65\x96\xbc\x22\xdd\xbc\xa9\xe8\xbc\x12\x93\x9f\x2b\xfe\xb1\x41\x7f\x6b\x0b\x33\x13\x39\x41\x7e\xf4\xb2\x40\x46\x0e\x89\xc9\x99\x31\x91\x69\xc2\xec\x68\x47\xc2\xcd\x84\xb6\x85\x94\x46\x52\xfb\x69\x49\x36\x86\xf4\x46\x80\x10\x49\xb1\x4f\xcf\x6f\xea\xf0\x2a\xa1\xc3\xc5\xdb\x23\xb5\xdb\xfd\xbc\xbf\xd2\xf8\x45\xd1\x4e\x45\x4e\x06\x53\x7a\xc5\x61\x6b\x2d\x5b\x23\xc8\x15\x0a\xd5\xc3\xa1\x26\xc4\xec\x0d\x6c\x1c\xce\x37\x92\x2f\x55\x34\xd8\xc4\xdc\x4f\x38\x3a\xa0\x6a\x41\x9b\xa7\xbe\x99\x2e\x1d\xe8\x77\xcd\x24\x2b\x91\xf8\x16\x91\x92\x82\xa7\xda\x5e\xa8\x1a\x94\x82\x0e\x66\x86\xf1\xa6\x89\xb3\x0b\x7e\x84\x59\x3d\x44\x84\xb4\xae\xe1\x18\xa2\x75\xe4\xfe\x74\xae\x6b\xe6\xcc\x78\xa6\x5e\x0f\x2d\xf7\x13\x96\x82\xae\x0b\x62\x3a\xf9\x68\x0a\x26\x83\x4d\x3e\x39\x57\x9c\x43\x74\x7a\xa2\xa2\x81\x35\xbd\x9c\xb6\xb5\x01\x80\x0f\xb3\x28\x2c\x6f\x80\x67\xea\x81\x86\xed\x16\x8d\x79\x66\xae\xed\x7b\x92\x4f\x4f\xcc\x0a\xb7\xdd\xda\x8f\xdb\x7d\x28\xd1\xde\xcc\xef\x77\x80\x31\xe7\xf7\x10\xde\xc3\xfb\x3d\x58\xbb\x40\xa9\x47\xf5\x7d\x1a\x5c\xd3\xa8\x24\x49\x0e\x76\xa5\xde\x02\x11\xa1\x4a\xfd\x14\xbb\xc4\xf8\xed\xfb\x51\x70\x17\xa1\xab\x23\xf1\xf3\xb9\x47\xd6\x34\x7c\x11\x27\x2f\xe9\x7c\x93\x5b\x25\x48\x10\x27\x9f\xfc\xaf\x7b\x1d\x28\x88\x6d\x16\xed\xfb\xb6\x25\x11\x36\x19\x05\xf5\x8d\x5a\x13\x9e\xa9\xb7\x7e\xd0\x7b\xd7\x21\xd2\xeb\xee\x61\x72\x7a\x12\x5a\xee\x71\xf3\xdd\x6e\x6f\x17\xd6\x36\x4c\xba\x5f\xbd\xd4\xe8\xd2\xe2\x8f\xa2\xb8\xd9\x4e\x8d\x74\x68\x36\xc3\xe4\xf4\xc4\x64\xbc\xe4\x87\xda\x71\xff\x98\x2e\x8b\x63\x21\x3d\x84\xf6\x75\x68\xd8\xfb\x73\xc2\xc3\x79\x48\x13\x3b\x2e\xf6\x08\xf9\xb5\x16\xc5\x4d\x29\x64\xbd\xe2\xa9\x89\xcc\xcf\xf4\xac\x07\x44\x57\x98\x72\x57\x50\x8d\xc1\x7f\x4b\xd1\x0d\x7d\x83\x3f\x53\xa0\xcb\x4e\x28\x51\xaf\x44\xe6\xd3\xe9\x57\xfe\x0c\xe3\xd6\xe2\x4b\x93\x5c\xed\x3d\x84\xd9\xff\x50\x0a\xc2\xda\x95\xdc\xc0\xad\xc3\x80\xa0\x70\x37\x28\x34\xca\x87\x7e\x50\xd8\x7d\xb0\xda\x78\xd5\xa5\x09\x5d\xbf\x68\x3a\xb2\xf3\xeb\x6d\x1b\xb8\xc3\x9e\x9d\xae\xb1\x87\xa2\xd1\xda\x51\x80\x69\xbb\x55\xc8\x73\x5b\xc8\x1d\x9b\x3c\xf4\xdc\x9f\x6a\x79\x9e\xd8\xaf\xde\x4e\x30\x67\x4d\xa1\x5d\x20\x5a\x42\xd5\x9d\x94\xed\xd8\x32\x74\x45\xdf\xa0\x36\x46\x7d\x61\xaf\x4d\x4d\xb0\xcf\xf2\xce\xf3\xdb\x16\x9e\x3e\x85\x2f\xc6\x85\x0c\xbd\xd7\x78\x35\x66\xf1\xbc\xab\x53\x36\x57\xaf\xbd\x1a\xbd\x4f\x0b\x9d\x84\x81\xf2\xce\xb7\x83\x12\xa7\xea\x9c\x9b\x27\xf1\xbc\xf3\x9f\x11\xef\x3c\x43\x3d\xa6\x4f\xbc\x1e\xe6\x83\xc3\xce\x0f\xe9\xe7\x5d\x74\x7c\x84\x85\xdf\x66\xaf\xc9\xfd\x1d\xdc\x78\xc8\x83\x3d\xdc\xcc\xea\x5c\xdc\x7d\xb6\xe9\x9c\xd7\x83\x1a\x7c\xd7\x49\xeb\x0d\xf1\x7d\x67\x18\x12\x76\xfb\x58\x31\x40\x9f\x08\x91\x92\x20\x9b\xca\x5e\x46\x18\x9d\x95\x39\x81\x6b\x14\xca\x43\xbb\xa5\x0c\xd6\xac\xe0\x19\x9d\x07\x29\xcf\x3a\x9d\xbe\x7b\x09\x9c\xdf\x13\x35\x04\xce\x3c\x1d\xc3\xdc\x7f\xf0\x72\x87\x9d\xf7\x1e\xbb\x38\x8b\x87\x53\x85\xfe\x59\x4b\xef\xdb\xd6\x61\x80\xba\xb4\x7f\x68\xbb\x3e\x02\xc0\x94\x8e\x3c\xf9\xa5\xdb\xba\x71\xef\x57\x55\x53\xce\x6d\x71\x99\xe5\x9d\xf2\xae\x70\x90\x83\xae\x1f\x1a\xc5\xe1\xac\x6b\xb8\xeb\xdd\xc8\x0b\xba\x50\x7c\xad\x47\xb6\xde\x9d\x67\xe5\xc9\x19\x56\x8a\x6b\xbe\x76\xad\x32\xbd\xa5\xd0\xa0\xab\xbd\x8c\xa5\xda\x9c\xa3\xd1\xed\xf4\xa2\xcb\x20\xdf\x32\xf5\x8d\x70\x6d\x86\x3d\xe0\x8d\xd7\xf3\xae\xe1\x5e\x87\x80\x59\xec\x98\x45\xf9\xd5\xbe\x67\xea\x32\x26\x19\xfd\x93\x93\xc5\x2d\x1b\x7a\x29\x2a\xa5\x59\xa5\x43\x23\xb3\x7d\x32\x16\xac\xf9\xd4\xed\x9d\x8b\xca\x68\xbe\xa1\xc4\xf3\x1c\xcc\xb5\x40\xee\x97\x89\x4c\x68\x3f\x1f\x9c\x13\xf6\xef\x0d\x82\x1b\x9b\x4b\x0f\xcc\xcc\xed\x9d\xe1\xa4\xf0\x6e\x28\xe9\x5d\xf4\x1c\x9e\xac\xad\xbc\x79\xdb\x9d\xdb\xb5\xd3\xa1\x86\xb7\xf8\xd6\x0e\x8b\x0b\x76\x19\xf2\x38\x72\x97\x6d\x2f\xf1\x04\x84\x9e\x87\x2c\xe0\xf3\xa3\x33\xf3\x3d\x40\xc1\x6d\x50\x4c\xe4\xa9\xe4\x0d\x5e\x0d\x41\xa1\x8f\x2b\xe9\x7b\x51\xf2\x79\xd3\xba\xf8\x8f\x47\x1b\x4b\x38\xa9\x53\x81\x77\x43\x99\xef\x22\xff\x45\xbb\xa2\x07\x5e\xfd\x68\x1e\x40\xf2\x1b\x36\x71\x82\xfd\xda\xe4\x3d\x7d\x6f\xb1\xdb\xee\xb2\x4e\x4f\x28\x50\xee\x33\xb2\xab\x68\x54\x03\x43\x68\x3d\x2a\x64\x01\x26\xb6\x1f\x24\x87\xc7\xc8\x91\xef\x6d\x3e\xe4\xb4\xac\x78\xd1\x3f\x8f\x1a\x4d\x92\x3e\x7b\x87\x57\xc6\xa9\x2d\x0b\x0c\x20\x99\x2b\x67\x50\xa8\xe9\x76\x99\xbe\xdc\xd6\x02\x84\xf4\x2d\x2a\xab\x80\x77\xb3\x49\x72\x42\x87\xad\xa7\xda\x56\x84\x25\xe6\x42\xda\x0f\x0e\xed\x69\x0d\xb9\x08\xbb\x60\xbc\xea\xee\x26\xcb\x45\x7f\x58\xb7\xae\xf2\x27\x96\xd9\xc3\x4a\x44\xd8\x4d\xbf\x56\x50\x98\x7e\x58\x98\x8f\x20\x3b\x86\xf2\xf6\xbd\x4d\x51\x1b\xe8\x05\x1e\xf7\x9d\x57\xd2\x35\x25\xdc\x27\x20\x0b\xf2\x9d\x99\xc8\x8d\x3c\x6c\x5b\xb0\xad\xf5\x87\x05\x21\x38\xe6\xaa\xc6\xe9\x62\xd2\x8b\xda\x96\x0f\x06\x63\xcc\xc6\x46\x7e\x9d\x65\x98\x0d\x87\xf3\xdc\x88\xfd\xf4\xc9\xcd\xfa\xf4\x69\x5c\xbe\xf7\x68\x33\x6f\x50\xe2\x6e\x71\x66\x1a\x78\x47\x26\xdc\x32\x3b\x3c\xf9\x08\x29\xab\x28\x58\x97\xe1\xc2\x2d\xb2\x88\xcf\x1d\x45\xd8\xf6\xce\x00\xe8\x74\xb3\x01\xac\x32\x68\xdb\xe9\xff\x07\x00\xff\xa1\xb8\xff\x7a\x33\x00\x00")
 
 func templateBuilderUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -261,12 +264,12 @@ func templateBuilderUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 7858, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/builder/update.tmpl", size: 13178, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x59\x4d\x73\xdb\x38\xd2\x3e\x8b\xbf\xa2\x5f\x96\x93\x97\x74\xc9\x60\x36\xb7\xd5\x96\x0f\x59\x3b\x3b\xa3\xaa\x19\x3b\xb3\xf1\xec\x4e\x55\x2a\x95\xc0\x60\x53\xc2\x9a\x02\x18\x10\xb4\xe5\xd2\xea\xbf\x6f\x35\x00\x7e\x49\xb2\xe2\xf1\xcc\xc5\x96\xf0\xd1\xfd\xa0\xfb\xe9\x46\x37\xb4\xd9\x64\xa7\xd1\x85\xae\x1e\x8d\x5c\x2c\x2d\xbc\x7d\xf3\x97\xbf\x9e\x55\x06\x6b\x54\x16\xfe\xc1\x05\xde\x6a\x7d\x07\x73\x25\x18\xbc\x2b\x4b\x70\x8b\x6a\xa0\x79\x73\x8f\x39\x8b\x6e\x96\xb2\x86\x5a\x37\x46\x20\x08\x9d\x23\xc8\x1a\x4a\x29\x50\xd5\x98\x43\xa3\x72\x34\x60\x97\x08\xef\x2a\x2e\x96\x08\x6f\xd9\x9b\x76\x16\x0a\xdd\xa8\x3c\x92\xca\xcd\xff\x34\xbf\x78\x7f\xf5\xf1\x3d\x14\xb2\x44\x08\x63\x46\x6b\x0b\xb9\x34\x28\xac\x36\x8f\xa0\x0b\xb0\x03\x65\xd6\x20\xb2\xe8\x34\xdb\x6e\xa3\x68\xb3\x81\x1c\x0b\xa9\x10\x62\x51\x4a\x54\x36\x86\x30\x7c\x52\xdd\x2d\x60\x76\x0e\xb7\xbc\x46\x38\x61\x17\x5a\x15\x72\xc1\x3e\x70\x71\xc7\x17\x48\x8b\x36\x1b\xb0\xb8\xaa\x4a\x6e\x11\xe2\x25\xf2\x1c\x4d\x0c\x27\x6e\xbb\x5c\x55\xda\x58\x48\xa2\x49\x5c\xea\x45\x1c\x45\x93\x98\x24\xee\x0b\xc9\x56\x72\x61\xb8\xc5\x38\x9a\x6c\x36\x60\xb8\x5a\x20\x9c\x7c\x99\xc2\x89\x22\xd5\x27\xec\x4a\xe7\x58\x93\xc8\x89\x97\xa0\x0e\x88\xf0\xe3\xfd\x80\x93\x75\x06\xa8\x72\x87\x65\x12\x2f\xa4\x5d\x36\xb7\x4c\xe8\x55\x56\x04\xb7\x48\x25\x9a\x5b\x6e\xb5\xc9\x50\xd9\x2c\x97\xbc\x44\x61\xf7\x40\x84\x63\x38\x24\x1f\xad\x36\x7c\x81\x6c\xee\xc6\x6a\x38\xeb\x41\x85\x65\x41\xb3\x53\x4c\xb3\x69\x14\x65\x19\x5c\x38\xab\x92\x6f\xc9\x31\xde\xc6\x60\x97\xdc\xc2\x52\x97\x79\x0d\xbc\x2c\x81\x86\x6e\x1b\x59\xe6\x68\x6a\x16\xd9\xc7\x0a\xdb\x6d\xb5\x35\x8d\xb0\xb0\x89\x26\xc2\x9d\xdb\x1f\x4d\x16\x04\xa8\xa9\x48\xed\xcf\xde\x80\xde\x46\x59\x06\x1f\xc5\x12\x57\x7c\x47\x5f\xa1\x0d\x08\x83\xdc\x4a\xb5\x98\x82\xb7\xb9\x54\x0b\xe0\x2a\x87\xdc\xe8\xaa\xa2\x2f\xb5\xdb\xc9\xa2\xc9\x24\xc8\x38\x0d\xce\x61\xfe\xfb\xc8\xac\xee\x73\x30\xd5\xbe\xaf\xb2\x0c\xbc\x57\xae\xf8\x8a\xa0\x1d\x80\x23\x95\x45\xc3\x85\x83\xf1\x20\xed\xd2\xcd\x8f\x37\xf5\x26\x99\x4c\xc6\x33\xa7\xa3\xaf\xde\x56\xbb\xf0\x06\xe4\xf4\x6a\xb3\x42\x62\x99\xd7\x19\xcf\x73\x69\xa5\x56\xbc\x0c\x74\xdd\x3a\x47\x5d\xe1\x43\x30\xba\xb3\x14\xd6\xc0\x41\xe1\x43\x8b\xd9\xdb\xbf\x31\x98\xf7\x70\x17\xf2\x1e\x15\xe8\x8a\xa4\xd5\x2c\x2a\x1a\x25\x7a\x31\x89\xae\x6c\x0d\x8c\xb1\x6b\x37\x9f\xc2\x69\x10\x4f\xce\x2c\x5c\x68\x79\x99\x9b\x52\x2f\x66\x50\xea\x05\xfb\x60\xa4\xb2\xa5\x9a\xc2\x52\xeb\xbb\x7a\x06\xaf\xdd\xff\x0d\x9d\x47\x14\x0b\x16\x14\x39\xc1\x8c\xb1\x34\x9a\x04\x6c\xb3\x73\x78\xed\x85\x6f\xbc\xc8\x19\x88\x62\xb1\x6d\xe7\x99\x54\xd2\x26\x69\x34\x31\x68\x1b\xa3\xc2\x89\xe8\xd8\x0e\x71\x22\x5a\x68\x29\xf8\x95\x04\xf1\x28\xcf\x44\xa0\x04\x9c\x43\xcb\x91\x2b\x7c\xf0\x63\x89\x60\xb9\x91\xf7\x68\xd2\x67\x13\x06\x00\x60\x22\xd8\xd8\xc7\xe7\x40\xb6\x3c\xe0\xe8\x44\x30\x7f\xca\xb1\x02\xef\xc5\xeb\xca\x79\x04\x15\xb9\x4f\x68\xa5\x50\x90\xd1\xc0\x6a\xe7\xb1\x9c\x5b\xee\x12\x5a\x5d\xa1\x90\x85\xc4\x1c\x6e\x1f\xfd\x8c\xc3\x0c\x8a\x34\x51\x58\x70\x92\xe6\x07\xcf\xc2\x62\xe1\xb6\xb7\x59\x94\x56\x4e\xdd\x52\x6f\xd6\x1d\xbe\x70\x6b\x29\x6f\xe7\xa4\x59\x5a\xe6\xb1\x79\xda\x41\xc5\x0d\x5f\xa1\x45\x53\x83\xe0\x0a\x6e\x11\x78\x9e\x63\xee\xc3\x34\xf0\x8c\xe2\xa2\x0f\x99\x40\x2e\x3a\x5d\xe2\x41\x5d\x39\xf5\x04\xe8\xa3\xc3\xe3\x4c\x54\x5b\xe3\x22\x3c\x30\x65\xc8\xbe\x24\xf8\x78\x0a\x68\x8c\x36\xce\xc7\xf5\x83\xb4\x62\x09\xbd\x40\xc7\x4d\x32\xcf\x66\x03\xff\xd1\x52\x0d\xf2\xde\xa5\xcf\x91\x35\xc4\x53\xa0\x3b\x62\xe6\x82\xf2\x0c\x4e\xec\xaa\x2a\xc9\x9f\x15\x91\xb7\x80\x38\x24\xd3\xec\x55\x9d\x85\xb8\x23\x77\xc4\xbd\xa8\x90\x3a\x69\xf3\xba\x8b\x51\x2f\x86\xf9\xb9\x1c\x0b\xde\x94\x96\x54\x04\xca\x2a\x59\x4e\xa1\x58\x59\xf6\x9e\xc0\x17\x49\xdc\xa8\xda\xf3\x12\xf3\x80\x7f\x06\xaf\xbe\xc5\xd3\xc1\x61\xd2\x68\xd2\xb2\xe2\x66\xbd\xe3\x24\x6b\xb8\xaa\xb9\x08\xfe\x18\xd9\x78\x18\x0e\x37\xeb\x44\xd8\x35\xf9\xc4\xe2\xda\xd2\xdd\x43\xff\xc9\x98\x37\xeb\xa1\x21\x65\x01\x5f\xa6\xa0\xef\x5c\x50\x07\xfa\xb3\xe4\xd4\xae\x2f\x7d\x24\xfc\x8d\xe6\x36\x47\x8e\xd3\xde\xb7\xdb\xed\x8c\x28\xa1\x34\xa5\x7e\x6e\x2c\xf0\x21\x54\x97\x79\xa4\x1a\x0f\xc6\xee\x9c\x13\xeb\x01\x11\x02\x85\x0f\x1e\xf8\x14\x06\xb1\x28\x0b\x37\xff\x7f\xe7\xa4\xfd\xd9\x60\x1c\x0a\x77\x55\x0c\x75\xce\xe0\xd5\x7d\xec\xf4\x79\xe5\xe3\x7c\xd6\xfa\x83\x00\xb8\xdc\x26\x58\xa9\x17\x53\xc8\xf1\xb6\x71\xdf\xdc\x87\x2e\xcb\x09\xe6\x3e\x6c\xbb\xfc\xf4\xfa\x66\x4d\xf0\x06\xa9\x6c\xea\x6f\x80\xa7\xea\x03\x4f\xa8\xf1\x1d\x31\x7b\x32\x7b\x14\x8b\x34\xc8\x6b\x6f\xea\xc9\x76\x4a\x56\x88\x5c\xe1\x73\x06\xd9\x29\xcc\x0b\x17\x7c\x75\x60\x6c\x48\x0e\x81\x72\x35\xdc\xac\xaf\x43\x84\x25\xa5\xbc\x43\xf8\xf8\xcb\x4f\x29\xb8\x82\xaa\x0f\x89\x83\x11\x61\xd7\x21\x34\x87\xf1\x10\xb6\xc9\x02\x96\xbc\xbe\x19\x47\x44\xc8\x8e\x87\x83\x25\x6c\x6c\x2b\x9d\x2c\x83\x4b\xb2\xec\x0e\xd7\x9d\xb5\xcf\x02\xc7\x61\x6e\xff\xbf\x86\xa6\xf6\x89\x69\x81\x16\xee\xd1\xdc\xea\x1a\xc9\x53\x0b\x72\xb4\x56\xd0\xe5\x3b\x5d\x21\x55\x0a\xee\x76\xcb\xb2\x28\xcb\xda\x1b\xc5\xe9\x49\x52\x1a\x75\x96\x4c\xa4\xca\x71\xdd\x39\xe4\x4d\xda\x1a\xdd\xaf\xf8\xa5\x41\xf3\xd8\x2e\xbf\xd0\x0d\xb9\xc1\xae\x53\x92\xb9\x17\x73\x41\xf4\xf0\xba\x94\x45\x4b\x9a\x21\x6f\xc5\x11\xea\x05\x93\x07\x9c\x6d\x14\x4c\x3d\x13\xd3\x83\xb4\xb4\xa6\xc1\x03\x9c\xfc\xa3\x57\xac\x2b\x01\xc9\xbe\x82\xfe\xd6\xe3\x5b\x68\x70\x41\xd1\x45\x52\x19\xbc\x47\x65\x6b\xe7\xb6\x6f\x0d\x1a\x89\x35\x14\x46\xaf\xba\x30\x3c\x90\xa3\x9c\xf4\x24\xf5\xd9\x88\xec\xd3\x42\x68\xf3\x50\x58\x10\xc0\xfc\x5a\xbb\xdb\xc6\x03\x59\x35\xd6\xb9\xd7\x1f\x9b\x18\x41\xe5\x28\xcd\xa0\xb2\xd2\x3e\x86\x73\x38\xef\xc3\x5c\x81\x36\xae\x2b\xd1\x24\x61\xb0\xa7\x27\x8c\x08\x77\x8c\xe0\x65\x39\x83\xaf\xc1\x38\x44\x0a\xf6\x6b\x8d\x09\x55\x2d\x5f\x0f\x9c\x81\xe6\xbc\x38\xc6\xd8\x8f\x5a\xdf\x75\x25\xc8\xd1\x96\x60\xa7\x64\x60\x9d\x18\x5f\x1d\x8d\x8b\x83\xe8\x78\x83\x41\x92\x7a\x5f\xbb\xd0\xed\x44\xc7\x17\x7d\x6b\x14\x4a\xdb\xb0\xd4\x97\xb6\x7c\x58\xd8\xee\xd7\xb1\x6d\x61\xed\x0a\xfb\xf1\xe6\xbd\xfa\x3e\xf4\x5e\x06\x85\xc3\xa7\xd8\x3f\x51\xa0\x4b\x3e\xdb\xed\x66\x43\x39\x02\xbf\xf9\xe9\x58\xc4\x7e\xcc\x7d\xeb\xb3\xcd\x2b\xf6\x96\xb2\x4b\x50\xff\x5f\x28\xf5\x43\xbb\x7b\x90\x28\x42\x72\xec\x91\xf4\x39\xe3\xe8\x59\x1c\x1b\xfb\xda\xd7\xa3\xee\x4b\xdf\x91\xcc\x44\x84\xf9\xd4\x17\xec\xbd\xb2\x9e\xa5\xaf\x47\x13\x7d\x6c\x6d\x77\xe9\xca\xa1\x94\xb5\xa5\x56\x76\x9f\xb4\x84\xc7\x7f\xa9\x2d\x17\x77\x8e\xad\xef\x1c\x07\x69\xf6\x2b\xd1\xa2\x98\x02\xdd\x38\xe9\x57\xc0\x6f\x0d\x2f\xdd\xb6\xaf\xbb\x9d\xa3\xa3\x5e\x9d\x14\xc9\x22\x59\x26\x69\x9a\x8e\xb8\x3a\x02\xfa\x14\x65\x43\xde\xd8\x2b\x65\x79\x55\xa1\xca\x93\x83\xd3\x21\xe9\x38\xce\x86\x84\xe1\x1a\x90\xa1\x4b\xfc\x40\x68\x88\x9c\x6b\xc6\xcc\x7f\x12\xa6\x17\x95\xa4\xbb\x2d\x93\x17\xb8\x89\x26\x9d\x35\x7d\xe1\xe0\x57\xfd\x1c\x06\xc3\xea\xae\xe2\x9e\xc2\x75\xe5\xb7\xa6\x63\x0f\xee\x08\xee\xfd\xd8\x6d\xec\x12\xab\xb7\x71\x3a\xed\xfc\x38\xeb\x3e\x75\x4e\xaf\xf2\xd1\xf9\x15\x34\x7e\xe4\x05\x06\xf0\xb2\xf6\x0c\x10\x54\xbc\xc4\x00\x7e\xeb\x53\x06\xf0\xb3\x7f\x8a\x01\xae\xd5\xf7\x6c\xd0\x07\xa2\xcf\xd7\xdf\x33\xc3\xb5\xc2\xa4\xcd\x18\x7b\x6d\xf4\x61\x13\x11\x88\xe1\xa5\xd2\x8d\xce\x2f\x07\xa2\xd8\xfc\x32\xdd\xc5\x3e\xbf\x7c\x36\x7a\x99\x3f\x03\xf9\xfc\x32\x91\x79\x70\xfb\xfc\x92\xdd\x50\x36\xfd\x0e\xea\x17\xfa\xf6\x5a\x91\x7b\xdb\xcd\x4c\xe6\x70\x0e\xaf\x65\x7e\xd4\xe3\xd7\xea\x8f\x3a\xfd\x12\x4b\x1c\x45\x7d\xee\x07\x5e\x40\x7a\x2f\x6a\x8f\xf4\x41\xc3\x4b\x0c\xe3\xb7\x3e\x45\x7a\x3f\xfb\xa7\x9c\x7f\x44\xfa\x43\x26\x78\x3e\xe7\x3b\x81\xcf\xe7\x7c\x8f\x61\xc8\xf9\x6e\xf4\x29\xce\x0f\x16\x3c\x17\xfc\x31\xca\x0f\xf5\x3d\x83\xf2\x23\xd0\xad\x36\xd7\x89\xb6\x3c\x60\xff\x5e\xa2\xf1\x66\x18\xdd\x77\x4e\x7e\x9a\x76\xbb\xd8\x01\xce\xef\x4d\xe9\x0a\xce\x3b\x46\x5c\x2b\x3c\xca\x09\x0a\x8b\x20\x61\xfb\xd4\xed\x46\x95\xee\xe3\x4b\x68\x1e\xda\x8a\x1d\x73\xb8\xd1\xdd\x12\x63\x3c\xbb\xc7\xd4\x16\xdb\x0f\x68\x07\xc0\xc6\xb7\x78\x28\x89\x6f\x1f\x41\xda\xfa\xa8\xff\x7e\x40\x7b\xe8\xdd\x60\x0a\x07\x9d\x99\x9c\xee\x94\x03\xfd\xbb\x42\xc7\xc0\xb6\x81\x3a\xee\x47\x76\xad\xca\x47\xdf\x59\x75\xc7\xf9\xcd\xff\xac\x70\x87\xf4\x65\x0a\xb7\x8d\x85\x8a\x2b\x29\x6a\xaa\x25\xb9\x0a\x6d\x83\x16\xa2\x31\xf5\xd1\x13\xfd\xf6\x3b\x8e\x34\x3e\x91\xaf\xe5\xdb\xb0\xe9\x9e\x29\x04\x0b\x76\x22\x21\x07\x1f\x28\x1c\xd0\xa4\x7b\x65\x08\xd6\xe8\x45\x85\x6a\x79\x50\xd5\x63\xa8\x9a\xdf\xe7\x8b\xbe\xac\x1f\x84\xc4\x09\x3a\x90\xde\x9e\x01\x1e\x19\xca\xb3\x62\x03\x15\xaf\x05\x2f\x69\x59\x8b\xbd\x6d\xc3\xda\x52\xb8\x9f\xc1\x7c\x81\x54\x8f\xf2\xdf\x45\xd7\x43\x4a\xbe\x9b\x9f\xda\x13\x78\x5b\xfa\x78\xa1\x8e\x74\x3c\x77\x80\xd5\x7e\x2d\xab\xb8\x5d\xc2\x39\x10\xb0\x27\x1e\xb4\xa8\xae\xff\x97\x3b\x48\xf7\xe2\xf7\xf7\x4e\xf0\x14\xbe\x0c\x48\xe9\xfa\x2a\xf7\x06\x8e\x6b\x4b\x2d\xc5\x89\x82\xb8\x6d\x53\xe2\xd0\x9c\x90\x03\x62\xf2\x47\x3c\xcf\x5d\xeb\x14\x3b\x0d\x31\xf4\x4f\x35\x47\x1e\x0e\x1d\xea\x8c\x76\xec\xbc\x93\x4c\x8e\xbe\x1b\x76\x1d\x9f\xff\x16\xf8\xe2\x14\xfb\x07\x9e\x01\x8b\x9c\x8a\xc8\x11\x64\xd0\x16\xb9\x6b\xaa\xcb\x00\x83\x9f\x2c\x7c\x89\xfe\xa4\x6b\xc3\xf5\x06\x9f\x3e\xd3\xa7\xc1\xfb\xb9\x36\xce\x9b\xcd\xca\x4b\x3e\x51\xec\x47\x5e\x7f\xd0\xa5\x14\x8f\xfe\x3c\xbe\x87\x70\xe1\x70\xa0\x37\xe8\x4f\x11\x3a\x08\xb7\xe6\xd3\xac\x44\xe5\x3f\xa6\x83\x8f\x9f\xa7\x70\xb8\xa3\xf9\x34\xfb\x3c\xe8\x88\xcb\x7a\x2c\xf9\x09\xc5\xe3\xee\xb9\x37\xd3\xc0\x60\x9b\x4d\x76\x0a\xef\xfa\x1f\x5a\xdc\xcf\x5a\xe1\x45\x5b\xdf\xa3\x31\x32\xc7\x1c\xe4\xce\xbb\x41\xff\xfb\x0b\xf8\x5f\x64\xda\x16\x2e\xbc\x16\x84\x77\xb4\x9d\xdf\x25\x0f\xfd\x7a\x33\x6a\x6a\xff\x17\x00\x00\xff\xff\x05\xcf\x98\xe2\x8e\x1d\x00\x00")
+var _templateClientTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x3b\xef\x73\xdb\xb6\x92\x9f\xa5\xbf\x62\xab\x51\x7c\xa4\x47\xa1\xfa\xfa\xed\xdc\xf1\xcd\xe4\xc5\x69\xab\x9b\x26\x6e\x5f\xdc\x5e\x67\xf2\x32\x29\x45\x2e\x25\x9c\x29\x80\x01\x20\xd9\x3a\xd5\xff\xfb\xcd\x2e\x00\xfe\x90\x28\xd9\x49\xde\x9b\x7c\x92\x48\x00\xbb\x8b\xfd\xbd\x0b\x70\xb7\x9b\x9e\x0f\x5f\xaa\x6a\xab\xc5\x62\x69\xe1\xbb\x6f\xff\xf6\x9f\xcf\x2b\x8d\x06\xa5\x85\x1f\xd2\x0c\xe7\x4a\xdd\xc2\x4c\x66\x09\xbc\x28\x4b\xe0\x49\x06\x68\x5c\x6f\x30\x4f\x86\x37\x4b\x61\xc0\xa8\xb5\xce\x10\x32\x95\x23\x08\x03\xa5\xc8\x50\x1a\xcc\x61\x2d\x73\xd4\x60\x97\x08\x2f\xaa\x34\x5b\x22\x7c\x97\x7c\x1b\x46\xa1\x50\x6b\x99\x0f\x85\xe4\xf1\x9f\x67\x2f\x5f\xbd\x79\xfb\x0a\x0a\x51\x22\xf8\x77\x5a\x29\x0b\xb9\xd0\x98\x59\xa5\xb7\xa0\x0a\xb0\x2d\x64\x56\x23\x26\xc3\xf3\xe9\xc3\xc3\x70\xb8\xdb\x41\x8e\x85\x90\x08\xa3\xac\x14\x28\xed\x08\xfc\xeb\x71\x75\xbb\x80\x8b\x4b\x98\xa7\x06\x61\x9c\xbc\x54\xb2\x10\x8b\xe4\x97\x34\xbb\x4d\x17\x48\x93\x76\x3b\xb0\xb8\xaa\xca\xd4\x22\x8c\x96\x98\xe6\xa8\x47\x30\xa6\x91\xa1\x58\x55\x4a\x5b\x88\x86\x83\x51\xa9\x16\xa3\xe1\x70\x30\xda\xed\xfa\x80\x4c\x57\x62\xa1\x53\x8b\xa3\xe3\x33\x2a\x8d\xb9\xc8\xdc\x9c\xdd\x0e\x74\x2a\x17\x08\xe3\x0f\x13\x18\x4b\x22\x6f\x9c\xbc\x51\x39\x1a\x42\x3b\x70\x30\x64\x0f\x10\xf7\xbe\x79\xc1\xb0\x9e\x03\xca\x9c\x16\x0e\x07\xa3\x85\xb0\xcb\xf5\x3c\xc9\xd4\x6a\x5a\x78\xd1\x09\x99\xad\xe7\xa9\x55\x7a\x8a\xd2\x4e\x73\x91\x96\x98\xd9\x03\x22\xfc\x56\x99\x92\xb7\x56\xe9\x74\x81\xc9\x8c\xdf\x19\x78\xde\x10\xe5\xa7\x79\xcc\x8c\x98\x46\xe3\xe1\x70\x3a\x85\x97\xcc\x79\x92\x3f\x09\xd4\xc9\x01\xec\x32\xb5\xb0\x54\x65\x6e\x20\x2d\x4b\xa0\x09\xf3\xb5\x28\x73\xd4\x26\x19\xda\x6d\x85\x61\x99\xb1\x7a\x9d\x59\xd8\x0d\x07\x19\xef\x9b\x28\x7c\x0e\xa2\x20\x82\xd6\x15\xa1\x7d\xed\x98\x4c\x5b\x1d\x0c\xa6\x53\x78\x9b\x2d\x71\x95\xee\xe1\x2b\x94\x86\x4c\x63\x6a\x85\x5c\x4c\xc0\xc9\x45\xc8\x05\xa4\x32\x87\x5c\xab\xaa\xa2\x07\xc3\x2b\x93\xe1\x60\xe0\x61\x9c\x7b\x01\x26\xee\xb9\xc3\x56\xfe\xef\x59\x75\x28\xab\xe9\x14\x88\x31\x32\x79\x93\xae\x48\x24\x3d\xe4\x08\x69\x51\xa7\x19\x51\x04\x77\xc2\x2e\x59\xb7\xbb\x8b\x1a\x96\x0c\x06\xdd\x91\xf3\xce\xa3\xe3\xd5\x3e\x79\x2d\x05\x76\x68\xa7\x85\xc0\x32\x37\xd3\x34\xcf\x85\x15\x4a\xa6\xa5\x57\xe9\x07\x16\xd4\x1b\xbc\xf3\x4c\x67\x4e\xa1\x81\x14\x24\xde\x05\x9a\x1d\xff\xd7\x1a\xf3\x86\xdc\x85\xd8\xa0\x04\x55\x11\x34\x93\x0c\x8b\xb5\xcc\x1a\x30\x91\xaa\xac\x81\x24\x49\xae\x79\x3c\x86\x73\x0f\x9e\x84\x59\xb0\xf9\x39\x98\xbb\x52\x2d\x2e\xa0\x54\x8b\xe4\x17\x2d\xa4\x2d\xe5\x04\x96\x4a\xdd\x9a\x0b\x38\xe3\xdf\x1d\xed\x27\x2b\x16\x89\x47\xc4\x80\x93\x24\x89\x87\x03\x4f\xdb\xc5\x25\x9c\x39\xe0\x3b\x07\xf2\x02\xb2\x62\xf1\x10\xc6\x13\x21\x85\x8d\xe2\xe1\x40\xa3\x5d\x6b\xe9\x77\x34\x7c\x18\x3a\x8a\xa3\x2c\x90\x16\x83\x9b\x09\xbb\x47\xf4\x2c\xf3\x2a\x01\x97\x5e\x99\x30\x79\x83\x77\xee\x5d\x94\x25\xb9\x16\x1b\xd4\xf1\x93\x15\x06\x00\x60\x90\x25\x5d\x19\x5f\x02\xf1\xb2\x47\xd0\x51\x96\xb8\x5d\x76\x11\x38\x29\x5e\x57\x2c\x11\x94\x24\xbe\x4c\x49\x89\x19\x31\x0d\xac\x62\x05\xcb\x53\x9b\xb2\xd3\x33\x15\x66\xa2\x10\x98\xc3\x7c\xeb\x46\x98\x66\x90\xa4\x61\x64\x16\x29\x41\x73\x1b\x79\xee\x27\x67\xbc\x3c\x78\x5a\x9a\x39\x61\x0b\x72\x6c\xdd\xd3\x97\xd4\x5a\xf2\xed\x39\x61\x16\x36\x21\x68\x4e\x11\xd2\x12\xaa\x54\xa7\x2b\xb4\xa8\x0d\x64\xa9\x84\x39\x42\x9a\xe7\x98\xb3\x5d\x04\x3d\x23\xbb\x68\x4c\xc6\x2b\x17\xed\x2e\x72\x44\x11\x4b\x26\x4c\xd0\x5b\xa6\x87\x9e\xc1\x58\xcd\x16\xee\x35\xa5\xad\x7d\x91\x97\xf1\x04\x50\x6b\xa5\x59\xc6\xe6\x4e\xd8\x6c\xe9\x77\xc9\x00\x48\x37\x89\x3d\xbb\x1d\xfc\xaf\x12\xb2\xe5\xf7\xae\x9c\x8f\x34\x30\x9a\x00\xc5\x91\x0b\x36\xca\xe7\x30\xb6\xab\xaa\x24\x79\x56\xa4\xbc\x05\x8c\xbc\x33\x9d\x3e\x33\x53\x6f\x77\xaa\x42\x39\x6a\x40\x79\xd7\x49\x8b\xef\x6b\x1b\x75\x60\x12\x37\x96\x63\x91\xae\x4b\x4b\x28\xbc\xca\x4a\x51\x4e\xa0\x58\xd9\xe4\x15\x11\x5f\x44\xa3\xb5\x34\x4e\x2f\x31\xf7\xf4\x5f\xc0\xb3\x8f\xa3\x49\x6b\x33\xf1\x70\x10\xb4\xe2\xe6\x7e\x4f\x48\x56\xa7\xd2\x90\xf7\x61\x79\x74\x78\xdc\x36\x87\x9b\xfb\x28\xb3\xf7\x90\x29\x69\xf1\xde\x52\xec\xa1\x5f\x62\xe6\xcd\x7d\x9b\x91\xa2\x80\x0f\x13\x50\xb7\xc4\x87\xa0\xfe\x49\x74\x6e\xef\xaf\x98\x9a\xf8\x7b\x1a\xdb\x9d\xd8\x4e\x88\xc9\x0f\x0f\x17\xa4\x12\x52\x91\xeb\x4f\xb5\x85\xb4\x4d\x2a\x7b\x1e\x21\xbb\x2f\x47\xbc\xcf\x81\x75\x04\x11\x05\x12\xef\x1c\xe1\x93\x9a\x98\x98\x69\x44\xad\xe1\x9b\x4b\x90\xa2\x7c\x32\x31\x4c\x05\xe9\x62\x07\xe7\x05\x3c\xdb\x8c\x18\x5f\x40\x9e\xa8\x39\xe7\x3e\x01\xad\xbd\xbf\x76\x2f\x74\xdc\xb8\x3b\x6f\xb7\xfc\xc2\x13\x06\x97\x60\xef\x6b\xcf\x74\x76\x73\x4f\x84\xb5\x9c\xd8\x64\x38\xd8\x0b\xca\x1d\xe7\xc1\xea\xb2\x17\x1d\x2e\x8e\xfa\x8d\x62\x11\x7b\x78\x21\x46\x0f\x1e\x26\xc4\x0e\x52\x13\xd2\xc7\xe9\x39\xcc\x28\x9f\x42\x30\x5e\x57\x3d\x95\x5e\xd9\x0c\xdc\xdc\x5f\x7b\xdb\x8a\x4a\x71\x8b\xf0\xf6\xd7\x9f\x63\xe0\x74\xab\x31\x86\x5e\x5b\xb0\xf7\xde\x28\xdb\x96\xe0\x97\x89\x02\x96\xa9\xb9\xe9\xda\x82\xf7\x8b\xfd\x66\xe2\x17\x7a\xd7\xf7\x18\x6e\x6f\x87\xa4\x3d\xf6\xfe\x2b\xe0\xaf\xd6\x7a\x81\x5f\x6f\xdf\xa5\x4a\x73\xcc\x17\x58\x28\xfd\x15\x88\xd0\x58\x68\x34\xcb\x7f\x07\xe6\xe9\x14\xae\x70\xbe\x5e\xec\x39\xb7\x9c\xde\x3d\xf7\x4e\x0d\x66\xf6\x3f\x0c\xac\x8d\x8b\x44\x0b\xb4\xb0\x41\x3d\x57\x06\x29\xe3\x58\x90\x65\x2b\x09\x75\x80\x53\x15\xea\xd4\xa7\x33\xd3\xe9\x70\x3a\x0d\x29\x04\xe3\x89\x62\x8a\x63\x6c\x40\x91\x90\x39\xde\xd7\x76\xf8\x6d\x1c\x6c\xcd\xcd\xf8\x75\x8d\x7a\x1b\xa6\xbf\x54\x6b\x69\xc9\x31\xc4\xc3\xe9\xf4\xd0\xc9\x7a\xd0\xe1\x85\xf7\xa7\x59\xc2\xdb\x68\x3b\xaa\x8c\x7d\xcd\x69\x67\xe2\x19\xef\xe9\x0d\xee\x8f\x3c\x52\xa9\x16\xb1\x9f\x4c\x63\xe4\x78\xf4\x1a\xbf\x3c\x87\xe2\x1c\x9f\xf8\x99\x95\xca\xa0\xe9\xa6\x19\xad\x0c\x84\x32\x85\x4a\xe3\x06\xa5\x35\x2c\xa6\x8f\x6b\xd4\x02\x0d\x14\x5a\xad\x6a\x3f\xdb\x13\x84\x5e\x12\xdc\x28\x26\x6f\xab\x34\xec\x1a\x12\xfc\xe6\x12\x3f\xc1\x13\xf3\x9b\xe1\x74\xc2\x11\xb2\x5a\x5b\x16\xa7\xcb\x28\x49\x03\xa8\xde\xa0\x11\x94\x56\xd8\xad\xdf\x07\x4b\x1b\x66\x12\x94\xe6\xd2\x54\x11\x84\xd6\x9a\x46\x41\x32\x9f\x44\x64\x69\x59\x5e\xc0\x9f\x9e\x39\x94\xc9\x25\xbf\x19\x8c\x28\x2d\xfd\xb3\x67\x0f\x34\xe6\xc0\x25\x49\xf2\x93\x52\xb7\x75\x8e\x79\xcc\xb3\xfb\x3c\xb3\xe3\xc7\x93\x1a\x0c\xe1\xd9\xcf\xfe\x86\x27\xe2\x04\x5b\x1c\x8c\x1b\x59\xb3\xb7\xa8\x41\x8f\x5e\x36\xf5\xb1\xaf\x5d\xfc\x54\x57\xbb\xa4\x7e\xdf\x9c\xa1\x1d\x16\x2a\xa1\x72\xe2\xca\xad\xbb\xf8\xa0\x80\xf3\x05\xb8\xc6\x8c\xc8\x18\xcb\xe4\x1f\x98\x21\xe9\x28\x3c\x3c\xec\x76\xe4\x13\xf0\xa3\x1b\x1e\x65\x44\x4f\x98\xdc\xf8\x96\x67\xc9\x77\x66\x54\xa3\xff\x0b\x4a\x75\x17\x56\xb7\x1c\x83\x8f\x81\x0d\x25\x8d\x8f\x38\xb9\x17\xd6\xc6\xa6\xb8\x71\x54\x7b\x89\xee\xc3\x8c\x32\x3f\x1e\xc3\x79\x17\x59\xa3\xa5\x67\x9d\x81\xc6\xb6\x1e\xf6\xd5\x35\x85\x52\x18\x4b\xfd\x8c\x43\xa5\x25\x7a\x9c\xfa\x18\x9b\x66\xb7\xac\xad\x2f\x58\x07\x69\xf4\x4f\x52\x8b\x62\x02\x8b\x09\x2c\xe3\x3f\x01\x3f\xae\xd3\xd2\xf0\xc0\x7e\x6b\x80\x55\xcf\x44\x45\xb4\x88\x96\x51\x1c\xc7\x1d\x5d\xed\x10\x7a\x4c\x65\xb3\x84\xdf\x1d\xd4\x2a\x69\x55\xa1\xcc\xa3\xde\x61\x5f\xcf\xb1\xce\xfa\x78\x31\x3d\x87\xdf\x05\xde\x19\x48\x35\x82\xc6\x34\x7f\xae\x64\xb9\x75\xe5\x84\x5d\xa2\xa6\x58\x85\x13\x12\xcf\x16\x96\xe9\x06\x41\xaa\x86\x2d\x75\x5d\xdc\x24\x1e\xa2\x00\xa9\x2c\xe1\x9c\x19\x02\x1c\xb4\xe0\x25\x97\xb2\x6d\xd9\xbb\x17\x1e\x04\xeb\x40\x87\xd6\xe3\xfc\x70\xa0\x22\x2f\xea\x7a\x81\xc7\xb0\x1b\x0e\x6a\xfa\x5c\x0a\xea\xc0\xbe\xf6\x2f\xfd\xec\xba\x76\x9b\xc0\x75\xe5\x96\x36\x3e\xf5\xac\x07\x70\xa3\x30\xf5\x42\x5f\x1c\x67\x5e\x98\xf1\xa4\xe6\xcc\x45\xfd\xef\x21\x64\x74\x4f\x28\x4f\x5c\xb9\x3f\x9d\xaf\xcb\xdb\x4f\x08\xd2\x83\xbe\x08\x3d\x96\x9f\x98\x1c\x74\x49\x28\x84\xcc\xbf\x32\x09\x06\x89\x3b\x5f\x99\x88\x4c\x55\xdb\xaf\x45\x82\xd9\xca\xec\x5f\x8f\x9b\xe2\x72\x95\x77\x4c\x51\xc2\xba\xca\x3f\xd3\x16\x7f\xab\xf2\x3e\x5b\xf4\x28\x3e\xc7\x16\xdd\xd2\x63\xb6\xe8\x46\xbf\xc4\x16\x6b\x06\x5c\xcb\xc7\x78\xd0\x04\x1f\x97\xa3\x3c\xc6\x86\x6b\x89\x51\x88\x92\x07\xbd\xc1\x7e\x16\x11\x11\xed\x44\xaa\x7e\x3b\xbb\x6a\x81\x4a\x66\x57\xf1\x3e\xed\xb3\xab\x27\x53\x2f\xf2\x27\x50\x3e\xbb\x8a\x44\xee\xc5\x3e\xbb\x4a\x6e\xb6\xd5\xa3\x54\x7f\xa6\x6c\xaf\x25\xc6\xcd\xe2\x44\xe4\x70\x09\x67\x22\x3f\x29\xf1\x6b\xf9\x2f\x72\xc0\xa7\x2c\xce\x31\x71\xba\x4a\xab\x7e\xbb\xa3\x98\x18\x1d\x18\x5f\x1c\x76\x3d\x2f\xf1\x07\x6e\xec\x7e\x8e\x39\xfe\xa0\xd5\xea\x4a\x14\x05\x64\x6a\x55\xa5\xda\xa7\xef\x4e\xfb\x3a\xfc\xa0\x1e\xbd\xb0\x02\x8d\x8b\xd1\x8e\x66\x37\x5b\x69\xb1\x10\xd4\x46\xea\x2e\xa0\x80\x5e\xb7\x8a\x09\xa3\x6b\x3f\xbb\xde\xff\x1d\x6a\x84\x6c\x49\xb9\x6f\x1e\x0e\x76\x56\x2a\x77\x1d\x49\x25\x31\x81\xdf\xa4\xf8\xb8\x46\xa0\xba\xb5\xce\x12\x8c\x11\x0b\x89\x39\x44\xd4\x27\x2c\x31\xd5\x98\xc7\x0e\x8f\xe0\xae\xc5\x96\xe1\x12\x2e\x57\xf2\x82\x92\x30\x57\x76\x59\x13\x1f\xf2\x0b\xa1\x41\xe4\x06\x72\x51\x14\xa8\x13\x98\x71\xf6\xb0\xa4\x62\xf0\x2e\x35\x81\xae\x09\x25\x1d\xc6\xa6\x16\x57\xfe\x04\x03\xef\x31\x5b\x5b\xcc\x03\x18\xc2\x74\x64\xf7\xc2\x78\x3b\xa1\xd9\x06\x84\x99\x30\x2f\xd4\xda\x82\x55\xeb\x8c\x71\x09\x6b\x3c\x23\x9f\xfb\x8e\x5f\xe0\x51\x84\xc9\x22\xf1\x63\x1f\xac\x58\x61\x1c\xca\xd1\x9a\x49\x17\x97\xd0\xb2\xd4\x97\xa5\x92\x54\x02\xb5\x66\x24\xac\x15\x70\x09\x9b\xb4\x5c\x23\x55\xa5\xcd\x7c\x6e\x5d\xc1\xa5\xcf\x84\xbb\xd9\x5a\xd2\xd5\x0c\xaa\x5b\x27\x2d\x54\x93\x5a\x4e\xdd\x6a\xb6\xd7\xc0\xdb\x40\xf6\xbb\x88\x93\x9a\x75\x0d\xc8\x3d\xb3\xa7\x46\x63\xe7\xc5\x5e\xcf\x31\x00\x48\x66\x57\xd4\xd7\x0b\x50\xe8\xf1\xa9\xfd\xbd\x95\x30\xab\xd4\x72\xa3\x9a\x34\xe2\xd9\x86\x65\xfb\x6c\x73\x18\x8d\xf6\xb6\x34\x6a\xe8\x4f\x66\x57\xcd\x16\xd8\x69\x52\x9d\xbe\x49\x35\x1d\x12\x0e\x82\x96\xcf\x95\x2a\x87\x83\x81\x77\x99\x70\xb9\xe7\x76\x5b\xc0\xe2\xe1\x20\xee\x14\x87\x85\x2f\x95\x0e\xcd\x9d\x67\x8d\x75\x53\xd1\x8d\x6a\x3a\x46\x30\x2e\x92\x57\xa4\xf8\x5b\xa7\x09\xae\x96\xda\xd0\xdc\xb1\xaf\x97\xc6\xaa\xb5\xb2\xa6\xa0\x67\xa5\xc7\x44\x07\x22\x45\xf2\x46\x94\x65\x3a\x2f\xd1\xc3\xa0\xb6\xc3\x68\x13\x6a\xb5\x0d\x3d\x9d\xd7\x8f\x8a\x1f\x95\x7f\xf4\xfe\xc7\x93\x2d\xb1\xc6\x5e\xc0\xe8\x99\x21\x19\x3e\xa3\xd2\x6e\x03\x63\xb5\x8f\x74\x66\x6e\xc4\xca\x1f\xbf\xd4\xcb\x9b\xd5\xdf\x3c\x33\xc9\x2b\x2a\x7c\xa2\x67\x26\x1e\x11\x51\x6d\x10\x58\x1a\x0c\xa5\x65\x91\xdc\x6c\x2b\x24\x2d\x34\x96\xd5\x6a\x44\xcf\x7f\xdf\x5a\x34\xa3\xe3\xe0\xe7\x34\x5e\x63\x98\x80\xc3\xb2\xe9\xc5\xa2\x34\x61\x99\x99\xff\x7e\x7b\xfd\x86\xfe\xfd\x4e\x06\xf8\x96\xda\xda\xa8\x8f\x63\xd0\x58\xf8\xce\x0d\x56\x8f\xe0\x91\xa7\x44\x42\x1b\xf0\x07\x1b\x9b\x09\xb0\x80\x6b\x9d\xd8\xed\x0e\x45\xdb\xd2\xe3\xbe\xe1\xef\xc9\xd6\xda\xa8\xea\x53\x1c\xc7\x2b\x77\x5e\xb2\x81\x4b\xd7\x57\x3f\x3b\x03\xe5\x7b\xec\x74\x7c\x31\x08\x0a\x9f\xbc\x24\x7f\xed\x10\xbc\xe5\x9e\x40\x40\x40\x07\x73\x83\x41\x63\x27\xa1\x2f\xb5\xb7\xd7\x80\xc7\xf7\xef\xcf\xce\x20\x52\x01\xe9\x5f\x7f\x39\x53\x25\xf5\x88\x2f\x86\x2d\xac\x6f\xd1\xf6\xe2\x3c\xdf\xc4\xc3\x7e\xa4\x35\x93\x49\x65\x1c\x66\x51\x34\xe0\x61\xf7\x14\xf0\x27\x19\xfe\x28\x66\xbf\xe5\xfd\xff\xde\x19\x88\x09\x8c\xd1\x3b\x84\x57\x1c\x1d\x3b\xba\x80\x89\x8f\x9c\x35\xed\x35\x31\x3c\x3b\x71\xa1\x91\x74\xde\xbc\xa3\x6d\x09\x78\x78\x78\x0f\x67\x67\x8d\x1a\x9c\x9a\xe7\xb6\x7f\x4c\xbf\xdc\x4a\x9a\x8d\x7b\x2c\x69\x69\xd9\xf1\x49\x5e\xd7\x3e\x59\xa5\xf0\xc9\x2a\xf5\x88\x16\x6d\x7c\x24\x51\xe4\x85\xbb\xc8\xbc\xa8\xf7\x51\xcd\xae\x22\x5a\x74\x1c\xe1\xc3\x63\xa2\x15\x05\x7c\x13\xd6\xb5\xa2\x56\x60\x97\x3b\x9f\x21\x08\x7e\x20\xd0\x93\x6e\x90\xc2\x6a\xdd\x52\x19\x73\x5e\x41\x8a\xc1\x7d\x24\x9f\xf1\xed\x45\x90\x3a\x74\xb8\x56\x1b\xc5\xba\x71\xe1\xe3\xd0\x95\xcf\x41\xda\xce\x96\xa4\xe4\xe0\x86\x16\x4f\x78\x1e\x17\x6d\x97\xde\xf8\x76\xd2\x54\xca\x74\xc2\x3c\xd7\x51\xbc\x61\x18\x06\xad\x09\x2d\xb7\x96\x36\x73\x78\x4b\x6a\xa2\x58\xd3\x26\xd0\x86\xfd\x71\xad\x28\x9b\x2d\x42\x2c\xae\xc7\x5c\xc2\xe4\xd6\x2d\x2c\x44\x25\x4a\x48\x62\xf8\x1b\x3c\x3c\x98\x66\x92\x2a\x7a\x1a\x7d\xdd\x5b\x0c\x44\xa4\xe0\x23\x82\x5e\x60\x9c\x33\x12\x40\xe7\x15\x84\x6d\x41\xdf\x4b\xe1\x38\xdd\xf2\x19\x1c\xa5\x6e\xc9\x1b\x75\x17\x37\xd9\x1f\x8b\x9a\xb2\x3f\xa5\xb9\xf1\x15\x12\x41\x45\x6d\xaf\x26\x4d\x4e\x7c\xba\xe1\xdb\x7e\x94\x00\x87\xec\xd3\x65\xe0\xe7\x6f\x94\xfd\x81\xee\x4a\x71\x56\xd3\xc9\x37\xb9\x19\x16\x1a\xdc\x94\xd0\x3a\x0a\x4f\x94\x63\x2c\x9e\xfe\x24\xad\xb7\x3a\xab\x5b\xf1\xb2\x3e\x74\x0d\xd9\x4c\x14\x27\xff\x43\x0d\xbc\xe8\xa0\xf7\xc8\xa5\x5e\x1c\xb7\x34\xf7\xf8\x99\x2c\x6a\xcd\x87\x1d\xb4\x15\xf8\x2f\xf8\xb6\x3d\x16\xec\x61\x3a\x85\xd7\xdb\xb7\xbf\xfe\x0c\x1a\xe9\x20\xdc\xb8\x4a\x80\xd4\x4b\xab\xbb\x9e\x3a\x23\x81\x9f\x50\x66\x38\x69\x86\x19\x06\x95\x0c\x2e\x27\xa7\x23\xa2\x3b\x91\xd5\x37\xcd\x0c\x69\x8a\xc1\x4c\xd1\x75\x08\x4d\x3d\x48\xeb\x71\xb9\xa4\x3e\x2d\x0a\xcc\x98\xaf\xc1\x21\xe2\xbd\x30\xb6\xc5\x92\x70\x0c\xf4\x08\x47\x5e\xd1\x32\x62\x7f\xcc\x1e\x90\x7d\x54\xc3\x97\xd6\x35\x00\x66\x0b\x0f\x7f\xc3\xa8\x5a\x43\x67\x1d\x7d\xd8\xc1\x01\xb2\x9f\xd3\x39\x96\xc7\x2e\x17\x10\xb3\x0f\x4a\xc4\x2b\x2c\xb1\xd3\x3c\xcd\xdd\x8b\x76\xb9\xdf\xb1\xa9\xe3\x0a\xe6\x40\x1d\x34\x4f\x3d\x86\xcf\x29\xea\xdd\xd2\x63\x0d\x1b\x37\xfa\x85\x0d\x1b\x07\xa4\xd3\xb0\xe9\x63\xc1\xd3\xfb\x35\x35\xc0\xa7\xf7\x6b\x1a\x1a\xda\xfd\x9a\xfa\xed\xb1\x7e\x4d\x6b\xc2\x53\x89\x3f\xd5\xae\x69\xe3\x7b\x42\xbb\xa6\x9e\x4e\xda\x1c\xb0\xb1\x41\x04\x3d\x78\xc4\x22\xea\x55\x49\x4f\xbf\xe6\x60\x48\x55\x70\x59\x6b\xc4\xb5\xc4\x93\x3a\x71\x2d\x71\xe7\x21\xd4\x3d\x9a\x96\xce\x1f\x1c\x18\xd0\x29\xe5\xb6\xc3\xb2\x0e\xd0\xe3\x3c\xf3\xb6\xbf\xc7\x1a\x7e\x0b\xbb\x23\x24\xf2\xe8\x81\xd6\x06\x7d\xfc\x11\x6d\x8b\xb0\xce\xc2\xe0\xed\xe7\x5b\x0e\x26\xa7\x64\xf9\x23\xda\x4f\xf0\xf4\x27\x0a\x70\xbf\x83\x27\x7b\xb9\x6b\x59\x6e\xeb\x8c\xc5\x6d\xe7\x0f\x8a\x5b\x7c\x8f\xe4\x47\xb4\x13\x98\xaf\x2d\x54\xa9\x14\x99\xa1\x10\x9c\x4a\x7f\xe4\xab\xb2\x6c\xad\xcd\xc9\x1d\xfd\xf1\x09\x5b\xea\xee\x88\x64\xd1\x98\x50\xcb\x77\x7b\x3e\x11\x90\xde\x48\xc5\x84\x46\xf5\x15\x20\xcf\x8d\x06\x54\xb3\xcb\xd7\xa9\xdc\xd6\x82\x3b\x4c\x44\xea\xe6\x94\x2a\x3a\xe6\x48\xb7\x16\x28\x3b\x50\x12\x9d\x16\x26\x70\xb3\x0c\xaa\x89\x39\x69\x84\xa1\x5b\xd3\xc4\x43\x3e\xb7\x6e\x2e\xf3\x35\x20\x22\xca\x15\x96\xa9\x69\x02\x5a\x89\x72\x61\x97\xb1\xcb\x22\x44\xa7\x21\x47\x01\xce\xdd\xbf\x9e\x4e\xdd\xb1\x5b\xca\xdb\xf5\xca\xe5\xc2\xa2\xd0\x50\x29\xc3\x37\x48\x89\x20\x41\xcd\x2d\xba\x5f\x51\xac\x4b\x36\x8f\x39\xb5\x53\x88\x6e\x2e\x20\x74\x68\x66\xfd\xa8\xd3\x6a\xf9\xeb\xcf\xf1\x49\x31\x12\xa7\x8e\x49\x92\xcf\x21\x7b\x14\xf4\xdd\xfb\xe3\x2a\x2a\x0a\x28\x51\x46\x22\x37\x31\x65\xf9\xfb\x69\x44\x93\x5b\x4b\xba\xc5\xf1\x29\x81\x7b\xc6\x50\xe9\x48\x33\x4e\x5e\x94\xe5\x63\xf9\x0c\xdf\x31\x0b\x49\xcd\x7c\x3b\xbb\xa2\x94\x77\x95\xde\x62\xb4\x4a\xab\x77\xfb\xbb\x3a\xd8\x11\x6d\x82\x49\x8c\xe3\xe1\x80\x98\xfc\x61\x02\x1c\x2a\x5d\x16\xcd\x43\x8c\x8e\x40\xbf\x23\x06\xbd\x87\x4b\x90\x5e\x31\x0d\x75\x16\x03\xbe\x43\x76\x05\x0e\x79\xd0\x82\x98\xdd\xc0\x26\xc6\x13\x64\x07\xe6\x9d\x20\xc0\x8c\x45\xe4\xef\xdb\x8a\xef\xc6\xeb\xdb\x64\x8d\xe6\x77\x6c\x9c\x5e\x7c\x89\x9d\xd3\xfa\x3f\x3e\x51\x43\xf6\x77\x0c\xbb\x43\x79\x7b\xd0\xc1\xe0\xfd\xfd\x8a\xa7\x1a\x3d\x43\xf3\xbb\xa6\x96\xe7\xeb\xb4\x82\x1c\xe9\xbb\x87\x43\x47\xcd\xf7\x0b\xc8\x47\x17\x65\x6a\xe1\x16\xb7\xcf\x5d\xc1\xa0\xd1\x7f\x5e\xc1\x29\x88\x37\x4a\x6a\x46\x73\x40\xca\x83\x67\xbf\x51\xaf\xd3\x8a\x4c\x7f\x85\x76\xa9\xf2\x04\x5e\xcc\x0d\x19\xd0\x2d\x6e\x0d\xdd\x1d\x90\xa1\x11\xe4\x3b\xc9\x54\x38\x38\x52\x38\x65\x65\x3d\x24\xc3\x4f\xbb\xcb\x54\x68\xea\x84\x65\x06\xfe\x0f\xb5\x22\x44\x4c\x9e\x21\x53\x47\xff\x9f\x16\xf8\x79\x54\xd5\xd0\x5b\x91\xd3\xc7\x04\x86\x3b\xf3\x28\xd7\x2b\x3f\x33\xe6\xbc\xd9\x8f\x87\xca\xc5\xb6\x9c\x98\x8f\x5b\x84\x47\x18\x98\x93\xef\x09\xf7\x1d\xbc\xe0\x23\x91\x60\xc2\x5b\x77\x47\x01\xfe\xc2\x30\x39\x44\xea\x41\x1f\xd7\x16\x2f\x88\x68\x05\x64\x62\xee\x66\xf0\x7b\xf7\x73\x32\xac\x35\x1e\x9c\x54\xa3\x1b\x9f\x7b\x42\x73\xb0\xf9\x8b\x4b\xb8\xdd\x5c\x31\xa7\xa3\xd5\x04\x0e\x5c\x46\xad\x97\xdc\x78\x4c\x59\xa5\x27\x70\xd6\xc9\xde\x26\xdc\x36\x88\xbf\x7f\x8a\x13\x39\x55\xdc\x37\xed\x20\xf2\xe8\xd4\x9e\x42\x49\x1e\x7b\x13\xba\x42\x47\x1b\x87\x9d\x9b\xbc\xab\x77\xbd\xbb\x28\xda\x5b\x78\x5f\x5f\xed\x1d\x0c\x5a\xac\xeb\x6d\x7c\x91\x4b\xc2\x3b\xf6\xa6\x45\x6d\x9e\x6e\xe5\x27\x30\xb1\x83\x7e\x02\x8f\xe0\x3c\xc6\xd2\xc1\xa0\x87\xad\x75\xbf\x66\x50\x73\xc9\x17\xfc\xc3\x2f\x23\xf2\xec\x51\x2a\x77\xbb\x20\xab\x56\x7f\xb5\x97\xec\x3e\xaa\x1b\x72\x65\xde\x15\xb1\xeb\x45\xff\xde\x58\x28\x77\x77\x5e\x91\x89\xfa\x5d\xd1\x2e\xc7\x9b\xe6\x1c\x80\xe9\x1c\x1d\x39\x3e\x38\x50\x9a\xd0\xf2\xf7\x67\x08\xe7\xdc\xbd\x6f\xf7\x83\x06\x27\xd5\x2d\xf4\x56\x4f\xf0\xa6\xbb\xfd\x7d\x99\x7c\x91\xc2\x76\x39\xd6\x16\xf0\x11\x38\x35\x1f\xa9\x1a\xa5\x39\x9b\x63\x52\xea\x28\xd7\x99\x5f\x27\x94\x74\xd5\x39\xb9\x9d\x0b\xe0\x8b\xe6\x75\x4f\xcb\xdd\x27\xbf\x38\x7a\x52\x55\x7b\x59\x28\x52\x51\xfa\xaf\x25\xd8\x0d\xc3\x3f\xbb\x90\xfe\x39\x6a\xdd\x4f\x7f\xe8\x57\xeb\xb0\xeb\xf6\x43\xfb\xbf\x27\xbf\x91\x4d\xeb\x9e\x78\xf0\x3b\x1f\xfa\x3a\xd1\xb4\x22\x54\x4b\x34\x84\x6c\xe9\x2e\xa1\xf2\xf4\x91\xb7\xe7\xe7\xdd\x0e\xaa\xd4\x64\x69\x49\xd3\xfc\x60\x7d\x2d\x34\x24\xca\xcd\x08\x05\x00\x8a\x3d\x7b\x21\xf5\x78\x08\x38\x8a\xe4\xd1\xfa\x3b\xec\xc0\x65\x0b\x44\xd2\xb6\x8e\x06\xcd\x58\x5f\x38\xe0\xb9\x49\x95\x5a\x6a\x99\x12\x61\x7d\xd9\x4a\x0c\x11\xe5\x01\xbf\xf3\x46\xc2\x85\xec\xe4\xef\x35\xe0\x09\x7c\x68\x45\xa4\x41\xdd\x53\xc5\x7b\x4b\x02\x1a\x4b\x18\x85\x6b\x93\x23\x7f\x59\x92\x04\x30\x22\x79\x8c\x66\x39\x7f\xea\x38\x62\x0c\xcd\x91\x96\xbf\x0c\x70\xd1\x7b\x15\x81\xa9\x9e\xd2\x8a\xbd\x3b\x08\x83\x41\xef\x95\x02\xff\xa1\x4a\xad\x32\xee\xc9\x2b\x0d\x81\xf9\xfd\xa0\x6f\xcd\x28\xb8\xc9\x14\x94\x6c\x48\xab\xc7\x54\x26\x50\x4a\x7b\x71\xea\x8e\x84\x9f\xf4\x84\x8b\x49\x01\x5c\xcf\x65\x88\x30\xd4\x7b\x1f\x82\xfb\x41\x3e\x2f\xe9\x7c\xac\xc7\x0d\xd8\xe3\x3a\xe6\xfb\x48\xf0\xee\x3d\xfd\x23\x6d\xe1\x05\xa4\x2d\xbd\x97\x21\xeb\x2f\xcb\xc8\x33\xcb\xe4\xcd\x7a\x45\xeb\x0c\xfd\xff\x29\x35\xbf\xa8\x52\x64\x5b\x9e\xe6\xe1\xd4\x57\x2b\xf9\xf1\xdd\x05\x65\xeb\xfc\x37\x6e\xfd\x7d\xdf\x13\x86\x18\xec\xbb\x8b\xf7\x07\x57\x85\xc9\xcd\xd9\xfb\x47\x3f\xd7\x39\x3b\x83\xe6\xb3\x96\x8e\x7b\x9b\x4e\xe1\x1f\x98\x29\xed\x52\x39\x6e\xc3\x84\x4c\x8e\xee\x58\x08\xd9\xfe\x54\xc6\xd7\x97\x94\x07\x7a\x58\x79\xd2\xa8\x8a\xdf\x9b\x63\xde\xce\xde\x27\x9a\x01\x1f\x56\x5c\xdc\xbd\x8c\x1f\x7c\x03\xcf\xed\xa9\xd1\x2d\x7e\xe9\x9d\x93\xdf\x65\x47\xcd\xa6\xe7\xf0\xa2\xf9\x1e\x92\x09\xf2\x79\xa4\xda\xa0\xd6\x82\xb2\x63\xb1\x77\xfb\xbb\xf9\x4c\x32\x24\xbb\x9d\xc4\x34\xf1\x77\x4f\xf7\x3e\x31\xee\xfb\xc8\xb2\x1d\x0e\x87\xff\x3f\x00\xb3\x9f\x95\xec\x59\x3d\x00\x00")
 
 func templateClientTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -281,12 +284,12 @@ func templateClientTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/client.tmpl", size: 7566, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/client.tmpl", size: 15705, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x6f\xe3\x36\x14\x3c\x8b\xbf\x62\x60\xf8\x60\x1b\x59\x7a\xbb\xb7\x16\xf0\x61\x91\xdd\xa2\x01\x82\xb4\x40\x7b\x2b\x8a\x82\x26\x9f\x64\xd6\x32\x9f\x4a\x52\x41\x03\xc1\xff\xbd\xe0\x87\x62\x05\xc8\x21\x27\x89\x7c\xf3\xbe\x66\x86\xd3\xb4\xdf\x89\x7b\x1e\x5e\xbc\xed\x4e\x11\x5f\x3e\xff\xf0\xe3\xa7\xc1\x53\x20\x17\xf1\xb3\xd2\x74\x64\x3e\xe3\xc1\x69\x89\xaf\x7d\x8f\x0c\x0a\x48\x71\xff\x4c\x46\x8a\x3f\x4e\x36\x20\xf0\xe8\x35\x41\xb3\x21\xd8\x80\xde\x6a\x72\x81\x0c\x46\x67\xc8\x23\x9e\x08\x5f\x07\xa5\x4f\x84\x2f\xf2\xf3\x1c\x45\xcb\xa3\x33\xc2\xba\x1c\x7f\x7c\xb8\xff\xfe\xf4\xfb\x77\xb4\xb6\x27\xd4\x3b\xcf\x1c\x61\xac\x27\x1d\xd9\xbf\x80\x5b\xc4\x45\xb3\xe8\x89\xa4\xd8\xed\xaf\x57\x21\xa6\x09\x86\x5a\xeb\x08\x2b\xcd\xae\xb5\xdd\x0a\xf5\x7a\x3d\x9c\x3b\xfc\x74\xc0\x51\x05\xc2\x5a\xde\xe7\xa8\xfc\x4d\xe9\xb3\xea\x28\x81\xa6\x09\x91\x2e\x43\xaf\x22\x61\x75\x22\x65\xc8\xaf\xb0\x9e\xd3\x6f\x21\x7b\x19\xd8\xc7\x39\xb4\xdf\xe3\xd7\x21\x5a\x76\x68\x47\xa7\xf3\x4f\x64\x94\xde\xa3\xa7\x3c\xbe\xee\x2d\xb9\x28\x45\x7c\x19\x68\x89\xde\xec\x0a\x6e\x9b\xcb\x94\x89\x12\x6b\x39\xa7\x56\x50\x05\xcd\x7e\x51\x09\xca\x19\xd8\x18\x70\x1c\x6d\x6f\xc8\xd7\xca\x25\x05\x21\xfa\x51\x47\x4c\xa2\xd9\xef\x61\xbc\x7d\x26\x8f\x31\x69\x90\x8a\xd0\x7f\xa4\xc7\x68\x5d\x07\xa3\xa2\xca\x5c\x78\xfa\x77\xa4\x10\x83\x14\x4d\x45\x1b\xab\x7a\xd2\x51\x7e\xcb\xc7\x52\x87\x8e\x63\x07\x72\xea\xd8\x13\x54\x3d\xf6\xdc\x75\xd6\x75\x29\x31\x9f\x8f\xcc\x7d\x46\xf7\xdc\xdd\x5a\x56\x14\xd8\xd5\xb4\x0b\x1b\x92\xa2\x49\xa0\xcc\x82\x94\xd2\xba\x48\xbe\x55\x9a\xa6\xeb\x36\x57\x38\x31\x9f\x43\x62\xb2\x0c\x4c\x29\xfb\x32\xc6\xcc\x46\x9a\xb4\xc4\x77\xf9\x23\x8a\x0c\xe5\x6a\x20\x5f\x59\xba\xcb\xdd\x5b\x15\x22\x94\xd6\x14\x42\xa5\xa9\xe0\x6e\x2c\x4d\xd3\x27\x78\xe5\x3a\xc2\xda\x25\x83\xac\xe5\x13\x1b\x0a\x49\x5d\x00\x68\x92\x77\x9c\x7c\x52\x97\xe4\x12\xfc\xf9\x57\x92\xf2\x17\xe6\x73\xc9\x24\x67\x12\x72\xe9\x84\x00\x35\x0c\xbd\xa5\x22\x24\xd7\x3b\x76\x0b\x5d\xc1\xc7\x7f\x12\xc3\x22\x11\x80\x8d\xc6\xec\x84\x19\xbe\xe1\x21\x06\x48\x29\x4b\xc9\x6d\x1a\x34\xad\xf3\xf7\x5d\x42\xa4\x31\xcb\xc8\x19\x36\x89\xa6\xe1\x21\x6e\xf4\x56\x34\x57\xd1\xd8\x16\x5a\x16\xaa\x53\x44\xcb\x2a\xeb\xe1\x26\x6c\x0a\x6e\xe6\xc0\x1d\xb4\xec\xb9\xcb\xc9\x65\x8f\x6f\x0b\xb5\xc3\x5b\xb1\xe7\x3d\x12\x0b\xc5\x1f\x75\x89\x52\x73\x3b\xfb\x7b\x12\x8d\xa7\x38\xfa\xea\xf4\xc5\x86\x75\xa6\x5c\xf4\x80\xe8\x47\xba\x35\x7e\xe4\x0e\x81\x62\x61\x6e\xee\xf8\xfa\xb0\x12\x01\x4b\x0b\xe5\xbe\x8f\xdc\x6d\x5a\xf7\xae\x93\x3e\x3c\x4c\xb2\xe2\x01\xad\x5b\x30\x50\x28\x7b\x7d\xc7\x61\xf9\xfc\xcc\x9b\xbd\xf3\x61\xf3\xee\xd3\xf9\x38\x1b\xaf\x0a\xd5\x27\x97\xe7\x98\xa6\x6a\xaf\xff\x03\x00\x00\xff\xff\xf5\xf2\xe9\x13\x9a\x05\x00\x00")
+var _templateConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x51\x6f\x1b\x37\x0c\x7e\xf6\xfd\x0a\xce\x70\x80\x73\xe0\xca\x5d\xdf\x56\x34\x05\xba\x26\xc5\x0a\x64\x6d\xb7\xe4\x6d\x18\x06\x59\xc7\x3b\x6b\x96\xa5\x9b\xc4\xf3\x1c\x1c\xfc\xdf\x07\x4a\x3a\xfb\x92\xa6\x69\x5e\xf6\xe2\xd3\x1d\xc9\x8f\x14\xf9\x91\x74\xdf\x2f\xcf\x8b\xf7\xae\xbd\xf3\xba\x59\x13\xbc\x7a\xf9\xe3\x4f\x2f\x5a\x8f\x01\x2d\xc1\x07\xa9\x70\xe5\xdc\x06\x3e\x5a\x25\xe0\x9d\x31\x10\x95\x02\xb0\xdc\xef\xb0\x12\xc5\xed\x5a\x07\x08\xae\xf3\x0a\x41\xb9\x0a\x41\x07\x30\x5a\xa1\x0d\x58\x41\x67\x2b\xf4\x40\x6b\x84\x77\xad\x54\x6b\x84\x57\xe2\xe5\x20\x85\xda\x75\xb6\x2a\xb4\x8d\xf2\xeb\x8f\xef\xaf\x3e\xdd\x5c\x41\xad\x0d\x42\xfe\xe6\x9d\x23\xa8\xb4\x47\x45\xce\xdf\x81\xab\x81\x46\xce\xc8\x23\x8a\xe2\x7c\x79\x38\x14\x45\xdf\x43\x85\xb5\xb6\x08\x53\xe5\x6c\xad\x9b\x29\xe4\xcf\xb3\x76\xd3\xc0\xeb\x0b\x58\xc9\x80\x30\x13\xef\xa3\x54\x7c\x91\x6a\x23\x1b\x64\xa5\xbe\x07\xc2\x6d\x6b\x24\x21\x4c\xd7\x28\x2b\xf4\x53\x98\x0d\xe6\x27\x91\xde\xb6\xce\xd3\x20\x5a\x2e\xe1\x73\x4b\xda\x59\xa8\x3b\xab\xe2\x81\x1c\x24\xdf\x9d\xc7\x18\xbe\x32\x1a\x2d\x89\x82\xee\x5a\x1c\x6b\x97\xe7\x49\x6f\x1e\x61\x52\x44\x9c\xb5\x68\x93\x11\x64\x84\xac\x9d\x1f\x21\x81\xb4\x15\x68\x0a\xb0\xea\xb4\xa9\xd0\x67\xe4\x04\x06\x81\x7c\xa7\x08\xfa\x62\xb2\x5c\x42\xe5\xf5\x0e\x3d\x74\x5c\x03\x06\xc1\x3d\xaa\x8e\xb4\x6d\xa0\x92\x24\x63\x2e\x3c\xfe\xd3\x61\xa0\x20\x8a\x49\xd6\xae\xb4\x34\xa8\x48\x5c\xc6\xd7\x84\x83\xab\xae\x01\xb4\x72\x65\x10\x64\x7e\x35\xae\x69\xb4\x6d\xd8\x30\xbe\xaf\x9c\x33\x51\xdb\xb8\xe6\xe4\x32\x6b\x81\xb3\xd9\x6c\xeb\x2a\x14\xc5\x84\x95\x62\x16\x84\x10\xda\x12\xfa\x5a\x2a\xec\x0f\xf3\x88\xb0\x76\x6e\x13\x80\x5c\x0e\x18\xd9\x7a\xdb\x51\xcc\x06\x47\x9a\xe4\xe7\xf1\x11\x0d\x68\xff\x79\x15\x99\xe8\xc1\xa5\x43\xca\xa3\xd1\x35\xaa\x3b\x65\x30\xb1\x06\x81\xbc\xb4\x41\xaa\x01\x68\x64\x17\x83\x51\xce\x12\xee\x89\xf9\xc1\xcf\x05\xdc\xee\xaf\x76\x68\x29\x85\xe5\xb1\x92\x8a\xc0\x23\x75\xde\x26\x07\x5b\x19\x36\x61\x00\x0f\x68\x83\x26\xbd\x43\xa8\x35\x9a\x8a\x23\xcd\x26\x11\x9c\xee\xda\x45\x92\x70\x95\xb4\x6d\xe6\xf9\x59\x4c\xfa\xfe\x05\xcc\x68\xdb\x1a\xe6\x68\xeb\xb5\xa5\x1a\xa6\xb9\x10\xcb\xb3\xb0\x4c\xc5\x5d\x46\xe3\x30\x85\x99\xb8\x21\xe7\x33\x73\xa3\xb1\xae\x61\x2d\xc3\xed\x40\xd3\x84\xc5\xc2\x28\xdd\x1f\xf9\x9b\x04\xb3\xa3\x1d\xda\x8a\xcf\x87\xc8\xc1\x98\x4f\x68\xd1\x67\xa6\x2d\x62\x05\x6b\x19\x08\xa4\x52\x18\x42\xa6\x5a\xd2\x3b\x31\x8d\x81\xbc\xb4\x0d\xc2\xcc\xf2\x05\x66\xe2\x93\xab\x30\x30\x30\x00\xc0\x84\xfb\xcf\x8a\x4f\x72\xcb\xf1\xc2\x1f\x7f\x72\x3b\xfc\xe2\xdc\xe6\x91\x10\x52\x7f\x04\x90\x6d\x6b\x74\x2e\xa2\xcb\xdf\x9c\x1d\xf5\x06\xb8\xd5\xdf\xcc\xd2\x82\x53\x0b\xa5\x82\xa1\x9b\x06\xf5\xd2\xb5\x14\x40\x08\x91\x20\xe7\x1c\x28\x5f\xe7\xaf\x05\x6b\x70\x98\x29\xe4\xa8\xd6\x17\x93\x89\x6b\xa9\x54\xf3\x62\x92\x33\x93\x32\xf5\x54\x35\x52\xbf\xfc\x0f\xd5\x98\xe8\x1a\x94\x48\xed\xc2\x91\x29\x91\x5b\xf3\x02\x72\x14\xe2\x92\x85\xe5\x20\x58\x80\x12\xc6\x35\x31\xf8\x54\xca\xcb\x51\xc7\x86\xfb\x0d\x3b\xe4\x91\xab\x90\x7a\x3c\x27\x31\xda\x94\xf3\x61\x46\xf5\xcc\x5e\x66\x7a\x9c\x6d\xe3\x0c\xe7\x98\x58\x1d\x2e\x80\x7c\x87\x27\xc7\xd7\xae\x81\x80\x94\xdb\x2f\x7b\x3c\x0e\x47\x2e\xc0\x78\x0c\xb0\x00\xae\x5d\x53\xd6\xf6\xd1\x69\xf0\xec\x60\x78\x9c\x5c\x40\x6d\x47\x19\x88\x57\x3b\x4e\x52\x0c\xe3\x11\x5a\xdd\xbb\x77\x7c\x29\x1f\x1d\x7f\xcf\xcf\xc6\xb1\x42\x79\x6c\x0e\x71\xdc\x9e\x46\x4c\xcc\x8b\x3c\xad\x0a\xce\x46\x1a\x57\x3c\x21\xbf\x3b\xaf\x80\xd6\x92\x40\x7a\x84\x40\xd2\x13\x56\x0c\xbf\xba\x1b\xdd\x4b\xc0\xed\x1a\x4f\xf8\x3a\x80\x92\xc6\x60\x05\xff\x6a\x5a\x0f\xcd\xc3\x83\x2d\x41\x65\x98\x87\x8e\x98\x20\x9a\x42\x44\xc7\x46\xdb\x05\x28\xb7\xdd\xea\xb4\x78\xbc\x33\x66\x25\xd5\x66\x11\xdf\x24\xb4\xd2\x6a\x35\x6c\xea\xa3\xe7\xca\x61\x00\xeb\x08\x64\x5d\xa3\xa2\x87\x1e\x44\xb1\x5c\x16\xcb\xe5\xe4\x94\x9b\x92\x4d\x4b\x45\x7b\xf8\x6a\x04\xe3\x71\x08\x43\xcf\x46\xdc\x1d\x28\x3e\xb7\xf0\xc3\x05\xdc\xee\x7f\xe6\x08\xb3\x80\x97\x8a\xf8\x12\xa7\x67\x39\xa5\x3d\x9c\xf1\x0e\x71\x1b\x7e\x96\x46\xae\xd0\x84\xd7\x70\xb6\x5b\x00\x7a\xcf\x87\xf9\x74\x11\x81\xf8\xf7\x32\xef\x5a\x3e\x5f\x47\x55\x3e\x5d\x79\x3f\x8f\xc8\x07\xfe\x3d\xf0\x39\xb5\xca\x38\x70\xfb\x9d\xd5\xf1\x6c\x0a\x8d\x96\xd1\x7d\x2a\xff\x9e\x96\xc8\x43\xfa\xc4\x1a\x3e\x5c\x46\x03\x71\x76\xd2\x74\x18\xf8\xed\xab\xad\x14\x49\x12\x75\x3d\xb6\x46\x2a\x8c\xa5\x1e\x19\xe5\x6a\xde\xc4\xe5\x04\x5b\xa4\xb5\xab\x8e\x4b\x0e\x2d\x69\xe2\xd1\xcc\xf5\x67\xa3\xe3\x62\x5e\x0c\x34\x88\x83\xe4\x43\xdc\x55\x83\x55\xfe\xaf\x12\x16\xec\x2a\xab\xed\xa4\xd1\x55\xcc\x3a\x57\xc4\xf9\x04\x99\x85\xc7\x91\x75\x74\x7c\xf3\xdb\xf5\xd0\x9c\xf9\x0e\x15\xd6\xb2\x33\x94\xee\xa2\x03\x4c\xdf\x1c\xef\xfa\x76\x3a\x70\x2c\x25\xaf\x7c\x7a\x03\x67\x02\x0d\xe5\xd9\x92\xb8\x69\x33\x8f\xde\x9c\x05\x71\x16\xde\x4e\x17\x70\xb2\x9e\x3f\xa0\xc3\xe0\xc3\xc2\xd3\x6e\x9e\x4d\x85\xfc\xb7\xe1\x3e\x0d\x8e\x97\xfb\x55\x86\xcd\x37\x0b\xdf\xe8\x1d\xda\x87\x45\xcf\x93\xae\x54\x79\x1e\xce\xef\x83\x3d\x99\x18\xee\xb7\x53\x44\x17\x60\xb5\xe1\xaf\xc3\x05\xee\x25\x9d\x83\x1d\x04\x83\xcd\x08\x7c\x5e\xe4\x7f\xe3\xdf\xdd\xad\x79\x89\x3f\x58\xae\x7d\xff\xed\xd5\xda\xf7\x8f\x2f\xd6\xbe\x1f\xd6\x6a\xd1\xf7\x80\xb6\x82\xc3\xe1\xbf\x01\x00\xaa\xb3\x6e\x98\xec\x0c\x00\x00")
 
 func templateConfigTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -301,12 +304,12 @@ func templateConfigTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/config.tmpl", size: 1434, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/config.tmpl", size: 3308, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateContextTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x93\x5d\x6b\xdb\x30\x14\x86\xaf\xab\x5f\xf1\x12\x0a\x8b\x4b\xe6\x74\xbd\xdb\x60\x17\xc5\xb4\x50\x36\xca\x60\x66\xbb\x1c\xaa\x7c\x1c\x8b\x38\x92\x91\x8f\x1b\x05\x91\xff\x3e\xe4\x8f\xe6\x63\xe4\xa6\xec\x2e\xe8\x1c\xbd\x7a\x9f\x3c\x38\x84\xe5\x8d\xc8\x6c\xb3\x73\x7a\x55\x31\xee\x6e\x3f\x7d\xfe\xd8\x38\x6a\xc9\x30\x1e\xa5\xa2\x17\x6b\xd7\x78\x32\x2a\xc5\x7d\x5d\xa3\x5f\x6a\x11\xe7\xee\x95\x8a\x54\xe4\x95\x6e\xd1\xda\xce\x29\x82\xb2\x05\x41\xb7\xa8\xb5\x22\xd3\x52\x81\xce\x14\xe4\xc0\x15\xe1\xbe\x91\xaa\x22\xdc\xa5\xb7\xd3\x14\xa5\xed\x4c\x21\xb4\xe9\xe7\xdf\x9f\xb2\x87\xe7\x9f\x0f\x28\x75\x4d\x18\xcf\x9c\xb5\x8c\x42\x3b\x52\x6c\xdd\x0e\xb6\x04\x1f\x3d\xc6\x8e\x28\x15\x37\xcb\xfd\x5e\x88\x10\x50\x50\xa9\x0d\x61\xa6\xac\x61\xf2\x3c\xc3\x78\x7e\xdd\xac\x57\xf8\xf2\x15\x2f\xb2\x25\x5c\xa7\x99\x35\xa5\x5e\xa5\x3f\xa4\x5a\xcb\x15\xc5\xa5\x10\xc0\xb4\x69\x6a\xc9\x84\x59\x45\xb2\x20\x37\xc3\x75\x7f\x5d\x6f\x1a\xeb\x18\x73\x71\xf5\x16\x2b\x12\x21\x78\xd7\x10\x54\xad\xc9\x70\xc6\xfe\x1b\xed\xd0\xb2\xeb\x14\x87\xbd\x10\xcb\x25\x1e\x9d\xdd\x64\xc3\x3a\x1c\x71\xe7\x4c\xdb\xe3\x64\xfd\x0d\xb4\x6c\x1d\x15\x91\x51\x62\x4c\x5d\xc0\x3a\x18\x5d\x43\x47\x44\x72\xf1\x4f\x34\x1f\x18\xd6\x50\x2a\xca\xce\xa8\xe3\xcc\xb9\x62\x3f\x5d\x4c\xc7\xb3\x04\x37\x63\x7a\x10\x57\x6a\x81\x3f\x91\x58\xb1\x4f\x7f\xc9\xba\xa3\xf9\x71\xd7\xb0\x4f\xd2\xf9\xb8\x9d\x88\xab\xa1\x20\x94\x18\xba\x3f\xd3\xf6\xbc\xba\x84\xa1\xed\xf4\x20\xb6\x9a\xab\x9e\x66\xa5\x5f\xc9\x4c\x4c\x92\x39\xea\x2d\xc6\xb6\x87\x94\x79\x23\x5d\x5c\x38\xeb\xbb\x80\x9a\x1a\x27\xe7\xb3\x88\x30\xb5\x1a\x27\xbf\x35\x57\x03\xc9\x10\xb7\xc0\x29\xd1\x02\x2a\x89\x00\xbd\x18\xf6\x27\x52\x30\x5a\xc9\xfd\x25\x2f\xb9\x7f\x9f\x93\x93\xc4\x0b\x56\x72\x1f\x71\xd8\xff\xa3\x64\x6a\x39\xe8\xc8\xfd\x41\x05\xfb\x83\x8b\xdc\xff\x1f\x1b\x6f\x39\x17\x7d\xb0\x8f\x65\xdf\x27\xe3\xc0\x12\x7f\xf7\x26\x42\x00\x99\x22\x7e\x44\x7f\x03\x00\x00\xff\xff\x33\x7a\xa9\x23\x5f\x04\x00\x00")
+var _templateContextTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x91\x51\x6b\xdb\x3c\x14\x86\xaf\xab\x5f\xf1\x12\x02\x5f\x1c\xf2\x39\x5d\xef\x36\xd8\x45\x31\x2d\x94\x8d\x32\x98\xd9\x2e\x87\x2a\x1f\xc7\x22\x8e\x64\xe4\xe3\x46\x41\xf8\xbf\x0f\xd9\xce\x92\x66\x8c\x42\xee\x8c\xce\xf1\xab\xe7\xd5\x13\xc2\x7a\x29\x32\xdb\x1c\x9c\xde\x54\x8c\xbb\xdb\x0f\x1f\xff\x6f\x1c\xb5\x64\x18\x8f\x52\xd1\x8b\xb5\x5b\x3c\x19\x95\xe2\xbe\xae\x31\x2c\xb5\x88\x73\xf7\x4a\x45\x2a\xf2\x4a\xb7\x68\x6d\xe7\x14\x41\xd9\x82\xa0\x5b\xd4\x5a\x91\x69\xa9\x40\x67\x0a\x72\xe0\x8a\x70\xdf\x48\x55\x11\xee\xd2\xdb\xe3\x14\xa5\xed\x4c\x21\xb4\x19\xe6\x5f\x9f\xb2\x87\xe7\xef\x0f\x28\x75\x4d\x98\xce\x9c\xb5\x8c\x42\x3b\x52\x6c\xdd\x01\xb6\x04\x9f\x5d\xc6\x8e\x28\x15\xcb\x75\xdf\x0b\x11\x02\x0a\x2a\xb5\x21\xcc\x94\x35\x4c\x9e\x67\x98\xce\xe7\xcd\x76\x83\x4f\x9f\xf1\x22\x5b\xc2\x3c\xcd\xac\x29\xf5\x26\xfd\x26\xd5\x56\x6e\x28\x2e\x85\x00\xa6\x5d\x53\x4b\x26\xcc\x2a\x92\x05\xb9\x19\xe6\x71\x22\xf4\xae\xb1\x8e\xb1\x10\x37\x7f\x62\x45\x22\x04\x1f\x1a\x82\xaa\x35\x19\xce\xd8\x7f\xa1\x03\x5a\x76\x9d\xe2\xd0\x0b\xb1\x5e\xe3\xd1\xd9\x5d\x36\xae\xc3\x11\x77\xce\xb4\x43\x9d\x6c\xf8\x03\x2d\x5b\x47\x45\xec\x28\x31\xa5\xae\x60\x1d\x8c\xae\xa1\x63\x45\x72\xf1\x11\xcd\x7f\x0c\x6b\x28\x15\x65\x67\xd4\x79\xe6\x42\xb1\x3f\xfe\x98\x4e\x67\x09\x96\x53\x7a\x10\x37\x6a\x85\x5f\xb1\xb1\x62\x9f\xfe\x90\x75\x47\x8b\x73\xd6\xd0\x27\xe9\x62\xda\x4e\xc4\xcd\x08\x08\x25\x46\xf6\x67\xda\x5f\xa2\x4b\x18\xda\x1f\x2f\xc4\x5e\x73\x15\x19\xb1\xd1\xaf\x64\x30\xdd\x2a\x99\xa3\xde\x62\xa2\x3d\xa5\x2c\x1a\xe9\x62\xe9\x0b\xde\x15\xd4\x91\x38\xb9\x9c\x21\x9c\xa8\xa6\xc9\x4f\xcd\xd5\xd8\x64\x8c\x5b\xbd\x79\xfd\xd0\xaf\xa0\x92\x58\x60\x10\xc3\xfe\x8d\x14\x4c\x56\x72\xff\x2f\x2f\xb9\xbf\xce\x49\xee\xdf\xb7\x92\xfb\x58\x87\xfd\x5f\x4a\x8e\x94\xa3\x8e\xdc\x9f\x54\xb0\x3f\xb9\xc8\xfd\x25\xf0\x75\x36\x72\xff\x9e\x0f\xf6\x11\xf6\x3a\x19\xa7\x2e\xf1\x7b\x30\x11\x02\xc8\x14\xe8\x7b\xf1\x7b\x00\x33\x7a\xa9\x23\x5f\x04\x00\x00")
 
 func templateContextTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -326,7 +329,7 @@ func templateContextTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x41\x6f\xe3\x46\x0f\x3d\x5b\xbf\x82\x30\x72\xb0\x0c\x5b\xda\x2f\xb7\xaf\x40\x0e\x6e\xba\x01\x02\x04\xdb\x02\x9b\xb6\x47\x87\x9e\xa1\xe4\x41\xc6\x33\x2a\x87\xf2\xd6\x10\xf4\xdf\x8b\x19\xd9\xb2\x76\xb3\x6d\xf6\x64\x88\x1c\x92\xef\x91\x8f\x74\xd7\x95\xcb\xec\xde\x37\x27\x36\xf5\x5e\xe0\xf6\xc3\xff\xfe\xbf\x6e\x98\x02\x39\x81\x07\x54\xb4\xf3\xfe\x15\x1e\x9d\x2a\x60\x63\x2d\xa4\x47\x01\xa2\x9f\x8f\xa4\x8b\xec\x79\x6f\x02\x04\xdf\xb2\x22\x50\x5e\x13\x98\x00\xd6\x28\x72\x81\x34\xb4\x4e\x13\x83\xec\x09\x36\x0d\xaa\x3d\xc1\x6d\xf1\xe1\xe2\x85\xca\xb7\x4e\x67\xc6\x25\xff\xd3\xe3\xfd\xc7\x4f\x9f\x3f\x42\x65\x2c\xc1\xd9\xc6\xde\x0b\x68\xc3\xa4\xc4\xf3\x09\x7c\x05\x32\x29\x26\x4c\x54\x64\xcb\xb2\xef\xb3\xac\xeb\x40\x53\x65\x1c\xc1\x5c\x1b\xb4\xa4\xa4\xac\x99\x0e\xd6\xb8\xd2\xb3\x26\x9e\xc3\xba\xef\xb3\x59\xd7\xad\xe1\x26\x19\xe0\xa7\x3b\xb8\x29\x3e\x2b\xdf\x50\xf1\x6b\x32\xa4\x07\x55\xeb\xd4\x42\x18\x96\x3a\xd8\xe2\x99\xf1\x48\x1c\xd0\xe6\xd0\x65\xb3\x59\xe5\x19\xb6\x2b\xa8\x62\x28\xa3\xab\x09\x2a\x43\x56\x87\xe4\x9c\x09\x17\x3f\x9f\x16\xd5\x0a\x62\x64\xd7\x41\x83\x41\xa1\xbd\x54\xeb\xfb\x3c\x9b\xcd\xfa\x6c\xd6\x67\x11\x03\x39\x0d\x03\xec\x72\x09\xaa\x0d\xe2\x0f\x10\x4c\xed\x50\x5a\x8e\x7d\x61\xa8\xd9\xb7\xcd\x7a\x77\x82\x88\x48\x8c\x77\x90\x88\xfe\x07\xcf\x14\x51\x8e\x59\xce\x8c\xcb\x12\x1e\x05\x6a\x92\x00\xf2\xc5\x83\xc5\x1d\xd9\x00\x18\xa0\x41\xc6\x03\x09\x71\x28\xe0\x79\x1f\xb9\x70\x10\x68\xe3\xd0\xce\xdd\x7f\xd9\x84\x17\x08\x42\x4d\x02\x14\x2d\x0d\x93\x36\x0a\x85\x56\x29\x31\x3a\x9d\xcc\x81\x94\x77\x3a\xce\x1d\x1d\xf8\x26\xa2\x45\x0b\x0e\x0f\x34\x46\x3a\xfa\x5b\xae\xe1\x01\x16\x9e\x93\xcf\xa2\x10\x43\x1b\xb0\xa6\xbc\xc8\x66\x72\x6a\x08\x36\x75\xcd\x54\xa3\xd0\x43\xeb\x54\xe2\xbf\x08\xc2\xc6\xd5\x2b\x18\x7e\x73\x18\x0d\xdf\xcc\xe9\x9b\xe6\xbe\xd3\x2b\x0c\xf3\xc9\xd4\x83\x20\xcb\x0a\xb6\xef\x16\x49\xf3\x66\x92\x96\x1d\x54\xee\x12\x47\x4e\xe7\x6f\xc7\xfb\x0e\x82\x58\x78\x2a\xcd\xca\x4d\x75\x99\xf8\x5f\x9d\x5f\x8c\xec\x1f\xa2\xe2\xa6\x6f\xfe\x1c\x8d\x6f\x98\x44\x14\x3f\xc4\xc5\x54\xe9\xed\xdd\x1d\xcc\xe7\x83\x98\xd3\x27\xfc\x42\x15\xb6\x56\xba\x2e\x01\xeb\xfb\xa7\x28\x9e\x41\xc6\x17\xfe\xe4\xf4\x0a\xb6\xdb\x62\x13\x86\xaa\x79\x94\xbe\xa9\xa6\x60\xfb\xfe\x77\x57\x79\xab\x17\x79\xf1\x07\xda\x96\xc2\x22\xad\x4d\x7a\x39\xe4\x5d\xe4\x5d\x07\x64\x03\x41\xdf\x5f\x8d\x11\xe8\x93\x57\x68\x93\x37\x75\x34\x96\xf9\x7e\x9f\xcb\xe5\x55\x78\xca\xbb\x20\xe8\x24\x7c\xbd\x48\x7a\x60\x03\xc7\x04\xa2\xf8\xc1\x7d\x4a\xc9\xde\x19\xd1\xe8\x4b\x92\x9f\x78\x3f\xc5\xef\xd1\xdb\xbc\xd6\xd1\xb9\xc3\x40\x70\x53\xdc\x7b\x57\x99\xba\xf8\x0d\xd5\x2b\xd6\xc3\xab\xb2\xfc\x7e\xcb\xe3\x66\xc5\x25\xba\x30\x48\x4b\xfc\xf5\x7e\x8d\x01\x80\xe7\xed\x89\x27\xe3\x72\x3b\x8a\xcb\x1d\x08\x7b\xdf\x5a\x0d\x3b\x1a\x16\x1d\x87\xbc\x41\xb8\x55\xb2\x16\xac\x53\x3e\x4d\xca\xeb\x24\x16\xcf\x80\x70\xc0\x06\x5e\xe9\x94\x5c\xc6\x09\x31\x0e\xf7\x28\x4e\x78\xb8\xd2\x49\x0a\xa4\xe3\x7f\x42\xe3\x5d\xa0\x73\x39\x07\xc3\xed\x13\x1f\xe1\xfd\xd5\x7a\xa1\x73\x8b\xfa\x1e\x6e\x63\xf2\x83\xe7\xf1\x88\xc6\x63\x82\x47\x6f\x74\x9c\x5f\x65\x8d\x92\x04\xa1\x0d\x34\x1c\xa3\xc8\x30\x76\x70\x50\xc1\xe4\xeb\xaa\x98\x41\x57\x2b\x98\x0f\x17\x75\x1b\x6b\xcd\xf3\x97\x84\x66\x3c\xa3\x09\xf6\xf9\xe4\x26\x30\x66\x82\xd3\x1f\x89\xd9\xc4\xff\x30\x29\xb2\x59\x9a\xfd\xbf\x8c\xe4\xee\x2d\xa7\x89\x24\xff\x09\x00\x00\xff\xff\x60\x61\x69\xce\x53\x07\x00\x00")
+var _templateDialectGremlinByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\xe2\xc1\xc8\xc1\x36\x6c\x69\x9b\x5b\x0b\xe4\xe0\xa6\x1b\x20\x40\xb0\x2d\xb0\x69\x7b\x74\x68\x72\x24\x13\xa1\x49\x95\x1c\x79\x6b\x08\xfa\xef\x05\x29\x59\xf1\x6e\xb6\x9b\x9c\x0c\xcd\x07\xe7\xbd\x79\x33\xe3\xae\x2b\x97\xf9\xad\x6b\x4e\x5e\xd7\x7b\xc6\xf5\x87\x9f\x7e\x5e\x37\x9e\x02\x59\xc6\x9d\x90\xb4\x73\xee\x19\xf7\x56\x16\xd8\x18\x83\x14\x14\x10\xfd\xfe\x48\xaa\xc8\x1f\xf7\x3a\x20\xb8\xd6\x4b\x82\x74\x8a\xa0\x03\x8c\x96\x64\x03\x29\xb4\x56\x91\x07\xef\x09\x9b\x46\xc8\x3d\xe1\xba\xf8\x70\xf6\xa2\x72\xad\x55\xb9\xb6\xc9\xff\x70\x7f\xfb\xf1\xd3\xe7\x8f\xa8\xb4\x21\x8c\x36\xef\x1c\x43\x69\x4f\x92\x9d\x3f\xc1\x55\xe0\x8b\x62\xec\x89\x8a\x7c\x59\xf6\x7d\x9e\x77\x1d\x14\x55\xda\x12\x66\x4a\x0b\x43\x92\xcb\xda\xd3\xc1\x68\x5b\x3a\xaf\xc8\xcf\xb0\xee\xfb\x3c\xeb\xba\x35\xae\x92\x01\xbf\xdc\xe0\xaa\xf8\x2c\x5d\x43\xc5\xef\xc9\x90\x02\xaa\xd6\xca\x39\x7b\x2c\x55\x30\xc5\xa3\x17\x47\xf2\x41\x98\x05\xba\x3c\xcb\x2a\xe7\xb1\x5d\xa1\x8a\xa9\x5e\xd8\x9a\x50\x69\x32\x2a\x24\x67\xc6\xbe\xf8\xf5\x34\xaf\x56\x88\x99\x5d\x87\x46\x04\x29\xcc\xb9\x5a\xdf\x2f\xf2\x2c\xeb\xf3\xac\xcf\x23\x06\xb2\x0a\x03\xec\x72\x09\xd9\x06\x76\x07\x04\x5d\x5b\xc1\xad\x27\xc4\x42\xb5\x77\x6d\xb3\xde\x9d\x10\x11\xb1\x76\x16\x89\xe8\x0f\x78\xa6\x8c\x72\x7a\x65\x64\x5c\x96\xb8\x67\xd4\xc4\x01\xfc\xc5\xc1\x88\x1d\x99\x00\x11\xd0\x08\x2f\x0e\xc4\xe4\x43\x81\xc7\x7d\xe4\xe2\x03\xa3\x8d\xa2\x8d\xdd\x7f\xda\x84\x27\x04\xa6\x26\x01\x8a\x1a\x35\x9e\x94\x96\x82\x69\x95\x67\x65\x09\x61\x55\x0a\x0c\x24\x9d\x55\x51\x77\x61\xe1\x9a\x88\x56\x18\x58\x71\xa0\x29\xd3\xd2\xbf\xfc\x92\x1e\x30\x77\x3e\xf9\x8c\x60\xf2\x68\x83\xa8\x69\x51\xe4\x19\x9f\x1a\xc2\xa6\xae\x3d\xd5\x82\xe9\xae\xb5\x32\xf1\x9f\x07\xf6\xda\xd6\x2b\x0c\xbf\x0b\x4c\x86\x6f\x74\xfa\xa6\xb9\x6f\xf4\x4a\x84\xb1\x49\x63\x0d\xe1\x79\x85\xed\x9b\x45\x92\xde\x9e\xb8\xf5\x16\x95\x3d\xe7\x91\x55\x8b\xd7\xf2\xbe\x81\x20\x16\x1e\x31\xc4\xbc\xab\xca\x5e\xce\x65\xe2\xff\xe2\xfc\xa2\x79\x7f\x17\x27\xee\x32\xe6\xef\xc9\xf8\x8a\x49\x44\xf1\x2e\x2e\xba\x4a\x3d\xbb\xb9\xc1\x6c\x96\x0c\x59\xfa\xc4\x6f\x54\x89\xd6\x70\xd7\x25\x60\x7d\xff\x10\x87\x67\x18\xe3\x33\x7f\xb2\x6a\x85\xed\xb6\xd8\x84\xa1\x0f\x8b\xa2\xeb\xa0\xab\x4b\xb0\x7d\xff\xa7\xad\x9c\x51\xf3\x45\xf1\x97\x30\x2d\x85\x79\x5a\x9b\x14\x39\xbc\x3b\x5f\x74\x1d\xc8\x04\x42\xdf\xbf\x18\x23\xd0\x07\x27\x85\x49\xde\xa4\x69\x2c\xf3\xfd\x3e\x97\xcb\x97\xc1\x93\xce\x06\x16\x96\xc3\xd7\x8b\xa4\x06\x36\x38\x26\x10\xc5\x3b\xf7\x29\x3d\xf6\x86\x44\x93\x2f\x8d\xfc\x85\xf7\x53\xfc\x9e\xbc\xcd\x73\x1d\x53\x77\x22\x10\xae\x8a\x5b\x67\x2b\x5d\x17\x7f\x08\xf9\x2c\xea\x21\xaa\x2c\xbf\xdf\xf2\xb8\x59\x71\x89\xce\x0c\xd2\x12\x7f\xbd\x5f\x53\x02\xc4\xb8\x3d\xf1\x64\x9c\x6f\x47\x71\xbe\x03\x61\xef\x5a\xa3\xb0\xa3\x61\xd1\xc5\xf0\x6e\x60\xdf\x4a\x5e\xb3\xa8\x53\xc7\x14\x49\xa7\xd2\xb0\x38\x0f\x81\x83\x68\xf0\x4c\xa7\xe4\xd2\x96\xc9\x8b\xf4\x26\xa2\xc2\x29\x7d\x18\x05\x52\xf1\x3f\xa1\x71\x36\xd0\x58\xce\x62\xb8\x7d\xec\xd0\x75\xf8\xa7\x75\x4c\x63\x8b\xfa\x1e\xd7\x70\x1e\x07\xe7\xa7\x23\x1a\x8f\x89\x38\x3a\xad\x20\x9d\xad\x8c\x96\x9c\x20\xb4\x81\x52\x91\xa7\xc8\x30\x76\x70\x98\x82\x8b\xaf\x89\xfa\x38\x57\x2b\xcc\x86\x8b\xba\x8d\xb5\x66\x8b\xa7\x84\x66\x3a\xa3\x09\xf6\x78\x72\x63\x00\xf4\x05\x4e\x77\x24\xef\x75\xfc\x0f\xe3\x22\xcf\x92\xf6\xff\x23\xc9\xcd\x6b\x4e\x79\xd7\xad\x41\x56\xa1\xef\xff\x1b\x00\x60\x61\x69\xce\x53\x07\x00\x00")
 
 func templateDialectGremlinByTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -346,7 +349,7 @@ func templateDialectGremlinByTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x4d\x6f\xdb\x38\x13\x3e\x4b\xbf\x62\x5e\xc3\x2d\xa4\x40\xa5\xdd\xde\xde\x2c\x7c\xc8\xba\xee\xd6\x40\x9b\x7e\x24\x9b\x4b\x11\x04\x0c\x39\xb2\x89\xc8\xa4\x4a\x52\x6e\x0c\x43\xff\x7d\x31\x94\x6c\xcb\x8a\xb3\xbb\xdd\x9e\x62\x71\xbe\x9e\x79\xe6\x19\x32\xdb\xed\xe8\x2c\x9e\x9a\x72\x63\xd5\x62\xe9\xe1\xcd\xf8\xf5\xff\x5f\x95\x16\x1d\x6a\x0f\xef\xb8\xc0\x7b\x63\x1e\x60\xae\x05\x83\x8b\xa2\x80\xe0\xe4\x80\xec\x76\x8d\x92\xc5\xd7\x4b\xe5\xc0\x99\xca\x0a\x04\x61\x24\x82\x72\x50\x28\x81\xda\xa1\x84\x4a\x4b\xb4\xe0\x97\x08\x17\x25\x17\x4b\x84\x37\x6c\xbc\xb3\x42\x6e\x2a\x2d\x63\xa5\x83\xfd\xc3\x7c\x3a\xbb\xbc\x9a\x41\xae\x0a\x84\xf6\xcc\x1a\xe3\x41\x2a\x8b\xc2\x1b\xbb\x01\x93\x83\xef\x14\xf3\x16\x91\xc5\x67\xa3\xba\x8e\xe3\xed\x16\x24\xe6\x4a\x23\x0c\xa4\xe2\x05\x0a\x3f\x5a\x58\x5c\x15\x4a\x8f\x84\x45\xee\x71\x00\x75\x4d\x5e\xc3\xfb\x4a\x15\x84\xe9\x7c\x02\x25\x77\x82\x17\x30\x64\x57\xc2\x94\xc8\x7e\x6f\x2d\xad\xa3\x45\x81\x6a\xdd\x78\xee\x7f\xef\xc3\x5b\xa7\x55\xe5\xb9\x57\x46\x87\x74\x56\x69\xdf\x89\x1b\xb0\x9d\x75\x00\xe4\x1f\xe7\x95\x16\x90\x1c\xe5\xae\x6b\x38\xeb\xa2\xaa\xeb\x14\x5a\xe0\x57\x7c\x8d\x89\xf0\x8f\x20\x8c\xf6\xf8\xe8\xd9\xb4\xf9\x9b\x42\x12\x42\xd8\x25\x5f\x21\xd4\x75\x06\x68\xad\xb1\x29\x6c\x63\x00\xa0\xc1\x10\x98\x97\x6d\x16\xf6\x15\x5d\x69\xb4\xc3\x6d\x1d\xcc\xdf\x2b\xb4\x9b\x0c\xee\x95\x96\x4a\x2f\x82\x6b\x0f\x10\x6b\x23\x93\x94\x7d\x21\xe7\x24\x8d\x23\x95\x53\x91\x53\xce\xd2\xd2\x2f\x36\x7b\x44\x41\x60\xb3\x7e\x81\x8c\x00\xa5\xbf\x85\xf0\xff\x4d\x40\xab\x02\xb6\x71\x14\x59\xf4\x95\xd5\xf4\x19\xe0\xc7\x51\xbd\x2b\x92\x81\x79\xa0\x42\xca\x4d\x8d\x76\x9e\x6b\x3f\xa3\xf6\x92\x26\x8d\x79\x78\x36\x3c\x70\xf2\xb5\x43\x2c\xb1\xd0\x25\x6a\x2b\x8c\xce\xd5\xe2\xfc\x49\x0f\xcd\x79\xdd\x6f\xb3\x9b\x8c\xbd\xb3\x66\xb5\xa3\x32\xf9\xd7\x2d\xb5\x67\xfd\x6c\x19\x79\xc5\x3f\xad\x88\x24\x85\x33\xe9\x0a\x76\x6d\xf9\x1a\xad\xe3\xa1\xee\x76\xfb\x0a\x7e\x28\xbf\x04\x76\x59\xad\x02\x65\x96\x93\x0e\xeb\x3a\x8e\x22\xbf\x29\x69\x29\xf7\x87\xce\xdb\x4a\xf8\x00\x37\x2a\x2d\xca\x7e\xbe\xd1\xa8\xeb\x4d\x1e\x4a\x70\x8f\x8c\xfc\x3d\x3a\x7f\xc2\x3f\x1c\xaf\xb8\x17\x4b\x74\xc0\xb5\x04\xe5\x5d\x93\x84\x6b\x4f\x81\x84\xe3\x90\x34\x28\x6e\xc5\x1f\x30\xf9\x76\x7b\x76\x38\xce\x60\x9c\x11\x4d\x8c\xfa\x6d\x9a\x42\x2d\x43\x13\x6b\x8a\x58\xb0\x0b\x29\x6f\x02\x53\xec\x33\x17\x0f\x7c\x41\x13\x65\x1f\xf8\x3d\x16\xad\xbf\xe5\x7a\x81\x30\xbc\xcb\x60\x98\x53\xc8\x90\xbd\x53\x58\x48\xd7\x30\xa1\x72\x58\xf3\xa2\xc2\x9d\xbc\x8e\x96\xb7\xae\x19\x7d\xe7\xec\x63\x7b\xf2\x07\x12\x81\xc9\x41\x70\xa1\x82\xca\xc9\xe7\x4f\xad\xbe\x57\xd8\x64\x3d\xee\x6c\x02\xbc\x2c\x51\xcb\xa4\x73\x98\xc1\xcb\xc3\x57\xc8\xd4\x30\x7f\x0e\x0b\x76\x93\xa4\xec\x3d\x77\xa7\xbb\xca\xa0\x7f\xdc\x40\xdc\x6d\x45\x90\x51\x68\x29\x65\x53\x53\x69\x9f\xa4\x59\x93\x9e\x26\x72\x0e\x77\x77\x6c\xee\x92\x92\x5d\xce\xbe\x24\xe3\x34\xdd\xc7\x25\x97\xf8\x63\x66\x6d\xd3\x45\x60\xe8\x97\xeb\xb7\x85\x69\x70\xd1\xd1\xe8\xa2\x68\xcd\x3e\x5b\x53\xa2\xf5\x9b\x84\x94\x73\xa5\xf4\xa2\xc0\x9f\x48\xdd\xe8\xa7\x9b\xb3\x37\x6a\x6c\x46\x3d\x93\x0b\x6c\x27\x4d\x0e\xc3\xe6\xd1\x68\x2f\xe6\xc1\x5c\x0f\x3a\x36\x4d\xd7\xc1\xee\xbe\xce\x61\xf0\xc2\xb1\x17\x6e\xd0\x01\x34\xc4\x86\x82\x0e\x9e\x38\x8a\x72\x63\xe1\x2e\x03\x25\xc3\x83\x10\x10\x9c\x12\x11\xb2\xab\xb0\x64\x81\x5a\xa8\xeb\xf9\x5b\x97\xa4\xc7\x1a\x42\x36\x77\x73\x4d\x2b\xb4\x97\x51\x0f\xf4\x04\x06\x9f\x2a\x3f\x38\xb2\x06\xd8\x4f\x51\x23\xbb\xde\x94\xf8\x0f\xd8\x69\x10\x17\x52\xce\xc2\xa8\x75\x73\x1d\xa6\xe1\x4e\x4b\x48\x86\x4a\xa6\x29\x9b\xeb\x9b\xe4\x30\xc1\xe2\x80\xed\x54\xe8\xb5\x39\x04\x7e\xaa\x7c\x37\x72\x3f\xfb\x43\xbb\xef\xb9\xeb\xdf\x4c\xbf\xb6\x39\xb3\x66\x73\x42\xa7\xc7\xc0\xe8\xe3\xc0\x63\x5d\xb7\x3b\x36\x7f\x4b\x58\xff\xfb\xa2\x90\xbe\xfe\x6e\x4f\xf4\xee\x2d\x26\x46\x9e\x5d\x87\x13\x52\x7e\xf6\xea\x56\x39\x14\xa8\xbb\x84\xa4\x30\x99\xc0\xb8\x91\x52\xfb\xb0\xac\xd9\x0d\xad\xc9\x47\x5e\x26\xde\xee\xd7\x25\xf2\xe1\x0d\xeb\x84\x7e\x1b\xdf\x32\xe2\x8e\x4d\x0d\x2f\xd0\x09\x4c\x7a\x46\xa2\x22\x7b\x92\x2e\x3d\xc8\x5e\xd8\x83\xec\xbb\xb1\xaf\xcf\x6f\x1b\x44\xde\xc2\x04\x84\xed\x97\xb1\x6d\x6a\x6f\x77\xe0\x5a\xe8\xde\xc6\x3d\xa5\x3d\xdb\x53\x87\xb3\xf0\x5f\x57\xfb\xfb\xaf\x00\x00\x00\xff\xff\xf5\xa2\xaa\xbd\xb8\x0a\x00\x00")
+var _templateDialectGremlinCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xdf\x6f\xdb\x38\x0c\x7e\xb6\xff\x0a\x5e\x90\x0d\x76\xe1\xa9\xdd\xde\xae\x87\x3c\xf4\xb2\xec\x16\x60\xeb\x7e\xb4\xd7\x97\x61\x28\x54\x89\x4e\x84\x3a\x92\x27\xc9\xd9\x02\x43\xff\xfb\x81\xb2\xd3\x38\x59\xd6\xbb\xdd\x9e\x1a\x89\xe4\xc7\x8f\xe4\x47\xb9\x6d\x7b\x7a\x92\x4e\x4d\xbd\xb1\x6a\xb1\xf4\xf0\xe2\xec\xf9\xef\xcf\x6a\x8b\x0e\xb5\x87\x57\x5c\xe0\x9d\x31\xf7\x30\xd7\x82\xc1\x45\x55\x41\x74\x72\x40\x76\xbb\x46\xc9\xd2\xeb\xa5\x72\xe0\x4c\x63\x05\x82\x30\x12\x41\x39\xa8\x94\x40\xed\x50\x42\xa3\x25\x5a\xf0\x4b\x84\x8b\x9a\x8b\x25\xc2\x0b\x76\xb6\xb5\x42\x69\x1a\x2d\x53\xa5\xa3\xfd\xcd\x7c\x3a\xbb\xbc\x9a\x41\xa9\x2a\x84\xfe\xce\x1a\xe3\x41\x2a\x8b\xc2\x1b\xbb\x01\x53\x82\x1f\x24\xf3\x16\x91\xa5\x27\xa7\x21\xa4\x69\xdb\x82\xc4\x52\x69\x84\x91\x54\xbc\x42\xe1\x4f\x17\x16\x57\x95\xd2\xa7\xc2\x22\xf7\x38\x82\x10\xc8\x6b\x7c\xd7\xa8\x8a\x38\x9d\x4f\xa0\xe6\x4e\xf0\x0a\xc6\xec\x4a\x98\x1a\xd9\x9f\xbd\xa5\x77\xb4\x28\x50\xad\x3b\xcf\x87\xdf\xe3\xbb\x7d\xa7\x55\xe3\xb9\x57\x46\x93\x53\x6d\x95\xf6\x83\xb8\x11\xdb\x5a\x47\x40\xfe\x69\xd9\x68\x01\xd9\x1e\x76\x08\x70\x32\x64\x15\x42\x0e\x3d\xf1\x2b\xbe\xc6\x4c\xf8\x6f\x20\x8c\xf6\xf8\xcd\xb3\x69\xf7\x37\x87\x2c\x86\xb0\x4b\xbe\x42\x08\xa1\x00\xb4\xd6\xd8\x1c\xda\x14\x00\x68\x30\x44\xe6\x69\x8f\xc2\x3e\xa2\xab\x8d\x76\xd8\x86\x68\xfe\xd2\xa0\xdd\x14\x70\xa7\xb4\x54\x7a\x11\x5d\x0f\x08\xb1\x3e\x32\xcb\xd9\x07\x72\xce\xf2\x34\x51\x25\x25\x39\xe6\x2c\x2d\xfd\x62\xb3\x6f\x28\x88\x6c\x71\x98\xa0\x20\x42\xf9\x1f\x31\xfc\xb7\x09\x68\x55\x41\x9b\x26\x89\x45\xdf\x58\x4d\xc7\x48\x3f\x4d\xc2\x36\x49\x01\xe6\x9e\x12\x29\x37\x35\xda\x79\xae\xfd\x8c\xca\xcb\x3a\x18\x73\xff\xc3\x70\x62\xc6\x3e\xee\xa8\x11\xc8\xd3\x61\xa3\x5a\x61\x74\xa9\x16\xe7\xdf\xd5\xd0\xdd\x87\xc3\x32\x87\x60\xec\x95\x35\xab\x6d\x2b\xb3\xff\x5c\x52\x7f\x77\x88\x56\x90\x57\xfa\xd3\x8a\xc8\x72\x38\x91\xae\x62\xd7\x96\xaf\xd1\x3a\x1e\xf3\xb6\xed\x33\xf8\xaa\xfc\x12\xd8\x65\xb3\x8a\x2d\xb3\x9c\x74\x18\x42\x9a\x24\x7e\x53\xd3\x52\x3e\x5c\x3a\x6f\x1b\xe1\x29\x2c\x49\x6a\x8b\xf2\x10\xef\xf4\x74\xe8\x4d\x1e\x4a\x70\x8f\x8c\xfc\x3d\x3a\x7f\xc4\x3f\x5e\xaf\xb8\x17\x4b\x74\xc0\xb5\x04\xe5\x5d\x07\xc2\xb5\xa7\x40\xe2\xb1\x03\x8d\x8a\x5b\xf1\x7b\xcc\x3e\x7d\x3e\xd9\x5d\x17\x70\x56\x50\xd3\x19\x84\x90\x77\x45\xa1\x96\xb4\x35\xc9\x9a\x22\x16\xec\x42\xca\x9b\xd8\x29\xf6\x9e\x8b\x7b\xbe\xa0\x89\xb2\x37\xfc\x0e\xab\xde\xdf\x72\xbd\x40\x18\xdf\x16\x30\x2e\x29\x64\xcc\x5e\x29\xac\xa4\x8b\x20\x34\xda\x35\xaf\x1a\xdc\xca\x6b\x6f\x79\x43\x60\x74\x2e\xd9\xdb\xfe\xe6\x2f\xa4\x06\x66\x3b\xc1\xc5\x0c\xaa\x24\x9f\xbf\xb5\xfa\xd2\x50\x76\x6a\xca\x5e\x65\x13\xe0\x75\x8d\x5a\x66\x83\xcb\x02\x9e\xee\x4e\x11\xa9\xeb\xfc\x39\x2c\xd8\x4d\x96\xb3\xd7\xdc\x1d\xaf\xaa\x80\xc3\x6b\x3a\x97\x6c\xbb\x15\x71\xf3\x63\x49\x39\x9b\x9a\x46\xfb\x2c\x2f\x3a\x78\x9a\xc8\x39\xdc\xde\xb2\xb9\xcb\x6a\x76\x39\xfb\x90\x9d\xe5\xf9\x43\x5c\x76\x89\x5f\x67\xd6\x76\x55\xc4\x0e\xfd\x72\xfe\x3e\x31\x0d\x2e\xd9\x1b\x5d\x92\xac\xd9\x7b\x6b\x6a\xb4\x7e\x93\x91\x72\xae\x94\x5e\x54\xf8\x13\xd0\x9d\x7e\x86\x98\x07\xa3\xc6\x6e\xd4\x33\xb9\xc0\x7e\xd2\xe4\x30\xee\x3e\x1a\xfd\xc3\x3c\x9a\xeb\xd1\xc0\xa6\xe9\x39\xd8\xbe\xd7\x25\x8c\x9e\x38\xf6\xc4\x8d\x06\x84\xc6\xd8\xb5\x60\xc0\x27\x4d\x92\xd2\x58\xb8\x2d\x40\x49\xca\xd8\x31\x38\x26\x22\x64\x57\x71\xc9\x62\x6b\x21\x84\xf9\x4b\x97\xe5\xfb\x1a\x42\x36\x77\x73\x4d\x2b\xfc\x20\xa3\x03\xd2\x13\x18\xbd\x6b\xfc\x68\xcf\x1a\x69\x7f\xcf\x1a\xd9\xf5\xa6\xc6\x7f\xe1\x4e\x83\xb8\x90\x72\x16\x47\x1d\x81\x42\xc8\xe3\x9b\x96\x91\x0c\x95\xcc\x73\x36\xd7\x37\xd9\x6e\x82\x95\xc3\xc7\x42\xaf\xcd\x2e\xf0\x5d\xe3\x6f\xb2\x23\xb3\xdf\x95\xfb\x9a\xbb\xc3\x97\xe9\xd7\x36\x67\xd6\x6d\x4e\xac\x74\x9f\x58\xdb\x0e\xfb\x18\x42\xbf\x63\xf3\x97\xc4\xf5\xff\x2f\x0a\xe9\xeb\xb1\x3d\xe9\xf3\x93\x3c\x1e\x59\x87\x23\x52\xfe\xe1\xd3\xad\x4a\xa8\x50\x0f\x1b\x92\xc3\x64\x02\x67\x9d\x94\xfa\x0f\xcb\x9a\xdd\xd0\x9a\xbc\xe5\x75\xe6\xed\xc3\xba\x24\x3e\x7e\xc3\x06\xa1\x9f\xce\x3e\x33\xea\x1d\x9b\x1a\x5e\xa1\x13\x38\xc4\x25\x23\xbd\x19\xc5\x77\x70\xf9\x4e\xf6\xc2\xee\x64\x3f\x8c\x7d\x7e\xfe\xb9\x63\xe4\x2d\x4c\x40\xd8\xc3\x34\xb6\x87\xf6\x76\x4b\xae\xa7\xee\x6d\x7a\xa0\xb4\x1f\xd6\x34\xe8\x59\xfc\xaf\x0b\xb5\x84\x10\xd2\x7f\x06\x00\xf5\xa2\xaa\xbd\xb8\x0a\x00\x00")
 
 func templateDialectGremlinCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -366,7 +369,7 @@ func templateDialectGremlinCreateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x95\x51\x6b\xdb\x3a\x14\xc7\x9f\xad\x4f\x71\x6e\x08\x25\x09\x89\xd2\x5b\x2e\x17\x96\x91\x87\xd2\xb4\x60\xb6\xf5\xa1\xe9\xf6\x32\x46\xab\x5a\xc7\x89\x36\x59\x32\x92\x12\x16\x8c\xbe\xfb\x90\x6c\x07\xa7\x0b\x4d\xa1\x4f\x83\xbd\x59\xe7\x1c\xfd\xcf\x39\x3f\xfb\x9f\x54\xd5\x74\x44\xae\x74\xb9\x33\x62\xb5\x76\x70\x71\xfe\xef\xbb\x49\x69\xd0\xa2\x72\x70\xc3\x32\x7c\xd2\xfa\x07\xa4\x2a\xa3\x70\x29\x25\xc4\x22\x0b\x21\x6f\xb6\xc8\x29\xb9\x5f\x0b\x0b\x56\x6f\x4c\x86\x90\x69\x8e\x20\x2c\x48\x91\xa1\xb2\xc8\x61\xa3\x38\x1a\x70\x6b\x84\xcb\x92\x65\x6b\x84\x0b\x7a\xde\x66\x21\xd7\x1b\xc5\x89\x50\x31\xff\x31\xbd\xba\xbe\x5d\x5e\x43\x2e\x24\x42\x13\x33\x5a\x3b\xe0\xc2\x60\xe6\xb4\xd9\x81\xce\xc1\x75\x9a\x39\x83\x48\xc9\x68\xea\x3d\x21\x55\x05\x1c\x73\xa1\x10\x7a\x5c\x30\x89\x99\x9b\xae\x0c\x16\x52\xa8\x29\xc7\x30\xd5\x54\x2b\xec\x81\xf7\xa1\xb2\x6f\x30\x43\xb1\x45\x03\xb3\x39\xf4\xe9\x5d\x7b\x0a\x42\xd3\x29\xdc\x18\x5d\xdc\xa1\x2d\x75\x98\xd1\x66\x4c\xd9\x38\x4c\xa3\x17\x36\xaf\x53\x9c\x39\x06\x42\x39\x0d\x41\x93\xde\xb2\x02\xc1\x7b\x4a\xf2\x8d\xca\x60\x70\xd0\xc7\x7b\x18\x75\x8b\x86\x07\x4d\x06\x06\x2d\x8c\x1a\x7d\xda\x46\x87\x80\xc6\x68\x03\x15\x49\xb6\x05\x2b\xc7\xe1\x18\x06\x36\x68\xe9\x1d\x32\xfe\x85\xc9\x0d\x7e\x62\xe5\x60\x48\x12\x91\xc7\xec\x3f\x73\x50\x42\x86\x1b\x89\x41\xb7\x31\x2a\x44\x49\xe2\x49\x52\x55\x13\xe8\x87\x5d\x82\x42\x69\x84\x72\xd0\x0b\xc7\xde\xc1\x90\x24\xd9\x32\x13\xb7\x89\xa5\xde\x83\x75\x66\x93\xb9\xa8\x98\x2e\x00\xea\x4d\xd3\x05\xbd\xdf\x95\x61\x0f\x80\xc7\xef\x56\xab\x59\x4f\xf0\xb1\x2e\x84\xc3\xa2\x74\xbb\xde\x23\x49\x92\xaa\x02\xc3\xd4\x0a\xa1\xff\x30\x86\x7e\x5e\x93\xbe\x11\x28\xb9\x8d\x8d\x92\x7a\xa4\x9c\x2e\x63\x87\x98\x09\x82\x55\x05\x22\x0f\xf1\xd4\xde\x8b\xc8\x4a\x28\xf7\xff\x7f\x55\x05\x28\x6d\x38\xee\x0b\x6e\x85\x94\xec\x49\x86\x58\x60\x8b\x8a\xd7\xd9\x7e\xde\x4e\xb7\x8f\xb6\x53\xd6\xd9\xa5\xd3\x86\xad\xf0\x03\xee\xc0\xfb\xdf\xc7\xae\xaf\x44\x6a\x0d\xd6\xd9\x1c\xc2\x1b\xa0\x8b\xf8\x25\x0d\xce\x3a\x80\x86\xef\x4f\x82\x3f\x20\x4c\xd3\x05\xcc\xbb\x84\x69\xba\x20\xa7\x61\x45\x56\x5d\x99\x76\x93\x03\x76\x41\x78\xf2\x1c\x9f\x13\x05\xd2\xcf\x4a\xfc\x1c\x9c\x8f\x0f\x1a\x1f\x93\x18\x42\x17\xf4\xe4\xe5\xe2\x0e\xab\xe6\x71\x12\x9e\x1b\x00\x4a\x48\x12\xdd\xd6\xd4\xbc\xc2\xa2\x05\x53\xbb\x57\x78\x34\x2e\x11\x7e\x43\xea\xd4\x32\xd3\x25\xd2\x65\x0c\xbc\xc9\xc1\xb6\x91\x78\xd1\xc1\x6d\xd1\x1f\xe3\xe0\xaf\xdf\xfe\x7a\xf8\x8d\x1e\xce\xb5\x81\x87\x31\x6c\xe3\xbb\x8b\x40\xba\x80\xc3\x85\xd1\xf3\x6f\x65\x0e\xac\x2c\x51\xf1\xc1\xf3\xcc\x18\xce\xba\x7f\x04\xe1\x72\x92\x2e\x66\xb0\xa5\xe9\x62\x5c\x23\x3d\x05\xfd\x38\xf5\xd9\x49\xf3\x6f\x4f\x5a\xfe\x78\xc5\x9e\x67\x3b\xdf\xde\xea\x89\x1f\x46\x40\xc7\x2d\xff\x2b\x00\x00\xff\xff\xde\xce\x31\x91\x58\x08\x00\x00")
+var _templateDialectGremlinDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x55\xdf\x6b\xe3\x46\x10\x7e\xd6\xfe\x15\x5f\x8d\x39\x6c\xe3\xac\xd3\xa3\x14\xea\xe0\x87\xe3\x7c\x01\xd3\x36\x0f\x71\xda\x97\x52\xee\xf6\xa4\x51\xb2\xad\xbc\x2b\x76\xd7\x6a\x8d\xd0\xff\x5e\x66\x2d\x05\xe9\xe2\x26\x2e\x79\x2a\xdc\x9b\x66\xe7\xc7\x37\xf3\xcd\xf0\xa9\xae\x17\x33\xf1\xde\x96\x07\xa7\xef\x1f\x02\xde\x5e\x7e\xfb\xc3\x45\xe9\xc8\x93\x09\xb8\x56\x29\x7d\xb6\xf6\x4f\x6c\x4c\x2a\xf1\xae\x28\x10\x83\x3c\xd8\xef\x2a\xca\xa4\xb8\x7b\xd0\x1e\xde\xee\x5d\x4a\x48\x6d\x46\xd0\x1e\x85\x4e\xc9\x78\xca\xb0\x37\x19\x39\x84\x07\xc2\xbb\x52\xa5\x0f\x84\xb7\xf2\xb2\xf3\x22\xb7\x7b\x93\x09\x6d\xa2\xff\xa7\xcd\xfb\x0f\x37\xdb\x0f\xc8\x75\x41\x68\xdf\x9c\xb5\x01\x99\x76\x94\x06\xeb\x0e\xb0\x39\x42\x0f\x2c\x38\x22\x29\x66\x8b\xa6\x11\xa2\xae\x91\x51\xae\x0d\x61\x94\x69\x55\x50\x1a\x16\xf7\x8e\x76\x85\x36\x8b\x8c\xb8\xab\x85\x35\x34\x42\xd3\x70\xe4\xd8\x51\x4a\xba\x22\x87\xe5\x0a\x63\x79\xdb\x59\x5c\x68\xb1\xc0\xb5\xb3\xbb\x5b\xf2\xa5\x35\x9e\xe0\x53\x65\x7c\x6c\xa6\xad\xc7\x93\x1f\x5d\x99\x0a\x0a\xda\x04\x0b\xae\x29\x6f\xd4\x8e\xd0\x34\x52\xe4\x7b\x93\x62\x32\xc0\x69\x1a\xcc\xfa\x41\xd3\x01\xc8\xc4\x91\xc7\xac\xad\x2f\xbb\xd7\x29\xc8\x39\xeb\x50\x8b\xa4\xda\xa9\x72\xce\x26\x37\xec\xc8\xcb\x5b\x52\xd9\xaf\xaa\xd8\xd3\xcf\xaa\x9c\x4c\x45\xa2\xf3\xe8\xfd\x66\x05\xa3\x0b\xce\x48\x1c\x85\xbd\x33\xfc\x2a\x92\x46\x24\x75\x7d\x81\x31\xcf\xc2\x15\x4a\xa7\x4d\xc0\x88\xcd\xd1\xa0\x49\x91\x54\xca\xc5\x69\xd8\x87\xa6\x81\x0f\x6e\x9f\x86\x58\x71\xb3\x06\xa2\x4f\x6e\xd6\xf2\xee\x50\xf2\x1c\xc0\xa7\x3f\xbc\x35\xcb\x91\xce\xe6\x76\xa7\x03\xed\xca\x70\x18\x7d\x12\x49\x52\xd7\x70\xca\xdc\x13\xc6\x1f\xe7\x18\xe7\x0c\x3b\x96\xd7\x9a\x8a\xcc\x47\x20\x8e\xb8\xc0\x38\x97\xdb\x88\x10\x3d\x5c\xb0\xae\xa1\x73\x7e\xbf\xd1\x45\xa1\x3e\x17\x8c\xc2\xd4\x91\xc9\xd0\x34\x8f\xde\x8d\xbf\xd3\x91\x6e\x6d\xc2\xf7\xdf\xb1\xbf\xf0\x6c\x72\x7f\x79\xd7\xdd\x63\x5a\xd7\xe5\xd1\xbb\x0d\xd6\xa9\x7b\xfa\x91\x0e\x68\x9a\xa7\x6d\x1f\x91\x22\x6b\x2d\xad\xcb\x15\x78\x03\x72\x1d\x2f\x69\xf2\xa6\x47\xd0\xf4\xea\x45\xe2\x07\x0c\xcb\xcd\x1a\xab\x3e\xc3\x72\xb3\x3e\x6e\xe7\x79\xb6\x38\x42\xe7\x50\x26\xeb\x4d\x3f\x64\x49\x24\x09\xdf\x41\x85\xe5\x10\xa0\x1b\xba\x4f\xf3\x15\xaa\x7e\xcf\xc9\x93\x36\x4f\x25\x61\x05\x43\x7f\x4d\x82\xde\x91\x64\xfc\x29\x23\x26\xb3\x33\x53\x63\xda\x2f\x46\xff\x3d\xb9\x9c\x63\x56\xc5\xe4\x6e\xb2\x76\x79\xe2\xfc\x46\x5a\x3e\xfa\x97\x30\x00\x78\x89\x80\x29\xfa\x37\x73\xf1\x7c\x70\xef\x28\xe2\xaa\x3a\xa3\xff\xdd\xae\xdd\xe8\x42\x44\x8d\x69\xdf\xcf\x10\xa6\x9d\x32\x87\x33\x94\x89\xa7\xf0\xac\x9c\xbc\xdf\xb1\xdc\xa6\xb6\x24\xb9\x8d\x0f\xaf\xd2\x2d\xdf\x96\x78\x56\xb7\xba\xa0\xff\x8d\x6e\xfd\xf6\xfb\x57\xe5\x7a\xa5\x72\xe5\xd6\xe1\xe3\xfc\xa8\x27\x47\x42\xfa\x04\x73\x82\xe1\xbf\xfd\x72\x85\x37\xfd\x5f\x1b\x3b\x92\xcd\x7a\x89\x4a\x6e\xd6\x73\x91\x9c\x43\x68\x27\x6f\xc6\x06\x4c\xfe\x55\xe3\xa6\x5d\xf4\xe9\x05\x2c\x5f\x14\x85\xea\x45\x29\x38\x1d\xf1\x48\xed\xbc\xd3\xca\x8e\xe9\x56\xbe\x3a\xab\x13\x88\x33\xee\xe7\x0c\x31\x67\x35\x0f\x9c\x7e\xba\xab\x2b\x84\xfe\xf6\x92\xe3\x3e\xfe\x83\x72\x27\xb3\xe7\x12\x06\xcc\xcd\x42\xd4\xeb\xe4\xe9\xc8\x03\xe3\xcb\x7f\x01\x56\x50\x65\x49\x26\x9b\x7c\xe9\x99\x83\xb1\xa7\xf1\xd0\x4e\x4b\xe7\x3f\x03\x00\x88\xdf\x44\x6b\x96\x0a\x00\x00")
 
 func templateDialectGremlinDecodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -381,12 +384,12 @@ func templateDialectGremlinDecodeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/decode.tmpl", size: 2136, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/decode.tmpl", size: 2710, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x6f\x13\x31\x10\x85\xcf\xeb\x5f\x31\x54\x15\xb2\xa3\xc5\x29\xbd\x01\xea\xa1\x84\x20\x22\x55\x08\x9a\x8a\x6b\xe4\xd8\xb3\x1b\xab\xc6\x5e\xc6\xde\x28\xd1\xca\xff\x1d\x79\xbb\xa9\x02\x54\x9c\x6c\xcd\x7c\xe3\xf7\x9e\x67\x18\xe6\x33\xb6\x08\xdd\x91\x6c\xbb\x4b\x70\x7d\xf5\xf6\xdd\x9b\x8e\x30\xa2\x4f\xf0\x59\x69\xdc\x86\xf0\x08\x2b\xaf\x25\xdc\x3a\x07\x23\x14\xa1\xf4\x69\x8f\x46\xb2\x87\x9d\x8d\x10\x43\x4f\x1a\x41\x07\x83\x60\x23\x38\xab\xd1\x47\x34\xd0\x7b\x83\x04\x69\x87\x70\xdb\x29\xbd\x43\xb8\x96\x57\xa7\x2e\x34\xa1\xf7\x86\x59\x3f\xf6\xef\x56\x8b\xe5\xd7\xf5\x12\x1a\xeb\x10\xa6\x1a\x85\x90\xc0\x58\x42\x9d\x02\x1d\x21\x34\x90\xce\xc4\x12\x21\x4a\x36\x9b\xe7\xcc\xd8\x30\x80\xc1\xc6\x7a\x84\x0b\x63\x95\x43\x9d\xe6\x2d\xe1\x4f\x67\xfd\xdc\xa0\xc3\x84\x17\x90\x73\xa1\x2e\xb7\xbd\x75\xc5\xd3\xfb\x1b\xe8\x54\xd4\xca\xc1\xa5\x5c\xeb\xd0\xa1\xfc\x38\x75\x26\x90\x50\xa3\xdd\x3f\x91\xcf\xf7\xe7\xf1\x22\xda\xf4\x5e\x03\x3f\x67\x73\x86\xd9\xb9\x48\xce\x02\x26\x1f\xcb\x03\x6a\xae\xd3\x01\x74\xf0\x09\x0f\x49\x2e\x9e\x4e\x01\xdc\xfa\x54\x03\x12\x05\x12\x30\xb0\x8a\x30\x16\xcd\xd7\xd3\xa0\xbc\xc7\xd8\x05\x1f\x71\xc8\xac\xfa\xd5\x23\x1d\x6b\xd8\x5a\x6f\xac\x6f\x47\xee\x0f\xaf\x39\xcb\x69\x8c\x0b\xf9\xbd\xc0\x5c\xb0\xca\x36\xe5\xf9\x97\x60\x43\xe5\x26\x4f\xe6\x6a\xf8\x4b\xa0\x2e\x8b\x16\x1f\xc6\xf1\x57\x37\xe0\xad\x2b\x0e\x2b\xc2\xd4\x93\x87\xab\xd1\x36\xab\x32\x3b\x55\x08\xa3\xbc\x47\x65\x56\x3e\x71\xc1\x32\x7b\xe9\x93\xe0\x3f\xbf\xc4\x05\xcc\x4c\x74\xf2\x81\xd4\x1e\x29\xaa\x51\x2e\x15\xe7\xad\xfc\xc1\x85\xfc\xa2\xe2\x9d\xda\xa2\x1b\x1f\x94\xdf\x94\x7e\x54\x2d\x96\x20\x63\x55\xb0\xaa\x09\x04\x9b\x1a\xba\x71\x6b\xca\xb7\xf8\x4f\xe4\x8e\xd0\x58\xad\x12\xc6\x31\x4a\xc7\x93\x38\x4f\x90\xe4\xda\x1a\x5c\x36\x0d\xea\xc4\x37\x1b\xf9\x89\x42\xc7\x85\x90\x8b\xd0\x4f\x99\x86\x01\xd0\x1b\xc8\xf9\x77\x00\x00\x00\xff\xff\xfa\x87\x8a\x9c\x39\x03\x00\x00")
+var _templateDialectGremlinDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x51\xd1\x6e\x13\x31\x10\x7c\x3e\x7f\xc5\x52\x55\xc8\x8e\x82\x53\xfa\x06\x55\x1f\x4a\x08\xa2\x52\x84\xa0\xa9\x78\x8d\x1c\x7b\xef\x62\xd5\xb5\x8f\xb5\x2f\x4a\x74\xf2\xbf\x23\x5f\x2f\x55\x80\x88\xa7\xb3\x76\x66\x76\x66\xe7\xfa\x7e\x36\x61\xf3\xd0\x1e\xc8\x36\xdb\x04\xd7\x57\xef\x3f\xbc\x6b\x09\x23\xfa\x04\x5f\x94\xc6\x4d\x08\x4f\x70\xef\xb5\x84\x3b\xe7\x60\x20\x45\x28\x38\xed\xd0\x48\xf6\xb8\xb5\x11\x62\xe8\x48\x23\xe8\x60\x10\x6c\x04\x67\x35\xfa\x88\x06\x3a\x6f\x90\x20\x6d\x11\xee\x5a\xa5\xb7\x08\xd7\xf2\xea\x88\x42\x1d\x3a\x6f\x98\xf5\x03\xbe\xbc\x9f\x2f\xbe\xad\x16\x50\x5b\x87\x30\xce\x28\x84\x04\xc6\x12\xea\x14\xe8\x00\xa1\x86\x74\x62\x96\x08\x51\xb2\xc9\x2c\x67\xc6\xfa\x1e\x0c\xd6\xd6\x23\x5c\x18\xab\x1c\xea\x34\x6b\x08\x9f\x9d\xf5\x33\x83\x0e\x13\x5e\x40\xce\x85\x75\xb9\xe9\xac\x2b\x99\x3e\xde\x42\xab\xa2\x56\x0e\x2e\xe5\x4a\x87\x16\xe5\xa7\x11\x19\x89\x84\x1a\xed\xee\x85\xf9\xfa\x7e\x95\x17\xd3\xba\xf3\x1a\xf8\x29\x37\x67\x98\x9c\x9a\xe4\x2c\x60\xcc\xb1\xd8\xa3\xe6\x3a\xed\x41\x07\x9f\x70\x9f\xe4\xfc\xe5\x2b\x80\x5b\x9f\xa6\x80\x44\x81\x04\xf4\xac\x22\x8c\xc5\xf3\xed\x28\x94\x0f\x18\xdb\xe0\x23\xf6\x99\x55\xbf\x3a\xa4\xc3\x14\x36\xd6\x1b\xeb\x9b\x81\xf7\x47\xd6\x9c\xe5\x28\xe3\x42\xfe\x28\x64\x2e\x58\x65\xeb\xb2\xfe\x1c\xd9\x50\x79\xc9\x63\xb8\x29\xfc\x65\x30\x2d\x3f\x5a\xdc\x0c\xf2\x37\xb7\xe0\xad\x2b\x09\x2b\xc2\xd4\x91\x87\xab\x21\x36\xab\x32\x3b\x4e\x08\xa3\x7c\x40\x65\xee\x7d\xe2\x82\x65\x76\xae\x24\xf8\x4f\x4b\x5c\xc0\xc4\x44\x27\x1f\x49\xed\x90\xa2\x1a\xec\x52\x49\xde\xc8\x9f\x5c\xc8\xaf\x2a\x2e\xd5\x06\xdd\xd0\xba\xfc\xae\xf4\x93\x6a\xb0\x1c\x32\x4c\x05\xab\xea\x40\xb0\x9e\x42\x5b\x24\xa4\x7c\x83\xff\x9c\xdc\x12\x1a\xab\x55\xc2\x58\x76\x57\x2d\x4f\x62\xb8\xc0\xd6\xe0\xec\xb3\x4d\xe7\x6a\x1a\x80\x9b\x11\x3f\xe9\x21\xc9\x65\x19\xf1\xc9\x80\x88\xd3\x26\x92\x5c\x59\x83\x8b\xba\x46\x9d\xf8\x7a\x2d\x3f\x53\x68\xb9\x10\x72\x1e\xba\xb1\x9b\xbe\x07\xf4\x06\x72\xfe\x3d\x00\xf4\x14\xa1\x7b\x81\x03\x00\x00")
 
 func templateDialectGremlinDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -401,12 +404,12 @@ func templateDialectGremlinDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/delete.tmpl", size: 825, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/delete.tmpl", size: 897, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\xc1\x8e\xdb\x36\x10\x3d\x47\x5f\xf1\xe2\x66\x03\xc9\x55\xe5\x4d\x6e\x75\xb1\x87\xc0\x75\xda\x00\xc5\x22\x6d\x92\xd3\x62\x0f\xb4\x38\x92\x89\xa5\x48\x67\x48\x29\x5d\x18\xfa\xf7\x82\xa4\xe4\x6e\xed\x06\x0d\xd0\x9b\x30\x33\x7c\x6f\xde\xcc\xd3\x1c\x8f\xab\x65\xb6\xb1\x87\x47\x56\xed\xde\xe3\xf5\xf5\xab\x1f\x7f\x38\x30\x39\x32\x1e\x6f\x45\x4d\x3b\x6b\x1f\xf0\xce\xd4\x15\xde\x68\x8d\x58\xe4\x10\xf2\x3c\x90\xac\xb2\x8f\x7b\xe5\xe0\x6c\xcf\x35\xa1\xb6\x92\xa0\x1c\xb4\xaa\xc9\x38\x92\xe8\x8d\x24\x86\xdf\x13\xde\x1c\x44\xbd\x27\xbc\xae\xae\xe7\x2c\x1a\xdb\x1b\x99\x29\x13\xf3\xbf\xbd\xdb\x6c\x6f\x3f\x6c\xd1\x28\x4d\x98\x62\x6c\xad\x87\x54\x4c\xb5\xb7\xfc\x08\xdb\xc0\x3f\x21\xf3\x4c\x54\x65\xcb\xd5\x38\x66\x59\xd0\x80\xba\x77\xde\x76\x20\x66\xcb\x0e\xc2\xc8\xf9\x73\x2f\x8c\xd4\xc4\x0e\x0d\xdb\x0e\xee\xb3\x86\x54\x42\x53\xed\x1d\xe2\xf3\xe3\x11\x92\x1a\x65\x08\x8b\x29\xb1\x6a\x99\x3a\xad\xcc\x2a\x21\x2c\x90\xaa\x5e\x1c\x1e\x5a\xac\x6f\xb0\x13\x8e\xf0\xa2\xda\x58\xd3\xa8\xb6\x7a\x2f\xea\x07\xd1\x52\xa8\xc9\x56\x2b\x6c\xe2\x10\xba\x83\xa6\x8e\x8c\x77\x51\x89\x74\xba\xba\x8d\x71\xe3\x89\x1b\x51\x53\x95\x35\xbd\xa9\x91\x13\x36\xd6\x38\xcf\x42\x19\xbf\x0d\x64\x45\x04\xc8\x0b\xe4\xce\xb3\x32\x6d\x89\xbb\xfb\xd3\xab\xe3\x58\xe0\x98\x3d\x63\xf2\x3d\x1b\x38\xcf\xb5\x35\x43\xf5\x7b\x6f\x3d\xe5\x54\x1d\x98\x1a\xf5\x67\x5e\xe0\x7b\x50\xd5\xb9\xb6\x28\x61\x94\xce\xc6\xec\xc4\xb5\xbc\x20\xfb\x64\x3a\xc1\x6e\x2f\xf4\x2f\x2c\x0e\x7b\x67\x4d\xbe\xc3\xdd\xfd\xee\xd1\x53\x91\xc6\x17\xf8\x06\xc1\x18\x70\xf7\xea\x7e\x99\x7a\xca\x9e\xa9\x26\x64\xc3\x2c\xda\xe9\x5d\x75\x42\xca\x77\x25\x5e\x0e\xc5\x4f\xb1\xe2\xf9\x4d\xe8\x21\x80\xcc\x5d\x13\x73\xf6\x6c\x8c\x10\xc3\xdd\xf5\x3d\x6e\x2e\x2a\x9a\xce\x57\xb1\xbd\x26\x5f\xcc\x53\x1f\xc7\x35\x3a\xe5\x9c\x32\x2d\x52\x13\x18\x84\xee\x69\x51\xcc\x60\xcf\x53\xd8\x55\xbf\x0a\xf7\x3e\x4d\x62\x19\x08\x4a\xfc\x3d\x99\xe2\x5b\x68\x94\x19\x84\x56\x72\xa6\x69\x2c\xa7\x49\xac\x71\xe5\x16\x25\x22\x68\x62\x8d\x53\xc6\x0d\x66\xe6\x8f\xac\xba\xaf\x52\x9f\xd6\x36\xed\x64\xb5\x42\xca\x21\xc5\x93\x53\xa6\x50\x1f\xfe\x9e\xc0\x3c\x19\x11\x75\x58\x9c\x30\xde\xcd\xc6\xb9\xd8\xe4\x69\xfb\x53\xdf\xc7\x09\x17\x8b\x6d\xea\x7e\x81\xc4\x7a\x4b\x5f\xb6\xcc\x9f\x8c\xfa\xdc\xd3\x5b\x45\x5a\xa2\x66\x12\x9e\x1c\x44\xa2\x89\xa8\xd3\xf6\x43\x0f\x7d\x2c\x45\x13\x6a\x67\xfe\x0b\x90\x5c\x8b\x1d\xe9\x32\x55\x61\xf6\xee\x80\x7f\x78\xf7\xdc\x7f\x4f\xcc\xfc\xf2\x2c\x75\xec\x5c\xbb\x8e\x3b\xfa\x70\x60\x65\x7c\x93\x2f\x12\xf4\x95\xab\xae\x1c\xbe\x28\xbf\x4f\x0e\x58\xe3\xea\xbb\x61\x51\xe2\x29\x7f\x89\xa1\x18\xb3\x4b\xb9\x5b\xd9\xd2\x37\xaa\x25\xd9\xd2\xbf\x89\x0d\x10\xb3\xd6\x50\x53\xe2\x64\x95\xff\xab\x2f\xc0\x3d\x95\xa7\xe4\x99\xb6\x99\xef\xa4\x4d\xb9\xcd\xe4\x8b\x44\xa7\x8c\x54\x75\x14\xa7\x9a\x68\xa7\x56\x0d\x64\xc2\xb5\x3e\xd8\x70\x72\xf7\x56\xcb\x20\xfc\xdc\x55\xe1\xc3\x0b\x65\x82\x6f\x84\x49\xc3\x98\xb4\x9f\x51\xe4\x8c\xe5\xf4\xba\xfa\x63\x82\x2d\x90\x9f\x0b\x2f\xb1\xb3\x56\xc7\xdf\x8d\xc2\x95\xb8\x50\x3f\xfe\xc7\x0d\xe1\x80\xde\x6b\x5f\xfd\x2c\xbc\x28\x41\x5f\x3d\x27\x46\x85\xa5\x0b\xed\x28\xfe\x92\xf3\x91\x29\xe1\xb9\xa7\x2c\x9e\x6d\x32\x12\xe3\xf8\x57\x00\x00\x00\xff\xff\x8a\x8f\xb8\xc0\xe8\x06\x00\x00")
+var _templateDialectGremlinErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x5f\x6f\xdb\xb6\x17\x7d\x8e\x3e\xc5\xa9\x7e\x4d\x2b\xb9\xaa\x9c\xf4\xed\xe7\xc2\x0f\x9d\x97\x6c\x01\x86\xa0\x5b\xdb\x27\xc3\x0f\xb4\x74\x25\x13\xa1\x48\x97\xa4\x94\x06\x86\xbe\xfb\x40\x8a\x72\x1c\x3b\xd9\x0a\xec\xc9\x09\x79\x78\xce\xfd\x73\xee\xd5\x6e\x37\x9d\x44\x0b\xb5\x7d\xd0\xbc\xde\x58\x7c\xb8\xb8\xfc\xff\xfb\xad\x26\x43\xd2\xe2\x9a\x15\xb4\x56\xea\x0e\x37\xb2\xc8\xf1\x49\x08\x78\x90\x81\xbb\xd7\x1d\x95\x79\xf4\x75\xc3\x0d\x8c\x6a\x75\x41\x28\x54\x49\xe0\x06\x82\x17\x24\x0d\x95\x68\x65\x49\x1a\x76\x43\xf8\xb4\x65\xc5\x86\xf0\x21\xbf\x18\x6f\x51\xa9\x56\x96\x11\x97\xfe\xfe\x8f\x9b\xc5\xd5\xed\x97\x2b\x54\x5c\x10\xc2\x99\x56\xca\xa2\xe4\x9a\x0a\xab\xf4\x03\x54\x05\x7b\x20\x66\x35\x51\x1e\x4d\xa6\x7d\x1f\x45\x2e\x07\x14\xad\xb1\xaa\x01\x69\xad\xb4\x01\x93\xe5\xf8\xe7\x86\xc9\x52\x90\x36\xa8\xb4\x6a\x60\xbe\x0b\x94\x9c\x09\x2a\xac\x81\x7f\xbe\xdb\xa1\xa4\x8a\x4b\x42\x1c\x2e\xa6\xb5\xa6\x46\x70\x39\x1d\x18\x62\x0c\xa8\xd7\xdb\xbb\x1a\xb3\x39\xd6\xcc\x10\x5e\xe7\x0b\x25\x2b\x5e\xe7\x9f\x59\x71\xc7\x6a\x72\x98\x68\x3a\xc5\xc2\x17\xa1\xd9\x0a\x6a\x48\x5a\xe3\x33\x29\x8d\xc8\x6f\xfd\xb9\xb4\xa4\x2b\x56\x50\x1e\x55\xad\x2c\x90\x10\x16\x4a\x1a\xab\x19\x97\xf6\xca\x89\xa5\x9e\x20\x49\x91\x18\xab\xb9\xac\x33\x2c\x57\xfb\x57\xbb\x3e\xc5\x2e\x3a\xd3\x64\x5b\x2d\x61\xac\x2e\x94\xec\xf2\x3f\x5b\x65\x29\xa1\x7c\xab\xa9\xe2\x3f\x92\x14\xef\x40\x79\x63\xea\x34\x83\xe4\x22\xea\xa3\xbd\xd6\xe4\x44\xec\x9b\x6c\x98\x36\x1b\x26\x7e\xd3\x6c\xbb\x31\x4a\x26\x6b\x2c\x57\xeb\x07\x4b\xe9\x50\x3e\xa7\xd7\x31\x8d\x0e\xcb\xcb\xd5\x64\x88\x29\x3a\xe3\x95\xbb\x75\xb5\xa8\xc3\xbb\x7c\xcf\x94\xac\x33\xbc\xe9\xd2\x8f\x1e\xf1\x6a\xee\x62\x70\x24\x63\xd4\xa4\x75\x74\xd6\x7b\x8a\x6e\x79\xb1\xc2\xfc\x04\x51\x35\x36\xf7\xe1\x55\x49\x3c\x56\xbd\xef\x67\x68\xb8\x31\x5c\xd6\x2e\x6f\xf7\xd3\x31\xd1\x52\x9c\x8e\x64\xaf\x86\x63\x93\xff\xce\xcc\xe7\xa1\x12\x13\x27\x90\xe1\xb1\x32\xe9\xcf\xc8\x70\xd9\x31\xc1\xcb\x51\xa6\x52\xda\x65\xa2\xf4\x0c\xe7\x26\xce\xe0\x49\x07\x55\x5f\x65\xcc\x03\xd2\xe4\x5f\x35\x6f\x5e\x94\xde\xb7\x2d\xf4\x64\x3a\xc5\x70\x87\xe1\x7c\x70\x4a\x38\x6a\xdd\xf4\x38\xe5\x60\x44\x14\xae\x71\x4c\x5a\x33\x1a\xe7\xa4\x93\xa3\xd0\x18\xf7\x2e\xf0\x22\xf6\x80\x19\x62\x0c\xaa\xb7\x74\x7f\xa5\xf5\x37\xc9\xbf\xb7\x74\xcd\x49\x94\x28\x34\x31\x4b\x06\x6c\x90\xf1\x66\x0c\xdd\x77\x31\xb4\x1e\x8a\xca\x61\x47\xfd\x13\x92\x44\xb0\x35\x89\x6c\x40\x85\x18\x32\x74\x8f\x8e\x77\xde\x3d\xf6\xdf\x81\x99\xdf\x1c\x5d\xed\x1a\x53\xcf\x7c\x8f\xbe\x6c\x35\x97\xb6\x4a\xe2\x81\xfa\xdc\xe4\xe7\x06\xf7\xdc\x6e\x06\x07\xcc\x70\xfe\xbf\x2e\xce\x70\xa8\x9f\xa1\x4b\xfb\xe8\x34\xdd\xab\xb2\xa6\x9f\xcc\x96\xca\x9a\x9e\x4b\xd6\x51\x8c\xb9\x3a\x4c\x86\xbd\x55\xfe\x6b\x7e\x8e\xee\x30\x3d\x5e\x1e\xe5\x36\xea\xed\x73\xf3\x95\x37\xd7\x4a\x3f\x92\x3f\x71\xd3\x50\x31\xbb\x61\xd6\xad\xe5\x42\x75\xa4\xa9\xc4\xfa\xc1\x5f\x86\x44\x0f\x8a\xe0\x81\xf7\xcc\x38\x97\x74\x5c\x09\x66\xa9\xcc\xa0\xb4\x1f\x50\xee\xd6\x2f\x85\x42\x71\x03\xa9\x2c\x58\x80\x71\x25\xdd\x7a\x66\x4f\xac\x92\xff\xc3\xda\x79\x26\xf0\x24\xc5\x72\x35\x7a\x37\x3a\xf3\x61\x8d\xe3\x30\x47\x68\x7e\xfc\xd2\xa8\xfb\x51\xcc\x02\xfe\xc9\x98\xbb\x71\x73\xc3\x2a\x59\x43\x98\x3d\x3b\xac\x4f\x1f\x7b\x09\x7e\x08\xbd\x91\x25\xfd\x48\x1c\x41\x86\xf8\x89\xf5\xe2\xf4\x23\x38\x5e\xcd\xf1\xfe\xd2\x6b\x3a\x0c\xe6\x70\x3f\xcb\x19\x5f\xf9\x2d\x71\x4a\xf5\xcb\x83\xa5\x40\xf7\x36\x7f\x3b\x0a\xce\xf7\x2c\x47\x91\x87\x7f\xc7\xea\xec\xdc\xcb\x25\x7f\x77\x39\x5b\x8d\x3e\xe0\x66\x11\xf6\x83\xf7\x16\xb8\x2c\x79\xe1\x4d\x1e\xba\x56\xf3\x8e\xa4\xfb\x6a\x6f\x95\x34\x84\x8d\x12\xa5\x1b\xf7\xe3\xed\xe2\x26\xc2\x32\x2e\xdd\xfe\x60\x7e\x55\x2b\x1d\xda\x78\x24\x91\x68\x4c\xc2\xeb\xfc\xaf\x40\x9b\x22\x39\xee\x74\x86\xb5\x52\xc2\xf7\xc3\x17\xff\x64\x0a\xfa\x7f\xf9\x96\x68\xc7\xde\x0a\x9b\xff\xca\x2c\xcb\x40\x2f\x7e\x56\x24\x77\xc3\xcf\x84\xa1\xc3\x9a\x51\x06\xab\x5b\x8a\xfa\x68\xb7\x03\xc9\x12\x7d\xff\xf7\x00\x35\xb8\x49\xb5\xf0\x08\x00\x00")
 
 func templateDialectGremlinErrorsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -421,12 +424,12 @@ func templateDialectGremlinErrorsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/errors.tmpl", size: 1768, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/errors.tmpl", size: 2288, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectGremlinGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x21\xc7\x40\xed\x34\xb7\x1e\x43\x48\x21\x50\x7a\x69\x7f\xc0\x91\xc6\x91\xa8\xa2\x35\xbb\x72\x21\x18\xff\x7b\x89\xeb\x42\xaf\xfb\xde\xce\x30\xd3\xd4\x6e\xdd\x51\x86\xbb\xa6\x6b\xac\xd8\xef\x9e\x5f\x9e\x06\xa5\xb1\x54\xbc\x76\x9e\x17\x91\x2f\x9c\x8b\x6f\x70\xc8\x19\x8b\x64\x78\x70\xfd\x66\x68\xdc\x67\x4c\x06\x93\x51\x3d\xe1\x25\x10\xc9\x90\x93\x67\x31\x06\x8c\x25\x50\x51\x23\x71\x18\x3a\x1f\x89\x7d\xb3\xfb\xa3\xe8\x65\x2c\xc1\xa5\xb2\xf0\xb7\xf3\xf1\xf4\xfe\x71\x42\x9f\x32\xb1\xde\x54\xa4\x22\x24\xa5\xaf\xa2\x77\x48\x8f\xfa\xaf\xac\x2a\xd9\xb8\x6d\x3b\xcf\xce\x3d\x36\xc0\x8f\x56\xe5\x86\x6b\x96\x4b\x97\x0d\x5d\x09\x88\xcc\x03\xd5\xd0\x8b\xe2\xaa\xbc\xe5\x54\x10\x52\x97\xe9\xab\x61\x79\x9d\x26\x04\xf6\xa9\x10\x9b\x15\xb4\xab\xd8\xae\x41\x1b\xfc\x6a\x2c\x01\xf3\xfc\x13\x00\x00\xff\xff\xd4\x11\x85\x70\x2e\x01\x00\x00")
+var _templateDialectGremlinGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\xc1\x6a\xc3\x30\x10\x44\xef\xfa\x8a\x21\xc7\x40\xed\x34\xb7\x1e\x43\x48\x21\x50\x7a\x69\x7f\xc0\x91\xc6\x91\xa8\xa2\x35\xbb\x72\x21\x18\xff\x7b\x89\xeb\x42\xaf\xfb\xde\xce\x30\xd3\xd4\x6e\xdd\x51\x86\xbb\xa6\x6b\xac\xd8\xef\x9e\x5f\x9e\x06\xa5\xb1\x54\xbc\x76\x9e\x17\x91\x2f\x9c\x8b\x6f\x70\xc8\x19\x8b\x64\x78\x70\xfd\x66\x68\xdc\x67\x4c\x06\x93\x51\x3d\xe1\x25\x10\xc9\x90\x93\x67\x31\x06\x8c\x25\x50\x51\x23\x71\x18\x3a\x1f\x89\x7d\xb3\xfb\xa3\xe8\x65\x2c\xc1\xa5\xb2\xf0\xb7\xf3\xf1\xf4\xfe\x71\x42\x9f\x32\xb1\xde\x54\xa4\x22\x24\xa5\xaf\xa2\x77\x48\x8f\xfa\xaf\xac\x2a\xd9\xb8\x6d\x3b\xcf\xce\x3d\x36\xc0\x8f\x56\xe5\x86\x6b\x96\x4b\x97\x0d\x5d\x09\x88\xcc\x03\xd5\xd0\x8b\xe2\xaa\xbc\xe5\x54\x10\x52\x97\xe9\xab\x61\x79\x9d\x26\x04\xf6\xa9\x10\x9b\x15\xb4\xab\xd8\xae\x41\x1b\xfc\x6a\x2c\x01\xf3\xfc\x33\x00\xd4\x11\x85\x70\x2e\x01\x00\x00")
 
 func templateDialectGremlinGlobalsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -446,7 +449,7 @@ func templateDialectGremlinGlobalsTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xd1\x6e\x1b\x2b\x10\x7d\x5e\xbe\x62\xae\x15\x5d\x2d\xbe\x7b\x71\x92\xb7\xb6\xf2\x43\x92\x26\x55\xa4\x26\x52\xe3\xb4\x2f\x55\x65\x11\x18\x6c\xd4\x35\x6c\x81\x5d\xd9\x5a\xf1\xef\x15\x78\x6d\x39\x89\x53\xa9\x4f\xc0\xcc\x99\x39\x67\x38\xec\xf6\xfd\x64\x4c\xae\x6c\xb3\x71\x7a\xb1\x0c\x70\x7e\x7a\xf6\xee\xff\xc6\xa1\x47\x13\xe0\x86\x0b\x7c\xb2\xf6\x27\xdc\x1a\xc1\xe0\xa2\xae\x21\x83\x3c\xa4\xbc\xeb\x50\x32\xf2\xb8\xd4\x1e\xbc\x6d\x9d\x40\x10\x56\x22\x68\x0f\xb5\x16\x68\x3c\x4a\x68\x8d\x44\x07\x61\x89\x70\xd1\x70\xb1\x44\x38\x67\xa7\xbb\x2c\x28\xdb\x1a\x49\xb4\xc9\xf9\xcf\xb7\x57\xd7\xf7\xb3\x6b\x50\xba\x46\x18\x62\xce\xda\x00\x52\x3b\x14\xc1\xba\x0d\x58\x05\xe1\x80\x2c\x38\x44\x46\xc6\x93\x18\x09\xe9\x7b\x90\xa8\xb4\x41\x18\x49\xcd\x6b\x14\x61\xb2\x70\xb8\xaa\xb5\x99\x2c\x9c\x6d\x9b\x11\xc4\x98\x40\x27\x4f\xad\xae\x93\xa4\xf7\x53\x68\xb8\x17\xbc\x86\x13\x36\x13\xb6\x41\x76\x39\x64\x06\xa0\x43\x81\xba\xdb\x22\xf7\xfb\x7d\x79\xe2\x54\xad\x11\x50\x3e\xc3\xc6\x08\xe3\x43\x96\x18\x29\x0c\x3a\x66\x82\x9b\x52\x84\x35\x08\x6b\x02\xae\x03\xbb\xda\xae\x15\x74\xa0\x4d\x40\xa7\xb8\xc0\x3e\x52\x40\xe7\xac\x83\x9e\x14\x0e\x7d\x22\xff\x77\x68\xc0\x1e\xd0\x37\xd6\x78\xec\x23\x29\x7e\xb5\xe8\x36\x15\x3c\x69\x23\xb5\x59\x64\xdc\x0b\x21\x6c\x28\xfb\x92\x90\x25\x65\xc3\x4a\x0a\xad\x12\xc5\xb1\x0a\xe9\xd2\x8e\x5d\xaf\x51\x24\xa5\x15\xbc\x60\xa9\x92\xeb\xf4\x43\x2e\xff\x67\x0a\x46\xd7\x49\x66\xe1\x30\xb4\xce\xa4\x28\x29\x62\xee\x5f\xa3\x79\x79\x2f\x4c\x69\xac\xa5\xa7\xff\x1d\xcd\x19\x4f\x61\x3a\x85\xb3\xc3\x7e\x0e\x3d\x7b\x40\x2e\xbf\xf1\xba\xec\x68\x6e\xdd\xad\xaa\x9d\xf6\x83\x6c\x8b\x77\xbc\x39\x98\xec\x6d\x69\xc3\xb1\x5b\xb1\x8f\x98\x9e\x6a\xea\x1b\xc9\xdf\x3a\x39\xdc\x24\x8c\xa5\xaf\xd9\xa3\xe3\x1d\x3a\xcf\x33\x5f\xc7\x1d\x94\xa4\x28\x82\xf3\xf0\xfd\xc7\x81\xab\xa4\x28\x0c\x5f\xe1\xab\x28\x25\x85\xb2\x0e\xe6\x15\x28\x93\xa7\xe2\x66\x81\xaf\x7c\x51\xc6\xe7\x69\x52\x8b\x0a\x42\x9e\x5f\x99\x72\xd4\x8c\x2a\x18\x8d\xe8\x40\x38\x05\xde\x34\x68\x64\x19\x9c\x4f\x28\xba\x27\xdd\x67\xf2\xb1\x82\xb4\x6c\x2f\x74\x47\xfe\x07\xee\x6c\xdb\x9e\xfe\x75\x33\x75\x9c\x7f\x3e\x67\x17\x3e\x49\xa4\xec\xab\x51\xb6\x96\x25\x65\xd9\x2b\x5f\x2a\x9a\x52\x8a\xd2\x43\x4f\xde\x78\xbd\xec\x53\xfa\x7a\x4b\xca\x48\x51\x14\xc5\xe5\xa6\x9c\xcf\x77\x6d\x8e\x2b\x65\x8c\x51\x76\x93\xf9\x9e\x15\x6d\x43\xec\x8e\x07\xb1\x4c\x0a\x33\x6e\x86\xe9\x4f\xb1\x9d\x24\x05\x86\x8a\x21\x9c\xec\xdd\x72\x0d\xf1\x7b\x5c\x87\x32\xbd\x98\xbe\x07\x34\x12\x62\xfc\x1d\x00\x00\xff\xff\x9b\x7d\xc5\x2a\x3b\x05\x00\x00")
+var _templateDialectGremlinGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6e\x1b\x2b\x14\x5d\x0f\x5f\x71\x9f\x15\x3d\x0d\x7e\xf3\x70\x92\x5d\x5b\x79\x91\xa4\x49\x15\xa9\x89\xd4\x38\xed\xa6\xaa\x2c\x02\x17\x1b\x75\x0c\x53\x60\x46\xb6\x46\xfc\x7b\x05\x1e\x5b\x4e\xe2\x54\xea\x8a\xe1\xde\x73\xcf\x39\x70\xb0\xfb\x7e\x32\x26\x57\xb6\xd9\x38\xbd\x58\x06\x38\x3f\x3d\x7b\xf7\x7f\xe3\xd0\xa3\x09\x70\xc3\x05\x3e\x59\xfb\x13\x6e\x8d\x60\x70\x51\xd7\x90\x41\x1e\x52\xdf\x75\x28\x19\x79\x5c\x6a\x0f\xde\xb6\x4e\x20\x08\x2b\x11\xb4\x87\x5a\x0b\x34\x1e\x25\xb4\x46\xa2\x83\xb0\x44\xb8\x68\xb8\x58\x22\x9c\xb3\xd3\x5d\x17\x94\x6d\x8d\x24\xda\xe4\xfe\xe7\xdb\xab\xeb\xfb\xd9\x35\x28\x5d\x23\x0c\x35\x67\x6d\x00\xa9\x1d\x8a\x60\xdd\x06\xac\x82\x70\x20\x16\x1c\x22\x23\xe3\x49\x8c\x84\xf4\x3d\x48\x54\xda\x20\x8c\xa4\xe6\x35\x8a\x30\x59\x38\x5c\xd5\xda\x4c\x16\xce\xb6\xcd\x08\x62\x4c\xa0\x93\xa7\x56\xd7\xc9\xd2\xfb\x29\x34\xdc\x0b\x5e\xc3\x09\x9b\x09\xdb\x20\xbb\x1c\x3a\x03\xd0\xa1\x40\xdd\x6d\x91\xfb\xef\xfd\x78\xd2\x54\xad\x11\x50\x3e\xc3\xc6\x08\xe3\x43\x95\x18\x29\x0c\x3e\x66\x82\x9b\x52\x84\x35\x08\x6b\x02\xae\x03\xbb\xda\xae\x15\x74\xa0\x4d\x40\xa7\xb8\xc0\x3e\x52\x40\xe7\xac\x83\x9e\x14\x0e\x7d\x12\xff\x77\x20\x60\x0f\xe8\x1b\x6b\x3c\xf6\x91\x14\xbf\x5a\x74\x9b\x0a\x9e\xb4\x91\xda\x2c\x32\xee\x85\x11\x36\x8c\x7d\x49\xc8\x92\xb2\x61\x25\x85\x56\x49\xe2\xd8\x84\x74\xe9\x8b\x5d\xaf\x51\x24\xa7\x15\xbc\x50\xa9\x52\xea\xf4\x43\x1e\xff\x67\x0a\x46\xd7\xc9\x66\xe1\x30\xb4\xce\xa4\x2a\x29\x62\xe6\xaf\xd1\xbc\xbc\x17\xa6\x34\xd6\xd2\xd3\xff\x8e\xf6\x8c\xa7\x30\x9d\xc2\xd9\x21\x9f\x43\xcf\x1e\x90\xcb\x6f\xbc\x2e\x3b\x9a\xa9\xbb\x55\xb5\xf3\x7e\xd0\x6d\xf1\x8e\x37\x07\x27\x7b\xdb\xda\xb0\xed\x56\xec\x23\xa6\xa7\x9a\x78\x23\xf9\xdb\x24\x87\x9b\x84\xb1\xf4\x35\x7b\x74\xbc\x43\xe7\x79\xbe\x8a\x8e\x3b\x28\x49\x51\x04\xe7\xe1\xfb\x8f\x83\x54\x49\x51\x18\xbe\xc2\x57\x55\x4a\x0a\x65\x1d\xcc\x2b\x50\x26\x9f\x8a\x9b\x05\xbe\xca\x45\x19\x9f\xd8\x33\x45\x05\x21\x3f\x49\x65\xca\x51\x33\xaa\x60\x34\xa2\x83\xe0\x14\x78\xd3\xa0\x91\x65\x70\x3e\xa1\xe8\x5e\x74\xdf\xc9\xdb\x0a\xd2\xb2\xbd\xd0\x9d\xf8\x1f\xb4\x73\x6c\xd0\xbf\x49\xa6\x8e\xeb\xcf\xe7\xec\xc2\x27\x8b\x94\x7d\x35\xca\xd6\xb2\xa4\x2c\x67\xe5\x4b\x45\x53\x4b\x51\x7a\x98\xc9\x1b\xaf\x97\x7d\x4a\xbf\xde\x92\x32\x52\x14\x45\x71\xb9\x29\xe7\xf3\x1d\xcd\x71\xa7\x8c\x31\xca\x6e\xb2\xde\xb3\xa1\x6d\x89\xdd\xf1\x20\x96\xc9\x61\xc6\xcd\x30\xfd\x53\x6c\x4f\x92\x0a\xc3\xc4\x50\x4e\xf1\x6e\xb5\x86\xfa\x3d\xae\x43\x99\x5e\x4c\xdf\x03\x1a\x09\x31\xfe\x1e\x00\x9b\x7d\xc5\x2a\x3b\x05\x00\x00")
 
 func templateDialectGremlinGroupTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -466,7 +469,7 @@ func templateDialectGremlinGroupTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x41\x6b\x1b\x31\x14\x84\xcf\xd1\xaf\x18\x8c\x4f\xa1\xde\x4d\x73\x6b\x21\x87\x60\x5c\x30\x84\x5c\xda\x7b\x91\xa5\xd9\x5d\x51\x59\x32\x7a\x72\x8a\x11\xfa\xef\x45\x9b\x4d\x9c\x43\xa1\x97\xde\xf4\xde\xf7\x66\x66\x77\x4a\xe9\x6f\xd5\x36\x9e\x2e\xc9\x8d\x53\xc6\xfd\xdd\xe7\x2f\x9b\x53\xa2\x30\x64\x7c\xd3\x86\x87\x18\x7f\x61\x1f\x4c\x87\x47\xef\x31\x1f\x09\x1a\x4f\x2f\xb4\x9d\xfa\x31\x39\x81\xc4\x73\x32\x84\x89\x96\x70\x02\xef\x0c\x83\xd0\xe2\x1c\x2c\x13\xf2\x44\x3c\x9e\xb4\x99\x88\xfb\xee\xee\x8d\x62\x88\xe7\x60\x95\x0b\x33\x7f\xda\x6f\x77\xcf\xdf\x77\x18\x9c\x27\x96\x5d\x8a\x31\xc3\xba\x44\x93\x63\xba\x20\x0e\xc8\x1f\xc2\x72\x22\x3b\x75\xdb\xd7\xaa\x54\xfb\x07\x98\x18\x24\xeb\x90\x05\x81\xb4\xb4\x18\x62\xc2\x98\x78\xf4\x2e\xc0\x3a\xed\x69\xb2\x74\x98\x15\xa5\xc0\x72\x70\x81\x58\x2d\xa4\x5f\x2e\xfb\x23\xb3\xee\xdf\xbd\x56\xa8\x55\xdd\x94\x82\xa4\xc3\x48\xac\x7f\x7e\xc2\x9a\xf8\xfa\x80\x75\xb7\xb3\x23\x05\xb5\x96\x82\xb5\xd7\x07\xfa\x79\xcd\xee\xa9\xbd\xb7\x8b\x01\x36\x4d\xdf\x0c\xdc\xd0\xe0\x5e\xf6\xe1\x85\x49\x38\x0b\x37\x6f\xca\x59\xb8\x90\xbf\xe9\x6f\xfa\x1e\xd7\x9c\x5a\x31\x45\x6f\x65\x6e\x49\x72\x72\x61\xc4\x2b\xb1\x0c\x31\xb7\xb1\x91\x52\xe0\xe3\x6f\xa6\xe6\xfd\xac\x8f\x2d\x12\x6e\x49\xa7\x1d\x89\x7c\x39\xbd\x97\x6d\x75\xd6\x07\x2d\xec\x5e\xbf\x96\x5e\xf8\xbf\xb3\xff\x99\x19\xec\xb5\xae\x6b\xdc\x03\x56\x6d\x5e\x8a\x45\xad\x2b\xf5\xf1\x7a\x79\xd6\xaa\xfe\x04\x00\x00\xff\xff\xb1\x73\xa1\x6f\xca\x02\x00\x00")
+var _templateDialectGremlinMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x90\x41\x6b\xdc\x30\x14\x84\xcf\xd1\xaf\x18\x16\x9f\x42\x57\x4e\x73\x6b\x21\x87\xb0\x6c\x61\x21\xe4\xd2\xde\x8b\x56\x1a\xdb\xa2\x5a\xc9\x48\xda\x94\x45\xe8\xbf\x17\x39\xce\x36\x85\x42\x2f\xbd\xd9\xef\x7b\x33\x9f\xfd\x4a\xe9\x6f\xc5\x2e\xcc\x97\x68\xc7\x29\xe3\xfe\xee\xe3\xa7\xed\x1c\x99\xe8\x33\xbe\x28\xcd\x63\x08\x3f\x70\xf0\x5a\xe2\xd1\x39\x2c\x4b\x09\x8d\xc7\x17\x1a\x29\xbe\x4d\x36\x21\x85\x73\xd4\x84\x0e\x86\xb0\x09\xce\x6a\xfa\x44\x83\xb3\x37\x8c\xc8\x13\xf1\x38\x2b\x3d\x11\xf7\xf2\xee\x8d\x62\x08\x67\x6f\x84\xf5\x0b\x7f\x3a\xec\xf6\xcf\x5f\xf7\x18\xac\x23\xd6\x59\x0c\x21\xc3\xd8\x48\x9d\x43\xbc\x20\x0c\xc8\xef\x64\x39\x92\x52\xdc\xf6\xb5\x0a\xd1\xfe\x01\x3a\xf8\x94\x95\xcf\x09\x9e\x34\x34\x18\x42\xc4\x18\x79\x72\xd6\xc3\x58\xe5\xa8\x73\x92\x58\x12\xa5\xc0\x70\xb0\x9e\xd8\xac\xa4\x5f\x37\xfb\x13\xb3\xea\xaf\x5d\x1b\xd4\x2a\x6e\x4a\x41\x54\x7e\x24\xba\xef\x1f\xd0\x11\x9f\x1f\xd0\xc9\xbd\x19\x99\x50\x6b\x29\xe8\x9c\x3a\xd2\x2d\x63\xca\xa7\xf6\xbc\x5b\x0b\xb0\x6d\xf9\x56\x60\x07\x74\x94\x87\x74\xf0\x2f\x8c\x89\x4b\x70\xfb\x96\x5c\x82\x2b\xf9\x5b\xfe\xa6\xef\xf1\xdb\x53\x2b\xa6\xe0\x4c\x5a\xae\x94\x72\xb4\x7e\xc4\x2b\x31\xf4\x21\xb7\xd7\x46\x4a\x81\x0b\x3f\x19\x5b\xf7\xb3\x3a\x35\x25\xec\x6a\xa7\x19\x89\x7c\x99\xaf\xc7\x36\x2a\xab\xa3\x4a\x94\xaf\x5f\x4b\x97\xf8\xbf\xdd\xff\x74\x7a\xb3\x2a\xff\xd0\x3d\x60\x53\xca\xf5\xb0\xa8\x75\x23\xde\x6f\x97\x02\x7a\x83\x5a\xc5\xaf\x01\x00\xb1\x73\xa1\x6f\xca\x02\x00\x00")
 
 func templateDialectGremlinMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -486,7 +489,7 @@ func templateDialectGremlinMetaTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x50\xc1\x6a\xdd\x30\x10\x3c\x4b\x5f\xb1\xcd\xc9\x0e\xae\x9c\xe6\xd6\x07\x39\x04\xf7\x15\x02\xe1\x51\x9a\xf6\x03\x54\x69\xfd\xbc\x54\x59\x89\x95\xec\x12\x8c\xff\xbd\xd8\x3c\xbf\x96\x9e\x7a\xdc\x99\xd9\x99\x61\xe6\xb9\xbd\xd5\x5d\x4c\x6f\x42\xe7\xa1\xc0\xfd\xdd\x87\x8f\xef\x93\x60\x46\x2e\xf0\xd9\x3a\xfc\x11\xe3\x4f\x78\x62\x67\xe0\x31\x04\xd8\x44\x19\x56\x5e\x26\xf4\x46\x7f\x1b\x28\x43\x8e\xa3\x38\x04\x17\x3d\x02\x65\x08\xe4\x90\x33\x7a\x18\xd9\xa3\x40\x19\x10\x1e\x93\x75\x03\xc2\xbd\xb9\xdb\x59\xe8\xe3\xc8\x5e\x13\x6f\xfc\xf3\x53\x77\x3c\xbd\x1c\xa1\xa7\x80\x70\xc1\x24\xc6\x02\x9e\x04\x5d\x89\xf2\x06\xb1\x87\xf2\x57\x58\x11\x44\xa3\x6f\xdb\x65\xd1\x7a\x9e\xc1\x63\x4f\x8c\x70\xe3\xc9\x06\x74\xa5\x3d\x0b\xbe\x06\xe2\xd6\x05\x42\x2e\x6d\x4c\xc8\x37\xb0\x2c\x5a\x8d\x0d\xa0\x08\x1c\x1e\x60\x94\x60\xbe\x58\xc9\x58\x79\x5b\xec\xcb\x66\x7b\xb2\xaf\x58\x6b\x45\xfd\x26\x7a\xf7\x00\x4c\x01\x66\xad\x94\x60\x19\x85\xd7\x73\xfb\xd7\x6a\xd1\xca\x5d\xad\x2e\x69\xe6\x84\xbf\xba\x2d\xb0\xda\x91\x2e\x72\x4f\xe7\xd5\xe1\xc8\x3e\x45\xe2\x72\xb8\xaa\x77\x64\x65\xd5\xf7\xaf\xcf\x07\x18\x1b\xad\xd4\xd2\x68\xb5\xfc\x77\x09\x2f\xd3\x3f\x0d\x3e\x09\x4d\x28\x95\xab\xf5\xfe\xf0\xa7\x96\x4d\x09\xd9\x57\x31\x15\x8a\x9c\x1b\xb8\x68\xbd\x4c\x75\x6d\x8c\xa9\x9b\xd5\x7c\xdd\x13\xd9\xaf\x73\xfd\x0e\x00\x00\xff\xff\xe0\x35\x12\x3f\x1e\x02\x00\x00")
+var _templateDialectGremlinOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\x41\x6b\xdc\x30\x14\x84\xcf\xd2\xaf\x98\xe6\xb4\x0e\xae\x9c\xe6\xd6\x85\x1c\xc2\x76\x0b\x81\xb0\x94\xa6\xfd\x01\xae\xf4\xbc\xfb\xa8\xf2\x64\x9e\xe5\x2d\x8b\xd1\x7f\x2f\x36\xeb\xb6\xf4\x94\xa3\xe6\x9b\x37\x33\x9a\xa6\xe6\xd6\xee\x52\x7f\x51\x3e\x9e\x32\xee\xef\x3e\x7c\x7c\xdf\x2b\x0d\x24\x19\x9f\x5b\x4f\x3f\x52\xfa\x89\x27\xf1\x0e\x8f\x31\x62\x31\x0d\x98\xb9\x9e\x29\x38\xfb\xed\xc4\x03\x86\x34\xaa\x27\xf8\x14\x08\x3c\x20\xb2\x27\x19\x28\x60\x94\x40\x8a\x7c\x22\x3c\xf6\xad\x3f\x11\xee\xdd\xdd\x4a\xd1\xa5\x51\x82\x65\x59\xf8\xf3\xd3\x6e\x7f\x78\xd9\xa3\xe3\x48\xb8\x6a\x9a\x52\x46\x60\x25\x9f\x93\x5e\x90\x3a\xe4\x7f\xca\xb2\x12\x39\x7b\xdb\x94\x62\xed\x34\x21\x50\xc7\x42\xb8\x09\xdc\x46\xf2\xb9\x39\x2a\xbd\x46\x96\xc6\x47\x26\xc9\x4d\xea\x49\x6e\x50\x8a\x35\x63\x0d\x52\xc5\xf6\x01\xa3\x46\xf7\xa5\xd5\x81\x36\xa1\xcd\xed\xcb\xf2\x87\x43\xfb\x4a\x95\x35\xdc\x2d\xa6\x77\x0f\x10\x8e\x98\xac\x31\x4a\x79\x54\x99\x9f\xcb\xbd\x35\xc5\x1a\xff\x27\xea\xda\xe6\x0e\xf4\x6b\xb7\x14\x6e\x56\x65\x97\xa4\xe3\xe3\x9c\xb0\x97\xd0\x27\x96\xbc\xc5\xca\x56\x65\xa6\xe6\xfb\xd7\xe7\x2d\xc6\xda\x1a\x53\x6a\x6b\xca\x9b\x47\x04\x3d\xff\xb7\xe0\x93\xf2\x99\x74\xe3\x2b\xbb\x1e\xfc\x9d\xd5\xf6\x3d\x49\xd8\xa4\x3e\x73\x92\xa1\xc6\xd5\x1b\xf4\x5c\x55\xce\xb9\xaa\x9e\xbb\xec\x34\x81\x24\xa0\x14\xfb\x7b\x00\xe0\x35\x12\x3f\x1e\x02\x00\x00")
 
 func templateDialectGremlinOpenTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -506,7 +509,7 @@ func templateDialectGremlinOpenTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xdf\x6b\xe3\x38\x10\x7e\xb6\xff\x8a\xa1\x14\xce\x0e\xa9\xd2\xdb\xb7\x3b\xe8\x43\xb7\x97\xe5\x02\x4b\xc3\xd1\xa5\xf7\x50\x4a\x50\xa5\x71\x22\xaa\x4a\x46\x92\x5d\x16\xe3\xff\xfd\x90\xe4\x38\x76\x9a\x92\x5e\x7b\x3f\xd8\xa7\x04\xcd\x68\xe6\x9b\xef\xfb\x2c\xa9\x69\x66\x93\xf4\x4a\x97\xdf\x8d\x58\x6f\x1c\x7c\x3a\xff\xf9\x97\xb3\xd2\xa0\x45\xe5\xe0\x0b\x65\xf8\xa0\xf5\x23\x2c\x14\x23\x70\x29\x25\x84\x24\x0b\x3e\x6e\x6a\xe4\x24\xfd\xb6\x11\x16\xac\xae\x0c\x43\x60\x9a\x23\x08\x0b\x52\x30\x54\x16\x39\x54\x8a\xa3\x01\xb7\x41\xb8\x2c\x29\xdb\x20\x7c\x22\xe7\xdb\x28\x14\xba\x52\x3c\x15\x2a\xc4\xbf\x2e\xae\xe6\xd7\x37\x73\x28\x84\x44\xe8\xd6\x8c\xd6\x0e\xb8\x30\xc8\x9c\x36\xdf\x41\x17\xe0\x06\xcd\x9c\x41\x24\xe9\x64\xd6\xb6\x69\xda\x34\xc0\xb1\x10\x0a\xe1\x84\x0b\x2a\x91\xb9\xd9\xda\xe0\x93\x14\x6a\x56\x1a\xe4\x82\x51\x87\x33\xc1\x4f\xe0\xac\x6d\xd3\xa4\xa8\x14\xcb\x1c\x4c\xb8\x95\xe4\x9b\xa1\x35\x1a\x4b\x65\x0e\x4d\x9a\x24\x8e\xfc\x4e\xed\xe2\xb7\x4c\xf0\x3c\x4d\xda\xb4\x69\xce\x00\x15\x87\xbf\xd1\x63\xa6\x4b\xdb\xf5\xf1\xbb\x4f\x75\x09\xbf\x5e\xc0\x29\xb9\x61\xba\x44\xb2\x2c\x07\x21\x6a\xd6\xc3\xd8\xa5\x59\x0f\x82\xd6\x69\x43\xd7\x38\x4c\xb8\xe9\x96\x8e\x0d\xe1\xf7\x8b\xc2\xb7\x26\xb7\xd4\x08\xca\x05\xf3\x13\x24\x49\x52\xfb\x72\x4f\xf4\x11\xb3\xbb\x7b\xa1\x1c\x9a\x82\x32\x6c\xda\x29\x48\x54\x59\xd3\x44\x48\x6d\x9b\xe7\x3e\xb9\xd0\x06\x84\xdf\x60\xa8\x5a\x23\xd4\xa1\x76\x92\xd4\x77\xe2\x1e\x2e\x60\x97\x7d\x27\xee\x7d\xa0\xed\x3a\x77\x7c\xed\xb8\x2c\x49\xd3\x00\xa3\x52\xf6\x43\x91\x65\x79\xe5\xad\xe2\xc9\x69\x5b\xdf\xf8\x25\xdc\x9a\x10\xbf\x0f\xa5\x45\x68\xdb\x5d\x37\xbf\x16\x3a\xe4\xef\x53\xa8\x10\x28\xf9\x50\xa0\x62\x48\xf1\x17\x1f\x7d\x9b\x4b\xb2\xaf\xf4\x01\xe5\x34\x10\x51\x90\x2b\xad\xac\xa3\xca\x41\xdb\x4e\xa1\x24\xf3\x3f\xb2\xfa\x23\x00\xf7\x5d\xf4\x1a\xc8\x63\x16\xfb\xb8\x8b\x94\x76\x41\x9a\x6b\x21\x77\x46\x3a\x4e\xc0\x11\xc9\xeb\x83\x9a\x77\x92\xf7\xf2\x46\x3f\x45\x07\x6c\xbb\x86\xa6\xb1\x75\xfe\x06\x63\x8d\x91\xe5\x7b\x1e\x7d\x87\x3c\xc8\xd7\x38\xdb\xd0\x91\x3a\x23\x7e\xe7\x7c\x4b\x6e\x88\x49\x8f\x34\xc4\x91\x04\xd4\x3d\x9c\x5d\x4e\x3c\xe3\x84\x56\x3e\xef\x64\x59\xb9\x41\x71\xcf\x12\x92\x85\x5d\x28\x2f\x4e\x57\x79\x7f\xdb\x05\x9c\x2c\xd4\x49\x1f\x9b\x4d\x80\xd6\x5a\x70\x60\xc2\xb0\x4a\x52\x03\x1c\x4b\x54\x1c\x99\x40\x0b\xe1\xc8\x4c\x86\xe8\x02\xb8\xae\xc1\x2b\x18\x3d\x47\x6f\x71\xcc\x6c\xe2\x11\x0b\xf7\x93\x05\xaa\xc0\x93\x05\xcf\xc2\x6d\xc0\xa2\x2c\xce\x0c\x16\x68\x50\x31\x9c\x82\xa3\x8f\x18\x0e\x79\xf7\xac\xa1\x46\xe3\x04\x1b\x43\x8b\x73\x7f\x16\x5c\x74\x67\x97\x23\x9f\xb5\xdb\x04\x4d\x23\xea\x81\x9c\xbd\x45\x12\xe7\x3d\x31\x60\xa6\x6d\xe7\xe3\x2d\x2f\xe2\xb7\xd9\x3f\xe8\x0a\x3f\xeb\x7f\xe5\x8c\x53\x11\x15\x5b\x8d\x93\x7a\x23\xbc\xd7\x3d\x07\x2b\x8f\xba\xff\xbf\x16\x1b\x39\x23\x20\xf1\xe6\x32\x58\xc0\x13\x52\x65\x41\x38\xb0\x1b\x5d\x49\x0e\x0f\xfe\x95\x50\x85\xf7\x84\x56\x18\x1f\x10\x08\xfd\x50\x3d\xce\x44\xa8\x29\xe8\xca\x79\xfe\x56\x2b\xb2\x50\xb7\x59\x3e\xf5\xff\x96\x95\x8b\xf6\x08\x97\xe1\x6a\x0a\xe5\xee\x3e\xf4\xe2\xdb\xee\x4e\x2c\x33\xa1\xf2\xee\x9f\xae\x5c\xbe\xbd\x0f\x13\x47\xfe\xdc\xa0\xc1\x2c\xc4\x7c\x41\x13\xff\x26\xb1\xf8\xbe\x37\x63\xb2\x50\xf9\xb4\xcf\x5a\xa8\xc3\x49\xbe\x4d\xcc\x8a\x3f\x87\xbe\x05\xd3\x0d\xe4\xf7\xbf\x94\x74\x6b\xfd\xa3\xb3\x39\x33\x1c\xe8\xd8\xf7\x15\xe1\x39\xf3\x2f\x7d\x69\x54\x8d\x9f\x71\xe6\xf0\x0d\x6d\xec\x6b\x4f\x9d\xf3\xf8\xda\xe9\x2b\xda\x70\xd3\x1c\xe6\x20\x26\x44\x22\xb6\xee\xb8\xc6\xe7\x48\x5b\x99\x45\xa1\x7d\xaf\x0b\xa0\xa5\xb7\x7f\xe6\x8c\x9d\x42\x58\x0f\x37\x96\xe9\xe8\x58\xad\xc8\x65\x8c\x12\x42\xde\xf9\x30\xd0\xe6\xc7\x1c\x7c\x69\x3e\x36\xb7\xd2\xee\x2d\x83\xef\xa1\xec\x40\x0e\x81\x5c\x6b\x97\xb9\x7d\x10\x7f\x05\x00\x00\xff\xff\xc8\x78\xeb\x82\xff\x0c\x00\x00")
+var _templateDialectGremlinPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x56\xdf\x6b\xe3\x38\x10\x7e\xb6\xff\x8a\xa1\x04\xce\x2e\xa9\xd2\xdb\xb7\x3b\xe8\x43\xb7\x97\xe5\x02\x4b\xc3\xd1\xa5\xf7\x50\x4a\x50\xad\x71\x22\xaa\x4a\x46\x1a\xbb\x2c\x46\xff\xfb\x21\xd9\x49\x9c\x34\x25\xbd\xf6\x96\x63\xdf\x8c\xe7\xd7\x37\xf3\x7d\x92\xa6\x6d\x27\xa7\xe9\x95\xa9\xbe\x5b\xb9\x5c\x11\x7c\x3a\xff\xf5\xb7\xb3\xca\xa2\x43\x4d\xf0\x85\x17\xf8\x60\xcc\x23\xcc\x74\xc1\xe0\x52\x29\x88\x4e\x0e\x82\xdd\x36\x28\x58\xfa\x6d\x25\x1d\x38\x53\xdb\x02\xa1\x30\x02\x41\x3a\x50\xb2\x40\xed\x50\x40\xad\x05\x5a\xa0\x15\xc2\x65\xc5\x8b\x15\xc2\x27\x76\xbe\xb6\x42\x69\x6a\x2d\x52\xa9\xa3\xfd\xeb\xec\x6a\x7a\x7d\x33\x85\x52\x2a\x84\xfe\x9f\x35\x86\x40\x48\x8b\x05\x19\xfb\x1d\x4c\x09\x34\x28\x46\x16\x91\xa5\xa7\x13\xef\xd3\xb4\x6d\x41\x60\x29\x35\xc2\x89\x90\x5c\x61\x41\x93\xa5\xc5\x27\x25\xf5\xa4\xb2\x28\x64\xc1\x09\x27\x52\x9c\xc0\x99\xf7\x69\x52\xd6\xba\xc8\x08\x4e\x85\x53\xec\x9b\xe5\x0d\x5a\xc7\x55\x0e\x6d\x9a\x24\xc4\xfe\xe4\x6e\xf6\x47\x26\x45\x9e\x26\x3e\x6d\xdb\x33\x40\x2d\xe0\x5f\xd4\x98\x98\xca\xf5\x75\x42\xf4\xc8\x54\xf0\xfb\x05\x8c\xd8\x4d\x61\x2a\x64\xf3\x6a\x60\xe2\x76\x39\xb4\x5d\xda\xe5\xc0\xe8\xc8\x58\xbe\xc4\xa1\xc3\x4d\xff\xeb\x58\x13\x21\x5e\x96\x30\x32\x15\xbb\xe5\x56\x72\x21\x8b\xd0\x41\x92\x24\x4d\x48\xf7\xc4\x1f\x31\xbb\xbb\x97\x9a\xd0\x96\xbc\xc0\xd6\x8f\x41\xa1\xce\xda\xb6\x83\xe4\x7d\x9e\xa7\x49\x92\x94\xc6\x82\x0c\x01\x96\xeb\x25\x42\x13\x07\x94\x24\xcd\x9d\xbc\x87\x0b\xd8\x7a\xdf\xc9\xfb\x60\xf0\x7d\xe5\x7e\x5e\xdb\x59\x56\xac\x6d\xa1\xe0\x4a\x6d\x9a\x62\xf3\xea\x2a\x48\x25\x0c\xc7\xfb\x50\xf8\x25\xdc\x86\xb1\x10\x87\xca\x21\x78\xbf\xad\x16\xfe\xc5\x0a\xf9\xfb\x18\x2a\x25\xaa\xb5\x10\x42\xf0\xa8\x1c\x8e\xf8\x4b\xb0\xbe\x4d\x25\xd9\x57\xfe\x80\x6a\x1c\x07\x51\xb2\x2b\xa3\x1d\x71\x4d\xe0\xfd\x18\x2a\x36\xfd\x2b\x6b\x3e\x02\x70\x5f\x45\xaf\x81\x3c\x26\xb1\x8f\xab\x48\x1b\x8a\xd4\x5c\x4b\xb5\x15\xd2\xf1\x01\x1c\xa1\xbc\x39\xc8\x79\x4f\xf9\x86\xde\x88\xa1\x57\xc0\xba\x6a\x2c\xda\xcd\x3e\x7f\x83\xb0\x76\x91\xe5\x7b\x1a\x7d\x07\x3d\x28\x96\x38\x59\xf1\x1d\x76\x76\xe6\x3b\x15\xeb\xe1\x46\x9b\x0a\x48\xa3\x1d\x59\x44\xbd\x81\xb3\xf5\xe9\xee\x38\x69\x74\xf0\x3b\x99\xd7\x34\x48\x1e\x4e\x06\xb2\x99\x9b\xe9\x70\xc4\xfb\xcc\xfb\x61\x17\x70\x32\xd3\x7d\x50\x12\x2e\x74\xe0\x8d\x91\x02\x0a\x69\x8b\x5a\x71\x0b\x02\x2b\xd4\x02\x0b\x89\x0e\xe2\x95\x99\x0c\xd1\x45\x70\x7d\x81\x57\x30\xa2\x3e\x7e\x2c\x42\xc6\xc9\x69\xe0\x55\xd2\x2f\x0e\xb8\x86\x30\x2c\x78\x96\xb4\x02\x87\xaa\x3c\xb3\x58\xa2\x45\x5d\xe0\x18\x88\x3f\x62\xbc\xe4\xe9\xd9\x40\x83\x96\x64\xb1\x0b\xad\xeb\xfb\xb3\x14\xb2\xbf\xbb\x88\x7d\x36\xb4\x0a\x97\x45\x8f\xda\xfb\x97\x12\x49\x28\x68\x62\x30\x19\xef\xa7\xbb\x21\x2f\xec\xb7\xd9\x7f\xa8\x8a\xd0\xeb\x80\xbc\x1f\xaa\x8c\x91\xec\x18\x5b\xec\x3a\xcd\xf4\x07\xd5\x73\x30\xf3\x4e\xf5\xff\x57\x62\x3b\xca\x88\x48\x82\xb8\x2c\x96\xf0\x84\x5c\x3b\x90\x04\x6e\x65\x6a\x25\xe0\x01\x81\x6c\x1d\xf7\x09\xa3\xb1\x5b\x20\xb0\xdf\x28\xa4\xd1\x1b\x9c\x89\xd4\x63\x30\x35\x05\xb2\x16\x0b\x36\xd3\xb7\x59\x3e\x86\xc5\x82\xcd\x6b\xea\xe4\x11\x1f\xc3\xc5\x18\xaa\xed\x7b\x18\x16\x0b\xd7\xbf\x89\x55\x26\x75\xde\x7f\x99\x9a\xf2\xf5\x7b\x98\x10\xfb\x7b\x85\x16\xb3\xf0\x9d\x84\x84\xb6\xfb\x8c\xdf\x35\xed\x6b\xb3\x73\x96\x3a\x1f\x6f\xbc\x66\xfa\xb0\x53\x28\xd3\x79\x75\xce\x87\xce\x82\xed\x1b\x6a\xdb\x43\x94\x7a\xff\xc6\xde\xc8\x0e\x1b\x3a\x76\xbe\x3a\x78\x64\x7f\xd0\x49\xe3\x7a\x77\x8d\xb3\x07\x75\x42\xd6\xbd\xb6\xea\x9c\x77\xdb\xce\x26\xa3\x0b\xef\xf4\x2b\x33\xe8\x1c\x62\xc6\x64\xad\x8e\x6b\x7c\xee\x24\x51\x65\x1d\xd1\xa1\xd6\x05\xf0\x2a\xdc\xb0\x19\x59\x37\x86\xf8\x3f\x50\x40\xb6\x1f\xc7\x62\xc1\x2e\x3b\x2b\x63\xec\x9d\x8b\x81\xb1\x3f\x67\xe3\x73\xfb\xb1\xbe\xb5\xa1\xb7\x34\xbe\x87\xb2\x07\x39\x04\x72\x6d\x28\xa3\x7d\x10\xff\x0c\x00\xc8\x78\xeb\x82\xff\x0c\x00\x00")
 
 func templateDialectGremlinPredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -526,7 +529,7 @@ func templateDialectGremlinPredicateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x5f\x4f\x23\xb7\x17\x7d\xce\x7c\x8a\xfb\x5b\x21\x34\x93\x5f\x70\x28\x7d\xea\x22\x2a\x41\x36\xab\x46\x62\xa1\x65\x11\x2f\x55\x55\x19\xfb\xce\xc4\x5a\xc7\x9e\xb5\x3d\xd9\xa0\x68\xbe\x7b\xe5\x3f\x03\x03\x24\x90\xad\xc4\xaa\x4f\x99\x5c\xdf\x7b\xce\xbd\xc7\xc7\xe3\x59\xaf\xc7\xc3\x6c\xa2\xeb\x3b\x23\xaa\xb9\x83\xa3\xc3\x9f\x7e\x39\xa8\x0d\x5a\x54\x0e\x3e\x52\x86\xb7\x5a\x7f\x81\x99\x62\x04\x4e\xa5\x84\x90\x64\xc1\xaf\x9b\x25\x72\x92\x5d\xcf\x85\x05\xab\x1b\xc3\x10\x98\xe6\x08\xc2\x82\x14\x0c\x95\x45\x0e\x8d\xe2\x68\xc0\xcd\x11\x4e\x6b\xca\xe6\x08\x47\xe4\xb0\x5b\x85\x52\x37\x8a\x67\x42\x85\xf5\xf3\xd9\x64\x7a\xf1\x79\x0a\xa5\x90\x08\x29\x66\xb4\x76\xc0\x85\x41\xe6\xb4\xb9\x03\x5d\x82\xeb\x91\x39\x83\x48\xb2\xe1\xb8\x6d\xb3\x6c\xbd\x06\x8e\xa5\x50\x08\xef\xb8\xa0\x12\x99\x1b\x57\x06\x17\x52\xa8\xf1\xd7\x06\xcd\xdd\x3b\x68\x5b\x9f\xb4\x77\xdb\x08\xe9\x5b\x7a\x7f\x02\x35\xb5\x8c\x4a\xd8\x23\x9f\x99\xae\x91\x9c\xa5\x95\x94\x68\x90\xa1\x58\xc6\xcc\xfb\xe7\xfb\x72\xcf\x59\x36\x8a\x41\xfe\x28\xb7\x6d\x61\xd8\x67\x69\xdb\x02\x52\x1f\xa7\x52\xe6\xcc\xad\x80\x69\xe5\x70\xe5\xc8\x24\xfe\x16\x90\xff\xf9\x57\xa8\x21\x17\x74\x81\xd0\xb6\x23\x40\x63\xb4\x29\x60\x9d\x0d\x0c\x5a\xcf\xbf\x9f\x30\xc8\x15\xda\x5a\x2b\x8b\xeb\x36\x1b\x84\xb9\x46\x70\x2b\x14\x17\xaa\x0a\x79\x4f\x7a\x21\xa9\xec\x0f\x9f\x99\x17\xe4\x86\xca\x06\x3f\xd1\x3a\x77\xa6\xc1\x82\xa4\x70\x36\x10\xa5\xa7\xdc\x04\xc0\x8d\x7f\x22\xd3\x15\x32\xdf\xfc\x08\x9e\x90\x8e\xbc\x0f\x8a\xe3\x50\xfe\xbf\x13\x50\x42\xfa\xae\x07\x06\x5d\x63\x94\xff\x1b\x86\xc9\x06\x6d\x36\x58\x52\xe3\xe1\x6b\xd9\x98\x20\xfa\x55\x4f\xb3\x7e\x3c\xa8\xe0\xf5\x7d\xdc\xd6\xa6\x3a\xf2\xd1\xe8\x45\x27\x49\xbe\x73\x27\xdb\xd0\x98\x56\xa5\xa8\x9e\x6e\x68\x0a\x17\x59\x87\xb5\xa5\x7c\xe4\x49\xb2\xef\xb6\xc5\x44\x37\xca\x6d\x31\x86\x50\xee\xed\xcc\x10\x89\x7f\x80\x0b\x0e\x1f\x94\x4f\x11\x83\x96\x5c\x21\xe5\x33\xdf\xc0\xf7\x4b\x36\x5d\x09\xbb\x4d\xb2\x5b\xad\xe5\xdb\x69\xf6\x1b\xb5\x17\xb8\xfa\x21\xaa\x95\x54\x5a\xdc\xaa\xdc\x99\xd6\xf2\xdf\x48\x97\xda\x86\x21\xb7\x92\x5c\x1b\xba\x44\x63\x69\xe0\x5d\xfa\x11\x2a\x72\x13\xa7\x3c\xa7\xb7\x28\x03\x2a\xf9\x9d\xb2\x2f\xb4\xf2\x2f\x26\x12\xa2\x71\xe6\x2d\x42\xf5\x07\x59\xc2\x56\x3d\xc9\x44\x6a\x85\x5e\xbe\x36\x1b\x94\xda\xc0\xdf\x23\xa8\xc3\x8b\x96\xaa\x0a\x9f\x55\xd5\x06\xb9\x60\xd4\xa1\x0d\xc0\x75\xbe\x8c\x95\xa2\x04\x89\xea\xd9\x81\xd5\x86\xa3\x29\xe0\x57\x38\x8c\x7d\x90\x4b\x1f\xf0\x6c\x3b\x70\x85\xe2\x50\x97\x78\x3c\x51\x9b\x0d\xec\x37\xe1\xd8\x1c\xa4\x58\x08\x37\x02\x5d\x96\x16\xdd\xa6\x5d\x4f\x09\xcf\x60\x43\xc1\xb1\x07\x66\xd4\x62\xc4\xe9\xd4\xda\xdf\xef\x00\x63\xe0\x7d\xe8\xfa\xca\xf7\x97\x0f\xe3\xca\x08\xd2\x03\xfc\x1f\x86\xa1\xb8\x48\x48\xaf\x57\x2e\xa8\x9b\x93\x4f\x74\x35\x53\xee\xe7\xa3\x62\x43\x03\xb1\xea\xdc\x47\xf2\x7b\xf0\xa8\x6f\xa3\xc4\xd7\x06\x37\x0d\x1a\x57\x8e\xc3\x0e\xc4\xe7\x02\x4e\x4e\xee\x35\xff\x80\xbc\xa9\xd3\x0e\x27\xf3\x2e\xb3\x70\xb3\xa2\xe2\x10\xaf\xec\xf1\x30\x9e\x89\x71\x4d\xdd\x3c\xdd\xdf\x36\x5c\xfc\x21\x0c\x15\x2a\x34\xd4\x09\xad\xc0\x6f\x5c\xc8\xd2\x25\x50\xa8\xc4\x12\x15\x20\xaf\x90\x40\xb8\xff\x5f\xbb\xfe\x03\x43\xf8\x06\x18\xac\xd7\x07\xb0\x17\x26\xea\x2e\xfe\x29\x0f\xf6\x86\xd0\x90\x67\xf7\xc0\xf0\x0d\x41\x21\x72\x70\x3a\xf4\x51\x19\xea\x30\xac\x86\x36\x9c\x4e\xcc\x11\xaf\xff\xb1\xd0\xc1\xf6\xee\x86\x94\x25\xb8\xff\xa4\xea\xa5\xcc\x42\xc0\xaf\x77\xe7\xe7\xd5\x77\x50\x84\x12\x25\xec\x21\x39\x13\x5c\x84\x6a\x7f\xa5\x25\xf4\xb6\x85\x93\xee\xb4\x93\x33\xed\xe6\xcf\x4e\xb1\xff\x8f\xf1\x2c\x4f\xb4\xb2\x8e\x86\xaa\x04\x8c\xd2\x62\x42\x9f\xd9\x99\xf2\xef\x07\x7c\x91\x62\xa6\xa6\x79\x44\xbc\xbe\xab\x71\x07\x1e\x72\xd9\xb8\x9b\xbc\x4f\xf7\x12\xfc\x65\xe3\xa6\xbb\x4e\x40\x66\xea\x01\x38\x9a\xac\x67\xb7\xbe\xdf\x4a\xa3\x17\xaf\xfb\x8d\x46\x8b\xa5\xc5\x50\xd3\x59\x4f\x69\xbe\xb3\xf5\x7c\x61\xcf\x7a\x61\x8f\xf7\x1e\xf9\xcd\xa3\x79\xbf\x59\x47\x8d\xeb\xf5\xe3\x2b\x1f\xd9\xec\x3f\x65\xdb\x03\xaf\xea\x4e\x6e\x24\x37\xcf\xde\xd1\xb3\x0f\xc5\x83\x3b\xd5\x1b\xd8\x73\x0b\xe7\x5b\xd9\x75\x0b\xdd\xbd\x7d\x77\x19\xf1\x45\xff\xfe\x13\x00\x00\xff\xff\x09\x18\xe3\x6b\xa5\x0d\x00\x00")
+var _templateDialectGremlinQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x56\x41\x6f\xe3\x36\x13\x3d\x5b\xbf\x62\xbe\x45\x10\x48\xfe\x1c\x26\x4d\x4f\xdd\x20\x05\x12\xaf\x17\x35\x90\x4d\xda\x6c\x90\x4b\x51\x14\xb4\x38\x92\x89\xa5\x49\x2d\x49\x79\x1d\x18\xfa\xef\xc5\x50\xb4\x23\x27\x76\xe3\xb4\xc8\xa2\x27\xcb\xc3\x99\xf7\xde\x0c\x1f\x45\x2d\x97\xc7\xfd\x64\x68\xaa\x07\x2b\xcb\xa9\x87\xd3\x93\x1f\x7e\x3a\xaa\x2c\x3a\xd4\x1e\x3e\xf2\x1c\x27\xc6\x7c\x81\xb1\xce\x19\x5c\x28\x05\x21\xc9\x01\xad\xdb\x39\x0a\x96\xdc\x4d\xa5\x03\x67\x6a\x9b\x23\xe4\x46\x20\x48\x07\x4a\xe6\xa8\x1d\x0a\xa8\xb5\x40\x0b\x7e\x8a\x70\x51\xf1\x7c\x8a\x70\xca\x4e\x56\xab\x50\x98\x5a\x8b\x44\xea\xb0\x7e\x35\x1e\x8e\xae\x3f\x8f\xa0\x90\x0a\x21\xc6\xac\x31\x1e\x84\xb4\x98\x7b\x63\x1f\xc0\x14\xe0\x3b\x64\xde\x22\xb2\xa4\x7f\xdc\x34\x49\xb2\x5c\x82\xc0\x42\x6a\x84\x77\x42\x72\x85\xb9\x3f\x2e\x2d\xce\x94\xd4\xc7\x5f\x6b\xb4\x0f\xef\xa0\x69\x28\xe9\x60\x52\x4b\x45\x92\xde\x9f\x43\xc5\x5d\xce\x15\x1c\xb0\xcf\xb9\xa9\x90\x5d\xc6\x95\x98\x68\x31\x47\x39\x6f\x33\xd7\xcf\xeb\x72\xe2\x2c\x6a\x9d\x43\xba\x91\xdb\x34\xd0\xef\xb2\x34\x4d\x06\x51\xc7\x85\x52\x69\xee\x17\x90\x1b\xed\x71\xe1\xd9\xb0\xfd\xcd\x20\xfd\xfd\x8f\x50\xc3\xae\xf9\x0c\xa1\x69\x06\x80\xd6\x1a\x9b\xc1\x32\xe9\x59\x74\xc4\x7f\x18\x31\xd8\x2d\xba\xca\x68\x87\xcb\x26\xe9\x85\xbe\x06\x30\x91\x5a\x48\x5d\x86\xbc\x27\x5a\x58\x2c\xfb\x8d\x32\xd3\x8c\xdd\x73\x55\xe3\x27\x5e\xa5\xde\xd6\x98\xb1\x18\x4e\x7a\xb2\x20\xca\x6d\x00\xc2\xd2\x13\x1b\x2d\x30\x27\xf1\x03\x78\x42\x3a\x20\x1f\x64\x67\xa1\xfc\x7f\xe7\xa0\xa5\x22\xd5\x3d\x8b\xbe\xb6\x9a\xfe\x86\x66\x92\x5e\x93\xf4\xe6\xdc\xc2\x72\x09\x95\xaa\x6d\x18\xfa\xed\x23\xcd\x46\x3c\x4c\x81\x76\x6b\x53\xd6\xb6\x3a\xf6\xd1\x9a\xd9\x6a\x24\xe9\xde\x4a\x76\xa1\xe5\x46\x17\xb2\x7c\xba\xa1\x31\x9c\x25\x2b\xac\x1d\xe5\x03\xe2\x4c\x5e\x6d\x8b\xa1\xa9\xb5\xdf\x61\x0c\xa9\xfd\xdb\x99\xa1\x25\xfe\x0e\x2e\x38\x79\x9c\x7c\x8c\x58\x74\xec\x16\xb9\x18\x6b\x9f\x66\xaf\x1f\xd9\x68\x21\xdd\xae\x91\x4d\x8c\x51\x6f\x37\xb3\x5f\xb8\xbb\xc6\xc5\x77\x99\x5a\xc1\x95\xc3\x9d\x93\xbb\x34\x46\xfd\x93\xd1\x45\xd9\xd0\x17\x4e\xb1\x3b\xcb\xe7\x68\x1d\x0f\xbc\x73\x6a\xbf\x64\xf7\x6d\x97\x57\x7c\x82\x2a\x78\x98\xfd\xca\xf3\x2f\xbc\xa4\x17\x13\x0b\xd1\xb6\xe7\x1d\x83\xea\x36\x32\x87\x9d\xf3\x64\x43\x65\x34\xa6\x59\x38\x8e\x85\xb1\xf0\xe7\x00\x2a\x12\x60\xb9\x2e\xf1\x59\x55\x65\x51\xc8\x9c\x7b\x74\xa4\xb4\x57\xa5\xf3\xb6\x52\x16\xa0\x50\x3f\x6d\x9e\x19\x2b\xd0\x66\xf0\x33\x9c\x84\xf4\x39\xbb\xa1\x00\xb1\xed\xc1\x15\x8a\x43\x5d\xe4\x21\xa2\x26\xe9\xb9\x6f\xd2\xe7\x53\x50\x72\x26\xfd\x00\x4c\x51\x38\xf4\xdb\x76\x3d\x26\x3c\x83\x0d\x05\x67\x04\x9c\x73\x87\x2d\xce\x6a\x5a\x87\x87\x2b\xc0\x36\xf0\x3e\xa8\xbe\xa5\x59\xa4\xfd\x76\x65\x00\xf1\x01\xfe\x0f\xfd\x50\x9c\x45\xa4\x97\x2b\x67\xdc\x4f\xd9\x27\xbe\x18\x6b\xff\xe3\x69\xb6\x45\x40\xcb\x77\x45\xa8\xe9\x1a\xbc\x7d\x01\xd7\x5a\x7e\xad\x71\x5b\xa3\xed\xca\x59\xd8\x81\xf6\x39\x83\xf3\xf3\xf5\xcc\x3f\xa0\xa8\xab\x34\xeb\x9a\x77\x9e\x84\x9b\x15\xb5\xa0\xd7\x7b\x42\x9f\x1d\xed\x7d\x72\x5c\x71\x3f\x8d\xf7\xb7\x0b\x17\x7f\x08\x43\x89\x1a\x2d\xf7\xd2\x68\xa0\x8d\x0b\x59\xa6\x00\x0e\xa5\x9c\xa3\x06\x14\x25\x32\x08\xf7\xff\x4b\xd7\x7f\x60\x08\xdf\x00\xbd\xe5\xf2\x08\x0e\x42\x47\xab\x8b\x7f\x24\x82\xbd\x21\x08\x22\x76\x02\x86\x6f\x08\x1a\x51\x80\x37\x41\x47\x69\xb9\xc7\xa0\x8d\xa0\xc0\x9b\xc8\xdc\xe2\xad\x07\xd3\x81\xed\xdc\x0d\x31\x4b\x0a\xfa\xa4\xea\xa4\x8c\x43\x80\x50\xa2\xda\x6d\x83\xde\x3c\xba\x2d\x94\x2c\xe0\x00\xd9\xa5\x14\x32\xa0\xf7\xa8\x46\x46\x30\x38\x5f\x9d\x76\x76\x69\xfc\xf4\xd9\x29\xa6\xff\xd8\x9e\xe5\xa1\xd1\xce\xf3\x20\x21\x02\xa3\x72\x18\xd1\xc7\x6e\xac\xe9\xfd\x80\x7f\x4b\x31\xd6\xa3\xc0\x80\xec\xee\xa1\xc2\x3d\x78\xd8\x4d\xed\xef\x57\x7d\xa0\x7a\x01\xfe\xa6\xf6\xa3\x7d\x3b\x60\x63\xfd\x08\xdc\x9a\xac\x63\xb7\xae\xdf\x0a\x6b\x66\x2f\xfb\x8d\xb7\x16\x8b\x8b\xa1\x66\x65\x3d\x6d\xc4\xde\xd6\xa3\xc2\x8e\xf5\xc2\x1e\x1f\x6c\xf8\x8d\xd0\xc8\x6f\xce\x73\xeb\x3b\x7a\xa8\x72\xc3\x66\xff\x29\xdb\x1e\xd1\x21\xde\xcb\x8d\xec\xfe\xd9\x3b\x7a\xfc\x21\x7b\x74\xa7\xde\x63\x73\x5f\x6b\xcf\x1d\x9c\x6f\x65\xd7\x1d\x74\x6b\xfb\xea\x7f\xeb\xdf\xbf\x06\x00\x09\x18\xe3\x6b\xa5\x0d\x00\x00")
 
 func templateDialectGremlinQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -546,7 +549,7 @@ func templateDialectGremlinQueryTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x4d\x4f\xdc\x30\x10\x3d\xc7\xbf\x62\x40\xa8\x4a\x56\xa9\x17\xb8\xb5\x55\x0e\x94\x0f\x69\xa5\xb6\x6a\x01\x71\x41\x1c\x8c\x3d\xd9\xb5\xf0\xda\xe9\xd8\x89\x40\x51\xfe\x7b\x65\x27\x4b\x53\x5a\x2a\x4e\xb1\xfd\xde\xbc\x79\x33\xf6\xa4\xef\x97\x0b\x76\xea\x9a\x27\xd2\xeb\x4d\x80\xe3\xc3\xa3\x0f\xef\x1b\x42\x8f\x36\xc0\x85\x90\x78\xef\xdc\x03\xac\xac\xe4\x70\x62\x0c\x24\x92\x87\x88\x53\x87\x8a\xb3\xeb\x8d\xf6\xe0\x5d\x4b\x12\x41\x3a\x85\xa0\x3d\x18\x2d\xd1\x7a\x54\xd0\x5a\x85\x04\x61\x83\x70\xd2\x08\xb9\x41\x38\xe6\x87\x3b\x14\x6a\xd7\x5a\xc5\xb4\x4d\xf8\x97\xd5\xe9\xf9\xb7\xab\x73\xa8\xb5\x41\x98\xce\xc8\xb9\x00\x4a\x13\xca\xe0\xe8\x09\x5c\x0d\x61\x96\x2c\x10\x22\x67\x8b\xe5\x30\x30\xd6\xf7\xa0\xb0\xd6\x16\x61\x5f\x69\x61\x50\x86\xe5\x9a\x70\x6b\xb4\x5d\x7a\x8c\xdb\x7d\x18\x86\xc8\x3a\xb8\x6f\xb5\x89\x9e\x3e\x56\xd0\x08\x2f\x85\x81\x03\x7e\x25\x5d\x83\xfc\xf3\x84\x4c\x44\x42\x89\xba\x1b\x99\xcf\xeb\xe7\xf0\x98\xb4\x6e\xad\x84\xfc\x0f\xee\x30\xc0\x62\x9e\x65\x18\x0a\x98\x8c\x5c\x49\x61\x73\x19\x1e\x41\x3a\x1b\xf0\x31\xf0\xd3\xf1\x5b\x42\x07\xda\x06\xa4\x5a\x48\xec\x87\x02\x90\xc8\x11\xf4\x2c\xeb\x04\x41\xce\xb2\x2c\x90\xe8\x90\xbc\x30\xb0\x50\xde\xf0\xeb\xdd\x96\x65\x19\xa1\x87\x0a\xde\x4d\x29\xf8\x25\xfa\xc6\x59\x8f\xfd\xc0\xb2\x82\x65\xba\x06\x83\xf6\xa5\x43\x5e\x6b\x34\xca\x17\x50\x55\x70\x14\xf3\x44\xde\xbf\x39\xb7\x87\x77\xb0\x57\x25\x90\x7f\x17\xf2\x41\xac\x31\x82\x17\x11\x5c\x9d\xa5\xd8\x99\xbb\xea\x2f\x95\x9d\xaf\x1b\x61\x5a\xf4\xaf\x18\xe1\x9c\x17\x2c\xcb\x06\x40\xe3\xf1\xed\x9a\xab\xb3\x3c\x85\xb1\x79\xe4\xa8\x18\xaf\x6c\x2b\x1e\x30\xbf\xbd\x9b\x75\xb6\xfc\x5f\x33\xa2\x54\xed\x08\x74\x09\x75\xba\x71\x61\xd7\xf8\x4a\x57\x46\x8f\x53\x87\xf4\x1d\x54\x50\x8f\x46\xde\xde\x8b\xaf\xa2\xc9\xe7\xd5\x0f\x2c\xfb\xd9\x22\x3d\x95\x70\xaf\xad\xd2\x76\x9d\x6a\x78\x96\xe3\x3f\x22\x98\x8f\x57\x8a\x94\xde\xe4\x4b\x7d\x45\x71\xc5\xcf\x1f\x51\xc6\x67\x56\xc2\x0b\xc1\x32\xce\x6c\xf1\x29\x85\xef\x55\x60\xb5\x49\x75\x10\x86\x96\x6c\x3c\x4d\x2e\xde\xfa\x64\xa6\x30\x42\xcf\x2f\x51\xa8\x1b\x61\xf2\x6e\xac\xa3\xdb\x96\x3b\x8b\x33\x74\x2c\xf9\x77\x01\xaf\x3b\x98\xb6\xdd\x96\x9f\x61\xfc\x9f\x44\xdd\x34\x91\x68\x55\x1c\xbb\x5f\x01\x00\x00\xff\xff\x5a\x24\x2f\x19\xaf\x04\x00\x00")
+var _templateDialectGremlinSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xcd\x4e\xdc\x30\x10\x3e\xc7\x4f\x31\x20\x54\x25\xab\xd4\x0b\xdc\x4a\x95\x03\xe5\x47\x5a\xa9\xad\x5a\x40\x5c\x10\x07\x63\x4f\x76\x2d\xbc\x76\x3a\x76\x22\x50\xe4\x77\xaf\x9c\xcd\xd2\x14\x4a\xb5\xa7\xb5\xfd\xcd\xf7\x33\xb3\x76\xfa\x7e\x3e\x63\x67\xae\x79\x26\xbd\x5c\x05\x38\x3e\x3c\xfa\xf4\xb1\x21\xf4\x68\x03\x5c\x0a\x89\x0f\xce\x3d\xc2\xc2\x4a\x0e\xa7\xc6\xc0\x50\xe4\x21\xe1\xd4\xa1\xe2\xec\x66\xa5\x3d\x78\xd7\x92\x44\x90\x4e\x21\x68\x0f\x46\x4b\xb4\x1e\x15\xb4\x56\x21\x41\x58\x21\x9c\x36\x42\xae\x10\x8e\xf9\xe1\x16\x85\xda\xb5\x56\x31\x6d\x07\xfc\xeb\xe2\xec\xe2\xfb\xf5\x05\xd4\xda\x20\x8c\x67\xe4\x5c\x00\xa5\x09\x65\x70\xf4\x0c\xae\x86\x30\x31\x0b\x84\xc8\xd9\x6c\x1e\x23\x63\x7d\x0f\x0a\x6b\x6d\x11\xf6\x95\x16\x06\x65\x98\x2f\x09\xd7\x46\xdb\xb9\xc7\xb4\xdd\x87\x18\x53\xd5\xc1\x43\xab\x4d\xca\x74\x52\x41\x23\xbc\x14\x06\x0e\xf8\xb5\x74\x0d\xf2\x2f\x23\x32\x16\x12\x4a\xd4\xdd\xa6\xf2\x65\xfd\x42\x4f\xa6\x75\x6b\x25\xe4\x7f\xd5\xc6\x08\xb3\xa9\x4b\x8c\x05\x8c\x41\xae\xa5\xb0\xb9\x0c\x4f\x20\x9d\x0d\xf8\x14\xf8\xd9\xe6\xb7\x84\x0e\xb4\x0d\x48\xb5\x90\xd8\xc7\x02\x90\xc8\x11\xf4\x2c\xeb\x04\x41\xce\xb2\x2c\x90\xe8\x90\xbc\x30\x30\x53\xde\xf0\x9b\xed\x96\x65\x19\xa1\x87\x0a\x3e\x8c\x16\xfc\x0a\x7d\xe3\xac\xc7\x3e\xb2\xac\x60\x99\xae\xc1\xa0\x7d\x9d\x90\xd7\x1a\x8d\xf2\x05\x54\x15\x1c\x25\x9f\x54\xf7\xef\x9a\xbb\xc3\x7b\xd8\xab\x06\x90\xff\x10\xf2\x51\x2c\x31\x81\x97\x49\x60\x71\x3e\x70\x27\xe9\xaa\x37\x2a\xdb\x5c\xb7\xc2\xb4\xe8\xdf\x09\xc2\x39\x2f\x58\x96\x45\x40\xe3\x71\x77\xcd\xc5\x79\x3e\xd0\xd8\x94\xb9\x89\x9d\xfe\xb2\xb5\x78\xc4\xfc\xee\x7e\x32\xd9\xf2\x7f\xc3\x48\x52\xb5\x23\xd0\x25\xd4\x89\x4e\xc2\x2e\xf1\x8d\xf7\x28\x9f\x9c\x46\xab\x3b\x7d\x0f\x15\xd4\x9b\x20\xbb\xcf\xe2\x9b\x68\xf2\x69\xf7\x91\x65\xbf\x5a\xa4\xe7\x12\x1e\xb4\x55\xda\x2e\x7d\x0a\xf1\x22\xc7\x7f\x26\x30\xf5\xab\xeb\x74\x3f\xe0\xe4\xad\xbe\xa2\xb4\xe2\x17\x4f\x28\xd3\x35\x2b\xe1\x95\x60\x99\xde\x6c\xf1\x79\xa0\xef\x55\x60\xb5\x19\x66\x4d\x18\x5a\xb2\xe9\x74\x48\xb1\xeb\x95\x19\x69\x84\x9e\x5f\xa1\x50\xb7\xc2\xe4\x5d\x31\x28\x74\xeb\x72\x1b\x71\x82\x6e\x5a\xfe\xd3\xc0\xfb\x09\xc6\x6d\xb7\xe6\xe7\x98\xbe\x27\x49\x77\x78\x91\x68\x15\xc4\xc8\x7e\x0f\x00\x5a\x24\x2f\x19\xaf\x04\x00\x00")
 
 func templateDialectGremlinSelectTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -566,7 +569,7 @@ func templateDialectGremlinSelectTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectGremlinUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5f\x73\xdb\x36\x12\x7f\x96\x3e\xc5\x56\xa3\x64\x48\x9f\x0a\x3b\x7d\x3b\xdf\xf8\x66\x12\x5b\x69\x75\xd3\xda\x69\x9c\xeb\x3d\x64\x32\x1e\x98\x5c\x4a\x18\x53\x80\x0a\x80\x8c\x7d\x1e\x7e\xf7\x9b\x05\x40\x12\x94\x64\xc7\x4e\x7a\xed\x4b\x62\x01\xcb\xfd\xf7\xfb\xed\x62\x81\xfb\xfb\xc3\x83\xf1\xa9\xda\xdc\x69\xb1\x5c\x59\xf8\xe1\xe8\xd5\xdf\xbf\xdf\x68\x34\x28\x2d\xbc\xe5\x19\x5e\x2b\x75\x03\x0b\x99\x31\x78\x5d\x96\xe0\x84\x0c\xd0\xbe\xae\x31\x67\xe3\x0f\x2b\x61\xc0\xa8\x4a\x67\x08\x99\xca\x11\x84\x81\x52\x64\x28\x0d\xe6\x50\xc9\x1c\x35\xd8\x15\xc2\xeb\x0d\xcf\x56\x08\x3f\xb0\xa3\x76\x17\x0a\x55\xc9\x7c\x2c\xa4\xdb\xff\x79\x71\x3a\x3f\xbf\x9c\x43\x21\x4a\x84\xb0\xa6\x95\xb2\x90\x0b\x8d\x99\x55\xfa\x0e\x54\x01\x36\x32\x66\x35\x22\x1b\x1f\x1c\x36\xcd\x78\x7c\x7f\x0f\x39\x16\x42\x22\x4c\x72\xc1\x4b\xcc\xec\xe1\x52\xe3\xba\x14\xf2\xb0\xda\xe4\xdc\xe2\x04\x9a\x86\xa4\xa6\xd7\x95\x28\xc9\xa7\xe3\x13\xd8\x70\x93\xf1\x12\xa6\xec\x32\x53\x1b\x64\x6f\xc2\x4e\x10\xd4\x98\xa1\xa8\xbd\x64\xf7\x77\xf7\x79\x10\x5a\x57\x96\x5b\xa1\xa4\x53\xa7\x85\xb4\xd1\x77\x13\xd6\xee\x76\xc6\x95\x44\x92\x5c\x71\x73\x59\x15\x85\xb8\xed\xf5\x4d\x2e\x64\xef\xe3\x7f\x51\x2b\x92\x3b\x82\xa6\xb9\xbf\x07\x51\xf8\x2f\xdd\x0f\xbf\x79\x02\x13\x29\xca\x89\x5f\x42\x99\xd3\x97\xe3\xa2\x92\x19\x24\x03\xdf\x9b\x06\x0e\xe2\xa8\x9b\x26\x85\x90\x98\x4b\x5e\x63\x92\xd9\x5b\xc8\x94\xb4\x78\x6b\xd9\xa9\xff\x3f\x25\x15\xdf\x47\x46\x9d\x02\x76\xce\xd7\xc1\x03\x2c\x0d\xfd\x25\xa4\xed\x6c\xcf\x00\xb5\x56\x3a\x85\xfb\xf1\x48\xa3\x21\xdf\x5f\x06\x33\xec\x3d\x9a\x8d\x92\x06\xef\x9b\xf1\x68\xa8\x78\x3c\x1a\x89\x7c\x06\xea\x86\xe4\x07\xd9\x6c\x1a\xe6\x8c\x2e\xce\xd8\x2f\x61\xed\x47\xb4\xd0\x34\x49\x4a\x1f\x15\xf0\x9d\xba\x21\x5b\xa3\x91\x46\x5b\x69\x09\x5d\x62\xc8\x97\x62\x6d\xd9\x9c\xfc\x29\x92\xc9\x5a\x18\x23\xe4\x12\xe2\x18\xd8\xe2\x0c\x0a\xa5\x21\x30\x83\x54\x92\x2f\xbf\x57\xa8\xef\x66\x70\x2d\x64\x2e\xe4\xd2\xb4\x4e\x45\xb9\x64\x21\xa6\x44\xe4\x29\xfb\x95\xc4\xc9\x1f\x0a\x2a\xe4\xe4\x79\x5a\xb6\x75\x78\x18\x29\x3c\xd4\x7a\xdf\x87\xb9\xa6\xbf\xd8\xfc\x16\x33\x42\x6e\x06\x5b\xc6\x66\x54\x96\xe9\x3f\xdc\xe7\xdf\x9d\x80\x14\xa5\x4b\xd2\xbe\x1c\xa1\xd6\xe3\x51\x67\xac\x05\x41\x98\x53\x25\x8d\xe5\xd2\xba\xfc\x25\x5e\x5d\xc8\xf5\x63\x6a\x76\x80\xa5\x85\xa9\x0b\x62\xca\xde\xf7\x21\xb8\x1d\xda\x68\x1a\x47\x92\x01\xb5\x32\x25\x0b\xb1\x3c\xde\x09\xdb\xaf\x37\x1e\xf9\x38\x35\xb4\xf9\x56\xab\x75\x4b\xb1\x64\x6f\xf8\xad\xe3\x52\x94\xc1\x61\x07\x77\x14\x8e\x76\xb1\x48\x51\x6e\x83\x19\x64\x34\x1a\xf6\x1e\x79\xbe\x90\x76\x0b\xac\x67\x57\x5d\x32\xa8\x67\x91\x43\xcb\xf3\x0f\x77\x1b\x8c\xcb\x39\x85\x83\xdc\x94\xec\x83\xe6\x35\x6a\xc3\x5d\x28\x64\xf8\xb3\xb0\x2b\x60\xe7\xd5\xda\x21\xa5\x39\xf5\x1c\xe7\xab\x25\x05\x59\xbf\x68\xac\xae\x32\xeb\x33\xb0\xd1\x98\x6f\xeb\x3b\x3c\x8c\xa5\x49\x42\x64\xdc\x22\x23\x79\x8b\xc6\xee\x91\x77\xcb\x6b\x6e\xb3\x15\x1a\xe0\x32\x07\x61\x8d\x57\xc2\xa5\x65\x21\xaf\xbd\x52\x47\xfe\x35\xbf\xc1\xe4\xe3\xa7\x83\x7e\x79\x06\x47\x33\x0a\x9b\x51\x94\x43\xea\xd3\xdf\x87\x07\x90\x71\x83\xd4\xed\x7d\x81\x82\xd9\x60\x26\x0a\x91\x41\x8d\xda\xe2\x2d\xb8\x96\xbf\x4b\xb9\x9a\xcc\x2d\xd9\x6f\x54\x9d\x9d\xaa\x25\x4a\xd4\xbc\x6c\x55\x51\xd9\x9f\x3b\x3d\x22\x43\x13\x69\xea\x31\xef\xd4\xa4\xec\x27\x6e\x7e\xe6\xd7\x58\x3a\x74\xd9\x3b\x9e\xdd\xf0\xa5\x6b\x20\x6e\x95\xfa\x06\xe9\xbb\x9a\xc1\xc6\x1d\x12\x5c\x2e\x71\x87\xbc\x5d\x62\x4d\x80\x22\xa9\x43\xc3\x89\x03\xaf\xb9\x86\xc4\x17\x87\x28\x40\xe9\x6d\x84\x93\x12\x25\x4c\xd9\x3c\x5f\xa2\x49\xbd\x9f\x23\x5d\xc3\x09\xd4\xec\xb4\x54\x12\x5d\x5f\x1c\x5d\xc1\x09\xe8\xda\xab\x69\x35\x8f\xac\x36\xf0\xf1\xd3\x10\xcc\xf1\x28\x64\xc8\xfb\x3c\xbd\x9a\xc1\xb4\xf0\xc5\xfa\x56\x60\x99\x9b\xbe\x88\xbd\x3b\x89\x54\x16\xa6\x05\x5b\xac\xa9\x47\x5f\x97\x98\xd2\xaf\x7f\xbb\xa4\x9e\x61\xc1\xab\x32\xb0\x90\x4a\xb4\xe6\x65\x85\x8f\xf5\xf5\x62\xa7\xab\x77\x4d\xa6\x33\x4a\xea\xa5\xf8\xbd\x0a\xa8\x8c\x86\xc4\x3a\x01\xbe\xd9\xa0\xcc\x93\x68\x71\x06\x2f\xfb\x5f\x5e\x97\x67\xfe\x71\x0f\xe7\x7e\x24\x67\xb0\xbd\xec\xbd\x6c\x9b\xa1\x6b\x0f\x2e\xaa\x94\x9d\xaa\x8a\xba\xc0\x2c\xe8\xa7\x92\x38\x86\xab\x2b\xb6\x30\xc9\x86\x9d\xcf\x7f\x4d\x8e\xd2\xb4\xfb\x30\x39\xc7\xcf\x73\xad\x7d\x20\x2e\xb3\xdf\xec\x40\x6b\xb9\x49\xbb\x6c\x75\x50\x8f\x46\x35\x7b\xa7\xd5\x06\xb5\xbd\x4b\x08\xf0\x4b\x21\x97\x25\x3e\x43\x3b\x29\x71\x9a\x7a\x14\xa8\x31\x11\x1b\x51\x8b\xac\x35\xf3\x25\x90\x5f\xe7\x39\xe6\xde\xc8\xa5\x6b\x43\x2e\xf6\x6d\xa4\x1f\x86\x7a\xc4\xf3\xfc\x37\x32\xe0\xea\xaa\xe3\x38\x89\x29\x99\x5c\x5d\x31\xb7\xb9\x8b\xe6\x4e\x5c\xe9\x8c\xb0\xe9\xe0\x08\x29\x64\x97\xd5\x3a\x49\xd9\x39\xde\x5a\x5f\x38\x5f\xcb\xae\x3f\x90\x5e\x6d\xc4\x3b\x0c\xfb\x33\x29\x46\x93\xd3\xa5\x9b\x63\x8b\x64\xf2\xb7\x13\x78\x51\x4f\x3a\xde\x75\x0e\x05\xe6\x6d\x53\xef\x1b\xb8\x77\x75\xf5\xc7\x22\xeb\x1d\xec\x78\xdc\x39\xb9\x7d\xd4\x6c\x1f\x3b\x25\x72\x0d\x6a\x43\x2c\xe6\x25\x14\xae\x13\xb2\xe8\x90\x70\x67\xef\x94\x80\xbe\x68\x85\xfc\x91\xc1\x35\x6c\x7c\xec\x02\xa9\xdb\x0a\x69\x51\x17\x3c\x73\x43\xef\x13\x1a\x6d\x54\x09\x43\xcd\xae\xd4\xf6\xb7\xd0\x61\x61\x9d\x92\xef\x98\x27\x69\x5b\x5b\x91\x3f\x1d\x9d\xfb\xb5\x27\xc0\xf2\x94\x24\x92\x77\x25\xca\x48\x71\x0a\xff\x84\x23\xef\x43\xcd\x2e\x45\x8e\xf3\xa2\xc0\xcc\x12\xb2\xef\x3a\xa1\x48\x9e\x31\x96\xb2\x33\xad\x36\x1e\xb5\x3d\xc0\x44\x99\x43\x9f\x39\x77\x0a\x46\x63\xa6\xbf\x21\x86\x5b\xd8\x64\xe1\xaf\x5b\x61\x4f\xd2\x6c\xd9\x5e\xce\x0a\x98\xbc\x30\xec\x85\x99\x44\xa1\x4f\xd1\xd7\x47\x14\x79\x7f\xf2\x4d\x91\x2d\xcc\x42\xd2\xa1\x89\x11\x52\x91\xc5\x13\x98\x5c\x54\x76\x12\x6f\x3a\x93\xbb\x16\xd1\x77\xd2\x2f\xdb\x1d\x64\xfa\xf0\x00\x34\xae\x55\x8d\x80\x2e\x6a\x4f\xc6\xc8\xbf\xb8\x73\x3e\xc0\x15\x7c\x94\x2b\xc3\xe9\x27\x0c\x34\x22\x1f\x4e\x34\xb1\xca\xf7\xce\x9f\x7c\x9f\xe6\xc5\x99\x89\xb5\xc6\x81\x78\x6f\xdf\x88\x5c\x84\x5c\x59\xbd\xd5\xdc\xdf\x28\xbb\x9a\xbb\xc2\x97\xfe\x42\x90\xfa\x69\xc8\x8d\x1e\x51\xa0\xec\x3f\x2b\xd4\x48\x8c\xba\xd0\xf4\xef\x42\x86\xee\xbb\x38\xa3\xd1\xcf\xf5\x85\x8b\xca\x0e\x16\xd3\xb4\x1b\x89\x02\xdb\xd8\xc2\xa2\xe6\xd6\x4f\x4e\x5d\x0e\xf6\x63\xbe\xe3\xea\x42\x3e\xd3\x51\xbb\x42\x3d\x74\xe8\x69\xfe\x3c\x60\xff\xa2\xb2\x7f\x82\x03\x5d\x6f\xa7\x11\xb2\x6b\x22\x56\x9b\x19\x58\xdd\xde\x9a\x03\x49\xc3\x7c\x3d\x20\xe9\x13\xb8\xf4\x65\x12\xed\x47\xa4\xa6\x11\x63\x98\x02\x77\x13\x4c\xc2\xfc\x9f\x7a\x56\xec\xa6\x72\xdf\x87\x1f\x54\xff\x99\x27\xce\xc3\x04\xfe\x89\x9b\xed\x8b\xd7\x7e\x7a\x7f\xd5\x40\xe1\xc7\x89\xad\x9a\x18\x3a\x3b\x9c\x0e\x9e\x31\x1b\x50\xd7\x7c\x6c\x34\x08\x16\x08\xaf\xf6\xac\x0f\x67\xc0\xd7\x47\xb2\x64\xf3\xed\x6b\x54\x17\xc8\x57\x55\xf1\x5f\x10\xfe\x16\x81\xfe\x4f\xd9\xa0\x1f\xfd\xa9\xd2\x34\x83\xb8\xff\xaa\xa8\xf7\x1e\xf9\x3b\x47\x74\x74\x0b\xaf\xfd\xf8\xf6\x0b\xdf\x24\x56\x57\x98\xf6\xaf\x85\x75\x1b\x43\x74\x35\x7d\xf4\x39\x23\x4c\x16\x51\x62\xa3\xd1\x22\x34\x9d\x35\xbf\x41\x30\x95\x46\xf7\x5a\x6c\xbb\xa7\x8a\x5c\xa1\x71\xcd\x30\x53\xd2\x72\x21\x61\xad\x9c\x0c\x97\x40\x7e\x86\x67\x04\x51\xc0\x67\x84\x15\xaf\x07\xcf\x26\xa1\x77\xb5\x45\xed\x5a\x6a\xf7\xc4\xf0\xad\x55\xfd\x08\x8c\x3f\x7e\x48\x5e\xc5\x28\xbe\xec\x13\xe2\xde\xe2\xee\xd7\x66\x79\x0c\x93\xd0\x67\xfb\x58\x43\x88\x66\x6f\x8c\x93\xe6\x61\x50\x47\x35\x9c\x44\x81\x9b\x8f\x47\x9f\xdc\x63\x05\x3b\x55\xbc\x44\x93\x61\xb2\xb5\x49\xfe\xce\xc0\xbd\x5e\xb4\xad\x3d\xd3\x7d\x6b\x8f\xa5\x5f\x1d\x7f\x0a\x73\xa8\x33\xa2\xb7\x15\xeb\x81\xb2\x3d\xac\xda\x3d\x71\x48\x34\x3c\xc7\xd1\xed\xe2\x5f\x4a\x48\xda\xa0\xf9\x71\xec\x5e\xcd\xc3\xa7\xff\x0b\x00\x00\xff\xff\xc6\xcb\x74\xa4\xce\x18\x00\x00")
+var _templateDialectGremlinUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\xdd\x6f\xdb\x38\x12\x7f\x96\xff\x8a\x59\xc3\x2d\xa4\x9c\x97\x49\xf7\xed\x52\xf8\x80\x36\x49\x77\x7d\x68\x93\x6e\xd3\xdb\x7b\x28\x8a\x80\x91\x46\x36\x51\x99\xd4\x92\x94\x9b\x9c\xa1\xff\xfd\x30\x14\x25\x51\xb6\x93\x26\xe9\x7e\xbc\xb4\x32\x3f\x86\xf3\x9b\xf9\xcd\x07\x99\xcd\xe6\xf0\x60\x74\xa2\xca\x5b\x2d\x16\x4b\x0b\x3f\x1d\xbd\xf8\xe7\x8f\xa5\x46\x83\xd2\xc2\x1b\x9e\xe2\xb5\x52\x5f\x60\x2e\x53\x06\xaf\x8a\x02\xdc\x22\x03\x34\xaf\xd7\x98\xb1\xd1\xc7\xa5\x30\x60\x54\xa5\x53\x84\x54\x65\x08\xc2\x40\x21\x52\x94\x06\x33\xa8\x64\x86\x1a\xec\x12\xe1\x55\xc9\xd3\x25\xc2\x4f\xec\xa8\x9d\x85\x5c\x55\x32\x1b\x09\xe9\xe6\xdf\xce\x4f\xce\xce\x2f\xcf\x20\x17\x05\x82\x1f\xd3\x4a\x59\xc8\x84\xc6\xd4\x2a\x7d\x0b\x2a\x07\x1b\x1c\x66\x35\x22\x1b\x1d\x1c\xd6\xf5\x68\xb4\xd9\x40\x86\xb9\x90\x08\xe3\x4c\xf0\x02\x53\x7b\xb8\xd0\xb8\x2a\x84\x3c\xac\xca\x8c\x5b\x1c\x43\x5d\xd3\xaa\xc9\x75\x25\x0a\xd2\xe9\x78\x06\x25\x37\x29\x2f\x60\xc2\x2e\x53\x55\x22\x7b\xed\x67\xfc\x42\x8d\x29\x8a\x75\xb3\xb2\xfb\x9e\x5c\x0f\x17\xad\x2a\xcb\xad\x50\x92\x16\x95\x5a\x48\x1b\xec\x1b\xb3\x76\xb6\x3b\x5c\x49\xa4\x95\x4b\x6e\x2e\xab\x3c\x17\x37\xbd\x3a\xe3\x0b\xd9\xeb\xf8\x3f\xd4\x8a\xd6\x1d\x41\x5d\x6f\x36\x20\xf2\x66\xa7\xfb\xd1\x4c\xce\x60\x2c\x45\x41\x1b\x36\x1b\x40\x99\xd1\xce\x51\x5e\xc9\x14\xe2\x81\xee\x75\x0d\x07\x21\xea\xba\x4e\xc0\x1b\xe6\x92\xaf\x31\x4e\xed\x0d\xa4\x4a\x5a\xbc\xb1\xec\xa4\xf9\x3f\x21\x11\x3f\x06\x87\x3a\x01\xec\x9c\xaf\xbc\x06\x58\x18\xfa\x12\xd2\x76\x67\x4f\x01\xb5\x56\x3a\x81\xcd\x28\xa2\xcd\x9a\xcb\x05\xc2\x24\x27\x10\x13\xf6\x46\x60\x91\x19\x52\x31\x8a\x5a\xd1\x39\x7b\x87\x7a\x81\xfc\xba\x20\x59\xa3\x28\x8a\x44\x0e\x57\x53\x50\x5f\x68\xcf\xc0\xb4\x75\xdd\xac\xcd\x68\x34\x67\x97\x56\x57\xa9\x75\x32\xa1\xae\xe3\xe4\x25\xed\xd9\x90\x84\x48\xa3\xad\xb4\x84\xce\x4a\x9d\x62\x86\x9d\xe3\xd7\x78\xbc\xd9\xc0\x35\x37\x08\x13\xc2\x9a\x8b\x05\x7b\xcf\xd3\x2f\x7c\x41\x1a\x1c\xc3\x0a\xf5\x42\xc8\x85\x23\x1e\x49\xc8\x5b\xc8\x90\xbb\xb3\x84\x01\xa9\x2c\x98\xaa\x2c\x95\xb6\x98\xc1\xf5\x6d\x6b\xca\x71\x42\xc7\x13\xbc\x00\xdf\xe9\xad\xe4\x2b\x91\x7a\x74\xf7\xc2\xdb\x07\xec\x3d\xb7\x4b\x33\x40\xf7\x7d\xf0\x0c\x5a\x4b\xf0\x4a\x92\xdb\x84\xd2\x13\x71\xf6\x40\x3d\xf1\x86\x3f\xc2\x6f\x8d\x86\xdc\xf9\xdc\xef\x67\x1f\xd0\x94\x4a\x1a\xdc\xf8\x75\x3d\xc9\x46\x51\x24\xb2\xfb\xec\xc3\xe6\xa7\xec\x9d\x1f\xfb\x19\xad\x73\x3d\x6d\xca\xe1\x87\xd6\x42\xfb\xec\x93\xaf\x2c\x3b\x23\x1b\xe5\xf1\x78\x25\x8c\x21\x13\x84\x7c\x66\xf3\x53\xc8\x95\x06\x9f\x25\x08\x20\xc1\xfb\xbd\x42\x7d\x3b\x85\x6b\x21\x33\x21\x17\xa6\x55\x2a\x88\x2b\xe6\x31\xc5\x22\x4b\xd8\xaf\xb4\x3c\x4e\x3c\xf8\x26\x3e\x1e\x27\x65\x5b\x86\x37\xa0\xc8\xc9\xc3\xfb\x36\x66\x9a\xbe\xd8\xd9\x0d\xa6\x14\xc5\x53\xd8\x3a\x6c\x4a\x29\x3a\x79\xe9\xb6\xff\x30\x03\x29\x0a\x67\xa4\x3b\x38\x34\x8a\xba\xc3\x5a\x27\x08\x73\xa2\xa4\xb1\x5c\x5a\x67\xbf\xb8\x11\xa7\xbe\x7c\x53\xcc\xae\x63\x73\x28\x50\x6e\x27\x26\x56\x6a\xcc\x44\xca\x2d\x9a\x04\xfe\x05\x47\x4e\x6e\xb4\x5e\xf1\x72\xda\x62\xd6\x68\xd8\x07\xe4\xd9\x6f\xbc\xa8\xf0\x1d\x2f\xc9\xc2\x91\x57\x33\x04\xd5\xe9\x23\x45\xe1\xf5\xf0\xe1\xe8\x8f\x26\xa9\x09\xcc\x66\x70\xb4\x67\xfd\xf3\x73\x65\xdf\x50\x29\x72\x38\x37\xce\x36\x41\xe8\xb0\xb7\xfc\x1a\x8b\xba\x15\xd9\xd2\x7d\xe2\x34\x9c\xb0\x0f\x3d\x22\x37\x03\x13\xfa\xa4\xb9\xe7\x21\xcf\x36\xa9\x0b\xc9\xe3\x1d\x3f\x36\xe3\xde\x4c\xa1\xaf\xe9\xe8\x37\x5a\xad\xda\x98\x89\xf7\xfa\x73\x0f\xf2\x7a\xe8\x1f\x3a\x65\x4a\x48\xb7\xd9\xe9\xd7\xb4\x46\x9e\x4b\xbb\xc5\xbe\x47\x97\x94\x78\x50\xac\x44\x06\x6d\xe0\x7e\xbc\x2d\xdb\xe2\xe1\x88\x9d\xc0\x41\x66\x0a\xf6\x51\xf3\x35\x6a\xc3\x8b\xb6\x6e\x7c\x15\x76\x09\xec\xbc\x5a\x39\xea\x69\x4e\x05\xd5\xd9\xd5\x92\x80\xb4\x1f\x34\xae\x08\xd0\xb6\x28\x22\x1e\x6d\xcb\x3b\x3c\x0c\x57\x77\x4c\x63\xb4\xde\xa2\xb1\x7b\xd6\xbb\xe1\x15\xb7\xe9\x12\x0d\x70\x99\x81\xb0\xa6\x11\xc2\xa5\x65\xde\xae\xbd\x50\x97\x13\x56\xfc\x0b\xc6\x9f\x3e\x1f\xf4\xc3\x53\x38\x9a\x12\x6c\x46\x28\x07\xd6\x74\xdf\x87\x07\x90\x52\x05\x52\xb9\xcf\x38\x60\x4a\x4c\x45\x2e\x52\x58\xa3\xb6\x78\x03\xae\x9f\xd9\x4d\x8e\x6b\x3a\x6e\xc1\x7e\xa3\x74\xd3\x89\x5a\xa0\x44\xcd\x8b\x56\x14\xe5\xb1\x73\x27\x47\xa4\x68\x02\x49\xbd\xcf\x3b\x31\x09\xfb\x85\x1b\xc7\xec\x78\x2f\xdf\xb7\x74\x27\xd1\x57\x53\x28\x69\x7b\x53\xdc\xef\x8e\x66\xe7\x95\x32\x5e\x27\x61\x36\xa0\xd2\xd9\xa3\xa1\xb0\x14\x2b\x61\xf7\x25\x36\x37\xf1\xd2\xcf\x87\x4c\x5f\xb3\xb7\x34\x16\x1f\xb8\x29\x9f\xa9\x43\x25\xd7\x5c\x43\xdc\x04\xa1\xc8\x41\xe9\x6d\x26\xc5\x05\x4a\x98\xb0\xb3\x6c\x41\x29\x87\x76\x44\x91\x5e\xc3\x0c\xd6\xec\xa4\x50\x12\x89\xfe\x51\x74\x05\x33\xd0\xeb\x46\x4c\x2b\x39\xb2\xda\xc0\xa7\xcf\x43\xd2\x8c\xa2\x64\xd0\xed\x5c\x4d\xef\xeb\x78\x94\x86\xd8\x19\x21\x67\xf3\x15\x15\xb7\xeb\x02\x13\xaa\xbe\xff\x71\xce\x3b\xc5\x9c\x57\x85\x67\x3b\xa5\xb8\x35\xe5\xbc\xfb\x0a\x62\xbe\x53\x0e\x83\x5e\xc1\x1f\x4a\xe2\xa5\xf8\xbd\xf2\x66\x8f\x86\x04\x9e\x01\x2f\x4b\x94\x59\x1c\x0c\x4e\xe1\x79\xff\xcb\xf7\x1d\x2e\xc2\x8e\x7b\xda\xec\x67\xcc\x74\x27\x71\xd2\xef\x9c\xb5\x55\xc4\xa5\x21\x87\x2a\x61\x27\xaa\xa2\x6c\x33\xf5\xf2\x29\xf4\x8e\xe1\xea\x8a\xcd\x4d\x5c\xb2\xf3\xb3\x5f\xe3\xa3\x24\xe9\x36\xc6\xe7\xf8\xf5\x4c\xeb\x06\x88\x6b\x8f\xbe\x5b\x81\xf6\xe4\x3a\xe9\xac\xd5\xb9\x9a\x88\xf6\x5e\xab\x12\xb5\xbd\x8d\xc9\xe1\x97\x42\x2e\x0a\x7c\x84\xf4\xae\xfa\xf4\x5e\xa0\x04\x48\x6c\x44\x3d\xe8\x08\xef\x75\xf2\xab\x2c\x7b\x40\xcf\x7b\xb7\xab\x23\x9e\x35\x95\x93\x18\xa4\x3b\x8e\xd3\x32\x25\xe3\xab\x2b\xe6\x26\x4d\xfc\x4d\x5c\xc9\x94\x7c\xd3\x0e\xc4\xde\x84\xec\xb2\x5a\xc5\x09\x3b\xc7\x1b\x57\x37\x9e\xce\xae\x3f\x90\x5e\x2d\xe2\x1d\x86\xfd\x95\x14\xa3\x96\xf3\xd2\x5d\x06\xf3\x78\xfc\x8f\x19\x3c\x5b\x8f\x3b\xde\x75\x0a\x79\xe6\x6d\x53\xef\x3b\xb8\x77\x75\xf5\xc7\x7a\xf6\xb1\xbd\x7e\x5b\xde\x0a\xe4\x1a\x54\x49\xa9\x8a\x17\xcd\xdd\xc9\xb0\xa0\x18\xb9\x1a\x3f\x21\x47\x5f\xb4\x8b\x68\xbb\xcb\xde\x65\x83\x5d\x20\x65\x5b\x21\x2d\xea\x9c\xa7\xee\xb6\xf0\x80\x44\x1b\x44\xc2\x50\xb2\x0b\xb5\x87\xdc\xb9\x4e\x48\x77\xcc\xe2\xa4\x8d\xad\x40\x9f\x8e\xce\xfd\xd8\x03\xdc\xf2\x10\x23\xb6\xfd\x71\x2f\x38\xec\x87\xd9\xa5\xc8\xf0\x2c\xcf\x31\xb5\xe4\x59\xcf\x0e\x81\x26\x58\xcf\x18\x4b\xd8\xa9\x56\x65\xe3\xb5\x7a\x34\x90\xbf\x65\x39\x6c\x2c\xe7\xaa\x60\xaf\xcc\xa4\x79\x66\xf1\x4f\x19\xe3\xb9\x1c\x07\x73\x92\x7a\xd8\xf6\x85\x23\x87\xf1\x33\xc3\x9e\x99\x71\x00\x7d\x82\x4d\x7c\x04\xc8\xfd\x5e\x4a\x7f\xc8\xe6\x66\x2e\xa9\x68\xb6\xc9\x69\xeb\xc4\x19\x8c\x2f\x2a\xeb\x4f\x0c\x8e\xdc\x3d\x11\x9b\x4c\xfa\xed\x73\x3b\xe3\x7a\x5a\x6a\x5c\xa9\x35\x02\x3a\xd4\x07\x87\x5b\xfa\x85\x99\xf3\x0e\xae\xe0\xbd\x5c\x19\x76\x59\xbe\x5b\x12\xd9\xb0\x5d\x0a\x45\x7e\x70\xfa\x64\xfb\x24\xcf\x4f\x4d\x28\x35\x04\xd2\x58\xf3\xb5\xc8\x84\xb7\x95\xd5\x5b\xc9\xfd\xb5\xb2\xcb\x33\x17\xf8\xce\x82\x75\x9d\x34\x3d\xb9\x6b\x3d\x02\xa0\xec\xbf\x4b\xd4\x48\x8c\xba\xd0\xf4\xef\x5c\xfa\xec\x3b\x3f\xa5\x16\xd3\x65\xfc\x8b\xca\x0e\x06\x93\xa4\x6b\x89\x3c\xdb\xd8\xdc\xa2\xe6\xb6\xe9\x9c\x3a\x1b\xec\xf7\xf9\x8e\xaa\x73\xf9\x48\x45\xed\x12\xf5\x50\xa1\x87\xe9\x73\xc7\xf9\x17\x95\xfd\x0b\x14\x68\xdd\xe7\x5a\xc8\x2e\x89\x58\x6d\xa6\x60\xb5\x8f\xd6\x36\x77\xfa\x3e\x7e\x40\xd2\x07\x70\xe9\xdb\x24\xda\xef\x91\x35\x7b\x95\x65\x43\x13\xb8\x1b\x67\xec\xef\x19\x49\xc3\x8a\x5d\x53\xee\xdb\xf8\x51\xf5\xdb\x1a\xe2\x6c\x5b\xa0\x57\xe4\x17\x6e\xb6\x2f\x78\xfb\xe9\xfd\xa4\x86\xa2\x69\x27\x02\x47\x53\x4c\x0c\x95\x1d\x76\x07\x8f\xe8\x0d\x28\x6b\xde\xd7\x1a\xf8\x13\xa6\x40\xe6\x9b\x8e\x82\x4a\xff\x74\x24\x0b\x76\xb6\x7d\x5d\xeb\x80\x3c\x29\x8a\xff\x06\xf8\x5b\x04\xfa\x93\xac\xb1\xd9\x84\x55\xa5\xae\x07\xb8\xff\x2e\xd4\x21\xfd\xbb\x1f\x3b\x25\x3a\xb8\xed\xaf\x59\xf7\xde\x65\x75\x85\x49\xff\xe4\xbe\x6e\x31\x74\x69\xe7\x1b\xcf\x26\xbe\xb3\x08\x0c\x1b\xb4\x16\x3e\xe9\xd0\x1b\x06\x98\x4a\xa3\x7b\xf9\xb6\xdd\x93\x48\xa6\xb0\x79\xf1\xa6\xbf\x0f\x70\x21\x61\xa5\xdc\x1a\x2e\x81\xee\xf1\xfe\xb9\x42\xe4\xf0\x15\x61\xc9\xd7\x83\xe7\x99\x83\xc3\x41\x50\x0f\x2f\xff\xdf\x1b\xd5\xf7\xb8\xf1\xe7\x8f\xf1\x8b\xd0\x8b\xcf\x7b\x83\x34\x8f\x7b\x2b\xb3\x38\x86\xb1\xcf\xb3\x3d\x56\x0f\xd1\xec\xc5\x38\xae\xef\x76\x6a\xb4\x86\x59\x00\xdc\x7c\x3a\xfa\xec\xde\x35\xd9\x89\xe2\x05\x9a\x14\x43\x58\x34\x49\xb9\x66\x0a\xf4\x36\xd2\xa5\xf6\x54\xf7\xa9\x3d\x5c\xfd\xe2\xf8\xb3\xef\x43\xdd\x21\x7a\x5b\xb0\x1e\x08\xdb\xc3\xaa\xdd\x8a\x43\xe7\xfa\x67\x3f\xba\x5d\xfc\x5b\x09\x49\x13\xd4\x3f\x8e\xdc\x9f\x9e\x50\x66\x50\xd7\xa3\xff\x0f\x00\x96\x3b\x04\x0f\x13\x1c\x00\x00")
 
 func templateDialectGremlinUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -581,12 +584,12 @@ func templateDialectGremlinUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/gremlin/update.tmpl", size: 6350, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/gremlin/update.tmpl", size: 7187, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcf\x8b\xdb\x3e\x10\xc5\xcf\xd6\x5f\xf1\x30\x7b\xb0\xc3\xc6\xde\xef\xde\xbe\x85\x1e\xd2\xb0\x81\x85\xfe\x38\xa4\xd0\x63\xf1\x4a\x23\x47\xd4\x95\x1c\x49\xde\x62\x84\xfe\xf7\x22\x79\x9b\xa6\x69\x1b\x7a\x33\x7a\x33\xf3\x79\x6f\xc6\x21\xb4\x2b\xb6\x35\xe3\x6c\x55\x7f\xf0\xb8\xbf\xfb\xef\xff\xf5\x68\xc9\x91\xf6\xd8\x75\x9c\x9e\x8c\xf9\x82\x47\xcd\x1b\x6c\x86\x01\xb9\xc8\x21\xe9\xf6\x99\x44\xc3\x3e\x1e\x94\x83\x33\x93\xe5\x04\x6e\x04\x41\x39\x0c\x8a\x93\x76\x24\x30\x69\x41\x16\xfe\x40\xd8\x8c\x1d\x3f\x10\xee\x9b\xbb\x1f\x2a\xa4\x99\xb4\x60\x4a\x67\xfd\xed\xe3\xf6\xe1\xfd\xfe\x01\x52\x0d\x84\x97\x37\x6b\x8c\x87\x50\x96\xb8\x37\x76\x86\x91\xf0\x67\x30\x6f\x89\x1a\xb6\x6a\x63\x64\x2c\x04\x08\x92\x4a\x13\x4a\xa1\xba\x81\xb8\x6f\xdd\x71\x68\x8d\x15\x64\x4b\xac\x63\x64\x45\x08\x6b\xdc\x48\xbc\x7a\x8d\x9b\x66\xcf\xcd\x48\xcd\x6e\xd2\x7c\xd1\xe4\xa4\x79\xe5\xb0\x72\xc7\xa1\xd9\xd3\x90\x79\x35\x02\x2b\x0a\x69\x2c\x3e\xdf\x22\xf7\xd9\x4e\xf7\x04\xa9\x68\x10\x2e\x8b\x85\x6b\x3e\x24\xc2\x9b\xb9\x4a\x9d\x21\x24\x40\x8c\x95\xac\x6b\x56\x14\x91\x15\x91\x25\x2a\x69\x81\xc5\x64\xbb\x02\x9f\x9c\x37\x5f\xe1\x54\xaf\x3b\x3f\xd9\xb4\x05\x8b\xde\x9a\x69\x5c\x3f\xcd\x48\x46\xbc\x32\x1a\x39\xd6\x5f\x52\xe5\xea\xf6\x34\xe1\x25\x9f\x9f\x47\xc2\xa6\xef\x2d\xf5\x9d\xa7\x9c\x2d\xc7\xba\x08\xe5\xbc\x55\xba\xbf\xf0\x75\x05\xd3\xb9\xf2\xda\x8e\x96\x71\xcb\x36\x2c\xf9\xc9\x6a\xa4\x82\x8d\xab\xa4\xae\x5c\x7d\x9b\x20\xf5\xef\x8b\xb8\x02\x4c\x9c\x5f\x4e\xa6\xff\x7c\xb3\x2c\x7e\x53\xfe\xb0\x4b\x07\x39\xaf\xf9\x74\x7a\xfc\x37\xe3\x67\xbe\x43\x80\x92\xa0\x63\xc6\x96\xef\xa8\xd3\x25\x62\xdc\x3c\xf7\x21\x80\x06\x47\x88\x31\x5f\x59\x2f\x1f\x4b\xa0\x6a\xe9\x3a\x33\x13\xa3\x6b\xb6\x55\xfe\x53\xea\x9f\x9d\xe5\xaa\x3c\xf5\x5c\xec\xe4\x7b\x00\x00\x00\xff\xff\x83\x86\x1e\x93\x84\x03\x00\x00")
+var _templateDialectSqlByTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x55\xc1\x6e\xe3\x36\x10\x3d\x4b\x5f\x31\x2b\x78\x01\xc9\x70\xa8\xec\xde\xea\x22\x07\xd7\x9b\x00\x01\xd2\x64\x8b\x14\xdb\x63\x41\x8b\x43\x99\x58\x85\x54\x38\x94\x53\x43\xd0\xbf\x17\x24\x25\xc7\xb5\x5b\xa3\xd8\x43\x00\x8b\xc3\x79\xef\xf1\x71\xf8\xd2\xf7\xe5\x3c\x5d\x9b\x76\x6f\x55\xbd\x75\xf0\xf9\xfa\xd3\x4f\x57\xad\x45\x42\xed\xe0\x8e\x57\xb8\x31\xe6\x3b\xdc\xeb\x8a\xc1\xaa\x69\x20\x6c\x22\xf0\x75\xbb\x43\xc1\xd2\xdf\xb7\x8a\x80\x4c\x67\x2b\x84\xca\x08\x04\x45\xd0\xa8\x0a\x35\xa1\x80\x4e\x0b\xb4\xe0\xb6\x08\xab\x96\x57\x5b\x84\xcf\xec\x7a\xaa\x82\x34\x9d\x16\xa9\xd2\xa1\xfe\x70\xbf\xbe\x7d\x7c\xbe\x05\xa9\x1a\x84\x71\xcd\x1a\xe3\x40\x28\x8b\x95\x33\x76\x0f\x46\x82\x3b\x22\x73\x16\x91\xa5\xf3\x72\x18\xd2\xb4\xef\x41\xa0\x54\x1a\x21\x13\x8a\x37\x58\xb9\x92\x5e\x9b\xd2\x58\x81\x36\x83\xab\x61\x48\x93\xbe\xbf\x82\x99\x84\xe5\x0d\xcc\xd8\x73\x65\x5a\x64\x77\x9d\xae\x62\x4d\x76\xba\xca\x09\xe6\xf4\xda\xb0\x67\xf4\xed\xc6\x16\xd0\xa7\x49\x22\x8d\x85\x3f\x17\x10\xfa\x2c\xd7\x35\x82\x54\xd8\x08\x0a\xc5\x84\xd8\x93\x67\xf8\x65\x9f\xfb\xce\xbe\xf7\x04\xc3\x90\xcb\xa2\x48\x93\x64\x48\x93\x21\xf5\xac\xa8\x05\x44\x91\xe5\x1c\x82\xa4\x52\x28\x72\x5c\x57\x08\x35\x6a\xb4\xdc\x21\x85\x03\x8f\x68\x5f\xa6\xaa\x69\x9d\x32\x7a\x01\x4a\x82\xd1\x18\x0d\x40\x70\xfb\x16\x09\xb6\x9c\x60\x17\x94\x8e\x92\x18\x04\x2f\x2e\x59\x71\xe0\x3d\xf6\x64\x04\x59\xde\x80\xe4\x0d\x21\x4c\x85\x78\xdc\x99\xf6\x47\x9f\xb1\x47\x23\x90\x60\x18\xfa\x7e\x2a\x04\x4f\x66\x9a\xdd\x05\xf6\x58\x52\x12\x66\x92\xdd\xd3\xb7\x88\x19\xd6\x26\x82\x1b\x70\xb6\xf3\xf0\x7d\x3f\x5a\x72\xfe\x23\x5e\x93\x92\x87\x26\xbf\x54\x96\xe7\xc6\xf8\xef\xe8\x99\x45\xea\x1a\x47\xb0\xd9\x87\xcf\x83\xb3\x1b\x74\x6f\x88\x71\x90\x46\x30\x72\xc6\xa2\x18\xa7\x2b\xe0\xd6\x6a\x87\x3a\xfa\x07\x5c\x0b\xd8\x41\xee\xf7\x6b\xe4\x16\xc9\x81\x54\x96\x5c\xb1\x80\x8e\x94\xae\x03\x52\x6c\x68\xeb\x11\xd1\xb4\xfe\xf6\x8c\x65\x70\x67\x2c\xe0\x5f\xfc\xa5\x6d\x70\xe9\xa1\xfd\x5f\x12\x64\xe7\x7d\x0f\x1b\x4e\x08\x33\xb6\x36\x5a\xaa\x9a\x7d\xe5\xd5\x77\x5e\x7b\x2b\xd8\xc9\xc1\xf2\x20\x65\x01\xbb\x05\xf8\x89\x7a\x6a\xd7\x86\x94\xc6\xa9\x5c\x14\x2c\xe0\x3e\xa8\x17\xe5\xf2\x4f\xd7\xc5\xc4\x04\x8f\xc6\x21\xb8\x2d\x77\xff\x9c\x09\xe0\x16\x81\xba\xb6\x35\xd6\xa1\x00\xa3\x9b\xbd\x37\xea\xab\x21\x57\x5b\x7c\xfe\xed\x01\xf2\xe9\x2c\x1e\xda\xbf\x83\x53\xaf\xa3\x24\x20\x67\x95\xae\x17\xb0\x0b\xc2\xe2\xfd\x2e\xc0\xb4\x47\x9f\x4f\x6d\x11\x9b\xc3\xc3\xf2\x0f\xc4\xa2\xeb\xac\x86\xff\x7e\x5e\x09\xbd\x29\x57\x6d\x41\xf8\x59\x22\xf6\x25\x0e\x6c\x5e\xfc\x1c\xdf\x57\xe5\x6d\x13\xf0\xe1\x06\xc6\x51\x66\xa3\x72\x5a\xfa\x72\x42\x6c\x25\xc4\xad\xb5\xc6\xe6\xf2\xc5\xb1\xf0\x4b\xe6\xd9\x05\xc3\x97\x67\xa3\xa4\x08\xb4\x71\x47\x26\x6d\xf6\xf0\x91\xb2\x05\x88\xf0\x8a\xa3\x86\x0f\xa6\x65\xdf\x78\xa3\x44\x5e\xfc\x38\xb3\xd2\x3b\x0f\x01\x27\xb3\x03\x1f\x5f\x33\xef\x64\xa4\x13\x28\x79\xd7\xb8\x89\x65\x54\x1b\xf2\xe5\x44\x79\x4e\x6c\x1d\x2f\xa7\x08\x03\x33\x21\x0c\x53\xf6\x24\x47\xe1\x73\x96\x43\x55\x47\xce\xbc\x00\xa9\x5a\x73\xd7\x59\x04\x9f\x74\xb5\x35\x5d\x7b\xb5\xd9\x87\x1b\xf3\xd1\x73\x31\x52\xc2\xee\xf2\x80\x30\x66\x8a\x4f\x27\x58\xd5\xb5\xc5\x9a\x3b\x0c\xa3\xe0\xd1\xf2\x93\xdb\x8f\xf3\x74\xa2\xeb\x02\x0d\xa7\xec\x52\x56\x47\xb8\x38\x35\xe3\xd4\x79\xbe\x15\xe5\x52\xe7\x54\x2c\x3c\x49\x71\x1e\xc8\x17\x08\xbd\xe8\x91\xd2\xf7\xcc\xa4\xfe\xf7\xff\x1d\xa1\xf8\xa6\xdc\x36\xe4\xe0\xf1\x9e\x3f\x0e\x8b\xff\x4f\xf8\x91\xee\x18\xa5\xf8\x1a\x68\xb3\x5f\x91\xeb\x0c\x86\x61\xb5\xab\x7d\x64\xc6\x90\xf6\xc9\x2a\xf5\x71\x78\xe6\x63\x00\xbf\x8b\x19\x86\xf7\x19\x79\xef\xcc\xe6\xd9\xa1\xe7\xc4\x93\xbf\x07\x00\x2b\x00\x42\x86\x0c\x08\x00\x00")
 
 func templateDialectSqlByTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -601,12 +604,32 @@ func templateDialectSqlByTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/by.tmpl", size: 900, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/by.tmpl", size: 2060, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\xc1\x6e\xe3\x36\x10\x3d\x4b\x5f\x31\x15\x8c\x42\x32\x14\x3a\x0d\x8a\x02\xcd\xc2\x05\xb6\x71\xb6\x30\xb0\x0d\xda\x3a\xe9\xa1\x97\x05\x4d\x8e\x6c\x22\x34\xa9\x90\x54\x9a\x40\xd0\xbf\x17\x43\x49\x59\xc5\x49\x36\xbd\x24\xf2\xf0\xcd\xcc\xe3\x9b\xd1\x53\xdb\x2e\xe6\xe9\x85\xad\x1f\x9d\xda\xed\x03\x9c\x9d\xfe\xf0\xf3\x49\xed\xd0\xa3\x09\xf0\x89\x0b\xdc\x5a\x7b\x0b\x6b\x23\x18\x7c\xd4\x1a\x22\xc8\x03\x9d\xbb\x7b\x94\x2c\xbd\xde\x2b\x0f\xde\x36\x4e\x20\x08\x2b\x11\x94\x07\xad\x04\x1a\x8f\x12\x1a\x23\xd1\x41\xd8\x23\x7c\xac\xb9\xd8\x23\x9c\xb1\xd3\xf1\x14\x2a\xdb\x18\x99\x2a\x13\xcf\x3f\xaf\x2f\x2e\xaf\x36\x97\x50\x29\x8d\x30\xc4\x9c\xb5\x01\xa4\x72\x28\x82\x75\x8f\x60\x2b\x08\x93\x66\xc1\x21\xb2\x74\xbe\xe8\xba\x34\x6d\x5b\x90\x58\x29\x83\x90\x49\xc5\x35\x8a\xb0\xf0\x77\x7a\x21\x1c\xf2\x80\x19\x74\x1d\x21\x66\xdb\x46\x69\xe2\x73\xbe\x84\x9a\x7b\xc1\x35\xcc\xd8\x46\xd8\x1a\xd9\xaf\xc3\xc9\x00\x74\x28\x50\xdd\xf7\xc8\xa7\xe7\xa7\xf4\x01\x74\x68\x02\x0f\xca\x9a\x58\xce\x29\x13\x26\x79\x19\x1b\x4f\x33\x20\x7c\x5a\x35\x46\x40\xfe\xac\x76\xd7\xc1\x7c\xca\xaa\xeb\x0a\xf0\x77\x7a\xc3\xef\x31\x17\xe1\x01\x84\x35\x01\x1f\x02\xbb\xe8\xff\x17\x90\x47\x38\xbb\xe2\x07\x84\xae\x2b\x01\x9d\xb3\xae\x80\x36\x4d\xee\xb9\x83\x3c\x4d\x92\x78\xfe\xd7\xa4\xc1\x12\xbe\x9f\xe6\xb4\xc2\x9a\x4a\xed\xce\xe1\x88\x08\xeb\xe3\x5d\x9a\x24\x5f\x7c\x8d\x82\xf2\xfc\x9d\xde\x39\x5e\xef\xd9\x45\x14\x71\x53\xa3\x68\xd3\x24\x49\xae\xf9\x56\x63\x5f\x81\xfd\xc1\xc5\x2d\xdf\x51\x65\x16\xc3\x25\x01\xd6\xab\xf3\x49\xf6\x27\x85\x5a\x3e\x25\x27\xd7\x8f\x35\x9e\x43\x45\x41\x16\x4b\xac\x57\x8c\x62\x74\x4b\x1f\xc6\xab\x45\xe8\x85\xd5\xcd\xc1\xbc\xec\x34\xa6\xc5\x0c\x6e\xc2\x98\x10\xff\x76\x69\x52\xa4\x49\xdb\x9e\x80\xaa\x7a\xd8\x8d\x47\xb7\x8a\xbb\x21\x69\x12\x49\xa2\x2a\x50\xb2\x04\x7b\x4b\x83\x7b\x36\xc8\x49\xf1\xdf\x87\xd8\x6f\x48\xf5\xf3\xe2\x03\xe1\xe3\x15\x8e\x35\x66\xeb\x15\x2c\x41\x49\x3a\x8b\xe2\x51\xfa\xdf\x5c\x37\x38\x86\xbb\x9e\x10\x9a\x9e\x00\x3d\x3b\x6e\x76\x08\xb3\x2f\x25\xcc\x2a\xa2\x31\xeb\x75\xf2\x4f\x0c\xef\xa9\xc0\xb7\x48\x56\xdf\xa0\xd8\xd3\x18\x2a\x2e\x81\xd7\x35\x1a\x99\x4f\xa3\xe5\xff\x9f\x50\xf5\xd6\x7c\xe2\x1d\xcf\x07\xa6\xef\x4e\xac\x7a\x39\xaf\xe2\x55\x39\x7b\xf0\x26\xb8\x46\x84\x48\xad\xdf\xe3\xb6\x8d\x13\xad\xd8\x95\xd2\x9a\x76\x0d\xba\x8e\x76\xbb\x57\x35\x92\x78\x57\x6a\xec\xa5\xbe\x94\x3b\xfc\xaa\xb4\xb1\x12\xfd\x5b\x2a\xe3\x11\x91\xf5\xca\x93\xd0\x1a\x4d\x1e\xf3\x0a\xf8\x05\x4e\xc7\xbd\x38\x81\x7f\x55\xd8\x03\x3e\x04\xea\x3f\x83\x8c\x1a\x65\xd4\x36\xbb\x22\x70\x06\xc1\x35\x08\xd9\x3f\xe8\x6c\x06\x99\x51\x3a\xeb\x59\x44\x15\x02\x1e\x6a\xcd\xc3\x91\x83\x49\xac\x30\x56\x61\xf4\xfa\xb6\x8b\xf9\xe0\x73\x92\x3c\x92\x00\x4d\x2d\x79\x40\x16\x0e\xb5\x86\xe8\x85\x03\x95\x51\x82\x71\x1b\xfa\x4b\x1f\x2d\x43\x0c\x96\x40\x1d\x8a\x97\xea\xa9\x8a\x2c\x86\xa4\x39\xf2\x02\xba\x0d\x59\x54\xf9\xc2\x47\xa4\xa3\xa7\x12\x62\xf9\xe2\x43\xcc\xff\x6e\x09\x46\xe9\x28\x92\xaa\x40\xa0\x73\xe3\x5e\x2b\xbf\xf9\xf3\x73\x5c\x0b\xc7\x95\x09\x97\xe4\x67\x39\x3a\x37\x59\x65\x2a\xb0\x8c\x49\x3d\xbf\xc4\x61\x68\x9c\xa1\x8a\xd1\x00\xd3\x91\xb4\xaa\x80\x93\xea\xc7\xaf\x7c\x6e\x5d\x1f\x5c\xfb\x4d\x70\xca\xec\xc6\x5f\x37\x37\xeb\x55\xd1\x4b\x44\xf9\x8b\x39\xac\x2c\x18\x1b\xf6\xca\xec\x4a\xd8\xa2\xe0\x8d\x47\xfa\x0c\x79\x84\x33\x08\x8f\x35\x7a\x38\x34\x3e\xc0\x16\xc1\x37\x75\xad\x15\x4a\xd8\x3e\xc6\x0f\x55\xe3\xd1\x31\x98\x2f\xe0\x64\xdc\x3a\xd4\x1e\xbf\x16\x7f\xd3\x8b\x48\x91\x57\x0d\x65\x39\xac\xd5\xf3\x51\x2a\x49\xb2\x3d\x77\x19\x96\x2b\x13\x7e\xfa\xf1\xf5\x97\x29\x7a\xd3\xd4\x67\xc9\x29\x94\x2c\xde\xe5\xd5\x1d\xf5\x9e\x3e\x0f\x23\x38\x6e\x56\xd2\x50\xd2\xfe\x63\x3c\x40\xff\x0b\x00\x00\xff\xff\x41\x70\x40\x3d\x5b\x08\x00\x00")
+var _templateDialectSqlConfigTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x4b\x6f\x1b\x39\x12\x3e\xab\x7f\x45\x4d\x0e\x03\xb5\x23\x77\x6b\xe6\xe6\xcc\x2a\x40\xc6\x71\x00\x03\xde\x1d\x4c\x62\x60\x0e\x8b\x3d\x50\x64\xb5\xc4\x84\x22\x3b\x24\xdb\xb2\x56\xd0\x7f\x5f\x14\xc9\x7e\x5a\xca\x7a\xb1\x27\x49\x7c\x54\x15\xbf\xaf\x9e\x3a\x1e\xcb\xab\xec\xd6\xd4\x07\x2b\x37\x5b\x0f\xbf\x2e\x7f\xb9\xb9\xae\x2d\x3a\xd4\x1e\x3e\x31\x8e\x6b\x63\xbe\xc1\xbd\xe6\x05\x7c\x50\x0a\xc2\x21\x07\xb4\x6f\x9f\x50\x14\xd9\xe3\x56\x3a\x70\xa6\xb1\x1c\x81\x1b\x81\x20\x1d\x28\xc9\x51\x3b\x14\xd0\x68\x81\x16\xfc\x16\xe1\x43\xcd\xf8\x16\xe1\xd7\x62\xd9\xee\x42\x65\x1a\x2d\x32\xa9\xc3\xfe\xc3\xfd\xed\xdd\x3f\xbe\xdc\x41\x25\x15\x42\x5a\xb3\xc6\x78\x10\xd2\x22\xf7\xc6\x1e\xc0\x54\xe0\x07\xca\xbc\x45\x2c\xb2\xab\xf2\x74\xca\x32\x7a\x03\x7c\x10\x42\x7a\x69\x34\x53\x50\x49\x54\xc2\x41\x65\xa2\x72\x6e\x74\x25\x37\x05\x84\xc3\xc7\x23\x08\xac\xa4\x46\x78\x23\x24\x53\xc8\x7d\xe9\xbe\xab\x32\x9e\x29\xe3\xcd\x37\x70\x3a\x65\xb3\xb2\x04\x64\x1b\xb4\x0f\x86\x89\xdf\x99\xe7\xdb\x2f\xf2\xdf\x08\x4a\xee\xa4\x77\x41\xae\x6e\x76\x6b\xb4\x64\x98\x14\x8e\xac\x0e\xc7\xaf\x95\x61\x42\xea\x0d\x7c\x6f\xd0\x4a\x74\x45\x36\x3b\x23\x46\x6a\x1f\x34\x58\xdc\x5b\xe9\x11\x1a\xc2\x8b\x0c\x8e\x0b\x74\xdf\x79\xe6\x71\x87\xda\x3b\x58\x63\x65\x2c\x92\xd2\x03\x30\x8b\x80\xcf\xc8\x1b\x4f\xf8\xcf\x5a\x01\xee\xbb\x2a\x3e\xc7\xef\x9f\x1a\xcd\x83\x70\x6f\x19\x47\x3b\x94\xcd\x8d\x0d\xb6\xb9\x9a\xe9\x1e\xa0\x56\xdc\x40\x65\x91\xcd\xd2\x6d\x12\xfc\x18\xbe\x06\x99\x3b\xf4\x56\x72\xd7\x0b\xe5\x46\x11\x8a\x24\x95\x64\xb5\xfb\xa6\xfa\x81\xe8\xf6\x10\xc9\xfe\x7b\xfc\x7e\x1b\xc5\x98\xa8\x85\x1b\xed\xa4\xf3\xa8\x79\x22\x1e\x5b\x38\xc1\x6f\x99\x1f\x81\x00\x7b\xe9\xb7\x43\xa2\xb3\xd9\xf0\x3a\xe9\xf8\x8c\x4c\xdc\xf6\x6b\xd9\xf1\x78\x0d\xa8\x05\x74\xce\xf3\x97\x65\xb5\x1b\xc8\x00\x61\xe5\x13\xda\x5e\xb4\xa9\xc9\xb7\x1c\xb0\xb5\x79\xc2\x57\xb9\x52\x94\x10\x5d\x49\x56\xc0\x8b\xf6\xd1\x3f\xad\x40\x4b\x05\xc7\x6c\x36\xe3\x45\xd2\xb3\x1a\x42\x31\x6f\x97\x17\xfd\xad\x3c\x9b\xb5\x72\x12\x2f\x97\xc5\x04\xb6\x46\x42\xe2\x95\x81\x8c\xd6\x6b\x2e\x0b\x49\xbe\x34\x12\x93\x6e\x45\x39\x65\x09\x5f\x3a\x4e\x03\x21\xdc\x34\x9a\xf8\x30\xba\x23\xec\x00\xeb\x46\x6c\xd0\x27\x12\x25\xb9\x8b\xf6\xf8\xec\x61\x2e\x2b\x90\x31\x06\xb6\xcc\x81\xd1\x98\x2f\x5e\xf8\x79\x54\xe8\x51\x2f\x20\x3c\x41\x40\xe7\xc5\x28\x28\xe2\x12\x3a\x45\x36\x35\xff\xf7\xa0\xb6\xb3\x3e\x9f\x50\xfe\x23\xe2\x12\xd5\x81\xb9\xb2\x84\xbb\x97\xc1\x1b\x19\x6e\x6c\xf0\x46\x84\x1d\x7b\x96\xbb\x66\x37\xc9\x07\x9d\x9f\x86\x54\x2a\x35\x30\x70\x52\x6f\x14\x66\x65\x99\xb0\xd9\x6f\x71\x9a\x34\x50\x6c\xd0\x15\xf0\xc0\xec\x06\x2d\x28\xe9\x12\xb6\xae\x56\xd2\x83\xd4\xde\xc0\x9a\x92\x08\xba\x05\x30\x2d\x82\x7e\x8b\xae\x51\xde\x91\x5c\xa2\x61\x87\x76\x83\x02\xd6\x8c\x7f\x03\x6f\x12\xec\x35\xb3\x64\x86\x36\x82\xc4\xdf\x57\xa0\x8d\x07\x87\x7e\x01\x0c\x12\x06\xd7\xae\x46\x2e\x2b\xc9\x09\x1c\xd6\x28\x4f\xb9\x9c\xc2\xbc\xc8\xaa\x46\xf3\x33\x40\xcc\x35\x59\x94\xc3\x1f\x01\x31\xf2\x22\x8b\xbe\xb1\x1a\xe8\xfc\x9c\xc3\x55\x04\x2a\x4f\xfe\x75\x26\x0d\xae\x40\x93\x33\x9d\x32\x32\xfe\xcb\x9f\x0f\xc9\xeb\x2c\x99\xe6\x80\x05\x41\x41\xf6\x38\x35\xd2\xab\xfb\x84\x02\xf3\x84\x84\xb4\xc0\xec\xa6\x09\x1e\x99\x03\xab\x7c\xac\x3e\x07\x12\xbe\x47\x8b\xb0\x6e\xa4\xa2\x27\x6b\x71\x31\xa5\xc2\xfa\x40\x77\x52\x02\x28\xe0\x93\xb1\x80\xcf\x6c\x57\x2b\x5c\xc4\x84\xc9\x36\x9b\x41\x7a\x7f\x97\x95\x65\x56\x96\xb3\x81\xf1\x73\xb2\x7a\xce\xfd\x73\xeb\xec\xc5\x6d\xfc\x5c\x24\xde\x9d\xb7\x52\x6f\x16\x64\xac\x83\x7f\xfe\x4b\x6a\x8f\xb6\x62\x1c\x8f\xa7\x1c\xe6\xed\xe6\x64\xfd\x48\x4a\x5a\x7c\xdf\x94\x57\xc0\xea\x7a\xc5\x6a\x09\x57\x25\xbc\x81\xb7\x51\x72\x14\x49\x27\x4f\x39\xd9\x45\x86\x0c\x61\x9d\x57\x2d\x37\x53\xc3\x2e\x68\xbd\x60\xcd\xab\x29\x6f\xf3\xcc\x0a\xaa\x01\xd1\x8f\xa9\xb2\x44\x8e\x53\x3e\x1b\x17\x28\x16\x4a\x54\x00\x1c\x19\xdf\xf6\x6c\x27\xb2\x2d\xd3\x8e\x71\xb2\x21\x8f\x15\x41\x06\xff\x9f\xb2\xc8\x95\x44\xed\x0b\x78\x24\x87\x09\x35\x6f\x6b\x54\xf0\x15\x10\xcc\xb3\x35\x73\x08\xee\xe0\x3c\xee\x16\x63\xa7\x5a\x0c\x2a\x3c\x09\x36\x15\xb0\xaa\x42\x4e\x1e\x62\xcd\x7e\x10\x7d\xa8\xbd\xf4\x87\xee\xa7\xa9\xd1\x32\xb2\x2b\x9a\xd5\x19\x34\x92\x5e\x64\xe3\xec\xf9\xb2\xa6\x85\x7c\x31\x78\x65\x4a\x87\x29\xf3\x31\x07\x7c\x2b\x95\xb0\xa8\x43\xba\xf1\x2e\xbc\x2e\x05\x6a\x84\x77\xee\xfb\x62\x60\x5f\x4d\x58\x22\x63\x05\xbe\xa7\x2b\x95\xa5\x96\xaf\x54\xf0\x8d\xed\xfa\x87\x94\x85\xc9\x94\x09\x59\x89\x9a\x0b\xbc\x2c\x48\xba\xd4\x5c\x35\x62\xd2\xf0\x9c\x05\x64\x00\x87\x8b\x94\xb6\x8a\x7b\x52\x9b\x04\xbe\xa9\x48\xf6\x45\x4a\xcf\xf0\x49\x67\xb9\x62\xce\x51\x0a\x6c\x85\x00\xf5\x7a\x68\xad\xb1\xa1\x60\x55\x4c\x2a\x14\x79\xb0\xfb\xff\xe3\x3f\x10\xd5\xd5\xfb\xb3\x8d\xd0\x45\xce\xaa\xcd\x84\xb5\x6a\xd3\x35\x17\x2b\xe0\x3d\x71\xd4\xbd\xfc\xd1\xd9\x13\x65\x44\x06\x43\xf4\x47\x0b\x09\x3b\xf7\x8a\x97\x0c\x1d\x90\x84\x1b\x3d\x7e\x93\x4b\x01\x46\xb8\x24\x6b\x86\x25\x8a\x89\x73\x4d\x5d\xea\xb6\x08\x5b\xe9\x61\xcf\x82\x93\xe5\x09\x9e\x39\x4f\xfb\xf9\xf8\x25\xe7\x53\x6b\xb4\x7e\x01\xa6\x4e\xc9\x2c\x9f\x9e\x21\x20\x43\xf7\x33\x34\xe4\xa7\xb6\xdd\x61\xe2\xee\x09\xb5\x6f\x58\x6a\x87\xfc\x73\x6a\x25\xfe\x92\x7e\x3b\x69\x20\xc9\x82\xc5\x58\xd0\xcb\x06\x6d\x15\x7b\xab\x9f\x7f\x1e\xf4\x7e\x69\x8d\x14\x24\x4a\xb9\x7f\x0e\x37\xd3\xcf\x56\xe1\xe8\xb1\xc3\xc7\xe5\x89\xdb\x5d\xe3\xc3\xfe\xc7\xd0\x29\x76\xe4\xf6\xa5\x6b\xe4\x7f\xa9\x51\x49\x77\xba\xee\xbc\x9d\x8c\xee\xbb\x9f\x01\x27\x49\xd3\x0c\x71\x2c\xec\xe1\xda\x36\x1a\x76\x34\xd9\xcd\x1d\x22\x7c\xb4\x87\xcf\x8d\xce\x17\x20\x7d\xa7\x93\x8d\x34\x46\x1f\x71\x53\xe7\x90\xda\x79\x64\x82\x34\x47\x9b\x52\x1d\xdf\xbd\x24\x7b\xfc\xb4\x73\x6c\xe7\x6d\xcf\x52\xa4\xe7\x47\x66\x09\xbc\x7b\x17\x4d\xa4\x6b\xf9\x10\x68\xda\x6c\xb7\xba\xae\x70\x80\x7c\xbb\x98\xf0\x1d\x37\x2b\x48\xde\xad\x28\x19\xb0\xd0\x94\xd1\x33\x74\x1a\xfb\xa6\x2d\x19\x67\x4a\x39\xa8\x74\x3f\x3c\xac\x69\xd4\x65\x34\xbd\xa4\x52\x12\xb2\xa4\xd1\x98\x78\xd8\xc5\x6c\xd6\xf6\x80\xce\x9b\xda\x81\x1c\x73\x42\xb1\xc1\x99\xe6\xa8\xa8\xba\xa1\xdf\x23\xea\x56\xef\x4b\x08\xa7\xd6\x9f\x0f\x99\xd0\xbe\x2d\xa0\x6d\x0a\xe4\x02\xbe\xd2\x4a\x1e\x33\x5e\xfa\x20\x0c\x1d\x75\xbd\xef\x56\x30\xe8\xe1\xa8\x7d\x9b\xe7\xd9\x8c\x6a\x80\x84\x77\x2b\x58\xfe\x06\x12\xfe\x06\x9a\x3e\xde\xae\x20\x5c\x21\xf8\xbf\xd2\xa6\x84\xb7\x61\x25\x9b\x11\x4f\x5f\xe1\x3d\x68\x92\x4b\xbb\xa1\x05\x24\x1e\x68\x07\xad\xa5\xe3\xdc\x3f\x17\x77\xd6\xce\xf3\xdf\xc8\x86\xe1\x94\xd2\xb2\x85\xd6\xbe\xb8\x55\xe9\xf0\x84\x57\x5c\xea\x59\xd7\x52\x4d\x09\xa7\x97\x8d\xe2\xe9\x7f\xec\xef\xcf\xfc\x0d\x70\xf8\x01\x43\xa4\x6e\x9e\x13\xee\x5d\x7e\x3a\xd3\x28\xbf\x87\xe5\x28\x6b\x9c\x39\xd3\x4d\x65\x7f\x3e\x48\x7f\xe1\x8f\x8a\x27\x66\x25\x5b\x2b\x74\xc9\xe0\x36\x3e\x69\x50\xb8\xb9\xb9\x81\xf5\x21\x4c\x64\x69\x00\x28\xe0\x01\xd9\x13\x82\x35\x66\xd7\x15\xfb\xae\xc3\x26\x20\x8c\xdf\xa2\x85\xda\xa2\xa0\x9a\x89\x0e\x98\x83\x3d\x2a\x55\x64\x33\xb7\x97\x9e\x6f\xbb\xb0\x2a\x3e\xc6\x88\x9d\x87\xa0\xe4\xd4\x78\xb5\x31\x1c\x0d\x7e\xd7\xbf\xee\x66\xb9\xcc\x66\xc9\x86\xc1\xf2\x2f\xcb\xe5\x72\xd9\x57\xb7\x31\x02\x54\xc7\xb1\x36\x96\x52\x4d\xf5\x32\x4a\x53\xfc\x12\xcc\x06\x76\xb1\xf3\x67\x9a\xc6\xce\x18\x44\xd3\x59\x8c\xa6\x9e\xb2\x4c\x15\xb8\xff\x37\x43\x6c\xda\xb9\x36\xd2\x5f\xd7\xea\x90\x86\x2c\xd8\x6f\x8d\x6a\x87\x31\x98\x2b\xf9\x2d\x71\x40\x33\x2b\xb5\x6d\x36\x07\xce\x34\xcd\x5d\x6b\x0c\x37\x65\x57\x48\x43\x3e\x88\x76\x38\xac\x99\x65\x1e\xd5\xa1\xab\x9e\x36\x8c\x2a\xa1\x23\x75\xfd\xff\x20\xd4\x8b\x0c\xb3\x6a\xc4\x8e\xf2\x47\xcd\xac\x97\x4c\x25\x53\xfe\x7b\x76\x10\xed\x1c\xb7\x36\x46\x0d\xba\x0d\x0d\xef\xcf\x44\xfc\x29\x3b\x1e\x01\xb5\x80\xd3\x29\xfb\xcf\x00\xb5\xd5\x27\x3e\x3d\x14\x00\x00")
+
+func templateDialectSqlConfigTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectSqlConfigTmpl,
+		"template/dialect/sql/config.tmpl",
+	)
+}
+
+func templateDialectSqlConfigTmpl() (*asset, error) {
+	bytes, err := templateDialectSqlConfigTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/sql/config.tmpl", size: 5181, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectSqlCreateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\xbd\xff\x6f\xdc\xb6\xb2\x28\xfe\xf3\xee\x5f\xc1\x2e\x52\x43\x4a\x15\xd9\x2d\x3e\xf8\x00\xcf\x89\x0f\xd0\xc6\x4e\x6b\x34\xb5\xdb\xd8\xb9\xe7\xbc\x67\x18\xa9\x2c\x51\x36\x63\xad\xb4\x11\xb5\x6b\xef\x75\xf7\x7f\x7f\x98\xe1\x0c\x49\x7d\xd9\xf5\xda\xc9\xc3\x7b\xf7\x02\xa7\xf1\x8a\x1a\x0e\x87\xf3\x7d\x86\xd4\xc3\xc3\xee\xcb\xf1\xdb\x6a\xb6\xac\xd5\xf5\x4d\x23\x7e\xda\xfb\xf1\x7f\xbc\x9a\xd5\x52\xcb\xb2\x11\xef\x92\x54\x5e\x55\xd5\xad\x38\x2e\xd3\x58\xfc\x5c\x14\x02\x07\x69\x01\xcf\xeb\x85\xcc\xe2\xf1\xf9\x8d\xd2\x42\x57\xf3\x3a\x95\x22\xad\x32\x29\x94\x16\x85\x4a\x65\xa9\x65\x26\xe6\x65\x26\x6b\xd1\xdc\x48\xf1\xf3\x2c\x49\x6f\xa4\xf8\x29\xde\xe3\xa7\x22\xaf\xe6\x65\x36\x56\x25\x3e\x7f\x7f\xfc\xf6\xe8\xe4\xec\x48\xe4\xaa\x90\x82\x7e\xab\xab\xaa\x11\x99\xaa\x65\xda\x54\xf5\x52\x54\xb9\x68\xbc\xc9\x9a\x5a\xca\x78\xfc\x72\x77\xb5\x1a\x8f\x1f\x1e\x44\x26\x73\x55\x4a\x31\xc9\x54\x52\xc8\xb4\xd9\xd5\x5f\x8a\xdd\xb4\x96\x49\x23\x27\x62\xb5\x82\x11\x2f\x66\xb7\xd7\x62\xff\x40\x5c\x25\x5a\x8a\x17\xf1\xdb\xaa\xcc\xd5\x75\xfc\x67\x92\xde\x26\xd7\x92\xc7\x5c\xcd\x55\x01\x38\xef\x1f\x88\x59\xa2\xd3\xa4\x10\x2f\xe2\xb3\xb4\x9a\xc9\xf8\x17\x7a\x42\x03\x6b\x99\x4a\xb5\x30\x23\xed\xbf\x5f\x5c\xb5\x07\x4d\xe7\x4d\xd2\xa8\xaa\x84\x41\xb3\x5a\x95\x8d\xf7\xde\x24\xe6\xa7\x13\x01\xe3\xc7\xf9\xbc\x4c\x45\xd0\x82\xbd\x5a\x89\x97\x3e\x56\xab\x55\x28\xf4\x97\xe2\x2c\x59\xc8\x20\x6d\xee\x45\x5a\x95\x8d\xbc\x6f\x60\x2d\xf0\xdf\x50\x04\x38\x3c\x3e\x49\xa6\xb0\xa2\x48\xc8\xba\xae\xea\x50\x3c\x8c\x47\x30\xfc\x40\x74\xa0\xc7\x77\xaa\xb9\x39\x9d\xc9\x1a\xb1\x04\x90\x91\x98\xf8\x10\x26\x91\x98\xbc\x35\x54\x0c\xc7\x23\x7c\xf2\xc1\xbd\x1e\x89\x4f\x7a\x26\x53\xb1\xdf\x07\x6c\x48\x7f\x36\x93\x69\x80\x2f\xbe\x12\x2a\x17\x2f\xe2\xdf\x12\xfd\xab\x2c\x61\x3e\x99\xc1\xa2\x47\xa3\xdd\x5d\xf1\x41\x26\x99\xb8\x4a\xd2\x5b\xda\xf5\x3b\x91\xd7\xd5\x14\xff\xc8\x92\x26\xc1\xfd\xca\xab\xda\x0c\x9e\x55\xb3\x79\x91\x34\xaa\xbc\xc6\x01\x8b\xa4\x98\x4b\x6d\x78\x43\x8a\x6b\x0b\x3b\x57\xb2\xc8\x74\x3c\x1e\xe1\xdc\x8d\x9c\xce\x8a\xa4\x19\x64\x8f\xdd\x5a\x26\x19\xcc\x3e\x11\x2f\x10\x25\x78\x41\x16\x5a\x5a\x8c\x0f\x65\x9e\xcc\x8b\xe6\xe8\x7e\x56\x3f\x09\x67\x95\x8b\xaa\x94\x8c\x9b\xc1\xc8\xbc\x0d\x64\x17\x09\xf0\x2c\x00\x16\xf2\x1e\x04\x4e\x03\xa3\xdc\x25\x5a\x94\x55\x23\xb4\x6c\x44\x55\x0a\x24\xa3\xaa\x4a\x58\x88\xca\x71\xfb\x2a\x64\xb9\x3c\x01\x0c\x57\xab\x87\x07\x51\x27\xe5\xb5\x14\x2f\x72\xf8\xf9\x45\xfc\x0e\xa7\x31\x4f\x60\x01\x79\x7f\x05\xf4\xa4\x82\x7f\x8b\x7f\xfe\x01\xa8\xb2\x84\xed\x68\xb1\xec\x6a\x15\xc3\xdf\x39\x33\x3e\x02\x86\x37\x0e\x0e\x44\xa9\x0a\x42\xe5\x40\x34\xf5\x9c\x10\xb1\x40\xcc\x3f\x80\xeb\x9e\x41\xfe\x11\x6f\x01\x02\x19\x8f\x54\x0e\x5c\x0c\x8b\xd3\x5f\x8a\xeb\x3a\x99\xdd\xc4\x86\x23\x4f\xaa\x0c\xa5\x20\xea\x31\x1f\xaf\xe1\xb0\x06\x76\x84\x31\x21\xb1\x6a\xf8\x1a\x81\x7d\x87\x4b\x40\x04\x55\x2e\x52\x59\xd7\x91\xa8\x6e\x61\x0e\xa5\xcf\xfe\x7a\xff\xb6\x2a\x75\x53\x27\xaa\x6c\x8e\x40\x7e\x02\x59\xd7\xe1\x6b\x18\x00\x2f\x8c\x00\xc0\x01\xbe\x64\x90\x1d\xd5\xb2\x99\xd7\x25\x40\x44\x81\x1b\xf3\x0a\x70\x97\xe5\x7d\x03\xe4\x78\x21\x26\x80\xef\xc4\x17\xa0\x09\x88\xc7\x44\x4c\x10\xb3\x09\x09\x5a\x55\x4f\x5a\x8b\x41\x0e\xde\x4c\x41\x95\x4d\x44\x2c\x56\x1d\xba\x11\x56\x7d\x99\x2d\x55\x31\x5e\x8d\xc7\xbb\xbb\x02\xf4\xc8\xf1\xa1\x61\x32\xa9\x91\x79\x7d\xe1\x67\x3d\x6c\x19\x3a\x29\x33\x61\xc0\x6a\x51\x95\xc5\x52\xa8\x46\x0b\x95\xc5\xe2\x63\x59\xa8\x5b\x89\xf0\x22\x00\xdc\x83\x24\xcb\x46\x35\x4b\xb0\x0d\xc0\xdc\x49\x51\x54\x29\x8a\x69\x59\xd5\x2c\xd1\x32\x8b\x70\x82\xe6\x46\xd6\x32\xaf\x6a\x19\x09\xd5\xc0\x1b\x73\x2d\xf3\x79\x01\x60\xf3\xaa\x16\x77\xb5\x6a\xe4\xab\x1b\x99\x2c\x96\x62\x96\x34\x37\x80\x76\xd2\x88\xac\x42\xb1\x01\x59\xc6\x75\x98\x35\x65\x34\x71\x2c\x4e\xaa\x46\x9a\x91\x37\x55\x75\xab\xc5\xb5\x6c\x44\x02\x30\x5b\x68\xd2\x80\x22\x6b\xad\x4f\x24\xda\xe9\x1a\x03\x44\x69\x22\x84\xcc\xc4\xd5\x12\x9f\x96\xf2\xbe\x11\xc8\x79\x55\x1d\x6f\xab\xcc\x81\x62\xc7\x87\x6b\x74\xb9\xca\x90\xb3\xe3\xe3\xc3\xf8\x7c\x39\xb3\x0a\xdd\x53\xea\x5d\xc6\x27\x85\xa2\x83\xd0\xca\xcd\x80\x6a\xbe\x91\xe9\x6d\xd0\x97\x04\x62\x18\x95\x39\x2e\x56\xb9\x28\x64\xd9\x5d\x46\x8c\x24\x0c\xc5\xc1\x81\xd8\xf3\xdf\xec\x0e\x23\x4b\x65\xd6\x17\xa2\x58\x2c\x92\x1a\x68\x24\xfe\x30\x74\x12\x07\xe6\x5f\xf2\xdd\xbc\x4c\x03\xa0\xd9\x10\x29\x22\x31\x35\xc3\x54\x55\x86\x22\xf8\x2f\x50\xf9\xbe\x69\x1b\xb1\xbc\xb3\x10\x4f\x63\xb2\x83\xfc\x16\xed\x6f\x68\xe4\xfd\x3b\x96\x64\xc2\x1b\x05\x37\x9f\x36\x31\x4a\x7b\x1e\x4c\xe6\xa5\xbc\x9f\xc9\x14\xf8\x87\x41\x8b\x06\x76\xe0\xfb\xf3\x49\x24\xa6\x21\xc9\x7d\x47\x5d\x8a\x03\x3b\xda\xcc\x43\x94\x14\x07\x8f\x50\xa6\xb7\x13\x2d\xc4\x7a\x7a\x66\xc7\x67\xd9\x87\x14\xdd\x98\xfd\xde\x14\xe6\xf7\x48\x1c\x1f\xee\x0b\x95\x91\xd8\x8f\x56\xe1\x78\x04\x52\xa4\x80\x4c\x1b\xb6\xf6\x95\xf8\xf1\xb5\x50\xe2\x5f\x07\x62\xef\xb5\x50\xaf\x5e\x31\x99\x07\xd6\x82\x6f\x5c\xa8\xcb\x60\x3a\x6f\x42\xe6\x9a\x4f\xbc\xf2\xe9\xbc\x31\xbb\xe0\xa9\x6a\x8f\x66\x5b\x71\xa1\xf7\x53\x57\x77\xfd\x47\xa4\x49\x51\x68\xfa\x0b\xf5\xc7\x2c\x29\x55\xaa\xc1\xc2\xd1\x8f\xac\xb1\x92\x12\x20\x3e\x59\x38\xff\x33\x2c\x9d\x1d\xc9\x04\x02\xf1\x7e\x0f\xb8\x43\x2d\x39\x50\x79\x77\xd1\x88\x33\x9a\x99\xf6\x82\xc7\x4f\x76\x0b\xbf\x42\x99\x7c\x0b\x0f\x71\xad\x3f\xa8\x32\xf6\x05\xad\x5e\xfa\x7f\xdc\x9c\xfb\x1c\x48\xfe\x2b\xb0\x17\x52\xf0\xa3\x96\xf5\x21\x06\x1c\x99\x08\xaa\xda\x90\xf5\x58\x9f\x35\x35\xf8\xa5\xf4\xd7\xc7\x8f\xc7\x87\x21\x9a\x62\x56\x06\x06\xa7\x8e\x08\xc4\xbc\x2d\xac\xac\x7e\x95\x8d\x58\xad\x02\x0f\x45\x0f\x23\x10\x80\x16\x9a\x5d\x62\xe9\x34\x29\x8f\x0f\x03\x24\x0f\xe0\x81\xda\x92\x5c\x70\xf4\x6a\xc9\xa5\x20\x87\xbc\xb3\x18\x7c\xf8\xb5\xe8\xf6\xf1\x25\x75\xe9\x9c\x13\x1f\x7b\x8f\x25\x3b\x68\xc7\x81\x2a\x9b\xff\xff\xff\x0b\x43\x82\xe3\x41\xc0\xa0\xef\x6b\x36\x65\x77\x57\x18\x52\x81\xac\x2c\x64\xdd\xa0\x82\x50\xe0\x7f\x24\x0d\xba\xe1\x2e\x98\xb8\x5a\xb6\xfd\xa0\x20\xd1\xe0\x9b\xdc\x25\x2d\x2f\x80\x1d\x9f\x0c\xd9\x34\x14\x4d\x85\x6f\x01\xc8\xe5\xcc\x86\x01\xbe\xec\x6c\xad\x89\x68\x53\x17\x02\xc9\xb2\xa5\x6b\xe0\x76\xd8\x2c\x1b\x56\xcd\xec\xae\xb2\xf8\x2c\x4d\xca\x60\xd1\xe3\x0c\x7d\xa7\x9a\xf4\x46\x2c\x60\xeb\x17\x71\x00\x66\x0f\xe1\x8d\x52\x58\xb9\x46\x0e\xdf\x87\x5d\x56\x99\x38\xe8\x22\x81\xf0\xcc\xc8\x8b\xcb\xab\x65\x23\x1f\x19\x49\xfe\xca\xbe\x93\xc3\x35\x66\x98\xac\xaf\x00\xdb\x85\x81\x94\x50\xd9\x24\x12\x0b\x32\xc5\x3e\x6b\x79\xcc\x07\xe2\xbb\x1a\x7b\x0f\xb7\xa5\xb7\x1f\xbd\xf6\x62\xea\x97\x1d\xc5\x05\xc3\x88\xe4\x6d\x57\x1b\x48\xf8\x14\x63\xbd\x65\xd8\x60\x05\x78\x63\x50\x00\x82\xf4\xa4\xb0\x00\x45\x8f\x8c\xab\xd1\xd6\xe0\x7c\xa3\x5f\xef\xc8\x11\x89\xab\x79\x03\xbc\x9f\x55\xd2\xf8\xf2\xec\xbd\xb7\xbc\xee\xb2\xca\xe4\xd6\xcc\xcd\xa6\x61\x90\xb0\xe2\x61\x03\x51\x26\x93\x6f\x43\x0c\xbb\x74\xc0\xf5\x6a\x5e\xdc\x7a\xf9\x1a\xc6\x74\xf2\xcb\xbc\xb8\xb5\xa9\xa4\xab\x75\xe9\x9f\xe2\x96\x87\xcc\x67\x5a\xd6\x8d\x83\x14\xd8\x7c\x12\x70\x43\x28\x26\x1f\x71\x40\x0b\xec\x7c\x18\x2c\x81\x02\x06\xa6\x88\x85\x26\x82\x1d\x02\xba\x33\x92\x20\x1e\xb8\x59\xa0\xf1\x12\x81\xa3\xaa\x7c\x20\x14\x53\x52\xc7\x63\x14\x2a\x1f\x9a\x6e\xea\x79\xda\x00\xc9\x0d\x43\x8e\x47\x04\x58\x8b\x8b\xcb\xce\xbe\x01\xf1\x72\x2d\xe0\xff\xae\xaa\xaa\x80\x3f\x9b\x5a\x49\x2d\x84\x2a\x1b\xcf\x47\x5b\x1f\x5d\x32\x22\xdd\x30\xd3\x67\x9c\xab\x01\xce\x41\x5c\x4d\xe8\xb4\xc6\xd7\x21\x64\x87\xd2\x60\xc0\x99\xba\xe5\xa6\x5d\x0d\x38\xe6\x00\x37\x12\x3b\x96\x1f\x7f\x49\x9a\xf4\xc6\x31\xe5\xc3\xaa\xe7\xc5\xed\xec\xf4\x81\x31\x45\xfe\x25\xf6\xc4\xce\x8e\xf1\x45\x0e\x65\x92\x15\x55\x7a\xeb\x3c\x91\x6e\x04\xd5\x03\xb1\x34\xd8\x74\xbd\x43\xb7\x12\x8f\xda\xff\xb1\x32\x0b\xcb\x30\xd2\xea\x1c\x62\xf6\x80\x45\x95\xa6\xf3\x5a\x3f\x81\xd0\x6b\x9c\xe0\x0e\xa1\x61\x29\x8b\xf5\xc4\x65\xca\x3e\xc1\x05\x5e\xd0\xda\xfe\x2b\x29\x54\x06\xd2\xad\x65\x63\x58\x9e\x4c\x87\x97\x00\x4c\x8a\x82\x05\x41\x9b\x54\x42\x3d\x2f\x71\xb0\xaa\x05\x06\xbd\x60\xe2\x33\x31\xd7\xb2\x7e\x65\xd2\xc5\x19\xd8\xec\x85\x81\x5d\xd5\x5a\x5c\x61\xe2\x41\x24\xe5\x52\x68\x88\x59\xa6\x90\x04\x57\x5a\xc8\x7b\x99\xce\x1b\x99\xc5\xe2\xb8\x21\x93\xaf\x45\x22\x5e\x82\xf0\x12\x6a\xaa\x2a\x71\x4f\x39\xc9\x50\x64\x9a\x1d\x02\xe4\x3e\x9b\xa3\x64\x14\xcd\xc0\x3c\x51\x85\xf5\x30\x54\x2d\x54\x99\xc9\xfb\x48\x54\x35\x12\x06\xdc\x9b\xa2\xa0\x37\xa7\x22\xa9\x31\x09\xa1\xb2\x18\x40\xbb\x94\x46\x0b\xac\x1d\x84\x9a\x38\xb9\x4e\x54\x09\x99\x44\x20\x3e\x27\x58\x6c\x16\x04\xc6\x82\x12\xb7\xeb\xdb\x8e\x23\x78\x37\x82\x90\xf8\xe9\x61\x0c\xe6\x5b\x83\xd6\x9a\x26\xb7\x32\x98\x26\xb3\x0b\x55\x36\x97\xf8\x94\x43\xce\x88\x71\x84\x61\x26\x69\xd9\x63\x11\xbb\x0a\x10\x0a\xfa\xa3\x95\xd5\x60\xce\x81\x3c\x3e\x3d\x5e\x97\xcf\x40\x94\x2e\xd4\xa5\x38\x10\xd6\xb9\x77\x39\x0d\x78\x18\x8a\x7f\xb5\x33\x18\x3b\x03\x1b\xfa\x80\xff\xab\xf7\x01\x88\x5e\xb5\x24\xd0\x06\xa3\x6f\x01\x85\x0f\x32\xd7\xa0\x8c\x72\x75\x3d\xaf\x49\xe1\xa1\x10\x35\x95\x58\xc8\x5a\xe5\x4b\xb7\x5b\x28\xbc\xe6\x4f\xd8\x83\x5a\xe6\xb2\x96\x65\xea\x7c\x4d\x99\x5d\x1b\xae\x56\x0d\xf2\x11\x2d\x16\x58\x51\xe9\x26\x62\x4e\xc5\xa1\xac\x47\x01\x92\x2a\xc1\x54\x10\xa7\xa6\x95\x6e\x20\x93\x25\xc5\x97\xb9\xac\x97\x62\x26\x6b\x04\x4c\xd2\x81\xab\x40\xe8\x89\x78\xf9\x81\x51\xe8\x72\x31\xe2\x3b\x55\x5a\xab\xf2\x5a\xa8\x4c\x47\x42\x95\xba\x81\x3c\x5b\x95\x8b\x44\xa4\x36\xb8\x62\xdd\x82\xcc\x4a\x88\x6c\xa9\x62\x2c\xfd\x82\xb0\xf5\x84\xbd\xaa\x16\x8f\xa0\xdd\x31\x79\x67\xbb\x15\xdd\x41\xb4\x2f\x1f\x40\x7d\x9e\x96\xac\x74\xd7\xed\x4e\x0d\xc3\x10\xeb\xbb\x9b\xaa\x90\xe2\x0a\xd4\xbd\x98\xcf\xe0\xd9\x34\xb9\x17\x8d\x9a\x4a\x58\xb7\xbf\x32\x20\x1b\x09\x6f\x55\x62\x2e\xdf\xcc\x11\x8b\x5f\xcc\xd6\x20\x50\x55\x5e\x47\x34\x15\xed\x1f\x6c\x92\xae\x6a\x17\x56\xa8\x5a\xcc\x4b\xf5\x65\x2e\xc5\xad\x5c\xc2\x2c\xa5\xa8\xea\x4c\xd6\x30\x41\x53\x89\x24\xfd\x32\x57\xb4\xd3\xa8\x1c\x04\xcc\x62\x8d\xa6\x06\x13\x87\xe3\x45\x82\xdc\x97\xce\xeb\x1a\xb4\x16\xac\x4d\xc7\xe2\x14\xd2\xa9\xac\x81\x02\x19\x5f\xc7\xde\x8e\xc1\x14\xe6\x51\x68\x55\x01\xa0\xad\x38\x17\x8b\x40\x1c\x9b\xb2\x9a\x80\xc9\x13\xd1\xd4\x49\xa9\x93\x14\x04\x45\x04\xe7\xf7\x3d\x10\x42\x2a\x98\x1c\x13\xc2\x57\x32\x4d\xe6\x5a\x92\xe6\xa6\xdd\x48\xae\x2a\x08\xbb\x0c\x0d\x3c\x68\x5b\x32\x4d\x67\x73\x03\xd8\x29\x55\x36\x5b\x71\x10\x20\xa8\x05\x68\xab\xfb\xc7\x78\xe8\xb4\x84\x4a\x61\xa1\x52\x93\xb7\xbe\x73\x32\xce\x35\xa8\x94\x9f\xdf\x24\x65\x56\xc0\xaf\x24\x03\x88\x2a\x09\x82\x38\xbf\x91\xe2\x5a\x2d\x64\x29\xd2\xaa\x98\x4f\x49\xf0\x6a\x09\xf6\xc8\xa6\x98\x2d\xa8\x26\xa9\x21\x45\xad\x4a\xf1\x67\xa5\x9b\xeb\x5a\x9e\xfd\xf5\x1e\xa5\xf6\xec\xaf\xf7\xaa\x21\x09\x06\x82\xab\xeb\xb2\xaa\x0d\x33\xfd\xb1\x3c\xfb\xeb\x3d\x98\x86\xf1\xee\xee\x88\x15\x41\x24\xf4\xad\x9a\xcd\xa4\xcb\x4d\xa5\x85\x92\x65\x13\xfb\x86\x1b\x5e\x1a\x8d\x8c\x83\x03\x2a\x30\x60\x76\x8d\xe3\x38\x34\x0f\x1d\x19\x02\xfa\xe5\xb0\x3a\xa9\x9a\x1b\x55\x5e\xf3\x0f\xce\xbe\x1b\x14\xc8\x43\xf9\xf4\xed\x66\x26\xca\xb9\x67\x1f\x67\x60\x87\x4e\xe4\x1d\x06\xc6\x7a\x10\x93\xad\x98\xa9\x3f\x89\x88\xe3\xd8\x84\xbb\xc4\x51\xd6\x0b\x17\x0f\x96\x67\x76\x5a\x0f\x1e\x8c\xaf\xbb\xdf\x63\xa5\x88\xf7\x7c\x9f\xff\xf1\x0d\xb8\xcb\xec\x70\x24\xe6\x9a\x87\x1a\xf6\xaa\x66\x20\x92\x86\xbd\x86\xb9\xca\xe8\x01\xfd\xa5\x88\x79\x72\x97\x22\x03\xd7\xa3\xfd\x04\x49\xfe\x6f\xa8\xca\x84\xbe\xff\xc3\xde\x8d\x05\x4e\x3b\x07\x06\x80\x42\x0f\xcf\x88\x60\x39\x05\xcb\xf7\x34\x2c\x6e\x33\xc9\x13\x99\x94\x37\xda\xdb\xb6\xe1\xe5\x04\x25\x04\x5b\x5b\x71\xac\xe3\x93\x8d\xe1\xaa\x37\x25\x91\x13\x18\xc5\x9b\xfc\x14\xe9\x3f\xc4\x34\x1c\x5a\xee\x78\xac\xf7\x68\x02\x9f\xe6\xd6\xfb\xfd\x18\xec\x41\x3c\x3c\xbc\xf2\xde\x7a\xb5\x5a\xf9\x61\x2d\xcc\x10\x7b\xe8\x86\xf1\x39\x22\x4c\x78\x83\x14\x11\x17\x52\xd8\xc3\x0a\xde\xb3\x8e\x86\xc9\x7a\x3c\x66\x2c\x24\x84\xcd\xb1\x38\x36\xca\x0e\xfe\x60\xee\x06\xbd\x06\xfc\xa1\x65\x13\x51\xf1\xbb\x4c\x0a\x28\xed\x5b\x37\x18\xf7\x9d\x9c\x1f\x2e\xa5\xf7\x4b\xe8\x49\xde\xc8\xfa\xe9\xfe\x84\x17\xc6\x75\x83\x96\xc8\x20\xfa\x72\x5d\x6c\xb7\x39\x7c\x74\x39\xf2\xab\xe7\x25\xc9\x41\xbb\x42\xa2\x1c\x0a\x61\x01\xa4\xdb\x66\x32\x35\x86\xe8\x56\xc2\xc4\x16\x2d\x87\x51\x64\x0b\x35\xad\x39\x99\x2f\x42\xf0\x8a\x0d\x35\x1d\x98\x36\xfe\x8f\xbf\x4f\x75\x4b\x0f\x04\x55\xe8\xb6\x78\x39\xb4\x3e\x35\x50\x36\x26\x85\xe1\x7c\x6b\xfc\x15\xb2\x8d\x46\x47\x43\x92\x0f\x0a\x7d\x0a\x12\x05\xa1\xb8\xb8\x54\x65\x23\xeb\x3c\x49\xe5\x03\xb5\x0c\x10\xfb\xe2\x9a\x2e\xd4\x65\xac\xed\xbb\xed\x19\x28\x11\x8e\xbf\xfd\xac\xb5\xba\x2e\x5b\xb0\x23\x8e\x0d\xe3\x38\xf6\xe6\xf0\x62\x96\xfe\x54\x09\x82\x19\x98\x8c\x81\xd1\xa4\x2b\xaf\x8c\xb6\x4d\x28\xe3\x63\x85\x6d\x4d\x1d\xae\xc4\xfc\xa1\x8d\x78\x06\xa3\x67\x86\x77\xa1\x2e\xc7\xa3\x35\xc1\xd1\xff\xa1\xfa\xea\xd3\x2a\xac\xed\x1a\xeb\x57\x55\x59\x91\xd6\xde\x62\xed\xb8\x56\xa9\xf5\x49\x41\x61\x1b\x1f\x13\x18\xf2\x34\xcc\x06\x46\x47\xc0\xbf\x84\x07\xd1\x0a\xa4\x21\x35\xd2\xda\xe6\xdc\x19\x0d\x25\xde\xa0\xc4\xb0\x40\x85\xaf\x7e\xe4\x79\xfd\x9a\x28\xa6\x1b\x2e\xd4\x0f\x3f\x5e\x72\x75\x14\xb8\x22\xda\xb4\xeb\x30\x96\x17\x4d\xb4\x31\x69\x7b\x02\xbf\xbb\x2b\x8e\xcb\x45\x75\x6b\x9c\xec\x24\x6d\xe6\x49\x21\x2a\x56\x4a\x90\x02\x80\xdf\x21\x55\xab\x1b\x47\x70\x0a\x23\xd2\x9b\x44\x61\x93\xd1\x88\xe4\xe9\x84\x14\x0a\xfc\xa1\xcd\xef\x2a\xef\xa3\x87\xb1\x18\x21\xe0\xed\x42\x6f\x5c\x6a\x03\xbc\xe1\x82\xf7\xba\x7d\xe1\x9d\xe1\xff\xf4\x8b\x87\x9e\xfa\x76\xd5\xc3\xd6\xdc\x83\xe5\xc3\xe1\xea\xe1\x68\xf4\x9c\x0a\xe2\xa8\x5b\x45\xec\xe1\xbd\xf2\xb9\x74\x5b\x6e\x5c\x9f\xf6\x66\x3e\x9d\xd8\x16\x22\xe6\x57\xbf\x8b\x68\x42\xbc\x43\x49\x72\xb3\x34\x1e\x68\x6b\x6c\xdd\xe5\x3f\x9a\x4b\x77\xdd\x46\x6d\x54\x39\xa7\xee\xad\xc9\x8a\x13\x57\x01\x91\x6f\x5b\xfd\x07\x84\xe3\xc6\xbe\x03\xee\x3c\x68\x8d\x75\x1d\x07\x84\x84\x93\x2a\xc8\xf8\x4c\xe7\x0d\x68\xea\x40\x45\xc2\x36\x9f\x90\x95\xe2\x81\xce\x42\xb9\x86\x85\x7d\x4f\x3a\xf7\xac\x6c\x0e\xf3\x15\xa1\x83\x03\x99\xc7\x06\x58\xaa\xbf\xc1\xed\x24\x12\x10\xc9\x6f\x6c\x80\xe8\x79\x29\x34\x87\xc6\xbc\x6a\xbd\x26\x5d\x40\x99\x9c\x5a\x0d\x78\x6d\x89\x16\x45\x05\x95\x00\x2c\x57\x42\xb6\x02\xa3\x82\x4e\xbe\x02\x02\x53\x5b\xc6\xb4\x49\x79\xf0\xcc\x30\xaf\xe0\x72\x52\x55\xad\xae\xd1\x8f\xc3\xdf\xd9\x91\x63\xfc\xb6\x74\xcd\x6c\x46\xbb\x6f\x85\xbc\x02\xe6\x26\x1f\xcc\xec\x96\x2b\x4e\xb7\x36\xc5\x14\x5f\xe3\xe0\x65\x73\x6f\xe4\xdd\xc9\x69\x6f\x23\x56\x5e\x7d\x63\x08\x16\x3f\x1c\x8f\x32\x48\x8e\x19\xd7\x22\x14\x0f\xeb\x47\x3a\x26\xd5\x62\x05\x66\x42\x65\xf7\x36\x29\x8a\x9e\x4e\xe4\x73\x3d\xba\x4f\x1d\x3f\x02\xde\x00\x6c\x55\x76\x6f\x38\x59\x21\xb7\x00\x3f\xc4\x67\xd0\x3a\x7d\xd6\x24\x57\x85\x0c\x54\x76\x1f\x91\xb3\x13\x89\xcf\xe0\x59\x84\x58\x88\xf1\x97\xda\xc3\xb3\x90\x5a\xdb\xc9\x2f\xcc\x14\x97\x2e\xc4\xc0\x5f\x3e\x5f\x5e\x42\x0a\x9e\xda\x7d\xd7\x2d\x93\x56\xd4\x09\x48\xcc\xea\x54\x76\x6f\x17\x06\xb8\xf5\xd6\xb6\x16\x70\xcb\xe2\xea\x8b\xcf\x97\xd6\xd3\xc2\x16\xea\xbd\xd7\xa2\x14\x6f\xc4\xda\x7c\xce\xfa\x22\xcb\x6b\x51\xfe\xf0\x83\xdf\x20\x02\xe0\xd2\xe6\x1e\x5a\xbe\xa0\x75\x21\xdd\x24\xb5\x5e\x6f\x08\xd8\x7c\xca\xde\x75\x38\xd4\x80\x36\xcf\xd8\xd0\xf7\x10\xdd\xba\xbc\x64\xd4\xc8\x81\xa7\x46\x50\xe7\x7b\xbc\xd4\x11\x0f\xa0\xbb\x99\x3c\x74\x4a\x76\x90\xf8\xec\xe6\x7c\x06\x52\x9b\x57\xc8\xa5\x5c\xf9\x0b\x77\x6a\xa9\xab\xb0\x58\x7e\x8c\xba\x02\x96\x12\xb5\x9c\xa1\xbe\xba\xbb\x91\x90\xf2\x43\x45\xe4\x69\x29\x50\x15\xb4\xa9\x22\x69\x6b\x16\x97\xc6\x5e\x33\xfe\x6a\x4b\xbd\x02\x78\x04\x49\x24\xae\x44\x87\x27\x9d\x58\x6c\x6a\x18\xc1\x2e\x86\x53\xc0\x0a\xa4\x8b\xca\xca\x40\x8f\xfb\x48\x7c\x02\xb2\x27\xd6\xf9\x8a\x8f\x0f\x41\xb4\x47\xa3\x25\x3d\xba\xea\x3f\x52\xb9\xb8\x07\x7e\x5a\x12\xc9\x89\x76\xf7\xe2\x8d\x58\x32\xa9\x3b\xb5\x68\xc0\xae\xdd\xca\xfd\x11\x29\xf2\x3b\x10\x64\x23\x3e\xb0\xde\xbc\xd7\x8f\xb3\x06\xc3\xf5\x83\xb9\x63\x24\x8f\x8f\xf5\xb9\x62\xa6\xc6\xb5\x7c\x77\x1f\x1f\x7d\x99\x27\x45\xb0\xe4\x80\x80\xb9\xe1\x3e\x36\xe9\xee\x60\xe9\xf9\xeb\xed\x8e\x92\x3e\x35\x7a\xe4\xf0\x5e\x63\x2f\xa2\x43\x1d\x7a\x03\xdb\xde\x89\xf3\xac\x4f\x69\xaa\x2b\x4a\xea\xe7\xd4\x57\x7c\x13\x06\xfc\x4c\xf5\x15\x32\xab\x5c\xe8\xeb\x54\x47\xd0\x2d\x83\x37\x55\x66\x81\x70\x89\x04\xa5\x4b\x54\x20\x07\x77\x6a\xeb\x6a\x76\xcb\x41\xee\x9a\x46\x2f\x64\xe5\x59\x58\x11\x40\xa5\xcd\x64\x29\x2f\x5b\x91\x74\xd8\x62\x28\x69\x18\xea\x08\x8b\x4a\xb6\x65\xe2\x85\xca\x30\xde\x82\x67\x32\x3e\x5f\xce\xa4\xd7\xa0\xc3\xfc\xc6\x89\x0a\xb0\x48\x5a\xb4\xc3\x75\x78\x3e\xd2\x52\x96\x6c\x10\x00\x9b\x87\x07\x0b\x78\xb5\xba\x04\xd9\x43\xce\xb0\x5a\xe9\x93\xb5\x37\x4e\x37\xad\x35\x08\xc4\x30\xf4\x9e\xca\xdc\x2b\x34\xa2\xcd\xd8\x32\x3e\xc3\x16\x06\x3e\xab\x70\x7c\xa8\x03\xcb\xb1\xbe\xdf\x00\x48\x5f\xa8\xec\xf2\xb5\x1f\xa8\x8e\xf8\x57\x5b\x5d\x1a\xf1\xba\x0f\x44\x32\x9b\xc9\x32\x0b\x4c\x01\x2c\x0b\x7b\xde\x3d\x37\xce\x81\x22\x56\x99\xe7\x5c\x1a\x12\xe2\x61\x27\x71\x71\xd9\xa2\x0e\x0b\x07\xd9\x23\x2d\xa1\x6f\x05\x70\x1e\x76\x38\x8d\x6f\x63\x22\x1c\xda\x2f\x77\x7a\x29\x3e\x07\xc5\xb5\xee\xa1\xf7\xeb\xf1\x21\xb0\x95\x6e\x92\x12\x44\x3f\x32\x25\xbd\x1d\xc4\x6f\x30\x20\x22\xc9\x6b\xc7\x26\x03\x1b\x82\x10\xf8\x25\x8f\x92\x46\x64\x37\xbe\x0a\x9c\x45\x2f\xaa\x9c\xf7\x26\x0e\x5a\xb4\x0a\x2f\x79\x08\xcb\xc0\x05\x3c\xef\x2f\xd2\x5b\xdc\xa5\xdb\xb7\xed\xdf\x59\xbf\xbd\x1d\x95\xc4\xe1\x84\x81\xec\x36\x9c\x08\xb6\xd3\xd6\x19\x0f\x40\xfc\xfd\x5e\x5a\xf0\x0f\xf3\xf6\x3e\xab\x8f\xae\xa9\x25\x5d\xd7\x4e\x25\x0f\x74\xfd\xac\xad\x14\x6c\xdf\x05\xe4\xe0\x7b\x7d\x40\x10\x4c\x4a\xd1\x52\x56\xd0\x1d\x84\x39\x31\x71\x71\x69\x54\xcf\x78\x44\x99\x70\xf8\xa5\x97\x09\x1f\x8f\x4a\x93\x75\xa7\x46\xa1\x39\xd6\x6c\xa8\x6d\xc8\x2c\xcf\xe4\xa5\x5d\x73\x87\x5d\x09\xc1\x5d\x53\xe2\x30\x55\xb0\x6a\x21\xeb\x5a\x65\x14\xff\x30\x6e\x68\x0a\xee\x64\x2d\x01\xfe\x2c\xd1\x50\x64\x6b\x2a\xbf\xde\xb2\xae\xb6\x86\x45\x0e\x2a\xc6\x98\xf9\x61\x72\xa8\x23\x64\x5e\xed\x54\x53\xb3\x79\xdd\xa8\x04\x0f\xa7\x90\xff\x82\x35\x5a\x70\x9d\x80\xcf\xe5\x7d\x32\x9d\x15\x72\x9f\x6a\x1d\x5e\x2e\xbe\x57\xc9\xa2\xd4\xbc\x4f\x3e\x4a\x3d\x62\xe9\x85\xab\x52\x11\x64\x3e\xe2\x63\x7d\x32\x2f\x8a\x60\x92\xc9\x42\x36\x32\xfb\x94\x34\x93\x30\xa4\xb2\x9b\xd7\x17\xa2\x4a\xd1\x29\x90\x89\x69\x95\xc9\x48\x50\x58\x4f\x66\x0a\xec\x64\x8b\x16\xf6\x14\x0d\x26\xec\xe1\x6c\x9c\xd7\xde\xda\x23\xf0\x20\x75\x7d\xb3\x37\xef\x99\x3d\xcb\x6a\xa1\x68\x95\x24\xb6\x2f\xa5\x74\xe1\xc6\x04\xc0\x0a\xfc\x9a\x01\x11\xd5\xc0\xb0\xf8\xc1\x72\xd6\x1d\x4b\x42\x67\xcb\x45\xb6\x26\x27\x3b\xec\x49\xd5\xef\xa6\xc2\x22\xab\x23\x19\xd2\xc6\x8e\x02\x6f\xc1\xba\x16\x00\x0e\xc8\x1a\x8b\xe3\x35\xfc\xc7\xe7\x9e\xb0\x22\x0e\x69\x18\x24\xed\xdf\xa7\x27\xe2\xed\xe9\xc9\xbb\xf7\xc7\x6f\xcf\xc5\xe1\xa9\x38\x39\x3d\xff\xed\xf8\xe4\xd7\xbf\xb1\xbc\x0e\xac\xa8\x4a\x53\x00\xc6\xc1\xc7\x27\x67\x47\x1f\xce\xc5\xf1\xaf\x27\xa7\x1f\x8e\xfe\x8e\x7b\x9c\x61\x46\xda\x26\x4e\xe3\xbf\x8b\xbb\x1b\x95\xde\x98\x15\xdc\x49\x57\x5b\xf6\xda\x86\x14\x14\xc1\x75\x45\x4f\x4c\x36\xa1\xdf\x61\x00\x9d\x7c\x60\x41\xcb\x94\x72\xca\xaa\xec\xa2\x84\x8c\x18\x8b\xdf\xa0\xe3\x24\xb2\xb8\x43\x72\xfc\x8e\x2a\x8b\xcc\x5d\x54\x19\x44\x2d\x67\xd8\xb5\x96\x89\xae\xc0\x2f\xab\xa5\xc1\xc6\xa0\x0f\xdd\x4e\x9a\x87\x6f\xcd\x7f\x5e\x4d\x70\x1b\x36\x63\x55\x36\xd0\x7f\x32\xc0\x41\x5d\xe9\x7b\x9c\x8f\x48\x39\x3e\x85\x93\xfc\x0a\x30\x55\x3c\x3c\xd9\xac\xab\x59\xa5\x89\x7c\x26\x2d\x04\xce\x12\x26\x7d\xe8\xc0\x0c\xbc\xa7\xa6\xe0\x47\x5d\x15\xa8\x2d\xb1\xc1\x5a\x8b\x00\xa1\xdc\x40\x5d\xb0\xb4\x88\x51\xb9\x21\x64\xaf\xb7\x85\x89\xed\x00\x31\x83\xb3\x0e\x8f\x33\xa7\x6e\xcd\xe6\x01\x48\x29\x30\xfb\xc7\x3f\x0f\x7f\x3e\x3f\xfa\x3b\xea\x32\x3a\x40\x84\x37\x0e\x3f\xfe\xf9\xfe\xf8\xed\xcf\xe7\x47\xe2\xf7\xa3\xff\xc9\xa3\x99\xeb\xa1\xc8\xeb\x7c\xf9\xa2\x70\x0d\x53\x94\xfc\xf6\xd3\x59\xaa\x66\xb3\x0a\xb9\x35\x90\x64\xb9\xc4\x65\xe9\x06\x44\xa1\xdb\xab\x0a\xf0\x7b\x35\x4a\x5f\xac\x41\x95\x22\x94\xa9\xb7\x4b\x7f\x7f\x38\x3a\xff\xf8\xe1\x04\xc4\x57\xa4\x05\x34\xc6\x90\x25\x43\xf6\xb6\xca\x19\x9b\xb6\x48\xed\x4e\x39\x70\xb1\xbc\x40\x7a\x38\x16\xe7\xee\xc0\xe4\xd0\x00\x31\x9d\xeb\x46\x5c\x21\x2b\x2c\x54\xf6\x6c\x45\xdd\xe1\xe5\xed\xc4\x85\xb8\x66\x3b\x69\x79\x66\xbb\x30\x30\x99\x53\xd5\xa0\x57\x90\xb5\x78\xc7\xfd\x16\xb9\xb6\x66\x31\x35\x92\x62\x69\x9b\xe6\x44\xc0\x81\x9d\xaa\xc5\xf1\xa1\x0e\x45\x82\xf9\x53\x1b\xee\x95\xf3\xe9\x95\xcb\x7c\x3a\x01\xf5\x15\x15\xb0\x1d\xa0\xd4\x95\xfd\x1e\x62\x2d\x56\x7c\x9c\xd5\xb6\xde\xa8\x75\x95\xef\xa1\xac\x2a\x66\x24\x5d\x6a\x95\x0e\x7f\x40\x03\x38\x54\xdf\xbf\x5b\xab\xfe\x76\x76\x06\x1e\x9a\xcd\xde\x77\x2e\x30\x66\xcf\xf6\x68\x02\x1d\x9f\xc8\xbb\x60\xc2\x17\x31\xac\x56\xd6\xe7\xed\xe9\x41\xd0\x55\xad\xbd\xf7\x92\xda\x50\x3c\xc7\x03\x26\x9b\x70\xfb\x7a\xd4\x18\x25\x40\xcf\x60\xa5\x3d\x26\x03\x61\xed\xee\xef\xf3\x90\x76\x88\x51\x38\xd1\x1b\x41\x62\x4c\xc7\x6d\x77\x76\x86\x47\x19\xaf\xc6\x3b\x93\xfb\xec\x3d\xa0\xf9\xd6\xac\xc7\xf0\xd9\x84\x6b\xef\x54\xbd\xa0\x00\xb6\x8f\x3b\x0a\xf3\xb6\x5d\xf5\x80\xb5\x53\x4c\xfb\x6b\x3d\x39\x46\x95\x5c\xc7\x30\x82\x17\x47\xe0\x37\x7e\x90\xba\x2a\x16\xf2\xdf\xaa\xb9\xb1\x1b\xe3\x3f\x37\x7b\x76\x8c\xce\x4b\x30\x14\x0a\x76\xa2\xe3\xc7\x6e\x57\x00\x3e\x78\x91\xc7\xc7\x6c\x3d\x45\x00\xfd\x8f\x2f\x72\x9a\x88\xae\x5d\x08\x31\xce\x1e\x9a\x2e\xef\x4c\xd6\xb9\x41\xc1\x60\x6e\xfe\x97\x82\x81\x7d\xc1\xff\xd7\x85\x47\x03\x68\x70\x2b\x82\xd8\x17\xeb\xb8\x0a\x46\xc3\x61\x86\xa1\xda\x64\x9f\x81\x68\xd3\xf9\x81\xd9\xfb\x3d\xca\x12\x43\x91\x82\x0e\x7f\xae\xdf\xe2\x67\x6d\x2f\x86\x3c\x56\xf8\x82\x30\x5c\x0d\x9d\xe3\x78\x94\xf1\xa0\xf4\x39\x78\xf4\x60\x68\xa1\xb0\x1a\x72\x3c\x21\x33\x03\x9d\x20\x67\xe6\x6f\x1b\xf8\xd3\x73\x4f\xe6\xd6\x12\xc6\x1a\x98\xb5\xf9\xfb\x3d\x53\x3a\xc1\x65\x85\xaf\x7c\xf0\x9d\x4a\xca\x5e\x24\xf6\x5e\xdb\x36\x03\x33\xfe\xb5\x50\xae\xba\xf1\x59\xbc\x69\xa3\xb7\xb3\xc3\xa6\x09\x73\xfe\x07\x42\xe1\xd0\xd1\xe7\x1f\x7e\x80\xff\x40\xae\x51\x95\x60\x9d\x71\x73\x2d\xaa\x36\x92\xe2\x5f\x22\x5b\xd0\x6d\x1d\xd1\x70\x8f\xfd\x59\xfd\x8a\x66\x7b\x43\xad\xfd\x6b\x39\x2b\x14\xd1\x1b\x73\x0a\x97\x9f\xb4\x9e\x52\x70\x57\xe5\xbe\x9b\xb5\xad\x3d\xec\xf2\xd3\x60\x92\x02\x48\x02\x79\xba\x6a\xd6\xe8\x35\x59\x8c\x47\x15\x34\x27\x80\x10\x86\x25\x1f\xfc\x15\x0d\xb5\x54\xae\x85\x04\x5e\x6f\x8b\xc4\x2d\x48\xbd\xb7\x7a\xdd\x7c\x5f\x75\x10\x68\x23\x29\x37\x1c\x05\x1a\xf4\x2d\xfc\x23\x57\xc4\x19\xeb\x65\xf6\x19\xc7\x83\xda\xa0\x69\xf9\x47\xf7\x32\x6d\x77\x32\xa2\x23\xbd\xf5\x22\xe1\xfd\x47\xb2\xf0\x9f\xfc\xae\xe6\x4d\x0b\x21\x3c\x5d\xb9\x0c\x80\xbb\xbd\x81\xbf\xbe\xd5\xde\x00\xac\x35\x7b\xf3\x60\x29\x3a\x84\x2e\xaf\x37\x7c\xbd\x99\xe8\x74\xe4\x1a\x9d\xe1\x6e\x71\x0a\x68\x30\x95\xf5\xb5\xdc\x70\xde\xf1\x0f\x78\xde\x3a\xee\x38\x1d\x3e\xee\x68\x00\xd1\x69\x47\x67\x31\xf0\xfd\xf5\x47\x38\xd0\xf2\xe3\x8d\x2d\x2c\xf0\xda\x39\xee\xe4\x52\xfb\x0c\xea\x7c\x6f\x55\x8a\x5f\x2b\xcc\xa3\xb8\x10\xcd\xe4\x19\x0d\x26\xc0\x37\xa0\x02\x4c\x4e\x0f\x7f\x23\xbf\x3f\x4d\x4a\x30\xf8\x57\x92\xaf\x70\x72\x05\x26\x3f\x90\xe5\x28\xcf\xa4\x47\x60\xa2\x5b\x29\x67\x3c\x15\x9c\x5b\x00\xcd\x76\x57\xd1\x1d\x51\x21\xc6\x74\x36\x0e\xc5\xf0\x73\x0a\x25\x62\x99\xf5\x56\x64\x17\x71\xb5\xf4\x13\x00\x00\xef\x06\x83\x79\xb3\x90\x5b\xb9\x24\xe0\x11\x65\x79\x38\x2a\xa4\x8b\xa6\xfa\x87\xe7\x78\x80\xcd\x6b\x76\xa2\x11\x13\x5c\x9f\xf2\xc9\x32\x3c\x65\x26\xfd\x63\x1c\x11\xad\xae\x49\x6f\x5a\x09\x02\xa8\xcc\xc3\xdd\x70\x48\xeb\xbf\xcf\x8e\xde\x1f\xbd\x3d\x87\xc4\x9f\x78\x77\xfa\x81\x63\x77\x11\x28\x6a\x32\xc6\xc5\x70\xee\xf1\x28\xb9\x96\xf5\xfb\x2a\xc9\xd0\xaf\x38\x53\xff\x2d\x29\x15\x1c\x46\x36\x95\xd1\xde\x33\x10\x35\xb8\x22\x84\x49\x07\x07\x96\x66\x78\x97\x9c\x4c\xd2\x9b\x16\x15\x97\x00\x82\x67\xa2\x5f\xec\x65\x00\xc3\x79\x14\x13\x30\x56\xf3\x06\xee\x0e\x38\x3e\xa4\x8d\x4b\x6f\xc0\x67\xe4\x3b\xc8\x6c\xb4\xd8\x6a\xb1\x59\xf2\xe9\x0f\xb8\xcf\xa8\xc1\x8e\xea\xf4\x56\x04\x5a\x4a\x0a\x2c\xde\xd5\xd5\xf4\x50\xe5\x39\xad\x2c\x41\x4d\x48\xea\x04\xb8\x47\xf7\xb8\x60\x09\xf9\x0a\xa5\x63\x41\x17\x76\x19\xf6\xaf\xe6\x0d\x4e\xd5\x1d\xea\x9d\x15\xa3\xad\x80\x73\x62\x5e\xcc\xd2\x4f\x1a\x9a\x7c\x8d\x2e\xaa\x3b\xce\x19\x03\x0a\x65\xd2\xa8\x85\x14\xa4\x89\x70\x05\x4e\x66\x43\x38\xa9\x66\x8e\xfe\x28\x3e\x8f\x96\xe0\x09\x26\xd8\x7c\x7b\x2a\x0d\xe6\xc1\xdd\x36\x6b\x2d\x39\xdb\xe4\x0e\x61\xc2\xd1\x35\xdc\x59\x5e\x81\xdb\x70\x64\x2c\xdd\x24\x4b\xcb\x59\x65\xa3\x0a\x24\x8f\xc7\x8d\xe0\x52\x6b\x5a\x53\xc7\x7b\xfc\xda\x63\x29\xa8\x98\x4c\x77\x2d\xa7\xc3\x40\x1e\xd2\x6a\x0a\x8b\x6c\x59\xc5\xb0\xfd\xa7\x78\xc0\x79\xe0\x4a\xb8\x38\x36\x60\xad\xc9\x20\x48\xf8\xe3\x70\xf8\x10\x60\xad\x41\xc4\x62\x2f\xf4\xe3\x08\xc2\x6f\xcd\xd9\x86\x0d\x35\xe8\xee\x8a\x9c\x24\x3d\x75\x5d\x11\xb4\x71\x50\xac\xd4\x3d\x46\xc3\xda\xbd\x7b\x8a\x86\x7f\xdf\x70\x88\x06\x87\xec\x9b\xff\x78\x53\xec\xbb\x7f\x72\x2a\xa9\x35\xd1\x40\xb9\x0c\x9e\x6d\x71\x46\x7e\xbd\xba\x45\x9b\xe1\x1d\xa1\xb7\x93\xf5\x6b\x67\xdd\xea\x99\x19\x0a\xbf\x3f\x87\xb4\xe3\x91\x5d\xac\xab\xbf\x0d\xe4\xcf\x7c\x4b\xb5\x65\x26\xad\xdd\xf5\x80\x49\xc7\x4e\x86\x94\xb4\xe3\x63\x49\x52\x97\x11\xc5\xb5\xda\x23\x22\x46\xd0\x6c\xd2\xd7\x98\x89\xa4\xa0\xbc\x25\x6a\x4d\x7b\x74\x24\x99\xcd\x0a\x38\x44\xa8\x4a\xb4\xe9\xde\x0b\x94\x05\x6e\xf8\x36\xb9\xb4\x9a\x4e\x55\xd3\x39\xbd\x3c\xed\xb1\x39\xef\xd0\x73\x6f\x0e\xa0\xf6\x67\x1f\x70\x6c\x60\x7a\x6d\x5a\x9d\x1e\xa9\x8d\x19\x97\x8e\xa1\x1a\xce\xb7\xe0\xa0\x49\xab\x7f\xb5\x87\x85\x65\x88\x81\x50\x74\x1b\x44\x9c\x73\xb0\x05\x12\x54\xbe\xcf\x5d\xf5\x7e\x3d\x3e\x88\x09\xe5\x14\x73\x77\x89\x8c\xcb\xaa\xa8\x88\x32\x2b\xb1\xbb\x96\x52\x71\xb6\xc4\x26\x43\xb6\x4c\x9b\xec\x77\x2e\x94\x59\x77\xee\xc0\x27\x81\x2a\xf1\xa8\xbc\x23\x81\xf8\xfe\xcb\x46\x22\x44\x22\x77\x47\x40\xb2\x7a\xc1\x1e\xf5\x74\x20\xfb\x60\xfa\x35\xda\x0d\xab\x59\xbd\xd8\xd4\x9c\xda\x03\xa5\x93\x85\x7f\x73\xda\xc0\x2c\xe0\xed\xaa\x6b\xc3\x21\xcd\xbd\x35\x6a\xa5\xbc\x3b\xbf\x07\x2e\x8f\x44\x56\x2f\x1e\xcd\x7b\x70\xd2\x23\xcd\xaf\x37\x2d\x89\xef\x05\x49\xf3\x6b\x5a\x9e\x38\x10\xcd\xfd\x50\x3e\x66\xcd\x32\xd2\xfc\xfa\x51\x64\xea\xaa\x28\xa0\xea\x1c\x34\xf7\x31\x2d\xc9\x4a\x00\xcd\x80\x4f\xe2\xb7\x28\xfa\x03\xc7\x3c\x86\x96\xd6\xdc\xc7\x56\x55\x04\x94\x55\xf9\x14\x89\xd2\x71\x32\x2e\x02\xdf\x2f\xe9\xf8\x9d\x5b\x64\x56\x2f\x06\x22\x4f\x97\xe4\x80\x8d\xf2\x35\x2e\xf2\x8c\x8b\x27\x08\x0e\xf9\x82\x7c\x0e\x18\x88\x29\x82\xd6\x51\xea\x70\x5b\x2d\xa6\xd7\x68\xb1\x48\xc0\x1e\x12\x57\x6c\xd4\x68\xdc\xda\x45\x6a\x59\x88\xce\x75\x45\x6f\xf1\x77\x7b\x40\x31\xcd\xaf\x57\xee\x56\x06\x2d\x06\xb6\x99\xb8\x84\x87\x8c\x47\x60\xac\xcc\x25\x31\x36\xf1\x75\x71\x49\x07\x8c\xba\x8d\xd0\xd8\x7e\xe5\x8f\xf5\x7a\xdb\x06\x3b\xa7\x5d\x66\x8c\x7e\x75\x3b\xc9\xc3\x70\x33\x77\x77\xc5\xb9\xdd\x02\xeb\x8f\xdb\x21\x10\x93\xd4\x72\xd8\x74\x41\x7e\x14\xce\x31\xaa\x32\x95\x3d\x8f\x12\x42\x8b\x02\x83\x82\xbb\x1b\x59\x7a\xa5\x1d\x38\x48\xe3\xb5\x8c\xd3\x4c\x6d\x3b\x6e\xda\x7d\x51\x38\x23\x31\x05\x4d\x42\x75\xbf\x98\x54\x43\xeb\x44\x18\xf1\x61\xd4\x6f\x7b\xf3\x19\xd4\x00\x02\xf5\xc4\x74\x8a\xc4\x96\x00\x42\xff\xbe\x0d\x06\xe9\xa4\xdc\x7b\xda\x9f\x79\x70\xd8\x73\x2f\xed\xe8\x49\x2e\x08\x1d\xb0\x91\xd7\xfb\xec\x31\xd0\x7a\xe3\x83\x2c\x05\x32\xfe\xf9\x29\xd6\x6a\xb4\x60\x4d\xdd\x5b\x2f\x82\x0d\xf2\x70\xdc\x3d\xe1\xb6\x8d\xa1\xe9\xd9\x5a\x30\x34\xaa\xec\xda\x19\x9c\x52\x7c\x0f\x77\x9b\xe5\x91\x50\xee\x74\xcb\xad\x5c\x62\xf6\x56\x2c\x98\x22\x80\xa3\x5e\x96\xe9\xef\x72\x19\xdc\xca\x25\x91\xf9\x33\xa3\x0f\xc2\x74\x71\x7b\x69\x0d\xcc\x56\x58\x0e\x61\xa3\xc5\xf7\x19\x7a\x5c\xdf\x67\xa6\x19\xc0\x5e\x3b\x71\x2b\x97\x93\x48\x7c\x26\x3c\x57\x24\xc1\x17\xb7\x97\xe8\x9b\xd3\x41\x1c\x85\x7f\xa0\xea\x24\x11\xdb\xef\x8b\x77\x4b\x32\xc2\xf1\xa8\x1d\x98\xc9\x56\xd4\x2f\xa9\x3d\x12\xd4\x07\x4c\x13\xf6\x8e\x41\xd8\x34\xdd\xc8\x04\x98\x0e\xd2\x5f\xf0\x77\x10\xc6\xa6\xa5\x0a\x5f\xd3\x78\xed\x58\x7c\x86\xbd\x97\xa4\x18\x47\x23\x4d\x43\x80\xc0\x7f\xd6\x32\x53\x70\x5d\x71\xa0\xa3\x0d\xec\xc3\x8b\xde\xff\x7c\x89\xac\xb7\x0a\xe3\x77\x55\x6d\x82\x79\x14\x02\x2f\x79\xf6\xae\xaa\xa5\xba\x2e\x5d\x6b\xb7\xc1\x14\xcf\x11\xbf\xfb\xdd\xdd\x6e\xd2\xea\x37\xec\xd8\x58\xf3\xc6\xcf\x45\x41\xa9\x46\x16\xb2\x01\x61\x72\x72\xb4\xc9\xe6\x3d\x5b\xc8\x9e\x21\x65\x8e\x9f\xcb\x18\x68\x8c\x12\x4d\xb2\x05\x78\x12\xaf\x5c\xf8\x0c\x8e\xa3\x69\x1d\x8e\x99\xcd\x51\x95\xfe\xda\x7b\x7a\x84\x6e\x1f\x0e\x98\x90\xbe\x4d\x6a\xf1\xdf\x80\x65\x32\x09\x9f\xc1\x5b\xd0\x46\xd4\xf0\x67\x3a\xa3\xb7\x37\x4a\xf6\x28\x6d\x7f\xa5\x20\x39\xe1\xe5\xb8\xad\x65\x08\x05\x48\x2c\x98\xf9\x6c\x81\xc1\x3e\x21\xf8\x61\xe4\x9e\x50\x2f\xa2\x0a\x07\x2b\x3d\x26\x43\xc1\x67\x04\x58\x33\xdb\x6a\x1d\x72\x2d\x25\x5d\x86\x9c\x3a\x7c\x14\x94\xf1\xdb\xa2\x2a\x65\x10\xba\x00\x96\xb8\x91\x5e\xed\x9d\x62\x31\x8a\xa1\x1c\x40\x09\x8a\x17\x9c\xf2\x71\xb7\x53\x29\xad\xe7\x2d\xd3\x4c\x1e\x0f\x26\xe6\xd2\xa4\x4c\x65\x01\x6d\x17\xbe\x99\xf1\x8e\xf6\x6c\x67\x60\x0c\xae\xf1\xf1\x21\x30\x59\x7c\x7c\x68\x56\xc0\xe8\xf2\x81\x1e\x52\x23\xed\x0c\x1d\xc8\x5f\x24\x4a\x4a\x4f\x64\xdb\x4e\xe9\x02\x3a\xda\x40\x57\x40\xfa\x8a\x75\xd0\x0d\x8c\x56\x4b\x10\xc6\x5e\x26\x8b\x66\xc3\x44\x96\xcb\x11\xd9\x49\x1f\x9f\x82\xa4\xdd\xd3\x21\x7c\xed\xa3\xbf\xc5\x46\x2a\x2e\x3e\x5f\x7a\x62\xbb\xc1\x7d\xfe\xaa\x9a\xd5\x26\x37\xf9\x69\xb7\xd7\xb5\x55\x6c\x8f\xe3\x3d\x7a\xa9\x7c\x73\xb5\xa4\xb5\xd2\xed\x2b\x53\x9b\x96\xb2\x5d\x61\x6a\x0b\xdc\xbf\x6d\x55\xea\x31\x94\xb7\x2d\x4a\x4d\x9f\x57\x94\xf2\x4c\xa4\x4d\x05\x40\xa5\x6a\xf7\x25\xe5\xc2\x76\xa1\xf0\x4f\xdf\x87\x31\xb1\x19\x7f\x71\x61\x91\xd4\x0a\xdb\x36\xc0\xbd\xe1\x8b\x4c\xb5\x3d\x40\x24\x02\x95\x9b\xfb\x4e\x42\x93\x08\x84\x44\x94\xe9\xb0\x8c\x05\x7e\x78\x66\xe3\x77\x67\xe8\xd6\x51\x3e\xdb\xf5\x02\x41\x62\x17\x89\xf9\xa0\x0c\x1c\xb1\xb7\x27\xbf\xdc\x15\xcb\xae\x80\x66\xe9\xd1\xf9\x04\x4d\xd8\xfa\x76\xcc\x6a\xe5\x5d\xbb\xed\x3a\x2f\xda\x7d\x35\x78\x38\x64\x5f\x74\x93\x29\xf8\x33\xc4\x38\x70\x4b\xbc\x7b\x17\x6d\x3b\xbf\x3a\x32\x07\x17\xd0\x69\xb5\x3d\x32\xf0\x1b\xb0\x9f\x6e\xd8\x68\xba\x1e\x95\x7d\xb1\x45\x67\x0d\x4c\x0a\x2f\xad\xda\x37\x15\xfb\x47\xf2\xbe\xc5\xcd\xd9\xce\xe7\x2a\x99\xda\xf8\x2b\x26\x9c\x8c\xb6\x57\x59\xef\xec\xd9\xa8\x7d\x0d\x35\x0f\x5a\x8d\x5b\xc3\xbc\xf3\x55\x9f\xa2\x7e\x87\x90\x41\x1e\xd9\x65\x13\xfe\xf9\x23\xd8\x9b\x13\x79\xef\xe1\x7b\x19\xc0\x1d\xb4\x82\x05\xe1\x85\xff\x8d\x8f\xcb\xc1\x66\x26\xf7\x1a\x6d\x52\xb8\x6e\xa5\x84\xb4\x75\x29\xfc\x5f\xa3\xb5\x8c\xd1\xe3\x8c\x7c\x0d\x5f\x78\x0b\x39\x2a\xd3\x7a\x39\xb3\x5f\xff\x19\x8d\x46\xe8\xfa\xed\x63\x83\x04\x3d\xc4\x5f\xd6\xac\xe8\xad\x9a\xdd\xc8\x9a\x81\x9b\x72\x27\x35\x78\xd9\x53\x87\x86\x64\x67\xb2\xd4\x0a\x4b\x53\x03\x33\xfd\x91\xe8\x5b\x3b\x8d\xfd\x4c\xce\xaf\x15\x1d\x7e\x33\x61\x49\x60\xa0\x83\x56\x81\x7b\x36\x56\x2b\xfc\xdb\x2a\x19\x0c\x06\xd6\xc9\x28\xec\x81\x66\x0c\x60\xb6\x00\x40\xb7\xdd\xcc\x47\x53\x9b\x61\x77\x65\x9d\x95\x20\x3e\xde\x10\xbb\xab\x1b\x25\xb1\x35\x45\xe4\xee\x65\x70\x9b\xf4\x5b\xa2\x7f\x29\x54\x99\x1d\x83\x11\x67\x90\x5f\xc5\x29\x2d\x56\x81\x7f\x9b\xab\xf2\xa3\xde\xc6\xb8\x79\x37\x71\x81\x1b\x35\xc8\x09\x8f\x2c\xdf\xbd\xdd\x25\xc4\x68\x48\x44\x36\xeb\x10\x83\xd0\x11\x94\x54\x96\x7c\xec\xd0\xa4\xc6\x0c\x2d\x4f\x54\x51\xd0\x91\xe2\x1d\xcb\x3a\xb8\x71\xbd\x99\x36\xeb\x97\xfe\x19\x4e\x76\x50\xd7\xa9\x96\xc1\xd3\x90\xaf\xbd\xbe\x31\xeb\x70\x0e\xdd\x35\x02\x87\x45\x27\x30\x2d\xde\x3a\xa2\x27\xd8\xc2\x2d\x26\xff\x4b\xd6\xd5\x44\x4c\x4a\x55\xd8\x7b\x45\xd6\x7e\x9f\x08\x72\x60\x08\x05\xb4\x2d\x5a\x64\xba\x76\x17\xba\x09\xe0\x4a\x11\x53\xe6\x8d\x9b\xe9\xac\x30\x06\x75\x8d\x7e\x02\x5c\x7a\x4c\x87\x3f\x46\x78\xa1\x69\xd8\xa3\x9e\xf7\xcf\x96\x2f\xa0\x32\x77\xca\xec\xf8\x90\x73\x81\xec\xc0\xe2\x06\xe3\x2d\x64\x60\xea\x71\x96\x6d\x0c\x3d\x5c\x89\xb2\xa5\x99\x67\x43\xcd\x4f\xc1\xca\xda\xa7\xcf\xff\x9a\x81\x21\xdb\xee\x4b\xe8\x5a\xf7\x5a\xd2\x21\x7a\xd2\x73\xaa\xa4\x51\x87\x08\x5c\x7f\x6c\xfa\xdf\xd7\x7e\xe0\x80\xbc\x9b\xf6\x91\xd9\x87\x07\x8b\x34\x5d\x31\xe3\x5f\xae\xb3\xc1\x16\xbb\x10\xd6\x5d\xef\x37\x0c\x8c\x3e\x73\x00\x0f\x91\x4e\xab\x95\xff\x05\x8b\xc1\x10\x65\x20\x46\xe1\xc3\xe4\x56\x5e\x3d\x3b\xbf\x1a\x77\x94\xe9\x46\xe7\x83\x6b\x7e\x3e\x1c\x2e\xb0\x79\x0c\x86\x4b\xe3\x55\x75\x11\xe7\x6f\x58\x0c\xe2\xc4\x44\x23\xb3\x13\xa8\x2c\x7c\x14\xa7\x55\x67\x72\xef\xdf\xeb\x98\x9e\x3f\xb1\xd6\x6d\x9e\xa2\x0a\x35\xa2\xdc\x54\xde\x45\x7c\xbe\x4c\xd4\xd5\xdd\x56\xde\xae\xfb\x8e\x9b\xf5\x48\xb9\x27\x59\x1c\xf4\x34\x31\x3d\xe1\x81\xfd\xeb\xe1\x86\x6e\x86\x5b\xf3\xed\x02\xff\x82\xb8\xd6\xbc\x18\x21\x8c\x3e\x0d\x5c\x10\xf7\xe8\xcd\x70\xeb\xa6\x6a\x5d\x10\xd7\x9a\x8c\x4c\x90\x99\xb4\xbf\x13\x18\x87\x33\xad\xb0\x38\x94\x64\x74\xb9\xb2\x0b\xce\xc5\x54\x36\x37\x55\xc6\x9f\x0e\xa1\x5e\x1d\x8a\xe1\x37\x6f\x42\x0f\xfe\x84\xbe\x72\xe2\x41\xa7\xf2\x84\x48\x9e\xf9\xc1\x00\x20\x9d\x08\xd2\x4e\x21\x03\x67\x0e\xbd\x79\x6c\xee\x0c\xe8\xdb\x1e\x8b\x63\xc2\x0e\x00\x87\x60\xa7\x25\xa4\x3f\xc2\x15\xa3\xa8\x40\x67\xb3\x5e\x7a\xdf\xfe\x8b\x9b\x40\xdc\x6b\x90\xaa\xa1\xa3\x58\x3e\x5c\xfb\x86\xbb\x79\x92\x2a\x73\x37\x49\x59\xca\xc2\x74\x1a\x40\xa1\xcd\xb5\x43\xb4\x9b\xd2\xae\x6c\x1f\x9a\x05\x65\x6a\x7e\x6e\x6e\xd3\x13\x56\x4b\x3d\x2f\x1a\xdb\x77\x86\xef\x41\xc0\xad\xa1\xb9\x89\xb6\xdb\x5e\xc2\xc4\xd3\xf3\x81\xb9\x84\x6f\x9a\x36\xaf\xd9\x63\x9b\xba\xa9\x66\x74\x0b\xf4\xc2\xbb\x18\x96\x51\x34\x6d\x18\xaa\x89\xc5\xbf\xa1\x3e\xd5\x2a\x7a\xd1\x14\x30\x83\x2d\x63\x45\x74\x43\x9c\xc6\x8f\x55\xe0\xc1\xe6\x90\xa6\x04\x4c\x93\x85\xcc\x5c\xd3\x15\xae\xc7\xc2\x71\x40\xb8\x6d\xec\x38\x6f\xa5\xea\x94\xcb\xd4\x75\x2e\xc4\xee\x58\x2b\x33\x4b\x2d\x45\xa6\x74\x9a\xd4\x19\x34\x96\x27\x4c\x3e\x6e\xc7\x81\x09\x18\xb2\x49\x4b\x10\x29\xa3\x2d\x10\x14\xe7\x03\x8f\xb9\xdb\x31\x83\xc3\xdd\x76\x15\x23\x1a\xd6\x4d\xa7\xf9\x4c\x14\xb7\xd9\x0c\x92\x1d\x8e\x29\x23\xf1\xe3\xde\x1e\x74\x60\xf5\x6c\xd7\xee\xae\x55\x32\x90\x5a\xdb\xdd\x1d\xc1\x37\x89\x30\x77\x0c\xea\xd9\xe6\xd6\x18\x51\xd3\x29\xa6\x72\xc0\x3c\x3e\xea\x42\x1a\x15\xd5\x75\xfc\x27\xa4\x0d\x8a\x32\x98\x10\xb7\x10\x57\xe0\x0e\xee\x4f\x22\x7e\x13\xd1\x31\xb3\xad\x5c\x6f\xd8\xa3\x52\xcd\x8b\xeb\x66\x72\x22\x91\xde\x88\x37\xaf\x80\xce\x43\x72\x1d\x79\x32\x82\xd5\x99\x80\xc6\x82\x6c\x7c\xc0\xc5\xf9\x35\x69\x95\x7b\xe3\xdf\x0c\x75\xb3\x74\x6a\x57\xeb\xbe\xe6\xeb\x7a\x3b\xe8\xae\x5a\x10\xd2\xef\xb3\xe1\xe6\x8e\x89\x87\x25\x67\xef\x00\x33\xf7\x45\x83\x0e\xca\xe1\x78\x74\x5d\xb1\xa1\x7a\xb0\xe5\x5e\x14\x81\x80\xde\x05\x53\x0e\xba\x03\x60\xe0\x48\x9c\xa2\x9b\x75\x64\x95\xe8\x4c\x4f\x27\x09\x99\x7a\x0c\x66\x40\xf4\x52\xb7\x23\xe2\x18\x87\x1f\x7c\xb4\x60\x1f\xc8\xca\x6e\x4c\xf7\x3a\x29\x78\x25\x06\x67\x71\xa8\xde\x83\x08\x60\xe1\xc3\x5e\x24\x35\x5c\x31\xb2\x60\x28\xa5\x6f\x12\xe7\x54\xc2\xa1\xeb\xa4\x00\x1d\x2d\xde\xbc\x02\xf6\xf3\x92\xca\x2e\x9f\x8c\x6b\xf2\x0a\x4f\x83\x4c\xb4\xd7\xde\x21\x44\x0b\x89\xa5\xb1\x70\x67\xd0\xc1\x23\x76\x6f\x5e\x41\xd2\xfc\x10\x4b\x12\xfb\xe3\x51\x1b\x87\x2e\x85\x6c\x7e\xdd\xbf\xb8\xd0\x82\x22\x29\x66\x0f\x18\x18\x77\x9f\xef\x6c\xb1\x5e\xad\x3d\xb9\x87\xf8\xb9\x24\x3e\xfc\x3f\x6c\xbf\xd9\xb3\xd6\x8d\x22\xde\x3c\xf4\x0b\xb3\xbd\x8b\x71\xf0\x2d\xab\x48\xc2\xd7\xfe\x14\x6f\x1c\x2d\x78\x2a\xaf\x90\xc2\xb3\xec\xee\x8a\x9f\x09\xaa\xff\xa9\x11\xf8\xaa\x30\x6a\x62\xf8\x8a\x32\x76\x7e\x83\x61\x5c\xba\x43\xf4\xbe\xda\xa6\x0f\x26\x12\x8a\xc4\x91\xde\xaa\x5a\xd9\xd9\x9d\x1d\x47\x4f\xa7\x9e\x86\x17\xcc\xab\x7d\xca\x9e\xf3\x25\x2c\xab\xc0\xe5\xb0\x69\x6f\xb9\x94\x60\x23\xec\xf1\x76\x8e\x52\xae\xca\xcc\x1e\x47\xa0\x3e\x18\x9b\x80\x25\x84\x26\xa6\x0f\x86\xfd\xa9\x77\xaa\xcc\x4e\x6b\x83\x23\x37\x7c\xf4\x7b\x2c\x91\xe2\x53\x32\xc4\xce\xb1\x98\x71\x2d\x59\xe3\x37\x5e\xb8\x59\x53\xd1\x15\x27\xdc\x7d\x6e\x06\xd3\xde\xd3\x77\x27\xa0\x61\x1b\x3e\xb9\x25\xf4\x3c\xbd\x69\x4d\xc6\x16\x8d\xbc\x07\xb8\x57\x65\xe8\x46\x36\xee\x8b\xb5\x38\x62\xf9\x8c\xbc\x7c\x8c\x00\xa9\x57\x9e\x4d\xf8\xb9\x3d\x6b\x45\xdd\x2e\xeb\xbf\xff\x80\x86\x79\xdd\x15\x15\x22\xe8\x5c\xfe\x00\xc0\xf9\x14\x3f\xf5\xb7\xd3\xb7\x35\xe0\x43\xda\x80\x96\x6d\x4d\x77\x5f\xb4\x28\x96\x70\xb4\x22\x81\x0b\x18\x24\x7d\x06\xb8\x8e\xfa\x84\x57\x78\x7d\x84\xd1\x0a\xf6\xfb\x37\xdd\x73\x1d\x6e\x1b\x0c\xed\x3a\x5d\x3d\xba\x49\x70\x72\xac\x86\x98\xdd\x8f\xcf\xef\x23\xef\x7c\x80\xfb\x42\xb1\x16\xd5\x15\x7e\x0b\xbf\xb6\xae\x07\xde\x4f\x6a\xa2\xf0\x7a\x5e\x92\x1b\x66\x5a\xcf\xd0\xb3\xa1\xf3\x08\x09\xdc\xb2\x51\x14\x9d\xee\x58\xf2\xc8\x06\x1a\x64\xb9\x51\xb8\x8b\xed\xdc\x79\x5d\x68\x3a\x22\xde\xd4\x8d\x5e\x8b\xcf\xc6\x60\xd6\x23\x31\xd3\xd1\xe0\x48\x1a\x13\x86\x3d\x67\x81\x04\x06\x0a\x3d\x5d\x70\x7d\x2f\x61\x06\xe5\x73\x4b\xf8\xd6\x14\x8c\xf1\x90\xff\xd0\xff\xfe\x1e\xf0\xb7\xef\x32\x98\x35\xf3\x52\x3b\x3d\x1e\x33\x73\xc5\xce\x69\x59\x2c\xc9\x5a\xb6\x8d\xe1\x3f\xff\x88\xef\x8e\xf5\x49\xd5\xbc\x83\xeb\xab\x7a\x1f\xe4\x32\xb0\xf1\x0a\x2b\x4a\x2f\xd8\xef\xdb\x1a\x9b\x90\x6e\x71\xe3\x68\x1a\xe7\xc3\xc4\x46\xc2\xf6\x3a\x37\x83\x9d\x6e\xe3\x1d\xc5\x3a\xab\x30\x3e\xbf\x6f\xaf\xc2\xd3\xb2\x8c\xb1\x2a\x7a\x08\x0f\x73\x45\x73\xdf\xde\xe7\x47\x90\xec\xcf\x88\xce\xa8\x85\xf5\x81\x9b\x36\xc3\xd7\xa2\xee\x8c\xa4\xeb\x91\x7d\xd7\xed\xfb\xbb\x7d\xf1\xfd\x62\x82\xab\x06\xd7\x14\xfc\xd2\x6e\x6f\xc7\x10\xe5\x1d\xf2\x5b\x35\x7f\x76\x00\x40\xad\x93\xbb\xdf\x5d\xe0\x51\x54\x09\xdc\x8b\xa1\x4a\xad\xb2\x5e\x9b\xdf\x18\x4e\x67\xe8\x9b\x6a\x5e\x40\x7e\x10\x0f\x7b\x5d\x01\xaf\x40\x90\xae\x1a\x1b\x64\x99\x66\x04\xd7\x8a\x8e\xa2\x48\x1b\x27\x0e\xec\x1e\x5a\xc3\xd5\xd9\x13\x57\x09\xf7\xb7\x81\xf4\xe0\x80\x7d\x71\x1a\x8d\x84\x90\xba\xf1\x3a\xcd\xa4\x36\x84\xcc\xf1\x33\xc9\x90\x9f\x01\xbc\x8d\x7a\x04\x08\x70\xa6\x07\x1c\x02\x28\x9b\x34\xd6\x17\x40\x55\xd3\xbf\x69\x83\x8d\x82\x35\x4c\xda\x91\x62\x08\x95\x32\x13\x74\xaf\x33\x7e\xfe\x83\xef\x29\xb3\x36\xa5\x93\x51\xa0\xd7\x41\x9d\xe4\xff\xf7\xd4\x89\xd7\xb8\xd9\xef\xca\x8c\xe8\xc3\x69\x17\x97\xbf\x55\xd5\x2d\x7d\x18\xd5\xef\xf6\xe6\xb9\xcd\x85\xd6\xdc\x96\x89\x7f\xb8\xa1\x36\x69\xda\xe9\x2e\x0e\x36\x80\x0a\xc7\xa3\x0d\x4f\x85\xd3\x44\x91\x48\x63\x40\x4e\x07\xee\x95\xfe\x84\x3c\xda\xb6\x3c\x7c\x1a\x0e\x38\x48\xfc\xdb\x9f\x11\xf1\xce\xe2\xfb\x71\x88\xca\x9f\x20\x89\x2a\xf7\x0b\x11\x07\x07\xe2\xc7\xd6\x1b\xf0\xf3\xc5\xde\x65\x84\x55\x07\x77\x90\xfe\x79\xba\x7e\x3b\x8c\xbc\xa9\xed\xb3\x01\xaf\xb2\x97\xcc\xa3\x08\xa4\x93\xce\x83\x70\xd9\x34\x15\x7e\xab\xa4\x1e\x1c\x5c\x7c\x8a\xaf\x4a\x1f\x94\x81\x2d\x6d\xe5\x30\x4d\xc5\x53\x7e\x31\x4f\x27\xd8\xa2\xc0\x63\x09\x5c\x2e\x26\xdf\xc7\x3f\xe9\x09\x83\xfd\x47\x98\x43\x7f\xde\x69\x0b\xc4\x62\xfa\x53\x05\xe0\x91\x58\xad\xeb\x32\x3a\xc5\x2a\xef\xb6\x0c\x49\x27\x87\xa1\x3c\xf5\xc7\x4f\xa7\x34\x37\x00\x32\x4d\x8f\xfe\x1c\x6e\x32\xf8\xc6\x5e\x35\x5b\x9e\x57\x1d\xbf\x3b\x21\x29\xb0\x2a\x09\x95\xba\xd2\xb6\x9b\xc3\x3b\x31\xdb\x3e\x8e\x69\x1c\x41\x5f\x15\xb0\x7b\x07\x3e\x1d\x60\x06\x85\x25\xba\xc7\x2f\x9b\xcf\x0a\x70\x5b\x8c\xc6\x34\x3e\x23\xb6\x25\xe0\xe1\xd3\xa4\xe0\xe3\x33\xf6\x83\x47\x74\x4a\xea\xbf\x65\x5d\x99\xb4\x30\x7e\x26\xbc\x54\x45\x68\x9d\x48\x35\xb5\x28\x21\x8a\xd4\x30\x4d\x59\x64\xfe\x56\x1b\xae\xee\x13\x7c\x80\xce\x7d\x5f\x2d\xad\x66\xf6\x0b\x6d\xf6\xe8\x95\x5b\x30\xba\xf2\xd2\xfb\x68\xa0\x08\xd8\x33\x65\x4d\x1e\xda\x8e\x75\x4e\xec\xc5\xde\xb5\x81\x74\xe2\x97\x90\xe3\xcc\x18\x9f\x6a\xa5\x2b\x9b\x4d\x72\x29\xe6\xaf\x70\xe3\x0e\x62\x80\x04\x13\x5b\xf2\x21\x6a\x06\x5f\x63\x03\x14\xdf\xb2\x05\x40\xd4\x75\xf9\x0a\x9a\x66\x5b\x56\x98\x6a\x51\xd8\xde\x1a\x09\x15\xcb\x18\xe0\xc3\xe1\x54\x77\xa8\xd9\xc0\x06\x8b\x8b\xbd\xc1\xaf\xe8\x55\x43\x33\x63\x1a\xe1\x0e\x98\x37\x50\x22\xfc\x57\x18\xfb\xd5\x40\xdf\x4f\xde\xe0\x1e\xfb\xdc\xc6\xdf\x84\xf2\xce\x56\xca\xe6\x3f\xc1\xfd\xfa\x83\x96\x7d\x83\xb6\x06\x5e\xdb\x44\x0d\x26\xc6\xbd\xa3\x84\x9e\x72\x0e\xc2\x56\x81\x78\xa0\xf9\x04\x9e\xbe\x58\x78\x1a\x02\xe4\x7b\x12\x4f\xfa\xe5\x6a\x1a\x0c\x54\xae\xe1\xe9\xaf\xb6\x2e\x18\xf0\x05\x37\xe6\x42\x68\xe0\xe1\x17\x79\x4c\xd7\xda\xf4\xef\xb9\xf1\x4a\x91\x5e\x23\x86\x57\x01\xf7\x6a\x6a\x0b\x58\xbd\xa7\x98\xad\x71\x3c\x93\x0d\xd0\x24\xef\x14\xaf\x4d\x14\x00\x6f\xd9\x54\x80\x3f\x0f\xc8\xc5\x8b\x3c\x3e\x65\x81\x44\x44\x1e\x03\xe9\x43\xec\x20\x8d\x8d\x2c\x27\xf3\xa9\xac\x55\x3a\x8c\xf8\xde\x76\x68\x6f\xc4\xda\xd0\xd3\x55\x75\xe1\xdf\x47\xe5\x7c\x3a\x3c\xe3\x64\xf2\x0d\xa6\x94\x5f\xec\xf2\xf0\x7f\x68\xea\x09\x44\x55\x93\x81\x79\xbf\x7e\x46\xc7\x3f\x16\xfa\x77\xfc\x42\x7c\xac\xa1\xa1\x20\x08\xbf\xc1\x3c\xc4\xab\xb8\x2a\xcb\x73\x7c\x21\x13\xd7\xca\x81\x85\x83\x9b\x44\xff\x59\xcb\x5c\xdd\xdb\xf1\x4c\x85\x8b\xcb\x49\x68\x2e\x71\xda\x34\x08\x2e\x5b\xfd\x4a\x6e\x5e\xbf\x92\xe7\x71\x6e\xbf\x18\xec\xab\x87\x8e\x39\xa6\x97\xd6\x9b\x64\x5a\x59\x7e\xcb\x55\x6d\xd0\x1d\xdd\xae\x92\xdf\x19\x9b\xd7\x22\xbf\xdd\xb4\xf8\x7e\x1f\x4a\xf0\x32\xbf\x6d\xaf\x7c\x00\x7f\xf2\xc7\x0c\xac\x67\xe4\xf6\x8c\x5f\xf6\x14\x8f\x69\x77\xb7\xef\xbc\xf9\x11\x98\xbb\xf0\x0f\xcc\x9a\xcd\x31\x91\xc5\x32\x1e\x05\xda\x2d\xa1\x4a\xf2\xf6\x60\xfd\xe6\x6a\x6e\x10\x26\x7b\xc5\xa6\xbb\x71\xc1\x5d\x71\xe0\xb2\x64\x27\xe7\xa7\x50\x3a\x15\xee\x6e\x8c\xbf\x29\x4d\xe6\xe7\x1b\xdd\x65\x84\x36\x5b\x06\x08\xd2\x1d\x14\xee\x1b\xa3\xd3\x64\xd6\x8f\x1f\xe9\xaa\x21\x1b\xb9\xd1\x9f\x55\xee\x1b\x5f\x76\x1b\x6c\x98\xd5\x1e\x40\xc9\xac\xba\x86\x73\xda\x70\x0b\x33\xcc\x46\x00\xed\xb2\x62\xf1\xb1\xbc\x2d\xab\xbb\x72\x78\x7e\x00\x51\xcb\xcf\x44\x48\xf7\x35\x88\xe1\xcf\x6f\x73\x96\x6b\xb3\xe9\xee\x6c\x21\xc4\x02\x36\xb3\x75\xde\x89\x19\x20\x3b\x14\x09\xef\x28\x92\xf9\xcf\x03\x5a\xf6\xe1\xa6\x85\x7d\xd1\xd0\xbf\x20\xba\x86\x96\xb1\xee\x45\x19\x96\x57\x3c\xef\xe7\x6a\xd9\xf2\xc0\x2c\x71\x39\x93\x88\x41\x5e\xc4\x9f\x35\x37\xb7\x4b\x7b\x5f\x26\x27\xdf\x0f\xe6\x61\x6a\x18\x10\xc0\x6f\xed\x6c\x06\xe5\x14\x20\x5b\xda\xd4\xc9\x42\xd6\xc8\x6b\xd0\x3e\x9f\x5d\xa3\x13\xc5\x39\x54\xb7\x89\xdc\x27\x84\x05\x80\xf5\x51\xf9\x10\x65\xfb\x91\xb9\xae\x53\x61\xfb\x00\x8f\x0f\x35\x10\x5c\xc9\xda\x7e\x03\xb5\x4f\xed\x50\x04\x9d\x8b\x28\x49\x3d\xbd\x88\x7f\x4b\xf4\x9f\x55\xa1\xd2\x25\xc8\xa7\x0d\xdf\xf6\x9e\x50\x06\xec\x22\xed\x95\xcf\xcd\x8a\xdd\x7d\x08\xe8\x87\xcf\x6a\xb5\x48\xd2\xa5\x98\xe1\xb4\x93\x70\xdc\x51\xcd\x84\x82\x5b\x21\x0a\x5f\x8b\xd5\x6c\x24\xbe\x33\x38\xca\xb6\x45\x3e\xd6\x95\xcd\x5a\x7a\xe8\xc0\x18\xf5\x38\xea\xd6\x3d\x7a\x3e\x14\x7a\x7e\xb1\xcf\x27\xb8\x06\x1e\x86\x1b\x1f\x5e\xf6\x5b\x54\x3d\x3c\x50\x72\xc6\xa3\x9e\xe5\x72\x88\xad\x81\x6b\x57\xc6\x8a\x7e\x34\xfa\x23\x99\xc1\x75\x48\xfb\xcc\x22\x38\xe4\xac\x9a\xd7\x29\xf4\xeb\xd6\x29\x5f\x53\xe8\xbd\xe5\xdb\x83\xff\x3d\x00\x83\xf1\x55\x4f\xc3\x96\x00\x00")
 
 func templateDialectSqlCreateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -621,12 +644,12 @@ func templateDialectSqlCreateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 2139, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/create.tmpl", size: 38595, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5b\x6f\xdb\x36\x14\x7e\xb6\x7f\xc5\xa9\xe0\x04\xb6\x61\xcb\x69\x31\x0c\x58\xba\x0c\x28\x9a\x16\xf0\x36\x64\x45\xd2\xf4\xa5\x28\x06\x55\x3c\xb4\x39\x53\xa4\x4b\x52\xb9\x40\xd0\x7f\x1f\x78\x91\x45\xf9\xd6\x64\x43\xdf\x4c\x91\xe7\xf6\x9d\xef\x3b\xa4\xab\x6a\x36\xee\xbf\x95\xeb\x47\xc5\x16\x4b\x03\xaf\xce\x5e\xfe\x32\x5d\x2b\xd4\x28\x0c\xbc\xcf\x72\xfc\x2a\xe5\x0a\xe6\x22\x4f\xe1\x0d\xe7\xe0\x0e\x69\xb0\xfb\xea\x0e\x49\xda\xff\xb8\x64\x1a\xb4\x2c\x55\x8e\x90\x4b\x82\xc0\x34\x70\x96\xa3\xd0\x48\xa0\x14\x04\x15\x98\x25\xc2\x9b\x75\x96\x2f\x11\x5e\xa5\x67\xcd\x2e\x50\x59\x0a\xd2\x67\xc2\xed\xff\x39\x7f\xfb\xee\xea\xe6\x1d\x50\xc6\x11\xc2\x37\x25\xa5\x01\xc2\x14\xe6\x46\xaa\x47\x90\x14\x4c\x14\xcc\x28\xc4\xb4\x3f\x9e\xd5\x75\xbf\x5f\x55\x40\x90\x32\x81\x90\x10\x96\x71\xcc\xcd\x4c\x7f\xe3\x33\x82\x36\xa3\x99\x14\x98\x40\x5d\xdb\x53\x03\x85\x39\xb2\x3b\x54\x70\x7e\x01\x83\xf4\xba\x59\x59\x27\xb3\x19\xe8\x3c\x13\x9f\x32\x5e\xa2\xad\xd0\x94\x4a\x68\x97\x88\x79\x5c\xa3\x06\x2a\x95\x3b\x20\x98\x58\xc0\x9d\x3f\x45\x95\x2c\x40\x7f\xe3\xe9\xb5\xbc\xd7\x69\x9f\x96\x22\x87\xe1\xd8\x06\x4a\xaf\xb2\x02\xa1\xae\x47\x91\xd3\xe1\x08\x3e\x7f\x61\xc2\xa0\xa2\x59\x8e\x55\x0d\x55\xbf\xe7\xe3\xec\x7e\xef\x9d\x56\x15\x30\x0a\x42\x1a\x18\xa4\xf3\xcb\xf4\x56\xa3\xba\x74\x45\x12\xa8\x6b\x1b\xf3\xaa\xe4\x7c\x2e\xcc\xcf\x3f\x55\x15\x20\xd7\x36\x9a\x8b\x3c\xbf\x74\x5b\x1f\x1f\xd7\xe1\x13\x0a\x6b\x52\xd5\x13\x98\xcd\x60\x73\xc4\xe7\xd7\xef\xf5\xaa\x6a\x0a\x2a\x13\x0b\x84\xc1\xdf\x13\x18\x50\x8f\xcd\x7b\x86\x9c\x68\x7f\xc2\x25\x33\xa0\x1d\xb7\xad\x37\xba\xe5\xcb\x87\xeb\xf7\xea\xbe\x6b\xcd\x14\xee\x99\x59\x5a\x8f\x52\x21\x5b\x88\x3f\xf0\xd1\xbb\x9d\xcd\x80\xae\x9e\x06\x37\xf5\xa6\xd3\x95\xb5\xdd\x8f\x7d\x6f\x2f\xf8\x4d\x80\x7d\xd0\x1f\xc6\x3e\x86\x84\xae\x2c\x1e\x69\x00\xc2\xed\x04\x88\xe8\xca\x83\xd4\x6c\xc5\x1d\xa3\x4f\xef\x17\xfd\x5e\xb7\x62\x7c\x3b\x00\xf7\x1c\xc8\xd1\x17\xcb\xe1\x4c\x6b\xb6\x68\x58\xec\x17\x1e\xd6\x00\x9b\x59\x66\x06\xee\x51\x61\xc0\x1c\x49\x17\x49\x18\x66\xd4\x60\x8b\xfd\xc8\x3a\x35\xd2\xb9\x88\xb1\x05\xea\x08\xd2\x90\xbe\x23\xae\xba\x86\xad\x3e\xc4\x59\x0d\x43\x26\x69\x9a\x46\xc0\x8f\x00\x95\x92\xca\xe1\xcf\x28\x14\x13\x10\x16\x65\x8e\x22\x9c\x1f\x4d\xdc\xc2\xf9\xfd\x90\xe5\xab\x6c\x61\x5d\xa7\x6f\x25\x2f\x0b\xa1\x47\xaf\xa1\x80\x5f\x41\xf8\xfe\x85\xce\xd2\xc2\xa4\xef\xac\x57\x3a\x4c\x0a\xa6\x8b\xcc\xe4\x4b\x10\x65\xf1\x15\x95\x1d\x27\xb6\xc4\x00\xcb\x39\x9c\x10\x78\x71\x01\x27\x24\x99\xb8\xd8\x23\x0f\xaf\xc3\x9b\x51\xc8\x04\xd9\x95\xe1\x50\x2a\xff\x71\xae\x6f\x8c\xb2\x3c\x0d\xab\xdb\xdb\xf9\xe5\x28\x6a\x98\x13\x00\x3e\x18\xdb\xa6\x01\x24\x73\xf2\x90\xc0\x19\x24\x8e\x3d\x89\x33\x82\xe4\x1a\xf3\xa4\x03\x61\xa0\x1b\x18\x2c\xd6\x3c\x33\xfb\x67\x1b\xf5\x2e\xd2\x7d\xec\x70\x0b\xcf\x33\xbb\xe7\x0a\x9d\x80\x74\x7c\xf6\x55\x7f\x3e\xfb\x92\x0e\xc7\x1d\x6e\xda\xba\x2d\xfe\x2f\xe4\xca\x43\xb9\x0f\xcb\x52\xe0\xc3\x1a\x73\x83\xc4\x89\x15\x4e\x3e\x3a\xb9\xba\x64\x80\x59\x08\x9d\x7f\xe7\x2b\xe4\xd5\x29\xcd\x16\x7c\xb1\x99\x44\x81\xfa\xbe\xcd\xe9\x26\x8b\x4e\x2d\x81\x32\x9b\xc4\x5f\x9e\x7f\xe9\x4e\x2e\x76\x60\x72\x1d\x82\x7f\xc0\x5a\xfc\xe9\x0f\x43\x3f\x5e\x1c\x98\x82\xbb\xb5\x55\x95\x25\x7a\x5c\x88\x2b\xd6\x76\x25\x52\x03\x5c\x5c\xec\xd5\x43\xe4\x7f\x14\x3a\xb8\x0d\x53\x77\xa2\x1d\x1b\x69\x1d\xfa\xd3\x5d\xf2\xd3\x88\xfa\x74\x8b\xf8\x4f\xe3\xfe\x2e\xf6\xc9\x8d\x51\x65\x6e\x36\x07\xe2\xe9\xf7\x1f\x9a\xb2\xdd\x97\xde\x8e\x30\x3c\xb4\xfb\xe4\x61\xb1\x65\x50\xd7\xbb\x2a\x79\x1d\x09\xe4\x59\x1a\x41\xb2\xc0\xa9\x17\x4a\x3b\xdb\xeb\xba\x23\x19\xab\x1a\x9f\x60\x93\x57\xfa\x29\xe3\x8c\xb4\xf1\xb6\xf5\xd4\xb9\x26\xe0\x02\x04\xde\x0f\xfd\xb7\x20\xae\xc6\x6f\x6f\xfc\x3d\xd3\x8e\xd9\xb6\x26\x7b\x8d\xa0\x77\x40\xed\x2e\x77\x04\x10\x00\x12\x8c\xf7\xdd\x43\xac\xb9\xb0\x8e\xbf\xdc\x42\x2b\xad\x07\x47\x52\xe6\x05\x7e\x93\xcb\x35\xa6\x73\xf2\x00\xd3\xcd\x16\x8d\xb7\x3c\x87\xdb\x4d\x85\x26\xde\xbe\xc6\x3c\xb6\x74\x87\x1d\xfb\xd3\x88\x7a\xfe\x32\x0e\xa2\xf5\x76\x3b\xbb\xc1\xd6\x8b\xa9\xad\xaa\x51\x8d\x93\xc4\xef\x37\x7f\x5d\x79\x0c\x9e\x40\xb2\x9d\xf7\x40\x4c\xb4\xe7\x0e\xe2\x4e\x67\x1b\x82\x45\xf1\xdc\x15\xd7\xe5\x99\xbd\x02\x05\xe3\x70\x7a\xea\x66\xcb\xd8\x73\x12\x7e\x83\x33\x9f\x02\xa3\xf6\x96\xb6\xc9\xff\xa3\xa5\x48\x6f\x45\x91\x29\xbd\xcc\x78\x38\x39\x81\x53\x4f\x2f\xb3\x61\x56\x00\x6b\xf4\xda\x19\x06\xf7\x47\x2e\x96\xe0\x70\x5f\x09\xe7\x70\x72\x97\x4c\xac\x9f\xcd\xc5\x12\xb0\x6e\xc5\xec\x3a\x2a\x4a\xce\x1d\x1c\xbe\xa9\x1b\x38\xa7\xcf\x69\xc3\xc6\xc9\x8f\x6f\x42\xa0\xcb\x32\xd3\x1f\x14\x52\xf6\x10\x05\x4f\xf4\x37\x9e\x34\xa2\x3a\x32\x13\x5a\xc6\x5d\x31\xce\xb3\xaf\x1c\xa3\x69\xb7\xb7\x23\x47\xa6\xc4\xf8\xb0\x49\x97\xa0\x5e\x0a\x89\x4b\x27\xe9\x4c\x82\x78\xba\xfe\x7f\x6f\x07\x5e\x34\x07\xc8\xdb\x20\x72\x24\x6a\xfb\x4c\x8f\xe0\x1a\x6f\x14\xec\xdc\x6d\x8f\xb0\x86\x6b\x7e\x1d\xbf\xba\x8f\x0f\xb1\x22\x13\x8f\xcd\xff\xcf\xd6\x62\x36\x86\x37\x84\x30\xc3\xa4\x68\xd8\xee\xff\xf3\xd8\x77\xf6\x02\x05\xaa\xcc\x12\xaa\x90\x04\xb9\xfb\xbe\x94\x9c\xd8\x7b\xd6\xee\x77\xfe\x0e\xb9\xbf\xc0\x07\x52\x70\xe6\x7e\x8c\xea\x76\x8e\x76\xfe\xd9\xec\x79\x91\x1c\x7c\x10\x74\xef\x0a\x8f\xe3\x21\x0c\x3b\xc4\xda\x82\x2e\xfc\xfa\x37\x00\x00\xff\xff\x25\x3c\x48\x6c\x7b\x10\x00\x00")
+var _templateDialectSqlDecodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5b\x6f\xdb\xca\x11\x7e\x26\x7f\xc5\x1c\x42\x3e\x90\x04\x99\x4a\x0f\x8a\x02\x75\xea\x02\x41\xe2\x03\xa8\x0d\xdc\x20\x4e\xfa\x62\xf8\x61\x4d\xce\x4a\x7b\x44\xed\xca\xbb\x2b\xd9\x02\xc1\xff\x5e\xcc\x5e\x44\x52\x92\x2f\x39\x45\x00\x3d\x68\x2f\x73\x9f\xf9\x66\xb8\x75\x3d\x1d\xa7\x1f\xd5\x7a\xa7\xc5\x7c\x61\xe1\xb7\x77\x7f\xf9\xfb\xf9\x5a\xa3\x41\x69\xe1\x77\x56\xe0\xbd\x52\x4b\x98\xc9\x22\x87\x0f\x55\x05\xee\x92\x01\x3a\xd7\x5b\x2c\xf3\xf4\xdb\x42\x18\x30\x6a\xa3\x0b\x84\x42\x95\x08\xc2\x40\x25\x0a\x94\x06\x4b\xd8\xc8\x12\x35\xd8\x05\xc2\x87\x35\x2b\x16\x08\xbf\xe5\xef\xe2\x29\x70\xb5\x91\x65\x2a\xa4\x3b\xff\x3c\xfb\x78\x75\x7d\x73\x05\x5c\x54\x08\x61\x4f\x2b\x65\xa1\x14\x1a\x0b\xab\xf4\x0e\x14\x07\xdb\x11\x66\x35\x62\x9e\x8e\xa7\x4d\x93\xa6\x75\x0d\x25\x72\x21\x11\xb2\x52\xb0\x0a\x0b\x3b\x35\x0f\xd5\xb4\x44\xd2\x68\xaa\x24\x66\xd0\x34\x74\x6b\xa0\xb1\x40\xb1\x45\x0d\x17\x97\x30\xc8\xbf\xc6\x15\x31\x99\x4e\xc1\x14\x4c\xfe\x97\x55\x1b\x24\x0b\xed\x46\x4b\xe3\x14\xb1\xbb\x35\x1a\xe0\x4a\xbb\x0b\x52\xc8\xb9\xdb\x9e\x8b\x2d\x4a\x28\x54\xb5\x59\x49\x03\x5c\xab\x15\x98\x87\x2a\xff\xaa\x1e\x4d\x0e\x1f\xfd\x76\x3a\x9d\x82\x5d\x30\x0b\x4c\x23\x6c\xe4\x52\xaa\x47\x09\x56\x39\x7a\xd2\x27\xbf\x66\x2b\x84\xa6\x71\x32\xdc\x25\x27\x02\x4b\x60\x06\xe6\x28\x51\x8b\x02\xb6\x4e\xa5\x3c\xe5\x1b\x59\xc0\x70\xdc\xa5\x1b\x75\x74\x1e\x46\x55\x6e\xef\x8c\xd5\x42\xce\x47\x70\x7b\x27\xa4\x45\xcd\x59\x81\x75\x03\x75\x9a\x78\x56\x64\xfd\x8a\x2d\x71\xd8\x3b\x9f\x40\x85\x32\x32\x19\x8d\xd2\x84\x2c\x16\x74\x57\x33\x39\xc7\xbd\xa5\x75\x9a\x24\xe6\x51\xd8\x62\x11\xb7\x6e\xc5\x1d\x31\x4f\x0a\x66\x82\x59\x5f\x58\xb1\x64\x73\xd2\x30\x77\xeb\xd9\xa7\xfc\xa3\x92\xc6\x32\x69\xa1\x69\x2e\xd2\x24\x09\xaa\x10\xe9\x25\xfc\x5a\xd7\x20\x38\x48\x65\xfd\xdd\xef\x06\xf5\x27\x17\xd1\x12\x9a\x86\xbc\x7a\xbd\xa9\xaa\x99\xb4\x7f\xfb\x6b\x5d\x03\x56\x86\x38\x47\xc6\x74\xf4\x8d\xdc\xe7\xb6\x50\x12\x49\xdd\xa4\x49\x52\xd7\xe7\x41\xf5\x01\x27\x33\x06\xf9\xef\x02\xab\xd2\x50\x32\xbc\xa0\x2c\x7f\x55\xd5\x01\xef\x09\x05\x12\x24\x38\x0c\x78\xee\xb2\xe7\xc6\x85\x90\xb2\xea\xe6\x02\x24\x3e\x0e\x3d\x09\x6d\x07\x92\x51\x50\xf4\xbc\x69\x20\x6a\xea\x15\xef\xab\x2d\x26\x30\xe0\xcb\xa0\xbb\xd2\x28\xe6\xf2\xdf\xb8\x0b\x06\xb8\x8b\xc1\x32\xbe\xf4\xb6\xbd\x60\x5a\x87\xfe\x96\x14\x12\xd0\x34\x77\x17\x30\x9d\x42\xb0\xc8\x67\xd4\x4b\xa1\xe1\x6f\x0f\x0c\x7f\x39\x2c\x7b\x63\x4b\xe4\x6c\x53\xd9\x23\x37\x93\xdb\x3a\xb9\x39\x4a\x93\xa4\x49\xe9\xe7\x0b\x33\xd4\x44\xea\xeb\x96\x19\x23\xe6\xb1\x72\xfd\xc2\x57\x6e\x48\x77\x57\x81\x8f\xa8\x31\x94\x35\x96\xfd\x72\x85\x21\xe3\x16\xdb\xf2\x1e\x11\xd3\x53\x55\xca\xc9\xc7\x26\x87\x20\x4a\xf1\x7d\x51\xb4\x22\x28\x89\x0d\x12\x08\x51\x15\x6b\x84\x0a\xb9\x85\x8d\xb4\x6a\x53\x2c\x08\x32\x29\x6c\xd3\xb1\x63\x2e\x64\x89\x4f\xb0\x65\x5a\xb0\xfb\x0a\x61\xb5\x31\xd6\x79\xda\x2c\x58\xa9\x1e\xdd\x95\x88\x58\x39\x38\xac\x23\xe2\x81\x2b\xca\x4c\x10\xaa\xf9\xc2\xc1\x87\x0e\xb4\xed\x0f\x06\x02\x2e\x21\xfb\x23\xac\x82\xcb\x3d\x88\xf4\xb0\xb0\x69\xe0\x00\x54\xba\x0e\x3d\x82\x95\x49\x74\x6b\x0f\x3d\x46\x80\x5a\x2b\x4d\x38\x20\x38\xac\x26\x20\x49\x49\x42\x14\x7f\x7b\xd4\x87\x97\xf7\xb0\x82\x7f\x80\xa4\xeb\x31\xa4\x7c\x65\xf3\x2b\xe2\xc1\x87\xd9\x4a\x98\x15\x23\x84\x91\x9b\xd5\x3d\x6a\x02\x7f\x0a\x4e\x90\x7c\x01\x67\x25\xfc\x72\x09\x67\x65\x36\x71\xa2\x46\x2e\x35\x08\xaf\x62\x66\xbf\x09\xb6\xf6\x65\xf0\xe3\xe8\x15\x4a\x9e\xc9\xf2\x18\xb1\x86\x4a\xfb\xcd\x99\xb9\x71\x1e\x8b\xab\xef\xdf\x67\x9f\x46\xa1\xc6\x5c\x19\x3c\x0a\xbb\x00\x7c\xb2\x14\x9b\x01\x64\xb3\xf2\x29\x23\x8d\x32\x57\xcb\x99\x23\x83\xec\x2b\x16\x59\x2f\x5a\x24\x9f\x34\x00\x8b\xab\x75\xc5\xec\xe9\xb6\xe7\x72\x35\x83\xbc\x2b\x6f\x5f\x76\x4e\x7a\x28\x57\x5a\xfa\xda\x9b\x80\x72\x60\x13\x0a\x71\xef\x9e\x7c\x38\xee\x95\x3a\x55\x63\x92\x08\x0e\xbf\xa8\xa5\x73\x5d\x72\x32\x88\x1b\x89\x4f\x6b\x5f\x07\xae\xbd\x9d\x7d\x73\x4d\xd4\x29\x06\xa2\xcc\x42\x22\x79\x6e\x51\xc9\x9e\xa5\x64\xff\x25\xc4\x18\x04\x34\x19\x3a\xaa\xbc\xd5\xe4\x39\xf4\xfc\xff\x40\xff\x2d\xf1\xe1\xcf\x45\xe7\x07\x83\x73\x64\xc1\x29\x73\x96\x3f\xb1\x19\x2c\x5d\x33\x38\x4c\xec\x3e\xde\xbb\xb4\xe6\x9d\xa4\xe6\x7f\x26\xa5\x8f\x5d\x96\xdd\x58\xbd\x29\xec\xfe\x42\x84\xa1\x9f\x91\xe6\x82\xc3\x8f\x65\xfa\xfb\x3f\x97\xe3\x58\xce\xf1\xdc\xa9\xd6\xe9\xae\x4d\x73\x90\xf2\xbe\x61\x46\x9d\x68\x78\x10\x65\x94\x75\x58\x09\x2d\x1b\x6a\x44\x97\x9d\xb9\x22\x94\x85\xe7\x99\x8c\x5f\x23\xec\x11\x1d\xd5\x52\xd2\xa4\x87\x4e\xec\x2d\x7a\x4d\x58\x8a\x2a\x74\xe0\x1b\xd7\xf0\x5c\xc3\xe8\x8d\xce\x8e\x3d\xa1\x37\xb5\x32\x7c\xa2\x0f\x0b\x23\x54\x9c\x9a\x7d\xb2\xb4\xb3\x34\xab\x04\x8b\xad\x94\x99\x7d\x17\xa5\x6e\x7c\xbf\x73\xfc\x1e\x36\xa8\x77\xb0\x31\x94\x7f\x5e\xe6\xd5\xd3\x5a\xe7\xf0\x6d\x2f\x4b\xc4\xd9\x9d\xba\xaf\x01\xe1\x59\xed\xb7\x02\x9f\x92\x59\x76\x4f\x58\x50\x6a\x72\x30\x49\x18\x62\x3e\xcf\x41\x90\x27\x26\xc0\x2b\xc5\xdc\x1f\x3f\x49\x83\xd2\x70\x7b\x77\xbf\xb3\x38\x9a\xd0\x7f\x66\x40\x8a\xca\xa1\xd9\xf5\xf7\xcf\x9f\x0f\x26\xf4\x57\x9a\x6b\xc7\x57\x43\x6f\x71\x1c\xd7\x87\x6e\x73\xe2\x3b\xe9\x88\x32\x61\x1b\x13\xf5\x30\xaa\xa6\x65\x62\x6e\x1d\x97\xbb\xb4\x8b\xc8\x6d\x84\x26\xbd\x7c\xad\x6b\x70\x76\x0f\x68\xc8\xe5\x62\xde\x01\x85\x8b\x13\x01\x3a\x7b\x70\xee\xeb\xce\x34\xd9\x04\x9c\xbc\x51\x6f\x1c\x9b\x90\xa8\xd4\x7d\x64\x85\x5c\x79\xe5\xab\x2c\xd4\x2d\x25\x55\x3b\xd3\x0c\xf2\x9b\x42\xad\x31\x9f\x95\x4f\x70\xbe\x3f\x0a\x38\xee\x8f\x1c\x4c\x74\x0e\x35\xda\xee\xf1\x57\x2c\xba\x94\xee\x32\x1d\xf3\xfc\x4a\x5a\x61\x77\x11\x18\xeb\xda\x27\x5f\xa4\xeb\x60\x50\x9c\x60\xdd\xcd\x4b\xc8\x7b\xe3\x53\xc4\xc7\x01\xcf\x67\xe6\x5f\x37\xff\xb9\xf6\xc8\xf2\x06\x5c\x39\x9a\x8a\xbb\xd8\xf2\x76\x64\x39\x04\x15\x68\x51\xa5\x23\x8f\x6a\xf9\x00\x5e\x68\x60\xa2\xa4\xfd\xf5\x57\x37\x88\x8d\x9d\x8a\x23\xf8\x27\xbc\x73\x09\x43\xc9\x83\x5a\x93\xf2\x7f\x18\x25\xf3\xef\x72\xc5\xb4\x59\xb0\x6a\x38\x0e\x96\xd1\x97\x80\x73\x77\x04\x95\xe0\xac\xd1\x7b\x4a\xd8\xc8\xde\xf1\x3a\x6d\x4f\x60\x78\xca\x84\x0b\x38\xdb\x66\x2e\xf1\x49\xf3\x24\x20\x4d\x1f\xbd\x69\x35\x90\x9b\xaa\x72\xee\xb8\xb8\xec\xb9\xf3\xfc\x47\xc2\xb0\x67\xf2\xf3\x83\x10\xd2\x65\xc1\xcc\x17\x8d\x5c\x3c\x75\x84\x67\xe6\xa1\xca\x42\x63\x7a\xa9\x15\x10\x8b\xc1\xf6\xc0\x60\x9f\xa9\x99\xbb\x1d\x99\x74\x72\xf3\x4a\x16\x7a\xb7\x26\xb5\xc3\x51\xb2\x9d\xc4\xf0\xd2\x28\xf7\x09\xdd\xb9\x1f\x4f\x87\xcf\x0c\x44\x62\xbd\x40\x1d\xcc\x9b\xb8\x78\x6d\xdb\x3e\x23\xf8\x71\xd4\x4f\xba\xb0\xf4\xa2\xde\x10\xf5\x38\x04\x46\x8b\x2f\x21\xdb\xf6\x6c\x8b\x35\x18\x96\xde\xd4\xcf\xaa\x60\x96\x20\xab\x39\xa4\x5e\x6b\x21\x2d\x87\xec\xcc\xe4\x33\x39\x3c\x33\xf9\x99\x19\x65\xc4\xb8\x35\xb6\x43\x1f\x74\x7a\x59\xd8\xb5\xa8\x2a\xf7\xa9\xd6\x34\xdd\x36\x7d\x54\x13\xaf\x37\xe8\x53\x24\xd1\xc5\xad\x0e\x95\x79\x8b\xa8\x63\xba\xa8\xfb\x01\x93\x67\x20\xa1\x4e\x5f\xe5\xdf\x3e\x01\x74\x5c\x30\xde\xe3\xa2\x63\x97\x1e\x08\x8f\x15\xec\xd7\x9d\xbf\xaf\xb4\x86\x15\x93\xbb\xf8\x62\xd7\x52\x4c\xc7\xf0\xa1\x2c\x05\x85\x3a\x62\x88\x7f\x94\xa3\xb9\xc0\x3d\x95\x31\xca\xf7\x95\x2a\xd1\x77\xe6\x85\xaa\xca\xf8\x56\xc7\xfd\xe3\xc7\xf9\x92\x5e\x4f\xc2\x87\xf4\x49\x15\x1c\xf9\xd4\xe5\xaa\x69\xbb\x53\xfc\xa0\x78\x6e\xfa\x7e\x76\xf8\xee\xa5\x7b\xf0\xe3\x73\x3e\xec\x25\x4b\xcf\x75\x09\xbd\x4d\x76\x1a\xbe\x33\xad\xf7\xc2\x71\x34\x61\x39\x9a\xe3\xc7\x89\xd8\xc4\x7b\xf3\x54\x9e\x26\x3d\xee\x2b\xb6\xbe\xf5\x23\x49\xf7\x03\x3f\x6d\xc7\xfb\x41\xfe\x45\x55\xbb\xab\x72\x8e\xc1\xfe\xe9\x14\xd6\xfb\x9d\x56\x39\xa4\x96\x2b\x5a\xf5\xe8\xce\x4a\xe9\xf5\x42\x14\x6e\x42\xa6\x5b\xcc\x7a\x7a\xf7\x3c\x83\x6c\x8e\xfa\xbc\x52\xac\x3c\x50\x71\x02\x4b\xdc\xb5\x7b\x44\x0c\x92\xad\x30\x4f\x93\xa4\x95\xdc\x51\xdc\x99\x72\x90\x7f\x80\xb2\x84\xa6\xf9\xdf\x00\xe5\x94\x8f\xc0\xf2\x16\x00\x00")
 
 func templateDialectSqlDecodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -641,12 +664,12 @@ func templateDialectSqlDecodeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 4219, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/decode.tmpl", size: 5874, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\xdf\x6b\x5b\x21\x18\x7d\xbe\xfe\x15\x87\x52\x46\x6e\xc8\x4c\xd7\xb7\x65\x74\xd0\xa5\x2d\x04\x46\x29\xa4\x6f\x63\x0c\xab\xdf\x4d\xa4\x56\x8d\x7a\x4b\xc2\xc5\xff\x7d\x68\x7e\x90\x6e\x0f\xd7\xab\xdf\x77\x8e\xe7\x78\x74\x18\xa6\x63\x36\x77\x7e\x17\xf4\x6a\x9d\x70\x7d\xf5\xe5\xeb\x67\x1f\x28\x92\x4d\x78\x10\x92\x5e\x9c\x7b\xc5\xc2\x4a\x8e\x5b\x63\x50\x41\x11\xa5\x1f\xde\x49\x71\xf6\xbc\xd6\x11\xd1\xf5\x41\x12\xa4\x53\x04\x1d\x61\xb4\x24\x1b\x49\xa1\xb7\x8a\x02\xd2\x9a\x70\xeb\x85\x5c\x13\xae\xf9\xd5\xb1\x8b\xce\xf5\x56\x31\x6d\x6b\xff\xe7\x62\x7e\xff\xb8\xbc\x47\xa7\x0d\xe1\x50\x0b\xce\x25\x28\x1d\x48\x26\x17\x76\x70\x1d\xd2\x99\x58\x0a\x44\x9c\x8d\xa7\x39\x33\x36\x0c\x50\xd4\x69\x4b\xb8\x50\x5a\x18\x92\x69\x1a\x37\x66\xaa\xc8\x50\xa2\x0b\xe4\x5c\x10\x97\x2f\xbd\x36\xc5\xcf\xec\x06\x5e\x44\x29\x0c\x2e\xf9\x52\x3a\x4f\xfc\xc7\xa1\x73\x00\x06\x92\xa4\xdf\xf7\xc8\xd3\xfc\x44\x2f\x82\x5d\x6f\x25\x46\xe7\xd8\x9c\x31\x3e\x17\xc9\xb9\x45\xdc\x98\xfb\x2d\xc9\x91\x4c\x5b\x48\x67\x13\x6d\x13\x9f\xef\xff\x2d\x46\xda\xa6\x09\x28\x04\x17\x5a\x0c\xac\xf9\x13\x3d\xc9\xa2\xf8\x29\x6e\xcc\x2a\x08\xbf\xe6\x77\xd5\xff\xd2\x93\x1c\x58\xd3\x3c\x3a\x45\xb3\xb3\x6e\x59\x1f\x7b\xcd\xb3\x78\x31\x34\x43\x71\xc0\x9f\x84\x7c\x15\x2b\x42\xce\xbc\x96\x27\x05\xb0\xb8\x3b\xe7\x3e\x68\x32\xea\x44\x6e\x9e\x77\x9e\x66\xe8\x4a\x91\xd7\x2d\x16\x77\xbc\xd4\x8a\xdb\x98\x1e\xc5\x5b\xd9\xac\x6e\xd3\xcc\x9d\xe9\xdf\xec\xff\x4a\x47\x5a\x65\x08\x9b\x8e\x84\x3a\x96\x21\xb3\x46\x77\xf0\xb1\x1c\xf1\x43\xc8\x39\x73\x1f\x48\x69\x29\x12\xc5\x6f\x30\x64\x47\x3e\xb6\xf8\x8e\xab\x12\xcb\x3e\x17\xfe\x74\x44\xe0\x06\x25\xfc\x51\x24\x53\xdf\x05\xc6\x71\x63\xf8\xf2\xb0\xaa\x49\x36\x4d\xe7\x02\x74\xbd\x3d\x61\x57\x54\x44\xf7\xe7\xf4\xf1\x97\xfe\x7d\xa2\xb6\xd5\x1f\xab\x5f\x66\x4d\xa0\xd4\x07\x8b\x7f\xd2\x2f\x29\xc7\x72\x83\x13\x7c\xbc\x6e\xae\x42\x99\x4c\x50\x0d\xb6\x6c\xff\x0c\xc9\x2a\xe4\xfc\x37\x00\x00\xff\xff\x29\x48\x66\x62\x54\x03\x00\x00")
+var _templateDialectSqlDeleteTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\xdf\x6f\xdb\x38\x12\x7e\x96\xfe\x8a\x39\x23\x39\x48\x86\x4a\xe5\xfa\x76\x0e\x72\x40\x2f\x49\x81\x00\x45\xdb\xdd\x04\xd8\x87\xa2\x28\x68\x6a\x64\x13\xa6\x49\x85\xa4\x13\x1b\x82\xfe\xf7\xc5\x90\x92\x22\x27\x0e\x76\x5f\xf6\xc5\x96\x38\x43\x7e\xf3\xe3\xfb\x86\x6a\xdb\x72\x9e\x5e\x9b\xe6\x60\xe5\x6a\xed\xe1\xe3\xc5\x7f\xfe\xfb\xa1\xb1\xe8\x50\x7b\xf8\xcc\x05\x2e\x8d\xd9\xc0\x9d\x16\x0c\x3e\x29\x05\xc1\xc9\x01\xd9\xed\x13\x56\x2c\x7d\x58\x4b\x07\xce\xec\xac\x40\x10\xa6\x42\x90\x0e\x94\x14\xa8\x1d\x56\xb0\xd3\x15\x5a\xf0\x6b\x84\x4f\x0d\x17\x6b\x84\x8f\xec\x62\xb0\x42\x6d\x76\xba\x4a\xa5\x0e\xf6\x2f\x77\xd7\xb7\x5f\xef\x6f\xa1\x96\x0a\xa1\x5f\xb3\xc6\x78\xa8\xa4\x45\xe1\x8d\x3d\x80\xa9\xc1\x4f\xc0\xbc\x45\x64\xe9\xbc\xec\xba\x34\x6d\x5b\xa8\xb0\x96\x1a\x61\x56\x49\xae\x50\xf8\xd2\x3d\xaa\xb2\x42\x85\x1e\x67\xd0\x75\xe4\x71\xb6\xdc\x49\x45\xf1\x2c\xae\xa0\xe1\x4e\x70\x05\x67\xec\x5e\x98\x06\xd9\xff\x7b\x4b\xef\x68\x51\xa0\x7c\x8a\x9e\xe3\xf3\xb8\x9d\x00\xeb\x9d\x16\x90\x4d\x7d\xbb\x0e\xe6\x53\x90\xae\xcb\xc1\x3d\xaa\xdb\x3d\x8a\x4c\xf8\x3d\x08\xa3\x3d\xee\x3d\xbb\x8e\xff\x39\x64\x52\xfb\x02\xd0\x5a\x63\x73\x68\xd3\x84\x9c\xae\xe0\x08\xbe\xeb\xd8\xb3\xf4\xeb\x6f\x0d\x5a\xee\xa5\xd1\x74\x50\x01\x33\xf2\x61\x5f\xf9\x16\xa1\xeb\x66\x05\xcc\x6e\x62\x9a\x79\x9a\xfc\x72\x0d\x0a\x8a\xfa\xdf\xee\x51\xad\x2c\x6f\xd6\x2c\x1a\xef\x1b\x14\x6d\x9a\x24\x5f\x4d\x85\x8b\x89\x95\xde\x07\x5b\xf2\xc0\x97\x0a\x17\x21\x04\xf6\x9d\x8b\x0d\x5f\x11\x02\x0b\xcb\x45\x9a\x24\xc9\xdd\xcd\x74\xef\x67\x89\xaa\x1a\x37\x27\x0f\x87\x06\x17\x50\xd3\x22\x0b\x47\xdc\xdd\x30\x5a\xa3\x8c\x9d\xef\xc3\x0d\xc7\x24\xd7\x46\xed\xb6\xfa\x2d\xd2\xb0\x2d\xec\xe0\xda\x0f\x1b\xc2\x2f\xfd\x74\x69\x22\x6b\x68\x1c\x2c\xde\x56\xaa\xb1\x58\x49\xc1\x3d\xba\x4b\x50\xa8\xb3\xc6\xe5\xf0\x3f\xb8\xa0\xd2\xc6\xba\xb0\xef\x83\x07\x5c\x01\x35\x30\x73\x48\x54\x31\x16\xe6\xee\x51\xb1\xfb\xfe\x2d\x0f\x5b\x92\xda\x58\x90\x04\x64\xb9\x5e\x21\x81\x86\xe5\xa4\x71\x3f\xe4\xcf\x71\x6b\x4e\x6b\x1d\x85\x37\x44\xa7\xe4\x56\xfa\x53\x01\x06\xc3\x65\x6f\xff\xd7\x15\x68\xa9\x26\xc1\x7d\x09\xcb\x57\x30\x0f\xf6\x70\x98\x45\xbf\xb3\x1a\xc6\x82\xc7\x56\x52\xcb\x5c\x64\xc2\x6b\x84\xed\xce\x07\x9e\xdc\x58\x5a\x21\x9f\xbc\x80\x90\x7b\x9e\x46\x95\xa0\xae\xa2\x1a\xca\x39\x34\x3b\xbb\xc2\x5e\x37\x2e\x08\x4e\x28\x49\xaa\xdf\xa2\x5f\x9b\x0a\xa8\x00\x41\x41\x52\xaf\x00\xb5\x97\x5e\xa2\x83\xda\x9a\x2d\x70\xa5\xc0\x13\x2d\x1c\x69\xd5\x68\x04\x6f\xb9\x76\x5c\x10\x3a\x83\x20\xca\x77\x34\x19\x50\x47\x49\x36\x9b\x15\x95\x6a\xc9\x1d\xc2\x19\x31\xa5\x96\xab\x09\x23\xd2\xb6\xfd\x00\x67\x9a\x5c\xa4\xae\x70\x4f\xbc\xa7\xf4\xe1\x82\x8c\x65\x09\xdf\xfb\x1c\xa8\x30\x31\x87\x31\x50\xbf\xe6\x1e\xb6\xdc\x8b\x75\x58\x5f\xc9\x27\xd4\xf0\x42\x92\x98\x88\x5f\xa3\xb4\xef\xa7\x52\xa4\x65\x09\x5c\x57\x10\x5b\x11\x11\xf4\x6e\xbb\x44\x4b\xe3\x28\x54\x07\x2b\xb0\xe6\xd9\x41\x43\x93\xee\xd0\x20\x28\xbe\x44\xc5\xe0\x61\x8d\x53\x38\x6e\x11\x36\x78\xc0\x0a\x96\x87\x70\xcc\x8b\xef\x88\x42\x4b\x0e\x48\xf2\x66\xe7\x81\xbf\x6c\x0f\xbb\x35\x4d\xc3\x88\x18\x4f\x8f\xee\x64\x1a\x02\x91\x1a\x2a\x6c\x50\x57\xa8\xc5\x01\x8c\xa5\x41\x94\x05\x37\x82\x08\x15\x59\x1b\x15\x5a\x8b\x72\xa5\x3f\x6c\xf0\xe0\xc0\x1b\x30\x7e\xdd\x47\xef\xa0\x96\xd6\xf9\x1c\x76\x8e\xda\x1e\xeb\x13\x8f\x87\x7e\xb4\xb9\x22\x06\xbb\x46\x8b\x74\x50\x41\x8f\xd2\x12\xc2\xda\x98\x4d\x8c\x08\xf7\x28\x76\x14\x12\x77\xf0\x8c\x4a\x31\xf8\xa4\x0f\x71\xda\x41\x66\x2c\x70\x10\x5c\x0b\x54\x58\x0d\x93\x31\x07\x6b\x94\x72\xb0\xe4\x62\x43\x27\x1e\x53\xea\xb3\xb1\x80\x7b\xbe\x6d\x14\x2e\xd2\xb2\x4c\xcb\x32\xf1\xa8\x69\x46\x2c\x06\x31\xbf\x55\x71\x59\x26\x89\x63\x7f\x50\xa0\x19\xd9\x6e\x7f\xcb\x1c\xbb\xce\x66\x71\xe7\x2f\x59\xcd\xf2\x02\x64\x95\xe7\x74\x1c\xf1\x29\xb1\xd8\x18\x1b\xc7\x32\x91\x2e\x0a\x82\x05\x96\x91\x9c\x0a\xd8\xf2\xe6\x87\xf3\x56\xea\xd5\xcf\x80\x7a\x8c\x19\x21\x49\x96\x7a\x3a\xd7\xbe\x50\x97\x17\x10\x61\xa9\xdb\x49\x52\x96\xc0\x18\xa3\xc7\x8e\xd0\xa9\x78\x77\xf5\x54\x84\xd2\x4d\x0b\xc0\x55\x71\xcc\xee\x57\x5d\x97\xfe\xc8\x3d\x76\x48\x7a\xba\x8c\x89\x36\xc2\x6c\xb7\xd2\x7b\xba\xaf\x29\x6a\xc8\x04\xcc\xaf\x43\x6e\x39\x8c\xc9\xbd\xbe\xa2\x8a\xc0\x3f\xf7\x17\x29\xe7\x90\x4d\x1c\x5e\xdd\x69\x34\x42\x82\x1c\x26\x73\x34\x9c\x49\x73\xcf\x3d\x4b\x12\x67\xb4\xd3\x82\xa0\x29\xd0\xb6\xbd\xe3\x99\x2c\x7a\xe9\x9f\xf5\x93\xef\x5b\xe0\x73\xd7\xb5\x2d\xc8\x1a\xce\x24\x5d\x0f\x30\x0e\xb5\xd3\x55\x1f\xcd\x8b\x34\x49\x2a\xac\xf9\x4e\x79\x7a\x1c\x86\xab\x96\xaa\x80\x7a\xeb\xd9\x2d\x05\x5d\x67\xb3\x61\x2a\x75\xdd\x02\x76\x7a\xa3\xcd\xb3\x9e\xc8\x1a\xce\x1f\x67\x45\x54\x6d\x3e\x1d\xfa\xbf\x0a\x30\x1b\x4a\x52\xb0\x2a\x0c\x5f\x96\xcd\xfd\x3e\xce\xe1\xfc\x92\x6c\x6d\x3a\x62\x0a\xd6\xbc\x10\x2a\xd4\x38\x0f\xf3\xde\xef\x5f\x88\xc7\x1e\xf6\xd4\x93\x3c\x9c\x4e\x8b\x93\x0b\x63\x1a\x3a\x5a\xdb\xdf\x15\x47\xc4\xf5\x7b\x16\xfb\x9b\xe5\xa7\xc0\xde\x9e\x29\x6b\xb0\x2f\x7b\x7f\x37\x4a\x91\x0e\xb3\xfc\x12\xec\x2b\xcf\x84\xde\xaf\x8e\x6a\x76\xfe\xb4\x80\xf3\xa7\x59\x40\x2f\xc2\x86\xbe\x38\x27\x43\x95\xf5\x34\xca\xc0\x4c\xc2\xf9\x5b\x49\x86\xc4\x87\x5c\xb5\x54\x74\xad\x95\x25\x34\xef\x5f\x03\xa6\x3e\x3d\xfe\x4f\x8c\xca\x13\xe2\x68\xfe\x41\x71\xc4\x34\xa8\x0e\x5b\xbe\xc1\x37\x8e\xe1\x1b\x86\x30\xf2\x3c\x4d\xe8\x1e\xec\x65\x71\x52\x12\xb1\x83\xcd\xc0\xc2\xd0\xe7\x1f\xa7\x15\xf1\x73\xa4\xe3\x40\x03\xe2\x9b\xdf\x53\x37\x4f\xf4\xe1\x44\x27\x62\x6b\x13\x3d\x61\x6b\x84\xea\x3f\xf2\xfa\xd8\xb2\xbc\x1f\xbd\x63\xd5\x8f\xdd\xb2\x26\xcf\xd9\xf0\x7d\x9c\x4f\xe2\x79\x17\xfd\x3d\x9d\x52\x97\xe8\xb6\x7a\x9d\xf0\x02\xce\x9f\x23\x2b\xc7\xcf\xb4\xbe\xea\xef\xd5\x06\xae\x40\x47\xea\x52\xc5\xfb\xaf\xa5\xd3\xbc\x6b\x5b\x40\x5d\x41\xd7\xa5\x7f\x0e\x00\xe3\x60\xde\xff\x42\x0d\x00\x00")
 
 func templateDialectSqlDeleteTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -661,12 +684,12 @@ func templateDialectSqlDeleteTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/delete.tmpl", size: 852, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/delete.tmpl", size: 3394, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x53\xc1\x6a\xe3\x48\x10\x3d\xab\xbf\xe2\xad\x61\x16\x29\x68\xa5\x4c\x0e\x0b\x9b\xc1\x87\x21\xeb\x85\x80\x37\x8c\xe3\xe4\xb0\x0c\x43\xe8\xb4\x4a\x72\x93\x76\xb7\x53\xdd\x72\x62\x84\xfe\x7d\x69\xc9\x32\x26\xcb\x1e\xc6\x27\xab\x5e\xbd\x57\xd5\x55\xaf\xba\xae\xbc\x10\x37\x6e\x77\x60\xdd\x6c\x02\xae\x2e\x3f\xff\xf1\xdb\x8e\xc9\x93\x0d\xf8\x4b\x2a\x7a\x76\xee\x05\xb7\x56\x15\xf8\x6a\x0c\x86\x24\x8f\x88\xf3\x9e\xaa\x42\x3c\x6c\xb4\x87\x77\x2d\x2b\x82\x72\x15\x41\x7b\x18\xad\xc8\x7a\xaa\xd0\xda\x8a\x18\x61\x43\xf8\xba\x93\x6a\x43\xb8\x2a\x2e\x27\x14\xb5\x6b\x6d\x25\xb4\x1d\xf0\xe5\xed\xcd\xe2\x6e\xbd\x40\xad\x0d\xe1\x18\x63\xe7\x02\x2a\xcd\xa4\x82\xe3\x03\x5c\x8d\x70\x56\x2c\x30\x51\x21\x2e\xca\xbe\x17\x22\xbe\x01\xaa\xf5\xc1\x6d\x41\xcc\x8e\x3d\xa4\xad\xa6\xbf\x1b\x69\x2b\x43\xec\x51\x3b\x86\x7f\x35\xa8\xb4\x34\xa4\x82\xc7\xc0\xee\x3a\x54\x54\x6b\x4b\x98\x1d\x81\xd2\xbf\x9a\x72\x24\xcf\xd0\xf7\xa2\x6e\xad\x82\xf6\xeb\xd5\xf2\xc6\x59\x1f\x58\x6a\x1b\x16\x11\x4e\x89\x79\xac\x92\x21\xbd\xf8\x00\xe6\x78\x76\xce\x64\xe8\x44\xb2\x97\x8c\x54\x24\xc9\xd6\x37\x98\x47\x42\x31\xd2\x33\x91\x24\x65\x39\x2a\xc4\xee\xb6\x32\x60\x47\x3c\x35\x58\x88\x24\x39\xbe\x61\x8e\xef\x45\x51\xfc\xf0\x81\xb5\x6d\x3a\x91\x24\xc9\x6c\x90\xc0\xe7\xcb\xdf\xaf\x66\x79\x72\xfa\x95\x25\xfe\x3e\xac\x57\xcb\x01\x38\x2a\xa7\x8b\xfb\xa7\x3f\x1f\xbf\x3d\x2d\xee\x1e\xee\xff\xc9\x8a\x81\xfd\x78\x77\xbb\x7a\x5c\x40\x9d\x7a\x46\x2d\xb5\xa1\xea\xa4\x55\x96\x58\xaf\x96\x3a\xd0\x98\x5f\xb5\x3b\xa3\x95\x0c\x84\x17\x3a\x60\x2f\x4d\x4b\xd8\x6b\x67\x64\x20\x8f\xd6\xea\xd7\x96\xce\xc4\x66\x79\xe4\x7f\x73\x3e\x34\x4c\xeb\xd5\x32\x6a\xf4\x22\xc9\x44\xa2\x6b\x3c\xe5\x70\x2f\xb8\x1e\x07\x91\x5e\xf8\x57\xd3\xb0\xdc\x6d\x8a\x0f\xf3\xcb\xbe\xc4\xb4\xf8\x56\xa6\xd0\xb2\xc5\xaf\x1f\x12\xba\xad\x6f\xf2\x28\xd2\xe7\x08\xdc\x92\x88\x25\xe2\x8e\x75\x14\x67\x69\x1b\x9a\x2c\x10\x55\x74\x8d\x71\x7c\x3e\x56\x0a\x52\x5b\x9f\x4e\x0a\x8e\xfd\x77\xfd\x63\xd8\xd5\x4f\x94\x8b\xf5\x7a\x31\xe5\x5b\x6d\x72\xd4\xd2\x78\x12\xbd\x10\x65\x09\x76\xc6\x3c\x4b\xf5\x02\x25\x8d\xf1\x08\x0e\xe1\xbd\xb8\x9f\x82\xd1\xa0\x6f\x2c\x77\x7e\xf0\x7a\xa3\xf7\x64\x8f\xeb\x7a\xd3\x61\x73\x3c\x80\x63\xee\x18\xd7\x35\x9c\x52\x2d\x73\xbc\xbb\xc1\x93\x53\x42\x1a\xde\x4f\x9e\x79\x78\x1f\x9a\x9c\x6c\x39\x52\xbb\x61\xf0\x1c\xe3\xd7\xf3\xf3\x36\xd2\xec\xcb\x18\xfe\x65\x1e\x1f\x30\x0c\x20\x7e\xce\x51\x6f\xc3\xe8\xd2\x3a\x9d\x7d\xf2\xd7\xf8\xb4\x9f\xe5\xe7\xd6\xcd\x07\x5e\x36\x4c\x40\xd7\x11\x99\xd6\xfa\x7f\x97\xf2\x9f\x85\x12\xf3\xf9\x00\xe3\xe7\x70\x8e\x64\x2b\xf4\xfd\xbf\x01\x00\x00\xff\xff\xd7\xe8\xc3\x3f\x99\x04\x00\x00")
+var _templateDialectSqlErrorsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5b\x6f\xdb\x38\xf6\x7f\x96\x3e\xc5\xa9\xd0\x36\x52\x47\x95\x93\xce\x1f\x7f\xec\xb8\xf0\x43\xa7\x49\x81\xec\x66\xba\x6d\x9c\xee\x62\x91\x09\x02\x46\x3a\xb2\x39\x96\x48\x87\xa4\x1d\x07\x1e\x7d\xf7\xc5\x21\xa9\x4b\x1c\x27\xd3\x1d\xec\xf6\xa1\x91\xc8\x73\xe7\xef\x5c\x28\x6f\xb7\xa3\x37\xe1\x47\xb9\xbc\x57\x7c\x36\x37\xf0\xee\xf0\xe8\xa7\xb7\x4b\x85\x1a\x85\x81\x4f\x2c\xc7\x1b\x29\x17\x70\x2a\xf2\x0c\x3e\x54\x15\x58\x22\x0d\xb4\xaf\xd6\x58\x64\xe1\xc5\x9c\x6b\xd0\x72\xa5\x72\x84\x5c\x16\x08\x5c\x43\xc5\x73\x14\x1a\x0b\x58\x89\x02\x15\x98\x39\xc2\x87\x25\xcb\xe7\x08\xef\xb2\xc3\x76\x17\x4a\xb9\x12\x45\xc8\x85\xdd\x3f\x3b\xfd\x78\xf2\x79\x7a\x02\x25\xaf\x10\xfc\x9a\x92\xd2\x40\xc1\x15\xe6\x46\xaa\x7b\x90\x25\x98\x81\x32\xa3\x10\xb3\xf0\xcd\xa8\x69\xc2\x90\x7c\x80\x7c\xa5\x8d\xac\x01\x95\x92\x4a\x03\x13\x45\xfb\x38\x67\xa2\xa8\x50\x69\x28\xa5\x02\x7d\x5b\x41\xc1\x59\x85\xb9\xd1\x60\xb9\xb7\x5b\x28\xb0\xe4\x02\x21\xf2\x1b\x23\x7d\x5b\x8d\x1c\x73\x04\x4d\x13\x96\x2b\x91\x03\xd7\xd3\xaf\x67\x1f\xa5\xd0\x46\x31\x2e\xcc\x09\x6d\xc7\xa8\x94\xd3\x92\x40\xfc\x66\x67\x33\x85\x1b\x29\xab\x04\xb6\x61\xb0\x66\x0a\xe2\x30\x08\x6a\x3d\x83\x09\x31\x64\x96\x22\x4e\xc2\x20\x18\x8d\x68\x41\x2a\xb2\xae\x66\x06\x96\xa8\x5a\x03\xb3\x30\x08\xbc\x0f\x13\xb8\xcc\xb2\xec\x4a\x1b\xc5\xc5\x6c\x1b\x06\x41\x10\x59\x11\x70\x74\xf8\xff\xef\xa2\x34\xe8\xfe\x8d\x46\xf0\xcb\xfd\xf4\xeb\x99\xdd\xf0\x92\xe3\x93\xf3\xeb\xe3\x6f\x5f\xae\x4f\x3e\x5f\x9c\xff\x2b\x21\xa9\x41\xf4\xed\xf3\xe9\xd7\x6f\x27\x90\x77\x36\x43\xc9\x78\x85\x45\x27\x6b\x34\x82\xe9\xd7\x33\x6e\xd0\xd1\x17\xab\x65\xc5\x73\x66\x10\x16\x78\x0f\x6b\x56\xad\x10\xd6\x5c\x56\xcc\xa0\x86\x95\xe0\xb7\x2b\x1c\x08\x8b\x52\xf2\xeb\x8b\xd4\x66\xa6\x70\xfa\xf5\x8c\x64\x34\x61\x90\x84\x01\x2f\xe1\x3a\x05\xb9\x80\xb1\x0b\x44\xfc\x46\xdf\x56\x33\xc5\x96\xf3\x6c\x27\x7e\xc9\x7b\x22\x23\x5f\x15\x9a\x95\x12\xf0\x7a\x87\x60\x5b\xeb\x59\x4a\x42\x9a\x14\x8c\x5a\x61\x18\x34\x61\x40\x67\xcc\x49\xb8\x62\x62\x86\x2d\x04\x48\x0a\x2f\xc1\x85\x4f\x93\x26\xc3\xb8\xd0\x71\x2b\x41\x2a\x7d\xc9\xaf\xec\x59\xfd\x07\xea\x48\x5f\x13\xb6\xf4\x82\x57\x29\x94\xac\xd2\x18\x36\x61\x38\x1a\xf9\xa8\xf4\x52\x34\xd4\x6c\xa9\x2d\xb4\x0d\xbb\xa9\x50\x83\x91\xf6\x4d\xb0\x1a\xb5\xc3\x37\x72\xf5\x38\x9a\x1a\x62\x82\x33\x17\x05\x6e\x50\x27\x3e\x3f\x48\x45\xc1\x0c\xbb\x61\x1a\x53\x8b\x77\x33\x7f\xc8\xe5\xc5\x97\x1c\xab\x42\x43\x2c\x5d\x2a\xe6\xb2\x5a\xd5\xc2\xea\xc3\x62\x46\xf2\xcc\x1c\xef\x21\x97\x6b\x54\x59\x48\x50\x7d\x6c\xf8\x84\x4c\xbf\x74\xe1\xbb\x1a\x3c\x5e\xf6\x88\xdc\x6e\xdf\xfa\x98\xbf\x14\x14\xff\x97\xd9\x67\x59\xa0\xa6\xfc\x09\xec\xe6\x1d\x37\x73\x78\x29\xb2\x6f\x8f\xa4\x5b\x92\x20\xda\x6e\x69\xfb\x82\x42\x03\x4d\x13\x8d\xdd\x69\x3c\x10\xcc\x6a\x4c\xe1\x65\xeb\xc1\x78\x02\x99\x93\xdf\xb1\xb3\xba\xe5\x85\x01\x1f\x27\x26\x6b\x54\xcb\xda\x34\xdb\x2d\xf0\x12\x5e\x72\x68\x9a\x14\xb6\x5b\x40\x51\x10\x27\x49\xc9\xfd\x03\x2d\xbd\x6d\x1a\x68\xd2\xce\x10\x5a\x72\x1a\xed\xe2\x70\x69\xf0\xec\xce\xff\x93\x8d\xfb\x27\xa9\x7a\x5f\xc1\x61\x45\x7f\xef\xb9\x30\x03\x4c\xd1\x91\xad\x51\x61\x01\x37\xf7\x96\x72\x65\x23\x48\x2a\xfa\xd3\xb6\x62\x2c\x42\x3c\xdf\x1d\xd3\x6d\x82\x16\xa9\xe5\x54\x4c\xe8\x8a\x19\x2e\x66\x5e\x5f\xc7\x4b\x00\xec\xb9\x14\x2e\xa5\x32\x56\x1d\xe9\x20\xda\x16\x67\xb0\xd2\x2d\xfb\x1e\x94\x3a\x04\xc3\x0c\x05\x2a\x46\x02\x74\x3e\xc7\x9a\x65\x70\xda\x3b\x2e\x78\x45\x71\x27\x09\x36\x37\x81\x6b\x52\x22\xa4\x01\xe6\x65\xbe\x1d\x58\xe6\x3c\xe0\x52\xa4\x40\xfe\x95\xc0\x8d\x1e\x5a\xce\xa9\xf8\x2c\x84\xbc\x13\x19\x7c\x92\x0a\x70\xc3\xea\x65\x85\xe3\x70\x34\x0a\x47\x23\x5b\x78\x73\x2a\xd2\x6f\xb6\x5b\xb0\x0e\xbc\xa4\xe4\x2f\xf9\x2c\xfb\xc2\xf2\x05\x9b\x11\x5a\x76\x0b\x0f\x31\xf2\xd2\x97\x85\xec\x83\xa6\x2a\x9f\xc2\x6b\x92\x43\x15\x62\x34\x0a\x6c\x95\xb9\x4e\xa1\xec\x0b\x0d\xed\x66\x7b\x4e\x3c\x6e\x59\xa8\x20\x9e\xdb\xc8\x0e\x9c\x97\x36\x93\x6d\xe9\x77\x70\x80\x32\x23\xf5\x41\x43\xff\xd3\x7f\xae\xf9\xc4\x08\xbb\xed\x25\xd9\x87\xaf\x38\x81\x36\x25\x29\x7b\x6c\xa0\x5c\xe7\xb9\xa7\xc6\x07\x00\x13\x88\xc8\x78\x2a\xe2\x07\x51\x18\x04\xfa\xb6\xe2\x06\xdd\xc6\x53\x3d\x61\x0c\x44\xb9\x74\xd5\x9c\x8a\x41\xf4\x74\xe5\x87\x5f\xa3\xc8\x16\xfa\x5a\xcf\x28\x3a\x98\xd5\x7a\x16\x06\xfa\x8e\x9b\x7c\x6e\x6d\xa2\x53\xd8\x5f\x88\x9d\x2d\xc9\x38\x1c\x74\x1f\x8f\x46\xbd\x9b\x22\x3b\x08\x8e\x31\x9b\x65\x10\x99\x2c\x3f\x4a\xc1\x64\xf9\xbb\x28\x49\x81\x0b\x6d\x90\x15\x94\x51\x04\x1b\x42\x79\xd6\x75\xe1\x5a\xcf\x7c\x15\xd3\xd9\x29\xe5\xcd\x03\x1b\x7e\xa8\x50\xc4\xad\x3d\x57\xae\x7b\xd8\xb6\xf2\x80\xe5\xe7\x7b\x83\x8e\xed\x60\x7c\x90\xbc\x07\x0e\x2f\x26\xf0\xf6\x88\xdc\x1c\xaa\x19\x73\x92\x40\x55\x83\xf0\x68\x6b\xbf\x8f\x80\x5f\xf2\x95\xa0\x3d\xba\xb0\x03\x58\xde\x03\xac\x55\x3c\x5d\x56\xdc\xc4\xed\xdb\x85\xe2\xf5\x74\xc9\x72\x6b\x46\x92\x42\x94\x42\xe4\xdb\xd8\x13\xe6\xe6\x29\x1c\x64\x07\x34\x79\x38\x9f\x26\xd6\xe2\xdf\x7f\xf7\x76\xbd\x98\x40\x14\xc1\xeb\xd7\xfd\x6b\x7e\x39\xe6\x57\xbe\x16\xf7\xad\x8e\x5e\xc9\xa3\xc0\xd1\x79\x32\x5a\xf0\xce\x4c\x80\x2d\x97\x28\x8a\xd8\xbd\xa7\x90\x5f\xf2\x1f\x8e\xc6\x57\x89\x0f\xc5\x70\x10\x78\xd4\x70\x2e\xad\xd4\xab\xf7\xf0\x42\x2e\x1e\x34\x65\xa7\xb9\xe9\x87\x02\x27\xfd\x59\x58\xb5\xc0\xb5\xc0\x22\x10\xc0\xf8\xc9\xe3\xef\x68\x2d\x00\x7a\xce\xe7\x21\x40\x42\x53\x38\x88\x1e\x61\x80\x36\x60\x02\xf4\x67\x80\x02\x6f\x7a\x0f\x5f\x97\xc9\x71\x14\xa5\x96\x34\x79\xd6\x9d\xe1\xe0\x97\xd0\x49\xed\x27\xb3\x19\xff\xa4\xcb\x67\x4c\x9b\x81\xdb\x8e\xd8\xfa\xec\xf9\xbe\xcb\xe1\x5f\x0f\xbe\xd3\xe3\x6e\x2a\xfd\x4b\x76\x98\x1d\xfd\x64\xa7\x15\x76\x23\xd7\x08\x4b\x85\x25\xdf\xd8\xec\xb6\xdd\xcb\x06\xc0\xcd\x0a\xdd\xa0\xd4\x65\xee\x9e\xf4\xf9\x43\x1b\xb3\x47\x26\x5a\x09\x29\xec\x58\xea\x16\x1c\x46\xff\xe0\x9c\x06\x02\x92\x9d\x09\xd0\x8f\x7e\xbb\x2c\xfb\xfa\x7e\xdb\x2d\xf9\x1a\xc5\xe3\x3a\x9a\xc1\x69\xd9\x8f\x8a\x83\x3e\x97\xb6\x3d\xb9\xa7\xa5\xdd\x4a\xca\x05\xdd\xb8\x96\x34\x1d\xb2\xaa\x72\xf9\xab\xdd\x60\xd8\x56\x3f\xa8\x57\xda\xd8\x5e\x7b\x83\xa0\xe7\xcc\xcf\x14\xb5\x54\xb6\xfd\x0b\x90\x02\x33\xd7\x71\x9e\x73\xda\x07\xfb\x61\xaf\xd9\x5b\xc7\xa8\x8c\x99\x74\x20\x4c\xf7\x05\xed\xf1\x94\xe9\xa7\xf4\xdd\x42\x44\x45\xc8\xad\x11\x41\x90\x4b\x61\xb8\x68\xe7\x6f\x2a\x62\x65\x5b\x47\x06\x7a\x2e\xc9\xd0\xab\xee\x0a\x61\xc9\x5c\xdc\x5f\x4c\xa8\x7e\x3d\x5d\xd0\xbc\x13\x13\x28\xbb\x09\xbf\x67\x9e\xf4\xcc\x43\xd6\x1e\x03\xbe\xe8\xb5\x21\x88\x05\xaf\x92\xd4\x73\x67\x59\x96\x78\x80\xd8\xdb\xe4\x31\xb2\xa2\x92\xf9\xc2\x25\x74\xdb\xe8\xfc\x74\xe4\x70\xd1\xce\x48\xc0\xa0\xf0\xd4\x7e\x74\x18\x8c\x6b\x64\x47\x3f\x1d\xb6\xb3\x9a\x3f\xc8\xc7\x9a\x86\xb7\x56\xba\xa2\xfe\xaf\x6f\xa8\xef\x8e\x7e\x8c\xd2\x07\x57\xd3\x77\x47\x3f\x7a\x91\x74\x35\x3d\xfb\xfb\xc7\xbf\x5d\x1f\x9f\x7c\x38\xa6\x07\x7f\x3d\xed\x9c\x2d\xd0\x60\x6e\xb0\xd8\xbd\x51\xc2\xff\x1d\x7e\x39\x3c\x6a\xa5\xb4\xe4\xd7\x2d\x79\xd2\xdf\x38\xff\x7b\x37\xc2\x7d\xb7\xbe\xe1\x85\x4f\xc9\xaa\xba\x61\xf9\x02\x72\x56\x55\xee\xfa\xb5\xc9\xce\xdb\x45\x4a\xc4\x3b\xd5\xde\x00\x87\xc7\xdb\x55\xbb\x4e\x80\x3f\xf6\x12\x64\x9e\xaf\x94\xa2\x0f\x2d\xf6\x30\x5b\x82\xd8\x6c\xba\x23\xb8\xd8\xa4\x30\x38\x51\xfb\x87\x8e\x94\x97\xa0\x68\x7d\x3c\x19\x9a\x11\x27\xef\xdd\xf2\x20\x0d\xe8\x75\x02\x65\x6d\xdc\x67\x89\x32\x8e\x5e\xdd\x8d\xe1\xd5\x3a\xb2\x82\x53\x4b\x9f\xb4\x89\x60\x57\x5c\xba\x3d\xf5\x49\xe4\xd1\xcd\x1d\x95\x1a\xc6\x8c\x5e\x5d\xc4\x7e\x5e\x55\x8b\x7f\xb0\x8a\x17\x76\xc6\x3f\x69\xc1\xbe\x0b\x69\x4f\x82\x50\xa3\x99\xcb\xa2\x2d\x9d\x37\xab\x6a\x01\x37\x2b\x5e\x15\xa8\xb4\xad\x8b\x14\xe3\xb9\xa4\x44\x25\xb6\x75\x27\xb9\x3d\xf5\x8e\xd1\xf1\x50\xd1\x6b\x87\xdc\xd4\x2b\xe3\xfe\x1a\x95\x85\xe6\x7e\x89\x7b\x2d\xd4\x46\xad\x72\x43\xa1\xb3\x16\xdb\x7b\xfd\x25\x17\xe6\xca\x6a\xf1\xae\x79\x67\xe8\x36\x52\x23\x55\xb7\xc1\x95\x47\x18\x54\x25\xcb\xdb\x14\xa5\xe9\x7e\x8f\x9e\x04\x7c\x0e\x7a\x94\x92\x42\x5e\x6c\x28\xf2\x35\x5b\x60\x7c\x79\xc5\x85\x49\xe1\x30\x05\xea\xdb\xe8\x0e\x4f\x27\x7b\x20\xef\xb7\x48\x80\x95\xd0\xcd\x66\xbc\xd8\xa4\xc0\xdd\xd9\x6a\xa9\x4c\x76\x2a\x8c\x8e\x79\xb1\x71\x03\xbc\x1e\xe8\x72\x36\x38\x5d\x44\xe0\xd5\xfc\x96\x0e\x35\x91\x70\x52\x52\xeb\x99\xbe\xfc\xed\xca\xa3\x6a\xba\x54\x5c\x98\x32\x8e\x7c\xdc\xe1\x55\xe1\xe1\xc5\xd3\xce\x38\xfa\xfe\xf2\x20\xb1\x86\x8c\xcf\xdc\xde\xc6\xf0\x8a\x3e\x8d\xd8\xb3\xee\x0f\x96\x0b\x8b\x8e\x31\xbc\xd2\x51\x6f\x73\xda\xe5\xfb\x5f\x25\x17\x34\x27\xe9\x14\xa2\xf7\x10\x25\x6d\x5d\x3e\xc7\x12\x15\x8a\x1c\x9f\xc4\xe2\x03\xd0\xf9\x4b\x33\xda\x3b\x3a\x19\xb6\xa2\x96\x6a\xf3\xf9\xe3\x1c\xf3\xc5\x39\x96\x0e\x98\x77\x73\x14\x1e\x5d\xf6\x72\x0f\xaa\x55\x04\xc2\x7e\x26\xb1\x82\x0a\x69\x9b\x33\x6e\xb8\x36\xf6\xd6\xdc\x63\x99\xf7\x13\x43\xcd\xb5\xa6\xee\x6a\xaf\xcd\xc4\xeb\x2d\x23\xc1\x7e\x54\xb2\xd0\xdd\xf1\xa5\x47\xed\x05\xed\x02\xf8\x60\x84\xc1\x2f\x4e\xe0\xf0\x1b\x8f\xc5\x96\x03\xe9\xb6\xf9\x73\x88\x7e\xa8\x7e\x1f\x98\xc9\xe0\x7d\x10\xeb\x10\xed\x0d\x6b\xb1\xd6\x0e\xb2\x2d\xaa\x5b\xbb\x09\x71\x4e\x56\x07\x6c\xfb\x3a\x1c\xd1\x2c\xba\xa7\xd6\x39\xed\x76\x9f\x87\xb8\x23\xf1\x8a\x79\xba\xab\x9b\x76\x7b\xa4\xf3\x47\x48\x7f\xa5\x1d\xc0\x89\x2d\xed\x4d\x75\x53\xc9\x9f\xc5\xb9\xb6\x98\xee\xa1\xa3\x5b\x28\x38\x1c\x78\xb0\x63\x46\xe7\xfb\xc7\x50\xd7\x48\xed\xe3\xf4\x58\xfb\xa7\x1e\x67\xa5\x92\xf5\xa0\x49\xb9\xd9\xab\xfb\x18\xc5\x45\x5e\xad\x0a\x2c\xda\xcf\xf4\xae\x93\x55\x5c\x1b\x37\x6c\xea\x9c\x09\xed\x87\xd4\x9a\x90\x21\x61\x6d\xc1\x4c\x33\x2c\xfd\x2a\x40\x47\x99\x53\x76\xb4\x9f\x92\x06\x0e\xc9\x72\x27\xc1\x6e\xb0\x74\xd3\x29\xde\x7b\xed\x1a\xe9\xe3\x94\x07\x5a\xe7\x44\x9c\x9b\x0d\xe5\xa0\xc1\x8d\xb1\xdd\x1c\x37\x26\x85\x42\xad\xbb\x3e\x79\xac\xf8\x1a\x55\x0a\x7e\x90\x75\xdf\x12\x7c\x94\x52\xeb\xf7\x03\xd0\xa7\xb0\xee\x61\xbd\x6d\x06\x8d\x55\xc9\x3b\x8b\x9a\xd7\xfa\xb6\xca\xce\xe5\x9d\xde\x36\x61\x70\xbb\x42\x75\x9f\x02\x53\x33\xbb\x47\x5b\xc7\x4e\x71\x5c\xa8\x75\xf7\x9c\xd8\xc1\x64\x6a\xad\x8e\x9d\x09\x76\xe5\x93\x92\x35\x7d\x6f\x70\x9f\x3f\xdd\xb0\xed\x68\xff\x39\x47\x85\x76\xeb\x54\x78\x0e\x6b\x2d\x0d\x93\x96\xe0\x2b\x69\xa6\x9f\x12\x5c\x73\x26\xed\xa4\xd1\x2d\xe7\x66\x93\xc2\xc0\xb6\x14\xc8\xfa\xe4\x3d\xec\x8c\x00\x3b\x8d\xba\xa0\x13\xb1\xa4\xd9\xc7\x4a\x6a\x8c\x93\x0e\xaf\x64\xc9\x34\x67\x62\x4a\xbf\xe3\xc4\x44\x92\xc2\x3a\x09\x9b\x70\xbb\x05\x14\x05\x34\x4d\xf8\xef\x01\x00\x73\xc0\x2d\x0a\x53\x1a\x00\x00")
 
 func templateDialectSqlErrorsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -681,12 +704,12 @@ func templateDialectSqlErrorsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 1177, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/errors.tmpl", size: 6739, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcf\x41\x6b\x02\x31\x10\x05\xe0\x7b\x7e\xc5\xc3\xa3\xd0\x5d\xeb\xad\x47\x11\x0b\x42\xe9\xa5\xfd\x03\x6b\xf2\xd6\x0d\x4d\x33\xeb\x4c\xb6\x20\x21\xff\xbd\x28\x16\x7a\x9d\x6f\x66\x1e\xaf\xd6\x7e\xed\xf6\x32\x5f\x35\x9e\xa7\x82\xed\xe6\xf9\xe5\x69\x56\x1a\x73\xc1\xeb\xe0\x79\x12\xf9\xc2\x31\xfb\x0e\xbb\x94\x70\x5f\x32\xdc\x5c\x7f\x18\x3a\xf7\x39\x45\x83\xc9\xa2\x9e\xf0\x12\x88\x68\x48\xd1\x33\x1b\x03\x96\x1c\xa8\x28\x13\xb1\x9b\x07\x3f\x11\xdb\x6e\xf3\xa7\x18\x65\xc9\xc1\xc5\x7c\xf7\xb7\xe3\xfe\xf0\xfe\x71\xc0\x18\x13\xf1\x98\xa9\x48\x41\x88\x4a\x5f\x44\xaf\x90\x11\xe5\x5f\x58\x51\xb2\x73\xeb\xbe\x35\xe7\x6e\x1d\xe0\x17\x2b\xf2\x8d\x73\x92\xd3\x90\x0c\x43\x0e\x98\x98\x66\xaa\x61\x14\x85\x5d\x12\x42\x1c\x12\x7d\x31\xdc\xcf\x6a\x45\xe0\x18\x33\xb1\x7a\x40\x6f\x97\xd4\x3f\x1e\xac\xd0\x5a\xad\x60\x0e\x68\xcd\xfd\x06\x00\x00\xff\xff\xa2\x7b\x06\xae\x26\x01\x00\x00")
+var _templateDialectSqlGlobalsTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x59\x59\x53\x1b\xc7\xb7\x7f\xd6\x7c\x8a\x13\x6e\x9c\x9a\xe1\x8e\x47\xce\x52\xa9\x0a\x29\x1e\x30\xe0\x8a\x2a\x8e\xed\x18\xb8\xf7\x81\xa2\x92\xd6\xf4\x19\xa9\xcd\xa8\x5b\x74\xf7\x0c\xe8\x8f\xf9\xee\xff\x3a\xbd\xcc\x22\x04\x21\x4f\xa0\x5e\xce\xf2\x3b\x7b\xcf\xfd\xfd\x74\x3f\x39\x56\xeb\x8d\x16\x8b\xa5\x85\x1f\xde\x7c\xff\xcb\xeb\xb5\x46\x83\xd2\xc2\x3b\x56\xe2\x5c\xa9\x6b\x98\xc9\xb2\x80\xa3\xba\x06\x77\xc8\x00\xed\xeb\x16\x79\x91\x9c\x2f\x85\x01\xa3\x1a\x5d\x22\x94\x8a\x23\x08\x03\xb5\x28\x51\x1a\xe4\xd0\x48\x8e\x1a\xec\x12\xe1\x68\xcd\xca\x25\xc2\x0f\xc5\x9b\xb8\x0b\x95\x6a\x24\x4f\x84\x74\xfb\xef\x67\xc7\xa7\x1f\xce\x4e\xa1\x12\x35\x42\x58\xd3\x4a\x59\xe0\x42\x63\x69\x95\xde\x80\xaa\xc0\x0e\x98\x59\x8d\x58\x24\xfb\xd3\x87\x87\x24\x21\x1d\xa0\x6c\x8c\x55\x2b\x58\xd4\x6a\xce\x6a\x03\x4c\x72\x58\x62\xbd\x46\x6d\xa0\x52\x1a\xcc\x4d\x0d\x5c\xb0\x1a\x4b\x6b\xc0\x5d\xbb\xbf\x07\x8e\x95\x90\x08\x7b\x61\x63\x6a\x6e\xea\x69\x20\xb0\x07\xfe\xc8\xb7\xeb\xeb\x05\x1c\x1c\xc2\x9c\x19\x84\x6f\x8b\x63\x25\x2b\xb1\x28\x3e\xb1\xf2\x9a\x2d\x90\xce\x24\xd3\x29\x7c\xd4\x1c\xf5\x89\x13\x55\x28\x19\xc8\x1a\xa7\x05\xef\x56\x55\x05\x4c\x82\xa2\xa3\x50\x09\xac\x39\x29\xba\x66\x0b\x21\x99\x45\x0e\x37\x0d\x6a\x81\xa6\x48\xec\x66\x8d\xdb\x14\x8d\xd5\x42\x2e\x92\xa4\x54\xd2\x58\x48\x93\xc9\x23\xa6\x47\xa6\x04\xb3\xc6\x52\x54\x02\x49\x7b\x60\xa6\x44\xc9\x85\x5c\x78\x96\x45\x32\x79\x7c\x61\xbc\x02\x87\xb0\x77\x74\x76\xbc\xb7\x83\xfa\x09\x8e\xc9\x03\xc7\x7f\x20\xef\x6e\x8c\x97\x88\xfe\xc9\x29\x31\xc8\x1c\x6a\x9f\xd8\x02\xdd\x89\x0e\xb0\x2d\x7c\x08\xb1\x2d\x84\x36\x05\x9c\x21\x3a\x64\x3f\x85\x0d\x22\xb5\x42\xbb\x54\xdc\xfb\x08\xfa\x83\x30\x6f\x44\xcd\xa3\xf9\x57\x4a\x93\x63\x55\x2a\xe0\xdb\xf3\x36\x56\x37\xa5\x85\xfb\x64\xf2\xce\x31\x05\x80\x08\xf7\xa4\x17\x7d\xac\x49\xe2\xcd\x7e\xdc\x68\xa3\x34\x08\x8e\xd2\x7a\xdc\x89\xfb\x5a\x19\x31\x30\x38\xf2\x05\x71\x1e\x69\x52\x2a\x29\x3d\xa5\x02\x66\x16\x96\xaa\xe6\xfe\xae\x20\x1d\x88\x34\xfd\x90\x14\x4f\xe4\xc7\xc2\x1a\x68\x59\xdd\xa0\x89\x1a\x0e\x50\x32\x79\x38\x43\xa1\x87\x92\x82\x90\x03\x73\x2e\xa0\xd6\xec\xa6\xc1\xa0\x4d\x50\x3c\xc8\xdc\x6b\x2d\x38\x69\x0c\x5f\x8c\x92\xc5\x67\x76\xfb\x07\x1a\xc3\x16\x98\x4c\x02\xc3\xcb\xab\xed\x1d\xaf\x7b\x19\x74\xf7\x72\x3b\xbe\xe4\x6b\x95\xd2\x2b\x66\x49\x4c\xcf\x28\x70\x2d\xb7\xb9\xce\x4e\x76\x71\x05\x80\xbf\x89\xdd\xc1\x9e\xd8\xfb\x3b\x99\xfc\xdf\x13\x22\xc4\x43\x6d\xae\x56\xc2\xe2\x6a\x6d\x37\x7b\x7f\x07\xb9\xfe\x60\xda\x2c\x59\x7d\x8e\x77\x16\xc4\x6a\x5d\xe3\x0a\xa5\x1d\x0b\x59\xd0\x66\x38\x87\x1a\x84\xb4\xa8\x2b\x56\x62\x91\x54\x8d\x2c\x21\x2d\x83\xec\xd9\x90\x58\x9a\x41\x7a\x79\x35\xdf\x58\xcc\x01\xb5\x56\x3a\x23\xf0\xe6\xee\x07\xe5\x07\x92\xa8\x08\xe7\x53\xaf\xee\xfd\xec\xe4\x00\xca\x42\xf0\x1c\xbc\x26\xf4\xcb\xc3\xfa\x90\x25\x13\x51\xb9\xbb\xdf\x1c\x82\x14\x35\x11\x9b\x68\xb4\x8d\x96\xf4\xd3\x91\x4d\x26\x0f\xc9\xc4\x92\x22\x07\x87\xb0\x62\xd7\xd8\x09\x40\xc9\xe8\xe7\x9f\x08\x91\x8b\xcf\xef\x4f\xa3\x5a\xee\x1f\xe4\xef\x51\xa6\x35\xca\x74\x9e\x65\x59\x32\x79\xee\x68\x4a\xc4\x73\x98\x67\x49\x64\xed\x17\xa4\xa8\x03\x9a\x17\x72\xf5\x42\x3c\xbb\x93\xbb\x11\xdd\x8f\x90\x8e\x28\x3a\x01\xc0\x6b\x95\x91\xca\x4a\x13\x10\xf3\x17\x2a\x7c\x82\x23\x85\x89\x98\xd3\x59\x76\x56\x79\xee\x5e\x3a\xcf\xc1\x5d\x79\xc6\x14\xd5\xca\x16\xa7\x24\x56\x95\xee\xc5\x62\xf0\xf0\x70\x00\x42\xb6\xac\x16\x3c\x44\xc1\x01\xbc\x6a\xf7\x1c\xcf\xcc\xd9\xac\x65\x1a\xda\xb0\xd7\x11\x8f\x3e\xd2\x01\x90\xce\x2f\x0f\xe4\x55\x0e\xdf\xb5\xd9\xaf\x43\xf6\x5f\xbf\x02\x99\xaf\x2d\x66\x27\x19\x1c\x1e\xc2\x9b\x7f\x2f\x10\xbc\xba\xd9\xeb\x94\x7b\x48\x26\xde\x09\xa3\xf3\xc1\x21\x10\xf1\x1c\xda\xc2\xfb\x65\x67\xfe\xde\xf0\x67\x2e\x67\x6c\x5b\x9c\xb8\xfb\x9d\xe7\xe3\xc6\x9f\x49\xb3\x90\x7a\x48\x01\x12\x26\x87\xbf\xc8\xb2\x65\x8c\x13\x72\xaa\xb4\x77\x3e\x7f\xd8\xf9\x44\x16\xc4\xa0\x34\x3d\x93\x95\x1a\xa4\xc8\x90\x45\x29\xc1\x52\x3e\xa7\x74\x13\x93\xed\x30\xaf\xf6\x69\xde\xdd\xef\x33\xcf\x6f\xcc\x7c\xc0\x3b\x4b\x3b\x94\x81\x60\xae\x54\x0d\x7d\xe2\x59\xf6\xdb\x94\x82\x7e\x63\xe6\x93\xc6\x56\xa8\xc6\xd0\xd2\x8e\xd3\xc3\x6d\xba\x71\x66\x99\xb6\x21\xcb\x12\xdd\xe0\xf9\xf1\x86\xe9\xb7\x47\xd9\x6b\x72\x2a\xf9\xe0\xd6\xa3\x7b\x28\xf9\x8e\x5b\xc1\x58\x1b\x59\x7e\x46\xd3\xd4\x16\x34\xae\x95\x0e\xd6\x2a\x97\x4c\x2e\x90\xfe\x67\x16\x6e\x51\x23\xb0\xf5\xba\x16\xc8\x61\xbe\x71\x07\xce\x36\xb2\xdc\x2a\x9d\x54\xc9\xec\x06\xca\x5a\x50\xda\x0c\xd9\x7b\x40\x7f\x90\xc1\xa5\x41\x4d\x8d\x0b\x39\x02\x4c\xa7\x7d\xbd\x75\xfc\x96\x8c\x83\x54\x60\xac\xd2\xc8\x03\xd9\x22\x99\x5c\xac\xb9\xab\x80\x4f\xdc\xe2\xa2\xaa\xa8\xfc\x6b\xb5\x22\x71\x84\x7e\x4c\x40\x7a\xb5\xf8\x6e\x02\x4c\x23\xe0\x4d\xc3\x6a\xb0\xea\x09\x0a\x27\x58\xe3\x48\x84\xe1\x01\x11\xf1\x22\x42\x6c\xee\xda\xe0\x28\x0d\x35\x3d\x82\x48\x19\xb4\x45\x8c\x93\x8d\x2c\x3f\xae\xc9\xe3\xc8\xf9\x2a\xb1\x68\x34\x9a\x7f\x0d\x6e\xa0\x40\x61\x94\xee\x9b\x6e\xc1\xf8\x3e\x69\xb0\x30\x88\x03\x15\x56\x54\xe5\x48\x04\x6a\xc3\xb3\xbd\xad\x4c\xa9\xd6\x08\x97\x57\x81\xc1\x4d\x5d\x9c\x21\x75\xc2\x4a\xc7\x40\x23\x12\x67\xee\x94\x46\x8a\xc3\x32\xf8\xd0\x93\xd8\xac\x98\x2d\x97\xc8\x5d\xef\xc1\x03\xa2\xf3\x8d\x13\x25\x40\xdf\x5d\x22\xfa\xee\x9e\xbb\xe3\x84\x5f\x88\x16\x25\xac\x35\x72\x51\x32\x8b\xa6\x80\x77\x4a\x03\xde\x31\xca\x37\xb9\xd3\x82\xf2\xc6\x90\x0a\x81\xa8\x24\x82\xba\x95\xa8\x41\xc9\x7a\x73\x90\x4c\xa7\xc9\x74\x3a\xd1\x68\xba\x84\xef\x1d\xb7\x38\x2f\x48\x90\xb4\xb4\x77\x79\xe7\x20\x39\x5c\xe3\x86\x4e\x4a\x5b\x74\xea\xa6\xb6\xf8\x8d\x99\x8f\x44\xf3\xff\x85\x5d\xa6\x8e\x7a\x31\x3b\x49\x05\xcf\xa8\x96\x4c\xa7\xbe\x29\xe8\x2f\xac\x0d\x14\x45\xb1\x03\xc9\x6c\x68\xca\xfb\x2e\xab\xb9\x93\x0a\x46\x66\x25\x9b\x4c\x54\xe1\xcd\x72\x48\x61\x89\x92\xa7\x61\x21\x87\xb5\x29\x8a\xc2\x65\xee\x87\xce\x01\x7e\xc7\x0d\x78\x8a\x64\x04\xa4\x40\xa7\x29\x4c\xda\x2e\xfd\xf5\xb8\x5e\xe3\x26\xf6\x8b\x0e\x77\x61\xa0\xa1\x79\xcc\x35\xc2\x64\x03\x6a\x6e\x43\x93\xd9\x85\x4f\xf0\x23\xb8\x15\x76\xb9\xcb\xf4\x05\x9c\x8b\x15\x46\xba\x14\x1e\xa5\x5a\xad\x19\x1d\x11\x12\x2e\xce\x8f\x43\x19\x08\xc2\xa6\xe1\xe0\xe5\x95\xab\x31\xc3\x52\x40\xe2\xf5\x05\xde\x6d\xe7\xbe\xe4\xd1\xbf\x86\x2a\x38\x49\x2a\x72\x68\xa9\x5c\x68\x0a\xf7\xc8\x97\x80\x13\x15\xd8\x1c\xd4\x35\x6d\xb6\x45\x6a\xc5\x0a\x0b\x92\x2d\xfb\x95\x16\xe9\xc4\xa4\x85\x43\xb0\xc5\xc5\xf9\x71\x9a\x15\xef\x5c\x8d\xf0\xc7\x3e\xbf\x3b\xfe\xf1\xc7\x1f\x7f\xf9\xc0\xa4\xca\x92\x09\xd5\xea\xc9\x35\x6e\x2e\xc5\x15\x1c\x42\x4b\x80\x77\x56\xa3\x4a\xb7\xd6\x42\xda\x2a\xdd\x7b\xf5\x3f\x54\xde\xaf\x71\x13\xa3\x85\x74\xfc\x14\x9d\xb7\x33\x0b\xeb\x1d\x7a\xe0\xef\x21\x1d\x68\x75\x6b\xe0\x76\xa9\x0c\x92\x1b\x42\xa9\xea\x66\x15\xe2\xd9\xbb\xf5\x96\x01\xcd\x00\xce\x8e\x55\x6a\x60\xe4\x73\x79\x47\xe7\xf2\xca\xe3\xeb\xc4\xa4\x96\xb9\xc3\xdd\x5d\xe8\x85\xbd\x77\x0d\x09\xa1\x1d\xae\xba\x1e\xe3\x7b\xb2\x4b\x6c\xf9\x7b\xdb\x74\x25\xfe\xfe\xc1\x5b\x88\x88\x93\x7d\xbc\x81\x7a\xeb\xd0\x7a\x40\xde\xd1\xf0\x88\xd2\xea\xa5\xb8\xba\x7c\x73\x15\xb0\x0e\xe0\x92\x44\x33\x99\x9a\xe2\x38\x0a\x71\xf9\xe6\x2a\xcb\x83\x8d\xa3\xef\x4f\x94\x1e\x88\x32\x56\x63\x2c\x4d\xf0\x96\xe0\x57\x5b\x12\x51\x82\x7a\x9e\x4c\x04\x22\xea\xf5\x25\x87\xb2\x27\x14\x76\x1d\xad\x09\x93\xfc\xf2\x0b\xa9\x46\xd2\x9c\xfe\xe9\x55\xc8\x1c\xe8\x97\x5f\xae\xa2\x4b\x29\xed\xf5\xa7\x43\x47\x92\xa7\x4c\xf2\x4e\xa9\x01\x04\x1f\x75\xaa\xb4\xdb\x08\x5e\x55\x32\xe9\x8c\x36\x0a\x74\x07\x4a\xa8\xe1\xcc\x80\x29\x99\x94\xc8\x29\xc7\xb6\x90\x0e\x3c\x27\x18\x6f\x50\xec\xeb\x5a\x91\x92\xb1\xdc\x8f\x58\x98\x5d\xa5\x49\xa0\xc9\xdc\xe8\x08\x0b\x94\xa8\x45\xe9\x2d\x52\xc0\x87\x8b\xf7\xef\x63\x04\x52\xe4\x7b\xf9\x28\xfb\x1b\x3f\xad\xc8\xa6\xae\xd9\xbc\x76\x3c\xa8\x0e\x19\x48\xb1\x58\x14\x4e\xcd\x0f\x4d\x5d\xfb\x86\x30\x7b\x74\xd9\x57\x68\xae\x45\x8b\x3a\x30\xf0\xe3\xac\xb2\x4b\x7a\x3a\x72\xa4\xe8\x12\x47\x8d\x15\x6a\x94\x25\xbd\x3a\xf9\xc8\x88\xba\xa4\x6d\xdf\x8b\xde\x3f\x64\x90\x86\x94\xd2\x8f\x69\xe6\x56\x50\xe5\x69\x63\xc6\xd8\xac\xd1\x8d\x6f\x25\x3d\xea\xec\x47\x21\xdf\x2a\x55\x1f\xf4\x5e\x1a\xba\xe3\x34\xdb\x3e\x37\x93\xf6\xe7\x9f\x5e\x72\xf0\x5d\xad\xd8\x0b\x8f\x7a\x80\x5e\x72\x92\x32\xdd\x8b\x28\x3a\x47\xd1\x74\x54\x54\xf0\x8d\xe3\x2c\x38\x69\xdd\xdd\xf5\xa6\x13\xf5\x38\x3a\x35\x56\x54\xd1\x8a\x99\xf4\x6f\x57\x69\x5c\x70\xa2\x7f\xac\xd2\xb6\x38\xcb\xb2\x62\x16\x21\x4f\xb3\x40\xc4\xf3\xf7\xd3\x5c\x60\xbb\xdf\xc2\x61\x3f\x54\x3d\xcf\x77\xbf\x0d\x8b\x1c\x2b\xd6\xd4\x96\x48\x68\x67\xb2\x47\x02\x50\xa0\x89\x0a\x74\x5b\xfc\x2e\x24\x4f\x33\xf8\xa6\x3f\xf4\xc9\x6a\xf8\xfa\x95\xf6\x66\xe6\x83\xa8\xd3\xec\x31\xeb\xe1\x10\xd5\x48\xbc\x5b\x63\x49\x61\x42\xc1\xe1\x5c\x0e\x5e\x9d\xef\xe5\xd0\x66\x63\xf9\x74\x5b\x9c\xd6\xb8\x4a\x77\xa9\x1e\x2b\xf5\xdb\xa6\xbe\x0e\x9d\x72\xdf\xa9\x69\xbf\x10\xfa\x97\x79\x68\x82\x62\x3c\xb3\xb6\x6f\xc8\x8f\x35\x32\x8b\x44\xe4\x9d\x56\xab\xc7\x0f\x5b\x3b\x1b\xc8\x01\xcf\xbe\xe3\x9b\x4e\x61\x76\x32\x6c\x17\x05\x37\xdb\xb1\x1e\x84\xa0\x5c\x51\x3a\xc6\x3c\x3e\xbd\x3a\x19\xf3\xf8\xcb\x3d\x36\x39\x92\x9e\x80\xd0\x5d\x4f\x55\xc0\xf9\x12\x3d\x66\xe3\x34\x24\xba\xf7\xac\xe1\x66\xd7\x83\x93\x6c\xa1\x42\x39\xc2\xa7\xba\x7f\x4a\xa2\xa8\x8d\x17\x9c\x20\x05\xcc\xaa\xf0\xbe\x65\xd0\xe6\x63\x1d\x86\x07\x7d\xde\x93\xca\x46\x7d\x8a\x64\x42\x94\x5d\x22\x08\x16\x3a\xd1\x9b\xcf\x8d\x0c\x49\x88\x52\x9d\xc4\x5b\x6a\xdd\x69\xea\x74\x0f\xc5\xba\x91\x92\x7a\xcf\x55\xe3\x5b\x2b\x43\x30\x70\xbd\x79\xad\x1b\x09\x2b\xc5\xd1\xeb\x6c\x2c\xb3\x61\x32\xf6\x22\x90\xb5\x3c\xdb\x1c\x1a\x37\xe5\xbc\xa6\xec\xdc\xf7\xc7\x1d\x68\x7d\x13\x8d\x77\x58\x36\xe4\x7a\xa1\xe9\x12\xa6\x13\x85\xb6\x35\x96\x84\x3d\x99\x85\xa8\x0b\x0b\xa9\x41\x0c\x2a\x9c\x75\x02\x64\x3e\x65\xb2\xa0\x7b\x47\x54\x79\xf3\x71\x66\x19\xbd\x83\x14\xf0\x36\x0a\x10\x3c\x9a\x51\x77\x61\x97\x68\x45\x49\xf4\xbd\x9f\x1e\xb8\x4b\xd1\x21\x88\x30\xfd\x6e\xc2\xdc\xd6\xe1\x1e\x1a\x97\xda\x3b\xae\x7f\x89\x1c\x38\x94\x41\x0b\x54\xf7\x80\xc1\x7f\x50\x2b\x22\x4f\x4f\x10\x22\x1a\xd2\x15\x98\x41\x71\xea\xa4\x0c\xca\x38\xc8\xa8\x57\x0e\x13\xad\xa3\x02\xac\xaa\x7c\xa8\x52\x37\x45\xfa\xd4\xd7\x1d\xec\xee\x30\x71\xf4\xa2\x9a\x0e\x0f\xd3\xac\x69\x26\x46\xbe\x6d\xc8\x1c\x8c\x90\xf4\x29\x61\xe9\x7a\x6b\xc6\xbb\xb9\xb3\xc7\xcc\x37\x61\x1e\x71\x1a\x27\xa2\x7d\xe8\x43\x00\xfd\xcd\xb6\x17\x06\x9d\x3f\x65\x62\x1a\x2a\xfa\xdb\xb1\xd2\x6f\x5b\xb0\x73\x47\x3b\xf6\xac\x34\xc0\x2f\x34\x30\xbd\x68\xbc\xb5\x07\x28\x0f\x1c\x24\xba\xa0\x6f\x20\xa3\x9a\x41\xb8\x18\xca\x42\x07\xef\x70\x23\x03\x85\xf5\x48\xc1\x5e\xa0\xdd\xaa\x5e\x5e\x91\x4a\xdd\xa9\x2d\x55\x77\xd1\x88\x0a\x13\x0c\x7f\x36\xa8\x37\x6f\x1b\xbe\x40\xfb\x44\xf8\x39\xc5\x58\x5d\x53\xab\x1c\xe4\x94\x0b\xa0\xc1\x51\x19\xea\xa6\xef\x86\xd8\xf4\x4d\x8d\x9f\xf9\xbc\xbb\xd1\x53\xb9\xd5\x4c\x1a\xe6\x9e\xd7\x4d\x46\xaa\x97\x75\xc3\xe3\x38\x39\xa0\xf0\x38\x0c\xe7\x1b\x40\xb6\x40\xfd\xba\x56\x8c\x87\x21\x89\xa8\xce\x37\x30\x6f\xea\x6b\x50\x6b\xd4\x3e\x29\x14\xd0\x2b\xea\x0d\x82\x77\x25\x52\x5f\x46\xc9\xc8\x2b\xb9\x1d\x90\xde\xb3\x2b\x26\x6a\x3f\x64\x31\x22\xbf\x3f\x80\xe5\xd4\x91\x40\xee\x6a\x93\x4f\x33\x81\x14\xa5\xbe\xa5\x9b\xb5\xe6\x1b\x60\x75\x1d\x21\x33\xc0\x91\x9a\xa7\x81\xeb\x76\xed\x95\x92\xd1\x7d\xb7\xd0\xdf\x65\xdc\xdc\xc1\x2b\xe4\x8b\x1c\x7a\x8b\x96\xbb\x1b\x2d\x3d\xd8\xbb\xa0\x99\x73\xe8\xd9\xb2\x59\xcd\x69\x76\xaf\x86\x76\xec\xbd\x79\x3b\x1b\x86\x0f\x39\x44\x35\xc0\x10\x92\xbd\xf7\xf1\x20\x67\x44\xb5\x36\x08\x34\x19\x2e\xb1\xf3\x27\xae\xd0\x38\x0b\x2c\x59\x8b\xc0\x02\x95\x00\xca\x96\xa0\xbb\x3d\x3e\x15\xd2\xe6\xee\xd1\x2f\xdb\xc2\x61\xc7\xf5\x1d\x10\x8c\x2c\x4a\x79\xaf\xb3\x0e\x95\x9a\x67\x7d\x91\x55\x16\xf5\x33\x28\x08\xdd\xe9\x79\xcb\xfc\x80\x1f\x1a\x82\x27\xf9\x1f\x6e\x4b\x3e\xda\x76\xe5\x71\x66\x76\x6c\x77\x46\x64\x0e\x0a\x64\xf4\xf4\xea\x26\x28\x8a\xaa\xdb\x25\xfa\x66\xbd\x2b\xde\x82\x02\x3b\x7e\x84\x73\x22\xbb\x0a\x1c\x80\xdf\xc9\x22\xc5\x58\xa7\x33\xc7\x23\xcc\xa9\xb4\x3a\x68\x20\x03\xfe\xce\xd8\xdd\x4b\x3b\x3e\x1d\x43\x9d\xc5\x1c\x65\x53\x1c\x19\xe2\x93\xc3\x77\x18\x6d\x45\x70\x5f\x84\x82\x11\xa0\x37\x03\x1f\xbb\xf8\x74\x72\x74\x7e\x3a\x30\x94\xf7\xb6\xa1\x53\x5b\x65\x59\x3d\x70\xed\x71\x8d\xf2\x2a\x0f\xb8\xec\x0e\x3e\xae\xdb\xf8\x75\xba\x38\xa1\x80\xd6\xb1\x89\xa0\x3e\xc9\x4d\x09\xfe\x7e\x28\xe1\xd1\x33\xfb\x11\xa7\x65\xba\x2f\x8f\x42\x5a\x3f\x15\xff\x95\x43\xd3\x8f\xb2\x91\x22\x35\xc4\xf4\x76\xd9\xbb\x5f\x4c\x55\xc2\x98\x66\xe4\x7b\x43\x17\x2b\x99\x2c\xb1\x26\x37\x9b\x0c\x3e\x6a\x94\xf6\x8e\x3e\x91\xa4\xe3\x8f\x18\xc3\x9e\xfb\x4d\xf8\x9a\x45\x06\x9b\x38\xb7\xc8\xa9\x9c\xb9\x87\x86\xa6\x70\xb6\x4b\xb3\x11\xcd\xe6\xdf\x50\x24\xcd\xe9\xd1\x95\x40\xf2\x7d\xf0\x88\x16\xa7\xbe\xfd\x0e\xc3\x63\xe0\x80\x7d\x0e\xdf\x69\x34\x2f\x64\xd2\x7f\x52\xd2\x68\x8a\xcf\xea\xd6\x1c\x05\xb0\x87\xa2\xff\x13\x95\xce\x40\xff\x7b\x48\x23\x6b\x2a\x47\x6f\x02\x71\x37\x7e\x80\xbb\xbf\x07\x94\x1c\x1e\x1e\x92\xff\x0e\x00\x3a\x39\xa4\x00\xa5\x21\x00\x00")
 
 func templateDialectSqlGlobalsTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -701,12 +724,12 @@ func templateDialectSqlGlobalsTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/globals.tmpl", size: 294, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/globals.tmpl", size: 8613, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x52\xcd\x6e\xdb\x3c\x10\x3c\x8b\x4f\xb1\x5f\x10\x7c\x90\x5c\x95\x4e\x73\x6b\x8b\x1c\x12\x23\x2d\x02\x14\x05\x5a\xf7\x56\x14\x85\x42\x2e\x6d\x22\xcc\x52\x5e\x52\x4e\x0c\x81\xef\x5e\x90\x91\x93\x34\x3f\x87\x9e\x44\xec\xcc\xee\xcc\x8e\x76\x1c\xe7\x33\xb1\xf0\xfd\x8e\xed\x6a\x1d\xe1\xf8\xe8\xdd\xfb\xb7\x3d\x63\x40\x8a\xf0\xa9\x53\x78\xe9\xfd\x15\x5c\x90\x92\x70\xea\x1c\x14\x52\x80\x8c\xf3\x16\xb5\x14\x3f\xd6\x36\x40\xf0\x03\x2b\x04\xe5\x35\x82\x0d\xe0\xac\x42\x0a\xa8\x61\x20\x8d\x0c\x71\x8d\x70\xda\x77\x6a\x8d\x70\x2c\x8f\xf6\x28\x18\x3f\x90\x16\x96\x0a\xfe\xe5\x62\x71\xfe\x75\x79\x0e\xc6\x3a\x84\xa9\xc6\xde\x47\xd0\x96\x51\x45\xcf\x3b\xf0\x06\xe2\x23\xb1\xc8\x88\x52\xcc\xe6\x29\x09\x31\x8e\xa0\xd1\x58\x42\x38\xd0\xb6\x73\xa8\xe2\x3c\x6c\xdc\x7c\xc5\x7e\xe8\x0f\x20\xa5\x4c\x38\xbc\x1c\xac\xcb\x76\x3e\x9c\x40\xdf\x05\xd5\x39\x38\x94\x4b\xe5\x7b\x94\x67\x13\x32\x11\x19\x15\xda\xed\x1d\xf3\xfe\x7d\xdf\x9e\xf5\xcc\x40\x0a\xea\xbf\xb8\x29\xc1\xec\xb1\x4a\x4a\x0d\x84\x8d\x5b\xaa\x8e\x6a\x15\x6f\x41\x79\x8a\x78\x1b\xe5\xe2\xee\xdb\xc2\x16\x2c\x45\x64\xd3\x29\x1c\x53\x03\xc8\xec\x19\x46\x51\xb1\xbf\x09\x59\xf9\xff\xb0\x71\xf2\xbb\xbf\x09\x63\x12\xd5\x66\x40\xde\xb5\xd0\xf1\xaa\x60\x4f\x94\x65\xd8\xb8\x6f\x99\x51\x37\x72\xfa\x8a\xca\x9a\x3c\xf3\x25\xb6\xe6\xfc\x9a\x98\x2a\xde\xb6\xf0\x68\x7c\x0b\xd9\x40\xf3\xb1\x34\xff\x77\x02\x64\x5d\x76\x55\x31\xc6\x81\x29\x57\x45\x95\x44\xa5\xd1\x20\x17\xaa\x5c\x38\x1f\x30\x2b\x4e\x94\xec\x3b\xaf\xbd\xcc\x3f\xba\xce\x94\x16\xb6\x8d\x48\xe2\x5f\x72\x9b\xd6\x80\x59\x99\x86\xae\xdc\x40\x36\x12\xf6\xef\x97\x63\x10\x95\xf2\x6e\xb8\xa6\x12\xd3\x75\x77\x85\xf5\xcf\x5f\x21\xb2\xa5\x55\x0b\x47\x2d\x38\xa4\xa7\xf2\xd2\x58\x74\x3a\x34\xf0\xe6\x19\x9a\x41\x0a\x4d\xf3\x30\xf4\x04\xba\xbe\x47\xd2\xf5\x54\x68\x9f\x79\xb8\x9b\x26\xa5\x6c\x44\x65\x3c\xc3\xef\x16\x0c\x95\x4b\xea\x68\x85\xcf\xe9\x14\x4a\xbc\xaf\x0b\x18\xaa\xf7\x3b\x67\x27\xe9\x21\xe7\xa9\x3a\xc5\xb3\xef\xc8\xd2\xf2\x73\xbe\xfc\xb3\xdd\x2b\xbb\x16\x77\xe5\xd6\x91\x34\xa4\xf4\x27\x00\x00\xff\xff\x7a\xa1\xbc\xa1\x03\x04\x00\x00")
+var _templateDialectSqlGroupTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\x5b\x4f\xe4\x38\x13\x7d\x8e\x7f\x45\x4d\x34\x42\x09\x5f\x70\x9a\x79\xfb\x58\xb1\x52\x0f\x62\x19\xa4\x11\x3b\xbb\x8d\xf6\x05\xa1\x95\xb1\x2b\x69\x8b\x60\x07\xdb\x69\x68\x45\xf9\xef\xab\xca\xa5\xbb\x69\x2e\xb3\xfb\x44\x70\x9d\xaa\x3a\x75\xea\xd2\x6d\x9b\x1f\xb2\x33\x5b\xaf\x9d\x2e\x97\x01\xbe\xcc\x8e\xff\x7f\x54\x3b\xf4\x68\x02\xfc\x26\x24\xde\x59\x7b\x0f\x97\x46\x72\x98\x57\x15\xf4\x20\x0f\x64\x77\x2b\x54\x9c\x5d\x2f\xb5\x07\x6f\x1b\x27\x11\xa4\x55\x08\xda\x43\xa5\x25\x1a\x8f\x0a\x1a\xa3\xd0\x41\x58\x22\xcc\x6b\x21\x97\x08\x5f\xf8\x6c\xb2\x42\x61\x1b\xa3\x98\x36\xbd\xfd\xfb\xe5\xd9\xf9\xd5\xe2\x1c\x0a\x5d\x21\x8c\x6f\xce\xda\x00\x4a\x3b\x94\xc1\xba\x35\xd8\x02\xc2\x4e\xb2\xe0\x10\x39\x3b\xcc\xbb\x8e\x31\xaa\x01\xe6\x4a\xe9\xa0\xad\x11\x15\x14\x1a\x2b\xe5\xa1\xb0\x43\xf2\xd2\xd9\xa6\x3e\xba\x5b\xc3\x5d\xa3\x2b\x85\x8e\x43\xef\xd6\xb6\xa0\xb0\xd0\x06\x21\x56\x5a\x54\x28\x43\xee\x1f\xab\xbc\x47\xe7\x43\x88\x18\xba\x8e\x45\x4b\xb1\xd2\xa6\xf4\x70\x73\x7b\xe8\x1f\x2b\xfe\xc3\xa1\xd2\x52\x04\x64\x6d\x7b\x04\x68\x14\x81\x3e\x8c\xd6\x87\x69\x5b\xf8\x3c\x12\x80\x93\x53\xa8\x85\x97\xa2\x82\xcf\x7c\x21\x6d\x8d\xfc\xeb\x68\x19\x81\x0e\x25\xea\xd5\x80\xdc\x7c\x6f\xdc\x29\x5f\x9e\xc3\xb7\x9e\x17\x08\xa5\x3c\xd4\x13\xa9\x6d\xd9\xdf\xe6\x7f\x5d\x5e\x5d\x80\xac\x44\xe3\x71\x90\x6f\x47\x8b\xc7\x06\xdd\x9a\xc3\xf5\x12\x77\x7d\xa5\x30\xe0\xb0\x40\x87\x46\x22\xcb\xf3\xad\x0f\xaa\x51\xd6\x0c\xc6\xf8\xa2\x2c\x1d\x96\x22\xa0\x82\x95\xa8\x1a\xf4\x70\xb7\x26\x83\x76\x60\xc4\x03\x7a\x48\x90\x97\x1c\x62\x69\x1b\x13\xe2\x8d\x1b\xd9\xa0\xd4\x2b\x34\x84\x9f\xfb\x94\xb3\x3c\x67\x79\x1e\xc9\x4a\xa3\x09\x9c\x74\xe2\x57\x04\xea\x3a\xfe\x07\xb1\x4c\x7a\x48\x14\x5d\x10\x8f\xaf\xeb\x84\x73\x3e\xbe\xcc\x27\x0a\x49\xdb\xc2\x9d\xf0\x08\x9f\xf9\x99\x35\x85\x2e\xf9\x0f\x21\xef\x45\xd9\x07\x39\x23\x02\x49\x3a\xfa\x0c\xaa\x25\xd4\xc9\x8b\xeb\x64\xc3\xee\x78\x36\x9b\x10\x0b\x29\x4c\x22\xc3\x73\x06\x07\xab\x94\xc8\x15\x8d\x91\x90\xbc\xe8\x4b\xd7\xc1\xe1\x6e\x47\xbb\x2e\x1d\xfb\x91\xd4\x1e\x38\xe7\x2f\x47\x25\xdd\x47\x43\xcb\xa2\xbd\x80\x7c\x9a\xb3\x53\x10\x75\x8d\x46\x25\xef\x00\x32\xa8\x3d\x69\xc0\x22\x87\xa1\x71\x06\xf6\x70\xac\x63\xff\x96\xb2\x7f\xac\xa6\x6a\x41\x5a\x13\xf0\x39\x90\x80\xf4\x37\x83\x15\x68\x13\xd0\x15\x42\x62\xdb\xa5\x80\xce\x59\x47\xbc\x65\x78\x86\xd3\xfd\xa4\xfc\x49\x87\xe5\xef\x35\x3a\x41\x4b\x48\x01\x33\x88\x77\x7b\x19\x67\x10\x8f\x1d\x8c\x53\x16\x79\xa4\x1d\xb1\xfd\x84\xef\x87\xf2\x8f\xd5\xd8\x78\x16\xf5\x73\x9a\x81\x70\xa5\x27\xe8\xe4\x36\x4d\x06\x8b\x74\x41\xcc\x5e\xd8\xce\x9d\x4b\xd2\x5f\xfa\xe7\x4f\xa7\x60\x74\x45\xac\x27\xb1\xd0\x39\x16\x75\x2c\x72\xf6\xa9\x8f\x78\x40\x8d\xfa\xd3\x3e\xf9\xb6\xdb\x0d\xb6\xcf\x49\x39\xfa\x1a\xd3\xf6\xd5\xed\x30\xcb\x80\xa2\xfd\x34\xa5\xa2\xcd\xea\xa1\xfc\xac\xb2\x1e\x93\x6d\x0b\x89\x04\x75\x62\x41\xe7\x31\x21\x48\x06\xab\x94\x3a\xf9\x1f\x5a\x39\x6a\x02\xfd\xec\x2d\x46\x35\xa8\xf6\x9f\x88\xcd\x22\x69\xab\xe6\xc1\xf4\x7a\x3c\x88\x7b\x4c\x6e\x6e\x7d\x70\xda\x94\x19\xcc\x32\xa8\xd0\xec\xa7\xe7\xc3\x2d\x48\xe1\x7f\xaf\xac\x64\x34\x3e\x4d\xb7\x41\x37\xf3\x3c\x3e\x64\xaf\x38\x0c\xd1\x86\x99\xa6\xf3\xf5\x77\x06\x85\x21\x32\x4e\x98\x12\x5f\xc3\x8d\xa7\xaa\xc6\x04\x84\x2b\x4c\x32\xd5\x98\xb2\x28\xca\x73\xe8\x2f\xc8\xdb\x67\x4a\x1b\xb0\x8e\x64\x0b\x76\x7b\xec\xe8\x36\x3d\x4c\x3f\x3d\x2f\x4e\x27\x67\x11\xcd\xc5\x5b\x2a\x8c\x0b\x99\xc2\xaf\x30\x83\x83\x03\xf8\x34\xa8\xe6\xfb\x1d\x12\xda\xf8\x64\xa0\x98\x41\x0c\xf3\x05\xc4\x69\x4f\x7b\xe2\x7d\x0a\xd4\xa7\xf9\x16\x34\x79\x5f\xdb\xef\xf6\x09\x5d\x32\xfd\xbf\xa8\x2b\x1d\xb6\xa1\x92\x38\xbd\x99\xdd\x92\xc2\x51\xb7\x51\xe1\x2d\x99\x07\x8f\xb4\x9f\xbd\xcd\x08\xec\xec\xc9\x30\x22\x13\x9e\xe4\xe7\xd3\x8d\xfd\xa8\x43\xfe\x49\x07\xb9\xfc\x58\x10\x3a\x13\x74\x8f\x67\x27\xe3\xc7\xf1\x09\x8b\x36\x73\xc8\xc7\x53\xf9\x8e\x3b\x55\xc7\x22\x85\x85\x68\xaa\xf0\x96\x5f\xaf\xdb\xfb\x07\x92\x68\x0e\x55\x4f\xdb\x35\xfa\xb3\xfe\xd7\x15\x8d\x82\xae\x63\xff\x0c\x00\x1e\xae\x9b\xc9\xfa\x08\x00\x00")
 
 func templateDialectSqlGroupTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -721,12 +744,12 @@ func templateDialectSqlGroupTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/group.tmpl", size: 1027, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/group.tmpl", size: 2298, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x6f\xeb\x36\x10\x3c\x4b\xbf\x62\x61\xf8\xf0\x5e\x90\x50\x79\xbe\xb5\x80\x0f\x0f\x6e\x02\x18\x89\x83\xb4\xc9\xad\x28\x0a\x5a\x5c\xc9\x84\x69\xd2\x21\x69\x3b\x82\xa0\xff\x5e\x90\xd4\x07\xe5\xda\x41\x81\xde\x24\x2e\x39\x3b\x33\xdc\x61\x5d\x67\x37\xe9\x42\xed\x2b\xcd\xcb\x8d\x85\xd9\xfd\x8f\x5f\xee\xf6\x1a\x0d\x4a\x0b\x8f\x34\xc7\xb5\x52\x5b\x58\xca\x9c\xc0\x4f\x21\xc0\x6f\x32\xe0\xea\xfa\x88\x8c\xa4\xef\x1b\x6e\xc0\xa8\x83\xce\x11\x72\xc5\x10\xb8\x01\xc1\x73\x94\x06\x19\x1c\x24\x43\x0d\x76\x83\xf0\x73\x4f\xf3\x0d\xc2\x8c\xdc\x77\x55\x28\xd4\x41\xb2\x94\x4b\x5f\x7f\x5e\x2e\x1e\x5e\xde\x1e\xa0\xe0\x02\xa1\x5d\xd3\x4a\x59\x60\x5c\x63\x6e\x95\xae\x40\x15\x60\xa3\x66\x56\x23\x92\xf4\x26\x6b\x9a\x34\x75\x1a\x20\x57\xd2\x58\x2a\xad\x01\x89\xc8\x90\x41\xa1\x34\x98\x0f\x01\x8c\x53\x81\xb9\x35\x04\xfc\xee\xba\x06\x86\x05\x97\x08\x93\xb6\x92\x99\x0f\x91\xed\xd0\xd2\xac\xc7\x98\x40\xd3\xa4\x49\x96\xc1\x3b\x5d\x0b\x84\x8d\x12\xcc\x78\x52\xd6\xff\x4b\xba\xc3\x40\x08\xa1\xae\x41\xa8\x13\x6a\x98\x92\x17\xb7\xdc\x34\x9d\x00\x46\x2d\x5d\x53\x83\x24\x4d\x02\xcc\x1c\x26\x75\x0d\x53\x12\xfe\x9a\x66\x92\x26\x75\x7d\x07\x9a\xca\x12\x61\xfa\xf7\x2d\x4c\x11\x7e\x9d\xc3\x94\x3c\xb0\x12\x8d\xa7\xe0\x38\xb8\x33\x18\x0e\x2d\x5a\x82\xbe\x4b\xcc\xc8\x7d\x0d\x2c\xc3\x89\x8e\x8e\x46\x41\x2d\x57\x32\x43\x56\x3a\x32\xbe\x29\x2f\xdc\x96\xd5\x6c\xe5\x76\xbc\x6f\x10\xf6\x9a\xef\xa8\xae\x60\x8b\x15\x30\xcc\x05\xd5\xc8\x60\x8d\x42\x9d\x48\x5d\x03\x4a\x16\xf8\x5c\x21\xd3\x4a\x43\xf2\x07\x8a\x58\x5f\xd7\x4b\x62\xaf\xdb\x1d\xaf\xf6\xd8\xef\x4a\x93\x48\xe5\x52\x1e\x51\x1b\xfc\x5a\xac\xb7\xdf\x5d\xef\xa0\xd5\x23\x76\x82\x51\x5a\x6e\x2b\xd2\x02\x2f\x2d\xe0\x27\x37\xd6\x84\x7b\xe1\x06\xf6\x34\xdf\xd2\xd2\x0f\x9a\xd2\x7e\x44\x15\xd0\xa3\xe2\x0c\x72\xae\xf3\x83\xa0\x1a\x18\xee\x51\x32\x94\x79\x05\x27\x6e\x37\xbe\xd3\x24\x6a\xf5\xda\x42\x34\xcd\xa4\x83\xf3\xfd\xbe\x56\x31\x1f\x61\x9c\xdb\x14\x79\x1c\x3c\x53\x76\xb8\xa3\x91\x4b\x0b\x25\x0e\x3b\x79\xd5\x9f\xdc\x97\x81\xa1\x54\x96\xcb\xf2\xbf\x8c\x44\x72\x0d\x78\x74\xb1\xa1\x7c\x81\x72\xf4\x3d\x0c\x4b\xc8\xe5\x91\x6a\xee\x58\xfd\x9f\x5c\xf6\x18\x7d\x2e\x03\x13\xd3\xce\x3c\x15\x02\xde\x7e\x7f\x6e\x85\x1b\xdf\xe2\x42\x2e\x0b\x8e\x82\x19\x92\x26\x47\xaa\x7b\x84\x39\xfc\xf9\x97\xb1\x9a\xcb\xb2\x6e\xc7\x9b\x2c\x7f\x23\x91\x05\xb7\xad\xd6\x36\xa6\x45\x88\xe8\xa3\xc7\x6a\x2f\xc6\x9d\x2a\x2e\x9d\xe9\xfc\xf1\x16\x65\x37\xee\x56\xa9\xac\xba\xa7\x03\x7d\xcc\xd5\x49\x1a\xa0\x8e\x33\xf2\x52\xde\xb9\xfc\x79\x43\x1c\xaa\x9f\xbd\x29\x79\x0c\xb5\x27\xac\x86\x57\x21\x5e\x1b\x92\xef\x5c\x88\x90\xdc\x22\xb5\x40\x35\xba\x36\x2e\xd0\x55\x3f\x0d\xbd\x2d\xd6\x0d\x63\x9a\x78\x57\x62\xd4\xb1\x33\x23\x0f\xb6\xce\x04\xd2\xaa\x4f\xfc\x84\x14\xdb\xe0\x49\x07\x3b\xb9\xed\x0e\xf5\x73\x1d\x34\x75\xd3\x11\xe9\x7b\x39\xec\xfa\x29\x77\x2c\xbe\x9d\xf5\xbb\xfc\x34\xfe\xfb\x21\xf3\x8b\x43\x4c\x5e\x9f\xe2\x49\xa6\x92\x5d\x8b\xcf\xcc\x3b\x74\x1e\x20\x33\x4a\x50\x8f\x1d\x3f\x94\xe3\x47\xe8\x3c\x5d\xf0\x6d\x35\x5b\x7d\x27\xe1\xe4\x25\x4a\x91\xc3\xce\x43\x2e\x19\x7e\x8e\xb3\x66\xe0\xde\x7b\x09\x57\xeb\x3f\x5c\x7d\xb0\xa3\x37\x7b\xfc\xf7\x3d\xb6\xbe\xff\xfa\x27\x00\x00\xff\xff\x4c\x3d\xd2\x31\xfd\x07\x00\x00")
+var _templateDialectSqlMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xdf\x6f\xdb\x36\x10\x7e\x96\xfe\x8a\x83\xe1\x07\xa7\x70\xe8\x34\x6f\x1b\x96\x01\x6d\x96\x0c\x46\x9a\xa0\x5b\x8c\xbd\x14\x45\x41\x8b\x27\x9b\x30\x4d\x2a\x24\x65\x57\x10\xf4\xbf\x0f\xfc\x21\x99\x6a\xe3\x60\xc0\x9e\x62\xf2\xc8\x8f\x77\xdf\x7d\xdf\x29\x6d\xbb\x78\x97\xdf\xaa\xaa\xd1\x7c\xb3\xb5\x70\x7d\xf5\xfe\x97\xcb\x4a\xa3\x41\x69\xe1\x9e\x16\xb8\x56\x6a\x07\x4b\x59\x10\xf8\x20\x04\xf8\x43\x06\x5c\x5c\x1f\x90\x91\x7c\xb5\xe5\x06\x8c\xaa\x75\x81\x50\x28\x86\xc0\x0d\x08\x5e\xa0\x34\xc8\xa0\x96\x0c\x35\xd8\x2d\xc2\x87\x8a\x16\x5b\x84\x6b\x72\xd5\x47\xa1\x54\xb5\x64\x39\x97\x3e\xfe\x69\x79\x7b\xf7\xf4\x7c\x07\x25\x17\x08\x71\x4f\x2b\x65\x81\x71\x8d\x85\x55\xba\x01\x55\x82\x4d\x1e\xb3\x1a\x91\xe4\xef\x16\x5d\x97\xe7\xae\x06\x28\x94\x34\x96\x4a\x6b\x40\x22\x32\x64\x50\x2a\x0d\xe6\x45\x00\xe3\x54\x60\x61\x0d\x01\x7f\xba\x6d\x81\x61\xc9\x25\xc2\x24\x46\x16\xe6\x45\x2c\xf6\x68\xe9\x62\xc0\x98\x40\xd7\xe5\xd9\x62\x01\x2b\xba\x16\x08\x5b\x25\x98\xf1\x49\x59\xbf\x96\x74\x8f\x21\x21\x84\xb6\x05\xa1\x8e\xa8\x61\x4a\x9e\xdc\x76\xd7\xf5\x05\x30\x6a\xe9\x9a\x1a\x24\x79\x16\x60\x6e\x60\xd2\xb6\x30\x25\x61\xd5\x75\x93\x3c\x6b\xdb\x4b\xd0\x54\x6e\x10\xa6\xdf\xe6\x30\x45\xf8\xf5\x06\xa6\xe4\x8e\x6d\xd0\xf8\x14\x5c\x0e\xee\x0e\x86\x4b\xb7\x31\x41\xff\x4a\x9a\x91\xdd\xa6\x59\x86\x1b\x7d\x3a\x1a\x05\xb5\x5c\xc9\x05\xb2\x8d\x4b\xc6\x3f\xca\x4b\x77\xe4\xf1\xfa\xd1\x9d\x58\x6d\x11\x2a\xcd\xf7\x54\x37\xb0\xc3\x06\x18\x16\x82\x6a\x64\xb0\x46\xa1\x8e\xa4\x6d\x01\x25\x0b\xf9\x9c\x49\x26\x96\x86\xe4\x6f\x14\x69\x7d\xfd\x5b\x12\x87\xba\xa7\x48\x56\x4d\x15\x31\x02\xe8\xa9\xca\xa5\x3c\xa0\x36\xf8\x76\xb1\x9e\x7e\xd7\xde\x53\xad\x1e\xb1\x2f\x18\xa5\xe5\xb6\x21\x11\x78\x69\x01\xbf\x73\x63\x4d\xe8\x0b\x37\x50\xd1\x62\x47\x37\x5e\x68\x4a\x7b\x89\x2a\xa0\x07\xc5\x19\x14\x5c\x17\xb5\xa0\x1a\x18\x56\x28\x19\xca\xa2\x81\x23\xb7\x5b\xcf\x74\xac\xd0\x3f\xf5\x39\x42\x74\xdd\xa4\x87\xf3\xef\xbd\x5d\xc5\xc0\xd2\x88\x80\x9e\xa6\x84\xe3\xc0\x99\xb2\xa7\x1e\x8d\x58\xba\x55\xa2\xde\xcb\xb3\xfc\x14\x3e\x0c\x0c\xa5\xb2\x5c\x6e\xfe\x8b\x24\xb2\x73\xc0\xa3\xc6\x86\x77\x5f\x49\x39\xf9\x7d\x12\x4b\xf0\xe5\x81\x6a\xee\xb2\xfa\x3f\xbe\x1c\x30\x06\x5f\x86\x4c\x4c\xd4\x3c\x15\x02\x9e\xff\xfa\x04\x45\xdc\x75\xda\x78\xc5\x97\x25\x47\xc1\x0c\xc9\xb3\x03\xd5\x03\xc2\x0d\x7c\xf9\x6a\xac\xe6\x72\xd3\x46\x79\x93\xe5\x1f\x24\xa1\x60\x9e\x67\xa9\x4d\xcb\x60\xd1\x7b\x8f\x15\x1b\xe3\xc8\x2b\x5f\xbb\x13\x99\xc8\x3c\x45\x8b\x77\xae\xab\x54\xc6\x59\x86\xe0\xc8\x37\xa0\x8e\xd2\x00\x75\xb4\x20\xdf\xc8\x4b\xe7\x3f\x3f\xa8\x5c\x2e\x5e\x7b\x53\x72\x1f\x62\x0f\xd8\x9c\xa6\x42\xba\x77\x72\xbe\x63\x21\x41\x72\x9b\xd4\x02\xd5\xe8\x9e\x71\x86\x6e\x06\x35\x0c\xb4\x58\x27\xc6\x3c\xf3\xac\xa4\xa8\x63\x66\x46\x1c\xec\x1c\x09\x24\x56\x9f\x79\x85\x94\xbb\xc0\x49\x0f\x3b\x99\xe7\xd9\x98\x84\xc0\x42\xbf\xf4\x6d\xfc\x87\x0a\xce\xa2\xaa\x34\x56\x4a\x3b\x97\x86\xb9\x1a\x45\xec\xbd\xce\x0d\x1c\xdc\x49\x98\x55\x54\xdb\x9e\xbe\x54\xec\xe6\x82\xe4\x59\x59\xcb\x22\x85\x9c\x45\x8c\x50\xc4\x05\xac\x95\x12\xe0\x6a\x71\xfa\xe0\xae\x84\x30\x7a\x7b\x29\xb8\x50\xc6\xcb\xfe\xe9\x9b\x9b\x3e\xf2\x85\x7f\xf5\xf7\xb2\x4c\xa3\xad\xb5\x04\xab\x6b\x74\xeb\x58\x55\xbf\x5d\x52\x61\x30\xcf\xba\x3c\x6d\xde\x53\xbd\x1f\x2c\xec\x28\x9e\xfd\x40\xe6\xeb\x73\xff\xe7\x29\xed\xae\x25\x33\xe0\xf3\x43\xa2\x37\xa0\x92\xc1\x19\x0b\x5f\xfb\xf6\xff\x44\xd8\x68\x3c\x0c\xd8\xe9\x57\x60\x3c\x61\x7f\x1c\x1d\x30\x7b\xbc\x7e\x74\xac\x67\xc3\xf4\x18\xa7\x94\xc8\xc7\x09\x84\x4b\x86\xdf\xc7\x83\xc4\xc0\x95\x9b\x25\x73\x38\x1b\x7f\xef\xe2\x27\x3a\x06\x25\x8d\x57\x17\x63\x5d\xbd\x6d\xd6\x9e\xd6\x92\x2c\xcd\x8a\xef\x71\x3c\x5b\x4b\xf2\x6c\x75\x5d\x58\x7f\x03\xba\x6e\xa5\x9d\xa6\x42\x7b\x9d\x4b\xa3\x34\xbc\xab\xac\x8b\x51\x8b\xa7\x6f\x6e\x39\xb0\xe4\x47\x0d\x58\xe5\x43\xc6\x26\xaa\xdd\xf0\x03\x4a\x58\xd7\xc5\x0e\x6d\x7c\x78\xb6\xe7\xb2\xb6\x38\x87\xad\xaa\xf5\x1c\x18\x6d\xe6\x70\x44\xdc\xcd\x61\xaf\xa4\xdd\x82\xd2\xd0\x20\xd5\x17\x04\x96\x16\x0a\x2a\x61\x8d\x50\x51\xe3\xfe\xc5\xb2\x0a\xfe\xd4\xaa\xae\x3e\x36\x73\xaf\x01\x6e\xbd\x55\x6a\x34\xbe\xeb\xa6\xa0\x52\x22\x03\x6a\x3c\xc7\xbe\x3c\xa5\xe9\x06\x1f\xb0\x81\xae\xfb\xf6\x5b\xc8\xe3\xf7\x89\xef\xa3\xf7\xcf\x59\x16\x66\xe1\xec\xe0\xa6\xf0\x77\xec\x0b\xf3\x22\x88\x23\xf5\xa3\x3f\x1a\x6f\xcc\x23\x37\x89\x38\x2e\x7a\xf3\xa4\x7d\x4c\x7e\xb7\x2d\xa0\x64\xd0\x75\xff\x0e\x00\x6c\xf2\xea\xaf\x9f\x0a\x00\x00")
 
 func templateDialectSqlMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -741,12 +764,12 @@ func templateDialectSqlMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 2045, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/meta.tmpl", size: 2719, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\x41\x8b\xdb\x30\x14\x84\xcf\xd6\xaf\x98\xe6\x64\x07\x57\x4e\x73\x6b\x21\x87\x90\xa6\x10\x28\xe9\x21\xfd\x03\xae\xf4\x5c\x8b\xd5\x3e\x29\x4f\xb2\x97\x60\xfc\xdf\x17\x9b\x0d\xec\xf1\xcd\xcc\x9b\x6f\xa6\xa9\xd9\xaa\x53\x88\x0f\x71\xff\xfb\x8c\xfd\xee\xdb\xf7\xaf\x51\x28\x11\x67\xfc\x6a\x0d\xfd\x0b\xe1\x05\x17\x36\x1a\x47\xef\xb1\x86\x12\x16\x5f\x46\xb2\x5a\xfd\xed\x5d\x42\x0a\x83\x18\x82\x09\x96\xe0\x12\xbc\x33\xc4\x89\x2c\x06\xb6\x24\xc8\x3d\xe1\x18\x5b\xd3\x13\xf6\x7a\xf7\x74\xd1\x85\x81\xad\x72\xbc\xfa\xbf\x2f\xa7\xf3\xf5\x76\x46\xe7\x3c\xe1\x43\x93\x10\x32\xac\x13\x32\x39\xc8\x03\xa1\x43\xfe\x04\xcb\x42\xa4\xd5\xb6\x99\x67\xa5\xa6\x09\x96\x3a\xc7\x84\x8d\x75\xad\x27\x93\x9b\x74\xf7\x8d\xf1\x8e\x38\x37\x21\x12\x6f\x30\xcf\xaa\xb0\x32\xd6\x20\x11\xfc\x38\x20\xdd\xbd\xfe\x13\x89\x4b\x2b\x6e\x24\xb9\xb6\xaf\x54\xc3\xb6\xb9\xbd\xad\xfd\xcb\x5d\xa9\xc2\x75\x6b\xfe\xcb\x01\xec\x3c\x26\x55\x14\x42\x79\x10\x5e\xce\xb5\x4a\x15\xb3\x7a\x6a\x57\x7a\x3b\xad\xc8\xb2\x8d\x91\xd8\x96\x21\x66\x17\x38\xd5\xf8\xb9\x32\x4a\x2b\x63\x55\x69\xad\xab\x7a\xf9\x5f\x66\x13\xdb\x65\xd9\x7b\x00\x00\x00\xff\xff\x01\xec\x54\x15\x85\x01\x00\x00")
+var _templateDialectSqlOpenTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\xc1\x8a\xdb\x30\x14\x45\xd7\xd6\x57\xdc\x66\x65\x07\x57\x4e\xb3\x6b\x21\x8b\x90\xa6\x10\x28\xe9\x22\xfd\x01\x57\x7a\xae\xc5\x68\x9e\x94\x27\xd9\x43\x30\xfe\xf7\xc1\x66\x02\xb3\xbc\xf7\x5c\xe9\xf0\xa6\xa9\xd9\xaa\x53\x88\x0f\x71\xff\xfb\x8c\xfd\xee\xdb\xf7\xaf\x51\x28\x11\x67\xfc\x6a\x0d\xfd\x0b\xe1\x05\x17\x36\x1a\x47\xef\xb1\x8e\x12\x16\x2e\x23\x59\xad\xfe\xf6\x2e\x21\x85\x41\x0c\xc1\x04\x4b\x70\x09\xde\x19\xe2\x44\x16\x03\x5b\x12\xe4\x9e\x70\x8c\xad\xe9\x09\x7b\xbd\x7b\x52\x74\x61\x60\xab\x1c\xaf\xfc\xf7\xe5\x74\xbe\xde\xce\xe8\x9c\x27\x7c\x74\x12\x42\x86\x75\x42\x26\x07\x79\x20\x74\xc8\x9f\x64\x59\x88\xb4\xda\x36\xf3\xac\xd4\x34\xc1\x52\xe7\x98\xb0\xb1\xae\xf5\x64\x72\x93\xee\xbe\x31\xde\x11\xe7\x26\x44\xe2\x0d\xe6\x59\x15\x56\xc6\x1a\x24\x82\x1f\x07\xa4\xbb\xd7\x7f\x22\x71\x69\xc5\x8d\x24\xd7\xf6\x95\x6a\xd8\x36\xb7\xb7\xf5\x98\x25\x57\xaa\x70\xdd\xba\xff\x72\x00\x3b\x8f\x49\x15\x85\x50\x1e\x84\x97\xb8\x7e\xa5\x8a\x59\x3d\xbb\x2b\xbd\x9d\x56\x65\xd9\xc6\x48\x6c\xcb\x10\xb3\x0b\x9c\x6a\xfc\x5c\x1d\xa5\x95\xb1\xaa\xb4\xd6\x55\xbd\xbc\x57\xd3\x04\x62\x8b\x79\x56\xef\x03\x00\x01\xec\x54\x15\x85\x01\x00\x00")
 
 func templateDialectSqlOpenTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -766,7 +789,27 @@ func templateDialectSqlOpenTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x56\x5d\x6b\x23\x37\x14\x7d\x9e\xf9\x15\x97\x10\xa8\xbc\x38\x72\x9c\xb7\x16\x52\x08\xae\x4d\x4d\x77\x9d\xb4\x0e\xdd\x87\x10\x8a\x32\xba\xe3\x11\xab\x48\x8a\x24\x8f\x31\xc3\xfc\xf7\x22\x79\x3c\x1e\x3b\xd9\x4d\xea\xb4\xd0\x87\x7d\x1b\xeb\x7e\x9f\x73\xae\xe4\xaa\x1a\x7c\x48\x47\xda\xac\xad\x58\x14\x1e\x2e\xce\x87\x3f\x9e\x19\x8b\x0e\x95\x87\x09\xcb\xf0\x41\xeb\x2f\x30\x55\x19\x85\x2b\x29\x21\x3a\x39\x08\x76\x5b\x22\xa7\xe9\x6d\x21\x1c\x38\xbd\xb4\x19\x42\xa6\x39\x82\x70\x20\x45\x86\xca\x21\x87\xa5\xe2\x68\xc1\x17\x08\x57\x86\x65\x05\xc2\x05\x3d\xdf\x5a\x21\xd7\x4b\xc5\x53\xa1\xa2\xfd\xe3\x74\x34\x9e\xcd\xc7\x90\x0b\x89\xd0\x9c\x59\xad\x3d\x70\x61\x31\xf3\xda\xae\x41\xe7\xe0\x3b\xc5\xbc\x45\xa4\xe9\x87\x41\x5d\xa7\x69\x55\x01\xc7\x5c\x28\x84\x13\x2e\x98\xc4\xcc\x0f\xdc\x93\x1c\x18\x8b\x5c\x64\xcc\xe3\x40\xf0\x13\x38\xab\xeb\x34\xc9\x97\x2a\x23\x0e\x3e\xb8\x27\x49\xe7\x28\x63\xea\x1e\x54\x69\x92\x38\xfa\xb9\x40\x8b\x24\x58\xc6\xbf\x13\x47\x47\xa4\xaa\xe0\x94\x4e\x7f\xa1\x23\xad\x9c\x67\xca\x43\x5d\xf7\xfa\x20\x78\xaf\x97\x26\x75\x5a\x55\x67\x80\x8a\xc3\x1b\x1b\x18\x68\xe3\x9a\x26\x42\xe4\xa9\x36\xf0\xd3\x25\x9c\xd2\x79\xa6\x0d\xd2\x6b\xd3\x31\x31\xbb\xe8\xda\xae\xec\xa2\x63\x74\x5e\x5b\xb6\xc0\xae\xc3\xbc\x39\x7a\x65\xc2\x10\x2e\xf2\x50\x99\xfe\xc9\xac\x60\x5c\x64\xa1\xf9\x24\x49\x06\x83\x60\x50\xda\x03\xb3\x8b\xe5\x23\x2a\xef\x60\x85\x16\xc1\x58\x5d\x0a\x8e\xbc\x0f\xcc\x98\x30\x6c\xe0\x65\x72\xf5\x71\x3e\x86\xac\x01\xc5\xf5\x9b\x0c\x4e\xa8\x0c\x61\x85\x90\x31\xf5\x83\x0f\x01\x72\x0d\x27\xd3\x19\x90\xde\x09\x85\xa8\x93\x95\x90\x12\x1e\xd9\x17\xdc\x30\xd9\xc2\x03\x39\x93\x6e\x4d\x43\x22\x91\x83\x44\x15\xa1\x0f\x30\xd4\x75\x0f\x2e\x2f\xe1\x3c\x0e\xb0\x4f\xd2\x84\x49\x87\x24\x70\x91\x24\x89\x45\xbf\xb4\x2a\x7c\xc6\x81\xca\x00\x4f\x28\x44\xee\xee\x85\xf2\x68\x73\x96\x61\x55\xf7\x0f\x73\xc7\xe0\x5c\x5b\x10\x21\xc0\x32\xb5\x40\x28\x9b\x5a\xe5\x9d\xb8\x87\x4b\xd8\x79\xdf\x89\xfb\x6d\x81\x0e\xf7\xfb\x4d\x55\x15\x64\x4c\xca\x96\x26\x7a\x6d\x46\x61\x2b\x02\xdd\x75\xfd\x0d\x55\x55\xd5\x0b\xdc\x94\x94\x86\x8c\x28\x1d\x42\x5d\x0b\x1e\xbe\x63\xd5\x23\x14\x98\x0b\x94\xbc\x2b\xc0\xbc\x2b\xa1\x49\xb0\x1e\xb9\x22\xf9\xc1\x28\xe5\xb1\xdd\x1d\xae\xc8\xd7\x3a\xfc\xbe\x3f\xff\xf1\xfe\xbc\x57\xde\xfb\x8a\xd8\x48\x3b\xa0\x13\xa0\x9b\x09\xd9\x20\xd7\x87\xf2\x45\xd5\x37\xa2\x8f\xf5\xdf\xa3\x78\xe4\x0b\x1c\x14\x6c\x4f\x52\x7b\xbc\x8f\xf9\xeb\xa4\x3b\x8f\x51\x68\xee\x49\x2e\x2c\x33\x05\x9d\xe1\x6a\xee\xd1\x90\x80\x55\x7b\x38\xb1\xfa\x91\xdc\xb2\x07\x89\x71\x93\x9f\xef\xf7\x9e\xf7\xad\x8e\x28\x21\x8d\x11\x1d\xbf\xb7\x04\x87\xa6\x49\xfb\x6b\x93\xe7\x0f\x94\xf4\x76\x6d\xb0\x4d\x81\x74\xea\xa6\xaa\x44\xeb\xba\x67\xcf\xca\x45\xea\xb7\xb2\x46\xfa\xe9\xe2\xd3\x06\x8e\xcd\x71\x38\xba\xf9\xad\xe3\x4f\x29\x6d\x23\xe2\x9d\x74\xe0\x3c\xd2\x72\xf9\xa8\x3a\x01\x3b\x6f\xc5\xb7\xce\x71\x9c\x20\xba\x76\x86\x5f\x99\x9b\xa1\x58\x14\x0f\xda\x3a\xe2\xfa\x10\x20\x3f\x9e\xed\x95\xf0\xc5\xff\x94\xf1\xb0\x05\x08\xa7\x1b\x1e\x22\x21\x6b\xd3\xb0\xb2\x91\x7a\xe0\x6d\xc3\xda\x21\x55\xbb\x57\x20\x5a\xda\xb5\xf8\xae\x98\xcf\xc2\x17\x5b\xd5\xf4\xe1\xeb\xb4\xc6\x67\xfe\xaf\x3e\x98\xdd\x4b\x1f\xc4\xe3\x9a\x9b\xd1\x10\xd7\xdb\x5e\x7f\xf5\x3f\x57\x1f\x53\x6f\xf8\x87\x39\x8c\xb2\xa2\x23\xa9\x15\x92\x1e\x9d\xa3\xbf\x21\x4a\xc8\x50\xf7\xe5\xe6\x62\xee\xa6\x43\x43\xdc\x30\x78\xee\x5d\xc9\x43\x7a\x43\x8e\xb8\x19\xb5\x7d\x77\xb3\xe2\x9b\xcd\x8a\x1c\x04\xfc\xbc\x7b\x76\x86\xf4\xda\x92\x16\xdf\x7f\x75\x16\xa5\xfd\xab\xc3\x18\xe2\xe8\x4c\xfb\xe7\xe9\xff\x0e\x00\x00\xff\xff\x4a\xed\x3f\xf7\xfd\x0c\x00\x00")
+var _templateDialectSqlPaginateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x6f\x1b\x37\x12\x7f\xde\xfd\x14\x53\xc3\x35\xb4\xce\x9a\xb2\xf3\x76\x4a\x54\xc0\xb1\xdd\x6b\x80\x34\x49\x93\xf4\xee\x00\x43\x68\xe9\xdd\x59\x99\xce\x9a\x54\x48\xae\x1c\x41\xdd\xef\x7e\x18\x92\xfb\x57\xb6\x13\x03\x6d\x1f\x6a\x2d\x39\x9c\x19\xce\xfc\xe6\x1f\xb3\xdd\x4e\x0f\xe3\x33\xb5\xda\x68\xb1\xbc\xb6\xf0\xfc\xf8\xe4\x5f\x47\x2b\x8d\x06\xa5\x85\x9f\x79\x86\x57\x4a\x7d\x86\xd7\x32\x63\x70\x5a\x96\xe0\x88\x0c\xd0\xbe\x5e\x63\xce\xe2\x4f\xd7\xc2\x80\x51\x95\xce\x10\x32\x95\x23\x08\x03\xa5\xc8\x50\x1a\xcc\xa1\x92\x39\x6a\xb0\xd7\x08\xa7\x2b\x9e\x5d\x23\x3c\x67\xc7\xcd\x2e\x14\xaa\x92\x79\x2c\xa4\xdb\x7f\xf3\xfa\xec\xe2\xed\xc7\x0b\x28\x44\x89\x10\xd6\xb4\x52\x16\x72\xa1\x31\xb3\x4a\x6f\x40\x15\x60\x7b\xc2\xac\x46\x64\xf1\xe1\xb4\xae\xe3\x98\xee\x00\x5f\x2a\xd4\x9b\xe9\x8a\x2f\x85\xe4\x16\x21\xc7\x42\x48\x34\x9e\x13\x96\x7c\x73\x64\xec\xa6\x44\x98\x7c\xc6\x8d\x41\x9b\x40\xa0\x14\x4a\x7a\xd6\xe8\x39\xc0\x55\x25\xca\x1c\x35\x03\xc7\x7b\xbb\x0d\x9c\x60\x2f\x17\xbc\xc4\xcc\x4e\xcd\x97\x72\x3a\x14\xb6\x07\x9e\x72\x7f\xf5\x79\x09\xb3\x39\xec\xb3\x8f\x99\x5a\x21\x7b\xcf\xb3\xcf\x7c\x89\xcd\x6e\xe0\x4c\x14\x2b\x6e\x32\x5e\xb6\x84\xaf\xc2\x4e\x20\xd4\x98\xa1\x58\x7b\xca\xf6\xf7\xfe\xd5\x90\x08\xf3\x25\x12\xc1\x4a\x0b\x69\x61\x9f\xbd\xe5\xb7\x08\x7b\x17\xf9\xb2\x53\x27\x53\x52\xee\x92\x9c\x29\x29\x31\xb3\x42\x49\x47\x18\x4f\xa7\xd0\xf2\xab\x6b\x72\x20\x19\xc3\x7d\x6a\x0c\x50\x68\xed\x44\x94\x5e\x14\x91\x4a\xe0\x90\xb5\xec\x58\x6c\x37\x2b\x1c\x30\x33\x56\x57\x99\x85\x6d\x1c\xbd\x25\x70\x00\x1c\x0e\x18\xfc\x79\x63\x94\x9c\xed\x49\x95\xe3\xde\x9f\x71\x74\x56\x69\xa3\x34\x84\x3f\x61\x33\x73\x5f\x7b\x7f\xc6\x9d\xaa\x24\xb2\xa7\x6a\xa7\xc1\x8e\x86\x28\xad\xb0\xc2\xc1\x80\x5b\xa2\xd7\x68\x2b\x2d\x31\x87\xab\x0d\xbc\x0f\xee\xeb\xe9\xdd\x70\xee\xf4\x26\x83\x1a\x70\xff\x5d\x2e\xfa\x57\x0b\xea\xd1\xa7\x21\xe5\xdf\xf3\x25\xbe\x96\x85\x02\x80\xf6\x67\xa0\x59\x85\x6f\x22\xfb\xa4\x2c\x2f\xcf\x54\x25\x2d\x90\x53\x02\x85\x6d\x57\xdb\x8b\x36\xda\x01\x7e\xc5\xac\xb2\x01\xca\x0e\x78\xc0\x65\x1e\x6e\x62\x40\x49\x24\x2c\x23\xdd\x5d\x58\xe0\x66\xe0\x93\x14\x0a\x55\x96\xea\x4e\xc8\xa5\x3b\xff\x81\x42\x81\xec\xe8\xcd\xda\xa3\x34\x60\x56\x98\x89\x42\x64\xce\xd9\x0c\x3e\x5d\x07\xc6\x14\x74\x48\xd0\xa7\x90\x36\xc4\xc9\xc7\x50\x2f\x84\x66\x21\x62\xef\x0c\x70\x8d\xc4\x5f\xe9\x1c\xb5\xb7\x33\x6d\x2d\xc5\x1a\xa5\x5f\x84\x42\x60\x99\x1b\x77\x09\xda\x12\x79\xda\xfe\xf6\x5a\x19\xb8\x56\xa5\x5f\x58\xf3\xb2\x42\x13\x22\x94\xb2\x86\x3b\xcb\x48\x02\xe9\xe7\xec\x06\x99\x33\xa7\xc6\x95\xd2\xd6\x9b\x49\x56\xb7\x57\xa8\xe9\xd8\x10\x01\xb7\xdc\x66\xd7\x9d\x21\x53\xd0\xb8\xe4\x3a\x2f\xd1\x34\x32\xe8\x52\x48\xfc\xe3\xe9\x34\x22\xeb\xa4\x80\xda\x45\x62\x56\x0a\x94\x96\xf5\xf1\xc5\x7e\x23\x2e\x93\x84\xe8\xa3\xe8\xbf\xd7\xa8\x71\xc2\x18\x0b\xdf\x8d\x07\x27\x99\xfd\x9a\x02\x2f\x2c\xea\x14\x0e\x0a\xa1\x8d\x4d\x41\x8a\x32\xfc\x8f\x98\x12\x62\xde\x91\x71\xb6\x3f\xd3\x05\x67\x0e\x8c\xbd\xfc\xc1\xdc\xf2\xff\x52\x38\x77\xf9\xd0\x59\x9c\xce\xb9\x33\xed\xda\x39\x9a\xac\x4e\xbc\xee\xf0\x0b\x37\x6f\xf1\xab\x25\xce\xce\xbc\xbf\x70\xf3\x5e\xe3\x5a\xa8\xca\xf8\x35\x4d\x91\x73\xbb\xaa\x2c\xe6\x50\x28\x9f\xa7\x9d\x72\x8e\xbc\xe4\xc6\x02\xd7\xcb\xea\x16\xa5\xa5\xb8\x21\x74\x58\xb1\xc6\x72\x93\xc2\xd5\x86\x1c\x50\xa0\xcd\xae\x09\x0e\x04\x41\xfc\x6a\x35\x27\x04\x30\x78\x27\xcb\x4d\xe3\x64\x67\xf5\x8c\x4b\xa9\x2c\x5c\x21\xbc\xfd\xfd\xcd\x1b\xc8\xb8\xa4\xdf\x95\x09\x82\x1d\x2a\x84\x5c\x76\x30\x70\x28\x27\x11\xb7\x95\xb1\x40\x67\xaf\xf9\x1a\x3d\x7c\x52\x28\xc5\xad\xb0\xa0\xc8\xbd\x85\x41\xeb\x91\x3a\x80\x96\x2a\x06\x68\x72\x5c\xbc\xeb\x1d\xd9\xab\x0d\x8b\x8b\x4a\x66\x30\x19\x64\xdb\xba\x86\xc3\x7e\x9e\xae\xeb\xa4\xcd\x11\xe4\x43\x0a\x16\x8b\x5f\x2d\x3b\xf3\x7f\x83\x4f\xe1\xd0\x27\xac\x34\x58\xef\x50\x48\x9b\xc2\x15\x16\x4a\x63\xb7\xe7\xec\xe9\xb7\x82\x0e\xc0\x58\xe7\xf7\x04\x26\x87\xbd\x04\xe4\x40\xa7\x74\x42\x19\x48\x14\x30\x52\x93\x79\x0b\xfc\x30\x27\x00\xc1\x5f\x7f\xed\xec\x7b\xc3\xf4\x08\x4a\x94\xe3\xbb\x32\xa7\x46\x02\x3f\xc1\x31\x49\x89\x7c\x3e\x21\x8e\x29\x14\xb7\x96\x5d\x68\xad\x74\x31\xd9\x6b\xea\x5a\x5d\xcf\x5a\x6b\x40\xae\xd0\x38\xbf\x98\x6a\x45\x51\xe7\xc2\x89\xf2\xec\x9d\xb0\xd7\x0f\xf8\x69\x2f\x89\xa3\xda\x5d\xc7\xdb\x29\x68\x77\x70\x00\x87\x7e\xe1\x25\x1c\xd3\x65\x4a\x3e\xdc\x74\xdf\x2f\x9f\xa0\xe5\x08\xc4\xce\xfb\x57\x08\x52\xc9\x23\x89\x4b\x4e\x20\x0e\xba\xe4\xc2\x45\xf6\x30\x8a\x4e\x4d\x16\x47\x84\xcb\x3f\x52\x50\xb4\xad\xb9\x5c\x06\x80\xbd\xda\x38\x5b\x6d\xb7\x47\xfe\xa6\xfb\xcc\x9d\xe5\x57\x25\xba\x08\x35\x54\x51\xa3\x28\x32\x77\xc2\xa1\xcd\xc7\xad\x3b\x13\x65\xdc\xb8\xe2\xe8\xd9\xed\x8b\x14\xf6\x0b\x62\xcf\xa0\xae\xb7\x5b\x10\x05\xec\x0b\xe7\xfa\xed\x16\x50\xe6\x7e\x75\x90\x04\xe8\x92\x05\xa1\xcf\x58\x2e\xad\x27\xf0\x94\x33\x12\x90\x63\xc1\xab\xd2\xba\xdf\xdf\x65\xa8\x4a\x06\xf7\x61\xde\x8f\x1f\xf8\xf1\x8b\x4b\x08\xfd\x4c\xb7\x97\x36\x97\x49\x88\xbd\xbb\xa4\x28\x40\xb1\xd6\x6c\xf0\xc3\x3d\x86\x84\x83\x83\xc7\x69\x28\x65\xc1\xf6\xbb\x35\x16\x72\xcd\x4b\xd1\x68\x9b\xb7\x7c\x7f\xfc\xb2\x97\xf6\x05\x75\x4a\x92\x8b\xe7\xfd\xad\xe0\x3e\x2c\x0d\x06\x67\xfd\xa3\xa6\x22\xa8\x78\x1f\x39\xc4\xb9\x82\xd5\x96\x94\x71\x48\x9e\x95\x4a\xe2\x24\x61\xae\x13\xa0\x94\x93\xb8\x78\x21\xea\x10\x10\xe3\x18\x40\xad\x1d\xdf\xe9\x14\x3e\x84\xea\x3b\x2e\xbd\xc2\xc7\x41\x4a\xed\x9a\xdb\x02\xab\x7c\x3e\xe5\xa1\x7e\xba\x55\x16\x47\xee\xaf\x21\x4c\xf2\xd5\x0a\x65\x3e\x09\x98\xbf\x9c\x51\xfe\x08\x1f\xc9\xe0\x63\x91\xc2\x37\x6b\x97\xfb\x7e\x7d\xde\x07\xee\xa0\x90\xe5\x42\xd7\x49\x1c\x4d\xa7\x70\x0a\xe6\x9a\x53\xbb\x02\x99\x5a\x6d\xe0\x33\xe2\x2a\x74\xa3\x7c\x89\xfa\xa8\x54\x3c\xa7\x7a\x93\x29\x59\x88\x65\xa5\x77\xbb\x77\x16\x47\x94\x89\x36\x74\x87\xc3\x91\x75\xc3\x16\x5b\x69\xcc\xa9\xc9\x41\x03\xbb\x1e\xe8\x36\xfd\xa5\x1f\xde\x4f\xbe\xb5\xbf\x68\xb3\x48\xd6\x65\x91\xcb\x45\xd7\x5a\x46\xa1\x07\x0b\x35\x22\x8e\x22\x5f\x4f\xe0\x4a\xa9\x32\x8e\xea\x2d\x6c\x43\xd3\x60\x75\x85\x94\x18\x7c\x51\x49\xa1\xe0\xa5\xc1\x1a\x6a\xc7\x45\x14\x90\xb1\xc0\x6a\xde\xa1\x84\x9a\x17\x2b\x64\x85\xb1\x0f\xd7\xd5\x83\xa8\xa3\x8e\xe7\x7d\xa3\xf7\xa4\xe1\x15\xea\x94\x49\x21\x63\x4e\x0d\x8a\xa9\x5d\x30\xee\xa2\xd1\x49\xbb\xc7\xd4\x01\x53\xe3\x9d\x14\x56\x49\x03\x61\x82\x52\xe8\x19\xb8\xc6\xae\xeb\x54\xd4\x51\x5c\x6d\x9a\xa6\x04\x7d\xeb\x81\x79\x3b\x2a\xe2\x1a\xb5\x69\x42\x93\xc5\x51\x58\x20\xbb\xfb\x6a\x10\x0c\x73\x70\xd0\x2f\x2f\x0d\x22\xdc\x29\x98\xc3\x2d\xff\x8c\x93\xcb\x85\xcb\x50\x3f\x57\x32\x4b\xa1\x85\xba\x49\x12\xef\x4e\xb1\x5b\x14\x4c\xe3\x86\x49\x2f\xcd\xc0\x7c\x9c\xea\x4e\x4d\x96\x90\xe5\x1a\xdd\xe8\x50\x5f\x81\x4b\xb1\x80\x39\x9c\x9a\x6c\xd2\x4b\x1f\x35\x60\xf9\x20\xed\x39\x8e\x88\x9d\x1d\x43\xed\xd9\xc6\xbe\xe2\xf4\x8b\x2d\x95\x06\xdf\x3b\x50\x7c\xf8\x9d\x67\x70\xd2\xfa\xcb\xef\xcd\xe1\xc0\xfd\x08\x0c\x4a\xfe\xc0\xf9\x92\x7f\xe3\x78\x1d\x47\x52\xe5\x68\x5a\xe4\xf9\x1b\x9c\x96\xe5\x93\x72\x5b\x33\xc6\x1e\xf4\x1a\xa4\x6d\x37\x47\xcd\x7c\x16\xbb\xbf\xb5\x20\x07\x3a\x1d\xa8\xd1\x09\x37\x26\xc3\x13\x1b\xd6\xcc\x69\xac\xdf\x2b\xcf\x5d\xb4\xc5\x91\x57\x1d\xe6\xe0\xfe\x5e\xce\xfc\xe1\x45\xd3\xc3\x94\xfc\x11\x39\x25\x7f\x48\xcc\xa0\x05\x6f\x45\x89\x62\x88\x8a\xb1\x68\xe2\xb7\x18\xa1\x61\x48\xd3\xc9\x3f\x72\xd4\xb3\x45\x8b\x87\x11\xf3\x00\xe2\x1b\xb2\xe8\x71\xda\x53\xfc\xe8\xe4\x05\x08\x78\x09\x37\x2f\xfc\xfe\x1c\xc4\xb3\x93\x14\x6e\x8e\x4e\x7a\x02\x2f\xc5\x22\x0d\x22\x6f\x16\xad\xf4\x9b\x76\x51\x74\x72\x9d\x89\xfd\xf8\xdc\x86\x56\x6f\x80\xee\x8b\xee\x62\x8b\xbe\xbb\xf0\xa2\x2f\x1f\x5d\x59\x8b\x21\x5a\x73\x29\xcb\x67\xcd\xb6\x12\x3d\x25\x3b\x75\xaa\xf9\xa0\xeb\xa9\xb5\xa5\x87\x8a\x99\xbb\x4d\x1a\x5e\x22\x66\x90\xb5\x96\x74\xef\x29\xa4\x78\xc7\x22\x79\x01\xb2\xed\xa2\x87\x0e\xff\x68\xb9\xb6\xe1\x39\x63\x0e\x07\x3d\xb1\xc7\x0b\xd6\x66\xfd\xe1\x99\x0b\x99\xdf\x7b\x42\x1e\x9d\x74\x67\xea\xb8\xb9\x19\x51\xb8\x41\x32\x3c\x1b\x0c\x92\x79\xfb\x48\xc0\xa1\x4d\xb8\xed\xac\xa7\xd5\x5d\x2f\xd7\xae\x94\x11\x94\xa5\x30\x0f\x53\xcd\x44\xe9\x30\xc4\xd0\x44\xd9\x1b\xe2\x43\xa9\x11\x72\x3c\xd8\xfb\x41\x8c\xd0\x37\x68\x91\xc2\x2b\x0d\x75\x6d\x7e\x2c\x73\x23\xf9\xf7\x8f\x60\xa3\xf2\xd4\x8d\x54\x21\xf9\x5e\x2e\xda\x0e\xa4\x99\xc8\xa8\x80\x26\x30\x69\xef\x3c\x18\xda\x47\xb3\x95\x73\x26\xf3\xef\x0c\x2e\x3f\xf7\x72\x7e\x80\xfe\xf7\x34\x88\xc1\x2a\xed\x60\xd4\xbd\x34\xf4\x6c\x61\xc2\xdc\x11\x5e\x35\x66\x6d\x5c\x08\x69\x51\x17\x3c\xc3\x6d\xfd\xa4\xa2\xa3\xf9\x1d\xad\x67\x4c\xe4\x1e\xff\x14\xc0\xc3\x1b\x51\xf4\x38\x3a\x22\xf3\x8b\x6d\x94\x46\xeb\x47\x9b\x82\xff\x10\x75\x53\x60\x52\xd0\xfc\xee\x49\x3d\x40\x2b\x0c\xe6\xb0\xee\x63\xf6\x7e\xbf\x4c\x68\x24\x9f\x18\x38\x34\x5f\x4a\xf6\xd1\x95\xfe\xe0\x25\x6a\x0d\x27\xc5\x09\xfc\x04\xeb\x93\x04\xde\x7d\x70\x1f\x73\x58\x9f\xc0\xe9\xdb\x73\x28\x9e\xd3\xc6\x73\xb7\xc1\x18\x8b\xa3\x48\xe9\x9e\x69\x1d\xbb\x16\x3f\x63\xeb\x3e\x6e\xde\x88\x86\xc8\x87\x59\x1d\xa7\x94\x22\xc9\x26\x8e\x8d\x4f\xa9\x2f\xe0\x06\x5e\x82\x78\x01\x37\xcf\x9e\x85\xc9\x86\xb8\xb4\xfd\x0f\x97\x79\x0a\xa4\xd3\xc5\x6f\x13\xc3\xce\x82\x2e\x97\x37\x8b\x50\xc7\x53\x08\x76\xbb\x59\x24\x83\x59\xeb\x3b\xba\x8b\xf9\x3c\x04\xc0\x63\x72\xff\xfd\xc9\xcb\x1d\xcb\x13\x8d\xbc\x5e\x91\x79\x80\xc5\x9b\x6f\xb1\x20\xf1\x2a\x74\x29\x24\xf3\xd4\x2b\x40\x6f\x63\x01\x1c\x86\xf9\xe7\x32\xda\x7d\xa7\x27\x4a\xd3\x1e\x45\x47\x32\xce\x66\x0e\x85\x90\x23\xfd\xf3\x42\x78\xf0\xf5\xb1\xe6\x84\x36\x49\x65\xe7\x81\xb1\xc9\x2f\xf7\xe6\x13\xc7\x73\xe2\xe8\xc0\x58\xff\xf8\x44\x31\x42\xaf\xb1\xec\x03\xbf\xfb\x15\x8d\xe1\x4b\x4c\x60\x32\x88\xcc\x2e\x71\x84\x26\xab\x68\xc6\x7b\x1a\xf3\xc2\x60\x5f\x74\x03\xd4\x3d\xef\x03\xfb\xec\xf5\xb9\x9f\x3b\x9b\xf7\x80\xc7\x47\x7c\x6a\xd9\xa2\x35\xd7\xb0\x76\xb4\x05\xfb\x44\x6f\xec\x75\x03\x8a\x10\xbd\x4e\xef\xdf\xe5\x2d\xd7\x34\x40\x4d\x34\xbf\x4b\xe1\x60\x9d\xbc\xd8\x89\xd4\xef\xca\x65\xcd\x94\x1d\x72\x9a\xb7\x33\x21\x3c\x5c\xd7\xe9\x11\xa2\x76\x06\x3f\xae\xf7\x5c\x16\xe9\x3c\x1f\x64\xac\xbd\x27\xc7\x23\xf0\xdf\x3f\x6e\xbb\xbd\xa4\x07\x99\x50\x40\x9b\xea\xd7\xc3\x4c\x40\x4b\x9f\x81\xab\xf5\x6d\x55\xdc\xc1\x91\xe9\x17\x2a\xf6\x61\x5c\xa9\x1a\x2e\x09\xec\xf6\x24\xfd\xd2\x94\xc0\xa4\xa9\x5b\xbd\xf2\x93\xb7\xf9\xd7\x79\xf0\xd7\xe0\xbf\xb1\x28\xf6\xfa\xfc\xb1\x6e\xd9\x33\x0e\x00\x75\x89\xf6\x5b\x0f\x54\x59\xe5\x84\x86\x83\x22\x9f\xb9\x57\x78\xe7\x68\x33\x6b\xd2\xdd\x28\x18\x7a\xa9\xf3\xd5\x26\x49\xea\x90\x3c\xff\x10\x0f\x3e\x93\x05\xdc\xf6\x42\xe8\xa1\xb7\xb1\x71\xf8\xb0\x80\xf0\xa7\x04\x49\x14\xad\x43\x2b\x37\x30\x9d\x27\xbe\x70\x3d\x87\x33\x41\x60\xdd\x47\xe5\xfd\x6f\x67\x9d\x59\xff\x1e\x98\x36\xe9\xb2\x8d\x13\x9a\xdc\x2b\xdd\x14\xe5\x3f\xa8\xb5\x26\x07\x8f\xc0\xf0\x58\x18\x77\x2a\x3a\xcf\x7b\xbe\x75\x87\x8c\xac\xd2\xfd\x20\x6c\xdf\xbc\x44\x31\x70\x66\xdb\xbe\xfe\x63\x57\xf7\x82\x2e\x8f\x9b\x2a\x37\x54\x33\x88\xf3\x38\xac\x77\xd3\x86\xfb\xe7\x4c\x94\x39\xd4\x75\xfc\xff\x01\x00\x7a\x11\x9b\x62\xb1\x1e\x00\x00")
+
+func templateDialectSqlPaginateTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectSqlPaginateTmpl,
+		"template/dialect/sql/paginate.tmpl",
+	)
+}
+
+func templateDialectSqlPaginateTmpl() (*asset, error) {
+	bytes, err := templateDialectSqlPaginateTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/sql/paginate.tmpl", size: 7857, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectSqlPredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7c\x7f\x6f\xdb\xb8\xb2\xf6\xdf\xf6\xa7\x98\xf5\x9b\x9e\x57\x0a\x1c\xa5\xce\x29\x8a\x73\x73\x37\x05\x7a\x12\x77\xd7\xd8\x36\x4d\xeb\xec\xee\x05\x8a\x62\x41\x4b\x63\x9b\xb0\x4c\xaa\x24\xed\xd4\x30\xfc\xdd\x2f\x86\xa2\x24\xca\xbf\xe2\xa4\xcd\xdd\x03\xec\x62\xb1\xad\x2c\x91\x33\xc3\xe1\x33\x33\x0f\x29\xaa\xcb\xe5\xe9\x71\xf3\x52\x66\x0b\xc5\x47\x63\x03\x67\xcf\x3b\xff\x75\x92\x29\xd4\x28\x0c\xbc\x61\x31\x0e\xa4\x9c\x40\x4f\xc4\x11\xbc\x4e\x53\xb0\x8d\x34\xd0\x73\x35\xc7\x24\x6a\xde\x8e\xb9\x06\x2d\x67\x2a\x46\x88\x65\x82\xc0\x35\xa4\x3c\x46\xa1\x31\x81\x99\x48\x50\x81\x19\x23\xbc\xce\x58\x3c\x46\x38\x8b\x9e\x17\x4f\x61\x28\x67\x22\x69\x72\x61\x9f\xbf\xed\x5d\x76\xaf\xfb\x5d\x18\xf2\x14\xc1\xdd\x53\x52\x1a\x48\xb8\xc2\xd8\x48\xb5\x00\x39\x04\xe3\x29\x33\x0a\x31\x6a\x1e\x9f\xae\x56\xcd\xe6\x72\x09\x09\x0e\xb9\x40\x68\x25\x9c\xa5\x18\x9b\x53\xfd\x25\x3d\xcd\x14\x26\x3c\x66\x06\x4f\x79\xd2\x82\x93\xd5\xaa\xd9\x18\xce\x44\x1c\x68\x38\xd6\x5f\xd2\xa8\x8f\xd4\x52\xaa\x10\x96\xcd\x46\x43\x47\xbf\x8f\x51\x61\x40\x4f\xba\x1f\x02\x1d\x5d\x06\xcb\x25\x1c\x45\xbd\xab\xe8\x52\x0a\x6d\x98\x30\xb0\x5a\x85\x6d\xe0\x49\x18\x36\x1b\xab\xe6\x72\x79\x02\x28\x12\x38\xd0\x80\x53\x99\x69\x67\x04\xf5\x3c\x92\x19\x9c\x5f\xc0\x51\xd4\x8f\x65\x86\xd1\xfb\xcc\x7b\xc4\xd4\xc8\x7f\xf6\x5a\x8d\xbc\x87\xda\x48\xc5\x46\xe8\x37\xe8\xbb\x5b\xf7\x8c\x90\xba\xf3\x21\x1c\xc9\x2c\xfa\x8d\x29\xce\x12\x1e\x93\xf1\x8d\x46\xe3\xf4\x14\xf8\x10\x84\x34\xc0\xd4\x68\x36\x45\x61\x34\xdc\xa1\x42\xc8\x94\x9c\xf3\x04\x93\x36\xb0\x2c\xa3\xc1\xd2\x5c\xbd\x79\xfd\xb6\xdf\x85\xd8\x39\x45\xb7\x9d\x04\xcd\x45\x8c\x70\x87\x10\x33\xf1\xff\x0d\x75\x48\x17\xd0\xea\x5d\x43\x10\xb6\x22\xb0\x38\xb9\xe3\x69\x0a\x53\x36\xc1\x7c\x26\x4b\xf7\xc0\x90\xa5\x7a\x11\x91\x20\x3e\x84\x14\x85\x75\x3d\xb9\x61\xb5\x0a\xe1\xe2\x02\x9e\xdb\x01\xd4\x27\xe9\x0d\x4b\x35\x06\x34\x17\x8d\x46\x43\xa1\x99\x29\x41\x97\x76\x40\x73\x72\x0f\x29\x0a\x3e\x7d\xe6\xc2\xa0\x1a\xb2\x18\x97\xab\xf6\xba\x6c\xdb\x79\x28\x15\x70\xea\xa0\x98\x18\x21\xcc\x9d\xae\xf9\x27\xfe\x19\x2e\xa0\x6a\xfd\x89\x7f\x2e\x14\x78\x73\x5f\x37\x6a\xb9\x84\x98\xa5\x69\x39\x4d\xd1\xfb\xec\x92\xa2\x82\xa6\x7b\xb5\xda\x83\xaa\xe5\x72\xcb\xdc\xcc\xa3\x28\x5a\x2e\x01\x53\x8d\xb0\x5a\xf1\x84\xae\x2d\xe2\x1e\x81\xc0\x21\xc7\xb4\x88\x02\xea\x78\x34\xf4\x21\xf4\x86\x9e\x1e\x0a\xa0\x61\xf4\x33\xd3\xff\x4e\xb9\x48\x7a\x22\xc1\xaf\x0e\x44\x3b\x02\x68\x18\x55\x2d\xeb\x43\xa6\x86\xd5\xb3\xdf\x58\x3a\xc3\x8d\x1e\xd7\x6c\x4a\x43\x6f\xc3\x3c\xa4\x31\x5b\x13\xac\x3b\x72\x3b\xba\x22\x56\x8b\xcc\xa0\x9b\x89\xdd\x36\x6c\x6a\x76\x5d\x7d\xb5\x97\x3c\x1b\xa3\xba\x47\x65\x4f\x5f\xcd\x14\x33\x5c\x8a\x87\xeb\x2c\x7a\xe6\x4a\x75\x74\x95\xcf\x54\x10\x6e\x2a\x7b\x90\xec\x79\x18\xae\x81\xf2\x31\xe0\x58\xcf\x50\xbb\x00\xf2\x77\xfa\x7a\xe2\xf4\xe5\x4f\x64\x31\x6c\x26\x12\x08\xa4\xaa\x83\xbe\x06\xc7\x10\x02\xca\xe0\xe4\x9f\x6b\x9e\x92\x7b\x42\xe7\x1f\x12\x71\x7a\x0c\x55\xbf\x39\xe1\x4f\x03\x53\x54\xb3\xa7\x19\x53\x98\xc0\x60\x41\xde\xe1\x0a\x06\x14\x7c\xc0\x29\x26\x41\xaa\xea\x7e\x90\xa0\x41\x35\xe5\x82\x6b\x43\xb2\x63\x1b\x2d\x06\xbf\x1a\xeb\xc2\x06\x59\x98\x38\x5b\xea\x1a\xc4\x1c\x15\xa9\x35\xd2\x89\x52\xe8\x18\x46\xde\xd8\xd5\x7b\x07\xcd\x08\x8e\x4f\x2b\xbb\x8f\x62\x99\xce\xa6\xc2\xe2\xa5\x06\x7b\x8a\x31\xab\x85\x1e\x65\x8a\x0b\x03\xad\x8d\xb8\x6e\x6d\x84\x75\x21\x77\x4b\x30\x57\x12\x2f\x72\x51\x3b\xc3\xb5\xe5\x09\xf2\x52\xc3\x7a\x56\x5c\x2e\x4b\xf3\x2f\x76\x26\x42\x5f\xab\x37\x8c\xaa\xad\x37\x92\xea\xa6\x1b\x4d\x59\x10\x9a\x8d\x7d\x01\xf2\xb0\x7a\xb8\xab\x20\xfa\x15\x31\xb7\x98\x12\x73\xd5\xfb\x13\xff\x9c\xf7\x5f\x6d\x80\xfd\xc0\xb2\xe8\x9c\x95\xe7\xb4\x28\x8a\xc2\xb0\xe6\xe5\xd5\x77\x11\x5c\x37\xdf\xe5\xdd\x7a\x45\x5f\xd3\xf8\x08\x85\xf5\x0c\x9d\x17\xf7\xb5\xe8\xcc\x6b\xcc\xd6\xba\xef\xca\xbe\x48\xfc\x29\xbe\x37\xc7\x9f\x1e\x57\x29\x29\xcf\xe8\x1a\x46\x28\x50\x31\x83\xda\x46\x58\xf9\x98\x7e\x32\x53\x04\x3f\x98\x3b\x09\xae\x43\x90\xbb\x4a\x87\x39\xdf\x46\xd0\x84\x34\xb3\xc8\xd0\xc5\xe5\x61\xb5\x44\xdb\x10\x21\x3f\x1e\x65\x13\x5b\x0e\x06\x4c\x23\x1c\x91\x5b\x86\x7c\x14\xdd\xb0\x78\x42\x39\xbf\x68\x34\xe1\x22\xd1\xd4\x2c\xe1\xb1\x29\xef\x0e\x16\xbf\x50\x2e\x5a\xbf\x8d\x5f\xd9\x34\x4b\x2d\x05\x4e\xb9\x2e\xef\xe7\xf4\xed\x88\xb7\xcb\xd2\x65\x59\x8d\x86\xb2\xf8\x4c\x9c\xb4\x56\xab\xbc\xb7\xc9\x23\x68\x41\xe4\x25\xb7\x3c\x89\xd1\xdc\xe5\xde\x62\x83\x14\x5d\x86\xda\xc7\x0c\x08\x65\x56\xdd\x05\xb4\x8a\xac\xd8\x82\xf5\x5e\x79\x42\xbf\x25\xef\x5e\xcf\xa6\xa8\x78\xec\x04\x61\xcc\xa7\x2c\x5d\x93\x23\xf2\x26\xbb\xc4\xf4\x74\xdf\x28\x2e\x46\xf9\x75\x57\xcc\xa6\x6b\xfd\xb5\x7d\xbc\xd9\xdd\xb6\xbf\xe5\x53\x5c\x6b\x6f\xf8\x14\x77\xb4\xfe\xf5\xd7\xde\xd5\x5a\xeb\xd9\x8c\x27\x9b\xad\xf1\x4b\x39\x42\x1b\x11\x36\x71\xb5\xe8\xf7\xbf\xa5\x4c\x5b\x6b\x32\x06\xee\x5e\xb3\x06\xf4\x62\x9e\x26\xdc\x0f\xd0\xa2\x28\xd2\xd4\x14\x90\x08\x21\x18\x33\xfd\x0b\x2e\x4a\xec\x58\xf3\x42\xa7\xa6\x00\x8e\xc3\x4d\x30\x42\xb3\xde\xb0\xce\xad\xca\xe0\x73\x3a\x1d\x4e\x2f\x40\x53\xcf\xfc\x87\xdf\xa3\x30\x71\xb9\x2c\xe5\xba\xb6\xbe\x96\x35\x25\xb5\xc1\xae\x5d\xd2\xb0\x69\x09\x5a\x83\x48\xe5\xb3\x75\x53\x6a\xeb\x8a\x1a\x5e\x6a\x33\x48\xcd\x4a\xb4\x1c\x2a\xcd\x43\xcf\x16\x61\x6b\x80\xd8\x2f\xaa\x84\xca\xe6\x68\x47\x06\x82\x14\x85\xeb\x18\x42\x87\x8c\x3f\x3d\xf5\x82\xcf\x05\xf5\x58\x52\x7e\xa3\xac\x96\x3f\xe2\x5a\x0a\xb0\x9d\x8a\xcc\xe5\x32\x1a\x65\x3a\x2b\x81\x09\x18\x78\x84\xe7\x8e\x9b\x31\x20\x8b\xc7\x20\xcd\x18\x0b\xa2\x03\xb9\xf8\x1f\xdf\x67\xaf\xaa\x6c\xaa\xa3\xe6\x9c\xa9\x4d\x1b\x68\x8d\x99\x7d\xca\x1d\xf3\x39\xff\x6b\xd9\x6c\x78\xb9\x28\x6e\xbb\x19\xa7\x74\x44\x17\xba\xc0\x12\x1c\x51\x71\x3e\x87\x56\xe1\x31\x58\xad\x5a\xed\x1a\x14\x6c\x52\x2f\x24\xe5\xdb\x05\x16\xb6\xad\xee\x87\x16\xb4\xae\xed\x9f\x6f\x6f\xed\x1f\xdd\x16\xb4\x7e\xba\xb5\x7f\x74\x8b\xf8\x81\x23\x5a\xc9\x79\xec\xe8\x8d\x4b\xcc\x79\xa9\x6a\x12\xe9\x2d\x5b\xad\x56\x96\xf1\x72\x57\x28\xe8\xbe\x6d\x55\xf9\x00\x06\x68\xee\x10\xc5\xde\x62\x41\xfd\x22\x1b\xe2\xab\x55\x54\x06\x2e\x31\xc3\x32\xf6\x02\xca\x08\x32\x23\xab\x5b\xa1\xb3\x83\xfe\xb7\x3e\xf1\xea\x42\xe4\xd9\x16\x6c\x79\x96\x13\xd4\x52\xec\xf3\x92\x8c\xec\x6f\x47\x78\x0a\x49\x5f\xcd\xd5\x76\xd9\xe1\x7b\x23\x18\x76\xda\x30\x3c\x83\x7c\x52\xc3\xca\x0d\x91\x3f\x44\x4b\x8c\xf2\xad\x07\xe7\x92\x9b\xa2\x9d\x13\x90\xaf\xfb\x2e\x73\x37\x95\x5e\x75\xab\xf7\x42\x3b\xa1\x73\xad\x3b\xe4\x52\x35\x30\x6f\x06\xfc\x9a\xad\xfd\x79\xd8\xe2\x7d\x98\x69\x2a\x05\x14\x07\x23\x3e\x47\x41\x3a\x64\x46\x5c\x40\xaa\x08\x6e\xab\xf0\xa0\xea\x36\x67\x29\x4f\x18\x95\xbf\xbb\x31\x8a\x3a\x55\xa0\x0d\xbd\x1c\x1a\xb4\x0b\x24\x12\x60\x02\x50\x29\xa9\xec\x83\x24\x29\x79\x3d\x69\xf8\x32\x43\xb5\xa0\x30\x96\x02\x9d\x55\x53\x6a\x47\x39\x9a\x79\xf1\x93\x2b\x2f\xec\x26\x76\xd1\xa6\xb5\x06\x1f\xba\xb5\x06\xdd\xc9\x4d\xe3\xc2\xf6\x32\x7c\x90\x62\xd4\xb4\xd3\xb4\xdd\xd3\x6e\xaa\xda\x20\x33\xa0\x66\x41\xf1\xbb\x98\x42\xbb\xa4\x2c\x7b\xed\x9b\x52\x37\xa3\xdb\x1b\x04\xbb\x57\xa8\x93\x4e\x1b\xe4\xa4\x43\x21\xb7\x9e\x2a\x3e\x0d\x3b\xb4\x79\x34\x39\xa3\x16\x67\xdb\x5b\x9c\x51\x0b\x7d\xc7\x4d\x3c\x26\x2b\x1a\x31\x31\xa6\x1f\xe4\xa4\x73\x4e\x04\x55\x47\xaf\x93\xa4\x4b\x8e\x0f\x86\x53\x13\xd9\xab\x61\x60\xd3\x07\x31\x2c\xca\x25\xd6\x31\xf0\xec\xcb\x7e\x8f\xfb\x83\x69\xb5\x61\xd8\x09\x43\x4f\xd9\xd9\xd3\x2a\x3b\xab\x94\x4d\x3a\xf0\xc3\x05\x3c\x50\xa1\xa6\xe1\x05\xcf\x74\x68\xa1\x58\x5c\xaf\x29\xda\xc2\xd8\x48\x77\xa7\x0d\x13\x17\x94\x93\xdc\x8e\x04\x87\x6c\x96\x1a\x67\x41\x4e\xf2\x65\x66\x49\xfc\xb0\x13\xb6\xc1\x5e\x9c\xe5\x2b\x04\x22\xdc\x61\x73\xad\x64\x95\x11\x6c\x49\xa3\x75\xc9\xe9\xdc\xee\x59\xac\x31\x6f\xcd\xa7\x3c\x65\x8a\x9b\x45\x85\x3b\x1b\xb7\xae\xb5\xed\xaa\x1f\x44\xb1\x9d\xa2\x83\x77\x6c\xea\xd5\xe0\x68\x18\xf5\x8d\x9a\xc5\xc6\xa2\x0f\x5a\x6f\xcf\xae\x38\x71\x98\x18\x5b\xfb\x8a\x83\x9f\x8e\xa4\x28\xb2\xce\x97\x99\x34\x48\xac\xa6\x98\x00\x6b\x60\xdb\x2d\x32\xc6\x18\x4f\xb4\x8b\x6d\xe8\xce\xe2\x94\x27\xc8\x04\xe5\x60\x48\x9c\xce\xb2\xb8\x70\xa3\x0b\x97\xd0\x04\xcf\x09\x5d\xcc\xc0\x54\x6a\x03\x53\xf6\x35\x82\xfe\x2c\xcb\xa4\xdd\x4d\x90\x22\x5d\x50\xd1\xbe\x91\xda\x8c\x14\xf6\x3f\xbc\x85\x20\x1b\xe5\x9d\xc3\xa8\x2c\x2b\xbf\xff\xdc\xfd\xd8\xb5\xf0\x18\x16\x3b\x4d\xc4\x0f\x57\x2b\xf8\xf1\xe4\x15\xcc\xe1\x47\x2a\xe2\x5f\xa9\xe9\x96\x2a\x30\xb7\xe9\xfb\x37\x2b\xb3\x4d\xed\x60\x98\x4a\x66\x5e\xbe\x38\xa4\x22\x3c\x38\x7f\x34\xf8\x10\xec\x6a\xc7\xdb\x6a\xf8\x6f\x48\x28\x4c\x1c\x0e\x22\x37\x58\x5d\x6e\x28\xed\x0c\x1b\xaf\x0c\x9e\xd7\xa6\xd2\xc5\xab\x2e\x1d\x39\x58\xc0\x33\xdd\x6a\x43\xb2\x75\x33\xaa\xb6\xdc\xae\x50\xb2\x63\xa5\xdb\x86\xb9\xf5\x93\x15\x95\xd7\x39\x8f\x8b\xec\x02\xdf\xa5\xd4\x5c\x60\xbf\x8c\x91\xa7\x85\x60\x6c\xb5\x59\xf9\x5e\x58\xde\x83\xc0\x14\x19\x41\x90\x8b\x47\x43\xb0\x03\x27\x10\x6c\xc5\xe1\xc5\x2b\x98\x87\xf0\xea\x82\xc4\x1f\x06\x44\x2e\xfe\xe2\x40\x5c\x47\xcc\x5e\x38\x72\xf1\x30\x38\xf6\x84\x40\x75\xa3\x64\x32\x8b\xcd\xd3\x42\x91\x93\x26\x2b\x3e\xcb\xd5\x51\x49\x78\x32\x04\x6e\x47\xdf\xff\xb3\xe8\x3b\x86\x93\xce\xdf\x10\x7c\x08\x04\x7d\x94\x1c\x0e\x3f\x8f\x3e\xf8\xa4\x41\xa0\xb9\x93\x6a\xb2\xc6\x1a\x8a\xbb\xa5\xf7\x2c\x65\xe8\xdd\x58\x5c\x5c\xf6\xae\x3e\x3e\x8a\x37\x38\xa9\xdf\x89\x38\xfc\xce\xcd\x98\x8b\xa7\x0b\x13\x8a\x06\xb7\x49\x4a\xdb\x54\xbd\x1b\x58\xad\x58\x92\x28\xd4\xba\x7a\x35\xea\x86\x54\x11\x32\x32\xc5\xbe\xb1\x21\xe3\xaa\x55\x10\xb8\x86\x10\x60\x34\x8a\xa0\xd5\x79\x1e\xd9\xff\x4e\xff\xd5\x0a\xed\x0a\x04\xbf\xcc\x58\x4a\x0b\x1a\x6e\xf6\x87\xd9\x7a\x6c\x6d\x0d\xad\x1f\x2f\x0a\x85\x3b\x62\xaa\x30\xe7\xf0\x65\xe6\xc3\x03\x49\xb4\x69\xbd\x66\xb3\x39\x2d\x80\x98\xd2\x48\xd0\x29\x74\x13\x34\x8b\x55\xc7\x66\xc0\xd9\xd0\xb2\x0b\x91\xad\xa1\x77\xfe\x64\x91\x67\x75\x92\xdd\x3f\x5c\x80\xe0\xe9\xa3\x15\x9d\xc3\xb3\x79\xcb\x7a\x20\x97\xeb\x53\xfe\xb5\x70\x46\x93\x63\x79\x67\x30\x0b\x2a\x13\x5c\x8c\x02\xf7\xce\x60\xf5\x90\xa2\x72\x29\x85\x61\x5c\xe8\x3f\x25\x52\x20\xa0\xdd\x2e\x02\xa1\x6d\x25\xd0\x4c\x99\x9e\x84\xbb\x03\x88\xde\x8f\x5a\x73\xbd\xd8\x29\x65\x95\xb1\xd3\x89\xce\xa2\x7f\xb6\xc2\x6f\x8f\x94\x57\xaf\x2e\xac\xf8\x1d\x61\x42\x8f\x9e\x34\x46\x78\xb6\x19\x24\xbd\x1b\xab\xf7\xef\xf8\xa8\xe2\xa3\xc0\xf0\xce\x08\xe1\xd9\xb7\x84\xc8\x1b\x36\xe5\xe9\xe2\x3f\xb1\x94\x0c\x30\x95\x62\xa4\xdd\x4e\x97\x8b\x87\xa1\x35\x17\x82\x17\x40\x2f\x4c\x7b\x37\xf3\x17\xb6\x2e\xbf\x2c\x7e\xbe\x0c\xa3\x2d\x58\x76\xbd\xb8\x30\x4f\x04\xe5\x21\x38\x15\x3f\x5c\xc0\x0b\xf8\xc7\x3f\xbc\x9f\x2f\x1f\x4f\x95\xce\x81\x0b\xbb\x4d\x58\xa6\x01\x27\xf6\x59\x42\x7b\x2b\xf6\xfa\x10\xce\x84\x26\x9f\xe5\x9d\x10\xf2\x44\xed\x65\x4c\x3a\x63\x86\xb3\x74\x7d\x9f\xc5\xdd\x2d\xfd\x66\x19\xd3\x08\xe5\x14\x8d\x5a\x3c\x8a\x2e\x39\x91\xdf\x95\x2e\x7d\x64\x09\x9f\x3d\x71\x29\x28\x07\x5d\xf2\x20\x1b\x55\x15\x7e\x95\x35\x02\x02\x2e\x60\x4a\x47\x3b\xaa\x6d\xfc\x4c\x52\x78\x06\x29\x33\x6d\x48\xc5\x28\xcc\x37\x8c\xcb\xcd\x1a\xae\xed\xce\xda\x8c\xd2\x92\x33\x4d\xd3\x11\x0b\xc9\xf3\x2d\xe2\x42\x8f\xc2\xa1\x54\xd8\xae\x5e\xc6\xc0\x74\xa6\x0d\xbd\x84\x71\x9c\xec\xf7\x9f\xfa\xf0\xaf\x17\x10\x4b\xa9\x12\x2e\x68\xa4\x7a\xa1\x0d\x4e\xf7\x17\x14\x08\xe8\xfa\xa7\x5e\x7f\x63\x81\xd3\xbf\xfd\xe3\xca\xd5\xf0\x2d\x55\xe6\xfc\x7c\x84\x72\xa4\x58\x36\x5e\xb4\xa1\x7f\xfb\x47\x1f\x4d\xff\x63\xef\x2a\xe8\xdf\xfe\xf1\x8e\x4d\xf0\x86\x06\x1d\xa4\xb4\x65\x9c\x32\x13\xb6\xe1\xc5\x3f\xcf\x5e\x86\xb5\x4e\xce\x4d\x3b\xaa\x54\xe1\xae\xa2\xdd\x5f\x7c\x7d\x94\x4f\xc4\xbd\xfb\x45\xeb\x5e\x0b\xc3\x87\x94\x8c\x1e\x1d\xee\xd4\x18\x9b\xff\xab\x60\x72\xd9\x20\x5d\x10\x08\x80\x97\xea\x6d\x88\x79\xc5\xa1\xec\x90\xaf\x34\x98\xe5\x15\x3f\xa1\xbc\x91\xe9\x62\x24\x45\xf8\x0d\x10\xaf\xc6\xbc\x0d\xe5\x6d\x18\x59\xcc\x5a\x73\x77\x41\x75\x64\xcd\xe9\xe7\x63\xf9\x8b\xe2\xd3\x73\xe3\x2e\x6c\x8e\x3c\x30\x16\x2f\x33\x2d\x75\xb0\x13\x49\x78\x5c\x1d\x84\xd2\x7a\x28\x3c\x2d\x52\xf3\xdc\xbd\x2b\xe7\x97\x39\xfc\xa0\xac\xff\x40\x88\xc2\x15\x9d\xeb\x23\xd3\x93\x73\x98\x69\x2c\xa9\x7e\xe5\x8a\xd5\xca\x2f\x80\xc0\x85\x36\xc8\x92\x6d\x3c\xe9\x5b\xb2\xe9\x7d\x7a\x37\x84\x57\x33\xec\x98\xc6\x0e\xd2\x21\x66\x69\xaa\xd9\x10\xd7\x58\xc7\xf5\xaf\x6f\xdf\x9e\xd8\xfb\x76\xff\xa0\xf6\x96\x87\x6a\xaa\xcc\xe8\xf8\x0f\x4b\x1f\xb7\x5d\xe3\x74\x7e\x27\x02\xd2\xfd\x70\x3d\x4b\xd3\xbe\x15\x58\x76\xa1\xf7\xad\xee\x88\xa5\x3d\x69\xe2\x1f\x0d\xe1\xc3\x8d\x03\x45\xb6\xf9\x05\x18\xc5\xa7\x45\x64\xe6\xf7\xfc\x48\xad\x33\xe8\xed\x58\xdf\xef\xb8\x7b\xe0\x1f\xc1\x6b\x5a\xfa\xe4\x67\x4d\x61\xca\x4c\x3c\x46\x5d\xe2\x5d\xc9\x3b\x0d\x77\x14\xed\x1e\xff\xe0\xda\xaa\xb4\x2c\xc5\x1d\xfb\x70\x27\x55\x6d\x77\xaf\x23\x37\x63\xfb\xa6\x9b\xe6\x93\xce\x18\x89\x13\xea\x18\xe6\xca\xb6\xe1\x75\x0e\xc7\xa5\x6b\xe8\xab\x85\xfb\x51\xfa\xf0\x9c\x4a\x87\x50\xe8\xac\xa5\x77\x84\xd3\xa5\xda\xb9\x5b\x07\xba\x5c\x4a\x8d\x2e\xfc\xb5\x8e\x77\x32\xed\x9e\x83\xe8\xc7\x73\x6f\x5b\xe0\x78\xee\xcd\xe2\x96\x1c\x5a\x40\x69\xcf\xd9\x74\xa6\xfc\x2c\x5a\x8f\xab\x7b\xf0\x8f\xc9\x08\x4f\xc7\xac\x76\x28\xbd\x76\x72\xbc\x9b\xdc\x7f\x6c\x5c\x1b\xcc\xdc\xba\xde\x72\xbf\xe8\x1a\xef\xfa\x06\xb3\x80\x1c\x55\xde\x7c\xa3\xe4\x34\xb8\xa5\x77\xd7\xee\x10\x89\x7f\x5e\x89\xc6\x51\x6b\x7d\x2b\xed\x50\x31\xb2\x3d\xbc\x76\x87\x74\x26\xa3\x83\xf2\x17\xb5\xc7\xe8\x23\xa6\x36\xf0\x4a\x11\x48\xeb\x53\x3a\x37\xad\xfd\x7b\x1b\xea\xc8\xaa\xb2\x30\x61\xf4\xee\xec\x5d\xee\x8e\xfc\x36\x49\xbe\xf9\xc5\x6b\x1f\x45\x51\xd9\xc3\xce\xf0\x5a\xe3\xfc\x9c\x8a\xd7\xa1\x6a\x2d\x5c\x86\x69\x34\x72\x5f\x90\x08\x4b\x79\x8e\x30\xea\x8f\xe9\x40\x95\x3b\x13\xe5\x3a\x95\x03\xcc\x1f\xba\x13\x30\x41\x75\xa0\xc9\xa6\xb0\xc8\x25\x94\x1a\x68\xda\x50\x9d\x79\x2d\x75\x95\x20\x0c\x9b\x9e\xf0\x9f\x99\xbe\x46\x3e\x1a\x0f\xa4\xd2\x81\xa6\xb3\x1e\x98\x6d\x5f\x34\x5a\x2c\xf1\x84\x0b\x2f\x75\xfb\xb5\xd6\x72\x3e\x9c\x0e\xd0\x1d\x2a\xe3\x89\x76\xa7\x59\x5c\x75\x24\x01\x60\xa3\x9b\x51\xe6\xd2\xb3\xc1\x89\x7d\x7e\x68\x32\x2f\x0d\x38\x00\xcd\xdb\xd2\x38\xd6\xd3\x78\xef\xaa\xf7\x0d\x9b\xee\x58\xe6\x23\x32\x6b\x2b\x8f\xe0\x09\xb1\xab\xea\x1c\x8f\x4d\xac\xe4\x15\x6d\xc3\xab\xfc\x62\xc0\x11\x0b\xe7\x0b\x5a\x27\xba\x43\x40\x3a\x77\x27\x1d\x10\xaa\x7b\xcc\x26\x5f\x6e\x0a\xf2\x86\x5f\x31\xb6\x4b\x49\x8d\x74\x58\xc3\x60\xba\xa8\x48\xef\xbe\x43\x61\x71\xca\x51\x18\x17\x41\x14\x3d\xc5\xa0\xa2\x0f\xa4\x26\x08\x5d\xa2\x72\x07\xc9\xb7\x12\xe1\x2f\x55\x5e\xe8\x5d\x69\xea\xc7\x51\x3d\x4d\xf2\xd6\xb3\x01\x41\xfe\x4b\xa1\x68\x11\x84\x2e\x79\x17\x3b\x8f\xb3\x01\x6d\xd9\xd1\xb6\x22\x2a\x55\x4f\xe8\x1e\x39\xa6\xad\xba\xfb\xb9\x6d\x99\x8f\xb7\x44\x35\x9d\x6c\x99\x0d\x76\x66\xe5\x2a\x5a\x5c\x6d\xdc\x13\x31\x15\x66\x08\x0a\xb4\xdc\xe7\x23\x71\x32\xc1\x7a\xd8\xd4\x80\xe4\x00\xc3\x13\xfd\xc0\xd0\xc9\xad\x39\x34\x7c\x76\x7d\x85\xb4\x7b\x86\x1e\xf2\x91\xc4\xf6\x4f\x24\x76\x7c\x32\xb8\x6a\x3e\x70\x76\x8a\x6f\x1f\x76\xcd\xcc\x94\x6b\x7b\xba\x6f\xfb\xc4\x90\x6d\x43\x2e\x12\x6a\x61\x59\x90\x9d\x29\x85\x43\x54\x48\x67\x6d\x18\x10\x9d\xc1\xaf\xf4\x1d\x8f\x18\x81\x90\xc9\xc1\x67\xfb\x7d\xed\xdf\x27\x8f\xbd\x2b\x84\x3d\x61\x2a\xf3\x61\x49\x1f\x10\xa3\x69\xc3\x60\x66\x0a\xaa\xa8\x2c\x40\x85\x84\xcd\x4c\x52\xac\xea\xb9\x06\x9e\x40\xc0\x04\x48\x95\x8d\x99\x20\x92\x18\x46\xf0\x86\xde\x18\xe6\x07\x50\xdb\x9e\xab\xed\xa7\xb3\xb1\xc2\xda\x89\x4a\xab\xcd\xb3\xc4\x7d\xa2\x96\x70\x4d\x45\x3d\xa1\x97\x8f\x09\x4d\x91\xc2\xa4\x4a\x7f\xd5\x1b\x93\x9c\x23\xe4\xa1\x4c\x86\xf5\xfa\x70\xfd\xfe\xd6\xb2\x59\x78\x7d\x7d\x65\x7f\x74\xff\xa7\xd7\xbf\xed\x43\xd0\xef\xbe\xed\x5e\xde\x92\xc5\x6f\x3e\xbe\x7f\xe7\x0f\xcb\x12\x08\xea\x9e\x0b\xe6\x09\x5c\x6c\x93\xbe\x2b\x5b\x3e\x4d\x62\x1c\xcc\x78\x9a\x60\xf9\xf2\xa5\xe0\xa2\x1e\x2b\xa5\x88\x6b\x18\x02\x91\x6b\x9b\x13\xaf\xc0\x7d\x57\x43\xdf\x95\xd8\x1b\x1b\xe3\x74\x54\x2a\x27\x52\xeb\xec\xa9\xa2\xb9\xf6\x49\x45\x3a\xa2\xd7\x3a\xd8\x02\xb0\xd0\xcf\xb2\x05\xc9\x89\x5e\x8b\xc4\x52\x49\xcb\x4a\xa2\x6b\x69\x88\x13\xef\x8d\xef\xb0\x5d\x6b\xdf\xa5\x40\xd4\x4e\x46\xe1\x0c\xe7\xa3\xc0\x44\x97\x35\x53\xec\xe8\x7a\x57\xf5\x2d\x9f\x90\x76\x89\xa8\x73\xa3\x61\x79\xac\xa9\x7e\x57\x49\xc7\x31\xb0\xee\x87\x03\x65\xb6\x61\xef\x18\x8a\x41\xb8\xbf\xf3\xbf\xbe\x91\xe7\x53\xb0\x1d\x90\x55\xfe\x0c\xae\xff\x04\x30\xfb\x7b\xad\xf0\xe7\xad\x15\x68\x33\xa8\x58\x2f\xb4\x61\x37\xa0\xec\xa7\x90\x7f\xb4\x21\xab\x4a\x3d\xe5\xb7\x62\xcb\x32\x0b\xf4\xda\x5b\xce\x07\xe1\x9e\x89\x03\xfe\x59\x0a\x7b\x9c\x5c\x47\x97\xa9\x14\x18\x84\x51\x1f\xcd\x4d\x20\x78\x1a\x36\x77\x19\xe7\x5e\x7b\x51\xe7\x46\x16\xe8\x8e\x3b\xbc\xec\x13\xcd\xce\x2e\x9e\xb9\x49\x33\x6b\xdc\xa5\x13\xdd\x04\x8f\xf8\xa7\x07\xa4\xfa\xe6\x61\xf2\xbd\xc3\xa4\x3a\x0f\xaf\xaa\xcf\xac\x3b\xd1\x7b\x15\x94\x33\xf3\x1f\xe2\x05\x21\xcd\xbd\x6e\xa0\xb3\xe7\xd7\xd2\x6c\x8a\xff\xdf\x01\x00\x3d\xde\x93\xe9\xa6\x45\x00\x00")
 
 func templateDialectSqlPredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -781,12 +824,12 @@ func templateDialectSqlPredicateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 3325, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/predicate.tmpl", size: 17830, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3a\x6d\x6f\xdb\x46\xd2\x9f\xa9\x5f\x31\x25\xfc\x14\x92\xa1\xd0\x4e\x9e\xc3\x01\xa7\xc0\x07\xf8\xe2\x04\x10\xd2\xa6\xb9\x3a\x6d\x3f\x08\x42\xcb\x90\x43\x79\x21\x6a\xc9\x90\x2b\xc7\x81\xc2\xff\x7e\x98\xd9\x17\x2e\x45\x4a\xb6\x93\x6b\x7b\x38\xdc\x87\xc4\xd2\xee\xcc\xce\xec\xbc\xcf\xac\x76\xbb\xb3\xd3\xd1\x8b\xa2\xfc\x54\x89\xd5\x8d\x82\x67\xe7\x4f\xff\xf6\xa4\xac\xb0\x46\xa9\xe0\x55\x9c\xe0\xfb\xa2\x58\xc3\x5c\x26\x11\x5c\xe6\x39\x30\x50\x0d\xb4\x5f\xdd\x62\x1a\x8d\xde\xdd\x88\x1a\xea\x62\x5b\x25\x08\x49\x91\x22\x88\x1a\x72\x91\xa0\xac\x31\x85\xad\x4c\xb1\x02\x75\x83\x70\x59\xc6\xc9\x0d\xc2\xb3\xe8\xdc\xee\x42\x56\x6c\x65\x3a\x12\x92\xf7\xbf\x9b\xbf\x78\xf9\xe6\xfa\x25\x64\x22\x47\x30\x6b\x55\x51\x28\x48\x45\x85\x89\x2a\xaa\x4f\x50\x64\xa0\x3c\x62\xaa\x42\x8c\x46\xa7\x67\x4d\x33\x1a\xd1\x1d\xe0\x32\x4d\x85\x12\x85\x8c\x73\xc8\x04\xe6\x69\x0d\x59\xa1\x89\xbf\xdf\x8a\x3c\xc5\x2a\x02\x86\xde\xed\x20\xc5\x4c\x48\x84\x30\x15\x71\x8e\x89\x3a\xab\x3f\xe4\x67\x1f\xb6\x58\x7d\x3a\xd3\x98\x21\x34\xcd\x28\xd8\xed\x9e\xc0\x47\xa1\x6e\xe0\x24\x7a\x55\x54\x28\x56\xf2\x35\x7e\xaa\x79\x2b\xa0\xf5\x57\xaf\x6b\x78\x5f\x14\xb9\x86\x44\x99\x02\x9f\xee\x3e\x1e\xa5\x14\x6a\x60\x38\x29\xd7\x2b\x98\x5d\xc0\x49\x74\x9d\x14\x25\x46\x6f\xe3\x64\x1d\xaf\xd0\xee\x1a\xd6\x09\xa2\x8c\xeb\x24\xce\x1d\xe0\x3f\xcc\x8e\x01\xac\x30\x41\x71\xab\x21\xdd\x67\x87\x4e\xdc\x64\x5b\x99\xc0\xb8\x03\xdb\x34\x70\xea\x53\x69\x9a\x09\xd4\x1f\xf2\xcb\x3c\x1f\x27\xea\x0e\x92\x42\x2a\xbc\x53\xd1\x0b\xfd\x77\x02\xe3\xc5\x92\xe1\xa3\x37\xf1\x86\x58\x9c\x02\x56\x55\x51\x4d\x60\x37\x0a\x6e\xe3\x0a\xc6\xa3\x20\x90\x45\x8a\x35\x5c\xc0\x1e\xe8\x8e\xa4\x76\x4c\xa2\x4e\xa4\x17\xb0\xc7\x63\x64\x76\xcc\x01\x46\xba\x41\xf0\x6b\x5d\x62\x32\x00\xce\xf2\xbd\x2e\x31\x19\x4f\xba\x34\x5f\xa6\x2b\xb4\xd4\xf2\x22\x4e\x31\x7d\xf7\xa9\xd4\xcc\xee\x76\x90\xa3\x84\x08\x9a\x66\x49\x3a\xdd\x11\x0c\xe3\x56\xb1\x5c\x21\x9c\x20\x09\x36\x32\xc8\xb4\xd3\x67\x71\xb7\x73\x3a\x42\x7b\x6d\xf8\xe6\x02\xa4\xc8\xa7\xee\x38\xc7\x7d\xd0\xec\xdd\x67\x72\xdc\xe2\x3a\x9b\xaf\xfd\xab\x04\x22\x23\x19\x18\x46\xc5\xd4\x63\x76\xb7\x03\x91\xc1\x4a\xc1\x89\x80\x73\x62\xe7\xf3\x67\x02\xd5\x24\x1f\x79\x07\x87\x07\x5a\x38\x9e\xc2\x54\xb5\x45\x5e\x73\x8c\xb6\xd7\x14\x19\x58\x40\x8d\xc7\x6a\x8b\xde\x14\x29\x46\x2f\x8a\x7c\xbb\x91\x74\x42\x5c\x96\x28\xd3\x71\x7f\x6f\xca\xea\xf5\xdc\xc2\x97\x4c\x14\x45\x13\x23\x4a\x9f\xa8\x3e\xe5\x3a\x89\xe5\xcf\x71\xbe\x65\x05\x93\xf1\x8f\x27\xb0\x58\x0a\xa9\xb0\xca\xe2\x04\x77\xfa\x1e\x64\xae\x24\xad\x6f\x3b\xc6\x9a\x14\x32\x13\xab\x59\xcf\xb4\xf4\x7a\xe3\x99\xb9\x61\x9c\xbf\x4e\x81\xfe\x10\x47\xb7\x9a\xee\xec\x82\x57\xa2\xda\xb1\xb2\x6f\x92\x7d\x35\xf7\xe4\x75\x6b\xef\x60\x48\xe9\xef\x9a\x56\x94\xad\xed\xb9\x9e\x2c\xba\x1a\xa8\x50\x6d\x2b\x09\x1a\x6d\x14\x38\xf9\x5c\xd6\xb5\x58\x49\x2b\x1b\x43\x25\x8a\x22\x4f\x42\x13\xed\xdf\xcc\x88\xc8\xc8\x43\xf4\x45\x27\x70\x71\x01\xe7\x9a\x3f\x73\x7c\xb6\x51\xd1\x4b\x02\xce\xc6\xa1\x0d\x6b\x4d\x33\x03\x43\x25\x89\xf3\x1c\x53\xbe\x59\xb1\x55\xfc\x55\xc8\x15\xb4\x3a\x0a\x89\xf9\xc6\x53\x08\x13\x5a\xb4\x24\x9f\x3c\x5d\x1e\xf6\x66\x96\x05\x2f\x44\x5d\xc7\xf6\xbe\x1d\x90\x0b\xa3\xc6\xcc\xa5\x11\xa5\x16\x85\x96\x67\x33\xa2\x8b\x63\xc5\x71\xb5\xfe\x90\xaf\xaa\xb8\xbc\x89\xfe\x49\x11\x86\xac\xb4\xa6\x38\x39\xed\x99\x49\x5a\xd1\xa7\x29\xb0\xa0\x27\xcf\x19\x5f\x3b\x11\xcb\xcc\x52\x16\x39\x07\x50\x4b\x65\x48\xbc\x1e\x93\xa4\x72\x91\x8f\xac\xb1\xfb\x71\xa9\x23\x0c\x27\x22\xbc\x53\x74\xd9\x13\x08\x7f\xc4\x24\xf4\x38\x0c\x09\x3a\x24\x5c\x1b\x59\x40\xe1\xa6\xcc\x63\x35\x98\x13\x31\x5e\x61\x45\x82\x14\x72\x15\xda\x18\xe8\x8b\xd2\xff\xdc\x67\xf8\x51\xa9\xe7\x45\xb1\x95\xea\x40\xf2\x11\x52\xf9\x09\x47\x87\xff\xd9\x3d\xf1\xdf\xf0\xe3\x54\xc7\x04\x1e\xac\xba\xc7\x31\xff\xf2\x4e\xd4\x87\x98\xa7\xa4\xe2\x73\x2f\xa7\xd6\xaa\xf6\x39\xf0\xa5\x30\x71\xe6\xd7\x37\x9f\x2c\xce\x6b\x9c\x1e\x74\xbc\xe4\x06\x93\x35\x20\xb1\x84\x32\xc1\x19\xfc\xdf\x6d\xc8\x34\xb5\x55\x5b\x3d\xc1\xdf\xe1\xfc\xb1\x7a\xf2\x04\x0c\xa7\x5d\xa7\xa0\xd5\x8e\x72\xbe\xed\xef\xd3\x1d\x48\x03\x33\x6f\x93\xbe\xdb\xbd\xe0\x5d\xfc\x3e\xc7\x59\x2f\xf0\xf3\x32\x67\x52\x93\x1b\xfa\x20\x36\x69\x10\xd0\xfc\xca\x27\xf0\x8a\x0a\x3b\x47\x21\xa0\x88\x30\xd3\x75\x62\xc4\x87\xcc\xaf\x22\x5a\x23\x8d\xd5\xca\x96\x37\x0c\xaa\xcf\xec\xd3\xb2\x68\x8c\x11\x4b\x65\x11\xf8\x7f\xfe\xef\x55\x55\x6c\xfa\x39\xa4\xfe\xc0\xe5\xc0\x4f\x52\x7c\xd8\xe2\x8c\x73\xe7\xd4\x86\x80\xb2\x1e\xb2\x88\xb2\xc2\x54\x24\xb1\xc2\xfa\x39\x07\x89\xb2\x9e\x90\xda\xd8\x18\x74\x2c\x7f\x6b\x21\x6c\x38\xaf\x31\xe7\xb2\x99\xf5\x13\x5d\x9b\x6f\x13\x1d\xb2\xa9\x2e\x16\x5c\x28\x72\x0c\x29\x6d\xa6\x29\xeb\x85\x58\x3a\x54\x97\x4d\x1a\x17\xa0\xc4\x46\xa8\x21\x06\x79\xe3\xb9\xd9\xf7\x2c\x55\x33\xf7\x1d\x2f\x5f\xc0\x29\xef\xdb\xc3\x8a\x2c\xab\x71\xf0\x34\xbd\xf3\xdc\x42\xf4\xce\xfb\x41\xaf\x5f\xc0\xa9\x86\x38\x2e\xbc\xa2\x4a\xb1\x3a\x24\xb7\x1f\x68\xf3\xf7\x93\x99\x71\x32\xa6\xf5\xb8\x50\xc2\xce\x62\xdc\xcb\xb1\x42\x24\xbd\x86\x80\xb6\xae\x74\xb4\xde\x3f\xd3\x84\x31\xb7\x3d\x99\x8c\x02\xf5\x94\x90\x6c\x2f\xc4\xce\x34\x1e\x74\xb1\xc9\x28\x70\xa2\xf0\x30\x34\x17\x63\xf5\xd4\x7a\x59\x0f\xdb\xac\x53\xe6\xe4\x7f\x64\xff\x63\xf5\x54\x07\xb1\x01\x37\xf0\x55\xeb\x28\x0e\x06\x44\x0f\xc0\xf2\xe1\xbe\x3f\x90\x1b\x56\x08\x69\xf1\xd7\x29\x94\xad\x22\x0f\xfb\x1a\xb3\x55\xfa\xaa\x7d\xd0\x01\x6c\x6f\x83\xb8\x5f\x68\xf4\x67\x67\xc6\xb1\x44\x0d\x9b\x58\xa6\x31\x77\xc3\xc4\x88\x81\x4d\xf2\x78\x5b\x63\x04\xbf\x20\xd4\x2a\xae\x94\xc6\xe1\x12\x20\xc5\x2c\xde\xe6\x4a\x17\x7f\x53\x88\x65\x0a\xc5\x2d\x56\x95\xa0\x46\x5d\xc1\x7b\xcc\x8b\x8f\xd4\x21\x48\xc4\x94\xba\x79\x4f\xcc\xda\xcb\xc6\xc6\xc7\x26\xda\x8b\xc7\x9b\x58\xdd\x44\xdf\xc7\x77\x73\xa9\xfe\xff\xd9\xe4\x8b\x03\x83\xa3\xa2\x4f\xd5\x91\xa1\x93\x98\x2c\xc4\x88\xdb\xdb\xb6\xa3\x3e\x3b\xd5\xe9\xe7\xac\x8c\xf5\xfd\x84\xc4\x9a\xbb\x7c\x5e\x86\x15\x4a\xac\x62\x25\x0a\xc9\x22\x62\xa8\x22\x83\x18\x56\xe2\x16\x25\x60\xba\xc2\x87\xcc\x01\x08\xaf\x9d\x02\x9c\x48\xae\xb2\xb8\xf7\x21\x0e\x88\x1c\xd7\xa9\x1f\x8d\xc8\x3d\x06\xb2\xaa\xd8\x18\x0a\x1a\x17\xfd\xf6\x9e\x2a\xaf\xce\x31\xc4\x10\x1d\x43\x1a\x00\x55\x30\xff\xab\x8a\x22\x39\xed\x32\xfb\xaa\xe8\x9c\x27\x52\x94\xca\x3f\x73\xce\x0b\x4f\x1c\x80\x3f\x0a\xb0\x30\x3f\xb6\x4a\x19\x05\xb5\xc2\xb2\x53\xcf\xbe\xc1\x8f\xd7\x0a\x4b\x6a\xdf\xdb\x84\x49\xce\x4b\xfa\x94\xfd\x1c\x0c\xbd\x75\xbd\xb0\x97\x0d\x87\x3c\xd9\x04\xb6\xc9\xd4\xa7\xf5\xae\x60\x4a\xa8\x53\xf0\x30\xb9\xfe\xa6\xb7\xda\x25\xdc\x3d\x9c\x44\x3e\x76\xdf\x34\xd2\x8f\x98\x33\xa2\xe3\x12\xa3\x79\x3d\x97\xb7\x58\xd5\xed\x5a\xef\x82\xa8\xf9\xd9\x4f\xf8\x24\x74\x91\xd1\xf6\xf7\xcf\xbe\xd7\x7a\x30\x13\x82\x81\x13\xde\xbe\xf6\xd0\xa3\x28\x72\x0d\x73\x5e\xe3\x7d\xb8\x3a\xa2\x79\xf8\x7e\xb7\xad\x71\xe9\xea\x3c\x48\xb0\x76\xd2\x34\xe0\x29\xfa\x1a\xd5\x1b\x14\xab\x9b\xf7\x45\x55\xdf\x9b\x33\xa6\x40\x86\x32\x39\xe0\x7f\x64\xe7\xf7\xfb\x5f\xac\x5d\xce\xf3\x0d\xe7\x8a\xdc\x78\x3d\x64\x24\x57\x15\x9b\xff\x4a\x57\x64\x30\x91\x0e\xc5\xcd\xf9\xd5\x1f\xe8\xa5\x22\xfd\x9f\x37\xfe\x29\xde\xf8\x95\xae\x78\xc4\x67\xba\x2d\xfb\x51\xfb\x3f\x6e\xa9\x0c\x20\x32\xe3\x50\x03\x96\x7a\x68\x68\xf8\xdc\xa0\x78\x49\xbf\xab\x19\x2d\xaf\x6c\xcd\x45\xfb\x26\x5e\xe3\x78\xb1\x34\xd7\xfe\x59\x57\x2b\xe7\x53\x6f\x24\xc2\x95\xb5\x48\x5b\xe8\x4d\x5c\x2e\xfc\xce\x0d\x9a\x66\x7f\x38\xbd\x87\x6d\x6a\x37\x3b\x60\xd2\xe5\x9b\x9e\xe3\xe9\x5a\x5e\xa4\xf5\x82\xa3\xd2\xfc\x6a\x09\x7a\x02\xc5\xeb\xc4\xa4\x1b\xbf\x65\x6b\x3b\x7b\x9b\x5f\xb9\x72\xdf\x4d\xbf\x83\x80\xa2\x08\xf1\xb9\x58\x76\x3d\xc2\xf0\xe8\x60\xe8\xc8\xce\x45\x7a\xa0\xcb\xbd\x11\x3a\x53\xe3\xff\x06\xba\x6b\xd2\x66\xa7\xc3\x0e\x02\x5a\x9a\xed\x81\xb4\xbb\x81\x71\xb0\xd9\x90\xc7\x69\x88\x03\x7d\xf8\x11\xe7\x3b\xd2\x9a\x0f\x38\x9c\x46\x31\x7f\x5c\x0b\x3b\x33\xdd\xd8\x60\x1b\x16\x04\x75\xf4\xcb\x0d\x56\x1c\x43\xa2\xb9\x1d\xd9\x3d\x80\xd8\x42\xcf\xc2\xf7\x6e\xfa\x94\x3c\x2a\xe7\x8f\xe7\xce\xb9\x96\x53\xc8\xd6\xdc\x38\x4c\x7c\x0e\xe9\xd0\x62\xcb\xf1\x3e\x24\xea\x6f\xb6\x79\x3e\x97\xea\xaf\x7f\x09\xdd\xa4\x9d\xad\xf1\xa7\x1a\xab\x2b\x76\x4d\x3b\x65\x27\xac\x0b\xbd\x49\x48\x46\xbf\xad\x33\xdb\xd3\x85\x3c\x7a\x78\x6b\x21\x7d\x12\x42\x12\x85\x16\xe2\x20\x9d\x76\xe4\x3a\x73\x53\xf1\x67\xfe\x58\xdc\xc8\xd9\xd4\xe1\x7b\x7b\xdf\xda\xeb\x34\xcd\xae\x99\xea\xa9\xb9\x90\xfc\xad\xf1\x65\xa5\xc7\xbe\x86\x42\xb1\x55\x53\x10\x12\x0e\x4c\x96\xc9\x21\x18\xa4\x58\xd3\xf5\x8b\xad\x8a\xc6\xa7\x2d\x1d\xad\x03\x0a\x42\xdf\x14\x6b\xf8\xfc\x19\x90\xc5\xd9\xc6\x95\x60\x78\x0a\xbd\x95\x78\x57\x62\xa2\x30\x05\x91\xea\x0e\x88\x4b\x12\x72\xbe\x27\xc5\x56\x85\xe6\x60\xf3\xa2\x83\x42\x5a\x0e\x84\x34\x0c\xf0\xcd\xfa\xf4\x49\xd6\x5f\x47\x5e\xc8\x3d\xea\xc5\x56\xb1\x52\x4c\x88\xdd\x9b\xdf\x5e\x56\xab\x10\x42\xba\x77\x08\x21\x4f\xb2\x42\xb6\x26\x08\xad\x9a\x43\xa7\x95\x87\xcf\x72\xcf\x36\xcf\x36\x7a\xf0\x1d\xda\xc7\x22\xcf\x4e\x02\x21\xef\xe7\x48\x48\x8f\x21\x67\x7c\x1d\xb6\xb4\x75\xfc\xdb\xb8\xa2\xc8\xeb\xf4\x94\xd6\x0b\x2b\xb8\x65\x47\x4b\x0f\xd3\x0b\x67\x02\x91\x92\x69\x72\x44\x36\x33\x52\x7b\xe4\x9e\x7d\x98\xb8\xee\x12\x81\x59\x20\xcb\xf6\xc1\xf9\xa4\x85\x59\x5b\x76\xc1\xdb\xf5\xf6\xa9\x28\xf0\xdf\x03\x3c\x17\xb2\x8f\x41\x83\x6f\x0f\x3c\xee\xff\xa2\xb7\x87\xee\xeb\x83\x27\x98\xdf\x74\xbe\xd6\xa9\x29\xd4\x01\xd4\x24\x9e\x90\x04\xf3\x9b\x1d\x1e\x1b\xd6\x18\xdc\xc4\xe2\xe1\x8a\x70\x7e\x35\x97\x56\x4a\x2e\x98\x4a\x5b\xf3\xb8\xf9\xb7\x3e\xc8\xbc\x39\x4f\xbc\x5b\x1f\xe4\x9a\xdf\x4c\x0c\x1b\x36\xa9\x7b\x19\xdd\x52\x30\x98\xe6\x29\x42\x9b\x8c\xd6\x02\xd5\xc0\xcb\x51\xdf\x5e\x0e\x89\xc6\xb3\x99\x3d\xc9\x68\x1b\xd2\x78\x98\x6a\x31\x49\x5b\x19\x18\xd3\xd9\x1b\x1d\xfa\x15\x87\x66\x6e\x21\x96\xe6\xf1\x4a\x1f\x7e\xad\xaa\x6d\xa2\xd8\xad\x74\xc5\xe8\x3f\x32\x1e\x07\x9e\x82\xf4\x48\xbb\x87\x1a\xca\x70\x3a\x83\xfc\xf0\x51\xbe\x7a\x6d\x9f\x1a\x53\xbf\xf8\x1a\xac\x41\x86\xaa\x30\xfa\x38\x54\x89\x3d\xac\x80\x39\x22\x0d\x91\x41\xb6\x6e\xdf\xfe\xc4\xb2\x7b\xc5\xd7\xf6\x92\xcf\x09\xac\x63\x1d\x41\xc7\x33\xd9\x2b\x4f\xb3\xf5\xa4\x95\x31\x85\x8a\xd3\x6c\xbd\xec\x0a\xd3\xae\x4e\x1d\xc5\x3d\xe1\x3d\xd4\xca\xff\x83\x2c\xdc\xde\xeb\x2b\x6c\x3c\xd3\x8f\xd2\x4f\xd6\xf8\xc9\xda\xfb\xbe\x0a\xc2\xdf\xdd\xe6\xe5\x01\x33\xfe\x92\xbe\xe1\x90\xc5\x1e\xec\x1d\xee\xb3\xd4\xe1\x8e\x80\x2f\x65\xe5\xe0\xf4\xd0\x6e\xd8\xa6\x82\xbe\xee\x59\x58\xff\xb7\x14\xbe\xe5\xb9\xa1\xb4\xdf\x65\x1b\x56\xc7\xc7\xaa\xe5\x47\x14\xcb\xbd\x76\xb6\x5b\x04\x37\x7f\x96\x71\x9b\x88\x70\x20\x14\x78\x71\xa3\x5b\x92\x1d\x32\xf3\x07\xd9\xb6\xa8\xf9\x28\x62\x8e\xe3\xfb\xa0\x89\xfb\x95\x88\x1f\x4c\xfe\x18\x9f\xdb\x63\xee\x34\x5b\x0f\x73\x78\xdc\xc9\x5c\x63\xa1\x5f\x23\xa1\x69\x64\xdb\x10\x79\x81\xf2\x9e\x8c\xd3\xa9\xd1\xf6\x7f\x1d\xd0\x7c\xd1\xd4\xc2\x2f\x03\xdd\x90\x22\xae\x3a\xbf\x95\xbb\xac\x56\xed\x1e\xbf\xe5\xfa\xbb\xad\x89\xe8\xb9\xe1\x36\xcf\x15\xf9\xba\x07\xe2\x35\x49\x23\x3b\x9e\xb8\x89\xeb\xb7\x15\x66\xe2\xce\x43\xa1\x8e\x2c\x34\x33\x1d\x92\x81\x7e\x37\xb6\xd8\x9a\x10\x33\xe7\x26\x7f\xde\x00\x49\xcb\x58\x16\xca\xe1\x89\x3c\xa7\xe6\x19\x9a\xe6\xb4\xf3\x63\xac\xd8\xbb\x4f\xff\xe7\x84\xff\x0a\x00\x00\xff\xff\x8b\x13\x66\xeb\xa9\x29\x00\x00")
+var _templateDialectSqlQueryTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x73\xdb\x38\xd2\xe0\xcf\xd2\x5f\xd1\xa3\x72\x52\xa2\x8f\xa6\x92\xb9\x47\xd5\x29\xf1\x5e\x79\x63\x67\xd7\x35\x49\x26\x9b\x64\x76\xee\xce\xe5\x9a\xa1\x49\x50\xc6\x98\x22\x69\x82\xb2\xa3\x55\xf4\xbf\x5f\x75\xa3\x01\x82\x2f\x4b\x4e\x32\xdf\x77\x75\xf7\x55\xed\x4e\x2c\x12\x68\x34\x1a\xfd\x46\x03\xdc\x6c\x66\x87\xe3\x57\x79\xb1\x2e\xe5\xe2\xba\x82\x1f\x9f\x3d\xff\xef\x47\x45\x29\x94\xc8\x2a\x78\x1d\x46\xe2\x2a\xcf\x6f\xe0\x3c\x8b\x02\x38\x49\x53\xa0\x46\x0a\xf0\x7d\x79\x27\xe2\x60\xfc\xe9\x5a\x2a\x50\xf9\xaa\x8c\x04\x44\x79\x2c\x40\x2a\x48\x65\x24\x32\x25\x62\x58\x65\xb1\x28\xa1\xba\x16\x70\x52\x84\xd1\xb5\x80\x1f\x83\x67\xe6\x2d\x24\xf9\x2a\x8b\xc7\x32\xa3\xf7\x6f\xce\x5f\x9d\xbd\xfb\x78\x06\x89\x4c\x05\xf0\xb3\x32\xcf\x2b\x88\x65\x29\xa2\x2a\x2f\xd7\x90\x27\x50\x39\x83\x55\xa5\x10\xc1\xf8\x70\xb6\xdd\x8e\xc7\x38\x07\x38\x89\x63\x59\xc9\x3c\x0b\x53\x48\xa4\x48\x63\x05\x49\xae\x07\xbf\x5a\xc9\x34\x16\x65\x00\xd4\x7a\xb3\x81\x58\x24\x32\x13\x30\x89\x65\x98\x8a\xa8\x9a\xa9\xdb\x74\x76\xbb\x12\xe5\x7a\xa6\x7b\x4e\x60\xbb\x1d\x8f\x36\x9b\x23\xb8\x97\xd5\x35\x1c\x04\xaf\xf3\x52\xc8\x45\xf6\x93\x58\x2b\x7a\x35\xc2\xe7\xaf\x7f\x52\x70\x95\xe7\xa9\x6e\x29\xb2\x98\x5e\xcd\x66\x50\x94\x22\x11\x55\x74\x0d\x4a\xfe\x4b\x20\xde\xaa\x2a\x45\xb8\x94\xd9\x02\x70\x14\x29\x54\x30\x1e\xd9\x46\x32\xab\xc6\xa3\xd9\x0c\xb1\xfd\xa5\x88\xc3\x4a\x40\x9a\x47\x37\x8a\x30\x57\x02\xf1\x13\x31\x94\xf9\x3d\x76\xaa\xdb\xe8\x81\x71\xb0\xb0\xac\x68\xde\x48\x79\xec\x13\xe5\xe9\x6a\x89\x14\x0c\x2b\x82\x91\xca\xa5\xac\x20\xcc\x62\xfa\x95\x27\x89\x12\x7a\xc0\xb0\x14\x10\x16\x45\x2a\x45\x0c\x55\xee\xc3\xfd\xb5\xc0\x6e\x82\x90\x5c\x23\xb8\x15\x2e\x22\x52\x51\x84\x0b\x51\x1e\xa5\x79\x18\xcb\x6c\x81\xc8\xdb\x41\x55\x55\xca\x6c\x41\xf0\xc4\xe7\xa2\x54\x04\x15\xff\x12\x4a\x21\x52\x1a\x1b\xc4\x2c\xac\xcc\x88\x22\x8b\x69\x48\x67\x8a\x32\xcf\x82\xf1\x08\xfb\x29\xb8\xb8\x3c\x54\xb7\x69\xf0\x91\x5e\x9c\x7d\x2e\x4a\x82\xce\x6b\x8a\x20\xea\x59\x3a\x70\x55\x14\x66\x99\x88\x41\x66\x0c\x58\x64\x88\xa2\x50\xc4\xb8\x04\xc2\xf4\x69\x34\x4f\x40\x56\x38\x57\xb1\x2c\xaa\x35\x4c\x95\x10\xb0\xd9\x40\x11\xaa\x28\x4c\xe1\x20\x78\x17\x2e\x05\x6c\xb7\x1a\x99\xe0\x24\x4d\x3d\x5c\x06\x8d\xcb\xc5\xa5\x99\x3d\x32\x40\x19\x66\x0b\x01\x07\x02\xe6\xc7\x70\x10\xbc\xcf\xd3\xf5\x59\xbc\x10\x0e\xbf\x6c\x36\x70\x20\x82\x8f\x55\xb9\x8a\xaa\xd7\x08\x01\xb6\xdb\x2e\x03\x39\x7f\x3e\xc8\xaa\xc4\xa3\x08\xb2\xb8\x59\xe8\x21\x3f\x46\x79\x21\x82\xf7\x61\x74\x13\x2e\x84\x79\xcb\xbc\x8f\x2d\xec\x9c\x74\xc3\xbf\xf2\x1b\x6e\x58\x8a\x48\xc8\x3b\xdd\xd2\xfe\x6d\xbb\x23\x36\xc9\x2a\x8b\x60\xda\x68\xbb\xdd\xc2\xa1\x3b\xca\x76\xeb\x81\xba\x4d\x4f\xd2\x74\x1a\x55\x9f\x21\xca\xb3\x4a\x7c\xae\x82\x57\xfa\x5f\x0f\xa6\x17\x97\xd4\xde\x90\xd5\x07\x51\x96\x79\xe9\xc1\x66\x3c\xc2\x0e\xc7\xd0\x02\x1f\xa0\xa0\xfd\x5c\x88\x32\x44\x6e\x43\xa0\x3e\x4c\x5c\x08\x13\x1f\x26\xff\x20\x7a\x78\xe3\x91\x4c\x10\x1e\xcc\xbb\x60\xa2\x6b\x11\xdd\x20\x2f\xa9\xa9\xf7\x82\x1a\xfd\x70\x0c\x99\x4c\x71\xe0\x51\x29\xaa\x55\x99\xe1\x4f\xc2\x67\x3c\xda\x92\xa4\xc1\x6f\x3e\x24\x08\x4c\xaf\x6c\x1b\x24\x33\x01\x02\x90\x09\xfc\x80\xaf\x1d\xe2\x07\xff\x0c\x53\x19\xbf\x22\x8e\x9b\x26\x1e\x8d\xd3\x18\x28\x59\x56\xc1\x19\x4e\x3e\x99\x4e\xcc\x32\x6e\xb7\x73\x90\xd9\x1d\xf6\xd4\x3a\x0c\x9e\xdc\xa2\x5e\xd0\x12\x39\xf1\x21\xf1\xc6\x23\xc4\x6e\x3b\x1e\xdd\x85\x25\x4c\xc7\xa3\x51\x96\xc7\x42\xc1\x31\xb4\x28\xbb\x41\x2d\xf5\x90\x06\xb3\x2a\xac\x9f\xe6\xaf\x7f\x52\xe3\x91\xcb\x97\xa3\xd1\x6f\xaa\x10\x51\xcf\x12\x11\x72\x1f\x0b\x11\x4d\xbd\xe6\x98\x0e\xff\x8f\x50\x77\x88\xf8\xd3\xba\xd0\xc8\x6e\x36\x90\x8a\x0c\x02\xd8\x6e\x2f\x51\x04\x88\x3c\x1d\x31\x0a\xb8\xf3\x68\xd4\x1e\x93\xe5\xc9\xb0\xb4\x30\xd3\xe6\x65\xf5\x2d\x38\x8b\xfd\x68\x3b\x6e\x3e\xf1\x1e\xd6\xf0\x8d\x97\x3f\xb9\x53\x41\x36\xdb\x6c\x0c\xa2\xd2\x77\x90\xdd\x6c\x40\x26\xb0\xa8\xe0\x40\xc2\x33\x14\xef\x2f\x5f\x90\x5c\x1a\x89\x47\xce\xc1\xf6\xd3\xbc\xe3\x2e\x58\x55\xae\x04\x3d\xdb\x8e\x3b\xd3\x94\x09\x98\x86\xba\x1f\x2d\x5b\xf0\x2e\x8f\x45\xf0\x8a\x15\xe0\x31\x6b\xe1\x69\xf7\x9d\x0f\x6d\x46\x76\x28\x13\x04\x81\xc7\xa4\x74\x07\xdd\xa9\xfd\x34\xc1\xfa\x26\xdf\x55\x88\x4f\x9f\x22\x67\x4c\xfb\xa5\xcd\x83\xbf\xc0\x33\x4d\x8f\x6f\x9d\x16\xfe\x16\x01\x32\x24\xe9\x62\xd4\x51\xaa\x0a\xb3\x0a\xb6\xdb\xa1\xc6\xe7\xa7\x9d\xa6\xde\xb8\x43\x0d\x3d\x38\xe9\x9a\x1e\x61\x11\xf8\x7c\x3c\x32\xa6\x68\x7e\x0c\x5d\x64\x0d\x8c\x8f\x51\x98\xfd\x33\x4c\x57\x24\x32\xa8\x7d\xa7\x1e\x5c\x5c\xca\xac\x12\x65\x12\x46\x62\xb3\x25\x4a\xa0\x02\x40\xaa\x3f\x6d\x88\x7f\x94\x67\x89\x5c\xcc\x3b\xe3\xeb\xe7\x5b\x47\x71\x30\xcd\xe8\xa7\x0f\xf8\x0f\x4e\xea\x4e\x8f\x3b\x3f\xa6\x27\x81\xb2\xa8\x4c\x19\x75\x6c\x84\xaa\xa9\x5f\x39\xd2\x34\x09\x3d\x03\xc9\x0e\xa4\x7f\xfb\x90\x89\xfb\xa9\x33\x17\x8f\x49\x69\x94\xa4\x6e\x46\xaa\x4e\x53\xe3\x44\x29\xb9\xc8\x0c\x25\x18\x6a\x10\x04\x2e\x0c\x54\xdf\x79\x69\x74\x32\xf2\x11\x62\xaf\x3c\x38\x3e\x86\x67\x0d\x1d\x3c\xa4\x7e\x79\x94\x28\x4c\x53\x11\x93\x24\xe5\xab\x8a\x7e\xa2\xfb\x56\xaf\xc8\xc4\xa0\x6b\xc8\x8f\xff\xaa\x8b\x7a\xc8\xa3\xe7\x97\x1a\x8b\x0c\xdf\xf6\xb1\x34\xd1\xc8\x7b\x01\x59\xcd\xd4\xf4\x08\xdb\xeb\xe9\x11\x38\xfd\xa7\x77\x94\xcd\x2f\x1b\xe4\xe4\x26\xf3\x46\x1b\x6a\x82\x08\x04\xda\x75\xb4\xec\xb3\x0c\x6f\xc4\x74\x19\x16\x17\xda\x65\x71\xb9\xc8\x87\x0c\x27\x43\x26\x4f\xfa\x20\x86\x4d\x9e\xb3\xaa\xdd\x41\x2e\x44\x70\x92\xca\x50\x4d\xbd\x4b\x38\x86\x43\x6a\x7b\x21\x2f\x83\xe9\xa1\xbb\x42\x46\x13\xb7\xcc\x93\xab\x5f\x09\x32\x3d\x08\x9a\x56\xc3\xf9\xd5\xd2\xe5\x66\x55\xa9\x6b\x48\x4b\xd8\xe4\x56\x9f\xc9\xe5\x11\x43\xd5\x7e\x82\xba\x4d\x17\x65\x58\x5c\x07\xe4\x43\xa0\x10\x2a\xed\x64\xb4\xa7\x1e\x97\xf8\x97\xaf\xa5\x75\x3f\x17\x62\x80\x03\x1d\x54\x95\x8f\x3d\xc6\xa3\x5e\x1d\xea\x90\xc4\x12\x4a\x7c\xae\x50\xe9\x1e\xc0\xe4\x83\x88\x26\x0e\x86\x13\x6c\x3d\xc1\xbe\xc6\x78\x41\x25\x96\x45\x1a\x56\x7d\xbe\xe3\x8c\xfc\x79\x24\xa7\xcc\x16\x13\x63\x66\x5d\x82\xfe\x69\xea\x7d\xc3\x36\x6a\xc0\x4f\x43\x9c\xfa\xfa\xe9\x45\xc1\xe5\x55\x5d\xe2\xf7\x90\xdf\x32\x99\x3b\x91\x2e\xe5\x29\x76\x7c\x78\x76\x88\x4d\x45\x6e\x60\xc3\x5c\x34\xd1\xd3\xcd\x64\x6c\xda\x9d\x9f\x3e\xd4\xaa\x6a\xb5\x42\x8e\xc6\x25\xa0\x20\xe5\xd7\x21\xca\x55\x22\x4d\x55\x1d\x9f\x1d\x19\x9f\xbb\xca\x9d\xf0\xac\x11\xf9\xd4\x31\x20\x79\x98\xd6\xc1\x98\x40\x91\xa7\xeb\x65\x5e\x16\xd7\x32\xa2\x41\x45\xbc\x10\x50\xe4\x32\xab\x14\x54\x79\x00\x9f\x5c\x28\x18\x2e\x2d\xca\x7c\x55\x88\x18\xae\xd6\x38\x82\x2c\xa1\x5a\x17\xc2\xa7\xa8\x52\x84\xd1\x35\xfd\xc4\x28\x0a\xd7\x8f\x82\x30\xb8\x0a\xab\xe8\x1a\xa3\x2f\x84\xa5\x1f\xeb\xa1\x5c\xb0\x7a\x45\x2c\x5c\xe8\x9b\xf8\xcf\xe5\x59\x59\xc2\x52\x54\xd7\x79\x8c\xc1\xb4\x69\x67\x66\x83\xf1\xd8\x7e\x91\xc9\x10\x69\xa7\x5e\xbb\x2d\x09\xea\xde\x3c\x6d\x1d\x32\x66\xb0\x56\x47\x94\x6f\x9a\xfa\x10\x73\x3b\xeb\xa7\x76\x2d\x97\x5e\x2a\x26\xc3\x42\xde\x09\xe6\xe7\xfd\xa9\xf0\x90\x88\xb5\x23\x36\x16\xb9\x76\x70\xe1\xda\x59\x13\x87\x8c\x64\xac\x00\xa0\xc7\xd4\x5c\x5c\x1a\xb6\xdf\x6e\xbd\x86\x09\x6b\xb7\xc4\x4e\x75\xdb\x4b\x52\xe1\xd8\xc3\xf8\x1a\xbf\xf9\x90\xd5\xd6\x49\xa3\x66\x14\x4a\x16\x14\x56\x6e\x8f\x5d\xdd\xd0\x78\xd1\x41\xce\x8e\x41\x0a\xc3\x6d\x7c\xd1\x5a\x05\xb4\x6a\xa8\x33\x46\xa3\x91\xba\x97\x98\xde\xc9\x02\x56\x0e\x46\xaf\x45\xa1\x12\x30\x99\xcc\xf1\xef\x11\x92\x52\x66\xc4\x17\xfa\x45\x33\x66\x30\x4a\x00\x85\x5f\xd9\xd0\xe1\x40\x1a\xff\x53\x2b\x2d\xc2\xa1\xb2\x38\xd8\xe7\x34\x44\x2c\x92\x70\x95\x56\xf3\xf1\x68\xb7\x5f\xb3\xca\xc4\xe7\x42\xe7\x92\x48\x56\x39\xb0\x74\x57\x95\xa5\xcf\x8c\xe5\xbb\xf3\xab\x29\x24\x13\xe3\x79\x38\xaf\x2f\x9b\x24\xef\x6d\x50\x93\xbe\x6f\x89\x2d\xf0\xdf\x7c\xc8\x6f\x1c\x17\xc8\x05\xc2\x10\x51\xcf\x6e\xb7\x97\x2f\xe0\x87\xfc\x66\x78\xc4\x66\xe3\x7a\xf1\x90\x51\x1b\x0d\x6b\xd7\xb4\xfd\xc6\x90\x40\xc6\x0d\x1a\x18\x63\xc9\xab\xd9\x5e\x49\x9e\x08\xca\xc3\xfc\x18\x10\x66\x6b\x15\x2f\x5f\x90\x63\x20\x1b\xf1\xcc\x68\xc0\x22\x92\x6e\x78\x93\x87\xf1\x5f\xb5\x42\x45\x31\xf5\x6d\x7f\x5f\x7b\xc3\xd2\x87\x3f\x30\xf3\xe5\x0a\x26\x3a\x82\x42\x2e\xae\xaf\xf2\x52\xf9\xc6\xde\x4e\x9f\x36\x50\x79\x95\x4a\x91\x55\xbb\x82\x05\x4f\x7b\x47\x53\x4c\x7d\x11\xdc\x5f\xaf\x45\x29\x48\xd3\x54\x6e\x94\x74\x7e\x7a\x4e\x58\x5d\xc8\xf9\x1f\x97\x18\x2b\xda\x0e\x9c\x10\x22\x0a\x5a\xf3\xdf\xb0\xe0\x96\x81\xd9\x7c\x33\x43\xf4\xca\xbd\x99\x95\xed\xca\xab\xdf\x26\xf3\x45\x16\x9c\x9f\x22\xe7\x65\x0d\x88\x3c\x90\x61\x07\xad\x93\xfa\x91\x6a\xe1\xd4\x9b\x47\xd8\xa9\x99\xee\xf6\xe4\xe6\x3b\xf8\x61\x40\x6f\xf5\xa9\xa2\x3b\x97\x1b\xdd\x29\x35\x53\x88\xb3\x19\xd4\x89\x54\xe6\x73\xe5\x18\x8f\xbe\x54\x6d\x2b\x31\x6b\x8c\x2e\xb9\x1d\x64\xcf\x65\xc9\xf3\xc1\xcc\xeb\x78\x36\xb3\xd9\x54\x34\x91\x1d\x03\x5d\x9b\x7c\x93\x5d\xb5\xaf\x38\xc9\xab\x95\xb0\x0f\x79\x59\xa7\x6f\x15\x39\x01\x26\x95\x8f\x9e\x0c\x0e\xb4\x44\x21\x60\x17\x24\xc4\x68\x43\xa8\xa1\xbc\x35\xe1\xa2\xe1\x93\x9f\x82\xb1\x5b\x00\xaf\x31\x9b\xfd\x39\x5c\x16\xa9\x98\x8f\x67\xb3\xf1\x6c\x36\x62\x7f\x90\x25\x24\x22\x91\x08\x1a\x58\x5a\xf6\x9f\xcd\x46\xa3\x9a\x9c\x53\x4c\x53\xe3\x1f\x27\x6a\x3a\xf9\x1f\x70\x04\xcf\x31\x1b\x59\x94\xe2\x6e\xe2\xc3\xf3\x67\x1e\x77\xb0\xcc\x3f\x9b\x61\xd6\xff\xce\x0e\x45\x03\x5f\x3c\xbb\x74\xa9\x30\x9d\x60\x93\x89\xa7\x71\x43\x62\xdb\x79\x2e\x57\xaa\x82\x2c\xaf\x70\xa1\x52\x19\x8b\x9a\xda\x66\xe5\x78\xa1\x5c\xdc\xa1\x0a\xaf\x52\x11\xec\x9b\xbc\x75\x26\x87\x9c\xa1\x20\x08\x82\x56\x36\xbe\xd7\x59\x6a\x6b\x0e\xc1\xe9\x0f\x56\xac\xbd\xaf\x7d\xa0\x7f\x50\x51\x58\xdf\xbc\xd5\x70\xac\x39\xb8\xce\xde\xea\x3f\x39\xed\x5f\x39\xc4\xe1\xa9\xf7\xb0\x73\x9c\x23\xd1\x08\x4c\x9b\x6e\x44\x1b\xd3\x90\xd8\xcf\xbe\x72\xe0\x92\x83\x9b\x57\xd7\xa2\xdc\x9b\x8c\x6e\xba\xb9\x56\xc9\x1c\x10\xf6\xe7\x01\xba\x01\x22\x47\x86\x66\x1e\xf3\xae\xbf\x84\x99\x53\xcf\xe6\xaa\xa3\x5a\x01\xb5\xf3\x57\x9c\x58\x42\x24\x4c\xea\xe9\x22\xba\xb4\x99\xc4\xed\xd8\x8d\xc5\x3b\xa9\xd0\xdd\xf0\xdd\x2e\x38\x46\xdf\x20\xed\x68\x8c\xa1\xee\x95\x6e\x90\x89\x59\x25\x37\xcb\xb0\x4f\x3e\x87\xd6\x11\xd3\xe8\x79\xd2\x65\x0e\xc3\x11\x4a\x4f\x3d\xcc\x68\x95\xcd\xcb\x3c\x69\xe8\xaa\x89\x0f\x76\x6c\x93\xfc\xe9\x41\xca\xa1\xa9\xb3\x90\x8f\xda\x3e\x79\x95\xaf\xb2\x6a\x60\x03\x45\x66\xd5\xf7\xd9\x34\xa1\x41\x30\x8b\x45\x19\x0d\x98\xef\xc8\xeb\xf3\x5c\x6c\xbe\x84\xba\xef\x9d\x2f\x79\xdc\xfc\xcf\x3e\x4b\x35\x34\x7f\x64\x79\x97\x00\x99\xd5\xa6\x6d\x0c\x5c\x42\x7a\xe3\x1e\xfb\xce\x53\x4a\xc2\x54\x89\xe1\xfd\x18\x12\x65\x10\x88\x92\xc8\x22\x31\x87\x27\xa8\xdd\x45\x59\x7a\x8d\x35\xc6\x1c\x9e\xff\xc8\xa5\x76\x08\x0c\x87\xcd\x4c\x14\x6e\xa7\xc0\xc6\x59\x9c\xa7\xdd\xf7\xc8\xfe\xb8\x02\x73\xe7\x25\xfe\x36\xef\x46\x9f\x50\xbd\xcd\x3b\xc2\x4a\x8f\x69\x87\x84\xd5\xc2\x7c\x48\x5f\x50\xa3\xf3\x53\x77\x00\x8a\x16\xed\x08\x23\xf4\x79\xe7\xda\x48\x6b\x93\x79\x7e\x4a\x49\x12\x9d\x23\x67\x76\x23\x30\x23\x0d\xb3\x3b\x96\xe9\xe6\x26\xe0\xb1\x03\xfd\x97\xfe\xf3\xba\xcc\x97\x5d\xe7\x54\xdd\xa6\xf8\xf2\x97\x4c\xde\xae\xc4\x9c\xa4\xce\x37\x79\x37\xf6\x1a\x7a\xb8\x42\xbf\xd1\x0e\x78\x7b\x4f\xa1\x9b\x89\x37\x51\x8b\xd9\xe9\xf5\x71\x91\x9d\xae\xff\xe9\xb9\x37\xd4\x8f\x2d\xdf\xbe\x5b\x11\x2d\x0a\x98\x88\x57\xd6\xda\x91\xe7\x64\xbc\x4a\xfd\xf3\x42\x5e\xa2\xd7\xb8\x07\x44\xf6\x2a\x1f\x8b\xab\x1d\xc6\x8d\x7e\x6c\x4e\xfe\xb5\xad\x47\xe8\xa1\xb4\x79\x67\x1a\xbf\x37\x45\x03\x7f\x5d\xf7\xe8\x2c\x5b\x52\x40\xa2\x5a\xf4\x2e\x5e\x51\x8a\x58\x46\x61\x25\x78\x01\x8b\xce\xe2\xbd\x37\x2d\xcc\x3e\x81\xf6\x62\xf3\x12\x1c\x2f\x86\x55\x47\x87\xc2\x05\x53\x77\x54\xa8\x0b\x79\x69\xbb\xb6\x66\x8e\x56\x9c\x4a\x2a\x7a\x10\xa4\x5a\x8b\x17\xfc\xde\x51\x35\x9a\x00\x6f\xe8\xf1\x31\x1c\xd2\x7b\x03\x4c\x57\x64\xf4\x4d\x57\xbf\x79\x61\x5a\x74\xe0\xfd\xac\x9f\x1f\xc3\xa1\xa9\xea\xd8\x3e\x40\xbc\xbc\x8c\x45\x39\x44\xb7\x9f\xf1\xe5\x9f\x47\x33\xd6\x92\x34\xd6\xe3\x6c\x01\xbb\xde\x4d\x54\x70\x48\xd3\x4e\x27\xf1\x83\x53\x9d\xe3\x9e\xf6\xdb\x21\xfb\x1a\x77\x99\xaa\xe7\x88\x3e\xf7\xd7\xda\x70\xda\x16\x20\x7a\xea\x8d\x47\x96\x14\x4e\x0f\x8d\xc5\xb4\x7a\x6e\xa4\xa4\xd3\x9b\x9f\xa3\x67\x4b\xff\x47\x05\x36\xad\x50\x57\xf4\xa4\xca\xd5\x6d\xea\x2e\xad\x1d\xb1\xbb\x82\xea\x36\x75\x1a\x30\x35\x2c\xc9\xf7\xc5\xc6\x2d\x6f\x28\xea\x85\x1c\x96\x35\xa4\xf6\xa8\x70\x97\x76\x2f\x00\xc4\x6f\xbd\x7d\xbf\x92\xe9\x31\x83\x8a\x82\x83\x79\xe6\x65\x98\xc5\x21\x95\x85\xa1\x0c\x73\xdb\x28\x0d\x57\x4a\x04\xf0\x2b\x46\x8f\x61\x59\xe9\x3e\xe8\x0c\x01\xa7\xca\x74\xd8\xaa\xf3\xd6\xf9\x9d\x28\x4b\x0c\xa3\x64\x05\x57\x22\xcd\xef\x41\x26\x90\x09\x11\x63\x59\x9b\x43\x66\x2d\x65\x53\x96\x31\x4f\x4b\xf1\x74\x19\x56\xd7\xc1\xdb\xf0\xf3\x79\x56\xfd\xe7\x1f\xbd\xaf\x56\x0c\x76\x14\x0d\x55\x6b\x86\x86\x67\x61\x5a\x70\x28\x74\x7e\xaa\x48\x24\x38\x75\xae\x20\xe4\x40\x9d\x6a\xdd\xc2\x8a\x7f\x61\x84\x24\x30\xf7\xd4\x1b\x13\xda\x80\x9c\xc2\xe9\x3a\xfd\x4e\x0e\x09\x06\xad\x70\x5e\x35\xeb\xbf\x96\x57\x22\xc6\xda\x2f\x27\xce\x0e\x69\xec\xd5\xd5\x11\x87\xdd\x19\x38\x2c\x93\x27\xa0\x7d\x69\x33\x94\x6f\xf7\x52\x79\xdb\x09\x47\x31\x38\x52\xcd\xd6\x52\x2c\xf3\x72\x1d\x00\x4e\xcf\x6e\x5e\xdc\x8b\x52\x40\x54\x8a\xb0\x62\x2c\xcb\xf0\x4e\x94\x0a\x31\x09\x33\x9b\x0b\x37\x8e\x3b\x23\x56\x0a\x8c\xf8\x40\xad\x8a\x22\x2f\x2b\x5c\xce\x3d\xf5\x8d\x21\x6e\x9f\xbe\xb1\x54\xee\x59\xdd\x5a\x4f\xf5\x4a\x78\x11\x56\xd7\xbd\x8b\x7e\x12\xc7\xe4\x72\x4e\x87\x9c\x4f\xbb\xda\x71\x2e\x94\x3b\x29\x43\x88\x30\x35\x25\x85\x13\xcf\xb3\x81\x9c\x4c\xe0\x20\xf8\x7b\xa8\xde\xe7\xa9\x8c\xd6\x18\x47\x7f\x9f\x41\x2d\xdf\xe0\x5a\x42\x51\xca\xbb\x30\x5a\xe3\x66\x92\x8c\xd6\x34\xbe\x1b\xdf\xb5\xf8\xb7\xab\xae\xa6\x7b\x78\x2d\x9e\xc7\x7c\x4f\xf1\xc6\xa9\x54\x95\xcc\xa2\xca\x32\x3f\x32\x50\xb6\x5a\x5e\x09\xd4\x01\x10\x9b\xd7\x9c\x9c\x62\xd6\xd7\x89\x2e\x76\x9f\x64\x36\x2c\x0e\x9a\x25\xb9\x9e\xb0\x57\x34\xe0\xed\x2a\xad\x64\x91\x5a\x6f\x2c\x42\xb4\xa8\x81\x1d\xbc\x5a\x15\xa9\x1d\xdc\x66\xca\x7c\x53\x81\xb9\xb6\x39\x33\xc3\x9e\x90\x67\xe9\x1a\x45\xf0\xed\xfa\xe3\x3f\xde\x50\xbb\xf7\xb9\xaa\x16\xa5\xf8\xf8\x8f\x37\x01\xbc\xcb\x2b\x2c\x79\x0c\x2b\x78\xf7\xcb\x9b\x37\x66\x6e\x86\xc9\x09\x01\x64\x71\xce\x65\xed\x9f\xc7\xd2\x49\x5c\xb4\x08\xfa\x77\x83\xc2\x75\x50\xd7\x5a\x20\x93\x23\xd0\xd4\xa4\x6d\x8b\xa9\xcc\x62\xf1\x19\x02\x78\xe6\xb9\x4b\x87\x7b\x15\xa9\x12\x5c\xf8\xd4\x5a\x57\xbb\x91\x41\x89\xae\x3d\xc5\xb3\x83\x61\x3b\x3e\x34\xee\x2a\x66\xad\xb4\xc3\xde\x8d\x98\x39\x0e\xec\x91\xe2\xa2\x14\x45\x58\x0a\xd4\x3f\x6b\x9c\xff\xe0\x2e\xff\x33\xde\x64\xde\x7e\x73\xfc\x6d\x26\x33\xf1\xdc\x48\xd6\x06\x5b\x9d\x09\x0f\xc7\xd9\x0f\x04\xef\x86\x2a\xb8\xd4\x0f\xc4\xc1\xcf\x1e\x88\x81\x11\x0f\x2b\x5e\x43\x21\xb0\x0d\x7f\xdb\xe2\xfa\x3f\xd1\x96\xa4\xf2\x46\x34\x1f\xfb\x70\xb5\xaa\xa0\x08\x33\x19\x29\xb4\xbd\xa8\xd0\x51\x1b\x42\x1e\x45\xab\x52\xed\xad\xb5\x9b\x63\xed\xcb\x17\x32\xab\x1e\xce\x1f\x34\xc0\x22\xd4\x5d\x74\xa4\x99\x4c\x3b\x64\x61\x8a\x9c\xa4\xe9\xdb\xb0\x50\x20\x3e\x8b\x68\x85\x26\xd2\xb1\xa4\x59\xdc\xd0\x68\x46\xf5\xb8\x2c\x43\x15\xe0\x10\x2a\x58\x88\x4c\x94\x32\x82\x65\x58\x38\xd5\xcf\x37\x62\x4d\x06\x92\xd3\x9c\xab\x65\x06\x19\x76\xac\x33\xee\x5d\x87\xd0\xa3\x5c\xbe\xab\x50\x4c\x1a\x7f\xa5\x8c\xa9\xc7\x27\xb4\xb9\x6f\xb5\x29\x29\xcb\x35\xa9\xb3\xf1\x6c\xd6\xdc\xc5\x0f\x15\xeb\x3c\xcd\x95\x06\xf4\x54\x04\x8b\x00\x33\xfb\xff\xed\xbf\xf8\x90\xa4\x79\x48\x7f\xe8\x4c\x8e\x89\xab\x2f\x2e\xaf\xd6\x95\x40\xa8\x50\xc9\xa5\x08\x3e\xc9\x25\xef\x08\x84\x8a\xf8\x0a\x4b\xc0\xf3\xd2\xd5\x81\x01\xb0\x16\x22\x9d\x14\xad\x54\x95\x2f\xe1\x6f\x39\xa3\xab\x07\xfd\xe5\x97\xf3\x53\x6f\x00\xc9\xbf\xe5\x16\x90\x75\x77\x92\x95\x1d\x49\x66\x18\xad\x54\x48\x09\xa2\xbd\xf1\x5f\x70\xdd\x70\x88\xb8\x36\x87\x66\x82\x10\xda\xe5\xd1\xa9\xe5\x3b\x29\xee\x45\xe9\x05\x70\x66\x77\xf8\x45\x4c\x6e\x8b\x32\x66\x00\x95\x38\xba\x44\x8f\x70\x53\x98\x95\xfa\x38\x9d\x4a\xac\x9d\x0c\xb1\x53\x7a\xf5\x9d\x95\x60\xa3\xd4\xe9\x3b\xd4\x6e\x9b\x3a\x02\xa2\x35\x96\x08\x0f\x4c\x63\xb3\x77\x29\xb2\xf7\x5d\xcb\x2c\x79\xda\xd3\x66\x99\xe5\xd6\xeb\x2f\x8d\xfc\xc6\xaa\x45\x2e\x56\x43\xb2\x63\xbc\x6e\xe0\x0e\x66\xbc\x97\x52\x91\xd2\x70\x9c\x21\x44\x8b\xf9\x7b\x0e\x4f\x62\x04\xf5\x24\x9e\xf8\x2e\x78\xbf\x01\xdc\xe4\xb4\xcb\xfc\xbe\x6f\xaf\xc1\x41\xb8\xdb\x8f\x4b\x07\x9d\x1d\x02\x7e\xcb\x25\xa0\xb5\x69\x33\xc4\x62\x1c\x4c\x62\xa9\xab\x4d\x7b\xe7\x49\x93\x62\xfd\xf6\xe4\x96\x0d\x51\x64\x6c\x11\x6f\xd0\x96\xf9\xbd\xde\x77\xb8\xab\x67\xe4\x64\xb9\xf0\x97\x8f\xea\xd4\x6b\x30\xb3\x09\xe1\xda\x36\xf8\x4f\x28\x0c\xe4\x67\x1a\x91\xda\x66\xb2\x58\xd7\xd6\x92\x1f\x7c\x2f\x3b\x69\xe0\xf7\xeb\x8d\x21\x79\xc3\x59\x68\x4c\x99\x32\x6d\x02\x30\xd8\xc1\x34\x7b\xbf\x59\x44\x90\x3c\xef\xf7\xf6\xb8\x94\xa8\xda\x2e\x7d\xaf\x9f\x5e\x9b\x3d\xea\xa7\xeb\xcd\x68\x97\x8e\x8a\xce\xd0\x95\xfe\x48\x27\xae\x7c\x24\xab\x39\xf9\x74\xb5\x4a\x12\x51\xda\x33\x59\xb2\x52\x10\x5d\xa3\xbd\x4b\x03\x0c\x77\xaf\xf0\x38\x5a\x7b\xf8\xee\x88\xd7\x22\xc5\xe1\x10\xb0\x0e\x58\x4d\x80\xa0\xcf\x78\x69\x93\x6a\xb2\x0d\x52\xc1\xf3\x67\xcf\xf6\x5e\x20\x43\x88\x69\x86\xc6\x72\xaf\x7d\x56\x7b\x8a\x8c\x8a\x1c\x98\xb6\xad\x46\x4c\xe6\xd7\x0f\x9d\x2f\x6b\xd0\x19\xd7\x06\x56\x59\x25\x53\xb6\xf8\xb6\xee\xae\x2a\xc3\x4c\x85\x54\x15\xe0\xd7\x5e\x02\x12\xe3\xf7\x8f\x67\x6f\xce\x5e\x7d\x42\x0f\x0b\x5e\xff\xfc\x01\x7e\x79\x7f\x7a\xf2\xe9\xec\x77\x9b\x93\xf9\x84\xd1\x46\x92\x97\xc2\x77\x1c\x1f\x75\x9d\xaf\xd2\x18\xae\x84\xf1\x8a\x90\xb4\x10\xba\xc3\x60\x6c\x02\x1f\xff\xf1\x46\x56\xa2\x1b\x8f\xa2\xaa\xa2\xc9\x90\x3b\xe2\xcc\xeb\xfe\x3a\x4f\x05\xc4\x61\x15\x5e\x61\xe5\x55\x9e\xc1\x7d\x89\x10\x64\xa6\x2a\x11\xee\x6f\x69\x2d\xcd\xfa\x4b\x04\x07\x53\xde\x76\xd3\xf3\xc1\x15\x79\x5f\xca\x65\xa8\x53\x58\x51\xc3\x21\x9c\x1a\x9e\xe5\xd8\xde\xf0\xab\xe8\x78\x11\x1e\x56\x6b\xb8\xf4\x63\x6e\x2c\x34\x68\x24\x9e\xa5\x82\xad\x93\xd0\x25\x0e\xc6\x49\x2b\x73\x72\x47\x4b\x11\xc6\x58\xfc\x09\xa5\x28\x52\x19\x85\x5c\xad\x81\x69\x90\x0f\xfa\x89\xe7\xf8\x49\x3a\x2d\x54\x0a\x9b\xca\x21\xfa\xb2\x9c\x50\xd2\xe6\x8f\x95\xc2\xe8\x74\xb9\x94\x55\x25\x62\xbd\x40\x3a\x77\x17\x82\xba\xce\xcb\xea\x1a\x9f\x20\x94\x0f\x22\x8c\x31\x34\xd4\x3b\x6c\x6b\xaa\xaa\xc0\x67\x4c\x1e\xaa\xa2\x70\xa2\x60\xed\x3d\x31\x43\x5a\xaf\xce\x4a\x2a\x0a\x69\x98\xaa\x9c\x69\x17\x43\x52\xe6\x4b\x97\x26\x96\x20\x8f\x90\x4b\x42\xa4\x9f\x07\xfa\x57\x38\xd8\x35\x29\x66\x81\x56\xb3\x5a\x05\x22\x69\x21\x72\xde\xa4\xe2\x4e\xa4\xdd\x52\x1c\x7e\x2e\x15\x14\xa1\x52\xf5\xb1\x4a\x5e\x5c\xad\xa9\xb0\x0b\x2b\x7c\x03\x41\x55\x61\x25\x96\x22\xab\x54\x33\x1b\xaa\x47\x6f\x0c\x66\x7a\x5a\x7e\xc0\x3a\xda\x16\xe2\x18\xc6\xd7\x67\x29\x49\x46\x79\xc2\x67\x77\x22\xab\x56\x61\x1a\xc0\x29\xa1\xc4\x3c\xa2\xab\x32\x34\xf3\xf5\xf0\x9e\x5c\x64\x79\x89\xa9\xd9\xbd\x17\xa9\x85\xd0\x34\xb2\x18\xb8\x68\xf6\xad\x60\x7b\xe9\x5c\xaa\x1f\x43\xb4\x43\x88\xb5\xa5\xe9\x0b\xeb\xea\x22\x68\x4d\x62\x65\x4b\xaf\x7a\x03\xbc\xda\xd6\xe4\x0d\xd6\x46\xca\xb2\xa1\x32\x27\xa3\x75\x6a\xdd\x66\x98\x64\xac\x30\xc2\xb0\xf6\x4f\x2a\x6b\x18\xb5\x8e\xbe\x11\x6b\xcc\xa5\x17\xe1\x42\x66\xe4\x8c\xc3\x54\xc6\xf0\x17\x48\x43\x55\x79\x94\x7e\xc2\x41\xc2\xa4\xe2\xd3\xda\x58\x82\x24\xf3\x95\x82\x3c\x13\x70\x1f\x2a\x62\xc4\xd5\xd2\x88\x31\xa2\x60\x31\x52\x10\xa5\x39\x32\x1e\xa9\x97\x30\x4d\x6b\xa3\x49\x7a\x00\x0f\x92\x53\x1c\x87\xef\xdb\xcc\x28\x15\x44\x61\x16\x89\x54\xc4\x01\x9c\x54\xb0\xcc\x55\x45\x83\x6a\x8f\x18\x4f\x7b\xe3\x39\x74\xa6\x88\x7e\x68\x46\xbe\x22\x73\xc2\x1c\xa7\x71\x08\x3a\x15\x5d\xd1\xae\x54\x98\x93\x05\xb3\xe6\xf7\xbf\x3e\x7b\xe6\x61\x11\xba\x08\x97\xb6\x70\x0b\x15\x55\x4f\x85\xdf\x6c\x46\x7b\x0c\x41\x80\x43\x8f\xb6\xf8\x9f\xda\x87\x7c\x79\x24\xca\x32\x6a\xb9\x84\xdd\x1e\x48\x94\x57\x61\x9a\x5a\xd9\x50\x55\x5e\x18\xdd\x6a\xa6\xd9\x4b\x73\xdf\x58\x50\x4d\xc4\x06\x69\x49\x9a\x52\x81\xe6\x8f\x4d\xb4\xf1\x50\x4c\x82\x1d\xc3\x33\xc3\x4a\x76\x0f\xc5\xe4\x15\x6d\x76\x52\x2f\x79\xc8\x5b\x17\xfa\xbc\xb8\xe5\x51\x6d\x67\x19\xf0\xbe\x92\x5a\x53\xd6\x20\x5b\x7b\xa1\xd3\x97\x47\x38\x4b\x68\x94\x9b\xfb\xc0\x4f\xeb\x00\x96\xbc\xb8\xfe\xf0\x95\x58\x9f\x42\x5c\x6a\xf4\xd2\x94\x55\xd1\xaf\x63\x74\xc8\xc8\x0f\x6d\xf1\x08\x85\x3b\x7d\x43\x63\x37\xcf\x77\xde\x13\x12\x3e\xe0\x6e\xde\x22\x37\xf1\x22\x0e\x10\x0b\xf4\x2f\x89\x13\x31\x0b\x14\x79\xad\x67\x34\x22\x3e\xac\x39\xa4\x8d\xbe\xb2\xa4\xd1\x03\x0f\x1f\x79\xc1\x01\xe0\xe5\x11\x97\xa6\xe2\x2e\xab\x53\xa5\xe3\xcc\x8d\xb5\x94\x06\xcc\x6a\x41\x0d\xa7\xc0\x1d\xa5\xc5\xfa\x45\xa7\xcf\x91\x47\x35\x42\x0d\x4d\xb6\x34\x8c\x40\x8d\x0c\x83\xee\xad\xb3\xd5\x20\x27\xe8\xe9\xa3\x1b\x6c\x8e\x20\x20\xec\x97\x47\xcd\xd5\x69\xd6\xd6\xb5\x89\xc9\x1c\xcd\x54\xfb\xf2\xa5\xb7\xf8\x8e\xf8\xbf\xde\x0d\xef\x89\x39\xdd\x44\xa8\x66\xdd\xae\x23\x7a\xfb\x80\x48\x4d\xbc\xc6\x79\x6d\xd4\xb9\x70\xe8\xd6\xca\xa0\x8b\x3e\x1a\xa5\x22\xc1\xad\xfc\xa3\xe7\xe3\x51\xff\x2e\x52\x67\xef\x90\x7b\x1c\xf6\x36\xb4\x9b\xb4\xd4\xea\x07\x23\x04\xa4\xc2\x90\xb4\x26\xd7\x90\x54\x34\x77\x3a\x75\x9b\x54\xf0\x12\x32\x82\x3d\xc2\x94\x05\xbe\xe5\x10\x1a\x13\x98\xa0\xae\xc3\x14\xf7\x49\xa3\xbc\x58\xc3\x8d\x10\x94\x80\x14\x8e\x57\x8a\xb6\x46\xd7\x8c\xaf\x74\xee\xdb\xf0\x10\x6f\x2c\x8e\x46\xf4\x07\xcc\xbb\x58\x9b\x77\xee\xbe\x73\xaf\x78\xf3\xcb\x8b\x79\xdf\x6a\xd6\xef\xbd\x5d\xef\xf9\x74\x26\x2d\x87\x43\xd4\x3e\x2c\x38\x71\xd0\x7e\xd3\xdd\x1f\x39\x3f\xfd\xdb\xa7\xe9\x21\x82\xb4\xd9\x14\xdd\x29\xe7\xf2\x8a\x8b\x4b\x2a\xb4\x78\xbd\xca\xa2\xcd\x89\x8a\xf6\xda\x01\xab\xa1\xa4\x5c\x3f\xf2\x14\xeb\xd9\x49\x4a\x6d\x50\xae\x1b\x38\x65\xf6\xac\x63\xdc\x99\x31\x6f\x5b\x8d\x61\xf6\xf0\xcd\x09\x56\x5d\x07\x40\x70\x75\x07\x1d\x1d\x3a\x47\x5b\xb0\xa5\x42\xad\x83\x7f\xcc\xed\xe3\x97\x47\x51\xf5\x39\x38\xcd\x33\x31\xf5\x1a\x87\x51\xf0\xf1\x59\x59\x4e\xdd\x6a\x10\x93\xe2\xa2\x71\xbc\x9a\xe1\xb8\x0b\xa6\x43\x9c\x76\xcc\x9e\x84\x02\xb2\x23\x1c\x1d\x3b\xbd\xb9\x25\x12\x1c\x8e\xe1\x29\x3d\xbc\xa8\x5f\x1f\x3d\xbf\x0c\xce\x4f\xdd\xac\x03\x27\x5b\x76\x9c\x8e\x64\x3f\x49\x4c\xe0\x80\xaf\xd1\xe0\x3d\x4d\x7d\xd1\x8c\x69\xa4\xcb\x0a\x64\xd6\x70\xfa\x28\xff\xab\x79\x1f\xc9\x4b\xad\x70\x87\xda\x94\xdb\xc7\x0b\xb1\xcf\x3d\x34\xd8\xaf\xbe\x85\xe6\x80\xc4\x16\x91\x01\xc2\x00\x45\x0a\x97\x00\xee\xb9\xd2\xc1\x41\x00\xc3\x1d\x1e\x81\xf6\x82\xcd\xa9\x46\x7d\xe9\x07\x1e\x27\x68\x80\xc1\x68\x0a\xc1\x60\xe1\x03\x2a\x73\xc4\x7f\x51\x22\x61\x10\x24\xa2\x41\x07\x02\x1d\x78\x32\x46\x97\xcc\x81\x79\x4e\x0f\x8e\x6c\x03\x2b\x70\x4e\x9b\x0f\xb5\x10\x8e\x47\xaa\x12\x45\x23\xc7\xf6\x4e\xdc\x7f\xac\x44\x81\xe9\x5f\xfb\x8c\x6a\x66\x50\x3e\x32\x57\x40\xa8\x2e\xc7\x87\xce\x73\xfd\xa0\x29\x39\xfe\x03\xfb\xf4\x9e\xef\x8e\xf5\x29\x27\x49\x14\xa4\x8e\x07\x86\xeb\xbe\x74\x9e\xb6\x44\xb6\x01\x1c\x49\x3e\xb5\xbf\x74\xa7\x0f\x22\x35\xaa\xdf\x40\x3f\x57\xe7\x19\x86\x47\xf5\xb3\xce\x04\x85\x2e\x56\x72\xa7\x68\xee\x7f\x90\x09\xc2\x78\xfb\xe3\x5b\x38\xe2\x4b\x2a\x06\x20\xbc\xff\xc9\xe9\x8e\x6e\xab\xb9\x40\x02\xb7\x6a\x77\xf4\xd5\x79\x73\xa7\xbf\xed\x9c\xc5\xdc\xd7\xf3\xdd\xe3\xca\x78\x96\xf3\x3a\x2c\x45\x6c\xb7\x8b\xc7\x23\x87\x32\xfa\x1d\x67\xe3\xa7\xf5\xf1\xb8\xc4\xb9\x4e\xa3\x8b\x47\xd2\x59\x63\xde\x49\x36\x43\x9b\x02\x04\xcf\x9c\xb7\x45\xf6\xa4\x63\x9a\xf5\xc8\xa2\x7a\x67\x4e\x11\xed\x2c\x19\xc3\x4d\x2a\x51\x78\x03\x7a\x00\xe5\x6d\xb7\x1e\x30\xc5\x2a\xb5\x8c\x5a\x95\x80\x82\xbc\x8f\x4a\xc0\x4e\xff\x4f\xaa\x04\x6a\x26\xe3\x3e\x7f\xf8\xfc\xf4\xdf\x50\x5b\xc8\xf8\x3f\xb4\xc2\xff\xd7\x5a\xe1\x1b\x55\xc2\x03\xb2\xdb\xbc\x6f\xe1\x41\x39\x7c\x58\x62\xdc\x06\x64\x84\x27\x66\x67\x09\xc1\xd6\xf5\x39\xa6\x03\xd7\xda\x50\x6b\x4b\x3d\x43\x0a\x99\xb0\x82\x98\x1f\x0f\x5d\xe0\xd0\xb9\x9c\xe8\x05\x77\x71\x1c\x4b\x2c\x17\xe4\x9b\xd9\x30\xd9\xd3\xc9\x7b\x71\x46\x89\xbd\xe6\x46\x48\xa0\x7b\x63\xce\xa1\x14\xaa\xca\x4b\xac\x61\xd0\xf9\x0e\x9d\x4f\xc3\x80\x82\x36\x76\x30\x27\xa4\x3b\x2e\x91\x39\x11\x9c\xaa\xfd\xde\x1a\xfa\xb8\xcd\xf8\x38\xcf\xd1\x28\xb9\xa9\xcf\x4f\x5d\x5c\xf2\x6a\xd2\xa9\x43\x5b\xd1\x8f\xca\x53\x6f\x66\x8e\x64\xdc\x3c\x6d\xd5\x0a\xd6\x9a\x67\xe1\x3b\xbd\x7b\xbd\x6a\xe7\x44\x28\x82\xbf\xc0\xdf\xe6\x70\x6a\x1e\xd3\x19\x71\x42\xd2\x06\x1b\xc9\x0d\x5e\x0e\xa2\x5b\xd5\x5b\x9b\x26\x88\x1c\x8d\xd0\x6f\x43\x3c\x2f\x2e\x9b\x0a\x87\x71\xb4\x6d\x1a\x67\xde\x7b\x9b\x5e\xb6\x0f\xf7\x63\x5f\xcf\x5e\xb1\xd4\x3c\x7c\x82\x4c\xda\x38\x80\x32\x1a\xe1\x23\xf7\x84\x08\xfe\xae\xdf\x8e\x58\x7f\xcd\xfb\x14\x1a\xf5\x1f\x3a\xa6\xf2\x80\x6e\x7b\xe0\xe4\x4a\x8f\x3e\xd3\x5d\xb8\x27\xbe\xcf\x57\x5a\x74\x30\x41\xfc\x6e\x95\xa6\xe7\x58\x99\xc2\xf2\x83\x2a\x13\x89\xf3\x8b\x12\xe5\x29\xc9\x73\xcc\x22\x84\xbd\x50\x5a\xcf\x4f\xa9\x13\x53\xcf\x11\x27\x86\x2e\xb3\x07\x81\xd7\xf4\xef\x0e\x21\x31\xea\x76\x5a\x0c\x8e\x53\x97\x2c\xcc\x6d\xc5\xc2\x8f\xee\xae\x6d\xf3\xfc\x72\xeb\xdd\x53\x33\x9d\xed\x16\x6f\xff\x79\xca\x43\xe3\xaf\xad\x4b\x2b\x7d\x17\x12\x8f\x90\xaf\x2a\x1f\x45\x7b\xa0\x70\x01\xd9\x8d\x9a\xe8\xc3\xfb\xf9\xaa\x0a\xa6\x87\xf5\x38\xf5\xc9\x6f\x3c\xb3\xff\xe5\x0b\x08\x1c\xbf\x71\x69\x40\x6f\xf2\xc5\xb9\xb6\x40\xc6\xba\x9c\x81\x76\x9e\x90\xfd\x8f\xf2\x55\x35\x61\xc0\x5b\x46\x41\x66\x06\x03\x99\x31\x02\x32\xeb\x1d\x5f\x66\xdf\x3a\xbc\xcc\x5a\xa3\xe7\x2b\x7d\x86\x97\x3d\x99\xd6\x8d\x3d\x27\xe5\x62\x02\x13\x9c\xf7\x04\x26\xe4\x10\x4f\x88\x9b\x60\x62\x96\x79\x62\x57\x65\xff\xdb\x7b\x66\xcb\x1f\x97\x21\xad\xd3\xa4\xad\xde\x11\x27\x99\xed\xc6\x48\x66\x0e\x42\x96\xf9\x1a\x68\x11\x0d\xbf\x1f\x56\xa8\xfd\xec\x3a\xc5\xea\xc2\x10\xee\xb2\xb1\x4a\xfb\xad\x0b\xc2\x02\x49\x1b\xc7\xc8\x14\x8a\x8b\x42\x0c\xc8\xe6\x0a\xb9\xf7\x4b\x50\xeb\x0b\x26\x50\xe3\x32\x89\x5a\xbb\x5a\x75\xcc\x0f\x50\x02\x7a\xc0\x36\x41\x35\x7b\xd5\xcf\xeb\xcb\xd5\x7a\x2e\x21\xf0\xad\x8e\x7f\xf4\x3d\x10\xc9\xcd\x8e\x7b\x20\x86\x8e\x46\xf5\x9e\xef\x19\x8d\x14\x6f\x8a\xe0\xcb\x73\x56\x33\xd3\x3d\xf4\xec\x85\xd5\x70\xae\x92\x7f\x5e\xd7\x01\x3f\xb3\x6c\x70\xe9\x43\x72\xe3\xdc\x13\x51\xdf\xae\xe0\x24\xc7\xad\x45\x21\x83\x83\x66\xe5\xeb\x4a\x70\x7a\x59\xe8\x77\x12\x25\x2e\xc5\x6b\x5d\xaf\x80\x2c\xf4\x7b\x5d\x54\xc4\x88\x35\x57\x6c\xbb\xab\x6c\xc9\x78\x5d\xf5\xe5\x93\xe8\xea\x60\x75\x88\xbd\x95\xc3\x1e\x2e\x27\x77\x89\x1c\x28\x4a\x14\x0a\xcc\xb0\x17\x21\x86\x5c\x29\x65\x4d\x4d\xce\xcc\x49\x26\x7a\x26\x6d\xbb\x83\x4f\xe2\x29\x76\x64\xf6\xf5\xcc\x1a\x33\x85\x5d\x7a\x6c\x36\x40\x55\x02\x07\xe8\x48\x27\x72\xe1\x2c\xf6\xdc\xa4\xb4\x93\x6e\x05\x42\xe7\xae\x22\x2c\x21\xcd\x2b\xb8\xaa\xef\x16\xce\x33\xac\x3f\xab\x67\x4d\x7a\x51\x4f\x19\x33\xd1\xf0\x24\xfe\xdd\x07\x17\x4b\x7f\x78\x52\x1f\xe5\xbf\xc4\xd4\xf3\xf6\x23\x74\xdf\xcd\xc7\x0d\xf7\xb3\x75\x05\x32\xba\x97\xdc\xdd\x16\x19\xd0\xfa\xa0\xdc\xfa\xbc\x3f\x8a\x3b\x9c\xce\x5c\x70\x8b\xd3\x94\x6f\x62\x8e\x12\x87\xf4\xcd\x58\xd6\x41\xe5\xc7\xbc\x7e\x7c\x58\x6f\x34\x1a\x7c\x89\x6e\x21\xfa\xf5\xcc\x6c\xb8\x5c\x8f\xd1\x08\x35\x21\x1f\xd0\x0a\x6d\xd7\xb9\x56\x5b\xf8\x8c\x2f\x16\xa4\x3f\x3d\xe7\xcf\xcb\xa1\xb8\x97\x2e\x7f\xe1\x81\x5b\x82\x6d\xc9\x35\x9c\xae\xee\x17\xa5\xde\xfb\x57\x6c\xda\xda\xf1\xae\xed\x7a\x70\x3f\xde\x00\x73\x15\x3d\x66\x07\xf7\xb5\x2f\xbf\x3b\xf6\xa5\xcd\xdf\x08\xba\x2e\x26\x26\x45\x91\x19\x1f\xdd\x60\xd8\x3e\x24\xe9\x7a\xff\x8c\x1c\xde\x8c\x48\x2a\x2d\xe8\xbb\x8b\xab\x5e\x8b\x3d\x1a\x9b\xfb\x1b\xcd\xe8\xc3\xea\xea\x21\x7e\xe3\xa7\xe6\x24\xe9\x63\x75\x9b\x3d\xfc\xc6\xed\xbf\x7c\x31\x42\xd0\x00\xb0\x33\x38\xe2\xa0\x85\x6f\x47\x79\x60\xd6\x6c\xab\xf4\x4d\x5c\xdc\x97\x36\x29\xcc\x8c\x68\xd8\xb9\xf3\x82\x4f\xf0\xc1\x4b\x2b\x23\x8a\xb7\x2b\xea\x50\x89\xfe\xbd\xe0\x96\x73\xe6\x17\x2e\xe7\x6b\xb7\x65\xd2\x1a\x82\x77\x48\xf0\xf4\x29\x9f\xf4\x6d\x8c\x08\x9b\x16\x18\x02\x77\x31\xd7\x4d\x2f\x1b\x10\x77\x90\xc0\x74\xee\xb9\x9b\x08\x0d\xae\x8e\x36\x7e\xbe\xcf\x5e\xff\xc4\xf4\x72\x03\xdb\x81\xc0\xb1\x2f\x1e\x46\x34\xfa\x62\xe2\xfd\x42\xc9\x07\x64\x41\x26\x90\xdc\xd4\x37\xe1\xc8\xcb\xe6\x34\x7f\x32\x13\x7d\x81\xcd\x1a\x7c\xd4\xf0\xe4\x18\xbf\x8b\xc3\xe4\xa6\xe5\xc7\x35\x7c\x38\xf2\xdf\x0e\x93\x9b\xa6\xa8\xba\x9d\x9b\x62\x67\x9e\xf2\x0e\xb4\x29\x55\x1e\x7d\x8d\x9d\x29\x8a\x74\x8d\x16\xa6\x61\x3d\xf4\x66\x3a\xee\x23\x57\xb9\xe3\x08\x18\x8b\xcf\xe6\xbe\x2d\x56\x0d\xb9\xd5\x8f\xbd\xbd\x1d\x81\x6f\x71\x02\x34\x22\x75\xa9\xc8\x9f\xed\x0e\x7c\x17\x57\x80\x27\xb9\xbf\xed\xfc\x77\xb1\x9b\xff\xd7\xd9\x4c\xc3\xff\x5f\x6b\x35\x31\x87\x27\x17\xd9\xd1\x8d\x58\xc3\xa4\x5f\xa8\x27\xdf\x66\x45\x77\xa4\x93\xf1\x7f\xb3\x19\xe0\x65\x16\xcd\x8a\x43\x75\xdd\xfe\x84\x04\x4b\x6a\x94\x2f\x8b\x5c\xc9\x4a\x38\xdd\xdd\x69\xd8\xab\xa1\x0c\xe5\x9c\x33\x98\x59\xc6\x57\x2b\xe6\x54\xb2\xc8\x00\x1a\xf7\xc4\xff\xe1\x37\x53\xd8\xa8\x9d\xff\x68\x5f\x11\xdf\xd0\x83\x89\x21\x19\x13\x8c\x4c\x71\xef\x1b\xdb\xdf\x52\xa7\x79\xfd\xa4\x43\xd3\x46\x2e\xfc\x11\xee\x48\xb6\x9f\x87\xf1\x28\xa7\xc1\x5a\xaa\xaf\x49\xd2\x0e\x19\x25\xd7\x1c\x3d\xca\x18\xf5\xa7\x5f\x69\x35\x0c\x63\x5a\xa9\xa8\x5f\x98\x0c\x2e\xb6\xb3\x4a\x47\x8b\xaa\xb9\x86\xdf\xd4\x82\xdb\xd4\x34\xb2\xe1\x81\x08\xf4\xcd\x35\x66\x21\xbe\x2a\x66\x29\x44\x49\x73\x08\x5c\xcd\x64\x2e\x52\xe1\x90\xe1\x91\x3b\x36\xdb\x3f\x29\x09\xf1\xd5\x6a\xd3\x76\x61\xec\x71\x71\xcd\x9a\x4e\xbf\x57\x22\xa3\x43\x92\xde\x04\xc5\xbf\x9b\x6e\x66\x2f\xa9\x29\x9c\x56\x93\x5a\xfd\x9c\xdc\xec\xce\x69\xfe\xbe\x97\x6a\x96\x74\xe8\x92\x72\x9d\xc8\x5f\x43\x1a\xda\x4d\xe4\x19\xe1\x40\x97\xe9\xdf\xc0\x62\xb4\x70\x3b\x4c\x6e\xfa\x10\xdc\x6d\x25\xd0\x48\x58\x72\x7f\x95\xa1\x18\xb4\x13\x88\xdc\x0e\x1b\xf1\x15\x26\x62\xd8\x36\xa0\x22\xd8\xd3\x3c\xb4\xac\xc3\x76\xdc\x6b\x1b\x76\xc7\x1f\x8c\xa5\xa3\xcc\xb2\x3a\xdf\xc7\xf2\xbd\x03\x0a\x86\xaf\x16\xc3\xef\x6e\x5e\x18\xea\xf6\xab\xb6\x6a\xdd\x34\xb6\xdd\x78\x0d\xcb\xc6\xf7\x96\x4e\xca\x45\xfd\x8e\x0e\x88\xbb\x6f\xcd\x24\xf9\x7d\xb6\x4a\x53\x3c\x3b\xec\x36\x31\x69\x76\xdb\x4a\x26\x70\x1d\x2a\xac\x46\x97\x9f\x9d\x2e\x13\x75\x9b\x4e\x78\x43\x1d\x27\x4b\x63\xd9\xde\x7a\x20\x42\xce\x96\x5d\x38\xbb\xf7\x7a\x9d\x90\x11\x4d\x3f\x99\xa6\xb8\xef\x06\xdb\xed\xa1\x25\x0d\x82\x0d\x9d\xf9\x30\xc1\x9c\x3f\x87\x68\xa7\x8f\x14\xcd\x30\x13\x96\xe4\x25\x7f\x9b\xca\xad\x52\xd1\x3f\xf9\x5b\x55\x43\x31\x86\x6e\xc4\xc7\x93\xf0\x8b\x55\x25\x9e\xd2\x3f\xc8\xb4\xba\x9f\xe8\xdb\x8a\x6b\xe0\xa5\xde\x9d\xb4\x06\xea\x20\xe3\xd3\x9a\xe1\xd2\x42\x6b\x7f\xa5\xe7\x20\xd3\xac\xe8\x52\xbb\xc7\x1e\x13\x22\x68\x5c\x2c\x1a\x09\x4c\xd0\xf0\x3d\x51\xaf\x71\x7e\xd3\xe6\xc6\xb8\x47\xe0\xd0\x63\x44\x12\x52\xbf\xed\x16\xea\x0b\xdd\x37\x1b\xb8\x5d\xe1\x09\xa6\x3a\x34\xaa\x2f\xaf\x49\x53\xa7\x84\x7a\xb3\xb1\xf3\x75\xcb\xb2\x49\x87\xe5\x99\x3d\x20\x56\x08\xb4\x20\xb3\x19\x07\x4e\x78\x3e\x8c\xe2\x16\x0c\x28\x35\xc7\x9a\x63\x44\x6a\x95\x56\xa6\x5e\x5f\x96\x34\xaa\x0a\xe0\x3d\x9f\xf0\x48\xd7\xd0\x3e\x94\x4e\x87\x70\xc2\x48\x27\x34\xed\x55\x40\x7c\xb9\x6a\x2a\x23\x74\x4f\xd2\x4a\x94\x78\x92\xe4\x4e\x20\xe4\x5f\x07\x6a\x05\xcc\x0d\xe3\x45\xba\x2a\xc3\xd4\xce\xeb\x0b\xa4\xf9\x3d\x85\x61\xce\x71\xb1\x30\xc5\x03\x0f\x06\x1b\x1c\x9a\xa8\x38\x8d\x74\xad\x34\xf3\x04\x96\x7c\x3b\x14\xde\xe3\xca\x7a\x4b\x4b\x1f\xf2\xa2\xa2\xa3\xd7\xd8\x79\x7a\xe8\x38\x13\x2e\xd3\x78\x0d\xaf\x85\xf3\xee\x9d\x8f\xc8\xb4\x95\x13\x7f\x72\x03\x7d\x25\x9c\x16\x9e\x39\xa9\x4f\x98\x0c\x50\x67\x8a\xf8\x98\x2f\x2a\xed\xcc\x8b\xed\x56\xc5\x4d\x5c\x8e\x9a\x9b\x7d\x99\xf3\xc5\x10\xe7\x63\x21\x93\x0f\xa2\xc2\x4d\x49\x5d\x3e\x62\xb6\xb0\x1f\xfd\xe5\x90\x86\xaa\x7d\xdc\x64\x9c\x6f\xba\xe0\xfe\x11\xdd\x7f\xef\xdc\x19\xcb\x27\xba\x5d\x6a\x3f\xa4\x9b\x8e\xe0\x60\xa5\xa5\x18\xbf\xb9\x23\x55\xd5\x52\x03\x6d\x15\xd0\x35\x5f\x9b\x8d\x05\x61\x1c\x54\xfb\xe0\xa0\xb1\x11\x6f\xff\xa0\x03\x16\xa8\x1b\x10\x2a\xde\x2f\x5d\xcb\x3d\x0a\x55\xed\x10\x70\xbd\x5f\xb8\xdc\x5b\xee\x1b\x02\x6d\x0e\x4f\x0d\xc9\xf4\xcf\x3f\xbe\xa5\xe6\x58\xef\x55\x4b\x33\x8b\xb7\x3d\xac\x2b\x4b\xc2\xf5\x25\x22\xfb\x17\xc4\x56\x7f\x59\x23\x00\x6d\xc1\xc7\xb3\x59\xb7\xf3\xd5\x5a\x7b\xb8\x0c\xa2\x56\x4b\xe1\x22\x94\x59\x3d\x41\xec\xe8\xc3\x95\x88\xf0\xcc\x2f\x0f\xe6\xb8\x46\x78\x6d\xdb\x1a\xae\xc3\x3b\x7b\xf1\xc5\x95\x10\x19\x0f\x12\xc0\x79\x06\x57\x39\xde\xe4\x11\x2a\x2c\xae\xb7\xf4\xab\x3f\x2b\xd2\xd2\x82\x18\xfd\xb8\xfa\x2f\x18\x0f\xe9\x0c\x67\x75\x1e\xa7\x33\x08\x01\x73\x65\x8c\x55\x0c\xae\xdd\x70\xed\x49\x8f\x8b\xdb\xb8\x47\x67\x95\xdd\x64\xf9\x7d\x67\xb1\x71\x8c\x27\xb7\x78\xa5\x4e\xbc\x10\x9e\x63\xbb\xad\x29\x92\x89\x65\xc2\x6d\xab\x10\x88\x4b\x3c\xad\x4e\x6b\xda\x40\xf2\x9a\x46\x9a\x81\x74\x74\x86\x33\xf5\x0d\x41\x9b\xd3\xb5\x95\x3f\x8e\x50\xd9\x14\x3b\x51\x62\xc3\x2f\x1f\xb4\xaa\x9c\x6e\x1f\x2c\x17\xb4\x31\xd6\xdc\xa8\x8e\x96\x0c\x3a\xf3\xe2\xad\xea\x5b\xe8\x9b\x1b\x6c\xe0\x76\x58\xbd\x7a\xb0\x75\x27\x7f\xbc\x7b\xfa\xb0\xd9\x2b\xed\xee\xc3\x1e\xfa\x8b\x99\xfa\x21\x28\x46\xc5\xd5\x2a\xbb\x5e\x74\xcb\x4a\x51\x80\xbc\xdb\x37\x41\xe6\x65\xe6\x5d\x6f\x3c\x6a\xad\x5b\xe3\x87\xbb\x85\xf1\x7d\x98\x74\x34\xc8\x9b\x6e\x56\x46\xef\x32\x64\x4e\x52\xa6\x2f\x97\x73\xb5\x3e\x3f\xed\x24\x72\xb2\x9e\x8d\x05\x8b\x4f\x07\xc4\x4e\x9b\xe3\x6e\x18\xe0\x78\xb6\x04\xaf\xb1\x63\xd0\xdd\x30\x70\x6b\xf0\x38\x32\x69\x74\xaf\x9b\x37\x1e\x3b\xc5\x1e\xae\x09\x8b\xbe\x29\xf3\xdc\x72\x2c\xec\x57\x38\xb2\x3d\x12\xca\xa4\x27\xa6\xb7\xfa\x87\xe6\xce\x3a\x7d\xf1\x8d\xa9\x8b\xb4\x26\x39\x4b\x16\x77\xea\x5d\x15\xa2\x53\x8a\x89\x65\x03\x9b\x65\xd4\x08\x67\x23\x84\x1f\x0c\x06\x5d\xfe\x76\xfe\xde\x27\xd2\x73\xa2\x15\x54\x6b\x75\xc4\xb2\x3b\x42\xf9\x0e\xa6\xbe\x36\xee\xe4\xfe\xe2\x95\x36\x57\xd4\x2c\x96\x49\x22\x4a\x91\x55\x68\x1e\xdd\x5b\xac\x5c\xab\x8f\xe0\x1a\x1f\x84\xa2\x85\xe5\xbb\xbc\x1e\xfe\x66\x57\x94\x67\x51\x29\x2a\x51\x7f\xbc\xcb\xb8\x14\x26\x16\xa1\x6a\x07\xea\xe7\x7c\xcc\xcb\xba\x0e\x8d\x99\xd7\x1f\xe4\xc2\x9b\x29\xf8\x88\x35\xfc\x1d\xef\x48\xf7\x9b\xb7\xa8\xe0\x20\xe6\x80\x64\x2c\x50\xb2\xf0\x60\x79\xeb\xa6\x15\x9a\x6e\xbd\x21\xd6\x79\x6f\x66\xd6\xfb\x05\x0f\x66\x63\x46\xc2\xc1\x12\x79\xda\xe7\x1a\x59\x5c\xd9\xed\x56\x27\x94\x37\x51\x58\xc6\x78\x83\x89\x28\xb7\x3e\x4c\xf2\xfb\x4c\x94\x8d\x6f\x6e\x58\x42\x2e\xf1\x1a\x8b\x2b\xfe\x38\x1a\x9d\xd0\xe6\x03\xaf\xfa\x7c\x07\x7f\x4e\xc9\xa9\x74\x26\x52\x9a\x5e\x31\x97\x77\xe6\x99\xe1\x01\xfe\x74\x9a\xeb\xab\xe8\xb0\xf6\x11\x4e\x0a\xe7\xc4\xfb\x7d\x13\xe3\x19\x38\x66\x9a\x76\x7d\x0e\x02\xbd\x17\x62\x1c\x76\x88\xc2\xa5\xa8\x83\xb2\xed\xf6\x5d\xaf\x0b\xd4\x12\xb4\xfa\x6b\x13\x77\x7d\x6a\xd6\x7c\x18\x8b\xe5\xfd\x2e\x98\xe2\xba\x7a\xb0\xd9\x89\x10\xb9\x0c\xcd\xa1\xe7\xe3\xd1\x43\x98\x5a\xbd\x3b\xd4\xa2\x56\xc1\xee\x0c\x1a\xbb\xf9\x3b\x4d\xa1\x4d\x4f\x12\x43\xac\x69\xed\xe0\xc9\xa7\x89\x0f\x77\x6c\x03\xb7\xe3\x87\x67\xc6\x21\xe4\x10\x92\xf5\xd1\x63\xa3\x76\x91\x91\xf9\x84\x0a\x37\xec\x72\xf4\xf0\x94\x91\x29\xfa\x4a\xe0\x9a\xea\x9b\xcf\x62\xba\x74\xe1\x06\x18\x63\xed\xa3\x42\x4b\x91\x94\x42\x5d\x3f\x46\x6f\x7e\xd0\x5d\xde\x86\x95\x28\x65\x98\xca\x7f\x89\xf8\x9f\x52\xdc\x9b\x7c\x83\xf9\x7a\x7d\x56\xe1\xad\x24\x26\xd9\xba\x74\x5a\xd3\x75\x7a\xbd\x3a\xf6\x6a\x8d\x03\x94\xe2\xa8\x3e\x36\x80\x5a\x89\x93\x25\xf5\x6d\xaa\x74\xd7\x05\xde\x63\x83\xdf\x13\xc9\xa2\x55\x89\xda\x36\xa5\x6f\x06\xa1\x37\xa6\x15\x17\x8d\x22\x15\xf0\x14\x39\xee\xc8\x57\x15\x8e\x81\x77\x0c\x21\xf8\x7c\x55\x39\x20\xf8\x06\x13\x3c\x68\x05\x58\xff\x75\x7f\x2d\xa3\x6b\x28\xc5\xed\x4a\x96\xa8\x8d\x81\x1d\x24\x7d\x53\x2a\x2b\x37\x1c\x67\x0f\x75\x36\x40\x36\xbe\x4f\x0f\x15\xd9\x6f\x78\x99\x8b\x9a\x68\x8f\xd2\x68\x31\xbc\x0f\xe5\x08\xa7\x5b\x47\x6b\x64\x6e\x34\x4d\xec\x3c\xc3\xb2\xd6\x52\xb5\xa2\xa7\x75\xc9\x0b\xda\x45\xe2\x3b\x47\x54\x74\x2d\x96\x21\x1f\xef\xee\xd1\x5e\x0f\xa0\xd9\xa3\xc9\x90\xb1\xcd\xd5\x8f\x8d\x95\xc0\x5b\x21\x1d\x6d\x26\x13\xa0\x83\x61\x51\xe7\x08\xce\x0b\xa0\x3b\xed\x98\x27\x03\x5e\x63\xbd\x63\xb2\x43\xac\x3b\x3c\xa5\xba\xd7\x5e\xa0\xd5\x64\xd8\xda\xed\x8d\xf5\x31\xfe\x2b\x73\x1e\xc2\x7c\x3f\x1f\xef\x23\xbc\xc2\x03\x85\x06\xb3\x36\x46\x1e\xbe\xff\x15\xaf\x47\xfa\x48\x13\x9e\x4e\x3e\x9c\xbd\xfe\x70\xf6\xf1\xef\xf0\xf6\xe4\xd3\xd9\x87\xf3\x93\x37\xe7\xff\xfb\xec\x14\xfe\x79\x7e\xf6\x2b\x60\x41\xb9\x6c\xf1\x26\x4e\xa8\x05\xe0\xd5\xcf\xef\x5e\xfd\xf2\xe1\xc3\xd9\xbb\x4f\x6f\xfe\x17\xf0\xfd\x02\xb4\xae\x3e\x84\xe5\x82\xbc\xef\x2b\x7d\x06\x6f\x8a\xe2\x61\xbf\xdf\x66\xaf\x67\x73\x29\x7a\xf6\x59\x44\x9a\x99\x1c\x10\x54\x7a\xd8\xd5\x23\x3b\x08\xcb\x12\x83\x5c\xd4\x95\x5b\x7b\x5f\x1f\xa2\x64\xaa\x6b\x87\x15\xcf\xff\x19\x00\xb4\xd8\x86\xbb\x98\x84\x00\x00")
 
 func templateDialectSqlQueryTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -801,12 +844,12 @@ func templateDialectSqlQueryTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 10665, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/query.tmpl", size: 33944, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x6e\xd4\x30\x10\x86\xcf\xf1\x53\xfc\x54\x15\xda\xac\x82\xb7\xf4\x06\xa8\x87\x12\x15\xa9\x12\x42\x82\xf0\x02\xa9\x3d\xe9\x5a\x18\x3b\x19\x3b\xdb\x5d\x45\x7e\x77\xe4\x6c\xd8\x2e\x55\x2f\x1c\x22\x4f\xfc\x7f\x33\xff\x8c\xed\x69\xda\xac\x45\xed\xfb\x03\x9b\xc7\x6d\xc4\xf5\xd5\xfb\x0f\xef\x7a\xa6\x40\x2e\xe2\x4b\xab\xe8\xc1\xfb\x5f\xb8\x77\x4a\xe2\xd6\x5a\xcc\x50\x40\xd6\x79\x47\x5a\x8a\x9f\x5b\x13\x10\xfc\xc8\x8a\xa0\xbc\x26\x98\x00\x6b\x14\xb9\x40\x1a\xa3\xd3\xc4\x88\x5b\xc2\x6d\xdf\xaa\x2d\xe1\x5a\x5e\xfd\x55\xd1\xf9\xd1\x69\x61\xdc\xac\x7f\xbd\xaf\xef\xbe\x35\x77\xe8\x8c\x25\x2c\x7b\xec\x7d\x84\x36\x4c\x2a\x7a\x3e\xc0\x77\x88\x67\x66\x91\x89\xa4\x58\x6f\x52\x12\x62\x9a\xa0\xa9\x33\x8e\x70\xa1\x4d\x6b\x49\xc5\x4d\x18\xec\x26\x50\x0e\x2f\x90\x52\x26\x2e\x1f\x46\x63\x73\x3f\x1f\x6f\xd0\xb7\x41\xb5\x16\x97\xb2\x51\xbe\x27\xf9\x79\x51\x16\x90\x49\x91\xd9\x1d\xc9\x53\x7c\x4a\xcf\x86\xdd\xe8\x14\x56\xff\xb0\x29\x61\x7d\xee\x92\x52\x89\x30\xd8\x46\xb5\x6e\xa5\xe2\x1e\xca\xbb\x48\xfb\x28\xeb\xe3\x5a\x61\x07\xe3\x22\x71\xd7\x2a\x9a\x52\x09\x62\xf6\x8c\x49\x14\xec\x9f\x42\x76\x7e\x1b\x06\x2b\x7f\xf8\xa7\x30\x25\x51\x0c\x23\xf1\xa1\x42\xcb\x8f\xb3\xf6\xc2\x59\x86\xc1\x7e\xcf\xc4\xaa\x94\xcb\x2a\x0a\xd3\xe5\x9a\xaf\xd1\x9a\x73\xb4\x90\x2a\xee\x2b\x9c\x95\xaf\x90\x1b\x28\x3f\xcd\xc9\x6f\x6e\xe0\x8c\xcd\x5d\x15\x4c\x71\x64\x97\x77\x45\x91\x44\xa1\xa9\x23\x9e\x51\x59\x5b\x1f\x28\x3b\x2e\x48\xee\x3b\x8f\xdd\xe4\x9b\x5e\x65\xa4\xc2\xae\x14\x49\xfc\xcf\xb9\x2d\x63\xcc\xc5\x72\x6c\x68\x3e\x9c\xe3\x95\xfa\x57\xc7\x0a\x83\x7d\x06\x64\x33\x07\xab\xd3\x7f\xed\xed\xf8\xdb\x85\x97\xe6\xb2\x33\x64\x75\x90\x52\x96\xf9\x7b\x1e\x62\xc9\x13\xf3\x93\x20\xa7\x91\xd2\x9f\x00\x00\x00\xff\xff\xfa\x7d\xe9\xc4\x2b\x03\x00\x00")
+var _templateDialectSqlSelectTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xcd\x8e\xdb\x36\x10\x3e\x8b\x4f\x31\x35\x16\x85\x64\x28\x54\x9a\x5b\x53\xec\x61\x6b\x24\xc0\x16\xc5\xa6\xad\x73\x08\x50\xf4\x40\x93\x23\x9b\x58\x2e\x69\x93\x94\xbd\xae\xa0\x77\x2f\x86\xe2\x6a\x15\xd7\x28\x9a\x83\x21\x4a\xfc\x38\xf3\xfd\x8c\xe4\xbe\x6f\x96\x6c\xe5\xf6\x67\xaf\xb7\xbb\x08\xef\xde\xfe\xf0\xe3\x9b\xbd\xc7\x80\x36\xc2\x47\x21\x71\xe3\xdc\x23\xdc\x5b\xc9\xe1\xce\x18\x48\xa0\x00\xb4\xef\x8f\xa8\x38\xfb\xbc\xd3\x01\x82\xeb\xbc\x44\x90\x4e\x21\xe8\x00\x46\x4b\xb4\x01\x15\x74\x56\xa1\x87\xb8\x43\xb8\xdb\x0b\xb9\x43\x78\xc7\xdf\xbe\xec\x42\xeb\x3a\xab\x98\xb6\x69\xff\xd7\xfb\xd5\x87\x87\xf5\x07\x68\xb5\x41\xc8\xcf\xbc\x73\x11\x94\xf6\x28\xa3\xf3\x67\x70\x2d\xc4\x59\xb3\xe8\x11\x39\x5b\x36\xc3\xc0\x18\x69\x80\x3b\xa5\x74\xd4\xce\x0a\x03\xad\x46\xa3\x02\xb4\x6e\x6c\x1e\xd0\xa0\x8c\xb0\xe9\xb4\x51\xe8\x39\xa4\x43\x7d\x0f\x0a\x5b\x6d\x11\x16\x4a\x0b\x02\x34\xe1\x60\x9a\x11\xdb\x8c\x15\x16\x30\x0c\xac\x68\x1a\x38\x74\xe8\xcf\x24\x8d\xca\x8d\x37\x71\x27\x62\xaa\x9e\x9b\x09\xff\xd2\x08\x15\xb4\xde\x3d\x71\x56\x8c\xc8\x65\xdf\xc3\x0d\xff\x9d\xd6\x0f\xe2\x09\xa9\x66\xdf\xbf\x01\xb4\x8a\x96\xff\x4d\x24\x31\xa0\xf3\x99\x3c\xbc\xbf\x85\xbd\x08\x52\x18\xb8\xe1\x6b\xe9\xf6\xc8\x7f\xce\x3b\x19\xe8\x51\xa2\x3e\x8e\xc8\x69\x3d\x1d\xa7\x86\x4d\x93\xa2\xc4\x67\x94\x5d\xc4\xb9\x26\x61\x15\x78\x8c\x9d\xb7\xe3\x53\x6a\xcc\x33\x67\x40\x1b\x75\xd4\x18\xe0\xa4\xe3\x0e\x9c\x35\xe7\x99\xb9\xa4\x79\xf4\x21\x48\x61\x2d\x2a\x0e\xf7\x91\x35\x0d\x88\xa3\xd3\x8a\x06\x46\x28\x6d\xb7\x20\x9d\xe9\x9e\x52\x75\x11\x93\x65\xd6\x45\xb0\x88\x0a\x15\x94\xc8\xb7\x1c\x8c\xf0\x5b\x84\x5f\xd6\x9f\x1e\xc0\x79\xd8\x18\xb7\xc9\x95\x2b\x0e\x9f\x77\x08\x5a\x51\x0e\xc2\x9c\xc4\x39\x4c\xcd\xeb\xd4\xca\xaa\x79\x20\x29\xa0\x13\xe6\x1e\x13\xcd\x47\xc4\x3d\xc1\xb4\x87\xbf\xd1\x3b\x38\x0a\xd3\x61\xe0\x29\x92\xa4\xec\x86\x7f\x1c\x0b\xe4\x9c\x6e\x5a\xb2\x52\x5b\x85\xcf\xaf\x7b\x6f\x29\x97\xa6\x61\x4d\x53\x58\xa7\x30\xd4\x80\x3e\x59\x2e\x8d\x46\x1b\xf9\xdc\xb9\x31\xfa\xb2\xe2\x84\x2e\xd6\x89\x48\x99\x00\xbf\x09\xf9\x28\xb6\x09\x43\xf7\x2d\x5f\x39\x1b\xa2\xb0\x11\x86\x21\xc3\xef\x8c\x29\x65\x7c\xae\x58\xd3\xcc\xa7\xa6\xed\xac\x84\xf2\xab\xbc\x87\x01\x96\xf3\x49\x19\x86\x0a\xf2\x69\x90\xce\x46\x7c\x8e\x54\x9f\xae\x15\x94\x7f\xfe\xb5\x9c\x73\x4c\xfc\x9d\xaf\xa0\x7f\x99\xda\xf7\xb7\xb0\xbc\xa8\xcf\xd3\x4e\x06\xf0\x6c\xf3\x2d\x5c\xa2\xc6\x0d\x56\x8c\x93\x34\xbe\x3b\x7c\xd2\x31\x0d\xe0\x17\xca\xd1\xe8\x47\x24\x96\x35\x6c\xba\x08\x7b\x61\xb5\x0c\xa0\x5b\x10\x76\x24\x04\x4e\xca\xce\x07\xfe\x0d\x82\xbf\x5c\x57\x7c\x21\x98\x84\x7e\x9d\xdc\xa5\x8c\x89\x71\xa1\xdb\x84\xf9\xee\x16\xac\x36\x74\xb0\x48\x44\x4b\xf4\xbe\x62\xc5\x30\x29\x4d\xf5\xd8\xc0\xfe\x2f\xd9\x70\x30\x6b\x29\xec\x35\xbe\x35\x1c\x41\xdb\x88\xbe\x15\x12\xfb\xa1\xca\x6e\xf4\xac\x20\xf0\xbf\xc9\xd2\xe4\x7e\xda\xa3\x17\xf4\x05\xa4\x82\x35\x2c\xe6\x72\x17\x35\x2c\xc6\xc9\x5b\x54\xac\xf0\xee\x14\x48\xf2\xf7\xe1\x60\xf8\x1f\xee\x14\xfa\x21\xa7\x5a\x83\xf0\xdb\x70\xcd\x8e\x70\x30\x2f\x73\x9c\xaf\x93\x31\x57\xd0\xca\x13\xb3\x8c\x4c\x74\x66\xe5\x6b\x20\x02\xd5\x4f\x97\xae\x66\x1b\xd1\xfb\xe4\xaa\xc2\x16\x7d\x82\xf2\x95\x71\x01\xcb\x6a\x72\x9a\x78\x93\x75\x6b\xfa\x33\x29\x09\x52\xc3\xb1\x22\xeb\xbf\xc1\xfb\x2c\x23\x15\xa3\xb5\x46\x4f\xe1\x8e\x5f\x0a\x77\x55\x56\x38\x98\x57\x00\xcf\xaf\xf2\x74\xbf\x1a\x3f\x6e\xe5\xf5\x17\x82\x73\x5e\xd1\xef\x55\x44\x3e\xc7\x06\xd6\xf7\x80\x56\xc1\x30\xfc\x33\x00\x50\xbb\x70\xa6\x8e\x07\x00\x00")
 
 func templateDialectSqlSelectTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -821,12 +864,32 @@ func templateDialectSqlSelectTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/select.tmpl", size: 811, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/select.tmpl", size: 1934, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateDialectSqlSyncTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5f\x73\xdb\xb8\x11\x7f\x16\x3f\xc5\x46\xa3\x64\x48\x47\x86\xd3\x7b\xab\x33\xee\xcc\x9d\xe3\x74\xdc\xb4\xce\xf5\x9c\x6b\x1f\x3c\x9e\x1b\x0a\x5c\x4a\x88\x28\x40\x06\x40\xd9\x1a\x1d\xbf\x7b\x67\x17\xe0\x1f\xc9\x76\xce\x69\xfb\x22\x91\x04\xb0\x7f\x7f\xfb\x5b\x00\xbb\xdd\xc9\x51\x72\x6e\xd6\x5b\xab\xe6\x0b\x0f\x3f\xbc\xfb\xd3\x9f\x8f\xd7\x16\x1d\x6a\x0f\x1f\x73\x89\x33\x63\x96\x70\xa9\xa5\x80\x1f\xab\x0a\x78\x92\x03\x1a\xb7\x1b\x2c\x44\xf2\x65\xa1\x1c\x38\x53\x5b\x89\x20\x4d\x81\xa0\x1c\x54\x4a\xa2\x76\x58\x40\xad\x0b\xb4\xe0\x17\x08\x3f\xae\x73\xb9\x40\xf8\x41\xbc\x6b\x47\xa1\x34\xb5\x2e\x12\xa5\x79\xfc\xef\x97\xe7\x17\x57\xd7\x17\x50\xaa\x0a\x21\x7e\xb3\xc6\x78\x28\x94\x45\xe9\x8d\xdd\x82\x29\xc1\x0f\x94\x79\x8b\x28\x92\xa3\x93\xa6\x49\x12\xf2\x01\x64\xa5\x50\xfb\x13\xb7\xd5\x12\xf2\xa2\x70\x2c\xe3\x9a\xde\x56\xe8\x17\xa6\x00\x6f\xf8\x13\x6a\xaf\xfc\x36\x4e\x17\xf0\x59\x57\x5b\xf0\xdb\x35\x3a\xb8\x57\x7e\x01\xb5\x56\x77\x35\xc2\x12\xb7\x0e\x64\xae\x61\x86\x40\x22\xb1\x10\xc0\xca\x76\x3b\x28\xb0\x54\x1a\x61\x5c\xa8\xbc\x42\xe9\x4f\xdc\x5d\x75\x32\xd0\x3e\x86\x30\x6d\xb2\x5e\xce\xe1\xf4\x0c\x66\xb9\x43\x98\x88\x73\xa3\x4b\x35\x17\x3f\xe7\x72\x99\xcf\xb1\x9d\x13\xd6\xd1\xb4\xb5\x55\xda\xc3\x44\x5c\xe5\x2b\x84\xf1\x39\x7f\x8f\xa2\x8e\x83\x69\x13\xf1\x2b\x1b\xf7\x89\x6c\x6b\x9a\xe4\xe4\x24\xf8\x67\x51\x1a\x2d\x55\x85\xc1\x67\x12\x1b\xa4\x34\x4d\xf0\x56\xa1\x6b\x83\x5a\xe4\x3e\x67\x83\x58\x22\x7d\x99\xab\x0d\x6a\x28\xd0\x29\x8b\x05\x38\xf4\x34\xd5\x68\x04\x6f\x73\xed\x72\xe9\x95\xd1\x82\x74\x7d\x59\x20\xcc\x6a\x55\x15\x68\x1d\xe4\x16\x61\x95\x7b\xb9\xc0\xa2\x17\xe5\xbc\x21\x19\x9d\xce\xd9\x96\x0d\xda\xe4\x55\x8d\x2e\x24\xb0\xd5\xb7\xc4\x2d\x94\x0a\xab\xc2\x4d\xe1\x7e\xa1\xe4\x02\x56\xb5\xf3\xa4\x86\x02\x8e\x1e\x8c\x86\xbc\xaa\x3a\x85\x53\xc8\x75\xc1\x73\x28\x23\x31\x49\xa9\xb1\xf4\x26\xcd\x06\x49\xef\x6c\x0b\x79\x3b\xa4\x74\x81\x0f\xd9\xa1\xd7\x02\xbe\x2c\x50\x9f\x26\x27\x27\xc9\xc9\xc9\xe8\x18\x7e\x6a\xdd\x21\x17\x4c\xed\x21\xdf\xf3\x61\xcb\x6e\x2a\xed\xd0\x7a\x02\xfb\xc1\x9a\x85\x21\x18\xb3\x13\x6c\x4a\x69\x2c\xaa\xb9\x3e\x26\xec\x64\x50\xa8\xb2\x44\x0b\xa5\x35\x2b\x8a\x82\xb2\x4f\x88\xae\xd7\x45\xde\x4b\xbe\x1e\x8c\x53\xca\x82\x02\x92\xc6\x93\xf3\x19\x17\x65\x2b\x70\x2f\x65\x34\x5e\x60\x85\x51\x58\x9b\xae\x60\xba\xe3\xd8\x51\x1c\x82\xbe\x90\x3d\x7c\x40\x59\x7b\xaa\x52\xa7\xf4\x9c\x33\x3e\xab\xab\x25\xd4\x6b\x5a\x03\xa9\x43\x84\xcf\x9a\x50\x5b\x29\xe9\x59\xc4\xaf\xbc\xfc\x0a\xef\xff\xc5\x19\xcd\xa6\xa4\xa7\x95\xcd\xea\x95\xd1\x03\x79\xfc\x89\x60\x91\x7b\x5c\x91\xed\x14\x66\xc8\xa9\xb8\x8e\xb5\xf1\xc7\x4a\xc3\xda\x62\xa1\x64\xee\x43\x6a\x2c\x52\x0c\xa7\xec\xde\xc2\x98\x65\x0b\x1a\xd2\x23\x2d\xe6\x1e\xf7\xd5\x1d\x00\xb2\x75\x29\x80\xa5\xd6\x72\x91\xeb\xf9\x30\xa2\xe4\xb7\x36\x1e\xee\xad\xf2\x1e\x35\xe4\x9e\x40\x26\xe0\xb2\x24\x0d\xa4\x55\x1a\xed\xf1\xc1\x13\x8d\xc9\x5c\x4b\xac\x08\x57\xe8\xef\x11\x75\x84\x78\xf4\xc5\x4d\x43\xf1\x39\x6f\xd6\x7d\x80\x07\x35\x43\x22\xac\xa9\x58\x40\x2e\x97\x31\x2d\x23\x8b\x6e\x0a\x68\x2d\x95\x7c\xe4\xa0\x61\xc1\x0a\x12\x9a\x4a\xff\x30\x1d\x40\xff\xe6\xd6\x79\xab\xf4\x7c\x07\xbb\xdd\x31\x4c\x06\x1c\x22\x76\x3b\x48\x19\xeb\x20\xe0\x5d\x46\x24\xe3\x7c\xae\x3d\x1c\x37\x0d\x34\xd9\x00\x0a\x24\xf7\x5a\x9a\x35\x82\x59\x53\x49\x13\x87\x7b\xab\xa4\x77\x4f\x96\xae\x5f\xe4\x7e\xaf\xc8\xc9\xc3\x88\x30\x62\xd2\x1c\x5c\x3d\x23\xb2\xe8\x13\xe4\xf3\x59\x85\x90\xa2\x98\x8b\x9e\x68\x49\x96\x29\x19\x0d\xe6\x5e\xa3\xcd\x28\xd8\x3c\x1c\x99\x4f\xb9\x61\xd0\xf2\x2a\x24\x3f\x64\x2e\xe2\x7e\xbd\xae\x14\x16\xa0\x34\x69\x51\x7e\x6f\x41\x48\xb5\xe2\x84\x51\x66\xa5\x59\xad\x28\xb7\x85\x48\xca\x5a\x4b\x48\x25\x1c\x0d\x78\xb6\x69\x32\x68\x23\xdc\xe6\x9a\x82\x46\xff\xd3\xae\xa2\x6e\x6e\x8f\x86\x39\x39\x67\xe4\x4d\x09\xb6\x1f\x43\xb5\xb7\x09\x99\x52\x30\x1d\x08\xc1\x69\xfb\xcc\x81\xcd\x20\xa5\x97\x5f\xd0\xd5\x95\xe7\x54\x1b\x9b\xc1\x2e\x19\xa9\x12\x2a\xd4\x69\x27\x25\x83\xb3\x33\x78\x47\x23\x23\x8b\xbe\xb6\x1a\xfa\x75\xbb\x26\xae\x74\xe2\x0a\xef\xd3\x71\xdb\x4e\x9a\xe6\x14\x56\xca\x71\x85\xf5\x14\x0a\xa5\xb1\xfb\xb4\x4f\x2d\x6b\x9c\x25\xa3\x26\x19\xd1\xd8\x6f\x53\x28\x09\x70\x96\xa2\x3a\xf0\x83\x74\xbb\x7b\xe5\xe5\x02\x4a\x36\x44\x52\x6b\xd8\xed\xe2\xc4\x89\x9a\xc2\x84\x17\x0a\x68\x9a\xdd\x0e\x54\x09\x13\x05\x4d\x33\x25\x6d\xa8\x8b\xf0\xf5\x10\x90\x93\xb2\xc7\x21\x4f\x08\x33\x4f\x93\xd1\xa8\xc0\x32\xaf\x2b\x4f\x8f\x4f\x3b\x5d\xae\xbc\xb8\xb0\xd6\xd8\x72\xdf\x69\xa5\x37\x79\xa5\x8a\xbe\x6f\xc0\xeb\xbb\x67\xdc\x9e\x42\x99\x25\x23\x72\xbd\xe1\xa0\xff\x36\x05\xb3\x24\x27\xa4\x28\xac\xda\xa0\x15\xe9\x91\x7f\xf8\xc0\x8f\xd9\x7b\x1a\x1b\xa4\x40\x0a\xd7\x15\x60\x84\xc3\x20\xf1\x21\xdf\x21\xae\xfe\xa1\xab\x63\x8d\xf7\x5f\x1e\xc2\x9a\x56\x47\xc6\xaa\x69\xfc\xd5\x19\x68\x55\x7d\x33\xcd\x2c\x4f\x96\xbc\x5b\x90\x42\xf2\x46\x21\x19\xc9\x72\x1e\x85\xc1\x19\xf8\x87\x64\x8f\x3a\xd2\x37\x7b\xa8\xde\x85\x45\xa7\x20\xcb\x79\x93\xbd\xcc\x87\x17\xda\x47\x1c\x36\xcb\xe5\x32\xf5\x0f\x22\xfa\x9c\xb5\xa1\x8d\xc6\xf0\x88\x38\xe7\xd2\x4b\xb3\xf7\xdf\xe5\xb6\x7f\x10\x5d\xcd\xa6\x59\xd2\x4e\x66\x5f\xb5\xaa\x92\x26\xa1\xaa\x27\x87\x22\x11\xb8\x3d\x82\x30\x25\xcb\x8d\x4d\x67\xc0\x2b\x31\x72\x69\x3e\x64\x8b\xec\x79\x56\x70\xff\x77\x56\xb8\xb9\x7d\x01\x29\x18\xca\xf9\x1b\xd7\x4d\x74\xbb\xbe\x64\xcd\xda\xf7\x45\x4b\xc0\x63\x10\x99\xb5\x4f\x4d\xc8\x80\x63\x3e\x3f\x3d\x83\x55\xbe\xc4\xf4\xe6\xb6\x6f\xa6\x43\x43\xa7\x4c\x3b\x46\xf0\xec\x2c\x0b\xe2\xd5\x14\xd6\x03\xe1\x61\x90\xe5\xf3\xd3\x8d\xba\x85\x33\x58\xb3\x96\x4d\x6e\x21\x65\xf4\x3a\x18\x24\x31\x19\x8d\x78\x6b\xd2\x69\xbf\xb9\xe5\x6d\x41\x50\x17\x83\x46\xea\x46\x8e\x9a\x67\x9c\xb6\xca\xd7\x37\x81\x3b\x6f\x95\xf6\x8f\xe6\xf6\xd6\xc5\xee\xd7\xdb\x18\x67\xb1\x8d\x71\xb0\x2f\x8f\xb6\xec\x06\x63\xab\xda\xe7\x14\xd1\x6f\x4e\x8a\x7c\xe4\x08\x79\xe4\xce\x20\x98\x03\x67\xba\x14\xb3\x3b\x14\xbe\xaf\xdf\x20\xd4\xd1\xa6\x25\x9c\x47\xa6\xf0\xac\x94\xe9\x89\xe8\xe1\x55\xe4\x9e\xae\x44\x18\xf5\xcf\x51\xe0\x23\xde\x27\x0a\x54\x7a\x9f\x01\xa3\x4a\x78\x5d\x8c\xa7\x50\x4e\x41\xb1\xae\x86\x7e\x96\xb8\xbd\xf9\x4a\x69\xdd\x04\x76\x1c\x31\x29\x12\xf4\x3e\xe1\x96\xda\x12\x4d\x55\x25\x7c\x6d\xcd\xa7\xbc\xdd\x2c\x6f\x3b\x8a\x7c\x91\x95\x4f\x59\xe3\xe0\x75\xc1\x7d\xfa\x75\x01\x8b\x7c\x83\x5c\xc1\x8e\xe6\x2c\x71\x3b\x9e\x92\x46\x15\x29\x7b\x14\x95\x32\x61\xb9\x00\x43\xc5\x2f\x8c\xc5\xb8\x4d\x39\x7d\x8c\xa6\xbd\xda\xcc\x86\xdd\xd6\x65\xf0\x97\xd8\x67\xef\x6a\xb4\x5b\x72\x4d\x8a\x7f\xd2\x63\x9a\x89\x7f\x2f\xd0\x62\xca\xa0\x17\x42\xb4\xef\xc4\x12\xa9\x83\x23\x77\x57\x89\x6b\xa4\x53\x5e\xac\xd7\xd1\xc8\xb5\x4b\xb6\x5a\xfe\xdc\x16\x5c\xea\xf6\x18\x96\xb5\xb2\x47\xf4\x33\x38\xc0\x7d\x0c\x47\x84\xf6\x04\x37\x8a\x26\x09\x1a\xfe\xf8\xc9\x11\xd1\xdb\x1a\xe3\x9a\xd0\x36\x93\xd1\x48\x9b\x62\x40\xfd\x61\xc5\x8f\x55\x45\x74\x15\x73\x76\xc0\xb8\x7b\x99\xe2\x06\x43\xc1\x6b\x79\x45\xf7\xc8\x65\xc9\x61\xc9\x0b\xd1\xff\xc7\xf0\xef\x81\xa6\xb9\x11\xb1\xa8\x08\x7a\xb2\x22\x26\xf1\x66\x88\x3c\x9e\xdd\x35\x6d\x62\x1c\xda\xa2\xa3\x7b\x9a\x74\x5f\x46\x12\x71\x63\xa3\x3b\x38\x3f\x52\x4b\xf8\xca\x6e\xdf\xf7\x3b\x9f\x57\x66\x19\xb7\x26\x4e\x5c\xc6\x63\xdf\xdb\xb7\xed\x68\x70\xe7\x9c\xb7\xa6\x45\x7a\x58\xdc\x59\xb7\x32\x1c\x93\x8a\xb7\x6f\x1f\x6d\x78\x9c\xf8\xb5\x3d\x94\xf0\xe8\x88\xb6\xa0\x4a\x73\xc6\x29\x34\xd1\xe9\x33\x6a\x75\xa8\x8b\x34\xbc\x77\x7e\x06\xd6\xa7\x63\x7f\x77\x0a\xe9\x8e\x34\xca\xb9\x9a\xb6\xe9\xa5\x8f\x57\x2e\xb1\x8f\xc1\x7d\xde\x1f\x65\xc4\xb0\x6b\x4b\xff\x40\x15\xfc\xbd\x0d\x3b\x16\x56\x30\xad\x2f\xad\xb0\xd1\xfa\xad\x43\xa9\x14\x61\xdb\xfc\x53\x5d\x2d\xa3\x1f\x5c\x5f\xfd\x91\xb2\x07\x16\x0f\x1c\x1c\x2e\xd3\x4c\x5c\xe7\x1b\x64\x8c\xbf\x7f\x0e\xdf\x4f\x58\xd8\xef\xfb\xfe\x37\x37\x0b\xac\x38\x48\xe2\x03\x1f\x7a\x1e\x33\xc5\x33\x14\x53\x60\xf5\x1d\x1c\x72\x57\x89\x2b\xe3\x5f\xc0\x25\x2d\x99\x34\xc9\x48\x77\x31\x26\x5d\x17\x0f\x28\x23\x11\xa8\xf2\xbb\x3c\xb4\xe8\xa2\x73\x45\xa8\xbe\xe7\xb7\x5e\x5c\xc2\x10\xc6\x5d\x7f\xa7\xf3\xcc\x95\x4e\xfb\x79\x58\xb8\xed\xc6\x8b\xbf\xfd\x82\x12\xa9\x11\x53\xc3\xda\xe7\xed\x5e\x5b\x1a\x44\x05\x76\xcf\x20\x58\xb0\x4b\xba\xe3\x0a\x8f\xee\x12\x26\xca\x78\x5a\xe9\x4e\x2a\x6d\xc1\xfe\xe1\xd9\x84\xcb\x92\x24\xd0\xc1\xa6\x14\x57\xaa\xaa\xf8\x10\xcb\xac\xcb\x98\x3e\xb4\x37\xca\xb8\xa0\xb3\x6d\x48\x11\x39\x71\xd6\x87\xbc\x07\x27\x85\x70\xd4\x72\x5e\xfb\xf1\xe8\x45\x02\x5b\xb3\xb0\x72\x9d\x31\x51\xc0\xf7\xad\x8f\x1d\x64\xf8\xdc\x74\x89\xde\xcf\x71\xe4\x35\xb0\xb8\x36\xd6\xd3\xed\x13\xfa\x45\xe4\x92\xfd\x1b\xbc\x96\xf1\x0e\xaf\xb8\xda\x4b\x84\xbd\xd9\x24\x7e\x0f\x08\x10\x7b\x45\x77\xc3\x40\xc7\xf6\x78\xe3\x37\x94\x3e\x05\xb5\xa2\xe7\x59\xd5\x5d\xb2\xd1\x16\x22\x3e\x52\xc3\x84\x5c\xc7\x1b\x2d\x52\x12\x99\x96\x45\xaa\xb9\x26\xae\x9f\xc2\x0c\x65\x5e\x3b\xde\x70\x6c\x3b\x65\xed\xb5\x50\xbc\x9e\x6c\x2f\xbc\x8c\xed\x46\x8c\x06\xdc\xd0\x56\x81\x89\xab\x3b\x35\xbc\x04\xbc\x6d\x7b\xe8\x82\x14\x32\xfe\x8f\xf8\xda\xcd\x9d\x19\x53\x3d\x85\xdf\x89\x88\xf1\xa1\x5c\xb5\xd8\x24\xab\xe9\x86\x71\x52\x8a\xcb\x2e\x28\x93\x32\x72\xe6\x87\xe0\x79\x36\x48\xfc\xc4\x0c\xaf\x94\x3b\x93\xc7\x62\xfc\x3c\x58\x1e\xd5\x00\x19\x6e\xa0\x15\x33\x3e\x1a\xd3\xeb\xe0\x40\xdf\x29\xd3\xd8\x69\x2b\x61\xbc\x21\x06\x7a\xed\xe2\xec\x7d\xe9\x97\xee\x8b\x5a\x75\x90\x6e\x17\xf7\x6b\x5f\x6d\xc4\xc5\x5d\x9d\x57\xe9\x6b\x97\x1d\x08\xe0\x5a\x20\x8a\xbb\x23\x41\x5f\xb6\x6b\xa4\x23\x9a\xf3\x1c\xd1\x31\xbd\xff\xb4\xf5\xe8\xc6\xdf\x10\x3e\xa3\x09\x51\xc1\x66\x0a\xcf\xeb\x88\xa1\x76\x7f\xbb\xfe\x7c\x45\x4f\xcc\x3e\xd7\x32\xd7\x1a\xed\x37\xe4\x5b\x2c\x69\xab\x28\x3e\x20\xae\xbf\xa5\xa5\x8b\x9e\x2a\xa1\x3b\x2c\xb4\x80\x89\x05\xdd\x02\xe6\xaf\x48\xe7\x53\xea\x5e\x66\x09\x6f\xde\x30\x83\x3e\x99\xa9\x27\x48\xe1\xda\xdb\x5a\xfa\x43\x96\xfa\xfd\xf7\xfd\xdb\x1b\x4d\xf9\x88\x8d\xa9\xa5\x86\xb8\x0b\x1d\x35\xc9\xbe\xc9\x87\xcf\x11\xb9\x18\x90\x7b\x51\xcc\x71\x1f\xb8\x13\x14\x9f\xef\xf5\xc7\x4f\xbd\xbf\xaa\x70\x8f\xbc\xc5\x03\x4b\x2f\x3f\x38\x72\x98\xf6\xef\xaa\x88\xbd\xf5\xcd\x9b\xc7\xf5\xb7\xbf\xf8\xd3\x13\x8e\x1e\xbd\x74\xc9\xab\x33\x50\x85\xbb\x79\x77\xfb\x5f\x04\x22\x4e\x2d\xf3\xca\x61\xd2\x24\x83\xa1\xdd\x0e\x50\x17\xd0\x34\xc9\x7f\x06\x00\x40\x91\x4e\x83\x67\x1b\x00\x00")
+
+func templateDialectSqlSyncTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateDialectSqlSyncTmpl,
+		"template/dialect/sql/sync.tmpl",
+	)
+}
+
+func templateDialectSqlSyncTmpl() (*asset, error) {
+	bytes, err := templateDialectSqlSyncTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/dialect/sql/sync.tmpl", size: 7015, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xbf\x6e\xdb\x3a\x18\xc5\x67\xf1\x29\xce\x35\x7c\x03\xcb\x50\xe8\x34\x5b\x55\x64\x48\xdd\x14\x08\x50\xb8\x43\xbd\x07\x0a\xf9\x49\x26\xcc\x90\x0e\x49\x39\x0a\x04\xbe\x7b\x41\xca\x2e\xe2\x2e\x9d\xf4\xfd\x3f\xbf\x43\x68\x1c\x57\x4b\xb6\xb6\x87\x77\xa7\xba\x5d\xc0\xed\xcd\xa7\xcf\xd7\x07\x47\x9e\x4c\xc0\xf7\x46\xd0\xb3\xb5\x7b\x3c\x1a\xc1\x71\xaf\x35\xf2\x90\x47\xea\xbb\x23\x49\xce\xb6\x3b\xe5\xe1\x6d\xef\x04\x41\x58\x49\x50\x1e\x5a\x09\x32\x9e\x24\x7a\x23\xc9\x21\xec\x08\xf7\x87\x46\xec\x08\xb7\xfc\xe6\xdc\x45\x6b\x7b\x23\x99\x32\xb9\xff\xe3\x71\xfd\xb0\xf9\xf5\x80\x56\x69\xc2\xa9\xe6\xac\x0d\x90\xca\x91\x08\xd6\xbd\xc3\xb6\x08\x1f\xc4\x82\x23\xe2\x6c\xb9\x8a\x91\xb1\x71\x84\xa4\x56\x19\xc2\x4c\xaa\x46\x93\x08\x2b\xff\xaa\x57\x61\xb0\x87\xa0\xac\xf1\x33\xc4\xc8\x56\x2b\x7c\xa5\x4e\x99\xed\x00\x47\xa1\x77\xc6\xa3\x41\x70\x8d\xf1\x8d\x48\x53\x8d\x86\xd0\x2a\xd9\x7e\x53\x61\x87\xd3\x2a\x67\x6d\x6f\x04\x16\x02\xcb\x75\xee\x96\xe7\x2b\x0b\x11\x06\x08\x6b\x02\x0d\x81\xaf\xa7\x6f\x95\xd6\x3c\x96\xfe\x55\xf3\xed\xf0\x73\x3a\x51\x62\xb1\xdc\x0e\x15\xc8\x39\xeb\x4a\x8c\xac\x50\x2d\x9e\x2a\xd8\x3d\xea\x3b\x08\x2e\x9d\x3a\x92\xe3\x8b\x65\x18\xbe\xe5\xb0\xfc\x92\x7a\x23\x2b\x8a\x09\x14\x46\xe9\x0a\xed\x4b\xe0\x0f\xe9\x44\xbb\x98\x91\x09\x35\x44\x63\x8c\x0d\xf0\xa1\x71\xe1\xd2\x4a\x76\xa0\xcc\x65\x71\x56\xb2\x22\xb2\x22\x4c\x24\x7f\x49\x27\xe0\x93\x38\xff\xe0\x6f\xf2\x53\x66\xe2\xb4\xf4\xdf\x5d\x62\xf9\x37\x5a\x66\x52\xa6\xbb\x24\xa8\xf1\xff\x71\x96\xd5\x27\x14\xd1\x76\x19\xc3\x9a\x56\x75\xe3\xc4\x52\xe3\xea\xfc\x0c\x63\x18\x6a\x24\x06\xe9\x8e\xf5\x1f\xd8\x58\x41\xdb\x2e\xe5\xda\x76\x15\x24\x3d\xf7\x39\xcb\x41\x85\x9d\xb5\x7b\x9f\xf2\x1c\x44\x76\xe6\xbc\xda\x0e\x89\x7a\xd2\xaa\x21\xda\xae\x62\x45\x31\x8e\x70\x8d\xe9\x08\xf3\xa7\x0a\x73\x93\x68\xe6\x7c\x63\x25\x79\x5c\xc7\xc8\x8a\x3c\x31\x37\x7c\xd3\xbc\x10\x62\xac\xb1\xa1\xb7\x8b\xca\xf4\x4f\x2c\x44\xdb\x95\xa7\x7b\x64\xe4\xb4\x1b\xab\xf4\x38\x2c\xb2\x53\x31\xc6\xdf\x01\x00\x00\xff\xff\x92\xfd\x87\x4a\x6b\x03\x00\x00")
+var _templateDialectSqlTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x4d\x6f\xdb\xb8\x16\x5d\x4b\xbf\xe2\xd6\x48\x02\x29\x75\xe9\xbe\xee\x9e\x03\x2f\x52\x3f\x17\x08\x5e\x90\x14\x8d\x67\x66\x51\x14\x01\x43\x5e\xd9\x44\x64\xd2\xa5\x68\x5b\x85\xa1\xff\x3e\xb8\x24\x25\x7f\xa5\x33\x01\x3a\xb3\xb2\x45\xde\x6f\x9e\x73\xc8\xed\x76\x70\x99\x8e\xcd\xf2\x87\x55\xb3\xb9\x83\x0f\xef\xff\xf3\xdf\x77\x4b\x8b\x15\x6a\x07\x9f\xb8\xc0\x27\x63\x9e\xe1\x46\x0b\x06\xd7\x65\x09\xde\xa8\x02\xda\xb7\x6b\x94\x2c\x9d\xce\x55\x05\x95\x59\x59\x81\x20\x8c\x44\x50\x15\x94\x4a\xa0\xae\x50\xc2\x4a\x4b\xb4\xe0\xe6\x08\xd7\x4b\x2e\xe6\x08\x1f\xd8\xfb\x76\x17\x0a\xb3\xd2\x32\x55\xda\xef\xdf\xde\x8c\x27\x77\x0f\x13\x28\x54\x89\x10\xd7\xac\x31\x0e\xa4\xb2\x28\x9c\xb1\x3f\xc0\x14\xe0\xf6\x92\x39\x8b\xc8\xd2\xcb\x41\xd3\xa4\xe9\x76\x0b\x12\x0b\xa5\x11\x7a\x52\xf1\x12\x85\x1b\x54\xdf\xcb\x81\xab\xcd\xd2\x29\xa3\xab\x1e\x34\x4d\x3a\x18\xc0\x47\x9c\x29\x3d\xad\xc1\xa2\x5b\x59\x5d\x01\x07\x67\xb9\xae\xb8\x20\x2b\x5e\x82\x28\x15\xb5\xbd\x51\x6e\x0e\xd1\x95\xa5\xc5\x4a\x0b\xc8\x04\x5c\x8e\xfd\x6e\xde\x46\xc9\x84\xab\x41\x18\xed\xb0\x76\x6c\x1c\x7e\xfb\xe4\x56\xc1\x65\xf5\xbd\x64\xd3\xfa\x3e\x84\xc8\x21\xbb\x9c\xd6\x7d\x40\x6b\x8d\xcd\x61\x9b\x26\xaa\x80\xc7\x3e\x98\x67\x18\x8e\x40\x30\x69\xd5\x1a\x2d\xcb\x2e\x5d\xfd\x3f\xff\x37\xbf\xa2\xbd\x6d\x9a\x24\xa1\x50\xd0\xaa\xec\x43\xb1\x70\x6c\x42\x21\x8a\xac\x87\xda\x0d\x41\x70\xad\x8d\x83\xca\x71\xeb\x0e\x5b\xf1\x1d\x28\x7d\xb8\xd8\xcb\xd3\xa4\x49\x13\x69\xd7\xa7\xa9\x95\x76\x68\x0b\x2e\x90\xaa\x4b\xba\x06\x8f\x9b\x3b\xe9\x2b\x4e\x9b\xed\xda\x4b\x93\x26\xf7\x0d\xbe\x79\x4d\x0b\xa1\x5f\x16\x13\x12\x76\x7c\x47\xab\xe5\xd2\x58\x87\x32\x96\xec\x42\x74\x2a\x59\xda\x75\x6b\x4d\xf3\x0f\xf3\x0e\x09\xd1\x5a\x78\x33\xa2\x59\xfd\x7d\x5e\x3f\x33\xa5\x67\x87\x13\x1a\xc2\xf9\xba\xe7\x53\xb5\x79\x25\xe5\xbc\x68\xcf\x65\xeb\xea\x21\x50\x52\x69\xd7\xc3\x6e\x7a\xbe\x40\xc9\xcc\x93\xa7\x44\xa8\x4a\x30\x57\xdf\x87\x05\x1a\x89\x28\x66\x14\x48\x30\x61\x74\xa1\x66\x7e\x21\x7a\xc3\x08\x5c\x2d\xd3\xb6\xdc\x8b\x69\x4d\xc5\x07\xbb\x21\x88\x62\xd6\x4f\x93\x64\xbb\x05\xcb\xf5\x0c\xe1\xec\xb1\x0f\x67\x9a\x62\x9d\xb1\x3b\x23\xb1\x82\x77\x4d\x93\x26\xde\xe2\x4c\xb3\x3b\xbe\x40\x68\x9a\x21\xdc\xe1\xe6\x60\x25\x40\x37\x13\xc5\x2c\x8f\xf1\x50\xcb\xe0\xdb\xf4\x69\x64\x69\x93\xc6\xc5\xa6\xf9\x19\x9d\x02\x3b\x06\x84\x2d\x57\xf7\xa2\xe1\xd9\xf2\xd9\xf7\xf6\xc4\x2b\x84\x33\x82\x4a\xa1\x66\xec\x33\x17\xcf\x7c\x86\x91\x76\x7f\x28\x37\x27\xd6\xad\x74\x05\x85\x86\x63\x60\xf6\x61\x33\x57\x62\x4e\xa7\x2f\xcc\x62\xa1\x9c\x43\x09\xaa\x20\xd3\x96\xa8\xfe\x14\xb9\x96\x60\x4d\x59\xa2\x84\x27\x2e\x9e\x8f\x4c\xb8\xa6\x54\x1e\x84\x60\x2c\x2c\xb9\x56\xa2\x82\x8c\xc4\xc5\xff\xa7\xf0\x16\xdf\x59\xae\x48\x99\x78\xe1\xa2\x32\x51\x44\x0a\x97\x33\xb8\x21\x85\xc1\x96\xd3\x30\x37\xa5\x3c\xd2\x08\xc8\x2a\x44\xca\x73\x87\x9b\x69\x1d\x79\x91\xf7\x29\xa1\xf7\xf4\x13\xa2\x4c\x7b\x3e\x9c\x00\xa8\x43\xf7\x51\xd9\xb0\x56\x95\x87\xdf\x8b\x9c\xad\xf8\x1a\x97\x46\x69\x47\x89\x94\xae\x1c\x72\xc9\x60\x3a\xc7\xdd\x0e\xa5\xb0\x58\x22\xaf\x5e\x37\x2a\x67\xc0\xb8\x39\xda\x8d\xaa\xb0\xef\xd5\xc1\xac\x1c\xf0\xa2\x40\x41\x75\x50\x22\x5f\xff\x9c\x50\x56\x81\x9b\x73\x07\x1b\xb4\x08\x0b\x2e\x3b\x3d\xde\x2f\xf6\x09\x0b\x63\x11\x94\x63\xf0\xc9\x58\xc0\x9a\x2f\x96\x25\x0e\xd3\xc1\x20\x1d\x0c\x92\x48\xd6\x00\x18\x16\x8e\x3f\x10\x83\xc4\x34\x73\x35\x5c\xb6\xd0\x69\x1a\x36\xad\xf3\x78\x6e\x5b\x72\x8e\xea\x18\x63\xb8\x9a\x6d\xb7\x90\x29\x2d\xb1\xee\x40\xff\x3e\x6f\x81\xcd\xc6\x16\xb9\xc3\x2c\x67\x0f\x7c\x8d\x94\x23\xbf\x3a\x54\x02\x8a\xd8\xb2\x0b\xad\xf5\x19\xe8\x32\xd8\x57\x08\xfa\x6c\x72\x2a\xfe\x44\xec\x77\xc5\x9f\x6a\x7d\xa1\x77\xfd\x74\x4d\xe4\x90\x51\xfe\x9d\xd8\xbb\x9a\xf8\x31\xad\x3f\x59\xb3\x88\xae\x14\x2e\x7f\xe5\x35\x70\x71\x01\xae\x86\xd1\x4e\xd8\xe8\xcb\xeb\x44\x27\x12\x51\x56\x48\x08\x5c\xcd\x94\x56\x2e\x0b\x02\xa6\x0a\xf2\x3d\x15\x45\x57\x33\xc2\xc0\x43\x0b\xa7\x78\x36\x7a\xcf\xcb\xcb\x20\x90\x66\x4d\xeb\x97\xc6\xda\xc5\xa2\x99\x92\x97\xc4\x02\x6d\x98\x87\xbf\xe3\xa8\xbb\x35\x75\x6e\x51\x98\x35\xda\x2c\xbf\x82\xf5\xbe\x7f\xf2\xe8\xb5\x8f\x7d\x89\x04\xa4\x9a\x93\xc4\x73\x35\x5b\xd3\xff\x26\x4d\x9a\x6c\xa7\xed\xc3\x11\x14\x3a\x7b\xa9\x12\x55\x80\xdd\xc1\x65\x17\xef\x0a\xec\x91\xa5\x47\xe6\xe8\xe0\x3e\x38\xdf\x0c\x3d\x55\x88\x8e\xe4\xf6\xf2\x85\xd0\xf7\xa1\x62\x55\xc7\xad\xc7\x4f\x57\xb3\xb1\xd7\xaf\x2c\x4f\x9b\x94\x38\x75\x30\xe4\x4e\xff\x4e\x89\x1e\xde\x36\x07\x0c\x0b\x84\xa7\x71\x52\x21\xfb\x94\xb4\x38\x53\x95\x43\x8b\x92\x52\x38\x43\x71\xa3\x9e\x05\xf9\x84\xa7\x1f\x5e\x65\x16\x2b\xc7\x83\xb3\xf1\x4a\xc9\x2d\x82\x54\x95\xe0\x56\x06\xd5\x70\x27\x92\x72\x20\x19\xed\xd3\xa7\x85\xf7\x09\x64\x5e\xcd\x88\x96\xdd\x89\xb4\xeb\x78\x4a\x01\xb2\x2f\x80\xde\xbf\x50\xd8\x62\xc5\x6e\x0d\x9d\x61\xf8\xec\xaa\xac\xde\xbe\x4d\x13\x4d\xdc\x27\x38\x2c\x1c\x7b\x58\x5a\xa5\x5d\x91\xf5\x76\x82\xf2\xd8\x59\x3f\x9e\xcb\x5e\x1f\x0e\x03\xd0\x75\xdc\xdd\x32\xc3\x11\x94\xa8\x33\xb2\xf0\x13\x0c\xe7\x17\x93\x2e\x56\xec\x37\x5d\xc6\x2a\x76\x20\x24\xe3\x49\x8d\x82\x58\xd1\x87\xde\xc3\xf5\xef\x93\xcf\xf7\x37\x77\x53\xe8\xbd\xa5\xc2\xfa\xf0\xf5\x5b\xf7\x9c\xda\x36\xdb\x70\xbf\xfe\x94\x3e\xfb\x50\xdc\xb5\x30\x04\x41\xba\x46\x98\xec\x2a\x1f\xc2\xf9\x66\xff\x75\xd2\x5e\x5c\x54\x53\xa4\x5d\x37\xe7\xe3\x19\x26\x47\x1d\xc2\x08\x8e\x56\xbe\x0e\xbb\xa9\x7c\x4b\x93\xd3\xfe\xdb\x7a\x8f\xba\xff\x72\x7f\x7b\xfb\xf1\x7a\xfc\x7f\x98\xde\xc3\x2b\x27\xf1\x0b\x6a\x61\xff\x49\xa9\xb0\xbf\xaa\x13\xe6\xe0\x6c\x5e\x23\x14\x3f\x05\xd1\x97\xc9\xed\xe4\xfa\x61\x02\xff\x12\x98\xc2\x53\xe1\xaf\xd1\xb4\xbb\x0c\xf7\x9f\x82\x7f\x0e\x00\x9a\xde\x26\x37\x1e\x0e\x00\x00")
 
 func templateDialectSqlTxTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -841,12 +904,12 @@ func templateDialectSqlTxTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/tx.tmpl", size: 875, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/tx.tmpl", size: 3614, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x5f\x6f\xdb\x36\x10\x7f\x96\x3e\xc5\x55\x08\x0a\x3b\x70\x95\xac\x6f\x73\xe1\x01\x69\x92\x0e\xc6\xda\xb4\x8b\xdb\x3d\xac\x28\x02\x45\x3c\x39\x84\x69\x4a\x21\xa9\x2c\x9d\xa1\xef\x3e\x1c\xff\xc8\x52\x64\xa7\xcd\x3a\x60\x0f\x71\x28\xde\x1d\xef\xf8\xbb\x3f\xbc\xdb\x6c\x8e\x0e\xe3\xd3\xb2\xfa\xaa\xf8\xf2\xc6\xc0\xcb\xe3\x9f\x7e\x7e\x51\x29\xd4\x28\x0d\xbc\xc9\x72\xbc\x2e\xcb\x15\xcc\x65\x9e\xc2\x89\x10\x60\x99\x34\x10\x5d\xdd\x21\x4b\xe3\x8f\x37\x5c\x83\x2e\x6b\x95\x23\xe4\x25\x43\xe0\x1a\x04\xcf\x51\x6a\x64\x50\x4b\x86\x0a\xcc\x0d\xc2\x49\x95\xe5\x37\x08\x2f\xd3\xe3\x40\x85\xa2\xac\x25\x8b\xb9\xb4\xf4\xb7\xf3\xd3\xf3\x8b\xc5\x39\x14\x5c\x20\xf8\x3d\x55\x96\x06\x18\x57\x98\x9b\x52\x7d\x85\xb2\x00\xd3\x51\x66\x14\x62\x1a\x1f\x1e\x35\x4d\x1c\x6f\x36\xc0\xb0\xe0\x12\x21\x61\x3c\x13\x98\x9b\x23\x7d\x2b\x8e\xea\x8a\x65\x06\x13\x68\x1a\xe2\x38\xa8\x56\x4b\x98\xce\xe0\x20\x5d\xe4\x65\x85\xe9\x87\x2c\x5f\x65\x4b\x0c\xd4\xeb\x9a\x0b\xb2\x76\x3a\x83\x2a\xd3\x79\x26\x5a\xc6\xd7\x9e\xe2\x19\x15\xe6\xc8\xef\x1c\x67\xbb\x6e\xc5\x3d\xd3\xba\x36\x99\xe1\xa5\xb4\xc7\x29\x2e\x4d\x47\x2e\x49\x03\xb5\x35\xad\x94\x48\x9c\x37\x99\x5e\xd4\x45\xc1\xef\xb7\xe7\x25\xef\x65\xb8\xc1\x0b\x38\xf8\x1b\x55\x49\x8c\xc7\xd0\x34\x9b\x0d\xf0\xc2\x89\xda\x0f\x47\x9c\x41\x22\xb9\x48\xdc\x16\x4a\xd6\x8a\x2a\x34\x24\x99\xc8\x64\x97\x2c\x51\x09\x9a\xcb\x60\x64\x57\x3e\x2e\x6a\x99\xc3\xa8\x77\xf9\xa6\x81\xc3\x2e\x6c\x4d\x33\x06\x7d\x2b\x16\xd9\x1d\x8e\x72\x73\x0f\x79\x29\x0d\xde\x9b\xf4\xd4\xfd\x1f\x07\x71\x43\x92\x3d\xf5\xf6\x98\xf4\x22\x5b\x7b\x5b\x50\x68\x5a\x71\x69\x5a\x0b\x26\x80\x4a\xd1\x5f\xa9\xc6\xb0\x89\xa3\x2b\x5d\x61\x4e\xb7\x79\xae\x6f\xc5\x52\x65\xd5\x4d\xfa\xc9\xfa\x7a\x51\x61\xbe\x89\xa3\xe8\xa2\x64\x38\xed\x50\xe9\x3b\xd0\xa2\x8f\xd9\xb5\xc0\x29\x58\xb5\xdb\x20\x48\xed\xf6\x84\x18\x4e\x4b\x51\xaf\xa5\x1e\xb2\x78\x82\x65\x9a\x9f\x75\x15\xbc\xe1\x28\x58\xab\x21\xfa\xf8\xb5\xc2\x29\x14\xb4\x99\xda\x43\xe6\x67\x29\xed\x11\x1c\xda\xf8\xbb\xda\x63\xbc\xb2\xa1\xae\x20\x66\x25\x32\x69\x82\x80\xfd\xa5\x9f\x26\x8e\xc8\xb1\x5b\x20\xe3\x28\xe2\x6c\x02\xe5\x8a\x90\xe9\x05\x61\xe7\xb8\x77\x7e\xef\x57\xeb\x89\xd1\x98\x84\x0a\x78\x56\xae\xc0\x5a\xae\xd0\xd4\x4a\x42\x1b\x4e\x84\x7d\xb1\x36\xe9\x39\x61\x5f\x8c\x92\x35\xd7\x9a\xcb\x25\x74\x7d\x96\xce\xcf\xa0\x28\x15\xf8\x74\xa3\x23\xc9\x16\xeb\x24\x8b\x3c\xe9\xfd\x23\x13\x35\xc2\x0c\x38\x73\x66\x7b\x2f\x3b\xf5\x95\x0e\x26\x77\xe2\x2b\xad\x14\x32\x9e\x67\x06\xf5\x2b\x10\x28\x47\x95\x1e\xc3\x2f\x70\xec\x0c\x75\xa7\x7f\x08\x2c\x30\x03\x0a\xd2\x91\x46\x61\xcb\x05\x1c\xea\x5b\x91\x2e\xfc\xd7\xd8\xc9\x44\x64\x25\xb7\x79\x9b\xc9\x25\x92\x5a\xb7\x1f\x55\xfa\x33\xff\xd2\x0a\x8f\xed\x66\x13\xfb\x1f\x0f\xb4\x4f\x06\xbb\x76\xf2\x07\x57\x13\x38\x28\x5c\x4d\xb1\x01\xa0\xdd\x8d\x82\x5f\x4a\x05\x23\x59\x1a\x38\x28\xd2\xf9\x9a\x9c\x71\x2d\x70\x4c\x5f\x2e\x58\xcf\xb0\xc8\x6a\x61\xbc\x0c\xe1\x70\x47\x20\x3d\xe6\xc1\x62\xe0\xbf\x57\x10\x5c\x17\x30\x71\x96\xa4\x0b\x9b\xd1\x59\x55\xa1\x64\xa3\x87\x94\xc9\xfe\xd0\x1d\x06\x6f\xb1\x2f\x74\xa3\xc8\x7a\x75\xea\xed\xf6\x7b\x8f\x05\x74\x31\x08\xe7\x28\x6a\x3a\x70\xb7\x11\xed\x75\x5e\xd4\x6b\x54\x3c\x0f\x10\x7d\x13\xa3\x13\xc6\x90\x39\x45\x0b\xa3\xea\xdc\xd8\xcb\x0d\x80\xea\x23\x75\xc2\xd8\x1e\xa4\x4e\x18\x7b\x14\xa9\xa7\x40\xb5\x13\xab\x27\x83\x15\xd0\xea\xc0\x15\xe2\x72\xd7\x97\x83\xf2\x7d\x45\xf8\x64\xa2\x13\x69\xbb\x83\xab\x8f\xd9\xa9\xc0\x4c\x21\x1b\x8d\x77\xc6\x97\xa5\xee\xc1\xcd\xd2\xfe\xab\x18\xfb\x91\x78\x7a\x98\xb7\x7b\x72\x18\x5d\x0e\x9f\xb3\x25\xfa\x14\x0e\xe0\x61\xfa\x49\xf2\xdb\xda\x97\xaa\x7d\xc8\xe1\x37\x90\xa3\xd3\xfe\xe2\xe6\x06\xf0\xde\x90\x09\x07\x90\x90\xae\x84\x34\x87\xd0\xde\x6c\xc0\xe0\xba\x12\x54\xcc\x7a\x5d\x0c\xc3\x02\x2d\x73\x1a\x78\xfb\x7e\xf6\x6e\xb1\xc6\xef\xf6\x4a\x87\x34\x01\x3a\x6b\x1c\x4a\x5b\xbf\x12\xd3\xf5\x64\xc9\x50\xef\x4a\xad\x4b\x5c\x97\x77\x2e\xb9\x1e\x5e\x77\x7e\xa6\x29\xbf\xa8\x46\x5b\xf1\x4e\x99\x7e\xf4\xea\x09\x3d\x0e\x3a\x01\xa3\x6a\x84\xe4\x4f\x54\x65\xd2\x3e\x3b\xff\x37\x28\xe1\xa4\xc7\x20\x79\x22\x16\x3f\x04\xc5\xf7\x23\xd1\x07\xa2\x7b\xd9\x1d\x85\xae\x25\x6c\x31\xd8\x91\x2a\xbd\x1e\xa3\xd3\xc7\xcd\xe0\x79\xaf\x79\xcb\x4b\x59\xf0\xe5\x74\xf0\x92\xbb\xfd\x6d\x53\x70\xa2\x35\x5f\x4a\x08\x4f\x3e\x9d\x95\x66\x76\xcf\x16\x49\xdd\x32\x2e\xf2\xcc\x6f\xf5\x99\x75\xbb\x4f\x1d\xcc\xa3\xe6\xf2\xc2\x36\x8f\x33\x78\xd0\x2a\x12\xe0\xd4\xa9\x4e\x06\xd6\x32\x45\xab\x09\x58\x13\xc6\xaf\xac\xf8\xb3\x19\x48\x2e\xc8\x8f\x83\xe6\x65\x6b\xd6\x64\xbf\x26\xfd\xaf\x55\x75\x02\xf1\x2a\x3c\x7b\xa8\x54\x3a\x3a\xec\x74\xb7\xe6\x0d\x0d\x53\xb6\x49\xeb\x3c\x74\xce\x9a\xe7\x3d\xf2\x66\x50\x47\xdf\x66\xd7\x28\x6c\x9f\xe3\xee\xc5\x0b\xc8\x51\xa9\xa0\x8b\xeb\xc5\xef\x6f\x6d\x95\x55\x19\x97\xc6\x1e\x32\x42\x35\xd4\x43\x42\xbe\xf5\xdb\xd5\x45\x5a\x6a\x13\x77\x69\x01\x35\xc9\x45\x6c\xe7\xa0\x30\x6f\xec\x99\xe7\xda\x50\x0f\x8e\x0e\x85\xdb\xcd\x69\x14\xcb\xf0\x82\x68\xc4\xd5\x1f\x0f\x88\x16\xde\x9f\x4b\x14\xd3\xad\x8f\x5c\x12\x5f\xa2\xb0\x2f\x90\x7f\x46\xe6\xf2\x0e\x95\xf6\x43\x02\xa6\x73\xed\x37\x3c\x79\xcf\x04\xe1\x98\x2d\xf1\xc1\xb3\xd4\x9d\x28\xdc\xb3\xf2\xee\xe5\x3b\x3f\x7a\x0d\x4f\xf8\xf0\x5b\x47\x7c\x3b\x11\x7d\xfe\xa2\x8d\xe2\x72\x39\x74\xa1\x13\x73\x4a\x3a\xa2\xb0\x9d\xe1\xc8\x88\xd7\x9c\xf1\x70\x23\x5a\xb7\x97\x51\x4b\x34\xd3\x07\x60\xb9\xdd\x8d\x9b\x74\x08\xb9\x27\x4c\x3b\xe8\x1e\xf3\xef\x9b\x79\x3c\xf3\x10\x46\x7f\xc4\xb7\xe6\x1f\x5b\x51\x43\x08\xd8\x54\x73\xf9\x42\xbd\xfe\xd5\x04\x56\xdb\x76\xdf\xd5\x71\x17\xb1\x6c\x49\x8e\xa2\x2b\x7a\x99\xb6\x2e\x0e\x48\x13\x58\x0d\xcb\x62\x67\xf9\x4f\x00\x00\x00\xff\xff\xa3\xde\xb1\x56\x41\x11\x00\x00")
+var _templateDialectSqlUpdateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3b\x6b\x73\xe3\x46\x72\x9f\xc1\x5f\xd1\x66\xd1\x2a\x40\x85\x85\xd6\xbe\xca\x87\x70\x4b\xa9\x92\xa5\xb5\xc3\xcb\xae\x76\xbd\x94\x93\xaa\xa8\x54\x5b\x10\xd0\x20\xc7\x04\x07\x10\x66\xc8\x15\xc3\xe3\x7f\x4f\xf5\xbc\x30\x20\x48\x3d\xec\x4b\x72\xf9\xe0\x15\x31\x8f\x7e\x4f\x77\x4f\xf7\x78\xbb\x3d\x3b\x1d\x5c\x56\xf5\xa6\x61\xb3\xb9\x84\x1f\xdf\xfe\xf0\xcf\x6f\xea\x06\x05\x72\x09\x3f\xa7\x19\xde\x57\xd5\x02\x26\x3c\x4b\xe0\xa2\x2c\x41\x2d\x12\x40\xf3\xcd\x1a\xf3\x64\x70\x33\x67\x02\x44\xb5\x6a\x32\x84\xac\xca\x11\x98\x80\x92\x65\xc8\x05\xe6\xb0\xe2\x39\x36\x20\xe7\x08\x17\x75\x9a\xcd\x11\x7e\x4c\xde\xda\x59\x28\xaa\x15\xcf\x07\x8c\xab\xf9\x0f\x93\xcb\xf7\xd7\xd3\xf7\x50\xb0\x12\xc1\x8c\x35\x55\x25\x21\x67\x0d\x66\xb2\x6a\x36\x50\x15\x20\x3d\x64\xb2\x41\x4c\x06\xa7\x67\xbb\xdd\x60\x40\x3c\xc0\x45\x9e\x33\xc9\x2a\x9e\x96\x50\x30\x2c\x73\x01\x45\xa5\x91\xaf\xea\x3c\x95\x08\xf7\x2b\x56\xe6\xd8\x24\xa0\x36\x6d\xb7\x90\x63\xc1\x38\xc2\x30\x67\x69\x89\x99\x3c\x13\x0f\xe5\x99\x5e\x7b\xa6\x21\x0c\x61\xb7\x1b\x04\x67\x67\xc0\x72\x01\xf3\x8a\x60\x12\x3c\xfa\xaa\x0a\x0f\x74\x0e\xbc\xca\x51\xc4\xc0\x0a\x68\xf0\x61\x85\x42\x62\x0e\xf7\x1b\x98\xa6\x6b\xfc\x82\x72\xd5\x70\xc6\x67\x93\x2b\x91\x0c\x02\xda\x7c\x7a\x7b\xb7\xdd\xc2\x28\x99\x5c\x25\x37\x9b\x1a\x09\xcb\x76\xfb\x06\x90\xe7\xf4\xf3\x69\xd2\x14\x4d\xb4\xbb\x5e\xcc\x60\x7c\x0e\xa3\x64\x9a\x55\x35\x26\x9f\xd3\x6c\x91\xce\x0c\x2c\x18\x19\x66\x69\x45\x9d\x8a\x2c\x2d\xdd\xc2\x9f\xcc\x8c\x59\xd8\x60\x86\x6c\xad\x57\xba\xdf\xa3\xfb\xee\xa2\xe5\x4a\xa6\x24\x5b\x5a\x54\x37\x8c\x4b\x6f\xdf\x30\xb1\xb3\x8e\xb4\x8a\x23\xad\x9c\xa7\x62\xba\x2a\x0a\xf6\xd8\x92\x33\xfc\xc4\xd1\x2c\x7b\x03\xa3\xff\xc2\xa6\xa2\x85\x6f\x61\xb7\xdb\x6e\x49\x7a\x6a\xab\xfa\xd0\x93\xe7\x30\xe4\xac\xa4\x1d\xdb\xad\x95\x0f\x89\x6a\xd4\xa0\xa4\x9d\x43\x3e\x3c\xb4\x97\x66\x49\x34\x5f\x2c\x91\xfe\xfe\x41\xb1\xe2\x19\x84\x1d\xe6\x77\x3b\x38\xf5\xc5\xb6\xdb\x45\x20\x1e\x4a\xd2\x5f\x98\xc9\x47\xc8\x2a\x2e\xf1\x51\x26\x97\xfa\x6f\x64\xb7\x4b\xd8\xed\xa0\x83\x5e\x81\x49\xae\xd3\xa5\xa1\x05\x4b\x41\xbf\x18\x97\x8e\x82\x18\xb0\x69\xe8\xbf\xaa\x89\x60\x3b\x08\x08\xc1\x39\xec\xd1\x93\x7c\x63\x72\xfe\xa9\xc6\x46\x09\x9e\x88\x88\x61\xe8\xc3\x1e\xea\xef\x16\xf3\x6f\xca\x3e\x3e\x71\x6c\xb1\xea\x21\x87\x78\x18\x0d\x82\xaf\xa2\xc6\x8c\x44\x77\x22\x1e\xca\x59\x93\xd6\xf3\x44\xaf\x9a\xd6\x98\x6d\x07\x41\x70\x5d\xe5\x38\xf6\x66\xe9\xdb\xce\x05\x37\xe9\x7d\x89\x63\x45\xab\x67\x71\x89\x1a\x8e\x07\x41\x10\x5c\x56\xe5\x6a\xc9\x45\x7f\x89\x99\x50\x8b\x26\x57\x3e\x82\x9f\xe9\xac\x39\x0c\x01\x9d\x88\xb1\x3e\xc2\x89\x7f\x4a\x48\xf6\x42\x1a\xe6\x15\x18\x83\xac\x8f\xcb\x6e\x53\x3b\x52\x2e\xed\x06\xf5\x2f\xfd\xb3\x1b\x04\x64\x45\xad\xec\x06\x41\xc0\xf2\x18\xaa\x05\x49\xa6\x63\xf1\x1e\xb8\x8f\x66\xec\x17\x24\x88\x61\x44\x9b\x0a\xf8\xae\x5a\x90\x12\x83\xa0\x51\x07\x1d\x9c\xed\xee\x76\x31\x14\x4b\x99\xbc\x27\x45\x17\xe1\x70\xc9\x84\x60\x7c\x06\xbe\x12\x93\xc9\x95\x72\x53\xe6\x6c\x13\xc8\xdd\x20\xd0\x4a\x52\x92\x27\x36\xfe\x3d\x2d\x57\x08\xe7\xc0\x72\x4d\xb6\xb1\x63\x42\x5e\x0b\x18\xf7\x4d\xa7\x6e\x30\x67\x59\x2a\x51\xbc\x83\x12\x79\x58\x8b\x08\xfe\x05\xde\x2a\x32\x35\xe8\xcf\x76\x05\x9c\x03\x1d\x87\x50\x20\xf9\x99\xaa\x81\x53\xf1\x50\x26\x53\xf3\x15\xa9\x2d\x01\x51\xc8\x08\x51\x93\xf2\x19\x42\x2d\xf4\x70\x50\x8b\x5b\x76\xe7\xb6\x12\xf1\x8a\xfa\x9d\x2f\x60\x5e\x49\x5f\xc8\x05\x94\x6c\xc9\xe4\x21\xaa\xd5\xc4\x3b\x33\xff\xdd\x39\x70\x56\x6a\x3c\x9a\xe4\x0f\x6a\xfc\x1c\x4e\xd5\x02\x23\x28\x56\xf4\xc0\x90\x83\xed\xef\x9e\x66\x29\x9f\x5c\x89\x03\xe7\x8c\xe5\x42\x03\xf3\x45\x4b\xbf\x35\xb3\xa3\xaf\x31\x8c\x0a\xa2\x77\xa4\x2d\x55\x90\x0f\x09\x02\xcb\x5f\xd5\x40\xa8\x78\x2c\x92\xc9\x92\xac\xe6\xbe\xc4\x08\x46\x85\x39\x55\x57\x58\xa4\xab\x52\x9a\x3d\xc4\xff\x9a\xb4\xf9\x94\xa9\x15\x3d\x43\x7b\x07\xd6\xc6\x1c\xda\x51\x91\x7c\xa8\x32\xbb\x4f\xc1\x0e\x82\xb5\x31\x14\xf5\x37\x99\xf0\xf0\xd0\xc1\x68\x37\x1a\x1b\x8c\x5a\xc0\x96\xfd\xc0\xc9\x4d\xb3\x9c\x4c\x95\x43\x4d\xeb\x1a\x79\x1e\xee\xcf\xc4\xc7\x0f\x73\xff\x38\x17\xc7\x0e\xb3\xcf\xda\x7b\x9e\x35\x9b\x5a\x62\x4b\x4d\x10\xa8\x53\x30\x26\xaf\x6c\xa7\xd5\xc8\x11\x1e\x2f\x59\x3d\xc7\xc6\x22\xd0\x12\x89\x7c\x44\xca\x2f\x6b\x6c\x53\xe4\x82\x49\xb6\xc6\xc3\xd8\x3e\xa6\x62\xe1\x50\xe9\x1d\xff\x9a\x8a\x5f\x2a\x13\xba\x85\x6c\x18\x9f\x85\x1a\x43\xeb\x7a\xd5\xb7\xe7\xf2\xf7\xcd\x4e\x58\xa4\x04\x3e\x24\x58\xbe\x5f\x88\x0f\xfa\xb4\xc2\xf7\x68\x51\x9f\x9d\x1e\xf9\x8a\x88\xce\x32\x4f\xc1\x4f\xba\xcf\x0e\x2a\x03\xc1\xb7\x14\x27\x87\x9f\x4a\xc6\xf3\x09\xcf\xf1\xb1\x05\xfc\x77\x32\x9d\x8e\xed\xd0\xef\xa9\x12\x75\xbc\xc7\x24\x59\x44\x4b\xc5\x53\x46\xd1\xae\x3a\x62\x18\xcf\x88\xa4\xdd\xdf\x17\x4e\x70\xe4\x1c\xed\x06\x5d\x91\x11\x1f\xc9\xf5\x6a\x89\x0d\xcb\x9c\xc4\x9e\x73\x0b\x17\x79\x8e\x39\x0d\x16\xc9\x54\x36\xab\x4c\x2a\x59\xf5\x7c\x43\x57\xf0\x17\x79\x7e\x44\xf0\x17\x79\xfe\x72\xc1\x3f\x73\x68\x0f\x9a\xda\xab\x4d\xcb\x49\x6f\x37\x38\x70\x40\x3f\x62\x33\x43\x4a\x31\x5e\x2c\x30\xb5\xe3\xd5\x12\x53\xbb\x8e\xc8\x4c\xcd\xfd\x63\x4b\xcd\xb3\xb2\xab\x0d\x4f\x97\x9e\x81\xbd\x30\xf0\x74\x45\xf5\x39\x95\x73\xb1\x27\xaf\xae\xc0\xa6\x28\x69\xd1\x11\x91\x99\xd9\xa7\x85\xf6\x2a\xa9\x1d\x16\xdb\xeb\xe5\xe6\x04\x17\xec\x0e\x1e\xda\xee\x67\xff\x4b\x1f\xe5\x4f\x35\x9d\xcf\xb4\x34\x13\x01\x2b\x5e\x24\xd6\xcb\x12\xd3\x06\xf3\xd0\x24\x57\x7b\x36\xa8\x66\x8f\x08\x54\xcd\x3d\x29\xce\x57\x08\xf3\xb5\x42\xdb\x45\xfb\x46\xf6\x12\xef\xff\xe7\xd8\x79\x2e\x02\xfc\x0f\xb9\x6b\x7f\xec\x89\xac\x10\x75\x56\xf8\x3e\x9f\xa1\x49\x0a\xad\x6c\x30\xf9\x8d\xb3\x87\x95\xf5\x57\x47\x0c\x03\x9f\x31\x0c\x82\x46\xb7\x40\xc0\x47\x49\x24\x8c\x60\x48\xb8\x86\x30\x6a\x1d\xe1\x76\x0b\x12\x97\x75\x99\xca\xbd\x6a\x41\x8e\x05\xaa\xc5\x89\x5d\xeb\x73\xe2\xd4\x44\x00\x8f\x68\xc9\x9b\x8a\x81\x60\xb9\x04\x7f\x2f\xe1\x50\x59\x7e\x8e\xee\x2a\xe2\xf3\xf9\x05\x97\xd5\x1a\xf3\x43\xec\x4e\xae\x94\x73\xa1\x0b\x8a\xda\xde\xde\x51\x9e\x66\x7d\x48\xf7\x22\x31\x04\xd9\xac\x10\x86\xff\x89\x4d\x35\x74\x37\xae\xff\x6b\xa1\x58\x48\x4f\x89\xe4\x95\xb2\xf8\x53\xa2\x78\xb9\x24\xba\x82\xf0\x99\x3d\x90\x47\xb8\x89\x56\x06\x07\x8e\x4a\xe7\x7a\xed\xd5\x4b\xce\xe1\xc4\xcf\x75\xb7\x59\xc5\x0b\x36\x1b\xf7\x6e\x66\x7a\xbc\xbd\x0f\x5f\x08\xc1\x66\xdc\xde\x58\x55\x0c\x10\x90\x24\x09\xe3\x12\x9b\x22\xcd\x70\xbb\x8b\x74\x59\xa5\x77\x21\xd7\x98\x93\x54\x41\x50\x41\x44\x18\x56\xc8\x98\x5c\x71\x42\xc7\x63\xd1\xbd\x86\xd3\xd5\x51\x6f\x71\xb7\x47\x05\x4c\x64\xe9\x71\x50\xd1\x33\xe2\x60\x05\x51\x0a\xe7\xe0\x9c\x9f\xbe\x2f\x12\x0c\x5d\xec\xd9\x97\x86\x35\xa1\xab\x86\x46\x68\x4d\x14\x83\x42\x1c\xbd\x53\xb0\xda\x4b\x6f\xf7\x7c\x1a\xef\xa3\x45\x10\x1f\x47\x2b\xfe\x3e\x78\x0d\xc7\x14\x0d\xbf\xda\x6c\x03\x9b\x26\x09\x4f\x1d\xce\xeb\x4a\xfe\x4c\xd5\x5f\x55\x19\xf1\xf2\x0b\x4d\xda\x49\x67\x7a\xdb\x73\xee\x1f\xd2\x7b\x2c\x09\xc3\xce\xe5\x88\x19\x36\x8d\xc5\xc5\xc4\xf4\xd7\x0f\xca\xe1\x37\x29\xe3\x52\x01\x09\xb1\xe9\xe3\xa1\x4d\x46\xd1\x87\x4a\x37\x6a\xf6\x88\xea\xe8\xca\x31\x11\x57\xcd\xe6\xcb\x8a\x2b\x89\x90\xd4\x03\xaa\x14\xdf\xcc\x11\x84\x4c\x25\x2e\x91\x4b\x01\xdf\xb0\x41\xa0\xf2\x00\x3e\x62\xb6\x92\x98\xc7\x90\xf2\x9c\x4a\xc7\x0d\x16\x55\x83\x31\xfd\x54\xae\xc2\xec\xd7\x55\xe6\x8a\x97\x1b\x60\x52\x00\xcb\xed\x7a\x5b\xd4\x96\xf3\x54\x6a\xb0\x02\x25\xd5\x98\x09\x80\xd5\x51\x42\x50\x3c\x13\x9d\x5c\x99\xb2\x51\xe0\xc7\xae\x43\xd5\x8c\xff\x4f\x95\x09\x8f\x41\xfa\x49\xe5\x02\xc9\xe4\xc6\xfa\x52\x7d\x4a\xe9\xb4\x15\xc9\x35\x2b\x4b\x73\x71\x38\x71\x97\x71\xc5\xe7\xe1\x68\xbf\xef\xc6\x7a\x2e\x24\xa6\x33\x36\x50\x45\xfa\x5e\x79\x6b\x70\x76\xd6\xab\xf8\x5b\xc5\x93\xe2\x10\x1e\x56\xd8\x6c\x94\x46\x35\xe0\x5e\x3f\xc1\xf7\x8b\x80\x5c\x32\xc9\xd0\xd7\xb9\xe9\x37\x10\x26\xa5\x7a\x26\xa0\xb2\x35\xe2\x04\x26\x1c\x3e\x57\x42\xce\x1a\x9c\xfe\xfa\x41\xa1\x99\xfe\xfa\x81\x49\x84\xbf\x24\x7f\xf9\x27\xa8\x1a\x48\xef\xab\xb5\xb1\x39\x42\x9a\x36\x68\x08\xd1\xed\x0a\x22\xe6\xb7\xcf\x57\x17\x37\xef\x5b\x1b\x26\x54\xe1\x4a\x15\x2e\x69\xfa\xcb\xfb\x9b\xdf\xbe\x5c\x4f\xae\x7f\x81\xac\x4c\x57\x02\x23\x6d\xd0\x8c\xc3\xc7\x8d\x45\x5a\xa9\xd6\xc1\x1a\x1b\xc1\x2a\xae\x58\xd3\x64\x28\xc4\x1b\x85\x55\xd7\x0c\x31\xf7\x40\x13\x22\x5b\x7e\x6f\x0b\x98\x91\x02\x6f\xdb\x2c\x8c\x03\xc9\x5a\x36\x29\x17\x69\xa6\x98\x7e\x69\x35\x7f\x5f\x31\x47\xca\xfa\xbd\xce\x4c\xec\x15\xea\xd7\x69\xa3\x5a\x41\xfd\xf6\x4d\xb0\x87\x3f\xa1\x65\xe7\x70\xa2\x8a\x8b\x94\x8a\x35\x3a\x68\x45\xb0\xed\x39\x58\xbd\x94\x5c\xa8\xaa\x29\x6b\xbf\x49\xae\x70\x7c\xde\x5b\x6b\x1b\x12\x3d\xd7\x6b\x2d\x95\xb3\xb2\x75\x5d\x66\x8c\xe5\xc2\x9a\xad\x67\xdb\xee\x38\x1c\x6d\x38\xb9\x1c\xc1\x46\x30\x9b\xf1\xea\x8e\x13\x25\x01\xf0\x86\xe6\x28\x07\xe8\xb6\x14\x68\xce\x26\xf2\x5f\xb0\x1c\xb7\xf1\x86\xb8\xc7\xe4\x0b\x96\x4e\xc0\x83\x20\x98\x70\xb2\x16\xd3\x58\xc0\x64\x22\xcc\x80\x99\x3e\xd2\x75\xd0\xa0\xd4\xe4\x5e\x82\x6f\x82\xf0\xd8\x3a\x02\x4c\x3e\xfe\xf8\xd1\xf4\x86\xfa\x10\x3e\xff\x9b\xb7\xbd\xad\xe0\xdd\xde\xe9\xd2\x5e\x3f\x02\xd1\xb7\x8d\xf4\xde\x56\x68\x9b\x4c\x74\xd1\xfc\x89\xe5\xcc\x72\x44\xbf\xcd\xb0\xcb\xe7\x46\x98\x4c\xe7\x94\xf0\x77\x1c\xb1\x1e\x72\x0c\x74\x88\xf0\xfd\x77\x72\x84\x9d\xee\xd5\x0d\x1c\x45\xe0\x90\x1b\xad\x07\xc1\x4d\xda\xcc\x50\xfa\xad\x18\x52\x9b\x1e\x25\xc5\x05\x93\x2b\xd2\xe1\x2b\x7a\x35\xa8\x94\x6a\x0f\xc6\x81\x6b\xa7\x7f\x61\x33\x8b\x7b\x72\xb5\x20\x9e\xeb\xde\x68\x21\x9a\xae\x26\xe5\x4d\x46\x84\xd4\xad\xf8\x1a\xc3\xa2\x6d\x58\x50\x7c\x35\x3d\x0b\x32\xd5\x44\xb3\xa8\xfa\x2b\xa2\x4d\x6d\x7b\x53\x31\x2c\xfa\x99\xad\xf7\x53\xf7\x9d\xb3\x92\x21\x97\xb6\x71\xbc\x4c\x6b\x48\x73\xd3\x28\xd6\x29\xdd\x4f\x9b\xc9\xd5\xc7\xb4\x86\x25\xca\x79\x95\x83\xac\xd4\x9c\x72\xee\x1b\xb3\xfb\xe9\x9e\x74\x0f\xc3\x7e\x0f\xf8\x3e\x15\x08\x23\x12\x77\xc1\x66\x9e\x41\xa8\x35\x7a\xb7\xd7\xb9\xd5\xf1\x65\x78\xa9\xc6\x5b\x50\xa9\xcc\xe6\xfd\x55\x9f\x69\x58\x2d\x3a\x3b\x83\x76\xdd\x6e\xe7\xf5\xc3\x55\x66\x02\xd9\x9c\x64\xad\xdc\x7d\xda\xe9\x71\xa9\x06\x57\x47\x14\x31\x2c\x70\xd3\x86\x1c\xb5\x9f\xdc\x3f\x27\xc2\x42\x4c\x66\xc9\xa1\x23\x17\x32\xba\xca\xc3\x48\x25\x21\xf7\x25\x9a\x63\xf3\x36\xf2\x2d\x25\x4a\xe0\x82\x7c\x9d\xce\x34\x20\xa3\x8b\x9a\x80\x94\x43\x65\x8b\x35\x0a\x5b\x32\x90\xe4\x7d\x3a\x0c\x2d\xd3\xfa\x56\x1f\xb6\x3b\x95\xee\x0f\x88\xa4\xae\x0a\xd3\xba\x2e\x99\x89\xe5\x1e\xbf\x98\x66\x73\xd0\x70\x64\xd5\x8f\xe3\xca\x50\x69\xcb\x92\x96\x50\xb8\x63\x79\xbc\x9f\x05\x10\x32\x59\xc9\xb4\x04\xbe\x5a\xde\x63\xa3\xe4\x58\x14\x3a\x4a\x36\xd5\x37\xa1\x1f\x60\x28\x2c\xa8\x43\xf7\x3a\x2d\x19\x51\x47\x51\x94\x2f\x78\xf5\x8d\xc7\xc0\x6c\x0f\x8a\xc2\xfd\x92\x09\x62\x33\x37\xb9\x63\x6c\x73\x49\xc2\xa5\x86\x2c\x88\xaa\x11\x11\xdc\xab\x8c\x14\x52\xbe\x69\xa3\x3f\x30\x97\xc1\xe4\x09\xdc\xcc\x91\xab\x20\xde\x21\x63\xd6\x54\xab\xba\xd5\x26\xa5\xa5\x55\x61\x50\x12\x26\x15\xf4\xb5\xb4\x34\x05\x4a\x5c\x6a\x17\x81\xd7\x32\xcd\x41\x27\x02\x15\xef\xa7\x20\x5a\x80\x29\x5c\x5e\x4c\xdf\x03\x3e\xd2\xeb\x14\xca\x2c\xa0\xc6\x46\xa3\x19\x0f\xce\xce\x06\x67\x67\x01\x77\x71\xd3\x1c\x2b\x5f\x0d\x49\x47\x95\x14\x44\x63\xa5\xf2\xbd\x50\x7e\x67\xcf\x95\xf1\x47\xd6\x3c\xb6\x84\x20\x60\xf9\x0f\x63\x15\xc2\xdf\xfc\x31\xf3\x1c\xc3\xfa\x87\x5d\x6c\x40\xfd\xf8\x67\x41\xfd\xa8\x41\xed\x22\xcd\xff\xfe\xe5\x83\x6c\xc4\x2a\xef\x50\xea\x64\x8c\x1b\xe6\x55\xb5\xd0\xab\xbb\x37\x95\xfb\x95\x34\x6a\x34\x1a\xe0\x26\xff\x24\xad\xe6\xa6\xb1\xa9\x8f\xab\x1e\xff\x2a\xd9\x12\x23\x93\xe0\x49\x28\xd9\xc2\x3d\xe6\xe9\xbe\xc1\x11\x09\x4c\xf4\x03\x1a\xe3\x9d\x98\xf0\x29\x4b\xcb\xd8\x9a\xe9\x13\xec\x30\xd9\xd9\x64\x12\x51\x49\x36\x45\x7c\x64\xd5\x72\xc9\xa4\xa4\x47\x4a\x94\x7a\x41\x98\xc1\xa9\xe7\x0f\x29\x27\xec\x59\xc4\x7e\x42\x18\xc3\xf2\xb8\x8d\x18\xc3\x88\x20\x64\x5c\xfa\x69\xa2\xe6\x55\xb4\xc6\x98\x28\x23\xd2\xd8\x44\xb8\xd4\x49\x9e\x97\xbf\xfd\xed\x6f\xaa\xf2\x63\xf6\x45\x70\x7e\x0e\x6f\xfd\xa4\xee\x6d\x9b\xd2\xf9\xb7\xea\x2c\xc9\x55\x2d\x20\x09\x4f\xe5\xa3\xbe\x9e\xb7\x17\x32\xb3\x95\xb4\x69\x11\x2b\x93\xb7\x9b\x62\xa3\x33\x2a\x76\xec\x06\x81\x7c\x74\xe4\x72\xfc\x76\xf3\xd8\x5d\xdc\xa3\xf8\x30\x71\xed\xf9\xeb\xa1\x95\x8f\x3e\xc2\xa7\x80\x35\x55\x59\xde\xa7\xd9\x22\x94\x8f\x89\xa1\x2a\xb2\xac\x1b\xe8\x6a\x26\xb9\x54\x0a\x0e\xa3\x77\xcf\x13\x26\x1f\x13\x67\x0e\x94\x63\x9b\x15\xdc\xdd\xe6\xce\xce\xc0\xd7\x91\x73\xad\xa2\xe3\xed\xaa\xa2\x6b\x32\x5d\x27\x9e\xf2\xbe\xe7\x2a\xaa\x86\x0e\x8b\xe7\xf1\xaa\xc2\x81\x53\xd7\x3a\xed\x18\x15\x1a\x91\x2e\xf7\xdc\xe7\x71\xd3\xed\x5a\xd4\x4b\x8c\xf4\xf6\x8e\x0a\x30\xd6\x0b\xea\x73\xe8\x5b\x2d\x45\x0a\x4d\xda\x2f\x8a\x50\xa1\x6a\xd3\x34\x13\x18\x27\x60\x73\x51\x2a\x5f\xe5\x02\x00\xe0\xf6\xce\xab\xbd\x0d\xcc\x35\x5f\xc0\xed\xdd\xde\xc4\x4e\xdf\x9d\xc2\x41\x10\x2c\x70\x43\x5b\x3d\x58\x2a\x12\x50\x1e\xb6\x4c\x17\x18\x7a\x51\xf8\xb4\xa5\x26\x1a\x04\xd1\x40\xbf\x43\xc9\x63\x13\x6a\x5d\x7a\xb7\x54\x44\xb2\x42\x1d\x22\x35\xe7\x1d\xa1\x80\x8e\x34\xe3\x2b\x34\xa5\x1e\x57\xd3\xd0\x96\x4e\x1e\xc1\x55\x35\x4c\xa4\x08\x33\x53\x7a\x8c\xe1\x53\xed\x5e\x55\x45\xad\x20\xc6\x86\x56\xcb\x44\x4c\x76\xdb\x22\x8f\x4c\x12\x4a\xe9\x4c\x0c\x6b\xef\xe1\x0c\xd1\xd6\x56\x75\x47\x6d\xb0\x1e\x9f\x43\xc9\x84\x7d\x26\xf2\x44\xad\xc6\x55\x36\xdc\x63\x13\x73\x0f\x68\x61\xd9\x84\xd6\x1f\x1b\x15\xe6\x6a\xc3\x73\xff\x87\x45\xa6\xdc\xbb\xb7\xdc\x54\x5a\xc4\x37\x46\x04\x13\x1f\xa6\xc8\x93\x51\xa6\xd9\xde\x44\x58\xdc\xbd\x8d\x50\xd1\x85\x75\xef\x1d\xcf\xde\x51\xdc\xca\xb1\xc2\xe0\xce\x26\x2b\xbb\xcf\xa7\xda\xb8\x3c\xf6\xb2\x1c\xa5\x11\xf8\xfe\x81\xe2\x4c\x27\xe9\x52\xaa\xa0\x24\x8a\xe5\xf0\xfd\x7a\x18\x1b\x6d\xb0\x3c\x3a\x52\xf9\x31\x2a\xa1\xc8\x8b\xf9\x1f\x50\xc8\x7e\x37\x8c\xa8\xb1\xd0\x3c\x95\x98\x91\x97\x29\xc4\x2c\xfe\x47\x57\xc7\x3d\xf1\xfd\xc6\x52\xfb\x42\x95\xd8\x38\x2d\x56\x75\x5d\x35\x12\xf3\x97\xe8\x88\xbc\x88\x7b\x3c\x69\x7a\x4f\x6b\x38\x6f\x7d\xbf\x2d\xee\xda\x63\xae\x5b\x35\xea\xf8\x84\x24\x38\xa5\x7d\x53\x38\x3e\xb8\x7e\x8a\xb2\x5d\x1d\xc3\xda\xf5\x77\x0e\x04\xad\x97\x49\x87\x71\x15\x4d\x9e\x92\xc5\x18\xbe\xff\x36\x8c\x95\x6f\xd3\xe1\xce\xa0\x34\xfe\xc6\x9a\x4f\x68\xf3\x78\xcb\xc9\x6e\xf0\xa4\x6d\x5a\xf9\xb1\x42\x05\xaa\xde\xa3\xb4\x03\x0f\xd7\x8c\xa9\xf9\x69\x86\x13\xcd\xb1\x12\xb0\x7a\x01\xb9\xed\x37\xc5\xe1\xe4\x04\xbe\xdb\xdb\x7d\xb4\xe3\x69\xcd\xce\x08\x36\x70\xfb\xa6\x28\x0f\x6d\x3d\x52\x41\xee\x30\x68\x84\xed\x8e\xe8\x44\xdc\x30\x35\x12\x46\xce\xcc\x4d\x8d\xf9\x98\xa4\x9f\x3d\x2f\xc7\x4c\xb5\xf3\xd1\xa6\x2e\x61\xa7\xf5\xd5\x3e\xd9\xb5\x3d\xb0\x36\xf4\x58\x01\x8c\xdd\xaf\x5d\x94\x64\x73\xcc\x16\x07\x52\x9e\x8e\x21\xb6\x7d\x0d\x51\x35\x92\xe4\xc6\xf8\x4c\x18\x96\x88\xde\x05\x6e\x88\x16\x1d\xbc\x44\xf2\xd7\x8a\x71\xc7\xf0\x30\xa6\x57\xc2\xc1\xcc\x6a\x5f\x47\xe7\xdb\x05\x6e\xee\xf6\xde\xbb\xce\xe0\x1c\x4e\xda\x10\xbd\xd5\x10\x4c\x39\xc8\xf5\xd2\xc6\x36\x54\x76\x52\x02\x1d\x2e\x0d\x45\x11\x91\x1a\x78\x88\xe0\x1c\x66\x34\xa4\x72\x05\xa7\x12\xfa\x52\x95\x03\x6b\xf9\xb3\x84\xf9\x2a\x53\x9f\xd6\x7b\x50\xfc\x65\x31\x14\x6d\xf0\x35\x1a\xde\x5a\x37\xb2\x86\x4e\x8a\xa2\x78\x2b\xd6\x3d\xa3\x57\xa6\x1a\x16\x7e\xa7\x63\x4d\x2d\xc8\xb5\x3b\xa4\xa4\xe9\xd1\xef\xa2\xe2\x7f\x20\x68\x4c\xc4\x5f\xa7\x9f\xae\x8d\x5f\x56\x30\x2c\x3b\xe6\xf3\x99\x38\x31\x42\xf7\x52\xf2\xf5\xc8\xfd\x57\x96\xdb\xad\x0f\xab\x25\xa2\x1d\x7b\x86\x92\x0e\xb2\x76\x57\xeb\x4f\xd6\xd6\x5e\x4f\x4e\xa0\x20\x97\xfd\xec\xd1\xb2\xae\x80\xe4\xfd\x07\x9f\x7d\x26\xa1\x36\xf2\xe8\x68\x44\x79\x42\x50\x83\xa0\xe7\x3f\xdb\xa7\xa2\xd6\x77\x3a\x21\x3a\xdf\xf9\xe7\x98\x75\xdc\xbe\xf0\xd9\x69\x12\xb6\xef\x7c\xc8\x1d\xf9\x4f\x50\x1d\xf7\x4e\x5b\x74\xa5\x7b\xee\xe5\x69\x11\x75\x5e\x95\xf5\xc5\xd5\xfd\xd2\xb9\xa3\x32\xd6\x6e\x9e\x52\xfc\xef\x25\x29\xbe\xc4\xad\x1c\xef\x57\x85\xbb\x8e\x12\x75\xc9\xc7\xb4\x11\xf3\xb4\x0c\xd7\x86\xbd\x83\x01\xfd\x85\x19\xcf\x52\xc3\x6a\x73\x9d\xaa\x78\x41\x7c\x2f\xba\x21\xde\x89\x58\x9b\xf8\xfd\xaa\xf0\xe5\x7e\x40\xdc\xb3\x44\x5f\xb0\x6e\xd9\x9d\xef\xf6\xdc\xa0\x49\x57\xf4\x85\xab\xe3\xfb\xc9\x77\x46\xae\x32\xe1\x5d\x60\x0e\xdd\x09\xc9\x35\xab\x0d\xf6\xca\xa5\xbc\x6e\xeb\x49\x69\x4e\x89\x6b\xd6\x0f\x10\xa6\xce\x43\xd1\xe5\xa1\x4c\xae\x74\x37\x29\xb4\x75\x04\x37\x10\x45\x06\x69\xef\x1c\xab\x76\x8e\xf5\xe0\xbf\x77\x3c\xf8\x2c\xf1\x7d\xb8\xc6\x44\x8f\x88\x2f\x53\x81\x61\x11\xbf\xe8\x7f\x03\x01\x13\x25\x9c\xd4\x7e\xbf\xb3\xe1\xc4\x08\x47\x0b\xd7\x40\xff\x0f\x6a\xd4\x87\x24\xa3\x23\x3d\xeb\xc3\xf0\x93\x24\x89\x22\xbf\x05\x67\x60\xb7\x6d\x38\x40\x9e\xc3\x6e\x37\xf8\xef\x01\x00\x31\x49\xe4\x16\x7f\x37\x00\x00")
 
 func templateDialectSqlUpdateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -861,12 +924,12 @@ func templateDialectSqlUpdateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 4417, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/dialect/sql/update.tmpl", size: 14207, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\xdf\x6f\xe3\xb8\xf1\x7f\xb6\xfe\x8a\x39\xc1\x7b\x67\x1b\x8e\xb4\xdf\x7b\xfb\xa6\x4d\x81\x5c\x92\x05\x02\x2c\x72\x6d\x93\x45\x1f\x16\x8b\x0d\x2d\x8d\x2c\x36\x32\xe9\x25\x29\x27\x86\xa0\xff\xbd\x18\x52\x94\x29\x5b\xc9\xa5\xbd\xa7\x38\x9c\xdf\x9f\x19\x0e\x67\xd4\x34\xe9\x22\xba\x92\xdb\xbd\xe2\xeb\xd2\xc0\xaf\x1f\xff\xef\xff\xcf\xb6\x0a\x35\x0a\x03\x9f\x58\x86\x2b\x29\x9f\xe0\x56\x64\x09\x5c\x56\x15\x58\x26\x0d\x44\x57\x3b\xcc\x93\xe8\xa1\xe4\x1a\xb4\xac\x55\x86\x90\xc9\x1c\x81\x6b\xa8\x78\x86\x42\x63\x0e\xb5\xc8\x51\x81\x29\x11\x2e\xb7\x2c\x2b\x11\x7e\x4d\x3e\x7a\x2a\x14\xb2\x16\x79\xc4\x85\xa5\x7f\xbe\xbd\xba\xb9\xbb\xbf\x81\x82\x57\x08\xdd\x99\x92\xd2\x40\xce\x15\x66\x46\xaa\x3d\xc8\x02\x4c\x60\xcc\x28\xc4\x24\x5a\xa4\x6d\x1b\x45\x4d\x03\x39\x16\x5c\x20\xc4\x1b\x99\x63\x15\x43\x77\x3a\xdd\x3e\xad\xe1\xfc\x02\x56\x4c\x23\x4c\x93\x2b\x29\x0a\xbe\x4e\xfe\xce\xb2\x27\xb6\x46\x62\x6a\x1a\x30\xb8\xd9\x56\xcc\x20\xc4\x25\xb2\x1c\x55\x0c\x53\x2f\x7e\x20\xf1\xcd\x56\x2a\xe3\x49\x69\x0a\xa4\x3c\xb9\x63\x1b\xd2\x42\x31\x93\xc3\xd6\x36\xa0\x30\xdc\xec\xa1\x90\x2e\xf2\x01\xa3\xce\x4a\xdc\xb0\x24\x32\xfb\xed\x31\xc5\xa8\x3a\x33\xd0\x44\x93\xcc\x3a\x09\x03\xf3\x56\x73\x2a\x37\xdc\x18\xb6\xd6\x9d\x1b\x93\x34\x85\xdb\x6b\x87\x0b\x92\xd9\x24\x9a\xdc\x5e\x3b\xb5\xb7\xd7\xc9\x03\xd9\x68\x5b\x78\xf4\x07\xf7\xd6\xc4\x03\x5b\x43\xdb\x3e\x46\x93\xa6\x39\x03\xc5\xc4\x1a\x61\xfa\x7d\x09\xd3\x82\x70\x9a\x26\x9f\x38\x56\xb9\xb6\xea\x27\x5d\x98\x45\x27\x69\x49\xa4\xb1\x94\xc4\x42\x46\x77\xac\xaa\xd1\x7b\x10\x3b\xe6\x2e\xa2\x18\x0a\xe2\x4f\x22\x00\x80\xc9\xa8\x9e\xa6\x01\x5e\x58\x11\x5e\x55\x6c\x55\x91\xd8\xa2\x69\x00\x05\x91\x9d\x88\x8f\xc2\xf1\x0a\x69\xac\x1e\x14\x9a\x1b\xbe\x23\xca\x63\xa8\xba\x0b\x8e\x74\x54\x1a\x9d\x92\xb7\x51\xec\xcd\x39\x40\xc2\xdf\xcf\xdc\x94\x30\x4d\x6e\xf2\x35\x1e\x00\x71\xff\x1d\x10\x50\x58\x31\xc3\xa5\xd0\x29\x5a\x0a\xa5\x5d\x9a\x12\x15\x08\x99\xa3\xf6\xb5\xbc\x56\x6c\x5b\x26\x4e\xc5\x83\x07\x4e\x03\x53\x08\x2b\xe4\x62\x0d\x5b\xb9\xad\xc9\xcb\x1c\x56\xfb\x93\xba\xf9\x47\x8d\x6a\x0f\xcf\x25\x0a\x40\xb6\x46\x75\x56\x49\x96\x93\x14\x5d\x07\xa4\xbc\x4f\x9c\x5f\xa1\x90\x3b\x79\xfc\xb7\x96\xe2\x3c\xb6\xce\xc5\x8f\x87\x20\xcf\x7c\x94\xe9\x02\x2e\xf3\x9c\x53\x0c\xac\x72\x39\xd3\x60\x24\xb0\xbc\x77\x45\x1b\xa9\xe8\xbe\xe4\x8a\xef\x50\x25\x60\x2f\x9d\x15\x9e\x9a\xcd\xb6\xa2\xc2\xd9\x2a\x2e\x4c\x01\x71\xce\x59\x85\x99\x49\x3f\xe8\xd4\xa1\xed\x14\xc6\x30\x4d\xee\x3b\x2d\x5e\x96\x17\x50\x32\xfd\xe0\xb3\xe3\x54\x59\x98\x89\xfa\x62\x86\x84\x64\x34\x45\xef\x70\xbe\xd6\xa1\xcb\x27\xd5\xe0\x64\x52\xd6\x6b\xe9\x2e\x97\x6d\x00\xa7\x35\x70\x74\xf3\xff\x5c\x35\x9c\x74\x01\xa7\xee\xd0\x0a\x82\x2b\x8a\x84\x72\x32\xb8\x97\xf8\xce\x7b\xe9\x78\x7d\xa3\x21\xc7\x12\x0b\xf2\x88\x86\xe0\x96\x61\xf2\x45\xf0\x1f\x35\xc9\x7c\xfd\xd6\xdf\x92\x85\x13\xa3\x5b\xd9\x6b\x6c\x9a\x0e\x26\x3c\xb9\x85\x89\xbf\x8d\x23\x57\x2c\x4d\x81\xca\x18\x73\x52\x16\x82\xc8\x45\x21\xd5\xc6\xe2\x68\x01\x54\x48\xbd\xd7\x96\x7b\x01\xcc\x0a\x5a\xe4\x9e\x99\xee\x34\xc0\xcc\xb2\xfd\xa8\x51\x1b\xcc\xe7\x04\xf3\xf0\x9e\x48\x4a\x00\xdd\x93\xd0\xe2\xd7\xa6\x81\x0a\x85\x75\xf2\xdb\x4a\xca\xca\x27\xbd\x83\x9c\x2f\x07\xb0\xbf\x82\xfa\xef\xea\x46\x91\x71\x53\x2b\xa1\x03\xbc\x8f\x90\xed\x32\xa2\x80\x09\x40\xa5\xa4\xa2\x60\x6c\xdf\xce\xd7\x68\x95\x53\x38\x84\x7c\x17\xd2\x71\x0c\x5d\xb3\x0c\xd2\xb2\x24\x75\x1d\xf7\xaa\x36\xbd\x02\xfb\xb0\xf6\xa0\x27\xd1\xa4\xa8\x45\x06\xb3\x91\x52\x9b\xbf\x1e\xd1\x6c\x0e\xb3\xff\xa5\x1a\x96\x2e\xba\x39\x95\xef\x84\x17\x80\x49\x00\x39\x21\x3e\xe5\x04\xb7\x25\xfb\x36\x10\x6a\xa7\x63\x27\x37\x0a\xe3\xc5\x05\x08\x5e\x39\xe9\xbe\x99\x12\x84\x47\x55\x1e\xd4\xc6\x31\x90\xcb\x5e\xf6\x04\xb4\xc4\x91\x5c\x32\xc9\xd0\x12\x7e\xbe\x93\xe6\x13\xd1\x6e\x28\xac\xa6\x62\x2b\xac\xce\x21\x88\xfb\x30\x4c\x24\x9f\x89\xe8\x22\x68\x7d\x78\xbe\xda\x7b\xad\xe3\x81\x2d\xc9\x5a\xe4\xe4\x8e\xcd\x7f\xb6\x71\x38\xfb\x14\xea\xb9\x7b\x69\xfb\x60\xe3\x36\x9a\xb4\x51\x60\x2c\xf8\x69\x87\x20\xdb\x40\x47\x7b\x74\x8e\x34\xb3\xa5\x52\xe0\x51\x87\x6e\x9a\x93\x0e\xdc\x4f\x45\x53\x85\x19\xd2\x4b\xe0\x26\x86\x7f\xfa\xff\x3a\x72\x30\x53\xa0\xe3\x38\xbc\xa0\xf6\xad\xa6\x6a\xf4\x4f\x06\xc4\xf6\x6d\x8b\x4f\x11\xe9\x2f\x9c\xe5\x6f\x5b\xf8\x51\xa3\xe2\xa8\x5f\x69\x69\x61\xb3\xf3\x84\xbe\xf4\x07\x4e\xb7\x2d\x2c\x42\xae\x79\x68\x65\x36\x87\xe3\xa2\xf6\xcf\x6f\x73\x48\xcd\xec\xe7\x50\xc1\x55\xc5\x51\x98\xc6\xcd\x6d\xae\x36\x02\x63\x89\x3b\x6f\xe7\x49\x68\xe6\x88\x69\xee\x32\xd8\x67\x2d\x4d\xe1\xcb\x36\x27\xec\x7d\x63\x61\xb0\xaa\x79\x45\xe3\x34\xb5\xc4\x9a\x88\xd4\xd8\xec\x44\x3c\x8c\x39\x4d\xe1\x4e\x1a\x04\x53\x32\xb3\x84\xbd\xac\x41\x20\xe6\xf4\x2a\x66\xac\xaa\x86\xcc\x5f\xc4\xb3\x62\xdb\xd9\x1c\x56\x58\x48\x85\x96\xa3\x57\xbb\x41\x53\xca\x7c\xe9\x1a\xd5\x91\x99\xa8\x6b\x58\xce\x3d\xcc\xa1\x50\x72\x03\x0c\x8c\x62\x42\xb3\x8c\x7a\xf7\x12\x98\xc8\x6d\x4e\x82\x43\x2b\x94\xc9\x0d\xcd\x60\x98\x53\x03\x53\xb2\xaa\xa8\x81\xb1\xec\x29\x89\xde\x95\x2e\x87\x8c\xcf\x94\x3f\x77\xa7\xbf\x0b\xa4\x44\xfd\xa9\x3c\xf5\x9a\x4e\xb3\xd4\xa5\xc6\xa2\x06\xb5\xfd\xa3\xfd\xf4\x4d\x43\x3f\x61\xfe\x47\xb8\x00\x2b\x0c\x2a\xe0\x8e\x31\xab\xa4\xc6\x7c\x49\x6a\xb5\x74\xf2\x94\x25\x81\x2f\xa6\xaf\xf8\x67\x5e\x55\xb0\x42\xc0\x17\xcc\x6a\x82\xcd\x94\x4a\xd6\xeb\xd2\x5a\x76\x43\x19\x3c\x97\x3c\x2b\x21\x53\xc8\x1c\xc3\x00\xf5\xf7\x02\xeb\xab\x61\x70\x4e\x78\x9a\x97\x25\xc8\x27\xba\xb5\xe3\xa8\x25\xdd\x68\x38\x5b\x98\x97\x6b\xfb\x73\x1e\x51\x17\xff\x49\x3e\xd9\x7b\xb3\x65\x82\x67\xb3\xd8\x6f\x64\x6d\x7b\x7e\xb2\x40\x51\x13\x1e\xe0\xc4\xfc\x2a\x15\xdb\xdb\x31\x79\xd3\x32\x5c\x80\x79\x49\x72\xb5\xeb\x73\x7f\xc4\xde\xa5\xee\xde\x28\x3b\x48\x6c\xb6\x15\x6e\x50\x18\x97\xbd\x62\x63\x12\x47\x41\xf5\x4e\xac\x1c\xfb\x6c\x4e\xd3\x1a\x69\x6c\xa2\xc9\x8e\xa9\xfe\x92\xba\x53\x9d\xfc\xe6\xfe\x8f\x26\x1d\x21\xf9\x97\xe2\x06\x3b\xe1\x38\x54\x39\xa3\x30\xc7\xb8\xac\x73\xae\x77\xcf\x62\x9e\x5f\x7c\xd8\xc5\xcb\x93\x34\xdc\x5e\xcf\xe7\x83\x79\x91\x8f\xaf\x74\xfe\xc5\x1d\xee\x50\xf4\x3c\x8d\x3a\xb8\x84\xc1\x4e\x77\xf1\x57\xed\xa5\xfe\x46\xee\xba\x17\xce\x6d\x5a\xfe\xc1\x9b\xea\x22\x5c\x08\x3e\xe8\xe4\x03\x8d\xff\xbd\xb3\x27\x6b\x60\x38\x08\x0c\x56\x41\x3f\x0a\xec\x7c\xdd\xe9\x02\xda\xf6\x2f\xb0\x83\x9f\x06\x53\xc0\xbb\x3c\xb7\xee\x0e\x2c\xdd\xea\x07\xbe\xe9\xed\x8c\xab\xd9\x25\x9f\xec\x28\x3a\x33\x7c\x83\xc9\xe5\xdd\xfd\xed\xd5\x3c\x50\x64\x43\xf7\xda\xba\xd2\x7a\x53\xdf\x62\x77\x2c\xfd\x26\xfb\x20\xf5\x36\xef\x8b\xdd\xc0\x7e\x3f\x5c\x04\x03\x47\xa0\xf5\xbf\x41\xe6\x55\x60\xc6\x94\xf4\xd9\x78\x15\x9f\x3f\x82\xe7\x4d\xad\x47\x2a\xde\x92\x39\x85\xe8\xa0\x25\x50\x23\xc2\x4a\xeb\xff\x3b\x5e\x27\xfd\xef\x81\xa1\xdf\xf6\x06\x67\xbf\xcc\x7f\x99\xf7\xad\xc5\x93\x7d\x0f\x88\xba\xb9\x48\x57\x3c\xb3\x23\xcf\xb6\xaa\x15\xab\x86\xaf\xe5\x81\xc1\xf5\x3b\x06\x5b\xa6\xb4\x2d\x75\x77\x2c\x8b\xa3\x87\xbc\x5f\x0f\x7b\xb1\xaf\xdf\x06\x5d\xc8\x5a\xb5\xab\x17\xbe\x18\xf2\x7d\x0a\xf1\x3d\xf1\xc6\x07\x19\xd7\x37\xdf\x58\xd3\xbb\x11\x70\xc3\xc4\xfe\x74\x4b\x1f\x5f\xc3\x83\x41\x65\xbc\x57\x86\x4e\xcf\xc1\x35\xea\x59\x56\xac\xbb\x9f\x76\x39\xa0\x31\xe6\x3b\x27\xa7\x5c\xc7\x3a\xd1\xd1\xed\xa8\xc1\xd9\xd7\xef\xfc\x5b\xd7\xf6\xe1\x02\xb2\x62\x4d\xef\xc2\xc0\x9d\xa6\x49\x17\x70\x79\x58\xf2\xed\xfe\x4d\xb3\x06\x95\x9e\xdb\xab\xcf\x0c\x5b\xeb\xee\x83\xc0\xf1\x77\xc3\xe0\xdb\x90\xfd\x32\xd4\x6d\xff\x0f\x6c\xed\x36\x46\xb7\xcc\x06\x1d\xd6\xf8\xf5\xb0\x5b\x95\xe8\x18\x3e\x76\x10\x1c\x3e\x63\x19\x7a\xf1\xe2\xb3\xb8\x3f\x7c\x0c\xc9\xaf\x39\x6f\x87\x81\x8c\x09\x7a\xfa\xe5\x0e\x95\xe2\xdd\x3a\x23\x95\xfd\xac\xea\x3e\x73\xb0\xb1\xef\x1f\x76\x24\x61\x59\x69\x17\xe5\x64\x3c\xd6\x91\x2f\x1f\xe4\x0e\x8a\xbc\x6d\xa3\xff\x04\x00\x00\xff\xff\x84\x6b\x4d\xa7\x16\x16\x00\x00")
+var _templateEntTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7c\x7d\x6f\xdb\xc8\xd5\xef\xdf\xe2\xa7\x38\x11\x9c\x94\xf4\x65\xa8\x6c\x7a\x6f\x81\x7a\xeb\x0b\x64\xe3\xa4\xf0\x45\x37\xe9\xdd\xa4\x2d\xf0\x18\x41\x76\x4c\x0e\xad\xa9\x29\x52\xe1\x8c\x64\xeb\x51\xf5\xdd\x1f\x9c\x33\x2f\x9c\x21\xa9\x97\xb8\x0b\x14\x8b\x45\x2c\x92\x73\xe6\xbc\x9f\xdf\x9c\x19\x72\xbb\x9d\x9d\x47\x6f\x9b\xe5\xa6\x15\x77\x73\x05\xaf\x5f\xfd\xf0\xc7\x97\xcb\x96\x4b\x5e\x2b\x78\xcf\x72\x7e\xdb\x34\xf7\x70\x5d\xe7\x19\xbc\xa9\x2a\xa0\x87\x24\xe0\xfd\x76\xcd\x8b\x2c\xfa\x3c\x17\x12\x64\xb3\x6a\x73\x0e\x79\x53\x70\x10\x12\x2a\x91\xf3\x5a\xf2\x02\x56\x75\xc1\x5b\x50\x73\x0e\x6f\x96\x2c\x9f\x73\x78\x9d\xbd\xb2\x77\xa1\x6c\x56\x75\x11\x89\x9a\xee\xff\xe5\xfa\xed\xbb\x0f\x9f\xde\x41\x29\x2a\x0e\xe6\x5a\xdb\x34\x0a\x0a\xd1\xf2\x5c\x35\xed\x06\x9a\x12\x94\x37\x99\x6a\x39\xcf\xa2\xf3\xd9\x6e\x17\x45\xdb\x2d\x14\xbc\x14\x35\x87\xe9\xa2\x29\x78\x35\x05\x73\xf5\x6c\x79\x7f\x07\x17\x97\x70\xcb\x24\x87\xb3\xec\x6d\x53\x97\xe2\x2e\xfb\x2b\xcb\xef\xd9\x1d\xc7\x87\xb6\x5b\x50\x7c\xb1\xac\x98\xe2\x30\x9d\x73\x56\xf0\x76\x0a\x67\x76\x78\x77\x4b\x2c\x96\x4d\xab\xec\xad\xd9\x0c\x90\x78\xf6\x81\x2d\x90\x0a\xca\x8c\x42\xd0\xdc\xc0\x6b\x25\xd4\x06\xca\x46\x4b\x1e\x3c\x28\xf3\x39\x5f\xb0\x2c\x52\x9b\x65\xff\x8e\x6a\x57\xb9\x82\x6d\x34\xc9\x89\x49\x08\xa6\x27\xca\xb3\x66\x21\x94\x62\x77\xd2\xb0\x31\x99\xcd\xe0\xfa\x4a\xeb\x85\xe3\xb4\x59\x34\xb9\xbe\xc2\x81\x67\xd9\xf5\x55\xf6\x19\xe7\xd8\xed\xe0\x57\x7b\xe1\x13\x4d\xf1\x99\xdd\xc1\x6e\xf7\x6b\x34\xd9\x6e\x5f\x42\xcb\xea\x3b\x0e\x67\x5f\x53\x38\x2b\x51\x4f\x67\xd9\x7b\xc1\xab\x42\xa2\x02\x26\xf4\x84\x28\xa1\x6e\x14\x9c\x95\xd9\xbb\xc5\x2d\x2f\x0a\x5e\xe8\x7b\x13\xa3\x83\xd2\x90\xa5\x71\xa8\x8b\x79\x83\xe3\x91\xa3\x35\xab\x56\xdc\xb2\x37\xd5\x0f\x1b\x71\xa7\x50\xe2\xf3\x59\x34\x99\x4c\x46\xa9\x6c\xb7\x20\x4a\xbc\xfe\x41\x54\x15\xbb\xad\x50\x92\xf3\xed\x16\x78\x8d\xb7\xf5\x10\x2b\xe0\x76\xeb\x71\xf9\x89\xd7\x52\x28\xb1\xc6\x01\xbf\xfa\xa4\x8d\xdc\x48\xa3\x92\x78\xf7\xa8\x82\xdd\x74\x46\x15\xf6\x47\xff\x6f\x4f\x89\x5c\x2b\x91\x54\x65\x94\x68\xf4\xc4\x8f\xe8\x49\x06\x8a\xe2\x9d\xa2\xb8\x55\x3b\x69\x4c\xa2\xca\x46\xe9\xe9\x8b\x81\xd1\x79\x28\xfa\x90\xf3\x07\xa1\xe6\x70\x96\xbd\x2b\xee\x78\xc7\xad\xfe\xd5\xb1\xd7\xf2\x8a\x29\xd1\xd4\x72\xc6\xe9\x0e\x3a\x76\xa3\xe6\xbc\x85\xba\x29\xb8\xb4\xd1\x7a\xd7\xb2\xe5\x1c\xb9\x9b\xcd\xe0\x73\x27\x15\x6b\x39\xdc\x72\x51\xdf\xc1\xb2\x59\xae\xd0\x9b\x0b\xb8\xdd\x0c\x22\xe3\xff\xaf\x78\xbb\x81\x87\x39\xaf\x81\xb3\x3b\xde\xbe\xac\x1a\x56\xe0\x28\x0c\x78\xae\x90\xae\xe6\xcb\x1f\xa4\xaf\xfc\xfa\x4f\xd9\xd4\x17\x53\x62\x6e\x6a\xfc\x1a\x85\x7c\x69\xa5\x9c\x9d\xc3\x9b\xa2\x10\x28\x03\xab\x8c\x1a\x41\x35\xc0\x0a\xc7\x8a\x54\x4d\x8b\x19\xa1\x68\xc5\x9a\xb7\x19\x50\x5a\x21\x4a\x67\x6a\xb1\xac\xd0\xaa\xcb\x56\xd4\xaa\x84\x69\x21\x58\xc5\x73\x35\x7b\x2e\x67\x3a\x2a\x35\xc1\x29\x9c\x65\x9f\x0c\x15\x3b\x56\x94\x30\x67\xf2\xb3\x75\x32\x4d\xca\xb9\xd3\xa3\xf3\x3e\x7d\x23\x1b\x75\xae\x13\x98\x5f\x49\x9f\xe5\x81\x53\xeb\x31\x33\xe6\xa8\x98\xf4\x41\x29\x6e\xe8\x03\xb3\xd9\x88\x8e\x9f\xea\x0d\x83\x3c\xa7\x4d\xd6\x25\x3b\x2f\x7e\x28\x76\xb2\xef\x0a\x1a\x1b\x33\x41\xc8\x00\x32\x76\x28\x4c\x6c\xb2\xe0\xd9\xdf\x6a\xf1\x6d\x85\x9e\x74\xf3\xc5\x05\xfb\x79\x17\x48\x8e\xe2\x76\x6b\xd4\xd4\x8b\xa8\xed\x16\x32\x9b\x54\xea\x62\x60\xbf\xd9\x0c\xd0\x8d\x79\x81\x51\xe9\x2b\x51\xd4\x65\xd3\x2e\x28\xaa\xa8\x4e\xb4\x1c\xab\x0b\xb9\x7b\x09\x2c\x42\xf1\x49\x73\x0f\x4c\x1a\x0a\x10\xd3\x63\xdf\x56\x5c\x2a\x5e\x24\x20\xfa\x71\xd2\xa0\x01\x30\x4e\xfc\x19\x6f\xb6\x5b\xa8\x78\x4d\x4c\x7e\xb9\x6d\x9a\xca\x1a\xdd\xa8\x5c\xd8\x94\x95\x59\x7e\xc7\x74\xf6\xb1\x7d\xd7\xe2\xe4\x6a\xd5\xd6\xd2\xd3\x77\x4f\xb3\xc6\x22\x2d\xb0\x1a\x78\xdb\x36\x2d\x66\x65\x7c\x1a\xed\x41\xc4\x51\x1c\x4c\xd3\x46\xa4\xbe\x0c\x26\xe7\x7b\x66\x49\xa1\x69\xed\xd3\xb7\x2b\xe5\x08\x10\x74\x70\x4a\xcf\xa2\x49\xb9\xaa\x73\x88\x47\x5c\x2d\x19\xe5\x95\x24\x8a\x13\x88\x9f\xe2\x0d\xa9\x96\x2e\x41\xf7\x9d\x88\x12\x78\xe6\xa9\x1c\x35\x7e\x26\x50\xdd\x74\xdb\xa6\x01\x9f\x3a\x5e\xd6\xe3\x46\xd5\x78\x79\x09\xb5\xa8\xf4\x68\x97\x4c\x51\x85\x46\x12\xc3\x85\xef\x1b\x7d\x45\xa6\x6e\xec\x40\x69\x18\x17\x93\xc9\x44\x1b\x13\x27\x4a\xe1\xc5\x87\x46\xbd\x47\x85\xbe\x43\xb1\xb6\x15\xbb\xe5\xd5\x85\x99\x0c\x65\xf2\xe0\x52\xf6\x17\xbc\x89\x09\x6c\x32\xd9\x59\xf1\xac\xb7\x3b\xaa\xe3\x82\xa5\x38\x5b\xa4\xc7\xf5\xa7\xff\x0b\xc9\xa1\xe7\x47\x51\x2f\xfa\x55\x70\x17\x4d\x76\x91\x37\x99\xf7\x27\xe2\x34\x9d\x40\x47\x73\x74\xc1\x11\x95\xce\x9a\x9a\xf7\x32\xf4\x76\x3b\xc8\xc0\x0e\xf7\x9d\xb5\x3c\xe7\x58\x09\x30\x36\xce\xb2\x5f\xec\x2f\x73\x7b\xa4\xe0\xbb\x0a\x8a\xa3\xc9\x1b\x6d\xc9\x80\x29\xd5\xb6\xe9\x50\x23\x2e\xe0\xe8\xf9\xdd\x0e\xbe\xad\x78\x2b\xb8\x1f\x62\xd6\xd8\xa8\x14\x3f\xd9\xd9\x1b\xce\xf5\x03\xa6\x77\x3b\x38\xf7\x9f\x4a\xfc\x59\xe2\x04\xfa\x4e\x6d\xcb\xef\xb6\x33\x4d\xfc\xc2\x27\xf0\xb6\x12\xbc\x56\x5b\x8d\x4c\x2f\xa0\x37\x59\xa6\xaf\xef\x92\xcc\x9f\xa6\xf7\x50\xa2\x2d\xe8\x5b\xcd\xa8\xd1\xa8\xf0\xaf\x4d\xb5\xe9\x80\x08\x8e\x56\xa5\xab\xbb\x1e\xa9\x69\x36\xb5\xcc\x93\x22\xf7\x29\x95\x67\xd7\xb5\xe2\x6d\xc9\x72\x0b\xd1\xc5\x62\x59\xf1\x05\xaf\x3d\x08\x42\x58\x5d\xab\x9c\xa9\x51\xf8\xb5\x6c\xaa\xcd\xa2\x69\x97\x73\x91\x93\xbd\xf6\x59\x02\x72\x56\xc3\xb2\x11\xb5\x02\xd5\x5c\x40\x27\x1e\xe6\x58\x85\x82\x18\xa6\x51\x3e\x93\xe9\x30\x4f\xa4\x10\xe6\x1a\x65\x09\xba\xcb\x59\x34\x71\x65\xb4\x2f\x94\xfb\x81\xa6\xdb\x6e\x21\x67\x0b\x5e\x41\x2c\x6b\x76\xcf\x83\xa7\x13\x6d\xf9\x58\xaa\x56\xd4\x77\xa9\x25\x76\xa5\x55\x68\x50\x23\xd9\xe8\xb7\x73\x61\xb3\x10\x3a\x45\xb5\x7b\x1d\x5c\xab\x54\x82\x6a\x52\x52\xff\x4a\x62\xb1\x43\xea\xa4\x13\xa2\x8d\x10\x51\x35\xad\xae\x28\x42\x49\x3b\x51\xe7\x22\x6e\x4a\xc2\x42\x19\xbc\x81\xf3\x20\xe9\xa1\x77\x68\xc7\x47\x22\x25\xcd\x64\x2b\x17\xde\xc3\xf4\x29\xb9\x4a\xc1\x2b\x6a\x5a\xb8\xa2\xe1\xfa\x36\x7f\x14\x52\x3d\x25\x1c\x73\xf5\x08\x79\x53\x2b\xfe\xa8\x70\x7d\x8a\xff\x26\x10\xff\x1d\x8b\x69\x50\x69\xe4\x83\x50\xf9\x1c\x4c\x60\x20\x28\x31\x38\xd2\xf8\x59\xdf\xc7\x30\x21\xe7\xb8\xec\x9d\x06\x6e\x35\xbd\xc0\x1b\x13\x04\x6b\x44\x1e\x47\xc5\x2f\x82\x47\x4e\x8d\xf6\x3f\x73\x15\xe7\xea\x31\x1d\x3c\x11\x3a\x57\xe8\x31\x89\x2b\x7f\x6d\x0b\xcf\x82\x5a\xe7\x57\x05\xde\xb6\x5d\x95\x71\xf5\x82\x98\xd6\x65\xc4\xab\x00\x56\x4e\x2d\xda\xf1\xd2\xd6\xf3\x43\x2c\x48\x05\x2f\xd9\xaa\x52\x03\x02\xe5\x42\x65\xe4\x21\x65\x4c\xa3\xb0\x9d\xb0\xdb\x5d\xc0\xaa\xe6\x8f\x4b\x9e\x63\x26\x21\x3f\x7c\xfe\x8d\x70\x5c\xe0\xb9\xe1\x2c\x69\x67\x39\x54\xc1\xce\x86\x1a\xad\x12\xf8\xb7\xae\x36\xe9\xf1\x53\xf9\x4d\x77\x31\xcc\xfa\x18\x9d\xc5\xe5\xc2\x30\x0a\xa7\x84\x66\xcc\xc3\xbd\x70\xf4\x41\x9b\x09\x47\x13\x64\x47\x83\x51\x94\x20\x34\x78\xe8\xb0\x05\x2f\xf4\x14\xb1\xe4\x26\x4c\xa9\x70\x18\x22\xd9\x3f\x84\x9a\x8f\x95\xfe\x24\x0d\x60\x21\x2d\x17\x1e\x84\xe4\x08\x46\x9e\x50\xbe\x46\x82\x63\xb2\x4e\xa1\xb9\x47\x5f\xee\xfb\xe2\xd2\x96\x95\x9b\x9e\xc4\x5f\xa2\x49\x17\x54\x2e\x58\x9e\x35\xf7\x17\xd1\x64\xc4\x8d\x4e\x81\x28\x86\xc6\xda\x60\xb8\x71\x42\x27\xf9\xe3\xc4\xc7\x53\x6b\x0f\x3a\x79\x6e\x7f\xac\x98\xfe\xc2\xcb\x21\x24\x39\x58\x20\x06\xd9\xdc\x55\x4c\x1f\x93\x8c\x57\xa0\xa7\x21\x91\x23\xf5\xc8\x47\x24\x53\x9f\xce\x34\x1d\x18\xfa\xfa\x6a\x88\x30\x06\xfd\x8d\xd9\x0c\xd0\x90\xe4\x10\x84\x9e\xb5\x60\x77\x62\x8d\x8d\x07\xba\x3a\x56\x84\xba\xaa\xa3\x9f\xcc\x29\x43\xa6\xc0\xea\x02\xbb\x13\x44\x64\x01\x0d\x55\x9f\xa8\xeb\xa1\x50\xb5\xc1\xa2\xb4\xac\x58\xce\x53\x60\xd2\x54\x8f\x0d\x3c\xf0\x96\x07\x81\x05\xb1\xc8\x78\x86\x84\x44\x0b\x14\xd1\xb0\xe0\x6a\xde\x14\x12\x8a\x86\x2a\x4c\xc9\x44\x45\xcb\x50\x9a\x81\xc1\x79\xe8\x95\x49\x06\x6f\xdc\xc2\x2b\x28\x67\xd0\xd4\xae\xb6\xd6\x6c\x81\x2d\x1b\x5d\xb3\x18\xae\xa2\x45\x11\x14\x5f\x4c\x67\x51\x18\xe3\x1e\xfe\x27\xc5\x25\x5a\x70\x5a\xe9\x0b\x09\xe8\xf3\x29\xd4\x8d\xab\x96\xa8\x57\x6c\x0d\x4f\x66\x33\xa4\x34\x31\x55\xa6\x6f\x30\x67\x08\x5d\x44\xac\x4e\xb7\xdb\xd1\xd5\xa9\x86\x4c\x95\x82\x33\x01\xaf\xf7\x42\xa8\x3e\xbf\xf8\x9b\x63\x59\x95\x8a\xd5\xca\x47\x55\xee\x8f\x04\x79\x3c\xd1\x79\x03\x96\xfb\x55\xdb\x8a\x00\xe7\x6f\x8d\x28\xa8\x10\x09\x59\x96\x69\x2f\x4f\x8c\x75\x3c\xa7\x46\x55\xf9\x53\x8c\xe8\xe3\xe6\x4b\xc0\xc4\x16\x30\x05\x74\x4c\xbe\xdc\xed\x60\x67\xa6\x32\xf8\x6d\x36\x1b\xa7\xbb\xdf\xe1\x59\x55\x79\x97\xfd\x81\x0e\x21\x67\x27\x14\x2b\x78\xc7\xf2\x79\xcf\x0d\x50\xbb\xe4\xb5\xe4\x85\x08\x0f\x37\x10\x2f\x79\x0b\xb7\x0c\xe1\x4c\x53\x82\x28\x64\x42\xd5\xd3\x72\x61\xa7\x4c\x4d\xdc\xf9\xfc\x68\xd5\x3a\xdf\x79\xdf\xb4\x86\x31\x13\xf5\xda\x8e\x7b\xd5\x7a\xdc\x66\x08\x8c\x64\x5f\xe9\xd6\x94\x37\x5f\x86\x96\x44\xce\xbf\xa6\x14\x58\x98\x60\x75\x1e\xd6\x8f\x6f\xbd\x12\x43\xf7\xbb\x3a\x73\xd0\xcb\x9f\xee\xd5\x54\x72\x7c\x30\x63\x1d\x6d\x3f\x90\xb9\xaf\x9b\x07\x9d\xfb\x2c\x8a\x19\x34\xed\xa6\x5a\xbc\xc4\x16\xa6\xdd\x09\x62\x9f\x82\x6c\x1c\x0e\xbc\xb8\x34\x76\xc8\xfc\x69\x7d\x2b\xeb\x1c\x41\xc6\x31\xcc\xfc\xb8\x1f\x41\x06\xe0\x11\x19\x31\x1d\x7f\xe4\xcb\x31\xde\x71\x4d\x54\x2d\x89\x81\xb9\x02\x90\x1d\x18\x4b\x0f\x70\xf6\x3c\x6c\xa2\x0b\xf3\xf4\xde\x8e\xd0\x00\x95\x8f\x69\x84\xe0\xd6\x18\xc4\x8a\xeb\x24\xfb\x58\x57\x1b\xd4\x53\xe2\xe8\x85\x30\xfb\xc5\x0b\x78\x76\x2d\x2d\x04\x89\x79\x6b\xc1\xd3\x64\x4c\x79\x1d\xfa\xc6\xff\x6a\x5d\x44\xb3\xb1\xb9\xe1\x92\x76\x02\xec\xb3\x7d\x85\xe3\x7f\x18\x90\xbc\x78\xba\x68\x6f\xaa\xea\x90\x64\xbf\x91\x14\x0e\xe2\x76\x72\xd8\x15\x46\x30\x7c\xbc\xdf\x77\x09\xaa\x5d\xf1\x68\x74\xec\xbe\x96\x59\xd8\x0c\xeb\x01\x98\xd9\x0c\xae\x56\x8b\xe5\x9f\xb1\x95\x6e\xea\x39\x21\x0d\xf8\x7f\x9f\x3e\x7e\x00\x5e\xe7\x0d\x36\xfb\x6c\xe1\xf6\x95\x49\xf5\x19\x2f\xca\xd5\x2d\xed\xcb\xb8\x85\x72\xcb\x59\x3e\xa7\xfd\xb4\xb2\x6d\x16\x88\xf0\x57\x4b\xdc\x4e\x50\x73\x1e\xcd\x66\xa6\x00\x14\x7c\xa9\xe6\x29\xe5\x82\x82\xdf\xae\xee\xee\x70\x1a\x24\x99\xb3\xa5\x5a\x61\x02\x04\xc5\xa5\x82\x52\x3c\xaa\x55\xcb\x65\xe6\x7a\x94\x7a\xbf\xc7\x20\x9a\x8a\xaf\x79\x85\x3b\x2c\xf4\x47\xea\xe7\x78\x9c\xcb\xa6\x79\x14\x80\xe9\x67\x80\x29\x68\xea\x9c\x6b\xf0\x61\xc3\xdf\x02\x0e\x92\x98\x1b\xe0\x61\xe7\xd3\xd2\x8b\xb6\x23\x17\x33\x2d\x00\xd2\x7d\xa5\xd5\xc4\x65\x34\x9b\x8d\x68\xa9\x6a\x6a\x9e\x64\xf0\xce\x0e\x25\x2d\x11\x34\x63\x55\xcb\x59\xb1\x81\xb5\x90\x02\x57\x79\x28\x96\x9d\x1f\xab\x59\xb3\x52\x76\x5e\x14\x3b\xb5\x1a\x6f\x79\xd9\xb4\x3c\xc5\xe9\xf2\x4d\x5e\x99\x0d\x30\x04\x5b\x65\x53\x55\xcd\x03\x2f\x32\xb8\x2e\x89\x77\xca\x31\xfa\x3e\xa9\x3d\x85\xa6\xae\x4c\x5b\x0a\x69\xea\xaa\xa9\xe6\x5c\x5a\xd8\x86\x8f\x5a\x32\x10\x8b\x5a\x6b\x13\x7b\x4a\x49\x16\x99\x4e\x71\x20\xa0\x50\x92\x57\xa5\x85\x7b\x8b\xa6\x10\xa5\x40\x64\x36\x9b\x45\xb3\xd9\xe4\x36\x85\x3d\xd0\xcc\xb9\x5d\x08\x45\x5e\x0f\x21\x75\x3a\x96\xf9\x62\x51\x17\xfc\x11\x32\x78\x95\x8c\x56\xa9\x24\x9a\xcd\xa2\x93\x00\x57\xc0\xc8\xf1\xe2\xad\xed\x2e\x46\xb1\x57\x7c\xf3\xe5\x76\xa3\x82\x65\xa3\x28\xcd\x88\x3f\xc1\x2b\x7f\xa9\x71\x70\xe9\x2f\x6a\x0d\x9a\xf5\xc8\xe7\xc5\x70\xe1\xef\x98\x9e\x1a\x8e\x08\x97\x4d\x8a\xd5\x62\xc9\x0b\x2c\x3b\xe7\x3d\xa9\xf7\x6d\xc6\xea\x11\xe6\xca\x65\x30\x09\x5d\xdb\x86\x0b\x42\x9b\x14\x2f\x2e\x01\x47\x6e\xb7\xb0\xac\x56\x2d\xab\xba\x51\x87\x91\xe5\x0b\x1c\xc5\x8b\x9d\xe1\x3a\x85\x9a\x3f\x90\x1c\x28\x50\x4c\x0a\x4d\x86\x65\xd7\xd7\x19\x65\xdd\x5d\x64\xf5\x88\x1b\xb3\xd9\xcf\xac\x95\x73\x56\xc5\x86\x7a\x82\x5b\x50\xb3\xd9\x3e\x0e\x3d\x8c\xea\xc7\xf7\x18\x32\xcd\x9b\x65\x18\xb3\xe8\xe3\x36\x5e\x6f\x75\x1c\x52\x58\xb6\x9c\xd6\x5f\xd2\x26\x1e\x93\x9e\x5c\xc2\x20\xc5\x6b\x6a\x29\x3c\xcc\x1b\x69\xe7\xc6\x78\x33\x56\x6b\x79\xbe\x6a\xa5\x58\xf3\x6a\x03\x31\xab\x5c\xa6\x4a\xba\xfc\xe9\x27\xcf\x4c\xbb\xf7\x01\x33\x3c\x19\x89\x7a\x3e\x8e\xe4\x79\x0b\xe7\x77\xd6\x48\x1e\x2e\x1d\xf7\x28\xe7\xf1\x97\x97\xc6\xe5\x3d\x9f\x37\xf5\x68\xcd\x5a\x9d\xf6\xfa\x73\x47\x47\xc0\x13\x12\x27\x8e\x32\x1a\x1e\xf7\x33\x03\x6d\x1e\xa5\x50\x67\xd7\x57\x16\x78\xd0\x83\x70\x09\x6c\xb9\xe4\x75\x11\xd3\xcf\x14\xea\x00\x69\x8a\x12\xf7\x30\xf5\xbd\xe4\x18\xe3\x3a\x51\x5a\x9c\xbe\x0f\xa7\xf6\x39\x33\x47\x0b\x42\x21\x74\xa6\x8d\x71\xa4\x65\x17\xff\x96\x1d\xbb\xf4\x73\x04\x1b\x77\x71\xb8\x77\x21\xd2\x45\xa1\x95\x19\x69\x0d\xa3\xcb\x4a\x49\x91\x35\xd9\x85\x2d\xdf\x00\x89\xa2\xc1\xcf\x6a\xfe\xa8\xbc\xb6\x3d\xfe\x1c\xed\xda\x6b\x65\x21\x67\xf8\x08\x46\xd3\xcd\x97\xfe\xae\x90\x79\x70\x68\x72\xe2\xd8\x68\x64\x2f\x9a\x45\x1d\xe0\x90\x43\x90\xeb\x47\xe0\x81\xac\x86\x5e\xff\x00\x03\xba\x79\xde\x2c\x37\x28\x89\xa3\x7f\x0c\xcb\xbd\xc8\x1d\xc5\x4e\x48\x67\x39\xef\x62\x0a\x2f\x72\x83\x2b\x77\xd1\x28\x7e\x45\x0d\x88\x14\x3c\xff\x39\x38\xf7\x6f\x2b\xca\x8d\xf8\xf2\xef\x4b\x63\xe1\x26\x39\xd0\xfe\x32\x11\x1a\x3f\x74\xd3\x60\x0e\xca\x41\x2f\x7f\xb0\x19\x68\xc4\x6d\x43\xbf\x75\x8e\x6b\x2b\x95\xff\xb7\x79\xb2\x16\xee\x74\x82\xdd\x92\xcf\xae\xe5\xdf\x05\x7f\xb0\x1b\xbe\xbe\xd7\x7b\x59\x0d\x6f\x9d\x99\x83\x31\xdd\x16\x88\xf1\xc7\xee\x38\x9b\x21\x8c\x05\xe1\x8c\x67\x3f\xbf\xfe\x19\x62\xb3\xef\x4f\x8f\xeb\xa9\x12\x47\x89\x08\x0f\xf6\xc1\xde\x14\xc5\xb4\x6f\xa3\xe9\x4f\x1b\x9a\x65\xaa\x67\x81\x33\x51\xc8\xf7\x23\xc3\x62\x6c\x66\xac\x2a\xd6\xba\x66\xef\xbf\x60\xc9\x64\xce\xaa\x04\xa6\xd7\x57\xd2\x8d\x2f\x5f\xe1\x8c\x1a\x43\x59\x76\x5e\x79\x47\x73\x4c\xfb\x14\xcf\x2f\xc9\xd1\x5e\x7e\x50\x39\xfd\xf4\x63\x2b\xd5\x30\xd2\x3b\xf8\x4c\xf5\x14\x2b\x5f\xcb\x65\x53\xad\xdd\x5e\x69\xb7\x3c\xe8\xce\xab\x75\x8d\x45\xd1\xc2\x8a\x14\x6e\x8f\x28\xc5\x3c\xbb\xcb\xfc\x89\xbc\x74\x8b\x57\xcb\x57\x3e\x34\x4c\xcc\x12\xc2\x32\xe1\xcf\x8f\x13\x8b\xba\x6b\x1f\x39\xac\x0d\x0b\x21\x51\xa7\xf0\xcf\x46\xd4\xd0\x36\x0f\x7a\x1c\x2b\xcc\x99\x88\xdb\x55\x75\x9f\x01\x6d\x16\x48\x58\xac\xa4\x82\x39\x5b\x73\xe4\x16\xfe\xdc\xe8\x2e\x87\xd1\x11\xf1\x4c\x74\x23\xd7\x5a\xdd\xbb\x3b\xe8\x49\xbd\xe8\xf6\xff\x16\xd4\xce\x62\xf5\xc6\xec\xb3\x64\x70\xbd\xaf\x3f\x4a\x0c\x5a\x10\x6e\x22\xb1\x87\x08\x83\x9d\xf4\x41\x1c\x9e\xa2\xd1\x14\xd6\x3f\xa4\xb0\x7e\x7d\x3a\xd6\xee\x4d\x79\x1c\xa0\x90\xd6\xc0\x76\xf0\x8d\x57\x64\x59\xe6\xf6\x04\xb6\x3b\x0f\x90\x7c\xdd\xbb\xda\x60\x45\x31\x96\xf9\x4c\x58\x85\x69\xa8\x64\x15\x76\x9b\x8d\xc1\xf4\x9c\x89\xcb\x21\x98\x6d\xba\xe3\xb9\x46\x98\x8f\xed\xdb\x96\xe3\x21\x0f\xec\x49\x8a\x7b\xee\xdf\x4b\xe9\x84\x4c\x4e\xf7\xe5\x69\xa1\xe1\xa0\xe6\xb1\xf8\x88\x8d\x0b\x9a\xd5\xab\xb7\x80\x5f\x64\xf0\xa1\x51\x66\xf7\x1a\x27\x6d\x96\xbc\xd5\xa7\xc1\xcc\x52\x8d\xa9\x66\x21\xf2\x14\x56\x75\xc5\xa5\xdf\xb2\xd5\x7a\x40\x09\x85\x04\x06\xaa\x65\xb5\x64\xb9\x39\x1d\x68\x0c\xa4\x43\x4f\x3d\x66\xda\x50\x71\x92\x64\xdf\xed\x01\x56\x69\xbf\xa1\x27\xc4\xa3\x10\xc3\x5f\x8f\x19\x2b\xfe\x3b\xfe\x81\x2d\x98\x81\x7b\xec\xa2\xd3\xe4\x3f\x3e\xd1\x71\x55\x68\x5f\x02\x3c\x6c\xb7\x47\x2f\x37\x5f\xbe\x53\x2d\x08\xd5\xe2\x68\x32\x79\x98\xa3\xeb\x2d\x5b\x5e\x88\x9c\x29\x9e\x0d\x47\x45\x93\xc9\x3d\xdf\x00\x00\x8a\x1b\x8f\x90\x4d\xba\x0d\x3b\x5c\x3c\x26\x91\xed\x77\x6a\x4e\xc3\x03\x99\x25\x96\x21\xaf\x1e\x1a\x8c\x49\x89\x93\xee\x68\xd2\x9f\x28\x01\x78\x1b\xf1\x07\x52\x94\x9f\xa1\xa8\x23\xba\x96\x38\xc9\x82\xdd\xf3\x98\x4e\xdd\x69\xea\x98\xbe\x08\xf3\x6b\x03\x26\x16\x87\x8a\x14\xd6\x1e\x0e\xa5\xbb\x06\x6f\x28\xb7\x03\xbc\xce\x62\x8f\x90\x3b\x6f\xf0\xac\xb9\x37\x8f\x9e\xb4\xcc\x1f\xec\xf0\x7f\xa6\x85\xbe\xd1\x53\x70\x96\x3d\x85\x75\xd2\xf5\xf7\x26\x6b\xa9\x31\x9b\x5a\x3b\xc4\xa5\x2d\x77\x79\x28\x79\xf7\x7c\xee\xba\x8e\xd7\x32\xcb\x32\x22\x8c\x36\xbd\xd4\x36\xad\xe1\x98\x55\x8d\x90\x06\xee\x84\x27\xe8\x1d\x34\xaf\xcd\xa4\xd4\xf9\xda\xd8\x49\xcd\xbe\xb5\x55\x93\xa7\x27\x1f\x51\xda\xcb\xe7\xe3\x44\xc6\x31\xb4\x25\x75\x64\x88\x81\x84\xfd\xad\x6e\xff\x7c\xc6\x29\xc6\xd3\x56\x7a\xfe\xcd\xa5\xd3\x00\x9c\x50\xf1\xee\x4e\x54\xf9\x9a\x9c\x9a\x98\xd5\x1d\x1b\xb3\xdd\x30\x6c\x5a\x87\x83\x74\xeb\x3a\x4e\xb2\x7f\xa0\x99\x63\x32\xb6\xdf\xb2\x16\x65\x1f\x1e\x8f\xf7\x4c\x4c\x39\xb1\xf1\xb0\x60\xcb\x1b\xcf\xae\x74\x7e\x57\x87\x05\xf1\x95\x24\xd1\xc1\xe5\xb8\xa5\x77\x73\xcf\x37\x71\x9d\x74\xfd\xe9\x5d\x44\x8b\xbf\xdb\x95\xa8\x0a\xde\xba\x0e\x43\x28\x93\xce\xfe\x6e\x86\xf1\xa8\x13\xa5\x03\x69\x37\x6b\x73\xe0\x15\x13\xa4\xa8\x57\xbc\x5b\x0a\x3f\x33\x29\xb1\xb7\x68\x1f\x9c\x86\x38\x76\xe4\x14\xff\x37\x4c\x1f\x36\x87\xa9\x5c\x49\xb0\x12\x37\x23\xb3\xc5\x4a\x51\xa5\xcd\x3e\x71\x1d\x6c\xb1\x2d\x16\xfb\x17\xdf\x9d\x9d\x7c\x26\xbc\x46\x80\xbd\x92\xda\x69\x12\xdf\x00\xeb\x81\xee\xb5\x46\x8a\x7d\x8b\xee\x53\xf6\xce\x4c\x57\xc4\xce\x9c\xc0\xff\xb5\x7d\x11\x51\x9a\x22\x64\xf6\x5b\x8e\xab\xea\xa7\x55\x75\xef\x28\x61\xd2\xc9\x3e\xb1\x35\xc7\x7a\x77\x60\x65\xe7\x29\xc5\x36\x3e\xc2\xa8\x37\xae\x63\xe8\x76\x0e\x64\x27\xd2\xdc\xd6\x2e\xbe\xcc\xf5\x6e\x6e\x23\xcc\xa9\x0c\x4c\xac\x56\x9d\x59\x9c\x1a\xea\x24\x1a\xa4\x14\x51\xf4\x4b\x8f\xd1\x4d\xf7\xea\x54\x0a\xaf\xfc\x80\xfb\x5f\xf8\xa7\xa1\x39\x1e\x7d\x66\x5e\x93\x37\xcc\xa3\xa8\x50\xe2\x5c\x14\x9e\xcb\x88\x42\x9a\x56\x18\x79\xc5\x6c\x06\x9f\xee\xc5\x92\x90\x5e\xb7\x9c\x21\x80\x68\xb7\x22\xec\x09\x43\xfa\x77\x2c\x2b\x9d\xb0\x95\xd6\x03\x40\x36\x65\x8d\x47\xde\xf5\xd5\x75\x1d\x8b\x82\xca\x50\x92\x5d\x5f\xc9\xef\xca\x67\xc6\x41\x89\xdb\x04\xfe\x44\x3f\x68\xab\x1f\x07\x48\x94\xd5\x4f\x74\xa3\xfa\xf7\x52\x9e\x26\x93\x74\x6e\x25\x8a\x4e\xed\x74\xd3\xec\xb6\xdf\x8b\xe5\x8d\x28\xba\x88\x43\x56\x26\xf8\xb2\x0c\x2e\xa9\x0b\x79\x73\xf1\xea\xcb\x38\x11\xb4\x8e\x8d\x9f\x67\x8e\x0c\x5d\xa1\xf1\xce\x72\xac\x28\x52\x10\xc5\x9e\x8e\xdf\x98\x3d\xfe\xb6\x2c\x98\xe2\x1f\x6b\x7e\x7d\xd5\x87\xa0\xd8\x0b\xcd\xfc\xa6\xc1\x6e\x17\xb3\xa2\x40\x95\x67\xef\x1e\x79\xbe\x27\x08\x87\x21\xb0\xf3\x1b\xef\xc6\xf3\xf4\xb9\xb1\x7d\x07\xe7\xbd\x3f\xf7\x75\x5d\x66\x33\xd0\xbc\xbb\xed\x47\x66\xc3\x97\xd0\xd0\x0a\x6f\x22\xf8\xa3\x85\x6e\x20\x33\x2e\x51\xdc\x22\x27\x85\x4d\xb3\x82\x9a\x23\x98\x6a\x20\xc7\xfe\x79\xa8\xa0\xfa\xa1\x65\xcb\x38\x31\x6d\x7b\x7a\xc2\x91\xd5\xc7\xa0\x52\xe4\x6f\x30\x4d\x64\xde\x2a\x71\xcb\x73\xda\xd5\x0c\xd6\x45\x5d\xbb\xc0\xbb\x48\xe7\x1a\xf3\x66\x81\xef\xfb\xf1\x02\x4f\x27\xb6\x4d\x55\xe1\x5a\x8e\xe5\xf7\x27\xae\x96\xb4\x66\xe2\x24\xbc\xee\x6c\xed\x2d\x68\x9e\x76\x98\xde\x51\xea\x33\x92\x84\x26\x45\x1d\x68\x05\xc2\x8a\xfe\x09\x8e\x7b\x52\x0a\x39\xa2\x22\x60\xa5\xe2\xad\x3d\xee\x99\x57\x8d\xe4\x05\x6d\x61\xca\xc6\xa6\xa0\x0a\xa8\xf9\x67\x8f\x77\x3f\x88\xaa\x82\x5b\x0e\xfc\x91\xe7\x2b\xcc\xb9\x6a\xde\x36\xab\x3b\xda\xae\x34\x2f\xd1\xc1\xc3\x5c\xe4\x73\x5b\x8a\xfa\x06\x38\x55\xc7\xd6\x31\x82\xeb\xa8\x5a\xf5\xb8\xef\xc4\xa7\x7e\x1b\x21\x33\xaf\xf2\xc5\xe7\xea\xf1\x8a\xfe\x4c\x22\x7f\x19\xb0\x64\xb5\xc8\x43\xd4\x18\x4c\xe1\x90\xa3\xc7\x34\xab\x8c\x56\xa7\x1a\x1f\x1e\x9c\x19\x33\xd0\x63\x56\xb4\x6b\xe7\x06\xbd\xc7\x4d\xa7\xe2\x2d\x6e\x42\x1b\xeb\xe0\x92\xbe\xe0\x7c\x09\xd8\x23\x36\x3d\xa6\x90\x2d\xf4\x65\x3c\xe2\x6e\xf7\xb0\xb0\xd3\x97\xba\x1d\x69\x34\xcf\xc6\x1e\x55\x2c\x98\x62\xf8\xde\x73\x16\xb9\x03\x89\x61\x03\xc3\xd0\x68\xb0\x4f\x85\x2f\x6e\x89\x3b\xd1\x89\x08\x6c\xf4\x29\xe7\x45\xc4\x21\x93\xf0\xc0\xab\xea\x44\x63\x92\xa4\x63\xb6\x1c\xd7\x4f\x96\xd3\xf3\xa3\x58\xd8\xfb\x3b\xb1\x3b\x8a\xf4\x78\xb7\x2b\xd8\xeb\x7c\xa2\xe2\x56\xd2\xdc\x91\x9c\xd7\xb0\x60\x4b\x4c\x62\x38\x94\xde\x77\x6f\xd7\x56\x73\xa2\x30\x3a\x68\xca\xae\xf9\x23\x6a\xc8\x37\x79\x25\x72\xfd\x6e\xa3\x3c\x51\x68\xe2\x2a\xb6\x13\xee\x15\x62\xa8\x14\x51\xf6\x15\xe2\x2f\xcf\xc2\x43\x23\xe8\xd8\x6e\xfd\x8b\x73\xdd\xf4\x86\x7e\xf9\x11\x9a\x7b\x7f\xe0\x3a\x8b\x43\x46\x89\xcc\xd7\x7c\x7c\x7b\x7a\x94\x24\x6e\x4b\x7c\xcd\x47\x3a\x06\x83\x77\xc8\xcf\x64\xf7\x26\x4f\x09\xd3\xe7\x32\x7b\x2e\xa7\x1e\xb1\xb1\x05\xe1\xde\xf5\x2b\x8a\x6a\xc3\x5e\xe2\x8b\x10\x3f\xc2\x3a\xa8\x8d\x93\xaf\xb4\x5a\x39\xa7\xe5\xf7\xe4\x6b\x3e\xba\xe4\x24\xe6\xdd\x02\xdd\x01\x57\x83\xba\xf5\xbb\xe5\xba\x59\x41\x96\x9c\xe2\xef\x9f\x36\x8a\x4b\x7b\x74\xcd\x98\x87\x38\xe8\x4d\xbf\x77\x46\x03\x20\x82\xb7\xd7\xb7\xe6\x08\x05\x11\xb2\x0b\xfe\x3e\x4f\x67\x65\x76\x2d\xe9\xd4\x0f\xe9\x74\x82\x39\x02\x3f\xd9\x10\xbf\xd8\x23\x5e\x0a\x2f\x1c\xd1\x24\x9a\x04\xc0\xb7\xff\xf7\x9e\x6d\x95\x7d\xa6\xa3\x07\xfa\x06\xec\x03\xcd\x68\xef\xce\xe0\xd7\xfc\xc0\x66\x97\x69\x91\x68\x5d\x74\x91\x93\x44\x83\x8e\xc2\x41\xf5\x1f\x9c\xc0\x00\xfe\x91\x85\x97\xc6\xfa\x8e\x2c\x42\x7c\xd3\x77\xfa\x2a\x3a\x9c\xd8\x4d\x6b\xda\x24\x07\x27\xbc\xf9\x4a\xbd\x20\x37\x08\x7f\xf7\x24\x33\xab\x97\xdd\x01\x33\x99\xa0\x7d\xf1\x35\x37\xb9\xce\xf4\xdc\x7a\xe7\xf3\xb1\x1d\xa2\xef\xf0\xf6\xc4\xf4\xa4\x1f\x8f\x13\xd3\xa4\xb4\xed\x46\x0b\xf2\xf4\x55\x99\xfd\xa4\x7f\x47\x76\xed\x9b\xfd\xa3\x15\x8a\x9b\xc1\xc1\xc9\xfc\x78\x9a\x8c\x3f\x45\xcc\xd1\x36\x5a\x19\x4f\x45\x71\xf9\x7c\x3d\x7a\x88\x3f\x49\x02\xaf\x14\x07\xbf\x4c\xd1\xfb\xde\x03\x2a\x73\x94\xc1\x34\xec\xd8\x5d\x4e\x93\x7d\x8f\xf6\x19\x92\x96\xfc\xcf\x4c\xde\xc7\x18\xb3\xbe\xb0\xa3\xa7\x9f\xc2\x2e\x67\x32\xe2\xbc\x4f\xcd\x89\xfb\x93\xe2\x09\x59\xf1\x7b\x34\xe3\xcf\x74\x2d\x3f\x0b\xdb\x5c\xde\x47\x66\x9d\xbd\xa7\xf7\xd9\x63\x25\x16\x3c\x7b\xf3\xe1\xd3\xf5\x5b\x13\x3d\xc3\x34\xe6\xb7\x8b\xf7\xd1\x3b\x5f\xf7\x47\x1f\x7c\x3c\x70\x2d\xf2\xab\xf3\x75\x30\xbf\x09\x23\x13\x65\x03\xaa\xdf\xa3\x99\xbd\x8a\x19\x23\xe2\xac\xb1\x57\x3f\xc7\xd4\x73\x90\x6a\x8f\xc4\xa1\x31\x43\x15\x75\x54\x92\x68\xa8\xa8\xe0\x97\xff\xc3\xff\x3b\x98\x08\xab\x63\xfc\xbb\xe4\x77\xdd\x6e\x9c\xbd\x6d\x58\x48\xec\xf6\xbe\x39\x96\xf4\x9e\xf2\x14\x65\x84\x2e\xb4\x11\x8d\x79\x37\x3c\x5c\x2c\x15\x81\x80\x39\x93\x73\x8b\x8c\xb1\xd7\x88\x9f\x29\x1a\x01\xca\xfa\xb4\x69\x3e\xa7\xda\x56\x70\xc5\xcd\x42\x87\x0e\xb1\xe2\x97\x88\xee\xf9\x46\x12\x32\xbe\x56\x90\x37\x6b\x6c\xe7\xb9\x9d\x60\xdb\x78\x69\x39\x54\x02\xbf\xb7\x80\x47\x4e\xfb\x91\x3e\x60\xdf\xbc\x15\xa3\xba\x3d\xe1\x82\x63\xbd\x37\xd0\x39\x0a\xbe\xd6\x13\xf2\xaa\xdf\x1c\xb2\x68\xbe\x69\x3b\x4c\x8e\x49\xb8\x29\x7d\xd6\xe8\xb5\x1b\xee\xbe\x24\x74\x52\x96\xf7\x78\x0d\x52\xfd\x1c\xcb\x9a\x9c\xb3\xd7\xff\xe7\x0f\xd9\x07\xfe\x10\x87\xb9\xb7\xf4\x8e\x17\x95\x1e\x85\x79\xda\xff\x9a\xcf\x30\x91\x8f\x81\x92\x24\xf4\x1e\xe3\x25\x73\xfe\x98\xbd\xa3\x93\xb5\x9f\x1b\xe3\x29\xf3\xec\xd3\x6a\x11\xd7\xa2\x4a\x46\x96\xba\x9f\x9b\x9f\xd9\xd2\xf3\x8b\xb2\x62\x0a\xee\xf9\xe6\x25\x6d\xaf\x41\xcb\xcd\xc7\xab\xa8\xcb\x3b\xa6\x6e\xed\x19\x2c\x9f\xa3\x12\x84\x42\xdb\x76\xc3\xf1\x43\x30\xfa\xb4\xb0\xde\x45\xfd\x85\x17\x42\xe2\x57\x5c\xe6\x78\xc8\xb6\xff\x61\x9b\x7b\xbe\x71\xfb\xbf\x66\x6f\x21\x6f\xaa\xd5\xa2\x96\xe1\x89\x65\xf7\xfe\x98\x68\x69\x37\xc9\x1c\x8f\xc0\x69\x30\x23\x38\x92\x12\x7e\x79\xff\x16\x7e\xff\xfb\xdf\xff\x31\xc9\xe0\x83\xb0\xdf\x7b\x49\xa1\x59\x9a\xf5\xa7\x71\x02\x0a\xa1\xff\xe6\x6d\xe3\x86\xd2\x9b\x68\xb6\x14\x9a\xa7\x90\xc5\x46\xb7\x38\x68\x39\x8f\xfe\x29\x1b\xf2\x6b\x77\xc0\x32\x1b\x9e\x1a\xc6\x37\xd9\x6f\x39\x14\xbc\xc7\x3c\xbc\x6f\x9b\x05\x2a\x5f\x77\x64\x50\xb7\xb8\x14\x35\x1d\xaf\xd3\x3c\x91\xac\x17\x27\xb8\xf8\xba\xd1\xe0\xc2\x1c\xd5\xc3\x2a\xb5\x08\x5a\x82\xc1\x6d\xf2\x30\xfc\x94\x89\xc3\x01\xff\xc2\xf3\x2f\xf0\x03\xfa\x77\x34\x59\xdc\x8c\x15\x62\x6c\x25\x7a\xa5\x18\x81\xd8\xfd\x5a\xfb\x5a\x9f\x4f\x44\x1e\x43\xe7\x1f\x05\x1d\x23\x1f\x9a\x3a\xbd\x36\x1f\x8b\x91\x61\xd9\x5e\xdc\x1c\xc5\x18\x81\x60\xb6\x76\x86\x95\x4e\x73\xf6\xd1\xba\xd1\x93\x38\x7b\xd6\xf2\x12\x3f\x7d\x94\xd1\x91\x9a\x8f\x65\xbc\x4e\xb2\x6b\xf9\x5f\xbc\x6d\xe2\xe4\xa9\xdc\x8e\x32\x6b\xb8\xfb\x5e\x5a\xa7\x48\x91\xb8\x89\x4c\x16\x0a\x7e\x8c\xa4\xa7\x45\x14\xbc\x5f\x3b\x74\x0b\xf3\xca\x56\x99\x5d\x6d\x6a\xb6\x10\xb9\xa5\x6a\x8e\x52\x58\x8c\xd7\xdf\xe2\x9d\x92\x12\xcd\x6a\xb2\x77\xb0\xcb\x26\xb7\xee\x3b\x47\xe6\xfb\x06\xdd\xf7\xf4\xcc\x59\xe3\x46\xbd\x94\x7c\xc9\x5a\x6a\xb1\x2d\x99\x9a\x9b\xb4\x35\x65\xd9\xed\x34\x31\xd9\xcf\x9b\xc0\xc5\xb8\xd9\xf4\x32\xc7\xa3\x9b\x56\x49\x78\x98\x73\x7c\x81\x01\xdb\x80\xd4\x50\x97\x4f\x7a\x3d\x9b\x98\x70\x67\xee\xbd\x16\x47\x4a\x67\x22\x8c\xa3\x18\xe5\xe2\x01\xf1\xbf\x32\x35\x3f\xc9\x74\x29\x20\x6d\x34\xe0\x2e\xf2\x45\x0a\x17\x5c\x06\x3f\xf9\x2a\x34\x09\x66\x8f\x0e\x91\xa8\x5f\x26\x5c\x55\xeb\x3e\x51\x77\xba\x12\x46\x99\xe9\xe9\xc4\x26\x34\x4f\x1d\xeb\x14\xbe\xee\x8d\x43\x4f\xb1\x28\xfc\x44\xda\x46\xcf\x3a\x33\xb4\x12\x4f\xa5\x74\xf7\x88\x8e\xae\x3d\x60\x85\x62\xa3\x95\xee\x78\xfb\x9f\xd0\xd0\x75\xad\x7a\xea\xa1\x93\xed\x4f\xd5\x8d\xe7\x57\x48\x79\x7d\xcc\x5b\xde\x57\x0d\x0b\x75\x51\xaf\x16\xb7\xff\x19\x55\x10\x2f\x3d\x65\x94\x78\xed\x0f\xff\xfb\x37\x51\x88\xa6\x7f\x54\x25\x3f\x35\x4d\x15\x68\x04\xa7\xe6\xac\xfe\x4f\xa8\x04\x79\xe9\x69\x04\xb9\x79\xba\x3a\x6e\xbd\xd8\x21\x1a\x9e\x8e\x6e\x5d\xe4\x4c\xba\x97\xf0\xba\xbf\xe8\x68\xae\xc4\x8f\x97\xe2\xf8\xfe\x2b\x1d\xf6\xcc\xa1\x7e\x40\xef\x1f\x30\x58\xb2\x56\x12\x12\xd0\x97\x9b\x32\x00\x5a\xde\xe7\xf1\xdc\x30\xd3\x98\x72\x64\xdd\x57\x0c\xf8\xa3\x42\x96\xce\x60\xfa\x09\x9f\x9d\x76\x63\xcc\xc7\x92\xf6\x7f\xa6\xd0\x7c\x02\x6b\xc1\xea\xcd\xf0\x2b\x85\x83\x8f\x60\x65\x3d\xb1\xc7\x8d\xe7\x33\x9d\xe0\xa9\xb8\x52\xdc\xc5\x79\x79\x67\xfe\x24\xdb\x8c\x75\xce\x02\x1a\xa6\x4e\x7a\xd7\x74\x7b\x8c\x48\xc0\x25\xe4\xe5\x1d\xb6\xa5\x7b\x56\xc0\x2f\x22\x76\x07\xeb\x71\x12\xc2\xb8\x88\x21\x25\x79\xf2\x4b\xfc\x46\xaa\xf9\x20\x62\xff\xcb\xb0\xde\x27\x3e\xe9\x85\x34\xb3\x16\xfd\xcc\xee\xec\x77\x73\x7e\x0d\x5f\xcd\x56\xe1\xab\xd9\x35\x5e\x86\x57\x46\x05\xdd\xeb\xd9\x08\x6c\x2e\xa6\x2f\xa7\xee\x62\xf7\x4d\xc0\x03\xcc\xd3\x32\xd3\xc0\x6d\x5c\x83\xb6\x02\x01\x37\x1e\x76\x6e\x29\xc8\x1a\xc2\xb8\xdd\x97\x1b\x2d\xb0\x37\xc7\xb8\xf1\x5d\x4e\x5a\x4f\x64\xe3\xb2\x8e\x7c\xf9\x11\xd9\xe1\x75\xb1\x9f\x99\x6e\xfb\xc4\x6c\xfc\x74\x5b\x21\x76\xcd\x00\x31\x3d\xba\x60\x1b\xdc\x02\x94\x73\x86\x69\xe1\x76\x03\x92\xaf\x79\x6b\x37\x90\x04\x97\x6e\x3b\x17\x17\x59\xd2\xbc\x49\xb5\xcf\x2e\xdd\x9b\x11\xe8\xd1\x7a\x1f\x82\x07\xaf\x31\xa1\x81\xfc\xf6\xb4\x69\xbb\x9a\xee\x6a\xd0\xc9\x1d\x7b\x3b\x6e\xbb\x7d\x09\xbc\x2e\x60\xb7\x8b\xfe\x67\x00\x8c\xf1\x66\xa0\xf3\x58\x00\x00")
 
 func templateEntTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -881,12 +944,12 @@ func templateEntTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/ent.tmpl", size: 5654, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/ent.tmpl", size: 22771, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEnttestTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x55\x5f\x8b\xdb\x38\x10\x7f\x96\x3e\xc5\x9c\x59\x0e\x67\xc9\xca\xbd\xbe\x5d\x8f\x7d\x68\xc3\x16\x0a\xd7\xdd\x83\x5d\xb8\x87\x52\x8a\x22\x8f\x6d\x11\x47\x72\xa5\x71\xd3\xc5\xf8\xbb\x1f\x92\xff\xc4\x49\xba\xe5\xe0\x9e\xee\x2d\xd2\x68\x66\x7e\x7f\x66\x9c\xae\xcb\xae\xf9\xc6\x36\xcf\x4e\x97\x15\xc1\xeb\x57\xbf\xfd\x7e\xd3\x38\xf4\x68\x08\xde\x4b\x85\x5b\x6b\x77\xf0\xc1\x28\x01\x6f\xeb\x1a\xe2\x23\x0f\x21\xee\xbe\x61\x2e\xf8\x53\xa5\x3d\x78\xdb\x3a\x85\xa0\x6c\x8e\xa0\x3d\xd4\x5a\xa1\xf1\x98\x43\x6b\x72\x74\x40\x15\xc2\xdb\x46\xaa\x0a\xe1\xb5\x78\x35\x45\xa1\xb0\xad\xc9\xb9\x36\x31\xfe\xe7\x87\xcd\xdd\xfd\xe3\x1d\x14\xba\x46\x18\xef\x9c\xb5\x04\xb9\x76\xa8\xc8\xba\x67\xb0\x05\xd0\xa2\x19\x39\x44\xc1\xaf\xb3\xbe\xe7\xbc\xeb\x20\xc7\x42\x1b\x84\x04\x0d\x11\x7a\x4a\x60\xbc\xbf\x6a\x76\x25\xbc\xb9\x85\xad\xf4\x08\x57\x62\x63\x4d\xa1\x4b\xf1\x97\x54\x3b\x59\xe2\xf4\xe8\xa0\xa9\x02\xfc\x4e\x68\x72\xb8\x82\x64\x8c\x26\x8b\x6a\x37\x7d\xcf\x59\xd7\x01\xe1\xbe\xa9\x25\x21\x24\x15\xca\x1c\x5d\x02\x22\x14\xe9\x3a\x08\xb9\xa1\x9c\xde\x37\xd6\x11\xa4\x9c\x25\xc5\x9e\x12\xce\x59\x12\x60\x5c\x76\x4e\x38\xcb\x32\x70\xf8\xb5\xd5\x0e\x73\xd8\x3e\x83\x57\x15\xee\x25\x54\xd6\xee\xbc\xe0\xec\x0b\xbc\x90\x99\xb9\xd6\x90\xde\x63\x28\xde\x75\xa0\x0b\xb8\x12\x8f\x6d\x13\xfa\x7e\xd4\xa5\x0b\xf0\x02\x5c\x96\x94\x9a\xaa\x76\x2b\x94\xdd\x67\xc5\x68\xa5\x36\xaa\xdd\x4a\xb2\x2e\x43\x43\x59\xae\x65\x8d\x8a\x32\xff\xb5\xce\x86\xee\x49\x2c\x39\x92\x59\x71\x4e\xcf\x0d\x06\x32\x59\x06\x4f\xe8\x49\x9b\xf2\x29\x58\x1c\xfc\xd1\x86\xd0\x85\xba\x40\x95\xa4\x70\xeb\x2b\x19\xa9\x20\x1d\x10\x4d\x4c\xa2\x21\x49\x3c\x81\x34\xf9\x7c\x7a\x17\x4f\xad\x1f\x78\x8f\x2a\x0b\xce\x8e\x2d\xe6\xda\x1d\x67\xec\xbd\xd4\xf5\xbd\x3d\xa4\x2b\xce\xd8\x9d\x73\xd6\xa5\x42\x88\xf9\x49\xd7\xaf\x38\xeb\x79\x6c\xf7\xd0\x90\xb6\x06\x54\x94\xac\x75\xe8\x41\xd5\x3a\x8c\xb2\x72\x28\x43\x48\x70\x36\xbe\x29\x5a\xa3\xd2\x6b\x1b\x0f\x7e\xc5\x39\x1b\x7f\x82\x27\xd7\x2a\x8a\x8d\x6d\x43\x1e\x3e\x7d\x9e\xe6\xa8\xef\xc5\x90\xcc\x19\xeb\xba\x9b\x97\x95\x67\xfb\xe1\xf4\x30\xe4\x0f\xd2\x8a\x8f\xf3\xe5\xb1\xc4\xa8\x34\x8b\x62\x67\x19\xfc\xad\xa9\x7a\x18\x81\x14\xd6\x1d\xa4\xcb\x3d\x4c\xc8\xc8\x5e\xd2\x09\x34\x96\x59\x69\xc4\x2c\x84\xb8\x00\xbd\x9a\xd4\xe9\x38\x73\x48\xad\x1b\x35\xb0\x30\xab\x30\x90\x16\xb1\xc4\x2d\xc8\xa6\x41\x93\xa7\xc3\x79\x1d\x50\x78\x21\x44\x14\x3b\xee\xcd\x8b\x02\x8c\x3c\x4e\xf8\xfe\x98\x8e\x6c\xc9\xc2\x20\xd6\x29\x9b\xd3\xdc\x99\xd4\x8f\x94\xfc\xf7\xbc\x96\xae\x2c\xe8\x2d\xae\xcf\x59\x2e\x2c\x1a\xb0\x19\x3c\x9c\x60\xfa\xf4\x79\x02\x31\x35\x0b\xbd\x6c\xf8\xe4\xfc\x3a\x5e\x74\x3d\x67\x85\x75\xf0\x25\x16\x0f\x11\x27\x4d\x89\xb1\xd3\x34\x65\xa9\x8d\x0d\x27\xfc\x36\x08\x1c\xc7\x19\x0d\x28\x59\xd7\x1e\x4e\xec\x44\x13\x17\x28\xa8\x77\xe3\x5a\x73\xa1\x60\x78\x91\xd2\xbc\xb1\x6b\xc8\x9d\xfe\x86\xee\x5e\xee\x71\x0d\xb9\x24\xf9\x18\x3f\xa2\xe1\x1c\xc6\x5d\x9b\x72\x20\x1e\x24\x9e\xf9\x2c\x3a\x6e\x86\xa9\x9b\x98\x9d\x89\xb0\xe2\x4c\xad\x01\x9d\x0b\xb1\x33\x9c\xe9\xcb\x9d\xd7\x30\x4c\xd6\xa0\xb6\x2e\x62\x85\x5f\x6e\xc1\xe8\x3a\xca\x42\x62\xd8\x75\x74\x6e\x15\x8f\x8b\xcf\x40\xfc\x1a\xff\x64\x01\xc7\x6a\x6f\x6e\x41\x89\xc7\x61\x66\x36\x61\x65\x30\x55\xd6\x10\x7e\x27\xf1\x4e\xaa\x5d\xe9\xc2\x7f\x50\xba\x0a\x48\x16\x43\x10\x00\xfd\x71\x8e\xe6\x1c\xce\x29\x9e\x09\xd0\xb4\xce\xa3\x8f\x6a\xf4\xf1\x1e\x0f\xa3\x84\x17\x66\x1e\x43\x3f\x75\x74\x7e\x76\x62\xeb\x7f\xf3\xec\xcc\xae\x63\x8b\xa5\x2f\xff\x1b\x99\x8f\x7f\x5b\xff\x04\x00\x00\xff\xff\x15\x12\xa4\x04\xd3\x08\x00\x00")
+var _templateEnttestTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4d\x6f\xe3\x36\x10\x3d\x93\xbf\x62\x2a\x04\x85\x1c\x38\xd4\x76\x6f\xdd\x22\x87\x5d\x23\x0b\x2c\xd0\x26\x05\x12\xa0\x87\xc5\x62\x41\x53\x23\x89\xb0\x4c\x6a\xa9\x51\x9c\x40\xd0\x7f\x2f\x48\xd1\xb2\xec\x7c\xf4\x0b\x05\xf6\x64\x93\xc3\x99\x79\xef\xcd\xd3\xf4\x7d\x76\xce\x57\xb6\x79\x74\xba\xac\x08\xde\xbe\xf9\xe9\xe7\x8b\xc6\x61\x8b\x86\xe0\xa3\x54\xb8\xb6\x76\x03\x9f\x8c\x12\xf0\xbe\xae\x21\x3c\x6a\xc1\xc7\xdd\x3d\xe6\x82\xdf\x55\xba\x85\xd6\x76\x4e\x21\x28\x9b\x23\xe8\x16\x6a\xad\xd0\xb4\x98\x43\x67\x72\x74\x40\x15\xc2\xfb\x46\xaa\x0a\xe1\xad\x78\xb3\x8f\x42\x61\x3b\x93\x73\x6d\x42\xfc\xd7\x4f\xab\xab\xeb\xdb\x2b\x28\x74\x8d\x10\xef\x9c\xb5\x04\xb9\x76\xa8\xc8\xba\x47\xb0\x05\xd0\xac\x19\x39\x44\xc1\xcf\xb3\x61\xe0\xbc\xef\x21\xc7\x42\x1b\x84\x04\x0d\x11\xb6\x94\x40\xbc\x3f\x6b\x36\x25\xbc\xbb\x84\xb5\x6c\x11\xce\xc4\xca\x9a\x42\x97\xe2\x77\xa9\x36\xb2\xc4\xfd\xa3\x9d\xa6\x0a\xf0\x81\xd0\xe4\x70\x06\x49\x8c\x26\xb3\x6a\x17\xc3\xc0\x59\xdf\x03\xe1\xb6\xa9\x25\x21\x24\x15\xca\x1c\x5d\x02\xc2\x17\xe9\x7b\xf0\xb9\xbe\x9c\xde\x36\xd6\x11\xa4\x9c\x25\xc5\x96\x12\xce\x59\xd2\xf7\xcf\x75\x4e\x38\xcb\x32\x70\xf8\xad\xd3\x0e\x73\x58\x3f\x42\xab\x2a\xdc\x4a\xa8\xac\xdd\xb4\x82\xb3\xaf\xf0\x42\x66\xe6\x3a\x43\x7a\x8b\xbe\x78\xdf\x83\x2e\xe0\x4c\xdc\x76\x8d\xef\xfb\x9b\x2e\x9d\x87\xe7\xe1\xb2\xa4\xd4\x54\x75\x6b\xa1\xec\x36\x2b\xe2\x28\xb5\x51\xdd\x5a\x92\x75\x19\x1a\xca\x72\x2d\x6b\x54\x94\xb5\xdf\xea\x6c\xec\x9e\x70\x76\x20\xb3\xe0\x9c\x1e\x1b\xf4\x64\xb2\x0c\xee\xb0\x25\x6d\xca\x3b\x3f\x62\x3f\x33\x6d\x08\x9d\xaf\x0b\x54\x49\xf2\xb7\x6d\x25\x03\x15\xa4\x1d\xa2\x09\x49\x34\x26\x89\x3b\x90\x26\x9f\x4e\x1f\xc2\xa9\xf3\x1e\x59\x3f\x42\x54\x59\x70\x76\x68\x31\xd5\xee\x39\x63\x1f\xa5\xae\xaf\xed\x2e\x5d\x70\xc6\xae\x9c\xb3\x2e\x15\x42\x4c\x4f\xfa\x61\xc1\xd9\xc0\x43\xbb\x9b\x86\xb4\x35\xa0\x82\xd8\x9d\xc3\x16\x54\xad\xbd\x95\x95\x43\xe9\x43\x82\xb3\xf8\xa6\xe8\x8c\x4a\xcf\x6d\x38\xb4\x0b\xce\x59\xfc\x0b\x2d\xb9\x4e\x51\x68\x6c\x1b\x6a\xe1\xf3\x97\xbd\x8f\x86\x41\x8c\xc9\x9c\xb1\xbe\xbf\x78\x59\x79\xb6\x1d\x4f\x37\x63\xfe\x28\xad\x88\x4f\x8e\x4a\x44\xa5\x59\x10\x3b\xcb\xe0\x0f\x4d\xd5\x4d\x04\x52\x58\xb7\x93\x2e\x6f\x61\x8f\x8c\xec\x53\x3a\x9e\xc6\x3c\x2b\x0d\x98\x85\x10\x4f\x40\x2f\x20\x32\xef\x39\x73\x48\x9d\x8b\x1a\x58\x98\x54\x18\x49\x8b\x50\xe2\x12\x64\xd3\xa0\xc9\xd3\xf1\xbc\xf4\x28\x5a\x21\x44\x10\x3b\x7c\x37\x2f\x0a\x10\x79\x1c\xf1\x7d\x9e\x8e\xec\xc8\xc2\x28\xd6\x31\x9b\xe3\xdc\x89\xd4\x73\x4a\xfe\x7d\x5e\xf3\xa9\xcc\xe8\xcd\xae\x4f\x59\xce\x46\x34\x62\x33\xb8\x3b\xc2\xf4\xf9\xcb\x1e\xc4\xbe\x99\x87\x61\xfd\xca\xf9\x31\x5e\xf4\x03\x67\x85\x75\xf0\x35\x14\xf7\x11\x27\x4d\x89\xfe\xd0\xee\x5d\x96\xda\x20\xeb\x1e\xbf\xe5\x03\xe7\xc1\xce\x68\x40\xc9\xba\x6e\xe1\x68\x9c\x68\xc2\x07\xe4\xd5\xbb\x70\x9d\x79\xa2\xe0\x4d\x83\x26\xa5\xe9\x8b\x5d\x42\xee\xf4\x3d\xba\x6b\xb9\xc5\x25\xe4\x92\xe4\x6d\xd8\xd8\xfe\xec\xed\xae\x4d\x19\xb0\x05\x89\x27\x3e\xb3\x8e\xab\xd1\x75\x07\x81\x7d\x83\x95\x35\x84\x0f\x94\xaa\xf1\x57\x7c\x90\x6a\x53\x3a\xbf\xd5\xd3\xc5\x12\xe8\xb5\xa6\x33\x99\x0f\x44\x63\x3d\xbf\x48\x6a\xbd\xc1\x40\x7e\x09\xeb\x8e\x40\x13\xb8\xce\x1b\xa6\xc2\x03\xd5\x71\x6b\xfb\x45\x54\xea\x7b\x2f\x53\x84\x71\x50\x60\x02\x48\x0f\x53\x34\xde\x2d\xe1\x7f\x51\x27\xcc\xfd\xc4\x22\x0b\xce\xd4\x12\xd0\x39\x1f\x9b\x65\x79\x84\xe9\xab\x12\x89\x83\x17\x75\x11\x2a\xfc\x70\x09\x46\xd7\xc1\x34\x24\xc6\x4d\x88\xce\xf9\xb5\x48\x62\xb6\x24\x07\xfe\xfa\x7a\x8a\xd5\xde\x5d\x82\x12\xb7\xe3\x17\xb5\xf2\xfb\x11\x53\x45\x0f\x4b\x38\xfa\x22\xfc\x90\x7e\x39\x6d\x7e\xda\xfd\xb8\x3d\x1b\xf8\xd1\x6e\x8b\x9e\x51\xd1\xd4\xd7\xb8\x8b\x8a\x3d\x71\xf6\x21\xf4\xaa\xbd\xa7\x67\x47\x1e\xff\x47\x06\x9e\x4a\x4c\x26\x79\xc9\xc5\x27\x4e\x3d\x4d\x9c\xec\x3a\x05\xfe\xb5\x67\x4f\x4b\xff\xb5\x71\xff\x9b\x2b\x4f\x0c\x39\xb5\x8f\x1b\x3f\x90\xfe\x6e\x8d\xd4\xf7\x80\x26\x87\x61\xe0\x7f\x0e\x00\x83\x73\x9e\xfb\xc2\x0a\x00\x00")
 
 func templateEnttestTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -901,12 +964,12 @@ func templateEnttestTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/enttest.tmpl", size: 2259, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/enttest.tmpl", size: 2754, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\xc1\x8a\xea\x30\x14\x86\xd7\xb7\x4f\xf1\x23\x5d\xc9\xbd\xa9\xd7\xdd\x0c\xb8\x90\xaa\x8c\x30\xe8\x80\xbe\x40\x4c\xfe\xb6\xc1\x92\x94\x24\xce\x20\x25\xef\x3e\xb4\x53\x41\x66\x15\xf8\xbe\x73\xf2\x9d\xbe\x2f\xe6\x59\xe9\xba\xbb\x37\x75\x13\xb1\x5c\xfc\x7f\xf9\xd7\x79\x06\xda\x88\x9d\x54\xbc\x38\x77\xc5\xde\x2a\x81\x75\xdb\x62\x1c\x0a\x18\xbc\xff\xa4\x16\xd9\xb9\x31\x01\xc1\xdd\xbc\x22\x94\xd3\x84\x09\x68\x8d\xa2\x0d\xd4\xb8\x59\x4d\x8f\xd8\x10\xeb\x4e\xaa\x86\x58\x8a\xc5\xc3\xa2\x72\x37\xab\x33\x63\x47\xff\xbe\x2f\xb7\x87\xd3\x16\x95\x69\x89\x89\x79\xe7\x22\xb4\xf1\x54\xd1\xf9\x3b\x5c\x85\xf8\x14\x8b\x9e\x14\xd9\xbc\x48\x29\xcb\xfa\x1e\x9a\x95\xb1\xc4\xac\xa1\xd4\xf4\x33\xa4\x34\xd0\x2f\x13\x1b\xe4\xe2\x6d\x84\x48\xa9\xef\x21\x7e\x1e\xb6\x81\x48\xa9\x28\x50\x0e\x57\xd7\xb4\xf4\x32\x52\xe3\x72\x07\x6d\x54\x7f\xb1\x39\xe2\x70\x3c\x63\xbb\xd9\x9f\xc5\xb0\x60\x35\xa6\x56\xde\x5d\x6b\xbc\xae\x70\x91\x81\xc8\x45\xe9\x6c\x65\x6a\xf1\x21\xd5\x55\xd6\x9c\xca\xa6\x42\x23\xc3\xce\xb0\xd5\xc8\x31\x3b\x29\xd7\x71\xbc\xea\xcf\xe3\x83\x15\x72\x31\xe2\x5f\x9b\x53\xa8\x9b\xe0\x63\xfc\x59\x7e\x07\x00\x00\xff\xff\x26\x39\x8f\x5b\xb4\x01\x00\x00")
+var _templateHeaderTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8e\xd1\x8a\xe2\x30\x14\x86\xaf\xb7\x4f\xf1\x23\xbd\x92\xdd\xd4\xf5\x6e\x17\xbc\x90\xaa\xac\xb0\xe8\x80\xbe\x40\x4c\xfe\xb6\xc1\x92\x94\x24\xce\x20\x21\xef\x3e\xd8\xa9\x20\x73\x15\xf8\xbe\x93\xf3\x9d\x94\xaa\x79\x51\xbb\xe1\xee\x4d\xdb\x45\x2c\x17\xbf\xff\xfc\x1a\x3c\x03\x6d\xc4\x4e\x2a\x5e\x9c\xbb\x62\x6f\x95\xc0\xba\xef\x31\x0e\x05\x3c\xbc\x7f\xa7\x16\xc5\xb9\x33\x01\xc1\xdd\xbc\x22\x94\xd3\x84\x09\xe8\x8d\xa2\x0d\xd4\xb8\x59\x4d\x8f\xd8\x11\xeb\x41\xaa\x8e\x58\x8a\xc5\xd3\xa2\x71\x37\xab\x0b\x63\x47\xff\x7f\x5f\x6f\x0f\xa7\x2d\x1a\xd3\x13\x13\xf3\xce\x45\x68\xe3\xa9\xa2\xf3\x77\xb8\x06\xf1\x25\x16\x3d\x29\x8a\x79\x95\x73\x51\xa4\x04\xcd\xc6\x58\x62\xd6\x51\x6a\xfa\x19\x72\x7e\xd0\x0f\x13\x3b\x94\xe2\xdf\x08\x91\x73\x4a\x10\x5f\x0f\xfb\x40\xe4\x5c\x55\xa8\x1f\x57\xb7\xb4\xf4\x32\x52\xe3\x72\x07\x6d\x54\x3f\xb1\x39\xe2\x70\x3c\x63\xbb\xd9\x9f\x45\x4a\xa0\xd5\x98\x5a\xe5\x70\x6d\xf1\x77\x85\x8b\x0c\x44\x29\x6a\x67\x1b\xd3\x8a\x37\xa9\xae\xb2\xe5\x54\x36\x0d\x3a\x19\x76\x86\xbd\x46\x89\xd9\x49\xb9\x81\xe3\x55\x3f\x9e\x0b\x56\x28\xc5\x88\xbf\xfd\x9c\x42\xc3\x04\x9f\xe3\xaf\xf2\x73\x00\x26\x39\x8f\x5b\xb4\x01\x00\x00")
 
 func templateHeaderTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -926,7 +989,7 @@ func templateHeaderTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateHookTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x56\x51\x6f\xdc\x36\x0c\x7e\xb6\x7f\x05\x67\x5c\x00\xbb\x73\xe4\xb4\x6f\x5b\x91\x01\x45\x96\x62\x05\xba\x64\xd8\xb2\xbd\x14\x7d\x50\x6d\xfa\xac\x9d\x2c\x19\x92\x9c\xbb\x83\xe7\xff\x3e\x50\xb2\xef\xdc\x78\xe8\x82\x62\x6f\x7b\x69\x73\x24\xc5\x8f\xfa\xf8\x91\xf2\x30\x14\x2f\xe2\x1b\xdd\x1d\x8d\xd8\x36\x0e\x5e\x5d\xbd\xfc\xee\xb2\x33\x68\x51\x39\x78\xcb\x4b\xfc\xa4\xf5\x0e\xde\xa9\x92\xc1\x1b\x29\xc1\x07\x59\x20\xbf\x79\xc4\x8a\xc5\x0f\x8d\xb0\x60\x75\x6f\x4a\x84\x52\x57\x08\xc2\x82\x14\x25\x2a\x8b\x15\xf4\xaa\x42\x03\xae\x41\x78\xd3\xf1\xb2\x41\x78\xc5\xae\x66\x2f\xd4\xba\x57\x55\x2c\x94\xf7\xbf\x7f\x77\x73\x7b\xf7\xdb\x2d\xd4\x42\x22\x4c\x36\xa3\xb5\x83\x4a\x18\x2c\x9d\x36\x47\xd0\x35\xb8\x05\x98\x33\x88\x2c\x7e\x51\x8c\x63\x1c\x0f\x03\x54\x58\x0b\x85\x90\x34\x5a\xef\x12\x98\x8c\x7b\xe1\x1a\xc0\x83\x43\x55\xc1\x06\x92\x5f\x78\xb9\xe3\x5b\x4c\x16\x51\xd1\x30\x80\xc3\xb6\x93\xdc\xd1\x61\xe4\x15\x9a\x04\x18\xb9\x86\x01\xe8\x1c\xa5\x12\x6d\xa7\x8d\x83\x64\x18\x60\xc3\x6e\xb4\xaa\xc5\x96\x4d\xc9\x60\x1c\x13\x8f\xb5\xe9\x76\x5b\xf8\xfe\x1a\x3e\x71\x8b\xff\x14\xe5\x83\x0c\x57\x5b\x84\x8d\xa2\xc0\x0d\xbb\xd3\x15\xda\xb9\x8a\x8d\xe2\x2d\x92\xbd\x33\x42\x39\xd8\x28\x76\x47\x86\xe4\x6d\xaf\xca\x53\xa9\x1b\x77\xec\xce\x41\x35\x24\x2f\x2e\x2c\xbb\xb0\x49\x40\xdf\x28\xf6\x73\xef\xb8\x13\x5a\xf9\xb3\x04\x1a\x15\x05\x3c\x34\x08\x27\x84\x71\x04\x9f\x44\x58\xe0\x0a\x78\xc5\x3b\x47\x3d\xd2\xc0\xa5\xd4\x7b\x4f\x7c\x6f\x91\xd8\xd6\xa6\x12\x8a\x9b\xa3\xcf\x51\xf7\xaa\xa4\xc4\xc0\x6d\xc8\xc5\x26\x08\x68\x09\x52\x1b\x16\x47\x3e\xef\x12\x88\x0e\xa5\xa5\x56\x0e\x0f\x8e\x18\xa1\xff\x73\x38\xdd\x63\x1c\x33\x48\x67\xea\xc6\x91\xfd\xc1\x65\x8f\x39\xa0\x31\xda\x64\xa1\x74\x7f\x1f\x84\x92\x4b\x69\xa1\x4e\x4b\x77\xc8\xa1\xcd\x58\x1c\x51\x6a\x48\xeb\x25\x5c\x36\x45\x53\x14\xac\x50\x5b\x58\x20\xcd\x34\x7d\x01\x1f\x86\x38\x8a\xda\xc7\x1c\xf4\x8e\x08\x6f\x59\xba\xac\x3b\x8e\x22\x51\xc3\x37\x7a\xe7\xc3\x22\x83\xae\x37\x0a\x94\x90\x39\xd4\xad\x63\xb7\x94\xa2\x4e\x93\x5e\xe1\xa1\xc3\xd2\x61\x15\x68\x22\x02\x7d\x8a\x8b\x07\x06\xc1\xb5\xa4\x23\xa1\xcb\xc5\x51\x34\xc6\xa7\x94\xf3\x9d\x1f\xb3\x38\xfa\x4c\x93\x45\x01\xf7\x0a\xf0\x80\x65\xef\xd0\xfa\xbe\x6d\xc5\x23\x2a\x20\x6d\x83\x56\x72\x9a\x98\xd9\xac\x3b\x34\xbe\x00\x16\x17\x45\x5c\x14\x11\xc5\xb1\x7b\x95\xbe\xd7\xdb\x7c\xc9\xcd\x8f\x28\xd1\xe1\x5f\x0b\xcb\x8d\x41\xee\x30\xa3\x73\x9e\xf6\x7b\x95\x36\xbb\xe5\x91\x9f\xb4\xde\xe5\xa0\xbb\xa5\xed\xbe\xcb\x9e\x86\x10\x57\xf3\xb5\x48\x19\x0a\x0f\x6e\xd5\x15\x4f\xfd\xca\xe6\x69\x9e\xce\x3e\xf5\x22\x8d\x48\x1a\xb4\xf6\x9f\x75\x9e\xda\xdb\xb2\xfb\x2e\xcd\xd8\x3b\x9b\xea\x6e\xb2\xce\x35\x34\x3b\x5f\x7d\xc6\xce\x92\x9b\x7a\xe7\x9b\x77\x12\x04\x15\xb2\x0a\x19\x7d\x2f\x43\x0f\x7f\xc5\x3f\x49\x04\x21\xde\x02\x0f\xed\x73\x0d\x27\x1b\xb9\x2c\x0d\xe6\xb9\x7b\x36\xf8\x5a\xee\xca\x06\x74\x37\x37\x33\x8c\xc3\x43\x06\xc4\xb3\x4d\x33\xf8\xf0\x71\x4d\x7e\x51\x9c\x0a\x5b\xb9\x83\x37\x0a\xe5\xa4\x5f\x94\xc3\xef\x5d\x45\x72\xc8\xfd\x89\x91\xfe\x1d\x4f\xd2\x98\xce\xff\x9f\xa4\xb0\x1a\xfa\x0b\x7b\x6e\x17\xad\x59\xa5\x5d\x58\xae\x58\xd1\x80\xfb\x4c\x5f\xa1\x94\x9b\x86\x0b\x05\xdc\x2b\x82\x84\x22\x85\x75\x34\xe2\x24\x18\xda\xe5\x15\x61\x61\x5d\x63\xe9\xc4\x23\xca\x23\x88\x96\x76\xce\x27\x89\x2c\x2c\x0b\x7a\xa1\xfd\x24\x57\x39\x08\x07\x7b\x21\x25\x70\xb9\xe7\x47\x0b\x8d\x96\x95\xdf\x15\x96\x56\xa9\xc5\x45\xe2\xe9\x31\xf6\x0e\x6d\x2a\x34\x2c\xf6\xdb\x2a\x94\x63\x9d\xe9\x69\x87\xc5\x51\x88\x5e\xe9\x6a\x2a\xfe\x0e\xf7\xe1\x40\xa8\x80\xea\x57\xb8\x87\xd2\xdb\x66\x2c\x16\x34\x34\xc7\xa6\x21\x25\x63\xec\x49\xce\x6c\x02\x3f\x6b\xc8\xff\x1e\x78\xd7\xa1\xaa\xd2\x55\x0d\xa9\x12\x32\xcb\x27\x0c\xc6\xb2\x99\x51\x2f\x44\x5f\x42\xd8\x9f\x6b\x46\xe7\xb1\x24\x6f\x2d\x14\x97\xde\x37\xd5\x99\x96\x01\x37\x0c\x5d\xfa\xaf\x1a\x6f\x67\xfd\x3e\x5f\xe6\xb5\x36\x20\xe8\xf9\x91\xa8\xd2\x92\xf9\xc2\x32\xb8\x84\x97\xaf\x41\xc0\x0f\xd7\x70\xf5\x1a\xc4\xe5\x65\xd0\xe3\x9c\xfe\x1a\xa6\xc0\x0f\xe2\xe3\x8c\xf9\xe4\x51\x99\xac\x67\x6d\xbd\xf1\xcc\x4d\x9f\x4a\xd4\x1c\xcf\x4a\x0e\xbc\xaa\x84\xda\x06\x01\x74\x58\x8a\x5a\x60\xe5\x29\xa0\x43\x7c\x62\x8d\x13\x6b\x0a\x4f\x52\x39\xbd\x74\xb5\xd4\xfb\x15\x57\x01\xea\x39\xbd\x55\xb8\xf7\xdb\xcc\x3f\xbf\x7c\x87\xeb\xc6\xe6\x70\x95\x7f\x46\xcd\xb7\xf4\x23\xfc\x99\x2d\x12\x5c\xc3\x24\x8d\xd9\x92\xcf\x1c\x91\x1c\xbe\x1c\xb8\x08\xfb\x4c\x6c\x73\xc4\x4c\xe1\x6d\xf8\xcc\x7c\x1e\x85\xde\xf7\xf5\x1c\x06\xac\x34\x4c\xcf\x64\x7b\x3a\x12\x25\x9b\x98\xf6\x51\x8b\xdb\x86\x2f\xd1\xe9\x33\xe2\xef\x00\x00\x00\xff\xff\xe7\x7b\xf9\x06\xfe\x0b\x00\x00")
+var _templateHookTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x56\x4f\x6f\xdc\xb6\x13\x3d\x4b\x9f\x62\x7e\xc2\x1a\x90\xf2\x93\x29\x27\xb7\x36\x70\x81\xc0\x75\xd0\x00\xa9\xb7\x68\xdd\x5e\x82\x1c\x68\x71\xb4\x62\x97\x22\x05\x92\xf2\xee\x42\xd5\x77\x2f\x86\x92\x76\x65\x6f\x90\xa4\x41\x6f\xbd\xd8\xd8\xf9\xf3\x66\xf8\xe6\x0d\xa9\xbe\x2f\x5e\xc4\x37\xa6\x3d\x58\xb9\xa9\x3d\xbc\xba\x7a\xf9\xdd\x65\x6b\xd1\xa1\xf6\xf0\x96\x97\xf8\x60\xcc\x16\xde\xe9\x92\xc1\x1b\xa5\x20\x04\x39\x20\xbf\x7d\x44\xc1\xe2\xfb\x5a\x3a\x70\xa6\xb3\x25\x42\x69\x04\x82\x74\xa0\x64\x89\xda\xa1\x80\x4e\x0b\xb4\xe0\x6b\x84\x37\x2d\x2f\x6b\x84\x57\xec\x6a\xf6\x42\x65\x3a\x2d\x62\xa9\x83\xff\xfd\xbb\x9b\xdb\xbb\xdf\x6e\xa1\x92\x0a\x61\xb2\x59\x63\x3c\x08\x69\xb1\xf4\xc6\x1e\xc0\x54\xe0\x17\xc5\xbc\x45\x64\xf1\x8b\x62\x18\xe2\xb8\xef\x41\x60\x25\x35\x42\x52\x1b\xb3\x4d\x60\x32\xee\xa4\xaf\x01\xf7\x1e\xb5\x80\x15\x24\xbf\xf0\x72\xcb\x37\x98\x2c\xa2\xa2\xbe\x07\x8f\x4d\xab\xb8\xa7\x64\xe4\x02\x6d\x02\x8c\x00\xfa\x1e\x28\x8f\xa0\x64\xd3\x1a\xeb\x21\xe9\x7b\x58\xb1\x1b\xa3\x2b\xb9\x61\x13\x18\x0c\x43\x12\x6a\xad\xda\xed\x06\xbe\xbf\x86\x07\xee\xf0\x53\x51\x21\xc8\x72\xbd\x41\x58\x69\x0a\x5c\xb1\x3b\x23\xd0\xcd\x5d\xac\x34\x6f\x90\xec\xad\x95\xda\xc3\x4a\xb3\x3b\x32\x24\x6f\x3b\x5d\x1e\x5b\x5d\xf9\x43\x7b\x0a\xaa\x20\x79\x71\xe1\xd8\x85\x4b\xc6\xea\x2b\xcd\x7e\xee\x3c\xf7\xd2\xe8\x90\x4b\x45\xa3\xa2\x80\xfb\x1a\xe1\x58\x61\x18\x20\x80\x48\x07\x5c\x03\x17\xbc\xf5\x34\x23\x03\x5c\x29\xb3\x0b\xc4\x77\x0e\x89\x6d\x63\x85\xd4\xdc\x1e\x02\x46\xd5\xe9\x92\x80\x81\xbb\x11\x8b\x4d\x25\xa0\xa1\x92\xc6\xb2\x38\x0a\xb8\xcb\x42\x94\x94\x96\x46\x7b\xdc\x7b\x62\x84\xfe\xe7\x70\x3c\xc7\x30\x64\x90\xce\xd4\x0d\x03\xfb\x83\xab\x0e\x73\x40\x6b\x8d\xcd\xc6\xd6\xc3\x79\x10\x4a\xae\x94\x83\x2a\x2d\xfd\x3e\x87\x26\x63\x71\x44\xd0\x90\x56\xcb\x73\x65\x53\x34\x45\xc1\x59\xd5\x06\x16\x95\x66\x9a\x3e\x53\x1f\xfa\x38\x8a\x9a\xc7\x1c\xcc\x96\x08\x6f\x58\xba\xec\x3b\x8e\x22\x59\xc1\xff\xcc\x36\x84\x45\x16\x7d\x67\x35\x68\xa9\x72\xa8\x1a\xcf\x6e\x09\xa2\x4a\x93\x4e\xe3\xbe\xc5\xd2\xa3\x18\x69\x22\x02\x03\x4b\x17\xf7\x0c\x46\xd7\x92\x8e\x84\x0e\x17\x47\xd1\x10\x1f\x21\xe7\x33\x3f\x66\x71\xf4\x44\x93\x45\x01\x6b\x0d\xb8\xc7\xb2\xf3\xe8\xc2\xdc\x36\xf2\x11\x35\x90\xb6\xc1\x68\x35\x6d\xcc\x6c\x36\x2d\xda\xd0\x00\x8b\x8b\x22\x2e\x8a\x88\xe2\xd8\x5a\xa7\xef\xcd\x26\x5f\x72\xf3\x23\x2a\xf4\xf8\xd7\xc2\x72\x63\x91\x7b\xcc\x28\x2f\xd0\xbe\xd6\x69\xbd\x5d\xa6\xfc\x64\xcc\x36\x07\xd3\x2e\x6d\xeb\x36\x7b\x1e\x42\x5c\xcd\xc7\x22\x65\x68\xdc\xfb\x65\x4c\x98\x8a\xb1\x4f\xf2\x26\x1b\xf4\x27\x4a\x9e\x7b\x91\x56\x24\xa5\xce\xfe\xc5\xc9\xd3\x78\x1b\xb6\x6e\xd3\x8c\xbd\x73\xa9\x69\x27\xeb\xdc\x43\xbd\x0d\xdd\x67\x53\x07\xb3\x30\x29\x64\x58\x0a\x82\x24\x78\x16\x32\x84\x59\x8e\x33\xfc\x15\xff\xc4\xd2\xc3\x08\xeb\x80\x8f\xe3\xf3\x35\x27\x1b\xb9\x1c\x2d\xe6\x69\x7a\x34\x69\xee\xa1\xe1\xbe\xac\xc1\xb4\xf3\x30\xe9\xf4\x90\xde\x67\x40\x3c\xbb\x34\x83\x0f\x1f\xcf\xc9\x2f\x8a\x63\x63\x67\xee\xd1\x1b\x8d\xed\xa4\x9f\x95\xc3\xef\xad\x20\x39\xe4\x21\x63\xa0\xbf\xc3\x51\x1a\x53\xfe\x7f\x49\x0a\x67\x4b\x7f\xe1\x4e\xe3\xa2\xe7\x50\x1b\x4f\x33\x34\x3b\x14\xb4\xe0\x01\xe9\x1b\x94\x72\x53\x73\xa9\x81\x07\x45\x90\x50\x94\x74\x9e\x56\x9c\x04\x43\x77\xb9\xa0\x5a\x58\x55\x58\x7a\xf9\x88\xea\x00\xb2\xa1\x3b\xe7\x41\x21\x89\x04\xd6\x9a\x5e\xe8\xb0\xc9\x22\x07\xe9\x61\x27\x95\x02\xae\x76\xfc\xe0\xa0\x36\x4a\x84\xbb\xc2\xd1\x55\xea\x70\x01\x3c\x3d\xc6\xc1\x61\xac\x40\xcb\xe2\x70\x83\x8d\xed\x38\x6f\x3b\xba\xc3\xe2\x70\x9d\xb8\x73\xd9\x4d\xcd\xdf\xe1\x6e\x4c\x18\x3b\xa0\xfe\x35\xee\xa0\x0c\xb6\xb9\x16\x1b\x35\x34\xc7\xa6\x23\x24\x63\xec\x19\x66\x06\x23\xd6\x49\x43\xe1\x77\xcf\xdb\x16\xb5\x48\xcf\x7a\x48\xb5\x54\x59\x3e\xd5\x60\x2c\x9b\x19\xa5\xfe\xc6\x16\x68\xab\xf0\x13\x8c\xce\x6b\x49\xde\x4a\x6a\xae\x82\x6f\xea\x33\x2d\xc7\x3e\xc6\xa5\x4b\xbf\xa8\xf1\xe9\xa1\xfc\x27\x32\xaf\x8c\x05\x49\xcf\x8f\x42\x9d\x96\x8c\x8a\xbb\x0c\x2e\xe1\xe5\x6b\x90\xf0\xc3\x35\x5c\xbd\x06\x79\x79\x49\xf4\x47\xd1\x0c\x7f\x0d\x53\xe0\x07\xf9\x31\x9d\x8c\xcf\x1e\x95\xc9\x7a\xd2\xd6\x9b\xc0\xdc\xf4\xa9\x44\xc3\x09\xac\xe4\xc0\x85\x90\x7a\x13\xc8\x71\x2d\x96\xb2\x92\x28\x02\x05\x94\xc4\x27\xd6\x38\xe9\x50\xe3\x51\x2a\xc7\x97\xae\x52\x66\x77\xc6\xd5\x58\xea\x6b\x66\xab\x71\x47\xc4\x3a\x3a\x7f\xc3\xb7\x78\x3e\xd8\x1c\xae\xf2\x27\xd4\xfc\x9f\x7e\x04\xe8\x2c\x5b\x00\x5c\xc3\x24\x8d\xd9\x92\xcf\x1c\x31\xc6\xbe\x10\xb8\x08\x9b\xd8\x0b\x07\xe9\xe7\x88\x99\xc2\xdb\xbd\xff\x7a\x0a\x83\xef\xdb\x39\x1c\x6b\xa5\x01\x65\xb6\x3d\x5f\x89\x92\x4d\x4c\x87\xa8\xc5\x69\xc7\x4f\x63\xd4\x02\x86\x21\xfe\x7b\x00\xe7\x7b\xf9\x06\xfe\x0b\x00\x00")
 
 func templateHookTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -946,7 +1009,7 @@ func templateHookTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateImportTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xc1\x6a\xdc\x30\x10\x3d\xaf\xbf\xe2\x61\xf6\x90\x04\x22\xa7\xb9\x35\x90\x43\x48\x13\x58\x28\x25\x90\xfc\x80\x56\x1a\xdb\x43\xbc\x92\x3b\x9a\x4d\x1b\x8c\xff\xbd\x58\x6b\x37\x59\xb6\xd0\x93\x9f\xf5\x66\xde\x8c\x66\x9e\x86\xa1\xba\x28\xee\x63\xff\x2e\xdc\xb4\x8a\xeb\xab\x2f\x5f\x2f\x7b\xa1\x44\x41\xf1\x68\x1d\x6d\x63\x7c\xc5\x26\x38\x83\xbb\xae\x43\x0e\x4a\x98\x78\x79\x23\x6f\x8a\x97\x96\x13\x52\xdc\x8b\x23\xb8\xe8\x09\x9c\xd0\xb1\xa3\x90\xc8\x63\x1f\x3c\x09\xb4\x25\xdc\xf5\xd6\xb5\x84\x6b\x73\xb5\xb0\xa8\xe3\x3e\xf8\x82\x43\xe6\xbf\x6f\xee\x1f\x7e\x3c\x3f\xa0\xe6\x8e\x30\x9f\x49\x8c\x0a\xcf\x42\x4e\xa3\xbc\x23\xd6\xd0\x4f\xc5\x54\x88\x4c\x71\x51\x8d\x63\x51\x0c\x03\x3c\xd5\x1c\x08\x25\xef\xfa\x28\x5a\x62\x1c\x8b\x03\xc4\x59\xb1\x2a\xeb\x9d\x96\xc5\xaa\x74\x31\x28\xfd\xce\x90\x44\xa2\xa4\x09\xed\xac\xb6\xd3\x37\xa9\x70\x68\xf2\x91\xf2\x8e\xca\x62\x35\x0c\x97\xa8\x2e\xc0\x4d\x88\x42\x68\x28\x90\x28\x87\x06\x31\xa0\x11\xdb\xb7\x48\x3d\x39\xae\xb9\x76\x50\xda\xf5\x9d\x55\x4a\xc8\x1d\xe5\x54\xae\x11\xa2\xe2\x8c\x7e\x62\x6d\xee\x63\xa8\xb9\x31\x4f\xd6\xbd\xda\x86\xb0\x5e\xd0\xf9\xd4\xe9\x6a\x55\x0e\xc3\x69\xd0\x38\x56\xbd\x90\x67\x67\x75\x6a\x27\x8b\xfe\x62\x6d\xb1\x36\x9b\x6f\xe6\xe5\xbd\x27\xf3\xf4\xda\x3c\x59\x6d\x0f\x22\x59\xc5\x60\x1c\x97\x60\x0a\xfe\xc0\x4c\x3f\x62\xc3\x54\xb8\xc6\xcd\x2d\xd6\xe6\x91\xa9\xf3\x69\xce\xfb\x10\xae\xff\x25\x7b\xa4\x7b\x2a\xbc\xfc\x7c\xc6\x65\xc3\xda\xee\xb7\xc6\xc5\x5d\x55\xcf\x2e\xe2\xe0\xf6\x5b\xab\x51\x2a\x0a\x79\x07\xff\x8b\xa9\x3c\xdb\x8e\x9c\xce\xab\x98\xaf\xfe\xac\x51\x0e\xd3\x39\xbe\xd8\xbc\xee\x9b\x5b\x98\x4d\x86\xe9\xd3\x54\x16\xf6\x74\x36\xc7\xf8\xef\x22\x17\x27\xa5\xca\x7a\xcf\xca\x31\xd8\xae\xc4\x7a\x0a\x3b\x9f\xfc\x36\xa7\x14\xd3\xf3\xc1\xdd\x47\x9a\xb6\x56\xe1\x6c\xc0\x96\x10\xdf\x48\x84\x3d\xf9\xc9\xd1\x51\xf2\x5b\x88\xb0\xde\xe3\x43\x13\x73\x99\x89\xe9\x0f\x7b\x4f\x26\x7b\xe8\xc4\xd4\xc7\xad\x8c\xe3\x30\x50\xf0\xe3\x58\xfc\x09\x00\x00\xff\xff\x98\xf7\x00\x1d\xc2\x03\x00\x00")
+var _templateImportTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xc1\x6a\xdb\x40\x10\x3d\x7b\xbf\xe2\x21\x7c\x48\x02\x59\xa5\xb9\x35\x90\x43\x48\x13\x30\x94\x12\x48\x7e\x60\xbd\x3b\x92\x86\x48\x3b\xea\xee\x38\x6d\x10\xfa\xf7\x22\xd9\x6e\x62\xec\x43\xa1\x27\x3d\xed\xcc\xbc\x37\xbb\xf3\x66\x18\xca\x0b\x73\x2f\xfd\x7b\xe2\xba\x51\x5c\x5f\x7d\xf9\x7a\xd9\x27\xca\x14\x15\x8f\xce\xd3\x5a\xe4\x15\xab\xe8\x2d\xee\xda\x16\x73\x52\xc6\x14\x4f\x6f\x14\xac\x79\x69\x38\x23\xcb\x26\x79\x82\x97\x40\xe0\x8c\x96\x3d\xc5\x4c\x01\x9b\x18\x28\x41\x1b\xc2\x5d\xef\x7c\x43\xb8\xb6\x57\xfb\x28\x2a\xd9\xc4\x60\x38\xce\xf1\xef\xab\xfb\x87\x1f\xcf\x0f\xa8\xb8\x25\xec\xce\x92\x88\x22\x70\x22\xaf\x92\xde\x21\x15\xf4\x93\x98\x26\x22\x6b\x2e\xca\x71\x34\x66\x18\x10\xa8\xe2\x48\x28\xb8\xeb\x25\x69\x81\x71\x34\x5b\x88\x33\xb3\x28\xaa\x4e\x0b\xb3\x28\xbc\x44\xa5\xdf\x33\xa4\x94\x24\xe5\x09\x75\x4e\x9b\xe9\x9b\x35\x71\xac\xe7\x23\xe5\x8e\x0a\xb3\x18\x86\x4b\x94\x17\xe0\x3a\x4a\x22\xd4\x14\x29\x29\xc7\x1a\x12\x51\x27\xd7\x37\xc8\x3d\x79\xae\xb8\xf2\x50\xea\xfa\xd6\x29\x65\xcc\x1d\xcd\xa5\x5c\x21\x8a\xe2\x8c\x7e\x62\x69\xef\x25\x56\x5c\xdb\x27\xe7\x5f\x5d\x4d\x58\xee\xd1\xf9\xd4\xe9\x62\x51\x0c\xc3\x71\xd2\x38\x96\x7d\xa2\xc0\xde\xe9\xd4\xce\x4c\xfa\x8b\xb5\xc1\xd2\xae\xbe\xd9\x97\xf7\x9e\xec\xd3\x6b\xfd\xe4\xb4\xd9\x92\xcc\x2c\x16\xe3\xb8\x4f\xa6\x18\xb6\x91\xe9\x27\xb9\x38\x09\x57\xb8\xb9\xc5\xd2\x3e\x32\xb5\x21\xef\xea\x3e\x88\xab\x53\xb4\x07\xbc\xc7\xc4\xa7\x54\x68\xab\xf2\xd0\xad\xe9\x84\x0a\xfd\x87\xca\x67\x5c\xd4\xac\xcd\x66\x6d\xbd\x74\x65\xb5\xf3\x2a\x47\xbf\x59\x3b\x95\x54\x52\xd4\xe2\x1f\x72\xca\xc0\xae\x25\xaf\x85\xf9\xfc\xc0\xcf\x2a\x69\x3b\x83\xc3\x8b\xed\x4c\x75\x73\x0b\xbb\x9a\xe1\xfe\x76\xd3\x1b\xed\xa3\xc7\x13\x38\xc4\x7f\xed\xb2\xf7\x6b\x2e\x5d\x08\xac\x2c\xd1\xb5\x05\x96\x13\xe5\xf9\xe4\xea\x5d\x89\x99\x96\x14\x77\x1f\x65\xda\x38\x85\x77\x11\x6b\x82\xbc\x51\x4a\x1c\x28\x4c\x7b\x23\x69\xde\x38\x81\x0b\x01\x1f\x9c\xd8\xc9\x40\x05\xfd\xd6\x78\xd9\xce\x4e\x3d\x5a\x9d\xc3\x56\xc6\x71\x18\x28\x86\x71\x34\x7f\x06\x00\x95\xdd\x00\xd1\x28\x04\x00\x00")
 
 func templateImportTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -961,12 +1024,12 @@ func templateImportTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/import.tmpl", size: 962, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/import.tmpl", size: 1064, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x57\x5f\x6f\xdb\x36\x10\x7f\x96\x3e\xc5\x41\x70\x01\x3b\x48\xe4\xb6\x6f\x0b\x90\x87\x2e\x49\xd1\x60\x45\x51\x20\x49\x5f\x86\xa1\xa0\xc5\x93\x4d\x94\x22\x55\x92\x72\x9a\x09\xfe\xee\x03\x8f\x94\x44\x25\xce\xb6\xf6\xc5\x30\xc9\xbb\xdf\xfd\xe1\xdd\x8f\xa7\xbe\x5f\x9f\xe4\x97\xba\x7d\x34\x62\xbb\x73\xf0\xf6\xf5\x9b\xdf\xce\x5a\x83\x16\x95\x83\xf7\xac\xc2\x8d\xd6\xdf\xe0\x46\x55\x25\xbc\x93\x12\x48\xc8\x82\x3f\x37\x7b\xe4\x65\x7e\xb7\x13\x16\xac\xee\x4c\x85\x50\x69\x8e\x20\x2c\x48\x51\xa1\xb2\xc8\xa1\x53\x1c\x0d\xb8\x1d\xc2\xbb\x96\x55\x3b\x84\xb7\xe5\xeb\xe1\x14\x6a\xdd\x29\x9e\x0b\x45\xe7\x1f\x6f\x2e\xaf\x3f\xdd\x5e\x43\x2d\x24\x42\xdc\x33\x5a\x3b\xe0\xc2\x60\xe5\xb4\x79\x04\x5d\x83\x4b\x8c\x39\x83\x58\xe6\x27\xeb\xc3\x21\xcf\xfb\x1e\x38\xd6\x42\x21\x14\x0d\x3a\x56\x40\xd8\x3c\x83\x07\xe1\x76\x80\x3f\x1c\x2a\x0e\x0b\x28\x3e\xb3\xea\x1b\xdb\x62\x01\x8b\x32\xfe\x85\xb3\xc3\x21\xcf\xfa\x1e\x1c\x36\xad\x64\x0e\xa1\xd8\x21\xe3\x68\x0a\x28\x3d\x4a\xdf\x83\xd7\x8d\x46\x26\x21\xd1\xb4\xda\xb8\x02\x16\x74\x54\x69\x65\x1d\x2c\xf3\x6c\xbd\x86\x8f\x6c\x83\x12\x76\x5a\x72\x4b\x51\x58\x67\x84\xda\x82\xa4\x6d\x8e\x4a\x3b\xbf\xf4\x27\x7d\x0f\x52\x3f\xa0\x81\x45\xf9\x89\x35\x08\x87\x03\xb8\xc7\x76\x0c\x9f\x33\xc7\x36\xcc\x62\x99\x67\x01\xf3\x02\x8a\xbe\x87\x45\x19\x56\x87\x43\x41\xf6\x68\xeb\xe6\xaa\xbc\xf4\x3e\x30\xe5\x3c\xcc\x33\xeb\x33\xbb\x82\x43\x2d\x50\xf2\x23\x86\x8e\x81\x0d\x66\x6f\xae\xca\x5b\xa7\x0d\xdb\xe2\x1f\xf8\x18\xcc\xfb\x14\x1b\xa6\xb6\x08\x8b\x1a\xce\x2f\x60\x51\xbe\xf7\xc0\xd6\x67\xd5\xeb\x04\x33\xfe\xa0\x9e\x20\x29\xe3\x83\xe7\x41\xe2\x3f\x5d\x9e\x52\x55\x8f\xb9\xda\xa3\x71\xf8\x03\x5a\xa3\x5b\x34\xee\xf1\x48\x34\xd9\xcc\x42\x8c\xa3\x3e\x1a\xc5\x70\xc9\x5e\x25\x46\x84\x21\xa2\x6b\xbe\x45\x0b\xe4\xb3\x17\x5c\x20\xdf\x86\x13\x4c\xb3\x34\x45\x44\xe7\x3f\x11\x10\x8e\x01\x91\xa6\xf2\x0b\xa1\xa0\xe9\x1c\x73\x42\x2b\x3b\xc4\x31\xe0\xc6\x30\x46\xb5\x23\x01\x2c\x5c\xd3\x4a\xef\x63\x6b\x84\x72\x35\x14\x5c\x30\x89\x95\x5b\xbf\xb2\x6b\xdf\x1f\xeb\x2a\x3a\x6e\x7d\x27\xc4\x74\x40\x6c\x84\x1f\x63\x91\x07\x18\xaa\xf0\x15\x95\x7f\xd8\x78\x19\x76\xcf\x8c\x60\x1b\x89\x4f\x61\xfb\x1e\x44\x0d\x3b\x66\xef\xe6\xd0\xff\x66\x71\xde\x78\xa2\x06\xed\xfb\xe4\x03\xb3\x57\x58\xb3\x4e\xba\xb0\xf8\xc2\xa4\xe0\xcc\x69\x63\x7d\x13\x75\xcd\x07\xad\xbf\xd9\x70\xf4\x59\x4b\x51\xf9\x1b\xce\x01\x00\xe8\xea\xd4\x20\x40\x17\x3b\x8a\x27\x22\xa2\x3e\xa6\xfc\x1c\xe0\x02\x18\xe7\xc9\xfa\x4d\x0a\x12\xfd\xce\x06\x40\x95\x18\xa2\x32\xf9\xa4\x1d\x82\xdb\x31\x47\xa5\x30\x66\x0d\x36\x28\xf5\x03\x30\xe3\x0b\x40\x38\xc1\xa4\xf8\x1b\x39\x6c\x1e\x03\x1b\x76\xca\x89\x06\x03\x42\x1b\xd9\x4b\x87\x9a\x1f\xc5\xa9\x64\x02\x53\x22\xb0\xb6\x95\xa2\xa2\xad\x12\xee\x76\x68\xb0\xd6\x06\x4f\x03\x82\x70\x60\x77\xba\x93\x1c\x36\x08\x81\xcd\x70\x64\x84\x86\x09\x05\xcc\x42\xad\xa5\xd4\x0f\xf6\x9c\x54\xe8\x27\x0b\xa2\xf0\x35\x92\xc2\xa5\x56\xb5\xd8\x8e\x6c\x7a\x38\xac\xa3\x9f\x45\xd4\x49\x13\xb2\x67\xc6\x93\xe4\x0b\x89\xc9\xc2\xff\x3f\x3d\x6e\x72\xf2\x17\x2a\x57\xfa\x45\x54\x1c\xc0\xb2\xe3\xf7\x95\x65\x59\x5c\x78\xbd\xf0\xf7\x98\x66\xe0\x05\x3b\x63\x2d\x22\x2d\x82\xbc\xb9\x2a\xef\x2d\x9a\x2b\x7a\x54\x38\x24\x6c\x46\x77\xdf\xb6\xf4\xa0\xc4\x0d\x2f\x1e\x44\x66\x16\x66\xc4\x58\x0f\x16\xbc\x83\xd1\x73\x46\x18\xe5\x50\xd0\x4b\xa5\x9d\x5f\xdf\xd8\x6b\xd5\x35\xab\x28\x1b\x9c\xe5\x51\x26\x50\x69\xd4\x88\x04\x40\x52\x91\x7c\x06\xb9\x19\xff\x0c\x9b\x7b\x26\x3b\x2a\x99\xca\x60\x28\x94\x5a\x9b\x81\x8d\x12\x62\x25\x5f\xcb\x68\x7c\x86\x39\x75\xa2\x77\xf3\x4e\x34\x18\xfe\xdd\xdf\x53\x06\xea\x4e\x55\xcb\x15\x8c\x89\x08\xb0\x77\xfe\x4d\x9b\x02\x1f\x73\x34\x5e\x60\x5d\xde\xb7\x9c\x39\xbc\x1a\x0d\xbd\x14\xf8\x4c\xee\x97\xc3\xef\x08\xe5\x17\x83\x9f\x22\xff\xa5\x78\x69\x20\x59\xd4\x65\x42\x5c\x69\xb8\xc4\xfe\x21\xd6\x51\x62\x26\x40\x03\xc2\xf9\x05\x8c\x1c\xec\x7d\x80\xe5\x2b\xbb\x02\x34\x46\x9b\xe2\x89\x07\x43\x66\x54\x0c\x4f\x58\x60\x3e\x13\x11\x7a\xc8\x41\x31\x4b\x42\x11\xb3\x00\x37\xce\x2b\x54\x4c\xca\x89\x87\x36\x9d\x90\x1c\x8d\x67\x2b\x4f\x27\x60\xd9\x1e\xa7\x7c\x0d\x76\xe8\xb5\x78\x21\x11\xe9\x62\x35\x27\xfa\xf5\xc9\x30\xc8\x55\x9d\x75\xba\x09\x03\x91\xf7\x12\x55\xd7\x40\xec\x22\x1a\xfa\xa6\x87\xfa\xd9\xe8\x41\xaf\xca\x70\x57\xbe\x99\x06\xbb\xeb\x13\xd0\x8d\x08\xb4\x3b\x50\x28\x79\x5c\x1b\x6f\x6b\x87\x64\xaf\x0c\x06\xe2\xb3\xeb\xd5\xcf\x2f\xc0\x19\xd1\x0c\x2c\x17\x53\x5c\xde\x86\x87\x7d\x91\xd0\x5f\x32\x07\x04\xbb\x31\x1e\x3b\xa2\xbf\x50\x77\x53\x7c\x3e\x99\x24\x98\xa2\x84\x19\x22\xcf\xd3\xae\x99\xf7\x8b\xdf\x5f\x9f\x00\xd4\x42\x71\xc2\x27\x55\x7a\x65\x5e\xe8\x05\x1f\x67\x9c\xc6\x52\xc2\xfa\x7a\x3a\xcc\x3e\x75\xe9\x93\x37\xab\x50\x51\x03\x7e\xf7\xe7\x93\xfd\x2f\xd4\x57\x51\xe6\x59\x19\x7b\x04\x8a\x71\x31\xc9\x3c\xaf\xc9\xd4\xb7\x24\xec\x50\x57\x59\x18\xaf\x43\xbe\xe6\xcd\x0f\x17\x29\xd2\xe8\xe5\xbc\xe7\x8e\x15\xde\xf3\x7b\xa2\x94\xd0\xb4\x35\x4e\xf3\xff\x37\x2d\xcf\xe3\x9c\x21\x0f\x13\x5b\x18\xd6\x02\xe8\xe4\xd4\xca\xfb\x12\xba\xd8\xa6\x6a\x2b\x08\xe5\xb5\x5c\x0d\x03\x64\xef\x95\x0d\xba\xce\xa8\xb8\xb5\xb4\xab\x3c\xcb\x0e\x79\x9e\x34\xdf\x53\xf6\x38\x1b\x4b\x99\x99\xad\x3f\x35\x58\xa1\xd8\x87\x59\xfa\xf7\xd0\xcc\xef\xe3\x8c\x9c\xff\x14\x5f\x78\xbc\x91\x2c\x42\xb9\xc5\x24\xfe\x14\x71\x50\xec\x89\xcd\xe5\x84\x3d\xcf\x07\x11\x5c\xc8\x82\x7d\x10\xae\xda\x41\x2a\x49\x77\x51\x31\x4b\x7d\x13\xef\x4c\x1c\xb9\xb3\x40\x0d\xca\x9f\xc2\x6b\x38\x1c\x4e\x9f\xd0\xf7\xad\x33\x5d\xe5\x86\x8c\xf4\x3d\xb4\xcc\x56\x4c\x86\xfb\x1b\x65\xcf\x43\x65\xc6\xdb\x50\x42\xd2\x3a\x96\xf0\xfc\xb0\x6e\x5c\x79\xed\x5d\xaf\x97\x61\x66\x9a\xd8\xe2\x1c\x84\xa2\xe4\x26\xd9\xa3\x1c\x1f\x79\x93\xce\xe1\xd5\xf7\xe2\x34\x09\xd9\x5f\x7d\x76\xa0\xfb\xcf\x26\x12\x7d\xe9\x83\x95\x06\x74\xc6\xb9\xf0\x0f\x3f\x93\xc3\x97\xeb\x53\xf6\x7d\x37\xa9\x10\x75\x54\x4c\xf9\x01\x51\xef\xd1\x18\xc1\xc3\x84\xa8\x0d\x7d\xd5\x6b\x9a\x81\x27\xc8\xf0\xf9\x3f\x54\x08\x31\x58\xe4\xd8\x72\x64\xec\xf4\x2b\x7d\xe6\x4d\x3a\x3e\xfd\x13\x00\x00\xff\xff\x9f\x55\xe0\x31\x92\x10\x00\x00")
+var _templateMetaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x58\x4b\x8f\xdb\x38\x12\x3e\x4b\xbf\xa2\x60\x38\x80\xdd\xe8\x96\x33\x73\x59\xac\x01\x1f\x32\xe9\xce\xc6\xd8\x20\x3b\x40\x3a\x73\x19\x0c\x06\xb4\x54\xb2\x89\x48\xa4\x42\x52\xee\x78\x05\xff\xf7\x41\xf1\x21\x51\x7e\x74\xd2\xb9\x18\x96\x58\xac\xc7\x57\x1f\xab\x8a\xea\xba\xc5\x4d\xfa\x56\x36\x07\xc5\xb7\x3b\x03\xbf\xbe\xfe\xe5\xdf\x77\x8d\x42\x8d\xc2\xc0\x3b\x96\xe3\x46\xca\x2f\xb0\x16\x79\x06\x6f\xaa\x0a\xac\x90\x06\x5a\x57\x7b\x2c\xb2\xf4\x71\xc7\x35\x68\xd9\xaa\x1c\x21\x97\x05\x02\xd7\x50\xf1\x1c\x85\xc6\x02\x5a\x51\xa0\x02\xb3\x43\x78\xd3\xb0\x7c\x87\xf0\x6b\xf6\x3a\xac\x42\x29\x5b\x51\xa4\x5c\xd8\xf5\x0f\xeb\xb7\x0f\x1f\x3f\x3d\x40\xc9\x2b\x04\xff\x4e\x49\x69\xa0\xe0\x0a\x73\x23\xd5\x01\x64\x09\x26\x32\x66\x14\x62\x96\xde\x2c\x8e\xc7\x34\xed\x3a\x28\xb0\xe4\x02\x61\x52\xa3\x61\x13\x70\x2f\xef\xe0\x89\x9b\x1d\xe0\x37\x83\xa2\x80\x29\x4c\x7e\x67\xf9\x17\xb6\xc5\x09\x4c\x33\xff\x17\xee\x8e\xc7\x34\xe9\x3a\x30\x58\x37\x15\x33\x08\x93\x1d\xb2\x02\xd5\x04\x32\xd2\xd2\x75\x40\x7b\xbd\x91\x41\x88\xd7\x8d\x54\x66\x02\x53\x12\x4a\x73\x29\xb4\x81\x59\x9a\x2c\x16\xf0\x81\x6d\xb0\x82\x9d\xac\x0a\x6d\xa3\xd0\x46\x71\xb1\x85\xca\xbe\x2e\x50\x48\x43\x8f\xb4\xd2\x75\x50\xc9\x27\x54\x30\xcd\x3e\xb2\x1a\xe1\x78\x04\x73\x68\xfa\xf0\x0b\x66\xd8\x86\x69\xcc\xd2\xc4\xe9\x5c\xc1\xa4\xeb\x60\x9a\xb9\xa7\xe3\x71\x62\xed\xd9\x57\xeb\xfb\xec\x2d\xf9\xc0\x84\x21\x35\x67\xd6\x47\x76\x79\x01\x25\xc7\xaa\xb8\x60\x88\x30\xe3\xa5\x53\xf8\xc8\x6b\xfc\x9f\x2a\x50\xa1\x8d\x9f\x6c\xad\x8d\x26\xa0\x59\x5b\x19\xd8\xb3\xaa\x45\x0d\x4c\x21\x18\x5e\xe3\x9d\x74\xa2\xb7\xc0\x44\x01\xf6\x81\xe2\xdc\x1c\x80\x1b\x28\x65\x55\xc9\x27\xe7\x51\xae\x90\x19\x2e\x85\x93\xb1\x21\xd8\xcc\x22\xa0\x30\xdc\x70\xd4\x30\xc3\x6c\x9b\x41\x29\x15\x7c\xc1\x83\x46\x03\x0d\xdb\x72\xe1\x76\xb5\x9a\xd4\x5a\xbf\x66\x5d\x07\xe4\x37\x4c\x29\xf8\x92\x6f\xfb\xa4\x1e\x8f\xd9\x1b\x9d\xcf\x2e\x40\x33\x9f\xcf\x7d\x98\x3e\xad\xc9\x25\xfc\x02\xd2\xeb\xfb\xec\x93\x91\x8a\x6d\xf1\xbf\x78\x70\x88\x13\x42\x8a\x89\x2d\xc2\xb4\x84\xe5\x0a\xa6\xd9\x3b\xc2\x52\x13\x91\x48\x95\x43\x96\x16\xca\x41\xa5\x25\x59\x48\x96\x93\xf8\x6e\x96\x06\x76\x94\x3d\x3d\xf6\xa8\x0c\x7e\x83\x46\xc9\x06\x95\x39\x5c\x48\x60\x32\xb2\xe0\xe3\x28\xcf\xa2\xe8\x13\x5d\x66\xef\x99\xfe\xad\xe2\xa2\x58\x8b\x02\xbf\xd9\x44\x0f\x9e\x66\xc3\xca\x8f\xd3\x6b\x43\x7b\x80\xd3\x26\xc8\x65\xd5\xd6\x02\x64\x79\x35\x24\x8b\x06\x39\x9e\x3c\x67\xb1\x0f\x24\x5e\x26\xd5\x41\x4f\x88\x69\xc8\x6a\xff\x9f\x1e\x42\xca\xd0\xa5\xec\xa1\xd8\xa2\x76\xb1\x92\xe0\x14\x8b\xad\x5b\xc1\x98\x06\x43\xca\xec\xfa\x0b\x32\x86\x7d\x78\x76\xa7\xa0\x07\x2e\xa0\x6e\x8d\x25\xb1\x0e\x89\x0a\x7a\x7d\x78\xfd\xb6\xc9\x79\x00\x53\x53\x37\x15\xf9\xd8\x28\x2e\x4c\x09\x93\x82\xb3\x0a\x73\xb3\x78\xa5\x17\x54\xf3\x16\xb9\x77\x5c\x53\x75\xf3\xf9\x0e\x04\xff\xd6\x17\x2e\xa7\xc6\x56\xad\xb9\x2d\x69\xb6\x42\xc6\x88\x2c\x16\xe0\x1e\x5c\x96\x59\x55\xd9\xe0\xfa\x40\x74\x94\xcd\x71\xe1\xba\x05\xb3\x63\x06\x72\x26\x60\x83\x50\x49\x56\x60\xe1\x0a\x80\x86\x0f\x92\x15\x4e\x6d\x8d\x66\x27\x8b\x2c\x4d\xf6\x4c\x79\x4b\x2b\xf8\xf3\x2f\xc7\xa4\x2e\x4d\xe2\x13\x66\x93\x92\x79\x52\x76\xdd\x49\x7e\x6e\xd3\x24\x86\x29\x39\xad\xd6\x3e\xb4\x77\x5c\x6c\x51\x59\xd8\xfc\x51\xf5\x61\x9e\x2f\x0c\xf9\xb5\xb4\xbc\x1e\xaa\x8b\x94\x6a\xdf\x8e\xe9\xdd\x10\x66\xa4\xd2\x07\x7a\x6b\x4d\xb9\x83\xca\x95\x15\x27\xe2\xd8\xe2\x97\x81\xb7\x4b\x8a\x58\x41\x70\x49\x05\x0a\x6b\xb9\xa7\xe6\xa9\x03\xb1\xac\x33\x59\xac\x9b\x09\x21\x1d\x97\x3c\x90\xe7\xb1\x5c\x05\xb5\x3c\x05\xb5\x7c\x09\xa8\x8e\x40\xd7\x69\xb8\x67\x8a\xb3\x4d\x85\xa7\x34\xec\x3a\xaa\x37\x3b\xa6\x1f\xc7\x54\x7c\x8e\xa1\x63\xcb\xbc\x04\x49\xbd\xf2\x3d\xd3\xf7\xbe\x07\xd9\x87\x3f\x58\xc5\x0b\x66\xa4\xd2\x6e\xf1\x83\xcc\x2d\x32\xee\xe9\x41\xe4\xea\xd0\x18\x2c\xa8\xcb\xb6\xf5\x7b\x29\xbf\x78\xb9\xdf\x65\xc5\x73\xaa\x87\x29\x00\x00\x01\x34\x15\x41\x60\xb9\x8a\xc5\x23\x11\x5e\x5e\xda\x7c\xae\x60\x05\xac\x28\xa2\xe7\x5f\x62\x25\x3e\xa8\xbe\x08\xf7\x52\xa1\xe6\x7c\x94\xc6\x53\x8c\xb8\xd8\x43\x0a\x1b\xac\xe4\x93\x6d\xb9\x5c\x70\xc3\x59\xc5\xff\xef\xb8\x47\x62\xaa\x15\xd4\x88\x9d\x86\xc6\x77\x42\x69\x89\x37\x88\xfb\xd6\xeb\x4e\x30\x6b\x9a\x8a\x3b\xb0\x32\x78\xdc\xa1\xc2\x52\x2a\xa4\x63\x45\x94\x35\xa0\x77\xb2\xad\x0a\x3a\xcc\x6e\xdc\xc1\x7e\x64\xa8\x19\x17\xc0\x74\x68\xec\x4b\xbb\xc5\xfe\x24\x4e\x14\xfe\xf6\x2d\xf4\xac\x33\x2f\xbc\x9f\x13\xbf\x27\x06\x84\x4a\xc2\x2c\x4d\xae\x00\x93\xb8\xff\x7f\x76\xdd\x68\xe5\x2f\x14\x26\xa3\xa5\x13\xe6\x26\x97\xf3\x95\x24\x89\x7f\xa0\x7d\xee\xef\xa5\x9d\x53\x5f\x02\xe2\x1e\x6f\x5b\x7c\x98\x8f\x3e\x6b\x54\xf7\x76\xea\x24\xe7\xfb\xbe\x6b\x73\xdf\x34\x14\x52\x78\x41\x83\x84\x13\x19\x59\x88\xcf\x63\x10\x0d\xa7\xd2\x8e\x61\x34\x49\x4d\xcb\x2c\xb0\x7d\x26\xa4\xa1\xd6\xb9\xd6\x0f\xa2\xad\xe7\x5e\xd6\xaa\x9a\x86\xa9\x6c\xb9\x8a\x76\xf8\x8a\x95\x26\x43\x4b\x0f\x72\xa3\x66\x16\x5e\xda\x91\x0e\xa4\x18\x66\x34\x9a\xc1\x42\x01\xbc\xd4\xb3\x7b\x8c\xcb\xb3\x71\xd1\xd9\x5c\x1b\xd8\xa2\x40\xc5\x0c\xea\xd1\x9c\x18\xe6\xc7\xd9\xe7\xcf\xeb\xfb\xfd\xbf\xe6\x8e\xee\x8e\x4f\x17\xe6\xc4\xc1\x5a\x0f\x61\x92\x9c\x04\x34\xd4\x08\xc2\x88\xfc\x71\xff\xc8\x02\x1c\x8f\x65\x2b\xf2\xd9\x1c\xfa\x2c\xd0\xee\x32\x7b\xa4\x89\x7b\x40\x7d\xd0\x3e\x44\xf6\xb9\x29\x98\xc1\x90\x85\xeb\xa8\x8f\xe4\x7e\x1a\xfb\xd6\x6a\xf9\x11\xe4\x47\x1a\x3d\x31\xfb\xc8\x7f\x2a\x5e\xd7\x31\xcb\x2c\x2a\xa9\x71\xb8\x76\x8e\x59\xae\x46\x12\x7e\xb7\x13\xb0\xad\x71\xb9\x82\xbe\x3b\x90\x0f\x30\x7b\xa5\xe7\x80\x4a\x49\x35\x39\xf1\x20\x20\x23\x7c\x78\x5c\x03\x83\x7d\xaf\x3a\x60\x30\x19\x81\x30\xf1\x28\xc0\xda\xd0\x65\x33\x67\x55\x35\x14\xc1\x4d\xcb\xab\x02\x95\x86\x8d\xad\x65\xa0\xd9\x1e\x07\xbc\x82\x1d\xd2\x67\x9e\x03\xc2\x25\xbe\xef\x24\x57\x40\x08\xeb\x17\x72\x1d\x2c\x0d\x89\xae\x82\x32\x3f\x53\xd0\x61\x08\x87\x40\x96\xdf\xcf\x75\xd0\x78\x43\x1b\x7b\xd7\x9e\x73\x7f\x68\x7d\x63\xdf\xca\xec\x2d\x6f\x76\xa8\x3e\x9e\xf9\x98\xdb\xf7\xc1\x1d\xec\xf7\x3f\xeb\xd8\xa9\x3a\xfd\xb5\xf2\x6f\xd2\x64\xe4\xcf\xa5\x9b\x46\xe4\xd4\xb0\x76\xee\x58\x7c\xa7\xf8\x71\xef\x92\x6b\x8a\xc9\xc5\xe1\x6d\x9a\x5c\x04\xb1\x7f\x8a\x1f\xe6\xe3\xa1\x64\x71\x13\x3e\x3c\xe4\xad\x36\xb2\x76\x17\x78\xe2\x2d\x8a\xb6\x0e\x53\xa4\xfd\x48\xd1\x75\x57\xef\x8d\xd4\x04\x3d\x46\xae\xb6\x07\xbb\x8b\x1b\x90\x35\x37\x36\xde\xd0\xd1\x2d\x0f\x4a\x45\xb6\x88\x45\x87\x06\x33\x67\xc0\x4f\xc8\x64\x77\xb9\x02\xa3\x78\x1d\x9a\xae\x3f\x74\xd9\x27\x3b\x63\x47\x1f\x3f\xfc\x2e\x4b\x18\xdf\x5c\xde\x33\xfd\x1f\x19\x1d\x51\x9f\x1e\x1b\xce\xf1\xe8\xa3\xd5\xbd\xed\x2b\x75\x6a\x88\xde\x66\xc2\x4a\xc6\x6a\xdc\x60\x3a\xc6\x76\x70\x25\xea\x79\x43\x3a\x16\x37\x00\x25\xb1\x80\x4c\x5b\x3d\xb6\x63\x5c\xa9\xa4\x84\x89\xbf\x76\xc7\xbd\xf6\xef\xdb\x70\x07\xa4\xe3\xd1\xd6\xa3\xfa\xc6\x4b\xc0\xaf\xb4\x3e\xd8\xff\x83\xce\x67\x90\x39\x3b\xff\xa4\xc1\x72\x75\x8a\xa7\x74\x0e\xa7\x95\x8f\x7d\x8b\x30\xf0\x14\xb5\xd7\x36\x8f\x9e\x37\x1a\x40\x5c\xc5\x9a\x5e\x40\xd2\xf4\x2c\x6b\x16\x12\x4d\x16\xfb\x2f\x55\x3f\x0a\xcb\x79\x9c\x23\xcd\xe1\xe6\xea\xaf\xe0\x56\xe9\xe0\xd4\x3c\xfd\x2e\xbf\x5c\x87\xd0\xb1\xd2\x39\x38\xa2\xce\xe6\xe1\x9a\x4d\x37\x98\x24\x51\x68\x5a\x25\xfc\xbb\x99\x9e\xd3\xcb\xf3\xd0\xbb\xee\x4a\xa3\xba\xf3\x38\xc1\x94\xa9\x2d\xad\x2a\xcc\x91\xef\xdd\xe7\x88\xdf\x5c\xdf\x78\xe7\xbf\x9c\xa4\x97\x12\x79\xb5\x35\x91\xbe\xbe\x2f\x81\x05\xdd\x23\xfe\xa2\x1e\x65\xa1\x88\x6c\xce\x06\xdd\x63\x78\x6c\x2f\x75\xa0\xe8\x27\x6e\xf2\x1d\xc4\x92\xf4\x3a\xc9\xe9\xbb\xd8\x50\x72\xf8\x85\x04\xbb\x9a\x23\x10\xa6\x1c\x5e\xd3\xcd\xef\x64\x52\xf8\x64\x54\x9b\x9b\x80\x48\xd7\x41\xc3\x74\xce\x2a\x52\x14\xcd\xb2\x34\xfa\x0f\xb9\x11\xbc\xb2\xcf\x9e\xef\xe3\xc5\xb2\x36\xd9\x03\xb9\x5e\xce\x2c\x6c\x51\x19\x5a\x02\x17\x16\xdc\x08\x3d\x5b\x5a\x2e\xd4\xf6\x25\xbc\xfa\x3a\xb9\x8d\x42\xee\x89\xe0\xae\x92\x9e\x0a\xd7\xbe\xdc\xda\x8f\x25\xac\x28\x38\x75\x6e\x56\x85\x4f\xb8\x23\xf1\xc5\x0d\xbc\x19\xb6\xc4\x5f\x35\xe4\x1e\x95\xe2\x74\x53\xe7\x7e\x32\x05\x23\xed\x5d\x6f\x50\xe9\xbe\x83\x07\x86\xd8\xda\xe7\x8b\xb7\xaf\xd4\x27\x9f\xab\x47\xde\xc4\xd7\x84\x7f\x06\x00\x9c\xd0\x32\x42\x9b\x17\x00\x00")
 
 func templateMetaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -981,12 +1044,12 @@ func templateMetaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/meta.tmpl", size: 4242, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/meta.tmpl", size: 6043, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x4d\x6f\xdc\x36\x10\x3d\x4b\xbf\x62\xaa\xb6\xe9\x6e\xb0\xa6\x1c\x17\x05\xda\x6d\x7c\x48\x6d\xa7\x58\xa0\x75\x53\x38\x41\x7a\x0c\x97\x1c\x49\x84\x29\x52\x21\x47\xfb\x81\xc5\xfe\xf7\x82\xa4\xb4\x1f\xf9\x40\x73\x68\x51\x5f\x16\x1e\x0e\xdf\x9b\x37\x6f\x48\x6a\xb7\x2b\x9f\xe6\x37\xb6\xdb\x3a\x55\x37\x04\x57\x97\xcf\x7e\xba\xe8\x1c\x7a\x34\x04\x2f\xb9\xc0\xa5\xb5\x8f\xb0\x30\x82\xc1\x0b\xad\x21\x26\x79\x08\xeb\x6e\x85\x92\xe5\xaf\x1b\xe5\xc1\xdb\xde\x09\x04\x61\x25\x82\xf2\xa0\x95\x40\xe3\x51\x42\x6f\x24\x3a\xa0\x06\xe1\x45\xc7\x45\x83\x70\xc5\x2e\xc7\x55\xa8\x6c\x6f\x64\xae\x4c\x5c\xff\x6d\x71\x73\x77\xff\x70\x07\x95\xd2\x08\x43\xcc\x59\x4b\x20\x95\x43\x41\xd6\x6d\xc1\x56\x40\x27\x64\xe4\x10\x59\xfe\xb4\xdc\xef\xf3\x7c\xb7\x03\x89\x95\x32\x08\x45\xab\x6a\xc7\x09\x0b\x48\xf1\x0b\x58\x2b\x6a\x00\x37\x84\x46\xc2\x37\x50\xbc\xe2\xe2\x91\xd7\x58\x9c\x64\x5e\xec\xf7\x79\xb6\xdb\x01\x61\xdb\x69\x4e\x08\x45\x83\x5c\xa2\x2b\x80\x05\x94\xdd\x0e\xc2\xde\x80\xa7\xda\xce\x3a\x82\x49\x9e\x15\xc2\x1a\xc2\x0d\x15\x79\x56\x54\x2d\x15\x79\x9e\x15\xb5\xa2\xa6\x5f\x32\x61\xdb\xb2\x1a\x1a\xa7\x8c\xe8\x97\x9c\xac\x2b\xd1\x50\x29\x15\xd7\x28\xe2\x9e\x2f\xcd\x2d\xfd\x7b\x5d\x7a\xd1\x60\xcb\x8b\x7c\x9a\xe7\x2b\xee\x02\x7d\x59\xc2\x5b\x45\xcd\xaf\xda\x2e\xb9\x7e\x63\xd4\xfb\x1e\x17\xb7\xe0\x91\x7c\xec\x5c\x6f\xd4\x0a\x9d\xe7\x1a\x94\xf4\x60\x3b\x52\xd6\x78\x20\x1b\x17\x93\x6e\x65\x0d\x8b\x38\x8b\xa1\xad\x29\x2b\xd8\x87\x86\x2f\x35\xca\x19\x84\x11\x38\x64\xc3\x5a\x69\x0d\x5c\x6b\x2b\x42\x8f\x38\x3c\x7b\xfe\xfc\xfb\x2b\x70\xdc\xd4\x18\x81\x2a\x9b\xac\x8e\x94\x15\x20\x17\x4d\x40\x50\xb4\x85\x09\x05\xc4\x69\x22\xbc\xb7\x84\x40\x0d\xa7\x33\x5e\xc1\x8d\xb1\x04\x4b\x04\xde\x75\x5a\xa1\x04\x6b\x20\x6e\xf3\x29\x99\x6b\x87\x5c\x6e\x01\x37\xca\x13\xcb\xb3\x4f\xe8\xbf\x86\xd4\x29\xf6\xf1\xda\xa1\x65\xb7\xce\x76\x37\x56\xf7\xad\x39\xb6\x4b\x3a\xdb\x81\x48\xc1\xa1\x9c\x7f\xa3\x57\x11\xd6\x6a\x39\x40\xfb\x08\x11\xb5\xac\xd1\x21\xf4\xe1\x84\x84\xa6\x2d\x2d\x35\x50\x29\xd4\xd2\x03\x37\x12\x50\xd6\xe8\x19\xc4\x93\x25\xb1\xe2\xbd\xa6\x68\x5e\xc5\xb5\xc7\x41\xf9\x89\x8c\x33\xd5\xc7\xf8\x99\xe2\x85\x91\xb8\xf9\x40\xb0\x8a\xb1\xff\x42\x6f\x44\xc6\x0f\xf5\xa6\x13\x2a\xc7\xd3\x3d\x14\xfd\x79\x99\x67\xa3\xd2\x47\x1f\x41\x58\xe3\xc9\x71\x65\xc8\x03\x3f\xc1\xec\xbd\x32\x35\xbc\x7b\x73\xbf\xf8\xf3\xcd\x1d\x2c\xee\x6f\xef\xfe\x7a\x37\x8b\x10\xa1\xa1\xd4\xa0\xc3\xca\x3a\x9c\x81\xa2\xef\xc2\xed\x25\x6c\xdb\xa2\x91\x28\x03\x61\xd2\x74\xa6\x94\x2c\xd4\x48\xd0\x5a\x37\xcc\xb6\xc6\x8d\x5a\x2a\x1d\x86\xf9\xac\x7e\x10\x4d\x38\x00\xfe\xc4\x96\xd4\xeb\x8f\x5c\x89\xe1\x83\x29\x2f\xd5\x86\x7a\x87\x47\x4b\x42\x79\xaa\x36\x17\x8f\xb8\x05\x87\x86\xb7\x41\xd0\x67\xcc\x81\x75\x83\x06\xfa\xae\x76\x5c\x2a\x53\x47\xd0\xe0\x47\xe5\x6c\x0b\xab\x4b\xf6\x8c\x5d\xc2\x44\x79\xdf\xe3\xc5\xd7\x57\x3f\xfe\x30\x65\x70\x7b\xd2\x5f\x72\xfd\x38\x45\x63\x15\x67\xc5\x0e\xc1\x70\xdb\x94\x25\x3c\x24\x99\x2a\x55\xf9\xe2\xd5\x22\x8e\xac\x70\xc8\x49\x99\x7a\x36\x16\x65\xea\xd8\xe9\x30\x02\x5d\xfc\x67\x44\xcc\x69\xdb\xe1\x88\xe2\xc9\xf5\x82\x60\x97\x67\xd2\xad\x60\xfc\x1b\xae\x3a\x76\xeb\xc2\xad\x95\x67\x87\xdb\x6b\x71\x0b\x4b\x6b\x75\xbe\x8f\x95\xdc\xe3\x7a\x80\x89\xec\xe8\x81\x83\xc1\xf5\xc1\x08\xad\xd0\x10\xcb\xab\xde\x88\x63\xee\x24\x10\x9d\x13\x4c\xe1\xe9\x80\xb3\x03\x87\xd4\x3b\x03\x4f\x52\x60\x27\xdd\x6a\x0e\xd2\xad\xf6\x90\x28\x6f\x22\xd1\x91\x4f\xeb\x91\xcd\x61\x7a\x86\xfc\x40\x38\xf1\x23\xea\x74\xd8\x35\x11\xb4\x81\xe1\x95\x60\x37\xe9\x77\x16\x1c\xf5\xc0\x18\x1b\xba\xf3\x7b\x7a\x83\xfe\x88\x3e\x4f\x01\x9d\xb3\x2e\xb4\x67\x78\x9b\x66\x21\x02\xf3\x83\x3d\xf7\xb8\x1e\x76\x4c\x3c\x93\x6e\x95\xf0\x18\x63\xd3\x3c\x53\x55\x4c\xfe\xea\x1a\x8c\xd2\x01\x23\x1b\xc4\x55\x2d\xb1\xbb\x00\x5c\x4d\x8a\xf0\xb2\x0c\xd8\x73\xf8\x76\x55\x44\x82\x69\x9e\xed\xf3\x31\x7b\x58\x65\x47\x11\x33\x78\x1d\xaf\xe1\x48\x93\xfa\xf2\xd6\x29\xc2\xd7\x16\xd6\xe1\xd7\x7f\xe2\x38\x84\x39\x5b\x83\x32\x9e\x90\xcb\xf0\x1a\xb8\xde\x98\x30\x17\xd4\x60\x0b\xbc\xe6\x61\x29\x5d\x45\x9c\xf8\x92\x87\x03\x5f\x96\x01\x7a\xd4\x31\xbf\x1e\x1d\x7d\x18\x46\x33\x71\x4e\xc6\x96\xfe\xc2\xc5\x63\xed\xc2\x37\xc4\x64\x3a\x03\xeb\xd9\x03\x49\xdb\xd3\xf4\xe7\xf3\x36\x94\x65\x96\x69\x5b\xb3\x97\x9c\xb8\x9e\x44\xb5\x81\x65\x1f\xe8\x3e\x72\xee\xc0\xf1\x29\xeb\xd6\xa0\x6c\xaa\xc2\x7d\xb1\x8f\x61\xfa\xe6\xd7\xf0\xc4\x9f\x68\x48\x53\x18\x0c\x4a\x60\x73\x58\xcf\xf2\x2c\x4b\xe1\x39\x24\x63\xa3\x25\xff\x3c\x05\xff\xd3\x0c\x1c\x3e\x89\xfe\x0e\x00\x00\xff\xff\x73\xfb\x09\x2f\x3d\x0a\x00\x00")
+var _templateMigrateMigrateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x61\x8f\xdb\xb8\x11\xfd\x6c\xfd\x8a\xa9\xdb\x5e\xed\xc0\x2b\x27\x29\x0a\xb4\x7b\xb7\x1f\xd2\x5d\x6f\x6b\xe0\xea\xec\xd5\x1b\x5c\x80\xa2\xe8\xd1\xe2\x48\x26\x56\x22\x75\xc3\x91\xed\x85\xe1\xff\x5e\x0c\x45\xd9\x72\x76\xd3\x24\x45\xda\x0b\x10\x18\x21\xa9\x37\x33\xef\x3d\xce\x30\xfb\xfd\xf4\x45\x72\xed\xea\x47\x32\xc5\x9a\xe1\xf5\xcb\x57\x7f\xba\xa8\x09\x3d\x5a\x86\x5b\x95\xe1\xca\xb9\x07\x98\xdb\x2c\x85\x37\x65\x09\xe1\x90\x07\xd9\xa7\x0d\xea\x34\xb9\x5f\x1b\x0f\xde\x35\x94\x21\x64\x4e\x23\x18\x0f\xa5\xc9\xd0\x7a\xd4\xd0\x58\x8d\x04\xbc\x46\x78\x53\xab\x6c\x8d\xf0\x3a\x7d\xd9\xed\x42\xee\x1a\xab\x13\x63\xc3\xfe\xf7\xf3\xeb\xd9\x62\x39\x83\xdc\x94\x08\x71\x8d\x9c\x63\xd0\x86\x30\x63\x47\x8f\xe0\x72\xe0\x5e\x30\x26\xc4\x34\x79\x31\x3d\x1c\x92\x64\xbf\x07\x8d\xb9\xb1\x08\xc3\xca\x14\xa4\x18\x87\xd0\xae\x5f\xc0\xd6\xf0\x1a\x70\xc7\x68\x35\xfc\x06\x86\x77\x2a\x7b\x50\x05\x0e\x7b\x27\x2f\x0e\x87\x64\xb0\xdf\x03\x63\x55\x97\x8a\x11\x86\x6b\x54\x1a\x69\x08\xa9\xa0\xec\xf7\x20\xdf\x0a\x9e\xa9\x6a\x47\x0c\xa3\x64\x30\xcc\x9c\x65\xdc\xf1\x30\x19\x0c\xf3\x8a\x87\x49\x32\x18\x16\x86\xd7\xcd\x2a\xcd\x5c\x35\xcd\x23\x71\xc6\x66\xcd\x4a\xb1\xa3\x29\x5a\x9e\x6a\xa3\x4a\xcc\x78\xf8\x05\x67\xa7\xfe\xe7\x72\xea\xb3\x35\x56\x6a\x98\x8c\x93\x64\xa3\x48\xc2\x4f\xa7\xf0\xa3\xe1\xf5\x5f\x4a\xb7\x52\xe5\x3b\x6b\x7e\x6e\x70\x7e\x03\x1e\xd9\x07\xe6\x1a\x6b\x36\x48\x5e\x95\x60\xb4\x07\x57\xb3\x71\xd6\x03\xbb\xb0\xd9\xd6\x6d\x9c\x4d\x03\xce\x3c\xd2\xda\x9e\x12\xf9\xd0\xaa\x55\x89\x7a\x02\x62\x81\xe3\x69\xd8\x9a\xb2\x04\x55\x96\x2e\x13\x8e\x14\xbc\xfa\xee\xbb\xdf\xbf\x06\x52\xb6\xc0\x00\x94\xbb\x56\xea\x10\x32\x07\x54\xd9\x5a\x10\x0c\x3f\xc2\x88\x05\x71\xdc\x06\x5c\x38\x46\xe0\xb5\xe2\xb3\xb8\x99\xb2\xd6\x31\xac\x10\x54\x5d\x97\x06\x35\x38\x0b\xe1\x33\x29\x49\x31\xa8\x92\x50\xe9\x47\xc0\x9d\xf1\x9c\x26\x83\x67\xea\xbf\x82\x96\xa9\xf4\xe9\xde\x91\xb2\x1b\x72\xf5\xb5\x2b\x9b\xca\x9e\xe8\xd2\xe4\x6a\xc8\xda\xc5\x98\xce\xd7\xe0\x2a\xc0\xba\x52\x47\x68\x1f\x72\x08\xb5\x6c\x91\x10\x1a\xb9\x21\x42\xda\xca\xf1\x1a\x72\x83\xa5\xf6\xa0\xac\x06\xd4\x05\xfa\x14\xc2\xcd\xd2\x98\xab\xa6\x14\x59\x1d\xe4\xaa\xf4\x18\x2b\xef\x95\x71\x56\xf5\x69\xfd\xac\xe2\xb9\xd5\xb8\xfb\xa0\x60\x13\xd6\xfe\x17\xf5\x06\x64\xfc\xb0\xde\xf6\x86\xea\xee\x76\xc7\xa4\x3f\x5e\xe6\x99\x55\x9a\xe0\x71\xc8\x9c\xf5\x4c\xca\x58\xf6\xa0\x7a\x98\x8d\x37\xb6\x80\x9f\xde\x2d\xe6\x3f\xbc\x9b\xc1\x7c\x71\x33\x7b\xff\xd3\x24\x40\x08\xa1\xbc\x46\xc2\xdc\x11\x4e\xc0\xf0\xef\xa4\x7b\x65\xae\xaa\xd0\x6a\xd4\x12\xb0\xd5\xf0\xac\x52\x76\x50\x20\x43\xe5\x28\x7a\xbb\xc4\x9d\x59\x99\x52\xcc\x7c\x96\x3f\x64\x6b\xb9\x00\xbe\x27\x4b\xcb\xf5\x13\x55\xc2\xf2\x51\x94\x5b\xb3\xe3\x86\xf0\x24\x89\xa4\x67\x0a\x7b\xf1\x80\x8f\x40\x68\x55\x25\x05\x7d\x44\x1c\xd8\xae\xd1\x42\x53\x17\xa4\xb4\xb1\x45\x00\x15\x3d\x72\x72\x15\x6c\x5e\xa6\xaf\xd2\x97\x30\x32\xde\x37\x78\xf1\xeb\xd7\x7f\xfc\xc3\x38\x85\x9b\x1e\xbf\x4c\x4d\xe7\xa2\x2e\x8b\xb3\x64\xe3\xe2\x31\xd5\x3b\xc2\xbf\xca\x0c\x50\x5a\x7b\x20\xb5\x85\xe5\x0f\xdf\x83\x67\xc5\x58\xa1\xe8\x10\xf4\x11\x31\x70\x87\x59\xc3\x27\x85\x4f\xf9\x32\x29\xeb\x55\x26\xc5\x04\xd8\xd0\xca\x11\x0a\xb3\x41\x0b\xb1\xd3\x4d\x60\x15\x34\x0a\x3b\xf1\xe2\x0b\x6a\x46\xa8\x04\xd4\x11\xa8\x92\x91\x50\xa7\x70\xeb\x08\x70\xa7\xaa\xba\xc4\x4b\x01\x94\xbf\x83\xd8\xcf\xd3\x5e\xce\xa3\x88\x9d\xde\x39\xcf\x05\xa1\x9f\xc0\xf0\xfa\xef\xb3\x37\xf7\x33\x98\xbd\xbf\x9f\x2d\x96\xf3\xb7\x0b\x98\xdf\xc2\xe2\xed\x3d\xcc\xde\xcf\x97\xf7\x4b\xa8\x8b\x7f\x31\x15\xd5\x70\x2c\x98\xc9\xa0\x07\x76\xce\x52\x5c\x3c\xb1\xe4\x3c\xff\x7f\x68\x52\x39\x23\x9d\xb1\xd4\x5a\xdc\x50\xdf\x44\xbe\xbd\x74\xcf\xb0\xf7\xa4\x09\x63\x3f\x4d\x97\xb7\xfd\x68\xed\xdc\x83\x07\x6a\xac\xf4\x61\xdc\x20\x3d\x9e\x12\x9d\x84\x36\x55\x35\x3e\xf4\x6b\xa3\xb1\xaa\x1d\xa3\xed\xba\xf2\x91\x8a\x73\xc2\xe2\xaa\x4c\xb1\xe9\x14\x96\x81\x4a\x69\xa1\xc2\xc1\x9b\xbb\xb9\x24\xdf\xa6\x6b\x6c\x31\xe9\x82\xd9\x22\xc4\x92\x86\x55\xcb\x8d\x50\x1d\x64\xc2\x8f\x35\x76\x28\x9e\xa9\xc9\x18\xf6\xc9\x40\xd3\x06\xba\x3f\x9d\xf8\x37\x24\xd3\x30\x19\x1c\xa7\xe2\xfc\x06\x56\xce\x95\xc9\x21\x64\xb2\xc0\x6d\x84\x09\xd1\xc5\x76\x60\x71\x1b\x03\x41\x56\x9a\x50\x5a\xde\xd8\xec\x74\x76\x24\x81\xce\x03\x8c\xe1\x45\xc4\xd9\x03\x21\x37\x64\xe1\x9b\x76\x61\xaf\x69\x73\x09\x9a\x36\x07\x68\x43\x5e\x87\x40\x51\x1c\x2f\x43\xb5\x8b\x46\xd8\x3e\x6f\x7c\x0c\x38\xf2\x1d\xea\x38\x7e\x35\xca\x78\x07\xf1\xf5\x91\x5e\xb7\xbf\x13\xe9\xd0\x1e\xd2\x34\x8d\xec\xfc\x2d\xb0\x87\x6f\x43\xff\x18\x03\x12\x39\x12\x7a\xe2\x1d\x99\xc8\x0a\x5c\x1e\xf5\x59\xe0\x36\x7e\x31\xf2\xa9\xa6\x4d\x8b\x97\xa6\xe9\x38\x19\x98\x3c\x1c\xfe\xd5\x15\x58\x53\x0a\xc6\x20\x16\x97\x57\x9c\xce\x04\x38\x1f\x0d\xe5\xc5\x12\xb1\x2f\xe1\xb7\x9b\x61\x08\x30\x4e\x06\x87\xa4\x3b\x1d\x77\xd3\x53\x11\x13\xb8\x0f\xfe\x0d\x61\x5a\x5e\x7e\x24\xc3\x78\xef\x60\x2b\xbf\xfe\x99\x36\x2b\xad\x70\x0b\xc6\x7a\x46\xa5\xe5\x45\x48\x8d\xb5\xe2\x0b\x5e\x63\x05\xaa\x50\xb2\x15\xbe\xd3\x8a\xd5\x4a\xc9\xbc\x9c\x4e\x05\xba\xab\xe3\xf2\xaa\x53\x74\x19\xbd\x29\xb1\xee\xdd\xa8\xa3\xf4\xcf\x2a\x7b\x28\x48\xde\xa6\xa3\xf1\x04\x9c\x4f\x97\xac\x5d\xc3\xe3\x6f\xcf\x69\x98\x4e\x07\x83\xd2\x15\xe9\xad\x62\x55\x8e\x42\xb5\x12\xe5\x90\x4c\xa7\x4f\x95\x3b\xc6\x78\x4e\xba\x2d\x18\xd7\x66\x41\x9f\xad\xa3\xb8\xef\xf2\x0a\xbe\x89\xc7\xc2\xd7\xad\x0b\x45\xa0\xf0\x4f\xba\x84\xed\x24\x19\x0c\xda\xe5\x4b\x68\x85\x0d\x92\x7c\xda\x05\xbf\xa0\x07\xee\x4a\x65\xaf\xa3\xda\xad\x77\xfc\x87\x3d\xea\xd8\xb7\x8e\x1d\x09\xb6\xae\x29\x75\xd7\x5a\x9f\x35\xc2\x44\x5c\xd0\xce\x6e\x43\xf1\xa4\x7c\xe9\x48\x23\x4d\xc2\x7f\x13\x5c\xc3\xdd\x46\xb4\x94\xbc\xc2\x10\xb2\x86\x48\xe6\x6b\x48\xa1\xeb\xca\x1d\x2e\x18\xdf\x22\xfb\x1a\x33\x3e\xbe\x45\x7a\xde\xd5\x41\x81\x49\xd7\xa7\xfb\x95\x10\x86\xaf\x7a\x6d\x3e\x3e\xd6\xa3\x6d\x07\x9e\x2b\xf6\x93\xe7\x9d\xdb\x63\xaa\xe5\xb2\xe3\xf7\xec\xc9\x31\x92\x79\x3f\x1e\x0b\x98\xc9\x3f\xc3\xc3\x1f\xb1\xf0\x07\xc1\xbe\xb4\x03\x8d\xfe\xf1\x4f\xcf\x14\x3a\x7b\x30\xf1\xf8\x19\x17\x4b\x88\x68\xe2\x33\xd3\x7e\x55\xc7\x5a\x53\x4e\x3e\xd7\xb6\x11\xe4\xf2\xea\x13\xce\xfd\xf6\x3f\xc5\x42\xa2\xfe\x1d\xd0\xb4\x49\x97\xad\xa8\xd6\x74\x23\xa8\x47\xee\xd7\xf0\xbc\x40\x1e\xed\x79\xfe\x88\x88\x56\xfc\x98\xdb\x97\xd8\xcd\xd4\xb4\x97\x53\x9c\x45\x9f\xb4\xc0\xd3\x99\xf8\x5f\xd9\x22\x32\x75\x36\x6b\xc7\xfd\x7c\x5a\xb7\x1f\xc5\x3e\x24\xfb\x3d\xa0\xd5\x70\x38\xfc\x7b\x00\x35\xd1\x65\x44\xcd\x10\x00\x00")
 
 func templateMigrateMigrateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1001,12 +1064,12 @@ func templateMigrateMigrateTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 2621, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/migrate/migrate.tmpl", size: 4301, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4b\x6f\xe3\x36\x10\x3e\x4b\xbf\x62\x20\xb8\xc5\x6e\x60\x4b\x49\x6e\x15\xe0\x43\x90\xcd\x02\xc1\x16\xe9\xa2\xc9\x9e\x82\xa0\x60\xa8\x91\x45\x58\x22\x15\x8a\x4a\xe3\xaa\xfa\xef\x05\x1f\x7a\xf9\x11\x7b\xbb\xeb\x8b\xf9\x98\x19\x72\xbe\x99\x6f\x86\x6a\x9a\xe8\xcc\xbf\x16\xe5\x46\xb2\x55\xa6\xe0\xf2\xfc\xe2\xb7\x45\x29\xb1\x42\xae\xe0\x33\xa1\xf8\x2c\xc4\x1a\x6e\x39\x0d\xe1\x2a\xcf\xc1\x08\x55\xa0\xf7\xe5\x2b\x26\xa1\xff\x90\xb1\x0a\x2a\x51\x4b\x8a\x40\x45\x82\xc0\x2a\xc8\x19\x45\x5e\x61\x02\x35\x4f\x50\x82\xca\x10\xae\x4a\x42\x33\x84\xcb\xf0\xbc\xdb\x85\x54\xd4\x3c\xf1\x19\x37\xfb\xbf\xdf\x5e\xdf\xdc\xdd\xdf\x40\xca\x72\x04\xb7\x26\x85\x50\x90\x30\x89\x54\x09\xb9\x01\x91\x82\x1a\x1d\xa6\x24\x62\xe8\x9f\x45\x6d\xeb\xfb\x4d\x03\x09\xa6\x8c\x23\x04\x15\xcd\xb0\x20\x01\xd8\xe5\x05\xfc\xcd\x54\x06\xf8\xa6\x90\x27\x30\x83\xe0\x2b\xa1\x6b\xb2\xc2\x00\x82\x82\xad\x24\x51\x18\xc0\xa2\x6d\x7d\xaf\x69\x40\x61\x51\xe6\x44\x21\x04\x19\x92\x04\x65\x00\xa1\xb6\xd2\x34\xa0\x75\xb5\x3d\x56\x94\x42\x2a\xf8\x60\xc4\x25\xe1\x2b\x84\xd9\x5f\x73\x98\x71\x88\x97\x30\x0b\xef\x44\x82\x95\x16\xf4\xbc\xa0\x69\x60\x16\x5e\x0b\x9e\xb2\x55\xe8\xce\x84\xb6\x8d\xf4\x32\x1f\x2d\x04\xda\xd4\xa2\x3f\xc0\x0b\x56\x4c\x65\xf5\x73\x48\x45\x11\xa5\x0e\x7c\xc6\x69\xfd\x4c\x94\x90\x11\x72\x15\x59\xff\xa2\x94\x61\x9e\x04\xa7\x28\x24\x8c\xe4\x48\x55\x54\xbd\xe4\x4e\x39\xf0\x3f\xfa\xfe\x2b\x91\xd6\x91\xc5\xd8\x13\x65\x3d\x79\x20\xcf\x79\xe7\x8a\x96\x88\xce\x20\x65\x3c\x01\xb5\x29\x11\xb8\x89\xb2\x0d\xd1\x4a\x92\x32\xeb\x23\xa3\xb4\xda\x1c\x58\x0a\xf8\xc6\x2a\x55\x81\x89\x8e\x35\x31\x33\x6a\xf1\x12\x18\x4f\xf0\xad\x47\xeb\x7c\x38\xe4\x30\xa0\x4d\x63\x6c\xbe\xc0\x4c\x85\x77\xa4\x40\x8d\xa1\xb9\xa2\xdd\xb3\xa6\x97\x5a\xcd\xcc\x2d\x9a\x43\xdc\xdc\x05\xa8\xc8\xeb\x82\x57\xda\x74\x49\x2a\x4a\xf2\xde\xdc\xbf\x50\x4a\xc6\x55\x0a\xc1\x2f\xd5\xb5\x95\x0a\xac\x62\x14\x81\x3e\xa0\x53\x6d\x5b\xc8\x44\x9e\x54\xc6\xf7\x6e\x31\x15\x36\xc5\x4d\xcc\x9d\xc5\xb6\x0d\x2c\x1a\xa1\x39\x7d\x62\x61\x09\x8f\x4f\x67\x36\x12\xa1\x3d\xad\xf1\xbd\x1d\x08\xa8\x81\x40\x39\x09\x17\x0b\xcf\x6b\x40\xdb\x8f\xed\x61\xb4\x3f\x6c\x0e\x0f\x9b\x12\x63\x30\x69\x11\xda\x3d\xbd\xa2\x53\xb0\x52\x4e\x6a\x6e\x2d\x34\x0b\x8d\xe6\x8c\x86\xdf\x38\x7b\xa9\xf5\x06\xd8\x51\x0c\x4a\xd6\x38\x1f\x03\x37\x16\xbf\xe5\x54\x62\xa1\xcb\x42\xdb\x42\x3f\x39\xa2\x74\x57\xe7\xb9\x8b\x14\x74\xe3\x18\xdc\xe5\x87\xbd\x3d\xfa\x86\xb8\x33\x1a\xde\xb3\x7f\x8c\xb6\xfe\x37\x9a\xe1\xfb\xf2\x57\x4a\x49\x2d\xaf\xff\x2d\x4e\xa1\x41\xe8\xb0\xc6\x0d\xaf\x0b\x13\x19\x33\x88\xe1\xf1\xa9\x52\x92\xf1\x55\x03\x03\xcd\x4d\xea\x1a\x43\xfa\xee\x38\xb5\x08\xef\xdd\xe7\x13\xa6\xa4\xce\x0d\x68\x6e\x78\x8a\x17\xf7\x26\x3f\x74\x08\x8d\xef\xfd\x2c\x86\x82\x94\x8f\xf6\x7e\x7b\xae\xb9\x9e\xc3\xec\x75\x72\xd5\xb5\x1e\xb8\x7c\x79\x9d\x5e\x7b\xa0\x88\x4d\x8d\x51\x25\xf2\xbc\x9e\x36\x26\x8d\x8f\x90\xc6\x90\x71\x4a\x19\xd5\x45\x7d\x20\x8c\xcd\x79\x60\x3c\x15\xb2\x20\x8a\x09\x7e\x1a\x77\x7a\x53\x4b\xf8\xd5\xf1\xc6\x1c\x68\x68\x33\xa2\xc3\xa0\x6f\xdc\x71\xcc\x89\xb7\x18\x6c\xf6\xbe\x4a\x56\x10\xb9\xf9\x82\x9b\x78\x3f\x1b\xb7\xe9\x58\xae\x1d\x1f\x07\xcd\x2e\x6c\x63\x51\x76\x98\xb9\x3d\x2b\x74\x1d\x2b\xd7\xae\x90\xf5\x14\x9e\x5e\xf2\x51\x4f\x19\xb4\xed\xd3\x56\x8e\x4c\x83\xb4\x3d\xb5\xce\x7d\x16\x12\xd9\x8a\x7f\xc1\x4d\x35\xf6\x6e\x58\xde\xeb\x61\xda\x79\x38\x52\x1f\x4e\x75\x2e\xdc\x6f\x8a\x67\x91\x3b\xbc\xd3\x75\x68\xe7\x3d\xe4\x63\xd4\xf7\xc3\xea\x01\xec\x96\xba\x0b\x73\x72\xba\xde\x85\x6c\x17\xdc\xcb\x43\xe8\x4e\x01\xa6\x17\x1d\xc0\x97\xdf\x8b\xf0\x2e\xc8\xfb\x56\xda\x79\x1f\xd5\xe8\x0c\x4a\x51\xa9\x52\x70\x04\x89\xa9\x44\x4e\x19\x5f\x81\x12\x40\x5e\x05\xb3\x5d\x93\x66\x48\xd7\x7a\x35\x17\xa2\xec\x1b\xa3\xfe\xfd\x89\xe9\x0f\x61\x36\xe8\x1f\x87\xcd\x8a\x1b\xf2\xfc\x3f\x00\xbb\x1a\x30\x36\xf4\x5e\x0b\xfd\x89\x28\x77\xb5\x31\x5d\x87\x7f\xf0\x6f\x65\x42\xd4\xb4\xbb\x75\x36\xba\xcd\xd8\xd5\x9b\xb0\x2b\xb6\xfe\x81\x33\xb6\x4c\x7f\xc2\x1c\x0f\x9a\xb6\x9b\xa7\x9a\x1e\x75\xdc\x6d\x8e\x76\x1d\x52\x85\xb7\xfa\x3d\x84\x7d\x1c\xdc\x74\x9c\x0b\x66\xa9\xd9\xa9\x35\x3a\x0d\x58\xf2\xe6\xf8\xb0\x65\x66\xa0\xec\xb8\x42\xb2\xe4\x6d\x5a\x23\xf5\xaf\x6b\xfe\x9d\x40\xff\x2c\xe8\x25\x8e\xe5\xe7\x9e\xd7\x8b\x4d\x4f\x6d\xee\x50\x9e\x9d\x4a\xea\x9f\xc7\xea\x3d\x09\xb7\x67\xa9\x77\xbb\x1b\x6c\x89\xec\xef\x95\xe3\x79\x14\x81\x7b\x40\xdb\xde\x47\xf2\xdc\x34\x39\x65\x17\xdd\xd3\xd9\x01\xe9\x7b\x4e\x76\xfc\x2c\xec\xdb\xdb\xf1\xe7\xb9\x37\x62\xe5\x7b\x9d\x79\xee\x4f\x2f\xdd\xea\x8f\x80\xb4\xe6\x14\x18\x67\xea\xc3\x47\x68\x4e\xfd\x18\xf8\xee\x17\xc1\x56\xb4\xdf\x69\x34\xe3\x6e\x3f\xde\x1e\xc2\xda\x97\x1d\x58\xc2\xa9\xf5\x68\xfb\x2e\x1d\x04\xa3\xb1\xfd\x86\x74\x93\xff\x02\x00\x00\xff\xff\x1c\x78\xd4\x5e\x12\x0f\x00\x00")
+var _templateMigrateSchemaTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x4d\x6f\xdb\x38\x13\x3e\x4b\xbf\x62\x20\xf8\x7d\xd1\x06\x8e\xdc\xe6\xb6\x06\x72\x28\xd2\x74\x11\x74\x91\x16\x9b\x16\x7b\x08\x8a\x05\x43\x8d\x6c\xc2\x12\xa9\x50\x94\x37\x5e\xad\xfe\xfb\x82\x1f\xa2\x28\x7f\xc5\xc9\xf6\x64\x7e\xcc\x0c\x67\x9e\x19\x3e\x1c\xb9\x6d\x67\x67\xf1\x95\xa8\x36\x92\x2d\x96\x0a\x2e\xde\xbd\xff\xe5\xbc\x92\x58\x23\x57\xf0\x89\x50\x7c\x10\x62\x05\x37\x9c\xa6\xf0\xa1\x28\xc0\x08\xd5\xa0\xf7\xe5\x1a\xb3\x34\xfe\xb6\x64\x35\xd4\xa2\x91\x14\x81\x8a\x0c\x81\xd5\x50\x30\x8a\xbc\xc6\x0c\x1a\x9e\xa1\x04\xb5\x44\xf8\x50\x11\xba\x44\xb8\x48\xdf\xf5\xbb\x90\x8b\x86\x67\x31\xe3\x66\xff\xb7\x9b\xab\xeb\xdb\xbb\x6b\xc8\x59\x81\xe0\xd6\xa4\x10\x0a\x32\x26\x91\x2a\x21\x37\x20\x72\x50\xc1\x61\x4a\x22\xa6\xf1\xd9\xac\xeb\xe2\xb8\x6d\x21\xc3\x9c\x71\x84\xa4\xa6\x4b\x2c\x49\x02\x76\xf9\x1c\xfe\x62\x6a\x09\xf8\xa4\x90\x67\x30\x81\xe4\x2b\xa1\x2b\xb2\xc0\x04\x92\x92\x2d\x24\x51\x98\xc0\x79\xd7\xc5\x51\xdb\x82\xc2\xb2\x2a\x88\x42\x48\x96\x48\x32\x94\x09\xa4\xda\x4a\xdb\x82\xd6\xd5\xf6\x58\x59\x09\xa9\xe0\x8d\x11\x97\x84\x2f\x10\x26\x7f\x4e\x61\xc2\x61\x7e\x09\x93\xf4\x56\x64\x58\x6b\x95\x28\x4a\xda\x16\x26\xe9\x95\xe0\x39\x5b\xa4\xee\x4c\xe8\xba\x99\x5e\xe6\xc1\x42\xa2\x4d\x9d\xfb\x03\xa2\x64\xc1\xd4\xb2\x79\x48\xa9\x28\x67\xb9\x03\x9f\x71\xda\x3c\x10\x25\xe4\x0c\xb9\x9a\xd9\xf8\x66\x39\xc3\x22\x4b\x4e\x51\xc8\x18\x29\x90\xaa\x59\xfd\x58\x38\xe5\x24\x7e\x1b\xc7\x6b\x22\x6d\x20\xe7\x61\x24\xca\x46\xf2\x8d\x3c\x14\x7d\x28\x5a\x62\x76\x06\x39\xe3\x19\xa8\x4d\x85\xc0\x4d\x96\x6d\x8a\x16\x92\x54\x4b\x9f\x19\xa5\xd5\xa6\xc0\x72\xc0\x27\x56\xab\x1a\x4c\x76\xac\x89\x89\x51\x9b\x5f\x02\xe3\x19\x3e\x79\xb4\xde\x0d\x87\x1c\x06\xb4\x6d\x8d\xcd\x47\x98\xa8\xf4\x96\x94\xa8\x31\x34\x2e\xda\x3d\x6b\xfa\x52\xe7\xc1\xcc\x2d\x9a\x43\xde\x9c\x03\x54\x14\x4d\xc9\x6b\x6d\xba\x22\x35\x25\x85\x37\xf7\x0f\x54\x92\x71\x95\x43\xf2\xbf\xfa\xca\x4a\x99\x02\x8a\xa2\xd9\x0c\xda\x76\x50\xed\x3a\x58\x8a\x22\xab\x4d\xec\xfd\x62\x2e\x6c\x89\x9b\x9c\x3b\x8b\x5d\x97\x58\x34\xd2\x38\x8a\xb6\x2c\x5c\xc2\xfd\x8f\x33\x9b\x89\xd4\x9e\xd6\xc6\xd1\x0e\x04\x54\xfb\x39\x51\x4e\xc2\xe5\x22\x8a\x5a\xd0\xf6\xe7\xf6\x30\xea\x0f\x9b\xc2\xb7\x4d\x85\x73\x30\x65\x91\xda\x3d\xbd\xa2\x4b\xb0\x56\x4e\x6a\x6a\x2d\xb4\xe7\x1a\xcd\x09\x4d\xbf\x73\xf6\xd8\x68\x75\xb0\xa3\x39\x28\xd9\xe0\x34\x04\x2e\x14\xbf\xe1\x54\x62\xa9\x69\xa1\xeb\xc0\x4f\x9e\x51\xba\x6d\x8a\xc2\x65\x0a\xfa\xf1\x1c\xda\x76\x6b\x6f\x8f\xbe\xb9\xb8\x13\x9a\xde\xb1\xbf\xb5\x04\xe8\x5f\xa3\x99\x1e\x97\xff\x2a\x91\xb2\x9a\x09\x5d\x0c\xe0\x27\xa7\x68\xde\x51\x62\x1d\x35\x83\x53\x34\x3e\x28\x25\xb5\x82\xfe\xb5\x39\xd1\xce\x25\x47\x34\xae\x79\x53\xea\x64\x82\x19\xcc\xe1\xfe\x47\xad\x24\xe3\x8b\x16\x06\x4a\x41\x9d\x7a\x63\x48\xe3\x84\x63\x8b\x70\xcc\x9f\x8f\x98\x93\xa6\x30\x09\x72\xc3\x53\xa2\x70\xa2\xd7\x4f\x95\x0c\x34\xf5\xd4\x68\xfb\xbb\xf1\x98\xbc\xc0\x52\xbd\x65\xaa\x9e\x43\x49\xaa\x7b\x1b\xed\x9e\xa0\x57\x53\x98\xac\x47\x81\xaf\x74\xe0\x3b\x1e\x4c\xd6\x23\x17\x8e\x79\x73\x87\x8f\x0d\x72\xaa\x01\x84\x7e\xfc\xd2\x88\x7e\x45\x8e\xfa\x99\xd0\xe1\x82\x9f\x9c\x6a\xc5\x5e\x9c\x3b\x25\xa4\x35\x60\x47\x07\xaf\x8c\x77\xdc\x90\x83\xbe\xbf\x46\xc9\xcf\x5e\x0b\xa1\x19\xaf\xc7\x75\x34\xf0\x63\x37\xed\xe9\xc7\xbb\xe3\x39\xd3\x70\xd8\x33\x8c\x69\x98\x78\xcc\x97\xaa\xbf\xf2\x03\x5b\x5a\xc2\x03\xc6\x73\x21\x4b\xa2\xf4\xfd\x3c\x89\x38\xbd\xa9\x4b\xf8\xbf\x23\x4d\x73\xa0\xe1\xcc\x80\x0b\x07\x7d\x13\x8e\xa3\xcd\x39\x8c\xc9\xd7\xec\x7d\x95\xac\x24\x72\xf3\x19\x37\xf3\xfd\x54\xbc\xcd\xc5\xd5\xca\x91\xf1\xa0\xd9\xa7\x2d\x14\x65\x87\x69\xdb\x97\x03\x3e\x6a\x73\xee\x15\xf3\xfc\x3d\x76\xf2\x5e\x4f\x19\x74\xdd\x8f\xad\x1a\x19\x27\x69\x2b\x67\x91\xcd\xe3\x27\x21\x91\x2d\xf8\x67\xdc\xd4\x61\x74\xc3\xf2\xde\x08\xf3\x3e\xc2\x40\xbd\x3f\x25\x6a\x5d\x08\x77\x9b\xf2\x41\x14\x0e\xef\x7c\x95\xda\xb9\x87\x3c\x44\x7d\x3f\xac\x11\xc0\xce\xc9\xf4\xbd\x39\x39\x5f\xed\x42\x36\x92\x35\xe0\x5e\x1c\x42\x77\x0c\x30\x7d\xdf\x03\x7c\xf1\x52\x84\x77\x50\xdd\xbb\xd2\xf5\x01\xeb\xe6\x19\x2a\x51\xab\x4a\x70\x04\x89\xb9\x44\x4e\x19\x5f\x80\x12\x40\xd6\x82\xd9\x96\x89\x2e\x91\xae\xf4\x6a\x21\x44\xe5\xbb\x22\x6d\xe0\x77\xcc\xff\x13\x66\x83\xfe\xf3\xb0\x59\x71\x73\x79\x5e\x07\x60\xcf\x01\xa1\xa1\x63\xfd\xd3\x4f\x44\xb9\xe7\xc6\x7c\x95\x7e\xe1\xdf\xab\x8c\xa8\x71\x6b\xe3\x04\xa3\x7e\x73\xee\xf8\x26\xed\x5f\xbf\xf8\xc0\x19\x5b\xa6\x3f\x62\x81\x07\x4d\xdb\xcd\xd7\x99\xfe\x88\x39\x4a\xe9\xb0\xdf\x35\x3e\x6c\x9f\x6a\xde\x6d\x8c\x97\x07\x2a\xd7\x2f\x8f\x4a\x6f\x74\xaf\xdd\x37\xf2\x51\xe4\xa6\x61\xa9\x99\xa5\x36\xde\x2e\x1b\xcd\x7a\x2c\x7b\x72\xd7\x6d\xcb\xcc\xc0\x08\x21\x01\xb3\xec\xa9\xaf\x15\xcf\x07\x51\xdf\x58\xf6\x02\xbe\xe5\xf4\x12\xcf\x95\x7f\x74\xa8\xfa\xb5\x39\xa7\x3c\x38\x76\xb0\xf8\xf7\x73\xc6\xcf\x23\x8d\xdd\xf4\xef\x5b\xf2\xd9\x0c\x8a\x43\xc7\x71\xc3\x69\xd1\x64\x61\x41\x44\x6e\x69\xdc\x21\x8e\x23\xeb\x9f\x7a\xfb\x79\x64\x6e\xda\x14\xbc\x6b\x26\x29\xd4\x0d\xb4\x1b\xe7\x5d\x07\x63\x07\xc6\xce\x8d\x5d\x72\xdd\x47\xbf\x19\xe9\x79\xd8\xe2\x9e\x6a\xe7\x4b\x75\x55\x90\x7a\x04\xbb\x5b\x7a\x8d\xb5\x3f\x34\x64\x81\x29\x3d\x1f\x75\x45\x8c\xab\x11\x50\xc7\xda\xca\xc9\x3a\x44\xec\x19\x78\xfa\xad\xad\x8d\xfd\xcd\x53\x38\x9f\xcd\xc0\x7d\x4e\xdb\x66\x88\x14\x85\xf9\x5c\x34\x8d\x4d\xdd\x7f\x48\xbb\xd2\x8f\x23\x27\x1b\x7e\x24\xfa\x7e\xe7\xf9\x8f\xf5\x28\xa0\x69\xb5\x4b\xce\xbe\x55\x9b\xc6\xd1\xc8\xc9\x2e\x7e\x1b\xc7\x79\xc3\x29\x30\xce\xd4\x9b\xb7\xd0\x9e\xfa\xd7\xc0\x8b\x5b\xc4\xc0\x2c\x3b\xde\x79\x84\xed\x5f\xb8\x3d\x5c\x44\xff\x0e\xc1\x25\x9c\xfa\x40\x6d\xfb\xd2\x43\x10\x8c\xcd\x5f\x47\x80\x3c\x83\xae\x8b\xff\x1d\x00\xc0\x17\x60\xb9\x20\x13\x00\x00")
 
 func templateMigrateSchemaTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1021,12 +1084,12 @@ func templateMigrateSchemaTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 3858, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/migrate/schema.tmpl", size: 4896, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatePredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6b\xdc\x30\x14\x84\xcf\xab\x5f\x31\x88\x3d\x24\xa1\x91\xd2\xdc\x5a\xe8\x21\x0d\x29\x04\xca\x52\x48\xef\x45\x2b\x3d\xdb\x22\xb2\xe4\x3e\x3d\xb7\x5d\x84\xff\x7b\xf1\xee\x36\xa4\xbd\xf5\x66\xde\x7c\x33\x66\x46\xad\xd9\x2b\x75\x5f\xa6\x03\xc7\x7e\x10\xdc\xde\xbc\x7d\x77\x3d\x31\x55\xca\x82\x4f\xce\xd3\xbe\x94\x67\x3c\x66\x6f\x70\x97\x12\x8e\x50\xc5\xaa\xf3\x0f\x0a\x46\x7d\x1d\x62\x45\x2d\x33\x7b\x82\x2f\x81\x10\x2b\x52\xf4\x94\x2b\x05\xcc\x39\x10\x43\x06\xc2\xdd\xe4\xfc\x40\xb8\x35\x37\x7f\x54\x74\x65\xce\x41\xc5\x7c\xd4\x3f\x3f\xde\x3f\xec\x9e\x1e\xd0\xc5\x44\x38\xdf\xb8\x14\x41\x88\x4c\x5e\x0a\x1f\x50\x3a\xc8\xab\x9f\x09\x13\x19\x75\x65\x97\x45\xa9\xd6\x10\xa8\x8b\x99\xa0\x27\xa6\x10\xbd\x13\xd2\x38\x29\xd7\xf8\x19\x65\x00\xfd\x12\xca\x01\x5b\xe8\x2f\xce\x3f\xbb\x9e\xf4\x5f\xec\xf5\xb2\xa8\x4d\x6b\x10\x1a\xa7\xe4\x84\xa0\x07\x72\x81\x58\xc3\xac\x39\xad\x61\x75\xaf\x89\x71\x9c\x0a\x0b\x2e\xd4\x46\x77\xa3\x68\xa5\x36\xba\x8f\x32\xcc\x7b\xe3\xcb\x68\xbb\xf3\x62\x31\xfb\x79\xef\xa4\xb0\xa5\x2c\x36\x44\x97\xc8\x8b\xed\x99\xc6\x14\xb3\xed\xd9\x4d\x83\x0d\x35\xe9\xff\x71\xd7\xef\x49\xab\xcb\x63\x5b\x76\xb9\x27\x6c\xbf\xbd\xc1\x36\xe3\xfd\x07\x6c\xcd\xae\x04\xaa\xa7\x1a\xd6\xa2\x35\x6c\xb3\xd9\xb9\x91\xb0\x2c\xeb\x93\xac\x7b\xbe\xd4\x45\x37\x67\x2f\xb1\x64\x74\x85\xcf\xec\x79\x95\x15\xdf\xcf\x31\x05\xe2\x6a\xd4\x46\x0e\x13\xfd\x13\xb6\x7a\x2f\xd6\x93\x79\x92\xc2\xae\x27\xf3\xf1\xc4\x63\x59\x2e\x5f\x0d\xf5\xf2\xf5\x3b\x00\x00\xff\xff\x61\xb1\x16\xa8\x61\x02\x00\x00")
+var _templatePredicateTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xc1\x6b\x14\x31\x18\xc5\xcf\x9b\xbf\xe2\x11\xf6\xd0\x16\x37\xa9\xbd\x29\x78\xa8\xa5\x42\x41\x16\xa1\xde\x25\x9b\x7c\x33\x13\x9a\x49\xc6\xe4\x1b\x75\x09\xf9\xdf\x25\xdd\x55\xaa\xb7\x5e\xbf\xf7\x7b\x2f\xbc\x97\x5a\xf5\x95\xb8\x4b\xcb\x31\xfb\x71\x62\xdc\x5c\xbf\x7d\xb7\x5b\x32\x15\x8a\x8c\x4f\xc6\xd2\x21\xa5\x27\x3c\x44\xab\x70\x1b\x02\x9e\xa1\x82\xae\xe7\x1f\xe4\x94\xf8\x3a\xf9\x82\x92\xd6\x6c\x09\x36\x39\x82\x2f\x08\xde\x52\x2c\xe4\xb0\x46\x47\x19\x3c\x11\x6e\x17\x63\x27\xc2\x8d\xba\xfe\xa3\x62\x48\x6b\x74\xc2\xc7\x67\xfd\xf3\xc3\xdd\xfd\xfe\xf1\x1e\x83\x0f\x84\xf3\x2d\xa7\xc4\x70\x3e\x93\xe5\x94\x8f\x48\x03\xf8\xc5\x63\x9c\x89\x94\xb8\xd2\xad\x09\x51\x2b\x1c\x0d\x3e\x12\xe4\x92\xc9\x79\x6b\x98\x24\x4e\xca\x0e\x3f\x3d\x4f\xa0\x5f\x4c\xd1\x61\x0b\xf9\xc5\xd8\x27\x33\x92\xfc\x87\xdd\xb5\x26\x36\xb5\x82\x69\x5e\x82\x61\x82\x9c\xc8\x38\xca\x12\xaa\xe7\xd4\x8a\xee\xee\x89\x7e\x5e\x52\x66\x5c\x88\x8d\x1c\x66\x96\x42\x6c\xe4\xe8\x79\x5a\x0f\xca\xa6\x59\x0f\xe7\xc5\x7c\xb4\xeb\xc1\x70\xca\x9a\x22\x6b\xe7\x4d\x20\xcb\x7a\xcc\x34\x07\x1f\xf5\x98\xcd\x32\x69\x57\x82\x7c\x8d\xbb\x7c\x0f\x52\x5c\xf6\x4e\xc8\x26\x8e\x84\xed\xb7\x37\xd8\x46\xbc\xff\x80\xad\xda\x27\x47\xe5\x54\x43\x6b\xd4\x8a\x6d\x54\x7b\x33\x13\x5a\xeb\x5f\xd2\x37\xfe\x5b\x17\xc3\x1a\x2d\xfb\x14\x31\xa4\x7c\x66\xcf\xab\x74\xfc\xb0\xfa\xe0\x28\x17\x25\x36\x7c\x5c\xe8\xbf\xb0\xee\xbd\xe8\x27\xf5\xc8\x29\x9b\x91\xd4\xc7\x13\x8f\xd6\x2e\x5f\x0c\x55\x2b\x28\x3a\xb4\xf6\x7b\x00\x61\xb1\x16\xa8\x61\x02\x00\x00")
 
 func templatePredicateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1046,7 +1109,7 @@ func templatePredicateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatePrivacyTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\xdf\x6f\xdb\xbe\x11\x7f\xb6\xfe\x8a\x83\xe0\x7c\x21\x75\xae\xf2\x5d\xdf\x96\x22\x0f\x45\x96\x02\xc5\xb6\xa4\x5b\x8b\xed\xa1\x28\x0a\x46\x3a\xd9\x44\x24\x52\x21\xa9\xd8\x86\xab\xff\x7d\xe0\x49\x94\x29\xd9\x72\xd3\x36\x05\xfa\x68\xf2\x7e\x7c\xee\xf8\xb9\x3b\x52\xde\xed\xce\x5f\x04\x57\xb2\xda\x2a\xbe\x5c\x19\x78\xf5\xe7\x5f\xff\xf6\xb2\x52\xa8\x51\x18\x78\xcb\x52\xbc\x93\xf2\x1e\xde\x89\x34\x81\x37\x45\x01\x24\xa4\xc1\xee\xab\x47\xcc\x92\xe0\xe3\x8a\x6b\xd0\xb2\x56\x29\x42\x2a\x33\x04\xae\xa1\xe0\x29\x0a\x8d\x19\xd4\x22\x43\x05\x66\x85\xf0\xa6\x62\xe9\x0a\xe1\x55\xf2\xa7\xdb\x85\x5c\xd6\x22\x0b\xb8\xa0\xfd\x7f\xbe\xbb\xba\xbe\xf9\x70\x0d\x39\x2f\x10\xba\x35\x25\xa5\x81\x8c\x2b\x4c\x8d\x54\x5b\x90\x39\x18\xcf\x99\x51\x88\x49\xf0\xe2\xbc\x69\x82\x60\xb7\x83\x0c\x73\x2e\x10\xc2\x4a\xf1\x47\x96\x6e\x43\x68\xd7\x5f\xc2\x9a\x9b\x15\xe0\xc6\xa0\xc8\x60\x0e\xe1\x7b\x96\xde\xb3\x25\x86\x9e\xe4\xcb\xa6\x09\x66\xbb\x1d\x18\x2c\xab\x82\x19\x84\x70\x85\x2c\x43\x15\x42\x62\xad\xec\x76\x60\x75\xad\x3d\x5e\x56\x52\x19\x08\x77\x3b\x98\x27\x57\x52\xe4\x7c\x99\x74\x06\xa1\x69\x42\x02\x32\xaf\xee\x97\x70\x71\x09\x77\x4c\xe3\x31\xa9\x20\x78\x64\x0a\xa2\x60\x76\x7e\x6e\x33\x2a\xd7\x50\xb2\x2d\xdc\x21\x28\x34\xb5\x12\x98\xc1\xdd\x16\x54\x5d\xa0\x06\x23\x81\x8b\x8c\xa7\x16\x93\x59\x31\x43\x59\xa9\x64\xc1\xd3\x2d\xa9\xe3\x23\x2b\x6a\x66\xb8\x14\xa0\x57\xb2\x2e\x32\x30\xa8\x4a\x2e\xac\x3c\x85\xcd\x04\x30\x72\x91\x61\xca\x35\x97\x22\x09\x66\xad\xcf\x4b\x40\xa5\xa4\xd2\xc9\x0d\xae\xa3\x10\x85\x39\xef\xd2\x71\xd1\x69\x58\x04\x61\x1c\x90\x9f\xbf\xa3\xd8\xfe\x5a\x94\x99\xf5\xe0\x81\x24\x8f\x27\x30\x92\xbc\x0f\xf1\xc3\x3d\xaf\x9e\x13\x62\x2a\x85\xe1\xa2\x46\xab\x6a\x85\x05\x6e\x0c\x19\x4b\x82\x19\xf9\x3a\x01\x4e\xdb\xfd\x0e\x5c\xdc\x92\x50\x31\xb1\x44\x98\xbb\x08\x2d\x3f\x0a\xae\x0d\x84\x74\x1a\x21\x84\x36\xe0\x10\x42\x6b\x9a\xa8\x6b\x51\x59\x32\xf5\x1a\x4d\x93\x77\x81\x69\x9b\xb0\x5c\xaa\x92\x19\x83\x19\xac\x15\xab\x2a\xcc\xc6\xd2\x7e\x36\xf3\x5a\xa4\x07\xd6\xa2\xd6\x04\x68\xa3\xb8\x58\x2e\x80\x41\x92\x24\x5c\x18\x54\x39\x4b\x71\xd7\xc4\x6d\x80\xb0\x0b\x66\xb3\xd6\x31\xe4\xa5\x49\xae\xed\xa2\x53\xfe\x4b\x78\x01\x67\xeb\x70\x01\x16\x82\xc8\x22\xb6\x18\xbb\x89\x93\x24\x89\x83\x59\x43\x59\x70\x65\x64\xb6\x15\xf6\xf8\xae\xcc\xe6\x1f\xb8\xb5\x30\xea\xd4\xc0\xae\x09\x02\x62\x5c\xb7\x29\x85\xb1\x99\x4f\x15\x32\x83\x1a\x58\xaf\x46\x27\x84\x1b\x93\x04\x14\xdd\x48\x21\xaa\x98\xb2\x0d\xcc\x09\x75\xcb\x8b\xbd\x3a\x05\x17\x8f\x05\x6c\xb4\x3c\xdf\x4b\x5d\x5e\x82\xe0\x05\x7c\xfd\xea\x4e\xfb\x9d\x8e\xdc\xe6\x82\x48\x17\xfb\x09\x6a\xbd\xda\x70\xdd\x8a\xb3\xff\x3f\x6e\x56\xff\x65\x45\x8d\x1d\xb2\xc5\x28\xfe\x5d\xb3\x5f\x89\x83\x26\x68\xa3\x72\x2b\x6f\x95\x2c\x5d\x64\xa9\xd9\x8c\x51\xc7\x10\x11\xbc\x05\xdc\x49\x59\x10\xa2\x3d\x48\x79\x6f\xd9\x96\x9a\x4d\xd2\xfa\x1f\xfb\x8d\x93\x56\x39\xa6\xc8\xe5\x3d\xfc\xf1\xc7\xd1\x60\x89\xa9\x6d\xb4\xfb\xf4\xd8\xec\xf8\xe1\xfa\x6e\x03\x77\xd2\x6d\xaf\xfb\x77\x8d\x6a\xfb\x9e\x6a\x0e\x52\x59\xde\x71\x81\x1a\xca\xba\x30\xbc\x2a\x10\x1e\xec\x6e\x57\xad\x5c\x18\x09\x0c\x34\x17\xcb\xc2\x95\x69\x12\xcc\x7c\x03\x9f\x3e\xd3\xaf\xff\xd4\x05\x06\x7b\xeb\xf6\x67\x37\x08\x34\x55\x6d\x4f\x67\x02\x96\x71\xb1\x84\xf5\x0a\xcd\x0a\x15\x30\x52\x6b\xdd\x72\xdd\x36\x3d\xcc\x80\x89\x0c\x64\x65\x3b\x01\x2b\x8a\x2d\x94\x32\xe3\xf9\x16\xb8\x71\xfe\xc9\xc5\xde\xac\xcd\xc6\xf5\x23\x2b\x68\x2f\x3a\x60\x9b\x1b\x07\x4d\x93\x90\x44\x57\x53\x36\x63\x31\xf1\xbc\xd7\x75\x2d\x88\x38\xde\xa2\x62\x4b\xc6\x85\x36\xfd\x6f\x97\x08\x62\x46\xd4\xfe\xf2\xb3\x1a\x83\x87\xe4\x90\x24\x0b\x78\x98\xc2\x33\x62\xbd\xe3\xcc\x04\xfb\xe2\xd7\x76\xdf\x63\xbd\x93\x23\x22\xe4\x52\xc1\x97\x05\x9d\xa4\xb5\xd1\x36\xbe\x0e\xac\xd5\xd1\x6b\x6e\xd2\x15\xf8\x9d\x90\xda\xea\x00\xfb\x02\x1e\xe2\xd7\x24\x9e\xda\x29\xfa\x3d\xe5\x78\xe1\x94\xa6\x39\x6c\x45\x1c\x76\xe2\xef\x2c\xc3\x9c\xd5\x85\xf1\x37\xf6\x41\xd9\xa8\xf6\x0c\xb7\x0a\x6d\x8f\xea\xf9\xf0\xd6\x1e\x08\x31\x9d\x53\x6f\x66\x19\xab\x8c\xbd\xf7\xc8\x6e\x96\x5a\x2a\xd6\x1a\x41\xe6\x56\x4f\xaa\x8c\x0b\xa6\xb6\x60\x0f\xd2\x32\x4d\x03\xd3\x7e\x01\x24\x6d\xd9\x0c\xed\x5b\xe1\xa7\x13\xcc\x71\xab\x1f\x19\xb9\x4b\xab\xe3\x4f\x3e\xb4\xff\x73\xe4\x71\xf3\xc1\x39\x19\x95\xfe\xbf\x6a\x43\xc3\x75\xb2\xfa\xcb\x4e\xe0\x74\x03\x18\x99\xf9\xf4\xd9\x2d\xec\xdb\x80\xbf\xf2\x7d\x9d\xa0\x87\xf0\xb4\x66\x30\x70\x74\xd8\x0f\xdc\xf6\xc9\x13\x73\x42\x47\xbb\x82\xdb\x1c\x34\x86\x1e\xe4\xbe\x37\xf4\x4b\x47\xdb\xc3\x30\x65\x31\x0c\xb1\x1d\x3b\xe7\xf2\x04\xc2\xdf\xa5\x4f\xf8\x11\x2c\xa0\xfc\xfd\x5b\x85\xcf\x96\x67\xe9\x16\xc3\x82\xe9\x1a\xc6\x81\x97\x6f\xf6\x8c\x31\x03\x0f\xc8\x37\x6a\x1f\xa5\xd7\x3e\xc6\xde\x9e\x83\x5c\xc3\x3e\x52\xc6\x5d\xfa\xba\x82\x5f\x2a\x59\x57\xae\x53\xda\xda\x1c\x72\x9f\xf7\x89\xe8\xe4\xdd\x95\xb2\x1b\xdd\xfe\xac\xdc\x17\xf0\xa8\x44\x3a\x8f\xfb\xb9\x9c\x4b\xb5\x66\x2a\xd3\xfe\x1b\xc1\xc8\x53\x23\xf9\x39\xa6\xb1\xbb\x4f\xb6\xf6\x69\xf7\x70\x42\x7a\x58\xfb\x68\x26\xe0\x9e\xee\x12\xcf\xd7\x1d\x86\xb8\x9d\xc0\xd1\xa2\xf5\xa7\xe8\xb0\x9b\x8e\x3b\x36\xbd\xd8\xa6\x0f\xff\x60\x66\x4e\xf7\xe6\xfd\xa5\x71\xd0\xc0\x3b\x28\x6f\x8a\x35\xdb\x6a\x2a\x7c\x52\xed\x9f\x5b\x6d\x97\x22\x18\xde\x13\x6c\xfc\xb2\xa6\x94\x8e\x6c\x44\xf1\x11\x48\x1e\xd1\xf9\x06\x33\xf7\x74\xd9\x91\x56\x33\x00\x63\x9f\x85\xdf\xc6\x32\x7e\x3e\x7b\x48\x9c\x81\xef\x02\x62\x95\x9a\x7e\x86\x0f\xf6\xbc\xa2\x1a\x3e\xa5\xfa\x37\x4b\x94\x0f\x35\x06\x85\xf0\xc4\xfb\x8b\x8f\x2c\xe9\xdb\xeb\x69\x0f\x3f\x32\x72\x27\xfd\x9c\x9f\xc3\xad\x70\xc2\xb7\x15\xaa\xf1\x2c\xb6\x0c\x5d\xf2\x47\x6c\xe9\x07\x52\x14\x5b\xb0\x73\xb9\x5b\xec\xc9\x29\x9d\x6e\x77\x2a\x47\xac\x46\x64\xc1\x3f\x98\x05\xc8\xca\x47\x7d\x5b\xc5\x30\x71\x70\xe3\x2e\x1c\xb5\x3d\xff\x47\x6a\xd7\x8e\xf6\x32\xb9\xad\xa2\xd8\x4e\x42\xd9\xbd\x6c\x9d\xa3\xc9\xe1\xdb\x4e\x3d\x27\x66\x07\x6b\x30\x6b\x5c\x75\x5b\x26\x1d\x04\x7c\x8c\xd0\x96\xc1\xf6\x52\xa6\x2b\x4c\x79\xce\x31\x9b\x4e\xe1\xa4\xcd\xe8\x09\x59\xeb\xae\x1b\xc7\xd3\xf6\xe5\x07\x92\xd6\xc5\x6d\x41\xe5\xa3\xef\x40\x3d\x70\x38\xd3\xb6\xab\x09\x69\xdc\xd5\x32\x5c\x74\xa9\x8e\x29\x59\xce\xca\x14\x3b\x2c\x21\x28\xa5\xde\xb7\x24\xba\x12\xcd\x93\x1b\x99\xa1\x86\xee\x0b\xe6\x5c\xb0\x92\xe2\xab\x14\x17\x06\xe6\x22\xb9\xb1\x0b\xe1\xe0\xaa\x1f\xf6\xd2\x54\xde\x4e\x3a\x87\xf0\xc5\x99\x4e\xce\x74\xd8\xc6\x3b\x17\x6d\x49\x92\x85\xee\x8b\xd4\xc7\x15\x42\xef\xa6\x69\x9e\x78\x8d\xe9\xef\x30\x64\x63\x70\x8f\x61\xde\xbb\x27\x09\x66\x64\xcf\x77\x30\x7d\x83\x21\xd1\xa6\xe9\x6f\x2e\xb3\xc1\xd4\x1e\x3f\x48\xdc\x47\xb0\x28\xf7\xcd\xff\xdc\x8c\xb6\xf5\xf2\xe0\xee\xc0\x0f\x49\xe4\xa3\xea\xaf\xbe\x87\x4f\xa3\x41\xb9\x1c\xa3\x4d\x2d\x70\x53\x61\x6a\x30\xeb\x92\x43\x46\xcf\x3e\x2e\xa0\x5d\xf7\xc3\x0f\x5b\x93\x4d\xe0\x1d\xff\xc1\xe9\x8f\xc9\x3e\x22\xc0\xf4\xf9\x3b\xc5\x5f\x4c\x81\xc1\x24\x7f\x1e\x16\xf4\xf7\xa1\x94\x15\xc5\xe0\xfa\x3a\xcd\x83\x9f\xbb\xf9\x50\xf7\x74\x6c\x28\x9f\xc6\x86\x71\xf3\xfc\x06\x1b\xfa\x3c\x9d\x26\x44\x79\xf0\xbd\x75\xff\x0f\xc6\xff\x03\x00\x00\xff\xff\x9d\x0a\x54\xe1\xed\x19\x00\x00")
+var _templatePrivacyTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x8f\xdc\xb6\x11\x7f\x96\x3e\xc5\x40\xb8\x0b\x24\x77\x4f\x97\xe6\xad\x0e\xfc\x60\xb8\x36\x60\xb4\xb5\xd3\xc6\x68\x1f\x82\x20\xe0\x49\xa3\x5d\xe2\x24\x52\x47\x52\xb7\xbb\x50\xf4\xdd\x8b\xa1\x48\xae\xa4\xdd\xbd\x3f\xf1\x05\xf0\xa3\xc8\xf9\xf3\x9b\x99\xdf\x0c\x49\xf5\xfd\xf5\xab\xf8\x9d\x6c\xf7\x8a\xaf\x37\x06\x7e\xf8\xfe\xaf\x7f\xbb\x6a\x15\x6a\x14\x06\x3e\xb0\x02\x6f\xa4\xbc\x85\x8f\xa2\xc8\xe1\x6d\x5d\x83\x15\xd2\x40\xfb\xea\x1e\xcb\x3c\xfe\xb2\xe1\x1a\xb4\xec\x54\x81\x50\xc8\x12\x81\x6b\xa8\x79\x81\x42\x63\x09\x9d\x28\x51\x81\xd9\x20\xbc\x6d\x59\xb1\x41\xf8\x21\xff\xde\xef\x42\x25\x3b\x51\xc6\x5c\xd8\xfd\x7f\x7e\x7c\xf7\xfe\xd3\xcf\xef\xa1\xe2\x35\x82\x5b\x53\x52\x1a\x28\xb9\xc2\xc2\x48\xb5\x07\x59\x81\x99\x38\x33\x0a\x31\x8f\x5f\x5d\x0f\x43\x1c\xf7\x3d\x94\x58\x71\x81\x90\xb4\x8a\xdf\xb3\x62\x9f\xc0\xb8\x7e\x05\x5b\x6e\x36\x80\x3b\x83\xa2\x84\x0b\x48\x7e\x62\xc5\x2d\x5b\x63\x32\x91\xbc\x1a\x86\x38\xea\x7b\x30\xd8\xb4\x35\x33\x08\xc9\x06\x59\x89\x2a\x81\x9c\xac\xf4\x3d\x90\x2e\xd9\xe3\x4d\x2b\x95\x81\xa4\xef\xe1\x22\x7f\x27\x45\xc5\xd7\xb9\x33\x08\xc3\x90\x90\x43\xb8\x68\x6f\xd7\xf0\xfa\x0d\xdc\x30\x8d\xa7\xa4\xe2\xf8\x9e\x29\x48\xe3\xe8\xfa\x9a\x32\x2a\xb7\xd0\xb0\x3d\xdc\x20\x28\x34\x9d\x12\x58\xc2\xcd\x1e\x54\x57\xa3\x06\x23\x81\x8b\x92\x17\x84\xc9\x6c\x98\xb1\x59\x69\x65\xcd\x8b\xbd\x55\xc7\x7b\x56\x77\xcc\x70\x29\x40\x6f\x64\x57\x97\x60\x50\x35\x5c\x90\xbc\x0d\x9b\x09\x60\xd6\x45\x89\x05\xd7\x5c\x8a\x3c\x8e\x46\x9f\x6f\x00\x95\x92\x4a\xe7\x9f\x70\x9b\x26\x28\xcc\xb5\x4b\xc7\x6b\xa7\x41\x08\x92\x2c\xb6\x7e\xfe\x8e\x62\xff\xe7\xa2\x2c\xc9\xc3\x04\xa4\xf5\xf8\x00\x46\x2b\x3f\x85\xf8\xf3\x2d\x6f\x5f\x12\x62\x21\x85\xe1\xa2\x43\xaa\x01\x65\x5d\xe0\xce\xd8\xaa\xe4\x71\x64\x7d\x3d\x00\x4e\xd3\xbe\x03\x97\x11\x27\xae\x40\x31\xb1\x46\xb8\xf0\x11\x12\x3f\x6a\xae\x0d\x24\xb6\x1a\x09\x24\x14\x70\x02\x09\x99\xb6\xd4\x25\x54\x7d\x3f\xd1\x18\x86\xca\x05\xa6\x29\x61\x95\x54\x0d\x33\x06\x4b\xd8\x2a\xd6\xb6\x58\x2e\xa5\xa7\xd9\xac\x3a\x51\x1c\x59\x4b\x47\x13\xa0\x8d\xe2\x62\xbd\x02\x06\x79\x9e\x73\x61\x50\x55\xac\xc0\x7e\xc8\xc6\x00\xa1\x8f\xa3\x68\x74\x0c\x55\x63\xf2\xf7\xb4\xe8\x95\xff\x92\xbc\x86\xcb\x6d\xb2\x02\x82\x20\xca\x94\xad\x96\x6e\xb2\x3c\xcf\xb3\x38\xa2\x2e\xba\x0a\x6d\x64\xf6\x2d\x06\x7c\xef\xcc\xee\x1f\xb8\x27\x18\x5d\x61\xa0\x1f\xe2\xd8\x32\xce\x6d\x4a\x61\x28\xf3\x85\x42\x66\x50\x03\x0b\x6a\xb6\x42\xb8\x33\x79\x6c\xa3\x5b\x28\xa4\x2d\x53\x34\xc0\xbc\x90\x5b\x5e\x1d\xd4\x6d\x70\xd9\x52\x80\xa2\xe5\xd5\x41\xea\xcd\x1b\x10\xbc\x86\xdf\x7f\xf7\xd5\xfe\xa8\x53\xbf\xb9\x02\xaa\x56\x36\x4d\xd0\xe8\x95\xc2\xf5\x2b\xde\xfe\xff\xb8\xd9\xfc\x97\xd5\x1d\x3a\x64\x07\x24\x63\xfc\xfd\x70\x58\xc9\xe2\x21\x1e\xa3\xf2\x2b\x1f\x94\x6c\x1c\xc2\xb4\x30\xbb\x25\xea\x0c\x52\x0b\x6f\x05\x37\x52\xd6\x16\x91\xd7\x5c\x81\xbc\x25\xb6\x15\x66\x97\x8f\xfe\x97\x7e\xb3\x7c\x54\xce\x6c\xe4\xf2\x16\xbe\xfb\xee\x64\xb0\x96\xa9\xd6\x76\xe4\x17\xc1\x66\x67\x1a\xee\x41\x5c\xde\xc6\xbe\xd2\xe3\xac\xfb\x77\x87\x6a\xff\x93\xed\x39\x28\x64\x73\xc3\x05\x6a\x68\xba\xda\xf0\xb6\x46\xb8\xa3\x5d\xd7\xad\x5c\x18\x09\x0c\x34\x17\xeb\xda\xb7\x69\x1e\x47\x53\x03\xbf\xfc\x6a\xbf\xfe\xd3\xd5\x18\x1f\xac\xd3\xa7\x3b\x08\xb4\x6d\xf1\x40\x67\x9b\xdb\x92\x8b\x35\x6c\x37\x68\x36\xa8\x80\x59\xb5\xd1\x2d\xd7\xe3\xd0\xc3\x12\x98\x28\x41\xb6\x34\x09\x58\x5d\xef\xa1\x91\x25\xaf\xf6\xc0\x8d\xf7\x6f\x5d\x1c\xcc\x52\x36\xde\xdf\xb3\xda\xba\x4f\x17\x65\x19\x9b\x81\x8e\x83\x61\xc8\xad\x84\xeb\x29\xca\x58\x66\x79\x1e\x74\xfd\x08\xb2\x1c\x1f\x51\xb1\x35\xe3\x42\x9b\xf0\xed\x13\x61\x99\x91\x8e\x5f\xd3\xac\x66\x30\x41\x72\x4c\x92\x15\xdc\x9d\xc3\xb3\x60\xbd\xe7\xcc\x19\xf6\x65\x3f\xd2\xfe\x84\xf5\x5e\x8e\xc2\x8a\x2a\xa9\xe0\xb7\x95\xad\x24\xd9\x18\x07\x9f\x03\x4b\x3a\x7a\xcb\x4d\xb1\x09\xb6\xad\x0c\x8d\xd5\x19\xf6\x15\xdc\x65\x3f\x12\xaa\xa8\xa0\x53\xf4\x39\xed\xf8\xda\x2b\x9d\xe7\x30\x89\x78\xec\x96\xbf\x51\x89\x15\xeb\x6a\x33\xdd\xf0\x4a\x71\x14\x0d\x53\x86\x93\xc2\x38\xa3\x02\x1f\x3e\x50\x41\x2c\xd3\x89\x48\x02\x58\xc9\x5a\x43\xf7\x1e\xe9\xce\x52\xa2\x62\xa7\x11\x64\x45\x7a\x52\x95\x5c\x30\xb5\x07\x2a\x24\x31\x4d\x03\xd3\xd3\x06\xc8\x63\x6b\x6c\x6e\x9f\x84\x9f\x4e\x30\xcf\xad\x70\x64\x54\x3e\xad\x9e\x3f\xd5\x1c\xff\xd7\x91\xc7\xe5\x26\x38\x59\xb4\xfe\xbf\x3a\x63\x6f\x29\x67\xbb\xbf\x71\x02\x0f\x0f\x80\x85\x99\x5f\x7e\xf5\x0b\x87\x31\x30\x5d\x79\xde\x24\x08\x10\x9e\x36\x0c\x66\x8e\x8e\xe7\x81\xdf\x7e\xb0\x62\x5e\xe8\xe4\x54\xf0\x9b\xb3\xc1\x10\x40\x1e\x66\x43\x58\x3a\x39\x1e\xbc\x95\xe9\x84\xf0\x6b\xa7\xeb\xdc\x3c\x80\xf0\x5b\x99\x13\xd3\x08\x56\xd0\x7c\xfb\xa3\xc2\x03\x7e\xb1\x69\x11\xaa\x3e\x1d\x18\x47\x5e\x1e\x9d\x19\x8b\xfa\x1e\x93\x6f\x31\x3e\x9a\xc9\xf8\x58\x7a\x7b\x09\x72\xcd\xe7\x48\x93\xb9\xf4\xb9\x86\x5f\x2b\xd9\xb5\x7e\x52\xd2\x41\x3d\xe7\x3e\x0f\x89\x70\xf2\xfe\x4a\xe9\x8e\xee\xe9\x59\x79\x68\xe0\x90\x35\xb7\x31\x84\x24\x58\x71\xa8\xa4\xda\x32\x55\x6a\xdf\x87\x94\x15\x23\x1d\x88\x93\x3d\xf7\x12\xa7\xb1\x4b\x84\xb3\x6f\x77\x8f\x4f\xc8\x78\x38\x2e\xd8\x19\xb8\x0f\x4f\x89\x97\x9b\x0e\x73\xdc\x5e\xe0\x64\xd3\x3a\xf4\x36\x36\xbf\x47\x5c\x02\xbe\x9c\xd8\xf6\xc5\x76\xbe\xf8\x47\x67\xe6\xf9\xd9\x1c\x8e\xbc\xf9\x00\x77\x50\xde\xd6\x5b\xb6\xd7\xf6\x8e\x40\xab\x81\xfc\xcc\x4e\x9e\x11\x46\x58\x3b\x7e\x59\x5b\x12\x2c\x6c\xa4\xd9\x09\x48\x87\x3c\x55\x7c\x87\xa5\x7f\xba\xf4\x56\x6b\x98\x81\xa1\x67\xe1\xe3\x58\x96\xcf\xe7\x09\x12\x6f\xe0\x59\x40\x48\x69\x08\x67\xf8\x6c\x2f\xbc\xd3\x0e\x4f\x0c\x37\x3b\xfc\x9b\x25\xad\xe6\x1a\xb3\x46\x58\xf2\xe9\xf1\x16\xa8\x72\xef\xe7\x11\x0f\x3e\xb2\xe7\x0c\xbc\xb3\x7e\xae\xaf\xe1\xb3\xf0\xc2\x9f\x5b\x54\xcb\xb3\x98\x18\xba\xe6\xf7\x38\xd2\x0f\xa4\xa8\xf7\x40\xe7\xb2\x5b\x0c\xe4\x94\x5e\xd7\x55\xe5\x84\xd5\xd4\x5a\xf0\xcb\x54\x98\x15\xc8\x76\x8a\xfa\x73\x9b\xcd\x86\xfb\x04\xf6\x74\x99\xa6\x70\x4a\x5e\xfe\x58\xef\xd2\xf3\xaf\xc9\x3f\xb7\x69\x46\x97\x66\xe9\x5e\xb6\xde\xd1\xd9\xc3\x77\x3c\xf5\xbc\x18\x3d\x89\xe3\x68\xf0\xb3\x89\x98\x74\x14\xf0\x29\x42\x13\x83\xe9\x52\xa6\x5b\x2c\x78\xc5\xb1\x3c\x9f\xc2\xb3\x36\xd3\x27\x64\xcd\x5d\x37\x4e\xa7\xed\xb7\x3f\x90\x34\x17\x37\x81\xaa\x16\xff\x81\x02\x70\xb8\xd4\x34\xd5\x84\x34\xfe\x6a\x99\xac\x5c\xaa\xe9\xdf\x48\x16\xaa\x79\x8e\x1d\x44\x08\x9b\xd2\xc9\xbf\x24\x7b\x25\xba\xc8\x3f\xc9\x12\x35\xb8\x3f\x98\x17\x82\x35\x36\xbe\x56\x71\x61\xe0\x42\xe4\x9f\x68\x21\x99\x5d\xf5\x93\x20\x6d\x0f\x4b\x2f\x5d\x41\xf2\xea\x52\xe7\x97\x3a\x19\xe3\xbd\x10\x63\x4b\x5a\x0b\xee\x8f\xd4\x97\x0d\x42\x70\x33\x0c\x4f\xbc\xc6\x84\x3b\x8c\xb5\x31\xbb\xc7\xf8\xb7\xad\xfb\xb3\x66\xed\x4d\x1d\x9c\xbf\xc1\x58\xd1\xc1\xff\xa5\x1a\x6f\xff\x61\xd0\xc0\xfc\x22\x41\xaf\x9e\xc8\x8f\x8e\x89\xf9\xaf\x3b\xa3\xa9\x5f\xee\xfc\x1d\xf8\x2e\x4f\xa7\xa8\xc2\xd5\x37\x5a\x22\x99\xb7\xcb\x29\xda\x74\x02\x77\x2d\x16\xf4\x6f\x6f\x4c\x8e\x35\x7a\xf9\x65\x05\xe3\xfa\x34\xfc\xc4\xde\x03\xa2\x21\x9e\x94\xff\xa8\xfa\x9e\x53\x67\x08\x70\xbe\xfe\x5e\xf1\x4f\xa6\x40\xe8\xf4\x97\x63\x81\x47\x0e\x05\xab\xeb\xd9\xf5\xf5\x3c\x0f\xbc\xce\x57\x4c\x4f\xcf\x86\xe6\x69\x6c\x68\x9e\xc7\x86\x90\xa7\x87\x09\xd1\x1c\xfd\x6f\xed\x7b\x40\x51\xc2\x30\xc4\xff\x1f\x00\x9d\x0a\x54\xe1\xed\x19\x00\x00")
 
 func templatePrivacyTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1066,7 +1129,7 @@ func templatePrivacyTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templateRuntimeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5d\x6f\xdb\x3a\xd2\xbe\xb6\x7e\xc5\xbc\x86\x5f\xc0\x0e\x12\x3a\xed\xdd\x66\xe1\x8b\x6e\xd3\xe2\x18\xd8\x16\xc5\xa6\xed\x4d\x10\x1c\xd0\xd2\xc8\xe6\x89\x44\xea\x90\x54\x9a\xc0\xd0\x7f\x5f\x70\x48\x4a\x94\x3f\xd2\xb3\xdd\x9b\xbd\x49\x44\x72\x66\x38\xf3\xcc\x27\xbd\xdf\x2f\x2f\xb2\xf7\xaa\x79\xd1\x62\xbb\xb3\xf0\xf6\xfa\xcd\xdf\xae\x1a\x8d\x06\xa5\x85\x8f\x3c\xc7\x8d\x52\x8f\xb0\x96\x39\x83\x77\x55\x05\x44\x64\xc0\x9d\xeb\x27\x2c\x58\xf6\x75\x27\x0c\x18\xd5\xea\x1c\x21\x57\x05\x82\x30\x50\x89\x1c\xa5\xc1\x02\x5a\x59\xa0\x06\xbb\x43\x78\xd7\xf0\x7c\x87\xf0\x96\x5d\xc7\x53\x28\x55\x2b\x8b\x4c\x48\x3a\xff\xe7\xfa\xfd\x87\xcf\x77\x1f\xa0\x14\x15\x42\xd8\xd3\x4a\x59\x28\x84\xc6\xdc\x2a\xfd\x02\xaa\x04\x9b\x5c\x66\x35\x22\xcb\x2e\x96\x5d\x97\x65\x64\xc3\x57\xc7\xd2\x4a\x2b\x6a\x04\x8b\x75\x53\x71\x8b\xb0\x45\x89\x9a\x5b\x34\x24\xd1\xe4\x3b\xac\xf9\x95\xb1\xc2\xe6\x3b\x21\xb7\x50\xa9\xad\xc8\x81\xcb\x02\x76\xaa\x2a\x88\x28\xab\x55\xd1\x56\x08\x4f\xa8\x8d\x50\x4e\x13\x6e\xe1\x07\x37\xd0\x3a\x8b\xac\xea\x45\x92\x44\x6e\x0c\x5a\xc3\xb2\x6c\x6d\x61\xc7\x0d\xbc\x85\x52\xe9\x9a\x5b\xc3\xe0\x1d\x4c\x83\x3a\x53\x68\x78\xfe\xc8\xb7\xe8\x85\x99\x9d\x6a\xab\x02\x36\x08\x58\x37\xf6\xe5\x4a\xd4\x8d\xd2\x16\x8b\x60\x77\x56\x73\x21\x7b\x8e\x52\xe9\xa0\xb6\x81\x1f\xc2\xee\x60\xa7\xd4\xa3\x01\xa5\xa1\x51\x95\xc8\x05\x1a\x98\x37\xca\xa2\xb4\x82\x57\x90\xbf\xe4\x95\xc8\x83\xc4\x05\x23\x4c\x0c\xe6\x4a\x16\x41\x2f\xe7\x9e\x68\x40\xea\x9f\x29\x4a\xdb\xab\x79\x49\x88\xa4\xca\x81\x30\x99\x54\x16\x24\xe6\x68\x0c\xd7\x2f\x30\x97\x0a\x54\x63\x1d\x42\x4e\xc5\x83\x8b\xe1\xf8\xe2\x08\xdf\x23\x62\x93\x6d\x78\xfe\xf8\x83\xeb\xc2\x5c\xe5\xaa\x6e\xb8\x15\x1b\x51\x09\xfb\xe2\x2d\x6c\x34\x3e\x09\xd5\x9a\xe8\x02\xe3\x5c\x8f\xd2\x0e\xde\x86\x02\x4b\x21\xb1\x07\x78\x49\xda\x77\x5d\x06\x00\xb0\xdf\x0f\xee\x1f\x3c\x30\x73\xc7\xfb\x3d\xa0\x2c\xe0\x8c\x90\xe6\x71\x9b\x0a\x21\x5d\xf0\xd9\x3a\x8e\x19\x4c\xbf\x78\x6c\xa6\x89\xcc\x40\x7b\xfe\x52\x96\x88\x0b\x17\x4f\xf6\x7b\x98\x85\x10\xbb\x59\xc1\x8c\x7d\xa2\xef\xb5\x2c\x55\x3c\x16\xa5\x73\x6f\x20\x62\xdf\x43\x1c\xc6\xf5\x5d\x5b\x13\x61\xae\xa4\xb1\x30\xcf\x26\x93\xfd\xfe\xca\x2b\x7b\xc8\xe2\xc8\x26\x93\xb8\x5a\xc1\x74\xbf\x27\x95\xa6\xb0\x5c\x42\xdc\xf6\xd8\x52\xee\x6e\x51\xb2\x20\x2f\x6a\x7b\x2c\x3c\xde\x3f\x99\xb8\xaf\x03\xa1\x6e\xeb\x75\x81\x0b\x32\x31\xac\x5e\xf5\xc7\x34\xee\x0f\xc0\xee\x90\x17\xa8\x03\xae\xee\x68\xe6\xb3\xe1\x66\x05\xd7\x41\x9e\xe6\x72\x8b\x30\x93\x1e\xdc\xcf\xaa\x40\xd3\xc3\x2e\xdb\xfa\xb7\x48\x3f\x93\xec\x73\x5c\x76\x9d\x47\x7d\x26\xd9\x6f\xdc\x7c\x71\x79\xf5\xe2\x37\x07\x96\x15\xf0\xa2\x48\xd6\x6f\x3c\x41\xea\xd5\x5d\x4a\xe8\x17\x03\xfd\xc8\x5a\x47\xad\x6d\xf3\xb8\x75\x9a\x94\xbc\x32\xd8\xeb\xb0\xe3\xe6\xa3\xc0\x8a\x42\xee\x2e\x57\x0d\xc1\x30\xd0\xaf\x00\xff\x84\x19\xa3\x13\x16\x42\x72\x84\xd8\x18\x52\x67\x94\x67\xec\x3a\x70\x55\x12\xde\x18\x1b\x33\xf2\x2a\x96\xcb\x65\xf8\xcf\xb6\x0a\x28\xc5\x42\x14\x06\x23\x62\x10\x4f\x4e\x05\xf9\x52\xe3\x56\x18\xeb\xbc\x32\x8b\x48\xa0\x37\x28\x9b\x4c\x96\x4b\x5f\x09\x4e\xd7\xdd\x51\x2d\x12\xd2\x65\xc9\x8c\xbd\x57\xb2\x14\xdb\xde\xb6\xae\x4b\xb4\x3b\x8c\x9d\x08\xdc\xf2\x02\xde\x0e\x95\xc6\x05\x9b\x3d\x67\x93\xab\x62\xff\x5b\x76\xbd\x62\xdf\x51\x96\x50\xa7\x83\xa8\x5a\xb8\x1f\x76\x5c\x16\x15\x6a\xe3\xca\xab\x7d\x69\x30\xd6\x71\xe3\x2d\x3f\x51\xea\x06\xe3\xba\x2e\x0b\x25\x7e\x9e\x25\xc9\x1e\xd5\xbd\xf3\x37\x90\xd1\x7d\xa6\x67\xa3\x8c\x76\xdf\xe7\xb2\x8e\x78\x4e\xd9\x4e\xb9\x95\x6c\x8c\x65\x66\x93\xe9\x56\xd8\x5d\xbb\x61\xb9\xaa\x97\x65\x98\x42\x84\xcc\xdb\x0d\xb7\x4a\x53\xb9\xcf\x16\x59\x96\x05\x3f\x08\x29\x2c\x94\xad\xcc\xa9\x1f\x69\xe4\x85\x01\x5e\x55\x11\x9f\x02\x4d\xae\x45\x63\x95\x0e\x3d\x34\xc0\xe0\xd8\x69\x66\x99\x17\x58\xf2\xb6\xb2\xf0\xc4\xab\x16\xcd\xa5\xfb\x2f\x0a\x4e\x0c\x4a\xfb\x96\xbb\xa0\xa6\xe8\x5d\x8d\x06\x84\x75\xdc\x0e\xf0\x1d\x0a\xdd\xb7\xeb\x27\xae\x05\xdf\x54\x68\x58\xe6\xf4\x21\xcd\xe6\x0b\xd8\x67\xaf\xa1\xe4\xce\x66\xa1\x1a\x8c\x61\x09\x67\xc1\x8e\x9b\x15\x6c\xb8\xc1\x93\xde\x19\x5c\x27\xd9\xbf\xbc\x79\x9f\xc4\xb3\x90\xb1\x8a\xfb\x0b\xba\xce\x6f\xde\xac\x28\x28\x4d\xe4\x67\xde\x1f\x9f\x79\x4d\x09\xd5\x31\x22\x9b\x2f\x8e\x3d\x7d\x5c\x26\xbd\xf8\x46\x0b\x69\xfd\x25\x53\xe6\xcf\x5c\x70\xc1\xcf\x2e\xf2\xa4\xee\xa6\x23\x29\x54\x38\x9d\x90\xfb\xeb\x07\x58\x91\x7f\xe7\x12\x9f\x2d\xcd\x02\x9f\x5a\xeb\xfc\xb3\x48\x17\xb0\x77\x6d\x49\xa3\x6d\xb5\x1c\xf6\xf1\xa3\x63\x24\xee\xdc\x3e\x43\xae\xa4\xc5\x67\xeb\x20\x74\xff\x2f\xa1\x1e\x48\x85\x92\x0b\x98\xbb\xe5\x77\x17\x08\x97\x80\x5a\xbb\x3b\x48\xee\x44\x94\x6e\x1d\xb0\x3b\x63\x2f\xfb\xf0\xc4\xab\x28\xcb\xdd\x77\x09\xf5\xe2\xef\xc4\xf7\x7f\x2b\x90\xa2\x0a\xb2\xa2\x96\x52\x54\x74\x0b\x6d\x52\x57\xed\x4f\x9c\x92\xde\x80\x28\xc7\x1d\x77\xee\x6f\x77\xec\x17\xef\xfb\x5d\xd2\xde\x1c\x7c\x5f\x94\x11\x96\x46\xa8\xd1\xac\x72\x05\xcb\x0b\xf0\x7d\xc9\x57\x06\x2a\x53\xc1\x49\xb5\x73\xbd\x61\xa1\x6a\x26\xc2\x45\xf1\x1c\x44\x7f\x12\xcf\x58\xac\x65\xdf\xd9\x26\x93\xb4\x0a\x08\xa2\x72\xd4\xc9\xa5\xc9\xa0\x94\x42\x47\x71\x16\x1c\x3d\x13\x2e\x60\x42\x68\x26\xd1\x7a\xef\xd6\xee\xec\x81\xcd\x85\xb4\xa8\x5d\x41\xd8\x7b\xfd\xe7\x0b\xb8\x7f\x70\x0e\x73\x2b\xe8\x16\x2c\xec\x46\x95\x46\x73\x4c\x58\x1c\xe0\xb0\x76\xef\x0a\xd4\x08\x5c\x63\x98\xae\x13\x50\x86\x67\x43\x40\x24\xe5\x0e\x71\xdd\x0f\x15\x49\x2b\x0f\x58\x34\x84\xc5\x6e\x34\x66\x50\x0b\x6a\x22\x88\xa1\xbd\xa7\x92\x56\x60\x75\x8b\x69\x33\x4f\x26\x8d\x3e\x0b\x53\x8e\xe8\x83\x11\xb6\x7d\xfe\xfc\x3c\xdd\x07\xd4\x8e\x40\x8b\x4e\xbd\x3c\x34\x26\x1b\xbb\xf5\x4c\x69\xf0\xb5\x47\xc4\xb1\x48\xd0\xe0\x74\xe4\x9d\xde\xa8\x14\x96\x9f\xc5\x4e\x52\x20\xfa\x08\x81\xd5\xeb\x21\xd6\xf8\xca\xb6\x96\x05\x3e\x47\xc6\x86\xc5\xe5\x43\xaf\x58\xe8\xf4\xbf\xa6\xc1\x39\x3f\x9c\xbd\xed\x44\x90\x9e\x2a\xbc\xee\x55\x40\x00\xdf\x86\x76\xe5\x57\xdf\x87\x66\x75\x10\x9f\x67\xf2\x96\x06\xcc\x93\x2e\xfc\xd5\x0c\xf6\x12\xff\x52\x0a\x7b\xd2\xf9\xe2\xe8\xee\x93\x28\xf8\xfe\x57\x7a\x85\xbd\x11\xbd\xf6\xfd\xd0\xbe\xbe\x65\xdf\x0c\xea\xdb\x90\xb5\x3e\xa1\x02\xcf\x0a\x78\xd3\xd0\x13\x2e\x6c\x10\xfd\x89\x94\xf2\x58\x95\x3d\x34\x93\xb4\x6b\x7e\xec\x15\x78\x3d\x8f\x7a\xe3\x26\x93\xc9\xef\x90\xc2\xe0\x4f\x7e\x92\x60\x25\x99\x78\xa0\xc3\x15\xcc\xdc\x00\xe3\x8e\x52\xdc\x6f\xd1\xe4\x53\x98\x95\xec\xce\xea\x36\xb7\xfe\xd1\x30\xf0\x2c\x2f\x00\x65\x5b\xc3\x78\xb2\x09\xa3\x62\x01\x12\xb9\x0e\xa3\x4b\x81\x79\xc5\x35\xf7\x6d\x62\xee\x4a\x5e\x32\x42\x2e\xfa\x3e\x90\x04\xe1\x9c\x13\x9e\x2c\x86\xe1\x9c\x2a\x5a\xc9\xd6\xe6\x83\x6c\xeb\xc5\xc2\x7d\x7f\x6b\x0a\x6e\xb1\x0f\xd4\x92\x8d\xa3\xd4\x15\x86\xe5\x92\xf0\x21\xe3\xba\xce\x4d\xcd\x43\xb1\x4d\x66\x36\xfa\x7d\x81\x3c\x1a\x81\x06\x42\x88\x85\xea\xe2\x0b\x47\xc9\x62\xaf\x4b\x2b\xc8\x24\x16\xa0\x78\xc9\x71\xf3\x1e\xc7\xef\x58\xcc\x41\xa1\x48\x0e\xfb\x1c\x66\xb7\xbd\xa2\xde\xed\xa3\xfa\x71\xe6\xfe\x51\x4c\xfc\xa7\xa2\x47\x35\xf3\xb0\x2b\xbc\xee\x99\x71\x4c\x79\x92\x83\xb0\x62\xd3\x84\x3f\xe0\x9d\xa5\xce\xf2\x5c\x5d\x37\xfc\x62\x36\x8e\x31\x50\x12\x72\x8d\xbc\xff\x69\xc8\x51\x9c\x73\xdf\x81\xc8\x55\x1a\x10\x51\x09\x36\x1f\x7e\x13\x71\x96\x7c\x15\x35\xfa\xaf\x6f\xdf\x28\x93\x69\xb4\x5b\x40\xda\x2c\x67\x25\xfb\xea\xa2\xb8\xeb\x4e\xf6\x34\x1f\x30\xe3\x10\xfd\xab\xd0\x8c\xb8\x7e\x15\xa0\x96\x84\xfc\x77\xf0\x8c\x14\x89\x20\x0d\x08\xfd\x0a\x2e\xa1\x06\x1e\xe7\x2a\xc1\x22\x9d\x7e\x27\x31\xe9\xe9\x53\x72\x2a\x23\xee\x45\x43\xf4\x25\x4c\xe9\x41\x34\xff\x7f\xb3\xf0\x63\xf5\x34\xd1\x26\x01\x50\x06\x14\x84\x01\x3e\xbc\xc3\x7a\xa8\xa6\x23\xac\xa6\x01\x2c\x58\xd3\x2f\x9b\x39\xaf\x5c\x71\xdb\xbc\x10\xe9\xa6\x15\x55\xe1\x9e\xc5\x1b\x2c\x95\x46\x30\xfc\x09\x59\x52\xc9\xf0\xcf\x03\x5b\xdf\xa4\x23\x4b\xd4\x63\x0c\xfa\x40\x7d\x7f\xfd\x40\xa0\x7b\x3b\x3d\xa0\x47\xb9\x3f\x16\x34\x38\x24\x32\xc5\xd7\x40\xf2\xde\xbc\x39\x77\xa1\xa7\x2c\x25\x91\xdc\x33\xc6\x1e\x48\xde\xd8\xab\x1e\xda\x28\x36\xed\x2f\x7f\x5c\x86\x97\xe7\x73\xd8\x38\x55\x92\x47\xaa\x50\x61\xfa\xc3\xcf\xdd\x67\xaf\x5a\x5c\x26\x57\x0d\xb5\x29\x3e\x66\xe2\x6b\x26\xe1\xff\x87\x77\x4b\x6c\x58\xf0\xaa\x01\xce\xed\xbf\x5f\x42\x49\x9a\x7b\xc5\x1d\x02\xf1\x38\x79\x93\x95\xf2\xb4\xfc\x93\xaf\xaf\xe4\x99\x18\xde\x5e\x83\xc6\xfd\xff\xe1\x89\x96\x5a\xd4\xbd\xfe\xb8\x48\xbf\x4f\x7f\xfa\x5f\xe6\xc2\xe2\xdf\x01\x00\x00\xff\xff\x68\x91\x6f\xa7\x7e\x19\x00\x00")
+var _templateRuntimeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5b\x6f\xdb\x3a\x12\x7e\x96\x7e\xc5\xac\xa1\x05\xe4\x20\xa1\xdb\xbe\x6d\x16\x7e\x38\xa7\x17\x34\xc0\x69\x51\x6c\x2f\x2f\x45\x70\x40\x4b\x23\x9b\x27\x32\xa9\x43\x52\x69\x02\x43\xff\x7d\x31\x14\x29\x51\xbe\x25\xed\xbe\x2c\x12\xc0\x12\x35\x9c\xf9\xe6\x9b\x0b\x2f\xbb\xdd\xe2\x22\x7d\xad\x9a\x47\x2d\xd6\x1b\x0b\xaf\x5e\xbc\xfc\xd7\x55\xa3\xd1\xa0\xb4\xf0\x8e\x17\xb8\x52\xea\x0e\x6e\x64\xc1\xe0\xb7\xba\x06\x27\x64\x80\xbe\xeb\x7b\x2c\x59\xfa\x65\x23\x0c\x18\xd5\xea\x02\xa1\x50\x25\x82\x30\x50\x8b\x02\xa5\xc1\x12\x5a\x59\xa2\x06\xbb\x41\xf8\xad\xe1\xc5\x06\xe1\x15\x7b\x11\xbe\x42\xa5\x5a\x59\xa6\x42\xba\xef\x7f\xdc\xbc\x7e\xfb\xf1\xf3\x5b\xa8\x44\x8d\xe0\xc7\xb4\x52\x16\x4a\xa1\xb1\xb0\x4a\x3f\x82\xaa\xc0\x46\xc6\xac\x46\x64\xe9\xc5\xa2\xeb\xd2\xd4\xf9\xf0\x85\xa6\xb4\xd2\x8a\x2d\x82\xc5\x6d\x53\x73\x8b\xb0\x46\x89\x9a\x5b\x34\x4e\xa3\x29\x36\xb8\xe5\x57\xc6\x0a\x5b\x6c\x84\x5c\x43\xad\xd6\xa2\x00\x2e\x4b\xd8\xa8\xba\x74\x42\xe9\x56\x95\x6d\x8d\x70\x8f\xda\x08\x45\x48\xb8\x85\x1f\xdc\x40\x4b\x1e\x59\x35\xa8\x24\x61\xe0\xc6\xa0\x35\x2c\x4d\x6f\x2c\x6c\xb8\x81\x57\x50\x29\xbd\xe5\xd6\x30\xf8\x0d\x66\x1e\xce\x0c\x1a\x5e\xdc\xf1\x35\xf6\xca\xcc\x46\xb5\x75\x09\x2b\x04\xdc\x36\xf6\xf1\x4a\x6c\x1b\xa5\x2d\x96\xde\xef\x74\xcb\x85\x1c\x66\x54\x4a\x7b\xd8\x06\x7e\x08\xbb\x81\x8d\x52\x77\x06\x94\x86\x46\xd5\xa2\x10\x68\x20\x6f\x94\x45\x69\x05\xaf\xa1\x78\x2c\x6a\x51\x78\x8d\x73\x8a\x0e\x82\xc1\x42\xc9\xd2\xe3\xa2\xf0\x04\x07\xe2\xf8\xcc\x50\xda\x01\xe6\xa5\x63\x24\x06\x07\xc2\xa4\x52\x59\x90\x58\xa0\x31\x5c\x3f\x42\x2e\x15\xa8\xc6\x12\x43\x04\x71\xcf\x30\x1c\x1a\x0e\xf4\xdd\x21\x36\xe9\x8a\x17\x77\x3f\xb8\x2e\xcd\x55\xa1\xb6\x0d\xb7\x62\x25\x6a\x61\x1f\x7b\x0f\x1b\x8d\xf7\x42\xb5\x26\x84\xc0\x50\xe8\x51\xda\x31\xda\x50\x62\x25\x24\x0e\x04\x2f\x1c\xfa\xae\x4b\x01\x00\x76\xbb\x31\xfc\x63\x04\x32\xe8\xba\x74\xb7\x03\x94\x25\x9c\x50\xd2\xdc\xad\x63\x25\x0e\x0b\x3e\x58\x9a\x91\xc1\xec\x53\x1f\x90\x59\xa4\xd3\xcb\x9e\x36\xca\x22\x75\xde\x70\xb2\xdb\x41\xe6\x53\xec\x7a\x09\x19\xfb\xe0\x9e\x6f\x64\xa5\xc2\x67\x51\x51\x78\xbd\x10\xfb\xe6\xf3\x30\xbc\x7f\x6e\xb7\x4e\xb0\x50\xd2\x58\xc8\xd3\x24\xd9\xed\xae\x7a\xe2\xf6\xa7\x90\x58\x92\x84\xb7\x25\xcc\x76\x3b\x07\x69\x06\x8b\x05\x84\xe1\x9e\x5b\x57\xbb\x6b\x94\xcc\xeb\x0b\x68\x0f\x95\x07\xfb\x49\x42\x4f\x7b\x4a\x69\xe8\xbc\xc2\x79\x9a\x8c\x64\x9c\x8d\xc7\x2c\x8c\x8f\xc4\x6e\x90\x97\xa8\x3d\xaf\x34\x25\xeb\xab\xe1\x7a\x09\x2f\xbc\x3e\xcd\xe5\x1a\x21\x93\x3d\xb9\x1f\x55\x89\x66\xa0\x5d\xb6\xdb\xf7\x41\x3e\x93\xec\x63\x78\xed\xba\x9e\xf5\x4c\xb2\xf7\xdc\x7c\xa2\xba\x7a\xec\x07\xc7\x29\x4b\xe0\x65\x19\xa9\x78\xd9\x0b\x78\xf8\xc9\x88\xc5\x0b\xf6\xc0\x46\xf9\x89\xb7\x24\xad\x6d\x73\xb7\x26\x24\x15\xaf\x0d\x0e\x18\x36\xdc\xbc\x13\x58\xbb\x94\xfb\x5c\xa8\xc6\xa5\xd9\x28\xbf\x04\xfc\x1b\x32\xe6\xbe\x30\x9f\x92\x13\xc6\x46\x23\xa9\x77\xaa\x9f\xd8\x75\x40\x5d\x12\x5e\x1a\x1b\x2a\xf2\x2a\xb4\xcb\x85\xff\x65\x6b\x05\x17\x8b\x31\x0b\xbd\x47\x21\x89\x93\x63\x49\xbe\xd0\xb8\x16\xc6\x52\x54\xb2\xc0\x04\xf6\x0e\xa5\x49\xb2\x58\xc0\x97\xd3\x7d\x77\xd2\x8b\x84\xa4\xa2\xcb\xd8\x6b\x25\x2b\xb1\x1e\x7c\xeb\xba\x08\xdd\x7e\xee\x04\xe2\x16\x17\xf0\x6a\xec\x34\x94\x6c\xf6\x94\x4f\xd4\xc5\xfe\xbf\xfc\x3a\xe3\xdf\xfe\x13\x95\xc3\xe2\x02\x02\x34\x6f\x1f\x36\x5c\x96\x35\x6a\x43\xed\xd5\x3e\x36\x18\xfa\xb8\xe9\xa3\x79\xa4\xd5\x8d\xce\x75\x5d\xea\x5b\x7c\x9e\x46\xc5\x1e\xe0\x7e\xee\x2d\x10\x7f\xc9\x50\xe9\xe9\xa4\xa2\xe9\xf9\x54\xd5\x25\xb3\x13\xbe\xd3\xb0\x8c\x06\xa6\x3a\xd3\x64\xb6\x16\x76\xd3\xae\x58\xa1\xb6\x8b\xca\xef\x42\x84\x2c\xda\x15\xb7\x4a\xbb\x76\x9f\xce\xd3\x34\xf5\x71\x10\x52\x58\xa8\x5a\x59\xb8\xf5\x48\x23\x2f\x0d\xf0\xba\x0e\xfc\x94\x68\x0a\x2d\x1a\xab\xb4\x5f\x43\x3d\x0d\x34\x9d\xfa\x1e\xe4\x25\x56\xbc\xad\x2d\xdc\xf3\xba\x45\x73\x49\xbf\xa2\x24\x53\x6e\xa5\x75\xe9\x32\x77\x8b\x62\x1f\x6a\x34\x20\x2c\xcd\x26\xc2\x37\x28\x74\x60\x1c\xee\xb9\x16\x7c\x55\xa3\x61\x29\xe1\x71\xc8\xf2\x39\xec\xd2\x73\x2c\xd1\xb7\xcc\x77\x83\x29\x2d\xfe\x9b\xf7\xe3\x7a\x09\x2b\x6e\xf0\x68\x74\xc6\xd0\x49\xf6\x9f\xde\xbd\x0f\xe2\x41\xf8\x45\x80\xe8\x26\x03\x5d\xd7\x0f\x5e\x2f\x5d\xb1\x79\xbd\x5d\xc7\xe8\x4d\xb2\x8f\x7c\x4b\x56\x77\x1d\x73\x62\xf9\xfc\x30\xd2\x87\x6d\xb2\x57\xdf\x68\x21\x6d\x6f\x64\xc6\xfa\x16\x4a\xc9\x05\x4f\x19\xea\x45\xf3\xf9\x11\x2d\xae\x71\x92\x92\xef\x2f\x6e\x61\xe9\xe2\x9b\x4b\x7c\xb0\x54\xde\xec\x43\x6b\x29\x3e\xf3\xf8\x05\x76\xb4\x2c\x69\xb4\xad\x96\xe3\x38\xbe\xa3\x89\x6e\x76\x61\x1f\xa0\x50\xd2\xe2\x83\x25\x0a\xe9\xf7\x12\xb6\xa3\xa8\x50\x72\x0e\x39\xbd\x7e\xa3\x44\xb8\x04\xd4\x9a\x6c\x38\xbd\x89\xa8\xe8\xdd\x73\x77\xc2\x5f\xf6\xf6\x9e\xd7\x41\x57\x5e\xd8\x87\x4b\xd8\xce\xff\xed\xe6\xfd\x63\x09\x52\xd4\x5e\x57\x40\x29\x45\xed\xac\xb8\x41\x2a\xb2\x01\x3f\x79\xea\x1d\x08\x7a\xe8\x73\x47\x4c\x75\x87\x71\xe9\x63\x3f\x2c\x87\xb4\x94\x29\x75\xf7\x49\x19\x41\x48\x4c\xe8\x75\xf4\x4f\xa9\xb2\xb8\x00\x47\xaf\xef\x0c\x6e\xef\xe9\x83\xb4\xa5\xd0\x1b\xe6\xbb\x66\xa4\x5c\x94\x0f\x5e\xf5\x07\xf1\x80\xe5\x8d\x1c\x56\xb6\x24\x89\xbb\x80\x70\x52\x24\x1d\x19\x0d\x7f\x7b\xd4\xb9\x3c\xf3\x81\xce\x04\x25\x8c\x4f\xcd\x28\x5b\xbf\x53\xce\xd0\xb7\x5b\x96\x0b\x69\x51\x53\x43\xd8\xf5\xf8\xf3\x39\x7c\xbf\xa5\x80\xd1\x1b\x74\x73\xe6\x47\xd3\x24\x99\x50\x14\xbf\x8c\x50\x1c\x0f\x37\x74\xae\x40\x8d\xc0\x35\xc2\x66\x9f\x94\xf1\xd8\xe0\x19\x89\x67\xfb\xbc\x1e\x36\x15\xd1\x52\xee\xb9\x68\x1c\x17\x1b\x4f\x54\xb4\x04\x35\x81\x44\xbf\xbc\xc7\x9a\x96\x60\x75\xeb\xf5\xf4\x0e\x8c\x4b\x40\x32\x54\x61\x3c\x23\xc4\x60\xc2\xed\x50\x3f\x70\xfd\x54\x15\x8e\xac\x1d\x90\x16\x82\x7a\xb9\xef\xcc\x24\xb4\x27\x5b\x03\x69\xa4\xe8\xf9\x6d\x91\x80\x97\x03\xd8\xc8\xd0\xe0\x54\x4c\xcb\x53\xb9\xc3\x06\x07\xc7\x0c\x81\xe5\xf9\x14\x73\xfa\x85\xbc\x91\x25\x3e\x84\x89\x0d\x0b\xaf\xb7\x03\x30\xbf\xd2\x07\xcb\x3f\x87\x60\x4f\x6a\x2a\x74\xcc\x5a\xcc\x77\x78\xd9\x7f\xf6\xa7\x02\x47\xf0\x1b\xbf\x5c\x51\x95\x73\xf3\x6d\x5c\xac\xfa\x81\x3f\x54\xe1\x7a\x99\x7f\x7d\x2b\x0b\xfd\xd8\xd0\x79\x2f\xf2\xe8\x4c\x59\xbb\xfd\xe7\xd1\x08\xff\x6a\x81\xf7\x1a\x9f\x55\xe1\xbd\x68\x3e\x3f\xb0\x7d\x94\x24\xf7\x92\x55\x6e\x8e\x77\x62\x40\xef\xf7\xb0\x92\xdd\xbc\x61\x5f\x0d\xea\x37\xbe\xa8\x5d\x35\x85\x39\x4b\xe0\x4d\x43\xaa\xc3\x80\x93\x3f\x52\x71\x3d\x57\x5e\x28\xe4\x6c\x70\xc2\xdb\x7c\xb2\xcc\x06\xe7\x92\x24\xf9\xd3\x2f\x8d\xb1\x86\x63\xde\x45\xf5\x57\x39\x17\xf7\x30\x5c\x41\x46\xfb\x1b\xfa\x14\xf3\xfe\x06\x4d\x31\x83\xac\x62\x9f\xad\x6e\x0b\xeb\xf4\x47\x73\x16\x17\x80\xb2\xdd\xc2\x74\xe3\xe3\x77\x92\x25\x48\xe4\xda\xef\x6c\x4a\x2c\x6a\xae\x5d\x3e\x19\xc8\x85\x9c\xec\x30\xe7\xc3\x32\x11\xe5\x68\x4e\x1b\xa5\xac\x62\x21\x4b\x73\xd7\xf0\x2a\x76\x63\xde\xca\x76\x3b\x9f\x13\xaa\xaf\x4d\xc9\x2d\x0e\x79\x5c\xb1\x38\x89\x2b\x36\x66\x70\xc5\x26\xe9\x9b\x24\x6e\xf3\x4d\xd4\x3b\xaf\xbb\x0e\x44\x7c\xb7\x13\xed\xf5\xe8\x38\xe0\x18\xae\x42\x04\xc0\x51\xc7\x7c\x57\x72\x25\x95\x55\x2c\xac\x91\x71\xe7\x49\x42\xe3\x0a\x46\xae\x97\xe7\x13\x7b\xaa\x66\xaf\xc1\x44\x1f\x87\xda\x67\x6f\x06\xa0\x7d\x3e\x4c\xfa\xce\x09\xfb\x93\x74\xfb\x59\xd5\x21\xa9\x0e\x53\x4c\x54\x70\x3e\x64\xd1\xc4\x2c\x64\xcc\x5e\xbe\xb1\x59\x34\xdf\xf3\x9d\xc6\xc1\xea\x67\x75\xdd\x78\xd3\x36\x4d\x3e\x50\x12\x0a\x8d\x7c\xb8\x52\xa2\xa0\x9e\x0a\xdf\x9e\xca\xa5\xb7\xe1\x62\x15\x40\xb0\x7c\xbc\x4b\x21\x4f\xbe\xd0\xc5\xa0\x7b\xfa\xfa\xd5\x95\xb8\xdb\x12\xce\x61\xa8\x74\xd2\x51\xb1\x2f\x94\xde\x5d\x77\x74\x2d\x14\xd5\x61\xee\x3e\x97\x9a\xc9\xac\x5f\x25\xa8\x75\x4a\xfe\x37\x7a\x26\x40\x02\x49\x23\x43\xbf\xce\xcb\x50\xb4\x11\x25\x92\xb0\x1d\xe5\x23\x48\x1f\x52\x21\xbd\x3f\x23\x0f\x75\xd0\xec\xee\x80\x11\xe8\x74\x13\x5a\x96\xaa\x9e\xa4\x22\x28\x9c\xf2\x10\x00\x9c\x76\x68\xbf\xf3\x3c\xe1\xd1\x6b\xd1\x6c\x50\x3f\xc7\x9f\xc2\x49\x06\xe8\x38\x98\xf9\x79\x27\x7a\x9b\x01\x5c\x0f\xfb\x3d\x37\xbf\xd7\x42\x96\xa1\x21\x0c\x2d\xa0\xc7\x7e\x0c\xfa\x28\x1f\xc1\x3f\x8d\x7f\x45\xe2\x20\x48\xfe\xf9\x4e\x9c\xf1\x62\x34\x9f\x26\x47\xa2\x71\x6c\x21\x8e\x17\x8c\x67\x45\x67\x90\x8f\xc5\xdd\x6d\x09\x9d\xba\x1d\x25\x15\xcc\x28\xf7\x21\xff\xa7\x99\xd3\xa1\x4c\xe9\x59\x94\xf9\x47\x22\x2a\x0c\xf0\xf1\xae\x60\x28\xcb\xd9\x84\x82\x99\xe7\x00\x6e\x2c\xad\x55\x05\xaf\x6b\x2c\x61\xf5\xe8\x44\x57\xad\xa8\x4b\xba\xba\x59\x61\xa5\x34\x82\xe1\xf7\xe8\x43\xee\xc2\x89\x7f\x4f\xb0\x9b\x61\xfb\x7c\x86\xcd\x51\xfa\xfb\x8b\x5b\x57\xe0\x99\x1d\x8b\xf7\x60\x9d\x99\x2a\x1a\x8b\x3f\x4c\x0a\x27\xd6\xe8\x4e\xe4\xfa\x94\xc1\x5e\xb2\x92\xee\x34\xf4\x9d\x31\x76\xeb\xf4\x4d\x3b\x48\x4f\x6d\x50\x1b\x6f\x72\xfe\xba\xf4\xb7\x23\x0f\x7e\x60\xe2\xba\xc7\x3b\x81\xe2\x16\xc1\xbf\xdc\xce\x31\x3f\x69\x6a\x7e\x19\x99\x1a\x92\x69\x38\x70\x87\x13\x77\x34\xff\xf7\x3e\x2c\x61\xd7\x04\xc7\xb4\x0e\x0e\x50\xd8\xff\xbc\x84\xca\x21\xef\x81\x13\x03\xe1\x73\x74\x6f\x50\xc9\xe3\xfa\x8f\xde\x10\x8c\xc0\xc2\xfd\xc0\x88\x78\xf8\xf5\x12\x52\xd4\xb1\x47\xdd\xf9\x03\x70\xfc\x7c\xfc\xb1\xbf\x3d\x46\x59\x42\xd7\xa5\xff\x1d\x00\x54\x56\x18\x76\x22\x1c\x00\x00")
 
 func templateRuntimeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1081,12 +1144,12 @@ func templateRuntimeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/runtime.tmpl", size: 6526, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/runtime.tmpl", size: 7202, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\xdf\x6f\xdb\xbe\x11\x7f\x96\xfe\x8a\x9b\x11\x14\x52\xe0\x4a\x5d\xdf\x16\x20\x0f\x45\xda\x02\x05\xba\x14\x5b\x5d\xac\xc0\x30\xb4\xb4\x78\xb2\x89\xca\xa4\x46\x51\x8e\x3c\xc3\xff\xfb\x70\x47\x52\x92\x63\xb7\xeb\x80\x6f\x1e\xda\x88\xf7\x83\xf7\xe3\x73\x9f\x63\x8e\xc7\xf2\x36\x7d\x30\xed\xc1\xaa\xcd\xd6\xc1\xeb\x57\x7f\xfe\xcb\xcb\xd6\x62\x87\xda\xc1\x7b\x51\xe1\xda\x98\x1f\xf0\x41\x57\x05\xbc\x69\x1a\x60\xa5\x0e\x48\x6e\xf7\x28\x8b\x74\xb5\x55\x1d\x74\xa6\xb7\x15\x42\x65\x24\x82\xea\xa0\x51\x15\xea\x0e\x25\xf4\x5a\xa2\x05\xb7\x45\x78\xd3\x8a\x6a\x8b\xf0\xba\x78\x15\xa5\x50\x9b\x5e\xcb\x54\x69\x96\x7f\xfc\xf0\xf0\xee\xf1\xf3\x3b\xa8\x55\x83\x10\xce\xac\x31\x0e\xa4\xb2\x58\x39\x63\x0f\x60\x6a\x70\xb3\xcb\x9c\x45\x2c\xd2\xdb\xf2\x74\x4a\xd3\xe3\x11\x24\xd6\x4a\x23\x2c\xdc\xb0\x80\x70\xe4\x70\xd7\x36\xc2\x21\x2c\xb6\x28\x24\xda\x05\xdc\xb0\x48\xed\x5a\x63\x1d\x64\x69\xb2\xa8\x8c\x76\x38\xb8\x45\x9a\x2c\xba\x83\xae\x16\x69\x9a\x2c\x36\xca\x6d\xfb\x75\x51\x99\x5d\x59\x87\xfc\x95\xae\xfa\xb5\x70\xc6\x96\xa8\x5d\x29\x95\x68\xb0\x72\x8b\x34\x4f\xd3\xb2\x84\xd5\x40\x39\x0b\x70\x56\xe8\x4e\x54\x4e\x19\x2d\x1a\xa8\x1a\x45\x15\x74\x5b\xe1\x48\x5c\x59\x14\x0e\x25\xac\x0f\x50\x89\xa6\x51\x7a\x03\x0f\xac\x51\xac\x86\x2c\x2f\x52\x77\x68\x91\x3c\x75\xce\xf6\x95\x83\x63\x9a\x54\x46\xd7\x6a\x93\x26\xc7\x23\x58\xa1\x37\x08\x37\xdf\x96\x70\xa3\xe1\xee\x1e\x6e\x8a\x47\x23\xb1\x83\x97\xa7\x53\x9a\x24\x65\x09\xc7\x23\xdc\xe8\xe2\x51\xec\x10\x4e\x27\xba\x8e\xca\x17\x22\xa8\x8d\x05\xa5\x1d\x5a\x0a\x4d\x6f\xe0\x49\xb9\x2d\xcb\xcf\x8d\xd6\xbd\x6a\x24\xda\xae\x48\x93\xe4\x5c\x72\x7b\xf6\xe9\xa3\xe6\xb0\x50\x4b\xae\x27\x45\xd0\x88\xff\xa8\xe6\x00\x8d\x11\x92\x50\x91\x84\xcb\xe9\xe7\x36\x9a\xf8\xb3\x4f\xba\x42\xa0\x62\x17\xf4\x9b\xb7\xae\xcc\xae\x6d\x90\x2a\xc7\xd5\x59\x8b\xea\x07\x05\xb2\xeb\x21\xfe\xb0\xc1\x5f\x7b\x87\x43\x9a\x18\xfd\x60\x76\x3b\x45\xde\xff\xf9\xaf\xba\xd7\x55\x86\xd6\x1a\x9b\x93\xe4\xef\xc6\x9b\x3f\x93\x30\x20\x5e\xc6\x42\x92\x84\xea\xd8\xa8\xce\xc1\xc2\x3b\x5b\xc0\x22\xda\x32\x80\x12\xd2\xbf\x31\xfa\x7d\xaf\xab\x8e\x94\x5b\xab\xb4\x83\x85\xd1\x8b\xe0\x80\x94\x42\xed\xc3\x37\xfd\xde\x98\x27\xb4\xe3\x89\xef\xc4\x0c\x19\x45\x9a\xb0\x28\x73\x03\xdc\xae\x86\x7c\x6e\x9e\xe5\xc0\xe1\x52\xf7\x13\xb4\x96\x6e\x75\x43\xe1\x81\x50\x48\xab\xf6\x68\x8b\xec\xd6\x0d\x6f\xf9\xd7\xbc\x70\x43\x71\x66\x9e\x26\x89\x1b\x8a\x5d\x5f\x7c\x34\xd5\x0f\xfe\x94\x58\xd3\x00\xf2\xe1\x17\xdd\xc4\x63\xc2\xc4\xb7\x25\xd4\x74\x83\xaf\x49\x70\x15\xf3\xa5\x5c\xd2\x24\x49\x6a\x2a\x20\x59\x10\xd0\x2c\xba\xde\x6a\x8a\x31\x4d\x42\xdb\x3f\xe9\x79\xfa\x42\x4a\x1a\x04\xfa\xe4\x5e\x3a\xc3\xed\x04\xa3\x2f\x2b\x73\x51\x88\x33\x57\x59\x0d\xb3\xf6\xe5\x1c\xcc\xef\xa5\x76\x99\xc8\x3d\x88\xb6\x45\x2d\xb3\x0b\xd1\x12\xea\x9c\x52\xa1\x56\x47\x30\x97\x65\x18\x4c\xf0\xe9\x52\x42\x0f\xb3\x59\x5e\x2b\x2d\x3b\xce\xac\xb7\x96\x4f\xe7\xcd\x3d\x4f\xc9\xdb\x65\x79\x1c\x01\x4a\x83\x1a\x3a\xce\x41\xf1\xd6\x64\x9c\xe7\x98\x61\x98\x9b\x7b\x78\xe1\x4d\x8e\xbe\xfb\x77\x13\x10\x4e\x73\xc5\x42\x69\xe5\x28\xef\x53\x9e\xc6\xfe\x8c\x42\x42\xfd\x79\x40\x5e\x9b\xee\xfa\x1f\xac\x42\x23\x17\x4b\x39\x31\xc1\x3d\x3c\xe2\xd3\x15\x36\xc8\xc6\xe0\xf2\x91\x18\xc8\x0b\x4f\x5d\x79\x0b\xb5\xb2\x9d\x03\x4d\xdb\x81\x90\x27\x4d\x05\x38\x08\x1a\x79\x60\xfe\xe6\x51\xf3\x4a\x77\xf7\xa0\xb4\xc4\x61\x8c\xe6\x55\xec\x4a\x84\x3d\x3c\x59\xd1\xfa\xb9\xda\xa8\x3d\x6a\x08\x74\x5c\xac\x06\xcf\x6d\x02\xb4\x69\xc7\xd3\x60\xa4\xe8\xb6\x1d\x6a\x27\x7c\xa3\x88\xb7\xb7\x08\x4a\xa2\x60\xbe\x34\xd0\xf5\x2d\xaf\x85\x59\x3f\x3b\x76\x68\x7a\x47\xc8\x26\xee\x14\xfa\x00\x38\x38\x2b\xfc\xaa\x73\x86\xc3\x98\xa8\xb3\x2c\xe1\x1f\x5b\xd4\x20\xe2\x19\xe3\x9f\xdd\x87\xf1\x25\xb6\x5f\x82\x72\xb0\x41\xe7\x93\xe8\xa8\x92\xb3\x1c\x94\xee\x9c\x20\x6c\x30\x14\x3d\xd1\x09\x2d\x61\x64\x36\x61\x91\x33\xa4\x52\x92\x03\x26\x77\x5a\x39\x31\x0e\x56\x27\x49\xdf\xa1\x85\x5d\xdf\xb9\x38\x86\x48\x3e\x79\x8f\xe2\x8e\xb6\xac\xb1\xbc\x9f\x0d\x11\x30\xdd\x63\x2c\xd8\x78\xcd\x05\x71\x95\x25\x59\x7f\xa8\x41\x40\xd5\x18\x5a\xef\x33\x31\x15\x11\x77\x6b\x94\x12\x25\x7b\xd6\x18\x2e\x82\x0d\x6a\xb4\xbc\xfc\x50\x3b\xe5\x14\x76\xcb\x31\x42\x3e\x39\x90\x5f\xd1\xb6\x8d\x42\x9a\xb6\x7f\xf7\x68\x0f\x4b\x4e\x2f\xa0\xe4\xce\xb3\x24\x01\x24\x02\xaf\xf8\x1b\x69\x7d\xfd\xfa\x95\xca\x49\x9e\xd8\x0a\x9e\x54\xd3\xc0\x1a\x01\x07\xac\x7a\x87\x92\x81\xb3\xb5\xa6\xdf\xf8\x9d\x27\x03\x84\xb6\xaa\xda\x8e\x3b\x99\x5f\x15\x57\x52\x7d\x34\x0e\xfd\xd0\x8f\xd8\x53\x1d\x68\xe3\x60\x63\xac\xe9\x1d\xbd\x37\x3a\x51\x63\xd8\xde\xa3\xd2\xb4\xc3\xf9\xf6\xe9\x56\x84\xce\x09\xeb\xaf\x3c\x2b\x2e\xd4\xd6\xec\x8a\x34\x91\x76\xff\x0c\xb8\xde\xc7\x10\x77\x3a\x3f\xa8\x9a\x03\x61\xf1\x7c\xa9\xb8\x61\x86\xa1\xd4\x4f\x8c\xc6\xa7\xd5\x10\xb2\xa4\xc2\x6a\x7c\x7a\xf6\x48\x09\xa0\xf4\x2c\xc1\xea\x59\xe5\x06\x08\xaf\xa2\xe2\xc1\xff\xbf\x84\xcb\xb8\x72\x98\x16\xd1\xd2\xaf\xad\xdc\xd3\x1b\x7f\xd1\x1c\x4b\xbb\x2f\xbc\xc3\x3c\x4d\x54\xcd\xc7\x7f\xba\x07\xad\x1a\x26\xbb\xc0\x56\x5a\x35\xcb\xb8\x52\xe2\xd9\x8b\xe8\xf9\xe8\x06\x62\x3e\x0e\xe0\x8e\xfe\x39\x2d\xc9\x20\xe4\xb7\x1a\x46\x8e\x7e\x5e\x4f\xe2\x88\x16\x2d\x31\x5f\x8c\xd7\x19\x10\x7b\xa3\x64\x9c\x29\x63\xa7\x91\xe2\x29\x25\x97\xd4\x87\xeb\x43\x55\xc0\xe7\xad\xe9\x1b\x49\xe8\x22\x75\x94\x60\x74\x73\xa0\x07\xdd\x75\xfd\x19\xf5\x4e\x41\x50\x3d\xce\x8b\x9b\x43\x36\x35\x6e\xaa\x24\x8c\x64\xce\x19\x83\xcf\xf8\xad\xd7\x3c\x4b\x3b\x58\xc7\x69\xfb\x5d\xac\x5d\x8b\x2e\xb8\xcf\x72\x82\x30\x61\x6c\x16\x46\x41\xed\x9c\x14\xe2\xa6\x34\x1d\xfa\xd7\x2f\x31\x12\xd3\x42\x74\x3d\xf3\xcb\x6a\xd3\xeb\x06\xa6\xd6\x47\x3f\xbe\x25\x93\x23\xff\xfd\x53\x86\x63\x6e\xfc\x72\xce\x6e\xdf\x57\x43\xe1\xfd\x7c\xbf\x46\x6d\x17\x74\x76\x19\x25\x2b\xfe\x2a\xcc\x11\x2f\x63\xa0\x23\x5b\xfe\xdf\xa1\x46\x5f\xe7\xc1\xfe\x9c\x7d\x2f\xc2\x8d\x0e\x7e\x15\xf0\xbb\x01\xab\xb8\x82\x86\x82\xbe\xae\x37\x9e\x24\xd7\x27\xdf\xd3\xaa\x87\xc3\x12\x84\xdd\x74\x4b\xd8\xfb\x2c\xe9\x8f\xa3\xe3\x69\xf6\x66\x9d\xb0\x12\x2e\x23\x97\xcb\xc8\xe7\xc1\x36\x0f\xc3\xcb\xfc\x3d\xc5\xc6\x9f\xd7\x83\x63\xd1\x1f\x1c\xdd\xe8\xf3\x6a\x78\x7b\x61\xe1\xdb\xf3\x17\xc4\xfd\xbc\xfa\x99\x56\x4d\xce\x7f\x64\x86\x77\xe3\x7f\x03\x00\x00\xff\xff\x9d\x65\x7e\xf5\x46\x0f\x00\x00")
+var _templateTxTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\xdf\x6f\xdb\x46\xf2\x7f\x26\xff\x8a\xa9\x60\x04\x64\xa0\x50\xfd\xf6\xed\xeb\x83\x1e\x72\x4e\x8a\x0b\xd0\xda\x77\x17\xe5\xae\x40\x50\xa4\x2b\x72\x28\x2d\x4c\xed\xb2\xbb\x4b\x9b\xaa\xa0\xff\xfd\x30\xfb\x8b\xa4\x4c\xa7\x6e\x71\xc0\xf5\xa1\xb1\xf6\xc7\xec\xcc\xec\xec\x67\x3e\x33\x3c\x9d\x56\xaf\xd3\x1b\xd9\x1e\x15\xdf\xed\x0d\x7c\xf7\xed\xff\xfd\xff\x9b\x56\xa1\x46\x61\xe0\x7b\x56\xe2\x56\xca\x7b\xf8\x20\xca\x02\xde\x36\x0d\xd8\x45\x1a\x68\x5e\x3d\x60\x55\xa4\x9b\x3d\xd7\xa0\x65\xa7\x4a\x84\x52\x56\x08\x5c\x43\xc3\x4b\x14\x1a\x2b\xe8\x44\x85\x0a\xcc\x1e\xe1\x6d\xcb\xca\x3d\xc2\x77\xc5\xb7\x61\x16\x6a\xd9\x89\x2a\xe5\xc2\xce\xff\xf0\xe1\xe6\xfd\xed\xc7\xf7\x50\xf3\x06\xc1\x8f\x29\x29\x0d\x54\x5c\x61\x69\xa4\x3a\x82\xac\xc1\x8c\x0e\x33\x0a\xb1\x48\x5f\xaf\xce\xe7\x34\x3d\x9d\xa0\xc2\x9a\x0b\x84\x85\xe9\x17\x70\x3e\xd3\xc8\x55\x7b\xbf\x83\xeb\x35\x6c\x99\x46\xb8\x2a\x6e\xa4\xa8\xf9\xae\xf8\x3b\x2b\xef\xd9\x0e\xc1\x6f\x33\x78\x68\x1b\x66\x10\x16\x7b\x64\x15\xaa\x05\x5c\xd9\x29\x7e\x68\xa5\x32\x90\xa5\xc9\xa2\x94\xc2\x60\x6f\x16\x69\xb2\x40\xa5\xa4\xd2\xf4\x97\x96\xca\x8e\xe8\xa3\x28\xe9\x5f\xc3\x0f\xb8\x48\xd3\x64\xb1\xe3\x66\xdf\x6d\x8b\x52\x1e\x56\xb5\xf7\x1e\x17\x65\xb7\x65\x46\xaa\x15\x0a\xb3\xaa\x38\x6b\xb0\x34\x8b\x34\x4f\xd3\xd5\x0a\x36\x3d\x79\x8c\x81\x51\x4c\x68\x56\x1a\x2e\x05\x6b\xa0\x6c\x38\xf9\xdf\xec\x99\xa1\xe9\x52\x21\x33\x58\xc1\xf6\x08\x25\x6b\x1a\x2e\x76\x70\x63\x57\x14\x9b\x3e\xcb\x8b\xd4\x1c\x5b\x24\x49\xda\xa8\xae\x34\x70\x4a\x93\xd2\x5a\x9b\x26\xa7\x13\x28\x26\x76\x08\x57\x5f\x96\x70\x25\xc8\x1f\x57\xc5\xad\xac\x50\xc3\x9b\xf3\x39\x4d\x92\xd5\x0a\xc8\x57\xa2\xb8\x65\x07\xf2\x0a\x1d\x47\x17\xe2\x35\xa8\xa5\x02\x2e\x0c\x2a\x52\x4d\xec\xe0\x91\x9b\xbd\xbd\x9c\xe9\xa6\x6d\xc7\x9b\x0a\x95\x2e\xd2\x24\x99\xce\xbc\x9e\xfc\x74\x5a\x5b\xb5\x50\x54\xd6\xd3\xa4\x41\xc3\x7e\xe3\xcd\x11\x1a\xc9\x2a\x8a\xa9\xc4\x1f\x0e\x00\xf0\x3a\x6c\x71\x63\x77\xa2\x44\x20\xa7\x17\xf4\x97\xdb\x5d\xca\x43\xdb\x20\x79\xce\x7a\x67\xcb\xca\x7b\x52\xe4\xd0\x41\xf8\xcf\x6e\xf8\xb1\x33\xd8\xa7\x89\x14\x37\xf2\x70\xe0\x06\x00\x3e\xff\x5c\x77\xa2\xcc\xec\xad\xe6\x34\xf3\x4f\xe9\xb6\x5f\xcc\xd8\x50\x79\x13\x1c\x49\x7b\xc8\x8f\x0d\xd7\x06\x16\x4e\xd8\x02\x16\x61\xaf\x0d\xbf\xe4\x74\x7a\x03\x57\x52\x7c\xdf\x89\x52\xd3\xe2\x56\x71\x61\x60\x21\xc5\xc2\x0b\xa0\x45\xde\xf7\xfe\x37\xfd\xdd\xc8\x47\x54\x71\xc4\xdd\xc4\x28\x32\x0a\xf2\xdc\x1b\xe0\x35\xe0\xaf\x7e\x55\x54\xe0\x7c\x06\x3a\x8d\xdc\x40\xfb\x98\x81\x47\x54\x08\x0a\x77\x5c\x1b\x54\x58\xd9\xf3\xb6\x47\x2b\xf3\xd0\x19\xe6\x56\xca\xfa\xf2\x10\xc8\x34\x22\x50\x70\xbd\xad\x0d\x2a\x27\x3f\x07\xa6\xd0\xba\x17\x2b\x60\x34\x0e\x0c\x74\x57\x96\xa8\x75\xdd\x35\x50\xda\x55\x5e\x3f\x7f\xb5\x74\xde\x5b\x68\x99\xe0\x25\x3d\x69\x29\x30\x9c\x36\x28\x05\x75\xd4\x99\x13\xac\x94\xf2\x81\x86\x97\xc0\x44\x05\x95\x44\x0d\x42\x1a\x60\x75\x8d\xa5\x09\x71\x37\x75\x52\x91\x26\x24\x03\x32\xd3\xc3\xeb\x4d\x9f\x8f\x5d\x9a\xe5\x60\xaf\x90\x5e\x44\x52\xa9\x07\xba\x09\xd3\x17\xee\x71\x14\x95\xe2\x0f\xa8\x8a\xec\xb5\xe9\xdf\xd9\x3f\xf3\x34\x49\x50\x29\x5a\x55\xa9\x87\xc2\xf4\xc5\x44\x96\x93\x51\x94\x8d\xd4\x18\x7f\xe1\x81\x9b\x6c\xd3\x8f\x16\x2e\xe9\x4c\x9a\x36\x7d\x71\xe8\x8a\x1f\x64\x79\x6f\x57\xd7\x42\xfb\xf3\x4f\xa7\x21\x36\xce\xe7\xb8\xf2\x93\x68\xe2\x5a\xa9\xe0\xcb\x12\x6a\xda\xe0\xc2\x8e\x76\x93\x15\x89\x66\x35\xde\xb0\xa6\xc9\xe8\xb8\x2c\x87\x13\xd4\x14\xc1\x39\x9c\x69\x23\x49\xfb\x4a\x88\x90\x00\x9a\x52\x0a\xd6\x6b\x10\xbc\xb1\x9e\xf1\x76\xd9\x55\x06\x2b\xab\x6d\x94\x14\x2e\x33\x51\x68\x3a\x25\x68\x6f\x9a\xf8\x77\x7b\x27\x46\x76\x03\xab\x2a\x42\xb2\x70\xa3\x60\xa4\x0d\x18\x90\xe2\x05\xb7\x36\x11\x95\xd5\x30\x7a\x7f\x39\x9c\x9e\x7a\xb3\xc2\x9a\x52\xcb\xa5\xe3\x9e\x78\x17\xd6\xc0\xda\x16\x45\x95\x3d\x99\x5a\x42\x9d\x93\x29\x23\x2b\x1d\x22\xdf\xb5\x01\x05\x1b\x5e\x63\x79\x2c\x1b\x84\x2d\x65\x2b\xe6\xd2\xd0\x04\xad\x23\x48\x2b\xa4\x94\x81\x15\xc5\x3a\x83\x4d\xff\xfe\x81\xde\x50\xc0\xe6\xbb\x16\xb4\x51\x5c\xec\x1c\xea\x8f\xf6\x3f\x39\x83\xa3\x2e\xd2\x52\x0a\x6d\xf3\xcf\xa6\xff\x2b\xee\xb8\x20\x1c\xb3\x52\xd6\xb0\xd8\xd2\xc0\x22\x4d\x36\x7d\x84\xb2\x30\xe5\xde\xa1\x9d\x8b\x60\x16\xe6\x54\x40\xa8\x98\x7a\xac\x8a\x50\xa1\x2e\x15\xdf\x22\x5d\xde\xa0\x0c\xda\xb9\x4b\x6b\x0b\xf8\x60\x8d\x6d\x99\xd6\x58\x91\x29\x46\xda\x77\x39\xdc\x3a\xb9\xe3\x91\x69\xd0\x68\x02\xdc\x6c\xfa\xbb\xad\x25\x0c\x0a\x64\x4b\xab\xa2\x5b\x9c\x06\x43\xde\xa2\xa0\xfa\x1d\xef\x3f\xc5\xc4\xbb\xd6\xda\x68\x77\xbf\xeb\x94\x45\xb6\x20\x83\x12\xb3\xd3\xc9\xa9\x0c\x9a\x53\x0a\xb9\xc4\xbc\x2d\xee\x18\x89\x5a\xad\xbc\x81\xac\x79\x64\x47\x0d\xbf\xa1\x92\x36\x09\x86\x6b\xb0\x6e\xa1\xf4\x12\x0f\xa2\x13\x8a\xf0\xcb\x4a\xf8\x81\x6d\xb1\xd1\xb0\x97\x4d\xe5\x0d\x71\x03\x5e\x79\x14\x86\x1b\x8e\x63\x94\xb6\x78\xec\x42\xc7\xec\xd1\x0a\x19\x6b\xd7\x09\xc3\x1b\xb7\x97\x8e\x5f\x82\x1e\x22\x4d\x97\x28\x2a\x62\x04\x52\x55\xa8\x8a\x34\xf1\xa7\x7f\xfe\xd9\xc7\x1b\x09\x7b\xaf\x54\x70\x88\x7d\x53\xc3\x2d\xb9\x57\xed\xb8\x05\x4d\xbb\x00\x02\xa9\x3c\x05\x73\x21\x53\xa4\x09\x89\xb0\x7b\xd3\xb3\x8d\x1e\x97\x97\xfd\x7e\x0a\x1d\x3f\x60\x25\x6f\xb9\xa8\xb4\x05\x80\x4e\x29\xba\xe1\x91\x35\x45\x3a\x7d\xf9\x6e\x5f\x96\x87\x54\x4f\x61\x40\x20\x1d\xf3\x7d\xf1\x4e\x46\xb0\x73\x40\xe0\xf9\xc1\x1a\x5e\xb9\x2d\x27\x87\xe8\xd7\x03\xb8\x7b\x54\x75\x0b\x0b\x2e\xb8\x21\x54\x23\x88\xf4\x30\x16\x27\xc9\x9c\xa9\x42\x6e\x35\x9c\x7e\x97\x3d\xd1\x9b\x0c\x88\x33\x30\x9e\x35\xdc\xe2\xe3\x0c\xeb\xc9\xa2\x72\x79\x24\x40\xc4\xc1\x2c\xbb\x58\xbd\x86\x9a\x2b\x6d\x40\x10\x87\xa6\x80\xab\x64\x09\xd8\x33\xa2\x36\x60\x59\x2e\xc1\xd4\x95\x5b\x74\xbd\x06\x2e\x2a\xec\xa3\x36\xdf\x06\xf0\x0a\xa9\x0c\x1e\x15\x6b\xdd\x85\xef\xf8\x03\x0a\xf0\xb4\xb3\xd8\xf4\x8e\xc3\x31\x10\xb2\x8d\xa3\x7e\x13\xa7\xd3\x0e\x28\x1c\x39\x28\x48\xe0\x66\x8f\xc0\x2b\x64\x36\x7c\x24\xe8\xae\x25\x94\x1b\xdf\xa7\xb6\x02\x65\x67\x28\x01\x50\x20\x32\x71\x04\xec\x8d\x62\xae\x20\xf0\x00\x31\x50\xc4\xd5\x0a\xfe\xbd\x47\x02\x49\x3f\x66\xd3\x84\x15\xef\x53\x32\xb1\xda\x25\x70\x03\x3b\x34\xce\x08\x4d\x74\x72\x64\x03\x17\xda\x30\x8a\x0d\xd2\xd1\xa3\x20\x51\x86\x08\x7a\x44\x57\xc8\xc2\xda\x47\xb2\x25\xb1\x44\xad\x83\x1e\x96\x61\xd0\x4c\xa7\x51\xc1\xa1\xd3\x26\x64\x2b\x24\x99\xee\xad\x1e\xe8\x89\xd9\x87\x45\xda\x0d\x6f\x23\xbc\x8b\x4b\x1c\x21\xe3\x68\xf7\x07\x82\x4d\x4b\x14\xaa\xf1\x34\x39\x11\x0f\x5b\xac\x2a\xac\x2e\x28\xd1\x0e\x05\x2a\x4b\xf2\x03\x3c\x2c\xa3\x86\x76\xe4\x48\x72\x59\xdb\x36\x84\x1c\x0c\x7e\xed\x50\x1d\x97\x16\x9a\x7c\x94\x5c\x53\x96\x75\x01\x12\x02\xaf\xf8\x07\xad\xfa\xe9\xa7\x9f\xc8\x9d\x24\xc9\xee\x82\x47\xde\x34\xb0\x45\xc0\x1e\xcb\xce\x78\x18\xdf\x2b\xd9\xed\x1c\xb7\xaf\x7c\x08\xed\x79\xb9\x8f\xb5\x87\xad\xbd\x66\x4c\xbd\x95\xc6\x03\x6c\x8c\x3d\xee\x58\xdb\x4e\x2a\xd9\x19\xaa\xca\x88\xb3\x78\xc8\x8f\x8b\x26\x98\x3f\x3e\x15\x41\x1b\x66\xe1\xed\x12\xa4\x6b\x25\x0f\x45\x4a\x64\xe5\x22\x70\x9d\x8c\x3e\x40\x9c\x2d\x3b\x9b\x23\xc5\xe2\x44\xe1\xc4\xf4\xa3\x18\xb2\x9b\x42\xda\xd2\x91\xad\x38\x72\x7b\x79\x34\x41\x65\x24\x48\xd3\xe2\x62\x52\x5d\xb0\x81\x32\x87\x22\x22\xb7\x07\xc9\x98\x00\x9f\x12\xee\x21\xcf\x65\xbc\xa6\xbc\xe9\x6f\xcb\x57\x9e\x31\x0e\x28\xd3\x44\x77\x8d\x05\xd8\xec\x35\xc4\xcb\x4b\xd3\x4d\x91\x26\x51\x2d\x72\x44\xe6\x0f\xa4\x5a\x99\x4a\xde\x65\x48\xd0\x79\x9a\x94\xa6\xb7\xc6\xc2\xc5\x9a\x34\xb1\xb7\x45\x33\xa4\x5e\xb1\xb1\x3a\x7a\x0d\x00\x0e\xac\xfd\xec\xf2\x10\xa5\xa3\xae\x34\x27\x57\xf7\xf8\xb7\xe1\x38\x93\x06\xfe\xd4\x29\x13\x8f\x87\x37\x47\x79\xca\xa5\x23\x2f\x60\x2b\x65\x63\x05\x6a\xf6\x80\xad\xe4\xc2\xd0\xae\x4e\x44\xd8\x88\xa3\x83\xf5\x21\xa2\xb9\xb8\x3c\xb4\x48\x93\xd1\x0e\x2e\x8c\xcf\x75\xef\x95\xda\xf4\x37\xee\x44\x3e\xcd\x99\x11\x4e\x32\x26\x86\xb7\x9b\xbb\x17\xe1\x1f\x18\x05\xb4\xb1\xb8\xaa\x87\xf0\xe2\x2a\x5d\xad\x5e\x6e\x31\x7c\xd2\xf4\xcc\x90\x2b\xf8\x24\x08\xdc\xe1\x80\x66\x2f\xab\x09\x40\xf9\xf3\xac\x60\x02\xaf\x99\x47\x6d\xf5\x7a\xe6\x81\x15\xe9\x03\x53\x13\x63\xd7\x44\xf7\xa5\xd2\xc5\x2d\x3e\x66\x8b\xd0\x54\x39\x9f\xaf\x23\x74\x5b\xce\x4a\x67\xcf\xe1\xdd\xc2\x11\x4d\x81\x8f\x9b\xde\xbb\x9d\xb0\x4b\xe0\xe3\x78\x15\x6b\xbc\x72\x9e\x19\xd8\xe5\x19\x05\xdc\x93\x78\x7c\xfa\xf4\x73\x18\xea\x37\x5b\x79\x49\x65\x69\x82\xe9\x97\x30\xaa\xe5\x9c\xc0\x3c\x0d\xd5\xcf\x37\x43\xf5\xe3\x09\x81\xe0\xcd\x32\x14\x37\x61\xec\x55\x90\x7c\x32\x3d\x91\x8b\x25\xc9\xba\xa6\xff\x9d\x97\xb4\xdf\x87\xc7\xa6\x8f\x34\xe8\xc2\xa3\x36\x0d\xb7\xa8\x20\x8b\x35\xa6\xf5\xd5\x83\xe4\x55\x48\x5b\x52\x0d\x59\x8b\x32\x90\x26\x97\x11\xd4\xcd\xe7\xad\x02\x3e\xee\x65\xd7\x54\xb0\x8d\xf5\xb8\x14\xcd\x31\xf0\xb7\xa7\xeb\x47\xec\x66\x50\x82\xfc\x31\x75\x6e\x0e\xd9\x80\x8d\x83\x27\xbd\x65\xd6\x78\xf2\x98\xb3\xf8\x9d\x5b\x39\x31\xdb\xef\x0e\xb0\xf3\x52\x38\x9f\xd3\xce\x8b\xcf\x72\x5f\x33\x8d\xd5\x28\xa8\x56\x1d\x16\x04\x32\x2a\xb5\x6d\x3d\x3a\x5a\x63\x23\x31\x88\x1e\xc9\xb5\xcb\x86\xa6\x40\x10\x3a\xd8\xe5\xaf\x64\x10\xe4\x7e\x3f\x4b\x22\x28\xdf\xc3\xa7\x29\x81\xf8\x65\xd3\x17\x4e\xce\x2f\x73\xec\xe1\xc2\x0b\x73\x5a\xda\x85\x5f\x53\x33\xc6\x4b\x54\x34\x12\x92\x3f\xac\x6a\x90\x35\x55\xf6\x79\x82\xf3\x44\xdd\x20\xe0\x6b\x0a\x87\xa6\x1b\x85\xc1\x73\x6d\x82\x17\x25\xde\xb9\x68\x19\x09\x0f\x8d\x83\xdc\x63\xc0\xb8\x65\x30\xdf\x31\x30\x7d\x31\xce\xd9\xe3\x6e\xc1\x68\xdc\xb6\x0a\x9c\x29\x03\x42\x7b\xd6\x3a\x2a\x7e\xe7\x1b\x6f\xb0\x3d\x7a\x44\x1e\xf5\xdd\xfe\xb4\xb5\x71\x3a\x9b\xb1\x71\xe8\x31\x8d\x94\x9f\x31\x92\xb0\x2b\xb9\x74\xc5\xf3\x4d\xa7\xa1\xe7\x44\x45\x94\x47\xbd\x72\x8f\xe5\xfd\x08\xfa\x26\x49\xd2\x93\x5a\x57\x7a\x44\x56\x38\xd3\x38\x79\x64\x7a\xea\xd5\xcb\xbc\xe7\xca\x71\x8f\x74\x1e\xe4\x42\x4c\xc3\x16\x6b\xa9\x02\x91\xe5\x62\xe7\x5d\xe6\x55\xcb\xe6\xd2\x85\x8f\x51\x9b\x09\x08\xd4\xe4\x7d\xc8\x11\xa3\xa0\xfe\x0b\xc8\x7b\x78\xf5\x8a\x1c\xc9\xb5\x45\x8d\xca\x57\x9f\x3e\xb4\x47\xc6\x8e\x13\xc6\x90\x13\x2c\x04\xc1\x81\xa9\xfb\xa7\x49\x81\x98\x0d\x4d\x57\x4b\x1f\x06\xdc\x7c\x3d\xf9\xcf\x07\x82\x07\xb3\x97\x07\xba\x4f\xd0\x6b\x30\xaa\x43\xaf\x67\x30\xef\xcf\x53\xb1\x39\xdd\x46\x4e\x23\x86\xf6\xd2\xc7\xe8\xbd\x18\x55\xf5\x3a\x7a\x92\x4a\xcc\xd8\x39\x33\xb2\x56\xf9\x54\x59\x26\x46\xb6\x18\x3d\x6d\xdc\xcc\x6b\xeb\xc5\xcd\x53\x8e\x17\x32\xe4\xdc\x87\x54\x5c\x3e\xea\xaf\x3a\xb3\x6c\x9c\x98\xbe\x08\x2b\x96\x74\x45\x25\x85\xa0\xe9\x0b\x9b\x23\x61\x1d\xb7\x2f\xc1\xcd\x10\xa5\xbe\x95\x8f\xfe\x02\x7d\xb3\xd9\x76\xa2\x2c\x01\x09\xa0\x44\x13\xd1\xea\xe1\xe5\x0d\x85\x85\x6d\x5b\x85\x52\x3c\x1c\x32\xe7\xbe\x50\x81\xcc\x7b\x8a\xce\xc9\xa4\x6b\xb7\x59\x8e\x30\x62\x5c\x14\x38\x83\x75\xcf\xd8\x8f\xf4\xd8\xbc\xcf\x4e\x77\xed\x35\xc8\x76\x49\x8c\xf3\x9a\x04\x9d\xed\xa3\x94\x2d\x7c\xb3\x8e\xd7\x46\xfb\x87\xf6\x1a\xac\x9d\x4b\x3e\x52\x13\x2f\x0b\x7e\xcb\x83\x6b\x47\x01\x46\x60\xd6\x0c\x48\x66\xfa\xc2\xd7\x24\x4e\xa0\x6f\x92\x45\xb8\x0f\x23\x4b\x68\xc6\xd2\x86\xd0\xa4\x8e\x5b\xf1\xd1\xb2\x11\x1d\x57\xe7\xe9\x4c\x3b\x7e\xe4\x84\x2c\xdc\x30\xda\xf6\xbc\xbb\x2b\xfa\xc8\xa1\xaa\x08\x9b\x0c\xf6\xf4\x85\xd5\x82\xa1\x9b\x9a\xdc\x20\xe9\x19\xda\x82\x17\xf5\x42\xf8\x0c\x13\x92\x8a\x7d\xa7\xae\x43\x50\x8d\xbe\xcd\x34\xc7\xf9\xbb\x74\x87\x65\xd6\x2d\x9e\x67\xe5\xf0\x37\xd2\xe5\x14\x5f\x22\xed\xcb\x04\xd5\xa0\x3f\xd2\x21\x74\xd3\xfe\x8f\xd1\xc5\xba\x21\xfc\x9e\xd6\xda\x0d\xb3\xef\xe8\xe0\x96\x71\x29\x72\xc8\xfe\xc5\x9a\x0e\xc7\x74\x3d\x49\x1e\x22\x5f\xa7\xf3\x0a\xbb\xd8\x3e\xc9\x25\x1c\xf2\xe7\xbe\x5b\x4c\xef\xdc\x2d\x1a\xae\x7a\xb2\x34\x19\x8d\xc3\x81\xdd\x63\x36\x53\x9b\xda\x83\xe8\xfa\xc7\xeb\x3f\xdb\x7f\x7e\x86\xb5\xef\x53\x9f\xce\xa7\xb8\x62\x12\x23\x7e\xa7\xf7\xca\x83\x2f\x27\x92\xf3\x28\x69\x86\x70\x19\x91\x07\x77\xd1\xe1\xcb\xe5\xcc\x9b\x74\x85\xbd\xff\x36\xa6\x2d\x6d\x26\x51\xdc\x68\xff\x71\x8d\xde\x2c\x13\xc7\x7c\x39\xa1\x70\xd4\x82\xd9\x2a\x64\xf7\x5f\xed\xeb\xd2\x85\x45\xad\xa6\xf4\xc9\x61\x74\x8c\xeb\x2f\xb0\x0e\x4a\x64\x39\xd8\xef\x61\x75\x16\x82\xfa\x7d\x8f\x65\xb0\xa9\x2f\xe8\xd7\x92\xce\xaa\x19\x6f\x5c\x7f\x70\x52\x56\x3e\x97\x63\x2c\xe8\xcf\x47\x2b\x89\x9c\x07\x68\xd7\xd3\x72\x17\xb9\x04\xa6\x76\x7a\x09\x0f\xae\x6e\xa2\x2f\xf0\xa7\xf3\x65\xce\xff\x63\x29\xdd\xf4\x85\xb7\x88\x8e\xf7\xc7\xc5\x73\x82\xfd\xb6\xd1\x36\x38\xc0\xfe\xfc\x2f\x7b\xc0\xca\xfc\x1f\xba\x20\x9e\x3f\xeb\x03\xea\x1c\x7c\xb9\x20\x5b\xb0\x1e\x17\x0a\x99\xcd\x57\xe9\xe9\x04\x28\x2a\x38\x9f\xd3\xff\x0c\x00\x52\xf2\x1c\x4c\x7a\x22\x00\x00")
 
 func templateTxTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1101,12 +1164,12 @@ func templateTxTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/tx.tmpl", size: 3910, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/tx.tmpl", size: 8826, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x4d\x6f\xe3\x36\x13\x3e\x4b\xbf\x62\x20\x28\x78\xed\x60\x43\xed\xbb\xb7\x16\xc8\xc1\xd8\x64\xb1\x2e\x0a\xbb\x6d\x16\xed\x21\x08\x0a\x46\x1c\x59\x44\x64\x52\x4b\xd2\xce\x06\x82\xff\x7b\x31\xa4\x2c\x39\xfe\x48\x9c\xae\x51\xec\xcd\x26\x87\xf3\xf1\x3c\xcf\x0c\xc5\xa6\xc9\xce\xe3\x8f\xba\x7e\x32\x72\x56\x3a\xf8\xf0\xfe\xff\x3f\x5d\xd4\x06\x2d\x2a\x07\x9f\x78\x8e\xf7\x5a\x3f\xc0\x58\xe5\x0c\x46\x55\x05\xde\xc8\x02\xed\x9b\x25\x0a\x16\x7f\x29\xa5\x05\xab\x17\x26\x47\xc8\xb5\x40\x90\x16\x2a\x99\xa3\xb2\x28\x60\xa1\x04\x1a\x70\x25\xc2\xa8\xe6\x79\x89\xf0\x81\xbd\x5f\xef\x42\xa1\x17\x4a\xc4\x52\xf9\xfd\x5f\xc7\x1f\xaf\x27\x37\xd7\x50\xc8\x0a\xa1\x5d\x33\x5a\x3b\x10\xd2\x60\xee\xb4\x79\x02\x5d\x80\xdb\x08\xe6\x0c\x22\x8b\xcf\xb3\xd5\x2a\x8e\x9b\x06\x04\x16\x52\x21\x24\x8f\x25\x1a\x4c\x20\xac\x5e\xc0\xa3\x74\x25\xe0\x37\x87\x4a\x40\x0a\xc9\x6f\x3c\x7f\xe0\x33\x4c\x20\x65\xed\x4f\xb8\x58\xad\xe2\xa8\x69\xc0\xe1\xbc\xae\xb8\x43\x48\x4a\xe4\x02\x4d\x02\x8c\xbc\x34\x0d\xd0\xd9\x36\x4a\x6f\x24\xe7\xb5\x36\x2e\x81\xd4\x6f\x65\x19\x8c\xaf\x28\x79\x87\xc6\xc2\x12\x8d\x93\x39\x5a\xb8\xe7\x84\x82\xf6\xe5\x48\x03\x52\xa0\x72\xb2\x90\x68\x58\x5c\x2c\x54\x0e\xe3\xab\x81\x14\xd0\x34\x90\xb2\xf1\x15\xfb\xf2\x54\x23\xac\x56\x43\xa8\x0d\x0a\x99\x73\x87\xcc\x6f\x4d\xf8\x9c\xd6\xa1\x89\x23\x83\x6e\x61\xd4\x01\x83\x41\x1c\x45\x54\x73\xea\xe6\x75\x05\x3f\x5f\x42\x6d\xa4\x72\x05\x24\x42\xf2\x0a\x73\x97\x9d\xd9\xac\x3b\x99\x49\x41\x28\xdc\x38\x6d\x08\x05\x02\xc1\x1f\xfe\xd6\x95\x18\xdc\xa4\x01\xa0\x61\x1c\x00\x30\x5c\xcd\x10\xd2\xbf\xdf\x41\xaa\x6b\x8a\xa1\x6b\xeb\xb3\x87\x16\xc6\x94\x9b\x19\xad\x27\xe4\x7f\xb5\x6a\x1a\x90\x05\xd9\xb2\x3f\xb9\x91\x5c\xc8\x3c\x2c\x7a\x33\x6f\x65\x5b\xb3\x16\x65\xef\xc3\x83\xb3\x51\xc0\xf8\xea\xcc\x26\xde\x4b\x5b\x6a\x1c\x65\x19\x74\x96\xab\x15\xf0\xba\xae\x24\x5a\xaf\x1b\x5a\xef\x4d\x7b\xb0\x5a\x22\x02\x53\x58\x09\x16\x47\xfe\xf8\x86\x9f\xc1\x3a\x35\x82\x7b\x5f\xea\x8c\xb1\x2e\xd7\x37\xf0\xf6\x3a\x71\xd1\x1e\xb5\x8e\xcc\x2c\x09\xe9\x24\xd3\xda\xd7\x0f\x49\x4b\xd8\x26\x77\x9e\x20\xef\xe1\x68\xea\x33\x5d\xdb\x1d\xfa\xf7\x0b\x80\xb5\x9b\xb4\x47\x79\x85\x68\xc3\x38\xda\xee\x8d\x0d\x69\x14\x94\x42\xca\x3e\x11\xca\xb6\x65\x35\x3b\x87\x5f\x6e\xa6\x13\xc8\xb9\x52\xda\xc1\x3d\x8d\x8b\x79\xcd\x0d\x8d\x09\x2b\xd5\x0c\x92\xcb\x04\xb8\x12\x70\xad\x16\x73\x28\xb9\x05\x0e\x8e\x90\x0d\x9d\x2d\x02\x38\xc4\x9f\x27\x0f\x14\x61\xe7\xdb\xdf\xa7\x26\x0b\x20\xb7\x03\x6d\x20\x2d\xd8\xd8\xfa\x58\xfe\x17\xf9\x1b\xae\x05\xde\x6b\x2b\x2d\xd8\x8d\x33\x8b\xdc\xf9\x2c\xc3\xfe\x01\x51\xe1\xd7\x05\xaf\xa4\x7b\x82\xbc\xc4\xfc\x61\x57\x50\x4d\x03\x5f\x17\x9a\x10\x2b\x3a\xd2\x83\xc2\x60\xec\xfe\x67\xdb\xbe\xcf\x79\x05\x4e\x6f\x06\xb8\xfe\x9d\xc5\xd1\xae\x06\x97\xe1\xdf\x51\xba\x3a\x42\x58\xfb\x94\xe5\x6b\x4e\x88\xa8\xb5\x78\x8e\x57\x4f\xd1\x9e\xdd\x16\xcf\x8b\xea\xd9\x92\x0f\xe9\x27\x6a\x99\x6b\x25\xf4\x26\x31\x51\x2f\xd8\x6e\xfc\x14\xeb\x55\x5f\x65\x97\x18\x9b\xd6\xb6\xe7\x9d\x2c\x2f\x89\x52\x54\xc2\x86\xbf\x83\x9c\x57\xd5\x96\x7d\x5a\x0c\xd7\xde\x36\x26\xd2\xce\xd8\xf3\xe7\xb7\x47\xde\xf2\x98\x89\xb7\x7c\x75\xe0\x6d\x4b\xf3\xd9\xdc\xf3\x34\x91\x30\x82\x84\x49\x23\x64\x4c\x0d\xd4\xc5\x5e\xab\xbe\x0d\xec\xcd\x2f\xc1\x19\x39\x5f\x5f\x7a\x61\xad\xbf\x04\x9f\x25\xf4\x1d\xa3\xf5\x70\x27\xec\x9f\xb5\x6d\xd7\x7a\x9f\xb2\xda\x02\xeb\xd8\x19\xec\x42\x9f\x74\x6b\x2f\x36\x4c\x3b\x2b\xb6\x5c\x92\x24\x97\x04\xe9\x9c\x3f\xe0\xe0\xf6\x4e\x2a\x87\xa6\xe0\x39\x36\xab\x77\x50\xa1\xda\xb8\x17\x86\x24\xdd\xa8\xd0\x06\x24\x1d\x08\xca\x58\x86\x66\x8c\x96\xb7\xf2\x0e\x2e\xa1\xb7\xbe\x95\x77\xb4\xb1\xbe\x5d\xd7\x10\x7f\xf7\x7d\xd0\x37\xf0\x69\xaf\x06\x4f\xd6\x69\x6e\x87\x8d\x16\x3a\xd8\xdb\x41\xc6\xec\x5a\xcc\xd0\x1e\x68\x86\xe4\x33\xa7\x64\x70\x67\x5a\xbf\x20\xd3\xcf\xdc\x92\xcb\x97\xf4\x89\x9d\x2a\x50\xcc\x70\x9f\x3c\x4f\x7f\x9f\x53\x4e\x54\xca\xdb\x69\xa1\x1c\xb3\x92\x9f\x88\x95\x50\x62\x1f\xf2\xcc\xfe\x25\x5d\x99\x74\xa5\x9f\x16\xdb\x80\x02\x87\x99\x5c\xa2\x82\x5c\x2b\x21\x9d\xd4\xca\xc2\x40\xbb\x12\x4d\xef\xc8\x0e\xf7\xd1\x40\xdb\x16\x18\x63\xcf\xb1\xc6\x30\xfa\xda\x40\x3f\x22\x57\x8f\x01\xd3\xd3\x7d\x63\x65\x19\x8c\x94\x80\x99\xd1\x8b\x9a\x1e\x59\xd6\xd1\x9b\xa8\x87\xaf\xff\x42\x1a\x4d\xae\x40\xd7\x68\xb8\xd3\x06\xee\xd1\x3d\x22\x7a\x8e\xe6\xed\xbb\x63\xa4\xc4\x60\xe3\xdc\x0e\xb8\xc7\xc0\xfa\x86\xa7\xc8\x2b\x80\x71\x75\xdc\x53\x84\x6d\x3c\x45\xb2\x0c\xa6\xe6\x18\x28\xa6\x7f\xbc\x88\xc4\xd4\xfc\x40\x40\x68\xf3\x6f\x70\x98\x68\xf7\xac\x41\xe9\x42\xed\x4a\x6e\x7b\x33\xf4\x5e\x9f\x62\x28\x7e\xa2\xdd\xa0\x3e\x90\xf8\x7f\x53\xb1\xd2\xee\xcd\x25\xf7\x1d\xf1\x4f\x00\x00\x00\xff\xff\x47\x00\x3c\x5d\xbb\x10\x00\x00")
+var _templateWhereTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x59\x5f\x6f\x1b\xb9\x11\x7f\xde\xfd\x14\x73\x0b\x05\xd5\x1a\x36\x95\xde\x5b\x7b\x70\x01\x37\x76\x7a\x6a\x0f\xf6\x25\x16\x72\x40\x83\xa0\xa0\x77\x67\x25\xc2\x2b\x72\x43\x52\x72\x54\x41\xdf\xbd\x18\x92\xfb\x47\xd2\x5a\x96\x7d\x39\xd4\x4f\x96\xc9\xe1\x70\xfe\xfe\x66\x38\xbb\x5e\x8f\x4e\xe2\x77\xaa\x5a\x69\x31\x9d\x59\xf8\xf1\xed\x9f\xff\x72\x56\x69\x34\x28\x2d\xbc\xe7\x19\xde\x29\x75\x0f\x63\x99\x31\xb8\x28\x4b\x70\x44\x06\x68\x5f\x2f\x31\x67\xf1\x64\x26\x0c\x18\xb5\xd0\x19\x42\xa6\x72\x04\x61\xa0\x14\x19\x4a\x83\x39\x2c\x64\x8e\x1a\xec\x0c\xe1\xa2\xe2\xd9\x0c\xe1\x47\xf6\xb6\xde\x85\x42\x2d\x64\x1e\x0b\xe9\xf6\x7f\x19\xbf\xbb\xba\xbe\xbd\x82\x42\x94\x08\x61\x4d\x2b\x65\x21\x17\x1a\x33\xab\xf4\x0a\x54\x01\xb6\x73\x99\xd5\x88\x2c\x3e\x19\x6d\x36\x71\xbc\x5e\x43\x8e\x85\x90\x08\xc9\xc3\x0c\x35\x26\xe0\x57\xcf\xe0\x41\xd8\x19\xe0\x37\x8b\x32\x87\x01\x24\xbf\xf2\xec\x9e\x4f\x31\x81\x01\x0b\x3f\xe1\x6c\xb3\x89\xa3\xf5\x1a\x2c\xce\xab\x92\x5b\x84\x64\x86\x3c\x47\x9d\x00\x23\x2e\xeb\x35\xd0\xd9\x70\x4b\x4b\x24\xe6\x95\xd2\x36\x81\x01\x11\xc5\xa3\x11\x8c\x2f\x49\x78\x8b\xda\xc0\x12\xb5\x15\x19\x1a\xb8\xe3\x64\x05\xe5\xd4\x11\x1a\x44\x8e\xd2\x8a\x42\xa0\x66\x71\xb1\x90\x19\x8c\x2f\x87\x22\x87\xf5\x1a\x06\x6c\x7c\xc9\x26\xab\x0a\x61\xb3\x49\xa1\xd2\x98\x8b\x8c\x5b\x64\x6e\xeb\x9a\xcf\x69\x1d\xd6\x71\xa4\xd1\x2e\xb4\x7c\x84\x60\x18\x47\x11\xe9\x3c\xb0\xf3\xaa\x84\xbf\x9e\x43\xa5\x85\xb4\x05\x24\xb9\xe0\x25\x66\x76\xf4\xc6\x8c\x9a\x93\x23\x91\x93\x15\x6e\xad\xd2\x64\x05\x32\x82\x3b\xfc\xad\x51\xd1\xb3\x19\x78\x03\xa5\xb1\x37\x80\xe6\x72\x8a\x30\xf8\xcf\x29\x0c\x54\x45\x77\xa8\xca\x38\xe9\x21\x98\x71\xc0\xf5\x94\xd6\x13\xe2\xbf\xd9\xac\xd7\x20\x0a\xa2\x65\x9f\xb8\x16\x3c\x17\x99\x5f\x74\x64\x8e\xca\x04\xb2\x60\x65\xc7\xc3\x19\xa7\xa3\xc0\xf8\xf2\x8d\x49\x1c\x97\xa0\x6a\x1c\x8d\x46\xd0\x50\x6e\x36\xc0\xab\xaa\x14\x68\xc8\xd0\x6e\xbd\x25\x6d\x8d\x15\x1c\xe1\x3d\x85\x65\xce\xe2\xc8\x5d\xd4\xe1\x33\xac\x45\x23\x73\xf7\x89\xce\x18\x6b\x64\x7d\x86\xdf\x9e\x76\x5c\xd4\x13\xad\x17\x7a\x9a\x78\x71\x92\x9b\xca\xe9\x0f\x49\x70\x58\xd7\x77\xce\x41\x8e\xc3\xd1\xae\x1f\xa9\xca\xec\xb9\xbf\x3f\x00\x58\xd8\xa4\x3d\xd2\xdb\xdf\x96\xc6\xd1\x6e\x6e\x74\x42\xa3\x20\x11\x06\xec\xbd\xc0\x32\x37\xc1\xab\xa3\x13\xf8\xe7\xed\xcd\x35\x64\x5c\x4a\x65\xe1\x8e\xe0\x62\x5e\x71\x4d\x30\x61\x84\x9c\x42\x72\x9e\x00\x97\x39\x5c\xc9\xc5\x1c\x66\xdc\x00\x07\x4b\x19\xe1\x33\x3b\xf7\xc6\x21\xff\x39\xe7\x81\x24\xdb\xb9\xf4\x77\x62\x8b\x02\x88\xed\x50\x69\x18\x14\x6c\x6c\xdc\x5d\xee\x97\xe3\x37\x28\xd8\x4d\xc5\xbf\x2e\x30\xad\x43\x3d\xf8\x9c\x04\x2d\xd8\xad\xd5\x8b\xcc\x3a\x79\xfd\xfe\x23\xe1\x85\x5f\x17\xbc\x14\x76\x05\xd9\x0c\xb3\xfb\xfd\xd0\x5a\xaf\xe1\xeb\x42\x51\xf2\x14\x8d\xfb\x9d\xb8\x0c\xc6\xf6\x4f\x26\x20\x40\xc6\x4b\xb0\xaa\x7b\xc1\xd5\x07\x16\x47\xfb\xd1\xb8\xf4\x34\x47\x45\xd8\x11\x21\xd6\x17\x63\x4e\xe7\x04\x06\x45\x70\xec\x73\xe2\xa8\x08\x67\x77\xc3\xe8\x60\x1c\xed\x04\x52\x94\xc6\x51\x14\x7c\x18\x82\xe9\x59\x61\x45\x59\x61\x1a\x20\x2a\xea\x55\x17\x2c\x8d\x60\xec\xa6\x32\xad\xdf\x89\xf2\x9c\x5c\x8a\x32\x37\xfe\xfc\x30\xe3\x65\xd9\x2a\xe2\xe8\x07\x45\x5a\x73\x0b\xe2\x44\xdb\xe2\x78\x00\x74\xe7\x77\xc1\x6f\x79\x0c\xf6\x2d\x9f\x84\xbe\xdd\xd0\xdc\x42\x40\xa2\x76\x09\xe2\x43\x98\x62\x84\xe2\x98\x52\xa9\xb9\xbb\x89\x7f\xb7\xe2\xc9\xcf\xc1\x6a\x31\xaf\xcb\x9f\x5f\x6b\xcb\xe1\x96\x40\xbf\x03\x64\x1f\xcf\x84\x7e\xd4\x0d\xf9\xeb\x78\x8a\x72\xc7\x58\xc7\xa2\xb1\xd3\xa5\xa3\xc1\xc1\x84\xa1\x38\x1c\x9d\xc0\x64\x86\xb0\xe4\xe5\x02\x0d\x75\x18\x28\x33\xbd\xaa\x2c\xe6\x0e\x8a\xf2\x85\xe6\x56\x28\xe9\x25\x37\xc0\x35\xa1\x96\xa4\xfa\x8e\x39\xdc\xad\x9c\xa6\x26\x04\x7f\x1d\xef\x2c\xa0\x52\x0d\x4b\xc4\x68\x4b\xee\x21\x01\xd5\xa0\x60\x57\xf5\x5d\x69\xb3\x34\x36\x97\xe1\xca\x80\x53\xd1\x92\x82\x61\xce\xef\x71\xf8\xf9\x8b\x90\x16\x75\xc1\x33\x5c\x6f\x4e\xa1\x44\xd9\xa9\x56\x29\xa5\x51\x54\x28\x0d\x82\x0e\xf8\x28\x5d\x3a\x3d\xa3\x68\xf9\x59\x7c\x81\x73\x68\xa9\x3f\x8b\x2f\xb4\x51\x0b\x59\xbb\xfb\x77\x57\xa9\x16\x4c\xbe\x6f\xc1\x72\xe6\xff\x3e\x35\xab\x93\xce\xcf\xc2\x99\xb3\x26\x9f\x3e\xb9\x7e\xb4\x86\x93\x63\xdb\x2d\xaf\xc2\xd2\x9d\xdd\xd3\x22\xb0\x9f\x71\x33\xd9\x56\x64\xb3\x79\xc4\xe8\xad\xa5\x5b\x5b\x3e\x65\x85\xe6\xaa\xfa\x9f\xdd\xdf\xa2\x80\xba\x7c\x8e\x7f\xf5\x7f\xdf\x8d\x2f\x3f\xbe\x4c\x55\x89\xf6\x41\xe9\xfb\x57\xac\xab\x53\xf0\xb6\xe2\x56\xf0\xf2\x65\x3a\x1a\x7f\xf8\x15\xea\xf8\xfc\xd8\x76\x30\x45\x5d\x12\x01\x1e\x2f\x61\xd8\xdb\x4e\x7d\x22\xa4\xbc\xa5\x1e\x0e\xf5\x16\x86\xc1\x10\xbf\x36\x65\xe8\x9d\x92\xc6\x3a\xd4\x4f\xe8\xff\xbf\xaf\x2c\x9a\x24\x4d\xd3\x97\x59\x59\x2e\xca\xd2\xf0\x62\x0b\x42\x5e\xa3\x99\x9f\xa5\xd5\x2e\x94\x1d\xd4\xa5\x47\xca\x41\x7d\xa8\xdf\xcd\xe8\xdd\x7c\x95\x4f\xb1\xf6\x72\x28\xb7\xb5\x74\x90\xfc\xcc\x49\x08\xdc\x6b\x7e\x0f\x54\xfd\x9f\xb9\x21\x96\x87\xca\x3d\x36\x45\x16\xf3\x29\xf6\x55\xfb\x83\x55\xf9\x45\x25\x88\x64\x22\x55\x9e\x5f\x59\x48\xc6\xd1\x8c\x7f\xa7\xc2\xe2\x6d\xd6\x5e\xf9\xc6\xfc\x26\xec\x2c\x69\x54\xff\xbe\xb6\xf5\x85\x98\xc3\x54\x2c\x51\x52\x5f\x92\x0b\xca\x5d\x03\x43\x65\x67\xa8\x5b\x46\x26\xed\x73\x03\x6d\x1b\x60\x8c\x35\x74\xce\xd6\xe8\x5e\x1b\xf5\x45\xaf\xd1\x57\xa4\xf6\x77\xf1\x97\xcb\xb8\x01\xb2\x9b\x07\xf9\xfe\x5f\xcf\xc5\x26\x27\x8d\xc8\x85\xdc\x13\xe5\x60\x2a\x1f\xb6\x49\x6b\x92\xa7\x34\x69\x6e\xda\xfa\xc7\xd3\x1e\x23\xf9\x5c\x18\x7a\x75\xbf\x12\xe1\xfb\x21\x75\x34\x82\x0b\x99\xc3\x54\xab\x45\x45\x53\x45\x63\xa9\x45\x6f\x14\x31\xed\x48\xe0\xe2\xfa\x12\x54\x85\x9a\x53\x67\x76\x87\xf6\x01\xd1\xe5\xce\x3c\x0c\xda\x2e\x64\x3e\xec\x9c\xdb\x0b\xfa\x63\xc2\xfd\xc9\x68\x3f\xda\x01\x5c\x1e\x37\x7b\x63\x9d\xd9\xdb\x68\x04\x37\xfa\x18\x53\xdc\x7c\x3c\x68\x89\x1b\xfd\x8a\x0c\xa1\xf4\x4b\xec\x70\xad\xec\x16\x70\x52\xa3\xd2\xa8\x1c\x30\xd3\x63\x62\x2b\xa2\x0f\x83\x6b\x65\x87\x15\xfc\x3f\x35\x96\xca\x3e\x5b\x65\xda\x1f\xf8\xd9\x72\x83\x4a\xf5\xf5\xc9\x7b\xb7\x9e\xd4\xdd\xc0\x40\xe4\xe1\x31\x7b\x24\x7e\x79\xea\x1d\x99\xdc\x8d\xae\x69\x74\xa3\x95\x82\x97\xa6\x59\x0f\x4d\xc6\x6e\x1f\xd9\x19\x38\x4c\xc4\x3c\xbc\xc2\x6b\x1e\x34\x72\x58\x6c\xbd\xcc\xdb\x2c\x0f\x80\x53\x93\x86\xbc\x27\x1e\x1f\x69\xa5\x19\xaa\x73\xb0\xc4\xd7\x35\x4f\xf4\xf4\xe6\xa1\xdd\x51\x45\x78\xbe\x33\x78\xaf\xd5\x9c\xbe\x3f\x08\x99\x95\x0b\x23\x96\xe8\x1e\xf1\x13\x45\x6b\xf8\x2d\xac\x9d\x52\x08\xd1\x3a\x87\xff\xa2\x56\xfe\x30\x94\xc8\x97\x21\x9c\x3c\xdb\x85\xbc\xa3\xef\x13\x7e\x7c\x2f\xac\x01\x23\x72\x64\xb1\x9b\x30\xb4\xc2\x19\xd7\x3a\x11\x3a\xd0\xdd\xa7\x30\x51\x4e\x4a\x46\x14\xf1\x76\x7f\x56\x57\x7e\xa7\x0e\xc5\xd5\x4c\x91\xe5\x54\xdd\x6f\xd7\x7a\xb6\x45\xbf\x89\xb0\x66\xfc\x20\x73\x20\xb7\x19\x06\xde\xed\x86\x74\xb1\x33\x6e\xdd\x64\x42\x8a\xd2\x35\xec\x38\xaf\xec\x2a\x75\x4b\x62\x2a\x15\x4d\x58\xef\x56\xf0\x1b\x7d\x18\xf1\xc7\x98\x9b\xc4\x9e\x42\xb6\x30\x96\xa4\xbe\xa3\xfe\xfc\x14\x0c\x4a\x23\x6c\x30\x1b\x71\x96\x4a\x9e\x19\xe4\x3a\x9b\xf1\xbb\x12\x3b\xb3\x91\x20\x4f\x3b\xca\xf5\xc2\x63\xee\xbf\xd0\x3c\xf0\x55\xb0\xd4\xb6\xc6\xad\xb5\xc6\x97\x63\x09\x9f\xbf\xec\x4c\xd0\xe3\xe8\x40\x80\xc5\xd1\xc1\x39\xef\xee\xc3\xe4\xb6\x51\xa6\x19\xfd\x1e\xf3\x44\xe9\x14\xaa\x3f\x7a\xb8\x16\x86\xd0\x3b\xed\x37\x9c\xec\x67\x52\x13\x6f\x94\x35\x3e\x15\xfb\xa6\x5d\xc1\x42\xf5\x3f\xbb\xbf\x77\xab\x7a\x83\x14\xcd\xc9\x60\xf9\xbd\xb7\x43\xd4\xdf\x2b\xd1\xf2\xfe\xfb\xa1\xe3\xda\xd0\x4a\x76\x1d\xbc\x2d\xe2\x63\xf2\x7a\x0c\xe8\x44\x2c\xf8\xe2\xeb\x13\xb4\xc1\x30\x43\x35\xd0\xce\x5a\x8c\x68\x52\xc1\xa0\xa5\xaf\x88\x05\x83\x2b\x9e\xcd\x02\x68\x84\x40\x74\xa3\x7b\x97\x39\x34\x2e\x6b\x26\xfa\x14\x51\xb4\xd0\xc1\x15\x4a\x65\x93\x9e\x3a\x14\x41\xe2\xd3\x7c\xe3\xf3\xf3\x7f\x43\x8e\xa2\xfb\x45\xee\x12\x8f\x7e\x16\x4a\xa3\x98\xca\xb3\x7b\x5c\xd1\x15\x41\x40\xca\xda\x94\x60\x48\x49\xac\xd7\x7c\x89\x12\xb9\x61\xf1\x68\x14\x8f\x46\x51\x56\x0a\x94\x76\xab\xb6\xb0\x0f\x0b\xd4\xab\x61\x4a\x24\x51\xe4\x0c\xe2\x86\x7e\x9d\x88\x62\x1d\x33\x0d\x8b\x94\x31\x16\xa8\x2f\xca\x72\x98\xd9\x6f\x29\x71\x77\xd5\x6f\x8b\x10\x4e\xb6\x72\x33\x85\xcf\x5f\xfa\xcb\x1b\xa5\xab\x28\xa0\x80\xf3\x73\x87\x30\x9d\xc6\x5f\x8a\xd2\x75\xd2\x4b\xae\xa1\x32\x8f\x72\x70\xe7\x69\x5c\x59\x30\x0a\x8e\x14\xfe\x06\x6f\x89\x6b\xd4\x99\xc3\x0f\x2b\x73\x0a\xb4\x1b\x88\x48\x8d\xb6\x4d\x7f\x2d\x90\xb0\x93\x97\xb4\x4c\xba\x69\x92\xab\x60\x7d\xe9\xfc\x13\x68\xf8\xa1\x35\x9c\xa7\xff\x41\x33\x2a\x17\x6c\x6c\xfe\x8d\x5a\x0d\xd3\x7a\x6b\xcf\x20\x7d\x1c\xff\x31\xb9\x1a\xfa\xf3\x7e\xe6\xeb\xc7\xb8\x0d\xe3\x89\x7a\x19\xdb\x5f\x26\x43\xcd\x26\x6a\x9b\x67\x9b\xb0\xa1\x01\x08\xf7\xf4\xeb\xba\xa3\xe8\x31\xb7\x5e\x7d\x18\x9e\xf4\x33\x4b\xd3\x1d\x09\x9e\x80\x8c\x3f\x0a\xe2\x44\x01\x22\x37\xad\x83\xfb\xe0\xee\x27\x37\x8c\x17\xb9\x69\x43\xbb\x47\xff\xfe\xe4\x18\x06\x1f\x1d\x7a\x58\xf9\x11\x7b\xfd\xad\x3c\x1c\xd8\x6d\x1b\x1b\x5d\xeb\xc7\xd6\xde\xb3\x37\x8a\xa2\x67\x5b\xb5\xee\x7c\x4d\xf8\xfe\x8f\x32\x87\xcd\x26\xfe\xdf\x00\x40\x2c\xcb\x96\x35\x22\x00\x00")
 
 func templateWhereTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -1121,7 +1184,7 @@ func templateWhereTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/where.tmpl", size: 4283, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/where.tmpl", size: 8757, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1202,6 +1265,7 @@ var _bindata = map[string]func() (*asset, error){
 	"template/dialect/gremlin/select.tmpl":    templateDialectGremlinSelectTmpl,
 	"template/dialect/gremlin/update.tmpl":    templateDialectGremlinUpdateTmpl,
 	"template/dialect/sql/by.tmpl":            templateDialectSqlByTmpl,
+	"template/dialect/sql/config.tmpl":        templateDialectSqlConfigTmpl,
 	"template/dialect/sql/create.tmpl":        templateDialectSqlCreateTmpl,
 	"template/dialect/sql/decode.tmpl":        templateDialectSqlDecodeTmpl,
 	"template/dialect/sql/delete.tmpl":        templateDialectSqlDeleteTmpl,
@@ -1210,9 +1274,11 @@ var _bindata = map[string]func() (*asset, error){
 	"template/dialect/sql/group.tmpl":         templateDialectSqlGroupTmpl,
 	"template/dialect/sql/meta.tmpl":          templateDialectSqlMetaTmpl,
 	"template/dialect/sql/open.tmpl":          templateDialectSqlOpenTmpl,
+	"template/dialect/sql/paginate.tmpl":      templateDialectSqlPaginateTmpl,
 	"template/dialect/sql/predicate.tmpl":     templateDialectSqlPredicateTmpl,
 	"template/dialect/sql/query.tmpl":         templateDialectSqlQueryTmpl,
 	"template/dialect/sql/select.tmpl":        templateDialectSqlSelectTmpl,
+	"template/dialect/sql/sync.tmpl":          templateDialectSqlSyncTmpl,
 	"template/dialect/sql/tx.tmpl":            templateDialectSqlTxTmpl,
 	"template/dialect/sql/update.tmpl":        templateDialectSqlUpdateTmpl,
 	"template/ent.tmpl":                       templateEntTmpl,
@@ -1302,6 +1368,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			}},
 			"sql": &bintree{nil, map[string]*bintree{
 				"by.tmpl":        &bintree{templateDialectSqlByTmpl, map[string]*bintree{}},
+				"config.tmpl":    &bintree{templateDialectSqlConfigTmpl, map[string]*bintree{}},
 				"create.tmpl":    &bintree{templateDialectSqlCreateTmpl, map[string]*bintree{}},
 				"decode.tmpl":    &bintree{templateDialectSqlDecodeTmpl, map[string]*bintree{}},
 				"delete.tmpl":    &bintree{templateDialectSqlDeleteTmpl, map[string]*bintree{}},
@@ -1310,9 +1377,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 				"group.tmpl":     &bintree{templateDialectSqlGroupTmpl, map[string]*bintree{}},
 				"meta.tmpl":      &bintree{templateDialectSqlMetaTmpl, map[string]*bintree{}},
 				"open.tmpl":      &bintree{templateDialectSqlOpenTmpl, map[string]*bintree{}},
+				"paginate.tmpl":  &bintree{templateDialectSqlPaginateTmpl, map[string]*bintree{}},
 				"predicate.tmpl": &bintree{templateDialectSqlPredicateTmpl, map[string]*bintree{}},
 				"query.tmpl":     &bintree{templateDialectSqlQueryTmpl, map[string]*bintree{}},
 				"select.tmpl":    &bintree{templateDialectSqlSelectTmpl, map[string]*bintree{}},
+				"sync.tmpl":      &bintree{templateDialectSqlSyncTmpl, map[string]*bintree{}},
 				"tx.tmpl":        &bintree{templateDialectSqlTxTmpl, map[string]*bintree{}},
 				"update.tmpl":    &bintree{templateDialectSqlUpdateTmpl, map[string]*bintree{}},
 			}},
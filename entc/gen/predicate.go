@@ -9,21 +9,26 @@ type Op int
 
 // List of all builtin predicates.
 const (
-	EQ           Op = iota // =
-	NEQ                    // <>
-	GT                     // >
-	GTE                    // >=
-	LT                     // <
-	LTE                    // <=
-	IsNil                  // IS NULL / has
-	NotNil                 // IS NOT NULL / hasNot
-	In                     // within
-	NotIn                  // without
-	EqualFold              // equals case-insensitive
-	Contains               // containing
-	ContainsFold           // containing case-insensitive
-	HasPrefix              // startingWith
-	HasSuffix              // endingWith
+	EQ            Op = iota // =
+	NEQ                     // <>
+	GT                      // >
+	GTE                     // >=
+	LT                      // <
+	LTE                     // <=
+	IsNil                   // IS NULL / has
+	NotNil                  // IS NOT NULL / hasNot
+	In                      // within
+	NotIn                   // without
+	EqualFold               // equals case-insensitive
+	Contains                // containing
+	ContainsFold            // containing case-insensitive
+	HasPrefix               // startingWith
+	HasSuffix               // endingWith
+	NotContains             // notContaining
+	NotHasPrefix            // notStartingWith
+	NotHasSuffix            // notEndingWith
+	HasPrefixFold           // startingWith case-insensitive
+	HasSuffixFold           // endingWith case-insensitive
 )
 
 // Name returns the string representation of an predicate.
@@ -47,26 +52,31 @@ func (o Op) Niladic() bool {
 var (
 	// operations text.
 	opText = [...]string{
-		EQ:           "EQ",
-		NEQ:          "NEQ",
-		GT:           "GT",
-		GTE:          "GTE",
-		LT:           "LT",
-		LTE:          "LTE",
-		IsNil:        "IsNil",
-		NotNil:       "NotNil",
-		EqualFold:    "EqualFold",
-		Contains:     "Contains",
-		ContainsFold: "ContainsFold",
-		HasPrefix:    "HasPrefix",
-		HasSuffix:    "HasSuffix",
-		In:           "In",
-		NotIn:        "NotIn",
+		EQ:            "EQ",
+		NEQ:           "NEQ",
+		GT:            "GT",
+		GTE:           "GTE",
+		LT:            "LT",
+		LTE:           "LTE",
+		IsNil:         "IsNil",
+		NotNil:        "NotNil",
+		EqualFold:     "EqualFold",
+		Contains:      "Contains",
+		ContainsFold:  "ContainsFold",
+		HasPrefix:     "HasPrefix",
+		HasSuffix:     "HasSuffix",
+		In:            "In",
+		NotIn:         "NotIn",
+		NotContains:   "NotContains",
+		NotHasPrefix:  "NotHasPrefix",
+		NotHasSuffix:  "NotHasSuffix",
+		HasPrefixFold: "HasPrefixFold",
+		HasSuffixFold: "HasSuffixFold",
 	}
 	// operations per type.
 	boolOps     = []Op{EQ, NEQ}
 	enumOps     = append(boolOps, In, NotIn)
 	numericOps  = append(enumOps, GT, GTE, LT, LTE)
-	stringOps   = append(numericOps, Contains, HasPrefix, HasSuffix)
+	stringOps   = append(numericOps, Contains, NotContains, HasPrefix, NotHasPrefix, HasSuffix, NotHasSuffix)
 	nillableOps = []Op{IsNil, NotNil}
 )
@@ -58,10 +58,10 @@ var drivers = []*Storage{
 		},
 		SchemaMode: Unique | Indexes | Cascade | Migrate,
 		Ops: func(f *Field) []Op {
-			if !f.IsString() {
+			if !f.IsString() || f.Encrypted() {
 				return nil
 			}
-			return []Op{EqualFold, ContainsFold}
+			return []Op{EqualFold, ContainsFold, HasPrefixFold, HasSuffixFold}
 		},
 		OpCode: opCodes(sqlCode[:]),
 	},
@@ -113,6 +113,10 @@ var (
 		Contains:  "Containing",
 		HasPrefix: "StartingWith",
 		HasSuffix: "EndingWith",
+		// negated string predicates.
+		NotContains:  "NotContaining",
+		NotHasPrefix: "NotStartingWith",
+		NotHasSuffix: "NotEndingWith",
 	}
 )
 
@@ -23,6 +23,7 @@ type (
 	// each Type object of the graph.
 	TypeTemplate struct {
 		Name   string             // template name.
+		Skip   func(*Type) bool   // skip condition.
 		Format func(*Type) string // file name format.
 	}
 	// GraphTemplate specifies a template that is executed with
@@ -39,14 +40,17 @@ var (
 	Templates = []TypeTemplate{
 		{
 			Name:   "create",
+			Skip:   isView,
 			Format: pkgf("%s_create.go"),
 		},
 		{
 			Name:   "update",
+			Skip:   isView,
 			Format: pkgf("%s_update.go"),
 		},
 		{
 			Name:   "delete",
+			Skip:   isView,
 			Format: pkgf("%s_delete.go"),
 		},
 		{
@@ -158,6 +162,9 @@ func init() {
 	}
 }
 
+// isView is a skip condition for the templates of the mutation builders.
+func isView(t *Type) bool { return t.IsView() }
+
 func pkgf(s string) func(t *Type) string {
 	return func(t *Type) string { return fmt.Sprintf(s, t.Package()) }
 }
@@ -5,9 +5,11 @@
 package gen
 
 import (
+	"encoding/json"
 	"fmt"
 	"go/token"
 	"go/types"
+	"math"
 	"path"
 	"reflect"
 	"sort"
@@ -16,6 +18,8 @@ import (
 	"unicode"
 
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/entsql"
 	"github.com/facebookincubator/ent/dialect/sql/schema"
 	"github.com/facebookincubator/ent/entc/load"
 	"github.com/facebookincubator/ent/schema/field"
@@ -43,11 +47,19 @@ type (
 		// ForeignKeys are the foreign-keys that resides in the type table.
 		ForeignKeys []*ForeignKey
 		foreignKeys map[string]struct{}
+		// PolyEdges holds the polymorphic edges of this type.
+		PolyEdges []*PolyEdge
+		// PolyRefs holds the polymorphic edges of other types
+		// that can point to this type.
+		PolyRefs []*PolyEdge
+		// Embeds holds the embedded struct fields of this type.
+		Embeds []*Embed
 	}
 
 	// Field holds the information of a type field used for the templates.
 	Field struct {
 		def *load.Field
+		cfg *Config
 		// Name is the name of this field in the database schema.
 		Name string
 		// Type holds the type information of the field.
@@ -69,11 +81,31 @@ type (
 		StructTag string
 		// Validators holds the number of validators this field have.
 		Validators int
+		// Location indicates if this field has a location for its time values.
+		Location bool
 		// Position info of the field.
 		Position *load.Position
 		// UserDefined indicates that this field was defined by the loaded schema.
 		// Unlike default id field, which is defined by the generator.
 		UserDefined bool
+		// Annotations that were defined for the field in the schema.
+		// The mapping is from the Annotation.Name() to a JSON decoded object.
+		Annotations map[string]interface{}
+		// embed holds the embedded struct of the field, if it is one of its fields.
+		embed *Embed
+	}
+
+	// Embed holds the information of an embedded struct field (see field.Embed). Its fields
+	// are flattened to the fields of the type, and the entity holds them in a single field.
+	Embed struct {
+		// Name holds the name of the field in the schema.
+		Name string
+		// Type holds the type information of the struct.
+		Type *field.TypeInfo
+		// StructTag of the struct field in the entity.
+		StructTag string
+		// Fields holds the fields of the struct.
+		Fields []*Field
 	}
 
 	// Edge of a graph between two types.
@@ -103,6 +135,9 @@ type (
 		//	edge.To("spouse", User.Type).Unique()   // one 2 one.
 		//
 		Bidi bool
+		// Annotations that were defined for the edge in the schema.
+		// The mapping is from the Annotation.Name() to a JSON decoded object.
+		Annotations map[string]interface{}
 	}
 
 	// Relation holds the relational database information for edges.
@@ -128,6 +163,17 @@ type (
 		Unique bool
 		// Columns are the table columns.
 		Columns []string
+		// Include are the non-key columns of covering indexes.
+		Include []string
+		// Type is the index method (e.g. GIST). Empty for the default method.
+		Type string
+		// OpClass is the operator class of the key columns (e.g. vector_l2_ops).
+		OpClass string
+		// With holds the storage parameters of the index (e.g. lists).
+		With map[string]int
+		// Annotations that were defined for the index in the schema.
+		// The mapping is from the Annotation.Name() to a JSON decoded object.
+		Annotations map[string]interface{}
 	}
 
 	// ForeignKey holds the information for foreign-key columns of types.
@@ -139,6 +185,23 @@ type (
 		// Edge that is associated with this foreign-key.
 		Edge *Edge
 	}
+
+	// PolyEdge holds the information of a polymorphic edge, an edge that can point to
+	// an entity of one of multiple types. It is stored in two fields of its owner: the
+	// type field that holds the name of the entity type, and the id field that holds
+	// its id (see field.Polymorphic).
+	PolyEdge struct {
+		// Name holds the name of the edge.
+		Name string
+		// Owner holds the type that holds the edge.
+		Owner *Type
+		// TypeField holds the field of the entity type.
+		TypeField *Field
+		// IDField holds the field of the entity id.
+		IDField *Field
+		// Types holds the types that the edge can point to.
+		Types []*Type
+	}
 )
 
 // NewType creates a new type and its fields from the given schema.
@@ -146,6 +209,7 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 	typ := &Type{
 		Config: c,
 		ID: &Field{
+			cfg:       c,
 			Name:      "id",
 			Type:      c.IDType,
 			StructTag: structTag("id", ""),
@@ -163,8 +227,15 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 		if err := typ.checkField(f); err != nil {
 			return nil, err
 		}
+		if len(f.Embedded) > 0 {
+			if err := typ.addEmbed(f); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		tf := &Field{
 			def:           f,
+			cfg:           c,
 			Name:          f.Name,
 			Type:          f.Info,
 			Unique:        f.Unique,
@@ -173,30 +244,76 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			Optional:      f.Optional,
 			Default:       f.Default,
 			UpdateDefault: f.UpdateDefault,
-			Immutable:     f.Immutable,
+			Immutable:     f.Immutable || f.Generated != "",
 			StructTag:     structTag(f.Name, f.Tag),
 			Validators:    f.Validators,
+			Location:      f.Location,
 			UserDefined:   true,
+			Annotations:   f.Annotations,
+		}
+		if err := tf.checkSequence(typ.ID.Name); err != nil {
+			return nil, err
 		}
 		// User defined id field.
 		if tf.Name == typ.ID.Name {
+			if tf.HasDefaultExpr() {
+				return nil, fmt.Errorf("id field of type %q cannot have a default expression", typ.Name)
+			}
 			typ.ID = tf
 		} else {
 			typ.Fields = append(typ.Fields, tf)
 			typ.fields[f.Name] = tf
 		}
 	}
+	for _, e := range typ.Embeds {
+		if typ.fields[e.Name] != nil {
+			return nil, fmt.Errorf("field %q redeclared for type %q", e.Name, typ.Name)
+		}
+	}
 	return typ, nil
 }
 
+// addEmbed adds the given embedded struct field to the type, and its fields to the type fields.
+func (t *Type) addEmbed(f *load.Field) error {
+	e := &Embed{Name: f.Name, Type: f.Info, StructTag: structTag(f.Name, f.Tag)}
+	for _, ef := range f.Embedded {
+		if err := t.checkField(ef); err != nil {
+			return err
+		}
+		tf := &Field{
+			def:         ef,
+			cfg:         t.Config,
+			Name:        ef.Name,
+			Type:        ef.Info,
+			Position:    f.Position,
+			Optional:    ef.Optional,
+			Immutable:   ef.Immutable,
+			StructTag:   structTag(ef.Name, ""),
+			UserDefined: true,
+			embed:       e,
+		}
+		e.Fields = append(e.Fields, tf)
+		t.Fields = append(t.Fields, tf)
+		t.fields[ef.Name] = tf
+	}
+	t.Embeds = append(t.Embeds, e)
+	return nil
+}
+
+// StructField returns the struct member of the embedded struct in the entity.
+func (e Embed) StructField() string { return pascal(e.Name) }
+
 // Label returns Gremlin label name of the node/type.
 func (t Type) Label() string { return snake(t.Name) }
 
 // Table returns SQL table name of the node/type.
-func (t Type) Table() string {
+func (t *Type) Table() string {
 	if t.schema != nil && t.schema.Config.Table != "" {
 		return t.schema.Config.Table
 	}
+	if t.Config != nil && t.Naming != nil {
+		return t.Naming.TableName(t)
+	}
 	return snake(rules.Pluralize(t.Name))
 }
 
@@ -248,6 +365,61 @@ func (t Type) HasDefault() bool {
 	return false
 }
 
+// IsView reports if the type is a read-only entity that is backed by a database
+// view, and therefore, no mutation builders are generated for it.
+func (t Type) IsView() bool { return t.schema != nil && t.schema.Config.View }
+
+// HasDefaultExpr reports if any of this type's fields has a default expression
+// that is evaluated by the database on creation.
+func (t Type) HasDefaultExpr() bool {
+	for _, f := range t.Fields {
+		if f.HasDefaultExpr() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasGenerated reports if any of this type's fields is a generated column.
+func (t Type) HasGenerated() bool {
+	for _, f := range t.Fields {
+		if f.Generated() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasSensitive reports if any of this type's fields is sensitive.
+func (t Type) HasSensitive() bool {
+	for _, f := range t.Fields {
+		if f.Sensitive() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEncrypted reports if any of this type's fields is encrypted.
+func (t Type) HasEncrypted() bool {
+	for _, f := range t.Fields {
+		if f.Encrypted() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasLocation reports if any of this type's fields has a location for its time values.
+func (t Type) HasLocation() bool {
+	for _, f := range t.Fields {
+		if f.Location {
+			return true
+		}
+	}
+	return false
+}
+
 // HasUpdateDefault reports if any of this type's fields has default value on update.
 func (t Type) HasUpdateDefault() bool {
 	for _, f := range t.Fields {
@@ -357,6 +529,105 @@ func (t Type) MutableFields() []*Field {
 	return fields
 }
 
+// ImmutableFields returns the types's immutable fields that cannot be set by the
+// update builders. Immutable fields with an update default are not included.
+func (t Type) ImmutableFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.Immutable && !f.UpdateDefault {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// OrderableFields returns the types's fields that can be used for ordering paginated queries.
+func (t Type) OrderableFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.Orderable() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// UniqueKeys returns the types's fields that are unique, or are part of a unique index,
+// and whose values can be compared. Used for ordering the builders of bulk inserts.
+func (t Type) UniqueKeys() []*Field {
+	columns := make(map[string]bool)
+	for _, idx := range t.Indexes {
+		if idx.Unique {
+			for _, c := range idx.Columns {
+				columns[c] = true
+			}
+		}
+	}
+	var fields []*Field
+	for _, f := range t.Fields {
+		if !f.Unique && !columns[f.StorageKey()] || f.Encrypted() {
+			continue
+		}
+		if f.Type.Numeric() || f.IsString() || f.IsEnum() || f.IsTime() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// UniqueFields returns the type's unique fields that can be used for resolving its
+// entities by value (e.g. by the Add<Edge>ByField methods of the M2M edges).
+func (t Type) UniqueFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.Unique && (f.Type.Numeric() || f.IsString() || f.IsEnum()) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// UniqueConstraints returns the names of the unique constraints (and indexes) of the type table
+// in the database, mapped to the columns they cover. Unique columns (of fields and O2O edges) are
+// created with an implicit constraint, and therefore, they are mapped by both their MySQL name
+// (the column name) and their PostgreSQL name (<table>_<column>_key).
+func (t Type) UniqueConstraints() map[string][]string {
+	constraints := make(map[string][]string)
+	unique := func(column string) {
+		constraints[column] = []string{column}
+		constraints[fmt.Sprintf("%s_%s_key", t.Table(), column)] = []string{column}
+	}
+	for _, f := range t.Fields {
+		if f.Unique {
+			unique(f.StorageKey())
+		}
+	}
+	for _, fk := range t.ForeignKeys {
+		if fk.Edge.Rel.Type == O2O {
+			unique(fk.Field.Name)
+		}
+	}
+	for _, idx := range t.Indexes {
+		if idx.Unique {
+			constraints[idx.Name] = idx.Columns
+		}
+	}
+	return constraints
+}
+
+// FingerprintFields returns the type's fields that are hashed by the Fingerprint
+// method of its entities, sorted by their names.
+func (t Type) FingerprintFields() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.Fingerprint() {
+			fields = append(fields, f)
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return fields
+}
+
 // NumM2M returns the type's many-to-many edge count
 func (t Type) NumM2M() int {
 	var n int
@@ -394,7 +665,7 @@ func (t Type) TagTypes() []string {
 // AddIndex adds a new index for the type.
 // It fails if the schema index is invalid.
 func (t *Type) AddIndex(idx *load.Index) error {
-	index := &Index{Name: idx.StorageKey, Unique: idx.Unique}
+	index := &Index{Name: idx.StorageKey, Unique: idx.Unique, Annotations: idx.Annotations}
 	if len(idx.Fields) == 0 && len(idx.Edges) == 0 {
 		return fmt.Errorf("missing fields or edges")
 	}
@@ -403,9 +674,14 @@ func (t *Type) AddIndex(idx *load.Index) error {
 		if !ok {
 			return fmt.Errorf("unknown index field %q", name)
 		}
-		if f.def.Size != nil && *f.def.Size > schema.DefaultStringLen {
+		if f.def.Size != nil && *f.def.Size > schema.DefaultStringLen && !f.HasBlindIndex() {
 			return fmt.Errorf("field %q exceeds the index size limit (%d)", name, schema.DefaultStringLen)
 		}
+		// Encrypted fields with a blind index are indexed by their index column.
+		if f.HasBlindIndex() {
+			index.Columns = append(index.Columns, f.BlindIndexColumn().Name)
+			continue
+		}
 		index.Columns = append(index.Columns, f.StorageKey())
 	}
 	for _, name := range idx.Edges {
@@ -427,6 +703,26 @@ func (t *Type) AddIndex(idx *load.Index) error {
 			index.Columns = append(index.Columns, edge.Rel.Column())
 		}
 	}
+	ant, err := index.EntSQL()
+	if err != nil {
+		return err
+	}
+	if ant != nil {
+		for _, c := range ant.IncludeColumns {
+			if !t.hasColumn(c) {
+				return fmt.Errorf("unknown included column %q", c)
+			}
+			for _, k := range index.Columns {
+				if k == c {
+					return fmt.Errorf("included column %q is already a key column of the index", c)
+				}
+			}
+			index.Include = append(index.Include, c)
+		}
+		index.Type = ant.IndexType
+		index.OpClass = ant.IndexOpClass
+		index.With = ant.IndexWith
+	}
 	// If no storage-key was defined for this index, generate one.
 	if idx.StorageKey == "" {
 		// Add the type name as a prefix to the index parts, because
@@ -438,6 +734,25 @@ func (t *Type) AddIndex(idx *load.Index) error {
 	return nil
 }
 
+// hasColumn reports if the given column is one of the
+// field columns or edge columns of the type table.
+func (t *Type) hasColumn(column string) bool {
+	if t.ID != nil && t.ID.StorageKey() == column {
+		return true
+	}
+	for _, f := range t.Fields {
+		if f.StorageKey() == column {
+			return true
+		}
+	}
+	for _, e := range t.Edges {
+		if (e.Rel.Type == M2O || e.Rel.Type == O2O && e.IsInverse()) && e.Rel.Column() == column {
+			return true
+		}
+	}
+	return false
+}
+
 // resolveFKs makes sure all edge-fks are created for the types.
 func (t *Type) resolveFKs() {
 	for _, e := range t.Edges {
@@ -556,9 +871,36 @@ func (t *Type) checkField(f *load.Field) (err error) {
 		err = fmt.Errorf("field %q redeclared for type %q", f.Name, t.Name)
 	case f.Sensitive && f.Tag != "":
 		err = fmt.Errorf("sensitive field %q cannot have struct tags", f.Name)
+	case len(f.Embedded) > 0 && f.Name == t.ID.Name:
+		err = fmt.Errorf("id field cannot be an embedded struct")
+	case len(f.Embedded) > 0 && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("embedded field %q is not supported by %s storage", f.Name, t.Storage.Name)
+	case f.Info.Type == field.TypeOther && f.Name == t.ID.Name:
+		err = fmt.Errorf("id field cannot have a custom (other) type")
+	case f.Info.Type == field.TypeOther && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("field %q with custom (other) type is not supported by %s storage", f.Name, t.Storage.Name)
+	case f.Info.Type == field.TypeDecimal && f.Name == t.ID.Name:
+		err = fmt.Errorf("id field cannot have a decimal type")
+	case f.Info.Type == field.TypeDecimal && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("field %q with decimal type is not supported by %s storage", f.Name, t.Storage.Name)
+	case f.Info.Type == field.TypeDuration && f.Name == t.ID.Name:
+		err = fmt.Errorf("id field cannot have a duration type")
+	case f.Info.Type == field.TypeDuration && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("field %q with duration type is not supported by %s storage", f.Name, t.Storage.Name)
+	case f.Generated != "" && f.Name == t.ID.Name:
+		err = fmt.Errorf("id field cannot be a generated column")
+	case f.Generated != "" && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("generated field %q is not supported by %s storage", f.Name, t.Storage.Name)
+	case f.Encrypted && f.Name == t.ID.Name:
+		err = fmt.Errorf("id field cannot be encrypted")
+	case f.Encrypted && t.Storage != nil && t.Storage.Name != "sql":
+		err = fmt.Errorf("encrypted field %q is not supported by %s storage", f.Name, t.Storage.Name)
+	case f.Encrypted && f.Unique && !f.Deterministic && !f.BlindIndex:
+		err = fmt.Errorf("unique encrypted field %q requires a deterministic cipher or a blind index", f.Name)
 	case f.Info.Type == field.TypeEnum:
-		if err = checkEnums(f); err == nil {
-			// Enum types should be named as follows: typepkg.Field.
+		// Enum types should be named as follows: typepkg.Field,
+		// unless they use a custom Go type (see GoType).
+		if err = checkEnums(f); err == nil && f.Info.PkgPath == "" {
 			f.Info.Ident = fmt.Sprintf("%s.%s", t.Package(), pascal(f.Name))
 		}
 	}
@@ -573,12 +915,31 @@ func (f Field) Constant() string {
 // DefaultName returns the variable name of the default value of this field.
 func (f Field) DefaultName() string { return "Default" + pascal(f.Name) }
 
+// TimeOrdered reports if the default values of the field are time-ordered (e.g. UUIDv7).
+// Hence, ordering by the field follows the creation order of the entities.
+func (f Field) TimeOrdered() bool { return f.def != nil && f.def.TimeOrdered }
+
 // UpdateDefaultName returns the variable name of the update default value of this field.
 func (f Field) UpdateDefaultName() string { return "Update" + f.DefaultName() }
 
+// LocationName returns the variable name of the time location of this field.
+func (f Field) LocationName() string { return pascal(f.Name) + "Location" }
+
 // DefaultValue returns the default value of the field. Invoked by the template.
 func (f Field) DefaultValue() interface{} { return f.def.DefaultValue }
 
+// HasDefaultExpr reports if the field has a default expression that is
+// evaluated by the database (and not by the client) on creation.
+func (f Field) HasDefaultExpr() bool {
+	return f.def != nil && (f.def.DefaultExpr != "" || len(f.def.DefaultExprs) > 0)
+}
+
+// Generated reports if the field is a generated (computed) column, whose
+// value is computed by the database and cannot be set by the builders.
+func (f Field) Generated() bool {
+	return f.def != nil && f.def.Generated != ""
+}
+
 // BuilderField returns the struct member of the field in the builder.
 func (f Field) BuilderField() string {
 	return builderField(f.Name)
@@ -643,27 +1004,166 @@ func (f Field) IsTime() bool { return f.Type != nil && f.Type.Type == field.Type
 // IsJSON returns true if the field is a JSON field.
 func (f Field) IsJSON() bool { return f.Type != nil && f.Type.Type == field.TypeJSON }
 
+// Mergeable returns true if the field is a mutable JSON field that holds an object (i.e. not
+// a JSON array), and therefore, it can be merged with other objects in update operations.
+func (f Field) Mergeable() bool {
+	return f.IsJSON() && !f.Immutable && !strings.HasPrefix(f.Type.Ident, "[]")
+}
+
+// Dynamic returns true if the field is a mergeable JSON field that holds a dynamic object (i.e.
+// map[string]interface{}), and therefore, its values can be accessed and set by their paths.
+func (f Field) Dynamic() bool {
+	return f.Mergeable() && !f.Nillable && f.Type.Ident == "map[string]interface {}"
+}
+
 // IsString returns true if the field is a string field.
 func (f Field) IsString() bool { return f.Type != nil && f.Type.Type == field.TypeString }
 
 // IsUUID returns true if the field is a UUID field.
 func (f Field) IsUUID() bool { return f.Type != nil && f.Type.Type == field.TypeUUID }
 
+// IsOther returns true if the field is a field with a custom Go type (see field.Other).
+func (f Field) IsOther() bool { return f.Type != nil && f.Type.Type == field.TypeOther }
+
+// IsDecimal returns true if the field is a decimal field.
+func (f Field) IsDecimal() bool { return f.Type != nil && f.Type.Type == field.TypeDecimal }
+
+// IsDuration returns true if the field is a duration field.
+func (f Field) IsDuration() bool { return f.Type != nil && f.Type.Type == field.TypeDuration }
+
+// ValueScanner returns true if the values of the field are scanned and stored by its custom
+// Go type (i.e. custom (other) fields, and decimal fields that were configured with GoType).
+func (f Field) ValueScanner() bool { return f.IsOther() || f.IsDecimal() && f.HasGoType() }
+
+// IsVector returns true if the field is a custom field that holds sql.Vector values.
+func (f Field) IsVector() bool {
+	return f.IsOther() && f.Type.PkgPath == "github.com/facebookincubator/ent/dialect/sql" &&
+		f.Type.Ident == "sql.Vector"
+}
+
+// IsIP returns true if the field is a custom field that holds sql.IP values.
+func (f Field) IsIP() bool {
+	return f.IsOther() && f.Type.PkgPath == "github.com/facebookincubator/ent/dialect/sql" &&
+		f.Type.Ident == "sql.IP"
+}
+
+// IsCIDR returns true if the field is a custom field that holds sql.CIDR values.
+func (f Field) IsCIDR() bool {
+	return f.IsOther() && f.Type.PkgPath == "github.com/facebookincubator/ent/dialect/sql" &&
+		f.Type.Ident == "sql.CIDR"
+}
+
+// IsGeoPoint returns true if the field is a custom field that holds sql.GeoPoint values.
+func (f Field) IsGeoPoint() bool {
+	return f.IsOther() && f.Type.PkgPath == "github.com/facebookincubator/ent/dialect/sql" &&
+		strings.TrimPrefix(f.Type.Ident, "*") == "sql.GeoPoint"
+}
+
+// IsGeoPolygon returns true if the field is a custom field that holds sql.GeoPolygon values.
+func (f Field) IsGeoPolygon() bool {
+	return f.IsOther() && f.Type.PkgPath == "github.com/facebookincubator/ent/dialect/sql" &&
+		strings.TrimPrefix(f.Type.Ident, "*") == "sql.GeoPolygon"
+}
+
+// IsGeometry returns true if the field is a custom field that holds sql.Geometry values.
+func (f Field) IsGeometry() bool {
+	return f.IsOther() && f.Type.PkgPath == "github.com/facebookincubator/ent/dialect/sql" &&
+		strings.TrimPrefix(f.Type.Ident, "*") == "sql.Geometry"
+}
+
+// IsSpatial returns true if the field holds PostGIS geometries (points, polygons or generic geometries).
+func (f Field) IsSpatial() bool { return f.IsGeoPoint() || f.IsGeoPolygon() || f.IsGeometry() }
+
+// ScanType returns the type that is allocated for scanning the values of custom fields.
+// Pointer types are scanned into their element type.
+func (f Field) ScanType() string {
+	return strings.TrimPrefix(f.Type.Ident, "*")
+}
+
 // IsInt returns true if the field is an int field.
 func (f Field) IsInt() bool { return f.Type != nil && f.Type.Type == field.TypeInt }
 
+// HasGoType indicates if the field has a custom Go type that is defined outside
+// of the generated code (e.g. enum fields that were configured with GoType).
+func (f Field) HasGoType() bool { return f.Type != nil && f.Type.PkgPath != "" }
+
 // IsEnum returns true if the field is an enum field.
 func (f Field) IsEnum() bool { return f.Type != nil && f.Type.Type == field.TypeEnum }
 
+// Embedded returns true if the field is a field of an embedded struct.
+func (f Field) Embedded() bool { return f.embed != nil }
+
+// Embed returns the embedded struct of the field, or nil if it is not a field of an embedded struct.
+func (f Field) Embed() *Embed { return f.embed }
+
+// EmbedField returns the struct member of the field in its embedded struct.
+func (f Field) EmbedField() string {
+	if f.embed == nil {
+		return ""
+	}
+	return f.def.EmbedField
+}
+
+// EntityField returns the struct member of the field in the entity. It is the StructField of the
+// field, unless it is a field of an embedded struct (e.g. Address.Street).
+func (f Field) EntityField() string {
+	if f.embed != nil {
+		return f.embed.StructField() + "." + f.EmbedField()
+	}
+	return f.StructField()
+}
+
 // Sensitive returns true if the field is a sensitive field.
 func (f Field) Sensitive() bool { return f.def != nil && f.def.Sensitive }
 
+// Encrypted returns true if the values of the field are encrypted by the builders.
+func (f Field) Encrypted() bool { return f.def != nil && f.def.Encrypted }
+
+// HasBlindIndex returns true if the field is an encrypted field with a blind index.
+func (f Field) HasBlindIndex() bool { return f.Encrypted() && f.def.BlindIndex }
+
+// Opaque returns true if the field is encrypted with a non-deterministic cipher, and it has
+// no blind index. Hence, its values cannot be compared in the database, and it gets no
+// predicates other than the nil checks.
+func (f Field) Opaque() bool { return f.Encrypted() && !f.def.Deterministic && !f.def.BlindIndex }
+
+// CipherName returns the variable name of the cipher of this field.
+func (f Field) CipherName() string { return pascal(f.Name) + "Cipher" }
+
+// BlindIndexName returns the variable name of the blind index of this field.
+func (f Field) BlindIndexName() string { return pascal(f.Name) + "BlindIndex" }
+
+// BlindIndexConstant returns the constant name of the blind index column of this field.
+func (f Field) BlindIndexConstant() string { return f.Constant() + "BlindIndex" }
+
+// BlindIndexColumn returns the column of the blind index of this field.
+func (f Field) BlindIndexColumn() *schema.Column {
+	return &schema.Column{
+		Name:     f.StorageKey() + "_bidx",
+		Type:     field.TypeString,
+		Unique:   f.Unique,
+		Nullable: f.Optional,
+	}
+}
+
+// Orderable returns true if the field can be used as an order field of paginated queries
+// (keyset pagination). Optional fields are excluded, because NULL values are not comparable.
+func (f Field) Orderable() bool {
+	if f.Type == nil || f.Optional || f.Nillable || f.Sensitive() || f.Encrypted() {
+		return false
+	}
+	return f.Type.Numeric() || f.IsString() || f.IsEnum() || f.IsTime()
+}
+
 // NullType returns the sql null-type for optional and nullable fields.
 func (f Field) NullType() string {
+	if f.ValueScanner() {
+		return "sql.NullScanner"
+	}
 	switch f.Type.Type {
 	case field.TypeJSON:
 		return "[]byte"
-	case field.TypeString, field.TypeEnum:
+	case field.TypeString, field.TypeEnum, field.TypeDecimal:
 		return "sql.NullString"
 	case field.TypeBool:
 		return "sql.NullBool"
@@ -674,6 +1174,8 @@ func (f Field) NullType() string {
 		return "sql.NullInt64"
 	case field.TypeFloat32, field.TypeFloat64:
 		return "sql.NullFloat64"
+	case field.TypeDuration:
+		return "sql.NullDuration"
 	}
 	return f.Type.String()
 }
@@ -681,13 +1183,23 @@ func (f Field) NullType() string {
 // NullTypeField extracts the nullable type field (if exists) from the given receiver.
 // It also does the type conversion if needed.
 func (f Field) NullTypeField(rec string) string {
+	if f.ValueScanner() {
+		if f.Type.Nillable {
+			return fmt.Sprintf("%s.S.(%s)", rec, f.Type)
+		}
+		return fmt.Sprintf("*%s.S.(*%s)", rec, f.Type)
+	}
 	switch f.Type.Type {
+	case field.TypeDecimal:
+		return fmt.Sprintf("%s.String", rec)
 	case field.TypeEnum:
 		return fmt.Sprintf("%s(%s.String)", f.Type, rec)
 	case field.TypeString, field.TypeBool, field.TypeInt64, field.TypeFloat64:
 		return fmt.Sprintf("%s.%s", rec, strings.Title(f.Type.String()))
 	case field.TypeTime:
 		return fmt.Sprintf("%s.Time", rec)
+	case field.TypeDuration:
+		return fmt.Sprintf("%s.Duration", rec)
 	case field.TypeFloat32:
 		return fmt.Sprintf("%s(%s.Float64)", f.Type, rec)
 	case field.TypeInt, field.TypeInt8, field.TypeInt16, field.TypeInt32,
@@ -708,15 +1220,29 @@ func (f Field) Column() *schema.Column {
 		Size:     f.size(),
 	}
 	switch {
-	case f.Default && (f.Type.Numeric() || f.Type.Type == field.TypeBool):
+	// Encrypted values are stored base64-encoded in text columns, and their defaults are
+	// applied (and encrypted) by the builders. The uniqueness of fields with a blind index
+	// is enforced by the index column.
+	case f.Encrypted():
+		c.Size = math.MaxInt32
+		c.Unique = f.Unique && !f.HasBlindIndex()
+	// Duration defaults are applied by the builders, because their database
+	// representation is different between the dialects.
+	case f.Default && (f.Type.Numeric() && !f.IsDuration() || f.Type.Type == field.TypeBool):
 		c.Default = f.DefaultValue()
-	case f.Default && (f.IsString() || f.IsEnum()):
+	case f.Default && (f.IsString() || f.IsEnum() || f.IsDecimal()):
 		if s, ok := f.DefaultValue().(string); ok {
 			c.Default = strconv.Quote(s)
 		}
 	}
 	if f.def != nil {
+		c.Precision = f.def.Precision
+		c.Scale = f.def.Scale
 		c.SchemaType = f.def.SchemaType
+		c.DefaultExpr = f.def.DefaultExpr
+		c.DefaultExprs = f.def.DefaultExprs
+		c.Generated = f.def.Generated
+		c.Stored = f.def.Stored
 	}
 	return c
 }
@@ -749,15 +1275,22 @@ func (f Field) PK() *schema.Column {
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
 	}
+	if seq := f.sequence(); seq != nil {
+		c.Sequence = seq.Name
+		c.DefaultExprs = map[string]string{dialect.Postgres: seq.Expr()}
+	}
 	return c
 }
 
 // StorageKey returns the storage name of the field.
 // SQL columns or Gremlin property.
-func (f Field) StorageKey() string {
+func (f *Field) StorageKey() string {
 	if f.def != nil && f.def.StorageKey != "" {
 		return f.def.StorageKey
 	}
+	if f.cfg != nil && f.cfg.Naming != nil {
+		return f.cfg.Naming.ColumnName(f)
+	}
 	return snake(f.Name)
 }
 
@@ -827,6 +1360,151 @@ func (e Edge) BuilderField() string {
 	return builderField(e.Name)
 }
 
+// Fingerprint reports if the field is hashed by the Fingerprint method of its entity. Time fields
+// with a default value (e.g. create_time and update_time) are excluded, unless the field was
+// annotated with field.Fingerprint, which overrides the default in both directions.
+func (f Field) Fingerprint() bool {
+	if v, ok := f.Annotations[field.FingerprintAnnotation{}.Name()]; ok {
+		ant := &field.FingerprintAnnotation{}
+		if buf, err := json.Marshal(v); err == nil && json.Unmarshal(buf, ant) == nil {
+			return ant.Include
+		}
+	}
+	return !f.IsTime() || !f.Default && !f.UpdateDefault
+}
+
+// EntSQL returns the EntSQL annotation of the field, or nil if it was not defined.
+func (f Field) EntSQL() (*entsql.Annotation, error) {
+	v, ok := f.Annotations[entsql.Annotation{}.Name()]
+	if !ok {
+		return nil, nil
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	ant := &entsql.Annotation{}
+	if err := json.Unmarshal(buf, ant); err != nil {
+		return nil, err
+	}
+	return ant, nil
+}
+
+// Polymorphic returns the Polymorphic annotation of the field, or nil if it was not defined.
+func (f Field) Polymorphic() (*field.PolymorphicAnnotation, error) {
+	v, ok := f.Annotations[field.PolymorphicAnnotation{}.Name()]
+	if !ok {
+		return nil, nil
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	ant := &field.PolymorphicAnnotation{}
+	if err := json.Unmarshal(buf, ant); err != nil {
+		return nil, err
+	}
+	return ant, nil
+}
+
+// sequence returns the sequence that generates the field values, or nil if it was not defined.
+// The annotation of the field is validated when the type is created.
+func (f Field) sequence() *entsql.Sequence {
+	if ant, _ := f.EntSQL(); ant != nil {
+		return ant.Sequence
+	}
+	return nil
+}
+
+// checkSequence checks that a sequence is defined only on a string id field.
+func (f Field) checkSequence(id string) error {
+	ant, err := f.EntSQL()
+	switch {
+	case err != nil:
+		return fmt.Errorf("invalid annotation for field %q: %v", f.Name, err)
+	case ant == nil || ant.Sequence == nil:
+		return nil
+	case f.Name != id || !f.IsString():
+		return fmt.Errorf("sequence annotation is supported only on string id fields, and not on field %q", f.Name)
+	case ant.Sequence.Name == "":
+		return fmt.Errorf("missing sequence name for field %q", f.Name)
+	case ant.Sequence.Width < 0:
+		return fmt.Errorf("invalid sequence width %d for field %q", ant.Sequence.Width, f.Name)
+	}
+	return nil
+}
+
+// EntSQL returns the EntSQL annotation of the edge, or nil if it was not defined.
+func (e Edge) EntSQL() (*entsql.Annotation, error) {
+	v, ok := e.Annotations[entsql.Annotation{}.Name()]
+	if !ok {
+		return nil, nil
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	ant := &entsql.Annotation{}
+	if err := json.Unmarshal(buf, ant); err != nil {
+		return nil, err
+	}
+	if ant.Deferrable != "" && !ant.Deferrable.Valid() {
+		return nil, fmt.Errorf("invalid deferrable mode %q", ant.Deferrable)
+	}
+	return ant, nil
+}
+
+// EntSQL returns the EntSQL annotation if exists.
+func (i Index) EntSQL() (*entsql.Annotation, error) {
+	v, ok := i.Annotations[entsql.Annotation{}.Name()]
+	if !ok {
+		return nil, nil
+	}
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	ant := &entsql.Annotation{}
+	if err := json.Unmarshal(buf, ant); err != nil {
+		return nil, err
+	}
+	return ant, nil
+}
+
+// deferrable returns the deferrable mode of the edge foreign-keys. The
+// annotation of the edge is validated when the graph is created.
+func (e Edge) deferrable() schema.DeferrableMode {
+	if ant, _ := e.EntSQL(); ant != nil {
+		return schema.DeferrableMode(ant.Deferrable)
+	}
+	return ""
+}
+
+// SharedFields returns the fields that prefix the composite foreign-key of the edge
+// (see entsql.CompositeForeignKey). The annotation is defined on the assoc edge, and
+// it is validated when the graph is created.
+func (e Edge) SharedFields() []*Field {
+	assoc := &e
+	if e.IsInverse() {
+		ref, ok := e.Type.HasAssoc(e.Inverse)
+		if !ok {
+			return nil
+		}
+		assoc = ref
+	}
+	ant, _ := assoc.EntSQL()
+	if ant == nil || len(ant.SharedColumns) == 0 {
+		return nil
+	}
+	fields := make([]*Field, 0, len(ant.SharedColumns))
+	for _, name := range ant.SharedColumns {
+		if f, ok := e.Type.fields[name]; ok {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // StructField returns the struct member of the edge in the model.
 func (e Edge) StructField() string {
 	return pascal(e.Name)
@@ -985,3 +1663,14 @@ func sortedKeys(m map[int]struct{}) []int {
 	sort.Ints(s)
 	return s
 }
+
+// StructField returns the name of the edge in the generated methods (e.g. QueryOwner).
+func (e PolyEdge) StructField() string {
+	return pascal(e.Name)
+}
+
+// Interface returns the name of the interface that is implemented by the
+// types that the edge can point to (e.g. CommentOwner).
+func (e PolyEdge) Interface() string {
+	return e.Owner.Name + pascal(e.Name)
+}
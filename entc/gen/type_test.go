@@ -5,8 +5,11 @@
 package gen
 
 import (
+	"math"
 	"testing"
+	"time"
 
+	"github.com/facebookincubator/ent/dialect/entsql"
 	"github.com/facebookincubator/ent/entc/load"
 	"github.com/facebookincubator/ent/schema/field"
 
@@ -62,6 +65,19 @@ func TestType(t *testing.T) {
 	})
 	require.Error(err, "empty value for enums")
 
+	typ, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "role", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []string{"user"}},
+			{Name: "kind", Info: &field.TypeInfo{Type: field.TypeEnum, Ident: "schema.Kind", PkgPath: "entc/gen/schema"}, Enums: []string{"a"}},
+		},
+	})
+	require.NoError(err)
+	require.Equal("t.Role", typ.Fields[0].Type.String())
+	require.False(typ.Fields[0].HasGoType())
+	require.Equal("schema.Kind", typ.Fields[1].Type.String(), "custom enum types are kept")
+	require.True(typ.Fields[1].HasGoType())
+
 	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{
 		Name: "T",
 		Fields: []*load.Field{
@@ -218,6 +234,314 @@ func TestType_AddIndex(t *testing.T) {
 
 	err = typ.AddIndex(&load.Index{Unique: true, Fields: []string{"name"}, Edges: []string{"owner"}})
 	require.NoError(t, err, "valid index on M2O relation and field")
+
+	include := func(columns ...string) map[string]interface{} {
+		ant := entsql.IncludeColumns(columns...)
+		return map[string]interface{}{ant.Name(): ant}
+	}
+	err = typ.AddIndex(&load.Index{Fields: []string{"name"}, Annotations: include("unknown")})
+	require.Error(t, err, "unknown included column")
+
+	err = typ.AddIndex(&load.Index{Fields: []string{"name"}, Annotations: include("name")})
+	require.Error(t, err, "included column is a key column")
+
+	err = typ.AddIndex(&load.Index{Edges: []string{"owner"}, Annotations: include("name", "prev_id")})
+	require.NoError(t, err, "valid covering index")
+	idx := typ.Indexes[len(typ.Indexes)-1]
+	require.Equal(t, []string{"file_id"}, idx.Columns)
+	require.Equal(t, []string{"name", "prev_id"}, idx.Include)
+
+	gist := entsql.IndexType("GIST")
+	err = typ.AddIndex(&load.Index{Fields: []string{"name"}, Annotations: map[string]interface{}{gist.Name(): gist}})
+	require.NoError(t, err, "valid index type")
+	require.Equal(t, "GIST", typ.Indexes[len(typ.Indexes)-1].Type)
+
+	hnsw := entsql.HNSW(entsql.VectorCosineOps, 16, 0)
+	err = typ.AddIndex(&load.Index{Fields: []string{"name"}, Annotations: map[string]interface{}{hnsw.Name(): hnsw}})
+	require.NoError(t, err, "valid vector index")
+	idx = typ.Indexes[len(typ.Indexes)-1]
+	require.Equal(t, "hnsw", idx.Type)
+	require.Equal(t, "vector_cosine_ops", idx.OpClass)
+	require.Equal(t, map[string]int{"m": 16}, idx.With)
+}
+
+func TestType_UniqueKeys(t *testing.T) {
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "email", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "first", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "active", Info: &field.TypeInfo{Type: field.TypeBool}, Unique: true},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, typ.AddIndex(&load.Index{Unique: true, Fields: []string{"first", "age"}}))
+	require.NoError(t, typ.AddIndex(&load.Index{Fields: []string{"name"}}))
+	var names []string
+	for _, f := range typ.UniqueKeys() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"email", "first", "age"}, names)
+}
+
+func TestType_UniqueFields(t *testing.T) {
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "Tag",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "color", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "code", Info: &field.TypeInfo{Type: field.TypeInt}, Unique: true},
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Unique: true},
+			{Name: "active", Info: &field.TypeInfo{Type: field.TypeBool}, Unique: true},
+		},
+	})
+	require.NoError(t, err)
+	var names []string
+	for _, f := range typ.UniqueFields() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"name", "code"}, names)
+}
+
+func TestType_UniqueConstraints(t *testing.T) {
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "Card",
+		Fields: []*load.Field{
+			{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}, Unique: true},
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "bank", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, typ.AddIndex(&load.Index{Fields: []string{"name", "bank"}, Unique: true}))
+	require.NoError(t, typ.AddIndex(&load.Index{Fields: []string{"bank"}}))
+	require.Equal(t, map[string][]string{
+		"number":           {"number"},
+		"cards_number_key": {"number"},
+		"card_name_bank":   {"name", "bank"},
+	}, typ.UniqueConstraints())
+}
+
+func TestType_FingerprintFields(t *testing.T) {
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Default: true},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "born_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+			{Name: "token", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{"Fingerprint": map[string]interface{}{"include": false}}},
+			{Name: "updated_at", Info: &field.TypeInfo{Type: field.TypeTime}, UpdateDefault: true, Annotations: map[string]interface{}{"Fingerprint": field.Fingerprint(true)}},
+		},
+	})
+	require.NoError(t, err)
+	var names []string
+	for _, f := range typ.FingerprintFields() {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"age", "born_at", "name", "updated_at"}, names)
+}
+
+func TestField_Other(t *testing.T) {
+	info := &field.TypeInfo{Type: field.TypeOther, Ident: "*sql.GeoPoint", PkgPath: "github.com/facebookincubator/ent/dialect/sql", Nillable: true}
+	f := &Field{Type: info}
+	require.True(t, f.IsOther())
+	require.True(t, f.IsGeoPoint())
+	require.True(t, f.IsSpatial())
+	require.False(t, f.Orderable())
+	require.Equal(t, "sql.NullScanner", f.NullType())
+	require.Equal(t, "sql.GeoPoint", f.ScanType())
+	require.Equal(t, "value.S.(*sql.GeoPoint)", f.NullTypeField("value"))
+
+	f = &Field{Type: &field.TypeInfo{Type: field.TypeOther, Ident: "schema.Money", PkgPath: "entc/gen/schema"}}
+	require.False(t, f.IsGeoPoint())
+	require.Equal(t, "schema.Money", f.ScanType())
+	require.Equal(t, "*value.S.(*schema.Money)", f.NullTypeField("value"))
+	require.False(t, f.IsVector())
+
+	f = &Field{Type: &field.TypeInfo{Type: field.TypeOther, Ident: "sql.Vector", PkgPath: "github.com/facebookincubator/ent/dialect/sql"}}
+	require.True(t, f.IsVector())
+	require.False(t, f.IsGeoPoint())
+	require.False(t, f.IsSpatial())
+	require.Equal(t, "*value.S.(*sql.Vector)", f.NullTypeField("value"))
+
+	f = &Field{Type: &field.TypeInfo{Type: field.TypeOther, Ident: "sql.GeoPolygon", PkgPath: "github.com/facebookincubator/ent/dialect/sql"}}
+	require.True(t, f.IsGeoPolygon())
+	require.True(t, f.IsSpatial())
+	f = &Field{Type: &field.TypeInfo{Type: field.TypeOther, Ident: "sql.Geometry", PkgPath: "github.com/facebookincubator/ent/dialect/sql"}}
+	require.True(t, f.IsGeometry())
+	require.False(t, f.IsGeoPolygon())
+	require.True(t, f.IsSpatial())
+
+	f = &Field{Type: &field.TypeInfo{Type: field.TypeOther, Ident: "sql.IP", PkgPath: "github.com/facebookincubator/ent/dialect/sql"}}
+	require.True(t, f.IsIP())
+	require.False(t, f.IsCIDR())
+	f = &Field{Type: &field.TypeInfo{Type: field.TypeOther, Ident: "sql.CIDR", PkgPath: "github.com/facebookincubator/ent/dialect/sql"}}
+	require.True(t, f.IsCIDR())
+	require.False(t, f.IsIP())
+
+	_, err := NewType(&Config{Package: "entc/gen", Storage: drivers[1]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "location", Info: info}},
+	})
+	require.Error(t, err, "other fields are not supported by gremlin")
+
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "location", Info: info}},
+	})
+	require.NoError(t, err)
+}
+
+func TestField_Decimal(t *testing.T) {
+	f := &Field{Name: "price", Type: &field.TypeInfo{Type: field.TypeDecimal}, def: &load.Field{Precision: 12, Scale: 2}}
+	require.True(t, f.IsDecimal())
+	require.False(t, f.ValueScanner())
+	require.Equal(t, "string", f.Type.String())
+	require.Equal(t, "sql.NullString", f.NullType())
+	require.Equal(t, "value.String", f.NullTypeField("value"))
+	c := f.Column()
+	require.Equal(t, field.TypeDecimal, c.Type)
+	require.Equal(t, 12, c.Precision)
+	require.Equal(t, 2, c.Scale)
+
+	f = &Field{Type: &field.TypeInfo{Type: field.TypeDecimal, Ident: "decimal.Decimal", PkgPath: "github.com/shopspring/decimal"}}
+	require.True(t, f.ValueScanner())
+	require.Equal(t, "sql.NullScanner", f.NullType())
+	require.Equal(t, "decimal.Decimal", f.ScanType())
+	require.Equal(t, "*value.S.(*decimal.Decimal)", f.NullTypeField("value"))
+
+	info := &field.TypeInfo{Type: field.TypeDecimal}
+	_, err := NewType(&Config{Package: "entc/gen", Storage: drivers[1]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "price", Info: info}},
+	})
+	require.Error(t, err, "decimal fields are not supported by gremlin")
+
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "price", Info: info}},
+	})
+	require.NoError(t, err)
+}
+
+func TestField_Duration(t *testing.T) {
+	f := &Field{Name: "ttl", Type: &field.TypeInfo{Type: field.TypeDuration}, Default: true, def: &load.Field{DefaultValue: int64(time.Hour)}}
+	require.True(t, f.IsDuration())
+	require.True(t, f.Orderable())
+	require.Equal(t, "time.Duration", f.Type.String())
+	require.Equal(t, "sql.NullDuration", f.NullType())
+	require.Equal(t, "value.Duration", f.NullTypeField("value"))
+	c := f.Column()
+	require.Equal(t, field.TypeDuration, c.Type)
+	require.Nil(t, c.Default, "duration defaults are applied by the builders")
+
+	info := &field.TypeInfo{Type: field.TypeDuration}
+	_, err := NewType(&Config{Package: "entc/gen", Storage: drivers[1]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "ttl", Info: info}},
+	})
+	require.Error(t, err, "duration fields are not supported by gremlin")
+
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "ttl", Info: info}},
+	})
+	require.NoError(t, err)
+}
+
+func TestField_Dynamic(t *testing.T) {
+	f := &Field{Name: "meta", Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]interface {}"}}
+	require.True(t, f.Mergeable())
+	require.True(t, f.Dynamic())
+	f.Nillable = true
+	require.False(t, f.Dynamic())
+	f = &Field{Name: "meta", Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]interface {}"}, Immutable: true}
+	require.False(t, f.Dynamic())
+	f = &Field{Name: "labels", Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]string"}}
+	require.True(t, f.Mergeable())
+	require.False(t, f.Dynamic())
+	f = &Field{Name: "dirs", Type: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]http.Dir"}}
+	require.False(t, f.Dynamic())
+}
+
+func TestField_Encrypted(t *testing.T) {
+	f := &Field{Name: "ssn", Type: &field.TypeInfo{Type: field.TypeString}, Unique: true, def: &load.Field{Encrypted: true}}
+	require.True(t, f.Encrypted())
+	require.True(t, f.Opaque())
+	require.False(t, f.Orderable())
+	require.Equal(t, "SsnCipher", f.CipherName())
+	c := f.Column()
+	require.Equal(t, int64(math.MaxInt32), c.Size)
+	require.True(t, c.Unique)
+
+	f = &Field{Name: "email", Type: &field.TypeInfo{Type: field.TypeString}, Unique: true, Optional: true, def: &load.Field{Encrypted: true, BlindIndex: true}}
+	require.True(t, f.HasBlindIndex())
+	require.False(t, f.Opaque())
+	require.Equal(t, "EmailBlindIndex", f.BlindIndexName())
+	require.Equal(t, "FieldEmailBlindIndex", f.BlindIndexConstant())
+	require.False(t, f.Column().Unique, "uniqueness is enforced by the blind index")
+	idx := f.BlindIndexColumn()
+	require.Equal(t, "email_bidx", idx.Name)
+	require.True(t, idx.Unique)
+	require.True(t, idx.Nullable)
+
+	info := &field.TypeInfo{Type: field.TypeString}
+	_, err := NewType(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "ssn", Info: info, Unique: true, Encrypted: true}},
+	})
+	require.Error(t, err, "unique encrypted fields require a deterministic cipher or a blind index")
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "ssn", Info: info, Unique: true, Encrypted: true, Deterministic: true}},
+	})
+	require.NoError(t, err)
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[1]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "ssn", Info: info, Encrypted: true}},
+	})
+	require.Error(t, err, "encrypted fields are not supported by gremlin")
+}
+
+func TestType_Embed(t *testing.T) {
+	info := &field.TypeInfo{Type: field.TypeOther, Ident: "schema.Address", PkgPath: "entc/gen/schema"}
+	embed := &load.Field{
+		Name: "address",
+		Info: info,
+		Embedded: []*load.Field{
+			{Name: "address_street", Info: &field.TypeInfo{Type: field.TypeString}, EmbedField: "Street"},
+			{Name: "address_zip_code", Info: &field.TypeInfo{Type: field.TypeInt}, EmbedField: "ZipCode"},
+		},
+	}
+	typ, err := NewType(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}}, embed},
+	})
+	require.NoError(t, err)
+	require.Len(t, typ.Fields, 3)
+	require.Len(t, typ.Embeds, 1)
+	require.Equal(t, "Address", typ.Embeds[0].StructField())
+	require.False(t, typ.Fields[0].Embedded())
+	require.Equal(t, "Name", typ.Fields[0].EntityField())
+	f := typ.Fields[2]
+	require.True(t, f.Embedded())
+	require.Equal(t, typ.Embeds[0], f.Embed())
+	require.Equal(t, "AddressZipCode", f.StructField())
+	require.Equal(t, "Address.ZipCode", f.EntityField())
+
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[0]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{embed, {Name: "address", Info: &field.TypeInfo{Type: field.TypeString}}},
+	})
+	require.Error(t, err, "field address redeclared")
+	_, err = NewType(&Config{Package: "entc/gen", Storage: drivers[1]}, &load.Schema{
+		Name:   "T",
+		Fields: []*load.Field{embed},
+	})
+	require.Error(t, err, "embedded fields are not supported by gremlin")
 }
 
 func TestField_Constant(t *testing.T) {
@@ -250,6 +574,117 @@ func TestField_DefaultName(t *testing.T) {
 	}
 }
 
+func TestField_Orderable(t *testing.T) {
+	tests := []struct {
+		field     *Field
+		orderable bool
+	}{
+		{&Field{Type: &field.TypeInfo{Type: field.TypeInt}}, true},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeString}}, true},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeTime}}, true},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeEnum}}, true},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeBool}}, false},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeJSON}}, false},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeBytes}}, false},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeInt}, Optional: true}, false},
+		{&Field{Type: &field.TypeInfo{Type: field.TypeString}, Nillable: true}, false},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.orderable, tt.field.Orderable(), tt.field.Type.String())
+	}
+}
+
+func TestField_Sequence(t *testing.T) {
+	ant := map[string]interface{}{"EntSQL": entsql.IDSequence("card_seq", "card_", 6)}
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "Card",
+		Fields: []*load.Field{
+			{Name: "id", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: ant},
+			{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	pk := typ.ID.PK()
+	require.Equal(t, "card_seq", pk.Sequence)
+	require.Equal(t, map[string]string{"postgres": "'card_' || lpad(nextval('card_seq')::text, 6, '0')"}, pk.DefaultExprs)
+
+	_, err = NewType(&Config{}, &load.Schema{
+		Name: "Card",
+		Fields: []*load.Field{
+			{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: ant},
+		},
+	})
+	require.Error(t, err, "sequences are supported only on id fields")
+	_, err = NewType(&Config{}, &load.Schema{
+		Name: "Card",
+		Fields: []*load.Field{
+			{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: ant},
+		},
+	})
+	require.Error(t, err, "sequences are supported only on string ids")
+}
+
+func TestField_DefaultExpr(t *testing.T) {
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "priority", Info: &field.TypeInfo{Type: field.TypeInt}, DefaultExpr: "0"},
+			{Name: "token", Info: &field.TypeInfo{Type: field.TypeUUID}, DefaultExprs: map[string]string{"postgres": "gen_random_uuid()"}},
+			{Name: "active", Info: &field.TypeInfo{Type: field.TypeBool}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, typ.HasDefaultExpr())
+	require.True(t, typ.Fields[0].HasDefaultExpr())
+	require.Equal(t, "0", typ.Fields[0].Column().DefaultExpr)
+	require.True(t, typ.Fields[1].HasDefaultExpr())
+	require.Equal(t, "gen_random_uuid()", typ.Fields[1].Column().DefaultExprs["postgres"])
+	require.False(t, typ.Fields[2].HasDefaultExpr())
+
+	_, err = NewType(&Config{}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "id", Info: &field.TypeInfo{Type: field.TypeUUID}, DefaultExpr: "gen_random_uuid()"},
+		},
+	})
+	require.Error(t, err, "id fields cannot have default expressions")
+}
+
+func TestField_Generated(t *testing.T) {
+	typ, err := NewType(&Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "first_name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "full_name", Info: &field.TypeInfo{Type: field.TypeString}, Generated: "first_name || '!'", Stored: true},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, typ.HasGenerated())
+	require.False(t, typ.Fields[0].Generated())
+	f := typ.Fields[1]
+	require.True(t, f.Generated())
+	require.True(t, f.Immutable, "generated fields cannot be updated")
+	require.Equal(t, []*Field{typ.Fields[0]}, typ.MutableFields())
+	c := f.Column()
+	require.Equal(t, "first_name || '!'", c.Generated)
+	require.True(t, c.Stored)
+
+	_, err = NewType(&Config{}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "id", Info: &field.TypeInfo{Type: field.TypeInt}, Generated: "1"},
+		},
+	})
+	require.Error(t, err, "id fields cannot be generated")
+	_, err = NewType(&Config{Storage: drivers[1]}, &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "full_name", Info: &field.TypeInfo{Type: field.TypeString}, Generated: "1"},
+		},
+	})
+	require.Error(t, err, "generated fields are not supported by gremlin")
+}
+
 func TestBuilderField(t *testing.T) {
 	tests := []struct {
 		name  string
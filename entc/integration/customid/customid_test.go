@@ -11,10 +11,17 @@ import (
 	"net"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/blob"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/note"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/user"
 	"github.com/go-sql-driver/mysql"
 
@@ -45,6 +52,13 @@ func TestMySQL(t *testing.T) {
 			err = client.Schema.Create(context.Background())
 			require.NoError(t, err)
 			CustomID(t, client)
+			CompositeFK(t, client)
+			PolymorphicEdge(t, client)
+			DecimalField(t, client)
+			NetworkFields(t, client)
+			DurationField(t, client)
+			EncryptedFields(t, client)
+			EmbeddedField(t, client)
 		})
 	}
 }
@@ -66,6 +80,15 @@ func TestPostgres(t *testing.T) {
 			err = client.Schema.Create(context.Background())
 			require.NoError(t, err)
 			CustomID(t, client)
+			SequenceID(t, client)
+			CompositeFK(t, client)
+			PolymorphicEdge(t, client)
+			DecimalField(t, client)
+			NetworkFields(t, client)
+			NetworkPredicates(t, client)
+			DurationField(t, client)
+			EncryptedFields(t, client)
+			EmbeddedField(t, client)
 		})
 	}
 }
@@ -76,6 +99,320 @@ func TestSQLite(t *testing.T) {
 	defer client.Close()
 	require.NoError(t, client.Schema.Create(context.Background()))
 	CustomID(t, client)
+	CompositeFK(t, client)
+	PolymorphicEdge(t, client)
+	DecimalField(t, client)
+	NetworkFields(t, client)
+	DurationField(t, client)
+	EncryptedFields(t, client)
+	EmbeddedField(t, client)
+}
+
+func TestGeneratedID(t *testing.T) {
+	db, err := sql.Open("sqlite3", "file:generated?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	// Device ids are generated by the database, and they are read back as they were returned by
+	// the driver. The table is created manually, because the migration does not set their default.
+	_, err = db.ExecContext(ctx, "CREATE TABLE `devices` (`id` uuid PRIMARY KEY DEFAULT (lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-a' || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6)))), `name` varchar(255) NOT NULL)")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+
+	d1 := client.Device.Create().SetName("d1").SaveX(ctx)
+	require.NotEqual(t, uuid.Nil, d1.ID)
+	require.Equal(t, "d1", client.Device.GetX(ctx, d1.ID).Name)
+	id := client.Device.Create().SetName("d2").SaveIDX(ctx)
+	require.NotEqual(t, uuid.Nil, id)
+	require.NotEqual(t, d1.ID, id)
+	require.Equal(t, "d2", client.Device.GetX(ctx, id).Name)
+
+	devices := client.Device.CreateBulk(
+		client.Device.Create().SetName("d3"),
+		client.Device.Create().SetName("d4"),
+	).SaveX(ctx)
+	for _, d := range devices {
+		require.Equal(t, d.Name, client.Device.GetX(ctx, d.ID).Name)
+	}
+	id = uuid.New()
+	require.Equal(t, id, client.Device.Create().SetID(id).SetName("d5").SaveX(ctx).ID, "use provided id")
+}
+
+// SequenceID tests ids that are generated from a PostgreSQL sequence.
+func SequenceID(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	n1 := client.Note.Create().SetText("n1").SaveX(ctx)
+	require.Regexp(t, `^note_\d{6}$`, n1.ID)
+	require.Equal(t, "n1", client.Note.GetX(ctx, n1.ID).Text)
+	id := client.Note.Create().SetText("n2").SaveIDX(ctx)
+	require.Regexp(t, `^note_\d{6}$`, id)
+	require.NotEqual(t, n1.ID, id)
+
+	notes := client.Note.CreateBulk(
+		client.Note.Create().SetText("n3"),
+		client.Note.Create().SetText("n4"),
+	).SaveX(ctx)
+	for _, n := range notes {
+		require.Regexp(t, `^note_\d{6}$`, n.ID)
+		require.Equal(t, n.Text, client.Note.GetX(ctx, n.ID).Text)
+	}
+	require.Equal(t, "note_custom", client.Note.Create().SetID("note_custom").SetText("n5").SaveX(ctx).ID, "use provided id")
+}
+
+// DurationField tests the storage, the predicates and the arithmetic updates of duration fields.
+func DurationField(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	n1 := client.Note.Create().SetID("ttl_1").SetText("n1").SetTTL(90 * time.Minute).SaveX(ctx)
+	n2 := client.Note.Create().SetID("ttl_2").SetText("n2").SetTTL(36*time.Hour + 1500*time.Microsecond).SaveX(ctx)
+	client.Note.Create().SetID("ttl_3").SetText("n3").SaveX(ctx)
+	require.Equal(t, 90*time.Minute, client.Note.GetX(ctx, n1.ID).TTL)
+	require.Equal(t, 36*time.Hour+1500*time.Microsecond, client.Note.GetX(ctx, n2.ID).TTL)
+
+	query := client.Note.Query().Where(note.IDIn("ttl_1", "ttl_2", "ttl_3"))
+	require.Equal(t, 1, query.Clone().Where(note.TTLIsNil()).CountX(ctx))
+	require.Equal(t, n2.ID, query.Clone().Where(note.TTLGT(2*time.Hour)).OnlyXID(ctx))
+	require.Equal(t, n1.ID, query.Clone().Where(note.TTL(90*time.Minute)).OnlyXID(ctx))
+	ids := query.Clone().Where(note.TTLNotNil()).Order(ent.Desc(note.FieldTTL)).IDsX(ctx)
+	require.Equal(t, []string{n2.ID, n1.ID}, ids)
+
+	client.Note.UpdateOneID(n1.ID).AddTTL(30 * time.Minute).ExecX(ctx)
+	require.Equal(t, 2*time.Hour, client.Note.GetX(ctx, n1.ID).TTL)
+	n1 = client.Note.UpdateOneID(n1.ID).SubTTL(3 * time.Hour).SaveX(ctx)
+	require.Equal(t, -time.Hour, client.Note.GetX(ctx, n1.ID).TTL)
+	require.Equal(t, n1.ID, query.Clone().Where(note.TTLLT(0)).OnlyXID(ctx))
+}
+
+// EncryptedFields tests that encrypted fields are stored encrypted, and are decrypted when they are
+// queried, and the equality predicates of deterministic and blind-indexed encrypted fields.
+func EncryptedFields(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	u1 := client.User.Create().SetSsn("123-45-6789").SetTaxID("t1").SetEmail("a8m@example.com").SaveX(ctx)
+	u2 := client.User.Create().SetSsn("123-45-6789").SetTaxID("t2").SetEmail("nati@example.com").SaveX(ctx)
+	require.Equal(t, "123-45-6789", u1.Ssn)
+	u1 = client.User.GetX(ctx, u1.ID)
+	require.Equal(t, "123-45-6789", u1.Ssn)
+	require.Equal(t, "t1", u1.TaxID)
+	require.Equal(t, "a8m@example.com", u1.Email)
+
+	query := client.User.Query().Where(user.SsnNotNil())
+	raw := query.Clone().Order(ent.Asc(user.FieldID)).Select(user.FieldSsn).StringsX(ctx)
+	require.Len(t, raw, 2)
+	require.NotContains(t, raw[0], "123-45-6789")
+	require.NotEqual(t, raw[0], raw[1], "ssn is encrypted with random nonces")
+	require.Equal(t, u2.ID, query.Clone().Where(user.TaxID("t2")).OnlyXID(ctx))
+	require.Equal(t, 2, query.Clone().Where(user.TaxIDIn("t1", "t2")).CountX(ctx))
+	require.Equal(t, u1.ID, query.Clone().Where(user.Email("a8m@example.com")).OnlyXID(ctx))
+	require.Equal(t, u2.ID, query.Clone().Where(user.EmailNEQ("a8m@example.com")).OnlyXID(ctx))
+	_, err := client.User.Create().SetEmail("a8m@example.com").Save(ctx)
+	require.True(t, ent.IsConstraintError(err), "email blind index is unique")
+
+	u2 = client.User.UpdateOne(u2).SetEmail("nati@example.org").ClearSsn().SaveX(ctx)
+	require.Equal(t, "nati@example.org", client.User.GetX(ctx, u2.ID).Email)
+	require.Equal(t, u2.ID, client.User.Query().Where(user.Email("nati@example.org")).OnlyXID(ctx))
+	require.Zero(t, client.User.Query().Where(user.Email("nati@example.com")).CountX(ctx))
+	require.Equal(t, u1.ID, query.Clone().OnlyXID(ctx))
+	client.User.UpdateOne(u2).ClearEmail().ExecX(ctx)
+	require.Equal(t, u1.ID, client.User.Query().Where(user.EmailNotNil()).OnlyXID(ctx))
+	client.User.Create().SetEmail("nati@example.org").SaveX(ctx)
+}
+
+// EmbeddedField tests the embedded struct fields, that are stored in prefixed columns.
+func EmbeddedField(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	since := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	addr := schema.Address{Street: "Dizengoff 1", City: "Tel Aviv", ZipCode: 6433201, Since: since}
+	u1 := client.User.Create().SetAddress(addr).SaveX(ctx)
+	require.Equal(t, addr, u1.Address)
+	u1 = client.User.GetX(ctx, u1.ID)
+	require.Equal(t, addr.City, u1.Address.City)
+	require.Equal(t, addr.ZipCode, u1.Address.ZipCode)
+	require.True(t, since.Equal(u1.Address.Since))
+	u2 := client.User.Create().SetAddressCity("Haifa").SaveX(ctx)
+	require.Equal(t, schema.Address{City: "Haifa"}, client.User.GetX(ctx, u2.ID).Address)
+
+	query := client.User.Query().Where(user.AddressCityNotNil())
+	require.Equal(t, 2, query.Clone().CountX(ctx))
+	require.Equal(t, u1.ID, query.Clone().Where(user.AddressCity("Tel Aviv")).OnlyXID(ctx))
+	require.Equal(t, u1.ID, query.Clone().Where(user.AddressZipCodeGT(1000)).OnlyXID(ctx))
+	require.Equal(t, u2.ID, query.Clone().Where(user.AddressStreetIsNil()).OnlyXID(ctx))
+
+	u2 = client.User.UpdateOne(u2).SetAddressStreet("Herzl 1").SaveX(ctx)
+	require.Equal(t, schema.Address{Street: "Herzl 1", City: "Haifa"}, u2.Address)
+	u1 = client.User.UpdateOne(u1).ClearAddress().SaveX(ctx)
+	require.Zero(t, client.User.GetX(ctx, u1.ID).Address)
+	require.Equal(t, u2.ID, query.Clone().OnlyXID(ctx))
+}
+
+// NetworkFields tests the IP and CIDR fields, and the predicates that are supported by all dialects.
+func NetworkFields(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	ip, err := entsql.ParseIP("10.1.2.3")
+	require.NoError(t, err)
+	network, err := entsql.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	u := client.User.Create().SetLastIP(ip).SetAllowedNetwork(network).SaveX(ctx)
+	u = client.User.GetX(ctx, u.ID)
+	require.True(t, ip.Equal(u.LastIP.IP))
+	require.Equal(t, "10.0.0.0/8", u.AllowedNetwork.String())
+	ip6, err := entsql.ParseIP("2001:db8::1")
+	require.NoError(t, err)
+	client.User.Create().SetLastIP(ip6).SaveX(ctx)
+
+	query := client.User.Query().Where(user.LastIPNotNil())
+	require.Equal(t, 2, query.Clone().CountX(ctx))
+	require.Equal(t, u.ID, query.Clone().Where(user.LastIPFamily(4)).OnlyXID(ctx))
+	require.NotEqual(t, u.ID, query.Clone().Where(user.LastIPFamily(6)).OnlyXID(ctx))
+	require.Equal(t, u.ID, query.Clone().Where(user.LastIP(ip)).OnlyXID(ctx))
+	_, err = query.Clone().Where(user.LastIPFamily(5)).Count(ctx)
+	require.Error(t, err, "invalid address family")
+}
+
+// NetworkPredicates tests the network predicates of the IP and CIDR fields, that are supported only by PostgreSQL.
+func NetworkPredicates(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	query := client.User.Query().Where(user.LastIPNotNil())
+	require.Equal(t, 1, query.Clone().Where(user.LastIPWithin("10.0.0.0/8")).CountX(ctx))
+	require.Equal(t, 1, query.Clone().Where(user.LastIPWithin("2001:db8::/32")).CountX(ctx))
+	require.Zero(t, query.Clone().Where(user.LastIPWithin("192.168.0.0/16")).CountX(ctx))
+	require.Equal(t, 1, query.Clone().Where(user.AllowedNetworkContains("10.200.0.1")).CountX(ctx))
+	require.Zero(t, query.Clone().Where(user.AllowedNetworkContains("11.0.0.1")).CountX(ctx))
+	require.Equal(t, 1, query.Clone().Where(user.AllowedNetworkWithin("10.0.0.0/7")).CountX(ctx))
+	_, err := query.Clone().Where(user.LastIPWithin("10.0.0.0")).Count(ctx)
+	require.Error(t, err, "invalid network")
+}
+
+// PolymorphicEdge tests edges that point to entities of different types.
+func PolymorphicEdge(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	p1 := client.Pet.Create().SetID("poly_pet").SaveX(ctx)
+	n1 := client.Note.Create().SetID("poly_n1").SetText("n1").SetOwner(p1).SaveX(ctx)
+	require.Equal(t, "Pet", n1.OwnerType)
+	require.Equal(t, p1.ID, n1.OwnerID)
+	n2 := client.Note.Create().SetID("poly_n2").SetText("n2").SetOwner(n1).SaveX(ctx)
+	require.Equal(t, "Note", n2.OwnerType)
+	n3 := client.Note.Create().SetID("poly_n3").SetText("n3").SaveX(ctx)
+
+	owner, err := n1.QueryOwner(ctx)
+	require.NoError(t, err)
+	require.Equal(t, p1.ID, owner.(*ent.Pet).ID)
+	owner, err = n2.QueryOwner(ctx)
+	require.NoError(t, err)
+	require.Equal(t, n1.ID, owner.(*ent.Note).ID)
+	_, err = n3.QueryOwner(ctx)
+	require.True(t, ent.IsNotFound(err))
+
+	notes := client.Note.Query().
+		Where(note.IDIn(n1.ID, n2.ID, n3.ID)).
+		Order(ent.Asc(note.FieldID)).
+		WithOwner().
+		AllX(ctx)
+	require.Len(t, notes, 3)
+	owner, err = notes[0].OwnerOrErr()
+	require.NoError(t, err)
+	require.Equal(t, p1.ID, owner.(*ent.Pet).ID)
+	owner, err = notes[1].OwnerOrErr()
+	require.NoError(t, err)
+	require.Equal(t, "n1", owner.(*ent.Note).Text)
+	_, err = notes[2].OwnerOrErr()
+	require.True(t, ent.IsNotFound(err))
+	_, err = n1.OwnerOrErr()
+	require.True(t, ent.IsNotLoaded(err))
+
+	n2 = n2.Update().ClearOwner().SaveX(ctx)
+	require.Empty(t, n2.OwnerType)
+	require.Empty(t, n2.OwnerID)
+	n3 = n3.Update().SetOwner(p1).SaveX(ctx)
+	owner, err = client.Note.Query().Where(note.ID(n3.ID)).WithOwner().OnlyX(ctx).OwnerOrErr()
+	require.NoError(t, err)
+	require.Equal(t, p1.ID, owner.(*ent.Pet).ID)
+}
+
+// CompositeFK tests edges that are scoped to a tenant using a composite foreign-key.
+func CompositeFK(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	inv := client.Invoice.Create().SetTenantID(1).SaveX(ctx)
+	li1 := client.LineItem.Create().SetTenantID(1).SetQuantity(1).SetInvoice(inv).SaveX(ctx)
+	require.Equal(t, inv.ID, li1.QueryInvoice().OnlyXID(ctx))
+	_, err := client.LineItem.Create().SetTenantID(2).SetQuantity(1).SetInvoice(inv).Save(ctx)
+	require.Error(t, err, "line item of a different tenant")
+
+	li2 := client.LineItem.Create().SetTenantID(1).SetQuantity(2).SaveX(ctx)
+	li3 := client.LineItem.Create().SetTenantID(2).SetQuantity(3).SaveX(ctx)
+	_, err = inv.Update().AddLineItems(li3).Save(ctx)
+	require.True(t, ent.IsConstraintError(err), "line item of a different tenant")
+	inv.Update().AddLineItems(li2).ExecX(ctx)
+	require.Equal(t, []int{li1.ID, li2.ID}, inv.QueryLineItems().Order(ent.Asc(lineitem.FieldID)).IDsX(ctx))
+	require.Equal(t, 2, client.Invoice.Query().Where(invoice.TenantID(1)).QueryLineItems().CountX(ctx))
+	require.Equal(t, inv.ID, client.LineItem.Query().Where(lineitem.ID(li2.ID)).QueryInvoice().OnlyXID(ctx))
+
+	items := client.LineItem.Query().WithInvoice().Order(ent.Asc(lineitem.FieldID)).AllX(ctx)
+	require.Len(t, items, 3)
+	require.Equal(t, inv.ID, items[0].Edges.Invoice.ID)
+	require.Equal(t, inv.ID, items[1].Edges.Invoice.ID)
+	require.Nil(t, items[2].Edges.Invoice)
+	require.Equal(t, inv.ID, client.Invoice.Query().Where(invoice.HasLineItemsWith(lineitem.QuantityGT(1))).OnlyXID(ctx))
+	require.Equal(t, []int{li1.ID, li2.ID}, client.LineItem.Query().Where(lineitem.HasInvoice()).Order(ent.Asc(lineitem.FieldID)).IDsX(ctx))
+	require.Equal(t, 2, client.LineItem.Query().Where(lineitem.HasInvoiceWith(invoice.TenantID(1))).CountX(ctx))
+	invs := client.Invoice.Query().WithLineItems().AllX(ctx)
+	require.Len(t, invs, 1)
+	require.Len(t, invs[0].Edges.LineItems, 2)
+	_, err = client.Invoice.Delete().Exec(ctx)
+	require.Error(t, err, "invoice is referenced by its line items")
+}
+
+// DecimalField tests decimal fields, with and without a custom Go type.
+func DecimalField(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	li := client.LineItem.Create().SetTenantID(10).SetQuantity(1).SaveX(ctx)
+	require.Equal(t, "0.00", li.Price, "default value")
+	require.Zero(t, li.Discount)
+	client.LineItem.CreateBulk(
+		client.LineItem.Create().SetTenantID(10).SetQuantity(1).SetPrice("10.50").SetDiscount(150),
+		client.LineItem.Create().SetTenantID(10).SetQuantity(1).SetPrice("3.25"),
+		client.LineItem.Create().SetTenantID(10).SetQuantity(1).SetPrice("100"),
+	).SaveX(ctx)
+
+	// Values are compared and ordered as numbers, and not as strings.
+	query := client.LineItem.Query().Where(lineitem.TenantID(10))
+	require.Equal(t, 2, query.Clone().Where(lineitem.PriceGT("10")).CountX(ctx))
+	require.Equal(t, 1, query.Clone().Where(lineitem.Price("10.5")).CountX(ctx))
+	items := query.Clone().Order(ent.Desc(lineitem.FieldPrice)).AllX(ctx)
+	require.Len(t, items, 4)
+	for i, p := range []float64{100, 10.5, 3.25, 0} {
+		v, err := strconv.ParseFloat(items[i].Price, 64)
+		require.NoError(t, err)
+		require.Equal(t, p, v)
+	}
+	require.Equal(t, schema.Amount(150), items[1].Discount)
+	require.Equal(t, 1, query.Clone().Where(lineitem.DiscountGTE(100)).CountX(ctx))
+	require.Equal(t, 3, query.Clone().Where(lineitem.DiscountIsNil()).CountX(ctx))
+
+	var v []struct {
+		TenantID int    `json:"tenant_id"`
+		Sum      string `json:"sum"`
+		Max      string `json:"max"`
+	}
+	query.Clone().
+		GroupBy(lineitem.FieldTenantID).
+		Aggregate(ent.Sum(lineitem.FieldPrice), ent.Max(lineitem.FieldPrice)).
+		ScanX(ctx, &v)
+	require.Len(t, v, 1)
+	sum, err := strconv.ParseFloat(v[0].Sum, 64)
+	require.NoError(t, err)
+	require.Equal(t, 113.75, sum)
+	max, err := strconv.ParseFloat(v[0].Max, 64)
+	require.NoError(t, err)
+	require.Equal(t, float64(100), max)
+
+	_, err = client.LineItem.Create().SetTenantID(10).SetQuantity(1).SetPrice("1e3").Save(ctx)
+	require.True(t, ent.IsValidationError(err))
+	_, err = li.Update().SetPrice("12345678901").Save(ctx)
+	require.True(t, ent.IsValidationError(err), "out of range for decimal(12, 2)")
+	li = li.Update().SetPrice("-0.5").SetDiscount(-25).SaveX(ctx)
+	li = client.LineItem.GetX(ctx, li.ID)
+	require.Equal(t, schema.Amount(-25), li.Discount)
+	require.Equal(t, 1, query.Clone().Where(lineitem.PriceLT("0")).CountX(ctx))
+	require.Zero(t, li.Update().ClearDiscount().SaveX(ctx).Discount)
 }
 
 func CustomID(t *testing.T, client *ent.Client) {
@@ -102,6 +439,22 @@ func CustomID(t *testing.T, client *ent.Client) {
 	require.Equal(t, lnk.ID, chd.QueryLinks().OnlyX(ctx).ID)
 	require.Equal(t, lnk.ID, blb.QueryLinks().OnlyX(ctx).ID)
 
+	// Default ids are time-ordered (UUIDv7).
+	require.Equal(t, uuid.Version(7), blb.ID.Version())
+	created := append([]*ent.Blob{blb}, client.Blob.CreateBulk(client.Blob.Create(), client.Blob.Create(), client.Blob.Create()).SaveX(ctx)...)
+	ids := make([]uuid.UUID, len(created))
+	for i := range created {
+		ids[i] = created[i].ID
+	}
+	blobs := client.Blob.Query().
+		Where(blob.IDIn(ids...)).
+		Order(ent.Desc(blob.FieldID)).
+		AllX(ctx)
+	require.Len(t, blobs, len(created))
+	for i, b := range blobs {
+		require.Equal(t, ids[len(ids)-1-i], b.ID, "ordering by id follows the creation order")
+	}
+
 	pedro := client.Pet.Create().SetID("pedro").SetOwner(a8m).SaveX(ctx)
 	require.Equal(t, a8m.ID, pedro.QueryOwner().OnlyXID(ctx))
 	require.Equal(t, pedro.ID, a8m.QueryPets().OnlyXID(ctx))
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -25,6 +29,9 @@ type Blob struct {
 	// The values are being populated by the BlobQuery when eager-loading is set.
 	Edges       BlobEdges `json:"edges"`
 	blob_parent *uuid.UUID
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // BlobEdges holds the relations/edges for other nodes in the graph.
@@ -61,49 +68,67 @@ func (e BlobEdges) LinksOrErr() ([]*Blob, error) {
 	return nil, &NotLoadedError{edge: "links"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Blob) scanValues() []interface{} {
-	return []interface{}{
-		&uuid.UUID{}, // id
-		&uuid.UUID{}, // uuid
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Blob) fkValues() []interface{} {
-	return []interface{}{
-		&uuid.UUID{}, // blob_parent
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Blob type are scanned as generic values.
+func (*Blob) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blob.FieldID:
+			values[i] = &uuid.UUID{}
+		case blob.FieldUUID:
+			values[i] = &uuid.UUID{}
+		case blob.ForeignKeys[0]: // blob_parent
+			values[i] = &uuid.UUID{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Blob fields.
-func (b *Blob) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(blob.Columns); m < n {
+// to the Blob fields. Values of columns that were not selected are left untouched.
+func (b *Blob) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	if value, ok := values[0].(*uuid.UUID); !ok {
-		return fmt.Errorf("unexpected type %T for field id", values[0])
-	} else if value != nil {
-		b.ID = *value
-	}
-	values = values[1:]
-	if value, ok := values[0].(*uuid.UUID); !ok {
-		return fmt.Errorf("unexpected type %T for field uuid", values[0])
-	} else if value != nil {
-		b.UUID = *value
-	}
-	values = values[1:]
-	if len(values) == len(blob.ForeignKeys) {
-		if value, ok := values[0].(*uuid.UUID); !ok {
-			return fmt.Errorf("unexpected type %T for field blob_parent", values[0])
-		} else if value != nil {
-			b.blob_parent = value
+	for i := range columns {
+		switch columns[i] {
+		case blob.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				b.ID = *value
+			}
+		case blob.FieldUUID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field uuid", values[i])
+			} else if value != nil {
+				b.UUID = *value
+			}
+		case blob.ForeignKeys[0]:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field blob_parent", values[i])
+			} else if value != nil {
+				b.blob_parent = value
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (b *Blob) SelectValue(alias string) (Value, error) {
+	v, ok := b.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryParent queries the parent edge of the Blob.
 func (b *Blob) QueryParent() *BlobQuery {
 	return (&BlobClient{config: b.config}).QueryParent(b)
@@ -114,6 +139,107 @@ func (b *Blob) QueryLinks() *BlobQuery {
 	return (&BlobClient{config: b.config}).QueryLinks(b)
 }
 
+// LoadEdges loads the given edges of the Blob using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see blob.Edges), and in this case, no edge is loaded.
+//
+//	err := b.LoadEdges(ctx, client, blob.EdgeParent, blob.EdgeLinks)
+//
+func (b *Blob) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadBlobEdges(ctx, client, []*Blob{b}, edges)
+}
+
+// loadBlobEdges loads the given edges of all the given Blob entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using BlobClient.LoadEdgeFor.
+func loadBlobEdges(ctx context.Context, client *Client, nodes []*Blob, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case blob.EdgeParent, blob.EdgeLinks:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Blob", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Blob.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Blob and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Blob alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Blob itself is not modified.
+//
+//	b, err := b.DumpGraph(ctx, client, 2, blob.EdgeParent)
+//
+func (b *Blob) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Blob DumpGraph", depth)
+	}
+	dumped := *b
+	dumped.Edges = BlobEdges{}
+	if err := dumpBlobs(ctx, client, []*Blob{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpBlobs loads the edges of the given Blob copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpBlobs(ctx context.Context, client *Client, nodes []*Blob, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Blob
+	for _, n := range nodes {
+		if dumper.visit(blob.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range blob.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadBlobEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextParent []*Blob
+	for _, n := range visit {
+		if e := n.Edges.Parent; e != nil {
+			c := *e
+			c.Edges = BlobEdges{}
+			n.Edges.Parent = &c
+			nextParent = append(nextParent, &c)
+		}
+	}
+	if err := dumpBlobs(ctx, client, nextParent, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextLinks []*Blob
+	for _, n := range visit {
+		for i, e := range n.Edges.Links {
+			c := *e
+			c.Edges = BlobEdges{}
+			n.Edges.Links[i] = &c
+			nextLinks = append(nextLinks, &c)
+		}
+	}
+	if err := dumpBlobs(ctx, client, nextLinks, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Blob.
 // Note that, you need to call Blob.Unwrap() before calling this method, if this Blob
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -132,6 +258,33 @@ func (b *Blob) Unwrap() *Blob {
 	return b
 }
 
+// Clone returns a deep copy of the Blob and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (b *Blob) Clone() *Blob {
+	return b.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Blob and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (b *Blob) clone(seen map[interface{}]interface{}) *Blob {
+	if b == nil {
+		return nil
+	}
+	if v, ok := seen[b]; ok {
+		return v.(*Blob)
+	}
+	_c := *b
+	seen[b] = &_c
+	_c.Edges.Parent = b.Edges.Parent.clone(seen)
+	if b.Edges.Links != nil {
+		_c.Edges.Links = make([]*Blob, len(b.Edges.Links))
+		for _i := range b.Edges.Links {
+			_c.Edges.Links[_i] = b.Edges.Links[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (b *Blob) String() string {
 	var builder strings.Builder
@@ -143,6 +296,26 @@ func (b *Blob) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Blob, for change detection and cache keys.
+// It covers the fields that are listed in blob.FingerprintFields, and it does not depend on the
+// ID of the Blob, on its edges, or on the order of the fields in the schema.
+func (b *Blob) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "uuid", b.UUID)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Blob for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Blob can be decoded using the FromMap method of its client.
+func (b *Blob) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[blob.FieldID] = kvEncode(b.ID)
+	m[blob.FieldUUID] = kvEncode(b.UUID)
+	return m
+}
+
 // Blobs is a parsable slice of Blob.
 type Blobs []*Blob
 
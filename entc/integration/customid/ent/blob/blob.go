@@ -14,6 +14,8 @@ const (
 	// Label holds the string label denoting the blob type in the database.
 	Label = "blob"
 	// FieldID holds the string denoting the id field in the database.
+	// Its default values are time-ordered, and ordering by it follows the creation order
+	// of the entities (e.g. for keyset pagination using Order(ent.Asc(FieldID))).
 	FieldID   = "id" // FieldUUID holds the string denoting the uuid vertex property in the database.
 	FieldUUID = "uuid"
 
@@ -32,6 +34,18 @@ const (
 	LinksTable = "blob_links"
 )
 
+// Edges holds all the edge names of the Blob type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeParent,
+	EdgeLinks,
+}
+
+// FingerprintFields holds the fields of the Blob type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldUUID,
+}
+
 // Columns holds all SQL columns for blob fields.
 var Columns = []string{
 	FieldID,
@@ -43,6 +57,16 @@ var ForeignKeys = []string{
 	"blob_parent",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// LinksPrimaryKey and LinksColumn2 are the table columns denoting the
 	// primary key for the links relation (M2M).
@@ -53,5 +77,6 @@ var (
 	// DefaultUUID holds the default value on creation for the uuid field.
 	DefaultUUID func() uuid.UUID
 	// DefaultID holds the default value on creation for the id field.
+	// It generates time-ordered values (UUIDv7) that follow the creation order.
 	DefaultID func() uuid.UUID
 )
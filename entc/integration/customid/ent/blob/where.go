@@ -7,6 +7,8 @@
 package blob
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
@@ -179,6 +181,63 @@ func UUIDLTE(v uuid.UUID) predicate.Blob {
 	})
 }
 
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:   "uuid",
+	FieldUUID: "uuid",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Blob.
+func FieldsEQ(f1, f2 string) predicate.Blob {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Blob.
+func FieldsNEQ(f1, f2 string) predicate.Blob {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Blob.
+func FieldsLT(f1, f2 string) predicate.Blob {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Blob.
+func FieldsLTE(f1, f2 string) predicate.Blob {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Blob.
+func FieldsGT(f1, f2 string) predicate.Blob {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Blob.
+func FieldsGTE(f1, f2 string) predicate.Blob {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Blob using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Blob", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Blob", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Blob are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Blob {
 	return predicate.Blob(func(s *sql.Selector) {
@@ -207,6 +266,45 @@ func HasParentWith(preds ...predicate.Blob) predicate.Blob {
 	})
 }
 
+// ParentIDIn applies a predicate on the "parent" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	blob.ParentIDIn(client.Blob.Query().Where(...))
+//
+func ParentIDIn(q sqlgraph.IDsQuerier) predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(ParentColumn), sub))
+	})
+}
+
+// ParentMissing applies a predicate on the "parent" edge, that checks if its foreign-key is set, but
+// there is no Blob with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE blob_parent IS NOT NULL AND NOT EXISTS (SELECT id FROM blobs WHERE id = blob_parent)
+//
+func ParentMissing() predicate.Blob {
+	return predicate.Blob(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("parent")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(ParentColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(ParentColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasLinks applies the HasEdge predicate on the "links" edge.
 func HasLinks() predicate.Blob {
 	return predicate.Blob(func(s *sql.Selector) {
@@ -242,6 +340,9 @@ func And(predicates ...predicate.Blob) predicate.Blob {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -256,6 +357,9 @@ func Or(predicates ...predicate.Blob) predicate.Blob {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -266,3 +370,43 @@ func Not(p predicate.Blob) predicate.Blob {
 		p(s.Not())
 	})
 }
+
+// BlobFilter holds optional filters on the Blob fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type BlobFilter struct {
+	IDIn       []uuid.UUID
+	UUID       *uuid.UUID
+	ParentIDIn []uuid.UUID
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Blob.Query().
+//		Where(blob.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *BlobFilter) []predicate.Blob {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Blob
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.UUID != nil {
+		ps = append(ps, UUIDEQ(*f.UUID))
+	}
+	if ids := f.ParentIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Blob(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(ParentColumn), v...))
+		}))
+	}
+	return ps
+}
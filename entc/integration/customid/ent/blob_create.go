@@ -8,8 +8,11 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/blob"
 	"github.com/facebookincubator/ent/schema/field"
@@ -71,13 +74,12 @@ func (bc *BlobCreate) AddLinks(b ...*Blob) *BlobCreate {
 
 // Save creates the Blob in the database.
 func (bc *BlobCreate) Save(ctx context.Context) (*Blob, error) {
-	if _, ok := bc.mutation.UUID(); !ok {
-		v := blob.DefaultUUID()
-		bc.mutation.SetUUID(v)
+	if err := checkTx(bc.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := bc.mutation.ID(); !ok {
-		v := blob.DefaultID()
-		bc.mutation.SetID(v)
+	bc.defaults()
+	if err := bc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -114,17 +116,135 @@ func (bc *BlobCreate) SaveX(ctx context.Context) *Blob {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (bc *BlobCreate) Unwrap() *BlobCreate {
+	tx, ok := bc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlobCreate is not a transactional builder")
+	}
+	bc.config.driver = tx.drv
+	bc.mutation.driver = tx.drv
+	return bc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (bc *BlobCreate) DebugFields() map[string]Value {
+	return debugFields(bc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BlobCreate) defaults() {
+	if _, ok := bc.mutation.UUID(); !ok {
+		v := blob.DefaultUUID()
+		bc.mutation.SetUUID(v)
+	}
+	if _, ok := bc.mutation.ID(); !ok {
+		v := blob.DefaultID()
+		bc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BlobCreate) check() error {
+	return nil
+}
+
 func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
-	var (
-		b     = &Blob{config: bc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: blob.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeUUID,
-				Column: blob.FieldID,
-			},
+	ctx = bc.withOperation(ctx, "Blob", "Create")
+	b, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	if _, ok := bc.mutation.ID(); !ok {
+		id, err := bc.scanID(_spec.ID.Value)
+		if err != nil {
+			return nil, err
+		}
+		b.ID = id
+	}
+	return b, nil
+}
+
+// SaveID creates the Blob in the database and returns only its id. Unlike Save,
+// the Blob entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Blob that holds only its id as the value that is returned by the next mutator.
+func (bc *BlobCreate) SaveID(ctx context.Context) (id uuid.UUID, err error) {
+	bc.defaults()
+	if err = bc.check(); err != nil {
+		return id, err
+	}
+	if len(bc.hooks) == 0 {
+		return bc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*BlobMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		bc.mutation = mutation
+		if id, err = bc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Blob{config: bc.config, ID: id}, nil
+	})
+	for i := len(bc.hooks) - 1; i >= 0; i-- {
+		mut = bc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, bc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (bc *BlobCreate) SaveIDX(ctx context.Context) uuid.UUID {
+	id, err := bc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (bc *BlobCreate) sqlSaveID(ctx context.Context) (id uuid.UUID, err error) {
+	ctx = bc.withOperation(ctx, "Blob", "Create")
+	_spec := bc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, bc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	if id, ok := bc.mutation.ID(); ok {
+		return id, nil
+	}
+	return bc.scanID(_spec.ID.Value)
+}
+
+// scanID converts an id that was generated by the database (as it was returned by
+// the driver) to the id type of the Blob.
+func (bc *BlobCreate) scanID(v Value) (id uuid.UUID, err error) {
+	err = id.Scan(v)
+	return id, err
+}
+
+func (bc *BlobCreate) createSpec() (*Blob, *sqlgraph.CreateSpec) {
+	b := &Blob{config: bc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: blob.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: blob.FieldID,
+		},
+	}
 	if id, ok := bc.mutation.ID(); ok {
 		b.ID = id
 		_spec.ID.Value = id
@@ -175,11 +295,469 @@ func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return b, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (bc *BlobCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: blob.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: blob.FieldID,
+		},
+	}
+	if id, ok := bc.mutation.ID(); ok {
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.UUID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: blob.FieldUUID,
+		})
+	}
+	if nodes := bc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   blob.ParentTable,
+			Columns: []string{blob.ParentColumn},
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.LinksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blob.LinksTable,
+			Columns: blob.LinksPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: blob.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// BlobCreateBulk is the builder for creating a bulk of Blob entities.
+type BlobCreateBulk struct {
+	config
+	builders []*BlobCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Blob entities in the database.
+func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
+	nodes, err := bcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && bcb.retries > 0 && isSQLDeadlockError(err) {
+		return bcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BlobCreateBulk) SaveX(ctx context.Context) []*Blob {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (bcb *BlobCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range bcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
 		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (bcb *BlobCreateBulk) CheckRefs() *BlobCreateBulk {
+	bcb.refs = true
+	return bcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (bcb *BlobCreateBulk) RetryOnDeadlock(max int) *BlobCreateBulk {
+	bcb.retries = max
+	return bcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Blob.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Blob.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (bcb *BlobCreateBulk) OnConflict(columns ...string) *BlobUpsertBulk {
+	return &BlobUpsertBulk{create: bcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Blob.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (bc *BlobCreate) OnConflict(target ...sql.ConflictOption) *BlobUpsertBulk {
+	bulk := &BlobCreateBulk{config: bc.config, builders: []*BlobCreate{bc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (bcb *BlobCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Blob, error) {
+	ctx = bcb.withOperation(ctx, "Blob", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(bcb.builders))
+		nodes    = make([]*Blob, len(bcb.builders))
+		mutators = make([]Mutator, len(bcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if bcb.refs {
+						if err := bcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, bcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					if _, ok := builder.mutation.ID(); !ok {
+						id, err := builder.scanID(specs[i].ID.Value)
+						if err != nil {
+							return nil, err
+						}
+						nodes[i].ID = id
+					}
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (bcb *BlobCreateBulk) retry(ctx context.Context, err error) ([]*Blob, error) {
+	if _, ok := bcb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	return b, nil
+	builders := bcb.builders
+	defer func() { bcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return bcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	bcb.builders = make([]*BlobCreate, len(idx))
+	for i, j := range idx {
+		bcb.builders[i] = builders[j]
+	}
+	for n := 0; n < bcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Blob
+		if sorted, err = bcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Blob, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (bcb *BlobCreateBulk) less(a, b *BlobCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (bcb *BlobCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[uuid.UUID]bool)
+		)
+		for _, builder := range bcb.builders {
+			for _, id := range builder.mutation.ParentIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []uuid.UUID
+			if err := selectIDs(ctx, bcb.driver, blob.Table, blob.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(uuid.UUID)] {
+					missing[blob.EdgeParent] = append(missing[blob.EdgeParent], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[uuid.UUID]bool)
+		)
+		for _, builder := range bcb.builders {
+			for _, id := range builder.mutation.LinksIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []uuid.UUID
+			if err := selectIDs(ctx, bcb.driver, blob.Table, blob.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(uuid.UUID)] {
+					missing[blob.EdgeLinks] = append(missing[blob.EdgeLinks], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Blob", Missing: missing}
+	}
+	return nil
+}
+
+// BlobUpsertBulk is the builder for the conflict handling of a bulk of Blob entities.
+type BlobUpsertBulk struct {
+	create  *BlobCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (bub *BlobUpsertBulk) Target(target ...sql.ConflictOption) *BlobUpsertBulk {
+	bub.target = append(bub.target, target...)
+	return bub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (bub *BlobUpsertBulk) DoNothing() *BlobUpsertBulk {
+	bub.nothing = true
+	return bub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (bub *BlobUpsertBulk) UpdateNewValues() *BlobUpsertBulk {
+	bub.update = true
+	return bub
+}
+
+// Save creates the Blob entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (bub *BlobUpsertBulk) Save(ctx context.Context) ([]*Blob, int, error) {
+	switch {
+	case !bub.nothing && !bub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Blob bulk insert")
+	case bub.nothing && bub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Blob bulk insert")
+	case bub.update:
+		if len(bub.columns) == 0 && len(bub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Blob bulk upsert")
+		}
+		nodes, err := bub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(bub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(blob.FieldID),
+			),
+			Columns:         blob.Columns,
+			ConflictColumns: bub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(bub.conflictTarget(), sql.DoNothing())}
+	nodes, err := bub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Blob, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (bub *BlobUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(bub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(bub.columns...))
+	}
+	return append(opts, bub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bub *BlobUpsertBulk) SaveX(ctx context.Context) ([]*Blob, int) {
+	nodes, skipped, err := bub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (bub *BlobUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := bub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bub *BlobUpsertBulk) ExecX(ctx context.Context) {
+	if err := bub.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
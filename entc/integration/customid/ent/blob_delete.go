@@ -23,6 +23,7 @@ type BlobDelete struct {
 	hooks      []Hook
 	mutation   *BlobMutation
 	predicates []predicate.Blob
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (bd *BlobDelete) Where(ps ...predicate.Blob) *BlobDelete {
 	return bd
 }
 
+// Limit limits the number of blobs that are deleted by the builder. For example,
+// for deleting large amounts of blobs in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (bd *BlobDelete) Limit(limit int) *BlobDelete {
+	bd.limit = &limit
+	return bd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (bd *BlobDelete) Unwrap() *BlobDelete {
+	tx, ok := bd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlobDelete is not a transactional builder")
+	}
+	bd.config.driver = tx.drv
+	bd.mutation.driver = tx.drv
+	return bd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (bd *BlobDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(bd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (bd *BlobDelete) ExecX(ctx context.Context) int {
 }
 
 func (bd *BlobDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = bd.withOperation(ctx, "Blob", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: blob.Table,
@@ -85,7 +111,10 @@ func (bd *BlobDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, bd.driver, _spec)
+	if limit := bd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, bd.mutationDriver(ctx), _spec)
 }
 
 // BlobDeleteOne is the builder for deleting a single Blob entity.
@@ -99,7 +128,7 @@ func (bdo *BlobDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{blob.Label}
 	default:
 		return nil
@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -33,6 +36,18 @@ type BlobQuery struct {
 	withParent *BlobQuery
 	withLinks  *BlobQuery
 	withFKs    bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see BlobSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -44,6 +59,19 @@ func (bq *BlobQuery) Where(ps ...predicate.Blob) *BlobQuery {
 	return bq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (bq *BlobQuery) Predicates() []predicate.Blob {
+	return append([]predicate.Blob{}, bq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (bq *BlobQuery) SetPredicates(ps ...predicate.Blob) *BlobQuery {
+	bq.predicates = append([]predicate.Blob{}, ps...)
+	return bq
+}
+
 // Limit adds a limit step to the query.
 func (bq *BlobQuery) Limit(limit int) *BlobQuery {
 	bq.limit = &limit
@@ -341,11 +369,15 @@ func (bq *BlobQuery) GroupBy(field string, fields ...string) *BlobGroupBy {
 func (bq *BlobQuery) Select(field string, fields ...string) *BlobSelect {
 	selector := &BlobSelect{config: bq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = bq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := bq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return bq.sqlQuery(), nil
+		if err := bq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery().AppendSelectExpr(bq.exprs...), nil
 	}
 	return selector
 }
@@ -362,6 +394,15 @@ func (bq *BlobQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
+	ctx = bq.withOperation(ctx, "Blob", "Query")
+	if err := bq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range bq.fields {
+		if !blob.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*Blob{}
 		withFKs     = bq.withFKs
@@ -377,12 +418,14 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, blob.ForeignKeys...)
 	}
+	_spec.Exprs = bq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Blob{config: bq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
-		if withFKs {
-			values = append(values, node.fkValues()...)
+		values := node.scanValues(columns)
+		for range bq.exprs {
+			values = append(values, new(interface{}))
 		}
 		return values
 	}
@@ -391,8 +434,16 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(bq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range bq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
 		return nil, err
@@ -402,31 +453,50 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 	}
 
 	if query := bq.withParent; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		ids := make([]uuid.UUID, 0, len(nodes))
 		nodeids := make(map[uuid.UUID][]*Blob)
 		for i := range nodes {
 			if fk := nodes[i].blob_parent; fk != nil {
-				ids = append(ids, *fk)
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
 				nodeids[*fk] = append(nodeids[*fk], nodes[i])
 			}
 		}
-		query.Where(blob.IDIn(ids...))
-		neighbors, err := query.All(ctx)
-		if err != nil {
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && bq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`ent: limit or offset of eager-loaded edge "parent" cannot be applied on %d neighbors with batch size %d`, len(ids), bq.eagerLoadSize())
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := nodeids[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "blob_parent" returned %v`, n.ID)
+		err := bq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], blob.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Parent = n
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "blob_parent" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Parent = n
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if query := bq.withLinks; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		ids := make(map[uuid.UUID]*Blob, len(nodes))
 		for _, node := range nodes {
@@ -443,9 +513,6 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 				Table:   blob.LinksTable,
 				Columns: blob.LinksPrimaryKey,
 			},
-			Predicate: func(s *sql.Selector) {
-				s.Where(sql.InValues(blob.LinksPrimaryKey[0], fks...))
-			},
 
 			ScanValues: func() [2]interface{} {
 				return [2]interface{}{&uuid.UUID{}, &uuid.UUID{}}
@@ -465,26 +532,69 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 				if !ok {
 					return fmt.Errorf("unexpected node id in edges: %v", outValue)
 				}
-				edgeids = append(edgeids, inValue)
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
 				edges[inValue] = append(edges[inValue], node)
 				return nil
 			},
 		}
-		if err := sqlgraph.QueryEdges(ctx, bq.driver, _spec); err != nil {
-			return nil, fmt.Errorf(`query edges "links": %v`, err)
-		}
-		query.Where(blob.IDIn(edgeids...))
-		neighbors, err := query.All(ctx)
+		err := bq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(blob.LinksPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, bq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "links": %v`, err)
+			}
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := edges[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected "links" node returned %v`, n.ID)
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && bq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "links" cannot be applied on %d neighbors with batch size %d`, len(edgeids), bq.eagerLoadSize())
+			return nil, err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = bq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], blob.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Links = append(nodes[i].Edges.Links, n)
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "links" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Links = append(nodes[i].Edges.Links, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return nil, err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Links
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Links = edges
 			}
 		}
 	}
@@ -492,7 +602,45 @@ func (bq *BlobQuery) sqlAll(ctx context.Context) ([]*Blob, error) {
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Blob entities (see Blob.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Blob.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Blob table.
+func (bq *BlobQuery) SelectExpr(exprs ...*sql.SelectExpr) *BlobQuery {
+	bq.exprs = append(bq.exprs, exprs...)
+	return bq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (bq *BlobQuery) checkExprs() error {
+	if len(bq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range blob.Columns {
+		columns[c] = true
+	}
+	for _, c := range blob.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range bq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Blob", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (bq *BlobQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = bq.withOperation(ctx, "Blob", "Count")
 	_spec := bq.querySpec()
 	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
 }
@@ -518,6 +666,17 @@ func (bq *BlobQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   bq.sql,
 		Unique: true,
 	}
+	if fields := bq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, blob.FieldID)
+		for i := range fields {
+			if fields[i] != blob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = bq.forUpdate
+	_spec.PartitionBy = bq.partition
 	if ps := bq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -566,11 +725,387 @@ func (bq *BlobQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Blob entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (bq *BlobQuery) IDsQuery() *sql.Selector {
+	selector := bq.sqlQuery()
+	if bq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(blob.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Blob entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Blob.Query().
+//		Where(...).
+//		CountDistinct(ctx, blob.FieldUUID)
+//
+func (bq *BlobQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = bq.withOperation(ctx, "Blob", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, bq.driver, bq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (bq *BlobQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := bq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Blob rows as generic maps that are keyed by
+// column name (see blob.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (bq *BlobQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = bq.withOperation(ctx, "Blob", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = bq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Blob{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (bq *BlobQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := bq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Blob entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (bq *BlobQuery) Prefetch(n int) *BlobQuery {
+	bq.prefetch = n
+	return bq
+}
+
+// ForUpdate locks the selected Blob rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (bq *BlobQuery) ForUpdate() *BlobQuery {
+	bq.forUpdate = true
+	return bq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (bq *BlobQuery) Primary() *BlobQuery {
+	return bq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (bq *BlobQuery) ReadConsistency(c sql.ReadConsistency) *BlobQuery {
+	bq.consistency = c
+	return bq
+}
+
+// Stream executes the query in batches, and sends the matched Blob entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Blob.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (bq *BlobQuery) Stream(ctx context.Context) (<-chan *Blob, <-chan error) {
+	size := bq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Blob, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := bq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Blob entities in batches of the given
+// size, and sends them to the given channel.
+func (bq *BlobQuery) stream(ctx context.Context, size int, nodes chan<- *Blob) error {
+	if bq.offset != nil || len(bq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *uuid.UUID
+		left = -1
+	)
+	if bq.limit != nil {
+		left = *bq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *bq
+		query.predicates = bq.predicates[:len(bq.predicates):len(bq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, blob.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(blob.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// BlobEdge is the edge representation of Blob in a connection.
+type BlobEdge struct {
+	Node   *Blob  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// BlobConnection is the connection of Blob entities that is returned by Paginate.
+type BlobConnection struct {
+	Edges      []BlobEdge `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Blob.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: blob.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (bq *BlobQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*BlobConnection, error) {
+	if bq.limit != nil || bq.offset != nil || len(bq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		return nil, fmt.Errorf("ent: unsupported order field %q for Blob", o.Field)
+	}
+	total, err := bq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: blob.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *bq
+	query.predicates = bq.predicates[:len(bq.predicates):len(bq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := bq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &BlobConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]BlobEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = BlobEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (bq *BlobQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Blob, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := bq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Blob(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*BlobQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case blob.FieldID:
+		var v uuid.UUID
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Blob", field)
+}
+
+// pageCursor returns the cursor of the Blob node for the given order fields.
+func (b *Blob) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(b.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if len(orderBy) > 0 {
+		return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Blob", orderBy[0].Field)
+	}
+	return Cursor{id: id}, nil
+}
+
 // BlobGroupBy is the builder for group-by Blob entities.
 type BlobGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -683,9 +1218,28 @@ func (bgb *BlobGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Blob.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (bgb *BlobGroupBy) Having(ps ...*sql.Predicate) *BlobGroupBy {
+	bgb.havings = append(bgb.havings, ps...)
+	return bgb
+}
+
 func (bgb *BlobGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = bgb.withOperation(ctx, "Blob", "GroupBy")
+	selector := bgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := bgb.sqlQuery().Query()
 	if err := bgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -698,15 +1252,30 @@ func (bgb *BlobGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(bgb.fields)+len(bgb.fns))
 	columns = append(columns, bgb.fields...)
 	for _, fn := range bgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(bgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
 	}
-	return selector.Select(columns...).GroupBy(bgb.fields...)
+	selector = selector.Select(columns...).GroupBy(bgb.fields...)
+	switch len(bgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(bgb.havings[0])
+	default:
+		selector.Having(sql.And(bgb.havings...))
+	}
+	return selector
 }
 
 // BlobSelect is the builder for select fields of Blob entities.
 type BlobSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *BlobQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -813,7 +1382,52 @@ func (bs *BlobSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (bs *BlobSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BlobSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (bs *BlobSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := bs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Blob entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Blob.Query().
+//		Select(blob.FieldUUID).
+//		All(ctx)
+//
+func (bs *BlobSelect) All(ctx context.Context) ([]*Blob, error) {
+	query := *bs.query
+	query.fields = bs.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bs *BlobSelect) AllX(ctx context.Context) []*Blob {
+	nodes, err := bs.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (bs *BlobSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = bs.withOperation(ctx, "Blob", "Select")
 	rows := &sql.Rows{}
 	query, args := bs.sqlQuery().Query()
 	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
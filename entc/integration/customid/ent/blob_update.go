@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -24,6 +25,9 @@ type BlobUpdate struct {
 	hooks      []Hook
 	mutation   *BlobMutation
 	predicates []predicate.Blob
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]uuid.UUID
 }
 
 // Where adds a new predicate for the builder.
@@ -32,12 +36,26 @@ func (bu *BlobUpdate) Where(ps ...predicate.Blob) *BlobUpdate {
 	return bu
 }
 
+// Limit limits the number of blobs that are updated by the builder. For example,
+// for updating large amounts of blobs in small batches without locking the whole table.
+func (bu *BlobUpdate) Limit(limit int) *BlobUpdate {
+	bu.limit = &limit
+	return bu
+}
+
 // SetUUID sets the uuid field.
 func (bu *BlobUpdate) SetUUID(u uuid.UUID) *BlobUpdate {
 	bu.mutation.SetUUID(u)
 	return bu
 }
 
+// UnsetUUID removes the changes of the uuid field from the builder (e.g. a previous call
+// to SetUUID), and therefore, the field is left unchanged in the database.
+func (bu *BlobUpdate) UnsetUUID() *BlobUpdate {
+	bu.mutation.ResetUUID()
+	return bu
+}
+
 // SetParentID sets the parent edge to Blob by id.
 func (bu *BlobUpdate) SetParentID(id uuid.UUID) *BlobUpdate {
 	bu.mutation.SetParentID(id)
@@ -93,9 +111,33 @@ func (bu *BlobUpdate) RemoveLinks(b ...*Blob) *BlobUpdate {
 	return bu.RemoveLinkIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (bu *BlobUpdate) Unwrap() *BlobUpdate {
+	tx, ok := bu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlobUpdate is not a transactional builder")
+	}
+	bu.config.driver = tx.drv
+	bu.mutation.driver = tx.drv
+	return bu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (bu *BlobUpdate) DebugFields() map[string]Value {
+	return debugFields(bu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (bu *BlobUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(bu.driver); err != nil {
+		return 0, err
+	}
+	if err := bu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -144,7 +186,16 @@ func (bu *BlobUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (bu *BlobUpdate) check() error {
+	if bu.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("ent: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	return nil
+}
+
 func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = bu.withOperation(ctx, "Blob", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   blob.Table,
@@ -162,6 +213,12 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := bu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if bu.ids != nil {
+		_spec.ScanIDs = bu.ids
+	}
 	if value, ok := bu.mutation.UUID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeUUID,
@@ -242,7 +299,7 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blob.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -253,11 +310,33 @@ func (bu *BlobUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Blob entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (bu *BlobUpdate) SaveReturningIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	bu.ids = &ids
+	defer func() { bu.ids = nil }()
+	if _, err := bu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // BlobUpdateOne is the builder for updating a single Blob entity.
 type BlobUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *BlobMutation
+	hooks      []Hook
+	mutation   *BlobMutation
+	predicates []predicate.Blob
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (buo *BlobUpdateOne) Where(ps ...predicate.Blob) *BlobUpdateOne {
+	buo.predicates = append(buo.predicates, ps...)
+	return buo
 }
 
 // SetUUID sets the uuid field.
@@ -266,6 +345,13 @@ func (buo *BlobUpdateOne) SetUUID(u uuid.UUID) *BlobUpdateOne {
 	return buo
 }
 
+// UnsetUUID removes the changes of the uuid field from the builder (e.g. a previous call
+// to SetUUID), and therefore, the field is left unchanged in the database.
+func (buo *BlobUpdateOne) UnsetUUID() *BlobUpdateOne {
+	buo.mutation.ResetUUID()
+	return buo
+}
+
 // SetParentID sets the parent edge to Blob by id.
 func (buo *BlobUpdateOne) SetParentID(id uuid.UUID) *BlobUpdateOne {
 	buo.mutation.SetParentID(id)
@@ -321,9 +407,33 @@ func (buo *BlobUpdateOne) RemoveLinks(b ...*Blob) *BlobUpdateOne {
 	return buo.RemoveLinkIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (buo *BlobUpdateOne) Unwrap() *BlobUpdateOne {
+	tx, ok := buo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlobUpdateOne is not a transactional builder")
+	}
+	buo.config.driver = tx.drv
+	buo.mutation.driver = tx.drv
+	return buo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (buo *BlobUpdateOne) DebugFields() map[string]Value {
+	return debugFields(buo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (buo *BlobUpdateOne) Save(ctx context.Context) (*Blob, error) {
-
+	if err := checkTx(buo.driver); err != nil {
+		return nil, err
+	}
+	if err := buo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Blob
@@ -372,7 +482,16 @@ func (buo *BlobUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (buo *BlobUpdateOne) check() error {
+	if buo.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("ent: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	return nil
+}
+
 func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (b *Blob, err error) {
+	ctx = buo.withOperation(ctx, "Blob", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   blob.Table,
@@ -388,6 +507,13 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (b *Blob, err error) {
 		return nil, fmt.Errorf("missing Blob.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := buo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := buo.mutation.UUID(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeUUID,
@@ -469,9 +595,11 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (b *Blob, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	b = &Blob{config: buo.config}
-	_spec.Assign = b.assignValues
-	_spec.ScanValues = b.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return b.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = b.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, buo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blob.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -479,5 +607,13 @@ func (buo *BlobUpdateOne) sqlSave(ctx context.Context) (b *Blob, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		b.ID = id
+		if value, ok := buo.mutation.UUID(); ok {
+			b.UUID = value
+		}
+	}
 	return b, nil
 }
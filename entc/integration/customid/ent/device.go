@@ -0,0 +1,189 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/device"
+	"github.com/google/uuid"
+)
+
+// Device is the model entity for the Device schema.
+type Device struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
+}
+
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Device type are scanned as generic values.
+func (*Device) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldID:
+			values[i] = &uuid.UUID{}
+		case device.FieldName:
+			values[i] = &sql.NullString{}
+		default:
+			values[i] = new(interface{})
+		}
+	}
+	return values
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Device fields. Values of columns that were not selected are left untouched.
+func (d *Device) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case device.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				d.ID = *value
+			}
+		case device.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				d.Name = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (d *Device) SelectValue(alias string) (Value, error) {
+	v, ok := d.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
+// DumpGraph returns the JSON encoding of the Device and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Device alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Device itself is not modified.
+//
+//	b, err := d.DumpGraph(ctx, client, 2)
+//
+func (d *Device) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Device DumpGraph", depth)
+	}
+	dumped := *d
+	if err := dumpDevices(ctx, client, []*Device{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpDevices loads the edges of the given Device copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpDevices(ctx context.Context, client *Client, nodes []*Device, depth int, dumper *graphDump) error {
+	return nil
+}
+
+// Update returns a builder for updating this Device.
+// Note that, you need to call Device.Unwrap() before calling this method, if this Device
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Device) Update() *DeviceUpdateOne {
+	return (&DeviceClient{config: d.config}).UpdateOne(d)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (d *Device) Unwrap() *Device {
+	tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Device is not a transactional entity")
+	}
+	d.config.driver = tx.drv
+	return d
+}
+
+// Clone returns a deep copy of the Device and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (d *Device) Clone() *Device {
+	return d.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Device and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (d *Device) clone(seen map[interface{}]interface{}) *Device {
+	if d == nil {
+		return nil
+	}
+	if v, ok := seen[d]; ok {
+		return v.(*Device)
+	}
+	_c := *d
+	seen[d] = &_c
+	return &_c
+}
+
+// String implements the fmt.Stringer.
+func (d *Device) String() string {
+	var builder strings.Builder
+	builder.WriteString("Device(")
+	builder.WriteString(fmt.Sprintf("id=%v", d.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(d.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Fingerprint returns a stable hash of the content of the Device, for change detection and cache keys.
+// It covers the fields that are listed in device.FingerprintFields, and it does not depend on the
+// ID of the Device, on its edges, or on the order of the fields in the schema.
+func (d *Device) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", d.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Device for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Device can be decoded using the FromMap method of its client.
+func (d *Device) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[device.FieldID] = kvEncode(d.ID)
+	m[device.FieldName] = kvEncode(d.Name)
+	return m
+}
+
+// Devices is a parsable slice of Device.
+type Devices []*Device
+
+func (d Devices) config(cfg config) {
+	for _i := range d {
+		d[_i].config = cfg
+	}
+}
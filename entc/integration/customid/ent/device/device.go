@@ -0,0 +1,40 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package device
+
+const (
+	// Label holds the string label denoting the device type in the database.
+	Label = "device"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID   = "id" // FieldName holds the string denoting the name vertex property in the database.
+	FieldName = "name"
+
+	// Table holds the table name of the device in the database.
+	Table = "devices"
+)
+
+// FingerprintFields holds the fields of the Device type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
+// Columns holds all SQL columns for device fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
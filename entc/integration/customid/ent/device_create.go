@@ -0,0 +1,592 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/device"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DeviceCreate is the builder for creating a Device entity.
+type DeviceCreate struct {
+	config
+	mutation *DeviceMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (dc *DeviceCreate) SetName(s string) *DeviceCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetID sets the id field.
+func (dc *DeviceCreate) SetID(u uuid.UUID) *DeviceCreate {
+	dc.mutation.SetID(u)
+	return dc
+}
+
+// Save creates the Device in the database.
+func (dc *DeviceCreate) Save(ctx context.Context) (*Device, error) {
+	if err := checkTx(dc.driver); err != nil {
+		return nil, err
+	}
+	dc.defaults()
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *Device
+	)
+	if len(dc.hooks) == 0 {
+		node, err = dc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeviceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			dc.mutation = mutation
+			node, err = dc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(dc.hooks) - 1; i >= 0; i-- {
+			mut = dc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeviceCreate) SaveX(ctx context.Context) *Device {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (dc *DeviceCreate) Unwrap() *DeviceCreate {
+	tx, ok := dc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceCreate is not a transactional builder")
+	}
+	dc.config.driver = tx.drv
+	dc.mutation.driver = tx.drv
+	return dc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (dc *DeviceCreate) DebugFields() map[string]Value {
+	return debugFields(dc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeviceCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeviceCreate) check() error {
+	if _, ok := dc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
+func (dc *DeviceCreate) sqlSave(ctx context.Context) (*Device, error) {
+	ctx = dc.withOperation(ctx, "Device", "Create")
+	d, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+
+	if _, ok := dc.mutation.ID(); !ok {
+		id, err := dc.scanID(_spec.ID.Value)
+		if err != nil {
+			return nil, err
+		}
+		d.ID = id
+	}
+	return d, nil
+}
+
+// SaveID creates the Device in the database and returns only its id. Unlike Save,
+// the Device entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Device that holds only its id as the value that is returned by the next mutator.
+func (dc *DeviceCreate) SaveID(ctx context.Context) (id uuid.UUID, err error) {
+	dc.defaults()
+	if err = dc.check(); err != nil {
+		return id, err
+	}
+	if len(dc.hooks) == 0 {
+		return dc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*DeviceMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		dc.mutation = mutation
+		if id, err = dc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Device{config: dc.config, ID: id}, nil
+	})
+	for i := len(dc.hooks) - 1; i >= 0; i-- {
+		mut = dc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, dc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (dc *DeviceCreate) SaveIDX(ctx context.Context) uuid.UUID {
+	id, err := dc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (dc *DeviceCreate) sqlSaveID(ctx context.Context) (id uuid.UUID, err error) {
+	ctx = dc.withOperation(ctx, "Device", "Create")
+	_spec := dc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, dc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	if id, ok := dc.mutation.ID(); ok {
+		return id, nil
+	}
+	return dc.scanID(_spec.ID.Value)
+}
+
+// scanID converts an id that was generated by the database (as it was returned by
+// the driver) to the id type of the Device.
+func (dc *DeviceCreate) scanID(v Value) (id uuid.UUID, err error) {
+	err = id.Scan(v)
+	return id, err
+}
+
+func (dc *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
+	d := &Device{config: dc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: device.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: device.FieldID,
+		},
+	}
+	if id, ok := dc.mutation.ID(); ok {
+		d.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: device.FieldName,
+		})
+		d.Name = value
+	}
+	return d, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (dc *DeviceCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: device.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: device.FieldID,
+		},
+	}
+	if id, ok := dc.mutation.ID(); ok {
+		_spec.ID.Value = id
+	}
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: device.FieldName,
+		})
+	}
+	return _spec
+}
+
+// DeviceCreateBulk is the builder for creating a bulk of Device entities.
+type DeviceCreateBulk struct {
+	config
+	builders []*DeviceCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Device entities in the database.
+func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
+	nodes, err := dcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && dcb.retries > 0 && isSQLDeadlockError(err) {
+		return dcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeviceCreateBulk) SaveX(ctx context.Context) []*Device {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (dcb *DeviceCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range dcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (dcb *DeviceCreateBulk) CheckRefs() *DeviceCreateBulk {
+	dcb.refs = true
+	return dcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (dcb *DeviceCreateBulk) RetryOnDeadlock(max int) *DeviceCreateBulk {
+	dcb.retries = max
+	return dcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Device.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Device.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (dcb *DeviceCreateBulk) OnConflict(columns ...string) *DeviceUpsertBulk {
+	return &DeviceUpsertBulk{create: dcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Device.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (dc *DeviceCreate) OnConflict(target ...sql.ConflictOption) *DeviceUpsertBulk {
+	bulk := &DeviceCreateBulk{config: dc.config, builders: []*DeviceCreate{dc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (dcb *DeviceCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Device, error) {
+	ctx = dcb.withOperation(ctx, "Device", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(dcb.builders))
+		nodes    = make([]*Device, len(dcb.builders))
+		mutators = make([]Mutator, len(dcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if dcb.refs {
+						if err := dcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, dcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					if _, ok := builder.mutation.ID(); !ok {
+						id, err := builder.scanID(specs[i].ID.Value)
+						if err != nil {
+							return nil, err
+						}
+						nodes[i].ID = id
+					}
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (dcb *DeviceCreateBulk) retry(ctx context.Context, err error) ([]*Device, error) {
+	if _, ok := dcb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := dcb.builders
+	defer func() { dcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return dcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	dcb.builders = make([]*DeviceCreate, len(idx))
+	for i, j := range idx {
+		dcb.builders[i] = builders[j]
+	}
+	for n := 0; n < dcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Device
+		if sorted, err = dcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Device, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (dcb *DeviceCreateBulk) less(a, b *DeviceCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (dcb *DeviceCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Device", Missing: missing}
+	}
+	return nil
+}
+
+// DeviceUpsertBulk is the builder for the conflict handling of a bulk of Device entities.
+type DeviceUpsertBulk struct {
+	create  *DeviceCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (dub *DeviceUpsertBulk) Target(target ...sql.ConflictOption) *DeviceUpsertBulk {
+	dub.target = append(dub.target, target...)
+	return dub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (dub *DeviceUpsertBulk) DoNothing() *DeviceUpsertBulk {
+	dub.nothing = true
+	return dub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (dub *DeviceUpsertBulk) UpdateNewValues() *DeviceUpsertBulk {
+	dub.update = true
+	return dub
+}
+
+// Save creates the Device entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (dub *DeviceUpsertBulk) Save(ctx context.Context) ([]*Device, int, error) {
+	switch {
+	case !dub.nothing && !dub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Device bulk insert")
+	case dub.nothing && dub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Device bulk insert")
+	case dub.update:
+		if len(dub.columns) == 0 && len(dub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Device bulk upsert")
+		}
+		nodes, err := dub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(dub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(device.FieldID),
+			),
+			Columns:         device.Columns,
+			ConflictColumns: dub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(dub.conflictTarget(), sql.DoNothing())}
+	nodes, err := dub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Device, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (dub *DeviceUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(dub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(dub.columns...))
+	}
+	return append(opts, dub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dub *DeviceUpsertBulk) SaveX(ctx context.Context) ([]*Device, int) {
+	nodes, skipped, err := dub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (dub *DeviceUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := dub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dub *DeviceUpsertBulk) ExecX(ctx context.Context) {
+	if err := dub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/device"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// DeviceDelete is the builder for deleting a Device entity.
+type DeviceDelete struct {
+	config
+	hooks      []Hook
+	mutation   *DeviceMutation
+	predicates []predicate.Device
+	limit      *int
+}
+
+// Where adds a new predicate to the delete builder.
+func (dd *DeviceDelete) Where(ps ...predicate.Device) *DeviceDelete {
+	dd.predicates = append(dd.predicates, ps...)
+	return dd
+}
+
+// Limit limits the number of devices that are deleted by the builder. For example,
+// for deleting large amounts of devices in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (dd *DeviceDelete) Limit(limit int) *DeviceDelete {
+	dd.limit = &limit
+	return dd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (dd *DeviceDelete) Unwrap() *DeviceDelete {
+	tx, ok := dd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceDelete is not a transactional builder")
+	}
+	dd.config.driver = tx.drv
+	dd.mutation.driver = tx.drv
+	return dd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dd *DeviceDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(dd.driver); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(dd.hooks) == 0 {
+		affected, err = dd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeviceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			dd.mutation = mutation
+			affected, err = dd.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(dd.hooks) - 1; i >= 0; i-- {
+			mut = dd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, dd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dd *DeviceDelete) ExecX(ctx context.Context) int {
+	n, err := dd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dd *DeviceDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = dd.withOperation(ctx, "Device", "Delete")
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: device.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: device.FieldID,
+			},
+		},
+	}
+	if ps := dd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, dd.mutationDriver(ctx), _spec)
+}
+
+// DeviceDeleteOne is the builder for deleting a single Device entity.
+type DeviceDeleteOne struct {
+	dd *DeviceDelete
+}
+
+// Exec executes the deletion query.
+func (ddo *DeviceDeleteOne) Exec(ctx context.Context) error {
+	n, err := ddo.dd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0 && !sql.IsDryRun(ctx):
+		return &NotFoundError{device.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ddo *DeviceDeleteOne) ExecX(ctx context.Context) {
+	ddo.dd.ExecX(ctx)
+}
@@ -0,0 +1,1242 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/device"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DeviceQuery is the builder for querying Device entities.
+type DeviceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Device
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see DeviceSelect.All).
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (dq *DeviceQuery) Where(ps ...predicate.Device) *DeviceQuery {
+	dq.predicates = append(dq.predicates, ps...)
+	return dq
+}
+
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (dq *DeviceQuery) Predicates() []predicate.Device {
+	return append([]predicate.Device{}, dq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (dq *DeviceQuery) SetPredicates(ps ...predicate.Device) *DeviceQuery {
+	dq.predicates = append([]predicate.Device{}, ps...)
+	return dq
+}
+
+// Limit adds a limit step to the query.
+func (dq *DeviceQuery) Limit(limit int) *DeviceQuery {
+	dq.limit = &limit
+	return dq
+}
+
+// Offset adds an offset step to the query.
+func (dq *DeviceQuery) Offset(offset int) *DeviceQuery {
+	dq.offset = &offset
+	return dq
+}
+
+// Order adds an order step to the query.
+func (dq *DeviceQuery) Order(o ...OrderFunc) *DeviceQuery {
+	dq.order = append(dq.order, o...)
+	return dq
+}
+
+// First returns the first Device entity in the query. Returns *NotFoundError when no device was found.
+func (dq *DeviceQuery) First(ctx context.Context) (*Device, error) {
+	ds, err := dq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ds) == 0 {
+		return nil, &NotFoundError{device.Label}
+	}
+	return ds[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dq *DeviceQuery) FirstX(ctx context.Context) *Device {
+	d, err := dq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return d
+}
+
+// FirstID returns the first Device id in the query. Returns *NotFoundError when no id was found.
+func (dq *DeviceQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{device.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (dq *DeviceQuery) FirstXID(ctx context.Context) uuid.UUID {
+	id, err := dq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Device entity in the query, returns an error if not exactly one entity was returned.
+func (dq *DeviceQuery) Only(ctx context.Context) (*Device, error) {
+	ds, err := dq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(ds) {
+	case 1:
+		return ds[0], nil
+	case 0:
+		return nil, &NotFoundError{device.Label}
+	default:
+		return nil, &NotSingularError{device.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dq *DeviceQuery) OnlyX(ctx context.Context) *Device {
+	d, err := dq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// OnlyID returns the only Device id in the query, returns an error if not exactly one id was returned.
+func (dq *DeviceQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{device.Label}
+	default:
+		err = &NotSingularError{device.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (dq *DeviceQuery) OnlyXID(ctx context.Context) uuid.UUID {
+	id, err := dq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Devices.
+func (dq *DeviceQuery) All(ctx context.Context) ([]*Device, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return dq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dq *DeviceQuery) AllX(ctx context.Context) []*Device {
+	ds, err := dq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ds
+}
+
+// IDs executes the query and returns a list of Device ids.
+func (dq *DeviceQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := dq.Select(device.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dq *DeviceQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dq *DeviceQuery) Count(ctx context.Context) (int, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return dq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dq *DeviceQuery) CountX(ctx context.Context) int {
+	count, err := dq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dq *DeviceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return dq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dq *DeviceQuery) ExistX(ctx context.Context) bool {
+	exist, err := dq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dq *DeviceQuery) Clone() *DeviceQuery {
+	return &DeviceQuery{
+		config:     dq.config,
+		limit:      dq.limit,
+		offset:     dq.offset,
+		order:      append([]OrderFunc{}, dq.order...),
+		unique:     append([]string{}, dq.unique...),
+		predicates: append([]predicate.Device{}, dq.predicates...),
+		// clone intermediate query.
+		sql:  dq.sql.Clone(),
+		path: dq.path,
+	}
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Device.Query().
+//		GroupBy(device.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (dq *DeviceQuery) GroupBy(field string, fields ...string) *DeviceGroupBy {
+	group := &DeviceGroupBy{config: dq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return dq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Device.Query().
+//		Select(device.FieldName).
+//		Scan(ctx, &v)
+//
+func (dq *DeviceQuery) Select(field string, fields ...string) *DeviceSelect {
+	selector := &DeviceSelect{config: dq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.query = dq
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := dq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if err := dq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return dq.sqlQuery().AppendSelectExpr(dq.exprs...), nil
+	}
+	return selector
+}
+
+func (dq *DeviceQuery) prepareQuery(ctx context.Context) error {
+	if dq.path != nil {
+		prev, err := dq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dq.sql = prev
+	}
+	return nil
+}
+
+func (dq *DeviceQuery) sqlAll(ctx context.Context) ([]*Device, error) {
+	ctx = dq.withOperation(ctx, "Device", "Query")
+	if err := dq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range dq.fields {
+		if !device.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
+	var (
+		nodes = []*Device{}
+		_spec = dq.querySpec()
+	)
+	_spec.Exprs = dq.exprs
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		node := &Device{config: dq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues(columns)
+		for range dq.exprs {
+			values = append(values, new(interface{}))
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		if n := len(dq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range dq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Device entities (see Device.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Device.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Device table.
+func (dq *DeviceQuery) SelectExpr(exprs ...*sql.SelectExpr) *DeviceQuery {
+	dq.exprs = append(dq.exprs, exprs...)
+	return dq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (dq *DeviceQuery) checkExprs() error {
+	if len(dq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range device.Columns {
+		columns[c] = true
+	}
+	for _, e := range dq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Device", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
+func (dq *DeviceQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = dq.withOperation(ctx, "Device", "Count")
+	_spec := dq.querySpec()
+	return sqlgraph.CountNodes(ctx, dq.driver, _spec)
+}
+
+func (dq *DeviceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := dq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (dq *DeviceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   device.Table,
+			Columns: device.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: device.FieldID,
+			},
+		},
+		From:   dq.sql,
+		Unique: true,
+	}
+	if fields := dq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, device.FieldID)
+		for i := range fields {
+			if fields[i] != device.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = dq.forUpdate
+	_spec.PartitionBy = dq.partition
+	if ps := dq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dq *DeviceQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(dq.driver.Dialect())
+	t1 := builder.Table(device.Table)
+	selector := builder.Select(t1.Columns(device.Columns...)...).From(t1)
+	if dq.sql != nil {
+		selector = dq.sql
+		selector.Select(selector.Columns(device.Columns...)...)
+	}
+	for _, p := range dq.predicates {
+		p(selector)
+	}
+	for _, p := range dq.order {
+		p(selector)
+	}
+	if offset := dq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IDsQuery returns a selector that selects the ids of the Device entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (dq *DeviceQuery) IDsQuery() *sql.Selector {
+	selector := dq.sqlQuery()
+	if dq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(device.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Device entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Device.Query().
+//		Where(...).
+//		CountDistinct(ctx, device.FieldName)
+//
+func (dq *DeviceQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = dq.withOperation(ctx, "Device", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, dq.driver, dq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (dq *DeviceQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := dq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Device rows as generic maps that are keyed by
+// column name (see device.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (dq *DeviceQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := dq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = dq.withOperation(ctx, "Device", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = dq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Device{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, dq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (dq *DeviceQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := dq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Device entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (dq *DeviceQuery) Prefetch(n int) *DeviceQuery {
+	dq.prefetch = n
+	return dq
+}
+
+// ForUpdate locks the selected Device rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (dq *DeviceQuery) ForUpdate() *DeviceQuery {
+	dq.forUpdate = true
+	return dq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (dq *DeviceQuery) Primary() *DeviceQuery {
+	return dq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (dq *DeviceQuery) ReadConsistency(c sql.ReadConsistency) *DeviceQuery {
+	dq.consistency = c
+	return dq
+}
+
+// Stream executes the query in batches, and sends the matched Device entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Device.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (dq *DeviceQuery) Stream(ctx context.Context) (<-chan *Device, <-chan error) {
+	size := dq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Device, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := dq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Device entities in batches of the given
+// size, and sends them to the given channel.
+func (dq *DeviceQuery) stream(ctx context.Context, size int, nodes chan<- *Device) error {
+	if dq.offset != nil || len(dq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *uuid.UUID
+		left = -1
+	)
+	if dq.limit != nil {
+		left = *dq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *dq
+		query.predicates = dq.predicates[:len(dq.predicates):len(dq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, device.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(device.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// DeviceEdge is the edge representation of Device in a connection.
+type DeviceEdge struct {
+	Node   *Device `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// DeviceConnection is the connection of Device entities that is returned by Paginate.
+type DeviceConnection struct {
+	Edges      []DeviceEdge `json:"edges"`
+	PageInfo   PageInfo     `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Device.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: device.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (dq *DeviceQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*DeviceConnection, error) {
+	if dq.limit != nil || dq.offset != nil || len(dq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case device.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for Device", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := dq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: device.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *dq
+	query.predicates = dq.predicates[:len(dq.predicates):len(dq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := dq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &DeviceConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]DeviceEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = DeviceEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (dq *DeviceQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Device, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := dq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Device(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*DeviceQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case device.FieldName:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field name: %v", err)
+		}
+		return v, nil
+	case device.FieldID:
+		var v uuid.UUID
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Device", field)
+}
+
+// pageCursor returns the cursor of the Device node for the given order fields.
+func (d *Device) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(d.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case device.FieldName:
+			v = d.Name
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Device", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
+// DeviceGroupBy is the builder for group-by Device entities.
+type DeviceGroupBy struct {
+	config
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dgb *DeviceGroupBy) Aggregate(fns ...AggregateFunc) *DeviceGroupBy {
+	dgb.fns = append(dgb.fns, fns...)
+	return dgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (dgb *DeviceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := dgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	dgb.sql = query
+	return dgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (dgb *DeviceGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := dgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (dgb *DeviceGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DeviceGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (dgb *DeviceGroupBy) StringsX(ctx context.Context) []string {
+	v, err := dgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (dgb *DeviceGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DeviceGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (dgb *DeviceGroupBy) IntsX(ctx context.Context) []int {
+	v, err := dgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (dgb *DeviceGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DeviceGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (dgb *DeviceGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := dgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (dgb *DeviceGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(dgb.fields) > 1 {
+		return nil, errors.New("ent: DeviceGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := dgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (dgb *DeviceGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := dgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Device.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (dgb *DeviceGroupBy) Having(ps ...*sql.Predicate) *DeviceGroupBy {
+	dgb.havings = append(dgb.havings, ps...)
+	return dgb
+}
+
+func (dgb *DeviceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = dgb.withOperation(ctx, "Device", "GroupBy")
+	selector := dgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	if err := dgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (dgb *DeviceGroupBy) sqlQuery() *sql.Selector {
+	selector := dgb.sql
+	columns := make([]string, 0, len(dgb.fields)+len(dgb.fns))
+	columns = append(columns, dgb.fields...)
+	for _, fn := range dgb.fns {
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(dgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(dgb.fields...)
+	switch len(dgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(dgb.havings[0])
+	default:
+		selector.Having(sql.And(dgb.havings...))
+	}
+	return selector
+}
+
+// DeviceSelect is the builder for select fields of Device entities.
+type DeviceSelect struct {
+	config
+	fields []string
+	// query is the query that the fields are selected from.
+	query *DeviceQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (ds *DeviceSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := ds.path(ctx)
+	if err != nil {
+		return err
+	}
+	ds.sql = query
+	return ds.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ds *DeviceSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ds.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (ds *DeviceSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DeviceSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ds *DeviceSelect) StringsX(ctx context.Context) []string {
+	v, err := ds.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (ds *DeviceSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DeviceSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ds *DeviceSelect) IntsX(ctx context.Context) []int {
+	v, err := ds.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (ds *DeviceSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DeviceSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ds *DeviceSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ds.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (ds *DeviceSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DeviceSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ds *DeviceSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ds.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ds *DeviceSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ds.fields) > 1 {
+		return nil, errors.New("ent: DeviceSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ds.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ds *DeviceSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ds.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Device entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Device.Query().
+//		Select(device.FieldName).
+//		All(ctx)
+//
+func (ds *DeviceSelect) All(ctx context.Context) ([]*Device, error) {
+	query := *ds.query
+	query.fields = ds.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ds *DeviceSelect) AllX(ctx context.Context) []*Device {
+	nodes, err := ds.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+func (ds *DeviceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ds.withOperation(ctx, "Device", "Select")
+	rows := &sql.Rows{}
+	query, args := ds.sqlQuery().Query()
+	if err := ds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ds *DeviceSelect) sqlQuery() sql.Querier {
+	selector := ds.sql
+	selector.Select(selector.Columns(ds.fields...)...)
+	return selector
+}
@@ -0,0 +1,356 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/device"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DeviceUpdate is the builder for updating Device entities.
+type DeviceUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *DeviceMutation
+	predicates []predicate.Device
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]uuid.UUID
+}
+
+// Where adds a new predicate for the builder.
+func (du *DeviceUpdate) Where(ps ...predicate.Device) *DeviceUpdate {
+	du.predicates = append(du.predicates, ps...)
+	return du
+}
+
+// Limit limits the number of devices that are updated by the builder. For example,
+// for updating large amounts of devices in small batches without locking the whole table.
+func (du *DeviceUpdate) Limit(limit int) *DeviceUpdate {
+	du.limit = &limit
+	return du
+}
+
+// SetName sets the name field.
+func (du *DeviceUpdate) SetName(s string) *DeviceUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (du *DeviceUpdate) UnsetName() *DeviceUpdate {
+	du.mutation.ResetName()
+	return du
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (du *DeviceUpdate) Unwrap() *DeviceUpdate {
+	tx, ok := du.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceUpdate is not a transactional builder")
+	}
+	du.config.driver = tx.drv
+	du.mutation.driver = tx.drv
+	return du
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (du *DeviceUpdate) DebugFields() map[string]Value {
+	return debugFields(du.mutation)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (du *DeviceUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(du.driver); err != nil {
+		return 0, err
+	}
+	if err := du.check(); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(du.hooks) == 0 {
+		affected, err = du.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeviceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			du.mutation = mutation
+			affected, err = du.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(du.hooks) - 1; i >= 0; i-- {
+			mut = du.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, du.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeviceUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeviceUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DeviceUpdate) check() error {
+	return nil
+}
+
+func (du *DeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = du.withOperation(ctx, "Device", "Update")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   device.Table,
+			Columns: device.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: device.FieldID,
+			},
+		},
+	}
+	if ps := du.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := du.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if du.ids != nil {
+		_spec.ScanIDs = du.ids
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: device.FieldName,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, du.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SaveReturningIDs executes the query and returns the ids of the Device entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (du *DeviceUpdate) SaveReturningIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	du.ids = &ids
+	defer func() { du.ids = nil }()
+	if _, err := du.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// DeviceUpdateOne is the builder for updating a single Device entity.
+type DeviceUpdateOne struct {
+	config
+	hooks      []Hook
+	mutation   *DeviceMutation
+	predicates []predicate.Device
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (duo *DeviceUpdateOne) Where(ps ...predicate.Device) *DeviceUpdateOne {
+	duo.predicates = append(duo.predicates, ps...)
+	return duo
+}
+
+// SetName sets the name field.
+func (duo *DeviceUpdateOne) SetName(s string) *DeviceUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (duo *DeviceUpdateOne) UnsetName() *DeviceUpdateOne {
+	duo.mutation.ResetName()
+	return duo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (duo *DeviceUpdateOne) Unwrap() *DeviceUpdateOne {
+	tx, ok := duo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceUpdateOne is not a transactional builder")
+	}
+	duo.config.driver = tx.drv
+	duo.mutation.driver = tx.drv
+	return duo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (duo *DeviceUpdateOne) DebugFields() map[string]Value {
+	return debugFields(duo.mutation)
+}
+
+// Save executes the query and returns the updated entity.
+func (duo *DeviceUpdateOne) Save(ctx context.Context) (*Device, error) {
+	if err := checkTx(duo.driver); err != nil {
+		return nil, err
+	}
+	if err := duo.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *Device
+	)
+	if len(duo.hooks) == 0 {
+		node, err = duo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*DeviceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			duo.mutation = mutation
+			node, err = duo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(duo.hooks) - 1; i >= 0; i-- {
+			mut = duo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, duo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeviceUpdateOne) SaveX(ctx context.Context) *Device {
+	d, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// Exec executes the query on the entity.
+func (duo *DeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DeviceUpdateOne) check() error {
+	return nil
+}
+
+func (duo *DeviceUpdateOne) sqlSave(ctx context.Context) (d *Device, err error) {
+	ctx = duo.withOperation(ctx, "Device", "UpdateOne")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   device.Table,
+			Columns: device.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: device.FieldID,
+			},
+		},
+	}
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Device.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if ps := duo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: device.FieldName,
+		})
+	}
+	d = &Device{config: duo.config}
+	_spec.Assign = func(values ...interface{}) error {
+		return d.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = d.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, duo.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{device.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		d.ID = id
+		if value, ok := duo.mutation.Name(); ok {
+			d.Name = value
+		}
+	}
+	return d, nil
+}
@@ -130,6 +130,9 @@ func And(predicates ...predicate.Group) predicate.Group {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -144,6 +147,9 @@ func Or(predicates ...predicate.Group) predicate.Group {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -154,3 +160,29 @@ func Not(p predicate.Group) predicate.Group {
 		p(s.Not())
 	})
 }
+
+// GroupFilter holds optional filters on the Group fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type GroupFilter struct {
+	IDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Group.Query().
+//		Where(group.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *GroupFilter) []predicate.Group {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Group
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	return ps
+}
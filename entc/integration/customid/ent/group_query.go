@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -31,6 +34,18 @@ type GroupQuery struct {
 	predicates []predicate.Group
 	// eager-loading edges.
 	withUsers *UserQuery
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see GroupSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -42,6 +57,19 @@ func (gq *GroupQuery) Where(ps ...predicate.Group) *GroupQuery {
 	return gq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (gq *GroupQuery) Predicates() []predicate.Group {
+	return append([]predicate.Group{}, gq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (gq *GroupQuery) SetPredicates(ps ...predicate.Group) *GroupQuery {
+	gq.predicates = append([]predicate.Group{}, ps...)
+	return gq
+}
+
 // Limit adds a limit step to the query.
 func (gq *GroupQuery) Limit(limit int) *GroupQuery {
 	gq.limit = &limit
@@ -286,11 +314,15 @@ func (gq *GroupQuery) GroupBy(field string, fields ...string) *GroupGroupBy {
 func (gq *GroupQuery) Select(field string, fields ...string) *GroupSelect {
 	selector := &GroupSelect{config: gq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = gq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return gq.sqlQuery(), nil
+		if err := gq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return gq.sqlQuery().AppendSelectExpr(gq.exprs...), nil
 	}
 	return selector
 }
@@ -307,6 +339,15 @@ func (gq *GroupQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
+	ctx = gq.withOperation(ctx, "Group", "Query")
+	if err := gq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range gq.fields {
+		if !group.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*Group{}
 		_spec       = gq.querySpec()
@@ -314,10 +355,15 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 			gq.withUsers != nil,
 		}
 	)
+	_spec.Exprs = gq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Group{config: gq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
+		values := node.scanValues(columns)
+		for range gq.exprs {
+			values = append(values, new(interface{}))
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -325,8 +371,16 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(gq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range gq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
 		return nil, err
@@ -336,6 +390,9 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	}
 
 	if query := gq.withUsers; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		ids := make(map[int]*Group, len(nodes))
 		for _, node := range nodes {
@@ -352,9 +409,6 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 				Table:   group.UsersTable,
 				Columns: group.UsersPrimaryKey,
 			},
-			Predicate: func(s *sql.Selector) {
-				s.Where(sql.InValues(group.UsersPrimaryKey[0], fks...))
-			},
 
 			ScanValues: func() [2]interface{} {
 				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
@@ -374,26 +428,69 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 				if !ok {
 					return fmt.Errorf("unexpected node id in edges: %v", outValue)
 				}
-				edgeids = append(edgeids, inValue)
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
 				edges[inValue] = append(edges[inValue], node)
 				return nil
 			},
 		}
-		if err := sqlgraph.QueryEdges(ctx, gq.driver, _spec); err != nil {
-			return nil, fmt.Errorf(`query edges "users": %v`, err)
-		}
-		query.Where(user.IDIn(edgeids...))
-		neighbors, err := query.All(ctx)
+		err := gq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(group.UsersPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, gq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "users": %v`, err)
+			}
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := edges[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected "users" node returned %v`, n.ID)
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && gq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "users" cannot be applied on %d neighbors with batch size %d`, len(edgeids), gq.eagerLoadSize())
+			return nil, err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = gq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Users = append(nodes[i].Edges.Users, n)
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "users" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Users = append(nodes[i].Edges.Users, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return nil, err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Users
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Users = edges
 			}
 		}
 	}
@@ -401,7 +498,42 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Group entities (see Group.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Group.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Group table.
+func (gq *GroupQuery) SelectExpr(exprs ...*sql.SelectExpr) *GroupQuery {
+	gq.exprs = append(gq.exprs, exprs...)
+	return gq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (gq *GroupQuery) checkExprs() error {
+	if len(gq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range group.Columns {
+		columns[c] = true
+	}
+	for _, e := range gq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Group", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = gq.withOperation(ctx, "Group", "Count")
 	_spec := gq.querySpec()
 	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
 }
@@ -427,6 +559,17 @@ func (gq *GroupQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   gq.sql,
 		Unique: true,
 	}
+	if fields := gq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, group.FieldID)
+		for i := range fields {
+			if fields[i] != group.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = gq.forUpdate
+	_spec.PartitionBy = gq.partition
 	if ps := gq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -475,11 +618,387 @@ func (gq *GroupQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Group entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (gq *GroupQuery) IDsQuery() *sql.Selector {
+	selector := gq.sqlQuery()
+	if gq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(group.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Group entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Group.Query().
+//		Where(...).
+//		CountDistinct(ctx, group.FieldID)
+//
+func (gq *GroupQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = gq.withOperation(ctx, "Group", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, gq.driver, gq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (gq *GroupQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := gq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Group rows as generic maps that are keyed by
+// column name (see group.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (gq *GroupQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = gq.withOperation(ctx, "Group", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = gq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Group{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (gq *GroupQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := gq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Group entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (gq *GroupQuery) Prefetch(n int) *GroupQuery {
+	gq.prefetch = n
+	return gq
+}
+
+// ForUpdate locks the selected Group rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (gq *GroupQuery) ForUpdate() *GroupQuery {
+	gq.forUpdate = true
+	return gq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (gq *GroupQuery) Primary() *GroupQuery {
+	return gq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (gq *GroupQuery) ReadConsistency(c sql.ReadConsistency) *GroupQuery {
+	gq.consistency = c
+	return gq
+}
+
+// Stream executes the query in batches, and sends the matched Group entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Group.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (gq *GroupQuery) Stream(ctx context.Context) (<-chan *Group, <-chan error) {
+	size := gq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Group, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := gq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Group entities in batches of the given
+// size, and sends them to the given channel.
+func (gq *GroupQuery) stream(ctx context.Context, size int, nodes chan<- *Group) error {
+	if gq.offset != nil || len(gq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if gq.limit != nil {
+		left = *gq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *gq
+		query.predicates = gq.predicates[:len(gq.predicates):len(gq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, group.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(group.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// GroupEdge is the edge representation of Group in a connection.
+type GroupEdge struct {
+	Node   *Group `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// GroupConnection is the connection of Group entities that is returned by Paginate.
+type GroupConnection struct {
+	Edges      []GroupEdge `json:"edges"`
+	PageInfo   PageInfo    `json:"pageInfo"`
+	TotalCount int         `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Group.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: group.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (gq *GroupQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*GroupConnection, error) {
+	if gq.limit != nil || gq.offset != nil || len(gq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		return nil, fmt.Errorf("ent: unsupported order field %q for Group", o.Field)
+	}
+	total, err := gq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: group.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *gq
+	query.predicates = gq.predicates[:len(gq.predicates):len(gq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := gq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &GroupConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]GroupEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = GroupEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (gq *GroupQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Group, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := gq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Group(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*GroupQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case group.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Group", field)
+}
+
+// pageCursor returns the cursor of the Group node for the given order fields.
+func (gr *Group) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(gr.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if len(orderBy) > 0 {
+		return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Group", orderBy[0].Field)
+	}
+	return Cursor{id: id}, nil
+}
+
 // GroupGroupBy is the builder for group-by Group entities.
 type GroupGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -592,9 +1111,28 @@ func (ggb *GroupGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Group.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (ggb *GroupGroupBy) Having(ps ...*sql.Predicate) *GroupGroupBy {
+	ggb.havings = append(ggb.havings, ps...)
+	return ggb
+}
+
 func (ggb *GroupGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ggb.withOperation(ctx, "Group", "GroupBy")
+	selector := ggb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := ggb.sqlQuery().Query()
 	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -607,15 +1145,30 @@ func (ggb *GroupGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(ggb.fields)+len(ggb.fns))
 	columns = append(columns, ggb.fields...)
 	for _, fn := range ggb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(ggb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(ggb.fields...)
+	switch len(ggb.havings) {
+	case 0:
+	case 1:
+		selector.Having(ggb.havings[0])
+	default:
+		selector.Having(sql.And(ggb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(ggb.fields...)
+	return selector
 }
 
 // GroupSelect is the builder for select fields of Group entities.
 type GroupSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *GroupQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -722,7 +1275,47 @@ func (gs *GroupSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (gs *GroupSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(gs.fields) > 1 {
+		return nil, errors.New("ent: GroupSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := gs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (gs *GroupSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := gs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Group entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+func (gs *GroupSelect) All(ctx context.Context) ([]*Group, error) {
+	query := *gs.query
+	query.fields = gs.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gs *GroupSelect) AllX(ctx context.Context) []*Group {
+	nodes, err := gs.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (gs *GroupSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = gs.withOperation(ctx, "Group", "Select")
 	rows := &sql.Rows{}
 	query, args := gs.sqlQuery().Query()
 	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
@@ -24,6 +24,9 @@ type GroupUpdate struct {
 	hooks      []Hook
 	mutation   *GroupMutation
 	predicates []predicate.Group
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,6 +35,13 @@ func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
 	return gu
 }
 
+// Limit limits the number of groups that are updated by the builder. For example,
+// for updating large amounts of groups in small batches without locking the whole table.
+func (gu *GroupUpdate) Limit(limit int) *GroupUpdate {
+	gu.limit = &limit
+	return gu
+}
+
 // AddUserIDs adds the users edge to User by ids.
 func (gu *GroupUpdate) AddUserIDs(ids ...int) *GroupUpdate {
 	gu.mutation.AddUserIDs(ids...)
@@ -62,9 +72,33 @@ func (gu *GroupUpdate) RemoveUsers(u ...*User) *GroupUpdate {
 	return gu.RemoveUserIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gu *GroupUpdate) Unwrap() *GroupUpdate {
+	tx, ok := gu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupUpdate is not a transactional builder")
+	}
+	gu.config.driver = tx.drv
+	gu.mutation.driver = tx.drv
+	return gu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (gu *GroupUpdate) DebugFields() map[string]Value {
+	return debugFields(gu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(gu.driver); err != nil {
+		return 0, err
+	}
+	if err := gu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -113,7 +147,13 @@ func (gu *GroupUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (gu *GroupUpdate) check() error {
+	return nil
+}
+
 func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = gu.withOperation(ctx, "Group", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   group.Table,
@@ -131,6 +171,12 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := gu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if gu.ids != nil {
+		_spec.ScanIDs = gu.ids
+	}
 	if nodes := gu.mutation.RemovedUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -169,7 +215,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -180,11 +226,33 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Group entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (gu *GroupUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	gu.ids = &ids
+	defer func() { gu.ids = nil }()
+	if _, err := gu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *GroupMutation
+	hooks      []Hook
+	mutation   *GroupMutation
+	predicates []predicate.Group
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.predicates = append(guo.predicates, ps...)
+	return guo
 }
 
 // AddUserIDs adds the users edge to User by ids.
@@ -217,9 +285,33 @@ func (guo *GroupUpdateOne) RemoveUsers(u ...*User) *GroupUpdateOne {
 	return guo.RemoveUserIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (guo *GroupUpdateOne) Unwrap() *GroupUpdateOne {
+	tx, ok := guo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupUpdateOne is not a transactional builder")
+	}
+	guo.config.driver = tx.drv
+	guo.mutation.driver = tx.drv
+	return guo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (guo *GroupUpdateOne) DebugFields() map[string]Value {
+	return debugFields(guo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
-
+	if err := checkTx(guo.driver); err != nil {
+		return nil, err
+	}
+	if err := guo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Group
@@ -268,7 +360,13 @@ func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (guo *GroupUpdateOne) check() error {
+	return nil
+}
+
 func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
+	ctx = guo.withOperation(ctx, "Group", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   group.Table,
@@ -284,6 +382,13 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		return nil, fmt.Errorf("missing Group.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := guo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if nodes := guo.mutation.RemovedUsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -323,9 +428,11 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	gr = &Group{config: guo.config}
-	_spec.Assign = gr.assignValues
-	_spec.ScanValues = gr.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return gr.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = gr.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, guo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -333,5 +440,10 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		gr.ID = id
+	}
 	return gr, nil
 }
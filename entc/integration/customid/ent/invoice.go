@@ -0,0 +1,283 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+)
+
+// Invoice is the model entity for the Invoice schema.
+type Invoice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int `json:"tenant_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the InvoiceQuery when eager-loading is set.
+	Edges InvoiceEdges `json:"edges"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
+}
+
+// InvoiceEdges holds the relations/edges for other nodes in the graph.
+type InvoiceEdges struct {
+	// LineItems holds the value of the line_items edge.
+	LineItems []*LineItem
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// LineItemsOrErr returns the LineItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e InvoiceEdges) LineItemsOrErr() ([]*LineItem, error) {
+	if e.loadedTypes[0] {
+		return e.LineItems, nil
+	}
+	return nil, &NotLoadedError{edge: "line_items"}
+}
+
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Invoice type are scanned as generic values.
+func (*Invoice) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case invoice.FieldID:
+			values[i] = &sql.NullInt64{}
+		case invoice.FieldTenantID:
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
+	}
+	return values
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Invoice fields. Values of columns that were not selected are left untouched.
+func (i *Invoice) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case invoice.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case invoice.FieldTenantID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[j])
+			} else if value.Valid {
+				i.TenantID = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (i *Invoice) SelectValue(alias string) (Value, error) {
+	v, ok := i.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
+// QueryLineItems queries the line_items edge of the Invoice.
+func (i *Invoice) QueryLineItems() *LineItemQuery {
+	return (&InvoiceClient{config: i.config}).QueryLineItems(i)
+}
+
+// LoadEdges loads the given edges of the Invoice using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see invoice.Edges), and in this case, no edge is loaded.
+//
+//	err := i.LoadEdges(ctx, client, invoice.EdgeLineItems)
+//
+func (i *Invoice) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadInvoiceEdges(ctx, client, []*Invoice{i}, edges)
+}
+
+// loadInvoiceEdges loads the given edges of all the given Invoice entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using InvoiceClient.LoadEdgeFor.
+func loadInvoiceEdges(ctx context.Context, client *Client, nodes []*Invoice, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case invoice.EdgeLineItems:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Invoice", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Invoice.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Invoice and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Invoice alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Invoice itself is not modified.
+//
+//	b, err := i.DumpGraph(ctx, client, 2, invoice.EdgeLineItems)
+//
+func (i *Invoice) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Invoice DumpGraph", depth)
+	}
+	dumped := *i
+	dumped.Edges = InvoiceEdges{}
+	if err := dumpInvoices(ctx, client, []*Invoice{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpInvoices loads the edges of the given Invoice copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpInvoices(ctx context.Context, client *Client, nodes []*Invoice, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Invoice
+	for _, n := range nodes {
+		if dumper.visit(invoice.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range invoice.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadInvoiceEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextLineItems []*LineItem
+	for _, n := range visit {
+		for i, e := range n.Edges.LineItems {
+			c := *e
+			c.Edges = LineItemEdges{}
+			n.Edges.LineItems[i] = &c
+			nextLineItems = append(nextLineItems, &c)
+		}
+	}
+	if err := dumpLineItems(ctx, client, nextLineItems, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Update returns a builder for updating this Invoice.
+// Note that, you need to call Invoice.Unwrap() before calling this method, if this Invoice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Invoice) Update() *InvoiceUpdateOne {
+	return (&InvoiceClient{config: i.config}).UpdateOne(i)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (i *Invoice) Unwrap() *Invoice {
+	tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Invoice is not a transactional entity")
+	}
+	i.config.driver = tx.drv
+	return i
+}
+
+// Clone returns a deep copy of the Invoice and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (i *Invoice) Clone() *Invoice {
+	return i.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Invoice and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (i *Invoice) clone(seen map[interface{}]interface{}) *Invoice {
+	if i == nil {
+		return nil
+	}
+	if v, ok := seen[i]; ok {
+		return v.(*Invoice)
+	}
+	_c := *i
+	seen[i] = &_c
+	if i.Edges.LineItems != nil {
+		_c.Edges.LineItems = make([]*LineItem, len(i.Edges.LineItems))
+		for _i := range i.Edges.LineItems {
+			_c.Edges.LineItems[_i] = i.Edges.LineItems[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
+// String implements the fmt.Stringer.
+func (i *Invoice) String() string {
+	var builder strings.Builder
+	builder.WriteString("Invoice(")
+	builder.WriteString(fmt.Sprintf("id=%v", i.ID))
+	builder.WriteString(", tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.TenantID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Fingerprint returns a stable hash of the content of the Invoice, for change detection and cache keys.
+// It covers the fields that are listed in invoice.FingerprintFields, and it does not depend on the
+// ID of the Invoice, on its edges, or on the order of the fields in the schema.
+func (i *Invoice) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "tenant_id", i.TenantID)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Invoice for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Invoice can be decoded using the FromMap method of its client.
+func (i *Invoice) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[invoice.FieldID] = kvEncode(i.ID)
+	m[invoice.FieldTenantID] = kvEncode(i.TenantID)
+	return m
+}
+
+// Invoices is a parsable slice of Invoice.
+type Invoices []*Invoice
+
+func (i Invoices) config(cfg config) {
+	for _i := range i {
+		i[_i].config = cfg
+	}
+}
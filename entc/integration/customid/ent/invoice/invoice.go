@@ -0,0 +1,55 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package invoice
+
+const (
+	// Label holds the string label denoting the invoice type in the database.
+	Label = "invoice"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID       = "id" // FieldTenantID holds the string denoting the tenant_id vertex property in the database.
+	FieldTenantID = "tenant_id"
+
+	// EdgeLineItems holds the string denoting the line_items edge name in mutations.
+	EdgeLineItems = "line_items"
+
+	// Table holds the table name of the invoice in the database.
+	Table = "invoices"
+	// LineItemsTable is the table the holds the line_items relation/edge.
+	LineItemsTable = "line_items"
+	// LineItemsInverseTable is the table name for the LineItem entity.
+	// It exists in this package in order to avoid circular dependency with the "lineitem" package.
+	LineItemsInverseTable = "line_items"
+	// LineItemsColumn is the table column denoting the line_items relation/edge.
+	LineItemsColumn = "invoice_line_items"
+)
+
+// Edges holds all the edge names of the Invoice type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeLineItems,
+}
+
+// FingerprintFields holds the fields of the Invoice type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldTenantID,
+}
+
+// Columns holds all SQL columns for invoice fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,336 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package invoice
+
+import (
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.Invoice {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:       "numeric",
+	FieldTenantID: "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Invoice.
+func FieldsEQ(f1, f2 string) predicate.Invoice {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Invoice.
+func FieldsNEQ(f1, f2 string) predicate.Invoice {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Invoice.
+func FieldsLT(f1, f2 string) predicate.Invoice {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Invoice.
+func FieldsLTE(f1, f2 string) predicate.Invoice {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Invoice.
+func FieldsGT(f1, f2 string) predicate.Invoice {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Invoice.
+func FieldsGTE(f1, f2 string) predicate.Invoice {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Invoice using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Invoice", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Invoice", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Invoice are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
+// HasLineItems applies the HasEdge predicate on the "line_items" edge.
+func HasLineItems() predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LineItemsTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LineItemsTable, LineItemsColumn),
+			sqlgraph.SharedColumns(FieldTenantID),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLineItemsWith applies the HasEdge predicate on the "line_items" edge with a given conditions (other predicates).
+func HasLineItemsWith(preds ...predicate.LineItem) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(LineItemsInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LineItemsTable, LineItemsColumn),
+			sqlgraph.SharedColumns(FieldTenantID),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Invoice) predicate.Invoice {
+	return predicate.Invoice(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
+
+// InvoiceFilter holds optional filters on the Invoice fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type InvoiceFilter struct {
+	IDIn     []int
+	TenantID *int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Invoice.Query().
+//		Where(invoice.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *InvoiceFilter) []predicate.Invoice {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Invoice
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.TenantID != nil {
+		ps = append(ps, TenantIDEQ(*f.TenantID))
+	}
+	return ps
+}
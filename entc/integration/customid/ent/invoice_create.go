@@ -0,0 +1,642 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceCreate is the builder for creating a Invoice entity.
+type InvoiceCreate struct {
+	config
+	mutation *InvoiceMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the tenant_id field.
+func (ic *InvoiceCreate) SetTenantID(i int) *InvoiceCreate {
+	ic.mutation.SetTenantID(i)
+	return ic
+}
+
+// AddLineItemIDs adds the line_items edge to LineItem by ids.
+func (ic *InvoiceCreate) AddLineItemIDs(ids ...int) *InvoiceCreate {
+	ic.mutation.AddLineItemIDs(ids...)
+	return ic
+}
+
+// AddLineItems adds the line_items edges to LineItem.
+func (ic *InvoiceCreate) AddLineItems(l ...*LineItem) *InvoiceCreate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return ic.AddLineItemIDs(ids...)
+}
+
+// Save creates the Invoice in the database.
+func (ic *InvoiceCreate) Save(ctx context.Context) (*Invoice, error) {
+	if err := checkTx(ic.driver); err != nil {
+		return nil, err
+	}
+	ic.defaults()
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *Invoice
+	)
+	if len(ic.hooks) == 0 {
+		node, err = ic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ic.mutation = mutation
+			node, err = ic.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(ic.hooks) - 1; i >= 0; i-- {
+			mut = ic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *InvoiceCreate) SaveX(ctx context.Context) *Invoice {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ic *InvoiceCreate) Unwrap() *InvoiceCreate {
+	tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceCreate is not a transactional builder")
+	}
+	ic.config.driver = tx.drv
+	ic.mutation.driver = tx.drv
+	return ic
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (ic *InvoiceCreate) DebugFields() map[string]Value {
+	return debugFields(ic.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *InvoiceCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *InvoiceCreate) check() error {
+	if _, ok := ic.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New("ent: missing required field \"tenant_id\"")}
+	}
+	return nil
+}
+
+func (ic *InvoiceCreate) sqlSave(ctx context.Context) (*Invoice, error) {
+	ctx = ic.withOperation(ctx, "Invoice", "Create")
+	i, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	i.ID = int(id)
+	return i, nil
+}
+
+// SaveID creates the Invoice in the database and returns only its id. Unlike Save,
+// the Invoice entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Invoice that holds only its id as the value that is returned by the next mutator.
+func (ic *InvoiceCreate) SaveID(ctx context.Context) (id int, err error) {
+	ic.defaults()
+	if err = ic.check(); err != nil {
+		return id, err
+	}
+	if len(ic.hooks) == 0 {
+		return ic.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*InvoiceMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		ic.mutation = mutation
+		if id, err = ic.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Invoice{config: ic.config, ID: id}, nil
+	})
+	for i := len(ic.hooks) - 1; i >= 0; i-- {
+		mut = ic.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, ic.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (ic *InvoiceCreate) SaveIDX(ctx context.Context) int {
+	id, err := ic.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (ic *InvoiceCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = ic.withOperation(ctx, "Invoice", "Create")
+	_spec := ic.idSpec()
+	if err = sqlgraph.CreateNode(ctx, ic.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (ic *InvoiceCreate) createSpec() (*Invoice, *sqlgraph.CreateSpec) {
+	i := &Invoice{config: ic.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: invoice.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldID,
+		},
+	}
+	if value, ok := ic.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+		i.TenantID = value
+	}
+	if nodes := ic.mutation.LineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.O2M,
+			Inverse:       false,
+			Table:         invoice.LineItemsTable,
+			Columns:       []string{invoice.LineItemsColumn},
+			Bidi:          false,
+			SharedColumns: []string{invoice.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: lineitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return i, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (ic *InvoiceCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: invoice.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: invoice.FieldID,
+		},
+	}
+	if value, ok := ic.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+	}
+	if nodes := ic.mutation.LineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.O2M,
+			Inverse:       false,
+			Table:         invoice.LineItemsTable,
+			Columns:       []string{invoice.LineItemsColumn},
+			Bidi:          false,
+			SharedColumns: []string{invoice.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: lineitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// InvoiceCreateBulk is the builder for creating a bulk of Invoice entities.
+type InvoiceCreateBulk struct {
+	config
+	builders []*InvoiceCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Invoice entities in the database.
+func (icb *InvoiceCreateBulk) Save(ctx context.Context) ([]*Invoice, error) {
+	nodes, err := icb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && icb.retries > 0 && isSQLDeadlockError(err) {
+		return icb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *InvoiceCreateBulk) SaveX(ctx context.Context) []*Invoice {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (icb *InvoiceCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range icb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (icb *InvoiceCreateBulk) CheckRefs() *InvoiceCreateBulk {
+	icb.refs = true
+	return icb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (icb *InvoiceCreateBulk) RetryOnDeadlock(max int) *InvoiceCreateBulk {
+	icb.retries = max
+	return icb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Invoice.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Invoice.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (icb *InvoiceCreateBulk) OnConflict(columns ...string) *InvoiceUpsertBulk {
+	return &InvoiceUpsertBulk{create: icb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Invoice.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (ic *InvoiceCreate) OnConflict(target ...sql.ConflictOption) *InvoiceUpsertBulk {
+	bulk := &InvoiceCreateBulk{config: ic.config, builders: []*InvoiceCreate{ic}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (icb *InvoiceCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Invoice, error) {
+	ctx = icb.withOperation(ctx, "Invoice", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(icb.builders))
+		nodes    = make([]*Invoice, len(icb.builders))
+		mutators = make([]Mutator, len(icb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InvoiceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if icb.refs {
+						if err := icb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, icb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (icb *InvoiceCreateBulk) retry(ctx context.Context, err error) ([]*Invoice, error) {
+	if _, ok := icb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := icb.builders
+	defer func() { icb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return icb.less(builders[idx[i]], builders[idx[j]])
+	})
+	icb.builders = make([]*InvoiceCreate, len(idx))
+	for i, j := range idx {
+		icb.builders[i] = builders[j]
+	}
+	for n := 0; n < icb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Invoice
+		if sorted, err = icb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Invoice, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (icb *InvoiceCreateBulk) less(a, b *InvoiceCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (icb *InvoiceCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range icb.builders {
+			for _, id := range builder.mutation.LineItemsIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, icb.driver, lineitem.Table, lineitem.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[invoice.EdgeLineItems] = append(missing[invoice.EdgeLineItems], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Invoice", Missing: missing}
+	}
+	return nil
+}
+
+// InvoiceUpsertBulk is the builder for the conflict handling of a bulk of Invoice entities.
+type InvoiceUpsertBulk struct {
+	create  *InvoiceCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (iub *InvoiceUpsertBulk) Target(target ...sql.ConflictOption) *InvoiceUpsertBulk {
+	iub.target = append(iub.target, target...)
+	return iub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (iub *InvoiceUpsertBulk) DoNothing() *InvoiceUpsertBulk {
+	iub.nothing = true
+	return iub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (iub *InvoiceUpsertBulk) UpdateNewValues() *InvoiceUpsertBulk {
+	iub.update = true
+	return iub
+}
+
+// Save creates the Invoice entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (iub *InvoiceUpsertBulk) Save(ctx context.Context) ([]*Invoice, int, error) {
+	switch {
+	case !iub.nothing && !iub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Invoice bulk insert")
+	case iub.nothing && iub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Invoice bulk insert")
+	case iub.update:
+		if len(iub.columns) == 0 && len(iub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Invoice bulk upsert")
+		}
+		nodes, err := iub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(iub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(invoice.FieldID),
+			),
+			Columns:         invoice.Columns,
+			ConflictColumns: iub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(iub.conflictTarget(), sql.DoNothing())}
+	nodes, err := iub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Invoice, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (iub *InvoiceUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(iub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(iub.columns...))
+	}
+	return append(opts, iub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iub *InvoiceUpsertBulk) SaveX(ctx context.Context) ([]*Invoice, int) {
+	nodes, skipped, err := iub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (iub *InvoiceUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := iub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iub *InvoiceUpsertBulk) ExecX(ctx context.Context) {
+	if err := iub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceDelete is the builder for deleting a Invoice entity.
+type InvoiceDelete struct {
+	config
+	hooks      []Hook
+	mutation   *InvoiceMutation
+	predicates []predicate.Invoice
+	limit      *int
+}
+
+// Where adds a new predicate to the delete builder.
+func (id *InvoiceDelete) Where(ps ...predicate.Invoice) *InvoiceDelete {
+	id.predicates = append(id.predicates, ps...)
+	return id
+}
+
+// Limit limits the number of invoices that are deleted by the builder. For example,
+// for deleting large amounts of invoices in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (id *InvoiceDelete) Limit(limit int) *InvoiceDelete {
+	id.limit = &limit
+	return id
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (id *InvoiceDelete) Unwrap() *InvoiceDelete {
+	tx, ok := id.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceDelete is not a transactional builder")
+	}
+	id.config.driver = tx.drv
+	id.mutation.driver = tx.drv
+	return id
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (id *InvoiceDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(id.driver); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(id.hooks) == 0 {
+		affected, err = id.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			id.mutation = mutation
+			affected, err = id.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(id.hooks) - 1; i >= 0; i-- {
+			mut = id.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, id.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (id *InvoiceDelete) ExecX(ctx context.Context) int {
+	n, err := id.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (id *InvoiceDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = id.withOperation(ctx, "Invoice", "Delete")
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: invoice.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+	}
+	if ps := id.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := id.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, id.mutationDriver(ctx), _spec)
+}
+
+// InvoiceDeleteOne is the builder for deleting a single Invoice entity.
+type InvoiceDeleteOne struct {
+	id *InvoiceDelete
+}
+
+// Exec executes the deletion query.
+func (ido *InvoiceDeleteOne) Exec(ctx context.Context) error {
+	n, err := ido.id.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0 && !sql.IsDryRun(ctx):
+		return &NotFoundError{invoice.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ido *InvoiceDeleteOne) ExecX(ctx context.Context) {
+	ido.id.ExecX(ctx)
+}
@@ -0,0 +1,1324 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceQuery is the builder for querying Invoice entities.
+type InvoiceQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Invoice
+	// eager-loading edges.
+	withLineItems *LineItemQuery
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see InvoiceSelect.All).
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (iq *InvoiceQuery) Where(ps ...predicate.Invoice) *InvoiceQuery {
+	iq.predicates = append(iq.predicates, ps...)
+	return iq
+}
+
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (iq *InvoiceQuery) Predicates() []predicate.Invoice {
+	return append([]predicate.Invoice{}, iq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (iq *InvoiceQuery) SetPredicates(ps ...predicate.Invoice) *InvoiceQuery {
+	iq.predicates = append([]predicate.Invoice{}, ps...)
+	return iq
+}
+
+// Limit adds a limit step to the query.
+func (iq *InvoiceQuery) Limit(limit int) *InvoiceQuery {
+	iq.limit = &limit
+	return iq
+}
+
+// Offset adds an offset step to the query.
+func (iq *InvoiceQuery) Offset(offset int) *InvoiceQuery {
+	iq.offset = &offset
+	return iq
+}
+
+// Order adds an order step to the query.
+func (iq *InvoiceQuery) Order(o ...OrderFunc) *InvoiceQuery {
+	iq.order = append(iq.order, o...)
+	return iq
+}
+
+// QueryLineItems chains the current query on the line_items edge.
+func (iq *InvoiceQuery) QueryLineItems() *LineItemQuery {
+	query := &LineItemQuery{config: iq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(invoice.Table, invoice.FieldID, iq.sqlQuery()),
+			sqlgraph.To(lineitem.Table, lineitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, invoice.LineItemsTable, invoice.LineItemsColumn),
+			sqlgraph.SharedColumns(invoice.FieldTenantID),
+		)
+		fromU = sqlgraph.SetNeighbors(iq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Invoice entity in the query. Returns *NotFoundError when no invoice was found.
+func (iq *InvoiceQuery) First(ctx context.Context) (*Invoice, error) {
+	is, err := iq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(is) == 0 {
+		return nil, &NotFoundError{invoice.Label}
+	}
+	return is[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (iq *InvoiceQuery) FirstX(ctx context.Context) *Invoice {
+	i, err := iq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return i
+}
+
+// FirstID returns the first Invoice id in the query. Returns *NotFoundError when no id was found.
+func (iq *InvoiceQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{invoice.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (iq *InvoiceQuery) FirstXID(ctx context.Context) int {
+	id, err := iq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Invoice entity in the query, returns an error if not exactly one entity was returned.
+func (iq *InvoiceQuery) Only(ctx context.Context) (*Invoice, error) {
+	is, err := iq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(is) {
+	case 1:
+		return is[0], nil
+	case 0:
+		return nil, &NotFoundError{invoice.Label}
+	default:
+		return nil, &NotSingularError{invoice.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (iq *InvoiceQuery) OnlyX(ctx context.Context) *Invoice {
+	i, err := iq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// OnlyID returns the only Invoice id in the query, returns an error if not exactly one id was returned.
+func (iq *InvoiceQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = iq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{invoice.Label}
+	default:
+		err = &NotSingularError{invoice.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (iq *InvoiceQuery) OnlyXID(ctx context.Context) int {
+	id, err := iq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Invoices.
+func (iq *InvoiceQuery) All(ctx context.Context) ([]*Invoice, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return iq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (iq *InvoiceQuery) AllX(ctx context.Context) []*Invoice {
+	is, err := iq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return is
+}
+
+// IDs executes the query and returns a list of Invoice ids.
+func (iq *InvoiceQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := iq.Select(invoice.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (iq *InvoiceQuery) IDsX(ctx context.Context) []int {
+	ids, err := iq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (iq *InvoiceQuery) Count(ctx context.Context) (int, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return iq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (iq *InvoiceQuery) CountX(ctx context.Context) int {
+	count, err := iq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (iq *InvoiceQuery) Exist(ctx context.Context) (bool, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return iq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (iq *InvoiceQuery) ExistX(ctx context.Context) bool {
+	exist, err := iq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (iq *InvoiceQuery) Clone() *InvoiceQuery {
+	return &InvoiceQuery{
+		config:     iq.config,
+		limit:      iq.limit,
+		offset:     iq.offset,
+		order:      append([]OrderFunc{}, iq.order...),
+		unique:     append([]string{}, iq.unique...),
+		predicates: append([]predicate.Invoice{}, iq.predicates...),
+		// clone intermediate query.
+		sql:  iq.sql.Clone(),
+		path: iq.path,
+	}
+}
+
+//  WithLineItems tells the query-builder to eager-loads the nodes that are connected to
+// the "line_items" edge. The optional arguments used to configure the query builder of the edge.
+func (iq *InvoiceQuery) WithLineItems(opts ...func(*LineItemQuery)) *InvoiceQuery {
+	query := &LineItemQuery{config: iq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	iq.withLineItems = query
+	return iq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Invoice.Query().
+//		GroupBy(invoice.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (iq *InvoiceQuery) GroupBy(field string, fields ...string) *InvoiceGroupBy {
+	group := &InvoiceGroupBy{config: iq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return iq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.Invoice.Query().
+//		Select(invoice.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (iq *InvoiceQuery) Select(field string, fields ...string) *InvoiceSelect {
+	selector := &InvoiceSelect{config: iq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.query = iq
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := iq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if err := iq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return iq.sqlQuery().AppendSelectExpr(iq.exprs...), nil
+	}
+	return selector
+}
+
+func (iq *InvoiceQuery) prepareQuery(ctx context.Context) error {
+	if iq.path != nil {
+		prev, err := iq.path(ctx)
+		if err != nil {
+			return err
+		}
+		iq.sql = prev
+	}
+	return nil
+}
+
+func (iq *InvoiceQuery) sqlAll(ctx context.Context) ([]*Invoice, error) {
+	ctx = iq.withOperation(ctx, "Invoice", "Query")
+	if err := iq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range iq.fields {
+		if !invoice.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
+	var (
+		nodes       = []*Invoice{}
+		_spec       = iq.querySpec()
+		loadedTypes = [1]bool{
+			iq.withLineItems != nil,
+		}
+	)
+	_spec.Exprs = iq.exprs
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		node := &Invoice{config: iq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues(columns)
+		for range iq.exprs {
+			values = append(values, new(interface{}))
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		if n := len(iq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range iq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, iq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := iq.withLineItems; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Invoice)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = invoice.LineItemsColumn
+		err := iq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.LineItem(func(s *sql.Selector) {
+				s.Where(sql.InValues(invoice.LineItemsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.invoice_line_items
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "invoice_line_items" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "invoice_line_items" returned %v for node %v`, *fk, n.ID)
+				}
+				// Neighbors that do not share the columns of the composite
+				// foreign-key with the node are not connected to it.
+				if n.TenantID != node.TenantID {
+					continue
+				}
+				node.Edges.LineItems = append(node.Edges.LineItems, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
+
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Invoice entities (see Invoice.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Invoice.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Invoice table.
+func (iq *InvoiceQuery) SelectExpr(exprs ...*sql.SelectExpr) *InvoiceQuery {
+	iq.exprs = append(iq.exprs, exprs...)
+	return iq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (iq *InvoiceQuery) checkExprs() error {
+	if len(iq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range invoice.Columns {
+		columns[c] = true
+	}
+	for _, e := range iq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Invoice", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
+func (iq *InvoiceQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = iq.withOperation(ctx, "Invoice", "Count")
+	_spec := iq.querySpec()
+	return sqlgraph.CountNodes(ctx, iq.driver, _spec)
+}
+
+func (iq *InvoiceQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := iq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (iq *InvoiceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+		From:   iq.sql,
+		Unique: true,
+	}
+	if fields := iq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, invoice.FieldID)
+		for i := range fields {
+			if fields[i] != invoice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = iq.forUpdate
+	_spec.PartitionBy = iq.partition
+	if ps := iq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := iq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := iq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (iq *InvoiceQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(iq.driver.Dialect())
+	t1 := builder.Table(invoice.Table)
+	selector := builder.Select(t1.Columns(invoice.Columns...)...).From(t1)
+	if iq.sql != nil {
+		selector = iq.sql
+		selector.Select(selector.Columns(invoice.Columns...)...)
+	}
+	for _, p := range iq.predicates {
+		p(selector)
+	}
+	for _, p := range iq.order {
+		p(selector)
+	}
+	if offset := iq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := iq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IDsQuery returns a selector that selects the ids of the Invoice entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (iq *InvoiceQuery) IDsQuery() *sql.Selector {
+	selector := iq.sqlQuery()
+	if iq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(invoice.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Invoice entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Invoice.Query().
+//		Where(...).
+//		CountDistinct(ctx, invoice.FieldTenantID)
+//
+func (iq *InvoiceQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = iq.withOperation(ctx, "Invoice", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, iq.driver, iq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (iq *InvoiceQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := iq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Invoice rows as generic maps that are keyed by
+// column name (see invoice.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (iq *InvoiceQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := iq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = iq.withOperation(ctx, "Invoice", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = iq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Invoice{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, iq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (iq *InvoiceQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := iq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Invoice entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (iq *InvoiceQuery) Prefetch(n int) *InvoiceQuery {
+	iq.prefetch = n
+	return iq
+}
+
+// ForUpdate locks the selected Invoice rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (iq *InvoiceQuery) ForUpdate() *InvoiceQuery {
+	iq.forUpdate = true
+	return iq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (iq *InvoiceQuery) Primary() *InvoiceQuery {
+	return iq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (iq *InvoiceQuery) ReadConsistency(c sql.ReadConsistency) *InvoiceQuery {
+	iq.consistency = c
+	return iq
+}
+
+// Stream executes the query in batches, and sends the matched Invoice entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Invoice.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (iq *InvoiceQuery) Stream(ctx context.Context) (<-chan *Invoice, <-chan error) {
+	size := iq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Invoice, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := iq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Invoice entities in batches of the given
+// size, and sends them to the given channel.
+func (iq *InvoiceQuery) stream(ctx context.Context, size int, nodes chan<- *Invoice) error {
+	if iq.offset != nil || len(iq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if iq.limit != nil {
+		left = *iq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *iq
+		query.predicates = iq.predicates[:len(iq.predicates):len(iq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, invoice.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(invoice.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// InvoiceEdge is the edge representation of Invoice in a connection.
+type InvoiceEdge struct {
+	Node   *Invoice `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// InvoiceConnection is the connection of Invoice entities that is returned by Paginate.
+type InvoiceConnection struct {
+	Edges      []InvoiceEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Invoice.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: invoice.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (iq *InvoiceQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*InvoiceConnection, error) {
+	if iq.limit != nil || iq.offset != nil || len(iq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case invoice.FieldTenantID:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for Invoice", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := iq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: invoice.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *iq
+	query.predicates = iq.predicates[:len(iq.predicates):len(iq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := iq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &InvoiceConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]InvoiceEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = InvoiceEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (iq *InvoiceQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Invoice, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := iq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Invoice(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*InvoiceQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case invoice.FieldTenantID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field tenant_id: %v", err)
+		}
+		return v, nil
+	case invoice.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Invoice", field)
+}
+
+// pageCursor returns the cursor of the Invoice node for the given order fields.
+func (i *Invoice) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(i.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case invoice.FieldTenantID:
+			v = i.TenantID
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Invoice", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
+// InvoiceGroupBy is the builder for group-by Invoice entities.
+type InvoiceGroupBy struct {
+	config
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (igb *InvoiceGroupBy) Aggregate(fns ...AggregateFunc) *InvoiceGroupBy {
+	igb.fns = append(igb.fns, fns...)
+	return igb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (igb *InvoiceGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := igb.path(ctx)
+	if err != nil {
+		return err
+	}
+	igb.sql = query
+	return igb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (igb *InvoiceGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := igb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (igb *InvoiceGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (igb *InvoiceGroupBy) StringsX(ctx context.Context) []string {
+	v, err := igb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (igb *InvoiceGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (igb *InvoiceGroupBy) IntsX(ctx context.Context) []int {
+	v, err := igb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (igb *InvoiceGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (igb *InvoiceGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := igb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (igb *InvoiceGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(igb.fields) > 1 {
+		return nil, errors.New("ent: InvoiceGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := igb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (igb *InvoiceGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := igb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Invoice.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (igb *InvoiceGroupBy) Having(ps ...*sql.Predicate) *InvoiceGroupBy {
+	igb.havings = append(igb.havings, ps...)
+	return igb
+}
+
+func (igb *InvoiceGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = igb.withOperation(ctx, "Invoice", "GroupBy")
+	selector := igb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	if err := igb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (igb *InvoiceGroupBy) sqlQuery() *sql.Selector {
+	selector := igb.sql
+	columns := make([]string, 0, len(igb.fields)+len(igb.fns))
+	columns = append(columns, igb.fields...)
+	for _, fn := range igb.fns {
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(igb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(igb.fields...)
+	switch len(igb.havings) {
+	case 0:
+	case 1:
+		selector.Having(igb.havings[0])
+	default:
+		selector.Having(sql.And(igb.havings...))
+	}
+	return selector
+}
+
+// InvoiceSelect is the builder for select fields of Invoice entities.
+type InvoiceSelect struct {
+	config
+	fields []string
+	// query is the query that the fields are selected from.
+	query *InvoiceQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (is *InvoiceSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := is.path(ctx)
+	if err != nil {
+		return err
+	}
+	is.sql = query
+	return is.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (is *InvoiceSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := is.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (is *InvoiceSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("ent: InvoiceSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (is *InvoiceSelect) StringsX(ctx context.Context) []string {
+	v, err := is.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (is *InvoiceSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("ent: InvoiceSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (is *InvoiceSelect) IntsX(ctx context.Context) []int {
+	v, err := is.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (is *InvoiceSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("ent: InvoiceSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (is *InvoiceSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := is.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (is *InvoiceSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("ent: InvoiceSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (is *InvoiceSelect) BoolsX(ctx context.Context) []bool {
+	v, err := is.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (is *InvoiceSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("ent: InvoiceSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (is *InvoiceSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := is.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Invoice entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Invoice.Query().
+//		Select(invoice.FieldTenantID).
+//		All(ctx)
+//
+func (is *InvoiceSelect) All(ctx context.Context) ([]*Invoice, error) {
+	query := *is.query
+	query.fields = is.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (is *InvoiceSelect) AllX(ctx context.Context) []*Invoice {
+	nodes, err := is.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+func (is *InvoiceSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = is.withOperation(ctx, "Invoice", "Select")
+	rows := &sql.Rows{}
+	query, args := is.sqlQuery().Query()
+	if err := is.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (is *InvoiceSelect) sqlQuery() sql.Querier {
+	selector := is.sql
+	selector.Select(selector.Columns(is.fields...)...)
+	return selector
+}
@@ -0,0 +1,524 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// InvoiceUpdate is the builder for updating Invoice entities.
+type InvoiceUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *InvoiceMutation
+	predicates []predicate.Invoice
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
+}
+
+// Where adds a new predicate for the builder.
+func (iu *InvoiceUpdate) Where(ps ...predicate.Invoice) *InvoiceUpdate {
+	iu.predicates = append(iu.predicates, ps...)
+	return iu
+}
+
+// Limit limits the number of invoices that are updated by the builder. For example,
+// for updating large amounts of invoices in small batches without locking the whole table.
+func (iu *InvoiceUpdate) Limit(limit int) *InvoiceUpdate {
+	iu.limit = &limit
+	return iu
+}
+
+// SetTenantID sets the tenant_id field.
+func (iu *InvoiceUpdate) SetTenantID(i int) *InvoiceUpdate {
+	iu.mutation.ResetTenantID()
+	iu.mutation.SetTenantID(i)
+	return iu
+}
+
+// AddTenantID adds i to tenant_id.
+func (iu *InvoiceUpdate) AddTenantID(i int) *InvoiceUpdate {
+	iu.mutation.AddTenantID(i)
+	return iu
+}
+
+// UnsetTenantID removes the changes of the tenant_id field from the builder (e.g. a previous call
+// to SetTenantID), and therefore, the field is left unchanged in the database.
+func (iu *InvoiceUpdate) UnsetTenantID() *InvoiceUpdate {
+	iu.mutation.ResetTenantID()
+	return iu
+}
+
+// AddLineItemIDs adds the line_items edge to LineItem by ids.
+func (iu *InvoiceUpdate) AddLineItemIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.AddLineItemIDs(ids...)
+	return iu
+}
+
+// AddLineItems adds the line_items edges to LineItem.
+func (iu *InvoiceUpdate) AddLineItems(l ...*LineItem) *InvoiceUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return iu.AddLineItemIDs(ids...)
+}
+
+// RemoveLineItemIDs removes the line_items edge to LineItem by ids.
+func (iu *InvoiceUpdate) RemoveLineItemIDs(ids ...int) *InvoiceUpdate {
+	iu.mutation.RemoveLineItemIDs(ids...)
+	return iu
+}
+
+// RemoveLineItems removes line_items edges to LineItem.
+func (iu *InvoiceUpdate) RemoveLineItems(l ...*LineItem) *InvoiceUpdate {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return iu.RemoveLineItemIDs(ids...)
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (iu *InvoiceUpdate) Unwrap() *InvoiceUpdate {
+	tx, ok := iu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceUpdate is not a transactional builder")
+	}
+	iu.config.driver = tx.drv
+	iu.mutation.driver = tx.drv
+	return iu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (iu *InvoiceUpdate) DebugFields() map[string]Value {
+	return debugFields(iu.mutation)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (iu *InvoiceUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(iu.driver); err != nil {
+		return 0, err
+	}
+	if err := iu.check(); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(iu.hooks) == 0 {
+		affected, err = iu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iu.mutation = mutation
+			affected, err = iu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(iu.hooks) - 1; i >= 0; i-- {
+			mut = iu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *InvoiceUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *InvoiceUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *InvoiceUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *InvoiceUpdate) check() error {
+	return nil
+}
+
+func (iu *InvoiceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = iu.withOperation(ctx, "Invoice", "Update")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+	}
+	if ps := iu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := iu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if iu.ids != nil {
+		_spec.ScanIDs = iu.ids
+	}
+	if value, ok := iu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+	}
+	if value, ok := iu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+	}
+	if nodes := iu.mutation.RemovedLineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.O2M,
+			Inverse:       false,
+			Table:         invoice.LineItemsTable,
+			Columns:       []string{invoice.LineItemsColumn},
+			Bidi:          false,
+			SharedColumns: []string{invoice.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: lineitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.LineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.O2M,
+			Inverse:       false,
+			Table:         invoice.LineItemsTable,
+			Columns:       []string{invoice.LineItemsColumn},
+			Bidi:          false,
+			SharedColumns: []string{invoice.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: lineitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SaveReturningIDs executes the query and returns the ids of the Invoice entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (iu *InvoiceUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	iu.ids = &ids
+	defer func() { iu.ids = nil }()
+	if _, err := iu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// InvoiceUpdateOne is the builder for updating a single Invoice entity.
+type InvoiceUpdateOne struct {
+	config
+	hooks      []Hook
+	mutation   *InvoiceMutation
+	predicates []predicate.Invoice
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (iuo *InvoiceUpdateOne) Where(ps ...predicate.Invoice) *InvoiceUpdateOne {
+	iuo.predicates = append(iuo.predicates, ps...)
+	return iuo
+}
+
+// SetTenantID sets the tenant_id field.
+func (iuo *InvoiceUpdateOne) SetTenantID(i int) *InvoiceUpdateOne {
+	iuo.mutation.ResetTenantID()
+	iuo.mutation.SetTenantID(i)
+	return iuo
+}
+
+// AddTenantID adds i to tenant_id.
+func (iuo *InvoiceUpdateOne) AddTenantID(i int) *InvoiceUpdateOne {
+	iuo.mutation.AddTenantID(i)
+	return iuo
+}
+
+// UnsetTenantID removes the changes of the tenant_id field from the builder (e.g. a previous call
+// to SetTenantID), and therefore, the field is left unchanged in the database.
+func (iuo *InvoiceUpdateOne) UnsetTenantID() *InvoiceUpdateOne {
+	iuo.mutation.ResetTenantID()
+	return iuo
+}
+
+// AddLineItemIDs adds the line_items edge to LineItem by ids.
+func (iuo *InvoiceUpdateOne) AddLineItemIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.AddLineItemIDs(ids...)
+	return iuo
+}
+
+// AddLineItems adds the line_items edges to LineItem.
+func (iuo *InvoiceUpdateOne) AddLineItems(l ...*LineItem) *InvoiceUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return iuo.AddLineItemIDs(ids...)
+}
+
+// RemoveLineItemIDs removes the line_items edge to LineItem by ids.
+func (iuo *InvoiceUpdateOne) RemoveLineItemIDs(ids ...int) *InvoiceUpdateOne {
+	iuo.mutation.RemoveLineItemIDs(ids...)
+	return iuo
+}
+
+// RemoveLineItems removes line_items edges to LineItem.
+func (iuo *InvoiceUpdateOne) RemoveLineItems(l ...*LineItem) *InvoiceUpdateOne {
+	ids := make([]int, len(l))
+	for i := range l {
+		ids[i] = l[i].ID
+	}
+	return iuo.RemoveLineItemIDs(ids...)
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (iuo *InvoiceUpdateOne) Unwrap() *InvoiceUpdateOne {
+	tx, ok := iuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InvoiceUpdateOne is not a transactional builder")
+	}
+	iuo.config.driver = tx.drv
+	iuo.mutation.driver = tx.drv
+	return iuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (iuo *InvoiceUpdateOne) DebugFields() map[string]Value {
+	return debugFields(iuo.mutation)
+}
+
+// Save executes the query and returns the updated entity.
+func (iuo *InvoiceUpdateOne) Save(ctx context.Context) (*Invoice, error) {
+	if err := checkTx(iuo.driver); err != nil {
+		return nil, err
+	}
+	if err := iuo.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *Invoice
+	)
+	if len(iuo.hooks) == 0 {
+		node, err = iuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*InvoiceMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			iuo.mutation = mutation
+			node, err = iuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(iuo.hooks) - 1; i >= 0; i-- {
+			mut = iuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, iuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) SaveX(ctx context.Context) *Invoice {
+	i, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+// Exec executes the query on the entity.
+func (iuo *InvoiceUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *InvoiceUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *InvoiceUpdateOne) check() error {
+	return nil
+}
+
+func (iuo *InvoiceUpdateOne) sqlSave(ctx context.Context) (i *Invoice, err error) {
+	ctx = iuo.withOperation(ctx, "Invoice", "UpdateOne")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   invoice.Table,
+			Columns: invoice.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: invoice.FieldID,
+			},
+		},
+	}
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Invoice.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if ps := iuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+	}
+	if value, ok := iuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: invoice.FieldTenantID,
+		})
+	}
+	if nodes := iuo.mutation.RemovedLineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.O2M,
+			Inverse:       false,
+			Table:         invoice.LineItemsTable,
+			Columns:       []string{invoice.LineItemsColumn},
+			Bidi:          false,
+			SharedColumns: []string{invoice.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: lineitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.LineItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.O2M,
+			Inverse:       false,
+			Table:         invoice.LineItemsTable,
+			Columns:       []string{invoice.LineItemsColumn},
+			Bidi:          false,
+			SharedColumns: []string{invoice.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: lineitem.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	i = &Invoice{config: iuo.config}
+	_spec.Assign = func(values ...interface{}) error {
+		return i.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = i.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, iuo.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{invoice.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		i.ID = id
+		if value, ok := iuo.mutation.TenantID(); ok {
+			i.TenantID = value
+		}
+	}
+	return i, nil
+}
@@ -0,0 +1,340 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
+)
+
+// LineItem is the model entity for the LineItem schema.
+type LineItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// TenantID holds the value of the "tenant_id" field.
+	TenantID int `json:"tenant_id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Price holds the value of the "price" field.
+	Price string `json:"price,omitempty"`
+	// Discount holds the value of the "discount" field.
+	Discount schema.Amount `json:"discount,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LineItemQuery when eager-loading is set.
+	Edges              LineItemEdges `json:"edges"`
+	invoice_line_items *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
+}
+
+// LineItemEdges holds the relations/edges for other nodes in the graph.
+type LineItemEdges struct {
+	// Invoice holds the value of the invoice edge.
+	Invoice *Invoice
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// InvoiceOrErr returns the Invoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LineItemEdges) InvoiceOrErr() (*Invoice, error) {
+	if e.loadedTypes[0] {
+		if e.Invoice == nil {
+			// The edge invoice was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: invoice.Label}
+		}
+		return e.Invoice, nil
+	}
+	return nil, &NotLoadedError{edge: "invoice"}
+}
+
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the LineItem type are scanned as generic values.
+func (*LineItem) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lineitem.FieldID:
+			values[i] = &sql.NullInt64{}
+		case lineitem.FieldTenantID:
+			values[i] = &sql.NullInt64{}
+		case lineitem.FieldQuantity:
+			values[i] = &sql.NullInt64{}
+		case lineitem.FieldPrice:
+			values[i] = &sql.NullString{}
+		case lineitem.FieldDiscount:
+			values[i] = &sql.NullScanner{S: new(schema.Amount)}
+		case lineitem.ForeignKeys[0]: // invoice_line_items
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
+	}
+	return values
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LineItem fields. Values of columns that were not selected are left untouched.
+func (li *LineItem) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lineitem.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			li.ID = int(value.Int64)
+		case lineitem.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				li.TenantID = int(value.Int64)
+			}
+		case lineitem.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				li.Quantity = int(value.Int64)
+			}
+		case lineitem.FieldPrice:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				li.Price = value.String
+			}
+		case lineitem.FieldDiscount:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field discount", values[i])
+			} else if value.Valid {
+				li.Discount = *value.S.(*schema.Amount)
+			}
+		case lineitem.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field invoice_line_items", value)
+			} else if value.Valid {
+				li.invoice_line_items = new(int)
+				*li.invoice_line_items = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (li *LineItem) SelectValue(alias string) (Value, error) {
+	v, ok := li.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
+// QueryInvoice queries the invoice edge of the LineItem.
+func (li *LineItem) QueryInvoice() *InvoiceQuery {
+	return (&LineItemClient{config: li.config}).QueryInvoice(li)
+}
+
+// LoadEdges loads the given edges of the LineItem using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see lineitem.Edges), and in this case, no edge is loaded.
+//
+//	err := li.LoadEdges(ctx, client, lineitem.EdgeInvoice)
+//
+func (li *LineItem) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadLineItemEdges(ctx, client, []*LineItem{li}, edges)
+}
+
+// loadLineItemEdges loads the given edges of all the given LineItem entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using LineItemClient.LoadEdgeFor.
+func loadLineItemEdges(ctx context.Context, client *Client, nodes []*LineItem, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case lineitem.EdgeInvoice:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type LineItem", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.LineItem.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the LineItem and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the LineItem alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The LineItem itself is not modified.
+//
+//	b, err := li.DumpGraph(ctx, client, 2, lineitem.EdgeInvoice)
+//
+func (li *LineItem) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for LineItem DumpGraph", depth)
+	}
+	dumped := *li
+	dumped.Edges = LineItemEdges{}
+	if err := dumpLineItems(ctx, client, []*LineItem{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpLineItems loads the edges of the given LineItem copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpLineItems(ctx context.Context, client *Client, nodes []*LineItem, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*LineItem
+	for _, n := range nodes {
+		if dumper.visit(lineitem.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range lineitem.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadLineItemEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextInvoice []*Invoice
+	for _, n := range visit {
+		if e := n.Edges.Invoice; e != nil {
+			c := *e
+			c.Edges = InvoiceEdges{}
+			n.Edges.Invoice = &c
+			nextInvoice = append(nextInvoice, &c)
+		}
+	}
+	if err := dumpInvoices(ctx, client, nextInvoice, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Update returns a builder for updating this LineItem.
+// Note that, you need to call LineItem.Unwrap() before calling this method, if this LineItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (li *LineItem) Update() *LineItemUpdateOne {
+	return (&LineItemClient{config: li.config}).UpdateOne(li)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (li *LineItem) Unwrap() *LineItem {
+	tx, ok := li.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LineItem is not a transactional entity")
+	}
+	li.config.driver = tx.drv
+	return li
+}
+
+// Clone returns a deep copy of the LineItem and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (li *LineItem) Clone() *LineItem {
+	return li.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the LineItem and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (li *LineItem) clone(seen map[interface{}]interface{}) *LineItem {
+	if li == nil {
+		return nil
+	}
+	if v, ok := seen[li]; ok {
+		return v.(*LineItem)
+	}
+	_c := *li
+	seen[li] = &_c
+	_c.Edges.Invoice = li.Edges.Invoice.clone(seen)
+	return &_c
+}
+
+// String implements the fmt.Stringer.
+func (li *LineItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("LineItem(")
+	builder.WriteString(fmt.Sprintf("id=%v", li.ID))
+	builder.WriteString(", tenant_id=")
+	builder.WriteString(fmt.Sprintf("%v", li.TenantID))
+	builder.WriteString(", quantity=")
+	builder.WriteString(fmt.Sprintf("%v", li.Quantity))
+	builder.WriteString(", price=")
+	builder.WriteString(fmt.Sprintf("%v", li.Price))
+	builder.WriteString(", discount=")
+	builder.WriteString(fmt.Sprintf("%v", li.Discount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Fingerprint returns a stable hash of the content of the LineItem, for change detection and cache keys.
+// It covers the fields that are listed in lineitem.FingerprintFields, and it does not depend on the
+// ID of the LineItem, on its edges, or on the order of the fields in the schema.
+func (li *LineItem) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "discount", li.Discount)
+	fingerprint(h, "price", li.Price)
+	fingerprint(h, "quantity", li.Quantity)
+	fingerprint(h, "tenant_id", li.TenantID)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the LineItem for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The LineItem can be decoded using the FromMap method of its client.
+func (li *LineItem) ToMap() map[string]string {
+	m := make(map[string]string, 5)
+	m[lineitem.FieldID] = kvEncode(li.ID)
+	m[lineitem.FieldTenantID] = kvEncode(li.TenantID)
+	m[lineitem.FieldQuantity] = kvEncode(li.Quantity)
+	m[lineitem.FieldPrice] = kvEncode(li.Price)
+	if v := li.Discount; !reflect.ValueOf(v).IsZero() {
+		m[lineitem.FieldDiscount] = kvEncode(v)
+	}
+	return m
+}
+
+// LineItems is a parsable slice of LineItem.
+type LineItems []*LineItem
+
+func (li LineItems) config(cfg config) {
+	for _i := range li {
+		li[_i].config = cfg
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package lineitem
+
+const (
+	// Label holds the string label denoting the lineitem type in the database.
+	Label = "line_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID       = "id"        // FieldTenantID holds the string denoting the tenant_id vertex property in the database.
+	FieldTenantID = "tenant_id" // FieldQuantity holds the string denoting the quantity vertex property in the database.
+	FieldQuantity = "quantity"  // FieldPrice holds the string denoting the price vertex property in the database.
+	FieldPrice    = "price"     // FieldDiscount holds the string denoting the discount vertex property in the database.
+	FieldDiscount = "discount"
+
+	// EdgeInvoice holds the string denoting the invoice edge name in mutations.
+	EdgeInvoice = "invoice"
+
+	// Table holds the table name of the lineitem in the database.
+	Table = "line_items"
+	// InvoiceTable is the table the holds the invoice relation/edge.
+	InvoiceTable = "line_items"
+	// InvoiceInverseTable is the table name for the Invoice entity.
+	// It exists in this package in order to avoid circular dependency with the "invoice" package.
+	InvoiceInverseTable = "invoices"
+	// InvoiceColumn is the table column denoting the invoice relation/edge.
+	InvoiceColumn = "invoice_line_items"
+)
+
+// Edges holds all the edge names of the LineItem type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeInvoice,
+}
+
+// FingerprintFields holds the fields of the LineItem type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldDiscount,
+	FieldPrice,
+	FieldQuantity,
+	FieldTenantID,
+}
+
+// Columns holds all SQL columns for lineitem fields.
+var Columns = []string{
+	FieldID,
+	FieldTenantID,
+	FieldQuantity,
+	FieldPrice,
+	FieldDiscount,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the LineItem type.
+var ForeignKeys = []string{
+	"invoice_line_items",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPrice holds the default value on creation for the price field.
+	DefaultPrice string
+	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	PriceValidator func(string) error
+)
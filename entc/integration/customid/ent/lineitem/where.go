@@ -0,0 +1,663 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package lineitem
+
+import (
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v string) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
+func Discount(v schema.Amount) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscount), v))
+	})
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...int) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...int) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTenantID), v...))
+	})
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTenantID), v))
+	})
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTenantID), v))
+	})
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldQuantity), v...))
+	})
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldQuantity), v))
+	})
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldQuantity), v))
+	})
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v string) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v string) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPrice), v))
+	})
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...string) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...string) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPrice), v...))
+	})
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v string) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v string) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v string) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPrice), v))
+	})
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v string) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPrice), v))
+	})
+}
+
+// DiscountEQ applies the EQ predicate on the "discount" field.
+func DiscountEQ(v schema.Amount) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountNEQ applies the NEQ predicate on the "discount" field.
+func DiscountNEQ(v schema.Amount) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountIn applies the In predicate on the "discount" field.
+func DiscountIn(vs ...schema.Amount) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDiscount), v...))
+	})
+}
+
+// DiscountNotIn applies the NotIn predicate on the "discount" field.
+func DiscountNotIn(vs ...schema.Amount) predicate.LineItem {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDiscount), v...))
+	})
+}
+
+// DiscountGT applies the GT predicate on the "discount" field.
+func DiscountGT(v schema.Amount) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountGTE applies the GTE predicate on the "discount" field.
+func DiscountGTE(v schema.Amount) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountLT applies the LT predicate on the "discount" field.
+func DiscountLT(v schema.Amount) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountLTE applies the LTE predicate on the "discount" field.
+func DiscountLTE(v schema.Amount) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDiscount), v))
+	})
+}
+
+// DiscountIsNil applies the IsNil predicate on the "discount" field.
+func DiscountIsNil() predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDiscount)))
+	})
+}
+
+// DiscountNotNil applies the NotNil predicate on the "discount" field.
+func DiscountNotNil() predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDiscount)))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldDiscount: "numeric",
+	FieldID:       "numeric",
+	FieldPrice:    "numeric",
+	FieldQuantity: "numeric",
+	FieldTenantID: "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the LineItem.
+func FieldsEQ(f1, f2 string) predicate.LineItem {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the LineItem.
+func FieldsNEQ(f1, f2 string) predicate.LineItem {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the LineItem.
+//
+//	lineitem.FieldsLT(lineitem.FieldTenantID, lineitem.FieldQuantity)
+//
+func FieldsLT(f1, f2 string) predicate.LineItem {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the LineItem.
+func FieldsLTE(f1, f2 string) predicate.LineItem {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the LineItem.
+func FieldsGT(f1, f2 string) predicate.LineItem {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the LineItem.
+func FieldsGTE(f1, f2 string) predicate.LineItem {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the LineItem using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of LineItem", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of LineItem", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of LineItem are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
+// HasInvoice applies the HasEdge predicate on the "invoice" edge.
+func HasInvoice() predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvoiceTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+			sqlgraph.SharedColumns(FieldTenantID),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasInvoiceWith applies the HasEdge predicate on the "invoice" edge with a given conditions (other predicates).
+func HasInvoiceWith(preds ...predicate.Invoice) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(InvoiceInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, InvoiceTable, InvoiceColumn),
+			sqlgraph.SharedColumns(FieldTenantID),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// InvoiceIDIn applies a predicate on the "invoice" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	lineitem.InvoiceIDIn(client.Invoice.Query().Where(...))
+//
+func InvoiceIDIn(q sqlgraph.IDsQuerier) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(InvoiceColumn), sub))
+	})
+}
+
+// InvoiceMissing applies a predicate on the "invoice" edge, that checks if its foreign-key is set, but
+// there is no Invoice with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE invoice_line_items IS NOT NULL AND NOT EXISTS (SELECT id FROM invoices WHERE id = invoice_line_items)
+//
+func InvoiceMissing() predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(InvoiceInverseTable).As("invoice")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(InvoiceColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(InvoiceColumn))),
+				),
+			),
+		)
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.LineItem) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.LineItem) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LineItem) predicate.LineItem {
+	return predicate.LineItem(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
+
+// LineItemFilter holds optional filters on the LineItem fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type LineItemFilter struct {
+	IDIn        []int
+	TenantID    *int
+	Quantity    *int
+	Price       *string
+	InvoiceIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.LineItem.Query().
+//		Where(lineitem.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *LineItemFilter) []predicate.LineItem {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.LineItem
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.TenantID != nil {
+		ps = append(ps, TenantIDEQ(*f.TenantID))
+	}
+	if f.Quantity != nil {
+		ps = append(ps, QuantityEQ(*f.Quantity))
+	}
+	if f.Price != nil {
+		ps = append(ps, PriceEQ(*f.Price))
+	}
+	if ids := f.InvoiceIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.LineItem(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(InvoiceColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -0,0 +1,738 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// LineItemCreate is the builder for creating a LineItem entity.
+type LineItemCreate struct {
+	config
+	mutation *LineItemMutation
+	hooks    []Hook
+}
+
+// SetTenantID sets the tenant_id field.
+func (lic *LineItemCreate) SetTenantID(i int) *LineItemCreate {
+	lic.mutation.SetTenantID(i)
+	return lic
+}
+
+// SetQuantity sets the quantity field.
+func (lic *LineItemCreate) SetQuantity(i int) *LineItemCreate {
+	lic.mutation.SetQuantity(i)
+	return lic
+}
+
+// SetPrice sets the price field.
+func (lic *LineItemCreate) SetPrice(s string) *LineItemCreate {
+	lic.mutation.SetPrice(s)
+	return lic
+}
+
+// SetNillablePrice sets the price field if the given value is not nil.
+func (lic *LineItemCreate) SetNillablePrice(s *string) *LineItemCreate {
+	if s != nil {
+		lic.SetPrice(*s)
+	}
+	return lic
+}
+
+// SetDiscount sets the discount field.
+func (lic *LineItemCreate) SetDiscount(s schema.Amount) *LineItemCreate {
+	lic.mutation.SetDiscount(s)
+	return lic
+}
+
+// SetNillableDiscount sets the discount field if the given value is not nil.
+func (lic *LineItemCreate) SetNillableDiscount(s *schema.Amount) *LineItemCreate {
+	if s != nil {
+		lic.SetDiscount(*s)
+	}
+	return lic
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (lic *LineItemCreate) SetInvoiceID(id int) *LineItemCreate {
+	lic.mutation.SetInvoiceID(id)
+	return lic
+}
+
+// SetNillableInvoiceID sets the invoice edge to Invoice by id if the given value is not nil.
+func (lic *LineItemCreate) SetNillableInvoiceID(id *int) *LineItemCreate {
+	if id != nil {
+		lic = lic.SetInvoiceID(*id)
+	}
+	return lic
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (lic *LineItemCreate) SetInvoice(i *Invoice) *LineItemCreate {
+	return lic.SetInvoiceID(i.ID)
+}
+
+// Save creates the LineItem in the database.
+func (lic *LineItemCreate) Save(ctx context.Context) (*LineItem, error) {
+	if err := checkTx(lic.driver); err != nil {
+		return nil, err
+	}
+	lic.defaults()
+	if err := lic.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *LineItem
+	)
+	if len(lic.hooks) == 0 {
+		node, err = lic.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LineItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lic.mutation = mutation
+			node, err = lic.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(lic.hooks) - 1; i >= 0; i-- {
+			mut = lic.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lic.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lic *LineItemCreate) SaveX(ctx context.Context) *LineItem {
+	v, err := lic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (lic *LineItemCreate) Unwrap() *LineItemCreate {
+	tx, ok := lic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LineItemCreate is not a transactional builder")
+	}
+	lic.config.driver = tx.drv
+	lic.mutation.driver = tx.drv
+	return lic
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (lic *LineItemCreate) DebugFields() map[string]Value {
+	return debugFields(lic.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (lic *LineItemCreate) defaults() {
+	if _, ok := lic.mutation.Price(); !ok {
+		v := lineitem.DefaultPrice
+		lic.mutation.SetPrice(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lic *LineItemCreate) check() error {
+	if _, ok := lic.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New("ent: missing required field \"tenant_id\"")}
+	}
+	if _, ok := lic.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New("ent: missing required field \"quantity\"")}
+	}
+	if v, ok := lic.mutation.Price(); ok {
+		if err := lineitem.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf("ent: validator failed for field \"price\": %v", err)}
+		}
+	}
+	return nil
+}
+
+func (lic *LineItemCreate) sqlSave(ctx context.Context) (*LineItem, error) {
+	ctx = lic.withOperation(ctx, "LineItem", "Create")
+	li, _spec := lic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lic.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	li.ID = int(id)
+	return li, nil
+}
+
+// SaveID creates the LineItem in the database and returns only its id. Unlike Save,
+// the LineItem entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// LineItem that holds only its id as the value that is returned by the next mutator.
+func (lic *LineItemCreate) SaveID(ctx context.Context) (id int, err error) {
+	lic.defaults()
+	if err = lic.check(); err != nil {
+		return id, err
+	}
+	if len(lic.hooks) == 0 {
+		return lic.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*LineItemMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		lic.mutation = mutation
+		if id, err = lic.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &LineItem{config: lic.config, ID: id}, nil
+	})
+	for i := len(lic.hooks) - 1; i >= 0; i-- {
+		mut = lic.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, lic.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (lic *LineItemCreate) SaveIDX(ctx context.Context) int {
+	id, err := lic.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (lic *LineItemCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = lic.withOperation(ctx, "LineItem", "Create")
+	_spec := lic.idSpec()
+	if err = sqlgraph.CreateNode(ctx, lic.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (lic *LineItemCreate) createSpec() (*LineItem, *sqlgraph.CreateSpec) {
+	li := &LineItem{config: lic.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: lineitem.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: lineitem.FieldID,
+		},
+	}
+	if value, ok := lic.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldTenantID,
+		})
+		li.TenantID = value
+	}
+	if value, ok := lic.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldQuantity,
+		})
+		li.Quantity = value
+	}
+	if value, ok := lic.mutation.Price(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldPrice,
+		})
+		li.Price = value
+	}
+	if value, ok := lic.mutation.Discount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldDiscount,
+		})
+		li.Discount = value
+	}
+	if nodes := lic.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.M2O,
+			Inverse:       true,
+			Table:         lineitem.InvoiceTable,
+			Columns:       []string{lineitem.InvoiceColumn},
+			Bidi:          false,
+			SharedColumns: []string{lineitem.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return li, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (lic *LineItemCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: lineitem.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: lineitem.FieldID,
+		},
+	}
+	if value, ok := lic.mutation.TenantID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldTenantID,
+		})
+	}
+	if value, ok := lic.mutation.Quantity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldQuantity,
+		})
+	}
+	if value, ok := lic.mutation.Price(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldPrice,
+		})
+	}
+	if value, ok := lic.mutation.Discount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldDiscount,
+		})
+	}
+	if nodes := lic.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.M2O,
+			Inverse:       true,
+			Table:         lineitem.InvoiceTable,
+			Columns:       []string{lineitem.InvoiceColumn},
+			Bidi:          false,
+			SharedColumns: []string{lineitem.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// LineItemCreateBulk is the builder for creating a bulk of LineItem entities.
+type LineItemCreateBulk struct {
+	config
+	builders []*LineItemCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the LineItem entities in the database.
+func (licb *LineItemCreateBulk) Save(ctx context.Context) ([]*LineItem, error) {
+	nodes, err := licb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && licb.retries > 0 && isSQLDeadlockError(err) {
+		return licb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (licb *LineItemCreateBulk) SaveX(ctx context.Context) []*LineItem {
+	v, err := licb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (licb *LineItemCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range licb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (licb *LineItemCreateBulk) CheckRefs() *LineItemCreateBulk {
+	licb.refs = true
+	return licb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (licb *LineItemCreateBulk) RetryOnDeadlock(max int) *LineItemCreateBulk {
+	licb.retries = max
+	return licb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.LineItem.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.LineItem.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (licb *LineItemCreateBulk) OnConflict(columns ...string) *LineItemUpsertBulk {
+	return &LineItemUpsertBulk{create: licb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.LineItem.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (lic *LineItemCreate) OnConflict(target ...sql.ConflictOption) *LineItemUpsertBulk {
+	bulk := &LineItemCreateBulk{config: lic.config, builders: []*LineItemCreate{lic}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (licb *LineItemCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*LineItem, error) {
+	ctx = licb.withOperation(ctx, "LineItem", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(licb.builders))
+		nodes    = make([]*LineItem, len(licb.builders))
+		mutators = make([]Mutator, len(licb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range licb.builders {
+		func(i int, root context.Context) {
+			builder := licb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LineItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, licb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if licb.refs {
+						if err := licb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, licb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, licb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (licb *LineItemCreateBulk) retry(ctx context.Context, err error) ([]*LineItem, error) {
+	if _, ok := licb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := licb.builders
+	defer func() { licb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return licb.less(builders[idx[i]], builders[idx[j]])
+	})
+	licb.builders = make([]*LineItemCreate, len(idx))
+	for i, j := range idx {
+		licb.builders[i] = builders[j]
+	}
+	for n := 0; n < licb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*LineItem
+		if sorted, err = licb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*LineItem, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (licb *LineItemCreateBulk) less(a, b *LineItemCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (licb *LineItemCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range licb.builders {
+			for _, id := range builder.mutation.InvoiceIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, licb.driver, invoice.Table, invoice.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[lineitem.EdgeInvoice] = append(missing[lineitem.EdgeInvoice], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "LineItem", Missing: missing}
+	}
+	return nil
+}
+
+// LineItemUpsertBulk is the builder for the conflict handling of a bulk of LineItem entities.
+type LineItemUpsertBulk struct {
+	create  *LineItemCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (liub *LineItemUpsertBulk) Target(target ...sql.ConflictOption) *LineItemUpsertBulk {
+	liub.target = append(liub.target, target...)
+	return liub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (liub *LineItemUpsertBulk) DoNothing() *LineItemUpsertBulk {
+	liub.nothing = true
+	return liub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (liub *LineItemUpsertBulk) UpdateNewValues() *LineItemUpsertBulk {
+	liub.update = true
+	return liub
+}
+
+// Save creates the LineItem entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (liub *LineItemUpsertBulk) Save(ctx context.Context) ([]*LineItem, int, error) {
+	switch {
+	case !liub.nothing && !liub.update:
+		return nil, 0, errors.New("ent: missing conflict action for LineItem bulk insert")
+	case liub.nothing && liub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for LineItem bulk insert")
+	case liub.update:
+		if len(liub.columns) == 0 && len(liub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for LineItem bulk upsert")
+		}
+		nodes, err := liub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(liub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(lineitem.FieldID),
+			),
+			Columns:         lineitem.Columns,
+			ConflictColumns: liub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(liub.conflictTarget(), sql.DoNothing())}
+	nodes, err := liub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*LineItem, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (liub *LineItemUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(liub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(liub.columns...))
+	}
+	return append(opts, liub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (liub *LineItemUpsertBulk) SaveX(ctx context.Context) ([]*LineItem, int) {
+	nodes, skipped, err := liub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (liub *LineItemUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := liub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (liub *LineItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := liub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
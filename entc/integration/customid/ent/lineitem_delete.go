@@ -0,0 +1,141 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// LineItemDelete is the builder for deleting a LineItem entity.
+type LineItemDelete struct {
+	config
+	hooks      []Hook
+	mutation   *LineItemMutation
+	predicates []predicate.LineItem
+	limit      *int
+}
+
+// Where adds a new predicate to the delete builder.
+func (lid *LineItemDelete) Where(ps ...predicate.LineItem) *LineItemDelete {
+	lid.predicates = append(lid.predicates, ps...)
+	return lid
+}
+
+// Limit limits the number of lineitems that are deleted by the builder. For example,
+// for deleting large amounts of lineitems in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (lid *LineItemDelete) Limit(limit int) *LineItemDelete {
+	lid.limit = &limit
+	return lid
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (lid *LineItemDelete) Unwrap() *LineItemDelete {
+	tx, ok := lid.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LineItemDelete is not a transactional builder")
+	}
+	lid.config.driver = tx.drv
+	lid.mutation.driver = tx.drv
+	return lid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lid *LineItemDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(lid.driver); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(lid.hooks) == 0 {
+		affected, err = lid.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LineItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			lid.mutation = mutation
+			affected, err = lid.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(lid.hooks) - 1; i >= 0; i-- {
+			mut = lid.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, lid.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lid *LineItemDelete) ExecX(ctx context.Context) int {
+	n, err := lid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lid *LineItemDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = lid.withOperation(ctx, "LineItem", "Delete")
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: lineitem.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: lineitem.FieldID,
+			},
+		},
+	}
+	if ps := lid.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := lid.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, lid.mutationDriver(ctx), _spec)
+}
+
+// LineItemDeleteOne is the builder for deleting a single LineItem entity.
+type LineItemDeleteOne struct {
+	lid *LineItemDelete
+}
+
+// Exec executes the deletion query.
+func (lido *LineItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := lido.lid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0 && !sql.IsDryRun(ctx):
+		return &NotFoundError{lineitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lido *LineItemDeleteOne) ExecX(ctx context.Context) {
+	lido.lid.ExecX(ctx)
+}
@@ -0,0 +1,1344 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// LineItemQuery is the builder for querying LineItem entities.
+type LineItemQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.LineItem
+	// eager-loading edges.
+	withInvoice *InvoiceQuery
+	withFKs     bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see LineItemSelect.All).
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (liq *LineItemQuery) Where(ps ...predicate.LineItem) *LineItemQuery {
+	liq.predicates = append(liq.predicates, ps...)
+	return liq
+}
+
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (liq *LineItemQuery) Predicates() []predicate.LineItem {
+	return append([]predicate.LineItem{}, liq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (liq *LineItemQuery) SetPredicates(ps ...predicate.LineItem) *LineItemQuery {
+	liq.predicates = append([]predicate.LineItem{}, ps...)
+	return liq
+}
+
+// Limit adds a limit step to the query.
+func (liq *LineItemQuery) Limit(limit int) *LineItemQuery {
+	liq.limit = &limit
+	return liq
+}
+
+// Offset adds an offset step to the query.
+func (liq *LineItemQuery) Offset(offset int) *LineItemQuery {
+	liq.offset = &offset
+	return liq
+}
+
+// Order adds an order step to the query.
+func (liq *LineItemQuery) Order(o ...OrderFunc) *LineItemQuery {
+	liq.order = append(liq.order, o...)
+	return liq
+}
+
+// QueryInvoice chains the current query on the invoice edge.
+func (liq *LineItemQuery) QueryInvoice() *InvoiceQuery {
+	query := &InvoiceQuery{config: liq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := liq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(lineitem.Table, lineitem.FieldID, liq.sqlQuery()),
+			sqlgraph.To(invoice.Table, invoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, lineitem.InvoiceTable, lineitem.InvoiceColumn),
+			sqlgraph.SharedColumns(lineitem.FieldTenantID),
+		)
+		fromU = sqlgraph.SetNeighbors(liq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LineItem entity in the query. Returns *NotFoundError when no lineitem was found.
+func (liq *LineItemQuery) First(ctx context.Context) (*LineItem, error) {
+	lis, err := liq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(lis) == 0 {
+		return nil, &NotFoundError{lineitem.Label}
+	}
+	return lis[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (liq *LineItemQuery) FirstX(ctx context.Context) *LineItem {
+	li, err := liq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return li
+}
+
+// FirstID returns the first LineItem id in the query. Returns *NotFoundError when no id was found.
+func (liq *LineItemQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = liq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{lineitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (liq *LineItemQuery) FirstXID(ctx context.Context) int {
+	id, err := liq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only LineItem entity in the query, returns an error if not exactly one entity was returned.
+func (liq *LineItemQuery) Only(ctx context.Context) (*LineItem, error) {
+	lis, err := liq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(lis) {
+	case 1:
+		return lis[0], nil
+	case 0:
+		return nil, &NotFoundError{lineitem.Label}
+	default:
+		return nil, &NotSingularError{lineitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (liq *LineItemQuery) OnlyX(ctx context.Context) *LineItem {
+	li, err := liq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return li
+}
+
+// OnlyID returns the only LineItem id in the query, returns an error if not exactly one id was returned.
+func (liq *LineItemQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = liq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{lineitem.Label}
+	default:
+		err = &NotSingularError{lineitem.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (liq *LineItemQuery) OnlyXID(ctx context.Context) int {
+	id, err := liq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LineItems.
+func (liq *LineItemQuery) All(ctx context.Context) ([]*LineItem, error) {
+	if err := liq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return liq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (liq *LineItemQuery) AllX(ctx context.Context) []*LineItem {
+	lis, err := liq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return lis
+}
+
+// IDs executes the query and returns a list of LineItem ids.
+func (liq *LineItemQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := liq.Select(lineitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (liq *LineItemQuery) IDsX(ctx context.Context) []int {
+	ids, err := liq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (liq *LineItemQuery) Count(ctx context.Context) (int, error) {
+	if err := liq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return liq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (liq *LineItemQuery) CountX(ctx context.Context) int {
+	count, err := liq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (liq *LineItemQuery) Exist(ctx context.Context) (bool, error) {
+	if err := liq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return liq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (liq *LineItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := liq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (liq *LineItemQuery) Clone() *LineItemQuery {
+	return &LineItemQuery{
+		config:     liq.config,
+		limit:      liq.limit,
+		offset:     liq.offset,
+		order:      append([]OrderFunc{}, liq.order...),
+		unique:     append([]string{}, liq.unique...),
+		predicates: append([]predicate.LineItem{}, liq.predicates...),
+		// clone intermediate query.
+		sql:  liq.sql.Clone(),
+		path: liq.path,
+	}
+}
+
+//  WithInvoice tells the query-builder to eager-loads the nodes that are connected to
+// the "invoice" edge. The optional arguments used to configure the query builder of the edge.
+func (liq *LineItemQuery) WithInvoice(opts ...func(*InvoiceQuery)) *LineItemQuery {
+	query := &InvoiceQuery{config: liq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	liq.withInvoice = query
+	return liq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LineItem.Query().
+//		GroupBy(lineitem.FieldTenantID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (liq *LineItemQuery) GroupBy(field string, fields ...string) *LineItemGroupBy {
+	group := &LineItemGroupBy{config: liq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := liq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return liq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		TenantID int `json:"tenant_id,omitempty"`
+//	}
+//
+//	client.LineItem.Query().
+//		Select(lineitem.FieldTenantID).
+//		Scan(ctx, &v)
+//
+func (liq *LineItemQuery) Select(field string, fields ...string) *LineItemSelect {
+	selector := &LineItemSelect{config: liq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.query = liq
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := liq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if err := liq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return liq.sqlQuery().AppendSelectExpr(liq.exprs...), nil
+	}
+	return selector
+}
+
+func (liq *LineItemQuery) prepareQuery(ctx context.Context) error {
+	if liq.path != nil {
+		prev, err := liq.path(ctx)
+		if err != nil {
+			return err
+		}
+		liq.sql = prev
+	}
+	return nil
+}
+
+func (liq *LineItemQuery) sqlAll(ctx context.Context) ([]*LineItem, error) {
+	ctx = liq.withOperation(ctx, "LineItem", "Query")
+	if err := liq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range liq.fields {
+		if !lineitem.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
+	var (
+		nodes       = []*LineItem{}
+		withFKs     = liq.withFKs
+		_spec       = liq.querySpec()
+		loadedTypes = [1]bool{
+			liq.withInvoice != nil,
+		}
+	)
+	if liq.withInvoice != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, lineitem.ForeignKeys...)
+	}
+	_spec.Exprs = liq.exprs
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		node := &LineItem{config: liq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues(columns)
+		for range liq.exprs {
+			values = append(values, new(interface{}))
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		if n := len(liq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range liq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, liq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := liq.withInvoice; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*LineItem)
+		for i := range nodes {
+			if fk := nodes[i].invoice_line_items; fk != nil {
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && liq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`ent: limit or offset of eager-loaded edge "invoice" cannot be applied on %d neighbors with batch size %d`, len(ids), liq.eagerLoadSize())
+			return nil, err
+		}
+		err := liq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], invoice.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "invoice_line_items" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					// Nodes that do not share the columns of the composite
+					// foreign-key with the neighbor are not connected to it.
+					if nodes[i].TenantID != n.TenantID {
+						continue
+					}
+					nodes[i].Edges.Invoice = n
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nodes, nil
+}
+
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the LineItem entities (see LineItem.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.LineItem.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the LineItem table.
+func (liq *LineItemQuery) SelectExpr(exprs ...*sql.SelectExpr) *LineItemQuery {
+	liq.exprs = append(liq.exprs, exprs...)
+	return liq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (liq *LineItemQuery) checkExprs() error {
+	if len(liq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range lineitem.Columns {
+		columns[c] = true
+	}
+	for _, c := range lineitem.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range liq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of LineItem", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
+func (liq *LineItemQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = liq.withOperation(ctx, "LineItem", "Count")
+	_spec := liq.querySpec()
+	return sqlgraph.CountNodes(ctx, liq.driver, _spec)
+}
+
+func (liq *LineItemQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := liq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (liq *LineItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   lineitem.Table,
+			Columns: lineitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: lineitem.FieldID,
+			},
+		},
+		From:   liq.sql,
+		Unique: true,
+	}
+	if fields := liq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, lineitem.FieldID)
+		for i := range fields {
+			if fields[i] != lineitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = liq.forUpdate
+	_spec.PartitionBy = liq.partition
+	if ps := liq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := liq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := liq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := liq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (liq *LineItemQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(liq.driver.Dialect())
+	t1 := builder.Table(lineitem.Table)
+	selector := builder.Select(t1.Columns(lineitem.Columns...)...).From(t1)
+	if liq.sql != nil {
+		selector = liq.sql
+		selector.Select(selector.Columns(lineitem.Columns...)...)
+	}
+	for _, p := range liq.predicates {
+		p(selector)
+	}
+	for _, p := range liq.order {
+		p(selector)
+	}
+	if offset := liq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := liq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IDsQuery returns a selector that selects the ids of the LineItem entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (liq *LineItemQuery) IDsQuery() *sql.Selector {
+	selector := liq.sqlQuery()
+	if liq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(lineitem.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the LineItem entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.LineItem.Query().
+//		Where(...).
+//		CountDistinct(ctx, lineitem.FieldTenantID)
+//
+func (liq *LineItemQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := liq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = liq.withOperation(ctx, "LineItem", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, liq.driver, liq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (liq *LineItemQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := liq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched LineItem rows as generic maps that are keyed by
+// column name (see lineitem.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (liq *LineItemQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := liq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = liq.withOperation(ctx, "LineItem", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = liq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&LineItem{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, liq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (liq *LineItemQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := liq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of LineItem entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (liq *LineItemQuery) Prefetch(n int) *LineItemQuery {
+	liq.prefetch = n
+	return liq
+}
+
+// ForUpdate locks the selected LineItem rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (liq *LineItemQuery) ForUpdate() *LineItemQuery {
+	liq.forUpdate = true
+	return liq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (liq *LineItemQuery) Primary() *LineItemQuery {
+	return liq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (liq *LineItemQuery) ReadConsistency(c sql.ReadConsistency) *LineItemQuery {
+	liq.consistency = c
+	return liq
+}
+
+// Stream executes the query in batches, and sends the matched LineItem entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.LineItem.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (liq *LineItemQuery) Stream(ctx context.Context) (<-chan *LineItem, <-chan error) {
+	size := liq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *LineItem, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := liq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the LineItem entities in batches of the given
+// size, and sends them to the given channel.
+func (liq *LineItemQuery) stream(ctx context.Context, size int, nodes chan<- *LineItem) error {
+	if liq.offset != nil || len(liq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if liq.limit != nil {
+		left = *liq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *liq
+		query.predicates = liq.predicates[:len(liq.predicates):len(liq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, lineitem.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(lineitem.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// LineItemEdge is the edge representation of LineItem in a connection.
+type LineItemEdge struct {
+	Node   *LineItem `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// LineItemConnection is the connection of LineItem entities that is returned by Paginate.
+type LineItemConnection struct {
+	Edges      []LineItemEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.LineItem.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: lineitem.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (liq *LineItemQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*LineItemConnection, error) {
+	if liq.limit != nil || liq.offset != nil || len(liq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case lineitem.FieldTenantID, lineitem.FieldQuantity:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for LineItem", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := liq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: lineitem.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *liq
+	query.predicates = liq.predicates[:len(liq.predicates):len(liq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := liq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &LineItemConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]LineItemEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = LineItemEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (liq *LineItemQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.LineItem, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := liq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.LineItem(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*LineItemQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case lineitem.FieldTenantID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field tenant_id: %v", err)
+		}
+		return v, nil
+	case lineitem.FieldQuantity:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field quantity: %v", err)
+		}
+		return v, nil
+	case lineitem.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for LineItem", field)
+}
+
+// pageCursor returns the cursor of the LineItem node for the given order fields.
+func (li *LineItem) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(li.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case lineitem.FieldTenantID:
+			v = li.TenantID
+		case lineitem.FieldQuantity:
+			v = li.Quantity
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for LineItem", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
+// LineItemGroupBy is the builder for group-by LineItem entities.
+type LineItemGroupBy struct {
+	config
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ligb *LineItemGroupBy) Aggregate(fns ...AggregateFunc) *LineItemGroupBy {
+	ligb.fns = append(ligb.fns, fns...)
+	return ligb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (ligb *LineItemGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ligb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ligb.sql = query
+	return ligb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ligb *LineItemGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ligb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (ligb *LineItemGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ligb.fields) > 1 {
+		return nil, errors.New("ent: LineItemGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ligb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ligb *LineItemGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ligb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (ligb *LineItemGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ligb.fields) > 1 {
+		return nil, errors.New("ent: LineItemGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ligb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ligb *LineItemGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ligb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (ligb *LineItemGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ligb.fields) > 1 {
+		return nil, errors.New("ent: LineItemGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ligb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ligb *LineItemGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ligb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (ligb *LineItemGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ligb.fields) > 1 {
+		return nil, errors.New("ent: LineItemGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ligb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ligb *LineItemGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ligb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.LineItem.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (ligb *LineItemGroupBy) Having(ps ...*sql.Predicate) *LineItemGroupBy {
+	ligb.havings = append(ligb.havings, ps...)
+	return ligb
+}
+
+func (ligb *LineItemGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ligb.withOperation(ctx, "LineItem", "GroupBy")
+	selector := ligb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	if err := ligb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ligb *LineItemGroupBy) sqlQuery() *sql.Selector {
+	selector := ligb.sql
+	columns := make([]string, 0, len(ligb.fields)+len(ligb.fns))
+	columns = append(columns, ligb.fields...)
+	for _, fn := range ligb.fns {
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(ligb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(ligb.fields...)
+	switch len(ligb.havings) {
+	case 0:
+	case 1:
+		selector.Having(ligb.havings[0])
+	default:
+		selector.Having(sql.And(ligb.havings...))
+	}
+	return selector
+}
+
+// LineItemSelect is the builder for select fields of LineItem entities.
+type LineItemSelect struct {
+	config
+	fields []string
+	// query is the query that the fields are selected from.
+	query *LineItemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (lis *LineItemSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := lis.path(ctx)
+	if err != nil {
+		return err
+	}
+	lis.sql = query
+	return lis.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (lis *LineItemSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := lis.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (lis *LineItemSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(lis.fields) > 1 {
+		return nil, errors.New("ent: LineItemSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := lis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (lis *LineItemSelect) StringsX(ctx context.Context) []string {
+	v, err := lis.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (lis *LineItemSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(lis.fields) > 1 {
+		return nil, errors.New("ent: LineItemSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := lis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (lis *LineItemSelect) IntsX(ctx context.Context) []int {
+	v, err := lis.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (lis *LineItemSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(lis.fields) > 1 {
+		return nil, errors.New("ent: LineItemSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := lis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (lis *LineItemSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := lis.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (lis *LineItemSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(lis.fields) > 1 {
+		return nil, errors.New("ent: LineItemSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := lis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (lis *LineItemSelect) BoolsX(ctx context.Context) []bool {
+	v, err := lis.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (lis *LineItemSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(lis.fields) > 1 {
+		return nil, errors.New("ent: LineItemSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := lis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (lis *LineItemSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := lis.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the LineItem entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.LineItem.Query().
+//		Select(lineitem.FieldTenantID).
+//		All(ctx)
+//
+func (lis *LineItemSelect) All(ctx context.Context) ([]*LineItem, error) {
+	query := *lis.query
+	query.fields = lis.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (lis *LineItemSelect) AllX(ctx context.Context) []*LineItem {
+	nodes, err := lis.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+func (lis *LineItemSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = lis.withOperation(ctx, "LineItem", "Select")
+	rows := &sql.Rows{}
+	query, args := lis.sqlQuery().Query()
+	if err := lis.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (lis *LineItemSelect) sqlQuery() sql.Querier {
+	selector := lis.sql
+	selector.Select(selector.Columns(lis.fields...)...)
+	return selector
+}
@@ -0,0 +1,741 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// LineItemUpdate is the builder for updating LineItem entities.
+type LineItemUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *LineItemMutation
+	predicates []predicate.LineItem
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
+}
+
+// Where adds a new predicate for the builder.
+func (liu *LineItemUpdate) Where(ps ...predicate.LineItem) *LineItemUpdate {
+	liu.predicates = append(liu.predicates, ps...)
+	return liu
+}
+
+// Limit limits the number of lineitems that are updated by the builder. For example,
+// for updating large amounts of lineitems in small batches without locking the whole table.
+func (liu *LineItemUpdate) Limit(limit int) *LineItemUpdate {
+	liu.limit = &limit
+	return liu
+}
+
+// SetTenantID sets the tenant_id field.
+func (liu *LineItemUpdate) SetTenantID(i int) *LineItemUpdate {
+	liu.mutation.ResetTenantID()
+	liu.mutation.SetTenantID(i)
+	return liu
+}
+
+// AddTenantID adds i to tenant_id.
+func (liu *LineItemUpdate) AddTenantID(i int) *LineItemUpdate {
+	liu.mutation.AddTenantID(i)
+	return liu
+}
+
+// UnsetTenantID removes the changes of the tenant_id field from the builder (e.g. a previous call
+// to SetTenantID), and therefore, the field is left unchanged in the database.
+func (liu *LineItemUpdate) UnsetTenantID() *LineItemUpdate {
+	liu.mutation.ResetTenantID()
+	return liu
+}
+
+// SetQuantity sets the quantity field.
+func (liu *LineItemUpdate) SetQuantity(i int) *LineItemUpdate {
+	liu.mutation.ResetQuantity()
+	liu.mutation.SetQuantity(i)
+	return liu
+}
+
+// AddQuantity adds i to quantity.
+func (liu *LineItemUpdate) AddQuantity(i int) *LineItemUpdate {
+	liu.mutation.AddQuantity(i)
+	return liu
+}
+
+// UnsetQuantity removes the changes of the quantity field from the builder (e.g. a previous call
+// to SetQuantity), and therefore, the field is left unchanged in the database.
+func (liu *LineItemUpdate) UnsetQuantity() *LineItemUpdate {
+	liu.mutation.ResetQuantity()
+	return liu
+}
+
+// SetPrice sets the price field.
+func (liu *LineItemUpdate) SetPrice(s string) *LineItemUpdate {
+	liu.mutation.SetPrice(s)
+	return liu
+}
+
+// SetNillablePrice sets the price field if the given value is not nil.
+func (liu *LineItemUpdate) SetNillablePrice(s *string) *LineItemUpdate {
+	if s != nil {
+		liu.SetPrice(*s)
+	}
+	return liu
+}
+
+// UnsetPrice removes the changes of the price field from the builder (e.g. a previous call
+// to SetPrice), and therefore, the field is left unchanged in the database.
+func (liu *LineItemUpdate) UnsetPrice() *LineItemUpdate {
+	liu.mutation.ResetPrice()
+	return liu
+}
+
+// SetDiscount sets the discount field.
+func (liu *LineItemUpdate) SetDiscount(s schema.Amount) *LineItemUpdate {
+	liu.mutation.SetDiscount(s)
+	return liu
+}
+
+// SetNillableDiscount sets the discount field if the given value is not nil.
+func (liu *LineItemUpdate) SetNillableDiscount(s *schema.Amount) *LineItemUpdate {
+	if s != nil {
+		liu.SetDiscount(*s)
+	}
+	return liu
+}
+
+// ClearDiscount clears the value of discount.
+func (liu *LineItemUpdate) ClearDiscount() *LineItemUpdate {
+	liu.mutation.ClearDiscount()
+	return liu
+}
+
+// UnsetDiscount removes the changes of the discount field from the builder (e.g. a previous call
+// to SetDiscount), and therefore, the field is left unchanged in the database. Unlike ClearDiscount,
+// it does not set the field to NULL, and also removes a previous call to ClearDiscount.
+func (liu *LineItemUpdate) UnsetDiscount() *LineItemUpdate {
+	liu.mutation.ResetDiscount()
+	return liu
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (liu *LineItemUpdate) SetInvoiceID(id int) *LineItemUpdate {
+	liu.mutation.SetInvoiceID(id)
+	return liu
+}
+
+// SetNillableInvoiceID sets the invoice edge to Invoice by id if the given value is not nil.
+func (liu *LineItemUpdate) SetNillableInvoiceID(id *int) *LineItemUpdate {
+	if id != nil {
+		liu = liu.SetInvoiceID(*id)
+	}
+	return liu
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (liu *LineItemUpdate) SetInvoice(i *Invoice) *LineItemUpdate {
+	return liu.SetInvoiceID(i.ID)
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (liu *LineItemUpdate) ClearInvoice() *LineItemUpdate {
+	liu.mutation.ClearInvoice()
+	return liu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (liu *LineItemUpdate) Unwrap() *LineItemUpdate {
+	tx, ok := liu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LineItemUpdate is not a transactional builder")
+	}
+	liu.config.driver = tx.drv
+	liu.mutation.driver = tx.drv
+	return liu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (liu *LineItemUpdate) DebugFields() map[string]Value {
+	return debugFields(liu.mutation)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (liu *LineItemUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(liu.driver); err != nil {
+		return 0, err
+	}
+	if err := liu.check(); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(liu.hooks) == 0 {
+		affected, err = liu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LineItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			liu.mutation = mutation
+			affected, err = liu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(liu.hooks) - 1; i >= 0; i-- {
+			mut = liu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, liu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (liu *LineItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := liu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (liu *LineItemUpdate) Exec(ctx context.Context) error {
+	_, err := liu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (liu *LineItemUpdate) ExecX(ctx context.Context) {
+	if err := liu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (liu *LineItemUpdate) check() error {
+	if v, ok := liu.mutation.Price(); ok {
+		if err := lineitem.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf("ent: validator failed for field \"price\": %v", err)}
+		}
+	}
+	if liu.mutation.conflictinvoice {
+		return &ValidationError{Name: "invoice", err: errors.New("ent: setting and clearing the unique edge \"invoice\" in the same mutation")}
+	}
+	return nil
+}
+
+func (liu *LineItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = liu.withOperation(ctx, "LineItem", "Update")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   lineitem.Table,
+			Columns: lineitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: lineitem.FieldID,
+			},
+		},
+	}
+	if ps := liu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := liu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if liu.ids != nil {
+		_spec.ScanIDs = liu.ids
+	}
+	if value, ok := liu.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldTenantID,
+		})
+	}
+	if value, ok := liu.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldTenantID,
+		})
+	}
+	if value, ok := liu.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldQuantity,
+		})
+	}
+	if value, ok := liu.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldQuantity,
+		})
+	}
+	if value, ok := liu.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldPrice,
+		})
+	}
+	if value, ok := liu.mutation.Discount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldDiscount,
+		})
+	}
+	if liu.mutation.DiscountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Column: lineitem.FieldDiscount,
+		})
+	}
+	if liu.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.M2O,
+			Inverse:       true,
+			Table:         lineitem.InvoiceTable,
+			Columns:       []string{lineitem.InvoiceColumn},
+			Bidi:          false,
+			SharedColumns: []string{lineitem.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := liu.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.M2O,
+			Inverse:       true,
+			Table:         lineitem.InvoiceTable,
+			Columns:       []string{lineitem.InvoiceColumn},
+			Bidi:          false,
+			SharedColumns: []string{lineitem.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, liu.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lineitem.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SaveReturningIDs executes the query and returns the ids of the LineItem entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (liu *LineItemUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	liu.ids = &ids
+	defer func() { liu.ids = nil }()
+	if _, err := liu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// LineItemUpdateOne is the builder for updating a single LineItem entity.
+type LineItemUpdateOne struct {
+	config
+	hooks      []Hook
+	mutation   *LineItemMutation
+	predicates []predicate.LineItem
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (liuo *LineItemUpdateOne) Where(ps ...predicate.LineItem) *LineItemUpdateOne {
+	liuo.predicates = append(liuo.predicates, ps...)
+	return liuo
+}
+
+// SetTenantID sets the tenant_id field.
+func (liuo *LineItemUpdateOne) SetTenantID(i int) *LineItemUpdateOne {
+	liuo.mutation.ResetTenantID()
+	liuo.mutation.SetTenantID(i)
+	return liuo
+}
+
+// AddTenantID adds i to tenant_id.
+func (liuo *LineItemUpdateOne) AddTenantID(i int) *LineItemUpdateOne {
+	liuo.mutation.AddTenantID(i)
+	return liuo
+}
+
+// UnsetTenantID removes the changes of the tenant_id field from the builder (e.g. a previous call
+// to SetTenantID), and therefore, the field is left unchanged in the database.
+func (liuo *LineItemUpdateOne) UnsetTenantID() *LineItemUpdateOne {
+	liuo.mutation.ResetTenantID()
+	return liuo
+}
+
+// SetQuantity sets the quantity field.
+func (liuo *LineItemUpdateOne) SetQuantity(i int) *LineItemUpdateOne {
+	liuo.mutation.ResetQuantity()
+	liuo.mutation.SetQuantity(i)
+	return liuo
+}
+
+// AddQuantity adds i to quantity.
+func (liuo *LineItemUpdateOne) AddQuantity(i int) *LineItemUpdateOne {
+	liuo.mutation.AddQuantity(i)
+	return liuo
+}
+
+// UnsetQuantity removes the changes of the quantity field from the builder (e.g. a previous call
+// to SetQuantity), and therefore, the field is left unchanged in the database.
+func (liuo *LineItemUpdateOne) UnsetQuantity() *LineItemUpdateOne {
+	liuo.mutation.ResetQuantity()
+	return liuo
+}
+
+// SetPrice sets the price field.
+func (liuo *LineItemUpdateOne) SetPrice(s string) *LineItemUpdateOne {
+	liuo.mutation.SetPrice(s)
+	return liuo
+}
+
+// SetNillablePrice sets the price field if the given value is not nil.
+func (liuo *LineItemUpdateOne) SetNillablePrice(s *string) *LineItemUpdateOne {
+	if s != nil {
+		liuo.SetPrice(*s)
+	}
+	return liuo
+}
+
+// UnsetPrice removes the changes of the price field from the builder (e.g. a previous call
+// to SetPrice), and therefore, the field is left unchanged in the database.
+func (liuo *LineItemUpdateOne) UnsetPrice() *LineItemUpdateOne {
+	liuo.mutation.ResetPrice()
+	return liuo
+}
+
+// SetDiscount sets the discount field.
+func (liuo *LineItemUpdateOne) SetDiscount(s schema.Amount) *LineItemUpdateOne {
+	liuo.mutation.SetDiscount(s)
+	return liuo
+}
+
+// SetNillableDiscount sets the discount field if the given value is not nil.
+func (liuo *LineItemUpdateOne) SetNillableDiscount(s *schema.Amount) *LineItemUpdateOne {
+	if s != nil {
+		liuo.SetDiscount(*s)
+	}
+	return liuo
+}
+
+// ClearDiscount clears the value of discount.
+func (liuo *LineItemUpdateOne) ClearDiscount() *LineItemUpdateOne {
+	liuo.mutation.ClearDiscount()
+	return liuo
+}
+
+// UnsetDiscount removes the changes of the discount field from the builder (e.g. a previous call
+// to SetDiscount), and therefore, the field is left unchanged in the database. Unlike ClearDiscount,
+// it does not set the field to NULL, and also removes a previous call to ClearDiscount.
+func (liuo *LineItemUpdateOne) UnsetDiscount() *LineItemUpdateOne {
+	liuo.mutation.ResetDiscount()
+	return liuo
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (liuo *LineItemUpdateOne) SetInvoiceID(id int) *LineItemUpdateOne {
+	liuo.mutation.SetInvoiceID(id)
+	return liuo
+}
+
+// SetNillableInvoiceID sets the invoice edge to Invoice by id if the given value is not nil.
+func (liuo *LineItemUpdateOne) SetNillableInvoiceID(id *int) *LineItemUpdateOne {
+	if id != nil {
+		liuo = liuo.SetInvoiceID(*id)
+	}
+	return liuo
+}
+
+// SetInvoice sets the invoice edge to Invoice.
+func (liuo *LineItemUpdateOne) SetInvoice(i *Invoice) *LineItemUpdateOne {
+	return liuo.SetInvoiceID(i.ID)
+}
+
+// ClearInvoice clears the invoice edge to Invoice.
+func (liuo *LineItemUpdateOne) ClearInvoice() *LineItemUpdateOne {
+	liuo.mutation.ClearInvoice()
+	return liuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (liuo *LineItemUpdateOne) Unwrap() *LineItemUpdateOne {
+	tx, ok := liuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LineItemUpdateOne is not a transactional builder")
+	}
+	liuo.config.driver = tx.drv
+	liuo.mutation.driver = tx.drv
+	return liuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (liuo *LineItemUpdateOne) DebugFields() map[string]Value {
+	return debugFields(liuo.mutation)
+}
+
+// Save executes the query and returns the updated entity.
+func (liuo *LineItemUpdateOne) Save(ctx context.Context) (*LineItem, error) {
+	if err := checkTx(liuo.driver); err != nil {
+		return nil, err
+	}
+	if err := liuo.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *LineItem
+	)
+	if len(liuo.hooks) == 0 {
+		node, err = liuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*LineItemMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			liuo.mutation = mutation
+			node, err = liuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(liuo.hooks) - 1; i >= 0; i-- {
+			mut = liuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, liuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (liuo *LineItemUpdateOne) SaveX(ctx context.Context) *LineItem {
+	li, err := liuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return li
+}
+
+// Exec executes the query on the entity.
+func (liuo *LineItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := liuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (liuo *LineItemUpdateOne) ExecX(ctx context.Context) {
+	if err := liuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (liuo *LineItemUpdateOne) check() error {
+	if v, ok := liuo.mutation.Price(); ok {
+		if err := lineitem.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf("ent: validator failed for field \"price\": %v", err)}
+		}
+	}
+	if liuo.mutation.conflictinvoice {
+		return &ValidationError{Name: "invoice", err: errors.New("ent: setting and clearing the unique edge \"invoice\" in the same mutation")}
+	}
+	return nil
+}
+
+func (liuo *LineItemUpdateOne) sqlSave(ctx context.Context) (li *LineItem, err error) {
+	ctx = liuo.withOperation(ctx, "LineItem", "UpdateOne")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   lineitem.Table,
+			Columns: lineitem.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: lineitem.FieldID,
+			},
+		},
+	}
+	id, ok := liuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing LineItem.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if ps := liuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := liuo.mutation.TenantID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldTenantID,
+		})
+	}
+	if value, ok := liuo.mutation.AddedTenantID(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldTenantID,
+		})
+	}
+	if value, ok := liuo.mutation.Quantity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldQuantity,
+		})
+	}
+	if value, ok := liuo.mutation.AddedQuantity(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: lineitem.FieldQuantity,
+		})
+	}
+	if value, ok := liuo.mutation.Price(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldPrice,
+		})
+	}
+	if value, ok := liuo.mutation.Discount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: lineitem.FieldDiscount,
+		})
+	}
+	if liuo.mutation.DiscountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Column: lineitem.FieldDiscount,
+		})
+	}
+	if liuo.mutation.InvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.M2O,
+			Inverse:       true,
+			Table:         lineitem.InvoiceTable,
+			Columns:       []string{lineitem.InvoiceColumn},
+			Bidi:          false,
+			SharedColumns: []string{lineitem.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := liuo.mutation.InvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:           sqlgraph.M2O,
+			Inverse:       true,
+			Table:         lineitem.InvoiceTable,
+			Columns:       []string{lineitem.InvoiceColumn},
+			Bidi:          false,
+			SharedColumns: []string{lineitem.FieldTenantID},
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: invoice.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	li = &LineItem{config: liuo.config}
+	_spec.Assign = func(values ...interface{}) error {
+		return li.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = li.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, liuo.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lineitem.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		li.ID = id
+		if value, ok := liuo.mutation.TenantID(); ok {
+			li.TenantID = value
+		}
+		if value, ok := liuo.mutation.Quantity(); ok {
+			li.Quantity = value
+		}
+		if value, ok := liuo.mutation.Price(); ok {
+			li.Price = value
+		}
+		if value, ok := liuo.mutation.Discount(); ok {
+			li.Discount = value
+		}
+	}
+	return li, nil
+}
@@ -54,6 +54,18 @@ var (
 			},
 		},
 	}
+	// DevicesColumns holds the columns for the "devices" table.
+	DevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+	}
+	// DevicesTable holds the schema information for the "devices" table.
+	DevicesTable = &schema.Table{
+		Name:        "devices",
+		Columns:     DevicesColumns,
+		PrimaryKey:  []*schema.Column{DevicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
 	// GroupsColumns holds the columns for the "groups" table.
 	GroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -65,6 +77,63 @@ var (
 		PrimaryKey:  []*schema.Column{GroupsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
 	}
+	// InvoicesColumns holds the columns for the "invoices" table.
+	InvoicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+	}
+	// InvoicesTable holds the schema information for the "invoices" table.
+	InvoicesTable = &schema.Table{
+		Name:        "invoices",
+		Columns:     InvoicesColumns,
+		PrimaryKey:  []*schema.Column{InvoicesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+		Indexes: []*schema.Index{
+			{
+				Name:    "invoices_tenant_id_id",
+				Unique:  true,
+				Columns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[0]},
+			},
+		},
+	}
+	// LineItemsColumns holds the columns for the "line_items" table.
+	LineItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "tenant_id", Type: field.TypeInt},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "price", Type: field.TypeDecimal, Precision: 12, Scale: 2, Default: "0.00"},
+		{Name: "discount", Type: field.TypeDecimal, Nullable: true, Precision: 8, Scale: 2},
+		{Name: "invoice_line_items", Type: field.TypeInt, Nullable: true},
+	}
+	// LineItemsTable holds the schema information for the "line_items" table.
+	LineItemsTable = &schema.Table{
+		Name:       "line_items",
+		Columns:    LineItemsColumns,
+		PrimaryKey: []*schema.Column{LineItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "line_items_invoices_line_items",
+				Columns: []*schema.Column{LineItemsColumns[1], LineItemsColumns[5]},
+
+				RefColumns: []*schema.Column{InvoicesColumns[1], InvoicesColumns[0]},
+			},
+		},
+	}
+	// NotesColumns holds the columns for the "notes" table.
+	NotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, DefaultExprs: map[string]string{"postgres": "'note_' || lpad(nextval('note_seq')::text, 6, '0')"}, Sequence: "note_seq"},
+		{Name: "text", Type: field.TypeString},
+		{Name: "ttl", Type: field.TypeDuration, Nullable: true},
+		{Name: "owner_type", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+	}
+	// NotesTable holds the schema information for the "notes" table.
+	NotesTable = &schema.Table{
+		Name:        "notes",
+		Columns:     NotesColumns,
+		PrimaryKey:  []*schema.Column{NotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{},
+	}
 	// PetsColumns holds the columns for the "pets" table.
 	PetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true, Size: 25},
@@ -96,6 +165,16 @@ var (
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "last_ip", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(45)", "postgres": "inet", "sqlite3": "varchar(45)"}},
+		{Name: "allowed_network", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(49)", "postgres": "cidr", "sqlite3": "varchar(49)"}},
+		{Name: "ssn", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "tax_id", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "email", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "email_bidx", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "address_street", Type: field.TypeString, Nullable: true},
+		{Name: "address_city", Type: field.TypeString, Nullable: true},
+		{Name: "address_zip_code", Type: field.TypeInt, Nullable: true},
+		{Name: "address_since", Type: field.TypeTime, Nullable: true},
 		{Name: "user_children", Type: field.TypeInt, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -106,7 +185,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:  "users_users_children",
-				Columns: []*schema.Column{UsersColumns[1]},
+				Columns: []*schema.Column{UsersColumns[11]},
 
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
@@ -198,7 +277,11 @@ var (
 	Tables = []*schema.Table{
 		BlobsTable,
 		CarsTable,
+		DevicesTable,
 		GroupsTable,
+		InvoicesTable,
+		LineItemsTable,
+		NotesTable,
 		PetsTable,
 		UsersTable,
 		BlobLinksTable,
@@ -210,6 +293,7 @@ var (
 func init() {
 	BlobsTable.ForeignKeys[0].RefTable = BlobsTable
 	CarsTable.ForeignKeys[0].RefTable = PetsTable
+	LineItemsTable.ForeignKeys[0].RefTable = InvoicesTable
 	PetsTable.ForeignKeys[0].RefTable = PetsTable
 	PetsTable.ForeignKeys[1].RefTable = UsersTable
 	UsersTable.ForeignKeys[0].RefTable = UsersTable
@@ -7,12 +7,20 @@
 package ent
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/blob"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/car"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/device"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/group"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/invoice"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/note"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/user"
 	"github.com/google/uuid"
 
@@ -28,26 +36,31 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBlob  = "Blob"
-	TypeCar   = "Car"
-	TypeGroup = "Group"
-	TypePet   = "Pet"
-	TypeUser  = "User"
+	TypeBlob     = "Blob"
+	TypeCar      = "Car"
+	TypeDevice   = "Device"
+	TypeGroup    = "Group"
+	TypeInvoice  = "Invoice"
+	TypeLineItem = "LineItem"
+	TypeNote     = "Note"
+	TypePet      = "Pet"
+	TypeUser     = "User"
 )
 
 // BlobMutation represents an operation that mutate the Blobs
 // nodes in the graph.
 type BlobMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	uuid          *uuid.UUID
-	clearedFields map[string]struct{}
-	parent        *uuid.UUID
-	clearedparent bool
-	links         map[uuid.UUID]struct{}
-	removedlinks  map[uuid.UUID]struct{}
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	uuid           *uuid.UUID
+	clearedFields  map[string]struct{}
+	parent         *uuid.UUID
+	clearedparent  bool
+	conflictparent bool
+	links          map[uuid.UUID]struct{}
+	removedlinks   map[uuid.UUID]struct{}
 }
 
 var _ ent.Mutation = (*BlobMutation)(nil)
@@ -81,6 +94,18 @@ func (m BlobMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m BlobMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // SetID sets the value of the id field. Note that, this
 // operation is accepted only on Blob creation.
 func (m *BlobMutation) SetID(id uuid.UUID) {
@@ -118,11 +143,15 @@ func (m *BlobMutation) ResetUUID() {
 // SetParentID sets the parent edge to Blob by id.
 func (m *BlobMutation) SetParentID(id uuid.UUID) {
 	m.parent = &id
+	m.conflictparent = false
 }
 
-// ClearParent clears the parent edge to Blob.
+// ClearParent clears the parent edge to Blob. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *BlobMutation) ClearParent() {
 	m.clearedparent = true
+	m.conflictparent = m.parent != nil
 }
 
 // ParentCleared returns if the edge parent was cleared.
@@ -138,6 +167,34 @@ func (m *BlobMutation) ParentID() (id uuid.UUID, exists bool) {
 	return
 }
 
+// ParentIDChanged reports if the parent edge is set to a different Blob, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *BlobMutation) ParentIDChanged(ctx context.Context) (oldID, newID uuid.UUID, changed bool, err error) {
+	if m.parent == nil && !m.clearedparent {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged requires an ID field in the mutation")
+	}
+	if m.parent != nil {
+		newID = *m.parent
+	}
+	oldID, err = m.Client().Blob.Query().Where(blob.ID(id)).QueryParent().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.parent != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old parent id: %v", err)
+	}
+	return oldID, newID, m.parent == nil || oldID != newID, nil
+}
+
 // ParentIDs returns the parent ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // ParentID instead. It exists only for internal usage by the builders.
@@ -152,6 +209,7 @@ func (m *BlobMutation) ParentIDs() (ids []uuid.UUID) {
 func (m *BlobMutation) ResetParent() {
 	m.parent = nil
 	m.clearedparent = false
+	m.conflictparent = false
 }
 
 // AddLinkIDs adds the links edge to Blob by ids.
@@ -206,6 +264,11 @@ func (m *BlobMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *BlobMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -409,6 +472,7 @@ type CarMutation struct {
 	clearedFields map[string]struct{}
 	owner         *string
 	clearedowner  bool
+	conflictowner bool
 }
 
 var _ ent.Mutation = (*CarMutation)(nil)
@@ -442,6 +506,18 @@ func (m CarMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CarMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CarMutation) ID() (id int, exists bool) {
@@ -473,11 +549,15 @@ func (m *CarMutation) ResetModel() {
 // SetOwnerID sets the owner edge to Pet by id.
 func (m *CarMutation) SetOwnerID(id string) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to Pet.
+// ClearOwner clears the owner edge to Pet. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *CarMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -493,6 +573,34 @@ func (m *CarMutation) OwnerID() (id string, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different Pet, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *CarMutation) OwnerIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Car.Query().Where(car.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -507,6 +615,7 @@ func (m *CarMutation) OwnerIDs() (ids []string) {
 func (m *CarMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // Op returns the operation name.
@@ -519,6 +628,11 @@ func (m *CarMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CarMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -690,6 +804,260 @@ func (m *CarMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Car edge %s", name)
 }
 
+// DeviceMutation represents an operation that mutate the Devices
+// nodes in the graph.
+type DeviceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	clearedFields map[string]struct{}
+}
+
+var _ ent.Mutation = (*DeviceMutation)(nil)
+
+// newDeviceMutation creates new mutation for $n.Name.
+func newDeviceMutation(c config, op Op) *DeviceMutation {
+	return &DeviceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDevice,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m DeviceMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on Device creation.
+func (m *DeviceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *DeviceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *DeviceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *DeviceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *DeviceMutation) ResetName() {
+	m.name = nil
+}
+
+// Op returns the operation name.
+func (m *DeviceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Device).
+func (m *DeviceMutation) Type() string {
+	return m.typ
+}
+
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *DeviceMutation) String() string {
+	return mutationString(m)
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *DeviceMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, device.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case device.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *DeviceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case device.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Device field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *DeviceMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *DeviceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Device numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *DeviceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *DeviceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Device nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *DeviceMutation) ResetField(name string) error {
+	switch name {
+	case device.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown Device field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *DeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *DeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *DeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *DeviceMutation) EdgeCleared(name string) bool {
+	switch name {
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *DeviceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Device unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *DeviceMutation) ResetEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Device edge %s", name)
+}
+
 // GroupMutation represents an operation that mutate the Groups
 // nodes in the graph.
 type GroupMutation struct {
@@ -733,6 +1101,18 @@ func (m GroupMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m GroupMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // SetID sets the value of the id field. Note that, this
 // operation is accepted only on Group creation.
 func (m *GroupMutation) SetID(id int) {
@@ -800,6 +1180,11 @@ func (m *GroupMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *GroupMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -959,39 +1344,35 @@ func (m *GroupMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Group edge %s", name)
 }
 
-// PetMutation represents an operation that mutate the Pets
+// InvoiceMutation represents an operation that mutate the Invoices
 // nodes in the graph.
-type PetMutation struct {
+type InvoiceMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	clearedFields      map[string]struct{}
-	owner              *int
-	clearedowner       bool
-	cars               map[int]struct{}
-	removedcars        map[int]struct{}
-	friends            map[string]struct{}
-	removedfriends     map[string]struct{}
-	best_friend        *string
-	clearedbest_friend bool
+	op                Op
+	typ               string
+	id                *int
+	tenant_id         *int
+	addtenant_id      *int
+	clearedFields     map[string]struct{}
+	line_items        map[int]struct{}
+	removedline_items map[int]struct{}
 }
 
-var _ ent.Mutation = (*PetMutation)(nil)
+var _ ent.Mutation = (*InvoiceMutation)(nil)
 
-// newPetMutation creates new mutation for $n.Name.
-func newPetMutation(c config, op Op) *PetMutation {
-	return &PetMutation{
+// newInvoiceMutation creates new mutation for $n.Name.
+func newInvoiceMutation(c config, op Op) *InvoiceMutation {
+	return &InvoiceMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePet,
+		typ:           TypeInvoice,
 		clearedFields: make(map[string]struct{}),
 	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PetMutation) Client() *Client {
+func (m InvoiceMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -999,7 +1380,7 @@ func (m PetMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PetMutation) Tx() (*Tx, error) {
+func (m InvoiceMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -1008,47 +1389,1458 @@ func (m PetMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that, this
-// operation is accepted only on Pet creation.
-func (m *PetMutation) SetID(id string) {
-	m.id = &id
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m InvoiceMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
 }
 
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *PetMutation) ID() (id string, exists bool) {
+func (m *InvoiceMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetOwnerID sets the owner edge to User by id.
-func (m *PetMutation) SetOwnerID(id int) {
-	m.owner = &id
+// SetTenantID sets the tenant_id field.
+func (m *InvoiceMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
 }
 
-// ClearOwner clears the owner edge to User.
-func (m *PetMutation) ClearOwner() {
-	m.clearedowner = true
+// TenantID returns the tenant_id value in the mutation.
+func (m *InvoiceMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OwnerCleared returns if the edge owner was cleared.
-func (m *PetMutation) OwnerCleared() bool {
-	return m.clearedowner
+// AddTenantID adds i to tenant_id.
+func (m *InvoiceMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
 }
 
-// OwnerID returns the owner id in the mutation.
-func (m *PetMutation) OwnerID() (id int, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// AddedTenantID returns the value that was added to the tenant_id field in this mutation.
+func (m *InvoiceMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the owner ids in the mutation.
-// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
+// ResetTenantID reset all changes of the "tenant_id" field.
+func (m *InvoiceMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// AddLineItemIDs adds the line_items edge to LineItem by ids.
+func (m *InvoiceMutation) AddLineItemIDs(ids ...int) {
+	if m.line_items == nil {
+		m.line_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.line_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveLineItemIDs removes the line_items edge to LineItem by ids.
+func (m *InvoiceMutation) RemoveLineItemIDs(ids ...int) {
+	if m.removedline_items == nil {
+		m.removedline_items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedline_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLineItems returns the removed ids of line_items.
+func (m *InvoiceMutation) RemovedLineItemsIDs() (ids []int) {
+	for id := range m.removedline_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LineItemsIDs returns the line_items ids in the mutation.
+func (m *InvoiceMutation) LineItemsIDs() (ids []int) {
+	for id := range m.line_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLineItems reset all changes of the "line_items" edge.
+func (m *InvoiceMutation) ResetLineItems() {
+	m.line_items = nil
+	m.removedline_items = nil
+}
+
+// Op returns the operation name.
+func (m *InvoiceMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Invoice).
+func (m *InvoiceMutation) Type() string {
+	return m.typ
+}
+
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *InvoiceMutation) String() string {
+	return mutationString(m)
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *InvoiceMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.tenant_id != nil {
+		fields = append(fields, invoice.FieldTenantID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *InvoiceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldTenantID:
+		return m.TenantID()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *InvoiceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *InvoiceMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, invoice.FieldTenantID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *InvoiceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case invoice.FieldTenantID:
+		return m.AddedTenantID()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *InvoiceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case invoice.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *InvoiceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *InvoiceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *InvoiceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Invoice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *InvoiceMutation) ResetField(name string) error {
+	switch name {
+	case invoice.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *InvoiceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.line_items != nil {
+		edges = append(edges, invoice.EdgeLineItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *InvoiceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case invoice.EdgeLineItems:
+		ids := make([]ent.Value, 0, len(m.line_items))
+		for id := range m.line_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *InvoiceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedline_items != nil {
+		edges = append(edges, invoice.EdgeLineItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *InvoiceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case invoice.EdgeLineItems:
+		ids := make([]ent.Value, 0, len(m.removedline_items))
+		for id := range m.removedline_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *InvoiceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *InvoiceMutation) EdgeCleared(name string) bool {
+	switch name {
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *InvoiceMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Invoice unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *InvoiceMutation) ResetEdge(name string) error {
+	switch name {
+	case invoice.EdgeLineItems:
+		m.ResetLineItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Invoice edge %s", name)
+}
+
+// LineItemMutation represents an operation that mutate the LineItems
+// nodes in the graph.
+type LineItemMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	tenant_id       *int
+	addtenant_id    *int
+	quantity        *int
+	addquantity     *int
+	price           *string
+	discount        *schema.Amount
+	clearedFields   map[string]struct{}
+	invoice         *int
+	clearedinvoice  bool
+	conflictinvoice bool
+}
+
+var _ ent.Mutation = (*LineItemMutation)(nil)
+
+// newLineItemMutation creates new mutation for $n.Name.
+func newLineItemMutation(c config, op Op) *LineItemMutation {
+	return &LineItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLineItem,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LineItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LineItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m LineItemMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *LineItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetTenantID sets the tenant_id field.
+func (m *LineItemMutation) SetTenantID(i int) {
+	m.tenant_id = &i
+	m.addtenant_id = nil
+}
+
+// TenantID returns the tenant_id value in the mutation.
+func (m *LineItemMutation) TenantID() (r int, exists bool) {
+	v := m.tenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddTenantID adds i to tenant_id.
+func (m *LineItemMutation) AddTenantID(i int) {
+	if m.addtenant_id != nil {
+		*m.addtenant_id += i
+	} else {
+		m.addtenant_id = &i
+	}
+}
+
+// AddedTenantID returns the value that was added to the tenant_id field in this mutation.
+func (m *LineItemMutation) AddedTenantID() (r int, exists bool) {
+	v := m.addtenant_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTenantID reset all changes of the "tenant_id" field.
+func (m *LineItemMutation) ResetTenantID() {
+	m.tenant_id = nil
+	m.addtenant_id = nil
+}
+
+// SetQuantity sets the quantity field.
+func (m *LineItemMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the quantity value in the mutation.
+func (m *LineItemMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddQuantity adds i to quantity.
+func (m *LineItemMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the quantity field in this mutation.
+func (m *LineItemMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity reset all changes of the "quantity" field.
+func (m *LineItemMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetPrice sets the price field.
+func (m *LineItemMutation) SetPrice(s string) {
+	m.price = &s
+}
+
+// Price returns the price value in the mutation.
+func (m *LineItemMutation) Price() (r string, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice reset all changes of the "price" field.
+func (m *LineItemMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetDiscount sets the discount field.
+func (m *LineItemMutation) SetDiscount(s schema.Amount) {
+	m.discount = &s
+}
+
+// Discount returns the discount value in the mutation.
+func (m *LineItemMutation) Discount() (r schema.Amount, exists bool) {
+	v := m.discount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDiscount clears the value of discount.
+func (m *LineItemMutation) ClearDiscount() {
+	m.discount = nil
+	m.clearedFields[lineitem.FieldDiscount] = struct{}{}
+}
+
+// DiscountCleared returns if the field discount was cleared in this mutation.
+func (m *LineItemMutation) DiscountCleared() bool {
+	_, ok := m.clearedFields[lineitem.FieldDiscount]
+	return ok
+}
+
+// ResetDiscount reset all changes of the "discount" field.
+func (m *LineItemMutation) ResetDiscount() {
+	m.discount = nil
+	delete(m.clearedFields, lineitem.FieldDiscount)
+}
+
+// SetInvoiceID sets the invoice edge to Invoice by id.
+func (m *LineItemMutation) SetInvoiceID(id int) {
+	m.invoice = &id
+	m.conflictinvoice = false
+}
+
+// ClearInvoice clears the invoice edge to Invoice. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
+func (m *LineItemMutation) ClearInvoice() {
+	m.clearedinvoice = true
+	m.conflictinvoice = m.invoice != nil
+}
+
+// InvoiceCleared returns if the edge invoice was cleared.
+func (m *LineItemMutation) InvoiceCleared() bool {
+	return m.clearedinvoice
+}
+
+// InvoiceID returns the invoice id in the mutation.
+func (m *LineItemMutation) InvoiceID() (id int, exists bool) {
+	if m.invoice != nil {
+		return *m.invoice, true
+	}
+	return
+}
+
+// InvoiceIDChanged reports if the invoice edge is set to a different Invoice, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *LineItemMutation) InvoiceIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.invoice == nil && !m.clearedinvoice {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: InvoiceIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: InvoiceIDChanged requires an ID field in the mutation")
+	}
+	if m.invoice != nil {
+		newID = *m.invoice
+	}
+	oldID, err = m.Client().LineItem.Query().Where(lineitem.ID(id)).QueryInvoice().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.invoice != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old invoice id: %v", err)
+	}
+	return oldID, newID, m.invoice == nil || oldID != newID, nil
+}
+
+// InvoiceIDs returns the invoice ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// InvoiceID instead. It exists only for internal usage by the builders.
+func (m *LineItemMutation) InvoiceIDs() (ids []int) {
+	if id := m.invoice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvoice reset all changes of the "invoice" edge.
+func (m *LineItemMutation) ResetInvoice() {
+	m.invoice = nil
+	m.clearedinvoice = false
+	m.conflictinvoice = false
+}
+
+// Op returns the operation name.
+func (m *LineItemMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (LineItem).
+func (m *LineItemMutation) Type() string {
+	return m.typ
+}
+
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *LineItemMutation) String() string {
+	return mutationString(m)
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *LineItemMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.tenant_id != nil {
+		fields = append(fields, lineitem.FieldTenantID)
+	}
+	if m.quantity != nil {
+		fields = append(fields, lineitem.FieldQuantity)
+	}
+	if m.price != nil {
+		fields = append(fields, lineitem.FieldPrice)
+	}
+	if m.discount != nil {
+		fields = append(fields, lineitem.FieldDiscount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *LineItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lineitem.FieldTenantID:
+		return m.TenantID()
+	case lineitem.FieldQuantity:
+		return m.Quantity()
+	case lineitem.FieldPrice:
+		return m.Price()
+	case lineitem.FieldDiscount:
+		return m.Discount()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *LineItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lineitem.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case lineitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case lineitem.FieldPrice:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case lineitem.FieldDiscount:
+		v, ok := value.(schema.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LineItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *LineItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addtenant_id != nil {
+		fields = append(fields, lineitem.FieldTenantID)
+	}
+	if m.addquantity != nil {
+		fields = append(fields, lineitem.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *LineItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case lineitem.FieldTenantID:
+		return m.AddedTenantID()
+	case lineitem.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *LineItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case lineitem.FieldTenantID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTenantID(v)
+		return nil
+	case lineitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LineItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *LineItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(lineitem.FieldDiscount) {
+		fields = append(fields, lineitem.FieldDiscount)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *LineItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LineItemMutation) ClearField(name string) error {
+	switch name {
+	case lineitem.FieldDiscount:
+		m.ClearDiscount()
+		return nil
+	}
+	return fmt.Errorf("unknown LineItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *LineItemMutation) ResetField(name string) error {
+	switch name {
+	case lineitem.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case lineitem.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case lineitem.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case lineitem.FieldDiscount:
+		m.ResetDiscount()
+		return nil
+	}
+	return fmt.Errorf("unknown LineItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *LineItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.invoice != nil {
+		edges = append(edges, lineitem.EdgeInvoice)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *LineItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case lineitem.EdgeInvoice:
+		if id := m.invoice; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *LineItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *LineItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *LineItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedinvoice {
+		edges = append(edges, lineitem.EdgeInvoice)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *LineItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case lineitem.EdgeInvoice:
+		return m.clearedinvoice
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *LineItemMutation) ClearEdge(name string) error {
+	switch name {
+	case lineitem.EdgeInvoice:
+		m.ClearInvoice()
+		return nil
+	}
+	return fmt.Errorf("unknown LineItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *LineItemMutation) ResetEdge(name string) error {
+	switch name {
+	case lineitem.EdgeInvoice:
+		m.ResetInvoice()
+		return nil
+	}
+	return fmt.Errorf("unknown LineItem edge %s", name)
+}
+
+// NoteMutation represents an operation that mutate the Notes
+// nodes in the graph.
+type NoteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	text          *string
+	ttl           *time.Duration
+	addttl        *time.Duration
+	owner_type    *string
+	owner_id      *string
+	clearedFields map[string]struct{}
+}
+
+var _ ent.Mutation = (*NoteMutation)(nil)
+
+// newNoteMutation creates new mutation for $n.Name.
+func newNoteMutation(c config, op Op) *NoteMutation {
+	return &NoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNote,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m NoteMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on Note creation.
+func (m *NoteMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *NoteMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetText sets the text field.
+func (m *NoteMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the text value in the mutation.
+func (m *NoteMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetText reset all changes of the "text" field.
+func (m *NoteMutation) ResetText() {
+	m.text = nil
+}
+
+// SetTTL sets the ttl field.
+func (m *NoteMutation) SetTTL(t time.Duration) {
+	m.ttl = &t
+	m.addttl = nil
+}
+
+// TTL returns the ttl value in the mutation.
+func (m *NoteMutation) TTL() (r time.Duration, exists bool) {
+	v := m.ttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddTTL adds t to ttl.
+func (m *NoteMutation) AddTTL(t time.Duration) {
+	if m.addttl != nil {
+		*m.addttl += t
+	} else {
+		m.addttl = &t
+	}
+}
+
+// AddedTTL returns the value that was added to the ttl field in this mutation.
+func (m *NoteMutation) AddedTTL() (r time.Duration, exists bool) {
+	v := m.addttl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTTL clears the value of ttl.
+func (m *NoteMutation) ClearTTL() {
+	m.ttl = nil
+	m.addttl = nil
+	m.clearedFields[note.FieldTTL] = struct{}{}
+}
+
+// TTLCleared returns if the field ttl was cleared in this mutation.
+func (m *NoteMutation) TTLCleared() bool {
+	_, ok := m.clearedFields[note.FieldTTL]
+	return ok
+}
+
+// ResetTTL reset all changes of the "ttl" field.
+func (m *NoteMutation) ResetTTL() {
+	m.ttl = nil
+	m.addttl = nil
+	delete(m.clearedFields, note.FieldTTL)
+}
+
+// SetOwnerType sets the owner_type field.
+func (m *NoteMutation) SetOwnerType(s string) {
+	m.owner_type = &s
+}
+
+// OwnerType returns the owner_type value in the mutation.
+func (m *NoteMutation) OwnerType() (r string, exists bool) {
+	v := m.owner_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOwnerType clears the value of owner_type.
+func (m *NoteMutation) ClearOwnerType() {
+	m.owner_type = nil
+	m.clearedFields[note.FieldOwnerType] = struct{}{}
+}
+
+// OwnerTypeCleared returns if the field owner_type was cleared in this mutation.
+func (m *NoteMutation) OwnerTypeCleared() bool {
+	_, ok := m.clearedFields[note.FieldOwnerType]
+	return ok
+}
+
+// ResetOwnerType reset all changes of the "owner_type" field.
+func (m *NoteMutation) ResetOwnerType() {
+	m.owner_type = nil
+	delete(m.clearedFields, note.FieldOwnerType)
+}
+
+// SetOwnerID sets the owner_id field.
+func (m *NoteMutation) SetOwnerID(s string) {
+	m.owner_id = &s
+}
+
+// OwnerID returns the owner_id value in the mutation.
+func (m *NoteMutation) OwnerID() (r string, exists bool) {
+	v := m.owner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOwnerID clears the value of owner_id.
+func (m *NoteMutation) ClearOwnerID() {
+	m.owner_id = nil
+	m.clearedFields[note.FieldOwnerID] = struct{}{}
+}
+
+// OwnerIDCleared returns if the field owner_id was cleared in this mutation.
+func (m *NoteMutation) OwnerIDCleared() bool {
+	_, ok := m.clearedFields[note.FieldOwnerID]
+	return ok
+}
+
+// ResetOwnerID reset all changes of the "owner_id" field.
+func (m *NoteMutation) ResetOwnerID() {
+	m.owner_id = nil
+	delete(m.clearedFields, note.FieldOwnerID)
+}
+
+// SetOwner sets the "owner" polymorphic edge to the given entity, by setting
+// the "owner_type" and "owner_id" fields to its type and id.
+func (m *NoteMutation) SetOwner(v NoteOwner) {
+	typ, id := v.noteOwner()
+	m.SetOwnerType(typ)
+	m.SetOwnerID(id)
+}
+
+// ClearOwner clears the "owner" polymorphic edge, by clearing
+// the "owner_type" and "owner_id" fields.
+func (m *NoteMutation) ClearOwner() {
+	m.ClearOwnerType()
+	m.ClearOwnerID()
+}
+
+// Op returns the operation name.
+func (m *NoteMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Note).
+func (m *NoteMutation) Type() string {
+	return m.typ
+}
+
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *NoteMutation) String() string {
+	return mutationString(m)
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *NoteMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.text != nil {
+		fields = append(fields, note.FieldText)
+	}
+	if m.ttl != nil {
+		fields = append(fields, note.FieldTTL)
+	}
+	if m.owner_type != nil {
+		fields = append(fields, note.FieldOwnerType)
+	}
+	if m.owner_id != nil {
+		fields = append(fields, note.FieldOwnerID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *NoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case note.FieldText:
+		return m.Text()
+	case note.FieldTTL:
+		return m.TTL()
+	case note.FieldOwnerType:
+		return m.OwnerType()
+	case note.FieldOwnerID:
+		return m.OwnerID()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *NoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case note.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case note.FieldTTL:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTTL(v)
+		return nil
+	case note.FieldOwnerType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerType(v)
+		return nil
+	case note.FieldOwnerID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *NoteMutation) AddedFields() []string {
+	var fields []string
+	if m.addttl != nil {
+		fields = append(fields, note.FieldTTL)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *NoteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case note.FieldTTL:
+		return m.AddedTTL()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *NoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case note.FieldTTL:
+		v, ok := value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTTL(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Note numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *NoteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(note.FieldTTL) {
+		fields = append(fields, note.FieldTTL)
+	}
+	if m.FieldCleared(note.FieldOwnerType) {
+		fields = append(fields, note.FieldOwnerType)
+	}
+	if m.FieldCleared(note.FieldOwnerID) {
+		fields = append(fields, note.FieldOwnerID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *NoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NoteMutation) ClearField(name string) error {
+	switch name {
+	case note.FieldTTL:
+		m.ClearTTL()
+		return nil
+	case note.FieldOwnerType:
+		m.ClearOwnerType()
+		return nil
+	case note.FieldOwnerID:
+		m.ClearOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Note nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *NoteMutation) ResetField(name string) error {
+	switch name {
+	case note.FieldText:
+		m.ResetText()
+		return nil
+	case note.FieldTTL:
+		m.ResetTTL()
+		return nil
+	case note.FieldOwnerType:
+		m.ResetOwnerType()
+		return nil
+	case note.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	}
+	return fmt.Errorf("unknown Note field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *NoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *NoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *NoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *NoteMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *NoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *NoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *NoteMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Note unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *NoteMutation) ResetEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Note edge %s", name)
+}
+
+// PetMutation represents an operation that mutate the Pets
+// nodes in the graph.
+type PetMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	clearedFields       map[string]struct{}
+	owner               *int
+	clearedowner        bool
+	conflictowner       bool
+	cars                map[int]struct{}
+	removedcars         map[int]struct{}
+	friends             map[string]struct{}
+	removedfriends      map[string]struct{}
+	best_friend         *string
+	clearedbest_friend  bool
+	conflictbest_friend bool
+}
+
+var _ ent.Mutation = (*PetMutation)(nil)
+
+// newPetMutation creates new mutation for $n.Name.
+func newPetMutation(c config, op Op) *PetMutation {
+	return &PetMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePet,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PetMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PetMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m PetMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on Pet creation.
+func (m *PetMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *PetMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetOwnerID sets the owner edge to User by id.
+func (m *PetMutation) SetOwnerID(id int) {
+	m.owner = &id
+	m.conflictowner = false
+}
+
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
+func (m *PetMutation) ClearOwner() {
+	m.clearedowner = true
+	m.conflictowner = m.owner != nil
+}
+
+// OwnerCleared returns if the edge owner was cleared.
+func (m *PetMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the owner id in the mutation.
+func (m *PetMutation) OwnerID() (id int, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *PetMutation) OwnerIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Pet.Query().Where(pet.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
+// OwnerIDs returns the owner ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
 func (m *PetMutation) OwnerIDs() (ids []int) {
 	if id := m.owner; id != nil {
 		ids = append(ids, *id)
@@ -1060,6 +2852,7 @@ func (m *PetMutation) OwnerIDs() (ids []int) {
 func (m *PetMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // AddCarIDs adds the cars edge to Car by ids.
@@ -1149,11 +2942,15 @@ func (m *PetMutation) ResetFriends() {
 // SetBestFriendID sets the best_friend edge to Pet by id.
 func (m *PetMutation) SetBestFriendID(id string) {
 	m.best_friend = &id
+	m.conflictbest_friend = false
 }
 
-// ClearBestFriend clears the best_friend edge to Pet.
+// ClearBestFriend clears the best_friend edge to Pet. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *PetMutation) ClearBestFriend() {
 	m.clearedbest_friend = true
+	m.conflictbest_friend = m.best_friend != nil
 }
 
 // BestFriendCleared returns if the edge best_friend was cleared.
@@ -1166,7 +2963,35 @@ func (m *PetMutation) BestFriendID() (id string, exists bool) {
 	if m.best_friend != nil {
 		return *m.best_friend, true
 	}
-	return
+	return
+}
+
+// BestFriendIDChanged reports if the best_friend edge is set to a different Pet, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *PetMutation) BestFriendIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.best_friend == nil && !m.clearedbest_friend {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: BestFriendIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: BestFriendIDChanged requires an ID field in the mutation")
+	}
+	if m.best_friend != nil {
+		newID = *m.best_friend
+	}
+	oldID, err = m.Client().Pet.Query().Where(pet.ID(id)).QueryBestFriend().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.best_friend != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old best_friend id: %v", err)
+	}
+	return oldID, newID, m.best_friend == nil || oldID != newID, nil
 }
 
 // BestFriendIDs returns the best_friend ids in the mutation.
@@ -1183,6 +3008,7 @@ func (m *PetMutation) BestFriendIDs() (ids []string) {
 func (m *PetMutation) ResetBestFriend() {
 	m.best_friend = nil
 	m.clearedbest_friend = false
+	m.conflictbest_friend = false
 }
 
 // Op returns the operation name.
@@ -1195,6 +3021,11 @@ func (m *PetMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *PetMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -1415,18 +3246,29 @@ func (m *PetMutation) ResetEdge(name string) error {
 // nodes in the graph.
 type UserMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	clearedFields   map[string]struct{}
-	groups          map[int]struct{}
-	removedgroups   map[int]struct{}
-	parent          *int
-	clearedparent   bool
-	children        map[int]struct{}
-	removedchildren map[int]struct{}
-	pets            map[string]struct{}
-	removedpets     map[string]struct{}
+	op                  Op
+	typ                 string
+	id                  *int
+	last_ip             *sql.IP
+	allowed_network     *sql.CIDR
+	ssn                 *string
+	tax_id              *string
+	email               *string
+	address_street      *string
+	address_city        *string
+	address_zip_code    *int
+	addaddress_zip_code *int
+	address_since       *time.Time
+	clearedFields       map[string]struct{}
+	groups              map[int]struct{}
+	removedgroups       map[int]struct{}
+	parent              *int
+	clearedparent       bool
+	conflictparent      bool
+	children            map[int]struct{}
+	removedchildren     map[int]struct{}
+	pets                map[string]struct{}
+	removedpets         map[string]struct{}
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -1460,6 +3302,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // SetID sets the value of the id field. Note that, this
 // operation is accepted only on User creation.
 func (m *UserMutation) SetID(id int) {
@@ -1475,6 +3329,315 @@ func (m *UserMutation) ID() (id int, exists bool) {
 	return *m.id, true
 }
 
+// SetLastIP sets the last_ip field.
+func (m *UserMutation) SetLastIP(s sql.IP) {
+	m.last_ip = &s
+}
+
+// LastIP returns the last_ip value in the mutation.
+func (m *UserMutation) LastIP() (r sql.IP, exists bool) {
+	v := m.last_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastIP clears the value of last_ip.
+func (m *UserMutation) ClearLastIP() {
+	m.last_ip = nil
+	m.clearedFields[user.FieldLastIP] = struct{}{}
+}
+
+// LastIPCleared returns if the field last_ip was cleared in this mutation.
+func (m *UserMutation) LastIPCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastIP]
+	return ok
+}
+
+// ResetLastIP reset all changes of the "last_ip" field.
+func (m *UserMutation) ResetLastIP() {
+	m.last_ip = nil
+	delete(m.clearedFields, user.FieldLastIP)
+}
+
+// SetAllowedNetwork sets the allowed_network field.
+func (m *UserMutation) SetAllowedNetwork(s sql.CIDR) {
+	m.allowed_network = &s
+}
+
+// AllowedNetwork returns the allowed_network value in the mutation.
+func (m *UserMutation) AllowedNetwork() (r sql.CIDR, exists bool) {
+	v := m.allowed_network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAllowedNetwork clears the value of allowed_network.
+func (m *UserMutation) ClearAllowedNetwork() {
+	m.allowed_network = nil
+	m.clearedFields[user.FieldAllowedNetwork] = struct{}{}
+}
+
+// AllowedNetworkCleared returns if the field allowed_network was cleared in this mutation.
+func (m *UserMutation) AllowedNetworkCleared() bool {
+	_, ok := m.clearedFields[user.FieldAllowedNetwork]
+	return ok
+}
+
+// ResetAllowedNetwork reset all changes of the "allowed_network" field.
+func (m *UserMutation) ResetAllowedNetwork() {
+	m.allowed_network = nil
+	delete(m.clearedFields, user.FieldAllowedNetwork)
+}
+
+// SetSsn sets the ssn field.
+func (m *UserMutation) SetSsn(s string) {
+	m.ssn = &s
+}
+
+// Ssn returns the ssn value in the mutation.
+func (m *UserMutation) Ssn() (r string, exists bool) {
+	v := m.ssn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSsn clears the value of ssn.
+func (m *UserMutation) ClearSsn() {
+	m.ssn = nil
+	m.clearedFields[user.FieldSsn] = struct{}{}
+}
+
+// SsnCleared returns if the field ssn was cleared in this mutation.
+func (m *UserMutation) SsnCleared() bool {
+	_, ok := m.clearedFields[user.FieldSsn]
+	return ok
+}
+
+// ResetSsn reset all changes of the "ssn" field.
+func (m *UserMutation) ResetSsn() {
+	m.ssn = nil
+	delete(m.clearedFields, user.FieldSsn)
+}
+
+// SetTaxID sets the tax_id field.
+func (m *UserMutation) SetTaxID(s string) {
+	m.tax_id = &s
+}
+
+// TaxID returns the tax_id value in the mutation.
+func (m *UserMutation) TaxID() (r string, exists bool) {
+	v := m.tax_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTaxID clears the value of tax_id.
+func (m *UserMutation) ClearTaxID() {
+	m.tax_id = nil
+	m.clearedFields[user.FieldTaxID] = struct{}{}
+}
+
+// TaxIDCleared returns if the field tax_id was cleared in this mutation.
+func (m *UserMutation) TaxIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldTaxID]
+	return ok
+}
+
+// ResetTaxID reset all changes of the "tax_id" field.
+func (m *UserMutation) ResetTaxID() {
+	m.tax_id = nil
+	delete(m.clearedFields, user.FieldTaxID)
+}
+
+// SetEmail sets the email field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the email value in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEmail clears the value of email.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the field email was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
+}
+
+// ResetEmail reset all changes of the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
+}
+
+// SetAddressStreet sets the address_street field.
+func (m *UserMutation) SetAddressStreet(s string) {
+	m.address_street = &s
+}
+
+// AddressStreet returns the address_street value in the mutation.
+func (m *UserMutation) AddressStreet() (r string, exists bool) {
+	v := m.address_street
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAddressStreet clears the value of address_street.
+func (m *UserMutation) ClearAddressStreet() {
+	m.address_street = nil
+	m.clearedFields[user.FieldAddressStreet] = struct{}{}
+}
+
+// AddressStreetCleared returns if the field address_street was cleared in this mutation.
+func (m *UserMutation) AddressStreetCleared() bool {
+	_, ok := m.clearedFields[user.FieldAddressStreet]
+	return ok
+}
+
+// ResetAddressStreet reset all changes of the "address_street" field.
+func (m *UserMutation) ResetAddressStreet() {
+	m.address_street = nil
+	delete(m.clearedFields, user.FieldAddressStreet)
+}
+
+// SetAddressCity sets the address_city field.
+func (m *UserMutation) SetAddressCity(s string) {
+	m.address_city = &s
+}
+
+// AddressCity returns the address_city value in the mutation.
+func (m *UserMutation) AddressCity() (r string, exists bool) {
+	v := m.address_city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAddressCity clears the value of address_city.
+func (m *UserMutation) ClearAddressCity() {
+	m.address_city = nil
+	m.clearedFields[user.FieldAddressCity] = struct{}{}
+}
+
+// AddressCityCleared returns if the field address_city was cleared in this mutation.
+func (m *UserMutation) AddressCityCleared() bool {
+	_, ok := m.clearedFields[user.FieldAddressCity]
+	return ok
+}
+
+// ResetAddressCity reset all changes of the "address_city" field.
+func (m *UserMutation) ResetAddressCity() {
+	m.address_city = nil
+	delete(m.clearedFields, user.FieldAddressCity)
+}
+
+// SetAddressZipCode sets the address_zip_code field.
+func (m *UserMutation) SetAddressZipCode(i int) {
+	m.address_zip_code = &i
+	m.addaddress_zip_code = nil
+}
+
+// AddressZipCode returns the address_zip_code value in the mutation.
+func (m *UserMutation) AddressZipCode() (r int, exists bool) {
+	v := m.address_zip_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddAddressZipCode adds i to address_zip_code.
+func (m *UserMutation) AddAddressZipCode(i int) {
+	if m.addaddress_zip_code != nil {
+		*m.addaddress_zip_code += i
+	} else {
+		m.addaddress_zip_code = &i
+	}
+}
+
+// AddedAddressZipCode returns the value that was added to the address_zip_code field in this mutation.
+func (m *UserMutation) AddedAddressZipCode() (r int, exists bool) {
+	v := m.addaddress_zip_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAddressZipCode clears the value of address_zip_code.
+func (m *UserMutation) ClearAddressZipCode() {
+	m.address_zip_code = nil
+	m.addaddress_zip_code = nil
+	m.clearedFields[user.FieldAddressZipCode] = struct{}{}
+}
+
+// AddressZipCodeCleared returns if the field address_zip_code was cleared in this mutation.
+func (m *UserMutation) AddressZipCodeCleared() bool {
+	_, ok := m.clearedFields[user.FieldAddressZipCode]
+	return ok
+}
+
+// ResetAddressZipCode reset all changes of the "address_zip_code" field.
+func (m *UserMutation) ResetAddressZipCode() {
+	m.address_zip_code = nil
+	m.addaddress_zip_code = nil
+	delete(m.clearedFields, user.FieldAddressZipCode)
+}
+
+// SetAddressSince sets the address_since field.
+func (m *UserMutation) SetAddressSince(t time.Time) {
+	m.address_since = &t
+}
+
+// AddressSince returns the address_since value in the mutation.
+func (m *UserMutation) AddressSince() (r time.Time, exists bool) {
+	v := m.address_since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAddressSince clears the value of address_since.
+func (m *UserMutation) ClearAddressSince() {
+	m.address_since = nil
+	m.clearedFields[user.FieldAddressSince] = struct{}{}
+}
+
+// AddressSinceCleared returns if the field address_since was cleared in this mutation.
+func (m *UserMutation) AddressSinceCleared() bool {
+	_, ok := m.clearedFields[user.FieldAddressSince]
+	return ok
+}
+
+// ResetAddressSince reset all changes of the "address_since" field.
+func (m *UserMutation) ResetAddressSince() {
+	m.address_since = nil
+	delete(m.clearedFields, user.FieldAddressSince)
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (m *UserMutation) AddGroupIDs(ids ...int) {
 	if m.groups == nil {
@@ -1520,11 +3683,15 @@ func (m *UserMutation) ResetGroups() {
 // SetParentID sets the parent edge to User by id.
 func (m *UserMutation) SetParentID(id int) {
 	m.parent = &id
+	m.conflictparent = false
 }
 
-// ClearParent clears the parent edge to User.
+// ClearParent clears the parent edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearParent() {
 	m.clearedparent = true
+	m.conflictparent = m.parent != nil
 }
 
 // ParentCleared returns if the edge parent was cleared.
@@ -1540,6 +3707,34 @@ func (m *UserMutation) ParentID() (id int, exists bool) {
 	return
 }
 
+// ParentIDChanged reports if the parent edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) ParentIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.parent == nil && !m.clearedparent {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged requires an ID field in the mutation")
+	}
+	if m.parent != nil {
+		newID = *m.parent
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryParent().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.parent != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old parent id: %v", err)
+	}
+	return oldID, newID, m.parent == nil || oldID != newID, nil
+}
+
 // ParentIDs returns the parent ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // ParentID instead. It exists only for internal usage by the builders.
@@ -1554,6 +3749,7 @@ func (m *UserMutation) ParentIDs() (ids []int) {
 func (m *UserMutation) ResetParent() {
 	m.parent = nil
 	m.clearedparent = false
+	m.conflictparent = false
 }
 
 // AddChildIDs adds the children edge to User by ids.
@@ -1650,11 +3846,43 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+	fields := make([]string, 0, 9)
+	if m.last_ip != nil {
+		fields = append(fields, user.FieldLastIP)
+	}
+	if m.allowed_network != nil {
+		fields = append(fields, user.FieldAllowedNetwork)
+	}
+	if m.ssn != nil {
+		fields = append(fields, user.FieldSsn)
+	}
+	if m.tax_id != nil {
+		fields = append(fields, user.FieldTaxID)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.address_street != nil {
+		fields = append(fields, user.FieldAddressStreet)
+	}
+	if m.address_city != nil {
+		fields = append(fields, user.FieldAddressCity)
+	}
+	if m.address_zip_code != nil {
+		fields = append(fields, user.FieldAddressZipCode)
+	}
+	if m.address_since != nil {
+		fields = append(fields, user.FieldAddressSince)
+	}
 	return fields
 }
 
@@ -1663,6 +3891,24 @@ func (m *UserMutation) Fields() []string {
 // not set, or was not define in the schema.
 func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case user.FieldLastIP:
+		return m.LastIP()
+	case user.FieldAllowedNetwork:
+		return m.AllowedNetwork()
+	case user.FieldSsn:
+		return m.Ssn()
+	case user.FieldTaxID:
+		return m.TaxID()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldAddressStreet:
+		return m.AddressStreet()
+	case user.FieldAddressCity:
+		return m.AddressCity()
+	case user.FieldAddressZipCode:
+		return m.AddressZipCode()
+	case user.FieldAddressSince:
+		return m.AddressSince()
 	}
 	return nil, false
 }
@@ -1672,6 +3918,69 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // type mismatch the field type.
 func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldLastIP:
+		v, ok := value.(sql.IP)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastIP(v)
+		return nil
+	case user.FieldAllowedNetwork:
+		v, ok := value.(sql.CIDR)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAllowedNetwork(v)
+		return nil
+	case user.FieldSsn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSsn(v)
+		return nil
+	case user.FieldTaxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaxID(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldAddressStreet:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressStreet(v)
+		return nil
+	case user.FieldAddressCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressCity(v)
+		return nil
+	case user.FieldAddressZipCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressZipCode(v)
+		return nil
+	case user.FieldAddressSince:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressSince(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -1679,13 +3988,21 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
 func (m *UserMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addaddress_zip_code != nil {
+		fields = append(fields, user.FieldAddressZipCode)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
 func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldAddressZipCode:
+		return m.AddedAddressZipCode()
+	}
 	return nil, false
 }
 
@@ -1694,6 +4011,13 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // type mismatch the field type.
 func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldAddressZipCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAddressZipCode(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User numeric field %s", name)
 }
@@ -1701,7 +4025,35 @@ func (m *UserMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
 func (m *UserMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(user.FieldLastIP) {
+		fields = append(fields, user.FieldLastIP)
+	}
+	if m.FieldCleared(user.FieldAllowedNetwork) {
+		fields = append(fields, user.FieldAllowedNetwork)
+	}
+	if m.FieldCleared(user.FieldSsn) {
+		fields = append(fields, user.FieldSsn)
+	}
+	if m.FieldCleared(user.FieldTaxID) {
+		fields = append(fields, user.FieldTaxID)
+	}
+	if m.FieldCleared(user.FieldEmail) {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.FieldCleared(user.FieldAddressStreet) {
+		fields = append(fields, user.FieldAddressStreet)
+	}
+	if m.FieldCleared(user.FieldAddressCity) {
+		fields = append(fields, user.FieldAddressCity)
+	}
+	if m.FieldCleared(user.FieldAddressZipCode) {
+		fields = append(fields, user.FieldAddressZipCode)
+	}
+	if m.FieldCleared(user.FieldAddressSince) {
+		fields = append(fields, user.FieldAddressSince)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
@@ -1714,6 +4066,35 @@ func (m *UserMutation) FieldCleared(name string) bool {
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldLastIP:
+		m.ClearLastIP()
+		return nil
+	case user.FieldAllowedNetwork:
+		m.ClearAllowedNetwork()
+		return nil
+	case user.FieldSsn:
+		m.ClearSsn()
+		return nil
+	case user.FieldTaxID:
+		m.ClearTaxID()
+		return nil
+	case user.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case user.FieldAddressStreet:
+		m.ClearAddressStreet()
+		return nil
+	case user.FieldAddressCity:
+		m.ClearAddressCity()
+		return nil
+	case user.FieldAddressZipCode:
+		m.ClearAddressZipCode()
+		return nil
+	case user.FieldAddressSince:
+		m.ClearAddressSince()
+		return nil
+	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
@@ -1722,6 +4103,33 @@ func (m *UserMutation) ClearField(name string) error {
 // defined in the schema.
 func (m *UserMutation) ResetField(name string) error {
 	switch name {
+	case user.FieldLastIP:
+		m.ResetLastIP()
+		return nil
+	case user.FieldAllowedNetwork:
+		m.ResetAllowedNetwork()
+		return nil
+	case user.FieldSsn:
+		m.ResetSsn()
+		return nil
+	case user.FieldTaxID:
+		m.ResetTaxID()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldAddressStreet:
+		m.ResetAddressStreet()
+		return nil
+	case user.FieldAddressCity:
+		m.ResetAddressCity()
+		return nil
+	case user.FieldAddressZipCode:
+		m.ResetAddressZipCode()
+		return nil
+	case user.FieldAddressSince:
+		m.ResetAddressSince()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
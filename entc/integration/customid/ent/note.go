@@ -0,0 +1,289 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/note"
+)
+
+// Note is the model entity for the Note schema.
+type Note struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// TTL holds the value of the "ttl" field.
+	TTL time.Duration `json:"ttl,omitempty"`
+	// OwnerType holds the value of the "owner_type" field.
+	OwnerType string `json:"owner_type,omitempty"`
+	// OwnerID holds the value of the "owner_id" field.
+	OwnerID string `json:"owner_id,omitempty"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
+	// polyEdges holds the entities of the polymorphic edges that
+	// were eager-loaded by the query, keyed by the edge name.
+	polyEdges map[string]Value
+}
+
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Note type are scanned as generic values.
+func (*Note) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case note.FieldID:
+			values[i] = &sql.NullString{}
+		case note.FieldText:
+			values[i] = &sql.NullString{}
+		case note.FieldTTL:
+			values[i] = &sql.NullDuration{}
+		case note.FieldOwnerType:
+			values[i] = &sql.NullString{}
+		case note.FieldOwnerID:
+			values[i] = &sql.NullString{}
+		default:
+			values[i] = new(interface{})
+		}
+	}
+	return values
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Note fields. Values of columns that were not selected are left untouched.
+func (n *Note) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case note.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				n.ID = value.String
+			}
+		case note.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				n.Text = value.String
+			}
+		case note.FieldTTL:
+			if value, ok := values[i].(*sql.NullDuration); !ok {
+				return fmt.Errorf("unexpected type %T for field ttl", values[i])
+			} else if value.Valid {
+				n.TTL = value.Duration
+			}
+		case note.FieldOwnerType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_type", values[i])
+			} else if value.Valid {
+				n.OwnerType = value.String
+			}
+		case note.FieldOwnerID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
+			} else if value.Valid {
+				n.OwnerID = value.String
+			}
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (n *Note) SelectValue(alias string) (Value, error) {
+	v, ok := n.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
+// NoteOwner is implemented by the entities that the "owner" polymorphic
+// edge of the Note can point to: *Pet, *Note.
+type NoteOwner interface {
+	noteOwner() (string, string)
+}
+
+// QueryOwner queries the entity that the "owner" polymorphic edge of the Note points to,
+// using the type that is stored in its "owner_type" field. A *NotFoundError is returned if
+// the edge is not set, or if the entity does not exist.
+func (n *Note) QueryOwner(ctx context.Context) (Value, error) {
+	switch n.OwnerType {
+	case "Pet":
+		node, err := (&PetClient{config: n.config}).Get(ctx, n.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		return node, nil
+	case "Note":
+		node, err := (&NoteClient{config: n.config}).Get(ctx, n.OwnerID)
+		if err != nil {
+			return nil, err
+		}
+		return node, nil
+	case "":
+		return nil, &NotFoundError{label: "owner"}
+	default:
+		return nil, fmt.Errorf("ent: unexpected type %q for Note owner", n.OwnerType)
+	}
+}
+
+// OwnerOrErr returns the entity of the "owner" polymorphic edge if it was eager-loaded
+// (see NoteQuery.WithOwner), or an error otherwise.
+func (n *Note) OwnerOrErr() (Value, error) {
+	v, ok := n.polyEdges["owner"]
+	switch {
+	case !ok:
+		return nil, &NotLoadedError{edge: "owner"}
+	case v == nil:
+		return nil, &NotFoundError{label: "owner"}
+	}
+	return v, nil
+}
+
+// noteOwner implements the NoteOwner interface.
+func (n *Note) noteOwner() (string, string) {
+	return "Note", n.ID
+}
+
+// DumpGraph returns the JSON encoding of the Note and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Note alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Note itself is not modified.
+//
+//	b, err := n.DumpGraph(ctx, client, 2)
+//
+func (n *Note) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Note DumpGraph", depth)
+	}
+	dumped := *n
+	if err := dumpNotes(ctx, client, []*Note{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpNotes loads the edges of the given Note copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpNotes(ctx context.Context, client *Client, nodes []*Note, depth int, dumper *graphDump) error {
+	return nil
+}
+
+// Update returns a builder for updating this Note.
+// Note that, you need to call Note.Unwrap() before calling this method, if this Note
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (n *Note) Update() *NoteUpdateOne {
+	return (&NoteClient{config: n.config}).UpdateOne(n)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (n *Note) Unwrap() *Note {
+	tx, ok := n.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Note is not a transactional entity")
+	}
+	n.config.driver = tx.drv
+	return n
+}
+
+// Clone returns a deep copy of the Note and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (n *Note) Clone() *Note {
+	return n.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Note and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (n *Note) clone(seen map[interface{}]interface{}) *Note {
+	if n == nil {
+		return nil
+	}
+	if v, ok := seen[n]; ok {
+		return v.(*Note)
+	}
+	_c := *n
+	seen[n] = &_c
+	return &_c
+}
+
+// String implements the fmt.Stringer.
+func (n *Note) String() string {
+	var builder strings.Builder
+	builder.WriteString("Note(")
+	builder.WriteString(fmt.Sprintf("id=%v", n.ID))
+	builder.WriteString(", text=")
+	builder.WriteString(n.Text)
+	builder.WriteString(", ttl=")
+	builder.WriteString(fmt.Sprintf("%v", n.TTL))
+	builder.WriteString(", owner_type=")
+	builder.WriteString(n.OwnerType)
+	builder.WriteString(", owner_id=")
+	builder.WriteString(n.OwnerID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Fingerprint returns a stable hash of the content of the Note, for change detection and cache keys.
+// It covers the fields that are listed in note.FingerprintFields, and it does not depend on the
+// ID of the Note, on its edges, or on the order of the fields in the schema.
+func (n *Note) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "owner_id", n.OwnerID)
+	fingerprint(h, "owner_type", n.OwnerType)
+	fingerprint(h, "text", n.Text)
+	fingerprint(h, "ttl", n.TTL)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Note for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Note can be decoded using the FromMap method of its client.
+func (n *Note) ToMap() map[string]string {
+	m := make(map[string]string, 5)
+	m[note.FieldID] = kvEncode(n.ID)
+	m[note.FieldText] = kvEncode(n.Text)
+	if v := n.TTL; !reflect.ValueOf(v).IsZero() {
+		m[note.FieldTTL] = kvEncode(v)
+	}
+	if v := n.OwnerType; !reflect.ValueOf(v).IsZero() {
+		m[note.FieldOwnerType] = kvEncode(v)
+	}
+	if v := n.OwnerID; !reflect.ValueOf(v).IsZero() {
+		m[note.FieldOwnerID] = kvEncode(v)
+	}
+	return m
+}
+
+// Notes is a parsable slice of Note.
+type Notes []*Note
+
+func (n Notes) config(cfg config) {
+	for _i := range n {
+		n[_i].config = cfg
+	}
+}
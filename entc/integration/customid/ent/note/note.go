@@ -0,0 +1,49 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package note
+
+const (
+	// Label holds the string label denoting the note type in the database.
+	Label = "note"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID        = "id"         // FieldText holds the string denoting the text vertex property in the database.
+	FieldText      = "text"       // FieldTTL holds the string denoting the ttl vertex property in the database.
+	FieldTTL       = "ttl"        // FieldOwnerType holds the string denoting the owner_type vertex property in the database.
+	FieldOwnerType = "owner_type" // FieldOwnerID holds the string denoting the owner_id vertex property in the database.
+	FieldOwnerID   = "owner_id"
+
+	// Table holds the table name of the note in the database.
+	Table = "notes"
+)
+
+// FingerprintFields holds the fields of the Note type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldOwnerID,
+	FieldOwnerType,
+	FieldText,
+	FieldTTL,
+}
+
+// Columns holds all SQL columns for note fields.
+var Columns = []string{
+	FieldID,
+	FieldText,
+	FieldTTL,
+	FieldOwnerType,
+	FieldOwnerID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
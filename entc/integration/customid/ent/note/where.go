@@ -0,0 +1,861 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package note
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldText), v))
+	})
+}
+
+// TTL applies equality check predicate on the "ttl" field. It's identical to TTLEQ.
+func TTL(v time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTTL), sql.DurationValue(s.Dialect(), v)))
+	})
+}
+
+// OwnerType applies equality check predicate on the "owner_type" field. It's identical to OwnerTypeEQ.
+func OwnerType(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerID applies equality check predicate on the "owner_id" field. It's identical to OwnerIDEQ.
+func OwnerID(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldText), v))
+	})
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldText), v))
+	})
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.Note {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldText), v...))
+	})
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.Note {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldText), v...))
+	})
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldText), v))
+	})
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldText), v))
+	})
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldText), v))
+	})
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldText), v))
+	})
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldText), v))
+	})
+}
+
+// TextNotContains applies the NotContains predicate on the "text" field.
+func TextNotContains(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldText), v))
+	})
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldText), v))
+	})
+}
+
+// TextNotHasPrefix applies the NotHasPrefix predicate on the "text" field.
+func TextNotHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldText), v))
+	})
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldText), v))
+	})
+}
+
+// TextNotHasSuffix applies the NotHasSuffix predicate on the "text" field.
+func TextNotHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldText), v))
+	})
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldText), v))
+	})
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldText), v))
+	})
+}
+
+// TextHasPrefixFold applies the HasPrefixFold predicate on the "text" field.
+func TextHasPrefixFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldText), v))
+	})
+}
+
+// TextHasSuffixFold applies the HasSuffixFold predicate on the "text" field.
+func TextHasSuffixFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldText), v))
+	})
+}
+
+// TTLEQ applies the EQ predicate on the "ttl" field.
+func TTLEQ(v time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTTL), sql.DurationValue(s.Dialect(), v)))
+	})
+}
+
+// TTLNEQ applies the NEQ predicate on the "ttl" field.
+func TTLNEQ(v time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTTL), sql.DurationValue(s.Dialect(), v)))
+	})
+}
+
+// TTLIn applies the In predicate on the "ttl" field.
+func TTLIn(vs ...time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(vs))
+		for i := range v {
+			v[i] = sql.DurationValue(s.Dialect(), vs[i])
+		}
+		s.Where(sql.In(s.C(FieldTTL), v...))
+	})
+}
+
+// TTLNotIn applies the NotIn predicate on the "ttl" field.
+func TTLNotIn(vs ...time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(vs))
+		for i := range v {
+			v[i] = sql.DurationValue(s.Dialect(), vs[i])
+		}
+		s.Where(sql.NotIn(s.C(FieldTTL), v...))
+	})
+}
+
+// TTLGT applies the GT predicate on the "ttl" field.
+func TTLGT(v time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTTL), sql.DurationValue(s.Dialect(), v)))
+	})
+}
+
+// TTLGTE applies the GTE predicate on the "ttl" field.
+func TTLGTE(v time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTTL), sql.DurationValue(s.Dialect(), v)))
+	})
+}
+
+// TTLLT applies the LT predicate on the "ttl" field.
+func TTLLT(v time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTTL), sql.DurationValue(s.Dialect(), v)))
+	})
+}
+
+// TTLLTE applies the LTE predicate on the "ttl" field.
+func TTLLTE(v time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTTL), sql.DurationValue(s.Dialect(), v)))
+	})
+}
+
+// TTLIsNil applies the IsNil predicate on the "ttl" field.
+func TTLIsNil() predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTTL)))
+	})
+}
+
+// TTLNotNil applies the NotNil predicate on the "ttl" field.
+func TTLNotNil() predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTTL)))
+	})
+}
+
+// OwnerTypeEQ applies the EQ predicate on the "owner_type" field.
+func OwnerTypeEQ(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeNEQ applies the NEQ predicate on the "owner_type" field.
+func OwnerTypeNEQ(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeIn applies the In predicate on the "owner_type" field.
+func OwnerTypeIn(vs ...string) predicate.Note {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOwnerType), v...))
+	})
+}
+
+// OwnerTypeNotIn applies the NotIn predicate on the "owner_type" field.
+func OwnerTypeNotIn(vs ...string) predicate.Note {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOwnerType), v...))
+	})
+}
+
+// OwnerTypeGT applies the GT predicate on the "owner_type" field.
+func OwnerTypeGT(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeGTE applies the GTE predicate on the "owner_type" field.
+func OwnerTypeGTE(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeLT applies the LT predicate on the "owner_type" field.
+func OwnerTypeLT(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeLTE applies the LTE predicate on the "owner_type" field.
+func OwnerTypeLTE(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeContains applies the Contains predicate on the "owner_type" field.
+func OwnerTypeContains(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeNotContains applies the NotContains predicate on the "owner_type" field.
+func OwnerTypeNotContains(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeHasPrefix applies the HasPrefix predicate on the "owner_type" field.
+func OwnerTypeHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeNotHasPrefix applies the NotHasPrefix predicate on the "owner_type" field.
+func OwnerTypeNotHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeHasSuffix applies the HasSuffix predicate on the "owner_type" field.
+func OwnerTypeHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeNotHasSuffix applies the NotHasSuffix predicate on the "owner_type" field.
+func OwnerTypeNotHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeIsNil applies the IsNil predicate on the "owner_type" field.
+func OwnerTypeIsNil() predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOwnerType)))
+	})
+}
+
+// OwnerTypeNotNil applies the NotNil predicate on the "owner_type" field.
+func OwnerTypeNotNil() predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOwnerType)))
+	})
+}
+
+// OwnerTypeEqualFold applies the EqualFold predicate on the "owner_type" field.
+func OwnerTypeEqualFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeContainsFold applies the ContainsFold predicate on the "owner_type" field.
+func OwnerTypeContainsFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeHasPrefixFold applies the HasPrefixFold predicate on the "owner_type" field.
+func OwnerTypeHasPrefixFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerTypeHasSuffixFold applies the HasSuffixFold predicate on the "owner_type" field.
+func OwnerTypeHasSuffixFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldOwnerType), v))
+	})
+}
+
+// OwnerIDEQ applies the EQ predicate on the "owner_id" field.
+func OwnerIDEQ(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDNEQ applies the NEQ predicate on the "owner_id" field.
+func OwnerIDNEQ(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDIn applies the In predicate on the "owner_id" field.
+func OwnerIDIn(vs ...string) predicate.Note {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldOwnerID), v...))
+	})
+}
+
+// OwnerIDNotIn applies the NotIn predicate on the "owner_id" field.
+func OwnerIDNotIn(vs ...string) predicate.Note {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Note(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldOwnerID), v...))
+	})
+}
+
+// OwnerIDGT applies the GT predicate on the "owner_id" field.
+func OwnerIDGT(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDGTE applies the GTE predicate on the "owner_id" field.
+func OwnerIDGTE(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDLT applies the LT predicate on the "owner_id" field.
+func OwnerIDLT(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDLTE applies the LTE predicate on the "owner_id" field.
+func OwnerIDLTE(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDContains applies the Contains predicate on the "owner_id" field.
+func OwnerIDContains(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDNotContains applies the NotContains predicate on the "owner_id" field.
+func OwnerIDNotContains(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDHasPrefix applies the HasPrefix predicate on the "owner_id" field.
+func OwnerIDHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDNotHasPrefix applies the NotHasPrefix predicate on the "owner_id" field.
+func OwnerIDNotHasPrefix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDHasSuffix applies the HasSuffix predicate on the "owner_id" field.
+func OwnerIDHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDNotHasSuffix applies the NotHasSuffix predicate on the "owner_id" field.
+func OwnerIDNotHasSuffix(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDIsNil applies the IsNil predicate on the "owner_id" field.
+func OwnerIDIsNil() predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldOwnerID)))
+	})
+}
+
+// OwnerIDNotNil applies the NotNil predicate on the "owner_id" field.
+func OwnerIDNotNil() predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldOwnerID)))
+	})
+}
+
+// OwnerIDEqualFold applies the EqualFold predicate on the "owner_id" field.
+func OwnerIDEqualFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDContainsFold applies the ContainsFold predicate on the "owner_id" field.
+func OwnerIDContainsFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDHasPrefixFold applies the HasPrefixFold predicate on the "owner_id" field.
+func OwnerIDHasPrefixFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldOwnerID), v))
+	})
+}
+
+// OwnerIDHasSuffixFold applies the HasSuffixFold predicate on the "owner_id" field.
+func OwnerIDHasSuffixFold(v string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldOwnerID), v))
+	})
+}
+
+// TTLEQNullSafe applies a NULL-safe equality predicate on the "ttl" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func TTLEQNullSafe(v *time.Duration) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = sql.DurationValue(s.Dialect(), *v)
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldTTL), arg))
+	})
+}
+
+// OwnerTypeEQNullSafe applies a NULL-safe equality predicate on the "owner_type" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OwnerTypeEQNullSafe(v *string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOwnerType), arg))
+	})
+}
+
+// OwnerIDEQNullSafe applies a NULL-safe equality predicate on the "owner_id" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OwnerIDEQNullSafe(v *string) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOwnerID), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:        "string",
+	FieldOwnerID:   "string",
+	FieldOwnerType: "string",
+	FieldTTL:       "duration",
+	FieldText:      "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Note.
+func FieldsEQ(f1, f2 string) predicate.Note {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Note.
+func FieldsNEQ(f1, f2 string) predicate.Note {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Note.
+//
+//	note.FieldsLT(note.FieldText, note.FieldOwnerType)
+//
+func FieldsLT(f1, f2 string) predicate.Note {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Note.
+func FieldsLTE(f1, f2 string) predicate.Note {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Note.
+func FieldsGT(f1, f2 string) predicate.Note {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Note.
+func FieldsGTE(f1, f2 string) predicate.Note {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Note using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Note", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Note", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Note are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.Note) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.Note) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Note) predicate.Note {
+	return predicate.Note(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
+
+// NoteFilter holds optional filters on the Note fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type NoteFilter struct {
+	IDIn      []string
+	Text      *string
+	TTL       *time.Duration
+	OwnerType *string
+	OwnerID   *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Note.Query().
+//		Where(note.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *NoteFilter) []predicate.Note {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Note
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Text != nil {
+		ps = append(ps, TextEQ(*f.Text))
+	}
+	if f.TTL != nil {
+		ps = append(ps, TTLEQ(*f.TTL))
+	}
+	if f.OwnerType != nil {
+		ps = append(ps, OwnerTypeEQ(*f.OwnerType))
+	}
+	if f.OwnerID != nil {
+		ps = append(ps, OwnerIDEQ(*f.OwnerID))
+	}
+	return ps
+}
@@ -0,0 +1,699 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/note"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// NoteCreate is the builder for creating a Note entity.
+type NoteCreate struct {
+	config
+	mutation *NoteMutation
+	hooks    []Hook
+}
+
+// SetText sets the text field.
+func (nc *NoteCreate) SetText(s string) *NoteCreate {
+	nc.mutation.SetText(s)
+	return nc
+}
+
+// SetTTL sets the ttl field.
+func (nc *NoteCreate) SetTTL(t time.Duration) *NoteCreate {
+	nc.mutation.SetTTL(t)
+	return nc
+}
+
+// SetNillableTTL sets the ttl field if the given value is not nil.
+func (nc *NoteCreate) SetNillableTTL(t *time.Duration) *NoteCreate {
+	if t != nil {
+		nc.SetTTL(*t)
+	}
+	return nc
+}
+
+// SetOwnerType sets the owner_type field.
+func (nc *NoteCreate) SetOwnerType(s string) *NoteCreate {
+	nc.mutation.SetOwnerType(s)
+	return nc
+}
+
+// SetNillableOwnerType sets the owner_type field if the given value is not nil.
+func (nc *NoteCreate) SetNillableOwnerType(s *string) *NoteCreate {
+	if s != nil {
+		nc.SetOwnerType(*s)
+	}
+	return nc
+}
+
+// SetOwnerID sets the owner_id field.
+func (nc *NoteCreate) SetOwnerID(s string) *NoteCreate {
+	nc.mutation.SetOwnerID(s)
+	return nc
+}
+
+// SetNillableOwnerID sets the owner_id field if the given value is not nil.
+func (nc *NoteCreate) SetNillableOwnerID(s *string) *NoteCreate {
+	if s != nil {
+		nc.SetOwnerID(*s)
+	}
+	return nc
+}
+
+// SetID sets the id field.
+func (nc *NoteCreate) SetID(s string) *NoteCreate {
+	nc.mutation.SetID(s)
+	return nc
+}
+
+// SetOwner sets the owner polymorphic edge to the given entity.
+func (nc *NoteCreate) SetOwner(v NoteOwner) *NoteCreate {
+	nc.mutation.SetOwner(v)
+	return nc
+}
+
+// Save creates the Note in the database.
+func (nc *NoteCreate) Save(ctx context.Context) (*Note, error) {
+	if err := checkTx(nc.driver); err != nil {
+		return nil, err
+	}
+	nc.defaults()
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *Note
+	)
+	if len(nc.hooks) == 0 {
+		node, err = nc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NoteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nc.mutation = mutation
+			node, err = nc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(nc.hooks) - 1; i >= 0; i-- {
+			mut = nc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NoteCreate) SaveX(ctx context.Context) *Note {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nc *NoteCreate) Unwrap() *NoteCreate {
+	tx, ok := nc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteCreate is not a transactional builder")
+	}
+	nc.config.driver = tx.drv
+	nc.mutation.driver = tx.drv
+	return nc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (nc *NoteCreate) DebugFields() map[string]Value {
+	return debugFields(nc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NoteCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NoteCreate) check() error {
+	if _, ok := nc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New("ent: missing required field \"text\"")}
+	}
+	return nil
+}
+
+func (nc *NoteCreate) sqlSave(ctx context.Context) (*Note, error) {
+	ctx = nc.withOperation(ctx, "Note", "Create")
+	n, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+
+	if _, ok := nc.mutation.ID(); !ok {
+		id, err := nc.scanID(_spec.ID.Value)
+		if err != nil {
+			return nil, err
+		}
+		n.ID = id
+	}
+	return n, nil
+}
+
+// SaveID creates the Note in the database and returns only its id. Unlike Save,
+// the Note entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Note that holds only its id as the value that is returned by the next mutator.
+func (nc *NoteCreate) SaveID(ctx context.Context) (id string, err error) {
+	nc.defaults()
+	if err = nc.check(); err != nil {
+		return id, err
+	}
+	if len(nc.hooks) == 0 {
+		return nc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*NoteMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		nc.mutation = mutation
+		if id, err = nc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Note{config: nc.config, ID: id}, nil
+	})
+	for i := len(nc.hooks) - 1; i >= 0; i-- {
+		mut = nc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, nc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (nc *NoteCreate) SaveIDX(ctx context.Context) string {
+	id, err := nc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (nc *NoteCreate) sqlSaveID(ctx context.Context) (id string, err error) {
+	ctx = nc.withOperation(ctx, "Note", "Create")
+	_spec := nc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, nc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	if id, ok := nc.mutation.ID(); ok {
+		return id, nil
+	}
+	return nc.scanID(_spec.ID.Value)
+}
+
+// scanID converts an id that was generated by the database (as it was returned by
+// the driver) to the id type of the Note.
+func (nc *NoteCreate) scanID(v Value) (id string, err error) {
+	switch v := v.(type) {
+	case string:
+		id = string(v)
+	case []byte:
+		id = string(v)
+	default:
+		err = fmt.Errorf("unexpected type %T for field id", v)
+	}
+	return id, err
+}
+
+func (nc *NoteCreate) createSpec() (*Note, *sqlgraph.CreateSpec) {
+	n := &Note{config: nc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: note.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: note.FieldID,
+		},
+	}
+	if id, ok := nc.mutation.ID(); ok {
+		n.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := nc.mutation.Text(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldText,
+		})
+		n.Text = value
+	}
+	if value, ok := nc.mutation.TTL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Value:  value,
+			Column: note.FieldTTL,
+		})
+		n.TTL = value
+	}
+	if value, ok := nc.mutation.OwnerType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerType,
+		})
+		n.OwnerType = value
+	}
+	if value, ok := nc.mutation.OwnerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerID,
+		})
+		n.OwnerID = value
+	}
+	return n, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (nc *NoteCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: note.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: note.FieldID,
+		},
+	}
+	if id, ok := nc.mutation.ID(); ok {
+		_spec.ID.Value = id
+	}
+	if value, ok := nc.mutation.Text(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldText,
+		})
+	}
+	if value, ok := nc.mutation.TTL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Value:  value,
+			Column: note.FieldTTL,
+		})
+	}
+	if value, ok := nc.mutation.OwnerType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerType,
+		})
+	}
+	if value, ok := nc.mutation.OwnerID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerID,
+		})
+	}
+	return _spec
+}
+
+// NoteCreateBulk is the builder for creating a bulk of Note entities.
+type NoteCreateBulk struct {
+	config
+	builders []*NoteCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Note entities in the database.
+func (ncb *NoteCreateBulk) Save(ctx context.Context) ([]*Note, error) {
+	nodes, err := ncb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ncb.retries > 0 && isSQLDeadlockError(err) {
+		return ncb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NoteCreateBulk) SaveX(ctx context.Context) []*Note {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ncb *NoteCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ncb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ncb *NoteCreateBulk) CheckRefs() *NoteCreateBulk {
+	ncb.refs = true
+	return ncb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ncb *NoteCreateBulk) RetryOnDeadlock(max int) *NoteCreateBulk {
+	ncb.retries = max
+	return ncb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Note.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Note.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ncb *NoteCreateBulk) OnConflict(columns ...string) *NoteUpsertBulk {
+	return &NoteUpsertBulk{create: ncb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Note.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (nc *NoteCreate) OnConflict(target ...sql.ConflictOption) *NoteUpsertBulk {
+	bulk := &NoteCreateBulk{config: nc.config, builders: []*NoteCreate{nc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ncb *NoteCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Note, error) {
+	ctx = ncb.withOperation(ctx, "Note", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ncb.builders))
+		nodes    = make([]*Note, len(ncb.builders))
+		mutators = make([]Mutator, len(ncb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ncb.refs {
+						if err := ncb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ncb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					if _, ok := builder.mutation.ID(); !ok {
+						id, err := builder.scanID(specs[i].ID.Value)
+						if err != nil {
+							return nil, err
+						}
+						nodes[i].ID = id
+					}
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ncb *NoteCreateBulk) retry(ctx context.Context, err error) ([]*Note, error) {
+	if _, ok := ncb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := ncb.builders
+	defer func() { ncb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ncb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ncb.builders = make([]*NoteCreate, len(idx))
+	for i, j := range idx {
+		ncb.builders[i] = builders[j]
+	}
+	for n := 0; n < ncb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Note
+		if sorted, err = ncb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Note, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ncb *NoteCreateBulk) less(a, b *NoteCreate) bool {
+	{
+		x, _ := a.mutation.ID()
+		y, _ := b.mutation.ID()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ncb *NoteCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Note", Missing: missing}
+	}
+	return nil
+}
+
+// NoteUpsertBulk is the builder for the conflict handling of a bulk of Note entities.
+type NoteUpsertBulk struct {
+	create  *NoteCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (nub *NoteUpsertBulk) Target(target ...sql.ConflictOption) *NoteUpsertBulk {
+	nub.target = append(nub.target, target...)
+	return nub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (nub *NoteUpsertBulk) DoNothing() *NoteUpsertBulk {
+	nub.nothing = true
+	return nub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (nub *NoteUpsertBulk) UpdateNewValues() *NoteUpsertBulk {
+	nub.update = true
+	return nub
+}
+
+// Save creates the Note entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (nub *NoteUpsertBulk) Save(ctx context.Context) ([]*Note, int, error) {
+	switch {
+	case !nub.nothing && !nub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Note bulk insert")
+	case nub.nothing && nub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Note bulk insert")
+	case nub.update:
+		if len(nub.columns) == 0 && len(nub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Note bulk upsert")
+		}
+		nodes, err := nub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(nub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(note.FieldID),
+			),
+			Columns:         note.Columns,
+			ConflictColumns: nub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(nub.conflictTarget(), sql.DoNothing())}
+	nodes, err := nub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Note, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (nub *NoteUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(nub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(nub.columns...))
+	}
+	return append(opts, nub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nub *NoteUpsertBulk) SaveX(ctx context.Context) ([]*Note, int) {
+	nodes, skipped, err := nub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (nub *NoteUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := nub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nub *NoteUpsertBulk) ExecX(ctx context.Context) {
+	if err := nub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/note"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// NoteDelete is the builder for deleting a Note entity.
+type NoteDelete struct {
+	config
+	hooks      []Hook
+	mutation   *NoteMutation
+	predicates []predicate.Note
+	limit      *int
+}
+
+// Where adds a new predicate to the delete builder.
+func (nd *NoteDelete) Where(ps ...predicate.Note) *NoteDelete {
+	nd.predicates = append(nd.predicates, ps...)
+	return nd
+}
+
+// Limit limits the number of notes that are deleted by the builder. For example,
+// for deleting large amounts of notes in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (nd *NoteDelete) Limit(limit int) *NoteDelete {
+	nd.limit = &limit
+	return nd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nd *NoteDelete) Unwrap() *NoteDelete {
+	tx, ok := nd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteDelete is not a transactional builder")
+	}
+	nd.config.driver = tx.drv
+	nd.mutation.driver = tx.drv
+	return nd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (nd *NoteDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(nd.driver); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(nd.hooks) == 0 {
+		affected, err = nd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NoteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nd.mutation = mutation
+			affected, err = nd.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(nd.hooks) - 1; i >= 0; i-- {
+			mut = nd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nd *NoteDelete) ExecX(ctx context.Context) int {
+	n, err := nd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (nd *NoteDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = nd.withOperation(ctx, "Note", "Delete")
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: note.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: note.FieldID,
+			},
+		},
+	}
+	if ps := nd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, nd.mutationDriver(ctx), _spec)
+}
+
+// NoteDeleteOne is the builder for deleting a single Note entity.
+type NoteDeleteOne struct {
+	nd *NoteDelete
+}
+
+// Exec executes the deletion query.
+func (ndo *NoteDeleteOne) Exec(ctx context.Context) error {
+	n, err := ndo.nd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0 && !sql.IsDryRun(ctx):
+		return &NotFoundError{note.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ndo *NoteDeleteOne) ExecX(ctx context.Context) {
+	ndo.nd.ExecX(ctx)
+}
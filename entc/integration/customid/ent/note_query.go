@@ -0,0 +1,1327 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/note"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// NoteQuery is the builder for querying Note entities.
+type NoteQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []OrderFunc
+	unique     []string
+	predicates []predicate.Note
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see NoteSelect.All).
+	fields    []string
+	withOwner bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (nq *NoteQuery) Where(ps ...predicate.Note) *NoteQuery {
+	nq.predicates = append(nq.predicates, ps...)
+	return nq
+}
+
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (nq *NoteQuery) Predicates() []predicate.Note {
+	return append([]predicate.Note{}, nq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (nq *NoteQuery) SetPredicates(ps ...predicate.Note) *NoteQuery {
+	nq.predicates = append([]predicate.Note{}, ps...)
+	return nq
+}
+
+// Limit adds a limit step to the query.
+func (nq *NoteQuery) Limit(limit int) *NoteQuery {
+	nq.limit = &limit
+	return nq
+}
+
+// Offset adds an offset step to the query.
+func (nq *NoteQuery) Offset(offset int) *NoteQuery {
+	nq.offset = &offset
+	return nq
+}
+
+// Order adds an order step to the query.
+func (nq *NoteQuery) Order(o ...OrderFunc) *NoteQuery {
+	nq.order = append(nq.order, o...)
+	return nq
+}
+
+// First returns the first Note entity in the query. Returns *NotFoundError when no note was found.
+func (nq *NoteQuery) First(ctx context.Context) (*Note, error) {
+	ns, err := nq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ns) == 0 {
+		return nil, &NotFoundError{note.Label}
+	}
+	return ns[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (nq *NoteQuery) FirstX(ctx context.Context) *Note {
+	n, err := nq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return n
+}
+
+// FirstID returns the first Note id in the query. Returns *NotFoundError when no id was found.
+func (nq *NoteQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = nq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{note.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (nq *NoteQuery) FirstXID(ctx context.Context) string {
+	id, err := nq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Note entity in the query, returns an error if not exactly one entity was returned.
+func (nq *NoteQuery) Only(ctx context.Context) (*Note, error) {
+	ns, err := nq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(ns) {
+	case 1:
+		return ns[0], nil
+	case 0:
+		return nil, &NotFoundError{note.Label}
+	default:
+		return nil, &NotSingularError{note.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (nq *NoteQuery) OnlyX(ctx context.Context) *Note {
+	n, err := nq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// OnlyID returns the only Note id in the query, returns an error if not exactly one id was returned.
+func (nq *NoteQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = nq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{note.Label}
+	default:
+		err = &NotSingularError{note.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (nq *NoteQuery) OnlyXID(ctx context.Context) string {
+	id, err := nq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Notes.
+func (nq *NoteQuery) All(ctx context.Context) ([]*Note, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return nq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (nq *NoteQuery) AllX(ctx context.Context) []*Note {
+	ns, err := nq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ns
+}
+
+// IDs executes the query and returns a list of Note ids.
+func (nq *NoteQuery) IDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	if err := nq.Select(note.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (nq *NoteQuery) IDsX(ctx context.Context) []string {
+	ids, err := nq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (nq *NoteQuery) Count(ctx context.Context) (int, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return nq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (nq *NoteQuery) CountX(ctx context.Context) int {
+	count, err := nq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (nq *NoteQuery) Exist(ctx context.Context) (bool, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return nq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (nq *NoteQuery) ExistX(ctx context.Context) bool {
+	exist, err := nq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (nq *NoteQuery) Clone() *NoteQuery {
+	return &NoteQuery{
+		config:     nq.config,
+		limit:      nq.limit,
+		offset:     nq.offset,
+		order:      append([]OrderFunc{}, nq.order...),
+		unique:     append([]string{}, nq.unique...),
+		predicates: append([]predicate.Note{}, nq.predicates...),
+		// clone intermediate query.
+		sql:  nq.sql.Clone(),
+		path: nq.path,
+	}
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Text string `json:"text,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Note.Query().
+//		GroupBy(note.FieldText).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (nq *NoteQuery) GroupBy(field string, fields ...string) *NoteGroupBy {
+	group := &NoteGroupBy{config: nq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return nq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Text string `json:"text,omitempty"`
+//	}
+//
+//	client.Note.Query().
+//		Select(note.FieldText).
+//		Scan(ctx, &v)
+//
+func (nq *NoteQuery) Select(field string, fields ...string) *NoteSelect {
+	selector := &NoteSelect{config: nq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.query = nq
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := nq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		if err := nq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return nq.sqlQuery().AppendSelectExpr(nq.exprs...), nil
+	}
+	return selector
+}
+
+func (nq *NoteQuery) prepareQuery(ctx context.Context) error {
+	if nq.path != nil {
+		prev, err := nq.path(ctx)
+		if err != nil {
+			return err
+		}
+		nq.sql = prev
+	}
+	return nil
+}
+
+func (nq *NoteQuery) sqlAll(ctx context.Context) ([]*Note, error) {
+	ctx = nq.withOperation(ctx, "Note", "Query")
+	if err := nq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range nq.fields {
+		if !note.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
+	var (
+		nodes = []*Note{}
+		_spec = nq.querySpec()
+	)
+	if nq.withOwner && len(nq.fields) > 0 {
+		_spec.Node.Columns = append(_spec.Node.Columns, note.FieldOwnerType, note.FieldOwnerID)
+	}
+	_spec.Exprs = nq.exprs
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		node := &Note{config: nq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues(columns)
+		for range nq.exprs {
+			values = append(values, new(interface{}))
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		if n := len(nq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range nq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
+		return node.assignValues(columns, values)
+	}
+	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if nq.withOwner {
+		if err := nq.loadOwner(ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// WithOwner tells the query-builder to eager-load the entities that the "owner" polymorphic
+// edge points to. The entities are grouped by their type, and each type is loaded in batches. The loaded
+// entities are returned by the OwnerOrErr method of the Note.
+func (nq *NoteQuery) WithOwner() *NoteQuery {
+	nq.withOwner = true
+	return nq
+}
+
+// loadOwner eager-loads the "owner" polymorphic edge of the given nodes.
+func (nq *NoteQuery) loadOwner(ctx context.Context, nodes []*Note) error {
+	var (
+		ids    = make(map[string][]string)
+		values = make(map[string]map[string]Value)
+	)
+	for _, n := range nodes {
+		if n.polyEdges == nil {
+			n.polyEdges = make(map[string]Value)
+		}
+		n.polyEdges["owner"] = nil
+		switch n.OwnerType {
+		case "":
+			continue
+		case "Pet", "Note":
+		default:
+			return fmt.Errorf("ent: unexpected type %q for Note owner", n.OwnerType)
+		}
+		if values[n.OwnerType] == nil {
+			values[n.OwnerType] = make(map[string]Value)
+		}
+		if _, ok := values[n.OwnerType][n.OwnerID]; !ok {
+			values[n.OwnerType][n.OwnerID] = nil
+			ids[n.OwnerType] = append(ids[n.OwnerType], n.OwnerID)
+		}
+	}
+	if ids := ids["Pet"]; len(ids) > 0 {
+		err := nq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			neighbors, err := (&PetClient{config: nq.config}).Query().
+				Where(pet.IDIn(ids[i:j]...)).
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				values["Pet"][n.ID] = n
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if ids := ids["Note"]; len(ids) > 0 {
+		err := nq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			neighbors, err := (&NoteClient{config: nq.config}).Query().
+				Where(note.IDIn(ids[i:j]...)).
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				values["Note"][n.ID] = n
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, n := range nodes {
+		if v := values[n.OwnerType][n.OwnerID]; v != nil {
+			n.polyEdges["owner"] = v
+		}
+	}
+	return nil
+}
+
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Note entities (see Note.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Note.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Note table.
+func (nq *NoteQuery) SelectExpr(exprs ...*sql.SelectExpr) *NoteQuery {
+	nq.exprs = append(nq.exprs, exprs...)
+	return nq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (nq *NoteQuery) checkExprs() error {
+	if len(nq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range note.Columns {
+		columns[c] = true
+	}
+	for _, e := range nq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Note", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
+func (nq *NoteQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = nq.withOperation(ctx, "Note", "Count")
+	_spec := nq.querySpec()
+	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
+}
+
+func (nq *NoteQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := nq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (nq *NoteQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   note.Table,
+			Columns: note.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: note.FieldID,
+			},
+		},
+		From:   nq.sql,
+		Unique: true,
+	}
+	if fields := nq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, note.FieldID)
+		for i := range fields {
+			if fields[i] != note.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = nq.forUpdate
+	_spec.PartitionBy = nq.partition
+	if ps := nq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := nq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := nq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (nq *NoteQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(nq.driver.Dialect())
+	t1 := builder.Table(note.Table)
+	selector := builder.Select(t1.Columns(note.Columns...)...).From(t1)
+	if nq.sql != nil {
+		selector = nq.sql
+		selector.Select(selector.Columns(note.Columns...)...)
+	}
+	for _, p := range nq.predicates {
+		p(selector)
+	}
+	for _, p := range nq.order {
+		p(selector)
+	}
+	if offset := nq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := nq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IDsQuery returns a selector that selects the ids of the Note entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (nq *NoteQuery) IDsQuery() *sql.Selector {
+	selector := nq.sqlQuery()
+	if nq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(note.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Note entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Note.Query().
+//		Where(...).
+//		CountDistinct(ctx, note.FieldText)
+//
+func (nq *NoteQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = nq.withOperation(ctx, "Note", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, nq.driver, nq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (nq *NoteQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := nq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Note rows as generic maps that are keyed by
+// column name (see note.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (nq *NoteQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = nq.withOperation(ctx, "Note", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = nq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Note{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (nq *NoteQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := nq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Note entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (nq *NoteQuery) Prefetch(n int) *NoteQuery {
+	nq.prefetch = n
+	return nq
+}
+
+// ForUpdate locks the selected Note rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (nq *NoteQuery) ForUpdate() *NoteQuery {
+	nq.forUpdate = true
+	return nq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (nq *NoteQuery) Primary() *NoteQuery {
+	return nq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (nq *NoteQuery) ReadConsistency(c sql.ReadConsistency) *NoteQuery {
+	nq.consistency = c
+	return nq
+}
+
+// Stream executes the query in batches, and sends the matched Note entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Note.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (nq *NoteQuery) Stream(ctx context.Context) (<-chan *Note, <-chan error) {
+	size := nq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Note, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := nq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Note entities in batches of the given
+// size, and sends them to the given channel.
+func (nq *NoteQuery) stream(ctx context.Context, size int, nodes chan<- *Note) error {
+	if nq.offset != nil || len(nq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *string
+		left = -1
+	)
+	if nq.limit != nil {
+		left = *nq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *nq
+		query.predicates = nq.predicates[:len(nq.predicates):len(nq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, note.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(note.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// NoteEdge is the edge representation of Note in a connection.
+type NoteEdge struct {
+	Node   *Note  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// NoteConnection is the connection of Note entities that is returned by Paginate.
+type NoteConnection struct {
+	Edges      []NoteEdge `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Note.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: note.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (nq *NoteQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*NoteConnection, error) {
+	if nq.limit != nil || nq.offset != nil || len(nq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case note.FieldText:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for Note", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := nq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: note.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *nq
+	query.predicates = nq.predicates[:len(nq.predicates):len(nq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := nq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &NoteConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]NoteEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = NoteEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (nq *NoteQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Note, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := nq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Note(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*NoteQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case note.FieldText:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field text: %v", err)
+		}
+		return v, nil
+	case note.FieldID:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Note", field)
+}
+
+// pageCursor returns the cursor of the Note node for the given order fields.
+func (n *Note) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(n.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case note.FieldText:
+			v = n.Text
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Note", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
+// NoteGroupBy is the builder for group-by Note entities.
+type NoteGroupBy struct {
+	config
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ngb *NoteGroupBy) Aggregate(fns ...AggregateFunc) *NoteGroupBy {
+	ngb.fns = append(ngb.fns, fns...)
+	return ngb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (ngb *NoteGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ngb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ngb.sql = query
+	return ngb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ngb *NoteGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ngb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (ngb *NoteGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NoteGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ngb *NoteGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ngb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (ngb *NoteGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NoteGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ngb *NoteGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ngb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (ngb *NoteGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NoteGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ngb *NoteGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ngb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (ngb *NoteGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ngb.fields) > 1 {
+		return nil, errors.New("ent: NoteGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ngb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ngb *NoteGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ngb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Note.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (ngb *NoteGroupBy) Having(ps ...*sql.Predicate) *NoteGroupBy {
+	ngb.havings = append(ngb.havings, ps...)
+	return ngb
+}
+
+func (ngb *NoteGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ngb.withOperation(ctx, "Note", "GroupBy")
+	selector := ngb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ngb *NoteGroupBy) sqlQuery() *sql.Selector {
+	selector := ngb.sql
+	columns := make([]string, 0, len(ngb.fields)+len(ngb.fns))
+	columns = append(columns, ngb.fields...)
+	for _, fn := range ngb.fns {
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(ngb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(ngb.fields...)
+	switch len(ngb.havings) {
+	case 0:
+	case 1:
+		selector.Having(ngb.havings[0])
+	default:
+		selector.Having(sql.And(ngb.havings...))
+	}
+	return selector
+}
+
+// NoteSelect is the builder for select fields of Note entities.
+type NoteSelect struct {
+	config
+	fields []string
+	// query is the query that the fields are selected from.
+	query *NoteQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (ns *NoteSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := ns.path(ctx)
+	if err != nil {
+		return err
+	}
+	ns.sql = query
+	return ns.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ns *NoteSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ns.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (ns *NoteSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NoteSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ns *NoteSelect) StringsX(ctx context.Context) []string {
+	v, err := ns.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (ns *NoteSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NoteSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ns *NoteSelect) IntsX(ctx context.Context) []int {
+	v, err := ns.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (ns *NoteSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NoteSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ns *NoteSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ns.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (ns *NoteSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NoteSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ns *NoteSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ns.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ns *NoteSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NoteSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ns *NoteSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ns.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Note entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Note.Query().
+//		Select(note.FieldText).
+//		All(ctx)
+//
+func (ns *NoteSelect) All(ctx context.Context) ([]*Note, error) {
+	query := *ns.query
+	query.fields = ns.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ns *NoteSelect) AllX(ctx context.Context) []*Note {
+	nodes, err := ns.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+func (ns *NoteSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ns.withOperation(ctx, "Note", "Select")
+	rows := &sql.Rows{}
+	query, args := ns.sqlQuery().Query()
+	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ns *NoteSelect) sqlQuery() sql.Querier {
+	selector := ns.sql
+	selector.Select(selector.Columns(ns.fields...)...)
+	return selector
+}
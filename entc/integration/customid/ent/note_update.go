@@ -0,0 +1,673 @@
+// Copyright (c) Facebook, Inc. and its affiliates. All Rights Reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/note"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// NoteUpdate is the builder for updating Note entities.
+type NoteUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *NoteMutation
+	predicates []predicate.Note
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]string
+}
+
+// Where adds a new predicate for the builder.
+func (nu *NoteUpdate) Where(ps ...predicate.Note) *NoteUpdate {
+	nu.predicates = append(nu.predicates, ps...)
+	return nu
+}
+
+// Limit limits the number of notes that are updated by the builder. For example,
+// for updating large amounts of notes in small batches without locking the whole table.
+func (nu *NoteUpdate) Limit(limit int) *NoteUpdate {
+	nu.limit = &limit
+	return nu
+}
+
+// SetText sets the text field.
+func (nu *NoteUpdate) SetText(s string) *NoteUpdate {
+	nu.mutation.SetText(s)
+	return nu
+}
+
+// UnsetText removes the changes of the text field from the builder (e.g. a previous call
+// to SetText), and therefore, the field is left unchanged in the database.
+func (nu *NoteUpdate) UnsetText() *NoteUpdate {
+	nu.mutation.ResetText()
+	return nu
+}
+
+// SetTTL sets the ttl field.
+func (nu *NoteUpdate) SetTTL(t time.Duration) *NoteUpdate {
+	nu.mutation.ResetTTL()
+	nu.mutation.SetTTL(t)
+	return nu
+}
+
+// SetNillableTTL sets the ttl field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableTTL(t *time.Duration) *NoteUpdate {
+	if t != nil {
+		nu.SetTTL(*t)
+	}
+	return nu
+}
+
+// AddTTL adds t to ttl.
+func (nu *NoteUpdate) AddTTL(t time.Duration) *NoteUpdate {
+	nu.mutation.AddTTL(t)
+	return nu
+}
+
+// SubTTL subtracts t from ttl.
+func (nu *NoteUpdate) SubTTL(t time.Duration) *NoteUpdate {
+	return nu.AddTTL(-t)
+}
+
+// ClearTTL clears the value of ttl.
+func (nu *NoteUpdate) ClearTTL() *NoteUpdate {
+	nu.mutation.ClearTTL()
+	return nu
+}
+
+// UnsetTTL removes the changes of the ttl field from the builder (e.g. a previous call
+// to SetTTL), and therefore, the field is left unchanged in the database. Unlike ClearTTL,
+// it does not set the field to NULL, and also removes a previous call to ClearTTL.
+func (nu *NoteUpdate) UnsetTTL() *NoteUpdate {
+	nu.mutation.ResetTTL()
+	return nu
+}
+
+// SetOwnerType sets the owner_type field.
+func (nu *NoteUpdate) SetOwnerType(s string) *NoteUpdate {
+	nu.mutation.SetOwnerType(s)
+	return nu
+}
+
+// SetNillableOwnerType sets the owner_type field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableOwnerType(s *string) *NoteUpdate {
+	if s != nil {
+		nu.SetOwnerType(*s)
+	}
+	return nu
+}
+
+// ClearOwnerType clears the value of owner_type.
+func (nu *NoteUpdate) ClearOwnerType() *NoteUpdate {
+	nu.mutation.ClearOwnerType()
+	return nu
+}
+
+// UnsetOwnerType removes the changes of the owner_type field from the builder (e.g. a previous call
+// to SetOwnerType), and therefore, the field is left unchanged in the database. Unlike ClearOwnerType,
+// it does not set the field to NULL, and also removes a previous call to ClearOwnerType.
+func (nu *NoteUpdate) UnsetOwnerType() *NoteUpdate {
+	nu.mutation.ResetOwnerType()
+	return nu
+}
+
+// SetOwnerID sets the owner_id field.
+func (nu *NoteUpdate) SetOwnerID(s string) *NoteUpdate {
+	nu.mutation.SetOwnerID(s)
+	return nu
+}
+
+// SetNillableOwnerID sets the owner_id field if the given value is not nil.
+func (nu *NoteUpdate) SetNillableOwnerID(s *string) *NoteUpdate {
+	if s != nil {
+		nu.SetOwnerID(*s)
+	}
+	return nu
+}
+
+// ClearOwnerID clears the value of owner_id.
+func (nu *NoteUpdate) ClearOwnerID() *NoteUpdate {
+	nu.mutation.ClearOwnerID()
+	return nu
+}
+
+// UnsetOwnerID removes the changes of the owner_id field from the builder (e.g. a previous call
+// to SetOwnerID), and therefore, the field is left unchanged in the database. Unlike ClearOwnerID,
+// it does not set the field to NULL, and also removes a previous call to ClearOwnerID.
+func (nu *NoteUpdate) UnsetOwnerID() *NoteUpdate {
+	nu.mutation.ResetOwnerID()
+	return nu
+}
+
+// SetOwner sets the owner polymorphic edge to the given entity.
+func (nu *NoteUpdate) SetOwner(v NoteOwner) *NoteUpdate {
+	nu.mutation.SetOwner(v)
+	return nu
+}
+
+// ClearOwner clears the owner polymorphic edge.
+func (nu *NoteUpdate) ClearOwner() *NoteUpdate {
+	nu.mutation.ClearOwner()
+	return nu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nu *NoteUpdate) Unwrap() *NoteUpdate {
+	tx, ok := nu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteUpdate is not a transactional builder")
+	}
+	nu.config.driver = tx.drv
+	nu.mutation.driver = tx.drv
+	return nu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (nu *NoteUpdate) DebugFields() map[string]Value {
+	return debugFields(nu.mutation)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (nu *NoteUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(nu.driver); err != nil {
+		return 0, err
+	}
+	if err := nu.check(); err != nil {
+		return 0, err
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(nu.hooks) == 0 {
+		affected, err = nu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NoteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nu.mutation = mutation
+			affected, err = nu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(nu.hooks) - 1; i >= 0; i-- {
+			mut = nu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NoteUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NoteUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NoteUpdate) check() error {
+	return nil
+}
+
+func (nu *NoteUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = nu.withOperation(ctx, "Note", "Update")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   note.Table,
+			Columns: note.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: note.FieldID,
+			},
+		},
+	}
+	if ps := nu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := nu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if nu.ids != nil {
+		_spec.ScanIDs = nu.ids
+	}
+	if value, ok := nu.mutation.Text(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldText,
+		})
+	}
+	if value, ok := nu.mutation.TTL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Value:  value,
+			Column: note.FieldTTL,
+		})
+	}
+	if value, ok := nu.mutation.AddedTTL(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Value:  value,
+			Column: note.FieldTTL,
+		})
+	}
+	if nu.mutation.TTLCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Column: note.FieldTTL,
+		})
+	}
+	if value, ok := nu.mutation.OwnerType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerType,
+		})
+	}
+	if nu.mutation.OwnerTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: note.FieldOwnerType,
+		})
+	}
+	if value, ok := nu.mutation.OwnerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerID,
+		})
+	}
+	if nu.mutation.OwnerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: note.FieldOwnerID,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{note.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// SaveReturningIDs executes the query and returns the ids of the Note entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (nu *NoteUpdate) SaveReturningIDs(ctx context.Context) ([]string, error) {
+	var ids []string
+	nu.ids = &ids
+	defer func() { nu.ids = nil }()
+	if _, err := nu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// NoteUpdateOne is the builder for updating a single Note entity.
+type NoteUpdateOne struct {
+	config
+	hooks      []Hook
+	mutation   *NoteMutation
+	predicates []predicate.Note
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (nuo *NoteUpdateOne) Where(ps ...predicate.Note) *NoteUpdateOne {
+	nuo.predicates = append(nuo.predicates, ps...)
+	return nuo
+}
+
+// SetText sets the text field.
+func (nuo *NoteUpdateOne) SetText(s string) *NoteUpdateOne {
+	nuo.mutation.SetText(s)
+	return nuo
+}
+
+// UnsetText removes the changes of the text field from the builder (e.g. a previous call
+// to SetText), and therefore, the field is left unchanged in the database.
+func (nuo *NoteUpdateOne) UnsetText() *NoteUpdateOne {
+	nuo.mutation.ResetText()
+	return nuo
+}
+
+// SetTTL sets the ttl field.
+func (nuo *NoteUpdateOne) SetTTL(t time.Duration) *NoteUpdateOne {
+	nuo.mutation.ResetTTL()
+	nuo.mutation.SetTTL(t)
+	return nuo
+}
+
+// SetNillableTTL sets the ttl field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableTTL(t *time.Duration) *NoteUpdateOne {
+	if t != nil {
+		nuo.SetTTL(*t)
+	}
+	return nuo
+}
+
+// AddTTL adds t to ttl.
+func (nuo *NoteUpdateOne) AddTTL(t time.Duration) *NoteUpdateOne {
+	nuo.mutation.AddTTL(t)
+	return nuo
+}
+
+// SubTTL subtracts t from ttl.
+func (nuo *NoteUpdateOne) SubTTL(t time.Duration) *NoteUpdateOne {
+	return nuo.AddTTL(-t)
+}
+
+// ClearTTL clears the value of ttl.
+func (nuo *NoteUpdateOne) ClearTTL() *NoteUpdateOne {
+	nuo.mutation.ClearTTL()
+	return nuo
+}
+
+// UnsetTTL removes the changes of the ttl field from the builder (e.g. a previous call
+// to SetTTL), and therefore, the field is left unchanged in the database. Unlike ClearTTL,
+// it does not set the field to NULL, and also removes a previous call to ClearTTL.
+func (nuo *NoteUpdateOne) UnsetTTL() *NoteUpdateOne {
+	nuo.mutation.ResetTTL()
+	return nuo
+}
+
+// SetOwnerType sets the owner_type field.
+func (nuo *NoteUpdateOne) SetOwnerType(s string) *NoteUpdateOne {
+	nuo.mutation.SetOwnerType(s)
+	return nuo
+}
+
+// SetNillableOwnerType sets the owner_type field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableOwnerType(s *string) *NoteUpdateOne {
+	if s != nil {
+		nuo.SetOwnerType(*s)
+	}
+	return nuo
+}
+
+// ClearOwnerType clears the value of owner_type.
+func (nuo *NoteUpdateOne) ClearOwnerType() *NoteUpdateOne {
+	nuo.mutation.ClearOwnerType()
+	return nuo
+}
+
+// UnsetOwnerType removes the changes of the owner_type field from the builder (e.g. a previous call
+// to SetOwnerType), and therefore, the field is left unchanged in the database. Unlike ClearOwnerType,
+// it does not set the field to NULL, and also removes a previous call to ClearOwnerType.
+func (nuo *NoteUpdateOne) UnsetOwnerType() *NoteUpdateOne {
+	nuo.mutation.ResetOwnerType()
+	return nuo
+}
+
+// SetOwnerID sets the owner_id field.
+func (nuo *NoteUpdateOne) SetOwnerID(s string) *NoteUpdateOne {
+	nuo.mutation.SetOwnerID(s)
+	return nuo
+}
+
+// SetNillableOwnerID sets the owner_id field if the given value is not nil.
+func (nuo *NoteUpdateOne) SetNillableOwnerID(s *string) *NoteUpdateOne {
+	if s != nil {
+		nuo.SetOwnerID(*s)
+	}
+	return nuo
+}
+
+// ClearOwnerID clears the value of owner_id.
+func (nuo *NoteUpdateOne) ClearOwnerID() *NoteUpdateOne {
+	nuo.mutation.ClearOwnerID()
+	return nuo
+}
+
+// UnsetOwnerID removes the changes of the owner_id field from the builder (e.g. a previous call
+// to SetOwnerID), and therefore, the field is left unchanged in the database. Unlike ClearOwnerID,
+// it does not set the field to NULL, and also removes a previous call to ClearOwnerID.
+func (nuo *NoteUpdateOne) UnsetOwnerID() *NoteUpdateOne {
+	nuo.mutation.ResetOwnerID()
+	return nuo
+}
+
+// SetOwner sets the owner polymorphic edge to the given entity.
+func (nuo *NoteUpdateOne) SetOwner(v NoteOwner) *NoteUpdateOne {
+	nuo.mutation.SetOwner(v)
+	return nuo
+}
+
+// ClearOwner clears the owner polymorphic edge.
+func (nuo *NoteUpdateOne) ClearOwner() *NoteUpdateOne {
+	nuo.mutation.ClearOwner()
+	return nuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nuo *NoteUpdateOne) Unwrap() *NoteUpdateOne {
+	tx, ok := nuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NoteUpdateOne is not a transactional builder")
+	}
+	nuo.config.driver = tx.drv
+	nuo.mutation.driver = tx.drv
+	return nuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (nuo *NoteUpdateOne) DebugFields() map[string]Value {
+	return debugFields(nuo.mutation)
+}
+
+// Save executes the query and returns the updated entity.
+func (nuo *NoteUpdateOne) Save(ctx context.Context) (*Note, error) {
+	if err := checkTx(nuo.driver); err != nil {
+		return nil, err
+	}
+	if err := nuo.check(); err != nil {
+		return nil, err
+	}
+	var (
+		err  error
+		node *Note
+	)
+	if len(nuo.hooks) == 0 {
+		node, err = nuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*NoteMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			nuo.mutation = mutation
+			node, err = nuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(nuo.hooks) - 1; i >= 0; i-- {
+			mut = nuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, nuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NoteUpdateOne) SaveX(ctx context.Context) *Note {
+	n, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// Exec executes the query on the entity.
+func (nuo *NoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NoteUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NoteUpdateOne) check() error {
+	return nil
+}
+
+func (nuo *NoteUpdateOne) sqlSave(ctx context.Context) (n *Note, err error) {
+	ctx = nuo.withOperation(ctx, "Note", "UpdateOne")
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   note.Table,
+			Columns: note.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeString,
+				Column: note.FieldID,
+			},
+		},
+	}
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Note.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if ps := nuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Text(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldText,
+		})
+	}
+	if value, ok := nuo.mutation.TTL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Value:  value,
+			Column: note.FieldTTL,
+		})
+	}
+	if value, ok := nuo.mutation.AddedTTL(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Value:  value,
+			Column: note.FieldTTL,
+		})
+	}
+	if nuo.mutation.TTLCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDuration,
+			Column: note.FieldTTL,
+		})
+	}
+	if value, ok := nuo.mutation.OwnerType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerType,
+		})
+	}
+	if nuo.mutation.OwnerTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: note.FieldOwnerType,
+		})
+	}
+	if value, ok := nuo.mutation.OwnerID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: note.FieldOwnerID,
+		})
+	}
+	if nuo.mutation.OwnerIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: note.FieldOwnerID,
+		})
+	}
+	n = &Note{config: nuo.config}
+	_spec.Assign = func(values ...interface{}) error {
+		return n.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = n.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, nuo.mutationDriver(ctx), _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{note.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		n.ID = id
+		if value, ok := nuo.mutation.Text(); ok {
+			n.Text = value
+		}
+		if value, ok := nuo.mutation.TTL(); ok {
+			n.TTL = value
+		}
+		if value, ok := nuo.mutation.OwnerType(); ok {
+			n.OwnerType = value
+		}
+		if value, ok := nuo.mutation.OwnerID(); ok {
+			n.OwnerID = value
+		}
+	}
+	return n, nil
+}
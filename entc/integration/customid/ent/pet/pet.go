@@ -45,6 +45,14 @@ const (
 	BestFriendColumn = "pet_best_friend"
 )
 
+// Edges holds all the edge names of the Pet type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+	EdgeCars,
+	EdgeFriends,
+	EdgeBestFriend,
+}
+
 // Columns holds all SQL columns for pet fields.
 var Columns = []string{
 	FieldID,
@@ -56,6 +64,16 @@ var ForeignKeys = []string{
 	"user_pets",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// FriendsPrimaryKey and FriendsColumn2 are the table columns denoting the
 	// primary key for the friends relation (M2M).
@@ -123,6 +123,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.Pet {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	pet.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_pets IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_pets)
+//
+func OwnerMissing() predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasCars applies the HasEdge predicate on the "cars" edge.
 func HasCars() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -207,6 +246,45 @@ func HasBestFriendWith(preds ...predicate.Pet) predicate.Pet {
 	})
 }
 
+// BestFriendIDIn applies a predicate on the "best_friend" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	pet.BestFriendIDIn(client.Pet.Query().Where(...))
+//
+func BestFriendIDIn(q sqlgraph.IDsQuerier) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(BestFriendColumn), sub))
+	})
+}
+
+// BestFriendMissing applies a predicate on the "best_friend" edge, that checks if its foreign-key is set, but
+// there is no Pet with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE pet_best_friend IS NOT NULL AND NOT EXISTS (SELECT id FROM pets WHERE id = pet_best_friend)
+//
+func BestFriendMissing() predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("best_friend")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(BestFriendColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(BestFriendColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Pet) predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -214,6 +292,9 @@ func And(predicates ...predicate.Pet) predicate.Pet {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -228,6 +309,9 @@ func Or(predicates ...predicate.Pet) predicate.Pet {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -238,3 +322,49 @@ func Not(p predicate.Pet) predicate.Pet {
 		p(s.Not())
 	})
 }
+
+// PetFilter holds optional filters on the Pet fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type PetFilter struct {
+	IDIn           []string
+	OwnerIDIn      []int
+	BestFriendIDIn []string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Pet.Query().
+//		Where(pet.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *PetFilter) []predicate.Pet {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Pet
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Pet(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	if ids := f.BestFriendIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Pet(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(BestFriendColumn), v...))
+		}))
+	}
+	return ps
+}
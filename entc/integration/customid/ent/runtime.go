@@ -8,8 +8,10 @@ package ent
 
 import (
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/blob"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/lineitem"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/user"
 	"github.com/google/uuid"
 )
 
@@ -27,6 +29,14 @@ func init() {
 	blobDescID := blobFields[0].Descriptor()
 	// blob.DefaultID holds the default value on creation for the id field.
 	blob.DefaultID = blobDescID.Default.(func() uuid.UUID)
+	lineitemFields := schema.LineItem{}.Fields()
+	_ = lineitemFields
+	// lineitemDescPrice is the schema descriptor for price field.
+	lineitemDescPrice := lineitemFields[2].Descriptor()
+	// lineitem.DefaultPrice holds the default value on creation for the price field.
+	lineitem.DefaultPrice = lineitemDescPrice.Default.(string)
+	// lineitem.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	lineitem.PriceValidator = lineitemDescPrice.Validators[0].(func(string) error)
 	petFields := schema.Pet{}.Fields()
 	_ = petFields
 	// petDescID is the schema descriptor for id field.
@@ -47,4 +57,20 @@ func init() {
 			return nil
 		}
 	}()
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescSsn is the schema descriptor for ssn field.
+	userDescSsn := userFields[3].Descriptor()
+	// user.SsnCipher holds the cipher of the encrypted ssn field.
+	user.SsnCipher = userDescSsn.Cipher
+	// userDescTaxID is the schema descriptor for tax_id field.
+	userDescTaxID := userFields[4].Descriptor()
+	// user.TaxIDCipher holds the cipher of the encrypted tax_id field.
+	user.TaxIDCipher = userDescTaxID.Cipher
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[5].Descriptor()
+	// user.EmailCipher holds the cipher of the encrypted email field.
+	user.EmailCipher = userDescEmail.Cipher
+	// user.EmailBlindIndex holds the blind index of the encrypted email field.
+	user.EmailBlindIndex = userDescEmail.BlindIndex
 }
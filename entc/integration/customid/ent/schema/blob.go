@@ -21,7 +21,7 @@ type Blob struct {
 func (Blob) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New),
+			DefaultUUIDv7(),
 		field.UUID("uuid", uuid.UUID{}).
 			Default(uuid.New),
 	}
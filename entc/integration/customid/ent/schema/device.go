@@ -0,0 +1,27 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+
+	"github.com/google/uuid"
+)
+
+// Device holds the schema definition for the Device entity.
+// Its ids are generated by the database.
+type Device struct {
+	ent.Schema
+}
+
+// Fields of the Device.
+func (Device) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable(),
+		field.String("name"),
+	}
+}
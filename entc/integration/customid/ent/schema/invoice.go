@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect/entsql"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// Invoice holds the schema definition for the Invoice entity.
+// Its line items are scoped to its tenant using a composite foreign-key.
+type Invoice struct {
+	ent.Schema
+}
+
+// Fields of the Invoice.
+func (Invoice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id"),
+	}
+}
+
+// Edges of the Invoice.
+func (Invoice) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("line_items", LineItem.Type).
+			Annotations(entsql.CompositeForeignKey("tenant_id")),
+	}
+}
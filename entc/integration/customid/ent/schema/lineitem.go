@@ -0,0 +1,78 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// Amount is a fixed-point decimal amount with 2 fraction digits, held in cents.
+type Amount int64
+
+// Value implements the driver.Valuer interface.
+func (a Amount) Value() (driver.Value, error) {
+	sign, v := "", int64(a)
+	if v < 0 {
+		sign, v = "-", -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/100, v%100), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (a *Amount) Scan(src interface{}) error {
+	var f float64
+	switch v := src.(type) {
+	case nil:
+	case int64:
+		f = float64(v)
+	case float64:
+		f = v
+	case []byte:
+		return a.Scan(string(v))
+	case string:
+		var err error
+		if f, err = strconv.ParseFloat(v, 64); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unexpected type %T for amount", src)
+	}
+	*a = Amount(math.Round(f * 100))
+	return nil
+}
+
+// LineItem holds the schema definition for the LineItem entity.
+type LineItem struct {
+	ent.Schema
+}
+
+// Fields of the LineItem.
+func (LineItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("tenant_id"),
+		field.Int("quantity"),
+		field.Decimal("price", 12, 2).
+			Default("0.00"),
+		field.Decimal("discount", 8, 2).
+			GoType(Amount(0)).
+			Optional(),
+	}
+}
+
+// Edges of the LineItem.
+func (LineItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("invoice", Invoice.Type).
+			Ref("line_items").
+			Unique(),
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect/entsql"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// Note holds the schema definition for the Note entity.
+// On PostgreSQL, its ids are generated from a sequence.
+type Note struct {
+	ent.Schema
+}
+
+// Fields of the Note.
+func (Note) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Immutable().
+			Annotations(entsql.IDSequence("note_seq", "note_", 6)),
+		field.String("text"),
+		field.Duration("ttl").
+			Optional(),
+		field.String("owner_type").
+			Optional(),
+		field.String("owner_id").
+			Optional().
+			Annotations(field.Polymorphic("owner", "owner_type", "Pet", "Note")),
+	}
+}
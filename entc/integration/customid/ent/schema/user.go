@@ -5,7 +5,10 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 )
@@ -19,9 +22,40 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("id"),
+		field.IP("last_ip").
+			Optional(),
+		field.CIDR("allowed_network").
+			Optional(),
+		field.String("ssn").
+			Optional().
+			Encrypted(sql.AESGCM(userKeys)),
+		field.String("tax_id").
+			Optional().
+			Encrypted(sql.DeterministicAESGCM(userKeys)),
+		field.String("email").
+			Optional().
+			Unique().
+			Encrypted(sql.AESGCM(userKeys)).
+			BlindIndex(sql.HMACIndex([]byte("email-index-key"))),
+		field.Embed("address", Address{}).
+			Optional(),
 	}
 }
 
+// Address is embedded in the user schema.
+type Address struct {
+	Street  string
+	City    string
+	ZipCode int
+	Since   time.Time
+}
+
+// userKeys holds the keys of the encrypted user fields.
+var userKeys = sql.StaticKeys("v2", map[string][]byte{
+	"v1": []byte("0123456789abcdef"),
+	"v2": []byte("0123456789abcdef0123456789abcdef"),
+})
+
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
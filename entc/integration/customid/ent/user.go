@@ -7,22 +7,44 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/user"
 )
 
 // User is the model entity for the User schema.
 type User struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// LastIP holds the value of the "last_ip" field.
+	LastIP sql.IP `json:"last_ip,omitempty"`
+	// AllowedNetwork holds the value of the "allowed_network" field.
+	AllowedNetwork sql.CIDR `json:"allowed_network,omitempty"`
+	// Ssn holds the value of the "ssn" field.
+	Ssn string `json:"ssn,omitempty"`
+	// TaxID holds the value of the "tax_id" field.
+	TaxID string `json:"tax_id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// Address holds the values of the "address" embedded fields.
+	Address schema.Address `json:"address,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges         UserEdges `json:"edges"`
 	user_children *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -81,44 +103,144 @@ func (e UserEdges) PetsOrErr() ([]*Pet, error) {
 	return nil, &NotLoadedError{edge: "pets"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*User) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // id
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*User) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // user_children
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the User type are scanned as generic values.
+func (*User) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &sql.NullInt64{}
+		case user.FieldLastIP:
+			values[i] = &sql.NullScanner{S: new(sql.IP)}
+		case user.FieldAllowedNetwork:
+			values[i] = &sql.NullScanner{S: new(sql.CIDR)}
+		case user.FieldSsn:
+			values[i] = &sql.NullString{}
+		case user.FieldTaxID:
+			values[i] = &sql.NullString{}
+		case user.FieldEmail:
+			values[i] = &sql.NullString{}
+		case user.FieldAddressStreet:
+			values[i] = &sql.NullString{}
+		case user.FieldAddressCity:
+			values[i] = &sql.NullString{}
+		case user.FieldAddressZipCode:
+			values[i] = &sql.NullInt64{}
+		case user.FieldAddressSince:
+			values[i] = &sql.NullTime{}
+		case user.ForeignKeys[0]: // user_children
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the User fields.
-func (u *User) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(user.Columns); m < n {
+// to the User fields. Values of columns that were not selected are left untouched.
+func (u *User) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	u.ID = int(value.Int64)
-	values = values[1:]
-	values = values[0:]
-	if len(values) == len(user.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_children", value)
-		} else if value.Valid {
-			u.user_children = new(int)
-			*u.user_children = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case user.FieldLastIP:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field last_ip", values[i])
+			} else if value.Valid {
+				u.LastIP = *value.S.(*sql.IP)
+			}
+		case user.FieldAllowedNetwork:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_network", values[i])
+			} else if value.Valid {
+				u.AllowedNetwork = *value.S.(*sql.CIDR)
+			}
+		case user.FieldSsn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ssn", values[i])
+			} else if value.Valid {
+				v, err := sql.DecryptString(user.SsnCipher, value.String)
+				if err != nil {
+					return fmt.Errorf("decrypt field ssn: %v", err)
+				}
+				u.Ssn = v
+			}
+		case user.FieldTaxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tax_id", values[i])
+			} else if value.Valid {
+				v, err := sql.DecryptString(user.TaxIDCipher, value.String)
+				if err != nil {
+					return fmt.Errorf("decrypt field tax_id: %v", err)
+				}
+				u.TaxID = v
+			}
+		case user.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				v, err := sql.DecryptString(user.EmailCipher, value.String)
+				if err != nil {
+					return fmt.Errorf("decrypt field email: %v", err)
+				}
+				u.Email = v
+			}
+		case user.FieldAddressStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_street", values[i])
+			} else if value.Valid {
+				u.Address.Street = value.String
+			}
+		case user.FieldAddressCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_city", values[i])
+			} else if value.Valid {
+				u.Address.City = value.String
+			}
+		case user.FieldAddressZipCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field address_zip_code", values[i])
+			} else if value.Valid {
+				u.Address.ZipCode = int(value.Int64)
+			}
+		case user.FieldAddressSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field address_since", values[i])
+			} else if value.Valid {
+				u.Address.Since = value.Time
+			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_children", value)
+			} else if value.Valid {
+				u.user_children = new(int)
+				*u.user_children = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (u *User) SelectValue(alias string) (Value, error) {
+	v, ok := u.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryGroups queries the groups edge of the User.
 func (u *User) QueryGroups() *GroupQuery {
 	return (&UserClient{config: u.config}).QueryGroups(u)
@@ -139,6 +261,131 @@ func (u *User) QueryPets() *PetQuery {
 	return (&UserClient{config: u.config}).QueryPets(u)
 }
 
+// LoadEdges loads the given edges of the User using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see user.Edges), and in this case, no edge is loaded.
+//
+//	err := u.LoadEdges(ctx, client, user.EdgeGroups, user.EdgeParent)
+//
+func (u *User) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadUserEdges(ctx, client, []*User{u}, edges)
+}
+
+// loadUserEdges loads the given edges of all the given User entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using UserClient.LoadEdgeFor.
+func loadUserEdges(ctx context.Context, client *Client, nodes []*User, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case user.EdgeGroups, user.EdgeParent, user.EdgeChildren, user.EdgePets:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type User", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.User.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the User and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the User alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The User itself is not modified.
+//
+//	b, err := u.DumpGraph(ctx, client, 2, user.EdgeGroups)
+//
+func (u *User) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for User DumpGraph", depth)
+	}
+	dumped := *u
+	dumped.Edges = UserEdges{}
+	if err := dumpUsers(ctx, client, []*User{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpUsers loads the edges of the given User copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpUsers(ctx context.Context, client *Client, nodes []*User, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*User
+	for _, n := range nodes {
+		if dumper.visit(user.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range user.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadUserEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextGroups []*Group
+	for _, n := range visit {
+		for i, e := range n.Edges.Groups {
+			c := *e
+			c.Edges = GroupEdges{}
+			n.Edges.Groups[i] = &c
+			nextGroups = append(nextGroups, &c)
+		}
+	}
+	if err := dumpGroups(ctx, client, nextGroups, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextParent []*User
+	for _, n := range visit {
+		if e := n.Edges.Parent; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Parent = &c
+			nextParent = append(nextParent, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextParent, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextChildren []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Children {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Children[i] = &c
+			nextChildren = append(nextChildren, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextChildren, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextPets []*Pet
+	for _, n := range visit {
+		for i, e := range n.Edges.Pets {
+			c := *e
+			c.Edges = PetEdges{}
+			n.Edges.Pets[i] = &c
+			nextPets = append(nextPets, &c)
+		}
+	}
+	if err := dumpPets(ctx, client, nextPets, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -157,15 +404,126 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the User and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if v, ok := seen[u]; ok {
+		return v.(*User)
+	}
+	_c := *u
+	seen[u] = &_c
+	if u.Edges.Groups != nil {
+		_c.Edges.Groups = make([]*Group, len(u.Edges.Groups))
+		for _i := range u.Edges.Groups {
+			_c.Edges.Groups[_i] = u.Edges.Groups[_i].clone(seen)
+		}
+	}
+	_c.Edges.Parent = u.Edges.Parent.clone(seen)
+	if u.Edges.Children != nil {
+		_c.Edges.Children = make([]*User, len(u.Edges.Children))
+		for _i := range u.Edges.Children {
+			_c.Edges.Children[_i] = u.Edges.Children[_i].clone(seen)
+		}
+	}
+	if u.Edges.Pets != nil {
+		_c.Edges.Pets = make([]*Pet, len(u.Edges.Pets))
+		for _i := range u.Edges.Pets {
+			_c.Edges.Pets[_i] = u.Edges.Pets[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	var builder strings.Builder
 	builder.WriteString("User(")
 	builder.WriteString(fmt.Sprintf("id=%v", u.ID))
+	builder.WriteString(", last_ip=")
+	builder.WriteString(fmt.Sprintf("%v", u.LastIP))
+	builder.WriteString(", allowed_network=")
+	builder.WriteString(fmt.Sprintf("%v", u.AllowedNetwork))
+	builder.WriteString(", ssn=")
+	builder.WriteString(u.Ssn)
+	builder.WriteString(", tax_id=")
+	builder.WriteString(u.TaxID)
+	builder.WriteString(", email=")
+	builder.WriteString(u.Email)
+	builder.WriteString(", address_street=")
+	builder.WriteString(u.Address.Street)
+	builder.WriteString(", address_city=")
+	builder.WriteString(u.Address.City)
+	builder.WriteString(", address_zip_code=")
+	builder.WriteString(fmt.Sprintf("%v", u.Address.ZipCode))
+	builder.WriteString(", address_since=")
+	builder.WriteString(u.Address.Since.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the User, for change detection and cache keys.
+// It covers the fields that are listed in user.FingerprintFields, and it does not depend on the
+// ID of the User, on its edges, or on the order of the fields in the schema.
+func (u *User) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "address_city", u.Address.City)
+	fingerprint(h, "address_since", u.Address.Since)
+	fingerprint(h, "address_street", u.Address.Street)
+	fingerprint(h, "address_zip_code", u.Address.ZipCode)
+	fingerprint(h, "allowed_network", u.AllowedNetwork)
+	fingerprint(h, "email", u.Email)
+	fingerprint(h, "last_ip", u.LastIP)
+	fingerprint(h, "ssn", u.Ssn)
+	fingerprint(h, "tax_id", u.TaxID)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the User for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The User can be decoded using the FromMap method of its client.
+func (u *User) ToMap() map[string]string {
+	m := make(map[string]string, 10)
+	m[user.FieldID] = kvEncode(u.ID)
+	if v := u.LastIP; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldLastIP] = kvEncode(v)
+	}
+	if v := u.AllowedNetwork; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldAllowedNetwork] = kvEncode(v)
+	}
+	if v := u.Ssn; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldSsn] = kvEncode(v)
+	}
+	if v := u.TaxID; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldTaxID] = kvEncode(v)
+	}
+	if v := u.Email; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldEmail] = kvEncode(v)
+	}
+	if v := u.Address.Street; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldAddressStreet] = kvEncode(v)
+	}
+	if v := u.Address.City; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldAddressCity] = kvEncode(v)
+	}
+	if v := u.Address.ZipCode; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldAddressZipCode] = kvEncode(v)
+	}
+	if v := u.Address.Since; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldAddressSince] = kvEncode(v)
+	}
+	return m
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
@@ -6,11 +6,26 @@
 
 package user
 
+import (
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
 const (
 	// Label holds the string label denoting the user type in the database.
 	Label = "user"
 	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	FieldID             = "id"              // FieldLastIP holds the string denoting the last_ip vertex property in the database.
+	FieldLastIP         = "last_ip"         // FieldAllowedNetwork holds the string denoting the allowed_network vertex property in the database.
+	FieldAllowedNetwork = "allowed_network" // FieldSsn holds the string denoting the ssn vertex property in the database.
+	FieldSsn            = "ssn"             // FieldTaxID holds the string denoting the tax_id vertex property in the database.
+	FieldTaxID          = "tax_id"          // FieldEmail holds the string denoting the email vertex property in the database.
+	FieldEmail          = "email"
+	// FieldEmailBlindIndex holds the string denoting the blind index column of the email field.
+	FieldEmailBlindIndex = "email_bidx"       // FieldAddressStreet holds the string denoting the address_street vertex property in the database.
+	FieldAddressStreet   = "address_street"   // FieldAddressCity holds the string denoting the address_city vertex property in the database.
+	FieldAddressCity     = "address_city"     // FieldAddressZipCode holds the string denoting the address_zip_code vertex property in the database.
+	FieldAddressZipCode  = "address_zip_code" // FieldAddressSince holds the string denoting the address_since vertex property in the database.
+	FieldAddressSince    = "address_since"
 
 	// EdgeGroups holds the string denoting the groups edge name in mutations.
 	EdgeGroups = "groups"
@@ -45,9 +60,40 @@ const (
 	PetsColumn = "user_pets"
 )
 
+// Edges holds all the edge names of the User type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeGroups,
+	EdgeParent,
+	EdgeChildren,
+	EdgePets,
+}
+
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldAddressCity,
+	FieldAddressSince,
+	FieldAddressStreet,
+	FieldAddressZipCode,
+	FieldAllowedNetwork,
+	FieldEmail,
+	FieldLastIP,
+	FieldSsn,
+	FieldTaxID,
+}
+
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
+	FieldLastIP,
+	FieldAllowedNetwork,
+	FieldSsn,
+	FieldTaxID,
+	FieldEmail,
+	FieldAddressStreet,
+	FieldAddressCity,
+	FieldAddressZipCode,
+	FieldAddressSince,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the User type.
@@ -55,8 +101,35 @@ var ForeignKeys = []string{
 	"user_children",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
 	// primary key for the groups relation (M2M).
 	GroupsPrimaryKey = []string{"group_id", "user_id"}
 )
+
+// AddressSinceTrunc returns a column that truncates the address_since field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "address_since_<bucket>".
+func AddressSinceTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldAddressSince)
+}
+
+var (
+	// SsnCipher holds the cipher of the encrypted ssn field.
+	SsnCipher sql.Cipher
+	// TaxIDCipher holds the cipher of the encrypted tax_id field.
+	TaxIDCipher sql.Cipher
+	// EmailCipher holds the cipher of the encrypted email field.
+	EmailCipher sql.Cipher
+	// EmailBlindIndex holds the blind index of the encrypted email field.
+	EmailBlindIndex sql.BlindIndex
+)
@@ -7,91 +7,1123 @@
 package user
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
 )
 
-// ID filters vertices based on their identifier.
-func ID(id int) predicate.User {
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// LastIP applies equality check predicate on the "last_ip" field. It's identical to LastIPEQ.
+func LastIP(v sql.IP) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastIP), v))
+	})
+}
+
+// AllowedNetwork applies equality check predicate on the "allowed_network" field. It's identical to AllowedNetworkEQ.
+func AllowedNetwork(v sql.CIDR) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAllowedNetwork), v))
+	})
+}
+
+// TaxID applies equality check predicate on the "tax_id" field. It's identical to TaxIDEQ.
+func TaxID(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxID), sql.EncryptValue(TaxIDCipher, v)))
+	})
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmailBlindIndex), sql.BlindIndexValue(EmailBlindIndex, v)))
+	})
+}
+
+// AddressStreet applies equality check predicate on the "address_street" field. It's identical to AddressStreetEQ.
+func AddressStreet(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressCity applies equality check predicate on the "address_city" field. It's identical to AddressCityEQ.
+func AddressCity(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressZipCode applies equality check predicate on the "address_zip_code" field. It's identical to AddressZipCodeEQ.
+func AddressZipCode(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressZipCode), v))
+	})
+}
+
+// AddressSince applies equality check predicate on the "address_since" field. It's identical to AddressSinceEQ.
+func AddressSince(v time.Time) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressSince), v))
+	})
+}
+
+// LastIPEQ applies the EQ predicate on the "last_ip" field.
+func LastIPEQ(v sql.IP) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldLastIP), v))
+	})
+}
+
+// LastIPNEQ applies the NEQ predicate on the "last_ip" field.
+func LastIPNEQ(v sql.IP) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldLastIP), v))
+	})
+}
+
+// LastIPIn applies the In predicate on the "last_ip" field.
+func LastIPIn(vs ...sql.IP) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldLastIP), v...))
+	})
+}
+
+// LastIPNotIn applies the NotIn predicate on the "last_ip" field.
+func LastIPNotIn(vs ...sql.IP) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldLastIP), v...))
+	})
+}
+
+// LastIPIsNil applies the IsNil predicate on the "last_ip" field.
+func LastIPIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldLastIP)))
+	})
+}
+
+// LastIPNotNil applies the NotNil predicate on the "last_ip" field.
+func LastIPNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldLastIP)))
+	})
+}
+
+// AllowedNetworkEQ applies the EQ predicate on the "allowed_network" field.
+func AllowedNetworkEQ(v sql.CIDR) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAllowedNetwork), v))
+	})
+}
+
+// AllowedNetworkNEQ applies the NEQ predicate on the "allowed_network" field.
+func AllowedNetworkNEQ(v sql.CIDR) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAllowedNetwork), v))
+	})
+}
+
+// AllowedNetworkIn applies the In predicate on the "allowed_network" field.
+func AllowedNetworkIn(vs ...sql.CIDR) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAllowedNetwork), v...))
+	})
+}
+
+// AllowedNetworkNotIn applies the NotIn predicate on the "allowed_network" field.
+func AllowedNetworkNotIn(vs ...sql.CIDR) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAllowedNetwork), v...))
+	})
+}
+
+// AllowedNetworkIsNil applies the IsNil predicate on the "allowed_network" field.
+func AllowedNetworkIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAllowedNetwork)))
+	})
+}
+
+// AllowedNetworkNotNil applies the NotNil predicate on the "allowed_network" field.
+func AllowedNetworkNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAllowedNetwork)))
+	})
+}
+
+// SsnIsNil applies the IsNil predicate on the "ssn" field.
+func SsnIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSsn)))
+	})
+}
+
+// SsnNotNil applies the NotNil predicate on the "ssn" field.
+func SsnNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSsn)))
+	})
+}
+
+// TaxIDEQ applies the EQ predicate on the "tax_id" field.
+func TaxIDEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTaxID), sql.EncryptValue(TaxIDCipher, v)))
+	})
+}
+
+// TaxIDNEQ applies the NEQ predicate on the "tax_id" field.
+func TaxIDNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTaxID), sql.EncryptValue(TaxIDCipher, v)))
+	})
+}
+
+// TaxIDIn applies the In predicate on the "tax_id" field.
+func TaxIDIn(vs ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(vs))
+		for i := range v {
+			v[i] = sql.EncryptValue(TaxIDCipher, vs[i])
+		}
+		s.Where(sql.In(s.C(FieldTaxID), v...))
+	})
+}
+
+// TaxIDNotIn applies the NotIn predicate on the "tax_id" field.
+func TaxIDNotIn(vs ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(vs))
+		for i := range v {
+			v[i] = sql.EncryptValue(TaxIDCipher, vs[i])
+		}
+		s.Where(sql.NotIn(s.C(FieldTaxID), v...))
+	})
+}
+
+// TaxIDIsNil applies the IsNil predicate on the "tax_id" field.
+func TaxIDIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTaxID)))
+	})
+}
+
+// TaxIDNotNil applies the NotNil predicate on the "tax_id" field.
+func TaxIDNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTaxID)))
+	})
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldEmailBlindIndex), sql.BlindIndexValue(EmailBlindIndex, v)))
+	})
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldEmailBlindIndex), sql.BlindIndexValue(EmailBlindIndex, v)))
+	})
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(vs))
+		for i := range v {
+			v[i] = sql.BlindIndexValue(EmailBlindIndex, vs[i])
+		}
+		s.Where(sql.In(s.C(FieldEmailBlindIndex), v...))
+	})
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(vs))
+		for i := range v {
+			v[i] = sql.BlindIndexValue(EmailBlindIndex, vs[i])
+		}
+		s.Where(sql.NotIn(s.C(FieldEmailBlindIndex), v...))
+	})
+}
+
+// EmailIsNil applies the IsNil predicate on the "email" field.
+func EmailIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldEmail)))
+	})
+}
+
+// EmailNotNil applies the NotNil predicate on the "email" field.
+func EmailNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldEmail)))
+	})
+}
+
+// AddressStreetEQ applies the EQ predicate on the "address_street" field.
+func AddressStreetEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetNEQ applies the NEQ predicate on the "address_street" field.
+func AddressStreetNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetIn applies the In predicate on the "address_street" field.
+func AddressStreetIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAddressStreet), v...))
+	})
+}
+
+// AddressStreetNotIn applies the NotIn predicate on the "address_street" field.
+func AddressStreetNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAddressStreet), v...))
+	})
+}
+
+// AddressStreetGT applies the GT predicate on the "address_street" field.
+func AddressStreetGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetGTE applies the GTE predicate on the "address_street" field.
+func AddressStreetGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetLT applies the LT predicate on the "address_street" field.
+func AddressStreetLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetLTE applies the LTE predicate on the "address_street" field.
+func AddressStreetLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetContains applies the Contains predicate on the "address_street" field.
+func AddressStreetContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetNotContains applies the NotContains predicate on the "address_street" field.
+func AddressStreetNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetHasPrefix applies the HasPrefix predicate on the "address_street" field.
+func AddressStreetHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetNotHasPrefix applies the NotHasPrefix predicate on the "address_street" field.
+func AddressStreetNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetHasSuffix applies the HasSuffix predicate on the "address_street" field.
+func AddressStreetHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetNotHasSuffix applies the NotHasSuffix predicate on the "address_street" field.
+func AddressStreetNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetIsNil applies the IsNil predicate on the "address_street" field.
+func AddressStreetIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAddressStreet)))
+	})
+}
+
+// AddressStreetNotNil applies the NotNil predicate on the "address_street" field.
+func AddressStreetNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAddressStreet)))
+	})
+}
+
+// AddressStreetEqualFold applies the EqualFold predicate on the "address_street" field.
+func AddressStreetEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetContainsFold applies the ContainsFold predicate on the "address_street" field.
+func AddressStreetContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetHasPrefixFold applies the HasPrefixFold predicate on the "address_street" field.
+func AddressStreetHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressStreetHasSuffixFold applies the HasSuffixFold predicate on the "address_street" field.
+func AddressStreetHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldAddressStreet), v))
+	})
+}
+
+// AddressCityEQ applies the EQ predicate on the "address_city" field.
+func AddressCityEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityNEQ applies the NEQ predicate on the "address_city" field.
+func AddressCityNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityIn applies the In predicate on the "address_city" field.
+func AddressCityIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldAddressCity), v...))
+	})
+}
+
+// AddressCityNotIn applies the NotIn predicate on the "address_city" field.
+func AddressCityNotIn(vs ...string) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.User(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldID), id))
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldAddressCity), v...))
 	})
 }
 
-// IDEQ applies the EQ predicate on the ID field.
-func IDEQ(id int) predicate.User {
+// AddressCityGT applies the GT predicate on the "address_city" field.
+func AddressCityGT(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
-		s.Where(sql.EQ(s.C(FieldID), id))
+		s.Where(sql.GT(s.C(FieldAddressCity), v))
 	})
 }
 
-// IDNEQ applies the NEQ predicate on the ID field.
-func IDNEQ(id int) predicate.User {
+// AddressCityGTE applies the GTE predicate on the "address_city" field.
+func AddressCityGTE(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
-		s.Where(sql.NEQ(s.C(FieldID), id))
+		s.Where(sql.GTE(s.C(FieldAddressCity), v))
 	})
 }
 
-// IDIn applies the In predicate on the ID field.
-func IDIn(ids ...int) predicate.User {
+// AddressCityLT applies the LT predicate on the "address_city" field.
+func AddressCityLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityLTE applies the LTE predicate on the "address_city" field.
+func AddressCityLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityContains applies the Contains predicate on the "address_city" field.
+func AddressCityContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityNotContains applies the NotContains predicate on the "address_city" field.
+func AddressCityNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityHasPrefix applies the HasPrefix predicate on the "address_city" field.
+func AddressCityHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityNotHasPrefix applies the NotHasPrefix predicate on the "address_city" field.
+func AddressCityNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityHasSuffix applies the HasSuffix predicate on the "address_city" field.
+func AddressCityHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityNotHasSuffix applies the NotHasSuffix predicate on the "address_city" field.
+func AddressCityNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityIsNil applies the IsNil predicate on the "address_city" field.
+func AddressCityIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAddressCity)))
+	})
+}
+
+// AddressCityNotNil applies the NotNil predicate on the "address_city" field.
+func AddressCityNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAddressCity)))
+	})
+}
+
+// AddressCityEqualFold applies the EqualFold predicate on the "address_city" field.
+func AddressCityEqualFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityContainsFold applies the ContainsFold predicate on the "address_city" field.
+func AddressCityContainsFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityHasPrefixFold applies the HasPrefixFold predicate on the "address_city" field.
+func AddressCityHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressCityHasSuffixFold applies the HasSuffixFold predicate on the "address_city" field.
+func AddressCityHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldAddressCity), v))
+	})
+}
+
+// AddressZipCodeEQ applies the EQ predicate on the "address_zip_code" field.
+func AddressZipCodeEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressZipCode), v))
+	})
+}
+
+// AddressZipCodeNEQ applies the NEQ predicate on the "address_zip_code" field.
+func AddressZipCodeNEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAddressZipCode), v))
+	})
+}
+
+// AddressZipCodeIn applies the In predicate on the "address_zip_code" field.
+func AddressZipCodeIn(vs ...int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.User(func(s *sql.Selector) {
 		// if not arguments were provided, append the FALSE constants,
 		// since we can't apply "IN ()". This will make this predicate falsy.
-		if len(ids) == 0 {
+		if len(vs) == 0 {
 			s.Where(sql.False())
 			return
 		}
-		v := make([]interface{}, len(ids))
-		for i := range v {
-			v[i] = ids[i]
+		s.Where(sql.In(s.C(FieldAddressZipCode), v...))
+	})
+}
+
+// AddressZipCodeNotIn applies the NotIn predicate on the "address_zip_code" field.
+func AddressZipCodeNotIn(vs ...int) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
 		}
-		s.Where(sql.In(s.C(FieldID), v...))
+		s.Where(sql.NotIn(s.C(FieldAddressZipCode), v...))
 	})
 }
 
-// IDNotIn applies the NotIn predicate on the ID field.
-func IDNotIn(ids ...int) predicate.User {
+// AddressZipCodeGT applies the GT predicate on the "address_zip_code" field.
+func AddressZipCodeGT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldAddressZipCode), v))
+	})
+}
+
+// AddressZipCodeGTE applies the GTE predicate on the "address_zip_code" field.
+func AddressZipCodeGTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldAddressZipCode), v))
+	})
+}
+
+// AddressZipCodeLT applies the LT predicate on the "address_zip_code" field.
+func AddressZipCodeLT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldAddressZipCode), v))
+	})
+}
+
+// AddressZipCodeLTE applies the LTE predicate on the "address_zip_code" field.
+func AddressZipCodeLTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldAddressZipCode), v))
+	})
+}
+
+// AddressZipCodeIsNil applies the IsNil predicate on the "address_zip_code" field.
+func AddressZipCodeIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAddressZipCode)))
+	})
+}
+
+// AddressZipCodeNotNil applies the NotNil predicate on the "address_zip_code" field.
+func AddressZipCodeNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAddressZipCode)))
+	})
+}
+
+// AddressSinceEQ applies the EQ predicate on the "address_since" field.
+func AddressSinceEQ(v time.Time) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldAddressSince), v))
+	})
+}
+
+// AddressSinceNEQ applies the NEQ predicate on the "address_since" field.
+func AddressSinceNEQ(v time.Time) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldAddressSince), v))
+	})
+}
+
+// AddressSinceIn applies the In predicate on the "address_since" field.
+func AddressSinceIn(vs ...time.Time) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
 	return predicate.User(func(s *sql.Selector) {
 		// if not arguments were provided, append the FALSE constants,
 		// since we can't apply "IN ()". This will make this predicate falsy.
-		if len(ids) == 0 {
+		if len(vs) == 0 {
 			s.Where(sql.False())
 			return
 		}
-		v := make([]interface{}, len(ids))
-		for i := range v {
-			v[i] = ids[i]
+		s.Where(sql.In(s.C(FieldAddressSince), v...))
+	})
+}
+
+// AddressSinceNotIn applies the NotIn predicate on the "address_since" field.
+func AddressSinceNotIn(vs ...time.Time) predicate.User {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
 		}
-		s.Where(sql.NotIn(s.C(FieldID), v...))
+		s.Where(sql.NotIn(s.C(FieldAddressSince), v...))
 	})
 }
 
-// IDGT applies the GT predicate on the ID field.
-func IDGT(id int) predicate.User {
+// AddressSinceGT applies the GT predicate on the "address_since" field.
+func AddressSinceGT(v time.Time) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
-		s.Where(sql.GT(s.C(FieldID), id))
+		s.Where(sql.GT(s.C(FieldAddressSince), v))
 	})
 }
 
-// IDGTE applies the GTE predicate on the ID field.
-func IDGTE(id int) predicate.User {
+// AddressSinceGTE applies the GTE predicate on the "address_since" field.
+func AddressSinceGTE(v time.Time) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
-		s.Where(sql.GTE(s.C(FieldID), id))
+		s.Where(sql.GTE(s.C(FieldAddressSince), v))
 	})
 }
 
-// IDLT applies the LT predicate on the ID field.
-func IDLT(id int) predicate.User {
+// AddressSinceLT applies the LT predicate on the "address_since" field.
+func AddressSinceLT(v time.Time) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
-		s.Where(sql.LT(s.C(FieldID), id))
+		s.Where(sql.LT(s.C(FieldAddressSince), v))
 	})
 }
 
-// IDLTE applies the LTE predicate on the ID field.
-func IDLTE(id int) predicate.User {
+// AddressSinceLTE applies the LTE predicate on the "address_since" field.
+func AddressSinceLTE(v time.Time) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
-		s.Where(sql.LTE(s.C(FieldID), id))
+		s.Where(sql.LTE(s.C(FieldAddressSince), v))
+	})
+}
+
+// AddressSinceIsNil applies the IsNil predicate on the "address_since" field.
+func AddressSinceIsNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldAddressSince)))
+	})
+}
+
+// AddressSinceNotNil applies the NotNil predicate on the "address_since" field.
+func AddressSinceNotNil() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldAddressSince)))
+	})
+}
+
+// LastIPWithin applies a predicate on the "last_ip" field, that checks if its address
+// is within the given network (e.g. "10.0.0.0/8"), or equal to it. Supported only by PostgreSQL.
+//
+//	WHERE last_ip <<= network
+//
+func LastIPWithin(network string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		n, err := sql.ParseCIDR(network)
+		switch d := s.Dialect(); {
+		case d != dialect.Postgres:
+			s.AddError(fmt.Errorf("user: LastIPWithin is not supported by %s", d))
+		case err != nil:
+			s.AddError(fmt.Errorf("user: LastIPWithin: %v", err))
+		default:
+			s.Where(sql.InetWithin(s.C(FieldLastIP), n.String()))
+		}
+	})
+}
+
+// LastIPContains applies a predicate on the "last_ip" field, that checks if its address (with
+// its netmask) contains the given address (e.g. "10.1.2.3"). Supported only by PostgreSQL.
+//
+//	WHERE last_ip >>= addr
+//
+func LastIPContains(addr string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		ip, err := sql.ParseIP(addr)
+		switch d := s.Dialect(); {
+		case d != dialect.Postgres:
+			s.AddError(fmt.Errorf("user: LastIPContains is not supported by %s", d))
+		case err != nil:
+			s.AddError(fmt.Errorf("user: LastIPContains: %v", err))
+		default:
+			s.Where(sql.InetContains(s.C(FieldLastIP), ip.String()))
+		}
+	})
+}
+
+// LastIPFamily applies a predicate on the "last_ip" field, that checks if its address
+// belongs to the given family (4 for IPv4 and 6 for IPv6).
+func LastIPFamily(family int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		if family != 4 && family != 6 {
+			s.AddError(fmt.Errorf("user: LastIPFamily: invalid address family %d", family))
+			return
+		}
+		s.Where(sql.InetFamily(s.C(FieldLastIP), family))
+	})
+}
+
+// AllowedNetworkWithin applies a predicate on the "allowed_network" field, that checks if its network
+// is within the given network (e.g. "10.0.0.0/8"), or equal to it. Supported only by PostgreSQL.
+//
+//	WHERE allowed_network <<= network
+//
+func AllowedNetworkWithin(network string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		n, err := sql.ParseCIDR(network)
+		switch d := s.Dialect(); {
+		case d != dialect.Postgres:
+			s.AddError(fmt.Errorf("user: AllowedNetworkWithin is not supported by %s", d))
+		case err != nil:
+			s.AddError(fmt.Errorf("user: AllowedNetworkWithin: %v", err))
+		default:
+			s.Where(sql.InetWithin(s.C(FieldAllowedNetwork), n.String()))
+		}
+	})
+}
+
+// AllowedNetworkContains applies a predicate on the "allowed_network" field, that checks if its network contains the given address (e.g. "10.1.2.3"). Supported only by PostgreSQL.
+//
+//	WHERE allowed_network >>= addr
+//
+func AllowedNetworkContains(addr string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		ip, err := sql.ParseIP(addr)
+		switch d := s.Dialect(); {
+		case d != dialect.Postgres:
+			s.AddError(fmt.Errorf("user: AllowedNetworkContains is not supported by %s", d))
+		case err != nil:
+			s.AddError(fmt.Errorf("user: AllowedNetworkContains: %v", err))
+		default:
+			s.Where(sql.InetContains(s.C(FieldAllowedNetwork), ip.String()))
+		}
+	})
+}
+
+// AllowedNetworkFamily applies a predicate on the "allowed_network" field, that checks if its network
+// belongs to the given family (4 for IPv4 and 6 for IPv6).
+func AllowedNetworkFamily(family int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		if family != 4 && family != 6 {
+			s.AddError(fmt.Errorf("user: AllowedNetworkFamily: invalid address family %d", family))
+			return
+		}
+		s.Where(sql.InetFamily(s.C(FieldAllowedNetwork), family))
+	})
+}
+
+// AddressStreetEQNullSafe applies a NULL-safe equality predicate on the "address_street" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func AddressStreetEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldAddressStreet), arg))
+	})
+}
+
+// AddressCityEQNullSafe applies a NULL-safe equality predicate on the "address_city" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func AddressCityEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldAddressCity), arg))
+	})
+}
+
+// AddressZipCodeEQNullSafe applies a NULL-safe equality predicate on the "address_zip_code" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func AddressZipCodeEQNullSafe(v *int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldAddressZipCode), arg))
+	})
+}
+
+// AddressSinceEQNullSafe applies a NULL-safe equality predicate on the "address_since" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func AddressSinceEQNullSafe(v *time.Time) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldAddressSince), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldAddressCity:    "string",
+	FieldAddressSince:   "time",
+	FieldAddressStreet:  "string",
+	FieldAddressZipCode: "numeric",
+	FieldID:             "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the User.
+func FieldsEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the User.
+func FieldsNEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the User.
+//
+//	user.FieldsLT(user.FieldAddressStreet, user.FieldAddressCity)
+//
+func FieldsLT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the User.
+func FieldsLTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the User.
+func FieldsGT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the User.
+func FieldsGTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the User using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of User", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of User", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of User are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
 	})
 }
 
@@ -151,6 +1183,45 @@ func HasParentWith(preds ...predicate.User) predicate.User {
 	})
 }
 
+// ParentIDIn applies a predicate on the "parent" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	user.ParentIDIn(client.User.Query().Where(...))
+//
+func ParentIDIn(q sqlgraph.IDsQuerier) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(ParentColumn), sub))
+	})
+}
+
+// ParentMissing applies a predicate on the "parent" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_children IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_children)
+//
+func ParentMissing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("parent")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(ParentColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(ParentColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasChildren applies the HasEdge predicate on the "children" edge.
 func HasChildren() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -214,6 +1285,9 @@ func And(predicates ...predicate.User) predicate.User {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -228,6 +1302,9 @@ func Or(predicates ...predicate.User) predicate.User {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -238,3 +1315,74 @@ func Not(p predicate.User) predicate.User {
 		p(s.Not())
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// UserFilter holds optional filters on the User fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type UserFilter struct {
+	IDIn           []int
+	TaxID          *string
+	Email          *string
+	AddressStreet  *string
+	AddressCity    *string
+	AddressZipCode *int
+	AddressSince   *TimeRange
+	ParentIDIn     []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.User.Query().
+//		Where(user.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *UserFilter) []predicate.User {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.User
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.TaxID != nil {
+		ps = append(ps, TaxIDEQ(*f.TaxID))
+	}
+	if f.Email != nil {
+		ps = append(ps, EmailEQ(*f.Email))
+	}
+	if f.AddressStreet != nil {
+		ps = append(ps, AddressStreetEQ(*f.AddressStreet))
+	}
+	if f.AddressCity != nil {
+		ps = append(ps, AddressCityEQ(*f.AddressCity))
+	}
+	if f.AddressZipCode != nil {
+		ps = append(ps, AddressZipCodeEQ(*f.AddressZipCode))
+	}
+	if r := f.AddressSince; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, AddressSinceGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, AddressSinceLT(r.To))
+		}
+	}
+	if ids := f.ParentIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.User(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(ParentColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -8,11 +8,16 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/user"
 	"github.com/facebookincubator/ent/schema/field"
 )
@@ -24,12 +29,147 @@ type UserCreate struct {
 	hooks    []Hook
 }
 
+// SetLastIP sets the last_ip field.
+func (uc *UserCreate) SetLastIP(s sql.IP) *UserCreate {
+	uc.mutation.SetLastIP(s)
+	return uc
+}
+
+// SetNillableLastIP sets the last_ip field if the given value is not nil.
+func (uc *UserCreate) SetNillableLastIP(s *sql.IP) *UserCreate {
+	if s != nil {
+		uc.SetLastIP(*s)
+	}
+	return uc
+}
+
+// SetAllowedNetwork sets the allowed_network field.
+func (uc *UserCreate) SetAllowedNetwork(s sql.CIDR) *UserCreate {
+	uc.mutation.SetAllowedNetwork(s)
+	return uc
+}
+
+// SetNillableAllowedNetwork sets the allowed_network field if the given value is not nil.
+func (uc *UserCreate) SetNillableAllowedNetwork(s *sql.CIDR) *UserCreate {
+	if s != nil {
+		uc.SetAllowedNetwork(*s)
+	}
+	return uc
+}
+
+// SetSsn sets the ssn field.
+func (uc *UserCreate) SetSsn(s string) *UserCreate {
+	uc.mutation.SetSsn(s)
+	return uc
+}
+
+// SetNillableSsn sets the ssn field if the given value is not nil.
+func (uc *UserCreate) SetNillableSsn(s *string) *UserCreate {
+	if s != nil {
+		uc.SetSsn(*s)
+	}
+	return uc
+}
+
+// SetTaxID sets the tax_id field.
+func (uc *UserCreate) SetTaxID(s string) *UserCreate {
+	uc.mutation.SetTaxID(s)
+	return uc
+}
+
+// SetNillableTaxID sets the tax_id field if the given value is not nil.
+func (uc *UserCreate) SetNillableTaxID(s *string) *UserCreate {
+	if s != nil {
+		uc.SetTaxID(*s)
+	}
+	return uc
+}
+
+// SetEmail sets the email field.
+func (uc *UserCreate) SetEmail(s string) *UserCreate {
+	uc.mutation.SetEmail(s)
+	return uc
+}
+
+// SetNillableEmail sets the email field if the given value is not nil.
+func (uc *UserCreate) SetNillableEmail(s *string) *UserCreate {
+	if s != nil {
+		uc.SetEmail(*s)
+	}
+	return uc
+}
+
+// SetAddressStreet sets the address_street field.
+func (uc *UserCreate) SetAddressStreet(s string) *UserCreate {
+	uc.mutation.SetAddressStreet(s)
+	return uc
+}
+
+// SetNillableAddressStreet sets the address_street field if the given value is not nil.
+func (uc *UserCreate) SetNillableAddressStreet(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAddressStreet(*s)
+	}
+	return uc
+}
+
+// SetAddressCity sets the address_city field.
+func (uc *UserCreate) SetAddressCity(s string) *UserCreate {
+	uc.mutation.SetAddressCity(s)
+	return uc
+}
+
+// SetNillableAddressCity sets the address_city field if the given value is not nil.
+func (uc *UserCreate) SetNillableAddressCity(s *string) *UserCreate {
+	if s != nil {
+		uc.SetAddressCity(*s)
+	}
+	return uc
+}
+
+// SetAddressZipCode sets the address_zip_code field.
+func (uc *UserCreate) SetAddressZipCode(i int) *UserCreate {
+	uc.mutation.SetAddressZipCode(i)
+	return uc
+}
+
+// SetNillableAddressZipCode sets the address_zip_code field if the given value is not nil.
+func (uc *UserCreate) SetNillableAddressZipCode(i *int) *UserCreate {
+	if i != nil {
+		uc.SetAddressZipCode(*i)
+	}
+	return uc
+}
+
+// SetAddressSince sets the address_since field.
+func (uc *UserCreate) SetAddressSince(t time.Time) *UserCreate {
+	uc.mutation.SetAddressSince(t)
+	return uc
+}
+
+// SetNillableAddressSince sets the address_since field if the given value is not nil.
+func (uc *UserCreate) SetNillableAddressSince(t *time.Time) *UserCreate {
+	if t != nil {
+		uc.SetAddressSince(*t)
+	}
+	return uc
+}
+
 // SetID sets the id field.
 func (uc *UserCreate) SetID(i int) *UserCreate {
 	uc.mutation.SetID(i)
 	return uc
 }
 
+// SetAddress sets the fields of the address embedded field.
+func (uc *UserCreate) SetAddress(v schema.Address) *UserCreate {
+	uc.SetAddressStreet(v.Street)
+	uc.SetAddressCity(v.City)
+	uc.SetAddressZipCode(v.ZipCode)
+	uc.SetAddressSince(v.Since)
+	return uc
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (uc *UserCreate) AddGroupIDs(ids ...int) *UserCreate {
 	uc.mutation.AddGroupIDs(ids...)
@@ -96,6 +236,13 @@ func (uc *UserCreate) AddPets(p ...*Pet) *UserCreate {
 
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
+	if err := checkTx(uc.driver); err != nil {
+		return nil, err
+	}
+	uc.defaults()
+	if err := uc.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *User
@@ -131,21 +278,198 @@ func (uc *UserCreate) SaveX(ctx context.Context) *User {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uc *UserCreate) Unwrap() *UserCreate {
+	tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserCreate is not a transactional builder")
+	}
+	uc.config.driver = tx.drv
+	uc.mutation.driver = tx.drv
+	return uc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (uc *UserCreate) DebugFields() map[string]Value {
+	return debugFields(uc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (uc *UserCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uc *UserCreate) check() error {
+	return nil
+}
+
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
-	var (
-		u     = &User{config: uc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: user.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: user.FieldID,
-			},
+	ctx = uc.withOperation(ctx, "User", "Create")
+	u, _spec := uc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	if u.ID == 0 {
+		id := _spec.ID.Value.(int64)
+		u.ID = int(id)
+	}
+	return u, nil
+}
+
+// SaveID creates the User in the database and returns only its id. Unlike Save,
+// the User entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// User that holds only its id as the value that is returned by the next mutator.
+func (uc *UserCreate) SaveID(ctx context.Context) (id int, err error) {
+	uc.defaults()
+	if err = uc.check(); err != nil {
+		return id, err
+	}
+	if len(uc.hooks) == 0 {
+		return uc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*UserMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		uc.mutation = mutation
+		if id, err = uc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &User{config: uc.config, ID: id}, nil
+	})
+	for i := len(uc.hooks) - 1; i >= 0; i-- {
+		mut = uc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, uc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (uc *UserCreate) SaveIDX(ctx context.Context) int {
+	id, err := uc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (uc *UserCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = uc.withOperation(ctx, "User", "Create")
+	_spec := uc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, uc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	if id, ok := uc.mutation.ID(); ok {
+		return id, nil
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
+	u := &User{config: uc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: user.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: user.FieldID,
+		},
+	}
 	if id, ok := uc.mutation.ID(); ok {
 		u.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := uc.mutation.LastIP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldLastIP,
+		})
+		u.LastIP = value
+	}
+	if value, ok := uc.mutation.AllowedNetwork(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldAllowedNetwork,
+		})
+		u.AllowedNetwork = value
+	}
+	if value, ok := uc.mutation.Ssn(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.SsnCipher, value),
+			Column: user.FieldSsn,
+		})
+		u.Ssn = value
+	}
+	if value, ok := uc.mutation.TaxID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.TaxIDCipher, value),
+			Column: user.FieldTaxID,
+		})
+		u.TaxID = value
+	}
+	if value, ok := uc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.EmailCipher, value),
+			Column: user.FieldEmail,
+		})
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.BlindIndexValue(user.EmailBlindIndex, value),
+			Column: user.FieldEmailBlindIndex,
+		})
+		u.Email = value
+	}
+	if value, ok := uc.mutation.AddressStreet(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressStreet,
+		})
+		u.Address.Street = value
+	}
+	if value, ok := uc.mutation.AddressCity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressCity,
+		})
+		u.Address.City = value
+	}
+	if value, ok := uc.mutation.AddressZipCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAddressZipCode,
+		})
+		u.Address.ZipCode = value
+	}
+	if value, ok := uc.mutation.AddressSince(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: user.FieldAddressSince,
+		})
+		u.Address.Since = value
+	}
 	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -222,15 +546,628 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return u, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (uc *UserCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: user.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: user.FieldID,
+		},
+	}
+	if id, ok := uc.mutation.ID(); ok {
+		_spec.ID.Value = id
+	}
+	if value, ok := uc.mutation.LastIP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldLastIP,
+		})
+	}
+	if value, ok := uc.mutation.AllowedNetwork(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldAllowedNetwork,
+		})
+	}
+	if value, ok := uc.mutation.Ssn(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.SsnCipher, value),
+			Column: user.FieldSsn,
+		})
+	}
+	if value, ok := uc.mutation.TaxID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.TaxIDCipher, value),
+			Column: user.FieldTaxID,
+		})
+	}
+	if value, ok := uc.mutation.Email(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.EmailCipher, value),
+			Column: user.FieldEmail,
+		})
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.BlindIndexValue(user.EmailBlindIndex, value),
+			Column: user.FieldEmailBlindIndex,
+		})
+	}
+	if value, ok := uc.mutation.AddressStreet(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressStreet,
+		})
+	}
+	if value, ok := uc.mutation.AddressCity(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressCity,
+		})
+	}
+	if value, ok := uc.mutation.AddressZipCode(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAddressZipCode,
+		})
+	}
+	if value, ok := uc.mutation.AddressSince(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: user.FieldAddressSince,
+		})
+	}
+	if nodes := uc.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   user.GroupsTable,
+			Columns: user.GroupsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: group.FieldID,
+				},
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   user.ParentTable,
+			Columns: []string{user.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ChildrenTable,
+			Columns: []string{user.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.PetsTable,
+			Columns: []string{user.PetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeString,
+					Column: pet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// UserCreateBulk is the builder for creating a bulk of User entities.
+type UserCreateBulk struct {
+	config
+	builders []*UserCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the User entities in the database.
+func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
+	nodes, err := ucb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ucb.retries > 0 && isSQLDeadlockError(err) {
+		return ucb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ucb *UserCreateBulk) SaveX(ctx context.Context) []*User {
+	v, err := ucb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ucb *UserCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ucb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ucb *UserCreateBulk) CheckRefs() *UserCreateBulk {
+	ucb.refs = true
+	return ucb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ucb *UserCreateBulk) RetryOnDeadlock(max int) *UserCreateBulk {
+	ucb.retries = max
+	return ucb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.User.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.User.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ucb *UserCreateBulk) OnConflict(columns ...string) *UserUpsertBulk {
+	return &UserUpsertBulk{create: ucb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.User.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (uc *UserCreate) OnConflict(target ...sql.ConflictOption) *UserUpsertBulk {
+	bulk := &UserCreateBulk{config: uc.config, builders: []*UserCreate{uc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ucb *UserCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*User, error) {
+	ctx = ucb.withOperation(ctx, "User", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ucb.builders))
+		nodes    = make([]*User, len(ucb.builders))
+		mutators = make([]Mutator, len(ucb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range ucb.builders {
+		func(i int, root context.Context) {
+			builder := ucb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ucb.refs {
+						if err := ucb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ucb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					if nodes[i].ID == 0 {
+						id := specs[i].ID.Value.(int64)
+						nodes[i].ID = int(id)
+					}
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ucb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ucb *UserCreateBulk) retry(ctx context.Context, err error) ([]*User, error) {
+	if _, ok := ucb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	if u.ID == 0 {
-		id := _spec.ID.Value.(int64)
-		u.ID = int(id)
+	builders := ucb.builders
+	defer func() { ucb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ucb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ucb.builders = make([]*UserCreate, len(idx))
+	for i, j := range idx {
+		ucb.builders[i] = builders[j]
+	}
+	for n := 0; n < ucb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*User
+		if sorted, err = ucb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*User, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ucb *UserCreateBulk) less(a, b *UserCreate) bool {
+	{
+		x, _ := a.mutation.ID()
+		y, _ := b.mutation.ID()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ucb *UserCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ucb.builders {
+			for _, id := range builder.mutation.GroupsIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ucb.driver, group.Table, group.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[user.EdgeGroups] = append(missing[user.EdgeGroups], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ucb.builders {
+			for _, id := range builder.mutation.ParentIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ucb.driver, user.Table, user.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[user.EdgeParent] = append(missing[user.EdgeParent], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ucb.builders {
+			for _, id := range builder.mutation.ChildrenIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ucb.driver, user.Table, user.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[user.EdgeChildren] = append(missing[user.EdgeChildren], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[string]bool)
+		)
+		for _, builder := range ucb.builders {
+			for _, id := range builder.mutation.PetsIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []string
+			if err := selectIDs(ctx, ucb.driver, pet.Table, pet.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(string)] {
+					missing[user.EdgePets] = append(missing[user.EdgePets], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "User", Missing: missing}
+	}
+	return nil
+}
+
+// UserUpsertBulk is the builder for the conflict handling of a bulk of User entities.
+type UserUpsertBulk struct {
+	create  *UserCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (uub *UserUpsertBulk) Target(target ...sql.ConflictOption) *UserUpsertBulk {
+	uub.target = append(uub.target, target...)
+	return uub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (uub *UserUpsertBulk) DoNothing() *UserUpsertBulk {
+	uub.nothing = true
+	return uub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (uub *UserUpsertBulk) UpdateNewValues() *UserUpsertBulk {
+	uub.update = true
+	return uub
+}
+
+// Save creates the User entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (uub *UserUpsertBulk) Save(ctx context.Context) ([]*User, int, error) {
+	switch {
+	case !uub.nothing && !uub.update:
+		return nil, 0, errors.New("ent: missing conflict action for User bulk insert")
+	case uub.nothing && uub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for User bulk insert")
+	case uub.update:
+		if len(uub.columns) == 0 && len(uub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for User bulk upsert")
+		}
+		nodes, err := uub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(uub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(user.FieldID),
+			),
+			Columns:         user.Columns,
+			ConflictColumns: uub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(uub.conflictTarget(), sql.DoNothing())}
+	nodes, err := uub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*User, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (uub *UserUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(uub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(uub.columns...))
+	}
+	return append(opts, uub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uub *UserUpsertBulk) SaveX(ctx context.Context) ([]*User, int) {
+	nodes, skipped, err := uub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (uub *UserUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := uub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uub *UserUpsertBulk) ExecX(ctx context.Context) {
+	if err := uub.Exec(ctx); err != nil {
+		panic(err)
 	}
-	return u, nil
 }
@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -36,6 +39,18 @@ type UserQuery struct {
 	withChildren *UserQuery
 	withPets     *PetQuery
 	withFKs      bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see UserSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -47,6 +62,19 @@ func (uq *UserQuery) Where(ps ...predicate.User) *UserQuery {
 	return uq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (uq *UserQuery) Predicates() []predicate.User {
+	return append([]predicate.User{}, uq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (uq *UserQuery) SetPredicates(ps ...predicate.User) *UserQuery {
+	uq.predicates = append([]predicate.User{}, ps...)
+	return uq
+}
+
 // Limit adds a limit step to the query.
 func (uq *UserQuery) Limit(limit int) *UserQuery {
 	uq.limit = &limit
@@ -362,6 +390,19 @@ func (uq *UserQuery) WithPets(opts ...func(*PetQuery)) *UserQuery {
 
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		LastIP sql.IP `json:"last_ip,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldLastIP).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
 func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 	group := &UserGroupBy{config: uq.config}
 	group.fields = append([]string{field}, fields...)
@@ -375,14 +416,29 @@ func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 }
 
 // Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		LastIP sql.IP `json:"last_ip,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		Select(user.FieldLastIP).
+//		Scan(ctx, &v)
+//
 func (uq *UserQuery) Select(field string, fields ...string) *UserSelect {
 	selector := &UserSelect{config: uq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = uq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return uq.sqlQuery(), nil
+		if err := uq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return uq.sqlQuery().AppendSelectExpr(uq.exprs...), nil
 	}
 	return selector
 }
@@ -399,6 +455,15 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
+	ctx = uq.withOperation(ctx, "User", "Query")
+	if err := uq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range uq.fields {
+		if !user.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*User{}
 		withFKs     = uq.withFKs
@@ -416,12 +481,14 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, user.ForeignKeys...)
 	}
+	_spec.Exprs = uq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &User{config: uq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
-		if withFKs {
-			values = append(values, node.fkValues()...)
+		values := node.scanValues(columns)
+		for range uq.exprs {
+			values = append(values, new(interface{}))
 		}
 		return values
 	}
@@ -430,8 +497,16 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(uq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range uq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
 		return nil, err
@@ -441,6 +516,9 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	}
 
 	if query := uq.withGroups; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		ids := make(map[int]*User, len(nodes))
 		for _, node := range nodes {
@@ -457,9 +535,6 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 				Table:   user.GroupsTable,
 				Columns: user.GroupsPrimaryKey,
 			},
-			Predicate: func(s *sql.Selector) {
-				s.Where(sql.InValues(user.GroupsPrimaryKey[1], fks...))
-			},
 
 			ScanValues: func() [2]interface{} {
 				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
@@ -479,56 +554,118 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 				if !ok {
 					return fmt.Errorf("unexpected node id in edges: %v", outValue)
 				}
-				edgeids = append(edgeids, inValue)
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
 				edges[inValue] = append(edges[inValue], node)
 				return nil
 			},
 		}
-		if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
-			return nil, fmt.Errorf(`query edges "groups": %v`, err)
-		}
-		query.Where(group.IDIn(edgeids...))
-		neighbors, err := query.All(ctx)
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(user.GroupsPrimaryKey[1], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "groups": %v`, err)
+			}
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := edges[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && uq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "groups" cannot be applied on %d neighbors with batch size %d`, len(edgeids), uq.eagerLoadSize())
+			return nil, err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = uq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], group.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Groups = append(nodes[i].Edges.Groups, n)
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Groups = append(nodes[i].Edges.Groups, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return nil, err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Groups
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Groups = edges
 			}
 		}
 	}
 
 	if query := uq.withParent; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*User)
 		for i := range nodes {
 			if fk := nodes[i].user_children; fk != nil {
-				ids = append(ids, *fk)
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
 				nodeids[*fk] = append(nodeids[*fk], nodes[i])
 			}
 		}
-		query.Where(user.IDIn(ids...))
-		neighbors, err := query.All(ctx)
-		if err != nil {
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && uq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`ent: limit or offset of eager-loaded edge "parent" cannot be applied on %d neighbors with batch size %d`, len(ids), uq.eagerLoadSize())
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := nodeids[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "user_children" returned %v`, n.ID)
+		err := uq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Parent = n
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_children" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Parent = n
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if query := uq.withChildren; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*User)
 		for i := range nodes {
@@ -536,27 +673,38 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 			nodeids[nodes[i].ID] = nodes[i]
 		}
 		query.withFKs = true
-		query.Where(predicate.User(func(s *sql.Selector) {
-			s.Where(sql.InValues(user.ChildrenColumn, fks...))
-		}))
-		neighbors, err := query.All(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range neighbors {
-			fk := n.user_children
-			if fk == nil {
-				return nil, fmt.Errorf(`foreign-key "user_children" is nil for node %v`, n.ID)
+		// The limit and the offset of the query are applied per node.
+		query.partition = user.ChildrenColumn
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.User(func(s *sql.Selector) {
+				s.Where(sql.InValues(user.ChildrenColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			node, ok := nodeids[*fk]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "user_children" returned %v for node %v`, *fk, n.ID)
+			for _, n := range neighbors {
+				fk := n.user_children
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "user_children" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_children" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Children = append(node.Edges.Children, n)
 			}
-			node.Edges.Children = append(node.Edges.Children, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if query := uq.withPets; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*User)
 		for i := range nodes {
@@ -564,30 +712,76 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 			nodeids[nodes[i].ID] = nodes[i]
 		}
 		query.withFKs = true
-		query.Where(predicate.Pet(func(s *sql.Selector) {
-			s.Where(sql.InValues(user.PetsColumn, fks...))
-		}))
-		neighbors, err := query.All(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range neighbors {
-			fk := n.user_pets
-			if fk == nil {
-				return nil, fmt.Errorf(`foreign-key "user_pets" is nil for node %v`, n.ID)
+		// The limit and the offset of the query are applied per node.
+		query.partition = user.PetsColumn
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Pet(func(s *sql.Selector) {
+				s.Where(sql.InValues(user.PetsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			node, ok := nodeids[*fk]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "user_pets" returned %v for node %v`, *fk, n.ID)
+			for _, n := range neighbors {
+				fk := n.user_pets
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "user_pets" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_pets" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Pets = append(node.Edges.Pets, n)
 			}
-			node.Edges.Pets = append(node.Edges.Pets, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the User entities (see User.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.User.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the User table.
+func (uq *UserQuery) SelectExpr(exprs ...*sql.SelectExpr) *UserQuery {
+	uq.exprs = append(uq.exprs, exprs...)
+	return uq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (uq *UserQuery) checkExprs() error {
+	if len(uq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range user.Columns {
+		columns[c] = true
+	}
+	for _, c := range user.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range uq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of User", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = uq.withOperation(ctx, "User", "Count")
 	_spec := uq.querySpec()
 	return sqlgraph.CountNodes(ctx, uq.driver, _spec)
 }
@@ -613,6 +807,17 @@ func (uq *UserQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   uq.sql,
 		Unique: true,
 	}
+	if fields := uq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for i := range fields {
+			if fields[i] != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = uq.forUpdate
+	_spec.PartitionBy = uq.partition
 	if ps := uq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -661,11 +866,387 @@ func (uq *UserQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the User entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (uq *UserQuery) IDsQuery() *sql.Selector {
+	selector := uq.sqlQuery()
+	if uq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(user.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the User entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.User.Query().
+//		Where(...).
+//		CountDistinct(ctx, user.FieldLastIP)
+//
+func (uq *UserQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := uq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = uq.withOperation(ctx, "User", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, uq.driver, uq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (uq *UserQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := uq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched User rows as generic maps that are keyed by
+// column name (see user.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (uq *UserQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := uq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = uq.withOperation(ctx, "User", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = uq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&User{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, uq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (uq *UserQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := uq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of User entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (uq *UserQuery) Prefetch(n int) *UserQuery {
+	uq.prefetch = n
+	return uq
+}
+
+// ForUpdate locks the selected User rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (uq *UserQuery) ForUpdate() *UserQuery {
+	uq.forUpdate = true
+	return uq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (uq *UserQuery) Primary() *UserQuery {
+	return uq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (uq *UserQuery) ReadConsistency(c sql.ReadConsistency) *UserQuery {
+	uq.consistency = c
+	return uq
+}
+
+// Stream executes the query in batches, and sends the matched User entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.User.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (uq *UserQuery) Stream(ctx context.Context) (<-chan *User, <-chan error) {
+	size := uq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *User, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := uq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the User entities in batches of the given
+// size, and sends them to the given channel.
+func (uq *UserQuery) stream(ctx context.Context, size int, nodes chan<- *User) error {
+	if uq.offset != nil || len(uq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if uq.limit != nil {
+		left = *uq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *uq
+		query.predicates = uq.predicates[:len(uq.predicates):len(uq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, user.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(user.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// UserEdge is the edge representation of User in a connection.
+type UserEdge struct {
+	Node   *User  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// UserConnection is the connection of User entities that is returned by Paginate.
+type UserConnection struct {
+	Edges      []UserEdge `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.User.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: user.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (uq *UserQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*UserConnection, error) {
+	if uq.limit != nil || uq.offset != nil || len(uq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		return nil, fmt.Errorf("ent: unsupported order field %q for User", o.Field)
+	}
+	total, err := uq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: user.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *uq
+	query.predicates = uq.predicates[:len(uq.predicates):len(uq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := uq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &UserConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]UserEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = UserEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (uq *UserQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.User, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := uq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.User(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*UserQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case user.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for User", field)
+}
+
+// pageCursor returns the cursor of the User node for the given order fields.
+func (u *User) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(u.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if len(orderBy) > 0 {
+		return Cursor{}, fmt.Errorf("ent: unsupported order field %q for User", orderBy[0].Field)
+	}
+	return Cursor{id: id}, nil
+}
+
 // UserGroupBy is the builder for group-by User entities.
 type UserGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -778,9 +1359,28 @@ func (ugb *UserGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.User.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (ugb *UserGroupBy) Having(ps ...*sql.Predicate) *UserGroupBy {
+	ugb.havings = append(ugb.havings, ps...)
+	return ugb
+}
+
 func (ugb *UserGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ugb.withOperation(ctx, "User", "GroupBy")
+	selector := ugb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := ugb.sqlQuery().Query()
 	if err := ugb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -793,15 +1393,30 @@ func (ugb *UserGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(ugb.fields)+len(ugb.fns))
 	columns = append(columns, ugb.fields...)
 	for _, fn := range ugb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(ugb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
 	}
-	return selector.Select(columns...).GroupBy(ugb.fields...)
+	selector = selector.Select(columns...).GroupBy(ugb.fields...)
+	switch len(ugb.havings) {
+	case 0:
+	case 1:
+		selector.Having(ugb.havings[0])
+	default:
+		selector.Having(sql.And(ugb.havings...))
+	}
+	return selector
 }
 
 // UserSelect is the builder for select fields of User entities.
 type UserSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *UserQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -908,7 +1523,52 @@ func (us *UserSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (us *UserSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(us.fields) > 1 {
+		return nil, errors.New("ent: UserSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := us.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (us *UserSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := us.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the User entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.User.Query().
+//		Select(user.FieldLastIP).
+//		All(ctx)
+//
+func (us *UserSelect) All(ctx context.Context) ([]*User, error) {
+	query := *us.query
+	query.fields = us.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (us *UserSelect) AllX(ctx context.Context) []*User {
+	nodes, err := us.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (us *UserSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = us.withOperation(ctx, "User", "Select")
 	rows := &sql.Rows{}
 	query, args := us.sqlQuery().Query()
 	if err := us.driver.Query(ctx, query, args, rows); err != nil {
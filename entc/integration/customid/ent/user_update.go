@@ -8,13 +8,16 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/customid/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/customid/ent/user"
 	"github.com/facebookincubator/ent/schema/field"
 )
@@ -25,6 +28,9 @@ type UserUpdate struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -33,6 +39,290 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Limit limits the number of users that are updated by the builder. For example,
+// for updating large amounts of users in small batches without locking the whole table.
+func (uu *UserUpdate) Limit(limit int) *UserUpdate {
+	uu.limit = &limit
+	return uu
+}
+
+// SetLastIP sets the last_ip field.
+func (uu *UserUpdate) SetLastIP(s sql.IP) *UserUpdate {
+	uu.mutation.SetLastIP(s)
+	return uu
+}
+
+// SetNillableLastIP sets the last_ip field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLastIP(s *sql.IP) *UserUpdate {
+	if s != nil {
+		uu.SetLastIP(*s)
+	}
+	return uu
+}
+
+// ClearLastIP clears the value of last_ip.
+func (uu *UserUpdate) ClearLastIP() *UserUpdate {
+	uu.mutation.ClearLastIP()
+	return uu
+}
+
+// UnsetLastIP removes the changes of the last_ip field from the builder (e.g. a previous call
+// to SetLastIP), and therefore, the field is left unchanged in the database. Unlike ClearLastIP,
+// it does not set the field to NULL, and also removes a previous call to ClearLastIP.
+func (uu *UserUpdate) UnsetLastIP() *UserUpdate {
+	uu.mutation.ResetLastIP()
+	return uu
+}
+
+// SetAllowedNetwork sets the allowed_network field.
+func (uu *UserUpdate) SetAllowedNetwork(s sql.CIDR) *UserUpdate {
+	uu.mutation.SetAllowedNetwork(s)
+	return uu
+}
+
+// SetNillableAllowedNetwork sets the allowed_network field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAllowedNetwork(s *sql.CIDR) *UserUpdate {
+	if s != nil {
+		uu.SetAllowedNetwork(*s)
+	}
+	return uu
+}
+
+// ClearAllowedNetwork clears the value of allowed_network.
+func (uu *UserUpdate) ClearAllowedNetwork() *UserUpdate {
+	uu.mutation.ClearAllowedNetwork()
+	return uu
+}
+
+// UnsetAllowedNetwork removes the changes of the allowed_network field from the builder (e.g. a previous call
+// to SetAllowedNetwork), and therefore, the field is left unchanged in the database. Unlike ClearAllowedNetwork,
+// it does not set the field to NULL, and also removes a previous call to ClearAllowedNetwork.
+func (uu *UserUpdate) UnsetAllowedNetwork() *UserUpdate {
+	uu.mutation.ResetAllowedNetwork()
+	return uu
+}
+
+// SetSsn sets the ssn field.
+func (uu *UserUpdate) SetSsn(s string) *UserUpdate {
+	uu.mutation.SetSsn(s)
+	return uu
+}
+
+// SetNillableSsn sets the ssn field if the given value is not nil.
+func (uu *UserUpdate) SetNillableSsn(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetSsn(*s)
+	}
+	return uu
+}
+
+// ClearSsn clears the value of ssn.
+func (uu *UserUpdate) ClearSsn() *UserUpdate {
+	uu.mutation.ClearSsn()
+	return uu
+}
+
+// UnsetSsn removes the changes of the ssn field from the builder (e.g. a previous call
+// to SetSsn), and therefore, the field is left unchanged in the database. Unlike ClearSsn,
+// it does not set the field to NULL, and also removes a previous call to ClearSsn.
+func (uu *UserUpdate) UnsetSsn() *UserUpdate {
+	uu.mutation.ResetSsn()
+	return uu
+}
+
+// SetTaxID sets the tax_id field.
+func (uu *UserUpdate) SetTaxID(s string) *UserUpdate {
+	uu.mutation.SetTaxID(s)
+	return uu
+}
+
+// SetNillableTaxID sets the tax_id field if the given value is not nil.
+func (uu *UserUpdate) SetNillableTaxID(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetTaxID(*s)
+	}
+	return uu
+}
+
+// ClearTaxID clears the value of tax_id.
+func (uu *UserUpdate) ClearTaxID() *UserUpdate {
+	uu.mutation.ClearTaxID()
+	return uu
+}
+
+// UnsetTaxID removes the changes of the tax_id field from the builder (e.g. a previous call
+// to SetTaxID), and therefore, the field is left unchanged in the database. Unlike ClearTaxID,
+// it does not set the field to NULL, and also removes a previous call to ClearTaxID.
+func (uu *UserUpdate) UnsetTaxID() *UserUpdate {
+	uu.mutation.ResetTaxID()
+	return uu
+}
+
+// SetEmail sets the email field.
+func (uu *UserUpdate) SetEmail(s string) *UserUpdate {
+	uu.mutation.SetEmail(s)
+	return uu
+}
+
+// SetNillableEmail sets the email field if the given value is not nil.
+func (uu *UserUpdate) SetNillableEmail(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetEmail(*s)
+	}
+	return uu
+}
+
+// ClearEmail clears the value of email.
+func (uu *UserUpdate) ClearEmail() *UserUpdate {
+	uu.mutation.ClearEmail()
+	return uu
+}
+
+// UnsetEmail removes the changes of the email field from the builder (e.g. a previous call
+// to SetEmail), and therefore, the field is left unchanged in the database. Unlike ClearEmail,
+// it does not set the field to NULL, and also removes a previous call to ClearEmail.
+func (uu *UserUpdate) UnsetEmail() *UserUpdate {
+	uu.mutation.ResetEmail()
+	return uu
+}
+
+// SetAddressStreet sets the address_street field.
+func (uu *UserUpdate) SetAddressStreet(s string) *UserUpdate {
+	uu.mutation.SetAddressStreet(s)
+	return uu
+}
+
+// SetNillableAddressStreet sets the address_street field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAddressStreet(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetAddressStreet(*s)
+	}
+	return uu
+}
+
+// ClearAddressStreet clears the value of address_street.
+func (uu *UserUpdate) ClearAddressStreet() *UserUpdate {
+	uu.mutation.ClearAddressStreet()
+	return uu
+}
+
+// UnsetAddressStreet removes the changes of the address_street field from the builder (e.g. a previous call
+// to SetAddressStreet), and therefore, the field is left unchanged in the database. Unlike ClearAddressStreet,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressStreet.
+func (uu *UserUpdate) UnsetAddressStreet() *UserUpdate {
+	uu.mutation.ResetAddressStreet()
+	return uu
+}
+
+// SetAddressCity sets the address_city field.
+func (uu *UserUpdate) SetAddressCity(s string) *UserUpdate {
+	uu.mutation.SetAddressCity(s)
+	return uu
+}
+
+// SetNillableAddressCity sets the address_city field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAddressCity(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetAddressCity(*s)
+	}
+	return uu
+}
+
+// ClearAddressCity clears the value of address_city.
+func (uu *UserUpdate) ClearAddressCity() *UserUpdate {
+	uu.mutation.ClearAddressCity()
+	return uu
+}
+
+// UnsetAddressCity removes the changes of the address_city field from the builder (e.g. a previous call
+// to SetAddressCity), and therefore, the field is left unchanged in the database. Unlike ClearAddressCity,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressCity.
+func (uu *UserUpdate) UnsetAddressCity() *UserUpdate {
+	uu.mutation.ResetAddressCity()
+	return uu
+}
+
+// SetAddressZipCode sets the address_zip_code field.
+func (uu *UserUpdate) SetAddressZipCode(i int) *UserUpdate {
+	uu.mutation.ResetAddressZipCode()
+	uu.mutation.SetAddressZipCode(i)
+	return uu
+}
+
+// SetNillableAddressZipCode sets the address_zip_code field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAddressZipCode(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetAddressZipCode(*i)
+	}
+	return uu
+}
+
+// AddAddressZipCode adds i to address_zip_code.
+func (uu *UserUpdate) AddAddressZipCode(i int) *UserUpdate {
+	uu.mutation.AddAddressZipCode(i)
+	return uu
+}
+
+// ClearAddressZipCode clears the value of address_zip_code.
+func (uu *UserUpdate) ClearAddressZipCode() *UserUpdate {
+	uu.mutation.ClearAddressZipCode()
+	return uu
+}
+
+// UnsetAddressZipCode removes the changes of the address_zip_code field from the builder (e.g. a previous call
+// to SetAddressZipCode), and therefore, the field is left unchanged in the database. Unlike ClearAddressZipCode,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressZipCode.
+func (uu *UserUpdate) UnsetAddressZipCode() *UserUpdate {
+	uu.mutation.ResetAddressZipCode()
+	return uu
+}
+
+// SetAddressSince sets the address_since field.
+func (uu *UserUpdate) SetAddressSince(t time.Time) *UserUpdate {
+	uu.mutation.SetAddressSince(t)
+	return uu
+}
+
+// SetNillableAddressSince sets the address_since field if the given value is not nil.
+func (uu *UserUpdate) SetNillableAddressSince(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetAddressSince(*t)
+	}
+	return uu
+}
+
+// ClearAddressSince clears the value of address_since.
+func (uu *UserUpdate) ClearAddressSince() *UserUpdate {
+	uu.mutation.ClearAddressSince()
+	return uu
+}
+
+// UnsetAddressSince removes the changes of the address_since field from the builder (e.g. a previous call
+// to SetAddressSince), and therefore, the field is left unchanged in the database. Unlike ClearAddressSince,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressSince.
+func (uu *UserUpdate) UnsetAddressSince() *UserUpdate {
+	uu.mutation.ResetAddressSince()
+	return uu
+}
+
+// SetAddress sets the fields of the address embedded field.
+func (uu *UserUpdate) SetAddress(v schema.Address) *UserUpdate {
+	uu.SetAddressStreet(v.Street)
+	uu.SetAddressCity(v.City)
+	uu.SetAddressZipCode(v.ZipCode)
+	uu.SetAddressSince(v.Since)
+	return uu
+}
+
+// ClearAddress clears the fields of the address embedded field.
+func (uu *UserUpdate) ClearAddress() *UserUpdate {
+	uu.ClearAddressStreet()
+	uu.ClearAddressCity()
+	uu.ClearAddressZipCode()
+	uu.ClearAddressSince()
+	return uu
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (uu *UserUpdate) AddGroupIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddGroupIDs(ids...)
@@ -148,9 +438,33 @@ func (uu *UserUpdate) RemovePets(p ...*Pet) *UserUpdate {
 	return uu.RemovePetIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uu *UserUpdate) Unwrap() *UserUpdate {
+	tx, ok := uu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdate is not a transactional builder")
+	}
+	uu.config.driver = tx.drv
+	uu.mutation.driver = tx.drv
+	return uu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uu *UserUpdate) DebugFields() map[string]Value {
+	return debugFields(uu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(uu.driver); err != nil {
+		return 0, err
+	}
+	if err := uu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -199,7 +513,16 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if uu.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("ent: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = uu.withOperation(ctx, "User", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -217,6 +540,145 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := uu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if uu.ids != nil {
+		_spec.ScanIDs = uu.ids
+	}
+	if value, ok := uu.mutation.LastIP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldLastIP,
+		})
+	}
+	if uu.mutation.LastIPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Column: user.FieldLastIP,
+		})
+	}
+	if value, ok := uu.mutation.AllowedNetwork(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldAllowedNetwork,
+		})
+	}
+	if uu.mutation.AllowedNetworkCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Column: user.FieldAllowedNetwork,
+		})
+	}
+	if value, ok := uu.mutation.Ssn(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.SsnCipher, value),
+			Column: user.FieldSsn,
+		})
+	}
+	if uu.mutation.SsnCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldSsn,
+		})
+	}
+	if value, ok := uu.mutation.TaxID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.TaxIDCipher, value),
+			Column: user.FieldTaxID,
+		})
+	}
+	if uu.mutation.TaxIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldTaxID,
+		})
+	}
+	if value, ok := uu.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.EmailCipher, value),
+			Column: user.FieldEmail,
+		})
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.BlindIndexValue(user.EmailBlindIndex, value),
+			Column: user.FieldEmailBlindIndex,
+		})
+	}
+	if uu.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldEmail,
+		})
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldEmailBlindIndex,
+		})
+	}
+	if value, ok := uu.mutation.AddressStreet(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressStreet,
+		})
+	}
+	if uu.mutation.AddressStreetCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldAddressStreet,
+		})
+	}
+	if value, ok := uu.mutation.AddressCity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressCity,
+		})
+	}
+	if uu.mutation.AddressCityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldAddressCity,
+		})
+	}
+	if value, ok := uu.mutation.AddressZipCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAddressZipCode,
+		})
+	}
+	if value, ok := uu.mutation.AddedAddressZipCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAddressZipCode,
+		})
+	}
+	if uu.mutation.AddressZipCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: user.FieldAddressZipCode,
+		})
+	}
+	if value, ok := uu.mutation.AddressSince(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: user.FieldAddressSince,
+		})
+	}
+	if uu.mutation.AddressSinceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: user.FieldAddressSince,
+		})
+	}
 	if nodes := uu.mutation.RemovedGroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -366,7 +828,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -377,11 +839,310 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the User entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (uu *UserUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	uu.ids = &ids
+	defer func() { uu.ids = nil }()
+	if _, err := uu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks      []Hook
+	mutation   *UserMutation
+	predicates []predicate.User
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.predicates = append(uuo.predicates, ps...)
+	return uuo
+}
+
+// SetLastIP sets the last_ip field.
+func (uuo *UserUpdateOne) SetLastIP(s sql.IP) *UserUpdateOne {
+	uuo.mutation.SetLastIP(s)
+	return uuo
+}
+
+// SetNillableLastIP sets the last_ip field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLastIP(s *sql.IP) *UserUpdateOne {
+	if s != nil {
+		uuo.SetLastIP(*s)
+	}
+	return uuo
+}
+
+// ClearLastIP clears the value of last_ip.
+func (uuo *UserUpdateOne) ClearLastIP() *UserUpdateOne {
+	uuo.mutation.ClearLastIP()
+	return uuo
+}
+
+// UnsetLastIP removes the changes of the last_ip field from the builder (e.g. a previous call
+// to SetLastIP), and therefore, the field is left unchanged in the database. Unlike ClearLastIP,
+// it does not set the field to NULL, and also removes a previous call to ClearLastIP.
+func (uuo *UserUpdateOne) UnsetLastIP() *UserUpdateOne {
+	uuo.mutation.ResetLastIP()
+	return uuo
+}
+
+// SetAllowedNetwork sets the allowed_network field.
+func (uuo *UserUpdateOne) SetAllowedNetwork(s sql.CIDR) *UserUpdateOne {
+	uuo.mutation.SetAllowedNetwork(s)
+	return uuo
+}
+
+// SetNillableAllowedNetwork sets the allowed_network field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAllowedNetwork(s *sql.CIDR) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAllowedNetwork(*s)
+	}
+	return uuo
+}
+
+// ClearAllowedNetwork clears the value of allowed_network.
+func (uuo *UserUpdateOne) ClearAllowedNetwork() *UserUpdateOne {
+	uuo.mutation.ClearAllowedNetwork()
+	return uuo
+}
+
+// UnsetAllowedNetwork removes the changes of the allowed_network field from the builder (e.g. a previous call
+// to SetAllowedNetwork), and therefore, the field is left unchanged in the database. Unlike ClearAllowedNetwork,
+// it does not set the field to NULL, and also removes a previous call to ClearAllowedNetwork.
+func (uuo *UserUpdateOne) UnsetAllowedNetwork() *UserUpdateOne {
+	uuo.mutation.ResetAllowedNetwork()
+	return uuo
+}
+
+// SetSsn sets the ssn field.
+func (uuo *UserUpdateOne) SetSsn(s string) *UserUpdateOne {
+	uuo.mutation.SetSsn(s)
+	return uuo
+}
+
+// SetNillableSsn sets the ssn field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableSsn(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetSsn(*s)
+	}
+	return uuo
+}
+
+// ClearSsn clears the value of ssn.
+func (uuo *UserUpdateOne) ClearSsn() *UserUpdateOne {
+	uuo.mutation.ClearSsn()
+	return uuo
+}
+
+// UnsetSsn removes the changes of the ssn field from the builder (e.g. a previous call
+// to SetSsn), and therefore, the field is left unchanged in the database. Unlike ClearSsn,
+// it does not set the field to NULL, and also removes a previous call to ClearSsn.
+func (uuo *UserUpdateOne) UnsetSsn() *UserUpdateOne {
+	uuo.mutation.ResetSsn()
+	return uuo
+}
+
+// SetTaxID sets the tax_id field.
+func (uuo *UserUpdateOne) SetTaxID(s string) *UserUpdateOne {
+	uuo.mutation.SetTaxID(s)
+	return uuo
+}
+
+// SetNillableTaxID sets the tax_id field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableTaxID(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetTaxID(*s)
+	}
+	return uuo
+}
+
+// ClearTaxID clears the value of tax_id.
+func (uuo *UserUpdateOne) ClearTaxID() *UserUpdateOne {
+	uuo.mutation.ClearTaxID()
+	return uuo
+}
+
+// UnsetTaxID removes the changes of the tax_id field from the builder (e.g. a previous call
+// to SetTaxID), and therefore, the field is left unchanged in the database. Unlike ClearTaxID,
+// it does not set the field to NULL, and also removes a previous call to ClearTaxID.
+func (uuo *UserUpdateOne) UnsetTaxID() *UserUpdateOne {
+	uuo.mutation.ResetTaxID()
+	return uuo
+}
+
+// SetEmail sets the email field.
+func (uuo *UserUpdateOne) SetEmail(s string) *UserUpdateOne {
+	uuo.mutation.SetEmail(s)
+	return uuo
+}
+
+// SetNillableEmail sets the email field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableEmail(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetEmail(*s)
+	}
+	return uuo
+}
+
+// ClearEmail clears the value of email.
+func (uuo *UserUpdateOne) ClearEmail() *UserUpdateOne {
+	uuo.mutation.ClearEmail()
+	return uuo
+}
+
+// UnsetEmail removes the changes of the email field from the builder (e.g. a previous call
+// to SetEmail), and therefore, the field is left unchanged in the database. Unlike ClearEmail,
+// it does not set the field to NULL, and also removes a previous call to ClearEmail.
+func (uuo *UserUpdateOne) UnsetEmail() *UserUpdateOne {
+	uuo.mutation.ResetEmail()
+	return uuo
+}
+
+// SetAddressStreet sets the address_street field.
+func (uuo *UserUpdateOne) SetAddressStreet(s string) *UserUpdateOne {
+	uuo.mutation.SetAddressStreet(s)
+	return uuo
+}
+
+// SetNillableAddressStreet sets the address_street field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAddressStreet(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAddressStreet(*s)
+	}
+	return uuo
+}
+
+// ClearAddressStreet clears the value of address_street.
+func (uuo *UserUpdateOne) ClearAddressStreet() *UserUpdateOne {
+	uuo.mutation.ClearAddressStreet()
+	return uuo
+}
+
+// UnsetAddressStreet removes the changes of the address_street field from the builder (e.g. a previous call
+// to SetAddressStreet), and therefore, the field is left unchanged in the database. Unlike ClearAddressStreet,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressStreet.
+func (uuo *UserUpdateOne) UnsetAddressStreet() *UserUpdateOne {
+	uuo.mutation.ResetAddressStreet()
+	return uuo
+}
+
+// SetAddressCity sets the address_city field.
+func (uuo *UserUpdateOne) SetAddressCity(s string) *UserUpdateOne {
+	uuo.mutation.SetAddressCity(s)
+	return uuo
+}
+
+// SetNillableAddressCity sets the address_city field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAddressCity(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetAddressCity(*s)
+	}
+	return uuo
+}
+
+// ClearAddressCity clears the value of address_city.
+func (uuo *UserUpdateOne) ClearAddressCity() *UserUpdateOne {
+	uuo.mutation.ClearAddressCity()
+	return uuo
+}
+
+// UnsetAddressCity removes the changes of the address_city field from the builder (e.g. a previous call
+// to SetAddressCity), and therefore, the field is left unchanged in the database. Unlike ClearAddressCity,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressCity.
+func (uuo *UserUpdateOne) UnsetAddressCity() *UserUpdateOne {
+	uuo.mutation.ResetAddressCity()
+	return uuo
+}
+
+// SetAddressZipCode sets the address_zip_code field.
+func (uuo *UserUpdateOne) SetAddressZipCode(i int) *UserUpdateOne {
+	uuo.mutation.ResetAddressZipCode()
+	uuo.mutation.SetAddressZipCode(i)
+	return uuo
+}
+
+// SetNillableAddressZipCode sets the address_zip_code field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAddressZipCode(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetAddressZipCode(*i)
+	}
+	return uuo
+}
+
+// AddAddressZipCode adds i to address_zip_code.
+func (uuo *UserUpdateOne) AddAddressZipCode(i int) *UserUpdateOne {
+	uuo.mutation.AddAddressZipCode(i)
+	return uuo
+}
+
+// ClearAddressZipCode clears the value of address_zip_code.
+func (uuo *UserUpdateOne) ClearAddressZipCode() *UserUpdateOne {
+	uuo.mutation.ClearAddressZipCode()
+	return uuo
+}
+
+// UnsetAddressZipCode removes the changes of the address_zip_code field from the builder (e.g. a previous call
+// to SetAddressZipCode), and therefore, the field is left unchanged in the database. Unlike ClearAddressZipCode,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressZipCode.
+func (uuo *UserUpdateOne) UnsetAddressZipCode() *UserUpdateOne {
+	uuo.mutation.ResetAddressZipCode()
+	return uuo
+}
+
+// SetAddressSince sets the address_since field.
+func (uuo *UserUpdateOne) SetAddressSince(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetAddressSince(t)
+	return uuo
+}
+
+// SetNillableAddressSince sets the address_since field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableAddressSince(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetAddressSince(*t)
+	}
+	return uuo
+}
+
+// ClearAddressSince clears the value of address_since.
+func (uuo *UserUpdateOne) ClearAddressSince() *UserUpdateOne {
+	uuo.mutation.ClearAddressSince()
+	return uuo
+}
+
+// UnsetAddressSince removes the changes of the address_since field from the builder (e.g. a previous call
+// to SetAddressSince), and therefore, the field is left unchanged in the database. Unlike ClearAddressSince,
+// it does not set the field to NULL, and also removes a previous call to ClearAddressSince.
+func (uuo *UserUpdateOne) UnsetAddressSince() *UserUpdateOne {
+	uuo.mutation.ResetAddressSince()
+	return uuo
+}
+
+// SetAddress sets the fields of the address embedded field.
+func (uuo *UserUpdateOne) SetAddress(v schema.Address) *UserUpdateOne {
+	uuo.SetAddressStreet(v.Street)
+	uuo.SetAddressCity(v.City)
+	uuo.SetAddressZipCode(v.ZipCode)
+	uuo.SetAddressSince(v.Since)
+	return uuo
+}
+
+// ClearAddress clears the fields of the address embedded field.
+func (uuo *UserUpdateOne) ClearAddress() *UserUpdateOne {
+	uuo.ClearAddressStreet()
+	uuo.ClearAddressCity()
+	uuo.ClearAddressZipCode()
+	uuo.ClearAddressSince()
+	return uuo
 }
 
 // AddGroupIDs adds the groups edge to Group by ids.
@@ -499,9 +1260,33 @@ func (uuo *UserUpdateOne) RemovePets(p ...*Pet) *UserUpdateOne {
 	return uuo.RemovePetIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uuo *UserUpdateOne) Unwrap() *UserUpdateOne {
+	tx, ok := uuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdateOne is not a transactional builder")
+	}
+	uuo.config.driver = tx.drv
+	uuo.mutation.driver = tx.drv
+	return uuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uuo *UserUpdateOne) DebugFields() map[string]Value {
+	return debugFields(uuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-
+	if err := checkTx(uuo.driver); err != nil {
+		return nil, err
+	}
+	if err := uuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *User
@@ -550,7 +1335,16 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if uuo.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("ent: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
+	ctx = uuo.withOperation(ctx, "User", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -566,6 +1360,146 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		return nil, fmt.Errorf("missing User.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := uuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.LastIP(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldLastIP,
+		})
+	}
+	if uuo.mutation.LastIPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Column: user.FieldLastIP,
+		})
+	}
+	if value, ok := uuo.mutation.AllowedNetwork(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Value:  value,
+			Column: user.FieldAllowedNetwork,
+		})
+	}
+	if uuo.mutation.AllowedNetworkCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeOther,
+			Column: user.FieldAllowedNetwork,
+		})
+	}
+	if value, ok := uuo.mutation.Ssn(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.SsnCipher, value),
+			Column: user.FieldSsn,
+		})
+	}
+	if uuo.mutation.SsnCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldSsn,
+		})
+	}
+	if value, ok := uuo.mutation.TaxID(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.TaxIDCipher, value),
+			Column: user.FieldTaxID,
+		})
+	}
+	if uuo.mutation.TaxIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldTaxID,
+		})
+	}
+	if value, ok := uuo.mutation.Email(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.EncryptValue(user.EmailCipher, value),
+			Column: user.FieldEmail,
+		})
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  sql.BlindIndexValue(user.EmailBlindIndex, value),
+			Column: user.FieldEmailBlindIndex,
+		})
+	}
+	if uuo.mutation.EmailCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldEmail,
+		})
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldEmailBlindIndex,
+		})
+	}
+	if value, ok := uuo.mutation.AddressStreet(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressStreet,
+		})
+	}
+	if uuo.mutation.AddressStreetCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldAddressStreet,
+		})
+	}
+	if value, ok := uuo.mutation.AddressCity(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: user.FieldAddressCity,
+		})
+	}
+	if uuo.mutation.AddressCityCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: user.FieldAddressCity,
+		})
+	}
+	if value, ok := uuo.mutation.AddressZipCode(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAddressZipCode,
+		})
+	}
+	if value, ok := uuo.mutation.AddedAddressZipCode(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: user.FieldAddressZipCode,
+		})
+	}
+	if uuo.mutation.AddressZipCodeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: user.FieldAddressZipCode,
+		})
+	}
+	if value, ok := uuo.mutation.AddressSince(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: user.FieldAddressSince,
+		})
+	}
+	if uuo.mutation.AddressSinceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: user.FieldAddressSince,
+		})
+	}
 	if nodes := uuo.mutation.RemovedGroupsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -716,9 +1650,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	u = &User{config: uuo.config}
-	_spec.Assign = u.assignValues
-	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return u.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = u.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, uuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -726,5 +1662,37 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		u.ID = id
+		if value, ok := uuo.mutation.LastIP(); ok {
+			u.LastIP = value
+		}
+		if value, ok := uuo.mutation.AllowedNetwork(); ok {
+			u.AllowedNetwork = value
+		}
+		if value, ok := uuo.mutation.Ssn(); ok {
+			u.Ssn = value
+		}
+		if value, ok := uuo.mutation.TaxID(); ok {
+			u.TaxID = value
+		}
+		if value, ok := uuo.mutation.Email(); ok {
+			u.Email = value
+		}
+		if value, ok := uuo.mutation.AddressStreet(); ok {
+			u.Address.Street = value
+		}
+		if value, ok := uuo.mutation.AddressCity(); ok {
+			u.Address.City = value
+		}
+		if value, ok := uuo.mutation.AddressZipCode(); ok {
+			u.Address.ZipCode = value
+		}
+		if value, ok := uuo.mutation.AddressSince(); ok {
+			u.Address.Since = value
+		}
+	}
 	return u, nil
 }
@@ -7,12 +7,20 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/entc/integration/ent/spec"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
 )
 
@@ -29,10 +37,19 @@ type Card struct {
 	Number string `json:"number,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// Type holds the value of the "type" field.
+	Type schema.CardType `json:"type,omitempty"`
+	// NumberHash holds the value of the "number_hash" field.
+	NumberHash string `json:"number_hash,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CardQuery when eager-loading is set.
 	Edges     CardEdges `json:"edges"`
 	user_card *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 
 	// StaticField defined by templates.
 	StaticField string `json:"boring,omitempty"`
@@ -72,68 +89,117 @@ func (e CardEdges) SpecOrErr() ([]*Spec, error) {
 	return nil, &NotLoadedError{edge: "spec"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Card) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullTime{},   // create_time
-		&sql.NullTime{},   // update_time
-		&sql.NullString{}, // number
-		&sql.NullString{}, // name
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Card) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // user_card
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Card type are scanned as generic values.
+func (*Card) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case card.FieldID:
+			values[i] = &sql.NullInt64{}
+		case card.FieldCreateTime:
+			values[i] = &sql.NullTime{}
+		case card.FieldUpdateTime:
+			values[i] = &sql.NullTime{}
+		case card.FieldNumber:
+			values[i] = &sql.NullString{}
+		case card.FieldName:
+			values[i] = &sql.NullString{}
+		case card.FieldExpiresAt:
+			values[i] = &sql.NullTime{}
+		case card.FieldType:
+			values[i] = &sql.NullString{}
+		case card.FieldNumberHash:
+			values[i] = &sql.NullString{}
+		case card.ForeignKeys[0]: // user_card
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Card fields.
-func (c *Card) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(card.Columns); m < n {
+// to the Card fields. Values of columns that were not selected are left untouched.
+func (c *Card) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	c.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullTime); !ok {
-		return fmt.Errorf("unexpected type %T for field create_time", values[0])
-	} else if value.Valid {
-		c.CreateTime = value.Time
-	}
-	if value, ok := values[1].(*sql.NullTime); !ok {
-		return fmt.Errorf("unexpected type %T for field update_time", values[1])
-	} else if value.Valid {
-		c.UpdateTime = value.Time
-	}
-	if value, ok := values[2].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field number", values[2])
-	} else if value.Valid {
-		c.Number = value.String
-	}
-	if value, ok := values[3].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[3])
-	} else if value.Valid {
-		c.Name = value.String
-	}
-	values = values[4:]
-	if len(values) == len(card.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_card", value)
-		} else if value.Valid {
-			c.user_card = new(int)
-			*c.user_card = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case card.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case card.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				c.CreateTime = value.Time
+			}
+		case card.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				c.UpdateTime = value.Time
+			}
+		case card.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				c.Number = value.String
+			}
+		case card.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case card.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				c.ExpiresAt = new(time.Time)
+				*c.ExpiresAt = value.Time.In(card.ExpiresAtLocation)
+			}
+		case card.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				c.Type = schema.CardType(value.String)
+			}
+		case card.FieldNumberHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number_hash", values[i])
+			} else if value.Valid {
+				c.NumberHash = value.String
+			}
+		case card.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_card", value)
+			} else if value.Valid {
+				c.user_card = new(int)
+				*c.user_card = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (c *Card) SelectValue(alias string) (Value, error) {
+	v, ok := c.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryOwner queries the owner edge of the Card.
 func (c *Card) QueryOwner() *UserQuery {
 	return (&CardClient{config: c.config}).QueryOwner(c)
@@ -144,6 +210,204 @@ func (c *Card) QuerySpec() *SpecQuery {
 	return (&CardClient{config: c.config}).QuerySpec(c)
 }
 
+// LoadEdges loads the given edges of the Card using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see card.Edges), and in this case, no edge is loaded.
+//
+//	err := c.LoadEdges(ctx, client, card.EdgeOwner, card.EdgeSpec)
+//
+func (c *Card) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadCardEdges(ctx, client, []*Card{c}, edges)
+}
+
+// loadCardEdges loads the given edges of all the given Card entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using CardClient.LoadEdgeFor.
+func loadCardEdges(ctx context.Context, client *Client, nodes []*Card, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case card.EdgeOwner, card.EdgeSpec:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Card", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Card.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Card and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Card alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Card itself is not modified.
+//
+//	b, err := c.DumpGraph(ctx, client, 2, card.EdgeOwner)
+//
+func (c *Card) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Card DumpGraph", depth)
+	}
+	dumped := *c
+	dumped.Edges = CardEdges{}
+	if err := dumpCards(ctx, client, []*Card{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpCards loads the edges of the given Card copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpCards(ctx context.Context, client *Client, nodes []*Card, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Card
+	for _, n := range nodes {
+		if dumper.visit(card.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range card.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadCardEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextOwner []*User
+	for _, n := range visit {
+		if e := n.Edges.Owner; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Owner = &c
+			nextOwner = append(nextOwner, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextOwner, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextSpec []*Spec
+	for _, n := range visit {
+		for i, e := range n.Edges.Spec {
+			c := *e
+			c.Edges = SpecEdges{}
+			n.Edges.Spec[i] = &c
+			nextSpec = append(nextSpec, &c)
+		}
+	}
+	if err := dumpSpecs(ctx, client, nextSpec, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddSpecByField adds the "spec" edges of the Card to the Spec entities that are resolved by the
+// given values of one of their unique fields (e.g. spec.FieldName). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := c.AddSpecByField(ctx, client, spec.FieldName, v1, v2)
+//
+func (c *Card) AddSpecByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := c.addSpecByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddSpecByFieldOrCreate is like AddSpecByField, but creates the Spec entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (c *Card) AddSpecByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*Spec, error) {
+	return c.addSpecByField(ctx, client, true, field, values)
+}
+
+func (c *Card) addSpecByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*Spec, error) {
+	var (
+		where predicate.Spec
+		key   func(*Spec) interface{}
+	)
+	switch field {
+	case spec.FieldName:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field name", v)
+			}
+			vs[i] = tv
+		}
+		where = spec.NameIn(vs...)
+		key = func(n *Spec) interface{} {
+			return n.Name
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type Spec", field)
+	}
+	nodes, err := client.Spec.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*SpecCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{spec.Label}
+		}
+		builder := client.Spec.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*Spec
+	if len(builders) > 0 {
+		if created, err = client.Spec.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ids := make([]int, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.Card.QuerySpec(c).Where(spec.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[int]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.Card.UpdateOneID(c.ID).AddSpecIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
 // Update returns a builder for updating this Card.
 // Note that, you need to call Card.Unwrap() before calling this method, if this Card
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -162,6 +426,37 @@ func (c *Card) Unwrap() *Card {
 	return c
 }
 
+// Clone returns a deep copy of the Card and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (c *Card) Clone() *Card {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Card and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (c *Card) clone(seen map[interface{}]interface{}) *Card {
+	if c == nil {
+		return nil
+	}
+	if v, ok := seen[c]; ok {
+		return v.(*Card)
+	}
+	_c := *c
+	seen[c] = &_c
+	if v := c.ExpiresAt; v != nil {
+		_v := *v
+		_c.ExpiresAt = &_v
+	}
+	_c.Edges.Owner = c.Edges.Owner.clone(seen)
+	if c.Edges.Spec != nil {
+		_c.Edges.Spec = make([]*Spec, len(c.Edges.Spec))
+		for _i := range c.Edges.Spec {
+			_c.Edges.Spec[_i] = c.Edges.Spec[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Card) String() string {
 	var builder strings.Builder
@@ -175,10 +470,54 @@ func (c *Card) String() string {
 	builder.WriteString(c.Number)
 	builder.WriteString(", name=")
 	builder.WriteString(c.Name)
+	if v := c.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", type=")
+	builder.WriteString(fmt.Sprintf("%v", c.Type))
+	builder.WriteString(", number_hash=")
+	builder.WriteString(c.NumberHash)
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Card, for change detection and cache keys.
+// It covers the fields that are listed in card.FingerprintFields, and it does not depend on the
+// ID of the Card, on its edges, or on the order of the fields in the schema.
+func (c *Card) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", c.Name)
+	fingerprint(h, "number", c.Number)
+	fingerprint(h, "type", c.Type)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Card for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Card can be decoded using the FromMap method of its client.
+func (c *Card) ToMap() map[string]string {
+	m := make(map[string]string, 8)
+	m[card.FieldID] = kvEncode(c.ID)
+	m[card.FieldCreateTime] = kvEncode(c.CreateTime)
+	m[card.FieldUpdateTime] = kvEncode(c.UpdateTime)
+	m[card.FieldNumber] = kvEncode(c.Number)
+	if v := c.Name; !reflect.ValueOf(v).IsZero() {
+		m[card.FieldName] = kvEncode(v)
+	}
+	if v := c.ExpiresAt; v != nil {
+		m[card.FieldExpiresAt] = kvEncode(*v)
+	}
+	if v := c.Type; !reflect.ValueOf(v).IsZero() {
+		m[card.FieldType] = kvEncode(v)
+	}
+	if v := c.NumberHash; !reflect.ValueOf(v).IsZero() {
+		m[card.FieldNumberHash] = kvEncode(v)
+	}
+	return m
+}
+
 // Cards is a parsable slice of Card.
 type Cards []*Card
 
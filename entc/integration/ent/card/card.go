@@ -7,7 +7,11 @@
 package card
 
 import (
+	"fmt"
 	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 )
 
 const (
@@ -18,7 +22,10 @@ const (
 	FieldCreateTime = "create_time" // FieldUpdateTime holds the string denoting the update_time vertex property in the database.
 	FieldUpdateTime = "update_time" // FieldNumber holds the string denoting the number vertex property in the database.
 	FieldNumber     = "number"      // FieldName holds the string denoting the name vertex property in the database.
-	FieldName       = "name"
+	FieldName       = "name"        // FieldExpiresAt holds the string denoting the expires_at vertex property in the database.
+	FieldExpiresAt  = "expires_at"  // FieldType holds the string denoting the type vertex property in the database.
+	FieldType       = "type"        // FieldNumberHash holds the string denoting the number_hash vertex property in the database.
+	FieldNumberHash = "number_hash"
 
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
@@ -41,6 +48,20 @@ const (
 	SpecInverseTable = "specs"
 )
 
+// Edges holds all the edge names of the Card type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+	EdgeSpec,
+}
+
+// FingerprintFields holds the fields of the Card type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+	FieldNumber,
+	FieldType,
+}
+
 // Columns holds all SQL columns for card fields.
 var Columns = []string{
 	FieldID,
@@ -48,6 +69,9 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldNumber,
 	FieldName,
+	FieldExpiresAt,
+	FieldType,
+	FieldNumberHash,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the Card type.
@@ -55,12 +79,40 @@ var ForeignKeys = []string{
 	"user_card",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// SpecPrimaryKey and SpecColumn2 are the table columns denoting the
 	// primary key for the spec relation (M2M).
 	SpecPrimaryKey = []string{"spec_id", "card_id"}
 )
 
+// CreateTimeTrunc returns a column that truncates the create_time field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "create_time_<bucket>".
+func CreateTimeTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldCreateTime)
+}
+
+// UpdateTimeTrunc returns a column that truncates the update_time field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "update_time_<bucket>".
+func UpdateTimeTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldUpdateTime)
+}
+
+// ExpiresAtTrunc returns a column that truncates the expires_at field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "expires_at_<bucket>".
+func ExpiresAtTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldExpiresAt)
+}
+
 var (
 	// DefaultCreateTime holds the default value on creation for the create_time field.
 	DefaultCreateTime func() time.Time
@@ -72,4 +124,23 @@ var (
 	NumberValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// ExpiresAtLocation holds the location of the time values of the expires_at field.
+	ExpiresAtLocation *time.Location
 )
+
+// schema.CardType values.
+const (
+	TypeVisa schema.CardType = "visa"
+	TypeMc   schema.CardType = "mc"
+	TypeAmex schema.CardType = "amex"
+)
+
+// TypeValidator is a validator for the "_type" field enum values. It is called by the builders before save.
+func TypeValidator(_type schema.CardType) error {
+	switch _type {
+	case TypeVisa, TypeMc, TypeAmex:
+		return nil
+	default:
+		return fmt.Errorf("card: invalid enum value for type field: %q", _type)
+	}
+}
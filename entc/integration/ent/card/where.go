@@ -7,11 +7,13 @@
 package card
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 )
 
 // ID filters vertices based on their identifier.
@@ -125,6 +127,20 @@ func Name(v string) predicate.Card {
 	})
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// NumberHash applies equality check predicate on the "number_hash" field. It's identical to NumberHashEQ.
+func NumberHash(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumberHash), v))
+	})
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -360,6 +376,13 @@ func NumberContains(v string) predicate.Card {
 	})
 }
 
+// NumberNotContains applies the NotContains predicate on the "number" field.
+func NumberNotContains(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNumber), v))
+	})
+}
+
 // NumberHasPrefix applies the HasPrefix predicate on the "number" field.
 func NumberHasPrefix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -367,6 +390,13 @@ func NumberHasPrefix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasPrefix applies the NotHasPrefix predicate on the "number" field.
+func NumberNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNumber), v))
+	})
+}
+
 // NumberHasSuffix applies the HasSuffix predicate on the "number" field.
 func NumberHasSuffix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -374,6 +404,13 @@ func NumberHasSuffix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasSuffix applies the NotHasSuffix predicate on the "number" field.
+func NumberNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNumber), v))
+	})
+}
+
 // NumberEqualFold applies the EqualFold predicate on the "number" field.
 func NumberEqualFold(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -388,6 +425,20 @@ func NumberContainsFold(v string) predicate.Card {
 	})
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasSuffixFold applies the HasSuffixFold predicate on the "number" field.
+func NumberHasSuffixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNumber), v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -471,6 +522,13 @@ func NameContains(v string) predicate.Card {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -478,6 +536,13 @@ func NameHasPrefix(v string) predicate.Card {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -485,6 +550,13 @@ func NameHasSuffix(v string) predicate.Card {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameIsNil applies the IsNil predicate on the "name" field.
 func NameIsNil() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -513,6 +585,446 @@ func NameContainsFold(v string) predicate.Card {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldExpiresAt), v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldExpiresAt), v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldExpiresAt)))
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v schema.CardType) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldType), v))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v schema.CardType) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldType), v))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...schema.CardType) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldType), v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...schema.CardType) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldType), v...))
+	})
+}
+
+// TypeIsNil applies the IsNil predicate on the "type" field.
+func TypeIsNil() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldType)))
+	})
+}
+
+// TypeNotNil applies the NotNil predicate on the "type" field.
+func TypeNotNil() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldType)))
+	})
+}
+
+// NumberHashEQ applies the EQ predicate on the "number_hash" field.
+func NumberHashEQ(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashNEQ applies the NEQ predicate on the "number_hash" field.
+func NumberHashNEQ(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashIn applies the In predicate on the "number_hash" field.
+func NumberHashIn(vs ...string) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldNumberHash), v...))
+	})
+}
+
+// NumberHashNotIn applies the NotIn predicate on the "number_hash" field.
+func NumberHashNotIn(vs ...string) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldNumberHash), v...))
+	})
+}
+
+// NumberHashGT applies the GT predicate on the "number_hash" field.
+func NumberHashGT(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashGTE applies the GTE predicate on the "number_hash" field.
+func NumberHashGTE(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashLT applies the LT predicate on the "number_hash" field.
+func NumberHashLT(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashLTE applies the LTE predicate on the "number_hash" field.
+func NumberHashLTE(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashContains applies the Contains predicate on the "number_hash" field.
+func NumberHashContains(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashNotContains applies the NotContains predicate on the "number_hash" field.
+func NumberHashNotContains(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashHasPrefix applies the HasPrefix predicate on the "number_hash" field.
+func NumberHashHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashNotHasPrefix applies the NotHasPrefix predicate on the "number_hash" field.
+func NumberHashNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashHasSuffix applies the HasSuffix predicate on the "number_hash" field.
+func NumberHashHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashNotHasSuffix applies the NotHasSuffix predicate on the "number_hash" field.
+func NumberHashNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashIsNil applies the IsNil predicate on the "number_hash" field.
+func NumberHashIsNil() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldNumberHash)))
+	})
+}
+
+// NumberHashNotNil applies the NotNil predicate on the "number_hash" field.
+func NumberHashNotNil() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldNumberHash)))
+	})
+}
+
+// NumberHashEqualFold applies the EqualFold predicate on the "number_hash" field.
+func NumberHashEqualFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashContainsFold applies the ContainsFold predicate on the "number_hash" field.
+func NumberHashContainsFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashHasPrefixFold applies the HasPrefixFold predicate on the "number_hash" field.
+func NumberHashHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNumberHash), v))
+	})
+}
+
+// NumberHashHasSuffixFold applies the HasSuffixFold predicate on the "number_hash" field.
+func NumberHashHasSuffixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNumberHash), v))
+	})
+}
+
+// NameEQNullSafe applies a NULL-safe equality predicate on the "name" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NameEQNullSafe(v *string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldName), arg))
+	})
+}
+
+// ExpiresAtEQNullSafe applies a NULL-safe equality predicate on the "expires_at" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func ExpiresAtEQNullSafe(v *time.Time) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldExpiresAt), arg))
+	})
+}
+
+// TypeEQNullSafe applies a NULL-safe equality predicate on the "type" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func TypeEQNullSafe(v *schema.CardType) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldType), arg))
+	})
+}
+
+// NumberHashEQNullSafe applies a NULL-safe equality predicate on the "number_hash" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NumberHashEQNullSafe(v *string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNumberHash), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldCreateTime: "time",
+	FieldExpiresAt:  "time",
+	FieldID:         "numeric",
+	FieldName:       "string",
+	FieldNumber:     "string",
+	FieldNumberHash: "string",
+	FieldType:       "string",
+	FieldUpdateTime: "time",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Card.
+func FieldsEQ(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Card.
+func FieldsNEQ(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Card.
+//
+//	card.FieldsLT(card.FieldCreateTime, card.FieldUpdateTime)
+//
+func FieldsLT(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Card.
+func FieldsLTE(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Card.
+func FieldsGT(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Card.
+func FieldsGTE(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Card using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Card", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Card", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Card are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -541,6 +1053,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.Card {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	card.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_card IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_card)
+//
+func OwnerMissing() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasSpec applies the HasEdge predicate on the "spec" edge.
 func HasSpec() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -576,6 +1127,9 @@ func And(predicates ...predicate.Card) predicate.Card {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -590,6 +1144,9 @@ func Or(predicates ...predicate.Card) predicate.Card {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -600,3 +1157,88 @@ func Not(p predicate.Card) predicate.Card {
 		p(s.Not())
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// CardFilter holds optional filters on the Card fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CardFilter struct {
+	IDIn       []int
+	CreateTime *TimeRange
+	UpdateTime *TimeRange
+	Number     *string
+	Name       *string
+	ExpiresAt  *TimeRange
+	Type       *schema.CardType
+	NumberHash *string
+	OwnerIDIn  []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Card.Query().
+//		Where(card.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CardFilter) []predicate.Card {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Card
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if r := f.CreateTime; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, CreateTimeGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, CreateTimeLT(r.To))
+		}
+	}
+	if r := f.UpdateTime; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, UpdateTimeGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, UpdateTimeLT(r.To))
+		}
+	}
+	if f.Number != nil {
+		ps = append(ps, NumberEQ(*f.Number))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if r := f.ExpiresAt; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, ExpiresAtGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, ExpiresAtLT(r.To))
+		}
+	}
+	if f.Type != nil {
+		ps = append(ps, TypeEQ(*f.Type))
+	}
+	if f.NumberHash != nil {
+		ps = append(ps, NumberHashEQ(*f.NumberHash))
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Card(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -10,10 +10,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/ent/spec"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
 	"github.com/facebookincubator/ent/schema/field"
@@ -74,6 +78,48 @@ func (cc *CardCreate) SetNillableName(s *string) *CardCreate {
 	return cc
 }
 
+// SetExpiresAt sets the expires_at field.
+func (cc *CardCreate) SetExpiresAt(t time.Time) *CardCreate {
+	cc.mutation.SetExpiresAt(t)
+	return cc
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (cc *CardCreate) SetNillableExpiresAt(t *time.Time) *CardCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetType sets the type field.
+func (cc *CardCreate) SetType(st schema.CardType) *CardCreate {
+	cc.mutation.SetType(st)
+	return cc
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (cc *CardCreate) SetNillableType(st *schema.CardType) *CardCreate {
+	if st != nil {
+		cc.SetType(*st)
+	}
+	return cc
+}
+
+// SetNumberHash sets the number_hash field.
+func (cc *CardCreate) SetNumberHash(s string) *CardCreate {
+	cc.mutation.SetNumberHash(s)
+	return cc
+}
+
+// SetNillableNumberHash sets the number_hash field if the given value is not nil.
+func (cc *CardCreate) SetNillableNumberHash(s *string) *CardCreate {
+	if s != nil {
+		cc.SetNumberHash(*s)
+	}
+	return cc
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (cc *CardCreate) SetOwnerID(id int) *CardCreate {
 	cc.mutation.SetOwnerID(id)
@@ -110,26 +156,12 @@ func (cc *CardCreate) AddSpec(s ...*Spec) *CardCreate {
 
 // Save creates the Card in the database.
 func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
-	if _, ok := cc.mutation.CreateTime(); !ok {
-		v := card.DefaultCreateTime()
-		cc.mutation.SetCreateTime(v)
-	}
-	if _, ok := cc.mutation.UpdateTime(); !ok {
-		v := card.DefaultUpdateTime()
-		cc.mutation.SetUpdateTime(v)
-	}
-	if _, ok := cc.mutation.Number(); !ok {
-		return nil, errors.New("ent: missing required field \"number\"")
-	}
-	if v, ok := cc.mutation.Number(); ok {
-		if err := card.NumberValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"number\": %v", err)
-		}
+	if err := checkTx(cc.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := cc.mutation.Name(); ok {
-		if err := card.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	cc.defaults()
+	if err := cc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -166,17 +198,138 @@ func (cc *CardCreate) SaveX(ctx context.Context) *Card {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cc *CardCreate) Unwrap() *CardCreate {
+	tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardCreate is not a transactional builder")
+	}
+	cc.config.driver = tx.drv
+	cc.mutation.driver = tx.drv
+	return cc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (cc *CardCreate) DebugFields() map[string]Value {
+	return debugFields(cc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CardCreate) defaults() {
+	if _, ok := cc.mutation.CreateTime(); !ok {
+		v := card.DefaultCreateTime()
+		cc.mutation.SetCreateTime(v)
+	}
+	if _, ok := cc.mutation.UpdateTime(); !ok {
+		v := card.DefaultUpdateTime()
+		cc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CardCreate) check() error {
+	if _, ok := cc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New("ent: missing required field \"number\"")}
+	}
+	if v, ok := cc.mutation.Number(); ok {
+		if err := card.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf("ent: validator failed for field \"number\": %v", err)}
+		}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := card.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := cc.mutation.GetType(); ok {
+		if err := card.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
-	var (
-		c     = &Card{config: cc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: card.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: card.FieldID,
-			},
+	ctx = cc.withOperation(ctx, "Card", "Create")
+	c, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	c.ID = int(id)
+	return c, nil
+}
+
+// SaveID creates the Card in the database and returns only its id. Unlike Save,
+// the Card entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Card that holds only its id as the value that is returned by the next mutator.
+func (cc *CardCreate) SaveID(ctx context.Context) (id int, err error) {
+	cc.defaults()
+	if err = cc.check(); err != nil {
+		return id, err
+	}
+	if len(cc.hooks) == 0 {
+		return cc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*CardMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		cc.mutation = mutation
+		if id, err = cc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Card{config: cc.config, ID: id}, nil
+	})
+	for i := len(cc.hooks) - 1; i >= 0; i-- {
+		mut = cc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, cc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (cc *CardCreate) SaveIDX(ctx context.Context) int {
+	id, err := cc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (cc *CardCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = cc.withOperation(ctx, "Card", "Create")
+	_spec := cc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, cc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (cc *CardCreate) createSpec() (*Card, *sqlgraph.CreateSpec) {
+	c := &Card{config: cc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: card.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: card.FieldID,
+		},
+	}
 	if value, ok := cc.mutation.CreateTime(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -209,6 +362,31 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		})
 		c.Name = value
 	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		value = value.In(card.ExpiresAtLocation)
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: card.FieldExpiresAt,
+		})
+		c.ExpiresAt = &value
+	}
+	if value, ok := cc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: card.FieldType,
+		})
+		c.Type = value
+	}
+	if value, ok := cc.mutation.NumberHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: card.FieldNumberHash,
+		})
+		c.NumberHash = value
+	}
 	if nodes := cc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -247,13 +425,704 @@ func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return c, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (cc *CardCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: card.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: card.FieldID,
+		},
+	}
+	if value, ok := cc.mutation.CreateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: card.FieldCreateTime,
+		})
+	}
+	if value, ok := cc.mutation.UpdateTime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: card.FieldUpdateTime,
+		})
+	}
+	if value, ok := cc.mutation.Number(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: card.FieldNumber,
+		})
+	}
+	if value, ok := cc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: card.FieldName,
+		})
+	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		value = value.In(card.ExpiresAtLocation)
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: card.FieldExpiresAt,
+		})
+	}
+	if value, ok := cc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: card.FieldType,
+		})
+	}
+	if value, ok := cc.mutation.NumberHash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: card.FieldNumberHash,
+		})
+	}
+	if nodes := cc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   card.OwnerTable,
+			Columns: []string{card.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := cc.mutation.SpecIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   card.SpecTable,
+			Columns: card.SpecPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: spec.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// CardCreateBulk is the builder for creating a bulk of Card entities.
+type CardCreateBulk struct {
+	config
+	builders []*CardCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Card entities in the database.
+func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
+	nodes, err := ccb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ccb.retries > 0 && isSQLDeadlockError(err) {
+		return ccb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CardCreateBulk) SaveX(ctx context.Context) []*Card {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ccb *CardCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ccb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ccb *CardCreateBulk) CheckRefs() *CardCreateBulk {
+	ccb.refs = true
+	return ccb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ccb *CardCreateBulk) RetryOnDeadlock(max int) *CardCreateBulk {
+	ccb.retries = max
+	return ccb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Card.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Card.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ccb *CardCreateBulk) OnConflict(columns ...string) *CardUpsertBulk {
+	return &CardUpsertBulk{create: ccb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Card.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (cc *CardCreate) OnConflict(target ...sql.ConflictOption) *CardUpsertBulk {
+	bulk := &CardCreateBulk{config: cc.config, builders: []*CardCreate{cc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ccb *CardCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Card, error) {
+	ctx = ccb.withOperation(ctx, "Card", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ccb.builders))
+		nodes    = make([]*Card, len(ccb.builders))
+		mutators = make([]Mutator, len(ccb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CardMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ccb.refs {
+						if err := ccb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ccb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
 		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ccb *CardCreateBulk) retry(ctx context.Context, err error) ([]*Card, error) {
+	if _, ok := ccb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	c.ID = int(id)
-	return c, nil
+	builders := ccb.builders
+	defer func() { ccb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ccb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ccb.builders = make([]*CardCreate, len(idx))
+	for i, j := range idx {
+		ccb.builders[i] = builders[j]
+	}
+	for n := 0; n < ccb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Card
+		if sorted, err = ccb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Card, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ccb *CardCreateBulk) less(a, b *CardCreate) bool {
+	{
+		x, _ := a.mutation.NumberHash()
+		y, _ := b.mutation.NumberHash()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ccb *CardCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ccb.builders {
+			for _, id := range builder.mutation.OwnerIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ccb.driver, user.Table, user.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[card.EdgeOwner] = append(missing[card.EdgeOwner], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ccb.builders {
+			for _, id := range builder.mutation.SpecIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ccb.driver, spec.Table, spec.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[card.EdgeSpec] = append(missing[card.EdgeSpec], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Card", Missing: missing}
+	}
+	return nil
+}
+
+// CardUpsertBulk is the builder for the conflict handling of a bulk of Card entities.
+type CardUpsertBulk struct {
+	create  *CardCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (cub *CardUpsertBulk) Target(target ...sql.ConflictOption) *CardUpsertBulk {
+	cub.target = append(cub.target, target...)
+	return cub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (cub *CardUpsertBulk) DoNothing() *CardUpsertBulk {
+	cub.nothing = true
+	return cub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (cub *CardUpsertBulk) UpdateNewValues() *CardUpsertBulk {
+	cub.update = true
+	return cub
+}
+
+// Save creates the Card entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (cub *CardUpsertBulk) Save(ctx context.Context) ([]*Card, int, error) {
+	switch {
+	case !cub.nothing && !cub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Card bulk insert")
+	case cub.nothing && cub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Card bulk insert")
+	case cub.update:
+		if len(cub.columns) == 0 && len(cub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Card bulk upsert")
+		}
+		nodes, err := cub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(cub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(card.FieldID, card.FieldCreateTime, card.FieldNumber, card.FieldNumberHash),
+			),
+			Columns:         card.Columns,
+			ConflictColumns: cub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(cub.conflictTarget(), sql.DoNothing())}
+	nodes, err := cub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Card, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (cub *CardUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(cub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(cub.columns...))
+	}
+	return append(opts, cub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cub *CardUpsertBulk) SaveX(ctx context.Context) ([]*Card, int) {
+	nodes, skipped, err := cub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (cub *CardUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := cub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cub *CardUpsertBulk) ExecX(ctx context.Context) {
+	if err := cub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored Card entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.Card.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *Card) *Card {
+//			// ...
+//			return existing
+//		}, card.FieldNumberHash).
+//		Save(ctx)
+//
+func (ccb *CardCreateBulk) OnConflictMerge(merge func(existing, incoming *Card) *Card, keyFields ...string) *CardMergeBulk {
+	return &CardMergeBulk{create: ccb, merge: merge, keyFields: keyFields}
+}
+
+// CardMergeBulk is the builder for merging a bulk of Card entities with the stored entities in Go.
+type CardMergeBulk struct {
+	create    *CardCreateBulk
+	merge     func(existing, incoming *Card) *Card
+	keyFields []string
+}
+
+// Save creates or merges the Card entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (cmb *CardMergeBulk) Save(ctx context.Context) ([]*Card, error) {
+	if cmb.merge == nil {
+		return nil, errors.New("ent: missing merge function for Card bulk merge")
+	}
+	if len(cmb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for Card bulk merge")
+	}
+	for _, f := range cmb.keyFields {
+		switch f {
+		case card.FieldNumberHash:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for Card bulk merge", f)
+		}
+	}
+	drv := cmb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return cmb.save(ctx, cmb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
+		return nil, err
+	}
+	cfg := cmb.create.config
+	cfg.driver = tx
+	nodes, err := cmb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (cmb *CardMergeBulk) save(ctx context.Context, cfg config) ([]*Card, error) {
+	var (
+		client   = &CardClient{config: cfg}
+		builders = cmb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *CardCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(cmb.keyFields))
+		for j, f := range cmb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in Card builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: Card builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Card)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, cmb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(cmb.keyFields))
+			for j, f := range cmb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*Card, len(builders))
+		inserts []*CardCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := cmb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmb *CardMergeBulk) SaveX(ctx context.Context) []*Card {
+	nodes, err := cmb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (cmb *CardMergeBulk) Exec(ctx context.Context) error {
+	_, err := cmb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmb *CardMergeBulk) ExecX(ctx context.Context) {
+	if err := cmb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -34,6 +37,18 @@ type CardQuery struct {
 	withOwner *UserQuery
 	withSpec  *SpecQuery
 	withFKs   bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see CardSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -45,6 +60,19 @@ func (cq *CardQuery) Where(ps ...predicate.Card) *CardQuery {
 	return cq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (cq *CardQuery) Predicates() []predicate.Card {
+	return append([]predicate.Card{}, cq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (cq *CardQuery) SetPredicates(ps ...predicate.Card) *CardQuery {
+	cq.predicates = append([]predicate.Card{}, ps...)
+	return cq
+}
+
 // Limit adds a limit step to the query.
 func (cq *CardQuery) Limit(limit int) *CardQuery {
 	cq.limit = &limit
@@ -342,11 +370,15 @@ func (cq *CardQuery) GroupBy(field string, fields ...string) *CardGroupBy {
 func (cq *CardQuery) Select(field string, fields ...string) *CardSelect {
 	selector := &CardSelect{config: cq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = cq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return cq.sqlQuery(), nil
+		if err := cq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return cq.sqlQuery().AppendSelectExpr(cq.exprs...), nil
 	}
 	return selector
 }
@@ -363,6 +395,15 @@ func (cq *CardQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
+	ctx = cq.withOperation(ctx, "Card", "Query")
+	if err := cq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range cq.fields {
+		if !card.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*Card{}
 		withFKs     = cq.withFKs
@@ -378,12 +419,14 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, card.ForeignKeys...)
 	}
+	_spec.Exprs = cq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Card{config: cq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
-		if withFKs {
-			values = append(values, node.fkValues()...)
+		values := node.scanValues(columns)
+		for range cq.exprs {
+			values = append(values, new(interface{}))
 		}
 		return values
 	}
@@ -392,8 +435,16 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(cq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range cq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
 		return nil, err
@@ -403,31 +454,50 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 	}
 
 	if query := cq.withOwner; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*Card)
 		for i := range nodes {
 			if fk := nodes[i].user_card; fk != nil {
-				ids = append(ids, *fk)
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
 				nodeids[*fk] = append(nodeids[*fk], nodes[i])
 			}
 		}
-		query.Where(user.IDIn(ids...))
-		neighbors, err := query.All(ctx)
-		if err != nil {
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && cq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`ent: limit or offset of eager-loaded edge "owner" cannot be applied on %d neighbors with batch size %d`, len(ids), cq.eagerLoadSize())
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := nodeids[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "user_card" returned %v`, n.ID)
+		err := cq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Owner = n
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_card" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Owner = n
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if query := cq.withSpec; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		ids := make(map[int]*Card, len(nodes))
 		for _, node := range nodes {
@@ -444,9 +514,6 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 				Table:   card.SpecTable,
 				Columns: card.SpecPrimaryKey,
 			},
-			Predicate: func(s *sql.Selector) {
-				s.Where(sql.InValues(card.SpecPrimaryKey[1], fks...))
-			},
 
 			ScanValues: func() [2]interface{} {
 				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
@@ -466,26 +533,69 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 				if !ok {
 					return fmt.Errorf("unexpected node id in edges: %v", outValue)
 				}
-				edgeids = append(edgeids, inValue)
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
 				edges[inValue] = append(edges[inValue], node)
 				return nil
 			},
 		}
-		if err := sqlgraph.QueryEdges(ctx, cq.driver, _spec); err != nil {
-			return nil, fmt.Errorf(`query edges "spec": %v`, err)
-		}
-		query.Where(spec.IDIn(edgeids...))
-		neighbors, err := query.All(ctx)
+		err := cq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(card.SpecPrimaryKey[1], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, cq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "spec": %v`, err)
+			}
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := edges[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected "spec" node returned %v`, n.ID)
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && cq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "spec" cannot be applied on %d neighbors with batch size %d`, len(edgeids), cq.eagerLoadSize())
+			return nil, err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = cq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], spec.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Spec = append(nodes[i].Edges.Spec, n)
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "spec" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Spec = append(nodes[i].Edges.Spec, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return nil, err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Spec
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Spec = edges
 			}
 		}
 	}
@@ -493,7 +603,45 @@ func (cq *CardQuery) sqlAll(ctx context.Context) ([]*Card, error) {
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Card entities (see Card.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Card.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Card table.
+func (cq *CardQuery) SelectExpr(exprs ...*sql.SelectExpr) *CardQuery {
+	cq.exprs = append(cq.exprs, exprs...)
+	return cq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (cq *CardQuery) checkExprs() error {
+	if len(cq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range card.Columns {
+		columns[c] = true
+	}
+	for _, c := range card.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range cq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Card", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (cq *CardQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = cq.withOperation(ctx, "Card", "Count")
 	_spec := cq.querySpec()
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
 }
@@ -519,6 +667,17 @@ func (cq *CardQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   cq.sql,
 		Unique: true,
 	}
+	if fields := cq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, card.FieldID)
+		for i := range fields {
+			if fields[i] != card.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = cq.forUpdate
+	_spec.PartitionBy = cq.partition
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -567,11 +726,427 @@ func (cq *CardQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Card entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (cq *CardQuery) IDsQuery() *sql.Selector {
+	selector := cq.sqlQuery()
+	if cq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(card.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Card entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Card.Query().
+//		Where(...).
+//		CountDistinct(ctx, card.FieldCreateTime)
+//
+func (cq *CardQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = cq.withOperation(ctx, "Card", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, cq.driver, cq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (cq *CardQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := cq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Card rows as generic maps that are keyed by
+// column name (see card.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (cq *CardQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = cq.withOperation(ctx, "Card", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = cq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Card{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (cq *CardQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := cq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Card entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (cq *CardQuery) Prefetch(n int) *CardQuery {
+	cq.prefetch = n
+	return cq
+}
+
+// ForUpdate locks the selected Card rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (cq *CardQuery) ForUpdate() *CardQuery {
+	cq.forUpdate = true
+	return cq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (cq *CardQuery) Primary() *CardQuery {
+	return cq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (cq *CardQuery) ReadConsistency(c sql.ReadConsistency) *CardQuery {
+	cq.consistency = c
+	return cq
+}
+
+// Stream executes the query in batches, and sends the matched Card entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Card.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (cq *CardQuery) Stream(ctx context.Context) (<-chan *Card, <-chan error) {
+	size := cq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Card, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := cq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Card entities in batches of the given
+// size, and sends them to the given channel.
+func (cq *CardQuery) stream(ctx context.Context, size int, nodes chan<- *Card) error {
+	if cq.offset != nil || len(cq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if cq.limit != nil {
+		left = *cq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *cq
+		query.predicates = cq.predicates[:len(cq.predicates):len(cq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, card.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(card.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// CardEdge is the edge representation of Card in a connection.
+type CardEdge struct {
+	Node   *Card  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// CardConnection is the connection of Card entities that is returned by Paginate.
+type CardConnection struct {
+	Edges      []CardEdge `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Card.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: card.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (cq *CardQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*CardConnection, error) {
+	if cq.limit != nil || cq.offset != nil || len(cq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case card.FieldCreateTime, card.FieldUpdateTime, card.FieldNumber:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for Card", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := cq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: card.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *cq
+	query.predicates = cq.predicates[:len(cq.predicates):len(cq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := cq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &CardConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]CardEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = CardEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (cq *CardQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Card, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := cq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Card(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*CardQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case card.FieldCreateTime:
+		var v time.Time
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field create_time: %v", err)
+		}
+		return v, nil
+	case card.FieldUpdateTime:
+		var v time.Time
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field update_time: %v", err)
+		}
+		return v, nil
+	case card.FieldNumber:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field number: %v", err)
+		}
+		return v, nil
+	case card.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Card", field)
+}
+
+// pageCursor returns the cursor of the Card node for the given order fields.
+func (c *Card) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(c.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case card.FieldCreateTime:
+			v = c.CreateTime
+		case card.FieldUpdateTime:
+			v = c.UpdateTime
+		case card.FieldNumber:
+			v = c.Number
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Card", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
 // CardGroupBy is the builder for group-by Card entities.
 type CardGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -684,9 +1259,28 @@ func (cgb *CardGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Card.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (cgb *CardGroupBy) Having(ps ...*sql.Predicate) *CardGroupBy {
+	cgb.havings = append(cgb.havings, ps...)
+	return cgb
+}
+
 func (cgb *CardGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = cgb.withOperation(ctx, "Card", "GroupBy")
+	selector := cgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := cgb.sqlQuery().Query()
 	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -699,15 +1293,30 @@ func (cgb *CardGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(cgb.fields)+len(cgb.fns))
 	columns = append(columns, cgb.fields...)
 	for _, fn := range cgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(cgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(cgb.fields...)
+	switch len(cgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(cgb.havings[0])
+	default:
+		selector.Having(sql.And(cgb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(cgb.fields...)
+	return selector
 }
 
 // CardSelect is the builder for select fields of Card entities.
 type CardSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *CardQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -814,7 +1423,52 @@ func (cs *CardSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (cs *CardSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(cs.fields) > 1 {
+		return nil, errors.New("ent: CardSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := cs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (cs *CardSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := cs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Card entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Card.Query().
+//		Select(card.FieldCreateTime).
+//		All(ctx)
+//
+func (cs *CardSelect) All(ctx context.Context) ([]*Card, error) {
+	query := *cs.query
+	query.fields = cs.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cs *CardSelect) AllX(ctx context.Context) []*Card {
+	nodes, err := cs.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (cs *CardSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = cs.withOperation(ctx, "Card", "Select")
 	rows := &sql.Rows{}
 	query, args := cs.sqlQuery().Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
@@ -8,12 +8,15 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/ent/spec"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
 	"github.com/facebookincubator/ent/schema/field"
@@ -25,6 +28,9 @@ type CardUpdate struct {
 	hooks      []Hook
 	mutation   *CardMutation
 	predicates []predicate.Card
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -33,6 +39,13 @@ func (cu *CardUpdate) Where(ps ...predicate.Card) *CardUpdate {
 	return cu
 }
 
+// Limit limits the number of cards that are updated by the builder. For example,
+// for updating large amounts of cards in small batches without locking the whole table.
+func (cu *CardUpdate) Limit(limit int) *CardUpdate {
+	cu.limit = &limit
+	return cu
+}
+
 // SetName sets the name field.
 func (cu *CardUpdate) SetName(s string) *CardUpdate {
 	cu.mutation.SetName(s)
@@ -53,6 +66,70 @@ func (cu *CardUpdate) ClearName() *CardUpdate {
 	return cu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (cu *CardUpdate) UnsetName() *CardUpdate {
+	cu.mutation.ResetName()
+	return cu
+}
+
+// SetExpiresAt sets the expires_at field.
+func (cu *CardUpdate) SetExpiresAt(t time.Time) *CardUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (cu *CardUpdate) SetNillableExpiresAt(t *time.Time) *CardUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (cu *CardUpdate) ClearExpiresAt() *CardUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
+// UnsetExpiresAt removes the changes of the expires_at field from the builder (e.g. a previous call
+// to SetExpiresAt), and therefore, the field is left unchanged in the database. Unlike ClearExpiresAt,
+// it does not set the field to NULL, and also removes a previous call to ClearExpiresAt.
+func (cu *CardUpdate) UnsetExpiresAt() *CardUpdate {
+	cu.mutation.ResetExpiresAt()
+	return cu
+}
+
+// SetType sets the type field.
+func (cu *CardUpdate) SetType(st schema.CardType) *CardUpdate {
+	cu.mutation.SetType(st)
+	return cu
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (cu *CardUpdate) SetNillableType(st *schema.CardType) *CardUpdate {
+	if st != nil {
+		cu.SetType(*st)
+	}
+	return cu
+}
+
+// ClearType clears the value of type.
+func (cu *CardUpdate) ClearType() *CardUpdate {
+	cu.mutation.ClearType()
+	return cu
+}
+
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (cu *CardUpdate) UnsetType() *CardUpdate {
+	cu.mutation.ResetType()
+	return cu
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (cu *CardUpdate) SetOwnerID(id int) *CardUpdate {
 	cu.mutation.SetOwnerID(id)
@@ -108,18 +185,37 @@ func (cu *CardUpdate) RemoveSpec(s ...*Spec) *CardUpdate {
 	return cu.RemoveSpecIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cu *CardUpdate) Unwrap() *CardUpdate {
+	tx, ok := cu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardUpdate is not a transactional builder")
+	}
+	cu.config.driver = tx.drv
+	cu.mutation.driver = tx.drv
+	return cu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cu *CardUpdate) DebugFields() map[string]Value {
+	return debugFields(cu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(cu.driver); err != nil {
+		return 0, err
+	}
 	if _, ok := cu.mutation.UpdateTime(); !ok {
 		v := card.UpdateDefaultUpdateTime()
 		cu.mutation.SetUpdateTime(v)
 	}
-	if v, ok := cu.mutation.Name(); ok {
-		if err := card.NameValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := cu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -133,6 +229,9 @@ func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
 			cu.mutation = mutation
+			if err := cu.checkImmutable(); err != nil {
+				return nil, err
+			}
 			affected, err = cu.sqlSave(ctx)
 			return affected, err
 		})
@@ -168,7 +267,42 @@ func (cu *CardUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cu *CardUpdate) check() error {
+	if err := cu.checkImmutable(); err != nil {
+		return err
+	}
+	if v, ok := cu.mutation.Name(); ok {
+		if err := card.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := cu.mutation.GetType(); ok {
+		if err := card.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if cu.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
+// checkImmutable fails the mutation if it sets, adds to or clears an immutable field.
+// It runs before the hooks and again after them, before the mutation is executed.
+func (cu *CardUpdate) checkImmutable() error {
+	for _, name := range []string{card.FieldCreateTime, card.FieldNumber, card.FieldNumberHash} {
+		_, set := cu.mutation.Field(name)
+		_, added := cu.mutation.AddedField(name)
+		if set || added || cu.mutation.FieldCleared(name) {
+			return &ValidationError{Name: name, err: fmt.Errorf("ent: immutable field %q cannot be updated", name)}
+		}
+	}
+	return nil
+}
+
 func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = cu.withOperation(ctx, "Card", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   card.Table,
@@ -186,6 +320,12 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := cu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if cu.ids != nil {
+		_spec.ScanIDs = cu.ids
+	}
 	if value, ok := cu.mutation.UpdateTime(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -206,6 +346,39 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: card.FieldName,
 		})
 	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		value = value.In(card.ExpiresAtLocation)
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: card.FieldExpiresAt,
+		})
+	}
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: card.FieldExpiresAt,
+		})
+	}
+	if value, ok := cu.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: card.FieldType,
+		})
+	}
+	if cu.mutation.TypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Column: card.FieldType,
+		})
+	}
+	if cu.mutation.NumberHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: card.FieldNumberHash,
+		})
+	}
 	if cu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -279,7 +452,7 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -290,11 +463,33 @@ func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Card entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (cu *CardUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	cu.ids = &ids
+	defer func() { cu.ids = nil }()
+	if _, err := cu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // CardUpdateOne is the builder for updating a single Card entity.
 type CardUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *CardMutation
+	hooks      []Hook
+	mutation   *CardMutation
+	predicates []predicate.Card
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (cuo *CardUpdateOne) Where(ps ...predicate.Card) *CardUpdateOne {
+	cuo.predicates = append(cuo.predicates, ps...)
+	return cuo
 }
 
 // SetName sets the name field.
@@ -317,6 +512,70 @@ func (cuo *CardUpdateOne) ClearName() *CardUpdateOne {
 	return cuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (cuo *CardUpdateOne) UnsetName() *CardUpdateOne {
+	cuo.mutation.ResetName()
+	return cuo
+}
+
+// SetExpiresAt sets the expires_at field.
+func (cuo *CardUpdateOne) SetExpiresAt(t time.Time) *CardUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (cuo *CardUpdateOne) SetNillableExpiresAt(t *time.Time) *CardUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (cuo *CardUpdateOne) ClearExpiresAt() *CardUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
+// UnsetExpiresAt removes the changes of the expires_at field from the builder (e.g. a previous call
+// to SetExpiresAt), and therefore, the field is left unchanged in the database. Unlike ClearExpiresAt,
+// it does not set the field to NULL, and also removes a previous call to ClearExpiresAt.
+func (cuo *CardUpdateOne) UnsetExpiresAt() *CardUpdateOne {
+	cuo.mutation.ResetExpiresAt()
+	return cuo
+}
+
+// SetType sets the type field.
+func (cuo *CardUpdateOne) SetType(st schema.CardType) *CardUpdateOne {
+	cuo.mutation.SetType(st)
+	return cuo
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (cuo *CardUpdateOne) SetNillableType(st *schema.CardType) *CardUpdateOne {
+	if st != nil {
+		cuo.SetType(*st)
+	}
+	return cuo
+}
+
+// ClearType clears the value of type.
+func (cuo *CardUpdateOne) ClearType() *CardUpdateOne {
+	cuo.mutation.ClearType()
+	return cuo
+}
+
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (cuo *CardUpdateOne) UnsetType() *CardUpdateOne {
+	cuo.mutation.ResetType()
+	return cuo
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (cuo *CardUpdateOne) SetOwnerID(id int) *CardUpdateOne {
 	cuo.mutation.SetOwnerID(id)
@@ -372,18 +631,37 @@ func (cuo *CardUpdateOne) RemoveSpec(s ...*Spec) *CardUpdateOne {
 	return cuo.RemoveSpecIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cuo *CardUpdateOne) Unwrap() *CardUpdateOne {
+	tx, ok := cuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardUpdateOne is not a transactional builder")
+	}
+	cuo.config.driver = tx.drv
+	cuo.mutation.driver = tx.drv
+	return cuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cuo *CardUpdateOne) DebugFields() map[string]Value {
+	return debugFields(cuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
+	if err := checkTx(cuo.driver); err != nil {
+		return nil, err
+	}
 	if _, ok := cuo.mutation.UpdateTime(); !ok {
 		v := card.UpdateDefaultUpdateTime()
 		cuo.mutation.SetUpdateTime(v)
 	}
-	if v, ok := cuo.mutation.Name(); ok {
-		if err := card.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := cuo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *Card
@@ -397,6 +675,9 @@ func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
 			cuo.mutation = mutation
+			if err := cuo.checkImmutable(); err != nil {
+				return nil, err
+			}
 			node, err = cuo.sqlSave(ctx)
 			return node, err
 		})
@@ -432,7 +713,42 @@ func (cuo *CardUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CardUpdateOne) check() error {
+	if err := cuo.checkImmutable(); err != nil {
+		return err
+	}
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := card.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := cuo.mutation.GetType(); ok {
+		if err := card.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if cuo.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
+// checkImmutable fails the mutation if it sets, adds to or clears an immutable field.
+// It runs before the hooks and again after them, before the mutation is executed.
+func (cuo *CardUpdateOne) checkImmutable() error {
+	for _, name := range []string{card.FieldCreateTime, card.FieldNumber, card.FieldNumberHash} {
+		_, set := cuo.mutation.Field(name)
+		_, added := cuo.mutation.AddedField(name)
+		if set || added || cuo.mutation.FieldCleared(name) {
+			return &ValidationError{Name: name, err: fmt.Errorf("ent: immutable field %q cannot be updated", name)}
+		}
+	}
+	return nil
+}
+
 func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
+	ctx = cuo.withOperation(ctx, "Card", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   card.Table,
@@ -448,6 +764,13 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 		return nil, fmt.Errorf("missing Card.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := cuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := cuo.mutation.UpdateTime(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeTime,
@@ -468,6 +791,39 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 			Column: card.FieldName,
 		})
 	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		value = value.In(card.ExpiresAtLocation)
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: card.FieldExpiresAt,
+		})
+	}
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: card.FieldExpiresAt,
+		})
+	}
+	if value, ok := cuo.mutation.GetType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: card.FieldType,
+		})
+	}
+	if cuo.mutation.TypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Column: card.FieldType,
+		})
+	}
+	if cuo.mutation.NumberHashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: card.FieldNumberHash,
+		})
+	}
 	if cuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -542,9 +898,11 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	c = &Card{config: cuo.config}
-	_spec.Assign = c.assignValues
-	_spec.ScanValues = c.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return c.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = c.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, cuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{card.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -552,5 +910,32 @@ func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (c *Card, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		c.ID = id
+		if value, ok := cuo.mutation.CreateTime(); ok {
+			c.CreateTime = value
+		}
+		if value, ok := cuo.mutation.UpdateTime(); ok {
+			c.UpdateTime = value
+		}
+		if value, ok := cuo.mutation.Number(); ok {
+			c.Number = value
+		}
+		if value, ok := cuo.mutation.Name(); ok {
+			c.Name = value
+		}
+		if value, ok := cuo.mutation.ExpiresAt(); ok {
+			value = value.In(card.ExpiresAtLocation)
+			c.ExpiresAt = &value
+		}
+		if value, ok := cuo.mutation.GetType(); ok {
+			c.Type = value
+		}
+		if value, ok := cuo.mutation.NumberHash(); ok {
+			c.NumberHash = value
+		}
+	}
 	return c, nil
 }
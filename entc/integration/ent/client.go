@@ -8,10 +8,16 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/entc/integration/ent/migrate"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/comment"
@@ -112,7 +118,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug, hooks: c.hooks}
+	tx.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = tx
 	return &Tx{
 		config:    cfg,
 		Card:      NewCardClient(cfg),
@@ -135,11 +143,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(*sql.Driver).BeginTx(ctx, opts)
+	drv, ok := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("ent: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: &txDriver{tx: tx, drv: c.driver}, log: c.log, debug: c.debug, hooks: c.hooks}
+	txd := &txDriver{tx: tx, drv: c.driver}
+	txd.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = txd
 	return &Tx{
 		config:    cfg,
 		Card:      NewCardClient(cfg),
@@ -157,6 +174,391 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+// WithTx runs fn in a transaction, which is committed if fn returns nil, and rolled back if fn returns an
+// error or panics (the panic is re-raised after the rollback). If the context holds a transaction (see
+// NewTxContext), or the client is transactional, fn runs in the existing transaction within a savepoint
+// instead. The savepoint is released if fn returns nil, and rolled back to otherwise, without affecting
+// the changes that were made in the transaction before it. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		if _, err := tx.Card.Create().Save(ctx); err != nil {
+//			return err
+//		}
+//		return nil
+//	})
+//
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	tx := TxFromContext(ctx)
+	if _, ok := c.driver.(*txDriver); ok && tx == nil {
+		tx = &Tx{config: c.config}
+		tx.init()
+	}
+	if tx != nil {
+		return tx.withSavepoint(ctx, fn)
+	}
+	if tx, err = c.Tx(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// withSavepoint runs fn within a savepoint of the transaction. The functions that were registered
+// to run after commit by the mutations of fn are discarded if the savepoint is rolled back to.
+func (tx *Tx) withSavepoint(ctx context.Context, fn func(tx *Tx) error) error {
+	drv := tx.config.driver.(*txDriver)
+	drv.mu.Lock()
+	drv.savepoints++
+	name := fmt.Sprintf("ent_savepoint_%d", drv.savepoints)
+	committed := len(drv.afterCommit)
+	drv.mu.Unlock()
+	if err := drv.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: creating savepoint: %w", err)
+	}
+	rollback := func() error {
+		drv.mu.Lock()
+		drv.afterCommit = drv.afterCommit[:committed]
+		drv.mu.Unlock()
+		return drv.Exec(ctx, "ROLLBACK TO SAVEPOINT "+name, []interface{}{}, nil)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back to savepoint: %v", err, rerr)
+		}
+		return err
+	}
+	if err := drv.Exec(ctx, "RELEASE SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: releasing savepoint: %w", err)
+	}
+	return nil
+}
+
+// Purge deletes the entities that match the given predicates from their tables in one transaction,
+// and returns the number of deleted rows per type label. The predicates are keyed by the type label,
+// and types without a predicate are not deleted. The types are deleted in dependency order (types
+// that hold foreign-keys to other types first) using their delete builders, and therefore, their
+// hooks are executed as well. Any error (or a canceled context) rolls back the transaction. For example:
+//
+//	tenant := func(s *sql.Selector) {
+//		s.Where(sql.EQ(s.C("tenant_id"), id))
+//	}
+//	report, err := client.Purge(ctx, map[string]func(*sql.Selector){
+//		card.Label: tenant,
+//		// ...
+//	})
+//
+// If the client is transactional, the entities are deleted in its transaction, and it is not committed.
+func (c *Client) Purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	for label := range preds {
+		switch label {
+		case card.Label, comment.Label, fieldtype.Label, file.Label, filetype.Label, pet.Label, user.Label, group.Label, groupinfo.Label, item.Label, node.Label, spec.Label:
+		default:
+			return nil, fmt.Errorf("ent: unknown type label %q", label)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.purge(ctx, preds)
+	}
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := tx.Client().purge(ctx, preds)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%v: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// purge deletes the entities of the given predicates in dependency order.
+func (c *Client) purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	report := make(map[string]int, len(preds))
+	if p, ok := preds[card.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Card.Delete().Where(predicate.Card(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging card: %w", err)
+		}
+		report[card.Label] = n
+	}
+	if p, ok := preds[comment.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Comment.Delete().Where(predicate.Comment(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging comment: %w", err)
+		}
+		report[comment.Label] = n
+	}
+	if p, ok := preds[fieldtype.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.FieldType.Delete().Where(predicate.FieldType(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging fieldtype: %w", err)
+		}
+		report[fieldtype.Label] = n
+	}
+	if p, ok := preds[file.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.File.Delete().Where(predicate.File(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging file: %w", err)
+		}
+		report[file.Label] = n
+	}
+	if p, ok := preds[filetype.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.FileType.Delete().Where(predicate.FileType(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging filetype: %w", err)
+		}
+		report[filetype.Label] = n
+	}
+	if p, ok := preds[pet.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Pet.Delete().Where(predicate.Pet(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging pet: %w", err)
+		}
+		report[pet.Label] = n
+	}
+	if p, ok := preds[user.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.User.Delete().Where(predicate.User(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging user: %w", err)
+		}
+		report[user.Label] = n
+	}
+	if p, ok := preds[group.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Group.Delete().Where(predicate.Group(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging group: %w", err)
+		}
+		report[group.Label] = n
+	}
+	if p, ok := preds[groupinfo.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.GroupInfo.Delete().Where(predicate.GroupInfo(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging groupinfo: %w", err)
+		}
+		report[groupinfo.Label] = n
+	}
+	if p, ok := preds[item.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Item.Delete().Where(predicate.Item(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging item: %w", err)
+		}
+		report[item.Label] = n
+	}
+	if p, ok := preds[node.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Node.Delete().Where(predicate.Node(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging node: %w", err)
+		}
+		report[node.Label] = n
+	}
+	if p, ok := preds[spec.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Spec.Delete().Where(predicate.Spec(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging spec: %w", err)
+		}
+		report[spec.Label] = n
+	}
+	return report, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given entities, that can be of different
+// types (e.g. the results of a polymorphic query). The entities are grouped by their concrete type, and
+// the edge of each group is loaded using the LoadEdgeFor method of its client. Hence, the number of
+// queries depends on the number of types, and not on the number of entities. For example:
+//
+//	err := client.LoadEdgeFor(ctx, []ent.Value{card, user}, "owner")
+//
+// The entities must be pointers to the generated types, and the edge must be defined on all their types.
+func (c *Client) LoadEdgeFor(ctx context.Context, nodes []Value, edge string) error {
+	var (
+		cardNodes      []*Card
+		commentNodes   []*Comment
+		fieldtypeNodes []*FieldType
+		fileNodes      []*File
+		filetypeNodes  []*FileType
+		groupNodes     []*Group
+		groupinfoNodes []*GroupInfo
+		itemNodes      []*Item
+		nodeNodes      []*Node
+		petNodes       []*Pet
+		specNodes      []*Spec
+		userNodes      []*User
+	)
+	for _, v := range nodes {
+		switch node := v.(type) {
+		case *Card:
+			cardNodes = append(cardNodes, node)
+		case *Comment:
+			commentNodes = append(commentNodes, node)
+		case *FieldType:
+			fieldtypeNodes = append(fieldtypeNodes, node)
+		case *File:
+			fileNodes = append(fileNodes, node)
+		case *FileType:
+			filetypeNodes = append(filetypeNodes, node)
+		case *Group:
+			groupNodes = append(groupNodes, node)
+		case *GroupInfo:
+			groupinfoNodes = append(groupinfoNodes, node)
+		case *Item:
+			itemNodes = append(itemNodes, node)
+		case *Node:
+			nodeNodes = append(nodeNodes, node)
+		case *Pet:
+			petNodes = append(petNodes, node)
+		case *Spec:
+			specNodes = append(specNodes, node)
+		case *User:
+			userNodes = append(userNodes, node)
+		default:
+			return fmt.Errorf("ent: unexpected entity type %T", v)
+		}
+	}
+	if len(cardNodes) > 0 {
+		if err := c.Card.LoadEdgeFor(ctx, cardNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(commentNodes) > 0 {
+		if err := c.Comment.LoadEdgeFor(ctx, commentNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(fieldtypeNodes) > 0 {
+		if err := c.FieldType.LoadEdgeFor(ctx, fieldtypeNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(fileNodes) > 0 {
+		if err := c.File.LoadEdgeFor(ctx, fileNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(filetypeNodes) > 0 {
+		if err := c.FileType.LoadEdgeFor(ctx, filetypeNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(groupNodes) > 0 {
+		if err := c.Group.LoadEdgeFor(ctx, groupNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(groupinfoNodes) > 0 {
+		if err := c.GroupInfo.LoadEdgeFor(ctx, groupinfoNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(itemNodes) > 0 {
+		if err := c.Item.LoadEdgeFor(ctx, itemNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(nodeNodes) > 0 {
+		if err := c.Node.LoadEdgeFor(ctx, nodeNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(petNodes) > 0 {
+		if err := c.Pet.LoadEdgeFor(ctx, petNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(specNodes) > 0 {
+		if err := c.Spec.LoadEdgeFor(ctx, specNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(userNodes) > 0 {
+		if err := c.User.LoadEdgeFor(ctx, userNodes, edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshMaterializedView replaces the contents of the materialized view with the given name by
+// re-executing its query (PostgreSQL only). If concurrently is true, the view is refreshed without
+// locking out concurrent reads from it, which requires a unique index on the view. For example:
+//
+//	err := client.RefreshMaterializedView(ctx, "card_stats", true)
+//
+// Read-only entities that query the view are defined using the View option in the schema config.
+func (c *Client) RefreshMaterializedView(ctx context.Context, name string, concurrently bool) error {
+	if d := c.driver.Dialect(); d != dialect.Postgres {
+		return fmt.Errorf("ent: materialized views are not supported by dialect %q", d)
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("REFRESH MATERIALIZED VIEW ")
+	if concurrently {
+		b.WriteString("CONCURRENTLY ")
+	}
+	query, args := b.Ident(name).Query()
+	if err := c.driver.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("ent: refreshing materialized view %q: %v", name, err)
+	}
+	return nil
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -168,7 +570,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true, hooks: c.hooks}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	client := &Client{config: cfg}
 	client.init()
 	return client
@@ -218,6 +622,353 @@ func (c *CardClient) Create() *CardCreate {
 	return &CardCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Card entities.
+func (c *CardClient) CreateBulk(builders ...*CardCreate) *CardCreateBulk {
+	return &CardCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Card builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Card.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *CardClient) CreateBulkFrom(ctx context.Context, ch <-chan *CardCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Card bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*CardCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*CardCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*CardCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Card that matches the given predicates, or creates it using
+// the given builder if there is no such Card. The returned bool reports whether the
+// Card was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Card is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Card.FindOrCreate(ctx, ps, client.Card.Create())
+//
+func (c *CardClient) FindOrCreate(ctx context.Context, ps []predicate.Card, create *CardCreate) (*Card, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Card.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Card using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *CardClient) findOrCreate(ctx context.Context, ps []predicate.Card, create *CardCreate) (*Card, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the cards table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Card columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Card columns are rejected before the statement is executed.
+//
+//	n, err := client.Card.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		card.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *CardClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   card.Table,
+		Columns: append(card.Columns[:len(card.Columns):len(card.Columns)], card.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Card and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Card.CopyToCreate(ca).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *CardClient) CopyToCreate(ca *Card) *CardCreate {
+	create := c.Create()
+	create.SetNumber(ca.Number)
+	if ca.Name != "" {
+		create.SetName(ca.Name)
+	}
+	if ca.ExpiresAt != nil {
+		create.SetExpiresAt(*ca.ExpiresAt)
+	}
+	if ca.Type != "" {
+		create.SetType(ca.Type)
+	}
+	if ca.NumberHash != "" {
+		create.SetNumberHash(ca.NumberHash)
+	}
+	return create
+}
+
+// Sync reconciles the Card entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.Card.Sync(ctx, builders, []string{card.FieldNumberHash})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *CardClient) Sync(ctx context.Context, desired []*CardCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for Card sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case card.FieldNumberHash:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for Card sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&CardClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *CardClient) sync(ctx context.Context, desired []*CardCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.Card, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in Card builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: Card builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Card)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*CardCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the Card.
+func (c *Card) syncValue(field string) Value {
+	switch field {
+	case card.FieldNumberHash:
+		return c.NumberHash
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// Card. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (c *Card) syncChanged(mutation *CardMutation) bool {
+	if v, ok := mutation.Name(); ok && (v != c.Name) {
+		return true
+	}
+	if v, ok := mutation.ExpiresAt(); ok && (c.ExpiresAt == nil || !v.Equal(*c.ExpiresAt)) {
+		return true
+	}
+	if v, ok := mutation.GetType(); ok && (v != c.Type) {
+		return true
+	}
+	if ids := mutation.OwnerIDs(); len(ids) > 0 && (c.user_card == nil || *c.user_card != ids[0]) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for Card.
 func (c *CardClient) Update() *CardUpdate {
 	mutation := newCardMutation(c.config, OpUpdate)
@@ -236,6 +987,185 @@ func (c *CardClient) UpdateOneID(id int) *CardUpdateOne {
 	return &CardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CardPatch holds the field changes of a Card for UpdateByIDMap, keyed by the field
+// name (e.g. card.FieldName). A nil value clears an optional field.
+type CardPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Card with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Card.UpdateByIDMap(ctx, map[int]ent.CardPatch{
+//		id1: {card.FieldName: v1},
+//		id2: {card.FieldName: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *CardClient) UpdateByIDMap(ctx context.Context, m map[int]CardPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *CardClient) patchUpdates(m map[int]CardPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newCardMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			switch name {
+			case card.FieldCreateTime, card.FieldUpdateTime, card.FieldNumber, card.FieldNumberHash:
+				return nil, fmt.Errorf("ent: immutable field %q in Card patch of id %v", name, id)
+			}
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Card patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&CardUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(card.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, card.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(card.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Card entities, and updates the original Card only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Card is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ca.Clone()
+//	modified.Field = value
+//	ca, err = client.Card.UpdateFromDiff(ctx, ca, modified)
+//
+func (c *CardClient) UpdateFromDiff(ctx context.Context, original, modified *Card) (*Card, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Card UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	switch v, o := modified.ExpiresAt, original.ExpiresAt; {
+	case v == nil && o != nil:
+		update.ClearExpiresAt()
+		changed = true
+	case v != nil && (o == nil || !v.Equal(*o)):
+		update.SetExpiresAt(*v)
+		changed = true
+	}
+	if modified.Type != original.Type {
+		update.SetType(modified.Type)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Owner, original.Edges.Owner; {
+		case v == nil && o != nil:
+			update.ClearOwner()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetOwnerID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
+// Touch sets the "update_time" field of the Card with the given
+// id to its update-default value (e.g. time.Now), without changing or reading its other
+// fields. Update hooks are executed, and *NotFoundError is returned if no entity was updated.
+func (c *CardClient) Touch(ctx context.Context, id int) error {
+	n, err := c.Update().Where(card.ID(id)).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	// MySQL reports only the rows that were changed. Hence, rows that
+	// were touched twice in the same second are not reported as affected.
+	switch exist, err := c.Query().Where(card.ID(id)).Exist(ctx); {
+	case err != nil:
+		return err
+	case !exist:
+		return &NotFoundError{card.Label}
+	default:
+		return nil
+	}
+}
+
 // Delete returns a delete builder for Card.
 func (c *CardClient) Delete() *CardDelete {
 	mutation := newCardMutation(c.config, OpDelete)
@@ -274,6 +1204,88 @@ func (c *CardClient) GetX(ctx context.Context, id int) *Card {
 	return ca
 }
 
+// GetMany returns the Card entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *CardClient) GetMany(ctx context.Context, ids ...int) ([]*Card, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(card.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Card, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Card, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CardClient) GetManyX(ctx context.Context, ids ...int) []*Card {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Card from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *CardClient) FromMap(m map[string]string) (*Card, error) {
+	ca := &Card{config: c.config}
+	if err := kvDecode(m, card.FieldID, &ca.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, card.FieldCreateTime, &ca.CreateTime, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, card.FieldUpdateTime, &ca.UpdateTime, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, card.FieldNumber, &ca.Number, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[card.FieldNumber]; ok {
+		if err := card.NumberValidator(ca.Number); err != nil {
+			return nil, &ValidationError{Name: "number", err: fmt.Errorf("ent: validator failed for field \"number\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, card.FieldName, &ca.Name, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[card.FieldName]; ok {
+		if err := card.NameValidator(ca.Name); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if _, ok := m[card.FieldExpiresAt]; ok {
+		ca.ExpiresAt = new(time.Time)
+		if err := kvDecode(m, card.FieldExpiresAt, ca.ExpiresAt, true); err != nil {
+			return nil, err
+		}
+	}
+	if err := kvDecode(m, card.FieldType, &ca.Type, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[card.FieldType]; ok {
+		if err := card.TypeValidator(ca.Type); err != nil {
+			return nil, &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, card.FieldNumberHash, &ca.NumberHash, false); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -306,9 +1318,182 @@ func (c *CardClient) QuerySpec(ca *Card) *SpecQuery {
 	return query
 }
 
+// LoadSpecFor loads the "spec" edge of all the given Card entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithSpec for cards that were already loaded.
+func (c *CardClient) LoadSpecFor(ctx context.Context, nodes []*Card, opts ...func(*SpecQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	cq := c.Query().WithSpec(opts...)
+	for _, node := range nodes {
+		node.Edges.Spec = nil
+	}
+
+	if query := cq.withSpec; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*Card, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*Card)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   card.SpecTable,
+				Columns: card.SpecPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := cq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(card.SpecPrimaryKey[1], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, cq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "spec": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && cq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "spec" cannot be applied on %d neighbors with batch size %d`, len(edgeids), cq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = cq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], spec.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "spec" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Spec = append(nodes[i].Edges.Spec, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Spec
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Spec = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[1] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Card entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *CardClient) LoadEdgeFor(ctx context.Context, nodes []*Card, edge string) error {
+	var (
+		query  func(*CardQuery)
+		assign func(node, loaded *Card)
+	)
+	switch edge {
+	case card.EdgeOwner:
+		query = func(q *CardQuery) { q.WithOwner() }
+		assign = func(node, loaded *Card) {
+			node.Edges.Owner, node.Edges.loadedTypes[0] = loaded.Edges.Owner, true
+		}
+	case card.EdgeSpec:
+		return c.LoadSpecFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown Card edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*Card, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(card.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *CardClient) Hooks() []Hook {
-	return c.hooks.Card
+	hooks := c.hooks.Card
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(card.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // CommentClient is a client for the Comment schema.
@@ -333,6 +1518,343 @@ func (c *CommentClient) Create() *CommentCreate {
 	return &CommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Comment entities.
+func (c *CommentClient) CreateBulk(builders ...*CommentCreate) *CommentCreateBulk {
+	return &CommentCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Comment builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Comment.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *CommentClient) CreateBulkFrom(ctx context.Context, ch <-chan *CommentCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Comment bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*CommentCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*CommentCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*CommentCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Comment that matches the given predicates, or creates it using
+// the given builder if there is no such Comment. The returned bool reports whether the
+// Comment was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Comment is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Comment.FindOrCreate(ctx, ps, client.Comment.Create())
+//
+func (c *CommentClient) FindOrCreate(ctx context.Context, ps []predicate.Comment, create *CommentCreate) (*Comment, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Comment.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Comment using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *CommentClient) findOrCreate(ctx context.Context, ps []predicate.Comment, create *CommentCreate) (*Comment, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the comments table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Comment columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Comment columns are rejected before the statement is executed.
+//
+//	n, err := client.Comment.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		comment.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *CommentClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   comment.Table,
+		Columns: comment.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Comment and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Comment.CopyToCreate(co).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *CommentClient) CopyToCreate(co *Comment) *CommentCreate {
+	create := c.Create()
+	create.SetUniqueInt(co.UniqueInt)
+	create.SetUniqueFloat(co.UniqueFloat)
+	if co.NillableInt != nil {
+		create.SetNillableInt(*co.NillableInt)
+	}
+	return create
+}
+
+// Sync reconciles the Comment entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.Comment.Sync(ctx, builders, []string{comment.FieldUniqueInt})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *CommentClient) Sync(ctx context.Context, desired []*CommentCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for Comment sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case comment.FieldUniqueInt, comment.FieldUniqueFloat:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for Comment sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&CommentClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *CommentClient) sync(ctx context.Context, desired []*CommentCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.Comment, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in Comment builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: Comment builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Comment)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*CommentCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the Comment.
+func (c *Comment) syncValue(field string) Value {
+	switch field {
+	case comment.FieldUniqueInt:
+		return c.UniqueInt
+	case comment.FieldUniqueFloat:
+		return c.UniqueFloat
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// Comment. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (c *Comment) syncChanged(mutation *CommentMutation) bool {
+	if v, ok := mutation.UniqueInt(); ok && (v != c.UniqueInt) {
+		return true
+	}
+	if v, ok := mutation.UniqueFloat(); ok && (v != c.UniqueFloat) {
+		return true
+	}
+	if v, ok := mutation.NillableInt(); ok && (c.NillableInt == nil || v != *c.NillableInt) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for Comment.
 func (c *CommentClient) Update() *CommentUpdate {
 	mutation := newCommentMutation(c.config, OpUpdate)
@@ -351,6 +1873,148 @@ func (c *CommentClient) UpdateOneID(id int) *CommentUpdateOne {
 	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CommentPatch holds the field changes of a Comment for UpdateByIDMap, keyed by the field
+// name (e.g. comment.FieldUniqueInt). A nil value clears an optional field.
+type CommentPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Comment with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Comment.UpdateByIDMap(ctx, map[int]ent.CommentPatch{
+//		id1: {comment.FieldUniqueInt: v1},
+//		id2: {comment.FieldUniqueInt: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *CommentClient) UpdateByIDMap(ctx context.Context, m map[int]CommentPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *CommentClient) patchUpdates(m map[int]CommentPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newCommentMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Comment patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&CommentUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(comment.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, comment.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(comment.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Comment entities, and updates the original Comment only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Comment is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := co.Clone()
+//	modified.Field = value
+//	co, err = client.Comment.UpdateFromDiff(ctx, co, modified)
+//
+func (c *CommentClient) UpdateFromDiff(ctx context.Context, original, modified *Comment) (*Comment, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Comment UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.UniqueInt != original.UniqueInt {
+		update.SetUniqueInt(modified.UniqueInt)
+		changed = true
+	}
+	if modified.UniqueFloat != original.UniqueFloat {
+		update.SetUniqueFloat(modified.UniqueFloat)
+		changed = true
+	}
+	switch v, o := modified.NillableInt, original.NillableInt; {
+	case v == nil && o != nil:
+		update.ClearNillableInt()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt(*v)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Comment.
 func (c *CommentClient) Delete() *CommentDelete {
 	mutation := newCommentMutation(c.config, OpDelete)
@@ -380,18 +2044,86 @@ func (c *CommentClient) Get(ctx context.Context, id int) (*Comment, error) {
 	return c.Query().Where(comment.ID(id)).Only(ctx)
 }
 
-// GetX is like Get, but panics if an error occurs.
-func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
-	co, err := c.Get(ctx, id)
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentClient) GetX(ctx context.Context, id int) *Comment {
+	co, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return co
+}
+
+// GetMany returns the Comment entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *CommentClient) GetMany(ctx context.Context, ids ...int) ([]*Comment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(comment.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Comment, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Comment, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CommentClient) GetManyX(ctx context.Context, ids ...int) []*Comment {
+	nodes, err := c.GetMany(ctx, ids...)
 	if err != nil {
 		panic(err)
 	}
-	return co
+	return nodes
+}
+
+// FromMap decodes a Comment from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *CommentClient) FromMap(m map[string]string) (*Comment, error) {
+	co := &Comment{config: c.config}
+	if err := kvDecode(m, comment.FieldID, &co.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, comment.FieldUniqueInt, &co.UniqueInt, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, comment.FieldUniqueFloat, &co.UniqueFloat, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[comment.FieldNillableInt]; ok {
+		co.NillableInt = new(int)
+		if err := kvDecode(m, comment.FieldNillableInt, co.NillableInt, true); err != nil {
+			return nil, err
+		}
+	}
+	return co, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Comment entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *CommentClient) LoadEdgeFor(ctx context.Context, nodes []*Comment, edge string) error {
+	return fmt.Errorf("ent: unknown Comment edge %q", edge)
 }
 
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
-	return c.hooks.Comment
+	hooks := c.hooks.Comment
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(comment.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // FieldTypeClient is a client for the FieldType schema.
@@ -416,6 +2148,241 @@ func (c *FieldTypeClient) Create() *FieldTypeCreate {
 	return &FieldTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of FieldType entities.
+func (c *FieldTypeClient) CreateBulk(builders ...*FieldTypeCreate) *FieldTypeCreateBulk {
+	return &FieldTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads FieldType builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.FieldType.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *FieldTypeClient) CreateBulkFrom(ctx context.Context, ch <-chan *FieldTypeCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for FieldType bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*FieldTypeCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*FieldTypeCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*FieldTypeCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the FieldType that matches the given predicates, or creates it using
+// the given builder if there is no such FieldType. The returned bool reports whether the
+// FieldType was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the FieldType is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.FieldType.FindOrCreate(ctx, ps, client.FieldType.Create())
+//
+func (c *FieldTypeClient) FindOrCreate(ctx context.Context, ps []predicate.FieldType, create *FieldTypeCreate) (*FieldType, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.FieldType.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the FieldType using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *FieldTypeClient) findOrCreate(ctx context.Context, ps []predicate.FieldType, create *FieldTypeCreate) (*FieldType, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the field_types table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the FieldType columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown FieldType columns are rejected before the statement is executed.
+//
+//	n, err := client.FieldType.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		fieldtype.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *FieldTypeClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   fieldtype.Table,
+		Columns: append(fieldtype.Columns[:len(fieldtype.Columns):len(fieldtype.Columns)], fieldtype.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given FieldType and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.FieldType.CopyToCreate(ft).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *FieldTypeClient) CopyToCreate(ft *FieldType) *FieldTypeCreate {
+	create := c.Create()
+	create.SetInt(ft.Int)
+	create.SetInt8(ft.Int8)
+	create.SetInt16(ft.Int16)
+	create.SetInt32(ft.Int32)
+	create.SetInt64(ft.Int64)
+	if ft.OptionalInt != 0 {
+		create.SetOptionalInt(ft.OptionalInt)
+	}
+	if ft.OptionalInt8 != 0 {
+		create.SetOptionalInt8(ft.OptionalInt8)
+	}
+	if ft.OptionalInt16 != 0 {
+		create.SetOptionalInt16(ft.OptionalInt16)
+	}
+	if ft.OptionalInt32 != 0 {
+		create.SetOptionalInt32(ft.OptionalInt32)
+	}
+	if ft.OptionalInt64 != 0 {
+		create.SetOptionalInt64(ft.OptionalInt64)
+	}
+	if ft.NillableInt != nil {
+		create.SetNillableInt(*ft.NillableInt)
+	}
+	if ft.NillableInt8 != nil {
+		create.SetNillableInt8(*ft.NillableInt8)
+	}
+	if ft.NillableInt16 != nil {
+		create.SetNillableInt16(*ft.NillableInt16)
+	}
+	if ft.NillableInt32 != nil {
+		create.SetNillableInt32(*ft.NillableInt32)
+	}
+	if ft.NillableInt64 != nil {
+		create.SetNillableInt64(*ft.NillableInt64)
+	}
+	if ft.ValidateOptionalInt32 != 0 {
+		create.SetValidateOptionalInt32(ft.ValidateOptionalInt32)
+	}
+	if ft.OptionalUint != 0 {
+		create.SetOptionalUint(ft.OptionalUint)
+	}
+	if ft.OptionalUint8 != 0 {
+		create.SetOptionalUint8(ft.OptionalUint8)
+	}
+	if ft.OptionalUint16 != 0 {
+		create.SetOptionalUint16(ft.OptionalUint16)
+	}
+	if ft.OptionalUint32 != 0 {
+		create.SetOptionalUint32(ft.OptionalUint32)
+	}
+	if ft.OptionalUint64 != 0 {
+		create.SetOptionalUint64(ft.OptionalUint64)
+	}
+	if ft.State != "" {
+		create.SetState(ft.State)
+	}
+	if ft.OptionalFloat != 0 {
+		create.SetOptionalFloat(ft.OptionalFloat)
+	}
+	if ft.OptionalFloat32 != 0 {
+		create.SetOptionalFloat32(ft.OptionalFloat32)
+	}
+	if !ft.Datetime.IsZero() {
+		create.SetDatetime(ft.Datetime)
+	}
+	if ft.Decimal != 0 {
+		create.SetDecimal(ft.Decimal)
+	}
+	return create
+}
+
 // Update returns an update builder for FieldType.
 func (c *FieldTypeClient) Update() *FieldTypeUpdate {
 	mutation := newFieldTypeMutation(c.config, OpUpdate)
@@ -434,6 +2401,256 @@ func (c *FieldTypeClient) UpdateOneID(id int) *FieldTypeUpdateOne {
 	return &FieldTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// FieldTypePatch holds the field changes of a FieldType for UpdateByIDMap, keyed by the field
+// name (e.g. fieldtype.FieldInt). A nil value clears an optional field.
+type FieldTypePatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the FieldType with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.FieldType.UpdateByIDMap(ctx, map[int]ent.FieldTypePatch{
+//		id1: {fieldtype.FieldInt: v1},
+//		id2: {fieldtype.FieldInt: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *FieldTypeClient) UpdateByIDMap(ctx context.Context, m map[int]FieldTypePatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *FieldTypeClient) patchUpdates(m map[int]FieldTypePatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newFieldTypeMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid FieldType patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&FieldTypeUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(fieldtype.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, fieldtype.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(fieldtype.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given FieldType entities, and updates the original FieldType only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original FieldType is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ft.Clone()
+//	modified.Field = value
+//	ft, err = client.FieldType.UpdateFromDiff(ctx, ft, modified)
+//
+func (c *FieldTypeClient) UpdateFromDiff(ctx context.Context, original, modified *FieldType) (*FieldType, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for FieldType UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Int != original.Int {
+		update.SetInt(modified.Int)
+		changed = true
+	}
+	if modified.Int8 != original.Int8 {
+		update.SetInt8(modified.Int8)
+		changed = true
+	}
+	if modified.Int16 != original.Int16 {
+		update.SetInt16(modified.Int16)
+		changed = true
+	}
+	if modified.Int32 != original.Int32 {
+		update.SetInt32(modified.Int32)
+		changed = true
+	}
+	if modified.Int64 != original.Int64 {
+		update.SetInt64(modified.Int64)
+		changed = true
+	}
+	if modified.OptionalInt != original.OptionalInt {
+		update.SetOptionalInt(modified.OptionalInt)
+		changed = true
+	}
+	if modified.OptionalInt8 != original.OptionalInt8 {
+		update.SetOptionalInt8(modified.OptionalInt8)
+		changed = true
+	}
+	if modified.OptionalInt16 != original.OptionalInt16 {
+		update.SetOptionalInt16(modified.OptionalInt16)
+		changed = true
+	}
+	if modified.OptionalInt32 != original.OptionalInt32 {
+		update.SetOptionalInt32(modified.OptionalInt32)
+		changed = true
+	}
+	if modified.OptionalInt64 != original.OptionalInt64 {
+		update.SetOptionalInt64(modified.OptionalInt64)
+		changed = true
+	}
+	switch v, o := modified.NillableInt, original.NillableInt; {
+	case v == nil && o != nil:
+		update.ClearNillableInt()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt8, original.NillableInt8; {
+	case v == nil && o != nil:
+		update.ClearNillableInt8()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt8(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt16, original.NillableInt16; {
+	case v == nil && o != nil:
+		update.ClearNillableInt16()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt16(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt32, original.NillableInt32; {
+	case v == nil && o != nil:
+		update.ClearNillableInt32()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt32(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt64, original.NillableInt64; {
+	case v == nil && o != nil:
+		update.ClearNillableInt64()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt64(*v)
+		changed = true
+	}
+	if modified.ValidateOptionalInt32 != original.ValidateOptionalInt32 {
+		update.SetValidateOptionalInt32(modified.ValidateOptionalInt32)
+		changed = true
+	}
+	if modified.OptionalUint != original.OptionalUint {
+		update.SetOptionalUint(modified.OptionalUint)
+		changed = true
+	}
+	if modified.OptionalUint8 != original.OptionalUint8 {
+		update.SetOptionalUint8(modified.OptionalUint8)
+		changed = true
+	}
+	if modified.OptionalUint16 != original.OptionalUint16 {
+		update.SetOptionalUint16(modified.OptionalUint16)
+		changed = true
+	}
+	if modified.OptionalUint32 != original.OptionalUint32 {
+		update.SetOptionalUint32(modified.OptionalUint32)
+		changed = true
+	}
+	if modified.OptionalUint64 != original.OptionalUint64 {
+		update.SetOptionalUint64(modified.OptionalUint64)
+		changed = true
+	}
+	if modified.State != original.State {
+		update.SetState(modified.State)
+		changed = true
+	}
+	if modified.OptionalFloat != original.OptionalFloat {
+		update.SetOptionalFloat(modified.OptionalFloat)
+		changed = true
+	}
+	if modified.OptionalFloat32 != original.OptionalFloat32 {
+		update.SetOptionalFloat32(modified.OptionalFloat32)
+		changed = true
+	}
+	if !modified.Datetime.Equal(original.Datetime) {
+		update.SetDatetime(modified.Datetime)
+		changed = true
+	}
+	if modified.Decimal != original.Decimal {
+		update.SetDecimal(modified.Decimal)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for FieldType.
 func (c *FieldTypeClient) Delete() *FieldTypeDelete {
 	mutation := newFieldTypeMutation(c.config, OpDelete)
@@ -472,9 +2689,168 @@ func (c *FieldTypeClient) GetX(ctx context.Context, id int) *FieldType {
 	return ft
 }
 
+// GetMany returns the FieldType entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *FieldTypeClient) GetMany(ctx context.Context, ids ...int) ([]*FieldType, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(fieldtype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*FieldType, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*FieldType, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FieldTypeClient) GetManyX(ctx context.Context, ids ...int) []*FieldType {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a FieldType from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *FieldTypeClient) FromMap(m map[string]string) (*FieldType, error) {
+	ft := &FieldType{config: c.config}
+	if err := kvDecode(m, fieldtype.FieldID, &ft.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt, &ft.Int, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt8, &ft.Int8, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt16, &ft.Int16, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt32, &ft.Int32, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt64, &ft.Int64, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt, &ft.OptionalInt, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt8, &ft.OptionalInt8, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt16, &ft.OptionalInt16, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt32, &ft.OptionalInt32, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt64, &ft.OptionalInt64, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[fieldtype.FieldNillableInt]; ok {
+		ft.NillableInt = new(int)
+		if err := kvDecode(m, fieldtype.FieldNillableInt, ft.NillableInt, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt8]; ok {
+		ft.NillableInt8 = new(int8)
+		if err := kvDecode(m, fieldtype.FieldNillableInt8, ft.NillableInt8, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt16]; ok {
+		ft.NillableInt16 = new(int16)
+		if err := kvDecode(m, fieldtype.FieldNillableInt16, ft.NillableInt16, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt32]; ok {
+		ft.NillableInt32 = new(int32)
+		if err := kvDecode(m, fieldtype.FieldNillableInt32, ft.NillableInt32, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt64]; ok {
+		ft.NillableInt64 = new(int64)
+		if err := kvDecode(m, fieldtype.FieldNillableInt64, ft.NillableInt64, true); err != nil {
+			return nil, err
+		}
+	}
+	if err := kvDecode(m, fieldtype.FieldValidateOptionalInt32, &ft.ValidateOptionalInt32, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[fieldtype.FieldValidateOptionalInt32]; ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(ft.ValidateOptionalInt32); err != nil {
+			return nil, &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint, &ft.OptionalUint, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint8, &ft.OptionalUint8, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint16, &ft.OptionalUint16, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint32, &ft.OptionalUint32, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint64, &ft.OptionalUint64, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldState, &ft.State, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[fieldtype.FieldState]; ok {
+		if err := fieldtype.StateValidator(ft.State); err != nil {
+			return nil, &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalFloat, &ft.OptionalFloat, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalFloat32, &ft.OptionalFloat32, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldDatetime, &ft.Datetime, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldDecimal, &ft.Decimal, false); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given FieldType entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *FieldTypeClient) LoadEdgeFor(ctx context.Context, nodes []*FieldType, edge string) error {
+	return fmt.Errorf("ent: unknown FieldType edge %q", edge)
+}
+
 // Hooks returns the client hooks.
 func (c *FieldTypeClient) Hooks() []Hook {
-	return c.hooks.FieldType
+	hooks := c.hooks.FieldType
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(fieldtype.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // FileClient is a client for the File schema.
@@ -493,10 +2869,372 @@ func (c *FileClient) Use(hooks ...Hook) {
 	c.hooks.File = append(c.hooks.File, hooks...)
 }
 
-// Create returns a create builder for File.
-func (c *FileClient) Create() *FileCreate {
-	mutation := newFileMutation(c.config, OpCreate)
-	return &FileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a create builder for File.
+func (c *FileClient) Create() *FileCreate {
+	mutation := newFileMutation(c.config, OpCreate)
+	return &FileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of File entities.
+func (c *FileClient) CreateBulk(builders ...*FileCreate) *FileCreateBulk {
+	return &FileCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads File builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.File.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *FileClient) CreateBulkFrom(ctx context.Context, ch <-chan *FileCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for File bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*FileCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*FileCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*FileCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the File that matches the given predicates, or creates it using
+// the given builder if there is no such File. The returned bool reports whether the
+// File was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the File is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.File.FindOrCreate(ctx, ps, client.File.Create())
+//
+func (c *FileClient) FindOrCreate(ctx context.Context, ps []predicate.File, create *FileCreate) (*File, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.File.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the File using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *FileClient) findOrCreate(ctx context.Context, ps []predicate.File, create *FileCreate) (*File, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the files table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the File columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown File columns are rejected before the statement is executed.
+//
+//	n, err := client.File.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		file.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *FileClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   file.Table,
+		Columns: append(file.Columns[:len(file.Columns):len(file.Columns)], file.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given File and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+// M2O edges are copied only if their foreign-keys were loaded by the query, i.e. if one of the
+// edges was eager-loaded (e.g. using With<Edge>).
+//
+//	node, err := client.File.CopyToCreate(f).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *FileClient) CopyToCreate(f *File) *FileCreate {
+	create := c.Create()
+	create.SetSize(f.Size)
+	create.SetName(f.Name)
+	if f.User != nil {
+		create.SetUser(*f.User)
+	}
+	if f.Group != "" {
+		create.SetGroup(f.Group)
+	}
+	if fk := f.user_files; fk != nil {
+		create.SetOwnerID(*fk)
+	}
+	if fk := f.file_type_files; fk != nil {
+		create.SetTypeID(*fk)
+	}
+	return create
+}
+
+// Sync reconciles the File entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.File.Sync(ctx, builders, []string{file.FieldName})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *FileClient) Sync(ctx context.Context, desired []*FileCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for File sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case file.FieldName, file.FieldUser:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for File sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&FileClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *FileClient) sync(ctx context.Context, desired []*FileCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.File, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in File builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: File builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*File)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*FileCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the File.
+func (f *File) syncValue(field string) Value {
+	switch field {
+	case file.FieldName:
+		return f.Name
+	case file.FieldUser:
+		if f.User == nil {
+			return nil
+		}
+		return *f.User
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// File. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (f *File) syncChanged(mutation *FileMutation) bool {
+	if v, ok := mutation.Size(); ok && (v != f.Size) {
+		return true
+	}
+	if v, ok := mutation.Name(); ok && (v != f.Name) {
+		return true
+	}
+	if v, ok := mutation.User(); ok && (f.User == nil || v != *f.User) {
+		return true
+	}
+	if v, ok := mutation.Group(); ok && (v != f.Group) {
+		return true
+	}
+	if ids := mutation.OwnerIDs(); len(ids) > 0 && (f.user_files == nil || *f.user_files != ids[0]) {
+		return true
+	}
+	if ids := mutation.TypeIDs(); len(ids) > 0 && (f.file_type_files == nil || *f.file_type_files != ids[0]) {
+		return true
+	}
+	return false
 }
 
 // Update returns an update builder for File.
@@ -517,6 +3255,172 @@ func (c *FileClient) UpdateOneID(id int) *FileUpdateOne {
 	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// FilePatch holds the field changes of a File for UpdateByIDMap, keyed by the field
+// name (e.g. file.FieldSize). A nil value clears an optional field.
+type FilePatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the File with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.File.UpdateByIDMap(ctx, map[int]ent.FilePatch{
+//		id1: {file.FieldSize: v1},
+//		id2: {file.FieldSize: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *FileClient) UpdateByIDMap(ctx context.Context, m map[int]FilePatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *FileClient) patchUpdates(m map[int]FilePatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newFileMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid File patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&FileUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(file.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, file.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(file.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given File entities, and updates the original File only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original File is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := f.Clone()
+//	modified.Field = value
+//	f, err = client.File.UpdateFromDiff(ctx, f, modified)
+//
+func (c *FileClient) UpdateFromDiff(ctx context.Context, original, modified *File) (*File, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for File UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Size != original.Size {
+		update.SetSize(modified.Size)
+		changed = true
+	}
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	switch v, o := modified.User, original.User; {
+	case v == nil && o != nil:
+		update.ClearUser()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetUser(*v)
+		changed = true
+	}
+	if modified.Group != original.Group {
+		update.SetGroup(modified.Group)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Owner, original.Edges.Owner; {
+		case v == nil && o != nil:
+			update.ClearOwner()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetOwnerID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[1] && original.Edges.loadedTypes[1] {
+		switch v, o := modified.Edges.Type, original.Edges.Type; {
+		case v == nil && o != nil:
+			update.ClearType()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetTypeID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for File.
 func (c *FileClient) Delete() *FileDelete {
 	mutation := newFileMutation(c.config, OpDelete)
@@ -555,6 +3459,69 @@ func (c *FileClient) GetX(ctx context.Context, id int) *File {
 	return f
 }
 
+// GetMany returns the File entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *FileClient) GetMany(ctx context.Context, ids ...int) ([]*File, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(file.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*File, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*File, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FileClient) GetManyX(ctx context.Context, ids ...int) []*File {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a File from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *FileClient) FromMap(m map[string]string) (*File, error) {
+	f := &File{config: c.config}
+	if err := kvDecode(m, file.FieldID, &f.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, file.FieldSize, &f.Size, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[file.FieldSize]; ok {
+		if err := file.SizeValidator(f.Size); err != nil {
+			return nil, &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, file.FieldName, &f.Name, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[file.FieldUser]; ok {
+		f.User = new(string)
+		if err := kvDecode(m, file.FieldUser, f.User, true); err != nil {
+			return nil, err
+		}
+	}
+	if err := kvDecode(m, file.FieldGroup, &f.Group, false); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
 // QueryOwner queries the owner edge of a File.
 func (c *FileClient) QueryOwner(f *File) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -603,9 +3570,120 @@ func (c *FileClient) QueryField(f *File) *FieldTypeQuery {
 	return query
 }
 
+// LoadFieldFor loads the "field" edge of all the given File entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithField for files that were already loaded.
+func (c *FileClient) LoadFieldFor(ctx context.Context, nodes []*File, opts ...func(*FieldTypeQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	fq := c.Query().WithField(opts...)
+	for _, node := range nodes {
+		node.Edges.Field = nil
+	}
+
+	if query := fq.withField; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*File)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = file.FieldColumn
+		err := fq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.FieldType(func(s *sql.Selector) {
+				s.Where(sql.InValues(file.FieldColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.file_field
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "file_field" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "file_field" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Field = append(node.Edges.Field, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[2] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given File entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *FileClient) LoadEdgeFor(ctx context.Context, nodes []*File, edge string) error {
+	var (
+		query  func(*FileQuery)
+		assign func(node, loaded *File)
+	)
+	switch edge {
+	case file.EdgeOwner:
+		query = func(q *FileQuery) { q.WithOwner() }
+		assign = func(node, loaded *File) {
+			node.Edges.Owner, node.Edges.loadedTypes[0] = loaded.Edges.Owner, true
+		}
+	case file.EdgeType:
+		query = func(q *FileQuery) { q.WithType() }
+		assign = func(node, loaded *File) {
+			node.Edges.Type, node.Edges.loadedTypes[1] = loaded.Edges.Type, true
+		}
+	case file.EdgeField:
+		return c.LoadFieldFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown File edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*File, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(file.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
-	return c.hooks.File
+	hooks := c.hooks.File
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(file.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // FileTypeClient is a client for the FileType schema.
@@ -630,6 +3708,331 @@ func (c *FileTypeClient) Create() *FileTypeCreate {
 	return &FileTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of FileType entities.
+func (c *FileTypeClient) CreateBulk(builders ...*FileTypeCreate) *FileTypeCreateBulk {
+	return &FileTypeCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads FileType builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.FileType.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *FileTypeClient) CreateBulkFrom(ctx context.Context, ch <-chan *FileTypeCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for FileType bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*FileTypeCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*FileTypeCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*FileTypeCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the FileType that matches the given predicates, or creates it using
+// the given builder if there is no such FileType. The returned bool reports whether the
+// FileType was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the FileType is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.FileType.FindOrCreate(ctx, ps, client.FileType.Create())
+//
+func (c *FileTypeClient) FindOrCreate(ctx context.Context, ps []predicate.FileType, create *FileTypeCreate) (*FileType, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.FileType.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the FileType using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *FileTypeClient) findOrCreate(ctx context.Context, ps []predicate.FileType, create *FileTypeCreate) (*FileType, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the file_types table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the FileType columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown FileType columns are rejected before the statement is executed.
+//
+//	n, err := client.FileType.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		filetype.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *FileTypeClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   filetype.Table,
+		Columns: filetype.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given FileType and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.FileType.CopyToCreate(ft).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *FileTypeClient) CopyToCreate(ft *FileType) *FileTypeCreate {
+	create := c.Create()
+	create.SetName(ft.Name)
+	return create
+}
+
+// Sync reconciles the FileType entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.FileType.Sync(ctx, builders, []string{filetype.FieldName})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *FileTypeClient) Sync(ctx context.Context, desired []*FileTypeCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for FileType sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case filetype.FieldName:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for FileType sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&FileTypeClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *FileTypeClient) sync(ctx context.Context, desired []*FileTypeCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.FileType, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in FileType builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: FileType builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*FileType)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*FileTypeCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the FileType.
+func (ft *FileType) syncValue(field string) Value {
+	switch field {
+	case filetype.FieldName:
+		return ft.Name
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// FileType. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (ft *FileType) syncChanged(mutation *FileTypeMutation) bool {
+	if v, ok := mutation.Name(); ok && (v != ft.Name) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for FileType.
 func (c *FileTypeClient) Update() *FileTypeUpdate {
 	mutation := newFileTypeMutation(c.config, OpUpdate)
@@ -648,6 +4051,136 @@ func (c *FileTypeClient) UpdateOneID(id int) *FileTypeUpdateOne {
 	return &FileTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// FileTypePatch holds the field changes of a FileType for UpdateByIDMap, keyed by the field
+// name (e.g. filetype.FieldName). A nil value clears an optional field.
+type FileTypePatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the FileType with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.FileType.UpdateByIDMap(ctx, map[int]ent.FileTypePatch{
+//		id1: {filetype.FieldName: v1},
+//		id2: {filetype.FieldName: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *FileTypeClient) UpdateByIDMap(ctx context.Context, m map[int]FileTypePatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *FileTypeClient) patchUpdates(m map[int]FileTypePatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newFileTypeMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid FileType patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&FileTypeUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(filetype.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, filetype.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(filetype.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given FileType entities, and updates the original FileType only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original FileType is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ft.Clone()
+//	modified.Field = value
+//	ft, err = client.FileType.UpdateFromDiff(ctx, ft, modified)
+//
+func (c *FileTypeClient) UpdateFromDiff(ctx context.Context, original, modified *FileType) (*FileType, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for FileType UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for FileType.
 func (c *FileTypeClient) Delete() *FileTypeDelete {
 	mutation := newFileTypeMutation(c.config, OpDelete)
@@ -686,6 +4219,52 @@ func (c *FileTypeClient) GetX(ctx context.Context, id int) *FileType {
 	return ft
 }
 
+// GetMany returns the FileType entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *FileTypeClient) GetMany(ctx context.Context, ids ...int) ([]*FileType, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(filetype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*FileType, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*FileType, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FileTypeClient) GetManyX(ctx context.Context, ids ...int) []*FileType {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a FileType from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *FileTypeClient) FromMap(m map[string]string) (*FileType, error) {
+	ft := &FileType{config: c.config}
+	if err := kvDecode(m, filetype.FieldID, &ft.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, filetype.FieldName, &ft.Name, true); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
 // QueryFiles queries the files edge of a FileType.
 func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -699,12 +4278,87 @@ func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 		fromV = sqlgraph.Neighbors(ft.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// LoadFilesFor loads the "files" edge of all the given FileType entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithFiles for filetypes that were already loaded.
+func (c *FileTypeClient) LoadFilesFor(ctx context.Context, nodes []*FileType, opts ...func(*FileQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	ftq := c.Query().WithFiles(opts...)
+	for _, node := range nodes {
+		node.Edges.Files = nil
+	}
+
+	if query := ftq.withFiles; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*FileType)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = filetype.FilesColumn
+		err := ftq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.File(func(s *sql.Selector) {
+				s.Where(sql.InValues(filetype.FilesColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.file_type_files
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "file_type_files" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "file_type_files" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Files = append(node.Edges.Files, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given FileType entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *FileTypeClient) LoadEdgeFor(ctx context.Context, nodes []*FileType, edge string) error {
+	switch edge {
+	case filetype.EdgeFiles:
+		return c.LoadFilesFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown FileType edge %q", edge)
+	}
 }
 
 // Hooks returns the client hooks.
 func (c *FileTypeClient) Hooks() []Hook {
-	return c.hooks.FileType
+	hooks := c.hooks.FileType
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(filetype.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // GroupClient is a client for the Group schema.
@@ -729,6 +4383,188 @@ func (c *GroupClient) Create() *GroupCreate {
 	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Group builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Group.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *GroupClient) CreateBulkFrom(ctx context.Context, ch <-chan *GroupCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Group bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*GroupCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*GroupCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*GroupCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Group that matches the given predicates, or creates it using
+// the given builder if there is no such Group. The returned bool reports whether the
+// Group was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Group is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Group.FindOrCreate(ctx, ps, client.Group.Create())
+//
+func (c *GroupClient) FindOrCreate(ctx context.Context, ps []predicate.Group, create *GroupCreate) (*Group, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Group.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Group using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *GroupClient) findOrCreate(ctx context.Context, ps []predicate.Group, create *GroupCreate) (*Group, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the groups table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Group columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Group columns are rejected before the statement is executed.
+//
+//	n, err := client.Group.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		group.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *GroupClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   group.Table,
+		Columns: append(group.Columns[:len(group.Columns):len(group.Columns)], group.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Group and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+// M2O edges are copied only if their foreign-keys were loaded by the query, i.e. if one of the
+// edges was eager-loaded (e.g. using With<Edge>).
+//
+//	node, err := client.Group.CopyToCreate(gr).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *GroupClient) CopyToCreate(gr *Group) *GroupCreate {
+	create := c.Create()
+	create.SetActive(gr.Active)
+	create.SetExpire(gr.Expire)
+	if gr.Type != nil {
+		create.SetType(*gr.Type)
+	}
+	if gr.MaxUsers != 0 {
+		create.SetMaxUsers(gr.MaxUsers)
+	}
+	create.SetName(gr.Name)
+	if fk := gr.group_info; fk != nil {
+		create.SetInfoID(*fk)
+	}
+	return create
+}
+
 // Update returns an update builder for Group.
 func (c *GroupClient) Update() *GroupUpdate {
 	mutation := newGroupMutation(c.config, OpUpdate)
@@ -747,6 +4583,166 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// GroupPatch holds the field changes of a Group for UpdateByIDMap, keyed by the field
+// name (e.g. group.FieldActive). A nil value clears an optional field.
+type GroupPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Group with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Group.UpdateByIDMap(ctx, map[int]ent.GroupPatch{
+//		id1: {group.FieldActive: v1},
+//		id2: {group.FieldActive: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *GroupClient) UpdateByIDMap(ctx context.Context, m map[int]GroupPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *GroupClient) patchUpdates(m map[int]GroupPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newGroupMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Group patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&GroupUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(group.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, group.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(group.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Group entities, and updates the original Group only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Group is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := gr.Clone()
+//	modified.Field = value
+//	gr, err = client.Group.UpdateFromDiff(ctx, gr, modified)
+//
+func (c *GroupClient) UpdateFromDiff(ctx context.Context, original, modified *Group) (*Group, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Group UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Active != original.Active {
+		update.SetActive(modified.Active)
+		changed = true
+	}
+	if !modified.Expire.Equal(original.Expire) {
+		update.SetExpire(modified.Expire)
+		changed = true
+	}
+	switch v, o := modified.Type, original.Type; {
+	case v == nil && o != nil:
+		update.ClearType()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetType(*v)
+		changed = true
+	}
+	if modified.MaxUsers != original.MaxUsers {
+		update.SetMaxUsers(modified.MaxUsers)
+		changed = true
+	}
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[3] && original.Edges.loadedTypes[3] {
+		switch v, o := modified.Edges.Info, original.Edges.Info; {
+		case v == nil && o != nil:
+			update.ClearInfo()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetInfoID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -785,6 +4781,82 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 	return gr
 }
 
+// GetMany returns the Group entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *GroupClient) GetMany(ctx context.Context, ids ...int) ([]*Group, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Group, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Group, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupClient) GetManyX(ctx context.Context, ids ...int) []*Group {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Group from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *GroupClient) FromMap(m map[string]string) (*Group, error) {
+	gr := &Group{config: c.config}
+	if err := kvDecode(m, group.FieldID, &gr.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, group.FieldActive, &gr.Active, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, group.FieldExpire, &gr.Expire, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[group.FieldType]; ok {
+		gr.Type = new(string)
+		if err := kvDecode(m, group.FieldType, gr.Type, true); err != nil {
+			return nil, err
+		}
+	}
+	if gr.Type != nil {
+		if err := group.TypeValidator(*gr.Type); err != nil {
+			return nil, &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, group.FieldMaxUsers, &gr.MaxUsers, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[group.FieldMaxUsers]; ok {
+		if err := group.MaxUsersValidator(gr.MaxUsers); err != nil {
+			return nil, &ValidationError{Name: "max_users", err: fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, group.FieldName, &gr.Name, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[group.FieldName]; ok {
+		if err := group.NameValidator(gr.Name); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	return gr, nil
+}
+
 // QueryFiles queries the files edge of a Group.
 func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -849,9 +4921,300 @@ func (c *GroupClient) QueryInfo(gr *Group) *GroupInfoQuery {
 	return query
 }
 
+// LoadFilesFor loads the "files" edge of all the given Group entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithFiles for groups that were already loaded.
+func (c *GroupClient) LoadFilesFor(ctx context.Context, nodes []*Group, opts ...func(*FileQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	gq := c.Query().WithFiles(opts...)
+	for _, node := range nodes {
+		node.Edges.Files = nil
+	}
+
+	if query := gq.withFiles; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Group)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = group.FilesColumn
+		err := gq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.File(func(s *sql.Selector) {
+				s.Where(sql.InValues(group.FilesColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.group_files
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "group_files" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "group_files" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Files = append(node.Edges.Files, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadBlockedFor loads the "blocked" edge of all the given Group entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithBlocked for groups that were already loaded.
+func (c *GroupClient) LoadBlockedFor(ctx context.Context, nodes []*Group, opts ...func(*UserQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	gq := c.Query().WithBlocked(opts...)
+	for _, node := range nodes {
+		node.Edges.Blocked = nil
+	}
+
+	if query := gq.withBlocked; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Group)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = group.BlockedColumn
+		err := gq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.User(func(s *sql.Selector) {
+				s.Where(sql.InValues(group.BlockedColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.group_blocked
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "group_blocked" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "group_blocked" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Blocked = append(node.Edges.Blocked, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[1] = true
+	}
+	return nil
+}
+
+// LoadUsersFor loads the "users" edge of all the given Group entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithUsers for groups that were already loaded.
+func (c *GroupClient) LoadUsersFor(ctx context.Context, nodes []*Group, opts ...func(*UserQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	gq := c.Query().WithUsers(opts...)
+	for _, node := range nodes {
+		node.Edges.Users = nil
+	}
+
+	if query := gq.withUsers; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*Group, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*Group)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   group.UsersTable,
+				Columns: group.UsersPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := gq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(group.UsersPrimaryKey[1], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, gq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "users": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && gq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "users" cannot be applied on %d neighbors with batch size %d`, len(edgeids), gq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = gq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "users" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Users = append(nodes[i].Edges.Users, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Users
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Users = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[2] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Group entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *GroupClient) LoadEdgeFor(ctx context.Context, nodes []*Group, edge string) error {
+	var (
+		query  func(*GroupQuery)
+		assign func(node, loaded *Group)
+	)
+	switch edge {
+	case group.EdgeFiles:
+		return c.LoadFilesFor(ctx, nodes)
+	case group.EdgeBlocked:
+		return c.LoadBlockedFor(ctx, nodes)
+	case group.EdgeUsers:
+		return c.LoadUsersFor(ctx, nodes)
+	case group.EdgeInfo:
+		query = func(q *GroupQuery) { q.WithInfo() }
+		assign = func(node, loaded *Group) {
+			node.Edges.Info, node.Edges.loadedTypes[3] = loaded.Edges.Info, true
+		}
+	default:
+		return fmt.Errorf("ent: unknown Group edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*Group, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(group.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
-	return c.hooks.Group
+	hooks := c.hooks.Group
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(group.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // GroupInfoClient is a client for the GroupInfo schema.
@@ -876,6 +5239,175 @@ func (c *GroupInfoClient) Create() *GroupInfoCreate {
 	return &GroupInfoCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of GroupInfo entities.
+func (c *GroupInfoClient) CreateBulk(builders ...*GroupInfoCreate) *GroupInfoCreateBulk {
+	return &GroupInfoCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads GroupInfo builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.GroupInfo.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *GroupInfoClient) CreateBulkFrom(ctx context.Context, ch <-chan *GroupInfoCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for GroupInfo bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*GroupInfoCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*GroupInfoCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*GroupInfoCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the GroupInfo that matches the given predicates, or creates it using
+// the given builder if there is no such GroupInfo. The returned bool reports whether the
+// GroupInfo was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the GroupInfo is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.GroupInfo.FindOrCreate(ctx, ps, client.GroupInfo.Create())
+//
+func (c *GroupInfoClient) FindOrCreate(ctx context.Context, ps []predicate.GroupInfo, create *GroupInfoCreate) (*GroupInfo, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.GroupInfo.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the GroupInfo using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *GroupInfoClient) findOrCreate(ctx context.Context, ps []predicate.GroupInfo, create *GroupInfoCreate) (*GroupInfo, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the group_infos table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the GroupInfo columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown GroupInfo columns are rejected before the statement is executed.
+//
+//	n, err := client.GroupInfo.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		groupinfo.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *GroupInfoClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   groupinfo.Table,
+		Columns: groupinfo.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given GroupInfo and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.GroupInfo.CopyToCreate(gi).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *GroupInfoClient) CopyToCreate(gi *GroupInfo) *GroupInfoCreate {
+	create := c.Create()
+	create.SetDesc(gi.Desc)
+	create.SetMaxUsers(gi.MaxUsers)
+	return create
+}
+
 // Update returns an update builder for GroupInfo.
 func (c *GroupInfoClient) Update() *GroupInfoUpdate {
 	mutation := newGroupInfoMutation(c.config, OpUpdate)
@@ -894,6 +5426,140 @@ func (c *GroupInfoClient) UpdateOneID(id int) *GroupInfoUpdateOne {
 	return &GroupInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// GroupInfoPatch holds the field changes of a GroupInfo for UpdateByIDMap, keyed by the field
+// name (e.g. groupinfo.FieldDesc). A nil value clears an optional field.
+type GroupInfoPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the GroupInfo with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.GroupInfo.UpdateByIDMap(ctx, map[int]ent.GroupInfoPatch{
+//		id1: {groupinfo.FieldDesc: v1},
+//		id2: {groupinfo.FieldDesc: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *GroupInfoClient) UpdateByIDMap(ctx context.Context, m map[int]GroupInfoPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *GroupInfoClient) patchUpdates(m map[int]GroupInfoPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newGroupInfoMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid GroupInfo patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&GroupInfoUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(groupinfo.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, groupinfo.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(groupinfo.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given GroupInfo entities, and updates the original GroupInfo only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original GroupInfo is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := gi.Clone()
+//	modified.Field = value
+//	gi, err = client.GroupInfo.UpdateFromDiff(ctx, gi, modified)
+//
+func (c *GroupInfoClient) UpdateFromDiff(ctx context.Context, original, modified *GroupInfo) (*GroupInfo, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for GroupInfo UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Desc != original.Desc {
+		update.SetDesc(modified.Desc)
+		changed = true
+	}
+	if modified.MaxUsers != original.MaxUsers {
+		update.SetMaxUsers(modified.MaxUsers)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for GroupInfo.
 func (c *GroupInfoClient) Delete() *GroupInfoDelete {
 	mutation := newGroupInfoMutation(c.config, OpDelete)
@@ -932,6 +5598,55 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id int) *GroupInfo {
 	return gi
 }
 
+// GetMany returns the GroupInfo entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *GroupInfoClient) GetMany(ctx context.Context, ids ...int) ([]*GroupInfo, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(groupinfo.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*GroupInfo, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*GroupInfo, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupInfoClient) GetManyX(ctx context.Context, ids ...int) []*GroupInfo {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a GroupInfo from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *GroupInfoClient) FromMap(m map[string]string) (*GroupInfo, error) {
+	gi := &GroupInfo{config: c.config}
+	if err := kvDecode(m, groupinfo.FieldID, &gi.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, groupinfo.FieldDesc, &gi.Desc, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, groupinfo.FieldMaxUsers, &gi.MaxUsers, true); err != nil {
+		return nil, err
+	}
+	return gi, nil
+}
+
 // QueryGroups queries the groups edge of a GroupInfo.
 func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 	query := &GroupQuery{config: c.config}
@@ -945,12 +5660,87 @@ func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 		fromV = sqlgraph.Neighbors(gi.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// LoadGroupsFor loads the "groups" edge of all the given GroupInfo entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithGroups for groupinfos that were already loaded.
+func (c *GroupInfoClient) LoadGroupsFor(ctx context.Context, nodes []*GroupInfo, opts ...func(*GroupQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	giq := c.Query().WithGroups(opts...)
+	for _, node := range nodes {
+		node.Edges.Groups = nil
+	}
+
+	if query := giq.withGroups; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*GroupInfo)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = groupinfo.GroupsColumn
+		err := giq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Group(func(s *sql.Selector) {
+				s.Where(sql.InValues(groupinfo.GroupsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.group_info
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "group_info" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "group_info" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Groups = append(node.Edges.Groups, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given GroupInfo entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *GroupInfoClient) LoadEdgeFor(ctx context.Context, nodes []*GroupInfo, edge string) error {
+	switch edge {
+	case groupinfo.EdgeGroups:
+		return c.LoadGroupsFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown GroupInfo edge %q", edge)
+	}
 }
 
 // Hooks returns the client hooks.
 func (c *GroupInfoClient) Hooks() []Hook {
-	return c.hooks.GroupInfo
+	hooks := c.hooks.GroupInfo
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(groupinfo.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // ItemClient is a client for the Item schema.
@@ -975,6 +5765,175 @@ func (c *ItemClient) Create() *ItemCreate {
 	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Item entities.
+func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Item builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Item.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *ItemClient) CreateBulkFrom(ctx context.Context, ch <-chan *ItemCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Item bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*ItemCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*ItemCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*ItemCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Item that matches the given predicates, or creates it using
+// the given builder if there is no such Item. The returned bool reports whether the
+// Item was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Item is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Item.FindOrCreate(ctx, ps, client.Item.Create())
+//
+func (c *ItemClient) FindOrCreate(ctx context.Context, ps []predicate.Item, create *ItemCreate) (*Item, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Item.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Item using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *ItemClient) findOrCreate(ctx context.Context, ps []predicate.Item, create *ItemCreate) (*Item, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the items table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Item columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Item columns are rejected before the statement is executed.
+//
+//	n, err := client.Item.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		item.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *ItemClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   item.Table,
+		Columns: item.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Item and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Item.CopyToCreate(i).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *ItemClient) CopyToCreate(i *Item) *ItemCreate {
+	create := c.Create()
+	create.SetCreatedAt(i.CreatedAt)
+	create.SetPriority(i.Priority)
+	return create
+}
+
 // Update returns an update builder for Item.
 func (c *ItemClient) Update() *ItemUpdate {
 	mutation := newItemMutation(c.config, OpUpdate)
@@ -993,6 +5952,140 @@ func (c *ItemClient) UpdateOneID(id int) *ItemUpdateOne {
 	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// ItemPatch holds the field changes of a Item for UpdateByIDMap, keyed by the field
+// name (e.g. item.FieldCreatedAt). A nil value clears an optional field.
+type ItemPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Item with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Item.UpdateByIDMap(ctx, map[int]ent.ItemPatch{
+//		id1: {item.FieldCreatedAt: v1},
+//		id2: {item.FieldCreatedAt: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *ItemClient) UpdateByIDMap(ctx context.Context, m map[int]ItemPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *ItemClient) patchUpdates(m map[int]ItemPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newItemMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Item patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&ItemUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(item.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, item.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(item.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Item entities, and updates the original Item only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Item is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := i.Clone()
+//	modified.Field = value
+//	i, err = client.Item.UpdateFromDiff(ctx, i, modified)
+//
+func (c *ItemClient) UpdateFromDiff(ctx context.Context, original, modified *Item) (*Item, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Item UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if !modified.CreatedAt.Equal(original.CreatedAt) {
+		update.SetCreatedAt(modified.CreatedAt)
+		changed = true
+	}
+	if modified.Priority != original.Priority {
+		update.SetPriority(modified.Priority)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Item.
 func (c *ItemClient) Delete() *ItemDelete {
 	mutation := newItemMutation(c.config, OpDelete)
@@ -1031,9 +6124,71 @@ func (c *ItemClient) GetX(ctx context.Context, id int) *Item {
 	return i
 }
 
+// GetMany returns the Item entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *ItemClient) GetMany(ctx context.Context, ids ...int) ([]*Item, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(item.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Item, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Item, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *ItemClient) GetManyX(ctx context.Context, ids ...int) []*Item {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Item from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *ItemClient) FromMap(m map[string]string) (*Item, error) {
+	i := &Item{config: c.config}
+	if err := kvDecode(m, item.FieldID, &i.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, item.FieldCreatedAt, &i.CreatedAt, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, item.FieldPriority, &i.Priority, true); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Item entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *ItemClient) LoadEdgeFor(ctx context.Context, nodes []*Item, edge string) error {
+	return fmt.Errorf("ent: unknown Item edge %q", edge)
+}
+
 // Hooks returns the client hooks.
 func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+	hooks := c.hooks.Item
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(item.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // NodeClient is a client for the Node schema.
@@ -1058,6 +6213,176 @@ func (c *NodeClient) Create() *NodeCreate {
 	return &NodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Node entities.
+func (c *NodeClient) CreateBulk(builders ...*NodeCreate) *NodeCreateBulk {
+	return &NodeCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Node builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Node.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *NodeClient) CreateBulkFrom(ctx context.Context, ch <-chan *NodeCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Node bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*NodeCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*NodeCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*NodeCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Node that matches the given predicates, or creates it using
+// the given builder if there is no such Node. The returned bool reports whether the
+// Node was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Node is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Node.FindOrCreate(ctx, ps, client.Node.Create())
+//
+func (c *NodeClient) FindOrCreate(ctx context.Context, ps []predicate.Node, create *NodeCreate) (*Node, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Node.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Node using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *NodeClient) findOrCreate(ctx context.Context, ps []predicate.Node, create *NodeCreate) (*Node, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the nodes table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Node columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Node columns are rejected before the statement is executed.
+//
+//	n, err := client.Node.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		node.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *NodeClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   node.Table,
+		Columns: append(node.Columns[:len(node.Columns):len(node.Columns)], node.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Node and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Node.CopyToCreate(n).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *NodeClient) CopyToCreate(n *Node) *NodeCreate {
+	create := c.Create()
+	if n.Value != 0 {
+		create.SetValue(n.Value)
+	}
+	return create
+}
+
 // Update returns an update builder for Node.
 func (c *NodeClient) Update() *NodeUpdate {
 	mutation := newNodeMutation(c.config, OpUpdate)
@@ -1076,6 +6401,156 @@ func (c *NodeClient) UpdateOneID(id int) *NodeUpdateOne {
 	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// NodePatch holds the field changes of a Node for UpdateByIDMap, keyed by the field
+// name (e.g. node.FieldValue). A nil value clears an optional field.
+type NodePatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Node with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Node.UpdateByIDMap(ctx, map[int]ent.NodePatch{
+//		id1: {node.FieldValue: v1},
+//		id2: {node.FieldValue: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *NodeClient) UpdateByIDMap(ctx context.Context, m map[int]NodePatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *NodeClient) patchUpdates(m map[int]NodePatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newNodeMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Node patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&NodeUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(node.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, node.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(node.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Node entities, and updates the original Node only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Node is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := n.Clone()
+//	modified.Field = value
+//	n, err = client.Node.UpdateFromDiff(ctx, n, modified)
+//
+func (c *NodeClient) UpdateFromDiff(ctx context.Context, original, modified *Node) (*Node, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Node UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Value != original.Value {
+		update.SetValue(modified.Value)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Prev, original.Edges.Prev; {
+		case v == nil && o != nil:
+			update.ClearPrev()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetPrevID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[1] && original.Edges.loadedTypes[1] {
+		switch v, o := modified.Edges.Next, original.Edges.Next; {
+		case v == nil && o != nil:
+			update.ClearNext()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetNextID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Node.
 func (c *NodeClient) Delete() *NodeDelete {
 	mutation := newNodeMutation(c.config, OpDelete)
@@ -1114,6 +6589,52 @@ func (c *NodeClient) GetX(ctx context.Context, id int) *Node {
 	return n
 }
 
+// GetMany returns the Node entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *NodeClient) GetMany(ctx context.Context, ids ...int) ([]*Node, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(node.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Node, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Node, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *NodeClient) GetManyX(ctx context.Context, ids ...int) []*Node {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Node from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *NodeClient) FromMap(m map[string]string) (*Node, error) {
+	n := &Node{config: c.config}
+	if err := kvDecode(m, node.FieldID, &n.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, node.FieldValue, &n.Value, false); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 // QueryPrev queries the prev edge of a Node.
 func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 	query := &NodeQuery{config: c.config}
@@ -1146,9 +6667,61 @@ func (c *NodeClient) QueryNext(n *Node) *NodeQuery {
 	return query
 }
 
+// LoadEdgeFor loads the edge with the given name of all the given Node entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *NodeClient) LoadEdgeFor(ctx context.Context, nodes []*Node, edge string) error {
+	var (
+		query  func(*NodeQuery)
+		assign func(node, loaded *Node)
+	)
+	switch edge {
+	case node.EdgePrev:
+		query = func(q *NodeQuery) { q.WithPrev() }
+		assign = func(node, loaded *Node) {
+			node.Edges.Prev, node.Edges.loadedTypes[0] = loaded.Edges.Prev, true
+		}
+	case node.EdgeNext:
+		query = func(q *NodeQuery) { q.WithNext() }
+		assign = func(node, loaded *Node) {
+			node.Edges.Next, node.Edges.loadedTypes[1] = loaded.Edges.Next, true
+		}
+	default:
+		return fmt.Errorf("ent: unknown Node edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*Node, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(node.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *NodeClient) Hooks() []Hook {
-	return c.hooks.Node
+	hooks := c.hooks.Node
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(node.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // PetClient is a client for the Pet schema.
@@ -1173,6 +6746,180 @@ func (c *PetClient) Create() *PetCreate {
 	return &PetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Pet entities.
+func (c *PetClient) CreateBulk(builders ...*PetCreate) *PetCreateBulk {
+	return &PetCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Pet builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Pet.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *PetClient) CreateBulkFrom(ctx context.Context, ch <-chan *PetCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Pet bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*PetCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*PetCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*PetCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Pet that matches the given predicates, or creates it using
+// the given builder if there is no such Pet. The returned bool reports whether the
+// Pet was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Pet is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Pet.FindOrCreate(ctx, ps, client.Pet.Create())
+//
+func (c *PetClient) FindOrCreate(ctx context.Context, ps []predicate.Pet, create *PetCreate) (*Pet, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Pet.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Pet using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *PetClient) findOrCreate(ctx context.Context, ps []predicate.Pet, create *PetCreate) (*Pet, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the pets table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Pet columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Pet columns are rejected before the statement is executed.
+//
+//	n, err := client.Pet.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		pet.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *PetClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   pet.Table,
+		Columns: append(pet.Columns[:len(pet.Columns):len(pet.Columns)], pet.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Pet and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+// M2O edges are copied only if their foreign-keys were loaded by the query, i.e. if one of the
+// edges was eager-loaded (e.g. using With<Edge>).
+//
+//	node, err := client.Pet.CopyToCreate(pe).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *PetClient) CopyToCreate(pe *Pet) *PetCreate {
+	create := c.Create()
+	create.SetName(pe.Name)
+	if fk := pe.user_pets; fk != nil {
+		create.SetOwnerID(*fk)
+	}
+	return create
+}
+
 // Update returns an update builder for Pet.
 func (c *PetClient) Update() *PetUpdate {
 	mutation := newPetMutation(c.config, OpUpdate)
@@ -1191,6 +6938,156 @@ func (c *PetClient) UpdateOneID(id int) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// PetPatch holds the field changes of a Pet for UpdateByIDMap, keyed by the field
+// name (e.g. pet.FieldName). A nil value clears an optional field.
+type PetPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Pet with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Pet.UpdateByIDMap(ctx, map[int]ent.PetPatch{
+//		id1: {pet.FieldName: v1},
+//		id2: {pet.FieldName: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *PetClient) UpdateByIDMap(ctx context.Context, m map[int]PetPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *PetClient) patchUpdates(m map[int]PetPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newPetMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Pet patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&PetUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(pet.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, pet.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(pet.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Pet entities, and updates the original Pet only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Pet is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := pe.Clone()
+//	modified.Field = value
+//	pe, err = client.Pet.UpdateFromDiff(ctx, pe, modified)
+//
+func (c *PetClient) UpdateFromDiff(ctx context.Context, original, modified *Pet) (*Pet, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Pet UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Team, original.Edges.Team; {
+		case v == nil && o != nil:
+			update.ClearTeam()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetTeamID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[1] && original.Edges.loadedTypes[1] {
+		switch v, o := modified.Edges.Owner, original.Edges.Owner; {
+		case v == nil && o != nil:
+			update.ClearOwner()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetOwnerID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -1229,6 +7126,52 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 	return pe
 }
 
+// GetMany returns the Pet entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *PetClient) GetMany(ctx context.Context, ids ...int) ([]*Pet, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Pet, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Pet, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *PetClient) GetManyX(ctx context.Context, ids ...int) []*Pet {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Pet from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *PetClient) FromMap(m map[string]string) (*Pet, error) {
+	pe := &Pet{config: c.config}
+	if err := kvDecode(m, pet.FieldID, &pe.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, pet.FieldName, &pe.Name, true); err != nil {
+		return nil, err
+	}
+	return pe, nil
+}
+
 // QueryTeam queries the team edge of a Pet.
 func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -1261,9 +7204,61 @@ func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
 	return query
 }
 
+// LoadEdgeFor loads the edge with the given name of all the given Pet entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *PetClient) LoadEdgeFor(ctx context.Context, nodes []*Pet, edge string) error {
+	var (
+		query  func(*PetQuery)
+		assign func(node, loaded *Pet)
+	)
+	switch edge {
+	case pet.EdgeTeam:
+		query = func(q *PetQuery) { q.WithTeam() }
+		assign = func(node, loaded *Pet) {
+			node.Edges.Team, node.Edges.loadedTypes[0] = loaded.Edges.Team, true
+		}
+	case pet.EdgeOwner:
+		query = func(q *PetQuery) { q.WithOwner() }
+		assign = func(node, loaded *Pet) {
+			node.Edges.Owner, node.Edges.loadedTypes[1] = loaded.Edges.Owner, true
+		}
+	default:
+		return fmt.Errorf("ent: unknown Pet edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*Pet, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(pet.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *PetClient) Hooks() []Hook {
-	return c.hooks.Pet
+	hooks := c.hooks.Pet
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(pet.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // SpecClient is a client for the Spec schema.
@@ -1288,6 +7283,333 @@ func (c *SpecClient) Create() *SpecCreate {
 	return &SpecCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Spec entities.
+func (c *SpecClient) CreateBulk(builders ...*SpecCreate) *SpecCreateBulk {
+	return &SpecCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Spec builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Spec.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *SpecClient) CreateBulkFrom(ctx context.Context, ch <-chan *SpecCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Spec bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*SpecCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*SpecCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*SpecCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Spec that matches the given predicates, or creates it using
+// the given builder if there is no such Spec. The returned bool reports whether the
+// Spec was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Spec is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Spec.FindOrCreate(ctx, ps, client.Spec.Create())
+//
+func (c *SpecClient) FindOrCreate(ctx context.Context, ps []predicate.Spec, create *SpecCreate) (*Spec, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Spec.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Spec using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *SpecClient) findOrCreate(ctx context.Context, ps []predicate.Spec, create *SpecCreate) (*Spec, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the specs table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Spec columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Spec columns are rejected before the statement is executed.
+//
+//	n, err := client.Spec.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		spec.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *SpecClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   spec.Table,
+		Columns: spec.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Spec and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Spec.CopyToCreate(s).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *SpecClient) CopyToCreate(s *Spec) *SpecCreate {
+	create := c.Create()
+	if s.Name != "" {
+		create.SetName(s.Name)
+	}
+	return create
+}
+
+// Sync reconciles the Spec entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.Spec.Sync(ctx, builders, []string{spec.FieldName})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *SpecClient) Sync(ctx context.Context, desired []*SpecCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for Spec sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case spec.FieldName:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for Spec sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&SpecClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *SpecClient) sync(ctx context.Context, desired []*SpecCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.Spec, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in Spec builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: Spec builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Spec)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*SpecCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the Spec.
+func (s *Spec) syncValue(field string) Value {
+	switch field {
+	case spec.FieldName:
+		return s.Name
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// Spec. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (s *Spec) syncChanged(mutation *SpecMutation) bool {
+	if v, ok := mutation.Name(); ok && (v != s.Name) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for Spec.
 func (c *SpecClient) Update() *SpecUpdate {
 	mutation := newSpecMutation(c.config, OpUpdate)
@@ -1306,6 +7628,136 @@ func (c *SpecClient) UpdateOneID(id int) *SpecUpdateOne {
 	return &SpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// SpecPatch holds the field changes of a Spec for UpdateByIDMap, keyed by the field
+// name (e.g. spec.FieldName). A nil value clears an optional field.
+type SpecPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Spec with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Spec.UpdateByIDMap(ctx, map[int]ent.SpecPatch{
+//		id1: {spec.FieldName: v1},
+//		id2: {spec.FieldName: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *SpecClient) UpdateByIDMap(ctx context.Context, m map[int]SpecPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *SpecClient) patchUpdates(m map[int]SpecPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newSpecMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Spec patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&SpecUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(spec.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, spec.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(spec.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Spec entities, and updates the original Spec only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Spec is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := s.Clone()
+//	modified.Field = value
+//	s, err = client.Spec.UpdateFromDiff(ctx, s, modified)
+//
+func (c *SpecClient) UpdateFromDiff(ctx context.Context, original, modified *Spec) (*Spec, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Spec UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Spec.
 func (c *SpecClient) Delete() *SpecDelete {
 	mutation := newSpecMutation(c.config, OpDelete)
@@ -1344,6 +7796,52 @@ func (c *SpecClient) GetX(ctx context.Context, id int) *Spec {
 	return s
 }
 
+// GetMany returns the Spec entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *SpecClient) GetMany(ctx context.Context, ids ...int) ([]*Spec, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(spec.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Spec, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Spec, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *SpecClient) GetManyX(ctx context.Context, ids ...int) []*Spec {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Spec from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *SpecClient) FromMap(m map[string]string) (*Spec, error) {
+	s := &Spec{config: c.config}
+	if err := kvDecode(m, spec.FieldID, &s.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, spec.FieldName, &s.Name, false); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // QueryCard queries the card edge of a Spec.
 func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
 	query := &CardQuery{config: c.config}
@@ -1357,34 +7855,558 @@ func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
 		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
-}
-
-// Hooks returns the client hooks.
-func (c *SpecClient) Hooks() []Hook {
-	return c.hooks.Spec
+	return query
+}
+
+// LoadCardFor loads the "card" edge of all the given Spec entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithCard for specs that were already loaded.
+func (c *SpecClient) LoadCardFor(ctx context.Context, nodes []*Spec, opts ...func(*CardQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	sq := c.Query().WithCard(opts...)
+	for _, node := range nodes {
+		node.Edges.Card = nil
+	}
+
+	if query := sq.withCard; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*Spec, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*Spec)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: false,
+				Table:   spec.CardTable,
+				Columns: spec.CardPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := sq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(spec.CardPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, sq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "card": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && sq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "card" cannot be applied on %d neighbors with batch size %d`, len(edgeids), sq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = sq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], card.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "card" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Card = append(nodes[i].Edges.Card, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Card
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Card = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Spec entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *SpecClient) LoadEdgeFor(ctx context.Context, nodes []*Spec, edge string) error {
+	switch edge {
+	case spec.EdgeCard:
+		return c.LoadCardFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown Spec edge %q", edge)
+	}
+}
+
+// Hooks returns the client hooks.
+func (c *SpecClient) Hooks() []Hook {
+	hooks := c.hooks.Spec
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(spec.Label)}, hooks...)
+	}
+	return hooks
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Create returns a create builder for User.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads User builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.User.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *UserClient) CreateBulkFrom(ctx context.Context, ch <-chan *UserCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for User bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*UserCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*UserCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*UserCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the User that matches the given predicates, or creates it using
+// the given builder if there is no such User. The returned bool reports whether the
+// User was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the User is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.User.FindOrCreate(ctx, ps, client.User.Create())
+//
+func (c *UserClient) FindOrCreate(ctx context.Context, ps []predicate.User, create *UserCreate) (*User, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.User.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the User using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *UserClient) findOrCreate(ctx context.Context, ps []predicate.User, create *UserCreate) (*User, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the users table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the User columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown User columns are rejected before the statement is executed.
+//
+//	n, err := client.User.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		user.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *UserClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   user.Table,
+		Columns: append(user.Columns[:len(user.Columns):len(user.Columns)], user.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given User and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+// M2O edges are copied only if their foreign-keys were loaded by the query, i.e. if one of the
+// edges was eager-loaded (e.g. using With<Edge>).
+//
+//	node, err := client.User.CopyToCreate(u).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *UserClient) CopyToCreate(u *User) *UserCreate {
+	create := c.Create()
+	if u.OptionalInt != 0 {
+		create.SetOptionalInt(u.OptionalInt)
+	}
+	create.SetAge(u.Age)
+	create.SetName(u.Name)
+	create.SetLast(u.Last)
+	if u.Nickname != "" {
+		create.SetNickname(u.Nickname)
+	}
+	if u.Phone != "" {
+		create.SetPhone(u.Phone)
+	}
+	if u.Password != "" {
+		create.SetPassword(u.Password)
+	}
+	create.SetRole(u.Role)
+	if u.SSOCert != "" {
+		create.SetSSOCert(u.SSOCert)
+	}
+	if fk := u.user_parent; fk != nil {
+		create.SetParentID(*fk)
+	}
+	return create
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
-	config
+// Sync reconciles the User entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.User.Sync(ctx, builders, []string{user.FieldNickname})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *UserClient) Sync(ctx context.Context, desired []*UserCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for User sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case user.FieldNickname, user.FieldPhone:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for User sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&UserClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *UserClient) sync(ctx context.Context, desired []*UserCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.User, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in User builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: User builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*User)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*UserCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
 }
 
-// Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// syncValue returns the value of the given key field of the User.
+func (u *User) syncValue(field string) Value {
+	switch field {
+	case user.FieldNickname:
+		return u.Nickname
+	case user.FieldPhone:
+		return u.Phone
+	}
+	return nil
 }
 
-// Create returns a create builder for User.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// User. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (u *User) syncChanged(mutation *UserMutation) bool {
+	if v, ok := mutation.OptionalInt(); ok && (v != u.OptionalInt) {
+		return true
+	}
+	if v, ok := mutation.Age(); ok && (v != u.Age) {
+		return true
+	}
+	if v, ok := mutation.Name(); ok && (v != u.Name) {
+		return true
+	}
+	if v, ok := mutation.Last(); ok && (v != u.Last) {
+		return true
+	}
+	if v, ok := mutation.Nickname(); ok && (v != u.Nickname) {
+		return true
+	}
+	if v, ok := mutation.Phone(); ok && (v != u.Phone) {
+		return true
+	}
+	if v, ok := mutation.Password(); ok && (v != u.Password) {
+		return true
+	}
+	if v, ok := mutation.Role(); ok && (v != u.Role) {
+		return true
+	}
+	if v, ok := mutation.SSOCert(); ok && (v != u.SSOCert) {
+		return true
+	}
+	if ids := mutation.SpouseIDs(); len(ids) > 0 && (u.user_spouse == nil || *u.user_spouse != ids[0]) {
+		return true
+	}
+	if ids := mutation.ParentIDs(); len(ids) > 0 && (u.user_parent == nil || *u.user_parent != ids[0]) {
+		return true
+	}
+	return false
 }
 
 // Update returns an update builder for User.
@@ -1405,6 +8427,211 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UserPatch holds the field changes of a User for UpdateByIDMap, keyed by the field
+// name (e.g. user.FieldOptionalInt). A nil value clears an optional field.
+type UserPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the User with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.User.UpdateByIDMap(ctx, map[int]ent.UserPatch{
+//		id1: {user.FieldOptionalInt: v1},
+//		id2: {user.FieldOptionalInt: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *UserClient) UpdateByIDMap(ctx context.Context, m map[int]UserPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *UserClient) patchUpdates(m map[int]UserPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newUserMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid User patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&UserUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			if v != nil && f == user.FieldPassword {
+				v = sql.MaskValue(v.(string), c.sensitiveMask(TypeUser, f))
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(user.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, user.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(user.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given User entities, and updates the original User only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original User is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := u.Clone()
+//	modified.Field = value
+//	u, err = client.User.UpdateFromDiff(ctx, u, modified)
+//
+func (c *UserClient) UpdateFromDiff(ctx context.Context, original, modified *User) (*User, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for User UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.OptionalInt != original.OptionalInt {
+		update.SetOptionalInt(modified.OptionalInt)
+		changed = true
+	}
+	if modified.Age != original.Age {
+		update.SetAge(modified.Age)
+		changed = true
+	}
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Last != original.Last {
+		update.SetLast(modified.Last)
+		changed = true
+	}
+	if modified.Nickname != original.Nickname {
+		update.SetNickname(modified.Nickname)
+		changed = true
+	}
+	if modified.Phone != original.Phone {
+		update.SetPhone(modified.Phone)
+		changed = true
+	}
+	if modified.Password != original.Password {
+		update.SetPassword(modified.Password)
+		changed = true
+	}
+	if modified.Role != original.Role {
+		update.SetRole(modified.Role)
+		changed = true
+	}
+	if modified.SSOCert != original.SSOCert {
+		update.SetSSOCert(modified.SSOCert)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Card, original.Edges.Card; {
+		case v == nil && o != nil:
+			update.ClearCard()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetCardID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[7] && original.Edges.loadedTypes[7] {
+		switch v, o := modified.Edges.Team, original.Edges.Team; {
+		case v == nil && o != nil:
+			update.ClearTeam()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetTeamID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[8] && original.Edges.loadedTypes[8] {
+		switch v, o := modified.Edges.Spouse, original.Edges.Spouse; {
+		case v == nil && o != nil:
+			update.ClearSpouse()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetSpouseID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[10] && original.Edges.loadedTypes[10] {
+		switch v, o := modified.Edges.Parent, original.Edges.Parent; {
+		case v == nil && o != nil:
+			update.ClearParent()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetParentID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -1443,6 +8670,83 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *UserClient) GetMany(ctx context.Context, ids ...int) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*User, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*User, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...int) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a User from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *UserClient) FromMap(m map[string]string) (*User, error) {
+	u := &User{config: c.config}
+	if err := kvDecode(m, user.FieldID, &u.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldOptionalInt, &u.OptionalInt, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[user.FieldOptionalInt]; ok {
+		if err := user.OptionalIntValidator(u.OptionalInt); err != nil {
+			return nil, &ValidationError{Name: "optional_int", err: fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, user.FieldAge, &u.Age, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldName, &u.Name, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldLast, &u.Last, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldNickname, &u.Nickname, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldPhone, &u.Phone, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldRole, &u.Role, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[user.FieldRole]; ok {
+		if err := user.RoleValidator(u.Role); err != nil {
+			return nil, &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, user.FieldSSOCert, &u.SSOCert, false); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
 	query := &CardQuery{config: c.config}
@@ -1619,7 +8923,750 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 	return query
 }
 
+// LoadPetsFor loads the "pets" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithPets for users that were already loaded.
+func (c *UserClient) LoadPetsFor(ctx context.Context, nodes []*User, opts ...func(*PetQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithPets(opts...)
+	for _, node := range nodes {
+		node.Edges.Pets = nil
+	}
+
+	if query := uq.withPets; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = user.PetsColumn
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Pet(func(s *sql.Selector) {
+				s.Where(sql.InValues(user.PetsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.user_pets
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "user_pets" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_pets" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Pets = append(node.Edges.Pets, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[1] = true
+	}
+	return nil
+}
+
+// LoadFilesFor loads the "files" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithFiles for users that were already loaded.
+func (c *UserClient) LoadFilesFor(ctx context.Context, nodes []*User, opts ...func(*FileQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithFiles(opts...)
+	for _, node := range nodes {
+		node.Edges.Files = nil
+	}
+
+	if query := uq.withFiles; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = user.FilesColumn
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.File(func(s *sql.Selector) {
+				s.Where(sql.InValues(user.FilesColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.user_files
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "user_files" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_files" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Files = append(node.Edges.Files, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[2] = true
+	}
+	return nil
+}
+
+// LoadGroupsFor loads the "groups" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithGroups for users that were already loaded.
+func (c *UserClient) LoadGroupsFor(ctx context.Context, nodes []*User, opts ...func(*GroupQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithGroups(opts...)
+	for _, node := range nodes {
+		node.Edges.Groups = nil
+	}
+
+	if query := uq.withGroups; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*User, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*User)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: false,
+				Table:   user.GroupsTable,
+				Columns: user.GroupsPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(user.GroupsPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "groups": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && uq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "groups" cannot be applied on %d neighbors with batch size %d`, len(edgeids), uq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = uq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], group.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "groups" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Groups = append(nodes[i].Edges.Groups, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Groups
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Groups = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[3] = true
+	}
+	return nil
+}
+
+// LoadFriendsFor loads the "friends" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithFriends for users that were already loaded.
+func (c *UserClient) LoadFriendsFor(ctx context.Context, nodes []*User, opts ...func(*UserQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithFriends(opts...)
+	for _, node := range nodes {
+		node.Edges.Friends = nil
+	}
+
+	if query := uq.withFriends; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*User, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*User)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: false,
+				Table:   user.FriendsTable,
+				Columns: user.FriendsPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(user.FriendsPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "friends": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && uq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "friends" cannot be applied on %d neighbors with batch size %d`, len(edgeids), uq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = uq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "friends" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Friends = append(nodes[i].Edges.Friends, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Friends
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Friends = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[4] = true
+	}
+	return nil
+}
+
+// LoadFollowersFor loads the "followers" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithFollowers for users that were already loaded.
+func (c *UserClient) LoadFollowersFor(ctx context.Context, nodes []*User, opts ...func(*UserQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithFollowers(opts...)
+	for _, node := range nodes {
+		node.Edges.Followers = nil
+	}
+
+	if query := uq.withFollowers; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*User, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*User)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: true,
+				Table:   user.FollowersTable,
+				Columns: user.FollowersPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(user.FollowersPrimaryKey[1], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "followers": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && uq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "followers" cannot be applied on %d neighbors with batch size %d`, len(edgeids), uq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = uq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "followers" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Followers = append(nodes[i].Edges.Followers, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Followers
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Followers = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[5] = true
+	}
+	return nil
+}
+
+// LoadFollowingFor loads the "following" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithFollowing for users that were already loaded.
+func (c *UserClient) LoadFollowingFor(ctx context.Context, nodes []*User, opts ...func(*UserQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithFollowing(opts...)
+	for _, node := range nodes {
+		node.Edges.Following = nil
+	}
+
+	if query := uq.withFollowing; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*User, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*User)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: false,
+				Table:   user.FollowingTable,
+				Columns: user.FollowingPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(user.FollowingPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, uq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "following": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && uq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "following" cannot be applied on %d neighbors with batch size %d`, len(edgeids), uq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = uq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "following" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Following = append(nodes[i].Edges.Following, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Following
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Following = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[6] = true
+	}
+	return nil
+}
+
+// LoadChildrenFor loads the "children" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithChildren for users that were already loaded.
+func (c *UserClient) LoadChildrenFor(ctx context.Context, nodes []*User, opts ...func(*UserQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithChildren(opts...)
+	for _, node := range nodes {
+		node.Edges.Children = nil
+	}
+
+	if query := uq.withChildren; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = user.ChildrenColumn
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.User(func(s *sql.Selector) {
+				s.Where(sql.InValues(user.ChildrenColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.user_parent
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "user_parent" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_parent" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Children = append(node.Edges.Children, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[9] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given User entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *UserClient) LoadEdgeFor(ctx context.Context, nodes []*User, edge string) error {
+	var (
+		query  func(*UserQuery)
+		assign func(node, loaded *User)
+	)
+	switch edge {
+	case user.EdgeCard:
+		query = func(q *UserQuery) { q.WithCard() }
+		assign = func(node, loaded *User) {
+			node.Edges.Card, node.Edges.loadedTypes[0] = loaded.Edges.Card, true
+		}
+	case user.EdgePets:
+		return c.LoadPetsFor(ctx, nodes)
+	case user.EdgeFiles:
+		return c.LoadFilesFor(ctx, nodes)
+	case user.EdgeGroups:
+		return c.LoadGroupsFor(ctx, nodes)
+	case user.EdgeFriends:
+		return c.LoadFriendsFor(ctx, nodes)
+	case user.EdgeFollowers:
+		return c.LoadFollowersFor(ctx, nodes)
+	case user.EdgeFollowing:
+		return c.LoadFollowingFor(ctx, nodes)
+	case user.EdgeTeam:
+		query = func(q *UserQuery) { q.WithTeam() }
+		assign = func(node, loaded *User) {
+			node.Edges.Team, node.Edges.loadedTypes[7] = loaded.Edges.Team, true
+		}
+	case user.EdgeSpouse:
+		query = func(q *UserQuery) { q.WithSpouse() }
+		assign = func(node, loaded *User) {
+			node.Edges.Spouse, node.Edges.loadedTypes[8] = loaded.Edges.Spouse, true
+		}
+	case user.EdgeChildren:
+		return c.LoadChildrenFor(ctx, nodes)
+	case user.EdgeParent:
+		query = func(q *UserQuery) { q.WithParent() }
+		assign = func(node, loaded *User) {
+			node.Edges.Parent, node.Edges.loadedTypes[10] = loaded.Edges.Parent, true
+		}
+	default:
+		return fmt.Errorf("ent: unknown User edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*User, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(user.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(user.Label)}, hooks...)
+	}
+	return hooks
 }
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -25,46 +29,104 @@ type Comment struct {
 	UniqueFloat float64 `json:"unique_float,omitempty"`
 	// NillableInt holds the value of the "nillable_int" field.
 	NillableInt *int `json:"nillable_int,omitempty"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Comment) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},   // id
-		&sql.NullInt64{},   // unique_int
-		&sql.NullFloat64{}, // unique_float
-		&sql.NullInt64{},   // nillable_int
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Comment type are scanned as generic values.
+func (*Comment) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldID:
+			values[i] = &sql.NullInt64{}
+		case comment.FieldUniqueInt:
+			values[i] = &sql.NullInt64{}
+		case comment.FieldUniqueFloat:
+			values[i] = &sql.NullFloat64{}
+		case comment.FieldNillableInt:
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Comment fields.
-func (c *Comment) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(comment.Columns); m < n {
+// to the Comment fields. Values of columns that were not selected are left untouched.
+func (c *Comment) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
+	for i := range columns {
+		switch columns[i] {
+		case comment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case comment.FieldUniqueInt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_int", values[i])
+			} else if value.Valid {
+				c.UniqueInt = int(value.Int64)
+			}
+		case comment.FieldUniqueFloat:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_float", values[i])
+			} else if value.Valid {
+				c.UniqueFloat = value.Float64
+			}
+		case comment.FieldNillableInt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nillable_int", values[i])
+			} else if value.Valid {
+				c.NillableInt = new(int)
+				*c.NillableInt = int(value.Int64)
+			}
+		}
 	}
-	c.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field unique_int", values[0])
-	} else if value.Valid {
-		c.UniqueInt = int(value.Int64)
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (c *Comment) SelectValue(alias string) (Value, error) {
+	v, ok := c.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
 	}
-	if value, ok := values[1].(*sql.NullFloat64); !ok {
-		return fmt.Errorf("unexpected type %T for field unique_float", values[1])
-	} else if value.Valid {
-		c.UniqueFloat = value.Float64
+	return v, nil
+}
+
+// DumpGraph returns the JSON encoding of the Comment and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Comment alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Comment itself is not modified.
+//
+//	b, err := c.DumpGraph(ctx, client, 2)
+//
+func (c *Comment) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Comment DumpGraph", depth)
 	}
-	if value, ok := values[2].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field nillable_int", values[2])
-	} else if value.Valid {
-		c.NillableInt = new(int)
-		*c.NillableInt = int(value.Int64)
+	dumped := *c
+	if err := dumpComments(ctx, client, []*Comment{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
 	}
+	return json.Marshal(&dumped)
+}
+
+// dumpComments loads the edges of the given Comment copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpComments(ctx context.Context, client *Client, nodes []*Comment, depth int, dumper *graphDump) error {
 	return nil
 }
 
@@ -86,6 +148,30 @@ func (c *Comment) Unwrap() *Comment {
 	return c
 }
 
+// Clone returns a deep copy of the Comment and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (c *Comment) Clone() *Comment {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Comment and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (c *Comment) clone(seen map[interface{}]interface{}) *Comment {
+	if c == nil {
+		return nil
+	}
+	if v, ok := seen[c]; ok {
+		return v.(*Comment)
+	}
+	_c := *c
+	seen[c] = &_c
+	if v := c.NillableInt; v != nil {
+		_v := *v
+		_c.NillableInt = &_v
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Comment) String() string {
 	var builder strings.Builder
@@ -103,6 +189,32 @@ func (c *Comment) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Comment, for change detection and cache keys.
+// It covers the fields that are listed in comment.FingerprintFields, and it does not depend on the
+// ID of the Comment, on its edges, or on the order of the fields in the schema.
+func (c *Comment) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "nillable_int", c.NillableInt)
+	fingerprint(h, "unique_float", c.UniqueFloat)
+	fingerprint(h, "unique_int", c.UniqueInt)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Comment for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Comment can be decoded using the FromMap method of its client.
+func (c *Comment) ToMap() map[string]string {
+	m := make(map[string]string, 4)
+	m[comment.FieldID] = kvEncode(c.ID)
+	m[comment.FieldUniqueInt] = kvEncode(c.UniqueInt)
+	m[comment.FieldUniqueFloat] = kvEncode(c.UniqueFloat)
+	if v := c.NillableInt; v != nil {
+		m[comment.FieldNillableInt] = kvEncode(*v)
+	}
+	return m
+}
+
 // Comments is a parsable slice of Comment.
 type Comments []*Comment
 
@@ -19,6 +19,14 @@ const (
 	Table = "comments"
 )
 
+// FingerprintFields holds the fields of the Comment type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldNillableInt,
+	FieldUniqueFloat,
+	FieldUniqueInt,
+}
+
 // Columns holds all SQL columns for comment fields.
 var Columns = []string{
 	FieldID,
@@ -26,3 +34,13 @@ var Columns = []string{
 	FieldUniqueFloat,
 	FieldNillableInt,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
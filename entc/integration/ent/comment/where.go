@@ -7,6 +7,8 @@
 package comment
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 )
@@ -357,6 +359,80 @@ func NillableIntNotNil() predicate.Comment {
 	})
 }
 
+// NillableIntEQNullSafe applies a NULL-safe equality predicate on the "nillable_int" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NillableIntEQNullSafe(v *int) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNillableInt), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:          "numeric",
+	FieldNillableInt: "numeric",
+	FieldUniqueFloat: "numeric",
+	FieldUniqueInt:   "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Comment.
+func FieldsEQ(f1, f2 string) predicate.Comment {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Comment.
+func FieldsNEQ(f1, f2 string) predicate.Comment {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Comment.
+//
+//	comment.FieldsLT(comment.FieldUniqueInt, comment.FieldUniqueFloat)
+//
+func FieldsLT(f1, f2 string) predicate.Comment {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Comment.
+func FieldsLTE(f1, f2 string) predicate.Comment {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Comment.
+func FieldsGT(f1, f2 string) predicate.Comment {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Comment.
+func FieldsGTE(f1, f2 string) predicate.Comment {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Comment using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Comment {
+	return predicate.Comment(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Comment", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Comment", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Comment are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Comment) predicate.Comment {
 	return predicate.Comment(func(s *sql.Selector) {
@@ -364,6 +440,9 @@ func And(predicates ...predicate.Comment) predicate.Comment {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -378,6 +457,9 @@ func Or(predicates ...predicate.Comment) predicate.Comment {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -388,3 +470,41 @@ func Not(p predicate.Comment) predicate.Comment {
 		p(s.Not())
 	})
 }
+
+// CommentFilter holds optional filters on the Comment fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CommentFilter struct {
+	IDIn        []int
+	UniqueInt   *int
+	UniqueFloat *float64
+	NillableInt *int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Comment.Query().
+//		Where(comment.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CommentFilter) []predicate.Comment {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Comment
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.UniqueInt != nil {
+		ps = append(ps, UniqueIntEQ(*f.UniqueInt))
+	}
+	if f.UniqueFloat != nil {
+		ps = append(ps, UniqueFloatEQ(*f.UniqueFloat))
+	}
+	if f.NillableInt != nil {
+		ps = append(ps, NillableIntEQ(*f.NillableInt))
+	}
+	return ps
+}
@@ -10,7 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/comment"
 	"github.com/facebookincubator/ent/schema/field"
@@ -51,11 +54,12 @@ func (cc *CommentCreate) SetNillableNillableInt(i *int) *CommentCreate {
 
 // Save creates the Comment in the database.
 func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
-	if _, ok := cc.mutation.UniqueInt(); !ok {
-		return nil, errors.New("ent: missing required field \"unique_int\"")
+	if err := checkTx(cc.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := cc.mutation.UniqueFloat(); !ok {
-		return nil, errors.New("ent: missing required field \"unique_float\"")
+	cc.defaults()
+	if err := cc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -92,17 +96,118 @@ func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cc *CommentCreate) Unwrap() *CommentCreate {
+	tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentCreate is not a transactional builder")
+	}
+	cc.config.driver = tx.drv
+	cc.mutation.driver = tx.drv
+	return cc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (cc *CommentCreate) DebugFields() map[string]Value {
+	return debugFields(cc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CommentCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CommentCreate) check() error {
+	if _, ok := cc.mutation.UniqueInt(); !ok {
+		return &ValidationError{Name: "unique_int", err: errors.New("ent: missing required field \"unique_int\"")}
+	}
+	if _, ok := cc.mutation.UniqueFloat(); !ok {
+		return &ValidationError{Name: "unique_float", err: errors.New("ent: missing required field \"unique_float\"")}
+	}
+	return nil
+}
+
 func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
-	var (
-		c     = &Comment{config: cc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: comment.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: comment.FieldID,
-			},
+	ctx = cc.withOperation(ctx, "Comment", "Create")
+	c, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	c.ID = int(id)
+	return c, nil
+}
+
+// SaveID creates the Comment in the database and returns only its id. Unlike Save,
+// the Comment entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Comment that holds only its id as the value that is returned by the next mutator.
+func (cc *CommentCreate) SaveID(ctx context.Context) (id int, err error) {
+	cc.defaults()
+	if err = cc.check(); err != nil {
+		return id, err
+	}
+	if len(cc.hooks) == 0 {
+		return cc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*CommentMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		cc.mutation = mutation
+		if id, err = cc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Comment{config: cc.config, ID: id}, nil
+	})
+	for i := len(cc.hooks) - 1; i >= 0; i-- {
+		mut = cc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, cc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (cc *CommentCreate) SaveIDX(ctx context.Context) int {
+	id, err := cc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (cc *CommentCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = cc.withOperation(ctx, "Comment", "Create")
+	_spec := cc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, cc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
+	c := &Comment{config: cc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: comment.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: comment.FieldID,
+		},
+	}
 	if value, ok := cc.mutation.UniqueInt(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -127,13 +232,587 @@ func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
 		})
 		c.NillableInt = &value
 	}
-	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return c, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (cc *CommentCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: comment.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: comment.FieldID,
+		},
+	}
+	if value, ok := cc.mutation.UniqueInt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldUniqueInt,
+		})
+	}
+	if value, ok := cc.mutation.UniqueFloat(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: comment.FieldUniqueFloat,
+		})
+	}
+	if value, ok := cc.mutation.NillableInt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: comment.FieldNillableInt,
+		})
+	}
+	return _spec
+}
+
+// CommentCreateBulk is the builder for creating a bulk of Comment entities.
+type CommentCreateBulk struct {
+	config
+	builders []*CommentCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Comment entities in the database.
+func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
+	nodes, err := ccb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ccb.retries > 0 && isSQLDeadlockError(err) {
+		return ccb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CommentCreateBulk) SaveX(ctx context.Context) []*Comment {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ccb *CommentCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ccb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
 		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ccb *CommentCreateBulk) CheckRefs() *CommentCreateBulk {
+	ccb.refs = true
+	return ccb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ccb *CommentCreateBulk) RetryOnDeadlock(max int) *CommentCreateBulk {
+	ccb.retries = max
+	return ccb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Comment.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Comment.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ccb *CommentCreateBulk) OnConflict(columns ...string) *CommentUpsertBulk {
+	return &CommentUpsertBulk{create: ccb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Comment.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (cc *CommentCreate) OnConflict(target ...sql.ConflictOption) *CommentUpsertBulk {
+	bulk := &CommentCreateBulk{config: cc.config, builders: []*CommentCreate{cc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ccb *CommentCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Comment, error) {
+	ctx = ccb.withOperation(ctx, "Comment", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ccb.builders))
+		nodes    = make([]*Comment, len(ccb.builders))
+		mutators = make([]Mutator, len(ccb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ccb.refs {
+						if err := ccb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ccb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ccb *CommentCreateBulk) retry(ctx context.Context, err error) ([]*Comment, error) {
+	if _, ok := ccb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	c.ID = int(id)
-	return c, nil
+	builders := ccb.builders
+	defer func() { ccb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ccb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ccb.builders = make([]*CommentCreate, len(idx))
+	for i, j := range idx {
+		ccb.builders[i] = builders[j]
+	}
+	for n := 0; n < ccb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Comment
+		if sorted, err = ccb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Comment, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ccb *CommentCreateBulk) less(a, b *CommentCreate) bool {
+	{
+		x, _ := a.mutation.UniqueInt()
+		y, _ := b.mutation.UniqueInt()
+		if x != y {
+			return x < y
+		}
+	}
+	{
+		x, _ := a.mutation.UniqueFloat()
+		y, _ := b.mutation.UniqueFloat()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ccb *CommentCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Comment", Missing: missing}
+	}
+	return nil
+}
+
+// CommentUpsertBulk is the builder for the conflict handling of a bulk of Comment entities.
+type CommentUpsertBulk struct {
+	create  *CommentCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (cub *CommentUpsertBulk) Target(target ...sql.ConflictOption) *CommentUpsertBulk {
+	cub.target = append(cub.target, target...)
+	return cub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (cub *CommentUpsertBulk) DoNothing() *CommentUpsertBulk {
+	cub.nothing = true
+	return cub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (cub *CommentUpsertBulk) UpdateNewValues() *CommentUpsertBulk {
+	cub.update = true
+	return cub
+}
+
+// Save creates the Comment entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (cub *CommentUpsertBulk) Save(ctx context.Context) ([]*Comment, int, error) {
+	switch {
+	case !cub.nothing && !cub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Comment bulk insert")
+	case cub.nothing && cub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Comment bulk insert")
+	case cub.update:
+		if len(cub.columns) == 0 && len(cub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Comment bulk upsert")
+		}
+		nodes, err := cub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(cub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(comment.FieldID),
+			),
+			Columns:         comment.Columns,
+			ConflictColumns: cub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(cub.conflictTarget(), sql.DoNothing())}
+	nodes, err := cub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Comment, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (cub *CommentUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(cub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(cub.columns...))
+	}
+	return append(opts, cub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cub *CommentUpsertBulk) SaveX(ctx context.Context) ([]*Comment, int) {
+	nodes, skipped, err := cub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (cub *CommentUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := cub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cub *CommentUpsertBulk) ExecX(ctx context.Context) {
+	if err := cub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored Comment entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.Comment.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *Comment) *Comment {
+//			// ...
+//			return existing
+//		}, comment.FieldUniqueInt).
+//		Save(ctx)
+//
+func (ccb *CommentCreateBulk) OnConflictMerge(merge func(existing, incoming *Comment) *Comment, keyFields ...string) *CommentMergeBulk {
+	return &CommentMergeBulk{create: ccb, merge: merge, keyFields: keyFields}
+}
+
+// CommentMergeBulk is the builder for merging a bulk of Comment entities with the stored entities in Go.
+type CommentMergeBulk struct {
+	create    *CommentCreateBulk
+	merge     func(existing, incoming *Comment) *Comment
+	keyFields []string
+}
+
+// Save creates or merges the Comment entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (cmb *CommentMergeBulk) Save(ctx context.Context) ([]*Comment, error) {
+	if cmb.merge == nil {
+		return nil, errors.New("ent: missing merge function for Comment bulk merge")
+	}
+	if len(cmb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for Comment bulk merge")
+	}
+	for _, f := range cmb.keyFields {
+		switch f {
+		case comment.FieldUniqueInt, comment.FieldUniqueFloat:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for Comment bulk merge", f)
+		}
+	}
+	drv := cmb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return cmb.save(ctx, cmb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
+		return nil, err
+	}
+	cfg := cmb.create.config
+	cfg.driver = tx
+	nodes, err := cmb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (cmb *CommentMergeBulk) save(ctx context.Context, cfg config) ([]*Comment, error) {
+	var (
+		client   = &CommentClient{config: cfg}
+		builders = cmb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *CommentCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(cmb.keyFields))
+		for j, f := range cmb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in Comment builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: Comment builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Comment)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, cmb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(cmb.keyFields))
+			for j, f := range cmb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*Comment, len(builders))
+		inserts []*CommentCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := cmb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmb *CommentMergeBulk) SaveX(ctx context.Context) []*Comment {
+	nodes, err := cmb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (cmb *CommentMergeBulk) Exec(ctx context.Context) error {
+	_, err := cmb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmb *CommentMergeBulk) ExecX(ctx context.Context) {
+	if err := cmb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
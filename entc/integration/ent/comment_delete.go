@@ -23,6 +23,7 @@ type CommentDelete struct {
 	hooks      []Hook
 	mutation   *CommentMutation
 	predicates []predicate.Comment
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (cd *CommentDelete) Where(ps ...predicate.Comment) *CommentDelete {
 	return cd
 }
 
+// Limit limits the number of comments that are deleted by the builder. For example,
+// for deleting large amounts of comments in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (cd *CommentDelete) Limit(limit int) *CommentDelete {
+	cd.limit = &limit
+	return cd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cd *CommentDelete) Unwrap() *CommentDelete {
+	tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentDelete is not a transactional builder")
+	}
+	cd.config.driver = tx.drv
+	cd.mutation.driver = tx.drv
+	return cd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (cd *CommentDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(cd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (cd *CommentDelete) ExecX(ctx context.Context) int {
 }
 
 func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = cd.withOperation(ctx, "Comment", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: comment.Table,
@@ -85,7 +111,10 @@ func (cd *CommentDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, cd.driver, _spec)
+	if limit := cd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, cd.mutationDriver(ctx), _spec)
 }
 
 // CommentDeleteOne is the builder for deleting a single Comment entity.
@@ -99,7 +128,7 @@ func (cdo *CommentDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{comment.Label}
 	default:
 		return nil
@@ -8,9 +8,12 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -27,6 +30,18 @@ type CommentQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.Comment
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see CommentSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -38,6 +53,19 @@ func (cq *CommentQuery) Where(ps ...predicate.Comment) *CommentQuery {
 	return cq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (cq *CommentQuery) Predicates() []predicate.Comment {
+	return append([]predicate.Comment{}, cq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (cq *CommentQuery) SetPredicates(ps ...predicate.Comment) *CommentQuery {
+	cq.predicates = append([]predicate.Comment{}, ps...)
+	return cq
+}
+
 // Limit adds a limit step to the query.
 func (cq *CommentQuery) Limit(limit int) *CommentQuery {
 	cq.limit = &limit
@@ -277,11 +305,15 @@ func (cq *CommentQuery) GroupBy(field string, fields ...string) *CommentGroupBy
 func (cq *CommentQuery) Select(field string, fields ...string) *CommentSelect {
 	selector := &CommentSelect{config: cq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = cq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return cq.sqlQuery(), nil
+		if err := cq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return cq.sqlQuery().AppendSelectExpr(cq.exprs...), nil
 	}
 	return selector
 }
@@ -298,14 +330,28 @@ func (cq *CommentQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
+	ctx = cq.withOperation(ctx, "Comment", "Query")
+	if err := cq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range cq.fields {
+		if !comment.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes = []*Comment{}
 		_spec = cq.querySpec()
 	)
+	_spec.Exprs = cq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Comment{config: cq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
+		values := node.scanValues(columns)
+		for range cq.exprs {
+			values = append(values, new(interface{}))
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -313,7 +359,15 @@ func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
-		return node.assignValues(values...)
+		if n := len(cq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range cq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
 		return nil, err
@@ -324,7 +378,42 @@ func (cq *CommentQuery) sqlAll(ctx context.Context) ([]*Comment, error) {
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Comment entities (see Comment.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Comment.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Comment table.
+func (cq *CommentQuery) SelectExpr(exprs ...*sql.SelectExpr) *CommentQuery {
+	cq.exprs = append(cq.exprs, exprs...)
+	return cq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (cq *CommentQuery) checkExprs() error {
+	if len(cq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range comment.Columns {
+		columns[c] = true
+	}
+	for _, e := range cq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Comment", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (cq *CommentQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = cq.withOperation(ctx, "Comment", "Count")
 	_spec := cq.querySpec()
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
 }
@@ -350,6 +439,17 @@ func (cq *CommentQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   cq.sql,
 		Unique: true,
 	}
+	if fields := cq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, comment.FieldID)
+		for i := range fields {
+			if fields[i] != comment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = cq.forUpdate
+	_spec.PartitionBy = cq.partition
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -398,11 +498,419 @@ func (cq *CommentQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Comment entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (cq *CommentQuery) IDsQuery() *sql.Selector {
+	selector := cq.sqlQuery()
+	if cq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(comment.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Comment entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Comment.Query().
+//		Where(...).
+//		CountDistinct(ctx, comment.FieldUniqueInt)
+//
+func (cq *CommentQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = cq.withOperation(ctx, "Comment", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, cq.driver, cq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (cq *CommentQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := cq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Comment rows as generic maps that are keyed by
+// column name (see comment.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (cq *CommentQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = cq.withOperation(ctx, "Comment", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = cq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Comment{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (cq *CommentQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := cq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Comment entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (cq *CommentQuery) Prefetch(n int) *CommentQuery {
+	cq.prefetch = n
+	return cq
+}
+
+// ForUpdate locks the selected Comment rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (cq *CommentQuery) ForUpdate() *CommentQuery {
+	cq.forUpdate = true
+	return cq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (cq *CommentQuery) Primary() *CommentQuery {
+	return cq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (cq *CommentQuery) ReadConsistency(c sql.ReadConsistency) *CommentQuery {
+	cq.consistency = c
+	return cq
+}
+
+// Stream executes the query in batches, and sends the matched Comment entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Comment.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (cq *CommentQuery) Stream(ctx context.Context) (<-chan *Comment, <-chan error) {
+	size := cq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Comment, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := cq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Comment entities in batches of the given
+// size, and sends them to the given channel.
+func (cq *CommentQuery) stream(ctx context.Context, size int, nodes chan<- *Comment) error {
+	if cq.offset != nil || len(cq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if cq.limit != nil {
+		left = *cq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *cq
+		query.predicates = cq.predicates[:len(cq.predicates):len(cq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, comment.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(comment.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// CommentEdge is the edge representation of Comment in a connection.
+type CommentEdge struct {
+	Node   *Comment `json:"node"`
+	Cursor Cursor   `json:"cursor"`
+}
+
+// CommentConnection is the connection of Comment entities that is returned by Paginate.
+type CommentConnection struct {
+	Edges      []CommentEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Comment.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: comment.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (cq *CommentQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*CommentConnection, error) {
+	if cq.limit != nil || cq.offset != nil || len(cq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case comment.FieldUniqueInt, comment.FieldUniqueFloat:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for Comment", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := cq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: comment.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *cq
+	query.predicates = cq.predicates[:len(cq.predicates):len(cq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := cq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &CommentConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]CommentEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = CommentEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (cq *CommentQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Comment, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := cq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Comment(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*CommentQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case comment.FieldUniqueInt:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field unique_int: %v", err)
+		}
+		return v, nil
+	case comment.FieldUniqueFloat:
+		var v float64
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field unique_float: %v", err)
+		}
+		return v, nil
+	case comment.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Comment", field)
+}
+
+// pageCursor returns the cursor of the Comment node for the given order fields.
+func (c *Comment) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(c.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case comment.FieldUniqueInt:
+			v = c.UniqueInt
+		case comment.FieldUniqueFloat:
+			v = c.UniqueFloat
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Comment", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
 // CommentGroupBy is the builder for group-by Comment entities.
 type CommentGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -515,9 +1023,28 @@ func (cgb *CommentGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Comment.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (cgb *CommentGroupBy) Having(ps ...*sql.Predicate) *CommentGroupBy {
+	cgb.havings = append(cgb.havings, ps...)
+	return cgb
+}
+
 func (cgb *CommentGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = cgb.withOperation(ctx, "Comment", "GroupBy")
+	selector := cgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := cgb.sqlQuery().Query()
 	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -530,15 +1057,30 @@ func (cgb *CommentGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(cgb.fields)+len(cgb.fns))
 	columns = append(columns, cgb.fields...)
 	for _, fn := range cgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(cgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(cgb.fields...)
+	switch len(cgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(cgb.havings[0])
+	default:
+		selector.Having(sql.And(cgb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(cgb.fields...)
+	return selector
 }
 
 // CommentSelect is the builder for select fields of Comment entities.
 type CommentSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *CommentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -645,7 +1187,52 @@ func (cs *CommentSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (cs *CommentSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(cs.fields) > 1 {
+		return nil, errors.New("ent: CommentSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := cs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (cs *CommentSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := cs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Comment entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Comment.Query().
+//		Select(comment.FieldUniqueInt).
+//		All(ctx)
+//
+func (cs *CommentSelect) All(ctx context.Context) ([]*Comment, error) {
+	query := *cs.query
+	query.fields = cs.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cs *CommentSelect) AllX(ctx context.Context) []*Comment {
+	nodes, err := cs.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (cs *CommentSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = cs.withOperation(ctx, "Comment", "Select")
 	rows := &sql.Rows{}
 	query, args := cs.sqlQuery().Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
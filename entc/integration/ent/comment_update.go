@@ -23,6 +23,9 @@ type CommentUpdate struct {
 	hooks      []Hook
 	mutation   *CommentMutation
 	predicates []predicate.Comment
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -31,6 +34,13 @@ func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
 	return cu
 }
 
+// Limit limits the number of comments that are updated by the builder. For example,
+// for updating large amounts of comments in small batches without locking the whole table.
+func (cu *CommentUpdate) Limit(limit int) *CommentUpdate {
+	cu.limit = &limit
+	return cu
+}
+
 // SetUniqueInt sets the unique_int field.
 func (cu *CommentUpdate) SetUniqueInt(i int) *CommentUpdate {
 	cu.mutation.ResetUniqueInt()
@@ -44,6 +54,13 @@ func (cu *CommentUpdate) AddUniqueInt(i int) *CommentUpdate {
 	return cu
 }
 
+// UnsetUniqueInt removes the changes of the unique_int field from the builder (e.g. a previous call
+// to SetUniqueInt), and therefore, the field is left unchanged in the database.
+func (cu *CommentUpdate) UnsetUniqueInt() *CommentUpdate {
+	cu.mutation.ResetUniqueInt()
+	return cu
+}
+
 // SetUniqueFloat sets the unique_float field.
 func (cu *CommentUpdate) SetUniqueFloat(f float64) *CommentUpdate {
 	cu.mutation.ResetUniqueFloat()
@@ -57,6 +74,13 @@ func (cu *CommentUpdate) AddUniqueFloat(f float64) *CommentUpdate {
 	return cu
 }
 
+// UnsetUniqueFloat removes the changes of the unique_float field from the builder (e.g. a previous call
+// to SetUniqueFloat), and therefore, the field is left unchanged in the database.
+func (cu *CommentUpdate) UnsetUniqueFloat() *CommentUpdate {
+	cu.mutation.ResetUniqueFloat()
+	return cu
+}
+
 // SetNillableInt sets the nillable_int field.
 func (cu *CommentUpdate) SetNillableInt(i int) *CommentUpdate {
 	cu.mutation.ResetNillableInt()
@@ -84,8 +108,41 @@ func (cu *CommentUpdate) ClearNillableInt() *CommentUpdate {
 	return cu
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (cu *CommentUpdate) UnsetNillableInt() *CommentUpdate {
+	cu.mutation.ResetNillableInt()
+	return cu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cu *CommentUpdate) Unwrap() *CommentUpdate {
+	tx, ok := cu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentUpdate is not a transactional builder")
+	}
+	cu.config.driver = tx.drv
+	cu.mutation.driver = tx.drv
+	return cu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cu *CommentUpdate) DebugFields() map[string]Value {
+	return debugFields(cu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(cu.driver); err != nil {
+		return 0, err
+	}
+	if err := cu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -134,7 +191,13 @@ func (cu *CommentUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	return nil
+}
+
 func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = cu.withOperation(ctx, "Comment", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   comment.Table,
@@ -152,6 +215,12 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := cu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if cu.ids != nil {
+		_spec.ScanIDs = cu.ids
+	}
 	if value, ok := cu.mutation.UniqueInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -200,7 +269,7 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: comment.FieldNillableInt,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -211,11 +280,33 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Comment entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (cu *CommentUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	cu.ids = &ids
+	defer func() { cu.ids = nil }()
+	if _, err := cu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *CommentMutation
+	hooks      []Hook
+	mutation   *CommentMutation
+	predicates []predicate.Comment
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.predicates = append(cuo.predicates, ps...)
+	return cuo
 }
 
 // SetUniqueInt sets the unique_int field.
@@ -231,6 +322,13 @@ func (cuo *CommentUpdateOne) AddUniqueInt(i int) *CommentUpdateOne {
 	return cuo
 }
 
+// UnsetUniqueInt removes the changes of the unique_int field from the builder (e.g. a previous call
+// to SetUniqueInt), and therefore, the field is left unchanged in the database.
+func (cuo *CommentUpdateOne) UnsetUniqueInt() *CommentUpdateOne {
+	cuo.mutation.ResetUniqueInt()
+	return cuo
+}
+
 // SetUniqueFloat sets the unique_float field.
 func (cuo *CommentUpdateOne) SetUniqueFloat(f float64) *CommentUpdateOne {
 	cuo.mutation.ResetUniqueFloat()
@@ -244,6 +342,13 @@ func (cuo *CommentUpdateOne) AddUniqueFloat(f float64) *CommentUpdateOne {
 	return cuo
 }
 
+// UnsetUniqueFloat removes the changes of the unique_float field from the builder (e.g. a previous call
+// to SetUniqueFloat), and therefore, the field is left unchanged in the database.
+func (cuo *CommentUpdateOne) UnsetUniqueFloat() *CommentUpdateOne {
+	cuo.mutation.ResetUniqueFloat()
+	return cuo
+}
+
 // SetNillableInt sets the nillable_int field.
 func (cuo *CommentUpdateOne) SetNillableInt(i int) *CommentUpdateOne {
 	cuo.mutation.ResetNillableInt()
@@ -271,8 +376,41 @@ func (cuo *CommentUpdateOne) ClearNillableInt() *CommentUpdateOne {
 	return cuo
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (cuo *CommentUpdateOne) UnsetNillableInt() *CommentUpdateOne {
+	cuo.mutation.ResetNillableInt()
+	return cuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cuo *CommentUpdateOne) Unwrap() *CommentUpdateOne {
+	tx, ok := cuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentUpdateOne is not a transactional builder")
+	}
+	cuo.config.driver = tx.drv
+	cuo.mutation.driver = tx.drv
+	return cuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cuo *CommentUpdateOne) DebugFields() map[string]Value {
+	return debugFields(cuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	if err := checkTx(cuo.driver); err != nil {
+		return nil, err
+	}
+	if err := cuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Comment
@@ -321,7 +459,13 @@ func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	return nil
+}
+
 func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error) {
+	ctx = cuo.withOperation(ctx, "Comment", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   comment.Table,
@@ -337,6 +481,13 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error
 		return nil, fmt.Errorf("missing Comment.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := cuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := cuo.mutation.UniqueInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -386,9 +537,11 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error
 		})
 	}
 	c = &Comment{config: cuo.config}
-	_spec.Assign = c.assignValues
-	_spec.ScanValues = c.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return c.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = c.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, cuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{comment.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -396,5 +549,19 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (c *Comment, err error
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		c.ID = id
+		if value, ok := cuo.mutation.UniqueInt(); ok {
+			c.UniqueInt = value
+		}
+		if value, ok := cuo.mutation.UniqueFloat(); ok {
+			c.UniqueFloat = value
+		}
+		if value, ok := cuo.mutation.NillableInt(); ok {
+			c.NillableInt = &value
+		}
+	}
 	return c, nil
 }
@@ -7,9 +7,18 @@
 package ent
 
 import (
+	"context"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
@@ -100,6 +109,73 @@ func Sum(field string) AggregateFunc {
 	}
 }
 
+// debugFields returns the changes of the mutation for the DebugFields method of the builders. The values
+// of the fields and the ids of the added edges are keyed by their names, and the other changes are keyed
+// by the name of the setter that made them. For example:
+//
+//	"name": "a8m", "add_age": 1, "clear_nickname": true, "pets": []Value{1}, "remove_friends": []Value{2}
+//
+func debugFields(m Mutation) map[string]Value {
+	fields := make(map[string]Value)
+	for _, name := range m.Fields() {
+		if v, ok := m.Field(name); ok {
+			fields[name] = redact(m, name, v)
+		}
+	}
+	for _, name := range m.AddedFields() {
+		if v, ok := m.AddedField(name); ok {
+			fields["add_"+name] = v
+		}
+	}
+	for _, name := range m.ClearedFields() {
+		fields["clear_"+name] = true
+	}
+	for _, name := range m.AddedEdges() {
+		fields[name] = m.AddedIDs(name)
+	}
+	for _, name := range m.RemovedEdges() {
+		fields["remove_"+name] = m.RemovedIDs(name)
+	}
+	for _, name := range m.ClearedEdges() {
+		fields["clear_"+name] = true
+	}
+	return fields
+}
+
+// mutationString returns the textual representation of the mutations for their String methods. The
+// changes are formatted in the order of their keys (see the debugFields function for the key format).
+func mutationString(m Mutation) string {
+	fields := debugFields(m)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(m.Type() + "Mutation(op=" + m.Op().String())
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf(", %s=%v", k, fields[k]))
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// redact returns the mask of the given value, if it is a value of a sensitive field of the mutation.
+func redact(m Mutation, name string, v Value) Value {
+	if r, ok := m.(interface{ redactField(string, Value) Value }); ok {
+		return r.redactField(name, v)
+	}
+	return v
+}
+
+// redactError masks the occurrences of the given value of a sensitive field in the message of the error.
+func redactError(err error, v, mask string) error {
+	if v == "" || !strings.Contains(err.Error(), v) {
+		return err
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), v, mask))
+}
+
 // NotFoundError returns when trying to fetch a specific entity and it was not found in the database.
 type NotFoundError struct {
 	label string
@@ -165,6 +241,31 @@ func IsNotLoaded(err error) bool {
 	return errors.As(err, &e)
 }
 
+// ValidationError returns when validating a field or an edge fails.
+type ValidationError struct {
+	Name string // Field or edge name.
+	err  error
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap implements the errors.Wrapper interface.
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
+// IsValidationError returns a boolean indicating whether the error is a validation error.
+func IsValidationError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *ValidationError
+	return errors.As(err, &e)
+}
+
 // ConstraintError returns when trying to create/update one or more entities and
 // one or more of their constraints failed. For example, violation of edge or
 // field uniqueness.
@@ -192,6 +293,269 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// fingerprint writes the name and the JSON encoding of a field value to the hash of a
+// Fingerprint. Time values are converted to UTC, and nil values are encoded as null.
+func fingerprint(w io.Writer, name string, v interface{}) {
+	switch t := v.(type) {
+	case time.Time:
+		v = t.UTC()
+	case *time.Time:
+		if t != nil {
+			v = t.UTC()
+		}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		b = []byte(fmt.Sprint(v))
+	}
+	fmt.Fprintf(w, "%s=%s\n", name, b)
+}
+
+// kvEncode encodes a field value for the flat key-value representation of the entities (see the
+// ToMap methods). Time values are encoded as RFC 3339, bytes as base64, values that implement the
+// encoding.TextMarshaler interface using their text encoding, values of basic kinds (and their
+// named types) using strconv, and other values (e.g. JSON fields) as JSON.
+func kvEncode(v interface{}) string {
+	switch v := v.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v)
+	case encoding.TextMarshaler:
+		if b, err := v.MarshalText(); err == nil {
+			return string(b)
+		}
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// kvDecode decodes the value of the given key in the key-value representation of an entity into v,
+// a pointer to the field, using the reverse encoding of kvEncode. Missing keys are skipped, unless
+// they are required.
+func kvDecode(m map[string]string, key string, v interface{}, required bool) error {
+	s, ok := m[key]
+	if !ok {
+		if required {
+			return fmt.Errorf("ent: missing required field %q", key)
+		}
+		return nil
+	}
+	var err error
+	switch v := v.(type) {
+	case *time.Time:
+		*v, err = time.Parse(time.RFC3339Nano, s)
+	case *[]byte:
+		*v, err = base64.StdEncoding.DecodeString(s)
+	case encoding.TextUnmarshaler:
+		err = v.UnmarshalText([]byte(s))
+	default:
+		err = kvDecodeValue(s, reflect.ValueOf(v).Elem())
+	}
+	if err != nil {
+		return fmt.Errorf("ent: decoding field %q: %v", key, err)
+	}
+	return nil
+}
+
+// kvDecodeValue decodes the given string into a value of a basic kind, or from JSON.
+func kvDecodeValue(s string, rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	default:
+		return json.Unmarshal([]byte(s), rv.Addr().Interface())
+	}
+	return nil
+}
+
+// jsonPath returns the value stored under the given dot-separated path of a JSON object.
+func jsonPath(doc map[string]interface{}, path string) (interface{}, bool) {
+	keys := strings.Split(path, ".")
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := doc[k].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		doc = next
+	}
+	v, ok := doc[keys[len(keys)-1]]
+	return v, ok
+}
+
+// jsonInt returns the integer value of a JSON number. Numbers are decoded as float64 by
+// the standard library, and therefore, fractional numbers are reported as non-integers.
+func jsonInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		if n := int(v); float64(n) == v {
+			return n, true
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return int(n), true
+		}
+	}
+	return 0, false
+}
+
+// jsonFloat returns the floating-point value of a JSON number.
+func jsonFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
+
+// deepCopy copies the value that src points to, into the value that dst points to. Pointers, slices,
+// maps, arrays, interfaces and the exported fields of structs are copied recursively, and therefore,
+// the two values do not share memory, besides the unexported fields of structs that are copied as is.
+func deepCopy(dst, src interface{}) {
+	reflect.ValueOf(dst).Elem().Set(copyValue(reflect.ValueOf(src).Elem()))
+}
+
+// copyValue returns a deep copy of the given value. See deepCopy for more info.
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(copyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		c := reflect.New(v.Type()).Elem()
+		if !v.IsNil() {
+			c.Set(copyValue(v.Elem()))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(copyValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// graphDump holds the state of a DumpGraph call.
+type graphDump struct {
+	edges   map[string]bool
+	visited map[string]bool
+}
+
+// newGraphDump returns a new graphDump that follows the given edges, or all edges if none were given.
+func newGraphDump(edges []string) *graphDump {
+	d := &graphDump{visited: make(map[string]bool)}
+	if len(edges) > 0 {
+		d.edges = make(map[string]bool, len(edges))
+		for _, name := range edges {
+			d.edges[name] = true
+		}
+	}
+	return d
+}
+
+// follow reports whether the edge with the given name should be followed.
+func (d *graphDump) follow(edge string) bool {
+	return d.edges == nil || d.edges[edge]
+}
+
+// visit marks the given entity as visited, and reports whether it was not visited before.
+func (d *graphDump) visit(label string, id interface{}) bool {
+	key := fmt.Sprintf("%s:%v", label, id)
+	if d.visited[key] {
+		return false
+	}
+	d.visited[key] = true
+	return true
+}
+
 func isSQLConstraintError(err error) (*ConstraintError, bool) {
 	var (
 		msg = err.Error()
@@ -213,13 +577,458 @@ func isSQLConstraintError(err error) (*ConstraintError, bool) {
 	return nil, false
 }
 
+// uniqueConstraints maps the tables to the names of their unique constraints (and indexes) in the
+// database, and the constraints to the fields (or the columns of edges) they cover.
+var uniqueConstraints = map[string]map[string][]string{
+	"cards": {
+		"cards_number_hash_key": {"number_hash"},
+		"cards_user_card_key":   {"user_card"},
+		"number_hash":           {"number_hash"},
+		"user_card":             {"user_card"},
+	},
+	"comments": {
+		"comments_unique_float_key": {"unique_float"},
+		"comments_unique_int_key":   {"unique_int"},
+		"unique_float":              {"unique_float"},
+		"unique_int":                {"unique_int"},
+	},
+	"files": {
+		"file_name_user":                       {"name", "user"},
+		"file_name_user_files_file_type_files": {"name", "user_files", "file_type_files"},
+	},
+	"file_types": {
+		"file_types_name_key": {"name"},
+		"name":                {"name"},
+	},
+	"nodes": {
+		"node_next":           {"node_next"},
+		"nodes_node_next_key": {"node_next"},
+	},
+	"pets": {
+		"pets_user_team_key": {"user_team"},
+		"user_team":          {"user_team"},
+	},
+	"specs": {
+		"name":           {"name"},
+		"specs_name_key": {"name"},
+	},
+	"users": {
+		"nickname":              {"nickname"},
+		"phone":                 {"phone"},
+		"user_spouse":           {"user_spouse"},
+		"users_nickname_key":    {"nickname"},
+		"users_phone_key":       {"phone"},
+		"users_user_spouse_key": {"user_spouse"},
+	},
+}
+
+// FieldsForConstraint returns the fields (or the columns of edges) that are covered by the unique
+// constraint (or index) that was violated, by translating the constraint name that was reported by
+// the database using the unique constraints of the generated schema. It returns nil if the error is
+// not a unique-constraint violation, or if its constraint is unknown. For example:
+//
+//	var cerr *ent.ConstraintError
+//	if errors.As(err, &cerr) {
+//		for _, f := range cerr.FieldsForConstraint() {
+//			// Report the error on the form field f.
+//		}
+//	}
+//
+func (e *ConstraintError) FieldsForConstraint() []string {
+	const (
+		mysql    = "for key '"
+		sqlite   = "UNIQUE constraint failed: "
+		postgres = "violates unique constraint \""
+	)
+	msg := e.msg
+	switch {
+	case strings.Contains(msg, sqlite):
+		// SQLite reports the columns of the constraint (e.g. "t.c1, t.c2"), instead of its name.
+		msg = msg[strings.Index(msg, sqlite)+len(sqlite):]
+		if i := strings.IndexByte(msg, ':'); i != -1 {
+			msg = msg[:i]
+		}
+		var table string
+		var fields []string
+		for _, c := range strings.Split(strings.TrimSpace(msg), ", ") {
+			i := strings.IndexByte(c, '.')
+			if i == -1 || table != "" && table != c[:i] {
+				return nil
+			}
+			table = c[:i]
+			fields = append(fields, c[i+1:])
+		}
+		if _, ok := uniqueConstraints[table]; !ok {
+			return nil
+		}
+		return fields
+	case strings.Contains(msg, postgres):
+		name := msg[strings.Index(msg, postgres)+len(postgres):]
+		if i := strings.IndexByte(name, '"'); i != -1 {
+			name = name[:i]
+		}
+		return constraintFields("", name)
+	case strings.Contains(msg, "Error 1062") && strings.Contains(msg, mysql):
+		name := msg[strings.LastIndex(msg, mysql)+len(mysql):]
+		if i := strings.IndexByte(name, '\''); i != -1 {
+			name = name[:i]
+		}
+		// MySQL 8.0.19 and above prefix the index name with the table name.
+		var table string
+		if i := strings.IndexByte(name, '.'); i != -1 {
+			table, name = name[:i], name[i+1:]
+		}
+		return constraintFields(table, name)
+	}
+	return nil
+}
+
+// constraintFields returns the fields of the given unique constraint. If the table is unknown,
+// the constraint is looked up in all tables, and its name must not be shared by more than one.
+func constraintFields(table, name string) []string {
+	var fields []string
+	for t, constraints := range uniqueConstraints {
+		if table != "" && t != table {
+			continue
+		}
+		if f, ok := constraints[name]; ok {
+			if fields != nil {
+				return nil
+			}
+			fields = f
+		}
+	}
+	if fields == nil {
+		return nil
+	}
+	return append([]string(nil), fields...)
+}
+
+// isSQLDeadlockError reports if the given error is a deadlock error that was returned by the database.
+func isSQLDeadlockError(err error) bool {
+	var (
+		msg = err.Error()
+		// error format per dialect.
+		errors = [...]string{
+			"Error 1213",        // MySQL 1213 error (ER_LOCK_DEADLOCK).
+			"deadlock detected", // PostgreSQL 40P01 error (deadlock_detected).
+		}
+	)
+	for i := range errors {
+		if strings.Contains(msg, errors[i]) {
+			return true
+		}
+	}
+	return false
+}
+
 // rollback calls to tx.Rollback and wraps the given error with the rollback error if occurred.
 func rollback(tx dialect.Tx, err error) error {
 	if rerr := tx.Rollback(); rerr != nil {
-		err = fmt.Errorf("%s: %v", err.Error(), rerr)
+		err = fmt.Errorf("%w: %v", err, rerr)
 	}
 	if err, ok := isSQLConstraintError(err); ok {
 		return err
 	}
 	return err
 }
+
+// BulkValidationError is returned by the Validate method of the bulk builders,
+// and holds the validation errors of the builders that failed, by their index.
+type BulkValidationError struct {
+	Errors map[int]error
+}
+
+// Error implements the error interface.
+func (e *BulkValidationError) Error() string {
+	idx := make([]int, 0, len(e.Errors))
+	for i := range e.Errors {
+		idx = append(idx, i)
+	}
+	sort.Ints(idx)
+	msgs := make([]string, len(idx))
+	for j, i := range idx {
+		msgs[j] = fmt.Sprintf("builder %d: %v", i, e.Errors[i])
+	}
+	return fmt.Sprintf("ent: %d invalid builders in bulk: %s", len(idx), strings.Join(msgs, "; "))
+}
+
+// ReferenceError is returned by the bulk builders that were configured with CheckRefs,
+// when their edges reference nodes that do not exist. It holds the ids of the missing
+// nodes by the edge name.
+type ReferenceError struct {
+	Type    string
+	Missing map[string][]interface{}
+}
+
+// Error implements the error interface.
+func (e *ReferenceError) Error() string {
+	edges := make([]string, 0, len(e.Missing))
+	for name := range e.Missing {
+		edges = append(edges, name)
+	}
+	sort.Strings(edges)
+	msgs := make([]string, len(edges))
+	for i, name := range edges {
+		msgs[i] = fmt.Sprintf("%s %v", name, e.Missing[name])
+	}
+	return fmt.Sprintf("ent: %s bulk references missing nodes: %s", e.Type, strings.Join(msgs, "; "))
+}
+
+// selectIDs selects the ids from the given table that are included in the given list, and scans
+// them into v. It is used for checking the references of bulk builders before they are inserted.
+func selectIDs(ctx context.Context, drv dialect.Driver, table, column string, ids []interface{}, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(drv.Dialect()).
+		Select(column).
+		From(sql.Table(table)).
+		Where(sql.In(column, ids...)).
+		Query()
+	if err := drv.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OrderDirection defines the direction of an order field in paginated queries.
+type OrderDirection string
+
+const (
+	// OrderDirectionAsc specifies an ascending order.
+	OrderDirectionAsc OrderDirection = "ASC"
+	// OrderDirectionDesc specifies a descending order.
+	OrderDirectionDesc OrderDirection = "DESC"
+)
+
+// PageOrder defines an order field of a paginated query. See the Paginate
+// method of the query builders for more info.
+type PageOrder struct {
+	Field     string
+	Direction OrderDirection
+}
+
+// Cursor identifies the position of an edge in a paginated connection. It holds the id of
+// the node and its values of the order fields, and it is encoded as an opaque string.
+type Cursor struct {
+	id     json.RawMessage
+	values []json.RawMessage
+}
+
+// cursor is the encoding format of Cursor.
+type cursor struct {
+	ID     json.RawMessage   `json:"i"`
+	Values []json.RawMessage `json:"v,omitempty"`
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (c Cursor) MarshalText() ([]byte, error) {
+	b, err := json.Marshal(cursor{ID: c.id, Values: c.values})
+	if err != nil {
+		return nil, err
+	}
+	text := make([]byte, base64.RawURLEncoding.EncodedLen(len(b)))
+	base64.RawURLEncoding.Encode(text, b)
+	return text, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *Cursor) UnmarshalText(text []byte) error {
+	b := make([]byte, base64.RawURLEncoding.DecodedLen(len(text)))
+	n, err := base64.RawURLEncoding.Decode(b, text)
+	if err != nil {
+		return fmt.Errorf("ent: invalid cursor: %v", err)
+	}
+	var v cursor
+	if err := json.Unmarshal(b[:n], &v); err != nil || len(v.ID) == 0 {
+		return fmt.Errorf("ent: invalid cursor %q", text)
+	}
+	c.id, c.values = v.ID, v.Values
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (c Cursor) String() string {
+	text, _ := c.MarshalText()
+	return string(text)
+}
+
+// PageInfo holds the pagination information of a connection.
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *Cursor `json:"startCursor,omitempty"`
+	EndCursor       *Cursor `json:"endCursor,omitempty"`
+}
+
+// SyncResult reports the changes that were applied by the Sync method of the entity clients.
+type SyncResult struct {
+	Inserted  int // builders that had no stored entity.
+	Updated   int // builders that differ from their stored entity.
+	Unchanged int // builders that are equal to their stored entity.
+	Deleted   int // stored entities that are absent from the desired set.
+}
+
+// SyncOption configures the Sync method of the entity clients.
+type SyncOption func(*syncOptions)
+
+// syncOptions holds the options of Sync.
+type syncOptions struct {
+	scope []func(*sql.Selector)
+}
+
+// SyncScope restricts the stored entities that are matched and deleted by Sync to the entities
+// that match the given predicates. For example, syncing the entities of one owner only:
+//
+//	res, err := client.T.Sync(ctx, builders, keys, ent.SyncScope(t.HasOwnerWith(owner.ID(id))))
+//
+func SyncScope(ps ...func(*sql.Selector)) SyncOption {
+	return func(o *syncOptions) {
+		o.scope = append(o.scope, ps...)
+	}
+}
+
+// syncKey returns the representation of the given key values that is used for matching
+// the builders of Sync with the stored entities. Time values are compared in UTC.
+func syncKey(values []Value) string {
+	key := make([]Value, len(values))
+	for i, v := range values {
+		if t, ok := v.(time.Time); ok {
+			v = t.UTC().Format(time.RFC3339Nano)
+		}
+		key[i] = v
+	}
+	return fmt.Sprintf("%#v", key)
+}
+
+// syncPredicate returns a predicate that matches the rows whose key columns hold one of the given keys.
+func syncPredicate(s *sql.Selector, columns []string, keys [][]Value) *sql.Predicate {
+	if len(columns) == 1 {
+		values := make([]interface{}, len(keys))
+		for i := range keys {
+			values[i] = keys[i][0]
+		}
+		return sql.In(s.C(columns[0]), values...)
+	}
+	or := make([]*sql.Predicate, len(keys))
+	for i, key := range keys {
+		and := make([]*sql.Predicate, len(columns))
+		for j, c := range columns {
+			and[j] = sql.EQ(s.C(c), key[j])
+		}
+		or[i] = sql.And(and...)
+	}
+	return sql.Or(or...)
+}
+
+// scanValue returns the value that was scanned to v (one of the values that were allocated by the
+// scanValues method of the entities) as a generic value. NULL values are returned as nil, nullable
+// types (e.g. sql.NullString) are returned as their driver values, and other types are dereferenced.
+func scanValue(v interface{}) (Value, error) {
+	switch v := v.(type) {
+	case *sql.NullBool:
+		return v.Value()
+	case *sql.NullInt64:
+		return v.Value()
+	case *sql.NullFloat64:
+		return v.Value()
+	case *sql.NullString:
+		return v.Value()
+	case *sql.NullTime:
+		return v.Value()
+	case *sql.NullScanner:
+		if !v.Valid {
+			return nil, nil
+		}
+		return reflect.Indirect(reflect.ValueOf(v.S)).Interface(), nil
+	case *[]byte:
+		if *v == nil {
+			return nil, nil
+		}
+		return *v, nil
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Ptr || rv.IsNil() {
+			return nil, fmt.Errorf("unexpected scan type %T", v)
+		}
+		return rv.Elem().Interface(), nil
+	}
+}
+
+// BulkResult holds the result of one batch that was saved by the CreateBulkFrom
+// method of the entity clients.
+type BulkResult struct {
+	// IDs holds the ids of the entities that were created in the batch, in the order
+	// of their builders. The type of the values is the id type of the entity.
+	IDs []Value
+	// Err is the error of the batch. If it is set, the entities of the batch were not created.
+	Err error
+}
+
+// DryRun returns a new context for running mutations in dry-run mode. The statements of the
+// create, update-one and delete builders that are executed with this context are recorded in
+// it (see DryRunStatements), and are not executed on the database. Builders return a synthetic
+// result: the created and the updated entities hold only the fields that were set (and a zero
+// id, if it is generated by the database), and deletions report zero affected rows. Bulk
+// creations and updates are not supported in dry-run mode, since they read from the database.
+func DryRun(ctx context.Context) context.Context {
+	return sql.WithDryRun(ctx)
+}
+
+// DryRunStatements returns the statements (and their arguments) that were recorded in the
+// given dry-run context, in their execution order.
+func DryRunStatements(ctx context.Context) []sql.Statement {
+	return sql.DryRunStatements(ctx)
+}
+
+// WithQueryBudget returns a new context that allows executing at most max statements by the
+// client (and its transactions), including the statements that are executed by eager-loading
+// and by bulk operations. Statements that exceed the budget are not executed, and fail with a
+// *QueryBudgetExceededError. The budget is shared by all contexts derived from the returned one.
+func WithQueryBudget(ctx context.Context, max int) context.Context {
+	return sql.WithQueryBudget(ctx, max)
+}
+
+// QueryBudgetUsed returns the number of statements that were executed with the query
+// budget of the given context, and false if the context does not have a budget.
+func QueryBudgetUsed(ctx context.Context) (int, bool) {
+	return sql.QueryBudgetUsed(ctx)
+}
+
+// QueryBudgetExceededError is returned for statements that are executed after the query
+// budget of their context was used.
+type QueryBudgetExceededError = sql.QueryBudgetExceededError
+
+// IsQueryBudgetExceeded returns a boolean indicating whether the error is a query budget error.
+func IsQueryBudgetExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *QueryBudgetExceededError
+	return errors.As(err, &e)
+}
+
+// execUpdates executes the given UPDATE statements, and returns the total number of affected rows.
+func execUpdates(ctx context.Context, drv dialect.Driver, updates []*sql.UpdateBuilder) (int, error) {
+	var affected int
+	for _, u := range updates {
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		query, args := u.Query()
+		if err := u.Err(); err != nil {
+			return 0, err
+		}
+		var res sql.Result
+		if err := drv.Exec(ctx, query, args, &res); err != nil {
+			return 0, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		affected += int(n)
+	}
+	return affected, nil
+}
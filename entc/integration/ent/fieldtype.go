@@ -7,7 +7,12 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -73,207 +78,304 @@ type FieldType struct {
 	// Decimal holds the value of the "decimal" field.
 	Decimal    float64 `json:"decimal,omitempty"`
 	file_field *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*FieldType) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},   // id
-		&sql.NullInt64{},   // int
-		&sql.NullInt64{},   // int8
-		&sql.NullInt64{},   // int16
-		&sql.NullInt64{},   // int32
-		&sql.NullInt64{},   // int64
-		&sql.NullInt64{},   // optional_int
-		&sql.NullInt64{},   // optional_int8
-		&sql.NullInt64{},   // optional_int16
-		&sql.NullInt64{},   // optional_int32
-		&sql.NullInt64{},   // optional_int64
-		&sql.NullInt64{},   // nillable_int
-		&sql.NullInt64{},   // nillable_int8
-		&sql.NullInt64{},   // nillable_int16
-		&sql.NullInt64{},   // nillable_int32
-		&sql.NullInt64{},   // nillable_int64
-		&sql.NullInt64{},   // validate_optional_int32
-		&sql.NullInt64{},   // optional_uint
-		&sql.NullInt64{},   // optional_uint8
-		&sql.NullInt64{},   // optional_uint16
-		&sql.NullInt64{},   // optional_uint32
-		&sql.NullInt64{},   // optional_uint64
-		&sql.NullString{},  // state
-		&sql.NullFloat64{}, // optional_float
-		&sql.NullFloat64{}, // optional_float32
-		&sql.NullTime{},    // datetime
-		&sql.NullFloat64{}, // decimal
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*FieldType) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // file_field
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the FieldType type are scanned as generic values.
+func (*FieldType) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case fieldtype.FieldID:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldInt:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldInt8:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldInt16:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldInt32:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldInt64:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalInt:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalInt8:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalInt16:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalInt32:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalInt64:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldNillableInt:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldNillableInt8:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldNillableInt16:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldNillableInt32:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldNillableInt64:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldValidateOptionalInt32:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalUint:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalUint8:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalUint16:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalUint32:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldOptionalUint64:
+			values[i] = &sql.NullInt64{}
+		case fieldtype.FieldState:
+			values[i] = &sql.NullString{}
+		case fieldtype.FieldOptionalFloat:
+			values[i] = &sql.NullFloat64{}
+		case fieldtype.FieldOptionalFloat32:
+			values[i] = &sql.NullFloat64{}
+		case fieldtype.FieldDatetime:
+			values[i] = &sql.NullTime{}
+		case fieldtype.FieldDecimal:
+			values[i] = &sql.NullFloat64{}
+		case fieldtype.ForeignKeys[0]: // file_field
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the FieldType fields.
-func (ft *FieldType) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(fieldtype.Columns); m < n {
+// to the FieldType fields. Values of columns that were not selected are left untouched.
+func (ft *FieldType) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	ft.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field int", values[0])
-	} else if value.Valid {
-		ft.Int = int(value.Int64)
-	}
-	if value, ok := values[1].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field int8", values[1])
-	} else if value.Valid {
-		ft.Int8 = int8(value.Int64)
-	}
-	if value, ok := values[2].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field int16", values[2])
-	} else if value.Valid {
-		ft.Int16 = int16(value.Int64)
-	}
-	if value, ok := values[3].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field int32", values[3])
-	} else if value.Valid {
-		ft.Int32 = int32(value.Int64)
-	}
-	if value, ok := values[4].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field int64", values[4])
-	} else if value.Valid {
-		ft.Int64 = value.Int64
-	}
-	if value, ok := values[5].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_int", values[5])
-	} else if value.Valid {
-		ft.OptionalInt = int(value.Int64)
-	}
-	if value, ok := values[6].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_int8", values[6])
-	} else if value.Valid {
-		ft.OptionalInt8 = int8(value.Int64)
-	}
-	if value, ok := values[7].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_int16", values[7])
-	} else if value.Valid {
-		ft.OptionalInt16 = int16(value.Int64)
-	}
-	if value, ok := values[8].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_int32", values[8])
-	} else if value.Valid {
-		ft.OptionalInt32 = int32(value.Int64)
-	}
-	if value, ok := values[9].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_int64", values[9])
-	} else if value.Valid {
-		ft.OptionalInt64 = value.Int64
-	}
-	if value, ok := values[10].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field nillable_int", values[10])
-	} else if value.Valid {
-		ft.NillableInt = new(int)
-		*ft.NillableInt = int(value.Int64)
-	}
-	if value, ok := values[11].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field nillable_int8", values[11])
-	} else if value.Valid {
-		ft.NillableInt8 = new(int8)
-		*ft.NillableInt8 = int8(value.Int64)
-	}
-	if value, ok := values[12].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field nillable_int16", values[12])
-	} else if value.Valid {
-		ft.NillableInt16 = new(int16)
-		*ft.NillableInt16 = int16(value.Int64)
-	}
-	if value, ok := values[13].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field nillable_int32", values[13])
-	} else if value.Valid {
-		ft.NillableInt32 = new(int32)
-		*ft.NillableInt32 = int32(value.Int64)
-	}
-	if value, ok := values[14].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field nillable_int64", values[14])
-	} else if value.Valid {
-		ft.NillableInt64 = new(int64)
-		*ft.NillableInt64 = value.Int64
-	}
-	if value, ok := values[15].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field validate_optional_int32", values[15])
-	} else if value.Valid {
-		ft.ValidateOptionalInt32 = int32(value.Int64)
-	}
-	if value, ok := values[16].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_uint", values[16])
-	} else if value.Valid {
-		ft.OptionalUint = uint(value.Int64)
-	}
-	if value, ok := values[17].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_uint8", values[17])
-	} else if value.Valid {
-		ft.OptionalUint8 = uint8(value.Int64)
-	}
-	if value, ok := values[18].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_uint16", values[18])
-	} else if value.Valid {
-		ft.OptionalUint16 = uint16(value.Int64)
-	}
-	if value, ok := values[19].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_uint32", values[19])
-	} else if value.Valid {
-		ft.OptionalUint32 = uint32(value.Int64)
-	}
-	if value, ok := values[20].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_uint64", values[20])
-	} else if value.Valid {
-		ft.OptionalUint64 = uint64(value.Int64)
-	}
-	if value, ok := values[21].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field state", values[21])
-	} else if value.Valid {
-		ft.State = fieldtype.State(value.String)
-	}
-	if value, ok := values[22].(*sql.NullFloat64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_float", values[22])
-	} else if value.Valid {
-		ft.OptionalFloat = value.Float64
-	}
-	if value, ok := values[23].(*sql.NullFloat64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_float32", values[23])
-	} else if value.Valid {
-		ft.OptionalFloat32 = float32(value.Float64)
-	}
-	if value, ok := values[24].(*sql.NullTime); !ok {
-		return fmt.Errorf("unexpected type %T for field datetime", values[24])
-	} else if value.Valid {
-		ft.Datetime = value.Time
-	}
-	if value, ok := values[25].(*sql.NullFloat64); !ok {
-		return fmt.Errorf("unexpected type %T for field decimal", values[25])
-	} else if value.Valid {
-		ft.Decimal = value.Float64
-	}
-	values = values[26:]
-	if len(values) == len(fieldtype.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field file_field", value)
-		} else if value.Valid {
-			ft.file_field = new(int)
-			*ft.file_field = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case fieldtype.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ft.ID = int(value.Int64)
+		case fieldtype.FieldInt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field int", values[i])
+			} else if value.Valid {
+				ft.Int = int(value.Int64)
+			}
+		case fieldtype.FieldInt8:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field int8", values[i])
+			} else if value.Valid {
+				ft.Int8 = int8(value.Int64)
+			}
+		case fieldtype.FieldInt16:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field int16", values[i])
+			} else if value.Valid {
+				ft.Int16 = int16(value.Int64)
+			}
+		case fieldtype.FieldInt32:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field int32", values[i])
+			} else if value.Valid {
+				ft.Int32 = int32(value.Int64)
+			}
+		case fieldtype.FieldInt64:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field int64", values[i])
+			} else if value.Valid {
+				ft.Int64 = value.Int64
+			}
+		case fieldtype.FieldOptionalInt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_int", values[i])
+			} else if value.Valid {
+				ft.OptionalInt = int(value.Int64)
+			}
+		case fieldtype.FieldOptionalInt8:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_int8", values[i])
+			} else if value.Valid {
+				ft.OptionalInt8 = int8(value.Int64)
+			}
+		case fieldtype.FieldOptionalInt16:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_int16", values[i])
+			} else if value.Valid {
+				ft.OptionalInt16 = int16(value.Int64)
+			}
+		case fieldtype.FieldOptionalInt32:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_int32", values[i])
+			} else if value.Valid {
+				ft.OptionalInt32 = int32(value.Int64)
+			}
+		case fieldtype.FieldOptionalInt64:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_int64", values[i])
+			} else if value.Valid {
+				ft.OptionalInt64 = value.Int64
+			}
+		case fieldtype.FieldNillableInt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nillable_int", values[i])
+			} else if value.Valid {
+				ft.NillableInt = new(int)
+				*ft.NillableInt = int(value.Int64)
+			}
+		case fieldtype.FieldNillableInt8:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nillable_int8", values[i])
+			} else if value.Valid {
+				ft.NillableInt8 = new(int8)
+				*ft.NillableInt8 = int8(value.Int64)
+			}
+		case fieldtype.FieldNillableInt16:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nillable_int16", values[i])
+			} else if value.Valid {
+				ft.NillableInt16 = new(int16)
+				*ft.NillableInt16 = int16(value.Int64)
+			}
+		case fieldtype.FieldNillableInt32:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nillable_int32", values[i])
+			} else if value.Valid {
+				ft.NillableInt32 = new(int32)
+				*ft.NillableInt32 = int32(value.Int64)
+			}
+		case fieldtype.FieldNillableInt64:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nillable_int64", values[i])
+			} else if value.Valid {
+				ft.NillableInt64 = new(int64)
+				*ft.NillableInt64 = value.Int64
+			}
+		case fieldtype.FieldValidateOptionalInt32:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field validate_optional_int32", values[i])
+			} else if value.Valid {
+				ft.ValidateOptionalInt32 = int32(value.Int64)
+			}
+		case fieldtype.FieldOptionalUint:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_uint", values[i])
+			} else if value.Valid {
+				ft.OptionalUint = uint(value.Int64)
+			}
+		case fieldtype.FieldOptionalUint8:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_uint8", values[i])
+			} else if value.Valid {
+				ft.OptionalUint8 = uint8(value.Int64)
+			}
+		case fieldtype.FieldOptionalUint16:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_uint16", values[i])
+			} else if value.Valid {
+				ft.OptionalUint16 = uint16(value.Int64)
+			}
+		case fieldtype.FieldOptionalUint32:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_uint32", values[i])
+			} else if value.Valid {
+				ft.OptionalUint32 = uint32(value.Int64)
+			}
+		case fieldtype.FieldOptionalUint64:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_uint64", values[i])
+			} else if value.Valid {
+				ft.OptionalUint64 = uint64(value.Int64)
+			}
+		case fieldtype.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				ft.State = fieldtype.State(value.String)
+			}
+		case fieldtype.FieldOptionalFloat:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_float", values[i])
+			} else if value.Valid {
+				ft.OptionalFloat = value.Float64
+			}
+		case fieldtype.FieldOptionalFloat32:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_float32", values[i])
+			} else if value.Valid {
+				ft.OptionalFloat32 = float32(value.Float64)
+			}
+		case fieldtype.FieldDatetime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field datetime", values[i])
+			} else if value.Valid {
+				ft.Datetime = value.Time
+			}
+		case fieldtype.FieldDecimal:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field decimal", values[i])
+			} else if value.Valid {
+				ft.Decimal = value.Float64
+			}
+		case fieldtype.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field file_field", value)
+			} else if value.Valid {
+				ft.file_field = new(int)
+				*ft.file_field = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (ft *FieldType) SelectValue(alias string) (Value, error) {
+	v, ok := ft.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
+// DumpGraph returns the JSON encoding of the FieldType and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the FieldType alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The FieldType itself is not modified.
+//
+//	b, err := ft.DumpGraph(ctx, client, 2)
+//
+func (ft *FieldType) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for FieldType DumpGraph", depth)
+	}
+	dumped := *ft
+	if err := dumpFieldTypes(ctx, client, []*FieldType{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpFieldTypes loads the edges of the given FieldType copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpFieldTypes(ctx context.Context, client *Client, nodes []*FieldType, depth int, dumper *graphDump) error {
+	return nil
+}
+
 // Update returns a builder for updating this FieldType.
 // Note that, you need to call FieldType.Unwrap() before calling this method, if this FieldType
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -292,6 +394,46 @@ func (ft *FieldType) Unwrap() *FieldType {
 	return ft
 }
 
+// Clone returns a deep copy of the FieldType and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (ft *FieldType) Clone() *FieldType {
+	return ft.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the FieldType and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (ft *FieldType) clone(seen map[interface{}]interface{}) *FieldType {
+	if ft == nil {
+		return nil
+	}
+	if v, ok := seen[ft]; ok {
+		return v.(*FieldType)
+	}
+	_c := *ft
+	seen[ft] = &_c
+	if v := ft.NillableInt; v != nil {
+		_v := *v
+		_c.NillableInt = &_v
+	}
+	if v := ft.NillableInt8; v != nil {
+		_v := *v
+		_c.NillableInt8 = &_v
+	}
+	if v := ft.NillableInt16; v != nil {
+		_v := *v
+		_c.NillableInt16 = &_v
+	}
+	if v := ft.NillableInt32; v != nil {
+		_v := *v
+		_c.NillableInt32 = &_v
+	}
+	if v := ft.NillableInt64; v != nil {
+		_v := *v
+		_c.NillableInt64 = &_v
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (ft *FieldType) String() string {
 	var builder strings.Builder
@@ -363,6 +505,118 @@ func (ft *FieldType) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the FieldType, for change detection and cache keys.
+// It covers the fields that are listed in fieldtype.FingerprintFields, and it does not depend on the
+// ID of the FieldType, on its edges, or on the order of the fields in the schema.
+func (ft *FieldType) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "datetime", ft.Datetime)
+	fingerprint(h, "decimal", ft.Decimal)
+	fingerprint(h, "int", ft.Int)
+	fingerprint(h, "int16", ft.Int16)
+	fingerprint(h, "int32", ft.Int32)
+	fingerprint(h, "int64", ft.Int64)
+	fingerprint(h, "int8", ft.Int8)
+	fingerprint(h, "nillable_int", ft.NillableInt)
+	fingerprint(h, "nillable_int16", ft.NillableInt16)
+	fingerprint(h, "nillable_int32", ft.NillableInt32)
+	fingerprint(h, "nillable_int64", ft.NillableInt64)
+	fingerprint(h, "nillable_int8", ft.NillableInt8)
+	fingerprint(h, "optional_float", ft.OptionalFloat)
+	fingerprint(h, "optional_float32", ft.OptionalFloat32)
+	fingerprint(h, "optional_int", ft.OptionalInt)
+	fingerprint(h, "optional_int16", ft.OptionalInt16)
+	fingerprint(h, "optional_int32", ft.OptionalInt32)
+	fingerprint(h, "optional_int64", ft.OptionalInt64)
+	fingerprint(h, "optional_int8", ft.OptionalInt8)
+	fingerprint(h, "optional_uint", ft.OptionalUint)
+	fingerprint(h, "optional_uint16", ft.OptionalUint16)
+	fingerprint(h, "optional_uint32", ft.OptionalUint32)
+	fingerprint(h, "optional_uint64", ft.OptionalUint64)
+	fingerprint(h, "optional_uint8", ft.OptionalUint8)
+	fingerprint(h, "state", ft.State)
+	fingerprint(h, "validate_optional_int32", ft.ValidateOptionalInt32)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the FieldType for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The FieldType can be decoded using the FromMap method of its client.
+func (ft *FieldType) ToMap() map[string]string {
+	m := make(map[string]string, 27)
+	m[fieldtype.FieldID] = kvEncode(ft.ID)
+	m[fieldtype.FieldInt] = kvEncode(ft.Int)
+	m[fieldtype.FieldInt8] = kvEncode(ft.Int8)
+	m[fieldtype.FieldInt16] = kvEncode(ft.Int16)
+	m[fieldtype.FieldInt32] = kvEncode(ft.Int32)
+	m[fieldtype.FieldInt64] = kvEncode(ft.Int64)
+	if v := ft.OptionalInt; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt] = kvEncode(v)
+	}
+	if v := ft.OptionalInt8; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt8] = kvEncode(v)
+	}
+	if v := ft.OptionalInt16; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt16] = kvEncode(v)
+	}
+	if v := ft.OptionalInt32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt32] = kvEncode(v)
+	}
+	if v := ft.OptionalInt64; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt64] = kvEncode(v)
+	}
+	if v := ft.NillableInt; v != nil {
+		m[fieldtype.FieldNillableInt] = kvEncode(*v)
+	}
+	if v := ft.NillableInt8; v != nil {
+		m[fieldtype.FieldNillableInt8] = kvEncode(*v)
+	}
+	if v := ft.NillableInt16; v != nil {
+		m[fieldtype.FieldNillableInt16] = kvEncode(*v)
+	}
+	if v := ft.NillableInt32; v != nil {
+		m[fieldtype.FieldNillableInt32] = kvEncode(*v)
+	}
+	if v := ft.NillableInt64; v != nil {
+		m[fieldtype.FieldNillableInt64] = kvEncode(*v)
+	}
+	if v := ft.ValidateOptionalInt32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldValidateOptionalInt32] = kvEncode(v)
+	}
+	if v := ft.OptionalUint; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint] = kvEncode(v)
+	}
+	if v := ft.OptionalUint8; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint8] = kvEncode(v)
+	}
+	if v := ft.OptionalUint16; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint16] = kvEncode(v)
+	}
+	if v := ft.OptionalUint32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint32] = kvEncode(v)
+	}
+	if v := ft.OptionalUint64; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint64] = kvEncode(v)
+	}
+	if v := ft.State; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldState] = kvEncode(v)
+	}
+	if v := ft.OptionalFloat; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalFloat] = kvEncode(v)
+	}
+	if v := ft.OptionalFloat32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalFloat32] = kvEncode(v)
+	}
+	if v := ft.Datetime; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldDatetime] = kvEncode(v)
+	}
+	if v := ft.Decimal; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldDecimal] = kvEncode(v)
+	}
+	return m
+}
+
 // FieldTypes is a parsable slice of FieldType.
 type FieldTypes []*FieldType
 
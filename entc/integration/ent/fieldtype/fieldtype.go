@@ -8,6 +8,8 @@ package fieldtype
 
 import (
 	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
 )
 
 const (
@@ -46,6 +48,37 @@ const (
 	Table = "field_types"
 )
 
+// FingerprintFields holds the fields of the FieldType type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldDatetime,
+	FieldDecimal,
+	FieldInt,
+	FieldInt16,
+	FieldInt32,
+	FieldInt64,
+	FieldInt8,
+	FieldNillableInt,
+	FieldNillableInt16,
+	FieldNillableInt32,
+	FieldNillableInt64,
+	FieldNillableInt8,
+	FieldOptionalFloat,
+	FieldOptionalFloat32,
+	FieldOptionalInt,
+	FieldOptionalInt16,
+	FieldOptionalInt32,
+	FieldOptionalInt64,
+	FieldOptionalInt8,
+	FieldOptionalUint,
+	FieldOptionalUint16,
+	FieldOptionalUint32,
+	FieldOptionalUint64,
+	FieldOptionalUint8,
+	FieldState,
+	FieldValidateOptionalInt32,
+}
+
 // Columns holds all SQL columns for fieldtype fields.
 var Columns = []string{
 	FieldID,
@@ -82,6 +115,22 @@ var ForeignKeys = []string{
 	"file_field",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// DatetimeTrunc returns a column that truncates the datetime field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "datetime_<bucket>".
+func DatetimeTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldDatetime)
+}
+
 var (
 	// ValidateOptionalInt32Validator is a validator for the "validate_optional_int32" field. It is called by the builders before save.
 	ValidateOptionalInt32Validator func(int32) error
@@ -7,6 +7,7 @@
 package fieldtype
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -2513,6 +2514,343 @@ func DecimalNotNil() predicate.FieldType {
 	})
 }
 
+// OptionalIntEQNullSafe applies a NULL-safe equality predicate on the "optional_int" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalIntEQNullSafe(v *int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalInt), arg))
+	})
+}
+
+// OptionalInt8EQNullSafe applies a NULL-safe equality predicate on the "optional_int8" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalInt8EQNullSafe(v *int8) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalInt8), arg))
+	})
+}
+
+// OptionalInt16EQNullSafe applies a NULL-safe equality predicate on the "optional_int16" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalInt16EQNullSafe(v *int16) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalInt16), arg))
+	})
+}
+
+// OptionalInt32EQNullSafe applies a NULL-safe equality predicate on the "optional_int32" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalInt32EQNullSafe(v *int32) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalInt32), arg))
+	})
+}
+
+// OptionalInt64EQNullSafe applies a NULL-safe equality predicate on the "optional_int64" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalInt64EQNullSafe(v *int64) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalInt64), arg))
+	})
+}
+
+// NillableIntEQNullSafe applies a NULL-safe equality predicate on the "nillable_int" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NillableIntEQNullSafe(v *int) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNillableInt), arg))
+	})
+}
+
+// NillableInt8EQNullSafe applies a NULL-safe equality predicate on the "nillable_int8" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NillableInt8EQNullSafe(v *int8) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNillableInt8), arg))
+	})
+}
+
+// NillableInt16EQNullSafe applies a NULL-safe equality predicate on the "nillable_int16" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NillableInt16EQNullSafe(v *int16) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNillableInt16), arg))
+	})
+}
+
+// NillableInt32EQNullSafe applies a NULL-safe equality predicate on the "nillable_int32" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NillableInt32EQNullSafe(v *int32) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNillableInt32), arg))
+	})
+}
+
+// NillableInt64EQNullSafe applies a NULL-safe equality predicate on the "nillable_int64" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NillableInt64EQNullSafe(v *int64) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNillableInt64), arg))
+	})
+}
+
+// ValidateOptionalInt32EQNullSafe applies a NULL-safe equality predicate on the "validate_optional_int32" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func ValidateOptionalInt32EQNullSafe(v *int32) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldValidateOptionalInt32), arg))
+	})
+}
+
+// OptionalUintEQNullSafe applies a NULL-safe equality predicate on the "optional_uint" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalUintEQNullSafe(v *uint) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalUint), arg))
+	})
+}
+
+// OptionalUint8EQNullSafe applies a NULL-safe equality predicate on the "optional_uint8" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalUint8EQNullSafe(v *uint8) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalUint8), arg))
+	})
+}
+
+// OptionalUint16EQNullSafe applies a NULL-safe equality predicate on the "optional_uint16" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalUint16EQNullSafe(v *uint16) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalUint16), arg))
+	})
+}
+
+// OptionalUint32EQNullSafe applies a NULL-safe equality predicate on the "optional_uint32" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalUint32EQNullSafe(v *uint32) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalUint32), arg))
+	})
+}
+
+// OptionalUint64EQNullSafe applies a NULL-safe equality predicate on the "optional_uint64" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalUint64EQNullSafe(v *uint64) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalUint64), arg))
+	})
+}
+
+// StateEQNullSafe applies a NULL-safe equality predicate on the "state" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func StateEQNullSafe(v *State) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldState), arg))
+	})
+}
+
+// OptionalFloatEQNullSafe applies a NULL-safe equality predicate on the "optional_float" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalFloatEQNullSafe(v *float64) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalFloat), arg))
+	})
+}
+
+// OptionalFloat32EQNullSafe applies a NULL-safe equality predicate on the "optional_float32" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalFloat32EQNullSafe(v *float32) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalFloat32), arg))
+	})
+}
+
+// DatetimeEQNullSafe applies a NULL-safe equality predicate on the "datetime" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func DatetimeEQNullSafe(v *time.Time) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldDatetime), arg))
+	})
+}
+
+// DecimalEQNullSafe applies a NULL-safe equality predicate on the "decimal" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func DecimalEQNullSafe(v *float64) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldDecimal), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldDatetime:              "time",
+	FieldDecimal:               "numeric",
+	FieldID:                    "numeric",
+	FieldInt:                   "numeric",
+	FieldInt16:                 "numeric",
+	FieldInt32:                 "numeric",
+	FieldInt64:                 "numeric",
+	FieldInt8:                  "numeric",
+	FieldNillableInt:           "numeric",
+	FieldNillableInt16:         "numeric",
+	FieldNillableInt32:         "numeric",
+	FieldNillableInt64:         "numeric",
+	FieldNillableInt8:          "numeric",
+	FieldOptionalFloat:         "numeric",
+	FieldOptionalFloat32:       "numeric",
+	FieldOptionalInt:           "numeric",
+	FieldOptionalInt16:         "numeric",
+	FieldOptionalInt32:         "numeric",
+	FieldOptionalInt64:         "numeric",
+	FieldOptionalInt8:          "numeric",
+	FieldOptionalUint:          "numeric",
+	FieldOptionalUint16:        "numeric",
+	FieldOptionalUint32:        "numeric",
+	FieldOptionalUint64:        "numeric",
+	FieldOptionalUint8:         "numeric",
+	FieldState:                 "string",
+	FieldValidateOptionalInt32: "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the FieldType.
+func FieldsEQ(f1, f2 string) predicate.FieldType {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the FieldType.
+func FieldsNEQ(f1, f2 string) predicate.FieldType {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the FieldType.
+//
+//	fieldtype.FieldsLT(fieldtype.FieldInt, fieldtype.FieldInt8)
+//
+func FieldsLT(f1, f2 string) predicate.FieldType {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the FieldType.
+func FieldsLTE(f1, f2 string) predicate.FieldType {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the FieldType.
+func FieldsGT(f1, f2 string) predicate.FieldType {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the FieldType.
+func FieldsGTE(f1, f2 string) predicate.FieldType {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the FieldType using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.FieldType {
+	return predicate.FieldType(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of FieldType", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of FieldType", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of FieldType are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.FieldType) predicate.FieldType {
 	return predicate.FieldType(func(s *sql.Selector) {
@@ -2520,6 +2858,9 @@ func And(predicates ...predicate.FieldType) predicate.FieldType {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -2534,6 +2875,9 @@ func Or(predicates ...predicate.FieldType) predicate.FieldType {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -2544,3 +2888,144 @@ func Not(p predicate.FieldType) predicate.FieldType {
 		p(s.Not())
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// FieldTypeFilter holds optional filters on the FieldType fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type FieldTypeFilter struct {
+	IDIn                  []int
+	Int                   *int
+	Int8                  *int8
+	Int16                 *int16
+	Int32                 *int32
+	Int64                 *int64
+	OptionalInt           *int
+	OptionalInt8          *int8
+	OptionalInt16         *int16
+	OptionalInt32         *int32
+	OptionalInt64         *int64
+	NillableInt           *int
+	NillableInt8          *int8
+	NillableInt16         *int16
+	NillableInt32         *int32
+	NillableInt64         *int64
+	ValidateOptionalInt32 *int32
+	OptionalUint          *uint
+	OptionalUint8         *uint8
+	OptionalUint16        *uint16
+	OptionalUint32        *uint32
+	OptionalUint64        *uint64
+	State                 *State
+	OptionalFloat         *float64
+	OptionalFloat32       *float32
+	Datetime              *TimeRange
+	Decimal               *float64
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.FieldType.Query().
+//		Where(fieldtype.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *FieldTypeFilter) []predicate.FieldType {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.FieldType
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Int != nil {
+		ps = append(ps, IntEQ(*f.Int))
+	}
+	if f.Int8 != nil {
+		ps = append(ps, Int8EQ(*f.Int8))
+	}
+	if f.Int16 != nil {
+		ps = append(ps, Int16EQ(*f.Int16))
+	}
+	if f.Int32 != nil {
+		ps = append(ps, Int32EQ(*f.Int32))
+	}
+	if f.Int64 != nil {
+		ps = append(ps, Int64EQ(*f.Int64))
+	}
+	if f.OptionalInt != nil {
+		ps = append(ps, OptionalIntEQ(*f.OptionalInt))
+	}
+	if f.OptionalInt8 != nil {
+		ps = append(ps, OptionalInt8EQ(*f.OptionalInt8))
+	}
+	if f.OptionalInt16 != nil {
+		ps = append(ps, OptionalInt16EQ(*f.OptionalInt16))
+	}
+	if f.OptionalInt32 != nil {
+		ps = append(ps, OptionalInt32EQ(*f.OptionalInt32))
+	}
+	if f.OptionalInt64 != nil {
+		ps = append(ps, OptionalInt64EQ(*f.OptionalInt64))
+	}
+	if f.NillableInt != nil {
+		ps = append(ps, NillableIntEQ(*f.NillableInt))
+	}
+	if f.NillableInt8 != nil {
+		ps = append(ps, NillableInt8EQ(*f.NillableInt8))
+	}
+	if f.NillableInt16 != nil {
+		ps = append(ps, NillableInt16EQ(*f.NillableInt16))
+	}
+	if f.NillableInt32 != nil {
+		ps = append(ps, NillableInt32EQ(*f.NillableInt32))
+	}
+	if f.NillableInt64 != nil {
+		ps = append(ps, NillableInt64EQ(*f.NillableInt64))
+	}
+	if f.ValidateOptionalInt32 != nil {
+		ps = append(ps, ValidateOptionalInt32EQ(*f.ValidateOptionalInt32))
+	}
+	if f.OptionalUint != nil {
+		ps = append(ps, OptionalUintEQ(*f.OptionalUint))
+	}
+	if f.OptionalUint8 != nil {
+		ps = append(ps, OptionalUint8EQ(*f.OptionalUint8))
+	}
+	if f.OptionalUint16 != nil {
+		ps = append(ps, OptionalUint16EQ(*f.OptionalUint16))
+	}
+	if f.OptionalUint32 != nil {
+		ps = append(ps, OptionalUint32EQ(*f.OptionalUint32))
+	}
+	if f.OptionalUint64 != nil {
+		ps = append(ps, OptionalUint64EQ(*f.OptionalUint64))
+	}
+	if f.State != nil {
+		ps = append(ps, StateEQ(*f.State))
+	}
+	if f.OptionalFloat != nil {
+		ps = append(ps, OptionalFloatEQ(*f.OptionalFloat))
+	}
+	if f.OptionalFloat32 != nil {
+		ps = append(ps, OptionalFloat32EQ(*f.OptionalFloat32))
+	}
+	if r := f.Datetime; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, DatetimeGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, DatetimeLT(r.To))
+		}
+	}
+	if f.Decimal != nil {
+		ps = append(ps, DecimalEQ(*f.Decimal))
+	}
+	return ps
+}
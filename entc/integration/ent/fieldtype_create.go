@@ -10,8 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
 	"github.com/facebookincubator/ent/schema/field"
@@ -350,30 +352,12 @@ func (ftc *FieldTypeCreate) SetNillableDecimal(f *float64) *FieldTypeCreate {
 
 // Save creates the FieldType in the database.
 func (ftc *FieldTypeCreate) Save(ctx context.Context) (*FieldType, error) {
-	if _, ok := ftc.mutation.Int(); !ok {
-		return nil, errors.New("ent: missing required field \"int\"")
-	}
-	if _, ok := ftc.mutation.Int8(); !ok {
-		return nil, errors.New("ent: missing required field \"int8\"")
-	}
-	if _, ok := ftc.mutation.Int16(); !ok {
-		return nil, errors.New("ent: missing required field \"int16\"")
-	}
-	if _, ok := ftc.mutation.Int32(); !ok {
-		return nil, errors.New("ent: missing required field \"int32\"")
-	}
-	if _, ok := ftc.mutation.Int64(); !ok {
-		return nil, errors.New("ent: missing required field \"int64\"")
-	}
-	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
-		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
-		}
+	if err := checkTx(ftc.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := ftc.mutation.State(); ok {
-		if err := fieldtype.StateValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
-		}
+	ftc.defaults()
+	if err := ftc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -410,17 +394,137 @@ func (ftc *FieldTypeCreate) SaveX(ctx context.Context) *FieldType {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftc *FieldTypeCreate) Unwrap() *FieldTypeCreate {
+	tx, ok := ftc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeCreate is not a transactional builder")
+	}
+	ftc.config.driver = tx.drv
+	ftc.mutation.driver = tx.drv
+	return ftc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (ftc *FieldTypeCreate) DebugFields() map[string]Value {
+	return debugFields(ftc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (ftc *FieldTypeCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ftc *FieldTypeCreate) check() error {
+	if _, ok := ftc.mutation.Int(); !ok {
+		return &ValidationError{Name: "int", err: errors.New("ent: missing required field \"int\"")}
+	}
+	if _, ok := ftc.mutation.Int8(); !ok {
+		return &ValidationError{Name: "int8", err: errors.New("ent: missing required field \"int8\"")}
+	}
+	if _, ok := ftc.mutation.Int16(); !ok {
+		return &ValidationError{Name: "int16", err: errors.New("ent: missing required field \"int16\"")}
+	}
+	if _, ok := ftc.mutation.Int32(); !ok {
+		return &ValidationError{Name: "int32", err: errors.New("ent: missing required field \"int32\"")}
+	}
+	if _, ok := ftc.mutation.Int64(); !ok {
+		return &ValidationError{Name: "int64", err: errors.New("ent: missing required field \"int64\"")}
+	}
+	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
+			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if v, ok := ftc.mutation.State(); ok {
+		if err := fieldtype.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
-	var (
-		ft    = &FieldType{config: ftc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: fieldtype.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: fieldtype.FieldID,
-			},
+	ctx = ftc.withOperation(ctx, "FieldType", "Create")
+	ft, _spec := ftc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ftc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	ft.ID = int(id)
+	return ft, nil
+}
+
+// SaveID creates the FieldType in the database and returns only its id. Unlike Save,
+// the FieldType entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// FieldType that holds only its id as the value that is returned by the next mutator.
+func (ftc *FieldTypeCreate) SaveID(ctx context.Context) (id int, err error) {
+	ftc.defaults()
+	if err = ftc.check(); err != nil {
+		return id, err
+	}
+	if len(ftc.hooks) == 0 {
+		return ftc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*FieldTypeMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		ftc.mutation = mutation
+		if id, err = ftc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &FieldType{config: ftc.config, ID: id}, nil
+	})
+	for i := len(ftc.hooks) - 1; i >= 0; i-- {
+		mut = ftc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, ftc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (ftc *FieldTypeCreate) SaveIDX(ctx context.Context) int {
+	id, err := ftc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (ftc *FieldTypeCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = ftc.withOperation(ctx, "FieldType", "Create")
+	_spec := ftc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, ftc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (ftc *FieldTypeCreate) createSpec() (*FieldType, *sqlgraph.CreateSpec) {
+	ft := &FieldType{config: ftc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: fieldtype.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: fieldtype.FieldID,
+		},
+	}
 	if value, ok := ftc.mutation.Int(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -629,13 +733,542 @@ func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
 		})
 		ft.Decimal = value
 	}
-	if err := sqlgraph.CreateNode(ctx, ftc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return ft, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (ftc *FieldTypeCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: fieldtype.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: fieldtype.FieldID,
+		},
+	}
+	if value, ok := ftc.mutation.Int(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldInt,
+		})
+	}
+	if value, ok := ftc.mutation.Int8(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldInt8,
+		})
+	}
+	if value, ok := ftc.mutation.Int16(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldInt16,
+		})
+	}
+	if value, ok := ftc.mutation.Int32(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldInt32,
+		})
+	}
+	if value, ok := ftc.mutation.Int64(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldInt64,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalInt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalInt8(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt8,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalInt16(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt16,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalInt32(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt32,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalInt64(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalInt64,
+		})
+	}
+	if value, ok := ftc.mutation.NillableInt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt,
+		})
+	}
+	if value, ok := ftc.mutation.NillableInt8(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt8,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt8,
+		})
+	}
+	if value, ok := ftc.mutation.NillableInt16(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt16,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt16,
+		})
+	}
+	if value, ok := ftc.mutation.NillableInt32(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt32,
+		})
+	}
+	if value, ok := ftc.mutation.NillableInt64(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: fieldtype.FieldNillableInt64,
+		})
+	}
+	if value, ok := ftc.mutation.ValidateOptionalInt32(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt32,
+			Value:  value,
+			Column: fieldtype.FieldValidateOptionalInt32,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalUint(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalUint8(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint8,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint8,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalUint16(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint16,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint16,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalUint32(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint32,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalUint64(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalUint64,
+		})
+	}
+	if value, ok := ftc.mutation.State(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: fieldtype.FieldState,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalFloat(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldOptionalFloat,
+		})
+	}
+	if value, ok := ftc.mutation.OptionalFloat32(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat32,
+			Value:  value,
+			Column: fieldtype.FieldOptionalFloat32,
+		})
+	}
+	if value, ok := ftc.mutation.Datetime(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: fieldtype.FieldDatetime,
+		})
+	}
+	if value, ok := ftc.mutation.Decimal(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: fieldtype.FieldDecimal,
+		})
+	}
+	return _spec
+}
+
+// FieldTypeCreateBulk is the builder for creating a bulk of FieldType entities.
+type FieldTypeCreateBulk struct {
+	config
+	builders []*FieldTypeCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the FieldType entities in the database.
+func (ftcb *FieldTypeCreateBulk) Save(ctx context.Context) ([]*FieldType, error) {
+	nodes, err := ftcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ftcb.retries > 0 && isSQLDeadlockError(err) {
+		return ftcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ftcb *FieldTypeCreateBulk) SaveX(ctx context.Context) []*FieldType {
+	v, err := ftcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ftcb *FieldTypeCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ftcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ftcb *FieldTypeCreateBulk) CheckRefs() *FieldTypeCreateBulk {
+	ftcb.refs = true
+	return ftcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ftcb *FieldTypeCreateBulk) RetryOnDeadlock(max int) *FieldTypeCreateBulk {
+	ftcb.retries = max
+	return ftcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.FieldType.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.FieldType.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ftcb *FieldTypeCreateBulk) OnConflict(columns ...string) *FieldTypeUpsertBulk {
+	return &FieldTypeUpsertBulk{create: ftcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.FieldType.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (ftc *FieldTypeCreate) OnConflict(target ...sql.ConflictOption) *FieldTypeUpsertBulk {
+	bulk := &FieldTypeCreateBulk{config: ftc.config, builders: []*FieldTypeCreate{ftc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ftcb *FieldTypeCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*FieldType, error) {
+	ctx = ftcb.withOperation(ctx, "FieldType", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ftcb.builders))
+		nodes    = make([]*FieldType, len(ftcb.builders))
+		mutators = make([]Mutator, len(ftcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
 		}
+	}
+	for i := range ftcb.builders {
+		func(i int, root context.Context) {
+			builder := ftcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FieldTypeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ftcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ftcb.refs {
+						if err := ftcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ftcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ftcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ftcb *FieldTypeCreateBulk) retry(ctx context.Context, err error) ([]*FieldType, error) {
+	if _, ok := ftcb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	ft.ID = int(id)
-	return ft, nil
+	builders := ftcb.builders
+	defer func() { ftcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ftcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ftcb.builders = make([]*FieldTypeCreate, len(idx))
+	for i, j := range idx {
+		ftcb.builders[i] = builders[j]
+	}
+	for n := 0; n < ftcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*FieldType
+		if sorted, err = ftcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*FieldType, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ftcb *FieldTypeCreateBulk) less(a, b *FieldTypeCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ftcb *FieldTypeCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "FieldType", Missing: missing}
+	}
+	return nil
+}
+
+// FieldTypeUpsertBulk is the builder for the conflict handling of a bulk of FieldType entities.
+type FieldTypeUpsertBulk struct {
+	create  *FieldTypeCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (ftub *FieldTypeUpsertBulk) Target(target ...sql.ConflictOption) *FieldTypeUpsertBulk {
+	ftub.target = append(ftub.target, target...)
+	return ftub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (ftub *FieldTypeUpsertBulk) DoNothing() *FieldTypeUpsertBulk {
+	ftub.nothing = true
+	return ftub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (ftub *FieldTypeUpsertBulk) UpdateNewValues() *FieldTypeUpsertBulk {
+	ftub.update = true
+	return ftub
+}
+
+// Save creates the FieldType entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (ftub *FieldTypeUpsertBulk) Save(ctx context.Context) ([]*FieldType, int, error) {
+	switch {
+	case !ftub.nothing && !ftub.update:
+		return nil, 0, errors.New("ent: missing conflict action for FieldType bulk insert")
+	case ftub.nothing && ftub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for FieldType bulk insert")
+	case ftub.update:
+		if len(ftub.columns) == 0 && len(ftub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for FieldType bulk upsert")
+		}
+		nodes, err := ftub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(ftub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(fieldtype.FieldID),
+			),
+			Columns:         fieldtype.Columns,
+			ConflictColumns: ftub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(ftub.conflictTarget(), sql.DoNothing())}
+	nodes, err := ftub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*FieldType, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (ftub *FieldTypeUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(ftub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(ftub.columns...))
+	}
+	return append(opts, ftub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ftub *FieldTypeUpsertBulk) SaveX(ctx context.Context) ([]*FieldType, int) {
+	nodes, skipped, err := ftub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (ftub *FieldTypeUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := ftub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftub *FieldTypeUpsertBulk) ExecX(ctx context.Context) {
+	if err := ftub.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -23,6 +23,7 @@ type FieldTypeDelete struct {
 	hooks      []Hook
 	mutation   *FieldTypeMutation
 	predicates []predicate.FieldType
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (ftd *FieldTypeDelete) Where(ps ...predicate.FieldType) *FieldTypeDelete {
 	return ftd
 }
 
+// Limit limits the number of fieldtypes that are deleted by the builder. For example,
+// for deleting large amounts of fieldtypes in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (ftd *FieldTypeDelete) Limit(limit int) *FieldTypeDelete {
+	ftd.limit = &limit
+	return ftd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftd *FieldTypeDelete) Unwrap() *FieldTypeDelete {
+	tx, ok := ftd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeDelete is not a transactional builder")
+	}
+	ftd.config.driver = tx.drv
+	ftd.mutation.driver = tx.drv
+	return ftd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (ftd *FieldTypeDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(ftd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (ftd *FieldTypeDelete) ExecX(ctx context.Context) int {
 }
 
 func (ftd *FieldTypeDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = ftd.withOperation(ctx, "FieldType", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: fieldtype.Table,
@@ -85,7 +111,10 @@ func (ftd *FieldTypeDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, ftd.driver, _spec)
+	if limit := ftd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, ftd.mutationDriver(ctx), _spec)
 }
 
 // FieldTypeDeleteOne is the builder for deleting a single FieldType entity.
@@ -99,7 +128,7 @@ func (ftdo *FieldTypeDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{fieldtype.Label}
 	default:
 		return nil
@@ -24,6 +24,9 @@ type FieldTypeUpdate struct {
 	hooks      []Hook
 	mutation   *FieldTypeMutation
 	predicates []predicate.FieldType
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,6 +35,13 @@ func (ftu *FieldTypeUpdate) Where(ps ...predicate.FieldType) *FieldTypeUpdate {
 	return ftu
 }
 
+// Limit limits the number of fieldtypes that are updated by the builder. For example,
+// for updating large amounts of fieldtypes in small batches without locking the whole table.
+func (ftu *FieldTypeUpdate) Limit(limit int) *FieldTypeUpdate {
+	ftu.limit = &limit
+	return ftu
+}
+
 // SetInt sets the int field.
 func (ftu *FieldTypeUpdate) SetInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetInt()
@@ -45,6 +55,13 @@ func (ftu *FieldTypeUpdate) AddInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt removes the changes of the int field from the builder (e.g. a previous call
+// to SetInt), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt() *FieldTypeUpdate {
+	ftu.mutation.ResetInt()
+	return ftu
+}
+
 // SetInt8 sets the int8 field.
 func (ftu *FieldTypeUpdate) SetInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetInt8()
@@ -58,6 +75,13 @@ func (ftu *FieldTypeUpdate) AddInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt8 removes the changes of the int8 field from the builder (e.g. a previous call
+// to SetInt8), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt8() *FieldTypeUpdate {
+	ftu.mutation.ResetInt8()
+	return ftu
+}
+
 // SetInt16 sets the int16 field.
 func (ftu *FieldTypeUpdate) SetInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetInt16()
@@ -71,6 +95,13 @@ func (ftu *FieldTypeUpdate) AddInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt16 removes the changes of the int16 field from the builder (e.g. a previous call
+// to SetInt16), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt16() *FieldTypeUpdate {
+	ftu.mutation.ResetInt16()
+	return ftu
+}
+
 // SetInt32 sets the int32 field.
 func (ftu *FieldTypeUpdate) SetInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetInt32()
@@ -84,6 +115,13 @@ func (ftu *FieldTypeUpdate) AddInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt32 removes the changes of the int32 field from the builder (e.g. a previous call
+// to SetInt32), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetInt32()
+	return ftu
+}
+
 // SetInt64 sets the int64 field.
 func (ftu *FieldTypeUpdate) SetInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetInt64()
@@ -97,6 +135,13 @@ func (ftu *FieldTypeUpdate) AddInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt64 removes the changes of the int64 field from the builder (e.g. a previous call
+// to SetInt64), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt64() *FieldTypeUpdate {
+	ftu.mutation.ResetInt64()
+	return ftu
+}
+
 // SetOptionalInt sets the optional_int field.
 func (ftu *FieldTypeUpdate) SetOptionalInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt()
@@ -124,6 +169,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt removes the changes of the optional_int field from the builder (e.g. a previous call
+// to SetOptionalInt), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt()
+	return ftu
+}
+
 // SetOptionalInt8 sets the optional_int8 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt8()
@@ -151,6 +204,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt8() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt8 removes the changes of the optional_int8 field from the builder (e.g. a previous call
+// to SetOptionalInt8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt8.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt8() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt8()
+	return ftu
+}
+
 // SetOptionalInt16 sets the optional_int16 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt16()
@@ -178,6 +239,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt16() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt16 removes the changes of the optional_int16 field from the builder (e.g. a previous call
+// to SetOptionalInt16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt16.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt16() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt16()
+	return ftu
+}
+
 // SetOptionalInt32 sets the optional_int32 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt32()
@@ -205,6 +274,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt32 removes the changes of the optional_int32 field from the builder (e.g. a previous call
+// to SetOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt32.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt32()
+	return ftu
+}
+
 // SetOptionalInt64 sets the optional_int64 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt64()
@@ -232,6 +309,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt64() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt64 removes the changes of the optional_int64 field from the builder (e.g. a previous call
+// to SetOptionalInt64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt64.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt64() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt64()
+	return ftu
+}
+
 // SetNillableInt sets the nillable_int field.
 func (ftu *FieldTypeUpdate) SetNillableInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt()
@@ -259,6 +344,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (ftu *FieldTypeUpdate) UnsetNillableInt() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt()
+	return ftu
+}
+
 // SetNillableInt8 sets the nillable_int8 field.
 func (ftu *FieldTypeUpdate) SetNillableInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt8()
@@ -286,6 +379,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt8() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt8 removes the changes of the nillable_int8 field from the builder (e.g. a previous call
+// to SetNillableInt8), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt8.
+func (ftu *FieldTypeUpdate) UnsetNillableInt8() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt8()
+	return ftu
+}
+
 // SetNillableInt16 sets the nillable_int16 field.
 func (ftu *FieldTypeUpdate) SetNillableInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt16()
@@ -313,6 +414,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt16() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt16 removes the changes of the nillable_int16 field from the builder (e.g. a previous call
+// to SetNillableInt16), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt16.
+func (ftu *FieldTypeUpdate) UnsetNillableInt16() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt16()
+	return ftu
+}
+
 // SetNillableInt32 sets the nillable_int32 field.
 func (ftu *FieldTypeUpdate) SetNillableInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt32()
@@ -340,6 +449,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt32 removes the changes of the nillable_int32 field from the builder (e.g. a previous call
+// to SetNillableInt32), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt32.
+func (ftu *FieldTypeUpdate) UnsetNillableInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt32()
+	return ftu
+}
+
 // SetNillableInt64 sets the nillable_int64 field.
 func (ftu *FieldTypeUpdate) SetNillableInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt64()
@@ -367,6 +484,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt64() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt64 removes the changes of the nillable_int64 field from the builder (e.g. a previous call
+// to SetNillableInt64), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt64.
+func (ftu *FieldTypeUpdate) UnsetNillableInt64() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt64()
+	return ftu
+}
+
 // SetValidateOptionalInt32 sets the validate_optional_int32 field.
 func (ftu *FieldTypeUpdate) SetValidateOptionalInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetValidateOptionalInt32()
@@ -394,6 +519,14 @@ func (ftu *FieldTypeUpdate) ClearValidateOptionalInt32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetValidateOptionalInt32 removes the changes of the validate_optional_int32 field from the builder (e.g. a previous call
+// to SetValidateOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearValidateOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearValidateOptionalInt32.
+func (ftu *FieldTypeUpdate) UnsetValidateOptionalInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetValidateOptionalInt32()
+	return ftu
+}
+
 // SetOptionalUint sets the optional_uint field.
 func (ftu *FieldTypeUpdate) SetOptionalUint(u uint) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint()
@@ -421,6 +554,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint removes the changes of the optional_uint field from the builder (e.g. a previous call
+// to SetOptionalUint), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint()
+	return ftu
+}
+
 // SetOptionalUint8 sets the optional_uint8 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint8(u uint8) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint8()
@@ -448,6 +589,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint8() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint8 removes the changes of the optional_uint8 field from the builder (e.g. a previous call
+// to SetOptionalUint8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint8.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint8() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint8()
+	return ftu
+}
+
 // SetOptionalUint16 sets the optional_uint16 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint16(u uint16) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint16()
@@ -475,6 +624,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint16() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint16 removes the changes of the optional_uint16 field from the builder (e.g. a previous call
+// to SetOptionalUint16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint16.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint16() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint16()
+	return ftu
+}
+
 // SetOptionalUint32 sets the optional_uint32 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint32(u uint32) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint32()
@@ -502,6 +659,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint32 removes the changes of the optional_uint32 field from the builder (e.g. a previous call
+// to SetOptionalUint32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint32.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint32() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint32()
+	return ftu
+}
+
 // SetOptionalUint64 sets the optional_uint64 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint64(u uint64) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint64()
@@ -529,6 +694,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint64() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint64 removes the changes of the optional_uint64 field from the builder (e.g. a previous call
+// to SetOptionalUint64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint64.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint64() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint64()
+	return ftu
+}
+
 // SetState sets the state field.
 func (ftu *FieldTypeUpdate) SetState(f fieldtype.State) *FieldTypeUpdate {
 	ftu.mutation.SetState(f)
@@ -549,6 +722,14 @@ func (ftu *FieldTypeUpdate) ClearState() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (ftu *FieldTypeUpdate) UnsetState() *FieldTypeUpdate {
+	ftu.mutation.ResetState()
+	return ftu
+}
+
 // SetOptionalFloat sets the optional_float field.
 func (ftu *FieldTypeUpdate) SetOptionalFloat(f float64) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalFloat()
@@ -576,6 +757,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalFloat() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalFloat removes the changes of the optional_float field from the builder (e.g. a previous call
+// to SetOptionalFloat), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat.
+func (ftu *FieldTypeUpdate) UnsetOptionalFloat() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalFloat()
+	return ftu
+}
+
 // SetOptionalFloat32 sets the optional_float32 field.
 func (ftu *FieldTypeUpdate) SetOptionalFloat32(f float32) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalFloat32()
@@ -603,6 +792,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalFloat32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalFloat32 removes the changes of the optional_float32 field from the builder (e.g. a previous call
+// to SetOptionalFloat32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat32.
+func (ftu *FieldTypeUpdate) UnsetOptionalFloat32() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalFloat32()
+	return ftu
+}
+
 // SetDatetime sets the datetime field.
 func (ftu *FieldTypeUpdate) SetDatetime(t time.Time) *FieldTypeUpdate {
 	ftu.mutation.SetDatetime(t)
@@ -623,6 +820,14 @@ func (ftu *FieldTypeUpdate) ClearDatetime() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetDatetime removes the changes of the datetime field from the builder (e.g. a previous call
+// to SetDatetime), and therefore, the field is left unchanged in the database. Unlike ClearDatetime,
+// it does not set the field to NULL, and also removes a previous call to ClearDatetime.
+func (ftu *FieldTypeUpdate) UnsetDatetime() *FieldTypeUpdate {
+	ftu.mutation.ResetDatetime()
+	return ftu
+}
+
 // SetDecimal sets the decimal field.
 func (ftu *FieldTypeUpdate) SetDecimal(f float64) *FieldTypeUpdate {
 	ftu.mutation.ResetDecimal()
@@ -650,17 +855,40 @@ func (ftu *FieldTypeUpdate) ClearDecimal() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetDecimal removes the changes of the decimal field from the builder (e.g. a previous call
+// to SetDecimal), and therefore, the field is left unchanged in the database. Unlike ClearDecimal,
+// it does not set the field to NULL, and also removes a previous call to ClearDecimal.
+func (ftu *FieldTypeUpdate) UnsetDecimal() *FieldTypeUpdate {
+	ftu.mutation.ResetDecimal()
+	return ftu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftu *FieldTypeUpdate) Unwrap() *FieldTypeUpdate {
+	tx, ok := ftu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeUpdate is not a transactional builder")
+	}
+	ftu.config.driver = tx.drv
+	ftu.mutation.driver = tx.drv
+	return ftu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftu *FieldTypeUpdate) DebugFields() map[string]Value {
+	return debugFields(ftu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (ftu *FieldTypeUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := ftu.mutation.ValidateOptionalInt32(); ok {
-		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
-		}
+	if err := checkTx(ftu.driver); err != nil {
+		return 0, err
 	}
-	if v, ok := ftu.mutation.State(); ok {
-		if err := fieldtype.StateValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
-		}
+	if err := ftu.check(); err != nil {
+		return 0, err
 	}
 	var (
 		err      error
@@ -710,7 +938,23 @@ func (ftu *FieldTypeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftu *FieldTypeUpdate) check() error {
+	if v, ok := ftu.mutation.ValidateOptionalInt32(); ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
+			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if v, ok := ftu.mutation.State(); ok {
+		if err := fieldtype.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = ftu.withOperation(ctx, "FieldType", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   fieldtype.Table,
@@ -728,6 +972,12 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := ftu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if ftu.ids != nil {
+		_spec.ScanIDs = ftu.ids
+	}
 	if value, ok := ftu.mutation.Int(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -1204,7 +1454,7 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldDecimal,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, ftu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fieldtype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -1215,11 +1465,33 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the FieldType entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (ftu *FieldTypeUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	ftu.ids = &ids
+	defer func() { ftu.ids = nil }()
+	if _, err := ftu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // FieldTypeUpdateOne is the builder for updating a single FieldType entity.
 type FieldTypeUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *FieldTypeMutation
+	hooks      []Hook
+	mutation   *FieldTypeMutation
+	predicates []predicate.FieldType
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (ftuo *FieldTypeUpdateOne) Where(ps ...predicate.FieldType) *FieldTypeUpdateOne {
+	ftuo.predicates = append(ftuo.predicates, ps...)
+	return ftuo
 }
 
 // SetInt sets the int field.
@@ -1235,6 +1507,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt(i int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt removes the changes of the int field from the builder (e.g. a previous call
+// to SetInt), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt()
+	return ftuo
+}
+
 // SetInt8 sets the int8 field.
 func (ftuo *FieldTypeUpdateOne) SetInt8(i int8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt8()
@@ -1248,6 +1527,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt8 removes the changes of the int8 field from the builder (e.g. a previous call
+// to SetInt8), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt8()
+	return ftuo
+}
+
 // SetInt16 sets the int16 field.
 func (ftuo *FieldTypeUpdateOne) SetInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt16()
@@ -1261,6 +1547,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt16(i int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt16 removes the changes of the int16 field from the builder (e.g. a previous call
+// to SetInt16), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt16()
+	return ftuo
+}
+
 // SetInt32 sets the int32 field.
 func (ftuo *FieldTypeUpdateOne) SetInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt32()
@@ -1274,6 +1567,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt32 removes the changes of the int32 field from the builder (e.g. a previous call
+// to SetInt32), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt32()
+	return ftuo
+}
+
 // SetInt64 sets the int64 field.
 func (ftuo *FieldTypeUpdateOne) SetInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt64()
@@ -1287,6 +1587,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt64 removes the changes of the int64 field from the builder (e.g. a previous call
+// to SetInt64), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt64()
+	return ftuo
+}
+
 // SetOptionalInt sets the optional_int field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt(i int) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt()
@@ -1314,6 +1621,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt removes the changes of the optional_int field from the builder (e.g. a previous call
+// to SetOptionalInt), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt()
+	return ftuo
+}
+
 // SetOptionalInt8 sets the optional_int8 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt8(i int8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt8()
@@ -1341,6 +1656,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt8() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt8 removes the changes of the optional_int8 field from the builder (e.g. a previous call
+// to SetOptionalInt8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt8.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt8()
+	return ftuo
+}
+
 // SetOptionalInt16 sets the optional_int16 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt16()
@@ -1368,6 +1691,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt16() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt16 removes the changes of the optional_int16 field from the builder (e.g. a previous call
+// to SetOptionalInt16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt16.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt16()
+	return ftuo
+}
+
 // SetOptionalInt32 sets the optional_int32 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt32()
@@ -1395,6 +1726,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt32 removes the changes of the optional_int32 field from the builder (e.g. a previous call
+// to SetOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt32.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt32()
+	return ftuo
+}
+
 // SetOptionalInt64 sets the optional_int64 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt64()
@@ -1422,6 +1761,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt64() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt64 removes the changes of the optional_int64 field from the builder (e.g. a previous call
+// to SetOptionalInt64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt64.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt64()
+	return ftuo
+}
+
 // SetNillableInt sets the nillable_int field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt(i int) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt()
@@ -1449,6 +1796,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt()
+	return ftuo
+}
+
 // SetNillableInt8 sets the nillable_int8 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt8(i int8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt8()
@@ -1476,6 +1831,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt8() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt8 removes the changes of the nillable_int8 field from the builder (e.g. a previous call
+// to SetNillableInt8), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt8.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt8()
+	return ftuo
+}
+
 // SetNillableInt16 sets the nillable_int16 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt16()
@@ -1503,6 +1866,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt16() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt16 removes the changes of the nillable_int16 field from the builder (e.g. a previous call
+// to SetNillableInt16), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt16.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt16()
+	return ftuo
+}
+
 // SetNillableInt32 sets the nillable_int32 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt32()
@@ -1530,6 +1901,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt32 removes the changes of the nillable_int32 field from the builder (e.g. a previous call
+// to SetNillableInt32), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt32.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt32()
+	return ftuo
+}
+
 // SetNillableInt64 sets the nillable_int64 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt64()
@@ -1557,6 +1936,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt64() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt64 removes the changes of the nillable_int64 field from the builder (e.g. a previous call
+// to SetNillableInt64), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt64.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt64()
+	return ftuo
+}
+
 // SetValidateOptionalInt32 sets the validate_optional_int32 field.
 func (ftuo *FieldTypeUpdateOne) SetValidateOptionalInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetValidateOptionalInt32()
@@ -1584,6 +1971,14 @@ func (ftuo *FieldTypeUpdateOne) ClearValidateOptionalInt32() *FieldTypeUpdateOne
 	return ftuo
 }
 
+// UnsetValidateOptionalInt32 removes the changes of the validate_optional_int32 field from the builder (e.g. a previous call
+// to SetValidateOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearValidateOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearValidateOptionalInt32.
+func (ftuo *FieldTypeUpdateOne) UnsetValidateOptionalInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetValidateOptionalInt32()
+	return ftuo
+}
+
 // SetOptionalUint sets the optional_uint field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint(u uint) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint()
@@ -1611,6 +2006,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint removes the changes of the optional_uint field from the builder (e.g. a previous call
+// to SetOptionalUint), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint()
+	return ftuo
+}
+
 // SetOptionalUint8 sets the optional_uint8 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint8(u uint8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint8()
@@ -1638,6 +2041,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint8() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint8 removes the changes of the optional_uint8 field from the builder (e.g. a previous call
+// to SetOptionalUint8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint8.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint8()
+	return ftuo
+}
+
 // SetOptionalUint16 sets the optional_uint16 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint16(u uint16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint16()
@@ -1665,6 +2076,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint16() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint16 removes the changes of the optional_uint16 field from the builder (e.g. a previous call
+// to SetOptionalUint16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint16.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint16()
+	return ftuo
+}
+
 // SetOptionalUint32 sets the optional_uint32 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint32(u uint32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint32()
@@ -1692,6 +2111,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint32 removes the changes of the optional_uint32 field from the builder (e.g. a previous call
+// to SetOptionalUint32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint32.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint32()
+	return ftuo
+}
+
 // SetOptionalUint64 sets the optional_uint64 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint64(u uint64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint64()
@@ -1719,6 +2146,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint64() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint64 removes the changes of the optional_uint64 field from the builder (e.g. a previous call
+// to SetOptionalUint64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint64.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint64()
+	return ftuo
+}
+
 // SetState sets the state field.
 func (ftuo *FieldTypeUpdateOne) SetState(f fieldtype.State) *FieldTypeUpdateOne {
 	ftuo.mutation.SetState(f)
@@ -1739,6 +2174,14 @@ func (ftuo *FieldTypeUpdateOne) ClearState() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (ftuo *FieldTypeUpdateOne) UnsetState() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetState()
+	return ftuo
+}
+
 // SetOptionalFloat sets the optional_float field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalFloat(f float64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalFloat()
@@ -1766,6 +2209,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalFloat removes the changes of the optional_float field from the builder (e.g. a previous call
+// to SetOptionalFloat), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalFloat() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalFloat()
+	return ftuo
+}
+
 // SetOptionalFloat32 sets the optional_float32 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalFloat32(f float32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalFloat32()
@@ -1793,6 +2244,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalFloat32 removes the changes of the optional_float32 field from the builder (e.g. a previous call
+// to SetOptionalFloat32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat32.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalFloat32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalFloat32()
+	return ftuo
+}
+
 // SetDatetime sets the datetime field.
 func (ftuo *FieldTypeUpdateOne) SetDatetime(t time.Time) *FieldTypeUpdateOne {
 	ftuo.mutation.SetDatetime(t)
@@ -1813,6 +2272,14 @@ func (ftuo *FieldTypeUpdateOne) ClearDatetime() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetDatetime removes the changes of the datetime field from the builder (e.g. a previous call
+// to SetDatetime), and therefore, the field is left unchanged in the database. Unlike ClearDatetime,
+// it does not set the field to NULL, and also removes a previous call to ClearDatetime.
+func (ftuo *FieldTypeUpdateOne) UnsetDatetime() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetDatetime()
+	return ftuo
+}
+
 // SetDecimal sets the decimal field.
 func (ftuo *FieldTypeUpdateOne) SetDecimal(f float64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetDecimal()
@@ -1840,17 +2307,40 @@ func (ftuo *FieldTypeUpdateOne) ClearDecimal() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetDecimal removes the changes of the decimal field from the builder (e.g. a previous call
+// to SetDecimal), and therefore, the field is left unchanged in the database. Unlike ClearDecimal,
+// it does not set the field to NULL, and also removes a previous call to ClearDecimal.
+func (ftuo *FieldTypeUpdateOne) UnsetDecimal() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetDecimal()
+	return ftuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftuo *FieldTypeUpdateOne) Unwrap() *FieldTypeUpdateOne {
+	tx, ok := ftuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeUpdateOne is not a transactional builder")
+	}
+	ftuo.config.driver = tx.drv
+	ftuo.mutation.driver = tx.drv
+	return ftuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftuo *FieldTypeUpdateOne) DebugFields() map[string]Value {
+	return debugFields(ftuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (ftuo *FieldTypeUpdateOne) Save(ctx context.Context) (*FieldType, error) {
-	if v, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
-		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
-		}
+	if err := checkTx(ftuo.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := ftuo.mutation.State(); ok {
-		if err := fieldtype.StateValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
-		}
+	if err := ftuo.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -1900,7 +2390,23 @@ func (ftuo *FieldTypeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftuo *FieldTypeUpdateOne) check() error {
+	if v, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
+			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if v, ok := ftuo.mutation.State(); ok {
+		if err := fieldtype.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err error) {
+	ctx = ftuo.withOperation(ctx, "FieldType", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   fieldtype.Table,
@@ -1916,6 +2422,13 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err
 		return nil, fmt.Errorf("missing FieldType.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := ftuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ftuo.mutation.Int(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -2393,9 +2906,11 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err
 		})
 	}
 	ft = &FieldType{config: ftuo.config}
-	_spec.Assign = ft.assignValues
-	_spec.ScanValues = ft.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, ftuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return ft.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = ft.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, ftuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{fieldtype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -2403,5 +2918,88 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (ft *FieldType, err
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		ft.ID = id
+		if value, ok := ftuo.mutation.Int(); ok {
+			ft.Int = value
+		}
+		if value, ok := ftuo.mutation.Int8(); ok {
+			ft.Int8 = value
+		}
+		if value, ok := ftuo.mutation.Int16(); ok {
+			ft.Int16 = value
+		}
+		if value, ok := ftuo.mutation.Int32(); ok {
+			ft.Int32 = value
+		}
+		if value, ok := ftuo.mutation.Int64(); ok {
+			ft.Int64 = value
+		}
+		if value, ok := ftuo.mutation.OptionalInt(); ok {
+			ft.OptionalInt = value
+		}
+		if value, ok := ftuo.mutation.OptionalInt8(); ok {
+			ft.OptionalInt8 = value
+		}
+		if value, ok := ftuo.mutation.OptionalInt16(); ok {
+			ft.OptionalInt16 = value
+		}
+		if value, ok := ftuo.mutation.OptionalInt32(); ok {
+			ft.OptionalInt32 = value
+		}
+		if value, ok := ftuo.mutation.OptionalInt64(); ok {
+			ft.OptionalInt64 = value
+		}
+		if value, ok := ftuo.mutation.NillableInt(); ok {
+			ft.NillableInt = &value
+		}
+		if value, ok := ftuo.mutation.NillableInt8(); ok {
+			ft.NillableInt8 = &value
+		}
+		if value, ok := ftuo.mutation.NillableInt16(); ok {
+			ft.NillableInt16 = &value
+		}
+		if value, ok := ftuo.mutation.NillableInt32(); ok {
+			ft.NillableInt32 = &value
+		}
+		if value, ok := ftuo.mutation.NillableInt64(); ok {
+			ft.NillableInt64 = &value
+		}
+		if value, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
+			ft.ValidateOptionalInt32 = value
+		}
+		if value, ok := ftuo.mutation.OptionalUint(); ok {
+			ft.OptionalUint = value
+		}
+		if value, ok := ftuo.mutation.OptionalUint8(); ok {
+			ft.OptionalUint8 = value
+		}
+		if value, ok := ftuo.mutation.OptionalUint16(); ok {
+			ft.OptionalUint16 = value
+		}
+		if value, ok := ftuo.mutation.OptionalUint32(); ok {
+			ft.OptionalUint32 = value
+		}
+		if value, ok := ftuo.mutation.OptionalUint64(); ok {
+			ft.OptionalUint64 = value
+		}
+		if value, ok := ftuo.mutation.State(); ok {
+			ft.State = value
+		}
+		if value, ok := ftuo.mutation.OptionalFloat(); ok {
+			ft.OptionalFloat = value
+		}
+		if value, ok := ftuo.mutation.OptionalFloat32(); ok {
+			ft.OptionalFloat32 = value
+		}
+		if value, ok := ftuo.mutation.Datetime(); ok {
+			ft.Datetime = value
+		}
+		if value, ok := ftuo.mutation.Decimal(); ok {
+			ft.Decimal = value
+		}
+	}
 	return ft, nil
 }
@@ -7,7 +7,12 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -35,6 +40,9 @@ type File struct {
 	file_type_files *int
 	group_files     *int
 	user_files      *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // FileEdges holds the relations/edges for other nodes in the graph.
@@ -87,83 +95,111 @@ func (e FileEdges) FieldOrErr() ([]*FieldType, error) {
 	return nil, &NotLoadedError{edge: "field"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*File) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullInt64{},  // size
-		&sql.NullString{}, // name
-		&sql.NullString{}, // user
-		&sql.NullString{}, // group
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*File) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // file_type_files
-		&sql.NullInt64{}, // group_files
-		&sql.NullInt64{}, // user_files
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the File type are scanned as generic values.
+func (*File) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case file.FieldID:
+			values[i] = &sql.NullInt64{}
+		case file.FieldSize:
+			values[i] = &sql.NullInt64{}
+		case file.FieldName:
+			values[i] = &sql.NullString{}
+		case file.FieldUser:
+			values[i] = &sql.NullString{}
+		case file.FieldGroup:
+			values[i] = &sql.NullString{}
+		case file.ForeignKeys[0]: // file_type_files
+			values[i] = &sql.NullInt64{}
+		case file.ForeignKeys[1]: // group_files
+			values[i] = &sql.NullInt64{}
+		case file.ForeignKeys[2]: // user_files
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the File fields.
-func (f *File) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(file.Columns); m < n {
+// to the File fields. Values of columns that were not selected are left untouched.
+func (f *File) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	f.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field size", values[0])
-	} else if value.Valid {
-		f.Size = int(value.Int64)
-	}
-	if value, ok := values[1].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[1])
-	} else if value.Valid {
-		f.Name = value.String
-	}
-	if value, ok := values[2].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field user", values[2])
-	} else if value.Valid {
-		f.User = new(string)
-		*f.User = value.String
-	}
-	if value, ok := values[3].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field group", values[3])
-	} else if value.Valid {
-		f.Group = value.String
-	}
-	values = values[4:]
-	if len(values) == len(file.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field file_type_files", value)
-		} else if value.Valid {
-			f.file_type_files = new(int)
-			*f.file_type_files = int(value.Int64)
-		}
-		if value, ok := values[1].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field group_files", value)
-		} else if value.Valid {
-			f.group_files = new(int)
-			*f.group_files = int(value.Int64)
-		}
-		if value, ok := values[2].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_files", value)
-		} else if value.Valid {
-			f.user_files = new(int)
-			*f.user_files = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case file.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			f.ID = int(value.Int64)
+		case file.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				f.Size = int(value.Int64)
+			}
+		case file.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				f.Name = value.String
+			}
+		case file.FieldUser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user", values[i])
+			} else if value.Valid {
+				f.User = new(string)
+				*f.User = value.String
+			}
+		case file.FieldGroup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group", values[i])
+			} else if value.Valid {
+				f.Group = value.String
+			}
+		case file.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field file_type_files", value)
+			} else if value.Valid {
+				f.file_type_files = new(int)
+				*f.file_type_files = int(value.Int64)
+			}
+		case file.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field group_files", value)
+			} else if value.Valid {
+				f.group_files = new(int)
+				*f.group_files = int(value.Int64)
+			}
+		case file.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_files", value)
+			} else if value.Valid {
+				f.user_files = new(int)
+				*f.user_files = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (f *File) SelectValue(alias string) (Value, error) {
+	v, ok := f.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryOwner queries the owner edge of the File.
 func (f *File) QueryOwner() *UserQuery {
 	return (&FileClient{config: f.config}).QueryOwner(f)
@@ -179,6 +215,118 @@ func (f *File) QueryField() *FieldTypeQuery {
 	return (&FileClient{config: f.config}).QueryField(f)
 }
 
+// LoadEdges loads the given edges of the File using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see file.Edges), and in this case, no edge is loaded.
+//
+//	err := f.LoadEdges(ctx, client, file.EdgeOwner, file.EdgeType)
+//
+func (f *File) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadFileEdges(ctx, client, []*File{f}, edges)
+}
+
+// loadFileEdges loads the given edges of all the given File entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using FileClient.LoadEdgeFor.
+func loadFileEdges(ctx context.Context, client *Client, nodes []*File, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case file.EdgeOwner, file.EdgeType, file.EdgeField:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type File", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.File.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the File and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the File alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The File itself is not modified.
+//
+//	b, err := f.DumpGraph(ctx, client, 2, file.EdgeOwner)
+//
+func (f *File) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for File DumpGraph", depth)
+	}
+	dumped := *f
+	dumped.Edges = FileEdges{}
+	if err := dumpFiles(ctx, client, []*File{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpFiles loads the edges of the given File copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpFiles(ctx context.Context, client *Client, nodes []*File, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*File
+	for _, n := range nodes {
+		if dumper.visit(file.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range file.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadFileEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextOwner []*User
+	for _, n := range visit {
+		if e := n.Edges.Owner; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Owner = &c
+			nextOwner = append(nextOwner, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextOwner, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextType []*FileType
+	for _, n := range visit {
+		if e := n.Edges.Type; e != nil {
+			c := *e
+			c.Edges = FileTypeEdges{}
+			n.Edges.Type = &c
+			nextType = append(nextType, &c)
+		}
+	}
+	if err := dumpFileTypes(ctx, client, nextType, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextField []*FieldType
+	for _, n := range visit {
+		for i, e := range n.Edges.Field {
+			c := *e
+			n.Edges.Field[i] = &c
+			nextField = append(nextField, &c)
+		}
+	}
+	if err := dumpFieldTypes(ctx, client, nextField, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this File.
 // Note that, you need to call File.Unwrap() before calling this method, if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -197,6 +345,38 @@ func (f *File) Unwrap() *File {
 	return f
 }
 
+// Clone returns a deep copy of the File and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (f *File) Clone() *File {
+	return f.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the File and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (f *File) clone(seen map[interface{}]interface{}) *File {
+	if f == nil {
+		return nil
+	}
+	if v, ok := seen[f]; ok {
+		return v.(*File)
+	}
+	_c := *f
+	seen[f] = &_c
+	if v := f.User; v != nil {
+		_v := *v
+		_c.User = &_v
+	}
+	_c.Edges.Owner = f.Edges.Owner.clone(seen)
+	_c.Edges.Type = f.Edges.Type.clone(seen)
+	if f.Edges.Field != nil {
+		_c.Edges.Field = make([]*FieldType, len(f.Edges.Field))
+		for _i := range f.Edges.Field {
+			_c.Edges.Field[_i] = f.Edges.Field[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (f *File) String() string {
 	var builder strings.Builder
@@ -216,6 +396,36 @@ func (f *File) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the File, for change detection and cache keys.
+// It covers the fields that are listed in file.FingerprintFields, and it does not depend on the
+// ID of the File, on its edges, or on the order of the fields in the schema.
+func (f *File) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "group", f.Group)
+	fingerprint(h, "name", f.Name)
+	fingerprint(h, "size", f.Size)
+	fingerprint(h, "user", f.User)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the File for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The File can be decoded using the FromMap method of its client.
+func (f *File) ToMap() map[string]string {
+	m := make(map[string]string, 5)
+	m[file.FieldID] = kvEncode(f.ID)
+	m[file.FieldSize] = kvEncode(f.Size)
+	m[file.FieldName] = kvEncode(f.Name)
+	if v := f.User; v != nil {
+		m[file.FieldUser] = kvEncode(*v)
+	}
+	if v := f.Group; !reflect.ValueOf(v).IsZero() {
+		m[file.FieldGroup] = kvEncode(v)
+	}
+	return m
+}
+
 // Files is a parsable slice of File.
 type Files []*File
 
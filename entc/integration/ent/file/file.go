@@ -48,6 +48,22 @@ const (
 	FieldColumn = "file_field"
 )
 
+// Edges holds all the edge names of the File type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+	EdgeType,
+	EdgeField,
+}
+
+// FingerprintFields holds the fields of the File type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldGroup,
+	FieldName,
+	FieldSize,
+	FieldUser,
+}
+
 // Columns holds all SQL columns for file fields.
 var Columns = []string{
 	FieldID,
@@ -64,6 +80,16 @@ var ForeignKeys = []string{
 	"user_files",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// DefaultSize holds the default value on creation for the size field.
 	DefaultSize int
@@ -7,6 +7,8 @@
 package file
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
@@ -282,6 +284,13 @@ func NameContains(v string) predicate.File {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -289,6 +298,13 @@ func NameHasPrefix(v string) predicate.File {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -296,6 +312,13 @@ func NameHasSuffix(v string) predicate.File {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -310,6 +333,20 @@ func NameContainsFold(v string) predicate.File {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
 // UserEQ applies the EQ predicate on the "user" field.
 func UserEQ(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -393,6 +430,13 @@ func UserContains(v string) predicate.File {
 	})
 }
 
+// UserNotContains applies the NotContains predicate on the "user" field.
+func UserNotContains(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldUser), v))
+	})
+}
+
 // UserHasPrefix applies the HasPrefix predicate on the "user" field.
 func UserHasPrefix(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -400,6 +444,13 @@ func UserHasPrefix(v string) predicate.File {
 	})
 }
 
+// UserNotHasPrefix applies the NotHasPrefix predicate on the "user" field.
+func UserNotHasPrefix(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldUser), v))
+	})
+}
+
 // UserHasSuffix applies the HasSuffix predicate on the "user" field.
 func UserHasSuffix(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -407,6 +458,13 @@ func UserHasSuffix(v string) predicate.File {
 	})
 }
 
+// UserNotHasSuffix applies the NotHasSuffix predicate on the "user" field.
+func UserNotHasSuffix(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldUser), v))
+	})
+}
+
 // UserIsNil applies the IsNil predicate on the "user" field.
 func UserIsNil() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -435,6 +493,20 @@ func UserContainsFold(v string) predicate.File {
 	})
 }
 
+// UserHasPrefixFold applies the HasPrefixFold predicate on the "user" field.
+func UserHasPrefixFold(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldUser), v))
+	})
+}
+
+// UserHasSuffixFold applies the HasSuffixFold predicate on the "user" field.
+func UserHasSuffixFold(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldUser), v))
+	})
+}
+
 // GroupEQ applies the EQ predicate on the "group" field.
 func GroupEQ(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -518,6 +590,13 @@ func GroupContains(v string) predicate.File {
 	})
 }
 
+// GroupNotContains applies the NotContains predicate on the "group" field.
+func GroupNotContains(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldGroup), v))
+	})
+}
+
 // GroupHasPrefix applies the HasPrefix predicate on the "group" field.
 func GroupHasPrefix(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -525,6 +604,13 @@ func GroupHasPrefix(v string) predicate.File {
 	})
 }
 
+// GroupNotHasPrefix applies the NotHasPrefix predicate on the "group" field.
+func GroupNotHasPrefix(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldGroup), v))
+	})
+}
+
 // GroupHasSuffix applies the HasSuffix predicate on the "group" field.
 func GroupHasSuffix(v string) predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -532,6 +618,13 @@ func GroupHasSuffix(v string) predicate.File {
 	})
 }
 
+// GroupNotHasSuffix applies the NotHasSuffix predicate on the "group" field.
+func GroupNotHasSuffix(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldGroup), v))
+	})
+}
+
 // GroupIsNil applies the IsNil predicate on the "group" field.
 func GroupIsNil() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -560,6 +653,107 @@ func GroupContainsFold(v string) predicate.File {
 	})
 }
 
+// GroupHasPrefixFold applies the HasPrefixFold predicate on the "group" field.
+func GroupHasPrefixFold(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldGroup), v))
+	})
+}
+
+// GroupHasSuffixFold applies the HasSuffixFold predicate on the "group" field.
+func GroupHasSuffixFold(v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldGroup), v))
+	})
+}
+
+// UserEQNullSafe applies a NULL-safe equality predicate on the "user" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func UserEQNullSafe(v *string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldUser), arg))
+	})
+}
+
+// GroupEQNullSafe applies a NULL-safe equality predicate on the "group" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func GroupEQNullSafe(v *string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldGroup), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldGroup: "string",
+	FieldID:    "numeric",
+	FieldName:  "string",
+	FieldSize:  "numeric",
+	FieldUser:  "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the File.
+func FieldsEQ(f1, f2 string) predicate.File {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the File.
+func FieldsNEQ(f1, f2 string) predicate.File {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the File.
+//
+//	file.FieldsLT(file.FieldName, file.FieldUser)
+//
+func FieldsLT(f1, f2 string) predicate.File {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the File.
+func FieldsLTE(f1, f2 string) predicate.File {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the File.
+func FieldsGT(f1, f2 string) predicate.File {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the File.
+func FieldsGTE(f1, f2 string) predicate.File {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the File using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of File", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of File", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of File are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -588,6 +782,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.File {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	file.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_files IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_files)
+//
+func OwnerMissing() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasType applies the HasEdge predicate on the "type" edge.
 func HasType() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -616,6 +849,45 @@ func HasTypeWith(preds ...predicate.FileType) predicate.File {
 	})
 }
 
+// TypeIDIn applies a predicate on the "type" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	file.TypeIDIn(client.FileType.Query().Where(...))
+//
+func TypeIDIn(q sqlgraph.IDsQuerier) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(TypeColumn), sub))
+	})
+}
+
+// TypeMissing applies a predicate on the "type" edge, that checks if its foreign-key is set, but
+// there is no FileType with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE file_type_files IS NOT NULL AND NOT EXISTS (SELECT id FROM file_types WHERE id = file_type_files)
+//
+func TypeMissing() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(TypeInverseTable).As("type")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(TypeColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(TypeColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasField applies the HasEdge predicate on the "field" edge.
 func HasField() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -651,6 +923,9 @@ func And(predicates ...predicate.File) predicate.File {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -665,6 +940,9 @@ func Or(predicates ...predicate.File) predicate.File {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -675,3 +953,65 @@ func Not(p predicate.File) predicate.File {
 		p(s.Not())
 	})
 }
+
+// FileFilter holds optional filters on the File fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type FileFilter struct {
+	IDIn      []int
+	Size      *int
+	Name      *string
+	User      *string
+	Group     *string
+	OwnerIDIn []int
+	TypeIDIn  []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.File.Query().
+//		Where(file.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *FileFilter) []predicate.File {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.File
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Size != nil {
+		ps = append(ps, SizeEQ(*f.Size))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.User != nil {
+		ps = append(ps, UserEQ(*f.User))
+	}
+	if f.Group != nil {
+		ps = append(ps, GroupEQ(*f.Group))
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.File(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	if ids := f.TypeIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.File(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(TypeColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -10,7 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/fieldtype"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
@@ -129,17 +132,12 @@ func (fc *FileCreate) AddField(f ...*FieldType) *FileCreate {
 
 // Save creates the File in the database.
 func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
-	if _, ok := fc.mutation.Size(); !ok {
-		v := file.DefaultSize
-		fc.mutation.SetSize(v)
-	}
-	if v, ok := fc.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
-		}
+	if err := checkTx(fc.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := fc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	fc.defaults()
+	if err := fc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -176,17 +174,124 @@ func (fc *FileCreate) SaveX(ctx context.Context) *File {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fc *FileCreate) Unwrap() *FileCreate {
+	tx, ok := fc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileCreate is not a transactional builder")
+	}
+	fc.config.driver = tx.drv
+	fc.mutation.driver = tx.drv
+	return fc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (fc *FileCreate) DebugFields() map[string]Value {
+	return debugFields(fc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FileCreate) defaults() {
+	if _, ok := fc.mutation.Size(); !ok {
+		v := file.DefaultSize
+		fc.mutation.SetSize(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FileCreate) check() error {
+	if v, ok := fc.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if _, ok := fc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
 func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
-	var (
-		f     = &File{config: fc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: file.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: file.FieldID,
-			},
+	ctx = fc.withOperation(ctx, "File", "Create")
+	f, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	f.ID = int(id)
+	return f, nil
+}
+
+// SaveID creates the File in the database and returns only its id. Unlike Save,
+// the File entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// File that holds only its id as the value that is returned by the next mutator.
+func (fc *FileCreate) SaveID(ctx context.Context) (id int, err error) {
+	fc.defaults()
+	if err = fc.check(); err != nil {
+		return id, err
+	}
+	if len(fc.hooks) == 0 {
+		return fc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*FileMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		fc.mutation = mutation
+		if id, err = fc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &File{config: fc.config, ID: id}, nil
+	})
+	for i := len(fc.hooks) - 1; i >= 0; i-- {
+		mut = fc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, fc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (fc *FileCreate) SaveIDX(ctx context.Context) int {
+	id, err := fc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (fc *FileCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = fc.withOperation(ctx, "File", "Create")
+	_spec := fc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, fc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (fc *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
+	f := &File{config: fc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: file.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: file.FieldID,
+		},
+	}
 	if value, ok := fc.mutation.Size(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -276,13 +381,736 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return f, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (fc *FileCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: file.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: file.FieldID,
+		},
+	}
+	if value, ok := fc.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: file.FieldSize,
+		})
+	}
+	if value, ok := fc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldName,
+		})
+	}
+	if value, ok := fc.mutation.User(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldUser,
+		})
+	}
+	if value, ok := fc.mutation.Group(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldGroup,
+		})
+	}
+	if nodes := fc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.OwnerTable,
+			Columns: []string{file.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.TypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.TypeTable,
+			Columns: []string{file.TypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filetype.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.FieldIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.FieldTable,
+			Columns: []string{file.FieldColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: fieldtype.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// FileCreateBulk is the builder for creating a bulk of File entities.
+type FileCreateBulk struct {
+	config
+	builders []*FileCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the File entities in the database.
+func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
+	nodes, err := fcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && fcb.retries > 0 && isSQLDeadlockError(err) {
+		return fcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FileCreateBulk) SaveX(ctx context.Context) []*File {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (fcb *FileCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range fcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (fcb *FileCreateBulk) CheckRefs() *FileCreateBulk {
+	fcb.refs = true
+	return fcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (fcb *FileCreateBulk) RetryOnDeadlock(max int) *FileCreateBulk {
+	fcb.retries = max
+	return fcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.File.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.File.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (fcb *FileCreateBulk) OnConflict(columns ...string) *FileUpsertBulk {
+	return &FileUpsertBulk{create: fcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.File.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (fc *FileCreate) OnConflict(target ...sql.ConflictOption) *FileUpsertBulk {
+	bulk := &FileCreateBulk{config: fc.config, builders: []*FileCreate{fc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (fcb *FileCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*File, error) {
+	ctx = fcb.withOperation(ctx, "File", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(fcb.builders))
+		nodes    = make([]*File, len(fcb.builders))
+		mutators = make([]Mutator, len(fcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if fcb.refs {
+						if err := fcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, fcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (fcb *FileCreateBulk) retry(ctx context.Context, err error) ([]*File, error) {
+	if _, ok := fcb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	f.ID = int(id)
-	return f, nil
+	builders := fcb.builders
+	defer func() { fcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return fcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	fcb.builders = make([]*FileCreate, len(idx))
+	for i, j := range idx {
+		fcb.builders[i] = builders[j]
+	}
+	for n := 0; n < fcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*File
+		if sorted, err = fcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*File, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (fcb *FileCreateBulk) less(a, b *FileCreate) bool {
+	{
+		x, _ := a.mutation.Name()
+		y, _ := b.mutation.Name()
+		if x != y {
+			return x < y
+		}
+	}
+	{
+		x, _ := a.mutation.User()
+		y, _ := b.mutation.User()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (fcb *FileCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range fcb.builders {
+			for _, id := range builder.mutation.OwnerIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, fcb.driver, user.Table, user.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[file.EdgeOwner] = append(missing[file.EdgeOwner], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range fcb.builders {
+			for _, id := range builder.mutation.TypeIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, fcb.driver, filetype.Table, filetype.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[file.EdgeType] = append(missing[file.EdgeType], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range fcb.builders {
+			for _, id := range builder.mutation.FieldIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, fcb.driver, fieldtype.Table, fieldtype.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[file.EdgeField] = append(missing[file.EdgeField], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "File", Missing: missing}
+	}
+	return nil
+}
+
+// FileUpsertBulk is the builder for the conflict handling of a bulk of File entities.
+type FileUpsertBulk struct {
+	create  *FileCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (fub *FileUpsertBulk) Target(target ...sql.ConflictOption) *FileUpsertBulk {
+	fub.target = append(fub.target, target...)
+	return fub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (fub *FileUpsertBulk) DoNothing() *FileUpsertBulk {
+	fub.nothing = true
+	return fub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (fub *FileUpsertBulk) UpdateNewValues() *FileUpsertBulk {
+	fub.update = true
+	return fub
+}
+
+// Save creates the File entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (fub *FileUpsertBulk) Save(ctx context.Context) ([]*File, int, error) {
+	switch {
+	case !fub.nothing && !fub.update:
+		return nil, 0, errors.New("ent: missing conflict action for File bulk insert")
+	case fub.nothing && fub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for File bulk insert")
+	case fub.update:
+		if len(fub.columns) == 0 && len(fub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for File bulk upsert")
+		}
+		nodes, err := fub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(fub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(file.FieldID),
+			),
+			Columns:         file.Columns,
+			ConflictColumns: fub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(fub.conflictTarget(), sql.DoNothing())}
+	nodes, err := fub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*File, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (fub *FileUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(fub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(fub.columns...))
+	}
+	return append(opts, fub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fub *FileUpsertBulk) SaveX(ctx context.Context) ([]*File, int) {
+	nodes, skipped, err := fub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (fub *FileUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := fub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fub *FileUpsertBulk) ExecX(ctx context.Context) {
+	if err := fub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored File entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.File.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *File) *File {
+//			// ...
+//			return existing
+//		}, file.FieldName).
+//		Save(ctx)
+//
+func (fcb *FileCreateBulk) OnConflictMerge(merge func(existing, incoming *File) *File, keyFields ...string) *FileMergeBulk {
+	return &FileMergeBulk{create: fcb, merge: merge, keyFields: keyFields}
+}
+
+// FileMergeBulk is the builder for merging a bulk of File entities with the stored entities in Go.
+type FileMergeBulk struct {
+	create    *FileCreateBulk
+	merge     func(existing, incoming *File) *File
+	keyFields []string
+}
+
+// Save creates or merges the File entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (fmb *FileMergeBulk) Save(ctx context.Context) ([]*File, error) {
+	if fmb.merge == nil {
+		return nil, errors.New("ent: missing merge function for File bulk merge")
+	}
+	if len(fmb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for File bulk merge")
+	}
+	for _, f := range fmb.keyFields {
+		switch f {
+		case file.FieldName, file.FieldUser:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for File bulk merge", f)
+		}
+	}
+	drv := fmb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return fmb.save(ctx, fmb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
+		return nil, err
+	}
+	cfg := fmb.create.config
+	cfg.driver = tx
+	nodes, err := fmb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (fmb *FileMergeBulk) save(ctx context.Context, cfg config) ([]*File, error) {
+	var (
+		client   = &FileClient{config: cfg}
+		builders = fmb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *FileCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(fmb.keyFields))
+		for j, f := range fmb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in File builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: File builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*File)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, fmb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(fmb.keyFields))
+			for j, f := range fmb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*File, len(builders))
+		inserts []*FileCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := fmb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fmb *FileMergeBulk) SaveX(ctx context.Context) []*File {
+	nodes, err := fmb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (fmb *FileMergeBulk) Exec(ctx context.Context) error {
+	_, err := fmb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmb *FileMergeBulk) ExecX(ctx context.Context) {
+	if err := fmb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
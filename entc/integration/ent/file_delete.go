@@ -23,6 +23,7 @@ type FileDelete struct {
 	hooks      []Hook
 	mutation   *FileMutation
 	predicates []predicate.File
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (fd *FileDelete) Where(ps ...predicate.File) *FileDelete {
 	return fd
 }
 
+// Limit limits the number of files that are deleted by the builder. For example,
+// for deleting large amounts of files in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (fd *FileDelete) Limit(limit int) *FileDelete {
+	fd.limit = &limit
+	return fd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fd *FileDelete) Unwrap() *FileDelete {
+	tx, ok := fd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileDelete is not a transactional builder")
+	}
+	fd.config.driver = tx.drv
+	fd.mutation.driver = tx.drv
+	return fd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (fd *FileDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(fd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (fd *FileDelete) ExecX(ctx context.Context) int {
 }
 
 func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = fd.withOperation(ctx, "File", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: file.Table,
@@ -85,7 +111,10 @@ func (fd *FileDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, fd.driver, _spec)
+	if limit := fd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, fd.mutationDriver(ctx), _spec)
 }
 
 // FileDeleteOne is the builder for deleting a single File entity.
@@ -99,7 +128,7 @@ func (fdo *FileDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{file.Label}
 	default:
 		return nil
@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -36,6 +39,18 @@ type FileQuery struct {
 	withType  *FileTypeQuery
 	withField *FieldTypeQuery
 	withFKs   bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see FileSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -47,6 +62,19 @@ func (fq *FileQuery) Where(ps ...predicate.File) *FileQuery {
 	return fq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (fq *FileQuery) Predicates() []predicate.File {
+	return append([]predicate.File{}, fq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (fq *FileQuery) SetPredicates(ps ...predicate.File) *FileQuery {
+	fq.predicates = append([]predicate.File{}, ps...)
+	return fq
+}
+
 // Limit adds a limit step to the query.
 func (fq *FileQuery) Limit(limit int) *FileQuery {
 	fq.limit = &limit
@@ -373,11 +401,15 @@ func (fq *FileQuery) GroupBy(field string, fields ...string) *FileGroupBy {
 func (fq *FileQuery) Select(field string, fields ...string) *FileSelect {
 	selector := &FileSelect{config: fq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = fq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := fq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return fq.sqlQuery(), nil
+		if err := fq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return fq.sqlQuery().AppendSelectExpr(fq.exprs...), nil
 	}
 	return selector
 }
@@ -394,6 +426,15 @@ func (fq *FileQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
+	ctx = fq.withOperation(ctx, "File", "Query")
+	if err := fq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range fq.fields {
+		if !file.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*File{}
 		withFKs     = fq.withFKs
@@ -410,12 +451,14 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, file.ForeignKeys...)
 	}
+	_spec.Exprs = fq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &File{config: fq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
-		if withFKs {
-			values = append(values, node.fkValues()...)
+		values := node.scanValues(columns)
+		for range fq.exprs {
+			values = append(values, new(interface{}))
 		}
 		return values
 	}
@@ -424,8 +467,16 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(fq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range fq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, fq.driver, _spec); err != nil {
 		return nil, err
@@ -435,56 +486,91 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 	}
 
 	if query := fq.withOwner; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*File)
 		for i := range nodes {
 			if fk := nodes[i].user_files; fk != nil {
-				ids = append(ids, *fk)
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
 				nodeids[*fk] = append(nodeids[*fk], nodes[i])
 			}
 		}
-		query.Where(user.IDIn(ids...))
-		neighbors, err := query.All(ctx)
-		if err != nil {
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && fq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`ent: limit or offset of eager-loaded edge "owner" cannot be applied on %d neighbors with batch size %d`, len(ids), fq.eagerLoadSize())
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := nodeids[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "user_files" returned %v`, n.ID)
+		err := fq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Owner = n
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_files" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Owner = n
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if query := fq.withType; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*File)
 		for i := range nodes {
 			if fk := nodes[i].file_type_files; fk != nil {
-				ids = append(ids, *fk)
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
 				nodeids[*fk] = append(nodeids[*fk], nodes[i])
 			}
 		}
-		query.Where(filetype.IDIn(ids...))
-		neighbors, err := query.All(ctx)
-		if err != nil {
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && fq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`ent: limit or offset of eager-loaded edge "type" cannot be applied on %d neighbors with batch size %d`, len(ids), fq.eagerLoadSize())
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := nodeids[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "file_type_files" returned %v`, n.ID)
+		err := fq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], filetype.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Type = n
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "file_type_files" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Type = n
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if query := fq.withField; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*File)
 		for i := range nodes {
@@ -492,30 +578,76 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 			nodeids[nodes[i].ID] = nodes[i]
 		}
 		query.withFKs = true
-		query.Where(predicate.FieldType(func(s *sql.Selector) {
-			s.Where(sql.InValues(file.FieldColumn, fks...))
-		}))
-		neighbors, err := query.All(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range neighbors {
-			fk := n.file_field
-			if fk == nil {
-				return nil, fmt.Errorf(`foreign-key "file_field" is nil for node %v`, n.ID)
+		// The limit and the offset of the query are applied per node.
+		query.partition = file.FieldColumn
+		err := fq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.FieldType(func(s *sql.Selector) {
+				s.Where(sql.InValues(file.FieldColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			node, ok := nodeids[*fk]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "file_field" returned %v for node %v`, *fk, n.ID)
+			for _, n := range neighbors {
+				fk := n.file_field
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "file_field" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "file_field" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Field = append(node.Edges.Field, n)
 			}
-			node.Edges.Field = append(node.Edges.Field, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the File entities (see File.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.File.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the File table.
+func (fq *FileQuery) SelectExpr(exprs ...*sql.SelectExpr) *FileQuery {
+	fq.exprs = append(fq.exprs, exprs...)
+	return fq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (fq *FileQuery) checkExprs() error {
+	if len(fq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range file.Columns {
+		columns[c] = true
+	}
+	for _, c := range file.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range fq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of File", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (fq *FileQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = fq.withOperation(ctx, "File", "Count")
 	_spec := fq.querySpec()
 	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
 }
@@ -541,6 +673,17 @@ func (fq *FileQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   fq.sql,
 		Unique: true,
 	}
+	if fields := fq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, file.FieldID)
+		for i := range fields {
+			if fields[i] != file.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = fq.forUpdate
+	_spec.PartitionBy = fq.partition
 	if ps := fq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -589,11 +732,419 @@ func (fq *FileQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the File entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (fq *FileQuery) IDsQuery() *sql.Selector {
+	selector := fq.sqlQuery()
+	if fq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(file.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the File entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.File.Query().
+//		Where(...).
+//		CountDistinct(ctx, file.FieldSize)
+//
+func (fq *FileQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = fq.withOperation(ctx, "File", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, fq.driver, fq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (fq *FileQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := fq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched File rows as generic maps that are keyed by
+// column name (see file.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (fq *FileQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = fq.withOperation(ctx, "File", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = fq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&File{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, fq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (fq *FileQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := fq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of File entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (fq *FileQuery) Prefetch(n int) *FileQuery {
+	fq.prefetch = n
+	return fq
+}
+
+// ForUpdate locks the selected File rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (fq *FileQuery) ForUpdate() *FileQuery {
+	fq.forUpdate = true
+	return fq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (fq *FileQuery) Primary() *FileQuery {
+	return fq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (fq *FileQuery) ReadConsistency(c sql.ReadConsistency) *FileQuery {
+	fq.consistency = c
+	return fq
+}
+
+// Stream executes the query in batches, and sends the matched File entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.File.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (fq *FileQuery) Stream(ctx context.Context) (<-chan *File, <-chan error) {
+	size := fq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *File, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := fq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the File entities in batches of the given
+// size, and sends them to the given channel.
+func (fq *FileQuery) stream(ctx context.Context, size int, nodes chan<- *File) error {
+	if fq.offset != nil || len(fq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if fq.limit != nil {
+		left = *fq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *fq
+		query.predicates = fq.predicates[:len(fq.predicates):len(fq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, file.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(file.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// FileEdge is the edge representation of File in a connection.
+type FileEdge struct {
+	Node   *File  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// FileConnection is the connection of File entities that is returned by Paginate.
+type FileConnection struct {
+	Edges      []FileEdge `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.File.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: file.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (fq *FileQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*FileConnection, error) {
+	if fq.limit != nil || fq.offset != nil || len(fq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case file.FieldSize, file.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for File", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := fq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: file.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *fq
+	query.predicates = fq.predicates[:len(fq.predicates):len(fq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := fq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &FileConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]FileEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = FileEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (fq *FileQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.File, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := fq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.File(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*FileQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case file.FieldSize:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field size: %v", err)
+		}
+		return v, nil
+	case file.FieldName:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field name: %v", err)
+		}
+		return v, nil
+	case file.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for File", field)
+}
+
+// pageCursor returns the cursor of the File node for the given order fields.
+func (f *File) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(f.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case file.FieldSize:
+			v = f.Size
+		case file.FieldName:
+			v = f.Name
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for File", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
 // FileGroupBy is the builder for group-by File entities.
 type FileGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -706,9 +1257,28 @@ func (fgb *FileGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.File.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (fgb *FileGroupBy) Having(ps ...*sql.Predicate) *FileGroupBy {
+	fgb.havings = append(fgb.havings, ps...)
+	return fgb
+}
+
 func (fgb *FileGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = fgb.withOperation(ctx, "File", "GroupBy")
+	selector := fgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := fgb.sqlQuery().Query()
 	if err := fgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -721,15 +1291,30 @@ func (fgb *FileGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(fgb.fields)+len(fgb.fns))
 	columns = append(columns, fgb.fields...)
 	for _, fn := range fgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(fgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(fgb.fields...)
+	switch len(fgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(fgb.havings[0])
+	default:
+		selector.Having(sql.And(fgb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(fgb.fields...)
+	return selector
 }
 
 // FileSelect is the builder for select fields of File entities.
 type FileSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *FileQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -836,7 +1421,52 @@ func (fs *FileSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (fs *FileSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(fs.fields) > 1 {
+		return nil, errors.New("ent: FileSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := fs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (fs *FileSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := fs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the File entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.File.Query().
+//		Select(file.FieldSize).
+//		All(ctx)
+//
+func (fs *FileSelect) All(ctx context.Context) ([]*File, error) {
+	query := *fs.query
+	query.fields = fs.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fs *FileSelect) AllX(ctx context.Context) []*File {
+	nodes, err := fs.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (fs *FileSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = fs.withOperation(ctx, "File", "Select")
 	rows := &sql.Rows{}
 	query, args := fs.sqlQuery().Query()
 	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
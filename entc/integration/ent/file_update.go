@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -26,6 +27,9 @@ type FileUpdate struct {
 	hooks      []Hook
 	mutation   *FileMutation
 	predicates []predicate.File
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -34,6 +38,13 @@ func (fu *FileUpdate) Where(ps ...predicate.File) *FileUpdate {
 	return fu
 }
 
+// Limit limits the number of files that are updated by the builder. For example,
+// for updating large amounts of files in small batches without locking the whole table.
+func (fu *FileUpdate) Limit(limit int) *FileUpdate {
+	fu.limit = &limit
+	return fu
+}
+
 // SetSize sets the size field.
 func (fu *FileUpdate) SetSize(i int) *FileUpdate {
 	fu.mutation.ResetSize()
@@ -55,12 +66,26 @@ func (fu *FileUpdate) AddSize(i int) *FileUpdate {
 	return fu
 }
 
+// UnsetSize removes the changes of the size field from the builder (e.g. a previous call
+// to SetSize), and therefore, the field is left unchanged in the database.
+func (fu *FileUpdate) UnsetSize() *FileUpdate {
+	fu.mutation.ResetSize()
+	return fu
+}
+
 // SetName sets the name field.
 func (fu *FileUpdate) SetName(s string) *FileUpdate {
 	fu.mutation.SetName(s)
 	return fu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (fu *FileUpdate) UnsetName() *FileUpdate {
+	fu.mutation.ResetName()
+	return fu
+}
+
 // SetUser sets the user field.
 func (fu *FileUpdate) SetUser(s string) *FileUpdate {
 	fu.mutation.SetUser(s)
@@ -81,6 +106,14 @@ func (fu *FileUpdate) ClearUser() *FileUpdate {
 	return fu
 }
 
+// UnsetUser removes the changes of the user field from the builder (e.g. a previous call
+// to SetUser), and therefore, the field is left unchanged in the database. Unlike ClearUser,
+// it does not set the field to NULL, and also removes a previous call to ClearUser.
+func (fu *FileUpdate) UnsetUser() *FileUpdate {
+	fu.mutation.ResetUser()
+	return fu
+}
+
 // SetGroup sets the group field.
 func (fu *FileUpdate) SetGroup(s string) *FileUpdate {
 	fu.mutation.SetGroup(s)
@@ -101,6 +134,14 @@ func (fu *FileUpdate) ClearGroup() *FileUpdate {
 	return fu
 }
 
+// UnsetGroup removes the changes of the group field from the builder (e.g. a previous call
+// to SetGroup), and therefore, the field is left unchanged in the database. Unlike ClearGroup,
+// it does not set the field to NULL, and also removes a previous call to ClearGroup.
+func (fu *FileUpdate) UnsetGroup() *FileUpdate {
+	fu.mutation.ResetGroup()
+	return fu
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (fu *FileUpdate) SetOwnerID(id int) *FileUpdate {
 	fu.mutation.SetOwnerID(id)
@@ -181,14 +222,33 @@ func (fu *FileUpdate) RemoveField(f ...*FieldType) *FileUpdate {
 	return fu.RemoveFieldIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fu *FileUpdate) Unwrap() *FileUpdate {
+	tx, ok := fu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileUpdate is not a transactional builder")
+	}
+	fu.config.driver = tx.drv
+	fu.mutation.driver = tx.drv
+	return fu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (fu *FileUpdate) DebugFields() map[string]Value {
+	return debugFields(fu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := fu.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
-		}
+	if err := checkTx(fu.driver); err != nil {
+		return 0, err
+	}
+	if err := fu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -237,7 +297,24 @@ func (fu *FileUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fu *FileUpdate) check() error {
+	if v, ok := fu.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if fu.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	if fu.mutation.conflict_type {
+		return &ValidationError{Name: "type", err: errors.New("ent: setting and clearing the unique edge \"type\" in the same mutation")}
+	}
+	return nil
+}
+
 func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = fu.withOperation(ctx, "File", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   file.Table,
@@ -255,6 +332,12 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := fu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if fu.ids != nil {
+		_spec.ScanIDs = fu.ids
+	}
 	if value, ok := fu.mutation.Size(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -410,7 +493,7 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -421,11 +504,33 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the File entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (fu *FileUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	fu.ids = &ids
+	defer func() { fu.ids = nil }()
+	if _, err := fu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // FileUpdateOne is the builder for updating a single File entity.
 type FileUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *FileMutation
+	hooks      []Hook
+	mutation   *FileMutation
+	predicates []predicate.File
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
+	fuo.predicates = append(fuo.predicates, ps...)
+	return fuo
 }
 
 // SetSize sets the size field.
@@ -449,12 +554,26 @@ func (fuo *FileUpdateOne) AddSize(i int) *FileUpdateOne {
 	return fuo
 }
 
+// UnsetSize removes the changes of the size field from the builder (e.g. a previous call
+// to SetSize), and therefore, the field is left unchanged in the database.
+func (fuo *FileUpdateOne) UnsetSize() *FileUpdateOne {
+	fuo.mutation.ResetSize()
+	return fuo
+}
+
 // SetName sets the name field.
 func (fuo *FileUpdateOne) SetName(s string) *FileUpdateOne {
 	fuo.mutation.SetName(s)
 	return fuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (fuo *FileUpdateOne) UnsetName() *FileUpdateOne {
+	fuo.mutation.ResetName()
+	return fuo
+}
+
 // SetUser sets the user field.
 func (fuo *FileUpdateOne) SetUser(s string) *FileUpdateOne {
 	fuo.mutation.SetUser(s)
@@ -475,6 +594,14 @@ func (fuo *FileUpdateOne) ClearUser() *FileUpdateOne {
 	return fuo
 }
 
+// UnsetUser removes the changes of the user field from the builder (e.g. a previous call
+// to SetUser), and therefore, the field is left unchanged in the database. Unlike ClearUser,
+// it does not set the field to NULL, and also removes a previous call to ClearUser.
+func (fuo *FileUpdateOne) UnsetUser() *FileUpdateOne {
+	fuo.mutation.ResetUser()
+	return fuo
+}
+
 // SetGroup sets the group field.
 func (fuo *FileUpdateOne) SetGroup(s string) *FileUpdateOne {
 	fuo.mutation.SetGroup(s)
@@ -495,6 +622,14 @@ func (fuo *FileUpdateOne) ClearGroup() *FileUpdateOne {
 	return fuo
 }
 
+// UnsetGroup removes the changes of the group field from the builder (e.g. a previous call
+// to SetGroup), and therefore, the field is left unchanged in the database. Unlike ClearGroup,
+// it does not set the field to NULL, and also removes a previous call to ClearGroup.
+func (fuo *FileUpdateOne) UnsetGroup() *FileUpdateOne {
+	fuo.mutation.ResetGroup()
+	return fuo
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (fuo *FileUpdateOne) SetOwnerID(id int) *FileUpdateOne {
 	fuo.mutation.SetOwnerID(id)
@@ -575,14 +710,33 @@ func (fuo *FileUpdateOne) RemoveField(f ...*FieldType) *FileUpdateOne {
 	return fuo.RemoveFieldIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fuo *FileUpdateOne) Unwrap() *FileUpdateOne {
+	tx, ok := fuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileUpdateOne is not a transactional builder")
+	}
+	fuo.config.driver = tx.drv
+	fuo.mutation.driver = tx.drv
+	return fuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (fuo *FileUpdateOne) DebugFields() map[string]Value {
+	return debugFields(fuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
-	if v, ok := fuo.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
-		}
+	if err := checkTx(fuo.driver); err != nil {
+		return nil, err
+	}
+	if err := fuo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *File
@@ -631,7 +785,24 @@ func (fuo *FileUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FileUpdateOne) check() error {
+	if v, ok := fuo.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if fuo.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	if fuo.mutation.conflict_type {
+		return &ValidationError{Name: "type", err: errors.New("ent: setting and clearing the unique edge \"type\" in the same mutation")}
+	}
+	return nil
+}
+
 func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
+	ctx = fuo.withOperation(ctx, "File", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   file.Table,
@@ -647,6 +818,13 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 		return nil, fmt.Errorf("missing File.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := fuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := fuo.mutation.Size(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -803,9 +981,11 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	f = &File{config: fuo.config}
-	_spec.Assign = f.assignValues
-	_spec.ScanValues = f.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return f.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = f.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, fuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{file.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -813,5 +993,22 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		f.ID = id
+		if value, ok := fuo.mutation.Size(); ok {
+			f.Size = value
+		}
+		if value, ok := fuo.mutation.Name(); ok {
+			f.Name = value
+		}
+		if value, ok := fuo.mutation.User(); ok {
+			f.User = &value
+		}
+		if value, ok := fuo.mutation.Group(); ok {
+			f.Group = value
+		}
+	}
 	return f, nil
 }
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -24,6 +28,9 @@ type FileType struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileTypeQuery when eager-loading is set.
 	Edges FileTypeEdges `json:"edges"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // FileTypeEdges holds the relations/edges for other nodes in the graph.
@@ -44,39 +51,153 @@ func (e FileTypeEdges) FilesOrErr() ([]*File, error) {
 	return nil, &NotLoadedError{edge: "files"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*FileType) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // name
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the FileType type are scanned as generic values.
+func (*FileType) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case filetype.FieldID:
+			values[i] = &sql.NullInt64{}
+		case filetype.FieldName:
+			values[i] = &sql.NullString{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the FileType fields.
-func (ft *FileType) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(filetype.Columns); m < n {
+// to the FileType fields. Values of columns that were not selected are left untouched.
+func (ft *FileType) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	ft.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[0])
-	} else if value.Valid {
-		ft.Name = value.String
+	for i := range columns {
+		switch columns[i] {
+		case filetype.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ft.ID = int(value.Int64)
+		case filetype.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ft.Name = value.String
+			}
+		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (ft *FileType) SelectValue(alias string) (Value, error) {
+	v, ok := ft.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryFiles queries the files edge of the FileType.
 func (ft *FileType) QueryFiles() *FileQuery {
 	return (&FileTypeClient{config: ft.config}).QueryFiles(ft)
 }
 
+// LoadEdges loads the given edges of the FileType using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see filetype.Edges), and in this case, no edge is loaded.
+//
+//	err := ft.LoadEdges(ctx, client, filetype.EdgeFiles)
+//
+func (ft *FileType) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadFileTypeEdges(ctx, client, []*FileType{ft}, edges)
+}
+
+// loadFileTypeEdges loads the given edges of all the given FileType entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using FileTypeClient.LoadEdgeFor.
+func loadFileTypeEdges(ctx context.Context, client *Client, nodes []*FileType, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case filetype.EdgeFiles:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type FileType", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.FileType.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the FileType and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the FileType alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The FileType itself is not modified.
+//
+//	b, err := ft.DumpGraph(ctx, client, 2, filetype.EdgeFiles)
+//
+func (ft *FileType) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for FileType DumpGraph", depth)
+	}
+	dumped := *ft
+	dumped.Edges = FileTypeEdges{}
+	if err := dumpFileTypes(ctx, client, []*FileType{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpFileTypes loads the edges of the given FileType copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpFileTypes(ctx context.Context, client *Client, nodes []*FileType, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*FileType
+	for _, n := range nodes {
+		if dumper.visit(filetype.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range filetype.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadFileTypeEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextFiles []*File
+	for _, n := range visit {
+		for i, e := range n.Edges.Files {
+			c := *e
+			c.Edges = FileEdges{}
+			n.Edges.Files[i] = &c
+			nextFiles = append(nextFiles, &c)
+		}
+	}
+	if err := dumpFiles(ctx, client, nextFiles, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this FileType.
 // Note that, you need to call FileType.Unwrap() before calling this method, if this FileType
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -95,6 +216,32 @@ func (ft *FileType) Unwrap() *FileType {
 	return ft
 }
 
+// Clone returns a deep copy of the FileType and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (ft *FileType) Clone() *FileType {
+	return ft.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the FileType and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (ft *FileType) clone(seen map[interface{}]interface{}) *FileType {
+	if ft == nil {
+		return nil
+	}
+	if v, ok := seen[ft]; ok {
+		return v.(*FileType)
+	}
+	_c := *ft
+	seen[ft] = &_c
+	if ft.Edges.Files != nil {
+		_c.Edges.Files = make([]*File, len(ft.Edges.Files))
+		for _i := range ft.Edges.Files {
+			_c.Edges.Files[_i] = ft.Edges.Files[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (ft *FileType) String() string {
 	var builder strings.Builder
@@ -106,6 +253,26 @@ func (ft *FileType) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the FileType, for change detection and cache keys.
+// It covers the fields that are listed in filetype.FingerprintFields, and it does not depend on the
+// ID of the FileType, on its edges, or on the order of the fields in the schema.
+func (ft *FileType) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", ft.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the FileType for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The FileType can be decoded using the FromMap method of its client.
+func (ft *FileType) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[filetype.FieldID] = kvEncode(ft.ID)
+	m[filetype.FieldName] = kvEncode(ft.Name)
+	return m
+}
+
 // FileTypes is a parsable slice of FileType.
 type FileTypes []*FileType
 
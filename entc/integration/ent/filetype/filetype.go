@@ -27,8 +27,29 @@ const (
 	FilesColumn = "file_type_files"
 )
 
+// Edges holds all the edge names of the FileType type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeFiles,
+}
+
+// FingerprintFields holds the fields of the FileType type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for filetype fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
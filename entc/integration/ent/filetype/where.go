@@ -7,6 +7,8 @@
 package filetype
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
@@ -185,6 +187,13 @@ func NameContains(v string) predicate.FileType {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.FileType {
+	return predicate.FileType(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.FileType {
 	return predicate.FileType(func(s *sql.Selector) {
@@ -192,6 +201,13 @@ func NameHasPrefix(v string) predicate.FileType {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.FileType {
+	return predicate.FileType(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.FileType {
 	return predicate.FileType(func(s *sql.Selector) {
@@ -199,6 +215,13 @@ func NameHasSuffix(v string) predicate.FileType {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.FileType {
+	return predicate.FileType(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.FileType {
 	return predicate.FileType(func(s *sql.Selector) {
@@ -213,6 +236,77 @@ func NameContainsFold(v string) predicate.FileType {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.FileType {
+	return predicate.FileType(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.FileType {
+	return predicate.FileType(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:   "numeric",
+	FieldName: "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the FileType.
+func FieldsEQ(f1, f2 string) predicate.FileType {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the FileType.
+func FieldsNEQ(f1, f2 string) predicate.FileType {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the FileType.
+func FieldsLT(f1, f2 string) predicate.FileType {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the FileType.
+func FieldsLTE(f1, f2 string) predicate.FileType {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the FileType.
+func FieldsGT(f1, f2 string) predicate.FileType {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the FileType.
+func FieldsGTE(f1, f2 string) predicate.FileType {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the FileType using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.FileType {
+	return predicate.FileType(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of FileType", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of FileType", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of FileType are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.FileType {
 	return predicate.FileType(func(s *sql.Selector) {
@@ -248,6 +342,9 @@ func And(predicates ...predicate.FileType) predicate.FileType {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -262,6 +359,9 @@ func Or(predicates ...predicate.FileType) predicate.FileType {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -272,3 +372,33 @@ func Not(p predicate.FileType) predicate.FileType {
 		p(s.Not())
 	})
 }
+
+// FileTypeFilter holds optional filters on the FileType fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type FileTypeFilter struct {
+	IDIn []int
+	Name *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.FileType.Query().
+//		Where(filetype.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *FileTypeFilter) []predicate.FileType {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.FileType
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	return ps
+}
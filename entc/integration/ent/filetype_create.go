@@ -10,7 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
@@ -47,8 +50,12 @@ func (ftc *FileTypeCreate) AddFiles(f ...*File) *FileTypeCreate {
 
 // Save creates the FileType in the database.
 func (ftc *FileTypeCreate) Save(ctx context.Context) (*FileType, error) {
-	if _, ok := ftc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	if err := checkTx(ftc.driver); err != nil {
+		return nil, err
+	}
+	ftc.defaults()
+	if err := ftc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -85,17 +92,115 @@ func (ftc *FileTypeCreate) SaveX(ctx context.Context) *FileType {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftc *FileTypeCreate) Unwrap() *FileTypeCreate {
+	tx, ok := ftc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeCreate is not a transactional builder")
+	}
+	ftc.config.driver = tx.drv
+	ftc.mutation.driver = tx.drv
+	return ftc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (ftc *FileTypeCreate) DebugFields() map[string]Value {
+	return debugFields(ftc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (ftc *FileTypeCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ftc *FileTypeCreate) check() error {
+	if _, ok := ftc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
 func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
-	var (
-		ft    = &FileType{config: ftc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: filetype.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: filetype.FieldID,
-			},
+	ctx = ftc.withOperation(ctx, "FileType", "Create")
+	ft, _spec := ftc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ftc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	ft.ID = int(id)
+	return ft, nil
+}
+
+// SaveID creates the FileType in the database and returns only its id. Unlike Save,
+// the FileType entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// FileType that holds only its id as the value that is returned by the next mutator.
+func (ftc *FileTypeCreate) SaveID(ctx context.Context) (id int, err error) {
+	ftc.defaults()
+	if err = ftc.check(); err != nil {
+		return id, err
+	}
+	if len(ftc.hooks) == 0 {
+		return ftc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*FileTypeMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		ftc.mutation = mutation
+		if id, err = ftc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &FileType{config: ftc.config, ID: id}, nil
+	})
+	for i := len(ftc.hooks) - 1; i >= 0; i-- {
+		mut = ftc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, ftc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (ftc *FileTypeCreate) SaveIDX(ctx context.Context) int {
+	id, err := ftc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (ftc *FileTypeCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = ftc.withOperation(ctx, "FileType", "Create")
+	_spec := ftc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, ftc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (ftc *FileTypeCreate) createSpec() (*FileType, *sqlgraph.CreateSpec) {
+	ft := &FileType{config: ftc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: filetype.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filetype.FieldID,
+		},
+	}
 	if value, ok := ftc.mutation.Name(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -123,13 +228,613 @@ func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, ftc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return ft, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (ftc *FileTypeCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: filetype.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filetype.FieldID,
+		},
+	}
+	if value, ok := ftc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filetype.FieldName,
+		})
+	}
+	if nodes := ftc.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   filetype.FilesTable,
+			Columns: []string{filetype.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// FileTypeCreateBulk is the builder for creating a bulk of FileType entities.
+type FileTypeCreateBulk struct {
+	config
+	builders []*FileTypeCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the FileType entities in the database.
+func (ftcb *FileTypeCreateBulk) Save(ctx context.Context) ([]*FileType, error) {
+	nodes, err := ftcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ftcb.retries > 0 && isSQLDeadlockError(err) {
+		return ftcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ftcb *FileTypeCreateBulk) SaveX(ctx context.Context) []*FileType {
+	v, err := ftcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ftcb *FileTypeCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ftcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ftcb *FileTypeCreateBulk) CheckRefs() *FileTypeCreateBulk {
+	ftcb.refs = true
+	return ftcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ftcb *FileTypeCreateBulk) RetryOnDeadlock(max int) *FileTypeCreateBulk {
+	ftcb.retries = max
+	return ftcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.FileType.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.FileType.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ftcb *FileTypeCreateBulk) OnConflict(columns ...string) *FileTypeUpsertBulk {
+	return &FileTypeUpsertBulk{create: ftcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.FileType.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (ftc *FileTypeCreate) OnConflict(target ...sql.ConflictOption) *FileTypeUpsertBulk {
+	bulk := &FileTypeCreateBulk{config: ftc.config, builders: []*FileTypeCreate{ftc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ftcb *FileTypeCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*FileType, error) {
+	ctx = ftcb.withOperation(ctx, "FileType", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ftcb.builders))
+		nodes    = make([]*FileType, len(ftcb.builders))
+		mutators = make([]Mutator, len(ftcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range ftcb.builders {
+		func(i int, root context.Context) {
+			builder := ftcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FileTypeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ftcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ftcb.refs {
+						if err := ftcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ftcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ftcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ftcb *FileTypeCreateBulk) retry(ctx context.Context, err error) ([]*FileType, error) {
+	if _, ok := ftcb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := ftcb.builders
+	defer func() { ftcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ftcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ftcb.builders = make([]*FileTypeCreate, len(idx))
+	for i, j := range idx {
+		ftcb.builders[i] = builders[j]
+	}
+	for n := 0; n < ftcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*FileType
+		if sorted, err = ftcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*FileType, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ftcb *FileTypeCreateBulk) less(a, b *FileTypeCreate) bool {
+	{
+		x, _ := a.mutation.Name()
+		y, _ := b.mutation.Name()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ftcb *FileTypeCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ftcb.builders {
+			for _, id := range builder.mutation.FilesIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ftcb.driver, file.Table, file.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[filetype.EdgeFiles] = append(missing[filetype.EdgeFiles], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "FileType", Missing: missing}
+	}
+	return nil
+}
+
+// FileTypeUpsertBulk is the builder for the conflict handling of a bulk of FileType entities.
+type FileTypeUpsertBulk struct {
+	create  *FileTypeCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (ftub *FileTypeUpsertBulk) Target(target ...sql.ConflictOption) *FileTypeUpsertBulk {
+	ftub.target = append(ftub.target, target...)
+	return ftub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (ftub *FileTypeUpsertBulk) DoNothing() *FileTypeUpsertBulk {
+	ftub.nothing = true
+	return ftub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (ftub *FileTypeUpsertBulk) UpdateNewValues() *FileTypeUpsertBulk {
+	ftub.update = true
+	return ftub
+}
+
+// Save creates the FileType entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (ftub *FileTypeUpsertBulk) Save(ctx context.Context) ([]*FileType, int, error) {
+	switch {
+	case !ftub.nothing && !ftub.update:
+		return nil, 0, errors.New("ent: missing conflict action for FileType bulk insert")
+	case ftub.nothing && ftub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for FileType bulk insert")
+	case ftub.update:
+		if len(ftub.columns) == 0 && len(ftub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for FileType bulk upsert")
 		}
+		nodes, err := ftub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(ftub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(filetype.FieldID),
+			),
+			Columns:         filetype.Columns,
+			ConflictColumns: ftub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(ftub.conflictTarget(), sql.DoNothing())}
+	nodes, err := ftub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*FileType, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (ftub *FileTypeUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(ftub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(ftub.columns...))
+	}
+	return append(opts, ftub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ftub *FileTypeUpsertBulk) SaveX(ctx context.Context) ([]*FileType, int) {
+	nodes, skipped, err := ftub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (ftub *FileTypeUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := ftub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftub *FileTypeUpsertBulk) ExecX(ctx context.Context) {
+	if err := ftub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored FileType entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.FileType.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *FileType) *FileType {
+//			// ...
+//			return existing
+//		}, filetype.FieldName).
+//		Save(ctx)
+//
+func (ftcb *FileTypeCreateBulk) OnConflictMerge(merge func(existing, incoming *FileType) *FileType, keyFields ...string) *FileTypeMergeBulk {
+	return &FileTypeMergeBulk{create: ftcb, merge: merge, keyFields: keyFields}
+}
+
+// FileTypeMergeBulk is the builder for merging a bulk of FileType entities with the stored entities in Go.
+type FileTypeMergeBulk struct {
+	create    *FileTypeCreateBulk
+	merge     func(existing, incoming *FileType) *FileType
+	keyFields []string
+}
+
+// Save creates or merges the FileType entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (ftmb *FileTypeMergeBulk) Save(ctx context.Context) ([]*FileType, error) {
+	if ftmb.merge == nil {
+		return nil, errors.New("ent: missing merge function for FileType bulk merge")
+	}
+	if len(ftmb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for FileType bulk merge")
+	}
+	for _, f := range ftmb.keyFields {
+		switch f {
+		case filetype.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for FileType bulk merge", f)
+		}
+	}
+	drv := ftmb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return ftmb.save(ctx, ftmb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	ft.ID = int(id)
-	return ft, nil
+	cfg := ftmb.create.config
+	cfg.driver = tx
+	nodes, err := ftmb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (ftmb *FileTypeMergeBulk) save(ctx context.Context, cfg config) ([]*FileType, error) {
+	var (
+		client   = &FileTypeClient{config: cfg}
+		builders = ftmb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *FileTypeCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(ftmb.keyFields))
+		for j, f := range ftmb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in FileType builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: FileType builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*FileType)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, ftmb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(ftmb.keyFields))
+			for j, f := range ftmb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*FileType, len(builders))
+		inserts []*FileTypeCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := ftmb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ftmb *FileTypeMergeBulk) SaveX(ctx context.Context) []*FileType {
+	nodes, err := ftmb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (ftmb *FileTypeMergeBulk) Exec(ctx context.Context) error {
+	_, err := ftmb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftmb *FileTypeMergeBulk) ExecX(ctx context.Context) {
+	if err := ftmb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -23,6 +23,7 @@ type FileTypeDelete struct {
 	hooks      []Hook
 	mutation   *FileTypeMutation
 	predicates []predicate.FileType
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (ftd *FileTypeDelete) Where(ps ...predicate.FileType) *FileTypeDelete {
 	return ftd
 }
 
+// Limit limits the number of filetypes that are deleted by the builder. For example,
+// for deleting large amounts of filetypes in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (ftd *FileTypeDelete) Limit(limit int) *FileTypeDelete {
+	ftd.limit = &limit
+	return ftd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftd *FileTypeDelete) Unwrap() *FileTypeDelete {
+	tx, ok := ftd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeDelete is not a transactional builder")
+	}
+	ftd.config.driver = tx.drv
+	ftd.mutation.driver = tx.drv
+	return ftd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (ftd *FileTypeDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(ftd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (ftd *FileTypeDelete) ExecX(ctx context.Context) int {
 }
 
 func (ftd *FileTypeDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = ftd.withOperation(ctx, "FileType", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: filetype.Table,
@@ -85,7 +111,10 @@ func (ftd *FileTypeDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, ftd.driver, _spec)
+	if limit := ftd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, ftd.mutationDriver(ctx), _spec)
 }
 
 // FileTypeDeleteOne is the builder for deleting a single FileType entity.
@@ -99,7 +128,7 @@ func (ftdo *FileTypeDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{filetype.Label}
 	default:
 		return nil
@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -31,6 +34,18 @@ type FileTypeQuery struct {
 	predicates []predicate.FileType
 	// eager-loading edges.
 	withFiles *FileQuery
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see FileTypeSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -42,6 +57,19 @@ func (ftq *FileTypeQuery) Where(ps ...predicate.FileType) *FileTypeQuery {
 	return ftq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (ftq *FileTypeQuery) Predicates() []predicate.FileType {
+	return append([]predicate.FileType{}, ftq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (ftq *FileTypeQuery) SetPredicates(ps ...predicate.FileType) *FileTypeQuery {
+	ftq.predicates = append([]predicate.FileType{}, ps...)
+	return ftq
+}
+
 // Limit adds a limit step to the query.
 func (ftq *FileTypeQuery) Limit(limit int) *FileTypeQuery {
 	ftq.limit = &limit
@@ -310,11 +338,15 @@ func (ftq *FileTypeQuery) GroupBy(field string, fields ...string) *FileTypeGroup
 func (ftq *FileTypeQuery) Select(field string, fields ...string) *FileTypeSelect {
 	selector := &FileTypeSelect{config: ftq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = ftq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := ftq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return ftq.sqlQuery(), nil
+		if err := ftq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return ftq.sqlQuery().AppendSelectExpr(ftq.exprs...), nil
 	}
 	return selector
 }
@@ -331,6 +363,15 @@ func (ftq *FileTypeQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
+	ctx = ftq.withOperation(ctx, "FileType", "Query")
+	if err := ftq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range ftq.fields {
+		if !filetype.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*FileType{}
 		_spec       = ftq.querySpec()
@@ -338,10 +379,15 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 			ftq.withFiles != nil,
 		}
 	)
+	_spec.Exprs = ftq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &FileType{config: ftq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
+		values := node.scanValues(columns)
+		for range ftq.exprs {
+			values = append(values, new(interface{}))
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -349,8 +395,16 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(ftq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range ftq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, ftq.driver, _spec); err != nil {
 		return nil, err
@@ -360,6 +414,9 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 	}
 
 	if query := ftq.withFiles; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*FileType)
 		for i := range nodes {
@@ -367,30 +424,73 @@ func (ftq *FileTypeQuery) sqlAll(ctx context.Context) ([]*FileType, error) {
 			nodeids[nodes[i].ID] = nodes[i]
 		}
 		query.withFKs = true
-		query.Where(predicate.File(func(s *sql.Selector) {
-			s.Where(sql.InValues(filetype.FilesColumn, fks...))
-		}))
-		neighbors, err := query.All(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range neighbors {
-			fk := n.file_type_files
-			if fk == nil {
-				return nil, fmt.Errorf(`foreign-key "file_type_files" is nil for node %v`, n.ID)
+		// The limit and the offset of the query are applied per node.
+		query.partition = filetype.FilesColumn
+		err := ftq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.File(func(s *sql.Selector) {
+				s.Where(sql.InValues(filetype.FilesColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			node, ok := nodeids[*fk]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "file_type_files" returned %v for node %v`, *fk, n.ID)
+			for _, n := range neighbors {
+				fk := n.file_type_files
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "file_type_files" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "file_type_files" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Files = append(node.Edges.Files, n)
 			}
-			node.Edges.Files = append(node.Edges.Files, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the FileType entities (see FileType.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.FileType.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the FileType table.
+func (ftq *FileTypeQuery) SelectExpr(exprs ...*sql.SelectExpr) *FileTypeQuery {
+	ftq.exprs = append(ftq.exprs, exprs...)
+	return ftq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (ftq *FileTypeQuery) checkExprs() error {
+	if len(ftq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range filetype.Columns {
+		columns[c] = true
+	}
+	for _, e := range ftq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of FileType", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (ftq *FileTypeQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = ftq.withOperation(ctx, "FileType", "Count")
 	_spec := ftq.querySpec()
 	return sqlgraph.CountNodes(ctx, ftq.driver, _spec)
 }
@@ -416,6 +516,17 @@ func (ftq *FileTypeQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   ftq.sql,
 		Unique: true,
 	}
+	if fields := ftq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, filetype.FieldID)
+		for i := range fields {
+			if fields[i] != filetype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = ftq.forUpdate
+	_spec.PartitionBy = ftq.partition
 	if ps := ftq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -464,11 +575,411 @@ func (ftq *FileTypeQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the FileType entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (ftq *FileTypeQuery) IDsQuery() *sql.Selector {
+	selector := ftq.sqlQuery()
+	if ftq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(filetype.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the FileType entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.FileType.Query().
+//		Where(...).
+//		CountDistinct(ctx, filetype.FieldName)
+//
+func (ftq *FileTypeQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = ftq.withOperation(ctx, "FileType", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, ftq.driver, ftq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (ftq *FileTypeQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := ftq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched FileType rows as generic maps that are keyed by
+// column name (see filetype.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (ftq *FileTypeQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = ftq.withOperation(ctx, "FileType", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = ftq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&FileType{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, ftq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (ftq *FileTypeQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := ftq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of FileType entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (ftq *FileTypeQuery) Prefetch(n int) *FileTypeQuery {
+	ftq.prefetch = n
+	return ftq
+}
+
+// ForUpdate locks the selected FileType rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (ftq *FileTypeQuery) ForUpdate() *FileTypeQuery {
+	ftq.forUpdate = true
+	return ftq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (ftq *FileTypeQuery) Primary() *FileTypeQuery {
+	return ftq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (ftq *FileTypeQuery) ReadConsistency(c sql.ReadConsistency) *FileTypeQuery {
+	ftq.consistency = c
+	return ftq
+}
+
+// Stream executes the query in batches, and sends the matched FileType entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.FileType.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (ftq *FileTypeQuery) Stream(ctx context.Context) (<-chan *FileType, <-chan error) {
+	size := ftq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *FileType, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := ftq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the FileType entities in batches of the given
+// size, and sends them to the given channel.
+func (ftq *FileTypeQuery) stream(ctx context.Context, size int, nodes chan<- *FileType) error {
+	if ftq.offset != nil || len(ftq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if ftq.limit != nil {
+		left = *ftq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *ftq
+		query.predicates = ftq.predicates[:len(ftq.predicates):len(ftq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, filetype.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(filetype.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// FileTypeEdge is the edge representation of FileType in a connection.
+type FileTypeEdge struct {
+	Node   *FileType `json:"node"`
+	Cursor Cursor    `json:"cursor"`
+}
+
+// FileTypeConnection is the connection of FileType entities that is returned by Paginate.
+type FileTypeConnection struct {
+	Edges      []FileTypeEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.FileType.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: filetype.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (ftq *FileTypeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*FileTypeConnection, error) {
+	if ftq.limit != nil || ftq.offset != nil || len(ftq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case filetype.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for FileType", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := ftq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: filetype.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *ftq
+	query.predicates = ftq.predicates[:len(ftq.predicates):len(ftq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := ftq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &FileTypeConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]FileTypeEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = FileTypeEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (ftq *FileTypeQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.FileType, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := ftq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.FileType(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*FileTypeQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case filetype.FieldName:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field name: %v", err)
+		}
+		return v, nil
+	case filetype.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for FileType", field)
+}
+
+// pageCursor returns the cursor of the FileType node for the given order fields.
+func (ft *FileType) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(ft.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case filetype.FieldName:
+			v = ft.Name
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for FileType", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
 // FileTypeGroupBy is the builder for group-by FileType entities.
 type FileTypeGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -581,9 +1092,28 @@ func (ftgb *FileTypeGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.FileType.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (ftgb *FileTypeGroupBy) Having(ps ...*sql.Predicate) *FileTypeGroupBy {
+	ftgb.havings = append(ftgb.havings, ps...)
+	return ftgb
+}
+
 func (ftgb *FileTypeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ftgb.withOperation(ctx, "FileType", "GroupBy")
+	selector := ftgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := ftgb.sqlQuery().Query()
 	if err := ftgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -596,15 +1126,30 @@ func (ftgb *FileTypeGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(ftgb.fields)+len(ftgb.fns))
 	columns = append(columns, ftgb.fields...)
 	for _, fn := range ftgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(ftgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(ftgb.fields...)
+	switch len(ftgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(ftgb.havings[0])
+	default:
+		selector.Having(sql.And(ftgb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(ftgb.fields...)
+	return selector
 }
 
 // FileTypeSelect is the builder for select fields of FileType entities.
 type FileTypeSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *FileTypeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -711,7 +1256,52 @@ func (fts *FileTypeSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (fts *FileTypeSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(fts.fields) > 1 {
+		return nil, errors.New("ent: FileTypeSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := fts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (fts *FileTypeSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := fts.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the FileType entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.FileType.Query().
+//		Select(filetype.FieldName).
+//		All(ctx)
+//
+func (fts *FileTypeSelect) All(ctx context.Context) ([]*FileType, error) {
+	query := *fts.query
+	query.fields = fts.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fts *FileTypeSelect) AllX(ctx context.Context) []*FileType {
+	nodes, err := fts.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (fts *FileTypeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = fts.withOperation(ctx, "FileType", "Select")
 	rows := &sql.Rows{}
 	query, args := fts.sqlQuery().Query()
 	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
@@ -24,6 +24,9 @@ type FileTypeUpdate struct {
 	hooks      []Hook
 	mutation   *FileTypeMutation
 	predicates []predicate.FileType
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,12 +35,26 @@ func (ftu *FileTypeUpdate) Where(ps ...predicate.FileType) *FileTypeUpdate {
 	return ftu
 }
 
+// Limit limits the number of filetypes that are updated by the builder. For example,
+// for updating large amounts of filetypes in small batches without locking the whole table.
+func (ftu *FileTypeUpdate) Limit(limit int) *FileTypeUpdate {
+	ftu.limit = &limit
+	return ftu
+}
+
 // SetName sets the name field.
 func (ftu *FileTypeUpdate) SetName(s string) *FileTypeUpdate {
 	ftu.mutation.SetName(s)
 	return ftu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (ftu *FileTypeUpdate) UnsetName() *FileTypeUpdate {
+	ftu.mutation.ResetName()
+	return ftu
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (ftu *FileTypeUpdate) AddFileIDs(ids ...int) *FileTypeUpdate {
 	ftu.mutation.AddFileIDs(ids...)
@@ -68,9 +85,33 @@ func (ftu *FileTypeUpdate) RemoveFiles(f ...*File) *FileTypeUpdate {
 	return ftu.RemoveFileIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftu *FileTypeUpdate) Unwrap() *FileTypeUpdate {
+	tx, ok := ftu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeUpdate is not a transactional builder")
+	}
+	ftu.config.driver = tx.drv
+	ftu.mutation.driver = tx.drv
+	return ftu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftu *FileTypeUpdate) DebugFields() map[string]Value {
+	return debugFields(ftu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (ftu *FileTypeUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(ftu.driver); err != nil {
+		return 0, err
+	}
+	if err := ftu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -119,7 +160,13 @@ func (ftu *FileTypeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftu *FileTypeUpdate) check() error {
+	return nil
+}
+
 func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = ftu.withOperation(ctx, "FileType", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   filetype.Table,
@@ -137,6 +184,12 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := ftu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if ftu.ids != nil {
+		_spec.ScanIDs = ftu.ids
+	}
 	if value, ok := ftu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -182,7 +235,7 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, ftu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{filetype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -193,11 +246,33 @@ func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the FileType entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (ftu *FileTypeUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	ftu.ids = &ids
+	defer func() { ftu.ids = nil }()
+	if _, err := ftu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // FileTypeUpdateOne is the builder for updating a single FileType entity.
 type FileTypeUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *FileTypeMutation
+	hooks      []Hook
+	mutation   *FileTypeMutation
+	predicates []predicate.FileType
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (ftuo *FileTypeUpdateOne) Where(ps ...predicate.FileType) *FileTypeUpdateOne {
+	ftuo.predicates = append(ftuo.predicates, ps...)
+	return ftuo
 }
 
 // SetName sets the name field.
@@ -206,6 +281,13 @@ func (ftuo *FileTypeUpdateOne) SetName(s string) *FileTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (ftuo *FileTypeUpdateOne) UnsetName() *FileTypeUpdateOne {
+	ftuo.mutation.ResetName()
+	return ftuo
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (ftuo *FileTypeUpdateOne) AddFileIDs(ids ...int) *FileTypeUpdateOne {
 	ftuo.mutation.AddFileIDs(ids...)
@@ -236,9 +318,33 @@ func (ftuo *FileTypeUpdateOne) RemoveFiles(f ...*File) *FileTypeUpdateOne {
 	return ftuo.RemoveFileIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftuo *FileTypeUpdateOne) Unwrap() *FileTypeUpdateOne {
+	tx, ok := ftuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeUpdateOne is not a transactional builder")
+	}
+	ftuo.config.driver = tx.drv
+	ftuo.mutation.driver = tx.drv
+	return ftuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftuo *FileTypeUpdateOne) DebugFields() map[string]Value {
+	return debugFields(ftuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (ftuo *FileTypeUpdateOne) Save(ctx context.Context) (*FileType, error) {
-
+	if err := checkTx(ftuo.driver); err != nil {
+		return nil, err
+	}
+	if err := ftuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *FileType
@@ -287,7 +393,13 @@ func (ftuo *FileTypeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftuo *FileTypeUpdateOne) check() error {
+	return nil
+}
+
 func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err error) {
+	ctx = ftuo.withOperation(ctx, "FileType", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   filetype.Table,
@@ -303,6 +415,13 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err e
 		return nil, fmt.Errorf("missing FileType.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := ftuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := ftuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -349,9 +468,11 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err e
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	ft = &FileType{config: ftuo.config}
-	_spec.Assign = ft.assignValues
-	_spec.ScanValues = ft.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, ftuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return ft.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = ft.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, ftuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{filetype.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -359,5 +480,13 @@ func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (ft *FileType, err e
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		ft.ID = id
+		if value, ok := ftuo.mutation.Name(); ok {
+			ft.Name = value
+		}
+	}
 	return ft, nil
 }
@@ -6,6 +6,10 @@
 
 package group
 
+import (
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
 const (
 	// Label holds the string label denoting the group type in the database.
 	Label = "group"
@@ -56,6 +60,24 @@ const (
 	InfoColumn = "group_info"
 )
 
+// Edges holds all the edge names of the Group type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeFiles,
+	EdgeBlocked,
+	EdgeUsers,
+	EdgeInfo,
+}
+
+// FingerprintFields holds the fields of the Group type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldActive,
+	FieldExpire,
+	FieldMaxUsers,
+	FieldName,
+	FieldType,
+}
+
 // Columns holds all SQL columns for group fields.
 var Columns = []string{
 	FieldID,
@@ -71,12 +93,28 @@ var ForeignKeys = []string{
 	"group_info",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// UsersPrimaryKey and UsersColumn2 are the table columns denoting the
 	// primary key for the users relation (M2M).
 	UsersPrimaryKey = []string{"user_id", "group_id"}
 )
 
+// ExpireTrunc returns a column that truncates the expire field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "expire_<bucket>".
+func ExpireTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldExpire)
+}
+
 var (
 	// DefaultActive holds the default value on creation for the active field.
 	DefaultActive bool
@@ -7,6 +7,7 @@
 package group
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -305,6 +306,13 @@ func TypeContains(v string) predicate.Group {
 	})
 }
 
+// TypeNotContains applies the NotContains predicate on the "type" field.
+func TypeNotContains(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldType), v))
+	})
+}
+
 // TypeHasPrefix applies the HasPrefix predicate on the "type" field.
 func TypeHasPrefix(v string) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -312,6 +320,13 @@ func TypeHasPrefix(v string) predicate.Group {
 	})
 }
 
+// TypeNotHasPrefix applies the NotHasPrefix predicate on the "type" field.
+func TypeNotHasPrefix(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldType), v))
+	})
+}
+
 // TypeHasSuffix applies the HasSuffix predicate on the "type" field.
 func TypeHasSuffix(v string) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -319,6 +334,13 @@ func TypeHasSuffix(v string) predicate.Group {
 	})
 }
 
+// TypeNotHasSuffix applies the NotHasSuffix predicate on the "type" field.
+func TypeNotHasSuffix(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldType), v))
+	})
+}
+
 // TypeIsNil applies the IsNil predicate on the "type" field.
 func TypeIsNil() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -347,6 +369,20 @@ func TypeContainsFold(v string) predicate.Group {
 	})
 }
 
+// TypeHasPrefixFold applies the HasPrefixFold predicate on the "type" field.
+func TypeHasPrefixFold(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldType), v))
+	})
+}
+
+// TypeHasSuffixFold applies the HasSuffixFold predicate on the "type" field.
+func TypeHasSuffixFold(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldType), v))
+	})
+}
+
 // MaxUsersEQ applies the EQ predicate on the "max_users" field.
 func MaxUsersEQ(v int) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -520,6 +556,13 @@ func NameContains(v string) predicate.Group {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -527,6 +570,13 @@ func NameHasPrefix(v string) predicate.Group {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -534,6 +584,13 @@ func NameHasSuffix(v string) predicate.Group {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -548,6 +605,108 @@ func NameContainsFold(v string) predicate.Group {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
+// TypeEQNullSafe applies a NULL-safe equality predicate on the "type" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func TypeEQNullSafe(v *string) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldType), arg))
+	})
+}
+
+// MaxUsersEQNullSafe applies a NULL-safe equality predicate on the "max_users" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func MaxUsersEQNullSafe(v *int) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldMaxUsers), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldActive:   "bool",
+	FieldExpire:   "time",
+	FieldID:       "numeric",
+	FieldMaxUsers: "numeric",
+	FieldName:     "string",
+	FieldType:     "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Group.
+func FieldsEQ(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Group.
+func FieldsNEQ(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Group.
+//
+//	group.FieldsLT(group.FieldType, group.FieldName)
+//
+func FieldsLT(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Group.
+func FieldsLTE(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Group.
+func FieldsGT(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Group.
+func FieldsGTE(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Group using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Group", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Group", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Group are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -660,6 +819,45 @@ func HasInfoWith(preds ...predicate.GroupInfo) predicate.Group {
 	})
 }
 
+// InfoIDIn applies a predicate on the "info" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	group.InfoIDIn(client.GroupInfo.Query().Where(...))
+//
+func InfoIDIn(q sqlgraph.IDsQuerier) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(InfoColumn), sub))
+	})
+}
+
+// InfoMissing applies a predicate on the "info" edge, that checks if its foreign-key is set, but
+// there is no GroupInfo with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE group_info IS NOT NULL AND NOT EXISTS (SELECT id FROM group_infos WHERE id = group_info)
+//
+func InfoMissing() predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(InfoInverseTable).As("info")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(InfoColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(InfoColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -667,6 +865,9 @@ func And(predicates ...predicate.Group) predicate.Group {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -681,6 +882,9 @@ func Or(predicates ...predicate.Group) predicate.Group {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -691,3 +895,70 @@ func Not(p predicate.Group) predicate.Group {
 		p(s.Not())
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// GroupFilter holds optional filters on the Group fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type GroupFilter struct {
+	IDIn     []int
+	Active   *bool
+	Expire   *TimeRange
+	Type     *string
+	MaxUsers *int
+	Name     *string
+	InfoIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Group.Query().
+//		Where(group.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *GroupFilter) []predicate.Group {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Group
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Active != nil {
+		ps = append(ps, ActiveEQ(*f.Active))
+	}
+	if r := f.Expire; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, ExpireGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, ExpireLT(r.To))
+		}
+	}
+	if f.Type != nil {
+		ps = append(ps, TypeEQ(*f.Type))
+	}
+	if f.MaxUsers != nil {
+		ps = append(ps, MaxUsersEQ(*f.MaxUsers))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if ids := f.InfoIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Group(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(InfoColumn), v...))
+		}))
+	}
+	return ps
+}
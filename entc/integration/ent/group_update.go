@@ -28,6 +28,9 @@ type GroupUpdate struct {
 	hooks      []Hook
 	mutation   *GroupMutation
 	predicates []predicate.Group
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -36,6 +39,13 @@ func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
 	return gu
 }
 
+// Limit limits the number of groups that are updated by the builder. For example,
+// for updating large amounts of groups in small batches without locking the whole table.
+func (gu *GroupUpdate) Limit(limit int) *GroupUpdate {
+	gu.limit = &limit
+	return gu
+}
+
 // SetActive sets the active field.
 func (gu *GroupUpdate) SetActive(b bool) *GroupUpdate {
 	gu.mutation.SetActive(b)
@@ -50,12 +60,26 @@ func (gu *GroupUpdate) SetNillableActive(b *bool) *GroupUpdate {
 	return gu
 }
 
+// UnsetActive removes the changes of the active field from the builder (e.g. a previous call
+// to SetActive), and therefore, the field is left unchanged in the database.
+func (gu *GroupUpdate) UnsetActive() *GroupUpdate {
+	gu.mutation.ResetActive()
+	return gu
+}
+
 // SetExpire sets the expire field.
 func (gu *GroupUpdate) SetExpire(t time.Time) *GroupUpdate {
 	gu.mutation.SetExpire(t)
 	return gu
 }
 
+// UnsetExpire removes the changes of the expire field from the builder (e.g. a previous call
+// to SetExpire), and therefore, the field is left unchanged in the database.
+func (gu *GroupUpdate) UnsetExpire() *GroupUpdate {
+	gu.mutation.ResetExpire()
+	return gu
+}
+
 // SetType sets the type field.
 func (gu *GroupUpdate) SetType(s string) *GroupUpdate {
 	gu.mutation.SetType(s)
@@ -76,6 +100,14 @@ func (gu *GroupUpdate) ClearType() *GroupUpdate {
 	return gu
 }
 
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (gu *GroupUpdate) UnsetType() *GroupUpdate {
+	gu.mutation.ResetType()
+	return gu
+}
+
 // SetMaxUsers sets the max_users field.
 func (gu *GroupUpdate) SetMaxUsers(i int) *GroupUpdate {
 	gu.mutation.ResetMaxUsers()
@@ -103,12 +135,27 @@ func (gu *GroupUpdate) ClearMaxUsers() *GroupUpdate {
 	return gu
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database. Unlike ClearMaxUsers,
+// it does not set the field to NULL, and also removes a previous call to ClearMaxUsers.
+func (gu *GroupUpdate) UnsetMaxUsers() *GroupUpdate {
+	gu.mutation.ResetMaxUsers()
+	return gu
+}
+
 // SetName sets the name field.
 func (gu *GroupUpdate) SetName(s string) *GroupUpdate {
 	gu.mutation.SetName(s)
 	return gu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (gu *GroupUpdate) UnsetName() *GroupUpdate {
+	gu.mutation.ResetName()
+	return gu
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (gu *GroupUpdate) AddFileIDs(ids ...int) *GroupUpdate {
 	gu.mutation.AddFileIDs(ids...)
@@ -216,26 +263,32 @@ func (gu *GroupUpdate) ClearInfo() *GroupUpdate {
 	return gu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gu *GroupUpdate) Unwrap() *GroupUpdate {
+	tx, ok := gu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupUpdate is not a transactional builder")
+	}
+	gu.config.driver = tx.drv
+	gu.mutation.driver = tx.drv
+	return gu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (gu *GroupUpdate) DebugFields() map[string]Value {
+	return debugFields(gu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := gu.mutation.GetType(); ok {
-		if err := group.TypeValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
-	}
-	if v, ok := gu.mutation.MaxUsers(); ok {
-		if err := group.MaxUsersValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)
-		}
-	}
-	if v, ok := gu.mutation.Name(); ok {
-		if err := group.NameValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(gu.driver); err != nil {
+		return 0, err
 	}
-
-	if _, ok := gu.mutation.InfoID(); gu.mutation.InfoCleared() && !ok {
-		return 0, errors.New("ent: clearing a unique edge \"info\"")
+	if err := gu.check(); err != nil {
+		return 0, err
 	}
 	var (
 		err      error
@@ -285,7 +338,34 @@ func (gu *GroupUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (gu *GroupUpdate) check() error {
+	if v, ok := gu.mutation.GetType(); ok {
+		if err := group.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if v, ok := gu.mutation.MaxUsers(); ok {
+		if err := group.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)}
+		}
+	}
+	if v, ok := gu.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if gu.mutation.conflictinfo {
+		return &ValidationError{Name: "info", err: errors.New("ent: setting and clearing the unique edge \"info\" in the same mutation")}
+	}
+	if _, ok := gu.mutation.InfoID(); gu.mutation.InfoCleared() && !ok {
+		return &ValidationError{Name: "info", err: errors.New("ent: clearing a unique edge \"info\"")}
+	}
+	return nil
+}
+
 func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = gu.withOperation(ctx, "Group", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   group.Table,
@@ -303,6 +383,12 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := gu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if gu.ids != nil {
+		_spec.ScanIDs = gu.ids
+	}
 	if value, ok := gu.mutation.Active(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -506,7 +592,7 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -517,11 +603,33 @@ func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Group entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (gu *GroupUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	gu.ids = &ids
+	defer func() { gu.ids = nil }()
+	if _, err := gu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *GroupMutation
+	hooks      []Hook
+	mutation   *GroupMutation
+	predicates []predicate.Group
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.predicates = append(guo.predicates, ps...)
+	return guo
 }
 
 // SetActive sets the active field.
@@ -538,12 +646,26 @@ func (guo *GroupUpdateOne) SetNillableActive(b *bool) *GroupUpdateOne {
 	return guo
 }
 
+// UnsetActive removes the changes of the active field from the builder (e.g. a previous call
+// to SetActive), and therefore, the field is left unchanged in the database.
+func (guo *GroupUpdateOne) UnsetActive() *GroupUpdateOne {
+	guo.mutation.ResetActive()
+	return guo
+}
+
 // SetExpire sets the expire field.
 func (guo *GroupUpdateOne) SetExpire(t time.Time) *GroupUpdateOne {
 	guo.mutation.SetExpire(t)
 	return guo
 }
 
+// UnsetExpire removes the changes of the expire field from the builder (e.g. a previous call
+// to SetExpire), and therefore, the field is left unchanged in the database.
+func (guo *GroupUpdateOne) UnsetExpire() *GroupUpdateOne {
+	guo.mutation.ResetExpire()
+	return guo
+}
+
 // SetType sets the type field.
 func (guo *GroupUpdateOne) SetType(s string) *GroupUpdateOne {
 	guo.mutation.SetType(s)
@@ -564,6 +686,14 @@ func (guo *GroupUpdateOne) ClearType() *GroupUpdateOne {
 	return guo
 }
 
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (guo *GroupUpdateOne) UnsetType() *GroupUpdateOne {
+	guo.mutation.ResetType()
+	return guo
+}
+
 // SetMaxUsers sets the max_users field.
 func (guo *GroupUpdateOne) SetMaxUsers(i int) *GroupUpdateOne {
 	guo.mutation.ResetMaxUsers()
@@ -591,12 +721,27 @@ func (guo *GroupUpdateOne) ClearMaxUsers() *GroupUpdateOne {
 	return guo
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database. Unlike ClearMaxUsers,
+// it does not set the field to NULL, and also removes a previous call to ClearMaxUsers.
+func (guo *GroupUpdateOne) UnsetMaxUsers() *GroupUpdateOne {
+	guo.mutation.ResetMaxUsers()
+	return guo
+}
+
 // SetName sets the name field.
 func (guo *GroupUpdateOne) SetName(s string) *GroupUpdateOne {
 	guo.mutation.SetName(s)
 	return guo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (guo *GroupUpdateOne) UnsetName() *GroupUpdateOne {
+	guo.mutation.ResetName()
+	return guo
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (guo *GroupUpdateOne) AddFileIDs(ids ...int) *GroupUpdateOne {
 	guo.mutation.AddFileIDs(ids...)
@@ -704,26 +849,32 @@ func (guo *GroupUpdateOne) ClearInfo() *GroupUpdateOne {
 	return guo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (guo *GroupUpdateOne) Unwrap() *GroupUpdateOne {
+	tx, ok := guo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupUpdateOne is not a transactional builder")
+	}
+	guo.config.driver = tx.drv
+	guo.mutation.driver = tx.drv
+	return guo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (guo *GroupUpdateOne) DebugFields() map[string]Value {
+	return debugFields(guo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
-	if v, ok := guo.mutation.GetType(); ok {
-		if err := group.TypeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
-	}
-	if v, ok := guo.mutation.MaxUsers(); ok {
-		if err := group.MaxUsersValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)
-		}
-	}
-	if v, ok := guo.mutation.Name(); ok {
-		if err := group.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(guo.driver); err != nil {
+		return nil, err
 	}
-
-	if _, ok := guo.mutation.InfoID(); guo.mutation.InfoCleared() && !ok {
-		return nil, errors.New("ent: clearing a unique edge \"info\"")
+	if err := guo.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -773,7 +924,34 @@ func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (guo *GroupUpdateOne) check() error {
+	if v, ok := guo.mutation.GetType(); ok {
+		if err := group.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if v, ok := guo.mutation.MaxUsers(); ok {
+		if err := group.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)}
+		}
+	}
+	if v, ok := guo.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if guo.mutation.conflictinfo {
+		return &ValidationError{Name: "info", err: errors.New("ent: setting and clearing the unique edge \"info\" in the same mutation")}
+	}
+	if _, ok := guo.mutation.InfoID(); guo.mutation.InfoCleared() && !ok {
+		return &ValidationError{Name: "info", err: errors.New("ent: clearing a unique edge \"info\"")}
+	}
+	return nil
+}
+
 func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
+	ctx = guo.withOperation(ctx, "Group", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   group.Table,
@@ -789,6 +967,13 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		return nil, fmt.Errorf("missing Group.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := guo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := guo.mutation.Active(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeBool,
@@ -993,9 +1178,11 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	gr = &Group{config: guo.config}
-	_spec.Assign = gr.assignValues
-	_spec.ScanValues = gr.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return gr.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = gr.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, guo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{group.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -1003,5 +1190,25 @@ func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (gr *Group, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		gr.ID = id
+		if value, ok := guo.mutation.Active(); ok {
+			gr.Active = value
+		}
+		if value, ok := guo.mutation.Expire(); ok {
+			gr.Expire = value
+		}
+		if value, ok := guo.mutation.GetType(); ok {
+			gr.Type = &value
+		}
+		if value, ok := guo.mutation.MaxUsers(); ok {
+			gr.MaxUsers = value
+		}
+		if value, ok := guo.mutation.Name(); ok {
+			gr.Name = value
+		}
+	}
 	return gr, nil
 }
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -26,6 +30,9 @@ type GroupInfo struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GroupInfoQuery when eager-loading is set.
 	Edges GroupInfoEdges `json:"edges"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // GroupInfoEdges holds the relations/edges for other nodes in the graph.
@@ -46,45 +53,161 @@ func (e GroupInfoEdges) GroupsOrErr() ([]*Group, error) {
 	return nil, &NotLoadedError{edge: "groups"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*GroupInfo) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // desc
-		&sql.NullInt64{},  // max_users
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the GroupInfo type are scanned as generic values.
+func (*GroupInfo) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case groupinfo.FieldID:
+			values[i] = &sql.NullInt64{}
+		case groupinfo.FieldDesc:
+			values[i] = &sql.NullString{}
+		case groupinfo.FieldMaxUsers:
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the GroupInfo fields.
-func (gi *GroupInfo) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(groupinfo.Columns); m < n {
+// to the GroupInfo fields. Values of columns that were not selected are left untouched.
+func (gi *GroupInfo) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	gi.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field desc", values[0])
-	} else if value.Valid {
-		gi.Desc = value.String
-	}
-	if value, ok := values[1].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field max_users", values[1])
-	} else if value.Valid {
-		gi.MaxUsers = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case groupinfo.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gi.ID = int(value.Int64)
+		case groupinfo.FieldDesc:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field desc", values[i])
+			} else if value.Valid {
+				gi.Desc = value.String
+			}
+		case groupinfo.FieldMaxUsers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_users", values[i])
+			} else if value.Valid {
+				gi.MaxUsers = int(value.Int64)
+			}
+		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (gi *GroupInfo) SelectValue(alias string) (Value, error) {
+	v, ok := gi.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryGroups queries the groups edge of the GroupInfo.
 func (gi *GroupInfo) QueryGroups() *GroupQuery {
 	return (&GroupInfoClient{config: gi.config}).QueryGroups(gi)
 }
 
+// LoadEdges loads the given edges of the GroupInfo using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see groupinfo.Edges), and in this case, no edge is loaded.
+//
+//	err := gi.LoadEdges(ctx, client, groupinfo.EdgeGroups)
+//
+func (gi *GroupInfo) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadGroupInfoEdges(ctx, client, []*GroupInfo{gi}, edges)
+}
+
+// loadGroupInfoEdges loads the given edges of all the given GroupInfo entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using GroupInfoClient.LoadEdgeFor.
+func loadGroupInfoEdges(ctx context.Context, client *Client, nodes []*GroupInfo, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case groupinfo.EdgeGroups:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type GroupInfo", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.GroupInfo.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the GroupInfo and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the GroupInfo alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The GroupInfo itself is not modified.
+//
+//	b, err := gi.DumpGraph(ctx, client, 2, groupinfo.EdgeGroups)
+//
+func (gi *GroupInfo) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for GroupInfo DumpGraph", depth)
+	}
+	dumped := *gi
+	dumped.Edges = GroupInfoEdges{}
+	if err := dumpGroupInfos(ctx, client, []*GroupInfo{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpGroupInfos loads the edges of the given GroupInfo copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpGroupInfos(ctx context.Context, client *Client, nodes []*GroupInfo, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*GroupInfo
+	for _, n := range nodes {
+		if dumper.visit(groupinfo.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range groupinfo.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadGroupInfoEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextGroups []*Group
+	for _, n := range visit {
+		for i, e := range n.Edges.Groups {
+			c := *e
+			c.Edges = GroupEdges{}
+			n.Edges.Groups[i] = &c
+			nextGroups = append(nextGroups, &c)
+		}
+	}
+	if err := dumpGroups(ctx, client, nextGroups, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this GroupInfo.
 // Note that, you need to call GroupInfo.Unwrap() before calling this method, if this GroupInfo
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -103,6 +226,32 @@ func (gi *GroupInfo) Unwrap() *GroupInfo {
 	return gi
 }
 
+// Clone returns a deep copy of the GroupInfo and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (gi *GroupInfo) Clone() *GroupInfo {
+	return gi.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the GroupInfo and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (gi *GroupInfo) clone(seen map[interface{}]interface{}) *GroupInfo {
+	if gi == nil {
+		return nil
+	}
+	if v, ok := seen[gi]; ok {
+		return v.(*GroupInfo)
+	}
+	_c := *gi
+	seen[gi] = &_c
+	if gi.Edges.Groups != nil {
+		_c.Edges.Groups = make([]*Group, len(gi.Edges.Groups))
+		for _i := range gi.Edges.Groups {
+			_c.Edges.Groups[_i] = gi.Edges.Groups[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gi *GroupInfo) String() string {
 	var builder strings.Builder
@@ -116,6 +265,28 @@ func (gi *GroupInfo) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the GroupInfo, for change detection and cache keys.
+// It covers the fields that are listed in groupinfo.FingerprintFields, and it does not depend on the
+// ID of the GroupInfo, on its edges, or on the order of the fields in the schema.
+func (gi *GroupInfo) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "desc", gi.Desc)
+	fingerprint(h, "max_users", gi.MaxUsers)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the GroupInfo for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The GroupInfo can be decoded using the FromMap method of its client.
+func (gi *GroupInfo) ToMap() map[string]string {
+	m := make(map[string]string, 3)
+	m[groupinfo.FieldID] = kvEncode(gi.ID)
+	m[groupinfo.FieldDesc] = kvEncode(gi.Desc)
+	m[groupinfo.FieldMaxUsers] = kvEncode(gi.MaxUsers)
+	return m
+}
+
 // GroupInfos is a parsable slice of GroupInfo.
 type GroupInfos []*GroupInfo
 
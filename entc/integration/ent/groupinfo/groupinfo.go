@@ -28,6 +28,18 @@ const (
 	GroupsColumn = "group_info"
 )
 
+// Edges holds all the edge names of the GroupInfo type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeGroups,
+}
+
+// FingerprintFields holds the fields of the GroupInfo type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldDesc,
+	FieldMaxUsers,
+}
+
 // Columns holds all SQL columns for groupinfo fields.
 var Columns = []string{
 	FieldID,
@@ -35,6 +47,16 @@ var Columns = []string{
 	FieldMaxUsers,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// DefaultMaxUsers holds the default value on creation for the max_users field.
 	DefaultMaxUsers int
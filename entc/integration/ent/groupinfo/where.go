@@ -7,6 +7,8 @@
 package groupinfo
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
@@ -192,6 +194,13 @@ func DescContains(v string) predicate.GroupInfo {
 	})
 }
 
+// DescNotContains applies the NotContains predicate on the "desc" field.
+func DescNotContains(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldDesc), v))
+	})
+}
+
 // DescHasPrefix applies the HasPrefix predicate on the "desc" field.
 func DescHasPrefix(v string) predicate.GroupInfo {
 	return predicate.GroupInfo(func(s *sql.Selector) {
@@ -199,6 +208,13 @@ func DescHasPrefix(v string) predicate.GroupInfo {
 	})
 }
 
+// DescNotHasPrefix applies the NotHasPrefix predicate on the "desc" field.
+func DescNotHasPrefix(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldDesc), v))
+	})
+}
+
 // DescHasSuffix applies the HasSuffix predicate on the "desc" field.
 func DescHasSuffix(v string) predicate.GroupInfo {
 	return predicate.GroupInfo(func(s *sql.Selector) {
@@ -206,6 +222,13 @@ func DescHasSuffix(v string) predicate.GroupInfo {
 	})
 }
 
+// DescNotHasSuffix applies the NotHasSuffix predicate on the "desc" field.
+func DescNotHasSuffix(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldDesc), v))
+	})
+}
+
 // DescEqualFold applies the EqualFold predicate on the "desc" field.
 func DescEqualFold(v string) predicate.GroupInfo {
 	return predicate.GroupInfo(func(s *sql.Selector) {
@@ -220,6 +243,20 @@ func DescContainsFold(v string) predicate.GroupInfo {
 	})
 }
 
+// DescHasPrefixFold applies the HasPrefixFold predicate on the "desc" field.
+func DescHasPrefixFold(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldDesc), v))
+	})
+}
+
+// DescHasSuffixFold applies the HasSuffixFold predicate on the "desc" field.
+func DescHasSuffixFold(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldDesc), v))
+	})
+}
+
 // MaxUsersEQ applies the EQ predicate on the "max_users" field.
 func MaxUsersEQ(v int) predicate.GroupInfo {
 	return predicate.GroupInfo(func(s *sql.Selector) {
@@ -296,6 +333,64 @@ func MaxUsersLTE(v int) predicate.GroupInfo {
 	})
 }
 
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldDesc:     "string",
+	FieldID:       "numeric",
+	FieldMaxUsers: "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the GroupInfo.
+func FieldsEQ(f1, f2 string) predicate.GroupInfo {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the GroupInfo.
+func FieldsNEQ(f1, f2 string) predicate.GroupInfo {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the GroupInfo.
+func FieldsLT(f1, f2 string) predicate.GroupInfo {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the GroupInfo.
+func FieldsLTE(f1, f2 string) predicate.GroupInfo {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the GroupInfo.
+func FieldsGT(f1, f2 string) predicate.GroupInfo {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the GroupInfo.
+func FieldsGTE(f1, f2 string) predicate.GroupInfo {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the GroupInfo using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.GroupInfo {
+	return predicate.GroupInfo(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of GroupInfo", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of GroupInfo", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of GroupInfo are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasGroups applies the HasEdge predicate on the "groups" edge.
 func HasGroups() predicate.GroupInfo {
 	return predicate.GroupInfo(func(s *sql.Selector) {
@@ -331,6 +426,9 @@ func And(predicates ...predicate.GroupInfo) predicate.GroupInfo {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -345,6 +443,9 @@ func Or(predicates ...predicate.GroupInfo) predicate.GroupInfo {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -355,3 +456,37 @@ func Not(p predicate.GroupInfo) predicate.GroupInfo {
 		p(s.Not())
 	})
 }
+
+// GroupInfoFilter holds optional filters on the GroupInfo fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type GroupInfoFilter struct {
+	IDIn     []int
+	Desc     *string
+	MaxUsers *int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.GroupInfo.Query().
+//		Where(groupinfo.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *GroupInfoFilter) []predicate.GroupInfo {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.GroupInfo
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Desc != nil {
+		ps = append(ps, DescEQ(*f.Desc))
+	}
+	if f.MaxUsers != nil {
+		ps = append(ps, MaxUsersEQ(*f.MaxUsers))
+	}
+	return ps
+}
@@ -10,7 +10,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
@@ -61,12 +63,12 @@ func (gic *GroupInfoCreate) AddGroups(g ...*Group) *GroupInfoCreate {
 
 // Save creates the GroupInfo in the database.
 func (gic *GroupInfoCreate) Save(ctx context.Context) (*GroupInfo, error) {
-	if _, ok := gic.mutation.Desc(); !ok {
-		return nil, errors.New("ent: missing required field \"desc\"")
+	if err := checkTx(gic.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := gic.mutation.MaxUsers(); !ok {
-		v := groupinfo.DefaultMaxUsers
-		gic.mutation.SetMaxUsers(v)
+	gic.defaults()
+	if err := gic.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -103,17 +105,119 @@ func (gic *GroupInfoCreate) SaveX(ctx context.Context) *GroupInfo {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gic *GroupInfoCreate) Unwrap() *GroupInfoCreate {
+	tx, ok := gic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInfoCreate is not a transactional builder")
+	}
+	gic.config.driver = tx.drv
+	gic.mutation.driver = tx.drv
+	return gic
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (gic *GroupInfoCreate) DebugFields() map[string]Value {
+	return debugFields(gic.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (gic *GroupInfoCreate) defaults() {
+	if _, ok := gic.mutation.MaxUsers(); !ok {
+		v := groupinfo.DefaultMaxUsers
+		gic.mutation.SetMaxUsers(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gic *GroupInfoCreate) check() error {
+	if _, ok := gic.mutation.Desc(); !ok {
+		return &ValidationError{Name: "desc", err: errors.New("ent: missing required field \"desc\"")}
+	}
+	return nil
+}
+
 func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
-	var (
-		gi    = &GroupInfo{config: gic.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: groupinfo.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: groupinfo.FieldID,
-			},
+	ctx = gic.withOperation(ctx, "GroupInfo", "Create")
+	gi, _spec := gic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gic.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	gi.ID = int(id)
+	return gi, nil
+}
+
+// SaveID creates the GroupInfo in the database and returns only its id. Unlike Save,
+// the GroupInfo entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// GroupInfo that holds only its id as the value that is returned by the next mutator.
+func (gic *GroupInfoCreate) SaveID(ctx context.Context) (id int, err error) {
+	gic.defaults()
+	if err = gic.check(); err != nil {
+		return id, err
+	}
+	if len(gic.hooks) == 0 {
+		return gic.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*GroupInfoMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		gic.mutation = mutation
+		if id, err = gic.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &GroupInfo{config: gic.config, ID: id}, nil
+	})
+	for i := len(gic.hooks) - 1; i >= 0; i-- {
+		mut = gic.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, gic.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (gic *GroupInfoCreate) SaveIDX(ctx context.Context) int {
+	id, err := gic.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (gic *GroupInfoCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = gic.withOperation(ctx, "GroupInfo", "Create")
+	_spec := gic.idSpec()
+	if err = sqlgraph.CreateNode(ctx, gic.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (gic *GroupInfoCreate) createSpec() (*GroupInfo, *sqlgraph.CreateSpec) {
+	gi := &GroupInfo{config: gic.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: groupinfo.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: groupinfo.FieldID,
+		},
+	}
 	if value, ok := gic.mutation.Desc(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -149,13 +253,421 @@ func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, gic.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return gi, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (gic *GroupInfoCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: groupinfo.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: groupinfo.FieldID,
+		},
+	}
+	if value, ok := gic.mutation.Desc(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: groupinfo.FieldDesc,
+		})
+	}
+	if value, ok := gic.mutation.MaxUsers(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: groupinfo.FieldMaxUsers,
+		})
+	}
+	if nodes := gic.mutation.GroupsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   groupinfo.GroupsTable,
+			Columns: []string{groupinfo.GroupsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: group.FieldID,
+				},
+			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// GroupInfoCreateBulk is the builder for creating a bulk of GroupInfo entities.
+type GroupInfoCreateBulk struct {
+	config
+	builders []*GroupInfoCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the GroupInfo entities in the database.
+func (gicb *GroupInfoCreateBulk) Save(ctx context.Context) ([]*GroupInfo, error) {
+	nodes, err := gicb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && gicb.retries > 0 && isSQLDeadlockError(err) {
+		return gicb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gicb *GroupInfoCreateBulk) SaveX(ctx context.Context) []*GroupInfo {
+	v, err := gicb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (gicb *GroupInfoCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range gicb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (gicb *GroupInfoCreateBulk) CheckRefs() *GroupInfoCreateBulk {
+	gicb.refs = true
+	return gicb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (gicb *GroupInfoCreateBulk) RetryOnDeadlock(max int) *GroupInfoCreateBulk {
+	gicb.retries = max
+	return gicb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.GroupInfo.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.GroupInfo.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (gicb *GroupInfoCreateBulk) OnConflict(columns ...string) *GroupInfoUpsertBulk {
+	return &GroupInfoUpsertBulk{create: gicb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.GroupInfo.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (gic *GroupInfoCreate) OnConflict(target ...sql.ConflictOption) *GroupInfoUpsertBulk {
+	bulk := &GroupInfoCreateBulk{config: gic.config, builders: []*GroupInfoCreate{gic}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (gicb *GroupInfoCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*GroupInfo, error) {
+	ctx = gicb.withOperation(ctx, "GroupInfo", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(gicb.builders))
+		nodes    = make([]*GroupInfo, len(gicb.builders))
+		mutators = make([]Mutator, len(gicb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range gicb.builders {
+		func(i int, root context.Context) {
+			builder := gicb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupInfoMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gicb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if gicb.refs {
+						if err := gicb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, gicb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gicb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (gicb *GroupInfoCreateBulk) retry(ctx context.Context, err error) ([]*GroupInfo, error) {
+	if _, ok := gicb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	gi.ID = int(id)
-	return gi, nil
+	builders := gicb.builders
+	defer func() { gicb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return gicb.less(builders[idx[i]], builders[idx[j]])
+	})
+	gicb.builders = make([]*GroupInfoCreate, len(idx))
+	for i, j := range idx {
+		gicb.builders[i] = builders[j]
+	}
+	for n := 0; n < gicb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*GroupInfo
+		if sorted, err = gicb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*GroupInfo, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (gicb *GroupInfoCreateBulk) less(a, b *GroupInfoCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (gicb *GroupInfoCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range gicb.builders {
+			for _, id := range builder.mutation.GroupsIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, gicb.driver, group.Table, group.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[groupinfo.EdgeGroups] = append(missing[groupinfo.EdgeGroups], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "GroupInfo", Missing: missing}
+	}
+	return nil
+}
+
+// GroupInfoUpsertBulk is the builder for the conflict handling of a bulk of GroupInfo entities.
+type GroupInfoUpsertBulk struct {
+	create  *GroupInfoCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (giub *GroupInfoUpsertBulk) Target(target ...sql.ConflictOption) *GroupInfoUpsertBulk {
+	giub.target = append(giub.target, target...)
+	return giub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (giub *GroupInfoUpsertBulk) DoNothing() *GroupInfoUpsertBulk {
+	giub.nothing = true
+	return giub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (giub *GroupInfoUpsertBulk) UpdateNewValues() *GroupInfoUpsertBulk {
+	giub.update = true
+	return giub
+}
+
+// Save creates the GroupInfo entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (giub *GroupInfoUpsertBulk) Save(ctx context.Context) ([]*GroupInfo, int, error) {
+	switch {
+	case !giub.nothing && !giub.update:
+		return nil, 0, errors.New("ent: missing conflict action for GroupInfo bulk insert")
+	case giub.nothing && giub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for GroupInfo bulk insert")
+	case giub.update:
+		if len(giub.columns) == 0 && len(giub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for GroupInfo bulk upsert")
+		}
+		nodes, err := giub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(giub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(groupinfo.FieldID),
+			),
+			Columns:         groupinfo.Columns,
+			ConflictColumns: giub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(giub.conflictTarget(), sql.DoNothing())}
+	nodes, err := giub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*GroupInfo, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (giub *GroupInfoUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(giub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(giub.columns...))
+	}
+	return append(opts, giub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (giub *GroupInfoUpsertBulk) SaveX(ctx context.Context) ([]*GroupInfo, int) {
+	nodes, skipped, err := giub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (giub *GroupInfoUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := giub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (giub *GroupInfoUpsertBulk) ExecX(ctx context.Context) {
+	if err := giub.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
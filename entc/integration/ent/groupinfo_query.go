@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -31,6 +34,18 @@ type GroupInfoQuery struct {
 	predicates []predicate.GroupInfo
 	// eager-loading edges.
 	withGroups *GroupQuery
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see GroupInfoSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -42,6 +57,19 @@ func (giq *GroupInfoQuery) Where(ps ...predicate.GroupInfo) *GroupInfoQuery {
 	return giq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (giq *GroupInfoQuery) Predicates() []predicate.GroupInfo {
+	return append([]predicate.GroupInfo{}, giq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (giq *GroupInfoQuery) SetPredicates(ps ...predicate.GroupInfo) *GroupInfoQuery {
+	giq.predicates = append([]predicate.GroupInfo{}, ps...)
+	return giq
+}
+
 // Limit adds a limit step to the query.
 func (giq *GroupInfoQuery) Limit(limit int) *GroupInfoQuery {
 	giq.limit = &limit
@@ -310,11 +338,15 @@ func (giq *GroupInfoQuery) GroupBy(field string, fields ...string) *GroupInfoGro
 func (giq *GroupInfoQuery) Select(field string, fields ...string) *GroupInfoSelect {
 	selector := &GroupInfoSelect{config: giq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = giq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := giq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return giq.sqlQuery(), nil
+		if err := giq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return giq.sqlQuery().AppendSelectExpr(giq.exprs...), nil
 	}
 	return selector
 }
@@ -331,6 +363,15 @@ func (giq *GroupInfoQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
+	ctx = giq.withOperation(ctx, "GroupInfo", "Query")
+	if err := giq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range giq.fields {
+		if !groupinfo.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*GroupInfo{}
 		_spec       = giq.querySpec()
@@ -338,10 +379,15 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 			giq.withGroups != nil,
 		}
 	)
+	_spec.Exprs = giq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &GroupInfo{config: giq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
+		values := node.scanValues(columns)
+		for range giq.exprs {
+			values = append(values, new(interface{}))
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -349,8 +395,16 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(giq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range giq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, giq.driver, _spec); err != nil {
 		return nil, err
@@ -360,6 +414,9 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 	}
 
 	if query := giq.withGroups; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*GroupInfo)
 		for i := range nodes {
@@ -367,30 +424,73 @@ func (giq *GroupInfoQuery) sqlAll(ctx context.Context) ([]*GroupInfo, error) {
 			nodeids[nodes[i].ID] = nodes[i]
 		}
 		query.withFKs = true
-		query.Where(predicate.Group(func(s *sql.Selector) {
-			s.Where(sql.InValues(groupinfo.GroupsColumn, fks...))
-		}))
-		neighbors, err := query.All(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range neighbors {
-			fk := n.group_info
-			if fk == nil {
-				return nil, fmt.Errorf(`foreign-key "group_info" is nil for node %v`, n.ID)
+		// The limit and the offset of the query are applied per node.
+		query.partition = groupinfo.GroupsColumn
+		err := giq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Group(func(s *sql.Selector) {
+				s.Where(sql.InValues(groupinfo.GroupsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			node, ok := nodeids[*fk]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "group_info" returned %v for node %v`, *fk, n.ID)
+			for _, n := range neighbors {
+				fk := n.group_info
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "group_info" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "group_info" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Groups = append(node.Edges.Groups, n)
 			}
-			node.Edges.Groups = append(node.Edges.Groups, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the GroupInfo entities (see GroupInfo.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.GroupInfo.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the GroupInfo table.
+func (giq *GroupInfoQuery) SelectExpr(exprs ...*sql.SelectExpr) *GroupInfoQuery {
+	giq.exprs = append(giq.exprs, exprs...)
+	return giq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (giq *GroupInfoQuery) checkExprs() error {
+	if len(giq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range groupinfo.Columns {
+		columns[c] = true
+	}
+	for _, e := range giq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of GroupInfo", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (giq *GroupInfoQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = giq.withOperation(ctx, "GroupInfo", "Count")
 	_spec := giq.querySpec()
 	return sqlgraph.CountNodes(ctx, giq.driver, _spec)
 }
@@ -416,6 +516,17 @@ func (giq *GroupInfoQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   giq.sql,
 		Unique: true,
 	}
+	if fields := giq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, groupinfo.FieldID)
+		for i := range fields {
+			if fields[i] != groupinfo.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = giq.forUpdate
+	_spec.PartitionBy = giq.partition
 	if ps := giq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -464,11 +575,419 @@ func (giq *GroupInfoQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the GroupInfo entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (giq *GroupInfoQuery) IDsQuery() *sql.Selector {
+	selector := giq.sqlQuery()
+	if giq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(groupinfo.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the GroupInfo entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.GroupInfo.Query().
+//		Where(...).
+//		CountDistinct(ctx, groupinfo.FieldDesc)
+//
+func (giq *GroupInfoQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := giq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = giq.withOperation(ctx, "GroupInfo", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, giq.driver, giq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (giq *GroupInfoQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := giq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched GroupInfo rows as generic maps that are keyed by
+// column name (see groupinfo.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (giq *GroupInfoQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := giq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = giq.withOperation(ctx, "GroupInfo", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = giq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&GroupInfo{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, giq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (giq *GroupInfoQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := giq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of GroupInfo entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (giq *GroupInfoQuery) Prefetch(n int) *GroupInfoQuery {
+	giq.prefetch = n
+	return giq
+}
+
+// ForUpdate locks the selected GroupInfo rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (giq *GroupInfoQuery) ForUpdate() *GroupInfoQuery {
+	giq.forUpdate = true
+	return giq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (giq *GroupInfoQuery) Primary() *GroupInfoQuery {
+	return giq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (giq *GroupInfoQuery) ReadConsistency(c sql.ReadConsistency) *GroupInfoQuery {
+	giq.consistency = c
+	return giq
+}
+
+// Stream executes the query in batches, and sends the matched GroupInfo entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.GroupInfo.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (giq *GroupInfoQuery) Stream(ctx context.Context) (<-chan *GroupInfo, <-chan error) {
+	size := giq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *GroupInfo, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := giq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the GroupInfo entities in batches of the given
+// size, and sends them to the given channel.
+func (giq *GroupInfoQuery) stream(ctx context.Context, size int, nodes chan<- *GroupInfo) error {
+	if giq.offset != nil || len(giq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if giq.limit != nil {
+		left = *giq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *giq
+		query.predicates = giq.predicates[:len(giq.predicates):len(giq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, groupinfo.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(groupinfo.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// GroupInfoEdge is the edge representation of GroupInfo in a connection.
+type GroupInfoEdge struct {
+	Node   *GroupInfo `json:"node"`
+	Cursor Cursor     `json:"cursor"`
+}
+
+// GroupInfoConnection is the connection of GroupInfo entities that is returned by Paginate.
+type GroupInfoConnection struct {
+	Edges      []GroupInfoEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.GroupInfo.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: groupinfo.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (giq *GroupInfoQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*GroupInfoConnection, error) {
+	if giq.limit != nil || giq.offset != nil || len(giq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case groupinfo.FieldDesc, groupinfo.FieldMaxUsers:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for GroupInfo", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := giq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: groupinfo.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *giq
+	query.predicates = giq.predicates[:len(giq.predicates):len(giq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := giq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &GroupInfoConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]GroupInfoEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = GroupInfoEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (giq *GroupInfoQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.GroupInfo, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := giq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.GroupInfo(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*GroupInfoQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case groupinfo.FieldDesc:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field desc: %v", err)
+		}
+		return v, nil
+	case groupinfo.FieldMaxUsers:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field max_users: %v", err)
+		}
+		return v, nil
+	case groupinfo.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for GroupInfo", field)
+}
+
+// pageCursor returns the cursor of the GroupInfo node for the given order fields.
+func (gi *GroupInfo) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(gi.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case groupinfo.FieldDesc:
+			v = gi.Desc
+		case groupinfo.FieldMaxUsers:
+			v = gi.MaxUsers
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for GroupInfo", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
 // GroupInfoGroupBy is the builder for group-by GroupInfo entities.
 type GroupInfoGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -581,9 +1100,28 @@ func (gigb *GroupInfoGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.GroupInfo.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (gigb *GroupInfoGroupBy) Having(ps ...*sql.Predicate) *GroupInfoGroupBy {
+	gigb.havings = append(gigb.havings, ps...)
+	return gigb
+}
+
 func (gigb *GroupInfoGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = gigb.withOperation(ctx, "GroupInfo", "GroupBy")
+	selector := gigb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := gigb.sqlQuery().Query()
 	if err := gigb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -596,15 +1134,30 @@ func (gigb *GroupInfoGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(gigb.fields)+len(gigb.fns))
 	columns = append(columns, gigb.fields...)
 	for _, fn := range gigb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(gigb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(gigb.fields...)
+	switch len(gigb.havings) {
+	case 0:
+	case 1:
+		selector.Having(gigb.havings[0])
+	default:
+		selector.Having(sql.And(gigb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(gigb.fields...)
+	return selector
 }
 
 // GroupInfoSelect is the builder for select fields of GroupInfo entities.
 type GroupInfoSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *GroupInfoQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -711,7 +1264,52 @@ func (gis *GroupInfoSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (gis *GroupInfoSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(gis.fields) > 1 {
+		return nil, errors.New("ent: GroupInfoSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := gis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (gis *GroupInfoSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := gis.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the GroupInfo entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.GroupInfo.Query().
+//		Select(groupinfo.FieldDesc).
+//		All(ctx)
+//
+func (gis *GroupInfoSelect) All(ctx context.Context) ([]*GroupInfo, error) {
+	query := *gis.query
+	query.fields = gis.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gis *GroupInfoSelect) AllX(ctx context.Context) []*GroupInfo {
+	nodes, err := gis.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (gis *GroupInfoSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = gis.withOperation(ctx, "GroupInfo", "Select")
 	rows := &sql.Rows{}
 	query, args := gis.sqlQuery().Query()
 	if err := gis.driver.Query(ctx, query, args, rows); err != nil {
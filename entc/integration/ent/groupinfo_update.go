@@ -24,6 +24,9 @@ type GroupInfoUpdate struct {
 	hooks      []Hook
 	mutation   *GroupInfoMutation
 	predicates []predicate.GroupInfo
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,12 +35,26 @@ func (giu *GroupInfoUpdate) Where(ps ...predicate.GroupInfo) *GroupInfoUpdate {
 	return giu
 }
 
+// Limit limits the number of groupinfos that are updated by the builder. For example,
+// for updating large amounts of groupinfos in small batches without locking the whole table.
+func (giu *GroupInfoUpdate) Limit(limit int) *GroupInfoUpdate {
+	giu.limit = &limit
+	return giu
+}
+
 // SetDesc sets the desc field.
 func (giu *GroupInfoUpdate) SetDesc(s string) *GroupInfoUpdate {
 	giu.mutation.SetDesc(s)
 	return giu
 }
 
+// UnsetDesc removes the changes of the desc field from the builder (e.g. a previous call
+// to SetDesc), and therefore, the field is left unchanged in the database.
+func (giu *GroupInfoUpdate) UnsetDesc() *GroupInfoUpdate {
+	giu.mutation.ResetDesc()
+	return giu
+}
+
 // SetMaxUsers sets the max_users field.
 func (giu *GroupInfoUpdate) SetMaxUsers(i int) *GroupInfoUpdate {
 	giu.mutation.ResetMaxUsers()
@@ -59,6 +76,13 @@ func (giu *GroupInfoUpdate) AddMaxUsers(i int) *GroupInfoUpdate {
 	return giu
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database.
+func (giu *GroupInfoUpdate) UnsetMaxUsers() *GroupInfoUpdate {
+	giu.mutation.ResetMaxUsers()
+	return giu
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (giu *GroupInfoUpdate) AddGroupIDs(ids ...int) *GroupInfoUpdate {
 	giu.mutation.AddGroupIDs(ids...)
@@ -89,9 +113,33 @@ func (giu *GroupInfoUpdate) RemoveGroups(g ...*Group) *GroupInfoUpdate {
 	return giu.RemoveGroupIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (giu *GroupInfoUpdate) Unwrap() *GroupInfoUpdate {
+	tx, ok := giu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInfoUpdate is not a transactional builder")
+	}
+	giu.config.driver = tx.drv
+	giu.mutation.driver = tx.drv
+	return giu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (giu *GroupInfoUpdate) DebugFields() map[string]Value {
+	return debugFields(giu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (giu *GroupInfoUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(giu.driver); err != nil {
+		return 0, err
+	}
+	if err := giu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -140,7 +188,13 @@ func (giu *GroupInfoUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (giu *GroupInfoUpdate) check() error {
+	return nil
+}
+
 func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = giu.withOperation(ctx, "GroupInfo", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   groupinfo.Table,
@@ -158,6 +212,12 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := giu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if giu.ids != nil {
+		_spec.ScanIDs = giu.ids
+	}
 	if value, ok := giu.mutation.Desc(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -217,7 +277,7 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, giu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, giu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{groupinfo.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -228,11 +288,33 @@ func (giu *GroupInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the GroupInfo entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (giu *GroupInfoUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	giu.ids = &ids
+	defer func() { giu.ids = nil }()
+	if _, err := giu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // GroupInfoUpdateOne is the builder for updating a single GroupInfo entity.
 type GroupInfoUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *GroupInfoMutation
+	hooks      []Hook
+	mutation   *GroupInfoMutation
+	predicates []predicate.GroupInfo
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (giuo *GroupInfoUpdateOne) Where(ps ...predicate.GroupInfo) *GroupInfoUpdateOne {
+	giuo.predicates = append(giuo.predicates, ps...)
+	return giuo
 }
 
 // SetDesc sets the desc field.
@@ -241,6 +323,13 @@ func (giuo *GroupInfoUpdateOne) SetDesc(s string) *GroupInfoUpdateOne {
 	return giuo
 }
 
+// UnsetDesc removes the changes of the desc field from the builder (e.g. a previous call
+// to SetDesc), and therefore, the field is left unchanged in the database.
+func (giuo *GroupInfoUpdateOne) UnsetDesc() *GroupInfoUpdateOne {
+	giuo.mutation.ResetDesc()
+	return giuo
+}
+
 // SetMaxUsers sets the max_users field.
 func (giuo *GroupInfoUpdateOne) SetMaxUsers(i int) *GroupInfoUpdateOne {
 	giuo.mutation.ResetMaxUsers()
@@ -262,6 +351,13 @@ func (giuo *GroupInfoUpdateOne) AddMaxUsers(i int) *GroupInfoUpdateOne {
 	return giuo
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database.
+func (giuo *GroupInfoUpdateOne) UnsetMaxUsers() *GroupInfoUpdateOne {
+	giuo.mutation.ResetMaxUsers()
+	return giuo
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (giuo *GroupInfoUpdateOne) AddGroupIDs(ids ...int) *GroupInfoUpdateOne {
 	giuo.mutation.AddGroupIDs(ids...)
@@ -292,9 +388,33 @@ func (giuo *GroupInfoUpdateOne) RemoveGroups(g ...*Group) *GroupInfoUpdateOne {
 	return giuo.RemoveGroupIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (giuo *GroupInfoUpdateOne) Unwrap() *GroupInfoUpdateOne {
+	tx, ok := giuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInfoUpdateOne is not a transactional builder")
+	}
+	giuo.config.driver = tx.drv
+	giuo.mutation.driver = tx.drv
+	return giuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (giuo *GroupInfoUpdateOne) DebugFields() map[string]Value {
+	return debugFields(giuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (giuo *GroupInfoUpdateOne) Save(ctx context.Context) (*GroupInfo, error) {
-
+	if err := checkTx(giuo.driver); err != nil {
+		return nil, err
+	}
+	if err := giuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *GroupInfo
@@ -343,7 +463,13 @@ func (giuo *GroupInfoUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (giuo *GroupInfoUpdateOne) check() error {
+	return nil
+}
+
 func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err error) {
+	ctx = giuo.withOperation(ctx, "GroupInfo", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   groupinfo.Table,
@@ -359,6 +485,13 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err
 		return nil, fmt.Errorf("missing GroupInfo.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := giuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := giuo.mutation.Desc(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -419,9 +552,11 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	gi = &GroupInfo{config: giuo.config}
-	_spec.Assign = gi.assignValues
-	_spec.ScanValues = gi.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, giuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return gi.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = gi.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, giuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{groupinfo.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -429,5 +564,16 @@ func (giuo *GroupInfoUpdateOne) sqlSave(ctx context.Context) (gi *GroupInfo, err
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		gi.ID = id
+		if value, ok := giuo.mutation.Desc(); ok {
+			gi.Desc = value
+		}
+		if value, ok := giuo.mutation.MaxUsers(); ok {
+			gi.MaxUsers = value
+		}
+	}
 	return gi, nil
 }
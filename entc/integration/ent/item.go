@@ -7,8 +7,13 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/item"
@@ -16,30 +21,102 @@ import (
 
 // Item is the model entity for the Item schema.
 type Item struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Item) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // id
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Item type are scanned as generic values.
+func (*Item) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case item.FieldID:
+			values[i] = &sql.NullInt64{}
+		case item.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		case item.FieldPriority:
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Item fields.
-func (i *Item) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(item.Columns); m < n {
+// to the Item fields. Values of columns that were not selected are left untouched.
+func (i *Item) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
+	for j := range columns {
+		switch columns[j] {
+		case item.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case item.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		case item.FieldPriority:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[j])
+			} else if value.Valid {
+				i.Priority = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (i *Item) SelectValue(alias string) (Value, error) {
+	v, ok := i.selectValues[alias]
 	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
+// DumpGraph returns the JSON encoding of the Item and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Item alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Item itself is not modified.
+//
+//	b, err := i.DumpGraph(ctx, client, 2)
+//
+func (i *Item) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Item DumpGraph", depth)
+	}
+	dumped := *i
+	if err := dumpItems(ctx, client, []*Item{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
 	}
-	i.ID = int(value.Int64)
-	values = values[1:]
+	return json.Marshal(&dumped)
+}
+
+// dumpItems loads the edges of the given Item copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpItems(ctx context.Context, client *Client, nodes []*Item, depth int, dumper *graphDump) error {
 	return nil
 }
 
@@ -61,15 +138,61 @@ func (i *Item) Unwrap() *Item {
 	return i
 }
 
+// Clone returns a deep copy of the Item and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (i *Item) Clone() *Item {
+	return i.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Item and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (i *Item) clone(seen map[interface{}]interface{}) *Item {
+	if i == nil {
+		return nil
+	}
+	if v, ok := seen[i]; ok {
+		return v.(*Item)
+	}
+	_c := *i
+	seen[i] = &_c
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (i *Item) String() string {
 	var builder strings.Builder
 	builder.WriteString("Item(")
 	builder.WriteString(fmt.Sprintf("id=%v", i.ID))
+	builder.WriteString(", created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", i.Priority))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Item, for change detection and cache keys.
+// It covers the fields that are listed in item.FingerprintFields, and it does not depend on the
+// ID of the Item, on its edges, or on the order of the fields in the schema.
+func (i *Item) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "created_at", i.CreatedAt)
+	fingerprint(h, "priority", i.Priority)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Item for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Item can be decoded using the FromMap method of its client.
+func (i *Item) ToMap() map[string]string {
+	m := make(map[string]string, 3)
+	m[item.FieldID] = kvEncode(i.ID)
+	m[item.FieldCreatedAt] = kvEncode(i.CreatedAt)
+	m[item.FieldPriority] = kvEncode(i.Priority)
+	return m
+}
+
 // Items is a parsable slice of Item.
 type Items []*Item
 
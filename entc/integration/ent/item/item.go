@@ -6,17 +6,48 @@
 
 package item
 
+import (
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
 const (
 	// Label holds the string label denoting the item type in the database.
 	Label = "item"
 	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	FieldID        = "id"         // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt = "created_at" // FieldPriority holds the string denoting the priority vertex property in the database.
+	FieldPriority  = "priority"
 
 	// Table holds the table name of the item in the database.
 	Table = "items"
 )
 
+// FingerprintFields holds the fields of the Item type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldCreatedAt,
+	FieldPriority,
+}
+
 // Columns holds all SQL columns for item fields.
 var Columns = []string{
 	FieldID,
+	FieldCreatedAt,
+	FieldPriority,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// CreatedAtTrunc returns a column that truncates the created_at field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "created_at_<bucket>".
+func CreatedAtTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldCreatedAt)
 }
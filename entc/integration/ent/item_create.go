@@ -8,8 +8,12 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"time"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/item"
 	"github.com/facebookincubator/ent/schema/field"
@@ -22,8 +26,27 @@ type ItemCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the created_at field.
+func (ic *ItemCreate) SetCreatedAt(t time.Time) *ItemCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetPriority sets the priority field.
+func (ic *ItemCreate) SetPriority(i int) *ItemCreate {
+	ic.mutation.SetPriority(i)
+	return ic
+}
+
 // Save creates the Item in the database.
 func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
+	if err := checkTx(ic.driver); err != nil {
+		return nil, err
+	}
+	ic.defaults()
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Item
@@ -59,24 +82,507 @@ func (ic *ItemCreate) SaveX(ctx context.Context) *Item {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ic *ItemCreate) Unwrap() *ItemCreate {
+	tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemCreate is not a transactional builder")
+	}
+	ic.config.driver = tx.drv
+	ic.mutation.driver = tx.drv
+	return ic
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (ic *ItemCreate) DebugFields() map[string]Value {
+	return debugFields(ic.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *ItemCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *ItemCreate) check() error {
+	return nil
+}
+
 func (ic *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
-	var (
-		i     = &Item{config: ic.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: item.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: item.FieldID,
-			},
+	ctx = ic.withOperation(ctx, "Item", "Create")
+	i, _spec := ic.createSpec()
+	// Read back the row from the database if one of the fields
+	// with a default expression was not set on creation.
+	if ic.mutation.created_at == nil || ic.mutation.priority == nil {
+		_spec.Columns = item.Columns
+		_spec.ScanValues = func() []interface{} {
+			return i.scanValues(_spec.Columns)
 		}
-	)
-	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		_spec.Assign = func(values ...interface{}) error {
+			return i.assignValues(_spec.Columns, values)
+		}
+	}
+	if err := sqlgraph.CreateNode(ctx, ic.mutationDriver(ctx), _spec); err != nil {
 		if cerr, ok := isSQLConstraintError(err); ok {
 			err = cerr
 		}
 		return nil, err
 	}
+
 	id := _spec.ID.Value.(int64)
 	i.ID = int(id)
 	return i, nil
 }
+
+// SaveID creates the Item in the database and returns only its id. Unlike Save,
+// the Item entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Item that holds only its id as the value that is returned by the next mutator.
+func (ic *ItemCreate) SaveID(ctx context.Context) (id int, err error) {
+	ic.defaults()
+	if err = ic.check(); err != nil {
+		return id, err
+	}
+	if len(ic.hooks) == 0 {
+		return ic.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*ItemMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		ic.mutation = mutation
+		if id, err = ic.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Item{config: ic.config, ID: id}, nil
+	})
+	for i := len(ic.hooks) - 1; i >= 0; i-- {
+		mut = ic.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, ic.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (ic *ItemCreate) SaveIDX(ctx context.Context) int {
+	id, err := ic.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (ic *ItemCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = ic.withOperation(ctx, "Item", "Create")
+	_spec := ic.idSpec()
+	if err = sqlgraph.CreateNode(ctx, ic.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (ic *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
+	i := &Item{config: ic.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: item.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: item.FieldID,
+		},
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: item.FieldCreatedAt,
+		})
+		i.CreatedAt = value
+	}
+	if value, ok := ic.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: item.FieldPriority,
+		})
+		i.Priority = value
+	}
+	return i, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (ic *ItemCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: item.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: item.FieldID,
+		},
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: item.FieldCreatedAt,
+		})
+	}
+	if value, ok := ic.mutation.Priority(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: item.FieldPriority,
+		})
+	}
+	return _spec
+}
+
+// ItemCreateBulk is the builder for creating a bulk of Item entities.
+type ItemCreateBulk struct {
+	config
+	builders []*ItemCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Item entities in the database.
+func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
+	nodes, err := icb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && icb.retries > 0 && isSQLDeadlockError(err) {
+		return icb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *ItemCreateBulk) SaveX(ctx context.Context) []*Item {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (icb *ItemCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range icb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (icb *ItemCreateBulk) CheckRefs() *ItemCreateBulk {
+	icb.refs = true
+	return icb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (icb *ItemCreateBulk) RetryOnDeadlock(max int) *ItemCreateBulk {
+	icb.retries = max
+	return icb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Item.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Item.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (icb *ItemCreateBulk) OnConflict(columns ...string) *ItemUpsertBulk {
+	return &ItemUpsertBulk{create: icb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Item.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (ic *ItemCreate) OnConflict(target ...sql.ConflictOption) *ItemUpsertBulk {
+	bulk := &ItemCreateBulk{config: ic.config, builders: []*ItemCreate{ic}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (icb *ItemCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Item, error) {
+	ctx = icb.withOperation(ctx, "Item", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(icb.builders))
+		nodes    = make([]*Item, len(icb.builders))
+		mutators = make([]Mutator, len(icb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if icb.refs {
+						if err := icb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, icb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (icb *ItemCreateBulk) retry(ctx context.Context, err error) ([]*Item, error) {
+	if _, ok := icb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := icb.builders
+	defer func() { icb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return icb.less(builders[idx[i]], builders[idx[j]])
+	})
+	icb.builders = make([]*ItemCreate, len(idx))
+	for i, j := range idx {
+		icb.builders[i] = builders[j]
+	}
+	for n := 0; n < icb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Item
+		if sorted, err = icb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Item, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (icb *ItemCreateBulk) less(a, b *ItemCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (icb *ItemCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Item", Missing: missing}
+	}
+	return nil
+}
+
+// ItemUpsertBulk is the builder for the conflict handling of a bulk of Item entities.
+type ItemUpsertBulk struct {
+	create  *ItemCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (iub *ItemUpsertBulk) Target(target ...sql.ConflictOption) *ItemUpsertBulk {
+	iub.target = append(iub.target, target...)
+	return iub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (iub *ItemUpsertBulk) DoNothing() *ItemUpsertBulk {
+	iub.nothing = true
+	return iub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (iub *ItemUpsertBulk) UpdateNewValues() *ItemUpsertBulk {
+	iub.update = true
+	return iub
+}
+
+// Save creates the Item entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (iub *ItemUpsertBulk) Save(ctx context.Context) ([]*Item, int, error) {
+	switch {
+	case !iub.nothing && !iub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Item bulk insert")
+	case iub.nothing && iub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Item bulk insert")
+	case iub.update:
+		if len(iub.columns) == 0 && len(iub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Item bulk upsert")
+		}
+		nodes, err := iub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(iub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(item.FieldID),
+			),
+			Columns:         item.Columns,
+			ConflictColumns: iub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(iub.conflictTarget(), sql.DoNothing())}
+	nodes, err := iub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Item, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (iub *ItemUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(iub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(iub.columns...))
+	}
+	return append(opts, iub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iub *ItemUpsertBulk) SaveX(ctx context.Context) ([]*Item, int) {
+	nodes, skipped, err := iub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (iub *ItemUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := iub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iub *ItemUpsertBulk) ExecX(ctx context.Context) {
+	if err := iub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
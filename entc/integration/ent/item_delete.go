@@ -23,6 +23,7 @@ type ItemDelete struct {
 	hooks      []Hook
 	mutation   *ItemMutation
 	predicates []predicate.Item
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (id *ItemDelete) Where(ps ...predicate.Item) *ItemDelete {
 	return id
 }
 
+// Limit limits the number of items that are deleted by the builder. For example,
+// for deleting large amounts of items in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (id *ItemDelete) Limit(limit int) *ItemDelete {
+	id.limit = &limit
+	return id
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (id *ItemDelete) Unwrap() *ItemDelete {
+	tx, ok := id.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemDelete is not a transactional builder")
+	}
+	id.config.driver = tx.drv
+	id.mutation.driver = tx.drv
+	return id
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (id *ItemDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(id.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (id *ItemDelete) ExecX(ctx context.Context) int {
 }
 
 func (id *ItemDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = id.withOperation(ctx, "Item", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: item.Table,
@@ -85,7 +111,10 @@ func (id *ItemDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, id.driver, _spec)
+	if limit := id.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, id.mutationDriver(ctx), _spec)
 }
 
 // ItemDeleteOne is the builder for deleting a single Item entity.
@@ -99,7 +128,7 @@ func (ido *ItemDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{item.Label}
 	default:
 		return nil
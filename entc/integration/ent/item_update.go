@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -23,6 +24,9 @@ type ItemUpdate struct {
 	hooks      []Hook
 	mutation   *ItemMutation
 	predicates []predicate.Item
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -31,8 +35,73 @@ func (iu *ItemUpdate) Where(ps ...predicate.Item) *ItemUpdate {
 	return iu
 }
 
+// Limit limits the number of items that are updated by the builder. For example,
+// for updating large amounts of items in small batches without locking the whole table.
+func (iu *ItemUpdate) Limit(limit int) *ItemUpdate {
+	iu.limit = &limit
+	return iu
+}
+
+// SetCreatedAt sets the created_at field.
+func (iu *ItemUpdate) SetCreatedAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetCreatedAt(t)
+	return iu
+}
+
+// UnsetCreatedAt removes the changes of the created_at field from the builder (e.g. a previous call
+// to SetCreatedAt), and therefore, the field is left unchanged in the database.
+func (iu *ItemUpdate) UnsetCreatedAt() *ItemUpdate {
+	iu.mutation.ResetCreatedAt()
+	return iu
+}
+
+// SetPriority sets the priority field.
+func (iu *ItemUpdate) SetPriority(i int) *ItemUpdate {
+	iu.mutation.ResetPriority()
+	iu.mutation.SetPriority(i)
+	return iu
+}
+
+// AddPriority adds i to priority.
+func (iu *ItemUpdate) AddPriority(i int) *ItemUpdate {
+	iu.mutation.AddPriority(i)
+	return iu
+}
+
+// UnsetPriority removes the changes of the priority field from the builder (e.g. a previous call
+// to SetPriority), and therefore, the field is left unchanged in the database.
+func (iu *ItemUpdate) UnsetPriority() *ItemUpdate {
+	iu.mutation.ResetPriority()
+	return iu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (iu *ItemUpdate) Unwrap() *ItemUpdate {
+	tx, ok := iu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemUpdate is not a transactional builder")
+	}
+	iu.config.driver = tx.drv
+	iu.mutation.driver = tx.drv
+	return iu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (iu *ItemUpdate) DebugFields() map[string]Value {
+	return debugFields(iu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(iu.driver); err != nil {
+		return 0, err
+	}
+	if err := iu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -81,7 +150,13 @@ func (iu *ItemUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (iu *ItemUpdate) check() error {
+	return nil
+}
+
 func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = iu.withOperation(ctx, "Item", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   item.Table,
@@ -99,7 +174,34 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+	if limit := iu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if iu.ids != nil {
+		_spec.ScanIDs = iu.ids
+	}
+	if value, ok := iu.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: item.FieldCreatedAt,
+		})
+	}
+	if value, ok := iu.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: item.FieldPriority,
+		})
+	}
+	if value, ok := iu.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: item.FieldPriority,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{item.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -110,15 +212,95 @@ func (iu *ItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Item entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (iu *ItemUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	iu.ids = &ids
+	defer func() { iu.ids = nil }()
+	if _, err := iu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // ItemUpdateOne is the builder for updating a single Item entity.
 type ItemUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *ItemMutation
+	hooks      []Hook
+	mutation   *ItemMutation
+	predicates []predicate.Item
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
+	iuo.predicates = append(iuo.predicates, ps...)
+	return iuo
+}
+
+// SetCreatedAt sets the created_at field.
+func (iuo *ItemUpdateOne) SetCreatedAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetCreatedAt(t)
+	return iuo
+}
+
+// UnsetCreatedAt removes the changes of the created_at field from the builder (e.g. a previous call
+// to SetCreatedAt), and therefore, the field is left unchanged in the database.
+func (iuo *ItemUpdateOne) UnsetCreatedAt() *ItemUpdateOne {
+	iuo.mutation.ResetCreatedAt()
+	return iuo
+}
+
+// SetPriority sets the priority field.
+func (iuo *ItemUpdateOne) SetPriority(i int) *ItemUpdateOne {
+	iuo.mutation.ResetPriority()
+	iuo.mutation.SetPriority(i)
+	return iuo
+}
+
+// AddPriority adds i to priority.
+func (iuo *ItemUpdateOne) AddPriority(i int) *ItemUpdateOne {
+	iuo.mutation.AddPriority(i)
+	return iuo
+}
+
+// UnsetPriority removes the changes of the priority field from the builder (e.g. a previous call
+// to SetPriority), and therefore, the field is left unchanged in the database.
+func (iuo *ItemUpdateOne) UnsetPriority() *ItemUpdateOne {
+	iuo.mutation.ResetPriority()
+	return iuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (iuo *ItemUpdateOne) Unwrap() *ItemUpdateOne {
+	tx, ok := iuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemUpdateOne is not a transactional builder")
+	}
+	iuo.config.driver = tx.drv
+	iuo.mutation.driver = tx.drv
+	return iuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (iuo *ItemUpdateOne) DebugFields() map[string]Value {
+	return debugFields(iuo.mutation)
 }
 
 // Save executes the query and returns the updated entity.
 func (iuo *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
+	if err := checkTx(iuo.driver); err != nil {
+		return nil, err
+	}
+	if err := iuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Item
@@ -167,7 +349,13 @@ func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (iuo *ItemUpdateOne) check() error {
+	return nil
+}
+
 func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (i *Item, err error) {
+	ctx = iuo.withOperation(ctx, "Item", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   item.Table,
@@ -183,10 +371,40 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (i *Item, err error) {
 		return nil, fmt.Errorf("missing Item.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := iuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.CreatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: item.FieldCreatedAt,
+		})
+	}
+	if value, ok := iuo.mutation.Priority(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: item.FieldPriority,
+		})
+	}
+	if value, ok := iuo.mutation.AddedPriority(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: item.FieldPriority,
+		})
+	}
 	i = &Item{config: iuo.config}
-	_spec.Assign = i.assignValues
-	_spec.ScanValues = i.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return i.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = i.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, iuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{item.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -194,5 +412,16 @@ func (iuo *ItemUpdateOne) sqlSave(ctx context.Context) (i *Item, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		i.ID = id
+		if value, ok := iuo.mutation.CreatedAt(); ok {
+			i.CreatedAt = value
+		}
+		if value, ok := iuo.mutation.Priority(); ok {
+			i.Priority = value
+		}
+	}
 	return i, nil
 }
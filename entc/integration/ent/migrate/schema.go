@@ -19,6 +19,9 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "number", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true, SchemaType: map[string]string{"postgres": "timestamptz"}},
+		{Name: "type", Type: field.TypeEnum, Nullable: true, Enums: []string{"visa", "mc", "amex"}},
+		{Name: "number_hash", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "user_card", Type: field.TypeInt, Unique: true, Nullable: true},
 	}
 	// CardsTable holds the schema information for the "cards" table.
@@ -29,7 +32,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:  "cards_users_card",
-				Columns: []*schema.Column{CardsColumns[5]},
+				Columns: []*schema.Column{CardsColumns[8]},
 
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
@@ -216,6 +219,8 @@ var (
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime, DefaultExpr: "CURRENT_TIMESTAMP"},
+		{Name: "priority", Type: field.TypeInt, DefaultExpr: "1"},
 	}
 	// ItemsTable holds the schema information for the "items" table.
 	ItemsTable = &schema.Table{
@@ -284,6 +289,7 @@ var (
 	// SpecsColumns holds the columns for the "specs" table.
 	SpecsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Nullable: true},
 	}
 	// SpecsTable holds the schema information for the "specs" table.
 	SpecsTable = &schema.Table{
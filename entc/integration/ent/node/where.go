@@ -7,6 +7,8 @@
 package node
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
@@ -192,6 +194,75 @@ func ValueNotNil() predicate.Node {
 	})
 }
 
+// ValueEQNullSafe applies a NULL-safe equality predicate on the "value" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func ValueEQNullSafe(v *int) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldValue), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:    "numeric",
+	FieldValue: "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Node.
+func FieldsEQ(f1, f2 string) predicate.Node {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Node.
+func FieldsNEQ(f1, f2 string) predicate.Node {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Node.
+func FieldsLT(f1, f2 string) predicate.Node {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Node.
+func FieldsLTE(f1, f2 string) predicate.Node {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Node.
+func FieldsGT(f1, f2 string) predicate.Node {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Node.
+func FieldsGTE(f1, f2 string) predicate.Node {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Node using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Node", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Node", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Node are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasPrev applies the HasEdge predicate on the "prev" edge.
 func HasPrev() predicate.Node {
 	return predicate.Node(func(s *sql.Selector) {
@@ -220,6 +291,45 @@ func HasPrevWith(preds ...predicate.Node) predicate.Node {
 	})
 }
 
+// PrevIDIn applies a predicate on the "prev" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	node.PrevIDIn(client.Node.Query().Where(...))
+//
+func PrevIDIn(q sqlgraph.IDsQuerier) predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(PrevColumn), sub))
+	})
+}
+
+// PrevMissing applies a predicate on the "prev" edge, that checks if its foreign-key is set, but
+// there is no Node with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE node_next IS NOT NULL AND NOT EXISTS (SELECT id FROM nodes WHERE id = node_next)
+//
+func PrevMissing() predicate.Node {
+	return predicate.Node(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("prev")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(PrevColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(PrevColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasNext applies the HasEdge predicate on the "next" edge.
 func HasNext() predicate.Node {
 	return predicate.Node(func(s *sql.Selector) {
@@ -255,6 +365,9 @@ func And(predicates ...predicate.Node) predicate.Node {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -269,6 +382,9 @@ func Or(predicates ...predicate.Node) predicate.Node {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -279,3 +395,43 @@ func Not(p predicate.Node) predicate.Node {
 		p(s.Not())
 	})
 }
+
+// NodeFilter holds optional filters on the Node fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type NodeFilter struct {
+	IDIn     []int
+	Value    *int
+	PrevIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Node.Query().
+//		Where(node.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *NodeFilter) []predicate.Node {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Node
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Value != nil {
+		ps = append(ps, ValueEQ(*f.Value))
+	}
+	if ids := f.PrevIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Node(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(PrevColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -32,6 +35,18 @@ type NodeQuery struct {
 	withPrev *NodeQuery
 	withNext *NodeQuery
 	withFKs  bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see NodeSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -43,6 +58,19 @@ func (nq *NodeQuery) Where(ps ...predicate.Node) *NodeQuery {
 	return nq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (nq *NodeQuery) Predicates() []predicate.Node {
+	return append([]predicate.Node{}, nq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (nq *NodeQuery) SetPredicates(ps ...predicate.Node) *NodeQuery {
+	nq.predicates = append([]predicate.Node{}, ps...)
+	return nq
+}
+
 // Limit adds a limit step to the query.
 func (nq *NodeQuery) Limit(limit int) *NodeQuery {
 	nq.limit = &limit
@@ -340,11 +368,15 @@ func (nq *NodeQuery) GroupBy(field string, fields ...string) *NodeGroupBy {
 func (nq *NodeQuery) Select(field string, fields ...string) *NodeSelect {
 	selector := &NodeSelect{config: nq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = nq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := nq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return nq.sqlQuery(), nil
+		if err := nq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return nq.sqlQuery().AppendSelectExpr(nq.exprs...), nil
 	}
 	return selector
 }
@@ -361,6 +393,15 @@ func (nq *NodeQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
+	ctx = nq.withOperation(ctx, "Node", "Query")
+	if err := nq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range nq.fields {
+		if !node.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*Node{}
 		withFKs     = nq.withFKs
@@ -376,12 +417,14 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, node.ForeignKeys...)
 	}
+	_spec.Exprs = nq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Node{config: nq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
-		if withFKs {
-			values = append(values, node.fkValues()...)
+		values := node.scanValues(columns)
+		for range nq.exprs {
+			values = append(values, new(interface{}))
 		}
 		return values
 	}
@@ -390,8 +433,16 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(nq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range nq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
 		return nil, err
@@ -401,31 +452,50 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 	}
 
 	if query := nq.withPrev; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*Node)
 		for i := range nodes {
 			if fk := nodes[i].node_next; fk != nil {
-				ids = append(ids, *fk)
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
 				nodeids[*fk] = append(nodeids[*fk], nodes[i])
 			}
 		}
-		query.Where(node.IDIn(ids...))
-		neighbors, err := query.All(ctx)
-		if err != nil {
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && nq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`ent: limit or offset of eager-loaded edge "prev" cannot be applied on %d neighbors with batch size %d`, len(ids), nq.eagerLoadSize())
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := nodeids[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "node_next" returned %v`, n.ID)
+		err := nq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], node.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Prev = n
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "node_next" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Prev = n
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if query := nq.withNext; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*Node)
 		for i := range nodes {
@@ -433,30 +503,74 @@ func (nq *NodeQuery) sqlAll(ctx context.Context) ([]*Node, error) {
 			nodeids[nodes[i].ID] = nodes[i]
 		}
 		query.withFKs = true
-		query.Where(predicate.Node(func(s *sql.Selector) {
-			s.Where(sql.InValues(node.NextColumn, fks...))
-		}))
-		neighbors, err := query.All(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range neighbors {
-			fk := n.node_next
-			if fk == nil {
-				return nil, fmt.Errorf(`foreign-key "node_next" is nil for node %v`, n.ID)
+		err := nq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Node(func(s *sql.Selector) {
+				s.Where(sql.InValues(node.NextColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			node, ok := nodeids[*fk]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "node_next" returned %v for node %v`, *fk, n.ID)
+			for _, n := range neighbors {
+				fk := n.node_next
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "node_next" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "node_next" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Next = n
 			}
-			node.Edges.Next = n
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Node entities (see Node.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Node.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Node table.
+func (nq *NodeQuery) SelectExpr(exprs ...*sql.SelectExpr) *NodeQuery {
+	nq.exprs = append(nq.exprs, exprs...)
+	return nq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (nq *NodeQuery) checkExprs() error {
+	if len(nq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range node.Columns {
+		columns[c] = true
+	}
+	for _, c := range node.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range nq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Node", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (nq *NodeQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = nq.withOperation(ctx, "Node", "Count")
 	_spec := nq.querySpec()
 	return sqlgraph.CountNodes(ctx, nq.driver, _spec)
 }
@@ -482,6 +596,17 @@ func (nq *NodeQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   nq.sql,
 		Unique: true,
 	}
+	if fields := nq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, node.FieldID)
+		for i := range fields {
+			if fields[i] != node.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = nq.forUpdate
+	_spec.PartitionBy = nq.partition
 	if ps := nq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -530,11 +655,387 @@ func (nq *NodeQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Node entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (nq *NodeQuery) IDsQuery() *sql.Selector {
+	selector := nq.sqlQuery()
+	if nq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(node.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Node entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Node.Query().
+//		Where(...).
+//		CountDistinct(ctx, node.FieldValue)
+//
+func (nq *NodeQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = nq.withOperation(ctx, "Node", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, nq.driver, nq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (nq *NodeQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := nq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Node rows as generic maps that are keyed by
+// column name (see node.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (nq *NodeQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := nq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = nq.withOperation(ctx, "Node", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = nq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Node{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, nq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (nq *NodeQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := nq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Node entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (nq *NodeQuery) Prefetch(n int) *NodeQuery {
+	nq.prefetch = n
+	return nq
+}
+
+// ForUpdate locks the selected Node rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (nq *NodeQuery) ForUpdate() *NodeQuery {
+	nq.forUpdate = true
+	return nq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (nq *NodeQuery) Primary() *NodeQuery {
+	return nq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (nq *NodeQuery) ReadConsistency(c sql.ReadConsistency) *NodeQuery {
+	nq.consistency = c
+	return nq
+}
+
+// Stream executes the query in batches, and sends the matched Node entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Node.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (nq *NodeQuery) Stream(ctx context.Context) (<-chan *Node, <-chan error) {
+	size := nq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Node, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := nq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Node entities in batches of the given
+// size, and sends them to the given channel.
+func (nq *NodeQuery) stream(ctx context.Context, size int, nodes chan<- *Node) error {
+	if nq.offset != nil || len(nq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if nq.limit != nil {
+		left = *nq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *nq
+		query.predicates = nq.predicates[:len(nq.predicates):len(nq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, node.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(node.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// NodeEdge is the edge representation of Node in a connection.
+type NodeEdge struct {
+	Node   *Node  `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// NodeConnection is the connection of Node entities that is returned by Paginate.
+type NodeConnection struct {
+	Edges      []NodeEdge `json:"edges"`
+	PageInfo   PageInfo   `json:"pageInfo"`
+	TotalCount int        `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Node.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: node.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (nq *NodeQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*NodeConnection, error) {
+	if nq.limit != nil || nq.offset != nil || len(nq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		return nil, fmt.Errorf("ent: unsupported order field %q for Node", o.Field)
+	}
+	total, err := nq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: node.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *nq
+	query.predicates = nq.predicates[:len(nq.predicates):len(nq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := nq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &NodeConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]NodeEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = NodeEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (nq *NodeQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Node, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := nq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Node(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*NodeQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case node.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Node", field)
+}
+
+// pageCursor returns the cursor of the Node node for the given order fields.
+func (n *Node) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(n.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if len(orderBy) > 0 {
+		return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Node", orderBy[0].Field)
+	}
+	return Cursor{id: id}, nil
+}
+
 // NodeGroupBy is the builder for group-by Node entities.
 type NodeGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -647,9 +1148,28 @@ func (ngb *NodeGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Node.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (ngb *NodeGroupBy) Having(ps ...*sql.Predicate) *NodeGroupBy {
+	ngb.havings = append(ngb.havings, ps...)
+	return ngb
+}
+
 func (ngb *NodeGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ngb.withOperation(ctx, "Node", "GroupBy")
+	selector := ngb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := ngb.sqlQuery().Query()
 	if err := ngb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -662,15 +1182,30 @@ func (ngb *NodeGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(ngb.fields)+len(ngb.fns))
 	columns = append(columns, ngb.fields...)
 	for _, fn := range ngb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(ngb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(ngb.fields...)
+	switch len(ngb.havings) {
+	case 0:
+	case 1:
+		selector.Having(ngb.havings[0])
+	default:
+		selector.Having(sql.And(ngb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(ngb.fields...)
+	return selector
 }
 
 // NodeSelect is the builder for select fields of Node entities.
 type NodeSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *NodeQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -777,7 +1312,52 @@ func (ns *NodeSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NodeSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ns *NodeSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ns.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Node entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Node.Query().
+//		Select(node.FieldValue).
+//		All(ctx)
+//
+func (ns *NodeSelect) All(ctx context.Context) ([]*Node, error) {
+	query := *ns.query
+	query.fields = ns.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ns *NodeSelect) AllX(ctx context.Context) []*Node {
+	nodes, err := ns.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (ns *NodeSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ns.withOperation(ctx, "Node", "Select")
 	rows := &sql.Rows{}
 	query, args := ns.sqlQuery().Query()
 	if err := ns.driver.Query(ctx, query, args, rows); err != nil {
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -27,6 +31,9 @@ type Pet struct {
 	Edges     PetEdges `json:"edges"`
 	user_pets *int
 	user_team *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // PetEdges holds the relations/edges for other nodes in the graph.
@@ -68,57 +75,77 @@ func (e PetEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Pet) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // name
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Pet) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // user_pets
-		&sql.NullInt64{}, // user_team
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Pet type are scanned as generic values.
+func (*Pet) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pet.FieldID:
+			values[i] = &sql.NullInt64{}
+		case pet.FieldName:
+			values[i] = &sql.NullString{}
+		case pet.ForeignKeys[0]: // user_pets
+			values[i] = &sql.NullInt64{}
+		case pet.ForeignKeys[1]: // user_team
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Pet fields.
-func (pe *Pet) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(pet.Columns); m < n {
+// to the Pet fields. Values of columns that were not selected are left untouched.
+func (pe *Pet) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	pe.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[0])
-	} else if value.Valid {
-		pe.Name = value.String
-	}
-	values = values[1:]
-	if len(values) == len(pet.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_pets", value)
-		} else if value.Valid {
-			pe.user_pets = new(int)
-			*pe.user_pets = int(value.Int64)
-		}
-		if value, ok := values[1].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_team", value)
-		} else if value.Valid {
-			pe.user_team = new(int)
-			*pe.user_team = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case pet.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pe.ID = int(value.Int64)
+		case pet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pe.Name = value.String
+			}
+		case pet.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_pets", value)
+			} else if value.Valid {
+				pe.user_pets = new(int)
+				*pe.user_pets = int(value.Int64)
+			}
+		case pet.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_team", value)
+			} else if value.Valid {
+				pe.user_team = new(int)
+				*pe.user_team = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (pe *Pet) SelectValue(alias string) (Value, error) {
+	v, ok := pe.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryTeam queries the team edge of the Pet.
 func (pe *Pet) QueryTeam() *UserQuery {
 	return (&PetClient{config: pe.config}).QueryTeam(pe)
@@ -129,6 +156,107 @@ func (pe *Pet) QueryOwner() *UserQuery {
 	return (&PetClient{config: pe.config}).QueryOwner(pe)
 }
 
+// LoadEdges loads the given edges of the Pet using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see pet.Edges), and in this case, no edge is loaded.
+//
+//	err := pe.LoadEdges(ctx, client, pet.EdgeTeam, pet.EdgeOwner)
+//
+func (pe *Pet) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadPetEdges(ctx, client, []*Pet{pe}, edges)
+}
+
+// loadPetEdges loads the given edges of all the given Pet entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using PetClient.LoadEdgeFor.
+func loadPetEdges(ctx context.Context, client *Client, nodes []*Pet, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case pet.EdgeTeam, pet.EdgeOwner:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Pet", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Pet.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Pet and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Pet alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Pet itself is not modified.
+//
+//	b, err := pe.DumpGraph(ctx, client, 2, pet.EdgeTeam)
+//
+func (pe *Pet) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Pet DumpGraph", depth)
+	}
+	dumped := *pe
+	dumped.Edges = PetEdges{}
+	if err := dumpPets(ctx, client, []*Pet{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpPets loads the edges of the given Pet copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpPets(ctx context.Context, client *Client, nodes []*Pet, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Pet
+	for _, n := range nodes {
+		if dumper.visit(pet.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range pet.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadPetEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextTeam []*User
+	for _, n := range visit {
+		if e := n.Edges.Team; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Team = &c
+			nextTeam = append(nextTeam, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextTeam, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextOwner []*User
+	for _, n := range visit {
+		if e := n.Edges.Owner; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Owner = &c
+			nextOwner = append(nextOwner, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextOwner, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Pet.
 // Note that, you need to call Pet.Unwrap() before calling this method, if this Pet
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -147,6 +275,28 @@ func (pe *Pet) Unwrap() *Pet {
 	return pe
 }
 
+// Clone returns a deep copy of the Pet and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (pe *Pet) Clone() *Pet {
+	return pe.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Pet and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (pe *Pet) clone(seen map[interface{}]interface{}) *Pet {
+	if pe == nil {
+		return nil
+	}
+	if v, ok := seen[pe]; ok {
+		return v.(*Pet)
+	}
+	_c := *pe
+	seen[pe] = &_c
+	_c.Edges.Team = pe.Edges.Team.clone(seen)
+	_c.Edges.Owner = pe.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (pe *Pet) String() string {
 	var builder strings.Builder
@@ -158,6 +308,26 @@ func (pe *Pet) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Pet, for change detection and cache keys.
+// It covers the fields that are listed in pet.FingerprintFields, and it does not depend on the
+// ID of the Pet, on its edges, or on the order of the fields in the schema.
+func (pe *Pet) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", pe.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Pet for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Pet can be decoded using the FromMap method of its client.
+func (pe *Pet) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[pet.FieldID] = kvEncode(pe.ID)
+	m[pet.FieldName] = kvEncode(pe.Name)
+	return m
+}
+
 // Pets is a parsable slice of Pet.
 type Pets []*Pet
 
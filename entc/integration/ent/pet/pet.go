@@ -36,6 +36,18 @@ const (
 	OwnerColumn = "user_pets"
 )
 
+// Edges holds all the edge names of the Pet type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeTeam,
+	EdgeOwner,
+}
+
+// FingerprintFields holds the fields of the Pet type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for pet fields.
 var Columns = []string{
 	FieldID,
@@ -47,3 +59,13 @@ var ForeignKeys = []string{
 	"user_pets",
 	"user_team",
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
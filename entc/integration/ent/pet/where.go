@@ -7,6 +7,8 @@
 package pet
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
@@ -185,6 +187,13 @@ func NameContains(v string) predicate.Pet {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -192,6 +201,13 @@ func NameHasPrefix(v string) predicate.Pet {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -199,6 +215,13 @@ func NameHasSuffix(v string) predicate.Pet {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -213,6 +236,77 @@ func NameContainsFold(v string) predicate.Pet {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:   "numeric",
+	FieldName: "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Pet.
+func FieldsEQ(f1, f2 string) predicate.Pet {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Pet.
+func FieldsNEQ(f1, f2 string) predicate.Pet {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Pet.
+func FieldsLT(f1, f2 string) predicate.Pet {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Pet.
+func FieldsLTE(f1, f2 string) predicate.Pet {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Pet.
+func FieldsGT(f1, f2 string) predicate.Pet {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Pet.
+func FieldsGTE(f1, f2 string) predicate.Pet {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Pet using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Pet", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Pet", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Pet are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasTeam applies the HasEdge predicate on the "team" edge.
 func HasTeam() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -241,6 +335,45 @@ func HasTeamWith(preds ...predicate.User) predicate.Pet {
 	})
 }
 
+// TeamIDIn applies a predicate on the "team" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	pet.TeamIDIn(client.User.Query().Where(...))
+//
+func TeamIDIn(q sqlgraph.IDsQuerier) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(TeamColumn), sub))
+	})
+}
+
+// TeamMissing applies a predicate on the "team" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_team IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_team)
+//
+func TeamMissing() predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(TeamInverseTable).As("team")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(TeamColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(TeamColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -269,6 +402,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.Pet {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	pet.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_pets IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_pets)
+//
+func OwnerMissing() predicate.Pet {
+	return predicate.Pet(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Pet) predicate.Pet {
 	return predicate.Pet(func(s *sql.Selector) {
@@ -276,6 +448,9 @@ func And(predicates ...predicate.Pet) predicate.Pet {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -290,6 +465,9 @@ func Or(predicates ...predicate.Pet) predicate.Pet {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -300,3 +478,53 @@ func Not(p predicate.Pet) predicate.Pet {
 		p(s.Not())
 	})
 }
+
+// PetFilter holds optional filters on the Pet fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type PetFilter struct {
+	IDIn      []int
+	Name      *string
+	TeamIDIn  []int
+	OwnerIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Pet.Query().
+//		Where(pet.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *PetFilter) []predicate.Pet {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Pet
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if ids := f.TeamIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Pet(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(TeamColumn), v...))
+		}))
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Pet(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -10,7 +10,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
@@ -70,8 +72,12 @@ func (pc *PetCreate) SetOwner(u *User) *PetCreate {
 
 // Save creates the Pet in the database.
 func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
-	if _, ok := pc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	if err := checkTx(pc.driver); err != nil {
+		return nil, err
+	}
+	pc.defaults()
+	if err := pc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -108,17 +114,115 @@ func (pc *PetCreate) SaveX(ctx context.Context) *Pet {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pc *PetCreate) Unwrap() *PetCreate {
+	tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PetCreate is not a transactional builder")
+	}
+	pc.config.driver = tx.drv
+	pc.mutation.driver = tx.drv
+	return pc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (pc *PetCreate) DebugFields() map[string]Value {
+	return debugFields(pc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PetCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PetCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
 func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
-	var (
-		pe    = &Pet{config: pc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: pet.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: pet.FieldID,
-			},
+	ctx = pc.withOperation(ctx, "Pet", "Create")
+	pe, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	pe.ID = int(id)
+	return pe, nil
+}
+
+// SaveID creates the Pet in the database and returns only its id. Unlike Save,
+// the Pet entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Pet that holds only its id as the value that is returned by the next mutator.
+func (pc *PetCreate) SaveID(ctx context.Context) (id int, err error) {
+	pc.defaults()
+	if err = pc.check(); err != nil {
+		return id, err
+	}
+	if len(pc.hooks) == 0 {
+		return pc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*PetMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		pc.mutation = mutation
+		if id, err = pc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Pet{config: pc.config, ID: id}, nil
+	})
+	for i := len(pc.hooks) - 1; i >= 0; i-- {
+		mut = pc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, pc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (pc *PetCreate) SaveIDX(ctx context.Context) int {
+	id, err := pc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (pc *PetCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = pc.withOperation(ctx, "Pet", "Create")
+	_spec := pc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, pc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (pc *PetCreate) createSpec() (*Pet, *sqlgraph.CreateSpec) {
+	pe := &Pet{config: pc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: pet.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: pet.FieldID,
+		},
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -165,13 +269,461 @@ func (pc *PetCreate) sqlSave(ctx context.Context) (*Pet, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return pe, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (pc *PetCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: pet.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: pet.FieldID,
+		},
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: pet.FieldName,
+		})
+	}
+	if nodes := pc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   pet.TeamTable,
+			Columns: []string{pet.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pet.OwnerTable,
+			Columns: []string{pet.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// PetCreateBulk is the builder for creating a bulk of Pet entities.
+type PetCreateBulk struct {
+	config
+	builders []*PetCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Pet entities in the database.
+func (pcb *PetCreateBulk) Save(ctx context.Context) ([]*Pet, error) {
+	nodes, err := pcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && pcb.retries > 0 && isSQLDeadlockError(err) {
+		return pcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PetCreateBulk) SaveX(ctx context.Context) []*Pet {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (pcb *PetCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range pcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (pcb *PetCreateBulk) CheckRefs() *PetCreateBulk {
+	pcb.refs = true
+	return pcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (pcb *PetCreateBulk) RetryOnDeadlock(max int) *PetCreateBulk {
+	pcb.retries = max
+	return pcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Pet.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Pet.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (pcb *PetCreateBulk) OnConflict(columns ...string) *PetUpsertBulk {
+	return &PetUpsertBulk{create: pcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Pet.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (pc *PetCreate) OnConflict(target ...sql.ConflictOption) *PetUpsertBulk {
+	bulk := &PetCreateBulk{config: pc.config, builders: []*PetCreate{pc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (pcb *PetCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Pet, error) {
+	ctx = pcb.withOperation(ctx, "Pet", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(pcb.builders))
+		nodes    = make([]*Pet, len(pcb.builders))
+		mutators = make([]Mutator, len(pcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
 		}
+	}
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if pcb.refs {
+						if err := pcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, pcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (pcb *PetCreateBulk) retry(ctx context.Context, err error) ([]*Pet, error) {
+	if _, ok := pcb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	pe.ID = int(id)
-	return pe, nil
+	builders := pcb.builders
+	defer func() { pcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return pcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	pcb.builders = make([]*PetCreate, len(idx))
+	for i, j := range idx {
+		pcb.builders[i] = builders[j]
+	}
+	for n := 0; n < pcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Pet
+		if sorted, err = pcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Pet, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (pcb *PetCreateBulk) less(a, b *PetCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (pcb *PetCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range pcb.builders {
+			for _, id := range builder.mutation.TeamIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, pcb.driver, user.Table, user.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[pet.EdgeTeam] = append(missing[pet.EdgeTeam], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range pcb.builders {
+			for _, id := range builder.mutation.OwnerIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, pcb.driver, user.Table, user.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[pet.EdgeOwner] = append(missing[pet.EdgeOwner], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Pet", Missing: missing}
+	}
+	return nil
+}
+
+// PetUpsertBulk is the builder for the conflict handling of a bulk of Pet entities.
+type PetUpsertBulk struct {
+	create  *PetCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (pub *PetUpsertBulk) Target(target ...sql.ConflictOption) *PetUpsertBulk {
+	pub.target = append(pub.target, target...)
+	return pub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (pub *PetUpsertBulk) DoNothing() *PetUpsertBulk {
+	pub.nothing = true
+	return pub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (pub *PetUpsertBulk) UpdateNewValues() *PetUpsertBulk {
+	pub.update = true
+	return pub
+}
+
+// Save creates the Pet entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (pub *PetUpsertBulk) Save(ctx context.Context) ([]*Pet, int, error) {
+	switch {
+	case !pub.nothing && !pub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Pet bulk insert")
+	case pub.nothing && pub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Pet bulk insert")
+	case pub.update:
+		if len(pub.columns) == 0 && len(pub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Pet bulk upsert")
+		}
+		nodes, err := pub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(pub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(pet.FieldID),
+			),
+			Columns:         pet.Columns,
+			ConflictColumns: pub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(pub.conflictTarget(), sql.DoNothing())}
+	nodes, err := pub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Pet, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (pub *PetUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(pub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(pub.columns...))
+	}
+	return append(opts, pub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pub *PetUpsertBulk) SaveX(ctx context.Context) ([]*Pet, int) {
+	nodes, skipped, err := pub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (pub *PetUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := pub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pub *PetUpsertBulk) ExecX(ctx context.Context) {
+	if err := pub.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -24,6 +25,9 @@ type PetUpdate struct {
 	hooks      []Hook
 	mutation   *PetMutation
 	predicates []predicate.Pet
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,12 +36,26 @@ func (pu *PetUpdate) Where(ps ...predicate.Pet) *PetUpdate {
 	return pu
 }
 
+// Limit limits the number of pets that are updated by the builder. For example,
+// for updating large amounts of pets in small batches without locking the whole table.
+func (pu *PetUpdate) Limit(limit int) *PetUpdate {
+	pu.limit = &limit
+	return pu
+}
+
 // SetName sets the name field.
 func (pu *PetUpdate) SetName(s string) *PetUpdate {
 	pu.mutation.SetName(s)
 	return pu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (pu *PetUpdate) UnsetName() *PetUpdate {
+	pu.mutation.ResetName()
+	return pu
+}
+
 // SetTeamID sets the team edge to User by id.
 func (pu *PetUpdate) SetTeamID(id int) *PetUpdate {
 	pu.mutation.SetTeamID(id)
@@ -88,9 +106,33 @@ func (pu *PetUpdate) ClearOwner() *PetUpdate {
 	return pu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pu *PetUpdate) Unwrap() *PetUpdate {
+	tx, ok := pu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PetUpdate is not a transactional builder")
+	}
+	pu.config.driver = tx.drv
+	pu.mutation.driver = tx.drv
+	return pu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (pu *PetUpdate) DebugFields() map[string]Value {
+	return debugFields(pu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(pu.driver); err != nil {
+		return 0, err
+	}
+	if err := pu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -139,7 +181,19 @@ func (pu *PetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (pu *PetUpdate) check() error {
+	if pu.mutation.conflictteam {
+		return &ValidationError{Name: "team", err: errors.New("ent: setting and clearing the unique edge \"team\" in the same mutation")}
+	}
+	if pu.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
 func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = pu.withOperation(ctx, "Pet", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   pet.Table,
@@ -157,6 +211,12 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := pu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if pu.ids != nil {
+		_spec.ScanIDs = pu.ids
+	}
 	if value, ok := pu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -234,7 +294,7 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -245,11 +305,33 @@ func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Pet entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (pu *PetUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	pu.ids = &ids
+	defer func() { pu.ids = nil }()
+	if _, err := pu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // PetUpdateOne is the builder for updating a single Pet entity.
 type PetUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *PetMutation
+	hooks      []Hook
+	mutation   *PetMutation
+	predicates []predicate.Pet
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (puo *PetUpdateOne) Where(ps ...predicate.Pet) *PetUpdateOne {
+	puo.predicates = append(puo.predicates, ps...)
+	return puo
 }
 
 // SetName sets the name field.
@@ -258,6 +340,13 @@ func (puo *PetUpdateOne) SetName(s string) *PetUpdateOne {
 	return puo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (puo *PetUpdateOne) UnsetName() *PetUpdateOne {
+	puo.mutation.ResetName()
+	return puo
+}
+
 // SetTeamID sets the team edge to User by id.
 func (puo *PetUpdateOne) SetTeamID(id int) *PetUpdateOne {
 	puo.mutation.SetTeamID(id)
@@ -308,9 +397,33 @@ func (puo *PetUpdateOne) ClearOwner() *PetUpdateOne {
 	return puo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (puo *PetUpdateOne) Unwrap() *PetUpdateOne {
+	tx, ok := puo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PetUpdateOne is not a transactional builder")
+	}
+	puo.config.driver = tx.drv
+	puo.mutation.driver = tx.drv
+	return puo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (puo *PetUpdateOne) DebugFields() map[string]Value {
+	return debugFields(puo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
-
+	if err := checkTx(puo.driver); err != nil {
+		return nil, err
+	}
+	if err := puo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Pet
@@ -359,7 +472,19 @@ func (puo *PetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (puo *PetUpdateOne) check() error {
+	if puo.mutation.conflictteam {
+		return &ValidationError{Name: "team", err: errors.New("ent: setting and clearing the unique edge \"team\" in the same mutation")}
+	}
+	if puo.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
 func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
+	ctx = puo.withOperation(ctx, "Pet", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   pet.Table,
@@ -375,6 +500,13 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 		return nil, fmt.Errorf("missing Pet.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := puo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := puo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -453,9 +585,11 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	pe = &Pet{config: puo.config}
-	_spec.Assign = pe.assignValues
-	_spec.ScanValues = pe.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return pe.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = pe.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, puo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{pet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -463,5 +597,13 @@ func (puo *PetUpdateOne) sqlSave(ctx context.Context) (pe *Pet, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		pe.ID = id
+		if value, ok := puo.mutation.Name(); ok {
+			pe.Name = value
+		}
+	}
 	return pe, nil
 }
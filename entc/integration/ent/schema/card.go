@@ -5,7 +5,10 @@
 package schema
 
 import (
+	"time"
+
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 	"github.com/facebookincubator/ent/schema/mixin"
@@ -22,6 +25,16 @@ func (Card) Mixin() []ent.Mixin {
 	}
 }
 
+// CardType is the type of the card network.
+type CardType string
+
+// CardType values.
+const (
+	CardTypeVisa CardType = "visa"
+	CardTypeMC   CardType = "mc"
+	CardTypeAmex CardType = "amex"
+)
+
 // Fields of the Comment.
 func (Card) Fields() []ent.Field {
 	return []ent.Field{
@@ -32,6 +45,23 @@ func (Card) Fields() []ent.Field {
 			Optional().
 			Comment("Exact name written on card").
 			NotEmpty(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "timestamptz",
+			}).
+			Location(time.UTC).
+			Annotations(field.Fingerprint(false)),
+		field.Enum("type").
+			Values("visa", "mc", "amex").
+			GoType(CardType("")).
+			Optional(),
+		field.String("number_hash").
+			Optional().
+			Unique().
+			Immutable().
+			Annotations(field.Fingerprint(false)),
 	}
 }
 
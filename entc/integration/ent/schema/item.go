@@ -4,7 +4,10 @@
 
 package schema
 
-import "github.com/facebookincubator/ent"
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/field"
+)
 
 // Item holds the schema definition for the Item entity.
 type Item struct {
@@ -13,7 +16,12 @@ type Item struct {
 
 // Fields of the Item.
 func (Item) Fields() []ent.Field {
-	return nil
+	return []ent.Field{
+		field.Time("created_at").
+			DefaultExpr("CURRENT_TIMESTAMP"),
+		field.Int("priority").
+			DefaultExpr("1"),
+	}
 }
 
 // Edges of the Item.
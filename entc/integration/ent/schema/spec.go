@@ -7,12 +7,22 @@ package schema
 import (
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
 )
 
 type Spec struct {
 	ent.Schema
 }
 
+// Fields of the Spec.
+func (Spec) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Optional().
+			Unique(),
+	}
+}
+
 // Edges of the Spec.
 func (Spec) Edges() []ent.Edge {
 	return []ent.Edge{
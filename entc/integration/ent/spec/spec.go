@@ -10,7 +10,8 @@ const (
 	// Label holds the string label denoting the spec type in the database.
 	Label = "spec"
 	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	FieldID   = "id" // FieldName holds the string denoting the name vertex property in the database.
+	FieldName = "name"
 
 	// EdgeCard holds the string denoting the card edge name in mutations.
 	EdgeCard = "card"
@@ -24,9 +25,31 @@ const (
 	CardInverseTable = "cards"
 )
 
+// Edges holds all the edge names of the Spec type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeCard,
+}
+
+// FingerprintFields holds the fields of the Spec type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for spec fields.
 var Columns = []string{
 	FieldID,
+	FieldName,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
 }
 
 var (
@@ -8,8 +8,12 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/spec"
@@ -23,6 +27,20 @@ type SpecCreate struct {
 	hooks    []Hook
 }
 
+// SetName sets the name field.
+func (sc *SpecCreate) SetName(s string) *SpecCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (sc *SpecCreate) SetNillableName(s *string) *SpecCreate {
+	if s != nil {
+		sc.SetName(*s)
+	}
+	return sc
+}
+
 // AddCardIDs adds the card edge to Card by ids.
 func (sc *SpecCreate) AddCardIDs(ids ...int) *SpecCreate {
 	sc.mutation.AddCardIDs(ids...)
@@ -40,6 +58,13 @@ func (sc *SpecCreate) AddCard(c ...*Card) *SpecCreate {
 
 // Save creates the Spec in the database.
 func (sc *SpecCreate) Save(ctx context.Context) (*Spec, error) {
+	if err := checkTx(sc.driver); err != nil {
+		return nil, err
+	}
+	sc.defaults()
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Spec
@@ -75,17 +100,158 @@ func (sc *SpecCreate) SaveX(ctx context.Context) *Spec {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (sc *SpecCreate) Unwrap() *SpecCreate {
+	tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecCreate is not a transactional builder")
+	}
+	sc.config.driver = tx.drv
+	sc.mutation.driver = tx.drv
+	return sc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (sc *SpecCreate) DebugFields() map[string]Value {
+	return debugFields(sc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SpecCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SpecCreate) check() error {
+	return nil
+}
+
 func (sc *SpecCreate) sqlSave(ctx context.Context) (*Spec, error) {
-	var (
-		s     = &Spec{config: sc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: spec.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: spec.FieldID,
+	ctx = sc.withOperation(ctx, "Spec", "Create")
+	s, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	s.ID = int(id)
+	return s, nil
+}
+
+// SaveID creates the Spec in the database and returns only its id. Unlike Save,
+// the Spec entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Spec that holds only its id as the value that is returned by the next mutator.
+func (sc *SpecCreate) SaveID(ctx context.Context) (id int, err error) {
+	sc.defaults()
+	if err = sc.check(); err != nil {
+		return id, err
+	}
+	if len(sc.hooks) == 0 {
+		return sc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*SpecMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		sc.mutation = mutation
+		if id, err = sc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Spec{config: sc.config, ID: id}, nil
+	})
+	for i := len(sc.hooks) - 1; i >= 0; i-- {
+		mut = sc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, sc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (sc *SpecCreate) SaveIDX(ctx context.Context) int {
+	id, err := sc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (sc *SpecCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = sc.withOperation(ctx, "Spec", "Create")
+	_spec := sc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, sc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (sc *SpecCreate) createSpec() (*Spec, *sqlgraph.CreateSpec) {
+	s := &Spec{config: sc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: spec.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: spec.FieldID,
+		},
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: spec.FieldName,
+		})
+		s.Name = value
+	}
+	if nodes := sc.mutation.CardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spec.CardTable,
+			Columns: spec.CardPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: card.FieldID,
+				},
 			},
 		}
-	)
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return s, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (sc *SpecCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: spec.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: spec.FieldID,
+		},
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: spec.FieldName,
+		})
+	}
 	if nodes := sc.mutation.CardIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -105,13 +271,575 @@ func (sc *SpecCreate) sqlSave(ctx context.Context) (*Spec, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return _spec
+}
+
+// SpecCreateBulk is the builder for creating a bulk of Spec entities.
+type SpecCreateBulk struct {
+	config
+	builders []*SpecCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Spec entities in the database.
+func (scb *SpecCreateBulk) Save(ctx context.Context) ([]*Spec, error) {
+	nodes, err := scb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && scb.retries > 0 && isSQLDeadlockError(err) {
+		return scb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SpecCreateBulk) SaveX(ctx context.Context) []*Spec {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (scb *SpecCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range scb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (scb *SpecCreateBulk) CheckRefs() *SpecCreateBulk {
+	scb.refs = true
+	return scb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (scb *SpecCreateBulk) RetryOnDeadlock(max int) *SpecCreateBulk {
+	scb.retries = max
+	return scb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Spec.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Spec.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (scb *SpecCreateBulk) OnConflict(columns ...string) *SpecUpsertBulk {
+	return &SpecUpsertBulk{create: scb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Spec.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (sc *SpecCreate) OnConflict(target ...sql.ConflictOption) *SpecUpsertBulk {
+	bulk := &SpecCreateBulk{config: sc.config, builders: []*SpecCreate{sc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (scb *SpecCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Spec, error) {
+	ctx = scb.withOperation(ctx, "Spec", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(scb.builders))
+		nodes    = make([]*Spec, len(scb.builders))
+		mutators = make([]Mutator, len(scb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpecMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if scb.refs {
+						if err := scb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, scb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (scb *SpecCreateBulk) retry(ctx context.Context, err error) ([]*Spec, error) {
+	if _, ok := scb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := scb.builders
+	defer func() { scb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return scb.less(builders[idx[i]], builders[idx[j]])
+	})
+	scb.builders = make([]*SpecCreate, len(idx))
+	for i, j := range idx {
+		scb.builders[i] = builders[j]
+	}
+	for n := 0; n < scb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Spec
+		if sorted, err = scb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Spec, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (scb *SpecCreateBulk) less(a, b *SpecCreate) bool {
+	{
+		x, _ := a.mutation.Name()
+		y, _ := b.mutation.Name()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (scb *SpecCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range scb.builders {
+			for _, id := range builder.mutation.CardIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, scb.driver, card.Table, card.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[spec.EdgeCard] = append(missing[spec.EdgeCard], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Spec", Missing: missing}
+	}
+	return nil
+}
+
+// SpecUpsertBulk is the builder for the conflict handling of a bulk of Spec entities.
+type SpecUpsertBulk struct {
+	create  *SpecCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (sub *SpecUpsertBulk) Target(target ...sql.ConflictOption) *SpecUpsertBulk {
+	sub.target = append(sub.target, target...)
+	return sub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (sub *SpecUpsertBulk) DoNothing() *SpecUpsertBulk {
+	sub.nothing = true
+	return sub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (sub *SpecUpsertBulk) UpdateNewValues() *SpecUpsertBulk {
+	sub.update = true
+	return sub
+}
+
+// Save creates the Spec entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (sub *SpecUpsertBulk) Save(ctx context.Context) ([]*Spec, int, error) {
+	switch {
+	case !sub.nothing && !sub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Spec bulk insert")
+	case sub.nothing && sub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Spec bulk insert")
+	case sub.update:
+		if len(sub.columns) == 0 && len(sub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Spec bulk upsert")
 		}
+		nodes, err := sub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(sub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(spec.FieldID),
+			),
+			Columns:         spec.Columns,
+			ConflictColumns: sub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(sub.conflictTarget(), sql.DoNothing())}
+	nodes, err := sub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Spec, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (sub *SpecUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(sub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(sub.columns...))
+	}
+	return append(opts, sub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sub *SpecUpsertBulk) SaveX(ctx context.Context) ([]*Spec, int) {
+	nodes, skipped, err := sub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (sub *SpecUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := sub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sub *SpecUpsertBulk) ExecX(ctx context.Context) {
+	if err := sub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored Spec entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.Spec.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *Spec) *Spec {
+//			// ...
+//			return existing
+//		}, spec.FieldName).
+//		Save(ctx)
+//
+func (scb *SpecCreateBulk) OnConflictMerge(merge func(existing, incoming *Spec) *Spec, keyFields ...string) *SpecMergeBulk {
+	return &SpecMergeBulk{create: scb, merge: merge, keyFields: keyFields}
+}
+
+// SpecMergeBulk is the builder for merging a bulk of Spec entities with the stored entities in Go.
+type SpecMergeBulk struct {
+	create    *SpecCreateBulk
+	merge     func(existing, incoming *Spec) *Spec
+	keyFields []string
+}
+
+// Save creates or merges the Spec entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (smb *SpecMergeBulk) Save(ctx context.Context) ([]*Spec, error) {
+	if smb.merge == nil {
+		return nil, errors.New("ent: missing merge function for Spec bulk merge")
+	}
+	if len(smb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for Spec bulk merge")
+	}
+	for _, f := range smb.keyFields {
+		switch f {
+		case spec.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for Spec bulk merge", f)
+		}
+	}
+	drv := smb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return smb.save(ctx, smb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	s.ID = int(id)
-	return s, nil
+	cfg := smb.create.config
+	cfg.driver = tx
+	nodes, err := smb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (smb *SpecMergeBulk) save(ctx context.Context, cfg config) ([]*Spec, error) {
+	var (
+		client   = &SpecClient{config: cfg}
+		builders = smb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *SpecCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(smb.keyFields))
+		for j, f := range smb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in Spec builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: Spec builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Spec)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, smb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(smb.keyFields))
+			for j, f := range smb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*Spec, len(builders))
+		inserts []*SpecCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := smb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smb *SpecMergeBulk) SaveX(ctx context.Context) []*Spec {
+	nodes, err := smb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (smb *SpecMergeBulk) Exec(ctx context.Context) error {
+	_, err := smb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smb *SpecMergeBulk) ExecX(ctx context.Context) {
+	if err := smb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
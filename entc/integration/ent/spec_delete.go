@@ -23,6 +23,7 @@ type SpecDelete struct {
 	hooks      []Hook
 	mutation   *SpecMutation
 	predicates []predicate.Spec
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (sd *SpecDelete) Where(ps ...predicate.Spec) *SpecDelete {
 	return sd
 }
 
+// Limit limits the number of specs that are deleted by the builder. For example,
+// for deleting large amounts of specs in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (sd *SpecDelete) Limit(limit int) *SpecDelete {
+	sd.limit = &limit
+	return sd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (sd *SpecDelete) Unwrap() *SpecDelete {
+	tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecDelete is not a transactional builder")
+	}
+	sd.config.driver = tx.drv
+	sd.mutation.driver = tx.drv
+	return sd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (sd *SpecDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(sd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (sd *SpecDelete) ExecX(ctx context.Context) int {
 }
 
 func (sd *SpecDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = sd.withOperation(ctx, "Spec", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: spec.Table,
@@ -85,7 +111,10 @@ func (sd *SpecDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, sd.driver, _spec)
+	if limit := sd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, sd.mutationDriver(ctx), _spec)
 }
 
 // SpecDeleteOne is the builder for deleting a single Spec entity.
@@ -99,7 +128,7 @@ func (sdo *SpecDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{spec.Label}
 	default:
 		return nil
@@ -24,6 +24,9 @@ type SpecUpdate struct {
 	hooks      []Hook
 	mutation   *SpecMutation
 	predicates []predicate.Spec
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,6 +35,41 @@ func (su *SpecUpdate) Where(ps ...predicate.Spec) *SpecUpdate {
 	return su
 }
 
+// Limit limits the number of specs that are updated by the builder. For example,
+// for updating large amounts of specs in small batches without locking the whole table.
+func (su *SpecUpdate) Limit(limit int) *SpecUpdate {
+	su.limit = &limit
+	return su
+}
+
+// SetName sets the name field.
+func (su *SpecUpdate) SetName(s string) *SpecUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (su *SpecUpdate) SetNillableName(s *string) *SpecUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// ClearName clears the value of name.
+func (su *SpecUpdate) ClearName() *SpecUpdate {
+	su.mutation.ClearName()
+	return su
+}
+
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (su *SpecUpdate) UnsetName() *SpecUpdate {
+	su.mutation.ResetName()
+	return su
+}
+
 // AddCardIDs adds the card edge to Card by ids.
 func (su *SpecUpdate) AddCardIDs(ids ...int) *SpecUpdate {
 	su.mutation.AddCardIDs(ids...)
@@ -62,9 +100,33 @@ func (su *SpecUpdate) RemoveCard(c ...*Card) *SpecUpdate {
 	return su.RemoveCardIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (su *SpecUpdate) Unwrap() *SpecUpdate {
+	tx, ok := su.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecUpdate is not a transactional builder")
+	}
+	su.config.driver = tx.drv
+	su.mutation.driver = tx.drv
+	return su
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (su *SpecUpdate) DebugFields() map[string]Value {
+	return debugFields(su.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (su *SpecUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(su.driver); err != nil {
+		return 0, err
+	}
+	if err := su.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -113,7 +175,13 @@ func (su *SpecUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (su *SpecUpdate) check() error {
+	return nil
+}
+
 func (su *SpecUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = su.withOperation(ctx, "Spec", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   spec.Table,
@@ -131,6 +199,25 @@ func (su *SpecUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := su.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if su.ids != nil {
+		_spec.ScanIDs = su.ids
+	}
+	if value, ok := su.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: spec.FieldName,
+		})
+	}
+	if su.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: spec.FieldName,
+		})
+	}
 	if nodes := su.mutation.RemovedCardIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -169,7 +256,7 @@ func (su *SpecUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, su.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{spec.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -180,11 +267,61 @@ func (su *SpecUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Spec entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (su *SpecUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	su.ids = &ids
+	defer func() { su.ids = nil }()
+	if _, err := su.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // SpecUpdateOne is the builder for updating a single Spec entity.
 type SpecUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *SpecMutation
+	hooks      []Hook
+	mutation   *SpecMutation
+	predicates []predicate.Spec
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (suo *SpecUpdateOne) Where(ps ...predicate.Spec) *SpecUpdateOne {
+	suo.predicates = append(suo.predicates, ps...)
+	return suo
+}
+
+// SetName sets the name field.
+func (suo *SpecUpdateOne) SetName(s string) *SpecUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (suo *SpecUpdateOne) SetNillableName(s *string) *SpecUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// ClearName clears the value of name.
+func (suo *SpecUpdateOne) ClearName() *SpecUpdateOne {
+	suo.mutation.ClearName()
+	return suo
+}
+
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (suo *SpecUpdateOne) UnsetName() *SpecUpdateOne {
+	suo.mutation.ResetName()
+	return suo
 }
 
 // AddCardIDs adds the card edge to Card by ids.
@@ -217,9 +354,33 @@ func (suo *SpecUpdateOne) RemoveCard(c ...*Card) *SpecUpdateOne {
 	return suo.RemoveCardIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (suo *SpecUpdateOne) Unwrap() *SpecUpdateOne {
+	tx, ok := suo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecUpdateOne is not a transactional builder")
+	}
+	suo.config.driver = tx.drv
+	suo.mutation.driver = tx.drv
+	return suo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (suo *SpecUpdateOne) DebugFields() map[string]Value {
+	return debugFields(suo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (suo *SpecUpdateOne) Save(ctx context.Context) (*Spec, error) {
-
+	if err := checkTx(suo.driver); err != nil {
+		return nil, err
+	}
+	if err := suo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Spec
@@ -268,7 +429,13 @@ func (suo *SpecUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (suo *SpecUpdateOne) check() error {
+	return nil
+}
+
 func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (s *Spec, err error) {
+	ctx = suo.withOperation(ctx, "Spec", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   spec.Table,
@@ -284,6 +451,26 @@ func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (s *Spec, err error) {
 		return nil, fmt.Errorf("missing Spec.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := suo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: spec.FieldName,
+		})
+	}
+	if suo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: spec.FieldName,
+		})
+	}
 	if nodes := suo.mutation.RemovedCardIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -323,9 +510,11 @@ func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (s *Spec, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	s = &Spec{config: suo.config}
-	_spec.Assign = s.assignValues
-	_spec.ScanValues = s.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return s.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = s.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, suo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{spec.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -333,5 +522,13 @@ func (suo *SpecUpdateOne) sqlSave(ctx context.Context) (s *Spec, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		s.ID = id
+		if value, ok := suo.mutation.Name(); ok {
+			s.Name = value
+		}
+	}
 	return s, nil
 }
@@ -7,12 +7,18 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
 )
 
@@ -45,6 +51,9 @@ type User struct {
 	group_blocked *int
 	user_spouse   *int
 	user_parent   *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -195,112 +204,150 @@ func (e UserEdges) ParentOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "parent"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*User) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullInt64{},  // optional_int
-		&sql.NullInt64{},  // age
-		&sql.NullString{}, // name
-		&sql.NullString{}, // last
-		&sql.NullString{}, // nickname
-		&sql.NullString{}, // phone
-		&sql.NullString{}, // password
-		&sql.NullString{}, // role
-		&sql.NullString{}, // SSOCert
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*User) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // group_blocked
-		&sql.NullInt64{}, // user_spouse
-		&sql.NullInt64{}, // user_parent
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the User type are scanned as generic values.
+func (*User) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &sql.NullInt64{}
+		case user.FieldOptionalInt:
+			values[i] = &sql.NullInt64{}
+		case user.FieldAge:
+			values[i] = &sql.NullInt64{}
+		case user.FieldName:
+			values[i] = &sql.NullString{}
+		case user.FieldLast:
+			values[i] = &sql.NullString{}
+		case user.FieldNickname:
+			values[i] = &sql.NullString{}
+		case user.FieldPhone:
+			values[i] = &sql.NullString{}
+		case user.FieldPassword:
+			values[i] = &sql.NullString{}
+		case user.FieldRole:
+			values[i] = &sql.NullString{}
+		case user.FieldSSOCert:
+			values[i] = &sql.NullString{}
+		case user.ForeignKeys[0]: // group_blocked
+			values[i] = &sql.NullInt64{}
+		case user.ForeignKeys[1]: // user_spouse
+			values[i] = &sql.NullInt64{}
+		case user.ForeignKeys[2]: // user_parent
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the User fields.
-func (u *User) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(user.Columns); m < n {
+// to the User fields. Values of columns that were not selected are left untouched.
+func (u *User) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	u.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field optional_int", values[0])
-	} else if value.Valid {
-		u.OptionalInt = int(value.Int64)
-	}
-	if value, ok := values[1].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field age", values[1])
-	} else if value.Valid {
-		u.Age = int(value.Int64)
-	}
-	if value, ok := values[2].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[2])
-	} else if value.Valid {
-		u.Name = value.String
-	}
-	if value, ok := values[3].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field last", values[3])
-	} else if value.Valid {
-		u.Last = value.String
-	}
-	if value, ok := values[4].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field nickname", values[4])
-	} else if value.Valid {
-		u.Nickname = value.String
-	}
-	if value, ok := values[5].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field phone", values[5])
-	} else if value.Valid {
-		u.Phone = value.String
-	}
-	if value, ok := values[6].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field password", values[6])
-	} else if value.Valid {
-		u.Password = value.String
-	}
-	if value, ok := values[7].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field role", values[7])
-	} else if value.Valid {
-		u.Role = user.Role(value.String)
-	}
-	if value, ok := values[8].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field SSOCert", values[8])
-	} else if value.Valid {
-		u.SSOCert = value.String
-	}
-	values = values[9:]
-	if len(values) == len(user.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field group_blocked", value)
-		} else if value.Valid {
-			u.group_blocked = new(int)
-			*u.group_blocked = int(value.Int64)
-		}
-		if value, ok := values[1].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_spouse", value)
-		} else if value.Valid {
-			u.user_spouse = new(int)
-			*u.user_spouse = int(value.Int64)
-		}
-		if value, ok := values[2].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_parent", value)
-		} else if value.Valid {
-			u.user_parent = new(int)
-			*u.user_parent = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case user.FieldOptionalInt:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field optional_int", values[i])
+			} else if value.Valid {
+				u.OptionalInt = int(value.Int64)
+			}
+		case user.FieldAge:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field age", values[i])
+			} else if value.Valid {
+				u.Age = int(value.Int64)
+			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				u.Name = value.String
+			}
+		case user.FieldLast:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last", values[i])
+			} else if value.Valid {
+				u.Last = value.String
+			}
+		case user.FieldNickname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nickname", values[i])
+			} else if value.Valid {
+				u.Nickname = value.String
+			}
+		case user.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				u.Phone = value.String
+			}
+		case user.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				u.Password = value.String
+			}
+		case user.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				u.Role = user.Role(value.String)
+			}
+		case user.FieldSSOCert:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field SSOCert", values[i])
+			} else if value.Valid {
+				u.SSOCert = value.String
+			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field group_blocked", value)
+			} else if value.Valid {
+				u.group_blocked = new(int)
+				*u.group_blocked = int(value.Int64)
+			}
+		case user.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_spouse", value)
+			} else if value.Valid {
+				u.user_spouse = new(int)
+				*u.user_spouse = int(value.Int64)
+			}
+		case user.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_parent", value)
+			} else if value.Valid {
+				u.user_parent = new(int)
+				*u.user_parent = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (u *User) SelectValue(alias string) (Value, error) {
+	v, ok := u.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryCard queries the card edge of the User.
 func (u *User) QueryCard() *CardQuery {
 	return (&UserClient{config: u.config}).QueryCard(u)
@@ -356,6 +403,545 @@ func (u *User) QueryParent() *UserQuery {
 	return (&UserClient{config: u.config}).QueryParent(u)
 }
 
+// LoadEdges loads the given edges of the User using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see user.Edges), and in this case, no edge is loaded.
+//
+//	err := u.LoadEdges(ctx, client, user.EdgeCard, user.EdgePets)
+//
+func (u *User) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadUserEdges(ctx, client, []*User{u}, edges)
+}
+
+// loadUserEdges loads the given edges of all the given User entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using UserClient.LoadEdgeFor.
+func loadUserEdges(ctx context.Context, client *Client, nodes []*User, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case user.EdgeCard, user.EdgePets, user.EdgeFiles, user.EdgeGroups, user.EdgeFriends, user.EdgeFollowers, user.EdgeFollowing, user.EdgeTeam, user.EdgeSpouse, user.EdgeChildren, user.EdgeParent:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type User", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.User.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the User and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the User alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The User itself is not modified.
+//
+//	b, err := u.DumpGraph(ctx, client, 2, user.EdgeCard)
+//
+func (u *User) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for User DumpGraph", depth)
+	}
+	dumped := *u
+	dumped.Edges = UserEdges{}
+	if err := dumpUsers(ctx, client, []*User{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpUsers loads the edges of the given User copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpUsers(ctx context.Context, client *Client, nodes []*User, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*User
+	for _, n := range nodes {
+		if dumper.visit(user.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range user.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadUserEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextCard []*Card
+	for _, n := range visit {
+		if e := n.Edges.Card; e != nil {
+			c := *e
+			c.Edges = CardEdges{}
+			n.Edges.Card = &c
+			nextCard = append(nextCard, &c)
+		}
+	}
+	if err := dumpCards(ctx, client, nextCard, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextPets []*Pet
+	for _, n := range visit {
+		for i, e := range n.Edges.Pets {
+			c := *e
+			c.Edges = PetEdges{}
+			n.Edges.Pets[i] = &c
+			nextPets = append(nextPets, &c)
+		}
+	}
+	if err := dumpPets(ctx, client, nextPets, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFiles []*File
+	for _, n := range visit {
+		for i, e := range n.Edges.Files {
+			c := *e
+			c.Edges = FileEdges{}
+			n.Edges.Files[i] = &c
+			nextFiles = append(nextFiles, &c)
+		}
+	}
+	if err := dumpFiles(ctx, client, nextFiles, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextGroups []*Group
+	for _, n := range visit {
+		for i, e := range n.Edges.Groups {
+			c := *e
+			c.Edges = GroupEdges{}
+			n.Edges.Groups[i] = &c
+			nextGroups = append(nextGroups, &c)
+		}
+	}
+	if err := dumpGroups(ctx, client, nextGroups, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFriends []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Friends {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Friends[i] = &c
+			nextFriends = append(nextFriends, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextFriends, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFollowers []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Followers {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Followers[i] = &c
+			nextFollowers = append(nextFollowers, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextFollowers, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFollowing []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Following {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Following[i] = &c
+			nextFollowing = append(nextFollowing, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextFollowing, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextTeam []*Pet
+	for _, n := range visit {
+		if e := n.Edges.Team; e != nil {
+			c := *e
+			c.Edges = PetEdges{}
+			n.Edges.Team = &c
+			nextTeam = append(nextTeam, &c)
+		}
+	}
+	if err := dumpPets(ctx, client, nextTeam, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextSpouse []*User
+	for _, n := range visit {
+		if e := n.Edges.Spouse; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Spouse = &c
+			nextSpouse = append(nextSpouse, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextSpouse, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextChildren []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Children {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Children[i] = &c
+			nextChildren = append(nextChildren, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextChildren, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextParent []*User
+	for _, n := range visit {
+		if e := n.Edges.Parent; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Parent = &c
+			nextParent = append(nextParent, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextParent, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddFriendsByField adds the "friends" edges of the User to the User entities that are resolved by the
+// given values of one of their unique fields (e.g. user.FieldNickname). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := u.AddFriendsByField(ctx, client, user.FieldNickname, v1, v2)
+//
+func (u *User) AddFriendsByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := u.addFriendsByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddFriendsByFieldOrCreate is like AddFriendsByField, but creates the User entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (u *User) AddFriendsByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*User, error) {
+	return u.addFriendsByField(ctx, client, true, field, values)
+}
+
+func (u *User) addFriendsByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*User, error) {
+	var (
+		where predicate.User
+		key   func(*User) interface{}
+	)
+	switch field {
+	case user.FieldNickname:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field nickname", v)
+			}
+			vs[i] = tv
+		}
+		where = user.NicknameIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Nickname
+		}
+	case user.FieldPhone:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field phone", v)
+			}
+			vs[i] = tv
+		}
+		where = user.PhoneIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Phone
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type User", field)
+	}
+	nodes, err := client.User.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*UserCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{user.Label}
+		}
+		builder := client.User.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*User
+	if len(builders) > 0 {
+		if created, err = client.User.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ids := make([]int, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.User.QueryFriends(u).Where(user.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[int]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.User.UpdateOneID(u.ID).AddFriendIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// AddFollowersByField adds the "followers" edges of the User to the User entities that are resolved by the
+// given values of one of their unique fields (e.g. user.FieldNickname). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := u.AddFollowersByField(ctx, client, user.FieldNickname, v1, v2)
+//
+func (u *User) AddFollowersByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := u.addFollowersByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddFollowersByFieldOrCreate is like AddFollowersByField, but creates the User entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (u *User) AddFollowersByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*User, error) {
+	return u.addFollowersByField(ctx, client, true, field, values)
+}
+
+func (u *User) addFollowersByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*User, error) {
+	var (
+		where predicate.User
+		key   func(*User) interface{}
+	)
+	switch field {
+	case user.FieldNickname:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field nickname", v)
+			}
+			vs[i] = tv
+		}
+		where = user.NicknameIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Nickname
+		}
+	case user.FieldPhone:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field phone", v)
+			}
+			vs[i] = tv
+		}
+		where = user.PhoneIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Phone
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type User", field)
+	}
+	nodes, err := client.User.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*UserCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{user.Label}
+		}
+		builder := client.User.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*User
+	if len(builders) > 0 {
+		if created, err = client.User.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ids := make([]int, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.User.QueryFollowers(u).Where(user.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[int]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.User.UpdateOneID(u.ID).AddFollowerIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// AddFollowingByField adds the "following" edges of the User to the User entities that are resolved by the
+// given values of one of their unique fields (e.g. user.FieldNickname). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := u.AddFollowingByField(ctx, client, user.FieldNickname, v1, v2)
+//
+func (u *User) AddFollowingByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := u.addFollowingByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddFollowingByFieldOrCreate is like AddFollowingByField, but creates the User entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (u *User) AddFollowingByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*User, error) {
+	return u.addFollowingByField(ctx, client, true, field, values)
+}
+
+func (u *User) addFollowingByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*User, error) {
+	var (
+		where predicate.User
+		key   func(*User) interface{}
+	)
+	switch field {
+	case user.FieldNickname:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field nickname", v)
+			}
+			vs[i] = tv
+		}
+		where = user.NicknameIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Nickname
+		}
+	case user.FieldPhone:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field phone", v)
+			}
+			vs[i] = tv
+		}
+		where = user.PhoneIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Phone
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type User", field)
+	}
+	nodes, err := client.User.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*UserCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{user.Label}
+		}
+		builder := client.User.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*User
+	if len(builders) > 0 {
+		if created, err = client.User.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ids := make([]int, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.User.QueryFollowing(u).Where(user.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[int]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.User.UpdateOneID(u.ID).AddFollowingIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -374,6 +960,72 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the User and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if v, ok := seen[u]; ok {
+		return v.(*User)
+	}
+	_c := *u
+	seen[u] = &_c
+	_c.Edges.Card = u.Edges.Card.clone(seen)
+	if u.Edges.Pets != nil {
+		_c.Edges.Pets = make([]*Pet, len(u.Edges.Pets))
+		for _i := range u.Edges.Pets {
+			_c.Edges.Pets[_i] = u.Edges.Pets[_i].clone(seen)
+		}
+	}
+	if u.Edges.Files != nil {
+		_c.Edges.Files = make([]*File, len(u.Edges.Files))
+		for _i := range u.Edges.Files {
+			_c.Edges.Files[_i] = u.Edges.Files[_i].clone(seen)
+		}
+	}
+	if u.Edges.Groups != nil {
+		_c.Edges.Groups = make([]*Group, len(u.Edges.Groups))
+		for _i := range u.Edges.Groups {
+			_c.Edges.Groups[_i] = u.Edges.Groups[_i].clone(seen)
+		}
+	}
+	if u.Edges.Friends != nil {
+		_c.Edges.Friends = make([]*User, len(u.Edges.Friends))
+		for _i := range u.Edges.Friends {
+			_c.Edges.Friends[_i] = u.Edges.Friends[_i].clone(seen)
+		}
+	}
+	if u.Edges.Followers != nil {
+		_c.Edges.Followers = make([]*User, len(u.Edges.Followers))
+		for _i := range u.Edges.Followers {
+			_c.Edges.Followers[_i] = u.Edges.Followers[_i].clone(seen)
+		}
+	}
+	if u.Edges.Following != nil {
+		_c.Edges.Following = make([]*User, len(u.Edges.Following))
+		for _i := range u.Edges.Following {
+			_c.Edges.Following[_i] = u.Edges.Following[_i].clone(seen)
+		}
+	}
+	_c.Edges.Team = u.Edges.Team.clone(seen)
+	_c.Edges.Spouse = u.Edges.Spouse.clone(seen)
+	if u.Edges.Children != nil {
+		_c.Edges.Children = make([]*User, len(u.Edges.Children))
+		for _i := range u.Edges.Children {
+			_c.Edges.Children[_i] = u.Edges.Children[_i].clone(seen)
+		}
+	}
+	_c.Edges.Parent = u.Edges.Parent.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	var builder strings.Builder
@@ -391,7 +1043,8 @@ func (u *User) String() string {
 	builder.WriteString(u.Nickname)
 	builder.WriteString(", phone=")
 	builder.WriteString(u.Phone)
-	builder.WriteString(", password=<sensitive>")
+	builder.WriteString(", password=")
+	builder.WriteString(u.sensitiveMask(TypeUser, user.FieldPassword))
 	builder.WriteString(", role=")
 	builder.WriteString(fmt.Sprintf("%v", u.Role))
 	builder.WriteString(", SSOCert=")
@@ -400,6 +1053,49 @@ func (u *User) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the User, for change detection and cache keys.
+// It covers the fields that are listed in user.FingerprintFields, and it does not depend on the
+// ID of the User, on its edges, or on the order of the fields in the schema.
+func (u *User) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "SSOCert", u.SSOCert)
+	fingerprint(h, "age", u.Age)
+	fingerprint(h, "last", u.Last)
+	fingerprint(h, "name", u.Name)
+	fingerprint(h, "nickname", u.Nickname)
+	fingerprint(h, "optional_int", u.OptionalInt)
+	fingerprint(h, "password", u.Password)
+	fingerprint(h, "phone", u.Phone)
+	fingerprint(h, "role", u.Role)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the User for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The User can be decoded using the FromMap method of its client.
+func (u *User) ToMap() map[string]string {
+	m := make(map[string]string, 10)
+	m[user.FieldID] = kvEncode(u.ID)
+	if v := u.OptionalInt; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldOptionalInt] = kvEncode(v)
+	}
+	m[user.FieldAge] = kvEncode(u.Age)
+	m[user.FieldName] = kvEncode(u.Name)
+	m[user.FieldLast] = kvEncode(u.Last)
+	if v := u.Nickname; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldNickname] = kvEncode(v)
+	}
+	if v := u.Phone; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldPhone] = kvEncode(v)
+	}
+	m[user.FieldRole] = kvEncode(u.Role)
+	if v := u.SSOCert; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldSSOCert] = kvEncode(v)
+	}
+	return m
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
@@ -103,6 +103,35 @@ const (
 	ParentColumn = "user_parent"
 )
 
+// Edges holds all the edge names of the User type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeCard,
+	EdgePets,
+	EdgeFiles,
+	EdgeGroups,
+	EdgeFriends,
+	EdgeFollowers,
+	EdgeFollowing,
+	EdgeTeam,
+	EdgeSpouse,
+	EdgeChildren,
+	EdgeParent,
+}
+
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldSSOCert,
+	FieldAge,
+	FieldLast,
+	FieldName,
+	FieldNickname,
+	FieldOptionalInt,
+	FieldPassword,
+	FieldPhone,
+	FieldRole,
+}
+
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
@@ -124,6 +153,16 @@ var ForeignKeys = []string{
 	"user_parent",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// GroupsPrimaryKey and GroupsColumn2 are the table columns denoting the
 	// primary key for the groups relation (M2M).
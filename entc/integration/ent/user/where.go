@@ -7,6 +7,8 @@
 package user
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
@@ -400,6 +402,13 @@ func NameContains(v string) predicate.User {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -407,6 +416,13 @@ func NameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -414,6 +430,13 @@ func NameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -428,6 +451,20 @@ func NameContainsFold(v string) predicate.User {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
 // LastEQ applies the EQ predicate on the "last" field.
 func LastEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -511,6 +548,13 @@ func LastContains(v string) predicate.User {
 	})
 }
 
+// LastNotContains applies the NotContains predicate on the "last" field.
+func LastNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldLast), v))
+	})
+}
+
 // LastHasPrefix applies the HasPrefix predicate on the "last" field.
 func LastHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -518,6 +562,13 @@ func LastHasPrefix(v string) predicate.User {
 	})
 }
 
+// LastNotHasPrefix applies the NotHasPrefix predicate on the "last" field.
+func LastNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldLast), v))
+	})
+}
+
 // LastHasSuffix applies the HasSuffix predicate on the "last" field.
 func LastHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -525,6 +576,13 @@ func LastHasSuffix(v string) predicate.User {
 	})
 }
 
+// LastNotHasSuffix applies the NotHasSuffix predicate on the "last" field.
+func LastNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldLast), v))
+	})
+}
+
 // LastEqualFold applies the EqualFold predicate on the "last" field.
 func LastEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -539,6 +597,20 @@ func LastContainsFold(v string) predicate.User {
 	})
 }
 
+// LastHasPrefixFold applies the HasPrefixFold predicate on the "last" field.
+func LastHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldLast), v))
+	})
+}
+
+// LastHasSuffixFold applies the HasSuffixFold predicate on the "last" field.
+func LastHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldLast), v))
+	})
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -622,6 +694,13 @@ func NicknameContains(v string) predicate.User {
 	})
 }
 
+// NicknameNotContains applies the NotContains predicate on the "nickname" field.
+func NicknameNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameHasPrefix applies the HasPrefix predicate on the "nickname" field.
 func NicknameHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -629,6 +708,13 @@ func NicknameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasPrefix applies the NotHasPrefix predicate on the "nickname" field.
+func NicknameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameHasSuffix applies the HasSuffix predicate on the "nickname" field.
 func NicknameHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -636,6 +722,13 @@ func NicknameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasSuffix applies the NotHasSuffix predicate on the "nickname" field.
+func NicknameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameIsNil applies the IsNil predicate on the "nickname" field.
 func NicknameIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -664,6 +757,20 @@ func NicknameContainsFold(v string) predicate.User {
 	})
 }
 
+// NicknameHasPrefixFold applies the HasPrefixFold predicate on the "nickname" field.
+func NicknameHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNickname), v))
+	})
+}
+
+// NicknameHasSuffixFold applies the HasSuffixFold predicate on the "nickname" field.
+func NicknameHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNickname), v))
+	})
+}
+
 // PhoneEQ applies the EQ predicate on the "phone" field.
 func PhoneEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -747,6 +854,13 @@ func PhoneContains(v string) predicate.User {
 	})
 }
 
+// PhoneNotContains applies the NotContains predicate on the "phone" field.
+func PhoneNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldPhone), v))
+	})
+}
+
 // PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
 func PhoneHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -754,6 +868,13 @@ func PhoneHasPrefix(v string) predicate.User {
 	})
 }
 
+// PhoneNotHasPrefix applies the NotHasPrefix predicate on the "phone" field.
+func PhoneNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldPhone), v))
+	})
+}
+
 // PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
 func PhoneHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -761,6 +882,13 @@ func PhoneHasSuffix(v string) predicate.User {
 	})
 }
 
+// PhoneNotHasSuffix applies the NotHasSuffix predicate on the "phone" field.
+func PhoneNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldPhone), v))
+	})
+}
+
 // PhoneIsNil applies the IsNil predicate on the "phone" field.
 func PhoneIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -789,6 +917,20 @@ func PhoneContainsFold(v string) predicate.User {
 	})
 }
 
+// PhoneHasPrefixFold applies the HasPrefixFold predicate on the "phone" field.
+func PhoneHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneHasSuffixFold applies the HasSuffixFold predicate on the "phone" field.
+func PhoneHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldPhone), v))
+	})
+}
+
 // PasswordEQ applies the EQ predicate on the "password" field.
 func PasswordEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -872,6 +1014,13 @@ func PasswordContains(v string) predicate.User {
 	})
 }
 
+// PasswordNotContains applies the NotContains predicate on the "password" field.
+func PasswordNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldPassword), v))
+	})
+}
+
 // PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
 func PasswordHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -879,6 +1028,13 @@ func PasswordHasPrefix(v string) predicate.User {
 	})
 }
 
+// PasswordNotHasPrefix applies the NotHasPrefix predicate on the "password" field.
+func PasswordNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldPassword), v))
+	})
+}
+
 // PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
 func PasswordHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -886,6 +1042,13 @@ func PasswordHasSuffix(v string) predicate.User {
 	})
 }
 
+// PasswordNotHasSuffix applies the NotHasSuffix predicate on the "password" field.
+func PasswordNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldPassword), v))
+	})
+}
+
 // PasswordIsNil applies the IsNil predicate on the "password" field.
 func PasswordIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -914,6 +1077,20 @@ func PasswordContainsFold(v string) predicate.User {
 	})
 }
 
+// PasswordHasPrefixFold applies the HasPrefixFold predicate on the "password" field.
+func PasswordHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldPassword), v))
+	})
+}
+
+// PasswordHasSuffixFold applies the HasSuffixFold predicate on the "password" field.
+func PasswordHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldPassword), v))
+	})
+}
+
 // RoleEQ applies the EQ predicate on the "role" field.
 func RoleEQ(v Role) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1045,6 +1222,13 @@ func SSOCertContains(v string) predicate.User {
 	})
 }
 
+// SSOCertNotContains applies the NotContains predicate on the "SSOCert" field.
+func SSOCertNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldSSOCert), v))
+	})
+}
+
 // SSOCertHasPrefix applies the HasPrefix predicate on the "SSOCert" field.
 func SSOCertHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1052,6 +1236,13 @@ func SSOCertHasPrefix(v string) predicate.User {
 	})
 }
 
+// SSOCertNotHasPrefix applies the NotHasPrefix predicate on the "SSOCert" field.
+func SSOCertNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldSSOCert), v))
+	})
+}
+
 // SSOCertHasSuffix applies the HasSuffix predicate on the "SSOCert" field.
 func SSOCertHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1059,6 +1250,13 @@ func SSOCertHasSuffix(v string) predicate.User {
 	})
 }
 
+// SSOCertNotHasSuffix applies the NotHasSuffix predicate on the "SSOCert" field.
+func SSOCertNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldSSOCert), v))
+	})
+}
+
 // SSOCertIsNil applies the IsNil predicate on the "SSOCert" field.
 func SSOCertIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1087,6 +1285,148 @@ func SSOCertContainsFold(v string) predicate.User {
 	})
 }
 
+// SSOCertHasPrefixFold applies the HasPrefixFold predicate on the "SSOCert" field.
+func SSOCertHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldSSOCert), v))
+	})
+}
+
+// SSOCertHasSuffixFold applies the HasSuffixFold predicate on the "SSOCert" field.
+func SSOCertHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldSSOCert), v))
+	})
+}
+
+// OptionalIntEQNullSafe applies a NULL-safe equality predicate on the "optional_int" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func OptionalIntEQNullSafe(v *int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldOptionalInt), arg))
+	})
+}
+
+// NicknameEQNullSafe applies a NULL-safe equality predicate on the "nickname" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NicknameEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNickname), arg))
+	})
+}
+
+// PhoneEQNullSafe applies a NULL-safe equality predicate on the "phone" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func PhoneEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldPhone), arg))
+	})
+}
+
+// PasswordEQNullSafe applies a NULL-safe equality predicate on the "password" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func PasswordEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldPassword), arg))
+	})
+}
+
+// SSOCertEQNullSafe applies a NULL-safe equality predicate on the "SSOCert" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func SSOCertEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldSSOCert), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldAge:         "numeric",
+	FieldID:          "numeric",
+	FieldLast:        "string",
+	FieldName:        "string",
+	FieldNickname:    "string",
+	FieldOptionalInt: "numeric",
+	FieldPassword:    "string",
+	FieldPhone:       "string",
+	FieldRole:        "string",
+	FieldSSOCert:     "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the User.
+func FieldsEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the User.
+func FieldsNEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the User.
+//
+//	user.FieldsLT(user.FieldOptionalInt, user.FieldAge)
+//
+func FieldsLT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the User.
+func FieldsLTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the User.
+func FieldsGT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the User.
+func FieldsGTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the User using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of User", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of User", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of User are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasCard applies the HasEdge predicate on the "card" edge.
 func HasCard() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1339,6 +1679,45 @@ func HasSpouseWith(preds ...predicate.User) predicate.User {
 	})
 }
 
+// SpouseIDIn applies a predicate on the "spouse" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	user.SpouseIDIn(client.User.Query().Where(...))
+//
+func SpouseIDIn(q sqlgraph.IDsQuerier) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(SpouseColumn), sub))
+	})
+}
+
+// SpouseMissing applies a predicate on the "spouse" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_spouse IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_spouse)
+//
+func SpouseMissing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("spouse")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(SpouseColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(SpouseColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasChildren applies the HasEdge predicate on the "children" edge.
 func HasChildren() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1395,6 +1774,45 @@ func HasParentWith(preds ...predicate.User) predicate.User {
 	})
 }
 
+// ParentIDIn applies a predicate on the "parent" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	user.ParentIDIn(client.User.Query().Where(...))
+//
+func ParentIDIn(q sqlgraph.IDsQuerier) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(ParentColumn), sub))
+	})
+}
+
+// ParentMissing applies a predicate on the "parent" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_parent IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_parent)
+//
+func ParentMissing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("parent")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(ParentColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(ParentColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1402,6 +1820,9 @@ func And(predicates ...predicate.User) predicate.User {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -1416,6 +1837,9 @@ func Or(predicates ...predicate.User) predicate.User {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -1426,3 +1850,81 @@ func Not(p predicate.User) predicate.User {
 		p(s.Not())
 	})
 }
+
+// UserFilter holds optional filters on the User fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type UserFilter struct {
+	IDIn        []int
+	OptionalInt *int
+	Age         *int
+	Name        *string
+	Last        *string
+	Nickname    *string
+	Phone       *string
+	Role        *Role
+	SSOCert     *string
+	SpouseIDIn  []int
+	ParentIDIn  []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.User.Query().
+//		Where(user.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *UserFilter) []predicate.User {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.User
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.OptionalInt != nil {
+		ps = append(ps, OptionalIntEQ(*f.OptionalInt))
+	}
+	if f.Age != nil {
+		ps = append(ps, AgeEQ(*f.Age))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.Last != nil {
+		ps = append(ps, LastEQ(*f.Last))
+	}
+	if f.Nickname != nil {
+		ps = append(ps, NicknameEQ(*f.Nickname))
+	}
+	if f.Phone != nil {
+		ps = append(ps, PhoneEQ(*f.Phone))
+	}
+	if f.Role != nil {
+		ps = append(ps, RoleEQ(*f.Role))
+	}
+	if f.SSOCert != nil {
+		ps = append(ps, SSOCertEQ(*f.SSOCert))
+	}
+	if ids := f.SpouseIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.User(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(SpouseColumn), v...))
+		}))
+	}
+	if ids := f.ParentIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.User(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(ParentColumn), v...))
+		}))
+	}
+	return ps
+}
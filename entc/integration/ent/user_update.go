@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -27,6 +28,9 @@ type UserUpdate struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -35,6 +39,13 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Limit limits the number of users that are updated by the builder. For example,
+// for updating large amounts of users in small batches without locking the whole table.
+func (uu *UserUpdate) Limit(limit int) *UserUpdate {
+	uu.limit = &limit
+	return uu
+}
+
 // SetOptionalInt sets the optional_int field.
 func (uu *UserUpdate) SetOptionalInt(i int) *UserUpdate {
 	uu.mutation.ResetOptionalInt()
@@ -62,6 +73,14 @@ func (uu *UserUpdate) ClearOptionalInt() *UserUpdate {
 	return uu
 }
 
+// UnsetOptionalInt removes the changes of the optional_int field from the builder (e.g. a previous call
+// to SetOptionalInt), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt.
+func (uu *UserUpdate) UnsetOptionalInt() *UserUpdate {
+	uu.mutation.ResetOptionalInt()
+	return uu
+}
+
 // SetAge sets the age field.
 func (uu *UserUpdate) SetAge(i int) *UserUpdate {
 	uu.mutation.ResetAge()
@@ -75,12 +94,26 @@ func (uu *UserUpdate) AddAge(i int) *UserUpdate {
 	return uu
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetAge() *UserUpdate {
+	uu.mutation.ResetAge()
+	return uu
+}
+
 // SetName sets the name field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
 	return uu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetName() *UserUpdate {
+	uu.mutation.ResetName()
+	return uu
+}
+
 // SetLast sets the last field.
 func (uu *UserUpdate) SetLast(s string) *UserUpdate {
 	uu.mutation.SetLast(s)
@@ -95,6 +128,13 @@ func (uu *UserUpdate) SetNillableLast(s *string) *UserUpdate {
 	return uu
 }
 
+// UnsetLast removes the changes of the last field from the builder (e.g. a previous call
+// to SetLast), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetLast() *UserUpdate {
+	uu.mutation.ResetLast()
+	return uu
+}
+
 // SetNickname sets the nickname field.
 func (uu *UserUpdate) SetNickname(s string) *UserUpdate {
 	uu.mutation.SetNickname(s)
@@ -115,6 +155,14 @@ func (uu *UserUpdate) ClearNickname() *UserUpdate {
 	return uu
 }
 
+// UnsetNickname removes the changes of the nickname field from the builder (e.g. a previous call
+// to SetNickname), and therefore, the field is left unchanged in the database. Unlike ClearNickname,
+// it does not set the field to NULL, and also removes a previous call to ClearNickname.
+func (uu *UserUpdate) UnsetNickname() *UserUpdate {
+	uu.mutation.ResetNickname()
+	return uu
+}
+
 // SetPhone sets the phone field.
 func (uu *UserUpdate) SetPhone(s string) *UserUpdate {
 	uu.mutation.SetPhone(s)
@@ -135,6 +183,14 @@ func (uu *UserUpdate) ClearPhone() *UserUpdate {
 	return uu
 }
 
+// UnsetPhone removes the changes of the phone field from the builder (e.g. a previous call
+// to SetPhone), and therefore, the field is left unchanged in the database. Unlike ClearPhone,
+// it does not set the field to NULL, and also removes a previous call to ClearPhone.
+func (uu *UserUpdate) UnsetPhone() *UserUpdate {
+	uu.mutation.ResetPhone()
+	return uu
+}
+
 // SetPassword sets the password field.
 func (uu *UserUpdate) SetPassword(s string) *UserUpdate {
 	uu.mutation.SetPassword(s)
@@ -155,6 +211,14 @@ func (uu *UserUpdate) ClearPassword() *UserUpdate {
 	return uu
 }
 
+// UnsetPassword removes the changes of the password field from the builder (e.g. a previous call
+// to SetPassword), and therefore, the field is left unchanged in the database. Unlike ClearPassword,
+// it does not set the field to NULL, and also removes a previous call to ClearPassword.
+func (uu *UserUpdate) UnsetPassword() *UserUpdate {
+	uu.mutation.ResetPassword()
+	return uu
+}
+
 // SetRole sets the role field.
 func (uu *UserUpdate) SetRole(u user.Role) *UserUpdate {
 	uu.mutation.SetRole(u)
@@ -169,6 +233,13 @@ func (uu *UserUpdate) SetNillableRole(u *user.Role) *UserUpdate {
 	return uu
 }
 
+// UnsetRole removes the changes of the role field from the builder (e.g. a previous call
+// to SetRole), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetRole() *UserUpdate {
+	uu.mutation.ResetRole()
+	return uu
+}
+
 // SetSSOCert sets the SSOCert field.
 func (uu *UserUpdate) SetSSOCert(s string) *UserUpdate {
 	uu.mutation.SetSSOCert(s)
@@ -189,6 +260,14 @@ func (uu *UserUpdate) ClearSSOCert() *UserUpdate {
 	return uu
 }
 
+// UnsetSSOCert removes the changes of the SSOCert field from the builder (e.g. a previous call
+// to SetSSOCert), and therefore, the field is left unchanged in the database. Unlike ClearSSOCert,
+// it does not set the field to NULL, and also removes a previous call to ClearSSOCert.
+func (uu *UserUpdate) UnsetSSOCert() *UserUpdate {
+	uu.mutation.ResetSSOCert()
+	return uu
+}
+
 // SetCardID sets the card edge to Card by id.
 func (uu *UserUpdate) SetCardID(id int) *UserUpdate {
 	uu.mutation.SetCardID(id)
@@ -499,19 +578,33 @@ func (uu *UserUpdate) ClearParent() *UserUpdate {
 	return uu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uu *UserUpdate) Unwrap() *UserUpdate {
+	tx, ok := uu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdate is not a transactional builder")
+	}
+	uu.config.driver = tx.drv
+	uu.mutation.driver = tx.drv
+	return uu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uu *UserUpdate) DebugFields() map[string]Value {
+	return debugFields(uu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := uu.mutation.OptionalInt(); ok {
-		if err := user.OptionalIntValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)
-		}
+	if err := checkTx(uu.driver); err != nil {
+		return 0, err
 	}
-	if v, ok := uu.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"role\": %v", err)
-		}
+	if err := uu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -560,7 +653,35 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.OptionalInt(); ok {
+		if err := user.OptionalIntValidator(v); err != nil {
+			return &ValidationError{Name: "optional_int", err: fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)}
+		}
+	}
+	if v, ok := uu.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %v", err)}
+		}
+	}
+	if uu.mutation.conflictcard {
+		return &ValidationError{Name: "card", err: errors.New("ent: setting and clearing the unique edge \"card\" in the same mutation")}
+	}
+	if uu.mutation.conflictteam {
+		return &ValidationError{Name: "team", err: errors.New("ent: setting and clearing the unique edge \"team\" in the same mutation")}
+	}
+	if uu.mutation.conflictspouse {
+		return &ValidationError{Name: "spouse", err: errors.New("ent: setting and clearing the unique edge \"spouse\" in the same mutation")}
+	}
+	if uu.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("ent: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = uu.withOperation(ctx, "User", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -578,6 +699,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := uu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if uu.ids != nil {
+		_spec.ScanIDs = uu.ids
+	}
 	if value, ok := uu.mutation.OptionalInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -655,7 +782,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.Password(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
-			Value:  value,
+			Value:  sql.MaskValue(value, uu.sensitiveMask(TypeUser, user.FieldPassword)),
 			Column: user.FieldPassword,
 		})
 	}
@@ -1091,7 +1218,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -1102,11 +1229,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the User entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (uu *UserUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	uu.ids = &ids
+	defer func() { uu.ids = nil }()
+	if _, err := uu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks      []Hook
+	mutation   *UserMutation
+	predicates []predicate.User
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.predicates = append(uuo.predicates, ps...)
+	return uuo
 }
 
 // SetOptionalInt sets the optional_int field.
@@ -1136,6 +1285,14 @@ func (uuo *UserUpdateOne) ClearOptionalInt() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetOptionalInt removes the changes of the optional_int field from the builder (e.g. a previous call
+// to SetOptionalInt), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt.
+func (uuo *UserUpdateOne) UnsetOptionalInt() *UserUpdateOne {
+	uuo.mutation.ResetOptionalInt()
+	return uuo
+}
+
 // SetAge sets the age field.
 func (uuo *UserUpdateOne) SetAge(i int) *UserUpdateOne {
 	uuo.mutation.ResetAge()
@@ -1149,12 +1306,26 @@ func (uuo *UserUpdateOne) AddAge(i int) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetAge() *UserUpdateOne {
+	uuo.mutation.ResetAge()
+	return uuo
+}
+
 // SetName sets the name field.
 func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	uuo.mutation.SetName(s)
 	return uuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetName() *UserUpdateOne {
+	uuo.mutation.ResetName()
+	return uuo
+}
+
 // SetLast sets the last field.
 func (uuo *UserUpdateOne) SetLast(s string) *UserUpdateOne {
 	uuo.mutation.SetLast(s)
@@ -1169,6 +1340,13 @@ func (uuo *UserUpdateOne) SetNillableLast(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetLast removes the changes of the last field from the builder (e.g. a previous call
+// to SetLast), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetLast() *UserUpdateOne {
+	uuo.mutation.ResetLast()
+	return uuo
+}
+
 // SetNickname sets the nickname field.
 func (uuo *UserUpdateOne) SetNickname(s string) *UserUpdateOne {
 	uuo.mutation.SetNickname(s)
@@ -1189,6 +1367,14 @@ func (uuo *UserUpdateOne) ClearNickname() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetNickname removes the changes of the nickname field from the builder (e.g. a previous call
+// to SetNickname), and therefore, the field is left unchanged in the database. Unlike ClearNickname,
+// it does not set the field to NULL, and also removes a previous call to ClearNickname.
+func (uuo *UserUpdateOne) UnsetNickname() *UserUpdateOne {
+	uuo.mutation.ResetNickname()
+	return uuo
+}
+
 // SetPhone sets the phone field.
 func (uuo *UserUpdateOne) SetPhone(s string) *UserUpdateOne {
 	uuo.mutation.SetPhone(s)
@@ -1209,6 +1395,14 @@ func (uuo *UserUpdateOne) ClearPhone() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetPhone removes the changes of the phone field from the builder (e.g. a previous call
+// to SetPhone), and therefore, the field is left unchanged in the database. Unlike ClearPhone,
+// it does not set the field to NULL, and also removes a previous call to ClearPhone.
+func (uuo *UserUpdateOne) UnsetPhone() *UserUpdateOne {
+	uuo.mutation.ResetPhone()
+	return uuo
+}
+
 // SetPassword sets the password field.
 func (uuo *UserUpdateOne) SetPassword(s string) *UserUpdateOne {
 	uuo.mutation.SetPassword(s)
@@ -1229,6 +1423,14 @@ func (uuo *UserUpdateOne) ClearPassword() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetPassword removes the changes of the password field from the builder (e.g. a previous call
+// to SetPassword), and therefore, the field is left unchanged in the database. Unlike ClearPassword,
+// it does not set the field to NULL, and also removes a previous call to ClearPassword.
+func (uuo *UserUpdateOne) UnsetPassword() *UserUpdateOne {
+	uuo.mutation.ResetPassword()
+	return uuo
+}
+
 // SetRole sets the role field.
 func (uuo *UserUpdateOne) SetRole(u user.Role) *UserUpdateOne {
 	uuo.mutation.SetRole(u)
@@ -1243,6 +1445,13 @@ func (uuo *UserUpdateOne) SetNillableRole(u *user.Role) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetRole removes the changes of the role field from the builder (e.g. a previous call
+// to SetRole), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetRole() *UserUpdateOne {
+	uuo.mutation.ResetRole()
+	return uuo
+}
+
 // SetSSOCert sets the SSOCert field.
 func (uuo *UserUpdateOne) SetSSOCert(s string) *UserUpdateOne {
 	uuo.mutation.SetSSOCert(s)
@@ -1263,6 +1472,14 @@ func (uuo *UserUpdateOne) ClearSSOCert() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetSSOCert removes the changes of the SSOCert field from the builder (e.g. a previous call
+// to SetSSOCert), and therefore, the field is left unchanged in the database. Unlike ClearSSOCert,
+// it does not set the field to NULL, and also removes a previous call to ClearSSOCert.
+func (uuo *UserUpdateOne) UnsetSSOCert() *UserUpdateOne {
+	uuo.mutation.ResetSSOCert()
+	return uuo
+}
+
 // SetCardID sets the card edge to Card by id.
 func (uuo *UserUpdateOne) SetCardID(id int) *UserUpdateOne {
 	uuo.mutation.SetCardID(id)
@@ -1573,19 +1790,33 @@ func (uuo *UserUpdateOne) ClearParent() *UserUpdateOne {
 	return uuo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uuo *UserUpdateOne) Unwrap() *UserUpdateOne {
+	tx, ok := uuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdateOne is not a transactional builder")
+	}
+	uuo.config.driver = tx.drv
+	uuo.mutation.driver = tx.drv
+	return uuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uuo *UserUpdateOne) DebugFields() map[string]Value {
+	return debugFields(uuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	if v, ok := uuo.mutation.OptionalInt(); ok {
-		if err := user.OptionalIntValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)
-		}
+	if err := checkTx(uuo.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := uuo.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"role\": %v", err)
-		}
+	if err := uuo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *User
@@ -1634,7 +1865,35 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.OptionalInt(); ok {
+		if err := user.OptionalIntValidator(v); err != nil {
+			return &ValidationError{Name: "optional_int", err: fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)}
+		}
+	}
+	if v, ok := uuo.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %v", err)}
+		}
+	}
+	if uuo.mutation.conflictcard {
+		return &ValidationError{Name: "card", err: errors.New("ent: setting and clearing the unique edge \"card\" in the same mutation")}
+	}
+	if uuo.mutation.conflictteam {
+		return &ValidationError{Name: "team", err: errors.New("ent: setting and clearing the unique edge \"team\" in the same mutation")}
+	}
+	if uuo.mutation.conflictspouse {
+		return &ValidationError{Name: "spouse", err: errors.New("ent: setting and clearing the unique edge \"spouse\" in the same mutation")}
+	}
+	if uuo.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("ent: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
+	ctx = uuo.withOperation(ctx, "User", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -1650,6 +1909,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		return nil, fmt.Errorf("missing User.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := uuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.OptionalInt(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -1727,7 +1993,7 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 	if value, ok := uuo.mutation.Password(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
-			Value:  value,
+			Value:  sql.MaskValue(value, uuo.sensitiveMask(TypeUser, user.FieldPassword)),
 			Column: user.FieldPassword,
 		})
 	}
@@ -2164,9 +2430,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	u = &User{config: uuo.config}
-	_spec.Assign = u.assignValues
-	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return u.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = u.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, uuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -2174,5 +2442,37 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		u.ID = id
+		if value, ok := uuo.mutation.OptionalInt(); ok {
+			u.OptionalInt = value
+		}
+		if value, ok := uuo.mutation.Age(); ok {
+			u.Age = value
+		}
+		if value, ok := uuo.mutation.Name(); ok {
+			u.Name = value
+		}
+		if value, ok := uuo.mutation.Last(); ok {
+			u.Last = value
+		}
+		if value, ok := uuo.mutation.Nickname(); ok {
+			u.Nickname = value
+		}
+		if value, ok := uuo.mutation.Phone(); ok {
+			u.Phone = value
+		}
+		if value, ok := uuo.mutation.Password(); ok {
+			u.Password = value
+		}
+		if value, ok := uuo.mutation.Role(); ok {
+			u.Role = value
+		}
+		if value, ok := uuo.mutation.SSOCert(); ok {
+			u.SSOCert = value
+		}
+	}
 	return u, nil
 }
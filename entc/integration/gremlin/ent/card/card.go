@@ -7,7 +7,10 @@
 package card
 
 import (
+	"fmt"
 	"time"
+
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 )
 
 const (
@@ -18,7 +21,10 @@ const (
 	FieldCreateTime = "create_time" // FieldUpdateTime holds the string denoting the update_time vertex property in the database.
 	FieldUpdateTime = "update_time" // FieldNumber holds the string denoting the number vertex property in the database.
 	FieldNumber     = "number"      // FieldName holds the string denoting the name vertex property in the database.
-	FieldName       = "name"
+	FieldName       = "name"        // FieldExpiresAt holds the string denoting the expires_at vertex property in the database.
+	FieldExpiresAt  = "expires_at"  // FieldType holds the string denoting the type vertex property in the database.
+	FieldType       = "type"        // FieldNumberHash holds the string denoting the number_hash vertex property in the database.
+	FieldNumberHash = "number_hash"
 
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
@@ -31,6 +37,20 @@ const (
 	SpecInverseLabel = "spec_card"
 )
 
+// Edges holds all the edge names of the Card type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+	EdgeSpec,
+}
+
+// FingerprintFields holds the fields of the Card type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+	FieldNumber,
+	FieldType,
+}
+
 var (
 	// DefaultCreateTime holds the default value on creation for the create_time field.
 	DefaultCreateTime func() time.Time
@@ -42,4 +62,23 @@ var (
 	NumberValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// ExpiresAtLocation holds the location of the time values of the expires_at field.
+	ExpiresAtLocation *time.Location
 )
+
+// schema.CardType values.
+const (
+	TypeVisa schema.CardType = "visa"
+	TypeMc   schema.CardType = "mc"
+	TypeAmex schema.CardType = "amex"
+)
+
+// TypeValidator is a validator for the "_type" field enum values. It is called by the builders before save.
+func TypeValidator(_type schema.CardType) error {
+	switch _type {
+	case TypeVisa, TypeMc, TypeAmex:
+		return nil
+	default:
+		return fmt.Errorf("card: invalid enum value for type field: %q", _type)
+	}
+}
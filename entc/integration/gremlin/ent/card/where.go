@@ -12,6 +12,7 @@ import (
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/predicate"
 )
 
@@ -114,6 +115,20 @@ func Name(v string) predicate.Card {
 	})
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.EQ(v))
+	})
+}
+
+// NumberHash applies equality check predicate on the "number_hash" field. It's identical to NumberHashEQ.
+func NumberHash(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.EQ(v))
+	})
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -313,6 +328,13 @@ func NumberContains(v string) predicate.Card {
 	})
 }
 
+// NumberNotContains applies the NotContains predicate on the "number" field.
+func NumberNotContains(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumber, p.NotContaining(v))
+	})
+}
+
 // NumberHasPrefix applies the HasPrefix predicate on the "number" field.
 func NumberHasPrefix(v string) predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -320,6 +342,13 @@ func NumberHasPrefix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasPrefix applies the NotHasPrefix predicate on the "number" field.
+func NumberNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumber, p.NotStartingWith(v))
+	})
+}
+
 // NumberHasSuffix applies the HasSuffix predicate on the "number" field.
 func NumberHasSuffix(v string) predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -327,6 +356,13 @@ func NumberHasSuffix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasSuffix applies the NotHasSuffix predicate on the "number" field.
+func NumberNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumber, p.NotEndingWith(v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -398,6 +434,13 @@ func NameContains(v string) predicate.Card {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotContaining(v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -405,6 +448,13 @@ func NameHasPrefix(v string) predicate.Card {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotStartingWith(v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -412,6 +462,13 @@ func NameHasSuffix(v string) predicate.Card {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotEndingWith(v))
+	})
+}
+
 // NameIsNil applies the IsNil predicate on the "name" field.
 func NameIsNil() predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -426,6 +483,254 @@ func NameNotNil() predicate.Card {
 	})
 }
 
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.EQ(v))
+	})
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.NEQ(v))
+	})
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.Within(v...))
+	})
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.Without(v...))
+	})
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.GT(v))
+	})
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.GTE(v))
+	})
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.LT(v))
+	})
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldExpiresAt, p.LTE(v))
+	})
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldExpiresAt)
+	})
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldExpiresAt)
+	})
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v schema.CardType) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldType, p.EQ(v))
+	})
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v schema.CardType) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldType, p.NEQ(v))
+	})
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...schema.CardType) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldType, p.Within(v...))
+	})
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...schema.CardType) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldType, p.Without(v...))
+	})
+}
+
+// TypeIsNil applies the IsNil predicate on the "type" field.
+func TypeIsNil() predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldType)
+	})
+}
+
+// TypeNotNil applies the NotNil predicate on the "type" field.
+func TypeNotNil() predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldType)
+	})
+}
+
+// NumberHashEQ applies the EQ predicate on the "number_hash" field.
+func NumberHashEQ(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.EQ(v))
+	})
+}
+
+// NumberHashNEQ applies the NEQ predicate on the "number_hash" field.
+func NumberHashNEQ(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.NEQ(v))
+	})
+}
+
+// NumberHashIn applies the In predicate on the "number_hash" field.
+func NumberHashIn(vs ...string) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.Within(v...))
+	})
+}
+
+// NumberHashNotIn applies the NotIn predicate on the "number_hash" field.
+func NumberHashNotIn(vs ...string) predicate.Card {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.Without(v...))
+	})
+}
+
+// NumberHashGT applies the GT predicate on the "number_hash" field.
+func NumberHashGT(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.GT(v))
+	})
+}
+
+// NumberHashGTE applies the GTE predicate on the "number_hash" field.
+func NumberHashGTE(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.GTE(v))
+	})
+}
+
+// NumberHashLT applies the LT predicate on the "number_hash" field.
+func NumberHashLT(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.LT(v))
+	})
+}
+
+// NumberHashLTE applies the LTE predicate on the "number_hash" field.
+func NumberHashLTE(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.LTE(v))
+	})
+}
+
+// NumberHashContains applies the Contains predicate on the "number_hash" field.
+func NumberHashContains(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.Containing(v))
+	})
+}
+
+// NumberHashNotContains applies the NotContains predicate on the "number_hash" field.
+func NumberHashNotContains(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.NotContaining(v))
+	})
+}
+
+// NumberHashHasPrefix applies the HasPrefix predicate on the "number_hash" field.
+func NumberHashHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.StartingWith(v))
+	})
+}
+
+// NumberHashNotHasPrefix applies the NotHasPrefix predicate on the "number_hash" field.
+func NumberHashNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.NotStartingWith(v))
+	})
+}
+
+// NumberHashHasSuffix applies the HasSuffix predicate on the "number_hash" field.
+func NumberHashHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.EndingWith(v))
+	})
+}
+
+// NumberHashNotHasSuffix applies the NotHasSuffix predicate on the "number_hash" field.
+func NumberHashNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNumberHash, p.NotEndingWith(v))
+	})
+}
+
+// NumberHashIsNil applies the IsNil predicate on the "number_hash" field.
+func NumberHashIsNil() predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldNumberHash)
+	})
+}
+
+// NumberHashNotNil applies the NotNil predicate on the "number_hash" field.
+func NumberHashNotNil() predicate.Card {
+	return predicate.Card(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldNumberHash)
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.Card(func(t *dsl.Traversal) {
@@ -496,3 +801,78 @@ func Not(p predicate.Card) predicate.Card {
 		tr.Where(__.Not(t))
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// CardFilter holds optional filters on the Card fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CardFilter struct {
+	IDIn       []string
+	CreateTime *TimeRange
+	UpdateTime *TimeRange
+	Number     *string
+	Name       *string
+	ExpiresAt  *TimeRange
+	Type       *schema.CardType
+	NumberHash *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Card.Query().
+//		Where(card.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CardFilter) []predicate.Card {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Card
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if r := f.CreateTime; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, CreateTimeGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, CreateTimeLT(r.To))
+		}
+	}
+	if r := f.UpdateTime; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, UpdateTimeGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, UpdateTimeLT(r.To))
+		}
+	}
+	if f.Number != nil {
+		ps = append(ps, NumberEQ(*f.Number))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if r := f.ExpiresAt; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, ExpiresAtGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, ExpiresAtLT(r.To))
+		}
+	}
+	if f.Type != nil {
+		ps = append(ps, TypeEQ(*f.Type))
+	}
+	if f.NumberHash != nil {
+		ps = append(ps, NumberHashEQ(*f.NumberHash))
+	}
+	return ps
+}
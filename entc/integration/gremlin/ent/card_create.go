@@ -17,6 +17,7 @@ import (
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/spec"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/user"
@@ -77,6 +78,48 @@ func (cc *CardCreate) SetNillableName(s *string) *CardCreate {
 	return cc
 }
 
+// SetExpiresAt sets the expires_at field.
+func (cc *CardCreate) SetExpiresAt(t time.Time) *CardCreate {
+	cc.mutation.SetExpiresAt(t)
+	return cc
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (cc *CardCreate) SetNillableExpiresAt(t *time.Time) *CardCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
+	}
+	return cc
+}
+
+// SetType sets the type field.
+func (cc *CardCreate) SetType(st schema.CardType) *CardCreate {
+	cc.mutation.SetType(st)
+	return cc
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (cc *CardCreate) SetNillableType(st *schema.CardType) *CardCreate {
+	if st != nil {
+		cc.SetType(*st)
+	}
+	return cc
+}
+
+// SetNumberHash sets the number_hash field.
+func (cc *CardCreate) SetNumberHash(s string) *CardCreate {
+	cc.mutation.SetNumberHash(s)
+	return cc
+}
+
+// SetNillableNumberHash sets the number_hash field if the given value is not nil.
+func (cc *CardCreate) SetNillableNumberHash(s *string) *CardCreate {
+	if s != nil {
+		cc.SetNumberHash(*s)
+	}
+	return cc
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (cc *CardCreate) SetOwnerID(id string) *CardCreate {
 	cc.mutation.SetOwnerID(id)
@@ -113,26 +156,12 @@ func (cc *CardCreate) AddSpec(s ...*Spec) *CardCreate {
 
 // Save creates the Card in the database.
 func (cc *CardCreate) Save(ctx context.Context) (*Card, error) {
-	if _, ok := cc.mutation.CreateTime(); !ok {
-		v := card.DefaultCreateTime()
-		cc.mutation.SetCreateTime(v)
-	}
-	if _, ok := cc.mutation.UpdateTime(); !ok {
-		v := card.DefaultUpdateTime()
-		cc.mutation.SetUpdateTime(v)
-	}
-	if _, ok := cc.mutation.Number(); !ok {
-		return nil, errors.New("ent: missing required field \"number\"")
-	}
-	if v, ok := cc.mutation.Number(); ok {
-		if err := card.NumberValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"number\": %v", err)
-		}
+	if err := checkTx(cc.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := cc.mutation.Name(); ok {
-		if err := card.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	cc.defaults()
+	if err := cc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -169,6 +198,61 @@ func (cc *CardCreate) SaveX(ctx context.Context) *Card {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cc *CardCreate) Unwrap() *CardCreate {
+	tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardCreate is not a transactional builder")
+	}
+	cc.config.driver = tx.drv
+	cc.mutation.driver = tx.drv
+	return cc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (cc *CardCreate) DebugFields() map[string]Value {
+	return debugFields(cc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CardCreate) defaults() {
+	if _, ok := cc.mutation.CreateTime(); !ok {
+		v := card.DefaultCreateTime()
+		cc.mutation.SetCreateTime(v)
+	}
+	if _, ok := cc.mutation.UpdateTime(); !ok {
+		v := card.DefaultUpdateTime()
+		cc.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CardCreate) check() error {
+	if _, ok := cc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New("ent: missing required field \"number\"")}
+	}
+	if v, ok := cc.mutation.Number(); ok {
+		if err := card.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf("ent: validator failed for field \"number\": %v", err)}
+		}
+	}
+	if v, ok := cc.mutation.Name(); ok {
+		if err := card.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := cc.mutation.GetType(); ok {
+		if err := card.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (cc *CardCreate) gremlinSave(ctx context.Context) (*Card, error) {
 	res := &gremlin.Response{}
 	query, bindings := cc.gremlin().Query()
@@ -190,7 +274,7 @@ func (cc *CardCreate) gremlin() *dsl.Traversal {
 		pred *dsl.Traversal // constraint predicate.
 		test *dsl.Traversal // test matches and its constant.
 	}
-	constraints := make([]*constraint, 0, 1)
+	constraints := make([]*constraint, 0, 2)
 	v := g.AddV(card.Label)
 	if value, ok := cc.mutation.CreateTime(); ok {
 		v.Property(dsl.Single, card.FieldCreateTime, value)
@@ -204,6 +288,19 @@ func (cc *CardCreate) gremlin() *dsl.Traversal {
 	if value, ok := cc.mutation.Name(); ok {
 		v.Property(dsl.Single, card.FieldName, value)
 	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		v.Property(dsl.Single, card.FieldExpiresAt, value)
+	}
+	if value, ok := cc.mutation.GetType(); ok {
+		v.Property(dsl.Single, card.FieldType, value)
+	}
+	if value, ok := cc.mutation.NumberHash(); ok {
+		constraints = append(constraints, &constraint{
+			pred: g.V().Has(card.Label, card.FieldNumberHash, value).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueField(card.Label, card.FieldNumberHash, value)),
+		})
+		v.Property(dsl.Single, card.FieldNumberHash, value)
+	}
 	for _, id := range cc.mutation.OwnerIDs() {
 		v.AddE(user.CardLabel).From(g.V(id)).InV()
 		constraints = append(constraints, &constraint{
@@ -24,6 +24,7 @@ type CardDelete struct {
 	hooks      []Hook
 	mutation   *CardMutation
 	predicates []predicate.Card
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (cd *CardDelete) Where(ps ...predicate.Card) *CardDelete {
 	return cd
 }
 
+// Limit limits the number of cards that are deleted by the builder. For example,
+// for deleting large amounts of cards in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (cd *CardDelete) Limit(limit int) *CardDelete {
+	cd.limit = &limit
+	return cd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cd *CardDelete) Unwrap() *CardDelete {
+	tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardDelete is not a transactional builder")
+	}
+	cd.config.driver = tx.drv
+	cd.mutation.driver = tx.drv
+	return cd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (cd *CardDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(cd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (cd *CardDelete) gremlin() *dsl.Traversal {
 	for _, p := range cd.predicates {
 		p(t)
 	}
+	if limit := cd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
@@ -8,13 +8,16 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/spec"
@@ -27,6 +30,7 @@ type CardUpdate struct {
 	hooks      []Hook
 	mutation   *CardMutation
 	predicates []predicate.Card
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -35,6 +39,13 @@ func (cu *CardUpdate) Where(ps ...predicate.Card) *CardUpdate {
 	return cu
 }
 
+// Limit limits the number of cards that are updated by the builder. For example,
+// for updating large amounts of cards in small batches without locking the whole table.
+func (cu *CardUpdate) Limit(limit int) *CardUpdate {
+	cu.limit = &limit
+	return cu
+}
+
 // SetName sets the name field.
 func (cu *CardUpdate) SetName(s string) *CardUpdate {
 	cu.mutation.SetName(s)
@@ -55,6 +66,70 @@ func (cu *CardUpdate) ClearName() *CardUpdate {
 	return cu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (cu *CardUpdate) UnsetName() *CardUpdate {
+	cu.mutation.ResetName()
+	return cu
+}
+
+// SetExpiresAt sets the expires_at field.
+func (cu *CardUpdate) SetExpiresAt(t time.Time) *CardUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (cu *CardUpdate) SetNillableExpiresAt(t *time.Time) *CardUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (cu *CardUpdate) ClearExpiresAt() *CardUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
+// UnsetExpiresAt removes the changes of the expires_at field from the builder (e.g. a previous call
+// to SetExpiresAt), and therefore, the field is left unchanged in the database. Unlike ClearExpiresAt,
+// it does not set the field to NULL, and also removes a previous call to ClearExpiresAt.
+func (cu *CardUpdate) UnsetExpiresAt() *CardUpdate {
+	cu.mutation.ResetExpiresAt()
+	return cu
+}
+
+// SetType sets the type field.
+func (cu *CardUpdate) SetType(st schema.CardType) *CardUpdate {
+	cu.mutation.SetType(st)
+	return cu
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (cu *CardUpdate) SetNillableType(st *schema.CardType) *CardUpdate {
+	if st != nil {
+		cu.SetType(*st)
+	}
+	return cu
+}
+
+// ClearType clears the value of type.
+func (cu *CardUpdate) ClearType() *CardUpdate {
+	cu.mutation.ClearType()
+	return cu
+}
+
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (cu *CardUpdate) UnsetType() *CardUpdate {
+	cu.mutation.ResetType()
+	return cu
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (cu *CardUpdate) SetOwnerID(id string) *CardUpdate {
 	cu.mutation.SetOwnerID(id)
@@ -110,18 +185,37 @@ func (cu *CardUpdate) RemoveSpec(s ...*Spec) *CardUpdate {
 	return cu.RemoveSpecIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cu *CardUpdate) Unwrap() *CardUpdate {
+	tx, ok := cu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardUpdate is not a transactional builder")
+	}
+	cu.config.driver = tx.drv
+	cu.mutation.driver = tx.drv
+	return cu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cu *CardUpdate) DebugFields() map[string]Value {
+	return debugFields(cu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(cu.driver); err != nil {
+		return 0, err
+	}
 	if _, ok := cu.mutation.UpdateTime(); !ok {
 		v := card.UpdateDefaultUpdateTime()
 		cu.mutation.SetUpdateTime(v)
 	}
-	if v, ok := cu.mutation.Name(); ok {
-		if err := card.NameValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := cu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -135,6 +229,9 @@ func (cu *CardUpdate) Save(ctx context.Context) (int, error) {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
 			cu.mutation = mutation
+			if err := cu.checkImmutable(); err != nil {
+				return nil, err
+			}
 			affected, err = cu.gremlinSave(ctx)
 			return affected, err
 		})
@@ -170,6 +267,40 @@ func (cu *CardUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cu *CardUpdate) check() error {
+	if err := cu.checkImmutable(); err != nil {
+		return err
+	}
+	if v, ok := cu.mutation.Name(); ok {
+		if err := card.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := cu.mutation.GetType(); ok {
+		if err := card.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if cu.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
+// checkImmutable fails the mutation if it sets, adds to or clears an immutable field.
+// It runs before the hooks and again after them, before the mutation is executed.
+func (cu *CardUpdate) checkImmutable() error {
+	for _, name := range []string{card.FieldCreateTime, card.FieldNumber, card.FieldNumberHash} {
+		_, set := cu.mutation.Field(name)
+		_, added := cu.mutation.AddedField(name)
+		if set || added || cu.mutation.FieldCleared(name) {
+			return &ValidationError{Name: name, err: fmt.Errorf("ent: immutable field %q cannot be updated", name)}
+		}
+	}
+	return nil
+}
+
 func (cu *CardUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := cu.gremlin().Query()
@@ -187,11 +318,14 @@ func (cu *CardUpdate) gremlin() *dsl.Traversal {
 		pred *dsl.Traversal // constraint predicate.
 		test *dsl.Traversal // test matches and its constant.
 	}
-	constraints := make([]*constraint, 0, 1)
+	constraints := make([]*constraint, 0, 2)
 	v := g.V().HasLabel(card.Label)
 	for _, p := range cu.predicates {
 		p(v)
 	}
+	if limit := cu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -204,10 +338,25 @@ func (cu *CardUpdate) gremlin() *dsl.Traversal {
 	if value, ok := cu.mutation.Name(); ok {
 		v.Property(dsl.Single, card.FieldName, value)
 	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		v.Property(dsl.Single, card.FieldExpiresAt, value)
+	}
+	if value, ok := cu.mutation.GetType(); ok {
+		v.Property(dsl.Single, card.FieldType, value)
+	}
 	var properties []interface{}
 	if cu.mutation.NameCleared() {
 		properties = append(properties, card.FieldName)
 	}
+	if cu.mutation.ExpiresAtCleared() {
+		properties = append(properties, card.FieldExpiresAt)
+	}
+	if cu.mutation.TypeCleared() {
+		properties = append(properties, card.FieldType)
+	}
+	if cu.mutation.NumberHashCleared() {
+		properties = append(properties, card.FieldNumberHash)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
@@ -247,8 +396,16 @@ func (cu *CardUpdate) gremlin() *dsl.Traversal {
 // CardUpdateOne is the builder for updating a single Card entity.
 type CardUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *CardMutation
+	hooks      []Hook
+	mutation   *CardMutation
+	predicates []predicate.Card
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (cuo *CardUpdateOne) Where(ps ...predicate.Card) *CardUpdateOne {
+	cuo.predicates = append(cuo.predicates, ps...)
+	return cuo
 }
 
 // SetName sets the name field.
@@ -271,6 +428,70 @@ func (cuo *CardUpdateOne) ClearName() *CardUpdateOne {
 	return cuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (cuo *CardUpdateOne) UnsetName() *CardUpdateOne {
+	cuo.mutation.ResetName()
+	return cuo
+}
+
+// SetExpiresAt sets the expires_at field.
+func (cuo *CardUpdateOne) SetExpiresAt(t time.Time) *CardUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (cuo *CardUpdateOne) SetNillableExpiresAt(t *time.Time) *CardUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (cuo *CardUpdateOne) ClearExpiresAt() *CardUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
+// UnsetExpiresAt removes the changes of the expires_at field from the builder (e.g. a previous call
+// to SetExpiresAt), and therefore, the field is left unchanged in the database. Unlike ClearExpiresAt,
+// it does not set the field to NULL, and also removes a previous call to ClearExpiresAt.
+func (cuo *CardUpdateOne) UnsetExpiresAt() *CardUpdateOne {
+	cuo.mutation.ResetExpiresAt()
+	return cuo
+}
+
+// SetType sets the type field.
+func (cuo *CardUpdateOne) SetType(st schema.CardType) *CardUpdateOne {
+	cuo.mutation.SetType(st)
+	return cuo
+}
+
+// SetNillableType sets the type field if the given value is not nil.
+func (cuo *CardUpdateOne) SetNillableType(st *schema.CardType) *CardUpdateOne {
+	if st != nil {
+		cuo.SetType(*st)
+	}
+	return cuo
+}
+
+// ClearType clears the value of type.
+func (cuo *CardUpdateOne) ClearType() *CardUpdateOne {
+	cuo.mutation.ClearType()
+	return cuo
+}
+
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (cuo *CardUpdateOne) UnsetType() *CardUpdateOne {
+	cuo.mutation.ResetType()
+	return cuo
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (cuo *CardUpdateOne) SetOwnerID(id string) *CardUpdateOne {
 	cuo.mutation.SetOwnerID(id)
@@ -326,18 +547,37 @@ func (cuo *CardUpdateOne) RemoveSpec(s ...*Spec) *CardUpdateOne {
 	return cuo.RemoveSpecIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cuo *CardUpdateOne) Unwrap() *CardUpdateOne {
+	tx, ok := cuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CardUpdateOne is not a transactional builder")
+	}
+	cuo.config.driver = tx.drv
+	cuo.mutation.driver = tx.drv
+	return cuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cuo *CardUpdateOne) DebugFields() map[string]Value {
+	return debugFields(cuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
+	if err := checkTx(cuo.driver); err != nil {
+		return nil, err
+	}
 	if _, ok := cuo.mutation.UpdateTime(); !ok {
 		v := card.UpdateDefaultUpdateTime()
 		cuo.mutation.SetUpdateTime(v)
 	}
-	if v, ok := cuo.mutation.Name(); ok {
-		if err := card.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := cuo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *Card
@@ -351,6 +591,9 @@ func (cuo *CardUpdateOne) Save(ctx context.Context) (*Card, error) {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
 			cuo.mutation = mutation
+			if err := cuo.checkImmutable(); err != nil {
+				return nil, err
+			}
 			node, err = cuo.gremlinSave(ctx)
 			return node, err
 		})
@@ -386,6 +629,40 @@ func (cuo *CardUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CardUpdateOne) check() error {
+	if err := cuo.checkImmutable(); err != nil {
+		return err
+	}
+	if v, ok := cuo.mutation.Name(); ok {
+		if err := card.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := cuo.mutation.GetType(); ok {
+		if err := card.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if cuo.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
+// checkImmutable fails the mutation if it sets, adds to or clears an immutable field.
+// It runs before the hooks and again after them, before the mutation is executed.
+func (cuo *CardUpdateOne) checkImmutable() error {
+	for _, name := range []string{card.FieldCreateTime, card.FieldNumber, card.FieldNumberHash} {
+		_, set := cuo.mutation.Field(name)
+		_, added := cuo.mutation.AddedField(name)
+		if set || added || cuo.mutation.FieldCleared(name) {
+			return &ValidationError{Name: name, err: fmt.Errorf("ent: immutable field %q cannot be updated", name)}
+		}
+	}
+	return nil
+}
+
 func (cuo *CardUpdateOne) gremlinSave(ctx context.Context) (*Card, error) {
 	res := &gremlin.Response{}
 	id, ok := cuo.mutation.ID()
@@ -399,6 +676,15 @@ func (cuo *CardUpdateOne) gremlinSave(ctx context.Context) (*Card, error) {
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(cuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{card.Label}
+		}
+	}
 	c := &Card{config: cuo.config}
 	if err := c.FromResponse(res); err != nil {
 		return nil, err
@@ -411,8 +697,11 @@ func (cuo *CardUpdateOne) gremlin(id string) *dsl.Traversal {
 		pred *dsl.Traversal // constraint predicate.
 		test *dsl.Traversal // test matches and its constant.
 	}
-	constraints := make([]*constraint, 0, 1)
+	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range cuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -425,10 +714,25 @@ func (cuo *CardUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := cuo.mutation.Name(); ok {
 		v.Property(dsl.Single, card.FieldName, value)
 	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		v.Property(dsl.Single, card.FieldExpiresAt, value)
+	}
+	if value, ok := cuo.mutation.GetType(); ok {
+		v.Property(dsl.Single, card.FieldType, value)
+	}
 	var properties []interface{}
 	if cuo.mutation.NameCleared() {
 		properties = append(properties, card.FieldName)
 	}
+	if cuo.mutation.ExpiresAtCleared() {
+		properties = append(properties, card.FieldExpiresAt)
+	}
+	if cuo.mutation.TypeCleared() {
+		properties = append(properties, card.FieldType)
+	}
+	if cuo.mutation.NumberHashCleared() {
+		properties = append(properties, card.FieldNumberHash)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
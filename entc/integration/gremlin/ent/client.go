@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"time"
 
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/comment"
@@ -118,7 +119,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug, hooks: c.hooks}
+	tx.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = tx
 	return &Tx{
 		config:    cfg,
 		Card:      NewCardClient(cfg),
@@ -147,7 +150,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true, hooks: c.hooks}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	client := &Client{config: cfg}
 	client.init()
 	return client
@@ -215,6 +220,78 @@ func (c *CardClient) UpdateOneID(id string) *CardUpdateOne {
 	return &CardUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Card entities, and updates the original Card only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Card is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ca.Clone()
+//	modified.Field = value
+//	ca, err = client.Card.UpdateFromDiff(ctx, ca, modified)
+//
+func (c *CardClient) UpdateFromDiff(ctx context.Context, original, modified *Card) (*Card, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Card UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	switch v, o := modified.ExpiresAt, original.ExpiresAt; {
+	case v == nil && o != nil:
+		update.ClearExpiresAt()
+		changed = true
+	case v != nil && (o == nil || !v.Equal(*o)):
+		update.SetExpiresAt(*v)
+		changed = true
+	}
+	if modified.Type != original.Type {
+		update.SetType(modified.Type)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Owner, original.Edges.Owner; {
+		case v == nil && o != nil:
+			update.ClearOwner()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetOwnerID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
+// Touch sets the "update_time" field of the Card with the given
+// id to its update-default value (e.g. time.Now), without changing or reading its other
+// fields. Update hooks are executed, and *NotFoundError is returned if no entity was updated.
+func (c *CardClient) Touch(ctx context.Context, id string) error {
+	n, err := c.Update().Where(card.ID(id)).Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+	// MySQL reports only the rows that were changed. Hence, rows that
+	// were touched twice in the same second are not reported as affected.
+	switch exist, err := c.Query().Where(card.ID(id)).Exist(ctx); {
+	case err != nil:
+		return err
+	case !exist:
+		return &NotFoundError{card.Label}
+	default:
+		return nil
+	}
+}
+
 // Delete returns a delete builder for Card.
 func (c *CardClient) Delete() *CardDelete {
 	mutation := newCardMutation(c.config, OpDelete)
@@ -253,6 +330,88 @@ func (c *CardClient) GetX(ctx context.Context, id string) *Card {
 	return ca
 }
 
+// GetMany returns the Card entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *CardClient) GetMany(ctx context.Context, ids ...string) ([]*Card, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(card.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Card, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Card, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CardClient) GetManyX(ctx context.Context, ids ...string) []*Card {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Card from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *CardClient) FromMap(m map[string]string) (*Card, error) {
+	ca := &Card{config: c.config}
+	if err := kvDecode(m, card.FieldID, &ca.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, card.FieldCreateTime, &ca.CreateTime, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, card.FieldUpdateTime, &ca.UpdateTime, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, card.FieldNumber, &ca.Number, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[card.FieldNumber]; ok {
+		if err := card.NumberValidator(ca.Number); err != nil {
+			return nil, &ValidationError{Name: "number", err: fmt.Errorf("ent: validator failed for field \"number\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, card.FieldName, &ca.Name, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[card.FieldName]; ok {
+		if err := card.NameValidator(ca.Name); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if _, ok := m[card.FieldExpiresAt]; ok {
+		ca.ExpiresAt = new(time.Time)
+		if err := kvDecode(m, card.FieldExpiresAt, ca.ExpiresAt, true); err != nil {
+			return nil, err
+		}
+	}
+	if err := kvDecode(m, card.FieldType, &ca.Type, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[card.FieldType]; ok {
+		if err := card.TypeValidator(ca.Type); err != nil {
+			return nil, &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, card.FieldNumberHash, &ca.NumberHash, false); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
 // QueryOwner queries the owner edge of a Card.
 func (c *CardClient) QueryOwner(ca *Card) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -277,7 +436,12 @@ func (c *CardClient) QuerySpec(ca *Card) *SpecQuery {
 
 // Hooks returns the client hooks.
 func (c *CardClient) Hooks() []Hook {
-	return c.hooks.Card
+	hooks := c.hooks.Card
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(card.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // CommentClient is a client for the Comment schema.
@@ -320,6 +484,45 @@ func (c *CommentClient) UpdateOneID(id string) *CommentUpdateOne {
 	return &CommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Comment entities, and updates the original Comment only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Comment is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := co.Clone()
+//	modified.Field = value
+//	co, err = client.Comment.UpdateFromDiff(ctx, co, modified)
+//
+func (c *CommentClient) UpdateFromDiff(ctx context.Context, original, modified *Comment) (*Comment, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Comment UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.UniqueInt != original.UniqueInt {
+		update.SetUniqueInt(modified.UniqueInt)
+		changed = true
+	}
+	if modified.UniqueFloat != original.UniqueFloat {
+		update.SetUniqueFloat(modified.UniqueFloat)
+		changed = true
+	}
+	switch v, o := modified.NillableInt, original.NillableInt; {
+	case v == nil && o != nil:
+		update.ClearNillableInt()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt(*v)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Comment.
 func (c *CommentClient) Delete() *CommentDelete {
 	mutation := newCommentMutation(c.config, OpDelete)
@@ -358,9 +561,69 @@ func (c *CommentClient) GetX(ctx context.Context, id string) *Comment {
 	return co
 }
 
+// GetMany returns the Comment entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *CommentClient) GetMany(ctx context.Context, ids ...string) ([]*Comment, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(comment.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Comment, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Comment, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CommentClient) GetManyX(ctx context.Context, ids ...string) []*Comment {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Comment from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *CommentClient) FromMap(m map[string]string) (*Comment, error) {
+	co := &Comment{config: c.config}
+	if err := kvDecode(m, comment.FieldID, &co.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, comment.FieldUniqueInt, &co.UniqueInt, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, comment.FieldUniqueFloat, &co.UniqueFloat, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[comment.FieldNillableInt]; ok {
+		co.NillableInt = new(int)
+		if err := kvDecode(m, comment.FieldNillableInt, co.NillableInt, true); err != nil {
+			return nil, err
+		}
+	}
+	return co, nil
+}
+
 // Hooks returns the client hooks.
 func (c *CommentClient) Hooks() []Hook {
-	return c.hooks.Comment
+	hooks := c.hooks.Comment
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(comment.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // FieldTypeClient is a client for the FieldType schema.
@@ -403,6 +666,153 @@ func (c *FieldTypeClient) UpdateOneID(id string) *FieldTypeUpdateOne {
 	return &FieldTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given FieldType entities, and updates the original FieldType only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original FieldType is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ft.Clone()
+//	modified.Field = value
+//	ft, err = client.FieldType.UpdateFromDiff(ctx, ft, modified)
+//
+func (c *FieldTypeClient) UpdateFromDiff(ctx context.Context, original, modified *FieldType) (*FieldType, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for FieldType UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Int != original.Int {
+		update.SetInt(modified.Int)
+		changed = true
+	}
+	if modified.Int8 != original.Int8 {
+		update.SetInt8(modified.Int8)
+		changed = true
+	}
+	if modified.Int16 != original.Int16 {
+		update.SetInt16(modified.Int16)
+		changed = true
+	}
+	if modified.Int32 != original.Int32 {
+		update.SetInt32(modified.Int32)
+		changed = true
+	}
+	if modified.Int64 != original.Int64 {
+		update.SetInt64(modified.Int64)
+		changed = true
+	}
+	if modified.OptionalInt != original.OptionalInt {
+		update.SetOptionalInt(modified.OptionalInt)
+		changed = true
+	}
+	if modified.OptionalInt8 != original.OptionalInt8 {
+		update.SetOptionalInt8(modified.OptionalInt8)
+		changed = true
+	}
+	if modified.OptionalInt16 != original.OptionalInt16 {
+		update.SetOptionalInt16(modified.OptionalInt16)
+		changed = true
+	}
+	if modified.OptionalInt32 != original.OptionalInt32 {
+		update.SetOptionalInt32(modified.OptionalInt32)
+		changed = true
+	}
+	if modified.OptionalInt64 != original.OptionalInt64 {
+		update.SetOptionalInt64(modified.OptionalInt64)
+		changed = true
+	}
+	switch v, o := modified.NillableInt, original.NillableInt; {
+	case v == nil && o != nil:
+		update.ClearNillableInt()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt8, original.NillableInt8; {
+	case v == nil && o != nil:
+		update.ClearNillableInt8()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt8(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt16, original.NillableInt16; {
+	case v == nil && o != nil:
+		update.ClearNillableInt16()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt16(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt32, original.NillableInt32; {
+	case v == nil && o != nil:
+		update.ClearNillableInt32()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt32(*v)
+		changed = true
+	}
+	switch v, o := modified.NillableInt64, original.NillableInt64; {
+	case v == nil && o != nil:
+		update.ClearNillableInt64()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetNillableInt64(*v)
+		changed = true
+	}
+	if modified.ValidateOptionalInt32 != original.ValidateOptionalInt32 {
+		update.SetValidateOptionalInt32(modified.ValidateOptionalInt32)
+		changed = true
+	}
+	if modified.OptionalUint != original.OptionalUint {
+		update.SetOptionalUint(modified.OptionalUint)
+		changed = true
+	}
+	if modified.OptionalUint8 != original.OptionalUint8 {
+		update.SetOptionalUint8(modified.OptionalUint8)
+		changed = true
+	}
+	if modified.OptionalUint16 != original.OptionalUint16 {
+		update.SetOptionalUint16(modified.OptionalUint16)
+		changed = true
+	}
+	if modified.OptionalUint32 != original.OptionalUint32 {
+		update.SetOptionalUint32(modified.OptionalUint32)
+		changed = true
+	}
+	if modified.OptionalUint64 != original.OptionalUint64 {
+		update.SetOptionalUint64(modified.OptionalUint64)
+		changed = true
+	}
+	if modified.State != original.State {
+		update.SetState(modified.State)
+		changed = true
+	}
+	if modified.OptionalFloat != original.OptionalFloat {
+		update.SetOptionalFloat(modified.OptionalFloat)
+		changed = true
+	}
+	if modified.OptionalFloat32 != original.OptionalFloat32 {
+		update.SetOptionalFloat32(modified.OptionalFloat32)
+		changed = true
+	}
+	if !modified.Datetime.Equal(original.Datetime) {
+		update.SetDatetime(modified.Datetime)
+		changed = true
+	}
+	if modified.Decimal != original.Decimal {
+		update.SetDecimal(modified.Decimal)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for FieldType.
 func (c *FieldTypeClient) Delete() *FieldTypeDelete {
 	mutation := newFieldTypeMutation(c.config, OpDelete)
@@ -441,9 +851,160 @@ func (c *FieldTypeClient) GetX(ctx context.Context, id string) *FieldType {
 	return ft
 }
 
+// GetMany returns the FieldType entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *FieldTypeClient) GetMany(ctx context.Context, ids ...string) ([]*FieldType, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(fieldtype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*FieldType, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*FieldType, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FieldTypeClient) GetManyX(ctx context.Context, ids ...string) []*FieldType {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a FieldType from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *FieldTypeClient) FromMap(m map[string]string) (*FieldType, error) {
+	ft := &FieldType{config: c.config}
+	if err := kvDecode(m, fieldtype.FieldID, &ft.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt, &ft.Int, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt8, &ft.Int8, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt16, &ft.Int16, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt32, &ft.Int32, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldInt64, &ft.Int64, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt, &ft.OptionalInt, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt8, &ft.OptionalInt8, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt16, &ft.OptionalInt16, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt32, &ft.OptionalInt32, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalInt64, &ft.OptionalInt64, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[fieldtype.FieldNillableInt]; ok {
+		ft.NillableInt = new(int)
+		if err := kvDecode(m, fieldtype.FieldNillableInt, ft.NillableInt, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt8]; ok {
+		ft.NillableInt8 = new(int8)
+		if err := kvDecode(m, fieldtype.FieldNillableInt8, ft.NillableInt8, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt16]; ok {
+		ft.NillableInt16 = new(int16)
+		if err := kvDecode(m, fieldtype.FieldNillableInt16, ft.NillableInt16, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt32]; ok {
+		ft.NillableInt32 = new(int32)
+		if err := kvDecode(m, fieldtype.FieldNillableInt32, ft.NillableInt32, true); err != nil {
+			return nil, err
+		}
+	}
+	if _, ok := m[fieldtype.FieldNillableInt64]; ok {
+		ft.NillableInt64 = new(int64)
+		if err := kvDecode(m, fieldtype.FieldNillableInt64, ft.NillableInt64, true); err != nil {
+			return nil, err
+		}
+	}
+	if err := kvDecode(m, fieldtype.FieldValidateOptionalInt32, &ft.ValidateOptionalInt32, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[fieldtype.FieldValidateOptionalInt32]; ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(ft.ValidateOptionalInt32); err != nil {
+			return nil, &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint, &ft.OptionalUint, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint8, &ft.OptionalUint8, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint16, &ft.OptionalUint16, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint32, &ft.OptionalUint32, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalUint64, &ft.OptionalUint64, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldState, &ft.State, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[fieldtype.FieldState]; ok {
+		if err := fieldtype.StateValidator(ft.State); err != nil {
+			return nil, &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalFloat, &ft.OptionalFloat, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldOptionalFloat32, &ft.OptionalFloat32, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldDatetime, &ft.Datetime, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, fieldtype.FieldDecimal, &ft.Decimal, false); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
 // Hooks returns the client hooks.
 func (c *FieldTypeClient) Hooks() []Hook {
-	return c.hooks.FieldType
+	hooks := c.hooks.FieldType
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(fieldtype.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // FileClient is a client for the File schema.
@@ -486,6 +1047,69 @@ func (c *FileClient) UpdateOneID(id string) *FileUpdateOne {
 	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given File entities, and updates the original File only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original File is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := f.Clone()
+//	modified.Field = value
+//	f, err = client.File.UpdateFromDiff(ctx, f, modified)
+//
+func (c *FileClient) UpdateFromDiff(ctx context.Context, original, modified *File) (*File, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for File UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Size != original.Size {
+		update.SetSize(modified.Size)
+		changed = true
+	}
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	switch v, o := modified.User, original.User; {
+	case v == nil && o != nil:
+		update.ClearUser()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetUser(*v)
+		changed = true
+	}
+	if modified.Group != original.Group {
+		update.SetGroup(modified.Group)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Owner, original.Edges.Owner; {
+		case v == nil && o != nil:
+			update.ClearOwner()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetOwnerID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[1] && original.Edges.loadedTypes[1] {
+		switch v, o := modified.Edges.Type, original.Edges.Type; {
+		case v == nil && o != nil:
+			update.ClearType()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetTypeID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for File.
 func (c *FileClient) Delete() *FileDelete {
 	mutation := newFileMutation(c.config, OpDelete)
@@ -524,6 +1148,69 @@ func (c *FileClient) GetX(ctx context.Context, id string) *File {
 	return f
 }
 
+// GetMany returns the File entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *FileClient) GetMany(ctx context.Context, ids ...string) ([]*File, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(file.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*File, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*File, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FileClient) GetManyX(ctx context.Context, ids ...string) []*File {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a File from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *FileClient) FromMap(m map[string]string) (*File, error) {
+	f := &File{config: c.config}
+	if err := kvDecode(m, file.FieldID, &f.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, file.FieldSize, &f.Size, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[file.FieldSize]; ok {
+		if err := file.SizeValidator(f.Size); err != nil {
+			return nil, &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, file.FieldName, &f.Name, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[file.FieldUser]; ok {
+		f.User = new(string)
+		if err := kvDecode(m, file.FieldUser, f.User, true); err != nil {
+			return nil, err
+		}
+	}
+	if err := kvDecode(m, file.FieldGroup, &f.Group, false); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
 // QueryOwner queries the owner edge of a File.
 func (c *FileClient) QueryOwner(f *File) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -559,7 +1246,12 @@ func (c *FileClient) QueryField(f *File) *FieldTypeQuery {
 
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
-	return c.hooks.File
+	hooks := c.hooks.File
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(file.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // FileTypeClient is a client for the FileType schema.
@@ -602,6 +1294,33 @@ func (c *FileTypeClient) UpdateOneID(id string) *FileTypeUpdateOne {
 	return &FileTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given FileType entities, and updates the original FileType only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original FileType is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ft.Clone()
+//	modified.Field = value
+//	ft, err = client.FileType.UpdateFromDiff(ctx, ft, modified)
+//
+func (c *FileTypeClient) UpdateFromDiff(ctx context.Context, original, modified *FileType) (*FileType, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for FileType UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for FileType.
 func (c *FileTypeClient) Delete() *FileTypeDelete {
 	mutation := newFileTypeMutation(c.config, OpDelete)
@@ -640,6 +1359,52 @@ func (c *FileTypeClient) GetX(ctx context.Context, id string) *FileType {
 	return ft
 }
 
+// GetMany returns the FileType entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *FileTypeClient) GetMany(ctx context.Context, ids ...string) ([]*FileType, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(filetype.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*FileType, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*FileType, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *FileTypeClient) GetManyX(ctx context.Context, ids ...string) []*FileType {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a FileType from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *FileTypeClient) FromMap(m map[string]string) (*FileType, error) {
+	ft := &FileType{config: c.config}
+	if err := kvDecode(m, filetype.FieldID, &ft.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, filetype.FieldName, &ft.Name, true); err != nil {
+		return nil, err
+	}
+	return ft, nil
+}
+
 // QueryFiles queries the files edge of a FileType.
 func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -653,7 +1418,12 @@ func (c *FileTypeClient) QueryFiles(ft *FileType) *FileQuery {
 
 // Hooks returns the client hooks.
 func (c *FileTypeClient) Hooks() []Hook {
-	return c.hooks.FileType
+	hooks := c.hooks.FileType
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(filetype.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // GroupClient is a client for the Group schema.
@@ -696,6 +1466,63 @@ func (c *GroupClient) UpdateOneID(id string) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Group entities, and updates the original Group only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Group is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := gr.Clone()
+//	modified.Field = value
+//	gr, err = client.Group.UpdateFromDiff(ctx, gr, modified)
+//
+func (c *GroupClient) UpdateFromDiff(ctx context.Context, original, modified *Group) (*Group, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Group UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Active != original.Active {
+		update.SetActive(modified.Active)
+		changed = true
+	}
+	if !modified.Expire.Equal(original.Expire) {
+		update.SetExpire(modified.Expire)
+		changed = true
+	}
+	switch v, o := modified.Type, original.Type; {
+	case v == nil && o != nil:
+		update.ClearType()
+		changed = true
+	case v != nil && (o == nil || *v != *o):
+		update.SetType(*v)
+		changed = true
+	}
+	if modified.MaxUsers != original.MaxUsers {
+		update.SetMaxUsers(modified.MaxUsers)
+		changed = true
+	}
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[3] && original.Edges.loadedTypes[3] {
+		switch v, o := modified.Edges.Info, original.Edges.Info; {
+		case v == nil && o != nil:
+			update.ClearInfo()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetInfoID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -734,6 +1561,82 @@ func (c *GroupClient) GetX(ctx context.Context, id string) *Group {
 	return gr
 }
 
+// GetMany returns the Group entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *GroupClient) GetMany(ctx context.Context, ids ...string) ([]*Group, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Group, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Group, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupClient) GetManyX(ctx context.Context, ids ...string) []*Group {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Group from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *GroupClient) FromMap(m map[string]string) (*Group, error) {
+	gr := &Group{config: c.config}
+	if err := kvDecode(m, group.FieldID, &gr.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, group.FieldActive, &gr.Active, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, group.FieldExpire, &gr.Expire, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[group.FieldType]; ok {
+		gr.Type = new(string)
+		if err := kvDecode(m, group.FieldType, gr.Type, true); err != nil {
+			return nil, err
+		}
+	}
+	if gr.Type != nil {
+		if err := group.TypeValidator(*gr.Type); err != nil {
+			return nil, &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, group.FieldMaxUsers, &gr.MaxUsers, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[group.FieldMaxUsers]; ok {
+		if err := group.MaxUsersValidator(gr.MaxUsers); err != nil {
+			return nil, &ValidationError{Name: "max_users", err: fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, group.FieldName, &gr.Name, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[group.FieldName]; ok {
+		if err := group.NameValidator(gr.Name); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	return gr, nil
+}
+
 // QueryFiles queries the files edge of a Group.
 func (c *GroupClient) QueryFiles(gr *Group) *FileQuery {
 	query := &FileQuery{config: c.config}
@@ -780,7 +1683,12 @@ func (c *GroupClient) QueryInfo(gr *Group) *GroupInfoQuery {
 
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
-	return c.hooks.Group
+	hooks := c.hooks.Group
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(group.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // GroupInfoClient is a client for the GroupInfo schema.
@@ -823,6 +1731,37 @@ func (c *GroupInfoClient) UpdateOneID(id string) *GroupInfoUpdateOne {
 	return &GroupInfoUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given GroupInfo entities, and updates the original GroupInfo only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original GroupInfo is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := gi.Clone()
+//	modified.Field = value
+//	gi, err = client.GroupInfo.UpdateFromDiff(ctx, gi, modified)
+//
+func (c *GroupInfoClient) UpdateFromDiff(ctx context.Context, original, modified *GroupInfo) (*GroupInfo, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for GroupInfo UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Desc != original.Desc {
+		update.SetDesc(modified.Desc)
+		changed = true
+	}
+	if modified.MaxUsers != original.MaxUsers {
+		update.SetMaxUsers(modified.MaxUsers)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for GroupInfo.
 func (c *GroupInfoClient) Delete() *GroupInfoDelete {
 	mutation := newGroupInfoMutation(c.config, OpDelete)
@@ -861,6 +1800,55 @@ func (c *GroupInfoClient) GetX(ctx context.Context, id string) *GroupInfo {
 	return gi
 }
 
+// GetMany returns the GroupInfo entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *GroupInfoClient) GetMany(ctx context.Context, ids ...string) ([]*GroupInfo, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(groupinfo.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*GroupInfo, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*GroupInfo, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupInfoClient) GetManyX(ctx context.Context, ids ...string) []*GroupInfo {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a GroupInfo from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *GroupInfoClient) FromMap(m map[string]string) (*GroupInfo, error) {
+	gi := &GroupInfo{config: c.config}
+	if err := kvDecode(m, groupinfo.FieldID, &gi.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, groupinfo.FieldDesc, &gi.Desc, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, groupinfo.FieldMaxUsers, &gi.MaxUsers, true); err != nil {
+		return nil, err
+	}
+	return gi, nil
+}
+
 // QueryGroups queries the groups edge of a GroupInfo.
 func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 	query := &GroupQuery{config: c.config}
@@ -874,7 +1862,12 @@ func (c *GroupInfoClient) QueryGroups(gi *GroupInfo) *GroupQuery {
 
 // Hooks returns the client hooks.
 func (c *GroupInfoClient) Hooks() []Hook {
-	return c.hooks.GroupInfo
+	hooks := c.hooks.GroupInfo
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(groupinfo.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // ItemClient is a client for the Item schema.
@@ -917,6 +1910,37 @@ func (c *ItemClient) UpdateOneID(id string) *ItemUpdateOne {
 	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Item entities, and updates the original Item only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Item is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := i.Clone()
+//	modified.Field = value
+//	i, err = client.Item.UpdateFromDiff(ctx, i, modified)
+//
+func (c *ItemClient) UpdateFromDiff(ctx context.Context, original, modified *Item) (*Item, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Item UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if !modified.CreatedAt.Equal(original.CreatedAt) {
+		update.SetCreatedAt(modified.CreatedAt)
+		changed = true
+	}
+	if modified.Priority != original.Priority {
+		update.SetPriority(modified.Priority)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Item.
 func (c *ItemClient) Delete() *ItemDelete {
 	mutation := newItemMutation(c.config, OpDelete)
@@ -955,9 +1979,63 @@ func (c *ItemClient) GetX(ctx context.Context, id string) *Item {
 	return i
 }
 
+// GetMany returns the Item entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *ItemClient) GetMany(ctx context.Context, ids ...string) ([]*Item, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(item.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Item, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Item, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *ItemClient) GetManyX(ctx context.Context, ids ...string) []*Item {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Item from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *ItemClient) FromMap(m map[string]string) (*Item, error) {
+	i := &Item{config: c.config}
+	if err := kvDecode(m, item.FieldID, &i.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, item.FieldCreatedAt, &i.CreatedAt, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, item.FieldPriority, &i.Priority, true); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
 // Hooks returns the client hooks.
 func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+	hooks := c.hooks.Item
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(item.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // NodeClient is a client for the Node schema.
@@ -1000,6 +2078,53 @@ func (c *NodeClient) UpdateOneID(id string) *NodeUpdateOne {
 	return &NodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Node entities, and updates the original Node only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Node is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := n.Clone()
+//	modified.Field = value
+//	n, err = client.Node.UpdateFromDiff(ctx, n, modified)
+//
+func (c *NodeClient) UpdateFromDiff(ctx context.Context, original, modified *Node) (*Node, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Node UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Value != original.Value {
+		update.SetValue(modified.Value)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Prev, original.Edges.Prev; {
+		case v == nil && o != nil:
+			update.ClearPrev()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetPrevID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[1] && original.Edges.loadedTypes[1] {
+		switch v, o := modified.Edges.Next, original.Edges.Next; {
+		case v == nil && o != nil:
+			update.ClearNext()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetNextID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Node.
 func (c *NodeClient) Delete() *NodeDelete {
 	mutation := newNodeMutation(c.config, OpDelete)
@@ -1038,6 +2163,52 @@ func (c *NodeClient) GetX(ctx context.Context, id string) *Node {
 	return n
 }
 
+// GetMany returns the Node entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *NodeClient) GetMany(ctx context.Context, ids ...string) ([]*Node, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(node.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Node, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Node, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *NodeClient) GetManyX(ctx context.Context, ids ...string) []*Node {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Node from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *NodeClient) FromMap(m map[string]string) (*Node, error) {
+	n := &Node{config: c.config}
+	if err := kvDecode(m, node.FieldID, &n.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, node.FieldValue, &n.Value, false); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
 // QueryPrev queries the prev edge of a Node.
 func (c *NodeClient) QueryPrev(n *Node) *NodeQuery {
 	query := &NodeQuery{config: c.config}
@@ -1062,7 +2233,12 @@ func (c *NodeClient) QueryNext(n *Node) *NodeQuery {
 
 // Hooks returns the client hooks.
 func (c *NodeClient) Hooks() []Hook {
-	return c.hooks.Node
+	hooks := c.hooks.Node
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(node.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // PetClient is a client for the Pet schema.
@@ -1105,6 +2281,53 @@ func (c *PetClient) UpdateOneID(id string) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Pet entities, and updates the original Pet only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Pet is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := pe.Clone()
+//	modified.Field = value
+//	pe, err = client.Pet.UpdateFromDiff(ctx, pe, modified)
+//
+func (c *PetClient) UpdateFromDiff(ctx context.Context, original, modified *Pet) (*Pet, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Pet UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Team, original.Edges.Team; {
+		case v == nil && o != nil:
+			update.ClearTeam()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetTeamID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[1] && original.Edges.loadedTypes[1] {
+		switch v, o := modified.Edges.Owner, original.Edges.Owner; {
+		case v == nil && o != nil:
+			update.ClearOwner()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetOwnerID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -1143,6 +2366,52 @@ func (c *PetClient) GetX(ctx context.Context, id string) *Pet {
 	return pe
 }
 
+// GetMany returns the Pet entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *PetClient) GetMany(ctx context.Context, ids ...string) ([]*Pet, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Pet, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Pet, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *PetClient) GetManyX(ctx context.Context, ids ...string) []*Pet {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Pet from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *PetClient) FromMap(m map[string]string) (*Pet, error) {
+	pe := &Pet{config: c.config}
+	if err := kvDecode(m, pet.FieldID, &pe.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, pet.FieldName, &pe.Name, true); err != nil {
+		return nil, err
+	}
+	return pe, nil
+}
+
 // QueryTeam queries the team edge of a Pet.
 func (c *PetClient) QueryTeam(pe *Pet) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -1167,7 +2436,12 @@ func (c *PetClient) QueryOwner(pe *Pet) *UserQuery {
 
 // Hooks returns the client hooks.
 func (c *PetClient) Hooks() []Hook {
-	return c.hooks.Pet
+	hooks := c.hooks.Pet
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(pet.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // SpecClient is a client for the Spec schema.
@@ -1210,6 +2484,33 @@ func (c *SpecClient) UpdateOneID(id string) *SpecUpdateOne {
 	return &SpecUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Spec entities, and updates the original Spec only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Spec is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := s.Clone()
+//	modified.Field = value
+//	s, err = client.Spec.UpdateFromDiff(ctx, s, modified)
+//
+func (c *SpecClient) UpdateFromDiff(ctx context.Context, original, modified *Spec) (*Spec, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Spec UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Spec.
 func (c *SpecClient) Delete() *SpecDelete {
 	mutation := newSpecMutation(c.config, OpDelete)
@@ -1248,6 +2549,52 @@ func (c *SpecClient) GetX(ctx context.Context, id string) *Spec {
 	return s
 }
 
+// GetMany returns the Spec entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *SpecClient) GetMany(ctx context.Context, ids ...string) ([]*Spec, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(spec.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*Spec, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Spec, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *SpecClient) GetManyX(ctx context.Context, ids ...string) []*Spec {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Spec from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *SpecClient) FromMap(m map[string]string) (*Spec, error) {
+	s := &Spec{config: c.config}
+	if err := kvDecode(m, spec.FieldID, &s.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, spec.FieldName, &s.Name, false); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // QueryCard queries the card edge of a Spec.
 func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
 	query := &CardQuery{config: c.config}
@@ -1261,7 +2608,12 @@ func (c *SpecClient) QueryCard(s *Spec) *CardQuery {
 
 // Hooks returns the client hooks.
 func (c *SpecClient) Hooks() []Hook {
-	return c.hooks.Spec
+	hooks := c.hooks.Spec
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(spec.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // UserClient is a client for the User schema.
@@ -1304,6 +2656,105 @@ func (c *UserClient) UpdateOneID(id string) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given User entities, and updates the original User only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original User is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := u.Clone()
+//	modified.Field = value
+//	u, err = client.User.UpdateFromDiff(ctx, u, modified)
+//
+func (c *UserClient) UpdateFromDiff(ctx context.Context, original, modified *User) (*User, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for User UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.OptionalInt != original.OptionalInt {
+		update.SetOptionalInt(modified.OptionalInt)
+		changed = true
+	}
+	if modified.Age != original.Age {
+		update.SetAge(modified.Age)
+		changed = true
+	}
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Last != original.Last {
+		update.SetLast(modified.Last)
+		changed = true
+	}
+	if modified.Nickname != original.Nickname {
+		update.SetNickname(modified.Nickname)
+		changed = true
+	}
+	if modified.Phone != original.Phone {
+		update.SetPhone(modified.Phone)
+		changed = true
+	}
+	if modified.Password != original.Password {
+		update.SetPassword(modified.Password)
+		changed = true
+	}
+	if modified.Role != original.Role {
+		update.SetRole(modified.Role)
+		changed = true
+	}
+	if modified.SSOCert != original.SSOCert {
+		update.SetSSOCert(modified.SSOCert)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Card, original.Edges.Card; {
+		case v == nil && o != nil:
+			update.ClearCard()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetCardID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[7] && original.Edges.loadedTypes[7] {
+		switch v, o := modified.Edges.Team, original.Edges.Team; {
+		case v == nil && o != nil:
+			update.ClearTeam()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetTeamID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[8] && original.Edges.loadedTypes[8] {
+		switch v, o := modified.Edges.Spouse, original.Edges.Spouse; {
+		case v == nil && o != nil:
+			update.ClearSpouse()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetSpouseID(v.ID)
+			changed = true
+		}
+	}
+	if modified.Edges.loadedTypes[10] && original.Edges.loadedTypes[10] {
+		switch v, o := modified.Edges.Parent, original.Edges.Parent; {
+		case v == nil && o != nil:
+			update.ClearParent()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetParentID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -1342,6 +2793,83 @@ func (c *UserClient) GetX(ctx context.Context, id string) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *UserClient) GetMany(ctx context.Context, ids ...string) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*User, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*User, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...string) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a User from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *UserClient) FromMap(m map[string]string) (*User, error) {
+	u := &User{config: c.config}
+	if err := kvDecode(m, user.FieldID, &u.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldOptionalInt, &u.OptionalInt, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[user.FieldOptionalInt]; ok {
+		if err := user.OptionalIntValidator(u.OptionalInt); err != nil {
+			return nil, &ValidationError{Name: "optional_int", err: fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, user.FieldAge, &u.Age, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldName, &u.Name, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldLast, &u.Last, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldNickname, &u.Nickname, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldPhone, &u.Phone, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldRole, &u.Role, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[user.FieldRole]; ok {
+		if err := user.RoleValidator(u.Role); err != nil {
+			return nil, &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, user.FieldSSOCert, &u.SSOCert, false); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
 // QueryCard queries the card edge of a User.
 func (c *UserClient) QueryCard(u *User) *CardQuery {
 	query := &CardQuery{config: c.config}
@@ -1465,5 +2993,10 @@ func (c *UserClient) QueryParent(u *User) *UserQuery {
 
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(user.Label)}, hooks...)
+	}
+	return hooks
 }
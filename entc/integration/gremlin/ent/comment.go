@@ -7,10 +7,15 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/comment"
 )
 
 // Comment is the model entity for the Comment schema.
@@ -48,6 +53,32 @@ func (c *Comment) FromResponse(res *gremlin.Response) error {
 	return nil
 }
 
+// DumpGraph returns the JSON encoding of the Comment and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Comment alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Comment itself is not modified.
+//
+//	b, err := c.DumpGraph(ctx, client, 2)
+//
+func (c *Comment) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Comment DumpGraph", depth)
+	}
+	dumped := *c
+	if err := dumpComments(ctx, client, []*Comment{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpComments loads the edges of the given Comment copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpComments(ctx context.Context, client *Client, nodes []*Comment, depth int, dumper *graphDump) error {
+	return nil
+}
+
 // Update returns a builder for updating this Comment.
 // Note that, you need to call Comment.Unwrap() before calling this method, if this Comment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -66,6 +97,30 @@ func (c *Comment) Unwrap() *Comment {
 	return c
 }
 
+// Clone returns a deep copy of the Comment and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (c *Comment) Clone() *Comment {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Comment and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (c *Comment) clone(seen map[interface{}]interface{}) *Comment {
+	if c == nil {
+		return nil
+	}
+	if v, ok := seen[c]; ok {
+		return v.(*Comment)
+	}
+	_c := *c
+	seen[c] = &_c
+	if v := c.NillableInt; v != nil {
+		_v := *v
+		_c.NillableInt = &_v
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Comment) String() string {
 	var builder strings.Builder
@@ -83,6 +138,32 @@ func (c *Comment) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Comment, for change detection and cache keys.
+// It covers the fields that are listed in comment.FingerprintFields, and it does not depend on the
+// ID of the Comment, on its edges, or on the order of the fields in the schema.
+func (c *Comment) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "nillable_int", c.NillableInt)
+	fingerprint(h, "unique_float", c.UniqueFloat)
+	fingerprint(h, "unique_int", c.UniqueInt)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Comment for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Comment can be decoded using the FromMap method of its client.
+func (c *Comment) ToMap() map[string]string {
+	m := make(map[string]string, 4)
+	m[comment.FieldID] = kvEncode(c.ID)
+	m[comment.FieldUniqueInt] = kvEncode(c.UniqueInt)
+	m[comment.FieldUniqueFloat] = kvEncode(c.UniqueFloat)
+	if v := c.NillableInt; v != nil {
+		m[comment.FieldNillableInt] = kvEncode(*v)
+	}
+	return m
+}
+
 // Comments is a parsable slice of Comment.
 type Comments []*Comment
 
@@ -102,12 +183,13 @@ func (c *Comments) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	for _, v := range scanc {
-		*c = append(*c, &Comment{
+		node := &Comment{
 			ID:          v.ID,
 			UniqueInt:   v.UniqueInt,
 			UniqueFloat: v.UniqueFloat,
 			NillableInt: v.NillableInt,
-		})
+		}
+		*c = append(*c, node)
 	}
 	return nil
 }
@@ -15,3 +15,11 @@ const (
 	FieldUniqueFloat = "unique_float" // FieldNillableInt holds the string denoting the nillable_int vertex property in the database.
 	FieldNillableInt = "nillable_int"
 )
+
+// FingerprintFields holds the fields of the Comment type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldNillableInt,
+	FieldUniqueFloat,
+	FieldUniqueInt,
+}
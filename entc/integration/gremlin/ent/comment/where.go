@@ -345,3 +345,41 @@ func Not(p predicate.Comment) predicate.Comment {
 		tr.Where(__.Not(t))
 	})
 }
+
+// CommentFilter holds optional filters on the Comment fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CommentFilter struct {
+	IDIn        []string
+	UniqueInt   *int
+	UniqueFloat *float64
+	NillableInt *int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Comment.Query().
+//		Where(comment.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CommentFilter) []predicate.Comment {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Comment
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.UniqueInt != nil {
+		ps = append(ps, UniqueIntEQ(*f.UniqueInt))
+	}
+	if f.UniqueFloat != nil {
+		ps = append(ps, UniqueFloatEQ(*f.UniqueFloat))
+	}
+	if f.NillableInt != nil {
+		ps = append(ps, NillableIntEQ(*f.NillableInt))
+	}
+	return ps
+}
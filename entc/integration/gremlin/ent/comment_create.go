@@ -54,11 +54,12 @@ func (cc *CommentCreate) SetNillableNillableInt(i *int) *CommentCreate {
 
 // Save creates the Comment in the database.
 func (cc *CommentCreate) Save(ctx context.Context) (*Comment, error) {
-	if _, ok := cc.mutation.UniqueInt(); !ok {
-		return nil, errors.New("ent: missing required field \"unique_int\"")
+	if err := checkTx(cc.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := cc.mutation.UniqueFloat(); !ok {
-		return nil, errors.New("ent: missing required field \"unique_float\"")
+	cc.defaults()
+	if err := cc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -95,6 +96,41 @@ func (cc *CommentCreate) SaveX(ctx context.Context) *Comment {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cc *CommentCreate) Unwrap() *CommentCreate {
+	tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentCreate is not a transactional builder")
+	}
+	cc.config.driver = tx.drv
+	cc.mutation.driver = tx.drv
+	return cc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (cc *CommentCreate) DebugFields() map[string]Value {
+	return debugFields(cc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CommentCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CommentCreate) check() error {
+	if _, ok := cc.mutation.UniqueInt(); !ok {
+		return &ValidationError{Name: "unique_int", err: errors.New("ent: missing required field \"unique_int\"")}
+	}
+	if _, ok := cc.mutation.UniqueFloat(); !ok {
+		return &ValidationError{Name: "unique_float", err: errors.New("ent: missing required field \"unique_float\"")}
+	}
+	return nil
+}
+
 func (cc *CommentCreate) gremlinSave(ctx context.Context) (*Comment, error) {
 	res := &gremlin.Response{}
 	query, bindings := cc.gremlin().Query()
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -38,6 +39,19 @@ func (cq *CommentQuery) Where(ps ...predicate.Comment) *CommentQuery {
 	return cq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (cq *CommentQuery) Predicates() []predicate.Comment {
+	return append([]predicate.Comment{}, cq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (cq *CommentQuery) SetPredicates(ps ...predicate.Comment) *CommentQuery {
+	cq.predicates = append([]predicate.Comment{}, ps...)
+	return cq
+}
+
 // Limit adds a limit step to the query.
 func (cq *CommentQuery) Limit(limit int) *CommentQuery {
 	cq.limit = &limit
@@ -621,6 +635,27 @@ func (cs *CommentSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (cs *CommentSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(cs.fields) > 1 {
+		return nil, errors.New("ent: CommentSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := cs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (cs *CommentSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := cs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (cs *CommentSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
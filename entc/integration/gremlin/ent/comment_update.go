@@ -25,6 +25,7 @@ type CommentUpdate struct {
 	hooks      []Hook
 	mutation   *CommentMutation
 	predicates []predicate.Comment
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -33,6 +34,13 @@ func (cu *CommentUpdate) Where(ps ...predicate.Comment) *CommentUpdate {
 	return cu
 }
 
+// Limit limits the number of comments that are updated by the builder. For example,
+// for updating large amounts of comments in small batches without locking the whole table.
+func (cu *CommentUpdate) Limit(limit int) *CommentUpdate {
+	cu.limit = &limit
+	return cu
+}
+
 // SetUniqueInt sets the unique_int field.
 func (cu *CommentUpdate) SetUniqueInt(i int) *CommentUpdate {
 	cu.mutation.ResetUniqueInt()
@@ -46,6 +54,13 @@ func (cu *CommentUpdate) AddUniqueInt(i int) *CommentUpdate {
 	return cu
 }
 
+// UnsetUniqueInt removes the changes of the unique_int field from the builder (e.g. a previous call
+// to SetUniqueInt), and therefore, the field is left unchanged in the database.
+func (cu *CommentUpdate) UnsetUniqueInt() *CommentUpdate {
+	cu.mutation.ResetUniqueInt()
+	return cu
+}
+
 // SetUniqueFloat sets the unique_float field.
 func (cu *CommentUpdate) SetUniqueFloat(f float64) *CommentUpdate {
 	cu.mutation.ResetUniqueFloat()
@@ -59,6 +74,13 @@ func (cu *CommentUpdate) AddUniqueFloat(f float64) *CommentUpdate {
 	return cu
 }
 
+// UnsetUniqueFloat removes the changes of the unique_float field from the builder (e.g. a previous call
+// to SetUniqueFloat), and therefore, the field is left unchanged in the database.
+func (cu *CommentUpdate) UnsetUniqueFloat() *CommentUpdate {
+	cu.mutation.ResetUniqueFloat()
+	return cu
+}
+
 // SetNillableInt sets the nillable_int field.
 func (cu *CommentUpdate) SetNillableInt(i int) *CommentUpdate {
 	cu.mutation.ResetNillableInt()
@@ -86,8 +108,41 @@ func (cu *CommentUpdate) ClearNillableInt() *CommentUpdate {
 	return cu
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (cu *CommentUpdate) UnsetNillableInt() *CommentUpdate {
+	cu.mutation.ResetNillableInt()
+	return cu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cu *CommentUpdate) Unwrap() *CommentUpdate {
+	tx, ok := cu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentUpdate is not a transactional builder")
+	}
+	cu.config.driver = tx.drv
+	cu.mutation.driver = tx.drv
+	return cu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cu *CommentUpdate) DebugFields() map[string]Value {
+	return debugFields(cu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (cu *CommentUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(cu.driver); err != nil {
+		return 0, err
+	}
+	if err := cu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -136,6 +191,11 @@ func (cu *CommentUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cu *CommentUpdate) check() error {
+	return nil
+}
+
 func (cu *CommentUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := cu.gremlin().Query()
@@ -158,6 +218,9 @@ func (cu *CommentUpdate) gremlin() *dsl.Traversal {
 	for _, p := range cu.predicates {
 		p(v)
 	}
+	if limit := cu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -225,8 +288,16 @@ func (cu *CommentUpdate) gremlin() *dsl.Traversal {
 // CommentUpdateOne is the builder for updating a single Comment entity.
 type CommentUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *CommentMutation
+	hooks      []Hook
+	mutation   *CommentMutation
+	predicates []predicate.Comment
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (cuo *CommentUpdateOne) Where(ps ...predicate.Comment) *CommentUpdateOne {
+	cuo.predicates = append(cuo.predicates, ps...)
+	return cuo
 }
 
 // SetUniqueInt sets the unique_int field.
@@ -242,6 +313,13 @@ func (cuo *CommentUpdateOne) AddUniqueInt(i int) *CommentUpdateOne {
 	return cuo
 }
 
+// UnsetUniqueInt removes the changes of the unique_int field from the builder (e.g. a previous call
+// to SetUniqueInt), and therefore, the field is left unchanged in the database.
+func (cuo *CommentUpdateOne) UnsetUniqueInt() *CommentUpdateOne {
+	cuo.mutation.ResetUniqueInt()
+	return cuo
+}
+
 // SetUniqueFloat sets the unique_float field.
 func (cuo *CommentUpdateOne) SetUniqueFloat(f float64) *CommentUpdateOne {
 	cuo.mutation.ResetUniqueFloat()
@@ -255,6 +333,13 @@ func (cuo *CommentUpdateOne) AddUniqueFloat(f float64) *CommentUpdateOne {
 	return cuo
 }
 
+// UnsetUniqueFloat removes the changes of the unique_float field from the builder (e.g. a previous call
+// to SetUniqueFloat), and therefore, the field is left unchanged in the database.
+func (cuo *CommentUpdateOne) UnsetUniqueFloat() *CommentUpdateOne {
+	cuo.mutation.ResetUniqueFloat()
+	return cuo
+}
+
 // SetNillableInt sets the nillable_int field.
 func (cuo *CommentUpdateOne) SetNillableInt(i int) *CommentUpdateOne {
 	cuo.mutation.ResetNillableInt()
@@ -282,8 +367,41 @@ func (cuo *CommentUpdateOne) ClearNillableInt() *CommentUpdateOne {
 	return cuo
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (cuo *CommentUpdateOne) UnsetNillableInt() *CommentUpdateOne {
+	cuo.mutation.ResetNillableInt()
+	return cuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cuo *CommentUpdateOne) Unwrap() *CommentUpdateOne {
+	tx, ok := cuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentUpdateOne is not a transactional builder")
+	}
+	cuo.config.driver = tx.drv
+	cuo.mutation.driver = tx.drv
+	return cuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cuo *CommentUpdateOne) DebugFields() map[string]Value {
+	return debugFields(cuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (cuo *CommentUpdateOne) Save(ctx context.Context) (*Comment, error) {
+	if err := checkTx(cuo.driver); err != nil {
+		return nil, err
+	}
+	if err := cuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Comment
@@ -332,6 +450,11 @@ func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CommentUpdateOne) check() error {
+	return nil
+}
+
 func (cuo *CommentUpdateOne) gremlinSave(ctx context.Context) (*Comment, error) {
 	res := &gremlin.Response{}
 	id, ok := cuo.mutation.ID()
@@ -345,6 +468,15 @@ func (cuo *CommentUpdateOne) gremlinSave(ctx context.Context) (*Comment, error)
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(cuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{comment.Label}
+		}
+	}
 	c := &Comment{config: cuo.config}
 	if err := c.FromResponse(res); err != nil {
 		return nil, err
@@ -359,6 +491,9 @@ func (cuo *CommentUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range cuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
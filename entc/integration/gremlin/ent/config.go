@@ -7,6 +7,8 @@
 package ent
 
 import (
+	"context"
+
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
 )
@@ -24,6 +26,10 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// txObserver observes the lifecycle of the transactions.
+	txObserver func(context.Context, TxEvent)
+	// redact returns the masks of the sensitive fields.
+	redact func(typ, field string) string
 }
 
 // hooks per client, for fast access.
@@ -72,3 +78,41 @@ func Driver(driver dialect.Driver) Option {
 		c.driver = driver
 	}
 }
+
+// TxObserver sets a function for observing the lifecycle of the transactions that are started
+// by the client. The function is called with the context that started the transaction on its
+// begin, commit and rollback, and a panic in the function does not affect the transaction.
+//
+//	TxObserver(func(ctx context.Context, e TxEvent) {
+//		if e.Op != TxBegin {
+//			log.Printf("tx %s took %s (labels: %v, err: %v)", e.Op, e.Duration, e.Labels, e.Err)
+//		}
+//	})
+//
+func TxObserver(fn func(context.Context, TxEvent)) Option {
+	return func(c *config) {
+		c.txObserver = fn
+	}
+}
+
+// Redact sets a function that returns the mask of the values of sensitive fields. The mask replaces
+// the values in the String methods of the entities and the mutations, in the DebugFields of the builders,
+// in the validation errors and in the debug logs of the SQL dialects. The default mask is "<sensitive>".
+//
+//	Redact(func(typ, field string) string {
+//		return fmt.Sprintf("<%s.%s>", typ, field)
+//	})
+//
+func Redact(fn func(typ, field string) string) Option {
+	return func(c *config) {
+		c.redact = fn
+	}
+}
+
+// sensitiveMask returns the mask of the given sensitive field.
+func (c config) sensitiveMask(typ, field string) string {
+	if c.redact == nil {
+		return "<sensitive>"
+	}
+	return c.redact(typ, field)
+}
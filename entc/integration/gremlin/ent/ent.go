@@ -7,10 +7,17 @@
 package ent
 
 import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect/gremlin"
@@ -149,6 +156,73 @@ func Sum(field string) AggregateFunc {
 	}
 }
 
+// debugFields returns the changes of the mutation for the DebugFields method of the builders. The values
+// of the fields and the ids of the added edges are keyed by their names, and the other changes are keyed
+// by the name of the setter that made them. For example:
+//
+//	"name": "a8m", "add_age": 1, "clear_nickname": true, "pets": []Value{1}, "remove_friends": []Value{2}
+//
+func debugFields(m Mutation) map[string]Value {
+	fields := make(map[string]Value)
+	for _, name := range m.Fields() {
+		if v, ok := m.Field(name); ok {
+			fields[name] = redact(m, name, v)
+		}
+	}
+	for _, name := range m.AddedFields() {
+		if v, ok := m.AddedField(name); ok {
+			fields["add_"+name] = v
+		}
+	}
+	for _, name := range m.ClearedFields() {
+		fields["clear_"+name] = true
+	}
+	for _, name := range m.AddedEdges() {
+		fields[name] = m.AddedIDs(name)
+	}
+	for _, name := range m.RemovedEdges() {
+		fields["remove_"+name] = m.RemovedIDs(name)
+	}
+	for _, name := range m.ClearedEdges() {
+		fields["clear_"+name] = true
+	}
+	return fields
+}
+
+// mutationString returns the textual representation of the mutations for their String methods. The
+// changes are formatted in the order of their keys (see the debugFields function for the key format).
+func mutationString(m Mutation) string {
+	fields := debugFields(m)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	b.WriteString(m.Type() + "Mutation(op=" + m.Op().String())
+	for _, k := range keys {
+		b.WriteString(fmt.Sprintf(", %s=%v", k, fields[k]))
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// redact returns the mask of the given value, if it is a value of a sensitive field of the mutation.
+func redact(m Mutation, name string, v Value) Value {
+	if r, ok := m.(interface{ redactField(string, Value) Value }); ok {
+		return r.redactField(name, v)
+	}
+	return v
+}
+
+// redactError masks the occurrences of the given value of a sensitive field in the message of the error.
+func redactError(err error, v, mask string) error {
+	if v == "" || !strings.Contains(err.Error(), v) {
+		return err
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), v, mask))
+}
+
 // NotFoundError returns when trying to fetch a specific entity and it was not found in the database.
 type NotFoundError struct {
 	label string
@@ -214,6 +288,31 @@ func IsNotLoaded(err error) bool {
 	return errors.As(err, &e)
 }
 
+// ValidationError returns when validating a field or an edge fails.
+type ValidationError struct {
+	Name string // Field or edge name.
+	err  error
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap implements the errors.Wrapper interface.
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
+// IsValidationError returns a boolean indicating whether the error is a validation error.
+func IsValidationError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var e *ValidationError
+	return errors.As(err, &e)
+}
+
 // ConstraintError returns when trying to create/update one or more entities and
 // one or more of their constraints failed. For example, violation of edge or
 // field uniqueness.
@@ -241,6 +340,269 @@ func IsConstraintError(err error) bool {
 	return errors.As(err, &e)
 }
 
+// fingerprint writes the name and the JSON encoding of a field value to the hash of a
+// Fingerprint. Time values are converted to UTC, and nil values are encoded as null.
+func fingerprint(w io.Writer, name string, v interface{}) {
+	switch t := v.(type) {
+	case time.Time:
+		v = t.UTC()
+	case *time.Time:
+		if t != nil {
+			v = t.UTC()
+		}
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		b = []byte(fmt.Sprint(v))
+	}
+	fmt.Fprintf(w, "%s=%s\n", name, b)
+}
+
+// kvEncode encodes a field value for the flat key-value representation of the entities (see the
+// ToMap methods). Time values are encoded as RFC 3339, bytes as base64, values that implement the
+// encoding.TextMarshaler interface using their text encoding, values of basic kinds (and their
+// named types) using strconv, and other values (e.g. JSON fields) as JSON.
+func kvEncode(v interface{}) string {
+	switch v := v.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v)
+	case encoding.TextMarshaler:
+		if b, err := v.MarshalText(); err == nil {
+			return string(b)
+		}
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String()
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+// kvDecode decodes the value of the given key in the key-value representation of an entity into v,
+// a pointer to the field, using the reverse encoding of kvEncode. Missing keys are skipped, unless
+// they are required.
+func kvDecode(m map[string]string, key string, v interface{}, required bool) error {
+	s, ok := m[key]
+	if !ok {
+		if required {
+			return fmt.Errorf("ent: missing required field %q", key)
+		}
+		return nil
+	}
+	var err error
+	switch v := v.(type) {
+	case *time.Time:
+		*v, err = time.Parse(time.RFC3339Nano, s)
+	case *[]byte:
+		*v, err = base64.StdEncoding.DecodeString(s)
+	case encoding.TextUnmarshaler:
+		err = v.UnmarshalText([]byte(s))
+	default:
+		err = kvDecodeValue(s, reflect.ValueOf(v).Elem())
+	}
+	if err != nil {
+		return fmt.Errorf("ent: decoding field %q: %v", key, err)
+	}
+	return nil
+}
+
+// kvDecodeValue decodes the given string into a value of a basic kind, or from JSON.
+func kvDecodeValue(s string, rv reflect.Value) error {
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+	default:
+		return json.Unmarshal([]byte(s), rv.Addr().Interface())
+	}
+	return nil
+}
+
+// jsonPath returns the value stored under the given dot-separated path of a JSON object.
+func jsonPath(doc map[string]interface{}, path string) (interface{}, bool) {
+	keys := strings.Split(path, ".")
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := doc[k].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		doc = next
+	}
+	v, ok := doc[keys[len(keys)-1]]
+	return v, ok
+}
+
+// jsonInt returns the integer value of a JSON number. Numbers are decoded as float64 by
+// the standard library, and therefore, fractional numbers are reported as non-integers.
+func jsonInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		if n := int(v); float64(n) == v {
+			return n, true
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return int(n), true
+		}
+	}
+	return 0, false
+}
+
+// jsonFloat returns the floating-point value of a JSON number.
+func jsonFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f, true
+		}
+	}
+	return 0, false
+}
+
+// deepCopy copies the value that src points to, into the value that dst points to. Pointers, slices,
+// maps, arrays, interfaces and the exported fields of structs are copied recursively, and therefore,
+// the two values do not share memory, besides the unexported fields of structs that are copied as is.
+func deepCopy(dst, src interface{}) {
+	reflect.ValueOf(dst).Elem().Set(copyValue(reflect.ValueOf(src).Elem()))
+}
+
+// copyValue returns a deep copy of the given value. See deepCopy for more info.
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(copyValue(v.Elem()))
+		return c
+	case reflect.Interface:
+		c := reflect.New(v.Type()).Elem()
+		if !v.IsNil() {
+			c.Set(copyValue(v.Elem()))
+		}
+		return c
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Array:
+		c := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			c.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if f := c.Field(i); f.CanSet() {
+				f.Set(copyValue(v.Field(i)))
+			}
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// graphDump holds the state of a DumpGraph call.
+type graphDump struct {
+	edges   map[string]bool
+	visited map[string]bool
+}
+
+// newGraphDump returns a new graphDump that follows the given edges, or all edges if none were given.
+func newGraphDump(edges []string) *graphDump {
+	d := &graphDump{visited: make(map[string]bool)}
+	if len(edges) > 0 {
+		d.edges = make(map[string]bool, len(edges))
+		for _, name := range edges {
+			d.edges[name] = true
+		}
+	}
+	return d
+}
+
+// follow reports whether the edge with the given name should be followed.
+func (d *graphDump) follow(edge string) bool {
+	return d.edges == nil || d.edges[edge]
+}
+
+// visit marks the given entity as visited, and reports whether it was not visited before.
+func (d *graphDump) visit(label string, id interface{}) bool {
+	key := fmt.Sprintf("%s:%v", label, id)
+	if d.visited[key] {
+		return false
+	}
+	d.visited[key] = true
+	return true
+}
+
 // Code implements the dsl.Node interface.
 func (e ConstraintError) Code() (string, []interface{}) {
 	return strconv.Quote(e.prefix() + e.msg), nil
@@ -274,6 +636,24 @@ func NewErrUniqueEdge(label, edge, id string) *ConstraintError {
 	return &ConstraintError{msg: fmt.Sprintf("edge %s.%s with id: %#v", label, edge, id)}
 }
 
+// FieldsForConstraint returns the field that is covered by the unique constraint that was
+// violated, or nil if the error is not a violation of a unique field.
+func (e *ConstraintError) FieldsForConstraint() []string {
+	const prefix = "field "
+	if !strings.HasPrefix(e.msg, prefix) {
+		return nil
+	}
+	name := strings.TrimPrefix(e.msg, prefix)
+	if i := strings.Index(name, " with value: "); i != -1 {
+		name = name[:i]
+	}
+	i := strings.IndexByte(name, '.')
+	if i == -1 {
+		return nil
+	}
+	return []string{name[i+1:]}
+}
+
 // isConstantError indicates if the given response holds a gremlin constant containing an error.
 func isConstantError(r *gremlin.Response) (*ConstraintError, bool) {
 	e := &ConstraintError{}
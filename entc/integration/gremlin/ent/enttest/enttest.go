@@ -7,6 +7,8 @@
 package enttest
 
 import (
+	"context"
+
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent"
 	// required by schema hooks.
 	_ "github.com/facebookincubator/ent/entc/integration/gremlin/ent/runtime"
@@ -45,6 +47,11 @@ func newOptions(opts []Option) *options {
 
 // Open calls ent.Open and auto-run migration.
 func Open(t TestingT, driverName, dataSourceName string, opts ...Option) *ent.Client {
+	return OpenContext(context.Background(), t, driverName, dataSourceName, opts...)
+}
+
+// OpenContext is like Open, but it runs the migration with the given context.
+func OpenContext(ctx context.Context, t TestingT, driverName, dataSourceName string, opts ...Option) *ent.Client {
 	o := newOptions(opts)
 	c, err := ent.Open(driverName, dataSourceName, o.opts...)
 	if err != nil {
@@ -56,6 +63,11 @@ func Open(t TestingT, driverName, dataSourceName string, opts ...Option) *ent.Cl
 
 // NewClient calls ent.NewClient and auto-run migration.
 func NewClient(t TestingT, opts ...Option) *ent.Client {
+	return NewClientContext(context.Background(), t, opts...)
+}
+
+// NewClientContext is like NewClient, but it runs the migration with the given context.
+func NewClientContext(ctx context.Context, t TestingT, opts ...Option) *ent.Client {
 	o := newOptions(opts)
 	c := ent.NewClient(o.opts...)
 	return c
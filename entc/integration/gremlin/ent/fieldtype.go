@@ -7,7 +7,12 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -70,6 +75,8 @@ type FieldType struct {
 	OptionalFloat32 float32 `json:"optional_float32,omitempty"`
 	// Datetime holds the value of the "datetime" field.
 	Datetime time.Time `json:"datetime,omitempty"`
+	// Decimal holds the value of the "decimal" field.
+	Decimal float64 `json:"decimal,omitempty"`
 }
 
 // FromResponse scans the gremlin response data into FieldType.
@@ -105,6 +112,7 @@ func (ft *FieldType) FromResponse(res *gremlin.Response) error {
 		OptionalFloat         float64         `json:"optional_float,omitempty"`
 		OptionalFloat32       float32         `json:"optional_float32,omitempty"`
 		Datetime              int64           `json:"datetime,omitempty"`
+		Decimal               float64         `json:"decimal,omitempty"`
 	}
 	if err := vmap.Decode(&scanft); err != nil {
 		return err
@@ -135,6 +143,33 @@ func (ft *FieldType) FromResponse(res *gremlin.Response) error {
 	ft.OptionalFloat = scanft.OptionalFloat
 	ft.OptionalFloat32 = scanft.OptionalFloat32
 	ft.Datetime = time.Unix(0, scanft.Datetime)
+	ft.Decimal = scanft.Decimal
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the FieldType and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the FieldType alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The FieldType itself is not modified.
+//
+//	b, err := ft.DumpGraph(ctx, client, 2)
+//
+func (ft *FieldType) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for FieldType DumpGraph", depth)
+	}
+	dumped := *ft
+	if err := dumpFieldTypes(ctx, client, []*FieldType{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpFieldTypes loads the edges of the given FieldType copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpFieldTypes(ctx context.Context, client *Client, nodes []*FieldType, depth int, dumper *graphDump) error {
 	return nil
 }
 
@@ -156,6 +191,46 @@ func (ft *FieldType) Unwrap() *FieldType {
 	return ft
 }
 
+// Clone returns a deep copy of the FieldType and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (ft *FieldType) Clone() *FieldType {
+	return ft.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the FieldType and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (ft *FieldType) clone(seen map[interface{}]interface{}) *FieldType {
+	if ft == nil {
+		return nil
+	}
+	if v, ok := seen[ft]; ok {
+		return v.(*FieldType)
+	}
+	_c := *ft
+	seen[ft] = &_c
+	if v := ft.NillableInt; v != nil {
+		_v := *v
+		_c.NillableInt = &_v
+	}
+	if v := ft.NillableInt8; v != nil {
+		_v := *v
+		_c.NillableInt8 = &_v
+	}
+	if v := ft.NillableInt16; v != nil {
+		_v := *v
+		_c.NillableInt16 = &_v
+	}
+	if v := ft.NillableInt32; v != nil {
+		_v := *v
+		_c.NillableInt32 = &_v
+	}
+	if v := ft.NillableInt64; v != nil {
+		_v := *v
+		_c.NillableInt64 = &_v
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (ft *FieldType) String() string {
 	var builder strings.Builder
@@ -221,10 +296,124 @@ func (ft *FieldType) String() string {
 	builder.WriteString(fmt.Sprintf("%v", ft.OptionalFloat32))
 	builder.WriteString(", datetime=")
 	builder.WriteString(ft.Datetime.Format(time.ANSIC))
+	builder.WriteString(", decimal=")
+	builder.WriteString(fmt.Sprintf("%v", ft.Decimal))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the FieldType, for change detection and cache keys.
+// It covers the fields that are listed in fieldtype.FingerprintFields, and it does not depend on the
+// ID of the FieldType, on its edges, or on the order of the fields in the schema.
+func (ft *FieldType) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "datetime", ft.Datetime)
+	fingerprint(h, "decimal", ft.Decimal)
+	fingerprint(h, "int", ft.Int)
+	fingerprint(h, "int16", ft.Int16)
+	fingerprint(h, "int32", ft.Int32)
+	fingerprint(h, "int64", ft.Int64)
+	fingerprint(h, "int8", ft.Int8)
+	fingerprint(h, "nillable_int", ft.NillableInt)
+	fingerprint(h, "nillable_int16", ft.NillableInt16)
+	fingerprint(h, "nillable_int32", ft.NillableInt32)
+	fingerprint(h, "nillable_int64", ft.NillableInt64)
+	fingerprint(h, "nillable_int8", ft.NillableInt8)
+	fingerprint(h, "optional_float", ft.OptionalFloat)
+	fingerprint(h, "optional_float32", ft.OptionalFloat32)
+	fingerprint(h, "optional_int", ft.OptionalInt)
+	fingerprint(h, "optional_int16", ft.OptionalInt16)
+	fingerprint(h, "optional_int32", ft.OptionalInt32)
+	fingerprint(h, "optional_int64", ft.OptionalInt64)
+	fingerprint(h, "optional_int8", ft.OptionalInt8)
+	fingerprint(h, "optional_uint", ft.OptionalUint)
+	fingerprint(h, "optional_uint16", ft.OptionalUint16)
+	fingerprint(h, "optional_uint32", ft.OptionalUint32)
+	fingerprint(h, "optional_uint64", ft.OptionalUint64)
+	fingerprint(h, "optional_uint8", ft.OptionalUint8)
+	fingerprint(h, "state", ft.State)
+	fingerprint(h, "validate_optional_int32", ft.ValidateOptionalInt32)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the FieldType for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The FieldType can be decoded using the FromMap method of its client.
+func (ft *FieldType) ToMap() map[string]string {
+	m := make(map[string]string, 27)
+	m[fieldtype.FieldID] = kvEncode(ft.ID)
+	m[fieldtype.FieldInt] = kvEncode(ft.Int)
+	m[fieldtype.FieldInt8] = kvEncode(ft.Int8)
+	m[fieldtype.FieldInt16] = kvEncode(ft.Int16)
+	m[fieldtype.FieldInt32] = kvEncode(ft.Int32)
+	m[fieldtype.FieldInt64] = kvEncode(ft.Int64)
+	if v := ft.OptionalInt; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt] = kvEncode(v)
+	}
+	if v := ft.OptionalInt8; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt8] = kvEncode(v)
+	}
+	if v := ft.OptionalInt16; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt16] = kvEncode(v)
+	}
+	if v := ft.OptionalInt32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt32] = kvEncode(v)
+	}
+	if v := ft.OptionalInt64; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalInt64] = kvEncode(v)
+	}
+	if v := ft.NillableInt; v != nil {
+		m[fieldtype.FieldNillableInt] = kvEncode(*v)
+	}
+	if v := ft.NillableInt8; v != nil {
+		m[fieldtype.FieldNillableInt8] = kvEncode(*v)
+	}
+	if v := ft.NillableInt16; v != nil {
+		m[fieldtype.FieldNillableInt16] = kvEncode(*v)
+	}
+	if v := ft.NillableInt32; v != nil {
+		m[fieldtype.FieldNillableInt32] = kvEncode(*v)
+	}
+	if v := ft.NillableInt64; v != nil {
+		m[fieldtype.FieldNillableInt64] = kvEncode(*v)
+	}
+	if v := ft.ValidateOptionalInt32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldValidateOptionalInt32] = kvEncode(v)
+	}
+	if v := ft.OptionalUint; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint] = kvEncode(v)
+	}
+	if v := ft.OptionalUint8; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint8] = kvEncode(v)
+	}
+	if v := ft.OptionalUint16; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint16] = kvEncode(v)
+	}
+	if v := ft.OptionalUint32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint32] = kvEncode(v)
+	}
+	if v := ft.OptionalUint64; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalUint64] = kvEncode(v)
+	}
+	if v := ft.State; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldState] = kvEncode(v)
+	}
+	if v := ft.OptionalFloat; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalFloat] = kvEncode(v)
+	}
+	if v := ft.OptionalFloat32; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldOptionalFloat32] = kvEncode(v)
+	}
+	if v := ft.Datetime; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldDatetime] = kvEncode(v)
+	}
+	if v := ft.Decimal; !reflect.ValueOf(v).IsZero() {
+		m[fieldtype.FieldDecimal] = kvEncode(v)
+	}
+	return m
+}
+
 // FieldTypes is a parsable slice of FieldType.
 type FieldTypes []*FieldType
 
@@ -261,12 +450,13 @@ func (ft *FieldTypes) FromResponse(res *gremlin.Response) error {
 		OptionalFloat         float64         `json:"optional_float,omitempty"`
 		OptionalFloat32       float32         `json:"optional_float32,omitempty"`
 		Datetime              int64           `json:"datetime,omitempty"`
+		Decimal               float64         `json:"decimal,omitempty"`
 	}
 	if err := vmap.Decode(&scanft); err != nil {
 		return err
 	}
 	for _, v := range scanft {
-		*ft = append(*ft, &FieldType{
+		node := &FieldType{
 			ID:                    v.ID,
 			Int:                   v.Int,
 			Int8:                  v.Int8,
@@ -293,7 +483,9 @@ func (ft *FieldTypes) FromResponse(res *gremlin.Response) error {
 			OptionalFloat:         v.OptionalFloat,
 			OptionalFloat32:       v.OptionalFloat32,
 			Datetime:              time.Unix(0, v.Datetime),
-		})
+			Decimal:               v.Decimal,
+		}
+		*ft = append(*ft, node)
 	}
 	return nil
 }
@@ -39,9 +39,41 @@ const (
 	FieldState                 = "state"                   // FieldOptionalFloat holds the string denoting the optional_float vertex property in the database.
 	FieldOptionalFloat         = "optional_float"          // FieldOptionalFloat32 holds the string denoting the optional_float32 vertex property in the database.
 	FieldOptionalFloat32       = "optional_float32"        // FieldDatetime holds the string denoting the datetime vertex property in the database.
-	FieldDatetime              = "datetime"
+	FieldDatetime              = "datetime"                // FieldDecimal holds the string denoting the decimal vertex property in the database.
+	FieldDecimal               = "decimal"
 )
 
+// FingerprintFields holds the fields of the FieldType type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldDatetime,
+	FieldDecimal,
+	FieldInt,
+	FieldInt16,
+	FieldInt32,
+	FieldInt64,
+	FieldInt8,
+	FieldNillableInt,
+	FieldNillableInt16,
+	FieldNillableInt32,
+	FieldNillableInt64,
+	FieldNillableInt8,
+	FieldOptionalFloat,
+	FieldOptionalFloat32,
+	FieldOptionalInt,
+	FieldOptionalInt16,
+	FieldOptionalInt32,
+	FieldOptionalInt64,
+	FieldOptionalInt8,
+	FieldOptionalUint,
+	FieldOptionalUint16,
+	FieldOptionalUint32,
+	FieldOptionalUint64,
+	FieldOptionalUint8,
+	FieldState,
+	FieldValidateOptionalInt32,
+}
+
 var (
 	// ValidateOptionalInt32Validator is a validator for the "validate_optional_int32" field. It is called by the builders before save.
 	ValidateOptionalInt32Validator func(int32) error
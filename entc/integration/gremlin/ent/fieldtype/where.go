@@ -254,6 +254,13 @@ func Datetime(v time.Time) predicate.FieldType {
 	})
 }
 
+// Decimal applies equality check predicate on the "decimal" field. It's identical to DecimalEQ.
+func Decimal(v float64) predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.EQ(v))
+	})
+}
+
 // IntEQ applies the EQ predicate on the "int" field.
 func IntEQ(v int) predicate.FieldType {
 	return predicate.FieldType(func(t *dsl.Traversal) {
@@ -2106,6 +2113,84 @@ func DatetimeNotNil() predicate.FieldType {
 	})
 }
 
+// DecimalEQ applies the EQ predicate on the "decimal" field.
+func DecimalEQ(v float64) predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.EQ(v))
+	})
+}
+
+// DecimalNEQ applies the NEQ predicate on the "decimal" field.
+func DecimalNEQ(v float64) predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.NEQ(v))
+	})
+}
+
+// DecimalIn applies the In predicate on the "decimal" field.
+func DecimalIn(vs ...float64) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.Within(v...))
+	})
+}
+
+// DecimalNotIn applies the NotIn predicate on the "decimal" field.
+func DecimalNotIn(vs ...float64) predicate.FieldType {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.Without(v...))
+	})
+}
+
+// DecimalGT applies the GT predicate on the "decimal" field.
+func DecimalGT(v float64) predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.GT(v))
+	})
+}
+
+// DecimalGTE applies the GTE predicate on the "decimal" field.
+func DecimalGTE(v float64) predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.GTE(v))
+	})
+}
+
+// DecimalLT applies the LT predicate on the "decimal" field.
+func DecimalLT(v float64) predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.LT(v))
+	})
+}
+
+// DecimalLTE applies the LTE predicate on the "decimal" field.
+func DecimalLTE(v float64) predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDecimal, p.LTE(v))
+	})
+}
+
+// DecimalIsNil applies the IsNil predicate on the "decimal" field.
+func DecimalIsNil() predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldDecimal)
+	})
+}
+
+// DecimalNotNil applies the NotNil predicate on the "decimal" field.
+func DecimalNotNil() predicate.FieldType {
+	return predicate.FieldType(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldDecimal)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.FieldType) predicate.FieldType {
 	return predicate.FieldType(func(tr *dsl.Traversal) {
@@ -2140,3 +2225,144 @@ func Not(p predicate.FieldType) predicate.FieldType {
 		tr.Where(__.Not(t))
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// FieldTypeFilter holds optional filters on the FieldType fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type FieldTypeFilter struct {
+	IDIn                  []string
+	Int                   *int
+	Int8                  *int8
+	Int16                 *int16
+	Int32                 *int32
+	Int64                 *int64
+	OptionalInt           *int
+	OptionalInt8          *int8
+	OptionalInt16         *int16
+	OptionalInt32         *int32
+	OptionalInt64         *int64
+	NillableInt           *int
+	NillableInt8          *int8
+	NillableInt16         *int16
+	NillableInt32         *int32
+	NillableInt64         *int64
+	ValidateOptionalInt32 *int32
+	OptionalUint          *uint
+	OptionalUint8         *uint8
+	OptionalUint16        *uint16
+	OptionalUint32        *uint32
+	OptionalUint64        *uint64
+	State                 *State
+	OptionalFloat         *float64
+	OptionalFloat32       *float32
+	Datetime              *TimeRange
+	Decimal               *float64
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.FieldType.Query().
+//		Where(fieldtype.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *FieldTypeFilter) []predicate.FieldType {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.FieldType
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Int != nil {
+		ps = append(ps, IntEQ(*f.Int))
+	}
+	if f.Int8 != nil {
+		ps = append(ps, Int8EQ(*f.Int8))
+	}
+	if f.Int16 != nil {
+		ps = append(ps, Int16EQ(*f.Int16))
+	}
+	if f.Int32 != nil {
+		ps = append(ps, Int32EQ(*f.Int32))
+	}
+	if f.Int64 != nil {
+		ps = append(ps, Int64EQ(*f.Int64))
+	}
+	if f.OptionalInt != nil {
+		ps = append(ps, OptionalIntEQ(*f.OptionalInt))
+	}
+	if f.OptionalInt8 != nil {
+		ps = append(ps, OptionalInt8EQ(*f.OptionalInt8))
+	}
+	if f.OptionalInt16 != nil {
+		ps = append(ps, OptionalInt16EQ(*f.OptionalInt16))
+	}
+	if f.OptionalInt32 != nil {
+		ps = append(ps, OptionalInt32EQ(*f.OptionalInt32))
+	}
+	if f.OptionalInt64 != nil {
+		ps = append(ps, OptionalInt64EQ(*f.OptionalInt64))
+	}
+	if f.NillableInt != nil {
+		ps = append(ps, NillableIntEQ(*f.NillableInt))
+	}
+	if f.NillableInt8 != nil {
+		ps = append(ps, NillableInt8EQ(*f.NillableInt8))
+	}
+	if f.NillableInt16 != nil {
+		ps = append(ps, NillableInt16EQ(*f.NillableInt16))
+	}
+	if f.NillableInt32 != nil {
+		ps = append(ps, NillableInt32EQ(*f.NillableInt32))
+	}
+	if f.NillableInt64 != nil {
+		ps = append(ps, NillableInt64EQ(*f.NillableInt64))
+	}
+	if f.ValidateOptionalInt32 != nil {
+		ps = append(ps, ValidateOptionalInt32EQ(*f.ValidateOptionalInt32))
+	}
+	if f.OptionalUint != nil {
+		ps = append(ps, OptionalUintEQ(*f.OptionalUint))
+	}
+	if f.OptionalUint8 != nil {
+		ps = append(ps, OptionalUint8EQ(*f.OptionalUint8))
+	}
+	if f.OptionalUint16 != nil {
+		ps = append(ps, OptionalUint16EQ(*f.OptionalUint16))
+	}
+	if f.OptionalUint32 != nil {
+		ps = append(ps, OptionalUint32EQ(*f.OptionalUint32))
+	}
+	if f.OptionalUint64 != nil {
+		ps = append(ps, OptionalUint64EQ(*f.OptionalUint64))
+	}
+	if f.State != nil {
+		ps = append(ps, StateEQ(*f.State))
+	}
+	if f.OptionalFloat != nil {
+		ps = append(ps, OptionalFloatEQ(*f.OptionalFloat))
+	}
+	if f.OptionalFloat32 != nil {
+		ps = append(ps, OptionalFloat32EQ(*f.OptionalFloat32))
+	}
+	if r := f.Datetime; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, DatetimeGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, DatetimeLT(r.To))
+		}
+	}
+	if f.Decimal != nil {
+		ps = append(ps, DecimalEQ(*f.Decimal))
+	}
+	return ps
+}
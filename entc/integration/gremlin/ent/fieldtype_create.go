@@ -335,32 +335,28 @@ func (ftc *FieldTypeCreate) SetNillableDatetime(t *time.Time) *FieldTypeCreate {
 	return ftc
 }
 
+// SetDecimal sets the decimal field.
+func (ftc *FieldTypeCreate) SetDecimal(f float64) *FieldTypeCreate {
+	ftc.mutation.SetDecimal(f)
+	return ftc
+}
+
+// SetNillableDecimal sets the decimal field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableDecimal(f *float64) *FieldTypeCreate {
+	if f != nil {
+		ftc.SetDecimal(*f)
+	}
+	return ftc
+}
+
 // Save creates the FieldType in the database.
 func (ftc *FieldTypeCreate) Save(ctx context.Context) (*FieldType, error) {
-	if _, ok := ftc.mutation.Int(); !ok {
-		return nil, errors.New("ent: missing required field \"int\"")
-	}
-	if _, ok := ftc.mutation.Int8(); !ok {
-		return nil, errors.New("ent: missing required field \"int8\"")
-	}
-	if _, ok := ftc.mutation.Int16(); !ok {
-		return nil, errors.New("ent: missing required field \"int16\"")
-	}
-	if _, ok := ftc.mutation.Int32(); !ok {
-		return nil, errors.New("ent: missing required field \"int32\"")
-	}
-	if _, ok := ftc.mutation.Int64(); !ok {
-		return nil, errors.New("ent: missing required field \"int64\"")
-	}
-	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
-		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
-		}
+	if err := checkTx(ftc.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := ftc.mutation.State(); ok {
-		if err := fieldtype.StateValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
-		}
+	ftc.defaults()
+	if err := ftc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -397,6 +393,60 @@ func (ftc *FieldTypeCreate) SaveX(ctx context.Context) *FieldType {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftc *FieldTypeCreate) Unwrap() *FieldTypeCreate {
+	tx, ok := ftc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeCreate is not a transactional builder")
+	}
+	ftc.config.driver = tx.drv
+	ftc.mutation.driver = tx.drv
+	return ftc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (ftc *FieldTypeCreate) DebugFields() map[string]Value {
+	return debugFields(ftc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (ftc *FieldTypeCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ftc *FieldTypeCreate) check() error {
+	if _, ok := ftc.mutation.Int(); !ok {
+		return &ValidationError{Name: "int", err: errors.New("ent: missing required field \"int\"")}
+	}
+	if _, ok := ftc.mutation.Int8(); !ok {
+		return &ValidationError{Name: "int8", err: errors.New("ent: missing required field \"int8\"")}
+	}
+	if _, ok := ftc.mutation.Int16(); !ok {
+		return &ValidationError{Name: "int16", err: errors.New("ent: missing required field \"int16\"")}
+	}
+	if _, ok := ftc.mutation.Int32(); !ok {
+		return &ValidationError{Name: "int32", err: errors.New("ent: missing required field \"int32\"")}
+	}
+	if _, ok := ftc.mutation.Int64(); !ok {
+		return &ValidationError{Name: "int64", err: errors.New("ent: missing required field \"int64\"")}
+	}
+	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
+			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if v, ok := ftc.mutation.State(); ok {
+		if err := fieldtype.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (ftc *FieldTypeCreate) gremlinSave(ctx context.Context) (*FieldType, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftc.gremlin().Query()
@@ -490,5 +540,8 @@ func (ftc *FieldTypeCreate) gremlin() *dsl.Traversal {
 	if value, ok := ftc.mutation.Datetime(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDatetime, value)
 	}
+	if value, ok := ftc.mutation.Decimal(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDecimal, value)
+	}
 	return v.ValueMap(true)
 }
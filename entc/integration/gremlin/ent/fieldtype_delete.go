@@ -24,6 +24,7 @@ type FieldTypeDelete struct {
 	hooks      []Hook
 	mutation   *FieldTypeMutation
 	predicates []predicate.FieldType
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (ftd *FieldTypeDelete) Where(ps ...predicate.FieldType) *FieldTypeDelete {
 	return ftd
 }
 
+// Limit limits the number of fieldtypes that are deleted by the builder. For example,
+// for deleting large amounts of fieldtypes in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (ftd *FieldTypeDelete) Limit(limit int) *FieldTypeDelete {
+	ftd.limit = &limit
+	return ftd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftd *FieldTypeDelete) Unwrap() *FieldTypeDelete {
+	tx, ok := ftd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeDelete is not a transactional builder")
+	}
+	ftd.config.driver = tx.drv
+	ftd.mutation.driver = tx.drv
+	return ftd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (ftd *FieldTypeDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(ftd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (ftd *FieldTypeDelete) gremlin() *dsl.Traversal {
 	for _, p := range ftd.predicates {
 		p(t)
 	}
+	if limit := ftd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -38,6 +39,19 @@ func (ftq *FieldTypeQuery) Where(ps ...predicate.FieldType) *FieldTypeQuery {
 	return ftq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (ftq *FieldTypeQuery) Predicates() []predicate.FieldType {
+	return append([]predicate.FieldType{}, ftq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (ftq *FieldTypeQuery) SetPredicates(ps ...predicate.FieldType) *FieldTypeQuery {
+	ftq.predicates = append([]predicate.FieldType{}, ps...)
+	return ftq
+}
+
 // Limit adds a limit step to the query.
 func (ftq *FieldTypeQuery) Limit(limit int) *FieldTypeQuery {
 	ftq.limit = &limit
@@ -621,6 +635,27 @@ func (fts *FieldTypeSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (fts *FieldTypeSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(fts.fields) > 1 {
+		return nil, errors.New("ent: FieldTypeSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := fts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (fts *FieldTypeSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := fts.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (fts *FieldTypeSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
@@ -25,6 +25,7 @@ type FieldTypeUpdate struct {
 	hooks      []Hook
 	mutation   *FieldTypeMutation
 	predicates []predicate.FieldType
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -33,6 +34,13 @@ func (ftu *FieldTypeUpdate) Where(ps ...predicate.FieldType) *FieldTypeUpdate {
 	return ftu
 }
 
+// Limit limits the number of fieldtypes that are updated by the builder. For example,
+// for updating large amounts of fieldtypes in small batches without locking the whole table.
+func (ftu *FieldTypeUpdate) Limit(limit int) *FieldTypeUpdate {
+	ftu.limit = &limit
+	return ftu
+}
+
 // SetInt sets the int field.
 func (ftu *FieldTypeUpdate) SetInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetInt()
@@ -46,6 +54,13 @@ func (ftu *FieldTypeUpdate) AddInt(i int) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt removes the changes of the int field from the builder (e.g. a previous call
+// to SetInt), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt() *FieldTypeUpdate {
+	ftu.mutation.ResetInt()
+	return ftu
+}
+
 // SetInt8 sets the int8 field.
 func (ftu *FieldTypeUpdate) SetInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetInt8()
@@ -59,6 +74,13 @@ func (ftu *FieldTypeUpdate) AddInt8(i int8) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt8 removes the changes of the int8 field from the builder (e.g. a previous call
+// to SetInt8), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt8() *FieldTypeUpdate {
+	ftu.mutation.ResetInt8()
+	return ftu
+}
+
 // SetInt16 sets the int16 field.
 func (ftu *FieldTypeUpdate) SetInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetInt16()
@@ -72,6 +94,13 @@ func (ftu *FieldTypeUpdate) AddInt16(i int16) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt16 removes the changes of the int16 field from the builder (e.g. a previous call
+// to SetInt16), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt16() *FieldTypeUpdate {
+	ftu.mutation.ResetInt16()
+	return ftu
+}
+
 // SetInt32 sets the int32 field.
 func (ftu *FieldTypeUpdate) SetInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetInt32()
@@ -85,6 +114,13 @@ func (ftu *FieldTypeUpdate) AddInt32(i int32) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt32 removes the changes of the int32 field from the builder (e.g. a previous call
+// to SetInt32), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetInt32()
+	return ftu
+}
+
 // SetInt64 sets the int64 field.
 func (ftu *FieldTypeUpdate) SetInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetInt64()
@@ -98,6 +134,13 @@ func (ftu *FieldTypeUpdate) AddInt64(i int64) *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetInt64 removes the changes of the int64 field from the builder (e.g. a previous call
+// to SetInt64), and therefore, the field is left unchanged in the database.
+func (ftu *FieldTypeUpdate) UnsetInt64() *FieldTypeUpdate {
+	ftu.mutation.ResetInt64()
+	return ftu
+}
+
 // SetOptionalInt sets the optional_int field.
 func (ftu *FieldTypeUpdate) SetOptionalInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt()
@@ -125,6 +168,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt removes the changes of the optional_int field from the builder (e.g. a previous call
+// to SetOptionalInt), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt()
+	return ftu
+}
+
 // SetOptionalInt8 sets the optional_int8 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt8()
@@ -152,6 +203,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt8() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt8 removes the changes of the optional_int8 field from the builder (e.g. a previous call
+// to SetOptionalInt8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt8.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt8() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt8()
+	return ftu
+}
+
 // SetOptionalInt16 sets the optional_int16 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt16()
@@ -179,6 +238,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt16() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt16 removes the changes of the optional_int16 field from the builder (e.g. a previous call
+// to SetOptionalInt16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt16.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt16() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt16()
+	return ftu
+}
+
 // SetOptionalInt32 sets the optional_int32 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt32()
@@ -206,6 +273,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt32 removes the changes of the optional_int32 field from the builder (e.g. a previous call
+// to SetOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt32.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt32()
+	return ftu
+}
+
 // SetOptionalInt64 sets the optional_int64 field.
 func (ftu *FieldTypeUpdate) SetOptionalInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalInt64()
@@ -233,6 +308,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalInt64() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalInt64 removes the changes of the optional_int64 field from the builder (e.g. a previous call
+// to SetOptionalInt64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt64.
+func (ftu *FieldTypeUpdate) UnsetOptionalInt64() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalInt64()
+	return ftu
+}
+
 // SetNillableInt sets the nillable_int field.
 func (ftu *FieldTypeUpdate) SetNillableInt(i int) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt()
@@ -260,6 +343,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (ftu *FieldTypeUpdate) UnsetNillableInt() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt()
+	return ftu
+}
+
 // SetNillableInt8 sets the nillable_int8 field.
 func (ftu *FieldTypeUpdate) SetNillableInt8(i int8) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt8()
@@ -287,6 +378,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt8() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt8 removes the changes of the nillable_int8 field from the builder (e.g. a previous call
+// to SetNillableInt8), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt8.
+func (ftu *FieldTypeUpdate) UnsetNillableInt8() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt8()
+	return ftu
+}
+
 // SetNillableInt16 sets the nillable_int16 field.
 func (ftu *FieldTypeUpdate) SetNillableInt16(i int16) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt16()
@@ -314,6 +413,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt16() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt16 removes the changes of the nillable_int16 field from the builder (e.g. a previous call
+// to SetNillableInt16), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt16.
+func (ftu *FieldTypeUpdate) UnsetNillableInt16() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt16()
+	return ftu
+}
+
 // SetNillableInt32 sets the nillable_int32 field.
 func (ftu *FieldTypeUpdate) SetNillableInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt32()
@@ -341,6 +448,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt32 removes the changes of the nillable_int32 field from the builder (e.g. a previous call
+// to SetNillableInt32), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt32.
+func (ftu *FieldTypeUpdate) UnsetNillableInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt32()
+	return ftu
+}
+
 // SetNillableInt64 sets the nillable_int64 field.
 func (ftu *FieldTypeUpdate) SetNillableInt64(i int64) *FieldTypeUpdate {
 	ftu.mutation.ResetNillableInt64()
@@ -368,6 +483,14 @@ func (ftu *FieldTypeUpdate) ClearNillableInt64() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetNillableInt64 removes the changes of the nillable_int64 field from the builder (e.g. a previous call
+// to SetNillableInt64), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt64.
+func (ftu *FieldTypeUpdate) UnsetNillableInt64() *FieldTypeUpdate {
+	ftu.mutation.ResetNillableInt64()
+	return ftu
+}
+
 // SetValidateOptionalInt32 sets the validate_optional_int32 field.
 func (ftu *FieldTypeUpdate) SetValidateOptionalInt32(i int32) *FieldTypeUpdate {
 	ftu.mutation.ResetValidateOptionalInt32()
@@ -395,6 +518,14 @@ func (ftu *FieldTypeUpdate) ClearValidateOptionalInt32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetValidateOptionalInt32 removes the changes of the validate_optional_int32 field from the builder (e.g. a previous call
+// to SetValidateOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearValidateOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearValidateOptionalInt32.
+func (ftu *FieldTypeUpdate) UnsetValidateOptionalInt32() *FieldTypeUpdate {
+	ftu.mutation.ResetValidateOptionalInt32()
+	return ftu
+}
+
 // SetOptionalUint sets the optional_uint field.
 func (ftu *FieldTypeUpdate) SetOptionalUint(u uint) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint()
@@ -422,6 +553,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint removes the changes of the optional_uint field from the builder (e.g. a previous call
+// to SetOptionalUint), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint()
+	return ftu
+}
+
 // SetOptionalUint8 sets the optional_uint8 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint8(u uint8) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint8()
@@ -449,6 +588,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint8() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint8 removes the changes of the optional_uint8 field from the builder (e.g. a previous call
+// to SetOptionalUint8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint8.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint8() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint8()
+	return ftu
+}
+
 // SetOptionalUint16 sets the optional_uint16 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint16(u uint16) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint16()
@@ -476,6 +623,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint16() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint16 removes the changes of the optional_uint16 field from the builder (e.g. a previous call
+// to SetOptionalUint16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint16.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint16() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint16()
+	return ftu
+}
+
 // SetOptionalUint32 sets the optional_uint32 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint32(u uint32) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint32()
@@ -503,6 +658,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint32 removes the changes of the optional_uint32 field from the builder (e.g. a previous call
+// to SetOptionalUint32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint32.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint32() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint32()
+	return ftu
+}
+
 // SetOptionalUint64 sets the optional_uint64 field.
 func (ftu *FieldTypeUpdate) SetOptionalUint64(u uint64) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalUint64()
@@ -530,6 +693,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalUint64() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalUint64 removes the changes of the optional_uint64 field from the builder (e.g. a previous call
+// to SetOptionalUint64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint64.
+func (ftu *FieldTypeUpdate) UnsetOptionalUint64() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalUint64()
+	return ftu
+}
+
 // SetState sets the state field.
 func (ftu *FieldTypeUpdate) SetState(f fieldtype.State) *FieldTypeUpdate {
 	ftu.mutation.SetState(f)
@@ -550,6 +721,14 @@ func (ftu *FieldTypeUpdate) ClearState() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (ftu *FieldTypeUpdate) UnsetState() *FieldTypeUpdate {
+	ftu.mutation.ResetState()
+	return ftu
+}
+
 // SetOptionalFloat sets the optional_float field.
 func (ftu *FieldTypeUpdate) SetOptionalFloat(f float64) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalFloat()
@@ -577,6 +756,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalFloat() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalFloat removes the changes of the optional_float field from the builder (e.g. a previous call
+// to SetOptionalFloat), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat.
+func (ftu *FieldTypeUpdate) UnsetOptionalFloat() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalFloat()
+	return ftu
+}
+
 // SetOptionalFloat32 sets the optional_float32 field.
 func (ftu *FieldTypeUpdate) SetOptionalFloat32(f float32) *FieldTypeUpdate {
 	ftu.mutation.ResetOptionalFloat32()
@@ -604,6 +791,14 @@ func (ftu *FieldTypeUpdate) ClearOptionalFloat32() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetOptionalFloat32 removes the changes of the optional_float32 field from the builder (e.g. a previous call
+// to SetOptionalFloat32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat32.
+func (ftu *FieldTypeUpdate) UnsetOptionalFloat32() *FieldTypeUpdate {
+	ftu.mutation.ResetOptionalFloat32()
+	return ftu
+}
+
 // SetDatetime sets the datetime field.
 func (ftu *FieldTypeUpdate) SetDatetime(t time.Time) *FieldTypeUpdate {
 	ftu.mutation.SetDatetime(t)
@@ -624,17 +819,75 @@ func (ftu *FieldTypeUpdate) ClearDatetime() *FieldTypeUpdate {
 	return ftu
 }
 
+// UnsetDatetime removes the changes of the datetime field from the builder (e.g. a previous call
+// to SetDatetime), and therefore, the field is left unchanged in the database. Unlike ClearDatetime,
+// it does not set the field to NULL, and also removes a previous call to ClearDatetime.
+func (ftu *FieldTypeUpdate) UnsetDatetime() *FieldTypeUpdate {
+	ftu.mutation.ResetDatetime()
+	return ftu
+}
+
+// SetDecimal sets the decimal field.
+func (ftu *FieldTypeUpdate) SetDecimal(f float64) *FieldTypeUpdate {
+	ftu.mutation.ResetDecimal()
+	ftu.mutation.SetDecimal(f)
+	return ftu
+}
+
+// SetNillableDecimal sets the decimal field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableDecimal(f *float64) *FieldTypeUpdate {
+	if f != nil {
+		ftu.SetDecimal(*f)
+	}
+	return ftu
+}
+
+// AddDecimal adds f to decimal.
+func (ftu *FieldTypeUpdate) AddDecimal(f float64) *FieldTypeUpdate {
+	ftu.mutation.AddDecimal(f)
+	return ftu
+}
+
+// ClearDecimal clears the value of decimal.
+func (ftu *FieldTypeUpdate) ClearDecimal() *FieldTypeUpdate {
+	ftu.mutation.ClearDecimal()
+	return ftu
+}
+
+// UnsetDecimal removes the changes of the decimal field from the builder (e.g. a previous call
+// to SetDecimal), and therefore, the field is left unchanged in the database. Unlike ClearDecimal,
+// it does not set the field to NULL, and also removes a previous call to ClearDecimal.
+func (ftu *FieldTypeUpdate) UnsetDecimal() *FieldTypeUpdate {
+	ftu.mutation.ResetDecimal()
+	return ftu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftu *FieldTypeUpdate) Unwrap() *FieldTypeUpdate {
+	tx, ok := ftu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeUpdate is not a transactional builder")
+	}
+	ftu.config.driver = tx.drv
+	ftu.mutation.driver = tx.drv
+	return ftu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftu *FieldTypeUpdate) DebugFields() map[string]Value {
+	return debugFields(ftu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (ftu *FieldTypeUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := ftu.mutation.ValidateOptionalInt32(); ok {
-		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
-		}
+	if err := checkTx(ftu.driver); err != nil {
+		return 0, err
 	}
-	if v, ok := ftu.mutation.State(); ok {
-		if err := fieldtype.StateValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
-		}
+	if err := ftu.check(); err != nil {
+		return 0, err
 	}
 	var (
 		err      error
@@ -684,6 +937,21 @@ func (ftu *FieldTypeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftu *FieldTypeUpdate) check() error {
+	if v, ok := ftu.mutation.ValidateOptionalInt32(); ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
+			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if v, ok := ftu.mutation.State(); ok {
+		if err := fieldtype.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (ftu *FieldTypeUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftu.gremlin().Query()
@@ -701,6 +969,9 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	for _, p := range ftu.predicates {
 		p(v)
 	}
+	if limit := ftu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		trs []*dsl.Traversal
 	)
@@ -848,6 +1119,12 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := ftu.mutation.Datetime(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDatetime, value)
 	}
+	if value, ok := ftu.mutation.Decimal(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDecimal, value)
+	}
+	if value, ok := ftu.mutation.AddedDecimal(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDecimal, __.Union(__.Values(fieldtype.FieldDecimal), __.Constant(value)).Sum())
+	}
 	var properties []interface{}
 	if ftu.mutation.OptionalIntCleared() {
 		properties = append(properties, fieldtype.FieldOptionalInt)
@@ -909,6 +1186,9 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if ftu.mutation.DatetimeCleared() {
 		properties = append(properties, fieldtype.FieldDatetime)
 	}
+	if ftu.mutation.DecimalCleared() {
+		properties = append(properties, fieldtype.FieldDecimal)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
@@ -920,8 +1200,16 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 // FieldTypeUpdateOne is the builder for updating a single FieldType entity.
 type FieldTypeUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *FieldTypeMutation
+	hooks      []Hook
+	mutation   *FieldTypeMutation
+	predicates []predicate.FieldType
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (ftuo *FieldTypeUpdateOne) Where(ps ...predicate.FieldType) *FieldTypeUpdateOne {
+	ftuo.predicates = append(ftuo.predicates, ps...)
+	return ftuo
 }
 
 // SetInt sets the int field.
@@ -937,6 +1225,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt(i int) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt removes the changes of the int field from the builder (e.g. a previous call
+// to SetInt), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt()
+	return ftuo
+}
+
 // SetInt8 sets the int8 field.
 func (ftuo *FieldTypeUpdateOne) SetInt8(i int8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt8()
@@ -950,6 +1245,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt8(i int8) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt8 removes the changes of the int8 field from the builder (e.g. a previous call
+// to SetInt8), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt8()
+	return ftuo
+}
+
 // SetInt16 sets the int16 field.
 func (ftuo *FieldTypeUpdateOne) SetInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt16()
@@ -963,6 +1265,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt16(i int16) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt16 removes the changes of the int16 field from the builder (e.g. a previous call
+// to SetInt16), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt16()
+	return ftuo
+}
+
 // SetInt32 sets the int32 field.
 func (ftuo *FieldTypeUpdateOne) SetInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt32()
@@ -976,6 +1285,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt32(i int32) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt32 removes the changes of the int32 field from the builder (e.g. a previous call
+// to SetInt32), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt32()
+	return ftuo
+}
+
 // SetInt64 sets the int64 field.
 func (ftuo *FieldTypeUpdateOne) SetInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetInt64()
@@ -989,6 +1305,13 @@ func (ftuo *FieldTypeUpdateOne) AddInt64(i int64) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetInt64 removes the changes of the int64 field from the builder (e.g. a previous call
+// to SetInt64), and therefore, the field is left unchanged in the database.
+func (ftuo *FieldTypeUpdateOne) UnsetInt64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetInt64()
+	return ftuo
+}
+
 // SetOptionalInt sets the optional_int field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt(i int) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt()
@@ -1016,6 +1339,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt removes the changes of the optional_int field from the builder (e.g. a previous call
+// to SetOptionalInt), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt()
+	return ftuo
+}
+
 // SetOptionalInt8 sets the optional_int8 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt8(i int8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt8()
@@ -1043,6 +1374,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt8() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt8 removes the changes of the optional_int8 field from the builder (e.g. a previous call
+// to SetOptionalInt8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt8.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt8()
+	return ftuo
+}
+
 // SetOptionalInt16 sets the optional_int16 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt16()
@@ -1070,6 +1409,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt16() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt16 removes the changes of the optional_int16 field from the builder (e.g. a previous call
+// to SetOptionalInt16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt16.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt16()
+	return ftuo
+}
+
 // SetOptionalInt32 sets the optional_int32 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt32()
@@ -1097,6 +1444,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt32 removes the changes of the optional_int32 field from the builder (e.g. a previous call
+// to SetOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt32.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt32()
+	return ftuo
+}
+
 // SetOptionalInt64 sets the optional_int64 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalInt64()
@@ -1124,6 +1479,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalInt64() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalInt64 removes the changes of the optional_int64 field from the builder (e.g. a previous call
+// to SetOptionalInt64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalInt64.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalInt64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalInt64()
+	return ftuo
+}
+
 // SetNillableInt sets the nillable_int field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt(i int) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt()
@@ -1151,6 +1514,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt removes the changes of the nillable_int field from the builder (e.g. a previous call
+// to SetNillableInt), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt()
+	return ftuo
+}
+
 // SetNillableInt8 sets the nillable_int8 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt8(i int8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt8()
@@ -1178,6 +1549,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt8() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt8 removes the changes of the nillable_int8 field from the builder (e.g. a previous call
+// to SetNillableInt8), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt8,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt8.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt8()
+	return ftuo
+}
+
 // SetNillableInt16 sets the nillable_int16 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt16(i int16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt16()
@@ -1205,6 +1584,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt16() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt16 removes the changes of the nillable_int16 field from the builder (e.g. a previous call
+// to SetNillableInt16), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt16,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt16.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt16()
+	return ftuo
+}
+
 // SetNillableInt32 sets the nillable_int32 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt32()
@@ -1232,6 +1619,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt32 removes the changes of the nillable_int32 field from the builder (e.g. a previous call
+// to SetNillableInt32), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt32.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt32()
+	return ftuo
+}
+
 // SetNillableInt64 sets the nillable_int64 field.
 func (ftuo *FieldTypeUpdateOne) SetNillableInt64(i int64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetNillableInt64()
@@ -1259,6 +1654,14 @@ func (ftuo *FieldTypeUpdateOne) ClearNillableInt64() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetNillableInt64 removes the changes of the nillable_int64 field from the builder (e.g. a previous call
+// to SetNillableInt64), and therefore, the field is left unchanged in the database. Unlike ClearNillableInt64,
+// it does not set the field to NULL, and also removes a previous call to ClearNillableInt64.
+func (ftuo *FieldTypeUpdateOne) UnsetNillableInt64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetNillableInt64()
+	return ftuo
+}
+
 // SetValidateOptionalInt32 sets the validate_optional_int32 field.
 func (ftuo *FieldTypeUpdateOne) SetValidateOptionalInt32(i int32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetValidateOptionalInt32()
@@ -1286,6 +1689,14 @@ func (ftuo *FieldTypeUpdateOne) ClearValidateOptionalInt32() *FieldTypeUpdateOne
 	return ftuo
 }
 
+// UnsetValidateOptionalInt32 removes the changes of the validate_optional_int32 field from the builder (e.g. a previous call
+// to SetValidateOptionalInt32), and therefore, the field is left unchanged in the database. Unlike ClearValidateOptionalInt32,
+// it does not set the field to NULL, and also removes a previous call to ClearValidateOptionalInt32.
+func (ftuo *FieldTypeUpdateOne) UnsetValidateOptionalInt32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetValidateOptionalInt32()
+	return ftuo
+}
+
 // SetOptionalUint sets the optional_uint field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint(u uint) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint()
@@ -1313,6 +1724,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint removes the changes of the optional_uint field from the builder (e.g. a previous call
+// to SetOptionalUint), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint()
+	return ftuo
+}
+
 // SetOptionalUint8 sets the optional_uint8 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint8(u uint8) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint8()
@@ -1340,6 +1759,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint8() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint8 removes the changes of the optional_uint8 field from the builder (e.g. a previous call
+// to SetOptionalUint8), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint8,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint8.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint8() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint8()
+	return ftuo
+}
+
 // SetOptionalUint16 sets the optional_uint16 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint16(u uint16) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint16()
@@ -1367,6 +1794,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint16() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint16 removes the changes of the optional_uint16 field from the builder (e.g. a previous call
+// to SetOptionalUint16), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint16,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint16.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint16() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint16()
+	return ftuo
+}
+
 // SetOptionalUint32 sets the optional_uint32 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint32(u uint32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint32()
@@ -1394,6 +1829,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint32 removes the changes of the optional_uint32 field from the builder (e.g. a previous call
+// to SetOptionalUint32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint32.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint32()
+	return ftuo
+}
+
 // SetOptionalUint64 sets the optional_uint64 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalUint64(u uint64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalUint64()
@@ -1421,6 +1864,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalUint64() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalUint64 removes the changes of the optional_uint64 field from the builder (e.g. a previous call
+// to SetOptionalUint64), and therefore, the field is left unchanged in the database. Unlike ClearOptionalUint64,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalUint64.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalUint64() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalUint64()
+	return ftuo
+}
+
 // SetState sets the state field.
 func (ftuo *FieldTypeUpdateOne) SetState(f fieldtype.State) *FieldTypeUpdateOne {
 	ftuo.mutation.SetState(f)
@@ -1441,6 +1892,14 @@ func (ftuo *FieldTypeUpdateOne) ClearState() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (ftuo *FieldTypeUpdateOne) UnsetState() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetState()
+	return ftuo
+}
+
 // SetOptionalFloat sets the optional_float field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalFloat(f float64) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalFloat()
@@ -1468,6 +1927,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalFloat removes the changes of the optional_float field from the builder (e.g. a previous call
+// to SetOptionalFloat), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalFloat() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalFloat()
+	return ftuo
+}
+
 // SetOptionalFloat32 sets the optional_float32 field.
 func (ftuo *FieldTypeUpdateOne) SetOptionalFloat32(f float32) *FieldTypeUpdateOne {
 	ftuo.mutation.ResetOptionalFloat32()
@@ -1495,6 +1962,14 @@ func (ftuo *FieldTypeUpdateOne) ClearOptionalFloat32() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetOptionalFloat32 removes the changes of the optional_float32 field from the builder (e.g. a previous call
+// to SetOptionalFloat32), and therefore, the field is left unchanged in the database. Unlike ClearOptionalFloat32,
+// it does not set the field to NULL, and also removes a previous call to ClearOptionalFloat32.
+func (ftuo *FieldTypeUpdateOne) UnsetOptionalFloat32() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetOptionalFloat32()
+	return ftuo
+}
+
 // SetDatetime sets the datetime field.
 func (ftuo *FieldTypeUpdateOne) SetDatetime(t time.Time) *FieldTypeUpdateOne {
 	ftuo.mutation.SetDatetime(t)
@@ -1515,17 +1990,75 @@ func (ftuo *FieldTypeUpdateOne) ClearDatetime() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetDatetime removes the changes of the datetime field from the builder (e.g. a previous call
+// to SetDatetime), and therefore, the field is left unchanged in the database. Unlike ClearDatetime,
+// it does not set the field to NULL, and also removes a previous call to ClearDatetime.
+func (ftuo *FieldTypeUpdateOne) UnsetDatetime() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetDatetime()
+	return ftuo
+}
+
+// SetDecimal sets the decimal field.
+func (ftuo *FieldTypeUpdateOne) SetDecimal(f float64) *FieldTypeUpdateOne {
+	ftuo.mutation.ResetDecimal()
+	ftuo.mutation.SetDecimal(f)
+	return ftuo
+}
+
+// SetNillableDecimal sets the decimal field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableDecimal(f *float64) *FieldTypeUpdateOne {
+	if f != nil {
+		ftuo.SetDecimal(*f)
+	}
+	return ftuo
+}
+
+// AddDecimal adds f to decimal.
+func (ftuo *FieldTypeUpdateOne) AddDecimal(f float64) *FieldTypeUpdateOne {
+	ftuo.mutation.AddDecimal(f)
+	return ftuo
+}
+
+// ClearDecimal clears the value of decimal.
+func (ftuo *FieldTypeUpdateOne) ClearDecimal() *FieldTypeUpdateOne {
+	ftuo.mutation.ClearDecimal()
+	return ftuo
+}
+
+// UnsetDecimal removes the changes of the decimal field from the builder (e.g. a previous call
+// to SetDecimal), and therefore, the field is left unchanged in the database. Unlike ClearDecimal,
+// it does not set the field to NULL, and also removes a previous call to ClearDecimal.
+func (ftuo *FieldTypeUpdateOne) UnsetDecimal() *FieldTypeUpdateOne {
+	ftuo.mutation.ResetDecimal()
+	return ftuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftuo *FieldTypeUpdateOne) Unwrap() *FieldTypeUpdateOne {
+	tx, ok := ftuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FieldTypeUpdateOne is not a transactional builder")
+	}
+	ftuo.config.driver = tx.drv
+	ftuo.mutation.driver = tx.drv
+	return ftuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftuo *FieldTypeUpdateOne) DebugFields() map[string]Value {
+	return debugFields(ftuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (ftuo *FieldTypeUpdateOne) Save(ctx context.Context) (*FieldType, error) {
-	if v, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
-		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)
-		}
+	if err := checkTx(ftuo.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := ftuo.mutation.State(); ok {
-		if err := fieldtype.StateValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"state\": %v", err)
-		}
+	if err := ftuo.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -1575,6 +2108,21 @@ func (ftuo *FieldTypeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftuo *FieldTypeUpdateOne) check() error {
+	if v, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
+		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
+			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf("ent: validator failed for field \"validate_optional_int32\": %v", err)}
+		}
+	}
+	if v, ok := ftuo.mutation.State(); ok {
+		if err := fieldtype.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("ent: validator failed for field \"state\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (ftuo *FieldTypeUpdateOne) gremlinSave(ctx context.Context) (*FieldType, error) {
 	res := &gremlin.Response{}
 	id, ok := ftuo.mutation.ID()
@@ -1588,6 +2136,15 @@ func (ftuo *FieldTypeUpdateOne) gremlinSave(ctx context.Context) (*FieldType, er
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(ftuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{fieldtype.Label}
+		}
+	}
 	ft := &FieldType{config: ftuo.config}
 	if err := ft.FromResponse(res); err != nil {
 		return nil, err
@@ -1597,6 +2154,9 @@ func (ftuo *FieldTypeUpdateOne) gremlinSave(ctx context.Context) (*FieldType, er
 
 func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	v := g.V(id)
+	for _, p := range ftuo.predicates {
+		p(v)
+	}
 	var (
 		trs []*dsl.Traversal
 	)
@@ -1744,6 +2304,12 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := ftuo.mutation.Datetime(); ok {
 		v.Property(dsl.Single, fieldtype.FieldDatetime, value)
 	}
+	if value, ok := ftuo.mutation.Decimal(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDecimal, value)
+	}
+	if value, ok := ftuo.mutation.AddedDecimal(); ok {
+		v.Property(dsl.Single, fieldtype.FieldDecimal, __.Union(__.Values(fieldtype.FieldDecimal), __.Constant(value)).Sum())
+	}
 	var properties []interface{}
 	if ftuo.mutation.OptionalIntCleared() {
 		properties = append(properties, fieldtype.FieldOptionalInt)
@@ -1805,6 +2371,9 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if ftuo.mutation.DatetimeCleared() {
 		properties = append(properties, fieldtype.FieldDatetime)
 	}
+	if ftuo.mutation.DecimalCleared() {
+		properties = append(properties, fieldtype.FieldDecimal)
+	}
 	if len(properties) > 0 {
 		v.SideEffect(__.Properties(properties...).Drop())
 	}
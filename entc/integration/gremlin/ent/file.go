@@ -7,10 +7,16 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/file"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/filetype"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/user"
 )
@@ -122,6 +128,139 @@ func (f *File) QueryField() *FieldTypeQuery {
 	return (&FileClient{config: f.config}).QueryField(f)
 }
 
+// LoadEdges loads the given edges of the File using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see file.Edges), and in this case, no edge is loaded.
+//
+//	err := f.LoadEdges(ctx, client, file.EdgeOwner, file.EdgeType)
+//
+func (f *File) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadFileEdges(ctx, client, []*File{f}, edges)
+}
+
+// loadFileEdges loads the given edges of all the given File entities.
+func loadFileEdges(ctx context.Context, client *Client, nodes []*File, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case file.EdgeOwner, file.EdgeType, file.EdgeField:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type File", name)
+		}
+	}
+	for _, name := range edges {
+		for _, n := range nodes {
+			switch name {
+			case file.EdgeOwner:
+				node, err := client.File.QueryOwner(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Owner = node
+				n.Edges.loadedTypes[0] = true
+			case file.EdgeType:
+				node, err := client.File.QueryType(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Type = node
+				n.Edges.loadedTypes[1] = true
+			case file.EdgeField:
+				loaded, err := client.File.QueryField(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Field = loaded
+				n.Edges.loadedTypes[2] = true
+			}
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the File and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the File alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The File itself is not modified.
+//
+//	b, err := f.DumpGraph(ctx, client, 2, file.EdgeOwner)
+//
+func (f *File) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for File DumpGraph", depth)
+	}
+	dumped := *f
+	dumped.Edges = FileEdges{}
+	if err := dumpFiles(ctx, client, []*File{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpFiles loads the edges of the given File copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpFiles(ctx context.Context, client *Client, nodes []*File, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*File
+	for _, n := range nodes {
+		if dumper.visit(file.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range file.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadFileEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextOwner []*User
+	for _, n := range visit {
+		if e := n.Edges.Owner; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Owner = &c
+			nextOwner = append(nextOwner, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextOwner, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextType []*FileType
+	for _, n := range visit {
+		if e := n.Edges.Type; e != nil {
+			c := *e
+			c.Edges = FileTypeEdges{}
+			n.Edges.Type = &c
+			nextType = append(nextType, &c)
+		}
+	}
+	if err := dumpFileTypes(ctx, client, nextType, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextField []*FieldType
+	for _, n := range visit {
+		for i, e := range n.Edges.Field {
+			c := *e
+			n.Edges.Field[i] = &c
+			nextField = append(nextField, &c)
+		}
+	}
+	if err := dumpFieldTypes(ctx, client, nextField, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this File.
 // Note that, you need to call File.Unwrap() before calling this method, if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -140,6 +279,38 @@ func (f *File) Unwrap() *File {
 	return f
 }
 
+// Clone returns a deep copy of the File and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (f *File) Clone() *File {
+	return f.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the File and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (f *File) clone(seen map[interface{}]interface{}) *File {
+	if f == nil {
+		return nil
+	}
+	if v, ok := seen[f]; ok {
+		return v.(*File)
+	}
+	_c := *f
+	seen[f] = &_c
+	if v := f.User; v != nil {
+		_v := *v
+		_c.User = &_v
+	}
+	_c.Edges.Owner = f.Edges.Owner.clone(seen)
+	_c.Edges.Type = f.Edges.Type.clone(seen)
+	if f.Edges.Field != nil {
+		_c.Edges.Field = make([]*FieldType, len(f.Edges.Field))
+		for _i := range f.Edges.Field {
+			_c.Edges.Field[_i] = f.Edges.Field[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (f *File) String() string {
 	var builder strings.Builder
@@ -159,6 +330,36 @@ func (f *File) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the File, for change detection and cache keys.
+// It covers the fields that are listed in file.FingerprintFields, and it does not depend on the
+// ID of the File, on its edges, or on the order of the fields in the schema.
+func (f *File) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "group", f.Group)
+	fingerprint(h, "name", f.Name)
+	fingerprint(h, "size", f.Size)
+	fingerprint(h, "user", f.User)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the File for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The File can be decoded using the FromMap method of its client.
+func (f *File) ToMap() map[string]string {
+	m := make(map[string]string, 5)
+	m[file.FieldID] = kvEncode(f.ID)
+	m[file.FieldSize] = kvEncode(f.Size)
+	m[file.FieldName] = kvEncode(f.Name)
+	if v := f.User; v != nil {
+		m[file.FieldUser] = kvEncode(*v)
+	}
+	if v := f.Group; !reflect.ValueOf(v).IsZero() {
+		m[file.FieldGroup] = kvEncode(v)
+	}
+	return m
+}
+
 // Files is a parsable slice of File.
 type Files []*File
 
@@ -179,13 +380,14 @@ func (f *Files) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	for _, v := range scanf {
-		*f = append(*f, &File{
+		node := &File{
 			ID:    v.ID,
 			Size:  v.Size,
 			Name:  v.Name,
 			User:  v.User,
 			Group: v.Group,
-		})
+		}
+		*f = append(*f, node)
 	}
 	return nil
 }
@@ -31,6 +31,22 @@ const (
 	FieldLabel = "file_field"
 )
 
+// Edges holds all the edge names of the File type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+	EdgeType,
+	EdgeField,
+}
+
+// FingerprintFields holds the fields of the File type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldGroup,
+	FieldName,
+	FieldSize,
+	FieldUser,
+}
+
 var (
 	// DefaultSize holds the default value on creation for the size field.
 	DefaultSize int
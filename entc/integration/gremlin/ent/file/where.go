@@ -247,6 +247,13 @@ func NameContains(v string) predicate.File {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotContaining(v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -254,6 +261,13 @@ func NameHasPrefix(v string) predicate.File {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotStartingWith(v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -261,6 +275,13 @@ func NameHasSuffix(v string) predicate.File {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotEndingWith(v))
+	})
+}
+
 // UserEQ applies the EQ predicate on the "user" field.
 func UserEQ(v string) predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -332,6 +353,13 @@ func UserContains(v string) predicate.File {
 	})
 }
 
+// UserNotContains applies the NotContains predicate on the "user" field.
+func UserNotContains(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldUser, p.NotContaining(v))
+	})
+}
+
 // UserHasPrefix applies the HasPrefix predicate on the "user" field.
 func UserHasPrefix(v string) predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -339,6 +367,13 @@ func UserHasPrefix(v string) predicate.File {
 	})
 }
 
+// UserNotHasPrefix applies the NotHasPrefix predicate on the "user" field.
+func UserNotHasPrefix(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldUser, p.NotStartingWith(v))
+	})
+}
+
 // UserHasSuffix applies the HasSuffix predicate on the "user" field.
 func UserHasSuffix(v string) predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -346,6 +381,13 @@ func UserHasSuffix(v string) predicate.File {
 	})
 }
 
+// UserNotHasSuffix applies the NotHasSuffix predicate on the "user" field.
+func UserNotHasSuffix(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldUser, p.NotEndingWith(v))
+	})
+}
+
 // UserIsNil applies the IsNil predicate on the "user" field.
 func UserIsNil() predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -431,6 +473,13 @@ func GroupContains(v string) predicate.File {
 	})
 }
 
+// GroupNotContains applies the NotContains predicate on the "group" field.
+func GroupNotContains(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldGroup, p.NotContaining(v))
+	})
+}
+
 // GroupHasPrefix applies the HasPrefix predicate on the "group" field.
 func GroupHasPrefix(v string) predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -438,6 +487,13 @@ func GroupHasPrefix(v string) predicate.File {
 	})
 }
 
+// GroupNotHasPrefix applies the NotHasPrefix predicate on the "group" field.
+func GroupNotHasPrefix(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldGroup, p.NotStartingWith(v))
+	})
+}
+
 // GroupHasSuffix applies the HasSuffix predicate on the "group" field.
 func GroupHasSuffix(v string) predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -445,6 +501,13 @@ func GroupHasSuffix(v string) predicate.File {
 	})
 }
 
+// GroupNotHasSuffix applies the NotHasSuffix predicate on the "group" field.
+func GroupNotHasSuffix(v string) predicate.File {
+	return predicate.File(func(t *dsl.Traversal) {
+		t.Has(Label, FieldGroup, p.NotEndingWith(v))
+	})
+}
+
 // GroupIsNil applies the IsNil predicate on the "group" field.
 func GroupIsNil() predicate.File {
 	return predicate.File(func(t *dsl.Traversal) {
@@ -547,3 +610,45 @@ func Not(p predicate.File) predicate.File {
 		tr.Where(__.Not(t))
 	})
 }
+
+// FileFilter holds optional filters on the File fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type FileFilter struct {
+	IDIn  []string
+	Size  *int
+	Name  *string
+	User  *string
+	Group *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.File.Query().
+//		Where(file.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *FileFilter) []predicate.File {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.File
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Size != nil {
+		ps = append(ps, SizeEQ(*f.Size))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.User != nil {
+		ps = append(ps, UserEQ(*f.User))
+	}
+	if f.Group != nil {
+		ps = append(ps, GroupEQ(*f.Group))
+	}
+	return ps
+}
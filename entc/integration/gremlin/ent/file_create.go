@@ -131,17 +131,12 @@ func (fc *FileCreate) AddField(f ...*FieldType) *FileCreate {
 
 // Save creates the File in the database.
 func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
-	if _, ok := fc.mutation.Size(); !ok {
-		v := file.DefaultSize
-		fc.mutation.SetSize(v)
-	}
-	if v, ok := fc.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
-		}
+	if err := checkTx(fc.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := fc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	fc.defaults()
+	if err := fc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -178,6 +173,47 @@ func (fc *FileCreate) SaveX(ctx context.Context) *File {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fc *FileCreate) Unwrap() *FileCreate {
+	tx, ok := fc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileCreate is not a transactional builder")
+	}
+	fc.config.driver = tx.drv
+	fc.mutation.driver = tx.drv
+	return fc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (fc *FileCreate) DebugFields() map[string]Value {
+	return debugFields(fc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FileCreate) defaults() {
+	if _, ok := fc.mutation.Size(); !ok {
+		v := file.DefaultSize
+		fc.mutation.SetSize(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FileCreate) check() error {
+	if v, ok := fc.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if _, ok := fc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
 func (fc *FileCreate) gremlinSave(ctx context.Context) (*File, error) {
 	res := &gremlin.Response{}
 	query, bindings := fc.gremlin().Query()
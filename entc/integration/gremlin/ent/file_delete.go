@@ -24,6 +24,7 @@ type FileDelete struct {
 	hooks      []Hook
 	mutation   *FileMutation
 	predicates []predicate.File
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (fd *FileDelete) Where(ps ...predicate.File) *FileDelete {
 	return fd
 }
 
+// Limit limits the number of files that are deleted by the builder. For example,
+// for deleting large amounts of files in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (fd *FileDelete) Limit(limit int) *FileDelete {
+	fd.limit = &limit
+	return fd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fd *FileDelete) Unwrap() *FileDelete {
+	tx, ok := fd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileDelete is not a transactional builder")
+	}
+	fd.config.driver = tx.drv
+	fd.mutation.driver = tx.drv
+	return fd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (fd *FileDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(fd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (fd *FileDelete) gremlin() *dsl.Traversal {
 	for _, p := range fd.predicates {
 		p(t)
 	}
+	if limit := fd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -44,6 +45,19 @@ func (fq *FileQuery) Where(ps ...predicate.File) *FileQuery {
 	return fq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (fq *FileQuery) Predicates() []predicate.File {
+	return append([]predicate.File{}, fq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (fq *FileQuery) SetPredicates(ps ...predicate.File) *FileQuery {
+	fq.predicates = append([]predicate.File{}, ps...)
+	return fq
+}
+
 // Limit adds a limit step to the query.
 func (fq *FileQuery) Limit(limit int) *FileQuery {
 	fq.limit = &limit
@@ -702,6 +716,27 @@ func (fs *FileSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (fs *FileSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(fs.fields) > 1 {
+		return nil, errors.New("ent: FileSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := fs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (fs *FileSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := fs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (fs *FileSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
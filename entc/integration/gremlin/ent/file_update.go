@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
@@ -27,6 +28,7 @@ type FileUpdate struct {
 	hooks      []Hook
 	mutation   *FileMutation
 	predicates []predicate.File
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -35,6 +37,13 @@ func (fu *FileUpdate) Where(ps ...predicate.File) *FileUpdate {
 	return fu
 }
 
+// Limit limits the number of files that are updated by the builder. For example,
+// for updating large amounts of files in small batches without locking the whole table.
+func (fu *FileUpdate) Limit(limit int) *FileUpdate {
+	fu.limit = &limit
+	return fu
+}
+
 // SetSize sets the size field.
 func (fu *FileUpdate) SetSize(i int) *FileUpdate {
 	fu.mutation.ResetSize()
@@ -56,12 +65,26 @@ func (fu *FileUpdate) AddSize(i int) *FileUpdate {
 	return fu
 }
 
+// UnsetSize removes the changes of the size field from the builder (e.g. a previous call
+// to SetSize), and therefore, the field is left unchanged in the database.
+func (fu *FileUpdate) UnsetSize() *FileUpdate {
+	fu.mutation.ResetSize()
+	return fu
+}
+
 // SetName sets the name field.
 func (fu *FileUpdate) SetName(s string) *FileUpdate {
 	fu.mutation.SetName(s)
 	return fu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (fu *FileUpdate) UnsetName() *FileUpdate {
+	fu.mutation.ResetName()
+	return fu
+}
+
 // SetUser sets the user field.
 func (fu *FileUpdate) SetUser(s string) *FileUpdate {
 	fu.mutation.SetUser(s)
@@ -82,6 +105,14 @@ func (fu *FileUpdate) ClearUser() *FileUpdate {
 	return fu
 }
 
+// UnsetUser removes the changes of the user field from the builder (e.g. a previous call
+// to SetUser), and therefore, the field is left unchanged in the database. Unlike ClearUser,
+// it does not set the field to NULL, and also removes a previous call to ClearUser.
+func (fu *FileUpdate) UnsetUser() *FileUpdate {
+	fu.mutation.ResetUser()
+	return fu
+}
+
 // SetGroup sets the group field.
 func (fu *FileUpdate) SetGroup(s string) *FileUpdate {
 	fu.mutation.SetGroup(s)
@@ -102,6 +133,14 @@ func (fu *FileUpdate) ClearGroup() *FileUpdate {
 	return fu
 }
 
+// UnsetGroup removes the changes of the group field from the builder (e.g. a previous call
+// to SetGroup), and therefore, the field is left unchanged in the database. Unlike ClearGroup,
+// it does not set the field to NULL, and also removes a previous call to ClearGroup.
+func (fu *FileUpdate) UnsetGroup() *FileUpdate {
+	fu.mutation.ResetGroup()
+	return fu
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (fu *FileUpdate) SetOwnerID(id string) *FileUpdate {
 	fu.mutation.SetOwnerID(id)
@@ -182,14 +221,33 @@ func (fu *FileUpdate) RemoveField(f ...*FieldType) *FileUpdate {
 	return fu.RemoveFieldIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fu *FileUpdate) Unwrap() *FileUpdate {
+	tx, ok := fu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileUpdate is not a transactional builder")
+	}
+	fu.config.driver = tx.drv
+	fu.mutation.driver = tx.drv
+	return fu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (fu *FileUpdate) DebugFields() map[string]Value {
+	return debugFields(fu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := fu.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
-		}
+	if err := checkTx(fu.driver); err != nil {
+		return 0, err
+	}
+	if err := fu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -238,6 +296,22 @@ func (fu *FileUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fu *FileUpdate) check() error {
+	if v, ok := fu.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if fu.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	if fu.mutation.conflict_type {
+		return &ValidationError{Name: "type", err: errors.New("ent: setting and clearing the unique edge \"type\" in the same mutation")}
+	}
+	return nil
+}
+
 func (fu *FileUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := fu.gremlin().Query()
@@ -260,6 +334,9 @@ func (fu *FileUpdate) gremlin() *dsl.Traversal {
 	for _, p := range fu.predicates {
 		p(v)
 	}
+	if limit := fu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -334,8 +411,16 @@ func (fu *FileUpdate) gremlin() *dsl.Traversal {
 // FileUpdateOne is the builder for updating a single File entity.
 type FileUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *FileMutation
+	hooks      []Hook
+	mutation   *FileMutation
+	predicates []predicate.File
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (fuo *FileUpdateOne) Where(ps ...predicate.File) *FileUpdateOne {
+	fuo.predicates = append(fuo.predicates, ps...)
+	return fuo
 }
 
 // SetSize sets the size field.
@@ -359,12 +444,26 @@ func (fuo *FileUpdateOne) AddSize(i int) *FileUpdateOne {
 	return fuo
 }
 
+// UnsetSize removes the changes of the size field from the builder (e.g. a previous call
+// to SetSize), and therefore, the field is left unchanged in the database.
+func (fuo *FileUpdateOne) UnsetSize() *FileUpdateOne {
+	fuo.mutation.ResetSize()
+	return fuo
+}
+
 // SetName sets the name field.
 func (fuo *FileUpdateOne) SetName(s string) *FileUpdateOne {
 	fuo.mutation.SetName(s)
 	return fuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (fuo *FileUpdateOne) UnsetName() *FileUpdateOne {
+	fuo.mutation.ResetName()
+	return fuo
+}
+
 // SetUser sets the user field.
 func (fuo *FileUpdateOne) SetUser(s string) *FileUpdateOne {
 	fuo.mutation.SetUser(s)
@@ -385,6 +484,14 @@ func (fuo *FileUpdateOne) ClearUser() *FileUpdateOne {
 	return fuo
 }
 
+// UnsetUser removes the changes of the user field from the builder (e.g. a previous call
+// to SetUser), and therefore, the field is left unchanged in the database. Unlike ClearUser,
+// it does not set the field to NULL, and also removes a previous call to ClearUser.
+func (fuo *FileUpdateOne) UnsetUser() *FileUpdateOne {
+	fuo.mutation.ResetUser()
+	return fuo
+}
+
 // SetGroup sets the group field.
 func (fuo *FileUpdateOne) SetGroup(s string) *FileUpdateOne {
 	fuo.mutation.SetGroup(s)
@@ -405,6 +512,14 @@ func (fuo *FileUpdateOne) ClearGroup() *FileUpdateOne {
 	return fuo
 }
 
+// UnsetGroup removes the changes of the group field from the builder (e.g. a previous call
+// to SetGroup), and therefore, the field is left unchanged in the database. Unlike ClearGroup,
+// it does not set the field to NULL, and also removes a previous call to ClearGroup.
+func (fuo *FileUpdateOne) UnsetGroup() *FileUpdateOne {
+	fuo.mutation.ResetGroup()
+	return fuo
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (fuo *FileUpdateOne) SetOwnerID(id string) *FileUpdateOne {
 	fuo.mutation.SetOwnerID(id)
@@ -485,14 +600,33 @@ func (fuo *FileUpdateOne) RemoveField(f ...*FieldType) *FileUpdateOne {
 	return fuo.RemoveFieldIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (fuo *FileUpdateOne) Unwrap() *FileUpdateOne {
+	tx, ok := fuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileUpdateOne is not a transactional builder")
+	}
+	fuo.config.driver = tx.drv
+	fuo.mutation.driver = tx.drv
+	return fuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (fuo *FileUpdateOne) DebugFields() map[string]Value {
+	return debugFields(fuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
-	if v, ok := fuo.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
-		}
+	if err := checkTx(fuo.driver); err != nil {
+		return nil, err
+	}
+	if err := fuo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *File
@@ -541,6 +675,22 @@ func (fuo *FileUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FileUpdateOne) check() error {
+	if v, ok := fuo.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf("ent: validator failed for field \"size\": %v", err)}
+		}
+	}
+	if fuo.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	if fuo.mutation.conflict_type {
+		return &ValidationError{Name: "type", err: errors.New("ent: setting and clearing the unique edge \"type\" in the same mutation")}
+	}
+	return nil
+}
+
 func (fuo *FileUpdateOne) gremlinSave(ctx context.Context) (*File, error) {
 	res := &gremlin.Response{}
 	id, ok := fuo.mutation.ID()
@@ -554,6 +704,15 @@ func (fuo *FileUpdateOne) gremlinSave(ctx context.Context) (*File, error) {
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(fuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{file.Label}
+		}
+	}
 	f := &File{config: fuo.config}
 	if err := f.FromResponse(res); err != nil {
 		return nil, err
@@ -568,6 +727,9 @@ func (fuo *FileUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 1)
 	v := g.V(id)
+	for _, p := range fuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -19,3 +19,14 @@ const (
 	// FilesLabel holds the string label denoting the files edge type in the database.
 	FilesLabel = "file_type_files"
 )
+
+// Edges holds all the edge names of the FileType type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeFiles,
+}
+
+// FingerprintFields holds the fields of the FileType type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
@@ -49,8 +49,12 @@ func (ftc *FileTypeCreate) AddFiles(f ...*File) *FileTypeCreate {
 
 // Save creates the FileType in the database.
 func (ftc *FileTypeCreate) Save(ctx context.Context) (*FileType, error) {
-	if _, ok := ftc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	if err := checkTx(ftc.driver); err != nil {
+		return nil, err
+	}
+	ftc.defaults()
+	if err := ftc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -87,6 +91,38 @@ func (ftc *FileTypeCreate) SaveX(ctx context.Context) *FileType {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftc *FileTypeCreate) Unwrap() *FileTypeCreate {
+	tx, ok := ftc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeCreate is not a transactional builder")
+	}
+	ftc.config.driver = tx.drv
+	ftc.mutation.driver = tx.drv
+	return ftc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (ftc *FileTypeCreate) DebugFields() map[string]Value {
+	return debugFields(ftc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (ftc *FileTypeCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ftc *FileTypeCreate) check() error {
+	if _, ok := ftc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
 func (ftc *FileTypeCreate) gremlinSave(ctx context.Context) (*FileType, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftc.gremlin().Query()
@@ -24,6 +24,7 @@ type FileTypeDelete struct {
 	hooks      []Hook
 	mutation   *FileTypeMutation
 	predicates []predicate.FileType
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (ftd *FileTypeDelete) Where(ps ...predicate.FileType) *FileTypeDelete {
 	return ftd
 }
 
+// Limit limits the number of filetypes that are deleted by the builder. For example,
+// for deleting large amounts of filetypes in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (ftd *FileTypeDelete) Limit(limit int) *FileTypeDelete {
+	ftd.limit = &limit
+	return ftd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftd *FileTypeDelete) Unwrap() *FileTypeDelete {
+	tx, ok := ftd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeDelete is not a transactional builder")
+	}
+	ftd.config.driver = tx.drv
+	ftd.mutation.driver = tx.drv
+	return ftd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (ftd *FileTypeDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(ftd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (ftd *FileTypeDelete) gremlin() *dsl.Traversal {
 	for _, p := range ftd.predicates {
 		p(t)
 	}
+	if limit := ftd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
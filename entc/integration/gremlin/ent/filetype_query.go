@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -40,6 +41,19 @@ func (ftq *FileTypeQuery) Where(ps ...predicate.FileType) *FileTypeQuery {
 	return ftq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (ftq *FileTypeQuery) Predicates() []predicate.FileType {
+	return append([]predicate.FileType{}, ftq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (ftq *FileTypeQuery) SetPredicates(ps ...predicate.FileType) *FileTypeQuery {
+	ftq.predicates = append([]predicate.FileType{}, ps...)
+	return ftq
+}
+
 // Limit adds a limit step to the query.
 func (ftq *FileTypeQuery) Limit(limit int) *FileTypeQuery {
 	ftq.limit = &limit
@@ -648,6 +662,27 @@ func (fts *FileTypeSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (fts *FileTypeSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(fts.fields) > 1 {
+		return nil, errors.New("ent: FileTypeSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := fts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (fts *FileTypeSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := fts.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (fts *FileTypeSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
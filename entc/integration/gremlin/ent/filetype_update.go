@@ -25,6 +25,7 @@ type FileTypeUpdate struct {
 	hooks      []Hook
 	mutation   *FileTypeMutation
 	predicates []predicate.FileType
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -33,12 +34,26 @@ func (ftu *FileTypeUpdate) Where(ps ...predicate.FileType) *FileTypeUpdate {
 	return ftu
 }
 
+// Limit limits the number of filetypes that are updated by the builder. For example,
+// for updating large amounts of filetypes in small batches without locking the whole table.
+func (ftu *FileTypeUpdate) Limit(limit int) *FileTypeUpdate {
+	ftu.limit = &limit
+	return ftu
+}
+
 // SetName sets the name field.
 func (ftu *FileTypeUpdate) SetName(s string) *FileTypeUpdate {
 	ftu.mutation.SetName(s)
 	return ftu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (ftu *FileTypeUpdate) UnsetName() *FileTypeUpdate {
+	ftu.mutation.ResetName()
+	return ftu
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (ftu *FileTypeUpdate) AddFileIDs(ids ...string) *FileTypeUpdate {
 	ftu.mutation.AddFileIDs(ids...)
@@ -69,9 +84,33 @@ func (ftu *FileTypeUpdate) RemoveFiles(f ...*File) *FileTypeUpdate {
 	return ftu.RemoveFileIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftu *FileTypeUpdate) Unwrap() *FileTypeUpdate {
+	tx, ok := ftu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeUpdate is not a transactional builder")
+	}
+	ftu.config.driver = tx.drv
+	ftu.mutation.driver = tx.drv
+	return ftu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftu *FileTypeUpdate) DebugFields() map[string]Value {
+	return debugFields(ftu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (ftu *FileTypeUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(ftu.driver); err != nil {
+		return 0, err
+	}
+	if err := ftu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -120,6 +159,11 @@ func (ftu *FileTypeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftu *FileTypeUpdate) check() error {
+	return nil
+}
+
 func (ftu *FileTypeUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := ftu.gremlin().Query()
@@ -142,6 +186,9 @@ func (ftu *FileTypeUpdate) gremlin() *dsl.Traversal {
 	for _, p := range ftu.predicates {
 		p(v)
 	}
+	if limit := ftu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -184,8 +231,16 @@ func (ftu *FileTypeUpdate) gremlin() *dsl.Traversal {
 // FileTypeUpdateOne is the builder for updating a single FileType entity.
 type FileTypeUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *FileTypeMutation
+	hooks      []Hook
+	mutation   *FileTypeMutation
+	predicates []predicate.FileType
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (ftuo *FileTypeUpdateOne) Where(ps ...predicate.FileType) *FileTypeUpdateOne {
+	ftuo.predicates = append(ftuo.predicates, ps...)
+	return ftuo
 }
 
 // SetName sets the name field.
@@ -194,6 +249,13 @@ func (ftuo *FileTypeUpdateOne) SetName(s string) *FileTypeUpdateOne {
 	return ftuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (ftuo *FileTypeUpdateOne) UnsetName() *FileTypeUpdateOne {
+	ftuo.mutation.ResetName()
+	return ftuo
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (ftuo *FileTypeUpdateOne) AddFileIDs(ids ...string) *FileTypeUpdateOne {
 	ftuo.mutation.AddFileIDs(ids...)
@@ -224,9 +286,33 @@ func (ftuo *FileTypeUpdateOne) RemoveFiles(f ...*File) *FileTypeUpdateOne {
 	return ftuo.RemoveFileIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ftuo *FileTypeUpdateOne) Unwrap() *FileTypeUpdateOne {
+	tx, ok := ftuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTypeUpdateOne is not a transactional builder")
+	}
+	ftuo.config.driver = tx.drv
+	ftuo.mutation.driver = tx.drv
+	return ftuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (ftuo *FileTypeUpdateOne) DebugFields() map[string]Value {
+	return debugFields(ftuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (ftuo *FileTypeUpdateOne) Save(ctx context.Context) (*FileType, error) {
-
+	if err := checkTx(ftuo.driver); err != nil {
+		return nil, err
+	}
+	if err := ftuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *FileType
@@ -275,6 +361,11 @@ func (ftuo *FileTypeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (ftuo *FileTypeUpdateOne) check() error {
+	return nil
+}
+
 func (ftuo *FileTypeUpdateOne) gremlinSave(ctx context.Context) (*FileType, error) {
 	res := &gremlin.Response{}
 	id, ok := ftuo.mutation.ID()
@@ -288,6 +379,15 @@ func (ftuo *FileTypeUpdateOne) gremlinSave(ctx context.Context) (*FileType, erro
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(ftuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{filetype.Label}
+		}
+	}
 	ft := &FileType{config: ftuo.config}
 	if err := ft.FromResponse(res); err != nil {
 		return nil, err
@@ -302,6 +402,9 @@ func (ftuo *FileTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range ftuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -7,12 +7,20 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/groupinfo"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/user"
 )
 
 // Group is the model entity for the Group schema.
@@ -137,6 +145,271 @@ func (gr *Group) QueryInfo() *GroupInfoQuery {
 	return (&GroupClient{config: gr.config}).QueryInfo(gr)
 }
 
+// LoadEdges loads the given edges of the Group using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see group.Edges), and in this case, no edge is loaded.
+//
+//	err := gr.LoadEdges(ctx, client, group.EdgeFiles, group.EdgeBlocked)
+//
+func (gr *Group) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadGroupEdges(ctx, client, []*Group{gr}, edges)
+}
+
+// loadGroupEdges loads the given edges of all the given Group entities.
+func loadGroupEdges(ctx context.Context, client *Client, nodes []*Group, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case group.EdgeFiles, group.EdgeBlocked, group.EdgeUsers, group.EdgeInfo:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Group", name)
+		}
+	}
+	for _, name := range edges {
+		for _, n := range nodes {
+			switch name {
+			case group.EdgeFiles:
+				loaded, err := client.Group.QueryFiles(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Files = loaded
+				n.Edges.loadedTypes[0] = true
+			case group.EdgeBlocked:
+				loaded, err := client.Group.QueryBlocked(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Blocked = loaded
+				n.Edges.loadedTypes[1] = true
+			case group.EdgeUsers:
+				loaded, err := client.Group.QueryUsers(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Users = loaded
+				n.Edges.loadedTypes[2] = true
+			case group.EdgeInfo:
+				node, err := client.Group.QueryInfo(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Info = node
+				n.Edges.loadedTypes[3] = true
+			}
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Group and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Group alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Group itself is not modified.
+//
+//	b, err := gr.DumpGraph(ctx, client, 2, group.EdgeFiles)
+//
+func (gr *Group) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Group DumpGraph", depth)
+	}
+	dumped := *gr
+	dumped.Edges = GroupEdges{}
+	if err := dumpGroups(ctx, client, []*Group{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpGroups loads the edges of the given Group copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpGroups(ctx context.Context, client *Client, nodes []*Group, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Group
+	for _, n := range nodes {
+		if dumper.visit(group.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range group.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadGroupEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextFiles []*File
+	for _, n := range visit {
+		for i, e := range n.Edges.Files {
+			c := *e
+			c.Edges = FileEdges{}
+			n.Edges.Files[i] = &c
+			nextFiles = append(nextFiles, &c)
+		}
+	}
+	if err := dumpFiles(ctx, client, nextFiles, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextBlocked []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Blocked {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Blocked[i] = &c
+			nextBlocked = append(nextBlocked, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextBlocked, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextUsers []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Users {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Users[i] = &c
+			nextUsers = append(nextUsers, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextUsers, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextInfo []*GroupInfo
+	for _, n := range visit {
+		if e := n.Edges.Info; e != nil {
+			c := *e
+			c.Edges = GroupInfoEdges{}
+			n.Edges.Info = &c
+			nextInfo = append(nextInfo, &c)
+		}
+	}
+	if err := dumpGroupInfos(ctx, client, nextInfo, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddUsersByField adds the "users" edges of the Group to the User entities that are resolved by the
+// given values of one of their unique fields (e.g. user.FieldNickname). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := gr.AddUsersByField(ctx, client, user.FieldNickname, v1, v2)
+//
+func (gr *Group) AddUsersByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := gr.addUsersByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddUsersByFieldOrCreate is like AddUsersByField, but creates the User entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (gr *Group) AddUsersByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*User, error) {
+	return gr.addUsersByField(ctx, client, true, field, values)
+}
+
+func (gr *Group) addUsersByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*User, error) {
+	var (
+		where predicate.User
+		key   func(*User) interface{}
+	)
+	switch field {
+	case user.FieldNickname:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field nickname", v)
+			}
+			vs[i] = tv
+		}
+		where = user.NicknameIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Nickname
+		}
+	case user.FieldPhone:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field phone", v)
+			}
+			vs[i] = tv
+		}
+		where = user.PhoneIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Phone
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type User", field)
+	}
+	nodes, err := client.User.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*UserCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{user.Label}
+		}
+		builder := client.User.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*User
+	for _, builder := range builders {
+		n, err := builder.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, n)
+	}
+	ids := make([]string, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.Group.QueryUsers(gr).Where(user.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[string]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.Group.UpdateOneID(gr.ID).AddUserIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
 // Update returns a builder for updating this Group.
 // Note that, you need to call Group.Unwrap() before calling this method, if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -155,6 +428,49 @@ func (gr *Group) Unwrap() *Group {
 	return gr
 }
 
+// Clone returns a deep copy of the Group and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (gr *Group) Clone() *Group {
+	return gr.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Group and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (gr *Group) clone(seen map[interface{}]interface{}) *Group {
+	if gr == nil {
+		return nil
+	}
+	if v, ok := seen[gr]; ok {
+		return v.(*Group)
+	}
+	_c := *gr
+	seen[gr] = &_c
+	if v := gr.Type; v != nil {
+		_v := *v
+		_c.Type = &_v
+	}
+	if gr.Edges.Files != nil {
+		_c.Edges.Files = make([]*File, len(gr.Edges.Files))
+		for _i := range gr.Edges.Files {
+			_c.Edges.Files[_i] = gr.Edges.Files[_i].clone(seen)
+		}
+	}
+	if gr.Edges.Blocked != nil {
+		_c.Edges.Blocked = make([]*User, len(gr.Edges.Blocked))
+		for _i := range gr.Edges.Blocked {
+			_c.Edges.Blocked[_i] = gr.Edges.Blocked[_i].clone(seen)
+		}
+	}
+	if gr.Edges.Users != nil {
+		_c.Edges.Users = make([]*User, len(gr.Edges.Users))
+		for _i := range gr.Edges.Users {
+			_c.Edges.Users[_i] = gr.Edges.Users[_i].clone(seen)
+		}
+	}
+	_c.Edges.Info = gr.Edges.Info.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gr *Group) String() string {
 	var builder strings.Builder
@@ -176,6 +492,38 @@ func (gr *Group) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Group, for change detection and cache keys.
+// It covers the fields that are listed in group.FingerprintFields, and it does not depend on the
+// ID of the Group, on its edges, or on the order of the fields in the schema.
+func (gr *Group) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "active", gr.Active)
+	fingerprint(h, "expire", gr.Expire)
+	fingerprint(h, "max_users", gr.MaxUsers)
+	fingerprint(h, "name", gr.Name)
+	fingerprint(h, "type", gr.Type)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Group for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Group can be decoded using the FromMap method of its client.
+func (gr *Group) ToMap() map[string]string {
+	m := make(map[string]string, 6)
+	m[group.FieldID] = kvEncode(gr.ID)
+	m[group.FieldActive] = kvEncode(gr.Active)
+	m[group.FieldExpire] = kvEncode(gr.Expire)
+	if v := gr.Type; v != nil {
+		m[group.FieldType] = kvEncode(*v)
+	}
+	if v := gr.MaxUsers; !reflect.ValueOf(v).IsZero() {
+		m[group.FieldMaxUsers] = kvEncode(v)
+	}
+	m[group.FieldName] = kvEncode(gr.Name)
+	return m
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
 
@@ -197,14 +545,15 @@ func (gr *Groups) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	for _, v := range scangr {
-		*gr = append(*gr, &Group{
+		node := &Group{
 			ID:       v.ID,
 			Active:   v.Active,
 			Expire:   time.Unix(0, v.Expire),
 			Type:     v.Type,
 			MaxUsers: v.MaxUsers,
 			Name:     v.Name,
-		})
+		}
+		*gr = append(*gr, node)
 	}
 	return nil
 }
@@ -36,6 +36,24 @@ const (
 	InfoLabel = "group_info"
 )
 
+// Edges holds all the edge names of the Group type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeFiles,
+	EdgeBlocked,
+	EdgeUsers,
+	EdgeInfo,
+}
+
+// FingerprintFields holds the fields of the Group type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldActive,
+	FieldExpire,
+	FieldMaxUsers,
+	FieldName,
+	FieldType,
+}
+
 var (
 	// DefaultActive holds the default value on creation for the active field.
 	DefaultActive bool
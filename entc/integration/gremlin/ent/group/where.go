@@ -270,6 +270,13 @@ func TypeContains(v string) predicate.Group {
 	})
 }
 
+// TypeNotContains applies the NotContains predicate on the "type" field.
+func TypeNotContains(v string) predicate.Group {
+	return predicate.Group(func(t *dsl.Traversal) {
+		t.Has(Label, FieldType, p.NotContaining(v))
+	})
+}
+
 // TypeHasPrefix applies the HasPrefix predicate on the "type" field.
 func TypeHasPrefix(v string) predicate.Group {
 	return predicate.Group(func(t *dsl.Traversal) {
@@ -277,6 +284,13 @@ func TypeHasPrefix(v string) predicate.Group {
 	})
 }
 
+// TypeNotHasPrefix applies the NotHasPrefix predicate on the "type" field.
+func TypeNotHasPrefix(v string) predicate.Group {
+	return predicate.Group(func(t *dsl.Traversal) {
+		t.Has(Label, FieldType, p.NotStartingWith(v))
+	})
+}
+
 // TypeHasSuffix applies the HasSuffix predicate on the "type" field.
 func TypeHasSuffix(v string) predicate.Group {
 	return predicate.Group(func(t *dsl.Traversal) {
@@ -284,6 +298,13 @@ func TypeHasSuffix(v string) predicate.Group {
 	})
 }
 
+// TypeNotHasSuffix applies the NotHasSuffix predicate on the "type" field.
+func TypeNotHasSuffix(v string) predicate.Group {
+	return predicate.Group(func(t *dsl.Traversal) {
+		t.Has(Label, FieldType, p.NotEndingWith(v))
+	})
+}
+
 // TypeIsNil applies the IsNil predicate on the "type" field.
 func TypeIsNil() predicate.Group {
 	return predicate.Group(func(t *dsl.Traversal) {
@@ -447,6 +468,13 @@ func NameContains(v string) predicate.Group {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Group {
+	return predicate.Group(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotContaining(v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Group {
 	return predicate.Group(func(t *dsl.Traversal) {
@@ -454,6 +482,13 @@ func NameHasPrefix(v string) predicate.Group {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Group {
+	return predicate.Group(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotStartingWith(v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Group {
 	return predicate.Group(func(t *dsl.Traversal) {
@@ -461,6 +496,13 @@ func NameHasSuffix(v string) predicate.Group {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Group {
+	return predicate.Group(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotEndingWith(v))
+	})
+}
+
 // HasFiles applies the HasEdge predicate on the "files" edge.
 func HasFiles() predicate.Group {
 	return predicate.Group(func(t *dsl.Traversal) {
@@ -567,3 +609,60 @@ func Not(p predicate.Group) predicate.Group {
 		tr.Where(__.Not(t))
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// GroupFilter holds optional filters on the Group fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type GroupFilter struct {
+	IDIn     []string
+	Active   *bool
+	Expire   *TimeRange
+	Type     *string
+	MaxUsers *int
+	Name     *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Group.Query().
+//		Where(group.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *GroupFilter) []predicate.Group {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Group
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Active != nil {
+		ps = append(ps, ActiveEQ(*f.Active))
+	}
+	if r := f.Expire; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, ExpireGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, ExpireLT(r.To))
+		}
+	}
+	if f.Type != nil {
+		ps = append(ps, TypeEQ(*f.Type))
+	}
+	if f.MaxUsers != nil {
+		ps = append(ps, MaxUsersEQ(*f.MaxUsers))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	return ps
+}
@@ -140,37 +140,12 @@ func (gc *GroupCreate) SetInfo(g *GroupInfo) *GroupCreate {
 
 // Save creates the Group in the database.
 func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
-	if _, ok := gc.mutation.Active(); !ok {
-		v := group.DefaultActive
-		gc.mutation.SetActive(v)
-	}
-	if _, ok := gc.mutation.Expire(); !ok {
-		return nil, errors.New("ent: missing required field \"expire\"")
-	}
-	if v, ok := gc.mutation.GetType(); ok {
-		if err := group.TypeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
-	}
-	if _, ok := gc.mutation.MaxUsers(); !ok {
-		v := group.DefaultMaxUsers
-		gc.mutation.SetMaxUsers(v)
-	}
-	if v, ok := gc.mutation.MaxUsers(); ok {
-		if err := group.MaxUsersValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)
-		}
-	}
-	if _, ok := gc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
-	}
-	if v, ok := gc.mutation.Name(); ok {
-		if err := group.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(gc.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := gc.mutation.InfoID(); !ok {
-		return nil, errors.New("ent: missing required edge \"info\"")
+	gc.defaults()
+	if err := gc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -207,6 +182,67 @@ func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gc *GroupCreate) Unwrap() *GroupCreate {
+	tx, ok := gc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupCreate is not a transactional builder")
+	}
+	gc.config.driver = tx.drv
+	gc.mutation.driver = tx.drv
+	return gc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (gc *GroupCreate) DebugFields() map[string]Value {
+	return debugFields(gc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GroupCreate) defaults() {
+	if _, ok := gc.mutation.Active(); !ok {
+		v := group.DefaultActive
+		gc.mutation.SetActive(v)
+	}
+	if _, ok := gc.mutation.MaxUsers(); !ok {
+		v := group.DefaultMaxUsers
+		gc.mutation.SetMaxUsers(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GroupCreate) check() error {
+	if _, ok := gc.mutation.Expire(); !ok {
+		return &ValidationError{Name: "expire", err: errors.New("ent: missing required field \"expire\"")}
+	}
+	if v, ok := gc.mutation.GetType(); ok {
+		if err := group.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if v, ok := gc.mutation.MaxUsers(); ok {
+		if err := group.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)}
+		}
+	}
+	if _, ok := gc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := gc.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if _, ok := gc.mutation.InfoID(); !ok {
+		return &ValidationError{Name: "info", err: errors.New("ent: missing required edge \"info\"")}
+	}
+	return nil
+}
+
 func (gc *GroupCreate) gremlinSave(ctx context.Context) (*Group, error) {
 	res := &gremlin.Response{}
 	query, bindings := gc.gremlin().Query()
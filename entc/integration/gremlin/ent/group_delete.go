@@ -24,6 +24,7 @@ type GroupDelete struct {
 	hooks      []Hook
 	mutation   *GroupMutation
 	predicates []predicate.Group
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (gd *GroupDelete) Where(ps ...predicate.Group) *GroupDelete {
 	return gd
 }
 
+// Limit limits the number of groups that are deleted by the builder. For example,
+// for deleting large amounts of groups in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (gd *GroupDelete) Limit(limit int) *GroupDelete {
+	gd.limit = &limit
+	return gd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gd *GroupDelete) Unwrap() *GroupDelete {
+	tx, ok := gd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupDelete is not a transactional builder")
+	}
+	gd.config.driver = tx.drv
+	gd.mutation.driver = tx.drv
+	return gd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (gd *GroupDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(gd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (gd *GroupDelete) gremlin() *dsl.Traversal {
 	for _, p := range gd.predicates {
 		p(t)
 	}
+	if limit := gd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
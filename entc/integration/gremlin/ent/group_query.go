@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -44,6 +45,19 @@ func (gq *GroupQuery) Where(ps ...predicate.Group) *GroupQuery {
 	return gq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (gq *GroupQuery) Predicates() []predicate.Group {
+	return append([]predicate.Group{}, gq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (gq *GroupQuery) SetPredicates(ps ...predicate.Group) *GroupQuery {
+	gq.predicates = append([]predicate.Group{}, ps...)
+	return gq
+}
+
 // Limit adds a limit step to the query.
 func (gq *GroupQuery) Limit(limit int) *GroupQuery {
 	gq.limit = &limit
@@ -727,6 +741,27 @@ func (gs *GroupSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (gs *GroupSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(gs.fields) > 1 {
+		return nil, errors.New("ent: GroupSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := gs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (gs *GroupSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := gs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (gs *GroupSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
@@ -28,6 +28,7 @@ type GroupUpdate struct {
 	hooks      []Hook
 	mutation   *GroupMutation
 	predicates []predicate.Group
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -36,6 +37,13 @@ func (gu *GroupUpdate) Where(ps ...predicate.Group) *GroupUpdate {
 	return gu
 }
 
+// Limit limits the number of groups that are updated by the builder. For example,
+// for updating large amounts of groups in small batches without locking the whole table.
+func (gu *GroupUpdate) Limit(limit int) *GroupUpdate {
+	gu.limit = &limit
+	return gu
+}
+
 // SetActive sets the active field.
 func (gu *GroupUpdate) SetActive(b bool) *GroupUpdate {
 	gu.mutation.SetActive(b)
@@ -50,12 +58,26 @@ func (gu *GroupUpdate) SetNillableActive(b *bool) *GroupUpdate {
 	return gu
 }
 
+// UnsetActive removes the changes of the active field from the builder (e.g. a previous call
+// to SetActive), and therefore, the field is left unchanged in the database.
+func (gu *GroupUpdate) UnsetActive() *GroupUpdate {
+	gu.mutation.ResetActive()
+	return gu
+}
+
 // SetExpire sets the expire field.
 func (gu *GroupUpdate) SetExpire(t time.Time) *GroupUpdate {
 	gu.mutation.SetExpire(t)
 	return gu
 }
 
+// UnsetExpire removes the changes of the expire field from the builder (e.g. a previous call
+// to SetExpire), and therefore, the field is left unchanged in the database.
+func (gu *GroupUpdate) UnsetExpire() *GroupUpdate {
+	gu.mutation.ResetExpire()
+	return gu
+}
+
 // SetType sets the type field.
 func (gu *GroupUpdate) SetType(s string) *GroupUpdate {
 	gu.mutation.SetType(s)
@@ -76,6 +98,14 @@ func (gu *GroupUpdate) ClearType() *GroupUpdate {
 	return gu
 }
 
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (gu *GroupUpdate) UnsetType() *GroupUpdate {
+	gu.mutation.ResetType()
+	return gu
+}
+
 // SetMaxUsers sets the max_users field.
 func (gu *GroupUpdate) SetMaxUsers(i int) *GroupUpdate {
 	gu.mutation.ResetMaxUsers()
@@ -103,12 +133,27 @@ func (gu *GroupUpdate) ClearMaxUsers() *GroupUpdate {
 	return gu
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database. Unlike ClearMaxUsers,
+// it does not set the field to NULL, and also removes a previous call to ClearMaxUsers.
+func (gu *GroupUpdate) UnsetMaxUsers() *GroupUpdate {
+	gu.mutation.ResetMaxUsers()
+	return gu
+}
+
 // SetName sets the name field.
 func (gu *GroupUpdate) SetName(s string) *GroupUpdate {
 	gu.mutation.SetName(s)
 	return gu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (gu *GroupUpdate) UnsetName() *GroupUpdate {
+	gu.mutation.ResetName()
+	return gu
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (gu *GroupUpdate) AddFileIDs(ids ...string) *GroupUpdate {
 	gu.mutation.AddFileIDs(ids...)
@@ -216,26 +261,32 @@ func (gu *GroupUpdate) ClearInfo() *GroupUpdate {
 	return gu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gu *GroupUpdate) Unwrap() *GroupUpdate {
+	tx, ok := gu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupUpdate is not a transactional builder")
+	}
+	gu.config.driver = tx.drv
+	gu.mutation.driver = tx.drv
+	return gu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (gu *GroupUpdate) DebugFields() map[string]Value {
+	return debugFields(gu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (gu *GroupUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := gu.mutation.GetType(); ok {
-		if err := group.TypeValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
-	}
-	if v, ok := gu.mutation.MaxUsers(); ok {
-		if err := group.MaxUsersValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)
-		}
-	}
-	if v, ok := gu.mutation.Name(); ok {
-		if err := group.NameValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(gu.driver); err != nil {
+		return 0, err
 	}
-
-	if _, ok := gu.mutation.InfoID(); gu.mutation.InfoCleared() && !ok {
-		return 0, errors.New("ent: clearing a unique edge \"info\"")
+	if err := gu.check(); err != nil {
+		return 0, err
 	}
 	var (
 		err      error
@@ -285,6 +336,32 @@ func (gu *GroupUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (gu *GroupUpdate) check() error {
+	if v, ok := gu.mutation.GetType(); ok {
+		if err := group.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if v, ok := gu.mutation.MaxUsers(); ok {
+		if err := group.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)}
+		}
+	}
+	if v, ok := gu.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if gu.mutation.conflictinfo {
+		return &ValidationError{Name: "info", err: errors.New("ent: setting and clearing the unique edge \"info\" in the same mutation")}
+	}
+	if _, ok := gu.mutation.InfoID(); gu.mutation.InfoCleared() && !ok {
+		return &ValidationError{Name: "info", err: errors.New("ent: clearing a unique edge \"info\"")}
+	}
+	return nil
+}
+
 func (gu *GroupUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := gu.gremlin().Query()
@@ -307,6 +384,9 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 	for _, p := range gu.predicates {
 		p(v)
 	}
+	if limit := gu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -395,8 +475,16 @@ func (gu *GroupUpdate) gremlin() *dsl.Traversal {
 // GroupUpdateOne is the builder for updating a single Group entity.
 type GroupUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *GroupMutation
+	hooks      []Hook
+	mutation   *GroupMutation
+	predicates []predicate.Group
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (guo *GroupUpdateOne) Where(ps ...predicate.Group) *GroupUpdateOne {
+	guo.predicates = append(guo.predicates, ps...)
+	return guo
 }
 
 // SetActive sets the active field.
@@ -413,12 +501,26 @@ func (guo *GroupUpdateOne) SetNillableActive(b *bool) *GroupUpdateOne {
 	return guo
 }
 
+// UnsetActive removes the changes of the active field from the builder (e.g. a previous call
+// to SetActive), and therefore, the field is left unchanged in the database.
+func (guo *GroupUpdateOne) UnsetActive() *GroupUpdateOne {
+	guo.mutation.ResetActive()
+	return guo
+}
+
 // SetExpire sets the expire field.
 func (guo *GroupUpdateOne) SetExpire(t time.Time) *GroupUpdateOne {
 	guo.mutation.SetExpire(t)
 	return guo
 }
 
+// UnsetExpire removes the changes of the expire field from the builder (e.g. a previous call
+// to SetExpire), and therefore, the field is left unchanged in the database.
+func (guo *GroupUpdateOne) UnsetExpire() *GroupUpdateOne {
+	guo.mutation.ResetExpire()
+	return guo
+}
+
 // SetType sets the type field.
 func (guo *GroupUpdateOne) SetType(s string) *GroupUpdateOne {
 	guo.mutation.SetType(s)
@@ -439,6 +541,14 @@ func (guo *GroupUpdateOne) ClearType() *GroupUpdateOne {
 	return guo
 }
 
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database. Unlike ClearType,
+// it does not set the field to NULL, and also removes a previous call to ClearType.
+func (guo *GroupUpdateOne) UnsetType() *GroupUpdateOne {
+	guo.mutation.ResetType()
+	return guo
+}
+
 // SetMaxUsers sets the max_users field.
 func (guo *GroupUpdateOne) SetMaxUsers(i int) *GroupUpdateOne {
 	guo.mutation.ResetMaxUsers()
@@ -466,12 +576,27 @@ func (guo *GroupUpdateOne) ClearMaxUsers() *GroupUpdateOne {
 	return guo
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database. Unlike ClearMaxUsers,
+// it does not set the field to NULL, and also removes a previous call to ClearMaxUsers.
+func (guo *GroupUpdateOne) UnsetMaxUsers() *GroupUpdateOne {
+	guo.mutation.ResetMaxUsers()
+	return guo
+}
+
 // SetName sets the name field.
 func (guo *GroupUpdateOne) SetName(s string) *GroupUpdateOne {
 	guo.mutation.SetName(s)
 	return guo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (guo *GroupUpdateOne) UnsetName() *GroupUpdateOne {
+	guo.mutation.ResetName()
+	return guo
+}
+
 // AddFileIDs adds the files edge to File by ids.
 func (guo *GroupUpdateOne) AddFileIDs(ids ...string) *GroupUpdateOne {
 	guo.mutation.AddFileIDs(ids...)
@@ -579,26 +704,32 @@ func (guo *GroupUpdateOne) ClearInfo() *GroupUpdateOne {
 	return guo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (guo *GroupUpdateOne) Unwrap() *GroupUpdateOne {
+	tx, ok := guo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupUpdateOne is not a transactional builder")
+	}
+	guo.config.driver = tx.drv
+	guo.mutation.driver = tx.drv
+	return guo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (guo *GroupUpdateOne) DebugFields() map[string]Value {
+	return debugFields(guo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (guo *GroupUpdateOne) Save(ctx context.Context) (*Group, error) {
-	if v, ok := guo.mutation.GetType(); ok {
-		if err := group.TypeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
-	}
-	if v, ok := guo.mutation.MaxUsers(); ok {
-		if err := group.MaxUsersValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)
-		}
-	}
-	if v, ok := guo.mutation.Name(); ok {
-		if err := group.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(guo.driver); err != nil {
+		return nil, err
 	}
-
-	if _, ok := guo.mutation.InfoID(); guo.mutation.InfoCleared() && !ok {
-		return nil, errors.New("ent: clearing a unique edge \"info\"")
+	if err := guo.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -648,6 +779,32 @@ func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (guo *GroupUpdateOne) check() error {
+	if v, ok := guo.mutation.GetType(); ok {
+		if err := group.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	if v, ok := guo.mutation.MaxUsers(); ok {
+		if err := group.MaxUsersValidator(v); err != nil {
+			return &ValidationError{Name: "max_users", err: fmt.Errorf("ent: validator failed for field \"max_users\": %v", err)}
+		}
+	}
+	if v, ok := guo.mutation.Name(); ok {
+		if err := group.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if guo.mutation.conflictinfo {
+		return &ValidationError{Name: "info", err: errors.New("ent: setting and clearing the unique edge \"info\" in the same mutation")}
+	}
+	if _, ok := guo.mutation.InfoID(); guo.mutation.InfoCleared() && !ok {
+		return &ValidationError{Name: "info", err: errors.New("ent: clearing a unique edge \"info\"")}
+	}
+	return nil
+}
+
 func (guo *GroupUpdateOne) gremlinSave(ctx context.Context) (*Group, error) {
 	res := &gremlin.Response{}
 	id, ok := guo.mutation.ID()
@@ -661,6 +818,15 @@ func (guo *GroupUpdateOne) gremlinSave(ctx context.Context) (*Group, error) {
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(guo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{group.Label}
+		}
+	}
 	gr := &Group{config: guo.config}
 	if err := gr.FromResponse(res); err != nil {
 		return nil, err
@@ -675,6 +841,9 @@ func (guo *GroupUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range guo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
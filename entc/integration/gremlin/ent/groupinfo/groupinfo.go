@@ -21,6 +21,18 @@ const (
 	GroupsInverseLabel = "group_info"
 )
 
+// Edges holds all the edge names of the GroupInfo type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeGroups,
+}
+
+// FingerprintFields holds the fields of the GroupInfo type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldDesc,
+	FieldMaxUsers,
+}
+
 var (
 	// DefaultMaxUsers holds the default value on creation for the max_users field.
 	DefaultMaxUsers int
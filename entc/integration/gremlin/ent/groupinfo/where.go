@@ -169,6 +169,13 @@ func DescContains(v string) predicate.GroupInfo {
 	})
 }
 
+// DescNotContains applies the NotContains predicate on the "desc" field.
+func DescNotContains(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDesc, p.NotContaining(v))
+	})
+}
+
 // DescHasPrefix applies the HasPrefix predicate on the "desc" field.
 func DescHasPrefix(v string) predicate.GroupInfo {
 	return predicate.GroupInfo(func(t *dsl.Traversal) {
@@ -176,6 +183,13 @@ func DescHasPrefix(v string) predicate.GroupInfo {
 	})
 }
 
+// DescNotHasPrefix applies the NotHasPrefix predicate on the "desc" field.
+func DescNotHasPrefix(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDesc, p.NotStartingWith(v))
+	})
+}
+
 // DescHasSuffix applies the HasSuffix predicate on the "desc" field.
 func DescHasSuffix(v string) predicate.GroupInfo {
 	return predicate.GroupInfo(func(t *dsl.Traversal) {
@@ -183,6 +197,13 @@ func DescHasSuffix(v string) predicate.GroupInfo {
 	})
 }
 
+// DescNotHasSuffix applies the NotHasSuffix predicate on the "desc" field.
+func DescNotHasSuffix(v string) predicate.GroupInfo {
+	return predicate.GroupInfo(func(t *dsl.Traversal) {
+		t.Has(Label, FieldDesc, p.NotEndingWith(v))
+	})
+}
+
 // MaxUsersEQ applies the EQ predicate on the "max_users" field.
 func MaxUsersEQ(v int) predicate.GroupInfo {
 	return predicate.GroupInfo(func(t *dsl.Traversal) {
@@ -299,3 +320,37 @@ func Not(p predicate.GroupInfo) predicate.GroupInfo {
 		tr.Where(__.Not(t))
 	})
 }
+
+// GroupInfoFilter holds optional filters on the GroupInfo fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type GroupInfoFilter struct {
+	IDIn     []string
+	Desc     *string
+	MaxUsers *int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.GroupInfo.Query().
+//		Where(groupinfo.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *GroupInfoFilter) []predicate.GroupInfo {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.GroupInfo
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Desc != nil {
+		ps = append(ps, DescEQ(*f.Desc))
+	}
+	if f.MaxUsers != nil {
+		ps = append(ps, MaxUsersEQ(*f.MaxUsers))
+	}
+	return ps
+}
@@ -64,12 +64,12 @@ func (gic *GroupInfoCreate) AddGroups(g ...*Group) *GroupInfoCreate {
 
 // Save creates the GroupInfo in the database.
 func (gic *GroupInfoCreate) Save(ctx context.Context) (*GroupInfo, error) {
-	if _, ok := gic.mutation.Desc(); !ok {
-		return nil, errors.New("ent: missing required field \"desc\"")
+	if err := checkTx(gic.driver); err != nil {
+		return nil, err
 	}
-	if _, ok := gic.mutation.MaxUsers(); !ok {
-		v := groupinfo.DefaultMaxUsers
-		gic.mutation.SetMaxUsers(v)
+	gic.defaults()
+	if err := gic.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -106,6 +106,42 @@ func (gic *GroupInfoCreate) SaveX(ctx context.Context) *GroupInfo {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gic *GroupInfoCreate) Unwrap() *GroupInfoCreate {
+	tx, ok := gic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInfoCreate is not a transactional builder")
+	}
+	gic.config.driver = tx.drv
+	gic.mutation.driver = tx.drv
+	return gic
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (gic *GroupInfoCreate) DebugFields() map[string]Value {
+	return debugFields(gic.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (gic *GroupInfoCreate) defaults() {
+	if _, ok := gic.mutation.MaxUsers(); !ok {
+		v := groupinfo.DefaultMaxUsers
+		gic.mutation.SetMaxUsers(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gic *GroupInfoCreate) check() error {
+	if _, ok := gic.mutation.Desc(); !ok {
+		return &ValidationError{Name: "desc", err: errors.New("ent: missing required field \"desc\"")}
+	}
+	return nil
+}
+
 func (gic *GroupInfoCreate) gremlinSave(ctx context.Context) (*GroupInfo, error) {
 	res := &gremlin.Response{}
 	query, bindings := gic.gremlin().Query()
@@ -24,6 +24,7 @@ type GroupInfoDelete struct {
 	hooks      []Hook
 	mutation   *GroupInfoMutation
 	predicates []predicate.GroupInfo
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (gid *GroupInfoDelete) Where(ps ...predicate.GroupInfo) *GroupInfoDelete {
 	return gid
 }
 
+// Limit limits the number of groupinfos that are deleted by the builder. For example,
+// for deleting large amounts of groupinfos in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (gid *GroupInfoDelete) Limit(limit int) *GroupInfoDelete {
+	gid.limit = &limit
+	return gid
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gid *GroupInfoDelete) Unwrap() *GroupInfoDelete {
+	tx, ok := gid.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInfoDelete is not a transactional builder")
+	}
+	gid.config.driver = tx.drv
+	gid.mutation.driver = tx.drv
+	return gid
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (gid *GroupInfoDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(gid.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (gid *GroupInfoDelete) gremlin() *dsl.Traversal {
 	for _, p := range gid.predicates {
 		p(t)
 	}
+	if limit := gid.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -41,6 +42,19 @@ func (giq *GroupInfoQuery) Where(ps ...predicate.GroupInfo) *GroupInfoQuery {
 	return giq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (giq *GroupInfoQuery) Predicates() []predicate.GroupInfo {
+	return append([]predicate.GroupInfo{}, giq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (giq *GroupInfoQuery) SetPredicates(ps ...predicate.GroupInfo) *GroupInfoQuery {
+	giq.predicates = append([]predicate.GroupInfo{}, ps...)
+	return giq
+}
+
 // Limit adds a limit step to the query.
 func (giq *GroupInfoQuery) Limit(limit int) *GroupInfoQuery {
 	giq.limit = &limit
@@ -649,6 +663,27 @@ func (gis *GroupInfoSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (gis *GroupInfoSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(gis.fields) > 1 {
+		return nil, errors.New("ent: GroupInfoSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := gis.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (gis *GroupInfoSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := gis.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (gis *GroupInfoSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
@@ -26,6 +26,7 @@ type GroupInfoUpdate struct {
 	hooks      []Hook
 	mutation   *GroupInfoMutation
 	predicates []predicate.GroupInfo
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -34,12 +35,26 @@ func (giu *GroupInfoUpdate) Where(ps ...predicate.GroupInfo) *GroupInfoUpdate {
 	return giu
 }
 
+// Limit limits the number of groupinfos that are updated by the builder. For example,
+// for updating large amounts of groupinfos in small batches without locking the whole table.
+func (giu *GroupInfoUpdate) Limit(limit int) *GroupInfoUpdate {
+	giu.limit = &limit
+	return giu
+}
+
 // SetDesc sets the desc field.
 func (giu *GroupInfoUpdate) SetDesc(s string) *GroupInfoUpdate {
 	giu.mutation.SetDesc(s)
 	return giu
 }
 
+// UnsetDesc removes the changes of the desc field from the builder (e.g. a previous call
+// to SetDesc), and therefore, the field is left unchanged in the database.
+func (giu *GroupInfoUpdate) UnsetDesc() *GroupInfoUpdate {
+	giu.mutation.ResetDesc()
+	return giu
+}
+
 // SetMaxUsers sets the max_users field.
 func (giu *GroupInfoUpdate) SetMaxUsers(i int) *GroupInfoUpdate {
 	giu.mutation.ResetMaxUsers()
@@ -61,6 +76,13 @@ func (giu *GroupInfoUpdate) AddMaxUsers(i int) *GroupInfoUpdate {
 	return giu
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database.
+func (giu *GroupInfoUpdate) UnsetMaxUsers() *GroupInfoUpdate {
+	giu.mutation.ResetMaxUsers()
+	return giu
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (giu *GroupInfoUpdate) AddGroupIDs(ids ...string) *GroupInfoUpdate {
 	giu.mutation.AddGroupIDs(ids...)
@@ -91,9 +113,33 @@ func (giu *GroupInfoUpdate) RemoveGroups(g ...*Group) *GroupInfoUpdate {
 	return giu.RemoveGroupIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (giu *GroupInfoUpdate) Unwrap() *GroupInfoUpdate {
+	tx, ok := giu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInfoUpdate is not a transactional builder")
+	}
+	giu.config.driver = tx.drv
+	giu.mutation.driver = tx.drv
+	return giu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (giu *GroupInfoUpdate) DebugFields() map[string]Value {
+	return debugFields(giu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (giu *GroupInfoUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(giu.driver); err != nil {
+		return 0, err
+	}
+	if err := giu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -142,6 +188,11 @@ func (giu *GroupInfoUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (giu *GroupInfoUpdate) check() error {
+	return nil
+}
+
 func (giu *GroupInfoUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := giu.gremlin().Query()
@@ -164,6 +215,9 @@ func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
 	for _, p := range giu.predicates {
 		p(v)
 	}
+	if limit := giu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -208,8 +262,16 @@ func (giu *GroupInfoUpdate) gremlin() *dsl.Traversal {
 // GroupInfoUpdateOne is the builder for updating a single GroupInfo entity.
 type GroupInfoUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *GroupInfoMutation
+	hooks      []Hook
+	mutation   *GroupInfoMutation
+	predicates []predicate.GroupInfo
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (giuo *GroupInfoUpdateOne) Where(ps ...predicate.GroupInfo) *GroupInfoUpdateOne {
+	giuo.predicates = append(giuo.predicates, ps...)
+	return giuo
 }
 
 // SetDesc sets the desc field.
@@ -218,6 +280,13 @@ func (giuo *GroupInfoUpdateOne) SetDesc(s string) *GroupInfoUpdateOne {
 	return giuo
 }
 
+// UnsetDesc removes the changes of the desc field from the builder (e.g. a previous call
+// to SetDesc), and therefore, the field is left unchanged in the database.
+func (giuo *GroupInfoUpdateOne) UnsetDesc() *GroupInfoUpdateOne {
+	giuo.mutation.ResetDesc()
+	return giuo
+}
+
 // SetMaxUsers sets the max_users field.
 func (giuo *GroupInfoUpdateOne) SetMaxUsers(i int) *GroupInfoUpdateOne {
 	giuo.mutation.ResetMaxUsers()
@@ -239,6 +308,13 @@ func (giuo *GroupInfoUpdateOne) AddMaxUsers(i int) *GroupInfoUpdateOne {
 	return giuo
 }
 
+// UnsetMaxUsers removes the changes of the max_users field from the builder (e.g. a previous call
+// to SetMaxUsers), and therefore, the field is left unchanged in the database.
+func (giuo *GroupInfoUpdateOne) UnsetMaxUsers() *GroupInfoUpdateOne {
+	giuo.mutation.ResetMaxUsers()
+	return giuo
+}
+
 // AddGroupIDs adds the groups edge to Group by ids.
 func (giuo *GroupInfoUpdateOne) AddGroupIDs(ids ...string) *GroupInfoUpdateOne {
 	giuo.mutation.AddGroupIDs(ids...)
@@ -269,9 +345,33 @@ func (giuo *GroupInfoUpdateOne) RemoveGroups(g ...*Group) *GroupInfoUpdateOne {
 	return giuo.RemoveGroupIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (giuo *GroupInfoUpdateOne) Unwrap() *GroupInfoUpdateOne {
+	tx, ok := giuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GroupInfoUpdateOne is not a transactional builder")
+	}
+	giuo.config.driver = tx.drv
+	giuo.mutation.driver = tx.drv
+	return giuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (giuo *GroupInfoUpdateOne) DebugFields() map[string]Value {
+	return debugFields(giuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (giuo *GroupInfoUpdateOne) Save(ctx context.Context) (*GroupInfo, error) {
-
+	if err := checkTx(giuo.driver); err != nil {
+		return nil, err
+	}
+	if err := giuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *GroupInfo
@@ -320,6 +420,11 @@ func (giuo *GroupInfoUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (giuo *GroupInfoUpdateOne) check() error {
+	return nil
+}
+
 func (giuo *GroupInfoUpdateOne) gremlinSave(ctx context.Context) (*GroupInfo, error) {
 	res := &gremlin.Response{}
 	id, ok := giuo.mutation.ID()
@@ -333,6 +438,15 @@ func (giuo *GroupInfoUpdateOne) gremlinSave(ctx context.Context) (*GroupInfo, er
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(giuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{groupinfo.Label}
+		}
+	}
 	gi := &GroupInfo{config: giuo.config}
 	if err := gi.FromResponse(res); err != nil {
 		return nil, err
@@ -347,6 +461,9 @@ func (giuo *GroupInfoUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 1)
 	v := g.V(id)
+	for _, p := range giuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -7,17 +7,27 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/item"
 )
 
 // Item is the model entity for the Item schema.
 type Item struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
 }
 
 // FromResponse scans the gremlin response data into Item.
@@ -27,12 +37,42 @@ func (i *Item) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var scani struct {
-		ID string `json:"id,omitempty"`
+		ID        string `json:"id,omitempty"`
+		CreatedAt int64  `json:"created_at,omitempty"`
+		Priority  int    `json:"priority,omitempty"`
 	}
 	if err := vmap.Decode(&scani); err != nil {
 		return err
 	}
 	i.ID = scani.ID
+	i.CreatedAt = time.Unix(0, scani.CreatedAt)
+	i.Priority = scani.Priority
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Item and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Item alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Item itself is not modified.
+//
+//	b, err := i.DumpGraph(ctx, client, 2)
+//
+func (i *Item) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Item DumpGraph", depth)
+	}
+	dumped := *i
+	if err := dumpItems(ctx, client, []*Item{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpItems loads the edges of the given Item copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpItems(ctx context.Context, client *Client, nodes []*Item, depth int, dumper *graphDump) error {
 	return nil
 }
 
@@ -54,15 +94,61 @@ func (i *Item) Unwrap() *Item {
 	return i
 }
 
+// Clone returns a deep copy of the Item and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (i *Item) Clone() *Item {
+	return i.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Item and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (i *Item) clone(seen map[interface{}]interface{}) *Item {
+	if i == nil {
+		return nil
+	}
+	if v, ok := seen[i]; ok {
+		return v.(*Item)
+	}
+	_c := *i
+	seen[i] = &_c
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (i *Item) String() string {
 	var builder strings.Builder
 	builder.WriteString("Item(")
 	builder.WriteString(fmt.Sprintf("id=%v", i.ID))
+	builder.WriteString(", created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", priority=")
+	builder.WriteString(fmt.Sprintf("%v", i.Priority))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Item, for change detection and cache keys.
+// It covers the fields that are listed in item.FingerprintFields, and it does not depend on the
+// ID of the Item, on its edges, or on the order of the fields in the schema.
+func (i *Item) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "created_at", i.CreatedAt)
+	fingerprint(h, "priority", i.Priority)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Item for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Item can be decoded using the FromMap method of its client.
+func (i *Item) ToMap() map[string]string {
+	m := make(map[string]string, 3)
+	m[item.FieldID] = kvEncode(i.ID)
+	m[item.FieldCreatedAt] = kvEncode(i.CreatedAt)
+	m[item.FieldPriority] = kvEncode(i.Priority)
+	return m
+}
+
 // Items is a parsable slice of Item.
 type Items []*Item
 
@@ -73,15 +159,20 @@ func (i *Items) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var scani []struct {
-		ID string `json:"id,omitempty"`
+		ID        string `json:"id,omitempty"`
+		CreatedAt int64  `json:"created_at,omitempty"`
+		Priority  int    `json:"priority,omitempty"`
 	}
 	if err := vmap.Decode(&scani); err != nil {
 		return err
 	}
 	for _, v := range scani {
-		*i = append(*i, &Item{
-			ID: v.ID,
-		})
+		node := &Item{
+			ID:        v.ID,
+			CreatedAt: time.Unix(0, v.CreatedAt),
+			Priority:  v.Priority,
+		}
+		*i = append(*i, node)
 	}
 	return nil
 }
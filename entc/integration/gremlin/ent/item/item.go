@@ -10,5 +10,14 @@ const (
 	// Label holds the string label denoting the item type in the database.
 	Label = "item"
 	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	FieldID        = "id"         // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt = "created_at" // FieldPriority holds the string denoting the priority vertex property in the database.
+	FieldPriority  = "priority"
 )
+
+// FingerprintFields holds the fields of the Item type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldCreatedAt,
+	FieldPriority,
+}
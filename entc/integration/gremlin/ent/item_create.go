@@ -9,6 +9,7 @@ package ent
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -23,8 +24,27 @@ type ItemCreate struct {
 	hooks    []Hook
 }
 
+// SetCreatedAt sets the created_at field.
+func (ic *ItemCreate) SetCreatedAt(t time.Time) *ItemCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetPriority sets the priority field.
+func (ic *ItemCreate) SetPriority(i int) *ItemCreate {
+	ic.mutation.SetPriority(i)
+	return ic
+}
+
 // Save creates the Item in the database.
 func (ic *ItemCreate) Save(ctx context.Context) (*Item, error) {
+	if err := checkTx(ic.driver); err != nil {
+		return nil, err
+	}
+	ic.defaults()
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Item
@@ -60,6 +80,35 @@ func (ic *ItemCreate) SaveX(ctx context.Context) *Item {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ic *ItemCreate) Unwrap() *ItemCreate {
+	tx, ok := ic.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemCreate is not a transactional builder")
+	}
+	ic.config.driver = tx.drv
+	ic.mutation.driver = tx.drv
+	return ic
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (ic *ItemCreate) DebugFields() map[string]Value {
+	return debugFields(ic.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *ItemCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *ItemCreate) check() error {
+	return nil
+}
+
 func (ic *ItemCreate) gremlinSave(ctx context.Context) (*Item, error) {
 	res := &gremlin.Response{}
 	query, bindings := ic.gremlin().Query()
@@ -78,5 +127,11 @@ func (ic *ItemCreate) gremlinSave(ctx context.Context) (*Item, error) {
 
 func (ic *ItemCreate) gremlin() *dsl.Traversal {
 	v := g.AddV(item.Label)
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		v.Property(dsl.Single, item.FieldCreatedAt, value)
+	}
+	if value, ok := ic.mutation.Priority(); ok {
+		v.Property(dsl.Single, item.FieldPriority, value)
+	}
 	return v.ValueMap(true)
 }
@@ -24,6 +24,7 @@ type ItemDelete struct {
 	hooks      []Hook
 	mutation   *ItemMutation
 	predicates []predicate.Item
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (id *ItemDelete) Where(ps ...predicate.Item) *ItemDelete {
 	return id
 }
 
+// Limit limits the number of items that are deleted by the builder. For example,
+// for deleting large amounts of items in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (id *ItemDelete) Limit(limit int) *ItemDelete {
+	id.limit = &limit
+	return id
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (id *ItemDelete) Unwrap() *ItemDelete {
+	tx, ok := id.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemDelete is not a transactional builder")
+	}
+	id.config.driver = tx.drv
+	id.mutation.driver = tx.drv
+	return id
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (id *ItemDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(id.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (id *ItemDelete) gremlin() *dsl.Traversal {
 	for _, p := range id.predicates {
 		p(t)
 	}
+	if limit := id.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
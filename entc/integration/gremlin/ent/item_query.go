@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -38,6 +39,19 @@ func (iq *ItemQuery) Where(ps ...predicate.Item) *ItemQuery {
 	return iq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (iq *ItemQuery) Predicates() []predicate.Item {
+	return append([]predicate.Item{}, iq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (iq *ItemQuery) SetPredicates(ps ...predicate.Item) *ItemQuery {
+	iq.predicates = append([]predicate.Item{}, ps...)
+	return iq
+}
+
 // Limit adds a limit step to the query.
 func (iq *ItemQuery) Limit(limit int) *ItemQuery {
 	iq.limit = &limit
@@ -237,6 +251,19 @@ func (iq *ItemQuery) Clone() *ItemQuery {
 
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Item.Query().
+//		GroupBy(item.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
 func (iq *ItemQuery) GroupBy(field string, fields ...string) *ItemGroupBy {
 	group := &ItemGroupBy{config: iq.config}
 	group.fields = append([]string{field}, fields...)
@@ -250,6 +277,17 @@ func (iq *ItemQuery) GroupBy(field string, fields ...string) *ItemGroupBy {
 }
 
 // Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.Item.Query().
+//		Select(item.FieldCreatedAt).
+//		Scan(ctx, &v)
+//
 func (iq *ItemQuery) Select(field string, fields ...string) *ItemSelect {
 	selector := &ItemSelect{config: iq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -597,6 +635,27 @@ func (is *ItemSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (is *ItemSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(is.fields) > 1 {
+		return nil, errors.New("ent: ItemSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := is.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (is *ItemSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := is.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (is *ItemSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
@@ -9,9 +9,11 @@ package ent
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/item"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/predicate"
@@ -23,6 +25,7 @@ type ItemUpdate struct {
 	hooks      []Hook
 	mutation   *ItemMutation
 	predicates []predicate.Item
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -31,8 +34,73 @@ func (iu *ItemUpdate) Where(ps ...predicate.Item) *ItemUpdate {
 	return iu
 }
 
+// Limit limits the number of items that are updated by the builder. For example,
+// for updating large amounts of items in small batches without locking the whole table.
+func (iu *ItemUpdate) Limit(limit int) *ItemUpdate {
+	iu.limit = &limit
+	return iu
+}
+
+// SetCreatedAt sets the created_at field.
+func (iu *ItemUpdate) SetCreatedAt(t time.Time) *ItemUpdate {
+	iu.mutation.SetCreatedAt(t)
+	return iu
+}
+
+// UnsetCreatedAt removes the changes of the created_at field from the builder (e.g. a previous call
+// to SetCreatedAt), and therefore, the field is left unchanged in the database.
+func (iu *ItemUpdate) UnsetCreatedAt() *ItemUpdate {
+	iu.mutation.ResetCreatedAt()
+	return iu
+}
+
+// SetPriority sets the priority field.
+func (iu *ItemUpdate) SetPriority(i int) *ItemUpdate {
+	iu.mutation.ResetPriority()
+	iu.mutation.SetPriority(i)
+	return iu
+}
+
+// AddPriority adds i to priority.
+func (iu *ItemUpdate) AddPriority(i int) *ItemUpdate {
+	iu.mutation.AddPriority(i)
+	return iu
+}
+
+// UnsetPriority removes the changes of the priority field from the builder (e.g. a previous call
+// to SetPriority), and therefore, the field is left unchanged in the database.
+func (iu *ItemUpdate) UnsetPriority() *ItemUpdate {
+	iu.mutation.ResetPriority()
+	return iu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (iu *ItemUpdate) Unwrap() *ItemUpdate {
+	tx, ok := iu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemUpdate is not a transactional builder")
+	}
+	iu.config.driver = tx.drv
+	iu.mutation.driver = tx.drv
+	return iu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (iu *ItemUpdate) DebugFields() map[string]Value {
+	return debugFields(iu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (iu *ItemUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(iu.driver); err != nil {
+		return 0, err
+	}
+	if err := iu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -81,6 +149,11 @@ func (iu *ItemUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (iu *ItemUpdate) check() error {
+	return nil
+}
+
 func (iu *ItemUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := iu.gremlin().Query()
@@ -98,9 +171,21 @@ func (iu *ItemUpdate) gremlin() *dsl.Traversal {
 	for _, p := range iu.predicates {
 		p(v)
 	}
+	if limit := iu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		trs []*dsl.Traversal
 	)
+	if value, ok := iu.mutation.CreatedAt(); ok {
+		v.Property(dsl.Single, item.FieldCreatedAt, value)
+	}
+	if value, ok := iu.mutation.Priority(); ok {
+		v.Property(dsl.Single, item.FieldPriority, value)
+	}
+	if value, ok := iu.mutation.AddedPriority(); ok {
+		v.Property(dsl.Single, item.FieldPriority, __.Union(__.Values(item.FieldPriority), __.Constant(value)).Sum())
+	}
 	v.Count()
 	trs = append(trs, v)
 	return dsl.Join(trs...)
@@ -109,12 +194,78 @@ func (iu *ItemUpdate) gremlin() *dsl.Traversal {
 // ItemUpdateOne is the builder for updating a single Item entity.
 type ItemUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *ItemMutation
+	hooks      []Hook
+	mutation   *ItemMutation
+	predicates []predicate.Item
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (iuo *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
+	iuo.predicates = append(iuo.predicates, ps...)
+	return iuo
+}
+
+// SetCreatedAt sets the created_at field.
+func (iuo *ItemUpdateOne) SetCreatedAt(t time.Time) *ItemUpdateOne {
+	iuo.mutation.SetCreatedAt(t)
+	return iuo
+}
+
+// UnsetCreatedAt removes the changes of the created_at field from the builder (e.g. a previous call
+// to SetCreatedAt), and therefore, the field is left unchanged in the database.
+func (iuo *ItemUpdateOne) UnsetCreatedAt() *ItemUpdateOne {
+	iuo.mutation.ResetCreatedAt()
+	return iuo
+}
+
+// SetPriority sets the priority field.
+func (iuo *ItemUpdateOne) SetPriority(i int) *ItemUpdateOne {
+	iuo.mutation.ResetPriority()
+	iuo.mutation.SetPriority(i)
+	return iuo
+}
+
+// AddPriority adds i to priority.
+func (iuo *ItemUpdateOne) AddPriority(i int) *ItemUpdateOne {
+	iuo.mutation.AddPriority(i)
+	return iuo
+}
+
+// UnsetPriority removes the changes of the priority field from the builder (e.g. a previous call
+// to SetPriority), and therefore, the field is left unchanged in the database.
+func (iuo *ItemUpdateOne) UnsetPriority() *ItemUpdateOne {
+	iuo.mutation.ResetPriority()
+	return iuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (iuo *ItemUpdateOne) Unwrap() *ItemUpdateOne {
+	tx, ok := iuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemUpdateOne is not a transactional builder")
+	}
+	iuo.config.driver = tx.drv
+	iuo.mutation.driver = tx.drv
+	return iuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (iuo *ItemUpdateOne) DebugFields() map[string]Value {
+	return debugFields(iuo.mutation)
 }
 
 // Save executes the query and returns the updated entity.
 func (iuo *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
+	if err := checkTx(iuo.driver); err != nil {
+		return nil, err
+	}
+	if err := iuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Item
@@ -163,6 +314,11 @@ func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (iuo *ItemUpdateOne) check() error {
+	return nil
+}
+
 func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
 	res := &gremlin.Response{}
 	id, ok := iuo.mutation.ID()
@@ -176,6 +332,15 @@ func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(iuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{item.Label}
+		}
+	}
 	i := &Item{config: iuo.config}
 	if err := i.FromResponse(res); err != nil {
 		return nil, err
@@ -185,9 +350,21 @@ func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
 
 func (iuo *ItemUpdateOne) gremlin(id string) *dsl.Traversal {
 	v := g.V(id)
+	for _, p := range iuo.predicates {
+		p(v)
+	}
 	var (
 		trs []*dsl.Traversal
 	)
+	if value, ok := iuo.mutation.CreatedAt(); ok {
+		v.Property(dsl.Single, item.FieldCreatedAt, value)
+	}
+	if value, ok := iuo.mutation.Priority(); ok {
+		v.Property(dsl.Single, item.FieldPriority, value)
+	}
+	if value, ok := iuo.mutation.AddedPriority(); ok {
+		v.Property(dsl.Single, item.FieldPriority, __.Union(__.Values(item.FieldPriority), __.Constant(value)).Sum())
+	}
 	v.ValueMap(true)
 	trs = append(trs, v)
 	return dsl.Join(trs...)
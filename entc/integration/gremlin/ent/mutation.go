@@ -7,9 +7,11 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/comment"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/fieldtype"
@@ -17,6 +19,7 @@ import (
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/filetype"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/groupinfo"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/item"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/node"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/spec"
@@ -59,9 +62,13 @@ type CardMutation struct {
 	update_time   *time.Time
 	number        *string
 	name          *string
+	expires_at    *time.Time
+	_type         *schema.CardType
+	number_hash   *string
 	clearedFields map[string]struct{}
 	owner         *string
 	clearedowner  bool
+	conflictowner bool
 	spec          map[string]struct{}
 	removedspec   map[string]struct{}
 }
@@ -97,6 +104,18 @@ func (m CardMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CardMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CardMutation) ID() (id string, exists bool) {
@@ -195,14 +214,114 @@ func (m *CardMutation) ResetName() {
 	delete(m.clearedFields, card.FieldName)
 }
 
+// SetExpiresAt sets the expires_at field.
+func (m *CardMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the expires_at value in the mutation.
+func (m *CardMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (m *CardMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[card.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the field expires_at was cleared in this mutation.
+func (m *CardMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[card.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt reset all changes of the "expires_at" field.
+func (m *CardMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, card.FieldExpiresAt)
+}
+
+// SetType sets the type field.
+func (m *CardMutation) SetType(st schema.CardType) {
+	m._type = &st
+}
+
+// GetType returns the type value in the mutation.
+func (m *CardMutation) GetType() (r schema.CardType, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearType clears the value of type.
+func (m *CardMutation) ClearType() {
+	m._type = nil
+	m.clearedFields[card.FieldType] = struct{}{}
+}
+
+// TypeCleared returns if the field type was cleared in this mutation.
+func (m *CardMutation) TypeCleared() bool {
+	_, ok := m.clearedFields[card.FieldType]
+	return ok
+}
+
+// ResetType reset all changes of the "type" field.
+func (m *CardMutation) ResetType() {
+	m._type = nil
+	delete(m.clearedFields, card.FieldType)
+}
+
+// SetNumberHash sets the number_hash field.
+func (m *CardMutation) SetNumberHash(s string) {
+	m.number_hash = &s
+}
+
+// NumberHash returns the number_hash value in the mutation.
+func (m *CardMutation) NumberHash() (r string, exists bool) {
+	v := m.number_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNumberHash clears the value of number_hash.
+func (m *CardMutation) ClearNumberHash() {
+	m.number_hash = nil
+	m.clearedFields[card.FieldNumberHash] = struct{}{}
+}
+
+// NumberHashCleared returns if the field number_hash was cleared in this mutation.
+func (m *CardMutation) NumberHashCleared() bool {
+	_, ok := m.clearedFields[card.FieldNumberHash]
+	return ok
+}
+
+// ResetNumberHash reset all changes of the "number_hash" field.
+func (m *CardMutation) ResetNumberHash() {
+	m.number_hash = nil
+	delete(m.clearedFields, card.FieldNumberHash)
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (m *CardMutation) SetOwnerID(id string) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to User.
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *CardMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -218,6 +337,34 @@ func (m *CardMutation) OwnerID() (id string, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *CardMutation) OwnerIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Card.Query().Where(card.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -232,6 +379,7 @@ func (m *CardMutation) OwnerIDs() (ids []string) {
 func (m *CardMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // AddSpecIDs adds the spec edge to Spec by ids.
@@ -286,11 +434,16 @@ func (m *CardMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CardMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
 func (m *CardMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 7)
 	if m.create_time != nil {
 		fields = append(fields, card.FieldCreateTime)
 	}
@@ -303,6 +456,15 @@ func (m *CardMutation) Fields() []string {
 	if m.name != nil {
 		fields = append(fields, card.FieldName)
 	}
+	if m.expires_at != nil {
+		fields = append(fields, card.FieldExpiresAt)
+	}
+	if m._type != nil {
+		fields = append(fields, card.FieldType)
+	}
+	if m.number_hash != nil {
+		fields = append(fields, card.FieldNumberHash)
+	}
 	return fields
 }
 
@@ -319,6 +481,12 @@ func (m *CardMutation) Field(name string) (ent.Value, bool) {
 		return m.Number()
 	case card.FieldName:
 		return m.Name()
+	case card.FieldExpiresAt:
+		return m.ExpiresAt()
+	case card.FieldType:
+		return m.GetType()
+	case card.FieldNumberHash:
+		return m.NumberHash()
 	}
 	return nil, false
 }
@@ -356,6 +524,27 @@ func (m *CardMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case card.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case card.FieldType:
+		v, ok := value.(schema.CardType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case card.FieldNumberHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumberHash(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Card field %s", name)
 }
@@ -389,6 +578,15 @@ func (m *CardMutation) ClearedFields() []string {
 	if m.FieldCleared(card.FieldName) {
 		fields = append(fields, card.FieldName)
 	}
+	if m.FieldCleared(card.FieldExpiresAt) {
+		fields = append(fields, card.FieldExpiresAt)
+	}
+	if m.FieldCleared(card.FieldType) {
+		fields = append(fields, card.FieldType)
+	}
+	if m.FieldCleared(card.FieldNumberHash) {
+		fields = append(fields, card.FieldNumberHash)
+	}
 	return fields
 }
 
@@ -406,6 +604,15 @@ func (m *CardMutation) ClearField(name string) error {
 	case card.FieldName:
 		m.ClearName()
 		return nil
+	case card.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case card.FieldType:
+		m.ClearType()
+		return nil
+	case card.FieldNumberHash:
+		m.ClearNumberHash()
+		return nil
 	}
 	return fmt.Errorf("unknown Card nullable field %s", name)
 }
@@ -427,6 +634,15 @@ func (m *CardMutation) ResetField(name string) error {
 	case card.FieldName:
 		m.ResetName()
 		return nil
+	case card.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case card.FieldType:
+		m.ResetType()
+		return nil
+	case card.FieldNumberHash:
+		m.ResetNumberHash()
+		return nil
 	}
 	return fmt.Errorf("unknown Card field %s", name)
 }
@@ -579,6 +795,18 @@ func (m CommentMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CommentMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CommentMutation) ID() (id string, exists bool) {
@@ -729,6 +957,11 @@ func (m *CommentMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CommentMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -1013,6 +1246,8 @@ type FieldTypeMutation struct {
 	optional_float32           *float32
 	addoptional_float32        *float32
 	datetime                   *time.Time
+	decimal                    *float64
+	adddecimal                 *float64
 	clearedFields              map[string]struct{}
 }
 
@@ -1047,6 +1282,18 @@ func (m FieldTypeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m FieldTypeMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *FieldTypeMutation) ID() (id string, exists bool) {
@@ -2269,6 +2516,59 @@ func (m *FieldTypeMutation) ResetDatetime() {
 	delete(m.clearedFields, fieldtype.FieldDatetime)
 }
 
+// SetDecimal sets the decimal field.
+func (m *FieldTypeMutation) SetDecimal(f float64) {
+	m.decimal = &f
+	m.adddecimal = nil
+}
+
+// Decimal returns the decimal value in the mutation.
+func (m *FieldTypeMutation) Decimal() (r float64, exists bool) {
+	v := m.decimal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddDecimal adds f to decimal.
+func (m *FieldTypeMutation) AddDecimal(f float64) {
+	if m.adddecimal != nil {
+		*m.adddecimal += f
+	} else {
+		m.adddecimal = &f
+	}
+}
+
+// AddedDecimal returns the value that was added to the decimal field in this mutation.
+func (m *FieldTypeMutation) AddedDecimal() (r float64, exists bool) {
+	v := m.adddecimal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDecimal clears the value of decimal.
+func (m *FieldTypeMutation) ClearDecimal() {
+	m.decimal = nil
+	m.adddecimal = nil
+	m.clearedFields[fieldtype.FieldDecimal] = struct{}{}
+}
+
+// DecimalCleared returns if the field decimal was cleared in this mutation.
+func (m *FieldTypeMutation) DecimalCleared() bool {
+	_, ok := m.clearedFields[fieldtype.FieldDecimal]
+	return ok
+}
+
+// ResetDecimal reset all changes of the "decimal" field.
+func (m *FieldTypeMutation) ResetDecimal() {
+	m.decimal = nil
+	m.adddecimal = nil
+	delete(m.clearedFields, fieldtype.FieldDecimal)
+}
+
 // Op returns the operation name.
 func (m *FieldTypeMutation) Op() Op {
 	return m.op
@@ -2279,11 +2579,16 @@ func (m *FieldTypeMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *FieldTypeMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
 func (m *FieldTypeMutation) Fields() []string {
-	fields := make([]string, 0, 25)
+	fields := make([]string, 0, 26)
 	if m.int != nil {
 		fields = append(fields, fieldtype.FieldInt)
 	}
@@ -2359,6 +2664,9 @@ func (m *FieldTypeMutation) Fields() []string {
 	if m.datetime != nil {
 		fields = append(fields, fieldtype.FieldDatetime)
 	}
+	if m.decimal != nil {
+		fields = append(fields, fieldtype.FieldDecimal)
+	}
 	return fields
 }
 
@@ -2417,6 +2725,8 @@ func (m *FieldTypeMutation) Field(name string) (ent.Value, bool) {
 		return m.OptionalFloat32()
 	case fieldtype.FieldDatetime:
 		return m.Datetime()
+	case fieldtype.FieldDecimal:
+		return m.Decimal()
 	}
 	return nil, false
 }
@@ -2601,6 +2911,13 @@ func (m *FieldTypeMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDatetime(v)
 		return nil
+	case fieldtype.FieldDecimal:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecimal(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FieldType field %s", name)
 }
@@ -2678,6 +2995,9 @@ func (m *FieldTypeMutation) AddedFields() []string {
 	if m.addoptional_float32 != nil {
 		fields = append(fields, fieldtype.FieldOptionalFloat32)
 	}
+	if m.adddecimal != nil {
+		fields = append(fields, fieldtype.FieldDecimal)
+	}
 	return fields
 }
 
@@ -2732,6 +3052,8 @@ func (m *FieldTypeMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedOptionalFloat()
 	case fieldtype.FieldOptionalFloat32:
 		return m.AddedOptionalFloat32()
+	case fieldtype.FieldDecimal:
+		return m.AddedDecimal()
 	}
 	return nil, false
 }
@@ -2902,6 +3224,13 @@ func (m *FieldTypeMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddOptionalFloat32(v)
 		return nil
+	case fieldtype.FieldDecimal:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDecimal(v)
+		return nil
 	}
 	return fmt.Errorf("unknown FieldType numeric field %s", name)
 }
@@ -2970,6 +3299,9 @@ func (m *FieldTypeMutation) ClearedFields() []string {
 	if m.FieldCleared(fieldtype.FieldDatetime) {
 		fields = append(fields, fieldtype.FieldDatetime)
 	}
+	if m.FieldCleared(fieldtype.FieldDecimal) {
+		fields = append(fields, fieldtype.FieldDecimal)
+	}
 	return fields
 }
 
@@ -3044,6 +3376,9 @@ func (m *FieldTypeMutation) ClearField(name string) error {
 	case fieldtype.FieldDatetime:
 		m.ClearDatetime()
 		return nil
+	case fieldtype.FieldDecimal:
+		m.ClearDecimal()
+		return nil
 	}
 	return fmt.Errorf("unknown FieldType nullable field %s", name)
 }
@@ -3128,6 +3463,9 @@ func (m *FieldTypeMutation) ResetField(name string) error {
 	case fieldtype.FieldDatetime:
 		m.ResetDatetime()
 		return nil
+	case fieldtype.FieldDecimal:
+		m.ResetDecimal()
+		return nil
 	}
 	return fmt.Errorf("unknown FieldType field %s", name)
 }
@@ -3207,8 +3545,10 @@ type FileMutation struct {
 	clearedFields map[string]struct{}
 	owner         *string
 	clearedowner  bool
+	conflictowner bool
 	_type         *string
 	cleared_type  bool
+	conflict_type bool
 	field         map[string]struct{}
 	removedfield  map[string]struct{}
 }
@@ -3244,6 +3584,18 @@ func (m FileMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m FileMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *FileMutation) ID() (id string, exists bool) {
@@ -3378,11 +3730,15 @@ func (m *FileMutation) ResetGroup() {
 // SetOwnerID sets the owner edge to User by id.
 func (m *FileMutation) SetOwnerID(id string) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to User.
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *FileMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -3398,6 +3754,34 @@ func (m *FileMutation) OwnerID() (id string, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *FileMutation) OwnerIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().File.Query().Where(file.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -3412,16 +3796,21 @@ func (m *FileMutation) OwnerIDs() (ids []string) {
 func (m *FileMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // SetTypeID sets the type edge to FileType by id.
 func (m *FileMutation) SetTypeID(id string) {
 	m._type = &id
+	m.conflict_type = false
 }
 
-// ClearType clears the type edge to FileType.
+// ClearType clears the type edge to FileType. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *FileMutation) ClearType() {
 	m.cleared_type = true
+	m.conflict_type = m._type != nil
 }
 
 // TypeCleared returns if the edge type was cleared.
@@ -3437,6 +3826,34 @@ func (m *FileMutation) TypeID() (id string, exists bool) {
 	return
 }
 
+// TypeIDChanged reports if the type edge is set to a different FileType, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *FileMutation) TypeIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m._type == nil && !m.cleared_type {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: TypeIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: TypeIDChanged requires an ID field in the mutation")
+	}
+	if m._type != nil {
+		newID = *m._type
+	}
+	oldID, err = m.Client().File.Query().Where(file.ID(id)).QueryType().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m._type != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old type id: %v", err)
+	}
+	return oldID, newID, m._type == nil || oldID != newID, nil
+}
+
 // TypeIDs returns the type ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // TypeID instead. It exists only for internal usage by the builders.
@@ -3451,6 +3868,7 @@ func (m *FileMutation) TypeIDs() (ids []string) {
 func (m *FileMutation) ResetType() {
 	m._type = nil
 	m.cleared_type = false
+	m.conflict_type = false
 }
 
 // AddFieldIDs adds the field edge to FieldType by ids.
@@ -3505,6 +3923,11 @@ func (m *FileMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *FileMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -3834,6 +4257,18 @@ func (m FileTypeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m FileTypeMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *FileTypeMutation) ID() (id string, exists bool) {
@@ -3914,6 +4349,11 @@ func (m *FileTypeMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *FileTypeMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -4110,6 +4550,7 @@ type GroupMutation struct {
 	removedusers   map[string]struct{}
 	info           *string
 	clearedinfo    bool
+	conflictinfo   bool
 }
 
 var _ ent.Mutation = (*GroupMutation)(nil)
@@ -4143,6 +4584,18 @@ func (m GroupMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m GroupMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *GroupMutation) ID() (id string, exists bool) {
@@ -4423,11 +4876,15 @@ func (m *GroupMutation) ResetUsers() {
 // SetInfoID sets the info edge to GroupInfo by id.
 func (m *GroupMutation) SetInfoID(id string) {
 	m.info = &id
+	m.conflictinfo = false
 }
 
-// ClearInfo clears the info edge to GroupInfo.
+// ClearInfo clears the info edge to GroupInfo. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *GroupMutation) ClearInfo() {
 	m.clearedinfo = true
+	m.conflictinfo = m.info != nil
 }
 
 // InfoCleared returns if the edge info was cleared.
@@ -4443,6 +4900,34 @@ func (m *GroupMutation) InfoID() (id string, exists bool) {
 	return
 }
 
+// InfoIDChanged reports if the info edge is set to a different GroupInfo, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *GroupMutation) InfoIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.info == nil && !m.clearedinfo {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: InfoIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: InfoIDChanged requires an ID field in the mutation")
+	}
+	if m.info != nil {
+		newID = *m.info
+	}
+	oldID, err = m.Client().Group.Query().Where(group.ID(id)).QueryInfo().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.info != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old info id: %v", err)
+	}
+	return oldID, newID, m.info == nil || oldID != newID, nil
+}
+
 // InfoIDs returns the info ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // InfoID instead. It exists only for internal usage by the builders.
@@ -4457,6 +4942,7 @@ func (m *GroupMutation) InfoIDs() (ids []string) {
 func (m *GroupMutation) ResetInfo() {
 	m.info = nil
 	m.clearedinfo = false
+	m.conflictinfo = false
 }
 
 // Op returns the operation name.
@@ -4469,6 +4955,11 @@ func (m *GroupMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *GroupMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -4839,6 +5330,18 @@ func (m GroupInfoMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m GroupInfoMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *GroupInfoMutation) ID() (id string, exists bool) {
@@ -4958,6 +5461,11 @@ func (m *GroupInfoMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *GroupInfoMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -5169,6 +5677,9 @@ type ItemMutation struct {
 	op            Op
 	typ           string
 	id            *string
+	created_at    *time.Time
+	priority      *int
+	addpriority   *int
 	clearedFields map[string]struct{}
 }
 
@@ -5203,6 +5714,18 @@ func (m ItemMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m ItemMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *ItemMutation) ID() (id string, exists bool) {
@@ -5212,6 +5735,64 @@ func (m *ItemMutation) ID() (id string, exists bool) {
 	return *m.id, true
 }
 
+// SetCreatedAt sets the created_at field.
+func (m *ItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the "created_at" field.
+func (m *ItemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPriority sets the priority field.
+func (m *ItemMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the priority value in the mutation.
+func (m *ItemMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddPriority adds i to priority.
+func (m *ItemMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the priority field in this mutation.
+func (m *ItemMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority reset all changes of the "priority" field.
+func (m *ItemMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
 // Op returns the operation name.
 func (m *ItemMutation) Op() Op {
 	return m.op
@@ -5222,11 +5803,22 @@ func (m *ItemMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *ItemMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
 func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+	fields := make([]string, 0, 2)
+	if m.created_at != nil {
+		fields = append(fields, item.FieldCreatedAt)
+	}
+	if m.priority != nil {
+		fields = append(fields, item.FieldPriority)
+	}
 	return fields
 }
 
@@ -5235,6 +5827,10 @@ func (m *ItemMutation) Fields() []string {
 // not set, or was not define in the schema.
 func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case item.FieldCreatedAt:
+		return m.CreatedAt()
+	case item.FieldPriority:
+		return m.Priority()
 	}
 	return nil, false
 }
@@ -5244,6 +5840,20 @@ func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 // type mismatch the field type.
 func (m *ItemMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case item.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case item.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Item field %s", name)
 }
@@ -5251,13 +5861,21 @@ func (m *ItemMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
 func (m *ItemMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, item.FieldPriority)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
 func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldPriority:
+		return m.AddedPriority()
+	}
 	return nil, false
 }
 
@@ -5266,6 +5884,13 @@ func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
 // type mismatch the field type.
 func (m *ItemMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case item.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Item numeric field %s", name)
 }
@@ -5294,6 +5919,12 @@ func (m *ItemMutation) ClearField(name string) error {
 // defined in the schema.
 func (m *ItemMutation) ResetField(name string) error {
 	switch name {
+	case item.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case item.FieldPriority:
+		m.ResetPriority()
+		return nil
 	}
 	return fmt.Errorf("unknown Item field %s", name)
 }
@@ -5370,8 +6001,10 @@ type NodeMutation struct {
 	clearedFields map[string]struct{}
 	prev          *string
 	clearedprev   bool
+	conflictprev  bool
 	next          *string
 	clearednext   bool
+	conflictnext  bool
 }
 
 var _ ent.Mutation = (*NodeMutation)(nil)
@@ -5405,6 +6038,18 @@ func (m NodeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m NodeMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *NodeMutation) ID() (id string, exists bool) {
@@ -5470,11 +6115,15 @@ func (m *NodeMutation) ResetValue() {
 // SetPrevID sets the prev edge to Node by id.
 func (m *NodeMutation) SetPrevID(id string) {
 	m.prev = &id
+	m.conflictprev = false
 }
 
-// ClearPrev clears the prev edge to Node.
+// ClearPrev clears the prev edge to Node. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *NodeMutation) ClearPrev() {
 	m.clearedprev = true
+	m.conflictprev = m.prev != nil
 }
 
 // PrevCleared returns if the edge prev was cleared.
@@ -5490,6 +6139,34 @@ func (m *NodeMutation) PrevID() (id string, exists bool) {
 	return
 }
 
+// PrevIDChanged reports if the prev edge is set to a different Node, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *NodeMutation) PrevIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.prev == nil && !m.clearedprev {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: PrevIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: PrevIDChanged requires an ID field in the mutation")
+	}
+	if m.prev != nil {
+		newID = *m.prev
+	}
+	oldID, err = m.Client().Node.Query().Where(node.ID(id)).QueryPrev().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.prev != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old prev id: %v", err)
+	}
+	return oldID, newID, m.prev == nil || oldID != newID, nil
+}
+
 // PrevIDs returns the prev ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // PrevID instead. It exists only for internal usage by the builders.
@@ -5504,16 +6181,21 @@ func (m *NodeMutation) PrevIDs() (ids []string) {
 func (m *NodeMutation) ResetPrev() {
 	m.prev = nil
 	m.clearedprev = false
+	m.conflictprev = false
 }
 
 // SetNextID sets the next edge to Node by id.
 func (m *NodeMutation) SetNextID(id string) {
 	m.next = &id
+	m.conflictnext = false
 }
 
-// ClearNext clears the next edge to Node.
+// ClearNext clears the next edge to Node. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *NodeMutation) ClearNext() {
 	m.clearednext = true
+	m.conflictnext = m.next != nil
 }
 
 // NextCleared returns if the edge next was cleared.
@@ -5529,6 +6211,34 @@ func (m *NodeMutation) NextID() (id string, exists bool) {
 	return
 }
 
+// NextIDChanged reports if the next edge is set to a different Node, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *NodeMutation) NextIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.next == nil && !m.clearednext {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: NextIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: NextIDChanged requires an ID field in the mutation")
+	}
+	if m.next != nil {
+		newID = *m.next
+	}
+	oldID, err = m.Client().Node.Query().Where(node.ID(id)).QueryNext().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.next != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old next id: %v", err)
+	}
+	return oldID, newID, m.next == nil || oldID != newID, nil
+}
+
 // NextIDs returns the next ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // NextID instead. It exists only for internal usage by the builders.
@@ -5543,6 +6253,7 @@ func (m *NodeMutation) NextIDs() (ids []string) {
 func (m *NodeMutation) ResetNext() {
 	m.next = nil
 	m.clearednext = false
+	m.conflictnext = false
 }
 
 // Op returns the operation name.
@@ -5555,6 +6266,11 @@ func (m *NodeMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *NodeMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -5779,8 +6495,10 @@ type PetMutation struct {
 	clearedFields map[string]struct{}
 	team          *string
 	clearedteam   bool
+	conflictteam  bool
 	owner         *string
 	clearedowner  bool
+	conflictowner bool
 }
 
 var _ ent.Mutation = (*PetMutation)(nil)
@@ -5814,6 +6532,18 @@ func (m PetMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m PetMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *PetMutation) ID() (id string, exists bool) {
@@ -5845,11 +6575,15 @@ func (m *PetMutation) ResetName() {
 // SetTeamID sets the team edge to User by id.
 func (m *PetMutation) SetTeamID(id string) {
 	m.team = &id
+	m.conflictteam = false
 }
 
-// ClearTeam clears the team edge to User.
+// ClearTeam clears the team edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *PetMutation) ClearTeam() {
 	m.clearedteam = true
+	m.conflictteam = m.team != nil
 }
 
 // TeamCleared returns if the edge team was cleared.
@@ -5865,6 +6599,34 @@ func (m *PetMutation) TeamID() (id string, exists bool) {
 	return
 }
 
+// TeamIDChanged reports if the team edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *PetMutation) TeamIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.team == nil && !m.clearedteam {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: TeamIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: TeamIDChanged requires an ID field in the mutation")
+	}
+	if m.team != nil {
+		newID = *m.team
+	}
+	oldID, err = m.Client().Pet.Query().Where(pet.ID(id)).QueryTeam().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.team != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old team id: %v", err)
+	}
+	return oldID, newID, m.team == nil || oldID != newID, nil
+}
+
 // TeamIDs returns the team ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // TeamID instead. It exists only for internal usage by the builders.
@@ -5879,16 +6641,21 @@ func (m *PetMutation) TeamIDs() (ids []string) {
 func (m *PetMutation) ResetTeam() {
 	m.team = nil
 	m.clearedteam = false
+	m.conflictteam = false
 }
 
 // SetOwnerID sets the owner edge to User by id.
 func (m *PetMutation) SetOwnerID(id string) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to User.
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *PetMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -5904,6 +6671,34 @@ func (m *PetMutation) OwnerID() (id string, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *PetMutation) OwnerIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Pet.Query().Where(pet.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -5918,6 +6713,7 @@ func (m *PetMutation) OwnerIDs() (ids []string) {
 func (m *PetMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // Op returns the operation name.
@@ -5930,6 +6726,11 @@ func (m *PetMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *PetMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -6126,6 +6927,7 @@ type SpecMutation struct {
 	op            Op
 	typ           string
 	id            *string
+	name          *string
 	clearedFields map[string]struct{}
 	card          map[string]struct{}
 	removedcard   map[string]struct{}
@@ -6162,6 +6964,18 @@ func (m SpecMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m SpecMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *SpecMutation) ID() (id string, exists bool) {
@@ -6171,6 +6985,38 @@ func (m *SpecMutation) ID() (id string, exists bool) {
 	return *m.id, true
 }
 
+// SetName sets the name field.
+func (m *SpecMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *SpecMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearName clears the value of name.
+func (m *SpecMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[spec.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the field name was cleared in this mutation.
+func (m *SpecMutation) NameCleared() bool {
+	_, ok := m.clearedFields[spec.FieldName]
+	return ok
+}
+
+// ResetName reset all changes of the "name" field.
+func (m *SpecMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, spec.FieldName)
+}
+
 // AddCardIDs adds the card edge to Card by ids.
 func (m *SpecMutation) AddCardIDs(ids ...string) {
 	if m.card == nil {
@@ -6223,11 +7069,19 @@ func (m *SpecMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *SpecMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
 func (m *SpecMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, spec.FieldName)
+	}
 	return fields
 }
 
@@ -6236,6 +7090,8 @@ func (m *SpecMutation) Fields() []string {
 // not set, or was not define in the schema.
 func (m *SpecMutation) Field(name string) (ent.Value, bool) {
 	switch name {
+	case spec.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
@@ -6245,6 +7101,13 @@ func (m *SpecMutation) Field(name string) (ent.Value, bool) {
 // type mismatch the field type.
 func (m *SpecMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case spec.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Spec field %s", name)
 }
@@ -6274,7 +7137,11 @@ func (m *SpecMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
 func (m *SpecMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(spec.FieldName) {
+		fields = append(fields, spec.FieldName)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
@@ -6287,6 +7154,11 @@ func (m *SpecMutation) FieldCleared(name string) bool {
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *SpecMutation) ClearField(name string) error {
+	switch name {
+	case spec.FieldName:
+		m.ClearName()
+		return nil
+	}
 	return fmt.Errorf("unknown Spec nullable field %s", name)
 }
 
@@ -6295,6 +7167,9 @@ func (m *SpecMutation) ClearField(name string) error {
 // defined in the schema.
 func (m *SpecMutation) ResetField(name string) error {
 	switch name {
+	case spec.FieldName:
+		m.ResetName()
+		return nil
 	}
 	return fmt.Errorf("unknown Spec field %s", name)
 }
@@ -6403,6 +7278,7 @@ type UserMutation struct {
 	clearedFields    map[string]struct{}
 	card             *string
 	clearedcard      bool
+	conflictcard     bool
 	pets             map[string]struct{}
 	removedpets      map[string]struct{}
 	files            map[string]struct{}
@@ -6417,12 +7293,15 @@ type UserMutation struct {
 	removedfollowing map[string]struct{}
 	team             *string
 	clearedteam      bool
+	conflictteam     bool
 	spouse           *string
 	clearedspouse    bool
+	conflictspouse   bool
 	children         map[string]struct{}
 	removedchildren  map[string]struct{}
 	parent           *string
 	clearedparent    bool
+	conflictparent   bool
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -6456,6 +7335,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *UserMutation) ID() (id string, exists bool) {
@@ -6745,11 +7636,15 @@ func (m *UserMutation) ResetSSOCert() {
 // SetCardID sets the card edge to Card by id.
 func (m *UserMutation) SetCardID(id string) {
 	m.card = &id
+	m.conflictcard = false
 }
 
-// ClearCard clears the card edge to Card.
+// ClearCard clears the card edge to Card. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearCard() {
 	m.clearedcard = true
+	m.conflictcard = m.card != nil
 }
 
 // CardCleared returns if the edge card was cleared.
@@ -6765,6 +7660,34 @@ func (m *UserMutation) CardID() (id string, exists bool) {
 	return
 }
 
+// CardIDChanged reports if the card edge is set to a different Card, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) CardIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.card == nil && !m.clearedcard {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: CardIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: CardIDChanged requires an ID field in the mutation")
+	}
+	if m.card != nil {
+		newID = *m.card
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryCard().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.card != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old card id: %v", err)
+	}
+	return oldID, newID, m.card == nil || oldID != newID, nil
+}
+
 // CardIDs returns the card ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // CardID instead. It exists only for internal usage by the builders.
@@ -6779,6 +7702,7 @@ func (m *UserMutation) CardIDs() (ids []string) {
 func (m *UserMutation) ResetCard() {
 	m.card = nil
 	m.clearedcard = false
+	m.conflictcard = false
 }
 
 // AddPetIDs adds the pets edge to Pet by ids.
@@ -7036,11 +7960,15 @@ func (m *UserMutation) ResetFollowing() {
 // SetTeamID sets the team edge to Pet by id.
 func (m *UserMutation) SetTeamID(id string) {
 	m.team = &id
+	m.conflictteam = false
 }
 
-// ClearTeam clears the team edge to Pet.
+// ClearTeam clears the team edge to Pet. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearTeam() {
 	m.clearedteam = true
+	m.conflictteam = m.team != nil
 }
 
 // TeamCleared returns if the edge team was cleared.
@@ -7056,6 +7984,34 @@ func (m *UserMutation) TeamID() (id string, exists bool) {
 	return
 }
 
+// TeamIDChanged reports if the team edge is set to a different Pet, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) TeamIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.team == nil && !m.clearedteam {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: TeamIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: TeamIDChanged requires an ID field in the mutation")
+	}
+	if m.team != nil {
+		newID = *m.team
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryTeam().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.team != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old team id: %v", err)
+	}
+	return oldID, newID, m.team == nil || oldID != newID, nil
+}
+
 // TeamIDs returns the team ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // TeamID instead. It exists only for internal usage by the builders.
@@ -7070,16 +8026,21 @@ func (m *UserMutation) TeamIDs() (ids []string) {
 func (m *UserMutation) ResetTeam() {
 	m.team = nil
 	m.clearedteam = false
+	m.conflictteam = false
 }
 
 // SetSpouseID sets the spouse edge to User by id.
 func (m *UserMutation) SetSpouseID(id string) {
 	m.spouse = &id
+	m.conflictspouse = false
 }
 
-// ClearSpouse clears the spouse edge to User.
+// ClearSpouse clears the spouse edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearSpouse() {
 	m.clearedspouse = true
+	m.conflictspouse = m.spouse != nil
 }
 
 // SpouseCleared returns if the edge spouse was cleared.
@@ -7095,6 +8056,34 @@ func (m *UserMutation) SpouseID() (id string, exists bool) {
 	return
 }
 
+// SpouseIDChanged reports if the spouse edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) SpouseIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.spouse == nil && !m.clearedspouse {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: SpouseIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: SpouseIDChanged requires an ID field in the mutation")
+	}
+	if m.spouse != nil {
+		newID = *m.spouse
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QuerySpouse().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.spouse != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old spouse id: %v", err)
+	}
+	return oldID, newID, m.spouse == nil || oldID != newID, nil
+}
+
 // SpouseIDs returns the spouse ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // SpouseID instead. It exists only for internal usage by the builders.
@@ -7109,6 +8098,7 @@ func (m *UserMutation) SpouseIDs() (ids []string) {
 func (m *UserMutation) ResetSpouse() {
 	m.spouse = nil
 	m.clearedspouse = false
+	m.conflictspouse = false
 }
 
 // AddChildIDs adds the children edge to User by ids.
@@ -7156,11 +8146,15 @@ func (m *UserMutation) ResetChildren() {
 // SetParentID sets the parent edge to User by id.
 func (m *UserMutation) SetParentID(id string) {
 	m.parent = &id
+	m.conflictparent = false
 }
 
-// ClearParent clears the parent edge to User.
+// ClearParent clears the parent edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearParent() {
 	m.clearedparent = true
+	m.conflictparent = m.parent != nil
 }
 
 // ParentCleared returns if the edge parent was cleared.
@@ -7176,6 +8170,34 @@ func (m *UserMutation) ParentID() (id string, exists bool) {
 	return
 }
 
+// ParentIDChanged reports if the parent edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) ParentIDChanged(ctx context.Context) (oldID, newID string, changed bool, err error) {
+	if m.parent == nil && !m.clearedparent {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged requires an ID field in the mutation")
+	}
+	if m.parent != nil {
+		newID = *m.parent
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryParent().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.parent != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old parent id: %v", err)
+	}
+	return oldID, newID, m.parent == nil || oldID != newID, nil
+}
+
 // ParentIDs returns the parent ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // ParentID instead. It exists only for internal usage by the builders.
@@ -7190,6 +8212,7 @@ func (m *UserMutation) ParentIDs() (ids []string) {
 func (m *UserMutation) ResetParent() {
 	m.parent = nil
 	m.clearedparent = false
+	m.conflictparent = false
 }
 
 // Op returns the operation name.
@@ -7202,6 +8225,20 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
+// redactField returns the mask of the given value, if it is a value of a sensitive field.
+func (m *UserMutation) redactField(name string, v ent.Value) ent.Value {
+	switch name {
+	case user.FieldPassword:
+		return m.sensitiveMask(TypeUser, name)
+	}
+	return v
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
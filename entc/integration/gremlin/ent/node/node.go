@@ -23,3 +23,15 @@ const (
 	// NextLabel holds the string label denoting the next edge type in the database.
 	NextLabel = "node_next"
 )
+
+// Edges holds all the edge names of the Node type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgePrev,
+	EdgeNext,
+}
+
+// FingerprintFields holds the fields of the Node type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldValue,
+}
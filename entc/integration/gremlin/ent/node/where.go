@@ -239,3 +239,33 @@ func Not(p predicate.Node) predicate.Node {
 		tr.Where(__.Not(t))
 	})
 }
+
+// NodeFilter holds optional filters on the Node fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type NodeFilter struct {
+	IDIn  []string
+	Value *int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Node.Query().
+//		Where(node.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *NodeFilter) []predicate.Node {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Node
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Value != nil {
+		ps = append(ps, ValueEQ(*f.Value))
+	}
+	return ps
+}
@@ -79,6 +79,13 @@ func (nc *NodeCreate) SetNext(n *Node) *NodeCreate {
 
 // Save creates the Node in the database.
 func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
+	if err := checkTx(nc.driver); err != nil {
+		return nil, err
+	}
+	nc.defaults()
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Node
@@ -114,6 +121,35 @@ func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nc *NodeCreate) Unwrap() *NodeCreate {
+	tx, ok := nc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeCreate is not a transactional builder")
+	}
+	nc.config.driver = tx.drv
+	nc.mutation.driver = tx.drv
+	return nc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (nc *NodeCreate) DebugFields() map[string]Value {
+	return debugFields(nc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NodeCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NodeCreate) check() error {
+	return nil
+}
+
 func (nc *NodeCreate) gremlinSave(ctx context.Context) (*Node, error) {
 	res := &gremlin.Response{}
 	query, bindings := nc.gremlin().Query()
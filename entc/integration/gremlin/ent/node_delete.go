@@ -24,6 +24,7 @@ type NodeDelete struct {
 	hooks      []Hook
 	mutation   *NodeMutation
 	predicates []predicate.Node
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (nd *NodeDelete) Where(ps ...predicate.Node) *NodeDelete {
 	return nd
 }
 
+// Limit limits the number of nodes that are deleted by the builder. For example,
+// for deleting large amounts of nodes in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (nd *NodeDelete) Limit(limit int) *NodeDelete {
+	nd.limit = &limit
+	return nd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nd *NodeDelete) Unwrap() *NodeDelete {
+	tx, ok := nd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeDelete is not a transactional builder")
+	}
+	nd.config.driver = tx.drv
+	nd.mutation.driver = tx.drv
+	return nd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (nd *NodeDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(nd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (nd *NodeDelete) gremlin() *dsl.Traversal {
 	for _, p := range nd.predicates {
 		p(t)
 	}
+	if limit := nd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -41,6 +42,19 @@ func (nq *NodeQuery) Where(ps ...predicate.Node) *NodeQuery {
 	return nq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (nq *NodeQuery) Predicates() []predicate.Node {
+	return append([]predicate.Node{}, nq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (nq *NodeQuery) SetPredicates(ps ...predicate.Node) *NodeQuery {
+	nq.predicates = append([]predicate.Node{}, ps...)
+	return nq
+}
+
 // Limit adds a limit step to the query.
 func (nq *NodeQuery) Limit(limit int) *NodeQuery {
 	nq.limit = &limit
@@ -674,6 +688,27 @@ func (ns *NodeSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ns *NodeSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ns.fields) > 1 {
+		return nil, errors.New("ent: NodeSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ns.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ns *NodeSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ns.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (ns *NodeSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
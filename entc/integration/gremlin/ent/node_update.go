@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
@@ -25,6 +26,7 @@ type NodeUpdate struct {
 	hooks      []Hook
 	mutation   *NodeMutation
 	predicates []predicate.Node
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -33,6 +35,13 @@ func (nu *NodeUpdate) Where(ps ...predicate.Node) *NodeUpdate {
 	return nu
 }
 
+// Limit limits the number of nodes that are updated by the builder. For example,
+// for updating large amounts of nodes in small batches without locking the whole table.
+func (nu *NodeUpdate) Limit(limit int) *NodeUpdate {
+	nu.limit = &limit
+	return nu
+}
+
 // SetValue sets the value field.
 func (nu *NodeUpdate) SetValue(i int) *NodeUpdate {
 	nu.mutation.ResetValue()
@@ -60,6 +69,14 @@ func (nu *NodeUpdate) ClearValue() *NodeUpdate {
 	return nu
 }
 
+// UnsetValue removes the changes of the value field from the builder (e.g. a previous call
+// to SetValue), and therefore, the field is left unchanged in the database. Unlike ClearValue,
+// it does not set the field to NULL, and also removes a previous call to ClearValue.
+func (nu *NodeUpdate) UnsetValue() *NodeUpdate {
+	nu.mutation.ResetValue()
+	return nu
+}
+
 // SetPrevID sets the prev edge to Node by id.
 func (nu *NodeUpdate) SetPrevID(id string) *NodeUpdate {
 	nu.mutation.SetPrevID(id)
@@ -110,9 +127,33 @@ func (nu *NodeUpdate) ClearNext() *NodeUpdate {
 	return nu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nu *NodeUpdate) Unwrap() *NodeUpdate {
+	tx, ok := nu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeUpdate is not a transactional builder")
+	}
+	nu.config.driver = tx.drv
+	nu.mutation.driver = tx.drv
+	return nu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (nu *NodeUpdate) DebugFields() map[string]Value {
+	return debugFields(nu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(nu.driver); err != nil {
+		return 0, err
+	}
+	if err := nu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -161,6 +202,17 @@ func (nu *NodeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nu *NodeUpdate) check() error {
+	if nu.mutation.conflictprev {
+		return &ValidationError{Name: "prev", err: errors.New("ent: setting and clearing the unique edge \"prev\" in the same mutation")}
+	}
+	if nu.mutation.conflictnext {
+		return &ValidationError{Name: "next", err: errors.New("ent: setting and clearing the unique edge \"next\" in the same mutation")}
+	}
+	return nil
+}
+
 func (nu *NodeUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := nu.gremlin().Query()
@@ -183,6 +235,9 @@ func (nu *NodeUpdate) gremlin() *dsl.Traversal {
 	for _, p := range nu.predicates {
 		p(v)
 	}
+	if limit := nu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -242,8 +297,16 @@ func (nu *NodeUpdate) gremlin() *dsl.Traversal {
 // NodeUpdateOne is the builder for updating a single Node entity.
 type NodeUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *NodeMutation
+	hooks      []Hook
+	mutation   *NodeMutation
+	predicates []predicate.Node
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (nuo *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
+	nuo.predicates = append(nuo.predicates, ps...)
+	return nuo
 }
 
 // SetValue sets the value field.
@@ -273,6 +336,14 @@ func (nuo *NodeUpdateOne) ClearValue() *NodeUpdateOne {
 	return nuo
 }
 
+// UnsetValue removes the changes of the value field from the builder (e.g. a previous call
+// to SetValue), and therefore, the field is left unchanged in the database. Unlike ClearValue,
+// it does not set the field to NULL, and also removes a previous call to ClearValue.
+func (nuo *NodeUpdateOne) UnsetValue() *NodeUpdateOne {
+	nuo.mutation.ResetValue()
+	return nuo
+}
+
 // SetPrevID sets the prev edge to Node by id.
 func (nuo *NodeUpdateOne) SetPrevID(id string) *NodeUpdateOne {
 	nuo.mutation.SetPrevID(id)
@@ -323,9 +394,33 @@ func (nuo *NodeUpdateOne) ClearNext() *NodeUpdateOne {
 	return nuo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nuo *NodeUpdateOne) Unwrap() *NodeUpdateOne {
+	tx, ok := nuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeUpdateOne is not a transactional builder")
+	}
+	nuo.config.driver = tx.drv
+	nuo.mutation.driver = tx.drv
+	return nuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (nuo *NodeUpdateOne) DebugFields() map[string]Value {
+	return debugFields(nuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (nuo *NodeUpdateOne) Save(ctx context.Context) (*Node, error) {
-
+	if err := checkTx(nuo.driver); err != nil {
+		return nil, err
+	}
+	if err := nuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Node
@@ -374,6 +469,17 @@ func (nuo *NodeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NodeUpdateOne) check() error {
+	if nuo.mutation.conflictprev {
+		return &ValidationError{Name: "prev", err: errors.New("ent: setting and clearing the unique edge \"prev\" in the same mutation")}
+	}
+	if nuo.mutation.conflictnext {
+		return &ValidationError{Name: "next", err: errors.New("ent: setting and clearing the unique edge \"next\" in the same mutation")}
+	}
+	return nil
+}
+
 func (nuo *NodeUpdateOne) gremlinSave(ctx context.Context) (*Node, error) {
 	res := &gremlin.Response{}
 	id, ok := nuo.mutation.ID()
@@ -387,6 +493,15 @@ func (nuo *NodeUpdateOne) gremlinSave(ctx context.Context) (*Node, error) {
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(nuo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{node.Label}
+		}
+	}
 	n := &Node{config: nuo.config}
 	if err := n.FromResponse(res); err != nil {
 		return nil, err
@@ -401,6 +516,9 @@ func (nuo *NodeUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 2)
 	v := g.V(id)
+	for _, p := range nuo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
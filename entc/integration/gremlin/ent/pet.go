@@ -7,10 +7,15 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/pet"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/user"
 )
 
@@ -93,6 +98,121 @@ func (pe *Pet) QueryOwner() *UserQuery {
 	return (&PetClient{config: pe.config}).QueryOwner(pe)
 }
 
+// LoadEdges loads the given edges of the Pet using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see pet.Edges), and in this case, no edge is loaded.
+//
+//	err := pe.LoadEdges(ctx, client, pet.EdgeTeam, pet.EdgeOwner)
+//
+func (pe *Pet) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadPetEdges(ctx, client, []*Pet{pe}, edges)
+}
+
+// loadPetEdges loads the given edges of all the given Pet entities.
+func loadPetEdges(ctx context.Context, client *Client, nodes []*Pet, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case pet.EdgeTeam, pet.EdgeOwner:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Pet", name)
+		}
+	}
+	for _, name := range edges {
+		for _, n := range nodes {
+			switch name {
+			case pet.EdgeTeam:
+				node, err := client.Pet.QueryTeam(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Team = node
+				n.Edges.loadedTypes[0] = true
+			case pet.EdgeOwner:
+				node, err := client.Pet.QueryOwner(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Owner = node
+				n.Edges.loadedTypes[1] = true
+			}
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Pet and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Pet alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Pet itself is not modified.
+//
+//	b, err := pe.DumpGraph(ctx, client, 2, pet.EdgeTeam)
+//
+func (pe *Pet) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Pet DumpGraph", depth)
+	}
+	dumped := *pe
+	dumped.Edges = PetEdges{}
+	if err := dumpPets(ctx, client, []*Pet{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpPets loads the edges of the given Pet copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpPets(ctx context.Context, client *Client, nodes []*Pet, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Pet
+	for _, n := range nodes {
+		if dumper.visit(pet.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range pet.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadPetEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextTeam []*User
+	for _, n := range visit {
+		if e := n.Edges.Team; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Team = &c
+			nextTeam = append(nextTeam, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextTeam, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextOwner []*User
+	for _, n := range visit {
+		if e := n.Edges.Owner; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Owner = &c
+			nextOwner = append(nextOwner, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextOwner, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Pet.
 // Note that, you need to call Pet.Unwrap() before calling this method, if this Pet
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -111,6 +231,28 @@ func (pe *Pet) Unwrap() *Pet {
 	return pe
 }
 
+// Clone returns a deep copy of the Pet and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (pe *Pet) Clone() *Pet {
+	return pe.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Pet and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (pe *Pet) clone(seen map[interface{}]interface{}) *Pet {
+	if pe == nil {
+		return nil
+	}
+	if v, ok := seen[pe]; ok {
+		return v.(*Pet)
+	}
+	_c := *pe
+	seen[pe] = &_c
+	_c.Edges.Team = pe.Edges.Team.clone(seen)
+	_c.Edges.Owner = pe.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (pe *Pet) String() string {
 	var builder strings.Builder
@@ -122,6 +264,26 @@ func (pe *Pet) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Pet, for change detection and cache keys.
+// It covers the fields that are listed in pet.FingerprintFields, and it does not depend on the
+// ID of the Pet, on its edges, or on the order of the fields in the schema.
+func (pe *Pet) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", pe.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Pet for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Pet can be decoded using the FromMap method of its client.
+func (pe *Pet) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[pet.FieldID] = kvEncode(pe.ID)
+	m[pet.FieldName] = kvEncode(pe.Name)
+	return m
+}
+
 // Pets is a parsable slice of Pet.
 type Pets []*Pet
 
@@ -139,10 +301,11 @@ func (pe *Pets) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	for _, v := range scanpe {
-		*pe = append(*pe, &Pet{
+		node := &Pet{
 			ID:   v.ID,
 			Name: v.Name,
-		})
+		}
+		*pe = append(*pe, node)
 	}
 	return nil
 }
@@ -23,3 +23,15 @@ const (
 	// OwnerInverseLabel holds the string label denoting the owner inverse edge type in the database.
 	OwnerInverseLabel = "user_pets"
 )
+
+// Edges holds all the edge names of the Pet type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeTeam,
+	EdgeOwner,
+}
+
+// FingerprintFields holds the fields of the Pet type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
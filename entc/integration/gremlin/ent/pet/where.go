@@ -162,6 +162,13 @@ func NameContains(v string) predicate.Pet {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Pet {
+	return predicate.Pet(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotContaining(v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Pet {
 	return predicate.Pet(func(t *dsl.Traversal) {
@@ -169,6 +176,13 @@ func NameHasPrefix(v string) predicate.Pet {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Pet {
+	return predicate.Pet(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotStartingWith(v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Pet {
 	return predicate.Pet(func(t *dsl.Traversal) {
@@ -176,6 +190,13 @@ func NameHasSuffix(v string) predicate.Pet {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Pet {
+	return predicate.Pet(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotEndingWith(v))
+	})
+}
+
 // HasTeam applies the HasEdge predicate on the "team" edge.
 func HasTeam() predicate.Pet {
 	return predicate.Pet(func(t *dsl.Traversal) {
@@ -246,3 +267,33 @@ func Not(p predicate.Pet) predicate.Pet {
 		tr.Where(__.Not(t))
 	})
 }
+
+// PetFilter holds optional filters on the Pet fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type PetFilter struct {
+	IDIn []string
+	Name *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Pet.Query().
+//		Where(pet.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *PetFilter) []predicate.Pet {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Pet
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	return ps
+}
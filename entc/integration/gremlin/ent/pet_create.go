@@ -73,8 +73,12 @@ func (pc *PetCreate) SetOwner(u *User) *PetCreate {
 
 // Save creates the Pet in the database.
 func (pc *PetCreate) Save(ctx context.Context) (*Pet, error) {
-	if _, ok := pc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	if err := checkTx(pc.driver); err != nil {
+		return nil, err
+	}
+	pc.defaults()
+	if err := pc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -111,6 +115,38 @@ func (pc *PetCreate) SaveX(ctx context.Context) *Pet {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pc *PetCreate) Unwrap() *PetCreate {
+	tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PetCreate is not a transactional builder")
+	}
+	pc.config.driver = tx.drv
+	pc.mutation.driver = tx.drv
+	return pc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (pc *PetCreate) DebugFields() map[string]Value {
+	return debugFields(pc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PetCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PetCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
 func (pc *PetCreate) gremlinSave(ctx context.Context) (*Pet, error) {
 	res := &gremlin.Response{}
 	query, bindings := pc.gremlin().Query()
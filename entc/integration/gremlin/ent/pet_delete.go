@@ -24,6 +24,7 @@ type PetDelete struct {
 	hooks      []Hook
 	mutation   *PetMutation
 	predicates []predicate.Pet
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (pd *PetDelete) Where(ps ...predicate.Pet) *PetDelete {
 	return pd
 }
 
+// Limit limits the number of pets that are deleted by the builder. For example,
+// for deleting large amounts of pets in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (pd *PetDelete) Limit(limit int) *PetDelete {
+	pd.limit = &limit
+	return pd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pd *PetDelete) Unwrap() *PetDelete {
+	tx, ok := pd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PetDelete is not a transactional builder")
+	}
+	pd.config.driver = tx.drv
+	pd.mutation.driver = tx.drv
+	return pd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (pd *PetDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(pd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (pd *PetDelete) gremlin() *dsl.Traversal {
 	for _, p := range pd.predicates {
 		p(t)
 	}
+	if limit := pd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -42,6 +43,19 @@ func (pq *PetQuery) Where(ps ...predicate.Pet) *PetQuery {
 	return pq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (pq *PetQuery) Predicates() []predicate.Pet {
+	return append([]predicate.Pet{}, pq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (pq *PetQuery) SetPredicates(ps ...predicate.Pet) *PetQuery {
+	pq.predicates = append([]predicate.Pet{}, ps...)
+	return pq
+}
+
 // Limit adds a limit step to the query.
 func (pq *PetQuery) Limit(limit int) *PetQuery {
 	pq.limit = &limit
@@ -675,6 +689,27 @@ func (ps *PetSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ps *PetSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ps.fields) > 1 {
+		return nil, errors.New("ent: PetSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ps *PetSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ps.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (ps *PetSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
@@ -26,6 +27,7 @@ type PetUpdate struct {
 	hooks      []Hook
 	mutation   *PetMutation
 	predicates []predicate.Pet
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -34,12 +36,26 @@ func (pu *PetUpdate) Where(ps ...predicate.Pet) *PetUpdate {
 	return pu
 }
 
+// Limit limits the number of pets that are updated by the builder. For example,
+// for updating large amounts of pets in small batches without locking the whole table.
+func (pu *PetUpdate) Limit(limit int) *PetUpdate {
+	pu.limit = &limit
+	return pu
+}
+
 // SetName sets the name field.
 func (pu *PetUpdate) SetName(s string) *PetUpdate {
 	pu.mutation.SetName(s)
 	return pu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (pu *PetUpdate) UnsetName() *PetUpdate {
+	pu.mutation.ResetName()
+	return pu
+}
+
 // SetTeamID sets the team edge to User by id.
 func (pu *PetUpdate) SetTeamID(id string) *PetUpdate {
 	pu.mutation.SetTeamID(id)
@@ -90,9 +106,33 @@ func (pu *PetUpdate) ClearOwner() *PetUpdate {
 	return pu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pu *PetUpdate) Unwrap() *PetUpdate {
+	tx, ok := pu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PetUpdate is not a transactional builder")
+	}
+	pu.config.driver = tx.drv
+	pu.mutation.driver = tx.drv
+	return pu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (pu *PetUpdate) DebugFields() map[string]Value {
+	return debugFields(pu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(pu.driver); err != nil {
+		return 0, err
+	}
+	if err := pu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -141,6 +181,17 @@ func (pu *PetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (pu *PetUpdate) check() error {
+	if pu.mutation.conflictteam {
+		return &ValidationError{Name: "team", err: errors.New("ent: setting and clearing the unique edge \"team\" in the same mutation")}
+	}
+	if pu.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
 func (pu *PetUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := pu.gremlin().Query()
@@ -163,6 +214,9 @@ func (pu *PetUpdate) gremlin() *dsl.Traversal {
 	for _, p := range pu.predicates {
 		p(v)
 	}
+	if limit := pu.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -208,8 +262,16 @@ func (pu *PetUpdate) gremlin() *dsl.Traversal {
 // PetUpdateOne is the builder for updating a single Pet entity.
 type PetUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *PetMutation
+	hooks      []Hook
+	mutation   *PetMutation
+	predicates []predicate.Pet
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (puo *PetUpdateOne) Where(ps ...predicate.Pet) *PetUpdateOne {
+	puo.predicates = append(puo.predicates, ps...)
+	return puo
 }
 
 // SetName sets the name field.
@@ -218,6 +280,13 @@ func (puo *PetUpdateOne) SetName(s string) *PetUpdateOne {
 	return puo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (puo *PetUpdateOne) UnsetName() *PetUpdateOne {
+	puo.mutation.ResetName()
+	return puo
+}
+
 // SetTeamID sets the team edge to User by id.
 func (puo *PetUpdateOne) SetTeamID(id string) *PetUpdateOne {
 	puo.mutation.SetTeamID(id)
@@ -268,9 +337,33 @@ func (puo *PetUpdateOne) ClearOwner() *PetUpdateOne {
 	return puo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (puo *PetUpdateOne) Unwrap() *PetUpdateOne {
+	tx, ok := puo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PetUpdateOne is not a transactional builder")
+	}
+	puo.config.driver = tx.drv
+	puo.mutation.driver = tx.drv
+	return puo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (puo *PetUpdateOne) DebugFields() map[string]Value {
+	return debugFields(puo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
-
+	if err := checkTx(puo.driver); err != nil {
+		return nil, err
+	}
+	if err := puo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Pet
@@ -319,6 +412,17 @@ func (puo *PetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (puo *PetUpdateOne) check() error {
+	if puo.mutation.conflictteam {
+		return &ValidationError{Name: "team", err: errors.New("ent: setting and clearing the unique edge \"team\" in the same mutation")}
+	}
+	if puo.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("ent: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
 func (puo *PetUpdateOne) gremlinSave(ctx context.Context) (*Pet, error) {
 	res := &gremlin.Response{}
 	id, ok := puo.mutation.ID()
@@ -332,6 +436,15 @@ func (puo *PetUpdateOne) gremlinSave(ctx context.Context) (*Pet, error) {
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(puo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{pet.Label}
+		}
+	}
 	pe := &Pet{config: puo.config}
 	if err := pe.FromResponse(res); err != nil {
 		return nil, err
@@ -346,6 +459,9 @@ func (puo *PetUpdateOne) gremlin(id string) *dsl.Traversal {
 	}
 	constraints := make([]*constraint, 0, 1)
 	v := g.V(id)
+	for _, p := range puo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
@@ -44,6 +44,10 @@ func init() {
 	cardDescName := cardFields[1].Descriptor()
 	// card.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	card.NameValidator = cardDescName.Validators[0].(func(string) error)
+	// cardDescExpiresAt is the schema descriptor for expires_at field.
+	cardDescExpiresAt := cardFields[2].Descriptor()
+	// card.ExpiresAtLocation holds the location of the time values of the expires_at field.
+	card.ExpiresAtLocation = cardDescExpiresAt.Location
 	fieldtypeFields := schema.FieldType{}.Fields()
 	_ = fieldtypeFields
 	// fieldtypeDescValidateOptionalInt32 is the schema descriptor for validate_optional_int32 field.
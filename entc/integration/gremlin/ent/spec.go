@@ -7,17 +7,27 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/card"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/spec"
 )
 
 // Spec is the model entity for the Spec schema.
 type Spec struct {
-	config
+	config `json:"-"`
 	// ID of the ent.
 	ID string `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SpecQuery when eager-loading is set.
 	Edges SpecEdges `json:"edges"`
@@ -48,12 +58,14 @@ func (s *Spec) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var scans struct {
-		ID string `json:"id,omitempty"`
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
 	}
 	if err := vmap.Decode(&scans); err != nil {
 		return err
 	}
 	s.ID = scans.ID
+	s.Name = scans.Name
 	return nil
 }
 
@@ -62,6 +74,201 @@ func (s *Spec) QueryCard() *CardQuery {
 	return (&SpecClient{config: s.config}).QueryCard(s)
 }
 
+// LoadEdges loads the given edges of the Spec using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see spec.Edges), and in this case, no edge is loaded.
+//
+//	err := s.LoadEdges(ctx, client, spec.EdgeCard)
+//
+func (s *Spec) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadSpecEdges(ctx, client, []*Spec{s}, edges)
+}
+
+// loadSpecEdges loads the given edges of all the given Spec entities.
+func loadSpecEdges(ctx context.Context, client *Client, nodes []*Spec, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case spec.EdgeCard:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Spec", name)
+		}
+	}
+	for _, name := range edges {
+		for _, n := range nodes {
+			switch name {
+			case spec.EdgeCard:
+				loaded, err := client.Spec.QueryCard(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Card = loaded
+				n.Edges.loadedTypes[0] = true
+			}
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Spec and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Spec alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Spec itself is not modified.
+//
+//	b, err := s.DumpGraph(ctx, client, 2, spec.EdgeCard)
+//
+func (s *Spec) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Spec DumpGraph", depth)
+	}
+	dumped := *s
+	dumped.Edges = SpecEdges{}
+	if err := dumpSpecs(ctx, client, []*Spec{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpSpecs loads the edges of the given Spec copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpSpecs(ctx context.Context, client *Client, nodes []*Spec, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Spec
+	for _, n := range nodes {
+		if dumper.visit(spec.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range spec.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadSpecEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextCard []*Card
+	for _, n := range visit {
+		for i, e := range n.Edges.Card {
+			c := *e
+			c.Edges = CardEdges{}
+			n.Edges.Card[i] = &c
+			nextCard = append(nextCard, &c)
+		}
+	}
+	if err := dumpCards(ctx, client, nextCard, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddCardByField adds the "card" edges of the Spec to the Card entities that are resolved by the
+// given values of one of their unique fields (e.g. card.FieldNumberHash). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := s.AddCardByField(ctx, client, card.FieldNumberHash, v1, v2)
+//
+func (s *Spec) AddCardByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := s.addCardByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddCardByFieldOrCreate is like AddCardByField, but creates the Card entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (s *Spec) AddCardByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*Card, error) {
+	return s.addCardByField(ctx, client, true, field, values)
+}
+
+func (s *Spec) addCardByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*Card, error) {
+	var (
+		where predicate.Card
+		key   func(*Card) interface{}
+	)
+	switch field {
+	case card.FieldNumberHash:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field number_hash", v)
+			}
+			vs[i] = tv
+		}
+		where = card.NumberHashIn(vs...)
+		key = func(n *Card) interface{} {
+			return n.NumberHash
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type Card", field)
+	}
+	nodes, err := client.Card.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*CardCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{card.Label}
+		}
+		builder := client.Card.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*Card
+	for _, builder := range builders {
+		n, err := builder.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, n)
+	}
+	ids := make([]string, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.Spec.QueryCard(s).Where(card.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[string]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.Spec.UpdateOneID(s.ID).AddCardIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
 // Update returns a builder for updating this Spec.
 // Note that, you need to call Spec.Unwrap() before calling this method, if this Spec
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -80,15 +287,65 @@ func (s *Spec) Unwrap() *Spec {
 	return s
 }
 
+// Clone returns a deep copy of the Spec and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (s *Spec) Clone() *Spec {
+	return s.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Spec and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (s *Spec) clone(seen map[interface{}]interface{}) *Spec {
+	if s == nil {
+		return nil
+	}
+	if v, ok := seen[s]; ok {
+		return v.(*Spec)
+	}
+	_c := *s
+	seen[s] = &_c
+	if s.Edges.Card != nil {
+		_c.Edges.Card = make([]*Card, len(s.Edges.Card))
+		for _i := range s.Edges.Card {
+			_c.Edges.Card[_i] = s.Edges.Card[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (s *Spec) String() string {
 	var builder strings.Builder
 	builder.WriteString("Spec(")
 	builder.WriteString(fmt.Sprintf("id=%v", s.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(s.Name)
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Spec, for change detection and cache keys.
+// It covers the fields that are listed in spec.FingerprintFields, and it does not depend on the
+// ID of the Spec, on its edges, or on the order of the fields in the schema.
+func (s *Spec) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", s.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Spec for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Spec can be decoded using the FromMap method of its client.
+func (s *Spec) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[spec.FieldID] = kvEncode(s.ID)
+	if v := s.Name; !reflect.ValueOf(v).IsZero() {
+		m[spec.FieldName] = kvEncode(v)
+	}
+	return m
+}
+
 // Specs is a parsable slice of Spec.
 type Specs []*Spec
 
@@ -99,15 +356,18 @@ func (s *Specs) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	var scans []struct {
-		ID string `json:"id,omitempty"`
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
 	}
 	if err := vmap.Decode(&scans); err != nil {
 		return err
 	}
 	for _, v := range scans {
-		*s = append(*s, &Spec{
-			ID: v.ID,
-		})
+		node := &Spec{
+			ID:   v.ID,
+			Name: v.Name,
+		}
+		*s = append(*s, node)
 	}
 	return nil
 }
@@ -10,7 +10,8 @@ const (
 	// Label holds the string label denoting the spec type in the database.
 	Label = "spec"
 	// FieldID holds the string denoting the id field in the database.
-	FieldID = "id"
+	FieldID   = "id" // FieldName holds the string denoting the name vertex property in the database.
+	FieldName = "name"
 
 	// EdgeCard holds the string denoting the card edge name in mutations.
 	EdgeCard = "card"
@@ -18,3 +19,14 @@ const (
 	// CardLabel holds the string label denoting the card edge type in the database.
 	CardLabel = "spec_card"
 )
+
+// Edges holds all the edge names of the Spec type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeCard,
+}
+
+// FingerprintFields holds the fields of the Spec type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
@@ -84,6 +84,133 @@ func IDLTE(id string) predicate.Spec {
 	})
 }
 
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.EQ(v))
+	})
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.EQ(v))
+	})
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NEQ(v))
+	})
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Spec {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.Within(v...))
+	})
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Spec {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.Without(v...))
+	})
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.GT(v))
+	})
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.GTE(v))
+	})
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.LT(v))
+	})
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.LTE(v))
+	})
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.Containing(v))
+	})
+}
+
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotContaining(v))
+	})
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.StartingWith(v))
+	})
+}
+
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotStartingWith(v))
+	})
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.EndingWith(v))
+	})
+}
+
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotEndingWith(v))
+	})
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.HasLabel(Label).HasNot(FieldName)
+	})
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.Spec {
+	return predicate.Spec(func(t *dsl.Traversal) {
+		t.HasLabel(Label).Has(FieldName)
+	})
+}
+
 // HasCard applies the HasEdge predicate on the "card" edge.
 func HasCard() predicate.Spec {
 	return predicate.Spec(func(t *dsl.Traversal) {
@@ -136,3 +263,33 @@ func Not(p predicate.Spec) predicate.Spec {
 		tr.Where(__.Not(t))
 	})
 }
+
+// SpecFilter holds optional filters on the Spec fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type SpecFilter struct {
+	IDIn []string
+	Name *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Spec.Query().
+//		Where(spec.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *SpecFilter) []predicate.Spec {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Spec
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	return ps
+}
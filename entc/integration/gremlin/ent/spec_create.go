@@ -12,7 +12,9 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/spec"
 )
 
@@ -23,6 +25,20 @@ type SpecCreate struct {
 	hooks    []Hook
 }
 
+// SetName sets the name field.
+func (sc *SpecCreate) SetName(s string) *SpecCreate {
+	sc.mutation.SetName(s)
+	return sc
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (sc *SpecCreate) SetNillableName(s *string) *SpecCreate {
+	if s != nil {
+		sc.SetName(*s)
+	}
+	return sc
+}
+
 // AddCardIDs adds the card edge to Card by ids.
 func (sc *SpecCreate) AddCardIDs(ids ...string) *SpecCreate {
 	sc.mutation.AddCardIDs(ids...)
@@ -40,6 +56,13 @@ func (sc *SpecCreate) AddCard(c ...*Card) *SpecCreate {
 
 // Save creates the Spec in the database.
 func (sc *SpecCreate) Save(ctx context.Context) (*Spec, error) {
+	if err := checkTx(sc.driver); err != nil {
+		return nil, err
+	}
+	sc.defaults()
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Spec
@@ -75,6 +98,35 @@ func (sc *SpecCreate) SaveX(ctx context.Context) *Spec {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (sc *SpecCreate) Unwrap() *SpecCreate {
+	tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecCreate is not a transactional builder")
+	}
+	sc.config.driver = tx.drv
+	sc.mutation.driver = tx.drv
+	return sc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (sc *SpecCreate) DebugFields() map[string]Value {
+	return debugFields(sc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SpecCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SpecCreate) check() error {
+	return nil
+}
+
 func (sc *SpecCreate) gremlinSave(ctx context.Context) (*Spec, error) {
 	res := &gremlin.Response{}
 	query, bindings := sc.gremlin().Query()
@@ -92,9 +144,28 @@ func (sc *SpecCreate) gremlinSave(ctx context.Context) (*Spec, error) {
 }
 
 func (sc *SpecCreate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
 	v := g.AddV(spec.Label)
+	if value, ok := sc.mutation.Name(); ok {
+		constraints = append(constraints, &constraint{
+			pred: g.V().Has(spec.Label, spec.FieldName, value).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueField(spec.Label, spec.FieldName, value)),
+		})
+		v.Property(dsl.Single, spec.FieldName, value)
+	}
 	for _, id := range sc.mutation.CardIDs() {
 		v.AddE(spec.CardLabel).To(g.V(id)).OutV()
 	}
-	return v.ValueMap(true)
+	if len(constraints) == 0 {
+		return v.ValueMap(true)
+	}
+	tr := constraints[0].pred.Coalesce(constraints[0].test, v.ValueMap(true))
+	for _, cr := range constraints[1:] {
+		tr = cr.pred.Coalesce(cr.test, tr)
+	}
+	return tr
 }
@@ -24,6 +24,7 @@ type SpecDelete struct {
 	hooks      []Hook
 	mutation   *SpecMutation
 	predicates []predicate.Spec
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (sd *SpecDelete) Where(ps ...predicate.Spec) *SpecDelete {
 	return sd
 }
 
+// Limit limits the number of specs that are deleted by the builder. For example,
+// for deleting large amounts of specs in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (sd *SpecDelete) Limit(limit int) *SpecDelete {
+	sd.limit = &limit
+	return sd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (sd *SpecDelete) Unwrap() *SpecDelete {
+	tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecDelete is not a transactional builder")
+	}
+	sd.config.driver = tx.drv
+	sd.mutation.driver = tx.drv
+	return sd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (sd *SpecDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(sd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (sd *SpecDelete) gremlin() *dsl.Traversal {
 	for _, p := range sd.predicates {
 		p(t)
 	}
+	if limit := sd.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
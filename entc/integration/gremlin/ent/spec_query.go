@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -40,6 +41,19 @@ func (sq *SpecQuery) Where(ps ...predicate.Spec) *SpecQuery {
 	return sq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (sq *SpecQuery) Predicates() []predicate.Spec {
+	return append([]predicate.Spec{}, sq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (sq *SpecQuery) SetPredicates(ps ...predicate.Spec) *SpecQuery {
+	sq.predicates = append([]predicate.Spec{}, ps...)
+	return sq
+}
+
 // Limit adds a limit step to the query.
 func (sq *SpecQuery) Limit(limit int) *SpecQuery {
 	sq.limit = &limit
@@ -264,6 +278,19 @@ func (sq *SpecQuery) WithCard(opts ...func(*CardQuery)) *SpecQuery {
 
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Spec.Query().
+//		GroupBy(spec.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
 func (sq *SpecQuery) GroupBy(field string, fields ...string) *SpecGroupBy {
 	group := &SpecGroupBy{config: sq.config}
 	group.fields = append([]string{field}, fields...)
@@ -277,6 +304,17 @@ func (sq *SpecQuery) GroupBy(field string, fields ...string) *SpecGroupBy {
 }
 
 // Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Spec.Query().
+//		Select(spec.FieldName).
+//		Scan(ctx, &v)
+//
 func (sq *SpecQuery) Select(field string, fields ...string) *SpecSelect {
 	selector := &SpecSelect{config: sq.config}
 	selector.fields = append([]string{field}, fields...)
@@ -624,6 +662,27 @@ func (ss *SpecSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ss *SpecSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ss.fields) > 1 {
+		return nil, errors.New("ent: SpecSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ss.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ss *SpecSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ss.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (ss *SpecSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
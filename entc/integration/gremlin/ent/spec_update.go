@@ -14,6 +14,7 @@ import (
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/__"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/g"
+	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl/p"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/spec"
 )
@@ -24,6 +25,7 @@ type SpecUpdate struct {
 	hooks      []Hook
 	mutation   *SpecMutation
 	predicates []predicate.Spec
+	limit      *int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,6 +34,41 @@ func (su *SpecUpdate) Where(ps ...predicate.Spec) *SpecUpdate {
 	return su
 }
 
+// Limit limits the number of specs that are updated by the builder. For example,
+// for updating large amounts of specs in small batches without locking the whole table.
+func (su *SpecUpdate) Limit(limit int) *SpecUpdate {
+	su.limit = &limit
+	return su
+}
+
+// SetName sets the name field.
+func (su *SpecUpdate) SetName(s string) *SpecUpdate {
+	su.mutation.SetName(s)
+	return su
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (su *SpecUpdate) SetNillableName(s *string) *SpecUpdate {
+	if s != nil {
+		su.SetName(*s)
+	}
+	return su
+}
+
+// ClearName clears the value of name.
+func (su *SpecUpdate) ClearName() *SpecUpdate {
+	su.mutation.ClearName()
+	return su
+}
+
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (su *SpecUpdate) UnsetName() *SpecUpdate {
+	su.mutation.ResetName()
+	return su
+}
+
 // AddCardIDs adds the card edge to Card by ids.
 func (su *SpecUpdate) AddCardIDs(ids ...string) *SpecUpdate {
 	su.mutation.AddCardIDs(ids...)
@@ -62,9 +99,33 @@ func (su *SpecUpdate) RemoveCard(c ...*Card) *SpecUpdate {
 	return su.RemoveCardIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (su *SpecUpdate) Unwrap() *SpecUpdate {
+	tx, ok := su.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecUpdate is not a transactional builder")
+	}
+	su.config.driver = tx.drv
+	su.mutation.driver = tx.drv
+	return su
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (su *SpecUpdate) DebugFields() map[string]Value {
+	return debugFields(su.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (su *SpecUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(su.driver); err != nil {
+		return 0, err
+	}
+	if err := su.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -113,6 +174,11 @@ func (su *SpecUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (su *SpecUpdate) check() error {
+	return nil
+}
+
 func (su *SpecUpdate) gremlinSave(ctx context.Context) (int, error) {
 	res := &gremlin.Response{}
 	query, bindings := su.gremlin().Query()
@@ -126,16 +192,38 @@ func (su *SpecUpdate) gremlinSave(ctx context.Context) (int, error) {
 }
 
 func (su *SpecUpdate) gremlin() *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
 	v := g.V().HasLabel(spec.Label)
 	for _, p := range su.predicates {
 		p(v)
 	}
+	if limit := su.limit; limit != nil {
+		v.Limit(*limit)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
 
 		trs []*dsl.Traversal
 	)
+	if value, ok := su.mutation.Name(); ok {
+		constraints = append(constraints, &constraint{
+			pred: g.V().Has(spec.Label, spec.FieldName, value).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueField(spec.Label, spec.FieldName, value)),
+		})
+		v.Property(dsl.Single, spec.FieldName, value)
+	}
+	var properties []interface{}
+	if su.mutation.NameCleared() {
+		properties = append(properties, spec.FieldName)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
 	for _, id := range su.mutation.RemovedCardIDs() {
 		tr := rv.Clone().OutE(spec.CardLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -144,6 +232,16 @@ func (su *SpecUpdate) gremlin() *dsl.Traversal {
 		v.AddE(spec.CardLabel).To(g.V(id)).OutV()
 	}
 	v.Count()
+	if len(constraints) > 0 {
+		constraints = append(constraints, &constraint{
+			pred: rv.Count(),
+			test: __.Is(p.GT(1)).Constant(&ConstraintError{msg: "update traversal contains more than one vertex"}),
+		})
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
@@ -151,8 +249,44 @@ func (su *SpecUpdate) gremlin() *dsl.Traversal {
 // SpecUpdateOne is the builder for updating a single Spec entity.
 type SpecUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *SpecMutation
+	hooks      []Hook
+	mutation   *SpecMutation
+	predicates []predicate.Spec
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (suo *SpecUpdateOne) Where(ps ...predicate.Spec) *SpecUpdateOne {
+	suo.predicates = append(suo.predicates, ps...)
+	return suo
+}
+
+// SetName sets the name field.
+func (suo *SpecUpdateOne) SetName(s string) *SpecUpdateOne {
+	suo.mutation.SetName(s)
+	return suo
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (suo *SpecUpdateOne) SetNillableName(s *string) *SpecUpdateOne {
+	if s != nil {
+		suo.SetName(*s)
+	}
+	return suo
+}
+
+// ClearName clears the value of name.
+func (suo *SpecUpdateOne) ClearName() *SpecUpdateOne {
+	suo.mutation.ClearName()
+	return suo
+}
+
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database. Unlike ClearName,
+// it does not set the field to NULL, and also removes a previous call to ClearName.
+func (suo *SpecUpdateOne) UnsetName() *SpecUpdateOne {
+	suo.mutation.ResetName()
+	return suo
 }
 
 // AddCardIDs adds the card edge to Card by ids.
@@ -185,9 +319,33 @@ func (suo *SpecUpdateOne) RemoveCard(c ...*Card) *SpecUpdateOne {
 	return suo.RemoveCardIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (suo *SpecUpdateOne) Unwrap() *SpecUpdateOne {
+	tx, ok := suo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpecUpdateOne is not a transactional builder")
+	}
+	suo.config.driver = tx.drv
+	suo.mutation.driver = tx.drv
+	return suo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (suo *SpecUpdateOne) DebugFields() map[string]Value {
+	return debugFields(suo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (suo *SpecUpdateOne) Save(ctx context.Context) (*Spec, error) {
-
+	if err := checkTx(suo.driver); err != nil {
+		return nil, err
+	}
+	if err := suo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Spec
@@ -236,6 +394,11 @@ func (suo *SpecUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (suo *SpecUpdateOne) check() error {
+	return nil
+}
+
 func (suo *SpecUpdateOne) gremlinSave(ctx context.Context) (*Spec, error) {
 	res := &gremlin.Response{}
 	id, ok := suo.mutation.ID()
@@ -249,6 +412,15 @@ func (suo *SpecUpdateOne) gremlinSave(ctx context.Context) (*Spec, error) {
 	if err, ok := isConstantError(res); ok {
 		return nil, err
 	}
+	if len(suo.predicates) > 0 {
+		vmap, err := res.ReadValueMap()
+		if err != nil {
+			return nil, err
+		}
+		if len(vmap) == 0 {
+			return nil, &NotFoundError{spec.Label}
+		}
+	}
 	s := &Spec{config: suo.config}
 	if err := s.FromResponse(res); err != nil {
 		return nil, err
@@ -257,13 +429,35 @@ func (suo *SpecUpdateOne) gremlinSave(ctx context.Context) (*Spec, error) {
 }
 
 func (suo *SpecUpdateOne) gremlin(id string) *dsl.Traversal {
+	type constraint struct {
+		pred *dsl.Traversal // constraint predicate.
+		test *dsl.Traversal // test matches and its constant.
+	}
+	constraints := make([]*constraint, 0, 1)
 	v := g.V(id)
+	for _, p := range suo.predicates {
+		p(v)
+	}
 	var (
 		rv = v.Clone()
 		_  = rv
 
 		trs []*dsl.Traversal
 	)
+	if value, ok := suo.mutation.Name(); ok {
+		constraints = append(constraints, &constraint{
+			pred: g.V().Has(spec.Label, spec.FieldName, value).Count(),
+			test: __.Is(p.NEQ(0)).Constant(NewErrUniqueField(spec.Label, spec.FieldName, value)),
+		})
+		v.Property(dsl.Single, spec.FieldName, value)
+	}
+	var properties []interface{}
+	if suo.mutation.NameCleared() {
+		properties = append(properties, spec.FieldName)
+	}
+	if len(properties) > 0 {
+		v.SideEffect(__.Properties(properties...).Drop())
+	}
 	for _, id := range suo.mutation.RemovedCardIDs() {
 		tr := rv.Clone().OutE(spec.CardLabel).Where(__.OtherV().HasID(id)).Drop().Iterate()
 		trs = append(trs, tr)
@@ -272,6 +466,12 @@ func (suo *SpecUpdateOne) gremlin(id string) *dsl.Traversal {
 		v.AddE(spec.CardLabel).To(g.V(id)).OutV()
 	}
 	v.ValueMap(true)
+	if len(constraints) > 0 {
+		v = constraints[0].pred.Coalesce(constraints[0].test, v)
+		for _, cr := range constraints[1:] {
+			v = cr.pred.Coalesce(cr.test, v)
+		}
+	}
 	trs = append(trs, v)
 	return dsl.Join(trs...)
 }
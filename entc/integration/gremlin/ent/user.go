@@ -7,12 +7,18 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/predicate"
 	"github.com/facebookincubator/ent/entc/integration/gremlin/ent/user"
 )
 
@@ -281,6 +287,628 @@ func (u *User) QueryParent() *UserQuery {
 	return (&UserClient{config: u.config}).QueryParent(u)
 }
 
+// LoadEdges loads the given edges of the User using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see user.Edges), and in this case, no edge is loaded.
+//
+//	err := u.LoadEdges(ctx, client, user.EdgeCard, user.EdgePets)
+//
+func (u *User) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadUserEdges(ctx, client, []*User{u}, edges)
+}
+
+// loadUserEdges loads the given edges of all the given User entities.
+func loadUserEdges(ctx context.Context, client *Client, nodes []*User, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case user.EdgeCard, user.EdgePets, user.EdgeFiles, user.EdgeGroups, user.EdgeFriends, user.EdgeFollowers, user.EdgeFollowing, user.EdgeTeam, user.EdgeSpouse, user.EdgeChildren, user.EdgeParent:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type User", name)
+		}
+	}
+	for _, name := range edges {
+		for _, n := range nodes {
+			switch name {
+			case user.EdgeCard:
+				node, err := client.User.QueryCard(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Card = node
+				n.Edges.loadedTypes[0] = true
+			case user.EdgePets:
+				loaded, err := client.User.QueryPets(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Pets = loaded
+				n.Edges.loadedTypes[1] = true
+			case user.EdgeFiles:
+				loaded, err := client.User.QueryFiles(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Files = loaded
+				n.Edges.loadedTypes[2] = true
+			case user.EdgeGroups:
+				loaded, err := client.User.QueryGroups(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Groups = loaded
+				n.Edges.loadedTypes[3] = true
+			case user.EdgeFriends:
+				loaded, err := client.User.QueryFriends(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Friends = loaded
+				n.Edges.loadedTypes[4] = true
+			case user.EdgeFollowers:
+				loaded, err := client.User.QueryFollowers(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Followers = loaded
+				n.Edges.loadedTypes[5] = true
+			case user.EdgeFollowing:
+				loaded, err := client.User.QueryFollowing(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Following = loaded
+				n.Edges.loadedTypes[6] = true
+			case user.EdgeTeam:
+				node, err := client.User.QueryTeam(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Team = node
+				n.Edges.loadedTypes[7] = true
+			case user.EdgeSpouse:
+				node, err := client.User.QuerySpouse(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Spouse = node
+				n.Edges.loadedTypes[8] = true
+			case user.EdgeChildren:
+				loaded, err := client.User.QueryChildren(n).All(ctx)
+				if err != nil {
+					return err
+				}
+				n.Edges.Children = loaded
+				n.Edges.loadedTypes[9] = true
+			case user.EdgeParent:
+				node, err := client.User.QueryParent(n).Only(ctx)
+				if err != nil && !IsNotFound(err) {
+					return err
+				}
+				n.Edges.Parent = node
+				n.Edges.loadedTypes[10] = true
+			}
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the User and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the User alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The User itself is not modified.
+//
+//	b, err := u.DumpGraph(ctx, client, 2, user.EdgeCard)
+//
+func (u *User) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for User DumpGraph", depth)
+	}
+	dumped := *u
+	dumped.Edges = UserEdges{}
+	if err := dumpUsers(ctx, client, []*User{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpUsers loads the edges of the given User copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpUsers(ctx context.Context, client *Client, nodes []*User, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*User
+	for _, n := range nodes {
+		if dumper.visit(user.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range user.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadUserEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextCard []*Card
+	for _, n := range visit {
+		if e := n.Edges.Card; e != nil {
+			c := *e
+			c.Edges = CardEdges{}
+			n.Edges.Card = &c
+			nextCard = append(nextCard, &c)
+		}
+	}
+	if err := dumpCards(ctx, client, nextCard, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextPets []*Pet
+	for _, n := range visit {
+		for i, e := range n.Edges.Pets {
+			c := *e
+			c.Edges = PetEdges{}
+			n.Edges.Pets[i] = &c
+			nextPets = append(nextPets, &c)
+		}
+	}
+	if err := dumpPets(ctx, client, nextPets, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFiles []*File
+	for _, n := range visit {
+		for i, e := range n.Edges.Files {
+			c := *e
+			c.Edges = FileEdges{}
+			n.Edges.Files[i] = &c
+			nextFiles = append(nextFiles, &c)
+		}
+	}
+	if err := dumpFiles(ctx, client, nextFiles, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextGroups []*Group
+	for _, n := range visit {
+		for i, e := range n.Edges.Groups {
+			c := *e
+			c.Edges = GroupEdges{}
+			n.Edges.Groups[i] = &c
+			nextGroups = append(nextGroups, &c)
+		}
+	}
+	if err := dumpGroups(ctx, client, nextGroups, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFriends []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Friends {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Friends[i] = &c
+			nextFriends = append(nextFriends, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextFriends, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFollowers []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Followers {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Followers[i] = &c
+			nextFollowers = append(nextFollowers, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextFollowers, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextFollowing []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Following {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Following[i] = &c
+			nextFollowing = append(nextFollowing, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextFollowing, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextTeam []*Pet
+	for _, n := range visit {
+		if e := n.Edges.Team; e != nil {
+			c := *e
+			c.Edges = PetEdges{}
+			n.Edges.Team = &c
+			nextTeam = append(nextTeam, &c)
+		}
+	}
+	if err := dumpPets(ctx, client, nextTeam, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextSpouse []*User
+	for _, n := range visit {
+		if e := n.Edges.Spouse; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Spouse = &c
+			nextSpouse = append(nextSpouse, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextSpouse, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextChildren []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Children {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Children[i] = &c
+			nextChildren = append(nextChildren, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextChildren, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextParent []*User
+	for _, n := range visit {
+		if e := n.Edges.Parent; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Parent = &c
+			nextParent = append(nextParent, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextParent, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddFriendsByField adds the "friends" edges of the User to the User entities that are resolved by the
+// given values of one of their unique fields (e.g. user.FieldNickname). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := u.AddFriendsByField(ctx, client, user.FieldNickname, v1, v2)
+//
+func (u *User) AddFriendsByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := u.addFriendsByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddFriendsByFieldOrCreate is like AddFriendsByField, but creates the User entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (u *User) AddFriendsByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*User, error) {
+	return u.addFriendsByField(ctx, client, true, field, values)
+}
+
+func (u *User) addFriendsByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*User, error) {
+	var (
+		where predicate.User
+		key   func(*User) interface{}
+	)
+	switch field {
+	case user.FieldNickname:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field nickname", v)
+			}
+			vs[i] = tv
+		}
+		where = user.NicknameIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Nickname
+		}
+	case user.FieldPhone:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field phone", v)
+			}
+			vs[i] = tv
+		}
+		where = user.PhoneIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Phone
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type User", field)
+	}
+	nodes, err := client.User.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*UserCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{user.Label}
+		}
+		builder := client.User.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*User
+	for _, builder := range builders {
+		n, err := builder.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, n)
+	}
+	ids := make([]string, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.User.QueryFriends(u).Where(user.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[string]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.User.UpdateOneID(u.ID).AddFriendIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// AddFollowersByField adds the "followers" edges of the User to the User entities that are resolved by the
+// given values of one of their unique fields (e.g. user.FieldNickname). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := u.AddFollowersByField(ctx, client, user.FieldNickname, v1, v2)
+//
+func (u *User) AddFollowersByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := u.addFollowersByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddFollowersByFieldOrCreate is like AddFollowersByField, but creates the User entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (u *User) AddFollowersByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*User, error) {
+	return u.addFollowersByField(ctx, client, true, field, values)
+}
+
+func (u *User) addFollowersByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*User, error) {
+	var (
+		where predicate.User
+		key   func(*User) interface{}
+	)
+	switch field {
+	case user.FieldNickname:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field nickname", v)
+			}
+			vs[i] = tv
+		}
+		where = user.NicknameIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Nickname
+		}
+	case user.FieldPhone:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field phone", v)
+			}
+			vs[i] = tv
+		}
+		where = user.PhoneIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Phone
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type User", field)
+	}
+	nodes, err := client.User.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*UserCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{user.Label}
+		}
+		builder := client.User.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*User
+	for _, builder := range builders {
+		n, err := builder.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, n)
+	}
+	ids := make([]string, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.User.QueryFollowers(u).Where(user.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[string]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.User.UpdateOneID(u.ID).AddFollowerIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+// AddFollowingByField adds the "following" edges of the User to the User entities that are resolved by the
+// given values of one of their unique fields (e.g. user.FieldNickname). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := u.AddFollowingByField(ctx, client, user.FieldNickname, v1, v2)
+//
+func (u *User) AddFollowingByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := u.addFollowingByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddFollowingByFieldOrCreate is like AddFollowingByField, but creates the User entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (u *User) AddFollowingByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*User, error) {
+	return u.addFollowingByField(ctx, client, true, field, values)
+}
+
+func (u *User) addFollowingByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*User, error) {
+	var (
+		where predicate.User
+		key   func(*User) interface{}
+	)
+	switch field {
+	case user.FieldNickname:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field nickname", v)
+			}
+			vs[i] = tv
+		}
+		where = user.NicknameIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Nickname
+		}
+	case user.FieldPhone:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field phone", v)
+			}
+			vs[i] = tv
+		}
+		where = user.PhoneIn(vs...)
+		key = func(n *User) interface{} {
+			return n.Phone
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type User", field)
+	}
+	nodes, err := client.User.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*UserCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{user.Label}
+		}
+		builder := client.User.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*User
+	for _, builder := range builders {
+		n, err := builder.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		created = append(created, n)
+	}
+	ids := make([]string, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.User.QueryFollowing(u).Where(user.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[string]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.User.UpdateOneID(u.ID).AddFollowingIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -299,6 +927,72 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the User and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if v, ok := seen[u]; ok {
+		return v.(*User)
+	}
+	_c := *u
+	seen[u] = &_c
+	_c.Edges.Card = u.Edges.Card.clone(seen)
+	if u.Edges.Pets != nil {
+		_c.Edges.Pets = make([]*Pet, len(u.Edges.Pets))
+		for _i := range u.Edges.Pets {
+			_c.Edges.Pets[_i] = u.Edges.Pets[_i].clone(seen)
+		}
+	}
+	if u.Edges.Files != nil {
+		_c.Edges.Files = make([]*File, len(u.Edges.Files))
+		for _i := range u.Edges.Files {
+			_c.Edges.Files[_i] = u.Edges.Files[_i].clone(seen)
+		}
+	}
+	if u.Edges.Groups != nil {
+		_c.Edges.Groups = make([]*Group, len(u.Edges.Groups))
+		for _i := range u.Edges.Groups {
+			_c.Edges.Groups[_i] = u.Edges.Groups[_i].clone(seen)
+		}
+	}
+	if u.Edges.Friends != nil {
+		_c.Edges.Friends = make([]*User, len(u.Edges.Friends))
+		for _i := range u.Edges.Friends {
+			_c.Edges.Friends[_i] = u.Edges.Friends[_i].clone(seen)
+		}
+	}
+	if u.Edges.Followers != nil {
+		_c.Edges.Followers = make([]*User, len(u.Edges.Followers))
+		for _i := range u.Edges.Followers {
+			_c.Edges.Followers[_i] = u.Edges.Followers[_i].clone(seen)
+		}
+	}
+	if u.Edges.Following != nil {
+		_c.Edges.Following = make([]*User, len(u.Edges.Following))
+		for _i := range u.Edges.Following {
+			_c.Edges.Following[_i] = u.Edges.Following[_i].clone(seen)
+		}
+	}
+	_c.Edges.Team = u.Edges.Team.clone(seen)
+	_c.Edges.Spouse = u.Edges.Spouse.clone(seen)
+	if u.Edges.Children != nil {
+		_c.Edges.Children = make([]*User, len(u.Edges.Children))
+		for _i := range u.Edges.Children {
+			_c.Edges.Children[_i] = u.Edges.Children[_i].clone(seen)
+		}
+	}
+	_c.Edges.Parent = u.Edges.Parent.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	var builder strings.Builder
@@ -316,7 +1010,8 @@ func (u *User) String() string {
 	builder.WriteString(u.Nickname)
 	builder.WriteString(", phone=")
 	builder.WriteString(u.Phone)
-	builder.WriteString(", password=<sensitive>")
+	builder.WriteString(", password=")
+	builder.WriteString(u.sensitiveMask(TypeUser, user.FieldPassword))
 	builder.WriteString(", role=")
 	builder.WriteString(fmt.Sprintf("%v", u.Role))
 	builder.WriteString(", SSOCert=")
@@ -325,6 +1020,49 @@ func (u *User) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the User, for change detection and cache keys.
+// It covers the fields that are listed in user.FingerprintFields, and it does not depend on the
+// ID of the User, on its edges, or on the order of the fields in the schema.
+func (u *User) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "SSOCert", u.SSOCert)
+	fingerprint(h, "age", u.Age)
+	fingerprint(h, "last", u.Last)
+	fingerprint(h, "name", u.Name)
+	fingerprint(h, "nickname", u.Nickname)
+	fingerprint(h, "optional_int", u.OptionalInt)
+	fingerprint(h, "password", u.Password)
+	fingerprint(h, "phone", u.Phone)
+	fingerprint(h, "role", u.Role)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the User for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The User can be decoded using the FromMap method of its client.
+func (u *User) ToMap() map[string]string {
+	m := make(map[string]string, 10)
+	m[user.FieldID] = kvEncode(u.ID)
+	if v := u.OptionalInt; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldOptionalInt] = kvEncode(v)
+	}
+	m[user.FieldAge] = kvEncode(u.Age)
+	m[user.FieldName] = kvEncode(u.Name)
+	m[user.FieldLast] = kvEncode(u.Last)
+	if v := u.Nickname; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldNickname] = kvEncode(v)
+	}
+	if v := u.Phone; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldPhone] = kvEncode(v)
+	}
+	m[user.FieldRole] = kvEncode(u.Role)
+	if v := u.SSOCert; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldSSOCert] = kvEncode(v)
+	}
+	return m
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
@@ -350,7 +1088,7 @@ func (u *Users) FromResponse(res *gremlin.Response) error {
 		return err
 	}
 	for _, v := range scanu {
-		*u = append(*u, &User{
+		node := &User{
 			ID:          v.ID,
 			OptionalInt: v.OptionalInt,
 			Age:         v.Age,
@@ -361,7 +1099,8 @@ func (u *Users) FromResponse(res *gremlin.Response) error {
 			Password:    v.Password,
 			Role:        v.Role,
 			SSOCert:     v.SSOCert,
-		})
+		}
+		*u = append(*u, node)
 	}
 	return nil
 }
@@ -72,6 +72,35 @@ const (
 	ParentLabel = "user_parent"
 )
 
+// Edges holds all the edge names of the User type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeCard,
+	EdgePets,
+	EdgeFiles,
+	EdgeGroups,
+	EdgeFriends,
+	EdgeFollowers,
+	EdgeFollowing,
+	EdgeTeam,
+	EdgeSpouse,
+	EdgeChildren,
+	EdgeParent,
+}
+
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldSSOCert,
+	FieldAge,
+	FieldLast,
+	FieldName,
+	FieldNickname,
+	FieldOptionalInt,
+	FieldPassword,
+	FieldPhone,
+	FieldRole,
+}
+
 var (
 	// OptionalIntValidator is a validator for the "optional_int" field. It is called by the builders before save.
 	OptionalIntValidator func(int) error
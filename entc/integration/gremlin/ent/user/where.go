@@ -353,6 +353,13 @@ func NameContains(v string) predicate.User {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotContaining(v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -360,6 +367,13 @@ func NameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotStartingWith(v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -367,6 +381,13 @@ func NameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldName, p.NotEndingWith(v))
+	})
+}
+
 // LastEQ applies the EQ predicate on the "last" field.
 func LastEQ(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -438,6 +459,13 @@ func LastContains(v string) predicate.User {
 	})
 }
 
+// LastNotContains applies the NotContains predicate on the "last" field.
+func LastNotContains(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldLast, p.NotContaining(v))
+	})
+}
+
 // LastHasPrefix applies the HasPrefix predicate on the "last" field.
 func LastHasPrefix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -445,6 +473,13 @@ func LastHasPrefix(v string) predicate.User {
 	})
 }
 
+// LastNotHasPrefix applies the NotHasPrefix predicate on the "last" field.
+func LastNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldLast, p.NotStartingWith(v))
+	})
+}
+
 // LastHasSuffix applies the HasSuffix predicate on the "last" field.
 func LastHasSuffix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -452,6 +487,13 @@ func LastHasSuffix(v string) predicate.User {
 	})
 }
 
+// LastNotHasSuffix applies the NotHasSuffix predicate on the "last" field.
+func LastNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldLast, p.NotEndingWith(v))
+	})
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -523,6 +565,13 @@ func NicknameContains(v string) predicate.User {
 	})
 }
 
+// NicknameNotContains applies the NotContains predicate on the "nickname" field.
+func NicknameNotContains(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNickname, p.NotContaining(v))
+	})
+}
+
 // NicknameHasPrefix applies the HasPrefix predicate on the "nickname" field.
 func NicknameHasPrefix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -530,6 +579,13 @@ func NicknameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasPrefix applies the NotHasPrefix predicate on the "nickname" field.
+func NicknameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNickname, p.NotStartingWith(v))
+	})
+}
+
 // NicknameHasSuffix applies the HasSuffix predicate on the "nickname" field.
 func NicknameHasSuffix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -537,6 +593,13 @@ func NicknameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasSuffix applies the NotHasSuffix predicate on the "nickname" field.
+func NicknameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldNickname, p.NotEndingWith(v))
+	})
+}
+
 // NicknameIsNil applies the IsNil predicate on the "nickname" field.
 func NicknameIsNil() predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -622,6 +685,13 @@ func PhoneContains(v string) predicate.User {
 	})
 }
 
+// PhoneNotContains applies the NotContains predicate on the "phone" field.
+func PhoneNotContains(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPhone, p.NotContaining(v))
+	})
+}
+
 // PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
 func PhoneHasPrefix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -629,6 +699,13 @@ func PhoneHasPrefix(v string) predicate.User {
 	})
 }
 
+// PhoneNotHasPrefix applies the NotHasPrefix predicate on the "phone" field.
+func PhoneNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPhone, p.NotStartingWith(v))
+	})
+}
+
 // PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
 func PhoneHasSuffix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -636,6 +713,13 @@ func PhoneHasSuffix(v string) predicate.User {
 	})
 }
 
+// PhoneNotHasSuffix applies the NotHasSuffix predicate on the "phone" field.
+func PhoneNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPhone, p.NotEndingWith(v))
+	})
+}
+
 // PhoneIsNil applies the IsNil predicate on the "phone" field.
 func PhoneIsNil() predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -721,6 +805,13 @@ func PasswordContains(v string) predicate.User {
 	})
 }
 
+// PasswordNotContains applies the NotContains predicate on the "password" field.
+func PasswordNotContains(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.NotContaining(v))
+	})
+}
+
 // PasswordHasPrefix applies the HasPrefix predicate on the "password" field.
 func PasswordHasPrefix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -728,6 +819,13 @@ func PasswordHasPrefix(v string) predicate.User {
 	})
 }
 
+// PasswordNotHasPrefix applies the NotHasPrefix predicate on the "password" field.
+func PasswordNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.NotStartingWith(v))
+	})
+}
+
 // PasswordHasSuffix applies the HasSuffix predicate on the "password" field.
 func PasswordHasSuffix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -735,6 +833,13 @@ func PasswordHasSuffix(v string) predicate.User {
 	})
 }
 
+// PasswordNotHasSuffix applies the NotHasSuffix predicate on the "password" field.
+func PasswordNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldPassword, p.NotEndingWith(v))
+	})
+}
+
 // PasswordIsNil applies the IsNil predicate on the "password" field.
 func PasswordIsNil() predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -856,6 +961,13 @@ func SSOCertContains(v string) predicate.User {
 	})
 }
 
+// SSOCertNotContains applies the NotContains predicate on the "SSOCert" field.
+func SSOCertNotContains(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSSOCert, p.NotContaining(v))
+	})
+}
+
 // SSOCertHasPrefix applies the HasPrefix predicate on the "SSOCert" field.
 func SSOCertHasPrefix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -863,6 +975,13 @@ func SSOCertHasPrefix(v string) predicate.User {
 	})
 }
 
+// SSOCertNotHasPrefix applies the NotHasPrefix predicate on the "SSOCert" field.
+func SSOCertNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSSOCert, p.NotStartingWith(v))
+	})
+}
+
 // SSOCertHasSuffix applies the HasSuffix predicate on the "SSOCert" field.
 func SSOCertHasSuffix(v string) predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -870,6 +989,13 @@ func SSOCertHasSuffix(v string) predicate.User {
 	})
 }
 
+// SSOCertNotHasSuffix applies the NotHasSuffix predicate on the "SSOCert" field.
+func SSOCertNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(t *dsl.Traversal) {
+		t.Has(Label, FieldSSOCert, p.NotEndingWith(v))
+	})
+}
+
 // SSOCertIsNil applies the IsNil predicate on the "SSOCert" field.
 func SSOCertIsNil() predicate.User {
 	return predicate.User(func(t *dsl.Traversal) {
@@ -1128,3 +1254,61 @@ func Not(p predicate.User) predicate.User {
 		tr.Where(__.Not(t))
 	})
 }
+
+// UserFilter holds optional filters on the User fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type UserFilter struct {
+	IDIn        []string
+	OptionalInt *int
+	Age         *int
+	Name        *string
+	Last        *string
+	Nickname    *string
+	Phone       *string
+	Role        *Role
+	SSOCert     *string
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.User.Query().
+//		Where(user.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *UserFilter) []predicate.User {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.User
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.OptionalInt != nil {
+		ps = append(ps, OptionalIntEQ(*f.OptionalInt))
+	}
+	if f.Age != nil {
+		ps = append(ps, AgeEQ(*f.Age))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.Last != nil {
+		ps = append(ps, LastEQ(*f.Last))
+	}
+	if f.Nickname != nil {
+		ps = append(ps, NicknameEQ(*f.Nickname))
+	}
+	if f.Phone != nil {
+		ps = append(ps, PhoneEQ(*f.Phone))
+	}
+	if f.Role != nil {
+		ps = append(ps, RoleEQ(*f.Role))
+	}
+	if f.SSOCert != nil {
+		ps = append(ps, SSOCertEQ(*f.SSOCert))
+	}
+	return ps
+}
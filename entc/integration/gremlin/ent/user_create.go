@@ -319,29 +319,12 @@ func (uc *UserCreate) SetParent(u *User) *UserCreate {
 
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
-	if v, ok := uc.mutation.OptionalInt(); ok {
-		if err := user.OptionalIntValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)
-		}
-	}
-	if _, ok := uc.mutation.Age(); !ok {
-		return nil, errors.New("ent: missing required field \"age\"")
-	}
-	if _, ok := uc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
-	}
-	if _, ok := uc.mutation.Last(); !ok {
-		v := user.DefaultLast
-		uc.mutation.SetLast(v)
-	}
-	if _, ok := uc.mutation.Role(); !ok {
-		v := user.DefaultRole
-		uc.mutation.SetRole(v)
+	if err := checkTx(uc.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := uc.mutation.Role(); ok {
-		if err := user.RoleValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"role\": %v", err)
-		}
+	uc.defaults()
+	if err := uc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -378,6 +361,59 @@ func (uc *UserCreate) SaveX(ctx context.Context) *User {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uc *UserCreate) Unwrap() *UserCreate {
+	tx, ok := uc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserCreate is not a transactional builder")
+	}
+	uc.config.driver = tx.drv
+	uc.mutation.driver = tx.drv
+	return uc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (uc *UserCreate) DebugFields() map[string]Value {
+	return debugFields(uc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (uc *UserCreate) defaults() {
+	if _, ok := uc.mutation.Last(); !ok {
+		v := user.DefaultLast
+		uc.mutation.SetLast(v)
+	}
+	if _, ok := uc.mutation.Role(); !ok {
+		v := user.DefaultRole
+		uc.mutation.SetRole(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uc *UserCreate) check() error {
+	if v, ok := uc.mutation.OptionalInt(); ok {
+		if err := user.OptionalIntValidator(v); err != nil {
+			return &ValidationError{Name: "optional_int", err: fmt.Errorf("ent: validator failed for field \"optional_int\": %v", err)}
+		}
+	}
+	if _, ok := uc.mutation.Age(); !ok {
+		return &ValidationError{Name: "age", err: errors.New("ent: missing required field \"age\"")}
+	}
+	if _, ok := uc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := uc.mutation.Role(); ok {
+		if err := user.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf("ent: validator failed for field \"role\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (uc *UserCreate) gremlinSave(ctx context.Context) (*User, error) {
 	res := &gremlin.Response{}
 	query, bindings := uc.gremlin().Query()
@@ -24,6 +24,7 @@ type UserDelete struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -32,8 +33,32 @@ func (ud *UserDelete) Where(ps ...predicate.User) *UserDelete {
 	return ud
 }
 
+// Limit limits the number of users that are deleted by the builder. For example,
+// for deleting large amounts of users in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (ud *UserDelete) Limit(limit int) *UserDelete {
+	ud.limit = &limit
+	return ud
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ud *UserDelete) Unwrap() *UserDelete {
+	tx, ok := ud.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserDelete is not a transactional builder")
+	}
+	ud.config.driver = tx.drv
+	ud.mutation.driver = tx.drv
+	return ud
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (ud *UserDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(ud.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -83,6 +108,9 @@ func (ud *UserDelete) gremlin() *dsl.Traversal {
 	for _, p := range ud.predicates {
 		p(t)
 	}
+	if limit := ud.limit; limit != nil {
+		t.Limit(*limit)
+	}
 	return t.SideEffect(__.Drop()).Count()
 }
 
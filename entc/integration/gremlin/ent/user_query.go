@@ -10,6 +10,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/gremlin"
 	"github.com/facebookincubator/ent/dialect/gremlin/graph/dsl"
@@ -50,6 +51,19 @@ func (uq *UserQuery) Where(ps ...predicate.User) *UserQuery {
 	return uq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (uq *UserQuery) Predicates() []predicate.User {
+	return append([]predicate.User{}, uq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (uq *UserQuery) SetPredicates(ps ...predicate.User) *UserQuery {
+	uq.predicates = append([]predicate.User{}, ps...)
+	return uq
+}
+
 // Limit adds a limit step to the query.
 func (uq *UserQuery) Limit(limit int) *UserQuery {
 	uq.limit = &limit
@@ -908,6 +922,27 @@ func (us *UserSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (us *UserSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(us.fields) > 1 {
+		return nil, errors.New("ent: UserSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := us.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (us *UserSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := us.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
 func (us *UserSelect) gremlinScan(ctx context.Context, v interface{}) error {
 	var (
 		traversal *dsl.Traversal
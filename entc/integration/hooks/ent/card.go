@@ -7,7 +7,12 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -31,6 +36,9 @@ type Card struct {
 	// The values are being populated by the CardQuery when eager-loading is set.
 	Edges      CardEdges `json:"edges"`
 	user_cards *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // CardEdges holds the relations/edges for other nodes in the graph.
@@ -56,67 +64,178 @@ func (e CardEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Card) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // number
-		&sql.NullString{}, // name
-		&sql.NullTime{},   // created_at
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Card) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // user_cards
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Card type are scanned as generic values.
+func (*Card) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case card.FieldID:
+			values[i] = &sql.NullInt64{}
+		case card.FieldNumber:
+			values[i] = &sql.NullString{}
+		case card.FieldName:
+			values[i] = &sql.NullString{}
+		case card.FieldCreatedAt:
+			values[i] = &sql.NullTime{}
+		case card.ForeignKeys[0]: // user_cards
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Card fields.
-func (c *Card) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(card.Columns); m < n {
+// to the Card fields. Values of columns that were not selected are left untouched.
+func (c *Card) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	c.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field number", values[0])
-	} else if value.Valid {
-		c.Number = value.String
-	}
-	if value, ok := values[1].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[1])
-	} else if value.Valid {
-		c.Name = value.String
-	}
-	if value, ok := values[2].(*sql.NullTime); !ok {
-		return fmt.Errorf("unexpected type %T for field created_at", values[2])
-	} else if value.Valid {
-		c.CreatedAt = value.Time
-	}
-	values = values[3:]
-	if len(values) == len(card.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_cards", value)
-		} else if value.Valid {
-			c.user_cards = new(int)
-			*c.user_cards = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case card.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case card.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				c.Number = value.String
+			}
+		case card.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		case card.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case card.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_cards", value)
+			} else if value.Valid {
+				c.user_cards = new(int)
+				*c.user_cards = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (c *Card) SelectValue(alias string) (Value, error) {
+	v, ok := c.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryOwner queries the owner edge of the Card.
 func (c *Card) QueryOwner() *UserQuery {
 	return (&CardClient{config: c.config}).QueryOwner(c)
 }
 
+// LoadEdges loads the given edges of the Card using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see card.Edges), and in this case, no edge is loaded.
+//
+//	err := c.LoadEdges(ctx, client, card.EdgeOwner)
+//
+func (c *Card) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadCardEdges(ctx, client, []*Card{c}, edges)
+}
+
+// loadCardEdges loads the given edges of all the given Card entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using CardClient.LoadEdgeFor.
+func loadCardEdges(ctx context.Context, client *Client, nodes []*Card, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case card.EdgeOwner:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Card", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Card.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Card and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Card alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Card itself is not modified.
+//
+//	b, err := c.DumpGraph(ctx, client, 2, card.EdgeOwner)
+//
+func (c *Card) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Card DumpGraph", depth)
+	}
+	dumped := *c
+	dumped.Edges = CardEdges{}
+	if err := dumpCards(ctx, client, []*Card{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpCards loads the edges of the given Card copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpCards(ctx context.Context, client *Client, nodes []*Card, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Card
+	for _, n := range nodes {
+		if dumper.visit(card.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range card.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadCardEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextOwner []*User
+	for _, n := range visit {
+		if e := n.Edges.Owner; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Owner = &c
+			nextOwner = append(nextOwner, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextOwner, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Card.
 // Note that, you need to call Card.Unwrap() before calling this method, if this Card
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -135,6 +254,27 @@ func (c *Card) Unwrap() *Card {
 	return c
 }
 
+// Clone returns a deep copy of the Card and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (c *Card) Clone() *Card {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Card and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (c *Card) clone(seen map[interface{}]interface{}) *Card {
+	if c == nil {
+		return nil
+	}
+	if v, ok := seen[c]; ok {
+		return v.(*Card)
+	}
+	_c := *c
+	seen[c] = &_c
+	_c.Edges.Owner = c.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Card) String() string {
 	var builder strings.Builder
@@ -150,6 +290,31 @@ func (c *Card) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Card, for change detection and cache keys.
+// It covers the fields that are listed in card.FingerprintFields, and it does not depend on the
+// ID of the Card, on its edges, or on the order of the fields in the schema.
+func (c *Card) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", c.Name)
+	fingerprint(h, "number", c.Number)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Card for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Card can be decoded using the FromMap method of its client.
+func (c *Card) ToMap() map[string]string {
+	m := make(map[string]string, 4)
+	m[card.FieldID] = kvEncode(c.ID)
+	m[card.FieldNumber] = kvEncode(c.Number)
+	if v := c.Name; !reflect.ValueOf(v).IsZero() {
+		m[card.FieldName] = kvEncode(v)
+	}
+	m[card.FieldCreatedAt] = kvEncode(c.CreatedAt)
+	return m
+}
+
 // Cards is a parsable slice of Card.
 type Cards []*Card
 
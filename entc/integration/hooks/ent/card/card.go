@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect/sql"
 )
 
 const (
@@ -35,6 +36,18 @@ const (
 	OwnerColumn = "user_cards"
 )
 
+// Edges holds all the edge names of the Card type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+}
+
+// FingerprintFields holds the fields of the Card type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+	FieldNumber,
+}
+
 // Columns holds all SQL columns for card fields.
 var Columns = []string{
 	FieldID,
@@ -48,6 +61,22 @@ var ForeignKeys = []string{
 	"user_cards",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// CreatedAtTrunc returns a column that truncates the created_at field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "created_at_<bucket>".
+func CreatedAtTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldCreatedAt)
+}
+
 // Note that the variables below are initialized by the runtime
 // package on the initialization of the application. Therefore,
 // it should be imported in the main as follows:
@@ -7,6 +7,7 @@
 package card
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -201,6 +202,13 @@ func NumberContains(v string) predicate.Card {
 	})
 }
 
+// NumberNotContains applies the NotContains predicate on the "number" field.
+func NumberNotContains(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNumber), v))
+	})
+}
+
 // NumberHasPrefix applies the HasPrefix predicate on the "number" field.
 func NumberHasPrefix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -208,6 +216,13 @@ func NumberHasPrefix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasPrefix applies the NotHasPrefix predicate on the "number" field.
+func NumberNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNumber), v))
+	})
+}
+
 // NumberHasSuffix applies the HasSuffix predicate on the "number" field.
 func NumberHasSuffix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -215,6 +230,13 @@ func NumberHasSuffix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasSuffix applies the NotHasSuffix predicate on the "number" field.
+func NumberNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNumber), v))
+	})
+}
+
 // NumberEqualFold applies the EqualFold predicate on the "number" field.
 func NumberEqualFold(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -229,6 +251,20 @@ func NumberContainsFold(v string) predicate.Card {
 	})
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasSuffixFold applies the HasSuffixFold predicate on the "number" field.
+func NumberHasSuffixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNumber), v))
+	})
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -312,6 +348,13 @@ func NameContains(v string) predicate.Card {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -319,6 +362,13 @@ func NameHasPrefix(v string) predicate.Card {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -326,6 +376,13 @@ func NameHasSuffix(v string) predicate.Card {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameIsNil applies the IsNil predicate on the "name" field.
 func NameIsNil() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -354,6 +411,20 @@ func NameContainsFold(v string) predicate.Card {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -430,6 +501,80 @@ func CreatedAtLTE(v time.Time) predicate.Card {
 	})
 }
 
+// NameEQNullSafe applies a NULL-safe equality predicate on the "name" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NameEQNullSafe(v *string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldName), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldCreatedAt: "time",
+	FieldID:        "numeric",
+	FieldName:      "string",
+	FieldNumber:    "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Card.
+func FieldsEQ(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Card.
+func FieldsNEQ(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Card.
+//
+//	card.FieldsLT(card.FieldNumber, card.FieldName)
+//
+func FieldsLT(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Card.
+func FieldsLTE(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Card.
+func FieldsGT(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Card.
+func FieldsGTE(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Card using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Card", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Card", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Card are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -458,6 +603,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.Card {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	card.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_cards IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_cards)
+//
+func OwnerMissing() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Card) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -465,6 +649,9 @@ func And(predicates ...predicate.Card) predicate.Card {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -479,6 +666,9 @@ func Or(predicates ...predicate.Card) predicate.Card {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -489,3 +679,62 @@ func Not(p predicate.Card) predicate.Card {
 		p(s.Not())
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// CardFilter holds optional filters on the Card fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CardFilter struct {
+	IDIn      []int
+	Number    *string
+	Name      *string
+	CreatedAt *TimeRange
+	OwnerIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Card.Query().
+//		Where(card.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CardFilter) []predicate.Card {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Card
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Number != nil {
+		ps = append(ps, NumberEQ(*f.Number))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if r := f.CreatedAt; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, CreatedAtGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, CreatedAtLT(r.To))
+		}
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Card(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	return ps
+}
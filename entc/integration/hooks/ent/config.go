@@ -7,8 +7,11 @@
 package ent
 
 import (
+	"context"
+
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 )
 
 // Option function to configure the client.
@@ -24,6 +27,20 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+	// txObserver observes the lifecycle of the transactions.
+	txObserver func(context.Context, TxEvent)
+	// redact returns the masks of the sensitive fields.
+	redact func(typ, field string) string
+	// eagerLoadBatchSize limits the number of ids in eager-loading queries.
+	eagerLoadBatchSize int
+	// rewrite used for rewriting statements before they are executed.
+	rewrite sql.RewriteFunc
+	// tracer used for recording spans for the executed statements.
+	tracer sql.Tracer
+	// metrics used for collecting the metrics of the executed statements.
+	metrics sql.MetricsCollector
+	// consistency of the queries that are executed with the config.
+	consistency sql.ReadConsistency
 }
 
 // hooks per client, for fast access.
@@ -37,6 +54,18 @@ func (c *config) options(opts ...Option) {
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.metrics != nil {
+		c.driver = sql.Metrics(c.driver, c.metrics)
+	}
+	if c.tracer != nil {
+		c.driver = sql.Trace(c.driver, c.tracer)
+	}
+	if c.rewrite != nil {
+		c.driver = sql.Rewrite(c.driver, c.rewrite)
+	}
+	// Statements are counted on the query budget of their context (if it
+	// has one), before they are rewritten, traced or recorded in metrics.
+	c.driver = sql.Budget(c.driver)
 	if c.debug {
 		c.driver = dialect.Debug(c.driver, c.log)
 	}
@@ -62,3 +91,145 @@ func Driver(driver dialect.Driver) Option {
 		c.driver = driver
 	}
 }
+
+// TxObserver sets a function for observing the lifecycle of the transactions that are started
+// by the client. The function is called with the context that started the transaction on its
+// begin, commit and rollback, and a panic in the function does not affect the transaction.
+//
+//	TxObserver(func(ctx context.Context, e TxEvent) {
+//		if e.Op != TxBegin {
+//			log.Printf("tx %s took %s (labels: %v, err: %v)", e.Op, e.Duration, e.Labels, e.Err)
+//		}
+//	})
+//
+func TxObserver(fn func(context.Context, TxEvent)) Option {
+	return func(c *config) {
+		c.txObserver = fn
+	}
+}
+
+// Redact sets a function that returns the mask of the values of sensitive fields. The mask replaces
+// the values in the String methods of the entities and the mutations, in the DebugFields of the builders,
+// in the validation errors and in the debug logs of the SQL dialects. The default mask is "<sensitive>".
+//
+//	Redact(func(typ, field string) string {
+//		return fmt.Sprintf("<%s.%s>", typ, field)
+//	})
+//
+func Redact(fn func(typ, field string) string) Option {
+	return func(c *config) {
+		c.redact = fn
+	}
+}
+
+// sensitiveMask returns the mask of the given sensitive field.
+func (c config) sensitiveMask(typ, field string) string {
+	if c.redact == nil {
+		return "<sensitive>"
+	}
+	return c.redact(typ, field)
+}
+
+// EagerLoadBatchSize configures the maximum number of ids that are sent in a single
+// query when eager-loading edges. Larger lists are split into batches, and the results
+// are merged back to their parent nodes. If not set, a dialect-specific default is used.
+func EagerLoadBatchSize(n int) Option {
+	return func(c *config) {
+		c.eagerLoadBatchSize = n
+	}
+}
+
+// SQLRewriter sets a function for rewriting the statements (and their arguments) after they
+// were built, and before they are executed by the driver. For example, for tagging queries:
+//
+//	SQLRewriter(func(ctx context.Context, query string, args []interface{}) (string, []interface{}) {
+//		return "/* app=api */ " + query, args
+//	})
+//
+func SQLRewriter(fn func(context.Context, string, []interface{}) (string, []interface{})) Option {
+	return func(c *config) {
+		c.rewrite = fn
+	}
+}
+
+// Tracer sets a tracer for recording a span for each statement (and transaction) that is
+// executed by the client. The spans hold the database system, the statement, the number
+// of affected rows, and the entity and the operation that executed the statement.
+// Statements that are executed in a transaction are recorded as children of its span.
+func Tracer(t sql.Tracer) Option {
+	return func(c *config) {
+		c.tracer = t
+	}
+}
+
+// Metrics sets a collector for the metrics of each statement that is executed by the client,
+// including statements that are executed in transactions. The metrics hold the duration of
+// the statement, the number of affected rows, the classification of its error (if failed),
+// and the entity and the operation that executed the statement.
+func Metrics(c sql.MetricsCollector) Option {
+	return func(cfg *config) {
+		cfg.metrics = c
+	}
+}
+
+// withOperation returns a context that holds the entity and the operation that are recorded
+// on the statements spans and metrics, and the read consistency of the config (if it was set).
+func (c config) withOperation(ctx context.Context, entity, op string) context.Context {
+	if c.consistency != sql.ReadEventual {
+		ctx = sql.WithReadConsistency(ctx, c.consistency)
+	}
+	if c.tracer == nil && c.metrics == nil {
+		return ctx
+	}
+	return sql.WithOperation(ctx, entity, op)
+}
+
+// mutationDriver returns the driver that executes the mutations of the config. If the context is in
+// dry-run mode (see DryRun), it returns a driver that records the statements instead of executing them.
+func (c config) mutationDriver(ctx context.Context) dialect.Driver {
+	if sql.IsDryRun(ctx) {
+		return sql.DryRun(c.driver)
+	}
+	return c.driver
+}
+
+// eagerLoadBatches splits a list of n ids into batches, and calls fn with the boundaries
+// of each one of them. The loading stops if the context was canceled between batches.
+func (c config) eagerLoadBatches(ctx context.Context, n int, fn func(i, j int) error) error {
+	size := c.eagerLoadSize()
+	for i := 0; i < n; i += size {
+		j := i + size
+		if j > n {
+			j = n
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := fn(i, j); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eagerLoadSize returns the maximum number of ids that are sent in a single eager-loading query.
+func (c config) eagerLoadSize() int {
+	if c.eagerLoadBatchSize > 0 {
+		return c.eagerLoadBatchSize
+	}
+	// SQLite limits the number of variables in a statement to 999 by
+	// default. Leave room for the arguments of other predicates as well.
+	switch c.driver.Dialect() {
+	case dialect.SQLite:
+		return 900
+	default:
+		return 10000
+	}
+}
+
+// eagerLoadBatched reports if a list of n ids is split into more than one batch when eager-loaded.
+// Options of the edge query that apply to the whole result (like limit or order) cannot be applied
+// on each batch separately, and therefore, these queries fail instead of returning partial results.
+func (c config) eagerLoadBatched(n int) bool {
+	return n > c.eagerLoadSize()
+}
@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -42,6 +43,7 @@ type CardMutation struct {
 	clearedFields map[string]struct{}
 	owner         *int
 	clearedowner  bool
+	conflictowner bool
 }
 
 var _ ent.Mutation = (*CardMutation)(nil)
@@ -75,6 +77,18 @@ func (m CardMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CardMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CardMutation) ID() (id int, exists bool) {
@@ -157,11 +171,15 @@ func (m *CardMutation) ResetCreatedAt() {
 // SetOwnerID sets the owner edge to User by id.
 func (m *CardMutation) SetOwnerID(id int) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to User.
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *CardMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -177,6 +195,34 @@ func (m *CardMutation) OwnerID() (id int, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *CardMutation) OwnerIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Card.Query().Where(card.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -191,6 +237,7 @@ func (m *CardMutation) OwnerIDs() (ids []int) {
 func (m *CardMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // Op returns the operation name.
@@ -203,6 +250,11 @@ func (m *CardMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CardMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -417,17 +469,18 @@ func (m *CardMutation) ResetEdge(name string) error {
 // nodes in the graph.
 type UserMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	name               *string
-	clearedFields      map[string]struct{}
-	cards              map[int]struct{}
-	removedcards       map[int]struct{}
-	friends            map[int]struct{}
-	removedfriends     map[int]struct{}
-	best_friend        *int
-	clearedbest_friend bool
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	clearedFields       map[string]struct{}
+	cards               map[int]struct{}
+	removedcards        map[int]struct{}
+	friends             map[int]struct{}
+	removedfriends      map[int]struct{}
+	best_friend         *int
+	clearedbest_friend  bool
+	conflictbest_friend bool
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -461,6 +514,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *UserMutation) ID() (id int, exists bool) {
@@ -576,11 +641,15 @@ func (m *UserMutation) ResetFriends() {
 // SetBestFriendID sets the best_friend edge to User by id.
 func (m *UserMutation) SetBestFriendID(id int) {
 	m.best_friend = &id
+	m.conflictbest_friend = false
 }
 
-// ClearBestFriend clears the best_friend edge to User.
+// ClearBestFriend clears the best_friend edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearBestFriend() {
 	m.clearedbest_friend = true
+	m.conflictbest_friend = m.best_friend != nil
 }
 
 // BestFriendCleared returns if the edge best_friend was cleared.
@@ -596,6 +665,34 @@ func (m *UserMutation) BestFriendID() (id int, exists bool) {
 	return
 }
 
+// BestFriendIDChanged reports if the best_friend edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) BestFriendIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.best_friend == nil && !m.clearedbest_friend {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: BestFriendIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: BestFriendIDChanged requires an ID field in the mutation")
+	}
+	if m.best_friend != nil {
+		newID = *m.best_friend
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryBestFriend().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.best_friend != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old best_friend id: %v", err)
+	}
+	return oldID, newID, m.best_friend == nil || oldID != newID, nil
+}
+
 // BestFriendIDs returns the best_friend ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // BestFriendID instead. It exists only for internal usage by the builders.
@@ -610,6 +707,7 @@ func (m *UserMutation) BestFriendIDs() (ids []int) {
 func (m *UserMutation) ResetBestFriend() {
 	m.best_friend = nil
 	m.clearedbest_friend = false
+	m.conflictbest_friend = false
 }
 
 // Op returns the operation name.
@@ -622,6 +720,11 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -37,6 +37,19 @@ const (
 	BestFriendColumn = "user_best_friend"
 )
 
+// Edges holds all the edge names of the User type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeCards,
+	EdgeFriends,
+	EdgeBestFriend,
+}
+
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
@@ -48,6 +61,16 @@ var ForeignKeys = []string{
 	"user_best_friend",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// FriendsPrimaryKey and FriendsColumn2 are the table columns denoting the
 	// primary key for the friends relation (M2M).
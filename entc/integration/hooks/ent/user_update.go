@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -24,6 +25,9 @@ type UserUpdate struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,12 +36,26 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Limit limits the number of users that are updated by the builder. For example,
+// for updating large amounts of users in small batches without locking the whole table.
+func (uu *UserUpdate) Limit(limit int) *UserUpdate {
+	uu.limit = &limit
+	return uu
+}
+
 // SetName sets the name field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
 	return uu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetName() *UserUpdate {
+	uu.mutation.ResetName()
+	return uu
+}
+
 // AddCardIDs adds the cards edge to Card by ids.
 func (uu *UserUpdate) AddCardIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddCardIDs(ids...)
@@ -123,9 +141,33 @@ func (uu *UserUpdate) ClearBestFriend() *UserUpdate {
 	return uu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uu *UserUpdate) Unwrap() *UserUpdate {
+	tx, ok := uu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdate is not a transactional builder")
+	}
+	uu.config.driver = tx.drv
+	uu.mutation.driver = tx.drv
+	return uu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uu *UserUpdate) DebugFields() map[string]Value {
+	return debugFields(uu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(uu.driver); err != nil {
+		return 0, err
+	}
+	if err := uu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -174,7 +216,16 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if uu.mutation.conflictbest_friend {
+		return &ValidationError{Name: "best_friend", err: errors.New("ent: setting and clearing the unique edge \"best_friend\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = uu.withOperation(ctx, "User", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -192,6 +243,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := uu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if uu.ids != nil {
+		_spec.ScanIDs = uu.ids
+	}
 	if value, ok := uu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -310,7 +367,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -321,11 +378,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the User entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (uu *UserUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	uu.ids = &ids
+	defer func() { uu.ids = nil }()
+	if _, err := uu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks      []Hook
+	mutation   *UserMutation
+	predicates []predicate.User
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.predicates = append(uuo.predicates, ps...)
+	return uuo
 }
 
 // SetName sets the name field.
@@ -334,6 +413,13 @@ func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetName() *UserUpdateOne {
+	uuo.mutation.ResetName()
+	return uuo
+}
+
 // AddCardIDs adds the cards edge to Card by ids.
 func (uuo *UserUpdateOne) AddCardIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddCardIDs(ids...)
@@ -419,9 +505,33 @@ func (uuo *UserUpdateOne) ClearBestFriend() *UserUpdateOne {
 	return uuo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uuo *UserUpdateOne) Unwrap() *UserUpdateOne {
+	tx, ok := uuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdateOne is not a transactional builder")
+	}
+	uuo.config.driver = tx.drv
+	uuo.mutation.driver = tx.drv
+	return uuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uuo *UserUpdateOne) DebugFields() map[string]Value {
+	return debugFields(uuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-
+	if err := checkTx(uuo.driver); err != nil {
+		return nil, err
+	}
+	if err := uuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *User
@@ -470,7 +580,16 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if uuo.mutation.conflictbest_friend {
+		return &ValidationError{Name: "best_friend", err: errors.New("ent: setting and clearing the unique edge \"best_friend\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
+	ctx = uuo.withOperation(ctx, "User", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -486,6 +605,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		return nil, fmt.Errorf("missing User.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := uuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -605,9 +731,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	u = &User{config: uuo.config}
-	_spec.Assign = u.assignValues
-	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return u.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = u.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, uuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -615,5 +743,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		u.ID = id
+		if value, ok := uuo.mutation.Name(); ok {
+			u.Name = value
+		}
+	}
 	return u, nil
 }
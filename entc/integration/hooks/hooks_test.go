@@ -10,6 +10,7 @@ import (
 	"sort"
 	"testing"
 
+	entgo "github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/entc/integration/hooks/ent"
 	"github.com/facebookincubator/ent/entc/integration/hooks/ent/card"
 	"github.com/facebookincubator/ent/entc/integration/hooks/ent/enttest"
@@ -61,6 +62,27 @@ func TestRuntimeHooks(t *testing.T) {
 	require.Equal(t, 4, calls, "debug client should keep thr same hooks")
 }
 
+func TestSaveID(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	var values []ent.Value
+	client.Card.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.CardFunc(func(ctx context.Context, m *ent.CardMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			values = append(values, v)
+			return v, err
+		})
+	})
+	crd := client.Card.Create().SetNumber("1234").SaveX(ctx)
+	id := client.Card.Create().SetNumber("5678").SaveIDX(ctx)
+	require.Len(t, values, 2)
+	require.IsType(t, crd, values[0])
+	require.IsType(t, crd, values[1], "hooks get the same value type from SaveID")
+	require.Equal(t, id, values[1].(*ent.Card).ID)
+	require.Empty(t, values[1].(*ent.Card).Number, "only the id is populated")
+}
+
 func TestRuntimeChain(t *testing.T) {
 	ctx := context.Background()
 	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
@@ -151,3 +173,76 @@ func TestDeletion(t *testing.T) {
 	require.Zero(t, client.User.Query().CountX(ctx))
 	require.Zero(t, client.Card.Query().CountX(ctx))
 }
+
+func TestMutationEdgeChanged(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
+	defer client.Close()
+	type change struct {
+		old, new int
+		changed  bool
+	}
+	var changes []change
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			oldID, newID, changed, err := m.BestFriendIDChanged(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if m.Op().Is(ent.OpUpdateOne) {
+				changes = append(changes, change{oldID, newID, changed})
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	alex := client.User.Create().SetName("alexsn").SaveX(ctx)
+	a8m = a8m.Update().SetBestFriend(nati).SaveX(ctx)
+	a8m = a8m.Update().ClearBestFriend().SetBestFriend(nati).SaveX(ctx)
+	a8m = a8m.Update().ClearBestFriend().SetBestFriend(alex).SaveX(ctx)
+	a8m = a8m.Update().ClearBestFriend().SaveX(ctx)
+	a8m.Update().SetName("Ariel").SaveX(ctx)
+	require.Equal(t, []change{
+		{0, nati.ID, true},
+		{nati.ID, nati.ID, false},
+		{nati.ID, alex.ID, true},
+		{alex.ID, 0, true},
+		{0, 0, false},
+	}, changes)
+
+	_, err := client.User.Create().SetName("pedro").SetBestFriend(a8m).Save(ctx)
+	require.EqualError(t, err, "ent: BestFriendIDChanged is allowed only on UpdateOne operations")
+}
+
+func TestAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1", enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)))
+	defer client.Close()
+	var ops []ent.Op
+	client.Card.Use(entgo.AfterCommit(func(_ context.Context, m ent.Mutation) {
+		ops = append(ops, m.Op())
+	}))
+	client.Card.Create().SetNumber("1234").SaveX(ctx)
+	require.Equal(t, []ent.Op{ent.OpCreate}, ops, "called after the statement was executed")
+
+	ops = nil
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.Card.Create().SetNumber("1234").SaveX(ctx)
+	tx.Card.Create().SetNumber("5678").SaveX(ctx)
+	require.Empty(t, ops, "not called before the transaction was committed")
+	require.NoError(t, tx.Commit())
+	require.Equal(t, []ent.Op{ent.OpCreate, ent.OpCreate}, ops)
+
+	ops = nil
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	tx.Card.Create().SetNumber("1234").SaveX(ctx)
+	require.NoError(t, tx.Rollback())
+	require.Empty(t, ops, "not called on rollback")
+
+	_, err = client.Card.Create().SetNumber("123").Save(ctx)
+	require.Error(t, err)
+	require.Empty(t, ops, "not called on failure")
+}
@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/facebookincubator/ent/entc/integration/idtype/ent/user"
@@ -37,6 +38,7 @@ type UserMutation struct {
 	clearedFields    map[string]struct{}
 	spouse           *uint64
 	clearedspouse    bool
+	conflictspouse   bool
 	followers        map[uint64]struct{}
 	removedfollowers map[uint64]struct{}
 	following        map[uint64]struct{}
@@ -74,6 +76,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *UserMutation) ID() (id uint64, exists bool) {
@@ -105,11 +119,15 @@ func (m *UserMutation) ResetName() {
 // SetSpouseID sets the spouse edge to User by id.
 func (m *UserMutation) SetSpouseID(id uint64) {
 	m.spouse = &id
+	m.conflictspouse = false
 }
 
-// ClearSpouse clears the spouse edge to User.
+// ClearSpouse clears the spouse edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearSpouse() {
 	m.clearedspouse = true
+	m.conflictspouse = m.spouse != nil
 }
 
 // SpouseCleared returns if the edge spouse was cleared.
@@ -125,6 +143,34 @@ func (m *UserMutation) SpouseID() (id uint64, exists bool) {
 	return
 }
 
+// SpouseIDChanged reports if the spouse edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) SpouseIDChanged(ctx context.Context) (oldID, newID uint64, changed bool, err error) {
+	if m.spouse == nil && !m.clearedspouse {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: SpouseIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: SpouseIDChanged requires an ID field in the mutation")
+	}
+	if m.spouse != nil {
+		newID = *m.spouse
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QuerySpouse().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.spouse != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old spouse id: %v", err)
+	}
+	return oldID, newID, m.spouse == nil || oldID != newID, nil
+}
+
 // SpouseIDs returns the spouse ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // SpouseID instead. It exists only for internal usage by the builders.
@@ -139,6 +185,7 @@ func (m *UserMutation) SpouseIDs() (ids []uint64) {
 func (m *UserMutation) ResetSpouse() {
 	m.spouse = nil
 	m.clearedspouse = false
+	m.conflictspouse = false
 }
 
 // AddFollowerIDs adds the followers edge to User by ids.
@@ -235,6 +282,11 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -32,6 +32,19 @@ const (
 	FollowingTable = "user_following"
 )
 
+// Edges holds all the edge names of the User type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeSpouse,
+	EdgeFollowers,
+	EdgeFollowing,
+}
+
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
@@ -43,6 +56,16 @@ var ForeignKeys = []string{
 	"user_spouse",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// FollowersPrimaryKey and FollowersColumn2 are the table columns denoting the
 	// primary key for the followers relation (M2M).
@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net"
@@ -20,14 +21,21 @@ import (
 	"time"
 
 	"github.com/facebookincubator/ent/dialect"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/card"
+	"github.com/facebookincubator/ent/entc/integration/ent/comment"
 	"github.com/facebookincubator/ent/entc/integration/ent/enttest"
 	"github.com/facebookincubator/ent/entc/integration/ent/file"
+	"github.com/facebookincubator/ent/entc/integration/ent/filetype"
 	"github.com/facebookincubator/ent/entc/integration/ent/group"
 	"github.com/facebookincubator/ent/entc/integration/ent/groupinfo"
 	"github.com/facebookincubator/ent/entc/integration/ent/migrate"
 	"github.com/facebookincubator/ent/entc/integration/ent/node"
 	"github.com/facebookincubator/ent/entc/integration/ent/pet"
+	"github.com/facebookincubator/ent/entc/integration/ent/predicate"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
+	"github.com/facebookincubator/ent/entc/integration/ent/spec"
 	"github.com/facebookincubator/ent/entc/integration/ent/user"
 	"github.com/stretchr/testify/mock"
 
@@ -49,6 +57,607 @@ func TestSQLite(t *testing.T) {
 	}
 }
 
+func TestEagerLoadBatchSize(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:batch?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(ent.EagerLoadBatchSize(2)))
+	defer client.Close()
+	ctx := context.Background()
+	require := require.New(t)
+	hub := client.Group.Create().SetName("GitHub").SetExpire(time.Now()).SetInfo(client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)).SaveX(ctx)
+	for i := 0; i < 5; i++ {
+		u := client.User.Create().SetName(fmt.Sprintf("u%d", i)).SetAge(i).AddGroups(hub).SaveX(ctx)
+		client.Pet.Create().SetName(fmt.Sprintf("p%d", i)).SetOwner(u).SaveX(ctx)
+		client.Card.Create().SetNumber(strconv.Itoa(i)).SetOwner(u).SaveX(ctx)
+	}
+	users := client.User.Query().WithPets().WithCard().WithGroups().Order(ent.Asc(user.FieldAge)).AllX(ctx)
+	require.Len(users, 5)
+	for i, u := range users {
+		require.Len(u.Edges.Pets, 1)
+		require.Equal(fmt.Sprintf("p%d", i), u.Edges.Pets[0].Name)
+		require.Equal(strconv.Itoa(i), u.Edges.Card.Number)
+		require.Len(u.Edges.Groups, 1)
+		require.Equal(hub.ID, u.Edges.Groups[0].ID)
+	}
+	cards := client.Card.Query().WithOwner(func(q *ent.UserQuery) { q.Where(user.AgeGT(1)) }).Order(ent.Asc(card.FieldNumber)).AllX(ctx)
+	require.Len(cards, 5)
+	for i, c := range cards {
+		if i > 1 {
+			require.Equal(fmt.Sprintf("u%d", i), c.Edges.Owner.Name)
+		} else {
+			require.Nil(c.Edges.Owner, "predicates of the eager-loading query apply on all batches")
+		}
+	}
+	hub = client.Group.Query().WithUsers().OnlyX(ctx)
+	require.Len(hub.Edges.Users, 5)
+
+	// Options that apply to the whole result of the edge query are not applied on each batch.
+	_, err := client.Card.Query().WithOwner(func(q *ent.UserQuery) { q.Limit(1) }).All(ctx)
+	require.Error(err)
+	_, err = client.Group.Query().WithUsers(func(q *ent.UserQuery) { q.Order(ent.Desc(user.FieldAge)) }).All(ctx)
+	require.Error(err)
+	cards = client.Card.Query().Where(card.NumberIn("3", "4")).WithOwner(func(q *ent.UserQuery) { q.Limit(1) }).Order(ent.Asc(card.FieldNumber)).AllX(ctx)
+	require.Len(cards, 2)
+	require.NotNil(cards[0].Edges.Owner)
+	require.Nil(cards[1].Edges.Owner, "limit applies to all neighbors of a single batch")
+	users = client.User.Query().WithGroups(func(q *ent.GroupQuery) { q.Order(ent.Desc(group.FieldName)) }).AllX(ctx)
+	require.Len(users, 5)
+	require.Equal(hub.ID, users[0].Edges.Groups[0].ID)
+}
+
+func TestDumpGraphQueries(t *testing.T) {
+	var queries int
+	client := enttest.Open(t, dialect.SQLite, "file:dump?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(
+		ent.Debug(),
+		ent.Log(func(v ...interface{}) {
+			if strings.HasPrefix(fmt.Sprint(v...), "driver.Query") {
+				queries++
+			}
+		}),
+	))
+	defer client.Close()
+	ctx := context.Background()
+	require := require.New(t)
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	dump := func() int {
+		queries = 0
+		b, err := a8m.DumpGraph(ctx, client, 2, user.EdgeFriends, user.EdgeCard)
+		require.NoError(err)
+		dumped := &ent.User{}
+		require.NoError(json.Unmarshal(b, dumped))
+		for _, f := range dumped.Edges.Friends {
+			require.NotNil(f.Edges.Card)
+		}
+		return queries
+	}
+	for i := 0; i < 2; i++ {
+		u := client.User.Create().SetName(fmt.Sprintf("u%d", i)).SetAge(i).AddFriends(a8m).SaveX(ctx)
+		client.Card.Create().SetNumber(strconv.Itoa(i)).SetOwner(u).SaveX(ctx)
+	}
+	n := dump()
+	for i := 2; i < 6; i++ {
+		u := client.User.Create().SetName(fmt.Sprintf("u%d", i)).SetAge(i).AddFriends(a8m).SaveX(ctx)
+		client.Card.Create().SetNumber(strconv.Itoa(i)).SetOwner(u).SaveX(ctx)
+	}
+	require.Equal(n, dump(), "the edges of each level are loaded for all entities at once")
+}
+
+func TestSQLRewriter(t *testing.T) {
+	var queries []string
+	rewrite := func(_ context.Context, query string, args []interface{}) (string, []interface{}) {
+		query = "/* app=test */ " + query
+		queries = append(queries, query)
+		return query, args
+	}
+	client := enttest.Open(t, dialect.SQLite, "file:rewrite?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(ent.SQLRewriter(rewrite)))
+	defer client.Close()
+	ctx := context.Background()
+	queries = queries[:0]
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	require.Equal(t, 1, client.User.Query().Where(user.Name("a8m")).CountX(ctx))
+	require.Len(t, queries, 2)
+	require.True(t, strings.HasPrefix(queries[0], "/* app=test */ INSERT INTO `users`"))
+	require.True(t, strings.HasPrefix(queries[1], "/* app=test */ SELECT COUNT"))
+
+	tx, err := client.BeginTx(ctx, &sql.TxOptions{})
+	require.NoError(t, err)
+	tx.User.UpdateOne(a8m).SetAge(31).ExecX(ctx)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 31, client.User.GetX(ctx, a8m.ID).Age)
+	require.True(t, strings.HasPrefix(queries[2], "/* app=test */ UPDATE `users`"), "transactions are rewritten as well")
+}
+
+func TestFindOrCreateConflict(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:findorcreate?mode=memory&cache=shared&_fk=1", opts)
+	defer client.Close()
+	ctx := context.Background()
+	var raced bool
+	// Simulate a concurrent writer that inserts the same user
+	// after the lookup and before the actual insertion.
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if um, ok := m.(*ent.UserMutation); ok && m.Op().Is(ent.OpCreate) && !raced {
+				raced = true
+				um.Client().User.Create().SetName("a8m").SetAge(30).SetNickname("a8m").SaveX(ctx)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	ps := []predicate.User{user.Nickname("a8m")}
+	u, created, err := client.User.FindOrCreate(ctx, ps, client.User.Create().SetName("a8m").SetAge(31).SetNickname("a8m"))
+	require.NoError(t, err)
+	require.False(t, created)
+	require.Equal(t, 30, u.Age, "the user that won the race is returned")
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+	require.Zero(t, u.QueryPets().CountX(ctx), "entity is not bound to the committed transaction")
+}
+
+func TestImmutableMutation(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:immutable?mode=memory&cache=shared&_fk=1", opts)
+	defer client.Close()
+	ctx := context.Background()
+	crd := client.Card.Create().SetNumber("42").SetNumberHash("h42").SaveX(ctx)
+	// Immutable fields have no setters in the update builders,
+	// but they can still be set on the mutation by hooks.
+	client.Card.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if m.Op().Is(ent.OpUpdate | ent.OpUpdateOne) {
+				if err := m.SetField(card.FieldNumberHash, "h43"); err != nil {
+					return nil, err
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	_, err := crd.Update().SetName("a8m").Save(ctx)
+	require.True(t, ent.IsValidationError(err))
+	require.EqualError(t, err, `ent: immutable field "number_hash" cannot be updated`)
+	err = client.Card.Update().Where(card.ID(crd.ID)).SetName("a8m").Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	crd = client.Card.GetX(ctx, crd.ID)
+	require.Equal(t, "h42", crd.NumberHash)
+	require.Empty(t, crd.Name)
+}
+
+func TestRetryOnDeadlock(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:deadlock?mode=memory&cache=shared&_fk=1", opts)
+	defer client.Close()
+	ctx := context.Background()
+	var (
+		names    []string
+		failures int
+		fail     error
+	)
+	// Simulate a bulk insert that fails on its first attempts.
+	client.FileType.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			name, _ := m.(*ent.FileTypeMutation).Name()
+			names = append(names, name)
+			if failures > 0 {
+				failures--
+				return nil, fail
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	create := func() []*ent.FileTypeCreate {
+		return []*ent.FileTypeCreate{
+			client.FileType.Create().SetName("c"),
+			client.FileType.Create().SetName("a"),
+			client.FileType.Create().SetName("b"),
+		}
+	}
+
+	failures, fail = 2, errors.New("Error 1213: Deadlock found when trying to get lock; try restarting transaction")
+	nodes, err := client.FileType.CreateBulk(create()...).RetryOnDeadlock(2).Save(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "a", "b"}, []string{nodes[0].Name, nodes[1].Name, nodes[2].Name}, "nodes are returned in the order of the builders")
+	require.Equal(t, []string{"c", "a", "a", "b", "c"}, names, "builders are sorted by their unique keys on retry")
+	require.Equal(t, 3, client.FileType.Query().CountX(ctx))
+	client.FileType.Delete().ExecX(ctx)
+
+	failures, names = 3, nil
+	_, err = client.FileType.CreateBulk(create()...).RetryOnDeadlock(2).Save(ctx)
+	require.Equal(t, fail, err, "retries are exhausted")
+	require.Len(t, names, 3)
+
+	failures, names, fail = 1, nil, errors.New("UNIQUE constraint failed: file_types.name")
+	_, err = client.FileType.CreateBulk(create()...).RetryOnDeadlock(2).Save(ctx)
+	require.Equal(t, fail, err, "non-deadlock errors are not retried")
+	require.Len(t, names, 1)
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	failures, names, fail = 1, nil, errors.New("pq: deadlock detected")
+	_, err = tx.FileType.CreateBulk(create()...).RetryOnDeadlock(2).Save(ctx)
+	require.Equal(t, fail, err, "bulks in transactions are not retried")
+	require.Len(t, names, 1)
+	require.NoError(t, tx.Rollback())
+	require.Zero(t, client.FileType.Query().CountX(ctx))
+}
+
+func TestEdgeMissing(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:missing?mode=memory&cache=shared&_fk=1", opts)
+	defer client.Close()
+	// Foreign-keys are not enforced by this connection, and therefore,
+	// deleting the owners of the rows below leaves them as orphans.
+	db, err := sql.Open(dialect.SQLite, "file:missing?mode=memory&cache=shared&_fk=0")
+	require.NoError(t, err)
+	defer db.Close()
+	ctx := context.Background()
+	require := require.New(t)
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SetParent(a8m).SaveX(ctx)
+	alex := client.User.Create().SetName("alex").SetAge(2).SetParent(nati).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	xabi := client.Pet.Create().SetName("xabi").SetOwner(nati).SaveX(ctx)
+	client.Pet.Create().SetName("coco").SaveX(ctx)
+	require.False(client.Pet.Query().Where(pet.OwnerMissing()).ExistX(ctx))
+	require.False(client.User.Query().Where(user.ParentMissing()).ExistX(ctx))
+
+	_, err = db.ExecContext(ctx, "DELETE FROM users WHERE id = ?", a8m.ID)
+	require.NoError(err)
+	require.Equal([]string{"pedro"}, client.Pet.Query().Where(pet.OwnerMissing()).Select(pet.FieldName).StringsX(ctx), "rows without owner are not orphans")
+	require.Equal(nati.ID, client.User.Query().Where(user.ParentMissing()).OnlyXID(ctx), "self-reference edges are supported")
+	require.False(client.Pet.Query().Where(pet.OwnerMissing(), pet.Name("xabi")).ExistX(ctx))
+
+	n := client.Pet.Delete().Where(pet.Or(pet.OwnerMissing(), pet.ID(xabi.ID))).ExecX(ctx)
+	require.Equal(2, n)
+	require.Equal([]string{"coco"}, client.Pet.Query().Select(pet.FieldName).StringsX(ctx))
+	require.Equal(alex.ID, client.User.Query().Where(user.Not(user.ParentMissing()), user.HasParent()).OnlyXID(ctx))
+}
+
+type (
+	spanRecorder struct{ names []string }
+	nopSpan      struct{}
+)
+
+func (r *spanRecorder) Start(ctx context.Context, name string, _ ...entsql.SpanAttribute) (context.Context, entsql.Span) {
+	r.names = append(r.names, name)
+	return ctx, nopSpan{}
+}
+
+func (nopSpan) SetAttributes(...entsql.SpanAttribute) {}
+func (nopSpan) RecordError(error)                     {}
+func (nopSpan) End()                                  {}
+
+func TestTracer(t *testing.T) {
+	rec := &spanRecorder{}
+	client := enttest.Open(t, dialect.SQLite, "file:tracer?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(ent.Tracer(rec)))
+	defer client.Close()
+	ctx := context.Background()
+	rec.names = rec.names[:0]
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.Query().Where(user.Name("a8m")).AllX(ctx)
+	client.User.Query().CountX(ctx)
+	require.Equal(t, []string{"sql.Tx", "ent.User.Create", "ent.User.Query", "ent.User.Count"}, rec.names)
+
+	rec.names = rec.names[:0]
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.UpdateOne(a8m).SetAge(31).ExecX(ctx)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, []string{"sql.Tx", "ent.User.UpdateOne", "ent.User.UpdateOne"}, rec.names)
+}
+
+func TestTxObserver(t *testing.T) {
+	var events []ent.TxEvent
+	observer := func(_ context.Context, e ent.TxEvent) {
+		events = append(events, e)
+		if e.Op == ent.TxCommit {
+			panic("observers cannot break the commit")
+		}
+	}
+	client := enttest.Open(t, dialect.SQLite, "file:txobserver?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(ent.TxObserver(observer)))
+	defer client.Close()
+	ctx := context.Background()
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	var called bool
+	tx.OnCommit(func(error) {
+		called = true
+		panic("callbacks cannot break the commit")
+	})
+	a8m := tx.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	tx.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	tx.User.Query().CountX(ctx)
+	require.NoError(t, tx.Commit())
+	require.True(t, called)
+	require.Len(t, events, 2)
+	require.Equal(t, ent.TxBegin, events[0].Op)
+	require.Zero(t, events[0].Duration)
+	require.Empty(t, events[0].Labels)
+	require.Equal(t, ent.TxCommit, events[1].Op)
+	require.NotZero(t, events[1].Duration)
+	require.Equal(t, []string{pet.Label, user.Label}, events[1].Labels)
+	require.NoError(t, events[1].Err)
+	require.Equal(t, 1, client.User.Query().CountX(ctx))
+
+	events = events[:0]
+	tx, err = client.Tx(ctx)
+	require.NoError(t, err)
+	_, err = tx.User.Create().SetName("nati").Save(ctx)
+	require.Error(t, err, "missing required field")
+	require.NoError(t, tx.Rollback())
+	require.Len(t, events, 2)
+	require.Equal(t, ent.TxRollback, events[1].Op)
+	require.Empty(t, events[1].Labels, "failed mutations are not recorded")
+
+	events = events[:0]
+	create := client.User.Create().SetName("nati").SetAge(30).SetNickname("nati")
+	_, created, err := client.User.FindOrCreate(ctx, []predicate.User{user.Nickname("nati")}, create)
+	require.NoError(t, err)
+	require.True(t, created)
+	require.Len(t, events, 2, "transaction of FindOrCreate is observed")
+	require.Equal(t, ent.TxCommit, events[1].Op)
+	require.Equal(t, []string{user.Label}, events[1].Labels)
+	create.SetNickname("nati2").SaveX(ctx)
+	require.Equal(t, 3, client.User.Query().CountX(ctx), "builder is not bound to the committed transaction")
+}
+
+func TestPlanChanges(t *testing.T) {
+	drv, err := entsql.Open(dialect.SQLite, "file:plan?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer drv.Close()
+	ctx := context.Background()
+	stmts, err := migrate.PlanChanges(ctx, drv)
+	require.NoError(t, err)
+	require.NotEmpty(t, stmts)
+	require.True(t, strings.HasPrefix(stmts[0], "CREATE TABLE"), stmts[0])
+	again, err := migrate.PlanChanges(ctx, drv)
+	require.NoError(t, err)
+	require.Equal(t, stmts, again, "statements were not executed")
+
+	require.NoError(t, migrate.NewSchema(drv).Create(ctx))
+	stmts, err = migrate.PlanChanges(ctx, drv)
+	require.NoError(t, err)
+	require.Empty(t, stmts)
+}
+
+type metricsRecorder struct{ metrics []entsql.Metric }
+
+func (r *metricsRecorder) Collect(_ context.Context, m entsql.Metric) {
+	r.metrics = append(r.metrics, m)
+}
+
+func TestMetrics(t *testing.T) {
+	rec := &metricsRecorder{}
+	client := enttest.Open(t, dialect.SQLite, "file:metrics?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(ent.Metrics(rec)))
+	defer client.Close()
+	ctx := context.Background()
+	rec.metrics = rec.metrics[:0]
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.Query().Where(user.Name("a8m")).AllX(ctx)
+	require.Len(t, rec.metrics, 2)
+	require.Equal(t, "Create", rec.metrics[0].Operation)
+	require.Equal(t, int64(1), rec.metrics[0].RowsAffected)
+	require.Equal(t, "Query", rec.metrics[1].Operation)
+
+	rec.metrics = rec.metrics[:0]
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	tx.User.UpdateOne(a8m).SetAge(31).ExecX(ctx)
+	_, err = tx.Card.Create().SetNumber("1").SetOwner(a8m).Save(ctx)
+	require.NoError(t, err)
+	_, err = tx.Card.Create().SetNumber("2").SetOwner(a8m).Save(ctx)
+	require.Error(t, err)
+	require.NoError(t, tx.Rollback())
+	ops := make([]string, len(rec.metrics))
+	for i, m := range rec.metrics {
+		ops[i] = m.Entity + "." + m.Operation
+	}
+	require.Equal(t, []string{"User.UpdateOne", "User.UpdateOne", "Card.Create", "Card.Create"}, ops, "transaction statements are collected once")
+	require.Equal(t, entsql.UniqueError, rec.metrics[3].ErrorType)
+}
+
+// cancelDriver records the statements that are issued by the client, and cancels the context
+// after the given number of statements. The statements are executed without the context, in
+// order to ensure that the client stops issuing them by itself.
+type cancelDriver struct {
+	dialect.Driver
+	stmts  []string
+	after  int
+	cancel func()
+}
+
+// reset returns a context that is canceled after the given number of statements.
+func (d *cancelDriver) reset(after int) context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.stmts, d.after, d.cancel = nil, after, cancel
+	return ctx
+}
+
+func (d *cancelDriver) record(query string) {
+	if d.stmts = append(d.stmts, query); len(d.stmts) == d.after {
+		d.cancel()
+	}
+}
+
+func (d *cancelDriver) Exec(_ context.Context, query string, args, v interface{}) error {
+	d.record(query)
+	return d.Driver.Exec(context.Background(), query, args, v)
+}
+
+func (d *cancelDriver) Query(_ context.Context, query string, args, v interface{}) error {
+	d.record(query)
+	return d.Driver.Query(context.Background(), query, args, v)
+}
+
+func (d *cancelDriver) Tx(context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return &cancelTx{Tx: tx, drv: d}, nil
+}
+
+type cancelTx struct {
+	dialect.Tx
+	drv *cancelDriver
+}
+
+func (tx *cancelTx) Exec(_ context.Context, query string, args, v interface{}) error {
+	tx.drv.record(query)
+	return tx.Tx.Exec(context.Background(), query, args, v)
+}
+
+func (tx *cancelTx) Query(_ context.Context, query string, args, v interface{}) error {
+	tx.drv.record(query)
+	return tx.Tx.Query(context.Background(), query, args, v)
+}
+
+func TestCancel(t *testing.T) {
+	db, err := entsql.Open(dialect.SQLite, "file:cancel?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	drv := &cancelDriver{Driver: db}
+	client := ent.NewClient(ent.Driver(drv), ent.EagerLoadBatchSize(2))
+	defer client.Close()
+	ctx := context.Background()
+	require := require.New(t)
+	require.NoError(client.Schema.Create(ctx))
+	for i := 0; i < 5; i++ {
+		u := client.User.Create().SetName(fmt.Sprintf("u%d", i)).SetAge(i).SaveX(ctx)
+		client.Pet.Create().SetName(fmt.Sprintf("p%d", i)).SetOwner(u).SaveX(ctx)
+	}
+
+	// Users are loaded using one query, and their pets using 3 batches.
+	_, err = client.User.Query().WithPets().All(drv.reset(2))
+	require.True(errors.Is(err, context.Canceled), "unexpected error: %v", err)
+	require.Len(drv.stmts, 2, "eager-loading stops after the first batch")
+
+	// Bulks with conflict handling insert the nodes one by one.
+	_, _, err = client.User.CreateBulk(
+		client.User.Create().SetName("a").SetAge(1),
+		client.User.Create().SetName("b").SetAge(2),
+		client.User.Create().SetName("c").SetAge(3),
+	).OnConflict().DoNothing().Save(drv.reset(1))
+	require.True(errors.Is(err, context.Canceled), "unexpected error: %v", err)
+	require.Len(drv.stmts, 1, "bulk stops after the first insert")
+	require.Equal(5, client.User.Query().CountX(ctx), "bulk was rolled back")
+
+	_, err = client.FileType.Sync(drv.reset(1), []*ent.FileTypeCreate{
+		client.FileType.Create().SetName("a"),
+	}, []string{filetype.FieldName})
+	require.True(errors.Is(err, context.Canceled), "unexpected error: %v", err)
+	require.Len(drv.stmts, 1, "sync stops after reading the stored entities")
+	require.Zero(client.FileType.Query().CountX(ctx))
+
+	all := func(*entsql.Selector) {}
+	_, err = client.Purge(drv.reset(1), map[string]func(*entsql.Selector){pet.Label: all, user.Label: all})
+	require.True(errors.Is(err, context.Canceled), "unexpected error: %v", err)
+	require.Len(drv.stmts, 1, "purge stops after the first type")
+	require.Equal(5, client.Pet.Query().CountX(ctx), "purge was rolled back")
+}
+
+func TestSelectFields(t *testing.T) {
+	db, err := entsql.Open(dialect.SQLite, "file:select?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	var queries []string
+	drv := dialect.Debug(db, func(v ...interface{}) { queries = append(queries, fmt.Sprint(v...)) })
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require := require.New(t)
+	require.NoError(client.Schema.Create(ctx))
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	crd := client.Card.Create().SetNumber("1234").SetName("a8m").SetType(schema.CardTypeVisa).SetOwner(a8m).SaveX(ctx)
+
+	queries = nil
+	cards := client.Card.Query().Select(card.FieldID, card.FieldType).AllX(ctx)
+	require.Len(queries, 1)
+	require.Contains(queries[0], "query=SELECT DISTINCT `cards`.`id`, `cards`.`type` FROM `cards`")
+	require.Len(cards, 1)
+	require.Equal(crd.ID, cards[0].ID)
+	require.Equal(schema.CardTypeVisa, cards[0].Type)
+	require.Empty(cards[0].Number, "unselected fields keep their zero values")
+	require.Empty(cards[0].Name)
+	require.True(cards[0].CreateTime.IsZero())
+
+	// The id is always selected, and the foreign-keys are
+	// selected for eager-loading the edges of the entities.
+	queries = nil
+	cards = client.Card.Query().WithOwner().Select(card.FieldNumber).AllX(ctx)
+	require.Contains(queries[0], "query=SELECT DISTINCT `cards`.`id`, `cards`.`number`, `cards`.`user_card` FROM `cards`")
+	require.Equal(crd.ID, cards[0].ID)
+	require.Equal("1234", cards[0].Number)
+	require.Empty(cards[0].Type)
+	require.Equal(a8m.ID, cards[0].Edges.Owner.ID)
+
+	_, err = client.Card.Query().Select("unknown").All(ctx)
+	require.EqualError(err, `ent: invalid field "unknown" for query`)
+	query := client.Card.Query()
+	require.Empty(query.Select(card.FieldType).AllX(ctx)[0].Number)
+	require.Equal("1234", query.AllX(ctx)[0].Number, "the query is not changed by select")
+}
+
+func TestQueryBudget(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:budget?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(ent.EagerLoadBatchSize(2)))
+	defer client.Close()
+	ctx := context.Background()
+	require := require.New(t)
+	users := client.User.CreateBulk(
+		client.User.Create().SetName("a8m").SetAge(30),
+		client.User.Create().SetName("nati").SetAge(28),
+		client.User.Create().SetName("ariel").SetAge(32),
+	).SaveX(ctx)
+	for _, u := range users {
+		client.Pet.Create().SetName(u.Name + "-pet").SetOwner(u).SaveX(ctx)
+	}
+
+	// 1 query for the users, and 2 batches for eager-loading their pets.
+	bctx := ent.WithQueryBudget(ctx, 3)
+	users, err := client.User.Query().WithPets().All(bctx)
+	require.NoError(err)
+	require.Len(users, 3)
+	used, ok := ent.QueryBudgetUsed(bctx)
+	require.True(ok)
+	require.Equal(3, used)
+	bctx = ent.WithQueryBudget(ctx, 2)
+	_, err = client.User.Query().WithPets().All(bctx)
+	require.True(ent.IsQueryBudgetExceeded(err))
+	var berr *ent.QueryBudgetExceededError
+	require.True(errors.As(err, &berr))
+	require.Equal(2, berr.Max)
+
+	// Bulk operations are counted on the budget as well.
+	bctx = ent.WithQueryBudget(ctx, 0)
+	_, err = client.User.CreateBulk(client.User.Create().SetName("alex").SetAge(20)).Save(bctx)
+	require.True(ent.IsQueryBudgetExceeded(err))
+	require.Zero(client.User.Query().Where(user.Name("alex")).CountX(ctx), "statements are not executed once the budget was used")
+	_, err = client.User.Update().SetAge(1).Save(ent.WithQueryBudget(ctx, 0))
+	require.True(ent.IsQueryBudgetExceeded(err))
+	require.Zero(client.User.Query().Where(user.Age(1)).CountX(ctx))
+
+	// Contexts without a budget are not limited.
+	_, ok = ent.QueryBudgetUsed(ctx)
+	require.False(ok)
+	require.Len(client.User.Query().WithPets().AllX(ctx), 3)
+}
+
+func TestReadConsistency(t *testing.T) {
+	primary, err := entsql.Open(dialect.SQLite, "file:primary?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	replica, err := entsql.Open(dialect.SQLite, "file:replica?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	ctx := context.Background()
+	require.NoError(t, ent.NewClient(ent.Driver(replica)).Schema.Create(ctx))
+	client := ent.NewClient(ent.Driver(entsql.Replica(primary, replica)))
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx), "migration is executed by the primary")
+
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.Card.Create().SetNumber("1").SetOwner(a8m).SaveX(ctx)
+	require.Zero(t, client.User.Query().CountX(ctx), "reads are routed to the replica")
+	require.Equal(t, 1, client.User.Query().Primary().CountX(ctx))
+	require.Equal(t, a8m.ID, client.User.Query().ReadConsistency(entsql.ReadPrimary).OnlyX(ctx).ID)
+	require.Equal(t, []string{"a8m"}, client.User.Query().Primary().Select(user.FieldName).StringsX(ctx))
+
+	u := client.User.Query().Primary().WithCard().OnlyX(ctx)
+	require.NotNil(t, u.Edges.Card, "eager-loading queries are executed by the primary")
+	require.Equal(t, 1, u.QueryCard().CountX(ctx), "entities inherit the consistency of their query")
+	require.Zero(t, client.Card.Query().CountX(ctx))
+	require.Equal(t, 1, client.Card.Query().CountX(entsql.WithReadConsistency(ctx, entsql.ReadPrimary)))
+}
+
 func TestMySQL(t *testing.T) {
 	t.Parallel()
 	for version, port := range map[string]int{"56": 3306, "57": 3307, "8": 3308} {
@@ -98,11 +707,52 @@ var (
 		Paging,
 		Select,
 		Delete,
+		Purge,
 		Relation,
 		Predicate,
 		AddValues,
 		ClearFields,
+		UpdateOneWhere,
+		UpdateReturningIDs,
 		UniqueConstraint,
+		CreateBulk,
+		Touch,
+		IDInQuery,
+		CloneEntity,
+		LoadEdges,
+		UpdateFromDiff,
+		DefaultExpr,
+		CountDistinct,
+		Sync,
+		WhereFilter,
+		CreateBulkFrom,
+		OnConflictMerge,
+		Fingerprint,
+		EagerLoadLimit,
+		AddEdgesByField,
+		AllMaps,
+		FieldsPredicates,
+		UpdateByIDMap,
+		ConstraintFields,
+		DumpGraph,
+		NullSafePredicates,
+		LoadEdgesFor,
+		DryRun,
+		SelectExpr,
+		DebugFields,
+		TimeBucket,
+		UpdateDeleteLimit,
+		KeyValue,
+		TimeLocation,
+		NillableTime,
+		SaveID,
+		FindOrCreate,
+		GetMany,
+		Predicates,
+		CreateFromSelect,
+		CopyToCreate,
+		Stream,
+		Paginate,
 		O2OTwoTypes,
 		O2OSameType,
 		O2OSelfRef,
@@ -295,6 +945,16 @@ func Select(t *testing.T, client *ent.Client) {
 		ScanX(ctx, &v)
 	require.Equal([]int{30, 30, 30}, []int{v[0].Age, v[1].Age, v[2].Age})
 	require.Equal([]string{"bar", "baz", "foo"}, []string{v[0].Name, v[1].Name, v[2].Name})
+
+	t.Log("select one field into primitive slices")
+	ids := client.User.Query().Order(ent.Asc(user.FieldID)).Select(user.FieldID).IntsX(ctx)
+	require.Equal(client.User.Query().Order(ent.Asc(user.FieldID)).IDsX(ctx), ids)
+	crd := client.Card.Create().SetNumber("1234").SaveX(ctx)
+	times := client.Card.Query().Select(card.FieldCreateTime).TimesX(ctx)
+	require.Len(times, 1)
+	require.True(crd.CreateTime.Equal(times[0]))
+	_, err := client.User.Query().Select(user.FieldAge, user.FieldName).Ints(ctx)
+	require.Error(err, "more than 1 field is selected")
 }
 
 func Predicate(t *testing.T, client *ent.Client) {
@@ -431,6 +1091,49 @@ func Delete(t *testing.T, client *ent.Client) {
 	require.Equal(3, affected)
 }
 
+func Purge(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(nati).SaveX(ctx)
+	client.Card.Create().SetNumber("1").SetOwner(a8m).SaveX(ctx)
+	owned := func(column string, id int) func(*entsql.Selector) {
+		return func(s *entsql.Selector) {
+			s.Where(entsql.EQ(s.C(column), id))
+		}
+	}
+
+	report, err := client.Purge(ctx, map[string]func(*entsql.Selector){
+		user.Label: owned(user.FieldID, a8m.ID),
+		pet.Label:  owned(pet.OwnerColumn, a8m.ID),
+		card.Label: owned(card.OwnerColumn, a8m.ID),
+	})
+	require.NoError(err)
+	require.Equal(map[string]int{user.Label: 1, pet.Label: 1, card.Label: 1}, report, "pets and cards are deleted before their owners")
+	require.Equal(1, client.User.Query().CountX(ctx))
+	require.Equal(1, client.Pet.Query().CountX(ctx))
+	require.Zero(client.Card.Query().CountX(ctx))
+
+	_, err = client.Purge(ctx, map[string]func(*entsql.Selector){"unknown": owned(user.FieldID, nati.ID)})
+	require.Error(err)
+	_, err = client.Purge(ctx, map[string]func(*entsql.Selector){
+		pet.Label:  owned(pet.OwnerColumn, nati.ID),
+		user.Label: owned("unknown", nati.ID),
+	})
+	require.Error(err)
+	require.Equal(1, client.Pet.Query().CountX(ctx), "purge is rolled back on error")
+
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	report, err = tx.Client().Purge(ctx, map[string]func(*entsql.Selector){pet.Label: owned(pet.OwnerColumn, nati.ID)})
+	require.NoError(err)
+	require.Equal(map[string]int{pet.Label: 1}, report)
+	require.NoError(tx.Rollback())
+	require.Equal(1, client.Pet.Query().CountX(ctx), "purge is executed in the transaction of the client")
+}
+
 func Relation(t *testing.T, client *ent.Client) {
 	require := require.New(t)
 	ctx := context.Background()
@@ -580,6 +1283,17 @@ func Relation(t *testing.T, client *ent.Client) {
 	require.Error(err, "max_users validator failed")
 	_, err = client.Group.UpdateOne(grp).SetMaxUsers(-10).Save(ctx)
 	require.Error(err, "max_users validator failed")
+	require.True(ent.IsValidationError(err))
+	_, err = client.Group.UpdateOne(grp).ClearInfo().Save(ctx)
+	require.True(ent.IsValidationError(err), "clearing a required edge")
+	_, err = client.Group.UpdateOne(grp).SetInfo(info).ClearInfo().Save(ctx)
+	verr := &ent.ValidationError{}
+	require.True(errors.As(err, &verr), "setting and clearing an edge in the same mutation")
+	require.Equal("info", verr.Name)
+	_, err = client.Group.Update().Where(group.ID(grp.ID)).SetInfo(info).ClearInfo().Save(ctx)
+	require.True(ent.IsValidationError(err))
+	require.Equal(info.ID, grp.QueryInfo().OnlyXID(ctx), "edge was not changed")
+	client.Group.UpdateOne(grp).ClearInfo().SetInfo(info).ExecX(ctx)
 
 	t.Log("query using edge-with predicate")
 	require.Len(usr.QueryGroups().Where(group.HasInfoWith(groupinfo.Desc("group info"))).AllX(ctx), 1)
@@ -618,6 +1332,13 @@ func Relation(t *testing.T, client *ent.Client) {
 	require.Len(client.User.Query().Where(user.NameContains("a8")).AllX(ctx), 1)
 	require.Len(client.User.Query().Where(user.NameHasPrefix("a8")).AllX(ctx), 1)
 	require.Len(client.User.Query().Where(user.Or(user.NameHasPrefix("a8"), user.NameHasSuffix("eta"))).AllX(ctx), 2)
+	require.Len(client.User.Query().Where(user.NameNotContains("a8")).AllX(ctx), 2)
+	require.Len(client.User.Query().Where(user.NameNotHasPrefix("a8")).AllX(ctx), 2)
+	require.Len(client.User.Query().Where(user.NameNotHasPrefix("a8"), user.NameNotHasSuffix("eta")).AllX(ctx), 1)
+	require.Len(client.User.Query().Where(user.NameEqualFold("A8M")).AllX(ctx), 1)
+	require.Len(client.User.Query().Where(user.NameContainsFold("E")).AllX(ctx), 2)
+	require.Len(client.User.Query().Where(user.NameHasPrefixFold("PE")).AllX(ctx), 1)
+	require.Len(client.User.Query().Where(user.Or(user.NameHasPrefixFold("A8"), user.NameHasSuffixFold("ETA"))).AllX(ctx), 2)
 
 	t.Log("group-by one field")
 	names, err := client.User.Query().GroupBy(user.FieldName).Strings(ctx)
@@ -673,6 +1394,95 @@ func Relation(t *testing.T, client *ent.Client) {
 	for i := range v2 {
 		require.Equal(2, v2[i].Total)
 	}
+
+	t.Log("group by with having")
+	var v3 []struct {
+		Name  string `json:"name"`
+		Age   int    `json:"age"`
+		Count int    `json:"count"`
+	}
+	client.User.Query().
+		GroupBy(user.FieldName, user.FieldAge).
+		Aggregate(ent.Count()).
+		Having(entsql.GT("count", 1)).
+		ScanX(ctx, &v3)
+	require.Len(v3, 2)
+	for i := range v3 {
+		require.Equal(2, v3[i].Count)
+	}
+	v2 = nil
+	client.User.Query().
+		GroupBy(user.FieldName).
+		Aggregate(ent.As(ent.Sum(user.FieldAge), "total")).
+		Having(entsql.LT("total", usr.Age*2), entsql.NEQ(user.FieldName, neta.Name)).
+		ScanX(ctx, &v2)
+	require.Len(v2, 1)
+	require.Equal(child.Age*2+1, v2[0].Total)
+	err = client.User.Query().
+		GroupBy(user.FieldName).
+		Aggregate(ent.Count()).
+		Having(entsql.GT(user.FieldAge, 1)).
+		Scan(ctx, &v3)
+	require.Error(err, "age is not a grouped column")
+}
+
+func UpdateOneWhere(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	crd := client.Card.Create().SetNumber("1001").SetName("pending").SaveX(ctx)
+
+	t.Log("update entity that matches the predicates")
+	crd = client.Card.UpdateOne(crd).Where(card.Name("pending")).SetName("active").SaveX(ctx)
+	require.Equal("active", crd.Name)
+
+	t.Log("update entity that does not match the predicates")
+	_, err := client.Card.UpdateOne(crd).Where(card.Name("pending")).SetName("canceled").Save(ctx)
+	require.True(ent.IsNotFound(err))
+	require.Equal("active", client.Card.GetX(ctx, crd.ID).Name)
+
+	t.Log("update entity with its current values")
+	crd = crd.Update().Where(card.Name("active")).SetName("active").SaveX(ctx)
+	require.Equal("active", crd.Name)
+
+	t.Log("update entity edges")
+	usr := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	err = crd.Update().Where(card.Not(card.HasOwner())).SetOwner(usr).Exec(ctx)
+	require.NoError(err)
+	err = crd.Update().Where(card.Not(card.HasOwner())).SetOwner(usr).Exec(ctx)
+	require.True(ent.IsNotFound(err))
+	spec := client.Spec.Create().SaveX(ctx)
+	err = crd.Update().Where(card.Name("pending")).AddSpec(spec).Exec(ctx)
+	require.True(ent.IsNotFound(err))
+	require.False(crd.QuerySpec().ExistX(ctx))
+	crd.Update().Where(card.Name("active")).AddSpec(spec).ExecX(ctx)
+	require.True(crd.QuerySpec().ExistX(ctx))
+}
+
+func UpdateReturningIDs(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(2).SaveX(ctx)
+
+	ids, err := client.User.Update().Where(user.AgeGT(10)).AddAge(1).SaveReturningIDs(ctx)
+	require.NoError(err)
+	require.ElementsMatch([]int{a8m.ID, nati.ID}, ids)
+	require.Equal(31, client.User.GetX(ctx, a8m.ID).Age)
+
+	ids, err = client.User.Update().Where(user.AgeGT(100)).AddAge(1).SaveReturningIDs(ctx)
+	require.NoError(err)
+	require.Empty(ids)
+	n := client.User.Update().Where(user.AgeGT(10)).AddAge(1).SaveX(ctx)
+	require.Equal(2, n, "default path is not changed")
+
+	update := client.User.Update().Where(user.Name("a8m")).AddAge(1)
+	ids, err = update.SaveReturningIDs(ctx)
+	require.NoError(err)
+	require.Equal([]int{a8m.ID}, ids)
+	require.Equal(1, update.SaveX(ctx))
+	require.Equal([]int{a8m.ID}, ids, "builder does not scan into the ids of a previous call")
+	require.Equal(34, client.User.GetX(ctx, a8m.ID).Age)
 }
 
 func ClearFields(t *testing.T, client *ent.Client) {
@@ -696,38 +1506,1440 @@ func ClearFields(t *testing.T, client *ent.Client) {
 	t.Log("revert previous set")
 	img = img.Update().SetUser("a8m").ClearUser().SaveX(ctx)
 	require.Nil(t, img.User)
+
+	t.Log("unset previous changes")
+	img = img.Update().SetUser("a8m").SetGroup("Github").SaveX(ctx)
+	img = img.Update().
+		SetUser("nati").UnsetUser().
+		ClearGroup().UnsetGroup().
+		AddSize(10).UnsetSize().
+		SetName("bar").
+		SaveX(ctx)
+	img = client.File.GetX(ctx, img.ID)
+	require.Equal(t, "a8m", *img.User)
+	require.Equal(t, "Github", img.Group)
+	require.Equal(t, 100, img.Size)
+	require.Equal(t, "bar", img.Name)
+	n := client.File.Update().SetGroup("GitLab").UnsetGroup().SetName("baz").SaveX(ctx)
+	require.Equal(t, 1, n)
+	require.Equal(t, "Github", client.File.GetX(ctx, img.ID).Group)
 }
 
-func UniqueConstraint(t *testing.T, client *ent.Client) {
+func CreateBulk(t *testing.T, client *ent.Client) {
 	require := require.New(t)
 	ctx := context.Background()
 
-	t.Log("unique constraint violation on 1 field")
-	foo := client.User.Create().SetAge(1).SetName("foo").SetNickname("baz").SaveX(ctx)
-	_, err := client.User.Create().SetAge(1).SetName("bar").SetNickname("baz").Save(ctx)
+	t.Log("create bulk of users with edges")
+	inf := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+	grp := client.Group.Create().SetName("Github").SetExpire(time.Now()).SetInfo(inf).SaveX(ctx)
+	users := client.User.CreateBulk(
+		client.User.Create().SetAge(1).SetName("a8m").SetNickname("a8m").AddGroups(grp),
+		client.User.Create().SetAge(2).SetName("nati").SetNickname("nati"),
+	).SaveX(ctx)
+	require.Len(users, 2)
+	require.NotEqual(users[0].ID, users[1].ID)
+	require.Equal("a8m", client.User.GetX(ctx, users[0].ID).Name)
+	require.Equal("nati", client.User.GetX(ctx, users[1].ID).Name)
+	require.Equal(grp.ID, users[0].QueryGroups().OnlyXID(ctx))
+
+	t.Log("create bulk with conflicting rows")
+	_, err := client.User.CreateBulk(
+		client.User.Create().SetAge(3).SetName("a8m").SetNickname("a8m"),
+	).Save(ctx)
 	require.True(ent.IsConstraintError(err))
-	bar := client.User.Create().SetAge(1).SetName("bar").SetNickname("bar").SetPhone("1").SaveX(ctx)
+	inserted, skipped, err := client.User.CreateBulk(
+		client.User.Create().SetAge(3).SetName("a8m").SetNickname("a8m"),
+		client.User.Create().SetAge(4).SetName("ariel").SetNickname("ariel"),
+		client.User.Create().SetAge(5).SetName("nati").SetNickname("nati"),
+	).OnConflict().DoNothing().Save(ctx)
+	require.NoError(err)
+	require.Equal(2, skipped)
+	require.Len(inserted, 1)
+	require.Equal("ariel", inserted[0].Name)
+	require.Equal("ariel", client.User.GetX(ctx, inserted[0].ID).Nickname)
+	require.Equal(3, client.User.Query().CountX(ctx))
 
-	t.Log("unique constraint violation on 2 fields")
-	_, err = client.User.Create().SetAge(1).SetName("baz").SetNickname("bar").SetPhone("1").Save(ctx)
-	require.True(ent.IsConstraintError(err))
-	_, err = client.User.Create().SetAge(1).SetName("baz").SetNickname("qux").SetPhone("1").Save(ctx)
-	require.True(ent.IsConstraintError(err))
-	_, err = client.User.Create().SetAge(1).SetName("baz").SetNickname("bar").SetPhone("2").Save(ctx)
-	require.True(ent.IsConstraintError(err))
-	client.User.Create().SetAge(1).SetName("baz").SetNickname("qux").SetPhone("2").SaveX(ctx)
-	_, err = client.User.UpdateOne(foo).SetNickname("bar").SetPhone("1").Save(ctx)
-	require.True(ent.IsConstraintError(err))
-	_, err = client.User.UpdateOne(foo).SetNickname("bar").SetPhone("2").Save(ctx)
-	require.True(ent.IsConstraintError(err))
+	_, _, err = client.User.CreateBulk(client.User.Create().SetAge(1).SetName("a8m")).OnConflict().Save(ctx)
+	require.Error(err, "conflict action is required")
 
-	t.Log("o2o unique constraint on creation")
-	dan := client.User.Create().SetAge(1).SetName("dan").SetNickname("dan").SetSpouse(foo).SaveX(ctx)
-	require.Equal(dan.Name, foo.QuerySpouse().OnlyX(ctx).Name)
-	_, err = client.User.Create().SetAge(1).SetName("b").SetSpouse(foo).Save(ctx)
-	require.True(ent.IsConstraintError(err))
+	t.Log("upsert bulk and read back the final rows")
+	upserted, _, err := client.User.CreateBulk(
+		client.User.Create().SetAge(30).SetName("nati").SetNickname("nati"),
+		client.User.Create().SetAge(6).SetName("alex").SetNickname("alex"),
+		client.User.Create().SetAge(40).SetName("a8m").SetNickname("a8m"),
+	).OnConflict(user.FieldNickname).UpdateNewValues().Save(ctx)
+	require.NoError(err)
+	require.Len(upserted, 3)
+	require.Equal(users[1].ID, upserted[0].ID)
+	require.Equal(users[0].ID, upserted[2].ID)
+	for i, age := range []int{30, 6, 40} {
+		require.Equal(age, upserted[i].Age)
+		require.Equal(age, client.User.GetX(ctx, upserted[i].ID).Age)
+	}
+	require.Equal("unknown", upserted[1].Last, "default values are read back")
+	require.Equal(4, client.User.Query().CountX(ctx))
+	_, _, err = client.User.CreateBulk(client.User.Create().SetAge(1).SetName("a8m")).OnConflict().UpdateNewValues().Save(ctx)
+	require.Error(err, "conflict columns are required")
+
+	t.Log("create with a partial index conflict target")
+	inserted, skipped, err = client.User.Create().SetAge(7).SetName("alex").SetNickname("alex").
+		OnConflict(entsql.ConflictColumnsWhere([]string{user.FieldNickname}, entsql.NotNull(user.FieldNickname))).
+		DoNothing().
+		Save(ctx)
+	require.NoError(err)
+	require.Equal(1, skipped)
+	require.Empty(inserted)
+	upserted, _, err = client.User.CreateBulk(client.User.Create().SetAge(7).SetName("alex").SetNickname("alex")).
+		OnConflict(user.FieldNickname).
+		Target(entsql.ConflictColumnsWhere([]string{user.FieldNickname}, entsql.NotNull(user.FieldNickname))).
+		UpdateNewValues().
+		Save(ctx)
+	require.NoError(err)
+	require.Len(upserted, 1)
+	require.Equal(7, client.User.GetX(ctx, upserted[0].ID).Age)
+	require.Equal(4, client.User.Query().CountX(ctx))
+
+	t.Log("validate bulk before save")
+	bulk := client.User.CreateBulk(
+		client.User.Create().SetAge(6).SetName("alex"),
+		client.User.Create().SetName("noage"),
+		client.User.Create().SetAge(7),
+	)
+	err = bulk.Validate()
+	require.Error(err)
+	var verr *ent.BulkValidationError
+	require.True(errors.As(err, &verr))
+	require.Len(verr.Errors, 2)
+	require.True(ent.IsValidationError(verr.Errors[1]))
+	require.True(ent.IsValidationError(verr.Errors[2]))
+	require.Contains(err.Error(), "builder 1")
+	require.Equal(4, client.User.Query().CountX(ctx), "no rows were inserted")
+	require.NoError(client.User.CreateBulk(client.User.Create().SetAge(6).SetName("alex")).Validate())
+
+	t.Log("check references before save")
+	owner := users[0]
+	_, err = client.Card.CreateBulk(
+		client.Card.Create().SetNumber("1").SetOwnerID(owner.ID),
+		client.Card.Create().SetNumber("2").SetOwnerID(owner.ID+100),
+		client.Card.Create().SetNumber("3").SetOwnerID(owner.ID+200),
+	).CheckRefs().Save(ctx)
+	require.Error(err)
+	var rerr *ent.ReferenceError
+	require.True(errors.As(err, &rerr))
+	require.Equal("Card", rerr.Type)
+	require.Equal([]interface{}{owner.ID + 100, owner.ID + 200}, rerr.Missing[card.EdgeOwner])
+	require.Zero(client.Card.Query().CountX(ctx), "no rows were inserted")
+	cards := client.Card.CreateBulk(
+		client.Card.Create().SetNumber("1").SetOwnerID(owner.ID),
+		client.Card.Create().SetNumber("2"),
+	).CheckRefs().SaveX(ctx)
+	require.Len(cards, 2)
+	require.Equal(owner.ID, cards[0].QueryOwner().OnlyXID(ctx))
+}
 
-	t.Log("o2m/m2o unique constraint on creation")
+func Touch(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	crd := client.Card.Create().SetNumber("1234").SetName("a8m").SaveX(ctx)
+	time.Sleep(time.Millisecond)
+	require.NoError(client.Card.Touch(ctx, crd.ID))
+	touched := client.Card.GetX(ctx, crd.ID)
+	require.False(touched.UpdateTime.Before(crd.UpdateTime))
+	require.Equal(crd.Name, touched.Name)
+	require.Equal(crd.CreateTime.Unix(), touched.CreateTime.Unix())
+	require.NoError(client.Card.Touch(ctx, crd.ID), "touch twice in the same second")
+	client.Card.DeleteOne(crd).ExecX(ctx)
+	require.True(ent.IsNotFound(client.Card.Touch(ctx, crd.ID)))
+}
+
+func IDInQuery(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetAge(30).SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetAge(28).SetName("nati").SaveX(ctx)
+	client.Card.Create().SetNumber("1").SetOwner(a8m).SaveX(ctx)
+	client.Card.Create().SetNumber("2").SetOwner(nati).SaveX(ctx)
+	client.Card.Create().SetNumber("3").SaveX(ctx)
+
+	owners := client.User.Query().Where(user.AgeGT(29), user.NameHasPrefix("a"))
+	crd := client.Card.Query().Where(card.OwnerIDIn(owners)).OnlyX(ctx)
+	require.Equal("1", crd.Number)
+	require.Equal(1, client.Card.Query().Where(card.Not(card.OwnerIDIn(owners))).CountX(ctx), "cards without owner are not matched")
+
+	n, err := client.Card.Update().Where(card.OwnerIDIn(client.User.Query().Where(user.Name("nati")))).SetName("nati").Save(ctx)
+	require.NoError(err)
+	require.Equal(1, n)
+	require.Equal("2", client.Card.Query().Where(card.Name("nati")).OnlyX(ctx).Number)
+
+	n, err = client.Card.Delete().Where(card.OwnerIDIn(client.User.Query().Where(user.AgeLT(40)))).Exec(ctx)
+	require.NoError(err)
+	require.Equal(2, n)
+	require.Equal("3", client.Card.Query().OnlyX(ctx).Number)
+
+	_, err = client.Card.Query().Where(card.OwnerIDIn(a8m.QueryFriends())).All(ctx)
+	require.Error(err, "traversal queries cannot be embedded")
+}
+
+func CloneEntity(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetAge(30).SetName("a8m").SaveX(ctx)
+	client.User.Create().SetAge(28).SetName("nati").AddFriends(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+
+	usr := client.User.Query().Where(user.ID(a8m.ID)).WithPets().WithFriends(func(q *ent.UserQuery) { q.WithFriends() }).OnlyX(ctx)
+	// Make the graph cyclic.
+	usr.Edges.Friends[0].Edges.Friends[0] = usr
+	clone := usr.Clone()
+	require.Equal(usr.String(), clone.String())
+	clone.Name = "Ariel"
+	clone.Edges.Pets[0].Name = "xabi"
+	clone.Edges.Friends = append(clone.Edges.Friends, clone)
+	require.Equal("a8m", usr.Name)
+	require.Equal("pedro", usr.Edges.Pets[0].Name)
+	require.Len(usr.Edges.Friends, 1)
+
+	friend := clone.Edges.Friends[0]
+	require.False(usr.Edges.Friends[0] == friend)
+	require.True(clone == friend.Edges.Friends[0], "cycles are preserved in the copy")
+	_, err := clone.Edges.PetsOrErr()
+	require.NoError(err)
+	_, err = clone.Edges.GroupsOrErr()
+	require.True(ent.IsNotLoaded(err))
+}
+
+func LoadEdges(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetAge(30).SetName("a8m").SaveX(ctx)
+	crd := client.Card.Create().SetNumber("102030").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+
+	usr := client.User.GetX(ctx, a8m.ID)
+	_, err := usr.Edges.CardOrErr()
+	require.True(ent.IsNotLoaded(err))
+	err = usr.LoadEdges(ctx, client, user.EdgeCard, "unknown")
+	require.EqualError(err, `ent: unknown edge "unknown" for type User`)
+	_, err = usr.Edges.CardOrErr()
+	require.True(ent.IsNotLoaded(err), "no edge is loaded on error")
+
+	require.NoError(usr.LoadEdges(ctx, client, user.EdgeCard, user.EdgePets, user.EdgeSpouse))
+	c, err := usr.Edges.CardOrErr()
+	require.NoError(err)
+	require.Equal(crd.ID, c.ID)
+	pets, err := usr.Edges.PetsOrErr()
+	require.NoError(err)
+	require.Len(pets, 1)
+	_, err = usr.Edges.SpouseOrErr()
+	require.True(ent.IsNotFound(err), "edge was loaded, but was not found")
+	_, err = usr.Edges.GroupsOrErr()
+	require.True(ent.IsNotLoaded(err))
+}
+
+func UpdateFromDiff(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetAge(30).SetName("a8m").SaveX(ctx)
+	nati := client.User.Create().SetAge(28).SetName("nati").SaveX(ctx)
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	crd := client.Card.Create().SetNumber("102030").SetName("a8m").SetExpiresAt(expires).SetOwner(a8m).SaveX(ctx)
+	crd = client.Card.Query().Where(card.ID(crd.ID)).WithOwner().OnlyX(ctx)
+
+	same, err := client.Card.UpdateFromDiff(ctx, crd, crd.Clone())
+	require.NoError(err)
+	require.True(same == crd, "no statement is executed if nothing was changed")
+	require.True(crd.UpdateTime.Equal(client.Card.GetX(ctx, crd.ID).UpdateTime))
+
+	modified := crd.Clone()
+	modified.Name = "nati"
+	modified.ExpiresAt = nil
+	modified.Edges.Owner = nati
+	// A concurrent writer changes a field that is not modified.
+	client.Card.UpdateOneID(crd.ID).SetType(schema.CardTypeVisa).ExecX(ctx)
+	updated, err := client.Card.UpdateFromDiff(ctx, crd, modified)
+	require.NoError(err)
+	require.Equal("nati", updated.Name)
+	require.Nil(updated.ExpiresAt)
+	stored := client.Card.GetX(ctx, crd.ID)
+	require.Equal("nati", stored.Name)
+	require.Nil(stored.ExpiresAt)
+	require.Equal(schema.CardTypeVisa, stored.Type, "untouched fields are not overridden")
+	require.Equal(nati.ID, stored.QueryOwner().OnlyXID(ctx))
+
+	modified = crd.Clone()
+	modified.ID++
+	_, err = client.Card.UpdateFromDiff(ctx, crd, modified)
+	require.Error(err)
+}
+
+func DefaultExpr(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	it := client.Item.Create().SaveX(ctx)
+	require.False(it.CreatedAt.IsZero(), "value should be read back from the database")
+	require.WithinDuration(time.Now(), it.CreatedAt, time.Minute)
+	require.True(it.CreatedAt.Equal(client.Item.GetX(ctx, it.ID).CreatedAt))
+	require.Equal(1, it.Priority, "value should be read back from the database")
+
+	created := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	it = client.Item.Create().SetCreatedAt(created).SaveX(ctx)
+	require.True(created.Equal(it.CreatedAt))
+	require.True(created.Equal(client.Item.GetX(ctx, it.ID).CreatedAt))
+	require.Equal(1, it.Priority)
+
+	it = client.Item.Create().SetPriority(5).SaveX(ctx)
+	require.Equal(5, it.Priority)
+	require.False(it.CreatedAt.IsZero())
+	require.Equal(5, client.Item.GetX(ctx, it.ID).Priority)
+}
+
+func CountDistinct(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.Card.CreateBulk(
+		client.Card.Create().SetNumber("1").SetName("a8m").SetType(schema.CardTypeVisa),
+		client.Card.Create().SetNumber("2").SetName("a8m").SetType(schema.CardTypeVisa),
+		client.Card.Create().SetNumber("3").SetName("nati").SetType(schema.CardTypeVisa),
+		client.Card.Create().SetNumber("4").SetName("nati").SetType(schema.CardTypeMC),
+		client.Card.Create().SetNumber("5"),
+	).SaveX(ctx)
+	require.Equal(2, client.Card.Query().CountDistinctX(ctx, card.FieldType), "NULL values are not counted")
+	require.Equal(1, client.Card.Query().Where(card.Name("a8m")).CountDistinctX(ctx, card.FieldType))
+	require.Equal(5, client.Card.Query().CountDistinctX(ctx, card.FieldID))
+
+	n, err := client.Card.Query().CountDistinct(ctx, card.FieldType, card.FieldName)
+	if err != nil {
+		require.Contains(err.Error(), "not supported by SQLite")
+	} else {
+		require.Equal(3, n)
+	}
+	_, err = client.Card.Query().CountDistinct(ctx, "unknown")
+	require.Error(err)
+}
+
+func Sync(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	keys := []string{file.FieldName, file.FieldUser}
+	res, err := client.File.Sync(ctx, []*ent.FileCreate{
+		client.File.Create().SetName("a").SetUser("u").SetSize(1),
+		client.File.Create().SetName("b").SetUser("u").SetSize(2),
+	}, keys)
+	require.NoError(err)
+	require.Equal(ent.SyncResult{Inserted: 2}, res)
+	res, err = client.File.Sync(ctx, []*ent.FileCreate{
+		client.File.Create().SetName("a").SetUser("u").SetSize(1),
+		client.File.Create().SetName("b").SetUser("u").SetSize(2),
+	}, keys)
+	require.NoError(err)
+	require.Equal(ent.SyncResult{Unchanged: 2}, res)
+
+	res, err = client.File.Sync(ctx, []*ent.FileCreate{
+		client.File.Create().SetName("a").SetUser("u").SetSize(10),
+		client.File.Create().SetName("b").SetUser("u").SetSize(2).SetOwner(a8m),
+		client.File.Create().SetName("c").SetUser("u").SetSize(3),
+	}, keys)
+	require.NoError(err)
+	require.Equal(ent.SyncResult{Inserted: 1, Updated: 2}, res)
+	require.Equal(10, client.File.Query().Where(file.Name("a")).OnlyX(ctx).Size)
+	require.Equal("b", client.File.Query().Where(file.HasOwnerWith(user.ID(a8m.ID))).OnlyX(ctx).Name)
+
+	client.File.Create().SetName("x").SetUser("v").SetSize(1).SaveX(ctx)
+	res, err = client.File.Sync(ctx, []*ent.FileCreate{
+		client.File.Create().SetName("c").SetUser("u").SetSize(3),
+	}, keys, ent.SyncScope(file.User("u")))
+	require.NoError(err)
+	require.Equal(ent.SyncResult{Unchanged: 1, Deleted: 2}, res)
+	require.Equal([]string{"c", "x"}, client.File.Query().Order(ent.Asc(file.FieldName)).Select(file.FieldName).StringsX(ctx))
+
+	_, err = client.File.Sync(ctx, nil, nil)
+	require.Error(err, "missing key fields")
+	_, err = client.File.Sync(ctx, nil, []string{file.FieldSize})
+	require.Error(err, "size is not a unique field")
+	_, err = client.File.Sync(ctx, []*ent.FileCreate{client.File.Create().SetName("a").SetSize(1)}, keys)
+	require.Error(err, "missing key field")
+	_, err = client.File.Sync(ctx, []*ent.FileCreate{
+		client.File.Create().SetName("a").SetUser("u").SetSize(1),
+		client.File.Create().SetName("a").SetUser("u").SetSize(2),
+	}, keys)
+	require.Error(err, "duplicate keys")
+	require.Equal(2, client.File.Query().CountX(ctx), "failed syncs are not applied")
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	res, err = tx.File.Sync(ctx, nil, keys, ent.SyncScope(file.User("v")))
+	require.NoError(err)
+	require.Equal(ent.SyncResult{Deleted: 1}, res)
+	require.NoError(tx.Rollback())
+	require.Equal(2, client.File.Query().CountX(ctx), "transactional syncs are not committed")
+
+	client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1).SaveX(ctx)
+	res, err = client.Comment.Sync(ctx, []*ent.CommentCreate{
+		client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1).SetNillableInt(1),
+		client.Comment.Create().SetUniqueInt(2).SetUniqueFloat(2),
+	}, []string{comment.FieldUniqueInt})
+	require.NoError(err)
+	require.Equal(ent.SyncResult{Inserted: 1, Updated: 1}, res)
+	require.Equal(1, *client.Comment.Query().Where(comment.UniqueInt(1)).OnlyX(ctx).NillableInt)
+	res, err = client.Comment.Sync(ctx, nil, []string{comment.FieldUniqueInt})
+	require.NoError(err)
+	require.Equal(ent.SyncResult{Deleted: 2}, res)
+}
+
+func CreateBulkFrom(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	_, err := client.Card.CreateBulkFrom(ctx, nil, 0)
+	require.Error(err, "invalid batch size")
+
+	ch := make(chan *ent.CardCreate)
+	results, err := client.Card.CreateBulkFrom(ctx, ch, 2)
+	require.NoError(err)
+	go func() {
+		defer close(ch)
+		for _, n := range []string{"1", "2", "", "4", "5"} {
+			ch <- client.Card.Create().SetNumber(n)
+		}
+	}()
+	var all [][]ent.Value
+	for res := range results {
+		all = append(all, res.IDs)
+		if len(all) == 2 {
+			require.Error(res.Err, "empty number fails the validation of the second batch")
+		} else {
+			require.NoError(res.Err)
+		}
+	}
+	require.Len(all, 3, "the last batch is partial")
+	require.Len(all[0], 2)
+	require.Empty(all[1])
+	require.Len(all[2], 1)
+	ids := client.Card.Query().Order(ent.Asc(card.FieldID)).IDsX(ctx)
+	require.Equal([]int{all[0][0].(int), all[0][1].(int), all[2][0].(int)}, ids)
+
+	cctx, cancel := context.WithCancel(ctx)
+	ch = make(chan *ent.CardCreate)
+	results, err = client.Card.CreateBulkFrom(cctx, ch, 2)
+	require.NoError(err)
+	ch <- client.Card.Create().SetNumber("6")
+	cancel()
+	res, ok := <-results
+	require.True(ok)
+	require.True(errors.Is(res.Err, context.Canceled), "unexpected error: %v", res.Err)
+	_, ok = <-results
+	require.False(ok, "results are closed after cancellation")
+	require.Equal(3, client.Card.Query().CountX(ctx), "pending builders are discarded")
+}
+
+func OnConflictMerge(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1).SetNillableInt(10).SaveX(ctx)
+	client.Comment.Create().SetUniqueInt(2).SetUniqueFloat(2).SetNillableInt(20).SaveX(ctx)
+	max := func(existing, incoming *ent.Comment) *ent.Comment {
+		if incoming.NillableInt == nil || *existing.NillableInt >= *incoming.NillableInt {
+			return nil
+		}
+		existing.NillableInt = incoming.NillableInt
+		return existing
+	}
+	_, err := client.Comment.CreateBulk(client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1)).
+		OnConflictMerge(max).
+		Save(ctx)
+	require.Error(err, "missing key fields")
+	_, err = client.Comment.CreateBulk(client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1)).
+		OnConflictMerge(max, comment.FieldNillableInt).
+		Save(ctx)
+	require.Error(err, "nillable_int is not a unique field")
+
+	nodes := client.Comment.CreateBulk(
+		client.Comment.Create().SetUniqueInt(1).SetUniqueFloat(1).SetNillableInt(15),
+		client.Comment.Create().SetUniqueInt(2).SetUniqueFloat(2).SetNillableInt(5),
+		client.Comment.Create().SetUniqueInt(3).SetUniqueFloat(3).SetNillableInt(30),
+	).OnConflictMerge(max, comment.FieldUniqueInt).SaveX(ctx)
+	require.Len(nodes, 3)
+	for i, v := range []int{15, 20, 30} {
+		require.Equal(i+1, nodes[i].UniqueInt)
+		require.Equal(v, *nodes[i].NillableInt)
+		require.Equal(v, *client.Comment.GetX(ctx, nodes[i].ID).NillableInt)
+	}
+	require.Equal(3, client.Comment.Query().CountX(ctx))
+
+	// Builders can be used after the transaction of the merge was closed.
+	b := client.Comment.Create().SetUniqueInt(4).SetUniqueFloat(4).SetNillableInt(1)
+	client.Comment.CreateBulk(b).OnConflictMerge(max, comment.FieldUniqueInt).ExecX(ctx)
+	b.SetUniqueInt(5).SetUniqueFloat(5).SaveX(ctx)
+	require.Equal(5, client.Comment.Query().CountX(ctx))
+
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	tx.Comment.CreateBulk(
+		tx.Comment.Create().SetUniqueInt(3).SetUniqueFloat(3).SetNillableInt(40),
+	).OnConflictMerge(max, comment.FieldUniqueInt).ExecX(ctx)
+	require.NoError(tx.Rollback())
+	require.Equal(30, *client.Comment.Query().Where(comment.UniqueInt(3)).OnlyX(ctx).NillableInt, "merge is rolled back with its transaction")
+}
+
+func Fingerprint(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	require.Equal([]string{card.FieldName, card.FieldNumber, card.FieldType}, card.FingerprintFields)
+	c1 := client.Card.Create().SetNumber("1").SetName("a8m").SaveX(ctx)
+	c2 := client.Card.Create().SetNumber("1").SetName("a8m").SetExpiresAt(time.Now()).SaveX(ctx)
+	require.Equal(c1.Fingerprint(), c2.Fingerprint(), "ids, timestamps and excluded fields are not hashed")
+	require.Equal(c1.Fingerprint(), client.Card.GetX(ctx, c1.ID).Fingerprint())
+	c2 = c2.Update().SetName("nati").SaveX(ctx)
+	require.NotEqual(c1.Fingerprint(), c2.Fingerprint())
+	c2 = c2.Update().SetName("a8m").SetType(schema.CardTypeVisa).SaveX(ctx)
+	require.NotEqual(c1.Fingerprint(), c2.Fingerprint())
+	c1 = c1.Update().SetType(schema.CardTypeVisa).SaveX(ctx)
+	require.Equal(c1.Fingerprint(), c2.Fingerprint())
+}
+
+func EagerLoadLimit(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).AddFriends(a8m).SaveX(ctx)
+	alex := client.User.Create().SetName("alex").SetAge(20).AddFriends(a8m, nati).SaveX(ctx)
+	for _, name := range []string{"a", "b", "c"} {
+		client.Pet.Create().SetName(name).SetOwner(a8m).SaveX(ctx)
+	}
+	client.Pet.Create().SetName("d").SetOwner(nati).SaveX(ctx)
+
+	users := client.User.Query().
+		Order(ent.Asc(user.FieldName)).
+		WithPets(func(q *ent.PetQuery) {
+			q.Order(ent.Desc(pet.FieldName)).Limit(2)
+		}).
+		AllX(ctx)
+	require.Len(users, 3)
+	names := func(pets []*ent.Pet) (names []string) {
+		for _, p := range pets {
+			names = append(names, p.Name)
+		}
+		return
+	}
+	require.Equal([]string{"c", "b"}, names(users[0].Edges.Pets), "top 2 pets of a8m")
+	require.Empty(users[1].Edges.Pets, "alex has no pets")
+	require.Equal([]string{"d"}, names(users[2].Edges.Pets))
+
+	users = client.User.Query().
+		Order(ent.Asc(user.FieldName)).
+		WithPets(func(q *ent.PetQuery) {
+			q.Order(ent.Asc(pet.FieldName)).Offset(1).Limit(1)
+		}).
+		AllX(ctx)
+	require.Equal([]string{"b"}, names(users[0].Edges.Pets))
+	require.Empty(users[2].Edges.Pets)
+
+	// Limit of M2M edges is applied per node after loading.
+	users = client.User.Query().
+		Order(ent.Asc(user.FieldName)).
+		WithFriends(func(q *ent.UserQuery) {
+			q.Order(ent.Desc(user.FieldName)).Limit(1)
+		}).
+		AllX(ctx)
+	require.Equal(nati.ID, users[0].Edges.Friends[0].ID)
+	require.Len(users[0].Edges.Friends, 1)
+	require.Equal(nati.ID, users[1].Edges.Friends[0].ID)
+	require.Len(users[1].Edges.Friends, 1)
+	require.Equal(alex.ID, users[2].Edges.Friends[0].ID)
+	require.Len(users[2].Edges.Friends, 1)
+}
+
+func AddEdgesByField(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	crd := client.Card.Create().SetNumber("1").SaveX(ctx)
+	client.Spec.Create().SetName("a").SaveX(ctx)
+	client.Spec.Create().SetName("b").SaveX(ctx)
+
+	err := crd.AddSpecByField(ctx, client, spec.FieldName, "a", "c")
+	require.True(ent.IsNotFound(err))
+	require.Zero(crd.QuerySpec().CountX(ctx), "no edge is added if one of the values is missing")
+	err = crd.AddSpecByField(ctx, client, spec.FieldID, 1)
+	require.Error(err, "id is not a unique field")
+	err = crd.AddSpecByField(ctx, client, spec.FieldName, 1)
+	require.Error(err, "values must have the type of the field")
+
+	require.NoError(crd.AddSpecByField(ctx, client, spec.FieldName, "a", "b", "a"))
+	require.Equal([]string{"a", "b"}, crd.QuerySpec().Order(ent.Asc(spec.FieldName)).Select(spec.FieldName).StringsX(ctx))
+	require.NoError(crd.AddSpecByField(ctx, client, spec.FieldName, "b"), "existing edges are skipped")
+	require.Equal(2, crd.QuerySpec().CountX(ctx))
+
+	created, err := crd.AddSpecByFieldOrCreate(ctx, client, spec.FieldName, "a", "c", "d", "c")
+	require.NoError(err)
+	require.Len(created, 2, "missing targets are created once")
+	require.Equal("c", created[0].Name)
+	require.Equal("d", created[1].Name)
+	require.Equal([]string{"a", "b", "c", "d"}, crd.QuerySpec().Order(ent.Asc(spec.FieldName)).Select(spec.FieldName).StringsX(ctx))
+	require.Equal(4, client.Spec.Query().Where(spec.NameNotNil()).CountX(ctx))
+
+	tx, err := client.Tx(ctx)
+	require.NoError(err)
+	created, err = crd.AddSpecByFieldOrCreate(ctx, tx.Client(), spec.FieldName, "e")
+	require.NoError(err)
+	require.Len(created, 1)
+	require.NoError(tx.Rollback())
+	require.Equal(4, crd.QuerySpec().CountX(ctx), "edges are rolled back with their transaction")
+	require.False(client.Spec.Query().Where(spec.Name("e")).ExistX(ctx))
+}
+
+func AllMaps(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	crd := client.Card.Create().SetNumber("1").SetName("visa").SetOwner(a8m).SaveX(ctx)
+	client.Card.Create().SetNumber("2").SaveX(ctx)
+
+	rows := client.Card.Query().Where(card.Number("1")).AllMapsX(ctx)
+	require.Len(rows, 1)
+	require.Len(rows[0], len(card.Columns), "foreign-keys are not selected")
+	require.Equal(int64(crd.ID), rows[0][card.FieldID])
+	require.Equal("1", rows[0][card.FieldNumber])
+	require.Equal("visa", rows[0][card.FieldName])
+	require.Nil(rows[0][card.FieldType], "NULL values are returned as nil")
+	require.Nil(rows[0][card.FieldExpiresAt])
+	require.IsType(time.Time{}, rows[0][card.FieldCreateTime])
+
+	rows = a8m.QueryCard().AllMapsX(ctx)
+	require.Len(rows, 1, "traversals are supported")
+	require.Equal(int64(crd.ID), rows[0][card.FieldID])
+	rows = client.Card.Query().Where(card.Number("3")).AllMapsX(ctx)
+	require.Empty(rows)
+	require.NotNil(rows)
+}
+
+func FieldsPredicates(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	now := time.Now()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	c1 := client.Card.Create().SetNumber("1").SetName("1").SetExpiresAt(now.Add(-time.Hour)).SetOwner(a8m).SaveX(ctx)
+	c2 := client.Card.Create().SetNumber("2").SetName("b").SetExpiresAt(now.Add(time.Hour)).SaveX(ctx)
+
+	ids := client.Card.Query().Where(card.FieldsLT(card.FieldExpiresAt, card.FieldCreateTime)).IDsX(ctx)
+	require.Equal([]int{c1.ID}, ids, "cards that expired before they were created")
+	ids = client.Card.Query().Where(card.Not(card.FieldsLT(card.FieldExpiresAt, card.FieldCreateTime))).IDsX(ctx)
+	require.Equal([]int{c2.ID}, ids)
+	ids = client.Card.Query().Where(card.FieldsEQ(card.FieldNumber, card.FieldName)).IDsX(ctx)
+	require.Equal([]int{c1.ID}, ids)
+	n := client.Card.Query().Where(card.Or(card.FieldsNEQ(card.FieldNumber, card.FieldName), card.FieldsGTE(card.FieldExpiresAt, card.FieldCreateTime))).CountX(ctx)
+	require.Equal(1, n)
+	owners := client.User.Query().Where(user.HasCardWith(card.FieldsLTE(card.FieldExpiresAt, card.FieldUpdateTime))).IDsX(ctx)
+	require.Equal([]int{a8m.ID}, owners)
+
+	_, err := client.Card.Query().Where(card.FieldsLT(card.FieldExpiresAt, card.FieldName)).All(ctx)
+	require.Error(err, "time and string fields are not comparable")
+	_, err = client.Card.Query().Where(card.Or(card.FieldsGT(card.FieldNumber, "unknown"), card.NameEQ("b"))).Count(ctx)
+	require.Error(err, "errors of nested predicates are reported")
+	_, err = client.User.Query().Where(user.HasCardWith(card.FieldsGT("unknown", card.FieldNumber))).All(ctx)
+	require.Error(err, "errors of edge predicates are reported")
+}
+
+func UpdateByIDMap(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	c1 := client.Card.Create().SetNumber("1").SaveX(ctx)
+	c2 := client.Card.Create().SetNumber("2").SaveX(ctx)
+	c3 := client.Card.Create().SetNumber("3").SetName("c").SetExpiresAt(expires).SaveX(ctx)
+
+	n, err := client.Card.UpdateByIDMap(ctx, map[int]ent.CardPatch{
+		c1.ID: {card.FieldName: "a", card.FieldExpiresAt: expires},
+		c2.ID: {card.FieldName: "b"},
+		c3.ID: {card.FieldName: "c3", card.FieldExpiresAt: nil},
+	})
+	require.NoError(err)
+	require.Equal(3, n)
+	c1, c2, c3 = client.Card.GetX(ctx, c1.ID), client.Card.GetX(ctx, c2.ID), client.Card.GetX(ctx, c3.ID)
+	require.Equal("a", c1.Name)
+	require.True(expires.Equal(*c1.ExpiresAt))
+	require.Equal("b", c2.Name)
+	require.Nil(c2.ExpiresAt)
+	require.Equal("c3", c3.Name)
+	require.Nil(c3.ExpiresAt, "nil values clear optional fields")
+
+	n, err = client.Card.UpdateByIDMap(ctx, nil)
+	require.NoError(err)
+	require.Zero(n)
+	for _, patch := range []ent.CardPatch{
+		{"unknown": "a"},
+		{card.FieldNumber: "4"},
+		{card.FieldName: 1},
+		{card.FieldName: ""},
+	} {
+		_, err = client.Card.UpdateByIDMap(ctx, map[int]ent.CardPatch{
+			c1.ID: {card.FieldName: "d"},
+			c2.ID: patch,
+		})
+		require.Error(err, "patch %v is invalid", patch)
+	}
+	require.Equal("a", client.Card.GetX(ctx, c1.ID).Name, "invalid patches fail before any statement is executed")
+}
+
+func ConstraintFields(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.Card.Create().SetNumber("1").SetNumberHash("h1").SaveX(ctx)
+	_, err := client.Card.Create().SetNumber("2").SetNumberHash("h1").Save(ctx)
+	var cerr *ent.ConstraintError
+	require.True(errors.As(err, &cerr))
+	require.Equal([]string{card.FieldNumberHash}, cerr.FieldsForConstraint())
+
+	client.User.Create().SetName("a8m").SetAge(30).SetNickname("a8m").SaveX(ctx)
+	_, err = client.User.Create().SetName("a8m").SetAge(30).SetNickname("a8m").Save(ctx)
+	require.True(errors.As(err, &cerr))
+	require.Equal([]string{user.FieldNickname}, cerr.FieldsForConstraint())
+
+	client.File.Create().SetName("a").SetSize(10).SetUser("a8m").SaveX(ctx)
+	_, err = client.File.Create().SetName("a").SetSize(20).SetUser("a8m").Save(ctx)
+	require.True(errors.As(err, &cerr))
+	require.Equal([]string{file.FieldName, file.FieldUser}, cerr.FieldsForConstraint(), "fields of unique indexes")
+}
+
+func DumpGraph(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).AddFriends(a8m).SaveX(ctx)
+	crd := client.Card.Create().SetNumber("1").SetOwner(a8m).SaveX(ctx)
+
+	b, err := a8m.DumpGraph(ctx, client, 2, user.EdgeFriends, user.EdgeCard)
+	require.NoError(err)
+	require.Nil(a8m.Edges.Friends, "the entity is not modified")
+	dumped := &ent.User{}
+	require.NoError(json.Unmarshal(b, dumped))
+	require.Equal(a8m.ID, dumped.ID)
+	require.NotNil(dumped.Edges.Card)
+	require.Equal(crd.ID, dumped.Edges.Card.ID)
+	require.Len(dumped.Edges.Friends, 1)
+	require.Equal(nati.Name, dumped.Edges.Friends[0].Name)
+	require.Len(dumped.Edges.Friends[0].Edges.Friends, 1)
+	require.Equal(a8m.ID, dumped.Edges.Friends[0].Edges.Friends[0].ID)
+	require.Nil(dumped.Edges.Friends[0].Edges.Friends[0].Edges.Card, "visited entities are encoded without their edges")
+	require.Nil(dumped.Edges.Pets, "only the given edges are followed")
+
+	b, err = a8m.DumpGraph(ctx, client, 0)
+	require.NoError(err)
+	dumped = &ent.User{}
+	require.NoError(json.Unmarshal(b, dumped))
+	require.Equal(a8m.Name, dumped.Name)
+	require.Nil(dumped.Edges.Friends)
+	require.Nil(dumped.Edges.Card)
+
+	b, err = crd.DumpGraph(ctx, client, 1)
+	require.NoError(err)
+	dumpedCard := &ent.Card{}
+	require.NoError(json.Unmarshal(b, dumpedCard))
+	require.Equal(a8m.ID, dumpedCard.Edges.Owner.ID)
+	require.Nil(dumpedCard.Edges.Owner.Edges.Friends, "edges are loaded up to the given depth")
+
+	_, err = a8m.DumpGraph(ctx, client, -1)
+	require.Error(err)
+}
+
+func NullSafePredicates(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	c1 := client.Card.Create().SetNumber("1").SetExpiresAt(expires).SaveX(ctx)
+	c2 := client.Card.Create().SetNumber("2").SaveX(ctx)
+
+	ids := client.Card.Query().Where(card.ExpiresAtEQNullSafe(&expires)).IDsX(ctx)
+	require.Equal([]int{c1.ID}, ids)
+	ids = client.Card.Query().Where(card.ExpiresAtEQNullSafe(nil)).IDsX(ctx)
+	require.Equal([]int{c2.ID}, ids, "nil values match NULL rows")
+	ids = client.Card.Query().Where(card.Number("2"), card.NameEQNullSafe(nil)).IDsX(ctx)
+	require.Equal([]int{c2.ID}, ids)
+	name := "a8m"
+	require.Zero(client.Card.Query().Where(card.NameEQNullSafe(&name)).CountX(ctx))
+}
+
+func LoadEdgesFor(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(20).SaveX(ctx)
+	client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("xabi").SetOwner(a8m).SaveX(ctx)
+	client.Pet.Create().SetName("luna").SetOwner(nati).SaveX(ctx)
+	inf := client.GroupInfo.Create().SetDesc("desc").SaveX(ctx)
+	client.Group.Create().SetName("GitHub").SetExpire(time.Now()).SetInfo(inf).AddUsers(a8m, nati).SaveX(ctx)
+
+	users := client.User.Query().Order(ent.Asc(user.FieldID)).AllX(ctx)
+	require.Len(users, 3)
+	_, err := users[0].Edges.PetsOrErr()
+	require.Error(err, "edge was not loaded")
+	require.NoError(client.User.LoadPetsFor(ctx, users))
+	for i, n := range []int{2, 1, 0} {
+		pets, err := users[i].Edges.PetsOrErr()
+		require.NoError(err)
+		require.Len(pets, n)
+	}
+	require.NoError(client.User.LoadPetsFor(ctx, users, func(q *ent.PetQuery) {
+		q.Where(pet.Name("xabi"))
+	}))
+	require.Len(users[0].Edges.Pets, 1, "previously loaded edges are replaced")
+	require.Empty(users[1].Edges.Pets)
+
+	require.NoError(client.User.LoadGroupsFor(ctx, users))
+	for i, n := range []int{1, 1, 0} {
+		groups, err := users[i].Edges.GroupsOrErr()
+		require.NoError(err)
+		require.Len(groups, n)
+	}
+	require.NoError(client.User.LoadGroupsFor(ctx, nil))
+
+	client.Card.Create().SetNumber("1").SetOwner(a8m).SaveX(ctx)
+	client.Card.Create().SetNumber("2").SaveX(ctx)
+	var nodes []ent.Value
+	for _, c := range client.Card.Query().Order(ent.Asc(card.FieldID)).AllX(ctx) {
+		nodes = append(nodes, c)
+	}
+	for _, p := range client.Pet.Query().Order(ent.Asc(pet.FieldID)).AllX(ctx) {
+		nodes = append(nodes, p)
+	}
+	require.Len(nodes, 5)
+	require.NoError(client.LoadEdgeFor(ctx, nodes, "owner"))
+	for i, owner := range []*ent.User{a8m, nil, a8m, a8m, nati} {
+		var (
+			v   *ent.User
+			err error
+		)
+		switch n := nodes[i].(type) {
+		case *ent.Card:
+			v, err = n.Edges.OwnerOrErr()
+		case *ent.Pet:
+			v, err = n.Edges.OwnerOrErr()
+		}
+		if owner == nil {
+			require.True(ent.IsNotFound(err), "edge was loaded, but was not found")
+			continue
+		}
+		require.NoError(err)
+		require.Equal(owner.ID, v.ID)
+	}
+	require.NoError(client.LoadEdgeFor(ctx, []ent.Value{users[0], users[1]}, user.EdgePets))
+	require.Len(users[0].Edges.Pets, 2)
+	require.EqualError(client.LoadEdgeFor(ctx, nodes, "spec"), `ent: unknown Pet edge "spec"`)
+	require.EqualError(client.LoadEdgeFor(ctx, []ent.Value{a8m.ID}, "owner"), "ent: unexpected entity type int")
+}
+
+func DryRun(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	crd := client.Card.Create().SetNumber("1").SaveX(ctx)
+
+	dry := ent.DryRun(ctx)
+	created := client.Card.Create().SetNumber("2").SetOwner(a8m).SaveX(dry)
+	require.Equal("2", created.Number)
+	require.Zero(created.ID)
+	updated := client.Card.UpdateOne(crd).SetName("a8m").SaveX(dry)
+	require.Equal(crd.ID, updated.ID)
+	require.Equal("a8m", updated.Name)
+	require.Zero(updated.Number, "fields that were not set are not loaded")
+	n := client.Card.Delete().Where(card.ID(crd.ID)).ExecX(dry)
+	require.Zero(n)
+	require.NoError(client.Card.DeleteOne(crd).Exec(dry))
+	_, err := client.Card.Update().SetName("a8m").Save(dry)
+	require.Error(err, "bulk updates are not supported")
+
+	stmts := ent.DryRunStatements(dry)
+	require.Len(stmts, 4)
+	for i, prefix := range []string{"INSERT INTO", "UPDATE", "DELETE FROM", "DELETE FROM"} {
+		require.True(strings.HasPrefix(stmts[i].Query, prefix), stmts[i].Query)
+	}
+	require.Empty(ent.DryRunStatements(ctx))
+
+	require.Equal(1, client.Card.Query().CountX(ctx), "statements were not executed")
+	crd = client.Card.GetX(ctx, crd.ID)
+	require.Empty(crd.Name)
+	require.False(a8m.QueryCard().ExistX(ctx))
+}
+
+func SelectExpr(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+
+	users := client.User.Query().
+		SelectExpr(entsql.ExprAs(fmt.Sprintf("%s + ?", user.FieldAge), "next_age", 1)).
+		Order(ent.Asc(user.FieldName)).
+		AllX(ctx)
+	require.Len(users, 2)
+	for i, age := range []string{"31", "29"} {
+		v, err := users[i].SelectValue("next_age")
+		require.NoError(err)
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+		require.Equal(age, fmt.Sprint(v))
+	}
+	_, err := users[0].SelectValue("prev_age")
+	require.Error(err)
+	users = client.User.Query().Where(user.Name("a8m")).AllX(ctx)
+	_, err = users[0].SelectValue("next_age")
+	require.Error(err, "expression columns are not selected by default")
+
+	var v []struct {
+		Name    string `json:"name"`
+		NextAge int    `json:"next_age"`
+	}
+	client.User.Query().
+		Where(user.Name("nati")).
+		SelectExpr(entsql.ExprAs(fmt.Sprintf("%s + ?", user.FieldAge), "next_age", 2)).
+		Select(user.FieldName).
+		ScanX(ctx, &v)
+	require.Len(v, 1)
+	require.Equal("nati", v[0].Name)
+	require.Equal(30, v[0].NextAge)
+	require.Equal(2, client.User.Query().SelectExpr(entsql.ExprAs("1", "one")).CountX(ctx))
+
+	_, err = client.User.Query().SelectExpr(entsql.ExprAs("1", user.FieldAge)).All(ctx)
+	require.Error(err, "alias collides with a column")
+	_, err = client.User.Query().SelectExpr(entsql.ExprAs("1", "one"), entsql.ExprAs("2", "one")).All(ctx)
+	require.Error(err, "alias collides with another expression")
+}
+
+func DebugFields(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	pedro := client.Pet.Create().SetName("pedro").SaveX(ctx)
+	create := client.User.Create().SetName("a8m").SetAge(30).AddPets(pedro)
+	require.Equal(map[string]ent.Value{
+		user.FieldName: "a8m",
+		user.FieldAge:  30,
+		user.EdgePets:  []ent.Value{pedro.ID},
+	}, create.DebugFields())
+	a8m := create.SaveX(ctx)
+	fields := create.DebugFields()
+	require.Equal("unknown", fields[user.FieldLast], "default values are set by save")
+	require.Equal(user.RoleUser, fields[user.FieldRole])
+
+	update := a8m.Update().AddAge(1).ClearNickname().RemovePets(pedro)
+	require.Equal(map[string]ent.Value{
+		"add_" + user.FieldAge:        1,
+		"clear_" + user.FieldNickname: true,
+		"remove_" + user.EdgePets:     []ent.Value{pedro.ID},
+	}, update.DebugFields())
+	require.Equal(map[string]ent.Value{
+		"clear_" + user.EdgeCard: true,
+	}, client.User.Update().ClearCard().DebugFields())
+}
+
+func TimeBucket(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	day := time.Date(2020, time.March, 10, 0, 0, 0, 0, time.UTC)
+	for i, d := range []time.Time{day.Add(time.Hour), day.Add(20 * time.Hour), day.Add(30 * time.Hour)} {
+		client.Card.Create().SetNumber(strconv.Itoa(i)).SetCreateTime(d).SaveX(ctx)
+	}
+	var v []struct {
+		Day   interface{} `json:"create_time_day"`
+		Count int         `json:"count"`
+	}
+	client.Card.Query().
+		GroupBy(card.CreateTimeTrunc("day")).
+		Aggregate(ent.Count()).
+		ScanX(ctx, &v)
+	require.Len(v, 2)
+	sort.Slice(v, func(i, j int) bool { return v[i].Count > v[j].Count })
+	for i, want := range []time.Time{day, day.AddDate(0, 0, 1)} {
+		switch d := v[i].Day.(type) {
+		case time.Time:
+			require.True(want.Equal(d), "bucket %v", d)
+		case string:
+			require.Equal(want.Format("2006-01-02 15:04:05"), d)
+		case []byte:
+			require.Equal(want.Format("2006-01-02 15:04:05"), string(d))
+		default:
+			require.Failf("unexpected bucket type", "%T", d)
+		}
+	}
+	require.Equal(2, v[0].Count)
+	require.Equal(1, v[1].Count)
+
+	err := client.Card.Query().
+		GroupBy(card.CreateTimeTrunc("decade")).
+		Aggregate(ent.Count()).
+		Scan(ctx, &v)
+	require.EqualError(err, `sql: unsupported time bucket "decade" for column "create_time"`)
+}
+
+func UpdateDeleteLimit(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	for i := 0; i < 5; i++ {
+		client.Card.Create().SetNumber(strconv.Itoa(i)).SetName("expired").SaveX(ctx)
+	}
+	client.Card.Create().SetNumber("5").SetName("active").SaveX(ctx)
+	n := client.Card.Update().Where(card.Name("expired")).SetName("archived").Limit(2).SaveX(ctx)
+	require.Equal(2, n)
+	require.Equal(2, client.Card.Query().Where(card.Name("archived")).CountX(ctx))
+	n = client.Card.Delete().Where(card.Name("expired")).Limit(2).ExecX(ctx)
+	require.Equal(2, n)
+	require.Equal(1, client.Card.Query().Where(card.Name("expired")).CountX(ctx))
+	n = client.Card.Delete().Where(card.NameIn("expired", "archived")).Limit(10).ExecX(ctx)
+	require.Equal(3, n)
+	require.Equal(1, client.Card.Query().CountX(ctx), "active card is not deleted")
+}
+
+func KeyValue(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SetPassword("secret").SaveX(ctx)
+	crd := client.Card.Create().SetNumber("1234").SetType(schema.CardTypeVisa).SetOwner(a8m).SaveX(ctx)
+	m := crd.ToMap()
+	require.Equal(strconv.Itoa(crd.ID), m[card.FieldID])
+	require.Equal(crd.CreateTime.Format(time.RFC3339Nano), m[card.FieldCreateTime])
+	require.Equal("visa", m[card.FieldType])
+	require.NotContains(m, card.FieldExpiresAt, "nil fields are omitted")
+	require.NotContains(m, card.FieldName, "zero values of optional fields are omitted")
+	decoded, err := client.Card.FromMap(m)
+	require.NoError(err)
+	require.Equal(m, decoded.ToMap())
+	require.True(crd.CreateTime.Equal(decoded.CreateTime))
+	require.Nil(decoded.ExpiresAt)
+	require.Equal(a8m.ID, decoded.QueryOwner().OnlyX(ctx).ID, "decoded entities are bound to the client")
+
+	m = a8m.ToMap()
+	require.NotContains(m, user.FieldPassword, "sensitive fields are omitted")
+	u, err := client.User.FromMap(m)
+	require.NoError(err)
+	require.Equal(a8m.Name, u.Name)
+	require.Equal(a8m.Age, u.Age)
+
+	ft := client.FieldType.Create().
+		SetInt(1).
+		SetInt8(math.MinInt8).
+		SetInt16(16).
+		SetInt32(32).
+		SetInt64(math.MaxInt64).
+		SetOptionalUint64(math.MaxUint32).
+		SetNillableInt32(-32).
+		SetDatetime(time.Now()).
+		SetDecimal(10.25).
+		SaveX(ctx)
+	m = ft.ToMap()
+	decodedFT, err := client.FieldType.FromMap(m)
+	require.NoError(err)
+	require.Equal(m, decodedFT.ToMap())
+	require.Equal(ft.Int64, decodedFT.Int64)
+	require.Equal(ft.OptionalUint64, decodedFT.OptionalUint64)
+	require.Equal(int32(-32), *decodedFT.NillableInt32)
+	require.Nil(decodedFT.NillableInt64)
+
+	m = crd.ToMap()
+	delete(m, card.FieldNumber)
+	_, err = client.Card.FromMap(m)
+	require.EqualError(err, `ent: missing required field "number"`)
+	m = crd.ToMap()
+	m[card.FieldID] = "one"
+	_, err = client.Card.FromMap(m)
+	require.Error(err)
+	m = crd.ToMap()
+	m[card.FieldType] = "unknown"
+	_, err = client.Card.FromMap(m)
+	require.True(ent.IsValidationError(err))
+}
+
+func WhereFilter(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	now := time.Now().UTC().Truncate(time.Second)
+	c1 := client.Card.Create().SetNumber("1").SetType(schema.CardTypeVisa).SetExpiresAt(now.Add(time.Hour)).SetOwner(a8m).SaveX(ctx)
+	c2 := client.Card.Create().SetNumber("2").SetType(schema.CardTypeAmex).SetExpiresAt(now.Add(2 * time.Hour)).SetOwner(nati).SaveX(ctx)
+	c3 := client.Card.Create().SetNumber("3").SetType(schema.CardTypeVisa).SaveX(ctx)
+
+	require.Nil(card.WhereFilter(nil))
+	require.Empty(card.WhereFilter(&card.CardFilter{}), "empty filters are ignored")
+	require.Equal(3, client.Card.Query().Where(card.WhereFilter(&card.CardFilter{})...).CountX(ctx))
+
+	typ := schema.CardTypeVisa
+	ids := client.Card.Query().
+		Where(card.WhereFilter(&card.CardFilter{Type: &typ})...).
+		Order(ent.Asc(card.FieldID)).
+		IDsX(ctx)
+	require.Equal([]int{c1.ID, c3.ID}, ids)
+
+	ids = client.Card.Query().
+		Where(card.WhereFilter(&card.CardFilter{Type: &typ, OwnerIDIn: []int{a8m.ID, nati.ID}})...).
+		IDsX(ctx)
+	require.Equal([]int{c1.ID}, ids)
+
+	ids = client.Card.Query().
+		Where(card.WhereFilter(&card.CardFilter{ExpiresAt: &card.TimeRange{From: now.Add(time.Hour + time.Minute)}})...).
+		IDsX(ctx)
+	require.Equal([]int{c2.ID}, ids)
+	ids = client.Card.Query().
+		Where(card.WhereFilter(&card.CardFilter{ExpiresAt: &card.TimeRange{To: now.Add(2 * time.Hour)}})...).
+		IDsX(ctx)
+	require.Equal([]int{c1.ID}, ids, "upper bound is exclusive")
+
+	number := "2"
+	ids = client.Card.Query().
+		Where(card.WhereFilter(&card.CardFilter{IDIn: []int{c1.ID, c2.ID}, Number: &number})...).
+		IDsX(ctx)
+	require.Equal([]int{c2.ID}, ids)
+}
+
+func TimeLocation(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(err)
+	expires := time.Date(2030, time.March, 10, 8, 30, 0, 0, loc)
+	crd := client.Card.Create().SetNumber("1234").SetExpiresAt(expires).SaveX(ctx)
+	require.True(expires.Equal(*crd.ExpiresAt))
+	require.Equal(time.UTC, crd.ExpiresAt.Location())
+
+	crd = client.Card.GetX(ctx, crd.ID)
+	require.True(expires.Equal(*crd.ExpiresAt), "same instant is read back")
+	require.Equal(time.UTC, crd.ExpiresAt.Location(), "values are read in the configured location")
+
+	expires = expires.AddDate(1, 0, 0)
+	crd = crd.Update().SetExpiresAt(expires).SaveX(ctx)
+	require.True(expires.Equal(*crd.ExpiresAt))
+	require.Equal(1, client.Card.Query().Where(card.ExpiresAtGT(expires.Add(-time.Minute))).CountX(ctx))
+}
+
+func NillableTime(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	crd := client.Card.Create().SetNumber("1234").SaveX(ctx)
+	require.Nil(crd.ExpiresAt)
+	require.Nil(client.Card.GetX(ctx, crd.ID).ExpiresAt, "NULL is read as nil")
+
+	var expires *time.Time
+	crd = client.Card.Create().SetNumber("5678").SetNillableExpiresAt(expires).SaveX(ctx)
+	require.Nil(client.Card.GetX(ctx, crd.ID).ExpiresAt)
+
+	epoch := time.Unix(0, 0)
+	crd = crd.Update().SetNillableExpiresAt(&epoch).SaveX(ctx)
+	require.NotNil(crd.ExpiresAt)
+	crd = client.Card.GetX(ctx, crd.ID)
+	require.NotNil(crd.ExpiresAt, "set value is distinguished from NULL")
+	require.True(epoch.Equal(*crd.ExpiresAt))
+
+	crd = crd.Update().ClearExpiresAt().SaveX(ctx)
+	require.Nil(crd.ExpiresAt)
+	require.Nil(client.Card.GetX(ctx, crd.ID).ExpiresAt)
+	require.Equal(2, client.Card.Query().Where(card.ExpiresAtIsNil()).CountX(ctx))
+}
+
+func SaveID(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	id, err := client.Card.Create().SetNumber("1234").SetName("a8m").SaveID(ctx)
+	require.NoError(err)
+	require.NotZero(id)
+	crd := client.Card.GetX(ctx, id)
+	require.Equal("1234", crd.Number)
+	require.Equal("a8m", crd.Name)
+	require.False(crd.CreateTime.IsZero(), "defaults are applied")
+
+	usr := client.User.Create().SetAge(30).SetName("a8m").SaveX(ctx)
+	id = client.Card.Create().SetNumber("5678").SetOwner(usr).SaveIDX(ctx)
+	require.Equal(id, usr.QueryCard().OnlyXID(ctx))
+	_, err = client.Card.Create().SaveID(ctx)
+	require.Error(err, "missing required field")
+}
+
+func FindOrCreate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	ps := []predicate.User{user.Nickname("a8m")}
+	u1, created, err := client.User.FindOrCreate(ctx, ps, client.User.Create().SetName("a8m").SetAge(30).SetNickname("a8m"))
+	require.NoError(err)
+	require.True(created)
+	u2, created, err := client.User.FindOrCreate(ctx, ps, client.User.Create().SetName("a8m").SetAge(30).SetNickname("a8m"))
+	require.NoError(err)
+	require.False(created)
+	require.Equal(u1.ID, u2.ID)
+	require.Equal(1, client.User.Query().CountX(ctx))
+
+	client.User.Create().SetName("nati").SetAge(30).SaveX(ctx)
+	_, _, err = client.User.FindOrCreate(ctx, []predicate.User{user.Age(30)}, client.User.Create().SetName("a8m").SetAge(30))
+	require.True(ent.IsNotSingular(err), "lookup errors are returned as is")
+	_, _, err = client.User.FindOrCreate(ctx, []predicate.User{user.Name("foo")}, client.User.Create())
+	require.Error(err, "missing required fields")
+	require.Equal(2, client.User.Query().CountX(ctx))
+}
+
+func GetMany(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	nati := client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	users := client.User.GetManyX(ctx, nati.ID, a8m.ID+nati.ID, a8m.ID, nati.ID)
+	require.Len(users, 4)
+	require.Equal(nati.ID, users[0].ID)
+	require.Nil(users[1], "missing ids are returned as nil")
+	require.Equal(a8m.ID, users[2].ID)
+	require.Equal(nati.ID, users[3].ID)
+	users, err := client.User.GetMany(ctx)
+	require.NoError(err)
+	require.Empty(users)
+}
+
+func CopyToCreate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	pedro := client.Pet.Create().SetName("pedro").SetOwner(a8m).SaveX(ctx)
+	// Foreign-keys are loaded only when edges are eager-loaded.
+	pedro = client.Pet.Query().Where(pet.ID(pedro.ID)).WithOwner().OnlyX(ctx)
+	xabi := client.Pet.CopyToCreate(pedro).SetName("xabi").SaveX(ctx)
+	require.NotEqual(pedro.ID, xabi.ID)
+	require.Equal(a8m.ID, xabi.QueryOwner().OnlyX(ctx).ID, "M2O edges are copied")
+
+	crd := client.Card.Create().SetNumber("1").SaveX(ctx)
+	crd2 := client.Card.CopyToCreate(crd).SaveX(ctx)
+	require.Equal(crd.Number, crd2.Number)
+	require.Empty(crd2.Name, "zero optional fields are not set")
+	require.Nil(crd2.ExpiresAt)
+	require.False(crd2.CreateTime.Before(crd.CreateTime), "default time fields are populated by the builder")
+
+	usr := client.User.CopyToCreate(a8m).SetName("nati").SetAge(28).SaveX(ctx)
+	require.Equal(a8m.Role, usr.Role)
+	require.Nil(usr.Edges.Parent)
+}
+
+func CreateFromSelect(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(32).SaveX(ctx)
+
+	n, err := client.Pet.CreateFromSelect(ctx, client.User.Query().Where(user.AgeGTE(30)), map[string]string{
+		pet.FieldName: user.FieldName,
+	})
+	require.NoError(err)
+	require.Equal(2, n)
+	names := client.Pet.Query().Order(ent.Asc(pet.FieldName)).Select(pet.FieldName).StringsX(ctx)
+	require.Equal([]string{"a8m", "alex"}, names)
+
+	_, err = client.Pet.CreateFromSelect(ctx, client.User.Query(), map[string]string{"nickname": user.FieldName})
+	require.Error(err, "unknown columns are rejected")
+	_, err = client.Pet.CreateFromSelect(ctx, client.User.Query().QueryPets(), map[string]string{pet.FieldName: pet.FieldName})
+	require.Error(err, "traversal queries are not supported")
+	require.Equal(2, client.Pet.Query().CountX(ctx))
+}
+
+func Predicates(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	client.User.Create().SetName("nati").SetAge(28).SaveX(ctx)
+	client.User.Create().SetName("alex").SetAge(30).SaveX(ctx)
+
+	query := client.User.Query().Where(user.Age(30))
+	ps := query.Predicates()
+	require.Len(ps, 1)
+	ps[0] = user.Age(28)
+	require.Equal(2, query.CountX(ctx), "changing the returned slice does not affect the query")
+
+	t.Log("rewrite the query predicates")
+	restrict := func(q *ent.UserQuery) *ent.UserQuery {
+		return q.SetPredicates(user.And(user.Not(user.Name("alex")), user.Or(q.Predicates()...)))
+	}
+	require.Equal([]string{"a8m"}, restrict(query.Clone()).Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx))
+	require.Equal(3, query.Clone().SetPredicates().CountX(ctx), "predicates were removed")
+	require.Equal(2, query.CountX(ctx), "original query was not changed")
+}
+
+func Stream(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	a8m := client.User.Create().SetName("a8m").SetAge(30).SaveX(ctx)
+	builders := make([]*ent.FileCreate, 10)
+	for i := range builders {
+		builders[i] = client.File.Create().SetName(fmt.Sprintf("file-%d", i)).SetSize(i + 1).SetOwner(a8m)
+	}
+	files := client.File.CreateBulk(builders...).SaveX(ctx)
+
+	t.Log("stream all files in batches")
+	nodes, errc := client.File.Query().WithOwner().Prefetch(3).Stream(ctx)
+	var ids []int
+	for f := range nodes {
+		require.NotNil(f.Edges.Owner, "eager-loading is applied to all batches")
+		ids = append(ids, f.ID)
+	}
+	require.NoError(<-errc)
+	require.Len(ids, len(files))
+	for i := range files {
+		require.Equal(files[i].ID, ids[i])
+	}
+
+	t.Log("stream with predicates and limit")
+	nodes, errc = client.File.Query().Where(file.SizeGT(2)).Limit(5).Prefetch(2).Stream(ctx)
+	var n int
+	for f := range nodes {
+		require.True(f.Size > 2)
+		n++
+	}
+	require.NoError(<-errc)
+	require.Equal(5, n)
+
+	t.Log("cancel the stream")
+	cctx, cancel := context.WithCancel(ctx)
+	nodes, errc = client.File.Query().Prefetch(1).Stream(cctx)
+	<-nodes
+	cancel()
+	for range nodes {
+	}
+	require.True(errors.Is(<-errc, context.Canceled))
+
+	_, errc = client.File.Query().Order(ent.Asc(file.FieldName)).Stream(ctx)
+	require.Error(<-errc)
+}
+
+func Paginate(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	builders := make([]*ent.UserCreate, 10)
+	for i := range builders {
+		builders[i] = client.User.Create().SetName(fmt.Sprintf("user-%d", i)).SetAge(i % 3)
+	}
+	client.User.CreateBulk(builders...).SaveX(ctx)
+	byAge := ent.PageOrder{Field: user.FieldAge, Direction: ent.OrderDirectionAsc}
+	expected := client.User.Query().Order(ent.Asc(user.FieldAge), ent.Asc(user.FieldID)).IDsX(ctx)
+
+	t.Log("paginate forward")
+	var (
+		ids   []int
+		after *ent.Cursor
+		first = 3
+	)
+	for {
+		conn, err := client.User.Query().Paginate(ctx, after, &first, nil, nil, byAge)
+		require.NoError(err)
+		require.Equal(10, conn.TotalCount)
+		require.Equal(after != nil, len(ids) > 0)
+		for _, e := range conn.Edges {
+			ids = append(ids, e.Node.ID)
+		}
+		if !conn.PageInfo.HasNextPage {
+			require.Len(conn.Edges, 1)
+			break
+		}
+		require.Len(conn.Edges, first)
+		// Cursors are passed to clients as opaque strings.
+		text, err := conn.PageInfo.EndCursor.MarshalText()
+		require.NoError(err)
+		after = &ent.Cursor{}
+		require.NoError(after.UnmarshalText(text))
+	}
+	require.Equal(expected, ids)
+
+	t.Log("paginate backward")
+	var (
+		rids   []int
+		before *ent.Cursor
+		last   = 4
+	)
+	for {
+		conn, err := client.User.Query().Paginate(ctx, nil, nil, before, &last, byAge)
+		require.NoError(err)
+		page := make([]int, len(conn.Edges))
+		for i, e := range conn.Edges {
+			page[i] = e.Node.ID
+		}
+		rids = append(page, rids...)
+		if !conn.PageInfo.HasPreviousPage {
+			require.Len(conn.Edges, 2)
+			break
+		}
+		before = conn.PageInfo.StartCursor
+	}
+	require.Equal(expected, rids)
+
+	t.Log("paginate with predicates and multiple order fields")
+	conn, err := client.User.Query().
+		Where(user.AgeGT(0)).
+		Paginate(ctx, nil, &first, nil, nil, ent.PageOrder{Field: user.FieldAge, Direction: ent.OrderDirectionDesc}, ent.PageOrder{Field: user.FieldName, Direction: ent.OrderDirectionDesc})
+	require.NoError(err)
+	require.Equal(6, conn.TotalCount)
+	require.True(conn.PageInfo.HasNextPage)
+	require.False(conn.PageInfo.HasPreviousPage)
+	names := make([]string, len(conn.Edges))
+	for i, e := range conn.Edges {
+		names[i] = e.Node.Name
+	}
+	require.Equal([]string{"user-8", "user-5", "user-2"}, names)
+
+	t.Log("first and last")
+	conn, err = client.User.Query().Paginate(ctx, nil, &first, nil, &first, byAge)
+	require.NoError(err)
+	require.Len(conn.Edges, first)
+	require.False(conn.PageInfo.HasPreviousPage)
+	zero := 0
+	conn, err = client.User.Query().Paginate(ctx, nil, &zero, nil, nil, byAge)
+	require.NoError(err)
+	require.Empty(conn.Edges)
+	require.True(conn.PageInfo.HasNextPage)
+	require.Nil(conn.PageInfo.EndCursor)
+
+	t.Log("paginate by time field")
+	crd1 := client.Card.Create().SetNumber("1").SaveX(ctx)
+	crd2 := client.Card.Create().SetNumber("2").SaveX(ctx)
+	one, byTime := 1, ent.PageOrder{Field: card.FieldCreateTime, Direction: ent.OrderDirectionAsc}
+	cconn, err := client.Card.Query().Paginate(ctx, nil, &one, nil, nil, byTime)
+	require.NoError(err)
+	require.Equal(crd1.ID, cconn.Edges[0].Node.ID)
+	cconn, err = client.Card.Query().Paginate(ctx, cconn.PageInfo.EndCursor, &one, nil, nil, byTime)
+	require.NoError(err)
+	require.Equal(crd2.ID, cconn.Edges[0].Node.ID)
+	require.False(cconn.PageInfo.HasNextPage)
+
+	t.Log("invalid arguments")
+	_, err = client.User.Query().Paginate(ctx, nil, &first, nil, nil, ent.PageOrder{Field: user.FieldOptionalInt, Direction: ent.OrderDirectionAsc})
+	require.Error(err, "optional fields are not supported")
+	_, err = client.User.Query().Limit(1).Paginate(ctx, nil, &first, nil, nil)
+	require.Error(err)
+	_, err = client.User.Query().Paginate(ctx, after, &first, nil, nil)
+	require.Error(err, "cursor does not match the order fields")
+	require.Error(after.UnmarshalText([]byte("invalid")))
+}
+
+func UniqueConstraint(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	t.Log("unique constraint violation on 1 field")
+	foo := client.User.Create().SetAge(1).SetName("foo").SetNickname("baz").SaveX(ctx)
+	_, err := client.User.Create().SetAge(1).SetName("bar").SetNickname("baz").Save(ctx)
+	require.True(ent.IsConstraintError(err))
+	bar := client.User.Create().SetAge(1).SetName("bar").SetNickname("bar").SetPhone("1").SaveX(ctx)
+
+	t.Log("unique constraint violation on 2 fields")
+	_, err = client.User.Create().SetAge(1).SetName("baz").SetNickname("bar").SetPhone("1").Save(ctx)
+	require.True(ent.IsConstraintError(err))
+	_, err = client.User.Create().SetAge(1).SetName("baz").SetNickname("qux").SetPhone("1").Save(ctx)
+	require.True(ent.IsConstraintError(err))
+	_, err = client.User.Create().SetAge(1).SetName("baz").SetNickname("bar").SetPhone("2").Save(ctx)
+	require.True(ent.IsConstraintError(err))
+	client.User.Create().SetAge(1).SetName("baz").SetNickname("qux").SetPhone("2").SaveX(ctx)
+	_, err = client.User.UpdateOne(foo).SetNickname("bar").SetPhone("1").Save(ctx)
+	require.True(ent.IsConstraintError(err))
+	_, err = client.User.UpdateOne(foo).SetNickname("bar").SetPhone("2").Save(ctx)
+	require.True(ent.IsConstraintError(err))
+
+	t.Log("o2o unique constraint on creation")
+	dan := client.User.Create().SetAge(1).SetName("dan").SetNickname("dan").SetSpouse(foo).SaveX(ctx)
+	require.Equal(dan.Name, foo.QuerySpouse().OnlyX(ctx).Name)
+	_, err = client.User.Create().SetAge(1).SetName("b").SetSpouse(foo).Save(ctx)
+	require.True(ent.IsConstraintError(err))
+
+	t.Log("o2m/m2o unique constraint on creation")
 	c1 := client.User.Create().SetAge(1).SetName("c1").SetNickname("c1").SetParent(foo).SaveX(ctx)
 	c2 := client.User.Create().SetAge(1).SetName("c2").SetNickname("c2").SetParent(foo).SaveX(ctx)
 	_, err = client.User.Create().SetAge(10).SetName("z").SetNickname("z").AddChildren(c1).Save(ctx)
@@ -821,6 +3033,25 @@ func Tx(t *testing.T, client *ent.Client) {
 		require.Error(t, err, "should not be able to query after tx was closed")
 		require.Zero(t, nde.Unwrap().QueryNext().CountX(ctx), "should be able to query the entity after wrap")
 	})
+	t.Run("Closed", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		create := tx.Node.Create().SetValue(1)
+		nde := tx.Node.Create().SaveX(ctx)
+		update := tx.Node.UpdateOne(nde).SetValue(2)
+		del := tx.Node.Delete().Where(node.ID(nde.ID))
+		require.NoError(t, tx.Commit())
+		_, err = create.Save(ctx)
+		require.True(t, errors.Is(err, ent.ErrTxClosed), "unexpected error: %v", err)
+		_, err = update.Save(ctx)
+		require.True(t, errors.Is(err, ent.ErrTxClosed), "unexpected error: %v", err)
+		_, err = nde.QueryNext().Count(ctx)
+		require.True(t, errors.Is(err, ent.ErrTxClosed), "unexpected error: %v", err)
+		require.Equal(t, 1, create.Unwrap().SaveX(ctx).Value)
+		require.Equal(t, 2, update.Unwrap().SaveX(ctx).Value)
+		require.Equal(t, 1, del.Unwrap().ExecX(ctx))
+		require.Panics(t, func() { client.Node.Create().Unwrap() }, "not a transactional builder")
+	})
 	t.Run("Nested", func(t *testing.T) {
 		tx, err := client.Tx(ctx)
 		require.NoError(t, err)
@@ -846,6 +3077,50 @@ func Tx(t *testing.T, client *ent.Client) {
 		require.Error(t, err)
 		require.NoError(t, tx.Rollback())
 	})
+	t.Run("WithTx", func(t *testing.T) {
+		err := client.WithTx(ctx, func(tx *ent.Tx) error {
+			tx.Node.Create().SetValue(100).SaveX(ctx)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, client.Node.Query().Where(node.Value(100)).CountX(ctx), "committed on success")
+		err = client.WithTx(ctx, func(tx *ent.Tx) error {
+			tx.Node.Create().SetValue(101).SaveX(ctx)
+			return errors.New("boring")
+		})
+		require.EqualError(t, err, "boring")
+		require.Zero(t, client.Node.Query().Where(node.Value(101)).CountX(ctx), "rolled back on error")
+		require.Panics(t, func() {
+			_ = client.WithTx(ctx, func(tx *ent.Tx) error {
+				tx.Node.Create().SetValue(102).SaveX(ctx)
+				panic("boring")
+			})
+		})
+		require.Zero(t, client.Node.Query().Where(node.Value(102)).CountX(ctx), "rolled back on panic")
+	})
+	t.Run("WithTxSavepoint", func(t *testing.T) {
+		err := client.WithTx(ctx, func(tx *ent.Tx) error {
+			tx.Node.Create().SetValue(200).SaveX(ctx)
+			err := tx.Client().WithTx(ctx, func(tx *ent.Tx) error {
+				tx.Node.Create().SetValue(201).SaveX(ctx)
+				return errors.New("boring")
+			})
+			require.EqualError(t, err, "boring")
+			require.Panics(t, func() {
+				_ = client.WithTx(ent.NewTxContext(ctx, tx), func(tx *ent.Tx) error {
+					tx.Node.Create().SetValue(202).SaveX(ctx)
+					panic("boring")
+				})
+			})
+			return client.WithTx(ent.NewTxContext(ctx, tx), func(tx *ent.Tx) error {
+				tx.Node.Create().SetValue(203).SaveX(ctx)
+				return nil
+			})
+		})
+		require.NoError(t, err)
+		values := client.Node.Query().Where(node.ValueIn(200, 201, 202, 203)).Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx)
+		require.Equal(t, []int{200, 203}, values, "savepoints that failed are rolled back")
+	})
 }
 
 func DefaultValue(t *testing.T, client *ent.Client) {
@@ -891,6 +3166,8 @@ func ImmutableValue(t *testing.T, client *ent.Client) {
 		require.False(t, v.MethodByName("SetCreatedAt").IsValid())
 		require.False(t, v.MethodByName("SetNillableCreatedAt").IsValid())
 		require.False(t, v.MethodByName("SetNumber").IsValid())
+		require.False(t, v.MethodByName("SetNumberHash").IsValid())
+		require.False(t, v.MethodByName("ClearNumberHash").IsValid())
 		require.True(t, v.MethodByName("SetName").IsValid())
 	}
 }
@@ -904,6 +3181,38 @@ func Sensitive(t *testing.T, client *ent.Client) {
 	b, err := json.Marshal(usr)
 	require.NoError(err)
 	require.NotContains(string(b), "secret-password")
+	require.Equal("<sensitive>", client.User.UpdateOne(usr).SetPassword("new-password").DebugFields()[user.FieldPassword])
+}
+
+func TestSensitiveRedact(t *testing.T) {
+	var logs []string
+	client := enttest.Open(t, dialect.SQLite, "file:redact?mode=memory&cache=shared&_fk=1", opts, enttest.WithOptions(
+		ent.Debug(),
+		ent.Log(func(v ...interface{}) { logs = append(logs, fmt.Sprint(v...)) }),
+		ent.Redact(func(typ, field string) string { return fmt.Sprintf("<%s.%s>", typ, field) }),
+	))
+	defer client.Close()
+	var mutations []string
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			mutations = append(mutations, fmt.Sprint(m))
+			return next.Mutate(ctx, m)
+		})
+	})
+	ctx := context.Background()
+	usr := client.User.Create().SetName("foo").SetAge(20).SetPassword("secret-password").SaveX(ctx)
+	require.Contains(t, usr.String(), "password=<User.password>")
+	usr = client.User.UpdateOne(usr).SetPassword("new-password").SaveX(ctx)
+	require.Equal(t, "new-password", client.User.GetX(ctx, usr.ID).Password)
+	require.Len(t, mutations, 2)
+	require.Equal(t, "UserMutation(op=OpCreate, age=20, last=unknown, name=foo, password=<User.password>, role=user)", mutations[0])
+	require.Equal(t, "UserMutation(op=OpUpdateOne, password=<User.password>)", mutations[1])
+	require.NotEmpty(t, logs)
+	for _, l := range logs {
+		require.NotContains(t, l, "secret-password", "sensitive values are masked in debug logs")
+		require.NotContains(t, l, "new-password", "sensitive values are masked in debug logs")
+	}
+	require.Contains(t, strings.Join(logs, "\n"), "<User.password>")
 }
 
 func EagerLoading(t *testing.T, client *ent.Client) {
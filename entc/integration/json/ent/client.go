@@ -8,15 +8,21 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/facebookincubator/ent/entc/integration/json/ent/migrate"
+	"github.com/facebookincubator/ent/entc/integration/json/ent/predicate"
 
 	"github.com/facebookincubator/ent/entc/integration/json/ent/user"
 
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -67,7 +73,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug, hooks: c.hooks}
+	tx.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = tx
 	return &Tx{
 		config: cfg,
 		User:   NewUserClient(cfg),
@@ -79,17 +87,213 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(*sql.Driver).BeginTx(ctx, opts)
+	drv, ok := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("ent: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: &txDriver{tx: tx, drv: c.driver}, log: c.log, debug: c.debug, hooks: c.hooks}
+	txd := &txDriver{tx: tx, drv: c.driver}
+	txd.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = txd
 	return &Tx{
 		config: cfg,
 		User:   NewUserClient(cfg),
 	}, nil
 }
 
+// WithTx runs fn in a transaction, which is committed if fn returns nil, and rolled back if fn returns an
+// error or panics (the panic is re-raised after the rollback). If the context holds a transaction (see
+// NewTxContext), or the client is transactional, fn runs in the existing transaction within a savepoint
+// instead. The savepoint is released if fn returns nil, and rolled back to otherwise, without affecting
+// the changes that were made in the transaction before it. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		if _, err := tx.User.Create().Save(ctx); err != nil {
+//			return err
+//		}
+//		return nil
+//	})
+//
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	tx := TxFromContext(ctx)
+	if _, ok := c.driver.(*txDriver); ok && tx == nil {
+		tx = &Tx{config: c.config}
+		tx.init()
+	}
+	if tx != nil {
+		return tx.withSavepoint(ctx, fn)
+	}
+	if tx, err = c.Tx(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// withSavepoint runs fn within a savepoint of the transaction. The functions that were registered
+// to run after commit by the mutations of fn are discarded if the savepoint is rolled back to.
+func (tx *Tx) withSavepoint(ctx context.Context, fn func(tx *Tx) error) error {
+	drv := tx.config.driver.(*txDriver)
+	drv.mu.Lock()
+	drv.savepoints++
+	name := fmt.Sprintf("ent_savepoint_%d", drv.savepoints)
+	committed := len(drv.afterCommit)
+	drv.mu.Unlock()
+	if err := drv.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: creating savepoint: %w", err)
+	}
+	rollback := func() error {
+		drv.mu.Lock()
+		drv.afterCommit = drv.afterCommit[:committed]
+		drv.mu.Unlock()
+		return drv.Exec(ctx, "ROLLBACK TO SAVEPOINT "+name, []interface{}{}, nil)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back to savepoint: %v", err, rerr)
+		}
+		return err
+	}
+	if err := drv.Exec(ctx, "RELEASE SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: releasing savepoint: %w", err)
+	}
+	return nil
+}
+
+// Purge deletes the entities that match the given predicates from their tables in one transaction,
+// and returns the number of deleted rows per type label. The predicates are keyed by the type label,
+// and types without a predicate are not deleted. The types are deleted in dependency order (types
+// that hold foreign-keys to other types first) using their delete builders, and therefore, their
+// hooks are executed as well. Any error (or a canceled context) rolls back the transaction. For example:
+//
+//	tenant := func(s *sql.Selector) {
+//		s.Where(sql.EQ(s.C("tenant_id"), id))
+//	}
+//	report, err := client.Purge(ctx, map[string]func(*sql.Selector){
+//		user.Label: tenant,
+//		// ...
+//	})
+//
+// If the client is transactional, the entities are deleted in its transaction, and it is not committed.
+func (c *Client) Purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	for label := range preds {
+		switch label {
+		case user.Label:
+		default:
+			return nil, fmt.Errorf("ent: unknown type label %q", label)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.purge(ctx, preds)
+	}
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := tx.Client().purge(ctx, preds)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%v: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// purge deletes the entities of the given predicates in dependency order.
+func (c *Client) purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	report := make(map[string]int, len(preds))
+	if p, ok := preds[user.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.User.Delete().Where(predicate.User(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging user: %w", err)
+		}
+		report[user.Label] = n
+	}
+	return report, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given entities, that can be of different
+// types (e.g. the results of a polymorphic query). The entities are grouped by their concrete type, and
+// the edge of each group is loaded using the LoadEdgeFor method of its client. Hence, the number of
+// queries depends on the number of types, and not on the number of entities. For example:
+//
+//	err := client.LoadEdgeFor(ctx, []ent.Value{card, user}, "owner")
+//
+// The entities must be pointers to the generated types, and the edge must be defined on all their types.
+func (c *Client) LoadEdgeFor(ctx context.Context, nodes []Value, edge string) error {
+	var (
+		userNodes []*User
+	)
+	for _, v := range nodes {
+		switch node := v.(type) {
+		case *User:
+			userNodes = append(userNodes, node)
+		default:
+			return fmt.Errorf("ent: unexpected entity type %T", v)
+		}
+	}
+	if len(userNodes) > 0 {
+		if err := c.User.LoadEdgeFor(ctx, userNodes, edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshMaterializedView replaces the contents of the materialized view with the given name by
+// re-executing its query (PostgreSQL only). If concurrently is true, the view is refreshed without
+// locking out concurrent reads from it, which requires a unique index on the view. For example:
+//
+//	err := client.RefreshMaterializedView(ctx, "card_stats", true)
+//
+// Read-only entities that query the view are defined using the View option in the schema config.
+func (c *Client) RefreshMaterializedView(ctx context.Context, name string, concurrently bool) error {
+	if d := c.driver.Dialect(); d != dialect.Postgres {
+		return fmt.Errorf("ent: materialized views are not supported by dialect %q", d)
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("REFRESH MATERIALIZED VIEW ")
+	if concurrently {
+		b.WriteString("CONCURRENTLY ")
+	}
+	query, args := b.Ident(name).Query()
+	if err := c.driver.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("ent: refreshing materialized view %q: %v", name, err)
+	}
+	return nil
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -101,7 +305,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true, hooks: c.hooks}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	client := &Client{config: cfg}
 	client.init()
 	return client
@@ -140,6 +346,194 @@ func (c *UserClient) Create() *UserCreate {
 	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads User builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.User.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *UserClient) CreateBulkFrom(ctx context.Context, ch <-chan *UserCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for User bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*UserCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*UserCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*UserCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the User that matches the given predicates, or creates it using
+// the given builder if there is no such User. The returned bool reports whether the
+// User was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the User is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.User.FindOrCreate(ctx, ps, client.User.Create())
+//
+func (c *UserClient) FindOrCreate(ctx context.Context, ps []predicate.User, create *UserCreate) (*User, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.User.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the User using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *UserClient) findOrCreate(ctx context.Context, ps []predicate.User, create *UserCreate) (*User, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the users table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the User columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown User columns are rejected before the statement is executed.
+//
+//	n, err := client.User.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		user.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *UserClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   user.Table,
+		Columns: user.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given User and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.User.CopyToCreate(u).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *UserClient) CopyToCreate(u *User) *UserCreate {
+	create := c.Create()
+	if u.URL != nil {
+		create.SetURL(u.URL)
+	}
+	if u.Raw != nil {
+		create.SetRaw(u.Raw)
+	}
+	if u.Dirs != nil {
+		create.SetDirs(u.Dirs)
+	}
+	if u.Ints != nil {
+		create.SetInts(u.Ints)
+	}
+	if u.Floats != nil {
+		create.SetFloats(u.Floats)
+	}
+	if u.Strings != nil {
+		create.SetStrings(u.Strings)
+	}
+	if u.Meta != nil {
+		create.SetMeta(u.Meta)
+	}
+	return create
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -158,6 +552,170 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UserPatch holds the field changes of a User for UpdateByIDMap, keyed by the field
+// name (e.g. user.FieldURL). A nil value clears an optional field.
+type UserPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the User with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.User.UpdateByIDMap(ctx, map[int]ent.UserPatch{
+//		id1: {user.FieldURL: v1},
+//		id2: {user.FieldURL: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *UserClient) UpdateByIDMap(ctx context.Context, m map[int]UserPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *UserClient) patchUpdates(m map[int]UserPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newUserMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid User patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&UserUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			switch f {
+			case user.FieldURL, user.FieldRaw, user.FieldDirs, user.FieldInts, user.FieldFloats, user.FieldStrings, user.FieldMeta:
+				if v != nil {
+					buf, err := json.Marshal(v)
+					if err != nil {
+						return nil, fmt.Errorf("ent: marshal field %q of User patch of id %v: %w", f, id, err)
+					}
+					v = buf
+				}
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(user.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, user.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(user.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given User entities, and updates the original User only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original User is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := u.Clone()
+//	modified.Field = value
+//	u, err = client.User.UpdateFromDiff(ctx, u, modified)
+//
+func (c *UserClient) UpdateFromDiff(ctx context.Context, original, modified *User) (*User, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for User UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if !reflect.DeepEqual(modified.URL, original.URL) {
+		update.SetURL(modified.URL)
+		changed = true
+	}
+	if !reflect.DeepEqual(modified.Raw, original.Raw) {
+		update.SetRaw(modified.Raw)
+		changed = true
+	}
+	if !reflect.DeepEqual(modified.Dirs, original.Dirs) {
+		update.SetDirs(modified.Dirs)
+		changed = true
+	}
+	if !reflect.DeepEqual(modified.Ints, original.Ints) {
+		update.SetInts(modified.Ints)
+		changed = true
+	}
+	if !reflect.DeepEqual(modified.Floats, original.Floats) {
+		update.SetFloats(modified.Floats)
+		changed = true
+	}
+	if !reflect.DeepEqual(modified.Strings, original.Strings) {
+		update.SetStrings(modified.Strings)
+		changed = true
+	}
+	if !reflect.DeepEqual(modified.Meta, original.Meta) {
+		update.SetMeta(modified.Meta)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -196,7 +754,84 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *UserClient) GetMany(ctx context.Context, ids ...int) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*User, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*User, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...int) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a User from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *UserClient) FromMap(m map[string]string) (*User, error) {
+	u := &User{config: c.config}
+	if err := kvDecode(m, user.FieldID, &u.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldURL, &u.URL, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldRaw, &u.Raw, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldDirs, &u.Dirs, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldInts, &u.Ints, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldFloats, &u.Floats, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldStrings, &u.Strings, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldMeta, &u.Meta, false); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given User entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *UserClient) LoadEdgeFor(ctx context.Context, nodes []*User, edge string) error {
+	return fmt.Errorf("ent: unknown User edge %q", edge)
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(user.Label)}, hooks...)
+	}
+	return hooks
 }
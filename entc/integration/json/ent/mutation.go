@@ -37,11 +37,16 @@ type UserMutation struct {
 	typ           string
 	id            *int
 	url           **url.URL
+	mergeurl      map[string]interface{}
 	raw           *json.RawMessage
+	mergeraw      map[string]interface{}
 	dirs          *[]http.Dir
 	ints          *[]int
 	floats        *[]float64
 	strings       *[]string
+	meta          *map[string]interface{}
+	mergemeta     map[string]interface{}
+	setpathmeta   map[string]interface{}
 	clearedFields map[string]struct{}
 }
 
@@ -76,6 +81,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *UserMutation) ID() (id int, exists bool) {
@@ -88,6 +105,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // SetURL sets the url field.
 func (m *UserMutation) SetURL(u *url.URL) {
 	m.url = &u
+	m.mergeurl = nil
 }
 
 // URL returns the url value in the mutation.
@@ -99,9 +117,30 @@ func (m *UserMutation) URL() (r *url.URL, exists bool) {
 	return *v, true
 }
 
+// MergeURL merges the keys of the given object into the url field in the database,
+// instead of replacing the whole value. Multiple calls are merged into one object.
+func (m *UserMutation) MergeURL(v map[string]interface{}) {
+	if m.mergeurl == nil {
+		m.mergeurl = make(map[string]interface{}, len(v))
+	}
+	for k := range v {
+		m.mergeurl[k] = v[k]
+	}
+}
+
+// MergedURL returns the object that is merged into the url field in this mutation.
+func (m *UserMutation) MergedURL() (r map[string]interface{}, exists bool) {
+	v := m.mergeurl
+	if v == nil {
+		return
+	}
+	return v, true
+}
+
 // ClearURL clears the value of url.
 func (m *UserMutation) ClearURL() {
 	m.url = nil
+	m.mergeurl = nil
 	m.clearedFields[user.FieldURL] = struct{}{}
 }
 
@@ -114,12 +153,14 @@ func (m *UserMutation) URLCleared() bool {
 // ResetURL reset all changes of the "url" field.
 func (m *UserMutation) ResetURL() {
 	m.url = nil
+	m.mergeurl = nil
 	delete(m.clearedFields, user.FieldURL)
 }
 
 // SetRaw sets the raw field.
 func (m *UserMutation) SetRaw(jm json.RawMessage) {
 	m.raw = &jm
+	m.mergeraw = nil
 }
 
 // Raw returns the raw value in the mutation.
@@ -131,9 +172,30 @@ func (m *UserMutation) Raw() (r json.RawMessage, exists bool) {
 	return *v, true
 }
 
+// MergeRaw merges the keys of the given object into the raw field in the database,
+// instead of replacing the whole value. Multiple calls are merged into one object.
+func (m *UserMutation) MergeRaw(v map[string]interface{}) {
+	if m.mergeraw == nil {
+		m.mergeraw = make(map[string]interface{}, len(v))
+	}
+	for k := range v {
+		m.mergeraw[k] = v[k]
+	}
+}
+
+// MergedRaw returns the object that is merged into the raw field in this mutation.
+func (m *UserMutation) MergedRaw() (r map[string]interface{}, exists bool) {
+	v := m.mergeraw
+	if v == nil {
+		return
+	}
+	return v, true
+}
+
 // ClearRaw clears the value of raw.
 func (m *UserMutation) ClearRaw() {
 	m.raw = nil
+	m.mergeraw = nil
 	m.clearedFields[user.FieldRaw] = struct{}{}
 }
 
@@ -146,6 +208,7 @@ func (m *UserMutation) RawCleared() bool {
 // ResetRaw reset all changes of the "raw" field.
 func (m *UserMutation) ResetRaw() {
 	m.raw = nil
+	m.mergeraw = nil
 	delete(m.clearedFields, user.FieldRaw)
 }
 
@@ -277,6 +340,83 @@ func (m *UserMutation) ResetStrings() {
 	delete(m.clearedFields, user.FieldStrings)
 }
 
+// SetMeta sets the meta field.
+func (m *UserMutation) SetMeta(value map[string]interface{}) {
+	m.meta = &value
+	m.mergemeta = nil
+	m.setpathmeta = nil
+}
+
+// Meta returns the meta value in the mutation.
+func (m *UserMutation) Meta() (r map[string]interface{}, exists bool) {
+	v := m.meta
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// MergeMeta merges the keys of the given object into the meta field in the database,
+// instead of replacing the whole value. Multiple calls are merged into one object.
+func (m *UserMutation) MergeMeta(v map[string]interface{}) {
+	if m.mergemeta == nil {
+		m.mergemeta = make(map[string]interface{}, len(v))
+	}
+	for k := range v {
+		m.mergemeta[k] = v[k]
+	}
+}
+
+// MergedMeta returns the object that is merged into the meta field in this mutation.
+func (m *UserMutation) MergedMeta() (r map[string]interface{}, exists bool) {
+	v := m.mergemeta
+	if v == nil {
+		return
+	}
+	return v, true
+}
+
+// SetMetaPath sets the value under the given dot-separated path (e.g. "a.b") of the meta
+// object in the database, instead of replacing the whole value. The parent objects of the path must
+// exist in the stored object, and multiple calls for the same path keep the last value.
+func (m *UserMutation) SetMetaPath(path string, v interface{}) {
+	if m.setpathmeta == nil {
+		m.setpathmeta = make(map[string]interface{})
+	}
+	m.setpathmeta[path] = v
+}
+
+// MetaPaths returns the values that are set by their paths in the meta field in this mutation.
+func (m *UserMutation) MetaPaths() (r map[string]interface{}, exists bool) {
+	v := m.setpathmeta
+	if v == nil {
+		return
+	}
+	return v, true
+}
+
+// ClearMeta clears the value of meta.
+func (m *UserMutation) ClearMeta() {
+	m.meta = nil
+	m.mergemeta = nil
+	m.setpathmeta = nil
+	m.clearedFields[user.FieldMeta] = struct{}{}
+}
+
+// MetaCleared returns if the field meta was cleared in this mutation.
+func (m *UserMutation) MetaCleared() bool {
+	_, ok := m.clearedFields[user.FieldMeta]
+	return ok
+}
+
+// ResetMeta reset all changes of the "meta" field.
+func (m *UserMutation) ResetMeta() {
+	m.meta = nil
+	m.mergemeta = nil
+	m.setpathmeta = nil
+	delete(m.clearedFields, user.FieldMeta)
+}
+
 // Op returns the operation name.
 func (m *UserMutation) Op() Op {
 	return m.op
@@ -287,11 +427,16 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 7)
 	if m.url != nil {
 		fields = append(fields, user.FieldURL)
 	}
@@ -310,6 +455,9 @@ func (m *UserMutation) Fields() []string {
 	if m.strings != nil {
 		fields = append(fields, user.FieldStrings)
 	}
+	if m.meta != nil {
+		fields = append(fields, user.FieldMeta)
+	}
 	return fields
 }
 
@@ -330,6 +478,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Floats()
 	case user.FieldStrings:
 		return m.Strings()
+	case user.FieldMeta:
+		return m.Meta()
 	}
 	return nil, false
 }
@@ -381,6 +531,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetStrings(v)
 		return nil
+	case user.FieldMeta:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeta(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -429,6 +586,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldStrings) {
 		fields = append(fields, user.FieldStrings)
 	}
+	if m.FieldCleared(user.FieldMeta) {
+		fields = append(fields, user.FieldMeta)
+	}
 	return fields
 }
 
@@ -461,6 +621,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldStrings:
 		m.ClearStrings()
 		return nil
+	case user.FieldMeta:
+		m.ClearMeta()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -488,6 +651,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldStrings:
 		m.ResetStrings()
 		return nil
+	case user.FieldMeta:
+		m.ResetMeta()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
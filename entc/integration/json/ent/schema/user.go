@@ -33,5 +33,7 @@ func (User) Fields() []ent.Field {
 			Optional(),
 		field.Strings("strings").
 			Optional(),
+		field.JSON("meta", map[string]interface{}{}).
+			Optional(),
 	}
 }
@@ -7,10 +7,14 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -34,81 +38,158 @@ type User struct {
 	Floats []float64 `json:"floats,omitempty"`
 	// Strings holds the value of the "strings" field.
 	Strings []string `json:"strings,omitempty"`
+	// Meta holds the value of the "meta" field.
+	Meta map[string]interface{} `json:"meta,omitempty"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*User) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // id
-		&[]byte{},        // url
-		&[]byte{},        // raw
-		&[]byte{},        // dirs
-		&[]byte{},        // ints
-		&[]byte{},        // floats
-		&[]byte{},        // strings
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the User type are scanned as generic values.
+func (*User) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &sql.NullInt64{}
+		case user.FieldURL:
+			values[i] = &[]byte{}
+		case user.FieldRaw:
+			values[i] = &[]byte{}
+		case user.FieldDirs:
+			values[i] = &[]byte{}
+		case user.FieldInts:
+			values[i] = &[]byte{}
+		case user.FieldFloats:
+			values[i] = &[]byte{}
+		case user.FieldStrings:
+			values[i] = &[]byte{}
+		case user.FieldMeta:
+			values[i] = &[]byte{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the User fields.
-func (u *User) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(user.Columns); m < n {
+// to the User fields. Values of columns that were not selected are left untouched.
+func (u *User) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	u.ID = int(value.Int64)
-	values = values[1:]
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case user.FieldURL:
 
-	if value, ok := values[0].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field url", values[0])
-	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.URL); err != nil {
-			return fmt.Errorf("unmarshal field url: %v", err)
-		}
-	}
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.URL); err != nil {
+					return fmt.Errorf("unmarshal field url: %v", err)
+				}
+			}
+		case user.FieldRaw:
 
-	if value, ok := values[1].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field raw", values[1])
-	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Raw); err != nil {
-			return fmt.Errorf("unmarshal field raw: %v", err)
-		}
-	}
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field raw", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Raw); err != nil {
+					return fmt.Errorf("unmarshal field raw: %v", err)
+				}
+			}
+		case user.FieldDirs:
 
-	if value, ok := values[2].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field dirs", values[2])
-	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Dirs); err != nil {
-			return fmt.Errorf("unmarshal field dirs: %v", err)
-		}
-	}
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dirs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Dirs); err != nil {
+					return fmt.Errorf("unmarshal field dirs: %v", err)
+				}
+			}
+		case user.FieldInts:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ints", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Ints); err != nil {
+					return fmt.Errorf("unmarshal field ints: %v", err)
+				}
+			}
+		case user.FieldFloats:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field floats", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Floats); err != nil {
+					return fmt.Errorf("unmarshal field floats: %v", err)
+				}
+			}
+		case user.FieldStrings:
+
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field strings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Strings); err != nil {
+					return fmt.Errorf("unmarshal field strings: %v", err)
+				}
+			}
+		case user.FieldMeta:
 
-	if value, ok := values[3].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field ints", values[3])
-	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Ints); err != nil {
-			return fmt.Errorf("unmarshal field ints: %v", err)
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field meta", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.Meta); err != nil {
+					return fmt.Errorf("unmarshal field meta: %v", err)
+				}
+			}
 		}
 	}
+	return nil
+}
 
-	if value, ok := values[4].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field floats", values[4])
-	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Floats); err != nil {
-			return fmt.Errorf("unmarshal field floats: %v", err)
-		}
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (u *User) SelectValue(alias string) (Value, error) {
+	v, ok := u.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
 	}
+	return v, nil
+}
 
-	if value, ok := values[5].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field strings", values[5])
-	} else if value != nil && len(*value) > 0 {
-		if err := json.Unmarshal(*value, &u.Strings); err != nil {
-			return fmt.Errorf("unmarshal field strings: %v", err)
-		}
+// DumpGraph returns the JSON encoding of the User and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the User alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The User itself is not modified.
+//
+//	b, err := u.DumpGraph(ctx, client, 2)
+//
+func (u *User) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for User DumpGraph", depth)
 	}
+	dumped := *u
+	if err := dumpUsers(ctx, client, []*User{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpUsers loads the edges of the given User copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpUsers(ctx context.Context, client *Client, nodes []*User, depth int, dumper *graphDump) error {
 	return nil
 }
 
@@ -130,6 +211,33 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the User and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if v, ok := seen[u]; ok {
+		return v.(*User)
+	}
+	_c := *u
+	seen[u] = &_c
+	deepCopy(&_c.URL, &u.URL)
+	deepCopy(&_c.Raw, &u.Raw)
+	deepCopy(&_c.Dirs, &u.Dirs)
+	deepCopy(&_c.Ints, &u.Ints)
+	deepCopy(&_c.Floats, &u.Floats)
+	deepCopy(&_c.Strings, &u.Strings)
+	deepCopy(&_c.Meta, &u.Meta)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	var builder strings.Builder
@@ -147,10 +255,90 @@ func (u *User) String() string {
 	builder.WriteString(fmt.Sprintf("%v", u.Floats))
 	builder.WriteString(", strings=")
 	builder.WriteString(fmt.Sprintf("%v", u.Strings))
+	builder.WriteString(", meta=")
+	builder.WriteString(fmt.Sprintf("%v", u.Meta))
 	builder.WriteByte(')')
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the User, for change detection and cache keys.
+// It covers the fields that are listed in user.FingerprintFields, and it does not depend on the
+// ID of the User, on its edges, or on the order of the fields in the schema.
+func (u *User) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "dirs", u.Dirs)
+	fingerprint(h, "floats", u.Floats)
+	fingerprint(h, "ints", u.Ints)
+	fingerprint(h, "meta", u.Meta)
+	fingerprint(h, "raw", u.Raw)
+	fingerprint(h, "strings", u.Strings)
+	fingerprint(h, "url", u.URL)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the User for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The User can be decoded using the FromMap method of its client.
+func (u *User) ToMap() map[string]string {
+	m := make(map[string]string, 8)
+	m[user.FieldID] = kvEncode(u.ID)
+	if v := u.URL; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldURL] = kvEncode(v)
+	}
+	if v := u.Raw; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldRaw] = kvEncode(v)
+	}
+	if v := u.Dirs; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldDirs] = kvEncode(v)
+	}
+	if v := u.Ints; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldInts] = kvEncode(v)
+	}
+	if v := u.Floats; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldFloats] = kvEncode(v)
+	}
+	if v := u.Strings; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldStrings] = kvEncode(v)
+	}
+	if v := u.Meta; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldMeta] = kvEncode(v)
+	}
+	return m
+}
+
+// MetaValue returns the value stored under the given dot-separated path (e.g. "a.b") of the
+// meta field, and reports whether it exists.
+func (u *User) MetaValue(path string) (interface{}, bool) {
+	return jsonPath(u.Meta, path)
+}
+
+// MetaString returns the string stored under the given path of the meta field.
+func (u *User) MetaString(path string) (string, bool) {
+	v, _ := u.MetaValue(path)
+	s, ok := v.(string)
+	return s, ok
+}
+
+// MetaInt returns the integer stored under the given path of the meta field.
+func (u *User) MetaInt(path string) (int, bool) {
+	v, _ := u.MetaValue(path)
+	return jsonInt(v)
+}
+
+// MetaFloat returns the number stored under the given path of the meta field.
+func (u *User) MetaFloat(path string) (float64, bool) {
+	v, _ := u.MetaValue(path)
+	return jsonFloat(v)
+}
+
+// MetaBool returns the boolean stored under the given path of the meta field.
+func (u *User) MetaBool(path string) (bool, bool) {
+	v, _ := u.MetaValue(path)
+	b, ok := v.(bool)
+	return b, ok
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
@@ -10,18 +10,31 @@ const (
 	// Label holds the string label denoting the user type in the database.
 	Label = "user"
 	// FieldID holds the string denoting the id field in the database.
-	FieldID      = "id"     // FieldURL holds the string denoting the url vertex property in the database.
-	FieldURL     = "url"    // FieldRaw holds the string denoting the raw vertex property in the database.
-	FieldRaw     = "raw"    // FieldDirs holds the string denoting the dirs vertex property in the database.
-	FieldDirs    = "dirs"   // FieldInts holds the string denoting the ints vertex property in the database.
-	FieldInts    = "ints"   // FieldFloats holds the string denoting the floats vertex property in the database.
-	FieldFloats  = "floats" // FieldStrings holds the string denoting the strings vertex property in the database.
-	FieldStrings = "strings"
+	FieldID      = "id"      // FieldURL holds the string denoting the url vertex property in the database.
+	FieldURL     = "url"     // FieldRaw holds the string denoting the raw vertex property in the database.
+	FieldRaw     = "raw"     // FieldDirs holds the string denoting the dirs vertex property in the database.
+	FieldDirs    = "dirs"    // FieldInts holds the string denoting the ints vertex property in the database.
+	FieldInts    = "ints"    // FieldFloats holds the string denoting the floats vertex property in the database.
+	FieldFloats  = "floats"  // FieldStrings holds the string denoting the strings vertex property in the database.
+	FieldStrings = "strings" // FieldMeta holds the string denoting the meta vertex property in the database.
+	FieldMeta    = "meta"
 
 	// Table holds the table name of the user in the database.
 	Table = "users"
 )
 
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldDirs,
+	FieldFloats,
+	FieldInts,
+	FieldMeta,
+	FieldRaw,
+	FieldStrings,
+	FieldURL,
+}
+
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
@@ -31,4 +44,15 @@ var Columns = []string{
 	FieldInts,
 	FieldFloats,
 	FieldStrings,
+	FieldMeta,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
 }
@@ -26,6 +26,9 @@ type UserUpdate struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -34,30 +37,67 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Limit limits the number of users that are updated by the builder. For example,
+// for updating large amounts of users in small batches without locking the whole table.
+func (uu *UserUpdate) Limit(limit int) *UserUpdate {
+	uu.limit = &limit
+	return uu
+}
+
 // SetURL sets the url field.
 func (uu *UserUpdate) SetURL(u *url.URL) *UserUpdate {
 	uu.mutation.SetURL(u)
 	return uu
 }
 
+// MergeURL merges the keys of the given object into the url field, instead of
+// replacing the whole value. See the UserMutation.MergeURL method for more info.
+func (uu *UserUpdate) MergeURL(v map[string]interface{}) *UserUpdate {
+	uu.mutation.MergeURL(v)
+	return uu
+}
+
 // ClearURL clears the value of url.
 func (uu *UserUpdate) ClearURL() *UserUpdate {
 	uu.mutation.ClearURL()
 	return uu
 }
 
+// UnsetURL removes the changes of the url field from the builder (e.g. a previous call
+// to SetURL), and therefore, the field is left unchanged in the database. Unlike ClearURL,
+// it does not set the field to NULL, and also removes a previous call to ClearURL.
+func (uu *UserUpdate) UnsetURL() *UserUpdate {
+	uu.mutation.ResetURL()
+	return uu
+}
+
 // SetRaw sets the raw field.
 func (uu *UserUpdate) SetRaw(jm json.RawMessage) *UserUpdate {
 	uu.mutation.SetRaw(jm)
 	return uu
 }
 
+// MergeRaw merges the keys of the given object into the raw field, instead of
+// replacing the whole value. See the UserMutation.MergeRaw method for more info.
+func (uu *UserUpdate) MergeRaw(v map[string]interface{}) *UserUpdate {
+	uu.mutation.MergeRaw(v)
+	return uu
+}
+
 // ClearRaw clears the value of raw.
 func (uu *UserUpdate) ClearRaw() *UserUpdate {
 	uu.mutation.ClearRaw()
 	return uu
 }
 
+// UnsetRaw removes the changes of the raw field from the builder (e.g. a previous call
+// to SetRaw), and therefore, the field is left unchanged in the database. Unlike ClearRaw,
+// it does not set the field to NULL, and also removes a previous call to ClearRaw.
+func (uu *UserUpdate) UnsetRaw() *UserUpdate {
+	uu.mutation.ResetRaw()
+	return uu
+}
+
 // SetDirs sets the dirs field.
 func (uu *UserUpdate) SetDirs(h []http.Dir) *UserUpdate {
 	uu.mutation.SetDirs(h)
@@ -70,6 +110,14 @@ func (uu *UserUpdate) ClearDirs() *UserUpdate {
 	return uu
 }
 
+// UnsetDirs removes the changes of the dirs field from the builder (e.g. a previous call
+// to SetDirs), and therefore, the field is left unchanged in the database. Unlike ClearDirs,
+// it does not set the field to NULL, and also removes a previous call to ClearDirs.
+func (uu *UserUpdate) UnsetDirs() *UserUpdate {
+	uu.mutation.ResetDirs()
+	return uu
+}
+
 // SetInts sets the ints field.
 func (uu *UserUpdate) SetInts(i []int) *UserUpdate {
 	uu.mutation.SetInts(i)
@@ -82,6 +130,14 @@ func (uu *UserUpdate) ClearInts() *UserUpdate {
 	return uu
 }
 
+// UnsetInts removes the changes of the ints field from the builder (e.g. a previous call
+// to SetInts), and therefore, the field is left unchanged in the database. Unlike ClearInts,
+// it does not set the field to NULL, and also removes a previous call to ClearInts.
+func (uu *UserUpdate) UnsetInts() *UserUpdate {
+	uu.mutation.ResetInts()
+	return uu
+}
+
 // SetFloats sets the floats field.
 func (uu *UserUpdate) SetFloats(f []float64) *UserUpdate {
 	uu.mutation.SetFloats(f)
@@ -94,6 +150,14 @@ func (uu *UserUpdate) ClearFloats() *UserUpdate {
 	return uu
 }
 
+// UnsetFloats removes the changes of the floats field from the builder (e.g. a previous call
+// to SetFloats), and therefore, the field is left unchanged in the database. Unlike ClearFloats,
+// it does not set the field to NULL, and also removes a previous call to ClearFloats.
+func (uu *UserUpdate) UnsetFloats() *UserUpdate {
+	uu.mutation.ResetFloats()
+	return uu
+}
+
 // SetStrings sets the strings field.
 func (uu *UserUpdate) SetStrings(s []string) *UserUpdate {
 	uu.mutation.SetStrings(s)
@@ -106,8 +170,75 @@ func (uu *UserUpdate) ClearStrings() *UserUpdate {
 	return uu
 }
 
+// UnsetStrings removes the changes of the strings field from the builder (e.g. a previous call
+// to SetStrings), and therefore, the field is left unchanged in the database. Unlike ClearStrings,
+// it does not set the field to NULL, and also removes a previous call to ClearStrings.
+func (uu *UserUpdate) UnsetStrings() *UserUpdate {
+	uu.mutation.ResetStrings()
+	return uu
+}
+
+// SetMeta sets the meta field.
+func (uu *UserUpdate) SetMeta(m map[string]interface{}) *UserUpdate {
+	uu.mutation.SetMeta(m)
+	return uu
+}
+
+// MergeMeta merges the keys of the given object into the meta field, instead of
+// replacing the whole value. See the UserMutation.MergeMeta method for more info.
+func (uu *UserUpdate) MergeMeta(v map[string]interface{}) *UserUpdate {
+	uu.mutation.MergeMeta(v)
+	return uu
+}
+
+// SetMetaPath sets the value under the given dot-separated path of the meta field, instead
+// of replacing the whole value. See the UserMutation.SetMetaPath method for more info.
+func (uu *UserUpdate) SetMetaPath(path string, v interface{}) *UserUpdate {
+	uu.mutation.SetMetaPath(path, v)
+	return uu
+}
+
+// ClearMeta clears the value of meta.
+func (uu *UserUpdate) ClearMeta() *UserUpdate {
+	uu.mutation.ClearMeta()
+	return uu
+}
+
+// UnsetMeta removes the changes of the meta field from the builder (e.g. a previous call
+// to SetMeta), and therefore, the field is left unchanged in the database. Unlike ClearMeta,
+// it does not set the field to NULL, and also removes a previous call to ClearMeta.
+func (uu *UserUpdate) UnsetMeta() *UserUpdate {
+	uu.mutation.ResetMeta()
+	return uu
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uu *UserUpdate) Unwrap() *UserUpdate {
+	tx, ok := uu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdate is not a transactional builder")
+	}
+	uu.config.driver = tx.drv
+	uu.mutation.driver = tx.drv
+	return uu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uu *UserUpdate) DebugFields() map[string]Value {
+	return debugFields(uu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	if err := checkTx(uu.driver); err != nil {
+		return 0, err
+	}
+	if err := uu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -156,7 +287,13 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = uu.withOperation(ctx, "User", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -174,6 +311,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := uu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if uu.ids != nil {
+		_spec.ScanIDs = uu.ids
+	}
 	if value, ok := uu.mutation.URL(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -181,6 +324,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldURL,
 		})
 	}
+	if value, ok := uu.mutation.MergedURL(); ok {
+		_spec.Fields.Merge = append(_spec.Fields.Merge, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldURL,
+		})
+	}
 	if uu.mutation.URLCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -194,6 +344,13 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldRaw,
 		})
 	}
+	if value, ok := uu.mutation.MergedRaw(); ok {
+		_spec.Fields.Merge = append(_spec.Fields.Merge, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldRaw,
+		})
+	}
 	if uu.mutation.RawCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -252,7 +409,34 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldStrings,
 		})
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	if value, ok := uu.mutation.Meta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMeta,
+		})
+	}
+	if value, ok := uu.mutation.MergedMeta(); ok {
+		_spec.Fields.Merge = append(_spec.Fields.Merge, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMeta,
+		})
+	}
+	if value, ok := uu.mutation.MetaPaths(); ok {
+		_spec.Fields.SetPath = append(_spec.Fields.SetPath, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMeta,
+		})
+	}
+	if uu.mutation.MetaCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMeta,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -263,11 +447,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the User entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (uu *UserUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	uu.ids = &ids
+	defer func() { uu.ids = nil }()
+	if _, err := uu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks      []Hook
+	mutation   *UserMutation
+	predicates []predicate.User
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.predicates = append(uuo.predicates, ps...)
+	return uuo
 }
 
 // SetURL sets the url field.
@@ -276,24 +482,54 @@ func (uuo *UserUpdateOne) SetURL(u *url.URL) *UserUpdateOne {
 	return uuo
 }
 
+// MergeURL merges the keys of the given object into the url field, instead of
+// replacing the whole value. See the UserMutation.MergeURL method for more info.
+func (uuo *UserUpdateOne) MergeURL(v map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.MergeURL(v)
+	return uuo
+}
+
 // ClearURL clears the value of url.
 func (uuo *UserUpdateOne) ClearURL() *UserUpdateOne {
 	uuo.mutation.ClearURL()
 	return uuo
 }
 
+// UnsetURL removes the changes of the url field from the builder (e.g. a previous call
+// to SetURL), and therefore, the field is left unchanged in the database. Unlike ClearURL,
+// it does not set the field to NULL, and also removes a previous call to ClearURL.
+func (uuo *UserUpdateOne) UnsetURL() *UserUpdateOne {
+	uuo.mutation.ResetURL()
+	return uuo
+}
+
 // SetRaw sets the raw field.
 func (uuo *UserUpdateOne) SetRaw(jm json.RawMessage) *UserUpdateOne {
 	uuo.mutation.SetRaw(jm)
 	return uuo
 }
 
+// MergeRaw merges the keys of the given object into the raw field, instead of
+// replacing the whole value. See the UserMutation.MergeRaw method for more info.
+func (uuo *UserUpdateOne) MergeRaw(v map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.MergeRaw(v)
+	return uuo
+}
+
 // ClearRaw clears the value of raw.
 func (uuo *UserUpdateOne) ClearRaw() *UserUpdateOne {
 	uuo.mutation.ClearRaw()
 	return uuo
 }
 
+// UnsetRaw removes the changes of the raw field from the builder (e.g. a previous call
+// to SetRaw), and therefore, the field is left unchanged in the database. Unlike ClearRaw,
+// it does not set the field to NULL, and also removes a previous call to ClearRaw.
+func (uuo *UserUpdateOne) UnsetRaw() *UserUpdateOne {
+	uuo.mutation.ResetRaw()
+	return uuo
+}
+
 // SetDirs sets the dirs field.
 func (uuo *UserUpdateOne) SetDirs(h []http.Dir) *UserUpdateOne {
 	uuo.mutation.SetDirs(h)
@@ -306,6 +542,14 @@ func (uuo *UserUpdateOne) ClearDirs() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetDirs removes the changes of the dirs field from the builder (e.g. a previous call
+// to SetDirs), and therefore, the field is left unchanged in the database. Unlike ClearDirs,
+// it does not set the field to NULL, and also removes a previous call to ClearDirs.
+func (uuo *UserUpdateOne) UnsetDirs() *UserUpdateOne {
+	uuo.mutation.ResetDirs()
+	return uuo
+}
+
 // SetInts sets the ints field.
 func (uuo *UserUpdateOne) SetInts(i []int) *UserUpdateOne {
 	uuo.mutation.SetInts(i)
@@ -318,6 +562,14 @@ func (uuo *UserUpdateOne) ClearInts() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetInts removes the changes of the ints field from the builder (e.g. a previous call
+// to SetInts), and therefore, the field is left unchanged in the database. Unlike ClearInts,
+// it does not set the field to NULL, and also removes a previous call to ClearInts.
+func (uuo *UserUpdateOne) UnsetInts() *UserUpdateOne {
+	uuo.mutation.ResetInts()
+	return uuo
+}
+
 // SetFloats sets the floats field.
 func (uuo *UserUpdateOne) SetFloats(f []float64) *UserUpdateOne {
 	uuo.mutation.SetFloats(f)
@@ -330,6 +582,14 @@ func (uuo *UserUpdateOne) ClearFloats() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetFloats removes the changes of the floats field from the builder (e.g. a previous call
+// to SetFloats), and therefore, the field is left unchanged in the database. Unlike ClearFloats,
+// it does not set the field to NULL, and also removes a previous call to ClearFloats.
+func (uuo *UserUpdateOne) UnsetFloats() *UserUpdateOne {
+	uuo.mutation.ResetFloats()
+	return uuo
+}
+
 // SetStrings sets the strings field.
 func (uuo *UserUpdateOne) SetStrings(s []string) *UserUpdateOne {
 	uuo.mutation.SetStrings(s)
@@ -342,8 +602,75 @@ func (uuo *UserUpdateOne) ClearStrings() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetStrings removes the changes of the strings field from the builder (e.g. a previous call
+// to SetStrings), and therefore, the field is left unchanged in the database. Unlike ClearStrings,
+// it does not set the field to NULL, and also removes a previous call to ClearStrings.
+func (uuo *UserUpdateOne) UnsetStrings() *UserUpdateOne {
+	uuo.mutation.ResetStrings()
+	return uuo
+}
+
+// SetMeta sets the meta field.
+func (uuo *UserUpdateOne) SetMeta(m map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.SetMeta(m)
+	return uuo
+}
+
+// MergeMeta merges the keys of the given object into the meta field, instead of
+// replacing the whole value. See the UserMutation.MergeMeta method for more info.
+func (uuo *UserUpdateOne) MergeMeta(v map[string]interface{}) *UserUpdateOne {
+	uuo.mutation.MergeMeta(v)
+	return uuo
+}
+
+// SetMetaPath sets the value under the given dot-separated path of the meta field, instead
+// of replacing the whole value. See the UserMutation.SetMetaPath method for more info.
+func (uuo *UserUpdateOne) SetMetaPath(path string, v interface{}) *UserUpdateOne {
+	uuo.mutation.SetMetaPath(path, v)
+	return uuo
+}
+
+// ClearMeta clears the value of meta.
+func (uuo *UserUpdateOne) ClearMeta() *UserUpdateOne {
+	uuo.mutation.ClearMeta()
+	return uuo
+}
+
+// UnsetMeta removes the changes of the meta field from the builder (e.g. a previous call
+// to SetMeta), and therefore, the field is left unchanged in the database. Unlike ClearMeta,
+// it does not set the field to NULL, and also removes a previous call to ClearMeta.
+func (uuo *UserUpdateOne) UnsetMeta() *UserUpdateOne {
+	uuo.mutation.ResetMeta()
+	return uuo
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uuo *UserUpdateOne) Unwrap() *UserUpdateOne {
+	tx, ok := uuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserUpdateOne is not a transactional builder")
+	}
+	uuo.config.driver = tx.drv
+	uuo.mutation.driver = tx.drv
+	return uuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uuo *UserUpdateOne) DebugFields() map[string]Value {
+	return debugFields(uuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	if err := checkTx(uuo.driver); err != nil {
+		return nil, err
+	}
+	if err := uuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *User
@@ -392,7 +719,13 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
+	ctx = uuo.withOperation(ctx, "User", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -408,6 +741,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		return nil, fmt.Errorf("missing User.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := uuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.URL(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -415,6 +755,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldURL,
 		})
 	}
+	if value, ok := uuo.mutation.MergedURL(); ok {
+		_spec.Fields.Merge = append(_spec.Fields.Merge, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldURL,
+		})
+	}
 	if uuo.mutation.URLCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -428,6 +775,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldRaw,
 		})
 	}
+	if value, ok := uuo.mutation.MergedRaw(); ok {
+		_spec.Fields.Merge = append(_spec.Fields.Merge, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldRaw,
+		})
+	}
 	if uuo.mutation.RawCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -486,10 +840,39 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 			Column: user.FieldStrings,
 		})
 	}
+	if value, ok := uuo.mutation.Meta(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMeta,
+		})
+	}
+	if value, ok := uuo.mutation.MergedMeta(); ok {
+		_spec.Fields.Merge = append(_spec.Fields.Merge, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMeta,
+		})
+	}
+	if value, ok := uuo.mutation.MetaPaths(); ok {
+		_spec.Fields.SetPath = append(_spec.Fields.SetPath, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: user.FieldMeta,
+		})
+	}
+	if uuo.mutation.MetaCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: user.FieldMeta,
+		})
+	}
 	u = &User{config: uuo.config}
-	_spec.Assign = u.assignValues
-	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return u.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = u.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, uuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -497,5 +880,31 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		u.ID = id
+		if value, ok := uuo.mutation.URL(); ok {
+			u.URL = value
+		}
+		if value, ok := uuo.mutation.Raw(); ok {
+			u.Raw = value
+		}
+		if value, ok := uuo.mutation.Dirs(); ok {
+			u.Dirs = value
+		}
+		if value, ok := uuo.mutation.Ints(); ok {
+			u.Ints = value
+		}
+		if value, ok := uuo.mutation.Floats(); ok {
+			u.Floats = value
+		}
+		if value, ok := uuo.mutation.Strings(); ok {
+			u.Strings = value
+		}
+		if value, ok := uuo.mutation.Meta(); ok {
+			u.Meta = value
+		}
+	}
 	return u, nil
 }
@@ -9,6 +9,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net"
 	"net/http"
 	"net/url"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,6 +54,8 @@ func TestMySQL(t *testing.T) {
 			Floats(t, client)
 			Strings(t, client)
 			RawMessage(t, client)
+			Merge(t, client)
+			Paths(t, client)
 		})
 	}
 }
@@ -79,10 +83,90 @@ func TestPostgres(t *testing.T) {
 			Floats(t, client)
 			Strings(t, client)
 			RawMessage(t, client)
+			Merge(t, client)
+			Paths(t, client)
 		})
 	}
 }
 
+func TestSQLite(t *testing.T) {
+	client, err := ent.Open(dialect.SQLite, "file:json?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	usr := client.User.Create().SetMeta(map[string]interface{}{"a": "a"}).SaveX(ctx)
+	_, err = usr.Update().MergeMeta(map[string]interface{}{"b": "b"}).Save(ctx)
+	require.Error(t, err, "merge is not supported by SQLite")
+	require.Equal(t, usr.Meta, client.User.GetX(ctx, usr.ID).Meta)
+	_, err = usr.Update().SetMetaPath("a", "b").Save(ctx)
+	require.Error(t, err, "path updates are not supported by SQLite")
+
+	usr = client.User.Create().SetMeta(map[string]interface{}{
+		"name":  "a8m",
+		"admin": true,
+		"stats": map[string]interface{}{"age": 30, "score": 9.5},
+	}).SaveX(ctx)
+	usr = client.User.GetX(ctx, usr.ID)
+	name, ok := usr.MetaString("name")
+	require.True(t, ok)
+	require.Equal(t, "a8m", name)
+	admin, ok := usr.MetaBool("admin")
+	require.True(t, ok)
+	require.True(t, admin)
+	age, ok := usr.MetaInt("stats.age")
+	require.True(t, ok)
+	require.Equal(t, 30, age)
+	_, ok = usr.MetaInt("stats.score")
+	require.False(t, ok, "fractional numbers are not integers")
+	score, ok := usr.MetaFloat("stats.score")
+	require.True(t, ok)
+	require.Equal(t, 9.5, score)
+	_, ok = usr.MetaString("admin")
+	require.False(t, ok)
+	_, ok = usr.MetaValue("stats.age.value")
+	require.False(t, ok)
+	_, ok = usr.MetaValue("missing")
+	require.False(t, ok)
+
+	// JSON fields are copied by their types, and values that are not valid JSON are copied as well.
+	usr.Floats = []float64{math.NaN(), 1}
+	usr.Meta["stats"].(map[string]interface{})["age"] = 31
+	clone := usr.Clone()
+	clone.Floats[1] = 2
+	clone.Meta["stats"].(map[string]interface{})["age"] = 32
+	require.Len(t, clone.Floats, 2)
+	require.True(t, math.IsNaN(clone.Floats[0]))
+	require.Equal(t, 1.0, usr.Floats[1])
+	require.Equal(t, 31, usr.Meta["stats"].(map[string]interface{})["age"])
+	require.Equal(t, 32, clone.Meta["stats"].(map[string]interface{})["age"])
+}
+
+func Paths(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetMeta(map[string]interface{}{"a": "a", "b": map[string]interface{}{"c": 1}}).SaveX(ctx)
+	usr = usr.Update().SetMetaPath("a", "b").SetMetaPath("b.c", 2).SetMetaPath("b.d", true).SaveX(ctx)
+	require.Equal(t, map[string]interface{}{"a": "b", "b": map[string]interface{}{"c": float64(2), "d": true}}, usr.Meta)
+	c, ok := usr.MetaInt("b.c")
+	require.True(t, ok)
+	require.Equal(t, 2, c)
+	usr = client.User.Create().SaveX(ctx)
+	n := client.User.Update().Where(user.ID(usr.ID)).SetMetaPath("a", "a").SaveX(ctx)
+	require.Equal(t, 1, n)
+	require.Equal(t, map[string]interface{}{"a": "a"}, client.User.GetX(ctx, usr.ID).Meta, "set path of NULL")
+}
+
+func Merge(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	usr := client.User.Create().SetMeta(map[string]interface{}{"a": "a", "b": "b"}).SaveX(ctx)
+	usr = usr.Update().MergeMeta(map[string]interface{}{"b": "c", "d": "d"}).SaveX(ctx)
+	require.Equal(t, map[string]interface{}{"a": "a", "b": "c", "d": "d"}, usr.Meta)
+	usr = client.User.Create().SaveX(ctx)
+	n := client.User.Update().Where(user.ID(usr.ID)).MergeMeta(map[string]interface{}{"a": "a"}).SaveX(ctx)
+	require.Equal(t, 1, n)
+	require.Equal(t, map[string]interface{}{"a": "a"}, client.User.GetX(ctx, usr.ID).Meta, "merge into NULL")
+}
+
 func Ints(t *testing.T, client *ent.Client) {
 	ctx := context.Background()
 	ints := []int{1, 2, 3}
@@ -26,6 +26,11 @@ const (
 	OwnerColumn = "user_car"
 )
 
+// Edges holds all the edge names of the Car type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+}
+
 // Columns holds all SQL columns for car fields.
 var Columns = []string{
 	FieldID,
@@ -35,3 +40,13 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"user_car",
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
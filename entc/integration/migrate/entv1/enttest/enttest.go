@@ -57,13 +57,18 @@ func newOptions(opts []Option) *options {
 
 // Open calls entv1.Open and auto-run migration.
 func Open(t TestingT, driverName, dataSourceName string, opts ...Option) *entv1.Client {
+	return OpenContext(context.Background(), t, driverName, dataSourceName, opts...)
+}
+
+// OpenContext is like Open, but it runs the migration with the given context.
+func OpenContext(ctx context.Context, t TestingT, driverName, dataSourceName string, opts ...Option) *entv1.Client {
 	o := newOptions(opts)
 	c, err := entv1.Open(driverName, dataSourceName, o.opts...)
 	if err != nil {
 		t.Error(err)
 		t.FailNow()
 	}
-	if err := c.Schema.Create(context.Background(), o.migrateOpts...); err != nil {
+	if err := c.Schema.Create(ctx, o.migrateOpts...); err != nil {
 		t.Error(err)
 		t.FailNow()
 	}
@@ -72,9 +77,14 @@ func Open(t TestingT, driverName, dataSourceName string, opts ...Option) *entv1.
 
 // NewClient calls entv1.NewClient and auto-run migration.
 func NewClient(t TestingT, opts ...Option) *entv1.Client {
+	return NewClientContext(context.Background(), t, opts...)
+}
+
+// NewClientContext is like NewClient, but it runs the migration with the given context.
+func NewClientContext(ctx context.Context, t TestingT, opts ...Option) *entv1.Client {
 	o := newOptions(opts)
 	c := entv1.NewClient(o.opts...)
-	if err := c.Schema.Create(context.Background(), o.migrateOpts...); err != nil {
+	if err := c.Schema.Create(ctx, o.migrateOpts...); err != nil {
 		t.Error(err)
 		t.FailNow()
 	}
@@ -7,6 +7,7 @@
 package entv1
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/car"
@@ -38,6 +39,7 @@ type CarMutation struct {
 	clearedFields map[string]struct{}
 	owner         *int
 	clearedowner  bool
+	conflictowner bool
 }
 
 var _ ent.Mutation = (*CarMutation)(nil)
@@ -71,6 +73,18 @@ func (m CarMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CarMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CarMutation) ID() (id int, exists bool) {
@@ -83,11 +97,15 @@ func (m *CarMutation) ID() (id int, exists bool) {
 // SetOwnerID sets the owner edge to User by id.
 func (m *CarMutation) SetOwnerID(id int) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to User.
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *CarMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -103,6 +121,34 @@ func (m *CarMutation) OwnerID() (id int, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *CarMutation) OwnerIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("entv1: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("entv1: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Car.Query().Where(car.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -117,6 +163,7 @@ func (m *CarMutation) OwnerIDs() (ids []int) {
 func (m *CarMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // Op returns the operation name.
@@ -129,6 +176,11 @@ func (m *CarMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CarMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -303,12 +355,15 @@ type UserMutation struct {
 	clearedFields   map[string]struct{}
 	parent          *int
 	clearedparent   bool
+	conflictparent  bool
 	children        map[int]struct{}
 	removedchildren map[int]struct{}
 	spouse          *int
 	clearedspouse   bool
+	conflictspouse  bool
 	car             *int
 	clearedcar      bool
+	conflictcar     bool
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -342,6 +397,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // SetID sets the value of the id field. Note that, this
 // operation is accepted only on User creation.
 func (m *UserMutation) SetID(id int) {
@@ -565,11 +632,15 @@ func (m *UserMutation) ResetState() {
 // SetParentID sets the parent edge to User by id.
 func (m *UserMutation) SetParentID(id int) {
 	m.parent = &id
+	m.conflictparent = false
 }
 
-// ClearParent clears the parent edge to User.
+// ClearParent clears the parent edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearParent() {
 	m.clearedparent = true
+	m.conflictparent = m.parent != nil
 }
 
 // ParentCleared returns if the edge parent was cleared.
@@ -585,6 +656,34 @@ func (m *UserMutation) ParentID() (id int, exists bool) {
 	return
 }
 
+// ParentIDChanged reports if the parent edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) ParentIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.parent == nil && !m.clearedparent {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("entv1: ParentIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("entv1: ParentIDChanged requires an ID field in the mutation")
+	}
+	if m.parent != nil {
+		newID = *m.parent
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryParent().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.parent != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old parent id: %v", err)
+	}
+	return oldID, newID, m.parent == nil || oldID != newID, nil
+}
+
 // ParentIDs returns the parent ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // ParentID instead. It exists only for internal usage by the builders.
@@ -599,6 +698,7 @@ func (m *UserMutation) ParentIDs() (ids []int) {
 func (m *UserMutation) ResetParent() {
 	m.parent = nil
 	m.clearedparent = false
+	m.conflictparent = false
 }
 
 // AddChildIDs adds the children edge to User by ids.
@@ -646,11 +746,15 @@ func (m *UserMutation) ResetChildren() {
 // SetSpouseID sets the spouse edge to User by id.
 func (m *UserMutation) SetSpouseID(id int) {
 	m.spouse = &id
+	m.conflictspouse = false
 }
 
-// ClearSpouse clears the spouse edge to User.
+// ClearSpouse clears the spouse edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearSpouse() {
 	m.clearedspouse = true
+	m.conflictspouse = m.spouse != nil
 }
 
 // SpouseCleared returns if the edge spouse was cleared.
@@ -666,6 +770,34 @@ func (m *UserMutation) SpouseID() (id int, exists bool) {
 	return
 }
 
+// SpouseIDChanged reports if the spouse edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) SpouseIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.spouse == nil && !m.clearedspouse {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("entv1: SpouseIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("entv1: SpouseIDChanged requires an ID field in the mutation")
+	}
+	if m.spouse != nil {
+		newID = *m.spouse
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QuerySpouse().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.spouse != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old spouse id: %v", err)
+	}
+	return oldID, newID, m.spouse == nil || oldID != newID, nil
+}
+
 // SpouseIDs returns the spouse ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // SpouseID instead. It exists only for internal usage by the builders.
@@ -680,16 +812,21 @@ func (m *UserMutation) SpouseIDs() (ids []int) {
 func (m *UserMutation) ResetSpouse() {
 	m.spouse = nil
 	m.clearedspouse = false
+	m.conflictspouse = false
 }
 
 // SetCarID sets the car edge to Car by id.
 func (m *UserMutation) SetCarID(id int) {
 	m.car = &id
+	m.conflictcar = false
 }
 
-// ClearCar clears the car edge to Car.
+// ClearCar clears the car edge to Car. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearCar() {
 	m.clearedcar = true
+	m.conflictcar = m.car != nil
 }
 
 // CarCleared returns if the edge car was cleared.
@@ -705,6 +842,34 @@ func (m *UserMutation) CarID() (id int, exists bool) {
 	return
 }
 
+// CarIDChanged reports if the car edge is set to a different Car, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) CarIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.car == nil && !m.clearedcar {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("entv1: CarIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("entv1: CarIDChanged requires an ID field in the mutation")
+	}
+	if m.car != nil {
+		newID = *m.car
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryCar().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.car != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old car id: %v", err)
+	}
+	return oldID, newID, m.car == nil || oldID != newID, nil
+}
+
 // CarIDs returns the car ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // CarID instead. It exists only for internal usage by the builders.
@@ -719,6 +884,7 @@ func (m *UserMutation) CarIDs() (ids []int) {
 func (m *UserMutation) ResetCar() {
 	m.car = nil
 	m.clearedcar = false
+	m.conflictcar = false
 }
 
 // Op returns the operation name.
@@ -731,6 +897,11 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
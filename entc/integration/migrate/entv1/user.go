@@ -7,7 +7,12 @@
 package entv1
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -39,6 +44,9 @@ type User struct {
 	Edges         UserEdges `json:"edges"`
 	user_children *int
 	user_spouse   *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -107,93 +115,125 @@ func (e UserEdges) CarOrErr() (*Car, error) {
 	return nil, &NotLoadedError{edge: "car"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*User) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullInt64{},  // age
-		&sql.NullString{}, // name
-		&sql.NullString{}, // nickname
-		&sql.NullString{}, // address
-		&sql.NullString{}, // renamed
-		&[]byte{},         // blob
-		&sql.NullString{}, // state
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*User) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // user_children
-		&sql.NullInt64{}, // user_spouse
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the User type are scanned as generic values.
+func (*User) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &sql.NullInt64{}
+		case user.FieldAge:
+			values[i] = &sql.NullInt64{}
+		case user.FieldName:
+			values[i] = &sql.NullString{}
+		case user.FieldNickname:
+			values[i] = &sql.NullString{}
+		case user.FieldAddress:
+			values[i] = &sql.NullString{}
+		case user.FieldRenamed:
+			values[i] = &sql.NullString{}
+		case user.FieldBlob:
+			values[i] = &[]byte{}
+		case user.FieldState:
+			values[i] = &sql.NullString{}
+		case user.ForeignKeys[0]: // user_children
+			values[i] = &sql.NullInt64{}
+		case user.ForeignKeys[1]: // user_spouse
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the User fields.
-func (u *User) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(user.Columns); m < n {
+// to the User fields. Values of columns that were not selected are left untouched.
+func (u *User) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	u.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field age", values[0])
-	} else if value.Valid {
-		u.Age = int32(value.Int64)
-	}
-	if value, ok := values[1].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[1])
-	} else if value.Valid {
-		u.Name = value.String
-	}
-	if value, ok := values[2].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field nickname", values[2])
-	} else if value.Valid {
-		u.Nickname = value.String
-	}
-	if value, ok := values[3].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field address", values[3])
-	} else if value.Valid {
-		u.Address = value.String
-	}
-	if value, ok := values[4].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field renamed", values[4])
-	} else if value.Valid {
-		u.Renamed = value.String
-	}
-	if value, ok := values[5].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field blob", values[5])
-	} else if value != nil {
-		u.Blob = *value
-	}
-	if value, ok := values[6].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field state", values[6])
-	} else if value.Valid {
-		u.State = user.State(value.String)
-	}
-	values = values[7:]
-	if len(values) == len(user.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_children", value)
-		} else if value.Valid {
-			u.user_children = new(int)
-			*u.user_children = int(value.Int64)
-		}
-		if value, ok := values[1].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_spouse", value)
-		} else if value.Valid {
-			u.user_spouse = new(int)
-			*u.user_spouse = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case user.FieldAge:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field age", values[i])
+			} else if value.Valid {
+				u.Age = int32(value.Int64)
+			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				u.Name = value.String
+			}
+		case user.FieldNickname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nickname", values[i])
+			} else if value.Valid {
+				u.Nickname = value.String
+			}
+		case user.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				u.Address = value.String
+			}
+		case user.FieldRenamed:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field renamed", values[i])
+			} else if value.Valid {
+				u.Renamed = value.String
+			}
+		case user.FieldBlob:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field blob", values[i])
+			} else if value != nil {
+				u.Blob = *value
+			}
+		case user.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				u.State = user.State(value.String)
+			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_children", value)
+			} else if value.Valid {
+				u.user_children = new(int)
+				*u.user_children = int(value.Int64)
+			}
+		case user.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_spouse", value)
+			} else if value.Valid {
+				u.user_spouse = new(int)
+				*u.user_spouse = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (u *User) SelectValue(alias string) (Value, error) {
+	v, ok := u.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("entv1: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryParent queries the parent edge of the User.
 func (u *User) QueryParent() *UserQuery {
 	return (&UserClient{config: u.config}).QueryParent(u)
@@ -214,6 +254,131 @@ func (u *User) QueryCar() *CarQuery {
 	return (&UserClient{config: u.config}).QueryCar(u)
 }
 
+// LoadEdges loads the given edges of the User using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see user.Edges), and in this case, no edge is loaded.
+//
+//	err := u.LoadEdges(ctx, client, user.EdgeParent, user.EdgeChildren)
+//
+func (u *User) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadUserEdges(ctx, client, []*User{u}, edges)
+}
+
+// loadUserEdges loads the given edges of all the given User entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using UserClient.LoadEdgeFor.
+func loadUserEdges(ctx context.Context, client *Client, nodes []*User, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case user.EdgeParent, user.EdgeChildren, user.EdgeSpouse, user.EdgeCar:
+		default:
+			return fmt.Errorf("entv1: unknown edge %q for type User", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.User.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the User and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the User alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The User itself is not modified.
+//
+//	b, err := u.DumpGraph(ctx, client, 2, user.EdgeParent)
+//
+func (u *User) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("entv1: invalid depth %d for User DumpGraph", depth)
+	}
+	dumped := *u
+	dumped.Edges = UserEdges{}
+	if err := dumpUsers(ctx, client, []*User{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpUsers loads the edges of the given User copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpUsers(ctx context.Context, client *Client, nodes []*User, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*User
+	for _, n := range nodes {
+		if dumper.visit(user.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range user.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadUserEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextParent []*User
+	for _, n := range visit {
+		if e := n.Edges.Parent; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Parent = &c
+			nextParent = append(nextParent, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextParent, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextChildren []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Children {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Children[i] = &c
+			nextChildren = append(nextChildren, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextChildren, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextSpouse []*User
+	for _, n := range visit {
+		if e := n.Edges.Spouse; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Spouse = &c
+			nextSpouse = append(nextSpouse, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextSpouse, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextCar []*Car
+	for _, n := range visit {
+		if e := n.Edges.Car; e != nil {
+			c := *e
+			c.Edges = CarEdges{}
+			n.Edges.Car = &c
+			nextCar = append(nextCar, &c)
+		}
+	}
+	if err := dumpCars(ctx, client, nextCar, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -232,6 +397,38 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the User and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if v, ok := seen[u]; ok {
+		return v.(*User)
+	}
+	_c := *u
+	seen[u] = &_c
+	if u.Blob != nil {
+		_c.Blob = append([]byte{}, u.Blob...)
+	}
+	_c.Edges.Parent = u.Edges.Parent.clone(seen)
+	if u.Edges.Children != nil {
+		_c.Edges.Children = make([]*User, len(u.Edges.Children))
+		for _i := range u.Edges.Children {
+			_c.Edges.Children[_i] = u.Edges.Children[_i].clone(seen)
+		}
+	}
+	_c.Edges.Spouse = u.Edges.Spouse.clone(seen)
+	_c.Edges.Car = u.Edges.Car.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	var builder strings.Builder
@@ -255,6 +452,46 @@ func (u *User) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the User, for change detection and cache keys.
+// It covers the fields that are listed in user.FingerprintFields, and it does not depend on the
+// ID of the User, on its edges, or on the order of the fields in the schema.
+func (u *User) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "address", u.Address)
+	fingerprint(h, "age", u.Age)
+	fingerprint(h, "blob", u.Blob)
+	fingerprint(h, "name", u.Name)
+	fingerprint(h, "nickname", u.Nickname)
+	fingerprint(h, "renamed", u.Renamed)
+	fingerprint(h, "state", u.State)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the User for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The User can be decoded using the FromMap method of its client.
+func (u *User) ToMap() map[string]string {
+	m := make(map[string]string, 8)
+	m[user.FieldID] = kvEncode(u.ID)
+	m[user.FieldAge] = kvEncode(u.Age)
+	m[user.FieldName] = kvEncode(u.Name)
+	m[user.FieldNickname] = kvEncode(u.Nickname)
+	if v := u.Address; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldAddress] = kvEncode(v)
+	}
+	if v := u.Renamed; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldRenamed] = kvEncode(v)
+	}
+	if v := u.Blob; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldBlob] = kvEncode(v)
+	}
+	if v := u.State; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldState] = kvEncode(v)
+	}
+	return m
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
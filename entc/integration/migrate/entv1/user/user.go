@@ -55,6 +55,26 @@ const (
 	CarColumn = "user_car"
 )
 
+// Edges holds all the edge names of the User type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeParent,
+	EdgeChildren,
+	EdgeSpouse,
+	EdgeCar,
+}
+
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldAddress,
+	FieldAge,
+	FieldBlob,
+	FieldName,
+	FieldNickname,
+	FieldRenamed,
+	FieldState,
+}
+
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
@@ -73,6 +93,16 @@ var ForeignKeys = []string{
 	"user_spouse",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
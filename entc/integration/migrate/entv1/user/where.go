@@ -7,6 +7,8 @@
 package user
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1/predicate"
@@ -296,6 +298,13 @@ func NameContains(v string) predicate.User {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -303,6 +312,13 @@ func NameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -310,6 +326,13 @@ func NameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -324,6 +347,20 @@ func NameContainsFold(v string) predicate.User {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -407,6 +444,13 @@ func NicknameContains(v string) predicate.User {
 	})
 }
 
+// NicknameNotContains applies the NotContains predicate on the "nickname" field.
+func NicknameNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameHasPrefix applies the HasPrefix predicate on the "nickname" field.
 func NicknameHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -414,6 +458,13 @@ func NicknameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasPrefix applies the NotHasPrefix predicate on the "nickname" field.
+func NicknameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameHasSuffix applies the HasSuffix predicate on the "nickname" field.
 func NicknameHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -421,6 +472,13 @@ func NicknameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasSuffix applies the NotHasSuffix predicate on the "nickname" field.
+func NicknameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameEqualFold applies the EqualFold predicate on the "nickname" field.
 func NicknameEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -435,6 +493,20 @@ func NicknameContainsFold(v string) predicate.User {
 	})
 }
 
+// NicknameHasPrefixFold applies the HasPrefixFold predicate on the "nickname" field.
+func NicknameHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNickname), v))
+	})
+}
+
+// NicknameHasSuffixFold applies the HasSuffixFold predicate on the "nickname" field.
+func NicknameHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNickname), v))
+	})
+}
+
 // AddressEQ applies the EQ predicate on the "address" field.
 func AddressEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -518,6 +590,13 @@ func AddressContains(v string) predicate.User {
 	})
 }
 
+// AddressNotContains applies the NotContains predicate on the "address" field.
+func AddressNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldAddress), v))
+	})
+}
+
 // AddressHasPrefix applies the HasPrefix predicate on the "address" field.
 func AddressHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -525,6 +604,13 @@ func AddressHasPrefix(v string) predicate.User {
 	})
 }
 
+// AddressNotHasPrefix applies the NotHasPrefix predicate on the "address" field.
+func AddressNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldAddress), v))
+	})
+}
+
 // AddressHasSuffix applies the HasSuffix predicate on the "address" field.
 func AddressHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -532,6 +618,13 @@ func AddressHasSuffix(v string) predicate.User {
 	})
 }
 
+// AddressNotHasSuffix applies the NotHasSuffix predicate on the "address" field.
+func AddressNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldAddress), v))
+	})
+}
+
 // AddressIsNil applies the IsNil predicate on the "address" field.
 func AddressIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -560,6 +653,20 @@ func AddressContainsFold(v string) predicate.User {
 	})
 }
 
+// AddressHasPrefixFold applies the HasPrefixFold predicate on the "address" field.
+func AddressHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldAddress), v))
+	})
+}
+
+// AddressHasSuffixFold applies the HasSuffixFold predicate on the "address" field.
+func AddressHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldAddress), v))
+	})
+}
+
 // RenamedEQ applies the EQ predicate on the "renamed" field.
 func RenamedEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -643,6 +750,13 @@ func RenamedContains(v string) predicate.User {
 	})
 }
 
+// RenamedNotContains applies the NotContains predicate on the "renamed" field.
+func RenamedNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldRenamed), v))
+	})
+}
+
 // RenamedHasPrefix applies the HasPrefix predicate on the "renamed" field.
 func RenamedHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -650,6 +764,13 @@ func RenamedHasPrefix(v string) predicate.User {
 	})
 }
 
+// RenamedNotHasPrefix applies the NotHasPrefix predicate on the "renamed" field.
+func RenamedNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldRenamed), v))
+	})
+}
+
 // RenamedHasSuffix applies the HasSuffix predicate on the "renamed" field.
 func RenamedHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -657,6 +778,13 @@ func RenamedHasSuffix(v string) predicate.User {
 	})
 }
 
+// RenamedNotHasSuffix applies the NotHasSuffix predicate on the "renamed" field.
+func RenamedNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldRenamed), v))
+	})
+}
+
 // RenamedIsNil applies the IsNil predicate on the "renamed" field.
 func RenamedIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -685,6 +813,20 @@ func RenamedContainsFold(v string) predicate.User {
 	})
 }
 
+// RenamedHasPrefixFold applies the HasPrefixFold predicate on the "renamed" field.
+func RenamedHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldRenamed), v))
+	})
+}
+
+// RenamedHasSuffixFold applies the HasSuffixFold predicate on the "renamed" field.
+func RenamedHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldRenamed), v))
+	})
+}
+
 // BlobEQ applies the EQ predicate on the "blob" field.
 func BlobEQ(v []byte) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -837,6 +979,107 @@ func StateNotNil() predicate.User {
 	})
 }
 
+// AddressEQNullSafe applies a NULL-safe equality predicate on the "address" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func AddressEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldAddress), arg))
+	})
+}
+
+// RenamedEQNullSafe applies a NULL-safe equality predicate on the "renamed" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func RenamedEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldRenamed), arg))
+	})
+}
+
+// StateEQNullSafe applies a NULL-safe equality predicate on the "state" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func StateEQNullSafe(v *State) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldState), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldAddress:  "string",
+	FieldAge:      "numeric",
+	FieldID:       "numeric",
+	FieldName:     "string",
+	FieldNickname: "string",
+	FieldRenamed:  "string",
+	FieldState:    "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the User.
+func FieldsEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the User.
+func FieldsNEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the User.
+//
+//	user.FieldsLT(user.FieldName, user.FieldNickname)
+//
+func FieldsLT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the User.
+func FieldsLTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the User.
+func FieldsGT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the User.
+func FieldsGTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the User using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("entv1: field %q is not a comparable field of User", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("entv1: field %q is not a comparable field of User", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("entv1: fields %q (%s) and %q (%s) of User are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -865,6 +1108,45 @@ func HasParentWith(preds ...predicate.User) predicate.User {
 	})
 }
 
+// ParentIDIn applies a predicate on the "parent" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	user.ParentIDIn(client.User.Query().Where(...))
+//
+func ParentIDIn(q sqlgraph.IDsQuerier) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(ParentColumn), sub))
+	})
+}
+
+// ParentMissing applies a predicate on the "parent" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_children IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_children)
+//
+func ParentMissing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("parent")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(ParentColumn)),
+				sql.NotExists(
+					builder.Select(t.C("oid")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("oid"), s.C(ParentColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasChildren applies the HasEdge predicate on the "children" edge.
 func HasChildren() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -921,6 +1203,45 @@ func HasSpouseWith(preds ...predicate.User) predicate.User {
 	})
 }
 
+// SpouseIDIn applies a predicate on the "spouse" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	user.SpouseIDIn(client.User.Query().Where(...))
+//
+func SpouseIDIn(q sqlgraph.IDsQuerier) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(SpouseColumn), sub))
+	})
+}
+
+// SpouseMissing applies a predicate on the "spouse" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_spouse IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_spouse)
+//
+func SpouseMissing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(Table).As("spouse")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(SpouseColumn)),
+				sql.NotExists(
+					builder.Select(t.C("oid")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("oid"), s.C(SpouseColumn))),
+				),
+			),
+		)
+	})
+}
+
 // HasCar applies the HasEdge predicate on the "car" edge.
 func HasCar() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -956,6 +1277,9 @@ func And(predicates ...predicate.User) predicate.User {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -970,6 +1294,9 @@ func Or(predicates ...predicate.User) predicate.User {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -980,3 +1307,73 @@ func Not(p predicate.User) predicate.User {
 		p(s.Not())
 	})
 }
+
+// UserFilter holds optional filters on the User fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type UserFilter struct {
+	IDIn       []int
+	Age        *int32
+	Name       *string
+	Nickname   *string
+	Address    *string
+	Renamed    *string
+	State      *State
+	ParentIDIn []int
+	SpouseIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.User.Query().
+//		Where(user.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *UserFilter) []predicate.User {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.User
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Age != nil {
+		ps = append(ps, AgeEQ(*f.Age))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.Nickname != nil {
+		ps = append(ps, NicknameEQ(*f.Nickname))
+	}
+	if f.Address != nil {
+		ps = append(ps, AddressEQ(*f.Address))
+	}
+	if f.Renamed != nil {
+		ps = append(ps, RenamedEQ(*f.Renamed))
+	}
+	if f.State != nil {
+		ps = append(ps, StateEQ(*f.State))
+	}
+	if ids := f.ParentIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.User(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(ParentColumn), v...))
+		}))
+	}
+	if ids := f.SpouseIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.User(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(SpouseColumn), v...))
+		}))
+	}
+	return ps
+}
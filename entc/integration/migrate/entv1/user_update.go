@@ -8,6 +8,7 @@ package entv1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -24,6 +25,9 @@ type UserUpdate struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,6 +36,13 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Limit limits the number of users that are updated by the builder. For example,
+// for updating large amounts of users in small batches without locking the whole table.
+func (uu *UserUpdate) Limit(limit int) *UserUpdate {
+	uu.limit = &limit
+	return uu
+}
+
 // SetAge sets the age field.
 func (uu *UserUpdate) SetAge(i int32) *UserUpdate {
 	uu.mutation.ResetAge()
@@ -45,18 +56,39 @@ func (uu *UserUpdate) AddAge(i int32) *UserUpdate {
 	return uu
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetAge() *UserUpdate {
+	uu.mutation.ResetAge()
+	return uu
+}
+
 // SetName sets the name field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
 	return uu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetName() *UserUpdate {
+	uu.mutation.ResetName()
+	return uu
+}
+
 // SetNickname sets the nickname field.
 func (uu *UserUpdate) SetNickname(s string) *UserUpdate {
 	uu.mutation.SetNickname(s)
 	return uu
 }
 
+// UnsetNickname removes the changes of the nickname field from the builder (e.g. a previous call
+// to SetNickname), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetNickname() *UserUpdate {
+	uu.mutation.ResetNickname()
+	return uu
+}
+
 // SetAddress sets the address field.
 func (uu *UserUpdate) SetAddress(s string) *UserUpdate {
 	uu.mutation.SetAddress(s)
@@ -77,6 +109,14 @@ func (uu *UserUpdate) ClearAddress() *UserUpdate {
 	return uu
 }
 
+// UnsetAddress removes the changes of the address field from the builder (e.g. a previous call
+// to SetAddress), and therefore, the field is left unchanged in the database. Unlike ClearAddress,
+// it does not set the field to NULL, and also removes a previous call to ClearAddress.
+func (uu *UserUpdate) UnsetAddress() *UserUpdate {
+	uu.mutation.ResetAddress()
+	return uu
+}
+
 // SetRenamed sets the renamed field.
 func (uu *UserUpdate) SetRenamed(s string) *UserUpdate {
 	uu.mutation.SetRenamed(s)
@@ -97,6 +137,14 @@ func (uu *UserUpdate) ClearRenamed() *UserUpdate {
 	return uu
 }
 
+// UnsetRenamed removes the changes of the renamed field from the builder (e.g. a previous call
+// to SetRenamed), and therefore, the field is left unchanged in the database. Unlike ClearRenamed,
+// it does not set the field to NULL, and also removes a previous call to ClearRenamed.
+func (uu *UserUpdate) UnsetRenamed() *UserUpdate {
+	uu.mutation.ResetRenamed()
+	return uu
+}
+
 // SetBlob sets the blob field.
 func (uu *UserUpdate) SetBlob(b []byte) *UserUpdate {
 	uu.mutation.SetBlob(b)
@@ -109,6 +157,14 @@ func (uu *UserUpdate) ClearBlob() *UserUpdate {
 	return uu
 }
 
+// UnsetBlob removes the changes of the blob field from the builder (e.g. a previous call
+// to SetBlob), and therefore, the field is left unchanged in the database. Unlike ClearBlob,
+// it does not set the field to NULL, and also removes a previous call to ClearBlob.
+func (uu *UserUpdate) UnsetBlob() *UserUpdate {
+	uu.mutation.ResetBlob()
+	return uu
+}
+
 // SetState sets the state field.
 func (uu *UserUpdate) SetState(u user.State) *UserUpdate {
 	uu.mutation.SetState(u)
@@ -129,6 +185,14 @@ func (uu *UserUpdate) ClearState() *UserUpdate {
 	return uu
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (uu *UserUpdate) UnsetState() *UserUpdate {
+	uu.mutation.ResetState()
+	return uu
+}
+
 // SetParentID sets the parent edge to User by id.
 func (uu *UserUpdate) SetParentID(id int) *UserUpdate {
 	uu.mutation.SetParentID(id)
@@ -234,19 +298,33 @@ func (uu *UserUpdate) ClearCar() *UserUpdate {
 	return uu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uu *UserUpdate) Unwrap() *UserUpdate {
+	tx, ok := uu.config.driver.(*txDriver)
+	if !ok {
+		panic("entv1: UserUpdate is not a transactional builder")
+	}
+	uu.config.driver = tx.drv
+	uu.mutation.driver = tx.drv
+	return uu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uu *UserUpdate) DebugFields() map[string]Value {
+	return debugFields(uu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := uu.mutation.Name(); ok {
-		if err := user.NameValidator(v); err != nil {
-			return 0, fmt.Errorf("entv1: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(uu.driver); err != nil {
+		return 0, err
 	}
-	if v, ok := uu.mutation.State(); ok {
-		if err := user.StateValidator(v); err != nil {
-			return 0, fmt.Errorf("entv1: validator failed for field \"state\": %v", err)
-		}
+	if err := uu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -295,7 +373,32 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("entv1: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := uu.mutation.State(); ok {
+		if err := user.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("entv1: validator failed for field \"state\": %v", err)}
+		}
+	}
+	if uu.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("entv1: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	if uu.mutation.conflictspouse {
+		return &ValidationError{Name: "spouse", err: errors.New("entv1: setting and clearing the unique edge \"spouse\" in the same mutation")}
+	}
+	if uu.mutation.conflictcar {
+		return &ValidationError{Name: "car", err: errors.New("entv1: setting and clearing the unique edge \"car\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = uu.withOperation(ctx, "User", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -313,6 +416,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := uu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if uu.ids != nil {
+		_spec.ScanIDs = uu.ids
+	}
 	if value, ok := uu.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -536,7 +645,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -547,11 +656,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the User entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (uu *UserUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	uu.ids = &ids
+	defer func() { uu.ids = nil }()
+	if _, err := uu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks      []Hook
+	mutation   *UserMutation
+	predicates []predicate.User
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.predicates = append(uuo.predicates, ps...)
+	return uuo
 }
 
 // SetAge sets the age field.
@@ -567,18 +698,39 @@ func (uuo *UserUpdateOne) AddAge(i int32) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetAge() *UserUpdateOne {
+	uuo.mutation.ResetAge()
+	return uuo
+}
+
 // SetName sets the name field.
 func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	uuo.mutation.SetName(s)
 	return uuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetName() *UserUpdateOne {
+	uuo.mutation.ResetName()
+	return uuo
+}
+
 // SetNickname sets the nickname field.
 func (uuo *UserUpdateOne) SetNickname(s string) *UserUpdateOne {
 	uuo.mutation.SetNickname(s)
 	return uuo
 }
 
+// UnsetNickname removes the changes of the nickname field from the builder (e.g. a previous call
+// to SetNickname), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetNickname() *UserUpdateOne {
+	uuo.mutation.ResetNickname()
+	return uuo
+}
+
 // SetAddress sets the address field.
 func (uuo *UserUpdateOne) SetAddress(s string) *UserUpdateOne {
 	uuo.mutation.SetAddress(s)
@@ -599,6 +751,14 @@ func (uuo *UserUpdateOne) ClearAddress() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetAddress removes the changes of the address field from the builder (e.g. a previous call
+// to SetAddress), and therefore, the field is left unchanged in the database. Unlike ClearAddress,
+// it does not set the field to NULL, and also removes a previous call to ClearAddress.
+func (uuo *UserUpdateOne) UnsetAddress() *UserUpdateOne {
+	uuo.mutation.ResetAddress()
+	return uuo
+}
+
 // SetRenamed sets the renamed field.
 func (uuo *UserUpdateOne) SetRenamed(s string) *UserUpdateOne {
 	uuo.mutation.SetRenamed(s)
@@ -619,6 +779,14 @@ func (uuo *UserUpdateOne) ClearRenamed() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetRenamed removes the changes of the renamed field from the builder (e.g. a previous call
+// to SetRenamed), and therefore, the field is left unchanged in the database. Unlike ClearRenamed,
+// it does not set the field to NULL, and also removes a previous call to ClearRenamed.
+func (uuo *UserUpdateOne) UnsetRenamed() *UserUpdateOne {
+	uuo.mutation.ResetRenamed()
+	return uuo
+}
+
 // SetBlob sets the blob field.
 func (uuo *UserUpdateOne) SetBlob(b []byte) *UserUpdateOne {
 	uuo.mutation.SetBlob(b)
@@ -631,6 +799,14 @@ func (uuo *UserUpdateOne) ClearBlob() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetBlob removes the changes of the blob field from the builder (e.g. a previous call
+// to SetBlob), and therefore, the field is left unchanged in the database. Unlike ClearBlob,
+// it does not set the field to NULL, and also removes a previous call to ClearBlob.
+func (uuo *UserUpdateOne) UnsetBlob() *UserUpdateOne {
+	uuo.mutation.ResetBlob()
+	return uuo
+}
+
 // SetState sets the state field.
 func (uuo *UserUpdateOne) SetState(u user.State) *UserUpdateOne {
 	uuo.mutation.SetState(u)
@@ -651,6 +827,14 @@ func (uuo *UserUpdateOne) ClearState() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (uuo *UserUpdateOne) UnsetState() *UserUpdateOne {
+	uuo.mutation.ResetState()
+	return uuo
+}
+
 // SetParentID sets the parent edge to User by id.
 func (uuo *UserUpdateOne) SetParentID(id int) *UserUpdateOne {
 	uuo.mutation.SetParentID(id)
@@ -756,19 +940,33 @@ func (uuo *UserUpdateOne) ClearCar() *UserUpdateOne {
 	return uuo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uuo *UserUpdateOne) Unwrap() *UserUpdateOne {
+	tx, ok := uuo.config.driver.(*txDriver)
+	if !ok {
+		panic("entv1: UserUpdateOne is not a transactional builder")
+	}
+	uuo.config.driver = tx.drv
+	uuo.mutation.driver = tx.drv
+	return uuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uuo *UserUpdateOne) DebugFields() map[string]Value {
+	return debugFields(uuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	if v, ok := uuo.mutation.Name(); ok {
-		if err := user.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("entv1: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(uuo.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := uuo.mutation.State(); ok {
-		if err := user.StateValidator(v); err != nil {
-			return nil, fmt.Errorf("entv1: validator failed for field \"state\": %v", err)
-		}
+	if err := uuo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *User
@@ -817,7 +1015,32 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("entv1: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := uuo.mutation.State(); ok {
+		if err := user.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("entv1: validator failed for field \"state\": %v", err)}
+		}
+	}
+	if uuo.mutation.conflictparent {
+		return &ValidationError{Name: "parent", err: errors.New("entv1: setting and clearing the unique edge \"parent\" in the same mutation")}
+	}
+	if uuo.mutation.conflictspouse {
+		return &ValidationError{Name: "spouse", err: errors.New("entv1: setting and clearing the unique edge \"spouse\" in the same mutation")}
+	}
+	if uuo.mutation.conflictcar {
+		return &ValidationError{Name: "car", err: errors.New("entv1: setting and clearing the unique edge \"car\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
+	ctx = uuo.withOperation(ctx, "User", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -833,6 +1056,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		return nil, fmt.Errorf("missing User.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := uuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt32,
@@ -1057,9 +1287,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	u = &User{config: uuo.config}
-	_spec.Assign = u.assignValues
-	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return u.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = u.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, uuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -1067,5 +1299,31 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		u.ID = id
+		if value, ok := uuo.mutation.Age(); ok {
+			u.Age = value
+		}
+		if value, ok := uuo.mutation.Name(); ok {
+			u.Name = value
+		}
+		if value, ok := uuo.mutation.Nickname(); ok {
+			u.Nickname = value
+		}
+		if value, ok := uuo.mutation.Address(); ok {
+			u.Address = value
+		}
+		if value, ok := uuo.mutation.Renamed(); ok {
+			u.Renamed = value
+		}
+		if value, ok := uuo.mutation.Blob(); ok {
+			u.Blob = value
+		}
+		if value, ok := uuo.mutation.State(); ok {
+			u.State = value
+		}
+	}
 	return u, nil
 }
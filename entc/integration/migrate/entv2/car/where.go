@@ -123,6 +123,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.Car {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	car.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_car IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_car)
+//
+func OwnerMissing() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("oid")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("oid"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Car) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -130,6 +169,9 @@ func And(predicates ...predicate.Car) predicate.Car {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -144,6 +186,9 @@ func Or(predicates ...predicate.Car) predicate.Car {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -154,3 +199,39 @@ func Not(p predicate.Car) predicate.Car {
 		p(s.Not())
 	})
 }
+
+// CarFilter holds optional filters on the Car fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CarFilter struct {
+	IDIn      []int
+	OwnerIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Car.Query().
+//		Where(car.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CarFilter) []predicate.Car {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Car
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Car(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	return ps
+}
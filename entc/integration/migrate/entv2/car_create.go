@@ -8,8 +8,11 @@ package entv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/car"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/user"
@@ -44,6 +47,13 @@ func (cc *CarCreate) SetOwner(u *User) *CarCreate {
 
 // Save creates the Car in the database.
 func (cc *CarCreate) Save(ctx context.Context) (*Car, error) {
+	if err := checkTx(cc.driver); err != nil {
+		return nil, err
+	}
+	cc.defaults()
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Car
@@ -79,17 +89,143 @@ func (cc *CarCreate) SaveX(ctx context.Context) *Car {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cc *CarCreate) Unwrap() *CarCreate {
+	tx, ok := cc.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: CarCreate is not a transactional builder")
+	}
+	cc.config.driver = tx.drv
+	cc.mutation.driver = tx.drv
+	return cc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (cc *CarCreate) DebugFields() map[string]Value {
+	return debugFields(cc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CarCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CarCreate) check() error {
+	return nil
+}
+
 func (cc *CarCreate) sqlSave(ctx context.Context) (*Car, error) {
-	var (
-		c     = &Car{config: cc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: car.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: car.FieldID,
+	ctx = cc.withOperation(ctx, "Car", "Create")
+	c, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	c.ID = int(id)
+	return c, nil
+}
+
+// SaveID creates the Car in the database and returns only its id. Unlike Save,
+// the Car entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Car that holds only its id as the value that is returned by the next mutator.
+func (cc *CarCreate) SaveID(ctx context.Context) (id int, err error) {
+	cc.defaults()
+	if err = cc.check(); err != nil {
+		return id, err
+	}
+	if len(cc.hooks) == 0 {
+		return cc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*CarMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		cc.mutation = mutation
+		if id, err = cc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Car{config: cc.config, ID: id}, nil
+	})
+	for i := len(cc.hooks) - 1; i >= 0; i-- {
+		mut = cc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, cc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (cc *CarCreate) SaveIDX(ctx context.Context) int {
+	id, err := cc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (cc *CarCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = cc.withOperation(ctx, "Car", "Create")
+	_spec := cc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, cc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (cc *CarCreate) createSpec() (*Car, *sqlgraph.CreateSpec) {
+	c := &Car{config: cc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: car.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: car.FieldID,
+		},
+	}
+	if nodes := cc.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   car.OwnerTable,
+			Columns: []string{car.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
 			},
 		}
-	)
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return c, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (cc *CarCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: car.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: car.FieldID,
+		},
+	}
 	if nodes := cc.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -109,13 +245,376 @@ func (cc *CarCreate) sqlSave(ctx context.Context) (*Car, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return _spec
+}
+
+// CarCreateBulk is the builder for creating a bulk of Car entities.
+type CarCreateBulk struct {
+	config
+	builders []*CarCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Car entities in the database.
+func (ccb *CarCreateBulk) Save(ctx context.Context) ([]*Car, error) {
+	nodes, err := ccb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ccb.retries > 0 && isSQLDeadlockError(err) {
+		return ccb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CarCreateBulk) SaveX(ctx context.Context) []*Car {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ccb *CarCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ccb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
 		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ccb *CarCreateBulk) CheckRefs() *CarCreateBulk {
+	ccb.refs = true
+	return ccb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ccb *CarCreateBulk) RetryOnDeadlock(max int) *CarCreateBulk {
+	ccb.retries = max
+	return ccb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Car.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Car.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ccb *CarCreateBulk) OnConflict(columns ...string) *CarUpsertBulk {
+	return &CarUpsertBulk{create: ccb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Car.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (cc *CarCreate) OnConflict(target ...sql.ConflictOption) *CarUpsertBulk {
+	bulk := &CarCreateBulk{config: cc.config, builders: []*CarCreate{cc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ccb *CarCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Car, error) {
+	ctx = ccb.withOperation(ctx, "Car", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ccb.builders))
+		nodes    = make([]*Car, len(ccb.builders))
+		mutators = make([]Mutator, len(ccb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CarMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ccb.refs {
+						if err := ccb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ccb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ccb *CarCreateBulk) retry(ctx context.Context, err error) ([]*Car, error) {
+	if _, ok := ccb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	c.ID = int(id)
-	return c, nil
+	builders := ccb.builders
+	defer func() { ccb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ccb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ccb.builders = make([]*CarCreate, len(idx))
+	for i, j := range idx {
+		ccb.builders[i] = builders[j]
+	}
+	for n := 0; n < ccb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Car
+		if sorted, err = ccb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Car, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ccb *CarCreateBulk) less(a, b *CarCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ccb *CarCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ccb.builders {
+			for _, id := range builder.mutation.OwnerIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ccb.driver, user.Table, user.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[car.EdgeOwner] = append(missing[car.EdgeOwner], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Car", Missing: missing}
+	}
+	return nil
+}
+
+// CarUpsertBulk is the builder for the conflict handling of a bulk of Car entities.
+type CarUpsertBulk struct {
+	create  *CarCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (cub *CarUpsertBulk) Target(target ...sql.ConflictOption) *CarUpsertBulk {
+	cub.target = append(cub.target, target...)
+	return cub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (cub *CarUpsertBulk) DoNothing() *CarUpsertBulk {
+	cub.nothing = true
+	return cub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (cub *CarUpsertBulk) UpdateNewValues() *CarUpsertBulk {
+	cub.update = true
+	return cub
+}
+
+// Save creates the Car entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (cub *CarUpsertBulk) Save(ctx context.Context) ([]*Car, int, error) {
+	switch {
+	case !cub.nothing && !cub.update:
+		return nil, 0, errors.New("entv2: missing conflict action for Car bulk insert")
+	case cub.nothing && cub.update:
+		return nil, 0, errors.New("entv2: conflicting actions DoNothing and UpdateNewValues for Car bulk insert")
+	case cub.update:
+		if len(cub.columns) == 0 && len(cub.target) == 0 {
+			return nil, 0, errors.New("entv2: missing conflict columns for Car bulk upsert")
+		}
+		nodes, err := cub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(cub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(car.FieldID),
+			),
+			Columns:         car.Columns,
+			ConflictColumns: cub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(cub.conflictTarget(), sql.DoNothing())}
+	nodes, err := cub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Car, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (cub *CarUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(cub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(cub.columns...))
+	}
+	return append(opts, cub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cub *CarUpsertBulk) SaveX(ctx context.Context) ([]*Car, int) {
+	nodes, skipped, err := cub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (cub *CarUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := cub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cub *CarUpsertBulk) ExecX(ctx context.Context) {
+	if err := cub.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -23,6 +23,7 @@ type CarDelete struct {
 	hooks      []Hook
 	mutation   *CarMutation
 	predicates []predicate.Car
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (cd *CarDelete) Where(ps ...predicate.Car) *CarDelete {
 	return cd
 }
 
+// Limit limits the number of cars that are deleted by the builder. For example,
+// for deleting large amounts of cars in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (cd *CarDelete) Limit(limit int) *CarDelete {
+	cd.limit = &limit
+	return cd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cd *CarDelete) Unwrap() *CarDelete {
+	tx, ok := cd.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: CarDelete is not a transactional builder")
+	}
+	cd.config.driver = tx.drv
+	cd.mutation.driver = tx.drv
+	return cd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (cd *CarDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(cd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (cd *CarDelete) ExecX(ctx context.Context) int {
 }
 
 func (cd *CarDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = cd.withOperation(ctx, "Car", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: car.Table,
@@ -85,7 +111,10 @@ func (cd *CarDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, cd.driver, _spec)
+	if limit := cd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, cd.mutationDriver(ctx), _spec)
 }
 
 // CarDeleteOne is the builder for deleting a single Car entity.
@@ -99,7 +128,7 @@ func (cdo *CarDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{car.Label}
 	default:
 		return nil
@@ -8,9 +8,12 @@ package entv2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -31,6 +34,18 @@ type CarQuery struct {
 	// eager-loading edges.
 	withOwner *UserQuery
 	withFKs   bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see CarSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -42,6 +57,19 @@ func (cq *CarQuery) Where(ps ...predicate.Car) *CarQuery {
 	return cq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (cq *CarQuery) Predicates() []predicate.Car {
+	return append([]predicate.Car{}, cq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (cq *CarQuery) SetPredicates(ps ...predicate.Car) *CarQuery {
+	cq.predicates = append([]predicate.Car{}, ps...)
+	return cq
+}
+
 // Limit adds a limit step to the query.
 func (cq *CarQuery) Limit(limit int) *CarQuery {
 	cq.limit = &limit
@@ -286,11 +314,15 @@ func (cq *CarQuery) GroupBy(field string, fields ...string) *CarGroupBy {
 func (cq *CarQuery) Select(field string, fields ...string) *CarSelect {
 	selector := &CarSelect{config: cq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = cq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := cq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return cq.sqlQuery(), nil
+		if err := cq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return cq.sqlQuery().AppendSelectExpr(cq.exprs...), nil
 	}
 	return selector
 }
@@ -307,6 +339,15 @@ func (cq *CarQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
+	ctx = cq.withOperation(ctx, "Car", "Query")
+	if err := cq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range cq.fields {
+		if !car.ValidColumn(f) {
+			return nil, fmt.Errorf("entv2: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*Car{}
 		withFKs     = cq.withFKs
@@ -321,12 +362,14 @@ func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, car.ForeignKeys...)
 	}
+	_spec.Exprs = cq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Car{config: cq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
-		if withFKs {
-			values = append(values, node.fkValues()...)
+		values := node.scanValues(columns)
+		for range cq.exprs {
+			values = append(values, new(interface{}))
 		}
 		return values
 	}
@@ -335,8 +378,16 @@ func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
 			return fmt.Errorf("entv2: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(cq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range cq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
 		return nil, err
@@ -346,34 +397,88 @@ func (cq *CarQuery) sqlAll(ctx context.Context) ([]*Car, error) {
 	}
 
 	if query := cq.withOwner; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		ids := make([]int, 0, len(nodes))
 		nodeids := make(map[int][]*Car)
 		for i := range nodes {
 			if fk := nodes[i].user_car; fk != nil {
-				ids = append(ids, *fk)
+				if _, ok := nodeids[*fk]; !ok {
+					ids = append(ids, *fk)
+				}
 				nodeids[*fk] = append(nodeids[*fk], nodes[i])
 			}
 		}
-		query.Where(user.IDIn(ids...))
-		neighbors, err := query.All(ctx)
-		if err != nil {
+		// The limit and the offset of the query apply to all neighbors, and not to each batch.
+		if (query.limit != nil || query.offset != nil) && cq.eagerLoadBatched(len(ids)) {
+			err := fmt.Errorf(`entv2: limit or offset of eager-loaded edge "owner" cannot be applied on %d neighbors with batch size %d`, len(ids), cq.eagerLoadSize())
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := nodeids[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "user_car" returned %v`, n.ID)
+		err := cq.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], user.IDIn(ids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Owner = n
+			for _, n := range neighbors {
+				nodes, ok := nodeids[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_car" returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Owner = n
+				}
 			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Car entities (see Car.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Car.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Car table.
+func (cq *CarQuery) SelectExpr(exprs ...*sql.SelectExpr) *CarQuery {
+	cq.exprs = append(cq.exprs, exprs...)
+	return cq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (cq *CarQuery) checkExprs() error {
+	if len(cq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range car.Columns {
+		columns[c] = true
+	}
+	for _, c := range car.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range cq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("entv2: alias %q of expression column collides with another column of Car", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (cq *CarQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = cq.withOperation(ctx, "Car", "Count")
 	_spec := cq.querySpec()
 	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
 }
@@ -399,6 +504,17 @@ func (cq *CarQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   cq.sql,
 		Unique: true,
 	}
+	if fields := cq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, car.FieldID)
+		for i := range fields {
+			if fields[i] != car.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = cq.forUpdate
+	_spec.PartitionBy = cq.partition
 	if ps := cq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -447,11 +563,387 @@ func (cq *CarQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Car entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (cq *CarQuery) IDsQuery() *sql.Selector {
+	selector := cq.sqlQuery()
+	if cq.path != nil {
+		selector.AddError(fmt.Errorf("entv2: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(car.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Car entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Car.Query().
+//		Where(...).
+//		CountDistinct(ctx, car.FieldID)
+//
+func (cq *CarQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = cq.withOperation(ctx, "Car", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, cq.driver, cq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("entv2: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (cq *CarQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := cq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Car rows as generic maps that are keyed by
+// column name (see car.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (cq *CarQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = cq.withOperation(ctx, "Car", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = cq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Car{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (cq *CarQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := cq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Car entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (cq *CarQuery) Prefetch(n int) *CarQuery {
+	cq.prefetch = n
+	return cq
+}
+
+// ForUpdate locks the selected Car rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (cq *CarQuery) ForUpdate() *CarQuery {
+	cq.forUpdate = true
+	return cq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (cq *CarQuery) Primary() *CarQuery {
+	return cq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (cq *CarQuery) ReadConsistency(c sql.ReadConsistency) *CarQuery {
+	cq.consistency = c
+	return cq
+}
+
+// Stream executes the query in batches, and sends the matched Car entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Car.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (cq *CarQuery) Stream(ctx context.Context) (<-chan *Car, <-chan error) {
+	size := cq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Car, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := cq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Car entities in batches of the given
+// size, and sends them to the given channel.
+func (cq *CarQuery) stream(ctx context.Context, size int, nodes chan<- *Car) error {
+	if cq.offset != nil || len(cq.order) > 0 {
+		return fmt.Errorf("entv2: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if cq.limit != nil {
+		left = *cq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *cq
+		query.predicates = cq.predicates[:len(cq.predicates):len(cq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, car.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(car.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// CarEdge is the edge representation of Car in a connection.
+type CarEdge struct {
+	Node   *Car   `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// CarConnection is the connection of Car entities that is returned by Paginate.
+type CarConnection struct {
+	Edges      []CarEdge `json:"edges"`
+	PageInfo   PageInfo  `json:"pageInfo"`
+	TotalCount int       `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Car.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: car.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (cq *CarQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*CarConnection, error) {
+	if cq.limit != nil || cq.offset != nil || len(cq.order) > 0 {
+		return nil, fmt.Errorf("entv2: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("entv2: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		return nil, fmt.Errorf("entv2: unsupported order field %q for Car", o.Field)
+	}
+	total, err := cq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: car.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *cq
+	query.predicates = cq.predicates[:len(cq.predicates):len(cq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := cq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &CarConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]CarEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = CarEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (cq *CarQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Car, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("entv2: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := cq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Car(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*CarQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case car.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("entv2: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("entv2: unsupported order field %q for Car", field)
+}
+
+// pageCursor returns the cursor of the Car node for the given order fields.
+func (c *Car) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(c.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if len(orderBy) > 0 {
+		return Cursor{}, fmt.Errorf("entv2: unsupported order field %q for Car", orderBy[0].Field)
+	}
+	return Cursor{id: id}, nil
+}
+
 // CarGroupBy is the builder for group-by Car entities.
 type CarGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -564,9 +1056,28 @@ func (cgb *CarGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Car.Query().
+//		GroupBy(...).
+//		Aggregate(entv2.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (cgb *CarGroupBy) Having(ps ...*sql.Predicate) *CarGroupBy {
+	cgb.havings = append(cgb.havings, ps...)
+	return cgb
+}
+
 func (cgb *CarGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = cgb.withOperation(ctx, "Car", "GroupBy")
+	selector := cgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := cgb.sqlQuery().Query()
 	if err := cgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -579,15 +1090,30 @@ func (cgb *CarGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(cgb.fields)+len(cgb.fns))
 	columns = append(columns, cgb.fields...)
 	for _, fn := range cgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(cgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
 	}
-	return selector.Select(columns...).GroupBy(cgb.fields...)
+	selector = selector.Select(columns...).GroupBy(cgb.fields...)
+	switch len(cgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(cgb.havings[0])
+	default:
+		selector.Having(sql.And(cgb.havings...))
+	}
+	return selector
 }
 
 // CarSelect is the builder for select fields of Car entities.
 type CarSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *CarQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -694,7 +1220,47 @@ func (cs *CarSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (cs *CarSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(cs.fields) > 1 {
+		return nil, errors.New("entv2: CarSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := cs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (cs *CarSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := cs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Car entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+func (cs *CarSelect) All(ctx context.Context) ([]*Car, error) {
+	query := *cs.query
+	query.fields = cs.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cs *CarSelect) AllX(ctx context.Context) []*Car {
+	nodes, err := cs.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (cs *CarSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = cs.withOperation(ctx, "Car", "Select")
 	rows := &sql.Rows{}
 	query, args := cs.sqlQuery().Query()
 	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
@@ -8,6 +8,7 @@ package entv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -24,6 +25,9 @@ type CarUpdate struct {
 	hooks      []Hook
 	mutation   *CarMutation
 	predicates []predicate.Car
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,6 +36,13 @@ func (cu *CarUpdate) Where(ps ...predicate.Car) *CarUpdate {
 	return cu
 }
 
+// Limit limits the number of cars that are updated by the builder. For example,
+// for updating large amounts of cars in small batches without locking the whole table.
+func (cu *CarUpdate) Limit(limit int) *CarUpdate {
+	cu.limit = &limit
+	return cu
+}
+
 // SetOwnerID sets the owner edge to User by id.
 func (cu *CarUpdate) SetOwnerID(id int) *CarUpdate {
 	cu.mutation.SetOwnerID(id)
@@ -57,9 +68,33 @@ func (cu *CarUpdate) ClearOwner() *CarUpdate {
 	return cu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cu *CarUpdate) Unwrap() *CarUpdate {
+	tx, ok := cu.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: CarUpdate is not a transactional builder")
+	}
+	cu.config.driver = tx.drv
+	cu.mutation.driver = tx.drv
+	return cu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cu *CarUpdate) DebugFields() map[string]Value {
+	return debugFields(cu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (cu *CarUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(cu.driver); err != nil {
+		return 0, err
+	}
+	if err := cu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -108,7 +143,16 @@ func (cu *CarUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cu *CarUpdate) check() error {
+	if cu.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("entv2: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
 func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = cu.withOperation(ctx, "Car", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   car.Table,
@@ -126,6 +170,12 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := cu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if cu.ids != nil {
+		_spec.ScanIDs = cu.ids
+	}
 	if cu.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -161,7 +211,7 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{car.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -172,11 +222,33 @@ func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Car entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (cu *CarUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	cu.ids = &ids
+	defer func() { cu.ids = nil }()
+	if _, err := cu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // CarUpdateOne is the builder for updating a single Car entity.
 type CarUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *CarMutation
+	hooks      []Hook
+	mutation   *CarMutation
+	predicates []predicate.Car
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (cuo *CarUpdateOne) Where(ps ...predicate.Car) *CarUpdateOne {
+	cuo.predicates = append(cuo.predicates, ps...)
+	return cuo
 }
 
 // SetOwnerID sets the owner edge to User by id.
@@ -204,9 +276,33 @@ func (cuo *CarUpdateOne) ClearOwner() *CarUpdateOne {
 	return cuo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (cuo *CarUpdateOne) Unwrap() *CarUpdateOne {
+	tx, ok := cuo.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: CarUpdateOne is not a transactional builder")
+	}
+	cuo.config.driver = tx.drv
+	cuo.mutation.driver = tx.drv
+	return cuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (cuo *CarUpdateOne) DebugFields() map[string]Value {
+	return debugFields(cuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (cuo *CarUpdateOne) Save(ctx context.Context) (*Car, error) {
-
+	if err := checkTx(cuo.driver); err != nil {
+		return nil, err
+	}
+	if err := cuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Car
@@ -255,7 +351,16 @@ func (cuo *CarUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CarUpdateOne) check() error {
+	if cuo.mutation.conflictowner {
+		return &ValidationError{Name: "owner", err: errors.New("entv2: setting and clearing the unique edge \"owner\" in the same mutation")}
+	}
+	return nil
+}
+
 func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (c *Car, err error) {
+	ctx = cuo.withOperation(ctx, "Car", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   car.Table,
@@ -271,6 +376,13 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (c *Car, err error) {
 		return nil, fmt.Errorf("missing Car.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := cuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if cuo.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -307,9 +419,11 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (c *Car, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	c = &Car{config: cuo.config}
-	_spec.Assign = c.assignValues
-	_spec.ScanValues = c.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return c.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = c.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, cuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{car.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -317,5 +431,10 @@ func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (c *Car, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		c.ID = id
+	}
 	return c, nil
 }
@@ -7,11 +7,17 @@
 package entv2
 
 import (
+	"bytes"
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/migrate"
+	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/predicate"
 
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/car"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/group"
@@ -80,7 +86,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("entv2: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug, hooks: c.hooks}
+	tx.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = tx
 	return &Tx{
 		config: cfg,
 		Car:    NewCarClient(cfg),
@@ -95,11 +103,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(*sql.Driver).BeginTx(ctx, opts)
+	drv, ok := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("ent: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: &txDriver{tx: tx, drv: c.driver}, log: c.log, debug: c.debug, hooks: c.hooks}
+	txd := &txDriver{tx: tx, drv: c.driver}
+	txd.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = txd
 	return &Tx{
 		config: cfg,
 		Car:    NewCarClient(cfg),
@@ -109,6 +126,247 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+// WithTx runs fn in a transaction, which is committed if fn returns nil, and rolled back if fn returns an
+// error or panics (the panic is re-raised after the rollback). If the context holds a transaction (see
+// NewTxContext), or the client is transactional, fn runs in the existing transaction within a savepoint
+// instead. The savepoint is released if fn returns nil, and rolled back to otherwise, without affecting
+// the changes that were made in the transaction before it. For example:
+//
+//	err := client.WithTx(ctx, func(tx *entv2.Tx) error {
+//		if _, err := tx.Car.Create().Save(ctx); err != nil {
+//			return err
+//		}
+//		return nil
+//	})
+//
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	tx := TxFromContext(ctx)
+	if _, ok := c.driver.(*txDriver); ok && tx == nil {
+		tx = &Tx{config: c.config}
+		tx.init()
+	}
+	if tx != nil {
+		return tx.withSavepoint(ctx, fn)
+	}
+	if tx, err = c.Tx(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// withSavepoint runs fn within a savepoint of the transaction. The functions that were registered
+// to run after commit by the mutations of fn are discarded if the savepoint is rolled back to.
+func (tx *Tx) withSavepoint(ctx context.Context, fn func(tx *Tx) error) error {
+	drv := tx.config.driver.(*txDriver)
+	drv.mu.Lock()
+	drv.savepoints++
+	name := fmt.Sprintf("entv2_savepoint_%d", drv.savepoints)
+	committed := len(drv.afterCommit)
+	drv.mu.Unlock()
+	if err := drv.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("entv2: creating savepoint: %w", err)
+	}
+	rollback := func() error {
+		drv.mu.Lock()
+		drv.afterCommit = drv.afterCommit[:committed]
+		drv.mu.Unlock()
+		return drv.Exec(ctx, "ROLLBACK TO SAVEPOINT "+name, []interface{}{}, nil)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back to savepoint: %v", err, rerr)
+		}
+		return err
+	}
+	if err := drv.Exec(ctx, "RELEASE SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("entv2: releasing savepoint: %w", err)
+	}
+	return nil
+}
+
+// Purge deletes the entities that match the given predicates from their tables in one transaction,
+// and returns the number of deleted rows per type label. The predicates are keyed by the type label,
+// and types without a predicate are not deleted. The types are deleted in dependency order (types
+// that hold foreign-keys to other types first) using their delete builders, and therefore, their
+// hooks are executed as well. Any error (or a canceled context) rolls back the transaction. For example:
+//
+//	tenant := func(s *sql.Selector) {
+//		s.Where(sql.EQ(s.C("tenant_id"), id))
+//	}
+//	report, err := client.Purge(ctx, map[string]func(*sql.Selector){
+//		car.Label: tenant,
+//		// ...
+//	})
+//
+// If the client is transactional, the entities are deleted in its transaction, and it is not committed.
+func (c *Client) Purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	for label := range preds {
+		switch label {
+		case car.Label, group.Label, user.Label, pet.Label:
+		default:
+			return nil, fmt.Errorf("entv2: unknown type label %q", label)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.purge(ctx, preds)
+	}
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := tx.Client().purge(ctx, preds)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%v: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// purge deletes the entities of the given predicates in dependency order.
+func (c *Client) purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	report := make(map[string]int, len(preds))
+	if p, ok := preds[car.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Car.Delete().Where(predicate.Car(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entv2: purging car: %w", err)
+		}
+		report[car.Label] = n
+	}
+	if p, ok := preds[group.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Group.Delete().Where(predicate.Group(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entv2: purging group: %w", err)
+		}
+		report[group.Label] = n
+	}
+	if p, ok := preds[user.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.User.Delete().Where(predicate.User(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entv2: purging user: %w", err)
+		}
+		report[user.Label] = n
+	}
+	if p, ok := preds[pet.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Pet.Delete().Where(predicate.Pet(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entv2: purging pet: %w", err)
+		}
+		report[pet.Label] = n
+	}
+	return report, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given entities, that can be of different
+// types (e.g. the results of a polymorphic query). The entities are grouped by their concrete type, and
+// the edge of each group is loaded using the LoadEdgeFor method of its client. Hence, the number of
+// queries depends on the number of types, and not on the number of entities. For example:
+//
+//	err := client.LoadEdgeFor(ctx, []entv2.Value{card, user}, "owner")
+//
+// The entities must be pointers to the generated types, and the edge must be defined on all their types.
+func (c *Client) LoadEdgeFor(ctx context.Context, nodes []Value, edge string) error {
+	var (
+		carNodes   []*Car
+		groupNodes []*Group
+		petNodes   []*Pet
+		userNodes  []*User
+	)
+	for _, v := range nodes {
+		switch node := v.(type) {
+		case *Car:
+			carNodes = append(carNodes, node)
+		case *Group:
+			groupNodes = append(groupNodes, node)
+		case *Pet:
+			petNodes = append(petNodes, node)
+		case *User:
+			userNodes = append(userNodes, node)
+		default:
+			return fmt.Errorf("entv2: unexpected entity type %T", v)
+		}
+	}
+	if len(carNodes) > 0 {
+		if err := c.Car.LoadEdgeFor(ctx, carNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(groupNodes) > 0 {
+		if err := c.Group.LoadEdgeFor(ctx, groupNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(petNodes) > 0 {
+		if err := c.Pet.LoadEdgeFor(ctx, petNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(userNodes) > 0 {
+		if err := c.User.LoadEdgeFor(ctx, userNodes, edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshMaterializedView replaces the contents of the materialized view with the given name by
+// re-executing its query (PostgreSQL only). If concurrently is true, the view is refreshed without
+// locking out concurrent reads from it, which requires a unique index on the view. For example:
+//
+//	err := client.RefreshMaterializedView(ctx, "card_stats", true)
+//
+// Read-only entities that query the view are defined using the View option in the schema config.
+func (c *Client) RefreshMaterializedView(ctx context.Context, name string, concurrently bool) error {
+	if d := c.driver.Dialect(); d != dialect.Postgres {
+		return fmt.Errorf("entv2: materialized views are not supported by dialect %q", d)
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("REFRESH MATERIALIZED VIEW ")
+	if concurrently {
+		b.WriteString("CONCURRENTLY ")
+	}
+	query, args := b.Ident(name).Query()
+	if err := c.driver.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("entv2: refreshing materialized view %q: %v", name, err)
+	}
+	return nil
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -120,7 +378,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true, hooks: c.hooks}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	client := &Client{config: cfg}
 	client.init()
 	return client
@@ -162,6 +422,179 @@ func (c *CarClient) Create() *CarCreate {
 	return &CarCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Car entities.
+func (c *CarClient) CreateBulk(builders ...*CarCreate) *CarCreateBulk {
+	return &CarCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Car builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Car.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *CarClient) CreateBulkFrom(ctx context.Context, ch <-chan *CarCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("entv2: invalid batch size %d for Car bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*CarCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*CarCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*CarCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Car that matches the given predicates, or creates it using
+// the given builder if there is no such Car. The returned bool reports whether the
+// Car was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Car is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Car.FindOrCreate(ctx, ps, client.Car.Create())
+//
+func (c *CarClient) FindOrCreate(ctx context.Context, ps []predicate.Car, create *CarCreate) (*Car, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Car.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Car using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *CarClient) findOrCreate(ctx context.Context, ps []predicate.Car, create *CarCreate) (*Car, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the cars table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Car columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Car columns are rejected before the statement is executed.
+//
+//	n, err := client.Car.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		car.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *CarClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   car.Table,
+		Columns: append(car.Columns[:len(car.Columns):len(car.Columns)], car.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Car and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+// M2O edges are copied only if their foreign-keys were loaded by the query, i.e. if one of the
+// edges was eager-loaded (e.g. using With<Edge>).
+//
+//	node, err := client.Car.CopyToCreate(ca).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *CarClient) CopyToCreate(ca *Car) *CarCreate {
+	create := c.Create()
+	if fk := ca.user_car; fk != nil {
+		create.SetOwnerID(*fk)
+	}
+	return create
+}
+
 // Update returns an update builder for Car.
 func (c *CarClient) Update() *CarUpdate {
 	mutation := newCarMutation(c.config, OpUpdate)
@@ -180,6 +613,39 @@ func (c *CarClient) UpdateOneID(id int) *CarUpdateOne {
 	return &CarUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Car entities, and updates the original Car only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Car is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ca.Clone()
+//	modified.Field = value
+//	ca, err = client.Car.UpdateFromDiff(ctx, ca, modified)
+//
+func (c *CarClient) UpdateFromDiff(ctx context.Context, original, modified *Car) (*Car, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("entv2: mismatched ids %v and %v for Car UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.Owner, original.Edges.Owner; {
+		case v == nil && o != nil:
+			update.ClearOwner()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetOwnerID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Car.
 func (c *CarClient) Delete() *CarDelete {
 	mutation := newCarMutation(c.config, OpDelete)
@@ -218,6 +684,49 @@ func (c *CarClient) GetX(ctx context.Context, id int) *Car {
 	return ca
 }
 
+// GetMany returns the Car entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *CarClient) GetMany(ctx context.Context, ids ...int) ([]*Car, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(car.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Car, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Car, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CarClient) GetManyX(ctx context.Context, ids ...int) []*Car {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Car from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *CarClient) FromMap(m map[string]string) (*Car, error) {
+	ca := &Car{config: c.config}
+	if err := kvDecode(m, car.FieldID, &ca.ID, true); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
 // QueryOwner queries the owner edge of a Car.
 func (c *CarClient) QueryOwner(ca *Car) *UserQuery {
 	query := &UserQuery{config: c.config}
@@ -234,9 +743,56 @@ func (c *CarClient) QueryOwner(ca *Car) *UserQuery {
 	return query
 }
 
+// LoadEdgeFor loads the edge with the given name of all the given Car entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *CarClient) LoadEdgeFor(ctx context.Context, nodes []*Car, edge string) error {
+	var (
+		query  func(*CarQuery)
+		assign func(node, loaded *Car)
+	)
+	switch edge {
+	case car.EdgeOwner:
+		query = func(q *CarQuery) { q.WithOwner() }
+		assign = func(node, loaded *Car) {
+			node.Edges.Owner, node.Edges.loadedTypes[0] = loaded.Edges.Owner, true
+		}
+	default:
+		return fmt.Errorf("entv2: unknown Car edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*Car, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(car.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *CarClient) Hooks() []Hook {
-	return c.hooks.Car
+	hooks := c.hooks.Car
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(car.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // GroupClient is a client for the Group schema.
@@ -261,6 +817,173 @@ func (c *GroupClient) Create() *GroupCreate {
 	return &GroupCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Group entities.
+func (c *GroupClient) CreateBulk(builders ...*GroupCreate) *GroupCreateBulk {
+	return &GroupCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Group builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Group.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *GroupClient) CreateBulkFrom(ctx context.Context, ch <-chan *GroupCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("entv2: invalid batch size %d for Group bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*GroupCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*GroupCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*GroupCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Group that matches the given predicates, or creates it using
+// the given builder if there is no such Group. The returned bool reports whether the
+// Group was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Group is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Group.FindOrCreate(ctx, ps, client.Group.Create())
+//
+func (c *GroupClient) FindOrCreate(ctx context.Context, ps []predicate.Group, create *GroupCreate) (*Group, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Group.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Group using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *GroupClient) findOrCreate(ctx context.Context, ps []predicate.Group, create *GroupCreate) (*Group, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the groups table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Group columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Group columns are rejected before the statement is executed.
+//
+//	n, err := client.Group.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		group.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *GroupClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   group.Table,
+		Columns: group.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Group and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Group.CopyToCreate(gr).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *GroupClient) CopyToCreate(gr *Group) *GroupCreate {
+	create := c.Create()
+	return create
+}
+
 // Update returns an update builder for Group.
 func (c *GroupClient) Update() *GroupUpdate {
 	mutation := newGroupMutation(c.config, OpUpdate)
@@ -279,6 +1002,29 @@ func (c *GroupClient) UpdateOneID(id int) *GroupUpdateOne {
 	return &GroupUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Group entities, and updates the original Group only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Group is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := gr.Clone()
+//	modified.Field = value
+//	gr, err = client.Group.UpdateFromDiff(ctx, gr, modified)
+//
+func (c *GroupClient) UpdateFromDiff(ctx context.Context, original, modified *Group) (*Group, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("entv2: mismatched ids %v and %v for Group UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Group.
 func (c *GroupClient) Delete() *GroupDelete {
 	mutation := newGroupMutation(c.config, OpDelete)
@@ -317,9 +1063,65 @@ func (c *GroupClient) GetX(ctx context.Context, id int) *Group {
 	return gr
 }
 
+// GetMany returns the Group entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *GroupClient) GetMany(ctx context.Context, ids ...int) ([]*Group, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(group.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Group, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Group, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GroupClient) GetManyX(ctx context.Context, ids ...int) []*Group {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Group from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *GroupClient) FromMap(m map[string]string) (*Group, error) {
+	gr := &Group{config: c.config}
+	if err := kvDecode(m, group.FieldID, &gr.ID, true); err != nil {
+		return nil, err
+	}
+	return gr, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Group entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *GroupClient) LoadEdgeFor(ctx context.Context, nodes []*Group, edge string) error {
+	return fmt.Errorf("entv2: unknown Group edge %q", edge)
+}
+
 // Hooks returns the client hooks.
 func (c *GroupClient) Hooks() []Hook {
-	return c.hooks.Group
+	hooks := c.hooks.Group
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(group.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // PetClient is a client for the Pet schema.
@@ -344,6 +1146,173 @@ func (c *PetClient) Create() *PetCreate {
 	return &PetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Pet entities.
+func (c *PetClient) CreateBulk(builders ...*PetCreate) *PetCreateBulk {
+	return &PetCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Pet builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Pet.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *PetClient) CreateBulkFrom(ctx context.Context, ch <-chan *PetCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("entv2: invalid batch size %d for Pet bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*PetCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*PetCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*PetCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Pet that matches the given predicates, or creates it using
+// the given builder if there is no such Pet. The returned bool reports whether the
+// Pet was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Pet is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Pet.FindOrCreate(ctx, ps, client.Pet.Create())
+//
+func (c *PetClient) FindOrCreate(ctx context.Context, ps []predicate.Pet, create *PetCreate) (*Pet, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Pet.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Pet using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *PetClient) findOrCreate(ctx context.Context, ps []predicate.Pet, create *PetCreate) (*Pet, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the pets table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Pet columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Pet columns are rejected before the statement is executed.
+//
+//	n, err := client.Pet.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		pet.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *PetClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   pet.Table,
+		Columns: pet.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Pet and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Pet.CopyToCreate(pe).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *PetClient) CopyToCreate(pe *Pet) *PetCreate {
+	create := c.Create()
+	return create
+}
+
 // Update returns an update builder for Pet.
 func (c *PetClient) Update() *PetUpdate {
 	mutation := newPetMutation(c.config, OpUpdate)
@@ -362,6 +1331,29 @@ func (c *PetClient) UpdateOneID(id int) *PetUpdateOne {
 	return &PetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UpdateFromDiff compares the given Pet entities, and updates the original Pet only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Pet is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := pe.Clone()
+//	modified.Field = value
+//	pe, err = client.Pet.UpdateFromDiff(ctx, pe, modified)
+//
+func (c *PetClient) UpdateFromDiff(ctx context.Context, original, modified *Pet) (*Pet, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("entv2: mismatched ids %v and %v for Pet UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Pet.
 func (c *PetClient) Delete() *PetDelete {
 	mutation := newPetMutation(c.config, OpDelete)
@@ -400,9 +1392,65 @@ func (c *PetClient) GetX(ctx context.Context, id int) *Pet {
 	return pe
 }
 
+// GetMany returns the Pet entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *PetClient) GetMany(ctx context.Context, ids ...int) ([]*Pet, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(pet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Pet, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Pet, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *PetClient) GetManyX(ctx context.Context, ids ...int) []*Pet {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Pet from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *PetClient) FromMap(m map[string]string) (*Pet, error) {
+	pe := &Pet{config: c.config}
+	if err := kvDecode(m, pet.FieldID, &pe.ID, true); err != nil {
+		return nil, err
+	}
+	return pe, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Pet entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *PetClient) LoadEdgeFor(ctx context.Context, nodes []*Pet, edge string) error {
+	return fmt.Errorf("entv2: unknown Pet edge %q", edge)
+}
+
 // Hooks returns the client hooks.
 func (c *PetClient) Hooks() []Hook {
-	return c.hooks.Pet
+	hooks := c.hooks.Pet
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(pet.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // UserClient is a client for the User schema.
@@ -427,6 +1475,383 @@ func (c *UserClient) Create() *UserCreate {
 	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads User builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.User.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *UserClient) CreateBulkFrom(ctx context.Context, ch <-chan *UserCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("entv2: invalid batch size %d for User bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*UserCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*UserCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*UserCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the User that matches the given predicates, or creates it using
+// the given builder if there is no such User. The returned bool reports whether the
+// User was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the User is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.User.FindOrCreate(ctx, ps, client.User.Create())
+//
+func (c *UserClient) FindOrCreate(ctx context.Context, ps []predicate.User, create *UserCreate) (*User, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.User.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the User using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *UserClient) findOrCreate(ctx context.Context, ps []predicate.User, create *UserCreate) (*User, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the users table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the User columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown User columns are rejected before the statement is executed.
+//
+//	n, err := client.User.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		user.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *UserClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   user.Table,
+		Columns: append(user.Columns[:len(user.Columns):len(user.Columns)], user.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given User and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+// M2O edges are copied only if their foreign-keys were loaded by the query, i.e. if one of the
+// edges was eager-loaded (e.g. using With<Edge>).
+//
+//	node, err := client.User.CopyToCreate(u).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *UserClient) CopyToCreate(u *User) *UserCreate {
+	create := c.Create()
+	create.SetAge(u.Age)
+	create.SetName(u.Name)
+	create.SetNickname(u.Nickname)
+	create.SetPhone(u.Phone)
+	if u.Buffer != nil {
+		create.SetBuffer(u.Buffer)
+	}
+	create.SetTitle(u.Title)
+	if u.NewName != "" {
+		create.SetNewName(u.NewName)
+	}
+	if u.Blob != nil {
+		create.SetBlob(u.Blob)
+	}
+	if u.State != "" {
+		create.SetState(u.State)
+	}
+	if fk := u.user_pets; fk != nil {
+		create.SetPetsID(*fk)
+	}
+	return create
+}
+
+// Sync reconciles the User entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.User.Sync(ctx, builders, []string{user.FieldAge})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *UserClient) Sync(ctx context.Context, desired []*UserCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("entv2: missing key fields for User sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case user.FieldAge, user.FieldPhone:
+		default:
+			return SyncResult{}, fmt.Errorf("entv2: invalid key field %q for User sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&UserClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *UserClient) sync(ctx context.Context, desired []*UserCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.User, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("entv2: missing key field %q in User builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("entv2: User builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*User)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*UserCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the User.
+func (u *User) syncValue(field string) Value {
+	switch field {
+	case user.FieldAge:
+		return u.Age
+	case user.FieldPhone:
+		return u.Phone
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// User. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (u *User) syncChanged(mutation *UserMutation) bool {
+	if v, ok := mutation.Age(); ok && (v != u.Age) {
+		return true
+	}
+	if v, ok := mutation.Name(); ok && (v != u.Name) {
+		return true
+	}
+	if v, ok := mutation.Nickname(); ok && (v != u.Nickname) {
+		return true
+	}
+	if v, ok := mutation.Phone(); ok && (v != u.Phone) {
+		return true
+	}
+	if v, ok := mutation.Buffer(); ok && (!bytes.Equal(v, u.Buffer)) {
+		return true
+	}
+	if v, ok := mutation.Title(); ok && (v != u.Title) {
+		return true
+	}
+	if v, ok := mutation.NewName(); ok && (v != u.NewName) {
+		return true
+	}
+	if v, ok := mutation.Blob(); ok && (!bytes.Equal(v, u.Blob)) {
+		return true
+	}
+	if v, ok := mutation.State(); ok && (v != u.State) {
+		return true
+	}
+	if ids := mutation.PetsIDs(); len(ids) > 0 && (u.user_pets == nil || *u.user_pets != ids[0]) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for User.
 func (c *UserClient) Update() *UserUpdate {
 	mutation := newUserMutation(c.config, OpUpdate)
@@ -445,6 +1870,178 @@ func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
 	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// UserPatch holds the field changes of a User for UpdateByIDMap, keyed by the field
+// name (e.g. user.FieldAge). A nil value clears an optional field.
+type UserPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the User with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.User.UpdateByIDMap(ctx, map[int]entv2.UserPatch{
+//		id1: {user.FieldAge: v1},
+//		id2: {user.FieldAge: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *UserClient) UpdateByIDMap(ctx context.Context, m map[int]UserPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *UserClient) patchUpdates(m map[int]UserPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newUserMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("entv2: invalid User patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&UserUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(user.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, user.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(user.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given User entities, and updates the original User only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original User is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := u.Clone()
+//	modified.Field = value
+//	u, err = client.User.UpdateFromDiff(ctx, u, modified)
+//
+func (c *UserClient) UpdateFromDiff(ctx context.Context, original, modified *User) (*User, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("entv2: mismatched ids %v and %v for User UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Age != original.Age {
+		update.SetAge(modified.Age)
+		changed = true
+	}
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Nickname != original.Nickname {
+		update.SetNickname(modified.Nickname)
+		changed = true
+	}
+	if modified.Phone != original.Phone {
+		update.SetPhone(modified.Phone)
+		changed = true
+	}
+	if !bytes.Equal(modified.Buffer, original.Buffer) {
+		update.SetBuffer(modified.Buffer)
+		changed = true
+	}
+	if modified.Title != original.Title {
+		update.SetTitle(modified.Title)
+		changed = true
+	}
+	if modified.NewName != original.NewName {
+		update.SetNewName(modified.NewName)
+		changed = true
+	}
+	if !bytes.Equal(modified.Blob, original.Blob) {
+		update.SetBlob(modified.Blob)
+		changed = true
+	}
+	if modified.State != original.State {
+		update.SetState(modified.State)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[1] && original.Edges.loadedTypes[1] {
+		switch v, o := modified.Edges.Pets, original.Edges.Pets; {
+		case v == nil && o != nil:
+			update.ClearPets()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetPetsID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for User.
 func (c *UserClient) Delete() *UserDelete {
 	mutation := newUserMutation(c.config, OpDelete)
@@ -483,6 +2080,81 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return u
 }
 
+// GetMany returns the User entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *UserClient) GetMany(ctx context.Context, ids ...int) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(user.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*User, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*User, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *UserClient) GetManyX(ctx context.Context, ids ...int) []*User {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a User from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *UserClient) FromMap(m map[string]string) (*User, error) {
+	u := &User{config: c.config}
+	if err := kvDecode(m, user.FieldID, &u.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldAge, &u.Age, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldName, &u.Name, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldNickname, &u.Nickname, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldPhone, &u.Phone, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldBuffer, &u.Buffer, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldTitle, &u.Title, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldNewName, &u.NewName, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldBlob, &u.Blob, false); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, user.FieldState, &u.State, false); err != nil {
+		return nil, err
+	}
+	if _, ok := m[user.FieldState]; ok {
+		if err := user.StateValidator(u.State); err != nil {
+			return nil, &ValidationError{Name: "state", err: fmt.Errorf("entv2: validator failed for field \"state\": %v", err)}
+		}
+	}
+	return u, nil
+}
+
 // QueryCar queries the car edge of a User.
 func (c *UserClient) QueryCar(u *User) *CarQuery {
 	query := &CarQuery{config: c.config}
@@ -515,7 +2187,113 @@ func (c *UserClient) QueryPets(u *User) *PetQuery {
 	return query
 }
 
+// LoadCarFor loads the "car" edge of all the given User entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithCar for users that were already loaded.
+func (c *UserClient) LoadCarFor(ctx context.Context, nodes []*User, opts ...func(*CarQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	uq := c.Query().WithCar(opts...)
+	for _, node := range nodes {
+		node.Edges.Car = nil
+	}
+
+	if query := uq.withCar; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = user.CarColumn
+		err := uq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Car(func(s *sql.Selector) {
+				s.Where(sql.InValues(user.CarColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.user_car
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "user_car" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "user_car" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Car = append(node.Edges.Car, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given User entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *UserClient) LoadEdgeFor(ctx context.Context, nodes []*User, edge string) error {
+	var (
+		query  func(*UserQuery)
+		assign func(node, loaded *User)
+	)
+	switch edge {
+	case user.EdgeCar:
+		return c.LoadCarFor(ctx, nodes)
+	case user.EdgePets:
+		query = func(q *UserQuery) { q.WithPets() }
+		assign = func(node, loaded *User) {
+			node.Edges.Pets, node.Edges.loadedTypes[1] = loaded.Edges.Pets, true
+		}
+	default:
+		return fmt.Errorf("entv2: unknown User edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*User, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(user.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(user.Label)}, hooks...)
+	}
+	return hooks
 }
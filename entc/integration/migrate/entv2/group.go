@@ -7,6 +7,8 @@
 package entv2
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -19,27 +21,79 @@ type Group struct {
 	config
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Group) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // id
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Group type are scanned as generic values.
+func (*Group) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case group.FieldID:
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Group fields.
-func (gr *Group) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(group.Columns); m < n {
+// to the Group fields. Values of columns that were not selected are left untouched.
+func (gr *Group) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
+	for i := range columns {
+		switch columns[i] {
+		case group.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gr.ID = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (gr *Group) SelectValue(alias string) (Value, error) {
+	v, ok := gr.selectValues[alias]
 	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
+		return nil, fmt.Errorf("entv2: expression column %q was not selected", alias)
 	}
-	gr.ID = int(value.Int64)
-	values = values[1:]
+	return v, nil
+}
+
+// DumpGraph returns the JSON encoding of the Group and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Group alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Group itself is not modified.
+//
+//	b, err := gr.DumpGraph(ctx, client, 2)
+//
+func (gr *Group) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("entv2: invalid depth %d for Group DumpGraph", depth)
+	}
+	dumped := *gr
+	if err := dumpGroups(ctx, client, []*Group{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpGroups loads the edges of the given Group copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpGroups(ctx context.Context, client *Client, nodes []*Group, depth int, dumper *graphDump) error {
 	return nil
 }
 
@@ -61,6 +115,26 @@ func (gr *Group) Unwrap() *Group {
 	return gr
 }
 
+// Clone returns a deep copy of the Group and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (gr *Group) Clone() *Group {
+	return gr.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Group and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (gr *Group) clone(seen map[interface{}]interface{}) *Group {
+	if gr == nil {
+		return nil
+	}
+	if v, ok := seen[gr]; ok {
+		return v.(*Group)
+	}
+	_c := *gr
+	seen[gr] = &_c
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gr *Group) String() string {
 	var builder strings.Builder
@@ -70,6 +144,16 @@ func (gr *Group) String() string {
 	return builder.String()
 }
 
+// ToMap returns a flat key-value representation of the Group for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Group can be decoded using the FromMap method of its client.
+func (gr *Group) ToMap() map[string]string {
+	m := make(map[string]string, 1)
+	m[group.FieldID] = kvEncode(gr.ID)
+	return m
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
 
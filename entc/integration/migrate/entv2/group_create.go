@@ -8,8 +8,11 @@ package entv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/group"
 	"github.com/facebookincubator/ent/schema/field"
@@ -24,6 +27,13 @@ type GroupCreate struct {
 
 // Save creates the Group in the database.
 func (gc *GroupCreate) Save(ctx context.Context) (*Group, error) {
+	if err := checkTx(gc.driver); err != nil {
+		return nil, err
+	}
+	gc.defaults()
+	if err := gc.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Group
@@ -59,24 +69,466 @@ func (gc *GroupCreate) SaveX(ctx context.Context) *Group {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gc *GroupCreate) Unwrap() *GroupCreate {
+	tx, ok := gc.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: GroupCreate is not a transactional builder")
+	}
+	gc.config.driver = tx.drv
+	gc.mutation.driver = tx.drv
+	return gc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (gc *GroupCreate) DebugFields() map[string]Value {
+	return debugFields(gc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GroupCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GroupCreate) check() error {
+	return nil
+}
+
 func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
-	var (
-		gr    = &Group{config: gc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: group.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: group.FieldID,
-			},
-		}
-	)
-	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
+	ctx = gc.withOperation(ctx, "Group", "Create")
+	gr, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.mutationDriver(ctx), _spec); err != nil {
 		if cerr, ok := isSQLConstraintError(err); ok {
 			err = cerr
 		}
 		return nil, err
 	}
+
 	id := _spec.ID.Value.(int64)
 	gr.ID = int(id)
 	return gr, nil
 }
+
+// SaveID creates the Group in the database and returns only its id. Unlike Save,
+// the Group entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Group that holds only its id as the value that is returned by the next mutator.
+func (gc *GroupCreate) SaveID(ctx context.Context) (id int, err error) {
+	gc.defaults()
+	if err = gc.check(); err != nil {
+		return id, err
+	}
+	if len(gc.hooks) == 0 {
+		return gc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*GroupMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		gc.mutation = mutation
+		if id, err = gc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Group{config: gc.config, ID: id}, nil
+	})
+	for i := len(gc.hooks) - 1; i >= 0; i-- {
+		mut = gc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, gc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (gc *GroupCreate) SaveIDX(ctx context.Context) int {
+	id, err := gc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (gc *GroupCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = gc.withOperation(ctx, "Group", "Create")
+	_spec := gc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, gc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (gc *GroupCreate) createSpec() (*Group, *sqlgraph.CreateSpec) {
+	gr := &Group{config: gc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: group.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: group.FieldID,
+		},
+	}
+	return gr, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (gc *GroupCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: group.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: group.FieldID,
+		},
+	}
+	return _spec
+}
+
+// GroupCreateBulk is the builder for creating a bulk of Group entities.
+type GroupCreateBulk struct {
+	config
+	builders []*GroupCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Group entities in the database.
+func (gcb *GroupCreateBulk) Save(ctx context.Context) ([]*Group, error) {
+	nodes, err := gcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && gcb.retries > 0 && isSQLDeadlockError(err) {
+		return gcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GroupCreateBulk) SaveX(ctx context.Context) []*Group {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (gcb *GroupCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range gcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (gcb *GroupCreateBulk) CheckRefs() *GroupCreateBulk {
+	gcb.refs = true
+	return gcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (gcb *GroupCreateBulk) RetryOnDeadlock(max int) *GroupCreateBulk {
+	gcb.retries = max
+	return gcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Group.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Group.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (gcb *GroupCreateBulk) OnConflict(columns ...string) *GroupUpsertBulk {
+	return &GroupUpsertBulk{create: gcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Group.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (gc *GroupCreate) OnConflict(target ...sql.ConflictOption) *GroupUpsertBulk {
+	bulk := &GroupCreateBulk{config: gc.config, builders: []*GroupCreate{gc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (gcb *GroupCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Group, error) {
+	ctx = gcb.withOperation(ctx, "Group", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(gcb.builders))
+		nodes    = make([]*Group, len(gcb.builders))
+		mutators = make([]Mutator, len(gcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GroupMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if gcb.refs {
+						if err := gcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, gcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (gcb *GroupCreateBulk) retry(ctx context.Context, err error) ([]*Group, error) {
+	if _, ok := gcb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := gcb.builders
+	defer func() { gcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return gcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	gcb.builders = make([]*GroupCreate, len(idx))
+	for i, j := range idx {
+		gcb.builders[i] = builders[j]
+	}
+	for n := 0; n < gcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Group
+		if sorted, err = gcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Group, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (gcb *GroupCreateBulk) less(a, b *GroupCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (gcb *GroupCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Group", Missing: missing}
+	}
+	return nil
+}
+
+// GroupUpsertBulk is the builder for the conflict handling of a bulk of Group entities.
+type GroupUpsertBulk struct {
+	create  *GroupCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (gub *GroupUpsertBulk) Target(target ...sql.ConflictOption) *GroupUpsertBulk {
+	gub.target = append(gub.target, target...)
+	return gub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (gub *GroupUpsertBulk) DoNothing() *GroupUpsertBulk {
+	gub.nothing = true
+	return gub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (gub *GroupUpsertBulk) UpdateNewValues() *GroupUpsertBulk {
+	gub.update = true
+	return gub
+}
+
+// Save creates the Group entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (gub *GroupUpsertBulk) Save(ctx context.Context) ([]*Group, int, error) {
+	switch {
+	case !gub.nothing && !gub.update:
+		return nil, 0, errors.New("entv2: missing conflict action for Group bulk insert")
+	case gub.nothing && gub.update:
+		return nil, 0, errors.New("entv2: conflicting actions DoNothing and UpdateNewValues for Group bulk insert")
+	case gub.update:
+		if len(gub.columns) == 0 && len(gub.target) == 0 {
+			return nil, 0, errors.New("entv2: missing conflict columns for Group bulk upsert")
+		}
+		nodes, err := gub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(gub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(group.FieldID),
+			),
+			Columns:         group.Columns,
+			ConflictColumns: gub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(gub.conflictTarget(), sql.DoNothing())}
+	nodes, err := gub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Group, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (gub *GroupUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(gub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(gub.columns...))
+	}
+	return append(opts, gub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gub *GroupUpsertBulk) SaveX(ctx context.Context) ([]*Group, int) {
+	nodes, skipped, err := gub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (gub *GroupUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := gub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gub *GroupUpsertBulk) ExecX(ctx context.Context) {
+	if err := gub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -23,6 +23,7 @@ type GroupDelete struct {
 	hooks      []Hook
 	mutation   *GroupMutation
 	predicates []predicate.Group
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (gd *GroupDelete) Where(ps ...predicate.Group) *GroupDelete {
 	return gd
 }
 
+// Limit limits the number of groups that are deleted by the builder. For example,
+// for deleting large amounts of groups in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (gd *GroupDelete) Limit(limit int) *GroupDelete {
+	gd.limit = &limit
+	return gd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gd *GroupDelete) Unwrap() *GroupDelete {
+	tx, ok := gd.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: GroupDelete is not a transactional builder")
+	}
+	gd.config.driver = tx.drv
+	gd.mutation.driver = tx.drv
+	return gd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (gd *GroupDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(gd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (gd *GroupDelete) ExecX(ctx context.Context) int {
 }
 
 func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = gd.withOperation(ctx, "Group", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: group.Table,
@@ -85,7 +111,10 @@ func (gd *GroupDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, gd.driver, _spec)
+	if limit := gd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, gd.mutationDriver(ctx), _spec)
 }
 
 // GroupDeleteOne is the builder for deleting a single Group entity.
@@ -99,7 +128,7 @@ func (gdo *GroupDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{group.Label}
 	default:
 		return nil
@@ -7,6 +7,7 @@
 package entv2
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/car"
@@ -40,6 +41,7 @@ type CarMutation struct {
 	clearedFields map[string]struct{}
 	owner         *int
 	clearedowner  bool
+	conflictowner bool
 }
 
 var _ ent.Mutation = (*CarMutation)(nil)
@@ -73,6 +75,18 @@ func (m CarMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CarMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CarMutation) ID() (id int, exists bool) {
@@ -85,11 +99,15 @@ func (m *CarMutation) ID() (id int, exists bool) {
 // SetOwnerID sets the owner edge to User by id.
 func (m *CarMutation) SetOwnerID(id int) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to User.
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *CarMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -105,6 +123,34 @@ func (m *CarMutation) OwnerID() (id int, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *CarMutation) OwnerIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("entv2: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("entv2: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Car.Query().Where(car.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -119,6 +165,7 @@ func (m *CarMutation) OwnerIDs() (ids []int) {
 func (m *CarMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // Op returns the operation name.
@@ -131,6 +178,11 @@ func (m *CarMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CarMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -328,6 +380,18 @@ func (m GroupMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m GroupMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *GroupMutation) ID() (id int, exists bool) {
@@ -347,6 +411,11 @@ func (m *GroupMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *GroupMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -524,6 +593,18 @@ func (m PetMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m PetMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *PetMutation) ID() (id int, exists bool) {
@@ -543,6 +624,11 @@ func (m *PetMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *PetMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -701,6 +787,7 @@ type UserMutation struct {
 	removedcar    map[int]struct{}
 	pets          *int
 	clearedpets   bool
+	conflictpets  bool
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -734,6 +821,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // SetID sets the value of the id field. Note that, this
 // operation is accepted only on User creation.
 func (m *UserMutation) SetID(id int) {
@@ -1037,11 +1136,15 @@ func (m *UserMutation) ResetCar() {
 // SetPetsID sets the pets edge to Pet by id.
 func (m *UserMutation) SetPetsID(id int) {
 	m.pets = &id
+	m.conflictpets = false
 }
 
-// ClearPets clears the pets edge to Pet.
+// ClearPets clears the pets edge to Pet. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearPets() {
 	m.clearedpets = true
+	m.conflictpets = m.pets != nil
 }
 
 // PetsCleared returns if the edge pets was cleared.
@@ -1057,6 +1160,34 @@ func (m *UserMutation) PetsID() (id int, exists bool) {
 	return
 }
 
+// PetsIDChanged reports if the pets edge is set to a different Pet, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) PetsIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.pets == nil && !m.clearedpets {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("entv2: PetsIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("entv2: PetsIDChanged requires an ID field in the mutation")
+	}
+	if m.pets != nil {
+		newID = *m.pets
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryPets().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.pets != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old pets id: %v", err)
+	}
+	return oldID, newID, m.pets == nil || oldID != newID, nil
+}
+
 // PetsIDs returns the pets ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // PetsID instead. It exists only for internal usage by the builders.
@@ -1071,6 +1202,7 @@ func (m *UserMutation) PetsIDs() (ids []int) {
 func (m *UserMutation) ResetPets() {
 	m.pets = nil
 	m.clearedpets = false
+	m.conflictpets = false
 }
 
 // Op returns the operation name.
@@ -1083,6 +1215,11 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -7,6 +7,8 @@
 package entv2
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -19,27 +21,79 @@ type Pet struct {
 	config
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Pet) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // id
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Pet type are scanned as generic values.
+func (*Pet) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case pet.FieldID:
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Pet fields.
-func (pe *Pet) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(pet.Columns); m < n {
+// to the Pet fields. Values of columns that were not selected are left untouched.
+func (pe *Pet) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
+	for i := range columns {
+		switch columns[i] {
+		case pet.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pe.ID = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (pe *Pet) SelectValue(alias string) (Value, error) {
+	v, ok := pe.selectValues[alias]
 	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
+		return nil, fmt.Errorf("entv2: expression column %q was not selected", alias)
 	}
-	pe.ID = int(value.Int64)
-	values = values[1:]
+	return v, nil
+}
+
+// DumpGraph returns the JSON encoding of the Pet and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Pet alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Pet itself is not modified.
+//
+//	b, err := pe.DumpGraph(ctx, client, 2)
+//
+func (pe *Pet) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("entv2: invalid depth %d for Pet DumpGraph", depth)
+	}
+	dumped := *pe
+	if err := dumpPets(ctx, client, []*Pet{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpPets loads the edges of the given Pet copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpPets(ctx context.Context, client *Client, nodes []*Pet, depth int, dumper *graphDump) error {
 	return nil
 }
 
@@ -61,6 +115,26 @@ func (pe *Pet) Unwrap() *Pet {
 	return pe
 }
 
+// Clone returns a deep copy of the Pet and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (pe *Pet) Clone() *Pet {
+	return pe.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Pet and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (pe *Pet) clone(seen map[interface{}]interface{}) *Pet {
+	if pe == nil {
+		return nil
+	}
+	if v, ok := seen[pe]; ok {
+		return v.(*Pet)
+	}
+	_c := *pe
+	seen[pe] = &_c
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (pe *Pet) String() string {
 	var builder strings.Builder
@@ -70,6 +144,16 @@ func (pe *Pet) String() string {
 	return builder.String()
 }
 
+// ToMap returns a flat key-value representation of the Pet for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Pet can be decoded using the FromMap method of its client.
+func (pe *Pet) ToMap() map[string]string {
+	m := make(map[string]string, 1)
+	m[pet.FieldID] = kvEncode(pe.ID)
+	return m
+}
+
 // Pets is a parsable slice of Pet.
 type Pets []*Pet
 
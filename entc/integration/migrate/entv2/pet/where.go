@@ -101,6 +101,9 @@ func And(predicates ...predicate.Pet) predicate.Pet {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -115,6 +118,9 @@ func Or(predicates ...predicate.Pet) predicate.Pet {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -125,3 +131,29 @@ func Not(p predicate.Pet) predicate.Pet {
 		p(s.Not())
 	})
 }
+
+// PetFilter holds optional filters on the Pet fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type PetFilter struct {
+	IDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Pet.Query().
+//		Where(pet.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *PetFilter) []predicate.Pet {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Pet
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	return ps
+}
@@ -23,6 +23,7 @@ type PetDelete struct {
 	hooks      []Hook
 	mutation   *PetMutation
 	predicates []predicate.Pet
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (pd *PetDelete) Where(ps ...predicate.Pet) *PetDelete {
 	return pd
 }
 
+// Limit limits the number of pets that are deleted by the builder. For example,
+// for deleting large amounts of pets in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (pd *PetDelete) Limit(limit int) *PetDelete {
+	pd.limit = &limit
+	return pd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pd *PetDelete) Unwrap() *PetDelete {
+	tx, ok := pd.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: PetDelete is not a transactional builder")
+	}
+	pd.config.driver = tx.drv
+	pd.mutation.driver = tx.drv
+	return pd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (pd *PetDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(pd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (pd *PetDelete) ExecX(ctx context.Context) int {
 }
 
 func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = pd.withOperation(ctx, "Pet", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: pet.Table,
@@ -85,7 +111,10 @@ func (pd *PetDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, pd.driver, _spec)
+	if limit := pd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, pd.mutationDriver(ctx), _spec)
 }
 
 // PetDeleteOne is the builder for deleting a single Pet entity.
@@ -99,7 +128,7 @@ func (pdo *PetDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{pet.Label}
 	default:
 		return nil
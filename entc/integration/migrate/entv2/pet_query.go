@@ -8,9 +8,12 @@ package entv2
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -27,6 +30,18 @@ type PetQuery struct {
 	order      []OrderFunc
 	unique     []string
 	predicates []predicate.Pet
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see PetSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -38,6 +53,19 @@ func (pq *PetQuery) Where(ps ...predicate.Pet) *PetQuery {
 	return pq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (pq *PetQuery) Predicates() []predicate.Pet {
+	return append([]predicate.Pet{}, pq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (pq *PetQuery) SetPredicates(ps ...predicate.Pet) *PetQuery {
+	pq.predicates = append([]predicate.Pet{}, ps...)
+	return pq
+}
+
 // Limit adds a limit step to the query.
 func (pq *PetQuery) Limit(limit int) *PetQuery {
 	pq.limit = &limit
@@ -253,11 +281,15 @@ func (pq *PetQuery) GroupBy(field string, fields ...string) *PetGroupBy {
 func (pq *PetQuery) Select(field string, fields ...string) *PetSelect {
 	selector := &PetSelect{config: pq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = pq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return pq.sqlQuery(), nil
+		if err := pq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return pq.sqlQuery().AppendSelectExpr(pq.exprs...), nil
 	}
 	return selector
 }
@@ -274,14 +306,28 @@ func (pq *PetQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
+	ctx = pq.withOperation(ctx, "Pet", "Query")
+	if err := pq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range pq.fields {
+		if !pet.ValidColumn(f) {
+			return nil, fmt.Errorf("entv2: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes = []*Pet{}
 		_spec = pq.querySpec()
 	)
+	_spec.Exprs = pq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Pet{config: pq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
+		values := node.scanValues(columns)
+		for range pq.exprs {
+			values = append(values, new(interface{}))
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -289,7 +335,15 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 			return fmt.Errorf("entv2: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
-		return node.assignValues(values...)
+		if n := len(pq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range pq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
 		return nil, err
@@ -300,7 +354,42 @@ func (pq *PetQuery) sqlAll(ctx context.Context) ([]*Pet, error) {
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Pet entities (see Pet.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Pet.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Pet table.
+func (pq *PetQuery) SelectExpr(exprs ...*sql.SelectExpr) *PetQuery {
+	pq.exprs = append(pq.exprs, exprs...)
+	return pq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (pq *PetQuery) checkExprs() error {
+	if len(pq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range pet.Columns {
+		columns[c] = true
+	}
+	for _, e := range pq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("entv2: alias %q of expression column collides with another column of Pet", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (pq *PetQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = pq.withOperation(ctx, "Pet", "Count")
 	_spec := pq.querySpec()
 	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
 }
@@ -326,6 +415,17 @@ func (pq *PetQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   pq.sql,
 		Unique: true,
 	}
+	if fields := pq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, pet.FieldID)
+		for i := range fields {
+			if fields[i] != pet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = pq.forUpdate
+	_spec.PartitionBy = pq.partition
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -374,11 +474,387 @@ func (pq *PetQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Pet entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (pq *PetQuery) IDsQuery() *sql.Selector {
+	selector := pq.sqlQuery()
+	if pq.path != nil {
+		selector.AddError(fmt.Errorf("entv2: IDsQuery does not support traversal queries"))
+	}
+	return selector.Select(selector.C(pet.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Pet entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Pet.Query().
+//		Where(...).
+//		CountDistinct(ctx, pet.FieldID)
+//
+func (pq *PetQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := pq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = pq.withOperation(ctx, "Pet", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, pq.driver, pq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("entv2: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (pq *PetQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := pq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Pet rows as generic maps that are keyed by
+// column name (see pet.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (pq *PetQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := pq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = pq.withOperation(ctx, "Pet", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = pq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Pet{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (pq *PetQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := pq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Pet entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (pq *PetQuery) Prefetch(n int) *PetQuery {
+	pq.prefetch = n
+	return pq
+}
+
+// ForUpdate locks the selected Pet rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (pq *PetQuery) ForUpdate() *PetQuery {
+	pq.forUpdate = true
+	return pq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (pq *PetQuery) Primary() *PetQuery {
+	return pq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (pq *PetQuery) ReadConsistency(c sql.ReadConsistency) *PetQuery {
+	pq.consistency = c
+	return pq
+}
+
+// Stream executes the query in batches, and sends the matched Pet entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Pet.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (pq *PetQuery) Stream(ctx context.Context) (<-chan *Pet, <-chan error) {
+	size := pq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Pet, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := pq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Pet entities in batches of the given
+// size, and sends them to the given channel.
+func (pq *PetQuery) stream(ctx context.Context, size int, nodes chan<- *Pet) error {
+	if pq.offset != nil || len(pq.order) > 0 {
+		return fmt.Errorf("entv2: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if pq.limit != nil {
+		left = *pq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *pq
+		query.predicates = pq.predicates[:len(pq.predicates):len(pq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, pet.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(pet.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// PetEdge is the edge representation of Pet in a connection.
+type PetEdge struct {
+	Node   *Pet   `json:"node"`
+	Cursor Cursor `json:"cursor"`
+}
+
+// PetConnection is the connection of Pet entities that is returned by Paginate.
+type PetConnection struct {
+	Edges      []PetEdge `json:"edges"`
+	PageInfo   PageInfo  `json:"pageInfo"`
+	TotalCount int       `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Pet.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: pet.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (pq *PetQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*PetConnection, error) {
+	if pq.limit != nil || pq.offset != nil || len(pq.order) > 0 {
+		return nil, fmt.Errorf("entv2: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("entv2: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		return nil, fmt.Errorf("entv2: unsupported order field %q for Pet", o.Field)
+	}
+	total, err := pq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: pet.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *pq
+	query.predicates = pq.predicates[:len(pq.predicates):len(pq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := pq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &PetConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]PetEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = PetEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (pq *PetQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Pet, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("entv2: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := pq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Pet(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*PetQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case pet.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("entv2: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("entv2: unsupported order field %q for Pet", field)
+}
+
+// pageCursor returns the cursor of the Pet node for the given order fields.
+func (pe *Pet) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(pe.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	if len(orderBy) > 0 {
+		return Cursor{}, fmt.Errorf("entv2: unsupported order field %q for Pet", orderBy[0].Field)
+	}
+	return Cursor{id: id}, nil
+}
+
 // PetGroupBy is the builder for group-by Pet entities.
 type PetGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -491,9 +967,28 @@ func (pgb *PetGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Pet.Query().
+//		GroupBy(...).
+//		Aggregate(entv2.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (pgb *PetGroupBy) Having(ps ...*sql.Predicate) *PetGroupBy {
+	pgb.havings = append(pgb.havings, ps...)
+	return pgb
+}
+
 func (pgb *PetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = pgb.withOperation(ctx, "Pet", "GroupBy")
+	selector := pgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := pgb.sqlQuery().Query()
 	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -506,15 +1001,30 @@ func (pgb *PetGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(pgb.fields)+len(pgb.fns))
 	columns = append(columns, pgb.fields...)
 	for _, fn := range pgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(pgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
 	}
-	return selector.Select(columns...).GroupBy(pgb.fields...)
+	selector = selector.Select(columns...).GroupBy(pgb.fields...)
+	switch len(pgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(pgb.havings[0])
+	default:
+		selector.Having(sql.And(pgb.havings...))
+	}
+	return selector
 }
 
 // PetSelect is the builder for select fields of Pet entities.
 type PetSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *PetQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -621,7 +1131,47 @@ func (ps *PetSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ps *PetSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ps.fields) > 1 {
+		return nil, errors.New("entv2: PetSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ps *PetSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ps.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Pet entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+func (ps *PetSelect) All(ctx context.Context) ([]*Pet, error) {
+	query := *ps.query
+	query.fields = ps.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ps *PetSelect) AllX(ctx context.Context) []*Pet {
+	nodes, err := ps.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (ps *PetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ps.withOperation(ctx, "Pet", "Select")
 	rows := &sql.Rows{}
 	query, args := ps.sqlQuery().Query()
 	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
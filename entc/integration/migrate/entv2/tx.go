@@ -8,7 +8,10 @@ package entv2
 
 import (
 	"context"
+	"errors"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect"
 )
@@ -35,13 +38,22 @@ type Tx struct {
 	onRollback []func(error)
 }
 
-// Commit commits the transaction.
+// Commit commits the transaction. Functions that were registered
+// by the mutations of the transaction (see ent.AfterCommit) are called after a successful commit.
+// A panic in one of the registered functions is recovered, and does not affect the commit.
 func (tx *Tx) Commit() error {
-	err := tx.config.driver.(*txDriver).tx.Commit()
+	drv := tx.config.driver.(*txDriver)
+	err := drv.tx.Commit()
+	drv.close()
+	drv.emit(TxCommit, err)
 	tx.mu.Lock()
-	defer tx.mu.Unlock()
-	for _, f := range tx.onCommit {
-		f(err)
+	fns := tx.onCommit
+	tx.mu.Unlock()
+	for _, f := range fns {
+		safeCall(func() { f(err) })
+	}
+	if err == nil {
+		drv.committed()
 	}
 	return err
 }
@@ -54,12 +66,17 @@ func (tx *Tx) OnCommit(f func(error)) {
 }
 
 // Rollback rollbacks the transaction.
+// A panic in one of the registered functions is recovered, and does not affect the rollback.
 func (tx *Tx) Rollback() error {
-	err := tx.config.driver.(*txDriver).tx.Rollback()
+	drv := tx.config.driver.(*txDriver)
+	err := drv.tx.Rollback()
+	drv.close()
+	drv.emit(TxRollback, err)
 	tx.mu.Lock()
-	defer tx.mu.Unlock()
-	for _, f := range tx.onRollback {
-		f(err)
+	fns := tx.onRollback
+	tx.mu.Unlock()
+	for _, f := range fns {
+		safeCall(func() { f(err) })
 	}
 	return err
 }
@@ -71,6 +88,31 @@ func (tx *Tx) OnRollback(f func(error)) {
 	tx.onRollback = append(tx.onRollback, f)
 }
 
+// TxOp is the lifecycle boundary of a transaction that is reported in a TxEvent.
+type TxOp string
+
+// Transaction lifecycle boundaries.
+const (
+	TxBegin    TxOp = "begin"
+	TxCommit   TxOp = "commit"
+	TxRollback TxOp = "rollback"
+)
+
+// TxEvent describes a lifecycle event of a transaction. It is passed
+// to the function that was set by the TxObserver option.
+type TxEvent struct {
+	// Op is the lifecycle boundary of the transaction.
+	Op TxOp
+	// Duration is the time that passed since the transaction began.
+	// It is always zero for TxBegin events.
+	Duration time.Duration
+	// Labels holds the labels of the entities that were mutated in the
+	// transaction until the event, sorted in ascending order.
+	Labels []string
+	// Err is the error that was returned by the commit or the rollback.
+	Err error
+}
+
 // Client returns a Client that binds to current transaction.
 func (tx *Tx) Client() *Client {
 	tx.clientOnce.Do(func() {
@@ -103,8 +145,26 @@ type txDriver struct {
 	drv dialect.Driver
 	// tx is the underlying transaction.
 	tx dialect.Tx
+	// functions to call after the transaction was committed.
+	mu          sync.Mutex
+	afterCommit []func()
+	// observer of the transaction lifecycle (if set), the context and the time
+	// the transaction began, and the labels of the entities that were mutated.
+	observer func(context.Context, TxEvent)
+	ctx      context.Context
+	start    time.Time
+	labels   map[string]struct{}
+	// closed reports if the transaction was committed or rolled back.
+	closed bool
+	// savepoints counts the savepoints that were created in the transaction.
+	savepoints int
 }
 
+// ErrTxClosed is returned by builders (and entities) that execute statements after their
+// transaction was committed or rolled back. Use their Unwrap method in order to execute
+// them through the driver that started the transaction.
+var ErrTxClosed = errors.New("entv2: builder bound to a closed transaction")
+
 // newTx creates a new transactional driver.
 func newTx(ctx context.Context, drv dialect.Driver) (*txDriver, error) {
 	tx, err := drv.Tx(ctx)
@@ -132,13 +192,114 @@ func (*txDriver) Commit() error { return nil }
 // User must call `Tx.Rollback` in order to rollback the transaction.
 func (*txDriver) Rollback() error { return nil }
 
-// Exec calls tx.Exec.
+// onCommitted adds a function to call after the transaction was committed.
+func (tx *txDriver) onCommitted(f func()) {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.afterCommit = append(tx.afterCommit, f)
+}
+
+// committed calls the functions that were registered by
+// the mutations after the transaction was committed.
+func (tx *txDriver) committed() {
+	tx.mu.Lock()
+	fns := tx.afterCommit
+	tx.afterCommit = nil
+	tx.mu.Unlock()
+	for _, f := range fns {
+		safeCall(f)
+	}
+}
+
+// checkTx returns ErrTxClosed if the given driver is a transaction that was
+// committed or rolled back. It is called by the builders before executing.
+func checkTx(drv dialect.Driver) error {
+	if tx, ok := drv.(*txDriver); ok && tx.isClosed() {
+		return ErrTxClosed
+	}
+	return nil
+}
+
+// close marks the transaction as closed, after it was committed or rolled back.
+func (tx *txDriver) close() {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	tx.closed = true
+}
+
+// isClosed reports if the transaction was committed or rolled back.
+func (tx *txDriver) isClosed() bool {
+	tx.mu.Lock()
+	defer tx.mu.Unlock()
+	return tx.closed
+}
+
+// observe sets the observer of the transaction and reports its TxBegin event.
+func (tx *txDriver) observe(ctx context.Context, observer func(context.Context, TxEvent)) {
+	if observer == nil {
+		return
+	}
+	tx.observer, tx.ctx, tx.start = observer, ctx, time.Now()
+	tx.emit(TxBegin, nil)
+}
+
+// emit reports the given lifecycle event to the observer of the transaction (if set).
+func (tx *txDriver) emit(op TxOp, err error) {
+	if tx.observer == nil {
+		return
+	}
+	e := TxEvent{Op: op, Err: err}
+	if op != TxBegin {
+		e.Duration = time.Since(tx.start)
+	}
+	tx.mu.Lock()
+	for l := range tx.labels {
+		e.Labels = append(e.Labels, l)
+	}
+	tx.mu.Unlock()
+	sort.Strings(e.Labels)
+	safeCall(func() { tx.observer(tx.ctx, e) })
+}
+
+// record returns a hook that records the given label in the
+// transaction after a mutation was applied successfully.
+func (tx *txDriver) record(label string) Hook {
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err == nil {
+				tx.mu.Lock()
+				if tx.labels == nil {
+					tx.labels = make(map[string]struct{})
+				}
+				tx.labels[label] = struct{}{}
+				tx.mu.Unlock()
+			}
+			return v, err
+		})
+	}
+}
+
+// safeCall calls the given callback of the transaction, and recovers from
+// its panic (if any), in order to not break the commit or the rollback.
+func safeCall(f func()) {
+	defer func() { _ = recover() }()
+	f()
+}
+
+// Exec calls tx.Exec, or fails with ErrTxClosed if the transaction was closed.
 func (tx *txDriver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if tx.isClosed() {
+		return ErrTxClosed
+	}
 	return tx.tx.Exec(ctx, query, args, v)
 }
 
-// Query calls tx.Query.
+// Query calls tx.Query, or fails with ErrTxClosed if the transaction was closed.
 func (tx *txDriver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if tx.isClosed() {
+		return ErrTxClosed
+	}
 	return tx.tx.Query(ctx, query, args, v)
 }
 
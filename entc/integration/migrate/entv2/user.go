@@ -7,7 +7,12 @@
 package entv2
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -42,6 +47,9 @@ type User struct {
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges     UserEdges `json:"edges"`
 	user_pets *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // UserEdges holds the relations/edges for other nodes in the graph.
@@ -78,98 +86,132 @@ func (e UserEdges) PetsOrErr() (*Pet, error) {
 	return nil, &NotLoadedError{edge: "pets"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*User) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullInt64{},  // age
-		&sql.NullString{}, // name
-		&sql.NullString{}, // nickname
-		&sql.NullString{}, // phone
-		&[]byte{},         // buffer
-		&sql.NullString{}, // title
-		&sql.NullString{}, // new_name
-		&[]byte{},         // blob
-		&sql.NullString{}, // state
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*User) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // user_pets
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the User type are scanned as generic values.
+func (*User) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			values[i] = &sql.NullInt64{}
+		case user.FieldAge:
+			values[i] = &sql.NullInt64{}
+		case user.FieldName:
+			values[i] = &sql.NullString{}
+		case user.FieldNickname:
+			values[i] = &sql.NullString{}
+		case user.FieldPhone:
+			values[i] = &sql.NullString{}
+		case user.FieldBuffer:
+			values[i] = &[]byte{}
+		case user.FieldTitle:
+			values[i] = &sql.NullString{}
+		case user.FieldNewName:
+			values[i] = &sql.NullString{}
+		case user.FieldBlob:
+			values[i] = &[]byte{}
+		case user.FieldState:
+			values[i] = &sql.NullString{}
+		case user.ForeignKeys[0]: // user_pets
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the User fields.
-func (u *User) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(user.Columns); m < n {
+// to the User fields. Values of columns that were not selected are left untouched.
+func (u *User) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	u.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field age", values[0])
-	} else if value.Valid {
-		u.Age = int(value.Int64)
-	}
-	if value, ok := values[1].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[1])
-	} else if value.Valid {
-		u.Name = value.String
-	}
-	if value, ok := values[2].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field nickname", values[2])
-	} else if value.Valid {
-		u.Nickname = value.String
-	}
-	if value, ok := values[3].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field phone", values[3])
-	} else if value.Valid {
-		u.Phone = value.String
-	}
-	if value, ok := values[4].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field buffer", values[4])
-	} else if value != nil {
-		u.Buffer = *value
-	}
-	if value, ok := values[5].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field title", values[5])
-	} else if value.Valid {
-		u.Title = value.String
-	}
-	if value, ok := values[6].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field new_name", values[6])
-	} else if value.Valid {
-		u.NewName = value.String
-	}
-	if value, ok := values[7].(*[]byte); !ok {
-		return fmt.Errorf("unexpected type %T for field blob", values[7])
-	} else if value != nil {
-		u.Blob = *value
-	}
-	if value, ok := values[8].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field state", values[8])
-	} else if value.Valid {
-		u.State = user.State(value.String)
-	}
-	values = values[9:]
-	if len(values) == len(user.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_pets", value)
-		} else if value.Valid {
-			u.user_pets = new(int)
-			*u.user_pets = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case user.FieldAge:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field age", values[i])
+			} else if value.Valid {
+				u.Age = int(value.Int64)
+			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				u.Name = value.String
+			}
+		case user.FieldNickname:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nickname", values[i])
+			} else if value.Valid {
+				u.Nickname = value.String
+			}
+		case user.FieldPhone:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field phone", values[i])
+			} else if value.Valid {
+				u.Phone = value.String
+			}
+		case user.FieldBuffer:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field buffer", values[i])
+			} else if value != nil {
+				u.Buffer = *value
+			}
+		case user.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				u.Title = value.String
+			}
+		case user.FieldNewName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field new_name", values[i])
+			} else if value.Valid {
+				u.NewName = value.String
+			}
+		case user.FieldBlob:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field blob", values[i])
+			} else if value != nil {
+				u.Blob = *value
+			}
+		case user.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				u.State = user.State(value.String)
+			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_pets", value)
+			} else if value.Valid {
+				u.user_pets = new(int)
+				*u.user_pets = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (u *User) SelectValue(alias string) (Value, error) {
+	v, ok := u.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("entv2: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryCar queries the car edge of the User.
 func (u *User) QueryCar() *CarQuery {
 	return (&UserClient{config: u.config}).QueryCar(u)
@@ -180,6 +222,106 @@ func (u *User) QueryPets() *PetQuery {
 	return (&UserClient{config: u.config}).QueryPets(u)
 }
 
+// LoadEdges loads the given edges of the User using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see user.Edges), and in this case, no edge is loaded.
+//
+//	err := u.LoadEdges(ctx, client, user.EdgeCar, user.EdgePets)
+//
+func (u *User) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadUserEdges(ctx, client, []*User{u}, edges)
+}
+
+// loadUserEdges loads the given edges of all the given User entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using UserClient.LoadEdgeFor.
+func loadUserEdges(ctx context.Context, client *Client, nodes []*User, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case user.EdgeCar, user.EdgePets:
+		default:
+			return fmt.Errorf("entv2: unknown edge %q for type User", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.User.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the User and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the User alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The User itself is not modified.
+//
+//	b, err := u.DumpGraph(ctx, client, 2, user.EdgeCar)
+//
+func (u *User) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("entv2: invalid depth %d for User DumpGraph", depth)
+	}
+	dumped := *u
+	dumped.Edges = UserEdges{}
+	if err := dumpUsers(ctx, client, []*User{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpUsers loads the edges of the given User copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpUsers(ctx context.Context, client *Client, nodes []*User, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*User
+	for _, n := range nodes {
+		if dumper.visit(user.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range user.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadUserEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextCar []*Car
+	for _, n := range visit {
+		for i, e := range n.Edges.Car {
+			c := *e
+			c.Edges = CarEdges{}
+			n.Edges.Car[i] = &c
+			nextCar = append(nextCar, &c)
+		}
+	}
+	if err := dumpCars(ctx, client, nextCar, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextPets []*Pet
+	for _, n := range visit {
+		if e := n.Edges.Pets; e != nil {
+			c := *e
+			n.Edges.Pets = &c
+			nextPets = append(nextPets, &c)
+		}
+	}
+	if err := dumpPets(ctx, client, nextPets, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,6 +340,39 @@ func (u *User) Unwrap() *User {
 	return u
 }
 
+// Clone returns a deep copy of the User and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (u *User) Clone() *User {
+	return u.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the User and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (u *User) clone(seen map[interface{}]interface{}) *User {
+	if u == nil {
+		return nil
+	}
+	if v, ok := seen[u]; ok {
+		return v.(*User)
+	}
+	_c := *u
+	seen[u] = &_c
+	if u.Buffer != nil {
+		_c.Buffer = append([]byte{}, u.Buffer...)
+	}
+	if u.Blob != nil {
+		_c.Blob = append([]byte{}, u.Blob...)
+	}
+	if u.Edges.Car != nil {
+		_c.Edges.Car = make([]*Car, len(u.Edges.Car))
+		for _i := range u.Edges.Car {
+			_c.Edges.Car[_i] = u.Edges.Car[_i].clone(seen)
+		}
+	}
+	_c.Edges.Pets = u.Edges.Pets.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (u *User) String() string {
 	var builder strings.Builder
@@ -225,6 +400,50 @@ func (u *User) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the User, for change detection and cache keys.
+// It covers the fields that are listed in user.FingerprintFields, and it does not depend on the
+// ID of the User, on its edges, or on the order of the fields in the schema.
+func (u *User) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "age", u.Age)
+	fingerprint(h, "blob", u.Blob)
+	fingerprint(h, "buffer", u.Buffer)
+	fingerprint(h, "name", u.Name)
+	fingerprint(h, "new_name", u.NewName)
+	fingerprint(h, "nickname", u.Nickname)
+	fingerprint(h, "phone", u.Phone)
+	fingerprint(h, "state", u.State)
+	fingerprint(h, "title", u.Title)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the User for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The User can be decoded using the FromMap method of its client.
+func (u *User) ToMap() map[string]string {
+	m := make(map[string]string, 10)
+	m[user.FieldID] = kvEncode(u.ID)
+	m[user.FieldAge] = kvEncode(u.Age)
+	m[user.FieldName] = kvEncode(u.Name)
+	m[user.FieldNickname] = kvEncode(u.Nickname)
+	m[user.FieldPhone] = kvEncode(u.Phone)
+	if v := u.Buffer; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldBuffer] = kvEncode(v)
+	}
+	m[user.FieldTitle] = kvEncode(u.Title)
+	if v := u.NewName; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldNewName] = kvEncode(v)
+	}
+	if v := u.Blob; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldBlob] = kvEncode(v)
+	}
+	if v := u.State; !reflect.ValueOf(v).IsZero() {
+		m[user.FieldState] = kvEncode(v)
+	}
+	return m
+}
+
 // Users is a parsable slice of User.
 type Users []*User
 
@@ -48,6 +48,26 @@ const (
 	PetsColumn = "user_pets"
 )
 
+// Edges holds all the edge names of the User type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeCar,
+	EdgePets,
+}
+
+// FingerprintFields holds the fields of the User type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldAge,
+	FieldBlob,
+	FieldBuffer,
+	FieldName,
+	FieldNewName,
+	FieldNickname,
+	FieldPhone,
+	FieldState,
+	FieldTitle,
+}
+
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
@@ -67,6 +87,16 @@ var ForeignKeys = []string{
 	"user_pets",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// DefaultPhone holds the default value on creation for the phone field.
 	DefaultPhone string
@@ -7,6 +7,8 @@
 package user
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv2/predicate"
@@ -310,6 +312,13 @@ func NameContains(v string) predicate.User {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -317,6 +326,13 @@ func NameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -324,6 +340,13 @@ func NameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -338,6 +361,20 @@ func NameContainsFold(v string) predicate.User {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
 // NicknameEQ applies the EQ predicate on the "nickname" field.
 func NicknameEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -421,6 +458,13 @@ func NicknameContains(v string) predicate.User {
 	})
 }
 
+// NicknameNotContains applies the NotContains predicate on the "nickname" field.
+func NicknameNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameHasPrefix applies the HasPrefix predicate on the "nickname" field.
 func NicknameHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -428,6 +472,13 @@ func NicknameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasPrefix applies the NotHasPrefix predicate on the "nickname" field.
+func NicknameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameHasSuffix applies the HasSuffix predicate on the "nickname" field.
 func NicknameHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -435,6 +486,13 @@ func NicknameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NicknameNotHasSuffix applies the NotHasSuffix predicate on the "nickname" field.
+func NicknameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNickname), v))
+	})
+}
+
 // NicknameEqualFold applies the EqualFold predicate on the "nickname" field.
 func NicknameEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -449,6 +507,20 @@ func NicknameContainsFold(v string) predicate.User {
 	})
 }
 
+// NicknameHasPrefixFold applies the HasPrefixFold predicate on the "nickname" field.
+func NicknameHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNickname), v))
+	})
+}
+
+// NicknameHasSuffixFold applies the HasSuffixFold predicate on the "nickname" field.
+func NicknameHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNickname), v))
+	})
+}
+
 // PhoneEQ applies the EQ predicate on the "phone" field.
 func PhoneEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -532,6 +604,13 @@ func PhoneContains(v string) predicate.User {
 	})
 }
 
+// PhoneNotContains applies the NotContains predicate on the "phone" field.
+func PhoneNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldPhone), v))
+	})
+}
+
 // PhoneHasPrefix applies the HasPrefix predicate on the "phone" field.
 func PhoneHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -539,6 +618,13 @@ func PhoneHasPrefix(v string) predicate.User {
 	})
 }
 
+// PhoneNotHasPrefix applies the NotHasPrefix predicate on the "phone" field.
+func PhoneNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldPhone), v))
+	})
+}
+
 // PhoneHasSuffix applies the HasSuffix predicate on the "phone" field.
 func PhoneHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -546,6 +632,13 @@ func PhoneHasSuffix(v string) predicate.User {
 	})
 }
 
+// PhoneNotHasSuffix applies the NotHasSuffix predicate on the "phone" field.
+func PhoneNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldPhone), v))
+	})
+}
+
 // PhoneEqualFold applies the EqualFold predicate on the "phone" field.
 func PhoneEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -560,6 +653,20 @@ func PhoneContainsFold(v string) predicate.User {
 	})
 }
 
+// PhoneHasPrefixFold applies the HasPrefixFold predicate on the "phone" field.
+func PhoneHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldPhone), v))
+	})
+}
+
+// PhoneHasSuffixFold applies the HasSuffixFold predicate on the "phone" field.
+func PhoneHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldPhone), v))
+	})
+}
+
 // BufferEQ applies the EQ predicate on the "buffer" field.
 func BufferEQ(v []byte) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -733,6 +840,13 @@ func TitleContains(v string) predicate.User {
 	})
 }
 
+// TitleNotContains applies the NotContains predicate on the "title" field.
+func TitleNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldTitle), v))
+	})
+}
+
 // TitleHasPrefix applies the HasPrefix predicate on the "title" field.
 func TitleHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -740,6 +854,13 @@ func TitleHasPrefix(v string) predicate.User {
 	})
 }
 
+// TitleNotHasPrefix applies the NotHasPrefix predicate on the "title" field.
+func TitleNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldTitle), v))
+	})
+}
+
 // TitleHasSuffix applies the HasSuffix predicate on the "title" field.
 func TitleHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -747,6 +868,13 @@ func TitleHasSuffix(v string) predicate.User {
 	})
 }
 
+// TitleNotHasSuffix applies the NotHasSuffix predicate on the "title" field.
+func TitleNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldTitle), v))
+	})
+}
+
 // TitleEqualFold applies the EqualFold predicate on the "title" field.
 func TitleEqualFold(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -761,6 +889,20 @@ func TitleContainsFold(v string) predicate.User {
 	})
 }
 
+// TitleHasPrefixFold applies the HasPrefixFold predicate on the "title" field.
+func TitleHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldTitle), v))
+	})
+}
+
+// TitleHasSuffixFold applies the HasSuffixFold predicate on the "title" field.
+func TitleHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldTitle), v))
+	})
+}
+
 // NewNameEQ applies the EQ predicate on the "new_name" field.
 func NewNameEQ(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -844,6 +986,13 @@ func NewNameContains(v string) predicate.User {
 	})
 }
 
+// NewNameNotContains applies the NotContains predicate on the "new_name" field.
+func NewNameNotContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNewName), v))
+	})
+}
+
 // NewNameHasPrefix applies the HasPrefix predicate on the "new_name" field.
 func NewNameHasPrefix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -851,6 +1000,13 @@ func NewNameHasPrefix(v string) predicate.User {
 	})
 }
 
+// NewNameNotHasPrefix applies the NotHasPrefix predicate on the "new_name" field.
+func NewNameNotHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNewName), v))
+	})
+}
+
 // NewNameHasSuffix applies the HasSuffix predicate on the "new_name" field.
 func NewNameHasSuffix(v string) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -858,6 +1014,13 @@ func NewNameHasSuffix(v string) predicate.User {
 	})
 }
 
+// NewNameNotHasSuffix applies the NotHasSuffix predicate on the "new_name" field.
+func NewNameNotHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNewName), v))
+	})
+}
+
 // NewNameIsNil applies the IsNil predicate on the "new_name" field.
 func NewNameIsNil() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -886,6 +1049,20 @@ func NewNameContainsFold(v string) predicate.User {
 	})
 }
 
+// NewNameHasPrefixFold applies the HasPrefixFold predicate on the "new_name" field.
+func NewNameHasPrefixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNewName), v))
+	})
+}
+
+// NewNameHasSuffixFold applies the HasSuffixFold predicate on the "new_name" field.
+func NewNameHasSuffixFold(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNewName), v))
+	})
+}
+
 // BlobEQ applies the EQ predicate on the "blob" field.
 func BlobEQ(v []byte) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1038,6 +1215,96 @@ func StateNotNil() predicate.User {
 	})
 }
 
+// NewNameEQNullSafe applies a NULL-safe equality predicate on the "new_name" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func NewNameEQNullSafe(v *string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldNewName), arg))
+	})
+}
+
+// StateEQNullSafe applies a NULL-safe equality predicate on the "state" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func StateEQNullSafe(v *State) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldState), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldAge:      "numeric",
+	FieldID:       "numeric",
+	FieldName:     "string",
+	FieldNewName:  "string",
+	FieldNickname: "string",
+	FieldPhone:    "string",
+	FieldState:    "string",
+	FieldTitle:    "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the User.
+func FieldsEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the User.
+func FieldsNEQ(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the User.
+//
+//	user.FieldsLT(user.FieldName, user.FieldNickname)
+//
+func FieldsLT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the User.
+func FieldsLTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the User.
+func FieldsGT(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the User.
+func FieldsGTE(f1, f2 string) predicate.User {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the User using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("entv2: field %q is not a comparable field of User", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("entv2: field %q is not a comparable field of User", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("entv2: fields %q (%s) and %q (%s) of User are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasCar applies the HasEdge predicate on the "car" edge.
 func HasCar() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1094,6 +1361,45 @@ func HasPetsWith(preds ...predicate.Pet) predicate.User {
 	})
 }
 
+// PetsIDIn applies a predicate on the "pets" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	user.PetsIDIn(client.Pet.Query().Where(...))
+//
+func PetsIDIn(q sqlgraph.IDsQuerier) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(PetsColumn), sub))
+	})
+}
+
+// PetsMissing applies a predicate on the "pets" edge, that checks if its foreign-key is set, but
+// there is no Pet with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_pets IS NOT NULL AND NOT EXISTS (SELECT id FROM pets WHERE id = user_pets)
+//
+func PetsMissing() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(PetsInverseTable).As("pets")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(PetsColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(PetsColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -1101,6 +1407,9 @@ func And(predicates ...predicate.User) predicate.User {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -1115,6 +1424,9 @@ func Or(predicates ...predicate.User) predicate.User {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -1125,3 +1437,67 @@ func Not(p predicate.User) predicate.User {
 		p(s.Not())
 	})
 }
+
+// UserFilter holds optional filters on the User fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type UserFilter struct {
+	IDIn     []int
+	Age      *int
+	Name     *string
+	Nickname *string
+	Phone    *string
+	Title    *string
+	NewName  *string
+	State    *State
+	PetsIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.User.Query().
+//		Where(user.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *UserFilter) []predicate.User {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.User
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Age != nil {
+		ps = append(ps, AgeEQ(*f.Age))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.Nickname != nil {
+		ps = append(ps, NicknameEQ(*f.Nickname))
+	}
+	if f.Phone != nil {
+		ps = append(ps, PhoneEQ(*f.Phone))
+	}
+	if f.Title != nil {
+		ps = append(ps, TitleEQ(*f.Title))
+	}
+	if f.NewName != nil {
+		ps = append(ps, NewNameEQ(*f.NewName))
+	}
+	if f.State != nil {
+		ps = append(ps, StateEQ(*f.State))
+	}
+	if ids := f.PetsIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.User(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(PetsColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -23,6 +23,7 @@ type UserDelete struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (ud *UserDelete) Where(ps ...predicate.User) *UserDelete {
 	return ud
 }
 
+// Limit limits the number of users that are deleted by the builder. For example,
+// for deleting large amounts of users in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (ud *UserDelete) Limit(limit int) *UserDelete {
+	ud.limit = &limit
+	return ud
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (ud *UserDelete) Unwrap() *UserDelete {
+	tx, ok := ud.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: UserDelete is not a transactional builder")
+	}
+	ud.config.driver = tx.drv
+	ud.mutation.driver = tx.drv
+	return ud
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (ud *UserDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(ud.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (ud *UserDelete) ExecX(ctx context.Context) int {
 }
 
 func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = ud.withOperation(ctx, "User", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: user.Table,
@@ -85,7 +111,10 @@ func (ud *UserDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, ud.driver, _spec)
+	if limit := ud.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, ud.mutationDriver(ctx), _spec)
 }
 
 // UserDeleteOne is the builder for deleting a single User entity.
@@ -99,7 +128,7 @@ func (udo *UserDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{user.Label}
 	default:
 		return nil
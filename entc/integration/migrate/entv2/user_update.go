@@ -8,6 +8,7 @@ package entv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -25,6 +26,9 @@ type UserUpdate struct {
 	hooks      []Hook
 	mutation   *UserMutation
 	predicates []predicate.User
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -33,6 +37,13 @@ func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
 	return uu
 }
 
+// Limit limits the number of users that are updated by the builder. For example,
+// for updating large amounts of users in small batches without locking the whole table.
+func (uu *UserUpdate) Limit(limit int) *UserUpdate {
+	uu.limit = &limit
+	return uu
+}
+
 // SetAge sets the age field.
 func (uu *UserUpdate) SetAge(i int) *UserUpdate {
 	uu.mutation.ResetAge()
@@ -46,18 +57,39 @@ func (uu *UserUpdate) AddAge(i int) *UserUpdate {
 	return uu
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetAge() *UserUpdate {
+	uu.mutation.ResetAge()
+	return uu
+}
+
 // SetName sets the name field.
 func (uu *UserUpdate) SetName(s string) *UserUpdate {
 	uu.mutation.SetName(s)
 	return uu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetName() *UserUpdate {
+	uu.mutation.ResetName()
+	return uu
+}
+
 // SetNickname sets the nickname field.
 func (uu *UserUpdate) SetNickname(s string) *UserUpdate {
 	uu.mutation.SetNickname(s)
 	return uu
 }
 
+// UnsetNickname removes the changes of the nickname field from the builder (e.g. a previous call
+// to SetNickname), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetNickname() *UserUpdate {
+	uu.mutation.ResetNickname()
+	return uu
+}
+
 // SetPhone sets the phone field.
 func (uu *UserUpdate) SetPhone(s string) *UserUpdate {
 	uu.mutation.SetPhone(s)
@@ -72,6 +104,13 @@ func (uu *UserUpdate) SetNillablePhone(s *string) *UserUpdate {
 	return uu
 }
 
+// UnsetPhone removes the changes of the phone field from the builder (e.g. a previous call
+// to SetPhone), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetPhone() *UserUpdate {
+	uu.mutation.ResetPhone()
+	return uu
+}
+
 // SetBuffer sets the buffer field.
 func (uu *UserUpdate) SetBuffer(b []byte) *UserUpdate {
 	uu.mutation.SetBuffer(b)
@@ -84,6 +123,14 @@ func (uu *UserUpdate) ClearBuffer() *UserUpdate {
 	return uu
 }
 
+// UnsetBuffer removes the changes of the buffer field from the builder (e.g. a previous call
+// to SetBuffer), and therefore, the field is left unchanged in the database. Unlike ClearBuffer,
+// it does not set the field to NULL, and also removes a previous call to ClearBuffer.
+func (uu *UserUpdate) UnsetBuffer() *UserUpdate {
+	uu.mutation.ResetBuffer()
+	return uu
+}
+
 // SetTitle sets the title field.
 func (uu *UserUpdate) SetTitle(s string) *UserUpdate {
 	uu.mutation.SetTitle(s)
@@ -98,6 +145,13 @@ func (uu *UserUpdate) SetNillableTitle(s *string) *UserUpdate {
 	return uu
 }
 
+// UnsetTitle removes the changes of the title field from the builder (e.g. a previous call
+// to SetTitle), and therefore, the field is left unchanged in the database.
+func (uu *UserUpdate) UnsetTitle() *UserUpdate {
+	uu.mutation.ResetTitle()
+	return uu
+}
+
 // SetNewName sets the new_name field.
 func (uu *UserUpdate) SetNewName(s string) *UserUpdate {
 	uu.mutation.SetNewName(s)
@@ -118,6 +172,14 @@ func (uu *UserUpdate) ClearNewName() *UserUpdate {
 	return uu
 }
 
+// UnsetNewName removes the changes of the new_name field from the builder (e.g. a previous call
+// to SetNewName), and therefore, the field is left unchanged in the database. Unlike ClearNewName,
+// it does not set the field to NULL, and also removes a previous call to ClearNewName.
+func (uu *UserUpdate) UnsetNewName() *UserUpdate {
+	uu.mutation.ResetNewName()
+	return uu
+}
+
 // SetBlob sets the blob field.
 func (uu *UserUpdate) SetBlob(b []byte) *UserUpdate {
 	uu.mutation.SetBlob(b)
@@ -130,6 +192,14 @@ func (uu *UserUpdate) ClearBlob() *UserUpdate {
 	return uu
 }
 
+// UnsetBlob removes the changes of the blob field from the builder (e.g. a previous call
+// to SetBlob), and therefore, the field is left unchanged in the database. Unlike ClearBlob,
+// it does not set the field to NULL, and also removes a previous call to ClearBlob.
+func (uu *UserUpdate) UnsetBlob() *UserUpdate {
+	uu.mutation.ResetBlob()
+	return uu
+}
+
 // SetState sets the state field.
 func (uu *UserUpdate) SetState(u user.State) *UserUpdate {
 	uu.mutation.SetState(u)
@@ -150,6 +220,14 @@ func (uu *UserUpdate) ClearState() *UserUpdate {
 	return uu
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (uu *UserUpdate) UnsetState() *UserUpdate {
+	uu.mutation.ResetState()
+	return uu
+}
+
 // AddCarIDs adds the car edge to Car by ids.
 func (uu *UserUpdate) AddCarIDs(ids ...int) *UserUpdate {
 	uu.mutation.AddCarIDs(ids...)
@@ -205,14 +283,33 @@ func (uu *UserUpdate) ClearPets() *UserUpdate {
 	return uu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uu *UserUpdate) Unwrap() *UserUpdate {
+	tx, ok := uu.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: UserUpdate is not a transactional builder")
+	}
+	uu.config.driver = tx.drv
+	uu.mutation.driver = tx.drv
+	return uu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uu *UserUpdate) DebugFields() map[string]Value {
+	return debugFields(uu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := uu.mutation.State(); ok {
-		if err := user.StateValidator(v); err != nil {
-			return 0, fmt.Errorf("entv2: validator failed for field \"state\": %v", err)
-		}
+	if err := checkTx(uu.driver); err != nil {
+		return 0, err
+	}
+	if err := uu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -261,7 +358,21 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.State(); ok {
+		if err := user.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("entv2: validator failed for field \"state\": %v", err)}
+		}
+	}
+	if uu.mutation.conflictpets {
+		return &ValidationError{Name: "pets", err: errors.New("entv2: setting and clearing the unique edge \"pets\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = uu.withOperation(ctx, "User", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -279,6 +390,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := uu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if uu.ids != nil {
+		_spec.ScanIDs = uu.ids
+	}
 	if value, ok := uu.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -446,7 +563,7 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -457,11 +574,33 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the User entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (uu *UserUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	uu.ids = &ids
+	defer func() { uu.ids = nil }()
+	if _, err := uu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // UserUpdateOne is the builder for updating a single User entity.
 type UserUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *UserMutation
+	hooks      []Hook
+	mutation   *UserMutation
+	predicates []predicate.User
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.predicates = append(uuo.predicates, ps...)
+	return uuo
 }
 
 // SetAge sets the age field.
@@ -477,18 +616,39 @@ func (uuo *UserUpdateOne) AddAge(i int) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetAge() *UserUpdateOne {
+	uuo.mutation.ResetAge()
+	return uuo
+}
+
 // SetName sets the name field.
 func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
 	uuo.mutation.SetName(s)
 	return uuo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetName() *UserUpdateOne {
+	uuo.mutation.ResetName()
+	return uuo
+}
+
 // SetNickname sets the nickname field.
 func (uuo *UserUpdateOne) SetNickname(s string) *UserUpdateOne {
 	uuo.mutation.SetNickname(s)
 	return uuo
 }
 
+// UnsetNickname removes the changes of the nickname field from the builder (e.g. a previous call
+// to SetNickname), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetNickname() *UserUpdateOne {
+	uuo.mutation.ResetNickname()
+	return uuo
+}
+
 // SetPhone sets the phone field.
 func (uuo *UserUpdateOne) SetPhone(s string) *UserUpdateOne {
 	uuo.mutation.SetPhone(s)
@@ -503,6 +663,13 @@ func (uuo *UserUpdateOne) SetNillablePhone(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetPhone removes the changes of the phone field from the builder (e.g. a previous call
+// to SetPhone), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetPhone() *UserUpdateOne {
+	uuo.mutation.ResetPhone()
+	return uuo
+}
+
 // SetBuffer sets the buffer field.
 func (uuo *UserUpdateOne) SetBuffer(b []byte) *UserUpdateOne {
 	uuo.mutation.SetBuffer(b)
@@ -515,6 +682,14 @@ func (uuo *UserUpdateOne) ClearBuffer() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetBuffer removes the changes of the buffer field from the builder (e.g. a previous call
+// to SetBuffer), and therefore, the field is left unchanged in the database. Unlike ClearBuffer,
+// it does not set the field to NULL, and also removes a previous call to ClearBuffer.
+func (uuo *UserUpdateOne) UnsetBuffer() *UserUpdateOne {
+	uuo.mutation.ResetBuffer()
+	return uuo
+}
+
 // SetTitle sets the title field.
 func (uuo *UserUpdateOne) SetTitle(s string) *UserUpdateOne {
 	uuo.mutation.SetTitle(s)
@@ -529,6 +704,13 @@ func (uuo *UserUpdateOne) SetNillableTitle(s *string) *UserUpdateOne {
 	return uuo
 }
 
+// UnsetTitle removes the changes of the title field from the builder (e.g. a previous call
+// to SetTitle), and therefore, the field is left unchanged in the database.
+func (uuo *UserUpdateOne) UnsetTitle() *UserUpdateOne {
+	uuo.mutation.ResetTitle()
+	return uuo
+}
+
 // SetNewName sets the new_name field.
 func (uuo *UserUpdateOne) SetNewName(s string) *UserUpdateOne {
 	uuo.mutation.SetNewName(s)
@@ -549,6 +731,14 @@ func (uuo *UserUpdateOne) ClearNewName() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetNewName removes the changes of the new_name field from the builder (e.g. a previous call
+// to SetNewName), and therefore, the field is left unchanged in the database. Unlike ClearNewName,
+// it does not set the field to NULL, and also removes a previous call to ClearNewName.
+func (uuo *UserUpdateOne) UnsetNewName() *UserUpdateOne {
+	uuo.mutation.ResetNewName()
+	return uuo
+}
+
 // SetBlob sets the blob field.
 func (uuo *UserUpdateOne) SetBlob(b []byte) *UserUpdateOne {
 	uuo.mutation.SetBlob(b)
@@ -561,6 +751,14 @@ func (uuo *UserUpdateOne) ClearBlob() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetBlob removes the changes of the blob field from the builder (e.g. a previous call
+// to SetBlob), and therefore, the field is left unchanged in the database. Unlike ClearBlob,
+// it does not set the field to NULL, and also removes a previous call to ClearBlob.
+func (uuo *UserUpdateOne) UnsetBlob() *UserUpdateOne {
+	uuo.mutation.ResetBlob()
+	return uuo
+}
+
 // SetState sets the state field.
 func (uuo *UserUpdateOne) SetState(u user.State) *UserUpdateOne {
 	uuo.mutation.SetState(u)
@@ -581,6 +779,14 @@ func (uuo *UserUpdateOne) ClearState() *UserUpdateOne {
 	return uuo
 }
 
+// UnsetState removes the changes of the state field from the builder (e.g. a previous call
+// to SetState), and therefore, the field is left unchanged in the database. Unlike ClearState,
+// it does not set the field to NULL, and also removes a previous call to ClearState.
+func (uuo *UserUpdateOne) UnsetState() *UserUpdateOne {
+	uuo.mutation.ResetState()
+	return uuo
+}
+
 // AddCarIDs adds the car edge to Car by ids.
 func (uuo *UserUpdateOne) AddCarIDs(ids ...int) *UserUpdateOne {
 	uuo.mutation.AddCarIDs(ids...)
@@ -636,14 +842,33 @@ func (uuo *UserUpdateOne) ClearPets() *UserUpdateOne {
 	return uuo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (uuo *UserUpdateOne) Unwrap() *UserUpdateOne {
+	tx, ok := uuo.config.driver.(*txDriver)
+	if !ok {
+		panic("entv2: UserUpdateOne is not a transactional builder")
+	}
+	uuo.config.driver = tx.drv
+	uuo.mutation.driver = tx.drv
+	return uuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (uuo *UserUpdateOne) DebugFields() map[string]Value {
+	return debugFields(uuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	if v, ok := uuo.mutation.State(); ok {
-		if err := user.StateValidator(v); err != nil {
-			return nil, fmt.Errorf("entv2: validator failed for field \"state\": %v", err)
-		}
+	if err := checkTx(uuo.driver); err != nil {
+		return nil, err
+	}
+	if err := uuo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *User
@@ -692,7 +917,21 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.State(); ok {
+		if err := user.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf("entv2: validator failed for field \"state\": %v", err)}
+		}
+	}
+	if uuo.mutation.conflictpets {
+		return &ValidationError{Name: "pets", err: errors.New("entv2: setting and clearing the unique edge \"pets\" in the same mutation")}
+	}
+	return nil
+}
+
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
+	ctx = uuo.withOperation(ctx, "User", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   user.Table,
@@ -708,6 +947,13 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		return nil, fmt.Errorf("missing User.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := uuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := uuo.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -876,9 +1122,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	u = &User{config: uuo.config}
-	_spec.Assign = u.assignValues
-	_spec.ScanValues = u.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return u.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = u.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, uuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -886,5 +1134,37 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		u.ID = id
+		if value, ok := uuo.mutation.Age(); ok {
+			u.Age = value
+		}
+		if value, ok := uuo.mutation.Name(); ok {
+			u.Name = value
+		}
+		if value, ok := uuo.mutation.Nickname(); ok {
+			u.Nickname = value
+		}
+		if value, ok := uuo.mutation.Phone(); ok {
+			u.Phone = value
+		}
+		if value, ok := uuo.mutation.Buffer(); ok {
+			u.Buffer = value
+		}
+		if value, ok := uuo.mutation.Title(); ok {
+			u.Title = value
+		}
+		if value, ok := uuo.mutation.NewName(); ok {
+			u.NewName = value
+		}
+		if value, ok := uuo.mutation.Blob(); ok {
+			u.Blob = value
+		}
+		if value, ok := uuo.mutation.State(); ok {
+			u.State = value
+		}
+	}
 	return u, nil
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/schema"
 	"github.com/facebookincubator/ent/entc/integration/migrate/entv1"
 	migratev1 "github.com/facebookincubator/ent/entc/integration/migrate/entv1/migrate"
 	userv1 "github.com/facebookincubator/ent/entc/integration/migrate/entv1/user"
@@ -92,6 +93,7 @@ func TestSQLite(t *testing.T) {
 	require.NoError(t, err)
 	EqualFold(t, client)
 	ContainsFold(t, client)
+	MigrationHooks(t, drv, client)
 }
 
 func V1ToV2(t *testing.T, clientv1 *entv1.Client, clientv2 *entv2.Client) {
@@ -199,6 +201,26 @@ func ContainsFold(t *testing.T, client *entv2.Client) {
 	require.Equal(t, 1, client.User.Query().Where(user.NameContainsFold("Raki")).CountX(ctx))
 }
 
+// MigrationHooks tests the raw SQL hooks of the migration on SQLite.
+func MigrationHooks(t *testing.T, drv *sql.Driver, client *entv2.Client) {
+	ctx := context.Background()
+	hooks := []schema.MigrateOption{
+		migratev2.WithPreHook(dialect.MySQL, "INVALID MYSQL STATEMENT"),
+		migratev2.WithPreHook(dialect.SQLite, "CREATE TABLE IF NOT EXISTS `car_audits` (`car_id` integer NOT NULL)"),
+		migratev2.WithPostHook(dialect.SQLite, "CREATE TRIGGER IF NOT EXISTS `cars_audit` AFTER INSERT ON `cars` BEGIN INSERT INTO `car_audits` (`car_id`) VALUES (NEW.`id`); END"),
+	}
+	// Hooks are executed on every migration.
+	require.NoError(t, client.Schema.Create(ctx, hooks...))
+	require.NoError(t, client.Schema.Create(ctx, hooks...))
+	id := client.Car.Create().SaveX(ctx).ID
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(ctx, "SELECT `car_id` FROM `car_audits`", []interface{}{}, rows))
+	defer rows.Close()
+	var ids []int
+	require.NoError(t, sql.ScanSlice(rows, &ids))
+	require.Equal(t, []int{id}, ids)
+}
+
 func idRange(t *testing.T, id, l, h int) {
 	require.Truef(t, id > l && id < h, "id %s should be between %d to %d", id, l, h)
 }
@@ -8,10 +8,15 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/migrate"
+	"github.com/facebookincubator/ent/entc/integration/privacy/ent/predicate"
 
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/galaxy"
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/planet"
@@ -72,7 +77,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug, hooks: c.hooks}
+	tx.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = tx
 	return &Tx{
 		config: cfg,
 		Galaxy: NewGalaxyClient(cfg),
@@ -85,11 +92,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(*sql.Driver).BeginTx(ctx, opts)
+	drv, ok := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("ent: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: &txDriver{tx: tx, drv: c.driver}, log: c.log, debug: c.debug, hooks: c.hooks}
+	txd := &txDriver{tx: tx, drv: c.driver}
+	txd.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = txd
 	return &Tx{
 		config: cfg,
 		Galaxy: NewGalaxyClient(cfg),
@@ -97,6 +113,211 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+// WithTx runs fn in a transaction, which is committed if fn returns nil, and rolled back if fn returns an
+// error or panics (the panic is re-raised after the rollback). If the context holds a transaction (see
+// NewTxContext), or the client is transactional, fn runs in the existing transaction within a savepoint
+// instead. The savepoint is released if fn returns nil, and rolled back to otherwise, without affecting
+// the changes that were made in the transaction before it. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		if _, err := tx.Galaxy.Create().Save(ctx); err != nil {
+//			return err
+//		}
+//		return nil
+//	})
+//
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	tx := TxFromContext(ctx)
+	if _, ok := c.driver.(*txDriver); ok && tx == nil {
+		tx = &Tx{config: c.config}
+		tx.init()
+	}
+	if tx != nil {
+		return tx.withSavepoint(ctx, fn)
+	}
+	if tx, err = c.Tx(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// withSavepoint runs fn within a savepoint of the transaction. The functions that were registered
+// to run after commit by the mutations of fn are discarded if the savepoint is rolled back to.
+func (tx *Tx) withSavepoint(ctx context.Context, fn func(tx *Tx) error) error {
+	drv := tx.config.driver.(*txDriver)
+	drv.mu.Lock()
+	drv.savepoints++
+	name := fmt.Sprintf("ent_savepoint_%d", drv.savepoints)
+	committed := len(drv.afterCommit)
+	drv.mu.Unlock()
+	if err := drv.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: creating savepoint: %w", err)
+	}
+	rollback := func() error {
+		drv.mu.Lock()
+		drv.afterCommit = drv.afterCommit[:committed]
+		drv.mu.Unlock()
+		return drv.Exec(ctx, "ROLLBACK TO SAVEPOINT "+name, []interface{}{}, nil)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back to savepoint: %v", err, rerr)
+		}
+		return err
+	}
+	if err := drv.Exec(ctx, "RELEASE SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: releasing savepoint: %w", err)
+	}
+	return nil
+}
+
+// Purge deletes the entities that match the given predicates from their tables in one transaction,
+// and returns the number of deleted rows per type label. The predicates are keyed by the type label,
+// and types without a predicate are not deleted. The types are deleted in dependency order (types
+// that hold foreign-keys to other types first) using their delete builders, and therefore, their
+// hooks are executed as well. Any error (or a canceled context) rolls back the transaction. For example:
+//
+//	tenant := func(s *sql.Selector) {
+//		s.Where(sql.EQ(s.C("tenant_id"), id))
+//	}
+//	report, err := client.Purge(ctx, map[string]func(*sql.Selector){
+//		galaxy.Label: tenant,
+//		// ...
+//	})
+//
+// If the client is transactional, the entities are deleted in its transaction, and it is not committed.
+func (c *Client) Purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	for label := range preds {
+		switch label {
+		case planet.Label, galaxy.Label:
+		default:
+			return nil, fmt.Errorf("ent: unknown type label %q", label)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.purge(ctx, preds)
+	}
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := tx.Client().purge(ctx, preds)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%v: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// purge deletes the entities of the given predicates in dependency order.
+func (c *Client) purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	report := make(map[string]int, len(preds))
+	if p, ok := preds[planet.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Planet.Delete().Where(predicate.Planet(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging planet: %w", err)
+		}
+		report[planet.Label] = n
+	}
+	if p, ok := preds[galaxy.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Galaxy.Delete().Where(predicate.Galaxy(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging galaxy: %w", err)
+		}
+		report[galaxy.Label] = n
+	}
+	return report, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given entities, that can be of different
+// types (e.g. the results of a polymorphic query). The entities are grouped by their concrete type, and
+// the edge of each group is loaded using the LoadEdgeFor method of its client. Hence, the number of
+// queries depends on the number of types, and not on the number of entities. For example:
+//
+//	err := client.LoadEdgeFor(ctx, []ent.Value{card, user}, "owner")
+//
+// The entities must be pointers to the generated types, and the edge must be defined on all their types.
+func (c *Client) LoadEdgeFor(ctx context.Context, nodes []Value, edge string) error {
+	var (
+		galaxyNodes []*Galaxy
+		planetNodes []*Planet
+	)
+	for _, v := range nodes {
+		switch node := v.(type) {
+		case *Galaxy:
+			galaxyNodes = append(galaxyNodes, node)
+		case *Planet:
+			planetNodes = append(planetNodes, node)
+		default:
+			return fmt.Errorf("ent: unexpected entity type %T", v)
+		}
+	}
+	if len(galaxyNodes) > 0 {
+		if err := c.Galaxy.LoadEdgeFor(ctx, galaxyNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(planetNodes) > 0 {
+		if err := c.Planet.LoadEdgeFor(ctx, planetNodes, edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshMaterializedView replaces the contents of the materialized view with the given name by
+// re-executing its query (PostgreSQL only). If concurrently is true, the view is refreshed without
+// locking out concurrent reads from it, which requires a unique index on the view. For example:
+//
+//	err := client.RefreshMaterializedView(ctx, "card_stats", true)
+//
+// Read-only entities that query the view are defined using the View option in the schema config.
+func (c *Client) RefreshMaterializedView(ctx context.Context, name string, concurrently bool) error {
+	if d := c.driver.Dialect(); d != dialect.Postgres {
+		return fmt.Errorf("ent: materialized views are not supported by dialect %q", d)
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("REFRESH MATERIALIZED VIEW ")
+	if concurrently {
+		b.WriteString("CONCURRENTLY ")
+	}
+	query, args := b.Ident(name).Query()
+	if err := c.driver.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("ent: refreshing materialized view %q: %v", name, err)
+	}
+	return nil
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -108,7 +329,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true, hooks: c.hooks}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	client := &Client{config: cfg}
 	client.init()
 	return client
@@ -148,6 +371,330 @@ func (c *GalaxyClient) Create() *GalaxyCreate {
 	return &GalaxyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Galaxy entities.
+func (c *GalaxyClient) CreateBulk(builders ...*GalaxyCreate) *GalaxyCreateBulk {
+	return &GalaxyCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Galaxy builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Galaxy.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *GalaxyClient) CreateBulkFrom(ctx context.Context, ch <-chan *GalaxyCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Galaxy bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*GalaxyCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*GalaxyCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*GalaxyCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Galaxy that matches the given predicates, or creates it using
+// the given builder if there is no such Galaxy. The returned bool reports whether the
+// Galaxy was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Galaxy is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Galaxy.FindOrCreate(ctx, ps, client.Galaxy.Create())
+//
+func (c *GalaxyClient) FindOrCreate(ctx context.Context, ps []predicate.Galaxy, create *GalaxyCreate) (*Galaxy, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Galaxy.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Galaxy using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *GalaxyClient) findOrCreate(ctx context.Context, ps []predicate.Galaxy, create *GalaxyCreate) (*Galaxy, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the galaxies table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Galaxy columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Galaxy columns are rejected before the statement is executed.
+//
+//	n, err := client.Galaxy.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		galaxy.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *GalaxyClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return 0, fmt.Errorf("ent: CreateFromSelect does not support entities with privacy policy")
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Galaxy and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Galaxy.CopyToCreate(ga).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *GalaxyClient) CopyToCreate(ga *Galaxy) *GalaxyCreate {
+	create := c.Create()
+	create.SetName(ga.Name)
+	create.SetType(ga.Type)
+	return create
+}
+
+// Sync reconciles the Galaxy entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.Galaxy.Sync(ctx, builders, []string{galaxy.FieldName})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *GalaxyClient) Sync(ctx context.Context, desired []*GalaxyCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for Galaxy sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case galaxy.FieldName:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for Galaxy sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&GalaxyClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *GalaxyClient) sync(ctx context.Context, desired []*GalaxyCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.Galaxy, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in Galaxy builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: Galaxy builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Galaxy)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*GalaxyCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the Galaxy.
+func (ga *Galaxy) syncValue(field string) Value {
+	switch field {
+	case galaxy.FieldName:
+		return ga.Name
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// Galaxy. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (ga *Galaxy) syncChanged(mutation *GalaxyMutation) bool {
+	if v, ok := mutation.Name(); ok && (v != ga.Name) {
+		return true
+	}
+	if v, ok := mutation.GetType(); ok && (v != ga.Type) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for Galaxy.
 func (c *GalaxyClient) Update() *GalaxyUpdate {
 	mutation := newGalaxyMutation(c.config, OpUpdate)
@@ -166,6 +713,140 @@ func (c *GalaxyClient) UpdateOneID(id int) *GalaxyUpdateOne {
 	return &GalaxyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// GalaxyPatch holds the field changes of a Galaxy for UpdateByIDMap, keyed by the field
+// name (e.g. galaxy.FieldName). A nil value clears an optional field.
+type GalaxyPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Galaxy with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Galaxy.UpdateByIDMap(ctx, map[int]ent.GalaxyPatch{
+//		id1: {galaxy.FieldName: v1},
+//		id2: {galaxy.FieldName: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *GalaxyClient) UpdateByIDMap(ctx context.Context, m map[int]GalaxyPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *GalaxyClient) patchUpdates(m map[int]GalaxyPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newGalaxyMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Galaxy patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&GalaxyUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(galaxy.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, galaxy.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(galaxy.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Galaxy entities, and updates the original Galaxy only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Galaxy is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ga.Clone()
+//	modified.Field = value
+//	ga, err = client.Galaxy.UpdateFromDiff(ctx, ga, modified)
+//
+func (c *GalaxyClient) UpdateFromDiff(ctx context.Context, original, modified *Galaxy) (*Galaxy, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Galaxy UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Type != original.Type {
+		update.SetType(modified.Type)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Galaxy.
 func (c *GalaxyClient) Delete() *GalaxyDelete {
 	mutation := newGalaxyMutation(c.config, OpDelete)
@@ -204,6 +885,65 @@ func (c *GalaxyClient) GetX(ctx context.Context, id int) *Galaxy {
 	return ga
 }
 
+// GetMany returns the Galaxy entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *GalaxyClient) GetMany(ctx context.Context, ids ...int) ([]*Galaxy, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(galaxy.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Galaxy, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Galaxy, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *GalaxyClient) GetManyX(ctx context.Context, ids ...int) []*Galaxy {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Galaxy from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *GalaxyClient) FromMap(m map[string]string) (*Galaxy, error) {
+	ga := &Galaxy{config: c.config}
+	if err := kvDecode(m, galaxy.FieldID, &ga.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, galaxy.FieldName, &ga.Name, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[galaxy.FieldName]; ok {
+		if err := galaxy.NameValidator(ga.Name); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, galaxy.FieldType, &ga.Type, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[galaxy.FieldType]; ok {
+		if err := galaxy.TypeValidator(ga.Type); err != nil {
+			return nil, &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	return ga, nil
+}
+
 // QueryPlanets queries the planets edge of a Galaxy.
 func (c *GalaxyClient) QueryPlanets(ga *Galaxy) *PlanetQuery {
 	query := &PlanetQuery{config: c.config}
@@ -220,10 +960,85 @@ func (c *GalaxyClient) QueryPlanets(ga *Galaxy) *PlanetQuery {
 	return query
 }
 
+// LoadPlanetsFor loads the "planets" edge of all the given Galaxy entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithPlanets for galaxies that were already loaded.
+func (c *GalaxyClient) LoadPlanetsFor(ctx context.Context, nodes []*Galaxy, opts ...func(*PlanetQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	gq := c.Query().WithPlanets(opts...)
+	for _, node := range nodes {
+		node.Edges.Planets = nil
+	}
+
+	if query := gq.withPlanets; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*Galaxy)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = galaxy.PlanetsColumn
+		err := gq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Planet(func(s *sql.Selector) {
+				s.Where(sql.InValues(galaxy.PlanetsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.galaxy_planets
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "galaxy_planets" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "galaxy_planets" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Planets = append(node.Edges.Planets, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Galaxy entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *GalaxyClient) LoadEdgeFor(ctx context.Context, nodes []*Galaxy, edge string) error {
+	switch edge {
+	case galaxy.EdgePlanets:
+		return c.LoadPlanetsFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown Galaxy edge %q", edge)
+	}
+}
+
 // Hooks returns the client hooks.
 func (c *GalaxyClient) Hooks() []Hook {
 	hooks := c.hooks.Galaxy
-	return append(hooks[:len(hooks):len(hooks)], galaxy.Hooks[:]...)
+	hooks = append(hooks[:len(hooks):len(hooks)], galaxy.Hooks[:]...)
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(galaxy.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // PlanetClient is a client for the Planet schema.
@@ -248,6 +1063,330 @@ func (c *PlanetClient) Create() *PlanetCreate {
 	return &PlanetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Planet entities.
+func (c *PlanetClient) CreateBulk(builders ...*PlanetCreate) *PlanetCreateBulk {
+	return &PlanetCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Planet builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Planet.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *PlanetClient) CreateBulkFrom(ctx context.Context, ch <-chan *PlanetCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Planet bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*PlanetCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*PlanetCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*PlanetCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Planet that matches the given predicates, or creates it using
+// the given builder if there is no such Planet. The returned bool reports whether the
+// Planet was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Planet is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Planet.FindOrCreate(ctx, ps, client.Planet.Create())
+//
+func (c *PlanetClient) FindOrCreate(ctx context.Context, ps []predicate.Planet, create *PlanetCreate) (*Planet, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Planet.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Planet using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *PlanetClient) findOrCreate(ctx context.Context, ps []predicate.Planet, create *PlanetCreate) (*Planet, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the planets table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Planet columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Planet columns are rejected before the statement is executed.
+//
+//	n, err := client.Planet.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		planet.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *PlanetClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return 0, fmt.Errorf("ent: CreateFromSelect does not support entities with privacy policy")
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Planet and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.Planet.CopyToCreate(pl).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *PlanetClient) CopyToCreate(pl *Planet) *PlanetCreate {
+	create := c.Create()
+	create.SetName(pl.Name)
+	if pl.Age != 0 {
+		create.SetAge(pl.Age)
+	}
+	return create
+}
+
+// Sync reconciles the Planet entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.Planet.Sync(ctx, builders, []string{planet.FieldName})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *PlanetClient) Sync(ctx context.Context, desired []*PlanetCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for Planet sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case planet.FieldName:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for Planet sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&PlanetClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *PlanetClient) sync(ctx context.Context, desired []*PlanetCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.Planet, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in Planet builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: Planet builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Planet)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*PlanetCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the Planet.
+func (pl *Planet) syncValue(field string) Value {
+	switch field {
+	case planet.FieldName:
+		return pl.Name
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// Planet. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (pl *Planet) syncChanged(mutation *PlanetMutation) bool {
+	if v, ok := mutation.Age(); ok && (v != pl.Age) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for Planet.
 func (c *PlanetClient) Update() *PlanetUpdate {
 	mutation := newPlanetMutation(c.config, OpUpdate)
@@ -266,6 +1405,140 @@ func (c *PlanetClient) UpdateOneID(id int) *PlanetUpdateOne {
 	return &PlanetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// PlanetPatch holds the field changes of a Planet for UpdateByIDMap, keyed by the field
+// name (e.g. planet.FieldAge). A nil value clears an optional field.
+type PlanetPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Planet with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Planet.UpdateByIDMap(ctx, map[int]ent.PlanetPatch{
+//		id1: {planet.FieldAge: v1},
+//		id2: {planet.FieldAge: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *PlanetClient) UpdateByIDMap(ctx context.Context, m map[int]PlanetPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *PlanetClient) patchUpdates(m map[int]PlanetPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newPlanetMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			switch name {
+			case planet.FieldName:
+				return nil, fmt.Errorf("ent: immutable field %q in Planet patch of id %v", name, id)
+			}
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Planet patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&PlanetUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(planet.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, planet.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(planet.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Planet entities, and updates the original Planet only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Planet is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := pl.Clone()
+//	modified.Field = value
+//	pl, err = client.Planet.UpdateFromDiff(ctx, pl, modified)
+//
+func (c *PlanetClient) UpdateFromDiff(ctx context.Context, original, modified *Planet) (*Planet, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Planet UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Age != original.Age {
+		update.SetAge(modified.Age)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Planet.
 func (c *PlanetClient) Delete() *PlanetDelete {
 	mutation := newPlanetMutation(c.config, OpDelete)
@@ -304,6 +1577,60 @@ func (c *PlanetClient) GetX(ctx context.Context, id int) *Planet {
 	return pl
 }
 
+// GetMany returns the Planet entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *PlanetClient) GetMany(ctx context.Context, ids ...int) ([]*Planet, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(planet.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Planet, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Planet, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *PlanetClient) GetManyX(ctx context.Context, ids ...int) []*Planet {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Planet from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *PlanetClient) FromMap(m map[string]string) (*Planet, error) {
+	pl := &Planet{config: c.config}
+	if err := kvDecode(m, planet.FieldID, &pl.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, planet.FieldName, &pl.Name, true); err != nil {
+		return nil, err
+	}
+	if _, ok := m[planet.FieldName]; ok {
+		if err := planet.NameValidator(pl.Name); err != nil {
+			return nil, &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if err := kvDecode(m, planet.FieldAge, &pl.Age, false); err != nil {
+		return nil, err
+	}
+	return pl, nil
+}
+
 // QueryNeighbors queries the neighbors edge of a Planet.
 func (c *PlanetClient) QueryNeighbors(pl *Planet) *PlanetQuery {
 	query := &PlanetQuery{config: c.config}
@@ -320,8 +1647,150 @@ func (c *PlanetClient) QueryNeighbors(pl *Planet) *PlanetQuery {
 	return query
 }
 
+// LoadNeighborsFor loads the "neighbors" edge of all the given Planet entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithNeighbors for planets that were already loaded.
+func (c *PlanetClient) LoadNeighborsFor(ctx context.Context, nodes []*Planet, opts ...func(*PlanetQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	pq := c.Query().WithNeighbors(opts...)
+	for _, node := range nodes {
+		node.Edges.Neighbors = nil
+	}
+
+	if query := pq.withNeighbors; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		ids := make(map[int]*Planet, len(nodes))
+		for _, node := range nodes {
+			ids[node.ID] = node
+			fks = append(fks, node.ID)
+		}
+		var (
+			edgeids []int
+			edges   = make(map[int][]*Planet)
+		)
+		_spec := &sqlgraph.EdgeQuerySpec{
+			Edge: &sqlgraph.EdgeSpec{
+				Inverse: false,
+				Table:   planet.NeighborsTable,
+				Columns: planet.NeighborsPrimaryKey,
+			},
+
+			ScanValues: func() [2]interface{} {
+				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
+			},
+			Assign: func(out, in interface{}) error {
+				eout, ok := out.(*sql.NullInt64)
+				if !ok || eout == nil {
+					return fmt.Errorf("unexpected id value for edge-out")
+				}
+				ein, ok := in.(*sql.NullInt64)
+				if !ok || ein == nil {
+					return fmt.Errorf("unexpected id value for edge-in")
+				}
+				outValue := int(eout.Int64)
+				inValue := int(ein.Int64)
+				node, ok := ids[outValue]
+				if !ok {
+					return fmt.Errorf("unexpected node id in edges: %v", outValue)
+				}
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
+				edges[inValue] = append(edges[inValue], node)
+				return nil
+			},
+		}
+		err := pq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(planet.NeighborsPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, pq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "neighbors": %v`, err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && pq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "neighbors" cannot be applied on %d neighbors with batch size %d`, len(edgeids), pq.eagerLoadSize())
+			return err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = pq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], planet.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "neighbors" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Neighbors = append(nodes[i].Edges.Neighbors, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Neighbors
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Neighbors = edges
+			}
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given Planet entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *PlanetClient) LoadEdgeFor(ctx context.Context, nodes []*Planet, edge string) error {
+	switch edge {
+	case planet.EdgeNeighbors:
+		return c.LoadNeighborsFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown Planet edge %q", edge)
+	}
+}
+
 // Hooks returns the client hooks.
 func (c *PlanetClient) Hooks() []Hook {
 	hooks := c.hooks.Planet
-	return append(hooks[:len(hooks):len(hooks)], planet.Hooks[:]...)
+	hooks = append(hooks[:len(hooks):len(hooks)], planet.Hooks[:]...)
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(planet.Label)}, hooks...)
+	}
+	return hooks
 }
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -26,6 +30,9 @@ type Galaxy struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GalaxyQuery when eager-loading is set.
 	Edges GalaxyEdges `json:"edges"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // GalaxyEdges holds the relations/edges for other nodes in the graph.
@@ -46,45 +53,161 @@ func (e GalaxyEdges) PlanetsOrErr() ([]*Planet, error) {
 	return nil, &NotLoadedError{edge: "planets"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Galaxy) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // name
-		&sql.NullString{}, // type
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Galaxy type are scanned as generic values.
+func (*Galaxy) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case galaxy.FieldID:
+			values[i] = &sql.NullInt64{}
+		case galaxy.FieldName:
+			values[i] = &sql.NullString{}
+		case galaxy.FieldType:
+			values[i] = &sql.NullString{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Galaxy fields.
-func (ga *Galaxy) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(galaxy.Columns); m < n {
+// to the Galaxy fields. Values of columns that were not selected are left untouched.
+func (ga *Galaxy) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	ga.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[0])
-	} else if value.Valid {
-		ga.Name = value.String
-	}
-	if value, ok := values[1].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field type", values[1])
-	} else if value.Valid {
-		ga.Type = galaxy.Type(value.String)
+	for i := range columns {
+		switch columns[i] {
+		case galaxy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ga.ID = int(value.Int64)
+		case galaxy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ga.Name = value.String
+			}
+		case galaxy.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ga.Type = galaxy.Type(value.String)
+			}
+		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (ga *Galaxy) SelectValue(alias string) (Value, error) {
+	v, ok := ga.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryPlanets queries the planets edge of the Galaxy.
 func (ga *Galaxy) QueryPlanets() *PlanetQuery {
 	return (&GalaxyClient{config: ga.config}).QueryPlanets(ga)
 }
 
+// LoadEdges loads the given edges of the Galaxy using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see galaxy.Edges), and in this case, no edge is loaded.
+//
+//	err := ga.LoadEdges(ctx, client, galaxy.EdgePlanets)
+//
+func (ga *Galaxy) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadGalaxyEdges(ctx, client, []*Galaxy{ga}, edges)
+}
+
+// loadGalaxyEdges loads the given edges of all the given Galaxy entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using GalaxyClient.LoadEdgeFor.
+func loadGalaxyEdges(ctx context.Context, client *Client, nodes []*Galaxy, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case galaxy.EdgePlanets:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Galaxy", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Galaxy.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Galaxy and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Galaxy alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Galaxy itself is not modified.
+//
+//	b, err := ga.DumpGraph(ctx, client, 2, galaxy.EdgePlanets)
+//
+func (ga *Galaxy) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Galaxy DumpGraph", depth)
+	}
+	dumped := *ga
+	dumped.Edges = GalaxyEdges{}
+	if err := dumpGalaxies(ctx, client, []*Galaxy{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpGalaxies loads the edges of the given Galaxy copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpGalaxies(ctx context.Context, client *Client, nodes []*Galaxy, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Galaxy
+	for _, n := range nodes {
+		if dumper.visit(galaxy.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range galaxy.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadGalaxyEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextPlanets []*Planet
+	for _, n := range visit {
+		for i, e := range n.Edges.Planets {
+			c := *e
+			c.Edges = PlanetEdges{}
+			n.Edges.Planets[i] = &c
+			nextPlanets = append(nextPlanets, &c)
+		}
+	}
+	if err := dumpPlanets(ctx, client, nextPlanets, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Galaxy.
 // Note that, you need to call Galaxy.Unwrap() before calling this method, if this Galaxy
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -103,6 +226,32 @@ func (ga *Galaxy) Unwrap() *Galaxy {
 	return ga
 }
 
+// Clone returns a deep copy of the Galaxy and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (ga *Galaxy) Clone() *Galaxy {
+	return ga.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Galaxy and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (ga *Galaxy) clone(seen map[interface{}]interface{}) *Galaxy {
+	if ga == nil {
+		return nil
+	}
+	if v, ok := seen[ga]; ok {
+		return v.(*Galaxy)
+	}
+	_c := *ga
+	seen[ga] = &_c
+	if ga.Edges.Planets != nil {
+		_c.Edges.Planets = make([]*Planet, len(ga.Edges.Planets))
+		for _i := range ga.Edges.Planets {
+			_c.Edges.Planets[_i] = ga.Edges.Planets[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (ga *Galaxy) String() string {
 	var builder strings.Builder
@@ -116,6 +265,28 @@ func (ga *Galaxy) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Galaxy, for change detection and cache keys.
+// It covers the fields that are listed in galaxy.FingerprintFields, and it does not depend on the
+// ID of the Galaxy, on its edges, or on the order of the fields in the schema.
+func (ga *Galaxy) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", ga.Name)
+	fingerprint(h, "type", ga.Type)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Galaxy for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Galaxy can be decoded using the FromMap method of its client.
+func (ga *Galaxy) ToMap() map[string]string {
+	m := make(map[string]string, 3)
+	m[galaxy.FieldID] = kvEncode(ga.ID)
+	m[galaxy.FieldName] = kvEncode(ga.Name)
+	m[galaxy.FieldType] = kvEncode(ga.Type)
+	return m
+}
+
 // Galaxies is a parsable slice of Galaxy.
 type Galaxies []*Galaxy
 
@@ -34,6 +34,18 @@ const (
 	PlanetsColumn = "galaxy_planets"
 )
 
+// Edges holds all the edge names of the Galaxy type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgePlanets,
+}
+
+// FingerprintFields holds the fields of the Galaxy type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+	FieldType,
+}
+
 // Columns holds all SQL columns for galaxy fields.
 var Columns = []string{
 	FieldID,
@@ -41,6 +53,16 @@ var Columns = []string{
 	FieldType,
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 // Note that the variables below are initialized by the runtime
 // package on the initialization of the application. Therefore,
 // it should be imported in the main as follows:
@@ -7,6 +7,8 @@
 package galaxy
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/predicate"
@@ -185,6 +187,13 @@ func NameContains(v string) predicate.Galaxy {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Galaxy {
+	return predicate.Galaxy(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Galaxy {
 	return predicate.Galaxy(func(s *sql.Selector) {
@@ -192,6 +201,13 @@ func NameHasPrefix(v string) predicate.Galaxy {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Galaxy {
+	return predicate.Galaxy(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Galaxy {
 	return predicate.Galaxy(func(s *sql.Selector) {
@@ -199,6 +215,13 @@ func NameHasSuffix(v string) predicate.Galaxy {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Galaxy {
+	return predicate.Galaxy(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.Galaxy {
 	return predicate.Galaxy(func(s *sql.Selector) {
@@ -213,6 +236,20 @@ func NameContainsFold(v string) predicate.Galaxy {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Galaxy {
+	return predicate.Galaxy(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.Galaxy {
+	return predicate.Galaxy(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
 // TypeEQ applies the EQ predicate on the "type" field.
 func TypeEQ(v Type) predicate.Galaxy {
 	return predicate.Galaxy(func(s *sql.Selector) {
@@ -261,6 +298,67 @@ func TypeNotIn(vs ...Type) predicate.Galaxy {
 	})
 }
 
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:   "numeric",
+	FieldName: "string",
+	FieldType: "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Galaxy.
+func FieldsEQ(f1, f2 string) predicate.Galaxy {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Galaxy.
+func FieldsNEQ(f1, f2 string) predicate.Galaxy {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Galaxy.
+//
+//	galaxy.FieldsLT(galaxy.FieldName, galaxy.FieldType)
+//
+func FieldsLT(f1, f2 string) predicate.Galaxy {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Galaxy.
+func FieldsLTE(f1, f2 string) predicate.Galaxy {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Galaxy.
+func FieldsGT(f1, f2 string) predicate.Galaxy {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Galaxy.
+func FieldsGTE(f1, f2 string) predicate.Galaxy {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Galaxy using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Galaxy {
+	return predicate.Galaxy(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Galaxy", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Galaxy", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Galaxy are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasPlanets applies the HasEdge predicate on the "planets" edge.
 func HasPlanets() predicate.Galaxy {
 	return predicate.Galaxy(func(s *sql.Selector) {
@@ -296,6 +394,9 @@ func And(predicates ...predicate.Galaxy) predicate.Galaxy {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -310,6 +411,9 @@ func Or(predicates ...predicate.Galaxy) predicate.Galaxy {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -320,3 +424,37 @@ func Not(p predicate.Galaxy) predicate.Galaxy {
 		p(s.Not())
 	})
 }
+
+// GalaxyFilter holds optional filters on the Galaxy fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type GalaxyFilter struct {
+	IDIn []int
+	Name *string
+	Type *Type
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Galaxy.Query().
+//		Where(galaxy.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *GalaxyFilter) []predicate.Galaxy {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Galaxy
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.Type != nil {
+		ps = append(ps, TypeEQ(*f.Type))
+	}
+	return ps
+}
@@ -10,7 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/galaxy"
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/planet"
@@ -53,21 +56,12 @@ func (gc *GalaxyCreate) AddPlanets(p ...*Planet) *GalaxyCreate {
 
 // Save creates the Galaxy in the database.
 func (gc *GalaxyCreate) Save(ctx context.Context) (*Galaxy, error) {
-	if _, ok := gc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
-	}
-	if v, ok := gc.mutation.Name(); ok {
-		if err := galaxy.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
-	}
-	if _, ok := gc.mutation.GetType(); !ok {
-		return nil, errors.New("ent: missing required field \"type\"")
+	if err := checkTx(gc.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := gc.mutation.GetType(); ok {
-		if err := galaxy.TypeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
+	gc.defaults()
+	if err := gc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -104,17 +98,128 @@ func (gc *GalaxyCreate) SaveX(ctx context.Context) *Galaxy {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gc *GalaxyCreate) Unwrap() *GalaxyCreate {
+	tx, ok := gc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GalaxyCreate is not a transactional builder")
+	}
+	gc.config.driver = tx.drv
+	gc.mutation.driver = tx.drv
+	return gc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (gc *GalaxyCreate) DebugFields() map[string]Value {
+	return debugFields(gc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (gc *GalaxyCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (gc *GalaxyCreate) check() error {
+	if _, ok := gc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := gc.mutation.Name(); ok {
+		if err := galaxy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if _, ok := gc.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New("ent: missing required field \"type\"")}
+	}
+	if v, ok := gc.mutation.GetType(); ok {
+		if err := galaxy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (gc *GalaxyCreate) sqlSave(ctx context.Context) (*Galaxy, error) {
-	var (
-		ga    = &Galaxy{config: gc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: galaxy.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: galaxy.FieldID,
-			},
+	ctx = gc.withOperation(ctx, "Galaxy", "Create")
+	ga, _spec := gc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, gc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	ga.ID = int(id)
+	return ga, nil
+}
+
+// SaveID creates the Galaxy in the database and returns only its id. Unlike Save,
+// the Galaxy entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Galaxy that holds only its id as the value that is returned by the next mutator.
+func (gc *GalaxyCreate) SaveID(ctx context.Context) (id int, err error) {
+	gc.defaults()
+	if err = gc.check(); err != nil {
+		return id, err
+	}
+	if len(gc.hooks) == 0 {
+		return gc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*GalaxyMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		gc.mutation = mutation
+		if id, err = gc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Galaxy{config: gc.config, ID: id}, nil
+	})
+	for i := len(gc.hooks) - 1; i >= 0; i-- {
+		mut = gc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, gc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (gc *GalaxyCreate) SaveIDX(ctx context.Context) int {
+	id, err := gc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (gc *GalaxyCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = gc.withOperation(ctx, "Galaxy", "Create")
+	_spec := gc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, gc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (gc *GalaxyCreate) createSpec() (*Galaxy, *sqlgraph.CreateSpec) {
+	ga := &Galaxy{config: gc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: galaxy.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: galaxy.FieldID,
+		},
+	}
 	if value, ok := gc.mutation.Name(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -150,13 +255,620 @@ func (gc *GalaxyCreate) sqlSave(ctx context.Context) (*Galaxy, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, gc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return ga, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (gc *GalaxyCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: galaxy.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: galaxy.FieldID,
+		},
+	}
+	if value, ok := gc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: galaxy.FieldName,
+		})
+	}
+	if value, ok := gc.mutation.GetType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: galaxy.FieldType,
+		})
+	}
+	if nodes := gc.mutation.PlanetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   galaxy.PlanetsTable,
+			Columns: []string{galaxy.PlanetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: planet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// GalaxyCreateBulk is the builder for creating a bulk of Galaxy entities.
+type GalaxyCreateBulk struct {
+	config
+	builders []*GalaxyCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Galaxy entities in the database.
+func (gcb *GalaxyCreateBulk) Save(ctx context.Context) ([]*Galaxy, error) {
+	nodes, err := gcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && gcb.retries > 0 && isSQLDeadlockError(err) {
+		return gcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gcb *GalaxyCreateBulk) SaveX(ctx context.Context) []*Galaxy {
+	v, err := gcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (gcb *GalaxyCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range gcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (gcb *GalaxyCreateBulk) CheckRefs() *GalaxyCreateBulk {
+	gcb.refs = true
+	return gcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (gcb *GalaxyCreateBulk) RetryOnDeadlock(max int) *GalaxyCreateBulk {
+	gcb.retries = max
+	return gcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Galaxy.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Galaxy.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (gcb *GalaxyCreateBulk) OnConflict(columns ...string) *GalaxyUpsertBulk {
+	return &GalaxyUpsertBulk{create: gcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Galaxy.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (gc *GalaxyCreate) OnConflict(target ...sql.ConflictOption) *GalaxyUpsertBulk {
+	bulk := &GalaxyCreateBulk{config: gc.config, builders: []*GalaxyCreate{gc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (gcb *GalaxyCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Galaxy, error) {
+	ctx = gcb.withOperation(ctx, "Galaxy", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(gcb.builders))
+		nodes    = make([]*Galaxy, len(gcb.builders))
+		mutators = make([]Mutator, len(gcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range gcb.builders {
+		func(i int, root context.Context) {
+			builder := gcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*GalaxyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if gcb.refs {
+						if err := gcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, gcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, gcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (gcb *GalaxyCreateBulk) retry(ctx context.Context, err error) ([]*Galaxy, error) {
+	if _, ok := gcb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	ga.ID = int(id)
-	return ga, nil
+	builders := gcb.builders
+	defer func() { gcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return gcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	gcb.builders = make([]*GalaxyCreate, len(idx))
+	for i, j := range idx {
+		gcb.builders[i] = builders[j]
+	}
+	for n := 0; n < gcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Galaxy
+		if sorted, err = gcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Galaxy, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (gcb *GalaxyCreateBulk) less(a, b *GalaxyCreate) bool {
+	{
+		x, _ := a.mutation.Name()
+		y, _ := b.mutation.Name()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (gcb *GalaxyCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range gcb.builders {
+			for _, id := range builder.mutation.PlanetsIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, gcb.driver, planet.Table, planet.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[galaxy.EdgePlanets] = append(missing[galaxy.EdgePlanets], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Galaxy", Missing: missing}
+	}
+	return nil
+}
+
+// GalaxyUpsertBulk is the builder for the conflict handling of a bulk of Galaxy entities.
+type GalaxyUpsertBulk struct {
+	create  *GalaxyCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (gub *GalaxyUpsertBulk) Target(target ...sql.ConflictOption) *GalaxyUpsertBulk {
+	gub.target = append(gub.target, target...)
+	return gub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (gub *GalaxyUpsertBulk) DoNothing() *GalaxyUpsertBulk {
+	gub.nothing = true
+	return gub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (gub *GalaxyUpsertBulk) UpdateNewValues() *GalaxyUpsertBulk {
+	gub.update = true
+	return gub
+}
+
+// Save creates the Galaxy entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (gub *GalaxyUpsertBulk) Save(ctx context.Context) ([]*Galaxy, int, error) {
+	switch {
+	case !gub.nothing && !gub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Galaxy bulk insert")
+	case gub.nothing && gub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Galaxy bulk insert")
+	case gub.update:
+		if len(gub.columns) == 0 && len(gub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Galaxy bulk upsert")
+		}
+		nodes, err := gub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(gub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(galaxy.FieldID),
+			),
+			Columns:         galaxy.Columns,
+			ConflictColumns: gub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(gub.conflictTarget(), sql.DoNothing())}
+	nodes, err := gub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Galaxy, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (gub *GalaxyUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(gub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(gub.columns...))
+	}
+	return append(opts, gub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gub *GalaxyUpsertBulk) SaveX(ctx context.Context) ([]*Galaxy, int) {
+	nodes, skipped, err := gub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (gub *GalaxyUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := gub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gub *GalaxyUpsertBulk) ExecX(ctx context.Context) {
+	if err := gub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored Galaxy entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.Galaxy.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *Galaxy) *Galaxy {
+//			// ...
+//			return existing
+//		}, galaxy.FieldName).
+//		Save(ctx)
+//
+func (gcb *GalaxyCreateBulk) OnConflictMerge(merge func(existing, incoming *Galaxy) *Galaxy, keyFields ...string) *GalaxyMergeBulk {
+	return &GalaxyMergeBulk{create: gcb, merge: merge, keyFields: keyFields}
+}
+
+// GalaxyMergeBulk is the builder for merging a bulk of Galaxy entities with the stored entities in Go.
+type GalaxyMergeBulk struct {
+	create    *GalaxyCreateBulk
+	merge     func(existing, incoming *Galaxy) *Galaxy
+	keyFields []string
+}
+
+// Save creates or merges the Galaxy entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (gmb *GalaxyMergeBulk) Save(ctx context.Context) ([]*Galaxy, error) {
+	if gmb.merge == nil {
+		return nil, errors.New("ent: missing merge function for Galaxy bulk merge")
+	}
+	if len(gmb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for Galaxy bulk merge")
+	}
+	for _, f := range gmb.keyFields {
+		switch f {
+		case galaxy.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for Galaxy bulk merge", f)
+		}
+	}
+	drv := gmb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return gmb.save(ctx, gmb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
+		return nil, err
+	}
+	cfg := gmb.create.config
+	cfg.driver = tx
+	nodes, err := gmb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (gmb *GalaxyMergeBulk) save(ctx context.Context, cfg config) ([]*Galaxy, error) {
+	var (
+		client   = &GalaxyClient{config: cfg}
+		builders = gmb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *GalaxyCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(gmb.keyFields))
+		for j, f := range gmb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in Galaxy builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: Galaxy builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Galaxy)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, gmb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(gmb.keyFields))
+			for j, f := range gmb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*Galaxy, len(builders))
+		inserts []*GalaxyCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := gmb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (gmb *GalaxyMergeBulk) SaveX(ctx context.Context) []*Galaxy {
+	nodes, err := gmb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (gmb *GalaxyMergeBulk) Exec(ctx context.Context) error {
+	_, err := gmb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (gmb *GalaxyMergeBulk) ExecX(ctx context.Context) {
+	if err := gmb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -31,6 +34,18 @@ type GalaxyQuery struct {
 	predicates []predicate.Galaxy
 	// eager-loading edges.
 	withPlanets *PlanetQuery
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see GalaxySelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -42,6 +57,19 @@ func (gq *GalaxyQuery) Where(ps ...predicate.Galaxy) *GalaxyQuery {
 	return gq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (gq *GalaxyQuery) Predicates() []predicate.Galaxy {
+	return append([]predicate.Galaxy{}, gq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (gq *GalaxyQuery) SetPredicates(ps ...predicate.Galaxy) *GalaxyQuery {
+	gq.predicates = append([]predicate.Galaxy{}, ps...)
+	return gq
+}
+
 // Limit adds a limit step to the query.
 func (gq *GalaxyQuery) Limit(limit int) *GalaxyQuery {
 	gq.limit = &limit
@@ -310,11 +338,15 @@ func (gq *GalaxyQuery) GroupBy(field string, fields ...string) *GalaxyGroupBy {
 func (gq *GalaxyQuery) Select(field string, fields ...string) *GalaxySelect {
 	selector := &GalaxySelect{config: gq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = gq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := gq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return gq.sqlQuery(), nil
+		if err := gq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return gq.sqlQuery().AppendSelectExpr(gq.exprs...), nil
 	}
 	return selector
 }
@@ -334,6 +366,15 @@ func (gq *GalaxyQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (gq *GalaxyQuery) sqlAll(ctx context.Context) ([]*Galaxy, error) {
+	ctx = gq.withOperation(ctx, "Galaxy", "Query")
+	if err := gq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range gq.fields {
+		if !galaxy.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*Galaxy{}
 		_spec       = gq.querySpec()
@@ -341,10 +382,15 @@ func (gq *GalaxyQuery) sqlAll(ctx context.Context) ([]*Galaxy, error) {
 			gq.withPlanets != nil,
 		}
 	)
+	_spec.Exprs = gq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Galaxy{config: gq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
+		values := node.scanValues(columns)
+		for range gq.exprs {
+			values = append(values, new(interface{}))
+		}
 		return values
 	}
 	_spec.Assign = func(values ...interface{}) error {
@@ -352,8 +398,16 @@ func (gq *GalaxyQuery) sqlAll(ctx context.Context) ([]*Galaxy, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(gq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range gq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
 		return nil, err
@@ -363,6 +417,9 @@ func (gq *GalaxyQuery) sqlAll(ctx context.Context) ([]*Galaxy, error) {
 	}
 
 	if query := gq.withPlanets; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		nodeids := make(map[int]*Galaxy)
 		for i := range nodes {
@@ -370,30 +427,73 @@ func (gq *GalaxyQuery) sqlAll(ctx context.Context) ([]*Galaxy, error) {
 			nodeids[nodes[i].ID] = nodes[i]
 		}
 		query.withFKs = true
-		query.Where(predicate.Planet(func(s *sql.Selector) {
-			s.Where(sql.InValues(galaxy.PlanetsColumn, fks...))
-		}))
-		neighbors, err := query.All(ctx)
-		if err != nil {
-			return nil, err
-		}
-		for _, n := range neighbors {
-			fk := n.galaxy_planets
-			if fk == nil {
-				return nil, fmt.Errorf(`foreign-key "galaxy_planets" is nil for node %v`, n.ID)
+		// The limit and the offset of the query are applied per node.
+		query.partition = galaxy.PlanetsColumn
+		err := gq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Planet(func(s *sql.Selector) {
+				s.Where(sql.InValues(galaxy.PlanetsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			node, ok := nodeids[*fk]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected foreign-key "galaxy_planets" returned %v for node %v`, *fk, n.ID)
+			for _, n := range neighbors {
+				fk := n.galaxy_planets
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "galaxy_planets" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "galaxy_planets" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Planets = append(node.Edges.Planets, n)
 			}
-			node.Edges.Planets = append(node.Edges.Planets, n)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Galaxy entities (see Galaxy.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Galaxy.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Galaxy table.
+func (gq *GalaxyQuery) SelectExpr(exprs ...*sql.SelectExpr) *GalaxyQuery {
+	gq.exprs = append(gq.exprs, exprs...)
+	return gq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (gq *GalaxyQuery) checkExprs() error {
+	if len(gq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range galaxy.Columns {
+		columns[c] = true
+	}
+	for _, e := range gq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Galaxy", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (gq *GalaxyQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = gq.withOperation(ctx, "Galaxy", "Count")
 	_spec := gq.querySpec()
 	return sqlgraph.CountNodes(ctx, gq.driver, _spec)
 }
@@ -419,6 +519,17 @@ func (gq *GalaxyQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   gq.sql,
 		Unique: true,
 	}
+	if fields := gq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, galaxy.FieldID)
+		for i := range fields {
+			if fields[i] != galaxy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = gq.forUpdate
+	_spec.PartitionBy = gq.partition
 	if ps := gq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -467,11 +578,420 @@ func (gq *GalaxyQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Galaxy entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (gq *GalaxyQuery) IDsQuery() *sql.Selector {
+	selector := gq.sqlQuery()
+	if gq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	selector.AddError(fmt.Errorf("ent: IDsQuery does not support entities with privacy policy"))
+	return selector.Select(selector.C(galaxy.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Galaxy entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Galaxy.Query().
+//		Where(...).
+//		CountDistinct(ctx, galaxy.FieldName)
+//
+func (gq *GalaxyQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = gq.withOperation(ctx, "Galaxy", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, gq.driver, gq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (gq *GalaxyQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := gq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Galaxy rows as generic maps that are keyed by
+// column name (see galaxy.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (gq *GalaxyQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := gq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = gq.withOperation(ctx, "Galaxy", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = gq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Galaxy{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, gq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (gq *GalaxyQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := gq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Galaxy entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (gq *GalaxyQuery) Prefetch(n int) *GalaxyQuery {
+	gq.prefetch = n
+	return gq
+}
+
+// ForUpdate locks the selected Galaxy rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (gq *GalaxyQuery) ForUpdate() *GalaxyQuery {
+	gq.forUpdate = true
+	return gq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (gq *GalaxyQuery) Primary() *GalaxyQuery {
+	return gq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (gq *GalaxyQuery) ReadConsistency(c sql.ReadConsistency) *GalaxyQuery {
+	gq.consistency = c
+	return gq
+}
+
+// Stream executes the query in batches, and sends the matched Galaxy entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Galaxy.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (gq *GalaxyQuery) Stream(ctx context.Context) (<-chan *Galaxy, <-chan error) {
+	size := gq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Galaxy, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := gq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Galaxy entities in batches of the given
+// size, and sends them to the given channel.
+func (gq *GalaxyQuery) stream(ctx context.Context, size int, nodes chan<- *Galaxy) error {
+	if gq.offset != nil || len(gq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if gq.limit != nil {
+		left = *gq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *gq
+		query.predicates = gq.predicates[:len(gq.predicates):len(gq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, galaxy.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(galaxy.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// GalaxyEdge is the edge representation of Galaxy in a connection.
+type GalaxyEdge struct {
+	Node   *Galaxy `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// GalaxyConnection is the connection of Galaxy entities that is returned by Paginate.
+type GalaxyConnection struct {
+	Edges      []GalaxyEdge `json:"edges"`
+	PageInfo   PageInfo     `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Galaxy.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: galaxy.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (gq *GalaxyQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*GalaxyConnection, error) {
+	if gq.limit != nil || gq.offset != nil || len(gq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case galaxy.FieldName, galaxy.FieldType:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for Galaxy", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := gq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: galaxy.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *gq
+	query.predicates = gq.predicates[:len(gq.predicates):len(gq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := gq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &GalaxyConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]GalaxyEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = GalaxyEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (gq *GalaxyQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Galaxy, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := gq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Galaxy(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*GalaxyQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case galaxy.FieldName:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field name: %v", err)
+		}
+		return v, nil
+	case galaxy.FieldType:
+		var v galaxy.Type
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field type: %v", err)
+		}
+		return v, nil
+	case galaxy.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Galaxy", field)
+}
+
+// pageCursor returns the cursor of the Galaxy node for the given order fields.
+func (ga *Galaxy) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(ga.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case galaxy.FieldName:
+			v = ga.Name
+		case galaxy.FieldType:
+			v = ga.Type
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Galaxy", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
 // GalaxyGroupBy is the builder for group-by Galaxy entities.
 type GalaxyGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -584,9 +1104,28 @@ func (ggb *GalaxyGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Galaxy.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (ggb *GalaxyGroupBy) Having(ps ...*sql.Predicate) *GalaxyGroupBy {
+	ggb.havings = append(ggb.havings, ps...)
+	return ggb
+}
+
 func (ggb *GalaxyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ggb.withOperation(ctx, "Galaxy", "GroupBy")
+	selector := ggb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := ggb.sqlQuery().Query()
 	if err := ggb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -599,15 +1138,30 @@ func (ggb *GalaxyGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(ggb.fields)+len(ggb.fns))
 	columns = append(columns, ggb.fields...)
 	for _, fn := range ggb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(ggb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(ggb.fields...)
+	switch len(ggb.havings) {
+	case 0:
+	case 1:
+		selector.Having(ggb.havings[0])
+	default:
+		selector.Having(sql.And(ggb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(ggb.fields...)
+	return selector
 }
 
 // GalaxySelect is the builder for select fields of Galaxy entities.
 type GalaxySelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *GalaxyQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -714,7 +1268,52 @@ func (gs *GalaxySelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (gs *GalaxySelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(gs.fields) > 1 {
+		return nil, errors.New("ent: GalaxySelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := gs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (gs *GalaxySelect) TimesX(ctx context.Context) []time.Time {
+	v, err := gs.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Galaxy entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Galaxy.Query().
+//		Select(galaxy.FieldName).
+//		All(ctx)
+//
+func (gs *GalaxySelect) All(ctx context.Context) ([]*Galaxy, error) {
+	query := *gs.query
+	query.fields = gs.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (gs *GalaxySelect) AllX(ctx context.Context) []*Galaxy {
+	nodes, err := gs.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (gs *GalaxySelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = gs.withOperation(ctx, "Galaxy", "Select")
 	rows := &sql.Rows{}
 	query, args := gs.sqlQuery().Query()
 	if err := gs.driver.Query(ctx, query, args, rows); err != nil {
@@ -24,6 +24,9 @@ type GalaxyUpdate struct {
 	hooks      []Hook
 	mutation   *GalaxyMutation
 	predicates []predicate.Galaxy
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,18 +35,39 @@ func (gu *GalaxyUpdate) Where(ps ...predicate.Galaxy) *GalaxyUpdate {
 	return gu
 }
 
+// Limit limits the number of galaxies that are updated by the builder. For example,
+// for updating large amounts of galaxies in small batches without locking the whole table.
+func (gu *GalaxyUpdate) Limit(limit int) *GalaxyUpdate {
+	gu.limit = &limit
+	return gu
+}
+
 // SetName sets the name field.
 func (gu *GalaxyUpdate) SetName(s string) *GalaxyUpdate {
 	gu.mutation.SetName(s)
 	return gu
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (gu *GalaxyUpdate) UnsetName() *GalaxyUpdate {
+	gu.mutation.ResetName()
+	return gu
+}
+
 // SetType sets the type field.
 func (gu *GalaxyUpdate) SetType(ga galaxy.Type) *GalaxyUpdate {
 	gu.mutation.SetType(ga)
 	return gu
 }
 
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database.
+func (gu *GalaxyUpdate) UnsetType() *GalaxyUpdate {
+	gu.mutation.ResetType()
+	return gu
+}
+
 // AddPlanetIDs adds the planets edge to Planet by ids.
 func (gu *GalaxyUpdate) AddPlanetIDs(ids ...int) *GalaxyUpdate {
 	gu.mutation.AddPlanetIDs(ids...)
@@ -74,19 +98,33 @@ func (gu *GalaxyUpdate) RemovePlanets(p ...*Planet) *GalaxyUpdate {
 	return gu.RemovePlanetIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (gu *GalaxyUpdate) Unwrap() *GalaxyUpdate {
+	tx, ok := gu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GalaxyUpdate is not a transactional builder")
+	}
+	gu.config.driver = tx.drv
+	gu.mutation.driver = tx.drv
+	return gu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (gu *GalaxyUpdate) DebugFields() map[string]Value {
+	return debugFields(gu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (gu *GalaxyUpdate) Save(ctx context.Context) (int, error) {
-	if v, ok := gu.mutation.Name(); ok {
-		if err := galaxy.NameValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(gu.driver); err != nil {
+		return 0, err
 	}
-	if v, ok := gu.mutation.GetType(); ok {
-		if err := galaxy.TypeValidator(v); err != nil {
-			return 0, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
+	if err := gu.check(); err != nil {
+		return 0, err
 	}
-
 	var (
 		err      error
 		affected int
@@ -135,7 +173,23 @@ func (gu *GalaxyUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (gu *GalaxyUpdate) check() error {
+	if v, ok := gu.mutation.Name(); ok {
+		if err := galaxy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := gu.mutation.GetType(); ok {
+		if err := galaxy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (gu *GalaxyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = gu.withOperation(ctx, "Galaxy", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   galaxy.Table,
@@ -153,6 +207,12 @@ func (gu *GalaxyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := gu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if gu.ids != nil {
+		_spec.ScanIDs = gu.ids
+	}
 	if value, ok := gu.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -205,7 +265,7 @@ func (gu *GalaxyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, gu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{galaxy.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -216,11 +276,33 @@ func (gu *GalaxyUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Galaxy entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (gu *GalaxyUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	gu.ids = &ids
+	defer func() { gu.ids = nil }()
+	if _, err := gu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // GalaxyUpdateOne is the builder for updating a single Galaxy entity.
 type GalaxyUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *GalaxyMutation
+	hooks      []Hook
+	mutation   *GalaxyMutation
+	predicates []predicate.Galaxy
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (guo *GalaxyUpdateOne) Where(ps ...predicate.Galaxy) *GalaxyUpdateOne {
+	guo.predicates = append(guo.predicates, ps...)
+	return guo
 }
 
 // SetName sets the name field.
@@ -229,12 +311,26 @@ func (guo *GalaxyUpdateOne) SetName(s string) *GalaxyUpdateOne {
 	return guo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (guo *GalaxyUpdateOne) UnsetName() *GalaxyUpdateOne {
+	guo.mutation.ResetName()
+	return guo
+}
+
 // SetType sets the type field.
 func (guo *GalaxyUpdateOne) SetType(ga galaxy.Type) *GalaxyUpdateOne {
 	guo.mutation.SetType(ga)
 	return guo
 }
 
+// UnsetType removes the changes of the type field from the builder (e.g. a previous call
+// to SetType), and therefore, the field is left unchanged in the database.
+func (guo *GalaxyUpdateOne) UnsetType() *GalaxyUpdateOne {
+	guo.mutation.ResetType()
+	return guo
+}
+
 // AddPlanetIDs adds the planets edge to Planet by ids.
 func (guo *GalaxyUpdateOne) AddPlanetIDs(ids ...int) *GalaxyUpdateOne {
 	guo.mutation.AddPlanetIDs(ids...)
@@ -265,19 +361,33 @@ func (guo *GalaxyUpdateOne) RemovePlanets(p ...*Planet) *GalaxyUpdateOne {
 	return guo.RemovePlanetIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (guo *GalaxyUpdateOne) Unwrap() *GalaxyUpdateOne {
+	tx, ok := guo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: GalaxyUpdateOne is not a transactional builder")
+	}
+	guo.config.driver = tx.drv
+	guo.mutation.driver = tx.drv
+	return guo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (guo *GalaxyUpdateOne) DebugFields() map[string]Value {
+	return debugFields(guo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (guo *GalaxyUpdateOne) Save(ctx context.Context) (*Galaxy, error) {
-	if v, ok := guo.mutation.Name(); ok {
-		if err := galaxy.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	if err := checkTx(guo.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := guo.mutation.GetType(); ok {
-		if err := galaxy.TypeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"type\": %v", err)
-		}
+	if err := guo.check(); err != nil {
+		return nil, err
 	}
-
 	var (
 		err  error
 		node *Galaxy
@@ -326,7 +436,23 @@ func (guo *GalaxyUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (guo *GalaxyUpdateOne) check() error {
+	if v, ok := guo.mutation.Name(); ok {
+		if err := galaxy.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	if v, ok := guo.mutation.GetType(); ok {
+		if err := galaxy.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf("ent: validator failed for field \"type\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (guo *GalaxyUpdateOne) sqlSave(ctx context.Context) (ga *Galaxy, err error) {
+	ctx = guo.withOperation(ctx, "Galaxy", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   galaxy.Table,
@@ -342,6 +468,13 @@ func (guo *GalaxyUpdateOne) sqlSave(ctx context.Context) (ga *Galaxy, err error)
 		return nil, fmt.Errorf("missing Galaxy.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := guo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := guo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -395,9 +528,11 @@ func (guo *GalaxyUpdateOne) sqlSave(ctx context.Context) (ga *Galaxy, err error)
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	ga = &Galaxy{config: guo.config}
-	_spec.Assign = ga.assignValues
-	_spec.ScanValues = ga.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, guo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return ga.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = ga.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, guo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{galaxy.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -405,5 +540,16 @@ func (guo *GalaxyUpdateOne) sqlSave(ctx context.Context) (ga *Galaxy, err error)
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		ga.ID = id
+		if value, ok := guo.mutation.Name(); ok {
+			ga.Name = value
+		}
+		if value, ok := guo.mutation.GetType(); ok {
+			ga.Type = value
+		}
+	}
 	return ga, nil
 }
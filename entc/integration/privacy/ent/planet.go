@@ -7,11 +7,17 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/planet"
+	"github.com/facebookincubator/ent/entc/integration/privacy/ent/predicate"
 )
 
 // Planet is the model entity for the Planet schema.
@@ -27,6 +33,9 @@ type Planet struct {
 	// The values are being populated by the PlanetQuery when eager-loading is set.
 	Edges          PlanetEdges `json:"edges"`
 	galaxy_planets *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // PlanetEdges holds the relations/edges for other nodes in the graph.
@@ -47,59 +56,265 @@ func (e PlanetEdges) NeighborsOrErr() ([]*Planet, error) {
 	return nil, &NotLoadedError{edge: "neighbors"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Planet) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // name
-		&sql.NullInt64{},  // age
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Planet) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // galaxy_planets
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Planet type are scanned as generic values.
+func (*Planet) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case planet.FieldID:
+			values[i] = &sql.NullInt64{}
+		case planet.FieldName:
+			values[i] = &sql.NullString{}
+		case planet.FieldAge:
+			values[i] = &sql.NullInt64{}
+		case planet.ForeignKeys[0]: // galaxy_planets
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Planet fields.
-func (pl *Planet) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(planet.Columns); m < n {
+// to the Planet fields. Values of columns that were not selected are left untouched.
+func (pl *Planet) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
+	for i := range columns {
+		switch columns[i] {
+		case planet.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pl.ID = int(value.Int64)
+		case planet.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pl.Name = value.String
+			}
+		case planet.FieldAge:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field age", values[i])
+			} else if value.Valid {
+				pl.Age = uint(value.Int64)
+			}
+		case planet.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field galaxy_planets", value)
+			} else if value.Valid {
+				pl.galaxy_planets = new(int)
+				*pl.galaxy_planets = int(value.Int64)
+			}
+		}
+	}
+	return nil
+}
+
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (pl *Planet) SelectValue(alias string) (Value, error) {
+	v, ok := pl.selectValues[alias]
 	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
+// QueryNeighbors queries the neighbors edge of the Planet.
+func (pl *Planet) QueryNeighbors() *PlanetQuery {
+	return (&PlanetClient{config: pl.config}).QueryNeighbors(pl)
+}
+
+// LoadEdges loads the given edges of the Planet using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see planet.Edges), and in this case, no edge is loaded.
+//
+//	err := pl.LoadEdges(ctx, client, planet.EdgeNeighbors)
+//
+func (pl *Planet) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadPlanetEdges(ctx, client, []*Planet{pl}, edges)
+}
+
+// loadPlanetEdges loads the given edges of all the given Planet entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using PlanetClient.LoadEdgeFor.
+func loadPlanetEdges(ctx context.Context, client *Client, nodes []*Planet, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case planet.EdgeNeighbors:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Planet", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Planet.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Planet and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Planet alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Planet itself is not modified.
+//
+//	b, err := pl.DumpGraph(ctx, client, 2, planet.EdgeNeighbors)
+//
+func (pl *Planet) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Planet DumpGraph", depth)
+	}
+	dumped := *pl
+	dumped.Edges = PlanetEdges{}
+	if err := dumpPlanets(ctx, client, []*Planet{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
 	}
-	pl.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[0])
-	} else if value.Valid {
-		pl.Name = value.String
+	return json.Marshal(&dumped)
+}
+
+// dumpPlanets loads the edges of the given Planet copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpPlanets(ctx context.Context, client *Client, nodes []*Planet, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
 	}
-	if value, ok := values[1].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field age", values[1])
-	} else if value.Valid {
-		pl.Age = uint(value.Int64)
+	var visit []*Planet
+	for _, n := range nodes {
+		if dumper.visit(planet.Label, n.ID) {
+			visit = append(visit, n)
+		}
 	}
-	values = values[2:]
-	if len(values) == len(planet.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field galaxy_planets", value)
-		} else if value.Valid {
-			pl.galaxy_planets = new(int)
-			*pl.galaxy_planets = int(value.Int64)
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range planet.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
 		}
 	}
+	if err := loadPlanetEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextNeighbors []*Planet
+	for _, n := range visit {
+		for i, e := range n.Edges.Neighbors {
+			c := *e
+			c.Edges = PlanetEdges{}
+			n.Edges.Neighbors[i] = &c
+			nextNeighbors = append(nextNeighbors, &c)
+		}
+	}
+	if err := dumpPlanets(ctx, client, nextNeighbors, depth-1, dumper); err != nil {
+		return err
+	}
 	return nil
 }
 
-// QueryNeighbors queries the neighbors edge of the Planet.
-func (pl *Planet) QueryNeighbors() *PlanetQuery {
-	return (&PlanetClient{config: pl.config}).QueryNeighbors(pl)
+// AddNeighborsByField adds the "neighbors" edges of the Planet to the Planet entities that are resolved by the
+// given values of one of their unique fields (e.g. planet.FieldName). The entities are resolved
+// in one query, and the missing join rows are added in bulk. Values must have the Go type of the field, and
+// a *NotFoundError is returned if one of them does not match any entity. In this case, no edge is added.
+//
+//	err := pl.AddNeighborsByField(ctx, client, planet.FieldName, v1, v2)
+//
+func (pl *Planet) AddNeighborsByField(ctx context.Context, client *Client, field string, values ...interface{}) error {
+	_, err := pl.addNeighborsByField(ctx, client, false, field, values)
+	return err
+}
+
+// AddNeighborsByFieldOrCreate is like AddNeighborsByField, but creates the Planet entities that were not resolved by the
+// given values (in bulk), and returns them. Note that the operation is not atomic, unless the given client
+// is a transactional client (e.g. tx.Client()).
+func (pl *Planet) AddNeighborsByFieldOrCreate(ctx context.Context, client *Client, field string, values ...interface{}) ([]*Planet, error) {
+	return pl.addNeighborsByField(ctx, client, true, field, values)
+}
+
+func (pl *Planet) addNeighborsByField(ctx context.Context, client *Client, create bool, field string, values []interface{}) ([]*Planet, error) {
+	var (
+		where predicate.Planet
+		key   func(*Planet) interface{}
+	)
+	switch field {
+	case planet.FieldName:
+		vs := make([]string, len(values))
+		for i, v := range values {
+			tv, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("ent: unexpected type %T for field name", v)
+			}
+			vs[i] = tv
+		}
+		where = planet.NameIn(vs...)
+		key = func(n *Planet) interface{} {
+			return n.Name
+		}
+	default:
+		return nil, fmt.Errorf("ent: field %q is not a unique field of type Planet", field)
+	}
+	nodes, err := client.Planet.Query().Where(where).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resolved := make(map[interface{}]bool, len(nodes))
+	for _, n := range nodes {
+		resolved[key(n)] = true
+	}
+	var builders []*PlanetCreate
+	for _, v := range values {
+		if resolved[v] {
+			continue
+		}
+		if !create {
+			return nil, &NotFoundError{planet.Label}
+		}
+		builder := client.Planet.Create()
+		if err := builder.mutation.SetField(field, v); err != nil {
+			return nil, err
+		}
+		builders = append(builders, builder)
+		resolved[v] = true
+	}
+	var created []*Planet
+	if len(builders) > 0 {
+		if created, err = client.Planet.CreateBulk(builders...).Save(ctx); err != nil {
+			return nil, err
+		}
+	}
+	ids := make([]int, 0, len(nodes)+len(created))
+	for _, n := range append(nodes, created...) {
+		ids = append(ids, n.ID)
+	}
+	// Skip the join rows that already exist.
+	exist, err := client.Planet.QueryNeighbors(pl).Where(planet.IDIn(ids...)).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(exist) < len(ids) {
+		skip := make(map[int]bool, len(exist))
+		for _, id := range exist {
+			skip[id] = true
+		}
+		add := ids[:0]
+		for _, id := range ids {
+			if !skip[id] {
+				add = append(add, id)
+			}
+		}
+		if err := client.Planet.UpdateOneID(pl.ID).AddNeighborIDs(add...).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
 }
 
 // Update returns a builder for updating this Planet.
@@ -120,6 +335,32 @@ func (pl *Planet) Unwrap() *Planet {
 	return pl
 }
 
+// Clone returns a deep copy of the Planet and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (pl *Planet) Clone() *Planet {
+	return pl.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Planet and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (pl *Planet) clone(seen map[interface{}]interface{}) *Planet {
+	if pl == nil {
+		return nil
+	}
+	if v, ok := seen[pl]; ok {
+		return v.(*Planet)
+	}
+	_c := *pl
+	seen[pl] = &_c
+	if pl.Edges.Neighbors != nil {
+		_c.Edges.Neighbors = make([]*Planet, len(pl.Edges.Neighbors))
+		for _i := range pl.Edges.Neighbors {
+			_c.Edges.Neighbors[_i] = pl.Edges.Neighbors[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (pl *Planet) String() string {
 	var builder strings.Builder
@@ -133,6 +374,30 @@ func (pl *Planet) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Planet, for change detection and cache keys.
+// It covers the fields that are listed in planet.FingerprintFields, and it does not depend on the
+// ID of the Planet, on its edges, or on the order of the fields in the schema.
+func (pl *Planet) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "age", pl.Age)
+	fingerprint(h, "name", pl.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Planet for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Planet can be decoded using the FromMap method of its client.
+func (pl *Planet) ToMap() map[string]string {
+	m := make(map[string]string, 3)
+	m[planet.FieldID] = kvEncode(pl.ID)
+	m[planet.FieldName] = kvEncode(pl.Name)
+	if v := pl.Age; !reflect.ValueOf(v).IsZero() {
+		m[planet.FieldAge] = kvEncode(v)
+	}
+	return m
+}
+
 // Planets is a parsable slice of Planet.
 type Planets []*Planet
 
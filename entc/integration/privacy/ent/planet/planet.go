@@ -27,6 +27,18 @@ const (
 	NeighborsTable = "planet_neighbors"
 )
 
+// Edges holds all the edge names of the Planet type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeNeighbors,
+}
+
+// FingerprintFields holds the fields of the Planet type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldAge,
+	FieldName,
+}
+
 // Columns holds all SQL columns for planet fields.
 var Columns = []string{
 	FieldID,
@@ -39,6 +51,16 @@ var ForeignKeys = []string{
 	"galaxy_planets",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// NeighborsPrimaryKey and NeighborsColumn2 are the table columns denoting the
 	// primary key for the neighbors relation (M2M).
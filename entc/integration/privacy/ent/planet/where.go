@@ -7,6 +7,8 @@
 package planet
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/predicate"
@@ -192,6 +194,13 @@ func NameContains(v string) predicate.Planet {
 	})
 }
 
+// NameNotContains applies the NotContains predicate on the "name" field.
+func NameNotContains(v string) predicate.Planet {
+	return predicate.Planet(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldName), v))
+	})
+}
+
 // NameHasPrefix applies the HasPrefix predicate on the "name" field.
 func NameHasPrefix(v string) predicate.Planet {
 	return predicate.Planet(func(s *sql.Selector) {
@@ -199,6 +208,13 @@ func NameHasPrefix(v string) predicate.Planet {
 	})
 }
 
+// NameNotHasPrefix applies the NotHasPrefix predicate on the "name" field.
+func NameNotHasPrefix(v string) predicate.Planet {
+	return predicate.Planet(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldName), v))
+	})
+}
+
 // NameHasSuffix applies the HasSuffix predicate on the "name" field.
 func NameHasSuffix(v string) predicate.Planet {
 	return predicate.Planet(func(s *sql.Selector) {
@@ -206,6 +222,13 @@ func NameHasSuffix(v string) predicate.Planet {
 	})
 }
 
+// NameNotHasSuffix applies the NotHasSuffix predicate on the "name" field.
+func NameNotHasSuffix(v string) predicate.Planet {
+	return predicate.Planet(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldName), v))
+	})
+}
+
 // NameEqualFold applies the EqualFold predicate on the "name" field.
 func NameEqualFold(v string) predicate.Planet {
 	return predicate.Planet(func(s *sql.Selector) {
@@ -220,6 +243,20 @@ func NameContainsFold(v string) predicate.Planet {
 	})
 }
 
+// NameHasPrefixFold applies the HasPrefixFold predicate on the "name" field.
+func NameHasPrefixFold(v string) predicate.Planet {
+	return predicate.Planet(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldName), v))
+	})
+}
+
+// NameHasSuffixFold applies the HasSuffixFold predicate on the "name" field.
+func NameHasSuffixFold(v string) predicate.Planet {
+	return predicate.Planet(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldName), v))
+	})
+}
+
 // AgeEQ applies the EQ predicate on the "age" field.
 func AgeEQ(v uint) predicate.Planet {
 	return predicate.Planet(func(s *sql.Selector) {
@@ -310,6 +347,76 @@ func AgeNotNil() predicate.Planet {
 	})
 }
 
+// AgeEQNullSafe applies a NULL-safe equality predicate on the "age" field. A nil value matches
+// the rows where the field is NULL, and other values match the rows with an equal (non-NULL) value.
+func AgeEQNullSafe(v *uint) predicate.Planet {
+	return predicate.Planet(func(s *sql.Selector) {
+		var arg interface{}
+		if v != nil {
+			arg = *v
+		}
+		s.Where(sql.NullSafeEQ(s.C(FieldAge), arg))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldAge:  "numeric",
+	FieldID:   "numeric",
+	FieldName: "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Planet.
+func FieldsEQ(f1, f2 string) predicate.Planet {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Planet.
+func FieldsNEQ(f1, f2 string) predicate.Planet {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Planet.
+func FieldsLT(f1, f2 string) predicate.Planet {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Planet.
+func FieldsLTE(f1, f2 string) predicate.Planet {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Planet.
+func FieldsGT(f1, f2 string) predicate.Planet {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Planet.
+func FieldsGTE(f1, f2 string) predicate.Planet {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Planet using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Planet {
+	return predicate.Planet(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Planet", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Planet", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Planet are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasNeighbors applies the HasEdge predicate on the "neighbors" edge.
 func HasNeighbors() predicate.Planet {
 	return predicate.Planet(func(s *sql.Selector) {
@@ -345,6 +452,9 @@ func And(predicates ...predicate.Planet) predicate.Planet {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -359,6 +469,9 @@ func Or(predicates ...predicate.Planet) predicate.Planet {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -369,3 +482,37 @@ func Not(p predicate.Planet) predicate.Planet {
 		p(s.Not())
 	})
 }
+
+// PlanetFilter holds optional filters on the Planet fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type PlanetFilter struct {
+	IDIn []int
+	Name *string
+	Age  *uint
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Planet.Query().
+//		Where(planet.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *PlanetFilter) []predicate.Planet {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Planet
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Name != nil {
+		ps = append(ps, NameEQ(*f.Name))
+	}
+	if f.Age != nil {
+		ps = append(ps, AgeEQ(*f.Age))
+	}
+	return ps
+}
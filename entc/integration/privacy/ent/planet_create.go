@@ -10,7 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/entc/integration/privacy/ent/planet"
 	"github.com/facebookincubator/ent/schema/field"
@@ -60,13 +63,12 @@ func (pc *PlanetCreate) AddNeighbors(p ...*Planet) *PlanetCreate {
 
 // Save creates the Planet in the database.
 func (pc *PlanetCreate) Save(ctx context.Context) (*Planet, error) {
-	if _, ok := pc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	if err := checkTx(pc.driver); err != nil {
+		return nil, err
 	}
-	if v, ok := pc.mutation.Name(); ok {
-		if err := planet.NameValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
-		}
+	pc.defaults()
+	if err := pc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -103,17 +105,120 @@ func (pc *PlanetCreate) SaveX(ctx context.Context) *Planet {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pc *PlanetCreate) Unwrap() *PlanetCreate {
+	tx, ok := pc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlanetCreate is not a transactional builder")
+	}
+	pc.config.driver = tx.drv
+	pc.mutation.driver = tx.drv
+	return pc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (pc *PlanetCreate) DebugFields() map[string]Value {
+	return debugFields(pc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PlanetCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PlanetCreate) check() error {
+	if _, ok := pc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	if v, ok := pc.mutation.Name(); ok {
+		if err := planet.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf("ent: validator failed for field \"name\": %v", err)}
+		}
+	}
+	return nil
+}
+
 func (pc *PlanetCreate) sqlSave(ctx context.Context) (*Planet, error) {
-	var (
-		pl    = &Planet{config: pc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: planet.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: planet.FieldID,
-			},
+	ctx = pc.withOperation(ctx, "Planet", "Create")
+	pl, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	pl.ID = int(id)
+	return pl, nil
+}
+
+// SaveID creates the Planet in the database and returns only its id. Unlike Save,
+// the Planet entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Planet that holds only its id as the value that is returned by the next mutator.
+func (pc *PlanetCreate) SaveID(ctx context.Context) (id int, err error) {
+	pc.defaults()
+	if err = pc.check(); err != nil {
+		return id, err
+	}
+	if len(pc.hooks) == 0 {
+		return pc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*PlanetMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		pc.mutation = mutation
+		if id, err = pc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Planet{config: pc.config, ID: id}, nil
+	})
+	for i := len(pc.hooks) - 1; i >= 0; i-- {
+		mut = pc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, pc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (pc *PlanetCreate) SaveIDX(ctx context.Context) int {
+	id, err := pc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (pc *PlanetCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = pc.withOperation(ctx, "Planet", "Create")
+	_spec := pc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, pc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (pc *PlanetCreate) createSpec() (*Planet, *sqlgraph.CreateSpec) {
+	pl := &Planet{config: pc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: planet.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: planet.FieldID,
+		},
+	}
 	if value, ok := pc.mutation.Name(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -149,13 +254,621 @@ func (pc *PlanetCreate) sqlSave(ctx context.Context) (*Planet, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return pl, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (pc *PlanetCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: planet.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: planet.FieldID,
+		},
+	}
+	if value, ok := pc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: planet.FieldName,
+		})
+	}
+	if value, ok := pc.mutation.Age(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUint,
+			Value:  value,
+			Column: planet.FieldAge,
+		})
+	}
+	if nodes := pc.mutation.NeighborsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   planet.NeighborsTable,
+			Columns: planet.NeighborsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: planet.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// PlanetCreateBulk is the builder for creating a bulk of Planet entities.
+type PlanetCreateBulk struct {
+	config
+	builders []*PlanetCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Planet entities in the database.
+func (pcb *PlanetCreateBulk) Save(ctx context.Context) ([]*Planet, error) {
+	nodes, err := pcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && pcb.retries > 0 && isSQLDeadlockError(err) {
+		return pcb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PlanetCreateBulk) SaveX(ctx context.Context) []*Planet {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (pcb *PlanetCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range pcb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (pcb *PlanetCreateBulk) CheckRefs() *PlanetCreateBulk {
+	pcb.refs = true
+	return pcb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (pcb *PlanetCreateBulk) RetryOnDeadlock(max int) *PlanetCreateBulk {
+	pcb.retries = max
+	return pcb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Planet.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Planet.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (pcb *PlanetCreateBulk) OnConflict(columns ...string) *PlanetUpsertBulk {
+	return &PlanetUpsertBulk{create: pcb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Planet.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (pc *PlanetCreate) OnConflict(target ...sql.ConflictOption) *PlanetUpsertBulk {
+	bulk := &PlanetCreateBulk{config: pc.config, builders: []*PlanetCreate{pc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (pcb *PlanetCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Planet, error) {
+	ctx = pcb.withOperation(ctx, "Planet", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(pcb.builders))
+		nodes    = make([]*Planet, len(pcb.builders))
+		mutators = make([]Mutator, len(pcb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlanetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if pcb.refs {
+						if err := pcb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, pcb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (pcb *PlanetCreateBulk) retry(ctx context.Context, err error) ([]*Planet, error) {
+	if _, ok := pcb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	pl.ID = int(id)
-	return pl, nil
+	builders := pcb.builders
+	defer func() { pcb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return pcb.less(builders[idx[i]], builders[idx[j]])
+	})
+	pcb.builders = make([]*PlanetCreate, len(idx))
+	for i, j := range idx {
+		pcb.builders[i] = builders[j]
+	}
+	for n := 0; n < pcb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Planet
+		if sorted, err = pcb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Planet, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (pcb *PlanetCreateBulk) less(a, b *PlanetCreate) bool {
+	{
+		x, _ := a.mutation.Name()
+		y, _ := b.mutation.Name()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (pcb *PlanetCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range pcb.builders {
+			for _, id := range builder.mutation.NeighborsIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, pcb.driver, planet.Table, planet.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[planet.EdgeNeighbors] = append(missing[planet.EdgeNeighbors], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Planet", Missing: missing}
+	}
+	return nil
+}
+
+// PlanetUpsertBulk is the builder for the conflict handling of a bulk of Planet entities.
+type PlanetUpsertBulk struct {
+	create  *PlanetCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (pub *PlanetUpsertBulk) Target(target ...sql.ConflictOption) *PlanetUpsertBulk {
+	pub.target = append(pub.target, target...)
+	return pub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (pub *PlanetUpsertBulk) DoNothing() *PlanetUpsertBulk {
+	pub.nothing = true
+	return pub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (pub *PlanetUpsertBulk) UpdateNewValues() *PlanetUpsertBulk {
+	pub.update = true
+	return pub
+}
+
+// Save creates the Planet entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (pub *PlanetUpsertBulk) Save(ctx context.Context) ([]*Planet, int, error) {
+	switch {
+	case !pub.nothing && !pub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Planet bulk insert")
+	case pub.nothing && pub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Planet bulk insert")
+	case pub.update:
+		if len(pub.columns) == 0 && len(pub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Planet bulk upsert")
+		}
+		nodes, err := pub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(pub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(planet.FieldID, planet.FieldName),
+			),
+			Columns:         planet.Columns,
+			ConflictColumns: pub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(pub.conflictTarget(), sql.DoNothing())}
+	nodes, err := pub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Planet, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (pub *PlanetUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(pub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(pub.columns...))
+	}
+	return append(opts, pub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pub *PlanetUpsertBulk) SaveX(ctx context.Context) ([]*Planet, int) {
+	nodes, skipped, err := pub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (pub *PlanetUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := pub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pub *PlanetUpsertBulk) ExecX(ctx context.Context) {
+	if err := pub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored Planet entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.Planet.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *Planet) *Planet {
+//			// ...
+//			return existing
+//		}, planet.FieldName).
+//		Save(ctx)
+//
+func (pcb *PlanetCreateBulk) OnConflictMerge(merge func(existing, incoming *Planet) *Planet, keyFields ...string) *PlanetMergeBulk {
+	return &PlanetMergeBulk{create: pcb, merge: merge, keyFields: keyFields}
+}
+
+// PlanetMergeBulk is the builder for merging a bulk of Planet entities with the stored entities in Go.
+type PlanetMergeBulk struct {
+	create    *PlanetCreateBulk
+	merge     func(existing, incoming *Planet) *Planet
+	keyFields []string
+}
+
+// Save creates or merges the Planet entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (pmb *PlanetMergeBulk) Save(ctx context.Context) ([]*Planet, error) {
+	if pmb.merge == nil {
+		return nil, errors.New("ent: missing merge function for Planet bulk merge")
+	}
+	if len(pmb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for Planet bulk merge")
+	}
+	for _, f := range pmb.keyFields {
+		switch f {
+		case planet.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for Planet bulk merge", f)
+		}
+	}
+	drv := pmb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return pmb.save(ctx, pmb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
+		return nil, err
+	}
+	cfg := pmb.create.config
+	cfg.driver = tx
+	nodes, err := pmb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (pmb *PlanetMergeBulk) save(ctx context.Context, cfg config) ([]*Planet, error) {
+	var (
+		client   = &PlanetClient{config: cfg}
+		builders = pmb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *PlanetCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(pmb.keyFields))
+		for j, f := range pmb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in Planet builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: Planet builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Planet)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, pmb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(pmb.keyFields))
+			for j, f := range pmb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*Planet, len(builders))
+		inserts []*PlanetCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := pmb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmb *PlanetMergeBulk) SaveX(ctx context.Context) []*Planet {
+	nodes, err := pmb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (pmb *PlanetMergeBulk) Exec(ctx context.Context) error {
+	_, err := pmb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmb *PlanetMergeBulk) ExecX(ctx context.Context) {
+	if err := pmb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -23,6 +23,7 @@ type PlanetDelete struct {
 	hooks      []Hook
 	mutation   *PlanetMutation
 	predicates []predicate.Planet
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (pd *PlanetDelete) Where(ps ...predicate.Planet) *PlanetDelete {
 	return pd
 }
 
+// Limit limits the number of planets that are deleted by the builder. For example,
+// for deleting large amounts of planets in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (pd *PlanetDelete) Limit(limit int) *PlanetDelete {
+	pd.limit = &limit
+	return pd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pd *PlanetDelete) Unwrap() *PlanetDelete {
+	tx, ok := pd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlanetDelete is not a transactional builder")
+	}
+	pd.config.driver = tx.drv
+	pd.mutation.driver = tx.drv
+	return pd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (pd *PlanetDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(pd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (pd *PlanetDelete) ExecX(ctx context.Context) int {
 }
 
 func (pd *PlanetDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = pd.withOperation(ctx, "Planet", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: planet.Table,
@@ -85,7 +111,10 @@ func (pd *PlanetDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, pd.driver, _spec)
+	if limit := pd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, pd.mutationDriver(ctx), _spec)
 }
 
 // PlanetDeleteOne is the builder for deleting a single Planet entity.
@@ -99,7 +128,7 @@ func (pdo *PlanetDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{planet.Label}
 	default:
 		return nil
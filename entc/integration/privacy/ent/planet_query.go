@@ -9,9 +9,12 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
+	"strings"
+	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
@@ -31,6 +34,18 @@ type PlanetQuery struct {
 	// eager-loading edges.
 	withNeighbors *PlanetQuery
 	withFKs       bool
+	// prefetch size of streaming queries.
+	prefetch int
+	// forUpdate locks the selected rows.
+	forUpdate bool
+	// partition is the column that the limit and the offset
+	// are applied to, when the query is used for eager-loading.
+	partition string
+	// exprs are expression columns that are appended to the selection.
+	exprs []*sql.SelectExpr
+	// fields are the columns that are scanned into the entities. All
+	// columns are scanned if it is empty (see PlanetSelect.All).
+	fields []string
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -42,6 +57,19 @@ func (pq *PlanetQuery) Where(ps ...predicate.Planet) *PlanetQuery {
 	return pq
 }
 
+// Predicates returns the predicates that were added to the builder. The returned slice
+// is a copy, and changing it does not affect the builder. Use SetPredicates for that.
+func (pq *PlanetQuery) Predicates() []predicate.Planet {
+	return append([]predicate.Planet{}, pq.predicates...)
+}
+
+// SetPredicates replaces the predicates of the builder with the given predicates. Together
+// with Predicates, it allows hooks and privacy rules to inspect and rewrite the query filters.
+func (pq *PlanetQuery) SetPredicates(ps ...predicate.Planet) *PlanetQuery {
+	pq.predicates = append([]predicate.Planet{}, ps...)
+	return pq
+}
+
 // Limit adds a limit step to the query.
 func (pq *PlanetQuery) Limit(limit int) *PlanetQuery {
 	pq.limit = &limit
@@ -310,11 +338,15 @@ func (pq *PlanetQuery) GroupBy(field string, fields ...string) *PlanetGroupBy {
 func (pq *PlanetQuery) Select(field string, fields ...string) *PlanetSelect {
 	selector := &PlanetSelect{config: pq.config}
 	selector.fields = append([]string{field}, fields...)
+	selector.query = pq
 	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
 		if err := pq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
-		return pq.sqlQuery(), nil
+		if err := pq.checkExprs(); err != nil {
+			return nil, err
+		}
+		return pq.sqlQuery().AppendSelectExpr(pq.exprs...), nil
 	}
 	return selector
 }
@@ -334,6 +366,15 @@ func (pq *PlanetQuery) prepareQuery(ctx context.Context) error {
 }
 
 func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
+	ctx = pq.withOperation(ctx, "Planet", "Query")
+	if err := pq.checkExprs(); err != nil {
+		return nil, err
+	}
+	for _, f := range pq.fields {
+		if !planet.ValidColumn(f) {
+			return nil, fmt.Errorf("ent: invalid field %q for query", f)
+		}
+	}
 	var (
 		nodes       = []*Planet{}
 		withFKs     = pq.withFKs
@@ -345,12 +386,14 @@ func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, planet.ForeignKeys...)
 	}
+	_spec.Exprs = pq.exprs
+	columns := _spec.Node.Columns
 	_spec.ScanValues = func() []interface{} {
 		node := &Planet{config: pq.config}
 		nodes = append(nodes, node)
-		values := node.scanValues()
-		if withFKs {
-			values = append(values, node.fkValues()...)
+		values := node.scanValues(columns)
+		for range pq.exprs {
+			values = append(values, new(interface{}))
 		}
 		return values
 	}
@@ -359,8 +402,16 @@ func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
 			return fmt.Errorf("ent: Assign called without calling ScanValues")
 		}
 		node := nodes[len(nodes)-1]
+		if n := len(pq.exprs); n > 0 {
+			exprs := values[len(values)-n:]
+			values = values[:len(values)-n]
+			node.selectValues = make(map[string]interface{}, n)
+			for i, e := range pq.exprs {
+				node.selectValues[e.Alias()] = *exprs[i].(*interface{})
+			}
+		}
 		node.Edges.loadedTypes = loadedTypes
-		return node.assignValues(values...)
+		return node.assignValues(columns, values)
 	}
 	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
 		return nil, err
@@ -370,6 +421,9 @@ func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
 	}
 
 	if query := pq.withNeighbors; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
 		fks := make([]driver.Value, 0, len(nodes))
 		ids := make(map[int]*Planet, len(nodes))
 		for _, node := range nodes {
@@ -386,9 +440,6 @@ func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
 				Table:   planet.NeighborsTable,
 				Columns: planet.NeighborsPrimaryKey,
 			},
-			Predicate: func(s *sql.Selector) {
-				s.Where(sql.InValues(planet.NeighborsPrimaryKey[0], fks...))
-			},
 
 			ScanValues: func() [2]interface{} {
 				return [2]interface{}{&sql.NullInt64{}, &sql.NullInt64{}}
@@ -408,26 +459,69 @@ func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
 				if !ok {
 					return fmt.Errorf("unexpected node id in edges: %v", outValue)
 				}
-				edgeids = append(edgeids, inValue)
+				if _, ok := edges[inValue]; !ok {
+					edgeids = append(edgeids, inValue)
+				}
 				edges[inValue] = append(edges[inValue], node)
 				return nil
 			},
 		}
-		if err := sqlgraph.QueryEdges(ctx, pq.driver, _spec); err != nil {
-			return nil, fmt.Errorf(`query edges "neighbors": %v`, err)
-		}
-		query.Where(planet.IDIn(edgeids...))
-		neighbors, err := query.All(ctx)
+		err := pq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			_spec.Predicate = func(s *sql.Selector) {
+				s.Where(sql.InValues(planet.NeighborsPrimaryKey[0], fks[i:j]...))
+			}
+			if err := sqlgraph.QueryEdges(ctx, pq.driver, _spec); err != nil {
+				return fmt.Errorf(`query edges "neighbors": %v`, err)
+			}
+			return nil
+		})
 		if err != nil {
 			return nil, err
 		}
-		for _, n := range neighbors {
-			nodes, ok := edges[n.ID]
-			if !ok {
-				return nil, fmt.Errorf(`unexpected "neighbors" node returned %v`, n.ID)
+		// The neighbors of each batch are ordered separately.
+		if len(query.order) > 0 && pq.eagerLoadBatched(len(edgeids)) {
+			err := fmt.Errorf(`ent: order of eager-loaded edge "neighbors" cannot be applied on %d neighbors with batch size %d`, len(edgeids), pq.eagerLoadSize())
+			return nil, err
+		}
+		// The limit and the offset of the query are applied to the
+		// edges of each node, after all neighbors were loaded.
+		limit, offset := query.limit, query.offset
+		query.limit, query.offset = nil, nil
+		err = pq.eagerLoadBatches(ctx, len(edgeids), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], planet.IDIn(edgeids[i:j]...))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
 			}
-			for i := range nodes {
-				nodes[i].Edges.Neighbors = append(nodes[i].Edges.Neighbors, n)
+			for _, n := range neighbors {
+				nodes, ok := edges[n.ID]
+				if !ok {
+					return fmt.Errorf(`unexpected "neighbors" node returned %v`, n.ID)
+				}
+				for i := range nodes {
+					nodes[i].Edges.Neighbors = append(nodes[i].Edges.Neighbors, n)
+				}
+			}
+			return nil
+		})
+		query.limit, query.offset = limit, offset
+		if err != nil {
+			return nil, err
+		}
+		if limit != nil || offset != nil {
+			for _, node := range nodes {
+				edges := node.Edges.Neighbors
+				switch {
+				case offset == nil:
+				case *offset < len(edges):
+					edges = edges[*offset:]
+				default:
+					edges = nil
+				}
+				if limit != nil && *limit < len(edges) {
+					edges = edges[:*limit]
+				}
+				node.Edges.Neighbors = edges
 			}
 		}
 	}
@@ -435,7 +529,45 @@ func (pq *PlanetQuery) sqlAll(ctx context.Context) ([]*Planet, error) {
 	return nodes, nil
 }
 
+// SelectExpr appends the given expression columns to the selection of the query. Their values are
+// scanned with the Planet entities (see Planet.SelectValue), or into the struct fields that
+// match their aliases when the query is used with Select and Scan. For example:
+//
+//	nodes, err := client.Planet.Query().
+//		SelectExpr(sql.ExprAs("? - 1", "prev", 10)).
+//		All(ctx)
+//	prev, err := nodes[0].SelectValue("prev")
+//
+// The aliases must not collide with the columns of the Planet table.
+func (pq *PlanetQuery) SelectExpr(exprs ...*sql.SelectExpr) *PlanetQuery {
+	pq.exprs = append(pq.exprs, exprs...)
+	return pq
+}
+
+// checkExprs checks that the aliases of the expression columns do not
+// collide with the table columns, or with the aliases of each other.
+func (pq *PlanetQuery) checkExprs() error {
+	if len(pq.exprs) == 0 {
+		return nil
+	}
+	columns := make(map[string]bool)
+	for _, c := range planet.Columns {
+		columns[c] = true
+	}
+	for _, c := range planet.ForeignKeys {
+		columns[c] = true
+	}
+	for _, e := range pq.exprs {
+		if columns[e.Alias()] {
+			return fmt.Errorf("ent: alias %q of expression column collides with another column of Planet", e.Alias())
+		}
+		columns[e.Alias()] = true
+	}
+	return nil
+}
+
 func (pq *PlanetQuery) sqlCount(ctx context.Context) (int, error) {
+	ctx = pq.withOperation(ctx, "Planet", "Count")
 	_spec := pq.querySpec()
 	return sqlgraph.CountNodes(ctx, pq.driver, _spec)
 }
@@ -461,6 +593,17 @@ func (pq *PlanetQuery) querySpec() *sqlgraph.QuerySpec {
 		From:   pq.sql,
 		Unique: true,
 	}
+	if fields := pq.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields)+1)
+		_spec.Node.Columns = append(_spec.Node.Columns, planet.FieldID)
+		for i := range fields {
+			if fields[i] != planet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	_spec.ForUpdate = pq.forUpdate
+	_spec.PartitionBy = pq.partition
 	if ps := pq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
@@ -509,11 +652,412 @@ func (pq *PlanetQuery) sqlQuery() *sql.Selector {
 	return selector
 }
 
+// IDsQuery returns a selector that selects the ids of the Planet entities matched by the query.
+// It is used for embedding the query as a sub-query in predicates of other entities, without loading
+// the ids into memory. Queries that were created by traversing an edge of another query are not supported.
+func (pq *PlanetQuery) IDsQuery() *sql.Selector {
+	selector := pq.sqlQuery()
+	if pq.path != nil {
+		selector.AddError(fmt.Errorf("ent: IDsQuery does not support traversal queries"))
+	}
+	selector.AddError(fmt.Errorf("ent: IDsQuery does not support entities with privacy policy"))
+	return selector.Select(selector.C(planet.FieldID))
+}
+
+// CountDistinct returns the number of distinct values of the given fields in the Planet entities
+// that are matched by the query. Multiple fields count the distinct tuples of their values, and they are
+// supported only by MySQL and PostgreSQL. Note that NULL values are not counted.
+//
+//	n, err := client.Planet.Query().
+//		Where(...).
+//		CountDistinct(ctx, planet.FieldName)
+//
+func (pq *PlanetQuery) CountDistinct(ctx context.Context, fields ...string) (int, error) {
+	if err := pq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	ctx = pq.withOperation(ctx, "Planet", "CountDistinct")
+	n, err := sqlgraph.CountDistinct(ctx, pq.driver, pq.querySpec(), fields...)
+	if err != nil {
+		return 0, fmt.Errorf("ent: count distinct: %v", err)
+	}
+	return n, nil
+}
+
+// CountDistinctX is like CountDistinct, but panics if an error occurs.
+func (pq *PlanetQuery) CountDistinctX(ctx context.Context, fields ...string) int {
+	n, err := pq.CountDistinct(ctx, fields...)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// AllMaps executes the query and returns the matched Planet rows as generic maps that are keyed by
+// column name (see planet.Columns). The values are scanned using the scan types of the entity, and
+// are returned as their driver values (e.g. int64, float64, bool, string, []byte and time.Time), or as nil
+// for NULL values. Fields with custom Go types (e.g. UUID) are returned as their Go values. It is useful
+// for inspecting rows without the typed entities (e.g. in a generic table viewer). Eager-loaded edges are
+// not loaded.
+func (pq *PlanetQuery) AllMaps(ctx context.Context) ([]map[string]interface{}, error) {
+	if err := pq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	ctx = pq.withOperation(ctx, "Planet", "Query")
+	var (
+		rows  = []map[string]interface{}{}
+		_spec = pq.querySpec()
+	)
+	columns := _spec.Node.Columns
+	_spec.ScanValues = func() []interface{} {
+		return (&Planet{}).scanValues(columns)
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(values) != len(columns) {
+			return fmt.Errorf("mismatch number of scan values: %d != %d", len(values), len(columns))
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, c := range columns {
+			v, err := scanValue(values[i])
+			if err != nil {
+				return fmt.Errorf("scan column %q: %v", c, err)
+			}
+			row[c] = v
+		}
+		rows = append(rows, row)
+		return nil
+	}
+	if err := sqlgraph.QueryNodes(ctx, pq.driver, _spec); err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// AllMapsX is like AllMaps, but panics if an error occurs.
+func (pq *PlanetQuery) AllMapsX(ctx context.Context) []map[string]interface{} {
+	rows, err := pq.AllMaps(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return rows
+}
+
+// Prefetch sets the number of Planet entities that are fetched in each batch by Stream,
+// and the buffer size of its channel. It bounds the number of entities that are held in
+// memory by the stream. The default is 100.
+func (pq *PlanetQuery) Prefetch(n int) *PlanetQuery {
+	pq.prefetch = n
+	return pq
+}
+
+// ForUpdate locks the selected Planet rows until the end of the transaction, using the
+// `SELECT ... FOR UPDATE` clause. Therefore, the query should be executed in a transaction.
+// SQLite does not support row locks, and locks the whole database on write instead.
+func (pq *PlanetQuery) ForUpdate() *PlanetQuery {
+	pq.forUpdate = true
+	return pq
+}
+
+// Primary forces the query (and the queries of its eager-loaded edges) to be executed by the primary
+// database when the client driver routes reads to replicas (see sql.Replica). It is used for reading
+// writes that were just committed, and it is a shorthand for ReadConsistency(sql.ReadPrimary).
+// Note that edges of the returned entities are also queried from the primary database.
+func (pq *PlanetQuery) Primary() *PlanetQuery {
+	return pq.ReadConsistency(sql.ReadPrimary)
+}
+
+// ReadConsistency sets the read consistency level of the query. The level is passed to the driver in
+// the context of the statements, and overrides the level of the context (see sql.WithReadConsistency)
+// if it is not sql.ReadEventual. Drivers that do not route reads to replicas ignore it.
+func (pq *PlanetQuery) ReadConsistency(c sql.ReadConsistency) *PlanetQuery {
+	pq.consistency = c
+	return pq
+}
+
+// Stream executes the query in batches, and sends the matched Planet entities to the returned
+// channel in the order of their ids. Each batch is fetched using keyset pagination (id > last) only
+// after the previous one was consumed, and the channel is closed when all entities were sent, or when
+// the context is canceled. At most one error is sent to the error channel before it is closed.
+//
+//	nodes, errc := client.Planet.Query().Where(...).Prefetch(500).Stream(ctx)
+//	for n := range nodes {
+//		// ...
+//	}
+//	if err := <-errc; err != nil {
+//		// ...
+//	}
+//
+// Callers that stop reading before the channel is closed, should cancel the context to release the
+// stream. Queries with order or offset are not supported, and a limit applies to the whole stream.
+func (pq *PlanetQuery) Stream(ctx context.Context) (<-chan *Planet, <-chan error) {
+	size := pq.prefetch
+	if size <= 0 {
+		size = 100
+	}
+	nodes, errc := make(chan *Planet, size), make(chan error, 1)
+	go func() {
+		defer close(errc)
+		defer close(nodes)
+		if err := pq.stream(ctx, size, nodes); err != nil {
+			errc <- err
+		}
+	}()
+	return nodes, errc
+}
+
+// stream fetches the Planet entities in batches of the given
+// size, and sends them to the given channel.
+func (pq *PlanetQuery) stream(ctx context.Context, size int, nodes chan<- *Planet) error {
+	if pq.offset != nil || len(pq.order) > 0 {
+		return fmt.Errorf("ent: Stream does not support queries with order or offset")
+	}
+	var (
+		last *int
+		left = -1
+	)
+	if pq.limit != nil {
+		left = *pq.limit
+	}
+	for left != 0 {
+		n := size
+		if left > 0 && left < n {
+			n = left
+		}
+		// A shallow copy keeps the eager-loading configuration of the query.
+		query := *pq
+		query.predicates = pq.predicates[:len(pq.predicates):len(pq.predicates)]
+		if last != nil {
+			query.predicates = append(query.predicates, planet.IDGT(*last))
+		}
+		query.order = []OrderFunc{Asc(planet.FieldID)}
+		query.limit = &n
+		batch, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, node := range batch {
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if len(batch) < n {
+			return nil
+		}
+		if left > 0 {
+			left -= len(batch)
+		}
+		last = &batch[len(batch)-1].ID
+	}
+	return nil
+}
+
+// PlanetEdge is the edge representation of Planet in a connection.
+type PlanetEdge struct {
+	Node   *Planet `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// PlanetConnection is the connection of Planet entities that is returned by Paginate.
+type PlanetConnection struct {
+	Edges      []PlanetEdge `json:"edges"`
+	PageInfo   PageInfo     `json:"pageInfo"`
+	TotalCount int          `json:"totalCount"`
+}
+
+// Paginate executes the query and returns one page of it as a connection, following the Relay
+// cursor connections specification. The page is selected using keyset pagination: the rows are
+// ordered by the given order fields and the id, and the cursors hold the values of these fields.
+// The total count reports the number of entities that match the query, regardless of the page.
+//
+//	conn, err := client.Planet.Query().
+//		Where(...).
+//		Paginate(ctx, after, &first, nil, nil, ent.PageOrder{Field: planet.FieldX, Direction: ent.OrderDirectionDesc})
+//
+// HasNextPage and HasPreviousPage are computed for the first and last arguments respectively, by
+// fetching one extra row. Only fields that cannot be NULL can be used for ordering, and the query
+// must not have order, limit or offset. The order fields of the cursors must match orderBy.
+func (pq *PlanetQuery) Paginate(ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy ...PageOrder) (*PlanetConnection, error) {
+	if pq.limit != nil || pq.offset != nil || len(pq.order) > 0 {
+		return nil, fmt.Errorf("ent: Paginate does not support queries with order, limit or offset")
+	}
+	if first != nil && *first < 0 || last != nil && *last < 0 {
+		return nil, fmt.Errorf("ent: first and last must be non-negative")
+	}
+	dir := OrderDirectionAsc
+	for _, o := range orderBy {
+		switch o.Field {
+		case planet.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: unsupported order field %q for Planet", o.Field)
+		}
+		if o.Direction != OrderDirectionAsc && o.Direction != OrderDirectionDesc {
+			return nil, fmt.Errorf("ent: invalid order direction %q", o.Direction)
+		}
+		dir = o.Direction
+	}
+	total, err := pq.Clone().Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows are ordered by the id last, in order to have a total order.
+	orders := append(orderBy[:len(orderBy):len(orderBy)], PageOrder{Field: planet.FieldID, Direction: dir})
+	// A shallow copy keeps the eager-loading configuration of the query.
+	query := *pq
+	query.predicates = pq.predicates[:len(pq.predicates):len(pq.predicates)]
+	for _, c := range []struct {
+		cursor *Cursor
+		after  bool
+	}{{after, true}, {before, false}} {
+		if c.cursor == nil {
+			continue
+		}
+		p, err := pq.pagePredicate(c.cursor, orders, c.after)
+		if err != nil {
+			return nil, err
+		}
+		query.predicates = append(query.predicates, p)
+	}
+	// Pages that are selected only by last are fetched in the reversed order.
+	reverse := first == nil && last != nil
+	query.order = make([]OrderFunc, len(orders))
+	for i, o := range orders {
+		if (o.Direction == OrderDirectionAsc) != reverse {
+			query.order[i] = Asc(o.Field)
+		} else {
+			query.order[i] = Desc(o.Field)
+		}
+	}
+	switch {
+	case first != nil:
+		limit := *first + 1
+		query.limit = &limit
+	case last != nil:
+		limit := *last + 1
+		query.limit = &limit
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn := &PlanetConnection{TotalCount: total}
+	if first != nil && len(nodes) > *first {
+		conn.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	}
+	if last != nil && len(nodes) > *last {
+		conn.PageInfo.HasPreviousPage = true
+		if reverse {
+			nodes = nodes[:*last]
+		} else {
+			nodes = nodes[len(nodes)-*last:]
+		}
+	}
+	if reverse {
+		for i, j := 0, len(nodes)-1; i < j; i, j = i+1, j-1 {
+			nodes[i], nodes[j] = nodes[j], nodes[i]
+		}
+	}
+	conn.Edges = make([]PlanetEdge, len(nodes))
+	for i, node := range nodes {
+		c, err := node.pageCursor(orderBy)
+		if err != nil {
+			return nil, err
+		}
+		conn.Edges[i] = PlanetEdge{Node: node, Cursor: c}
+	}
+	if n := len(conn.Edges); n > 0 {
+		conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
+		conn.PageInfo.EndCursor = &conn.Edges[n-1].Cursor
+	}
+	return conn, nil
+}
+
+// pagePredicate returns a predicate for the rows that are positioned after (or before)
+// the given cursor in the given order. The last order field is the id of the entity.
+func (pq *PlanetQuery) pagePredicate(c *Cursor, orders []PageOrder, after bool) (predicate.Planet, error) {
+	if len(c.values) != len(orders)-1 {
+		return nil, fmt.Errorf("ent: cursor does not match the order fields")
+	}
+	values := make([]interface{}, len(orders))
+	for i, o := range orders {
+		raw := c.id
+		if i < len(c.values) {
+			raw = c.values[i]
+		}
+		v, err := pq.pageValue(o.Field, raw)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return predicate.Planet(func(s *sql.Selector) {
+		// (f1 > v1) OR (f1 = v1 AND f2 > v2) OR ...
+		or := make([]*sql.Predicate, len(orders))
+		for i, o := range orders {
+			and := make([]*sql.Predicate, 0, i+1)
+			for j := 0; j < i; j++ {
+				and = append(and, sql.EQ(s.C(orders[j].Field), values[j]))
+			}
+			if (o.Direction == OrderDirectionAsc) == after {
+				and = append(and, sql.GT(s.C(o.Field), values[i]))
+			} else {
+				and = append(and, sql.LT(s.C(o.Field), values[i]))
+			}
+			or[i] = sql.And(and...)
+		}
+		s.Where(sql.Or(or...))
+	}), nil
+}
+
+// pageValue decodes the cursor value of the given order field.
+func (*PlanetQuery) pageValue(field string, raw json.RawMessage) (interface{}, error) {
+	switch field {
+	case planet.FieldName:
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field name: %v", err)
+		}
+		return v, nil
+	case planet.FieldID:
+		var v int
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("ent: invalid cursor value for field id: %v", err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("ent: unsupported order field %q for Planet", field)
+}
+
+// pageCursor returns the cursor of the Planet node for the given order fields.
+func (pl *Planet) pageCursor(orderBy []PageOrder) (Cursor, error) {
+	id, err := json.Marshal(pl.ID)
+	if err != nil {
+		return Cursor{}, err
+	}
+	cur := Cursor{id: id, values: make([]json.RawMessage, len(orderBy))}
+	for _i, o := range orderBy {
+		var v interface{}
+		switch o.Field {
+		case planet.FieldName:
+			v = pl.Name
+		default:
+			return Cursor{}, fmt.Errorf("ent: unsupported order field %q for Planet", o.Field)
+		}
+		if cur.values[_i], err = json.Marshal(v); err != nil {
+			return Cursor{}, err
+		}
+	}
+	return cur, nil
+}
+
 // PlanetGroupBy is the builder for group-by Planet entities.
 type PlanetGroupBy struct {
 	config
-	fields []string
-	fns    []AggregateFunc
+	fields  []string
+	fns     []AggregateFunc
+	havings []*sql.Predicate
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -626,9 +1170,28 @@ func (pgb *PlanetGroupBy) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Having adds predicates for the HAVING clause of the group-by query. The predicates can reference
+// the grouped fields, or the aggregated values by their names (e.g. "count", or the name given by As).
+//
+//	client.Planet.Query().
+//		GroupBy(...).
+//		Aggregate(ent.Count()).
+//		Having(sql.GT("count", 100)).
+//		Scan(ctx, &v)
+//
+func (pgb *PlanetGroupBy) Having(ps ...*sql.Predicate) *PlanetGroupBy {
+	pgb.havings = append(pgb.havings, ps...)
+	return pgb
+}
+
 func (pgb *PlanetGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = pgb.withOperation(ctx, "Planet", "GroupBy")
+	selector := pgb.sqlQuery()
+	query, args := selector.Query()
+	if err := selector.Err(); err != nil {
+		return err
+	}
 	rows := &sql.Rows{}
-	query, args := pgb.sqlQuery().Query()
 	if err := pgb.driver.Query(ctx, query, args, rows); err != nil {
 		return err
 	}
@@ -641,15 +1204,30 @@ func (pgb *PlanetGroupBy) sqlQuery() *sql.Selector {
 	columns := make([]string, 0, len(pgb.fields)+len(pgb.fns))
 	columns = append(columns, pgb.fields...)
 	for _, fn := range pgb.fns {
-		columns = append(columns, fn(selector))
+		column := fn(selector)
+		// Name the aggregated values in order to reference them in the HAVING clause.
+		if len(pgb.havings) > 0 && !strings.Contains(column, " AS ") {
+			column = sql.As(column, strings.ToLower(strings.Split(column, "(")[0]))
+		}
+		columns = append(columns, column)
+	}
+	selector = selector.Select(columns...).GroupBy(pgb.fields...)
+	switch len(pgb.havings) {
+	case 0:
+	case 1:
+		selector.Having(pgb.havings[0])
+	default:
+		selector.Having(sql.And(pgb.havings...))
 	}
-	return selector.Select(columns...).GroupBy(pgb.fields...)
+	return selector
 }
 
 // PlanetSelect is the builder for select fields of Planet entities.
 type PlanetSelect struct {
 	config
 	fields []string
+	// query is the query that the fields are selected from.
+	query *PlanetQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -756,7 +1334,52 @@ func (ps *PlanetSelect) BoolsX(ctx context.Context) []bool {
 	return v
 }
 
+// Times returns list of times from selector. It is only allowed when selecting one field.
+func (ps *PlanetSelect) Times(ctx context.Context) ([]time.Time, error) {
+	if len(ps.fields) > 1 {
+		return nil, errors.New("ent: PlanetSelect.Times is not achievable when selecting more than 1 field")
+	}
+	var v []time.Time
+	if err := ps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// TimesX is like Times, but panics if an error occurs.
+func (ps *PlanetSelect) TimesX(ctx context.Context) []time.Time {
+	v, err := ps.Times(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// All executes the query and returns the Planet entities with only the selected fields scanned. It
+// avoids reading columns that are not needed (e.g. large JSON or blob fields). The id is always selected,
+// and the fields that were not selected keep their zero values.
+//
+//	nodes, err := client.Planet.Query().
+//		Select(planet.FieldName).
+//		All(ctx)
+//
+func (ps *PlanetSelect) All(ctx context.Context) ([]*Planet, error) {
+	query := *ps.query
+	query.fields = ps.fields
+	return query.All(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ps *PlanetSelect) AllX(ctx context.Context) []*Planet {
+	nodes, err := ps.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
 func (ps *PlanetSelect) sqlScan(ctx context.Context, v interface{}) error {
+	ctx = ps.withOperation(ctx, "Planet", "Select")
 	rows := &sql.Rows{}
 	query, args := ps.sqlQuery().Query()
 	if err := ps.driver.Query(ctx, query, args, rows); err != nil {
@@ -23,6 +23,9 @@ type PlanetUpdate struct {
 	hooks      []Hook
 	mutation   *PlanetMutation
 	predicates []predicate.Planet
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -31,6 +34,13 @@ func (pu *PlanetUpdate) Where(ps ...predicate.Planet) *PlanetUpdate {
 	return pu
 }
 
+// Limit limits the number of planets that are updated by the builder. For example,
+// for updating large amounts of planets in small batches without locking the whole table.
+func (pu *PlanetUpdate) Limit(limit int) *PlanetUpdate {
+	pu.limit = &limit
+	return pu
+}
+
 // SetAge sets the age field.
 func (pu *PlanetUpdate) SetAge(u uint) *PlanetUpdate {
 	pu.mutation.ResetAge()
@@ -58,6 +68,14 @@ func (pu *PlanetUpdate) ClearAge() *PlanetUpdate {
 	return pu
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database. Unlike ClearAge,
+// it does not set the field to NULL, and also removes a previous call to ClearAge.
+func (pu *PlanetUpdate) UnsetAge() *PlanetUpdate {
+	pu.mutation.ResetAge()
+	return pu
+}
+
 // AddNeighborIDs adds the neighbors edge to Planet by ids.
 func (pu *PlanetUpdate) AddNeighborIDs(ids ...int) *PlanetUpdate {
 	pu.mutation.AddNeighborIDs(ids...)
@@ -88,9 +106,33 @@ func (pu *PlanetUpdate) RemoveNeighbors(p ...*Planet) *PlanetUpdate {
 	return pu.RemoveNeighborIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (pu *PlanetUpdate) Unwrap() *PlanetUpdate {
+	tx, ok := pu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlanetUpdate is not a transactional builder")
+	}
+	pu.config.driver = tx.drv
+	pu.mutation.driver = tx.drv
+	return pu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (pu *PlanetUpdate) DebugFields() map[string]Value {
+	return debugFields(pu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (pu *PlanetUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(pu.driver); err != nil {
+		return 0, err
+	}
+	if err := pu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -104,6 +146,9 @@ func (pu *PlanetUpdate) Save(ctx context.Context) (int, error) {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
 			pu.mutation = mutation
+			if err := pu.checkImmutable(); err != nil {
+				return nil, err
+			}
 			affected, err = pu.sqlSave(ctx)
 			return affected, err
 		})
@@ -139,7 +184,29 @@ func (pu *PlanetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (pu *PlanetUpdate) check() error {
+	if err := pu.checkImmutable(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkImmutable fails the mutation if it sets, adds to or clears an immutable field.
+// It runs before the hooks and again after them, before the mutation is executed.
+func (pu *PlanetUpdate) checkImmutable() error {
+	for _, name := range []string{planet.FieldName} {
+		_, set := pu.mutation.Field(name)
+		_, added := pu.mutation.AddedField(name)
+		if set || added || pu.mutation.FieldCleared(name) {
+			return &ValidationError{Name: name, err: fmt.Errorf("ent: immutable field %q cannot be updated", name)}
+		}
+	}
+	return nil
+}
+
 func (pu *PlanetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = pu.withOperation(ctx, "Planet", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   planet.Table,
@@ -157,6 +224,12 @@ func (pu *PlanetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := pu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if pu.ids != nil {
+		_spec.ScanIDs = pu.ids
+	}
 	if value, ok := pu.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint,
@@ -215,7 +288,7 @@ func (pu *PlanetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{planet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -226,11 +299,33 @@ func (pu *PlanetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Planet entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (pu *PlanetUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	pu.ids = &ids
+	defer func() { pu.ids = nil }()
+	if _, err := pu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // PlanetUpdateOne is the builder for updating a single Planet entity.
 type PlanetUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *PlanetMutation
+	hooks      []Hook
+	mutation   *PlanetMutation
+	predicates []predicate.Planet
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (puo *PlanetUpdateOne) Where(ps ...predicate.Planet) *PlanetUpdateOne {
+	puo.predicates = append(puo.predicates, ps...)
+	return puo
 }
 
 // SetAge sets the age field.
@@ -260,6 +355,14 @@ func (puo *PlanetUpdateOne) ClearAge() *PlanetUpdateOne {
 	return puo
 }
 
+// UnsetAge removes the changes of the age field from the builder (e.g. a previous call
+// to SetAge), and therefore, the field is left unchanged in the database. Unlike ClearAge,
+// it does not set the field to NULL, and also removes a previous call to ClearAge.
+func (puo *PlanetUpdateOne) UnsetAge() *PlanetUpdateOne {
+	puo.mutation.ResetAge()
+	return puo
+}
+
 // AddNeighborIDs adds the neighbors edge to Planet by ids.
 func (puo *PlanetUpdateOne) AddNeighborIDs(ids ...int) *PlanetUpdateOne {
 	puo.mutation.AddNeighborIDs(ids...)
@@ -290,9 +393,33 @@ func (puo *PlanetUpdateOne) RemoveNeighbors(p ...*Planet) *PlanetUpdateOne {
 	return puo.RemoveNeighborIDs(ids...)
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (puo *PlanetUpdateOne) Unwrap() *PlanetUpdateOne {
+	tx, ok := puo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PlanetUpdateOne is not a transactional builder")
+	}
+	puo.config.driver = tx.drv
+	puo.mutation.driver = tx.drv
+	return puo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (puo *PlanetUpdateOne) DebugFields() map[string]Value {
+	return debugFields(puo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (puo *PlanetUpdateOne) Save(ctx context.Context) (*Planet, error) {
-
+	if err := checkTx(puo.driver); err != nil {
+		return nil, err
+	}
+	if err := puo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Planet
@@ -306,6 +433,9 @@ func (puo *PlanetUpdateOne) Save(ctx context.Context) (*Planet, error) {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
 			puo.mutation = mutation
+			if err := puo.checkImmutable(); err != nil {
+				return nil, err
+			}
 			node, err = puo.sqlSave(ctx)
 			return node, err
 		})
@@ -341,7 +471,29 @@ func (puo *PlanetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (puo *PlanetUpdateOne) check() error {
+	if err := puo.checkImmutable(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkImmutable fails the mutation if it sets, adds to or clears an immutable field.
+// It runs before the hooks and again after them, before the mutation is executed.
+func (puo *PlanetUpdateOne) checkImmutable() error {
+	for _, name := range []string{planet.FieldName} {
+		_, set := puo.mutation.Field(name)
+		_, added := puo.mutation.AddedField(name)
+		if set || added || puo.mutation.FieldCleared(name) {
+			return &ValidationError{Name: name, err: fmt.Errorf("ent: immutable field %q cannot be updated", name)}
+		}
+	}
+	return nil
+}
+
 func (puo *PlanetUpdateOne) sqlSave(ctx context.Context) (pl *Planet, err error) {
+	ctx = puo.withOperation(ctx, "Planet", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   planet.Table,
@@ -357,6 +509,13 @@ func (puo *PlanetUpdateOne) sqlSave(ctx context.Context) (pl *Planet, err error)
 		return nil, fmt.Errorf("missing Planet.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := puo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := puo.mutation.Age(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeUint,
@@ -416,9 +575,11 @@ func (puo *PlanetUpdateOne) sqlSave(ctx context.Context) (pl *Planet, err error)
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	pl = &Planet{config: puo.config}
-	_spec.Assign = pl.assignValues
-	_spec.ScanValues = pl.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return pl.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = pl.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, puo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{planet.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -426,5 +587,16 @@ func (puo *PlanetUpdateOne) sqlSave(ctx context.Context) (pl *Planet, err error)
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		pl.ID = id
+		if value, ok := puo.mutation.Name(); ok {
+			pl.Name = value
+		}
+		if value, ok := puo.mutation.Age(); ok {
+			pl.Age = value
+		}
+	}
 	return pl, nil
 }
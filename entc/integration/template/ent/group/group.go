@@ -17,8 +17,24 @@ const (
 	Table = "groups"
 )
 
+// FingerprintFields holds the fields of the Group type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldMaxUsers,
+}
+
 // Columns holds all SQL columns for group fields.
 var Columns = []string{
 	FieldID,
 	FieldMaxUsers,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -7,6 +7,8 @@
 package group
 
 import (
+	"fmt"
+
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/entc/integration/template/ent/predicate"
 )
@@ -177,6 +179,63 @@ func MaxUsersLTE(v int) predicate.Group {
 	})
 }
 
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:       "numeric",
+	FieldMaxUsers: "numeric",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Group.
+func FieldsEQ(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Group.
+func FieldsNEQ(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Group.
+func FieldsLT(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Group.
+func FieldsLTE(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Group.
+func FieldsGT(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Group.
+func FieldsGTE(f1, f2 string) predicate.Group {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Group using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Group {
+	return predicate.Group(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Group", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Group", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Group are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Group) predicate.Group {
 	return predicate.Group(func(s *sql.Selector) {
@@ -184,6 +243,9 @@ func And(predicates ...predicate.Group) predicate.Group {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -198,6 +260,9 @@ func Or(predicates ...predicate.Group) predicate.Group {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -208,3 +273,33 @@ func Not(p predicate.Group) predicate.Group {
 		p(s.Not())
 	})
 }
+
+// GroupFilter holds optional filters on the Group fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type GroupFilter struct {
+	IDIn     []int
+	MaxUsers *int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Group.Query().
+//		Where(group.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *GroupFilter) []predicate.Group {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Group
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.MaxUsers != nil {
+		ps = append(ps, MaxUsersEQ(*f.MaxUsers))
+	}
+	return ps
+}
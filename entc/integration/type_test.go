@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/facebookincubator/ent/entc/integration/ent"
+	"github.com/facebookincubator/ent/entc/integration/ent/card"
+	"github.com/facebookincubator/ent/entc/integration/ent/schema"
 
 	"github.com/stretchr/testify/require"
 )
@@ -87,4 +89,11 @@ func Types(t *testing.T, client *ent.Client) {
 	require.Equal(int64(math.MaxInt64), *ft.NillableInt64)
 	require.Equal(10.20, ft.Decimal)
 	require.False(ft.Datetime.IsZero())
+
+	crd := client.Card.Create().SetNumber("1234").SetType(schema.CardTypeVisa).SaveX(ctx)
+	require.Equal(schema.CardTypeVisa, crd.Type)
+	require.Equal(crd.ID, client.Card.Query().Where(card.TypeEQ(schema.CardTypeVisa)).OnlyX(ctx).ID)
+	require.False(client.Card.Query().Where(card.TypeIn(schema.CardTypeMC, schema.CardTypeAmex)).ExistX(ctx))
+	_, err := crd.Update().SetType("unknown").Save(ctx)
+	require.True(ent.IsValidationError(err), "enum values are validated")
 }
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x51\x5f\x6b\xdb\x3e\x14\x7d\xb6\x3e\xc5\xf9\x99\xfe\xa8\xdd\xa5\x4a\xdb\xb7\x0d\xf2\x50\xda\x0c\x32\xb6\x76\x90\xc2\x1e\xba\x52\x14\xfb\x3a\x11\x75\x24\xef\x4a\x29\x0b\x42\xdf\x7d\x48\x4e\xc2\xf6\x64\x4b\xe7\xdc\xf3\x47\x37\x84\xe9\x85\xb8\xb3\xc3\x9e\xf5\x7a\xe3\x71\x73\x75\xfd\xf1\x72\x60\x72\x64\x3c\x3e\xab\x86\x56\xd6\xbe\x61\x61\x1a\x89\xdb\xbe\x47\x26\x39\x24\x9c\xdf\xa9\x95\xe2\x69\xa3\x1d\x9c\xdd\x71\x43\x68\x6c\x4b\xd0\x0e\xbd\x6e\xc8\x38\x6a\xb1\x33\x2d\x31\xfc\x86\x70\x3b\xa8\x66\x43\xb8\x91\x57\x47\x14\x9d\xdd\x99\x56\x68\x93\xf1\xaf\x8b\xbb\xf9\xc3\x72\x8e\x4e\xf7\x84\xc3\x1d\x5b\xeb\xd1\x6a\xa6\xc6\x5b\xde\xc3\x76\xf0\x7f\x99\x79\x26\x92\xe2\x62\x1a\xa3\x10\x21\xa0\xa5\x4e\x1b\x42\xb9\x55\xda\x94\x88\x51\x4c\xa7\xb8\x4b\x79\xd6\x64\x88\x95\xa7\x16\xab\x3d\xce\xc9\xf8\xe6\x74\x75\x2e\x71\xff\x88\x87\xc7\x27\xcc\xef\x17\x4f\x52\x0c\xaa\x79\x53\x6b\x42\xd2\x10\x42\x6f\x07\xcb\x1e\x95\x28\x4a\xeb\x4a\x51\x94\xab\xbd\xa7\xf4\x13\x02\x3c\x6d\x87\x5e\x79\x42\x39\xb2\x5c\xb6\xcc\xd0\xc0\xda\xf8\x0e\xe5\xff\xbf\x4a\xc8\xef\x07\xc5\x18\x45\x9d\x63\x9e\xad\x94\x23\x7c\x9a\x21\x7f\x8f\x78\x9a\x7d\x57\x0c\xd7\x6c\x68\xab\x1c\x66\x78\x7e\x21\xe3\xe5\xc2\x78\xe2\x4e\x35\x14\xb2\x34\x2b\xb3\x26\x9c\xbd\x4e\x70\x66\xd4\x36\xcb\xc8\x07\xb5\x25\x97\xf4\x8b\x22\x84\xcb\x83\x7e\x8c\x32\x1d\x4e\x51\x5c\x88\xe5\x61\x26\xc6\x49\xd6\x22\xd3\xe2\x32\x46\x11\x85\xe8\x76\xa6\xc9\x9d\xab\x1a\x41\x14\x29\x48\xaf\x0d\x39\x3c\xbf\x3c\xbf\xa4\xd2\xa2\xe8\x2c\xe3\x75\x72\xc8\x97\x7c\xc7\x28\xc7\xbc\x41\x14\xc5\x6a\x02\x62\x4e\xd8\x37\xc5\x6e\xa3\xfa\x65\x06\xab\x91\x53\x8b\xa2\xd0\x5d\x66\xfc\x37\x83\xd1\x7d\x9e\x29\x3a\xa5\xfb\x8a\x98\x13\x9c\x2a\x8c\xbe\x33\xa8\x61\x20\xd3\x56\xf9\x38\xc1\xaa\x16\x09\xb5\x4e\x2e\x7d\x6b\x77\x5e\xfe\x60\xed\xa9\xca\xfb\x90\x5f\xac\x36\x47\xe2\x18\xb7\x2a\x7f\x9a\xb2\xae\xeb\x53\xb7\xa3\x4b\xb2\xb7\x9c\x4b\x8e\x5a\xc4\x3c\x6a\x2d\x3d\x6b\xb3\x4e\x1c\x39\x4f\x9c\xaa\xfe\x90\x45\x32\x71\xfe\x5b\xfb\xea\x3a\xcb\xfd\xb3\xfa\xb1\xd9\xb8\xf9\xc3\x8b\xc6\x28\xfe\x04\x00\x00\xff\xff\x95\x06\x0f\xa4\x50\x03\x00\x00")
+var _templateMainTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\x51\x6b\xdb\x3c\x18\x85\xaf\xad\x5f\x71\x3e\xd3\x8f\xda\x5d\xaa\xb4\xbd\xdb\x20\x17\xa5\xcd\x20\x63\x6b\x07\x29\xec\xa2\x2b\x45\xb1\x5f\x27\xa2\x8e\xe4\xbd\x52\xca\x82\xd0\x7f\x1f\x92\x93\xb0\x5d\x25\xd6\x39\x7a\xce\x39\x28\x84\xe9\x85\xb8\xb3\xc3\x9e\xf5\x7a\xe3\x71\x73\x75\xfd\xf1\x72\x60\x72\x64\x3c\x3e\xab\x86\x56\xd6\xbe\x61\x61\x1a\x89\xdb\xbe\x47\x36\x39\x24\x9d\xdf\xa9\x95\xe2\x69\xa3\x1d\x9c\xdd\x71\x43\x68\x6c\x4b\xd0\x0e\xbd\x6e\xc8\x38\x6a\xb1\x33\x2d\x31\xfc\x86\x70\x3b\xa8\x66\x43\xb8\x91\x57\x47\x15\x9d\xdd\x99\x56\x68\x93\xf5\xaf\x8b\xbb\xf9\xc3\x72\x8e\x4e\xf7\x84\xc3\x19\x5b\xeb\xd1\x6a\xa6\xc6\x5b\xde\xc3\x76\xf0\x7f\x85\x79\x26\x92\xe2\x62\x1a\xa3\x10\x21\xa0\xa5\x4e\x1b\x42\xb9\x55\xda\x94\x88\x51\x4c\xa7\xb8\x4b\x7d\xd6\x64\x88\x95\xa7\x16\xab\x3d\xce\xc9\xf8\xe6\x74\x74\x2e\x71\xff\x88\x87\xc7\x27\xcc\xef\x17\x4f\x52\x0c\xaa\x79\x53\x6b\x42\x62\x08\xa1\xb7\x83\x65\x8f\x4a\x14\xa5\x75\xa5\x28\xca\xd5\xde\x53\xfa\x13\x02\x3c\x6d\x87\x5e\x79\x42\x39\xba\x5c\x8e\xcc\xd2\xc0\xda\xf8\x0e\xe5\xff\xbf\x4a\xc8\xef\x07\x62\x8c\xa2\xce\x35\xcf\x56\xca\x11\x3e\xcd\x90\x7f\x8f\x7a\xba\xfb\xae\x18\xae\xd9\xd0\x56\x39\xcc\xf0\xfc\x42\xc6\xcb\x85\xf1\xc4\x9d\x6a\x28\x64\x34\x2b\xb3\x26\x9c\xbd\x4e\x70\x66\xd4\x36\x63\xe4\x83\xda\x92\x4b\xe1\x45\x11\xc2\xe5\x81\x1f\xa3\x4c\x1f\xa7\x2a\x2e\xc4\xf2\x70\x27\xc6\x49\x66\x91\x69\x71\x19\xa3\x88\x42\x74\x3b\xd3\xe4\xcd\x55\x8d\x20\x8a\x54\xa4\xd7\x86\x1c\x9e\x5f\x9e\x5f\xd2\x68\x51\x74\x96\xf1\x3a\x39\xf4\x4b\xb9\x63\x95\x63\xdf\x20\x8a\x62\x35\x01\x31\x27\xed\x9b\x62\xb7\x51\xfd\x32\x8b\xd5\xe8\xa9\x45\x51\xe8\x2e\x3b\xfe\x9b\xc1\xe8\x3e\x25\x15\x45\xa7\x74\x5f\x11\x73\x92\xd3\x84\x31\x77\x06\x35\x0c\x64\xda\x2a\x7f\x4e\xb0\xaa\x45\x11\x45\x61\x9d\x5c\xfa\xd6\xee\xbc\xfc\xc1\xda\x53\x95\xaa\x39\xf9\xc5\x6a\x73\x34\x8e\x75\xab\xf2\xa7\x29\xeb\xba\x3e\x6d\x3b\xa6\xa4\x78\xcb\x79\xe4\xc8\x22\xe6\x91\xb5\xf4\xac\xcd\x3a\x79\xe4\x3c\x79\xaa\xfa\x43\x86\xe4\xd0\xf9\x6f\xed\xab\xeb\x8c\xfb\xe7\xe9\xc7\x65\xe3\xcb\x87\x00\x32\x2d\x62\x14\x7f\x06\x00\x95\x06\x0f\xa4\x50\x03\x00\x00")
 
 func templateMainTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -98,7 +98,7 @@ func templateMainTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\xef\x6f\xdc\x36\xef\x7f\x7d\xfe\x2b\xd8\x00\x0d\xec\xe2\xe6\xeb\x86\x61\xf8\x7e\xaf\xcf\x3d\xc0\xb0\xb5\xd8\x3d\x7b\x9a\x16\x6b\xbb\x37\x45\xd1\x39\xb6\x7c\xa7\xc6\x96\x5d\x4b\x4e\x73\xcb\xf2\xbf\x3f\x20\x29\xd9\xb2\xcf\x77\xe9\x8f\xe4\xde\xc4\xa6\x48\x91\xfc\x88\x22\x29\x39\x8b\x05\xfc\x52\xd5\xbb\x46\x6e\xb6\x06\x7e\x78\xfc\xfd\xff\x7f\x57\x37\x42\x0b\x65\xe0\x59\x92\x8a\xf3\xaa\xba\x80\xb5\x4a\x63\xf8\xb9\x28\x80\x98\x34\xe0\x78\x73\x29\xb2\x38\x58\x2c\xe0\xf5\x56\x6a\xd0\x55\xdb\xa4\x02\xd2\x2a\x13\x20\x35\x14\x32\x15\x4a\x8b\x0c\x5a\x95\x89\x06\xcc\x56\xc0\xcf\x75\x92\x6e\x05\xfc\x10\x3f\x76\xa3\x90\x57\xad\xca\x70\x0a\xa9\x88\xe5\xbf\xeb\x5f\x9e\x9e\xbd\x7a\x0a\xb9\x2c\x84\xa3\x35\x55\x65\x20\x93\x8d\x48\x4d\xd5\xec\xa0\xca\xc1\x78\xfa\x4c\x23\x44\x1c\x04\x75\x92\x5e\x24\x1b\x01\x45\x95\x64\x41\x20\xcb\xba\x6a\x0c\x84\xc1\xec\x44\xa8\xb4\xca\xa4\xda\x2c\x3e\xe8\x4a\x9d\x04\xb3\x93\xbc\x34\xf8\xa7\x11\x79\x21\x52\x73\x12\x04\xb3\x93\x8d\x34\xdb\xf6\x3c\x4e\xab\x72\x91\x5b\x87\xa5\x4a\xdb\xf3\xc4\x54\xcd\x42\x28\xe2\xbf\x8d\x67\xa1\xd3\xad\x28\x93\x85\xc8\x36\xe2\x4b\xf8\x73\x29\x8a\xec\x4b\x04\xa4\xca\xc4\xd5\x49\x10\x05\x08\xdb\x2b\xa2\x41\x23\xec\x82\x69\x48\x14\x08\x65\x62\x3b\x60\xb6\x89\x81\x4f\x89\x26\x5c\x44\x06\x79\x53\x95\x90\x40\x5a\x95\x75\x21\x71\x71\xb4\x68\xc0\x62\x17\x07\x66\x57\x0b\x37\xa5\x36\x4d\x9b\x1a\xb8\x0e\x66\x67\x49\x29\x00\x00\x29\x52\x6d\x80\x7e\x7f\x21\x9a\xcb\x13\x95\x94\x62\x5e\x95\xd2\x88\xb2\x36\xbb\x93\xbf\x82\xd9\x2f\x95\xca\xe5\x06\xc8\x06\xf7\x6c\x99\x53\x7a\x1d\xb2\x3f\xcd\x36\x42\x03\xc0\xdb\x77\x8f\xf0\xd1\x9f\x1b\x81\xd4\x43\xee\x67\x88\x95\x26\x6e\x7a\xf4\xb8\x09\xc6\x11\xfb\x1a\x91\x12\x1a\xd9\xe9\xd1\x63\x97\x3c\x34\xe4\xff\xad\xaa\x2e\xac\x31\x2f\x2b\x2d\x8d\xac\x94\xe3\xdf\xe2\xd0\x90\xfb\x65\x55\xc8\x74\x07\x70\x5e\x55\x05\xc0\x00\x96\x9a\x86\x06\xec\x37\xb4\x5c\xdd\xb4\x99\xd0\x69\x23\xcf\x85\x86\x04\xc8\x74\xa8\xdd\x90\x8d\x7a\x5e\x6d\xbb\x26\x9d\x5c\xbf\x2a\x9d\x47\x00\x52\x19\x80\xc5\x02\x18\x13\x72\xcd\xcd\xc2\x73\x17\x52\x9b\x38\x98\x3d\x97\x57\x22\x5b\x2b\x14\x21\xa3\x17\x0b\x58\xab\x4c\xa6\x89\x11\x1a\x64\xee\x09\x60\xc4\x94\xc8\xfd\x9d\x54\x2c\x28\xd5\xda\xce\xcb\xba\x88\x34\xd4\x55\x12\x89\x75\xb1\xbb\x6c\xd0\x7e\x70\x32\xfd\x2b\x62\x93\x05\xf7\x43\x93\x7f\x7e\x80\xde\x12\xa6\x6b\x95\x57\x3d\xdb\x23\xf2\x3a\x7e\xbd\xab\x85\x1d\xb0\x82\xa8\x74\x28\xf8\x3a\xf1\x15\x1c\xd4\x68\x92\x51\xa0\xbf\x92\x7f\x7b\x96\x3e\x92\xca\xfc\xf4\xe3\x84\x9c\x96\x7f\x8f\x14\x3e\x55\x6d\xa9\x3b\xb6\xb7\xef\xc6\x2a\xdd\x6e\x41\xb6\xa1\xe4\x1b\x25\x3f\xb6\x9d\x52\x3f\x4c\x07\x92\x2d\xb1\x0d\x45\xcf\x64\x51\x24\xe7\x85\xb8\x45\x54\x59\xb6\xa1\xf0\x8b\x1a\x43\x35\x29\x6e\x11\xae\x2c\xdb\x50\xf8\x57\x91\x27\x6d\x61\x6e\x33\x3a\x63\xb6\x49\xd9\x3f\x93\x02\xdd\x96\xca\x88\x06\x33\xe9\xf5\xcd\xa4\xec\xfb\x4b\xe4\x1b\x41\x56\x67\x89\x11\xce\x86\xc3\x90\x11\xdb\xfb\x49\x23\xd6\x65\xd9\x9a\x0e\xbb\x83\x53\x48\xc7\x36\x94\xfe\x33\x29\x64\x86\x19\x9f\x96\x9c\x36\xdb\x94\xf4\x65\xc7\x36\x8a\x32\x53\x35\xc9\x46\xfc\x2e\x76\x70\x2c\x3a\x35\xb3\xbd\xbf\x10\xbb\x71\x4e\xb3\x79\x86\x7e\x8f\x86\xaf\x7e\x7e\x63\xfa\x48\xb9\x50\x48\xbe\xbc\xc5\x73\xed\xd8\x46\xd2\x94\xef\x70\x0b\x22\x6f\x99\xd4\x6f\xd9\x7c\x17\xf0\x4e\x9a\xd8\xde\xef\x6d\x4c\x4e\x38\x54\x43\xf6\xf3\x0d\x91\xbf\x22\xdd\x90\xdc\x44\xb6\x19\x9a\xb4\x9f\x5d\x9c\x17\x23\xc6\x23\xd9\x64\xc4\x38\xce\x1e\x7f\x88\x9c\x95\x0f\xf9\x1a\x91\xbf\xdf\xd7\xfe\x87\xc8\xed\xfa\x71\x49\xed\x99\x0f\xe4\x07\xbb\x54\x47\xf2\xc1\x5a\x5d\x8a\x46\x8b\x31\xab\x64\xf2\x58\xfd\xc7\x56\x36\x22\x1b\xf1\x36\x96\x3c\xb1\x6a\x5c\x59\xf6\x97\x8d\xe9\x5f\xb1\x6e\x2c\xd8\x2f\x9c\x97\x09\xbb\xb0\x3c\xe2\xad\x6b\x4a\xfc\x7c\x7b\x7b\x53\x32\xc1\x3d\xd5\x94\x78\x5b\xb4\xdb\x9f\xb7\x6c\x4b\x46\xe9\x4c\x7c\xa2\xf5\x4c\x1b\x41\x05\x3b\x51\x0e\x11\x34\x8a\x61\xa1\x27\xee\x2d\x6a\x53\x35\x71\x90\xb7\x2a\x75\x92\xa1\xc8\xe0\x11\x72\xc4\xbf\x76\x1c\x91\x0d\x92\xeb\x60\xa6\x04\x2c\x57\x70\x8a\xaf\xd7\xc1\x0c\x43\x73\xc9\x18\x88\x2c\x7e\x9d\x6c\xe6\x48\xdb\xd5\x62\xd9\xd1\x30\x9a\x83\x19\xed\x8a\x8e\x88\x2f\x48\x64\xc4\x97\x4c\xe4\x17\x24\xdb\x38\x5a\x12\xd9\xbe\x20\xdd\xc5\xcc\x12\xe9\xee\x85\x07\x72\x3b\x3f\x0d\xe4\x76\xfe\x9b\x60\x26\x73\x68\x44\x8e\x26\xf3\xc8\x13\x7a\x7d\xb0\x02\x25\x0b\x74\x67\xa6\x04\x92\x61\xd5\xb9\xdf\x88\x3c\x22\xd1\x46\x98\xb6\x51\xa0\x44\x8f\x2c\x37\x16\xfb\xd0\x72\x3b\x44\xd8\xf2\xe3\x14\xb8\x24\x1c\xe6\x99\xeb\x23\x7c\x78\x43\xee\x54\xe7\x20\x9a\x06\xdf\xaf\x83\x99\x26\xab\x4f\x89\x7e\x3d\x00\x90\x7e\x79\x8f\x22\x36\x23\xc3\x11\xa4\xcc\x07\xab\xe3\x46\xec\x12\x51\xbb\xb0\xf4\x07\x88\x32\x5c\x13\x37\xd4\x2f\x8c\x2b\xf8\xcb\xde\x06\x57\xdb\x83\x59\x57\xd1\xfb\x51\x47\xc1\x51\x5b\x2e\x97\xfd\xbc\xae\x80\xf2\x6a\x90\x6e\xbf\xb0\x2e\x49\xf7\xa0\xd4\xf6\x9c\x5d\xfd\x5c\x76\x3e\x77\xa5\x32\x98\x79\xdb\x67\x69\x87\x7b\x0a\x8e\xf7\x05\x94\xc6\x0b\xa1\xc2\x3c\x8b\x7b\x6a\x44\x93\xb8\x12\xd4\xe9\xe8\x28\x34\xdc\x95\xa2\x4e\x47\x47\xe9\x82\x4f\xe7\xb4\x18\xb0\xea\x23\xce\xc5\x95\x2c\xe6\x90\x97\x26\x7e\x8a\x4b\x9e\x87\x27\xa5\xd4\x1a\x37\x3a\xe5\x26\x89\x42\x79\xd5\xd8\x78\x7a\xf8\xf1\x64\x8e\x73\xe1\x92\x47\xdd\xdc\xd8\x2c\x2e\x57\x40\x5d\x22\xda\x8f\xdd\x63\xf4\x84\xe9\x0f\x56\xf0\x98\xd4\xe9\x9c\xe8\xb0\x82\x53\x1c\x20\x61\xcc\xa6\xdc\xc8\xdb\xe6\x04\xa8\xcb\x81\x34\x51\x70\x2e\x80\x0e\xc3\x22\x03\x53\x11\xcf\x46\x28\xd1\x24\x14\xcb\x28\xf9\xac\x6a\x40\x5c\x25\x65\x5d\x88\x39\xa8\xca\xe0\xd9\xa4\x55\x29\x75\x00\x85\xbc\x10\x60\x64\x29\xe2\xb3\xea\x53\x4c\x56\xbe\xa7\xa0\x46\x3b\x31\x7d\xc5\xcf\x93\x46\x6f\x93\x22\xec\xd7\x3f\x7a\x42\x0c\x1e\x42\x3a\x8f\x07\x4d\xda\xca\x8b\x16\xe7\xbc\x8d\x72\xca\x2f\x28\xdb\xf7\xe6\x6f\xde\xac\x7f\x85\xd3\xd3\xfd\x08\xa3\xb9\xcd\xae\x46\x5b\xec\xb9\x9e\x04\x5e\xe4\xbe\x35\xc1\x0c\xa7\x37\xbb\x3a\xfe\x5d\xaa\x2c\x8c\x50\xd8\x71\x3f\xc3\xad\xfc\xcf\x3f\x34\x7a\xd6\x96\x6b\xc5\xc3\x8f\x3d\xda\x8b\xd6\x30\xf1\x7b\x47\x44\xca\xe3\x28\x7e\x45\x69\x9c\xc7\x9c\xf1\x1d\x0d\x2d\x3b\x18\x18\xe2\xaa\x16\xa9\xe1\xb8\x08\x11\xea\x30\x82\x87\x3a\xa2\xf0\x68\x5b\x99\x0d\x17\xf1\x64\xbe\x37\x3d\xfa\x74\xe3\xe7\x34\x9d\xcf\x51\x4d\x9f\xd8\xb8\x14\xee\x27\x36\x3e\xb9\x51\x62\xe3\xc7\xa9\xc4\x46\xc2\xa1\xcc\xae\xf0\xc0\x92\x89\xab\x61\xe1\xe0\xa9\xaf\x3b\xdd\xa7\x44\x40\x87\xa9\x80\xda\x7c\x20\xb3\x2b\xea\xbf\x28\x05\x71\xad\x5c\x76\x03\xfc\x3e\x4e\x4e\x38\xd2\xa7\x26\x7f\xc7\xe3\xc8\x60\xbf\xdf\x58\x4f\x6d\xf0\xd9\xbb\x0b\x0e\x73\x0a\x71\xef\x2e\xa4\x3b\x10\xe0\x53\x05\x09\xfc\xe7\xd5\x8b\x33\x14\xa6\x0e\xc3\xee\x90\x4c\xf0\x0e\x21\x16\x9c\xc0\x0a\x57\xe7\x1f\x70\xa9\xf8\x8f\x45\x68\xa0\x34\xd4\x4e\x37\x36\x2e\x56\x53\x04\xe1\x39\xbc\x7d\x77\xbe\x33\x82\x37\x8b\x57\x05\xa8\x08\xb0\x2c\x62\xc6\x97\x25\x4b\x77\xee\xe7\xd7\x30\xf2\x2b\xac\x54\x7c\x0b\x16\x8e\x62\x9c\x45\xa2\x88\x92\x08\x89\xf0\x4e\xb2\xbb\x53\xc7\xb8\xe6\x74\x60\x77\xac\xbc\x31\x1f\xdc\x9e\xba\xac\x53\x0f\x3f\x2e\xe1\xe1\x25\x66\x2a\xae\x4d\x28\x1e\x4d\xaa\xe1\x15\xbd\x7b\x3d\xdc\x78\x75\xba\x92\x5c\x50\x50\x39\x45\x9d\x21\x77\xa1\x0b\xb7\x1f\x26\x37\x4a\x27\x89\xda\x08\xea\xab\x34\x67\x30\x0e\x66\x58\x41\x52\xd7\x42\x65\xa1\x25\xcc\xfb\x2e\xcb\xdb\x25\x61\x14\x59\x98\xec\x7d\x93\xef\x80\xbd\x9e\xba\x4f\x17\x70\xeb\x76\x4e\x58\x1b\xac\x1b\xee\x72\xcc\x73\x64\xed\x8c\xf4\xb7\xfe\xa4\x37\xa3\x45\xa7\x8b\xb3\xfb\x8f\x2d\xbe\x71\xbb\x7b\x3d\x56\x70\x50\xc5\x74\x64\x33\xcb\x1b\x55\x0e\x72\x0b\x27\x08\xcd\xf5\x53\x5e\x0a\x05\xe7\x6d\x9e\x8b\x06\x28\xa5\xd8\xec\xea\x2e\xef\x28\x4d\x8c\x66\x08\xcf\xdb\xdc\xe6\x04\x6c\x0f\x99\x38\x3f\x94\x19\x06\x30\x90\x85\xdd\x74\x38\xd1\x1c\xf4\x71\x20\x44\xd3\xf8\x01\x91\xf7\xe1\xa0\x6d\xf6\x25\x91\x5e\x47\x1e\xdb\xa2\xa3\xc3\xfd\x99\xf7\xa7\x1e\x95\x1f\xbf\xfa\x74\x59\x87\x9e\xb4\xbd\x1f\x34\x95\x45\xc7\x9e\x58\xfc\x74\x69\x01\x0b\x35\x58\x58\x22\x18\xa7\xae\x71\x7e\x25\xd8\xd0\x36\x9a\x7d\xb0\xbf\x06\x19\xef\xc8\xee\xf2\x21\x92\x73\x28\xbd\x2d\xc3\x26\xd3\x69\x02\x8f\xdf\xd4\x92\x4d\xe7\xe0\xf2\xaa\xcb\xbf\xc1\x6c\x66\x0f\x7e\xbe\x35\x36\x31\x96\x57\x51\x0f\xf7\x04\xb2\xc3\xbe\x11\xb5\x77\x71\xab\xbc\xa8\x45\x7b\xc9\xe0\x0f\x83\x35\xcd\xfb\x15\x9d\x61\x2b\x60\xf5\xf7\x67\x94\xe1\x6e\x46\xb6\x09\x53\xbe\xd4\x16\x32\x06\x7b\xbb\xee\xbe\x68\x05\xa7\xee\x99\x67\xa4\x74\x62\x3b\x82\x0f\x73\x22\xd9\xdb\x68\x22\x9a\x86\x6b\xfd\xcc\xbb\x6a\x5e\x82\x9c\xf7\x93\xbb\x60\xf5\xd2\x95\x6d\x1e\x40\xe7\x0e\x90\x43\x45\xe2\xae\x41\x3f\x54\x1c\xbe\xaa\x3a\xd0\xac\xc7\xea\xc3\x3d\x58\x7f\xb0\x2e\x7c\x4b\x61\x20\x05\xfc\xa1\xc4\x77\x83\x8b\xc3\x9d\xc7\x7d\x6f\x3f\xa9\x74\xd6\xf3\x37\x1c\xcf\xf6\xdf\xd8\xa0\x3b\x8c\xc7\xbd\xa6\x7b\x98\xf2\x6c\xa0\x72\xce\xe3\x43\xde\x57\xe4\xbc\x41\x1f\x75\x30\xe9\x1d\xce\x33\x5f\x9c\xf6\xa6\xb3\xc8\xe7\x25\x91\xc3\xcb\xda\xd5\x88\x83\xe9\xc1\x61\x4b\x3c\xb7\xed\xf2\x3d\xcc\x27\xb1\xf3\xdb\x91\x83\xd0\x1d\x0a\xd4\x2f\x04\x6e\x2a\x0c\x3f\x37\x0a\xbb\x20\xe4\xc0\xea\x02\x30\x4f\x0a\xbe\x16\xbb\xf9\x6c\x97\x07\xad\xd1\x41\x9f\xed\x77\x49\xdf\xe9\x61\x4f\xf5\x19\x5e\xeb\xd8\x7e\xf8\x5c\x01\x4f\x67\x79\xa7\xcd\xcc\x81\xef\xbf\x22\xe8\xbb\x8a\xde\x1e\x99\xc3\x83\xee\x46\x00\x4f\xd5\x0f\xf8\x52\x05\x8f\xdb\xa2\x91\xa9\x3d\x3f\x7b\x13\xa3\x05\x6a\x0e\xd5\x05\xb7\x2a\xfe\x65\x42\x1c\xe6\x45\x95\x98\x9f\x7e\x64\x2f\x1e\x54\x17\xbe\xb0\x9f\x5f\x5a\xc5\x07\x6f\x31\x3a\x60\xf3\x41\xbc\xbb\x9b\x59\xf2\xe5\x8c\x7f\x37\xa3\x3f\x49\x93\x6e\xc1\xb0\xf6\xee\x9a\xe2\x09\x6a\x4a\x13\x2d\xc0\xc0\xbf\xfd\x1b\x8b\xb5\x32\xff\x07\xa7\xa7\x60\xe0\x5f\x23\xf2\x4f\x3f\x2e\x31\x93\x8d\xaf\x43\xf8\xc6\x47\x45\xd3\xd3\xbd\x91\xd3\xf3\xbd\x91\x07\x27\x6c\xfb\x19\xa7\x12\x56\x9f\x31\xe0\x53\x93\xd4\xda\xff\xf4\x6c\xe9\x89\xca\xb8\x0f\x72\x84\x52\x98\x6d\x95\xc1\x27\x69\xb6\xd0\x88\xb4\xba\xe4\xe6\x57\x28\xdd\x36\x02\x54\x05\x75\xa2\x64\xaa\x41\x2a\xb0\x9d\xaa\x54\x1b\x9b\xe6\xbc\x0c\x95\x67\xde\x27\x3a\xb0\xc4\x08\xde\xbe\xeb\xbf\x10\xdf\x44\x10\xda\x64\xe4\x91\xc7\x27\xe9\x4c\x60\xfb\x6d\xaf\x4f\x6c\x33\x7b\xc9\x57\x41\x64\x1c\xf6\xb1\x97\x83\xe4\x44\xb7\x52\x83\x90\x78\xf8\xda\x79\xc7\xc6\xdb\xd2\x93\x67\x73\xb8\xa4\x16\x27\x77\x89\x89\xa2\x90\xf2\x3f\x76\x7a\x2e\xba\xb2\xd8\x39\x30\x1f\xa1\xcb\x0d\xc1\x1e\xb8\x4c\xfe\x56\x28\xfd\x33\xb0\x8f\x26\xd3\x1d\x98\x74\xc3\x8f\x58\x72\xa7\xd2\x13\xef\x03\xc9\x81\x7f\x03\x30\x19\x48\x61\x1b\xa4\x49\x1c\x7d\xe1\x7d\x28\x5d\x67\xb2\x07\xa6\x1b\xf8\x56\x38\x87\x27\x72\x1f\x50\x37\xe2\x20\xe5\xbb\x2f\xc4\x54\x76\xff\x64\xd2\xd1\xef\x11\x56\xe7\xe9\x04\xb0\xb2\xeb\xdb\x8e\x41\xdb\x39\x32\x06\x97\x4f\x6a\x7b\xd0\x32\xf9\x5b\x81\x3d\x76\x82\x0b\xb9\xdd\x63\xfc\x9e\xf7\xa7\xb8\x7b\xc1\x8f\xdd\x99\x40\x8f\x8d\x38\x8e\x1d\x7b\xb1\x87\x1c\x17\xfb\x3d\xe4\x98\xfc\xad\xc8\x0d\x7a\x19\x2f\x20\x99\xee\xc2\x11\xdf\x28\x1a\xb9\x09\xe9\x89\xf7\x08\x25\xfb\x37\x01\xe5\xd6\x36\x3f\xc7\xa0\xb4\xe6\x8f\xa1\xb4\xad\xc5\x1e\x96\x96\xfe\xad\x60\x1e\xed\x92\x42\xdb\xce\x20\xf9\xa5\xd7\x28\xdd\x0b\x78\xd6\xa1\x09\xf4\x6a\xd7\x5d\x1d\x83\xcf\x3a\xd2\xe3\x47\x2e\x76\x77\x13\x66\xf0\x15\x24\x1a\xbc\xd1\xb1\xa1\x6a\xc0\xb8\xaf\x20\xab\xfe\x2b\xc8\x4b\xd3\xf0\xa7\x14\x58\x81\x89\x9f\x16\xa2\x0c\x07\x7d\x83\x09\x6e\x82\xff\x05\x00\x00\xff\xff\x76\x23\x4a\xaa\x2c\x2a\x00\x00")
+var _schemaGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x5a\x5f\x73\xdb\x36\x90\x7f\x16\x3f\xc5\xc6\x33\xf1\x90\x19\x95\x4a\x3b\x99\xcc\x9d\x72\xea\x4c\x2f\x71\xef\x7c\x6d\x9d\x4c\x9d\xf4\x25\x93\x71\x69\x72\x29\x21\x26\x41\x95\x00\x1d\xbb\x89\xbf\xfb\xcd\xe2\x1f\x01\x8a\x92\xe5\xd8\x4e\x1e\x4c\x2e\x76\x17\xbb\x3f\x2c\x16\xbb\xa0\x66\x33\x78\xdd\xac\xaf\x5b\xb6\x5c\x49\xf8\xe9\xf9\x8f\xff\xf9\xc3\xba\x45\x81\x5c\xc2\xaf\x59\x8e\xe7\x4d\x73\x01\xc7\x3c\x4f\xe1\x97\xaa\x02\xc5\x24\x80\xc6\xdb\x4b\x2c\xd2\x68\x36\x83\xf7\x2b\x26\x40\x34\x5d\x9b\x23\xe4\x4d\x81\xc0\x04\x54\x2c\x47\x2e\xb0\x80\x8e\x17\xd8\x82\x5c\x21\xfc\xb2\xce\xf2\x15\xc2\x4f\xe9\x73\x3b\x0a\x65\xd3\xf1\x82\x54\x30\xae\x58\x7e\x3f\x7e\x7d\x74\x72\x7a\x04\x25\xab\xd0\xd2\xda\xa6\x91\x50\xb0\x16\x73\xd9\xb4\xd7\xd0\x94\x20\xbd\xf9\x64\x8b\x98\x46\xd1\x3a\xcb\x2f\xb2\x25\x42\xd5\x64\x45\x14\xb1\x7a\xdd\xb4\x12\xe2\x68\x72\x80\x3c\x6f\x0a\xc6\x97\xb3\xcf\xa2\xe1\x07\xd1\xe4\xa0\xac\x25\xfd\x69\xb1\xac\x30\x97\x07\x51\x34\x39\x58\x32\xb9\xea\xce\xd3\xbc\xa9\x67\xa5\x71\x98\xf1\xbc\x3b\xcf\x64\xd3\xce\x90\xcb\x83\x3d\x78\x66\x22\x5f\x61\x9d\xcd\xb0\x58\xe2\x5d\xf8\x4b\x86\x55\x71\x17\x01\xc6\x0b\xbc\x3a\x88\x92\x88\x60\x3b\x55\x34\x68\xd1\x2c\x98\x80\x8c\x03\x72\x99\x9a\x01\xb9\xca\x24\x7c\xc9\x84\xc2\x05\x0b\x28\xdb\xa6\x86\x0c\xf2\xa6\x5e\x57\x8c\x16\x47\x60\x0b\x06\xbb\x34\x92\xd7\x6b\xb4\x2a\x85\x6c\xbb\x5c\xc2\xd7\x68\x72\x92\xd5\x08\x00\x20\x64\xcb\xf8\x92\x9e\x00\xfe\x26\x34\xe7\x07\x3c\xab\x71\xda\xd4\x4c\x62\xbd\x96\xd7\x07\x7f\x47\x93\xd7\x0d\x2f\xd9\x12\x94\x0d\xf6\xd9\x30\xe7\xea\x35\x64\x3f\x2a\x96\x28\x00\xe0\xe3\xa7\x67\xf4\xe8\xeb\x26\x20\x45\xc8\xfd\x2b\x61\x25\x14\xb7\x7a\xf4\xb8\x15\x8c\x03\xf6\x63\x42\x0a\x05\xb1\xab\x47\x8f\x5d\x81\x38\x54\xff\xbf\x4d\x73\x61\x8c\x79\xd7\x08\x26\x59\xc3\x2d\xff\x8a\x86\x42\xee\x77\x4d\xc5\xf2\x6b\x80\xf3\xa6\xa9\xc0\xfc\x33\xdc\x6b\x35\x14\xb0\xdf\xa8\xe5\x72\x6a\x0b\x14\x79\xcb\xce\x51\x40\x06\xca\x74\x58\xdb\x21\x13\xf5\x3a\x9c\xcc\x9a\x38\xb9\x7e\x55\x9c\x47\x00\x8c\x4b\x80\xd9\x0c\x34\x26\xca\x35\xab\x45\xeb\xae\x98\x90\x69\x34\xf9\x83\x5d\x61\x71\xcc\x49\x46\x19\x3d\x9b\xc1\x31\x2f\x58\x9e\x49\x14\xc0\x4a\x4f\x80\x22\xa6\x26\xee\x1f\x18\xd7\x82\x8c\x1f\x1b\xbd\x7a\x2e\x45\x0a\xe7\xaa\x15\x49\xcf\xa5\xdd\xd5\x06\x6d\x06\xa7\xa6\x7f\x47\x6c\x6a\xc1\xcd\xd0\x04\x18\x06\xa8\xf7\x6f\x6b\xac\x1e\xf3\xb2\xb1\x4c\x00\xcf\x94\xeb\xe9\xfb\xeb\x35\xfa\x03\x46\x9a\xa6\x0f\xa5\xdf\x67\x4b\xd8\x77\x6e\x99\x2d\x43\xe1\x53\xf6\xaf\x67\xf8\x33\xc6\xe5\xcb\x17\xf6\x6d\x28\x2c\xd8\xbf\x83\xa9\xdf\xb5\x98\x33\x41\xc1\xe2\x96\x1f\xb6\x49\xaf\x2d\xef\xc0\x80\x3c\xab\x7a\x0b\x76\xab\x10\xc4\x1b\x8a\x1f\xf1\xae\x16\x4e\xfc\xe3\xa7\x51\xf7\x8d\x38\x12\x6f\x28\xfe\x81\xb3\x7f\x3a\x37\xbd\xbf\x83\x36\xc5\x3b\xc5\x1b\xca\x9f\xb0\xaa\xca\xce\x2b\xdc\x47\x9e\x1b\xde\x50\xc3\xdb\x35\xed\xa7\xac\xda\x47\x43\x63\x78\x43\x0d\x6f\xb0\xcc\xba\x4a\xc2\x3e\x1a\x0a\xcd\x3b\xaa\xe0\xaf\xac\x22\x28\x18\x97\xd8\xd2\xc9\xf2\xf5\x66\xbb\x82\xb3\x4b\x62\x0e\xd5\xbc\x67\x35\xbe\x6d\x0b\x6c\xb1\xb8\xd5\x0e\xc9\x6a\x3c\x6b\x34\x73\xa8\xe5\xc3\xba\xc8\x24\x5a\x9f\x76\x6b\xe9\x14\xef\xd9\xa8\x53\xc7\x75\xdd\x49\xb7\x34\xbb\xf5\x30\xcb\x1b\xaa\xf8\x2b\xab\x58\x41\xa7\x9e\xb8\x3d\x34\x2f\x1d\x6f\xa8\xe3\x54\x36\x6d\xb6\xc4\xdf\xf0\xfa\xf6\xcd\x29\x34\xef\xd9\x05\x5e\x87\x4a\x5c\xd6\x55\xdc\xcf\xc2\xd7\x81\x12\x9b\xbe\x07\x66\x20\x27\xf2\xe5\x3e\x68\x08\xcb\x3b\x50\xa1\xce\x01\xca\x4a\xc4\x5c\x67\xeb\x8f\xda\x9b\x60\xcb\x59\x15\x8a\xf7\x6c\x33\x57\x99\x75\x3d\xba\x5a\xb7\xb7\xc2\x61\x43\x0d\xaf\xd6\xed\x56\x2d\xe2\x36\x53\x7c\x2d\x83\xcd\xff\x3f\xc8\xb1\xcd\xa4\x0a\xd7\xdb\x8c\x59\x5a\xde\x50\x05\x2d\xaf\x91\xbf\x1d\x57\xc5\x1b\xca\xff\xde\xe4\x59\xbf\x94\xbb\xe5\x2b\xc3\x1b\x6a\x38\xe2\x79\x7b\xbd\xb6\x4e\xec\xd6\x80\x96\x37\x54\xf1\x06\x25\xb6\x35\xe3\x4c\x48\x96\xdf\xa2\xa2\xf0\x79\x43\x35\xff\x5d\x31\x5e\xb8\x6a\x60\xb7\x9a\x73\xe2\x3d\x53\xa7\x76\xa8\xe4\xa8\x3e\xc7\xa2\xb0\x90\xfa\xf5\xd5\xa6\x12\x34\xbc\x23\x1a\x9c\xd4\xee\x55\x55\x1a\xce\xd4\x79\x1b\x2a\xf9\x85\xf3\x46\x2a\xb4\x45\x18\xec\x7e\x76\x34\x4a\xb2\x9e\x37\x50\xa2\x4b\x0f\x55\x4d\x6e\x56\x1e\x8a\xfc\x1d\x85\x87\x92\x1b\xaf\x3b\xee\x5a\x75\xd8\xad\x7c\xbb\xec\xee\x9a\xe3\x8e\x15\xc7\x9f\x58\x3a\xab\x77\x8b\xb6\x58\x9e\x6d\x9a\xfd\x27\x96\x96\x0f\xfa\x5a\x7d\x8b\xfc\xf6\xd3\xfe\xee\x67\xfd\x31\xbf\xc4\x56\xec\x75\x9e\x68\xce\x50\xfc\x4f\xfc\xa7\x63\x26\x59\xec\x16\x6f\x0d\xe7\xf6\x90\xbc\x4f\x40\xea\x1d\xba\x19\x91\x9a\xfe\x1d\x21\xa9\x05\xfb\x98\xbc\x1f\xca\xb6\x11\xdb\xa3\x9c\xdb\xde\x97\xdd\x2e\x3c\xd6\xa6\xf9\x67\xf5\xa8\xec\xed\x27\xf5\x43\x2d\xd2\x09\x7e\x21\x20\x20\x6f\x51\x35\x45\x19\xb7\x0b\x42\xdd\xa8\xee\x9e\xd5\x93\xee\xdf\xd6\xb2\x69\xd3\xa8\xec\x78\x6e\x25\x63\x2c\xe0\x19\x71\xa4\x6f\x1c\x47\x62\xf6\xcb\xd7\x68\xc2\x11\xe6\x0b\x38\xa4\xd7\xaf\xd1\x64\xf2\x3e\x5b\xce\x95\x7f\x80\x45\xfa\x3e\x5b\x4e\x89\x76\xbd\xc6\xb9\xa3\x51\x06\x88\x26\xaa\x05\x77\x44\x7a\x21\x4e\xbd\xe0\x44\xc6\x22\xd5\x2f\x44\x36\xfb\x65\xae\xc8\xe6\x85\xe8\x76\x23\xcc\x89\x6e\x5f\xf4\x40\x69\xf4\xab\x81\xd2\xe8\xbf\x89\x26\xac\x84\x16\x4b\x32\x59\x8f\xbc\x52\xaf\x4f\x16\xc0\x59\x45\x21\x37\xe1\x48\x64\x58\x38\xf7\x5b\x2c\x13\x2b\x5a\x21\x8f\xb1\x48\xbd\xb5\x49\xe0\x67\x78\x6e\x05\xfd\x35\x5b\x40\x9d\x5d\x60\x3c\xbe\x74\xd3\x31\x4d\x49\x34\x99\x94\x4d\x0b\x67\x53\xc8\xc8\xc0\x36\xe3\x4b\x84\x90\x49\xcd\x34\x98\xea\x63\x96\x92\x7f\x71\xf2\x09\x16\x90\x45\x13\xb2\xf5\x26\x9a\xb4\x28\xbb\x96\x03\xc7\x3e\x10\x54\x50\x8f\x44\x82\x0a\x61\x1d\x0a\xfa\x71\x2c\x16\x94\x70\x5c\x16\xb6\xab\xf4\xa3\x21\xd6\x87\xeb\x14\xb0\x6d\xe9\xfd\xab\x02\xba\x2c\xd2\xa3\xb6\xf5\xc1\xb5\x36\xb1\x6a\x0a\x65\x2d\x69\xb8\x69\xcb\xf8\x40\x69\x84\xa7\xff\xcc\xe1\xe9\xe5\xc1\x14\x4a\x13\x11\xf4\x70\xd4\xb6\x1a\x7e\xa1\x56\xed\x50\x4d\xf4\x35\x08\x20\xf5\xdf\xca\xa8\x70\x29\x9b\x70\x84\xba\xdf\x69\x10\x9d\x76\xc4\x84\xa8\x6b\x3f\xe7\x76\xc0\x51\x48\xee\x94\xda\xc5\x5e\xb2\x2c\x52\x45\xa1\x21\xd5\x36\x06\x43\x8a\x12\x46\xb3\x1d\xea\x43\xda\xb6\x7b\x66\x94\xac\x37\x14\x1a\xb5\xad\x5c\x3f\x6a\x29\x34\x6a\x8a\x56\x33\x48\xa3\x86\x62\xa0\x26\x1e\xaf\x85\x9a\x1b\x4f\x7b\x0a\x31\x04\xdd\xd1\x9c\x94\x84\xfd\x52\xaf\xca\xf5\x3f\x0e\x1b\x47\xa1\xe1\x3e\xd7\xcd\xcd\x70\x4f\xa1\xf1\xbe\xf7\x51\xe3\x14\xf8\x65\x91\xf6\xd4\x84\x98\x4e\x6d\xa7\xe0\xe6\x70\x14\x35\xec\x3a\x06\x37\x87\xa3\x78\x88\x50\x33\x60\xbc\xf5\x28\x03\x06\x31\x1f\x32\x08\xe2\x70\x25\xbc\xb3\xc0\x51\xac\x97\x76\xcc\x58\xa0\x8b\xf0\x68\xe2\x4a\x6f\x33\x5a\x16\xa9\x2b\xc6\x7b\x14\x5d\x75\xed\xf4\xbf\x66\xeb\x15\xda\xed\x41\x2c\x7d\xd9\x6b\xbd\xf4\x0a\x61\x4f\x93\x2b\x4b\x2d\x5b\x5f\xa8\xba\x24\x57\x4c\xa1\xb9\xa0\x0d\xe3\x26\x4a\xe3\x3e\x01\x41\x50\xa8\xc7\x89\x2e\xd5\x6f\x92\x57\x24\x44\x9b\x4b\x94\x69\x58\xcb\x2f\xa0\x08\x29\xb1\x4b\x8a\xa2\x54\xfb\x0b\x16\xb7\xef\xf3\x9a\x09\x41\x6d\x95\x3a\xed\x19\x09\x51\xc2\xb3\xbb\xff\x60\x0a\xa2\x54\xbb\x38\x48\xb8\xe5\x96\x84\x2b\xca\xbb\x26\xdc\x72\x9f\x84\x1b\x32\xa9\x99\x26\xa2\xdc\x23\xe1\x6a\x28\x4c\x5c\xc1\xe1\x21\xc4\xfd\x2b\x85\x19\xad\xf4\xc1\x01\x7c\xfb\xa6\xbc\x0a\xc7\xb4\x5b\xc9\x9e\x49\xd2\x08\x42\xc6\x0b\xfb\x4c\x4a\x20\x6b\x11\xea\x4e\x76\x59\x55\x5d\x03\x5e\xe5\x55\x27\xd8\x25\x8e\xc0\x2a\xca\x3e\xba\x8d\x59\xa1\xbd\x64\xa5\x28\x07\x29\x41\xd3\x1e\xc5\x23\xd7\x14\xeb\xab\x63\x01\xb9\xaa\x6a\x60\x95\x5d\x22\x98\xc6\x1b\xd4\x4d\x91\x18\x73\x87\xae\x1e\xe7\x0b\xba\x58\x79\xf9\x22\xa6\xe4\xc1\xfe\xc5\xe4\x95\xa6\x3f\x59\xf4\xf1\xa2\xee\x28\x17\x70\x48\x03\x6a\xcd\xcc\xf2\x63\x11\xac\xbf\x6b\x1d\x69\x39\xb0\x54\xc7\x1a\x31\xb8\x53\x10\x0b\x3a\xac\x59\x09\x18\x9e\x6f\x81\xa7\xd8\xb6\x3a\x36\x68\x66\xa7\x72\x01\xd9\x7a\x8d\xbc\x88\x3d\xe2\x14\x6c\x8d\x41\xf7\xd7\xfa\xd6\x3a\x70\x9a\xe0\x80\x73\x04\xf5\xe5\x07\x0b\x90\x8d\xe2\x31\xa8\xd1\x29\x3d\xa1\xcb\xf2\xa6\x05\xbc\xca\xea\x75\x85\x53\x20\xf4\x32\xa0\xc3\x9b\xb6\x0d\x54\xec\x02\x81\xee\xca\xd2\x93\xe6\x4b\xaa\x50\x3b\x73\x7e\x51\x1d\x99\xfe\x91\xb5\x62\x95\x55\x71\x9f\x1b\x93\x57\x8a\xc1\xdb\xd7\xfd\x12\xeb\x2b\xbe\x85\x97\x49\xed\x62\x98\xe3\x56\x15\x7a\x94\x13\xfa\x3b\xe8\x0f\x1f\x8e\xdf\xd0\xbe\xe8\x65\x7c\xec\xe4\xf5\x9a\x6c\x31\x1f\xb1\x94\xf8\xdb\xd2\xb7\x46\x23\x2e\xaf\xd7\xe9\x6f\x8c\x17\x71\x02\x4f\x7a\xee\x5f\xa9\x60\xfd\xf6\x4d\x8d\x9e\x74\xf5\x31\xd7\xc3\xcf\x3d\xda\xdb\x4e\x6a\xe2\x8f\x96\x48\x94\xe7\x49\x7a\xaa\x0a\x74\x3d\x66\x8d\x77\xb4\x8d\x55\xf5\xe3\x17\xaf\xd6\x98\x4b\x9a\x14\x21\x26\xa8\xe3\x04\x9e\x8a\x04\x28\xaa\xba\x8e\x15\xe1\x22\x1e\x4c\x37\xd4\x27\xc3\x6a\x4d\x94\x53\x02\xbb\x2f\xd9\x74\x4b\xb4\x59\xb2\xa9\x0b\x0f\x5d\xb2\xe9\xc7\xb1\x92\x4d\x09\xc7\xac\xb8\x82\x67\x8a\x29\xa8\xd9\xcc\x07\x24\x2a\xe1\x19\x01\x7f\xa8\xde\xc9\x5f\x2a\x7b\x85\x39\xcc\x58\x71\x95\xaa\x77\x3a\x79\xd4\xa1\x63\x46\x68\x40\xbf\x0f\x4b\x1d\x1a\xe9\x0b\x1d\xbf\x3c\xa0\x91\xa0\x38\xe8\x93\x3c\x0d\x8d\x66\x79\xce\xee\x9a\xe5\x87\xaa\xc6\xd3\xfc\x80\xcb\x14\xd6\x6c\x8f\x3c\xef\xa2\xc1\xac\x92\xd9\x38\xe6\x23\xa3\xde\xa2\x6a\x7b\x7a\x1f\x2d\x9d\x91\x94\xa3\x1a\xc8\xe0\xff\x4e\xdf\x9e\x44\xb3\x99\xee\x92\xcd\xee\x2e\x50\xef\x6e\xc5\x42\x0a\x8c\x70\x73\xfe\x99\xc2\x4c\xff\x31\xab\x1b\x4c\x1a\x0b\x3b\x37\x35\xdf\x66\xa6\x04\xe2\x73\xf8\xf8\xe9\xfc\x5a\xa2\xde\xe8\x7d\x6d\x2e\x08\x86\x43\xad\x9d\xfc\xd6\x5f\x35\xe7\xf6\x03\x9d\x7e\x8d\x13\xbf\x4d\x63\x5c\x7f\xae\x8e\x07\xfb\x53\x8b\x24\x89\x41\xca\xad\xa9\xc9\x2c\x22\xa5\x16\x43\x7d\x59\x33\x46\x26\xaf\x86\x49\x73\xdb\xee\x32\x4e\xb9\xae\x40\x98\xa6\x00\x6d\x47\x30\x9c\x46\x87\xe3\xc3\xcf\x43\xcd\xaf\x70\xc9\x52\x64\x25\xaa\x1d\x61\x27\x72\x86\x3c\xc4\x5c\xf6\x40\xea\x03\x55\xcd\xae\x94\x0a\xbd\x13\xbd\x23\xc4\x6c\xcd\xbe\x55\xf7\x76\x78\x9c\x24\x06\x26\xf3\x61\xd8\x77\xc0\x7c\x47\x7e\x4c\x17\x28\xed\xf4\xbb\xcd\x7c\xb7\x26\xc5\x22\xb5\x5f\xb1\x3d\x47\x0c\x69\x1a\xa4\xad\x51\x6f\x06\x8b\xae\xbe\x70\x3f\xfc\x9a\x0f\xa7\xd1\x9f\xc6\x1f\x7e\x1e\x23\x18\x9c\xc0\x22\x31\x99\xe5\x03\xaf\x83\xdc\xa2\x13\x84\x50\xc9\x65\xc9\x2e\x91\xc3\x79\x57\x96\xf4\x53\x14\x4a\x29\xe6\x64\xb0\x5f\xd9\x55\x9a\x18\x68\x88\xcf\xbb\xd2\xe4\x04\x6a\xda\xb5\xda\xe9\xb6\xcc\x10\xc0\xa0\x2c\x74\xea\x48\xd1\x14\xc4\x6e\x20\x54\x01\xd4\x07\x44\xd9\x87\x83\x30\x47\x07\x4d\xe9\xcd\x51\xa6\xe6\xc0\x14\xf1\xa6\xe6\x4d\xd5\x83\xa3\xd3\x3f\x39\x5d\xd6\x51\xe7\xa5\xfa\xf2\x4f\x3f\x1f\x68\x4c\x8a\x33\xd7\x5e\x7e\xba\x34\x80\xc5\x02\x0c\x2c\x49\xaf\x64\x4b\x7e\x55\xb0\x91\x0b\x4a\x7b\x90\x20\x82\x8c\xe7\x60\xdc\xc4\xc9\x87\x88\x4d\xa1\xf6\xb6\x8c\x52\xaa\x78\xe9\xe6\x9a\xe8\xdb\x72\x70\x7d\xe5\xf2\x2f\x1d\x75\x0a\xd9\xc0\x1a\x93\x18\xeb\xab\xdd\x55\xab\x1f\xb8\x7a\x76\x17\xb7\xdc\x8b\x5a\xb2\x57\xad\xe9\xe7\x60\x4d\x4d\xe1\x6e\xfa\xa5\xcd\x9a\xb9\x0c\x77\x73\x34\x19\x35\xe5\xae\xb6\x28\x63\xa8\xc0\x76\x9f\x32\x17\x70\x68\x9f\xb5\xc6\xbe\xa3\x06\xf8\x4c\x67\xda\xc4\xfe\x6c\x44\xd5\x2b\xb2\xd5\x85\xca\xc4\xfb\x4d\xc8\x1c\xd8\xb4\x57\x6e\x83\xd5\x4b\x57\xa6\xf2\x01\x51\x5a\x40\xb6\x1d\x12\x0f\x0d\xfa\xb6\xc3\xe1\xbb\x4e\x07\x65\xf9\xae\xf3\xe1\x11\xac\xdf\x7a\x2e\xdc\xe7\x60\x50\x8e\xe8\x5f\x34\xf9\x6e\xe8\xc3\xe1\xa1\x9d\xf8\xdc\xdb\xaf\xa6\xb4\xd6\xab\xd9\x7c\xdb\x15\x61\xfa\x90\xf1\x98\x0c\xb3\x5e\x98\xf2\x4c\xa0\xd2\xa3\x30\xd7\x2a\xdf\x91\xf3\x82\x3a\x6a\x6b\xd2\xdb\x9e\x67\xee\x9c\xf6\xc6\xb3\xc8\x7e\x49\x64\xfb\xb2\x06\xfd\xb7\x5d\x82\x20\x3d\x58\x6c\x6f\xa2\x3d\x76\xf9\x06\xe6\xa3\xd8\xf9\xe5\xc8\x56\xe8\xb6\x05\xea\x1d\x81\x1b\x0b\xc3\x7d\xa3\xd0\xb8\x0e\x26\xb0\x5c\x00\x96\x59\x25\x54\xf8\xdd\xec\xed\x72\x50\x1a\x6d\xf5\xd9\xfc\x80\xd0\x77\x3a\xac\xa9\xf6\xf0\x5a\xa4\xe6\x17\x8a\x0b\xd0\xea\x0c\xef\xb8\x99\x25\xe8\xaf\x12\x89\x6d\xc3\x45\xec\xd9\xc3\x4a\x78\xe2\x6e\x33\xe8\x46\xe0\x89\xbe\xc6\x4c\x4f\xba\x1a\x5b\x96\xc7\x89\x6f\x81\x9a\xe4\x26\x9a\x70\x77\xad\x6a\x45\xd5\x45\x48\x1a\x97\x55\x93\xc9\x97\x2f\xf4\xda\x3d\x69\x2e\x7c\x61\x3f\xbf\x74\x5c\x5f\x1a\xe0\xe0\x72\x40\x5f\x22\xb8\xdb\xd0\xb9\xbe\x0e\xf5\xef\xb9\xc4\x17\x26\xf3\x15\x48\x3d\xbb\xbb\x62\x79\x45\x33\xe5\x99\x40\x90\xf0\xb3\x7f\xdb\x72\xcc\xe5\x7f\xd0\x6d\x8b\x84\xff\x1a\x90\x5f\xbe\x98\x82\x0c\xaf\x66\xde\x74\xad\x6a\xb0\xe7\x74\xb4\x07\xae\x81\xbd\x56\xe3\xc9\xf8\x3c\x1f\xd8\xf8\x44\x44\x7f\xf9\x62\x54\x61\xd7\x6b\xdc\x08\xb1\xd9\xcc\x4b\x25\xf0\xa5\xcd\xd6\xc2\xff\xf1\xa8\xa1\xd3\xcd\xa7\x4a\xd5\x76\xd7\xd6\x28\x57\x4d\x01\x5f\x98\x5c\x41\x8b\x79\x73\xa9\xab\x62\xe4\xa2\x6b\x11\x78\x03\xeb\x8c\xb3\x5c\xd0\x0f\x3b\x4d\x09\xcb\xf8\xd2\xe4\x3f\x2f\x75\x95\x85\xf7\xab\x35\x30\xc4\x04\x3e\x7e\xea\x7f\xe3\x79\x93\x40\x6c\xb2\x94\x47\x1e\xb6\xd8\x05\x52\x5d\x6e\xee\x84\x4c\x95\x7b\x49\x4b\x67\x8c\xa3\x02\xf7\xd2\x0f\xf5\x09\xc9\x2f\x82\x58\x79\xfa\xde\x7a\xa7\x8d\x37\x67\x52\x59\x4c\xe1\x92\x52\x9f\x29\xf5\xc0\xec\x01\x0a\x92\x9b\x38\x71\x80\x96\x85\x11\x8f\x13\xbf\x34\x76\xa5\xc9\x26\xb8\x9a\x7c\x5f\x28\xfd\xe6\xd8\x47\x53\xd3\x2d\x98\xf4\xa6\xb0\xd4\x25\x4c\x4f\x7c\x0c\x24\x03\xff\x02\x30\x35\x90\x68\x2a\xa7\x51\x1c\x7d\xe1\x4d\x28\x6d\xc9\xb2\x01\xa6\x1d\xb8\x2f\x9c\x46\xcf\x08\xa0\x76\xc4\x42\xaa\xde\x15\xa6\xb6\xac\xf2\xe8\x8f\x08\xab\xb1\x63\x0c\x58\x6b\xc8\x6e\x68\x9d\x23\x43\x70\x55\x45\xbe\x09\xad\x26\xdf\x17\xd8\x5d\xad\x5d\xac\x92\x8b\xc1\xef\x8f\xbe\xbd\x7b\x14\xfc\x94\xfe\x31\xf4\xb4\x11\xbb\xb1\x53\xc2\x9b\xc8\xe9\x2a\x60\x03\x39\x4d\xbe\x2f\x72\x41\x91\xe3\x05\xa4\xa6\xdb\x70\xa4\x37\x15\x8d\xba\x3a\xe9\x89\x8f\x08\x25\xa9\x1f\xdd\xe1\x2b\x53\x15\xed\x82\xd2\x98\x3f\x84\xd2\xd4\x1c\x1b\x58\x1a\xfa\x7d\xc1\xdc\x59\x3e\xc5\xa6\xce\x21\xf2\x3b\xaf\x82\x7a\x14\xf0\x8c\x43\x23\xe8\x19\x2b\x76\xc3\x67\x1c\xe9\x43\x91\x8c\xea\x2f\x2d\x64\xf0\x69\x27\x09\xde\xc8\x30\xaa\x7d\xa4\xfd\xb4\xb3\xe8\x3f\xed\xbc\x93\xaa\x5e\x9b\x48\x58\x80\x4c\x8f\x2a\xac\xcd\xa7\xe7\x16\x65\xd7\x72\x90\xd1\x4d\xf4\xff\x03\x00\x9a\x7a\xfc\x08\xee\x35\x00\x00")
 
 func schemaGoBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +113,7 @@ func schemaGo() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "schema.go", size: 10796, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "schema.go", size: 13806, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
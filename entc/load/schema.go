@@ -35,43 +35,59 @@ type Position struct {
 
 // Field represents an ent.Field that was loaded from a complied user package.
 type Field struct {
-	Name          string            `json:"name,omitempty"`
-	Info          *field.TypeInfo   `json:"type,omitempty"`
-	Tag           string            `json:"tag,omitempty"`
-	Size          *int64            `json:"size,omitempty"`
-	Enums         []string          `json:"enums,omitempty"`
-	Unique        bool              `json:"unique,omitempty"`
-	Nillable      bool              `json:"nillable,omitempty"`
-	Optional      bool              `json:"optional,omitempty"`
-	Default       bool              `json:"default,omitempty"`
-	DefaultValue  interface{}       `json:"default_value,omitempty"`
-	UpdateDefault bool              `json:"update_default,omitempty"`
-	Immutable     bool              `json:"immutable,omitempty"`
-	Validators    int               `json:"validators,omitempty"`
-	StorageKey    string            `json:"storage_key,omitempty"`
-	Position      *Position         `json:"position,omitempty"`
-	Sensitive     bool              `json:"sensitive,omitempty"`
-	SchemaType    map[string]string `json:"schema_type,omitempty"`
+	Name          string                 `json:"name,omitempty"`
+	Info          *field.TypeInfo        `json:"type,omitempty"`
+	Tag           string                 `json:"tag,omitempty"`
+	Size          *int64                 `json:"size,omitempty"`
+	Precision     int                    `json:"precision,omitempty"`
+	Scale         int                    `json:"scale,omitempty"`
+	Enums         []string               `json:"enums,omitempty"`
+	Unique        bool                   `json:"unique,omitempty"`
+	Nillable      bool                   `json:"nillable,omitempty"`
+	Optional      bool                   `json:"optional,omitempty"`
+	Default       bool                   `json:"default,omitempty"`
+	DefaultValue  interface{}            `json:"default_value,omitempty"`
+	TimeOrdered   bool                   `json:"time_ordered,omitempty"`
+	UpdateDefault bool                   `json:"update_default,omitempty"`
+	Immutable     bool                   `json:"immutable,omitempty"`
+	Validators    int                    `json:"validators,omitempty"`
+	StorageKey    string                 `json:"storage_key,omitempty"`
+	Position      *Position              `json:"position,omitempty"`
+	Sensitive     bool                   `json:"sensitive,omitempty"`
+	SchemaType    map[string]string      `json:"schema_type,omitempty"`
+	DefaultExpr   string                 `json:"default_expr,omitempty"`
+	DefaultExprs  map[string]string      `json:"default_exprs,omitempty"`
+	Generated     string                 `json:"generated,omitempty"`
+	Stored        bool                   `json:"stored,omitempty"`
+	Location      bool                   `json:"location,omitempty"`
+	Encrypted     bool                   `json:"encrypted,omitempty"`
+	Deterministic bool                   `json:"deterministic,omitempty"`
+	BlindIndex    bool                   `json:"blind_index,omitempty"`
+	Embedded      []*Field               `json:"embedded,omitempty"`
+	EmbedField    string                 `json:"embed_field,omitempty"`
+	Annotations   map[string]interface{} `json:"annotations,omitempty"`
 }
 
 // Edge represents an ent.Edge that was loaded from a complied user package.
 type Edge struct {
-	Name     string `json:"name,omitempty"`
-	Type     string `json:"type,omitempty"`
-	Tag      string `json:"tag,omitempty"`
-	RefName  string `json:"ref_name,omitempty"`
-	Ref      *Edge  `json:"ref,omitempty"`
-	Unique   bool   `json:"unique,omitempty"`
-	Inverse  bool   `json:"inverse,omitempty"`
-	Required bool   `json:"required,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Type        string                 `json:"type,omitempty"`
+	Tag         string                 `json:"tag,omitempty"`
+	RefName     string                 `json:"ref_name,omitempty"`
+	Ref         *Edge                  `json:"ref,omitempty"`
+	Unique      bool                   `json:"unique,omitempty"`
+	Inverse     bool                   `json:"inverse,omitempty"`
+	Required    bool                   `json:"required,omitempty"`
+	Annotations map[string]interface{} `json:"annotations,omitempty"`
 }
 
 // Index represents an ent.Index that was loaded from a complied user package.
 type Index struct {
-	Unique     bool     `json:"unique,omitempty"`
-	Edges      []string `json:"edges,omitempty"`
-	Fields     []string `json:"fields,omitempty"`
-	StorageKey string   `json:"storage_key,omitempty"`
+	Unique      bool                   `json:"unique,omitempty"`
+	Edges       []string               `json:"edges,omitempty"`
+	Fields      []string               `json:"fields,omitempty"`
+	StorageKey  string                 `json:"storage_key,omitempty"`
+	Annotations map[string]interface{} `json:"annotations,omitempty"`
 }
 
 // NewEdge creates an loaded edge from edge descriptor.
@@ -88,33 +104,75 @@ func NewEdge(ed *edge.Descriptor) *Edge {
 	if ref := ed.Ref; ref != nil {
 		ne.Ref = NewEdge(ref)
 	}
+	if len(ed.Annotations) > 0 {
+		ne.Annotations = make(map[string]interface{}, len(ed.Annotations))
+		for _, a := range ed.Annotations {
+			ne.Annotations[a.Name()] = a
+		}
+	}
 	return ne
 }
 
 // NewField creates an loaded field from field descriptor.
 func NewField(fd *field.Descriptor) (*Field, error) {
+	if fd.Err != nil {
+		return nil, fmt.Errorf("field %q: %v", fd.Name, fd.Err)
+	}
 	sf := &Field{
 		Name:          fd.Name,
 		Info:          fd.Info,
 		Tag:           fd.Tag,
+		Precision:     fd.Precision,
+		Scale:         fd.Scale,
 		Enums:         fd.Enums,
 		Unique:        fd.Unique,
 		Nillable:      fd.Nillable,
 		Optional:      fd.Optional,
 		Default:       fd.Default != nil,
+		TimeOrdered:   fd.TimeOrdered,
 		UpdateDefault: fd.UpdateDefault != nil,
 		Immutable:     fd.Immutable,
 		StorageKey:    fd.StorageKey,
 		Validators:    len(fd.Validators),
 		Sensitive:     fd.Sensitive,
 		SchemaType:    fd.SchemaType,
+		DefaultExpr:   fd.DefaultExpr,
+		DefaultExprs:  fd.DefaultExprs,
+		Generated:     fd.Generated,
+		Stored:        fd.Stored,
+		Location:      fd.Location != nil,
+		Encrypted:     fd.Cipher != nil,
+		BlindIndex:    fd.BlindIndex != nil,
+		EmbedField:    fd.EmbedField,
+	}
+	if d, ok := fd.Cipher.(interface{ Deterministic() bool }); ok {
+		sf.Deterministic = d.Deterministic()
 	}
 	if sf.Info == nil {
 		return nil, fmt.Errorf("missing type info for field %q", sf.Name)
 	}
+	if len(fd.Annotations) > 0 {
+		sf.Annotations = make(map[string]interface{}, len(fd.Annotations))
+		for _, a := range fd.Annotations {
+			sf.Annotations[a.Name()] = a
+		}
+	}
+	if sf.Default && (sf.DefaultExpr != "" || len(sf.DefaultExprs) > 0) {
+		return nil, fmt.Errorf("field %q: Default and DefaultExpr are mutually exclusive", sf.Name)
+	}
+	if sf.Generated != "" && (sf.Default || sf.UpdateDefault || sf.DefaultExpr != "" || len(sf.DefaultExprs) > 0) {
+		return nil, fmt.Errorf("field %q: generated fields cannot have default values", sf.Name)
+	}
 	if size := int64(fd.Size); size != 0 {
 		sf.Size = &size
 	}
+	for _, ed := range fd.Embedded {
+		ef, err := NewField(ed)
+		if err != nil {
+			return nil, err
+		}
+		sf.Embedded = append(sf.Embedded, ef)
+	}
 	// If the default value can be encoded to the generator.
 	// For example, not a function like time.Now.
 	if _, err := json.Marshal(fd.Default); err == nil {
@@ -131,12 +189,19 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 
 // NewIndex creates an loaded index from index descriptor.
 func NewIndex(idx *index.Descriptor) *Index {
-	return &Index{
+	ni := &Index{
 		Edges:      idx.Edges,
 		Fields:     idx.Fields,
 		Unique:     idx.Unique,
 		StorageKey: idx.StorageKey,
 	}
+	if len(idx.Annotations) > 0 {
+		ni.Annotations = make(map[string]interface{}, len(idx.Annotations))
+		for _, a := range idx.Annotations {
+			ni.Annotations[a.Name()] = a
+		}
+	}
+	return ni
 }
 
 // MarshalSchema encode the ent.Schema interface into a JSON
@@ -291,7 +356,7 @@ func (f *Field) defaults() error {
 		return fmt.Errorf("unexpected default value type for field: %q", f.Name)
 	}
 	switch t := f.Info.Type; {
-	case t >= field.TypeInt8 && t <= field.TypeInt64:
+	case t >= field.TypeInt8 && t <= field.TypeInt64, t == field.TypeDuration:
 		f.DefaultValue = int64(n)
 	case t >= field.TypeUint8 && t <= field.TypeUint64:
 		f.DefaultValue = uint64(n)
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect/entsql"
 	"github.com/facebookincubator/ent/schema/edge"
 	"github.com/facebookincubator/ent/schema/field"
 	"github.com/facebookincubator/ent/schema/index"
@@ -47,7 +48,8 @@ func (User) Fields() []ent.Field {
 
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("groups", Group.Type),
+		edge.To("groups", Group.Type).
+			Annotations(entsql.Deferrable(entsql.InitiallyDeferred)),
 		edge.To("parent", User.Type).
 			Unique().
 			From("children"),
@@ -122,6 +124,7 @@ func TestMarshalSchema(t *testing.T) {
 		require.Equal(t, "groups", schema.Edges[0].Name)
 		require.Equal(t, "Group", schema.Edges[0].Type)
 		require.False(t, schema.Edges[0].Inverse)
+		require.Equal(t, map[string]interface{}{"deferrable": "INITIALLY DEFERRED"}, schema.Edges[0].Annotations["EntSQL"])
 		require.Equal(t, "children", schema.Edges[1].Name)
 		require.Equal(t, "User", schema.Edges[1].Type)
 		require.True(t, schema.Edges[1].Inverse)
@@ -0,0 +1,209 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package openapi provides a codegen extension for generating the OpenAPI
+// component schemas of the entities that are defined in the ent schema.
+//
+//	err := entc.Generate("./schema", &gen.Config{}, entc.Hooks(openapi.Hook("openapi.json")))
+//
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/facebookincubator/ent/entc/gen"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+type (
+	// Document is an OpenAPI (v3) document that holds the component schemas of the graph.
+	Document struct {
+		OpenAPI    string                 `json:"openapi"`
+		Info       Info                   `json:"info"`
+		Paths      map[string]interface{} `json:"paths"`
+		Components Components             `json:"components"`
+	}
+
+	// Info holds the metadata of the API.
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	// Components holds the component schemas of the document.
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+
+	// Schema is an OpenAPI schema object. It supports the
+	// subset of JSON Schema that is needed for ent entities.
+	Schema struct {
+		Ref        string             `json:"$ref,omitempty"`
+		Type       string             `json:"type,omitempty"`
+		Format     string             `json:"format,omitempty"`
+		Nullable   bool               `json:"nullable,omitempty"`
+		Enum       []string           `json:"enum,omitempty"`
+		Minimum    *int               `json:"minimum,omitempty"`
+		Items      *Schema            `json:"items,omitempty"`
+		Properties map[string]*Schema `json:"properties,omitempty"`
+		Required   []string           `json:"required,omitempty"`
+	}
+
+	// Option configures the generated document.
+	Option func(*Document)
+)
+
+// Title sets the title of the API in the generated document.
+func Title(title string) Option {
+	return func(d *Document) {
+		d.Info.Title = title
+	}
+}
+
+// Version sets the version of the API in the generated document.
+func Version(version string) Option {
+	return func(d *Document) {
+		d.Info.Version = version
+	}
+}
+
+// Hook returns a codegen hook that writes the OpenAPI document of the graph to the
+// given path after the code-generation. Relative paths are resolved from the target
+// directory of the codegen (e.g. "<project>/ent").
+func Hook(path string, opts ...Option) gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(g *gen.Graph) error {
+			if err := next.Generate(g); err != nil {
+				return err
+			}
+			doc, err := NewDocument(g, opts...)
+			if err != nil {
+				return err
+			}
+			buf, err := json.MarshalIndent(doc, "", "  ")
+			if err != nil {
+				return err
+			}
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(g.Config.Target, path)
+			}
+			return ioutil.WriteFile(path, append(buf, '\n'), 0644)
+		})
+	}
+}
+
+// NewDocument returns the OpenAPI document of the given graph.
+func NewDocument(g *gen.Graph, opts ...Option) (*Document, error) {
+	doc := &Document{
+		OpenAPI:    "3.0.3",
+		Info:       Info{Title: filepath.Base(g.Config.Package), Version: "0.0.0"},
+		Paths:      make(map[string]interface{}),
+		Components: Components{Schemas: make(map[string]*Schema, len(g.Nodes))},
+	}
+	for _, opt := range opts {
+		opt(doc)
+	}
+	for _, n := range g.Nodes {
+		s, err := TypeSchema(n)
+		if err != nil {
+			return nil, err
+		}
+		doc.Components.Schemas[n.Name] = s
+	}
+	return doc, nil
+}
+
+// TypeSchema returns the schema of the given type, as it is encoded to JSON. The
+// edges are defined in the "edges" property as references to the schemas of their types.
+func TypeSchema(t *gen.Type) (*Schema, error) {
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for _, f := range append([]*gen.Field{t.ID}, t.Fields...) {
+		name, ok := jsonName(f.StructTag, f.StructField())
+		if !ok || f.Sensitive() {
+			continue
+		}
+		fs, err := FieldSchema(f)
+		if err != nil {
+			return nil, fmt.Errorf("openapi: field %s.%s: %v", t.Name, f.Name, err)
+		}
+		s.Properties[name] = fs
+		if !f.Optional {
+			s.Required = append(s.Required, name)
+		}
+	}
+	if len(t.Edges) > 0 {
+		edges := &Schema{Type: "object", Properties: make(map[string]*Schema, len(t.Edges))}
+		for _, e := range t.Edges {
+			name, ok := jsonName(e.StructTag, e.StructField())
+			if !ok {
+				continue
+			}
+			ref := &Schema{Ref: "#/components/schemas/" + e.Type.Name}
+			if !e.Unique {
+				ref = &Schema{Type: "array", Items: ref}
+			}
+			edges.Properties[name] = ref
+		}
+		s.Properties["edges"] = edges
+	}
+	return s, nil
+}
+
+// FieldSchema returns the schema of the given field.
+func FieldSchema(f *gen.Field) (*Schema, error) {
+	var s *Schema
+	switch t := f.Type.Type; t {
+	case field.TypeBool:
+		s = &Schema{Type: "boolean"}
+	case field.TypeTime:
+		s = &Schema{Type: "string", Format: "date-time"}
+	case field.TypeUUID:
+		s = &Schema{Type: "string", Format: "uuid"}
+	case field.TypeBytes:
+		s = &Schema{Type: "string", Format: "byte"}
+	case field.TypeString:
+		s = &Schema{Type: "string"}
+	case field.TypeEnum:
+		s = &Schema{Type: "string", Enum: f.Enums()}
+	case field.TypeJSON:
+		s = &Schema{Type: "object"}
+		if strings.HasPrefix(f.Type.Ident, "[]") {
+			s = &Schema{Type: "array", Items: &Schema{}}
+		}
+	case field.TypeInt8, field.TypeInt16, field.TypeInt32:
+		s = &Schema{Type: "integer", Format: "int32"}
+	case field.TypeInt, field.TypeInt64:
+		s = &Schema{Type: "integer", Format: "int64"}
+	case field.TypeUint8, field.TypeUint16, field.TypeUint32:
+		s = &Schema{Type: "integer", Format: "int32", Minimum: new(int)}
+	case field.TypeUint, field.TypeUint64:
+		s = &Schema{Type: "integer", Format: "int64", Minimum: new(int)}
+	case field.TypeFloat32:
+		s = &Schema{Type: "number", Format: "float"}
+	case field.TypeFloat64:
+		s = &Schema{Type: "number", Format: "double"}
+	default:
+		return nil, fmt.Errorf("unsupported field type %q", t)
+	}
+	s.Nullable = f.Nillable
+	return s, nil
+}
+
+// jsonName returns the JSON name of a struct field from its tag, or false if it is
+// omitted from JSON. Like encoding/json, it defaults to the name of the struct field.
+func jsonName(tag, structField string) (string, bool) {
+	name := strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return structField, true
+	}
+	return name, true
+}
@@ -0,0 +1,111 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package openapi
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/facebookincubator/ent/entc/gen"
+	"github.com/facebookincubator/ent/entc/load"
+	"github.com/facebookincubator/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func graph(t *testing.T) *gen.Graph {
+	storage, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+	g, err := gen.NewGraph(&gen.Config{
+		Package: "entc/openapi/ent",
+		Storage: storage,
+		IDType:  &field.TypeInfo{Type: field.TypeInt},
+	},
+		&load.Schema{
+			Name: "User",
+			Fields: []*load.Field{
+				{Name: "age", Info: &field.TypeInfo{Type: field.TypeUint8}},
+				{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}, Tag: `json:"full_name,omitempty"`},
+				{Name: "score", Info: &field.TypeInfo{Type: field.TypeFloat64}, Optional: true},
+				{Name: "role", Info: &field.TypeInfo{Type: field.TypeEnum}, Enums: []string{"admin", "user"}},
+				{Name: "deleted_at", Info: &field.TypeInfo{Type: field.TypeTime}, Optional: true, Nillable: true},
+				{Name: "password", Info: &field.TypeInfo{Type: field.TypeString}, Sensitive: true},
+				{Name: "tags", Info: &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string"}, Optional: true},
+			},
+			Edges: []*load.Edge{
+				{Name: "devices", Type: "Device"},
+				{Name: "spouse", Type: "User", Unique: true, Tag: `json:"spouse,omitempty"`},
+			},
+		},
+		&load.Schema{
+			Name: "Device",
+			Fields: []*load.Field{
+				{Name: "id", Info: &field.TypeInfo{Type: field.TypeBytes}},
+			},
+			Edges: []*load.Edge{
+				{Name: "owner", Type: "User", RefName: "devices", Unique: true, Inverse: true},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return g
+}
+
+func TestNewDocument(t *testing.T) {
+	doc, err := NewDocument(graph(t), Title("users"), Version("1.0.0"))
+	require.NoError(t, err)
+	require.Equal(t, Info{Title: "users", Version: "1.0.0"}, doc.Info)
+	require.Len(t, doc.Components.Schemas, 2)
+
+	user := doc.Components.Schemas["User"]
+	require.Equal(t, "object", user.Type)
+	require.Equal(t, []string{"id", "age", "full_name", "role"}, user.Required)
+	require.Equal(t, &Schema{Type: "integer", Format: "int64"}, user.Properties["id"])
+	require.Equal(t, &Schema{Type: "integer", Format: "int32", Minimum: new(int)}, user.Properties["age"])
+	require.Equal(t, &Schema{Type: "string"}, user.Properties["full_name"])
+	require.Equal(t, &Schema{Type: "number", Format: "double"}, user.Properties["score"])
+	require.Equal(t, &Schema{Type: "string", Enum: []string{"admin", "user"}}, user.Properties["role"])
+	require.Equal(t, &Schema{Type: "string", Format: "date-time", Nullable: true}, user.Properties["deleted_at"])
+	require.Equal(t, &Schema{Type: "array", Items: &Schema{}}, user.Properties["tags"])
+	require.NotContains(t, user.Properties, "password", "sensitive fields are not encoded to JSON")
+	require.Equal(t, &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"Devices": {Type: "array", Items: &Schema{Ref: "#/components/schemas/Device"}},
+			"spouse":  {Ref: "#/components/schemas/User"},
+		},
+	}, user.Properties["edges"])
+
+	device := doc.Components.Schemas["Device"]
+	require.Equal(t, &Schema{Type: "string", Format: "byte"}, device.Properties["id"])
+	require.Equal(t, &Schema{Ref: "#/components/schemas/User"}, device.Properties["edges"].Properties["Owner"])
+}
+
+func TestHook(t *testing.T) {
+	target, err := ioutil.TempDir("", "openapi")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	g := graph(t)
+	g.Config.Target = target
+	var called bool
+	next := gen.GenerateFunc(func(*gen.Graph) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, Hook("openapi.json")(next).Generate(g))
+	require.True(t, called)
+
+	buf, err := ioutil.ReadFile(filepath.Join(target, "openapi.json"))
+	require.NoError(t, err)
+	doc := &Document{}
+	require.NoError(t, json.Unmarshal(buf, doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+	require.Equal(t, "ent", doc.Info.Title)
+	require.Contains(t, doc.Components.Schemas, "User")
+	require.Contains(t, doc.Components.Schemas, "Device")
+}
@@ -0,0 +1,253 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package repository provides a codegen extension for generating typed repository
+// interfaces for the entities that are defined in the ent schema, along with their
+// implementations that are backed by the ent client, and mocks for testing.
+//
+//	err := entc.Generate("./schema", &gen.Config{}, entc.Hooks(repository.Hook()))
+//
+// The code is generated into a separate package (by default, "<target>/repository"),
+// and therefore, it can be excluded from the build by removing the hook.
+package repository
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"text/template"
+
+	"github.com/facebookincubator/ent/entc/gen"
+
+	"golang.org/x/tools/imports"
+)
+
+type (
+	// Config holds the configuration of the generated package.
+	Config struct {
+		// Package is the name of the generated package, and the name of its
+		// directory under the target directory of the codegen.
+		Package string
+	}
+
+	// Option configures the generated package.
+	Option func(*Config)
+)
+
+// Package sets the name of the generated package. The default is "repository".
+func Package(name string) Option {
+	return func(c *Config) {
+		c.Package = name
+	}
+}
+
+// Hook returns a codegen hook that generates the repository package after the code-generation.
+// It generates 2 files: "repository.go" that holds the <T>Repository interfaces and their ent
+// implementations, and "mock.go" that holds the <T>RepositoryMock implementations.
+//
+// Note that the repositories use the SQL specific CopyToCreate method of the clients, and
+// therefore, only the SQL storage is supported.
+func Hook(opts ...Option) gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(g *gen.Graph) error {
+			if err := next.Generate(g); err != nil {
+				return err
+			}
+			return Generate(g, opts...)
+		})
+	}
+}
+
+// Generate generates the repository package of the given graph.
+func Generate(g *gen.Graph, opts ...Option) error {
+	cfg := &Config{Package: "repository"}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if g.Storage == nil || g.Storage.Name != "sql" {
+		return fmt.Errorf("repository: unsupported storage driver %v", g.Storage)
+	}
+	dir := filepath.Join(g.Config.Target, cfg.Package)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("repository: create dir %q: %v", dir, err)
+	}
+	header := g.Config.Header
+	if header == "" {
+		header = "// Code generated by entc, DO NOT EDIT."
+	}
+	// Views are read-only, and therefore, they have no repositories.
+	var nodes []*gen.Type
+	for _, n := range g.Nodes {
+		if !n.IsView() {
+			nodes = append(nodes, n)
+		}
+	}
+	data := struct {
+		*gen.Graph
+		Nodes    []*gen.Type
+		Name     string
+		Header   string
+		EntPkg   string
+		EntName  string
+		PredPath string
+	}{
+		Graph:    g,
+		Nodes:    nodes,
+		Name:     cfg.Package,
+		Header:   header,
+		EntPkg:   g.Config.Package,
+		EntName:  path.Base(g.Config.Package),
+		PredPath: path.Join(g.Config.Package, "predicate"),
+	}
+	for _, name := range []string{"repository", "mock"} {
+		b := bytes.NewBuffer(nil)
+		if err := templates.ExecuteTemplate(b, name, data); err != nil {
+			return fmt.Errorf("repository: execute template %q: %v", name, err)
+		}
+		file := filepath.Join(dir, name+".go")
+		src, err := imports.Process(file, b.Bytes(), nil)
+		if err != nil {
+			return fmt.Errorf("repository: format file %q: %v", file, err)
+		}
+		if err := ioutil.WriteFile(file, src, 0644); err != nil {
+			return fmt.Errorf("repository: write file %q: %v", file, err)
+		}
+	}
+	return nil
+}
+
+var templates = template.Must(template.New("repository").Funcs(gen.Funcs).Parse(`
+{{ define "header" }}
+{{- .Header }}
+
+package {{ .Name }}
+
+import (
+	"context"
+
+	"{{ .EntPkg }}"
+	"{{ .PredPath }}"
+)
+{{ end }}
+
+{{ define "repository" }}
+{{- template "header" . }}
+{{- $ent := .EntName }}
+{{ range $n := .Nodes }}
+{{ $repo := print $n.Name "Repository" }}
+{{ $impl := print (camel (snake $n.Name)) "Repository" }}
+// {{ $repo }} provides the common operations on the {{ $n.Name }} entities.
+type {{ $repo }} interface {
+	// Get returns the {{ $n.Name }} with the given id.
+	Get(ctx context.Context, id {{ $n.ID.Type }}) (*{{ $ent }}.{{ $n.Name }}, error)
+	// List returns the {{ $n.Name }} entities that match all the given predicates.
+	List(ctx context.Context, ps ...predicate.{{ $n.Name }}) ([]*{{ $ent }}.{{ $n.Name }}, error)
+	// Create creates a {{ $n.Name }} with the fields and the M2O edges of the given entity.
+	Create(ctx context.Context, v *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error)
+	// Update updates the fields that were changed in the modified {{ $n.Name }}.
+	Update(ctx context.Context, original, modified *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error)
+	// Delete deletes the {{ $n.Name }} with the given id.
+	Delete(ctx context.Context, id {{ $n.ID.Type }}) error
+}
+
+// {{ $impl }} implements the {{ $repo }} using the ent client.
+type {{ $impl }} struct {
+	client *{{ $ent }}.{{ $n.Name }}Client
+}
+
+// New{{ $repo }} returns a {{ $repo }} that is backed by the given client.
+func New{{ $repo }}(client *{{ $ent }}.Client) {{ $repo }} {
+	return &{{ $impl }}{client: client.{{ $n.Name }}}
+}
+
+// Get implements the {{ $repo }} interface.
+func (r *{{ $impl }}) Get(ctx context.Context, id {{ $n.ID.Type }}) (*{{ $ent }}.{{ $n.Name }}, error) {
+	return r.client.Get(ctx, id)
+}
+
+// List implements the {{ $repo }} interface.
+func (r *{{ $impl }}) List(ctx context.Context, ps ...predicate.{{ $n.Name }}) ([]*{{ $ent }}.{{ $n.Name }}, error) {
+	return r.client.Query().Where(ps...).All(ctx)
+}
+
+// Create implements the {{ $repo }} interface.
+func (r *{{ $impl }}) Create(ctx context.Context, v *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error) {
+	return r.client.CopyToCreate(v).Save(ctx)
+}
+
+// Update implements the {{ $repo }} interface.
+func (r *{{ $impl }}) Update(ctx context.Context, original, modified *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error) {
+	return r.client.UpdateFromDiff(ctx, original, modified)
+}
+
+// Delete implements the {{ $repo }} interface.
+func (r *{{ $impl }}) Delete(ctx context.Context, id {{ $n.ID.Type }}) error {
+	return r.client.DeleteOneID(id).Exec(ctx)
+}
+{{ end }}
+{{ end }}
+
+{{ define "mock" }}
+{{- template "header" . }}
+{{- $ent := .EntName }}
+{{ range $n := .Nodes }}
+{{ $repo := print $n.Name "Repository" }}
+{{ $mock := print $repo "Mock" }}
+// {{ $mock }} is a mock implementation of the {{ $repo }}. Each method calls
+// the function field with the same name, and panics if it was not set.
+type {{ $mock }} struct {
+	GetFunc    func(ctx context.Context, id {{ $n.ID.Type }}) (*{{ $ent }}.{{ $n.Name }}, error)
+	ListFunc   func(ctx context.Context, ps ...predicate.{{ $n.Name }}) ([]*{{ $ent }}.{{ $n.Name }}, error)
+	CreateFunc func(ctx context.Context, v *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error)
+	UpdateFunc func(ctx context.Context, original, modified *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error)
+	DeleteFunc func(ctx context.Context, id {{ $n.ID.Type }}) error
+}
+
+var _ {{ $repo }} = (*{{ $mock }})(nil)
+
+// Get calls the GetFunc of the mock.
+func (m *{{ $mock }}) Get(ctx context.Context, id {{ $n.ID.Type }}) (*{{ $ent }}.{{ $n.Name }}, error) {
+	if m.GetFunc == nil {
+		panic("{{ $mock }}.GetFunc is not set")
+	}
+	return m.GetFunc(ctx, id)
+}
+
+// List calls the ListFunc of the mock.
+func (m *{{ $mock }}) List(ctx context.Context, ps ...predicate.{{ $n.Name }}) ([]*{{ $ent }}.{{ $n.Name }}, error) {
+	if m.ListFunc == nil {
+		panic("{{ $mock }}.ListFunc is not set")
+	}
+	return m.ListFunc(ctx, ps...)
+}
+
+// Create calls the CreateFunc of the mock.
+func (m *{{ $mock }}) Create(ctx context.Context, v *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error) {
+	if m.CreateFunc == nil {
+		panic("{{ $mock }}.CreateFunc is not set")
+	}
+	return m.CreateFunc(ctx, v)
+}
+
+// Update calls the UpdateFunc of the mock.
+func (m *{{ $mock }}) Update(ctx context.Context, original, modified *{{ $ent }}.{{ $n.Name }}) (*{{ $ent }}.{{ $n.Name }}, error) {
+	if m.UpdateFunc == nil {
+		panic("{{ $mock }}.UpdateFunc is not set")
+	}
+	return m.UpdateFunc(ctx, original, modified)
+}
+
+// Delete calls the DeleteFunc of the mock.
+func (m *{{ $mock }}) Delete(ctx context.Context, id {{ $n.ID.Type }}) error {
+	if m.DeleteFunc == nil {
+		panic("{{ $mock }}.DeleteFunc is not set")
+	}
+	return m.DeleteFunc(ctx, id)
+}
+{{ end }}
+{{ end }}
+`))
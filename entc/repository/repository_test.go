@@ -0,0 +1,92 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package repository
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/facebookincubator/ent/entc/gen"
+	"github.com/facebookincubator/ent/entc/load"
+	"github.com/facebookincubator/ent/schema/field"
+
+	"github.com/stretchr/testify/require"
+)
+
+func graph(t *testing.T, storage string) *gen.Graph {
+	s, err := gen.NewStorage(storage)
+	require.NoError(t, err)
+	g, err := gen.NewGraph(&gen.Config{
+		Package: "entc/repository/ent",
+		Storage: s,
+		IDType:  &field.TypeInfo{Type: field.TypeInt},
+	},
+		&load.Schema{
+			Name: "Card",
+			Fields: []*load.Field{
+				{Name: "number", Info: &field.TypeInfo{Type: field.TypeString}},
+			},
+		},
+		&load.Schema{
+			Name: "FieldType",
+			Fields: []*load.Field{
+				{Name: "int", Info: &field.TypeInfo{Type: field.TypeInt}},
+			},
+		},
+	)
+	require.NoError(t, err)
+	return g
+}
+
+func TestHook(t *testing.T) {
+	target, err := ioutil.TempDir("", "repository")
+	require.NoError(t, err)
+	defer os.RemoveAll(target)
+	g := graph(t, "sql")
+	g.Config.Target = target
+	var called bool
+	next := gen.GenerateFunc(func(*gen.Graph) error {
+		called = true
+		return nil
+	})
+	require.NoError(t, Hook(Package("repo"))(next).Generate(g))
+	require.True(t, called)
+
+	decls := make(map[string]bool)
+	for _, name := range []string{"repository.go", "mock.go"} {
+		f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(target, "repo", name), nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, "repo", f.Name.Name)
+		for _, d := range f.Decls {
+			switch d := d.(type) {
+			case *ast.GenDecl:
+				for _, s := range d.Specs {
+					if s, ok := s.(*ast.TypeSpec); ok {
+						decls[s.Name.Name] = true
+					}
+				}
+			case *ast.FuncDecl:
+				decls[d.Name.Name] = true
+			}
+		}
+	}
+	for _, name := range []string{
+		"CardRepository", "cardRepository", "NewCardRepository", "CardRepositoryMock",
+		"FieldTypeRepository", "fieldTypeRepository", "NewFieldTypeRepository", "FieldTypeRepositoryMock",
+	} {
+		require.True(t, decls[name], "missing declaration %s", name)
+	}
+}
+
+func TestGenerate_Storage(t *testing.T) {
+	g := graph(t, "gremlin")
+	err := Generate(g)
+	require.Error(t, err, "only the sql storage is supported")
+}
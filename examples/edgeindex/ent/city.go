@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -24,6 +28,9 @@ type City struct {
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CityQuery when eager-loading is set.
 	Edges CityEdges `json:"edges"`
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // CityEdges holds the relations/edges for other nodes in the graph.
@@ -44,39 +51,153 @@ func (e CityEdges) StreetsOrErr() ([]*Street, error) {
 	return nil, &NotLoadedError{edge: "streets"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*City) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // name
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the City type are scanned as generic values.
+func (*City) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case city.FieldID:
+			values[i] = &sql.NullInt64{}
+		case city.FieldName:
+			values[i] = &sql.NullString{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the City fields.
-func (c *City) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(city.Columns); m < n {
+// to the City fields. Values of columns that were not selected are left untouched.
+func (c *City) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	c.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[0])
-	} else if value.Valid {
-		c.Name = value.String
+	for i := range columns {
+		switch columns[i] {
+		case city.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case city.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				c.Name = value.String
+			}
+		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (c *City) SelectValue(alias string) (Value, error) {
+	v, ok := c.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryStreets queries the streets edge of the City.
 func (c *City) QueryStreets() *StreetQuery {
 	return (&CityClient{config: c.config}).QueryStreets(c)
 }
 
+// LoadEdges loads the given edges of the City using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see city.Edges), and in this case, no edge is loaded.
+//
+//	err := c.LoadEdges(ctx, client, city.EdgeStreets)
+//
+func (c *City) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadCityEdges(ctx, client, []*City{c}, edges)
+}
+
+// loadCityEdges loads the given edges of all the given City entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using CityClient.LoadEdgeFor.
+func loadCityEdges(ctx context.Context, client *Client, nodes []*City, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case city.EdgeStreets:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type City", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.City.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the City and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the City alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The City itself is not modified.
+//
+//	b, err := c.DumpGraph(ctx, client, 2, city.EdgeStreets)
+//
+func (c *City) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for City DumpGraph", depth)
+	}
+	dumped := *c
+	dumped.Edges = CityEdges{}
+	if err := dumpCities(ctx, client, []*City{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpCities loads the edges of the given City copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpCities(ctx context.Context, client *Client, nodes []*City, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*City
+	for _, n := range nodes {
+		if dumper.visit(city.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range city.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadCityEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextStreets []*Street
+	for _, n := range visit {
+		for i, e := range n.Edges.Streets {
+			c := *e
+			c.Edges = StreetEdges{}
+			n.Edges.Streets[i] = &c
+			nextStreets = append(nextStreets, &c)
+		}
+	}
+	if err := dumpStreets(ctx, client, nextStreets, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this City.
 // Note that, you need to call City.Unwrap() before calling this method, if this City
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -95,6 +216,32 @@ func (c *City) Unwrap() *City {
 	return c
 }
 
+// Clone returns a deep copy of the City and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (c *City) Clone() *City {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the City and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (c *City) clone(seen map[interface{}]interface{}) *City {
+	if c == nil {
+		return nil
+	}
+	if v, ok := seen[c]; ok {
+		return v.(*City)
+	}
+	_c := *c
+	seen[c] = &_c
+	if c.Edges.Streets != nil {
+		_c.Edges.Streets = make([]*Street, len(c.Edges.Streets))
+		for _i := range c.Edges.Streets {
+			_c.Edges.Streets[_i] = c.Edges.Streets[_i].clone(seen)
+		}
+	}
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *City) String() string {
 	var builder strings.Builder
@@ -106,6 +253,26 @@ func (c *City) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the City, for change detection and cache keys.
+// It covers the fields that are listed in city.FingerprintFields, and it does not depend on the
+// ID of the City, on its edges, or on the order of the fields in the schema.
+func (c *City) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", c.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the City for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The City can be decoded using the FromMap method of its client.
+func (c *City) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[city.FieldID] = kvEncode(c.ID)
+	m[city.FieldName] = kvEncode(c.Name)
+	return m
+}
+
 // Cities is a parsable slice of City.
 type Cities []*City
 
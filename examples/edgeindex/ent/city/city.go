@@ -27,8 +27,29 @@ const (
 	StreetsColumn = "city_streets"
 )
 
+// Edges holds all the edge names of the City type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeStreets,
+}
+
+// FingerprintFields holds the fields of the City type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for city fields.
 var Columns = []string{
 	FieldID,
 	FieldName,
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
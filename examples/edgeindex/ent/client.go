@@ -8,10 +8,15 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/migrate"
+	"github.com/facebookincubator/ent/examples/edgeindex/ent/predicate"
 
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/street"
@@ -72,7 +77,9 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: tx, log: c.log, debug: c.debug, hooks: c.hooks}
+	tx.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = tx
 	return &Tx{
 		config: cfg,
 		City:   NewCityClient(cfg),
@@ -85,11 +92,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	if _, ok := c.driver.(*txDriver); ok {
 		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
 	}
-	tx, err := c.driver.(*sql.Driver).BeginTx(ctx, opts)
+	drv, ok := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("ent: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %v", err)
 	}
-	cfg := config{driver: &txDriver{tx: tx, drv: c.driver}, log: c.log, debug: c.debug, hooks: c.hooks}
+	txd := &txDriver{tx: tx, drv: c.driver}
+	txd.observe(ctx, c.txObserver)
+	cfg := c.config
+	cfg.driver = txd
 	return &Tx{
 		config: cfg,
 		City:   NewCityClient(cfg),
@@ -97,6 +113,211 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+// WithTx runs fn in a transaction, which is committed if fn returns nil, and rolled back if fn returns an
+// error or panics (the panic is re-raised after the rollback). If the context holds a transaction (see
+// NewTxContext), or the client is transactional, fn runs in the existing transaction within a savepoint
+// instead. The savepoint is released if fn returns nil, and rolled back to otherwise, without affecting
+// the changes that were made in the transaction before it. For example:
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		if _, err := tx.City.Create().Save(ctx); err != nil {
+//			return err
+//		}
+//		return nil
+//	})
+//
+func (c *Client) WithTx(ctx context.Context, fn func(tx *Tx) error) (err error) {
+	tx := TxFromContext(ctx)
+	if _, ok := c.driver.(*txDriver); ok && tx == nil {
+		tx = &Tx{config: c.config}
+		tx.init()
+	}
+	if tx != nil {
+		return tx.withSavepoint(ctx, fn)
+	}
+	if tx, err = c.Tx(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = tx.Rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// withSavepoint runs fn within a savepoint of the transaction. The functions that were registered
+// to run after commit by the mutations of fn are discarded if the savepoint is rolled back to.
+func (tx *Tx) withSavepoint(ctx context.Context, fn func(tx *Tx) error) error {
+	drv := tx.config.driver.(*txDriver)
+	drv.mu.Lock()
+	drv.savepoints++
+	name := fmt.Sprintf("ent_savepoint_%d", drv.savepoints)
+	committed := len(drv.afterCommit)
+	drv.mu.Unlock()
+	if err := drv.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: creating savepoint: %w", err)
+	}
+	rollback := func() error {
+		drv.mu.Lock()
+		drv.afterCommit = drv.afterCommit[:committed]
+		drv.mu.Unlock()
+		return drv.Exec(ctx, "ROLLBACK TO SAVEPOINT "+name, []interface{}{}, nil)
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			_ = rollback()
+			panic(v)
+		}
+	}()
+	if err := fn(tx); err != nil {
+		if rerr := rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back to savepoint: %v", err, rerr)
+		}
+		return err
+	}
+	if err := drv.Exec(ctx, "RELEASE SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return fmt.Errorf("ent: releasing savepoint: %w", err)
+	}
+	return nil
+}
+
+// Purge deletes the entities that match the given predicates from their tables in one transaction,
+// and returns the number of deleted rows per type label. The predicates are keyed by the type label,
+// and types without a predicate are not deleted. The types are deleted in dependency order (types
+// that hold foreign-keys to other types first) using their delete builders, and therefore, their
+// hooks are executed as well. Any error (or a canceled context) rolls back the transaction. For example:
+//
+//	tenant := func(s *sql.Selector) {
+//		s.Where(sql.EQ(s.C("tenant_id"), id))
+//	}
+//	report, err := client.Purge(ctx, map[string]func(*sql.Selector){
+//		city.Label: tenant,
+//		// ...
+//	})
+//
+// If the client is transactional, the entities are deleted in its transaction, and it is not committed.
+func (c *Client) Purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	for label := range preds {
+		switch label {
+		case street.Label, city.Label:
+		default:
+			return nil, fmt.Errorf("ent: unknown type label %q", label)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.purge(ctx, preds)
+	}
+	tx, err := c.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report, err := tx.Client().purge(ctx, preds)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%v: %v", err, rerr)
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// purge deletes the entities of the given predicates in dependency order.
+func (c *Client) purge(ctx context.Context, preds map[string]func(*sql.Selector)) (map[string]int, error) {
+	report := make(map[string]int, len(preds))
+	if p, ok := preds[street.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.Street.Delete().Where(predicate.Street(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging street: %w", err)
+		}
+		report[street.Label] = n
+	}
+	if p, ok := preds[city.Label]; ok {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		n, err := c.City.Delete().Where(predicate.City(p)).Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("ent: purging city: %w", err)
+		}
+		report[city.Label] = n
+	}
+	return report, nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given entities, that can be of different
+// types (e.g. the results of a polymorphic query). The entities are grouped by their concrete type, and
+// the edge of each group is loaded using the LoadEdgeFor method of its client. Hence, the number of
+// queries depends on the number of types, and not on the number of entities. For example:
+//
+//	err := client.LoadEdgeFor(ctx, []ent.Value{card, user}, "owner")
+//
+// The entities must be pointers to the generated types, and the edge must be defined on all their types.
+func (c *Client) LoadEdgeFor(ctx context.Context, nodes []Value, edge string) error {
+	var (
+		cityNodes   []*City
+		streetNodes []*Street
+	)
+	for _, v := range nodes {
+		switch node := v.(type) {
+		case *City:
+			cityNodes = append(cityNodes, node)
+		case *Street:
+			streetNodes = append(streetNodes, node)
+		default:
+			return fmt.Errorf("ent: unexpected entity type %T", v)
+		}
+	}
+	if len(cityNodes) > 0 {
+		if err := c.City.LoadEdgeFor(ctx, cityNodes, edge); err != nil {
+			return err
+		}
+	}
+	if len(streetNodes) > 0 {
+		if err := c.Street.LoadEdgeFor(ctx, streetNodes, edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshMaterializedView replaces the contents of the materialized view with the given name by
+// re-executing its query (PostgreSQL only). If concurrently is true, the view is refreshed without
+// locking out concurrent reads from it, which requires a unique index on the view. For example:
+//
+//	err := client.RefreshMaterializedView(ctx, "card_stats", true)
+//
+// Read-only entities that query the view are defined using the View option in the schema config.
+func (c *Client) RefreshMaterializedView(ctx context.Context, name string, concurrently bool) error {
+	if d := c.driver.Dialect(); d != dialect.Postgres {
+		return fmt.Errorf("ent: materialized views are not supported by dialect %q", d)
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("REFRESH MATERIALIZED VIEW ")
+	if concurrently {
+		b.WriteString("CONCURRENTLY ")
+	}
+	query, args := b.Ident(name).Query()
+	if err := c.driver.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("ent: refreshing materialized view %q: %v", name, err)
+	}
+	return nil
+}
+
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
@@ -108,7 +329,9 @@ func (c *Client) Debug() *Client {
 	if c.debug {
 		return c
 	}
-	cfg := config{driver: dialect.Debug(c.driver, c.log), log: c.log, debug: true, hooks: c.hooks}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	cfg.debug = true
 	client := &Client{config: cfg}
 	client.init()
 	return client
@@ -148,6 +371,174 @@ func (c *CityClient) Create() *CityCreate {
 	return &CityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of City entities.
+func (c *CityClient) CreateBulk(builders ...*CityCreate) *CityCreateBulk {
+	return &CityCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads City builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.City.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *CityClient) CreateBulkFrom(ctx context.Context, ch <-chan *CityCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for City bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*CityCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*CityCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*CityCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the City that matches the given predicates, or creates it using
+// the given builder if there is no such City. The returned bool reports whether the
+// City was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the City is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.City.FindOrCreate(ctx, ps, client.City.Create())
+//
+func (c *CityClient) FindOrCreate(ctx context.Context, ps []predicate.City, create *CityCreate) (*City, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.City.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the City using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *CityClient) findOrCreate(ctx context.Context, ps []predicate.City, create *CityCreate) (*City, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the cities table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the City columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown City columns are rejected before the statement is executed.
+//
+//	n, err := client.City.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		city.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *CityClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   city.Table,
+		Columns: city.Columns,
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given City and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+//	node, err := client.City.CopyToCreate(ci).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *CityClient) CopyToCreate(ci *City) *CityCreate {
+	create := c.Create()
+	create.SetName(ci.Name)
+	return create
+}
+
 // Update returns an update builder for City.
 func (c *CityClient) Update() *CityUpdate {
 	mutation := newCityMutation(c.config, OpUpdate)
@@ -166,6 +557,136 @@ func (c *CityClient) UpdateOneID(id int) *CityUpdateOne {
 	return &CityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CityPatch holds the field changes of a City for UpdateByIDMap, keyed by the field
+// name (e.g. city.FieldName). A nil value clears an optional field.
+type CityPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the City with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.City.UpdateByIDMap(ctx, map[int]ent.CityPatch{
+//		id1: {city.FieldName: v1},
+//		id2: {city.FieldName: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *CityClient) UpdateByIDMap(ctx context.Context, m map[int]CityPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *CityClient) patchUpdates(m map[int]CityPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newCityMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid City patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&CityUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(city.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, city.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(city.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given City entities, and updates the original City only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original City is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := ci.Clone()
+//	modified.Field = value
+//	ci, err = client.City.UpdateFromDiff(ctx, ci, modified)
+//
+func (c *CityClient) UpdateFromDiff(ctx context.Context, original, modified *City) (*City, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for City UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for City.
 func (c *CityClient) Delete() *CityDelete {
 	mutation := newCityMutation(c.config, OpDelete)
@@ -204,6 +725,52 @@ func (c *CityClient) GetX(ctx context.Context, id int) *City {
 	return ci
 }
 
+// GetMany returns the City entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *CityClient) GetMany(ctx context.Context, ids ...int) ([]*City, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(city.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*City, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*City, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *CityClient) GetManyX(ctx context.Context, ids ...int) []*City {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a City from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *CityClient) FromMap(m map[string]string) (*City, error) {
+	ci := &City{config: c.config}
+	if err := kvDecode(m, city.FieldID, &ci.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, city.FieldName, &ci.Name, true); err != nil {
+		return nil, err
+	}
+	return ci, nil
+}
+
 // QueryStreets queries the streets edge of a City.
 func (c *CityClient) QueryStreets(ci *City) *StreetQuery {
 	query := &StreetQuery{config: c.config}
@@ -220,9 +787,84 @@ func (c *CityClient) QueryStreets(ci *City) *StreetQuery {
 	return query
 }
 
+// LoadStreetsFor loads the "streets" edge of all the given City entities with one query (per
+// batch of ids), and assigns the results to their edges. Previously loaded edges are replaced.
+// It is the explicit alternative to WithStreets for cities that were already loaded.
+func (c *CityClient) LoadStreetsFor(ctx context.Context, nodes []*City, opts ...func(*StreetQuery)) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	cq := c.Query().WithStreets(opts...)
+	for _, node := range nodes {
+		node.Edges.Streets = nil
+	}
+
+	if query := cq.withStreets; query != nil {
+		// Ids are sent in batches, and the predicates of the query
+		// are restored before executing each one of them.
+		preds := query.predicates
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*City)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		// The limit and the offset of the query are applied per node.
+		query.partition = city.StreetsColumn
+		err := cq.eagerLoadBatches(ctx, len(fks), func(i, j int) error {
+			query.predicates = append(preds[:len(preds):len(preds)], predicate.Street(func(s *sql.Selector) {
+				s.Where(sql.InValues(city.StreetsColumn, fks[i:j]...))
+			}))
+			neighbors, err := query.All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, n := range neighbors {
+				fk := n.city_streets
+				if fk == nil {
+					return fmt.Errorf(`foreign-key "city_streets" is nil for node %v`, n.ID)
+				}
+				node, ok := nodeids[*fk]
+				if !ok {
+					return fmt.Errorf(`unexpected foreign-key "city_streets" returned %v for node %v`, *fk, n.ID)
+				}
+				node.Edges.Streets = append(node.Edges.Streets, n)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		node.Edges.loadedTypes[0] = true
+	}
+	return nil
+}
+
+// LoadEdgeFor loads the edge with the given name of all the given City entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *CityClient) LoadEdgeFor(ctx context.Context, nodes []*City, edge string) error {
+	switch edge {
+	case city.EdgeStreets:
+		return c.LoadStreetsFor(ctx, nodes)
+	default:
+		return fmt.Errorf("ent: unknown City edge %q", edge)
+	}
+}
+
 // Hooks returns the client hooks.
 func (c *CityClient) Hooks() []Hook {
-	return c.hooks.City
+	hooks := c.hooks.City
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(city.Label)}, hooks...)
+	}
+	return hooks
 }
 
 // StreetClient is a client for the Street schema.
@@ -247,6 +889,341 @@ func (c *StreetClient) Create() *StreetCreate {
 	return &StreetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// CreateBulk returns a builder for creating a bulk of Street entities.
+func (c *StreetClient) CreateBulk(builders ...*StreetCreate) *StreetCreateBulk {
+	return &StreetCreateBulk{config: c.config, builders: builders}
+}
+
+// CreateBulkFrom reads Street builders from the given channel, and saves them in batches of
+// batchSize builders using CreateBulk. The result of each batch is sent to the returned channel,
+// and a failed batch does not stop the saving of the batches after it. When the builders channel
+// is closed, the last (partial) batch is saved and the results channel is closed.
+//
+// If the context is canceled, the builders that were not saved are discarded, a result with the
+// context error is sent, and the results channel is closed. The results channel must be drained.
+//
+//	results, err := client.Street.CreateBulkFrom(ctx, builders, 100)
+//	if err != nil {
+//		return err
+//	}
+//	for res := range results {
+//		if res.Err != nil {
+//			log.Println("failed saving batch:", res.Err)
+//		}
+//	}
+//
+func (c *StreetClient) CreateBulkFrom(ctx context.Context, ch <-chan *StreetCreate, batchSize int) (<-chan BulkResult, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("ent: invalid batch size %d for Street bulk", batchSize)
+	}
+	results := make(chan BulkResult)
+	go func() {
+		defer close(results)
+		save := func(batch []*StreetCreate) error {
+			nodes, err := c.CreateBulk(batch...).Save(ctx)
+			res := BulkResult{Err: err}
+			if err == nil {
+				res.IDs = make([]Value, len(nodes))
+				for i, n := range nodes {
+					res.IDs[i] = n.ID
+				}
+			}
+			results <- res
+			return err
+		}
+		batch := make([]*StreetCreate, 0, batchSize)
+		for {
+			select {
+			case <-ctx.Done():
+				results <- BulkResult{Err: ctx.Err()}
+				return
+			case builder, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						save(batch)
+					}
+					return
+				}
+				if batch = append(batch, builder); len(batch) < batchSize {
+					continue
+				}
+				// A batch that failed on cancellation already reported the context error.
+				if err := save(batch); err != nil && ctx.Err() != nil {
+					return
+				}
+				batch = make([]*StreetCreate, 0, batchSize)
+			}
+		}
+	}()
+	return results, nil
+}
+
+// FindOrCreate returns the Street that matches the given predicates, or creates it using
+// the given builder if there is no such Street. The returned bool reports whether the
+// Street was created by this call.
+//
+// The insertion is executed in a transaction with `ON CONFLICT DO NOTHING` (`INSERT IGNORE`
+// in MySQL), and if the row was inserted concurrently by another writer, the Street is
+// selected again using the given predicates. The transaction is started like Client.Tx, and
+// therefore, its observer and the hooks that run after commit are called as well. If the client
+// is transactional, the existing transaction is used.
+//
+//	node, created, err := client.Street.FindOrCreate(ctx, ps, client.Street.Create())
+//
+func (c *StreetClient) FindOrCreate(ctx context.Context, ps []predicate.Street, create *StreetCreate) (*Street, bool, error) {
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err == nil || !IsNotFound(err) {
+		return node, false, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.findOrCreate(ctx, ps, create)
+	}
+	tx, err := (&Client{config: c.config}).Tx(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	node, created, err := tx.Street.findOrCreate(ctx, ps, create)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: %v", err, rerr)
+		}
+		return nil, false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	// Entities that were loaded inside the transaction
+	// should not be bound to it after it was committed.
+	node.config = c.config
+	return node, created, nil
+}
+
+// findOrCreate inserts the Street using the client driver (a transaction),
+// and falls back to selecting it in case it already exists in the database.
+// The builder is bound to the client driver (and hooks) only for the insertion.
+func (c *StreetClient) findOrCreate(ctx context.Context, ps []predicate.Street, create *StreetCreate) (*Street, bool, error) {
+	defer func(drv dialect.Driver, hooks []Hook) {
+		create.driver, create.hooks = drv, hooks
+		create.mutation.driver = drv
+	}(create.driver, create.hooks)
+	create.driver, create.hooks = c.driver, c.Hooks()
+	create.mutation.driver = c.driver
+	nodes, _, err := c.CreateBulk(create).OnConflict().DoNothing().Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(nodes) == 1 {
+		return nodes[0], true, nil
+	}
+	node, err := c.Query().Where(ps...).Only(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	return node, false, nil
+}
+
+// CreateFromSelect inserts the rows that are selected by the given query into the streets table
+// using one statement (`INSERT INTO ... SELECT ...`), and returns the number of inserted rows. The columns
+// map the Street columns to the columns of the query, and the predicates of the query are carried over
+// to the statement. Unknown Street columns are rejected before the statement is executed.
+//
+//	n, err := client.Street.CreateFromSelect(ctx, client.T.Query().Where(...), map[string]string{
+//		street.Column: t.Column,
+//	})
+//
+// Note that the rows are copied by the database, and therefore, hooks, defaults and validators are not
+// executed, and queries that were created by traversing an edge of another query are not supported.
+func (c *StreetClient) CreateFromSelect(ctx context.Context, src sqlgraph.IDsQuerier, columns map[string]string) (int, error) {
+	return sqlgraph.InsertSelect(ctx, c.driver, &sqlgraph.InsertSelectSpec{
+		Table:   street.Table,
+		Columns: append(street.Columns[:len(street.Columns):len(street.Columns)], street.ForeignKeys...),
+		Mapping: columns,
+		Source:  src,
+	})
+}
+
+// CopyToCreate returns a create builder that is populated with the fields of the given Street and
+// its M2O edges, for duplicating it. The id, optional fields that hold their zero value (or nil) and
+// time fields with default values (e.g. create_time) are not copied, and they are populated by the
+// builder (and the database) when it is saved. Note that unique fields must be changed before saving.
+//
+// M2O edges are copied only if their foreign-keys were loaded by the query, i.e. if one of the
+// edges was eager-loaded (e.g. using With<Edge>).
+//
+//	node, err := client.Street.CopyToCreate(s).
+//		SetX(x).
+//		Save(ctx)
+//
+func (c *StreetClient) CopyToCreate(s *Street) *StreetCreate {
+	create := c.Create()
+	create.SetName(s.Name)
+	if fk := s.city_streets; fk != nil {
+		create.SetCityID(*fk)
+	}
+	return create
+}
+
+// Sync reconciles the Street entities in the database with the given desired set in one transaction.
+// The builders are matched with the stored entities by the values of the given key fields, which must
+// be set on all builders, and must be unique (or be covered by a unique index) in the database. Then:
+//
+//	- Builders without a stored entity are inserted.
+//	- Builders whose fields (or foreign-keys) differ from their stored entity are updated.
+//	- Stored entities whose keys are absent from the desired set are deleted.
+//
+// The inserts and the updates are executed using one bulk upsert (see OnConflict and UpdateNewValues),
+// and the deletion using one delete statement with a key-not-in predicate. Therefore, the hooks of the
+// create and the delete builders are executed, and unchanged entities are not written at all. If
+// the context is canceled between the statements, Sync stops and the transaction is rolled back.
+//
+//	res, err := client.Street.Sync(ctx, builders, []string{street.FieldName})
+//
+// The SyncScope option restricts the stored entities that are matched and deleted to a subset of the
+// table (e.g. the entities of one owner). If the client is transactional, the changes are applied in
+// its transaction, and it is not committed.
+func (c *StreetClient) Sync(ctx context.Context, desired []*StreetCreate, keyFields []string, opts ...SyncOption) (SyncResult, error) {
+	if len(keyFields) == 0 {
+		return SyncResult{}, errors.New("ent: missing key fields for Street sync")
+	}
+	for _, f := range keyFields {
+		switch f {
+		case street.FieldName:
+		default:
+			return SyncResult{}, fmt.Errorf("ent: invalid key field %q for Street sync", f)
+		}
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return c.sync(ctx, desired, keyFields, opts)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	cfg := c.config
+	cfg.driver = tx
+	res, err := (&StreetClient{config: cfg}).sync(ctx, desired, keyFields, opts)
+	if err != nil {
+		return SyncResult{}, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return SyncResult{}, err
+	}
+	tx.committed()
+	return res, nil
+}
+
+// sync applies the changes of Sync using the client driver (a transaction).
+func (c *StreetClient) sync(ctx context.Context, desired []*StreetCreate, keyFields []string, opts []SyncOption) (SyncResult, error) {
+	o := &syncOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	scope := make([]predicate.Street, len(o.scope))
+	for i, p := range o.scope {
+		scope[i] = p
+	}
+	var (
+		res  SyncResult
+		keys = make([][]Value, len(desired))
+		seen = make(map[string]int, len(desired))
+	)
+	for i, builder := range desired {
+		builder.driver = c.driver
+		builder.mutation.driver = c.driver
+		builder.defaults()
+		key := make([]Value, len(keyFields))
+		for j, f := range keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return res, fmt.Errorf("ent: missing key field %q in Street builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return res, fmt.Errorf("ent: Street builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Street)
+	if len(keys) > 0 {
+		query := c.Query().Where(scope...).Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, keyFields, keys))
+		})
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(keyFields))
+			for j, f := range keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+	}
+	var writes []*StreetCreate
+	for i, builder := range desired {
+		switch n, ok := stored[syncKey(keys[i])]; {
+		case !ok:
+			res.Inserted++
+		case n.syncChanged(builder.mutation):
+			res.Updated++
+		default:
+			res.Unchanged++
+			continue
+		}
+		writes = append(writes, builder)
+	}
+	// Statements are not issued after the context was canceled.
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	if len(writes) > 0 {
+		if _, _, err := c.CreateBulk(writes...).OnConflict(keyFields...).UpdateNewValues().Save(ctx); err != nil {
+			return SyncResult{}, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return SyncResult{}, err
+	}
+	del := c.Delete().Where(scope...)
+	if len(keys) > 0 {
+		del.Where(func(s *sql.Selector) {
+			s.Where(sql.Not(syncPredicate(s, keyFields, keys)))
+		})
+	}
+	n, err := del.Exec(ctx)
+	if err != nil {
+		return SyncResult{}, err
+	}
+	res.Deleted = n
+	return res, nil
+}
+
+// syncValue returns the value of the given key field of the Street.
+func (s *Street) syncValue(field string) Value {
+	switch field {
+	case street.FieldName:
+		return s.Name
+	}
+	return nil
+}
+
+// syncChanged reports whether the values of the mutation differ from the stored values of the
+// Street. Fields that are not set on the mutation, immutable fields and fields with an update
+// default are ignored, because they are not changed by the upsert (or changed on every write).
+func (s *Street) syncChanged(mutation *StreetMutation) bool {
+	if v, ok := mutation.Name(); ok && (v != s.Name) {
+		return true
+	}
+	if ids := mutation.CityIDs(); len(ids) > 0 && (s.city_streets == nil || *s.city_streets != ids[0]) {
+		return true
+	}
+	return false
+}
+
 // Update returns an update builder for Street.
 func (c *StreetClient) Update() *StreetUpdate {
 	mutation := newStreetMutation(c.config, OpUpdate)
@@ -265,6 +1242,146 @@ func (c *StreetClient) UpdateOneID(id int) *StreetUpdateOne {
 	return &StreetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
+// StreetPatch holds the field changes of a Street for UpdateByIDMap, keyed by the field
+// name (e.g. street.FieldName). A nil value clears an optional field.
+type StreetPatch map[string]Value
+
+// UpdateByIDMap applies the changes of each patch to the Street with the matching id, and returns the
+// total number of affected rows. All patches are validated (unknown, immutable or mistyped fields, and the
+// field validators) before any statement is executed. Then, the patches are grouped by the set of fields
+// they change, and each group is applied using one UPDATE statement with a CASE expression per field:
+//
+//	n, err := client.Street.UpdateByIDMap(ctx, map[int]ent.StreetPatch{
+//		id1: {street.FieldName: v1},
+//		id2: {street.FieldName: v2},
+//	})
+//
+// The statements are executed in one transaction. Update hooks are not executed, but fields with an update
+// default (e.g. update_time) are set like in the update builders. If the client is transactional, the
+// statements are executed in its transaction, and it is not committed.
+func (c *StreetClient) UpdateByIDMap(ctx context.Context, m map[int]StreetPatch) (int, error) {
+	updates, err := c.patchUpdates(m)
+	if err != nil || len(updates) == 0 {
+		return 0, err
+	}
+	if _, ok := c.driver.(*txDriver); ok {
+		return execUpdates(ctx, c.driver, updates)
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return 0, err
+	}
+	n, err := execUpdates(ctx, tx, updates)
+	if err != nil {
+		return 0, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return 0, err
+	}
+	tx.committed()
+	return n, nil
+}
+
+// patchUpdates validates the patches of UpdateByIDMap, and returns an UPDATE statement for
+// each group of patches that change the same set of fields.
+func (c *StreetClient) patchUpdates(m map[int]StreetPatch) ([]*sql.UpdateBuilder, error) {
+	type patchGroup struct {
+		fields []string
+		ids    []interface{}
+		values [][]interface{}
+	}
+	var (
+		keys   []string
+		groups = make(map[string]*patchGroup)
+	)
+	for id, patch := range m {
+		if len(patch) == 0 {
+			continue
+		}
+		mutation := newStreetMutation(c.config, OpUpdateOne)
+		fields := make([]string, 0, len(patch))
+		for name, v := range patch {
+			var err error
+			if v == nil {
+				err = mutation.ClearField(name)
+			} else {
+				err = mutation.SetField(name, v)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("ent: invalid Street patch of id %v: %w", id, err)
+			}
+			fields = append(fields, name)
+		}
+		if err := (&StreetUpdateOne{config: c.config, mutation: mutation}).check(); err != nil {
+			return nil, err
+		}
+		sort.Strings(fields)
+		key := strings.Join(fields, ",")
+		g, ok := groups[key]
+		if !ok {
+			g = &patchGroup{fields: fields, values: make([][]interface{}, len(fields))}
+			groups[key] = g
+			keys = append(keys, key)
+		}
+		g.ids = append(g.ids, id)
+		for i, f := range fields {
+			var v interface{}
+			if fv, ok := mutation.Field(f); ok {
+				v = fv
+			}
+			g.values[i] = append(g.values[i], v)
+		}
+	}
+	sort.Strings(keys)
+	updates := make([]*sql.UpdateBuilder, len(keys))
+	for i, key := range keys {
+		g := groups[key]
+		update := sql.Dialect(c.driver.Dialect()).Update(street.Table)
+		for j, f := range g.fields {
+			update.SetCase(f, street.FieldID, g.ids, g.values[j])
+		}
+		updates[i] = update.Where(sql.In(street.FieldID, g.ids...))
+	}
+	return updates, nil
+}
+
+// UpdateFromDiff compares the given Street entities, and updates the original Street only with the
+// fields that were changed in the modified one. Unique edges are reassigned (or cleared) only if they were
+// loaded on both entities and their ids differ. If nothing was changed, no statement is executed and the
+// original Street is returned as is, without touching its update-default fields (e.g. update_time).
+//
+//	modified := s.Clone()
+//	modified.Field = value
+//	s, err = client.Street.UpdateFromDiff(ctx, s, modified)
+//
+func (c *StreetClient) UpdateFromDiff(ctx context.Context, original, modified *Street) (*Street, error) {
+	if original.ID != modified.ID {
+		return nil, fmt.Errorf("ent: mismatched ids %v and %v for Street UpdateFromDiff", original.ID, modified.ID)
+	}
+	var (
+		changed bool
+		update  = c.UpdateOneID(original.ID)
+	)
+	if modified.Name != original.Name {
+		update.SetName(modified.Name)
+		changed = true
+	}
+	if modified.Edges.loadedTypes[0] && original.Edges.loadedTypes[0] {
+		switch v, o := modified.Edges.City, original.Edges.City; {
+		case v == nil && o != nil:
+			update.ClearCity()
+			changed = true
+		case v != nil && (o == nil || v.ID != o.ID):
+			update.SetCityID(v.ID)
+			changed = true
+		}
+	}
+	if !changed {
+		return original, nil
+	}
+	return update.Save(ctx)
+}
+
 // Delete returns a delete builder for Street.
 func (c *StreetClient) Delete() *StreetDelete {
 	mutation := newStreetMutation(c.config, OpDelete)
@@ -303,6 +1420,52 @@ func (c *StreetClient) GetX(ctx context.Context, id int) *Street {
 	return s
 }
 
+// GetMany returns the Street entities of the given ids using one query. The returned
+// slice is ordered by the given ids (and has the same length), and ids that were not found
+// have a nil entity in their position. This is useful for batching loaders (e.g. GraphQL).
+func (c *StreetClient) GetMany(ctx context.Context, ids ...int) ([]*Street, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	nodes, err := c.Query().Where(street.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Street, len(nodes))
+	for _, n := range nodes {
+		byID[n.ID] = n
+	}
+	result := make([]*Street, len(ids))
+	for i, id := range ids {
+		result[i] = byID[id]
+	}
+	return result, nil
+}
+
+// GetManyX is like GetMany, but panics if an error occurs.
+func (c *StreetClient) GetManyX(ctx context.Context, ids ...int) []*Street {
+	nodes, err := c.GetMany(ctx, ids...)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// FromMap decodes a Street from its flat key-value representation that was created by its ToMap
+// method. Absent keys of nillable fields are decoded as nil, and absent keys of optional fields as zero
+// values. The values of fields with validators (or enum values) are validated, and the returned entity
+// is bound to the client (i.e. its edges can be queried).
+func (c *StreetClient) FromMap(m map[string]string) (*Street, error) {
+	s := &Street{config: c.config}
+	if err := kvDecode(m, street.FieldID, &s.ID, true); err != nil {
+		return nil, err
+	}
+	if err := kvDecode(m, street.FieldName, &s.Name, true); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 // QueryCity queries the city edge of a Street.
 func (c *StreetClient) QueryCity(s *Street) *CityQuery {
 	query := &CityQuery{config: c.config}
@@ -319,7 +1482,54 @@ func (c *StreetClient) QueryCity(s *Street) *CityQuery {
 	return query
 }
 
+// LoadEdgeFor loads the edge with the given name of all the given Street entities, and assigns
+// the results to their edges. O2M and M2M edges are loaded using their Load<Edge>For method. Unique
+// edges are loaded by querying the entities again with the edge, because their foreign-keys may have
+// not been loaded. In both cases, the edge is loaded with one query per batch of ids.
+func (c *StreetClient) LoadEdgeFor(ctx context.Context, nodes []*Street, edge string) error {
+	var (
+		query  func(*StreetQuery)
+		assign func(node, loaded *Street)
+	)
+	switch edge {
+	case street.EdgeCity:
+		query = func(q *StreetQuery) { q.WithCity() }
+		assign = func(node, loaded *Street) {
+			node.Edges.City, node.Edges.loadedTypes[0] = loaded.Edges.City, true
+		}
+	default:
+		return fmt.Errorf("ent: unknown Street edge %q", edge)
+	}
+	ids := make([]int, 0, len(nodes))
+	byID := make(map[int][]*Street, len(nodes))
+	for _, node := range nodes {
+		if _, ok := byID[node.ID]; !ok {
+			ids = append(ids, node.ID)
+		}
+		byID[node.ID] = append(byID[node.ID], node)
+	}
+	return c.eagerLoadBatches(ctx, len(ids), func(i, j int) error {
+		q := c.Query().Where(street.IDIn(ids[i:j]...))
+		query(q)
+		loaded, err := q.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, l := range loaded {
+			for _, node := range byID[l.ID] {
+				assign(node, l)
+			}
+		}
+		return nil
+	})
+}
+
 // Hooks returns the client hooks.
 func (c *StreetClient) Hooks() []Hook {
-	return c.hooks.Street
+	hooks := c.hooks.Street
+	if tx, ok := c.driver.(*txDriver); ok && tx.observer != nil {
+		// Record the mutated entities in transactions that are observed.
+		return append([]Hook{tx.record(street.Label)}, hooks...)
+	}
+	return hooks
 }
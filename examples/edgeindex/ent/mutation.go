@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
@@ -72,6 +73,18 @@ func (m CityMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CityMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CityMutation) ID() (id int, exists bool) {
@@ -152,6 +165,11 @@ func (m *CityMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CityMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -337,6 +355,7 @@ type StreetMutation struct {
 	clearedFields map[string]struct{}
 	city          *int
 	clearedcity   bool
+	conflictcity  bool
 }
 
 var _ ent.Mutation = (*StreetMutation)(nil)
@@ -370,6 +389,18 @@ func (m StreetMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m StreetMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *StreetMutation) ID() (id int, exists bool) {
@@ -401,11 +432,15 @@ func (m *StreetMutation) ResetName() {
 // SetCityID sets the city edge to City by id.
 func (m *StreetMutation) SetCityID(id int) {
 	m.city = &id
+	m.conflictcity = false
 }
 
-// ClearCity clears the city edge to City.
+// ClearCity clears the city edge to City. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *StreetMutation) ClearCity() {
 	m.clearedcity = true
+	m.conflictcity = m.city != nil
 }
 
 // CityCleared returns if the edge city was cleared.
@@ -421,6 +456,34 @@ func (m *StreetMutation) CityID() (id int, exists bool) {
 	return
 }
 
+// CityIDChanged reports if the city edge is set to a different City, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *StreetMutation) CityIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.city == nil && !m.clearedcity {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: CityIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: CityIDChanged requires an ID field in the mutation")
+	}
+	if m.city != nil {
+		newID = *m.city
+	}
+	oldID, err = m.Client().Street.Query().Where(street.ID(id)).QueryCity().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.city != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old city id: %v", err)
+	}
+	return oldID, newID, m.city == nil || oldID != newID, nil
+}
+
 // CityIDs returns the city ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // CityID instead. It exists only for internal usage by the builders.
@@ -435,6 +498,7 @@ func (m *StreetMutation) CityIDs() (ids []int) {
 func (m *StreetMutation) ResetCity() {
 	m.city = nil
 	m.clearedcity = false
+	m.conflictcity = false
 }
 
 // Op returns the operation name.
@@ -447,6 +511,11 @@ func (m *StreetMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *StreetMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -26,6 +30,9 @@ type Street struct {
 	// The values are being populated by the StreetQuery when eager-loading is set.
 	Edges        StreetEdges `json:"edges"`
 	city_streets *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // StreetEdges holds the relations/edges for other nodes in the graph.
@@ -51,55 +58,162 @@ func (e StreetEdges) CityOrErr() (*City, error) {
 	return nil, &NotLoadedError{edge: "city"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Street) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // name
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Street) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // city_streets
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Street type are scanned as generic values.
+func (*Street) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case street.FieldID:
+			values[i] = &sql.NullInt64{}
+		case street.FieldName:
+			values[i] = &sql.NullString{}
+		case street.ForeignKeys[0]: // city_streets
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Street fields.
-func (s *Street) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(street.Columns); m < n {
+// to the Street fields. Values of columns that were not selected are left untouched.
+func (s *Street) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	s.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[0])
-	} else if value.Valid {
-		s.Name = value.String
-	}
-	values = values[1:]
-	if len(values) == len(street.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field city_streets", value)
-		} else if value.Valid {
-			s.city_streets = new(int)
-			*s.city_streets = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case street.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case street.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				s.Name = value.String
+			}
+		case street.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field city_streets", value)
+			} else if value.Valid {
+				s.city_streets = new(int)
+				*s.city_streets = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (s *Street) SelectValue(alias string) (Value, error) {
+	v, ok := s.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryCity queries the city edge of the Street.
 func (s *Street) QueryCity() *CityQuery {
 	return (&StreetClient{config: s.config}).QueryCity(s)
 }
 
+// LoadEdges loads the given edges of the Street using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see street.Edges), and in this case, no edge is loaded.
+//
+//	err := s.LoadEdges(ctx, client, street.EdgeCity)
+//
+func (s *Street) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadStreetEdges(ctx, client, []*Street{s}, edges)
+}
+
+// loadStreetEdges loads the given edges of all the given Street entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using StreetClient.LoadEdgeFor.
+func loadStreetEdges(ctx context.Context, client *Client, nodes []*Street, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case street.EdgeCity:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Street", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Street.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Street and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Street alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Street itself is not modified.
+//
+//	b, err := s.DumpGraph(ctx, client, 2, street.EdgeCity)
+//
+func (s *Street) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Street DumpGraph", depth)
+	}
+	dumped := *s
+	dumped.Edges = StreetEdges{}
+	if err := dumpStreets(ctx, client, []*Street{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpStreets loads the edges of the given Street copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpStreets(ctx context.Context, client *Client, nodes []*Street, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Street
+	for _, n := range nodes {
+		if dumper.visit(street.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range street.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadStreetEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextCity []*City
+	for _, n := range visit {
+		if e := n.Edges.City; e != nil {
+			c := *e
+			c.Edges = CityEdges{}
+			n.Edges.City = &c
+			nextCity = append(nextCity, &c)
+		}
+	}
+	if err := dumpCities(ctx, client, nextCity, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Street.
 // Note that, you need to call Street.Unwrap() before calling this method, if this Street
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -118,6 +232,27 @@ func (s *Street) Unwrap() *Street {
 	return s
 }
 
+// Clone returns a deep copy of the Street and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (s *Street) Clone() *Street {
+	return s.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Street and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (s *Street) clone(seen map[interface{}]interface{}) *Street {
+	if s == nil {
+		return nil
+	}
+	if v, ok := seen[s]; ok {
+		return v.(*Street)
+	}
+	_c := *s
+	seen[s] = &_c
+	_c.Edges.City = s.Edges.City.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (s *Street) String() string {
 	var builder strings.Builder
@@ -129,6 +264,26 @@ func (s *Street) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Street, for change detection and cache keys.
+// It covers the fields that are listed in street.FingerprintFields, and it does not depend on the
+// ID of the Street, on its edges, or on the order of the fields in the schema.
+func (s *Street) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", s.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Street for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Street can be decoded using the FromMap method of its client.
+func (s *Street) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[street.FieldID] = kvEncode(s.ID)
+	m[street.FieldName] = kvEncode(s.Name)
+	return m
+}
+
 // Streets is a parsable slice of Street.
 type Streets []*Street
 
@@ -27,6 +27,17 @@ const (
 	CityColumn = "city_streets"
 )
 
+// Edges holds all the edge names of the Street type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeCity,
+}
+
+// FingerprintFields holds the fields of the Street type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for street fields.
 var Columns = []string{
 	FieldID,
@@ -37,3 +48,13 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"city_streets",
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
@@ -10,7 +10,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/city"
 	"github.com/facebookincubator/ent/examples/edgeindex/ent/street"
@@ -51,8 +54,12 @@ func (sc *StreetCreate) SetCity(c *City) *StreetCreate {
 
 // Save creates the Street in the database.
 func (sc *StreetCreate) Save(ctx context.Context) (*Street, error) {
-	if _, ok := sc.mutation.Name(); !ok {
-		return nil, errors.New("ent: missing required field \"name\"")
+	if err := checkTx(sc.driver); err != nil {
+		return nil, err
+	}
+	sc.defaults()
+	if err := sc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -89,17 +96,115 @@ func (sc *StreetCreate) SaveX(ctx context.Context) *Street {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (sc *StreetCreate) Unwrap() *StreetCreate {
+	tx, ok := sc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StreetCreate is not a transactional builder")
+	}
+	sc.config.driver = tx.drv
+	sc.mutation.driver = tx.drv
+	return sc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (sc *StreetCreate) DebugFields() map[string]Value {
+	return debugFields(sc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *StreetCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *StreetCreate) check() error {
+	if _, ok := sc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New("ent: missing required field \"name\"")}
+	}
+	return nil
+}
+
 func (sc *StreetCreate) sqlSave(ctx context.Context) (*Street, error) {
-	var (
-		s     = &Street{config: sc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: street.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: street.FieldID,
-			},
+	ctx = sc.withOperation(ctx, "Street", "Create")
+	s, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	s.ID = int(id)
+	return s, nil
+}
+
+// SaveID creates the Street in the database and returns only its id. Unlike Save,
+// the Street entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Street that holds only its id as the value that is returned by the next mutator.
+func (sc *StreetCreate) SaveID(ctx context.Context) (id int, err error) {
+	sc.defaults()
+	if err = sc.check(); err != nil {
+		return id, err
+	}
+	if len(sc.hooks) == 0 {
+		return sc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*StreetMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		sc.mutation = mutation
+		if id, err = sc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Street{config: sc.config, ID: id}, nil
+	})
+	for i := len(sc.hooks) - 1; i >= 0; i-- {
+		mut = sc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, sc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (sc *StreetCreate) SaveIDX(ctx context.Context) int {
+	id, err := sc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (sc *StreetCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = sc.withOperation(ctx, "Street", "Create")
+	_spec := sc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, sc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (sc *StreetCreate) createSpec() (*Street, *sqlgraph.CreateSpec) {
+	s := &Street{config: sc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: street.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: street.FieldID,
+		},
+	}
 	if value, ok := sc.mutation.Name(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -127,13 +232,614 @@ func (sc *StreetCreate) sqlSave(ctx context.Context) (*Street, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return s, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (sc *StreetCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: street.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: street.FieldID,
+		},
+	}
+	if value, ok := sc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: street.FieldName,
+		})
+	}
+	if nodes := sc.mutation.CityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   street.CityTable,
+			Columns: []string{street.CityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: city.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// StreetCreateBulk is the builder for creating a bulk of Street entities.
+type StreetCreateBulk struct {
+	config
+	builders []*StreetCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Street entities in the database.
+func (scb *StreetCreateBulk) Save(ctx context.Context) ([]*Street, error) {
+	nodes, err := scb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && scb.retries > 0 && isSQLDeadlockError(err) {
+		return scb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *StreetCreateBulk) SaveX(ctx context.Context) []*Street {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (scb *StreetCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range scb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
+		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (scb *StreetCreateBulk) CheckRefs() *StreetCreateBulk {
+	scb.refs = true
+	return scb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (scb *StreetCreateBulk) RetryOnDeadlock(max int) *StreetCreateBulk {
+	scb.retries = max
+	return scb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Street.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Street.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (scb *StreetCreateBulk) OnConflict(columns ...string) *StreetUpsertBulk {
+	return &StreetUpsertBulk{create: scb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Street.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (sc *StreetCreate) OnConflict(target ...sql.ConflictOption) *StreetUpsertBulk {
+	bulk := &StreetCreateBulk{config: sc.config, builders: []*StreetCreate{sc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (scb *StreetCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Street, error) {
+	ctx = scb.withOperation(ctx, "Street", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(scb.builders))
+		nodes    = make([]*Street, len(scb.builders))
+		mutators = make([]Mutator, len(scb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StreetMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if scb.refs {
+						if err := scb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, scb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (scb *StreetCreateBulk) retry(ctx context.Context, err error) ([]*Street, error) {
+	if _, ok := scb.driver.(*txDriver); ok {
+		return nil, err
+	}
+	builders := scb.builders
+	defer func() { scb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return scb.less(builders[idx[i]], builders[idx[j]])
+	})
+	scb.builders = make([]*StreetCreate, len(idx))
+	for i, j := range idx {
+		scb.builders[i] = builders[j]
+	}
+	for n := 0; n < scb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Street
+		if sorted, err = scb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Street, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (scb *StreetCreateBulk) less(a, b *StreetCreate) bool {
+	{
+		x, _ := a.mutation.Name()
+		y, _ := b.mutation.Name()
+		if x != y {
+			return x < y
+		}
+	}
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (scb *StreetCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range scb.builders {
+			for _, id := range builder.mutation.CityIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, scb.driver, city.Table, city.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[street.EdgeCity] = append(missing[street.EdgeCity], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Street", Missing: missing}
+	}
+	return nil
+}
+
+// StreetUpsertBulk is the builder for the conflict handling of a bulk of Street entities.
+type StreetUpsertBulk struct {
+	create  *StreetCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (sub *StreetUpsertBulk) Target(target ...sql.ConflictOption) *StreetUpsertBulk {
+	sub.target = append(sub.target, target...)
+	return sub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (sub *StreetUpsertBulk) DoNothing() *StreetUpsertBulk {
+	sub.nothing = true
+	return sub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (sub *StreetUpsertBulk) UpdateNewValues() *StreetUpsertBulk {
+	sub.update = true
+	return sub
+}
+
+// Save creates the Street entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (sub *StreetUpsertBulk) Save(ctx context.Context) ([]*Street, int, error) {
+	switch {
+	case !sub.nothing && !sub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Street bulk insert")
+	case sub.nothing && sub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Street bulk insert")
+	case sub.update:
+		if len(sub.columns) == 0 && len(sub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Street bulk upsert")
 		}
+		nodes, err := sub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(sub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(street.FieldID),
+			),
+			Columns:         street.Columns,
+			ConflictColumns: sub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(sub.conflictTarget(), sql.DoNothing())}
+	nodes, err := sub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Street, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (sub *StreetUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(sub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(sub.columns...))
+	}
+	return append(opts, sub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sub *StreetUpsertBulk) SaveX(ctx context.Context) ([]*Street, int) {
+	nodes, skipped, err := sub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (sub *StreetUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := sub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sub *StreetUpsertBulk) ExecX(ctx context.Context) {
+	if err := sub.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflictMerge configures the bulk to resolve its conflicts with the stored Street entities in Go,
+// using the given merge function, for merges that cannot be expressed by the `ON CONFLICT` clause (e.g.
+// keeping the max of two fields). The builders are matched with the stored entities by the values of the
+// given key fields, which must be set on all builders, and must be unique in the database.
+//
+// On Save, in one transaction, the matching rows are locked using `SELECT ... FOR UPDATE` (in batches of
+// the EagerLoadBatchSize option), and the merge function is called with a copy of each stored entity and
+// the entity that was proposed for insertion (without an ID). The changed fields of the returned entity
+// are written back (see UpdateFromDiff), and a nil return keeps the stored entity as is. Builders without
+// a stored entity are inserted using one bulk insert.
+//
+// Note that it is slower than the native upsert (see OnConflict), because it costs a locking query per
+// batch and an update statement per merged entity, and the rows stay locked until the transaction ends.
+//
+//	nodes, err := client.Street.
+//		CreateBulk(builders...).
+//		OnConflictMerge(func(existing, incoming *Street) *Street {
+//			// ...
+//			return existing
+//		}, street.FieldName).
+//		Save(ctx)
+//
+func (scb *StreetCreateBulk) OnConflictMerge(merge func(existing, incoming *Street) *Street, keyFields ...string) *StreetMergeBulk {
+	return &StreetMergeBulk{create: scb, merge: merge, keyFields: keyFields}
+}
+
+// StreetMergeBulk is the builder for merging a bulk of Street entities with the stored entities in Go.
+type StreetMergeBulk struct {
+	create    *StreetCreateBulk
+	merge     func(existing, incoming *Street) *Street
+	keyFields []string
+}
+
+// Save creates or merges the Street entities in the database, and returns them in the order of
+// their builders, as they are stored after the merge. If the client is transactional, the changes are
+// applied in its transaction, and it is not committed.
+func (smb *StreetMergeBulk) Save(ctx context.Context) ([]*Street, error) {
+	if smb.merge == nil {
+		return nil, errors.New("ent: missing merge function for Street bulk merge")
+	}
+	if len(smb.keyFields) == 0 {
+		return nil, errors.New("ent: missing key fields for Street bulk merge")
+	}
+	for _, f := range smb.keyFields {
+		switch f {
+		case street.FieldName:
+		default:
+			return nil, fmt.Errorf("ent: invalid key field %q for Street bulk merge", f)
+		}
+	}
+	drv := smb.create.driver
+	if _, ok := drv.(*txDriver); ok {
+		return smb.save(ctx, smb.create.config)
+	}
+	tx, err := newTx(ctx, drv)
+	if err != nil {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	s.ID = int(id)
-	return s, nil
+	cfg := smb.create.config
+	cfg.driver = tx
+	nodes, err := smb.save(ctx, cfg)
+	if err != nil {
+		return nil, rollback(tx.tx, err)
+	}
+	if err := tx.tx.Commit(); err != nil {
+		return nil, err
+	}
+	tx.committed()
+	for _, n := range nodes {
+		n.config.driver = drv
+	}
+	return nodes, nil
+}
+
+// save merges the bulk using the driver of the given config (a transaction).
+func (smb *StreetMergeBulk) save(ctx context.Context, cfg config) ([]*Street, error) {
+	var (
+		client   = &StreetClient{config: cfg}
+		builders = smb.create.builders
+		keys     = make([][]Value, len(builders))
+		seen     = make(map[string]int, len(builders))
+	)
+	for i, builder := range builders {
+		// The drivers of the builders are restored after the merge,
+		// since the transaction is closed when it returns.
+		defer func(builder *StreetCreate, drv, mdrv dialect.Driver) {
+			builder.driver, builder.mutation.driver = drv, mdrv
+		}(builder, builder.driver, builder.mutation.driver)
+		builder.driver = cfg.driver
+		builder.mutation.driver = cfg.driver
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			return nil, err
+		}
+		key := make([]Value, len(smb.keyFields))
+		for j, f := range smb.keyFields {
+			v, ok := builder.mutation.Field(f)
+			if !ok {
+				return nil, fmt.Errorf("ent: missing key field %q in Street builder %d", f, i)
+			}
+			key[j] = v
+		}
+		k := syncKey(key)
+		if j, ok := seen[k]; ok {
+			return nil, fmt.Errorf("ent: Street builders %d and %d have the same key", j, i)
+		}
+		seen[k], keys[i] = i, key
+	}
+	stored := make(map[string]*Street)
+	err := client.eagerLoadBatches(ctx, len(keys), func(i, j int) error {
+		query := client.Query().Where(func(s *sql.Selector) {
+			s.Where(syncPredicate(s, smb.keyFields, keys[i:j]))
+		}).ForUpdate()
+		query.withFKs = true
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			key := make([]Value, len(smb.keyFields))
+			for j, f := range smb.keyFields {
+				key[j] = n.syncValue(f)
+			}
+			stored[syncKey(key)] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		nodes   = make([]*Street, len(builders))
+		inserts []*StreetCreate
+		indexes []int
+	)
+	for i, builder := range builders {
+		n, ok := stored[syncKey(keys[i])]
+		if !ok {
+			inserts, indexes = append(inserts, builder), append(indexes, i)
+			continue
+		}
+		incoming, _ := builder.createSpec()
+		merged := smb.merge(n.Clone(), incoming)
+		if merged == nil {
+			nodes[i] = n
+			continue
+		}
+		// Statements are not issued after the context was canceled.
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		merged.ID = n.ID
+		if nodes[i], err = client.UpdateFromDiff(ctx, n, merged); err != nil {
+			return nil, err
+		}
+	}
+	if len(inserts) > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		created, err := client.CreateBulk(inserts...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for j, n := range created {
+			nodes[indexes[j]] = n
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smb *StreetMergeBulk) SaveX(ctx context.Context) []*Street {
+	nodes, err := smb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Exec executes the query.
+func (smb *StreetMergeBulk) Exec(ctx context.Context) error {
+	_, err := smb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smb *StreetMergeBulk) ExecX(ctx context.Context) {
+	if err := smb.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -23,6 +23,7 @@ type StreetDelete struct {
 	hooks      []Hook
 	mutation   *StreetMutation
 	predicates []predicate.Street
+	limit      *int
 }
 
 // Where adds a new predicate to the delete builder.
@@ -31,8 +32,32 @@ func (sd *StreetDelete) Where(ps ...predicate.Street) *StreetDelete {
 	return sd
 }
 
+// Limit limits the number of streets that are deleted by the builder. For example,
+// for deleting large amounts of streets in small batches without locking the whole table.
+// In MySQL, it is applied using a LIMIT clause, and in other dialects using a subquery on the ids.
+func (sd *StreetDelete) Limit(limit int) *StreetDelete {
+	sd.limit = &limit
+	return sd
+}
+
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (sd *StreetDelete) Unwrap() *StreetDelete {
+	tx, ok := sd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StreetDelete is not a transactional builder")
+	}
+	sd.config.driver = tx.drv
+	sd.mutation.driver = tx.drv
+	return sd
+}
+
 // Exec executes the deletion query and returns how many vertices were deleted.
 func (sd *StreetDelete) Exec(ctx context.Context) (int, error) {
+	if err := checkTx(sd.driver); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -69,6 +94,7 @@ func (sd *StreetDelete) ExecX(ctx context.Context) int {
 }
 
 func (sd *StreetDelete) sqlExec(ctx context.Context) (int, error) {
+	ctx = sd.withOperation(ctx, "Street", "Delete")
 	_spec := &sqlgraph.DeleteSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table: street.Table,
@@ -85,7 +111,10 @@ func (sd *StreetDelete) sqlExec(ctx context.Context) (int, error) {
 			}
 		}
 	}
-	return sqlgraph.DeleteNodes(ctx, sd.driver, _spec)
+	if limit := sd.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	return sqlgraph.DeleteNodes(ctx, sd.mutationDriver(ctx), _spec)
 }
 
 // StreetDeleteOne is the builder for deleting a single Street entity.
@@ -99,7 +128,7 @@ func (sdo *StreetDeleteOne) Exec(ctx context.Context) error {
 	switch {
 	case err != nil:
 		return err
-	case n == 0:
+	case n == 0 && !sql.IsDryRun(ctx):
 		return &NotFoundError{street.Label}
 	default:
 		return nil
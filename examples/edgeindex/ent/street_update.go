@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -24,6 +25,9 @@ type StreetUpdate struct {
 	hooks      []Hook
 	mutation   *StreetMutation
 	predicates []predicate.Street
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -32,12 +36,26 @@ func (su *StreetUpdate) Where(ps ...predicate.Street) *StreetUpdate {
 	return su
 }
 
+// Limit limits the number of streets that are updated by the builder. For example,
+// for updating large amounts of streets in small batches without locking the whole table.
+func (su *StreetUpdate) Limit(limit int) *StreetUpdate {
+	su.limit = &limit
+	return su
+}
+
 // SetName sets the name field.
 func (su *StreetUpdate) SetName(s string) *StreetUpdate {
 	su.mutation.SetName(s)
 	return su
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (su *StreetUpdate) UnsetName() *StreetUpdate {
+	su.mutation.ResetName()
+	return su
+}
+
 // SetCityID sets the city edge to City by id.
 func (su *StreetUpdate) SetCityID(id int) *StreetUpdate {
 	su.mutation.SetCityID(id)
@@ -63,9 +81,33 @@ func (su *StreetUpdate) ClearCity() *StreetUpdate {
 	return su
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (su *StreetUpdate) Unwrap() *StreetUpdate {
+	tx, ok := su.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StreetUpdate is not a transactional builder")
+	}
+	su.config.driver = tx.drv
+	su.mutation.driver = tx.drv
+	return su
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (su *StreetUpdate) DebugFields() map[string]Value {
+	return debugFields(su.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (su *StreetUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(su.driver); err != nil {
+		return 0, err
+	}
+	if err := su.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -114,7 +156,16 @@ func (su *StreetUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (su *StreetUpdate) check() error {
+	if su.mutation.conflictcity {
+		return &ValidationError{Name: "city", err: errors.New("ent: setting and clearing the unique edge \"city\" in the same mutation")}
+	}
+	return nil
+}
+
 func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = su.withOperation(ctx, "Street", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   street.Table,
@@ -132,6 +183,12 @@ func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := su.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if su.ids != nil {
+		_spec.ScanIDs = su.ids
+	}
 	if value, ok := su.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -174,7 +231,7 @@ func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, su.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{street.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -185,11 +242,33 @@ func (su *StreetUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Street entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (su *StreetUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	su.ids = &ids
+	defer func() { su.ids = nil }()
+	if _, err := su.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // StreetUpdateOne is the builder for updating a single Street entity.
 type StreetUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *StreetMutation
+	hooks      []Hook
+	mutation   *StreetMutation
+	predicates []predicate.Street
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (suo *StreetUpdateOne) Where(ps ...predicate.Street) *StreetUpdateOne {
+	suo.predicates = append(suo.predicates, ps...)
+	return suo
 }
 
 // SetName sets the name field.
@@ -198,6 +277,13 @@ func (suo *StreetUpdateOne) SetName(s string) *StreetUpdateOne {
 	return suo
 }
 
+// UnsetName removes the changes of the name field from the builder (e.g. a previous call
+// to SetName), and therefore, the field is left unchanged in the database.
+func (suo *StreetUpdateOne) UnsetName() *StreetUpdateOne {
+	suo.mutation.ResetName()
+	return suo
+}
+
 // SetCityID sets the city edge to City by id.
 func (suo *StreetUpdateOne) SetCityID(id int) *StreetUpdateOne {
 	suo.mutation.SetCityID(id)
@@ -223,9 +309,33 @@ func (suo *StreetUpdateOne) ClearCity() *StreetUpdateOne {
 	return suo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (suo *StreetUpdateOne) Unwrap() *StreetUpdateOne {
+	tx, ok := suo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StreetUpdateOne is not a transactional builder")
+	}
+	suo.config.driver = tx.drv
+	suo.mutation.driver = tx.drv
+	return suo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (suo *StreetUpdateOne) DebugFields() map[string]Value {
+	return debugFields(suo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (suo *StreetUpdateOne) Save(ctx context.Context) (*Street, error) {
-
+	if err := checkTx(suo.driver); err != nil {
+		return nil, err
+	}
+	if err := suo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Street
@@ -274,7 +384,16 @@ func (suo *StreetUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (suo *StreetUpdateOne) check() error {
+	if suo.mutation.conflictcity {
+		return &ValidationError{Name: "city", err: errors.New("ent: setting and clearing the unique edge \"city\" in the same mutation")}
+	}
+	return nil
+}
+
 func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (s *Street, err error) {
+	ctx = suo.withOperation(ctx, "Street", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   street.Table,
@@ -290,6 +409,13 @@ func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (s *Street, err error)
 		return nil, fmt.Errorf("missing Street.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := suo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := suo.mutation.Name(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeString,
@@ -333,9 +459,11 @@ func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (s *Street, err error)
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	s = &Street{config: suo.config}
-	_spec.Assign = s.assignValues
-	_spec.ScanValues = s.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return s.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = s.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, suo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{street.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -343,5 +471,13 @@ func (suo *StreetUpdateOne) sqlSave(ctx context.Context) (s *Street, err error)
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		s.ID = id
+		if value, ok := suo.mutation.Name(); ok {
+			s.Name = value
+		}
+	}
 	return s, nil
 }
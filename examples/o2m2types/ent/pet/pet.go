@@ -27,6 +27,17 @@ const (
 	OwnerColumn = "user_pets"
 )
 
+// Edges holds all the edge names of the Pet type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+}
+
+// FingerprintFields holds the fields of the Pet type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for pet fields.
 var Columns = []string{
 	FieldID,
@@ -37,3 +48,13 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"user_pets",
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
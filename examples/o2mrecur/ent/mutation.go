@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/facebookincubator/ent/examples/o2mrecur/ent/node"
@@ -38,6 +39,7 @@ type NodeMutation struct {
 	clearedFields   map[string]struct{}
 	parent          *int
 	clearedparent   bool
+	conflictparent  bool
 	children        map[int]struct{}
 	removedchildren map[int]struct{}
 }
@@ -73,6 +75,18 @@ func (m NodeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m NodeMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *NodeMutation) ID() (id int, exists bool) {
@@ -124,11 +138,15 @@ func (m *NodeMutation) ResetValue() {
 // SetParentID sets the parent edge to Node by id.
 func (m *NodeMutation) SetParentID(id int) {
 	m.parent = &id
+	m.conflictparent = false
 }
 
-// ClearParent clears the parent edge to Node.
+// ClearParent clears the parent edge to Node. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *NodeMutation) ClearParent() {
 	m.clearedparent = true
+	m.conflictparent = m.parent != nil
 }
 
 // ParentCleared returns if the edge parent was cleared.
@@ -144,6 +162,34 @@ func (m *NodeMutation) ParentID() (id int, exists bool) {
 	return
 }
 
+// ParentIDChanged reports if the parent edge is set to a different Node, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *NodeMutation) ParentIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.parent == nil && !m.clearedparent {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: ParentIDChanged requires an ID field in the mutation")
+	}
+	if m.parent != nil {
+		newID = *m.parent
+	}
+	oldID, err = m.Client().Node.Query().Where(node.ID(id)).QueryParent().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.parent != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old parent id: %v", err)
+	}
+	return oldID, newID, m.parent == nil || oldID != newID, nil
+}
+
 // ParentIDs returns the parent ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // ParentID instead. It exists only for internal usage by the builders.
@@ -158,6 +204,7 @@ func (m *NodeMutation) ParentIDs() (ids []int) {
 func (m *NodeMutation) ResetParent() {
 	m.parent = nil
 	m.clearedparent = false
+	m.conflictparent = false
 }
 
 // AddChildIDs adds the children edge to Node by ids.
@@ -212,6 +259,11 @@ func (m *NodeMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *NodeMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
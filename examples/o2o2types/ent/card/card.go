@@ -6,6 +6,10 @@
 
 package card
 
+import (
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
 const (
 	// Label holds the string label denoting the card type in the database.
 	Label = "card"
@@ -28,6 +32,18 @@ const (
 	OwnerColumn = "user_card"
 )
 
+// Edges holds all the edge names of the Card type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+}
+
+// FingerprintFields holds the fields of the Card type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldExpired,
+	FieldNumber,
+}
+
 // Columns holds all SQL columns for card fields.
 var Columns = []string{
 	FieldID,
@@ -39,3 +55,19 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"user_card",
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpiredTrunc returns a column that truncates the expired field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "expired_<bucket>".
+func ExpiredTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldExpired)
+}
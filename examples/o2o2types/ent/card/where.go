@@ -7,6 +7,7 @@
 package card
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -270,6 +271,13 @@ func NumberContains(v string) predicate.Card {
 	})
 }
 
+// NumberNotContains applies the NotContains predicate on the "number" field.
+func NumberNotContains(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldNumber), v))
+	})
+}
+
 // NumberHasPrefix applies the HasPrefix predicate on the "number" field.
 func NumberHasPrefix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -277,6 +285,13 @@ func NumberHasPrefix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasPrefix applies the NotHasPrefix predicate on the "number" field.
+func NumberNotHasPrefix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldNumber), v))
+	})
+}
+
 // NumberHasSuffix applies the HasSuffix predicate on the "number" field.
 func NumberHasSuffix(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -284,6 +299,13 @@ func NumberHasSuffix(v string) predicate.Card {
 	})
 }
 
+// NumberNotHasSuffix applies the NotHasSuffix predicate on the "number" field.
+func NumberNotHasSuffix(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldNumber), v))
+	})
+}
+
 // NumberEqualFold applies the EqualFold predicate on the "number" field.
 func NumberEqualFold(v string) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -298,6 +320,78 @@ func NumberContainsFold(v string) predicate.Card {
 	})
 }
 
+// NumberHasPrefixFold applies the HasPrefixFold predicate on the "number" field.
+func NumberHasPrefixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldNumber), v))
+	})
+}
+
+// NumberHasSuffixFold applies the HasSuffixFold predicate on the "number" field.
+func NumberHasSuffixFold(v string) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldNumber), v))
+	})
+}
+
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldExpired: "time",
+	FieldID:      "numeric",
+	FieldNumber:  "string",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Card.
+func FieldsEQ(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Card.
+func FieldsNEQ(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Card.
+func FieldsLT(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Card.
+func FieldsLTE(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Card.
+func FieldsGT(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Card.
+func FieldsGTE(f1, f2 string) predicate.Card {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Card using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Card", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Card", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Card are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -326,6 +420,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.Card {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	card.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_card IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_card)
+//
+func OwnerMissing() predicate.Card {
+	return predicate.Card(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Card) predicate.Card {
 	return predicate.Card(func(s *sql.Selector) {
@@ -333,6 +466,9 @@ func And(predicates ...predicate.Card) predicate.Card {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -347,6 +483,9 @@ func Or(predicates ...predicate.Card) predicate.Card {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -357,3 +496,58 @@ func Not(p predicate.Card) predicate.Card {
 		p(s.Not())
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// CardFilter holds optional filters on the Card fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CardFilter struct {
+	IDIn      []int
+	Expired   *TimeRange
+	Number    *string
+	OwnerIDIn []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Card.Query().
+//		Where(card.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CardFilter) []predicate.Card {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Card
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if r := f.Expired; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, ExpiredGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, ExpiredLT(r.To))
+		}
+	}
+	if f.Number != nil {
+		ps = append(ps, NumberEQ(*f.Number))
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Card(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -41,6 +42,7 @@ type CardMutation struct {
 	clearedFields map[string]struct{}
 	owner         *int
 	clearedowner  bool
+	conflictowner bool
 }
 
 var _ ent.Mutation = (*CardMutation)(nil)
@@ -74,6 +76,18 @@ func (m CardMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m CardMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *CardMutation) ID() (id int, exists bool) {
@@ -124,11 +138,15 @@ func (m *CardMutation) ResetNumber() {
 // SetOwnerID sets the owner edge to User by id.
 func (m *CardMutation) SetOwnerID(id int) {
 	m.owner = &id
+	m.conflictowner = false
 }
 
-// ClearOwner clears the owner edge to User.
+// ClearOwner clears the owner edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *CardMutation) ClearOwner() {
 	m.clearedowner = true
+	m.conflictowner = m.owner != nil
 }
 
 // OwnerCleared returns if the edge owner was cleared.
@@ -144,6 +162,34 @@ func (m *CardMutation) OwnerID() (id int, exists bool) {
 	return
 }
 
+// OwnerIDChanged reports if the owner edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *CardMutation) OwnerIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.owner == nil && !m.clearedowner {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: OwnerIDChanged requires an ID field in the mutation")
+	}
+	if m.owner != nil {
+		newID = *m.owner
+	}
+	oldID, err = m.Client().Card.Query().Where(card.ID(id)).QueryOwner().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.owner != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old owner id: %v", err)
+	}
+	return oldID, newID, m.owner == nil || oldID != newID, nil
+}
+
 // OwnerIDs returns the owner ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // OwnerID instead. It exists only for internal usage by the builders.
@@ -158,6 +204,7 @@ func (m *CardMutation) OwnerIDs() (ids []int) {
 func (m *CardMutation) ResetOwner() {
 	m.owner = nil
 	m.clearedowner = false
+	m.conflictowner = false
 }
 
 // Op returns the operation name.
@@ -170,6 +217,11 @@ func (m *CardMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *CardMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -369,6 +421,7 @@ type UserMutation struct {
 	clearedFields map[string]struct{}
 	card          *int
 	clearedcard   bool
+	conflictcard  bool
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -402,6 +455,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *UserMutation) ID() (id int, exists bool) {
@@ -472,11 +537,15 @@ func (m *UserMutation) ResetName() {
 // SetCardID sets the card edge to Card by id.
 func (m *UserMutation) SetCardID(id int) {
 	m.card = &id
+	m.conflictcard = false
 }
 
-// ClearCard clears the card edge to Card.
+// ClearCard clears the card edge to Card. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearCard() {
 	m.clearedcard = true
+	m.conflictcard = m.card != nil
 }
 
 // CardCleared returns if the edge card was cleared.
@@ -492,6 +561,34 @@ func (m *UserMutation) CardID() (id int, exists bool) {
 	return
 }
 
+// CardIDChanged reports if the card edge is set to a different Card, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) CardIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.card == nil && !m.clearedcard {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: CardIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: CardIDChanged requires an ID field in the mutation")
+	}
+	if m.card != nil {
+		newID = *m.card
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QueryCard().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.card != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old card id: %v", err)
+	}
+	return oldID, newID, m.card == nil || oldID != newID, nil
+}
+
 // CardIDs returns the card ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // CardID instead. It exists only for internal usage by the builders.
@@ -506,6 +603,7 @@ func (m *UserMutation) CardIDs() (ids []int) {
 func (m *UserMutation) ResetCard() {
 	m.card = nil
 	m.clearedcard = false
+	m.conflictcard = false
 }
 
 // Op returns the operation name.
@@ -518,6 +616,11 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/facebookincubator/ent/examples/o2obidi/ent/user"
@@ -30,15 +31,16 @@ const (
 // nodes in the graph.
 type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	age           *int
-	addage        *int
-	name          *string
-	clearedFields map[string]struct{}
-	spouse        *int
-	clearedspouse bool
+	op             Op
+	typ            string
+	id             *int
+	age            *int
+	addage         *int
+	name           *string
+	clearedFields  map[string]struct{}
+	spouse         *int
+	clearedspouse  bool
+	conflictspouse bool
 }
 
 var _ ent.Mutation = (*UserMutation)(nil)
@@ -72,6 +74,18 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m UserMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *UserMutation) ID() (id int, exists bool) {
@@ -142,11 +156,15 @@ func (m *UserMutation) ResetName() {
 // SetSpouseID sets the spouse edge to User by id.
 func (m *UserMutation) SetSpouseID(id int) {
 	m.spouse = &id
+	m.conflictspouse = false
 }
 
-// ClearSpouse clears the spouse edge to User.
+// ClearSpouse clears the spouse edge to User. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *UserMutation) ClearSpouse() {
 	m.clearedspouse = true
+	m.conflictspouse = m.spouse != nil
 }
 
 // SpouseCleared returns if the edge spouse was cleared.
@@ -162,6 +180,34 @@ func (m *UserMutation) SpouseID() (id int, exists bool) {
 	return
 }
 
+// SpouseIDChanged reports if the spouse edge is set to a different User, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *UserMutation) SpouseIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.spouse == nil && !m.clearedspouse {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: SpouseIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: SpouseIDChanged requires an ID field in the mutation")
+	}
+	if m.spouse != nil {
+		newID = *m.spouse
+	}
+	oldID, err = m.Client().User.Query().Where(user.ID(id)).QuerySpouse().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.spouse != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old spouse id: %v", err)
+	}
+	return oldID, newID, m.spouse == nil || oldID != newID, nil
+}
+
 // SpouseIDs returns the spouse ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // SpouseID instead. It exists only for internal usage by the builders.
@@ -176,6 +222,7 @@ func (m *UserMutation) SpouseIDs() (ids []int) {
 func (m *UserMutation) ResetSpouse() {
 	m.spouse = nil
 	m.clearedspouse = false
+	m.conflictspouse = false
 }
 
 // Op returns the operation name.
@@ -188,6 +235,11 @@ func (m *UserMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *UserMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
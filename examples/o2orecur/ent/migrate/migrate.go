@@ -35,6 +35,16 @@ var (
 	// WithFixture sets the foreign-key renaming option to the migration when upgrading
 	// ent from v0.1.0 (issue-#285). Defaults to true.
 	WithFixture = schema.WithFixture
+	// WithPreHook adds raw SQL statements that are executed in the migration transaction
+	// of the given dialect, before the tables are created or altered. For example:
+	//
+	//	migrate.WithPreHook(dialect.Postgres, "CREATE EXTENSION IF NOT EXISTS pg_trgm")
+	//
+	WithPreHook = schema.WithPreHook
+	// WithPostHook adds raw SQL statements that are executed in the migration transaction
+	// of the given dialect, after the tables and their foreign-keys were created or altered.
+	// Note that the statements of both hooks run on every migration, and must be idempotent.
+	WithPostHook = schema.WithPostHook
 )
 
 // Schema is the API for creating, migrating and dropping a schema.
@@ -72,3 +82,30 @@ func (s *Schema) WriteTo(ctx context.Context, w io.Writer, opts ...schema.Migrat
 	}
 	return migrate.Create(ctx, Tables...)
 }
+
+// PlanChanges returns the statements that the migration would execute against the database,
+// in their execution order, without executing them. The current state of the database is
+// inspected using the schema driver, and the statements respect the given options.
+//
+//	stmts, err := client.Schema.PlanChanges(ctx, migrate.WithDropIndex(true))
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+func (s *Schema) PlanChanges(ctx context.Context, opts ...schema.MigrateOption) ([]string, error) {
+	drv := &schema.PlanDriver{Driver: s.drv}
+	migrate, err := schema.NewMigrate(drv, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("ent/migrate: %v", err)
+	}
+	if err := migrate.Create(ctx, Tables...); err != nil {
+		return nil, err
+	}
+	return drv.Stmts, nil
+}
+
+// PlanChanges returns the statements that the migration would execute against the
+// database of the given driver, without executing them. See Schema.PlanChanges.
+func PlanChanges(ctx context.Context, drv dialect.Driver, opts ...schema.MigrateOption) ([]string, error) {
+	return NewSchema(drv).PlanChanges(ctx, opts...)
+}
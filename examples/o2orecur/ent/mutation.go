@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/facebookincubator/ent/examples/o2orecur/ent/node"
@@ -38,8 +39,10 @@ type NodeMutation struct {
 	clearedFields map[string]struct{}
 	prev          *int
 	clearedprev   bool
+	conflictprev  bool
 	next          *int
 	clearednext   bool
+	conflictnext  bool
 }
 
 var _ ent.Mutation = (*NodeMutation)(nil)
@@ -73,6 +76,18 @@ func (m NodeMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// AfterCommit registers f to be called after the transaction of the mutation was
+// committed successfully. It returns false if the mutation is not running in a
+// transaction. See ent.AfterCommit for more info.
+func (m NodeMutation) AfterCommit(f func()) bool {
+	tx, ok := m.driver.(*txDriver)
+	if !ok {
+		return false
+	}
+	tx.onCommitted(f)
+	return true
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
 func (m *NodeMutation) ID() (id int, exists bool) {
@@ -124,11 +139,15 @@ func (m *NodeMutation) ResetValue() {
 // SetPrevID sets the prev edge to Node by id.
 func (m *NodeMutation) SetPrevID(id int) {
 	m.prev = &id
+	m.conflictprev = false
 }
 
-// ClearPrev clears the prev edge to Node.
+// ClearPrev clears the prev edge to Node. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *NodeMutation) ClearPrev() {
 	m.clearedprev = true
+	m.conflictprev = m.prev != nil
 }
 
 // PrevCleared returns if the edge prev was cleared.
@@ -144,6 +163,34 @@ func (m *NodeMutation) PrevID() (id int, exists bool) {
 	return
 }
 
+// PrevIDChanged reports if the prev edge is set to a different Node, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *NodeMutation) PrevIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.prev == nil && !m.clearedprev {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: PrevIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: PrevIDChanged requires an ID field in the mutation")
+	}
+	if m.prev != nil {
+		newID = *m.prev
+	}
+	oldID, err = m.Client().Node.Query().Where(node.ID(id)).QueryPrev().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.prev != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old prev id: %v", err)
+	}
+	return oldID, newID, m.prev == nil || oldID != newID, nil
+}
+
 // PrevIDs returns the prev ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // PrevID instead. It exists only for internal usage by the builders.
@@ -158,16 +205,21 @@ func (m *NodeMutation) PrevIDs() (ids []int) {
 func (m *NodeMutation) ResetPrev() {
 	m.prev = nil
 	m.clearedprev = false
+	m.conflictprev = false
 }
 
 // SetNextID sets the next edge to Node by id.
 func (m *NodeMutation) SetNextID(id int) {
 	m.next = &id
+	m.conflictnext = false
 }
 
-// ClearNext clears the next edge to Node.
+// ClearNext clears the next edge to Node. Clearing an edge that was already
+// set in this mutation is invalid and fails the mutation, but clearing and then setting it replaces
+// the edge.
 func (m *NodeMutation) ClearNext() {
 	m.clearednext = true
+	m.conflictnext = m.next != nil
 }
 
 // NextCleared returns if the edge next was cleared.
@@ -183,6 +235,34 @@ func (m *NodeMutation) NextID() (id int, exists bool) {
 	return
 }
 
+// NextIDChanged reports if the next edge is set to a different Node, or cleared, by
+// the mutation, and returns its old and new ids. The new id is the zero value if the edge is
+// cleared. The old id is loaded from the database, and therefore, this method is supported only
+// on UpdateOne operations.
+func (m *NodeMutation) NextIDChanged(ctx context.Context) (oldID, newID int, changed bool, err error) {
+	if m.next == nil && !m.clearednext {
+		return oldID, newID, false, nil
+	}
+	if !m.op.Is(OpUpdateOne) {
+		return oldID, newID, false, fmt.Errorf("ent: NextIDChanged is allowed only on UpdateOne operations")
+	}
+	id, exists := m.ID()
+	if !exists {
+		return oldID, newID, false, fmt.Errorf("ent: NextIDChanged requires an ID field in the mutation")
+	}
+	if m.next != nil {
+		newID = *m.next
+	}
+	oldID, err = m.Client().Node.Query().Where(node.ID(id)).QueryNext().OnlyID(ctx)
+	switch {
+	case IsNotFound(err):
+		return oldID, newID, m.next != nil, nil
+	case err != nil:
+		return oldID, newID, false, fmt.Errorf("querying old next id: %v", err)
+	}
+	return oldID, newID, m.next == nil || oldID != newID, nil
+}
+
 // NextIDs returns the next ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // NextID instead. It exists only for internal usage by the builders.
@@ -197,6 +277,7 @@ func (m *NodeMutation) NextIDs() (ids []int) {
 func (m *NodeMutation) ResetNext() {
 	m.next = nil
 	m.clearednext = false
+	m.conflictnext = false
 }
 
 // Op returns the operation name.
@@ -209,6 +290,11 @@ func (m *NodeMutation) Type() string {
 	return m.typ
 }
 
+// String implements the fmt.Stringer interface. The values of sensitive fields are masked.
+func (m *NodeMutation) String() string {
+	return mutationString(m)
+}
+
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
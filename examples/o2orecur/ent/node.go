@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -25,6 +29,9 @@ type Node struct {
 	// The values are being populated by the NodeQuery when eager-loading is set.
 	Edges     NodeEdges `json:"edges"`
 	node_next *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // NodeEdges holds the relations/edges for other nodes in the graph.
@@ -66,50 +73,68 @@ func (e NodeEdges) NextOrErr() (*Node, error) {
 	return nil, &NotLoadedError{edge: "next"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Node) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // id
-		&sql.NullInt64{}, // value
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Node) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // node_next
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Node type are scanned as generic values.
+func (*Node) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldID:
+			values[i] = &sql.NullInt64{}
+		case node.FieldValue:
+			values[i] = &sql.NullInt64{}
+		case node.ForeignKeys[0]: // node_next
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Node fields.
-func (n *Node) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(node.Columns); m < n {
+// to the Node fields. Values of columns that were not selected are left untouched.
+func (n *Node) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	n.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullInt64); !ok {
-		return fmt.Errorf("unexpected type %T for field value", values[0])
-	} else if value.Valid {
-		n.Value = int(value.Int64)
-	}
-	values = values[1:]
-	if len(values) == len(node.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field node_next", value)
-		} else if value.Valid {
-			n.node_next = new(int)
-			*n.node_next = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case node.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			n.ID = int(value.Int64)
+		case node.FieldValue:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				n.Value = int(value.Int64)
+			}
+		case node.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field node_next", value)
+			} else if value.Valid {
+				n.node_next = new(int)
+				*n.node_next = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (n *Node) SelectValue(alias string) (Value, error) {
+	v, ok := n.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryPrev queries the prev edge of the Node.
 func (n *Node) QueryPrev() *NodeQuery {
 	return (&NodeClient{config: n.config}).QueryPrev(n)
@@ -120,6 +145,107 @@ func (n *Node) QueryNext() *NodeQuery {
 	return (&NodeClient{config: n.config}).QueryNext(n)
 }
 
+// LoadEdges loads the given edges of the Node using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see node.Edges), and in this case, no edge is loaded.
+//
+//	err := n.LoadEdges(ctx, client, node.EdgePrev, node.EdgeNext)
+//
+func (n *Node) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadNodeEdges(ctx, client, []*Node{n}, edges)
+}
+
+// loadNodeEdges loads the given edges of all the given Node entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using NodeClient.LoadEdgeFor.
+func loadNodeEdges(ctx context.Context, client *Client, nodes []*Node, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case node.EdgePrev, node.EdgeNext:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Node", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Node.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Node and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Node alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Node itself is not modified.
+//
+//	b, err := n.DumpGraph(ctx, client, 2, node.EdgePrev)
+//
+func (n *Node) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Node DumpGraph", depth)
+	}
+	dumped := *n
+	dumped.Edges = NodeEdges{}
+	if err := dumpNodes(ctx, client, []*Node{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpNodes loads the edges of the given Node copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpNodes(ctx context.Context, client *Client, nodes []*Node, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Node
+	for _, n := range nodes {
+		if dumper.visit(node.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range node.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadNodeEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextPrev []*Node
+	for _, n := range visit {
+		if e := n.Edges.Prev; e != nil {
+			c := *e
+			c.Edges = NodeEdges{}
+			n.Edges.Prev = &c
+			nextPrev = append(nextPrev, &c)
+		}
+	}
+	if err := dumpNodes(ctx, client, nextPrev, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextNext []*Node
+	for _, n := range visit {
+		if e := n.Edges.Next; e != nil {
+			c := *e
+			c.Edges = NodeEdges{}
+			n.Edges.Next = &c
+			nextNext = append(nextNext, &c)
+		}
+	}
+	if err := dumpNodes(ctx, client, nextNext, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Node.
 // Note that, you need to call Node.Unwrap() before calling this method, if this Node
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -138,6 +264,28 @@ func (n *Node) Unwrap() *Node {
 	return n
 }
 
+// Clone returns a deep copy of the Node and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (n *Node) Clone() *Node {
+	return n.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Node and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (n *Node) clone(seen map[interface{}]interface{}) *Node {
+	if n == nil {
+		return nil
+	}
+	if v, ok := seen[n]; ok {
+		return v.(*Node)
+	}
+	_c := *n
+	seen[n] = &_c
+	_c.Edges.Prev = n.Edges.Prev.clone(seen)
+	_c.Edges.Next = n.Edges.Next.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (n *Node) String() string {
 	var builder strings.Builder
@@ -149,6 +297,26 @@ func (n *Node) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Node, for change detection and cache keys.
+// It covers the fields that are listed in node.FingerprintFields, and it does not depend on the
+// ID of the Node, on its edges, or on the order of the fields in the schema.
+func (n *Node) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "value", n.Value)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Node for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Node can be decoded using the FromMap method of its client.
+func (n *Node) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[node.FieldID] = kvEncode(n.ID)
+	m[node.FieldValue] = kvEncode(n.Value)
+	return m
+}
+
 // Nodes is a parsable slice of Node.
 type Nodes []*Node
 
@@ -30,6 +30,18 @@ const (
 	NextColumn = "node_next"
 )
 
+// Edges holds all the edge names of the Node type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgePrev,
+	EdgeNext,
+}
+
+// FingerprintFields holds the fields of the Node type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldValue,
+}
+
 // Columns holds all SQL columns for node fields.
 var Columns = []string{
 	FieldID,
@@ -40,3 +52,13 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"node_next",
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
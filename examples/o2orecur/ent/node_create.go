@@ -10,7 +10,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/examples/o2orecur/ent/node"
 	"github.com/facebookincubator/ent/schema/field"
@@ -69,8 +71,12 @@ func (nc *NodeCreate) SetNext(n *Node) *NodeCreate {
 
 // Save creates the Node in the database.
 func (nc *NodeCreate) Save(ctx context.Context) (*Node, error) {
-	if _, ok := nc.mutation.Value(); !ok {
-		return nil, errors.New("ent: missing required field \"value\"")
+	if err := checkTx(nc.driver); err != nil {
+		return nil, err
+	}
+	nc.defaults()
+	if err := nc.check(); err != nil {
+		return nil, err
 	}
 	var (
 		err  error
@@ -107,17 +113,115 @@ func (nc *NodeCreate) SaveX(ctx context.Context) *Node {
 	return v
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nc *NodeCreate) Unwrap() *NodeCreate {
+	tx, ok := nc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeCreate is not a transactional builder")
+	}
+	nc.config.driver = tx.drv
+	nc.mutation.driver = tx.drv
+	return nc
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the field and edge
+// names (see the debugFields function for the key format). The default values of the fields are included
+// only after they were set by Save.
+func (nc *NodeCreate) DebugFields() map[string]Value {
+	return debugFields(nc.mutation)
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NodeCreate) defaults() {
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NodeCreate) check() error {
+	if _, ok := nc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New("ent: missing required field \"value\"")}
+	}
+	return nil
+}
+
 func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
-	var (
-		n     = &Node{config: nc.config}
-		_spec = &sqlgraph.CreateSpec{
-			Table: node.Table,
-			ID: &sqlgraph.FieldSpec{
-				Type:   field.TypeInt,
-				Column: node.FieldID,
-			},
+	ctx = nc.withOperation(ctx, "Node", "Create")
+	n, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
 		}
-	)
+		return nil, err
+	}
+
+	id := _spec.ID.Value.(int64)
+	n.ID = int(id)
+	return n, nil
+}
+
+// SaveID creates the Node in the database and returns only its id. Unlike Save,
+// the Node entity is not allocated nor populated, and therefore, it is useful
+// for write-heavy paths that do not read the created entity. Note that hooks get a
+// Node that holds only its id as the value that is returned by the next mutator.
+func (nc *NodeCreate) SaveID(ctx context.Context) (id int, err error) {
+	nc.defaults()
+	if err = nc.check(); err != nil {
+		return id, err
+	}
+	if len(nc.hooks) == 0 {
+		return nc.sqlSaveID(ctx)
+	}
+	var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+		mutation, ok := m.(*NodeMutation)
+		if !ok {
+			return nil, fmt.Errorf("unexpected mutation type %T", m)
+		}
+		nc.mutation = mutation
+		if id, err = nc.sqlSaveID(ctx); err != nil {
+			return nil, err
+		}
+		return &Node{config: nc.config, ID: id}, nil
+	})
+	for i := len(nc.hooks) - 1; i >= 0; i-- {
+		mut = nc.hooks[i](mut)
+	}
+	if _, err = mut.Mutate(ctx, nc.mutation); err != nil {
+		return id, err
+	}
+	return id, nil
+}
+
+// SaveIDX calls SaveID and panics if SaveID returns an error.
+func (nc *NodeCreate) SaveIDX(ctx context.Context) int {
+	id, err := nc.SaveID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+func (nc *NodeCreate) sqlSaveID(ctx context.Context) (id int, err error) {
+	ctx = nc.withOperation(ctx, "Node", "Create")
+	_spec := nc.idSpec()
+	if err = sqlgraph.CreateNode(ctx, nc.mutationDriver(ctx), _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return id, err
+	}
+	return int(_spec.ID.Value.(int64)), nil
+}
+
+func (nc *NodeCreate) createSpec() (*Node, *sqlgraph.CreateSpec) {
+	n := &Node{config: nc.config}
+	_spec := &sqlgraph.CreateSpec{
+		Table: node.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: node.FieldID,
+		},
+	}
 	if value, ok := nc.mutation.Value(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -164,13 +268,461 @@ func (nc *NodeCreate) sqlSave(ctx context.Context) (*Node, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
-		if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	return n, _spec
+}
+
+// idSpec is like createSpec, but it does not populate the created node.
+func (nc *NodeCreate) idSpec() *sqlgraph.CreateSpec {
+	_spec := &sqlgraph.CreateSpec{
+		Table: node.Table,
+		ID: &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: node.FieldID,
+		},
+	}
+	if value, ok := nc.mutation.Value(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: node.FieldValue,
+		})
+	}
+	if nodes := nc.mutation.PrevIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   node.PrevTable,
+			Columns: []string{node.PrevColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: node.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := nc.mutation.NextIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   node.NextTable,
+			Columns: []string{node.NextColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: node.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _spec
+}
+
+// NodeCreateBulk is the builder for creating a bulk of Node entities.
+type NodeCreateBulk struct {
+	config
+	builders []*NodeCreate
+	refs     bool
+	retries  int
+}
+
+// Save creates the Node entities in the database.
+func (ncb *NodeCreateBulk) Save(ctx context.Context) ([]*Node, error) {
+	nodes, err := ncb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{})
+	if err != nil && ncb.retries > 0 && isSQLDeadlockError(err) {
+		return ncb.retry(ctx, err)
+	}
+	return nodes, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NodeCreateBulk) SaveX(ctx context.Context) []*Node {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Validate sets the default values of all builders, and runs their checks and user-defined
+// validators before any statement is executed. It returns a *BulkValidationError that holds
+// the errors of the builders that failed by their index, or nil if all of them are valid.
+// Note that the builders are validated again on Save, and that hooks are not executed.
+func (ncb *NodeCreateBulk) Validate() error {
+	errs := make(map[int]error)
+	for i, builder := range ncb.builders {
+		builder.defaults()
+		if err := builder.check(); err != nil {
+			errs[i] = err
 		}
+	}
+	if len(errs) > 0 {
+		return &BulkValidationError{Errors: errs}
+	}
+	return nil
+}
+
+// CheckRefs configures the bulk to verify that the nodes that are referenced by the edges of its
+// builders exist, before the entities are inserted. It costs one query per edge, and returns
+// a *ReferenceError that holds the missing ids, instead of a constraint error of the insert.
+func (ncb *NodeCreateBulk) CheckRefs() *NodeCreateBulk {
+	ncb.refs = true
+	return ncb
+}
+
+// RetryOnDeadlock configures the bulk to retry the whole batch up to max times, if the insert
+// failed on a deadlock. Before retrying, the builders are sorted by their unique keys, in order
+// to acquire the index locks in the same order as concurrent bulks. Other errors (e.g. constraint
+// errors) are not retried, and bulks that are executed in a transaction (Tx) are not retried either,
+// because the deadlock aborts their transaction.
+func (ncb *NodeCreateBulk) RetryOnDeadlock(max int) *NodeCreateBulk {
+	ncb.retries = max
+	return ncb
+}
+
+// OnConflict allows configuring the conflict handling of the bulk insert. The given columns
+// are used as the conflict target in PostgreSQL and SQLite, and are ignored by MySQL.
+//
+//	inserted, skipped, err := client.Node.
+//		CreateBulk(builders...).
+//		OnConflict().
+//		DoNothing().
+//		Save(ctx)
+//
+//	nodes, _, err := client.Node.
+//		CreateBulk(builders...).
+//		OnConflict(columns...).
+//		UpdateNewValues().
+//		Save(ctx)
+//
+func (ncb *NodeCreateBulk) OnConflict(columns ...string) *NodeUpsertBulk {
+	return &NodeUpsertBulk{create: ncb, columns: columns}
+}
+
+// OnConflict allows configuring the conflict handling of the insert, using the given options
+// as the conflict target (e.g. sql.ConflictConstraint or sql.ConflictColumnsWhere). It returns
+// the conflict builder of a bulk that holds only this builder.
+//
+//	nodes, skipped, err := client.Node.
+//		Create().
+//		OnConflict(sql.ConflictConstraint(name)).
+//		DoNothing().
+//		Save(ctx)
+//
+func (nc *NodeCreate) OnConflict(target ...sql.ConflictOption) *NodeUpsertBulk {
+	bulk := &NodeCreateBulk{config: nc.config, builders: []*NodeCreate{nc}}
+	return bulk.OnConflict().Target(target...)
+}
+
+// sqlSave executes the bulk insert using the given batch spec. If the spec columns are
+// set, the final rows of the nodes are read back from the database after the insert.
+func (ncb *NodeCreateBulk) sqlSave(ctx context.Context, spec *sqlgraph.BatchCreateSpec) ([]*Node, error) {
+	ctx = ncb.withOperation(ctx, "Node", "CreateBulk")
+	var (
+		specs    = make([]*sqlgraph.CreateSpec, len(ncb.builders))
+		nodes    = make([]*Node, len(ncb.builders))
+		mutators = make([]Mutator, len(ncb.builders))
+	)
+	if len(spec.Columns) > 0 {
+		spec.ScanValues = func(i int) []interface{} {
+			return nodes[i].scanValues(spec.Columns)
+		}
+		spec.Assign = func(i int, values ...interface{}) error {
+			return nodes[i].assignValues(spec.Columns, values)
+		}
+	}
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				builder.mutation = mutation
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					// Invoke the actual operation on the latest mutation in the chain.
+					spec.Nodes = specs
+					if ncb.refs {
+						if err := ncb.checkRefs(ctx); err != nil {
+							return nil, err
+						}
+					}
+					if err = sqlgraph.BatchCreate(ctx, ncb.mutationDriver(ctx), spec); err != nil {
+						if cerr, ok := isSQLConstraintError(err); ok {
+							err = cerr
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// retry sorts the builders by their unique keys, and retries the bulk insert as long as it fails
+// on a deadlock. The returned entities are ordered by the original order of the builders.
+func (ncb *NodeCreateBulk) retry(ctx context.Context, err error) ([]*Node, error) {
+	if _, ok := ncb.driver.(*txDriver); ok {
 		return nil, err
 	}
-	id := _spec.ID.Value.(int64)
-	n.ID = int(id)
-	return n, nil
+	builders := ncb.builders
+	defer func() { ncb.builders = builders }()
+	idx := make([]int, len(builders))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return ncb.less(builders[idx[i]], builders[idx[j]])
+	})
+	ncb.builders = make([]*NodeCreate, len(idx))
+	for i, j := range idx {
+		ncb.builders[i] = builders[j]
+	}
+	for n := 0; n < ncb.retries && isSQLDeadlockError(err); n++ {
+		if cerr := ctx.Err(); cerr != nil {
+			return nil, cerr
+		}
+		var sorted []*Node
+		if sorted, err = ncb.sqlSave(ctx, &sqlgraph.BatchCreateSpec{}); err == nil {
+			nodes := make([]*Node, len(sorted))
+			for i, j := range idx {
+				nodes[j] = sorted[i]
+			}
+			return nodes, nil
+		}
+	}
+	return nil, err
+}
+
+// less reports whether the unique keys of builder a are ordered before the unique keys of builder b.
+func (ncb *NodeCreateBulk) less(a, b *NodeCreate) bool {
+	return false
+}
+
+// checkRefs verifies that the nodes that are referenced by the edges of the builders
+// exist, and returns a *ReferenceError with the ids of the missing nodes otherwise.
+func (ncb *NodeCreateBulk) checkRefs(ctx context.Context) error {
+	missing := make(map[string][]interface{})
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ncb.builders {
+			for _, id := range builder.mutation.PrevIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ncb.driver, node.Table, node.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[node.EdgePrev] = append(missing[node.EdgePrev], id)
+				}
+			}
+		}
+	}
+	{
+		var (
+			ids  []interface{}
+			seen = make(map[int]bool)
+		)
+		for _, builder := range ncb.builders {
+			for _, id := range builder.mutation.NextIDs() {
+				if _, ok := seen[id]; !ok {
+					seen[id] = true
+					ids = append(ids, id)
+				}
+			}
+		}
+		if len(ids) > 0 {
+			var found []int
+			if err := selectIDs(ctx, ncb.driver, node.Table, node.FieldID, ids, &found); err != nil {
+				return err
+			}
+			for _, id := range found {
+				seen[id] = false
+			}
+			for _, id := range ids {
+				if seen[id.(int)] {
+					missing[node.EdgeNext] = append(missing[node.EdgeNext], id)
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return &ReferenceError{Type: "Node", Missing: missing}
+	}
+	return nil
+}
+
+// NodeUpsertBulk is the builder for the conflict handling of a bulk of Node entities.
+type NodeUpsertBulk struct {
+	create  *NodeCreateBulk
+	columns []string
+	target  []sql.ConflictOption
+	nothing bool
+	update  bool
+}
+
+// Target sets the conflict target using the given options, and overrides the columns that were
+// passed to OnConflict in PostgreSQL and SQLite. It allows targeting named constraints and partial
+// unique indexes, for example:
+//
+//	OnConflict(columns...).
+//		Target(sql.ConflictColumnsWhere(columns, sql.IsNull("deleted_at")))
+//
+// Note that in UpdateNewValues mode, dialects other than PostgreSQL read the rows back by
+// the columns that were passed to OnConflict.
+func (nub *NodeUpsertBulk) Target(target ...sql.ConflictOption) *NodeUpsertBulk {
+	nub.target = append(nub.target, target...)
+	return nub
+}
+
+// DoNothing configures the conflict action to skip the rows that conflict with
+// existing rows. In PostgreSQL and SQLite, it is translated to `ON CONFLICT DO NOTHING`,
+// and in MySQL to `INSERT IGNORE`.
+//
+// Note that MySQL does not report which rows were ignored, and that it also ignores
+// other errors (e.g. data truncation) in `INSERT IGNORE` mode. Hence, in MySQL, rows
+// that were skipped for other reasons are also reported as skipped.
+func (nub *NodeUpsertBulk) DoNothing() *NodeUpsertBulk {
+	nub.nothing = true
+	return nub
+}
+
+// UpdateNewValues configures the conflict action to update the rows that conflict with
+// existing rows, using the values that were proposed for insertion. The ID and the immutable
+// fields (that have no update default) of the existing rows are not updated. In PostgreSQL
+// and SQLite, it is translated to `ON CONFLICT (...) DO UPDATE`, and in MySQL to
+// `ON DUPLICATE KEY UPDATE`.
+//
+// Save returns all entities in the order of their builders, as they are stored in the database
+// after the insert. PostgreSQL reads them using the `RETURNING` clause, and other dialects query
+// them by the conflict columns. Therefore, the conflict columns must be provided to OnConflict.
+func (nub *NodeUpsertBulk) UpdateNewValues() *NodeUpsertBulk {
+	nub.update = true
+	return nub
+}
+
+// Save creates the Node entities in the database. In DoNothing mode, it returns the entities
+// that were actually inserted (with their IDs) along with the number of the rows that were skipped.
+// In UpdateNewValues mode, it returns all entities as they are stored in the database.
+func (nub *NodeUpsertBulk) Save(ctx context.Context) ([]*Node, int, error) {
+	switch {
+	case !nub.nothing && !nub.update:
+		return nil, 0, errors.New("ent: missing conflict action for Node bulk insert")
+	case nub.nothing && nub.update:
+		return nil, 0, errors.New("ent: conflicting actions DoNothing and UpdateNewValues for Node bulk insert")
+	case nub.update:
+		if len(nub.columns) == 0 && len(nub.target) == 0 {
+			return nil, 0, errors.New("ent: missing conflict columns for Node bulk upsert")
+		}
+		nodes, err := nub.create.sqlSave(ctx, &sqlgraph.BatchCreateSpec{
+			OnConflict: append(nub.conflictTarget(),
+				sql.ResolveWithNewValues(),
+				sql.UpdateIgnore(node.FieldID),
+			),
+			Columns:         node.Columns,
+			ConflictColumns: nub.columns,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return nodes, 0, nil
+	}
+	spec := &sqlgraph.BatchCreateSpec{OnConflict: append(nub.conflictTarget(), sql.DoNothing())}
+	nodes, err := nub.create.sqlSave(ctx, spec)
+	if err != nil {
+		return nil, 0, err
+	}
+	skipped := spec.Skipped
+	if len(skipped) == 0 {
+		return nodes, 0, nil
+	}
+	inserted := make([]*Node, 0, len(nodes)-len(skipped))
+	for i, j := 0, 0; i < len(nodes); i++ {
+		if j < len(skipped) && skipped[j] == i {
+			j++
+			continue
+		}
+		inserted = append(inserted, nodes[i])
+	}
+	return inserted, len(skipped), nil
+}
+
+// conflictTarget returns the conflict options that set the conflict target of the insert.
+func (nub *NodeUpsertBulk) conflictTarget() []sql.ConflictOption {
+	var opts []sql.ConflictOption
+	if len(nub.columns) > 0 {
+		opts = append(opts, sql.ConflictColumns(nub.columns...))
+	}
+	return append(opts, nub.target...)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nub *NodeUpsertBulk) SaveX(ctx context.Context) ([]*Node, int) {
+	nodes, skipped, err := nub.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes, skipped
+}
+
+// Exec executes the query.
+func (nub *NodeUpsertBulk) Exec(ctx context.Context) error {
+	_, _, err := nub.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nub *NodeUpsertBulk) ExecX(ctx context.Context) {
+	if err := nub.Exec(ctx); err != nil {
+		panic(err)
+	}
 }
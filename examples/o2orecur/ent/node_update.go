@@ -8,6 +8,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -23,6 +24,9 @@ type NodeUpdate struct {
 	hooks      []Hook
 	mutation   *NodeMutation
 	predicates []predicate.Node
+	limit      *int
+	// ids holds the ids of the updated nodes, if requested by SaveReturningIDs.
+	ids *[]int
 }
 
 // Where adds a new predicate for the builder.
@@ -31,6 +35,13 @@ func (nu *NodeUpdate) Where(ps ...predicate.Node) *NodeUpdate {
 	return nu
 }
 
+// Limit limits the number of nodes that are updated by the builder. For example,
+// for updating large amounts of nodes in small batches without locking the whole table.
+func (nu *NodeUpdate) Limit(limit int) *NodeUpdate {
+	nu.limit = &limit
+	return nu
+}
+
 // SetValue sets the value field.
 func (nu *NodeUpdate) SetValue(i int) *NodeUpdate {
 	nu.mutation.ResetValue()
@@ -44,6 +55,13 @@ func (nu *NodeUpdate) AddValue(i int) *NodeUpdate {
 	return nu
 }
 
+// UnsetValue removes the changes of the value field from the builder (e.g. a previous call
+// to SetValue), and therefore, the field is left unchanged in the database.
+func (nu *NodeUpdate) UnsetValue() *NodeUpdate {
+	nu.mutation.ResetValue()
+	return nu
+}
+
 // SetPrevID sets the prev edge to Node by id.
 func (nu *NodeUpdate) SetPrevID(id int) *NodeUpdate {
 	nu.mutation.SetPrevID(id)
@@ -94,9 +112,33 @@ func (nu *NodeUpdate) ClearNext() *NodeUpdate {
 	return nu
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nu *NodeUpdate) Unwrap() *NodeUpdate {
+	tx, ok := nu.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeUpdate is not a transactional builder")
+	}
+	nu.config.driver = tx.drv
+	nu.mutation.driver = tx.drv
+	return nu
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (nu *NodeUpdate) DebugFields() map[string]Value {
+	return debugFields(nu.mutation)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (nu *NodeUpdate) Save(ctx context.Context) (int, error) {
-
+	if err := checkTx(nu.driver); err != nil {
+		return 0, err
+	}
+	if err := nu.check(); err != nil {
+		return 0, err
+	}
 	var (
 		err      error
 		affected int
@@ -145,7 +187,19 @@ func (nu *NodeUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nu *NodeUpdate) check() error {
+	if nu.mutation.conflictprev {
+		return &ValidationError{Name: "prev", err: errors.New("ent: setting and clearing the unique edge \"prev\" in the same mutation")}
+	}
+	if nu.mutation.conflictnext {
+		return &ValidationError{Name: "next", err: errors.New("ent: setting and clearing the unique edge \"next\" in the same mutation")}
+	}
+	return nil
+}
+
 func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	ctx = nu.withOperation(ctx, "Node", "Update")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   node.Table,
@@ -163,6 +217,12 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if limit := nu.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if nu.ids != nil {
+		_spec.ScanIDs = nu.ids
+	}
 	if value, ok := nu.mutation.Value(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -247,7 +307,7 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{node.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -258,11 +318,33 @@ func (nu *NodeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	return n, nil
 }
 
+// SaveReturningIDs executes the query and returns the ids of the Node entities that were updated
+// by this operation. In PostgreSQL and SQLite 3.35 or above, the ids are returned by the UPDATE statement
+// (using the RETURNING clause), and in MySQL and older versions of SQLite, they are selected (using the
+// builder predicates) and updated in one transaction.
+func (nu *NodeUpdate) SaveReturningIDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	nu.ids = &ids
+	defer func() { nu.ids = nil }()
+	if _, err := nu.Save(ctx); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 // NodeUpdateOne is the builder for updating a single Node entity.
 type NodeUpdateOne struct {
 	config
-	hooks    []Hook
-	mutation *NodeMutation
+	hooks      []Hook
+	mutation   *NodeMutation
+	predicates []predicate.Node
+}
+
+// Where adds a new predicate for the builder. The entity is updated only if it
+// matches all predicates, and a *NotFoundError is returned otherwise.
+func (nuo *NodeUpdateOne) Where(ps ...predicate.Node) *NodeUpdateOne {
+	nuo.predicates = append(nuo.predicates, ps...)
+	return nuo
 }
 
 // SetValue sets the value field.
@@ -278,6 +360,13 @@ func (nuo *NodeUpdateOne) AddValue(i int) *NodeUpdateOne {
 	return nuo
 }
 
+// UnsetValue removes the changes of the value field from the builder (e.g. a previous call
+// to SetValue), and therefore, the field is left unchanged in the database.
+func (nuo *NodeUpdateOne) UnsetValue() *NodeUpdateOne {
+	nuo.mutation.ResetValue()
+	return nuo
+}
+
 // SetPrevID sets the prev edge to Node by id.
 func (nuo *NodeUpdateOne) SetPrevID(id int) *NodeUpdateOne {
 	nuo.mutation.SetPrevID(id)
@@ -328,9 +417,33 @@ func (nuo *NodeUpdateOne) ClearNext() *NodeUpdateOne {
 	return nuo
 }
 
+// Unwrap rebinds the builder that was created by a transactional client to the driver that started
+// the transaction, after the transaction was committed or rolled back. Builders that are bound to a
+// closed transaction fail with ErrTxClosed.
+func (nuo *NodeUpdateOne) Unwrap() *NodeUpdateOne {
+	tx, ok := nuo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: NodeUpdateOne is not a transactional builder")
+	}
+	nuo.config.driver = tx.drv
+	nuo.mutation.driver = tx.drv
+	return nuo
+}
+
+// DebugFields returns the changes that were applied to the builder so far, keyed by the
+// field and edge names (see the debugFields function for the key format).
+func (nuo *NodeUpdateOne) DebugFields() map[string]Value {
+	return debugFields(nuo.mutation)
+}
+
 // Save executes the query and returns the updated entity.
 func (nuo *NodeUpdateOne) Save(ctx context.Context) (*Node, error) {
-
+	if err := checkTx(nuo.driver); err != nil {
+		return nil, err
+	}
+	if err := nuo.check(); err != nil {
+		return nil, err
+	}
 	var (
 		err  error
 		node *Node
@@ -379,7 +492,19 @@ func (nuo *NodeUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NodeUpdateOne) check() error {
+	if nuo.mutation.conflictprev {
+		return &ValidationError{Name: "prev", err: errors.New("ent: setting and clearing the unique edge \"prev\" in the same mutation")}
+	}
+	if nuo.mutation.conflictnext {
+		return &ValidationError{Name: "next", err: errors.New("ent: setting and clearing the unique edge \"next\" in the same mutation")}
+	}
+	return nil
+}
+
 func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
+	ctx = nuo.withOperation(ctx, "Node", "UpdateOne")
 	_spec := &sqlgraph.UpdateSpec{
 		Node: &sqlgraph.NodeSpec{
 			Table:   node.Table,
@@ -395,6 +520,13 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 		return nil, fmt.Errorf("missing Node.ID for update")
 	}
 	_spec.Node.ID.Value = id
+	if ps := nuo.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
 	if value, ok := nuo.mutation.Value(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeInt,
@@ -480,9 +612,11 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	n = &Node{config: nuo.config}
-	_spec.Assign = n.assignValues
-	_spec.ScanValues = n.scanValues()
-	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+	_spec.Assign = func(values ...interface{}) error {
+		return n.assignValues(_spec.Node.Columns, values)
+	}
+	_spec.ScanValues = n.scanValues(_spec.Node.Columns)
+	if err = sqlgraph.UpdateNode(ctx, nuo.mutationDriver(ctx), _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{node.Label}
 		} else if cerr, ok := isSQLConstraintError(err); ok {
@@ -490,5 +624,13 @@ func (nuo *NodeUpdateOne) sqlSave(ctx context.Context) (n *Node, err error) {
 		}
 		return nil, err
 	}
+	if sql.IsDryRun(ctx) {
+		// The statements were not executed, and therefore, the node
+		// holds only its id and the fields that were set by the mutation.
+		n.ID = id
+		if value, ok := nuo.mutation.Value(); ok {
+			n.Value = value
+		}
+	}
 	return n, nil
 }
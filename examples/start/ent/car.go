@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -29,6 +33,9 @@ type Car struct {
 	// The values are being populated by the CarQuery when eager-loading is set.
 	Edges     CarEdges `json:"edges"`
 	user_cars *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // CarEdges holds the relations/edges for other nodes in the graph.
@@ -54,61 +61,170 @@ func (e CarEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Car) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // model
-		&sql.NullTime{},   // registered_at
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Car) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // user_cars
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Car type are scanned as generic values.
+func (*Car) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case car.FieldID:
+			values[i] = &sql.NullInt64{}
+		case car.FieldModel:
+			values[i] = &sql.NullString{}
+		case car.FieldRegisteredAt:
+			values[i] = &sql.NullTime{}
+		case car.ForeignKeys[0]: // user_cars
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Car fields.
-func (c *Car) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(car.Columns); m < n {
+// to the Car fields. Values of columns that were not selected are left untouched.
+func (c *Car) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	c.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field model", values[0])
-	} else if value.Valid {
-		c.Model = value.String
-	}
-	if value, ok := values[1].(*sql.NullTime); !ok {
-		return fmt.Errorf("unexpected type %T for field registered_at", values[1])
-	} else if value.Valid {
-		c.RegisteredAt = value.Time
-	}
-	values = values[2:]
-	if len(values) == len(car.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field user_cars", value)
-		} else if value.Valid {
-			c.user_cars = new(int)
-			*c.user_cars = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case car.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case car.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				c.Model = value.String
+			}
+		case car.FieldRegisteredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field registered_at", values[i])
+			} else if value.Valid {
+				c.RegisteredAt = value.Time
+			}
+		case car.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_cars", value)
+			} else if value.Valid {
+				c.user_cars = new(int)
+				*c.user_cars = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (c *Car) SelectValue(alias string) (Value, error) {
+	v, ok := c.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryOwner queries the owner edge of the Car.
 func (c *Car) QueryOwner() *UserQuery {
 	return (&CarClient{config: c.config}).QueryOwner(c)
 }
 
+// LoadEdges loads the given edges of the Car using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see car.Edges), and in this case, no edge is loaded.
+//
+//	err := c.LoadEdges(ctx, client, car.EdgeOwner)
+//
+func (c *Car) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadCarEdges(ctx, client, []*Car{c}, edges)
+}
+
+// loadCarEdges loads the given edges of all the given Car entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using CarClient.LoadEdgeFor.
+func loadCarEdges(ctx context.Context, client *Client, nodes []*Car, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case car.EdgeOwner:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Car", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Car.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Car and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Car alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Car itself is not modified.
+//
+//	b, err := c.DumpGraph(ctx, client, 2, car.EdgeOwner)
+//
+func (c *Car) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Car DumpGraph", depth)
+	}
+	dumped := *c
+	dumped.Edges = CarEdges{}
+	if err := dumpCars(ctx, client, []*Car{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpCars loads the edges of the given Car copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpCars(ctx context.Context, client *Client, nodes []*Car, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Car
+	for _, n := range nodes {
+		if dumper.visit(car.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range car.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadCarEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextOwner []*User
+	for _, n := range visit {
+		if e := n.Edges.Owner; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Owner = &c
+			nextOwner = append(nextOwner, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextOwner, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Car.
 // Note that, you need to call Car.Unwrap() before calling this method, if this Car
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -127,6 +243,27 @@ func (c *Car) Unwrap() *Car {
 	return c
 }
 
+// Clone returns a deep copy of the Car and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (c *Car) Clone() *Car {
+	return c.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Car and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (c *Car) clone(seen map[interface{}]interface{}) *Car {
+	if c == nil {
+		return nil
+	}
+	if v, ok := seen[c]; ok {
+		return v.(*Car)
+	}
+	_c := *c
+	seen[c] = &_c
+	_c.Edges.Owner = c.Edges.Owner.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (c *Car) String() string {
 	var builder strings.Builder
@@ -140,6 +277,28 @@ func (c *Car) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Car, for change detection and cache keys.
+// It covers the fields that are listed in car.FingerprintFields, and it does not depend on the
+// ID of the Car, on its edges, or on the order of the fields in the schema.
+func (c *Car) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "model", c.Model)
+	fingerprint(h, "registered_at", c.RegisteredAt)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Car for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Car can be decoded using the FromMap method of its client.
+func (c *Car) ToMap() map[string]string {
+	m := make(map[string]string, 3)
+	m[car.FieldID] = kvEncode(c.ID)
+	m[car.FieldModel] = kvEncode(c.Model)
+	m[car.FieldRegisteredAt] = kvEncode(c.RegisteredAt)
+	return m
+}
+
 // Cars is a parsable slice of Car.
 type Cars []*Car
 
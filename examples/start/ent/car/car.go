@@ -6,6 +6,10 @@
 
 package car
 
+import (
+	"github.com/facebookincubator/ent/dialect/sql"
+)
+
 const (
 	// Label holds the string label denoting the car type in the database.
 	Label = "car"
@@ -28,6 +32,18 @@ const (
 	OwnerColumn = "user_cars"
 )
 
+// Edges holds all the edge names of the Car type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeOwner,
+}
+
+// FingerprintFields holds the fields of the Car type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldModel,
+	FieldRegisteredAt,
+}
+
 // Columns holds all SQL columns for car fields.
 var Columns = []string{
 	FieldID,
@@ -39,3 +55,19 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"user_cars",
 }
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisteredAtTrunc returns a column that truncates the registered_at field to the start of the given bucket
+// (minute, hour, day, week, month or year). It can be passed to GroupBy, and its values are scanned as "registered_at_<bucket>".
+func RegisteredAtTrunc(bucket string) string {
+	return sql.TimeBucket(bucket, FieldRegisteredAt)
+}
@@ -7,6 +7,7 @@
 package car
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -194,6 +195,13 @@ func ModelContains(v string) predicate.Car {
 	})
 }
 
+// ModelNotContains applies the NotContains predicate on the "model" field.
+func ModelNotContains(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotContains(s.C(FieldModel), v))
+	})
+}
+
 // ModelHasPrefix applies the HasPrefix predicate on the "model" field.
 func ModelHasPrefix(v string) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -201,6 +209,13 @@ func ModelHasPrefix(v string) predicate.Car {
 	})
 }
 
+// ModelNotHasPrefix applies the NotHasPrefix predicate on the "model" field.
+func ModelNotHasPrefix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotHasPrefix(s.C(FieldModel), v))
+	})
+}
+
 // ModelHasSuffix applies the HasSuffix predicate on the "model" field.
 func ModelHasSuffix(v string) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -208,6 +223,13 @@ func ModelHasSuffix(v string) predicate.Car {
 	})
 }
 
+// ModelNotHasSuffix applies the NotHasSuffix predicate on the "model" field.
+func ModelNotHasSuffix(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.NotHasSuffix(s.C(FieldModel), v))
+	})
+}
+
 // ModelEqualFold applies the EqualFold predicate on the "model" field.
 func ModelEqualFold(v string) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -222,6 +244,20 @@ func ModelContainsFold(v string) predicate.Car {
 	})
 }
 
+// ModelHasPrefixFold applies the HasPrefixFold predicate on the "model" field.
+func ModelHasPrefixFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasPrefixFold(s.C(FieldModel), v))
+	})
+}
+
+// ModelHasSuffixFold applies the HasSuffixFold predicate on the "model" field.
+func ModelHasSuffixFold(v string) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		s.Where(sql.HasSuffixFold(s.C(FieldModel), v))
+	})
+}
+
 // RegisteredAtEQ applies the EQ predicate on the "registered_at" field.
 func RegisteredAtEQ(v time.Time) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -298,6 +334,64 @@ func RegisteredAtLTE(v time.Time) predicate.Car {
 	})
 }
 
+// comparableFields holds the comparison kinds of the fields that
+// can be compared with each other by the Fields<Op> predicates.
+var comparableFields = map[string]string{
+	FieldID:           "numeric",
+	FieldModel:        "string",
+	FieldRegisteredAt: "time",
+}
+
+// FieldsEQ applies the EQ predicate between two fields (columns) of the Car.
+func FieldsEQ(f1, f2 string) predicate.Car {
+	return fieldsPredicate(f1, f2, sql.ColumnsEQ)
+}
+
+// FieldsNEQ applies the NEQ predicate between two fields (columns) of the Car.
+func FieldsNEQ(f1, f2 string) predicate.Car {
+	return fieldsPredicate(f1, f2, sql.ColumnsNEQ)
+}
+
+// FieldsLT applies the LT predicate between two fields (columns) of the Car.
+func FieldsLT(f1, f2 string) predicate.Car {
+	return fieldsPredicate(f1, f2, sql.ColumnsLT)
+}
+
+// FieldsLTE applies the LTE predicate between two fields (columns) of the Car.
+func FieldsLTE(f1, f2 string) predicate.Car {
+	return fieldsPredicate(f1, f2, sql.ColumnsLTE)
+}
+
+// FieldsGT applies the GT predicate between two fields (columns) of the Car.
+func FieldsGT(f1, f2 string) predicate.Car {
+	return fieldsPredicate(f1, f2, sql.ColumnsGT)
+}
+
+// FieldsGTE applies the GTE predicate between two fields (columns) of the Car.
+func FieldsGTE(f1, f2 string) predicate.Car {
+	return fieldsPredicate(f1, f2, sql.ColumnsGTE)
+}
+
+// fieldsPredicate returns a predicate that compares two fields of the Car using the given
+// operator. The fields are validated when the predicate is applied, and an error is added to the
+// query if one of them is not a comparable field of the type, or if their types are incompatible.
+func fieldsPredicate(f1, f2 string, op func(string, string) *sql.Predicate) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		k1, ok1 := comparableFields[f1]
+		k2, ok2 := comparableFields[f2]
+		switch {
+		case !ok1:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Car", f1))
+		case !ok2:
+			s.AddError(fmt.Errorf("ent: field %q is not a comparable field of Car", f2))
+		case k1 != k2:
+			s.AddError(fmt.Errorf("ent: fields %q (%s) and %q (%s) of Car are not comparable", f1, k1, f2, k2))
+		default:
+			s.Where(op(s.C(f1), s.C(f2)))
+		}
+	})
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -326,6 +420,45 @@ func HasOwnerWith(preds ...predicate.User) predicate.Car {
 	})
 }
 
+// OwnerIDIn applies a predicate on the "owner" edge, that checks if its id is one of the
+// ids selected by the given query. The query is embedded as a sub-query and it is not executed separately.
+//
+//	car.OwnerIDIn(client.User.Query().Where(...))
+//
+func OwnerIDIn(q sqlgraph.IDsQuerier) predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		sub := q.IDsQuery()
+		if err := sub.Err(); err != nil {
+			s.AddError(err)
+			return
+		}
+		s.Where(sql.In(s.C(OwnerColumn), sub))
+	})
+}
+
+// OwnerMissing applies a predicate on the "owner" edge, that checks if its foreign-key is set, but
+// there is no User with this id (an orphan row). For example, rows that were created when the
+// foreign-keys were disabled or deferred.
+//
+//	WHERE user_cars IS NOT NULL AND NOT EXISTS (SELECT id FROM users WHERE id = user_cars)
+//
+func OwnerMissing() predicate.Car {
+	return predicate.Car(func(s *sql.Selector) {
+		builder := sql.Dialect(s.Dialect())
+		t := builder.Table(OwnerInverseTable).As("owner")
+		s.Where(
+			sql.And(
+				sql.NotNull(s.C(OwnerColumn)),
+				sql.NotExists(
+					builder.Select(t.C("id")).
+						From(t).
+						Where(sql.ColumnsEQ(t.C("id"), s.C(OwnerColumn))),
+				),
+			),
+		)
+	})
+}
+
 // And groups list of predicates with the AND operator between them.
 func And(predicates ...predicate.Car) predicate.Car {
 	return predicate.Car(func(s *sql.Selector) {
@@ -333,6 +466,9 @@ func And(predicates ...predicate.Car) predicate.Car {
 		for _, p := range predicates {
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -347,6 +483,9 @@ func Or(predicates ...predicate.Car) predicate.Car {
 			}
 			p(s1)
 		}
+		if err := s1.Err(); err != nil {
+			s.AddError(err)
+		}
 		s.Where(s1.P())
 	})
 }
@@ -357,3 +496,58 @@ func Not(p predicate.Car) predicate.Car {
 		p(s.Not())
 	})
 }
+
+// TimeRange filters a time field by a range of values. From is inclusive and To is exclusive,
+// and a zero value leaves the range unbounded on its side.
+type TimeRange struct {
+	From, To time.Time
+}
+
+// CarFilter holds optional filters on the Car fields and edges. Filters
+// that are nil (or empty) are ignored by WhereFilter. JSON, custom, bytes, sensitive and
+// non-searchable encrypted fields cannot be filtered this way.
+type CarFilter struct {
+	IDIn         []int
+	Model        *string
+	RegisteredAt *TimeRange
+	OwnerIDIn    []int
+}
+
+// WhereFilter returns the predicates of the filters that are set in f. Each field filter checks
+// for equality (or for a range of times), and each ID filter checks if the id (or the foreign-key
+// of the edge) is one of the given ids.
+//
+//	client.Car.Query().
+//		Where(car.WhereFilter(f)...).
+//		All(ctx)
+//
+func WhereFilter(f *CarFilter) []predicate.Car {
+	if f == nil {
+		return nil
+	}
+	var ps []predicate.Car
+	if len(f.IDIn) > 0 {
+		ps = append(ps, IDIn(f.IDIn...))
+	}
+	if f.Model != nil {
+		ps = append(ps, ModelEQ(*f.Model))
+	}
+	if r := f.RegisteredAt; r != nil {
+		if !r.From.IsZero() {
+			ps = append(ps, RegisteredAtGTE(r.From))
+		}
+		if !r.To.IsZero() {
+			ps = append(ps, RegisteredAtLT(r.To))
+		}
+	}
+	if ids := f.OwnerIDIn; len(ids) > 0 {
+		ps = append(ps, predicate.Car(func(s *sql.Selector) {
+			v := make([]interface{}, len(ids))
+			for i := range v {
+				v[i] = ids[i]
+			}
+			s.Where(sql.In(s.C(OwnerColumn), v...))
+		}))
+	}
+	return ps
+}
@@ -7,6 +7,10 @@
 package ent
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -26,6 +30,9 @@ type Group struct {
 	// The values are being populated by the GroupQuery when eager-loading is set.
 	Edges       GroupEdges `json:"edges"`
 	group_admin *int
+	// selectValues holds the values of the expression
+	// columns that were selected by the query.
+	selectValues map[string]interface{}
 }
 
 // GroupEdges holds the relations/edges for other nodes in the graph.
@@ -62,50 +69,68 @@ func (e GroupEdges) AdminOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "admin"}
 }
 
-// scanValues returns the types for scanning values from sql.Rows.
-func (*Group) scanValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{},  // id
-		&sql.NullString{}, // name
-	}
-}
-
-// fkValues returns the types for scanning foreign-keys values from sql.Rows.
-func (*Group) fkValues() []interface{} {
-	return []interface{}{
-		&sql.NullInt64{}, // group_admin
+// scanValues returns the types for scanning the given columns from sql.Rows. Columns
+// that are unknown to the Group type are scanned as generic values.
+func (*Group) scanValues(columns []string) []interface{} {
+	values := make([]interface{}, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case group.FieldID:
+			values[i] = &sql.NullInt64{}
+		case group.FieldName:
+			values[i] = &sql.NullString{}
+		case group.ForeignKeys[0]: // group_admin
+			values[i] = &sql.NullInt64{}
+		default:
+			values[i] = new(interface{})
+		}
 	}
+	return values
 }
 
 // assignValues assigns the values that were returned from sql.Rows (after scanning)
-// to the Group fields.
-func (gr *Group) assignValues(values ...interface{}) error {
-	if m, n := len(values), len(group.Columns); m < n {
+// to the Group fields. Values of columns that were not selected are left untouched.
+func (gr *Group) assignValues(columns []string, values []interface{}) error {
+	if m, n := len(values), len(columns); m < n {
 		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
 	}
-	value, ok := values[0].(*sql.NullInt64)
-	if !ok {
-		return fmt.Errorf("unexpected type %T for field id", value)
-	}
-	gr.ID = int(value.Int64)
-	values = values[1:]
-	if value, ok := values[0].(*sql.NullString); !ok {
-		return fmt.Errorf("unexpected type %T for field name", values[0])
-	} else if value.Valid {
-		gr.Name = value.String
-	}
-	values = values[1:]
-	if len(values) == len(group.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
-			return fmt.Errorf("unexpected type %T for edge-field group_admin", value)
-		} else if value.Valid {
-			gr.group_admin = new(int)
-			*gr.group_admin = int(value.Int64)
+	for i := range columns {
+		switch columns[i] {
+		case group.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			gr.ID = int(value.Int64)
+		case group.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				gr.Name = value.String
+			}
+		case group.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field group_admin", value)
+			} else if value.Valid {
+				gr.group_admin = new(int)
+				*gr.group_admin = int(value.Int64)
+			}
 		}
 	}
 	return nil
 }
 
+// SelectValue returns the value of the expression column with the given alias that was selected
+// by the query using SelectExpr. The value is returned as it was returned by the database driver
+// (e.g. int64, float64, string or []byte), or as nil for NULL values.
+func (gr *Group) SelectValue(alias string) (Value, error) {
+	v, ok := gr.selectValues[alias]
+	if !ok {
+		return nil, fmt.Errorf("ent: expression column %q was not selected", alias)
+	}
+	return v, nil
+}
+
 // QueryUsers queries the users edge of the Group.
 func (gr *Group) QueryUsers() *UserQuery {
 	return (&GroupClient{config: gr.config}).QueryUsers(gr)
@@ -116,6 +141,107 @@ func (gr *Group) QueryAdmin() *UserQuery {
 	return (&GroupClient{config: gr.config}).QueryAdmin(gr)
 }
 
+// LoadEdges loads the given edges of the Group using the given client, and sets them on its
+// Edges field in place, as if they were eager-loaded (i.e. their OrErr methods do not fail with
+// a *NotLoadedError). An error is returned if one of the names is not a valid edge of the type
+// (see group.Edges), and in this case, no edge is loaded.
+//
+//	err := gr.LoadEdges(ctx, client, group.EdgeUsers, group.EdgeAdmin)
+//
+func (gr *Group) LoadEdges(ctx context.Context, client *Client, edges ...string) error {
+	return loadGroupEdges(ctx, client, []*Group{gr}, edges)
+}
+
+// loadGroupEdges loads the given edges of all the given Group entities. Each edge is loaded
+// with one query (per batch of ids) for all the entities, using GroupClient.LoadEdgeFor.
+func loadGroupEdges(ctx context.Context, client *Client, nodes []*Group, edges []string) error {
+	for _, name := range edges {
+		switch name {
+		case group.EdgeUsers, group.EdgeAdmin:
+		default:
+			return fmt.Errorf("ent: unknown edge %q for type Group", name)
+		}
+	}
+	for _, name := range edges {
+		if err := client.Group.LoadEdgeFor(ctx, nodes, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DumpGraph returns the JSON encoding of the Group and the subgraph that is reachable from it up to the
+// given depth, for debugging and capturing test fixtures. The edges are loaded level by level, for all the
+// entities of a level at once (see LoadEdges), and encoded in the edges of their entities (a depth of 0 encodes
+// the Group alone). Entities that were already visited are encoded without their edges, and therefore,
+// cycles are not followed. If edge names are given, only the edges with these names are followed (in all types).
+// The Group itself is not modified.
+//
+//	b, err := gr.DumpGraph(ctx, client, 2, group.EdgeUsers)
+//
+func (gr *Group) DumpGraph(ctx context.Context, client *Client, depth int, edges ...string) ([]byte, error) {
+	if depth < 0 {
+		return nil, fmt.Errorf("ent: invalid depth %d for Group DumpGraph", depth)
+	}
+	dumped := *gr
+	dumped.Edges = GroupEdges{}
+	if err := dumpGroups(ctx, client, []*Group{&dumped}, depth, newGraphDump(edges)); err != nil {
+		return nil, err
+	}
+	return json.Marshal(&dumped)
+}
+
+// dumpGroups loads the edges of the given Group copies that were not visited before, and replaces the
+// loaded entities with copies, whose edges are dumped recursively (all at once) up to the given depth.
+func dumpGroups(ctx context.Context, client *Client, nodes []*Group, depth int, dumper *graphDump) error {
+	if depth == 0 {
+		return nil
+	}
+	var visit []*Group
+	for _, n := range nodes {
+		if dumper.visit(group.Label, n.ID) {
+			visit = append(visit, n)
+		}
+	}
+	if len(visit) == 0 {
+		return nil
+	}
+	var names []string
+	for _, name := range group.Edges {
+		if dumper.follow(name) {
+			names = append(names, name)
+		}
+	}
+	if err := loadGroupEdges(ctx, client, visit, names); err != nil {
+		return err
+	}
+	var nextUsers []*User
+	for _, n := range visit {
+		for i, e := range n.Edges.Users {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Users[i] = &c
+			nextUsers = append(nextUsers, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextUsers, depth-1, dumper); err != nil {
+		return err
+	}
+	var nextAdmin []*User
+	for _, n := range visit {
+		if e := n.Edges.Admin; e != nil {
+			c := *e
+			c.Edges = UserEdges{}
+			n.Edges.Admin = &c
+			nextAdmin = append(nextAdmin, &c)
+		}
+	}
+	if err := dumpUsers(ctx, client, nextAdmin, depth-1, dumper); err != nil {
+		return err
+	}
+	return nil
+}
+
 // Update returns a builder for updating this Group.
 // Note that, you need to call Group.Unwrap() before calling this method, if this Group
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -134,6 +260,33 @@ func (gr *Group) Unwrap() *Group {
 	return gr
 }
 
+// Clone returns a deep copy of the Group and its loaded edges, without querying the database.
+// Edges that were not loaded on the original entity are not loaded on the returned copy as well.
+func (gr *Group) Clone() *Group {
+	return gr.clone(make(map[interface{}]interface{}))
+}
+
+// clone copies the Group and uses the seen map for
+// preserving the identity of entities in cyclic graphs.
+func (gr *Group) clone(seen map[interface{}]interface{}) *Group {
+	if gr == nil {
+		return nil
+	}
+	if v, ok := seen[gr]; ok {
+		return v.(*Group)
+	}
+	_c := *gr
+	seen[gr] = &_c
+	if gr.Edges.Users != nil {
+		_c.Edges.Users = make([]*User, len(gr.Edges.Users))
+		for _i := range gr.Edges.Users {
+			_c.Edges.Users[_i] = gr.Edges.Users[_i].clone(seen)
+		}
+	}
+	_c.Edges.Admin = gr.Edges.Admin.clone(seen)
+	return &_c
+}
+
 // String implements the fmt.Stringer.
 func (gr *Group) String() string {
 	var builder strings.Builder
@@ -145,6 +298,26 @@ func (gr *Group) String() string {
 	return builder.String()
 }
 
+// Fingerprint returns a stable hash of the content of the Group, for change detection and cache keys.
+// It covers the fields that are listed in group.FingerprintFields, and it does not depend on the
+// ID of the Group, on its edges, or on the order of the fields in the schema.
+func (gr *Group) Fingerprint() string {
+	h := sha256.New()
+	fingerprint(h, "name", gr.Name)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ToMap returns a flat key-value representation of the Group for caching it in key-value stores
+// (e.g. Redis hashes). The values are keyed by the field columns, and encoded using their types (e.g.
+// time values as RFC 3339). Nil fields, optional fields with zero values and sensitive fields are omitted,
+// and so are the edges. The Group can be decoded using the FromMap method of its client.
+func (gr *Group) ToMap() map[string]string {
+	m := make(map[string]string, 2)
+	m[group.FieldID] = kvEncode(gr.ID)
+	m[group.FieldName] = kvEncode(gr.Name)
+	return m
+}
+
 // Groups is a parsable slice of Group.
 type Groups []*Group
 
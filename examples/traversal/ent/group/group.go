@@ -34,6 +34,18 @@ const (
 	AdminColumn = "group_admin"
 )
 
+// Edges holds all the edge names of the Group type, that can be loaded by its LoadEdges method.
+var Edges = []string{
+	EdgeUsers,
+	EdgeAdmin,
+}
+
+// FingerprintFields holds the fields of the Group type that are hashed by its Fingerprint method,
+// in their hashing order. Fields are added or removed using the field.Fingerprint annotation.
+var FingerprintFields = []string{
+	FieldName,
+}
+
 // Columns holds all SQL columns for group fields.
 var Columns = []string{
 	FieldID,
@@ -45,6 +57,16 @@ var ForeignKeys = []string{
 	"group_admin",
 }
 
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
 var (
 	// UsersPrimaryKey and UsersColumn2 are the table columns denoting the
 	// primary key for the users relation (M2M).
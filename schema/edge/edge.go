@@ -6,18 +6,21 @@ package edge
 
 import (
 	"reflect"
+
+	"github.com/facebookincubator/ent/schema"
 )
 
 // A Descriptor for edge configuration.
 type Descriptor struct {
-	Tag      string      // struct tag.
-	Type     string      // edge type.
-	Name     string      // edge name.
-	RefName  string      // ref name; inverse only.
-	Ref      *Descriptor // edge reference; to/from of the same type.
-	Unique   bool        // unique edge.
-	Inverse  bool        // inverse edge.
-	Required bool        // required on creation.
+	Tag         string              // struct tag.
+	Type        string              // edge type.
+	Name        string              // edge name.
+	RefName     string              // ref name; inverse only.
+	Ref         *Descriptor         // edge reference; to/from of the same type.
+	Unique      bool                // unique edge.
+	Inverse     bool                // inverse edge.
+	Required    bool                // required on creation.
+	Annotations []schema.Annotation // edge annotations.
 }
 
 // To defines an association edge between two vertices.
@@ -72,6 +75,16 @@ func (b *assocBuilder) Comment(string) *assocBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the edge object to be used by
+// codegen extensions.
+//
+//	edge.To("pets", Pet.Type).
+//		Annotations(entsql.Deferrable(entsql.InitiallyDeferred))
+func (b *assocBuilder) Annotations(annotations ...schema.Annotation) *assocBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Descriptor interface.
 func (b *assocBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -113,6 +126,16 @@ func (b *inverseBuilder) Comment(string) *inverseBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the edge object to be used by
+// codegen extensions.
+//
+//	edge.To("pets", Pet.Type).
+//		Annotations(entsql.Deferrable(entsql.InitiallyDeferred))
+func (b *inverseBuilder) Annotations(annotations ...schema.Annotation) *inverseBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Descriptor interface.
 func (b *inverseBuilder) Descriptor() *Descriptor {
 	return b.desc
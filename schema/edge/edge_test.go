@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/dialect/entsql"
+	"github.com/facebookincubator/ent/schema"
 	"github.com/facebookincubator/ent/schema/edge"
 
 	"github.com/stretchr/testify/assert"
@@ -81,4 +83,15 @@ func TestEdge(t *testing.T) {
 		Descriptor()
 	assert.Equal("followers", from.Tag)
 	assert.Equal("following", from.Ref.Tag)
+
+	e = edge.To("owner", User.Type).
+		Unique().
+		Annotations(entsql.Deferrable(entsql.InitiallyDeferred)).
+		Descriptor()
+	assert.Equal([]schema.Annotation{entsql.Deferrable(entsql.InitiallyDeferred)}, e.Annotations)
+	from = edge.From("pets", User.Type).
+		Ref("owner").
+		Annotations(entsql.Deferrable(entsql.InitiallyImmediate)).
+		Descriptor()
+	assert.Len(from.Annotations, 1)
 }
@@ -0,0 +1,58 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+// FingerprintAnnotation is a builtin field annotation that configures whether the field
+// is hashed by the generated Fingerprint method of its entity. By default, all fields are
+// hashed, except for time fields with a default value (e.g. create_time and update_time).
+//
+//	field.Time("synced_at").
+//		Annotations(field.Fingerprint(false))
+//
+type FingerprintAnnotation struct {
+	// Include reports if the field is hashed by the fingerprint.
+	Include bool `json:"include"`
+}
+
+// Name describes the annotation name.
+func (FingerprintAnnotation) Name() string {
+	return "Fingerprint"
+}
+
+// Fingerprint returns an annotation that includes (or excludes) the
+// field in the fingerprint of its entity.
+func Fingerprint(include bool) *FingerprintAnnotation {
+	return &FingerprintAnnotation{Include: include}
+}
+
+// PolymorphicAnnotation is a builtin field annotation that defines a polymorphic edge, an edge
+// that points to an entity of one of multiple types. The annotated field holds the id of the
+// entity, and the type field (a string field of the same schema) holds the name of its type.
+//
+//	field.String("owner_type").
+//		Optional(),
+//	field.Int("owner_id").
+//		Optional().
+//		Annotations(field.Polymorphic("owner", "owner_type", "Post", "Photo")),
+//
+type PolymorphicAnnotation struct {
+	// Edge is the name of the polymorphic edge.
+	Edge string `json:"edge"`
+	// TypeField is the name of the field that holds the type of the entity.
+	TypeField string `json:"type_field"`
+	// Types are the names of the types that the edge can point to.
+	Types []string `json:"types"`
+}
+
+// Name describes the annotation name.
+func (PolymorphicAnnotation) Name() string {
+	return "Polymorphic"
+}
+
+// Polymorphic returns an annotation that defines the polymorphic edge with the given name. The type
+// field holds the name of the type of the entity that the edge points to, and it must be one of types.
+func Polymorphic(edge, typeField string, types ...string) *PolymorphicAnnotation {
+	return &PolymorphicAnnotation{Edge: edge, TypeField: typeField, Types: types}
+}
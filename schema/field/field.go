@@ -5,32 +5,63 @@
 package field
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"math"
 	"reflect"
 	"regexp"
+	"strings"
 	"time"
+	"unicode"
+
+	"github.com/facebookincubator/ent/dialect"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/schema"
 )
 
 // A Descriptor for field configuration.
 type Descriptor struct {
-	Tag           string            // struct tag.
-	Size          int               // varchar size.
-	Name          string            // field name.
-	Info          *TypeInfo         // field type info.
-	Unique        bool              // unique index of field.
-	Nillable      bool              // nillable struct field.
-	Optional      bool              // nullable field in database.
-	Immutable     bool              // create-only field.
-	Default       interface{}       // default value on create.
-	UpdateDefault interface{}       // default value on update.
-	Validators    []interface{}     // validator functions.
-	StorageKey    string            // sql column or gremlin property.
-	Enums         []string          // enum values.
-	Sensitive     bool              // sensitive info string field.
-	SchemaType    map[string]string // override the schema type.
-}
+	Tag           string              // struct tag.
+	Size          int                 // varchar size.
+	Precision     int                 // decimal precision.
+	Scale         int                 // decimal scale.
+	Name          string              // field name.
+	Info          *TypeInfo           // field type info.
+	Unique        bool                // unique index of field.
+	Nillable      bool                // nillable struct field.
+	Optional      bool                // nullable field in database.
+	Immutable     bool                // create-only field.
+	Default       interface{}         // default value on create.
+	TimeOrdered   bool                // default values are time-ordered.
+	UpdateDefault interface{}         // default value on update.
+	Validators    []interface{}       // validator functions.
+	StorageKey    string              // sql column or gremlin property.
+	Enums         []string            // enum values.
+	Sensitive     bool                // sensitive info string field.
+	SchemaType    map[string]string   // override the schema type.
+	DefaultExpr   string              // default expression in the database.
+	DefaultExprs  map[string]string   // default expression in the database per dialect.
+	Generated     string              // expression of generated (computed) columns.
+	Stored        bool                // generated column is stored (and not virtual).
+	Location      *time.Location      // location of time values.
+	Cipher        entsql.Cipher       // cipher of encrypted fields.
+	BlindIndex    entsql.BlindIndex   // blind index of encrypted fields.
+	Embedded      []*Descriptor       // fields of embedded structs.
+	EmbedField    string              // struct field of the fields of embedded structs.
+	Annotations   []schema.Annotation // field annotations.
+	Err           error               // error of the field declaration.
+}
+
+// GeneratedStorage defines how the values of a generated (computed) column are stored.
+type GeneratedStorage uint8
+
+// Storage kinds of generated columns.
+const (
+	Virtual GeneratedStorage = iota // computed when the rows are read.
+	Stored                          // computed when the rows are written, and stored.
+)
 
 // String returns a new Field with type string.
 func String(name string) *stringBuilder {
@@ -147,6 +178,176 @@ func UUID(name string, typ driver.Valuer) *uuidBuilder {
 			Ident:    rt.String(),
 			PkgPath:  rt.PkgPath(),
 		},
+	}, rt}
+}
+
+// Other returns a new Field with a custom Go type that implements the sql.Scanner
+// and driver.Valuer interfaces (e.g. geometry types). Since the database type of
+// such fields cannot be inferred, the SchemaType option is required. For example:
+//
+//	field.Other("location", &sql.GeoPoint{}).
+//		SchemaType(map[string]string{
+//			dialect.Postgres: "geometry(Point,4326)",
+//		})
+//
+func Other(name string, typ driver.Valuer) *otherBuilder {
+	ob := &otherBuilder{&Descriptor{
+		Name: name,
+		Info: &TypeInfo{Type: TypeOther},
+	}}
+	rt := reflect.TypeOf(typ)
+	if rt == nil {
+		ob.desc.Err = errors.New("expect a non-nil driver.Valuer for other type")
+		return ob
+	}
+	ob.desc.Info.Ident = rt.String()
+	ob.desc.Info.PkgPath = indirect(rt).PkgPath()
+	if rt.Kind() == reflect.Ptr {
+		ob.desc.Info.Nillable = true
+	}
+	if !rt.Implements(scannerType) && !reflect.PtrTo(rt).Implements(scannerType) {
+		ob.desc.Err = fmt.Errorf("other type %s must implement the sql.Scanner interface", rt)
+	}
+	return ob
+}
+
+// Vector returns a new Field for storing embeddings of the given dimension in PostgreSQL
+// pgvector columns. Its Go type is sql.Vector, and its database type is "vector(dim)".
+// The builders reject values that do not match the dimension of the field. For example:
+//
+//	field.Vector("embedding", 1536).
+//		Optional()
+//
+// Note that vector fields are supported only by PostgreSQL (with the pgvector extension),
+// and migrating them on other dialects fails, unless their SchemaType was overridden.
+func Vector(name string, dim int) *otherBuilder {
+	ob := Other(name, entsql.Vector{}).
+		SchemaType(map[string]string{
+			dialect.Postgres: fmt.Sprintf("vector(%d)", dim),
+		})
+	if dim <= 0 {
+		ob.desc.Err = fmt.Errorf("invalid dimension %d for vector field", dim)
+		return ob
+	}
+	ob.desc.Validators = append(ob.desc.Validators, func(v entsql.Vector) error {
+		if len(v) != dim {
+			return fmt.Errorf("expected %d dimensions for vector, got %d", dim, len(v))
+		}
+		return nil
+	})
+	return ob
+}
+
+// IP returns a new Field for storing IP addresses. Its Go type is sql.IP, and its
+// database type is "inet" in PostgreSQL, and a string column in other dialects. For
+// example:
+//
+//	field.IP("address").
+//		Optional()
+//
+func IP(name string) *otherBuilder {
+	return Other(name, entsql.IP{}).
+		SchemaType(map[string]string{
+			dialect.Postgres: "inet",
+			dialect.MySQL:    "varchar(45)",
+			dialect.SQLite:   "varchar(45)",
+		})
+}
+
+// CIDR returns a new Field for storing IP networks. Its Go type is sql.CIDR, and its
+// database type is "cidr" in PostgreSQL, and a string column in other dialects. For
+// example:
+//
+//	field.CIDR("subnet")
+//
+func CIDR(name string) *otherBuilder {
+	return Other(name, entsql.CIDR{}).
+		SchemaType(map[string]string{
+			dialect.Postgres: "cidr",
+			dialect.MySQL:    "varchar(49)",
+			dialect.SQLite:   "varchar(49)",
+		})
+}
+
+// Point returns a new Field for storing PostGIS points. Its Go type is sql.GeoPoint, and
+// its database type is "geometry(Point,4326)", unless another SRID was set. For example:
+//
+//	field.Point("location").
+//		Optional()
+//
+// Note that spatial fields are supported only by PostgreSQL (with the PostGIS extension),
+// and migrating them on other dialects fails, unless their SchemaType was overridden.
+func Point(name string) *geometryBuilder {
+	gb := newGeometryBuilder(name, entsql.GeoPoint{}, "Point")
+	gb.desc.Validators = append(gb.desc.Validators, func(v entsql.GeoPoint) error {
+		return gb.checkSRID(v.SRID)
+	})
+	return gb
+}
+
+// Polygon returns a new Field for storing PostGIS polygons. Its Go type is sql.GeoPolygon,
+// and its database type is "geometry(Polygon,4326)", unless another SRID was set.
+//
+//	field.Polygon("area").
+//		SRID(3857)
+//
+func Polygon(name string) *geometryBuilder {
+	gb := newGeometryBuilder(name, entsql.GeoPolygon{}, "Polygon")
+	gb.desc.Validators = append(gb.desc.Validators, func(v entsql.GeoPolygon) error {
+		return gb.checkSRID(v.SRID)
+	})
+	return gb
+}
+
+// Geometry returns a new Field for storing PostGIS geometries of any type (e.g. lines or
+// multi-polygons). Its Go type is sql.Geometry (holding the WKT of the geometry), and its
+// database type is "geometry(Geometry,4326)", unless another SRID was set.
+//
+//	field.Geometry("route")
+//
+func Geometry(name string) *geometryBuilder {
+	gb := newGeometryBuilder(name, entsql.Geometry{}, "Geometry")
+	gb.desc.Validators = append(gb.desc.Validators, func(v entsql.Geometry) error {
+		return gb.checkSRID(v.SRID)
+	})
+	return gb
+}
+
+// Decimal returns a new Field with a fixed-point decimal type with the given precision (the
+// total number of digits) and scale (the number of digits after the decimal point). In SQL
+// dialects, it is the "DECIMAL(precision, scale)" type ("NUMERIC" in PostgreSQL), and its
+// values are represented by strings holding their exact text (e.g. "10.50"), unless a custom
+// Go type was configured using the GoType option. For example:
+//
+//	field.Decimal("price", 12, 2).
+//		Default("0.00")
+//
+func Decimal(name string, precision, scale int) *decimalBuilder {
+	db := &decimalBuilder{&Descriptor{
+		Name:      name,
+		Precision: precision,
+		Scale:     scale,
+		Info:      &TypeInfo{Type: TypeDecimal},
+	}}
+	if precision <= 0 || scale < 0 || scale > precision {
+		db.desc.Err = fmt.Errorf("invalid precision and scale for decimal(%d, %d)", precision, scale)
+		return db
+	}
+	db.desc.Validators = append(db.desc.Validators, decimalValidator(precision, scale))
+	return db
+}
+
+// Duration returns a new Field with type time.Duration. In PostgreSQL, its database type
+// is "interval" (with a precision of microseconds), and in other dialects, it is stored as
+// an integer of nanoseconds. For example:
+//
+//	field.Duration("ttl").
+//		Default(time.Hour)
+//
+func Duration(name string) *durationBuilder {
+	return &durationBuilder{&Descriptor{
+		Name: name,
+		Info: &TypeInfo{Type: TypeDuration},
 	}}
 }
 
@@ -167,6 +368,35 @@ func (b *stringBuilder) Sensitive() *stringBuilder {
 	return b
 }
 
+// Encrypted encrypts the values of the field using the given cipher. The values are encrypted
+// by the builders before they are written to the database (and stored base64-encoded in a text
+// column), and they are decrypted by the queries after they are read. Validators are applied on
+// the plaintext values.
+//
+//	field.String("ssn").
+//		Encrypted(sql.AESGCM(sql.StaticKey(key)))
+//
+// Encrypted fields get only equality predicates, and only if their cipher is deterministic (e.g.
+// sql.DeterministicAESGCM), or if a blind index was configured for them using the BlindIndex option.
+func (b *stringBuilder) Encrypted(c entsql.Cipher) *stringBuilder {
+	b.desc.Cipher = c
+	return b
+}
+
+// BlindIndex sets the blind index of an encrypted field. The index of the value is stored in an
+// additional column (named <column>_bidx), and it is used by the equality predicates of the field,
+// and for enforcing the uniqueness of unique fields.
+//
+//	field.String("email").
+//		Unique().
+//		Encrypted(sql.AESGCM(keys)).
+//		BlindIndex(sql.HMACIndex(indexKey))
+//
+func (b *stringBuilder) BlindIndex(idx entsql.BlindIndex) *stringBuilder {
+	b.desc.BlindIndex = idx
+	return b
+}
+
 // Match adds a regex matcher for this field. Operation fails if the regex fails.
 func (b *stringBuilder) Match(re *regexp.Regexp) *stringBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
@@ -273,8 +503,58 @@ func (b *stringBuilder) SchemaType(types map[string]string) *stringBuilder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `lower(hex(randomblob(16)))`), instead of a value that is set by the
+// generated code. Hence, the default also applies to rows that are inserted by other writers.
+// The field is not required on creation, and when it is not set, the value is read back from
+// the database after the insert. See DefaultExprs for setting an expression per dialect.
+func (b *stringBuilder) DefaultExpr(expr string) *stringBuilder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+//
+//	field.String("token").
+//		DefaultExprs(map[string]string{
+//			dialect.Postgres: "md5(random()::text)",
+//			dialect.SQLite:   "lower(hex(randomblob(16)))",
+//		})
+//
+func (b *stringBuilder) DefaultExprs(exprs map[string]string) *stringBuilder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+//
+//	field.String("full_name").
+//		GeneratedAs("first_name || ' ' || last_name", field.Stored)
+//
+// Note that PostgreSQL supports only stored generated columns, and therefore, virtual columns
+// are created as stored in PostgreSQL.
+func (b *stringBuilder) GeneratedAs(expr string, storage GeneratedStorage) *stringBuilder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *stringBuilder) Annotations(annotations ...schema.Annotation) *stringBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *stringBuilder) Descriptor() *Descriptor {
+	if b.desc.Err == nil && b.desc.BlindIndex != nil && b.desc.Cipher == nil {
+		b.desc.Err = errors.New("blind index is allowed only for encrypted fields")
+	}
 	return b.desc
 }
 
@@ -325,6 +605,52 @@ func (b *timeBuilder) Default(f func() time.Time) *timeBuilder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database, instead of a function that is called by the generated code (see Default).
+// Hence, the default also applies to rows that are inserted by other writers. The field is not
+// required on creation, and when it is not set, the value is read back from the database after
+// the insert. For example:
+//
+//	field.Time("created_at").
+//		DefaultExpr("CURRENT_TIMESTAMP")
+//
+// Note that the expression is written as is to the migration, and therefore, it must be valid in
+// all dialects the schema is migrated to. Use DefaultExprs for setting an expression per dialect.
+func (b *timeBuilder) DefaultExpr(expr string) *timeBuilder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+//
+//	field.Time("created_at").
+//		DefaultExprs(map[string]string{
+//			dialect.Postgres: "now()",
+//			dialect.SQLite:   "datetime('now')",
+//		})
+//
+func (b *timeBuilder) DefaultExprs(exprs map[string]string) *timeBuilder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+//
+//	field.Time("expired_at").
+//		GeneratedAs("created_at + interval '1 day'", field.Stored)
+//
+// Note that PostgreSQL supports only stored generated columns, and therefore, virtual columns
+// are created as stored in PostgreSQL.
+func (b *timeBuilder) GeneratedAs(expr string, storage GeneratedStorage) *timeBuilder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // UpdateDefault sets the function that is applied to set default value
 // of the field on update. For example:
 //
@@ -344,6 +670,13 @@ func (b *timeBuilder) StorageKey(key string) *timeBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *timeBuilder) Annotations(annotations ...schema.Annotation) *timeBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *timeBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -363,6 +696,21 @@ func (b *timeBuilder) SchemaType(types map[string]string) *timeBuilder {
 	return b
 }
 
+// Location sets the location of the time values that are read from the database.
+// It does not change the instant the values represent, and therefore, it should
+// be used with a schema type that keeps the time-zone information. For example:
+//
+//	field.Time("expires_at").
+//		SchemaType(map[string]string{
+//			dialect.Postgres: "timestamptz",
+//		}).
+//		Location(loc)
+//
+func (b *timeBuilder) Location(loc *time.Location) *timeBuilder {
+	b.desc.Location = loc
+	return b
+}
+
 // boolBuilder is the builder for boolean fields.
 type boolBuilder struct {
 	desc *Descriptor
@@ -374,6 +722,27 @@ func (b *boolBuilder) Default(v bool) *boolBuilder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database, instead of a value that is set by the generated code. The field is not
+// required on creation, and when it is not set, the value is read back from the database
+// after the insert. See DefaultExprs for setting an expression per dialect.
+//
+//	field.Bool("active").
+//		DefaultExpr("true")
+//
+func (b *boolBuilder) DefaultExpr(expr string) *boolBuilder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *boolBuilder) DefaultExprs(exprs map[string]string) *boolBuilder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *boolBuilder) Nillable() *boolBuilder {
@@ -412,6 +781,13 @@ func (b *boolBuilder) StorageKey(key string) *boolBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *boolBuilder) Annotations(annotations ...schema.Annotation) *boolBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *boolBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -474,6 +850,13 @@ func (b *bytesBuilder) StorageKey(key string) *bytesBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *bytesBuilder) Annotations(annotations ...schema.Annotation) *bytesBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *bytesBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -543,11 +926,174 @@ func (b *jsonBuilder) SchemaType(types map[string]string) *jsonBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *jsonBuilder) Annotations(annotations ...schema.Annotation) *jsonBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *jsonBuilder) Descriptor() *Descriptor {
 	return b.desc
 }
 
+// Embed returns a new Field for embedding the given struct in the schema. The exported fields of
+// the struct are flattened to columns that are prefixed with the field name, and the generated
+// entity holds them in a single field of the struct type. For example:
+//
+//	type Address struct {
+//		Street string
+//		City   string
+//	}
+//
+//	field.Embed("address", Address{})
+//
+// is stored in the "address_street" and "address_city" columns, and it is exposed as the
+// Address field of the entity. The fields of the struct can be strings, booleans, numbers
+// or time values.
+func Embed(name string, typ interface{}) *embedBuilder {
+	b := &embedBuilder{&Descriptor{
+		Name: name,
+		Info: &TypeInfo{Type: TypeOther},
+	}}
+	t := reflect.TypeOf(typ)
+	if t == nil || t.Kind() != reflect.Struct {
+		b.desc.Err = fmt.Errorf("embedded type must be a struct, got: %T", typ)
+		return b
+	}
+	if t.Name() == "" {
+		b.desc.Err = errors.New("embedded type must be a named struct")
+		return b
+	}
+	b.desc.Info.Ident = t.String()
+	b.desc.Info.PkgPath = t.PkgPath()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		info, err := embedType(f.Type)
+		if err != nil {
+			b.desc.Err = fmt.Errorf("embedded field %s.%s: %v", t.Name(), f.Name, err)
+			return b
+		}
+		b.desc.Embedded = append(b.desc.Embedded, &Descriptor{
+			Name:       name + "_" + snake(f.Name),
+			Info:       info,
+			EmbedField: f.Name,
+		})
+	}
+	if len(b.desc.Embedded) == 0 {
+		b.desc.Err = fmt.Errorf("embedded type %s has no exported fields", t)
+	}
+	return b
+}
+
+// embedType returns the type info of the given type of an embedded struct field.
+func embedType(t reflect.Type) (*TypeInfo, error) {
+	if t == reflect.TypeOf(time.Time{}) {
+		return &TypeInfo{Type: TypeTime}, nil
+	}
+	if t.PkgPath() != "" {
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &TypeInfo{Type: TypeString}, nil
+	case reflect.Bool:
+		return &TypeInfo{Type: TypeBool}, nil
+	case reflect.Int:
+		return &TypeInfo{Type: TypeInt}, nil
+	case reflect.Int8:
+		return &TypeInfo{Type: TypeInt8}, nil
+	case reflect.Int16:
+		return &TypeInfo{Type: TypeInt16}, nil
+	case reflect.Int32:
+		return &TypeInfo{Type: TypeInt32}, nil
+	case reflect.Int64:
+		return &TypeInfo{Type: TypeInt64}, nil
+	case reflect.Uint:
+		return &TypeInfo{Type: TypeUint}, nil
+	case reflect.Uint8:
+		return &TypeInfo{Type: TypeUint8}, nil
+	case reflect.Uint16:
+		return &TypeInfo{Type: TypeUint16}, nil
+	case reflect.Uint32:
+		return &TypeInfo{Type: TypeUint32}, nil
+	case reflect.Uint64:
+		return &TypeInfo{Type: TypeUint64}, nil
+	case reflect.Float32:
+		return &TypeInfo{Type: TypeFloat32}, nil
+	case reflect.Float64:
+		return &TypeInfo{Type: TypeFloat64}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+}
+
+// snake converts the given struct field name into a snake_case.
+//
+//	ZipCode => zip_code
+//	ID => id
+//
+func snake(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && i < len(s)-1 && unicode.IsUpper(r) &&
+			(unicode.IsLower(rune(s[i-1])) || unicode.IsLower(rune(s[i+1])) && unicode.IsLetter(rune(s[i-1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// embedBuilder is the builder for embedded struct fields.
+type embedBuilder struct {
+	desc *Descriptor
+}
+
+// Optional indicates that the fields of the struct are optional on create.
+// Their columns are nullable, and NULL values are read as zero values.
+func (b *embedBuilder) Optional() *embedBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that the fields of the struct cannot be updated.
+func (b *embedBuilder) Immutable() *embedBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *embedBuilder) Comment(c string) *embedBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *embedBuilder) StructTag(s string) *embedBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *embedBuilder) Annotations(annotations ...schema.Annotation) *embedBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *embedBuilder) Descriptor() *Descriptor {
+	for _, fd := range b.desc.Embedded {
+		fd.Optional = b.desc.Optional
+		fd.Immutable = b.desc.Immutable
+	}
+	return b.desc
+}
+
 // enumBuilder is the builder for enum fields.
 type enumBuilder struct {
 	desc *Descriptor
@@ -559,12 +1105,69 @@ func (b *enumBuilder) Values(values ...string) *enumBuilder {
 	return b
 }
 
+// EnumValues is implemented by Go types that provide the values of an enum field.
+// See enumBuilder.GoType for more info.
+type EnumValues interface {
+	Values() []string
+}
+
+// GoType overrides the default Go type of the enum field (a type that is generated in the
+// entity package) with a custom string type. If the type implements the EnumValues interface,
+// and the field values were not set, they are taken from the Values method.
+//
+//	type CardType string
+//
+//	const (
+//		CardTypeVisa CardType = "visa"
+//		CardTypeAmex CardType = "amex"
+//	)
+//
+//	field.Enum("type").
+//		Values("visa", "amex").
+//		GoType(CardType(""))
+//
+func (b *enumBuilder) GoType(typ interface{}) *enumBuilder {
+	rt := reflect.TypeOf(typ)
+	if rt == nil || rt.Kind() != reflect.String || rt.PkgPath() == "" {
+		b.desc.Err = fmt.Errorf("GoType must be a named string type, got: %T", typ)
+		return b
+	}
+	b.desc.Info.Ident = rt.String()
+	b.desc.Info.PkgPath = rt.PkgPath()
+	if ev, ok := typ.(EnumValues); ok && len(b.desc.Enums) == 0 {
+		b.desc.Enums = ev.Values()
+	}
+	return b
+}
+
 // Default sets the default value of the field.
 func (b *enumBuilder) Default(value string) *enumBuilder {
 	b.desc.Default = value
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database, instead of a value that is set by the generated code. The field is not
+// required on creation, and when it is not set, the value is read back from the database
+// after the insert. See DefaultExprs for setting an expression per dialect.
+//
+//	field.Enum("status").
+//		Values("active", "disabled").
+//		DefaultExpr("'active'")
+//
+func (b *enumBuilder) DefaultExpr(expr string) *enumBuilder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *enumBuilder) DefaultExprs(exprs map[string]string) *enumBuilder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
 // StorageKey sets the storage key of the field.
 // In SQL dialects is the column name and Gremlin is the property.
 func (b *enumBuilder) StorageKey(key string) *enumBuilder {
@@ -616,6 +1219,13 @@ func (b *enumBuilder) SchemaType(types map[string]string) *enumBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *enumBuilder) Annotations(annotations ...schema.Annotation) *enumBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *enumBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -624,6 +1234,7 @@ func (b *enumBuilder) Descriptor() *Descriptor {
 // uuidBuilder is the builder for uuid fields.
 type uuidBuilder struct {
 	desc *Descriptor
+	typ  reflect.Type
 }
 
 // StorageKey sets the storage key of the field.
@@ -666,6 +1277,53 @@ func (b *uuidBuilder) StructTag(s string) *uuidBuilder {
 //
 func (b *uuidBuilder) Default(fn interface{}) *uuidBuilder {
 	b.desc.Default = fn
+	b.desc.TimeOrdered = false
+	return b
+}
+
+// DefaultUUIDv7 sets the default value of the field to a function that generates
+// time-ordered UUIDs (version 7). Since the values that are generated by the process
+// are increasing, ordering by the field follows the creation order of the entities,
+// and it can be used for keyset pagination. The Go type of the field must be a 16-byte
+// array, like uuid.UUID.
+//
+//	field.UUID("id", uuid.UUID{}).
+//		DefaultUUIDv7()
+//
+func (b *uuidBuilder) DefaultUUIDv7() *uuidBuilder {
+	if !reflect.TypeOf([16]byte{}).ConvertibleTo(b.typ) {
+		b.desc.Err = fmt.Errorf("DefaultUUIDv7 expects a [16]byte uuid type, got %s", b.typ)
+		return b
+	}
+	typ := b.typ
+	fn := reflect.MakeFunc(reflect.FuncOf(nil, []reflect.Type{typ}, false), func([]reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(newUUIDv7()).Convert(typ)}
+	})
+	b.desc.Default = fn.Interface()
+	b.desc.TimeOrdered = true
+	return b
+}
+
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database, instead of a function that is called by the generated code (see Default).
+// The field is not required on creation, and when it is not set, the value is read back from
+// the database after the insert. Note that ID fields cannot have default expressions.
+//
+//	field.UUID("token", uuid.UUID{}).
+//		DefaultExprs(map[string]string{
+//			dialect.Postgres: "gen_random_uuid()",
+//		})
+//
+func (b *uuidBuilder) DefaultExpr(expr string) *uuidBuilder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *uuidBuilder) DefaultExprs(exprs map[string]string) *uuidBuilder {
+	b.desc.DefaultExprs = exprs
 	return b
 }
 
@@ -682,7 +1340,429 @@ func (b *uuidBuilder) SchemaType(types map[string]string) *uuidBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *uuidBuilder) Annotations(annotations ...schema.Annotation) *uuidBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uuidBuilder) Descriptor() *Descriptor {
 	return b.desc
 }
+
+// otherBuilder is the builder for fields with custom Go types.
+type otherBuilder struct {
+	desc *Descriptor
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *otherBuilder) StorageKey(key string) *otherBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *otherBuilder) Optional() *otherBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *otherBuilder) Immutable() *otherBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *otherBuilder) Comment(c string) *otherBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *otherBuilder) StructTag(s string) *otherBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// SchemaType sets the database type of the field (per dialect). Migrating
+// the field on a dialect that is missing from the map fails.
+//
+//	field.Other("location", &sql.GeoPoint{}).
+//		SchemaType(map[string]string{
+//			dialect.Postgres: "geometry(Point,4326)",
+//		})
+//
+func (b *otherBuilder) SchemaType(types map[string]string) *otherBuilder {
+	b.desc.SchemaType = types
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *otherBuilder) Annotations(annotations ...schema.Annotation) *otherBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *otherBuilder) Descriptor() *Descriptor {
+	if b.desc.Err == nil && len(b.desc.SchemaType) == 0 {
+		b.desc.Err = errors.New("missing SchemaType option for other type")
+	}
+	return b.desc
+}
+
+// geometryBuilder is the builder for spatial fields.
+type geometryBuilder struct {
+	desc *Descriptor
+	kind string
+	srid int
+}
+
+// newGeometryBuilder returns a builder for a spatial field with the given Go type and geometry kind.
+func newGeometryBuilder(name string, typ driver.Valuer, kind string) *geometryBuilder {
+	gb := &geometryBuilder{desc: Other(name, typ).desc, kind: kind}
+	return gb.SRID(entsql.SRID4326)
+}
+
+// checkSRID checks if the given SRID of a value matches the SRID of the field.
+func (b *geometryBuilder) checkSRID(srid int) error {
+	if srid == 0 {
+		srid = entsql.SRID4326
+	}
+	if srid != b.srid {
+		return fmt.Errorf("expected SRID %d for %s, got %d", b.srid, strings.ToLower(b.kind), srid)
+	}
+	return nil
+}
+
+// SRID sets the spatial reference identifier of the field (defaults to 4326, the WGS 84
+// coordinate system). The builders reject values with a different SRID.
+//
+//	field.Point("location").
+//		SRID(3857)
+//
+func (b *geometryBuilder) SRID(srid int) *geometryBuilder {
+	b.srid = srid
+	return b.SchemaType(map[string]string{
+		dialect.Postgres: fmt.Sprintf("geometry(%s,%d)", b.kind, srid),
+	})
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *geometryBuilder) StorageKey(key string) *geometryBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *geometryBuilder) Optional() *geometryBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *geometryBuilder) Immutable() *geometryBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *geometryBuilder) Comment(c string) *geometryBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *geometryBuilder) StructTag(s string) *geometryBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// SchemaType overrides the database type of the field (per dialect). Note that
+// setting the SRID of the field resets its schema type.
+func (b *geometryBuilder) SchemaType(types map[string]string) *geometryBuilder {
+	b.desc.SchemaType = types
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *geometryBuilder) Annotations(annotations ...schema.Annotation) *geometryBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *geometryBuilder) Descriptor() *Descriptor {
+	return b.desc
+}
+
+// decimalBuilder is the builder for decimal fields.
+type decimalBuilder struct {
+	desc *Descriptor
+}
+
+// Unique makes the field unique within all vertices of this type.
+func (b *decimalBuilder) Unique() *decimalBuilder {
+	b.desc.Unique = true
+	return b
+}
+
+// Default sets the default value of the field. The value must be a valid
+// decimal for the precision and the scale of the field.
+func (b *decimalBuilder) Default(s string) *decimalBuilder {
+	b.desc.Default = s
+	if b.desc.Err == nil {
+		b.desc.Err = decimalValidator(b.desc.Precision, b.desc.Scale)(s)
+	}
+	return b
+}
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
+func (b *decimalBuilder) Nillable() *decimalBuilder {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *decimalBuilder) Optional() *decimalBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *decimalBuilder) Immutable() *decimalBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *decimalBuilder) Comment(c string) *decimalBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *decimalBuilder) StructTag(s string) *decimalBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *decimalBuilder) StorageKey(key string) *decimalBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// GoType overrides the default Go type (string) of the field with a custom decimal type
+// that implements the sql.Scanner and driver.Valuer interfaces. For example:
+//
+//	field.Decimal("price", 12, 2).
+//		GoType(decimal.Decimal{})
+//
+// Values of custom types are not validated against the precision and the scale of the
+// field, and such fields cannot have a default value.
+func (b *decimalBuilder) GoType(typ driver.Valuer) *decimalBuilder {
+	rt := reflect.TypeOf(typ)
+	if rt == nil {
+		b.desc.Err = errors.New("expect a non-nil driver.Valuer for decimal type")
+		return b
+	}
+	b.desc.Info.Ident = rt.String()
+	b.desc.Info.PkgPath = indirect(rt).PkgPath()
+	b.desc.Info.Nillable = rt.Kind() == reflect.Ptr
+	b.desc.Validators = nil
+	if !rt.Implements(scannerType) && !reflect.PtrTo(rt).Implements(scannerType) {
+		b.desc.Err = fmt.Errorf("decimal type %s must implement the sql.Scanner interface", rt)
+	}
+	return b
+}
+
+// SchemaType overrides the default database type with a custom
+// schema type (per dialect) for decimal.
+//
+//	field.Decimal("price", 12, 2).
+//		SchemaType(map[string]string{
+//			dialect.MySQL: "decimal(12,2) unsigned",
+//		})
+//
+func (b *decimalBuilder) SchemaType(types map[string]string) *decimalBuilder {
+	b.desc.SchemaType = types
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *decimalBuilder) Annotations(annotations ...schema.Annotation) *decimalBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *decimalBuilder) Descriptor() *Descriptor {
+	if b.desc.Err == nil && b.desc.Default != nil && b.desc.Info.Ident != "" {
+		b.desc.Err = fmt.Errorf("decimal field with a custom Go type (%s) cannot have a default value", b.desc.Info)
+	}
+	return b.desc
+}
+
+// decimalValidator returns a validator for the textual representation of decimals that checks
+// that the number of digits before the decimal point fits the precision and the scale of the
+// field. Extra digits after the decimal point are rounded by the database.
+func decimalValidator(precision, scale int) func(string) error {
+	return func(s string) error {
+		v := strings.TrimLeft(s, "+-")
+		if len(s)-len(v) > 1 {
+			return fmt.Errorf("value %q is not a valid decimal", s)
+		}
+		i := strings.IndexByte(v, '.')
+		if i == -1 {
+			i = len(v)
+		}
+		ip, fp := v[:i], strings.TrimPrefix(v[i:], ".")
+		if ip == "" && fp == "" || strings.Trim(ip+fp, "0123456789") != "" {
+			return fmt.Errorf("value %q is not a valid decimal", s)
+		}
+		if ip = strings.TrimLeft(ip, "0"); len(ip) > precision-scale {
+			return fmt.Errorf("value %q is out of range for decimal(%d, %d)", s, precision, scale)
+		}
+		return nil
+	}
+}
+
+// durationBuilder is the builder for duration fields.
+type durationBuilder struct {
+	desc *Descriptor
+}
+
+// Range adds a range validator for this field where the given value needs to be in the range of [i, j].
+func (b *durationBuilder) Range(i, j time.Duration) *durationBuilder {
+	b.desc.Validators = append(b.desc.Validators, func(v time.Duration) error {
+		if v < i || v > j {
+			return errors.New("value out of range")
+		}
+		return nil
+	})
+	return b
+}
+
+// Min adds a minimum value validator for this field. Operation fails if the validator fails.
+func (b *durationBuilder) Min(i time.Duration) *durationBuilder {
+	b.desc.Validators = append(b.desc.Validators, func(v time.Duration) error {
+		if v < i {
+			return errors.New("value out of range")
+		}
+		return nil
+	})
+	return b
+}
+
+// Max adds a maximum value validator for this field. Operation fails if the validator fails.
+func (b *durationBuilder) Max(i time.Duration) *durationBuilder {
+	b.desc.Validators = append(b.desc.Validators, func(v time.Duration) error {
+		if v > i {
+			return errors.New("value out of range")
+		}
+		return nil
+	})
+	return b
+}
+
+// Positive adds a minimum value validator with the value of 1ns. Operation fails if the validator fails.
+func (b *durationBuilder) Positive() *durationBuilder {
+	return b.Min(1)
+}
+
+// Default sets the default value of the field. Unlike other numeric fields, the
+// default value is applied by the builders, and not defined in the database.
+func (b *durationBuilder) Default(d time.Duration) *durationBuilder {
+	b.desc.Default = d
+	return b
+}
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
+func (b *durationBuilder) Nillable() *durationBuilder {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *durationBuilder) Optional() *durationBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *durationBuilder) Immutable() *durationBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *durationBuilder) Comment(c string) *durationBuilder {
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *durationBuilder) StructTag(s string) *durationBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// Validate adds a validator for this field. Operation fails if the validation fails.
+func (b *durationBuilder) Validate(fn func(time.Duration) error) *durationBuilder {
+	b.desc.Validators = append(b.desc.Validators, fn)
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *durationBuilder) StorageKey(key string) *durationBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// SchemaType overrides the default database type with a custom
+// schema type (per dialect) for duration.
+//
+//	field.Duration("ttl").
+//		SchemaType(map[string]string{
+//			dialect.Postgres: "interval hour to second",
+//		})
+//
+func (b *durationBuilder) SchemaType(types map[string]string) *durationBuilder {
+	b.desc.SchemaType = types
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *durationBuilder) Annotations(annotations ...schema.Annotation) *durationBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *durationBuilder) Descriptor() *Descriptor {
+	return b.desc
+}
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// indirect returns the type at the end of indirection.
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
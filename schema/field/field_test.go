@@ -5,12 +5,15 @@
 package field_test
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"net/http"
 	"regexp"
 	"testing"
 	"time"
 
 	"github.com/facebookincubator/ent/dialect"
+	entsql "github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/schema/field"
 
 	"github.com/google/uuid"
@@ -123,6 +126,61 @@ func TestTime(t *testing.T) {
 		Descriptor()
 	assert.Equal(t, "updated_at", fd.Name)
 	assert.Equal(t, now, fd.UpdateDefault.(func() time.Time)())
+
+	fd = field.Time("expires_at").
+		Location(time.UTC).
+		Descriptor()
+	assert.Equal(t, time.UTC, fd.Location)
+
+	fd = field.Time("created_at").
+		DefaultExpr("CURRENT_TIMESTAMP").
+		DefaultExprs(map[string]string{dialect.Postgres: "now()"}).
+		Descriptor()
+	assert.Nil(t, fd.Default)
+	assert.Equal(t, "CURRENT_TIMESTAMP", fd.DefaultExpr)
+	assert.Equal(t, map[string]string{dialect.Postgres: "now()"}, fd.DefaultExprs)
+}
+
+func TestField_Generated(t *testing.T) {
+	fd := field.String("full_name").
+		GeneratedAs("first_name || ' ' || last_name", field.Stored).
+		Descriptor()
+	assert.Equal(t, "first_name || ' ' || last_name", fd.Generated)
+	assert.True(t, fd.Stored)
+	fd = field.Int("name_len").
+		GeneratedAs("length(first_name)", field.Virtual).
+		Descriptor()
+	assert.Equal(t, "length(first_name)", fd.Generated)
+	assert.False(t, fd.Stored)
+	fd = field.Time("expired_at").
+		GeneratedAs("created_at + interval '1 day'", field.Stored).
+		Descriptor()
+	assert.True(t, fd.Stored)
+}
+
+func TestField_DefaultExpr(t *testing.T) {
+	fd := field.Int("priority").
+		DefaultExpr("0").
+		Descriptor()
+	assert.Equal(t, "0", fd.DefaultExpr)
+	fd = field.Float("score").
+		DefaultExprs(map[string]string{dialect.Postgres: "random()"}).
+		Descriptor()
+	assert.Equal(t, map[string]string{dialect.Postgres: "random()"}, fd.DefaultExprs)
+	fd = field.Bool("active").
+		DefaultExpr("true").
+		Descriptor()
+	assert.Equal(t, "true", fd.DefaultExpr)
+	fd = field.Enum("status").
+		Values("active", "disabled").
+		DefaultExpr("'active'").
+		Descriptor()
+	assert.Equal(t, "'active'", fd.DefaultExpr)
+	fd = field.UUID("token", uuid.UUID{}).
+		DefaultExprs(map[string]string{dialect.Postgres: "gen_random_uuid()"}).
+		Descriptor()
+	assert.Nil(t, fd.Default)
+	assert.Equal(t, map[string]string{dialect.Postgres: "gen_random_uuid()"}, fd.DefaultExprs)
 }
 
 func TestJSON(t *testing.T) {
@@ -154,6 +212,184 @@ func TestJSON(t *testing.T) {
 	assert.Equal(t, "[]string", fd.Info.String())
 }
 
+type valuer struct{}
+
+func (valuer) Value() (driver.Value, error) { return nil, nil }
+
+func TestOther(t *testing.T) {
+	fd := field.Other("other", &sql.NullInt64{}).
+		SchemaType(map[string]string{dialect.Postgres: "bigint"}).
+		Optional().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.True(t, fd.Optional)
+	assert.Equal(t, "other", fd.Name)
+	assert.Equal(t, field.TypeOther, fd.Info.Type)
+	assert.Equal(t, "*sql.NullInt64", fd.Info.String())
+	assert.Equal(t, "database/sql", fd.Info.PkgPath)
+	assert.True(t, fd.Info.Nillable)
+
+	fd = field.Other("other", sql.NullString{}).
+		SchemaType(map[string]string{dialect.Postgres: "varchar"}).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "sql.NullString", fd.Info.String())
+	assert.False(t, fd.Info.Nillable)
+
+	fd = field.Other("other", sql.NullString{}).Descriptor()
+	assert.Error(t, fd.Err, "missing schema type")
+
+	fd = field.Other("other", valuer{}).
+		SchemaType(map[string]string{dialect.Postgres: "varchar"}).
+		Descriptor()
+	assert.Error(t, fd.Err, "type does not implement sql.Scanner")
+}
+
+func TestVector(t *testing.T) {
+	fd := field.Vector("embedding", 3).
+		Optional().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "embedding", fd.Name)
+	assert.Equal(t, field.TypeOther, fd.Info.Type)
+	assert.Equal(t, "sql.Vector", fd.Info.String())
+	assert.Equal(t, "github.com/facebookincubator/ent/dialect/sql", fd.Info.PkgPath)
+	assert.False(t, fd.Info.Nillable)
+	assert.Equal(t, map[string]string{dialect.Postgres: "vector(3)"}, fd.SchemaType)
+	assert.Len(t, fd.Validators, 1)
+	validate := fd.Validators[0].(func(entsql.Vector) error)
+	assert.NoError(t, validate(entsql.Vector{1, 2, 3}))
+	assert.Error(t, validate(entsql.Vector{1, 2}))
+
+	fd = field.Vector("embedding", 0).Descriptor()
+	assert.Error(t, fd.Err, "invalid dimension")
+}
+
+func TestIP(t *testing.T) {
+	fd := field.IP("address").
+		Optional().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "address", fd.Name)
+	assert.Equal(t, field.TypeOther, fd.Info.Type)
+	assert.Equal(t, "sql.IP", fd.Info.String())
+	assert.Equal(t, "github.com/facebookincubator/ent/dialect/sql", fd.Info.PkgPath)
+	assert.False(t, fd.Info.Nillable)
+	assert.Equal(t, "inet", fd.SchemaType[dialect.Postgres])
+	assert.Equal(t, "varchar(45)", fd.SchemaType[dialect.SQLite])
+
+	fd = field.CIDR("subnet").Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "sql.CIDR", fd.Info.String())
+	assert.Equal(t, "cidr", fd.SchemaType[dialect.Postgres])
+	assert.Equal(t, "varchar(49)", fd.SchemaType[dialect.MySQL])
+}
+
+func TestGeometry(t *testing.T) {
+	fd := field.Point("location").
+		Optional().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "location", fd.Name)
+	assert.Equal(t, field.TypeOther, fd.Info.Type)
+	assert.Equal(t, "sql.GeoPoint", fd.Info.String())
+	assert.Equal(t, "github.com/facebookincubator/ent/dialect/sql", fd.Info.PkgPath)
+	assert.Equal(t, map[string]string{dialect.Postgres: "geometry(Point,4326)"}, fd.SchemaType)
+	assert.Len(t, fd.Validators, 1)
+	validate := fd.Validators[0].(func(entsql.GeoPoint) error)
+	assert.NoError(t, validate(entsql.GeoPoint{Lat: 1, Lng: 2}))
+	assert.NoError(t, validate(entsql.GeoPoint{SRID: 4326}))
+	assert.Error(t, validate(entsql.GeoPoint{SRID: 3857}))
+
+	fd = field.Polygon("area").
+		SRID(3857).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "sql.GeoPolygon", fd.Info.String())
+	assert.Equal(t, map[string]string{dialect.Postgres: "geometry(Polygon,3857)"}, fd.SchemaType)
+	polygon := fd.Validators[0].(func(entsql.GeoPolygon) error)
+	assert.NoError(t, polygon(entsql.GeoPolygon{SRID: 3857}))
+	assert.Error(t, polygon(entsql.GeoPolygon{}))
+
+	fd = field.Geometry("route").
+		Immutable().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.True(t, fd.Immutable)
+	assert.Equal(t, "sql.Geometry", fd.Info.String())
+	assert.Equal(t, "geometry(Geometry,4326)", fd.SchemaType[dialect.Postgres])
+	geometry := fd.Validators[0].(func(entsql.Geometry) error)
+	assert.NoError(t, geometry(entsql.Geometry{WKT: "LINESTRING(0 0,1 1)"}))
+}
+
+func TestDecimal(t *testing.T) {
+	fd := field.Decimal("price", 12, 2).
+		Default("0.00").
+		Optional().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "price", fd.Name)
+	assert.Equal(t, field.TypeDecimal, fd.Info.Type)
+	assert.Equal(t, "string", fd.Info.String())
+	assert.Equal(t, 12, fd.Precision)
+	assert.Equal(t, 2, fd.Scale)
+	assert.Equal(t, "0.00", fd.Default)
+	assert.Len(t, fd.Validators, 1)
+	validate := fd.Validators[0].(func(string) error)
+	for _, v := range []string{"1", "-10.5", "+0.125", "0000000001.10", ".5", "9999999999.99"} {
+		assert.NoError(t, validate(v), v)
+	}
+	for _, v := range []string{"", ".", "-", "--1", "1e3", "1.2.3", "abc", "10000000000", "-99999999999.5"} {
+		assert.Error(t, validate(v), v)
+	}
+
+	fd = field.Decimal("price", 12, 2).Default("1e3").Descriptor()
+	assert.Error(t, fd.Err, "invalid default value")
+	fd = field.Decimal("price", 2, 3).Descriptor()
+	assert.Error(t, fd.Err, "scale is greater than precision")
+	fd = field.Decimal("price", 0, 0).Descriptor()
+	assert.Error(t, fd.Err, "invalid precision")
+
+	fd = field.Decimal("price", 12, 2).GoType(&sql.NullFloat64{}).Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, field.TypeDecimal, fd.Info.Type)
+	assert.Equal(t, "*sql.NullFloat64", fd.Info.String())
+	assert.Equal(t, "database/sql", fd.Info.PkgPath)
+	assert.True(t, fd.Info.Nillable)
+	assert.Empty(t, fd.Validators)
+	fd = field.Decimal("price", 12, 2).GoType(valuer{}).Descriptor()
+	assert.Error(t, fd.Err, "type does not implement sql.Scanner")
+	fd = field.Decimal("price", 12, 2).Default("1.00").GoType(sql.NullFloat64{}).Descriptor()
+	assert.Error(t, fd.Err, "custom types cannot have a default value")
+}
+
+func TestDuration(t *testing.T) {
+	fd := field.Duration("ttl").
+		Default(time.Hour).
+		Positive().
+		Optional().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "ttl", fd.Name)
+	assert.Equal(t, field.TypeDuration, fd.Info.Type)
+	assert.Equal(t, "time.Duration", fd.Info.String())
+	assert.Equal(t, "TypeDuration", fd.Info.ConstName())
+	assert.True(t, fd.Info.Numeric())
+	assert.Equal(t, time.Hour, fd.Default)
+	assert.True(t, fd.Optional)
+	assert.Len(t, fd.Validators, 1)
+	validate := fd.Validators[0].(func(time.Duration) error)
+	assert.NoError(t, validate(time.Nanosecond))
+	assert.Error(t, validate(0))
+
+	fd = field.Duration("ttl").
+		Range(time.Second, time.Minute).
+		Descriptor()
+	validate = fd.Validators[0].(func(time.Duration) error)
+	assert.NoError(t, validate(time.Second))
+	assert.Error(t, validate(time.Hour))
+}
+
 func TestField_Tag(t *testing.T) {
 	fd := field.Bool("expired").
 		StructTag(`json:"expired,omitempty"`).
@@ -175,6 +411,37 @@ func TestField_Enums(t *testing.T) {
 	assert.Equal(t, "user", fd.Default)
 }
 
+type Role string
+
+func (Role) Values() []string {
+	return []string{"user", "admin"}
+}
+
+func TestField_EnumGoType(t *testing.T) {
+	fd := field.Enum("role").
+		GoType(Role("")).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "field_test.Role", fd.Info.String())
+	assert.Equal(t, "github.com/facebookincubator/ent/schema/field_test", fd.Info.PkgPath)
+	assert.Equal(t, []string{"user", "admin"}, fd.Enums, "values are taken from the Go type")
+
+	fd = field.Enum("role").
+		Values("user").
+		GoType(Role("")).
+		Descriptor()
+	assert.Equal(t, []string{"user"}, fd.Enums)
+
+	fd = field.Enum("role").
+		GoType(1).
+		Descriptor()
+	assert.Error(t, fd.Err)
+	fd = field.Enum("role").
+		GoType("").
+		Descriptor()
+	assert.Error(t, fd.Err, "unnamed types are not allowed")
+}
+
 func TestField_UUID(t *testing.T) {
 	fd := field.UUID("id", uuid.UUID{}).
 		Default(uuid.New).
@@ -186,12 +453,98 @@ func TestField_UUID(t *testing.T) {
 	assert.NotEmpty(t, fd.Default.(func() uuid.UUID)())
 }
 
+func TestField_UUIDv7(t *testing.T) {
+	fd := field.UUID("id", uuid.UUID{}).
+		DefaultUUIDv7().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.True(t, fd.TimeOrdered)
+	gen := fd.Default.(func() uuid.UUID)
+	prev := gen()
+	assert.Equal(t, uuid.Version(7), prev.Version())
+	assert.Equal(t, uuid.RFC4122, prev.Variant())
+	assert.InDelta(t, time.Now().UnixNano()/1e6, int64(prev[0])<<40|int64(prev[1])<<32|int64(prev[2])<<24|int64(prev[3])<<16|int64(prev[4])<<8|int64(prev[5]), 1000)
+	for i := 0; i < 10000; i++ {
+		u := gen()
+		assert.True(t, prev.String() < u.String(), "uuids must be increasing")
+		prev = u
+	}
+
+	fd = field.UUID("id", uuid.UUID{}).
+		DefaultUUIDv7().
+		Default(uuid.New).
+		Descriptor()
+	assert.False(t, fd.TimeOrdered)
+
+	fd = field.UUID("id", &uuid.UUID{}).
+		DefaultUUIDv7().
+		Descriptor()
+	assert.Error(t, fd.Err, "pointer types are not supported")
+}
+
+func TestField_Encrypted(t *testing.T) {
+	c := entsql.AESGCM(entsql.StaticKey(make([]byte, 32)))
+	fd := field.String("ssn").
+		Encrypted(c).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, c, fd.Cipher)
+	assert.Nil(t, fd.BlindIndex)
+
+	idx := entsql.HMACIndex([]byte("key"))
+	fd = field.String("email").
+		Unique().
+		Encrypted(c).
+		BlindIndex(idx).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, idx, fd.BlindIndex)
+
+	fd = field.String("email").
+		BlindIndex(idx).
+		Descriptor()
+	assert.Error(t, fd.Err, "blind index is allowed only for encrypted fields")
+}
+
+func TestEmbed(t *testing.T) {
+	type Address struct {
+		Street  string
+		ZipCode int
+		Since   time.Time
+		private bool
+	}
+	fd := field.Embed("address", Address{}).
+		Optional().
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "address", fd.Name)
+	assert.Equal(t, "field_test.Address", fd.Info.String())
+	assert.Len(t, fd.Embedded, 3)
+	assert.Equal(t, "address_street", fd.Embedded[0].Name)
+	assert.Equal(t, "Street", fd.Embedded[0].EmbedField)
+	assert.Equal(t, field.TypeString, fd.Embedded[0].Info.Type)
+	assert.Equal(t, "address_zip_code", fd.Embedded[1].Name)
+	assert.Equal(t, field.TypeInt, fd.Embedded[1].Info.Type)
+	assert.Equal(t, field.TypeTime, fd.Embedded[2].Info.Type)
+	assert.True(t, fd.Embedded[2].Optional)
+
+	fd = field.Embed("address", &Address{}).Descriptor()
+	assert.Error(t, fd.Err, "pointers are not supported")
+	fd = field.Embed("address", struct{ Street string }{}).Descriptor()
+	assert.Error(t, fd.Err, "unnamed structs are not supported")
+	type Location struct {
+		Point []float64
+	}
+	fd = field.Embed("location", Location{}).Descriptor()
+	assert.Error(t, fd.Err, "slices are not supported")
+}
+
 func TestTypeString(t *testing.T) {
 	typ := field.TypeBool
 	assert.Equal(t, "bool", typ.String())
 	typ = field.TypeInvalid
 	assert.Equal(t, "invalid", typ.String())
-	typ = 21
+	typ = 23
 	assert.Equal(t, "invalid", typ.String())
 }
 
@@ -207,7 +560,7 @@ func TestTypeValid(t *testing.T) {
 	assert.True(t, typ.Valid())
 	typ = 0
 	assert.False(t, typ.Valid())
-	typ = 21
+	typ = 23
 	assert.False(t, typ.Valid())
 }
 
@@ -216,6 +569,6 @@ func TestTypeConstName(t *testing.T) {
 	assert.Equal(t, "TypeJSON", typ.ConstName())
 	typ = field.TypeInt
 	assert.Equal(t, "TypeInt", typ.ConstName())
-	typ = 21
+	typ = 23
 	assert.Equal(t, "invalid", typ.ConstName())
 }
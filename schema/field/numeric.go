@@ -4,7 +4,11 @@
 
 package field
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/facebookincubator/ent/schema"
+)
 
 //go:generate go run gen/gen.go
 
@@ -169,6 +173,33 @@ func (b *intBuilder) Default(i int) *intBuilder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *intBuilder) DefaultExpr(expr string) *intBuilder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *intBuilder) DefaultExprs(exprs map[string]string) *intBuilder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *intBuilder) GeneratedAs(expr string, storage GeneratedStorage) *intBuilder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *intBuilder) Nillable() *intBuilder {
@@ -226,6 +257,13 @@ func (b *intBuilder) SchemaType(types map[string]string) *intBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *intBuilder) Annotations(annotations ...schema.Annotation) *intBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *intBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -286,6 +324,33 @@ func (b *uintBuilder) Default(i uint) *uintBuilder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *uintBuilder) DefaultExpr(expr string) *uintBuilder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *uintBuilder) DefaultExprs(exprs map[string]string) *uintBuilder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *uintBuilder) GeneratedAs(expr string, storage GeneratedStorage) *uintBuilder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *uintBuilder) Nillable() *uintBuilder {
@@ -343,6 +408,13 @@ func (b *uintBuilder) SchemaType(types map[string]string) *uintBuilder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *uintBuilder) Annotations(annotations ...schema.Annotation) *uintBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uintBuilder) Descriptor() *Descriptor {
 	return b.desc
@@ -413,6 +485,33 @@ func (b *int8Builder) Default(i int8) *int8Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *int8Builder) DefaultExpr(expr string) *int8Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *int8Builder) DefaultExprs(exprs map[string]string) *int8Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *int8Builder) GeneratedAs(expr string, storage GeneratedStorage) *int8Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *int8Builder) Nillable() *int8Builder {
@@ -470,6 +569,13 @@ func (b *int8Builder) SchemaType(types map[string]string) *int8Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *int8Builder) Annotations(annotations ...schema.Annotation) *int8Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int8Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -540,6 +646,33 @@ func (b *int16Builder) Default(i int16) *int16Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *int16Builder) DefaultExpr(expr string) *int16Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *int16Builder) DefaultExprs(exprs map[string]string) *int16Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *int16Builder) GeneratedAs(expr string, storage GeneratedStorage) *int16Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *int16Builder) Nillable() *int16Builder {
@@ -597,6 +730,13 @@ func (b *int16Builder) SchemaType(types map[string]string) *int16Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *int16Builder) Annotations(annotations ...schema.Annotation) *int16Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int16Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -667,6 +807,33 @@ func (b *int32Builder) Default(i int32) *int32Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *int32Builder) DefaultExpr(expr string) *int32Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *int32Builder) DefaultExprs(exprs map[string]string) *int32Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *int32Builder) GeneratedAs(expr string, storage GeneratedStorage) *int32Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *int32Builder) Nillable() *int32Builder {
@@ -724,6 +891,13 @@ func (b *int32Builder) SchemaType(types map[string]string) *int32Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *int32Builder) Annotations(annotations ...schema.Annotation) *int32Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int32Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -794,6 +968,33 @@ func (b *int64Builder) Default(i int64) *int64Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *int64Builder) DefaultExpr(expr string) *int64Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *int64Builder) DefaultExprs(exprs map[string]string) *int64Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *int64Builder) GeneratedAs(expr string, storage GeneratedStorage) *int64Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *int64Builder) Nillable() *int64Builder {
@@ -851,6 +1052,13 @@ func (b *int64Builder) SchemaType(types map[string]string) *int64Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *int64Builder) Annotations(annotations ...schema.Annotation) *int64Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *int64Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -911,6 +1119,33 @@ func (b *uint8Builder) Default(i uint8) *uint8Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *uint8Builder) DefaultExpr(expr string) *uint8Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *uint8Builder) DefaultExprs(exprs map[string]string) *uint8Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *uint8Builder) GeneratedAs(expr string, storage GeneratedStorage) *uint8Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *uint8Builder) Nillable() *uint8Builder {
@@ -968,6 +1203,13 @@ func (b *uint8Builder) SchemaType(types map[string]string) *uint8Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *uint8Builder) Annotations(annotations ...schema.Annotation) *uint8Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint8Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1028,6 +1270,33 @@ func (b *uint16Builder) Default(i uint16) *uint16Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *uint16Builder) DefaultExpr(expr string) *uint16Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *uint16Builder) DefaultExprs(exprs map[string]string) *uint16Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *uint16Builder) GeneratedAs(expr string, storage GeneratedStorage) *uint16Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *uint16Builder) Nillable() *uint16Builder {
@@ -1085,6 +1354,13 @@ func (b *uint16Builder) SchemaType(types map[string]string) *uint16Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *uint16Builder) Annotations(annotations ...schema.Annotation) *uint16Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint16Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1145,6 +1421,33 @@ func (b *uint32Builder) Default(i uint32) *uint32Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *uint32Builder) DefaultExpr(expr string) *uint32Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *uint32Builder) DefaultExprs(exprs map[string]string) *uint32Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *uint32Builder) GeneratedAs(expr string, storage GeneratedStorage) *uint32Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *uint32Builder) Nillable() *uint32Builder {
@@ -1202,6 +1505,13 @@ func (b *uint32Builder) SchemaType(types map[string]string) *uint32Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *uint32Builder) Annotations(annotations ...schema.Annotation) *uint32Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint32Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1262,6 +1572,33 @@ func (b *uint64Builder) Default(i uint64) *uint64Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *uint64Builder) DefaultExpr(expr string) *uint64Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *uint64Builder) DefaultExprs(exprs map[string]string) *uint64Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *uint64Builder) GeneratedAs(expr string, storage GeneratedStorage) *uint64Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *uint64Builder) Nillable() *uint64Builder {
@@ -1319,6 +1656,13 @@ func (b *uint64Builder) SchemaType(types map[string]string) *uint64Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *uint64Builder) Annotations(annotations ...schema.Annotation) *uint64Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *uint64Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1384,6 +1728,33 @@ func (b *float64Builder) Default(i float64) *float64Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *float64Builder) DefaultExpr(expr string) *float64Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *float64Builder) DefaultExprs(exprs map[string]string) *float64Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *float64Builder) GeneratedAs(expr string, storage GeneratedStorage) *float64Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *float64Builder) Nillable() *float64Builder {
@@ -1442,6 +1813,13 @@ func (b *float64Builder) SchemaType(types map[string]string) *float64Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *float64Builder) Annotations(annotations ...schema.Annotation) *float64Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *float64Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -1507,6 +1885,33 @@ func (b *float32Builder) Default(i float32) *float32Builder {
 	return b
 }
 
+// DefaultExpr sets the default value of the column as an SQL expression that is evaluated
+// by the database (e.g. `nextval('seq')`), instead of a value that is set by the generated
+// code. The field is not required on creation, and when it is not set, the value is read
+// back from the database after the insert. See DefaultExprs for setting it per dialect.
+func (b *float32Builder) DefaultExpr(expr string) *float32Builder {
+	b.desc.DefaultExpr = expr
+	return b
+}
+
+// DefaultExprs is like DefaultExpr, but sets the default expression per dialect.
+// Dialects that are missing in the map use the expression that was set by DefaultExpr,
+// or no default at all.
+func (b *float32Builder) DefaultExprs(exprs map[string]string) *float32Builder {
+	b.desc.DefaultExprs = exprs
+	return b
+}
+
+// GeneratedAs defines the field as a generated (computed) column, whose value is computed
+// by the database from the given expression. No setters are generated for the field in the
+// create and update builders, and its value is read back from the database after creation.
+// Note that PostgreSQL supports only stored generated columns.
+func (b *float32Builder) GeneratedAs(expr string, storage GeneratedStorage) *float32Builder {
+	b.desc.Generated = expr
+	b.desc.Stored = storage == Stored
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated field.
 func (b *float32Builder) Nillable() *float32Builder {
@@ -1565,6 +1970,13 @@ func (b *float32Builder) SchemaType(types map[string]string) *float32Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+func (b *float32Builder) Annotations(annotations ...schema.Annotation) *float32Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Field interface by returning its descriptor.
 func (b *float32Builder) Descriptor() *Descriptor {
 	return b.desc
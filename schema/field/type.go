@@ -19,6 +19,8 @@ const (
 	TypeBytes
 	TypeEnum
 	TypeString
+	TypeDecimal
+	TypeOther
 	TypeInt8
 	TypeInt16
 	TypeInt32
@@ -31,6 +33,7 @@ const (
 	TypeUint64
 	TypeFloat32
 	TypeFloat64
+	TypeDuration
 	endTypes
 )
 
@@ -103,32 +106,38 @@ func (t TypeInfo) ConstName() string {
 
 var (
 	typeNames = [...]string{
-		TypeInvalid: "invalid",
-		TypeBool:    "bool",
-		TypeTime:    "time.Time",
-		TypeJSON:    "json.RawMessage",
-		TypeUUID:    "[16]byte",
-		TypeBytes:   "[]byte",
-		TypeEnum:    "string",
-		TypeString:  "string",
-		TypeInt:     "int",
-		TypeInt8:    "int8",
-		TypeInt16:   "int16",
-		TypeInt32:   "int32",
-		TypeInt64:   "int64",
-		TypeUint:    "uint",
-		TypeUint8:   "uint8",
-		TypeUint16:  "uint16",
-		TypeUint32:  "uint32",
-		TypeUint64:  "uint64",
-		TypeFloat32: "float32",
-		TypeFloat64: "float64",
+		TypeInvalid:  "invalid",
+		TypeBool:     "bool",
+		TypeTime:     "time.Time",
+		TypeJSON:     "json.RawMessage",
+		TypeUUID:     "[16]byte",
+		TypeBytes:    "[]byte",
+		TypeEnum:     "string",
+		TypeString:   "string",
+		TypeDecimal:  "string",
+		TypeOther:    "other",
+		TypeInt:      "int",
+		TypeInt8:     "int8",
+		TypeInt16:    "int16",
+		TypeInt32:    "int32",
+		TypeInt64:    "int64",
+		TypeUint:     "uint",
+		TypeUint8:    "uint8",
+		TypeUint16:   "uint16",
+		TypeUint32:   "uint32",
+		TypeUint64:   "uint64",
+		TypeFloat32:  "float32",
+		TypeFloat64:  "float64",
+		TypeDuration: "time.Duration",
 	}
 	constNames = [...]string{
-		TypeJSON:  "TypeJSON",
-		TypeUUID:  "TypeUUID",
-		TypeTime:  "TypeTime",
-		TypeEnum:  "TypeEnum",
-		TypeBytes: "TypeBytes",
+		TypeJSON:     "TypeJSON",
+		TypeUUID:     "TypeUUID",
+		TypeTime:     "TypeTime",
+		TypeEnum:     "TypeEnum",
+		TypeBytes:    "TypeBytes",
+		TypeDecimal:  "TypeDecimal",
+		TypeOther:    "TypeOther",
+		TypeDuration: "TypeDuration",
 	}
 )
@@ -0,0 +1,47 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+import (
+	"crypto/rand"
+	"sync"
+	"time"
+)
+
+// v7 holds the state of the UUIDv7 generator, that keeps the values
+// generated by the process increasing, also within the same millisecond.
+var v7 struct {
+	sync.Mutex
+	ms  int64
+	seq uint16
+}
+
+// newUUIDv7 returns a new time-ordered UUID (version 7). Its 48 most significant bits
+// hold the Unix time in milliseconds, followed by a 12-bit sequence that is seeded from
+// the sub-millisecond time, and 62 random bits.
+func newUUIDv7() [16]byte {
+	var u [16]byte
+	if _, err := rand.Read(u[:]); err != nil {
+		panic(err)
+	}
+	now := time.Now().UnixNano()
+	ms, seq := now/1e6, uint16(now%1e6*4096/1e6)
+	v7.Lock()
+	if ms < v7.ms || ms == v7.ms && seq <= v7.seq {
+		ms, seq = v7.ms, v7.seq+1
+		if seq > 0xfff {
+			ms, seq = ms+1, 0
+		}
+	}
+	v7.ms, v7.seq = ms, seq
+	v7.Unlock()
+	for i := 0; i < 6; i++ {
+		u[i] = byte(ms >> (40 - 8*i))
+	}
+	u[6] = 0x70 | byte(seq>>8)
+	u[7] = byte(seq)
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
@@ -4,12 +4,15 @@
 
 package index
 
+import "github.com/facebookincubator/ent/schema"
+
 // A Descriptor for index configuration.
 type Descriptor struct {
-	Unique     bool     // unique index.
-	Edges      []string // edge columns.
-	Fields     []string // field columns.
-	StorageKey string   // custom index name.
+	Unique      bool                // unique index.
+	Edges       []string            // edge columns.
+	Fields      []string            // field columns.
+	StorageKey  string              // custom index name.
+	Annotations []schema.Annotation // index annotations.
 }
 
 // Builder for indexes on vertex columns and edges in the graph.
@@ -97,6 +100,17 @@ func (b *Builder) StorageKey(key string) *Builder {
 	return b
 }
 
+// Annotations adds a list of annotations to the index object to be used by
+// codegen extensions.
+//
+//	index.Fields("owner_id").
+//		Annotations(entsql.IncludeColumns("type", "expires_at"))
+//
+func (b *Builder) Annotations(annotations ...schema.Annotation) *Builder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
 // Descriptor implements the ent.Descriptor interface.
 func (b *Builder) Descriptor() *Descriptor {
 	return b.desc
@@ -0,0 +1,14 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package schema holds the types that are shared between the schema builders.
+package schema
+
+// Annotation is used to attach arbitrary metadata to the schema objects (e.g. edges)
+// that is used by the codegen. The object must be serializable to JSON, because the
+// schema is loaded by the codegen from a compiled program.
+type Annotation interface {
+	// Name defines the name of the annotation to be retrieved by the codegen.
+	Name() string
+}